@@ -0,0 +1,77 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package googlesecretmanagerprovider // import "github.com/open-telemetry/opentelemetry-collector-contrib/confmap/provider/googlesecretmanagerprovider"
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+
+	"golang.org/x/oauth2/google"
+)
+
+const (
+	defaultEndpoint = "https://secretmanager.googleapis.com"
+
+	cloudPlatformScope = "https://www.googleapis.com/auth/cloud-platform"
+)
+
+// client is a minimal client of the Secret Manager REST API, accessing the versions of the secrets.
+type client struct {
+	endpoint   string
+	httpClient *http.Client
+}
+
+// newClient returns a client authenticated with the Application Default Credentials.
+func newClient(ctx context.Context) (*client, error) {
+	httpClient, err := google.DefaultClient(ctx, cloudPlatformScope)
+	if err != nil {
+		return nil, err
+	}
+	return &client{endpoint: defaultEndpoint, httpClient: httpClient}, nil
+}
+
+// secretVersion is a version of a secret, with its payload.
+type secretVersion struct {
+	// Name is the resource name of the version, holding its number when the latest version is accessed.
+	Name    string `json:"name"`
+	Payload struct {
+		Data []byte `json:"data"`
+	} `json:"payload"`
+}
+
+// access returns the version of the secret, e.g. "projects/my-project/secrets/api-key/versions/latest".
+func (c *client) access(ctx context.Context, name string) (*secretVersion, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, c.endpoint+"/v1/"+name+":access", nil)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		var errResp struct {
+			Error struct {
+				Message string `json:"message"`
+			} `json:"error"`
+		}
+		b, _ := io.ReadAll(resp.Body)
+		if json.Unmarshal(b, &errResp) == nil && errResp.Error.Message != "" {
+			return nil, fmt.Errorf("%s: %s: %s", name, resp.Status, errResp.Error.Message)
+		}
+		return nil, fmt.Errorf("%s: %s", name, resp.Status)
+	}
+
+	v := &secretVersion{}
+	if err := json.NewDecoder(resp.Body).Decode(v); err != nil {
+		return nil, fmt.Errorf("%s: failed to decode the response: %w", name, err)
+	}
+	return v, nil
+}
@@ -0,0 +1,162 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package googlesecretmanagerprovider // import "github.com/open-telemetry/opentelemetry-collector-contrib/confmap/provider/googlesecretmanagerprovider"
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"regexp"
+	"strings"
+	"sync"
+	"time"
+
+	"go.opentelemetry.io/collector/confmap"
+	"go.uber.org/zap"
+)
+
+const (
+	schemeName = "googlesecretmanager"
+
+	latestVersion = "latest"
+
+	// defaultPollInterval is the interval at which the latest versions of the secrets are accessed again, to detect
+	// their rotation.
+	defaultPollInterval = 5 * time.Minute
+)
+
+// secretPattern matches the resource names of the secrets, with an optional version.
+var secretPattern = regexp.MustCompile(`^projects/[^/]+/secrets/[^/]+(/versions/[^/]+)?$`)
+
+type provider struct {
+	client       *client
+	logger       *zap.Logger
+	pollInterval time.Duration
+
+	mu sync.Mutex
+	// pollers cancel the polling of the retrieved secrets.
+	pollers map[int]context.CancelFunc
+	nextID  int
+}
+
+// NewFactory returns a new confmap.ProviderFactory that creates a confmap.Provider
+// which reads configuration from Google Cloud Secret Manager secrets.
+//
+// This Provider supports "googlesecretmanager" scheme, and can be called with a selector:
+// `googlesecretmanager:projects/PROJECT/secrets/SECRET[/versions/VERSION]`, e.g.
+// `googlesecretmanager:projects/my-project/secrets/api-key`. The latest version of the secret is read when the
+// version isn't set.
+//
+// The client is authenticated with the Application Default Credentials. The latest versions of the secrets are
+// accessed again every 5 minutes, and the configuration is reloaded when a secret is rotated, which restarts the
+// components using it.
+func NewFactory() confmap.ProviderFactory {
+	return confmap.NewProviderFactory(newWithSettings)
+}
+
+func newWithSettings(set confmap.ProviderSettings) confmap.Provider {
+	logger := set.Logger
+	if logger == nil {
+		logger = zap.NewNop()
+	}
+	return &provider{
+		logger:       logger,
+		pollInterval: defaultPollInterval,
+		pollers:      map[int]context.CancelFunc{},
+	}
+}
+
+func (p *provider) Retrieve(ctx context.Context, uri string, watcher confmap.WatcherFunc) (*confmap.Retrieved, error) {
+	if !strings.HasPrefix(uri, schemeName+":") {
+		return nil, fmt.Errorf("%q uri is not supported by %q provider", uri, schemeName)
+	}
+	name := strings.TrimPrefix(uri, schemeName+":")
+	if !secretPattern.MatchString(name) {
+		return nil, fmt.Errorf("%q uri is not a secret, must be projects/PROJECT/secrets/SECRET[/versions/VERSION]", uri)
+	}
+	if !strings.Contains(name, "/versions/") {
+		name += "/versions/" + latestVersion
+	}
+
+	// initialize the secret manager client in the first call of Retrieve
+	if p.client == nil {
+		c, err := newClient(context.Background())
+		if err != nil {
+			return nil, fmt.Errorf("failed to find the Google Cloud credentials: %w", err)
+		}
+		p.client = c
+	}
+
+	v, err := p.client.access(ctx, name)
+	if err != nil {
+		return nil, fmt.Errorf("failed to access the secret %q: %w", name, err)
+	}
+
+	// the other versions never change
+	if watcher == nil || !strings.HasSuffix(name, "/versions/"+latestVersion) {
+		return confmap.NewRetrieved(string(v.Payload.Data))
+	}
+	stop := p.poll(name, v.Payload.Data, watcher)
+	return confmap.NewRetrieved(string(v.Payload.Data), confmap.WithRetrievedClose(func(context.Context) error {
+		stop()
+		return nil
+	}))
+}
+
+// poll accesses the latest version of the secret at the poll interval, and notifies the watcher once its value
+// changed, e.g. after its rotation. It returns the function stopping the polling.
+func (p *provider) poll(name string, data []byte, watcher confmap.WatcherFunc) func() {
+	ctx, cancel := context.WithCancel(context.Background())
+	p.mu.Lock()
+	id := p.nextID
+	p.nextID++
+	p.pollers[id] = cancel
+	p.mu.Unlock()
+
+	logger := p.logger.With(zap.String("secret", name))
+	go func() {
+		ticker := time.NewTicker(p.pollInterval)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+			}
+			v, err := p.client.access(ctx, name)
+			switch {
+			case ctx.Err() != nil:
+				return
+			case err != nil:
+				logger.Warn("Failed to access the secret again", zap.Error(err))
+			case !bytes.Equal(v.Payload.Data, data):
+				logger.Info("The secret changed, reloading the configuration", zap.String("version", v.Name))
+				watcher(&confmap.ChangeEvent{})
+				// the secret is accessed again by the new configuration
+				return
+			}
+		}
+	}()
+
+	return func() {
+		p.mu.Lock()
+		defer p.mu.Unlock()
+		cancel()
+		delete(p.pollers, id)
+	}
+}
+
+func (*provider) Scheme() string {
+	return schemeName
+}
+
+func (p *provider) Shutdown(context.Context) error {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	for id, cancel := range p.pollers {
+		cancel()
+		delete(p.pollers, id)
+	}
+	return nil
+}
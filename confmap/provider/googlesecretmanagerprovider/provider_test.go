@@ -0,0 +1,177 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package googlesecretmanagerprovider
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/collector/confmap"
+	"go.uber.org/zap"
+)
+
+// fakeSecretManager serves the versions of the secrets like the Secret Manager REST API.
+type fakeSecretManager struct {
+	t *testing.T
+
+	mu sync.Mutex
+	// versions are the payloads of the versions of the secrets by secret name, the last one being the latest.
+	versions map[string][]string
+}
+
+func (f *fakeSecretManager) add(secret, payload string) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.versions[secret] = append(f.versions[secret], payload)
+}
+
+func (f *fakeSecretManager) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	name, ok := strings.CutSuffix(strings.TrimPrefix(r.URL.Path, "/v1/"), ":access")
+	assert.True(f.t, ok)
+	secret, version, _ := strings.Cut(name, "/versions/")
+	versions := f.versions[secret]
+	number := len(versions)
+	if version != latestVersion {
+		number, _ = strconv.Atoi(version)
+	}
+	if number < 1 || number > len(versions) {
+		w.WriteHeader(http.StatusNotFound)
+		_, _ = w.Write([]byte(`{"error":{"code":404,"message":"Secret [` + name + `] not found or has no versions.","status":"NOT_FOUND"}}`))
+		return
+	}
+
+	resp := map[string]any{
+		"name":    secret + "/versions/" + strconv.Itoa(number),
+		"payload": map[string]any{"data": []byte(versions[number-1])},
+	}
+	assert.NoError(f.t, json.NewEncoder(w).Encode(resp))
+}
+
+func newTestProvider(t *testing.T) (*provider, *fakeSecretManager) {
+	f := &fakeSecretManager{t: t, versions: map[string][]string{}}
+	s := httptest.NewServer(f)
+	t.Cleanup(s.Close)
+
+	p := &provider{
+		client:       &client{endpoint: s.URL, httpClient: s.Client()},
+		logger:       zap.NewNop(),
+		pollInterval: 10 * time.Millisecond,
+		pollers:      map[int]context.CancelFunc{},
+	}
+	t.Cleanup(func() {
+		assert.NoError(t, p.Shutdown(context.Background()))
+	})
+	return p, f
+}
+
+func TestRetrieve(t *testing.T) {
+	p, f := newTestProvider(t)
+	f.add("projects/otel/secrets/api-key", "v1")
+	f.add("projects/otel/secrets/api-key", "v2")
+
+	tests := []struct {
+		uri  string
+		want any
+	}{
+		{uri: "googlesecretmanager:projects/otel/secrets/api-key", want: "v2"},
+		{uri: "googlesecretmanager:projects/otel/secrets/api-key/versions/latest", want: "v2"},
+		{uri: "googlesecretmanager:projects/otel/secrets/api-key/versions/1", want: "v1"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.uri, func(t *testing.T) {
+			ret, err := p.Retrieve(context.Background(), tt.uri, nil)
+			require.NoError(t, err)
+			value, err := ret.AsRaw()
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, value)
+			assert.NoError(t, ret.Close(context.Background()))
+		})
+	}
+}
+
+func TestRetrieveErrors(t *testing.T) {
+	p, _ := newTestProvider(t)
+
+	tests := []struct {
+		uri     string
+		wantErr string
+	}{
+		{
+			uri:     "vault:secret/data/otel",
+			wantErr: `"vault:secret/data/otel" uri is not supported by "googlesecretmanager" provider`,
+		},
+		{
+			uri:     "googlesecretmanager:api-key",
+			wantErr: `"googlesecretmanager:api-key" uri is not a secret, must be projects/PROJECT/secrets/SECRET[/versions/VERSION]`,
+		},
+		{
+			uri:     "googlesecretmanager:projects/otel/secrets/missing",
+			wantErr: `failed to access the secret "projects/otel/secrets/missing/versions/latest": projects/otel/secrets/missing/versions/latest: 404 Not Found: Secret [projects/otel/secrets/missing/versions/latest] not found or has no versions.`,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.uri, func(t *testing.T) {
+			_, err := p.Retrieve(context.Background(), tt.uri, nil)
+			assert.EqualError(t, err, tt.wantErr)
+		})
+	}
+}
+
+func TestRotation(t *testing.T) {
+	p, f := newTestProvider(t)
+	f.add("projects/otel/secrets/api-key", "v1")
+
+	changed := make(chan *confmap.ChangeEvent, 1)
+	ret, err := p.Retrieve(context.Background(), "googlesecretmanager:projects/otel/secrets/api-key", func(event *confmap.ChangeEvent) {
+		changed <- event
+	})
+	require.NoError(t, err)
+
+	select {
+	case <-changed:
+		t.Fatal("unexpected change of the secret")
+	case <-time.After(100 * time.Millisecond):
+	}
+
+	f.add("projects/otel/secrets/api-key", "v2")
+	select {
+	case event := <-changed:
+		assert.NoError(t, event.Error)
+	case <-time.After(5 * time.Second):
+		t.Fatal("the rotation of the secret wasn't detected")
+	}
+
+	assert.NoError(t, ret.Close(context.Background()))
+	assert.Empty(t, p.pollers)
+}
+
+func TestPinnedVersionNotPolled(t *testing.T) {
+	p, f := newTestProvider(t)
+	f.add("projects/otel/secrets/api-key", "v1")
+
+	ret, err := p.Retrieve(context.Background(), "googlesecretmanager:projects/otel/secrets/api-key/versions/1", func(*confmap.ChangeEvent) {
+		t.Error("unexpected change of a pinned version")
+	})
+	require.NoError(t, err)
+	assert.Empty(t, p.pollers)
+	assert.NoError(t, ret.Close(context.Background()))
+}
+
+func TestFactory(t *testing.T) {
+	p := NewFactory().Create(confmap.ProviderSettings{})
+	assert.Equal(t, schemeName, p.Scheme())
+	assert.NoError(t, p.Shutdown(context.Background()))
+}
@@ -7,17 +7,35 @@ import (
 	"context"
 	"fmt"
 	"strings"
+	"sync"
+	"time"
 
+	"github.com/aws/aws-sdk-go-v2/config"
 	"github.com/aws/aws-sdk-go-v2/service/secretsmanager"
 	"go.opentelemetry.io/collector/confmap"
+	"go.uber.org/zap"
 )
 
 const (
 	schemeName = "secretsmanager"
+
+	// defaultPollInterval is the interval at which the secrets are read again, to detect their rotation.
+	defaultPollInterval = 5 * time.Minute
 )
 
+type secretsManagerClient interface {
+	GetSecretValue(context.Context, *secretsmanager.GetSecretValueInput, ...func(*secretsmanager.Options)) (*secretsmanager.GetSecretValueOutput, error)
+}
+
 type provider struct {
-	client *secretsmanager.Client
+	client       secretsManagerClient
+	logger       *zap.Logger
+	pollInterval time.Duration
+
+	mu sync.Mutex
+	// pollers cancel the polling of the retrieved secrets.
+	pollers map[int]context.CancelFunc
+	nextID  int
 }
 
 // NewFactory returns a new confmap.ProviderFactory that creates a confmap.Provider
@@ -25,12 +43,24 @@ type provider struct {
 //
 // This Provider supports "secretsmanager" scheme, and can be called with a selector:
 // `secretsmanager:NAME_OR_ARN`
+//
+// The secrets are read again every 5 minutes, and the configuration is reloaded when a secret is rotated, which
+// restarts the components using it.
 func NewFactory() confmap.ProviderFactory {
 	return confmap.NewProviderFactory(newWithSettings)
 }
 
-func newWithSettings(_ confmap.ProviderSettings) confmap.Provider {
-	return &provider{client: nil}
+func newWithSettings(set confmap.ProviderSettings) confmap.Provider {
+	logger := set.Logger
+	if logger == nil {
+		logger = zap.NewNop()
+	}
+	return &provider{
+		client:       nil,
+		logger:       logger,
+		pollInterval: defaultPollInterval,
+		pollers:      map[int]context.CancelFunc{},
+	}
 }
 
 // New returns a new confmap.Provider that reads the configuration from the given AWS Secrets Manager Name or ARN.
@@ -40,14 +70,23 @@ func newWithSettings(_ confmap.ProviderSettings) confmap.Provider {
 //
 // Deprecated: [v0.100.0] Use NewFactory() instead.
 func New() confmap.Provider {
-	return &provider{}
+	return newWithSettings(confmap.ProviderSettings{})
 }
 
-func (provider *provider) Retrieve(ctx context.Context, uri string, _ confmap.WatcherFunc) (*confmap.Retrieved, error) {
+func (provider *provider) Retrieve(ctx context.Context, uri string, watcher confmap.WatcherFunc) (*confmap.Retrieved, error) {
 	if !strings.HasPrefix(uri, schemeName+":") {
 		return nil, fmt.Errorf("%q uri is not supported by %q provider", uri, schemeName)
 	}
 
+	// initialize the secrets manager client in the first call of Retrieve
+	if provider.client == nil {
+		cfg, err := config.LoadDefaultConfig(context.Background())
+		if err != nil {
+			return nil, fmt.Errorf("failed to load configurations to initialize an AWS SDK client, error: %w", err)
+		}
+		provider.client = secretsmanager.NewFromConfig(cfg)
+	}
+
 	secretArn := strings.Replace(uri, schemeName+":", "", 1)
 
 	input := &secretsmanager.GetSecretValueInput{
@@ -63,13 +102,69 @@ func (provider *provider) Retrieve(ctx context.Context, uri string, _ confmap.Wa
 		return nil, nil
 	}
 
-	return confmap.NewRetrieved(*response.SecretString)
+	if watcher == nil {
+		return confmap.NewRetrieved(*response.SecretString)
+	}
+	stop := provider.poll(secretArn, *response.SecretString, watcher)
+	return confmap.NewRetrieved(*response.SecretString, confmap.WithRetrievedClose(func(context.Context) error {
+		stop()
+		return nil
+	}))
+}
+
+// poll reads the secret again at the poll interval, and notifies the watcher once its value changed, e.g. after its
+// rotation. It returns the function stopping the polling.
+func (provider *provider) poll(secretArn string, value string, watcher confmap.WatcherFunc) func() {
+	ctx, cancel := context.WithCancel(context.Background())
+	provider.mu.Lock()
+	id := provider.nextID
+	provider.nextID++
+	provider.pollers[id] = cancel
+	provider.mu.Unlock()
+
+	logger := provider.logger.With(zap.String("secret", secretArn))
+	go func() {
+		ticker := time.NewTicker(provider.pollInterval)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+			}
+			response, err := provider.client.GetSecretValue(ctx, &secretsmanager.GetSecretValueInput{SecretId: &secretArn})
+			switch {
+			case ctx.Err() != nil:
+				return
+			case err != nil:
+				logger.Warn("Failed to read the secret again", zap.Error(err))
+			case response.SecretString != nil && *response.SecretString != value:
+				logger.Info("The secret changed, reloading the configuration")
+				watcher(&confmap.ChangeEvent{})
+				// the secret is read again by the new configuration
+				return
+			}
+		}
+	}()
+
+	return func() {
+		provider.mu.Lock()
+		defer provider.mu.Unlock()
+		cancel()
+		delete(provider.pollers, id)
+	}
 }
 
 func (*provider) Scheme() string {
 	return schemeName
 }
 
-func (*provider) Shutdown(context.Context) error {
+func (provider *provider) Shutdown(context.Context) error {
+	provider.mu.Lock()
+	defer provider.mu.Unlock()
+	for id, cancel := range provider.pollers {
+		cancel()
+		delete(provider.pollers, id)
+	}
 	return nil
 }
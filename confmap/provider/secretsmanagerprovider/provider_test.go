@@ -8,6 +8,7 @@ import (
 	"encoding/json"
 	"net/http"
 	"net/http/httptest"
+	"sync"
 	"testing"
 	"time"
 
@@ -17,6 +18,7 @@ import (
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 	"go.opentelemetry.io/collector/confmap"
+	"go.uber.org/zap"
 )
 
 type resolver struct {
@@ -75,6 +77,54 @@ func TestSecretsManagerFetchSecret(t *testing.T) {
 	assert.Equal(t, secretValue, value)
 }
 
+func TestSecretsManagerRotation(t *testing.T) {
+	var mu sync.Mutex
+	secretValue := "v1"
+	s := httptest.NewServer(http.HandlerFunc(func(writer http.ResponseWriter, _ *http.Request) {
+		mu.Lock()
+		defer mu.Unlock()
+		b, err := json.Marshal(map[string]any{"Name": "FOO", "SecretString": secretValue})
+		assert.NoError(t, err)
+		_, err = writer.Write(b)
+		assert.NoError(t, err)
+	}))
+	defer s.Close()
+
+	fp := NewTestProvider(s.URL).(*provider)
+	fp.logger = zap.NewNop()
+	fp.pollInterval = 10 * time.Millisecond
+	fp.pollers = map[int]context.CancelFunc{}
+	defer func() { assert.NoError(t, fp.Shutdown(context.Background())) }()
+
+	changed := make(chan *confmap.ChangeEvent, 1)
+	result, err := fp.Retrieve(context.Background(), "secretsmanager:FOO", func(event *confmap.ChangeEvent) {
+		changed <- event
+	})
+	require.NoError(t, err)
+	value, err := result.AsRaw()
+	require.NoError(t, err)
+	assert.Equal(t, "v1", value)
+
+	select {
+	case <-changed:
+		t.Fatal("unexpected change of the secret")
+	case <-time.After(100 * time.Millisecond):
+	}
+
+	mu.Lock()
+	secretValue = "v2"
+	mu.Unlock()
+	select {
+	case event := <-changed:
+		assert.NoError(t, event.Error)
+	case <-time.After(5 * time.Second):
+		t.Fatal("the rotation of the secret wasn't detected")
+	}
+
+	assert.NoError(t, result.Close(context.Background()))
+	assert.Empty(t, fp.pollers)
+}
+
 func TestFactory(t *testing.T) {
 	p := NewFactory().Create(confmap.ProviderSettings{})
 	_, ok := p.(*provider)
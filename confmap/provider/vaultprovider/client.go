@@ -0,0 +1,123 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package vaultprovider // import "github.com/open-telemetry/opentelemetry-collector-contrib/confmap/provider/vaultprovider"
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"strings"
+	"time"
+)
+
+const (
+	defaultAddr = "https://127.0.0.1:8200"
+
+	envAddr      = "VAULT_ADDR"
+	envToken     = "VAULT_TOKEN"
+	envNamespace = "VAULT_NAMESPACE"
+)
+
+// client is a minimal client of the Vault HTTP API, reading secrets and renewing their leases.
+type client struct {
+	addr       string
+	token      string
+	namespace  string
+	httpClient *http.Client
+}
+
+// newClientFromEnv returns a client configured with the environment variables of the Vault CLI.
+func newClientFromEnv() *client {
+	addr := os.Getenv(envAddr)
+	if addr == "" {
+		addr = defaultAddr
+	}
+	return &client{
+		addr:       strings.TrimSuffix(addr, "/"),
+		token:      os.Getenv(envToken),
+		namespace:  os.Getenv(envNamespace),
+		httpClient: &http.Client{Timeout: 30 * time.Second},
+	}
+}
+
+// secret is a secret returned by Vault, with its lease if any.
+type secret struct {
+	LeaseID       string         `json:"lease_id"`
+	LeaseDuration int            `json:"lease_duration"`
+	Renewable     bool           `json:"renewable"`
+	Data          map[string]any `json:"data"`
+}
+
+func (s *secret) leaseDuration() time.Duration {
+	return time.Duration(s.LeaseDuration) * time.Second
+}
+
+// values returns the key/value pairs of the secret, unwrapping the ones of the KV version 2 secrets engine.
+func (s *secret) values() map[string]any {
+	data, isMap := s.Data["data"].(map[string]any)
+	if _, hasMetadata := s.Data["metadata"]; isMap && hasMetadata {
+		return data
+	}
+	return s.Data
+}
+
+// read reads the secret at the given path, e.g. "secret/data/otel" or "database/creds/collector".
+func (c *client) read(ctx context.Context, path string) (*secret, error) {
+	return c.do(ctx, http.MethodGet, strings.TrimPrefix(path, "/"), nil)
+}
+
+// renew extends the lease of a secret by the given increment.
+func (c *client) renew(ctx context.Context, leaseID string, increment time.Duration) (*secret, error) {
+	body, err := json.Marshal(map[string]any{
+		"lease_id":  leaseID,
+		"increment": int(increment.Seconds()),
+	})
+	if err != nil {
+		return nil, err
+	}
+	return c.do(ctx, http.MethodPut, "sys/leases/renew", body)
+}
+
+func (c *client) do(ctx context.Context, method, path string, body []byte) (*secret, error) {
+	req, err := http.NewRequestWithContext(ctx, method, c.addr+"/v1/"+path, bytes.NewReader(body))
+	if err != nil {
+		return nil, err
+	}
+	if c.token != "" {
+		req.Header.Set("X-Vault-Token", c.token)
+	}
+	if c.namespace != "" {
+		req.Header.Set("X-Vault-Namespace", c.namespace)
+	}
+	if body != nil {
+		req.Header.Set("Content-Type", "application/json")
+	}
+
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		var errResp struct {
+			Errors []string `json:"errors"`
+		}
+		b, _ := io.ReadAll(resp.Body)
+		if json.Unmarshal(b, &errResp) == nil && len(errResp.Errors) > 0 {
+			return nil, fmt.Errorf("%s %s: %s: %s", method, path, resp.Status, strings.Join(errResp.Errors, ", "))
+		}
+		return nil, fmt.Errorf("%s %s: %s", method, path, resp.Status)
+	}
+
+	s := &secret{}
+	if err := json.NewDecoder(resp.Body).Decode(s); err != nil {
+		return nil, fmt.Errorf("%s %s: failed to decode the response: %w", method, path, err)
+	}
+	return s, nil
+}
@@ -0,0 +1,133 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package vaultprovider // import "github.com/open-telemetry/opentelemetry-collector-contrib/confmap/provider/vaultprovider"
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"sync"
+	"time"
+
+	"go.opentelemetry.io/collector/confmap"
+	"go.uber.org/zap"
+)
+
+const (
+	schemeName = "vault"
+
+	// defaultPollInterval is the interval at which the secrets without lease are read again, to detect their rotation.
+	defaultPollInterval = 5 * time.Minute
+	// retryInterval is the interval at which a failed renewal or read of a secret is retried.
+	retryInterval = 30 * time.Second
+)
+
+type provider struct {
+	client       *client
+	logger       *zap.Logger
+	pollInterval time.Duration
+
+	mu sync.Mutex
+	// watchers are the watched secrets by path, shared by the URIs reading the same secret so that they resolve
+	// to the same version of it, e.g. the username and the password of dynamic database credentials.
+	watchers map[string]*secretWatcher
+}
+
+// NewFactory returns a new confmap.ProviderFactory that creates a confmap.Provider
+// which reads configuration from HashiCorp Vault secrets.
+//
+// This Provider supports "vault" scheme, and can be called with a selector:
+// `vault:PATH#KEY`, e.g. `vault:secret/data/otel#api_key`. Without key, the provider returns all the key/value pairs
+// of the secret.
+//
+// The Vault server, the token and the namespace are configured with the VAULT_ADDR, VAULT_TOKEN and VAULT_NAMESPACE
+// environment variables. The leases of the secrets are renewed, and the configuration is reloaded when a secret
+// changes, which restarts the components using it.
+func NewFactory() confmap.ProviderFactory {
+	return confmap.NewProviderFactory(newWithSettings)
+}
+
+func newWithSettings(set confmap.ProviderSettings) confmap.Provider {
+	logger := set.Logger
+	if logger == nil {
+		logger = zap.NewNop()
+	}
+	return &provider{
+		client:       newClientFromEnv(),
+		logger:       logger,
+		pollInterval: defaultPollInterval,
+		watchers:     map[string]*secretWatcher{},
+	}
+}
+
+func (p *provider) Retrieve(ctx context.Context, uri string, watcher confmap.WatcherFunc) (*confmap.Retrieved, error) {
+	if !strings.HasPrefix(uri, schemeName+":") {
+		return nil, fmt.Errorf("%q uri is not supported by %q provider", uri, schemeName)
+	}
+	path, key, _ := strings.Cut(strings.TrimPrefix(uri, schemeName+":"), "#")
+	if path == "" {
+		return nil, fmt.Errorf("%q uri has no secret path", uri)
+	}
+
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	w, ok := p.watchers[path]
+	if !ok {
+		s, err := p.client.read(ctx, path)
+		if err != nil {
+			return nil, fmt.Errorf("failed to read the secret %q: %w", path, err)
+		}
+		w = newSecretWatcher(p.client, p.logger.With(zap.String("path", path)), path, s, p.pollInterval)
+		p.watchers[path] = w
+	}
+
+	value, err := secretValue(w.current(), key)
+	if err != nil {
+		p.release(path, w)
+		return nil, fmt.Errorf("%q: %w", uri, err)
+	}
+	w.watch(watcher)
+
+	return confmap.NewRetrieved(value, confmap.WithRetrievedClose(func(context.Context) error {
+		p.mu.Lock()
+		defer p.mu.Unlock()
+		p.release(path, w)
+		return nil
+	}))
+}
+
+// release stops watching the secret once it isn't used by any retrieved value anymore. p.mu must be held.
+func (p *provider) release(path string, w *secretWatcher) {
+	if w.release() && p.watchers[path] == w {
+		delete(p.watchers, path)
+	}
+}
+
+func (*provider) Scheme() string {
+	return schemeName
+}
+
+func (p *provider) Shutdown(context.Context) error {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	for path, w := range p.watchers {
+		w.stop()
+		delete(p.watchers, path)
+	}
+	return nil
+}
+
+// secretValue returns the value of the key of the secret, or all its key/value pairs without key.
+func secretValue(s *secret, key string) (any, error) {
+	values := s.values()
+	if key == "" {
+		return values, nil
+	}
+	value, ok := values[key]
+	if !ok {
+		return nil, fmt.Errorf("key %q not found in the secret", key)
+	}
+	return value, nil
+}
@@ -0,0 +1,259 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package vaultprovider
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/collector/confmap"
+	"go.opentelemetry.io/collector/confmap/confmaptest"
+	"go.uber.org/zap"
+)
+
+// fakeVault serves the secrets, and the renewal of their leases, like the Vault HTTP API.
+type fakeVault struct {
+	t *testing.T
+
+	mu      sync.Mutex
+	secrets map[string]*secret
+	// renewed is the lease duration returned by the renewals, 0 to fail them
+	renewed int
+
+	reads    atomic.Int32
+	renewals atomic.Int32
+}
+
+func newFakeVault(t *testing.T) *fakeVault {
+	return &fakeVault{t: t, secrets: map[string]*secret{}}
+}
+
+func (f *fakeVault) set(path string, s *secret) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.secrets[path] = s
+}
+
+func (f *fakeVault) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	assert.Equal(f.t, "token", r.Header.Get("X-Vault-Token"))
+	assert.Equal(f.t, "ns", r.Header.Get("X-Vault-Namespace"))
+
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	if r.Method == http.MethodPut && r.URL.Path == "/v1/sys/leases/renew" {
+		f.renewals.Add(1)
+		if f.renewed == 0 {
+			w.WriteHeader(http.StatusBadRequest)
+			_, _ = w.Write([]byte(`{"errors":["lease not found"]}`))
+			return
+		}
+		assert.NoError(f.t, json.NewEncoder(w).Encode(&secret{LeaseID: "lease", LeaseDuration: f.renewed, Renewable: true}))
+		return
+	}
+
+	f.reads.Add(1)
+	s, ok := f.secrets[r.URL.Path[len("/v1/"):]]
+	if !ok {
+		w.WriteHeader(http.StatusNotFound)
+		_, _ = w.Write([]byte(`{"errors":[]}`))
+		return
+	}
+	assert.NoError(f.t, json.NewEncoder(w).Encode(s))
+}
+
+func newTestProvider(t *testing.T, f *fakeVault) *provider {
+	s := httptest.NewServer(f)
+	t.Cleanup(s.Close)
+
+	p := &provider{
+		client:       &client{addr: s.URL, token: "token", namespace: "ns", httpClient: s.Client()},
+		logger:       zap.NewNop(),
+		pollInterval: time.Hour,
+		watchers:     map[string]*secretWatcher{},
+	}
+	t.Cleanup(func() {
+		assert.NoError(t, p.Shutdown(context.Background()))
+	})
+	return p
+}
+
+func kv2(values map[string]any) *secret {
+	return &secret{Data: map[string]any{
+		"data":     values,
+		"metadata": map[string]any{"version": 1},
+	}}
+}
+
+func TestRetrieve(t *testing.T) {
+	f := newFakeVault(t)
+	f.set("secret/data/otel", kv2(map[string]any{"api_key": "key", "endpoint": "localhost:4317"}))
+	f.set("kv/otel", &secret{LeaseDuration: 3600, Data: map[string]any{"api_key": "v1"}})
+	p := newTestProvider(t, f)
+
+	tests := []struct {
+		uri  string
+		want any
+	}{
+		{uri: "vault:secret/data/otel#api_key", want: "key"},
+		{uri: "vault:secret/data/otel", want: map[string]any{"api_key": "key", "endpoint": "localhost:4317"}},
+		{uri: "vault:kv/otel#api_key", want: "v1"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.uri, func(t *testing.T) {
+			ret, err := p.Retrieve(context.Background(), tt.uri, nil)
+			require.NoError(t, err)
+			value, err := ret.AsRaw()
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, value)
+			assert.NoError(t, ret.Close(context.Background()))
+		})
+	}
+	assert.Empty(t, p.watchers)
+}
+
+func TestRetrieveErrors(t *testing.T) {
+	f := newFakeVault(t)
+	f.set("secret/data/otel", kv2(map[string]any{"api_key": "key"}))
+	p := newTestProvider(t, f)
+
+	_, err := p.Retrieve(context.Background(), "file:secret/data/otel", nil)
+	assert.EqualError(t, err, `"file:secret/data/otel" uri is not supported by "vault" provider`)
+
+	_, err = p.Retrieve(context.Background(), "vault:#api_key", nil)
+	assert.EqualError(t, err, `"vault:#api_key" uri has no secret path`)
+
+	_, err = p.Retrieve(context.Background(), "vault:secret/data/otel#password", nil)
+	assert.EqualError(t, err, `"vault:secret/data/otel#password": key "password" not found in the secret`)
+
+	_, err = p.Retrieve(context.Background(), "vault:secret/data/missing", nil)
+	assert.ErrorContains(t, err, `failed to read the secret "secret/data/missing": GET secret/data/missing: 404 Not Found`)
+
+	assert.Empty(t, p.watchers)
+}
+
+func TestRetrieveSharedSecret(t *testing.T) {
+	f := newFakeVault(t)
+	f.set("database/creds/collector", &secret{
+		LeaseID:       "lease",
+		LeaseDuration: 3600,
+		Renewable:     true,
+		Data:          map[string]any{"username": "user", "password": "pass"},
+	})
+	p := newTestProvider(t, f)
+
+	username, err := p.Retrieve(context.Background(), "vault:database/creds/collector#username", nil)
+	require.NoError(t, err)
+	password, err := p.Retrieve(context.Background(), "vault:database/creds/collector#password", nil)
+	require.NoError(t, err)
+
+	// the dynamic credentials are read once, for the username and the password to match
+	assert.Equal(t, int32(1), f.reads.Load())
+
+	require.NoError(t, username.Close(context.Background()))
+	assert.Len(t, p.watchers, 1)
+	require.NoError(t, password.Close(context.Background()))
+	assert.Empty(t, p.watchers)
+}
+
+func TestRotation(t *testing.T) {
+	f := newFakeVault(t)
+	f.set("secret/data/otel", kv2(map[string]any{"api_key": "key"}))
+	p := newTestProvider(t, f)
+	p.pollInterval = 10 * time.Millisecond
+
+	changed := make(chan *confmap.ChangeEvent, 1)
+	ret, err := p.Retrieve(context.Background(), "vault:secret/data/otel#api_key", func(event *confmap.ChangeEvent) {
+		changed <- event
+	})
+	require.NoError(t, err)
+	defer func() {
+		assert.NoError(t, ret.Close(context.Background()))
+	}()
+
+	// unchanged secrets don't reload the configuration
+	require.Eventually(t, func() bool { return f.reads.Load() > 2 }, 5*time.Second, 10*time.Millisecond)
+	assert.Empty(t, changed)
+
+	f.set("secret/data/otel", kv2(map[string]any{"api_key": "rotated"}))
+	select {
+	case event := <-changed:
+		assert.NoError(t, event.Error)
+	case <-time.After(5 * time.Second):
+		t.Fatal("the rotation of the secret wasn't notified")
+	}
+}
+
+func TestLeaseRenewal(t *testing.T) {
+	f := newFakeVault(t)
+	f.set("database/creds/collector", &secret{
+		LeaseID:       "lease",
+		LeaseDuration: 1,
+		Renewable:     true,
+		Data:          map[string]any{"username": "user-1"},
+	})
+	f.renewed = 1
+	p := newTestProvider(t, f)
+
+	changed := make(chan *confmap.ChangeEvent, 1)
+	ret, err := p.Retrieve(context.Background(), "vault:database/creds/collector#username", func(event *confmap.ChangeEvent) {
+		changed <- event
+	})
+	require.NoError(t, err)
+	defer func() {
+		assert.NoError(t, ret.Close(context.Background()))
+	}()
+
+	require.Eventually(t, func() bool { return f.renewals.Load() > 0 }, 5*time.Second, 10*time.Millisecond)
+	assert.Empty(t, changed)
+	assert.Equal(t, int32(1), f.reads.Load())
+
+	// once the lease can't be renewed anymore, new credentials are read, and the configuration is reloaded
+	f.mu.Lock()
+	f.renewed = 0
+	f.secrets["database/creds/collector"] = &secret{
+		LeaseID:       "lease-2",
+		LeaseDuration: 1,
+		Renewable:     true,
+		Data:          map[string]any{"username": "user-2"},
+	}
+	f.mu.Unlock()
+	select {
+	case event := <-changed:
+		assert.NoError(t, event.Error)
+	case <-time.After(5 * time.Second):
+		t.Fatal("the new credentials weren't notified")
+	}
+	assert.Equal(t, int32(2), f.reads.Load())
+}
+
+func TestClientFromEnv(t *testing.T) {
+	t.Setenv(envAddr, "http://vault:8200/")
+	t.Setenv(envToken, "token")
+	t.Setenv(envNamespace, "ns")
+
+	c := newClientFromEnv()
+	assert.Equal(t, "http://vault:8200", c.addr)
+	assert.Equal(t, "token", c.token)
+	assert.Equal(t, "ns", c.namespace)
+
+	t.Setenv(envAddr, "")
+	assert.Equal(t, defaultAddr, newClientFromEnv().addr)
+}
+
+func TestFactory(t *testing.T) {
+	p := NewFactory().Create(confmap.ProviderSettings{})
+	_, ok := p.(*provider)
+	require.True(t, ok)
+	assert.NoError(t, confmaptest.ValidateProviderScheme(p))
+	assert.NoError(t, p.Shutdown(context.Background()))
+}
@@ -0,0 +1,145 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package vaultprovider // import "github.com/open-telemetry/opentelemetry-collector-contrib/confmap/provider/vaultprovider"
+
+import (
+	"context"
+	"reflect"
+	"sync"
+	"time"
+
+	"go.opentelemetry.io/collector/confmap"
+	"go.uber.org/zap"
+)
+
+// secretWatcher renews the lease of a secret, and reads it again when the lease can't be renewed anymore or, for
+// the secrets without lease, at the poll interval. The configuration is reloaded when the secret changes.
+type secretWatcher struct {
+	client       *client
+	logger       *zap.Logger
+	path         string
+	pollInterval time.Duration
+	cancel       context.CancelFunc
+
+	mu       sync.Mutex
+	secret   *secret
+	onChange confmap.WatcherFunc
+	refs     int
+}
+
+func newSecretWatcher(c *client, logger *zap.Logger, path string, s *secret, pollInterval time.Duration) *secretWatcher {
+	ctx, cancel := context.WithCancel(context.Background())
+	w := &secretWatcher{
+		client:       c,
+		logger:       logger,
+		path:         path,
+		pollInterval: pollInterval,
+		cancel:       cancel,
+		secret:       s,
+	}
+	go w.run(ctx)
+	return w
+}
+
+func (w *secretWatcher) current() *secret {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	return w.secret
+}
+
+// watch adds a reference to the secret, notifying onChange, if not nil, of its changes.
+func (w *secretWatcher) watch(onChange confmap.WatcherFunc) {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	w.refs++
+	if onChange != nil {
+		w.onChange = onChange
+	}
+}
+
+// release removes a reference to the secret, and stops watching it after the last one. It returns whether the
+// watcher is stopped.
+func (w *secretWatcher) release() bool {
+	w.mu.Lock()
+	w.refs--
+	last := w.refs <= 0
+	w.mu.Unlock()
+	if last {
+		w.stop()
+	}
+	return last
+}
+
+func (w *secretWatcher) stop() {
+	w.cancel()
+}
+
+func (w *secretWatcher) run(ctx context.Context) {
+	delay := w.refreshDelay(w.current())
+	for {
+		timer := time.NewTimer(delay)
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return
+		case <-timer.C:
+		}
+
+		changed, err := w.refresh(ctx)
+		switch {
+		case ctx.Err() != nil:
+			return
+		case err != nil:
+			w.logger.Warn("Failed to refresh the secret, retrying", zap.Error(err), zap.Duration("retry_interval", retryInterval))
+			delay = retryInterval
+		case changed:
+			w.logger.Info("The secret changed, reloading the configuration")
+			w.mu.Lock()
+			onChange := w.onChange
+			w.mu.Unlock()
+			if onChange != nil {
+				onChange(&confmap.ChangeEvent{})
+			}
+			// the secret is read again by the new configuration
+			return
+		default:
+			delay = w.refreshDelay(w.current())
+		}
+	}
+}
+
+// refreshDelay returns the delay before the next refresh of the secret: before the expiration of its lease, and
+// at most the poll interval.
+func (w *secretWatcher) refreshDelay(s *secret) time.Duration {
+	delay := w.pollInterval
+	if lease := s.leaseDuration() * 2 / 3; lease > 0 && lease < delay {
+		delay = lease
+	}
+	return delay
+}
+
+// refresh renews the lease of the secret or reads it again, and returns whether its value changed.
+func (w *secretWatcher) refresh(ctx context.Context) (bool, error) {
+	s := w.current()
+	if s.Renewable && s.LeaseID != "" {
+		renewed, err := w.client.renew(ctx, s.LeaseID, s.leaseDuration())
+		if err == nil && renewed.LeaseDuration >= s.LeaseDuration {
+			w.logger.Debug("Renewed the lease of the secret", zap.Duration("lease_duration", renewed.leaseDuration()))
+			return false, nil
+		}
+		// the lease reached its max TTL or can't be renewed anymore: a new secret is needed
+		if err != nil {
+			w.logger.Debug("Failed to renew the lease of the secret, reading it again", zap.Error(err))
+		}
+	}
+
+	latest, err := w.client.read(ctx, w.path)
+	if err != nil {
+		return false, err
+	}
+	w.mu.Lock()
+	w.secret = latest
+	w.mu.Unlock()
+	return !reflect.DeepEqual(s.values(), latest.values()), nil
+}
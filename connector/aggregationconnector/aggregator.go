@@ -0,0 +1,276 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package aggregationconnector // import "github.com/open-telemetry/opentelemetry-collector-contrib/connector/aggregationconnector"
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math"
+	"sort"
+	"strconv"
+	"time"
+
+	"go.opentelemetry.io/collector/pdata/pcommon"
+	"go.opentelemetry.io/collector/pdata/pmetric"
+	"go.uber.org/zap"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/internal/filter/expr"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl/contexts/ottllog"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/pdatautil"
+)
+
+var noAttributes = [16]byte{}
+
+type metricDef struct {
+	name      string
+	desc      string
+	unit      string
+	typ       MetricType
+	buckets   []float64
+	condition expr.BoolExpr[ottllog.TransformContext]
+	value     *ottl.ValueExpression[ottllog.TransformContext]
+	attrs     []attributeDef
+}
+
+type attributeDef struct {
+	key          string
+	value        *ottl.ValueExpression[ottllog.TransformContext]
+	defaultValue any
+}
+
+// aggregator aggregates the values of the log records of a resource into data points.
+type aggregator struct {
+	logger     *zap.Logger
+	metricDefs []metricDef
+	points     map[string]map[[16]byte]*dataPoint
+	timestamp  time.Time
+}
+
+type dataPoint struct {
+	attrs pcommon.Map
+
+	// isDouble is set once a value isn't an integer, and the data point reported as a double.
+	isDouble bool
+	// value is the sum of the values for sums, and the latest value for gauges.
+	value     float64
+	timestamp pcommon.Timestamp
+
+	count        uint64
+	min          float64
+	max          float64
+	bucketCounts []uint64
+}
+
+func newAggregator(logger *zap.Logger, metricDefs []metricDef) *aggregator {
+	return &aggregator{
+		logger:     logger,
+		metricDefs: metricDefs,
+		points:     make(map[string]map[[16]byte]*dataPoint, len(metricDefs)),
+		timestamp:  time.Now(),
+	}
+}
+
+func (a *aggregator) update(ctx context.Context, tCtx ottllog.TransformContext) error {
+	timestamp := tCtx.GetLogRecord().Timestamp()
+	if timestamp == 0 {
+		timestamp = tCtx.GetLogRecord().ObservedTimestamp()
+	}
+
+	var multiError error
+	for _, md := range a.metricDefs {
+		if md.condition != nil {
+			match, err := md.condition.Eval(ctx, tCtx)
+			if err != nil {
+				multiError = errors.Join(multiError, err)
+				continue
+			}
+			if !match {
+				continue
+			}
+		}
+
+		raw, err := md.value.Eval(ctx, tCtx)
+		if err != nil {
+			multiError = errors.Join(multiError, fmt.Errorf("metric %q: %w", md.name, err))
+			continue
+		}
+		if raw == nil {
+			continue
+		}
+		value, isInt, err := toNumber(raw)
+		if err != nil {
+			a.logger.Debug("Ignoring the log record value", zap.String("metric", md.name), zap.Error(err))
+			continue
+		}
+
+		attrs, ok, err := md.evalAttributes(ctx, tCtx)
+		if err != nil {
+			multiError = errors.Join(multiError, fmt.Errorf("metric %q: %w", md.name, err))
+			continue
+		}
+		// Missing necessary attributes to be aggregated
+		if !ok {
+			continue
+		}
+		a.record(md, attrs, value, isInt, timestamp)
+	}
+	return multiError
+}
+
+// evalAttributes returns the dimensions of the data point of a log record, and false when one of them is missing.
+func (md *metricDef) evalAttributes(ctx context.Context, tCtx ottllog.TransformContext) (pcommon.Map, bool, error) {
+	attrs := pcommon.NewMap()
+	attrs.EnsureCapacity(len(md.attrs))
+	for _, attr := range md.attrs {
+		val, err := attr.value.Eval(ctx, tCtx)
+		if err != nil {
+			return attrs, false, fmt.Errorf("attribute %q: %w", attr.key, err)
+		}
+		if val == nil {
+			val = attr.defaultValue
+		}
+		if val == nil {
+			return attrs, false, nil
+		}
+		if err = putAttribute(attrs, attr.key, val); err != nil {
+			return attrs, false, fmt.Errorf("attribute %q: %w", attr.key, err)
+		}
+	}
+	return attrs, true, nil
+}
+
+func (a *aggregator) record(md metricDef, attrs pcommon.Map, value float64, isInt bool, timestamp pcommon.Timestamp) {
+	if _, ok := a.points[md.name]; !ok {
+		a.points[md.name] = make(map[[16]byte]*dataPoint)
+	}
+
+	key := noAttributes
+	if attrs.Len() > 0 {
+		key = pdatautil.MapHash(attrs)
+	}
+
+	dp, ok := a.points[md.name][key]
+	if !ok {
+		dp = &dataPoint{attrs: attrs, min: math.Inf(1), max: math.Inf(-1)}
+		if md.typ == MetricTypeHistogram {
+			dp.bucketCounts = make([]uint64, len(md.buckets)+1)
+		}
+		a.points[md.name][key] = dp
+	}
+	dp.isDouble = dp.isDouble || !isInt
+
+	switch md.typ {
+	case MetricTypeSum:
+		dp.value += value
+	case MetricTypeGauge:
+		// Keep the value of the latest log record
+		if dp.count == 0 || timestamp >= dp.timestamp {
+			dp.value = value
+			dp.timestamp = timestamp
+		}
+	case MetricTypeHistogram:
+		dp.value += value
+		dp.min = math.Min(dp.min, value)
+		dp.max = math.Max(dp.max, value)
+		// The upper bound of a bucket is inclusive
+		dp.bucketCounts[sort.SearchFloat64s(md.buckets, value)]++
+	}
+	dp.count++
+}
+
+func (a *aggregator) appendMetricsTo(metricSlice pmetric.MetricSlice) {
+	timestamp := pcommon.NewTimestampFromTime(a.timestamp)
+	for _, md := range a.metricDefs {
+		if len(a.points[md.name]) == 0 {
+			continue
+		}
+		metric := metricSlice.AppendEmpty()
+		metric.SetName(md.name)
+		metric.SetDescription(md.desc)
+		metric.SetUnit(md.unit)
+
+		switch md.typ {
+		case MetricTypeSum:
+			sum := metric.SetEmptySum()
+			sum.SetAggregationTemporality(pmetric.AggregationTemporalityDelta)
+			for _, point := range a.points[md.name] {
+				dp := sum.DataPoints().AppendEmpty()
+				point.attrs.CopyTo(dp.Attributes())
+				point.setValue(dp)
+				dp.SetTimestamp(timestamp)
+			}
+		case MetricTypeGauge:
+			gauge := metric.SetEmptyGauge()
+			for _, point := range a.points[md.name] {
+				dp := gauge.DataPoints().AppendEmpty()
+				point.attrs.CopyTo(dp.Attributes())
+				point.setValue(dp)
+				if point.timestamp != 0 {
+					dp.SetTimestamp(point.timestamp)
+				} else {
+					dp.SetTimestamp(timestamp)
+				}
+			}
+		case MetricTypeHistogram:
+			histogram := metric.SetEmptyHistogram()
+			histogram.SetAggregationTemporality(pmetric.AggregationTemporalityDelta)
+			for _, point := range a.points[md.name] {
+				dp := histogram.DataPoints().AppendEmpty()
+				point.attrs.CopyTo(dp.Attributes())
+				dp.SetCount(point.count)
+				dp.SetSum(point.value)
+				dp.SetMin(point.min)
+				dp.SetMax(point.max)
+				dp.ExplicitBounds().FromRaw(md.buckets)
+				dp.BucketCounts().FromRaw(point.bucketCounts)
+				dp.SetTimestamp(timestamp)
+			}
+		}
+	}
+}
+
+func (dp *dataPoint) setValue(ndp pmetric.NumberDataPoint) {
+	if dp.isDouble {
+		ndp.SetDoubleValue(dp.value)
+		return
+	}
+	ndp.SetIntValue(int64(dp.value))
+}
+
+// toNumber converts the value of a log record to a number, telling whether it is an integer.
+func toNumber(raw any) (float64, bool, error) {
+	switch v := raw.(type) {
+	case int64:
+		return float64(v), true, nil
+	case float64:
+		return v, false, nil
+	case string:
+		if i, err := strconv.ParseInt(v, 10, 64); err == nil {
+			return float64(i), true, nil
+		}
+		f, err := strconv.ParseFloat(v, 64)
+		if err != nil {
+			return 0, false, fmt.Errorf("invalid number %q", v)
+		}
+		return f, false, nil
+	default:
+		return 0, false, fmt.Errorf("unsupported value type %T", raw)
+	}
+}
+
+func putAttribute(attrs pcommon.Map, key string, val any) error {
+	switch v := val.(type) {
+	case pcommon.Value:
+		v.CopyTo(attrs.PutEmpty(key))
+	case pcommon.Map:
+		v.CopyTo(attrs.PutEmptyMap(key))
+	case pcommon.Slice:
+		v.CopyTo(attrs.PutEmptySlice(key))
+	default:
+		return attrs.PutEmpty(key).FromRaw(v)
+	}
+	return nil
+}
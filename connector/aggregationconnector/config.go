@@ -0,0 +1,142 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package aggregationconnector // import "github.com/open-telemetry/opentelemetry-collector-contrib/connector/aggregationconnector"
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+
+	"go.opentelemetry.io/collector/component"
+	"go.uber.org/zap"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/internal/filter/filterottl"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl/contexts/ottllog"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl/ottlfuncs"
+)
+
+// MetricType is the type of the metric derived from the log records.
+type MetricType string
+
+const (
+	// MetricTypeSum adds up the values of the log records.
+	MetricTypeSum MetricType = "sum"
+	// MetricTypeGauge reports the value of the latest log record.
+	MetricTypeGauge MetricType = "gauge"
+	// MetricTypeHistogram reports the distribution of the values of the log records.
+	MetricTypeHistogram MetricType = "histogram"
+)
+
+// defaultHistogramBuckets are the default explicit bucket boundaries of the OpenTelemetry SDKs.
+var defaultHistogramBuckets = []float64{0, 5, 10, 25, 50, 75, 100, 250, 500, 750, 1000, 2500, 5000, 7500, 10000}
+
+// Config for the connector
+type Config struct {
+	// Logs are the metrics derived from the log records.
+	Logs []MetricInfo `mapstructure:"logs"`
+}
+
+// MetricInfo defines a metric derived from the log records.
+type MetricInfo struct {
+	Name        string     `mapstructure:"name"`
+	Description string     `mapstructure:"description"`
+	Unit        string     `mapstructure:"unit"`
+	Type        MetricType `mapstructure:"type"`
+	// Value is the OTTL expression of the value of the log record, e.g. `attributes["duration_ms"]`.
+	// Integers, doubles, and strings holding a number are supported; log records without value are ignored.
+	Value string `mapstructure:"value"`
+	// Conditions are the OTTL conditions the log records must match, any of them, to be aggregated.
+	Conditions []string `mapstructure:"conditions"`
+	// Attributes are the dimensions the values are aggregated by.
+	Attributes []AttributeConfig `mapstructure:"attributes"`
+	// Buckets are the explicit bucket boundaries of the histogram, in increasing order.
+	Buckets []float64 `mapstructure:"buckets"`
+}
+
+// AttributeConfig defines a dimension of a metric.
+type AttributeConfig struct {
+	Key string `mapstructure:"key"`
+	// Value is the OTTL expression of the dimension value. Defaults to the log record attribute named after the key.
+	Value string `mapstructure:"value"`
+	// DefaultValue is the value of the dimension for the log records without value. When not set, the log records
+	// without value are ignored.
+	DefaultValue any `mapstructure:"default_value"`
+}
+
+// Validate checks if the connector configuration is valid
+func (c *Config) Validate() error {
+	names := make(map[string]struct{}, len(c.Logs))
+	for i, info := range c.Logs {
+		if info.Name == "" {
+			return fmt.Errorf("logs: metric %d: name missing", i)
+		}
+		if _, ok := names[info.Name]; ok {
+			return fmt.Errorf("logs: metric %q: duplicate name", info.Name)
+		}
+		names[info.Name] = struct{}{}
+		if err := info.validate(); err != nil {
+			return fmt.Errorf("logs: metric %q: %w", info.Name, err)
+		}
+	}
+	return nil
+}
+
+func (i *MetricInfo) validate() error {
+	switch i.Type {
+	case MetricTypeSum, MetricTypeGauge:
+		if len(i.Buckets) > 0 {
+			return fmt.Errorf("buckets not supported for type %q", i.Type)
+		}
+	case MetricTypeHistogram:
+		for j := 1; j < len(i.Buckets); j++ {
+			if i.Buckets[j] <= i.Buckets[j-1] {
+				return errors.New("buckets must be in increasing order")
+			}
+		}
+	default:
+		return fmt.Errorf("unsupported type %q, must be one of %q, %q, or %q", i.Type, MetricTypeSum, MetricTypeGauge, MetricTypeHistogram)
+	}
+	if i.Value == "" {
+		return errors.New("value missing")
+	}
+
+	settings := component.TelemetrySettings{Logger: zap.NewNop()}
+	if _, err := filterottl.NewBoolExprForLog(i.Conditions, filterottl.StandardLogFuncs(), ottl.PropagateError, settings); err != nil {
+		return fmt.Errorf("condition: %w", err)
+	}
+	parser, err := newValueParser(settings)
+	if err != nil {
+		return err
+	}
+	if _, err = parser.ParseValueExpression(i.Value); err != nil {
+		return fmt.Errorf("value: %w", err)
+	}
+	keys := make(map[string]struct{}, len(i.Attributes))
+	for _, attr := range i.Attributes {
+		if attr.Key == "" {
+			return errors.New("attribute key missing")
+		}
+		if _, ok := keys[attr.Key]; ok {
+			return fmt.Errorf("attribute %q: duplicate key", attr.Key)
+		}
+		keys[attr.Key] = struct{}{}
+		if _, err = parser.ParseValueExpression(attr.valueExpression()); err != nil {
+			return fmt.Errorf("attribute %q: %w", attr.Key, err)
+		}
+	}
+	return nil
+}
+
+// valueExpression returns the OTTL expression of the dimension value.
+func (a *AttributeConfig) valueExpression() string {
+	if a.Value != "" {
+		return a.Value
+	}
+	return "attributes[" + strconv.Quote(a.Key) + "]"
+}
+
+func newValueParser(settings component.TelemetrySettings) (ottl.Parser[ottllog.TransformContext], error) {
+	return ottllog.NewParser(ottlfuncs.StandardConverters[ottllog.TransformContext](), settings)
+}
@@ -0,0 +1,147 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package aggregationconnector
+
+import (
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/collector/component"
+	"go.opentelemetry.io/collector/confmap/confmaptest"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/connector/aggregationconnector/internal/metadata"
+)
+
+func TestLoadConfig(t *testing.T) {
+	testCases := []struct {
+		name   string
+		expect *Config
+	}{
+		{
+			name:   "",
+			expect: &Config{},
+		},
+		{
+			name: "full",
+			expect: &Config{
+				Logs: []MetricInfo{
+					{
+						Name:        "http.server.request.duration",
+						Description: "The duration of the requests.",
+						Unit:        "ms",
+						Type:        MetricTypeHistogram,
+						Buckets:     []float64{10, 100, 1000},
+						Value:       `attributes["duration_ms"]`,
+						Conditions:  []string{`attributes["duration_ms"] != nil`},
+						Attributes: []AttributeConfig{
+							{Key: "http.route"},
+							{Key: "status_class", Value: `Substring(attributes["status"], 0, 1)`, DefaultValue: "unknown"},
+						},
+					},
+					{
+						Name:  "http.server.response.size",
+						Type:  MetricTypeSum,
+						Value: `attributes["bytes"]`,
+					},
+					{
+						Name:  "queue.depth",
+						Type:  MetricTypeGauge,
+						Value: `ExtractPatterns(body, "depth=(?P<depth>\\d+)")["depth"]`,
+					},
+				},
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			cm, err := confmaptest.LoadConf(filepath.Join("testdata", "config.yaml"))
+			require.NoError(t, err)
+
+			factory := NewFactory()
+			cfg := factory.CreateDefaultConfig()
+
+			sub, err := cm.Sub(component.NewIDWithName(metadata.Type, tc.name).String())
+			require.NoError(t, err)
+			require.NoError(t, sub.Unmarshal(cfg))
+
+			assert.NoError(t, component.ValidateConfig(cfg))
+			assert.Equal(t, tc.expect, cfg)
+		})
+	}
+}
+
+func TestConfigErrors(t *testing.T) {
+	testCases := []struct {
+		name   string
+		input  MetricInfo
+		expect string
+	}{
+		{
+			name:   "missing_name",
+			input:  MetricInfo{Type: MetricTypeSum, Value: `attributes["bytes"]`},
+			expect: "logs: metric 0: name missing",
+		},
+		{
+			name:   "invalid_type",
+			input:  MetricInfo{Name: "bytes", Type: "counter", Value: `attributes["bytes"]`},
+			expect: `logs: metric "bytes": unsupported type "counter", must be one of "sum", "gauge", or "histogram"`,
+		},
+		{
+			name:   "missing_value",
+			input:  MetricInfo{Name: "bytes", Type: MetricTypeSum},
+			expect: `logs: metric "bytes": value missing`,
+		},
+		{
+			name:   "invalid_value",
+			input:  MetricInfo{Name: "bytes", Type: MetricTypeSum, Value: `attributes[`},
+			expect: `logs: metric "bytes": value: expression has invalid syntax`,
+		},
+		{
+			name:   "invalid_condition",
+			input:  MetricInfo{Name: "bytes", Type: MetricTypeSum, Value: `attributes["bytes"]`, Conditions: []string{"invalid condition"}},
+			expect: `logs: metric "bytes": condition: unable to parse OTTL condition`,
+		},
+		{
+			name:   "buckets_on_sum",
+			input:  MetricInfo{Name: "bytes", Type: MetricTypeSum, Value: `attributes["bytes"]`, Buckets: []float64{1, 2}},
+			expect: `logs: metric "bytes": buckets not supported for type "sum"`,
+		},
+		{
+			name:   "unsorted_buckets",
+			input:  MetricInfo{Name: "duration", Type: MetricTypeHistogram, Value: `attributes["duration"]`, Buckets: []float64{10, 1}},
+			expect: `logs: metric "duration": buckets must be in increasing order`,
+		},
+		{
+			name:   "missing_attribute_key",
+			input:  MetricInfo{Name: "bytes", Type: MetricTypeSum, Value: `attributes["bytes"]`, Attributes: []AttributeConfig{{Value: `attributes["route"]`}}},
+			expect: `logs: metric "bytes": attribute key missing`,
+		},
+		{
+			name:   "duplicate_attribute_key",
+			input:  MetricInfo{Name: "bytes", Type: MetricTypeSum, Value: `attributes["bytes"]`, Attributes: []AttributeConfig{{Key: "route"}, {Key: "route"}}},
+			expect: `logs: metric "bytes": attribute "route": duplicate key`,
+		},
+		{
+			name:   "invalid_attribute_value",
+			input:  MetricInfo{Name: "bytes", Type: MetricTypeSum, Value: `attributes["bytes"]`, Attributes: []AttributeConfig{{Key: "route", Value: "Unknown()"}}},
+			expect: `logs: metric "bytes": attribute "route": undefined function "Unknown"`,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			cfg := &Config{Logs: []MetricInfo{tc.input}}
+			assert.ErrorContains(t, cfg.Validate(), tc.expect)
+		})
+	}
+
+	cfg := &Config{Logs: []MetricInfo{
+		{Name: "bytes", Type: MetricTypeSum, Value: `attributes["bytes"]`},
+		{Name: "bytes", Type: MetricTypeGauge, Value: `attributes["bytes"]`},
+	}}
+	assert.EqualError(t, cfg.Validate(), `logs: metric "bytes": duplicate name`)
+}
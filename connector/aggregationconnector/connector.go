@@ -0,0 +1,71 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package aggregationconnector // import "github.com/open-telemetry/opentelemetry-collector-contrib/connector/aggregationconnector"
+
+import (
+	"context"
+	"errors"
+
+	"go.opentelemetry.io/collector/component"
+	"go.opentelemetry.io/collector/consumer"
+	"go.opentelemetry.io/collector/pdata/plog"
+	"go.opentelemetry.io/collector/pdata/pmetric"
+	"go.uber.org/zap"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl/contexts/ottllog"
+)
+
+const scopeName = "otelcol/aggregationconnector"
+
+// aggregation derives metrics from the values of log records
+// and emits them onto a metrics pipeline.
+type aggregation struct {
+	logger          *zap.Logger
+	metricsConsumer consumer.Metrics
+	component.StartFunc
+	component.ShutdownFunc
+
+	metricDefs []metricDef
+}
+
+func (c *aggregation) Capabilities() consumer.Capabilities {
+	return consumer.Capabilities{MutatesData: false}
+}
+
+func (c *aggregation) ConsumeLogs(ctx context.Context, ld plog.Logs) error {
+	var multiError error
+	aggregatedMetrics := pmetric.NewMetrics()
+	aggregatedMetrics.ResourceMetrics().EnsureCapacity(ld.ResourceLogs().Len())
+	for i := 0; i < ld.ResourceLogs().Len(); i++ {
+		resourceLog := ld.ResourceLogs().At(i)
+		agg := newAggregator(c.logger, c.metricDefs)
+
+		for j := 0; j < resourceLog.ScopeLogs().Len(); j++ {
+			scopeLogs := resourceLog.ScopeLogs().At(j)
+
+			for k := 0; k < scopeLogs.LogRecords().Len(); k++ {
+				logRecord := scopeLogs.LogRecords().At(k)
+
+				lCtx := ottllog.NewTransformContext(logRecord, scopeLogs.Scope(), resourceLog.Resource())
+				multiError = errors.Join(multiError, agg.update(ctx, lCtx))
+			}
+		}
+
+		if len(agg.points) == 0 {
+			continue // don't add an empty resource
+		}
+
+		aggregatedResource := aggregatedMetrics.ResourceMetrics().AppendEmpty()
+		resourceLog.Resource().Attributes().CopyTo(aggregatedResource.Resource().Attributes())
+
+		aggregatedScope := aggregatedResource.ScopeMetrics().AppendEmpty()
+		aggregatedScope.Scope().SetName(scopeName)
+
+		agg.appendMetricsTo(aggregatedScope.Metrics())
+	}
+	if multiError != nil {
+		return multiError
+	}
+	return c.metricsConsumer.ConsumeMetrics(ctx, aggregatedMetrics)
+}
@@ -0,0 +1,208 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package aggregationconnector
+
+import (
+	"context"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/collector/component/componenttest"
+	"go.opentelemetry.io/collector/connector/connectortest"
+	"go.opentelemetry.io/collector/consumer/consumertest"
+	"go.opentelemetry.io/collector/pdata/pcommon"
+	"go.opentelemetry.io/collector/pdata/plog"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/golden"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/pdatatest/pmetrictest"
+)
+
+var testTime = time.Date(2024, 6, 1, 12, 0, 0, 0, time.UTC)
+
+// buildTestLogs builds access logs of two services, and a log of a queue depth.
+func buildTestLogs() plog.Logs {
+	ld := plog.NewLogs()
+	for _, service := range []string{"checkout", "cart"} {
+		rl := ld.ResourceLogs().AppendEmpty()
+		rl.Resource().Attributes().PutStr("service.name", service)
+		lrs := rl.ScopeLogs().AppendEmpty().LogRecords()
+		for i, access := range []struct {
+			route    string
+			status   string
+			duration any
+			bytes    int64
+		}{
+			{route: "/pay", status: "200", duration: int64(5), bytes: 100},
+			{route: "/pay", status: "200", duration: 50.5, bytes: 200},
+			{route: "/pay", status: "500", duration: "2000", bytes: 10},
+			{route: "/pay", duration: int64(100), bytes: 20},
+			{route: "/items", status: "200", duration: "not a number", bytes: 1},
+		} {
+			lr := lrs.AppendEmpty()
+			lr.SetTimestamp(pcommon.NewTimestampFromTime(testTime.Add(time.Duration(i) * time.Second)))
+			lr.Body().SetStr("request served")
+			lr.Attributes().PutStr("http.route", access.route)
+			if access.status != "" {
+				lr.Attributes().PutStr("status", access.status)
+			}
+			_ = lr.Attributes().PutEmpty("duration_ms").FromRaw(access.duration)
+			lr.Attributes().PutInt("bytes", access.bytes)
+		}
+		for i, depth := range []string{"7", "3"} {
+			lr := lrs.AppendEmpty()
+			lr.SetTimestamp(pcommon.NewTimestampFromTime(testTime.Add(time.Duration(10-i) * time.Second)))
+			lr.Body().SetStr("queue depth=" + depth)
+		}
+	}
+	return ld
+}
+
+func TestLogsToMetrics(t *testing.T) {
+	testCases := []struct {
+		name string
+		cfg  *Config
+	}{
+		{
+			name: "histogram",
+			cfg: &Config{
+				Logs: []MetricInfo{
+					{
+						Name:        "http.server.request.duration",
+						Description: "The duration of the requests.",
+						Unit:        "ms",
+						Type:        MetricTypeHistogram,
+						Buckets:     []float64{10, 100, 1000},
+						Value:       `attributes["duration_ms"]`,
+						Attributes: []AttributeConfig{
+							{Key: "http.route"},
+							{Key: "status", DefaultValue: "unknown"},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "sum",
+			cfg: &Config{
+				Logs: []MetricInfo{
+					{
+						Name:       "http.server.response.size",
+						Unit:       "By",
+						Type:       MetricTypeSum,
+						Value:      `attributes["bytes"]`,
+						Conditions: []string{`attributes["status"] == "200"`},
+						Attributes: []AttributeConfig{
+							{Key: "http.route"},
+							{Key: "status_class", Value: `Concat([Substring(attributes["status"], 0, 1), "xx"], "")`},
+						},
+					},
+					{
+						Name:  "http.server.request.duration.total",
+						Type:  MetricTypeSum,
+						Value: `attributes["duration_ms"]`,
+					},
+				},
+			},
+		},
+		{
+			name: "gauge",
+			cfg: &Config{
+				Logs: []MetricInfo{
+					{
+						Name:  "queue.depth",
+						Type:  MetricTypeGauge,
+						Value: `ExtractPatterns(body, "depth=(?P<depth>\\d+)")["depth"]`,
+						Conditions: []string{
+							`IsMatch(body, "depth=")`,
+						},
+					},
+				},
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			require.NoError(t, tc.cfg.Validate())
+			sink := &consumertest.MetricsSink{}
+			conn, err := NewFactory().CreateLogsToMetrics(context.Background(),
+				connectortest.NewNopCreateSettings(), tc.cfg, sink)
+			require.NoError(t, err)
+			assert.False(t, conn.Capabilities().MutatesData)
+
+			require.NoError(t, conn.Start(context.Background(), componenttest.NewNopHost()))
+			defer func() {
+				assert.NoError(t, conn.Shutdown(context.Background()))
+			}()
+
+			assert.NoError(t, conn.ConsumeLogs(context.Background(), buildTestLogs()))
+
+			allMetrics := sink.AllMetrics()
+			require.Len(t, allMetrics, 1)
+
+			// golden.WriteMetrics(t, filepath.Join("testdata", "logs", tc.name+".yaml"), allMetrics[0])
+			expected, err := golden.ReadMetrics(filepath.Join("testdata", "logs", tc.name+".yaml"))
+			require.NoError(t, err)
+			assert.NoError(t, pmetrictest.CompareMetrics(expected, allMetrics[0],
+				pmetrictest.IgnoreTimestamp(),
+				pmetrictest.IgnoreResourceMetricsOrder(),
+				pmetrictest.IgnoreMetricsOrder(),
+				pmetrictest.IgnoreMetricDataPointsOrder()))
+		})
+	}
+}
+
+func TestLogsToMetricsGaugeTimestamp(t *testing.T) {
+	cfg := &Config{
+		Logs: []MetricInfo{
+			{
+				Name:  "queue.depth",
+				Type:  MetricTypeGauge,
+				Value: `ExtractPatterns(body, "depth=(?P<depth>\\d+)")["depth"]`,
+			},
+		},
+	}
+	require.NoError(t, cfg.Validate())
+	sink := &consumertest.MetricsSink{}
+	conn, err := NewFactory().CreateLogsToMetrics(context.Background(), connectortest.NewNopCreateSettings(), cfg, sink)
+	require.NoError(t, err)
+
+	ld := plog.NewLogs()
+	lrs := ld.ResourceLogs().AppendEmpty().ScopeLogs().AppendEmpty().LogRecords()
+	latest := lrs.AppendEmpty()
+	latest.SetTimestamp(pcommon.NewTimestampFromTime(testTime.Add(time.Second)))
+	latest.Body().SetStr("queue depth=7")
+	observed := lrs.AppendEmpty()
+	observed.SetObservedTimestamp(pcommon.NewTimestampFromTime(testTime))
+	observed.Body().SetStr("queue depth=3")
+	require.NoError(t, conn.ConsumeLogs(context.Background(), ld))
+
+	require.Len(t, sink.AllMetrics(), 1)
+	dps := sink.AllMetrics()[0].ResourceMetrics().At(0).ScopeMetrics().At(0).Metrics().At(0).Gauge().DataPoints()
+	require.Equal(t, 1, dps.Len())
+	assert.Equal(t, int64(7), dps.At(0).IntValue(), "the gauge must report the value of the latest log record")
+	assert.Equal(t, pcommon.NewTimestampFromTime(testTime.Add(time.Second)), dps.At(0).Timestamp())
+}
+
+func TestLogsToMetricsError(t *testing.T) {
+	cfg := &Config{
+		Logs: []MetricInfo{
+			{
+				Name:  "duration",
+				Type:  MetricTypeSum,
+				Value: `Int(attributes["duration_ms"]) / 0`,
+			},
+		},
+	}
+	require.NoError(t, cfg.Validate())
+	sink := &consumertest.MetricsSink{}
+	conn, err := NewFactory().CreateLogsToMetrics(context.Background(), connectortest.NewNopCreateSettings(), cfg, sink)
+	require.NoError(t, err)
+
+	assert.ErrorContains(t, conn.ConsumeLogs(context.Background(), buildTestLogs()), `metric "duration"`)
+	assert.Empty(t, sink.AllMetrics())
+}
@@ -0,0 +1,83 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+//go:generate mdatagen metadata.yaml
+
+package aggregationconnector // import "github.com/open-telemetry/opentelemetry-collector-contrib/connector/aggregationconnector"
+
+import (
+	"context"
+
+	"go.opentelemetry.io/collector/component"
+	"go.opentelemetry.io/collector/connector"
+	"go.opentelemetry.io/collector/consumer"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/connector/aggregationconnector/internal/metadata"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/internal/filter/filterottl"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl"
+)
+
+// NewFactory returns a ConnectorFactory.
+func NewFactory() connector.Factory {
+	return connector.NewFactory(
+		metadata.Type,
+		createDefaultConfig,
+		connector.WithLogsToMetrics(createLogsToMetrics, metadata.LogsToMetricsStability),
+	)
+}
+
+// createDefaultConfig creates the default configuration.
+func createDefaultConfig() component.Config {
+	return &Config{}
+}
+
+// createLogsToMetrics creates a logs to metrics connector based on provided config.
+func createLogsToMetrics(
+	_ context.Context,
+	set connector.CreateSettings,
+	cfg component.Config,
+	nextConsumer consumer.Metrics,
+) (connector.Logs, error) {
+	c := cfg.(*Config)
+
+	parser, err := newValueParser(set.TelemetrySettings)
+	if err != nil {
+		return nil, err
+	}
+
+	metricDefs := make([]metricDef, 0, len(c.Logs))
+	for _, info := range c.Logs {
+		md := metricDef{
+			name:    info.Name,
+			desc:    info.Description,
+			unit:    info.Unit,
+			typ:     info.Type,
+			buckets: info.Buckets,
+		}
+		if md.typ == MetricTypeHistogram && len(md.buckets) == 0 {
+			md.buckets = defaultHistogramBuckets
+		}
+		if len(info.Conditions) > 0 {
+			// Error checked in Config.Validate()
+			condition, _ := filterottl.NewBoolExprForLog(info.Conditions, filterottl.StandardLogFuncs(), ottl.PropagateError, set.TelemetrySettings)
+			md.condition = condition
+		}
+		// Errors checked in Config.Validate()
+		md.value, _ = parser.ParseValueExpression(info.Value)
+		for _, attr := range info.Attributes {
+			value, _ := parser.ParseValueExpression(attr.valueExpression())
+			md.attrs = append(md.attrs, attributeDef{
+				key:          attr.Key,
+				value:        value,
+				defaultValue: attr.DefaultValue,
+			})
+		}
+		metricDefs = append(metricDefs, md)
+	}
+
+	return &aggregation{
+		logger:          set.Logger,
+		metricsConsumer: nextConsumer,
+		metricDefs:      metricDefs,
+	}, nil
+}
@@ -5,6 +5,7 @@ package countconnector // import "github.com/open-telemetry/opentelemetry-collec
 
 import (
 	"fmt"
+	"time"
 
 	"go.opentelemetry.io/collector/component"
 	"go.opentelemetry.io/collector/confmap"
@@ -37,6 +38,9 @@ type Config struct {
 	Metrics    map[string]MetricInfo `mapstructure:"metrics"`
 	DataPoints map[string]MetricInfo `mapstructure:"datapoints"`
 	Logs       map[string]MetricInfo `mapstructure:"logs"`
+
+	// DistinctInterval is the interval over which the distinct values are counted.
+	DistinctInterval time.Duration `mapstructure:"distinct_interval"`
 }
 
 // MetricInfo for a data type
@@ -44,6 +48,9 @@ type MetricInfo struct {
 	Description string            `mapstructure:"description"`
 	Conditions  []string          `mapstructure:"conditions"`
 	Attributes  []AttributeConfig `mapstructure:"attributes"`
+	// DistinctAttribute, when set, makes the metric count the distinct values of the attribute
+	// within each distinct_interval, rather than the number of matching items.
+	DistinctAttribute string `mapstructure:"distinct_attribute"`
 }
 
 type AttributeConfig struct {
@@ -84,6 +91,9 @@ func (c *Config) Validate() error {
 		if len(info.Attributes) > 0 {
 			return fmt.Errorf("metrics attributes not supported: metric %q", name)
 		}
+		if info.DistinctAttribute != "" {
+			return fmt.Errorf("metrics distinct attribute not supported: metric %q", name)
+		}
 	}
 
 	for name, info := range c.DataPoints {
@@ -108,9 +118,24 @@ func (c *Config) Validate() error {
 			return fmt.Errorf("logs attributes: metric %q: %w", name, err)
 		}
 	}
+	if c.hasDistinct() && c.DistinctInterval <= 0 {
+		return fmt.Errorf("distinct_interval must be positive: %v", c.DistinctInterval)
+	}
 	return nil
 }
 
+// hasDistinct tells whether any metric counts distinct values.
+func (c *Config) hasDistinct() bool {
+	for _, infos := range []map[string]MetricInfo{c.Spans, c.SpanEvents, c.DataPoints, c.Logs} {
+		for _, info := range infos {
+			if info.DistinctAttribute != "" {
+				return true
+			}
+		}
+	}
+	return false
+}
+
 func (i *MetricInfo) validateAttributes() error {
 	for _, attr := range i.Attributes {
 		if attr.Key == "" {
@@ -7,6 +7,7 @@ import (
 	"fmt"
 	"path/filepath"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -24,6 +25,7 @@ func TestLoadConfig(t *testing.T) {
 		{
 			name: "",
 			expect: &Config{
+				DistinctInterval: time.Minute,
 				Spans: map[string]MetricInfo{
 					defaultMetricNameSpans: {
 						Description: defaultMetricDescSpans,
@@ -54,6 +56,7 @@ func TestLoadConfig(t *testing.T) {
 		{
 			name: "custom_description",
 			expect: &Config{
+				DistinctInterval: time.Minute,
 				Spans: map[string]MetricInfo{
 					defaultMetricNameSpans: {
 						Description: "My description for default span count metric.",
@@ -84,6 +87,7 @@ func TestLoadConfig(t *testing.T) {
 		{
 			name: "custom_metric",
 			expect: &Config{
+				DistinctInterval: time.Minute,
 				Spans: map[string]MetricInfo{
 					"my.span.count": {
 						Description: "My span count.",
@@ -114,6 +118,7 @@ func TestLoadConfig(t *testing.T) {
 		{
 			name: "condition",
 			expect: &Config{
+				DistinctInterval: time.Minute,
 				Spans: map[string]MetricInfo{
 					"my.span.count": {
 						Description: "My span count.",
@@ -149,6 +154,7 @@ func TestLoadConfig(t *testing.T) {
 		{
 			name: "multiple_condition",
 			expect: &Config{
+				DistinctInterval: time.Minute,
 				Spans: map[string]MetricInfo{
 					"my.span.count": {
 						Description: "My span count.",
@@ -199,6 +205,7 @@ func TestLoadConfig(t *testing.T) {
 		{
 			name: "attribute",
 			expect: &Config{
+				DistinctInterval: time.Minute,
 				Spans: map[string]MetricInfo{
 					"my.span.count": {
 						Description: "My span count by environment.",
@@ -241,6 +248,7 @@ func TestLoadConfig(t *testing.T) {
 		{
 			name: "multiple_metrics",
 			expect: &Config{
+				DistinctInterval: time.Minute,
 				Spans: map[string]MetricInfo{
 					"my.span.count": {
 						Description: "My span count.",
@@ -323,9 +331,42 @@ func TestLoadConfig(t *testing.T) {
 				},
 			},
 		},
+		{
+			name: "distinct",
+			expect: &Config{
+				DistinctInterval: 5 * time.Minute,
+				Spans: map[string]MetricInfo{
+					defaultMetricNameSpans: {
+						Description: defaultMetricDescSpans,
+					},
+				},
+				SpanEvents: map[string]MetricInfo{
+					defaultMetricNameSpanEvents: {
+						Description: defaultMetricDescSpanEvents,
+					},
+				},
+				Metrics: map[string]MetricInfo{
+					defaultMetricNameMetrics: {
+						Description: defaultMetricDescMetrics,
+					},
+				},
+				DataPoints: map[string]MetricInfo{
+					defaultMetricNameDataPoints: {
+						Description: defaultMetricDescDataPoints,
+					},
+				},
+				Logs: map[string]MetricInfo{
+					"user.distinct.count": {
+						Description:       "The number of distinct users by service.",
+						DistinctAttribute: "user.id",
+					},
+				},
+			},
+		},
 		{
 			name: "default_values",
 			expect: &Config{
+				DistinctInterval: time.Minute,
 				Spans: map[string]MetricInfo{
 					defaultMetricNameSpans: {
 						Description: defaultMetricDescSpans,
@@ -510,6 +551,30 @@ func TestConfigErrors(t *testing.T) {
 			},
 			expect: fmt.Sprintf("logs condition: metric %q: unable to parse OTTL condition", defaultMetricNameLogs),
 		},
+		{
+			name: "distinct_attribute_metric",
+			input: &Config{
+				Metrics: map[string]MetricInfo{
+					defaultMetricNameMetrics: {
+						Description:       defaultMetricDescMetrics,
+						DistinctAttribute: "name",
+					},
+				},
+				DistinctInterval: time.Minute,
+			},
+			expect: fmt.Sprintf("metrics distinct attribute not supported: metric %q", defaultMetricNameMetrics),
+		},
+		{
+			name: "invalid_distinct_interval",
+			input: &Config{
+				Logs: map[string]MetricInfo{
+					"user.distinct.count": {
+						DistinctAttribute: "user.id",
+					},
+				},
+			},
+			expect: "distinct_interval must be positive: 0s",
+		},
 	}
 
 	for _, tc := range testCases {
@@ -28,14 +28,29 @@ const scopeName = "otelcol/countconnector"
 // and emit the counts onto a metrics pipeline.
 type count struct {
 	metricsConsumer consumer.Metrics
-	component.StartFunc
-	component.ShutdownFunc
 
 	spansMetricDefs      map[string]metricDef[ottlspan.TransformContext]
 	spanEventsMetricDefs map[string]metricDef[ottlspanevent.TransformContext]
 	metricsMetricDefs    map[string]metricDef[ottlmetric.TransformContext]
 	dataPointsMetricDefs map[string]metricDef[ottldatapoint.TransformContext]
 	logsMetricDefs       map[string]metricDef[ottllog.TransformContext]
+
+	// distinct is nil unless a metric counts distinct values.
+	distinct *distinctCounts
+}
+
+func (c *count) Start(context.Context, component.Host) error {
+	if c.distinct != nil {
+		c.distinct.startFlushing()
+	}
+	return nil
+}
+
+func (c *count) Shutdown(ctx context.Context) error {
+	if c.distinct == nil {
+		return nil
+	}
+	return c.distinct.shutdown(ctx)
 }
 
 func (c *count) Capabilities() consumer.Capabilities {
@@ -67,6 +82,10 @@ func (c *count) ConsumeTraces(ctx context.Context, td ptrace.Traces) error {
 			}
 		}
 
+		if c.distinct != nil {
+			multiError = errors.Join(multiError, c.distinct.merge(resourceSpan.Resource(), spansCounter.sketches, spanEventsCounter.sketches))
+		}
+
 		if len(spansCounter.counts)+len(spanEventsCounter.counts) == 0 {
 			continue // don't add an empty resource
 		}
@@ -142,6 +161,10 @@ func (c *count) ConsumeMetrics(ctx context.Context, md pmetric.Metrics) error {
 			}
 		}
 
+		if c.distinct != nil {
+			multiError = errors.Join(multiError, c.distinct.merge(resourceMetric.Resource(), dataPointsCounter.sketches))
+		}
+
 		if len(metricsCounter.counts)+len(dataPointsCounter.counts) == 0 {
 			continue // don't add an empty resource
 		}
@@ -181,6 +204,10 @@ func (c *count) ConsumeLogs(ctx context.Context, ld plog.Logs) error {
 			}
 		}
 
+		if c.distinct != nil {
+			multiError = errors.Join(multiError, c.distinct.merge(resourceLog.Resource(), counter.sketches))
+		}
+
 		if len(counter.counts) == 0 {
 			continue // don't add an empty resource
 		}
@@ -8,6 +8,7 @@ import (
 	"errors"
 	"time"
 
+	"github.com/axiomhq/hyperloglog"
 	"go.opentelemetry.io/collector/pdata/pcommon"
 	"go.opentelemetry.io/collector/pdata/pmetric"
 
@@ -20,6 +21,7 @@ func newCounter[K any](metricDefs map[string]metricDef[K]) *counter[K] {
 	return &counter[K]{
 		metricDefs: metricDefs,
 		counts:     make(map[string]map[[16]byte]*attrCounter, len(metricDefs)),
+		sketches:   make(map[string]map[[16]byte]*attrSketch),
 		timestamp:  time.Now(),
 	}
 }
@@ -27,6 +29,7 @@ func newCounter[K any](metricDefs map[string]metricDef[K]) *counter[K] {
 type counter[K any] struct {
 	metricDefs map[string]metricDef[K]
 	counts     map[string]map[[16]byte]*attrCounter
+	sketches   map[string]map[[16]byte]*attrSketch
 	timestamp  time.Time
 }
 
@@ -35,6 +38,12 @@ type attrCounter struct {
 	count uint64
 }
 
+// attrSketch estimates the number of distinct values observed for a set of attributes.
+type attrSketch struct {
+	attrs  pcommon.Map
+	sketch *hyperloglog.Sketch
+}
+
 func (c *counter[K]) update(ctx context.Context, attrs pcommon.Map, tCtx K) error {
 	var multiError error
 	for name, md := range c.metricDefs {
@@ -72,21 +81,34 @@ func (c *counter[K]) update(ctx context.Context, attrs pcommon.Map, tCtx K) erro
 			continue
 		}
 
+		// Missing the attribute whose distinct values are counted
+		if _, ok := attrs.Get(md.distinctAttr); md.distinctAttr != "" && !ok {
+			continue
+		}
+
 		// No conditions, so match all.
 		if md.condition == nil {
-			multiError = errors.Join(multiError, c.increment(name, countAttrs))
+			multiError = errors.Join(multiError, c.record(name, md, countAttrs, attrs))
 			continue
 		}
 
 		if match, err := md.condition.Eval(ctx, tCtx); err != nil {
 			multiError = errors.Join(multiError, err)
 		} else if match {
-			multiError = errors.Join(multiError, c.increment(name, countAttrs))
+			multiError = errors.Join(multiError, c.record(name, md, countAttrs, attrs))
 		}
 	}
 	return multiError
 }
 
+func (c *counter[K]) record(metricName string, md metricDef[K], countAttrs pcommon.Map, attrs pcommon.Map) error {
+	if md.distinctAttr == "" {
+		return c.increment(metricName, countAttrs)
+	}
+	distinctVal, _ := attrs.Get(md.distinctAttr)
+	return c.insert(metricName, countAttrs, distinctVal.AsString())
+}
+
 func (c *counter[K]) increment(metricName string, attrs pcommon.Map) error {
 	if _, ok := c.counts[metricName]; !ok {
 		c.counts[metricName] = make(map[[16]byte]*attrCounter)
@@ -105,6 +127,24 @@ func (c *counter[K]) increment(metricName string, attrs pcommon.Map) error {
 	return nil
 }
 
+func (c *counter[K]) insert(metricName string, attrs pcommon.Map, distinctVal string) error {
+	if _, ok := c.sketches[metricName]; !ok {
+		c.sketches[metricName] = make(map[[16]byte]*attrSketch)
+	}
+
+	key := noAttributes
+	if attrs.Len() > 0 {
+		key = pdatautil.MapHash(attrs)
+	}
+
+	if _, ok := c.sketches[metricName][key]; !ok {
+		c.sketches[metricName][key] = &attrSketch{attrs: attrs, sketch: hyperloglog.New()}
+	}
+
+	c.sketches[metricName][key].sketch.Insert([]byte(distinctVal))
+	return nil
+}
+
 func (c *counter[K]) appendMetricsTo(metricSlice pmetric.MetricSlice) {
 	for name, md := range c.metricDefs {
 		if len(c.counts[name]) == 0 {
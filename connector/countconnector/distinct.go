@@ -0,0 +1,162 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package countconnector // import "github.com/open-telemetry/opentelemetry-collector-contrib/connector/countconnector"
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"go.opentelemetry.io/collector/consumer"
+	"go.opentelemetry.io/collector/pdata/pcommon"
+	"go.opentelemetry.io/collector/pdata/pmetric"
+	"go.uber.org/zap"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/pdatautil"
+)
+
+// distinctCounts accumulates the distinct values counted across the batches received
+// within an interval, and emits their estimated number at the end of the interval.
+type distinctCounts struct {
+	logger          *zap.Logger
+	metricsConsumer consumer.Metrics
+	descs           map[string]string
+	interval        time.Duration
+
+	mu        sync.Mutex
+	resources map[[16]byte]*resourceSketches
+	start     time.Time
+
+	done chan struct{}
+	wg   sync.WaitGroup
+}
+
+type resourceSketches struct {
+	attrs    pcommon.Map
+	sketches map[string]map[[16]byte]*attrSketch
+}
+
+// newDistinctCounts returns nil when no metric, described in descs by name, counts distinct values.
+func newDistinctCounts(logger *zap.Logger, metricsConsumer consumer.Metrics, interval time.Duration, descs map[string]string) *distinctCounts {
+	if len(descs) == 0 {
+		return nil
+	}
+	return &distinctCounts{
+		logger:          logger,
+		metricsConsumer: metricsConsumer,
+		descs:           descs,
+		interval:        interval,
+		resources:       make(map[[16]byte]*resourceSketches),
+		start:           time.Now(),
+		done:            make(chan struct{}),
+	}
+}
+
+// addDistinctDescs adds the descriptions of the metrics counting distinct values to descs.
+func addDistinctDescs[K any](descs map[string]string, metricDefs map[string]metricDef[K]) {
+	for name, md := range metricDefs {
+		if md.distinctAttr != "" {
+			descs[name] = md.desc
+		}
+	}
+}
+
+// merge adds the sketches of a batch of items of the given resource to the current interval.
+func (d *distinctCounts) merge(resource pcommon.Resource, sketches ...map[string]map[[16]byte]*attrSketch) error {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	resourceKey := pdatautil.MapHash(resource.Attributes())
+	for _, metricSketches := range sketches {
+		for name, attrSketches := range metricSketches {
+			rs, ok := d.resources[resourceKey]
+			if !ok {
+				rs = &resourceSketches{attrs: pcommon.NewMap(), sketches: make(map[string]map[[16]byte]*attrSketch)}
+				resource.Attributes().CopyTo(rs.attrs)
+				d.resources[resourceKey] = rs
+			}
+			if _, ok = rs.sketches[name]; !ok {
+				rs.sketches[name] = make(map[[16]byte]*attrSketch)
+			}
+			for key, as := range attrSketches {
+				current, ok := rs.sketches[name][key]
+				if !ok {
+					rs.sketches[name][key] = as
+					continue
+				}
+				if err := current.sketch.Merge(as.sketch); err != nil {
+					return err
+				}
+			}
+		}
+	}
+	return nil
+}
+
+func (d *distinctCounts) startFlushing() {
+	d.wg.Add(1)
+	go func() {
+		defer d.wg.Done()
+		ticker := time.NewTicker(d.interval)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-d.done:
+				return
+			case <-ticker.C:
+				if err := d.flush(context.Background()); err != nil {
+					d.logger.Error("Failed to emit the distinct counts", zap.Error(err))
+				}
+			}
+		}
+	}()
+}
+
+// shutdown stops the periodic flushing and emits the counts of the current interval.
+func (d *distinctCounts) shutdown(ctx context.Context) error {
+	close(d.done)
+	d.wg.Wait()
+	return d.flush(ctx)
+}
+
+// flush emits the distinct counts of the current interval, and starts a new one.
+func (d *distinctCounts) flush(ctx context.Context) error {
+	d.mu.Lock()
+	resources, start := d.resources, d.start
+	d.resources = make(map[[16]byte]*resourceSketches)
+	d.start = time.Now()
+	d.mu.Unlock()
+
+	if len(resources) == 0 {
+		return nil
+	}
+
+	startTimestamp := pcommon.NewTimestampFromTime(start)
+	timestamp := pcommon.NewTimestampFromTime(time.Now())
+	distinctMetrics := pmetric.NewMetrics()
+	distinctMetrics.ResourceMetrics().EnsureCapacity(len(resources))
+	for _, rs := range resources {
+		distinctResource := distinctMetrics.ResourceMetrics().AppendEmpty()
+		rs.attrs.CopyTo(distinctResource.Resource().Attributes())
+
+		distinctScope := distinctResource.ScopeMetrics().AppendEmpty()
+		distinctScope.Scope().SetName(scopeName)
+
+		for name, attrSketches := range rs.sketches {
+			distinctMetric := distinctScope.Metrics().AppendEmpty()
+			distinctMetric.SetName(name)
+			distinctMetric.SetDescription(d.descs[name])
+			// The number of distinct values within an interval can't be added up across intervals
+			gauge := distinctMetric.SetEmptyGauge()
+			for _, as := range attrSketches {
+				dp := gauge.DataPoints().AppendEmpty()
+				as.attrs.CopyTo(dp.Attributes())
+				dp.SetIntValue(int64(as.sketch.Estimate()))
+				dp.SetStartTimestamp(startTimestamp)
+				dp.SetTimestamp(timestamp)
+			}
+		}
+	}
+	return d.metricsConsumer.ConsumeMetrics(ctx, distinctMetrics)
+}
@@ -0,0 +1,124 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package countconnector
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/collector/component/componenttest"
+	"go.opentelemetry.io/collector/connector/connectortest"
+	"go.opentelemetry.io/collector/consumer/consumertest"
+	"go.opentelemetry.io/collector/pdata/plog"
+	"go.opentelemetry.io/collector/pdata/pmetric"
+)
+
+func buildDistinctLogs(service string, userIDs ...string) plog.Logs {
+	ld := plog.NewLogs()
+	rl := ld.ResourceLogs().AppendEmpty()
+	rl.Resource().Attributes().PutStr("service.name", service)
+	sl := rl.ScopeLogs().AppendEmpty()
+	for _, userID := range userIDs {
+		lr := sl.LogRecords().AppendEmpty()
+		lr.Attributes().PutStr("env", "prod")
+		if userID != "" {
+			lr.Attributes().PutStr("user.id", userID)
+		}
+	}
+	return ld
+}
+
+// distinctCountsOf returns the distinct counts of a metric, by service.
+func distinctCountsOf(t *testing.T, allMetrics []pmetric.Metrics, name string) map[string]int64 {
+	counts := make(map[string]int64)
+	for _, md := range allMetrics {
+		for i := 0; i < md.ResourceMetrics().Len(); i++ {
+			rm := md.ResourceMetrics().At(i)
+			service, _ := rm.Resource().Attributes().Get("service.name")
+			for j := 0; j < rm.ScopeMetrics().Len(); j++ {
+				metrics := rm.ScopeMetrics().At(j).Metrics()
+				for k := 0; k < metrics.Len(); k++ {
+					if metrics.At(k).Name() != name {
+						continue
+					}
+					require.Equal(t, pmetric.MetricTypeGauge, metrics.At(k).Type())
+					dps := metrics.At(k).Gauge().DataPoints()
+					require.Equal(t, 1, dps.Len())
+					env, _ := dps.At(0).Attributes().Get("env")
+					assert.Equal(t, "prod", env.Str())
+					counts[service.Str()] += dps.At(0).IntValue()
+				}
+			}
+		}
+	}
+	return counts
+}
+
+func TestLogsToMetricsDistinct(t *testing.T) {
+	cfg := &Config{
+		Logs: map[string]MetricInfo{
+			"log.record.count": {
+				Description: "The number of log records observed.",
+			},
+			"user.distinct.count": {
+				Description:       "The number of distinct users.",
+				Attributes:        []AttributeConfig{{Key: "env"}},
+				DistinctAttribute: "user.id",
+			},
+		},
+		DistinctInterval: time.Hour,
+	}
+	require.NoError(t, cfg.Validate())
+
+	sink := &consumertest.MetricsSink{}
+	conn, err := NewFactory().CreateLogsToMetrics(context.Background(), connectortest.NewNopCreateSettings(), cfg, sink)
+	require.NoError(t, err)
+	require.NoError(t, conn.Start(context.Background(), componenttest.NewNopHost()))
+
+	require.NoError(t, conn.ConsumeLogs(context.Background(), buildDistinctLogs("checkout", "alice", "bob", "alice", "")))
+	require.NoError(t, conn.ConsumeLogs(context.Background(), buildDistinctLogs("checkout", "alice", "carol")))
+	require.NoError(t, conn.ConsumeLogs(context.Background(), buildDistinctLogs("cart", "bob")))
+	assert.Empty(t, distinctCountsOf(t, sink.AllMetrics(), "user.distinct.count"), "the distinct counts must be emitted at the end of the interval")
+
+	require.NoError(t, conn.Shutdown(context.Background()))
+	assert.Equal(t, map[string]int64{"checkout": 3, "cart": 1}, distinctCountsOf(t, sink.AllMetrics(), "user.distinct.count"))
+}
+
+func TestDistinctCountsInterval(t *testing.T) {
+	cfg := &Config{
+		Logs: map[string]MetricInfo{
+			"user.distinct.count": {
+				Attributes:        []AttributeConfig{{Key: "env"}},
+				DistinctAttribute: "user.id",
+			},
+		},
+		DistinctInterval: 10 * time.Millisecond,
+	}
+	require.NoError(t, cfg.Validate())
+
+	sink := &consumertest.MetricsSink{}
+	conn, err := NewFactory().CreateLogsToMetrics(context.Background(), connectortest.NewNopCreateSettings(), cfg, sink)
+	require.NoError(t, err)
+	require.NoError(t, conn.Start(context.Background(), componenttest.NewNopHost()))
+	defer func() {
+		assert.NoError(t, conn.Shutdown(context.Background()))
+	}()
+
+	require.NoError(t, conn.ConsumeLogs(context.Background(), buildDistinctLogs("checkout", "alice", "bob")))
+	require.Eventually(t, func() bool {
+		return len(distinctCountsOf(t, sink.AllMetrics(), "user.distinct.count")) > 0
+	}, time.Second, 5*time.Millisecond)
+	assert.Equal(t, map[string]int64{"checkout": 2}, distinctCountsOf(t, sink.AllMetrics(), "user.distinct.count"))
+
+	// a new interval starts from scratch
+	sink.Reset()
+	require.NoError(t, conn.ConsumeLogs(context.Background(), buildDistinctLogs("checkout", "alice")))
+	require.Eventually(t, func() bool {
+		return len(distinctCountsOf(t, sink.AllMetrics(), "user.distinct.count")) > 0
+	}, time.Second, 5*time.Millisecond)
+	assert.Equal(t, map[string]int64{"checkout": 1}, distinctCountsOf(t, sink.AllMetrics(), "user.distinct.count"))
+}
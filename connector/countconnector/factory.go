@@ -7,6 +7,7 @@ package countconnector // import "github.com/open-telemetry/opentelemetry-collec
 
 import (
 	"context"
+	"time"
 
 	"go.opentelemetry.io/collector/component"
 	"go.opentelemetry.io/collector/connector"
@@ -36,7 +37,9 @@ func NewFactory() connector.Factory {
 
 // createDefaultConfig creates the default configuration.
 func createDefaultConfig() component.Config {
-	return &Config{}
+	return &Config{
+		DistinctInterval: time.Minute,
+	}
 }
 
 // createTracesToMetrics creates a traces to metrics connector based on provided config.
@@ -51,8 +54,9 @@ func createTracesToMetrics(
 	spanMetricDefs := make(map[string]metricDef[ottlspan.TransformContext], len(c.Spans))
 	for name, info := range c.Spans {
 		md := metricDef[ottlspan.TransformContext]{
-			desc:  info.Description,
-			attrs: info.Attributes,
+			desc:         info.Description,
+			attrs:        info.Attributes,
+			distinctAttr: info.DistinctAttribute,
 		}
 		if len(info.Conditions) > 0 {
 			// Error checked in Config.Validate()
@@ -65,8 +69,9 @@ func createTracesToMetrics(
 	spanEventMetricDefs := make(map[string]metricDef[ottlspanevent.TransformContext], len(c.SpanEvents))
 	for name, info := range c.SpanEvents {
 		md := metricDef[ottlspanevent.TransformContext]{
-			desc:  info.Description,
-			attrs: info.Attributes,
+			desc:         info.Description,
+			attrs:        info.Attributes,
+			distinctAttr: info.DistinctAttribute,
 		}
 		if len(info.Conditions) > 0 {
 			// Error checked in Config.Validate()
@@ -76,10 +81,15 @@ func createTracesToMetrics(
 		spanEventMetricDefs[name] = md
 	}
 
+	distinctDescs := make(map[string]string)
+	addDistinctDescs(distinctDescs, spanMetricDefs)
+	addDistinctDescs(distinctDescs, spanEventMetricDefs)
+
 	return &count{
 		metricsConsumer:      nextConsumer,
 		spansMetricDefs:      spanMetricDefs,
 		spanEventsMetricDefs: spanEventMetricDefs,
+		distinct:             newDistinctCounts(set.Logger, nextConsumer, c.DistinctInterval, distinctDescs),
 	}, nil
 }
 
@@ -108,8 +118,9 @@ func createMetricsToMetrics(
 	dataPointMetricDefs := make(map[string]metricDef[ottldatapoint.TransformContext], len(c.DataPoints))
 	for name, info := range c.DataPoints {
 		md := metricDef[ottldatapoint.TransformContext]{
-			desc:  info.Description,
-			attrs: info.Attributes,
+			desc:         info.Description,
+			attrs:        info.Attributes,
+			distinctAttr: info.DistinctAttribute,
 		}
 		if len(info.Conditions) > 0 {
 			// Error checked in Config.Validate()
@@ -119,10 +130,14 @@ func createMetricsToMetrics(
 		dataPointMetricDefs[name] = md
 	}
 
+	distinctDescs := make(map[string]string)
+	addDistinctDescs(distinctDescs, dataPointMetricDefs)
+
 	return &count{
 		metricsConsumer:      nextConsumer,
 		metricsMetricDefs:    metricMetricDefs,
 		dataPointsMetricDefs: dataPointMetricDefs,
+		distinct:             newDistinctCounts(set.Logger, nextConsumer, c.DistinctInterval, distinctDescs),
 	}, nil
 }
 
@@ -138,8 +153,9 @@ func createLogsToMetrics(
 	metricDefs := make(map[string]metricDef[ottllog.TransformContext], len(c.Logs))
 	for name, info := range c.Logs {
 		md := metricDef[ottllog.TransformContext]{
-			desc:  info.Description,
-			attrs: info.Attributes,
+			desc:         info.Description,
+			attrs:        info.Attributes,
+			distinctAttr: info.DistinctAttribute,
 		}
 		if len(info.Conditions) > 0 {
 			// Error checked in Config.Validate()
@@ -149,14 +165,19 @@ func createLogsToMetrics(
 		metricDefs[name] = md
 	}
 
+	distinctDescs := make(map[string]string)
+	addDistinctDescs(distinctDescs, metricDefs)
+
 	return &count{
 		metricsConsumer: nextConsumer,
 		logsMetricDefs:  metricDefs,
+		distinct:        newDistinctCounts(set.Logger, nextConsumer, c.DistinctInterval, distinctDescs),
 	}, nil
 }
 
 type metricDef[K any] struct {
-	condition expr.BoolExpr[K]
-	desc      string
-	attrs     []AttributeConfig
+	condition    expr.BoolExpr[K]
+	desc         string
+	attrs        []AttributeConfig
+	distinctAttr string
 }
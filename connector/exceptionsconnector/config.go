@@ -19,6 +19,14 @@ type Exemplars struct {
 	Enabled bool `mapstructure:"enabled"`
 }
 
+// Fingerprint defines the fingerprint computed from the exception stacktrace, to group identical exceptions
+// regardless of the variable data of their messages.
+type Fingerprint struct {
+	Enabled bool `mapstructure:"enabled"`
+	// MaxFrames is the number of frames, from the top of the stacktrace, the fingerprint is computed from.
+	MaxFrames int `mapstructure:"max_frames"`
+}
+
 // Config defines the configuration options for exceptionsconnector
 type Config struct {
 	// Dimensions defines the list of additional dimensions on top of the provided:
@@ -31,6 +39,8 @@ type Config struct {
 	Dimensions []Dimension `mapstructure:"dimensions"`
 	// Exemplars defines the configuration for exemplars.
 	Exemplars Exemplars `mapstructure:"exemplars"`
+	// Fingerprint defines the configuration for the exception.fingerprint dimension.
+	Fingerprint Fingerprint `mapstructure:"fingerprint"`
 }
 
 var _ component.ConfigValidator = (*Config)(nil)
@@ -41,13 +51,16 @@ func (c Config) Validate() error {
 	if err != nil {
 		return err
 	}
+	if c.Fingerprint.Enabled && c.Fingerprint.MaxFrames <= 0 {
+		return fmt.Errorf("fingerprint max_frames must be positive: %d", c.Fingerprint.MaxFrames)
+	}
 	return nil
 }
 
 // validateDimensions checks duplicates for reserved dimensions and additional dimensions.
 func validateDimensions(dimensions []Dimension) error {
 	labelNames := make(map[string]struct{})
-	for _, key := range []string{serviceNameKey, spanKindKey, spanNameKey, statusCodeKey} {
+	for _, key := range []string{serviceNameKey, spanKindKey, spanNameKey, statusCodeKey, exceptionFingerprintKey} {
 		labelNames[key] = struct{}{}
 	}
 
@@ -39,6 +39,10 @@ func TestLoadConfig(t *testing.T) {
 				Exemplars: Exemplars{
 					Enabled: false,
 				},
+				Fingerprint: Fingerprint{
+					Enabled:   true,
+					MaxFrames: 5,
+				},
 			},
 		},
 	}
@@ -101,3 +105,15 @@ func TestValidateDimensions(t *testing.T) {
 		})
 	}
 }
+
+func TestValidateFingerprint(t *testing.T) {
+	cfg := createDefaultConfig().(*Config)
+	cfg.Fingerprint.Enabled = true
+	assert.NoError(t, cfg.Validate())
+
+	cfg.Fingerprint.MaxFrames = 0
+	assert.EqualError(t, cfg.Validate(), "fingerprint max_frames must be positive: 0")
+
+	cfg.Fingerprint.Enabled = false
+	assert.NoError(t, cfg.Validate())
+}
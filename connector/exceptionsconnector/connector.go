@@ -18,6 +18,8 @@ const (
 	spanNameKey   = "span.name"   // OpenTelemetry non-standard constant.
 	statusCodeKey = "status.code" // OpenTelemetry non-standard constant.
 	eventNameExc  = "exception"   // OpenTelemetry non-standard constant.
+
+	exceptionFingerprintKey = "exception.fingerprint"
 )
 
 type dimension struct {
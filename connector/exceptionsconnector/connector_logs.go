@@ -120,6 +120,10 @@ func (c *logsConnector) attrToLogRecord(sl plog.ScopeLogs, serviceName string, s
 
 	// Add stacktrace to the log record.
 	logRecord.Attributes().PutStr(exceptionStacktraceKey, getValue(eventAttrs, exceptionStacktraceKey))
+	if c.config.Fingerprint.Enabled {
+		fp := fingerprint(getValue(eventAttrs, exceptionTypeKey), getValue(eventAttrs, exceptionStacktraceKey), c.config.Fingerprint.MaxFrames)
+		logRecord.Attributes().PutStr(exceptionFingerprintKey, fp)
+	}
 	return logRecord
 }
 
@@ -58,3 +58,22 @@ func newTestLogsConnector(lcon consumer.Logs, logger *zap.Logger) *logsConnector
 	c.logsConsumer = lcon
 	return c
 }
+
+func TestConnectorLogConsumeTracesFingerprint(t *testing.T) {
+	lsink := new(consumertest.LogsSink)
+	cfg := createDefaultConfig().(*Config)
+	cfg.Fingerprint.Enabled = true
+	c := newLogsConnector(zaptest.NewLogger(t), cfg)
+	c.logsConsumer = lsink
+
+	require.NoError(t, c.ConsumeTraces(context.Background(), buildFingerprintTrace()))
+
+	require.Len(t, lsink.AllLogs(), 1)
+	records := lsink.AllLogs()[0].ResourceLogs().At(0).ScopeLogs().At(0).LogRecords()
+	require.Equal(t, 2, records.Len())
+	first, ok := records.At(0).Attributes().Get(exceptionFingerprintKey)
+	require.True(t, ok)
+	second, ok := records.At(1).Attributes().Get(exceptionFingerprintKey)
+	require.True(t, ok)
+	assert.Equal(t, first.Str(), second.Str())
+}
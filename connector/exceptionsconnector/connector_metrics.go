@@ -93,10 +93,13 @@ func (c *metricsConnector) ConsumeTraces(ctx context.Context, traces ptrace.Trac
 
 						c.keyBuf.Reset()
 						buildKey(c.keyBuf, serviceName, span, c.dimensions, eventAttrs)
-						key := c.keyBuf.String()
-
 						attrs := buildDimensionKVs(c.dimensions, serviceName, span, eventAttrs)
-						exc := c.addException(key, attrs)
+						if c.config.Fingerprint.Enabled {
+							fp := fingerprint(getValue(eventAttrs, exceptionTypeKey), getValue(eventAttrs, exceptionStacktraceKey), c.config.Fingerprint.MaxFrames)
+							concatDimensionValue(c.keyBuf, fp, true)
+							attrs.PutStr(exceptionFingerprintKey, fp)
+						}
+						exc := c.addException(c.keyBuf.String(), attrs)
 						c.addExemplar(exc, span.TraceID(), span.SpanID())
 					}
 				}
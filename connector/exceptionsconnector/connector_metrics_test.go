@@ -346,3 +346,22 @@ func TestBuildKeyWithDimensions(t *testing.T) {
 		})
 	}
 }
+
+func TestConnectorConsumeTracesFingerprint(t *testing.T) {
+	msink := new(consumertest.MetricsSink)
+	cfg := createDefaultConfig().(*Config)
+	cfg.Dimensions = []Dimension{{Name: exceptionTypeKey}}
+	cfg.Fingerprint.Enabled = true
+	c := newMetricsConnector(zaptest.NewLogger(t), cfg)
+	c.metricsConsumer = msink
+
+	require.NoError(t, c.ConsumeTraces(context.Background(), buildFingerprintTrace()))
+
+	require.Len(t, msink.AllMetrics(), 1)
+	dps := msink.AllMetrics()[0].ResourceMetrics().At(0).ScopeMetrics().At(0).Metrics().At(0).Sum().DataPoints()
+	require.Equal(t, 1, dps.Len(), "the exceptions must be grouped by fingerprint")
+	assert.Equal(t, int64(2), dps.At(0).IntValue())
+	fp, ok := dps.At(0).Attributes().Get(exceptionFingerprintKey)
+	require.True(t, ok)
+	assert.Len(t, fp.Str(), 16)
+}
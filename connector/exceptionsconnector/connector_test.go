@@ -111,3 +111,23 @@ func initSpan(span span, s ptrace.Span) {
 	e.Attributes().PutStr(exceptionMessageKey, "Exception message")
 	e.Attributes().PutStr(exceptionStacktraceKey, "Exception stacktrace")
 }
+
+// buildFingerprintTrace builds a trace with two exceptions thrown from the same code, with different messages.
+func buildFingerprintTrace() ptrace.Traces {
+	traces := ptrace.NewTraces()
+	rs := traces.ResourceSpans().AppendEmpty()
+	rs.Resource().Attributes().PutStr(serviceNameKey, "service-a")
+	spans := rs.ScopeSpans().AppendEmpty().Spans()
+	for _, userID := range []string{"42", "7"} {
+		s := spans.AppendEmpty()
+		s.SetName("svc-a-op")
+		s.SetKind(ptrace.SpanKindServer)
+		e := s.Events().AppendEmpty()
+		e.SetName(eventNameExc)
+		e.Attributes().PutStr(exceptionTypeKey, "java.lang.IllegalStateException")
+		e.Attributes().PutStr(exceptionMessageKey, "user "+userID+" not found")
+		e.Attributes().PutStr(exceptionStacktraceKey, "java.lang.IllegalStateException: user "+userID+" not found\n"+
+			"\tat com.example.UserService.find(UserService.java:42)\n")
+	}
+	return traces
+}
@@ -31,6 +31,9 @@ func createDefaultConfig() component.Config {
 			{Name: exceptionTypeKey},
 			{Name: exceptionMessageKey},
 		},
+		Fingerprint: Fingerprint{
+			MaxFrames: 10,
+		},
 	}
 }
 
@@ -0,0 +1,66 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package exceptionsconnector // import "github.com/open-telemetry/opentelemetry-collector-contrib/connector/exceptionsconnector"
+
+import (
+	"fmt"
+	"hash/fnv"
+	"regexp"
+	"strings"
+)
+
+var (
+	// goFileLine matches the file and line of a Go frame, e.g. "/app/main.go:12 +0x1d".
+	goFileLine = regexp.MustCompile(`\.go:\d+`)
+	// variableParts matches the parts of a frame that change between builds or runs of the same code:
+	// memory addresses, line and column numbers, and generated lambda identifiers.
+	variableParts = regexp.MustCompile(`0x[0-9a-fA-F]+|:\d+|\bline \d+|\$\$Lambda\$\d+`)
+	// digits matches the numbers of the lines that aren't recognized as frames, likely variable data.
+	digits = regexp.MustCompile(`\d+`)
+)
+
+// fingerprint returns a stable fingerprint of an exception, computed from its type and the normalized top
+// maxFrames frames of its stacktrace, so that the same crash gets the same fingerprint whatever its message.
+// The Java, JavaScript, .NET, Python and Go stacktrace formats are recognized; when no frame is recognized,
+// every line of the stacktrace is considered a frame, its numbers removed.
+func fingerprint(excType, stacktrace string, maxFrames int) string {
+	lines := strings.Split(stacktrace, "\n")
+	frames := make([]string, 0, maxFrames)
+	for _, line := range lines {
+		if len(frames) == maxFrames {
+			break
+		}
+		if line = strings.TrimSpace(line); isFrame(line) {
+			frames = append(frames, normalizeFrame(line))
+		}
+	}
+	if len(frames) == 0 {
+		for _, line := range lines {
+			if len(frames) == maxFrames {
+				break
+			}
+			if line = strings.TrimSpace(line); line != "" {
+				frames = append(frames, digits.ReplaceAllString(normalizeFrame(line), ""))
+			}
+		}
+	}
+
+	h := fnv.New64a()
+	_, _ = h.Write([]byte(excType))
+	for _, frame := range frames {
+		_, _ = h.Write([]byte{'\n'})
+		_, _ = h.Write([]byte(frame))
+	}
+	return fmt.Sprintf("%016x", h.Sum64())
+}
+
+func isFrame(line string) bool {
+	return strings.HasPrefix(line, "at ") || // Java, JavaScript and .NET
+		strings.HasPrefix(line, `File "`) || // Python
+		goFileLine.MatchString(line)
+}
+
+func normalizeFrame(frame string) string {
+	return variableParts.ReplaceAllString(frame, "")
+}
@@ -0,0 +1,133 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package exceptionsconnector
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+const javaStacktrace = `java.lang.IllegalStateException: user 42 not found
+	at com.example.UserService.find(UserService.java:42)
+	at com.example.UserController.get(UserController.java:17)
+	at com.example.UserController$$Lambda$123/0x0000000800c4b040.apply(Unknown Source)
+	at java.base/java.lang.Thread.run(Thread.java:833)`
+
+func TestFingerprint(t *testing.T) {
+	fp := fingerprint("java.lang.IllegalStateException", javaStacktrace, 10)
+	assert.Len(t, fp, 16)
+
+	for _, tc := range []struct {
+		name       string
+		excType    string
+		stacktrace string
+		maxFrames  int
+		same       bool
+	}{
+		{
+			name:       "different message",
+			excType:    "java.lang.IllegalStateException",
+			stacktrace: "java.lang.IllegalStateException: user 7 not found" + javaStacktrace[len("java.lang.IllegalStateException: user 42 not found"):],
+			maxFrames:  10,
+			same:       true,
+		},
+		{
+			name:    "different line numbers and lambda",
+			excType: "java.lang.IllegalStateException",
+			stacktrace: `java.lang.IllegalStateException: user 42 not found
+	at com.example.UserService.find(UserService.java:45)
+	at com.example.UserController.get(UserController.java:18)
+	at com.example.UserController$$Lambda$456/0x0000000800c4c000.apply(Unknown Source)
+	at java.base/java.lang.Thread.run(Thread.java:840)`,
+			maxFrames: 10,
+			same:      true,
+		},
+		{
+			name:       "different type",
+			excType:    "java.lang.IllegalArgumentException",
+			stacktrace: javaStacktrace,
+			maxFrames:  10,
+		},
+		{
+			name:    "different frame",
+			excType: "java.lang.IllegalStateException",
+			stacktrace: `java.lang.IllegalStateException: user 42 not found
+	at com.example.UserService.load(UserService.java:42)
+	at com.example.UserController.get(UserController.java:17)
+	at com.example.UserController$$Lambda$123/0x0000000800c4b040.apply(Unknown Source)
+	at java.base/java.lang.Thread.run(Thread.java:833)`,
+			maxFrames: 10,
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			if tc.same {
+				assert.Equal(t, fp, fingerprint(tc.excType, tc.stacktrace, tc.maxFrames))
+			} else {
+				assert.NotEqual(t, fp, fingerprint(tc.excType, tc.stacktrace, tc.maxFrames))
+			}
+		})
+	}
+}
+
+func TestFingerprintMaxFrames(t *testing.T) {
+	other := `java.lang.IllegalStateException: user 42 not found
+	at com.example.UserService.find(UserService.java:42)
+	at com.example.UserController.get(UserController.java:17)
+	at com.example.OtherController.get(OtherController.java:3)`
+
+	assert.NotEqual(t, fingerprint("E", javaStacktrace, 3), fingerprint("E", other, 3))
+	assert.Equal(t, fingerprint("E", javaStacktrace, 2), fingerprint("E", other, 2), "only the top frames must be considered")
+}
+
+func TestFingerprintFormats(t *testing.T) {
+	for _, tc := range []struct {
+		name  string
+		trace string
+		other string
+	}{
+		{
+			name: "python",
+			trace: `Traceback (most recent call last):
+  File "/app/handler.py", line 12, in handle
+    return parse(value)
+ValueError: invalid literal for int() with base 10: 'abc'`,
+			other: `Traceback (most recent call last):
+  File "/app/handler.py", line 14, in handle
+    return parse(value)
+ValueError: invalid literal for int() with base 10: 'xyz'`,
+		},
+		{
+			name: "javascript",
+			trace: `TypeError: Cannot read properties of undefined (reading 'id')
+    at getUser (/app/users.js:10:15)
+    at /app/server.js:22:5`,
+			other: `TypeError: Cannot read properties of undefined (reading 'name')
+    at getUser (/app/users.js:11:3)
+    at /app/server.js:22:5`,
+		},
+		{
+			name: "go",
+			trace: `goroutine 1 [running]:
+main.handle(0xc000012345)
+	/app/main.go:12 +0x1d
+main.main()
+	/app/main.go:20 +0x25`,
+			other: `goroutine 7 [running]:
+main.handle(0xc000098765)
+	/app/main.go:13 +0x2e
+main.main()
+	/app/main.go:20 +0x25`,
+		},
+		{
+			name:  "unknown format",
+			trace: "something failed at step 3",
+			other: "something failed at step 4",
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			assert.Equal(t, fingerprint("E", tc.trace, 10), fingerprint("E", tc.other, 10))
+		})
+	}
+}
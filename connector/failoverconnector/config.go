@@ -5,6 +5,7 @@ package failoverconnector // import "github.com/open-telemetry/opentelemetry-col
 
 import (
 	"errors"
+	"net/url"
 	"time"
 
 	"go.opentelemetry.io/collector/component"
@@ -13,6 +14,8 @@ import (
 var (
 	errNoPipelinePriority    = errors.New("No pipelines are defined in the priority list")
 	errInvalidRetryIntervals = errors.New("Retry interval must be positive, and retry_interval must be greater than retry_gap times the length of the priority list")
+	errInvalidHealthEndpoint = errors.New("Health check endpoint must be an absolute http or https URL")
+	errInvalidHealthInterval = errors.New("Health check interval must be positive, and stabilization_window must not be negative")
 )
 
 type Config struct {
@@ -33,6 +36,23 @@ type Config struct {
 	// MaxRetry is the maximum retries per level, once this limit is hit for a level, even if the next pipeline level fails,
 	// it will not try to recover the level that exceeded the maximum retries
 	MaxRetries int `mapstructure:"max_retries"`
+
+	// HealthCheck when set replaces the retries of the higher priority levels by probing the health of the primary
+	// level, the connector fails back to the primary level only once it has been healthy for the stabilization window
+	HealthCheck *HealthCheckConfig `mapstructure:"health_check"`
+}
+
+// HealthCheckConfig defines how the health of the primary priority level is probed
+type HealthCheckConfig struct {
+	// Endpoint is the URL probed with a GET request, the primary level is healthy when it responds with a 2xx status,
+	// e.g. the health check extension of the collector exporting the data of the primary level
+	Endpoint string `mapstructure:"endpoint"`
+
+	// Interval is the frequency at which the endpoint is probed, it is also the timeout of a single probe
+	Interval time.Duration `mapstructure:"interval"`
+
+	// StabilizationWindow is how long the primary level must be continuously healthy before failing back to it
+	StabilizationWindow time.Duration `mapstructure:"stabilization_window"`
 }
 
 // Validate needs to ensure RetryInterval > # elements in PriorityList * RetryGap
@@ -46,3 +66,15 @@ func (c *Config) Validate() error {
 	}
 	return nil
 }
+
+// Validate is called for the health check settings only when the health_check section is set
+func (c *HealthCheckConfig) Validate() error {
+	u, err := url.Parse(c.Endpoint)
+	if err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+		return errInvalidHealthEndpoint
+	}
+	if c.Interval <= 0 || c.StabilizationWindow < 0 {
+		return errInvalidHealthInterval
+	}
+	return nil
+}
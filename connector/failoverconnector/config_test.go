@@ -57,6 +57,27 @@ func TestLoadConfig(t *testing.T) {
 				MaxRetries:    10,
 			},
 		},
+		{
+			id: component.NewIDWithName(metadata.Type, "health_check"),
+			expected: &Config{
+				PipelinePriority: [][]component.ID{
+					{
+						component.NewIDWithName(component.DataTypeTraces, "first"),
+					},
+					{
+						component.NewIDWithName(component.DataTypeTraces, "second"),
+					},
+				},
+				RetryInterval: 10 * time.Minute,
+				RetryGap:      30 * time.Second,
+				MaxRetries:    10,
+				HealthCheck: &HealthCheckConfig{
+					Endpoint:            "http://localhost:13133/",
+					Interval:            10 * time.Second,
+					StabilizationWindow: 2 * time.Minute,
+				},
+			},
+		},
 	}
 
 	for _, tc := range testcases {
@@ -93,6 +114,16 @@ func TestValidateConfig(t *testing.T) {
 			id:   component.NewIDWithName(metadata.Type, "invalid"),
 			err:  errInvalidRetryIntervals,
 		},
+		{
+			name: "invalid health check endpoint",
+			id:   component.NewIDWithName(metadata.Type, "invalid_health_endpoint"),
+			err:  errInvalidHealthEndpoint,
+		},
+		{
+			name: "invalid health check interval",
+			id:   component.NewIDWithName(metadata.Type, "invalid_health_interval"),
+			err:  errInvalidHealthInterval,
+		},
 	}
 
 	for _, tc := range testcases {
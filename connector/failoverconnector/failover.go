@@ -37,8 +37,15 @@ func newFailoverRouter[C any](provider consumerProvider[C], cfg *Config) *failov
 		RetryGap:      cfg.RetryGap,
 		MaxRetries:    cfg.MaxRetries,
 	}
+	if cfg.HealthCheck != nil {
+		pSConstants.HealthCheckInterval = cfg.HealthCheck.Interval
+		pSConstants.StabilizationWindow = cfg.HealthCheck.StabilizationWindow
+	}
 
 	selector := state.NewPipelineSelector(len(cfg.PipelinePriority), pSConstants)
+	if cfg.HealthCheck != nil {
+		selector.EnableHealthFailback(newHTTPHealthProbe(cfg.HealthCheck.Endpoint))
+	}
 	selector.Start(done, &wg)
 	return &failoverRouter[C]{
 		consumerProvider: provider,
@@ -4,6 +4,9 @@
 package failoverconnector // import "github.com/open-telemetry/opentelemetry-collector-contrib/connector/failoverconnector"
 import (
 	"context"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
 	"testing"
 	"time"
 
@@ -224,3 +227,78 @@ func resetConsumers(conn *tracesFailover, consumers ...consumer.Traces) {
 	}
 	conn.failover.pS.TestSetStableIndex(0)
 }
+
+func TestFailoverHealthCheckFailback(t *testing.T) {
+	var sinkFirst, sinkSecond consumertest.TracesSink
+	tracesFirst := component.NewIDWithName(component.DataTypeTraces, "traces/first")
+	tracesSecond := component.NewIDWithName(component.DataTypeTraces, "traces/second")
+
+	var healthy atomic.Bool
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		if healthy.Load() {
+			w.WriteHeader(http.StatusOK)
+			return
+		}
+		w.WriteHeader(http.StatusServiceUnavailable)
+	}))
+	defer server.Close()
+
+	cfg := &Config{
+		PipelinePriority: [][]component.ID{{tracesFirst}, {tracesSecond}},
+		RetryInterval:    50 * time.Millisecond,
+		RetryGap:         10 * time.Millisecond,
+		MaxRetries:       10000,
+		HealthCheck: &HealthCheckConfig{
+			Endpoint:            server.URL,
+			Interval:            10 * time.Millisecond,
+			StabilizationWindow: 50 * time.Millisecond,
+		},
+	}
+
+	router := connector.NewTracesRouter(map[component.ID]consumer.Traces{
+		tracesFirst:  &sinkFirst,
+		tracesSecond: &sinkSecond,
+	})
+
+	conn, err := NewFactory().CreateTracesToTraces(context.Background(),
+		connectortest.NewNopCreateSettings(), cfg, router.(consumer.Traces))
+	require.NoError(t, err)
+
+	failoverConnector := conn.(*tracesFailover)
+	defer func() {
+		assert.NoError(t, failoverConnector.Shutdown(context.Background()))
+	}()
+
+	tr := sampleTrace()
+	failoverConnector.failover.ModifyConsumerAtIndex(0, consumertest.NewErr(errTracesConsumer))
+	require.NoError(t, conn.ConsumeTraces(context.Background(), tr))
+	require.Equal(t, 1, failoverConnector.failover.pS.TestStableIndex())
+
+	// The primary pipeline recovered, but is not retried as long as its endpoint reports it unhealthy
+	failoverConnector.failover.ModifyConsumerAtIndex(0, &sinkFirst)
+	time.Sleep(150 * time.Millisecond)
+	require.NoError(t, conn.ConsumeTraces(context.Background(), tr))
+	require.Equal(t, 1, failoverConnector.failover.pS.TestCurrentIndex())
+	require.Zero(t, sinkFirst.SpanCount())
+
+	healthy.Store(true)
+	require.Eventually(t, func() bool {
+		return consumeTracesAndCheckStable(failoverConnector, 0, tr)
+	}, 3*time.Second, 5*time.Millisecond)
+}
+
+func TestHTTPHealthProbe(t *testing.T) {
+	status := http.StatusOK
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		w.WriteHeader(status)
+	}))
+	defer server.Close()
+
+	probe := newHTTPHealthProbe(server.URL)
+	assert.True(t, probe(context.Background()))
+
+	status = http.StatusInternalServerError
+	assert.False(t, probe(context.Background()))
+
+	assert.False(t, newHTTPHealthProbe("http://localhost:0")(context.Background()))
+}
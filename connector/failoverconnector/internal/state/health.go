@@ -0,0 +1,64 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package state // import "github.com/open-telemetry/opentelemetry-collector-contrib/connector/failoverconnector/internal/state"
+
+import (
+	"context"
+	"sync"
+	"time"
+)
+
+// HealthProbe reports whether the primary priority level is healthy
+type HealthProbe func(ctx context.Context) bool
+
+// EnableHealthFailback replaces the retry based recovery of the higher priority levels by probing the health of
+// the primary level, the selector fails back to it once it has been healthy for the whole stabilization window
+func (p *PipelineSelector) EnableHealthFailback(probe HealthProbe) {
+	p.probe = probe
+}
+
+// monitorPrimaryHealth probes the primary level every HealthCheckInterval while a lower level is stable
+func (p *PipelineSelector) monitorPrimaryHealth(done chan struct{}, wg *sync.WaitGroup) {
+	defer wg.Done()
+	ticker := time.NewTicker(p.constants.HealthCheckInterval)
+	defer ticker.Stop()
+
+	var healthySince time.Time
+	for {
+		select {
+		case <-done:
+			return
+		case <-ticker.C:
+			if p.loadStable() == 0 {
+				healthySince = time.Time{}
+				continue
+			}
+			if !p.probePrimary() {
+				healthySince = time.Time{}
+				continue
+			}
+			now := time.Now()
+			if healthySince.IsZero() {
+				healthySince = now
+			}
+			if now.Sub(healthySince) >= p.constants.StabilizationWindow {
+				p.failback()
+				healthySince = time.Time{}
+			}
+		}
+	}
+}
+
+func (p *PipelineSelector) probePrimary() bool {
+	ctx, cancel := context.WithTimeout(context.Background(), p.constants.HealthCheckInterval)
+	defer cancel()
+	return p.probe(ctx)
+}
+
+// failback makes the primary level the stable and current level again
+func (p *PipelineSelector) failback() {
+	p.resetRetryCount(0)
+	p.stableIndex.Store(0)
+	p.currentIndex.Store(0)
+}
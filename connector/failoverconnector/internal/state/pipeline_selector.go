@@ -22,6 +22,7 @@ type PipelineSelector struct {
 	errTryLock    *TryLock
 	stableTryLock *TryLock
 	chans         []chan bool
+	probe         HealthProbe
 }
 
 func (p *PipelineSelector) handlePipelineError(idx int) {
@@ -30,7 +31,7 @@ func (p *PipelineSelector) handlePipelineError(idx int) {
 	}
 	doRetry := p.indexIsStable(idx)
 	p.updatePipelineIndex(idx)
-	if !doRetry {
+	if !doRetry || p.probe != nil {
 		return
 	}
 	ctx, cancel := context.WithCancel(context.Background())
@@ -193,6 +194,10 @@ func NewPipelineSelector(lenPriority int, consts PSConstants) *PipelineSelector
 func (p *PipelineSelector) Start(done chan struct{}, wg *sync.WaitGroup) {
 	wg.Add(1)
 	go p.ListenToChannels(done, wg)
+	if p.probe != nil {
+		wg.Add(1)
+		go p.monitorPrimaryHealth(done, wg)
+	}
 }
 
 func (p *PipelineSelector) ListenToChannels(done chan struct{}, wg *sync.WaitGroup) {
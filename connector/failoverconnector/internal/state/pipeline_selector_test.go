@@ -6,6 +6,7 @@ package state
 import (
 	"context"
 	"sync"
+	"sync/atomic"
 	"testing"
 	"time"
 
@@ -84,3 +85,48 @@ func TestCurrentPipelineWithRetry(t *testing.T) {
 		return idx == 0
 	}, 3*time.Second, 5*time.Millisecond)
 }
+
+func TestHealthFailback(t *testing.T) {
+	var wg sync.WaitGroup
+	done := make(chan struct{})
+	constants := PSConstants{
+		RetryInterval:       50 * time.Millisecond,
+		RetryGap:            10 * time.Millisecond,
+		MaxRetries:          1000,
+		HealthCheckInterval: 5 * time.Millisecond,
+		StabilizationWindow: 50 * time.Millisecond,
+	}
+	pS := NewPipelineSelector(3, constants)
+
+	var healthy, probed atomic.Bool
+	var healthySince atomic.Int64
+	pS.EnableHealthFailback(func(context.Context) bool {
+		probed.Store(true)
+		return healthy.Load()
+	})
+	pS.Start(done, &wg)
+	defer func() {
+		close(done)
+		wg.Wait()
+	}()
+
+	_, ch := pS.SelectedPipeline()
+	ch <- false
+	require.Eventually(t, func() bool {
+		return pS.TestStableIndex() == 1
+	}, 3*time.Second, 5*time.Millisecond)
+
+	// The primary level is not retried while unhealthy
+	require.Eventually(t, probed.Load, 3*time.Second, 5*time.Millisecond)
+	time.Sleep(100 * time.Millisecond)
+	require.Equal(t, 1, pS.TestCurrentIndex())
+
+	healthySince.Store(time.Now().UnixNano())
+	healthy.Store(true)
+	require.Eventually(t, func() bool {
+		idx, _ := pS.SelectedPipeline()
+		return idx == 0
+	}, 3*time.Second, 5*time.Millisecond)
+	require.GreaterOrEqual(t, time.Since(time.Unix(0, healthySince.Load())), constants.StabilizationWindow)
+	require.Equal(t, 0, pS.TestStableIndex())
+}
@@ -13,6 +13,9 @@ type PSConstants struct {
 	RetryInterval time.Duration
 	RetryGap      time.Duration
 	MaxRetries    int
+
+	HealthCheckInterval time.Duration
+	StabilizationWindow time.Duration
 }
 
 type TryLock struct {
@@ -0,0 +1,102 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package logalertconnector // import "github.com/open-telemetry/opentelemetry-collector-contrib/connector/logalertconnector"
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	"go.opentelemetry.io/collector/component"
+	"go.opentelemetry.io/collector/pdata/plog"
+	"go.uber.org/zap"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/internal/filter/filterottl"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl"
+)
+
+const defaultSeverity = "WARN"
+
+// Config for the connector
+type Config struct {
+	// Rules are the alerting rules evaluated against every log record.
+	Rules []RuleConfig `mapstructure:"rules"`
+}
+
+// RuleConfig defines a single threshold rule.
+type RuleConfig struct {
+	// Name identifies the rule. It is used as the metric name and the
+	// value of the alert.name attribute on emitted events.
+	Name string `mapstructure:"name"`
+	// Description is attached to emitted metrics and log events.
+	Description string `mapstructure:"description"`
+	// Conditions are OTTL log conditions; a record matches if any of them is true.
+	// A rule without conditions matches every record.
+	Conditions []string `mapstructure:"conditions"`
+	// GroupBy lists the attribute keys used to group matching records. Keys are
+	// looked up on the log record first and on the resource second. Records
+	// missing any of the keys are not counted.
+	GroupBy []string `mapstructure:"group_by"`
+	// Threshold is the number of matching records within Window that fires the alert.
+	Threshold int `mapstructure:"threshold"`
+	// Window is the length of the sliding window.
+	Window time.Duration `mapstructure:"window"`
+	// Severity is the severity text of emitted alert log records.
+	Severity string `mapstructure:"severity"`
+}
+
+func (c *Config) Validate() error {
+	if len(c.Rules) == 0 {
+		return errors.New("at least one rule must be configured")
+	}
+	names := make(map[string]struct{}, len(c.Rules))
+	for _, rule := range c.Rules {
+		if rule.Name == "" {
+			return errors.New("rules: name missing")
+		}
+		if _, ok := names[rule.Name]; ok {
+			return fmt.Errorf("rules: duplicate rule name %q", rule.Name)
+		}
+		names[rule.Name] = struct{}{}
+		if rule.Threshold <= 0 {
+			return fmt.Errorf("rule %q: threshold must be greater than zero", rule.Name)
+		}
+		if rule.Window <= 0 {
+			return fmt.Errorf("rule %q: window must be greater than zero", rule.Name)
+		}
+		if rule.Severity != "" {
+			if severityNumber(rule.Severity) == plog.SeverityNumberUnspecified {
+				return fmt.Errorf("rule %q: unknown severity %q", rule.Name, rule.Severity)
+			}
+		}
+		for _, key := range rule.GroupBy {
+			if key == "" {
+				return fmt.Errorf("rule %q: group_by key missing", rule.Name)
+			}
+		}
+		if _, err := filterottl.NewBoolExprForLog(rule.Conditions, filterottl.StandardLogFuncs(), ottl.PropagateError, component.TelemetrySettings{Logger: zap.NewNop()}); err != nil {
+			return fmt.Errorf("rule %q condition: %w", rule.Name, err)
+		}
+	}
+	return nil
+}
+
+// severityNumber maps the short severity text to its number.
+func severityNumber(text string) plog.SeverityNumber {
+	switch text {
+	case "TRACE":
+		return plog.SeverityNumberTrace
+	case "DEBUG":
+		return plog.SeverityNumberDebug
+	case "INFO":
+		return plog.SeverityNumberInfo
+	case "WARN":
+		return plog.SeverityNumberWarn
+	case "ERROR":
+		return plog.SeverityNumberError
+	case "FATAL":
+		return plog.SeverityNumberFatal
+	}
+	return plog.SeverityNumberUnspecified
+}
@@ -0,0 +1,77 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package logalertconnector
+
+import (
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/collector/component"
+	"go.opentelemetry.io/collector/confmap/confmaptest"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/connector/logalertconnector/internal/metadata"
+)
+
+func TestLoadConfig(t *testing.T) {
+	cm, err := confmaptest.LoadConf(filepath.Join("testdata", "config.yaml"))
+	require.NoError(t, err)
+
+	factory := NewFactory()
+	cfg := factory.CreateDefaultConfig()
+	sub, err := cm.Sub(component.NewID(metadata.Type).String())
+	require.NoError(t, err)
+	require.NoError(t, sub.Unmarshal(cfg))
+
+	assert.NoError(t, component.ValidateConfig(cfg))
+	assert.Equal(t, &Config{
+		Rules: []RuleConfig{
+			{
+				Name:        "login.failures",
+				Description: "Too many failed logins for a user.",
+				Conditions:  []string{`attributes["event.name"] == "login.failed"`},
+				GroupBy:     []string{"user.name", "service.name"},
+				Threshold:   5,
+				Window:      2 * time.Minute,
+				Severity:    "ERROR",
+			},
+			{
+				Name:       "errors",
+				Conditions: []string{"severity_number >= SEVERITY_NUMBER_ERROR"},
+				Threshold:  100,
+				Window:     time.Minute,
+			},
+		},
+	}, cfg)
+}
+
+func TestValidateConfig(t *testing.T) {
+	testCases := []struct {
+		name   string
+		expect string
+	}{
+		{name: "empty", expect: "at least one rule must be configured"},
+		{name: "bad_threshold", expect: `rule "errors": threshold must be greater than zero`},
+		{name: "bad_window", expect: `rule "errors": window must be greater than zero`},
+		{name: "duplicate_name", expect: `rules: duplicate rule name "errors"`},
+		{name: "bad_condition", expect: `rule "errors" condition:`},
+		{name: "bad_severity", expect: `rule "errors": unknown severity "LOUD"`},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			cm, err := confmaptest.LoadConf(filepath.Join("testdata", "config.yaml"))
+			require.NoError(t, err)
+
+			cfg := NewFactory().CreateDefaultConfig()
+			sub, err := cm.Sub(component.NewIDWithName(metadata.Type, tc.name).String())
+			require.NoError(t, err)
+			require.NoError(t, sub.Unmarshal(cfg))
+
+			assert.ErrorContains(t, component.ValidateConfig(cfg), tc.expect)
+		})
+	}
+}
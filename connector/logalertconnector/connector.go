@@ -0,0 +1,103 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package logalertconnector // import "github.com/open-telemetry/opentelemetry-collector-contrib/connector/logalertconnector"
+
+import (
+	"context"
+	"fmt"
+
+	"go.opentelemetry.io/collector/component"
+	"go.opentelemetry.io/collector/consumer"
+	"go.opentelemetry.io/collector/pdata/pcommon"
+	"go.opentelemetry.io/collector/pdata/plog"
+	"go.opentelemetry.io/collector/pdata/pmetric"
+)
+
+const (
+	scopeName = "otelcol/logalertconnector"
+
+	attrAlertName      = "alert.name"
+	attrAlertCount     = "alert.count"
+	attrAlertThreshold = "alert.threshold"
+	attrAlertWindow    = "alert.window"
+)
+
+// logAlert evaluates threshold rules over log records and emits the fired
+// alerts either as log events or as metrics.
+type logAlert struct {
+	evaluator       *evaluator
+	logsConsumer    consumer.Logs
+	metricsConsumer consumer.Metrics
+	component.StartFunc
+	component.ShutdownFunc
+}
+
+func (c *logAlert) Capabilities() consumer.Capabilities {
+	return consumer.Capabilities{MutatesData: false}
+}
+
+func (c *logAlert) ConsumeLogs(ctx context.Context, ld plog.Logs) error {
+	alerts, err := c.evaluator.evaluate(ctx, ld)
+	if err != nil {
+		return err
+	}
+	if len(alerts) == 0 {
+		return nil
+	}
+	if c.logsConsumer != nil {
+		return c.logsConsumer.ConsumeLogs(ctx, alertsToLogs(alerts))
+	}
+	return c.metricsConsumer.ConsumeMetrics(ctx, alertsToMetrics(alerts))
+}
+
+func alertsToLogs(alerts []alert) plog.Logs {
+	ld := plog.NewLogs()
+	for _, a := range alerts {
+		rl := ld.ResourceLogs().AppendEmpty()
+		a.resource.CopyTo(rl.Resource())
+		sl := rl.ScopeLogs().AppendEmpty()
+		sl.Scope().SetName(scopeName)
+
+		lr := sl.LogRecords().AppendEmpty()
+		ts := pcommon.NewTimestampFromTime(a.timestamp)
+		lr.SetTimestamp(ts)
+		lr.SetObservedTimestamp(ts)
+		lr.SetSeverityText(a.rule.severity)
+		lr.SetSeverityNumber(severityNumber(a.rule.severity))
+		if a.rule.description != "" {
+			lr.Body().SetStr(a.rule.description)
+		} else {
+			lr.Body().SetStr(fmt.Sprintf("%s: %d matching log records within %s", a.rule.name, a.count, a.rule.window))
+		}
+		a.attrs.CopyTo(lr.Attributes())
+		lr.Attributes().PutStr(attrAlertName, a.rule.name)
+		lr.Attributes().PutInt(attrAlertCount, int64(a.count))
+		lr.Attributes().PutInt(attrAlertThreshold, int64(a.rule.threshold))
+		lr.Attributes().PutStr(attrAlertWindow, a.rule.window.String())
+	}
+	return ld
+}
+
+func alertsToMetrics(alerts []alert) pmetric.Metrics {
+	md := pmetric.NewMetrics()
+	for _, a := range alerts {
+		rm := md.ResourceMetrics().AppendEmpty()
+		a.resource.CopyTo(rm.Resource())
+		sm := rm.ScopeMetrics().AppendEmpty()
+		sm.Scope().SetName(scopeName)
+
+		m := sm.Metrics().AppendEmpty()
+		m.SetName(a.rule.name)
+		m.SetDescription(a.rule.description)
+		sum := m.SetEmptySum()
+		// Every data point represents a single firing, so a value accumulated downstream is monotonic
+		sum.SetIsMonotonic(true)
+		sum.SetAggregationTemporality(pmetric.AggregationTemporalityDelta)
+		dp := sum.DataPoints().AppendEmpty()
+		a.attrs.CopyTo(dp.Attributes())
+		dp.SetIntValue(1)
+		dp.SetTimestamp(pcommon.NewTimestampFromTime(a.timestamp))
+	}
+	return md
+}
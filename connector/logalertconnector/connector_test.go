@@ -0,0 +1,131 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package logalertconnector
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/collector/connector/connectortest"
+	"go.opentelemetry.io/collector/consumer/consumertest"
+	"go.opentelemetry.io/collector/pdata/plog"
+	"go.opentelemetry.io/collector/pdata/pmetric"
+)
+
+func testConfig() *Config {
+	return &Config{
+		Rules: []RuleConfig{
+			{
+				Name:       "login.failures",
+				Conditions: []string{`attributes["event.name"] == "login.failed"`},
+				GroupBy:    []string{"user.name", "service.name"},
+				Threshold:  3,
+				Window:     time.Minute,
+			},
+		},
+	}
+}
+
+func testLogs(service string, users ...string) plog.Logs {
+	ld := plog.NewLogs()
+	rl := ld.ResourceLogs().AppendEmpty()
+	rl.Resource().Attributes().PutStr("service.name", service)
+	sl := rl.ScopeLogs().AppendEmpty()
+	for _, user := range users {
+		lr := sl.LogRecords().AppendEmpty()
+		lr.Attributes().PutStr("event.name", "login.failed")
+		lr.Attributes().PutStr("user.name", user)
+	}
+	// never matches the condition
+	lr := sl.LogRecords().AppendEmpty()
+	lr.Attributes().PutStr("event.name", "login.succeeded")
+	lr.Attributes().PutStr("user.name", "alice")
+	return ld
+}
+
+func TestLogsToLogs(t *testing.T) {
+	require.NoError(t, testConfig().Validate())
+
+	sink := &consumertest.LogsSink{}
+	conn, err := NewFactory().CreateLogsToLogs(context.Background(), connectortest.NewNopCreateSettings(), testConfig(), sink)
+	require.NoError(t, err)
+
+	now := time.Unix(1700000000, 0)
+	conn.(*logAlert).evaluator.now = func() time.Time { return now }
+
+	require.NoError(t, conn.ConsumeLogs(context.Background(), testLogs("auth", "alice", "alice", "bob")))
+	assert.Empty(t, sink.AllLogs())
+
+	now = now.Add(30 * time.Second)
+	require.NoError(t, conn.ConsumeLogs(context.Background(), testLogs("auth", "alice", "bob")))
+	require.Len(t, sink.AllLogs(), 1)
+
+	ld := sink.AllLogs()[0]
+	require.Equal(t, 1, ld.LogRecordCount())
+	rl := ld.ResourceLogs().At(0)
+	assert.Equal(t, scopeName, rl.ScopeLogs().At(0).Scope().Name())
+	lr := rl.ScopeLogs().At(0).LogRecords().At(0)
+	assert.Equal(t, defaultSeverity, lr.SeverityText())
+	assert.Equal(t, plog.SeverityNumberWarn, lr.SeverityNumber())
+	assert.Equal(t, "login.failures: 3 matching log records within 1m0s", lr.Body().Str())
+	assert.Equal(t, map[string]any{
+		"user.name":        "alice",
+		"service.name":     "auth",
+		attrAlertName:      "login.failures",
+		attrAlertCount:     int64(3),
+		attrAlertThreshold: int64(3),
+		attrAlertWindow:    "1m0s",
+	}, lr.Attributes().AsRaw())
+
+	// bob's first failure falls out of the window, so two more are needed.
+	now = now.Add(45 * time.Second)
+	require.NoError(t, conn.ConsumeLogs(context.Background(), testLogs("auth", "bob")))
+	assert.Len(t, sink.AllLogs(), 1)
+	require.NoError(t, conn.ConsumeLogs(context.Background(), testLogs("auth", "bob")))
+	assert.Len(t, sink.AllLogs(), 2)
+
+	// alice's window was reset when the alert fired.
+	require.NoError(t, conn.ConsumeLogs(context.Background(), testLogs("auth", "alice")))
+	assert.Len(t, sink.AllLogs(), 2)
+}
+
+func TestLogsToMetrics(t *testing.T) {
+	sink := &consumertest.MetricsSink{}
+	conn, err := NewFactory().CreateLogsToMetrics(context.Background(), connectortest.NewNopCreateSettings(), testConfig(), sink)
+	require.NoError(t, err)
+
+	require.NoError(t, conn.ConsumeLogs(context.Background(), testLogs("auth", "alice", "alice", "alice", "bob")))
+	require.NoError(t, conn.ConsumeLogs(context.Background(), testLogs("billing", "alice", "alice", "alice")))
+	require.Len(t, sink.AllMetrics(), 2)
+
+	for i, service := range []string{"auth", "billing"} {
+		md := sink.AllMetrics()[i]
+		require.Equal(t, 1, md.DataPointCount())
+		rm := md.ResourceMetrics().At(0)
+		m := rm.ScopeMetrics().At(0).Metrics().At(0)
+		assert.Equal(t, "login.failures", m.Name())
+		assert.Equal(t, pmetric.AggregationTemporalityDelta, m.Sum().AggregationTemporality())
+		dp := m.Sum().DataPoints().At(0)
+		assert.Equal(t, int64(1), dp.IntValue())
+		assert.Equal(t, map[string]any{"user.name": "alice", "service.name": service}, dp.Attributes().AsRaw())
+	}
+}
+
+func TestGroupSweep(t *testing.T) {
+	conn, err := NewFactory().CreateLogsToLogs(context.Background(), connectortest.NewNopCreateSettings(), testConfig(), consumertest.NewNop())
+	require.NoError(t, err)
+	e := conn.(*logAlert).evaluator
+
+	now := time.Unix(1700000000, 0)
+	e.now = func() time.Time { return now }
+	require.NoError(t, conn.ConsumeLogs(context.Background(), testLogs("auth", "alice", "bob")))
+	assert.Len(t, e.groups[0], 2)
+
+	now = now.Add(2 * time.Minute)
+	require.NoError(t, conn.ConsumeLogs(context.Background(), plog.NewLogs()))
+	assert.Empty(t, e.groups[0])
+}
@@ -0,0 +1,6 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+// Package logalertconnector evaluates OTTL conditions over log streams in
+// sliding time windows and emits alert events when a threshold is reached.
+package logalertconnector // import "github.com/open-telemetry/opentelemetry-collector-contrib/connector/logalertconnector"
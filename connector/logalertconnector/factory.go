@@ -0,0 +1,83 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+//go:generate mdatagen metadata.yaml
+
+package logalertconnector // import "github.com/open-telemetry/opentelemetry-collector-contrib/connector/logalertconnector"
+
+import (
+	"context"
+
+	"go.opentelemetry.io/collector/component"
+	"go.opentelemetry.io/collector/connector"
+	"go.opentelemetry.io/collector/consumer"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/connector/logalertconnector/internal/metadata"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/internal/filter/filterottl"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl"
+)
+
+// NewFactory returns a ConnectorFactory.
+func NewFactory() connector.Factory {
+	return connector.NewFactory(
+		metadata.Type,
+		createDefaultConfig,
+		connector.WithLogsToLogs(createLogsToLogs, metadata.LogsToLogsStability),
+		connector.WithLogsToMetrics(createLogsToMetrics, metadata.LogsToMetricsStability),
+	)
+}
+
+// createDefaultConfig creates the default configuration.
+func createDefaultConfig() component.Config {
+	return &Config{}
+}
+
+// createLogsToLogs creates a logs to logs connector based on provided config.
+func createLogsToLogs(
+	_ context.Context,
+	set connector.CreateSettings,
+	cfg component.Config,
+	nextConsumer consumer.Logs,
+) (connector.Logs, error) {
+	return &logAlert{
+		evaluator:    newEvaluator(buildRules(cfg.(*Config), set)),
+		logsConsumer: nextConsumer,
+	}, nil
+}
+
+// createLogsToMetrics creates a logs to metrics connector based on provided config.
+func createLogsToMetrics(
+	_ context.Context,
+	set connector.CreateSettings,
+	cfg component.Config,
+	nextConsumer consumer.Metrics,
+) (connector.Logs, error) {
+	return &logAlert{
+		evaluator:       newEvaluator(buildRules(cfg.(*Config), set)),
+		metricsConsumer: nextConsumer,
+	}, nil
+}
+
+func buildRules(c *Config, set connector.CreateSettings) []*rule {
+	rules := make([]*rule, 0, len(c.Rules))
+	for _, rc := range c.Rules {
+		r := &rule{
+			name:        rc.Name,
+			description: rc.Description,
+			groupBy:     rc.GroupBy,
+			threshold:   rc.Threshold,
+			window:      rc.Window,
+			severity:    rc.Severity,
+		}
+		if r.severity == "" {
+			r.severity = defaultSeverity
+		}
+		if len(rc.Conditions) > 0 {
+			// Error checked in Config.Validate()
+			condition, _ := filterottl.NewBoolExprForLog(rc.Conditions, filterottl.StandardLogFuncs(), ottl.PropagateError, set.TelemetrySettings)
+			r.condition = condition
+		}
+		rules = append(rules, r)
+	}
+	return rules
+}
@@ -0,0 +1,171 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package logalertconnector // import "github.com/open-telemetry/opentelemetry-collector-contrib/connector/logalertconnector"
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"go.opentelemetry.io/collector/pdata/pcommon"
+	"go.opentelemetry.io/collector/pdata/plog"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/internal/filter/expr"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl/contexts/ottllog"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/pdatautil"
+)
+
+type rule struct {
+	name        string
+	description string
+	condition   expr.BoolExpr[ottllog.TransformContext]
+	groupBy     []string
+	threshold   int
+	window      time.Duration
+	severity    string
+}
+
+// alert is produced each time a rule fires for a group.
+type alert struct {
+	rule      *rule
+	attrs     pcommon.Map
+	resource  pcommon.Resource
+	count     int
+	timestamp time.Time
+}
+
+// group keeps the arrival times of the most recent matching records. Only the
+// last threshold timestamps are needed to decide whether the rule fires.
+type group struct {
+	times []time.Time
+}
+
+// observe records a match at now and reports whether the group has reached
+// the threshold within the window. A fired group is reset so that the next
+// alert requires threshold new matches.
+func (g *group) observe(now time.Time, r *rule) bool {
+	cutoff := now.Add(-r.window)
+	i := 0
+	for i < len(g.times) && !g.times[i].After(cutoff) {
+		i++
+	}
+	g.times = append(g.times[i:], now)
+	if len(g.times) < r.threshold {
+		return false
+	}
+	g.times = g.times[:0]
+	return true
+}
+
+func (g *group) expired(now time.Time, r *rule) bool {
+	return len(g.times) == 0 || !g.times[len(g.times)-1].After(now.Add(-r.window))
+}
+
+// evaluator holds the window state of all rules. It is safe for concurrent use.
+type evaluator struct {
+	rules []*rule
+	now   func() time.Time
+
+	mu        sync.Mutex
+	groups    []map[[16]byte]*group
+	lastSweep time.Time
+	sweepIvl  time.Duration
+}
+
+func newEvaluator(rules []*rule) *evaluator {
+	e := &evaluator{
+		rules:  rules,
+		now:    time.Now,
+		groups: make([]map[[16]byte]*group, len(rules)),
+	}
+	for i, r := range rules {
+		e.groups[i] = make(map[[16]byte]*group)
+		if e.sweepIvl == 0 || r.window < e.sweepIvl {
+			e.sweepIvl = r.window
+		}
+	}
+	return e
+}
+
+// evaluate runs all rules against the given logs and returns the alerts fired.
+func (e *evaluator) evaluate(ctx context.Context, ld plog.Logs) ([]alert, error) {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+
+	now := e.now()
+	e.sweep(now)
+
+	var alerts []alert
+	for i := 0; i < ld.ResourceLogs().Len(); i++ {
+		resourceLog := ld.ResourceLogs().At(i)
+		for j := 0; j < resourceLog.ScopeLogs().Len(); j++ {
+			scopeLogs := resourceLog.ScopeLogs().At(j)
+			for k := 0; k < scopeLogs.LogRecords().Len(); k++ {
+				logRecord := scopeLogs.LogRecords().At(k)
+				lCtx := ottllog.NewTransformContext(logRecord, scopeLogs.Scope(), resourceLog.Resource())
+				for ri, r := range e.rules {
+					if r.condition != nil {
+						match, err := r.condition.Eval(ctx, lCtx)
+						if err != nil {
+							return nil, err
+						}
+						if !match {
+							continue
+						}
+					}
+					attrs, ok := groupAttributes(r.groupBy, logRecord.Attributes(), resourceLog.Resource().Attributes())
+					if !ok {
+						continue
+					}
+					key := pdatautil.MapHash(attrs)
+					g, ok := e.groups[ri][key]
+					if !ok {
+						g = &group{}
+						e.groups[ri][key] = g
+					}
+					if g.observe(now, r) {
+						alerts = append(alerts, alert{
+							rule:      r,
+							attrs:     attrs,
+							resource:  resourceLog.Resource(),
+							count:     r.threshold,
+							timestamp: now,
+						})
+					}
+				}
+			}
+		}
+	}
+	return alerts, nil
+}
+
+// sweep drops groups that have not seen a match within their window.
+func (e *evaluator) sweep(now time.Time) {
+	if now.Sub(e.lastSweep) < e.sweepIvl {
+		return
+	}
+	e.lastSweep = now
+	for ri, r := range e.rules {
+		for key, g := range e.groups[ri] {
+			if g.expired(now, r) {
+				delete(e.groups[ri], key)
+			}
+		}
+	}
+}
+
+func groupAttributes(keys []string, recordAttrs, resourceAttrs pcommon.Map) (pcommon.Map, bool) {
+	attrs := pcommon.NewMap()
+	attrs.EnsureCapacity(len(keys))
+	for _, key := range keys {
+		v, ok := recordAttrs.Get(key)
+		if !ok {
+			if v, ok = resourceAttrs.Get(key); !ok {
+				return attrs, false
+			}
+		}
+		v.CopyTo(attrs.PutEmpty(key))
+	}
+	return attrs, true
+}
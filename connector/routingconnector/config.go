@@ -16,6 +16,13 @@ var (
 	errNoPipelines        = errors.New("invalid route: no pipelines defined")
 	errUnexpectedConsumer = errors.New("expected consumer to be a connector router")
 	errNoTableItems       = errors.New("invalid routing table: the routing table is empty")
+	errInvalidContext     = errors.New("invalid route: the context must be \"resource\" or \"datapoint\"")
+	errDataPointContext   = errors.New("invalid route: the \"datapoint\" context is only supported for metrics")
+)
+
+const (
+	resourceContext  = "resource"
+	dataPointContext = "datapoint"
 )
 
 // Config defines configuration for the Routing processor.
@@ -62,6 +69,10 @@ func (c *Config) Validate() error {
 		if len(item.Pipelines) == 0 {
 			return errNoPipelines
 		}
+
+		if item.Context != "" && item.Context != resourceContext && item.Context != dataPointContext {
+			return errInvalidContext
+		}
 	}
 
 	return nil
@@ -73,6 +84,12 @@ type RoutingTableItem struct {
 	// Required when 'Value' isn't provided.
 	Statement string `mapstructure:"statement"`
 
+	// Context is the OTTL context the statement is evaluated in. Valid values are `resource` and
+	// `datapoint`. `datapoint` is only supported for metrics, each data point being routed on its
+	// own, e.g. on its attributes, splitting the metrics across pipelines.
+	// The default value is `resource`.
+	Context string `mapstructure:"context"`
+
 	// Pipelines contains the list of pipelines to use when the value from the FromAttribute field
 	// matches this table item. When no pipelines are specified, the ones specified under
 	// DefaultPipelines are used, if any.
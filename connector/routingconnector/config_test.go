@@ -69,6 +69,13 @@ func TestLoadConfig(t *testing.T) {
 							component.NewIDWithName(component.DataTypeMetrics, "otlp-globex"),
 						},
 					},
+					{
+						Statement: `route() where attributes["deployment.environment"] == "production"`,
+						Context:   "datapoint",
+						Pipelines: []component.ID{
+							component.NewIDWithName(component.DataTypeMetrics, "otlp-production"),
+						},
+					},
 				},
 			},
 		},
@@ -156,6 +163,21 @@ func TestValidateConfig(t *testing.T) {
 			},
 			error: "invalid routing table: the routing table is empty",
 		},
+		{
+			name: "invalid context",
+			config: &Config{
+				Table: []RoutingTableItem{
+					{
+						Statement: `route() where attributes["attr"] == "acme"`,
+						Context:   "span",
+						Pipelines: []component.ID{
+							component.NewIDWithName(component.DataTypeTraces, "otlp"),
+						},
+					},
+				},
+			},
+			error: "invalid route: the context must be \"resource\" or \"datapoint\"",
+		},
 		{
 			name:   "empty config",
 			config: &Config{},
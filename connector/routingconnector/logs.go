@@ -48,6 +48,10 @@ func newLogsConnector(
 		return nil, err
 	}
 
+	if r.hasDataPointRoutes() {
+		return nil, errDataPointContext
+	}
+
 	return &logsConnector{
 		logger: set.TelemetrySettings.Logger,
 		config: cfg,
@@ -465,3 +465,26 @@ func TestLogsConnectorCapabilities(t *testing.T) {
 	require.NoError(t, err)
 	assert.Equal(t, false, conn.Capabilities().MutatesData)
 }
+
+func TestLogsDataPointContextNotSupported(t *testing.T) {
+	logs0 := component.NewIDWithName(component.DataTypeLogs, "0")
+
+	cfg := &Config{
+		Table: []RoutingTableItem{
+			{
+				Statement: `route() where attributes["X-Tenant"] == "acme"`,
+				Context:   dataPointContext,
+				Pipelines: []component.ID{logs0},
+			},
+		},
+	}
+	require.NoError(t, cfg.Validate())
+
+	router := connector.NewLogsRouter(map[component.ID]consumer.Logs{
+		logs0: &consumertest.LogsSink{},
+	})
+
+	_, err := NewFactory().CreateLogsToLogs(context.Background(),
+		connectortest.NewNopCreateSettings(), cfg, router.(consumer.Logs))
+	assert.ErrorIs(t, err, errDataPointContext)
+}
@@ -14,6 +14,7 @@ import (
 	"go.uber.org/zap"
 
 	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl/contexts/ottldatapoint"
 	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl/contexts/ottlresource"
 )
 
@@ -69,6 +70,12 @@ func (c *metricsConnector) ConsumeMetrics(ctx context.Context, md pmetric.Metric
 
 	for i := 0; i < md.ResourceMetrics().Len(); i++ {
 		rmetrics := md.ResourceMetrics().At(i)
+		if c.router.hasDataPointRoutes() {
+			if err := c.routeDataPoints(ctx, groups, rmetrics); err != nil {
+				return err
+			}
+			continue
+		}
 		rtx := ottlresource.NewTransformContext(rmetrics.Resource())
 
 		noRoutesMatch := true
@@ -118,3 +125,230 @@ func (c *metricsConnector) group(
 	metrics.CopyTo(group.ResourceMetrics().AppendEmpty())
 	groups[consumer] = group
 }
+
+type routeResult struct {
+	isMatch bool
+	err     error
+}
+
+// routeDataPoints routes each data point of the resource metrics on its own, so that the data points of a metric
+// can be split across pipelines. The routes in the resource context are only evaluated once for the resource.
+func (c *metricsConnector) routeDataPoints(
+	ctx context.Context,
+	groups map[consumer.Metrics]pmetric.Metrics,
+	rmetrics pmetric.ResourceMetrics,
+) error {
+	rtx := ottlresource.NewTransformContext(rmetrics.Resource())
+	resourceResults := make(map[int]routeResult)
+	dpGroups := newDataPointGroups(groups, rmetrics)
+
+	for i := 0; i < rmetrics.ScopeMetrics().Len(); i++ {
+		smetrics := rmetrics.ScopeMetrics().At(i)
+		dpGroups.startScope(smetrics)
+		for j := 0; j < smetrics.Metrics().Len(); j++ {
+			metric := smetrics.Metrics().At(j)
+			dpGroups.startMetric(metric)
+			route := func(dataPoint any) ([]consumer.Metrics, error) {
+				dtx := ottldatapoint.NewTransformContext(dataPoint, metric, smetrics.Metrics(), smetrics.Scope(), rmetrics.Resource())
+				return c.matchDataPoint(ctx, rtx, resourceResults, dtx)
+			}
+
+			switch metric.Type() {
+			case pmetric.MetricTypeGauge:
+				dps := metric.Gauge().DataPoints()
+				for k := 0; k < dps.Len(); k++ {
+					consumers, err := route(dps.At(k))
+					if err != nil {
+						return err
+					}
+					for _, consumer := range consumers {
+						dps.At(k).CopyTo(dpGroups.metric(consumer).Gauge().DataPoints().AppendEmpty())
+					}
+				}
+			case pmetric.MetricTypeSum:
+				dps := metric.Sum().DataPoints()
+				for k := 0; k < dps.Len(); k++ {
+					consumers, err := route(dps.At(k))
+					if err != nil {
+						return err
+					}
+					for _, consumer := range consumers {
+						dps.At(k).CopyTo(dpGroups.metric(consumer).Sum().DataPoints().AppendEmpty())
+					}
+				}
+			case pmetric.MetricTypeHistogram:
+				dps := metric.Histogram().DataPoints()
+				for k := 0; k < dps.Len(); k++ {
+					consumers, err := route(dps.At(k))
+					if err != nil {
+						return err
+					}
+					for _, consumer := range consumers {
+						dps.At(k).CopyTo(dpGroups.metric(consumer).Histogram().DataPoints().AppendEmpty())
+					}
+				}
+			case pmetric.MetricTypeExponentialHistogram:
+				dps := metric.ExponentialHistogram().DataPoints()
+				for k := 0; k < dps.Len(); k++ {
+					consumers, err := route(dps.At(k))
+					if err != nil {
+						return err
+					}
+					for _, consumer := range consumers {
+						dps.At(k).CopyTo(dpGroups.metric(consumer).ExponentialHistogram().DataPoints().AppendEmpty())
+					}
+				}
+			case pmetric.MetricTypeSummary:
+				dps := metric.Summary().DataPoints()
+				for k := 0; k < dps.Len(); k++ {
+					consumers, err := route(dps.At(k))
+					if err != nil {
+						return err
+					}
+					for _, consumer := range consumers {
+						dps.At(k).CopyTo(dpGroups.metric(consumer).Summary().DataPoints().AppendEmpty())
+					}
+				}
+			}
+		}
+	}
+	return nil
+}
+
+// matchDataPoint returns the consumers of the data point. The results of the routes in the resource context are
+// cached in resourceResults, as they are the same for all the data points of the resource.
+func (c *metricsConnector) matchDataPoint(
+	ctx context.Context,
+	rtx ottlresource.TransformContext,
+	resourceResults map[int]routeResult,
+	dtx ottldatapoint.TransformContext,
+) ([]consumer.Metrics, error) {
+	var consumers []consumer.Metrics
+	add := func(consumer consumer.Metrics) {
+		if consumer == nil {
+			return
+		}
+		for _, c := range consumers {
+			if c == consumer {
+				return
+			}
+		}
+		consumers = append(consumers, consumer)
+	}
+
+	noRoutesMatch := true
+	for i, route := range c.router.routeSlice {
+		var result routeResult
+		if route.dataPointStatement != nil {
+			_, result.isMatch, result.err = route.dataPointStatement.Execute(ctx, dtx)
+		} else {
+			var ok bool
+			if result, ok = resourceResults[i]; !ok {
+				_, result.isMatch, result.err = route.statement.Execute(ctx, rtx)
+				resourceResults[i] = result
+			}
+		}
+		if result.err != nil {
+			if c.config.ErrorMode == ottl.PropagateError {
+				return nil, result.err
+			}
+			add(c.router.defaultConsumer)
+			continue
+		}
+		if result.isMatch {
+			noRoutesMatch = false
+			add(route.consumer)
+			if c.config.MatchOnce {
+				break
+			}
+		}
+	}
+
+	if noRoutesMatch {
+		// no route conditions are matched, add the data point to default pipelines group
+		add(c.router.defaultConsumer)
+	}
+	return consumers, nil
+}
+
+// dataPointGroups copies the data points of a resource metrics to the groups of their consumers, adding the
+// resource, the scope and the metric of the data points to the group of a consumer on its first data point.
+type dataPointGroups struct {
+	groups map[consumer.Metrics]pmetric.Metrics
+
+	// the resource metrics being routed, and its current scope metrics and metric
+	srcResource pmetric.ResourceMetrics
+	srcScope    pmetric.ScopeMetrics
+	srcMetric   pmetric.Metric
+
+	resourceGroups map[consumer.Metrics]pmetric.ResourceMetrics
+	scopeGroups    map[consumer.Metrics]pmetric.ScopeMetrics
+	metricGroups   map[consumer.Metrics]pmetric.Metric
+}
+
+func newDataPointGroups(groups map[consumer.Metrics]pmetric.Metrics, rmetrics pmetric.ResourceMetrics) *dataPointGroups {
+	return &dataPointGroups{
+		groups:         groups,
+		srcResource:    rmetrics,
+		resourceGroups: make(map[consumer.Metrics]pmetric.ResourceMetrics),
+	}
+}
+
+func (g *dataPointGroups) startScope(smetrics pmetric.ScopeMetrics) {
+	g.srcScope = smetrics
+	g.scopeGroups = make(map[consumer.Metrics]pmetric.ScopeMetrics)
+}
+
+func (g *dataPointGroups) startMetric(metric pmetric.Metric) {
+	g.srcMetric = metric
+	g.metricGroups = make(map[consumer.Metrics]pmetric.Metric)
+}
+
+// metric returns the copy of the current metric in the group of the consumer, without its data points.
+func (g *dataPointGroups) metric(consumer consumer.Metrics) pmetric.Metric {
+	if metric, ok := g.metricGroups[consumer]; ok {
+		return metric
+	}
+
+	smetrics, ok := g.scopeGroups[consumer]
+	if !ok {
+		rmetrics, ok := g.resourceGroups[consumer]
+		if !ok {
+			group, ok := g.groups[consumer]
+			if !ok {
+				group = pmetric.NewMetrics()
+				g.groups[consumer] = group
+			}
+			rmetrics = group.ResourceMetrics().AppendEmpty()
+			g.srcResource.Resource().CopyTo(rmetrics.Resource())
+			rmetrics.SetSchemaUrl(g.srcResource.SchemaUrl())
+			g.resourceGroups[consumer] = rmetrics
+		}
+		smetrics = rmetrics.ScopeMetrics().AppendEmpty()
+		g.srcScope.Scope().CopyTo(smetrics.Scope())
+		smetrics.SetSchemaUrl(g.srcScope.SchemaUrl())
+		g.scopeGroups[consumer] = smetrics
+	}
+
+	metric := smetrics.Metrics().AppendEmpty()
+	metric.SetName(g.srcMetric.Name())
+	metric.SetDescription(g.srcMetric.Description())
+	metric.SetUnit(g.srcMetric.Unit())
+	g.srcMetric.Metadata().CopyTo(metric.Metadata())
+	switch g.srcMetric.Type() {
+	case pmetric.MetricTypeGauge:
+		metric.SetEmptyGauge()
+	case pmetric.MetricTypeSum:
+		sum := metric.SetEmptySum()
+		sum.SetAggregationTemporality(g.srcMetric.Sum().AggregationTemporality())
+		sum.SetIsMonotonic(g.srcMetric.Sum().IsMonotonic())
+	case pmetric.MetricTypeHistogram:
+		metric.SetEmptyHistogram().SetAggregationTemporality(g.srcMetric.Histogram().AggregationTemporality())
+	case pmetric.MetricTypeExponentialHistogram:
+		metric.SetEmptyExponentialHistogram().SetAggregationTemporality(g.srcMetric.ExponentialHistogram().AggregationTemporality())
+	case pmetric.MetricTypeSummary:
+		metric.SetEmptySummary()
+	}
+	g.metricGroups[consumer] = metric
+	return metric
+}
@@ -412,6 +412,126 @@ func TestMetricsAreCorrectlyMatchOnceWithOTTL(t *testing.T) {
 	})
 }
 
+func TestMetricsAreCorrectlySplitPerDataPointAttributeWithOTTL(t *testing.T) {
+	metricsDefault := component.NewIDWithName(component.DataTypeMetrics, "default")
+	metricsProd := component.NewIDWithName(component.DataTypeMetrics, "prod")
+	metricsAcme := component.NewIDWithName(component.DataTypeMetrics, "acme")
+
+	newMetrics := func() pmetric.Metrics {
+		m := pmetric.NewMetrics()
+		rm := m.ResourceMetrics().AppendEmpty()
+		rm.Resource().Attributes().PutStr("X-Tenant", "acme")
+		sm := rm.ScopeMetrics().AppendEmpty()
+		sm.Scope().SetName("scope")
+
+		sum := sm.Metrics().AppendEmpty()
+		sum.SetName("requests")
+		sum.SetUnit("1")
+		sum.SetEmptySum().SetAggregationTemporality(pmetric.AggregationTemporalityCumulative)
+		sum.Sum().SetIsMonotonic(true)
+		for _, env := range []string{"prod", "dev", "prod"} {
+			dp := sum.Sum().DataPoints().AppendEmpty()
+			dp.Attributes().PutStr("deployment.environment", env)
+			dp.SetIntValue(1)
+		}
+
+		histogram := sm.Metrics().AppendEmpty()
+		histogram.SetName("latency")
+		histogram.SetEmptyHistogram().SetAggregationTemporality(pmetric.AggregationTemporalityDelta)
+		histogram.Histogram().DataPoints().AppendEmpty().Attributes().PutStr("deployment.environment", "dev")
+		return m
+	}
+
+	tests := []struct {
+		name      string
+		matchOnce bool
+		// expected number of data points of the "requests" and "latency" metrics per pipeline
+		expected map[component.ID][]int
+	}{
+		{
+			name: "all matching routes",
+			expected: map[component.ID][]int{
+				metricsProd: {2, 0},
+				metricsAcme: {3, 1},
+			},
+		},
+		{
+			name:      "match once",
+			matchOnce: true,
+			expected: map[component.ID][]int{
+				metricsProd: {2, 0},
+				metricsAcme: {1, 1},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cfg := &Config{
+				DefaultPipelines: []component.ID{metricsDefault},
+				MatchOnce:        tt.matchOnce,
+				Table: []RoutingTableItem{
+					{
+						Statement: `route() where attributes["deployment.environment"] == "prod"`,
+						Context:   dataPointContext,
+						Pipelines: []component.ID{metricsProd},
+					},
+					{
+						Statement: `route() where attributes["X-Tenant"] == "acme"`,
+						Pipelines: []component.ID{metricsAcme},
+					},
+				},
+			}
+			require.NoError(t, cfg.Validate())
+
+			sinks := map[component.ID]*consumertest.MetricsSink{
+				metricsDefault: {},
+				metricsProd:    {},
+				metricsAcme:    {},
+			}
+			router := connector.NewMetricsRouter(map[component.ID]consumer.Metrics{
+				metricsDefault: sinks[metricsDefault],
+				metricsProd:    sinks[metricsProd],
+				metricsAcme:    sinks[metricsAcme],
+			})
+
+			conn, err := NewFactory().CreateMetricsToMetrics(context.Background(),
+				connectortest.NewNopCreateSettings(), cfg, router.(consumer.Metrics))
+			require.NoError(t, err)
+
+			require.NoError(t, conn.ConsumeMetrics(context.Background(), newMetrics()))
+
+			assert.Empty(t, sinks[metricsDefault].AllMetrics())
+			for id, expected := range tt.expected {
+				require.Len(t, sinks[id].AllMetrics(), 1, id.String())
+				rm := sinks[id].AllMetrics()[0].ResourceMetrics()
+				require.Equal(t, 1, rm.Len())
+				tenant, _ := rm.At(0).Resource().Attributes().Get("X-Tenant")
+				assert.Equal(t, "acme", tenant.Str())
+				require.Equal(t, 1, rm.At(0).ScopeMetrics().Len())
+				sm := rm.At(0).ScopeMetrics().At(0)
+				assert.Equal(t, "scope", sm.Scope().Name())
+
+				metrics := sm.Metrics()
+				require.GreaterOrEqual(t, metrics.Len(), 1, id.String())
+				requests := metrics.At(0)
+				assert.Equal(t, "requests", requests.Name())
+				assert.Equal(t, "1", requests.Unit())
+				assert.True(t, requests.Sum().IsMonotonic())
+				assert.Equal(t, pmetric.AggregationTemporalityCumulative, requests.Sum().AggregationTemporality())
+				assert.Equal(t, expected[0], requests.Sum().DataPoints().Len(), id.String())
+				if expected[1] > 0 {
+					require.Equal(t, 2, metrics.Len(), id.String())
+					assert.Equal(t, "latency", metrics.At(1).Name())
+					assert.Equal(t, expected[1], metrics.At(1).Histogram().DataPoints().Len(), id.String())
+				} else {
+					assert.Equal(t, 1, metrics.Len(), id.String())
+				}
+			}
+		})
+	}
+}
+
 func TestMetricsResourceAttributeDroppedByOTTL(t *testing.T) {
 	metricsDefault := component.NewIDWithName(component.DataTypeMetrics, "default")
 	metricsOther := component.NewIDWithName(component.DataTypeMetrics, "other")
@@ -13,6 +13,7 @@ import (
 
 	"github.com/open-telemetry/opentelemetry-collector-contrib/connector/routingconnector/internal/common"
 	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl/contexts/ottldatapoint"
 	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl/contexts/ottlresource"
 )
 
@@ -27,8 +28,9 @@ type consumerProvider[C any] func(...component.ID) (C, error)
 // parameter C is expected to be one of: consumer.Traces, consumer.Metrics, or
 // consumer.Logs.
 type router[C any] struct {
-	logger *zap.Logger
-	parser ottl.Parser[ottlresource.TransformContext]
+	logger          *zap.Logger
+	parser          ottl.Parser[ottlresource.TransformContext]
+	dataPointParser ottl.Parser[ottldatapoint.TransformContext]
 
 	table      []RoutingTableItem
 	routes     map[string]routingItem[C]
@@ -55,9 +57,19 @@ func newRouter[C any](
 		return nil, err
 	}
 
+	dataPointParser, err := ottldatapoint.NewParser(
+		common.Functions[ottldatapoint.TransformContext](),
+		settings,
+	)
+
+	if err != nil {
+		return nil, err
+	}
+
 	r := &router[C]{
 		logger:           settings.Logger,
 		parser:           parser,
+		dataPointParser:  dataPointParser,
 		table:            table,
 		routes:           make(map[string]routingItem[C]),
 		consumerProvider: provider,
@@ -73,6 +85,8 @@ func newRouter[C any](
 type routingItem[C any] struct {
 	consumer  C
 	statement *ottl.Statement[ottlresource.TransformContext]
+	// dataPointStatement is set instead of statement for the routes in the datapoint context.
+	dataPointStatement *ottl.Statement[ottldatapoint.TransformContext]
 }
 
 func (r *router[C]) registerConsumers(defaultPipelineIDs []component.ID) error {
@@ -112,14 +126,17 @@ func (r *router[C]) registerDefaultConsumer(pipelineIDs []component.ID) error {
 // for each route
 func (r *router[C]) registerRouteConsumers() error {
 	for _, item := range r.table {
-		statement, err := r.getStatementFrom(item)
-		if err != nil {
-			return err
-		}
-
 		route, ok := r.routes[key(item)]
 		if !ok {
-			route.statement = statement
+			var err error
+			if item.Context == dataPointContext {
+				route.dataPointStatement, err = r.dataPointParser.ParseStatement(item.Statement)
+			} else {
+				route.statement, err = r.getStatementFrom(item)
+			}
+			if err != nil {
+				return err
+			}
 		} else {
 			pipelineNames := []string{}
 			for _, pipeline := range item.Pipelines {
@@ -158,6 +175,19 @@ func (r *router[C]) getStatementFrom(item RoutingTableItem) (*ottl.Statement[ott
 	return statement, nil
 }
 
+// hasDataPointRoutes returns whether some of the routes are in the datapoint context.
+func (r *router[C]) hasDataPointRoutes() bool {
+	for _, route := range r.routeSlice {
+		if route.dataPointStatement != nil {
+			return true
+		}
+	}
+	return false
+}
+
 func key(entry RoutingTableItem) string {
+	if entry.Context == dataPointContext {
+		return dataPointContext + ": " + entry.Statement
+	}
 	return entry.Statement
 }
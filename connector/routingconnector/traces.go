@@ -48,6 +48,10 @@ func newTracesConnector(
 		return nil, err
 	}
 
+	if r.hasDataPointRoutes() {
+		return nil, errDataPointContext
+	}
+
 	return &tracesConnector{
 		logger: set.TelemetrySettings.Logger,
 		config: cfg,
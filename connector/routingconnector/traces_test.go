@@ -419,3 +419,26 @@ func TestTraceConnectorCapabilities(t *testing.T) {
 	require.NoError(t, err)
 	assert.Equal(t, false, conn.Capabilities().MutatesData)
 }
+
+func TestTracesDataPointContextNotSupported(t *testing.T) {
+	traces0 := component.NewIDWithName(component.DataTypeTraces, "0")
+
+	cfg := &Config{
+		Table: []RoutingTableItem{
+			{
+				Statement: `route() where attributes["X-Tenant"] == "acme"`,
+				Context:   dataPointContext,
+				Pipelines: []component.ID{traces0},
+			},
+		},
+	}
+	require.NoError(t, cfg.Validate())
+
+	router := connector.NewTracesRouter(map[component.ID]consumer.Traces{
+		traces0: &consumertest.TracesSink{},
+	})
+
+	_, err := NewFactory().CreateTracesToTraces(context.Background(),
+		connectortest.NewNopCreateSettings(), cfg, router.(consumer.Traces))
+	assert.ErrorIs(t, err, errDataPointContext)
+}
@@ -5,6 +5,8 @@ package servicegraphconnector // import "github.com/open-telemetry/opentelemetry
 
 import (
 	"time"
+
+	"go.opentelemetry.io/collector/component"
 )
 
 // Config defines the configuration options for servicegraphprocessor.
@@ -36,6 +38,9 @@ type Config struct {
 	StoreExpirationLoop time.Duration `mapstructure:"store_expiration_loop"`
 	// VirtualNodePeerAttributes the list of attributes need to match, the higher the front, the higher the priority.
 	VirtualNodePeerAttributes []string `mapstructure:"virtual_node_peer_attributes"`
+	// VirtualNodeExtraLabel adds the virtual_node dimension, telling whether the client or the server of the edge is
+	// a virtual node.
+	VirtualNodeExtraLabel bool `mapstructure:"virtual_node_extra_label"`
 
 	// MetricsFlushInterval is the interval at which metrics are flushed to the exporter.
 	// If set to 0, metrics are flushed on every received batch of traces.
@@ -51,4 +56,9 @@ type StoreConfig struct {
 	MaxItems int `mapstructure:"max_items"`
 	// TTL is the time to live for items in the store.
 	TTL time.Duration `mapstructure:"ttl"`
+	// StorageID is the optional storage extension the pending edges are saved to on shutdown, and restored from on
+	// start, so that the requests in flight during a restart still complete their edges.
+	StorageID *component.ID `mapstructure:"storage"`
+	// StorageTTL is the time after which the saved edges are discarded rather than restored.
+	StorageTTL time.Duration `mapstructure:"storage_ttl"`
 }
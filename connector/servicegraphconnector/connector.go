@@ -14,6 +14,7 @@ import (
 
 	"go.opentelemetry.io/collector/component"
 	"go.opentelemetry.io/collector/consumer"
+	"go.opentelemetry.io/collector/extension/experimental/storage"
 	"go.opentelemetry.io/collector/pdata/pcommon"
 	"go.opentelemetry.io/collector/pdata/pmetric"
 	"go.opentelemetry.io/collector/pdata/ptrace"
@@ -29,6 +30,10 @@ const (
 	metricKeySeparator = string(byte(0))
 	clientKind         = "client"
 	serverKind         = "server"
+	virtualNodeLabel   = "virtual_node"
+
+	// storageKey is the key of the pending edges in the storage extension.
+	storageKey = "edges"
 )
 
 var (
@@ -40,7 +45,7 @@ var (
 	}
 
 	defaultPeerAttributes = []string{
-		semconv.AttributePeerService, semconv.AttributeDBName, semconv.AttributeDBSystem,
+		semconv.AttributePeerService, semconv.AttributeDBName, semconv.AttributeDBSystem, semconv.AttributeNetPeerName,
 	}
 
 	defaultDatabaseNameAttribute = semconv.AttributeDBName
@@ -54,11 +59,13 @@ type metricSeries struct {
 var _ processor.Traces = (*serviceGraphConnector)(nil)
 
 type serviceGraphConnector struct {
+	id              component.ID
 	config          *Config
 	logger          *zap.Logger
 	metricsConsumer consumer.Metrics
 
-	store *store.Store
+	store         *store.Store
+	storageClient storage.Client
 
 	startTime time.Time
 
@@ -138,8 +145,11 @@ func newConnector(set component.TelemetrySettings, config component.Config, next
 	}, nil
 }
 
-func (p *serviceGraphConnector) Start(_ context.Context, _ component.Host) error {
+func (p *serviceGraphConnector) Start(ctx context.Context, host component.Host) error {
 	p.store = store.NewStore(p.config.Store.TTL, p.config.Store.MaxItems, p.onComplete, p.onExpire)
+	if err := p.restoreEdges(ctx, host); err != nil {
+		return err
+	}
 
 	go p.metricFlushLoop(p.config.MetricsFlushInterval)
 
@@ -186,12 +196,64 @@ func (p *serviceGraphConnector) flushMetrics(ctx context.Context) error {
 	return p.metricsConsumer.ConsumeMetrics(ctx, md)
 }
 
-func (p *serviceGraphConnector) Shutdown(_ context.Context) error {
+func (p *serviceGraphConnector) Shutdown(ctx context.Context) error {
 	p.logger.Info("Shutting down servicegraphconnector")
 	close(p.shutdownCh)
+	return p.saveEdges(ctx)
+}
+
+// restoreEdges gets the storage client when configured, and restores the edges pending when the connector was
+// last shut down.
+func (p *serviceGraphConnector) restoreEdges(ctx context.Context, host component.Host) error {
+	if p.config.Store.StorageID == nil {
+		return nil
+	}
+	ext, ok := host.GetExtensions()[*p.config.Store.StorageID]
+	if !ok {
+		return fmt.Errorf("storage extension '%s' not found", p.config.Store.StorageID)
+	}
+	storageExt, ok := ext.(storage.Extension)
+	if !ok {
+		return fmt.Errorf("non-storage extension '%s' found", p.config.Store.StorageID)
+	}
+	client, err := storageExt.GetClient(ctx, component.KindConnector, p.id, "")
+	if err != nil {
+		return fmt.Errorf("failed to get the storage client: %w", err)
+	}
+	p.storageClient = client
+
+	saved, err := client.Get(ctx, storageKey)
+	if err != nil {
+		return fmt.Errorf("failed to read the saved edges: %w", err)
+	}
+	if saved == nil {
+		return nil
+	}
+	restored, err := p.store.Unmarshal(saved, time.Now(), p.config.Store.StorageTTL)
+	if err != nil {
+		// the pending edges are lost, which isn't a reason to stop the pipeline
+		p.logger.Warn("Ignoring the saved edges", zap.Error(err))
+	}
+	p.logger.Debug("Restored the saved edges", zap.Int("edges", restored))
 	return nil
 }
 
+// saveEdges saves the pending edges to the storage extension when configured.
+func (p *serviceGraphConnector) saveEdges(ctx context.Context) error {
+	if p.storageClient == nil {
+		return nil
+	}
+	var errs error
+	saved, err := p.store.Marshal(time.Now())
+	if err == nil {
+		err = p.storageClient.Set(ctx, storageKey, saved)
+	}
+	if err != nil {
+		errs = errors.Join(errs, fmt.Errorf("failed to save the edges: %w", err))
+	}
+	return errors.Join(errs, p.storageClient.Close(ctx))
+}
+
 func (p *serviceGraphConnector) Capabilities() consumer.Capabilities {
 	return consumer.Capabilities{MutatesData: false}
 }
@@ -349,18 +411,24 @@ func (p *serviceGraphConnector) onExpire(e *store.Edge) {
 		e.ConnectionType = store.VirtualNode
 		if len(e.ClientService) == 0 && e.Key.SpanIDIsEmpty() {
 			e.ClientService = "user"
+			if p.config.VirtualNodeExtraLabel {
+				e.VirtualNode = clientKind
+			}
 			p.onComplete(e)
 		}
 
 		if len(e.ServerService) == 0 {
 			e.ServerService = p.getPeerHost(p.config.VirtualNodePeerAttributes, e.Peer)
+			if p.config.VirtualNodeExtraLabel {
+				e.VirtualNode = serverKind
+			}
 			p.onComplete(e)
 		}
 	}
 }
 
 func (p *serviceGraphConnector) aggregateMetricsForEdge(e *store.Edge) {
-	metricKey := p.buildMetricKey(e.ClientService, e.ServerService, string(e.ConnectionType), e.Dimensions)
+	metricKey := p.buildMetricKey(e.ClientService, e.ServerService, string(e.ConnectionType), e.VirtualNode, e.Dimensions)
 	dimensions := buildDimensions(e)
 
 	p.seriesMutex.Lock()
@@ -428,6 +496,9 @@ func buildDimensions(e *store.Edge) pcommon.Map {
 	dims.PutStr("server", e.ServerService)
 	dims.PutStr("connection_type", string(e.ConnectionType))
 	dims.PutBool("failed", e.Failed)
+	if e.VirtualNode != "" {
+		dims.PutStr(virtualNodeLabel, e.VirtualNode)
+	}
 	for k, v := range e.Dimensions {
 		dims.PutStr(k, v)
 	}
@@ -567,9 +638,12 @@ func (p *serviceGraphConnector) collectServerLatencyMetrics(ilm pmetric.ScopeMet
 	return nil
 }
 
-func (p *serviceGraphConnector) buildMetricKey(clientName, serverName, connectionType string, edgeDimensions map[string]string) string {
+func (p *serviceGraphConnector) buildMetricKey(clientName, serverName, connectionType, virtualNode string, edgeDimensions map[string]string) string {
 	var metricKey strings.Builder
 	metricKey.WriteString(clientName + metricKeySeparator + serverName + metricKeySeparator + connectionType)
+	if virtualNode != "" {
+		metricKey.WriteString(metricKeySeparator + virtualNode)
+	}
 
 	for _, dimName := range p.config.Dimensions {
 		dim, ok := edgeDimensions[dimName]
@@ -27,6 +27,9 @@ import (
 	"go.opentelemetry.io/otel/sdk/metric/metricdata"
 	"go.opentelemetry.io/otel/sdk/metric/metricdata/metricdatatest"
 	"go.uber.org/zap/zaptest"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/connector/servicegraphconnector/internal/store"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/extension/storage/storagetest"
 )
 
 func TestConnectorStart(t *testing.T) {
@@ -247,7 +250,7 @@ func TestUpdateDurationMetrics(t *testing.T) {
 			Dimensions: []string{},
 		},
 	}
-	metricKey := p.buildMetricKey("foo", "bar", "", map[string]string{})
+	metricKey := p.buildMetricKey("foo", "bar", "", "", map[string]string{})
 
 	testCases := []struct {
 		caseStr  string
@@ -436,3 +439,88 @@ func TestValidateOwnTelemetry(t *testing.T) {
 	}
 	metricdatatest.AssertEqual(t, want, got, metricdatatest.IgnoreTimestamp())
 }
+
+func TestConnectorStorageRestoresPendingEdges(t *testing.T) {
+	ext := storagetest.NewFileBackedStorageExtension("test", t.TempDir())
+	host := storagetest.NewStorageHost().WithExtension(ext.ID, ext)
+
+	cfg := createDefaultConfig().(*Config)
+	cfg.Dimensions = []string{"some-attribute"}
+	cfg.Store.TTL = time.Minute
+	cfg.Store.StorageID = &ext.ID
+
+	// The client span is received before a restart, the server span after it
+	td := buildSampleTrace(t, "val")
+	spans := td.ResourceSpans().At(0).ScopeSpans().At(0).Spans()
+	serverTraces := ptrace.NewTraces()
+	td.ResourceSpans().At(0).Resource().CopyTo(serverTraces.ResourceSpans().AppendEmpty().Resource())
+	spans.At(1).CopyTo(serverTraces.ResourceSpans().At(0).ScopeSpans().AppendEmpty().Spans().AppendEmpty())
+	spans.RemoveIf(func(span ptrace.Span) bool { return span.Kind() == ptrace.SpanKindServer })
+
+	set := componenttest.NewNopTelemetrySettings()
+	set.Logger = zaptest.NewLogger(t)
+	p, err := newConnector(set, cfg, newMockMetricsExporter())
+	require.NoError(t, err)
+	require.NoError(t, p.Start(context.Background(), host))
+	require.NoError(t, p.ConsumeTraces(context.Background(), td))
+	assert.Len(t, p.keyToMetric, 0)
+	require.NoError(t, p.Shutdown(context.Background()))
+
+	p, err = newConnector(set, cfg, newMockMetricsExporter())
+	require.NoError(t, err)
+	require.NoError(t, p.Start(context.Background(), host))
+	require.NoError(t, p.ConsumeTraces(context.Background(), serverTraces))
+	assert.Len(t, p.keyToMetric, 1, "the edge must be completed with the client span received before the restart")
+	require.NoError(t, p.Shutdown(context.Background()))
+}
+
+func TestConnectorStorageErrors(t *testing.T) {
+	nonStorage := storagetest.NewNonStorageExtension("other")
+	host := storagetest.NewStorageHost().WithExtension(nonStorage.ID, nonStorage)
+
+	tests := []struct {
+		storageID component.ID
+		wantErr   string
+	}{
+		{storageID: storagetest.NewStorageID("missing"), wantErr: "storage extension 'test_storage/missing' not found"},
+		{storageID: nonStorage.ID, wantErr: "non-storage extension 'non_storage/other' found"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.storageID.String(), func(t *testing.T) {
+			cfg := createDefaultConfig().(*Config)
+			cfg.Store.StorageID = &tt.storageID
+
+			p, err := newConnector(componenttest.NewNopTelemetrySettings(), cfg, newMockMetricsExporter())
+			require.NoError(t, err)
+			assert.EqualError(t, p.Start(context.Background(), host), tt.wantErr)
+		})
+	}
+}
+
+func TestVirtualNodeExtraLabel(t *testing.T) {
+	cfg := createDefaultConfig().(*Config)
+	cfg.VirtualNodePeerAttributes = defaultPeerAttributes
+	cfg.VirtualNodeExtraLabel = true
+
+	set := componenttest.NewNopTelemetrySettings()
+	set.Logger = zaptest.NewLogger(t)
+	p, err := newConnector(set, cfg, newMockMetricsExporter())
+	require.NoError(t, err)
+	require.NoError(t, p.Start(context.Background(), componenttest.NewNopHost()))
+	defer func() { require.NoError(t, p.Shutdown(context.Background())) }()
+
+	// A client span calling an uninstrumented database, only known by its host name
+	p.onExpire(&store.Edge{
+		ClientService: "some-service",
+		Dimensions:    map[string]string{},
+		Peer:          map[string]string{semconv.AttributeNetPeerName: "db.example.com"},
+	})
+
+	require.Len(t, p.keyToMetric, 1)
+	for _, series := range p.keyToMetric {
+		verifyAttr(t, series.dimensions, "client", "some-service")
+		verifyAttr(t, series.dimensions, "server", "db.example.com")
+		verifyAttr(t, series.dimensions, "connection_type", string(store.VirtualNode))
+		verifyAttr(t, series.dimensions, virtualNodeLabel, serverKind)
+	}
+}
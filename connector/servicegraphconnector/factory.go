@@ -61,8 +61,9 @@ func NewFactory() connector.Factory {
 func createDefaultConfig() component.Config {
 	return &Config{
 		Store: StoreConfig{
-			TTL:      2 * time.Second,
-			MaxItems: 1000,
+			TTL:        2 * time.Second,
+			MaxItems:   1000,
+			StorageTTL: time.Minute,
 		},
 		CacheLoop:           time.Minute,
 		StoreExpirationLoop: 2 * time.Second,
@@ -70,5 +71,10 @@ func createDefaultConfig() component.Config {
 }
 
 func createTracesToMetricsConnector(_ context.Context, params connector.CreateSettings, cfg component.Config, nextConsumer consumer.Metrics) (connector.Traces, error) {
-	return newConnector(params.TelemetrySettings, cfg, nextConsumer)
+	c, err := newConnector(params.TelemetrySettings, cfg, nextConsumer)
+	if err != nil {
+		return nil, err
+	}
+	c.id = params.ID
+	return c, nil
 }
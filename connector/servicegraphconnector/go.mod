@@ -3,6 +3,7 @@ module github.com/open-telemetry/opentelemetry-collector-contrib/connector/servi
 go 1.21.0
 
 require (
+	github.com/open-telemetry/opentelemetry-collector-contrib/extension/storage v0.102.0
 	github.com/stretchr/testify v1.9.0
 	go.opentelemetry.io/collector/component v0.102.1
 	go.opentelemetry.io/collector/config/configtelemetry v0.102.1
@@ -10,6 +11,7 @@ require (
 	go.opentelemetry.io/collector/connector v0.102.1
 	go.opentelemetry.io/collector/consumer v0.102.1
 	go.opentelemetry.io/collector/exporter v0.102.1
+	go.opentelemetry.io/collector/extension v0.102.1
 	go.opentelemetry.io/collector/featuregate v1.9.1-0.20240605145924-86ee482e5b49
 	go.opentelemetry.io/collector/otelcol v0.102.1
 	go.opentelemetry.io/collector/pdata v1.9.1-0.20240605145924-86ee482e5b49
@@ -67,7 +69,6 @@ require (
 	go.opentelemetry.io/collector/confmap/provider/httpprovider v0.102.1 // indirect
 	go.opentelemetry.io/collector/confmap/provider/httpsprovider v0.102.1 // indirect
 	go.opentelemetry.io/collector/confmap/provider/yamlprovider v0.102.1 // indirect
-	go.opentelemetry.io/collector/extension v0.102.1 // indirect
 	go.opentelemetry.io/collector/pdata/testdata v0.102.1 // indirect
 	go.opentelemetry.io/collector/receiver v0.102.1 // indirect
 	go.opentelemetry.io/collector/service v0.102.1 // indirect
@@ -103,3 +104,5 @@ retract (
 	v0.76.1
 	v0.65.0
 )
+
+replace github.com/open-telemetry/opentelemetry-collector-contrib/extension/storage => ../../extension/storage
@@ -38,6 +38,9 @@ type Edge struct {
 	expiration time.Time
 
 	Peer map[string]string
+
+	// VirtualNode tells which of the client or server of the Edge is a virtual node, when reported.
+	VirtualNode string
 }
 
 func newEdge(key Key, ttl time.Duration) *Edge {
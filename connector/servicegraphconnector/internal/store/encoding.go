@@ -0,0 +1,114 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package store // import "github.com/open-telemetry/opentelemetry-collector-contrib/connector/servicegraphconnector/internal/store"
+
+import (
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"time"
+)
+
+// savedEdges is the encoding of the pending edges of a store.
+type savedEdges struct {
+	SavedAt time.Time   `json:"saved_at"`
+	Edges   []savedEdge `json:"edges"`
+}
+
+type savedEdge struct {
+	TraceID          string            `json:"trace_id"`
+	SpanID           string            `json:"span_id"`
+	ConnectionType   ConnectionType    `json:"connection_type,omitempty"`
+	ServerService    string            `json:"server_service,omitempty"`
+	ClientService    string            `json:"client_service,omitempty"`
+	ServerLatencySec float64           `json:"server_latency_sec,omitempty"`
+	ClientLatencySec float64           `json:"client_latency_sec,omitempty"`
+	Failed           bool              `json:"failed,omitempty"`
+	Dimensions       map[string]string `json:"dimensions,omitempty"`
+	Peer             map[string]string `json:"peer,omitempty"`
+	// TTL is the time the edge had left to find its pair when saved.
+	TTL time.Duration `json:"ttl"`
+}
+
+// Marshal encodes the pending edges, with the time they have left to find their pair.
+func (s *Store) Marshal(now time.Time) ([]byte, error) {
+	s.mtx.Lock()
+	defer s.mtx.Unlock()
+
+	saved := savedEdges{SavedAt: now, Edges: make([]savedEdge, 0, s.l.Len())}
+	for ele := s.l.Front(); ele != nil; ele = ele.Next() {
+		e := ele.Value.(*Edge)
+		saved.Edges = append(saved.Edges, savedEdge{
+			TraceID:          hex.EncodeToString(e.Key.tid[:]),
+			SpanID:           hex.EncodeToString(e.Key.sid[:]),
+			ConnectionType:   e.ConnectionType,
+			ServerService:    e.ServerService,
+			ClientService:    e.ClientService,
+			ServerLatencySec: e.ServerLatencySec,
+			ClientLatencySec: e.ClientLatencySec,
+			Failed:           e.Failed,
+			Dimensions:       e.Dimensions,
+			Peer:             e.Peer,
+			TTL:              max(e.expiration.Sub(now), 0),
+		})
+	}
+	return json.Marshal(saved)
+}
+
+// Unmarshal restores the pending edges encoded by Marshal, giving them the time they had left when saved.
+// The edges saved more than maxAge ago are discarded, as the other spans of their requests are likely lost.
+// It returns the number of restored edges, the edges already in the store or over the max items being ignored.
+func (s *Store) Unmarshal(data []byte, now time.Time, maxAge time.Duration) (int, error) {
+	var saved savedEdges
+	if err := json.Unmarshal(data, &saved); err != nil {
+		return 0, fmt.Errorf("invalid saved edges: %w", err)
+	}
+	if now.Sub(saved.SavedAt) > maxAge {
+		return 0, nil
+	}
+
+	s.mtx.Lock()
+	defer s.mtx.Unlock()
+
+	restored := 0
+	for _, se := range saved.Edges {
+		var key Key
+		if err := decodeHex(se.TraceID, key.tid[:]); err != nil {
+			return restored, fmt.Errorf("invalid trace ID %q: %w", se.TraceID, err)
+		}
+		if err := decodeHex(se.SpanID, key.sid[:]); err != nil {
+			return restored, fmt.Errorf("invalid span ID %q: %w", se.SpanID, err)
+		}
+		if _, ok := s.m[key]; ok || s.l.Len() >= s.maxItems {
+			continue
+		}
+
+		e := newEdge(key, se.TTL)
+		e.expiration = now.Add(se.TTL)
+		e.TraceID = key.tid
+		e.ConnectionType = se.ConnectionType
+		e.ServerService = se.ServerService
+		e.ClientService = se.ClientService
+		e.ServerLatencySec = se.ServerLatencySec
+		e.ClientLatencySec = se.ClientLatencySec
+		e.Failed = se.Failed
+		for k, v := range se.Dimensions {
+			e.Dimensions[k] = v
+		}
+		for k, v := range se.Peer {
+			e.Peer[k] = v
+		}
+		s.m[key] = s.l.PushBack(e)
+		restored++
+	}
+	return restored, nil
+}
+
+func decodeHex(s string, dst []byte) error {
+	if hex.DecodedLen(len(s)) != len(dst) {
+		return fmt.Errorf("invalid length %d", len(s))
+	}
+	_, err := hex.Decode(dst, []byte(s))
+	return err
+}
@@ -0,0 +1,101 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package store
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/collector/pdata/pcommon"
+)
+
+func TestStoreMarshalUnmarshal(t *testing.T) {
+	now := time.Now()
+	key := NewKey(pcommon.TraceID([16]byte{1, 2, 3}), pcommon.SpanID([8]byte{1, 2, 3}))
+	s := NewStore(time.Minute, 10, noopCallback, noopCallback)
+	_, err := s.UpsertEdge(key, func(e *Edge) {
+		e.ClientService = clientService
+		e.ClientLatencySec = 1.5
+		e.Failed = true
+		e.Dimensions["region"] = "eu"
+		e.Peer["db.system"] = "postgresql"
+	})
+	require.NoError(t, err)
+
+	data, err := s.Marshal(now)
+	require.NoError(t, err)
+
+	restored := NewStore(time.Minute, 10, noopCallback, noopCallback)
+	n, err := restored.Unmarshal(data, now.Add(10*time.Second), time.Minute)
+	require.NoError(t, err)
+	assert.Equal(t, 1, n)
+	require.Equal(t, 1, restored.len())
+
+	e := restored.l.Front().Value.(*Edge)
+	assert.Equal(t, key, e.Key)
+	assert.Equal(t, pcommon.TraceID([16]byte{1, 2, 3}), e.TraceID)
+	assert.Equal(t, clientService, e.ClientService)
+	assert.Equal(t, 1.5, e.ClientLatencySec)
+	assert.True(t, e.Failed)
+	assert.Equal(t, map[string]string{"region": "eu"}, e.Dimensions)
+	assert.Equal(t, map[string]string{"db.system": "postgresql"}, e.Peer)
+	// the edge keeps the time it had left when saved
+	ttl := s.l.Front().Value.(*Edge).expiration.Sub(now)
+	assert.Equal(t, now.Add(10*time.Second).Add(ttl), e.expiration)
+
+	// restoring twice doesn't duplicate the edges
+	n, err = restored.Unmarshal(data, now, time.Minute)
+	require.NoError(t, err)
+	assert.Equal(t, 0, n)
+	assert.Equal(t, 1, restored.len())
+}
+
+func TestStoreUnmarshalTooOld(t *testing.T) {
+	now := time.Now()
+	s := NewStore(time.Minute, 10, noopCallback, noopCallback)
+	_, err := s.UpsertEdge(NewKey(pcommon.TraceID([16]byte{1}), pcommon.SpanID([8]byte{1})), func(e *Edge) {
+		e.ClientService = clientService
+	})
+	require.NoError(t, err)
+	data, err := s.Marshal(now)
+	require.NoError(t, err)
+
+	restored := NewStore(time.Minute, 10, noopCallback, noopCallback)
+	n, err := restored.Unmarshal(data, now.Add(2*time.Minute), time.Minute)
+	require.NoError(t, err)
+	assert.Equal(t, 0, n)
+	assert.Equal(t, 0, restored.len())
+}
+
+func TestStoreUnmarshalMaxItems(t *testing.T) {
+	now := time.Now()
+	s := NewStore(time.Minute, 10, noopCallback, noopCallback)
+	for i := byte(0); i < 3; i++ {
+		_, err := s.UpsertEdge(NewKey(pcommon.TraceID([16]byte{i}), pcommon.SpanID([8]byte{i})), func(e *Edge) {
+			e.ClientService = clientService
+		})
+		require.NoError(t, err)
+	}
+	data, err := s.Marshal(now)
+	require.NoError(t, err)
+
+	restored := NewStore(time.Minute, 2, noopCallback, noopCallback)
+	n, err := restored.Unmarshal(data, now, time.Minute)
+	require.NoError(t, err)
+	assert.Equal(t, 2, n)
+	assert.Equal(t, 2, restored.len())
+}
+
+func TestStoreUnmarshalInvalid(t *testing.T) {
+	s := NewStore(time.Minute, 10, noopCallback, noopCallback)
+
+	_, err := s.Unmarshal([]byte("not json"), time.Now(), time.Minute)
+	assert.ErrorContains(t, err, "invalid saved edges")
+
+	_, err = s.Unmarshal([]byte(`{"saved_at":"`+time.Now().Format(time.RFC3339Nano)+`","edges":[{"trace_id":"zz","span_id":"0102030000000000"}]}`), time.Now(), time.Minute)
+	assert.ErrorContains(t, err, `invalid trace ID "zz"`)
+	assert.Equal(t, 0, s.len())
+}
@@ -0,0 +1,98 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package slowspanconnector // import "github.com/open-telemetry/opentelemetry-collector-contrib/connector/slowspanconnector"
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	"go.opentelemetry.io/collector/pdata/plog"
+)
+
+const defaultSeverity = "WARN"
+
+// Config for the connector
+type Config struct {
+	// DefaultThreshold is the duration threshold of the operations without a
+	// threshold of their own. When zero, only the spans of the configured
+	// operations are checked.
+	DefaultThreshold time.Duration `mapstructure:"default_threshold"`
+	// Operations are the duration thresholds per operation.
+	Operations []OperationConfig `mapstructure:"operations"`
+	// Severity is the severity text of the emitted log events.
+	Severity string `mapstructure:"severity"`
+}
+
+// OperationConfig defines the duration threshold of an operation.
+type OperationConfig struct {
+	// Name is the span name of the operation.
+	Name string `mapstructure:"name"`
+	// Service restricts the threshold to the spans of the service with this
+	// service.name resource attribute. The threshold applies to the operation
+	// of every service when empty.
+	Service string `mapstructure:"service"`
+	// Threshold is the duration over which the spans of the operation are slow.
+	Threshold time.Duration `mapstructure:"threshold"`
+}
+
+func (c *Config) Validate() error {
+	if c.DefaultThreshold < 0 {
+		return errors.New("default_threshold can't be negative")
+	}
+	if c.DefaultThreshold == 0 && len(c.Operations) == 0 {
+		return errors.New("either default_threshold or operations must be configured")
+	}
+	operations := make(map[operationKey]struct{}, len(c.Operations))
+	for _, op := range c.Operations {
+		if op.Name == "" {
+			return errors.New("operations: name missing")
+		}
+		key := operationKey{service: op.Service, name: op.Name}
+		if _, ok := operations[key]; ok {
+			return fmt.Errorf("operations: duplicate operation %q", key)
+		}
+		operations[key] = struct{}{}
+		if op.Threshold <= 0 {
+			return fmt.Errorf("operation %q: threshold must be greater than zero", key)
+		}
+	}
+	if c.Severity != "" && severityNumber(c.Severity) == plog.SeverityNumberUnspecified {
+		return fmt.Errorf("unknown severity %q", c.Severity)
+	}
+	return nil
+}
+
+// operationKey identifies the operation of a threshold, with an empty service
+// for the thresholds applying to every service.
+type operationKey struct {
+	service string
+	name    string
+}
+
+func (k operationKey) String() string {
+	if k.service == "" {
+		return k.name
+	}
+	return k.service + "/" + k.name
+}
+
+// severityNumber maps the short severity text to its number.
+func severityNumber(text string) plog.SeverityNumber {
+	switch text {
+	case "TRACE":
+		return plog.SeverityNumberTrace
+	case "DEBUG":
+		return plog.SeverityNumberDebug
+	case "INFO":
+		return plog.SeverityNumberInfo
+	case "WARN":
+		return plog.SeverityNumberWarn
+	case "ERROR":
+		return plog.SeverityNumberError
+	case "FATAL":
+		return plog.SeverityNumberFatal
+	}
+	return plog.SeverityNumberUnspecified
+}
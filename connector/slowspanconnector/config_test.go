@@ -0,0 +1,73 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package slowspanconnector
+
+import (
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/collector/component"
+	"go.opentelemetry.io/collector/confmap/confmaptest"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/connector/slowspanconnector/internal/metadata"
+)
+
+func TestLoadConfig(t *testing.T) {
+	cm, err := confmaptest.LoadConf(filepath.Join("testdata", "config.yaml"))
+	require.NoError(t, err)
+
+	factory := NewFactory()
+	cfg := factory.CreateDefaultConfig()
+	sub, err := cm.Sub(component.NewID(metadata.Type).String())
+	require.NoError(t, err)
+	require.NoError(t, sub.Unmarshal(cfg))
+
+	assert.NoError(t, component.ValidateConfig(cfg))
+	assert.Equal(t, &Config{
+		DefaultThreshold: 5 * time.Second,
+		Operations: []OperationConfig{
+			{
+				Name:      "GET /users",
+				Threshold: 500 * time.Millisecond,
+			},
+			{
+				Name:      "GET /users",
+				Service:   "legacy",
+				Threshold: 2 * time.Second,
+			},
+		},
+		Severity: "ERROR",
+	}, cfg)
+}
+
+func TestValidateConfig(t *testing.T) {
+	testCases := []struct {
+		name   string
+		expect string
+	}{
+		{name: "empty", expect: "either default_threshold or operations must be configured"},
+		{name: "negative_default_threshold", expect: "default_threshold can't be negative"},
+		{name: "missing_name", expect: "operations: name missing"},
+		{name: "bad_threshold", expect: `operation "legacy/GET /users": threshold must be greater than zero`},
+		{name: "duplicate_operation", expect: `operations: duplicate operation "GET /users"`},
+		{name: "bad_severity", expect: `unknown severity "LOUD"`},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			cm, err := confmaptest.LoadConf(filepath.Join("testdata", "config.yaml"))
+			require.NoError(t, err)
+
+			cfg := NewFactory().CreateDefaultConfig()
+			sub, err := cm.Sub(component.NewIDWithName(metadata.Type, tc.name).String())
+			require.NoError(t, err)
+			require.NoError(t, sub.Unmarshal(cfg))
+
+			assert.EqualError(t, component.ValidateConfig(cfg), tc.expect)
+		})
+	}
+}
@@ -0,0 +1,116 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package slowspanconnector // import "github.com/open-telemetry/opentelemetry-collector-contrib/connector/slowspanconnector"
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"go.opentelemetry.io/collector/component"
+	"go.opentelemetry.io/collector/consumer"
+	"go.opentelemetry.io/collector/pdata/pcommon"
+	"go.opentelemetry.io/collector/pdata/plog"
+	"go.opentelemetry.io/collector/pdata/ptrace"
+	conventions "go.opentelemetry.io/collector/semconv/v1.6.1"
+)
+
+const (
+	scopeName = "otelcol/slowspanconnector"
+
+	eventName = "slow_span"
+
+	attrEventName     = "event.name"
+	attrSpanName      = "span.name"
+	attrSpanKind      = "span.kind"
+	attrSpanDuration  = "span.duration_ms"
+	attrSpanThreshold = "span.threshold_ms"
+)
+
+// slowSpans emits a log event for every span lasting longer than the threshold
+// of its operation.
+type slowSpans struct {
+	defaultThreshold time.Duration
+	thresholds       map[operationKey]time.Duration
+	severity         string
+	logsConsumer     consumer.Logs
+	now              func() time.Time
+	component.StartFunc
+	component.ShutdownFunc
+}
+
+func (c *slowSpans) Capabilities() consumer.Capabilities {
+	return consumer.Capabilities{MutatesData: false}
+}
+
+func (c *slowSpans) ConsumeTraces(ctx context.Context, td ptrace.Traces) error {
+	ld := plog.NewLogs()
+	observed := pcommon.NewTimestampFromTime(c.now())
+	for i := 0; i < td.ResourceSpans().Len(); i++ {
+		rs := td.ResourceSpans().At(i)
+		service := ""
+		if v, ok := rs.Resource().Attributes().Get(conventions.AttributeServiceName); ok {
+			service = v.AsString()
+		}
+
+		// the resource logs are only added for the resources with slow spans
+		var events plog.LogRecordSlice
+		for j := 0; j < rs.ScopeSpans().Len(); j++ {
+			spans := rs.ScopeSpans().At(j).Spans()
+			for k := 0; k < spans.Len(); k++ {
+				span := spans.At(k)
+				threshold, ok := c.threshold(service, span.Name())
+				if !ok || span.EndTimestamp() < span.StartTimestamp() {
+					continue
+				}
+				duration := span.EndTimestamp().AsTime().Sub(span.StartTimestamp().AsTime())
+				if duration <= threshold {
+					continue
+				}
+				if events == (plog.LogRecordSlice{}) {
+					rl := ld.ResourceLogs().AppendEmpty()
+					rs.Resource().CopyTo(rl.Resource())
+					rl.SetSchemaUrl(rs.SchemaUrl())
+					sl := rl.ScopeLogs().AppendEmpty()
+					sl.Scope().SetName(scopeName)
+					events = sl.LogRecords()
+				}
+				c.appendEvent(events.AppendEmpty(), span, duration, threshold, observed)
+			}
+		}
+	}
+	if ld.LogRecordCount() == 0 {
+		return nil
+	}
+	return c.logsConsumer.ConsumeLogs(ctx, ld)
+}
+
+// threshold returns the threshold of the operation of the service, falling back
+// to the threshold of the operation for all the services, then to the default one.
+func (c *slowSpans) threshold(service, name string) (time.Duration, bool) {
+	if threshold, ok := c.thresholds[operationKey{service: service, name: name}]; ok {
+		return threshold, true
+	}
+	if threshold, ok := c.thresholds[operationKey{name: name}]; ok {
+		return threshold, true
+	}
+	return c.defaultThreshold, c.defaultThreshold > 0
+}
+
+func (c *slowSpans) appendEvent(lr plog.LogRecord, span ptrace.Span, duration, threshold time.Duration, observed pcommon.Timestamp) {
+	lr.SetTimestamp(span.EndTimestamp())
+	lr.SetObservedTimestamp(observed)
+	lr.SetSeverityText(c.severity)
+	lr.SetSeverityNumber(severityNumber(c.severity))
+	lr.SetTraceID(span.TraceID())
+	lr.SetSpanID(span.SpanID())
+	lr.Body().SetStr(fmt.Sprintf("span %q took %s, over the %s threshold", span.Name(), duration, threshold))
+
+	span.Attributes().CopyTo(lr.Attributes())
+	lr.Attributes().PutStr(attrEventName, eventName)
+	lr.Attributes().PutStr(attrSpanName, span.Name())
+	lr.Attributes().PutStr(attrSpanKind, span.Kind().String())
+	lr.Attributes().PutDouble(attrSpanDuration, float64(duration)/float64(time.Millisecond))
+	lr.Attributes().PutDouble(attrSpanThreshold, float64(threshold)/float64(time.Millisecond))
+}
@@ -0,0 +1,136 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package slowspanconnector
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/collector/connector/connectortest"
+	"go.opentelemetry.io/collector/consumer/consumertest"
+	"go.opentelemetry.io/collector/pdata/pcommon"
+	"go.opentelemetry.io/collector/pdata/plog"
+	"go.opentelemetry.io/collector/pdata/ptrace"
+)
+
+var start = time.Unix(1700000000, 0)
+
+func testConfig() *Config {
+	return &Config{
+		DefaultThreshold: 5 * time.Second,
+		Operations: []OperationConfig{
+			{Name: "GET /users", Threshold: 500 * time.Millisecond},
+			{Name: "GET /users", Service: "legacy", Threshold: 2 * time.Second},
+		},
+		Severity: defaultSeverity,
+	}
+}
+
+// testTraces returns the spans of the service lasting the given durations.
+func testTraces(service, name string, durations ...time.Duration) ptrace.Traces {
+	td := ptrace.NewTraces()
+	rs := td.ResourceSpans().AppendEmpty()
+	rs.Resource().Attributes().PutStr("service.name", service)
+	spans := rs.ScopeSpans().AppendEmpty().Spans()
+	for i, duration := range durations {
+		span := spans.AppendEmpty()
+		span.SetName(name)
+		span.SetKind(ptrace.SpanKindServer)
+		span.SetTraceID(pcommon.TraceID{1})
+		span.SetSpanID(pcommon.SpanID{byte(i + 1)})
+		span.SetStartTimestamp(pcommon.NewTimestampFromTime(start))
+		span.SetEndTimestamp(pcommon.NewTimestampFromTime(start.Add(duration)))
+		span.Attributes().PutStr("http.route", "/users")
+	}
+	return td
+}
+
+func newTestConnector(t *testing.T, sink *consumertest.LogsSink) *slowSpans {
+	require.NoError(t, testConfig().Validate())
+	conn, err := NewFactory().CreateTracesToLogs(context.Background(), connectortest.NewNopCreateSettings(), testConfig(), sink)
+	require.NoError(t, err)
+	c := conn.(*slowSpans)
+	c.now = func() time.Time { return start.Add(time.Minute) }
+	return c
+}
+
+func TestTracesToLogs(t *testing.T) {
+	sink := &consumertest.LogsSink{}
+	conn := newTestConnector(t, sink)
+
+	require.NoError(t, conn.ConsumeTraces(context.Background(), testTraces("users", "GET /users", 100*time.Millisecond, time.Second)))
+
+	require.Len(t, sink.AllLogs(), 1)
+	ld := sink.AllLogs()[0]
+	require.Equal(t, 1, ld.LogRecordCount())
+
+	rl := ld.ResourceLogs().At(0)
+	service, _ := rl.Resource().Attributes().Get("service.name")
+	assert.Equal(t, "users", service.Str())
+	assert.Equal(t, scopeName, rl.ScopeLogs().At(0).Scope().Name())
+
+	lr := rl.ScopeLogs().At(0).LogRecords().At(0)
+	assert.Equal(t, pcommon.NewTimestampFromTime(start.Add(time.Second)), lr.Timestamp())
+	assert.Equal(t, pcommon.NewTimestampFromTime(start.Add(time.Minute)), lr.ObservedTimestamp())
+	assert.Equal(t, "WARN", lr.SeverityText())
+	assert.Equal(t, plog.SeverityNumberWarn, lr.SeverityNumber())
+	assert.Equal(t, pcommon.TraceID{1}, lr.TraceID())
+	assert.Equal(t, pcommon.SpanID{2}, lr.SpanID())
+	assert.Equal(t, `span "GET /users" took 1s, over the 500ms threshold`, lr.Body().Str())
+	assert.Equal(t, map[string]any{
+		"http.route":        "/users",
+		"event.name":        "slow_span",
+		"span.name":         "GET /users",
+		"span.kind":         "Server",
+		"span.duration_ms":  float64(1000),
+		"span.threshold_ms": float64(500),
+	}, lr.Attributes().AsRaw())
+}
+
+func TestTracesToLogsThresholds(t *testing.T) {
+	testCases := []struct {
+		name     string
+		service  string
+		span     string
+		duration time.Duration
+		slow     bool
+	}{
+		{name: "operation threshold", service: "users", span: "GET /users", duration: time.Second, slow: true},
+		{name: "service operation threshold", service: "legacy", span: "GET /users", duration: time.Second, slow: false},
+		{name: "service operation threshold exceeded", service: "legacy", span: "GET /users", duration: 3 * time.Second, slow: true},
+		{name: "default threshold", service: "users", span: "GET /orders", duration: 3 * time.Second, slow: false},
+		{name: "default threshold exceeded", service: "users", span: "GET /orders", duration: 6 * time.Second, slow: true},
+		{name: "exactly the threshold", service: "users", span: "GET /users", duration: 500 * time.Millisecond, slow: false},
+		{name: "end before start", service: "users", span: "GET /users", duration: -time.Hour, slow: false},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			sink := &consumertest.LogsSink{}
+			conn := newTestConnector(t, sink)
+
+			require.NoError(t, conn.ConsumeTraces(context.Background(), testTraces(tc.service, tc.span, tc.duration)))
+
+			if tc.slow {
+				assert.Equal(t, 1, sink.LogRecordCount())
+			} else {
+				assert.Empty(t, sink.AllLogs())
+			}
+		})
+	}
+}
+
+func TestTracesToLogsWithoutDefaultThreshold(t *testing.T) {
+	sink := &consumertest.LogsSink{}
+	cfg := testConfig()
+	cfg.DefaultThreshold = 0
+	conn, err := NewFactory().CreateTracesToLogs(context.Background(), connectortest.NewNopCreateSettings(), cfg, sink)
+	require.NoError(t, err)
+
+	require.NoError(t, conn.ConsumeTraces(context.Background(), testTraces("users", "GET /orders", time.Hour)))
+	assert.Empty(t, sink.AllLogs())
+}
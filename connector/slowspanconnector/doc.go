@@ -0,0 +1,6 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+// Package slowspanconnector watches span streams and emits a warning log event
+// for every span exceeding the duration threshold of its operation.
+package slowspanconnector // import "github.com/open-telemetry/opentelemetry-collector-contrib/connector/slowspanconnector"
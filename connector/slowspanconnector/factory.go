@@ -0,0 +1,58 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+//go:generate mdatagen metadata.yaml
+
+package slowspanconnector // import "github.com/open-telemetry/opentelemetry-collector-contrib/connector/slowspanconnector"
+
+import (
+	"context"
+	"time"
+
+	"go.opentelemetry.io/collector/component"
+	"go.opentelemetry.io/collector/connector"
+	"go.opentelemetry.io/collector/consumer"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/connector/slowspanconnector/internal/metadata"
+)
+
+// NewFactory returns a ConnectorFactory.
+func NewFactory() connector.Factory {
+	return connector.NewFactory(
+		metadata.Type,
+		createDefaultConfig,
+		connector.WithTracesToLogs(createTracesToLogs, metadata.TracesToLogsStability),
+	)
+}
+
+// createDefaultConfig creates the default configuration.
+func createDefaultConfig() component.Config {
+	return &Config{
+		Severity: defaultSeverity,
+	}
+}
+
+// createTracesToLogs creates a traces to logs connector based on provided config.
+func createTracesToLogs(
+	_ context.Context,
+	_ connector.CreateSettings,
+	cfg component.Config,
+	nextConsumer consumer.Logs,
+) (connector.Traces, error) {
+	c := cfg.(*Config)
+	thresholds := make(map[operationKey]time.Duration, len(c.Operations))
+	for _, op := range c.Operations {
+		thresholds[operationKey{service: op.Service, name: op.Name}] = op.Threshold
+	}
+	severity := c.Severity
+	if severity == "" {
+		severity = defaultSeverity
+	}
+	return &slowSpans{
+		defaultThreshold: c.DefaultThreshold,
+		thresholds:       thresholds,
+		severity:         severity,
+		logsConsumer:     nextConsumer,
+		now:              time.Now,
+	}, nil
+}
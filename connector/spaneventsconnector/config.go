@@ -0,0 +1,83 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package spaneventsconnector // import "github.com/open-telemetry/opentelemetry-collector-contrib/connector/spaneventsconnector"
+
+import (
+	"errors"
+	"fmt"
+
+	"go.opentelemetry.io/collector/component"
+)
+
+const (
+	defaultMetricName        = "span.event.count"
+	defaultMetricDescription = "The number of span events observed."
+)
+
+// Dimension defines the dimension name and optional default value if the Dimension is missing from the span event
+// and span attributes.
+type Dimension struct {
+	Name    string  `mapstructure:"name"`
+	Default *string `mapstructure:"default"`
+}
+
+// Config defines the configuration options for spaneventsconnector.
+type Config struct {
+	// Events lists the names of the span events to convert, e.g. "exception".
+	// All the span events are converted when empty.
+	Events []string `mapstructure:"events"`
+
+	// Logs defines the log records the span events are converted to.
+	Logs LogsConfig `mapstructure:"logs"`
+
+	// Metrics defines the counter of the span events.
+	Metrics MetricsConfig `mapstructure:"metrics"`
+}
+
+type LogsConfig struct {
+	// Attributes lists the span event attributes copied to the log records.
+	// All the span event attributes are copied when empty.
+	Attributes []string `mapstructure:"attributes"`
+	// SpanAttributes lists the span attributes copied to the log records,
+	// the span event attributes taking precedence.
+	SpanAttributes []string `mapstructure:"span_attributes"`
+}
+
+type MetricsConfig struct {
+	// Name is the name of the counter.
+	Name string `mapstructure:"name"`
+	// Description is the description of the counter.
+	Description string `mapstructure:"description"`
+	// Dimensions defines the list of dimensions on top of event.name. The dimensions are fetched from the span event
+	// attributes, then from the span attributes.
+	Dimensions []Dimension `mapstructure:"dimensions"`
+}
+
+var _ component.ConfigValidator = (*Config)(nil)
+
+// Validate checks if the connector configuration is valid
+func (c Config) Validate() error {
+	if c.Metrics.Name == "" {
+		return errors.New("metrics: name missing")
+	}
+	if err := validateDimensions(c.Metrics.Dimensions); err != nil {
+		return fmt.Errorf("metrics: %w", err)
+	}
+	return nil
+}
+
+// validateDimensions checks duplicates for reserved dimensions and additional dimensions.
+func validateDimensions(dimensions []Dimension) error {
+	labelNames := map[string]struct{}{eventNameKey: {}}
+	for _, key := range dimensions {
+		if key.Name == "" {
+			return errors.New("dimension name missing")
+		}
+		if _, ok := labelNames[key.Name]; ok {
+			return fmt.Errorf("duplicate dimension name %q", key.Name)
+		}
+		labelNames[key.Name] = struct{}{}
+	}
+	return nil
+}
@@ -0,0 +1,39 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package spaneventsconnector // import "github.com/open-telemetry/opentelemetry-collector-contrib/connector/spaneventsconnector"
+
+import (
+	"go.opentelemetry.io/collector/pdata/ptrace"
+)
+
+const (
+	scopeName = "otelcol/spanevents"
+
+	eventNameKey = "event.name"
+	spanNameKey  = "span.name" // OpenTelemetry non-standard constant.
+	eventNameExc = "exception"
+)
+
+// eventFilter selects the span events to convert by name.
+type eventFilter map[string]struct{}
+
+func newEventFilter(names []string) eventFilter {
+	if len(names) == 0 {
+		return nil
+	}
+	f := make(eventFilter, len(names))
+	for _, name := range names {
+		f[name] = struct{}{}
+	}
+	return f
+}
+
+// matches returns whether the span event is converted, all the span events being converted without names.
+func (f eventFilter) matches(event ptrace.SpanEvent) bool {
+	if f == nil {
+		return true
+	}
+	_, ok := f[event.Name()]
+	return ok
+}
@@ -0,0 +1,125 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package spaneventsconnector // import "github.com/open-telemetry/opentelemetry-collector-contrib/connector/spaneventsconnector"
+
+import (
+	"context"
+	"time"
+
+	"go.opentelemetry.io/collector/component"
+	"go.opentelemetry.io/collector/consumer"
+	"go.opentelemetry.io/collector/pdata/pcommon"
+	"go.opentelemetry.io/collector/pdata/plog"
+	"go.opentelemetry.io/collector/pdata/ptrace"
+	"go.uber.org/zap"
+)
+
+// logsConnector converts the span events to log records, keeping their resource and scope.
+type logsConnector struct {
+	events         eventFilter
+	attributes     []string
+	spanAttributes []string
+
+	logsConsumer consumer.Logs
+	component.StartFunc
+	component.ShutdownFunc
+
+	logger *zap.Logger
+}
+
+func newLogsConnector(logger *zap.Logger, cfg *Config, logsConsumer consumer.Logs) *logsConnector {
+	return &logsConnector{
+		events:         newEventFilter(cfg.Events),
+		attributes:     cfg.Logs.Attributes,
+		spanAttributes: cfg.Logs.SpanAttributes,
+		logsConsumer:   logsConsumer,
+		logger:         logger,
+	}
+}
+
+// Capabilities implements the consumer interface.
+func (c *logsConnector) Capabilities() consumer.Capabilities {
+	return consumer.Capabilities{MutatesData: false}
+}
+
+// ConsumeTraces implements the consumer.Traces interface.
+// It converts the span events to log records.
+func (c *logsConnector) ConsumeTraces(ctx context.Context, traces ptrace.Traces) error {
+	ld := plog.NewLogs()
+	observed := pcommon.NewTimestampFromTime(time.Now())
+	for i := 0; i < traces.ResourceSpans().Len(); i++ {
+		rspans := traces.ResourceSpans().At(i)
+		rl := ld.ResourceLogs().AppendEmpty()
+		rspans.Resource().CopyTo(rl.Resource())
+		rl.SetSchemaUrl(rspans.SchemaUrl())
+		for j := 0; j < rspans.ScopeSpans().Len(); j++ {
+			ils := rspans.ScopeSpans().At(j)
+			sl := rl.ScopeLogs().AppendEmpty()
+			ils.Scope().CopyTo(sl.Scope())
+			sl.SetSchemaUrl(ils.SchemaUrl())
+			for k := 0; k < ils.Spans().Len(); k++ {
+				span := ils.Spans().At(k)
+				for l := 0; l < span.Events().Len(); l++ {
+					event := span.Events().At(l)
+					if c.events.matches(event) {
+						c.eventToLogRecord(sl.LogRecords().AppendEmpty(), span, event, observed)
+					}
+				}
+			}
+		}
+	}
+	// Only the resources and scopes with converted span events are kept.
+	ld.ResourceLogs().RemoveIf(func(rl plog.ResourceLogs) bool {
+		rl.ScopeLogs().RemoveIf(func(sl plog.ScopeLogs) bool {
+			return sl.LogRecords().Len() == 0
+		})
+		return rl.ScopeLogs().Len() == 0
+	})
+	if ld.LogRecordCount() == 0 {
+		return nil
+	}
+	if err := c.logsConsumer.ConsumeLogs(ctx, ld); err != nil {
+		c.logger.Error("failed to convert span events to logs", zap.Error(err))
+		return err
+	}
+	return nil
+}
+
+// eventToLogRecord fills the log record from the span event, the body being the name of the span event.
+func (c *logsConnector) eventToLogRecord(logRecord plog.LogRecord, span ptrace.Span, event ptrace.SpanEvent, observed pcommon.Timestamp) {
+	logRecord.SetTimestamp(event.Timestamp())
+	logRecord.SetObservedTimestamp(observed)
+	logRecord.SetTraceID(span.TraceID())
+	logRecord.SetSpanID(span.SpanID())
+	if event.Name() == eventNameExc {
+		logRecord.SetSeverityNumber(plog.SeverityNumberError)
+		logRecord.SetSeverityText("ERROR")
+	} else {
+		logRecord.SetSeverityNumber(plog.SeverityNumberInfo)
+		logRecord.SetSeverityText("INFO")
+	}
+	logRecord.Body().SetStr(event.Name())
+
+	attrs := logRecord.Attributes()
+	copyAttributes(attrs, span.Attributes(), c.spanAttributes)
+	if len(c.attributes) == 0 {
+		event.Attributes().Range(func(k string, v pcommon.Value) bool {
+			v.CopyTo(attrs.PutEmpty(k))
+			return true
+		})
+	} else {
+		copyAttributes(attrs, event.Attributes(), c.attributes)
+	}
+	attrs.PutStr(eventNameKey, event.Name())
+	attrs.PutStr(spanNameKey, span.Name())
+}
+
+// copyAttributes copies the attributes with the given keys.
+func copyAttributes(dest pcommon.Map, src pcommon.Map, keys []string) {
+	for _, key := range keys {
+		if v, ok := src.Get(key); ok {
+			v.CopyTo(dest.PutEmpty(key))
+		}
+	}
+}
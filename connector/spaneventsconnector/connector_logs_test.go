@@ -0,0 +1,97 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package spaneventsconnector
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/collector/component/componenttest"
+	"go.opentelemetry.io/collector/consumer/consumertest"
+	"go.opentelemetry.io/collector/pdata/plog"
+	"go.opentelemetry.io/collector/pdata/ptrace"
+	"go.uber.org/zap/zaptest"
+)
+
+func TestConnectorLogsConsumeTraces(t *testing.T) {
+	sink := new(consumertest.LogsSink)
+	c := newLogsConnector(zaptest.NewLogger(t), createDefaultConfig().(*Config), sink)
+	require.NoError(t, c.Start(context.Background(), componenttest.NewNopHost()))
+	defer func() { require.NoError(t, c.Shutdown(context.Background())) }()
+
+	require.NoError(t, c.ConsumeTraces(context.Background(), buildSampleTrace()))
+	require.Len(t, sink.AllLogs(), 1)
+	ld := sink.AllLogs()[0]
+
+	require.Equal(t, 1, ld.ResourceLogs().Len(), "Must only keep the resources with span events")
+	rl := ld.ResourceLogs().At(0)
+	assert.Equal(t, "https://opentelemetry.io/schemas/1.9.0", rl.SchemaUrl())
+	assert.Equal(t, map[string]any{"service.name": "checkout"}, rl.Resource().Attributes().AsRaw())
+	require.Equal(t, 1, rl.ScopeLogs().Len())
+	sl := rl.ScopeLogs().At(0)
+	assert.Equal(t, "checkout-instrumentation", sl.Scope().Name())
+	require.Equal(t, 3, sl.LogRecords().Len())
+
+	exception := sl.LogRecords().At(0)
+	assert.Equal(t, "exception", exception.Body().Str())
+	assert.Equal(t, testTimestamp, exception.Timestamp())
+	assert.NotZero(t, exception.ObservedTimestamp())
+	assert.Equal(t, testTraceID, exception.TraceID())
+	assert.Equal(t, testSpanID, exception.SpanID())
+	assert.Equal(t, plog.SeverityNumberError, exception.SeverityNumber())
+	assert.Equal(t, "ERROR", exception.SeverityText())
+	assert.Equal(t, map[string]any{
+		"event.name":           "exception",
+		"span.name":            "POST /checkout",
+		"exception.type":       "PaymentError",
+		"exception.message":    "card declined",
+		"exception.stacktrace": "at pay()",
+	}, exception.Attributes().AsRaw())
+
+	retry := sl.LogRecords().At(1)
+	assert.Equal(t, "retry", retry.Body().Str())
+	assert.Equal(t, plog.SeverityNumberInfo, retry.SeverityNumber())
+	assert.Equal(t, map[string]any{
+		"event.name": "retry",
+		"span.name":  "POST /checkout",
+		"attempt":    int64(2),
+	}, retry.Attributes().AsRaw())
+}
+
+func TestConnectorLogsAttributeSelection(t *testing.T) {
+	cfg := createDefaultConfig().(*Config)
+	cfg.Events = []string{"exception"}
+	cfg.Logs.Attributes = []string{"exception.type", "exception.message"}
+	cfg.Logs.SpanAttributes = []string{"http.route", "exception.type"}
+	sink := new(consumertest.LogsSink)
+	c := newLogsConnector(zaptest.NewLogger(t), cfg, sink)
+
+	traces := buildSampleTrace()
+	traces.ResourceSpans().At(0).ScopeSpans().At(0).Spans().At(0).Attributes().PutStr("exception.type", "Error")
+	require.NoError(t, c.ConsumeTraces(context.Background(), traces))
+	require.Len(t, sink.AllLogs(), 1)
+	records := sink.AllLogs()[0].ResourceLogs().At(0).ScopeLogs().At(0).LogRecords()
+	require.Equal(t, 2, records.Len(), "Must only convert the configured span events")
+	for i := 0; i < records.Len(); i++ {
+		assert.Equal(t, map[string]any{
+			"event.name":        "exception",
+			"span.name":         "POST /checkout",
+			"exception.type":    "PaymentError",
+			"exception.message": "card declined",
+			"http.route":        "/checkout",
+		}, records.At(i).Attributes().AsRaw(), "Must only copy the selected attributes, preferring the span event ones")
+	}
+}
+
+func TestConnectorLogsWithoutEvents(t *testing.T) {
+	sink := new(consumertest.LogsSink)
+	c := newLogsConnector(zaptest.NewLogger(t), createDefaultConfig().(*Config), sink)
+
+	traces := ptrace.NewTraces()
+	traces.ResourceSpans().AppendEmpty().ScopeSpans().AppendEmpty().Spans().AppendEmpty().SetName("GET /")
+	require.NoError(t, c.ConsumeTraces(context.Background(), traces))
+	assert.Empty(t, sink.AllLogs(), "Must not export empty logs")
+}
@@ -0,0 +1,168 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package spaneventsconnector // import "github.com/open-telemetry/opentelemetry-collector-contrib/connector/spaneventsconnector"
+
+import (
+	"context"
+	"time"
+
+	"go.opentelemetry.io/collector/component"
+	"go.opentelemetry.io/collector/consumer"
+	"go.opentelemetry.io/collector/pdata/pcommon"
+	"go.opentelemetry.io/collector/pdata/pmetric"
+	"go.opentelemetry.io/collector/pdata/ptrace"
+	"go.uber.org/zap"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/pdatautil"
+)
+
+// metricsConnector counts the span events of each resource, emitting delta sums.
+type metricsConnector struct {
+	events      eventFilter
+	name        string
+	description string
+	dimensions  []dimension
+
+	metricsConsumer consumer.Metrics
+	component.StartFunc
+	component.ShutdownFunc
+
+	logger *zap.Logger
+}
+
+type dimension struct {
+	name  string
+	value *pcommon.Value
+}
+
+func newDimensions(cfgDims []Dimension) []dimension {
+	if len(cfgDims) == 0 {
+		return nil
+	}
+	dims := make([]dimension, len(cfgDims))
+	for i := range cfgDims {
+		dims[i].name = cfgDims[i].Name
+		if cfgDims[i].Default != nil {
+			val := pcommon.NewValueStr(*cfgDims[i].Default)
+			dims[i].value = &val
+		}
+	}
+	return dims
+}
+
+type eventCount struct {
+	attrs pcommon.Map
+	count int64
+}
+
+func newMetricsConnector(logger *zap.Logger, cfg *Config, metricsConsumer consumer.Metrics) *metricsConnector {
+	return &metricsConnector{
+		events:          newEventFilter(cfg.Events),
+		name:            cfg.Metrics.Name,
+		description:     cfg.Metrics.Description,
+		dimensions:      newDimensions(cfg.Metrics.Dimensions),
+		metricsConsumer: metricsConsumer,
+		logger:          logger,
+	}
+}
+
+// Capabilities implements the consumer interface.
+func (c *metricsConnector) Capabilities() consumer.Capabilities {
+	return consumer.Capabilities{MutatesData: false}
+}
+
+// ConsumeTraces implements the consumer.Traces interface.
+// It counts the span events by name and configured dimensions.
+func (c *metricsConnector) ConsumeTraces(ctx context.Context, traces ptrace.Traces) error {
+	md := pmetric.NewMetrics()
+	timestamp := pcommon.NewTimestampFromTime(time.Now())
+	for i := 0; i < traces.ResourceSpans().Len(); i++ {
+		rspans := traces.ResourceSpans().At(i)
+		counts := map[[16]byte]*eventCount{}
+		var keys [][16]byte
+		for j := 0; j < rspans.ScopeSpans().Len(); j++ {
+			ils := rspans.ScopeSpans().At(j)
+			for k := 0; k < ils.Spans().Len(); k++ {
+				span := ils.Spans().At(k)
+				for l := 0; l < span.Events().Len(); l++ {
+					event := span.Events().At(l)
+					if !c.events.matches(event) {
+						continue
+					}
+					attrs := c.buildAttributes(span, event)
+					key := pdatautil.MapHash(attrs)
+					ec, ok := counts[key]
+					if !ok {
+						ec = &eventCount{attrs: attrs}
+						counts[key] = ec
+						keys = append(keys, key)
+					}
+					ec.count++
+				}
+			}
+		}
+		if len(counts) == 0 {
+			continue // don't add an empty resource
+		}
+
+		rm := md.ResourceMetrics().AppendEmpty()
+		rspans.Resource().CopyTo(rm.Resource())
+		sm := rm.ScopeMetrics().AppendEmpty()
+		sm.Scope().SetName(scopeName)
+		metric := sm.Metrics().AppendEmpty()
+		metric.SetName(c.name)
+		metric.SetDescription(c.description)
+		sum := metric.SetEmptySum()
+		// The delta value is always positive, so a value accumulated downstream is monotonic
+		sum.SetIsMonotonic(true)
+		sum.SetAggregationTemporality(pmetric.AggregationTemporalityDelta)
+		for _, key := range keys {
+			dp := sum.DataPoints().AppendEmpty()
+			counts[key].attrs.CopyTo(dp.Attributes())
+			dp.SetIntValue(counts[key].count)
+			dp.SetTimestamp(timestamp)
+		}
+	}
+	if md.ResourceMetrics().Len() == 0 {
+		return nil
+	}
+	if err := c.metricsConsumer.ConsumeMetrics(ctx, md); err != nil {
+		c.logger.Error("failed to convert span events to metrics", zap.Error(err))
+		return err
+	}
+	return nil
+}
+
+// buildAttributes returns the attributes of the data point counting the span event.
+func (c *metricsConnector) buildAttributes(span ptrace.Span, event ptrace.SpanEvent) pcommon.Map {
+	attrs := pcommon.NewMap()
+	attrs.EnsureCapacity(1 + len(c.dimensions))
+	attrs.PutStr(eventNameKey, event.Name())
+	for _, d := range c.dimensions {
+		if v, ok := getDimensionValue(d, event.Attributes(), span.Attributes()); ok {
+			v.CopyTo(attrs.PutEmpty(d.name))
+		}
+	}
+	return attrs
+}
+
+// getDimensionValue gets the dimension value for the given configured dimension.
+// It searches through the span event attributes first, being the more specific;
+// falling back to searching in the span attributes if it can't be found in the span event.
+// Finally, falls back to the configured default value if provided.
+//
+// The ok flag indicates if a dimension value was fetched in order to differentiate
+// an empty string value from a state where no value was found.
+func getDimensionValue(d dimension, eventAttr pcommon.Map, spanAttr pcommon.Map) (v pcommon.Value, ok bool) {
+	if attr, exists := eventAttr.Get(d.name); exists {
+		return attr, true
+	}
+	if attr, exists := spanAttr.Get(d.name); exists {
+		return attr, true
+	}
+	if d.value != nil {
+		return *d.value, true
+	}
+	return v, ok
+}
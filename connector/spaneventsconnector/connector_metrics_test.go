@@ -0,0 +1,77 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package spaneventsconnector
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/collector/consumer/consumertest"
+	"go.opentelemetry.io/collector/pdata/pmetric"
+	"go.uber.org/zap/zaptest"
+)
+
+func TestConnectorMetricsConsumeTraces(t *testing.T) {
+	defaultStatusCode := "unknown"
+	cfg := createDefaultConfig().(*Config)
+	cfg.Metrics.Dimensions = []Dimension{
+		{Name: "exception.type"},
+		{Name: "http.route"},
+		{Name: "http.status_code", Default: &defaultStatusCode},
+	}
+	sink := new(consumertest.MetricsSink)
+	c := newMetricsConnector(zaptest.NewLogger(t), cfg, sink)
+
+	require.NoError(t, c.ConsumeTraces(context.Background(), buildSampleTrace()))
+	require.Len(t, sink.AllMetrics(), 1)
+	md := sink.AllMetrics()[0]
+
+	require.Equal(t, 1, md.ResourceMetrics().Len(), "Must only keep the resources with span events")
+	rm := md.ResourceMetrics().At(0)
+	assert.Equal(t, map[string]any{"service.name": "checkout"}, rm.Resource().Attributes().AsRaw())
+	require.Equal(t, 1, rm.ScopeMetrics().Len())
+	assert.Equal(t, scopeName, rm.ScopeMetrics().At(0).Scope().Name())
+	require.Equal(t, 1, rm.ScopeMetrics().At(0).Metrics().Len())
+
+	metric := rm.ScopeMetrics().At(0).Metrics().At(0)
+	assert.Equal(t, defaultMetricName, metric.Name())
+	assert.Equal(t, defaultMetricDescription, metric.Description())
+	assert.True(t, metric.Sum().IsMonotonic())
+	assert.Equal(t, pmetric.AggregationTemporalityDelta, metric.Sum().AggregationTemporality())
+
+	dps := metric.Sum().DataPoints()
+	require.Equal(t, 2, dps.Len())
+	assert.Equal(t, map[string]any{
+		"event.name":       "exception",
+		"exception.type":   "PaymentError",
+		"http.route":       "/checkout",
+		"http.status_code": "unknown",
+	}, dps.At(0).Attributes().AsRaw())
+	assert.Equal(t, int64(2), dps.At(0).IntValue())
+	assert.Equal(t, map[string]any{
+		"event.name":       "retry",
+		"http.route":       "/checkout",
+		"http.status_code": "unknown",
+	}, dps.At(1).Attributes().AsRaw())
+	assert.Equal(t, int64(1), dps.At(1).IntValue())
+	assert.NotZero(t, dps.At(1).Timestamp())
+}
+
+func TestConnectorMetricsEventFilter(t *testing.T) {
+	cfg := createDefaultConfig().(*Config)
+	cfg.Events = []string{"timeout"}
+	sink := new(consumertest.MetricsSink)
+	c := newMetricsConnector(zaptest.NewLogger(t), cfg, sink)
+
+	require.NoError(t, c.ConsumeTraces(context.Background(), buildSampleTrace()))
+	assert.Empty(t, sink.AllMetrics(), "Must not export metrics without matching span events")
+}
+
+func TestConnectorMetricsConsumeError(t *testing.T) {
+	c := newMetricsConnector(zaptest.NewLogger(t), createDefaultConfig().(*Config), consumertest.NewErr(errors.New("boom")))
+	assert.EqualError(t, c.ConsumeTraces(context.Background(), buildSampleTrace()), "boom")
+}
@@ -0,0 +1,55 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package spaneventsconnector
+
+import (
+	"time"
+
+	"go.opentelemetry.io/collector/pdata/pcommon"
+	"go.opentelemetry.io/collector/pdata/ptrace"
+)
+
+var (
+	testTraceID   = pcommon.TraceID{1, 2, 3, 4, 5, 6, 7, 8, 9, 10, 11, 12, 13, 14, 15, 16}
+	testSpanID    = pcommon.SpanID{1, 2, 3, 4, 5, 6, 7, 8}
+	testTimestamp = pcommon.NewTimestampFromTime(time.Date(2024, 6, 1, 0, 0, 0, 0, time.UTC))
+)
+
+// buildSampleTrace builds traces of two services, the first having a span with an exception and a retry event,
+// and the second a span without events.
+func buildSampleTrace() ptrace.Traces {
+	traces := ptrace.NewTraces()
+
+	rs := traces.ResourceSpans().AppendEmpty()
+	rs.SetSchemaUrl("https://opentelemetry.io/schemas/1.9.0")
+	rs.Resource().Attributes().PutStr("service.name", "checkout")
+	ss := rs.ScopeSpans().AppendEmpty()
+	ss.Scope().SetName("checkout-instrumentation")
+	span := ss.Spans().AppendEmpty()
+	span.SetName("POST /checkout")
+	span.SetTraceID(testTraceID)
+	span.SetSpanID(testSpanID)
+	span.Attributes().PutStr("http.route", "/checkout")
+	span.Attributes().PutStr("http.method", "POST")
+
+	exception := span.Events().AppendEmpty()
+	exception.SetName("exception")
+	exception.SetTimestamp(testTimestamp)
+	exception.Attributes().PutStr("exception.type", "PaymentError")
+	exception.Attributes().PutStr("exception.message", "card declined")
+	exception.Attributes().PutStr("exception.stacktrace", "at pay()")
+
+	retry := span.Events().AppendEmpty()
+	retry.SetName("retry")
+	retry.SetTimestamp(testTimestamp + 1)
+	retry.Attributes().PutInt("attempt", 2)
+
+	exception.CopyTo(span.Events().AppendEmpty())
+
+	other := traces.ResourceSpans().AppendEmpty()
+	other.Resource().Attributes().PutStr("service.name", "cart")
+	other.ScopeSpans().AppendEmpty().Spans().AppendEmpty().SetName("GET /cart")
+
+	return traces
+}
@@ -0,0 +1,41 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package spaneventsconnector
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/collector/connector/connectortest"
+	"go.opentelemetry.io/collector/consumer/consumertest"
+	"go.opentelemetry.io/collector/pdata/pcommon"
+)
+
+func TestNewConnector(t *testing.T) {
+	defaultType := "unknown"
+	defaultTypeValue := pcommon.NewValueStr(defaultType)
+
+	factory := NewFactory()
+	cfg := factory.CreateDefaultConfig().(*Config)
+	cfg.Events = []string{"exception"}
+	cfg.Metrics.Dimensions = []Dimension{
+		{Name: "exception.type", Default: &defaultType},
+		{Name: "http.route"},
+	}
+
+	tracesToMetrics, err := factory.CreateTracesToMetrics(context.Background(), connectortest.NewNopCreateSettings(), cfg, consumertest.NewNop())
+	require.NoError(t, err)
+	mc := tracesToMetrics.(*metricsConnector)
+	assert.Equal(t, []dimension{
+		{name: "exception.type", value: &defaultTypeValue},
+		{name: "http.route"},
+	}, mc.dimensions)
+	assert.Equal(t, eventFilter{"exception": {}}, mc.events)
+
+	tracesToLogs, err := factory.CreateTracesToLogs(context.Background(), connectortest.NewNopCreateSettings(), cfg, consumertest.NewNop())
+	require.NoError(t, err)
+	assert.Equal(t, eventFilter{"exception": {}}, tracesToLogs.(*logsConnector).events)
+}
@@ -96,6 +96,9 @@ type HistogramConfig struct {
 type ExemplarsConfig struct {
 	Enabled         bool `mapstructure:"enabled"`
 	MaxPerDataPoint *int `mapstructure:"max_per_data_point"`
+	// PerBucket keeps the exemplar of the latest span of each explicit duration histogram bucket,
+	// rather than the first spans of the flush interval, so that exemplars cover the whole latency range.
+	PerBucket bool `mapstructure:"per_bucket"`
 }
 
 type ExponentialHistogramConfig struct {
@@ -105,6 +108,16 @@ type ExponentialHistogramConfig struct {
 type ExplicitHistogramConfig struct {
 	// Buckets is the list of durations representing explicit histogram buckets.
 	Buckets []time.Duration `mapstructure:"buckets"`
+	// Overrides defines the buckets of the histograms of specific dimension sets, the first matching override applying.
+	Overrides []BucketsOverride `mapstructure:"overrides"`
+}
+
+// BucketsOverride defines the buckets of the histograms with all the dimension values.
+type BucketsOverride struct {
+	// Dimensions maps the dimension names to the values the histogram dimensions must have.
+	Dimensions map[string]string `mapstructure:"dimensions"`
+	// Buckets is the list of durations representing the histogram buckets.
+	Buckets []time.Duration `mapstructure:"buckets"`
 }
 
 type EventsConfig struct {
@@ -136,6 +149,16 @@ func (c Config) Validate() error {
 		return errors.New("use either `explicit` or `exponential` buckets histogram")
 	}
 
+	if c.Histogram.Explicit != nil {
+		if err := validateBucketsOverrides(c.Histogram.Explicit.Overrides, c.Dimensions, c.ExcludeDimensions); err != nil {
+			return fmt.Errorf("failed validating histogram overrides: %w", err)
+		}
+	}
+
+	if c.Exemplars.PerBucket && c.Histogram.Exponential != nil {
+		return errors.New("exemplars `per_bucket` requires an `explicit` buckets histogram")
+	}
+
 	if c.MetricsFlushInterval < 0 {
 		return fmt.Errorf("invalid metrics_flush_interval: %v, the duration should be positive", c.MetricsFlushInterval)
 	}
@@ -197,3 +220,31 @@ func validateEventDimensions(enabled bool, dimensions []Dimension) error {
 	}
 	return validateDimensions(dimensions)
 }
+
+// validateBucketsOverrides checks that the overrides have buckets and only match the dimensions of the metrics.
+func validateBucketsOverrides(overrides []BucketsOverride, dimensions []Dimension, excludeDimensions []string) error {
+	dimensionNames := make(map[string]struct{})
+	for _, key := range []string{serviceNameKey, spanKindKey, statusCodeKey, spanNameKey} {
+		if !contains(excludeDimensions, key) {
+			dimensionNames[key] = struct{}{}
+		}
+	}
+	for _, d := range dimensions {
+		dimensionNames[d.Name] = struct{}{}
+	}
+
+	for i, o := range overrides {
+		if len(o.Dimensions) == 0 {
+			return fmt.Errorf("no dimensions configured for override %d", i)
+		}
+		if len(o.Buckets) == 0 {
+			return fmt.Errorf("no buckets configured for override %d", i)
+		}
+		for name := range o.Dimensions {
+			if _, ok := dimensionNames[name]; !ok {
+				return fmt.Errorf("unknown dimension name %s for override %d", name, i)
+			}
+		}
+	}
+	return nil
+}
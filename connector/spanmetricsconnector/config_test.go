@@ -151,6 +151,37 @@ func TestLoadConfig(t *testing.T) {
 				assert.Equal(t, defaultDeltaTimestampCacheSize, config.GetDeltaTimestampCacheSize())
 			},
 		},
+		{
+			id: component.NewIDWithName(metadata.Type, "explicit_histogram_overrides"),
+			expected: &Config{
+				AggregationTemporality:   "AGGREGATION_TEMPORALITY_CUMULATIVE",
+				Dimensions:               []Dimension{{Name: "http.route"}},
+				DimensionsCacheSize:      defaultDimensionsCacheSize,
+				ResourceMetricsCacheSize: defaultResourceMetricsCacheSize,
+				MetricsFlushInterval:     60 * time.Second,
+				Histogram: HistogramConfig{
+					Unit: defaultUnit,
+					Explicit: &ExplicitHistogramConfig{
+						Buckets: []time.Duration{10 * time.Millisecond, 100 * time.Millisecond},
+						Overrides: []BucketsOverride{
+							{
+								Dimensions: map[string]string{"http.route": "/checkout"},
+								Buckets:    []time.Duration{time.Millisecond, 2 * time.Millisecond, 5 * time.Millisecond},
+							},
+						},
+					},
+				},
+				Exemplars: ExemplarsConfig{Enabled: true, PerBucket: true},
+			},
+		},
+		{
+			id:           component.NewIDWithName(metadata.Type, "invalid_histogram_overrides"),
+			errorMessage: "failed validating histogram overrides: unknown dimension name http.route for override 0",
+		},
+		{
+			id:           component.NewIDWithName(metadata.Type, "exemplars_per_bucket_exponential_histogram"),
+			errorMessage: "exemplars `per_bucket` requires an `explicit` buckets histogram",
+		},
 		{
 			id:           component.NewIDWithName(metadata.Type, "invalid_delta_timestamp_cache_size"),
 			errorMessage: "invalid delta timestamp cache size: 0, the maximum number of the items in the cache should be positive",
@@ -235,6 +266,53 @@ func TestValidateDimensions(t *testing.T) {
 	}
 }
 
+func TestValidateBucketsOverrides(t *testing.T) {
+	for _, tc := range []struct {
+		name              string
+		overrides         []BucketsOverride
+		excludeDimensions []string
+		expectedErr       string
+	}{
+		{
+			name: "default and additional dimensions",
+			overrides: []BucketsOverride{
+				{Dimensions: map[string]string{"span.name": "GET /checkout"}, Buckets: []time.Duration{time.Millisecond}},
+				{Dimensions: map[string]string{"http.route": "/checkout"}, Buckets: []time.Duration{time.Millisecond}},
+			},
+		},
+		{
+			name:        "no dimensions",
+			overrides:   []BucketsOverride{{Buckets: []time.Duration{time.Millisecond}}},
+			expectedErr: "no dimensions configured for override 0",
+		},
+		{
+			name:        "no buckets",
+			overrides:   []BucketsOverride{{Dimensions: map[string]string{"http.route": "/checkout"}}},
+			expectedErr: "no buckets configured for override 0",
+		},
+		{
+			name:        "unknown dimension",
+			overrides:   []BucketsOverride{{Dimensions: map[string]string{"http.method": "GET"}, Buckets: []time.Duration{time.Millisecond}}},
+			expectedErr: "unknown dimension name http.method for override 0",
+		},
+		{
+			name:              "excluded dimension",
+			overrides:         []BucketsOverride{{Dimensions: map[string]string{"span.name": "GET /"}, Buckets: []time.Duration{time.Millisecond}}},
+			excludeDimensions: []string{"span.name"},
+			expectedErr:       "unknown dimension name span.name for override 0",
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			err := validateBucketsOverrides(tc.overrides, []Dimension{{Name: "http.route"}}, tc.excludeDimensions)
+			if tc.expectedErr != "" {
+				assert.EqualError(t, err, tc.expectedErr)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
+
 func TestValidateEventDimensions(t *testing.T) {
 	for _, tc := range []struct {
 		enabled     bool
@@ -168,6 +168,15 @@ func initHistogramMetrics(cfg Config) metrics.HistogramMetrics {
 	}
 
 	var bounds []float64
+	var overrides []metrics.BoundsOverride
+	if cfg.Histogram.Explicit != nil {
+		for _, o := range cfg.Histogram.Explicit.Overrides {
+			overrides = append(overrides, metrics.BoundsOverride{
+				Attributes: o.Dimensions,
+				Bounds:     durationsToUnits(o.Buckets, unitDivider(cfg.Histogram.Unit)),
+			})
+		}
+	}
 	if cfg.Histogram.Explicit != nil && cfg.Histogram.Explicit.Buckets != nil {
 		bounds = durationsToUnits(cfg.Histogram.Explicit.Buckets, unitDivider(cfg.Histogram.Unit))
 	} else {
@@ -182,7 +191,7 @@ func initHistogramMetrics(cfg Config) metrics.HistogramMetrics {
 		}
 	}
 
-	return metrics.NewExplicitHistogramMetrics(bounds, cfg.Exemplars.MaxPerDataPoint)
+	return metrics.NewExplicitHistogramMetrics(bounds, overrides, cfg.Exemplars.MaxPerDataPoint, cfg.Exemplars.PerBucket)
 }
 
 // unitDivider returns a unit divider to convert nanoseconds to milliseconds or seconds.
@@ -1377,7 +1377,7 @@ func TestConnector_initHistogramMetrics(t *testing.T) {
 		{
 			name:   "initialize histogram with no config provided",
 			config: Config{},
-			want:   metrics.NewExplicitHistogramMetrics(defaultHistogramBucketsMs, nil),
+			want:   metrics.NewExplicitHistogramMetrics(defaultHistogramBucketsMs, nil, nil, false),
 		},
 		{
 			name: "Disable histogram",
@@ -1395,7 +1395,7 @@ func TestConnector_initHistogramMetrics(t *testing.T) {
 					Unit: metrics.Milliseconds,
 				},
 			},
-			want: metrics.NewExplicitHistogramMetrics(defaultHistogramBucketsMs, nil),
+			want: metrics.NewExplicitHistogramMetrics(defaultHistogramBucketsMs, nil, nil, false),
 		},
 		{
 			name: "initialize explicit histogram with default bounds (seconds)",
@@ -1404,7 +1404,7 @@ func TestConnector_initHistogramMetrics(t *testing.T) {
 					Unit: metrics.Seconds,
 				},
 			},
-			want: metrics.NewExplicitHistogramMetrics(defaultHistogramBucketsSeconds, nil),
+			want: metrics.NewExplicitHistogramMetrics(defaultHistogramBucketsSeconds, nil, nil, false),
 		},
 		{
 			name: "initialize explicit histogram with bounds (seconds)",
@@ -1419,7 +1419,7 @@ func TestConnector_initHistogramMetrics(t *testing.T) {
 					},
 				},
 			},
-			want: metrics.NewExplicitHistogramMetrics([]float64{0.1, 1}, nil),
+			want: metrics.NewExplicitHistogramMetrics([]float64{0.1, 1}, nil, nil, false),
 		},
 		{
 			name: "initialize explicit histogram with bounds (ms)",
@@ -1434,7 +1434,31 @@ func TestConnector_initHistogramMetrics(t *testing.T) {
 					},
 				},
 			},
-			want: metrics.NewExplicitHistogramMetrics([]float64{100, 1000}, nil),
+			want: metrics.NewExplicitHistogramMetrics([]float64{100, 1000}, nil, nil, false),
+		},
+		{
+			name: "initialize explicit histogram with overrides and exemplars per bucket (ms)",
+			config: Config{
+				Histogram: HistogramConfig{
+					Unit: metrics.Milliseconds,
+					Explicit: &ExplicitHistogramConfig{
+						Buckets: []time.Duration{
+							100 * time.Millisecond,
+							1000 * time.Millisecond,
+						},
+						Overrides: []BucketsOverride{
+							{
+								Dimensions: map[string]string{"http.route": "/checkout"},
+								Buckets:    []time.Duration{10 * time.Millisecond},
+							},
+						},
+					},
+				},
+				Exemplars: ExemplarsConfig{Enabled: true, PerBucket: true},
+			},
+			want: metrics.NewExplicitHistogramMetrics([]float64{100, 1000}, []metrics.BoundsOverride{
+				{Attributes: map[string]string{"http.route": "/checkout"}, Bounds: []float64{10}},
+			}, nil, true),
 		},
 		{
 			name: "initialize exponential histogram",
@@ -4,6 +4,7 @@
 package metrics // import "github.com/open-telemetry/opentelemetry-collector-contrib/connector/spanmetricsconnector/internal/metrics"
 
 import (
+	"slices"
 	"sort"
 
 	"github.com/lightstep/go-expohisto/structure"
@@ -24,10 +25,19 @@ type Histogram interface {
 	AddExemplar(traceID pcommon.TraceID, spanID pcommon.SpanID, value float64)
 }
 
+// BoundsOverride defines the bounds of the explicit histograms whose attributes
+// have all the values of the override.
+type BoundsOverride struct {
+	Attributes map[string]string
+	Bounds     []float64
+}
+
 type explicitHistogramMetrics struct {
-	metrics          map[Key]*explicitHistogram
-	bounds           []float64
-	maxExemplarCount *int
+	metrics            map[Key]*explicitHistogram
+	bounds             []float64
+	overrides          []BoundsOverride
+	maxExemplarCount   *int
+	exemplarsPerBucket bool
 }
 
 type exponentialHistogramMetrics struct {
@@ -47,6 +57,10 @@ type explicitHistogram struct {
 	bounds []float64
 
 	maxExemplarCount *int
+	// exemplarBuckets holds the bucket index of each exemplar
+	// when keeping the latest exemplar of each bucket.
+	exemplarBuckets    []int
+	exemplarsPerBucket bool
 }
 
 type exponentialHistogram struct {
@@ -68,23 +82,30 @@ func NewExponentialHistogramMetrics(maxSize int32, maxExemplarCount *int) Histog
 	}
 }
 
-func NewExplicitHistogramMetrics(bounds []float64, maxExemplarCount *int) HistogramMetrics {
+// NewExplicitHistogramMetrics creates explicit histograms with the bounds of the first matching override,
+// or the default bounds. When exemplarsPerBucket is set, each histogram keeps the latest exemplar of each bucket
+// instead of the first maxExemplarCount exemplars.
+func NewExplicitHistogramMetrics(bounds []float64, overrides []BoundsOverride, maxExemplarCount *int, exemplarsPerBucket bool) HistogramMetrics {
 	return &explicitHistogramMetrics{
-		metrics:          make(map[Key]*explicitHistogram),
-		bounds:           bounds,
-		maxExemplarCount: maxExemplarCount,
+		metrics:            make(map[Key]*explicitHistogram),
+		bounds:             bounds,
+		overrides:          overrides,
+		maxExemplarCount:   maxExemplarCount,
+		exemplarsPerBucket: exemplarsPerBucket,
 	}
 }
 
 func (m *explicitHistogramMetrics) GetOrCreate(key Key, attributes pcommon.Map) Histogram {
 	h, ok := m.metrics[key]
 	if !ok {
+		bounds := m.boundsOf(attributes)
 		h = &explicitHistogram{
-			attributes:       attributes,
-			exemplars:        pmetric.NewExemplarSlice(),
-			bounds:           m.bounds,
-			bucketCounts:     make([]uint64, len(m.bounds)+1),
-			maxExemplarCount: m.maxExemplarCount,
+			attributes:         attributes,
+			exemplars:          pmetric.NewExemplarSlice(),
+			bounds:             bounds,
+			bucketCounts:       make([]uint64, len(bounds)+1),
+			maxExemplarCount:   m.maxExemplarCount,
+			exemplarsPerBucket: m.exemplarsPerBucket,
 		}
 		m.metrics[key] = h
 	}
@@ -92,6 +113,26 @@ func (m *explicitHistogramMetrics) GetOrCreate(key Key, attributes pcommon.Map)
 	return h
 }
 
+// boundsOf returns the bounds of the first override matching the attributes, or the default bounds.
+func (m *explicitHistogramMetrics) boundsOf(attributes pcommon.Map) []float64 {
+	for _, o := range m.overrides {
+		if matchAttributes(o.Attributes, attributes) {
+			return o.Bounds
+		}
+	}
+	return m.bounds
+}
+
+func matchAttributes(expected map[string]string, attributes pcommon.Map) bool {
+	for k, v := range expected {
+		attr, ok := attributes.Get(k)
+		if !ok || attr.AsString() != v {
+			return false
+		}
+	}
+	return true
+}
+
 func (m *explicitHistogramMetrics) BuildMetrics(
 	metric pmetric.Metric,
 	startTimestamp generateStartTimestamp,
@@ -112,7 +153,11 @@ func (m *explicitHistogramMetrics) BuildMetrics(
 		for i := 0; i < h.exemplars.Len(); i++ {
 			h.exemplars.At(i).SetTimestamp(timestamp)
 		}
-		h.exemplars.CopyTo(dp.Exemplars())
+		if h.exemplarsPerBucket {
+			h.copyExemplarsByBucket(dp.Exemplars())
+		} else {
+			h.exemplars.CopyTo(dp.Exemplars())
+		}
 		h.attributes.CopyTo(dp.Attributes())
 	}
 }
@@ -120,6 +165,7 @@ func (m *explicitHistogramMetrics) BuildMetrics(
 func (m *explicitHistogramMetrics) ClearExemplars() {
 	for _, h := range m.metrics {
 		h.exemplars = pmetric.NewExemplarSlice()
+		h.exemplarBuckets = h.exemplarBuckets[:0]
 	}
 }
 
@@ -214,6 +260,10 @@ func (h *explicitHistogram) Observe(value float64) {
 }
 
 func (h *explicitHistogram) AddExemplar(traceID pcommon.TraceID, spanID pcommon.SpanID, value float64) {
+	if h.exemplarsPerBucket {
+		h.addBucketExemplar(traceID, spanID, value)
+		return
+	}
 	if h.maxExemplarCount != nil && h.exemplars.Len() >= *h.maxExemplarCount {
 		return
 	}
@@ -223,6 +273,37 @@ func (h *explicitHistogram) AddExemplar(traceID pcommon.TraceID, spanID pcommon.
 	e.SetDoubleValue(value)
 }
 
+// addBucketExemplar replaces the exemplar of the bucket of the value, so that the exemplars
+// cover the whole range of the histogram rather than the first observed values.
+func (h *explicitHistogram) addBucketExemplar(traceID pcommon.TraceID, spanID pcommon.SpanID, value float64) {
+	index := sort.SearchFloat64s(h.bounds, value)
+	var e pmetric.Exemplar
+	if i := slices.Index(h.exemplarBuckets, index); i >= 0 {
+		e = h.exemplars.At(i)
+	} else {
+		e = h.exemplars.AppendEmpty()
+		h.exemplarBuckets = append(h.exemplarBuckets, index)
+	}
+	e.SetTraceID(traceID)
+	e.SetSpanID(spanID)
+	e.SetDoubleValue(value)
+}
+
+// copyExemplarsByBucket copies the exemplars kept for each bucket, in the order of the buckets.
+func (h *explicitHistogram) copyExemplarsByBucket(dest pmetric.ExemplarSlice) {
+	order := make([]int, len(h.exemplarBuckets))
+	for i := range order {
+		order[i] = i
+	}
+	sort.Slice(order, func(i, j int) bool {
+		return h.exemplarBuckets[order[i]] < h.exemplarBuckets[order[j]]
+	})
+	dest.EnsureCapacity(len(order))
+	for _, i := range order {
+		h.exemplars.At(i).CopyTo(dest.AppendEmpty())
+	}
+}
+
 func (h *exponentialHistogram) Observe(value float64) {
 	h.histogram.Update(value)
 }
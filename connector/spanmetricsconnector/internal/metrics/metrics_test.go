@@ -287,3 +287,56 @@ func TestExponentialHistogram_AddExemplar(t *testing.T) {
 		})
 	}
 }
+
+func TestExplicitHistogram_AddExemplarPerBucket(t *testing.T) {
+	maxCount := 1
+	m := NewExplicitHistogramMetrics([]float64{10, 100}, nil, &maxCount, true)
+	h := m.GetOrCreate("key", pcommon.NewMap())
+
+	h.AddExemplar(pcommon.TraceID{1}, pcommon.SpanID{1}, 500)
+	h.AddExemplar(pcommon.TraceID{2}, pcommon.SpanID{2}, 5)
+	h.AddExemplar(pcommon.TraceID{3}, pcommon.SpanID{3}, 8)
+
+	metric := pmetric.NewMetric()
+	m.BuildMetrics(metric, func(Key) pcommon.Timestamp { return 0 }, 1, pmetric.AggregationTemporalityCumulative)
+	exemplars := metric.Histogram().DataPoints().At(0).Exemplars()
+	assert.Equal(t, 2, exemplars.Len(), "Must keep one exemplar per bucket, ignoring the max count")
+	assert.Equal(t, pcommon.TraceID{3}, exemplars.At(0).TraceID(), "Must keep the latest exemplar of the first bucket")
+	assert.Equal(t, 8.0, exemplars.At(0).DoubleValue())
+	assert.Equal(t, pcommon.TraceID{1}, exemplars.At(1).TraceID(), "Must sort the exemplars by bucket")
+
+	m.ClearExemplars()
+	h.AddExemplar(pcommon.TraceID{4}, pcommon.SpanID{4}, 50)
+	metric = pmetric.NewMetric()
+	m.BuildMetrics(metric, func(Key) pcommon.Timestamp { return 0 }, 1, pmetric.AggregationTemporalityCumulative)
+	exemplars = metric.Histogram().DataPoints().At(0).Exemplars()
+	assert.Equal(t, 1, exemplars.Len())
+	assert.Equal(t, pcommon.TraceID{4}, exemplars.At(0).TraceID())
+}
+
+func TestExplicitHistogramMetrics_BoundsOverrides(t *testing.T) {
+	m := NewExplicitHistogramMetrics([]float64{10, 100}, []BoundsOverride{
+		{Attributes: map[string]string{"http.route": "/checkout", "http.status_code": "200"}, Bounds: []float64{1, 2, 5}},
+		{Attributes: map[string]string{"http.route": "/checkout"}, Bounds: []float64{1, 10}},
+	}, nil, false)
+
+	attributes := func(raw map[string]any) pcommon.Map {
+		attrs := pcommon.NewMap()
+		assert.NoError(t, attrs.FromRaw(raw))
+		return attrs
+	}
+	tests := []struct {
+		key        Key
+		attributes pcommon.Map
+		want       []float64
+	}{
+		{key: "ok", attributes: attributes(map[string]any{"http.route": "/checkout", "http.status_code": 200}), want: []float64{1, 2, 5}},
+		{key: "error", attributes: attributes(map[string]any{"http.route": "/checkout", "http.status_code": 500}), want: []float64{1, 10}},
+		{key: "other", attributes: attributes(map[string]any{"http.route": "/cart"}), want: []float64{10, 100}},
+	}
+	for _, tt := range tests {
+		h := m.GetOrCreate(tt.key, tt.attributes).(*explicitHistogram)
+		assert.Equal(t, tt.want, h.bounds, string(tt.key))
+		assert.Len(t, h.bucketCounts, len(tt.want)+1, string(tt.key))
+	}
+}
@@ -9,6 +9,8 @@ import (
 	"go.opentelemetry.io/collector/component"
 	"go.opentelemetry.io/collector/config/configcompression"
 	"go.uber.org/multierr"
+
+	parquettranslator "github.com/open-telemetry/opentelemetry-collector-contrib/pkg/translator/parquet"
 )
 
 // S3UploaderConfig contains aws s3 uploader related config to controls things
@@ -24,6 +26,24 @@ type S3UploaderConfig struct {
 	S3ForcePathStyle bool                   `mapstructure:"s3_force_path_style"`
 	DisableSSL       bool                   `mapstructure:"disable_ssl"`
 	Compression      configcompression.Type `mapstructure:"compression"`
+
+	// S3KeyTemplate is the template of the S3 key prefix, replacing S3Prefix and S3Partition.
+	// `{yyyy}`, `{MM}`, `{dd}`, `{HH}`, `{mm}` and `{ss}` placeholders, or combinations of them such
+	// as `{yyyy-MM-dd}`, are replaced by the UTC time of the upload. Other placeholders are replaced
+	// by the value of the resource attribute they name, e.g. `logs/tenant={tenant.id}/dt={yyyy-MM-dd}/`.
+	S3KeyTemplate string `mapstructure:"s3_key_template"`
+}
+
+// ParquetConfig contains the settings of the parquet marshaler.
+type ParquetConfig struct {
+	// Compression is the codec compressing the columns: none, snappy, gzip or zstd.
+	Compression string `mapstructure:"compression"`
+}
+
+// ORCConfig contains the settings of the orc marshaler.
+type ORCConfig struct {
+	// Compression is the codec compressing the streams: none or zlib.
+	Compression string `mapstructure:"compression"`
 }
 
 type MarshalerType string
@@ -33,6 +53,8 @@ const (
 	OtlpJSON     MarshalerType = "otlp_json"
 	SumoIC       MarshalerType = "sumo_ic"
 	Body         MarshalerType = "body"
+	Parquet      MarshalerType = "parquet"
+	ORC          MarshalerType = "orc"
 )
 
 // Config contains the main configuration options for the s3 exporter
@@ -43,6 +65,12 @@ type Config struct {
 	// Encoding to apply. If present, overrides the marshaler configuration option.
 	Encoding              *component.ID `mapstructure:"encoding"`
 	EncodingFileExtension string        `mapstructure:"encoding_file_extension"`
+
+	// Parquet contains the settings of the parquet marshaler.
+	Parquet ParquetConfig `mapstructure:"parquet"`
+
+	// ORC contains the settings of the orc marshaler.
+	ORC ORCConfig `mapstructure:"orc"`
 }
 
 func (c *Config) Validate() error {
@@ -59,9 +87,27 @@ func (c *Config) Validate() error {
 			errs = multierr.Append(errs, errors.New("unknown compression type"))
 		}
 
-		if c.MarshalerName == SumoIC {
+		if c.MarshalerName == SumoIC || c.MarshalerName == Parquet || c.MarshalerName == ORC {
 			errs = multierr.Append(errs, errors.New("marshaler does not support compression"))
 		}
 	}
+	if c.S3Uploader.S3KeyTemplate != "" {
+		if _, err := newKeyTemplate(c.S3Uploader.S3KeyTemplate); err != nil {
+			errs = multierr.Append(errs, err)
+		}
+		if c.S3Uploader.S3Prefix != "" {
+			errs = multierr.Append(errs, errors.New("s3_prefix can't be used with s3_key_template"))
+		}
+	}
+	if c.MarshalerName == Parquet && c.Encoding == nil {
+		if _, err := parquettranslator.CompressionCodec(c.Parquet.Compression); err != nil {
+			errs = multierr.Append(errs, err)
+		}
+	}
+	if c.MarshalerName == ORC && c.Encoding == nil {
+		if _, err := orcCompressionCodec(c.ORC.Compression); err != nil {
+			errs = multierr.Append(errs, err)
+		}
+	}
 	return errs
 }
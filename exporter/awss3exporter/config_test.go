@@ -5,6 +5,7 @@ package awss3exporter
 
 import (
 	"errors"
+	"fmt"
 	"path/filepath"
 	"testing"
 
@@ -15,6 +16,7 @@ import (
 	"go.uber.org/multierr"
 
 	"github.com/open-telemetry/opentelemetry-collector-contrib/exporter/awss3exporter/internal/metadata"
+	parquettranslator "github.com/open-telemetry/opentelemetry-collector-contrib/pkg/translator/parquet"
 )
 
 func TestLoadConfig(t *testing.T) {
@@ -40,6 +42,8 @@ func TestLoadConfig(t *testing.T) {
 				S3Partition: "minute",
 			},
 			MarshalerName: "otlp_json",
+			Parquet:       ParquetConfig{Compression: parquettranslator.CompressionSnappy},
+			ORC:           ORCConfig{Compression: orcCompressionZlib},
 		},
 	)
 }
@@ -68,6 +72,8 @@ func TestConfig(t *testing.T) {
 				Endpoint:    "http://endpoint.com",
 			},
 			MarshalerName: "otlp_json",
+			Parquet:       ParquetConfig{Compression: parquettranslator.CompressionSnappy},
+			ORC:           ORCConfig{Compression: orcCompressionZlib},
 		},
 	)
 }
@@ -98,6 +104,8 @@ func TestConfigForS3CompatibleSystems(t *testing.T) {
 				DisableSSL:       true,
 			},
 			MarshalerName: "otlp_json",
+			Parquet:       ParquetConfig{Compression: parquettranslator.CompressionSnappy},
+			ORC:           ORCConfig{Compression: orcCompressionZlib},
 		},
 	)
 }
@@ -150,6 +158,71 @@ func TestConfig_Validate(t *testing.T) {
 			}(),
 			errExpected: errors.New("region is required"),
 		},
+		{
+			name: "key template and prefix",
+			config: func() *Config {
+				c := createDefaultConfig().(*Config)
+				c.S3Uploader.S3Bucket = "foo"
+				c.S3Uploader.S3Prefix = "bar"
+				c.S3Uploader.S3KeyTemplate = "logs/{tenant.id}/"
+				return c
+			}(),
+			errExpected: errors.New("s3_prefix can't be used with s3_key_template"),
+		},
+		{
+			name: "invalid key template",
+			config: func() *Config {
+				c := createDefaultConfig().(*Config)
+				c.S3Uploader.S3Bucket = "foo"
+				c.S3Uploader.S3KeyTemplate = "logs/{tenant.id/"
+				return c
+			}(),
+			errExpected: fmt.Errorf("invalid s3_key_template %q: %w", "logs/{tenant.id/", errKeyTemplateUnclosedBrace),
+		},
+		{
+			name: "parquet with compression",
+			config: func() *Config {
+				c := createDefaultConfig().(*Config)
+				c.S3Uploader.S3Bucket = "foo"
+				c.S3Uploader.Compression = "gzip"
+				c.MarshalerName = Parquet
+				return c
+			}(),
+			errExpected: errors.New("marshaler does not support compression"),
+		},
+		{
+			name: "parquet unknown compression",
+			config: func() *Config {
+				c := createDefaultConfig().(*Config)
+				c.S3Uploader.S3Bucket = "foo"
+				c.MarshalerName = Parquet
+				c.Parquet.Compression = "lz4"
+				return c
+			}(),
+			errExpected: errors.New(`parquet compression "lz4" is not supported`),
+		},
+		{
+			name: "orc with compression",
+			config: func() *Config {
+				c := createDefaultConfig().(*Config)
+				c.S3Uploader.S3Bucket = "foo"
+				c.S3Uploader.Compression = "gzip"
+				c.MarshalerName = ORC
+				return c
+			}(),
+			errExpected: errors.New("marshaler does not support compression"),
+		},
+		{
+			name: "orc unknown compression",
+			config: func() *Config {
+				c := createDefaultConfig().(*Config)
+				c.S3Uploader.S3Bucket = "foo"
+				c.MarshalerName = ORC
+				c.ORC.Compression = "snappy"
+				return c
+			}(),
+			errExpected: errors.New(`orc compression "snappy" is not supported`),
+		},
 	}
 
 	for _, tt := range tests {
@@ -182,6 +255,8 @@ func TestMarshallerName(t *testing.T) {
 				S3Partition: "minute",
 			},
 			MarshalerName: "sumo_ic",
+			Parquet:       ParquetConfig{Compression: parquettranslator.CompressionSnappy},
+			ORC:           ORCConfig{Compression: orcCompressionZlib},
 		},
 	)
 
@@ -195,6 +270,8 @@ func TestMarshallerName(t *testing.T) {
 				S3Partition: "minute",
 			},
 			MarshalerName: "otlp_proto",
+			Parquet:       ParquetConfig{Compression: parquettranslator.CompressionSnappy},
+			ORC:           ORCConfig{Compression: orcCompressionZlib},
 		},
 	)
 
@@ -223,6 +300,8 @@ func TestCompressionName(t *testing.T) {
 				Compression: "gzip",
 			},
 			MarshalerName: "otlp_json",
+			Parquet:       ParquetConfig{Compression: parquettranslator.CompressionSnappy},
+			ORC:           ORCConfig{Compression: orcCompressionZlib},
 		},
 	)
 
@@ -237,7 +316,38 @@ func TestCompressionName(t *testing.T) {
 				Compression: "none",
 			},
 			MarshalerName: "otlp_proto",
+			Parquet:       ParquetConfig{Compression: parquettranslator.CompressionSnappy},
+			ORC:           ORCConfig{Compression: orcCompressionZlib},
 		},
 	)
 
 }
+
+func TestPartitionedParquetConfig(t *testing.T) {
+	factories, err := otelcoltest.NopFactories()
+	assert.NoError(t, err)
+
+	factory := NewFactory()
+	factories.Exporters[factory.Type()] = factory
+	cfg, err := otelcoltest.LoadConfigAndValidate(
+		filepath.Join("testdata", "parquet.yaml"), factories)
+
+	require.NoError(t, err)
+	require.NotNil(t, cfg)
+
+	e := cfg.Exporters[component.MustNewID("awss3")].(*Config)
+
+	assert.Equal(t, e,
+		&Config{
+			S3Uploader: S3UploaderConfig{
+				Region:        "us-east-1",
+				S3Bucket:      "foo",
+				S3Partition:   "minute",
+				S3KeyTemplate: "logs/tenant={tenant.id}/dt={yyyy-MM-dd}/",
+			},
+			MarshalerName: "parquet",
+			Parquet:       ParquetConfig{Compression: parquettranslator.CompressionZstd},
+			ORC:           ORCConfig{Compression: orcCompressionZlib},
+		},
+	)
+}
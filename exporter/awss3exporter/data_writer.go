@@ -6,5 +6,7 @@ package awss3exporter // import "github.com/open-telemetry/opentelemetry-collect
 import "context"
 
 type dataWriter interface {
-	writeBuffer(ctx context.Context, buf []byte, config *Config, metadata string, format string) error
+	// writeBuffer uploads the buffer to a new S3 object. The key of the object starts with keyPrefix
+	// if it is not empty, and with the configured prefix and time partition otherwise.
+	writeBuffer(ctx context.Context, buf []byte, config *Config, keyPrefix string, metadata string, format string) error
 }
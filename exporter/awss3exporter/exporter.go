@@ -6,21 +6,25 @@ package awss3exporter // import "github.com/open-telemetry/opentelemetry-collect
 import (
 	"context"
 	"fmt"
+	"time"
 
 	"go.opentelemetry.io/collector/component"
 	"go.opentelemetry.io/collector/consumer"
 	"go.opentelemetry.io/collector/exporter"
+	"go.opentelemetry.io/collector/pdata/pcommon"
 	"go.opentelemetry.io/collector/pdata/plog"
 	"go.opentelemetry.io/collector/pdata/pmetric"
 	"go.opentelemetry.io/collector/pdata/ptrace"
+	"go.uber.org/multierr"
 	"go.uber.org/zap"
 )
 
 type s3Exporter struct {
-	config     *Config
-	dataWriter dataWriter
-	logger     *zap.Logger
-	marshaler  marshaler
+	config      *Config
+	dataWriter  dataWriter
+	logger      *zap.Logger
+	marshaler   marshaler
+	keyTemplate *keyTemplate
 }
 
 func newS3Exporter(config *Config,
@@ -38,16 +42,31 @@ func (e *s3Exporter) start(_ context.Context, host component.Host) error {
 
 	var m marshaler
 	var err error
-	if e.config.Encoding != nil {
+	switch {
+	case e.config.Encoding != nil:
 		if m, err = newMarshalerFromEncoding(e.config.Encoding, e.config.EncodingFileExtension, host, e.logger); err != nil {
 			return err
 		}
-	} else {
+	case e.config.MarshalerName == Parquet:
+		if m, err = newParquetMarshaler(e.config.Parquet); err != nil {
+			return err
+		}
+	case e.config.MarshalerName == ORC:
+		if m, err = newORCMarshaler(e.config.ORC); err != nil {
+			return err
+		}
+	default:
 		if m, err = newMarshaler(e.config.MarshalerName, e.logger); err != nil {
 			return fmt.Errorf("unknown marshaler %q", e.config.MarshalerName)
 		}
 	}
 
+	if e.config.S3Uploader.S3KeyTemplate != "" {
+		if e.keyTemplate, err = newKeyTemplate(e.config.S3Uploader.S3KeyTemplate); err != nil {
+			return err
+		}
+	}
+
 	e.marshaler = m
 	return nil
 }
@@ -57,30 +76,115 @@ func (e *s3Exporter) Capabilities() consumer.Capabilities {
 }
 
 func (e *s3Exporter) ConsumeMetrics(ctx context.Context, md pmetric.Metrics) error {
-	buf, err := e.marshaler.MarshalMetrics(md)
+	var errs error
+	for keyPrefix, partition := range e.partitionMetrics(md, time.Now()) {
+		buf, err := e.marshaler.MarshalMetrics(partition)
+		if err != nil {
+			errs = multierr.Append(errs, err)
+			continue
+		}
+		errs = multierr.Append(errs, e.dataWriter.writeBuffer(ctx, buf, e.config, keyPrefix, "metrics", e.marshaler.format()))
+	}
+	return errs
+}
 
-	if err != nil {
-		return err
+func (e *s3Exporter) ConsumeLogs(ctx context.Context, logs plog.Logs) error {
+	var errs error
+	for keyPrefix, partition := range e.partitionLogs(logs, time.Now()) {
+		buf, err := e.marshaler.MarshalLogs(partition)
+		if err != nil {
+			errs = multierr.Append(errs, err)
+			continue
+		}
+		errs = multierr.Append(errs, e.dataWriter.writeBuffer(ctx, buf, e.config, keyPrefix, "logs", e.marshaler.format()))
 	}
+	return errs
+}
 
-	return e.dataWriter.writeBuffer(ctx, buf, e.config, "metrics", e.marshaler.format())
+func (e *s3Exporter) ConsumeTraces(ctx context.Context, traces ptrace.Traces) error {
+	var errs error
+	for keyPrefix, partition := range e.partitionTraces(traces, time.Now()) {
+		buf, err := e.marshaler.MarshalTraces(partition)
+		if err != nil {
+			errs = multierr.Append(errs, err)
+			continue
+		}
+		errs = multierr.Append(errs, e.dataWriter.writeBuffer(ctx, buf, e.config, keyPrefix, "traces", e.marshaler.format()))
+	}
+	return errs
 }
 
-func (e *s3Exporter) ConsumeLogs(ctx context.Context, logs plog.Logs) error {
-	buf, err := e.marshaler.MarshalLogs(logs)
+// partitionMetrics groups the resource metrics by the key prefix rendered from the key template.
+// Without key template, all the metrics are written with the configured prefix and time partition.
+func (e *s3Exporter) partitionMetrics(md pmetric.Metrics, now time.Time) map[string]pmetric.Metrics {
+	if e.keyTemplate == nil {
+		return map[string]pmetric.Metrics{"": md}
+	}
+	if !e.keyTemplate.hasAttributes() {
+		return map[string]pmetric.Metrics{e.keyTemplate.render(pcommon.NewResource(), now): md}
+	}
 
-	if err != nil {
-		return err
+	partitions := make(map[string]pmetric.Metrics)
+	rms := md.ResourceMetrics()
+	for i := 0; i < rms.Len(); i++ {
+		rm := rms.At(i)
+		keyPrefix := e.keyTemplate.render(rm.Resource(), now)
+		partition, ok := partitions[keyPrefix]
+		if !ok {
+			partition = pmetric.NewMetrics()
+			partitions[keyPrefix] = partition
+		}
+		rm.CopyTo(partition.ResourceMetrics().AppendEmpty())
 	}
+	return partitions
+}
 
-	return e.dataWriter.writeBuffer(ctx, buf, e.config, "logs", e.marshaler.format())
+// partitionLogs groups the resource logs by the key prefix rendered from the key template.
+// Without key template, all the logs are written with the configured prefix and time partition.
+func (e *s3Exporter) partitionLogs(ld plog.Logs, now time.Time) map[string]plog.Logs {
+	if e.keyTemplate == nil {
+		return map[string]plog.Logs{"": ld}
+	}
+	if !e.keyTemplate.hasAttributes() {
+		return map[string]plog.Logs{e.keyTemplate.render(pcommon.NewResource(), now): ld}
+	}
+
+	partitions := make(map[string]plog.Logs)
+	rls := ld.ResourceLogs()
+	for i := 0; i < rls.Len(); i++ {
+		rl := rls.At(i)
+		keyPrefix := e.keyTemplate.render(rl.Resource(), now)
+		partition, ok := partitions[keyPrefix]
+		if !ok {
+			partition = plog.NewLogs()
+			partitions[keyPrefix] = partition
+		}
+		rl.CopyTo(partition.ResourceLogs().AppendEmpty())
+	}
+	return partitions
 }
 
-func (e *s3Exporter) ConsumeTraces(ctx context.Context, traces ptrace.Traces) error {
-	buf, err := e.marshaler.MarshalTraces(traces)
-	if err != nil {
-		return err
+// partitionTraces groups the resource spans by the key prefix rendered from the key template.
+// Without key template, all the traces are written with the configured prefix and time partition.
+func (e *s3Exporter) partitionTraces(td ptrace.Traces, now time.Time) map[string]ptrace.Traces {
+	if e.keyTemplate == nil {
+		return map[string]ptrace.Traces{"": td}
+	}
+	if !e.keyTemplate.hasAttributes() {
+		return map[string]ptrace.Traces{e.keyTemplate.render(pcommon.NewResource(), now): td}
 	}
 
-	return e.dataWriter.writeBuffer(ctx, buf, e.config, "traces", e.marshaler.format())
+	partitions := make(map[string]ptrace.Traces)
+	rss := td.ResourceSpans()
+	for i := 0; i < rss.Len(); i++ {
+		rs := rss.At(i)
+		keyPrefix := e.keyTemplate.render(rs.Resource(), now)
+		partition, ok := partitions[keyPrefix]
+		if !ok {
+			partition = ptrace.NewTraces()
+			partitions[keyPrefix] = partition
+		}
+		rs.CopyTo(partition.ResourceSpans().AppendEmpty())
+	}
+	return partitions
 }
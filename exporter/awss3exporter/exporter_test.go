@@ -8,7 +8,10 @@ import (
 	"testing"
 
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/collector/component/componenttest"
 	"go.opentelemetry.io/collector/pdata/plog"
+	"go.opentelemetry.io/collector/pdata/ptrace"
 	"go.uber.org/zap"
 )
 
@@ -18,7 +21,7 @@ type TestWriter struct {
 	t *testing.T
 }
 
-func (testWriter *TestWriter) writeBuffer(_ context.Context, buf []byte, _ *Config, _ string, _ string) error {
+func (testWriter *TestWriter) writeBuffer(_ context.Context, buf []byte, _ *Config, _ string, _ string, _ string) error {
 	assert.Equal(testWriter.t, testLogs, buf)
 	return nil
 }
@@ -46,3 +49,84 @@ func TestLog(t *testing.T) {
 	exporter := getLogExporter(t)
 	assert.NoError(t, exporter.ConsumeLogs(context.Background(), logs))
 }
+
+type uploadedObject struct {
+	metadata string
+	format   string
+	buf      []byte
+}
+
+// recordingWriter records the objects written by key prefix.
+type recordingWriter struct {
+	objects map[string]uploadedObject
+}
+
+func (w *recordingWriter) writeBuffer(_ context.Context, buf []byte, _ *Config, keyPrefix string, metadata string, format string) error {
+	w.objects[keyPrefix] = uploadedObject{metadata: metadata, format: format, buf: buf}
+	return nil
+}
+
+func TestPartitionedExport(t *testing.T) {
+	config := createDefaultConfig().(*Config)
+	config.S3Uploader.S3KeyTemplate = "{signal.name}/tenant={tenant.id}/"
+	writer := &recordingWriter{objects: map[string]uploadedObject{}}
+	exporter := &s3Exporter{
+		config:     config,
+		dataWriter: writer,
+		logger:     zap.NewNop(),
+	}
+	require.NoError(t, exporter.start(context.Background(), componenttest.NewNopHost()))
+
+	ld := plog.NewLogs()
+	for _, tenant := range []string{"acme", "globex", "acme", ""} {
+		rl := ld.ResourceLogs().AppendEmpty()
+		if tenant != "" {
+			rl.Resource().Attributes().PutStr("tenant.id", tenant)
+		}
+		rl.Resource().Attributes().PutStr("signal.name", "logs")
+		rl.ScopeLogs().AppendEmpty().LogRecords().AppendEmpty().Body().SetStr(tenant)
+	}
+	require.NoError(t, exporter.ConsumeLogs(context.Background(), ld))
+
+	require.Len(t, writer.objects, 3)
+	expected := map[string]int{
+		"logs/tenant=acme/":    2,
+		"logs/tenant=globex/":  1,
+		"logs/tenant=unknown/": 1,
+	}
+	for keyPrefix, count := range expected {
+		object, ok := writer.objects[keyPrefix]
+		require.True(t, ok, keyPrefix)
+		assert.Equal(t, "logs", object.metadata)
+		assert.Equal(t, "json", object.format)
+		logs, err := (&plog.JSONUnmarshaler{}).UnmarshalLogs(object.buf)
+		require.NoError(t, err)
+		assert.Equal(t, count, logs.ResourceLogs().Len())
+	}
+	assert.Equal(t, 4, ld.ResourceLogs().Len(), "the logs must not be modified")
+}
+
+func TestPartitionedExportTimeOnly(t *testing.T) {
+	config := createDefaultConfig().(*Config)
+	config.S3Uploader.S3KeyTemplate = "traces/dt={yyyy-MM-dd}/"
+	config.MarshalerName = Parquet
+	writer := &recordingWriter{objects: map[string]uploadedObject{}}
+	exporter := &s3Exporter{
+		config:     config,
+		dataWriter: writer,
+		logger:     zap.NewNop(),
+	}
+	require.NoError(t, exporter.start(context.Background(), componenttest.NewNopHost()))
+
+	td := ptrace.NewTraces()
+	td.ResourceSpans().AppendEmpty().ScopeSpans().AppendEmpty().Spans().AppendEmpty().SetName("a")
+	td.ResourceSpans().AppendEmpty().ScopeSpans().AppendEmpty().Spans().AppendEmpty().SetName("b")
+	require.NoError(t, exporter.ConsumeTraces(context.Background(), td))
+
+	require.Len(t, writer.objects, 1)
+	for keyPrefix, object := range writer.objects {
+		assert.Regexp(t, `^traces/dt=\d{4}-\d{2}-\d{2}/$`, keyPrefix)
+		assert.Equal(t, "traces", object.metadata)
+		assert.Equal(t, "parquet", object.format)
+	}
+}
@@ -0,0 +1,163 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package awss3exporter // import "github.com/open-telemetry/opentelemetry-collector-contrib/exporter/awss3exporter"
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"go.opentelemetry.io/collector/pdata/pcommon"
+)
+
+// defaultKeyTemplateValue replaces the attribute placeholders whose attribute is missing or empty.
+const defaultKeyTemplateValue = "unknown"
+
+var (
+	errKeyTemplateUnclosedBrace    = errors.New("unclosed placeholder")
+	errKeyTemplateEmptyPlaceholder = errors.New("empty placeholder")
+	errKeyTemplateUnexpectedBrace  = errors.New("unexpected '}'")
+)
+
+// timeLayoutTokens maps the date and time tokens of the key templates to the Go layout.
+var timeLayoutTokens = []struct {
+	token  string
+	layout string
+}{
+	{"yyyy", "2006"},
+	{"MM", "01"},
+	{"dd", "02"},
+	{"HH", "15"},
+	{"mm", "04"},
+	{"ss", "05"},
+}
+
+// keyTemplateSegment is either a literal part of a key template, the layout of a date or time token
+// or a resource attribute placeholder.
+type keyTemplateSegment struct {
+	literal    string
+	timeLayout string
+	attribute  string
+}
+
+// keyTemplate renders the S3 key prefix of the telemetry of a resource.
+type keyTemplate struct {
+	segments []keyTemplateSegment
+}
+
+// hasAttributes returns whether the rendered key depends on the resource attributes.
+func (t *keyTemplate) hasAttributes() bool {
+	for _, segment := range t.segments {
+		if segment.attribute != "" {
+			return true
+		}
+	}
+	return false
+}
+
+func newKeyTemplate(template string) (*keyTemplate, error) {
+	var segments []keyTemplateSegment
+	for rest := template; rest != ""; {
+		open := strings.IndexAny(rest, "{}")
+		if open < 0 {
+			segments = append(segments, keyTemplateSegment{literal: rest})
+			break
+		}
+		if rest[open] == '}' {
+			return nil, fmt.Errorf("invalid s3_key_template %q: %w", template, errKeyTemplateUnexpectedBrace)
+		}
+		if open > 0 {
+			segments = append(segments, keyTemplateSegment{literal: rest[:open]})
+		}
+
+		closing := strings.IndexByte(rest[open:], '}')
+		if closing < 0 {
+			return nil, fmt.Errorf("invalid s3_key_template %q: %w", template, errKeyTemplateUnclosedBrace)
+		}
+		placeholder := strings.TrimSpace(rest[open+1 : open+closing])
+		if placeholder == "" {
+			return nil, fmt.Errorf("invalid s3_key_template %q: %w", template, errKeyTemplateEmptyPlaceholder)
+		}
+		if timeSegments, ok := parseTimePlaceholder(placeholder); ok {
+			segments = append(segments, timeSegments...)
+		} else {
+			segments = append(segments, keyTemplateSegment{attribute: placeholder})
+		}
+		rest = rest[open+closing+1:]
+	}
+	return &keyTemplate{segments: segments}, nil
+}
+
+// parseTimePlaceholder converts placeholders made of date and time tokens and separators, such as
+// `yyyy-MM-dd`, to segments formatting each token on its own. Other placeholders are resource
+// attribute names.
+func parseTimePlaceholder(placeholder string) ([]keyTemplateSegment, bool) {
+	var segments []keyTemplateSegment
+	hasToken := false
+	for rest := placeholder; rest != ""; {
+		matched := false
+		for _, t := range timeLayoutTokens {
+			if strings.HasPrefix(rest, t.token) {
+				segments = append(segments, keyTemplateSegment{timeLayout: t.layout})
+				rest = rest[len(t.token):]
+				matched, hasToken = true, true
+				break
+			}
+		}
+		if matched {
+			continue
+		}
+		switch rest[0] {
+		case '-', '_', '/', '.', ':':
+			segments = append(segments, keyTemplateSegment{literal: rest[:1]})
+			rest = rest[1:]
+		default:
+			return nil, false
+		}
+	}
+	return segments, hasToken
+}
+
+// render returns the key prefix of the telemetry of the resource, written at the given time.
+func (t *keyTemplate) render(resource pcommon.Resource, now time.Time) string {
+	var sb strings.Builder
+	for _, segment := range t.segments {
+		switch {
+		case segment.timeLayout != "":
+			sb.WriteString(now.UTC().Format(segment.timeLayout))
+		case segment.attribute != "":
+			value := ""
+			if v, ok := resource.Attributes().Get(segment.attribute); ok {
+				value = sanitizeKeyTemplateValue(v.AsString())
+			}
+			if value == "" {
+				value = defaultKeyTemplateValue
+			}
+			sb.WriteString(value)
+		default:
+			sb.WriteString(segment.literal)
+		}
+	}
+	return sb.String()
+}
+
+// sanitizeKeyTemplateValue replaces the characters of attribute values that would change the
+// structure of the key, so that an attribute value always renders a single path segment.
+func sanitizeKeyTemplateValue(value string) string {
+	value = strings.TrimSpace(value)
+	if value == "." || value == ".." {
+		return ""
+	}
+	return strings.Map(func(r rune) rune {
+		switch r {
+		case '/', '\\', '=':
+			return '_'
+		}
+		if r < 0x20 || r == 0x7f {
+			return -1
+		}
+		return r
+	}, value)
+}
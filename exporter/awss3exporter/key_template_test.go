@@ -0,0 +1,136 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package awss3exporter
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/collector/pdata/pcommon"
+)
+
+func TestNewKeyTemplate(t *testing.T) {
+	tests := map[string]struct {
+		template      string
+		expected      []keyTemplateSegment
+		hasAttributes bool
+		err           error
+	}{
+		"literal": {
+			template: "logs/",
+			expected: []keyTemplateSegment{{literal: "logs/"}},
+		},
+		"time and attributes": {
+			template: "logs/tenant={ tenant.id }/dt={yyyy-MM-dd}/",
+			expected: []keyTemplateSegment{
+				{literal: "logs/tenant="},
+				{attribute: "tenant.id"},
+				{literal: "/dt="},
+				{timeLayout: "2006"},
+				{literal: "-"},
+				{timeLayout: "01"},
+				{literal: "-"},
+				{timeLayout: "02"},
+				{literal: "/"},
+			},
+			hasAttributes: true,
+		},
+		"time only": {
+			template: "{HH:mm:ss}",
+			expected: []keyTemplateSegment{
+				{timeLayout: "15"},
+				{literal: ":"},
+				{timeLayout: "04"},
+				{literal: ":"},
+				{timeLayout: "05"},
+			},
+		},
+		"attribute looking like time": {
+			template:      "{yyyy.name}",
+			expected:      []keyTemplateSegment{{attribute: "yyyy.name"}},
+			hasAttributes: true,
+		},
+		"unclosed placeholder": {
+			template: "logs/{tenant.id",
+			err:      errKeyTemplateUnclosedBrace,
+		},
+		"empty placeholder": {
+			template: "logs/{ }",
+			err:      errKeyTemplateEmptyPlaceholder,
+		},
+		"unexpected brace": {
+			template: "logs/}",
+			err:      errKeyTemplateUnexpectedBrace,
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			template, err := newKeyTemplate(tt.template)
+			if tt.err != nil {
+				assert.ErrorIs(t, err, tt.err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.expected, template.segments)
+			assert.Equal(t, tt.hasAttributes, template.hasAttributes())
+		})
+	}
+}
+
+func TestKeyTemplateRender(t *testing.T) {
+	now := time.Date(2024, 6, 5, 13, 4, 5, 0, time.FixedZone("CEST", 2*60*60))
+
+	tests := map[string]struct {
+		template   string
+		attributes map[string]any
+		expected   string
+	}{
+		"time is UTC": {
+			template: "logs/{yyyy}/{MM}/{dd}/{HH}/{mm}/{ss}/",
+			expected: "logs/2024/06/05/11/04/05/",
+		},
+		"separators are not layout elements": {
+			template: "{MM_yyyy}/",
+			expected: "06_2024/",
+		},
+		"attributes": {
+			template:   "logs/tenant={tenant.id}/dt={yyyy-MM-dd}/",
+			attributes: map[string]any{"tenant.id": "acme"},
+			expected:   "logs/tenant=acme/dt=2024-06-05/",
+		},
+		"non string attributes": {
+			template:   "{shard}/",
+			attributes: map[string]any{"shard": 3},
+			expected:   "3/",
+		},
+		"missing attributes": {
+			template:   "{tenant.id}/{service.name}/",
+			attributes: map[string]any{"service.name": " "},
+			expected:   "unknown/unknown/",
+		},
+		"sanitized values": {
+			template:   "{tenant.id}/{service.name}/",
+			attributes: map[string]any{"tenant.id": "../../etc", "service.name": "a/b=c\n"},
+			expected:   ".._.._etc/a_b_c/",
+		},
+		"dot segments": {
+			template:   "{tenant.id}/",
+			attributes: map[string]any{"tenant.id": ".."},
+			expected:   "unknown/",
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			template, err := newKeyTemplate(tt.template)
+			require.NoError(t, err)
+			resource := pcommon.NewResource()
+			require.NoError(t, resource.Attributes().FromRaw(tt.attributes))
+			assert.Equal(t, tt.expected, template.render(resource, now))
+		})
+	}
+}
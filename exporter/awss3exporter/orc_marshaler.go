@@ -0,0 +1,189 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package awss3exporter // import "github.com/open-telemetry/opentelemetry-collector-contrib/exporter/awss3exporter"
+
+import (
+	"bytes"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/apache/arrow/go/v15/arrow"
+	"github.com/apache/arrow/go/v15/arrow/array"
+	"github.com/scritchley/orc"
+	"go.opentelemetry.io/collector/pdata/plog"
+	"go.opentelemetry.io/collector/pdata/pmetric"
+	"go.opentelemetry.io/collector/pdata/ptrace"
+
+	parquettranslator "github.com/open-telemetry/opentelemetry-collector-contrib/pkg/translator/parquet"
+)
+
+// The names of the compression codecs supported by the orc marshaler.
+const (
+	orcCompressionNone = "none"
+	orcCompressionZlib = "zlib"
+)
+
+func orcCompressionCodec(name string) (orc.CompressionCodec, error) {
+	switch name {
+	case orcCompressionNone:
+		return orc.CompressionNone{}, nil
+	case orcCompressionZlib:
+		return orc.CompressionZlib{}, nil
+	default:
+		return nil, fmt.Errorf("orc compression %q is not supported", name)
+	}
+}
+
+// orcMarshaler marshals each batch of telemetry to an ORC file, with the same columns as the
+// parquet marshaler: a row per span, log record or metric data point.
+type orcMarshaler struct {
+	codec orc.CompressionCodec
+}
+
+func newORCMarshaler(cfg ORCConfig) (*orcMarshaler, error) {
+	codec, err := orcCompressionCodec(cfg.Compression)
+	if err != nil {
+		return nil, err
+	}
+	return &orcMarshaler{codec: codec}, nil
+}
+
+func (m *orcMarshaler) MarshalTraces(td ptrace.Traces) ([]byte, error) {
+	rec := parquettranslator.TracesToRecord(td)
+	defer rec.Release()
+	return m.marshal(rec)
+}
+
+func (m *orcMarshaler) MarshalLogs(ld plog.Logs) ([]byte, error) {
+	rec := parquettranslator.LogsToRecord(ld)
+	defer rec.Release()
+	return m.marshal(rec)
+}
+
+func (m *orcMarshaler) MarshalMetrics(md pmetric.Metrics) ([]byte, error) {
+	rec := parquettranslator.MetricsToRecord(md)
+	defer rec.Release()
+	return m.marshal(rec)
+}
+
+func (m *orcMarshaler) marshal(rec arrow.Record) ([]byte, error) {
+	schema, err := orcSchema(rec.Schema())
+	if err != nil {
+		return nil, err
+	}
+	var buf bytes.Buffer
+	writer, err := orc.NewWriter(&buf, orc.SetSchema(schema), orc.SetCompression(m.codec))
+	if err != nil {
+		return nil, err
+	}
+	row := make([]any, rec.NumCols())
+	for i := 0; i < int(rec.NumRows()); i++ {
+		for j, col := range rec.Columns() {
+			row[j] = orcValue(col, i)
+		}
+		if err = writer.Write(row...); err != nil {
+			return nil, err
+		}
+	}
+	if err = writer.Close(); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+func (m *orcMarshaler) format() string {
+	return "orc"
+}
+
+// orcSchema returns the ORC struct type of the columns of the arrow schema.
+func orcSchema(schema *arrow.Schema) (*orc.TypeDescription, error) {
+	fields := make([]string, 0, len(schema.Fields()))
+	for _, field := range schema.Fields() {
+		fieldType, err := orcType(field.Type)
+		if err != nil {
+			return nil, fmt.Errorf("column %q: %w", field.Name, err)
+		}
+		fields = append(fields, field.Name+":"+fieldType)
+	}
+	return orc.ParseSchema("struct<" + strings.Join(fields, ",") + ">")
+}
+
+// orcType returns the ORC type of the arrow types used by the translator. The unsigned integers are
+// written as bigint, as ORC has no unsigned types.
+func orcType(dataType arrow.DataType) (string, error) {
+	switch dt := dataType.(type) {
+	case *arrow.StringType:
+		return "string", nil
+	case *arrow.TimestampType:
+		return "timestamp", nil
+	case *arrow.BooleanType:
+		return "boolean", nil
+	case *arrow.Int32Type:
+		return "int", nil
+	case *arrow.Int64Type, *arrow.Uint32Type, *arrow.Uint64Type:
+		return "bigint", nil
+	case *arrow.Float64Type:
+		return "double", nil
+	case *arrow.MapType:
+		key, err := orcType(dt.KeyType())
+		if err != nil {
+			return "", err
+		}
+		item, err := orcType(dt.ItemType())
+		if err != nil {
+			return "", err
+		}
+		return "map<" + key + "," + item + ">", nil
+	case *arrow.ListType:
+		elem, err := orcType(dt.Elem())
+		if err != nil {
+			return "", err
+		}
+		return "array<" + elem + ">", nil
+	default:
+		return "", fmt.Errorf("unsupported type %s", dataType)
+	}
+}
+
+// orcValue returns the value of the row of the column, as expected by the ORC writer.
+func orcValue(col arrow.Array, i int) any {
+	if col.IsNull(i) {
+		return nil
+	}
+	switch c := col.(type) {
+	case *array.String:
+		return c.Value(i)
+	case *array.Timestamp:
+		return time.Unix(0, int64(c.Value(i))).UTC()
+	case *array.Boolean:
+		return c.Value(i)
+	case *array.Int32:
+		return c.Value(i)
+	case *array.Int64:
+		return c.Value(i)
+	case *array.Uint32:
+		return int64(c.Value(i))
+	case *array.Uint64:
+		return int64(c.Value(i))
+	case *array.Float64:
+		return c.Value(i)
+	case *array.Map:
+		start, end := c.ValueOffsets(i)
+		values := make(map[any]any, end-start)
+		for j := int(start); j < int(end); j++ {
+			values[orcValue(c.Keys(), j)] = orcValue(c.Items(), j)
+		}
+		return values
+	case *array.List:
+		start, end := c.ValueOffsets(i)
+		values := make([]any, 0, end-start)
+		for j := int(start); j < int(end); j++ {
+			values = append(values, orcValue(c.ListValues(), j))
+		}
+		return values
+	default:
+		return nil
+	}
+}
@@ -0,0 +1,94 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package awss3exporter
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/scritchley/orc"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/collector/pdata/plog"
+	"go.opentelemetry.io/collector/pdata/pmetric"
+	"go.opentelemetry.io/collector/pdata/ptrace"
+)
+
+func readORC(t *testing.T, buf []byte) (int, []string) {
+	rdr, err := orc.NewReader(bytes.NewReader(buf))
+	require.NoError(t, err)
+	defer rdr.Close()
+	return rdr.NumRows(), rdr.Schema().Columns()
+}
+
+func TestORCMarshaler(t *testing.T) {
+	for _, compression := range []string{orcCompressionNone, orcCompressionZlib} {
+		t.Run(compression, func(t *testing.T) {
+			m, err := newORCMarshaler(ORCConfig{Compression: compression})
+			require.NoError(t, err)
+			assert.Equal(t, "orc", m.format())
+
+			ld := plog.NewLogs()
+			lrs := ld.ResourceLogs().AppendEmpty().ScopeLogs().AppendEmpty().LogRecords()
+			lrs.AppendEmpty().Body().SetStr("first")
+			lr := lrs.AppendEmpty()
+			lr.Body().SetStr("second")
+			lr.Attributes().PutStr("key", "value")
+			buf, err := m.MarshalLogs(ld)
+			require.NoError(t, err)
+			rows, columns := readORC(t, buf)
+			assert.Equal(t, 2, rows)
+			assert.Contains(t, columns, "body")
+
+			td := ptrace.NewTraces()
+			td.ResourceSpans().AppendEmpty().ScopeSpans().AppendEmpty().Spans().AppendEmpty().SetName("span")
+			buf, err = m.MarshalTraces(td)
+			require.NoError(t, err)
+			rows, columns = readORC(t, buf)
+			assert.Equal(t, 1, rows)
+			assert.Contains(t, columns, "duration_nanos")
+
+			md := pmetric.NewMetrics()
+			dps := md.ResourceMetrics().AppendEmpty().ScopeMetrics().AppendEmpty().Metrics().AppendEmpty().SetEmptyGauge().DataPoints()
+			dps.AppendEmpty().SetDoubleValue(1)
+			dps.AppendEmpty().SetDoubleValue(2)
+			dps.AppendEmpty().SetDoubleValue(3)
+			buf, err = m.MarshalMetrics(md)
+			require.NoError(t, err)
+			rows, columns = readORC(t, buf)
+			assert.Equal(t, 3, rows)
+			assert.Contains(t, columns, "metric_name")
+		})
+	}
+}
+
+func TestORCMarshalerValues(t *testing.T) {
+	m, err := newORCMarshaler(ORCConfig{Compression: orcCompressionZlib})
+	require.NoError(t, err)
+
+	ld := plog.NewLogs()
+	lrs := ld.ResourceLogs().AppendEmpty().ScopeLogs().AppendEmpty().LogRecords()
+	lrs.AppendEmpty().Body().SetStr("first")
+	lrs.AppendEmpty().Body().SetStr("second")
+	buf, err := m.MarshalLogs(ld)
+	require.NoError(t, err)
+
+	rdr, err := orc.NewReader(bytes.NewReader(buf))
+	require.NoError(t, err)
+	defer rdr.Close()
+	cursor := rdr.Select("body")
+	var bodies []any
+	for cursor.Stripes() {
+		for cursor.Next() {
+			bodies = append(bodies, cursor.Row()[0])
+		}
+	}
+	require.NoError(t, cursor.Err())
+	assert.Equal(t, []any{"first", "second"}, bodies)
+}
+
+func TestORCMarshalerUnknownCompression(t *testing.T) {
+	_, err := newORCMarshaler(ORCConfig{Compression: "snappy"})
+	assert.EqualError(t, err, `orc compression "snappy" is not supported`)
+}
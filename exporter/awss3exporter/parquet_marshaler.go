@@ -0,0 +1,73 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package awss3exporter // import "github.com/open-telemetry/opentelemetry-collector-contrib/exporter/awss3exporter"
+
+import (
+	"bytes"
+
+	"github.com/apache/arrow/go/v15/arrow"
+	"github.com/apache/arrow/go/v15/parquet"
+	"github.com/apache/arrow/go/v15/parquet/pqarrow"
+	"go.opentelemetry.io/collector/pdata/plog"
+	"go.opentelemetry.io/collector/pdata/pmetric"
+	"go.opentelemetry.io/collector/pdata/ptrace"
+
+	parquettranslator "github.com/open-telemetry/opentelemetry-collector-contrib/pkg/translator/parquet"
+)
+
+// parquetMarshaler marshals each batch of telemetry to a Parquet file, with a row per span, log
+// record or metric data point.
+type parquetMarshaler struct {
+	props *parquet.WriterProperties
+}
+
+func newParquetMarshaler(cfg ParquetConfig) (*parquetMarshaler, error) {
+	codec, err := parquettranslator.CompressionCodec(cfg.Compression)
+	if err != nil {
+		return nil, err
+	}
+	return &parquetMarshaler{
+		props: parquet.NewWriterProperties(
+			parquet.WithCompression(codec),
+			parquet.WithCreatedBy("opentelemetry-collector awss3exporter"),
+		),
+	}, nil
+}
+
+func (m *parquetMarshaler) MarshalTraces(td ptrace.Traces) ([]byte, error) {
+	rec := parquettranslator.TracesToRecord(td)
+	defer rec.Release()
+	return m.marshal(rec)
+}
+
+func (m *parquetMarshaler) MarshalLogs(ld plog.Logs) ([]byte, error) {
+	rec := parquettranslator.LogsToRecord(ld)
+	defer rec.Release()
+	return m.marshal(rec)
+}
+
+func (m *parquetMarshaler) MarshalMetrics(md pmetric.Metrics) ([]byte, error) {
+	rec := parquettranslator.MetricsToRecord(md)
+	defer rec.Release()
+	return m.marshal(rec)
+}
+
+func (m *parquetMarshaler) marshal(rec arrow.Record) ([]byte, error) {
+	var buf bytes.Buffer
+	writer, err := pqarrow.NewFileWriter(rec.Schema(), &buf, m.props, pqarrow.NewArrowWriterProperties(pqarrow.WithStoreSchema()))
+	if err != nil {
+		return nil, err
+	}
+	if err = writer.Write(rec); err != nil {
+		return nil, err
+	}
+	if err = writer.Close(); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+func (m *parquetMarshaler) format() string {
+	return "parquet"
+}
@@ -0,0 +1,82 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package awss3exporter
+
+import (
+	"bytes"
+	"context"
+	"testing"
+
+	"github.com/apache/arrow/go/v15/arrow/memory"
+	"github.com/apache/arrow/go/v15/parquet/file"
+	"github.com/apache/arrow/go/v15/parquet/pqarrow"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/collector/pdata/plog"
+	"go.opentelemetry.io/collector/pdata/pmetric"
+	"go.opentelemetry.io/collector/pdata/ptrace"
+
+	parquettranslator "github.com/open-telemetry/opentelemetry-collector-contrib/pkg/translator/parquet"
+)
+
+func readParquet(t *testing.T, buf []byte) (int64, []string) {
+	rdr, err := file.NewParquetReader(bytes.NewReader(buf))
+	require.NoError(t, err)
+	defer rdr.Close()
+	reader, err := pqarrow.NewFileReader(rdr, pqarrow.ArrowReadProperties{}, memory.DefaultAllocator)
+	require.NoError(t, err)
+	table, err := reader.ReadTable(context.Background())
+	require.NoError(t, err)
+	defer table.Release()
+
+	var columns []string
+	for _, field := range table.Schema().Fields() {
+		columns = append(columns, field.Name)
+	}
+	return table.NumRows(), columns
+}
+
+func TestParquetMarshaler(t *testing.T) {
+	for _, compression := range []string{parquettranslator.CompressionNone, parquettranslator.CompressionSnappy, parquettranslator.CompressionGzip, parquettranslator.CompressionZstd} {
+		t.Run(compression, func(t *testing.T) {
+			m, err := newParquetMarshaler(ParquetConfig{Compression: compression})
+			require.NoError(t, err)
+			assert.Equal(t, "parquet", m.format())
+
+			ld := plog.NewLogs()
+			lrs := ld.ResourceLogs().AppendEmpty().ScopeLogs().AppendEmpty().LogRecords()
+			lrs.AppendEmpty().Body().SetStr("first")
+			lrs.AppendEmpty().Body().SetStr("second")
+			buf, err := m.MarshalLogs(ld)
+			require.NoError(t, err)
+			rows, columns := readParquet(t, buf)
+			assert.EqualValues(t, 2, rows)
+			assert.Contains(t, columns, "body")
+
+			td := ptrace.NewTraces()
+			td.ResourceSpans().AppendEmpty().ScopeSpans().AppendEmpty().Spans().AppendEmpty().SetName("span")
+			buf, err = m.MarshalTraces(td)
+			require.NoError(t, err)
+			rows, columns = readParquet(t, buf)
+			assert.EqualValues(t, 1, rows)
+			assert.Contains(t, columns, "duration_nanos")
+
+			md := pmetric.NewMetrics()
+			dps := md.ResourceMetrics().AppendEmpty().ScopeMetrics().AppendEmpty().Metrics().AppendEmpty().SetEmptyGauge().DataPoints()
+			dps.AppendEmpty().SetDoubleValue(1)
+			dps.AppendEmpty().SetDoubleValue(2)
+			dps.AppendEmpty().SetDoubleValue(3)
+			buf, err = m.MarshalMetrics(md)
+			require.NoError(t, err)
+			rows, columns = readParquet(t, buf)
+			assert.EqualValues(t, 3, rows)
+			assert.Contains(t, columns, "metric_name")
+		})
+	}
+}
+
+func TestParquetMarshalerUnknownCompression(t *testing.T) {
+	_, err := newParquetMarshaler(ParquetConfig{Compression: "lz4"})
+	assert.EqualError(t, err, `parquet compression "lz4" is not supported`)
+}
@@ -10,6 +10,7 @@ import (
 	"fmt"
 	"math/rand"
 	"strconv"
+	"strings"
 	"time"
 
 	"github.com/aws/aws-sdk-go/aws"
@@ -42,13 +43,21 @@ func randomInRange(low, hi int) int {
 
 func getS3Key(time time.Time, keyPrefix string, partition string, filePrefix string, metadata string, fileFormat string, compression configcompression.Type) string {
 	timeKey := getTimeKey(time, partition)
+	return getTemplatedS3Key(keyPrefix+"/"+timeKey+"/", filePrefix, metadata, fileFormat, compression)
+}
+
+// getTemplatedS3Key appends the file name to the key prefix rendered from the key template.
+func getTemplatedS3Key(keyPrefix string, filePrefix string, metadata string, fileFormat string, compression configcompression.Type) string {
 	randomID := randomInRange(100000000, 999999999)
 	suffix := ""
 	if fileFormat != "" {
 		suffix = "." + fileFormat
 	}
+	if keyPrefix != "" && !strings.HasSuffix(keyPrefix, "/") {
+		keyPrefix += "/"
+	}
 
-	s3Key := keyPrefix + "/" + timeKey + "/" + filePrefix + metadata + "_" + strconv.Itoa(randomID) + suffix
+	s3Key := keyPrefix + filePrefix + metadata + "_" + strconv.Itoa(randomID) + suffix
 
 	// add ".gz" extension to files if compression is enabled
 	if compression == configcompression.TypeGzip {
@@ -84,11 +93,15 @@ func getSession(config *Config, sessionConfig *aws.Config) (*session.Session, er
 	return sess, err
 }
 
-func (s3writer *s3Writer) writeBuffer(_ context.Context, buf []byte, config *Config, metadata string, format string) error {
-	now := time.Now()
-	key := getS3Key(now,
-		config.S3Uploader.S3Prefix, config.S3Uploader.S3Partition,
-		config.S3Uploader.FilePrefix, metadata, format, config.S3Uploader.Compression)
+func (s3writer *s3Writer) writeBuffer(_ context.Context, buf []byte, config *Config, keyPrefix string, metadata string, format string) error {
+	var key string
+	if keyPrefix != "" {
+		key = getTemplatedS3Key(keyPrefix, config.S3Uploader.FilePrefix, metadata, format, config.S3Uploader.Compression)
+	} else {
+		key = getS3Key(time.Now(),
+			config.S3Uploader.S3Prefix, config.S3Uploader.S3Partition,
+			config.S3Uploader.FilePrefix, metadata, format, config.S3Uploader.Compression)
+	}
 
 	encoding := ""
 	var reader *bytes.Reader
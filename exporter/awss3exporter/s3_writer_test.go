@@ -83,6 +83,15 @@ func TestS3KeyOfCompressedFileEmptyFileFormat(t *testing.T) {
 	assert.Equal(t, true, matched)
 }
 
+func TestTemplatedS3Key(t *testing.T) {
+	re := regexp.MustCompile(`^logs/tenant=acme/fileprefixlogs_([0-9]+).parquet$`)
+	assert.Regexp(t, re, getTemplatedS3Key("logs/tenant=acme/", "fileprefix", "logs", "parquet", ""))
+	assert.Regexp(t, re, getTemplatedS3Key("logs/tenant=acme", "fileprefix", "logs", "parquet", ""))
+
+	re = regexp.MustCompile(`^logs/tenant=acme/logs_([0-9]+).json.gz$`)
+	assert.Regexp(t, re, getTemplatedS3Key("logs/tenant=acme/", "", "logs", "json", "gzip"))
+}
+
 func TestGetSessionConfigWithEndpoint(t *testing.T) {
 	const endpoint = "https://endpoint.com"
 	const region = "region"
@@ -109,6 +109,7 @@ func extractResourceSpans(config component.Config, logger *zap.Logger, td ptrace
 					spans.At(k), resource,
 					config.(*Config).IndexedAttributes,
 					config.(*Config).IndexAllAttributes,
+					config.(*Config).AttributeMappings,
 					config.(*Config).LogGroupNames,
 					config.(*Config).skipTimestampValidation)
 
@@ -4,6 +4,9 @@
 package awsxrayexporter // import "github.com/open-telemetry/opentelemetry-collector-contrib/exporter/awsxrayexporter"
 
 import (
+	"fmt"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/exporter/awsxrayexporter/internal/translator"
 	"github.com/open-telemetry/opentelemetry-collector-contrib/internal/aws/awsutil"
 	"github.com/open-telemetry/opentelemetry-collector-contrib/internal/aws/xray/telemetry"
 )
@@ -20,6 +23,9 @@ type Config struct {
 	// Set to true to convert all OpenTelemetry attributes to X-Ray annotation (indexed) ignoring the IndexedAttributes option.
 	// Default value: false
 	IndexAllAttributes bool `mapstructure:"index_all_attributes"`
+	// AttributeMappings declares which span and resource attributes are converted to X-Ray annotations
+	// or metadata, optionally renaming them. They take precedence over IndexedAttributes and IndexAllAttributes.
+	AttributeMappings []translator.AttributeMapping `mapstructure:"attribute_mappings"`
 
 	LogGroupNames []string `mapstructure:"aws_log_groups"`
 	// TelemetryConfig contains the options for telemetry collection.
@@ -28,3 +34,16 @@ type Config struct {
 	// skipTimestampValidation if enabled, will skip timestamp validation logic on the trace ID
 	skipTimestampValidation bool
 }
+
+// Validate checks that an attribute isn't mapped twice.
+func (c *Config) Validate() error {
+	mapped := map[translator.AttributeMapping]bool{}
+	for _, mapping := range c.AttributeMappings {
+		key := translator.AttributeMapping{Key: mapping.Key, Resource: mapping.Resource}
+		if mapped[key] {
+			return fmt.Errorf("attribute %q is mapped more than once", mapping.Key)
+		}
+		mapped[key] = true
+	}
+	return nil
+}
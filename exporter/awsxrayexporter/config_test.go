@@ -13,6 +13,7 @@ import (
 	"go.opentelemetry.io/collector/confmap/confmaptest"
 
 	"github.com/open-telemetry/opentelemetry-collector-contrib/exporter/awsxrayexporter/internal/metadata"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/exporter/awsxrayexporter/internal/translator"
 	"github.com/open-telemetry/opentelemetry-collector-contrib/internal/aws/awsutil"
 )
 
@@ -45,8 +46,13 @@ func TestLoadConfig(t *testing.T) {
 					ResourceARN:           "arn:aws:ec2:us-east1:123456789:instance/i-293hiuhe0u",
 					RoleARN:               "arn:aws:iam::123456789:role/monitoring-EKS-NodeInstanceRole",
 				},
-				IndexedAttributes:       []string{"indexed_attr_0", "indexed_attr_1"},
-				IndexAllAttributes:      false,
+				IndexedAttributes:  []string{"indexed_attr_0", "indexed_attr_1"},
+				IndexAllAttributes: false,
+				AttributeMappings: []translator.AttributeMapping{
+					{Key: "tenant.id", Name: "tenant"},
+					{Key: "order.total", Type: translator.AttributeMappingMetadata, Namespace: "business"},
+					{Key: "deployment.environment", Resource: true, Name: "environment"},
+				},
 				LogGroupNames:           []string{"group1", "group2"},
 				skipTimestampValidation: false,
 			},
@@ -67,3 +73,36 @@ func TestLoadConfig(t *testing.T) {
 		})
 	}
 }
+
+func TestLoadInvalidConfig(t *testing.T) {
+	t.Parallel()
+
+	cm, err := confmaptest.LoadConf(filepath.Join("testdata", "config.yaml"))
+	require.NoError(t, err)
+
+	tests := []struct {
+		id  component.ID
+		err string
+	}{
+		{
+			id:  component.NewIDWithName(metadata.Type, "duplicate_mapping"),
+			err: `attribute "tenant.id" is mapped more than once`,
+		},
+		{
+			id:  component.NewIDWithName(metadata.Type, "invalid_mapping"),
+			err: `attribute mapping "tenant.id": unknown type "index", must be "annotation" or "metadata"`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.id.String(), func(t *testing.T) {
+			cfg := NewFactory().CreateDefaultConfig()
+
+			sub, err := cm.Sub(tt.id.String())
+			require.NoError(t, err)
+			require.NoError(t, sub.Unmarshal(cfg))
+
+			assert.EqualError(t, component.ValidateConfig(cfg), tt.err)
+		})
+	}
+}
@@ -0,0 +1,94 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package translator // import "github.com/open-telemetry/opentelemetry-collector-contrib/exporter/awsxrayexporter/internal/translator"
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	"go.opentelemetry.io/collector/pdata/pcommon"
+)
+
+// Types of the X-Ray fields an attribute can be mapped to.
+const (
+	AttributeMappingAnnotation = "annotation"
+	AttributeMappingMetadata   = "metadata"
+)
+
+// AttributeMapping declares how a span or resource attribute is converted to an X-Ray annotation or metadata,
+// taking precedence over the indexed_attributes and index_all_attributes options.
+type AttributeMapping struct {
+	// Key is the name of the attribute.
+	Key string `mapstructure:"key"`
+	// Resource matches the resource attribute named Key instead of the span attribute.
+	// Resource attributes are only written to segments, not to subsegments.
+	Resource bool `mapstructure:"resource"`
+	// Type is either annotation (default) or metadata. Values which can't be annotations,
+	// such as maps and slices, are written as metadata.
+	Type string `mapstructure:"type"`
+	// Name renames the attribute in the segment. Defaults to Key, or to otel.resource.<Key>
+	// for resource attributes.
+	Name string `mapstructure:"name"`
+	// Namespace is the metadata namespace the attribute is written to. Defaults to "default".
+	Namespace string `mapstructure:"namespace"`
+}
+
+// Validate checks the attribute mapping.
+func (m AttributeMapping) Validate() error {
+	if m.Key == "" {
+		return errors.New("attribute mapping key must not be empty")
+	}
+	switch m.Type {
+	case "", AttributeMappingAnnotation:
+		if m.Namespace != "" {
+			return fmt.Errorf("attribute mapping %q: namespace can only be set for metadata", m.Key)
+		}
+	case AttributeMappingMetadata:
+	default:
+		return fmt.Errorf("attribute mapping %q: unknown type %q, must be %q or %q", m.Key, m.Type, AttributeMappingAnnotation, AttributeMappingMetadata)
+	}
+	return nil
+}
+
+// splitAttributeMappings indexes the mappings of span attributes and of resource attributes by attribute name.
+func splitAttributeMappings(attributeMappings []AttributeMapping) (span map[string]AttributeMapping, resource map[string]AttributeMapping) {
+	span = map[string]AttributeMapping{}
+	resource = map[string]AttributeMapping{}
+	for _, mapping := range attributeMappings {
+		if mapping.Resource {
+			resource[mapping.Key] = mapping
+		} else {
+			span[mapping.Key] = mapping
+		}
+	}
+	return span, resource
+}
+
+// applyAttributeMapping writes the attribute value to the annotations or metadata declared by the mapping.
+// name is the name of the attribute when the mapping doesn't rename it.
+func applyAttributeMapping(mapping AttributeMapping, name string, value pcommon.Value, annotations map[string]any, metadata map[string]map[string]any, defaultMetadata map[string]any) {
+	if mapping.Name != "" {
+		name = mapping.Name
+	}
+	if mapping.Type != AttributeMappingMetadata {
+		if annoVal := annotationValue(value); annoVal != nil {
+			annotations[fixAnnotationKey(name)] = annoVal
+			return
+		}
+	}
+
+	metaVal := value.AsRaw()
+	if metaVal == nil {
+		return
+	}
+	if mapping.Namespace == "" || strings.EqualFold(mapping.Namespace, defaultMetadataNamespace) {
+		defaultMetadata[name] = metaVal
+		return
+	}
+	if metadata[mapping.Namespace] == nil {
+		metadata[mapping.Namespace] = map[string]any{}
+	}
+	metadata[mapping.Namespace][name] = metaVal
+}
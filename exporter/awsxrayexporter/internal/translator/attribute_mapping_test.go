@@ -0,0 +1,117 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package translator
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/collector/featuregate"
+	"go.opentelemetry.io/collector/pdata/ptrace"
+	conventions "go.opentelemetry.io/collector/semconv/v1.8.0"
+)
+
+func TestAttributeMappingValidate(t *testing.T) {
+	tests := []struct {
+		name    string
+		mapping AttributeMapping
+		err     string
+	}{
+		{
+			name:    "annotation",
+			mapping: AttributeMapping{Key: "tenant.id", Name: "tenant"},
+		},
+		{
+			name:    "metadata",
+			mapping: AttributeMapping{Key: "tenant.id", Type: AttributeMappingMetadata, Namespace: "business"},
+		},
+		{
+			name:    "empty key",
+			mapping: AttributeMapping{Type: AttributeMappingAnnotation},
+			err:     "attribute mapping key must not be empty",
+		},
+		{
+			name:    "unknown type",
+			mapping: AttributeMapping{Key: "tenant.id", Type: "index"},
+			err:     `attribute mapping "tenant.id": unknown type "index", must be "annotation" or "metadata"`,
+		},
+		{
+			name:    "annotation namespace",
+			mapping: AttributeMapping{Key: "tenant.id", Namespace: "business"},
+			err:     `attribute mapping "tenant.id": namespace can only be set for metadata`,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.mapping.Validate()
+			if tt.err == "" {
+				assert.NoError(t, err)
+			} else {
+				assert.EqualError(t, err, tt.err)
+			}
+		})
+	}
+}
+
+func TestAttributeMappings(t *testing.T) {
+	err := featuregate.GlobalRegistry().Set("exporter.xray.allowDot", false)
+	require.NoError(t, err)
+
+	attributes := map[string]any{
+		"tenant.id":                     "acme",
+		"order.total":                   12,
+		"cart":                          "{\"items\":3}",
+		"attr1":                         "val1",
+		conventions.AttributeEnduserID:  "user-1",
+		conventions.AttributeHTTPMethod: "GET",
+	}
+	mappings := []AttributeMapping{
+		{Key: "tenant.id", Name: "tenant"},
+		{Key: "order.total", Type: AttributeMappingMetadata, Namespace: "business"},
+		{Key: "cart", Type: AttributeMappingMetadata},
+		{Key: conventions.AttributeEnduserID, Name: "user"},
+		{Key: conventions.AttributeHTTPMethod},
+		{Key: resourceStringKey, Resource: true},
+		{Key: resourceIntKey, Resource: true, Name: "shard"},
+		{Key: resourceMapKey, Resource: true},
+		{Key: resourceBoolKey, Resource: true, Type: AttributeMappingMetadata, Namespace: "business"},
+	}
+	span := constructServerSpan(newSegmentID(), "/api/orders", ptrace.StatusCodeOk, "OK", attributes)
+
+	// the mappings take precedence over index_all_attributes
+	segment, err := MakeSegment(span, constructDefaultResource(), nil, true, mappings, nil, false)
+	require.NoError(t, err)
+
+	assert.Equal(t, "user-1", *segment.User)
+	assert.Equal(t, "acme", segment.Annotations["tenant"])
+	assert.Equal(t, "user-1", segment.Annotations["user"])
+	assert.Equal(t, "GET", segment.Annotations["http_method"])
+	assert.Equal(t, "string", segment.Annotations["otel_resource_string_key"])
+	assert.Equal(t, int64(10), segment.Annotations["shard"])
+	assert.Equal(t, "val1", segment.Annotations["attr1"])
+	assert.NotContains(t, segment.Annotations, "order_total")
+	assert.NotContains(t, segment.Annotations, "otel_resource_bool_key")
+
+	assert.Equal(t, int64(12), segment.Metadata["business"]["order.total"])
+	assert.Equal(t, true, segment.Metadata["business"]["otel.resource.bool.key"])
+	assert.Equal(t, "{\"items\":3}", segment.Metadata["default"]["cart"])
+	// maps can't be annotations
+	assert.Equal(t, map[string]any{"key1": int64(1), "key2": "value"}, segment.Metadata["default"]["otel.resource.map.key"])
+}
+
+func TestResourceAttributeMappingsOnSubsegments(t *testing.T) {
+	attributes := map[string]any{"tenant.id": "acme"}
+	span := constructClientSpan(newSegmentID(), "/api/orders", ptrace.StatusCodeOk, "OK", attributes)
+	mappings := []AttributeMapping{
+		{Key: "tenant.id", Type: AttributeMappingMetadata},
+		{Key: resourceStringKey, Resource: true},
+	}
+
+	segment, err := MakeSegment(span, constructDefaultResource(), nil, false, mappings, nil, false)
+	require.NoError(t, err)
+
+	assert.Empty(t, segment.Annotations)
+	assert.Equal(t, map[string]map[string]any{"default": {"tenant.id": "acme"}}, segment.Metadata)
+}
@@ -95,8 +95,8 @@ var (
 )
 
 // MakeSegmentDocuments converts spans to json documents
-func MakeSegmentDocuments(span ptrace.Span, resource pcommon.Resource, indexedAttrs []string, indexAllAttrs bool, logGroupNames []string, skipTimestampValidation bool) ([]string, error) {
-	segments, err := MakeSegmentsFromSpan(span, resource, indexedAttrs, indexAllAttrs, logGroupNames, skipTimestampValidation)
+func MakeSegmentDocuments(span ptrace.Span, resource pcommon.Resource, indexedAttrs []string, indexAllAttrs bool, attributeMappings []AttributeMapping, logGroupNames []string, skipTimestampValidation bool) ([]string, error) {
+	segments, err := MakeSegmentsFromSpan(span, resource, indexedAttrs, indexAllAttrs, attributeMappings, logGroupNames, skipTimestampValidation)
 
 	if err == nil {
 		var documents []string
@@ -142,13 +142,13 @@ func addNamespaceToSubsegmentWithRemoteService(span ptrace.Span, segment *awsxra
 	}
 }
 
-func MakeDependencySubsegmentForLocalRootDependencySpan(span ptrace.Span, resource pcommon.Resource, indexedAttrs []string, indexAllAttrs bool, logGroupNames []string, skipTimestampValidation bool, serviceSegmentID pcommon.SpanID) (*awsxray.Segment, error) {
+func MakeDependencySubsegmentForLocalRootDependencySpan(span ptrace.Span, resource pcommon.Resource, indexedAttrs []string, indexAllAttrs bool, attributeMappings []AttributeMapping, logGroupNames []string, skipTimestampValidation bool, serviceSegmentID pcommon.SpanID) (*awsxray.Segment, error) {
 	var dependencySpan = ptrace.NewSpan()
 	span.CopyTo(dependencySpan)
 
 	dependencySpan.SetParentSpanID(serviceSegmentID)
 
-	dependencySubsegment, err := MakeSegment(dependencySpan, resource, indexedAttrs, indexAllAttrs, logGroupNames, skipTimestampValidation)
+	dependencySubsegment, err := MakeSegment(dependencySpan, resource, indexedAttrs, indexAllAttrs, attributeMappings, logGroupNames, skipTimestampValidation)
 
 	if err != nil {
 		return nil, err
@@ -174,7 +174,7 @@ func MakeDependencySubsegmentForLocalRootDependencySpan(span ptrace.Span, resour
 	return dependencySubsegment, err
 }
 
-func MakeServiceSegmentForLocalRootDependencySpan(span ptrace.Span, resource pcommon.Resource, indexedAttrs []string, indexAllAttrs bool, logGroupNames []string, skipTimestampValidation bool, serviceSegmentID pcommon.SpanID) (*awsxray.Segment, error) {
+func MakeServiceSegmentForLocalRootDependencySpan(span ptrace.Span, resource pcommon.Resource, indexedAttrs []string, indexAllAttrs bool, attributeMappings []AttributeMapping, logGroupNames []string, skipTimestampValidation bool, serviceSegmentID pcommon.SpanID) (*awsxray.Segment, error) {
 	// We always create a segment for the service
 	var serviceSpan ptrace.Span = ptrace.NewSpan()
 	span.CopyTo(serviceSpan)
@@ -186,7 +186,7 @@ func MakeServiceSegmentForLocalRootDependencySpan(span ptrace.Span, resource pco
 		serviceSpan.Attributes().Remove(v)
 	}
 
-	serviceSegment, err := MakeSegment(serviceSpan, resource, indexedAttrs, indexAllAttrs, logGroupNames, skipTimestampValidation)
+	serviceSegment, err := MakeSegment(serviceSpan, resource, indexedAttrs, indexAllAttrs, attributeMappings, logGroupNames, skipTimestampValidation)
 
 	if err != nil {
 		return nil, err
@@ -232,8 +232,8 @@ func MakeServiceSegmentForLocalRootDependencySpan(span ptrace.Span, resource pco
 	return serviceSegment, nil
 }
 
-func MakeServiceSegmentForLocalRootSpanWithoutDependency(span ptrace.Span, resource pcommon.Resource, indexedAttrs []string, indexAllAttrs bool, logGroupNames []string, skipTimestampValidation bool) ([]*awsxray.Segment, error) {
-	segment, err := MakeSegment(span, resource, indexedAttrs, indexAllAttrs, logGroupNames, skipTimestampValidation)
+func MakeServiceSegmentForLocalRootSpanWithoutDependency(span ptrace.Span, resource pcommon.Resource, indexedAttrs []string, indexAllAttrs bool, attributeMappings []AttributeMapping, logGroupNames []string, skipTimestampValidation bool) ([]*awsxray.Segment, error) {
+	segment, err := MakeSegment(span, resource, indexedAttrs, indexAllAttrs, attributeMappings, logGroupNames, skipTimestampValidation)
 
 	if err != nil {
 		return nil, err
@@ -245,8 +245,8 @@ func MakeServiceSegmentForLocalRootSpanWithoutDependency(span ptrace.Span, resou
 	return []*awsxray.Segment{segment}, err
 }
 
-func MakeNonLocalRootSegment(span ptrace.Span, resource pcommon.Resource, indexedAttrs []string, indexAllAttrs bool, logGroupNames []string, skipTimestampValidation bool) ([]*awsxray.Segment, error) {
-	segment, err := MakeSegment(span, resource, indexedAttrs, indexAllAttrs, logGroupNames, skipTimestampValidation)
+func MakeNonLocalRootSegment(span ptrace.Span, resource pcommon.Resource, indexedAttrs []string, indexAllAttrs bool, attributeMappings []AttributeMapping, logGroupNames []string, skipTimestampValidation bool) ([]*awsxray.Segment, error) {
+	segment, err := MakeSegment(span, resource, indexedAttrs, indexAllAttrs, attributeMappings, logGroupNames, skipTimestampValidation)
 
 	if err != nil {
 		return nil, err
@@ -257,20 +257,20 @@ func MakeNonLocalRootSegment(span ptrace.Span, resource pcommon.Resource, indexe
 	return []*awsxray.Segment{segment}, nil
 }
 
-func MakeServiceSegmentAndDependencySubsegment(span ptrace.Span, resource pcommon.Resource, indexedAttrs []string, indexAllAttrs bool, logGroupNames []string, skipTimestampValidation bool) ([]*awsxray.Segment, error) {
+func MakeServiceSegmentAndDependencySubsegment(span ptrace.Span, resource pcommon.Resource, indexedAttrs []string, indexAllAttrs bool, attributeMappings []AttributeMapping, logGroupNames []string, skipTimestampValidation bool) ([]*awsxray.Segment, error) {
 	// If it is a local root span and a dependency span, we need to make a segment and subsegment representing the local service and remote service, respectively.
 	var serviceSegmentID = newSegmentID()
 	var segments []*awsxray.Segment
 
 	// Make Dependency Subsegment
-	dependencySubsegment, err := MakeDependencySubsegmentForLocalRootDependencySpan(span, resource, indexedAttrs, indexAllAttrs, logGroupNames, skipTimestampValidation, serviceSegmentID)
+	dependencySubsegment, err := MakeDependencySubsegmentForLocalRootDependencySpan(span, resource, indexedAttrs, indexAllAttrs, attributeMappings, logGroupNames, skipTimestampValidation, serviceSegmentID)
 	if err != nil {
 		return nil, err
 	}
 	segments = append(segments, dependencySubsegment)
 
 	// Make Service Segment
-	serviceSegment, err := MakeServiceSegmentForLocalRootDependencySpan(span, resource, indexedAttrs, indexAllAttrs, logGroupNames, skipTimestampValidation, serviceSegmentID)
+	serviceSegment, err := MakeServiceSegmentForLocalRootDependencySpan(span, resource, indexedAttrs, indexAllAttrs, attributeMappings, logGroupNames, skipTimestampValidation, serviceSegmentID)
 	if err != nil {
 		return nil, err
 	}
@@ -280,22 +280,22 @@ func MakeServiceSegmentAndDependencySubsegment(span ptrace.Span, resource pcommo
 }
 
 // MakeSegmentsFromSpan creates one or more segments from a span
-func MakeSegmentsFromSpan(span ptrace.Span, resource pcommon.Resource, indexedAttrs []string, indexAllAttrs bool, logGroupNames []string, skipTimestampValidation bool) ([]*awsxray.Segment, error) {
+func MakeSegmentsFromSpan(span ptrace.Span, resource pcommon.Resource, indexedAttrs []string, indexAllAttrs bool, attributeMappings []AttributeMapping, logGroupNames []string, skipTimestampValidation bool) ([]*awsxray.Segment, error) {
 	if !isLocalRoot(span) {
-		return MakeNonLocalRootSegment(span, resource, indexedAttrs, indexAllAttrs, logGroupNames, skipTimestampValidation)
+		return MakeNonLocalRootSegment(span, resource, indexedAttrs, indexAllAttrs, attributeMappings, logGroupNames, skipTimestampValidation)
 	}
 
 	if !isLocalRootSpanADependencySpan(span) {
-		return MakeServiceSegmentForLocalRootSpanWithoutDependency(span, resource, indexedAttrs, indexAllAttrs, logGroupNames, skipTimestampValidation)
+		return MakeServiceSegmentForLocalRootSpanWithoutDependency(span, resource, indexedAttrs, indexAllAttrs, attributeMappings, logGroupNames, skipTimestampValidation)
 	}
 
-	return MakeServiceSegmentAndDependencySubsegment(span, resource, indexedAttrs, indexAllAttrs, logGroupNames, skipTimestampValidation)
+	return MakeServiceSegmentAndDependencySubsegment(span, resource, indexedAttrs, indexAllAttrs, attributeMappings, logGroupNames, skipTimestampValidation)
 }
 
 // MakeSegmentDocumentString converts an OpenTelemetry Span to an X-Ray Segment and then serializes to JSON
 // MakeSegmentDocumentString will be deprecated in the future
-func MakeSegmentDocumentString(span ptrace.Span, resource pcommon.Resource, indexedAttrs []string, indexAllAttrs bool, logGroupNames []string, skipTimestampValidation bool) (string, error) {
-	segment, err := MakeSegment(span, resource, indexedAttrs, indexAllAttrs, logGroupNames, skipTimestampValidation)
+func MakeSegmentDocumentString(span ptrace.Span, resource pcommon.Resource, indexedAttrs []string, indexAllAttrs bool, attributeMappings []AttributeMapping, logGroupNames []string, skipTimestampValidation bool) (string, error) {
+	segment, err := MakeSegment(span, resource, indexedAttrs, indexAllAttrs, attributeMappings, logGroupNames, skipTimestampValidation)
 
 	if err != nil {
 		return "", err
@@ -324,7 +324,7 @@ func isAwsSdkSpan(span ptrace.Span) bool {
 }
 
 // MakeSegment converts an OpenTelemetry Span to an X-Ray Segment
-func MakeSegment(span ptrace.Span, resource pcommon.Resource, indexedAttrs []string, indexAllAttrs bool, logGroupNames []string, skipTimestampValidation bool) (*awsxray.Segment, error) {
+func MakeSegment(span ptrace.Span, resource pcommon.Resource, indexedAttrs []string, indexAllAttrs bool, attributeMappings []AttributeMapping, logGroupNames []string, skipTimestampValidation bool) (*awsxray.Segment, error) {
 	var segmentType string
 
 	storeResource := true
@@ -352,8 +352,8 @@ func MakeSegment(span ptrace.Span, resource pcommon.Resource, indexedAttrs []str
 		awsfiltered, aws                                   = makeAws(causefiltered, resource, logGroupNames)
 		service                                            = makeService(resource)
 		sqlfiltered, sql                                   = makeSQL(span, awsfiltered)
-		additionalAttrs                                    = addSpecialAttributes(sqlfiltered, indexedAttrs, attributes)
-		user, annotations, metadata                        = makeXRayAttributes(additionalAttrs, resource, storeResource, indexedAttrs, indexAllAttrs)
+		additionalAttrs                                    = addSpecialAttributes(sqlfiltered, indexedAttrs, attributeMappings, attributes)
+		user, annotations, metadata                        = makeXRayAttributes(additionalAttrs, resource, storeResource, indexedAttrs, indexAllAttrs, attributeMappings)
 		spanLinks, makeSpanLinkErr                         = makeSpanLinks(span.Links(), skipTimestampValidation)
 		name                                               string
 		namespace                                          string
@@ -598,30 +598,43 @@ func timestampToFloatSeconds(ts pcommon.Timestamp) float64 {
 	return float64(ts) / float64(time.Second)
 }
 
-func addSpecialAttributes(attributes map[string]pcommon.Value, indexedAttrs []string, unfilteredAttributes pcommon.Map) map[string]pcommon.Value {
+func addSpecialAttributes(attributes map[string]pcommon.Value, indexedAttrs []string, attributeMappings []AttributeMapping, unfilteredAttributes pcommon.Map) map[string]pcommon.Value {
 	for _, name := range indexedAttrs {
-		// Allow attributes that have been filtered out before if explicitly added to be annotated/indexed
-		_, isAnnotatable := attributes[name]
-		unfilteredAttribute, attributeExists := unfilteredAttributes.Get(name)
-		if !isAnnotatable && attributeExists {
-			attributes[name] = unfilteredAttribute
+		addFilteredAttribute(attributes, name, unfilteredAttributes)
+	}
+	for _, mapping := range attributeMappings {
+		if !mapping.Resource {
+			addFilteredAttribute(attributes, mapping.Key, unfilteredAttributes)
 		}
 	}
 
 	return attributes
 }
 
-func makeXRayAttributes(attributes map[string]pcommon.Value, resource pcommon.Resource, storeResource bool, indexedAttrs []string, indexAllAttrs bool) (
+// addFilteredAttribute allows attributes that have been filtered out before if explicitly added to be
+// annotated/indexed or mapped.
+func addFilteredAttribute(attributes map[string]pcommon.Value, name string, unfilteredAttributes pcommon.Map) {
+	_, isAnnotatable := attributes[name]
+	unfilteredAttribute, attributeExists := unfilteredAttributes.Get(name)
+	if !isAnnotatable && attributeExists {
+		attributes[name] = unfilteredAttribute
+	}
+}
+
+func makeXRayAttributes(attributes map[string]pcommon.Value, resource pcommon.Resource, storeResource bool, indexedAttrs []string, indexAllAttrs bool, attributeMappings []AttributeMapping) (
 	string, map[string]any, map[string]map[string]any) {
 	var (
-		annotations = map[string]any{}
-		metadata    = map[string]map[string]any{}
-		user        string
+		annotations                    = map[string]any{}
+		metadata                       = map[string]map[string]any{}
+		user                           string
+		spanMappings, resourceMappings = splitAttributeMappings(attributeMappings)
 	)
 	userid, ok := attributes[conventions.AttributeEnduserID]
 	if ok {
 		user = userid.Str()
-		delete(attributes, conventions.AttributeEnduserID)
+		if _, mapped := spanMappings[conventions.AttributeEnduserID]; !mapped {
+			delete(attributes, conventions.AttributeEnduserID)
+		}
 	}
 
 	if len(attributes) == 0 && (!storeResource || resource.Attributes().Len() == 0) {
@@ -653,6 +666,10 @@ func makeXRayAttributes(attributes map[string]pcommon.Value, resource pcommon.Re
 
 	if storeResource {
 		resource.Attributes().Range(func(key string, value pcommon.Value) bool {
+			if mapping, mapped := resourceMappings[key]; mapped {
+				applyAttributeMapping(mapping, "otel.resource."+key, value, annotations, metadata, defaultMetadata)
+				return true
+			}
 			key = "otel.resource." + key
 			annoVal := annotationValue(value)
 			indexed := indexAllAttrs || indexedKeys[key]
@@ -669,6 +686,13 @@ func makeXRayAttributes(attributes map[string]pcommon.Value, resource pcommon.Re
 		})
 	}
 
+	for key, value := range attributes {
+		if mapping, mapped := spanMappings[key]; mapped {
+			applyAttributeMapping(mapping, key, value, annotations, metadata, defaultMetadata)
+			delete(attributes, key)
+		}
+	}
+
 	if indexAllAttrs {
 		for key, value := range attributes {
 			key = fixAnnotationKey(key)
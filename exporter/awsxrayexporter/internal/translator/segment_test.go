@@ -51,13 +51,13 @@ func TestClientSpanWithRpcAwsSdkClientAttributes(t *testing.T) {
 	resource := constructDefaultResource()
 	span := constructClientSpan(parentSpanID, spanName, 0, "OK", attributes)
 
-	segment, _ := MakeSegment(span, resource, nil, false, nil, false)
+	segment, _ := MakeSegment(span, resource, nil, false, nil, nil, false)
 	assert.Equal(t, "DynamoDB", *segment.Name)
 	assert.Equal(t, conventions.AttributeCloudProviderAWS, *segment.Namespace)
 	assert.Equal(t, "GetItem", *segment.AWS.Operation)
 	assert.Equal(t, "subsegment", *segment.Type)
 
-	jsonStr, err := MakeSegmentDocumentString(span, resource, nil, false, nil, false)
+	jsonStr, err := MakeSegmentDocumentString(span, resource, nil, false, nil, nil, false)
 
 	assert.NotNil(t, jsonStr)
 	assert.NoError(t, err)
@@ -84,13 +84,13 @@ func TestClientSpanWithLegacyAwsSdkClientAttributes(t *testing.T) {
 	resource := constructDefaultResource()
 	span := constructClientSpan(parentSpanID, spanName, 0, "OK", attributes)
 
-	segment, _ := MakeSegment(span, resource, nil, false, nil, false)
+	segment, _ := MakeSegment(span, resource, nil, false, nil, nil, false)
 	assert.Equal(t, "DynamoDB", *segment.Name)
 	assert.Equal(t, conventions.AttributeCloudProviderAWS, *segment.Namespace)
 	assert.Equal(t, "GetItem", *segment.AWS.Operation)
 	assert.Equal(t, "subsegment", *segment.Type)
 
-	jsonStr, err := MakeSegmentDocumentString(span, resource, nil, false, nil, false)
+	jsonStr, err := MakeSegmentDocumentString(span, resource, nil, false, nil, nil, false)
 
 	assert.NotNil(t, jsonStr)
 	assert.NoError(t, err)
@@ -116,7 +116,7 @@ func TestClientSpanWithPeerService(t *testing.T) {
 	resource := constructDefaultResource()
 	span := constructClientSpan(parentSpanID, spanName, 0, "OK", attributes)
 
-	segment, _ := MakeSegment(span, resource, nil, false, nil, false)
+	segment, _ := MakeSegment(span, resource, nil, false, nil, nil, false)
 	assert.Equal(t, "cats-table", *segment.Name)
 }
 
@@ -139,7 +139,7 @@ func TestServerSpanWithInternalServerError(t *testing.T) {
 	timeEvents := constructTimedEventsWithSentMessageEvent(span.StartTimestamp())
 	timeEvents.CopyTo(span.Events())
 
-	segment, _ := MakeSegment(span, resource, nil, false, nil, false)
+	segment, _ := MakeSegment(span, resource, nil, false, nil, nil, false)
 
 	assert.NotNil(t, segment)
 	assert.NotNil(t, segment.Cause)
@@ -166,7 +166,7 @@ func TestServerSpanWithThrottle(t *testing.T) {
 	timeEvents := constructTimedEventsWithSentMessageEvent(span.StartTimestamp())
 	timeEvents.CopyTo(span.Events())
 
-	segment, _ := MakeSegment(span, resource, nil, false, nil, false)
+	segment, _ := MakeSegment(span, resource, nil, false, nil, nil, false)
 
 	assert.NotNil(t, segment)
 	assert.NotNil(t, segment.Cause)
@@ -182,7 +182,7 @@ func TestServerSpanNoParentId(t *testing.T) {
 	resource := constructDefaultResource()
 	span := constructServerSpan(parentSpanID, spanName, ptrace.StatusCodeOk, "OK", nil)
 
-	segment, _ := MakeSegment(span, resource, nil, false, nil, false)
+	segment, _ := MakeSegment(span, resource, nil, false, nil, nil, false)
 
 	assert.Empty(t, segment.ParentID)
 }
@@ -197,7 +197,7 @@ func TestSpanNoParentId(t *testing.T) {
 	span.SetStartTimestamp(pcommon.NewTimestampFromTime(time.Now()))
 	span.SetEndTimestamp(pcommon.NewTimestampFromTime(time.Now().Add(10)))
 	resource := pcommon.NewResource()
-	segment, _ := MakeSegment(span, resource, nil, false, nil, false)
+	segment, _ := MakeSegment(span, resource, nil, false, nil, nil, false)
 
 	assert.Empty(t, segment.ParentID)
 	assert.Nil(t, segment.Type)
@@ -213,7 +213,7 @@ func TestSpanWithNoStatus(t *testing.T) {
 	span.SetEndTimestamp(pcommon.NewTimestampFromTime(time.Now().Add(10)))
 
 	resource := pcommon.NewResource()
-	segment, _ := MakeSegment(span, resource, nil, false, nil, false)
+	segment, _ := MakeSegment(span, resource, nil, false, nil, nil, false)
 	assert.NotNil(t, segment)
 }
 
@@ -233,7 +233,7 @@ func TestClientSpanWithDbComponent(t *testing.T) {
 	resource := constructDefaultResource()
 	span := constructClientSpan(parentSpanID, spanName, ptrace.StatusCodeUnset, "OK", attributes)
 
-	segment, _ := MakeSegment(span, resource, nil, false, nil, false)
+	segment, _ := MakeSegment(span, resource, nil, false, nil, nil, false)
 
 	assert.NotNil(t, segment)
 	assert.NotNil(t, segment.SQL)
@@ -271,7 +271,7 @@ func TestClientSpanWithHttpHost(t *testing.T) {
 	resource := constructDefaultResource()
 	span := constructClientSpan(parentSpanID, spanName, ptrace.StatusCodeUnset, "OK", attributes)
 
-	segment, _ := MakeSegment(span, resource, nil, false, nil, false)
+	segment, _ := MakeSegment(span, resource, nil, false, nil, nil, false)
 
 	assert.NotNil(t, segment)
 	assert.Equal(t, "foo.com", *segment.Name)
@@ -290,7 +290,7 @@ func TestClientSpanWithoutHttpHost(t *testing.T) {
 	resource := constructDefaultResource()
 	span := constructClientSpan(parentSpanID, spanName, ptrace.StatusCodeUnset, "OK", attributes)
 
-	segment, _ := MakeSegment(span, resource, nil, false, nil, false)
+	segment, _ := MakeSegment(span, resource, nil, false, nil, nil, false)
 
 	assert.NotNil(t, segment)
 	assert.Equal(t, "bar.com", *segment.Name)
@@ -310,7 +310,7 @@ func TestClientSpanWithRpcHost(t *testing.T) {
 	resource := constructDefaultResource()
 	span := constructClientSpan(parentSpanID, spanName, ptrace.StatusCodeUnset, "OK", attributes)
 
-	segment, _ := MakeSegment(span, resource, nil, false, nil, false)
+	segment, _ := MakeSegment(span, resource, nil, false, nil, nil, false)
 
 	assert.NotNil(t, segment)
 	assert.Equal(t, "com.foo.AnimalService", *segment.Name)
@@ -332,7 +332,7 @@ func TestSpanWithInvalidTraceId(t *testing.T) {
 	traceID[0] = 0x11
 	span.SetTraceID(traceID)
 
-	_, err := MakeSegmentDocumentString(span, resource, nil, false, nil, false)
+	_, err := MakeSegmentDocumentString(span, resource, nil, false, nil, nil, false)
 
 	assert.Error(t, err)
 }
@@ -367,12 +367,12 @@ func TestSpanWithInvalidTraceIdWithoutTimestampValidation(t *testing.T) {
 	traceID[0] = 0x11
 	span.SetTraceID(traceID)
 
-	segment, err := MakeSegment(span, resource, nil, false, nil, true)
+	segment, err := MakeSegment(span, resource, nil, false, nil, nil, true)
 	require.NoError(t, err)
 	assert.Equal(t, "ProducerService", *segment.Name)
 	assert.Equal(t, "subsegment", *segment.Type)
 
-	jsonStr, err := MakeSegmentDocumentString(span, resource, nil, false, nil, true)
+	jsonStr, err := MakeSegmentDocumentString(span, resource, nil, false, nil, nil, true)
 
 	require.NoError(t, err)
 	assert.NotNil(t, jsonStr)
@@ -447,7 +447,7 @@ func TestServerSpanWithNilAttributes(t *testing.T) {
 	timeEvents.CopyTo(span.Events())
 	pcommon.NewMap().CopyTo(span.Attributes())
 
-	segment, _ := MakeSegment(span, resource, nil, false, nil, false)
+	segment, _ := MakeSegment(span, resource, nil, false, nil, nil, false)
 
 	assert.NotNil(t, segment)
 	assert.NotNil(t, segment.Cause)
@@ -464,7 +464,7 @@ func TestSpanWithAttributesDefaultNotIndexed(t *testing.T) {
 	resource := constructDefaultResource()
 	span := constructServerSpan(parentSpanID, spanName, ptrace.StatusCodeError, "OK", attributes)
 
-	segment, _ := MakeSegment(span, resource, nil, false, nil, false)
+	segment, _ := MakeSegment(span, resource, nil, false, nil, nil, false)
 
 	assert.NotNil(t, segment)
 	assert.Equal(t, 0, len(segment.Annotations))
@@ -491,7 +491,7 @@ func TestSpanWithResourceNotStoredIfSubsegment(t *testing.T) {
 	resource := constructDefaultResource()
 	span := constructClientSpan(parentSpanID, spanName, ptrace.StatusCodeError, "ERROR", attributes)
 
-	segment, _ := MakeSegment(span, resource, nil, false, nil, false)
+	segment, _ := MakeSegment(span, resource, nil, false, nil, nil, false)
 
 	assert.NotNil(t, segment)
 	assert.Equal(t, 0, len(segment.Annotations))
@@ -514,7 +514,7 @@ func TestSpanWithAttributesPartlyIndexed(t *testing.T) {
 	resource := constructDefaultResource()
 	span := constructServerSpan(parentSpanID, spanName, ptrace.StatusCodeError, "OK", attributes)
 
-	segment, _ := MakeSegment(span, resource, []string{"attr1@1", "not_exist"}, false, nil, false)
+	segment, _ := MakeSegment(span, resource, []string{"attr1@1", "not_exist"}, false, nil, nil, false)
 
 	assert.NotNil(t, segment)
 	assert.Equal(t, 1, len(segment.Annotations))
@@ -532,7 +532,7 @@ func TestSpanWithAnnotationsAttribute(t *testing.T) {
 	resource := constructDefaultResource()
 	span := constructServerSpan(parentSpanID, spanName, ptrace.StatusCodeError, "OK", attributes)
 
-	segment, _ := MakeSegment(span, resource, nil, false, nil, false)
+	segment, _ := MakeSegment(span, resource, nil, false, nil, nil, false)
 
 	assert.NotNil(t, segment)
 	assert.Equal(t, 1, len(segment.Annotations))
@@ -549,7 +549,7 @@ func TestSpanWithAttributesAllIndexed(t *testing.T) {
 	resource := constructDefaultResource()
 	span := constructServerSpan(parentSpanID, spanName, ptrace.StatusCodeOk, "OK", attributes)
 
-	segment, _ := MakeSegment(span, resource, []string{"attr1@1", "not_exist"}, true, nil, false)
+	segment, _ := MakeSegment(span, resource, []string{"attr1@1", "not_exist"}, true, nil, nil, false)
 
 	assert.NotNil(t, segment)
 	assert.Equal(t, "val1", segment.Annotations["attr1_1"])
@@ -567,7 +567,7 @@ func TestSpanWithAttributesSegmentMetadata(t *testing.T) {
 	resource := constructDefaultResource()
 	span := constructServerSpan(parentSpanID, spanName, ptrace.StatusCodeError, "OK", attributes)
 
-	segment, _ := MakeSegment(span, resource, nil, false, nil, false)
+	segment, _ := MakeSegment(span, resource, nil, false, nil, nil, false)
 
 	assert.NotNil(t, segment)
 	assert.Equal(t, 0, len(segment.Annotations))
@@ -599,7 +599,7 @@ func TestResourceAttributesCanBeIndexed(t *testing.T) {
 		"otel.resource.bool.key",
 		"otel.resource.map.key",
 		"otel.resource.array.key",
-	}, false, nil, false)
+	}, false, nil, nil, false)
 
 	assert.NotNil(t, segment)
 	assert.Equal(t, 4, len(segment.Annotations))
@@ -632,7 +632,7 @@ func TestResourceAttributesCanBeIndexedWithAllowDot(t *testing.T) {
 		"otel.resource.bool.key",
 		"otel.resource.map.key",
 		"otel.resource.array.key",
-	}, false, nil, false)
+	}, false, nil, nil, false)
 
 	assert.NotNil(t, segment)
 	assert.Equal(t, 4, len(segment.Annotations))
@@ -663,7 +663,7 @@ func TestResourceAttributesNotIndexedIfSubsegment(t *testing.T) {
 		"otel.resource.bool.key",
 		"otel.resource.map.key",
 		"otel.resource.array.key",
-	}, false, nil, false)
+	}, false, nil, nil, false)
 
 	assert.NotNil(t, segment)
 	assert.Empty(t, segment.Annotations)
@@ -682,7 +682,7 @@ func TestSpanWithSpecialAttributesAsListed(t *testing.T) {
 	resource := constructDefaultResource()
 	span := constructServerSpan(parentSpanID, spanName, ptrace.StatusCodeError, "OK", attributes)
 
-	segment, _ := MakeSegment(span, resource, []string{awsxray.AWSOperationAttribute, conventions.AttributeRPCMethod}, false, nil, false)
+	segment, _ := MakeSegment(span, resource, []string{awsxray.AWSOperationAttribute, conventions.AttributeRPCMethod}, false, nil, nil, false)
 
 	assert.NotNil(t, segment)
 	assert.Equal(t, 2, len(segment.Annotations))
@@ -702,7 +702,7 @@ func TestSpanWithSpecialAttributesAsListedWithAllowDot(t *testing.T) {
 	resource := constructDefaultResource()
 	span := constructServerSpan(parentSpanID, spanName, ptrace.StatusCodeError, "OK", attributes)
 
-	segment, _ := MakeSegment(span, resource, []string{awsxray.AWSOperationAttribute, conventions.AttributeRPCMethod}, false, nil, false)
+	segment, _ := MakeSegment(span, resource, []string{awsxray.AWSOperationAttribute, conventions.AttributeRPCMethod}, false, nil, nil, false)
 
 	assert.NotNil(t, segment)
 	assert.Equal(t, 2, len(segment.Annotations))
@@ -722,7 +722,7 @@ func TestSpanWithSpecialAttributesAsListedAndIndexAll(t *testing.T) {
 	resource := constructDefaultResource()
 	span := constructServerSpan(parentSpanID, spanName, ptrace.StatusCodeError, "OK", attributes)
 
-	segment, _ := MakeSegment(span, resource, []string{awsxray.AWSOperationAttribute, conventions.AttributeRPCMethod}, true, nil, false)
+	segment, _ := MakeSegment(span, resource, []string{awsxray.AWSOperationAttribute, conventions.AttributeRPCMethod}, true, nil, nil, false)
 
 	assert.NotNil(t, segment)
 	assert.Equal(t, "aws_operation_val", segment.Annotations["aws_operation"])
@@ -741,7 +741,7 @@ func TestSpanWithSpecialAttributesAsListedAndIndexAllWithAllowDot(t *testing.T)
 	resource := constructDefaultResource()
 	span := constructServerSpan(parentSpanID, spanName, ptrace.StatusCodeError, "OK", attributes)
 
-	segment, _ := MakeSegment(span, resource, []string{awsxray.AWSOperationAttribute, conventions.AttributeRPCMethod}, true, nil, false)
+	segment, _ := MakeSegment(span, resource, []string{awsxray.AWSOperationAttribute, conventions.AttributeRPCMethod}, true, nil, nil, false)
 
 	assert.NotNil(t, segment)
 	assert.Equal(t, "aws_operation_val", segment.Annotations[awsxray.AWSOperationAttribute])
@@ -757,7 +757,7 @@ func TestSpanWithSpecialAttributesNotListedAndIndexAll(t *testing.T) {
 	resource := constructDefaultResource()
 	span := constructServerSpan(parentSpanID, spanName, ptrace.StatusCodeError, "OK", attributes)
 
-	segment, _ := MakeSegment(span, resource, nil, true, nil, false)
+	segment, _ := MakeSegment(span, resource, nil, true, nil, nil, false)
 
 	assert.NotNil(t, segment)
 	assert.Nil(t, segment.Annotations["aws_operation"])
@@ -774,7 +774,7 @@ func TestOriginNotAws(t *testing.T) {
 	attrs.PutStr(conventions.AttributeHostID, "instance-123")
 	span := constructServerSpan(parentSpanID, spanName, ptrace.StatusCodeError, "OK", attributes)
 
-	segment, _ := MakeSegment(span, resource, []string{}, false, nil, false)
+	segment, _ := MakeSegment(span, resource, []string{}, false, nil, nil, false)
 
 	assert.NotNil(t, segment)
 	assert.Nil(t, segment.Origin)
@@ -791,7 +791,7 @@ func TestOriginEc2(t *testing.T) {
 	attrs.PutStr(conventions.AttributeHostID, "instance-123")
 	span := constructServerSpan(parentSpanID, spanName, ptrace.StatusCodeError, "OK", attributes)
 
-	segment, _ := MakeSegment(span, resource, []string{}, false, nil, false)
+	segment, _ := MakeSegment(span, resource, []string{}, false, nil, nil, false)
 
 	assert.NotNil(t, segment)
 	assert.Equal(t, OriginEC2, *segment.Origin)
@@ -809,7 +809,7 @@ func TestOriginEcs(t *testing.T) {
 	attrs.PutStr(conventions.AttributeContainerName, "container-123")
 	span := constructServerSpan(parentSpanID, spanName, ptrace.StatusCodeError, "OK", attributes)
 
-	segment, _ := MakeSegment(span, resource, []string{}, false, nil, false)
+	segment, _ := MakeSegment(span, resource, []string{}, false, nil, nil, false)
 
 	assert.NotNil(t, segment)
 	assert.Equal(t, OriginECS, *segment.Origin)
@@ -828,7 +828,7 @@ func TestOriginEcsEc2(t *testing.T) {
 	attrs.PutStr(conventions.AttributeContainerName, "container-123")
 	span := constructServerSpan(parentSpanID, spanName, ptrace.StatusCodeError, "OK", attributes)
 
-	segment, _ := MakeSegment(span, resource, []string{}, false, nil, false)
+	segment, _ := MakeSegment(span, resource, []string{}, false, nil, nil, false)
 
 	assert.NotNil(t, segment)
 	assert.Equal(t, OriginECSEC2, *segment.Origin)
@@ -847,7 +847,7 @@ func TestOriginEcsFargate(t *testing.T) {
 	attrs.PutStr(conventions.AttributeContainerName, "container-123")
 	span := constructServerSpan(parentSpanID, spanName, ptrace.StatusCodeError, "OK", attributes)
 
-	segment, _ := MakeSegment(span, resource, []string{}, false, nil, false)
+	segment, _ := MakeSegment(span, resource, []string{}, false, nil, nil, false)
 
 	assert.NotNil(t, segment)
 	assert.Equal(t, OriginECSFargate, *segment.Origin)
@@ -866,7 +866,7 @@ func TestOriginEb(t *testing.T) {
 	attrs.PutStr(conventions.AttributeServiceInstanceID, "service-123")
 	span := constructServerSpan(parentSpanID, spanName, ptrace.StatusCodeError, "OK", attributes)
 
-	segment, _ := MakeSegment(span, resource, []string{}, false, nil, false)
+	segment, _ := MakeSegment(span, resource, []string{}, false, nil, nil, false)
 
 	assert.NotNil(t, segment)
 	assert.Equal(t, OriginEB, *segment.Origin)
@@ -897,7 +897,7 @@ func TestOriginEks(t *testing.T) {
 	attrs.PutStr(conventions.AttributeHostType, "m5.xlarge")
 	span := constructServerSpan(parentSpanID, spanName, ptrace.StatusCodeError, "OK", attributes)
 
-	segment, _ := MakeSegment(span, resource, []string{}, false, nil, false)
+	segment, _ := MakeSegment(span, resource, []string{}, false, nil, nil, false)
 
 	assert.NotNil(t, segment)
 	assert.Equal(t, OriginEKS, *segment.Origin)
@@ -913,7 +913,7 @@ func TestOriginAppRunner(t *testing.T) {
 	attrs.PutStr(conventions.AttributeCloudPlatform, conventions.AttributeCloudPlatformAWSAppRunner)
 	span := constructServerSpan(parentSpanID, spanName, ptrace.StatusCodeError, "OK", attributes)
 
-	segment, _ := MakeSegment(span, resource, []string{}, false, nil, false)
+	segment, _ := MakeSegment(span, resource, []string{}, false, nil, nil, false)
 
 	assert.NotNil(t, segment)
 	assert.Equal(t, OriginAppRunner, *segment.Origin)
@@ -928,7 +928,7 @@ func TestOriginBlank(t *testing.T) {
 	attrs.PutStr(conventions.AttributeCloudProvider, conventions.AttributeCloudProviderAWS)
 	span := constructServerSpan(parentSpanID, spanName, ptrace.StatusCodeError, "OK", attributes)
 
-	segment, _ := MakeSegment(span, resource, []string{}, false, nil, false)
+	segment, _ := MakeSegment(span, resource, []string{}, false, nil, nil, false)
 
 	assert.NotNil(t, segment)
 	assert.Nil(t, segment.Origin)
@@ -948,7 +948,7 @@ func TestOriginPrefersInfraService(t *testing.T) {
 	attrs.PutStr(conventions.AttributeServiceInstanceID, "service-123")
 	span := constructServerSpan(parentSpanID, spanName, ptrace.StatusCodeError, "OK", attributes)
 
-	segment, _ := MakeSegment(span, resource, []string{}, false, nil, false)
+	segment, _ := MakeSegment(span, resource, []string{}, false, nil, nil, false)
 
 	assert.NotNil(t, segment)
 	assert.Equal(t, OriginEC2, *segment.Origin)
@@ -978,7 +978,7 @@ func TestFilteredAttributesMetadata(t *testing.T) {
 	mapValue.PutDouble("value1", -987.65)
 	mapValue.PutBool("value2", true)
 
-	segment, _ := MakeSegment(span, resource, []string{}, false, nil, false)
+	segment, _ := MakeSegment(span, resource, []string{}, false, nil, nil, false)
 
 	assert.NotNil(t, segment)
 	assert.Nil(t, segment.Metadata["default"]["null_value"])
@@ -1001,7 +1001,7 @@ func TestSpanWithSingleDynamoDBTableHasTableName(t *testing.T) {
 	resource := constructDefaultResource()
 	span := constructServerSpan(parentSpanID, spanName, ptrace.StatusCodeError, "OK", attributes)
 
-	segment, _ := MakeSegment(span, resource, nil, false, nil, false)
+	segment, _ := MakeSegment(span, resource, nil, false, nil, nil, false)
 
 	assert.NotNil(t, segment)
 	assert.Equal(t, "table1", *segment.AWS.TableName)
@@ -1017,7 +1017,7 @@ func TestSpanWithMultipleDynamoDBTablesHasTableNames(t *testing.T) {
 	resource := constructDefaultResource()
 	span := constructServerSpan(parentSpanID, spanName, ptrace.StatusCodeError, "OK", attributes)
 
-	segment, _ := MakeSegment(span, resource, nil, false, nil, false)
+	segment, _ := MakeSegment(span, resource, nil, false, nil, nil, false)
 
 	assert.NotNil(t, segment)
 	assert.Nil(t, segment.AWS.TableName)
@@ -1035,7 +1035,7 @@ func TestSegmentWithLogGroupsFromConfig(t *testing.T) {
 	timeEvents.CopyTo(span.Events())
 	pcommon.NewMap().CopyTo(span.Attributes())
 
-	segment, _ := MakeSegment(span, resource, nil, false, []string{"my-logGroup-1"}, false)
+	segment, _ := MakeSegment(span, resource, nil, false, nil, []string{"my-logGroup-1"}, false)
 
 	cwl := []awsxray.LogGroupMetadata{{
 		LogGroup: awsxray.String("my-logGroup-1"),
@@ -1054,7 +1054,7 @@ func TestSegmentWith2LogGroupsFromConfig(t *testing.T) {
 	timeEvents.CopyTo(span.Events())
 	pcommon.NewMap().CopyTo(span.Attributes())
 
-	segment, _ := MakeSegment(span, resource, nil, false, []string{"my-logGroup-1", "my-logGroup-2"}, false)
+	segment, _ := MakeSegment(span, resource, nil, false, nil, []string{"my-logGroup-1", "my-logGroup-2"}, false)
 
 	cwl := []awsxray.LogGroupMetadata{{
 		LogGroup: awsxray.String("my-logGroup-1"),
@@ -1080,11 +1080,11 @@ func TestClientSpanWithAwsRemoteServiceName(t *testing.T) {
 	resource := constructDefaultResource()
 	span := constructClientSpan(parentSpanID, spanName, 0, "OK", attributes)
 
-	segment, _ := MakeSegment(span, resource, nil, false, nil, false)
+	segment, _ := MakeSegment(span, resource, nil, false, nil, nil, false)
 	assert.Equal(t, "PaymentService", *segment.Name)
 	assert.Equal(t, "subsegment", *segment.Type)
 
-	jsonStr, err := MakeSegmentDocumentString(span, resource, nil, false, nil, false)
+	jsonStr, err := MakeSegmentDocumentString(span, resource, nil, false, nil, nil, false)
 
 	assert.NotNil(t, jsonStr)
 	assert.NoError(t, err)
@@ -1107,11 +1107,11 @@ func TestAwsSdkSpanWithAwsRemoteServiceName(t *testing.T) {
 	resource := constructDefaultResource()
 	span := constructClientSpan(parentSpanID, spanName, 0, "OK", attributes)
 
-	segment, _ := MakeSegment(span, resource, nil, false, nil, false)
+	segment, _ := MakeSegment(span, resource, nil, false, nil, nil, false)
 	assert.Equal(t, "DynamoDb", *segment.Name)
 	assert.Equal(t, "subsegment", *segment.Type)
 
-	jsonStr, err := MakeSegmentDocumentString(span, resource, nil, false, nil, false)
+	jsonStr, err := MakeSegmentDocumentString(span, resource, nil, false, nil, nil, false)
 
 	assert.NotNil(t, jsonStr)
 	assert.NoError(t, err)
@@ -1136,11 +1136,11 @@ func TestProducerSpanWithAwsRemoteServiceName(t *testing.T) {
 	resource := constructDefaultResource()
 	span := constructProducerSpan(parentSpanID, spanName, 0, "OK", attributes)
 
-	segment, _ := MakeSegment(span, resource, nil, false, nil, false)
+	segment, _ := MakeSegment(span, resource, nil, false, nil, nil, false)
 	assert.Equal(t, "ProducerService", *segment.Name)
 	assert.Equal(t, "subsegment", *segment.Type)
 
-	jsonStr, err := MakeSegmentDocumentString(span, resource, nil, false, nil, false)
+	jsonStr, err := MakeSegmentDocumentString(span, resource, nil, false, nil, nil, false)
 
 	assert.NotNil(t, jsonStr)
 	assert.NoError(t, err)
@@ -1159,7 +1159,7 @@ func TestConsumerSpanWithAwsRemoteServiceName(t *testing.T) {
 	resource := constructDefaultResource()
 	span := constructConsumerSpan(parentSpanID, spanName, 0, "Ok", attributes)
 
-	jsonStr, err := MakeSegmentDocumentString(span, resource, nil, false, nil, false)
+	jsonStr, err := MakeSegmentDocumentString(span, resource, nil, false, nil, nil, false)
 
 	assert.NotNil(t, jsonStr)
 	assert.NoError(t, err)
@@ -1184,10 +1184,10 @@ func TestServerSpanWithAwsLocalServiceName(t *testing.T) {
 	resource := constructDefaultResource()
 	span := constructServerSpan(parentSpanID, spanName, 0, "OK", attributes)
 
-	segment, _ := MakeSegment(span, resource, nil, false, nil, false)
+	segment, _ := MakeSegment(span, resource, nil, false, nil, nil, false)
 	assert.Equal(t, "PaymentLocalService", *segment.Name)
 
-	jsonStr, err := MakeSegmentDocumentString(span, resource, nil, false, nil, false)
+	jsonStr, err := MakeSegmentDocumentString(span, resource, nil, false, nil, nil, false)
 
 	assert.NotNil(t, jsonStr)
 	assert.NoError(t, err)
@@ -1320,7 +1320,7 @@ func TestLocalRootConsumer(t *testing.T) {
 
 	addSpanLink(span)
 
-	segments, err := MakeSegmentsFromSpan(span, resource, []string{awsRemoteService, "myAnnotationKey"}, false, nil, false)
+	segments, err := MakeSegmentsFromSpan(span, resource, []string{awsRemoteService, "myAnnotationKey"}, false, nil, nil, false)
 
 	assert.NotNil(t, segments)
 	assert.Equal(t, 2, len(segments))
@@ -1351,7 +1351,7 @@ func TestNonLocalRootConsumerProcess(t *testing.T) {
 
 	addSpanLink(span)
 
-	segments, err := MakeSegmentsFromSpan(span, resource, []string{awsRemoteService, "myAnnotationKey"}, false, nil, false)
+	segments, err := MakeSegmentsFromSpan(span, resource, []string{awsRemoteService, "myAnnotationKey"}, false, nil, nil, false)
 
 	assert.NotNil(t, segments)
 	assert.Equal(t, 1, len(segments))
@@ -1397,7 +1397,7 @@ func TestLocalRootConsumerAWSNamespace(t *testing.T) {
 	spanLink.SetTraceID(newTraceID())
 	spanLink.SetSpanID(newSegmentID())
 
-	segments, err := MakeSegmentsFromSpan(span, resource, []string{awsRemoteService, "myAnnotationKey"}, false, nil, false)
+	segments, err := MakeSegmentsFromSpan(span, resource, []string{awsRemoteService, "myAnnotationKey"}, false, nil, nil, false)
 
 	assert.NotNil(t, segments)
 	assert.Equal(t, 2, len(segments))
@@ -1423,7 +1423,7 @@ func TestLocalRootClient(t *testing.T) {
 	spanLink.SetTraceID(newTraceID())
 	spanLink.SetSpanID(newSegmentID())
 
-	segments, err := MakeSegmentsFromSpan(span, resource, []string{awsRemoteService, "myAnnotationKey"}, false, nil, false)
+	segments, err := MakeSegmentsFromSpan(span, resource, []string{awsRemoteService, "myAnnotationKey"}, false, nil, nil, false)
 
 	assert.NotNil(t, segments)
 	assert.Equal(t, 2, len(segments))
@@ -1460,7 +1460,7 @@ func TestLocalRootClientAwsServiceMetrics(t *testing.T) {
 	spanLink.SetTraceID(newTraceID())
 	spanLink.SetSpanID(newSegmentID())
 
-	segments, err := MakeSegmentsFromSpan(span, resource, []string{awsRemoteService, "myAnnotationKey"}, false, nil, false)
+	segments, err := MakeSegmentsFromSpan(span, resource, []string{awsRemoteService, "myAnnotationKey"}, false, nil, nil, false)
 
 	assert.NotNil(t, segments)
 	assert.Equal(t, 2, len(segments))
@@ -1484,7 +1484,7 @@ func TestLocalRootProducer(t *testing.T) {
 
 	addSpanLink(span)
 
-	segments, err := MakeSegmentsFromSpan(span, resource, []string{awsRemoteService, "myAnnotationKey"}, false, nil, false)
+	segments, err := MakeSegmentsFromSpan(span, resource, []string{awsRemoteService, "myAnnotationKey"}, false, nil, nil, false)
 
 	assert.NotNil(t, segments)
 	assert.Equal(t, 2, len(segments))
@@ -1561,7 +1561,7 @@ func TestLocalRootServer(t *testing.T) {
 
 	addSpanLink(span)
 
-	segments, err := MakeSegmentsFromSpan(span, resource, []string{awsRemoteService, "myAnnotationKey"}, false, nil, false)
+	segments, err := MakeSegmentsFromSpan(span, resource, []string{awsRemoteService, "myAnnotationKey"}, false, nil, nil, false)
 
 	assert.NotNil(t, segments)
 	assert.Equal(t, 1, len(segments))
@@ -1584,7 +1584,7 @@ func TestLocalRootInternal(t *testing.T) {
 
 	addSpanLink(span)
 
-	segments, err := MakeSegmentsFromSpan(span, resource, []string{awsRemoteService, "myAnnotationKey"}, false, nil, false)
+	segments, err := MakeSegmentsFromSpan(span, resource, []string{awsRemoteService, "myAnnotationKey"}, false, nil, nil, false)
 
 	assert.NotNil(t, segments)
 	assert.Equal(t, 1, len(segments))
@@ -1605,7 +1605,7 @@ func TestNotLocalRootInternal(t *testing.T) {
 
 	addSpanLink(span)
 
-	segments, err := MakeSegmentsFromSpan(span, resource, []string{awsRemoteService, "myAnnotationKey"}, false, nil, false)
+	segments, err := MakeSegmentsFromSpan(span, resource, []string{awsRemoteService, "myAnnotationKey"}, false, nil, nil, false)
 
 	assert.NotNil(t, segments)
 	assert.Equal(t, 1, len(segments))
@@ -1629,7 +1629,7 @@ func TestNotLocalRootConsumer(t *testing.T) {
 
 	addSpanLink(span)
 
-	segments, err := MakeSegmentsFromSpan(span, resource, []string{awsRemoteService, "myAnnotationKey"}, false, nil, false)
+	segments, err := MakeSegmentsFromSpan(span, resource, []string{awsRemoteService, "myAnnotationKey"}, false, nil, nil, false)
 
 	assert.NotNil(t, segments)
 	assert.Equal(t, 1, len(segments))
@@ -1653,7 +1653,7 @@ func TestNotLocalRootClient(t *testing.T) {
 
 	addSpanLink(span)
 
-	segments, err := MakeSegmentsFromSpan(span, resource, []string{awsRemoteService, "myAnnotationKey"}, false, nil, false)
+	segments, err := MakeSegmentsFromSpan(span, resource, []string{awsRemoteService, "myAnnotationKey"}, false, nil, nil, false)
 
 	assert.NotNil(t, segments)
 	assert.Equal(t, 1, len(segments))
@@ -1677,7 +1677,7 @@ func TestNotLocalRootProducer(t *testing.T) {
 
 	addSpanLink(span)
 
-	segments, err := MakeSegmentsFromSpan(span, resource, []string{awsRemoteService, "myAnnotationKey"}, false, nil, false)
+	segments, err := MakeSegmentsFromSpan(span, resource, []string{awsRemoteService, "myAnnotationKey"}, false, nil, nil, false)
 
 	assert.NotNil(t, segments)
 	assert.Equal(t, 1, len(segments))
@@ -1703,7 +1703,7 @@ func TestNotLocalRootServer(t *testing.T) {
 
 	addSpanLink(span)
 
-	segments, err := MakeSegmentsFromSpan(span, resource, []string{awsRemoteService, "myAnnotationKey"}, false, nil, false)
+	segments, err := MakeSegmentsFromSpan(span, resource, []string{awsRemoteService, "myAnnotationKey"}, false, nil, nil, false)
 
 	assert.NotNil(t, segments)
 	assert.Equal(t, 1, len(segments))
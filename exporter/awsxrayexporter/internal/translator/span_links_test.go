@@ -26,7 +26,7 @@ func TestSpanLinkSimple(t *testing.T) {
 	spanLink.SetTraceID(traceID)
 	spanLink.SetSpanID(newSegmentID())
 
-	segment, _ := MakeSegment(span, resource, nil, false, nil, false)
+	segment, _ := MakeSegment(span, resource, nil, false, nil, nil, false)
 
 	var convertedTraceID, _ = convertToAmazonTraceID(traceID, false)
 
@@ -35,7 +35,7 @@ func TestSpanLinkSimple(t *testing.T) {
 	assert.Equal(t, convertedTraceID, *segment.Links[0].TraceID)
 	assert.Equal(t, 0, len(segment.Links[0].Attributes))
 
-	jsonStr, _ := MakeSegmentDocumentString(span, resource, nil, false, nil, false)
+	jsonStr, _ := MakeSegmentDocumentString(span, resource, nil, false, nil, nil, false)
 
 	assert.True(t, strings.Contains(jsonStr, "links"))
 	assert.False(t, strings.Contains(jsonStr, "attributes"))
@@ -50,11 +50,11 @@ func TestSpanLinkEmpty(t *testing.T) {
 	resource := constructDefaultResource()
 	span := constructServerSpan(parentSpanID, spanName, ptrace.StatusCodeOk, "OK", attributes)
 
-	segment, _ := MakeSegment(span, resource, nil, false, nil, false)
+	segment, _ := MakeSegment(span, resource, nil, false, nil, nil, false)
 
 	assert.Equal(t, 0, len(segment.Links))
 
-	jsonStr, _ := MakeSegmentDocumentString(span, resource, nil, false, nil, false)
+	jsonStr, _ := MakeSegmentDocumentString(span, resource, nil, false, nil, nil, false)
 
 	assert.False(t, strings.Contains(jsonStr, "links"))
 }
@@ -76,11 +76,11 @@ func TestOldSpanLinkError(t *testing.T) {
 	spanLink.SetTraceID(traceID)
 	spanLink.SetSpanID(newSegmentID())
 
-	_, error1 := MakeSegment(span, resource, nil, false, nil, false)
+	_, error1 := MakeSegment(span, resource, nil, false, nil, nil, false)
 
 	assert.NotNil(t, error1)
 
-	_, error2 := MakeSegmentDocumentString(span, resource, nil, false, nil, false)
+	_, error2 := MakeSegmentDocumentString(span, resource, nil, false, nil, nil, false)
 
 	assert.NotNil(t, error2)
 }
@@ -106,7 +106,7 @@ func TestTwoSpanLinks(t *testing.T) {
 	spanLink2.SetSpanID(newSegmentID())
 	spanLink2.Attributes().PutInt("myKey2", 1234)
 
-	segment, _ := MakeSegment(span, resource, nil, false, nil, false)
+	segment, _ := MakeSegment(span, resource, nil, false, nil, nil, false)
 
 	var convertedTraceID1, _ = convertToAmazonTraceID(traceID1, false)
 	var convertedTraceID2, _ = convertToAmazonTraceID(traceID2, false)
@@ -123,7 +123,7 @@ func TestTwoSpanLinks(t *testing.T) {
 	assert.Equal(t, 1, len(segment.Links[0].Attributes))
 	assert.Equal(t, int64(1234), segment.Links[1].Attributes["myKey2"])
 
-	jsonStr, _ := MakeSegmentDocumentString(span, resource, nil, false, nil, false)
+	jsonStr, _ := MakeSegmentDocumentString(span, resource, nil, false, nil, nil, false)
 
 	assert.True(t, strings.Contains(jsonStr, "attributes"))
 	assert.True(t, strings.Contains(jsonStr, "links"))
@@ -170,7 +170,7 @@ func TestSpanLinkComplexAttributes(t *testing.T) {
 	slice4.AppendEmpty().SetDouble(2.718)
 	slice4.AppendEmpty().SetDouble(1.618)
 
-	segment, _ := MakeSegment(span, resource, nil, false, nil, false)
+	segment, _ := MakeSegment(span, resource, nil, false, nil, nil, false)
 
 	assert.Equal(t, 1, len(segment.Links))
 	assert.Equal(t, 8, len(segment.Links[0].Attributes))
@@ -196,7 +196,7 @@ func TestSpanLinkComplexAttributes(t *testing.T) {
 	assert.Equal(t, 2.718, segment.Links[0].Attributes["myKey8"].([]any)[0])
 	assert.Equal(t, 1.618, segment.Links[0].Attributes["myKey8"].([]any)[1])
 
-	jsonStr, _ := MakeSegmentDocumentString(span, resource, nil, false, nil, false)
+	jsonStr, _ := MakeSegmentDocumentString(span, resource, nil, false, nil, nil, false)
 
 	assert.True(t, strings.Contains(jsonStr, "links"))
 
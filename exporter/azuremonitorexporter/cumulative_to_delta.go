@@ -0,0 +1,100 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package azuremonitorexporter // import "github.com/open-telemetry/opentelemetry-collector-contrib/exporter/azuremonitorexporter"
+
+import (
+	"fmt"
+	"sync"
+	"time"
+
+	"go.opentelemetry.io/collector/pdata/pcommon"
+)
+
+// cumulativeMaxStaleness is the time after which the series which didn't receive any point are forgotten.
+const cumulativeMaxStaleness = time.Hour
+
+// cumulativePoint is the value of a cumulative sum, or the sum and count of a cumulative histogram.
+type cumulativePoint struct {
+	startTimestamp pcommon.Timestamp
+	timestamp      pcommon.Timestamp
+	value          float64
+	count          uint64
+}
+
+type trackedPoint struct {
+	cumulativePoint
+	lastSeen time.Time
+}
+
+// cumulativeToDelta keeps the last point of each cumulative series. Application Insights aggregates the
+// values received over each interval, so cumulative points are sent as the difference with the previous point.
+type cumulativeToDelta struct {
+	mu        sync.Mutex
+	series    map[string]*trackedPoint
+	lastPurge time.Time
+	now       func() time.Time
+}
+
+func newCumulativeToDelta() *cumulativeToDelta {
+	return &cumulativeToDelta{
+		series: map[string]*trackedPoint{},
+		now:    time.Now,
+	}
+}
+
+// convert returns the delta between the point and the previous point of the series, from the timestamp of the
+// previous point. It returns false for the first point of a series, after a reset and for out of order points.
+func (c *cumulativeToDelta) convert(identity string, point cumulativePoint) (cumulativePoint, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	now := c.now()
+	c.purge(now)
+
+	previous, ok := c.series[identity]
+	if ok && point.timestamp <= previous.timestamp {
+		return cumulativePoint{}, false
+	}
+	c.series[identity] = &trackedPoint{cumulativePoint: point, lastSeen: now}
+	if !ok {
+		return cumulativePoint{}, false
+	}
+
+	// sums have no count, their value only decreases when they are reset
+	reset := point.startTimestamp != previous.startTimestamp ||
+		point.count < previous.count ||
+		(point.count == 0 && point.value < previous.value)
+	if reset {
+		return cumulativePoint{}, false
+	}
+	return cumulativePoint{
+		startTimestamp: previous.timestamp,
+		timestamp:      point.timestamp,
+		value:          point.value - previous.value,
+		count:          point.count - previous.count,
+	}, true
+}
+
+func (c *cumulativeToDelta) purge(now time.Time) {
+	if now.Sub(c.lastPurge) < cumulativeMaxStaleness {
+		return
+	}
+	for identity, point := range c.series {
+		if now.Sub(point.lastSeen) >= cumulativeMaxStaleness {
+			delete(c.series, identity)
+		}
+	}
+	c.lastPurge = now
+}
+
+// seriesIdentity identifies the series of a data point, from the metric name, its resource and scope and the
+// attributes of the data point.
+func seriesIdentity(metricPrefix string, attributes pcommon.Map) string {
+	return metricPrefix + fmt.Sprint(attributes.AsRaw())
+}
+
+// metricIdentityPrefix is the part of the series identity shared by the data points of a metric.
+func metricIdentityPrefix(name string, resource pcommon.Resource, scope pcommon.InstrumentationScope) string {
+	return fmt.Sprint(name, "\x00", resource.Attributes().AsRaw(), "\x00", scope.Name(), "\x00", scope.Version(), "\x00")
+}
@@ -0,0 +1,129 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package azuremonitorexporter
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"go.opentelemetry.io/collector/pdata/pcommon"
+)
+
+func TestCumulativeToDelta(t *testing.T) {
+	tests := []struct {
+		name     string
+		points   []cumulativePoint
+		expected []cumulativePoint
+	}{
+		{
+			name: "sum",
+			points: []cumulativePoint{
+				{startTimestamp: 1, timestamp: 10, value: 5},
+				{startTimestamp: 1, timestamp: 20, value: 8},
+				{startTimestamp: 1, timestamp: 30, value: 8},
+			},
+			expected: []cumulativePoint{
+				{startTimestamp: 10, timestamp: 20, value: 3},
+				{startTimestamp: 20, timestamp: 30, value: 0},
+			},
+		},
+		{
+			name: "histogram",
+			points: []cumulativePoint{
+				{startTimestamp: 1, timestamp: 10, value: 5, count: 2},
+				{startTimestamp: 1, timestamp: 20, value: 3, count: 4},
+			},
+			expected: []cumulativePoint{
+				{startTimestamp: 10, timestamp: 20, value: -2, count: 2},
+			},
+		},
+		{
+			name: "out of order",
+			points: []cumulativePoint{
+				{startTimestamp: 1, timestamp: 10, value: 5},
+				{startTimestamp: 1, timestamp: 5, value: 3},
+				{startTimestamp: 1, timestamp: 10, value: 5},
+				{startTimestamp: 1, timestamp: 20, value: 6},
+			},
+			expected: []cumulativePoint{
+				{startTimestamp: 10, timestamp: 20, value: 1},
+			},
+		},
+		{
+			name: "reset",
+			points: []cumulativePoint{
+				{startTimestamp: 1, timestamp: 10, value: 5},
+				{startTimestamp: 1, timestamp: 20, value: 2},
+				{startTimestamp: 1, timestamp: 30, value: 4},
+				{startTimestamp: 25, timestamp: 40, value: 7},
+				{startTimestamp: 25, timestamp: 50, value: 9},
+				{startTimestamp: 25, timestamp: 60, value: 10, count: 3},
+				{startTimestamp: 25, timestamp: 70, value: 11, count: 1},
+			},
+			expected: []cumulativePoint{
+				{startTimestamp: 20, timestamp: 30, value: 2},
+				{startTimestamp: 40, timestamp: 50, value: 2},
+				{startTimestamp: 50, timestamp: 60, value: 1, count: 3},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := newCumulativeToDelta()
+			var deltas []cumulativePoint
+			for _, point := range tt.points {
+				if delta, ok := c.convert("series", point); ok {
+					deltas = append(deltas, delta)
+				}
+			}
+			assert.Equal(t, tt.expected, deltas)
+		})
+	}
+}
+
+func TestCumulativeToDeltaStaleness(t *testing.T) {
+	now := time.Date(2024, 6, 1, 12, 0, 0, 0, time.UTC)
+	c := newCumulativeToDelta()
+	c.now = func() time.Time { return now }
+
+	_, ok := c.convert("stale", cumulativePoint{timestamp: 10, value: 1})
+	assert.False(t, ok)
+	_, ok = c.convert("active", cumulativePoint{timestamp: 10, value: 1})
+	assert.False(t, ok)
+
+	now = now.Add(cumulativeMaxStaleness / 2)
+	_, ok = c.convert("active", cumulativePoint{timestamp: 20, value: 2})
+	assert.True(t, ok)
+
+	now = now.Add(cumulativeMaxStaleness / 2)
+	_, ok = c.convert("active", cumulativePoint{timestamp: 30, value: 3})
+	assert.True(t, ok)
+	assert.Len(t, c.series, 1)
+
+	_, ok = c.convert("stale", cumulativePoint{timestamp: 20, value: 2})
+	assert.False(t, ok, "the series must start again once forgotten")
+}
+
+func TestSeriesIdentity(t *testing.T) {
+	resource := pcommon.NewResource()
+	resource.Attributes().PutStr("service.name", "checkout")
+	scope := pcommon.NewInstrumentationScope()
+	scope.SetName("scope")
+	prefix := metricIdentityPrefix("requests", resource, scope)
+
+	attributes := pcommon.NewMap()
+	attributes.PutStr("route", "/cart")
+	attributes.PutInt("status", 200)
+	sameAttributes := pcommon.NewMap()
+	sameAttributes.PutInt("status", 200)
+	sameAttributes.PutStr("route", "/cart")
+	otherAttributes := pcommon.NewMap()
+	otherAttributes.PutStr("route", "/cart")
+
+	assert.Equal(t, seriesIdentity(prefix, attributes), seriesIdentity(prefix, sameAttributes))
+	assert.NotEqual(t, seriesIdentity(prefix, attributes), seriesIdentity(prefix, otherAttributes))
+	assert.NotEqual(t, seriesIdentity(prefix, attributes), seriesIdentity(metricIdentityPrefix("errors", resource, scope), attributes))
+	assert.NotEqual(t, prefix, metricIdentityPrefix("requests", pcommon.NewResource(), scope))
+}
@@ -4,6 +4,7 @@
 package azuremonitorexporter // import "github.com/open-telemetry/opentelemetry-collector-contrib/exporter/azuremonitorexporter"
 
 import (
+	"strconv"
 	"time"
 
 	"github.com/microsoft/ApplicationInsights-Go/appinsights/contracts"
@@ -12,14 +13,46 @@ import (
 	"go.uber.org/zap"
 )
 
+// aggregationIntervalProperty is the property Application Insights reads the aggregation interval of
+// pre-aggregated metrics from.
+const aggregationIntervalProperty = "_MS.AggregationIntervalMs"
+
 type metricPacker struct {
-	logger *zap.Logger
+	logger     *zap.Logger
+	cumulative *cumulativeToDelta
 }
 
 type timedMetricDataPoint struct {
 	dataPoint  *contracts.DataPoint
 	timestamp  pcommon.Timestamp
 	attributes pcommon.Map
+	// interval is the aggregation interval of delta points, zero for the other points.
+	interval time.Duration
+}
+
+// temporality handles the aggregation temporality of sums and histograms. Cumulative points are converted
+// to deltas, delta points are sent with their aggregation interval.
+type temporality struct {
+	aggregationTemporality pmetric.AggregationTemporality
+	cumulative             *cumulativeToDelta
+	identityPrefix         string
+}
+
+// toDelta returns the delta of the point and its aggregation interval. It returns false for the points which
+// must not be sent.
+func (t temporality) toDelta(attributes pcommon.Map, point cumulativePoint) (cumulativePoint, time.Duration, bool) {
+	switch t.aggregationTemporality {
+	case pmetric.AggregationTemporalityCumulative:
+		delta, ok := t.cumulative.convert(seriesIdentity(t.identityPrefix, attributes), point)
+		return delta, time.Duration(delta.timestamp - delta.startTimestamp), ok
+	case pmetric.AggregationTemporalityDelta:
+		var interval time.Duration
+		if point.startTimestamp != 0 && point.timestamp > point.startTimestamp {
+			interval = time.Duration(point.timestamp - point.startTimestamp)
+		}
+		return point, interval, true
+	}
+	return point, 0, true
 }
 
 type metricTimedData interface {
@@ -30,7 +63,7 @@ type metricTimedData interface {
 func (packer *metricPacker) MetricToEnvelopes(metric pmetric.Metric, resource pcommon.Resource, instrumentationScope pcommon.InstrumentationScope) []*contracts.Envelope {
 	var envelopes []*contracts.Envelope
 
-	mtd := packer.getMetricTimedData(metric)
+	mtd := packer.getMetricTimedData(metric, resource, instrumentationScope)
 
 	if mtd != nil {
 
@@ -59,6 +92,9 @@ func (packer *metricPacker) MetricToEnvelopes(metric pmetric.Metric, resource pc
 			applyInternalSdkVersionTagToEnvelope(envelope)
 
 			setAttributesAsProperties(timedDataPoint.attributes, metricData.Properties)
+			if timedDataPoint.interval > 0 {
+				metricData.Properties[aggregationIntervalProperty] = strconv.FormatInt(timedDataPoint.interval.Milliseconds(), 10)
+			}
 
 			packer.sanitize(func() []string { return metricData.Sanitize() })
 			packer.sanitize(func() []string { return envelope.Sanitize() })
@@ -82,22 +118,41 @@ func (packer *metricPacker) sanitize(sanitizeFunc func() []string) {
 
 func newMetricPacker(logger *zap.Logger) *metricPacker {
 	packer := &metricPacker{
-		logger: logger,
+		logger:     logger,
+		cumulative: newCumulativeToDelta(),
 	}
 	return packer
 }
 
-func (packer metricPacker) getMetricTimedData(metric pmetric.Metric) metricTimedData {
+func (packer metricPacker) temporality(aggregationTemporality pmetric.AggregationTemporality, metric pmetric.Metric, resource pcommon.Resource, instrumentationScope pcommon.InstrumentationScope) temporality {
+	t := temporality{
+		aggregationTemporality: aggregationTemporality,
+		cumulative:             packer.cumulative,
+	}
+	if aggregationTemporality == pmetric.AggregationTemporalityCumulative {
+		t.identityPrefix = metricIdentityPrefix(metric.Name(), resource, instrumentationScope)
+	}
+	return t
+}
+
+func (packer metricPacker) getMetricTimedData(metric pmetric.Metric, resource pcommon.Resource, instrumentationScope pcommon.InstrumentationScope) metricTimedData {
 	//exhaustive:enforce
 	switch metric.Type() {
 	case pmetric.MetricTypeGauge:
-		return newScalarMetric(metric.Name(), metric.Gauge().DataPoints())
+		return newScalarMetric(metric.Name(), metric.Gauge().DataPoints(), temporality{})
 	case pmetric.MetricTypeSum:
-		return newScalarMetric(metric.Name(), metric.Sum().DataPoints())
+		aggregationTemporality := metric.Sum().AggregationTemporality()
+		if !metric.Sum().IsMonotonic() && aggregationTemporality == pmetric.AggregationTemporalityCumulative {
+			// non monotonic cumulative sums are sent as gauges
+			aggregationTemporality = pmetric.AggregationTemporalityUnspecified
+		}
+		return newScalarMetric(metric.Name(), metric.Sum().DataPoints(), packer.temporality(aggregationTemporality, metric, resource, instrumentationScope))
 	case pmetric.MetricTypeHistogram:
-		return newHistogramMetric(metric.Name(), metric.Histogram().DataPoints())
+		return newHistogramMetric(metric.Name(), metric.Histogram().DataPoints(),
+			packer.temporality(metric.Histogram().AggregationTemporality(), metric, resource, instrumentationScope))
 	case pmetric.MetricTypeExponentialHistogram:
-		return newExponentialHistogramMetric(metric.Name(), metric.ExponentialHistogram().DataPoints())
+		return newExponentialHistogramMetric(metric.Name(), metric.ExponentialHistogram().DataPoints(),
+			packer.temporality(metric.ExponentialHistogram().AggregationTemporality(), metric, resource, instrumentationScope))
 	case pmetric.MetricTypeSummary:
 		return newSummaryMetric(metric.Name(), metric.Summary().DataPoints())
 	}
@@ -109,36 +164,50 @@ func (packer metricPacker) getMetricTimedData(metric pmetric.Metric) metricTimed
 type scalarMetric struct {
 	name           string
 	dataPointSlice pmetric.NumberDataPointSlice
+	temporality    temporality
 }
 
-func newScalarMetric(name string, dataPointSlice pmetric.NumberDataPointSlice) *scalarMetric {
+func newScalarMetric(name string, dataPointSlice pmetric.NumberDataPointSlice, temporality temporality) *scalarMetric {
 	return &scalarMetric{
 		name:           name,
 		dataPointSlice: dataPointSlice,
+		temporality:    temporality,
 	}
 }
 
 func (m scalarMetric) getTimedDataPoints() []*timedMetricDataPoint {
-	timedDataPoints := make([]*timedMetricDataPoint, m.dataPointSlice.Len())
+	timedDataPoints := make([]*timedMetricDataPoint, 0, m.dataPointSlice.Len())
 	for i := 0; i < m.dataPointSlice.Len(); i++ {
 		numberDataPoint := m.dataPointSlice.At(i)
-		dataPoint := contracts.NewDataPoint()
-		dataPoint.Name = m.name
+		var value float64
 		switch numberDataPoint.ValueType() {
 		case pmetric.NumberDataPointValueTypeDouble:
-			dataPoint.Value = numberDataPoint.DoubleValue()
+			value = numberDataPoint.DoubleValue()
 		case pmetric.NumberDataPointValueTypeInt:
-			dataPoint.Value = float64(numberDataPoint.IntValue())
+			value = float64(numberDataPoint.IntValue())
 		case pmetric.NumberDataPointValueTypeEmpty:
-			dataPoint.Value = 0
+			value = 0
 		}
+		delta, interval, ok := m.temporality.toDelta(numberDataPoint.Attributes(), cumulativePoint{
+			startTimestamp: numberDataPoint.StartTimestamp(),
+			timestamp:      numberDataPoint.Timestamp(),
+			value:          value,
+		})
+		if !ok {
+			continue
+		}
+
+		dataPoint := contracts.NewDataPoint()
+		dataPoint.Name = m.name
+		dataPoint.Value = delta.value
 		dataPoint.Count = 1
 		dataPoint.Kind = contracts.Measurement
-		timedDataPoints[i] = &timedMetricDataPoint{
+		timedDataPoints = append(timedDataPoints, &timedMetricDataPoint{
 			dataPoint:  dataPoint,
 			timestamp:  numberDataPoint.Timestamp(),
 			attributes: numberDataPoint.Attributes(),
-		}
+			interval:   interval,
+		})
 	}
 	return timedDataPoints
 }
@@ -146,32 +215,48 @@ func (m scalarMetric) getTimedDataPoints() []*timedMetricDataPoint {
 type histogramMetric struct {
 	name           string
 	dataPointSlice pmetric.HistogramDataPointSlice
+	temporality    temporality
 }
 
-func newHistogramMetric(name string, dataPointSlice pmetric.HistogramDataPointSlice) *histogramMetric {
+func newHistogramMetric(name string, dataPointSlice pmetric.HistogramDataPointSlice, temporality temporality) *histogramMetric {
 	return &histogramMetric{
 		name:           name,
 		dataPointSlice: dataPointSlice,
+		temporality:    temporality,
 	}
 }
 
 func (m histogramMetric) getTimedDataPoints() []*timedMetricDataPoint {
-	timedDataPoints := make([]*timedMetricDataPoint, m.dataPointSlice.Len())
+	timedDataPoints := make([]*timedMetricDataPoint, 0, m.dataPointSlice.Len())
 	for i := 0; i < m.dataPointSlice.Len(); i++ {
 		histogramDataPoint := m.dataPointSlice.At(i)
+		delta, interval, ok := m.temporality.toDelta(histogramDataPoint.Attributes(), cumulativePoint{
+			startTimestamp: histogramDataPoint.StartTimestamp(),
+			timestamp:      histogramDataPoint.Timestamp(),
+			value:          histogramDataPoint.Sum(),
+			count:          histogramDataPoint.Count(),
+		})
+		if !ok {
+			continue
+		}
+
 		dataPoint := contracts.NewDataPoint()
 		dataPoint.Name = m.name
-		dataPoint.Value = histogramDataPoint.Sum()
+		dataPoint.Value = delta.value
 		dataPoint.Kind = contracts.Aggregation
-		dataPoint.Min = histogramDataPoint.Min()
-		dataPoint.Max = histogramDataPoint.Max()
-		dataPoint.Count = int(histogramDataPoint.Count())
+		// the min and max of cumulative histograms cover the whole series, not the interval
+		if m.temporality.aggregationTemporality != pmetric.AggregationTemporalityCumulative {
+			dataPoint.Min = histogramDataPoint.Min()
+			dataPoint.Max = histogramDataPoint.Max()
+		}
+		dataPoint.Count = int(delta.count)
 
-		timedDataPoints[i] = &timedMetricDataPoint{
+		timedDataPoints = append(timedDataPoints, &timedMetricDataPoint{
 			dataPoint:  dataPoint,
 			timestamp:  histogramDataPoint.Timestamp(),
 			attributes: histogramDataPoint.Attributes(),
-		}
+			interval:   interval,
+		})
 
 	}
 	return timedDataPoints
@@ -180,32 +265,48 @@ func (m histogramMetric) getTimedDataPoints() []*timedMetricDataPoint {
 type exponentialHistogramMetric struct {
 	name           string
 	dataPointSlice pmetric.ExponentialHistogramDataPointSlice
+	temporality    temporality
 }
 
-func newExponentialHistogramMetric(name string, dataPointSlice pmetric.ExponentialHistogramDataPointSlice) *exponentialHistogramMetric {
+func newExponentialHistogramMetric(name string, dataPointSlice pmetric.ExponentialHistogramDataPointSlice, temporality temporality) *exponentialHistogramMetric {
 	return &exponentialHistogramMetric{
 		name:           name,
 		dataPointSlice: dataPointSlice,
+		temporality:    temporality,
 	}
 }
 
 func (m exponentialHistogramMetric) getTimedDataPoints() []*timedMetricDataPoint {
-	timedDataPoints := make([]*timedMetricDataPoint, m.dataPointSlice.Len())
+	timedDataPoints := make([]*timedMetricDataPoint, 0, m.dataPointSlice.Len())
 	for i := 0; i < m.dataPointSlice.Len(); i++ {
 		exponentialHistogramDataPoint := m.dataPointSlice.At(i)
+		delta, interval, ok := m.temporality.toDelta(exponentialHistogramDataPoint.Attributes(), cumulativePoint{
+			startTimestamp: exponentialHistogramDataPoint.StartTimestamp(),
+			timestamp:      exponentialHistogramDataPoint.Timestamp(),
+			value:          exponentialHistogramDataPoint.Sum(),
+			count:          exponentialHistogramDataPoint.Count(),
+		})
+		if !ok {
+			continue
+		}
+
 		dataPoint := contracts.NewDataPoint()
 		dataPoint.Name = m.name
-		dataPoint.Value = exponentialHistogramDataPoint.Sum()
+		dataPoint.Value = delta.value
 		dataPoint.Kind = contracts.Aggregation
-		dataPoint.Min = exponentialHistogramDataPoint.Min()
-		dataPoint.Max = exponentialHistogramDataPoint.Max()
-		dataPoint.Count = int(exponentialHistogramDataPoint.Count())
+		// the min and max of cumulative histograms cover the whole series, not the interval
+		if m.temporality.aggregationTemporality != pmetric.AggregationTemporalityCumulative {
+			dataPoint.Min = exponentialHistogramDataPoint.Min()
+			dataPoint.Max = exponentialHistogramDataPoint.Max()
+		}
+		dataPoint.Count = int(delta.count)
 
-		timedDataPoints[i] = &timedMetricDataPoint{
+		timedDataPoints = append(timedDataPoints, &timedMetricDataPoint{
 			dataPoint:  dataPoint,
 			timestamp:  exponentialHistogramDataPoint.Timestamp(),
 			attributes: exponentialHistogramDataPoint.Attributes(),
-		}
+			interval:   interval,
+		})
 	}
 	return timedDataPoints
 }
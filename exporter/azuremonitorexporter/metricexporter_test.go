@@ -10,6 +10,7 @@ Contains tests for metricexporter.go and metric_to_envelopes.go
 import (
 	"context"
 	"testing"
+	"time"
 
 	"github.com/microsoft/ApplicationInsights-Go/appinsights/contracts"
 	"github.com/stretchr/testify/assert"
@@ -105,6 +106,79 @@ func TestSummaryEnvelopes(t *testing.T) {
 	assert.Equal(t, dataPoint.Kind, contracts.Aggregation)
 }
 
+func TestCumulativeSumEnvelopes(t *testing.T) {
+	packer := getMetricPacker()
+	sumMetric := getCumulativeTestSumMetric(10, 4)
+
+	// the first point of a cumulative series is only used as the reference of the next one
+	assert.Empty(t, packer.MetricToEnvelopes(sumMetric, getResource(), getScope()))
+
+	sumMetric = getCumulativeTestSumMetric(70, 10)
+	dataPoint, properties := getDataPointAndProperties(t, packer, sumMetric)
+	assert.Equal(t, dataPoint.Value, float64(6))
+	assert.Equal(t, dataPoint.Count, 1)
+	assert.Equal(t, dataPoint.Kind, contracts.Measurement)
+	assert.Equal(t, "60000", properties[aggregationIntervalProperty])
+
+	// non monotonic sums are sent as gauges
+	sumMetric.Sum().SetIsMonotonic(false)
+	dataPoint, properties = getDataPointAndProperties(t, packer, sumMetric)
+	assert.Equal(t, dataPoint.Value, float64(10))
+	assert.NotContains(t, properties, aggregationIntervalProperty)
+}
+
+func TestDeltaSumEnvelopes(t *testing.T) {
+	sumMetric := getCumulativeTestSumMetric(10, 4)
+	sumMetric.Sum().SetAggregationTemporality(pmetric.AggregationTemporalityDelta)
+	sumMetric.Sum().DataPoints().At(0).SetStartTimestamp(pcommon.Timestamp(6 * time.Second))
+
+	dataPoint, properties := getDataPointAndProperties(t, getMetricPacker(), sumMetric)
+	assert.Equal(t, dataPoint.Value, float64(4))
+	assert.Equal(t, "4000", properties[aggregationIntervalProperty])
+}
+
+func TestCumulativeHistogramEnvelopes(t *testing.T) {
+	packer := getMetricPacker()
+	histogramMetric := getTestHistogramMetric()
+	histogramMetric.Histogram().SetAggregationTemporality(pmetric.AggregationTemporalityCumulative)
+	assert.Empty(t, packer.MetricToEnvelopes(histogramMetric, getResource(), getScope()))
+
+	datapoint := histogramMetric.Histogram().DataPoints().At(0)
+	datapoint.SetTimestamp(pcommon.Timestamp(30 * time.Second))
+	datapoint.SetSum(10)
+	datapoint.SetCount(5)
+	dataPoint, properties := getDataPointAndProperties(t, packer, histogramMetric)
+	assert.Equal(t, dataPoint.Value, float64(7))
+	assert.Equal(t, dataPoint.Count, 2)
+	assert.Equal(t, dataPoint.Min, float64(0))
+	assert.Equal(t, dataPoint.Max, float64(0))
+	assert.Equal(t, dataPoint.Kind, contracts.Aggregation)
+	assert.Equal(t, "30000", properties[aggregationIntervalProperty])
+}
+
+func TestDeltaExponentialHistogramEnvelopes(t *testing.T) {
+	exponentialHistogramMetric := getTestExponentialHistogramMetric()
+	exponentialHistogramMetric.ExponentialHistogram().SetAggregationTemporality(pmetric.AggregationTemporalityDelta)
+	datapoint := exponentialHistogramMetric.ExponentialHistogram().DataPoints().At(0)
+	datapoint.SetStartTimestamp(pcommon.Timestamp(15 * time.Second))
+	datapoint.SetTimestamp(pcommon.Timestamp(30 * time.Second))
+
+	dataPoint, properties := getDataPointAndProperties(t, getMetricPacker(), exponentialHistogramMetric)
+	assert.Equal(t, dataPoint.Value, float64(4))
+	assert.Equal(t, dataPoint.Count, 4)
+	assert.Equal(t, dataPoint.Min, float64(1))
+	assert.Equal(t, dataPoint.Max, float64(3))
+	assert.Equal(t, "15000", properties[aggregationIntervalProperty])
+}
+
+func getDataPointAndProperties(t testing.TB, packer *metricPacker, metric pmetric.Metric) (*contracts.DataPoint, map[string]string) {
+	envelopes := packer.MetricToEnvelopes(metric, getResource(), getScope())
+	require.Len(t, envelopes, 1)
+	metricData := envelopes[0].Data.(*contracts.Data).BaseData.(*contracts.MetricData)
+	require.Len(t, metricData.Metrics, 1)
+	return metricData.Metrics[0], metricData.Properties
+}
+
 func getDataPoint(t testing.TB, metric pmetric.Metric) *contracts.DataPoint {
 	var envelopes []*contracts.Envelope = getMetricPacker().MetricToEnvelopes(metric, getResource(), getScope())
 	require.Equal(t, len(envelopes), 1)
@@ -227,6 +301,17 @@ func getTestSumMetric(modify func(pmetric.NumberDataPoint)) pmetric.Metric {
 	return metric
 }
 
+func getCumulativeTestSumMetric(seconds int64, value int64) pmetric.Metric {
+	metric := getTestSumMetric(func(datapoint pmetric.NumberDataPoint) {
+		datapoint.SetStartTimestamp(0)
+		datapoint.SetTimestamp(pcommon.Timestamp(seconds * int64(time.Second)))
+		datapoint.SetIntValue(value)
+	})
+	metric.Sum().SetAggregationTemporality(pmetric.AggregationTemporalityCumulative)
+	metric.Sum().SetIsMonotonic(true)
+	return metric
+}
+
 func getTestHistogramMetric() pmetric.Metric {
 	metric := pmetric.NewMetric()
 	metric.SetName("Histogram")
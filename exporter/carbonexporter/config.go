@@ -31,6 +31,34 @@ type Config struct {
 
 	// ResourceToTelemetrySettings defines configuration for converting resource attributes to metric labels.
 	ResourceToTelemetryConfig resourcetotelemetry.Settings `mapstructure:"resource_to_telemetry_conversion"`
+
+	// Tags defines which attributes are written as Graphite tags.
+	Tags TagsConfig `mapstructure:"tags"`
+}
+
+// TagsConfig defines which attributes are written as Graphite tags, ie.: "<metric_name>;tag1=val1".
+type TagsConfig struct {
+	// Include lists the attributes written as tags, the other attributes are dropped.
+	// When empty, all the data point attributes are written as tags.
+	Include []TagRule `mapstructure:"include"`
+}
+
+// TagRule includes an attribute as a tag.
+type TagRule struct {
+	// Key is the name of the attribute.
+	Key string `mapstructure:"key"`
+	// Resource matches the resource attribute named Key instead of the data point attribute.
+	Resource bool `mapstructure:"resource"`
+	// Name renames the tag. Defaults to Key.
+	Name string `mapstructure:"name"`
+}
+
+// tagName returns the name of the tag written for the rule.
+func (r TagRule) tagName() string {
+	if r.Name != "" {
+		return r.Name
+	}
+	return r.Key
 }
 
 func (cfg *Config) Validate() error {
@@ -49,5 +77,16 @@ func (cfg *Config) Validate() error {
 		return errors.New("'max_idle_conns' must be non-negative")
 	}
 
+	tagNames := make(map[string]struct{}, len(cfg.Tags.Include))
+	for _, rule := range cfg.Tags.Include {
+		if rule.Key == "" {
+			return errors.New("'tags::include' rules must have a non-empty 'key'")
+		}
+		if _, ok := tagNames[rule.tagName()]; ok {
+			return fmt.Errorf("'tags::include' has more than one rule for the tag %q", rule.tagName())
+		}
+		tagNames[rule.tagName()] = struct{}{}
+	}
+
 	return nil
 }
@@ -62,6 +62,12 @@ func TestLoadConfig(t *testing.T) {
 				ResourceToTelemetryConfig: resourcetotelemetry.Settings{
 					Enabled: true,
 				},
+				Tags: TagsConfig{
+					Include: []TagRule{
+						{Key: "service.name", Resource: true, Name: "service"},
+						{Key: "http.method"},
+					},
+				},
 			},
 		},
 	}
@@ -118,6 +124,25 @@ func TestValidateConfig(t *testing.T) {
 			},
 			wantErr: true,
 		},
+		{
+			name: "tag_rule_without_key",
+			config: &Config{
+				TCPAddrConfig: confignet.TCPAddrConfig{Endpoint: defaultEndpoint},
+				Tags:          TagsConfig{Include: []TagRule{{Name: "service"}}},
+			},
+			wantErr: true,
+		},
+		{
+			name: "duplicate_tag_name",
+			config: &Config{
+				TCPAddrConfig: confignet.TCPAddrConfig{Endpoint: defaultEndpoint},
+				Tags: TagsConfig{Include: []TagRule{
+					{Key: "service.name", Resource: true, Name: "service"},
+					{Key: "service"},
+				}},
+			},
+			wantErr: true,
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
@@ -79,7 +79,10 @@ var writerPool = sync.Pool{
 //     a single Carbon metric.
 //   - number of time series successfully converted to carbon.
 //   - number of time series that could not be converted to Carbon.
-func metricDataToPlaintext(md pmetric.Metrics) string {
+//
+// The tags are the data point attributes, or the attributes selected by the
+// include rules when there are any.
+func metricDataToPlaintext(md pmetric.Metrics, tagRules []TagRule) string {
 	if md.DataPointCount() == 0 {
 		return ""
 	}
@@ -90,6 +93,7 @@ func metricDataToPlaintext(md pmetric.Metrics) string {
 
 	for i := 0; i < md.ResourceMetrics().Len(); i++ {
 		rm := md.ResourceMetrics().At(i)
+		tg := tagger{rules: tagRules, resource: rm.Resource().Attributes()}
 		for j := 0; j < rm.ScopeMetrics().Len(); j++ {
 			sm := rm.ScopeMetrics().At(j)
 			for k := 0; k < sm.Metrics().Len(); k++ {
@@ -100,13 +104,13 @@ func metricDataToPlaintext(md pmetric.Metrics) string {
 				}
 				switch metric.Type() {
 				case pmetric.MetricTypeGauge:
-					writeNumberDataPoints(buf, metric.Name(), metric.Gauge().DataPoints())
+					writeNumberDataPoints(buf, tg, metric.Name(), metric.Gauge().DataPoints())
 				case pmetric.MetricTypeSum:
-					writeNumberDataPoints(buf, metric.Name(), metric.Sum().DataPoints())
+					writeNumberDataPoints(buf, tg, metric.Name(), metric.Sum().DataPoints())
 				case pmetric.MetricTypeHistogram:
-					formatHistogramDataPoints(buf, metric.Name(), metric.Histogram().DataPoints())
+					formatHistogramDataPoints(buf, tg, metric.Name(), metric.Histogram().DataPoints())
 				case pmetric.MetricTypeSummary:
-					formatSummaryDataPoints(buf, metric.Name(), metric.Summary().DataPoints())
+					formatSummaryDataPoints(buf, tg, metric.Name(), metric.Summary().DataPoints())
 				}
 			}
 		}
@@ -115,7 +119,7 @@ func metricDataToPlaintext(md pmetric.Metrics) string {
 	return buf.String()
 }
 
-func writeNumberDataPoints(buf *bytes.Buffer, metricName string, dps pmetric.NumberDataPointSlice) {
+func writeNumberDataPoints(buf *bytes.Buffer, tg tagger, metricName string, dps pmetric.NumberDataPointSlice) {
 	for i := 0; i < dps.Len(); i++ {
 		dp := dps.At(i)
 		var valueStr string
@@ -129,7 +133,7 @@ func writeNumberDataPoints(buf *bytes.Buffer, metricName string, dps pmetric.Num
 		}
 		writeLine(
 			buf,
-			buildPath(metricName, dp.Attributes()),
+			buildPath(metricName, tg.tags(dp.Attributes())),
 			valueStr,
 			formatTimestamp(dp.Timestamp()))
 	}
@@ -151,6 +155,7 @@ func writeNumberDataPoints(buf *bytes.Buffer, metricName string, dps pmetric.Num
 // less than or equal to the upper bound.
 func formatHistogramDataPoints(
 	buf *bytes.Buffer,
+	tg tagger,
 	metricName string,
 	dps pmetric.HistogramDataPointSlice,
 ) {
@@ -158,7 +163,8 @@ func formatHistogramDataPoints(
 		dp := dps.At(i)
 
 		timestampStr := formatTimestamp(dp.Timestamp())
-		formatCountAndSum(buf, metricName, dp.Attributes(), dp.Count(), dp.Sum(), timestampStr)
+		tags := tg.tags(dp.Attributes())
+		formatCountAndSum(buf, metricName, tags, dp.Count(), dp.Sum(), timestampStr)
 		if dp.ExplicitBounds().Len() == 0 {
 			continue
 		}
@@ -170,7 +176,7 @@ func formatHistogramDataPoints(
 		}
 		carbonBounds[len(carbonBounds)-1] = infinityCarbonValue
 
-		bucketPath := buildPath(metricName+distributionBucketSuffix, dp.Attributes())
+		bucketPath := buildPath(metricName+distributionBucketSuffix, tags)
 		for j := 0; j < dp.BucketCounts().Len(); j++ {
 			writeLine(
 				buf,
@@ -195,6 +201,7 @@ func formatHistogramDataPoints(
 // and will include a tag key "quantile" that specifies the quantile value.
 func formatSummaryDataPoints(
 	buf *bytes.Buffer,
+	tg tagger,
 	metricName string,
 	dps pmetric.SummaryDataPointSlice,
 ) {
@@ -202,13 +209,14 @@ func formatSummaryDataPoints(
 		dp := dps.At(i)
 
 		timestampStr := formatTimestamp(dp.Timestamp())
-		formatCountAndSum(buf, metricName, dp.Attributes(), dp.Count(), dp.Sum(), timestampStr)
+		tags := tg.tags(dp.Attributes())
+		formatCountAndSum(buf, metricName, tags, dp.Count(), dp.Sum(), timestampStr)
 
 		if dp.QuantileValues().Len() == 0 {
 			continue
 		}
 
-		quantilePath := buildPath(metricName+summaryQuantileSuffix, dp.Attributes())
+		quantilePath := buildPath(metricName+summaryQuantileSuffix, tags)
 		for j := 0; j < dp.QuantileValues().Len(); j++ {
 			writeLine(
 				buf,
@@ -274,6 +282,32 @@ func buildPath(name string, attributes pcommon.Map) string {
 	return buf.String()
 }
 
+// tagger selects the attributes written as tags, from the data point attributes
+// and the attributes of their resource.
+type tagger struct {
+	rules    []TagRule
+	resource pcommon.Map
+}
+
+// tags returns the attributes written as tags for the data point attributes.
+func (tg tagger) tags(attributes pcommon.Map) pcommon.Map {
+	if len(tg.rules) == 0 {
+		return attributes
+	}
+
+	tags := pcommon.NewMap()
+	for _, rule := range tg.rules {
+		from := attributes
+		if rule.Resource {
+			from = tg.resource
+		}
+		if v, ok := from.Get(rule.Key); ok {
+			v.CopyTo(tags.PutEmpty(rule.tagName()))
+		}
+	}
+	return tags
+}
+
 // writeLine builds a single Carbon metric textual line, ie.: it already adds
 // a new-line character at the end of the string.
 func writeLine(buf *bytes.Buffer, path, value, timestamp string) {
@@ -270,7 +270,7 @@ func TestToPlaintext(t *testing.T) {
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			gotLines := metricDataToPlaintext(tt.metricsDataFn())
+			gotLines := metricDataToPlaintext(tt.metricsDataFn(), nil)
 			got := strings.Split(gotLines, "\n")
 			got = got[:len(got)-1]
 			assert.Len(t, got, len(tt.wantLines)+tt.wantExtraLinesCount)
@@ -302,6 +302,49 @@ func expectedDistributionLines(
 	return lines
 }
 
+func TestToPlaintextWithTagRules(t *testing.T) {
+	md := pmetric.NewMetrics()
+	rm := md.ResourceMetrics().AppendEmpty()
+	rm.Resource().Attributes().PutStr("service.name", "checkout")
+	rm.Resource().Attributes().PutStr("host.name", "host-1")
+	ms := rm.ScopeMetrics().AppendEmpty().Metrics()
+
+	gauge := ms.AppendEmpty()
+	gauge.SetName("requests")
+	dp := gauge.SetEmptyGauge().DataPoints().AppendEmpty()
+	dp.SetTimestamp(pcommon.Timestamp(10 * time.Second))
+	dp.SetIntValue(3)
+	dp.Attributes().PutStr("http.method", "GET")
+	dp.Attributes().PutStr("http.url", "/cart?id=1")
+
+	histogram := ms.AppendEmpty()
+	histogram.SetName("latency")
+	hdp := histogram.SetEmptyHistogram().DataPoints().AppendEmpty()
+	hdp.SetTimestamp(pcommon.Timestamp(10 * time.Second))
+	hdp.SetCount(2)
+	hdp.SetSum(5)
+	hdp.ExplicitBounds().FromRaw([]float64{1})
+	hdp.BucketCounts().FromRaw([]uint64{1, 1})
+
+	rules := []TagRule{
+		{Key: "service.name", Resource: true, Name: "service"},
+		{Key: "http.method"},
+		{Key: "missing"},
+	}
+	expected := []string{
+		"requests;service=checkout;http.method=GET 3 10",
+		"latency.count;service=checkout 2 10",
+		"latency;service=checkout 5 10",
+		"latency.bucket;service=checkout;upper_bound=1 1 10",
+		"latency.bucket;service=checkout;upper_bound=inf 1 10",
+		"",
+	}
+	assert.Equal(t, strings.Join(expected, "\n"), metricDataToPlaintext(md, rules))
+
+	// without rules, all the data point attributes are written as tags
+	assert.Contains(t, metricDataToPlaintext(md, nil), "requests;http.method=GET;http.url=/cart?id=1 3 10\n")
+}
+
 func expectedSummaryLines(
 	metricName string,
 	tags string,
@@ -328,6 +371,6 @@ func BenchmarkConsumeMetricsDefault(b *testing.B) {
 	b.ResetTimer()
 	b.ReportAllocs()
 	for n := 0; n < b.N; n++ {
-		assert.Len(b, metricDataToPlaintext(md), 62)
+		assert.Len(b, metricDataToPlaintext(md, nil), 62)
 	}
 }
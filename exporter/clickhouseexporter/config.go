@@ -8,6 +8,7 @@ import (
 	"errors"
 	"fmt"
 	"net/url"
+	"strconv"
 	"time"
 
 	"github.com/ClickHouse/clickhouse-go/v2"
@@ -49,6 +50,28 @@ type Config struct {
 	ClusterName string `mapstructure:"cluster_name"`
 	// CreateSchema if set to true will run the DDL for creating the database and tables. default is true.
 	CreateSchema *bool `mapstructure:"create_schema"`
+	// LogsSchema customizes the logs table.
+	LogsSchema TableSchema `mapstructure:"logs_schema"`
+	// TracesSchema customizes the traces tables.
+	TracesSchema TableSchema `mapstructure:"traces_schema"`
+	// MetricsSchema customizes the metrics tables.
+	MetricsSchema TableSchema `mapstructure:"metrics_schema"`
+	// AsyncInsert configures the ClickHouse asynchronous inserts.
+	AsyncInsert AsyncInsert `mapstructure:"async_insert"`
+}
+
+// AsyncInsert configures the ClickHouse asynchronous inserts, buffering the inserted data on the
+// server side until it's flushed to the table.
+type AsyncInsert struct {
+	// Enabled enables asynchronous inserts. default is false.
+	Enabled bool `mapstructure:"enabled"`
+	// WaitForFlush if set to true waits for the data to be flushed to the table before acknowledging the insert,
+	// otherwise errors happening while flushing the data are not reported. default is true.
+	WaitForFlush bool `mapstructure:"wait_for_flush"`
+	// BusyTimeout is the maximum time the data is buffered before it's flushed. 0 uses the server setting.
+	BusyTimeout time.Duration `mapstructure:"busy_timeout"`
+	// MaxDataSize is the maximum size in bytes of the data buffered before it's flushed. 0 uses the server setting.
+	MaxDataSize uint64 `mapstructure:"max_data_size"`
 }
 
 // TableEngine defines the ENGINE string value when creating the table.
@@ -64,8 +87,12 @@ var (
 	errConfigNoEndpoint      = errors.New("endpoint must be specified")
 	errConfigInvalidEndpoint = errors.New("endpoint must be url format")
 	errConfigTTL             = errors.New("both 'ttl_days' and 'ttl' can not be provided. 'ttl_days' is deprecated, use 'ttl' instead")
+	errConfigAsyncInsert     = errors.New("asynchronous insert settings can't be provided in both 'connection_params' and 'async_insert'")
 )
 
+// asyncInsertSettings are the ClickHouse settings configured by AsyncInsert.
+var asyncInsertSettings = []string{"async_insert", "wait_for_async_insert", "async_insert_busy_timeout_ms", "async_insert_max_data_size"}
+
 // Validate the ClickHouse server configuration.
 func (cfg *Config) Validate() (err error) {
 	if cfg.Endpoint == "" {
@@ -80,6 +107,21 @@ func (cfg *Config) Validate() (err error) {
 		err = errors.Join(err, errConfigTTL)
 	}
 
+	if cfg.AsyncInsert.Enabled {
+		for _, setting := range asyncInsertSettings {
+			if _, ok := cfg.ConnectionParams[setting]; ok {
+				err = errors.Join(err, errConfigAsyncInsert)
+				break
+			}
+		}
+	}
+
+	err = errors.Join(err,
+		cfg.LogsSchema.validate("logs", insertColumns(insertLogsSQLTemplate), logsAttributeColumns),
+		cfg.TracesSchema.validate("traces", insertColumns(insertTracesSQLTemplate), tracesAttributeColumns),
+		cfg.MetricsSchema.validate("metrics", metricsInsertColumns(), metricsAttributeColumns),
+	)
+
 	// Validate DSN with clickhouse driver.
 	// Last chance to catch invalid config.
 	if _, e := clickhouse.ParseDSN(dsn); e != nil {
@@ -102,6 +144,20 @@ func (cfg *Config) buildDSN(database string) (string, error) {
 		queryParams.Set(k, v)
 	}
 
+	if cfg.AsyncInsert.Enabled {
+		queryParams.Set("async_insert", "1")
+		queryParams.Set("wait_for_async_insert", "0")
+		if cfg.AsyncInsert.WaitForFlush {
+			queryParams.Set("wait_for_async_insert", "1")
+		}
+		if cfg.AsyncInsert.BusyTimeout > 0 {
+			queryParams.Set("async_insert_busy_timeout_ms", strconv.FormatInt(cfg.AsyncInsert.BusyTimeout.Milliseconds(), 10))
+		}
+		if cfg.AsyncInsert.MaxDataSize > 0 {
+			queryParams.Set("async_insert_max_data_size", strconv.FormatUint(cfg.AsyncInsert.MaxDataSize, 10))
+		}
+	}
+
 	// Enable TLS if scheme is https. This flag is necessary to support https connections.
 	if dsnURL.Scheme == "https" {
 		queryParams.Set("secure", "true")
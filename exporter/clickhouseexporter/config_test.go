@@ -75,6 +75,20 @@ func TestLoadConfig(t *testing.T) {
 					QueueSize:    100,
 					StorageID:    &storageID,
 				},
+				LogsSchema: TableSchema{
+					Columns: map[string]string{"Body": "Message"},
+					MaterializedColumns: []MaterializedColumn{
+						{Name: "K8sNamespace", Type: "LowCardinality(String)", Source: "resource", Attribute: "k8s.namespace.name"},
+					},
+				},
+				TracesSchema: TableSchema{
+					DDL: []string{"CREATE TABLE IF NOT EXISTS otel_traces (Timestamp DateTime64(9)) ENGINE = MergeTree() ORDER BY Timestamp"},
+				},
+				AsyncInsert: AsyncInsert{
+					Enabled:     true,
+					BusyTimeout: 500 * time.Millisecond,
+					MaxDataSize: 10485760,
+				},
 			},
 		},
 	}
@@ -393,3 +407,49 @@ func TestClusterString(t *testing.T) {
 		})
 	}
 }
+
+func TestConfig_buildDSNAsyncInsert(t *testing.T) {
+	tests := []struct {
+		name        string
+		asyncInsert AsyncInsert
+		want        string
+	}{
+		{
+			name:        "disabled",
+			asyncInsert: AsyncInsert{WaitForFlush: true},
+			want:        "clickhouse://127.0.0.1:9000/default",
+		},
+		{
+			name:        "enabled",
+			asyncInsert: AsyncInsert{Enabled: true, WaitForFlush: true},
+			want:        "clickhouse://127.0.0.1:9000/default?async_insert=1&wait_for_async_insert=1",
+		},
+		{
+			name:        "flush settings",
+			asyncInsert: AsyncInsert{Enabled: true, BusyTimeout: 2 * time.Second, MaxDataSize: 1048576},
+			want:        "clickhouse://127.0.0.1:9000/default?async_insert=1&async_insert_busy_timeout_ms=2000&async_insert_max_data_size=1048576&wait_for_async_insert=0",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cfg := withDefaultConfig(func(cfg *Config) {
+				cfg.Endpoint = defaultEndpoint
+				cfg.AsyncInsert = tt.asyncInsert
+			})
+			got, err := cfg.buildDSN(cfg.Database)
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestConfig_ValidateAsyncInsert(t *testing.T) {
+	cfg := withDefaultConfig(func(cfg *Config) {
+		cfg.Endpoint = defaultEndpoint
+		cfg.ConnectionParams = map[string]string{"async_insert": "1"}
+	})
+	assert.NoError(t, cfg.Validate())
+
+	cfg.AsyncInsert.Enabled = true
+	assert.ErrorIs(t, cfg.Validate(), errConfigAsyncInsert)
+}
@@ -50,7 +50,9 @@ func (e *logsExporter) start(ctx context.Context, _ component.Host) error {
 		return err
 	}
 
-	return createLogsTable(ctx, e.cfg, e.client)
+	return e.cfg.LogsSchema.createTables(ctx, e.cfg, e.client, []string{e.cfg.LogsTableName}, logsAttributeColumns, func() error {
+		return createLogsTable(ctx, e.cfg, e.client)
+	})
 }
 
 // shutdown will shut down the exporter.
@@ -243,7 +245,7 @@ func renderCreateLogsTableSQL(cfg *Config) string {
 }
 
 func renderInsertLogsSQL(cfg *Config) string {
-	return fmt.Sprintf(insertLogsSQLTemplate, cfg.LogsTableName)
+	return cfg.LogsSchema.renderInsertSQL(fmt.Sprintf(insertLogsSQLTemplate, cfg.LogsTableName))
 }
 
 func doWithTx(_ context.Context, db *sql.DB, fn func(tx *sql.Tx) error) error {
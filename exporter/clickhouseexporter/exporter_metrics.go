@@ -8,6 +8,7 @@ import (
 	"database/sql"
 	"errors"
 	"fmt"
+	"slices"
 
 	"go.opentelemetry.io/collector/component"
 	"go.opentelemetry.io/collector/pdata/pmetric"
@@ -17,7 +18,8 @@ import (
 )
 
 type metricsExporter struct {
-	client *sql.DB
+	client    *sql.DB
+	insertSQL map[pmetric.MetricType]string
 
 	logger *zap.Logger
 	cfg    *Config
@@ -30,9 +32,10 @@ func newMetricsExporter(logger *zap.Logger, cfg *Config) (*metricsExporter, erro
 	}
 
 	return &metricsExporter{
-		client: client,
-		logger: logger,
-		cfg:    cfg,
+		client:    client,
+		insertSQL: renderInsertMetricsSQL(cfg),
+		logger:    logger,
+		cfg:       cfg,
 	}, nil
 }
 
@@ -47,8 +50,11 @@ func (e *metricsExporter) start(ctx context.Context, _ component.Host) error {
 		return err
 	}
 
-	ttlExpr := generateTTLExpr(e.cfg.TTLDays, e.cfg.TTL, "TimeUnix")
-	return internal.NewMetricsTable(ctx, e.cfg.MetricsTableName, e.cfg.ClusterString(), e.cfg.TableEngineString(), ttlExpr, e.client)
+	tables := internal.MetricsTableNames(e.cfg.MetricsTableName)
+	return e.cfg.MetricsSchema.createTables(ctx, e.cfg, e.client, tables, metricsAttributeColumns, func() error {
+		ttlExpr := generateTTLExpr(e.cfg.TTLDays, e.cfg.TTL, "TimeUnix")
+		return internal.NewMetricsTable(ctx, e.cfg.MetricsTableName, e.cfg.ClusterString(), e.cfg.TableEngineString(), ttlExpr, e.client)
+	})
 }
 
 // shutdown will shut down the exporter.
@@ -60,7 +66,7 @@ func (e *metricsExporter) shutdown(_ context.Context) error {
 }
 
 func (e *metricsExporter) pushMetricsData(ctx context.Context, md pmetric.Metrics) error {
-	metricsMap := internal.NewMetricsModel(e.cfg.MetricsTableName)
+	metricsMap := internal.NewMetricsModel(e.insertSQL)
 	for i := 0; i < md.ResourceMetrics().Len(); i++ {
 		metrics := md.ResourceMetrics().At(i)
 		resAttr := attributesToMap(metrics.Resource().Attributes())
@@ -97,3 +103,24 @@ func (e *metricsExporter) pushMetricsData(ctx context.Context, md pmetric.Metric
 	// batch insert https://clickhouse.com/docs/en/about-us/performance/#performance-when-inserting-data
 	return internal.InsertMetrics(ctx, e.client, metricsMap)
 }
+
+func renderInsertMetricsSQL(cfg *Config) map[pmetric.MetricType]string {
+	insertSQL := internal.MetricsInsertSQL(cfg.MetricsTableName)
+	for metricType, sql := range insertSQL {
+		insertSQL[metricType] = cfg.MetricsSchema.renderInsertSQL(sql)
+	}
+	return insertSQL
+}
+
+// metricsInsertColumns returns the columns of the tables of all the metric types.
+func metricsInsertColumns() []string {
+	var columns []string
+	for _, sql := range internal.MetricsInsertSQL("") {
+		for _, column := range insertColumns(sql) {
+			if !slices.Contains(columns, column) {
+				columns = append(columns, column)
+			}
+		}
+	}
+	return columns
+}
@@ -50,7 +50,9 @@ func (e *tracesExporter) start(ctx context.Context, _ component.Host) error {
 		return err
 	}
 
-	return createTracesTable(ctx, e.cfg, e.client)
+	return e.cfg.TracesSchema.createTables(ctx, e.cfg, e.client, []string{e.cfg.TracesTableName}, tracesAttributeColumns, func() error {
+		return createTracesTable(ctx, e.cfg, e.client)
+	})
 }
 
 // shutdown will shut down the exporter.
@@ -291,7 +293,7 @@ func createTracesTable(ctx context.Context, cfg *Config, db *sql.DB) error {
 }
 
 func renderInsertTracesSQL(cfg *Config) string {
-	return fmt.Sprintf(strings.ReplaceAll(insertTracesSQLTemplate, "'", "`"), cfg.TracesTableName)
+	return cfg.TracesSchema.renderInsertSQL(fmt.Sprintf(strings.ReplaceAll(insertTracesSQLTemplate, "'", "`"), cfg.TracesTableName))
 }
 
 func renderCreateTracesTableSQL(cfg *Config) string {
@@ -45,6 +45,9 @@ func createDefaultConfig() component.Config {
 		MetricsTableName: "otel_metrics",
 		TTL:              0,
 		CreateSchema:     &defaultCreateSchema,
+		AsyncInsert: AsyncInsert{
+			WaitForFlush: true,
+		},
 	}
 }
 
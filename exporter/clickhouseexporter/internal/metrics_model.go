@@ -61,23 +61,45 @@ func NewMetricsTable(ctx context.Context, tableName, cluster, engine, ttlExpr st
 	return nil
 }
 
-// NewMetricsModel create a model for contain different metric data
-func NewMetricsModel(tableName string) map[pmetric.MetricType]MetricsModel {
+// MetricsTableNames returns the names of the tables storing each type of metric.
+func MetricsTableNames(tableName string) []string {
+	return []string{
+		tableName + "_gauge",
+		tableName + "_sum",
+		tableName + "_histogram",
+		tableName + "_exponential_histogram",
+		tableName + "_summary",
+	}
+}
+
+// MetricsInsertSQL returns the SQL inserting each type of metric into its table.
+func MetricsInsertSQL(tableName string) map[pmetric.MetricType]string {
+	return map[pmetric.MetricType]string{
+		pmetric.MetricTypeGauge:                fmt.Sprintf(insertGaugeTableSQL, tableName),
+		pmetric.MetricTypeSum:                  fmt.Sprintf(insertSumTableSQL, tableName),
+		pmetric.MetricTypeHistogram:            fmt.Sprintf(insertHistogramTableSQL, tableName),
+		pmetric.MetricTypeExponentialHistogram: fmt.Sprintf(insertExpHistogramTableSQL, tableName),
+		pmetric.MetricTypeSummary:              fmt.Sprintf(insertSummaryTableSQL, tableName),
+	}
+}
+
+// NewMetricsModel create a model for contain different metric data, inserted with the SQL of each metric type
+func NewMetricsModel(insertSQL map[pmetric.MetricType]string) map[pmetric.MetricType]MetricsModel {
 	return map[pmetric.MetricType]MetricsModel{
 		pmetric.MetricTypeGauge: &gaugeMetrics{
-			insertSQL: fmt.Sprintf(insertGaugeTableSQL, tableName),
+			insertSQL: insertSQL[pmetric.MetricTypeGauge],
 		},
 		pmetric.MetricTypeSum: &sumMetrics{
-			insertSQL: fmt.Sprintf(insertSumTableSQL, tableName),
+			insertSQL: insertSQL[pmetric.MetricTypeSum],
 		},
 		pmetric.MetricTypeHistogram: &histogramMetrics{
-			insertSQL: fmt.Sprintf(insertHistogramTableSQL, tableName),
+			insertSQL: insertSQL[pmetric.MetricTypeHistogram],
 		},
 		pmetric.MetricTypeExponentialHistogram: &expHistogramMetrics{
-			insertSQL: fmt.Sprintf(insertExpHistogramTableSQL, tableName),
+			insertSQL: insertSQL[pmetric.MetricTypeExponentialHistogram],
 		},
 		pmetric.MetricTypeSummary: &summaryMetrics{
-			insertSQL: fmt.Sprintf(insertSummaryTableSQL, tableName),
+			insertSQL: insertSQL[pmetric.MetricTypeSummary],
 		},
 	}
 }
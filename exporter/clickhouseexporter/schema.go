@@ -0,0 +1,197 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package clickhouseexporter // import "github.com/open-telemetry/opentelemetry-collector-contrib/exporter/clickhouseexporter"
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"slices"
+	"sort"
+	"strings"
+)
+
+// TableSchema customizes the tables of a signal.
+type TableSchema struct {
+	// DDL replaces the statements creating the tables of the signal when create_schema is enabled.
+	// The statements are run in order.
+	DDL []string `mapstructure:"ddl"`
+	// Columns maps the default column names to the names of the columns of the tables.
+	Columns map[string]string `mapstructure:"columns"`
+	// MaterializedColumns are added to the tables of the signal when create_schema is enabled.
+	MaterializedColumns []MaterializedColumn `mapstructure:"materialized_columns"`
+}
+
+// MaterializedColumn is a column materialized from the value of an attribute.
+type MaterializedColumn struct {
+	// Name is the name of the column.
+	Name string `mapstructure:"name"`
+	// Type is the type of the column, it must accept the String value of the attribute. default is `String`.
+	Type string `mapstructure:"type"`
+	// Source is where the attribute is read from, one of `resource`, `scope` or `attributes`,
+	// the attributes of the log record, span or data point. default is `attributes`.
+	Source string `mapstructure:"source"`
+	// Attribute is the key of the attribute.
+	Attribute string `mapstructure:"attribute"`
+}
+
+const (
+	sourceResource   = "resource"
+	sourceScope      = "scope"
+	sourceAttributes = "attributes"
+
+	defaultMaterializedColumnType = "String"
+)
+
+// attributeColumns are the columns of the attribute maps of a signal tables, by materialized column source.
+type attributeColumns map[string]string
+
+var (
+	logsAttributeColumns = attributeColumns{
+		sourceResource:   "ResourceAttributes",
+		sourceScope:      "ScopeAttributes",
+		sourceAttributes: "LogAttributes",
+	}
+	tracesAttributeColumns = attributeColumns{
+		sourceResource:   "ResourceAttributes",
+		sourceAttributes: "SpanAttributes",
+	}
+	metricsAttributeColumns = attributeColumns{
+		sourceResource:   "ResourceAttributes",
+		sourceScope:      "ScopeAttributes",
+		sourceAttributes: "Attributes",
+	}
+)
+
+var (
+	errEmptyDDL                    = errors.New("ddl statements can't be empty")
+	errEmptyColumnName             = errors.New("column names can't be empty")
+	errMaterializedColumnName      = errors.New("materialized column name is required")
+	errMaterializedColumnAttribute = errors.New("materialized column attribute is required")
+)
+
+func (s *TableSchema) validate(signal string, insertColumns []string, sources attributeColumns) (err error) {
+	for _, statement := range s.DDL {
+		if strings.TrimSpace(statement) == "" {
+			err = errors.Join(err, fmt.Errorf("%s_schema: %w", signal, errEmptyDDL))
+		}
+	}
+
+	for _, column := range sortedKeys(s.Columns) {
+		if !slices.Contains(insertColumns, column) {
+			err = errors.Join(err, fmt.Errorf("%s_schema: unknown column %q", signal, column))
+		}
+		if s.Columns[column] == "" {
+			err = errors.Join(err, fmt.Errorf("%s_schema: %w: %q", signal, errEmptyColumnName, column))
+		}
+	}
+
+	for _, column := range s.MaterializedColumns {
+		if column.Name == "" {
+			err = errors.Join(err, fmt.Errorf("%s_schema: %w", signal, errMaterializedColumnName))
+		}
+		if column.Attribute == "" {
+			err = errors.Join(err, fmt.Errorf("%s_schema: %w", signal, errMaterializedColumnAttribute))
+		}
+		if column.Source != "" {
+			if _, ok := sources[column.Source]; !ok {
+				err = errors.Join(err, fmt.Errorf("%s_schema: unsupported materialized column source %q, supported: %s", signal, column.Source, strings.Join(sortedKeys(sources), ", ")))
+			}
+		}
+	}
+	return err
+}
+
+// column returns the name of the column replacing the default one.
+func (s *TableSchema) column(name string) string {
+	if mapped, ok := s.Columns[name]; ok {
+		return mapped
+	}
+	return name
+}
+
+// renderInsertSQL renames the columns of an insert statement.
+func (s *TableSchema) renderInsertSQL(insertSQL string) string {
+	if len(s.Columns) == 0 {
+		return insertSQL
+	}
+	start, end := insertColumnsBounds(insertSQL)
+	columns := insertColumns(insertSQL)
+	for i, column := range columns {
+		columns[i] = s.column(column)
+	}
+	return insertSQL[:start] + strings.Join(columns, ", ") + insertSQL[end:]
+}
+
+// renderMaterializedColumnsSQL returns the statements adding the materialized columns to the table.
+func (s *TableSchema) renderMaterializedColumnsSQL(cfg *Config, table string, sources attributeColumns) []string {
+	statements := make([]string, 0, len(s.MaterializedColumns))
+	for _, column := range s.MaterializedColumns {
+		source := column.Source
+		if source == "" {
+			source = sourceAttributes
+		}
+		columnType := column.Type
+		if columnType == "" {
+			columnType = defaultMaterializedColumnType
+		}
+		statements = append(statements, fmt.Sprintf("ALTER TABLE %s %s ADD COLUMN IF NOT EXISTS %s %s MATERIALIZED %s['%s']",
+			table, cfg.ClusterString(), column.Name, columnType, s.column(sources[source]), escapeString(column.Attribute)))
+	}
+	return statements
+}
+
+// createTables runs the custom DDL of the signal, or createDefault when there is none, then adds the
+// materialized columns to the tables.
+func (s *TableSchema) createTables(ctx context.Context, cfg *Config, db *sql.DB, tables []string, sources attributeColumns, createDefault func() error) error {
+	if len(s.DDL) == 0 {
+		if err := createDefault(); err != nil {
+			return err
+		}
+	}
+	for _, statement := range s.DDL {
+		if _, err := db.ExecContext(ctx, statement); err != nil {
+			return fmt.Errorf("exec custom ddl: %w", err)
+		}
+	}
+	for _, table := range tables {
+		for _, statement := range s.renderMaterializedColumnsSQL(cfg, table, sources) {
+			if _, err := db.ExecContext(ctx, statement); err != nil {
+				return fmt.Errorf("exec add materialized column sql: %w", err)
+			}
+		}
+	}
+	return nil
+}
+
+// insertColumnsBounds returns the bounds of the column list of an insert statement.
+func insertColumnsBounds(insertSQL string) (int, int) {
+	start := strings.Index(insertSQL, "(") + 1
+	end := start + strings.Index(insertSQL[start:], ")")
+	return start, end
+}
+
+// insertColumns returns the columns of an insert statement.
+func insertColumns(insertSQL string) []string {
+	start, end := insertColumnsBounds(insertSQL)
+	columns := strings.Split(insertSQL[start:end], ",")
+	for i, column := range columns {
+		columns[i] = strings.TrimSpace(column)
+	}
+	return columns
+}
+
+func escapeString(s string) string {
+	return strings.NewReplacer(`\`, `\\`, `'`, `\'`).Replace(s)
+}
+
+func sortedKeys[V any](m map[string]V) []string {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	return keys
+}
@@ -0,0 +1,147 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package clickhouseexporter
+
+import (
+	"database/sql/driver"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestTableSchema_validate(t *testing.T) {
+	tests := []struct {
+		name   string
+		schema TableSchema
+		errs   []string
+	}{
+		{
+			name: "valid",
+			schema: TableSchema{
+				DDL:     []string{"CREATE TABLE otel_logs (Timestamp DateTime64(9)) ENGINE = MergeTree() ORDER BY Timestamp"},
+				Columns: map[string]string{"Body": "Message", "LogAttributes": "Attributes"},
+				MaterializedColumns: []MaterializedColumn{
+					{Name: "Namespace", Source: "resource", Attribute: "k8s.namespace.name"},
+					{Name: "UserID", Attribute: "user.id"},
+				},
+			},
+		},
+		{
+			name: "invalid",
+			schema: TableSchema{
+				DDL:     []string{" "},
+				Columns: map[string]string{"Message": "Body", "Body": ""},
+				MaterializedColumns: []MaterializedColumn{
+					{Source: "span", Attribute: "user.id"},
+					{Name: "UserID"},
+				},
+			},
+			errs: []string{
+				"logs_schema: ddl statements can't be empty",
+				`logs_schema: column names can't be empty: "Body"`,
+				`logs_schema: unknown column "Message"`,
+				"logs_schema: materialized column name is required",
+				`logs_schema: unsupported materialized column source "span", supported: attributes, resource, scope`,
+				"logs_schema: materialized column attribute is required",
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.schema.validate("logs", insertColumns(insertLogsSQLTemplate), logsAttributeColumns)
+			if len(tt.errs) == 0 {
+				require.NoError(t, err)
+				return
+			}
+			require.Error(t, err)
+			for _, e := range tt.errs {
+				assert.ErrorContains(t, err, e)
+			}
+		})
+	}
+}
+
+func TestTableSchema_renderInsertSQL(t *testing.T) {
+	schema := TableSchema{Columns: map[string]string{"Body": "Message", "Attributes": "PointAttributes"}}
+
+	logsSQL := schema.renderInsertSQL(renderInsertLogsSQL(withDefaultConfig()))
+	columns := insertColumns(logsSQL)
+	assert.Len(t, columns, len(insertColumns(insertLogsSQLTemplate)))
+	assert.Contains(t, columns, "Message")
+	assert.NotContains(t, columns, "Body")
+	assert.True(t, strings.HasPrefix(logsSQL, "INSERT INTO otel_logs ("))
+	assert.Equal(t, 15, strings.Count(logsSQL, "?"))
+
+	metricsSQL := renderInsertMetricsSQL(withDefaultConfig(func(cfg *Config) {
+		cfg.MetricsSchema = schema
+	}))
+	for _, sql := range metricsSQL {
+		assert.Contains(t, insertColumns(sql), "PointAttributes")
+		assert.NotContains(t, insertColumns(sql), "Attributes")
+	}
+}
+
+func TestLogsExporter_customSchema(t *testing.T) {
+	var mu sync.Mutex
+	var queries []string
+	initClickhouseTestServer(t, func(query string, _ []driver.Value) error {
+		mu.Lock()
+		defer mu.Unlock()
+		queries = append(queries, query)
+		return nil
+	})
+
+	exporter := newTestLogsExporter(t, defaultEndpoint, func(cfg *Config) {
+		cfg.Database = defaultDatabase
+		cfg.ClusterName = "cluster_a"
+		cfg.LogsSchema = TableSchema{
+			DDL:     []string{"CREATE TABLE IF NOT EXISTS otel_logs (Timestamp DateTime64(9), Message String) ENGINE = MergeTree() ORDER BY Timestamp"},
+			Columns: map[string]string{"Body": "Message", "ResourceAttributes": "Resource"},
+			MaterializedColumns: []MaterializedColumn{
+				{Name: "Namespace", Type: "LowCardinality(String)", Source: "resource", Attribute: "k8s.namespace.name"},
+				{Name: "UserID", Attribute: "user's id"},
+			},
+		}
+	})
+	mustPushLogsData(t, exporter, simpleLogs(1))
+
+	mu.Lock()
+	defer mu.Unlock()
+	require.Len(t, queries, 4)
+	assert.Equal(t, "CREATE TABLE IF NOT EXISTS otel_logs (Timestamp DateTime64(9), Message String) ENGINE = MergeTree() ORDER BY Timestamp", queries[0])
+	assert.Equal(t, "ALTER TABLE otel_logs ON CLUSTER cluster_a ADD COLUMN IF NOT EXISTS Namespace LowCardinality(String) MATERIALIZED Resource['k8s.namespace.name']", queries[1])
+	assert.Equal(t, `ALTER TABLE otel_logs ON CLUSTER cluster_a ADD COLUMN IF NOT EXISTS UserID String MATERIALIZED LogAttributes['user\'s id']`, queries[2])
+	assert.Contains(t, insertColumns(queries[3]), "Message")
+	assert.Contains(t, insertColumns(queries[3]), "Resource")
+}
+
+func TestMetricsExporter_materializedColumns(t *testing.T) {
+	var mu sync.Mutex
+	var alters []string
+	initClickhouseTestServer(t, func(query string, _ []driver.Value) error {
+		mu.Lock()
+		defer mu.Unlock()
+		if strings.HasPrefix(query, "ALTER TABLE") {
+			alters = append(alters, query)
+		}
+		return nil
+	})
+
+	newTestMetricsExporter(t, defaultEndpoint, func(cfg *Config) {
+		cfg.MetricsSchema.MaterializedColumns = []MaterializedColumn{{Name: "Pod", Source: "resource", Attribute: "k8s.pod.name"}}
+	})
+
+	mu.Lock()
+	defer mu.Unlock()
+	assert.ElementsMatch(t, []string{
+		"ALTER TABLE otel_metrics_gauge  ADD COLUMN IF NOT EXISTS Pod String MATERIALIZED ResourceAttributes['k8s.pod.name']",
+		"ALTER TABLE otel_metrics_sum  ADD COLUMN IF NOT EXISTS Pod String MATERIALIZED ResourceAttributes['k8s.pod.name']",
+		"ALTER TABLE otel_metrics_histogram  ADD COLUMN IF NOT EXISTS Pod String MATERIALIZED ResourceAttributes['k8s.pod.name']",
+		"ALTER TABLE otel_metrics_exponential_histogram  ADD COLUMN IF NOT EXISTS Pod String MATERIALIZED ResourceAttributes['k8s.pod.name']",
+		"ALTER TABLE otel_metrics_summary  ADD COLUMN IF NOT EXISTS Pod String MATERIALIZED ResourceAttributes['k8s.pod.name']",
+	}, alters)
+}
@@ -0,0 +1,53 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package deadmansswitchexporter // import "github.com/open-telemetry/opentelemetry-collector-contrib/exporter/deadmansswitchexporter"
+
+import (
+	"errors"
+	"time"
+
+	"go.opentelemetry.io/collector/component"
+	"go.opentelemetry.io/collector/config/confighttp"
+)
+
+// Config defines configuration settings for the dead man's switch exporter.
+type Config struct {
+	// Configures the client of the heartbeats.
+	// The Endpoint is the URL the heartbeats are sent to (e.g.: https://hc-ping.com/<uuid>).
+	confighttp.ClientConfig `mapstructure:",squash"` // squash ensures fields are correctly decoded in embedded struct.
+
+	// Interval is the period of the heartbeats, the items received during each interval being checked against the
+	// thresholds before sending a heartbeat.
+	Interval time.Duration `mapstructure:"interval"`
+
+	// MinItems is the minimum number of spans, data points and log records to receive during an interval to send the
+	// heartbeat.
+	MinItems int64 `mapstructure:"min_items"`
+
+	// MaxItems is the maximum number of spans, data points and log records to receive during an interval to send the
+	// heartbeat, e.g. to be alerted of a flood of data. Zero means no maximum.
+	MaxItems int64 `mapstructure:"max_items"`
+}
+
+var _ component.Config = (*Config)(nil)
+
+// Validate checks if the exporter configuration is valid
+func (cfg *Config) Validate() error {
+	if cfg.Endpoint == "" {
+		return errors.New("endpoint required")
+	}
+	if cfg.Interval <= 0 {
+		return errors.New("interval must be positive")
+	}
+	if cfg.MinItems < 0 {
+		return errors.New("min_items must not be negative")
+	}
+	if cfg.MaxItems < 0 {
+		return errors.New("max_items must not be negative")
+	}
+	if cfg.MaxItems > 0 && cfg.MaxItems < cfg.MinItems {
+		return errors.New("max_items must be greater than or equal to min_items")
+	}
+	return nil
+}
@@ -0,0 +1,87 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package deadmansswitchexporter
+
+import (
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/collector/component"
+	"go.opentelemetry.io/collector/confmap/confmaptest"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/exporter/deadmansswitchexporter/internal/metadata"
+)
+
+func TestLoadConfig(t *testing.T) {
+	t.Parallel()
+
+	cm, err := confmaptest.LoadConf(filepath.Join("testdata", "config.yaml"))
+	require.NoError(t, err)
+
+	defaultConfig := func() *Config {
+		cfg := createDefaultConfig().(*Config)
+		cfg.Endpoint = "https://hc-ping.com/0a1b2c3d"
+		return cfg
+	}
+	customConfig := defaultConfig()
+	customConfig.Interval = 5 * time.Minute
+	customConfig.MinItems = 100
+	customConfig.MaxItems = 1000000
+	customConfig.Timeout = 5 * time.Second
+
+	tests := []struct {
+		id          component.ID
+		expected    component.Config
+		expectedErr string
+	}{
+		{
+			id:       component.NewID(metadata.Type),
+			expected: defaultConfig(),
+		},
+		{
+			id:       component.NewIDWithName(metadata.Type, "customname"),
+			expected: customConfig,
+		},
+		{
+			id:          component.NewIDWithName(metadata.Type, "no_endpoint"),
+			expectedErr: "endpoint required",
+		},
+		{
+			id:          component.NewIDWithName(metadata.Type, "invalid_interval"),
+			expectedErr: "interval must be positive",
+		},
+		{
+			id:          component.NewIDWithName(metadata.Type, "invalid_min_items"),
+			expectedErr: "min_items must not be negative",
+		},
+		{
+			id:          component.NewIDWithName(metadata.Type, "invalid_max_items"),
+			expectedErr: "max_items must not be negative",
+		},
+		{
+			id:          component.NewIDWithName(metadata.Type, "max_items_lower_than_min_items"),
+			expectedErr: "max_items must be greater than or equal to min_items",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.id.String(), func(t *testing.T) {
+			cfg := createDefaultConfig()
+
+			sub, err := cm.Sub(tt.id.String())
+			require.NoError(t, err)
+			require.NoError(t, sub.Unmarshal(cfg))
+
+			if tt.expectedErr != "" {
+				assert.EqualError(t, component.ValidateConfig(cfg), tt.expectedErr)
+				return
+			}
+			assert.NoError(t, component.ValidateConfig(cfg))
+			assert.Equal(t, tt.expected, cfg)
+		})
+	}
+}
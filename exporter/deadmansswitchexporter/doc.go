@@ -0,0 +1,9 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+// Package deadmansswitchexporter implements an exporter sending periodic heartbeats to a monitoring service, such as
+// healthchecks.io, while the data flows through its pipelines, so that the service alerts when the pipelines stop.
+
+//go:generate mdatagen metadata.yaml
+
+package deadmansswitchexporter // import "github.com/open-telemetry/opentelemetry-collector-contrib/exporter/deadmansswitchexporter"
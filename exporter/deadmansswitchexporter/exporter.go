@@ -0,0 +1,123 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package deadmansswitchexporter // import "github.com/open-telemetry/opentelemetry-collector-contrib/exporter/deadmansswitchexporter"
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"go.opentelemetry.io/collector/component"
+	"go.opentelemetry.io/collector/pdata/plog"
+	"go.opentelemetry.io/collector/pdata/pmetric"
+	"go.opentelemetry.io/collector/pdata/ptrace"
+	"go.uber.org/zap"
+)
+
+// deadMansSwitch counts the items received by the exporter, and sends a heartbeat at the end of each interval if the
+// count is within the thresholds. The monitoring service alerts when it misses the heartbeats, i.e. when the data
+// stopped flowing or the collector itself died.
+type deadMansSwitch struct {
+	config   *Config
+	settings component.TelemetrySettings
+	client   *http.Client
+
+	// items is the number of items received during the current interval
+	items atomic.Int64
+
+	cancel context.CancelFunc
+	wg     sync.WaitGroup
+}
+
+func newDeadMansSwitch(config *Config, settings component.TelemetrySettings) *deadMansSwitch {
+	return &deadMansSwitch{
+		config:   config,
+		settings: settings,
+	}
+}
+
+func (s *deadMansSwitch) Start(ctx context.Context, host component.Host) error {
+	client, err := s.config.ClientConfig.ToClient(ctx, host, s.settings)
+	if err != nil {
+		return fmt.Errorf("failed to create the HTTP client: %w", err)
+	}
+	s.client = client
+
+	ctx, s.cancel = context.WithCancel(context.Background())
+	s.wg.Add(1)
+	go func() {
+		defer s.wg.Done()
+		ticker := time.NewTicker(s.config.Interval)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+				s.check(ctx)
+			}
+		}
+	}()
+	return nil
+}
+
+func (s *deadMansSwitch) Shutdown(context.Context) error {
+	if s.cancel != nil {
+		s.cancel()
+	}
+	s.wg.Wait()
+	return nil
+}
+
+func (s *deadMansSwitch) consumeTraces(_ context.Context, td ptrace.Traces) error {
+	s.items.Add(int64(td.SpanCount()))
+	return nil
+}
+
+func (s *deadMansSwitch) consumeMetrics(_ context.Context, md pmetric.Metrics) error {
+	s.items.Add(int64(md.DataPointCount()))
+	return nil
+}
+
+func (s *deadMansSwitch) consumeLogs(_ context.Context, ld plog.Logs) error {
+	s.items.Add(int64(ld.LogRecordCount()))
+	return nil
+}
+
+// check ends the current interval, sending the heartbeat if the items received during the interval are within the
+// thresholds.
+func (s *deadMansSwitch) check(ctx context.Context) {
+	items := s.items.Swap(0)
+	if items < s.config.MinItems || (s.config.MaxItems > 0 && items > s.config.MaxItems) {
+		s.settings.Logger.Warn("Skipping the heartbeat, the items received are out of the thresholds",
+			zap.Int64("items", items),
+			zap.Int64("min_items", s.config.MinItems),
+			zap.Int64("max_items", s.config.MaxItems))
+		return
+	}
+	if err := s.sendHeartbeat(ctx); err != nil {
+		s.settings.Logger.Warn("Failed to send the heartbeat", zap.Error(err))
+	}
+}
+
+func (s *deadMansSwitch) sendHeartbeat(ctx context.Context) error {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, s.config.Endpoint, nil)
+	if err != nil {
+		return err
+	}
+	resp, err := s.client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	_, _ = io.Copy(io.Discard, resp.Body)
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		return fmt.Errorf("the heartbeat failed with status %q", resp.Status)
+	}
+	return nil
+}
@@ -0,0 +1,181 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package deadmansswitchexporter
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/collector/component/componenttest"
+	"go.opentelemetry.io/collector/exporter/exportertest"
+	"go.opentelemetry.io/collector/pdata/plog"
+	"go.opentelemetry.io/collector/pdata/pmetric"
+	"go.opentelemetry.io/collector/pdata/ptrace"
+	"go.uber.org/zap"
+	"go.uber.org/zap/zaptest/observer"
+)
+
+func TestCheck(t *testing.T) {
+	tests := []struct {
+		name              string
+		minItems          int64
+		maxItems          int64
+		items             int
+		expectedHeartbeat bool
+	}{
+		{
+			name:              "within_thresholds",
+			minItems:          2,
+			maxItems:          4,
+			items:             3,
+			expectedHeartbeat: true,
+		},
+		{
+			name:              "no_maximum",
+			minItems:          1,
+			items:             10,
+			expectedHeartbeat: true,
+		},
+		{
+			name:              "no_minimum",
+			items:             0,
+			expectedHeartbeat: true,
+		},
+		{
+			name:     "below_minimum",
+			minItems: 4,
+			items:    3,
+		},
+		{
+			name:     "above_maximum",
+			minItems: 1,
+			maxItems: 2,
+			items:    3,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var heartbeats atomic.Int64
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				assert.Equal(t, http.MethodGet, r.Method)
+				assert.Equal(t, "/ping/0a1b2c3d", r.URL.Path)
+				heartbeats.Add(1)
+				w.WriteHeader(http.StatusOK)
+			}))
+			defer server.Close()
+
+			s := newTestSwitch(t, server.URL+"/ping/0a1b2c3d", tt.minItems, tt.maxItems)
+			for i := 0; i < tt.items; i++ {
+				require.NoError(t, s.consumeLogs(context.Background(), newLogs(1)))
+			}
+			s.check(context.Background())
+			if tt.expectedHeartbeat {
+				assert.Equal(t, int64(1), heartbeats.Load())
+			} else {
+				assert.Zero(t, heartbeats.Load())
+			}
+
+			// the items are counted per interval
+			assert.Zero(t, s.items.Load())
+			require.NoError(t, s.Shutdown(context.Background()))
+		})
+	}
+}
+
+func TestCountItems(t *testing.T) {
+	s := newDeadMansSwitch(createDefaultConfig().(*Config), componenttest.NewNopTelemetrySettings())
+
+	td := ptrace.NewTraces()
+	spans := td.ResourceSpans().AppendEmpty().ScopeSpans().AppendEmpty().Spans()
+	spans.AppendEmpty()
+	spans.AppendEmpty()
+	require.NoError(t, s.consumeTraces(context.Background(), td))
+
+	md := pmetric.NewMetrics()
+	md.ResourceMetrics().AppendEmpty().ScopeMetrics().AppendEmpty().Metrics().AppendEmpty().SetEmptyGauge().DataPoints().AppendEmpty()
+	require.NoError(t, s.consumeMetrics(context.Background(), md))
+
+	require.NoError(t, s.consumeLogs(context.Background(), newLogs(3)))
+
+	assert.Equal(t, int64(6), s.items.Load())
+}
+
+func TestHeartbeatFailure(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		w.WriteHeader(http.StatusNotFound)
+	}))
+	defer server.Close()
+
+	s := newTestSwitch(t, server.URL, 0, 0)
+	core, logs := observer.New(zap.WarnLevel)
+	s.settings.Logger = zap.New(core)
+
+	s.check(context.Background())
+	require.Equal(t, 1, logs.Len())
+	assert.Equal(t, "Failed to send the heartbeat", logs.All()[0].Message)
+	assert.EqualError(t, s.sendHeartbeat(context.Background()), `the heartbeat failed with status "404 Not Found"`)
+	require.NoError(t, s.Shutdown(context.Background()))
+}
+
+func TestSharedSwitch(t *testing.T) {
+	var heartbeats atomic.Int64
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		heartbeats.Add(1)
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer server.Close()
+
+	cfg := createDefaultConfig().(*Config)
+	cfg.Endpoint = server.URL
+	cfg.Interval = 10 * time.Millisecond
+	cfg.MinItems = 2
+	set := exportertest.NewNopCreateSettings()
+	factory := NewFactory()
+
+	traces, err := factory.CreateTracesExporter(context.Background(), set, cfg)
+	require.NoError(t, err)
+	logs, err := factory.CreateLogsExporter(context.Background(), set, cfg)
+	require.NoError(t, err)
+	require.NoError(t, traces.Start(context.Background(), componenttest.NewNopHost()))
+	require.NoError(t, logs.Start(context.Background(), componenttest.NewNopHost()))
+
+	// the items of both pipelines are counted together, reaching the minimum
+	td := ptrace.NewTraces()
+	td.ResourceSpans().AppendEmpty().ScopeSpans().AppendEmpty().Spans().AppendEmpty()
+	require.NoError(t, traces.ConsumeTraces(context.Background(), td))
+	require.NoError(t, logs.ConsumeLogs(context.Background(), newLogs(1)))
+	assert.Eventually(t, func() bool {
+		return heartbeats.Load() > 0
+	}, 5*time.Second, 10*time.Millisecond)
+
+	require.NoError(t, traces.Shutdown(context.Background()))
+	require.NoError(t, logs.Shutdown(context.Background()))
+}
+
+func newTestSwitch(t *testing.T, endpoint string, minItems, maxItems int64) *deadMansSwitch {
+	cfg := createDefaultConfig().(*Config)
+	cfg.Endpoint = endpoint
+	cfg.Interval = time.Hour
+	cfg.MinItems = minItems
+	cfg.MaxItems = maxItems
+	require.NoError(t, cfg.Validate())
+	s := newDeadMansSwitch(cfg, componenttest.NewNopTelemetrySettings())
+	require.NoError(t, s.Start(context.Background(), componenttest.NewNopHost()))
+	return s
+}
+
+func newLogs(count int) plog.Logs {
+	ld := plog.NewLogs()
+	records := ld.ResourceLogs().AppendEmpty().ScopeLogs().AppendEmpty().LogRecords()
+	for i := 0; i < count; i++ {
+		records.AppendEmpty()
+	}
+	return ld
+}
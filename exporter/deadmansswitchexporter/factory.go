@@ -0,0 +1,105 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package deadmansswitchexporter // import "github.com/open-telemetry/opentelemetry-collector-contrib/exporter/deadmansswitchexporter"
+
+import (
+	"context"
+	"time"
+
+	"go.opentelemetry.io/collector/component"
+	"go.opentelemetry.io/collector/config/confighttp"
+	"go.opentelemetry.io/collector/consumer"
+	"go.opentelemetry.io/collector/exporter"
+	"go.opentelemetry.io/collector/exporter/exporterhelper"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/exporter/deadmansswitchexporter/internal/metadata"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/internal/sharedcomponent"
+)
+
+const (
+	defaultInterval = time.Minute
+	defaultTimeout  = 10 * time.Second
+)
+
+// NewFactory creates a factory for the dead man's switch exporter.
+func NewFactory() exporter.Factory {
+	return exporter.NewFactory(
+		metadata.Type,
+		createDefaultConfig,
+		exporter.WithTraces(createTracesExporter, metadata.TracesStability),
+		exporter.WithMetrics(createMetricsExporter, metadata.MetricsStability),
+		exporter.WithLogs(createLogsExporter, metadata.LogsStability))
+}
+
+func createDefaultConfig() component.Config {
+	clientConfig := confighttp.NewDefaultClientConfig()
+	clientConfig.Timeout = defaultTimeout
+	return &Config{
+		ClientConfig: clientConfig,
+		Interval:     defaultInterval,
+		MinItems:     1,
+	}
+}
+
+func createTracesExporter(
+	ctx context.Context,
+	set exporter.CreateSettings,
+	cfg component.Config,
+) (exporter.Traces, error) {
+	e := getOrCreateSwitch(cfg, set)
+	return exporterhelper.NewTracesExporter(
+		ctx,
+		set,
+		cfg,
+		e.Unwrap().(*deadMansSwitch).consumeTraces,
+		exporterhelper.WithStart(e.Start),
+		exporterhelper.WithShutdown(e.Shutdown),
+		exporterhelper.WithCapabilities(consumer.Capabilities{MutatesData: false}),
+	)
+}
+
+func createMetricsExporter(
+	ctx context.Context,
+	set exporter.CreateSettings,
+	cfg component.Config,
+) (exporter.Metrics, error) {
+	e := getOrCreateSwitch(cfg, set)
+	return exporterhelper.NewMetricsExporter(
+		ctx,
+		set,
+		cfg,
+		e.Unwrap().(*deadMansSwitch).consumeMetrics,
+		exporterhelper.WithStart(e.Start),
+		exporterhelper.WithShutdown(e.Shutdown),
+		exporterhelper.WithCapabilities(consumer.Capabilities{MutatesData: false}),
+	)
+}
+
+func createLogsExporter(
+	ctx context.Context,
+	set exporter.CreateSettings,
+	cfg component.Config,
+) (exporter.Logs, error) {
+	e := getOrCreateSwitch(cfg, set)
+	return exporterhelper.NewLogsExporter(
+		ctx,
+		set,
+		cfg,
+		e.Unwrap().(*deadMansSwitch).consumeLogs,
+		exporterhelper.WithStart(e.Start),
+		exporterhelper.WithShutdown(e.Shutdown),
+		exporterhelper.WithCapabilities(consumer.Capabilities{MutatesData: false}),
+	)
+}
+
+// getOrCreateSwitch returns the switch of the configuration, creating it on its first call, so that the items of all
+// the pipelines of the exporter are counted together and a single heartbeat is sent per interval.
+func getOrCreateSwitch(cfg component.Config, set exporter.CreateSettings) *sharedcomponent.SharedComponent {
+	return switches.GetOrAdd(cfg, func() component.Component {
+		return newDeadMansSwitch(cfg.(*Config), set.TelemetrySettings)
+	})
+}
+
+// switches are the switches of the configurations, shared by the pipelines of the exporter.
+var switches = sharedcomponent.NewSharedComponents()
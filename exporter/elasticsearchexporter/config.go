@@ -52,6 +52,11 @@ type Config struct {
 	// fall back to pure TracesIndex, if 'elasticsearch.index.prefix' or 'elasticsearch.index.suffix' are not found in resource or attribute (prio: resource > attribute)
 	TracesDynamicIndex DynamicIndexSetting `mapstructure:"traces_dynamic_index"`
 
+	// LogsDataStream routes logs to data streams named after their attributes, replacing LogsIndex.
+	LogsDataStream DataStreamSettings `mapstructure:"logs_data_stream"`
+	// TracesDataStream routes spans to data streams named after their attributes, replacing TracesIndex.
+	TracesDataStream DataStreamSettings `mapstructure:"traces_data_stream"`
+
 	// Pipeline configures the ingest node pipeline name that should be used to process the
 	// events.
 	//
@@ -258,6 +263,29 @@ func (cfg *Config) Validate() error {
 		return fmt.Errorf("unknown mapping mode %q", cfg.Mapping.Mode)
 	}
 
+	if err := cfg.validateDataStream("logs_data_stream", &cfg.LogsDataStream, cfg.LogsDynamicIndex); err != nil {
+		return err
+	}
+	if err := cfg.validateDataStream("traces_data_stream", &cfg.TracesDataStream, cfg.TracesDynamicIndex); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (cfg *Config) validateDataStream(name string, dataStream *DataStreamSettings, dynamicIndex DynamicIndexSetting) error {
+	if !dataStream.Enabled {
+		return nil
+	}
+	if dynamicIndex.Enabled {
+		return fmt.Errorf("%s: %w", name, errDataStreamDynamicIndex)
+	}
+	if cfg.LogstashFormat.Enabled {
+		return fmt.Errorf("%s: %w", name, errDataStreamLogstashFormat)
+	}
+	if err := dataStream.validate(); err != nil {
+		return fmt.Errorf("%s: %w", name, err)
+	}
 	return nil
 }
 
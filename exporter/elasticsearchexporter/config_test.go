@@ -55,7 +55,13 @@ func TestConfig(t *testing.T) {
 				Index:       "",
 				LogsIndex:   "logs-generic-default",
 				TracesIndex: "trace_index",
-				Pipeline:    "mypipeline",
+				LogsDataStream: DataStreamSettings{
+					Name: defaultLogsDataStreamName,
+				},
+				TracesDataStream: DataStreamSettings{
+					Name: defaultTracesDataStreamName,
+				},
+				Pipeline: "mypipeline",
 				ClientConfig: ClientConfig{
 					Authentication: AuthenticationSettings{
 						User:     "elastic",
@@ -105,7 +111,13 @@ func TestConfig(t *testing.T) {
 				Index:       "",
 				LogsIndex:   "my_log_index",
 				TracesIndex: "traces-generic-default",
-				Pipeline:    "mypipeline",
+				LogsDataStream: DataStreamSettings{
+					Name: defaultLogsDataStreamName,
+				},
+				TracesDataStream: DataStreamSettings{
+					Name: defaultTracesDataStreamName,
+				},
+				Pipeline: "mypipeline",
 				ClientConfig: ClientConfig{
 					Authentication: AuthenticationSettings{
 						User:     "elastic",
@@ -167,6 +179,20 @@ func TestConfig(t *testing.T) {
 				cfg.Index = "my_log_index"
 			}),
 		},
+		{
+			id:         component.NewIDWithName(metadata.Type, "data_stream"),
+			configFile: "config.yaml",
+			expected: withDefaultConfig(func(cfg *Config) {
+				cfg.Endpoints = []string{"https://elastic.example.com:9200"}
+				cfg.LogsDataStream = DataStreamSettings{
+					Enabled:   true,
+					Name:      "logs-{service.name}-{deployment.environment}",
+					Fallbacks: map[string]string{"service.name": "generic"},
+					Pipelines: map[string]string{"logs-nginx-default": "nginx"},
+				}
+				cfg.TracesDataStream.Enabled = true
+			}),
+		},
 	}
 
 	for _, tt := range tests {
@@ -237,6 +263,38 @@ func TestConfig_Validate(t *testing.T) {
 			}),
 			err: `unknown mapping mode "invalid"`,
 		},
+		"invalid data stream name": {
+			config: withDefaultConfig(func(cfg *Config) {
+				cfg.Endpoints = []string{"http://test:9200"}
+				cfg.LogsDataStream.Enabled = true
+				cfg.LogsDataStream.Name = "logs-{service.name"
+			}),
+			err: `logs_data_stream: invalid data stream name "logs-{service.name": unclosed placeholder`,
+		},
+		"data stream with dynamic index": {
+			config: withDefaultConfig(func(cfg *Config) {
+				cfg.Endpoints = []string{"http://test:9200"}
+				cfg.TracesDataStream.Enabled = true
+				cfg.TracesDynamicIndex.Enabled = true
+			}),
+			err: "traces_data_stream: data stream routing can't be used with dynamic index",
+		},
+		"data stream with logstash format": {
+			config: withDefaultConfig(func(cfg *Config) {
+				cfg.Endpoints = []string{"http://test:9200"}
+				cfg.LogsDataStream.Enabled = true
+				cfg.LogstashFormat.Enabled = true
+			}),
+			err: "logs_data_stream: data stream routing can't be used with logstash_format",
+		},
+		"empty data stream pipeline": {
+			config: withDefaultConfig(func(cfg *Config) {
+				cfg.Endpoints = []string{"http://test:9200"}
+				cfg.LogsDataStream.Enabled = true
+				cfg.LogsDataStream.Pipelines = map[string]string{"logs-nginx-default": ""}
+			}),
+			err: "logs_data_stream: data stream pipelines must not be empty",
+		},
 		"invalid scheme": {
 			config: withDefaultConfig(func(cfg *Config) {
 				cfg.Endpoints = []string{"without_scheme"}
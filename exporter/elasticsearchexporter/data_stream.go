@@ -0,0 +1,163 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package elasticsearchexporter // import "github.com/open-telemetry/opentelemetry-collector-contrib/exporter/elasticsearchexporter"
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"unicode/utf8"
+)
+
+const (
+	defaultLogsDataStreamName   = "logs-{service.name}-{deployment.environment}"
+	defaultTracesDataStreamName = "traces-{service.name}-{deployment.environment}"
+
+	// defaultDataStreamValue replaces the placeholders without attribute value nor fallback.
+	defaultDataStreamValue = "default"
+
+	// maxDataStreamNameBytes is the maximum length of an Elasticsearch index or data stream name.
+	maxDataStreamNameBytes = 255
+)
+
+var (
+	errDataStreamEmptyName       = errors.New("data stream name must not be empty")
+	errDataStreamEmptyPipeline   = errors.New("data stream pipelines must not be empty")
+	errDataStreamDynamicIndex    = errors.New("data stream routing can't be used with dynamic index")
+	errDataStreamLogstashFormat  = errors.New("data stream routing can't be used with logstash_format")
+	errDataStreamUnclosedBrace   = errors.New("unclosed placeholder")
+	errDataStreamEmptyAttribute  = errors.New("empty placeholder")
+	errDataStreamUnexpectedBrace = errors.New("unexpected '}'")
+)
+
+// DataStreamSettings routes documents to data streams named after their attributes.
+type DataStreamSettings struct {
+	Enabled bool `mapstructure:"enabled"`
+
+	// Name is the template of the data stream name. The `{attribute}` placeholders are replaced by
+	// the sanitized value of the attribute, looked up in the resource, scope and record attributes.
+	Name string `mapstructure:"name"`
+
+	// Fallbacks are the values of the placeholders whose attribute is missing, by attribute name.
+	// Placeholders without attribute nor fallback are replaced by `default`.
+	Fallbacks map[string]string `mapstructure:"fallbacks"`
+
+	// Pipelines maps data stream names to the ingest pipeline processing their documents, instead
+	// of `pipeline`.
+	Pipelines map[string]string `mapstructure:"pipelines"`
+}
+
+func (s *DataStreamSettings) validate() error {
+	if !s.Enabled {
+		return nil
+	}
+	if _, err := parseDataStreamName(s.Name); err != nil {
+		return err
+	}
+	for name, pipeline := range s.Pipelines {
+		if name == "" || pipeline == "" {
+			return errDataStreamEmptyPipeline
+		}
+	}
+	return nil
+}
+
+// dataStreamSegment is either a literal part of a data stream name or an attribute placeholder.
+type dataStreamSegment struct {
+	literal   string
+	attribute string
+}
+
+// dataStreamRouter resolves the data stream of the documents.
+type dataStreamRouter struct {
+	segments  []dataStreamSegment
+	fallbacks map[string]string
+}
+
+func newDataStreamRouter(s DataStreamSettings) (*dataStreamRouter, error) {
+	segments, err := parseDataStreamName(s.Name)
+	if err != nil {
+		return nil, err
+	}
+	return &dataStreamRouter{
+		segments:  segments,
+		fallbacks: s.Fallbacks,
+	}, nil
+}
+
+func parseDataStreamName(name string) ([]dataStreamSegment, error) {
+	if name == "" {
+		return nil, errDataStreamEmptyName
+	}
+
+	var segments []dataStreamSegment
+	for rest := name; rest != ""; {
+		open := strings.IndexAny(rest, "{}")
+		if open < 0 {
+			segments = append(segments, dataStreamSegment{literal: rest})
+			break
+		}
+		if rest[open] == '}' {
+			return nil, fmt.Errorf("invalid data stream name %q: %w", name, errDataStreamUnexpectedBrace)
+		}
+		if open > 0 {
+			segments = append(segments, dataStreamSegment{literal: rest[:open]})
+		}
+
+		closing := strings.IndexByte(rest[open:], '}')
+		if closing < 0 {
+			return nil, fmt.Errorf("invalid data stream name %q: %w", name, errDataStreamUnclosedBrace)
+		}
+		attribute := strings.TrimSpace(rest[open+1 : open+closing])
+		if attribute == "" {
+			return nil, fmt.Errorf("invalid data stream name %q: %w", name, errDataStreamEmptyAttribute)
+		}
+		segments = append(segments, dataStreamSegment{attribute: attribute})
+		rest = rest[open+closing+1:]
+	}
+	return segments, nil
+}
+
+// route returns the data stream of the record.
+func (r *dataStreamRouter) route(resource, scope, record attrGetter) string {
+	var sb strings.Builder
+	for _, segment := range r.segments {
+		if segment.attribute == "" {
+			sb.WriteString(segment.literal)
+			continue
+		}
+
+		value := sanitizeDataStreamValue(getFromAttributes(segment.attribute, resource, scope, record))
+		if value == "" {
+			value = sanitizeDataStreamValue(r.fallbacks[segment.attribute])
+		}
+		if value == "" {
+			value = defaultDataStreamValue
+		}
+		sb.WriteString(value)
+	}
+
+	name := strings.TrimLeft(strings.ToLower(sb.String()), "-_+")
+	if len(name) > maxDataStreamNameBytes {
+		end := maxDataStreamNameBytes
+		for end > 0 && !utf8.RuneStart(name[end]) {
+			end--
+		}
+		name = name[:end]
+	}
+	return name
+}
+
+// sanitizeDataStreamValue lowercases the value and replaces the characters Elasticsearch doesn't
+// accept in data stream names, as well as the `-` separating the parts of the name, with `_`.
+func sanitizeDataStreamValue(value string) string {
+	value = strings.ToLower(strings.TrimSpace(value))
+	return strings.Map(func(r rune) rune {
+		switch r {
+		case '\\', '/', '*', '?', '"', '<', '>', '|', ' ', ',', '#', ':', '-':
+			return '_'
+		}
+		return r
+	}, value)
+}
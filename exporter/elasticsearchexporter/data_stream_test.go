@@ -0,0 +1,133 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package elasticsearchexporter
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/collector/pdata/pcommon"
+	"go.opentelemetry.io/collector/pdata/plog"
+)
+
+func TestParseDataStreamName(t *testing.T) {
+	tests := map[string]struct {
+		name     string
+		expected []dataStreamSegment
+		err      error
+	}{
+		"literal": {
+			name:     "logs-generic-default",
+			expected: []dataStreamSegment{{literal: "logs-generic-default"}},
+		},
+		"placeholders": {
+			name: "logs-{service.name}-{ deployment.environment }",
+			expected: []dataStreamSegment{
+				{literal: "logs-"},
+				{attribute: "service.name"},
+				{literal: "-"},
+				{attribute: "deployment.environment"},
+			},
+		},
+		"empty": {
+			err: errDataStreamEmptyName,
+		},
+		"unclosed placeholder": {
+			name: "logs-{service.name",
+			err:  errDataStreamUnclosedBrace,
+		},
+		"empty placeholder": {
+			name: "logs-{}",
+			err:  errDataStreamEmptyAttribute,
+		},
+		"unexpected brace": {
+			name: "logs-}",
+			err:  errDataStreamUnexpectedBrace,
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			segments, err := parseDataStreamName(tt.name)
+			if tt.err != nil {
+				assert.ErrorIs(t, err, tt.err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.expected, segments)
+		})
+	}
+}
+
+func TestDataStreamRouterRoute(t *testing.T) {
+	tests := map[string]struct {
+		settings  DataStreamSettings
+		resource  map[string]string
+		scope     map[string]string
+		record    map[string]string
+		expected  string
+		maxLength bool
+	}{
+		"resource attributes": {
+			settings: DataStreamSettings{Name: defaultLogsDataStreamName},
+			resource: map[string]string{"service.name": "checkout", "deployment.environment": "prod"},
+			expected: "logs-checkout-prod",
+		},
+		"resource takes precedence": {
+			settings: DataStreamSettings{Name: defaultLogsDataStreamName},
+			resource: map[string]string{"service.name": "checkout"},
+			scope:    map[string]string{"service.name": "scope", "deployment.environment": "staging"},
+			record:   map[string]string{"service.name": "record", "deployment.environment": "prod"},
+			expected: "logs-checkout-staging",
+		},
+		"sanitized values": {
+			settings: DataStreamSettings{Name: defaultLogsDataStreamName},
+			resource: map[string]string{"service.name": "My-Service/Api v2", "deployment.environment": "EU:West#1"},
+			expected: "logs-my_service_api_v2-eu_west_1",
+		},
+		"fallbacks": {
+			settings: DataStreamSettings{
+				Name:      defaultLogsDataStreamName,
+				Fallbacks: map[string]string{"service.name": "Generic"},
+			},
+			record:   map[string]string{"service.name": "  "},
+			expected: "logs-generic-default",
+		},
+		"leading separators are trimmed": {
+			settings: DataStreamSettings{Name: "{service.name}-logs"},
+			resource: map[string]string{"service.name": "_internal"},
+			expected: "internal-logs",
+		},
+		"truncated": {
+			settings:  DataStreamSettings{Name: "logs-{service.name}"},
+			resource:  map[string]string{"service.name": strings.Repeat("é", 200)},
+			maxLength: true,
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			router, err := newDataStreamRouter(tt.settings)
+			require.NoError(t, err)
+
+			resource := pcommon.NewResource()
+			fillResourceAttributeMap(resource.Attributes(), tt.resource)
+			scope := pcommon.NewInstrumentationScope()
+			fillResourceAttributeMap(scope.Attributes(), tt.scope)
+			record := plog.NewLogRecord()
+			fillResourceAttributeMap(record.Attributes(), tt.record)
+
+			dataStream := router.route(resource, scope, record)
+			if tt.maxLength {
+				assert.LessOrEqual(t, len(dataStream), maxDataStreamNameBytes)
+				assert.True(t, strings.HasPrefix(dataStream, "logs-é"))
+				assert.True(t, strings.HasSuffix(dataStream, "é"))
+				return
+			}
+			assert.Equal(t, tt.expected, dataStream)
+		})
+	}
+}
@@ -21,13 +21,16 @@ type elasticsearchExporter struct {
 	index          string
 	logstashFormat LogstashFormatSettings
 	dynamicIndex   bool
+	dataStream     *dataStreamRouter
 
 	client      *esClientCurrent
 	bulkIndexer *esBulkIndexerCurrent
-	model       mappingModel
+	// pipelineBulkIndexers are the bulk indexers of the data streams with their own pipeline.
+	pipelineBulkIndexers map[string]*esBulkIndexerCurrent
+	model                mappingModel
 }
 
-func newExporter(logger *zap.Logger, cfg *Config, index string, dynamicIndex bool) (*elasticsearchExporter, error) {
+func newExporter(logger *zap.Logger, cfg *Config, index string, dynamicIndex bool, dataStream DataStreamSettings) (*elasticsearchExporter, error) {
 	if err := cfg.Validate(); err != nil {
 		return nil, err
 	}
@@ -48,7 +51,7 @@ func newExporter(logger *zap.Logger, cfg *Config, index string, dynamicIndex boo
 		mode:  cfg.MappingMode(),
 	}
 
-	return &elasticsearchExporter{
+	exp := &elasticsearchExporter{
 		logger:      logger,
 		client:      client,
 		bulkIndexer: bulkIndexer,
@@ -57,11 +60,59 @@ func newExporter(logger *zap.Logger, cfg *Config, index string, dynamicIndex boo
 		dynamicIndex:   dynamicIndex,
 		model:          model,
 		logstashFormat: cfg.LogstashFormat,
-	}, nil
+	}
+
+	if dataStream.Enabled {
+		if exp.dataStream, err = newDataStreamRouter(dataStream); err != nil {
+			return nil, err
+		}
+		if err = exp.createPipelineBulkIndexers(cfg, dataStream.Pipelines); err != nil {
+			_ = exp.Shutdown(context.Background())
+			return nil, err
+		}
+	}
+
+	return exp, nil
+}
+
+// createPipelineBulkIndexers creates a bulk indexer for each pipeline of the data streams.
+func (e *elasticsearchExporter) createPipelineBulkIndexers(cfg *Config, pipelines map[string]string) error {
+	bulkIndexers := map[string]*esBulkIndexerCurrent{}
+	e.pipelineBulkIndexers = map[string]*esBulkIndexerCurrent{}
+	for dataStream, pipeline := range pipelines {
+		bulkIndexer, ok := bulkIndexers[pipeline]
+		if !ok {
+			pipelineCfg := *cfg
+			pipelineCfg.Pipeline = pipeline
+			var err error
+			if bulkIndexer, err = newBulkIndexer(e.logger, e.client, &pipelineCfg); err != nil {
+				return err
+			}
+			bulkIndexers[pipeline] = bulkIndexer
+		}
+		e.pipelineBulkIndexers[dataStream] = bulkIndexer
+	}
+	return nil
 }
 
 func (e *elasticsearchExporter) Shutdown(ctx context.Context) error {
-	return e.bulkIndexer.Close(ctx)
+	errs := []error{e.bulkIndexer.Close(ctx)}
+	closed := map[*esBulkIndexerCurrent]bool{}
+	for _, bulkIndexer := range e.pipelineBulkIndexers {
+		if !closed[bulkIndexer] {
+			closed[bulkIndexer] = true
+			errs = append(errs, bulkIndexer.Close(ctx))
+		}
+	}
+	return errors.Join(errs...)
+}
+
+// bulkIndexerFor returns the bulk indexer of the documents of the index.
+func (e *elasticsearchExporter) bulkIndexerFor(index string) *esBulkIndexerCurrent {
+	if bulkIndexer, ok := e.pipelineBulkIndexers[index]; ok {
+		return bulkIndexer
+	}
+	return e.bulkIndexer
 }
 
 func (e *elasticsearchExporter) pushLogsData(ctx context.Context, ld plog.Logs) error {
@@ -93,7 +144,9 @@ func (e *elasticsearchExporter) pushLogsData(ctx context.Context, ld plog.Logs)
 
 func (e *elasticsearchExporter) pushLogRecord(ctx context.Context, resource pcommon.Resource, record plog.LogRecord, scope pcommon.InstrumentationScope) error {
 	fIndex := e.index
-	if e.dynamicIndex {
+	if e.dataStream != nil {
+		fIndex = e.dataStream.route(resource, scope, record)
+	} else if e.dynamicIndex {
 		prefix := getFromAttributes(indexPrefix, resource, scope, record)
 		suffix := getFromAttributes(indexSuffix, resource, scope, record)
 
@@ -112,7 +165,7 @@ func (e *elasticsearchExporter) pushLogRecord(ctx context.Context, resource pcom
 	if err != nil {
 		return fmt.Errorf("Failed to encode log event: %w", err)
 	}
-	return pushDocuments(ctx, fIndex, document, e.bulkIndexer)
+	return pushDocuments(ctx, fIndex, document, e.bulkIndexerFor(fIndex))
 }
 
 func (e *elasticsearchExporter) pushTraceData(
@@ -146,7 +199,9 @@ func (e *elasticsearchExporter) pushTraceData(
 
 func (e *elasticsearchExporter) pushTraceRecord(ctx context.Context, resource pcommon.Resource, span ptrace.Span, scope pcommon.InstrumentationScope) error {
 	fIndex := e.index
-	if e.dynamicIndex {
+	if e.dataStream != nil {
+		fIndex = e.dataStream.route(resource, scope, span)
+	} else if e.dynamicIndex {
 		prefix := getFromAttributes(indexPrefix, resource, scope, span)
 		suffix := getFromAttributes(indexSuffix, resource, scope, span)
 
@@ -165,5 +220,5 @@ func (e *elasticsearchExporter) pushTraceRecord(ctx context.Context, resource pc
 	if err != nil {
 		return fmt.Errorf("Failed to encode trace record: %w", err)
 	}
-	return pushDocuments(ctx, fIndex, document, e.bulkIndexer)
+	return pushDocuments(ctx, fIndex, document, e.bulkIndexerFor(fIndex))
 }
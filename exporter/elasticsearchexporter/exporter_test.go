@@ -215,6 +215,88 @@ func TestExporterLogs(t *testing.T) {
 		rec.WaitItems(1)
 	})
 
+	t.Run("publish with data stream routing", func(t *testing.T) {
+		rec := newBulkRecorder()
+		server := newESTestServer(t, func(docs []itemRequest) ([]itemResponse, error) {
+			rec.Record(docs)
+			return itemsAllOK(docs)
+		})
+
+		exporter := newTestLogsExporter(t, server.URL, func(cfg *Config) {
+			cfg.LogsDataStream.Enabled = true
+			cfg.LogsDataStream.Fallbacks = map[string]string{"deployment.environment": "dev"}
+		})
+		logs := newLogsWithAttributeAndResourceMap(
+			map[string]string{"deployment.environment": "Prod"},
+			map[string]string{"service.name": "my-service"},
+		)
+		fallback := newLogsWithAttributeAndResourceMap(nil, nil)
+		fallback.ResourceLogs().MoveAndAppendTo(logs.ResourceLogs())
+		mustSendLogs(t, exporter, logs)
+
+		rec.WaitItems(2)
+		var indices []string
+		for _, item := range rec.Items() {
+			var action struct {
+				Create struct {
+					Index string `json:"_index"`
+				} `json:"create"`
+			}
+			require.NoError(t, json.Unmarshal(item.Action, &action))
+			indices = append(indices, action.Create.Index)
+		}
+		assert.ElementsMatch(t, []string{"logs-my_service-prod", "logs-default-dev"}, indices)
+	})
+
+	t.Run("publish with data stream pipelines", func(t *testing.T) {
+		var mu sync.Mutex
+		pipelines := map[string]string{}
+		server := newESTestServerBulkHandlerFunc(t, func(w http.ResponseWriter, r *http.Request) {
+			pipeline := r.URL.Query().Get("pipeline")
+			var items []itemResponse
+			dec := json.NewDecoder(r.Body)
+			for dec.More() {
+				var action struct {
+					Create struct {
+						Index string `json:"_index"`
+					} `json:"create"`
+				}
+				var doc json.RawMessage
+				assert.NoError(t, dec.Decode(&action))
+				assert.NoError(t, dec.Decode(&doc))
+
+				mu.Lock()
+				pipelines[action.Create.Index] = pipeline
+				mu.Unlock()
+				items = append(items, itemResponse{Status: http.StatusOK})
+			}
+			w.Header().Set("Content-Type", "application/json")
+			assert.NoError(t, json.NewEncoder(w).Encode(bulkResult{Items: items}))
+		})
+
+		exporter := newTestLogsExporter(t, server.URL, func(cfg *Config) {
+			cfg.Pipeline = "default-pipeline"
+			cfg.LogsDataStream.Enabled = true
+			cfg.LogsDataStream.Name = "logs-{service.name}-default"
+			cfg.LogsDataStream.Pipelines = map[string]string{"logs-nginx-default": "nginx-pipeline"}
+		})
+		for _, service := range []string{"nginx", "app"} {
+			mustSendLogs(t, exporter, newLogsWithAttributeAndResourceMap(nil, map[string]string{"service.name": service}))
+		}
+
+		assert.Eventually(t, func() bool {
+			mu.Lock()
+			defer mu.Unlock()
+			return len(pipelines) == 2
+		}, time.Second, 10*time.Millisecond)
+		mu.Lock()
+		defer mu.Unlock()
+		assert.Equal(t, map[string]string{
+			"logs-nginx-default": "nginx-pipeline",
+			"logs-app-default":   "default-pipeline",
+		}, pipelines)
+	})
+
 	t.Run("publish with logstash index format enabled and dynamic index disabled", func(t *testing.T) {
 		rec := newBulkRecorder()
 		server := newESTestServer(t, func(docs []itemRequest) ([]itemResponse, error) {
@@ -517,6 +599,34 @@ func TestExporterTraces(t *testing.T) {
 		rec.WaitItems(1)
 	})
 
+	t.Run("publish with data stream routing", func(t *testing.T) {
+		rec := newBulkRecorder()
+		server := newESTestServer(t, func(docs []itemRequest) ([]itemResponse, error) {
+			rec.Record(docs)
+
+			var action struct {
+				Create struct {
+					Index string `json:"_index"`
+				} `json:"create"`
+			}
+			assert.NoError(t, json.Unmarshal(docs[0].Action, &action))
+			assert.Equal(t, "traces-checkout-default", action.Create.Index)
+
+			return itemsAllOK(docs)
+		})
+
+		exporter := newTestTracesExporter(t, server.URL, func(cfg *Config) {
+			cfg.TracesDataStream.Enabled = true
+		})
+
+		mustSendTraces(t, exporter, newTracesWithAttributeAndResourceMap(
+			nil,
+			map[string]string{"service.name": "checkout"},
+		))
+
+		rec.WaitItems(1)
+	})
+
 	t.Run("publish with logstash format index", func(t *testing.T) {
 		var defaultCfg Config
 
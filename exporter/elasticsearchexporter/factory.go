@@ -47,6 +47,12 @@ func createDefaultConfig() component.Config {
 		Index:       "",
 		LogsIndex:   defaultLogsIndex,
 		TracesIndex: defaultTracesIndex,
+		LogsDataStream: DataStreamSettings{
+			Name: defaultLogsDataStreamName,
+		},
+		TracesDataStream: DataStreamSettings{
+			Name: defaultTracesDataStreamName,
+		},
 		Retry: RetrySettings{
 			Enabled:         true,
 			MaxRequests:     3,
@@ -91,7 +97,7 @@ func createLogsExporter(
 
 	setDefaultUserAgentHeader(cf, set.BuildInfo)
 
-	exporter, err := newExporter(set.Logger, cf, index, cf.LogsDynamicIndex.Enabled)
+	exporter, err := newExporter(set.Logger, cf, index, cf.LogsDynamicIndex.Enabled, cf.LogsDataStream)
 	if err != nil {
 		return nil, fmt.Errorf("cannot configure Elasticsearch exporter: %w", err)
 	}
@@ -114,7 +120,7 @@ func createTracesExporter(ctx context.Context,
 
 	setDefaultUserAgentHeader(cf, set.BuildInfo)
 
-	exporter, err := newExporter(set.Logger, cf, cf.TracesIndex, cf.TracesDynamicIndex.Enabled)
+	exporter, err := newExporter(set.Logger, cf, cf.TracesIndex, cf.TracesDynamicIndex.Enabled, cf.TracesDataStream)
 	if err != nil {
 		return nil, fmt.Errorf("cannot configure Elasticsearch exporter: %w", err)
 	}
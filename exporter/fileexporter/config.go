@@ -11,6 +11,8 @@ import (
 
 	"go.opentelemetry.io/collector/component"
 	"go.opentelemetry.io/collector/confmap"
+
+	parquettranslator "github.com/open-telemetry/opentelemetry-collector-contrib/pkg/translator/parquet"
 )
 
 const (
@@ -38,6 +40,7 @@ type Config struct {
 	// Options:
 	// - json[default]:  OTLP json bytes.
 	// - proto:  OTLP binary protobuf bytes.
+	// - parquet:  Parquet files, see Parquet.
 	FormatType string `mapstructure:"format"`
 
 	// Encoding defines the encoding of the telemetry data.
@@ -54,6 +57,25 @@ type Config struct {
 
 	// GroupBy enables writing to separate files based on a resource attribute.
 	GroupBy *GroupBy `mapstructure:"group_by"`
+
+	// Parquet defines the settings of the Parquet files written when FormatType is parquet.
+	Parquet ParquetSettings `mapstructure:"parquet"`
+}
+
+// ParquetSettings defines how the Parquet files are written. Each signal is written to its own files,
+// named after Path with the signal name and the creation time, and rotated by size and age.
+type ParquetSettings struct {
+	// Compression is the codec compressing the columns: none, snappy, gzip or zstd.
+	// The default is snappy.
+	Compression string `mapstructure:"compression"`
+
+	// MaxMegabytes is the size in megabytes of a file before it gets rotated.
+	// The default is 100 megabytes, 0 disables the size based rotation.
+	MaxMegabytes int `mapstructure:"max_megabytes"`
+
+	// MaxAge is the age of a file before it gets rotated, the files are checked every FlushInterval.
+	// The default is 1 hour, 0 disables the age based rotation.
+	MaxAge time.Duration `mapstructure:"max_age"`
 }
 
 // Rotation an option to rolling log files
@@ -107,9 +129,14 @@ func (cfg *Config) Validate() error {
 	if cfg.Append && cfg.Rotation != nil {
 		return fmt.Errorf("append and rotation enabled at the same time is not supported")
 	}
-	if cfg.FormatType != formatTypeJSON && cfg.FormatType != formatTypeProto {
+	if cfg.FormatType != formatTypeJSON && cfg.FormatType != formatTypeProto && cfg.FormatType != formatTypeParquet {
 		return errors.New("format type is not supported")
 	}
+	if cfg.FormatType == formatTypeParquet {
+		if err := cfg.validateParquet(); err != nil {
+			return err
+		}
+	}
 	if cfg.Compression != "" && cfg.Compression != compressionZSTD {
 		return errors.New("compression is not supported")
 	}
@@ -135,6 +162,34 @@ func (cfg *Config) Validate() error {
 	return nil
 }
 
+func (cfg *Config) validateParquet() error {
+	if cfg.Append {
+		return errors.New("append is not supported with the parquet format")
+	}
+	if cfg.Rotation != nil {
+		return errors.New("rotation is not supported with the parquet format, use the parquet settings")
+	}
+	if cfg.Compression != "" {
+		return errors.New("compression is not supported with the parquet format, use parquet::compression")
+	}
+	if cfg.Encoding != nil {
+		return errors.New("encoding is not supported with the parquet format")
+	}
+	if cfg.GroupBy != nil && cfg.GroupBy.Enabled {
+		return errors.New("group_by is not supported with the parquet format")
+	}
+	if _, err := parquettranslator.CompressionCodec(cfg.Parquet.Compression); err != nil {
+		return err
+	}
+	if cfg.Parquet.MaxMegabytes < 0 {
+		return errors.New("parquet::max_megabytes must not be negative")
+	}
+	if cfg.Parquet.MaxAge < 0 {
+		return errors.New("parquet::max_age must not be negative")
+	}
+	return nil
+}
+
 // Unmarshal a confmap.Conf into the config struct.
 func (cfg *Config) Unmarshal(componentParser *confmap.Conf) error {
 	if componentParser == nil {
@@ -14,6 +14,7 @@ import (
 	"go.opentelemetry.io/collector/confmap/confmaptest"
 
 	"github.com/open-telemetry/opentelemetry-collector-contrib/exporter/fileexporter/internal/metadata"
+	parquettranslator "github.com/open-telemetry/opentelemetry-collector-contrib/pkg/translator/parquet"
 )
 
 func TestLoadConfig(t *testing.T) {
@@ -43,6 +44,11 @@ func TestLoadConfig(t *testing.T) {
 					MaxOpenFiles:      defaultMaxOpenFiles,
 					ResourceAttribute: defaultResourceAttribute,
 				},
+				Parquet: ParquetSettings{
+					Compression:  parquettranslator.CompressionSnappy,
+					MaxMegabytes: defaultParquetMaxMegabytes,
+					MaxAge:       defaultParquetMaxAge,
+				},
 			},
 		},
 		{
@@ -62,6 +68,11 @@ func TestLoadConfig(t *testing.T) {
 					MaxOpenFiles:      defaultMaxOpenFiles,
 					ResourceAttribute: defaultResourceAttribute,
 				},
+				Parquet: ParquetSettings{
+					Compression:  parquettranslator.CompressionSnappy,
+					MaxMegabytes: defaultParquetMaxMegabytes,
+					MaxAge:       defaultParquetMaxAge,
+				},
 			},
 		},
 		{
@@ -77,6 +88,11 @@ func TestLoadConfig(t *testing.T) {
 					MaxOpenFiles:      defaultMaxOpenFiles,
 					ResourceAttribute: defaultResourceAttribute,
 				},
+				Parquet: ParquetSettings{
+					Compression:  parquettranslator.CompressionSnappy,
+					MaxMegabytes: defaultParquetMaxMegabytes,
+					MaxAge:       defaultParquetMaxAge,
+				},
 			},
 		},
 		{
@@ -93,6 +109,11 @@ func TestLoadConfig(t *testing.T) {
 					MaxOpenFiles:      defaultMaxOpenFiles,
 					ResourceAttribute: defaultResourceAttribute,
 				},
+				Parquet: ParquetSettings{
+					Compression:  parquettranslator.CompressionSnappy,
+					MaxMegabytes: defaultParquetMaxMegabytes,
+					MaxAge:       defaultParquetMaxAge,
+				},
 			},
 		},
 		{
@@ -113,6 +134,11 @@ func TestLoadConfig(t *testing.T) {
 					MaxOpenFiles:      defaultMaxOpenFiles,
 					ResourceAttribute: defaultResourceAttribute,
 				},
+				Parquet: ParquetSettings{
+					Compression:  parquettranslator.CompressionSnappy,
+					MaxMegabytes: defaultParquetMaxMegabytes,
+					MaxAge:       defaultParquetMaxAge,
+				},
 			},
 		},
 		{
@@ -125,6 +151,11 @@ func TestLoadConfig(t *testing.T) {
 					MaxOpenFiles:      defaultMaxOpenFiles,
 					ResourceAttribute: defaultResourceAttribute,
 				},
+				Parquet: ParquetSettings{
+					Compression:  parquettranslator.CompressionSnappy,
+					MaxMegabytes: defaultParquetMaxMegabytes,
+					MaxAge:       defaultParquetMaxAge,
+				},
 			},
 		},
 		{
@@ -137,6 +168,11 @@ func TestLoadConfig(t *testing.T) {
 					MaxOpenFiles:      defaultMaxOpenFiles,
 					ResourceAttribute: defaultResourceAttribute,
 				},
+				Parquet: ParquetSettings{
+					Compression:  parquettranslator.CompressionSnappy,
+					MaxMegabytes: defaultParquetMaxMegabytes,
+					MaxAge:       defaultParquetMaxAge,
+				},
 			},
 		},
 		{
@@ -158,6 +194,11 @@ func TestLoadConfig(t *testing.T) {
 					MaxOpenFiles:      10,
 					ResourceAttribute: "dummy",
 				},
+				Parquet: ParquetSettings{
+					Compression:  parquettranslator.CompressionSnappy,
+					MaxMegabytes: defaultParquetMaxMegabytes,
+					MaxAge:       defaultParquetMaxAge,
+				},
 			},
 		},
 		{
@@ -171,6 +212,11 @@ func TestLoadConfig(t *testing.T) {
 					MaxOpenFiles:      defaultMaxOpenFiles,
 					ResourceAttribute: defaultResourceAttribute,
 				},
+				Parquet: ParquetSettings{
+					Compression:  parquettranslator.CompressionSnappy,
+					MaxMegabytes: defaultParquetMaxMegabytes,
+					MaxAge:       defaultParquetMaxAge,
+				},
 			},
 		},
 		{
@@ -185,6 +231,39 @@ func TestLoadConfig(t *testing.T) {
 			id:           component.NewIDWithName(metadata.Type, "group_by_empty_resource_attribute"),
 			errorMessage: "resource_attribute must not be empty when group_by is enabled",
 		},
+		{
+			id: component.NewIDWithName(metadata.Type, "parquet"),
+			expected: &Config{
+				Path:          "./archive/telemetry.parquet",
+				FormatType:    formatTypeParquet,
+				FlushInterval: time.Second,
+				GroupBy: &GroupBy{
+					MaxOpenFiles:      defaultMaxOpenFiles,
+					ResourceAttribute: defaultResourceAttribute,
+				},
+				Parquet: ParquetSettings{
+					Compression:  parquettranslator.CompressionZstd,
+					MaxMegabytes: 256,
+					MaxAge:       15 * time.Minute,
+				},
+			},
+		},
+		{
+			id:           component.NewIDWithName(metadata.Type, "parquet_compression_error"),
+			errorMessage: `parquet compression "lz4" is not supported`,
+		},
+		{
+			id:           component.NewIDWithName(metadata.Type, "parquet_rotation_error"),
+			errorMessage: "rotation is not supported with the parquet format, use the parquet settings",
+		},
+		{
+			id:           component.NewIDWithName(metadata.Type, "parquet_group_by_error"),
+			errorMessage: "group_by is not supported with the parquet format",
+		},
+		{
+			id:           component.NewIDWithName(metadata.Type, "parquet_negative_max_age"),
+			errorMessage: "parquet::max_age must not be negative",
+		},
 	}
 
 	for _, tt := range tests {
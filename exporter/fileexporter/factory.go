@@ -21,6 +21,7 @@ import (
 
 	"github.com/open-telemetry/opentelemetry-collector-contrib/exporter/fileexporter/internal/metadata"
 	"github.com/open-telemetry/opentelemetry-collector-contrib/internal/sharedcomponent"
+	parquettranslator "github.com/open-telemetry/opentelemetry-collector-contrib/pkg/translator/parquet"
 )
 
 const (
@@ -28,8 +29,9 @@ const (
 	defaultMaxBackups = 100
 
 	// the format of encoded telemetry data
-	formatTypeJSON  = "json"
-	formatTypeProto = "proto"
+	formatTypeJSON    = "json"
+	formatTypeProto   = "proto"
+	formatTypeParquet = "parquet"
 
 	// the type of compression codec
 	compressionZSTD = "zstd"
@@ -37,6 +39,9 @@ const (
 	defaultMaxOpenFiles = 100
 
 	defaultResourceAttribute = "fileexporter.path_segment"
+
+	defaultParquetMaxMegabytes = 100
+	defaultParquetMaxAge       = time.Hour
 )
 
 type FileExporter interface {
@@ -64,6 +69,11 @@ func createDefaultConfig() component.Config {
 			ResourceAttribute: defaultResourceAttribute,
 			MaxOpenFiles:      defaultMaxOpenFiles,
 		},
+		Parquet: ParquetSettings{
+			Compression:  parquettranslator.CompressionSnappy,
+			MaxMegabytes: defaultParquetMaxMegabytes,
+			MaxAge:       defaultParquetMaxAge,
+		},
 	}
 }
 
@@ -133,6 +143,12 @@ func getOrCreateFileExporter(cfg component.Config, logger *zap.Logger) FileExpor
 }
 
 func newFileExporter(conf *Config, logger *zap.Logger) FileExporter {
+	if conf.FormatType == formatTypeParquet {
+		return &parquetFileExporter{
+			conf: conf,
+		}
+	}
+
 	if conf.GroupBy == nil || !conf.GroupBy.Enabled {
 		return &fileExporter{
 			conf: conf,
@@ -0,0 +1,248 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package fileexporter // import "github.com/open-telemetry/opentelemetry-collector-contrib/exporter/fileexporter"
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/apache/arrow/go/v15/arrow"
+	"github.com/apache/arrow/go/v15/parquet"
+	"github.com/apache/arrow/go/v15/parquet/pqarrow"
+	"go.opentelemetry.io/collector/component"
+	"go.opentelemetry.io/collector/pdata/plog"
+	"go.opentelemetry.io/collector/pdata/pmetric"
+	"go.opentelemetry.io/collector/pdata/ptrace"
+
+	parquettranslator "github.com/open-telemetry/opentelemetry-collector-contrib/pkg/translator/parquet"
+)
+
+const (
+	parquetExtension = ".parquet"
+	// parquetInProgressExtension is appended to the name of the files being written, the files are
+	// renamed once closed so that only complete files have the parquet extension.
+	parquetInProgressExtension = ".inprogress"
+	parquetTimeFormat          = "2006-01-02T15-04-05.000000000"
+)
+
+// parquetFileExporter writes the telemetry data of each signal to rotated Parquet files.
+type parquetFileExporter struct {
+	conf *Config
+
+	traces  *parquetWriter
+	metrics *parquetWriter
+	logs    *parquetWriter
+
+	stopTicker chan struct{}
+	wg         sync.WaitGroup
+}
+
+func (e *parquetFileExporter) consumeTraces(_ context.Context, td ptrace.Traces) error {
+	if td.SpanCount() == 0 {
+		return nil
+	}
+	rec := parquettranslator.TracesToRecord(td)
+	defer rec.Release()
+	return e.traces.write(rec)
+}
+
+func (e *parquetFileExporter) consumeMetrics(_ context.Context, md pmetric.Metrics) error {
+	if md.DataPointCount() == 0 {
+		return nil
+	}
+	rec := parquettranslator.MetricsToRecord(md)
+	defer rec.Release()
+	return e.metrics.write(rec)
+}
+
+func (e *parquetFileExporter) consumeLogs(_ context.Context, ld plog.Logs) error {
+	if ld.LogRecordCount() == 0 {
+		return nil
+	}
+	rec := parquettranslator.LogsToRecord(ld)
+	defer rec.Release()
+	return e.logs.write(rec)
+}
+
+// Start creates the writers of the signals and starts the age based rotation.
+func (e *parquetFileExporter) Start(context.Context, component.Host) error {
+	if err := os.MkdirAll(filepath.Dir(e.conf.Path), 0755); err != nil {
+		return err
+	}
+
+	e.traces = newParquetWriter(e.conf.Path, "traces", parquettranslator.TracesSchema, e.conf.Parquet)
+	e.metrics = newParquetWriter(e.conf.Path, "metrics", parquettranslator.MetricsSchema, e.conf.Parquet)
+	e.logs = newParquetWriter(e.conf.Path, "logs", parquettranslator.LogsSchema, e.conf.Parquet)
+
+	if e.conf.Parquet.MaxAge > 0 {
+		interval := e.conf.FlushInterval
+		if interval <= 0 {
+			interval = time.Second
+		}
+		e.stopTicker = make(chan struct{})
+		e.wg.Add(1)
+		go e.rotateOnAge(interval)
+	}
+	return nil
+}
+
+// rotateOnAge closes the files older than the max age, even if no data is written to them.
+func (e *parquetFileExporter) rotateOnAge(interval time.Duration) {
+	defer e.wg.Done()
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ticker.C:
+			for _, w := range []*parquetWriter{e.traces, e.metrics, e.logs} {
+				// errors are reported by the next write or by shutdown
+				_ = w.rotateIfOlder()
+			}
+		case <-e.stopTicker:
+			return
+		}
+	}
+}
+
+// Shutdown closes the files being written.
+func (e *parquetFileExporter) Shutdown(context.Context) error {
+	if e.stopTicker != nil {
+		close(e.stopTicker)
+		e.wg.Wait()
+		e.stopTicker = nil
+	}
+
+	var errs error
+	for _, w := range []*parquetWriter{e.traces, e.metrics, e.logs} {
+		if w != nil {
+			errs = errors.Join(errs, w.close())
+		}
+	}
+	return errs
+}
+
+// parquetWriter writes the records of a signal to a Parquet file, rotated by size and age.
+type parquetWriter struct {
+	// pathPrefix is the path of the files without the timestamp and extension.
+	pathPrefix string
+	schema     *arrow.Schema
+	props      *parquet.WriterProperties
+	maxBytes   int64
+	maxAge     time.Duration
+	now        func() time.Time
+
+	mutex    sync.Mutex
+	path     string
+	file     *countingFile
+	writer   *pqarrow.FileWriter
+	openedAt time.Time
+}
+
+func newParquetWriter(path, signal string, schema *arrow.Schema, settings ParquetSettings) *parquetWriter {
+	// the compression is checked when validating the config
+	codec, _ := parquettranslator.CompressionCodec(settings.Compression)
+	return &parquetWriter{
+		pathPrefix: fmt.Sprintf("%s-%s-", strings.TrimSuffix(path, parquetExtension), signal),
+		schema:     schema,
+		props: parquet.NewWriterProperties(
+			parquet.WithCompression(codec),
+			parquet.WithCreatedBy("opentelemetry-collector fileexporter"),
+		),
+		maxBytes: int64(settings.MaxMegabytes) * 1024 * 1024,
+		maxAge:   settings.MaxAge,
+		now:      time.Now,
+	}
+}
+
+// write writes the record as a new row group, rotating the file first if needed.
+func (w *parquetWriter) write(rec arrow.Record) error {
+	w.mutex.Lock()
+	defer w.mutex.Unlock()
+
+	if w.writer != nil && w.shouldRotate() {
+		if err := w.closeFile(); err != nil {
+			return err
+		}
+	}
+	if w.writer == nil {
+		if err := w.openFile(); err != nil {
+			return err
+		}
+	}
+	if err := w.writer.Write(rec); err != nil {
+		// the writer is closed on errors, start a new file with the next record
+		return errors.Join(err, w.closeFile())
+	}
+	return nil
+}
+
+func (w *parquetWriter) shouldRotate() bool {
+	if w.maxBytes > 0 && w.file.written >= w.maxBytes {
+		return true
+	}
+	return w.maxAge > 0 && w.now().Sub(w.openedAt) >= w.maxAge
+}
+
+func (w *parquetWriter) rotateIfOlder() error {
+	w.mutex.Lock()
+	defer w.mutex.Unlock()
+	if w.writer == nil || w.maxAge <= 0 || w.now().Sub(w.openedAt) < w.maxAge {
+		return nil
+	}
+	return w.closeFile()
+}
+
+func (w *parquetWriter) openFile() error {
+	w.openedAt = w.now()
+	w.path = w.pathPrefix + w.openedAt.UTC().Format(parquetTimeFormat) + parquetExtension
+	f, err := os.OpenFile(w.path+parquetInProgressExtension, os.O_RDWR|os.O_CREATE|os.O_EXCL, 0644)
+	if err != nil {
+		return err
+	}
+	w.file = &countingFile{File: f}
+	w.writer, err = pqarrow.NewFileWriter(w.schema, w.file, w.props, pqarrow.NewArrowWriterProperties(pqarrow.WithStoreSchema()))
+	if err != nil {
+		_ = f.Close()
+		return errors.Join(err, os.Remove(f.Name()))
+	}
+	return nil
+}
+
+// closeFile writes the footer of the current file and gives it its final name.
+func (w *parquetWriter) closeFile() error {
+	writer := w.writer
+	w.writer = nil
+	w.file = nil
+	if err := writer.Close(); err != nil {
+		return err
+	}
+	return os.Rename(w.path+parquetInProgressExtension, w.path)
+}
+
+func (w *parquetWriter) close() error {
+	w.mutex.Lock()
+	defer w.mutex.Unlock()
+	if w.writer == nil {
+		return nil
+	}
+	return w.closeFile()
+}
+
+// countingFile counts the bytes written to the file.
+type countingFile struct {
+	*os.File
+	written int64
+}
+
+func (f *countingFile) Write(p []byte) (int, error) {
+	n, err := f.File.Write(p)
+	f.written += int64(n)
+	return n, err
+}
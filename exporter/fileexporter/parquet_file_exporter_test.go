@@ -0,0 +1,210 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package fileexporter
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"sort"
+	"testing"
+	"time"
+
+	"github.com/apache/arrow/go/v15/arrow"
+	"github.com/apache/arrow/go/v15/arrow/memory"
+	"github.com/apache/arrow/go/v15/parquet/file"
+	"github.com/apache/arrow/go/v15/parquet/pqarrow"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/collector/component/componenttest"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/internal/coreinternal/testdata"
+	parquettranslator "github.com/open-telemetry/opentelemetry-collector-contrib/pkg/translator/parquet"
+)
+
+func newParquetConfig(t *testing.T, compression string) *Config {
+	return &Config{
+		Path:          filepath.Join(t.TempDir(), "archive", "telemetry.parquet"),
+		FormatType:    formatTypeParquet,
+		FlushInterval: time.Second,
+		Parquet: ParquetSettings{
+			Compression:  compression,
+			MaxMegabytes: defaultParquetMaxMegabytes,
+			MaxAge:       defaultParquetMaxAge,
+		},
+	}
+}
+
+// readParquetFiles returns the rows of the files written for the signal, in the order of the files.
+func readParquetFiles(t *testing.T, conf *Config, signal string) []arrow.Table {
+	paths, err := filepath.Glob(filepath.Join(filepath.Dir(conf.Path), "telemetry-"+signal+"-*"))
+	require.NoError(t, err)
+	sort.Strings(paths)
+
+	var tables []arrow.Table
+	for _, path := range paths {
+		require.Equal(t, parquetExtension, filepath.Ext(path), "file %s is still in progress", path)
+		rdr, err := file.OpenParquetFile(path, false)
+		require.NoError(t, err)
+		reader, err := pqarrow.NewFileReader(rdr, pqarrow.ArrowReadProperties{}, memory.DefaultAllocator)
+		require.NoError(t, err)
+		table, err := reader.ReadTable(context.Background())
+		require.NoError(t, err)
+		require.NoError(t, rdr.Close())
+		t.Cleanup(table.Release)
+		tables = append(tables, table)
+	}
+	return tables
+}
+
+func fieldNames(schema *arrow.Schema) []string {
+	names := make([]string, schema.NumFields())
+	for i, field := range schema.Fields() {
+		names[i] = field.Name
+	}
+	return names
+}
+
+func TestParquetFileExporter(t *testing.T) {
+	for _, compression := range []string{parquettranslator.CompressionNone, parquettranslator.CompressionSnappy, parquettranslator.CompressionGzip, parquettranslator.CompressionZstd} {
+		t.Run(compression, func(t *testing.T) {
+			conf := newParquetConfig(t, compression)
+			fe := newFileExporter(conf, nil)
+			require.IsType(t, &parquetFileExporter{}, fe)
+			require.NoError(t, fe.Start(context.Background(), componenttest.NewNopHost()))
+
+			td := testdata.GenerateTracesTwoSpansSameResourceOneDifferent()
+			require.NoError(t, fe.consumeTraces(context.Background(), td))
+			require.NoError(t, fe.consumeTraces(context.Background(), td))
+			md := testdata.GenerateMetricsAllTypesEmptyDataPoint()
+			require.NoError(t, fe.consumeMetrics(context.Background(), md))
+			ld := testdata.GenerateLogsManyLogRecordsSameResource(10)
+			require.NoError(t, fe.consumeLogs(context.Background(), ld))
+			require.NoError(t, fe.Shutdown(context.Background()))
+
+			traces := readParquetFiles(t, conf, "traces")
+			require.Len(t, traces, 1)
+			assert.Equal(t, fieldNames(parquettranslator.TracesSchema), fieldNames(traces[0].Schema()))
+			assert.EqualValues(t, 2*td.SpanCount(), traces[0].NumRows())
+
+			metrics := readParquetFiles(t, conf, "metrics")
+			require.Len(t, metrics, 1)
+			assert.Equal(t, fieldNames(parquettranslator.MetricsSchema), fieldNames(metrics[0].Schema()))
+			assert.EqualValues(t, md.DataPointCount(), metrics[0].NumRows())
+
+			logs := readParquetFiles(t, conf, "logs")
+			require.Len(t, logs, 1)
+			assert.Equal(t, fieldNames(parquettranslator.LogsSchema), fieldNames(logs[0].Schema()))
+			assert.EqualValues(t, ld.LogRecordCount(), logs[0].NumRows())
+		})
+	}
+}
+
+func TestParquetFileExporterNoData(t *testing.T) {
+	conf := newParquetConfig(t, parquettranslator.CompressionSnappy)
+	fe := newFileExporter(conf, nil)
+	require.NoError(t, fe.Start(context.Background(), componenttest.NewNopHost()))
+	require.NoError(t, fe.consumeMetrics(context.Background(), testdata.GenerateMetricsAllTypesNoDataPoints()))
+	require.NoError(t, fe.Shutdown(context.Background()))
+
+	entries, err := os.ReadDir(filepath.Dir(conf.Path))
+	require.NoError(t, err)
+	assert.Empty(t, entries)
+}
+
+func TestParquetWriterRotation(t *testing.T) {
+	tests := []struct {
+		name     string
+		settings ParquetSettings
+		advance  time.Duration
+		files    int
+	}{
+		{
+			name:     "no rotation",
+			settings: ParquetSettings{Compression: parquettranslator.CompressionSnappy},
+			advance:  time.Hour,
+			files:    1,
+		},
+		{
+			name:     "size",
+			settings: ParquetSettings{Compression: parquettranslator.CompressionSnappy, MaxMegabytes: 1},
+			advance:  time.Second,
+			files:    1,
+		},
+		{
+			name:     "age",
+			settings: ParquetSettings{Compression: parquettranslator.CompressionSnappy, MaxAge: time.Minute},
+			advance:  time.Minute,
+			files:    3,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			conf := newParquetConfig(t, tt.settings.Compression)
+			require.NoError(t, os.MkdirAll(filepath.Dir(conf.Path), 0755))
+			w := newParquetWriter(conf.Path, "logs", parquettranslator.LogsSchema, tt.settings)
+			now := time.Date(2024, 6, 1, 12, 0, 0, 0, time.UTC)
+			w.now = func() time.Time { return now }
+
+			ld := testdata.GenerateLogsManyLogRecordsSameResource(5)
+			for i := 0; i < 3; i++ {
+				rec := parquettranslator.LogsToRecord(ld)
+				require.NoError(t, w.write(rec))
+				rec.Release()
+				now = now.Add(tt.advance)
+			}
+			require.NoError(t, w.close())
+
+			tables := readParquetFiles(t, conf, "logs")
+			require.Len(t, tables, tt.files)
+			var rows int64
+			for _, table := range tables {
+				rows += table.NumRows()
+			}
+			assert.EqualValues(t, 3*ld.LogRecordCount(), rows)
+		})
+	}
+}
+
+func TestParquetWriterRotationBySize(t *testing.T) {
+	conf := newParquetConfig(t, parquettranslator.CompressionSnappy)
+	require.NoError(t, os.MkdirAll(filepath.Dir(conf.Path), 0755))
+	w := newParquetWriter(conf.Path, "logs", parquettranslator.LogsSchema, ParquetSettings{Compression: parquettranslator.CompressionSnappy})
+	// rotate as soon as anything is written, the magic bytes are written when the file is opened.
+	w.maxBytes = 1
+	now := time.Date(2024, 6, 1, 12, 0, 0, 0, time.UTC)
+	w.now = func() time.Time {
+		now = now.Add(time.Millisecond)
+		return now
+	}
+
+	rec := parquettranslator.LogsToRecord(testdata.GenerateLogsManyLogRecordsSameResource(5))
+	defer rec.Release()
+	for i := 0; i < 3; i++ {
+		require.NoError(t, w.write(rec))
+	}
+	require.NoError(t, w.close())
+	assert.Len(t, readParquetFiles(t, conf, "logs"), 3)
+}
+
+func TestParquetWriterRotateIfOlder(t *testing.T) {
+	conf := newParquetConfig(t, parquettranslator.CompressionSnappy)
+	require.NoError(t, os.MkdirAll(filepath.Dir(conf.Path), 0755))
+	w := newParquetWriter(conf.Path, "logs", parquettranslator.LogsSchema, ParquetSettings{Compression: parquettranslator.CompressionSnappy, MaxAge: time.Minute})
+	now := time.Date(2024, 6, 1, 12, 0, 0, 0, time.UTC)
+	w.now = func() time.Time { return now }
+
+	rec := parquettranslator.LogsToRecord(testdata.GenerateLogsOneLogRecord())
+	defer rec.Release()
+	require.NoError(t, w.write(rec))
+
+	require.NoError(t, w.rotateIfOlder())
+	_, err := os.Stat(conf.Path[:len(conf.Path)-len(parquetExtension)] + "-logs-2024-06-01T12-00-00.000000000.parquet" + parquetInProgressExtension)
+	require.NoError(t, err, "the file must not be rotated before max_age")
+
+	now = now.Add(time.Minute)
+	require.NoError(t, w.rotateIfOlder())
+	assert.Len(t, readParquetFiles(t, conf, "logs"), 1)
+	require.NoError(t, w.close())
+}
@@ -43,6 +43,9 @@ type Config struct {
 	// TopicFromAttribute is the name of the attribute to use as the topic name.
 	TopicFromAttribute string `mapstructure:"topic_from_attribute"`
 
+	// DynamicTopic resolves the topic of messages from a template rendered with the attributes of the data.
+	DynamicTopic DynamicTopic `mapstructure:"dynamic_topic"`
+
 	// Encoding of messages (default "otlp_proto")
 	Encoding string `mapstructure:"encoding"`
 
@@ -53,6 +56,9 @@ type Config struct {
 
 	PartitionMetricsByResourceAttributes bool `mapstructure:"partition_metrics_by_resource_attributes"`
 
+	// PartitionKey sets the message key of each signal, so that related data lands in the same partition.
+	PartitionKey PartitionKey `mapstructure:"partition_key"`
+
 	// Metadata is the namespace for metadata management properties used by the
 	// Client, and shared by the Producer/Consumer.
 	Metadata Metadata `mapstructure:"metadata"`
@@ -125,9 +131,36 @@ func (cfg *Config) Validate() error {
 		return err
 	}
 
+	if err = cfg.validatePartitionKey(); err != nil {
+		return err
+	}
+
+	if cfg.TopicFromAttribute != "" && cfg.DynamicTopic.Template != "" {
+		return fmt.Errorf("topic_from_attribute cannot be used with dynamic_topic")
+	}
+	if err = cfg.DynamicTopic.validate(); err != nil {
+		return err
+	}
+
 	return validateSASLConfig(cfg.Authentication.SASL)
 }
 
+func (cfg *Config) validatePartitionKey() error {
+	if cfg.PartitionTracesByID && cfg.PartitionKey.Traces.Source != "" {
+		return fmt.Errorf("partition_traces_by_id cannot be used with partition_key::traces")
+	}
+	if cfg.PartitionMetricsByResourceAttributes && cfg.PartitionKey.Metrics.Source != "" {
+		return fmt.Errorf("partition_metrics_by_resource_attributes cannot be used with partition_key::metrics")
+	}
+	if err := cfg.PartitionKey.Traces.validate("traces", true); err != nil {
+		return err
+	}
+	if err := cfg.PartitionKey.Metrics.validate("metrics", false); err != nil {
+		return err
+	}
+	return cfg.PartitionKey.Logs.validate("logs", true)
+}
+
 func validateSASLConfig(c *kafka.SASLConfig) error {
 	if c == nil {
 		return nil
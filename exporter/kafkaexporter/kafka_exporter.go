@@ -19,15 +19,22 @@ import (
 	"go.uber.org/zap"
 
 	"github.com/open-telemetry/opentelemetry-collector-contrib/internal/kafka"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/pdatautil"
 )
 
 var errUnrecognizedEncoding = fmt.Errorf("unrecognized encoding")
 
+// sizeEstimator estimates the OTLP protobuf size of the payloads, to split the ones larger than the max_message_bytes
+// before marshaling them, instead of having the whole payload rejected by the producer.
+var sizeEstimator = pdatautil.NewSizeEstimator(pdatautil.DefaultSizeSampleSize)
+
 // kafkaTracesProducer uses sarama to produce trace messages to Kafka.
 type kafkaTracesProducer struct {
 	cfg       Config
 	producer  sarama.SyncProducer
 	marshaler TracesMarshaler
+	keyer     *partitionKeyer
+	topics    *topicResolver
 	logger    *zap.Logger
 }
 
@@ -41,11 +48,31 @@ func (ke kafkaErrors) Error() string {
 }
 
 func (e *kafkaTracesProducer) tracesPusher(_ context.Context, td ptrace.Traces) error {
-	messages, err := e.marshaler.Marshal(td, getTopic(&e.cfg, td.ResourceSpans()))
-	if err != nil {
-		return consumererror.NewPermanent(err)
+	var messages []*sarama.ProducerMessage
+	keys, payloads := []string{""}, []ptrace.Traces{td}
+	if e.keyer != nil {
+		keys, payloads = e.keyer.splitTraces(td)
+	}
+	for i, payload := range payloads {
+		topics, topicPayloads := []string{""}, []ptrace.Traces{payload}
+		if e.topics != nil {
+			topics, topicPayloads = e.topics.splitTraces(payload)
+		}
+		for j, topicPayload := range topicPayloads {
+			for _, chunk := range sizeEstimator.SplitTraces(topicPayload, e.cfg.Producer.MaxMessageBytes) {
+				topic := topics[j]
+				if topic == "" {
+					topic = getTopic(&e.cfg, chunk.ResourceSpans())
+				}
+				chunkMessages, err := e.marshaler.Marshal(chunk, topic)
+				if err != nil {
+					return consumererror.NewPermanent(err)
+				}
+				messages = append(messages, setKey(chunkMessages, keys[i])...)
+			}
+		}
 	}
-	err = e.producer.SendMessages(messages)
+	err := e.producer.SendMessages(messages)
 	if err != nil {
 		var prodErr sarama.ProducerErrors
 		if errors.As(err, &prodErr) {
@@ -79,15 +106,37 @@ type kafkaMetricsProducer struct {
 	cfg       Config
 	producer  sarama.SyncProducer
 	marshaler MetricsMarshaler
+	keyer     *partitionKeyer
+	topics    *topicResolver
 	logger    *zap.Logger
 }
 
 func (e *kafkaMetricsProducer) metricsDataPusher(_ context.Context, md pmetric.Metrics) error {
-	messages, err := e.marshaler.Marshal(md, getTopic(&e.cfg, md.ResourceMetrics()))
-	if err != nil {
-		return consumererror.NewPermanent(err)
+	var messages []*sarama.ProducerMessage
+	keys, payloads := []string{""}, []pmetric.Metrics{md}
+	if e.keyer != nil {
+		keys, payloads = e.keyer.splitMetrics(md)
+	}
+	for i, payload := range payloads {
+		topics, topicPayloads := []string{""}, []pmetric.Metrics{payload}
+		if e.topics != nil {
+			topics, topicPayloads = e.topics.splitMetrics(payload)
+		}
+		for j, topicPayload := range topicPayloads {
+			for _, chunk := range sizeEstimator.SplitMetrics(topicPayload, e.cfg.Producer.MaxMessageBytes) {
+				topic := topics[j]
+				if topic == "" {
+					topic = getTopic(&e.cfg, chunk.ResourceMetrics())
+				}
+				chunkMessages, err := e.marshaler.Marshal(chunk, topic)
+				if err != nil {
+					return consumererror.NewPermanent(err)
+				}
+				messages = append(messages, setKey(chunkMessages, keys[i])...)
+			}
+		}
 	}
-	err = e.producer.SendMessages(messages)
+	err := e.producer.SendMessages(messages)
 	if err != nil {
 		var prodErr sarama.ProducerErrors
 		if errors.As(err, &prodErr) {
@@ -121,15 +170,37 @@ type kafkaLogsProducer struct {
 	cfg       Config
 	producer  sarama.SyncProducer
 	marshaler LogsMarshaler
+	keyer     *partitionKeyer
+	topics    *topicResolver
 	logger    *zap.Logger
 }
 
 func (e *kafkaLogsProducer) logsDataPusher(_ context.Context, ld plog.Logs) error {
-	messages, err := e.marshaler.Marshal(ld, getTopic(&e.cfg, ld.ResourceLogs()))
-	if err != nil {
-		return consumererror.NewPermanent(err)
+	var messages []*sarama.ProducerMessage
+	keys, payloads := []string{""}, []plog.Logs{ld}
+	if e.keyer != nil {
+		keys, payloads = e.keyer.splitLogs(ld)
+	}
+	for i, payload := range payloads {
+		topics, topicPayloads := []string{""}, []plog.Logs{payload}
+		if e.topics != nil {
+			topics, topicPayloads = e.topics.splitLogs(payload)
+		}
+		for j, topicPayload := range topicPayloads {
+			for _, chunk := range sizeEstimator.SplitLogs(topicPayload, e.cfg.Producer.MaxMessageBytes) {
+				topic := topics[j]
+				if topic == "" {
+					topic = getTopic(&e.cfg, chunk.ResourceLogs())
+				}
+				chunkMessages, err := e.marshaler.Marshal(chunk, topic)
+				if err != nil {
+					return consumererror.NewPermanent(err)
+				}
+				messages = append(messages, setKey(chunkMessages, keys[i])...)
+			}
+		}
 	}
-	err = e.producer.SendMessages(messages)
+	err := e.producer.SendMessages(messages)
 	if err != nil {
 		var prodErr sarama.ProducerErrors
 		if errors.As(err, &prodErr) {
@@ -158,6 +229,17 @@ func (e *kafkaLogsProducer) start(_ context.Context, _ component.Host) error {
 	return nil
 }
 
+// setKey sets the key of the messages, unless the key is empty
+func setKey(messages []*sarama.ProducerMessage, key string) []*sarama.ProducerMessage {
+	if key == "" {
+		return messages
+	}
+	for _, message := range messages {
+		message.Key = sarama.ByteEncoder(key)
+	}
+	return messages
+}
+
 func newSaramaProducer(config Config) (sarama.SyncProducer, error) {
 	c := sarama.NewConfig()
 
@@ -218,6 +300,8 @@ func newMetricsExporter(config Config, set exporter.CreateSettings, marshalers m
 	return &kafkaMetricsProducer{
 		cfg:       config,
 		marshaler: marshaler,
+		keyer:     newPartitionKeyer(config.PartitionKey.Metrics),
+		topics:    newTopicResolver(config.DynamicTopic, config.Topic),
 		logger:    set.Logger,
 	}, nil
 
@@ -238,6 +322,8 @@ func newTracesExporter(config Config, set exporter.CreateSettings, marshalers ma
 	return &kafkaTracesProducer{
 		cfg:       config,
 		marshaler: marshaler,
+		keyer:     newPartitionKeyer(config.PartitionKey.Traces),
+		topics:    newTopicResolver(config.DynamicTopic, config.Topic),
 		logger:    set.Logger,
 	}, nil
 }
@@ -251,6 +337,8 @@ func newLogsExporter(config Config, set exporter.CreateSettings, marshalers map[
 	return &kafkaLogsProducer{
 		cfg:       config,
 		marshaler: marshaler,
+		keyer:     newPartitionKeyer(config.PartitionKey.Logs),
+		topics:    newTopicResolver(config.DynamicTopic, config.Topic),
 		logger:    set.Logger,
 	}, nil
 
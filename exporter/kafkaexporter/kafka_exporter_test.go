@@ -6,6 +6,7 @@ package kafkaexporter
 import (
 	"context"
 	"fmt"
+	"strings"
 	"testing"
 
 	"github.com/IBM/sarama"
@@ -437,3 +438,35 @@ func Test_GetTopic(t *testing.T) {
 		})
 	}
 }
+
+// each resource is about 420 bytes once marshaled
+const resourcePayload = 400
+
+func logsWithResources(count int) plog.Logs {
+	ld := plog.NewLogs()
+	for i := 0; i < count; i++ {
+		rl := ld.ResourceLogs().AppendEmpty()
+		rl.ScopeLogs().AppendEmpty().LogRecords().AppendEmpty().Body().SetStr(strings.Repeat("x", resourcePayload))
+	}
+	return ld
+}
+
+func TestLogsDataPusher_split(t *testing.T) {
+	c := sarama.NewConfig()
+	producer := mocks.NewSyncProducer(t, c)
+	producer.ExpectSendMessageAndSucceed()
+	producer.ExpectSendMessageAndSucceed()
+
+	p := kafkaLogsProducer{
+		cfg: Config{
+			Producer: Producer{MaxMessageBytes: 1000},
+		},
+		producer:  producer,
+		marshaler: newPdataLogsMarshaler(&plog.ProtoMarshaler{}, defaultEncoding),
+	}
+	t.Cleanup(func() {
+		require.NoError(t, p.Close(context.Background()))
+	})
+	err := p.logsDataPusher(context.Background(), logsWithResources(3))
+	require.NoError(t, err)
+}
@@ -0,0 +1,196 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package kafkaexporter // import "github.com/open-telemetry/opentelemetry-collector-contrib/exporter/kafkaexporter"
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"go.opentelemetry.io/collector/pdata/pcommon"
+	"go.opentelemetry.io/collector/pdata/plog"
+	"go.opentelemetry.io/collector/pdata/pmetric"
+	"go.opentelemetry.io/collector/pdata/ptrace"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/internal/coreinternal/traceutil"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/batchpersignal"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/pdatautil"
+)
+
+const (
+	// partitionKeyTraceID keys messages by trace ID, for traces and logs
+	partitionKeyTraceID = "trace_id"
+	// partitionKeyResourceAttributes keys messages by the hash of resource attributes
+	partitionKeyResourceAttributes = "resource_attributes"
+	// partitionKeyTemplate keys messages by a template rendered with resource attributes
+	partitionKeyTemplate = "template"
+)
+
+// PartitionKey defines the message key of each signal, which determines the partition of the messages.
+type PartitionKey struct {
+	Traces  PartitionKeyConfig `mapstructure:"traces"`
+	Metrics PartitionKeyConfig `mapstructure:"metrics"`
+	Logs    PartitionKeyConfig `mapstructure:"logs"`
+}
+
+// PartitionKeyConfig defines how the message key of a signal is built.
+type PartitionKeyConfig struct {
+	// Source of the message key: "trace_id" (traces and logs only), "resource_attributes" or "template".
+	// Messages have no key when empty.
+	Source string `mapstructure:"source"`
+
+	// ResourceAttributes are the resource attributes hashed into the key when Source is "resource_attributes".
+	// All resource attributes are hashed when empty.
+	ResourceAttributes []string `mapstructure:"resource_attributes"`
+
+	// Template is the key when Source is "template". "%{name}" is replaced by the value of the resource attribute
+	// name, or by an empty string when the resource doesn't have it.
+	Template string `mapstructure:"template"`
+}
+
+func (cfg *PartitionKeyConfig) validate(signal string, traceIDAllowed bool) error {
+	switch cfg.Source {
+	case "":
+	case partitionKeyTraceID:
+		if !traceIDAllowed {
+			return fmt.Errorf("partition_key::%s::source %q is not supported for %s", signal, cfg.Source, signal)
+		}
+	case partitionKeyResourceAttributes:
+	case partitionKeyTemplate:
+		if cfg.Template == "" {
+			return fmt.Errorf("partition_key::%s::template is required when source is %q", signal, partitionKeyTemplate)
+		}
+		if _, err := parseKeyTemplate(cfg.Template); err != nil {
+			return fmt.Errorf("partition_key::%s::template is invalid: %w", signal, err)
+		}
+	default:
+		return fmt.Errorf("partition_key::%s::source should be one of %q, %q or %q. configured value %v",
+			signal, partitionKeyTraceID, partitionKeyResourceAttributes, partitionKeyTemplate, cfg.Source)
+	}
+	return nil
+}
+
+// keyTemplatePart is either a literal or, when attribute is true, the name of the resource attribute to render
+type keyTemplatePart struct {
+	value     string
+	attribute bool
+}
+
+func parseKeyTemplate(template string) ([]keyTemplatePart, error) {
+	return parseTemplate(template, "%{")
+}
+
+// parseTemplate splits template into literals and the names of the attributes in the placeholders,
+// which start with open and end with "}"
+func parseTemplate(template string, open string) ([]keyTemplatePart, error) {
+	var parts []keyTemplatePart
+	rest := template
+	for rest != "" {
+		start := strings.Index(rest, open)
+		if start == -1 {
+			parts = append(parts, keyTemplatePart{value: rest})
+			break
+		}
+		if start > 0 {
+			parts = append(parts, keyTemplatePart{value: rest[:start]})
+		}
+		end := strings.IndexByte(rest[start:], '}')
+		if end == -1 {
+			return nil, fmt.Errorf("unclosed placeholder in %q", template)
+		}
+		name := rest[start+len(open) : start+end]
+		if name == "" {
+			return nil, fmt.Errorf("empty placeholder in %q", template)
+		}
+		parts = append(parts, keyTemplatePart{value: name, attribute: true})
+		rest = rest[start+end+1:]
+	}
+	return parts, nil
+}
+
+// partitionKeyer splits payloads by message key
+type partitionKeyer struct {
+	source     string
+	attributes []string
+	template   []keyTemplatePart
+}
+
+// newPartitionKeyer returns nil when messages have no key. Relies on config being validated.
+func newPartitionKeyer(cfg PartitionKeyConfig) *partitionKeyer {
+	if cfg.Source == "" {
+		return nil
+	}
+	template, _ := parseKeyTemplate(cfg.Template)
+	return &partitionKeyer{
+		source:     cfg.Source,
+		attributes: cfg.ResourceAttributes,
+		template:   template,
+	}
+}
+
+func (k *partitionKeyer) resourceKey(resource pcommon.Resource) string {
+	if k.source == partitionKeyTemplate {
+		var key strings.Builder
+		for _, part := range k.template {
+			if !part.attribute {
+				key.WriteString(part.value)
+				continue
+			}
+			if value, ok := resource.Attributes().Get(part.value); ok {
+				key.WriteString(value.AsString())
+			}
+		}
+		return key.String()
+	}
+
+	attributes := resource.Attributes()
+	if len(k.attributes) > 0 {
+		attributes = pcommon.NewMap()
+		for _, name := range k.attributes {
+			if value, ok := resource.Attributes().Get(name); ok {
+				value.CopyTo(attributes.PutEmpty(name))
+			}
+		}
+	}
+	hash := pdatautil.MapHash(attributes)
+	return string(hash[:])
+}
+
+func (k *partitionKeyer) splitTraces(td ptrace.Traces) ([]string, []ptrace.Traces) {
+	return sortedByKey(batchpersignal.SplitTracesByKey(td, func(resource pcommon.Resource, _ pcommon.InstrumentationScope, span ptrace.Span) string {
+		if k.source == partitionKeyTraceID {
+			return traceutil.TraceIDToHexOrEmptyString(span.TraceID())
+		}
+		return k.resourceKey(resource)
+	}))
+}
+
+func (k *partitionKeyer) splitMetrics(md pmetric.Metrics) ([]string, []pmetric.Metrics) {
+	return sortedByKey(batchpersignal.SplitMetricsByKey(md, func(resource pcommon.Resource, _ pcommon.InstrumentationScope, _ pmetric.Metric) string {
+		return k.resourceKey(resource)
+	}))
+}
+
+func (k *partitionKeyer) splitLogs(ld plog.Logs) ([]string, []plog.Logs) {
+	return sortedByKey(batchpersignal.SplitLogsByKey(ld, func(resource pcommon.Resource, _ pcommon.InstrumentationScope, record plog.LogRecord) string {
+		if k.source == partitionKeyTraceID {
+			return traceutil.TraceIDToHexOrEmptyString(record.TraceID())
+		}
+		return k.resourceKey(resource)
+	}))
+}
+
+// sortedByKey returns the payloads of each key, sorted by key to produce messages in a deterministic order
+func sortedByKey[T any](byKey map[string]T) ([]string, []T) {
+	keys := make([]string, 0, len(byKey))
+	for key := range byKey {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	payloads := make([]T, 0, len(keys))
+	for _, key := range keys {
+		payloads = append(payloads, byKey[key])
+	}
+	return keys, payloads
+}
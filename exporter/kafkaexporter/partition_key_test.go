@@ -0,0 +1,199 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package kafkaexporter
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/IBM/sarama"
+	"github.com/IBM/sarama/mocks"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/collector/pdata/pcommon"
+	"go.opentelemetry.io/collector/pdata/plog"
+	"go.opentelemetry.io/collector/pdata/pmetric"
+	"go.opentelemetry.io/collector/pdata/ptrace"
+)
+
+func TestPartitionKeyConfigValidate(t *testing.T) {
+	tests := []struct {
+		name           string
+		cfg            PartitionKeyConfig
+		traceIDAllowed bool
+		err            string
+	}{
+		{name: "no key", cfg: PartitionKeyConfig{}},
+		{name: "trace id", cfg: PartitionKeyConfig{Source: "trace_id"}, traceIDAllowed: true},
+		{
+			name: "trace id not supported",
+			cfg:  PartitionKeyConfig{Source: "trace_id"},
+			err:  `partition_key::signal::source "trace_id" is not supported for signal`,
+		},
+		{name: "resource attributes", cfg: PartitionKeyConfig{Source: "resource_attributes", ResourceAttributes: []string{"tenant"}}},
+		{name: "template", cfg: PartitionKeyConfig{Source: "template", Template: "%{tenant}-%{service.name}"}},
+		{
+			name: "missing template",
+			cfg:  PartitionKeyConfig{Source: "template"},
+			err:  `partition_key::signal::template is required when source is "template"`,
+		},
+		{
+			name: "unclosed placeholder",
+			cfg:  PartitionKeyConfig{Source: "template", Template: "%{tenant"},
+			err:  `partition_key::signal::template is invalid: unclosed placeholder in "%{tenant"`,
+		},
+		{
+			name: "empty placeholder",
+			cfg:  PartitionKeyConfig{Source: "template", Template: "tenant-%{}"},
+			err:  `partition_key::signal::template is invalid: empty placeholder in "tenant-%{}"`,
+		},
+		{
+			name: "unknown source",
+			cfg:  PartitionKeyConfig{Source: "span_id"},
+			err:  `partition_key::signal::source should be one of "trace_id", "resource_attributes" or "template". configured value span_id`,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.cfg.validate("signal", tt.traceIDAllowed)
+			if tt.err == "" {
+				assert.NoError(t, err)
+			} else {
+				assert.EqualError(t, err, tt.err)
+			}
+		})
+	}
+}
+
+func TestValidate_partition_key_conflicts(t *testing.T) {
+	config := &Config{
+		Producer:            Producer{Compression: "none"},
+		PartitionTracesByID: true,
+		PartitionKey:        PartitionKey{Traces: PartitionKeyConfig{Source: "trace_id"}},
+	}
+	assert.EqualError(t, config.Validate(), "partition_traces_by_id cannot be used with partition_key::traces")
+
+	config = &Config{
+		Producer:                             Producer{Compression: "none"},
+		PartitionMetricsByResourceAttributes: true,
+		PartitionKey:                         PartitionKey{Metrics: PartitionKeyConfig{Source: "resource_attributes"}},
+	}
+	assert.EqualError(t, config.Validate(), "partition_metrics_by_resource_attributes cannot be used with partition_key::metrics")
+}
+
+func TestPartitionKeyTemplate(t *testing.T) {
+	keyer := newPartitionKeyer(PartitionKeyConfig{Source: "template", Template: "tenant-%{tenant}/%{missing}/%{replicas}"})
+	resource := pcommon.NewResource()
+	resource.Attributes().PutStr("tenant", "acme")
+	resource.Attributes().PutInt("replicas", 3)
+	assert.Equal(t, "tenant-acme//3", keyer.resourceKey(resource))
+}
+
+func TestPartitionKeyResourceAttributes(t *testing.T) {
+	keyer := newPartitionKeyer(PartitionKeyConfig{Source: "resource_attributes", ResourceAttributes: []string{"tenant"}})
+	first := pcommon.NewResource()
+	first.Attributes().PutStr("tenant", "acme")
+	first.Attributes().PutStr("host.name", "a")
+	second := pcommon.NewResource()
+	second.Attributes().PutStr("tenant", "acme")
+	second.Attributes().PutStr("host.name", "b")
+	other := pcommon.NewResource()
+	other.Attributes().PutStr("tenant", "other")
+	other.Attributes().PutStr("host.name", "a")
+
+	assert.Equal(t, keyer.resourceKey(first), keyer.resourceKey(second))
+	assert.NotEqual(t, keyer.resourceKey(first), keyer.resourceKey(other))
+
+	// all resource attributes are hashed when none is selected
+	keyer = newPartitionKeyer(PartitionKeyConfig{Source: "resource_attributes"})
+	assert.NotEqual(t, keyer.resourceKey(first), keyer.resourceKey(second))
+}
+
+func TestNoPartitionKey(t *testing.T) {
+	assert.Nil(t, newPartitionKeyer(PartitionKeyConfig{}))
+}
+
+func generateTenantTraces() ptrace.Traces {
+	td := ptrace.NewTraces()
+	for i, tenant := range []string{"acme", "other", "acme"} {
+		rs := td.ResourceSpans().AppendEmpty()
+		rs.Resource().Attributes().PutStr("tenant", tenant)
+		spans := rs.ScopeSpans().AppendEmpty().Spans()
+		for j := 0; j < 2; j++ {
+			span := spans.AppendEmpty()
+			span.SetTraceID(pcommon.TraceID([16]byte{byte(j + 1)}))
+			span.SetName(fmt.Sprintf("span-%d-%d", i, j))
+		}
+	}
+	return td
+}
+
+func TestPartitionKeySplitTraces(t *testing.T) {
+	keyer := newPartitionKeyer(PartitionKeyConfig{Source: "trace_id"})
+	keys, payloads := keyer.splitTraces(generateTenantTraces())
+	assert.Equal(t, []string{"01000000000000000000000000000000", "02000000000000000000000000000000"}, keys)
+	for _, payload := range payloads {
+		assert.Equal(t, 3, payload.SpanCount())
+	}
+
+	keyer = newPartitionKeyer(PartitionKeyConfig{Source: "template", Template: "%{tenant}"})
+	keys, payloads = keyer.splitTraces(generateTenantTraces())
+	assert.Equal(t, []string{"acme", "other"}, keys)
+	assert.Equal(t, 4, payloads[0].SpanCount())
+	assert.Equal(t, 2, payloads[1].SpanCount())
+}
+
+func TestPartitionKeySplitLogs(t *testing.T) {
+	ld := plog.NewLogs()
+	records := ld.ResourceLogs().AppendEmpty().ScopeLogs().AppendEmpty().LogRecords()
+	records.AppendEmpty().SetTraceID(pcommon.TraceID([16]byte{1}))
+	records.AppendEmpty().SetTraceID(pcommon.TraceID([16]byte{1}))
+	records.AppendEmpty()
+
+	keys, payloads := newPartitionKeyer(PartitionKeyConfig{Source: "trace_id"}).splitLogs(ld)
+	assert.Equal(t, []string{"", "01000000000000000000000000000000"}, keys)
+	assert.Equal(t, 1, payloads[0].LogRecordCount())
+	assert.Equal(t, 2, payloads[1].LogRecordCount())
+}
+
+func TestPartitionKeySplitMetrics(t *testing.T) {
+	md := pmetric.NewMetrics()
+	for _, tenant := range []string{"acme", "other"} {
+		rm := md.ResourceMetrics().AppendEmpty()
+		rm.Resource().Attributes().PutStr("tenant", tenant)
+		rm.ScopeMetrics().AppendEmpty().Metrics().AppendEmpty().SetEmptyGauge().DataPoints().AppendEmpty()
+	}
+
+	keys, payloads := newPartitionKeyer(PartitionKeyConfig{Source: "template", Template: "%{tenant}"}).splitMetrics(md)
+	assert.Equal(t, []string{"acme", "other"}, keys)
+	for _, payload := range payloads {
+		assert.Equal(t, 1, payload.DataPointCount())
+	}
+}
+
+func TestTracesPusher_partition_key(t *testing.T) {
+	c := sarama.NewConfig()
+	producer := mocks.NewSyncProducer(t, c)
+	var keys []string
+	for i := 0; i < 2; i++ {
+		producer.ExpectSendMessageWithMessageCheckerFunctionAndSucceed(func(msg *sarama.ProducerMessage) error {
+			key, err := msg.Key.Encode()
+			keys = append(keys, string(key))
+			return err
+		})
+	}
+
+	p := kafkaTracesProducer{
+		producer:  producer,
+		marshaler: newPdataTracesMarshaler(&ptrace.ProtoMarshaler{}, defaultEncoding),
+		keyer:     newPartitionKeyer(PartitionKeyConfig{Source: "template", Template: "tenant-%{tenant}"}),
+	}
+	t.Cleanup(func() {
+		require.NoError(t, p.Close(context.Background()))
+	})
+	err := p.tracesPusher(context.Background(), generateTenantTraces())
+	require.NoError(t, err)
+	assert.Equal(t, []string{"tenant-acme", "tenant-other"}, keys)
+}
@@ -0,0 +1,140 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package kafkaexporter // import "github.com/open-telemetry/opentelemetry-collector-contrib/exporter/kafkaexporter"
+
+import (
+	"fmt"
+	"strings"
+
+	"go.opentelemetry.io/collector/pdata/pcommon"
+	"go.opentelemetry.io/collector/pdata/plog"
+	"go.opentelemetry.io/collector/pdata/pmetric"
+	"go.opentelemetry.io/collector/pdata/ptrace"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/batchpersignal"
+)
+
+// maxTopicLength is the maximum length of a Kafka topic name
+const maxTopicLength = 249
+
+// DynamicTopic defines how the topic of messages is resolved from the attributes of the data they contain.
+type DynamicTopic struct {
+	// Template of the topic. "{name}" is replaced by the value of the attribute name of the span or log record,
+	// or of its resource when the span or log record doesn't have it. Metrics are resolved from resource attributes only.
+	Template string `mapstructure:"template"`
+
+	// AllowedTopics are the topics the template can resolve to. All topics are allowed when empty.
+	AllowedTopics []string `mapstructure:"allowed_topics"`
+
+	// FallbackTopic is the topic of the data when an attribute of the template is missing, or the resolved
+	// topic is either not allowed or not a valid topic name. Defaults to topic.
+	FallbackTopic string `mapstructure:"fallback_topic"`
+}
+
+func (cfg *DynamicTopic) validate() error {
+	if cfg.Template == "" {
+		if len(cfg.AllowedTopics) > 0 || cfg.FallbackTopic != "" {
+			return fmt.Errorf("dynamic_topic::template is required when dynamic_topic is configured")
+		}
+		return nil
+	}
+	if _, err := parseTemplate(cfg.Template, "{"); err != nil {
+		return fmt.Errorf("dynamic_topic::template is invalid: %w", err)
+	}
+	for _, topic := range cfg.AllowedTopics {
+		if !isValidTopic(topic) {
+			return fmt.Errorf("dynamic_topic::allowed_topics contains invalid topic %q", topic)
+		}
+	}
+	if cfg.FallbackTopic != "" && !isValidTopic(cfg.FallbackTopic) {
+		return fmt.Errorf("dynamic_topic::fallback_topic %q is not a valid topic", cfg.FallbackTopic)
+	}
+	return nil
+}
+
+// isValidTopic reports whether topic is a legal Kafka topic name
+func isValidTopic(topic string) bool {
+	if topic == "" || topic == "." || topic == ".." || len(topic) > maxTopicLength {
+		return false
+	}
+	for _, c := range topic {
+		if (c < 'a' || c > 'z') && (c < 'A' || c > 'Z') && (c < '0' || c > '9') && c != '.' && c != '_' && c != '-' {
+			return false
+		}
+	}
+	return true
+}
+
+// topicResolver splits payloads by the topic resolved from their attributes
+type topicResolver struct {
+	template []keyTemplatePart
+	allowed  map[string]struct{}
+	fallback string
+}
+
+// newTopicResolver returns nil when the topic isn't resolved from attributes. Relies on config being validated.
+func newTopicResolver(cfg DynamicTopic, topic string) *topicResolver {
+	if cfg.Template == "" {
+		return nil
+	}
+	template, _ := parseTemplate(cfg.Template, "{")
+	r := &topicResolver{template: template, fallback: cfg.FallbackTopic}
+	if r.fallback == "" {
+		r.fallback = topic
+	}
+	if len(cfg.AllowedTopics) > 0 {
+		r.allowed = make(map[string]struct{}, len(cfg.AllowedTopics))
+		for _, allowed := range cfg.AllowedTopics {
+			r.allowed[allowed] = struct{}{}
+		}
+	}
+	return r
+}
+
+// resolve renders the template with the record attributes, falling back to the resource attributes.
+// The record attributes are empty for metrics.
+func (r *topicResolver) resolve(resource pcommon.Resource, attributes pcommon.Map) string {
+	var topic strings.Builder
+	for _, part := range r.template {
+		if !part.attribute {
+			topic.WriteString(part.value)
+			continue
+		}
+		value, ok := attributes.Get(part.value)
+		if !ok {
+			value, ok = resource.Attributes().Get(part.value)
+		}
+		if !ok || value.AsString() == "" {
+			return r.fallback
+		}
+		topic.WriteString(value.AsString())
+	}
+	resolved := topic.String()
+	if !isValidTopic(resolved) {
+		return r.fallback
+	}
+	if _, ok := r.allowed[resolved]; r.allowed != nil && !ok {
+		return r.fallback
+	}
+	return resolved
+}
+
+func (r *topicResolver) splitTraces(td ptrace.Traces) ([]string, []ptrace.Traces) {
+	return sortedByKey(batchpersignal.SplitTracesByKey(td, func(resource pcommon.Resource, _ pcommon.InstrumentationScope, span ptrace.Span) string {
+		return r.resolve(resource, span.Attributes())
+	}))
+}
+
+func (r *topicResolver) splitMetrics(md pmetric.Metrics) ([]string, []pmetric.Metrics) {
+	noAttributes := pcommon.NewMap()
+	return sortedByKey(batchpersignal.SplitMetricsByKey(md, func(resource pcommon.Resource, _ pcommon.InstrumentationScope, _ pmetric.Metric) string {
+		return r.resolve(resource, noAttributes)
+	}))
+}
+
+func (r *topicResolver) splitLogs(ld plog.Logs) ([]string, []plog.Logs) {
+	return sortedByKey(batchpersignal.SplitLogsByKey(ld, func(resource pcommon.Resource, _ pcommon.InstrumentationScope, record plog.LogRecord) string {
+		return r.resolve(resource, record.Attributes())
+	}))
+}
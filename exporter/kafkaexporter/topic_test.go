@@ -0,0 +1,151 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package kafkaexporter
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/IBM/sarama"
+	"github.com/IBM/sarama/mocks"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/collector/pdata/pcommon"
+	"go.opentelemetry.io/collector/pdata/plog"
+	"go.opentelemetry.io/collector/pdata/pmetric"
+)
+
+func TestDynamicTopicValidate(t *testing.T) {
+	tests := []struct {
+		name string
+		cfg  DynamicTopic
+		err  string
+	}{
+		{name: "disabled", cfg: DynamicTopic{}},
+		{name: "template", cfg: DynamicTopic{Template: "logs-{k8s.namespace.name}", AllowedTopics: []string{"logs-a"}, FallbackTopic: "logs"}},
+		{
+			name: "missing template",
+			cfg:  DynamicTopic{FallbackTopic: "logs"},
+			err:  "dynamic_topic::template is required when dynamic_topic is configured",
+		},
+		{
+			name: "invalid template",
+			cfg:  DynamicTopic{Template: "logs-{tenant"},
+			err:  `dynamic_topic::template is invalid: unclosed placeholder in "logs-{tenant"`,
+		},
+		{
+			name: "invalid allowed topic",
+			cfg:  DynamicTopic{Template: "logs-{tenant}", AllowedTopics: []string{"logs a"}},
+			err:  `dynamic_topic::allowed_topics contains invalid topic "logs a"`,
+		},
+		{
+			name: "invalid fallback topic",
+			cfg:  DynamicTopic{Template: "logs-{tenant}", FallbackTopic: "logs/other"},
+			err:  `dynamic_topic::fallback_topic "logs/other" is not a valid topic`,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.cfg.validate()
+			if tt.err == "" {
+				assert.NoError(t, err)
+			} else {
+				assert.EqualError(t, err, tt.err)
+			}
+		})
+	}
+}
+
+func TestValidate_dynamic_topic_conflict(t *testing.T) {
+	config := &Config{
+		Producer:           Producer{Compression: "none"},
+		TopicFromAttribute: "topic",
+		DynamicTopic:       DynamicTopic{Template: "logs-{tenant}"},
+	}
+	assert.EqualError(t, config.Validate(), "topic_from_attribute cannot be used with dynamic_topic")
+}
+
+func TestIsValidTopic(t *testing.T) {
+	assert.True(t, isValidTopic("logs-team_a.v1"))
+	assert.False(t, isValidTopic(""))
+	assert.False(t, isValidTopic(".."))
+	assert.False(t, isValidTopic("logs team"))
+	assert.False(t, isValidTopic(strings.Repeat("a", maxTopicLength+1)))
+}
+
+func TestTopicResolverResolve(t *testing.T) {
+	r := newTopicResolver(DynamicTopic{Template: "logs-{k8s.namespace.name}", AllowedTopics: []string{"logs-a", "logs-b"}}, "otlp_logs")
+	resource := pcommon.NewResource()
+	resource.Attributes().PutStr("k8s.namespace.name", "a")
+	attributes := pcommon.NewMap()
+
+	assert.Equal(t, "logs-a", r.resolve(resource, attributes))
+
+	attributes.PutStr("k8s.namespace.name", "b")
+	assert.Equal(t, "logs-b", r.resolve(resource, attributes), "record attributes take precedence")
+
+	attributes.PutStr("k8s.namespace.name", "c")
+	assert.Equal(t, "otlp_logs", r.resolve(resource, attributes), "topic not allowed")
+
+	attributes.PutStr("k8s.namespace.name", "a/b")
+	assert.Equal(t, "otlp_logs", r.resolve(resource, attributes), "invalid topic")
+
+	assert.Equal(t, "otlp_logs", r.resolve(pcommon.NewResource(), pcommon.NewMap()), "missing attribute")
+
+	r = newTopicResolver(DynamicTopic{Template: "logs-{k8s.namespace.name}", FallbackTopic: "logs-unknown"}, "otlp_logs")
+	assert.Equal(t, "logs-unknown", r.resolve(pcommon.NewResource(), pcommon.NewMap()))
+	assert.Nil(t, newTopicResolver(DynamicTopic{}, "otlp_logs"))
+}
+
+func TestTopicResolverSplitMetrics(t *testing.T) {
+	md := pmetric.NewMetrics()
+	for _, namespace := range []string{"b", "a", ""} {
+		rm := md.ResourceMetrics().AppendEmpty()
+		if namespace != "" {
+			rm.Resource().Attributes().PutStr("k8s.namespace.name", namespace)
+		}
+		rm.ScopeMetrics().AppendEmpty().Metrics().AppendEmpty().SetEmptyGauge().DataPoints().AppendEmpty()
+	}
+
+	r := newTopicResolver(DynamicTopic{Template: "metrics-{k8s.namespace.name}"}, "otlp_metrics")
+	topics, payloads := r.splitMetrics(md)
+	assert.Equal(t, []string{"metrics-a", "metrics-b", "otlp_metrics"}, topics)
+	for _, payload := range payloads {
+		assert.Equal(t, 1, payload.DataPointCount())
+	}
+}
+
+func TestLogsDataPusher_dynamic_topic(t *testing.T) {
+	c := sarama.NewConfig()
+	producer := mocks.NewSyncProducer(t, c)
+	var topics []string
+	for i := 0; i < 3; i++ {
+		producer.ExpectSendMessageWithMessageCheckerFunctionAndSucceed(func(msg *sarama.ProducerMessage) error {
+			topics = append(topics, msg.Topic)
+			return nil
+		})
+	}
+
+	ld := plog.NewLogs()
+	rl := ld.ResourceLogs().AppendEmpty()
+	rl.Resource().Attributes().PutStr("k8s.namespace.name", "a")
+	records := rl.ScopeLogs().AppendEmpty().LogRecords()
+	records.AppendEmpty()
+	records.AppendEmpty().Attributes().PutStr("k8s.namespace.name", "b")
+	records.AppendEmpty().Attributes().PutStr("k8s.namespace.name", "c")
+
+	p := kafkaLogsProducer{
+		cfg:       Config{Topic: "otlp_logs"},
+		producer:  producer,
+		marshaler: newPdataLogsMarshaler(&plog.ProtoMarshaler{}, defaultEncoding),
+		topics:    newTopicResolver(DynamicTopic{Template: "logs-{k8s.namespace.name}", AllowedTopics: []string{"logs-a", "logs-b"}}, "otlp_logs"),
+	}
+	t.Cleanup(func() {
+		require.NoError(t, p.Close(context.Background()))
+	})
+	err := p.logsDataPusher(context.Background(), ld)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"logs-a", "logs-b", "otlp_logs"}, topics)
+}
@@ -0,0 +1,119 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package loadbalancingexporter // import "github.com/open-telemetry/opentelemetry-collector-contrib/exporter/loadbalancingexporter"
+
+import (
+	"math"
+	"sync"
+	"time"
+)
+
+const (
+	defaultAdaptiveInterval  = 30 * time.Second
+	defaultAdaptiveMinWeight = 10
+
+	// costSmoothing is the weight of the latest interval in the smoothed cost of an endpoint, so that a
+	// single slow interval doesn't move the keys around
+	costSmoothing = 0.5
+	// weightStep is the granularity of the weights, avoiding rebuilding the ring for insignificant changes
+	weightStep = 10
+	// minSuccessRatio bounds the cost of endpoints failing all their exports
+	minSuccessRatio = 0.01
+)
+
+// exportStats accumulates the outcome of the exports to an endpoint since the previous weights update.
+type exportStats struct {
+	exports int64
+	errors  int64
+	latency time.Duration
+}
+
+// adaptiveWeighting derives the weights of the endpoints from their observed export latency and error rate.
+// The cost of an endpoint is its average latency divided by its success ratio, and its weight is inversely
+// proportional to its cost, relative to the cheapest endpoint.
+type adaptiveWeighting struct {
+	interval  time.Duration
+	minWeight int
+
+	mutex sync.Mutex
+	stats map[string]*exportStats
+	costs map[string]float64
+}
+
+func newAdaptiveWeighting(cfg AdaptiveWeightingConfig) *adaptiveWeighting {
+	aw := &adaptiveWeighting{
+		interval:  cfg.Interval,
+		minWeight: cfg.MinWeight * defaultWeight / 100,
+		stats:     map[string]*exportStats{},
+		costs:     map[string]float64{},
+	}
+	if aw.interval == 0 {
+		aw.interval = defaultAdaptiveInterval
+	}
+	if cfg.MinWeight == 0 {
+		aw.minWeight = defaultAdaptiveMinWeight * defaultWeight / 100
+	}
+	return aw
+}
+
+// observe records the outcome of an export to the given endpoint.
+func (aw *adaptiveWeighting) observe(endpoint string, latency time.Duration, err error) {
+	aw.mutex.Lock()
+	defer aw.mutex.Unlock()
+
+	stats, ok := aw.stats[endpoint]
+	if !ok {
+		stats = &exportStats{}
+		aw.stats[endpoint] = stats
+	}
+	stats.exports++
+	stats.latency += latency
+	if err != nil {
+		stats.errors++
+	}
+}
+
+// weights updates the cost of the given endpoints with the exports observed since the previous call, and returns
+// their new weights. Endpoints without any observed export keep their previous cost, or the default weight.
+func (aw *adaptiveWeighting) weights(endpoints []string) map[string]int {
+	aw.mutex.Lock()
+	defer aw.mutex.Unlock()
+
+	costs := make(map[string]float64, len(endpoints))
+	for _, endpoint := range endpoints {
+		cost, known := aw.costs[endpoint]
+		if stats, ok := aw.stats[endpoint]; ok && stats.exports > 0 {
+			latency := math.Max(float64(stats.latency.Milliseconds())/float64(stats.exports), 1)
+			successRatio := math.Max(1-float64(stats.errors)/float64(stats.exports), minSuccessRatio)
+			sample := latency / successRatio
+			if known {
+				sample = costSmoothing*sample + (1-costSmoothing)*cost
+			}
+			cost, known = sample, true
+		}
+		if known {
+			costs[endpoint] = cost
+		}
+	}
+	// forget the endpoints that aren't in the ring anymore
+	aw.costs = costs
+	aw.stats = map[string]*exportStats{}
+
+	lowest := math.Inf(1)
+	for _, cost := range costs {
+		lowest = math.Min(lowest, cost)
+	}
+
+	weights := make(map[string]int, len(endpoints))
+	for _, endpoint := range endpoints {
+		cost, ok := costs[endpoint]
+		if !ok {
+			weights[endpoint] = defaultWeight
+			continue
+		}
+		weight := int(math.Round(float64(defaultWeight)*lowest/cost/weightStep)) * weightStep
+		weights[endpoint] = max(weight, aw.minWeight)
+	}
+	return weights
+}
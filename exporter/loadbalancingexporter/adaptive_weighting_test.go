@@ -0,0 +1,92 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package loadbalancingexporter
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAdaptiveWeightingDefaults(t *testing.T) {
+	aw := newAdaptiveWeighting(AdaptiveWeightingConfig{})
+	assert.Equal(t, defaultAdaptiveInterval, aw.interval)
+	assert.Equal(t, 10, aw.minWeight)
+
+	aw = newAdaptiveWeighting(AdaptiveWeightingConfig{Interval: time.Second, MinWeight: 50})
+	assert.Equal(t, time.Second, aw.interval)
+	assert.Equal(t, 50, aw.minWeight)
+}
+
+func TestAdaptiveWeights(t *testing.T) {
+	endpoints := []string{"endpoint-1", "endpoint-2", "endpoint-3", "endpoint-4"}
+	errExport := errors.New("export failed")
+
+	for _, tt := range []struct {
+		desc     string
+		observe  func(aw *adaptiveWeighting)
+		expected map[string]int
+	}{
+		{
+			desc:     "no observations",
+			observe:  func(*adaptiveWeighting) {},
+			expected: map[string]int{"endpoint-1": 100, "endpoint-2": 100, "endpoint-3": 100, "endpoint-4": 100},
+		},
+		{
+			desc: "slower endpoints get less weight",
+			observe: func(aw *adaptiveWeighting) {
+				aw.observe("endpoint-1", 10*time.Millisecond, nil)
+				aw.observe("endpoint-2", 20*time.Millisecond, nil)
+				aw.observe("endpoint-3", 11*time.Millisecond, nil)
+				aw.observe("endpoint-3", 9*time.Millisecond, nil)
+			},
+			expected: map[string]int{"endpoint-1": 100, "endpoint-2": 50, "endpoint-3": 100, "endpoint-4": 100},
+		},
+		{
+			desc: "failing endpoints get less weight",
+			observe: func(aw *adaptiveWeighting) {
+				aw.observe("endpoint-1", 10*time.Millisecond, nil)
+				aw.observe("endpoint-2", 10*time.Millisecond, nil)
+				aw.observe("endpoint-2", 10*time.Millisecond, errExport)
+			},
+			expected: map[string]int{"endpoint-1": 100, "endpoint-2": 50, "endpoint-3": 100, "endpoint-4": 100},
+		},
+		{
+			desc: "weights don't go below the minimum",
+			observe: func(aw *adaptiveWeighting) {
+				aw.observe("endpoint-1", time.Millisecond, nil)
+				aw.observe("endpoint-2", time.Second, errExport)
+			},
+			expected: map[string]int{"endpoint-1": 100, "endpoint-2": 10, "endpoint-3": 100, "endpoint-4": 100},
+		},
+	} {
+		t.Run(tt.desc, func(t *testing.T) {
+			aw := newAdaptiveWeighting(AdaptiveWeightingConfig{})
+			tt.observe(aw)
+			assert.Equal(t, tt.expected, aw.weights(endpoints))
+		})
+	}
+}
+
+func TestAdaptiveWeightsSmoothing(t *testing.T) {
+	endpoints := []string{"endpoint-1", "endpoint-2"}
+	aw := newAdaptiveWeighting(AdaptiveWeightingConfig{})
+
+	aw.observe("endpoint-1", 10*time.Millisecond, nil)
+	aw.observe("endpoint-2", 40*time.Millisecond, nil)
+	assert.Equal(t, map[string]int{"endpoint-1": 100, "endpoint-2": 30}, aw.weights(endpoints))
+
+	// without new observations, the costs are kept
+	assert.Equal(t, map[string]int{"endpoint-1": 100, "endpoint-2": 30}, aw.weights(endpoints))
+
+	// a single fast interval only moves the cost halfway
+	aw.observe("endpoint-2", 10*time.Millisecond, nil)
+	assert.Equal(t, map[string]int{"endpoint-1": 100, "endpoint-2": 40}, aw.weights(endpoints))
+
+	// removed endpoints are forgotten
+	assert.Equal(t, map[string]int{"endpoint-1": 100}, aw.weights([]string{"endpoint-1"}))
+	assert.Equal(t, map[string]int{"endpoint-1": 100, "endpoint-2": 100}, aw.weights(endpoints))
+}
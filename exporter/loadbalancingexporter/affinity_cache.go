@@ -0,0 +1,93 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package loadbalancingexporter // import "github.com/open-telemetry/opentelemetry-collector-contrib/exporter/loadbalancingexporter"
+
+import (
+	"container/list"
+	"sync"
+	"time"
+)
+
+const (
+	defaultAffinityTTL     = time.Minute
+	defaultAffinityMaxKeys = 100000
+)
+
+type affinityEntry struct {
+	key       string
+	endpoints []string
+	expires   time.Time
+}
+
+// affinityCache is a bounded LRU cache of the endpoints the routing keys were sent to. The entries expire after
+// the TTL from the moment the key was routed, regardless of how often it's routed afterwards, so that all the
+// keys eventually move to the endpoints of the current ring.
+type affinityCache struct {
+	ttl     time.Duration
+	maxKeys int
+
+	mutex   sync.Mutex
+	entries map[string]*list.Element
+	lru     *list.List
+}
+
+func newAffinityCache(cfg AffinityCacheConfig) *affinityCache {
+	c := &affinityCache{
+		ttl:     cfg.TTL,
+		maxKeys: cfg.MaxKeys,
+		entries: map[string]*list.Element{},
+		lru:     list.New(),
+	}
+	if c.ttl == 0 {
+		c.ttl = defaultAffinityTTL
+	}
+	if c.maxKeys == 0 {
+		c.maxKeys = defaultAffinityMaxKeys
+	}
+	return c
+}
+
+// get returns the endpoints the key was routed to, if they haven't expired yet.
+func (c *affinityCache) get(key string) ([]string, bool) {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+
+	elem, ok := c.entries[key]
+	if !ok {
+		return nil, false
+	}
+	entry := elem.Value.(*affinityEntry)
+	if time.Now().After(entry.expires) {
+		c.lru.Remove(elem)
+		delete(c.entries, key)
+		return nil, false
+	}
+	c.lru.MoveToFront(elem)
+	return entry.endpoints, true
+}
+
+// add records the endpoints the key is routed to, evicting the least recently used key when the cache is full.
+func (c *affinityCache) add(key string, endpoints []string) {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+
+	entry := &affinityEntry{key: key, endpoints: endpoints, expires: time.Now().Add(c.ttl)}
+	if elem, ok := c.entries[key]; ok {
+		elem.Value = entry
+		c.lru.MoveToFront(elem)
+		return
+	}
+	c.entries[key] = c.lru.PushFront(entry)
+	if c.lru.Len() > c.maxKeys {
+		oldest := c.lru.Back()
+		c.lru.Remove(oldest)
+		delete(c.entries, oldest.Value.(*affinityEntry).key)
+	}
+}
+
+func (c *affinityCache) len() int {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+	return c.lru.Len()
+}
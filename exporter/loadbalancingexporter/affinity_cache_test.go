@@ -0,0 +1,48 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package loadbalancingexporter
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAffinityCacheDefaults(t *testing.T) {
+	c := newAffinityCache(AffinityCacheConfig{})
+	assert.Equal(t, defaultAffinityTTL, c.ttl)
+	assert.Equal(t, defaultAffinityMaxKeys, c.maxKeys)
+}
+
+func TestAffinityCacheEvictsLeastRecentlyUsed(t *testing.T) {
+	c := newAffinityCache(AffinityCacheConfig{MaxKeys: 2})
+
+	c.add("key-1", []string{"endpoint-1"})
+	c.add("key-2", []string{"endpoint-2"})
+	_, ok := c.get("key-1")
+	assert.True(t, ok)
+
+	c.add("key-3", []string{"endpoint-3"})
+	assert.Equal(t, 2, c.len())
+	_, ok = c.get("key-2")
+	assert.False(t, ok)
+	endpoints, ok := c.get("key-1")
+	assert.True(t, ok)
+	assert.Equal(t, []string{"endpoint-1"}, endpoints)
+}
+
+func TestAffinityCacheExpires(t *testing.T) {
+	c := newAffinityCache(AffinityCacheConfig{TTL: 50 * time.Millisecond})
+
+	c.add("key-1", []string{"endpoint-1"})
+	_, ok := c.get("key-1")
+	assert.True(t, ok)
+
+	// reading the key doesn't extend its TTL
+	time.Sleep(100 * time.Millisecond)
+	_, ok = c.get("key-1")
+	assert.False(t, ok)
+	assert.Equal(t, 0, c.len())
+}
@@ -35,6 +35,20 @@ func newHashRing(endpoints []string) *hashRing {
 	}
 }
 
+// newWeightedHashRing builds a new immutable consistent hash ring based on the given endpoints, where each
+// endpoint gets the number of positions from the weights, or the default weight when it's not listed.
+func newWeightedHashRing(endpoints []string, weights map[string]int) *hashRing {
+	items := weightedPositionsForEndpoints(endpoints, func(endpoint string) int {
+		if weight, ok := weights[endpoint]; ok {
+			return weight
+		}
+		return defaultWeight
+	})
+	return &hashRing{
+		items: items,
+	}
+}
+
 // endpointFor calculates which backend is responsible for the given traceID
 func (h *hashRing) endpointFor(identifier []byte) string {
 	if h == nil {
@@ -49,6 +63,34 @@ func (h *hashRing) endpointFor(identifier []byte) string {
 	return h.findEndpoint(position(pos))
 }
 
+// endpointsFor returns up to n distinct endpoints responsible for the given identifier: the one
+// returned by endpointFor, followed by the next distinct endpoints found walking the ring clockwise.
+func (h *hashRing) endpointsFor(identifier []byte, n int) []string {
+	if h == nil || len(h.items) == 0 {
+		return nil
+	}
+	hasher := crc32.NewIEEE()
+	hasher.Write(identifier)
+	pos := position(hasher.Sum32() % maxPositions)
+
+	// the first item at or after the position, wrapping around to the first item of the ring
+	start := sort.Search(len(h.items), func(i int) bool {
+		return h.items[i].pos >= pos
+	})
+
+	var endpoints []string
+	seen := map[string]bool{}
+	for i := 0; i < len(h.items) && len(endpoints) < n; i++ {
+		endpoint := h.items[(start+i)%len(h.items)].endpoint
+		if seen[endpoint] {
+			continue
+		}
+		seen[endpoint] = true
+		endpoints = append(endpoints, endpoint)
+	}
+	return endpoints
+}
+
 // findEndpoint returns the "next" endpoint starting from the given position, or an empty string in case no endpoints are available
 func (h *hashRing) findEndpoint(pos position) string {
 	ringSize := len(h.items)
@@ -115,11 +157,18 @@ func positionsFor(endpoint string, numPoints int) []position {
 
 // positionsForEndpoints calculates all the positions for all the given endpoints
 func positionsForEndpoints(endpoints []string, weight int) []ringItem {
+	return weightedPositionsForEndpoints(endpoints, func(string) int {
+		return weight
+	})
+}
+
+// weightedPositionsForEndpoints calculates all the positions for all the given endpoints, with the number of
+// positions of each endpoint given by weightFor
+func weightedPositionsForEndpoints(endpoints []string, weightFor func(endpoint string) int) []ringItem {
 	var items []ringItem
 	positions := map[position]bool{} // tracking the used positions
 	for _, endpoint := range endpoints {
-		// for this initial implementation, we don't allow endpoints to have custom weights
-		for _, pos := range positionsFor(endpoint, weight) {
+		for _, pos := range positionsFor(endpoint, weightFor(endpoint)) {
 			// if this position is occupied already, skip this item
 			if _, found := positions[pos]; found {
 				continue
@@ -141,6 +190,30 @@ func positionsForEndpoints(endpoints []string, weight int) []ringItem {
 	return items
 }
 
+// keyShare returns, for each endpoint, the fraction of the ring positions it is responsible for.
+// An item owns all the positions after the previous item, up to and including its own, with the
+// first item also owning the positions after the last one.
+func (h *hashRing) keyShare() map[string]float64 {
+	shares := map[string]float64{}
+	if h == nil || len(h.items) == 0 {
+		return shares
+	}
+	owned := map[string]uint32{}
+	prev := h.items[len(h.items)-1].pos
+	for _, item := range h.items {
+		if len(h.items) == 1 {
+			owned[item.endpoint] = maxPositions
+			break
+		}
+		owned[item.endpoint] += (uint32(item.pos) + maxPositions - uint32(prev)) % maxPositions
+		prev = item.pos
+	}
+	for endpoint, positions := range owned {
+		shares[endpoint] = float64(positions) / float64(maxPositions)
+	}
+	return shares
+}
+
 func (h *hashRing) equal(candidate *hashRing) bool {
 	if candidate == nil {
 		return false
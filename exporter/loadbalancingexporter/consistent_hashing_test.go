@@ -21,6 +21,18 @@ func TestNewHashRing(t *testing.T) {
 	assert.Len(t, ring.items, 2*defaultWeight)
 }
 
+func TestNewWeightedHashRing(t *testing.T) {
+	// prepare
+	endpoints := []string{"endpoint-1", "endpoint-2"}
+
+	// test
+	ring := newWeightedHashRing(endpoints, map[string]int{"endpoint-2": 30})
+
+	// verify
+	assert.Len(t, ring.items, defaultWeight+30)
+	assert.True(t, newWeightedHashRing(endpoints, nil).equal(newHashRing(endpoints)))
+}
+
 func TestEndpointFor(t *testing.T) {
 	// prepare
 	endpoints := []string{"endpoint-1", "endpoint-2"}
@@ -47,6 +59,30 @@ func TestEndpointFor(t *testing.T) {
 	}
 }
 
+func TestEndpointsFor(t *testing.T) {
+	// prepare
+	endpoints := []string{"endpoint-1", "endpoint-2", "endpoint-3"}
+	ring := newHashRing(endpoints)
+
+	for _, id := range [][]byte{{1, 2, 0, 0}, {128, 128, 0, 0}, []byte("ad-service-7"), []byte("get-recommendations-1")} {
+		t.Run(fmt.Sprintf("Endpoints for id %s", string(id)), func(t *testing.T) {
+			// test
+			replicas := ring.endpointsFor(id, 2)
+			all := ring.endpointsFor(id, 5)
+
+			// verify
+			assert.Len(t, replicas, 2)
+			assert.Equal(t, ring.endpointFor(id), replicas[0])
+			assert.NotEqual(t, replicas[0], replicas[1])
+			assert.ElementsMatch(t, endpoints, all)
+			assert.Equal(t, replicas, all[:2])
+		})
+	}
+
+	var empty *hashRing
+	assert.Empty(t, empty.endpointsFor([]byte("id"), 2))
+}
+
 func TestPositionsFor(t *testing.T) {
 	// prepare
 	endpoint := "host1"
@@ -219,3 +255,23 @@ func TestEqual(t *testing.T) {
 		})
 	}
 }
+
+func TestKeyShare(t *testing.T) {
+	// prepare
+	var nilRing *hashRing
+	ring := newHashRing([]string{"endpoint-1", "endpoint-2", "endpoint-3"})
+
+	// test
+	shares := ring.keyShare()
+
+	// verify
+	assert.Empty(t, nilRing.keyShare())
+	assert.Len(t, shares, 3)
+	total := 0.0
+	for _, share := range shares {
+		assert.Greater(t, share, 0.0)
+		total += share
+	}
+	assert.InDelta(t, 1.0, total, 1e-9)
+	assert.Equal(t, map[string]float64{"endpoint-1": 1}, newHashRing([]string{"endpoint-1"}).keyShare())
+}
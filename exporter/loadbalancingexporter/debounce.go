@@ -0,0 +1,81 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package loadbalancingexporter // import "github.com/open-telemetry/opentelemetry-collector-contrib/exporter/loadbalancingexporter"
+
+import (
+	"sync"
+	"time"
+)
+
+// debouncer delays the updates of the list of backends until it stops changing for the stabilization window, so
+// that the backends flapping during a rolling update, e.g. pods being replaced every few seconds, rebuild the ring
+// once instead of for every change. The first update isn't delayed, so that the data can be routed right away.
+type debouncer struct {
+	window     time.Duration
+	apply      func([]string)
+	onSuppress func()
+
+	mutex      sync.Mutex
+	started    bool
+	stopped    bool
+	pending    []string
+	hasPending bool
+	timer      *time.Timer
+	// generation identifies the latest update, so that the timers of the replaced updates are no-ops
+	generation int
+}
+
+func newDebouncer(window time.Duration, apply func([]string), onSuppress func()) *debouncer {
+	return &debouncer{window: window, apply: apply, onSuppress: onSuppress}
+}
+
+// update schedules the given backends to be applied once the window elapses without any other update. The update
+// replaces the pending one, if any, which is suppressed.
+func (d *debouncer) update(endpoints []string) {
+	d.mutex.Lock()
+	if d.stopped {
+		d.mutex.Unlock()
+		return
+	}
+	if !d.started {
+		d.started = true
+		d.mutex.Unlock()
+		d.apply(endpoints)
+		return
+	}
+	if d.hasPending {
+		d.timer.Stop()
+		d.onSuppress()
+	}
+	d.generation++
+	generation := d.generation
+	d.pending = endpoints
+	d.hasPending = true
+	d.timer = time.AfterFunc(d.window, func() { d.flush(generation) })
+	d.mutex.Unlock()
+}
+
+// flush applies the pending update, unless it was replaced by a later one.
+func (d *debouncer) flush(generation int) {
+	d.mutex.Lock()
+	if d.stopped || generation != d.generation {
+		d.mutex.Unlock()
+		return
+	}
+	endpoints := d.pending
+	d.pending, d.hasPending = nil, false
+	d.mutex.Unlock()
+	d.apply(endpoints)
+}
+
+// stop discards the pending update.
+func (d *debouncer) stop() {
+	d.mutex.Lock()
+	defer d.mutex.Unlock()
+	d.stopped = true
+	if d.timer != nil {
+		d.timer.Stop()
+	}
+	d.pending, d.hasPending = nil, false
+}
@@ -0,0 +1,76 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package loadbalancingexporter
+
+import (
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+type appliedUpdates struct {
+	mutex      sync.Mutex
+	updates    [][]string
+	suppressed int
+}
+
+func (a *appliedUpdates) apply(endpoints []string) {
+	a.mutex.Lock()
+	defer a.mutex.Unlock()
+	a.updates = append(a.updates, endpoints)
+}
+
+func (a *appliedUpdates) suppress() {
+	a.mutex.Lock()
+	defer a.mutex.Unlock()
+	a.suppressed++
+}
+
+func (a *appliedUpdates) get() ([][]string, int) {
+	a.mutex.Lock()
+	defer a.mutex.Unlock()
+	return append([][]string{}, a.updates...), a.suppressed
+}
+
+func TestDebouncer(t *testing.T) {
+	applied := &appliedUpdates{}
+	d := newDebouncer(50*time.Millisecond, applied.apply, applied.suppress)
+	defer d.stop()
+
+	// the first update is applied right away
+	d.update([]string{"endpoint-1", "endpoint-2"})
+	updates, _ := applied.get()
+	assert.Equal(t, [][]string{{"endpoint-1", "endpoint-2"}}, updates)
+
+	// a rolling update: the pods are replaced one by one
+	d.update([]string{"endpoint-2"})
+	d.update([]string{"endpoint-2", "endpoint-3"})
+	d.update([]string{"endpoint-3"})
+	d.update([]string{"endpoint-3", "endpoint-4"})
+
+	assert.Eventually(t, func() bool {
+		updates, _ := applied.get()
+		return len(updates) == 2
+	}, time.Second, 10*time.Millisecond)
+	updates, suppressed := applied.get()
+	assert.Equal(t, []string{"endpoint-3", "endpoint-4"}, updates[1])
+	assert.Equal(t, 3, suppressed)
+}
+
+func TestDebouncerStop(t *testing.T) {
+	applied := &appliedUpdates{}
+	d := newDebouncer(20*time.Millisecond, applied.apply, applied.suppress)
+
+	d.update([]string{"endpoint-1"})
+	d.update([]string{"endpoint-2"})
+	d.stop()
+	d.update([]string{"endpoint-3"})
+
+	time.Sleep(50 * time.Millisecond)
+	updates, suppressed := applied.get()
+	assert.Equal(t, [][]string{{"endpoint-1"}}, updates)
+	assert.Equal(t, 0, suppressed)
+}
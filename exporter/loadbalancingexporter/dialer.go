@@ -0,0 +1,140 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package loadbalancingexporter // import "github.com/open-telemetry/opentelemetry-collector-contrib/exporter/loadbalancingexporter"
+
+import (
+	"bufio"
+	"context"
+	"encoding/base64"
+	"fmt"
+	"net"
+	"net/http"
+	"net/url"
+	"strings"
+
+	"go.opentelemetry.io/collector/component"
+	"go.opentelemetry.io/collector/exporter"
+	"go.opentelemetry.io/collector/exporter/otlpexporter"
+	"golang.org/x/net/proxy"
+)
+
+// unixScheme is the scheme of the endpoints which are Unix domain sockets, e.g. unix:///var/run/otelcol.sock, which
+// the exporters connect to directly.
+const unixScheme = "unix:"
+
+// proxySchemes are the supported schemes of the proxy_url of the dialer.
+var proxySchemes = []string{"http", "socks5", "socks5h"}
+
+// isUnixEndpoint returns whether the endpoint is a Unix domain socket.
+func isUnixEndpoint(endpoint string) bool {
+	return strings.HasPrefix(endpoint, unixScheme)
+}
+
+// unixSocketPath returns the path of the socket of a Unix domain socket endpoint.
+func unixSocketPath(endpoint string) string {
+	return strings.TrimPrefix(strings.TrimPrefix(endpoint, unixScheme+"//"), unixScheme)
+}
+
+// createEndpointExporter creates the exporter of the endpoint with the given function. When a dialer is configured, the
+// connections to the endpoint are established through it instead, by an exporter of the given data type which, unlike
+// the ones of the otlp exporter factory, can be given a dialer.
+func createEndpointExporter(ctx context.Context, cfg *Config, params exporter.CreateSettings, endpoint string, dataType component.DataType, create func(exporter.CreateSettings, *otlpexporter.Config) (component.Component, error)) (component.Component, error) {
+	set := wrappedExporterSettings(params, endpoint)
+	oCfg := buildExporterConfig(cfg, endpoint)
+	if cfg.Dialer == nil || isUnixEndpoint(endpoint) {
+		return create(set, &oCfg)
+	}
+
+	dialer, err := newProxyDialer(*cfg.Dialer)
+	if err != nil {
+		return nil, err
+	}
+	return newDialerExporter(ctx, set, &oCfg, dialer, dataType)
+}
+
+// newProxyDialer returns the dialer connecting to the endpoints through the proxy of the dialer configuration.
+func newProxyDialer(cfg DialerConfig) (proxy.ContextDialer, error) {
+	proxyURL, err := url.Parse(cfg.ProxyURL)
+	if err != nil {
+		return nil, fmt.Errorf("dialer: invalid proxy_url: %w", err)
+	}
+	forward := &net.Dialer{Timeout: cfg.Timeout}
+	if proxyURL.Scheme == "http" {
+		return &httpConnectDialer{proxyURL: proxyURL, forward: forward}, nil
+	}
+	dialer, err := proxy.FromURL(proxyURL, forward)
+	if err != nil {
+		return nil, fmt.Errorf("dialer: %w", err)
+	}
+	contextDialer, ok := dialer.(proxy.ContextDialer)
+	if !ok {
+		return nil, fmt.Errorf("dialer: unsupported proxy_url scheme %q", proxyURL.Scheme)
+	}
+	return contextDialer, nil
+}
+
+// httpConnectDialer connects to the endpoints through an HTTP proxy supporting the CONNECT method.
+type httpConnectDialer struct {
+	proxyURL *url.URL
+	forward  *net.Dialer
+}
+
+func (d *httpConnectDialer) DialContext(ctx context.Context, network, address string) (net.Conn, error) {
+	proxyAddr := d.proxyURL.Host
+	if d.proxyURL.Port() == "" {
+		proxyAddr = net.JoinHostPort(d.proxyURL.Hostname(), "80")
+	}
+	conn, err := d.forward.DialContext(ctx, network, proxyAddr)
+	if err != nil {
+		return nil, err
+	}
+
+	req := &http.Request{
+		Method: http.MethodConnect,
+		URL:    &url.URL{Opaque: address},
+		Host:   address,
+		Header: http.Header{},
+	}
+	if user := d.proxyURL.User; user != nil {
+		password, _ := user.Password()
+		credentials := base64.StdEncoding.EncodeToString([]byte(user.Username() + ":" + password))
+		req.Header.Set("Proxy-Authorization", "Basic "+credentials)
+	}
+	// the handshake is bounded by the context, the connection being used by the exporter afterwards
+	stop := context.AfterFunc(ctx, func() { _ = conn.Close() })
+	defer stop()
+
+	if err = req.Write(conn); err != nil {
+		_ = conn.Close()
+		return nil, fmt.Errorf("failed to send the CONNECT request to the proxy: %w", err)
+	}
+	reader := bufio.NewReader(conn)
+	resp, err := http.ReadResponse(reader, req)
+	if err != nil {
+		_ = conn.Close()
+		return nil, fmt.Errorf("failed to read the CONNECT response of the proxy: %w", err)
+	}
+	_ = resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		_ = conn.Close()
+		return nil, fmt.Errorf("the proxy refused to connect to %q: %s", address, resp.Status)
+	}
+	if !stop() {
+		return nil, ctx.Err()
+	}
+	if reader.Buffered() > 0 {
+		return &bufferedConn{Conn: conn, reader: reader}, nil
+	}
+	return conn, nil
+}
+
+// bufferedConn is a connection whose first bytes were read ahead in its reader.
+type bufferedConn struct {
+	net.Conn
+	reader *bufio.Reader
+}
+
+func (c *bufferedConn) Read(b []byte) (int, error) {
+	return c.reader.Read(b)
+}
@@ -0,0 +1,200 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package loadbalancingexporter // import "github.com/open-telemetry/opentelemetry-collector-contrib/exporter/loadbalancingexporter"
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"runtime"
+	"time"
+
+	"go.opentelemetry.io/collector/component"
+	"go.opentelemetry.io/collector/consumer"
+	"go.opentelemetry.io/collector/consumer/consumererror"
+	"go.opentelemetry.io/collector/exporter"
+	"go.opentelemetry.io/collector/exporter/exporterhelper"
+	"go.opentelemetry.io/collector/exporter/otlpexporter"
+	"go.opentelemetry.io/collector/pdata/plog"
+	"go.opentelemetry.io/collector/pdata/plog/plogotlp"
+	"go.opentelemetry.io/collector/pdata/pmetric"
+	"go.opentelemetry.io/collector/pdata/pmetric/pmetricotlp"
+	"go.opentelemetry.io/collector/pdata/ptrace"
+	"go.opentelemetry.io/collector/pdata/ptrace/ptraceotlp"
+	"go.uber.org/zap"
+	"golang.org/x/net/proxy"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+// dialerExporter is the OTLP exporter of an endpoint whose connections are established through the dialer. It
+// behaves like the exporter of the otlp exporter factory, which can't be given a dialer.
+type dialerExporter struct {
+	config   *otlpexporter.Config
+	dialer   proxy.ContextDialer
+	settings component.TelemetrySettings
+
+	clientConn     *grpc.ClientConn
+	traceExporter  ptraceotlp.GRPCClient
+	metricExporter pmetricotlp.GRPCClient
+	logExporter    plogotlp.GRPCClient
+	metadata       metadata.MD
+	callOptions    []grpc.CallOption
+
+	userAgent string
+}
+
+// newDialerExporter creates the exporter of the given data type, connecting to the endpoint of the configuration
+// through the dialer.
+func newDialerExporter(ctx context.Context, set exporter.CreateSettings, oCfg *otlpexporter.Config, dialer proxy.ContextDialer, dataType component.DataType) (component.Component, error) {
+	e := &dialerExporter{
+		config:   oCfg,
+		dialer:   dialer,
+		settings: set.TelemetrySettings,
+		userAgent: fmt.Sprintf("%s/%s (%s/%s)",
+			set.BuildInfo.Description, set.BuildInfo.Version, runtime.GOOS, runtime.GOARCH),
+	}
+	options := []exporterhelper.Option{
+		exporterhelper.WithCapabilities(consumer.Capabilities{MutatesData: false}),
+		exporterhelper.WithTimeout(oCfg.TimeoutSettings),
+		exporterhelper.WithRetry(oCfg.RetryConfig),
+		exporterhelper.WithQueue(oCfg.QueueConfig),
+		exporterhelper.WithStart(e.start),
+		exporterhelper.WithShutdown(e.shutdown),
+	}
+
+	switch dataType {
+	case component.DataTypeTraces:
+		return exporterhelper.NewTracesExporter(ctx, set, oCfg, e.pushTraces, options...)
+	case component.DataTypeMetrics:
+		return exporterhelper.NewMetricsExporter(ctx, set, oCfg, e.pushMetrics, options...)
+	case component.DataTypeLogs:
+		return exporterhelper.NewLogsExporter(ctx, set, oCfg, e.pushLogs, options...)
+	default:
+		return nil, fmt.Errorf("unsupported data type %q", dataType)
+	}
+}
+
+func (e *dialerExporter) start(ctx context.Context, host component.Host) (err error) {
+	// the endpoint is passed through to the dialer as is, the proxy resolving it
+	clientCfg := e.config.ClientConfig
+	clientCfg.Endpoint = "passthrough:///" + clientCfg.Endpoint
+	dial := func(ctx context.Context, addr string) (net.Conn, error) {
+		return e.dialer.DialContext(ctx, "tcp", addr)
+	}
+	if e.clientConn, err = clientCfg.ToClientConn(ctx, host, e.settings, grpc.WithUserAgent(e.userAgent), grpc.WithContextDialer(dial)); err != nil {
+		return err
+	}
+	e.traceExporter = ptraceotlp.NewGRPCClient(e.clientConn)
+	e.metricExporter = pmetricotlp.NewGRPCClient(e.clientConn)
+	e.logExporter = plogotlp.NewGRPCClient(e.clientConn)
+	headers := map[string]string{}
+	for k, v := range e.config.ClientConfig.Headers {
+		headers[k] = string(v)
+	}
+	e.metadata = metadata.New(headers)
+	e.callOptions = []grpc.CallOption{
+		grpc.WaitForReady(e.config.ClientConfig.WaitForReady),
+	}
+	return nil
+}
+
+func (e *dialerExporter) shutdown(context.Context) error {
+	if e.clientConn != nil {
+		return e.clientConn.Close()
+	}
+	return nil
+}
+
+func (e *dialerExporter) pushTraces(ctx context.Context, td ptrace.Traces) error {
+	resp, respErr := e.traceExporter.Export(e.enhanceContext(ctx), ptraceotlp.NewExportRequestFromTraces(td), e.callOptions...)
+	if err := processGRPCError(respErr); err != nil {
+		return err
+	}
+	partialSuccess := resp.PartialSuccess()
+	if !(partialSuccess.ErrorMessage() == "" && partialSuccess.RejectedSpans() == 0) {
+		e.settings.Logger.Warn("Partial success response",
+			zap.String("message", partialSuccess.ErrorMessage()),
+			zap.Int64("dropped_spans", partialSuccess.RejectedSpans()),
+		)
+	}
+	return nil
+}
+
+func (e *dialerExporter) pushMetrics(ctx context.Context, md pmetric.Metrics) error {
+	resp, respErr := e.metricExporter.Export(e.enhanceContext(ctx), pmetricotlp.NewExportRequestFromMetrics(md), e.callOptions...)
+	if err := processGRPCError(respErr); err != nil {
+		return err
+	}
+	partialSuccess := resp.PartialSuccess()
+	if !(partialSuccess.ErrorMessage() == "" && partialSuccess.RejectedDataPoints() == 0) {
+		e.settings.Logger.Warn("Partial success response",
+			zap.String("message", partialSuccess.ErrorMessage()),
+			zap.Int64("dropped_data_points", partialSuccess.RejectedDataPoints()),
+		)
+	}
+	return nil
+}
+
+func (e *dialerExporter) pushLogs(ctx context.Context, ld plog.Logs) error {
+	resp, respErr := e.logExporter.Export(e.enhanceContext(ctx), plogotlp.NewExportRequestFromLogs(ld), e.callOptions...)
+	if err := processGRPCError(respErr); err != nil {
+		return err
+	}
+	partialSuccess := resp.PartialSuccess()
+	if !(partialSuccess.ErrorMessage() == "" && partialSuccess.RejectedLogRecords() == 0) {
+		e.settings.Logger.Warn("Partial success response",
+			zap.String("message", partialSuccess.ErrorMessage()),
+			zap.Int64("dropped_log_records", partialSuccess.RejectedLogRecords()),
+		)
+	}
+	return nil
+}
+
+func (e *dialerExporter) enhanceContext(ctx context.Context) context.Context {
+	if e.metadata.Len() > 0 {
+		return metadata.NewOutgoingContext(ctx, e.metadata)
+	}
+	return ctx
+}
+
+// processGRPCError classifies the error of an export the way the otlp exporter does: the errors the backend can
+// recover from are retried, after the delay it asked for if any, the others are permanent.
+func processGRPCError(err error) error {
+	if err == nil {
+		return nil
+	}
+	st := status.Convert(err)
+	if st.Code() == codes.OK {
+		return nil
+	}
+
+	var retryInfo *errdetails.RetryInfo
+	for _, detail := range st.Details() {
+		if info, ok := detail.(*errdetails.RetryInfo); ok {
+			retryInfo = info
+			break
+		}
+	}
+	switch st.Code() {
+	case codes.Canceled, codes.DeadlineExceeded, codes.Aborted, codes.OutOfRange, codes.Unavailable, codes.DataLoss:
+	case codes.ResourceExhausted:
+		// the backend can only recover from the exhaustion of its resources when it tells when to retry
+		if retryInfo == nil {
+			return consumererror.NewPermanent(err)
+		}
+	default:
+		return consumererror.NewPermanent(err)
+	}
+
+	if retryInfo != nil && retryInfo.RetryDelay != nil {
+		if delay := time.Duration(retryInfo.RetryDelay.Seconds)*time.Second + time.Duration(retryInfo.RetryDelay.Nanos)*time.Nanosecond; delay > 0 {
+			return exporterhelper.NewThrottleRetry(err, delay)
+		}
+	}
+	return err
+}
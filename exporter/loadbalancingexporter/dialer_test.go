@@ -0,0 +1,267 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package loadbalancingexporter
+
+import (
+	"bufio"
+	"context"
+	"encoding/base64"
+	"io"
+	"net"
+	"net/http"
+	"path/filepath"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/collector/component"
+	"go.opentelemetry.io/collector/component/componenttest"
+	"go.opentelemetry.io/collector/consumer/consumererror"
+	"go.opentelemetry.io/collector/exporter"
+	"go.opentelemetry.io/collector/exporter/exporterhelper"
+	"go.opentelemetry.io/collector/exporter/exportertest"
+	"go.opentelemetry.io/collector/exporter/otlpexporter"
+	"go.opentelemetry.io/collector/pdata/ptrace/ptraceotlp"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/durationpb"
+)
+
+func TestHTTPConnectDialer(t *testing.T) {
+	target := startEchoServer(t)
+	proxyAddr, requests := startConnectProxy(t, http.StatusOK)
+
+	dialer, err := newProxyDialer(DialerConfig{ProxyURL: "http://user:secret@" + proxyAddr, Timeout: time.Second})
+	require.NoError(t, err)
+	conn, err := dialer.DialContext(context.Background(), "tcp", target)
+	require.NoError(t, err)
+	defer conn.Close()
+
+	assertEcho(t, conn)
+	req := <-requests
+	assert.Equal(t, target, req.Host)
+	assert.Equal(t, "Basic "+base64.StdEncoding.EncodeToString([]byte("user:secret")), req.Header.Get("Proxy-Authorization"))
+}
+
+func TestHTTPConnectDialerRefused(t *testing.T) {
+	proxyAddr, _ := startConnectProxy(t, http.StatusProxyAuthRequired)
+
+	dialer, err := newProxyDialer(DialerConfig{ProxyURL: "http://" + proxyAddr})
+	require.NoError(t, err)
+	_, err = dialer.DialContext(context.Background(), "tcp", "backend:4317")
+	assert.ErrorContains(t, err, `the proxy refused to connect to "backend:4317": 407 Proxy Authentication Required`)
+}
+
+func TestConsumeTracesThroughDialer(t *testing.T) {
+	// prepare
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	receiver := &countingTracesReceiver{}
+	server := grpc.NewServer()
+	ptraceotlp.RegisterGRPCServer(server, receiver)
+	go func() {
+		_ = server.Serve(listener)
+	}()
+	defer server.Stop()
+	proxyAddr, requests := startConnectProxy(t, http.StatusOK)
+
+	cfg := createDefaultConfig().(*Config)
+	cfg.Resolver.Static = &StaticResolver{Hostnames: []string{listener.Addr().String()}}
+	cfg.Dialer = &DialerConfig{ProxyURL: "http://" + proxyAddr}
+	cfg.Protocol.OTLP.TLSSetting.Insecure = true
+	cfg.Protocol.OTLP.QueueConfig.Enabled = false
+	cfg.Protocol.OTLP.RetryConfig.Enabled = false
+
+	p, err := newTracesExporter(exportertest.NewNopCreateSettings(), cfg)
+	require.NoError(t, err)
+	require.NoError(t, p.Start(context.Background(), componenttest.NewNopHost()))
+	defer func() {
+		require.NoError(t, p.Shutdown(context.Background()))
+	}()
+
+	// test
+	err = p.ConsumeTraces(context.Background(), simpleTraces())
+
+	// verify: the backend is connected to through the proxy
+	require.NoError(t, err)
+	assert.Equal(t, int64(1), receiver.spans.Load())
+	req := <-requests
+	assert.Equal(t, listener.Addr().String(), req.Host)
+}
+
+func TestProcessGRPCError(t *testing.T) {
+	throttled, err := status.New(codes.ResourceExhausted, "slow down").WithDetails(&errdetails.RetryInfo{RetryDelay: durationpb.New(time.Second)})
+	require.NoError(t, err)
+
+	assert.NoError(t, processGRPCError(nil))
+	assert.NoError(t, processGRPCError(status.Error(codes.OK, "")))
+	assert.True(t, consumererror.IsPermanent(processGRPCError(status.Error(codes.InvalidArgument, "bad request"))))
+	assert.True(t, consumererror.IsPermanent(processGRPCError(status.Error(codes.ResourceExhausted, "exhausted"))))
+
+	unavailable := processGRPCError(status.Error(codes.Unavailable, "unavailable"))
+	require.Error(t, unavailable)
+	assert.False(t, consumererror.IsPermanent(unavailable))
+
+	throttleErr := processGRPCError(throttled.Err())
+	require.Error(t, throttleErr)
+	assert.False(t, consumererror.IsPermanent(throttleErr))
+	assert.Equal(t, exporterhelper.NewThrottleRetry(throttled.Err(), time.Second), throttleErr)
+}
+
+func TestCreateEndpointExporterUnixSocket(t *testing.T) {
+	cfg := createDefaultConfig().(*Config)
+	cfg.Dialer = &DialerConfig{ProxyURL: "socks5://proxy:1080"}
+
+	var created otlpexporter.Config
+	exp, err := createEndpointExporter(context.Background(), cfg, exportertest.NewNopCreateSettings(), "unix:///var/run/otelcol.sock", component.DataTypeTraces, func(_ exporter.CreateSettings, oCfg *otlpexporter.Config) (component.Component, error) {
+		created = *oCfg
+		return newNopMockTracesExporter(), nil
+	})
+	require.NoError(t, err)
+
+	// the sockets are connected to directly
+	assert.IsType(t, &mockTracesExporter{}, exp)
+	assert.Equal(t, "unix:///var/run/otelcol.sock", created.Endpoint)
+	assert.Empty(t, created.Authority)
+}
+
+func TestConsumeTracesUnixSocket(t *testing.T) {
+	// prepare
+	socket := filepath.Join(t.TempDir(), "otelcol.sock")
+	listener, err := net.Listen("unix", socket)
+	require.NoError(t, err)
+	receiver := &countingTracesReceiver{}
+	server := grpc.NewServer()
+	ptraceotlp.RegisterGRPCServer(server, receiver)
+	go func() {
+		_ = server.Serve(listener)
+	}()
+	defer server.Stop()
+
+	cfg := createDefaultConfig().(*Config)
+	cfg.Resolver.Static = &StaticResolver{Hostnames: []string{"unix://" + socket}}
+	cfg.Protocol.OTLP.TLSSetting.Insecure = true
+	cfg.Protocol.OTLP.QueueConfig.Enabled = false
+	cfg.Protocol.OTLP.RetryConfig.Enabled = false
+
+	p, err := newTracesExporter(exportertest.NewNopCreateSettings(), cfg)
+	require.NoError(t, err)
+	require.NoError(t, p.Start(context.Background(), componenttest.NewNopHost()))
+	defer func() {
+		require.NoError(t, p.Shutdown(context.Background()))
+	}()
+
+	// test
+	err = p.ConsumeTraces(context.Background(), simpleTraces())
+
+	// verify
+	require.NoError(t, err)
+	assert.Equal(t, int64(1), receiver.spans.Load())
+}
+
+type countingTracesReceiver struct {
+	ptraceotlp.UnimplementedGRPCServer
+	spans atomic.Int64
+}
+
+func (r *countingTracesReceiver) Export(_ context.Context, req ptraceotlp.ExportRequest) (ptraceotlp.ExportResponse, error) {
+	r.spans.Add(int64(req.Traces().SpanCount()))
+	return ptraceotlp.NewExportResponse(), nil
+}
+
+// startEchoServer starts a TCP server writing back what it reads, returning its address.
+func startEchoServer(t *testing.T) string {
+	return startTestServer(t, func(conn net.Conn) {
+		_, _ = io.Copy(conn, conn)
+	})
+}
+
+// startConnectProxy starts an HTTP proxy answering the CONNECT requests with the given status, and connecting to the
+// requested address when it's 200. It returns the address of the proxy, and the requests it received.
+func startConnectProxy(t *testing.T, status int) (string, <-chan *http.Request) {
+	requests := make(chan *http.Request, 10)
+	addr := startTestServer(t, func(conn net.Conn) {
+		req, err := http.ReadRequest(bufio.NewReader(conn))
+		if err != nil || req.Method != http.MethodConnect {
+			return
+		}
+		requests <- req
+		if status != http.StatusOK {
+			resp := &http.Response{StatusCode: status, ProtoMajor: 1, ProtoMinor: 1}
+			_ = resp.Write(conn)
+			return
+		}
+		upstream, err := net.Dial("tcp", req.Host)
+		if err != nil {
+			return
+		}
+		defer upstream.Close()
+		_, _ = io.WriteString(conn, "HTTP/1.1 200 Connection established\r\n\r\n")
+		done := make(chan struct{})
+		go func() {
+			defer close(done)
+			_, _ = io.Copy(upstream, conn)
+			_ = upstream.Close()
+		}()
+		_, _ = io.Copy(conn, upstream)
+		_ = conn.Close()
+		<-done
+	})
+	return addr, requests
+}
+
+// startTestServer starts a TCP server handling each connection it accepts, the connections being closed when the
+// test ends. It returns the address of the server.
+func startTestServer(t *testing.T, handle func(net.Conn)) string {
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+
+	var wg sync.WaitGroup
+	var lock sync.Mutex
+	var conns []net.Conn
+	t.Cleanup(func() {
+		_ = listener.Close()
+		lock.Lock()
+		for _, conn := range conns {
+			_ = conn.Close()
+		}
+		lock.Unlock()
+		wg.Wait()
+	})
+
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+		for {
+			conn, err := listener.Accept()
+			if err != nil {
+				return
+			}
+			lock.Lock()
+			conns = append(conns, conn)
+			lock.Unlock()
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+				defer conn.Close()
+				handle(conn)
+			}()
+		}
+	}()
+	return listener.Addr().String()
+}
+
+func assertEcho(t *testing.T, conn net.Conn) {
+	_, err := conn.Write([]byte("ping"))
+	require.NoError(t, err)
+	reply := make([]byte, 4)
+	_, err = io.ReadFull(conn, reply)
+	require.NoError(t, err)
+	assert.Equal(t, "ping", string(reply))
+}
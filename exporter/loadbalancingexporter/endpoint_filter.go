@@ -0,0 +1,111 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package loadbalancingexporter // import "github.com/open-telemetry/opentelemetry-collector-contrib/exporter/loadbalancingexporter"
+
+import (
+	"fmt"
+	"net"
+	"net/netip"
+	"regexp"
+	"strings"
+)
+
+// endpointFilter keeps the resolved endpoints matching the include patterns, if any, and none of the exclude
+// patterns. A pattern is either a CIDR block, matched against the IP address of the endpoint, or a regular
+// expression, matched against the whole endpoint.
+type endpointFilter struct {
+	include []endpointPattern
+	exclude []endpointPattern
+}
+
+type endpointPattern struct {
+	prefix netip.Prefix
+	regexp *regexp.Regexp
+}
+
+func newEndpointFilter(cfg EndpointFilterConfig) (*endpointFilter, error) {
+	if len(cfg.Include) == 0 && len(cfg.Exclude) == 0 {
+		return nil, nil
+	}
+	include, err := compileEndpointPatterns(cfg.Include)
+	if err != nil {
+		return nil, fmt.Errorf("include: %w", err)
+	}
+	exclude, err := compileEndpointPatterns(cfg.Exclude)
+	if err != nil {
+		return nil, fmt.Errorf("exclude: %w", err)
+	}
+	return &endpointFilter{include: include, exclude: exclude}, nil
+}
+
+func compileEndpointPatterns(patterns []string) ([]endpointPattern, error) {
+	compiled := make([]endpointPattern, 0, len(patterns))
+	for _, pattern := range patterns {
+		if prefix, err := netip.ParsePrefix(pattern); err == nil {
+			compiled = append(compiled, endpointPattern{prefix: prefix.Masked()})
+			continue
+		}
+		re, err := regexp.Compile(pattern)
+		if err != nil {
+			return nil, fmt.Errorf("%q is neither a CIDR block nor a valid regular expression: %w", pattern, err)
+		}
+		compiled = append(compiled, endpointPattern{regexp: re})
+	}
+	return compiled, nil
+}
+
+// filter returns the endpoints kept by the filter. A nil filter keeps all the endpoints.
+func (f *endpointFilter) filter(endpoints []string) []string {
+	if f == nil {
+		return endpoints
+	}
+	kept := make([]string, 0, len(endpoints))
+	for _, endpoint := range endpoints {
+		if len(f.include) > 0 && !matchesAny(f.include, endpoint) {
+			continue
+		}
+		if matchesAny(f.exclude, endpoint) {
+			continue
+		}
+		kept = append(kept, endpoint)
+	}
+	return kept
+}
+
+func matchesAny(patterns []endpointPattern, endpoint string) bool {
+	for _, pattern := range patterns {
+		if pattern.matches(endpoint) {
+			return true
+		}
+	}
+	return false
+}
+
+func (p endpointPattern) matches(endpoint string) bool {
+	if p.regexp != nil {
+		return p.regexp.MatchString(endpoint)
+	}
+	addr, ok := endpointAddr(endpoint)
+	if !ok {
+		// hostnames aren't matched by the CIDR blocks
+		return false
+	}
+	return p.prefix.Contains(addr)
+}
+
+// endpointAddr returns the IP address of the endpoint, which might have a port, or be a bracketed IPv6 address
+// without port, as returned by the DNS resolver. It returns false for the hostnames.
+func endpointAddr(endpoint string) (netip.Addr, bool) {
+	host := endpoint
+	if h, _, err := net.SplitHostPort(endpoint); err == nil {
+		host = h
+	} else if strings.HasPrefix(endpoint, "[") && strings.HasSuffix(endpoint, "]") {
+		host = endpoint[1 : len(endpoint)-1]
+	}
+	addr, err := netip.ParseAddr(host)
+	if err != nil {
+		return netip.Addr{}, false
+	}
+	return addr.Unmap(), true
+}
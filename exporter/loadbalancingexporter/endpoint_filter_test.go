@@ -0,0 +1,61 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package loadbalancingexporter
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestEndpointFilter(t *testing.T) {
+	endpoints := []string{
+		"10.0.0.1:4317",
+		"10.1.0.1:4317",
+		"10.1.0.2",
+		"[fd00::1]:4317",
+		"collector-0.collector:4317",
+		"collector-canary-0.collector:4317",
+	}
+	for _, tt := range []struct {
+		desc     string
+		cfg      EndpointFilterConfig
+		expected []string
+	}{
+		{
+			desc:     "exclude a subnet",
+			cfg:      EndpointFilterConfig{Exclude: []string{"10.1.0.0/16"}},
+			expected: []string{"10.0.0.1:4317", "[fd00::1]:4317", "collector-0.collector:4317", "collector-canary-0.collector:4317"},
+		},
+		{
+			desc:     "exclude the canaries",
+			cfg:      EndpointFilterConfig{Exclude: []string{"-canary-"}},
+			expected: []string{"10.0.0.1:4317", "10.1.0.1:4317", "10.1.0.2", "[fd00::1]:4317", "collector-0.collector:4317"},
+		},
+		{
+			desc:     "include IPv6 subnet and hostnames",
+			cfg:      EndpointFilterConfig{Include: []string{"fd00::/8", `\.collector:`}},
+			expected: []string{"[fd00::1]:4317", "collector-0.collector:4317", "collector-canary-0.collector:4317"},
+		},
+		{
+			desc:     "include and exclude",
+			cfg:      EndpointFilterConfig{Include: []string{"10.0.0.0/8"}, Exclude: []string{"10.1.0.2"}},
+			expected: []string{"10.0.0.1:4317", "10.1.0.1:4317"},
+		},
+	} {
+		t.Run(tt.desc, func(t *testing.T) {
+			f, err := newEndpointFilter(tt.cfg)
+			require.NoError(t, err)
+			assert.Equal(t, tt.expected, f.filter(endpoints))
+		})
+	}
+}
+
+func TestNoEndpointFilter(t *testing.T) {
+	f, err := newEndpointFilter(EndpointFilterConfig{})
+	require.NoError(t, err)
+	assert.Nil(t, f)
+	assert.Equal(t, []string{"endpoint-1"}, f.filter([]string{"endpoint-1"}))
+}
@@ -0,0 +1,136 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package loadbalancingexporter // import "github.com/open-telemetry/opentelemetry-collector-contrib/exporter/loadbalancingexporter"
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+)
+
+const (
+	defaultEndpointQueueSize    = 100
+	defaultEndpointQueueWorkers = 1
+)
+
+var (
+	errEndpointQueueFull   = errors.New("the queue of the endpoint is full")
+	errEndpointQueueClosed = errors.New("the queue of the endpoint is closed")
+)
+
+// endpointQueue holds the exports routed to an endpoint until the workers of the endpoint run them, so that a slow
+// or failing endpoint only delays the exports routed to it instead of the ones to the other endpoints.
+type endpointQueue struct {
+	exports chan func()
+	workers sync.WaitGroup
+
+	// lock prevents the exports from being queued while the queue is closed
+	lock   sync.RWMutex
+	closed bool
+}
+
+func newEndpointQueue(cfg EndpointQueueConfig) *endpointQueue {
+	size := cfg.Size
+	if size == 0 {
+		size = defaultEndpointQueueSize
+	}
+	workers := cfg.Workers
+	if workers == 0 {
+		workers = defaultEndpointQueueWorkers
+	}
+
+	q := &endpointQueue{exports: make(chan func(), size)}
+	q.workers.Add(workers)
+	for i := 0; i < workers; i++ {
+		go func() {
+			defer q.workers.Done()
+			for export := range q.exports {
+				export()
+			}
+		}()
+	}
+	return q
+}
+
+// offer queues the export, waiting for room in the queue until the context is done.
+func (q *endpointQueue) offer(ctx context.Context, export func()) error {
+	q.lock.RLock()
+	defer q.lock.RUnlock()
+	if q.closed {
+		return errEndpointQueueClosed
+	}
+	// the export is queued when there's room, even if the context is done
+	select {
+	case q.exports <- export:
+		return nil
+	default:
+	}
+	select {
+	case q.exports <- export:
+		return nil
+	case <-ctx.Done():
+		return fmt.Errorf("%w: %w", errEndpointQueueFull, context.Cause(ctx))
+	}
+}
+
+// close stops the workers once the queued exports are run. Closing the queue again has no effect.
+func (q *endpointQueue) close() {
+	q.lock.Lock()
+	if !q.closed {
+		q.closed = true
+		close(q.exports)
+	}
+	q.lock.Unlock()
+	q.workers.Wait()
+}
+
+// exportTo starts the export of the given number of items to the endpoint of the exporter, which must have been
+// accounted for in the consumeWG of the exporter, and returns the channel receiving its outcome. When the endpoint
+// has a queue, the export is run by the workers of the endpoint, so that the exports of a request to its endpoints
+// run concurrently and a slow endpoint doesn't hold back the others. While all the workers of the endpoint are busy
+// and its queue is full, the request waits for room in the queue.
+func (lb *loadBalancer) exportTo(ctx context.Context, outgoingCtx context.Context, exp *wrappedExporter, endpoint string, items int, export func(context.Context) error) <-chan error {
+	result := make(chan error, 1)
+	if exp.queue == nil {
+		defer exp.consumeWG.Done()
+		result <- lb.exportNow(ctx, outgoingCtx, endpoint, items, export)
+		return result
+	}
+
+	queued := func() {
+		defer exp.consumeWG.Done()
+		// the request may have been canceled while its export was queued
+		if err := ctx.Err(); err != nil {
+			result <- err
+			return
+		}
+		result <- lb.exportNow(ctx, outgoingCtx, endpoint, items, export)
+	}
+	// waiting for room in the queue of the endpoint doesn't hold back the exports to the other endpoints
+	go func() {
+		if err := exp.queue.offer(ctx, queued); err != nil {
+			exp.consumeWG.Done()
+			result <- err
+		}
+	}()
+	return result
+}
+
+// exportNow runs the export of the given number of items to the endpoint, recording its span and outcome.
+func (lb *loadBalancer) exportNow(ctx context.Context, outgoingCtx context.Context, endpoint string, items int, export func(context.Context) error) error {
+	exportCtx, span := lb.startExportSpan(outgoingCtx, endpoint, items)
+	lb.exportStarted(ctx, endpoint)
+	start := time.Now()
+	err := export(exportCtx)
+	endSpan(span, err)
+	duration := time.Since(start)
+
+	lb.observe(ctx, endpoint, duration, err)
+	attrs := endpointAttrs(endpoint, err == nil)
+	lb.telemetry.LoadbalancerBackendLatency.Record(ctx, duration.Milliseconds(), attrs)
+	lb.telemetry.LoadbalancerBackendOutcome.Add(ctx, 1, attrs)
+	return err
+}
@@ -0,0 +1,188 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package loadbalancingexporter
+
+import (
+	"context"
+	"errors"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/collector/component"
+	"go.opentelemetry.io/collector/component/componenttest"
+	"go.opentelemetry.io/collector/consumer/consumererror"
+	"go.opentelemetry.io/collector/exporter/exportertest"
+	"go.opentelemetry.io/collector/pdata/ptrace"
+	conventions "go.opentelemetry.io/collector/semconv/v1.9.0"
+)
+
+func TestEndpointQueue(t *testing.T) {
+	q := newEndpointQueue(EndpointQueueConfig{Size: 1})
+	ran := make(chan struct{})
+	release := make(chan struct{})
+	require.NoError(t, q.offer(context.Background(), func() {
+		close(ran)
+		<-release
+	}))
+	<-ran
+
+	// the worker is busy, so the queue holds a single export, the next one waiting for room in vain
+	var queued atomic.Bool
+	assert.NoError(t, q.offer(context.Background(), func() { queued.Store(true) }))
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+	assert.ErrorIs(t, q.offer(ctx, func() {}), errEndpointQueueFull)
+
+	close(release)
+	q.close()
+	assert.True(t, queued.Load())
+	assert.ErrorIs(t, q.offer(context.Background(), func() {}), errEndpointQueueClosed)
+}
+
+func TestConsumeTracesEndpointQueue(t *testing.T) {
+	// prepare
+	cfg := simpleConfig()
+	cfg.RoutingKey = "service"
+	cfg.RoutingTable = map[string]string{"slow-service": "slow", "fast-service": "fast"}
+	cfg.EndpointQueue = &EndpointQueueConfig{Size: 1}
+
+	slowStarted := make(chan struct{}, 10)
+	release := make(chan struct{})
+	var slowSpans, fastSpans atomic.Int64
+	componentFactory := func(_ context.Context, endpoint string) (component.Component, error) {
+		switch endpoint {
+		case "slow:4317":
+			return newMockTracesExporter(func(_ context.Context, td ptrace.Traces) error {
+				slowStarted <- struct{}{}
+				<-release
+				slowSpans.Add(int64(td.SpanCount()))
+				return nil
+			}), nil
+		case "fast:4317":
+			return newMockTracesExporter(func(_ context.Context, td ptrace.Traces) error {
+				fastSpans.Add(int64(td.SpanCount()))
+				return nil
+			}), nil
+		default:
+			return newNopMockTracesExporter(), nil
+		}
+	}
+	lb, err := newLoadBalancer(exportertest.NewNopCreateSettings(), cfg, componentFactory)
+	require.NotNil(t, lb)
+	require.NoError(t, err)
+
+	p, err := newTracesExporter(exportertest.NewNopCreateSettings(), cfg)
+	require.NotNil(t, p)
+	require.NoError(t, err)
+
+	lb.res = &mockResolver{
+		triggerCallbacks: true,
+		onResolve: func(_ context.Context) ([]string, error) {
+			return []string{"endpoint-1"}, nil
+		},
+	}
+	p.loadBalancer = lb
+
+	require.NoError(t, p.Start(context.Background(), componenttest.NewNopHost()))
+
+	// test
+	// the fast endpoint gets its data while the export to the slow endpoint is blocked
+	consumed := make(chan error, 2)
+	go func() {
+		consumed <- p.ConsumeTraces(context.Background(), tracesOfServices("slow-service", "fast-service"))
+	}()
+	<-slowStarted
+	assert.Eventually(t, func() bool { return fastSpans.Load() == 1 }, time.Second, 10*time.Millisecond)
+
+	// the queue of the slow endpoint holds a single export, the next request waiting for room until it's canceled
+	go func() {
+		consumed <- p.ConsumeTraces(context.Background(), tracesOfServices("slow-service", "fast-service"))
+	}()
+	assert.Eventually(t, func() bool { return fastSpans.Load() == 2 }, time.Second, 10*time.Millisecond)
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+	err = p.ConsumeTraces(ctx, tracesOfServices("slow-service", "fast-service"))
+
+	// verify
+	require.Error(t, err)
+	assert.ErrorIs(t, err, errEndpointQueueFull)
+	var exportErr *ExportError
+	require.ErrorAs(t, err, &exportErr)
+	assert.True(t, exportErr.Partial)
+	require.Len(t, exportErr.Endpoints, 1)
+	assert.Equal(t, "slow:4317", exportErr.Endpoints[0].Endpoint)
+
+	// only the data of the slow endpoint is rejected
+	var tracesErr consumererror.Traces
+	require.ErrorAs(t, err, &tracesErr)
+	failed := tracesErr.Data()
+	require.Equal(t, 1, failed.ResourceSpans().Len())
+	service, _ := failed.ResourceSpans().At(0).Resource().Attributes().Get(conventions.AttributeServiceName)
+	assert.Equal(t, "slow-service", service.Str())
+	assert.Equal(t, int64(3), fastSpans.Load())
+
+	// the waiting requests complete once the slow endpoint gets their data
+	close(release)
+	assert.NoError(t, <-consumed)
+	assert.NoError(t, <-consumed)
+	assert.Equal(t, int64(2), slowSpans.Load())
+	require.NoError(t, p.Shutdown(context.Background()))
+}
+
+func TestConsumeTracesEndpointQueueFailure(t *testing.T) {
+	// prepare
+	cfg := simpleConfig()
+	cfg.EndpointQueue = &EndpointQueueConfig{}
+
+	exported := make(chan struct{})
+	componentFactory := func(_ context.Context, _ string) (component.Component, error) {
+		return newMockTracesExporter(func(context.Context, ptrace.Traces) error {
+			defer close(exported)
+			return errors.New("backend unavailable")
+		}), nil
+	}
+	lb, err := newLoadBalancer(exportertest.NewNopCreateSettings(), cfg, componentFactory)
+	require.NotNil(t, lb)
+	require.NoError(t, err)
+
+	p, err := newTracesExporter(exportertest.NewNopCreateSettings(), cfg)
+	require.NotNil(t, p)
+	require.NoError(t, err)
+
+	lb.res = &mockResolver{
+		triggerCallbacks: true,
+		onResolve: func(_ context.Context) ([]string, error) {
+			return []string{"endpoint-1"}, nil
+		},
+	}
+	p.loadBalancer = lb
+
+	require.NoError(t, p.Start(context.Background(), componenttest.NewNopHost()))
+	defer func() {
+		require.NoError(t, p.Shutdown(context.Background()))
+	}()
+
+	// test
+	err = p.ConsumeTraces(context.Background(), simpleTraces())
+
+	// verify: the failure of the queued export is reported to the caller
+	<-exported
+	assert.ErrorContains(t, err, "backend unavailable")
+	var tracesErr consumererror.Traces
+	require.ErrorAs(t, err, &tracesErr)
+	assert.Equal(t, 1, tracesErr.Data().SpanCount())
+}
+
+func tracesOfServices(services ...string) ptrace.Traces {
+	traces := ptrace.NewTraces()
+	for i, service := range services {
+		rs := traces.ResourceSpans().AppendEmpty()
+		rs.Resource().Attributes().PutStr(conventions.AttributeServiceName, service)
+		rs.ScopeSpans().AppendEmpty().Spans().AppendEmpty().SetTraceID([16]byte{1, 2, 3, byte(i)})
+	}
+	return traces
+}
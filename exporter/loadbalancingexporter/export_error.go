@@ -0,0 +1,103 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package loadbalancingexporter // import "github.com/open-telemetry/opentelemetry-collector-contrib/exporter/loadbalancingexporter"
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/metric"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/exporter/loadbalancingexporter/internal/metadata"
+)
+
+const partialTagKey = "partial"
+
+// ExportError is the error of a request whose export failed for some of its endpoints. It's wrapped in the
+// consumererror of the signal, which holds the data to retry, and can be retrieved with errors.As.
+type ExportError struct {
+	// Partial is true when the request was exported to some of its endpoints, and false when all of its exports
+	// failed.
+	Partial bool
+
+	// Endpoints holds the failed exports, ordered by endpoint.
+	Endpoints []EndpointError
+}
+
+// EndpointError is the error of the exports of a request to an endpoint.
+type EndpointError struct {
+	Endpoint string
+
+	// FailedItems is the number of spans, data points or log records which couldn't be exported to the endpoint.
+	FailedItems int
+
+	Err error
+}
+
+func (e *ExportError) Error() string {
+	failures := make([]string, len(e.Endpoints))
+	for i, endpoint := range e.Endpoints {
+		failures[i] = fmt.Sprintf("%s (%d items): %v", endpoint.Endpoint, endpoint.FailedItems, endpoint.Err)
+	}
+	kind := "total"
+	if e.Partial {
+		kind = "partial"
+	}
+	return fmt.Sprintf("%s export failure: %s", kind, strings.Join(failures, "; "))
+}
+
+// Unwrap returns the errors of the endpoints, so that e.g. the permanent errors can still be detected.
+func (e *ExportError) Unwrap() []error {
+	errs := make([]error, len(e.Endpoints))
+	for i, endpoint := range e.Endpoints {
+		errs[i] = endpoint.Err
+	}
+	return errs
+}
+
+// exportFailures accounts for the failed exports of a request to its endpoints.
+type exportFailures struct {
+	succeeded int
+	endpoints map[string]*EndpointError
+}
+
+// record accounts for the export of the given number of items to the endpoint.
+func (f *exportFailures) record(endpoint string, items int, err error) {
+	if err == nil {
+		f.succeeded++
+		return
+	}
+	if f.endpoints == nil {
+		f.endpoints = make(map[string]*EndpointError)
+	}
+	failure, ok := f.endpoints[endpoint]
+	if !ok {
+		f.endpoints[endpoint] = &EndpointError{Endpoint: endpoint, FailedItems: items, Err: err}
+		return
+	}
+	failure.FailedItems += items
+	failure.Err = fmt.Errorf("%w; %w", failure.Err, err)
+}
+
+// finish records the failed items of each endpoint, and returns the error of the request, if any export failed.
+func (f *exportFailures) finish(ctx context.Context, telemetry *metadata.TelemetryBuilder) error {
+	if len(f.endpoints) == 0 {
+		return nil
+	}
+	exportErr := &ExportError{Partial: f.succeeded > 0, Endpoints: make([]EndpointError, 0, len(f.endpoints))}
+	for _, failure := range f.endpoints {
+		exportErr.Endpoints = append(exportErr.Endpoints, *failure)
+		telemetry.LoadbalancerBackendFailedItems.Add(ctx, int64(failure.FailedItems), metric.WithAttributes(
+			attribute.String(endpointTagKey, failure.Endpoint),
+			attribute.Bool(partialTagKey, exportErr.Partial),
+		))
+	}
+	sort.Slice(exportErr.Endpoints, func(i, j int) bool {
+		return exportErr.Endpoints[i].Endpoint < exportErr.Endpoints[j].Endpoint
+	})
+	return exportErr
+}
@@ -0,0 +1,86 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package loadbalancingexporter
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/collector/consumer/consumererror"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/sdk/metric/metricdata"
+	"go.opentelemetry.io/otel/sdk/metric/metricdata/metricdatatest"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/exporter/loadbalancingexporter/internal/metadata"
+)
+
+func TestExportFailures(t *testing.T) {
+	tt := setupTestTelemetry()
+	defer func() { require.NoError(t, tt.Shutdown(context.Background())) }()
+	tb, err := metadata.NewTelemetryBuilder(tt.NewCreateSettings().TelemetrySettings)
+	require.NoError(t, err)
+
+	var failures exportFailures
+	failures.record("endpoint-2:4317", 3, errors.New("unavailable"))
+	failures.record("endpoint-1:4317", 5, nil)
+	failures.record("endpoint-3:4317", 1, consumererror.NewPermanent(errors.New("invalid")))
+	failures.record("endpoint-2:4317", 4, errors.New("timeout"))
+
+	err = failures.finish(context.Background(), tb)
+
+	var exportErr *ExportError
+	require.ErrorAs(t, err, &exportErr)
+	assert.True(t, exportErr.Partial)
+	require.Len(t, exportErr.Endpoints, 2)
+	assert.Equal(t, "endpoint-2:4317", exportErr.Endpoints[0].Endpoint)
+	assert.Equal(t, 7, exportErr.Endpoints[0].FailedItems)
+	assert.Equal(t, "endpoint-3:4317", exportErr.Endpoints[1].Endpoint)
+	assert.Equal(t, 1, exportErr.Endpoints[1].FailedItems)
+	assert.EqualError(t, err, "partial export failure: endpoint-2:4317 (7 items): unavailable; timeout; endpoint-3:4317 (1 items): Permanent error: invalid")
+	assert.True(t, consumererror.IsPermanent(err))
+
+	var md metricdata.ResourceMetrics
+	require.NoError(t, tt.reader.Collect(context.Background(), &md))
+	metricdatatest.AssertEqual(t, metricdata.Metrics{
+		Name:        "loadbalancer_backend_failed_items",
+		Description: "Number of spans, data points or log records that failed to be exported to each endpoint, for the partially and the totally failed requests.",
+		Unit:        "{items}",
+		Data: metricdata.Sum[int64]{
+			Temporality: metricdata.CumulativeTemporality,
+			IsMonotonic: true,
+			DataPoints: []metricdata.DataPoint[int64]{
+				{
+					Value:      7,
+					Attributes: attribute.NewSet(attribute.String(endpointTagKey, "endpoint-2:4317"), attribute.Bool(partialTagKey, true)),
+				},
+				{
+					Value:      1,
+					Attributes: attribute.NewSet(attribute.String(endpointTagKey, "endpoint-3:4317"), attribute.Bool(partialTagKey, true)),
+				},
+			},
+		},
+	}, tt.getMetric("loadbalancer_backend_failed_items", md), metricdatatest.IgnoreTimestamp(), metricdatatest.IgnoreExemplars())
+}
+
+func TestExportFailuresTotal(t *testing.T) {
+	var failures exportFailures
+	failures.record("endpoint-1:4317", 2, errors.New("unavailable"))
+
+	err := failures.finish(context.Background(), newTestTelemetryBuilder(t))
+
+	var exportErr *ExportError
+	require.ErrorAs(t, err, &exportErr)
+	assert.False(t, exportErr.Partial)
+	assert.EqualError(t, err, "total export failure: endpoint-1:4317 (2 items): unavailable")
+}
+
+func TestNoExportFailures(t *testing.T) {
+	var failures exportFailures
+	failures.record("endpoint-1:4317", 2, nil)
+
+	assert.NoError(t, failures.finish(context.Background(), newTestTelemetryBuilder(t)))
+}
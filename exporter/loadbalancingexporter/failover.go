@@ -0,0 +1,107 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package loadbalancingexporter // import "github.com/open-telemetry/opentelemetry-collector-contrib/exporter/loadbalancingexporter"
+
+import (
+	"sort"
+	"sync"
+	"time"
+
+	"go.uber.org/zap"
+)
+
+const (
+	modeLoadBalancing = "loadbalancing"
+	modeFailover      = "failover"
+
+	defaultFailbackAfter = time.Minute
+)
+
+// failover sends all the data to the first healthy endpoint, in order of priority. An endpoint is unhealthy for
+// the failback period after its latest failed export, after which it gets the traffic back.
+type failover struct {
+	logger        *zap.Logger
+	failbackAfter time.Duration
+	// priority is the position of the endpoints in the static resolver configuration, the endpoints of the other
+	// resolvers are ordered alphabetically
+	priority map[string]int
+
+	mutex     sync.Mutex
+	failures  map[string]time.Time
+	lastFirst string
+}
+
+func newFailover(logger *zap.Logger, cfg FailoverConfig, priority []string) *failover {
+	f := &failover{
+		logger:        logger,
+		failbackAfter: cfg.FailbackAfter,
+		priority:      make(map[string]int, len(priority)),
+		failures:      map[string]time.Time{},
+	}
+	if f.failbackAfter == 0 {
+		f.failbackAfter = defaultFailbackAfter
+	}
+	for i, endpoint := range priority {
+		if _, ok := f.priority[endpoint]; !ok {
+			f.priority[endpoint] = i
+		}
+	}
+	return f
+}
+
+// observe records the outcome of an export to the given endpoint.
+func (f *failover) observe(endpoint string, err error) {
+	if err == nil {
+		return
+	}
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+	f.failures[endpoint] = time.Now()
+}
+
+// endpoints returns up to n of the given endpoints, healthy ones first, in order of priority. When all of them
+// are unhealthy, the ones that failed the longest ago come first.
+func (f *failover) endpoints(resolved []string, n int) []string {
+	if len(resolved) == 0 {
+		return nil
+	}
+	ordered := make([]string, len(resolved))
+	copy(ordered, resolved)
+	sort.SliceStable(ordered, func(i, j int) bool {
+		pi, oki := f.priority[ordered[i]]
+		pj, okj := f.priority[ordered[j]]
+		if oki != okj {
+			return oki
+		}
+		return pi < pj
+	})
+
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+
+	now := time.Now()
+	healthy := make([]string, 0, len(ordered))
+	var unhealthy []string
+	for _, endpoint := range ordered {
+		failed, ok := f.failures[endpoint]
+		if ok && now.Sub(failed) < f.failbackAfter {
+			unhealthy = append(unhealthy, endpoint)
+			continue
+		}
+		delete(f.failures, endpoint)
+		healthy = append(healthy, endpoint)
+	}
+	sort.SliceStable(unhealthy, func(i, j int) bool {
+		return f.failures[unhealthy[i]].Before(f.failures[unhealthy[j]])
+	})
+
+	endpoints := append(healthy, unhealthy...)[:min(n, len(ordered))]
+	if endpoints[0] != f.lastFirst {
+		if f.lastFirst != "" {
+			f.logger.Info("switching the active endpoint", zap.String("from", f.lastFirst), zap.String("to", endpoints[0]))
+		}
+		f.lastFirst = endpoints[0]
+	}
+	return endpoints
+}
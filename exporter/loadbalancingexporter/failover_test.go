@@ -0,0 +1,52 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package loadbalancingexporter
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/zap"
+)
+
+func TestFailoverDefaults(t *testing.T) {
+	f := newFailover(zap.NewNop(), FailoverConfig{}, nil)
+	assert.Equal(t, defaultFailbackAfter, f.failbackAfter)
+}
+
+func TestFailoverPriority(t *testing.T) {
+	f := newFailover(zap.NewNop(), FailoverConfig{}, []string{"endpoint-3", "endpoint-1"})
+
+	// the endpoints unknown to the priority list come last, in resolved order
+	resolved := []string{"endpoint-1", "endpoint-2", "endpoint-3", "endpoint-4"}
+	assert.Equal(t, []string{"endpoint-3"}, f.endpoints(resolved, 1))
+	assert.Equal(t, []string{"endpoint-3", "endpoint-1", "endpoint-2", "endpoint-4"}, f.endpoints(resolved, 5))
+	assert.Empty(t, f.endpoints(nil, 1))
+}
+
+func TestFailoverAndFailback(t *testing.T) {
+	f := newFailover(zap.NewNop(), FailoverConfig{FailbackAfter: 100 * time.Millisecond}, nil)
+	resolved := []string{"endpoint-1", "endpoint-2", "endpoint-3"}
+
+	f.observe("endpoint-1", nil)
+	assert.Equal(t, []string{"endpoint-1"}, f.endpoints(resolved, 1))
+
+	// fail over to the next healthy endpoint
+	f.observe("endpoint-1", errors.New("unavailable"))
+	assert.Equal(t, []string{"endpoint-2"}, f.endpoints(resolved, 1))
+	f.observe("endpoint-2", errors.New("unavailable"))
+	assert.Equal(t, []string{"endpoint-3", "endpoint-1"}, f.endpoints(resolved, 2))
+
+	// when all of them are unhealthy, the least recently failed one is used
+	f.observe("endpoint-3", errors.New("unavailable"))
+	assert.Equal(t, []string{"endpoint-1", "endpoint-2", "endpoint-3"}, f.endpoints(resolved, 3))
+
+	// fail back once the endpoints have been stable
+	assert.Eventually(t, func() bool {
+		endpoints := f.endpoints(resolved, 1)
+		return endpoints[0] == "endpoint-1" && len(f.failures) == 0
+	}, time.Second, 10*time.Millisecond)
+}
@@ -4,6 +4,12 @@
 package loadbalancingexporter // import "github.com/open-telemetry/opentelemetry-collector-contrib/exporter/loadbalancingexporter"
 
 import (
+	"context"
+	"strings"
+
+	"go.opentelemetry.io/collector/client"
+	"go.opentelemetry.io/collector/pdata/pcommon"
+	"go.opentelemetry.io/collector/pdata/plog"
 	"go.opentelemetry.io/collector/pdata/pmetric"
 	"go.opentelemetry.io/collector/pdata/ptrace"
 )
@@ -19,3 +25,82 @@ func mergeMetrics(m1 pmetric.Metrics, m2 pmetric.Metrics) pmetric.Metrics {
 	m2.ResourceMetrics().MoveAndAppendTo(m1.ResourceMetrics())
 	return m1
 }
+
+// mergeLogs concatenates two plog.Logs into a single plog.Logs.
+func mergeLogs(l1 plog.Logs, l2 plog.Logs) plog.Logs {
+	l2.ResourceLogs().MoveAndAppendTo(l1.ResourceLogs())
+	return l1
+}
+
+// metadataRoutingID returns the routing identifier taken from the client metadata of the request.
+// Requests without the metadata key are all routed with the same, empty, identifier.
+func metadataRoutingID(ctx context.Context, key string) string {
+	return strings.Join(client.FromContext(ctx).Metadata.Get(key), ",")
+}
+
+// routedBatch tracks where the resources of a batch were appended in the payload of each of the exporters it was
+// routed to, so that the batch can be extracted back from the payloads when its exports fail.
+type routedBatch struct {
+	offsets   map[*wrappedExporter]int
+	resources int
+}
+
+// failedIn returns one of the exporters of the batch, and the offset of the batch in its payload, if the export
+// failed for all the exporters of the batch. A batch accepted by any of its replicas doesn't need to be retried.
+func (b routedBatch) failedIn(failed map[*wrappedExporter]bool) (*wrappedExporter, int, bool) {
+	var exp *wrappedExporter
+	for e := range b.offsets {
+		if !failed[e] {
+			return nil, 0, false
+		}
+		exp = e
+	}
+	return exp, b.offsets[exp], exp != nil && b.resources > 0
+}
+
+// resourceRoutingID returns the routing identifier of a resource, made of all its attributes, or only of the
+// given ones when any is configured.
+func resourceRoutingID(attrs pcommon.Map, attrKeys []string) string {
+	if len(attrKeys) == 0 {
+		return strings.Join(sortedMapAttrs(attrs), "")
+	}
+	selected := pcommon.NewMap()
+	for _, k := range attrKeys {
+		if v, ok := attrs.Get(k); ok {
+			v.CopyTo(selected.PutEmpty(k))
+		}
+	}
+	return strings.Join(sortedMapAttrs(selected), "")
+}
+
+// scopeRoutingID returns the part of the routing identifier made of the instrumentation scope, which is empty
+// when the scope isn't part of the routing.
+func scopeRoutingID(scope pcommon.InstrumentationScope, cfg *RoutingScopeConfig) string {
+	if cfg == nil {
+		return ""
+	}
+	var b strings.Builder
+	b.WriteByte(0)
+	b.WriteString(scope.Name())
+	if cfg.IncludeVersion {
+		b.WriteByte(0)
+		b.WriteString(scope.Version())
+	}
+	if len(cfg.Attributes) > 0 {
+		b.WriteByte(0)
+		b.WriteString(resourceRoutingID(scope.Attributes(), cfg.Attributes))
+	}
+	return b.String()
+}
+
+// withScopeRoutingID appends the scope part of the routing identifier to each of the identifiers.
+func withScopeRoutingID[T any](ids map[string]T, scopeID string) map[string]T {
+	if scopeID == "" {
+		return ids
+	}
+	scoped := make(map[string]T, len(ids))
+	for id, v := range ids {
+		scoped[id+scopeID] = v
+	}
+	return scoped
+}
@@ -6,7 +6,9 @@ package loadbalancingexporter
 import (
 	"testing"
 
+	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/collector/pdata/pcommon"
 	"go.opentelemetry.io/collector/pdata/pmetric"
 	"go.opentelemetry.io/collector/pdata/ptrace"
 	conventions "go.opentelemetry.io/collector/semconv/v1.6.1"
@@ -173,3 +175,29 @@ func BenchmarkMergeMetrics_X500(b *testing.B) {
 func BenchmarkMergeMetrics_X1000(b *testing.B) {
 	benchMergeMetrics(b, 1000)
 }
+
+func TestResourceRoutingID(t *testing.T) {
+	attrs := pcommon.NewMap()
+	attrs.PutStr("k8s.pod.name", "pod-1")
+	attrs.PutStr("k8s.namespace.name", "ns")
+	attrs.PutInt("process.pid", 42)
+
+	assert.Equal(t, "k8s.namespace.namensk8s.pod.namepod-1process.pid42", resourceRoutingID(attrs, nil))
+	assert.Equal(t, "k8s.namespace.namensk8s.pod.namepod-1", resourceRoutingID(attrs, []string{"k8s.pod.name", "k8s.namespace.name", "missing"}))
+}
+
+func TestScopeRoutingID(t *testing.T) {
+	scope := pcommon.NewInstrumentationScope()
+	scope.SetName("io.opentelemetry.runtime-metrics")
+	scope.SetVersion("1.32.0")
+	scope.Attributes().PutStr("library.kind", "jvm")
+
+	assert.Empty(t, scopeRoutingID(scope, nil))
+	assert.Equal(t, "\x00io.opentelemetry.runtime-metrics", scopeRoutingID(scope, &RoutingScopeConfig{}))
+	assert.Equal(t, "\x00io.opentelemetry.runtime-metrics\x001.32.0\x00library.kindjvm",
+		scopeRoutingID(scope, &RoutingScopeConfig{IncludeVersion: true, Attributes: []string{"library.kind"}}))
+
+	ids := map[string]bool{"svc-a": true, "svc-b": true}
+	assert.Equal(t, ids, withScopeRoutingID(ids, ""))
+	assert.Equal(t, map[string]bool{"svc-a\x00scope": true, "svc-b\x00scope": true}, withScopeRoutingID(ids, "\x00scope"))
+}
@@ -3,9 +3,14 @@
 package metadata
 
 import (
-	"go.opentelemetry.io/collector/component"
+	"errors"
+
 	"go.opentelemetry.io/otel/metric"
+	"go.opentelemetry.io/otel/metric/noop"
 	"go.opentelemetry.io/otel/trace"
+
+	"go.opentelemetry.io/collector/component"
+	"go.opentelemetry.io/collector/config/configtelemetry"
 )
 
 func Meter(settings component.TelemetrySettings) metric.Meter {
@@ -15,3 +20,143 @@ func Meter(settings component.TelemetrySettings) metric.Meter {
 func Tracer(settings component.TelemetrySettings) trace.Tracer {
 	return settings.TracerProvider.Tracer("otelcol/loadbalancing")
 }
+
+// TelemetryBuilder provides an interface for components to report telemetry
+// as defined in metadata and user config.
+type TelemetryBuilder struct {
+	LoadbalancerBackendFailedItems          metric.Int64Counter
+	LoadbalancerBackendFailureStreak        metric.Int64Gauge
+	LoadbalancerBackendInflightRequests     metric.Int64UpDownCounter
+	LoadbalancerBackendLatency              metric.Int64Histogram
+	LoadbalancerBackendOutcome              metric.Int64Counter
+	LoadbalancerNumBackendUpdates           metric.Int64Counter
+	LoadbalancerNumBackends                 metric.Int64Gauge
+	LoadbalancerNumResolutions              metric.Int64Counter
+	LoadbalancerNumSuppressedBackendUpdates metric.Int64Counter
+	LoadbalancerRingEndpointsAdded          metric.Int64Counter
+	LoadbalancerRingEndpointsRemoved        metric.Int64Counter
+	LoadbalancerRingRebuildDuration         metric.Float64Histogram
+	LoadbalancerRoutedDataPoints            metric.Int64Counter
+	LoadbalancerRoutedLogRecords            metric.Int64Counter
+	LoadbalancerRoutedSpans                 metric.Int64Counter
+	level                                   configtelemetry.Level
+}
+
+// telemetryBuilderOption applies changes to default builder.
+type telemetryBuilderOption func(*TelemetryBuilder)
+
+// WithLevel sets the current telemetry level for the component.
+func WithLevel(lvl configtelemetry.Level) telemetryBuilderOption {
+	return func(builder *TelemetryBuilder) {
+		builder.level = lvl
+	}
+}
+
+// NewTelemetryBuilder provides a struct with methods to update all internal telemetry
+// for a component
+func NewTelemetryBuilder(settings component.TelemetrySettings, options ...telemetryBuilderOption) (*TelemetryBuilder, error) {
+	builder := TelemetryBuilder{level: configtelemetry.LevelBasic}
+	for _, op := range options {
+		op(&builder)
+	}
+	var (
+		err, errs error
+		meter     metric.Meter
+	)
+	if builder.level >= configtelemetry.LevelBasic {
+		meter = Meter(settings)
+	} else {
+		meter = noop.Meter{}
+	}
+	builder.LoadbalancerBackendFailedItems, err = meter.Int64Counter(
+		"loadbalancer_backend_failed_items",
+		metric.WithDescription("Number of spans, data points or log records that failed to be exported to each endpoint, for the partially and the totally failed requests."),
+		metric.WithUnit("{items}"),
+	)
+	errs = errors.Join(errs, err)
+	builder.LoadbalancerBackendFailureStreak, err = meter.Int64Gauge(
+		"loadbalancer_backend_failure_streak",
+		metric.WithDescription("Number of consecutive failed exports to each endpoint, reset by a successful export."),
+		metric.WithUnit("{failures}"),
+	)
+	errs = errors.Join(errs, err)
+	builder.LoadbalancerBackendInflightRequests, err = meter.Int64UpDownCounter(
+		"loadbalancer_backend_inflight_requests",
+		metric.WithDescription("Number of exports to each endpoint waiting for their outcome."),
+		metric.WithUnit("{requests}"),
+	)
+	errs = errors.Join(errs, err)
+	builder.LoadbalancerBackendLatency, err = meter.Int64Histogram(
+		"loadbalancer_backend_latency",
+		metric.WithDescription("Response latency in ms for the backends."),
+		metric.WithUnit("ms"), metric.WithExplicitBucketBoundaries([]float64{5, 10, 20, 50, 100, 200, 500, 1000, 2000, 5000}...),
+	)
+	errs = errors.Join(errs, err)
+	builder.LoadbalancerBackendOutcome, err = meter.Int64Counter(
+		"loadbalancer_backend_outcome",
+		metric.WithDescription("Number of successes and failures for each endpoint."),
+		metric.WithUnit("{outcomes}"),
+	)
+	errs = errors.Join(errs, err)
+	builder.LoadbalancerNumBackendUpdates, err = meter.Int64Counter(
+		"loadbalancer_num_backend_updates",
+		metric.WithDescription("Number of times the list of backends was updated."),
+		metric.WithUnit("{updates}"),
+	)
+	errs = errors.Join(errs, err)
+	builder.LoadbalancerNumBackends, err = meter.Int64Gauge(
+		"loadbalancer_num_backends",
+		metric.WithDescription("Current number of backends in use."),
+		metric.WithUnit("{backends}"),
+	)
+	errs = errors.Join(errs, err)
+	builder.LoadbalancerNumResolutions, err = meter.Int64Counter(
+		"loadbalancer_num_resolutions",
+		metric.WithDescription("Number of times the resolver has triggered new resolutions."),
+		metric.WithUnit("{resolutions}"),
+	)
+	errs = errors.Join(errs, err)
+	builder.LoadbalancerNumSuppressedBackendUpdates, err = meter.Int64Counter(
+		"loadbalancer_num_suppressed_backend_updates",
+		metric.WithDescription("Number of updates of the list of backends superseded by a later one within the stabilization window."),
+		metric.WithUnit("{updates}"),
+	)
+	errs = errors.Join(errs, err)
+	builder.LoadbalancerRingEndpointsAdded, err = meter.Int64Counter(
+		"loadbalancer_ring_endpoints_added",
+		metric.WithDescription("Number of endpoints added to the hash ring."),
+		metric.WithUnit("{endpoints}"),
+	)
+	errs = errors.Join(errs, err)
+	builder.LoadbalancerRingEndpointsRemoved, err = meter.Int64Counter(
+		"loadbalancer_ring_endpoints_removed",
+		metric.WithDescription("Number of endpoints removed from the hash ring."),
+		metric.WithUnit("{endpoints}"),
+	)
+	errs = errors.Join(errs, err)
+	builder.LoadbalancerRingRebuildDuration, err = meter.Float64Histogram(
+		"loadbalancer_ring_rebuild_duration",
+		metric.WithDescription("Time taken to rebuild the hash ring and update the backend exporters after the list of backends changed."),
+		metric.WithUnit("ms"), metric.WithExplicitBucketBoundaries([]float64{0.1, 0.5, 1, 5, 10, 50, 100, 500, 1000}...),
+	)
+	errs = errors.Join(errs, err)
+	builder.LoadbalancerRoutedDataPoints, err = meter.Int64Counter(
+		"loadbalancer_routed_data_points",
+		metric.WithDescription("Number of metric data points routed to each endpoint."),
+		metric.WithUnit("{datapoints}"),
+	)
+	errs = errors.Join(errs, err)
+	builder.LoadbalancerRoutedLogRecords, err = meter.Int64Counter(
+		"loadbalancer_routed_log_records",
+		metric.WithDescription("Number of log records routed to each endpoint."),
+		metric.WithUnit("{records}"),
+	)
+	errs = errors.Join(errs, err)
+	builder.LoadbalancerRoutedSpans, err = meter.Int64Counter(
+		"loadbalancer_routed_spans",
+		metric.WithDescription("Number of spans routed to each endpoint."),
+		metric.WithUnit("{spans}"),
+	)
+	errs = errors.Join(errs, err)
+	return &builder, errs
+}
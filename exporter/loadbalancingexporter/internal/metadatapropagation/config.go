@@ -0,0 +1,41 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+// Package metadatapropagation forwards selected client metadata from incoming requests
+// on the outgoing requests made by the exporter to its backends, so that metadata such as the tenant survives
+// between collector tiers.
+package metadatapropagation // import "github.com/open-telemetry/opentelemetry-collector-contrib/exporter/loadbalancingexporter/internal/metadatapropagation"
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+)
+
+// Config defines which client metadata keys are forwarded on outgoing requests.
+type Config struct {
+	// Keys lists the client metadata keys to forward. Keys are case-insensitive.
+	// Client metadata is only available when the receiver has include_metadata enabled.
+	Keys []string `mapstructure:"keys"`
+}
+
+// Validate checks that the configured keys are valid.
+func (c Config) Validate() error {
+	seen := make(map[string]struct{}, len(c.Keys))
+	for _, key := range c.Keys {
+		if key == "" {
+			return errors.New("metadata keys cannot be empty")
+		}
+		lower := strings.ToLower(key)
+		if _, ok := seen[lower]; ok {
+			return fmt.Errorf("duplicate metadata key %q", key)
+		}
+		seen[lower] = struct{}{}
+	}
+	return nil
+}
+
+// Enabled reports whether any key is to be forwarded.
+func (c Config) Enabled() bool {
+	return len(c.Keys) > 0
+}
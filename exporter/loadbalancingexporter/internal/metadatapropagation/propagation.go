@@ -0,0 +1,40 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package metadatapropagation // import "github.com/open-telemetry/opentelemetry-collector-contrib/exporter/loadbalancingexporter/internal/metadatapropagation"
+
+import (
+	"context"
+	"strings"
+
+	"go.opentelemetry.io/collector/client"
+	"google.golang.org/grpc/metadata"
+)
+
+// OutgoingContext returns a context whose outgoing gRPC metadata carries the values of the
+// configured keys found in the client metadata of ctx. Outgoing metadata already present in
+// ctx is kept, with the forwarded values replacing the ones for the same keys.
+func (c Config) OutgoingContext(ctx context.Context) context.Context {
+	if !c.Enabled() {
+		return ctx
+	}
+
+	info := client.FromContext(ctx)
+	var md metadata.MD
+	for _, key := range c.Keys {
+		vals := info.Metadata.Get(key)
+		if len(vals) == 0 {
+			continue
+		}
+		if md == nil {
+			md, _ = metadata.FromOutgoingContext(ctx)
+			md = md.Copy()
+		}
+		md.Set(strings.ToLower(key), vals...)
+	}
+
+	if md == nil {
+		return ctx
+	}
+	return metadata.NewOutgoingContext(ctx, md)
+}
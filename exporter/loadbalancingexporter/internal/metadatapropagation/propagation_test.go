@@ -0,0 +1,73 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package metadatapropagation
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"go.opentelemetry.io/collector/client"
+	"google.golang.org/grpc/metadata"
+)
+
+func TestValidate(t *testing.T) {
+	assert.NoError(t, Config{}.Validate())
+	assert.NoError(t, Config{Keys: []string{"X-Scope-OrgID", "tracestate"}}.Validate())
+	assert.EqualError(t, Config{Keys: []string{""}}.Validate(), "metadata keys cannot be empty")
+	assert.EqualError(t, Config{Keys: []string{"X-Tenant", "x-tenant"}}.Validate(), `duplicate metadata key "x-tenant"`)
+}
+
+func TestOutgoingContext(t *testing.T) {
+	incoming := client.NewContext(context.Background(), client.Info{
+		Metadata: client.NewMetadata(map[string][]string{
+			"x-scope-orgid": {"tenant-1"},
+			"tracestate":    {"vendor=value"},
+			"authorization": {"secret"},
+		}),
+	})
+
+	tests := []struct {
+		name     string
+		cfg      Config
+		ctx      context.Context
+		expected metadata.MD
+	}{
+		{
+			name: "disabled",
+			cfg:  Config{},
+			ctx:  incoming,
+		},
+		{
+			name:     "selected keys only",
+			cfg:      Config{Keys: []string{"X-Scope-OrgID", "tracestate"}},
+			ctx:      incoming,
+			expected: metadata.Pairs("x-scope-orgid", "tenant-1", "tracestate", "vendor=value"),
+		},
+		{
+			name: "missing keys",
+			cfg:  Config{Keys: []string{"x-missing"}},
+			ctx:  incoming,
+		},
+		{
+			name:     "merged with existing outgoing metadata",
+			cfg:      Config{Keys: []string{"x-scope-orgid"}},
+			ctx:      metadata.AppendToOutgoingContext(incoming, "x-existing", "value", "x-scope-orgid", "overridden"),
+			expected: metadata.Pairs("x-existing", "value", "x-scope-orgid", "tenant-1"),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			md, ok := metadata.FromOutgoingContext(tt.cfg.OutgoingContext(tt.ctx))
+			if tt.expected == nil {
+				_, hadMD := metadata.FromOutgoingContext(tt.ctx)
+				assert.Equal(t, hadMD, ok)
+				return
+			}
+			assert.True(t, ok)
+			assert.Equal(t, tt.expected, md)
+		})
+	}
+}
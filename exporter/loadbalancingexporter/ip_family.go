@@ -0,0 +1,54 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package loadbalancingexporter // import "github.com/open-telemetry/opentelemetry-collector-contrib/exporter/loadbalancingexporter"
+
+const (
+	// ipFamilyAny keeps all the resolved addresses, the default
+	ipFamilyAny = "any"
+	// ipFamilyIPv4 and ipFamilyIPv6 keep the addresses of a single family
+	ipFamilyIPv4 = "ipv4"
+	ipFamilyIPv6 = "ipv6"
+	// ipFamilyPreferIPv4 and ipFamilyPreferIPv6 keep the addresses of the preferred family, or the ones of the other
+	// family when none of the preferred family is resolved, so that the backends of a dual-stack cluster, resolved
+	// with both an IPv4 and an IPv6 address, appear only once in the ring
+	ipFamilyPreferIPv4 = "prefer_ipv4"
+	ipFamilyPreferIPv6 = "prefer_ipv6"
+)
+
+// filterIPFamily returns the endpoints of the given IP family. The endpoints which aren't IP addresses, like the
+// hostnames of the static resolver, are always kept.
+func filterIPFamily(endpoints []string, family string) []string {
+	var keepV4, keepV6 bool
+	switch family {
+	case ipFamilyIPv4:
+		keepV4 = true
+	case ipFamilyIPv6:
+		keepV6 = true
+	case ipFamilyPreferIPv4, ipFamilyPreferIPv6:
+		hasV4, hasV6 := resolvedFamilies(endpoints)
+		keepV4 = hasV4 && (family == ipFamilyPreferIPv4 || !hasV6)
+		keepV6 = hasV6 && (family == ipFamilyPreferIPv6 || !hasV4)
+	default:
+		return endpoints
+	}
+
+	kept := make([]string, 0, len(endpoints))
+	for _, endpoint := range endpoints {
+		addr, ok := endpointAddr(endpoint)
+		if !ok || (addr.Is4() && keepV4) || (addr.Is6() && keepV6) {
+			kept = append(kept, endpoint)
+		}
+	}
+	return kept
+}
+
+func resolvedFamilies(endpoints []string) (hasV4, hasV6 bool) {
+	for _, endpoint := range endpoints {
+		if addr, ok := endpointAddr(endpoint); ok {
+			hasV4 = hasV4 || addr.Is4()
+			hasV6 = hasV6 || addr.Is6()
+		}
+	}
+	return hasV4, hasV6
+}
@@ -0,0 +1,79 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package loadbalancingexporter
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestFilterIPFamily(t *testing.T) {
+	dualStack := []string{"10.0.0.1:4317", "[fd00::1]:4317", "10.0.0.2", "[fd00::2]", "collector-0.collector:4317"}
+	ipv6Only := []string{"[fd00::1]:4317", "collector-0.collector:4317"}
+	for _, tt := range []struct {
+		desc      string
+		family    string
+		endpoints []string
+		expected  []string
+	}{
+		{
+			desc:      "default",
+			endpoints: dualStack,
+			expected:  dualStack,
+		},
+		{
+			desc:      "any",
+			family:    ipFamilyAny,
+			endpoints: dualStack,
+			expected:  dualStack,
+		},
+		{
+			desc:      "IPv4 only",
+			family:    ipFamilyIPv4,
+			endpoints: dualStack,
+			expected:  []string{"10.0.0.1:4317", "10.0.0.2", "collector-0.collector:4317"},
+		},
+		{
+			desc:      "IPv6 only",
+			family:    ipFamilyIPv6,
+			endpoints: dualStack,
+			expected:  []string{"[fd00::1]:4317", "[fd00::2]", "collector-0.collector:4317"},
+		},
+		{
+			desc:      "IPv4 only without IPv4 addresses",
+			family:    ipFamilyIPv4,
+			endpoints: ipv6Only,
+			expected:  []string{"collector-0.collector:4317"},
+		},
+		{
+			desc:      "prefer IPv4",
+			family:    ipFamilyPreferIPv4,
+			endpoints: dualStack,
+			expected:  []string{"10.0.0.1:4317", "10.0.0.2", "collector-0.collector:4317"},
+		},
+		{
+			desc:      "prefer IPv6",
+			family:    ipFamilyPreferIPv6,
+			endpoints: dualStack,
+			expected:  []string{"[fd00::1]:4317", "[fd00::2]", "collector-0.collector:4317"},
+		},
+		{
+			desc:      "prefer IPv4 falls back to IPv6",
+			family:    ipFamilyPreferIPv4,
+			endpoints: ipv6Only,
+			expected:  ipv6Only,
+		},
+		{
+			desc:      "IPv4-mapped IPv6 address",
+			family:    ipFamilyIPv4,
+			endpoints: []string{"[::ffff:10.0.0.1]:4317", "[fd00::1]:4317"},
+			expected:  []string{"[::ffff:10.0.0.1]:4317"},
+		},
+	} {
+		t.Run(tt.desc, func(t *testing.T) {
+			assert.Equal(t, tt.expected, filterIPFamily(tt.endpoints, tt.family))
+		})
+	}
+}
@@ -7,12 +7,21 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"maps"
 	"strings"
 	"sync"
+	"time"
 
 	"go.opentelemetry.io/collector/component"
 	"go.opentelemetry.io/collector/exporter"
+	"go.opentelemetry.io/collector/extension/experimental/storage"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
+	"go.uber.org/multierr"
 	"go.uber.org/zap"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/exporter/loadbalancingexporter/internal/metadata"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/exporter/loadbalancingexporter/internal/metadatapropagation"
 )
 
 const (
@@ -27,15 +36,62 @@ var (
 type componentFactory func(ctx context.Context, endpoint string) (component.Component, error)
 
 type loadBalancer struct {
-	logger *zap.Logger
-	host   component.Host
+	id       component.ID
+	logger   *zap.Logger
+	settings component.TelemetrySettings
+	host     component.Host
 
 	res  resolver
 	ring *hashRing
 
+	// filter removes the excluded endpoints from the resolved ones
+	filter *endpointFilter
+
+	// ipFamily selects the IP family of the resolved endpoints
+	ipFamily string
+
+	// storageClient, when a storage is configured, holds the endpoints of the ring across restarts
+	storageID     *component.ID
+	storageClient storage.Client
+
+	// debouncer, when set, delays the updates of the backends until they are stable
+	debouncer *debouncer
+
 	componentFactory componentFactory
 	exporters        map[string]*wrappedExporter
 
+	// routingTable maps the pinned routing identifiers to their endpoint, and pinned holds the
+	// exporters of those endpoints, which live independently of the resolved backends.
+	routingTable map[string]string
+	pinned       map[string]*wrappedExporter
+
+	replicationFactor int
+
+	// endpointQueue, when set, configures the queue of each endpoint
+	endpointQueue *EndpointQueueConfig
+
+	// adaptive adjusts the weights of the resolved endpoints, which are kept to rebuild the ring
+	adaptive       *adaptiveWeighting
+	weights        map[string]int
+	resolved       []string
+	stopAdaptive   chan struct{}
+	adaptiveDoneWg sync.WaitGroup
+
+	// affinity holds the endpoints the recently routed identifiers were sent to
+	affinity *affinityCache
+
+	// failover, when set, replaces the hash ring for choosing the endpoints
+	failover *failover
+
+	// streaks holds the number of consecutive failed exports of each endpoint
+	streaks    map[string]int64
+	streakLock sync.Mutex
+
+	telemetry   *metadata.TelemetryBuilder
+	tracer      trace.Tracer
+	zpages      *ringPage
+	propagation metadatapropagation.Config
+
 	stopped    bool
 	updateLock sync.RWMutex
 }
@@ -61,19 +117,27 @@ func newLoadBalancer(params exporter.CreateSettings, cfg component.Config, facto
 		return nil, errMultipleResolversProvided
 	}
 
+	telemetry, err := metadata.NewTelemetryBuilder(params.TelemetrySettings)
+	if err != nil {
+		return nil, err
+	}
+
 	var res resolver
 	if oCfg.Resolver.Static != nil {
-		var err error
-		res, err = newStaticResolver(oCfg.Resolver.Static.Hostnames)
-		if err != nil {
-			return nil, err
+		if oCfg.Resolver.Static.HostnamesFile != "" {
+			staticLogger := params.Logger.With(zap.String("resolver", "static"))
+			res = newStaticFileResolver(staticLogger, oCfg.Resolver.Static.HostnamesFile, oCfg.Resolver.Static.ReloadInterval, telemetry)
+		} else {
+			res, err = newStaticResolver(oCfg.Resolver.Static.Hostnames, telemetry)
+			if err != nil {
+				return nil, err
+			}
 		}
 	}
 	if oCfg.Resolver.DNS != nil {
 		dnsLogger := params.Logger.With(zap.String("resolver", "dns"))
 
-		var err error
-		res, err = newDNSResolver(dnsLogger, oCfg.Resolver.DNS.Hostname, oCfg.Resolver.DNS.Port, oCfg.Resolver.DNS.Interval, oCfg.Resolver.DNS.Timeout)
+		res, err = newDNSResolver(dnsLogger, oCfg.Resolver.DNS.Hostname, oCfg.Resolver.DNS.Port, oCfg.Resolver.DNS.Interval, oCfg.Resolver.DNS.Timeout, telemetry)
 		if err != nil {
 			return nil, err
 		}
@@ -85,7 +149,7 @@ func newLoadBalancer(params exporter.CreateSettings, cfg component.Config, facto
 		if err != nil {
 			return nil, err
 		}
-		res, err = newK8sResolver(clt, k8sLogger, oCfg.Resolver.K8sSvc.Service, oCfg.Resolver.K8sSvc.Ports, oCfg.Resolver.K8sSvc.Timeout)
+		res, err = newK8sResolver(clt, k8sLogger, oCfg.Resolver.K8sSvc.Service, oCfg.Resolver.K8sSvc.Ports, oCfg.Resolver.K8sSvc.Timeout, telemetry)
 		if err != nil {
 			return nil, err
 		}
@@ -93,8 +157,7 @@ func newLoadBalancer(params exporter.CreateSettings, cfg component.Config, facto
 
 	if oCfg.Resolver.AWSCloudMap != nil {
 		awsCloudMapLogger := params.Logger.With(zap.String("resolver", "aws_cloud_map"))
-		var err error
-		res, err = newCloudMapResolver(awsCloudMapLogger, &oCfg.Resolver.AWSCloudMap.NamespaceName, &oCfg.Resolver.AWSCloudMap.ServiceName, oCfg.Resolver.AWSCloudMap.Port, &oCfg.Resolver.AWSCloudMap.HealthStatus, oCfg.Resolver.AWSCloudMap.Interval, oCfg.Resolver.AWSCloudMap.Timeout)
+		res, err = newCloudMapResolver(awsCloudMapLogger, &oCfg.Resolver.AWSCloudMap.NamespaceName, &oCfg.Resolver.AWSCloudMap.ServiceName, oCfg.Resolver.AWSCloudMap.Port, &oCfg.Resolver.AWSCloudMap.HealthStatus, oCfg.Resolver.AWSCloudMap.Interval, oCfg.Resolver.AWSCloudMap.Timeout, telemetry)
 		if err != nil {
 			return nil, err
 		}
@@ -104,38 +167,146 @@ func newLoadBalancer(params exporter.CreateSettings, cfg component.Config, facto
 		return nil, errNoResolver
 	}
 
-	return &loadBalancer{
-		logger:           params.Logger,
-		res:              res,
-		componentFactory: factory,
-		exporters:        map[string]*wrappedExporter{},
-	}, nil
+	filter, err := newEndpointFilter(oCfg.Resolver.Filter)
+	if err != nil {
+		return nil, err
+	}
+
+	lb := &loadBalancer{
+		id:                params.ID,
+		logger:            params.Logger,
+		settings:          params.TelemetrySettings,
+		res:               res,
+		filter:            filter,
+		ipFamily:          oCfg.Resolver.IPFamily,
+		storageID:         oCfg.Resolver.StorageID,
+		componentFactory:  factory,
+		exporters:         map[string]*wrappedExporter{},
+		telemetry:         telemetry,
+		tracer:            metadata.Tracer(params.TelemetrySettings),
+		propagation:       oCfg.PropagateMetadata,
+		routingTable:      map[string]string{},
+		pinned:            map[string]*wrappedExporter{},
+		streaks:           map[string]int64{},
+		replicationFactor: oCfg.ReplicationFactor,
+		endpointQueue:     oCfg.EndpointQueue,
+	}
+	for identifier, endpoint := range oCfg.RoutingTable {
+		lb.routingTable[identifier] = endpointWithPort(endpoint)
+	}
+	if oCfg.Resolver.StabilizationWindow > 0 {
+		lb.debouncer = newDebouncer(oCfg.Resolver.StabilizationWindow, lb.onBackendChanges, func() {
+			lb.telemetry.LoadbalancerNumSuppressedBackendUpdates.Add(context.Background(), 1)
+		})
+	}
+	if oCfg.ZPages != nil {
+		lb.zpages = &ringPage{config: *oCfg.ZPages, lb: lb}
+	}
+	if oCfg.AdaptiveWeighting != nil {
+		lb.adaptive = newAdaptiveWeighting(*oCfg.AdaptiveWeighting)
+	}
+	if oCfg.AffinityCache != nil {
+		lb.affinity = newAffinityCache(*oCfg.AffinityCache)
+	}
+	if oCfg.Mode == modeFailover {
+		var priority []string
+		if oCfg.Resolver.Static != nil {
+			priority = oCfg.Resolver.Static.Hostnames
+		}
+		lb.failover = newFailover(params.Logger, oCfg.Failover, priority)
+	}
+	return lb, nil
 }
 
 func (lb *loadBalancer) Start(ctx context.Context, host component.Host) error {
-	lb.res.onChange(lb.onBackendChanges)
+	if lb.debouncer != nil {
+		lb.res.onChange(lb.debouncer.update)
+	} else {
+		lb.res.onChange(lb.onBackendChanges)
+	}
 	lb.host = host
+	if err := lb.startPinnedExporters(ctx); err != nil {
+		return err
+	}
+	if lb.zpages != nil {
+		if err := lb.zpages.start(ctx, host); err != nil {
+			return err
+		}
+	}
+	if lb.adaptive != nil {
+		lb.startAdaptiveWeighting()
+	}
+	if err := lb.startStorage(ctx, host); err != nil {
+		return err
+	}
 	return lb.res.start(ctx)
 }
 
 func (lb *loadBalancer) onBackendChanges(resolved []string) {
-	newRing := newHashRing(resolved)
+	start := time.Now()
 
-	if !newRing.equal(lb.ring) {
-		lb.updateLock.Lock()
-		defer lb.updateLock.Unlock()
+	// TODO: set a timeout?
+	ctx, span := lb.tracer.Start(context.Background(), resolutionSpanName, trace.WithAttributes(attribute.StringSlice(endpointsTagKey, resolved)))
+	defer span.End()
 
-		lb.ring = newRing
+	if selected := filterIPFamily(resolved, lb.ipFamily); len(selected) < len(resolved) {
+		lb.logger.Debug("endpoints of another IP family ignored", zap.String("ip_family", lb.ipFamily), zap.Strings("resolved", resolved), zap.Strings("kept", selected))
+		resolved = selected
+	}
+
+	if lb.filter != nil {
+		filtered := lb.filter.filter(resolved)
+		span.SetAttributes(attribute.Int(filteredTagKey, len(resolved)-len(filtered)))
+		if len(filtered) < len(resolved) {
+			lb.logger.Debug("endpoints filtered out of the resolved ones", zap.Strings("resolved", resolved), zap.Strings("kept", filtered))
+		}
+		resolved = filtered
+	}
+
+	lb.updateLock.Lock()
+	defer lb.updateLock.Unlock()
+
+	if lb.stopped {
+		lb.logger.Debug("backend changes ignored after shutdown", zap.Strings("resolved", resolved))
+		return
+	}
+
+	lb.resolved = resolved
+	newRing := newWeightedHashRing(resolved, lb.weights)
 
-		// TODO: set a timeout?
-		ctx := context.Background()
+	changed := !newRing.equal(lb.ring)
+	span.SetAttributes(attribute.Bool(ringChangedTagKey, changed))
+	if changed {
+		lb.ring = newRing
 
 		// add the missing exporters first
 		lb.addMissingExporters(ctx, resolved)
 		lb.removeExtraExporters(ctx, resolved)
+		lb.saveEndpoints(ctx, resolved)
+
+		lb.telemetry.LoadbalancerRingRebuildDuration.Record(ctx, float64(time.Since(start))/float64(time.Millisecond))
 	}
 }
 
+// startPinnedExporters creates the exporters for the endpoints of the routing table.
+func (lb *loadBalancer) startPinnedExporters(ctx context.Context) error {
+	for _, endpoint := range lb.routingTable {
+		if _, exists := lb.pinned[endpoint]; exists {
+			continue
+		}
+		exp, err := lb.componentFactory(ctx, endpoint)
+		if err != nil {
+			return fmt.Errorf("failed to create the exporter for the pinned endpoint %q: %w", endpoint, err)
+		}
+		we := lb.wrapExporter(exp)
+		if err = we.Start(ctx, lb.host); err != nil {
+			return fmt.Errorf("failed to start the exporter for the pinned endpoint %q: %w", endpoint, err)
+		}
+		lb.pinned[endpoint] = we
+	}
+	return nil
+}
+
 func (lb *loadBalancer) addMissingExporters(ctx context.Context, endpoints []string) {
 	for _, endpoint := range endpoints {
 		endpoint = endpointWithPort(endpoint)
@@ -146,16 +317,26 @@ func (lb *loadBalancer) addMissingExporters(ctx context.Context, endpoints []str
 				lb.logger.Error("failed to create new exporter for endpoint", zap.String("endpoint", endpoint), zap.Error(err))
 				continue
 			}
-			we := newWrappedExporter(exp)
+			we := lb.wrapExporter(exp)
 			if err = we.Start(ctx, lb.host); err != nil {
 				lb.logger.Error("failed to start new exporter for endpoint", zap.String("endpoint", endpoint), zap.Error(err))
 				continue
 			}
 			lb.exporters[endpoint] = we
+			lb.telemetry.LoadbalancerRingEndpointsAdded.Add(ctx, 1)
 		}
 	}
 }
 
+// wrapExporter wraps the exporter of an endpoint, with its queue when the endpoint queue is enabled.
+func (lb *loadBalancer) wrapExporter(exp component.Component) *wrappedExporter {
+	we := newWrappedExporter(exp)
+	if lb.endpointQueue != nil {
+		we.queue = newEndpointQueue(*lb.endpointQueue)
+	}
+	return we
+}
+
 func endpointWithPort(endpoint string) string {
 	if !strings.Contains(endpoint, ":") {
 		endpoint = fmt.Sprintf("%s:%s", endpoint, defaultPort)
@@ -176,6 +357,8 @@ func (lb *loadBalancer) removeExtraExporters(ctx context.Context, endpoints []st
 				_ = exp.Shutdown(ctx)
 			}()
 			delete(lb.exporters, existing)
+			lb.resetFailureStreak(ctx, existing)
+			lb.telemetry.LoadbalancerRingEndpointsRemoved.Add(ctx, 1)
 		}
 	}
 }
@@ -190,9 +373,126 @@ func endpointFound(endpoint string, endpoints []string) bool {
 	return false
 }
 
+// startAdaptiveWeighting periodically updates the weights of the endpoints, rebuilding the ring when they change.
+func (lb *loadBalancer) startAdaptiveWeighting() {
+	lb.stopAdaptive = make(chan struct{})
+	lb.adaptiveDoneWg.Add(1)
+	go func() {
+		defer lb.adaptiveDoneWg.Done()
+		ticker := time.NewTicker(lb.adaptive.interval)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-ticker.C:
+				lb.updateWeights()
+			case <-lb.stopAdaptive:
+				return
+			}
+		}
+	}()
+}
+
+func (lb *loadBalancer) updateWeights() {
+	start := time.Now()
+
+	lb.updateLock.Lock()
+	defer lb.updateLock.Unlock()
+
+	weights := lb.adaptive.weights(lb.resolved)
+	if maps.Equal(weights, lb.weights) {
+		return
+	}
+	lb.weights = weights
+	lb.logger.Debug("updated the endpoint weights", zap.Any("weights", weights))
+
+	newRing := newWeightedHashRing(lb.resolved, weights)
+	if !newRing.equal(lb.ring) {
+		lb.ring = newRing
+		lb.telemetry.LoadbalancerRingRebuildDuration.Record(context.Background(), float64(time.Since(start))/float64(time.Millisecond))
+	}
+}
+
+// exportStarted accounts for an export to the endpoint being in flight, until its outcome is observed.
+func (lb *loadBalancer) exportStarted(ctx context.Context, endpoint string) {
+	lb.telemetry.LoadbalancerBackendInflightRequests.Add(ctx, 1, backendAttrs(endpoint))
+}
+
+// observe records the outcome of an export to the endpoint started with exportStarted.
+func (lb *loadBalancer) observe(ctx context.Context, endpoint string, latency time.Duration, err error) {
+	lb.telemetry.LoadbalancerBackendInflightRequests.Add(ctx, -1, backendAttrs(endpoint))
+	lb.recordFailureStreak(ctx, endpoint, err)
+	if lb.adaptive != nil {
+		lb.adaptive.observe(endpoint, latency, err)
+	}
+	if lb.failover != nil {
+		lb.failover.observe(endpoint, err)
+	}
+}
+
+// recordFailureStreak updates the number of consecutive failed exports to the endpoint.
+func (lb *loadBalancer) recordFailureStreak(ctx context.Context, endpoint string, err error) {
+	lb.streakLock.Lock()
+	defer lb.streakLock.Unlock()
+	streak := int64(0)
+	if err != nil {
+		streak = lb.streaks[endpoint] + 1
+	}
+	if streak == 0 && lb.streaks[endpoint] == 0 {
+		// the endpoint keeps succeeding
+		return
+	}
+	lb.streaks[endpoint] = streak
+	lb.telemetry.LoadbalancerBackendFailureStreak.Record(ctx, streak, backendAttrs(endpoint))
+}
+
+// resetFailureStreak resets the failure streak of a removed endpoint, so that it doesn't keep reporting its last
+// failures.
+func (lb *loadBalancer) resetFailureStreak(ctx context.Context, endpointWithDefaultPort string) {
+	lb.streakLock.Lock()
+	defer lb.streakLock.Unlock()
+	for endpoint, streak := range lb.streaks {
+		if endpointWithPort(endpoint) != endpointWithDefaultPort {
+			continue
+		}
+		if streak > 0 {
+			lb.telemetry.LoadbalancerBackendFailureStreak.Record(ctx, 0, backendAttrs(endpoint))
+		}
+		delete(lb.streaks, endpoint)
+	}
+}
+
 func (lb *loadBalancer) Shutdown(ctx context.Context) error {
+	if lb.stopAdaptive != nil {
+		close(lb.stopAdaptive)
+		lb.adaptiveDoneWg.Wait()
+	}
 	err := lb.res.shutdown(ctx)
+	if lb.debouncer != nil {
+		lb.debouncer.stop()
+	}
+	err = multierr.Append(err, lb.shutdownStorage(ctx))
+	if lb.zpages != nil {
+		err = multierr.Append(err, lb.zpages.shutdown(ctx))
+	}
+	for _, exp := range lb.pinned {
+		err = multierr.Append(err, exp.Shutdown(ctx))
+	}
+	// no backend change is applied after this point, so the exporters can be waited for without holding the lock
+	lb.updateLock.Lock()
 	lb.stopped = true
+	var queued []*wrappedExporter
+	for _, exp := range lb.exporters {
+		if exp.queue != nil {
+			queued = append(queued, exp)
+		}
+	}
+	lb.updateLock.Unlock()
+
+	// the exports queued to the resolved endpoints are run before stopping
+	for _, exp := range queued {
+		exp.consumeWG.Wait()
+		exp.queue.close()
+	}
 	return err
 }
 
@@ -201,9 +501,20 @@ func (lb *loadBalancer) exporterAndEndpoint(identifier []byte) (*wrappedExporter
 	// NOTE: make rolling updates of next tier of collectors work. currently, this may cause
 	// data loss because the latest batches sent to outdated backend will never find their way out.
 	// for details: https://github.com/open-telemetry/opentelemetry-collector-contrib/issues/1690
+	if endpoint, pinned := lb.routingTable[string(identifier)]; pinned {
+		exp, found := lb.pinned[endpoint]
+		if !found {
+			return nil, "", fmt.Errorf("couldn't find the exporter for the pinned endpoint %q", endpoint)
+		}
+		return exp, endpoint, nil
+	}
+
 	lb.updateLock.RLock()
 	defer lb.updateLock.RUnlock()
-	endpoint := lb.ring.endpointFor(identifier)
+	var endpoint string
+	if endpoints := lb.routedEndpoints(identifier, 1); len(endpoints) > 0 {
+		endpoint = endpoints[0]
+	}
 	exp, found := lb.exporters[endpointWithPort(endpoint)]
 	if !found {
 		// something is really wrong... how come we couldn't find the exporter??
@@ -212,3 +523,66 @@ func (lb *loadBalancer) exporterAndEndpoint(identifier []byte) (*wrappedExporter
 
 	return exp, endpoint, nil
 }
+
+// exportersAndEndpoints returns the exporters, along with their endpoints, the data for the given
+// identifier is sent to: the exporter returned by exporterAndEndpoint, followed by the replicas
+// when a replication factor is configured.
+func (lb *loadBalancer) exportersAndEndpoints(identifier []byte) (map[*wrappedExporter]string, error) {
+	_, pinned := lb.routingTable[string(identifier)]
+	if lb.replicationFactor <= 1 || pinned {
+		exp, endpoint, err := lb.exporterAndEndpoint(identifier)
+		if err != nil {
+			return nil, err
+		}
+		return map[*wrappedExporter]string{exp: endpoint}, nil
+	}
+
+	lb.updateLock.RLock()
+	defer lb.updateLock.RUnlock()
+	endpoints := lb.routedEndpoints(identifier, lb.replicationFactor)
+	if len(endpoints) == 0 {
+		return nil, errors.New("couldn't find any endpoint, the hash ring is empty")
+	}
+
+	exporters := make(map[*wrappedExporter]string, len(endpoints))
+	for _, endpoint := range endpoints {
+		exp, found := lb.exporters[endpointWithPort(endpoint)]
+		if !found {
+			return nil, fmt.Errorf("couldn't find the exporter for the endpoint %q", endpoint)
+		}
+		exporters[exp] = endpoint
+	}
+	return exporters, nil
+}
+
+// routedEndpoints returns up to n endpoints of the ring responsible for the given identifier. With the affinity
+// cache, the identifiers keep being routed to the endpoints they were first routed to until their entry expires,
+// as long as those endpoints are still available. In failover mode, the identifier is ignored and the first
+// healthy endpoints are returned instead. The caller must hold the update lock.
+func (lb *loadBalancer) routedEndpoints(identifier []byte, n int) []string {
+	if lb.failover != nil {
+		return lb.failover.endpoints(lb.resolved, n)
+	}
+	if lb.affinity == nil {
+		return lb.ring.endpointsFor(identifier, n)
+	}
+
+	key := string(identifier)
+	if endpoints, ok := lb.affinity.get(key); ok && lb.available(endpoints) {
+		return endpoints[:min(n, len(endpoints))]
+	}
+	endpoints := lb.ring.endpointsFor(identifier, n)
+	if len(endpoints) > 0 {
+		lb.affinity.add(key, endpoints)
+	}
+	return endpoints
+}
+
+func (lb *loadBalancer) available(endpoints []string) bool {
+	for _, endpoint := range endpoints {
+		if _, found := lb.exporters[endpointWithPort(endpoint)]; !found {
+			return false
+		}
+	}
+	return true
+}
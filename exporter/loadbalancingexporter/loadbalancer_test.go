@@ -6,7 +6,9 @@ package loadbalancingexporter
 import (
 	"context"
 	"errors"
+	"fmt"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -220,6 +222,67 @@ func TestOnBackendChanges(t *testing.T) {
 	assert.Len(t, p.ring.items, 2*defaultWeight)
 }
 
+func TestOnBackendChangesAfterShutdown(t *testing.T) {
+	// prepare
+	cfg := simpleConfig()
+	componentFactory := func(_ context.Context, _ string) (component.Component, error) {
+		return newNopMockExporter(), nil
+	}
+	p, err := newLoadBalancer(exportertest.NewNopCreateSettings(), cfg, componentFactory)
+	require.NotNil(t, p)
+	require.NoError(t, err)
+	p.onBackendChanges([]string{"endpoint-1"})
+	require.NoError(t, p.Shutdown(context.Background()))
+
+	// test
+	p.onBackendChanges([]string{"endpoint-1", "endpoint-2"})
+
+	// verify
+	assert.Len(t, p.ring.items, defaultWeight)
+	assert.Len(t, p.exporters, 1)
+}
+
+func TestOnBackendChangesFiltered(t *testing.T) {
+	// prepare
+	cfg := simpleConfig()
+	cfg.Resolver.Filter = EndpointFilterConfig{Exclude: []string{"canary", "10.1.0.0/16"}}
+	componentFactory := func(_ context.Context, _ string) (component.Component, error) {
+		return newNopMockExporter(), nil
+	}
+	p, err := newLoadBalancer(exportertest.NewNopCreateSettings(), cfg, componentFactory)
+	require.NotNil(t, p)
+	require.NoError(t, err)
+
+	// test
+	p.onBackendChanges([]string{"10.0.0.1:4317", "10.1.0.1:4317", "collector-canary-0:4317"})
+
+	// verify
+	assert.Equal(t, []string{"10.0.0.1:4317"}, p.resolved)
+	assert.Len(t, p.ring.items, defaultWeight)
+	assert.Len(t, p.exporters, 1)
+	assert.Contains(t, p.exporters, "10.0.0.1:4317")
+}
+
+func TestOnBackendChangesIPFamily(t *testing.T) {
+	// prepare
+	cfg := simpleConfig()
+	cfg.Resolver.IPFamily = ipFamilyPreferIPv6
+	componentFactory := func(_ context.Context, _ string) (component.Component, error) {
+		return newNopMockExporter(), nil
+	}
+	p, err := newLoadBalancer(exportertest.NewNopCreateSettings(), cfg, componentFactory)
+	require.NotNil(t, p)
+	require.NoError(t, err)
+
+	// test
+	p.onBackendChanges([]string{"10.0.0.1:4317", "[fd00::1]:4317"})
+
+	// verify
+	assert.Equal(t, []string{"[fd00::1]:4317"}, p.resolved)
+	assert.Len(t, p.exporters, 1)
+	assert.Contains(t, p.exporters, "[fd00::1]:4317")
+}
+
 func TestRemoveExtraExporters(t *testing.T) {
 	// prepare
 	cfg := simpleConfig()
@@ -390,6 +453,239 @@ func TestFailedExporterInRing(t *testing.T) {
 	assert.Error(t, err)
 }
 
+func TestRoutingTable(t *testing.T) {
+	// prepare
+	cfg := simpleConfig()
+	cfg.RoutingKey = "service"
+	cfg.RoutingTable = map[string]string{
+		"big-tenant":   "dedicated",
+		"other-tenant": "dedicated:4317",
+	}
+	var created []string
+	componentFactory := func(_ context.Context, endpoint string) (component.Component, error) {
+		created = append(created, endpoint)
+		return newNopMockExporter(), nil
+	}
+	p, err := newLoadBalancer(exportertest.NewNopCreateSettings(), cfg, componentFactory)
+	require.NotNil(t, p)
+	require.NoError(t, err)
+
+	p.res = &mockResolver{
+		triggerCallbacks: true,
+		onResolve: func(_ context.Context) ([]string, error) {
+			return []string{"endpoint-1", "endpoint-2"}, nil
+		},
+	}
+	require.NoError(t, p.Start(context.Background(), componenttest.NewNopHost()))
+	defer func() {
+		require.NoError(t, p.Shutdown(context.Background()))
+	}()
+
+	// test and verify
+	// a single exporter is created for the pinned endpoint
+	assert.Len(t, p.pinned, 1)
+	for _, id := range []string{"big-tenant", "other-tenant"} {
+		exp, endpoint, err := p.exporterAndEndpoint([]byte(id))
+		require.NoError(t, err)
+		assert.Equal(t, "dedicated:4317", endpoint)
+		assert.Same(t, p.pinned["dedicated:4317"], exp)
+	}
+
+	// unmapped keys are load-balanced across the resolved endpoints only
+	for i := 0; i < 100; i++ {
+		_, endpoint, err := p.exporterAndEndpoint([]byte(fmt.Sprintf("tenant-%d", i)))
+		require.NoError(t, err)
+		assert.Contains(t, []string{"endpoint-1", "endpoint-2"}, endpoint)
+	}
+	assert.ElementsMatch(t, []string{"dedicated:4317", "endpoint-1:4317", "endpoint-2:4317"}, created)
+}
+
+func TestReplicationFactor(t *testing.T) {
+	// prepare
+	cfg := simpleConfig()
+	cfg.RoutingKey = "service"
+	cfg.ReplicationFactor = 2
+	cfg.RoutingTable = map[string]string{"big-tenant": "dedicated"}
+	componentFactory := func(_ context.Context, _ string) (component.Component, error) {
+		return newNopMockExporter(), nil
+	}
+	p, err := newLoadBalancer(exportertest.NewNopCreateSettings(), cfg, componentFactory)
+	require.NotNil(t, p)
+	require.NoError(t, err)
+
+	p.res = &mockResolver{
+		triggerCallbacks: true,
+		onResolve: func(_ context.Context) ([]string, error) {
+			return []string{"endpoint-1", "endpoint-2", "endpoint-3"}, nil
+		},
+	}
+	require.NoError(t, p.Start(context.Background(), componenttest.NewNopHost()))
+	defer func() {
+		require.NoError(t, p.Shutdown(context.Background()))
+	}()
+
+	// test and verify
+	for i := 0; i < 100; i++ {
+		id := []byte(fmt.Sprintf("tenant-%d", i))
+		exps, err := p.exportersAndEndpoints(id)
+		require.NoError(t, err)
+		require.Len(t, exps, 2)
+
+		primary, endpoint, err := p.exporterAndEndpoint(id)
+		require.NoError(t, err)
+		assert.Equal(t, endpoint, exps[primary])
+	}
+
+	// pinned keys aren't replicated
+	exps, err := p.exportersAndEndpoints([]byte("big-tenant"))
+	require.NoError(t, err)
+	assert.Equal(t, map[*wrappedExporter]string{p.pinned["dedicated:4317"]: "dedicated:4317"}, exps)
+}
+
+func TestAdaptiveWeightingRebuildsRing(t *testing.T) {
+	// prepare
+	cfg := simpleConfig()
+	cfg.AdaptiveWeighting = &AdaptiveWeightingConfig{Interval: time.Hour}
+	componentFactory := func(_ context.Context, _ string) (component.Component, error) {
+		return newNopMockExporter(), nil
+	}
+	p, err := newLoadBalancer(exportertest.NewNopCreateSettings(), cfg, componentFactory)
+	require.NotNil(t, p)
+	require.NoError(t, err)
+
+	p.res = &mockResolver{
+		triggerCallbacks: true,
+		onResolve: func(_ context.Context) ([]string, error) {
+			return []string{"endpoint-1", "endpoint-2"}, nil
+		},
+	}
+	require.NoError(t, p.Start(context.Background(), componenttest.NewNopHost()))
+	defer func() {
+		require.NoError(t, p.Shutdown(context.Background()))
+	}()
+	require.Len(t, p.ring.items, 2*defaultWeight)
+
+	// test
+	p.observe(context.Background(), "endpoint-1", 10*time.Millisecond, nil)
+	p.observe(context.Background(), "endpoint-2", 50*time.Millisecond, nil)
+	p.updateWeights()
+
+	// verify
+	positions := map[string]int{}
+	for _, item := range p.ring.items {
+		positions[item.endpoint]++
+	}
+	assert.Equal(t, map[string]int{"endpoint-1": defaultWeight, "endpoint-2": 20}, positions)
+
+	// the weights survive the resolver updates
+	p.onBackendChanges([]string{"endpoint-1", "endpoint-2", "endpoint-3"})
+	assert.Len(t, p.ring.items, 2*defaultWeight+20)
+}
+
+func TestAffinityCache(t *testing.T) {
+	// prepare
+	cfg := simpleConfig()
+	cfg.AffinityCache = &AffinityCacheConfig{TTL: 200 * time.Millisecond}
+	componentFactory := func(_ context.Context, _ string) (component.Component, error) {
+		return newNopMockExporter(), nil
+	}
+	p, err := newLoadBalancer(exportertest.NewNopCreateSettings(), cfg, componentFactory)
+	require.NotNil(t, p)
+	require.NoError(t, err)
+
+	p.res = &mockResolver{
+		triggerCallbacks: true,
+		onResolve: func(_ context.Context) ([]string, error) {
+			return []string{"endpoint-1", "endpoint-2"}, nil
+		},
+	}
+	require.NoError(t, p.Start(context.Background(), componenttest.NewNopHost()))
+	defer func() {
+		require.NoError(t, p.Shutdown(context.Background()))
+	}()
+
+	routes := func() map[string]string {
+		routed := map[string]string{}
+		for i := 0; i < 100; i++ {
+			key := fmt.Sprintf("trace-%d", i)
+			_, endpoint, err := p.exporterAndEndpoint([]byte(key))
+			require.NoError(t, err)
+			routed[key] = endpoint
+		}
+		return routed
+	}
+	before := routes()
+
+	// test: scale up
+	p.onBackendChanges([]string{"endpoint-1", "endpoint-2", "endpoint-3"})
+
+	// verify: the keys stick to their endpoints, even though the ring moved some of them
+	assert.Equal(t, before, routes())
+	moved := 0
+	for key, endpoint := range before {
+		if p.ring.endpointFor([]byte(key)) != endpoint {
+			moved++
+		}
+	}
+	assert.Positive(t, moved)
+
+	// test: scale down
+	p.onBackendChanges([]string{"endpoint-2", "endpoint-3"})
+
+	// verify: only the keys of the removed endpoint move
+	for key, endpoint := range routes() {
+		if before[key] == "endpoint-2" {
+			assert.Equal(t, "endpoint-2", endpoint)
+		} else {
+			assert.Equal(t, p.ring.endpointFor([]byte(key)), endpoint)
+		}
+	}
+
+	// verify: once expired, the keys follow the ring
+	time.Sleep(300 * time.Millisecond)
+	for key, endpoint := range routes() {
+		assert.Equal(t, p.ring.endpointFor([]byte(key)), endpoint)
+	}
+}
+
+func TestFailoverMode(t *testing.T) {
+	// prepare
+	cfg := simpleConfig()
+	cfg.Resolver.Static.Hostnames = []string{"endpoint-2", "endpoint-1"}
+	cfg.Mode = modeFailover
+	cfg.ReplicationFactor = 2
+	componentFactory := func(_ context.Context, _ string) (component.Component, error) {
+		return newNopMockExporter(), nil
+	}
+	p, err := newLoadBalancer(exportertest.NewNopCreateSettings(), cfg, componentFactory)
+	require.NotNil(t, p)
+	require.NoError(t, err)
+
+	require.NoError(t, p.Start(context.Background(), componenttest.NewNopHost()))
+	defer func() {
+		require.NoError(t, p.Shutdown(context.Background()))
+	}()
+
+	// test
+	for i := 0; i < 10; i++ {
+		_, endpoint, err := p.exporterAndEndpoint([]byte(fmt.Sprintf("trace-%d", i)))
+		require.NoError(t, err)
+		assert.Equal(t, "endpoint-2", endpoint)
+	}
+	exps, err := p.exportersAndEndpoints([]byte("trace-1"))
+	require.NoError(t, err)
+	var endpoints []string
+	for _, endpoint := range exps {
+		endpoints = append(endpoints, endpoint)
+	}
+	assert.ElementsMatch(t, []string{"endpoint-2", "endpoint-1"}, endpoints)
+
+	p.observe(context.Background(), "endpoint-2", time.Millisecond, errors.New("unavailable"))
+	_, endpoint, err := p.exporterAndEndpoint([]byte("trace-1"))
+	require.NoError(t, err)
+	assert.Equal(t, "endpoint-1", endpoint)
+}
+
 func TestNewLoadBalancerInvalidNamespaceAwsResolver(t *testing.T) {
 	// prepare
 	cfg := &Config{
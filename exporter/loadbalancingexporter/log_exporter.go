@@ -5,27 +5,37 @@ package loadbalancingexporter // import "github.com/open-telemetry/opentelemetry
 
 import (
 	"context"
+	"errors"
 	"math/rand"
+	"strings"
 	"sync"
 	"time"
 
-	"go.opencensus.io/stats"
-	"go.opencensus.io/tag"
 	"go.opentelemetry.io/collector/component"
 	"go.opentelemetry.io/collector/consumer"
+	"go.opentelemetry.io/collector/consumer/consumererror"
 	"go.opentelemetry.io/collector/exporter"
 	"go.opentelemetry.io/collector/exporter/otlpexporter"
 	"go.opentelemetry.io/collector/pdata/pcommon"
 	"go.opentelemetry.io/collector/pdata/plog"
+	conventions "go.opentelemetry.io/collector/semconv/v1.6.1"
 	"go.uber.org/multierr"
 
+	"github.com/open-telemetry/opentelemetry-collector-contrib/exporter/loadbalancingexporter/internal/metadata"
 	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/batchpersignal"
 )
 
 var _ exporter.Logs = (*logExporterImp)(nil)
 
+type exporterLogs map[*wrappedExporter]plog.Logs
+
 type logExporterImp struct {
-	loadBalancer *loadBalancer
+	loadBalancer       *loadBalancer
+	routingKey         routingKey
+	routingAttributes  []string
+	routingScope       *RoutingScopeConfig
+	routingMetadataKey string
+	telemetry          *metadata.TelemetryBuilder
 
 	started    bool
 	shutdownWg sync.WaitGroup
@@ -36,16 +46,36 @@ func newLogsExporter(params exporter.CreateSettings, cfg component.Config) (*log
 	exporterFactory := otlpexporter.NewFactory()
 
 	lb, err := newLoadBalancer(params, cfg, func(ctx context.Context, endpoint string) (component.Component, error) {
-		oCfg := buildExporterConfig(cfg.(*Config), endpoint)
-		return exporterFactory.CreateLogsExporter(ctx, params, &oCfg)
+		return createEndpointExporter(ctx, cfg.(*Config), params, endpoint, component.DataTypeLogs, func(set exporter.CreateSettings, oCfg *otlpexporter.Config) (component.Component, error) {
+			return exporterFactory.CreateLogsExporter(ctx, set, oCfg)
+		})
 	})
 	if err != nil {
 		return nil, err
 	}
 
-	return &logExporterImp{
-		loadBalancer: lb,
-	}, nil
+	logExporter := logExporterImp{loadBalancer: lb, routingKey: traceIDRouting, routingScope: cfg.(*Config).RoutingScope, telemetry: lb.telemetry}
+
+	switch cfg.(*Config).RoutingKey {
+	case "traceID", "":
+	case "service":
+		logExporter.routingKey = svcRouting
+	case "resource":
+		logExporter.routingKey = resourceRouting
+		logExporter.routingAttributes = cfg.(*Config).RoutingAttributes
+	case "attributes":
+		logExporter.routingKey = attrRouting
+		logExporter.routingAttributes = cfg.(*Config).RoutingAttributes
+	case "logRecordAttribute":
+		logExporter.routingKey = logRecordAttrRouting
+		logExporter.routingAttributes = []string{cfg.(*Config).RoutingLogRecordAttribute}
+	case "metadata":
+		logExporter.routingKey = metadataRouting
+		logExporter.routingMetadataKey = cfg.(*Config).RoutingMetadataKey
+	default:
+		return nil, errUnsupportedRoutingKey(component.DataTypeLogs, cfg.(*Config).RoutingKey)
+	}
+	return &logExporter, nil
 }
 
 func (e *logExporterImp) Capabilities() consumer.Capabilities {
@@ -68,16 +98,41 @@ func (e *logExporterImp) Shutdown(ctx context.Context) error {
 }
 
 func (e *logExporterImp) ConsumeLogs(ctx context.Context, ld plog.Logs) error {
+	ctx, span := e.loadBalancer.startRoutingSpan(ctx, e.routingKey)
+	err := e.consumeLogs(ctx, ld)
+	endSpan(span, err)
+	return err
+}
+
+func (e *logExporterImp) consumeLogs(ctx context.Context, ld plog.Logs) error {
+	if e.routingKey != traceIDRouting {
+		return e.consumeByRoutingID(ctx, ld)
+	}
+
 	var errs error
+	var failures exportFailures
+	failed := plog.NewLogs()
 	batches := batchpersignal.SplitLogs(ld)
 	for _, batch := range batches {
-		errs = multierr.Append(errs, e.consumeLog(ctx, batch))
+		delivered, err := e.consumeLog(ctx, batch, &failures)
+		errs = multierr.Append(errs, err)
+		if !delivered {
+			batch.ResourceLogs().MoveAndAppendTo(failed.ResourceLogs())
+		}
 	}
 
-	return errs
+	errs = multierr.Append(errs, failures.finish(ctx, e.telemetry))
+	if failed.ResourceLogs().Len() == 0 {
+		// every batch was accepted by at least one of its replicas
+		return nil
+	}
+	// report the batches that couldn't be exported, so that only those are retried
+	return consumererror.NewLogs(errs, failed)
 }
 
-func (e *logExporterImp) consumeLog(ctx context.Context, ld plog.Logs) error {
+// consumeLog exports the batch to its endpoints, accounting for the failed exports, and returns whether the
+// batch was accepted by at least one of them.
+func (e *logExporterImp) consumeLog(ctx context.Context, ld plog.Logs, failures *exportFailures) (bool, error) {
 	traceID := traceIDFromLogs(ld)
 	balancingKey := traceID
 	if traceID == pcommon.NewTraceIDEmpty() {
@@ -87,30 +142,245 @@ func (e *logExporterImp) consumeLog(ctx context.Context, ld plog.Logs) error {
 		balancingKey = random()
 	}
 
-	le, endpoint, err := e.loadBalancer.exporterAndEndpoint(balancingKey[:])
+	exps, err := e.loadBalancer.exportersAndEndpoints(balancingKey[:])
 	if err != nil {
-		return err
+		return false, err
 	}
+	recordRouting(ctx, traceIDRouting, string(balancingKey[:]), exps)
+
+	delivered := false
+	for le, endpoint := range exps {
+		err := e.consumeLogWith(ctx, ld, le, endpoint)
+		failures.record(endpoint, ld.LogRecordCount(), err)
+		delivered = delivered || err == nil
+	}
+	return delivered, nil
+}
 
+func (e *logExporterImp) consumeLogWith(ctx context.Context, ld plog.Logs, le *wrappedExporter, endpoint string) error {
 	le.consumeWG.Add(1)
 	defer le.consumeWG.Done()
 
+	logRecordCount := ld.LogRecordCount()
+	exportCtx, span := e.loadBalancer.startExportSpan(e.loadBalancer.propagation.OutgoingContext(ctx), endpoint, logRecordCount)
+	e.loadBalancer.exportStarted(ctx, endpoint)
 	start := time.Now()
-	err = le.ConsumeLogs(ctx, ld)
+	err := le.ConsumeLogs(exportCtx, ld)
 	duration := time.Since(start)
-	if err == nil {
-		_ = stats.RecordWithTags(
-			ctx,
-			[]tag.Mutator{tag.Upsert(endpointTagKey, endpoint), successTrueMutator},
-			mBackendLatency.M(duration.Milliseconds()))
+	endSpan(span, err)
+
+	e.loadBalancer.observe(ctx, endpoint, duration, err)
+	attrs := endpointAttrs(endpoint, err == nil)
+	e.telemetry.LoadbalancerBackendLatency.Record(ctx, duration.Milliseconds(), attrs)
+	e.telemetry.LoadbalancerBackendOutcome.Add(ctx, 1, attrs)
+	e.telemetry.LoadbalancerRoutedLogRecords.Add(ctx, int64(logRecordCount), routedAttrs(endpoint, traceIDRouting))
+
+	return err
+}
+
+// consumeByRoutingID splits the logs per routing identifier and sends each split to the backend
+// responsible for it, merging the splits going to the same backend.
+func (e *logExporterImp) consumeByRoutingID(ctx context.Context, ld plog.Logs) error {
+	var batches map[string]plog.Logs
+	if e.routingKey == metadataRouting {
+		// all the data in the request shares the same routing identifier
+		batches = map[string]plog.Logs{metadataRoutingID(ctx, e.routingMetadataKey): ld}
 	} else {
-		_ = stats.RecordWithTags(
-			ctx,
-			[]tag.Mutator{tag.Upsert(endpointTagKey, endpoint), successFalseMutator},
-			mBackendLatency.M(duration.Milliseconds()))
+		var err error
+		if batches, err = splitLogsByRoutingID(ld, e.routingKey, e.routingAttributes, e.routingScope); err != nil {
+			return err
+		}
 	}
 
-	return err
+	exporterSegregatedLogs := make(exporterLogs)
+	endpoints := make(map[*wrappedExporter]string)
+	var routedBatches []routedBatch
+	for rid, batch := range batches {
+		exps, err := e.loadBalancer.exportersAndEndpoints([]byte(rid))
+		if err != nil {
+			return err
+		}
+		recordRouting(ctx, e.routingKey, rid, exps)
+
+		routed := routedBatch{offsets: make(map[*wrappedExporter]int, len(exps))}
+		for exp, endpoint := range exps {
+			data := batch
+			if len(exps) > 1 {
+				// merging moves the data, so each replica gets its own copy
+				data = plog.NewLogs()
+				batch.CopyTo(data)
+			}
+
+			_, ok := exporterSegregatedLogs[exp]
+			if !ok {
+				exp.consumeWG.Add(1)
+				exporterSegregatedLogs[exp] = plog.NewLogs()
+			}
+			routed.offsets[exp] = exporterSegregatedLogs[exp].ResourceLogs().Len()
+			routed.resources = data.ResourceLogs().Len()
+			exporterSegregatedLogs[exp] = mergeLogs(exporterSegregatedLogs[exp], data)
+
+			endpoints[exp] = endpoint
+		}
+		routedBatches = append(routedBatches, routed)
+	}
+
+	var failures exportFailures
+	failedExporters := make(map[*wrappedExporter]bool)
+	outgoingCtx := e.loadBalancer.propagation.OutgoingContext(ctx)
+
+	// all the exports are started before waiting for any of them, so that the queued ones run concurrently
+	results := make(map[*wrappedExporter]<-chan error, len(exporterSegregatedLogs))
+	logRecordCounts := make(map[*wrappedExporter]int, len(exporterSegregatedLogs))
+	for exp, ld := range exporterSegregatedLogs {
+		exp, ld := exp, ld
+		logRecordCounts[exp] = ld.LogRecordCount()
+		results[exp] = e.loadBalancer.exportTo(ctx, outgoingCtx, exp, endpoints[exp], logRecordCounts[exp], func(exportCtx context.Context) error {
+			return exp.ConsumeLogs(exportCtx, ld)
+		})
+
+		e.telemetry.LoadbalancerRoutedLogRecords.Add(ctx, int64(logRecordCounts[exp]), routedAttrs(endpoints[exp], e.routingKey))
+	}
+
+	for exp, result := range results {
+		err := <-result
+		failures.record(endpoints[exp], logRecordCounts[exp], err)
+		if err != nil {
+			failedExporters[exp] = true
+		}
+	}
+
+	exportErr := failures.finish(ctx, e.telemetry)
+	if exportErr == nil {
+		return nil
+	}
+	// report the batches that couldn't be exported to any of their endpoints, so that only those are retried
+	failed := plog.NewLogs()
+	for _, routed := range routedBatches {
+		exp, offset, ok := routed.failedIn(failedExporters)
+		if !ok {
+			continue
+		}
+		resources := exporterSegregatedLogs[exp].ResourceLogs()
+		for i := offset; i < offset+routed.resources; i++ {
+			resources.At(i).CopyTo(failed.ResourceLogs().AppendEmpty())
+		}
+	}
+	if failed.ResourceLogs().Len() == 0 {
+		// every batch was accepted by at least one of its replicas
+		return nil
+	}
+	return consumererror.NewLogs(exportErr, failed)
+}
+
+// splitLogsByRoutingID splits the logs into one plog.Logs per routing identifier. With service and resource
+// routing, whole resources are routed together, or whole scopes when the scope is part of the routing, while
+// with attribute routing each log record is routed on its own, as its attributes might differ from the ones of
+// its siblings.
+func splitLogsByRoutingID(ld plog.Logs, key routingKey, attrKeys []string, scopeCfg *RoutingScopeConfig) (map[string]plog.Logs, error) {
+	batches := make(map[string]plog.Logs)
+	batchFor := func(rid string) plog.Logs {
+		batch, ok := batches[rid]
+		if !ok {
+			batch = plog.NewLogs()
+			batches[rid] = batch
+		}
+		return batch
+	}
+
+	// appendResource appends the whole resource to the batch of the routing identifier, or each of its scopes to
+	// the batch of the routing identifier with the scope
+	appendResource := func(rl plog.ResourceLogs, rid string) {
+		if scopeCfg == nil {
+			rl.CopyTo(batchFor(rid).ResourceLogs().AppendEmpty())
+			return
+		}
+		for j := 0; j < rl.ScopeLogs().Len(); j++ {
+			sl := rl.ScopeLogs().At(j)
+			destRL := batchFor(rid + scopeRoutingID(sl.Scope(), scopeCfg)).ResourceLogs().AppendEmpty()
+			rl.Resource().CopyTo(destRL.Resource())
+			destRL.SetSchemaUrl(rl.SchemaUrl())
+			sl.CopyTo(destRL.ScopeLogs().AppendEmpty())
+		}
+	}
+
+	rls := ld.ResourceLogs()
+	for i := 0; i < rls.Len(); i++ {
+		rl := rls.At(i)
+		switch key {
+		case svcRouting:
+			svc, ok := rl.Resource().Attributes().Get(conventions.AttributeServiceName)
+			if !ok {
+				return nil, errors.New("unable to get service name")
+			}
+			appendResource(rl, svc.Str())
+		case resourceRouting:
+			appendResource(rl, resourceRoutingID(rl.Resource().Attributes(), attrKeys))
+		case attrRouting, logRecordAttrRouting:
+			for j := 0; j < rl.ScopeLogs().Len(); j++ {
+				sl := rl.ScopeLogs().At(j)
+				// the destination scope for each routing identifier, so that the resource and the scope
+				// are copied only once per routing identifier
+				scopes := make(map[string]plog.ScopeLogs)
+				for k := 0; k < sl.LogRecords().Len(); k++ {
+					lr := sl.LogRecords().At(k)
+					var rid string
+					if key == logRecordAttrRouting {
+						rid = logRecordAttributeRoutingID(attrKeys[0], rl.Resource().Attributes(), lr.Attributes())
+					} else {
+						rid = attributesRoutingID(attrKeys, rl.Resource().Attributes(), lr.Attributes())
+					}
+					rid += scopeRoutingID(sl.Scope(), scopeCfg)
+					dest, ok := scopes[rid]
+					if !ok {
+						destRL := batchFor(rid).ResourceLogs().AppendEmpty()
+						rl.Resource().CopyTo(destRL.Resource())
+						destRL.SetSchemaUrl(rl.SchemaUrl())
+						dest = destRL.ScopeLogs().AppendEmpty()
+						sl.Scope().CopyTo(dest.Scope())
+						dest.SetSchemaUrl(sl.SchemaUrl())
+						scopes[rid] = dest
+					}
+					lr.CopyTo(dest.LogRecords().AppendEmpty())
+				}
+			}
+		}
+	}
+	return batches, nil
+}
+
+// attributesRoutingID builds the routing identifier out of the values of the given attributes. Each attribute
+// is looked up in the resource attributes first, and then in the record attributes. Missing attributes
+// contribute an empty value, so records missing all of them are consistently routed to the same backend.
+func attributesRoutingID(keys []string, resourceAttrs, recordAttrs pcommon.Map) string {
+	var b strings.Builder
+	for i, key := range keys {
+		if i > 0 {
+			b.WriteByte(0)
+		}
+		v, ok := resourceAttrs.Get(key)
+		if !ok {
+			v, ok = recordAttrs.Get(key)
+		}
+		if ok {
+			b.WriteString(v.AsString())
+		}
+	}
+	return b.String()
+}
+
+// logRecordAttributeRoutingID returns the value of the attribute as routing identifier, looking it up in the record
+// attributes first, and then in the resource attributes. Records without the attribute are all routed to the same
+// backend.
+func logRecordAttributeRoutingID(key string, resourceAttrs, recordAttrs pcommon.Map) string {
+	v, ok := recordAttrs.Get(key)
+	if !ok {
+		v, ok = resourceAttrs.Get(key)
+	}
+	if !ok {
+		return ""
+	}
+	return v.AsString()
 }
 
 func traceIDFromLogs(ld plog.Logs) pcommon.TraceID {
@@ -18,6 +18,7 @@ import (
 	"go.opentelemetry.io/collector/component"
 	"go.opentelemetry.io/collector/component/componenttest"
 	"go.opentelemetry.io/collector/consumer"
+	"go.opentelemetry.io/collector/consumer/consumererror"
 	"go.opentelemetry.io/collector/consumer/consumertest"
 	"go.opentelemetry.io/collector/exporter"
 	"go.opentelemetry.io/collector/exporter/exportertest"
@@ -181,7 +182,7 @@ func TestConsumeLogsUnexpectedExporterType(t *testing.T) {
 
 	// verify
 	assert.Error(t, res)
-	assert.EqualError(t, res, fmt.Sprintf("unable to export logs, unexpected exporter type: expected exporter.Logs but got %T", newNopMockExporter()))
+	assert.ErrorContains(t, res, fmt.Sprintf("unable to export logs, unexpected exporter type: expected exporter.Logs but got %T", newNopMockExporter()))
 }
 
 func TestLogBatchWithTwoTraces(t *testing.T) {
@@ -223,6 +224,167 @@ func TestLogBatchWithTwoTraces(t *testing.T) {
 	assert.Len(t, sink.AllLogs(), 2)
 }
 
+func TestNewLogsExporterUnsupportedRoutingKey(t *testing.T) {
+	cfg := simpleConfig()
+	cfg.RoutingKey = "metric"
+
+	_, err := newLogsExporter(exportertest.NewNopCreateSettings(), cfg)
+
+	assert.EqualError(t, err, `routing_key "metric" can't be used for logs, supported values are "traceID", "service", "resource", "attributes", "logRecordAttribute" and "metadata"`)
+}
+
+func TestConsumeLogsAttributeRouting(t *testing.T) {
+	sinks := map[string]*consumertest.LogsSink{
+		"endpoint-1:4317": new(consumertest.LogsSink),
+		"endpoint-2:4317": new(consumertest.LogsSink),
+	}
+	componentFactory := func(_ context.Context, endpoint string) (component.Component, error) {
+		return newMockLogsExporter(sinks[endpoint].ConsumeLogs), nil
+	}
+	cfg := simpleConfig()
+	cfg.Resolver.Static.Hostnames = []string{"endpoint-1", "endpoint-2"}
+	cfg.RoutingKey = "attributes"
+	cfg.RoutingAttributes = []string{"tenant"}
+	lb, err := newLoadBalancer(exportertest.NewNopCreateSettings(), cfg, componentFactory)
+	require.NoError(t, err)
+
+	p, err := newLogsExporter(exportertest.NewNopCreateSettings(), cfg)
+	require.NoError(t, err)
+	p.loadBalancer = lb
+
+	require.NoError(t, p.Start(context.Background(), componenttest.NewNopHost()))
+	defer func() {
+		require.NoError(t, p.Shutdown(context.Background()))
+	}()
+
+	ld := plog.NewLogs()
+	sl := ld.ResourceLogs().AppendEmpty().ScopeLogs().AppendEmpty()
+	for i := 0; i < 20; i++ {
+		sl.LogRecords().AppendEmpty().Attributes().PutStr("tenant", fmt.Sprintf("tenant-%d", i%10))
+	}
+
+	// test
+	require.NoError(t, p.ConsumeLogs(context.Background(), ld))
+
+	// verify
+	total := 0
+	tenantEndpoints := map[string]string{}
+	for endpoint, sink := range sinks {
+		for _, batch := range sink.AllLogs() {
+			total += batch.LogRecordCount()
+			for i := 0; i < batch.ResourceLogs().Len(); i++ {
+				records := batch.ResourceLogs().At(i).ScopeLogs().At(0).LogRecords()
+				for j := 0; j < records.Len(); j++ {
+					tenant, _ := records.At(j).Attributes().Get("tenant")
+					if previous, ok := tenantEndpoints[tenant.Str()]; ok {
+						assert.Equal(t, previous, endpoint, "records of the same tenant should go to the same endpoint")
+					}
+					tenantEndpoints[tenant.Str()] = endpoint
+				}
+			}
+		}
+	}
+	assert.Equal(t, 20, total)
+	assert.Len(t, tenantEndpoints, 10)
+}
+
+func TestSplitLogsByRoutingID(t *testing.T) {
+	newLogs := func() plog.Logs {
+		ld := plog.NewLogs()
+		for _, svc := range []string{"svc-a", "svc-b", "svc-a"} {
+			rl := ld.ResourceLogs().AppendEmpty()
+			rl.Resource().Attributes().PutStr("service.name", svc)
+			rl.Resource().Attributes().PutStr("region", "eu")
+			sl := rl.ScopeLogs().AppendEmpty()
+			sl.Scope().SetName("scope")
+			sl.LogRecords().AppendEmpty().Attributes().PutStr("tenant", "t1")
+			sl.LogRecords().AppendEmpty().Attributes().PutStr("tenant", "t2")
+			sl.LogRecords().AppendEmpty()
+		}
+		return ld
+	}
+
+	t.Run("service", func(t *testing.T) {
+		batches, err := splitLogsByRoutingID(newLogs(), svcRouting, nil, nil)
+		require.NoError(t, err)
+		require.Len(t, batches, 2)
+		assert.Equal(t, 2, batches["svc-a"].ResourceLogs().Len())
+		assert.Equal(t, 6, batches["svc-a"].LogRecordCount())
+		assert.Equal(t, 3, batches["svc-b"].LogRecordCount())
+	})
+
+	t.Run("missing service", func(t *testing.T) {
+		_, err := splitLogsByRoutingID(simpleLogs(), svcRouting, nil, nil)
+		assert.EqualError(t, err, "unable to get service name")
+	})
+
+	t.Run("resource", func(t *testing.T) {
+		batches, err := splitLogsByRoutingID(newLogs(), resourceRouting, nil, nil)
+		require.NoError(t, err)
+		assert.Len(t, batches, 2)
+	})
+
+	t.Run("service and scope", func(t *testing.T) {
+		ld := newLogs()
+		sl := ld.ResourceLogs().At(0).ScopeLogs().AppendEmpty()
+		sl.Scope().SetName("other-scope")
+		sl.LogRecords().AppendEmpty()
+
+		batches, err := splitLogsByRoutingID(ld, svcRouting, nil, &RoutingScopeConfig{})
+		require.NoError(t, err)
+		require.Len(t, batches, 3)
+		assert.Equal(t, 6, batches["svc-a\x00scope"].LogRecordCount())
+		assert.Equal(t, 1, batches["svc-a\x00other-scope"].LogRecordCount())
+		assert.Equal(t, 3, batches["svc-b\x00scope"].LogRecordCount())
+	})
+
+	t.Run("attributes", func(t *testing.T) {
+		batches, err := splitLogsByRoutingID(newLogs(), attrRouting, []string{"region", "tenant"}, nil)
+		require.NoError(t, err)
+		require.Len(t, batches, 3)
+
+		t1 := batches[attributesRoutingID([]string{"region", "tenant"}, pcommon.NewMap(), recordWithTenant("eu", "t1"))]
+		assert.Equal(t, 3, t1.ResourceLogs().Len())
+		assert.Equal(t, 3, t1.LogRecordCount())
+		assert.Equal(t, "scope", t1.ResourceLogs().At(0).ScopeLogs().At(0).Scope().Name())
+		svc, _ := t1.ResourceLogs().At(1).Resource().Attributes().Get("service.name")
+		assert.Equal(t, "svc-b", svc.Str())
+
+		// records without the attribute are kept together
+		missing := batches[attributesRoutingID([]string{"region", "tenant"}, pcommon.NewMap(), recordWithTenant("eu", ""))]
+		assert.Equal(t, 3, missing.LogRecordCount())
+	})
+
+	t.Run("log record attribute", func(t *testing.T) {
+		ld := newLogs()
+		// the record attribute takes precedence over the resource one
+		ld.ResourceLogs().At(1).Resource().Attributes().PutStr("tenant", "t3")
+
+		batches, err := splitLogsByRoutingID(ld, logRecordAttrRouting, []string{"tenant"}, nil)
+		require.NoError(t, err)
+		require.Len(t, batches, 4)
+		assert.Equal(t, 3, batches["t1"].LogRecordCount())
+		assert.Equal(t, 3, batches["t2"].LogRecordCount())
+		assert.Equal(t, 1, batches["t3"].LogRecordCount())
+		assert.Equal(t, 2, batches[""].LogRecordCount())
+
+		// the records of a scope going to the same backend are kept in a single scope
+		t1 := batches["t1"]
+		assert.Equal(t, 3, t1.ResourceLogs().Len())
+		assert.Equal(t, 1, t1.ResourceLogs().At(0).ScopeLogs().Len())
+		assert.Equal(t, "scope", t1.ResourceLogs().At(0).ScopeLogs().At(0).Scope().Name())
+	})
+}
+
+func recordWithTenant(region, tenant string) pcommon.Map {
+	attrs := pcommon.NewMap()
+	attrs.PutStr("region", region)
+	if tenant != "" {
+		attrs.PutStr("tenant", tenant)
+	}
+	return attrs
+}
+
 func TestNoLogsInBatch(t *testing.T) {
 	for _, tt := range []struct {
 		desc  string
@@ -348,7 +510,8 @@ func TestRollingUpdatesWhenConsumeLogs(t *testing.T) {
 
 	// simulate rolling updates, the dns resolver should resolve in the following order
 	// ["127.0.0.1"] -> ["127.0.0.1", "127.0.0.2"] -> ["127.0.0.2"]
-	res, err := newDNSResolver(zap.NewNop(), "service-1", "", 5*time.Second, 1*time.Second)
+	tb := newTestTelemetryBuilder(t)
+	res, err := newDNSResolver(zap.NewNop(), "service-1", "", 5*time.Second, 1*time.Second, tb)
 	require.NoError(t, err)
 
 	mu := sync.Mutex{}
@@ -479,6 +642,62 @@ func TestRollingUpdatesWhenConsumeLogs(t *testing.T) {
 	require.Greater(t, counter2.Load(), int64(0))
 }
 
+func TestConsumeLogsPartialFailure(t *testing.T) {
+	failingEndpoint := endpointWithPort("endpoint-1")
+	componentFactory := func(_ context.Context, endpoint string) (component.Component, error) {
+		return newMockLogsExporter(func(_ context.Context, _ plog.Logs) error {
+			if endpoint == failingEndpoint {
+				return errors.New("backend unavailable")
+			}
+			return nil
+		}), nil
+	}
+	lb, err := newLoadBalancer(exportertest.NewNopCreateSettings(), simpleConfig(), componentFactory)
+	require.NoError(t, err)
+
+	p, err := newLogsExporter(exportertest.NewNopCreateSettings(), simpleConfig())
+	require.NoError(t, err)
+
+	lb.res = &mockResolver{
+		triggerCallbacks: true,
+		onResolve: func(_ context.Context) ([]string, error) {
+			return []string{"endpoint-1", "endpoint-2", "endpoint-3"}, nil
+		},
+	}
+	p.loadBalancer = lb
+
+	require.NoError(t, p.Start(context.Background(), componenttest.NewNopHost()))
+	defer func() {
+		require.NoError(t, p.Shutdown(context.Background()))
+	}()
+
+	// find logs routed to the failing endpoint and to the other ones
+	ld := plog.NewLogs()
+	expectedFailed := map[pcommon.TraceID]bool{}
+	for i := byte(0); i < 20; i++ {
+		tid := pcommon.TraceID([16]byte{i, 1, 2, 3})
+		ld.ResourceLogs().AppendEmpty().ScopeLogs().AppendEmpty().LogRecords().AppendEmpty().SetTraceID(tid)
+		if endpointWithPort(lb.ring.endpointFor(tid[:])) == failingEndpoint {
+			expectedFailed[tid] = true
+		}
+	}
+	require.NotEmpty(t, expectedFailed)
+	require.Less(t, len(expectedFailed), 20)
+
+	// test
+	err = p.ConsumeLogs(context.Background(), ld)
+
+	// verify: only the logs routed to the failing endpoint are returned for retry
+	var logsErr consumererror.Logs
+	require.ErrorAs(t, err, &logsErr)
+	failed := map[pcommon.TraceID]bool{}
+	rls := logsErr.Data().ResourceLogs()
+	for i := 0; i < rls.Len(); i++ {
+		failed[rls.At(i).ScopeLogs().At(0).LogRecords().At(0).TraceID()] = true
+	}
+	assert.Equal(t, expectedFailed, failed)
+}
+
 func randomLogs() plog.Logs {
 	return simpleLogWithID(random())
 }
@@ -6,23 +6,21 @@ package loadbalancingexporter // import "github.com/open-telemetry/opentelemetry
 import (
 	"context"
 	"errors"
-	"fmt"
 	"sort"
+	"strconv"
 	"strings"
 	"sync"
-	"time"
 
-	"go.opencensus.io/stats"
-	"go.opencensus.io/tag"
 	"go.opentelemetry.io/collector/component"
 	"go.opentelemetry.io/collector/consumer"
+	"go.opentelemetry.io/collector/consumer/consumererror"
 	"go.opentelemetry.io/collector/exporter"
 	"go.opentelemetry.io/collector/exporter/otlpexporter"
 	"go.opentelemetry.io/collector/pdata/pcommon"
 	"go.opentelemetry.io/collector/pdata/pmetric"
 	conventions "go.opentelemetry.io/collector/semconv/v1.6.1"
-	"go.uber.org/multierr"
 
+	"github.com/open-telemetry/opentelemetry-collector-contrib/exporter/loadbalancingexporter/internal/metadata"
 	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/batchpersignal"
 )
 
@@ -31,8 +29,12 @@ var _ exporter.Metrics = (*metricExporterImp)(nil)
 type exporterMetrics map[*wrappedExporter]pmetric.Metrics
 
 type metricExporterImp struct {
-	loadBalancer *loadBalancer
-	routingKey   routingKey
+	loadBalancer       *loadBalancer
+	routingKey         routingKey
+	routingAttributes  []string
+	routingScope       *RoutingScopeConfig
+	routingMetadataKey string
+	telemetry          *metadata.TelemetryBuilder
 
 	stopped    bool
 	shutdownWg sync.WaitGroup
@@ -42,14 +44,15 @@ func newMetricsExporter(params exporter.CreateSettings, cfg component.Config) (*
 	exporterFactory := otlpexporter.NewFactory()
 
 	lb, err := newLoadBalancer(params, cfg, func(ctx context.Context, endpoint string) (component.Component, error) {
-		oCfg := buildExporterConfig(cfg.(*Config), endpoint)
-		return exporterFactory.CreateMetricsExporter(ctx, params, &oCfg)
+		return createEndpointExporter(ctx, cfg.(*Config), params, endpoint, component.DataTypeMetrics, func(set exporter.CreateSettings, oCfg *otlpexporter.Config) (component.Component, error) {
+			return exporterFactory.CreateMetricsExporter(ctx, set, oCfg)
+		})
 	})
 	if err != nil {
 		return nil, err
 	}
 
-	metricExporter := metricExporterImp{loadBalancer: lb, routingKey: svcRouting}
+	metricExporter := metricExporterImp{loadBalancer: lb, routingKey: svcRouting, routingScope: cfg.(*Config).RoutingScope, telemetry: lb.telemetry}
 
 	switch cfg.(*Config).RoutingKey {
 	case "service", "":
@@ -57,10 +60,16 @@ func newMetricsExporter(params exporter.CreateSettings, cfg component.Config) (*
 		metricExporter.routingKey = svcRouting
 	case "resource":
 		metricExporter.routingKey = resourceRouting
+		metricExporter.routingAttributes = cfg.(*Config).RoutingAttributes
 	case "metric":
 		metricExporter.routingKey = metricNameRouting
+	case "streamID":
+		metricExporter.routingKey = streamRouting
+	case "metadata":
+		metricExporter.routingKey = metadataRouting
+		metricExporter.routingMetadataKey = cfg.(*Config).RoutingMetadataKey
 	default:
-		return nil, fmt.Errorf("unsupported routing_key: %q", cfg.(*Config).RoutingKey)
+		return nil, errUnsupportedRoutingKey(component.DataTypeMetrics, cfg.(*Config).RoutingKey)
 	}
 	return &metricExporter, nil
 
@@ -82,62 +91,131 @@ func (e *metricExporterImp) Shutdown(ctx context.Context) error {
 }
 
 func (e *metricExporterImp) ConsumeMetrics(ctx context.Context, md pmetric.Metrics) error {
-	batches := batchpersignal.SplitMetrics(md)
+	ctx, span := e.loadBalancer.startRoutingSpan(ctx, e.routingKey)
+	err := e.consumeMetrics(ctx, md)
+	endSpan(span, err)
+	return err
+}
+
+func (e *metricExporterImp) consumeMetrics(ctx context.Context, md pmetric.Metrics) error {
+	var batches []pmetric.Metrics
+	switch e.routingKey {
+	case metadataRouting:
+		// all the data in the request shares the same routing identifier
+		batches = []pmetric.Metrics{md}
+	case streamRouting:
+		for _, batch := range splitMetricsByStream(md) {
+			batches = append(batches, batch)
+		}
+	default:
+		batches = batchpersignal.SplitMetrics(md)
+	}
 
 	exporterSegregatedMetrics := make(exporterMetrics)
 	endpoints := make(map[*wrappedExporter]string)
+	var routedBatches []routedBatch
 
 	for _, batch := range batches {
-		routingIDs, err := routingIdentifiersFromMetrics(batch, e.routingKey)
-		if err != nil {
-			return err
+		var routedData map[string]pmetric.Metrics
+		switch e.routingKey {
+		case metadataRouting:
+			routedData = map[string]pmetric.Metrics{metadataRoutingID(ctx, e.routingMetadataKey): batch}
+		case streamRouting:
+			routedData = map[string]pmetric.Metrics{streamRoutingKey(batch): batch}
+		default:
+			var err error
+			if routedData, err = splitMetricsByRoutingID(batch, e.routingKey, e.routingAttributes); err != nil {
+				return err
+			}
+			if e.routingScope != nil {
+				// each batch holds a single scope
+				routedData = withScopeRoutingID(routedData, scopeRoutingID(batch.ResourceMetrics().At(0).ScopeMetrics().At(0).Scope(), e.routingScope))
+			}
 		}
 
-		for rid := range routingIDs {
-			exp, endpoint, err := e.loadBalancer.exporterAndEndpoint([]byte(rid))
+		for rid, ridData := range routedData {
+			exps, err := e.loadBalancer.exportersAndEndpoints([]byte(rid))
 			if err != nil {
 				return err
 			}
+			recordRouting(ctx, e.routingKey, rid, exps)
+
+			routed := routedBatch{offsets: make(map[*wrappedExporter]int, len(exps))}
+			for exp, endpoint := range exps {
+				data := ridData
+				if len(exps) > 1 {
+					// merging moves the data, so each replica gets its own copy
+					data = pmetric.NewMetrics()
+					ridData.CopyTo(data)
+				}
 
-			_, ok := exporterSegregatedMetrics[exp]
-			if !ok {
-				exp.consumeWG.Add(1)
-				exporterSegregatedMetrics[exp] = pmetric.NewMetrics()
-			}
-			exporterSegregatedMetrics[exp] = mergeMetrics(exporterSegregatedMetrics[exp], batch)
+				_, ok := exporterSegregatedMetrics[exp]
+				if !ok {
+					exp.consumeWG.Add(1)
+					exporterSegregatedMetrics[exp] = pmetric.NewMetrics()
+				}
+				routed.offsets[exp] = exporterSegregatedMetrics[exp].ResourceMetrics().Len()
+				routed.resources = data.ResourceMetrics().Len()
+				exporterSegregatedMetrics[exp] = mergeMetrics(exporterSegregatedMetrics[exp], data)
 
-			endpoints[exp] = endpoint
+				endpoints[exp] = endpoint
+			}
+			routedBatches = append(routedBatches, routed)
 		}
 	}
 
-	var errs error
+	var failures exportFailures
+	failedExporters := make(map[*wrappedExporter]bool)
+	outgoingCtx := e.loadBalancer.propagation.OutgoingContext(ctx)
 
+	// all the exports are started before waiting for any of them, so that the queued ones run concurrently
+	results := make(map[*wrappedExporter]<-chan error, len(exporterSegregatedMetrics))
+	dataPointCounts := make(map[*wrappedExporter]int, len(exporterSegregatedMetrics))
 	for exp, metrics := range exporterSegregatedMetrics {
-		start := time.Now()
-		err := exp.ConsumeMetrics(ctx, metrics)
-		exp.consumeWG.Done()
-		duration := time.Since(start)
-		errs = multierr.Append(errs, err)
-
-		if err == nil {
-			_ = stats.RecordWithTags(
-				ctx,
-				[]tag.Mutator{tag.Upsert(endpointTagKey, endpoints[exp]), successTrueMutator},
-				mBackendLatency.M(duration.Milliseconds()))
-		} else {
-			_ = stats.RecordWithTags(
-				ctx,
-				[]tag.Mutator{tag.Upsert(endpointTagKey, endpoints[exp]), successFalseMutator},
-				mBackendLatency.M(duration.Milliseconds()))
+		exp, metrics := exp, metrics
+		dataPointCounts[exp] = metrics.DataPointCount()
+		results[exp] = e.loadBalancer.exportTo(ctx, outgoingCtx, exp, endpoints[exp], dataPointCounts[exp], func(exportCtx context.Context) error {
+			return exp.ConsumeMetrics(exportCtx, metrics)
+		})
+
+		e.telemetry.LoadbalancerRoutedDataPoints.Add(ctx, int64(dataPointCounts[exp]), routedAttrs(endpoints[exp], e.routingKey))
+	}
+
+	for exp, result := range results {
+		err := <-result
+		failures.record(endpoints[exp], dataPointCounts[exp], err)
+		if err != nil {
+			failedExporters[exp] = true
 		}
 	}
 
-	return errs
+	exportErr := failures.finish(ctx, e.telemetry)
+	if exportErr == nil {
+		return nil
+	}
+	// report the batches that couldn't be exported to any of their endpoints, so that only those are retried
+	failed := pmetric.NewMetrics()
+	for _, routed := range routedBatches {
+		exp, offset, ok := routed.failedIn(failedExporters)
+		if !ok {
+			continue
+		}
+		resources := exporterSegregatedMetrics[exp].ResourceMetrics()
+		for i := offset; i < offset+routed.resources; i++ {
+			resources.At(i).CopyTo(failed.ResourceMetrics().AppendEmpty())
+		}
+	}
+	if failed.ResourceMetrics().Len() == 0 {
+		// every batch was accepted by at least one of its replicas
+		return nil
+	}
+	return consumererror.NewMetrics(exportErr, failed)
 }
 
-func routingIdentifiersFromMetrics(mds pmetric.Metrics, key routingKey) (map[string]bool, error) {
-	ids := make(map[string]bool)
-
+// splitMetricsByRoutingID splits the metrics into one pmetric.Metrics per routing identifier, so that each
+// endpoint only gets the resources, or the metrics, routed to it. With service routing, whole resources are
+// routed together, while with metric name and resource routing each metric is routed on its own.
+func splitMetricsByRoutingID(mds pmetric.Metrics, key routingKey, attrKeys []string) (map[string]pmetric.Metrics, error) {
 	// no need to test "empty labels"
 	// no need to test "empty resources"
 
@@ -156,8 +234,19 @@ func routingIdentifiersFromMetrics(mds pmetric.Metrics, key routingKey) (map[str
 		return nil, errors.New("empty metrics")
 	}
 
+	batches := make(map[string]pmetric.Metrics)
+	batchFor := func(rid string) pmetric.Metrics {
+		batch, ok := batches[rid]
+		if !ok {
+			batch = pmetric.NewMetrics()
+			batches[rid] = batch
+		}
+		return batch
+	}
+
 	for i := 0; i < rs.Len(); i++ {
-		resource := rs.At(i).Resource()
+		rm := rs.At(i)
+		resource := rm.Resource()
 		switch key {
 		default:
 		case svcRouting, traceIDRouting:
@@ -165,35 +254,38 @@ func routingIdentifiersFromMetrics(mds pmetric.Metrics, key routingKey) (map[str
 			if !ok {
 				return nil, errors.New("unable to get service name")
 			}
-			ids[svc.Str()] = true
-		case metricNameRouting:
-			sm := rs.At(i).ScopeMetrics()
-			for j := 0; j < sm.Len(); j++ {
-				metrics := sm.At(j).Metrics()
-				for k := 0; k < metrics.Len(); k++ {
-					md := metrics.At(k)
+			rm.CopyTo(batchFor(svc.Str()).ResourceMetrics().AppendEmpty())
+		case metricNameRouting, resourceRouting:
+			for j := 0; j < rm.ScopeMetrics().Len(); j++ {
+				sm := rm.ScopeMetrics().At(j)
+				// the destination scope for each routing identifier, so that the resource and the scope
+				// are copied only once per routing identifier
+				scopes := make(map[string]pmetric.ScopeMetrics)
+				for k := 0; k < sm.Metrics().Len(); k++ {
+					md := sm.Metrics().At(k)
 					rKey := metricRoutingKey(md)
-					ids[rKey] = true
-				}
-			}
-		case resourceRouting:
-			sm := rs.At(i).ScopeMetrics()
-			for j := 0; j < sm.Len(); j++ {
-				metrics := sm.At(j).Metrics()
-				for k := 0; k < metrics.Len(); k++ {
-					md := metrics.At(k)
-					rKey := resourceRoutingKey(md, resource.Attributes())
-					ids[rKey] = true
+					if key == resourceRouting {
+						rKey = resourceRoutingKey(md, resource.Attributes(), attrKeys)
+					}
+					dest, ok := scopes[rKey]
+					if !ok {
+						destRM := batchFor(rKey).ResourceMetrics().AppendEmpty()
+						resource.CopyTo(destRM.Resource())
+						destRM.SetSchemaUrl(rm.SchemaUrl())
+						dest = destRM.ScopeMetrics().AppendEmpty()
+						sm.Scope().CopyTo(dest.Scope())
+						dest.SetSchemaUrl(sm.SchemaUrl())
+						scopes[rKey] = dest
+					}
+					md.CopyTo(dest.Metrics().AppendEmpty())
 				}
 			}
 		}
 	}
 
-	return ids, nil
-
+	return batches, nil
 }
 
-// maintain
 func sortedMapAttrs(attrs pcommon.Map) []string {
 	keys := make([]string, 0)
 	for k := range attrs.AsRaw() {
@@ -211,14 +303,144 @@ func sortedMapAttrs(attrs pcommon.Map) []string {
 	return attrsHash
 }
 
-func resourceRoutingKey(md pmetric.Metric, attrs pcommon.Map) string {
-	attrsHash := sortedMapAttrs(attrs)
-	attrsHash = append(attrsHash, md.Name())
-	routingRef := strings.Join(attrsHash, "")
-
-	return routingRef
+func resourceRoutingKey(md pmetric.Metric, attrs pcommon.Map, attrKeys []string) string {
+	return resourceRoutingID(attrs, attrKeys) + md.Name()
 }
 
 func metricRoutingKey(md pmetric.Metric) string {
 	return md.Name()
 }
+
+// streamRoutingID returns the identifier of a stream, made of the resource attributes, the metric name and the
+// attributes of the data point. Each part is prefixed with its length, so that distinct streams never share an
+// identifier.
+func streamRoutingID(resource pcommon.Resource, md pmetric.Metric, dpAttrs pcommon.Map) string {
+	var b strings.Builder
+	writePart := func(s string) {
+		b.WriteString(strconv.Itoa(len(s)))
+		b.WriteByte(':')
+		b.WriteString(s)
+	}
+	writeAttrs := func(attrs pcommon.Map) {
+		parts := sortedMapAttrs(attrs)
+		writePart(strconv.Itoa(len(parts)))
+		for _, part := range parts {
+			writePart(part)
+		}
+	}
+	writeAttrs(resource.Attributes())
+	writePart(md.Name())
+	writeAttrs(dpAttrs)
+	return b.String()
+}
+
+// streamRoutingKey returns the stream identifier of a batch made by splitMetricsByStream, which holds the data
+// points of a single stream.
+func streamRoutingKey(batch pmetric.Metrics) string {
+	rm := batch.ResourceMetrics().At(0)
+	md := rm.ScopeMetrics().At(0).Metrics().At(0)
+	var dpAttrs pcommon.Map
+	//exhaustive:enforce
+	switch md.Type() {
+	case pmetric.MetricTypeGauge:
+		dpAttrs = md.Gauge().DataPoints().At(0).Attributes()
+	case pmetric.MetricTypeSum:
+		dpAttrs = md.Sum().DataPoints().At(0).Attributes()
+	case pmetric.MetricTypeHistogram:
+		dpAttrs = md.Histogram().DataPoints().At(0).Attributes()
+	case pmetric.MetricTypeExponentialHistogram:
+		dpAttrs = md.ExponentialHistogram().DataPoints().At(0).Attributes()
+	case pmetric.MetricTypeSummary:
+		dpAttrs = md.Summary().DataPoints().At(0).Attributes()
+	case pmetric.MetricTypeEmpty:
+		dpAttrs = pcommon.NewMap()
+	}
+	return streamRoutingID(rm.Resource(), md, dpAttrs)
+}
+
+// splitMetricsByStream returns one pmetric.Metrics per stream of the given metrics, by stream identifier, so that
+// each series is routed on its own. Metrics without data points are dropped.
+func splitMetricsByStream(md pmetric.Metrics) map[string]pmetric.Metrics {
+	streams := make(map[string]pmetric.Metrics)
+	for i := 0; i < md.ResourceMetrics().Len(); i++ {
+		rm := md.ResourceMetrics().At(i)
+		for j := 0; j < rm.ScopeMetrics().Len(); j++ {
+			sm := rm.ScopeMetrics().At(j)
+			for k := 0; k < sm.Metrics().Len(); k++ {
+				m := sm.Metrics().At(k)
+				// streamMetric returns the metric of the batch of the stream of the data point attributes
+				streamMetric := func(dpAttrs pcommon.Map) pmetric.Metric {
+					id := streamRoutingID(rm.Resource(), m, dpAttrs)
+					batch, ok := streams[id]
+					if !ok {
+						batch = pmetric.NewMetrics()
+						newRM := batch.ResourceMetrics().AppendEmpty()
+						rm.Resource().CopyTo(newRM.Resource())
+						newRM.SetSchemaUrl(rm.SchemaUrl())
+						newSM := newRM.ScopeMetrics().AppendEmpty()
+						sm.Scope().CopyTo(newSM.Scope())
+						newSM.SetSchemaUrl(sm.SchemaUrl())
+						copyMetricDescription(m, newSM.Metrics().AppendEmpty())
+						streams[id] = batch
+					}
+					return batch.ResourceMetrics().At(0).ScopeMetrics().At(0).Metrics().At(0)
+				}
+
+				//exhaustive:enforce
+				switch m.Type() {
+				case pmetric.MetricTypeGauge:
+					for l := 0; l < m.Gauge().DataPoints().Len(); l++ {
+						dp := m.Gauge().DataPoints().At(l)
+						dp.CopyTo(streamMetric(dp.Attributes()).Gauge().DataPoints().AppendEmpty())
+					}
+				case pmetric.MetricTypeSum:
+					for l := 0; l < m.Sum().DataPoints().Len(); l++ {
+						dp := m.Sum().DataPoints().At(l)
+						dp.CopyTo(streamMetric(dp.Attributes()).Sum().DataPoints().AppendEmpty())
+					}
+				case pmetric.MetricTypeHistogram:
+					for l := 0; l < m.Histogram().DataPoints().Len(); l++ {
+						dp := m.Histogram().DataPoints().At(l)
+						dp.CopyTo(streamMetric(dp.Attributes()).Histogram().DataPoints().AppendEmpty())
+					}
+				case pmetric.MetricTypeExponentialHistogram:
+					for l := 0; l < m.ExponentialHistogram().DataPoints().Len(); l++ {
+						dp := m.ExponentialHistogram().DataPoints().At(l)
+						dp.CopyTo(streamMetric(dp.Attributes()).ExponentialHistogram().DataPoints().AppendEmpty())
+					}
+				case pmetric.MetricTypeSummary:
+					for l := 0; l < m.Summary().DataPoints().Len(); l++ {
+						dp := m.Summary().DataPoints().At(l)
+						dp.CopyTo(streamMetric(dp.Attributes()).Summary().DataPoints().AppendEmpty())
+					}
+				case pmetric.MetricTypeEmpty:
+				}
+			}
+		}
+	}
+	return streams
+}
+
+// copyMetricDescription copies the metric without its data points.
+func copyMetricDescription(src pmetric.Metric, dest pmetric.Metric) {
+	dest.SetName(src.Name())
+	dest.SetDescription(src.Description())
+	dest.SetUnit(src.Unit())
+	src.Metadata().CopyTo(dest.Metadata())
+	//exhaustive:enforce
+	switch src.Type() {
+	case pmetric.MetricTypeGauge:
+		dest.SetEmptyGauge()
+	case pmetric.MetricTypeSum:
+		sum := dest.SetEmptySum()
+		sum.SetAggregationTemporality(src.Sum().AggregationTemporality())
+		sum.SetIsMonotonic(src.Sum().IsMonotonic())
+	case pmetric.MetricTypeHistogram:
+		dest.SetEmptyHistogram().SetAggregationTemporality(src.Histogram().AggregationTemporality())
+	case pmetric.MetricTypeExponentialHistogram:
+		dest.SetEmptyExponentialHistogram().SetAggregationTemporality(src.ExponentialHistogram().AggregationTemporality())
+	case pmetric.MetricTypeSummary:
+		dest.SetEmptySummary()
+	case pmetric.MetricTypeEmpty:
+	}
+}
@@ -375,13 +375,96 @@ func TestServiceBasedRoutingForSameMetricName(t *testing.T) {
 		},
 	} {
 		t.Run(tt.desc, func(t *testing.T) {
-			res, err := routingIdentifiersFromMetrics(tt.batch, tt.routingKey)
+			res, err := splitMetricsByRoutingID(tt.batch, tt.routingKey, nil)
 			assert.Equal(t, err, nil)
-			assert.Equal(t, res, tt.res)
+			ids := map[string]bool{}
+			for rid, batch := range res {
+				ids[rid] = true
+				// each split only holds the resource of its service
+				require.Equal(t, 1, batch.ResourceMetrics().Len())
+				svc, _ := batch.ResourceMetrics().At(0).Resource().Attributes().Get(conventions.AttributeServiceName)
+				assert.Equal(t, rid, svc.Str())
+			}
+			assert.Equal(t, ids, tt.res)
 		})
 	}
 }
 
+func TestSplitMetricsByRoutingID(t *testing.T) {
+	md := pmetric.NewMetrics()
+	rm := md.ResourceMetrics().AppendEmpty()
+	rm.Resource().Attributes().PutStr(conventions.AttributeServiceName, serviceName1)
+	sm := rm.ScopeMetrics().AppendEmpty()
+	sm.Scope().SetName("scope")
+	sm.Metrics().AppendEmpty().SetName(signal1Name)
+	sm.Metrics().AppendEmpty().SetName(signal2Name)
+	sm.Metrics().AppendEmpty().SetName(signal1Name)
+
+	res, err := splitMetricsByRoutingID(md, metricNameRouting, nil)
+	require.NoError(t, err)
+	require.Len(t, res, 2)
+	for name, count := range map[string]int{signal1Name: 2, signal2Name: 1} {
+		batch := res[name]
+		require.Equal(t, 1, batch.ResourceMetrics().Len())
+		require.Equal(t, 1, batch.ResourceMetrics().At(0).ScopeMetrics().Len())
+		scope := batch.ResourceMetrics().At(0).ScopeMetrics().At(0)
+		assert.Equal(t, "scope", scope.Scope().Name())
+		require.Equal(t, count, scope.Metrics().Len())
+		for i := 0; i < count; i++ {
+			assert.Equal(t, name, scope.Metrics().At(i).Name())
+		}
+	}
+}
+
+func TestConsumeMetricsSplitPerEndpoint(t *testing.T) {
+	sinks := map[string]*consumertest.MetricsSink{
+		"endpoint-1:4317": new(consumertest.MetricsSink),
+		"endpoint-2:4317": new(consumertest.MetricsSink),
+	}
+	componentFactory := func(_ context.Context, endpoint string) (component.Component, error) {
+		return newMockMetricsExporter(sinks[endpoint].ConsumeMetrics), nil
+	}
+	cfg := serviceBasedRoutingConfig()
+	lb, err := newLoadBalancer(exportertest.NewNopCreateSettings(), cfg, componentFactory)
+	require.NoError(t, err)
+
+	p, err := newMetricsExporter(exportertest.NewNopCreateSettings(), cfg)
+	require.NoError(t, err)
+	p.loadBalancer = lb
+
+	require.NoError(t, p.Start(context.Background(), componenttest.NewNopHost()))
+	defer func() {
+		require.NoError(t, p.Shutdown(context.Background()))
+	}()
+	lb.onBackendChanges([]string{"endpoint-1", "endpoint-2"})
+
+	md := pmetric.NewMetrics()
+	for i := 0; i < 10; i++ {
+		rm := md.ResourceMetrics().AppendEmpty()
+		rm.Resource().Attributes().PutStr(conventions.AttributeServiceName, fmt.Sprintf("service-%d", i))
+		appendSimpleMetricWithID(rm, signal1Name)
+	}
+
+	// test
+	require.NoError(t, p.ConsumeMetrics(context.Background(), md))
+
+	// verify: each service is sent once, to its endpoint
+	services := map[string]int{}
+	for endpoint, sink := range sinks {
+		for _, batch := range sink.AllMetrics() {
+			for i := 0; i < batch.ResourceMetrics().Len(); i++ {
+				svc, _ := batch.ResourceMetrics().At(i).Resource().Attributes().Get(conventions.AttributeServiceName)
+				assert.Equal(t, endpointWithPort(lb.ring.endpointFor([]byte(svc.Str()))), endpoint)
+				services[svc.Str()]++
+			}
+		}
+	}
+	assert.Len(t, services, 10)
+	for svc, count := range services {
+		assert.Equal(t, 1, count, svc)
+	}
+}
+
 func TestConsumeMetricsExporterNoEndpoint(t *testing.T) {
 	componentFactory := func(_ context.Context, _ string) (component.Component, error) {
 		return newNopMockMetricsExporter(), nil
@@ -450,7 +533,7 @@ func TestConsumeMetricsUnexpectedExporterType(t *testing.T) {
 
 	// verify
 	assert.Error(t, res)
-	assert.EqualError(t, res, fmt.Sprintf("unable to export metrics, unexpected exporter type: expected exporter.Metrics but got %T", newNopMockExporter()))
+	assert.ErrorContains(t, res, fmt.Sprintf("unable to export metrics, unexpected exporter type: expected exporter.Metrics but got %T", newNopMockExporter()))
 }
 
 func TestBuildExporterConfigUnknown(t *testing.T) {
@@ -545,9 +628,9 @@ func TestNoMetricsInBatch(t *testing.T) {
 		},
 	} {
 		t.Run(tt.desc, func(t *testing.T) {
-			res, err := routingIdentifiersFromMetrics(tt.batch, tt.routingKey)
+			res, err := splitMetricsByRoutingID(tt.batch, tt.routingKey, nil)
 			assert.Equal(t, err, tt.err)
-			assert.Equal(t, res, map[string]bool(nil))
+			assert.Equal(t, res, map[string]pmetric.Metrics(nil))
 		})
 	}
 }
@@ -557,21 +640,148 @@ func TestResourceRoutingKey(t *testing.T) {
 	md := pmetric.NewMetric()
 	md.SetName("metric")
 	attrs := pcommon.NewMap()
-	if got := resourceRoutingKey(md, attrs); got != "metric" {
+	if got := resourceRoutingKey(md, attrs, nil); got != "metric" {
 		t.Errorf("metricRoutingKey() = %v, want %v", got, "metric")
 	}
 
 	attrs.PutStr("k1", "v1")
-	if got := resourceRoutingKey(md, attrs); got != "k1v1metric" {
+	if got := resourceRoutingKey(md, attrs, nil); got != "k1v1metric" {
 		t.Errorf("metricRoutingKey() = %v, want %v", got, "k1v1metric")
 	}
 
 	attrs.PutStr("k2", "v2")
-	if got := resourceRoutingKey(md, attrs); got != "k1v1k2v2metric" {
+	if got := resourceRoutingKey(md, attrs, nil); got != "k1v1k2v2metric" {
 		t.Errorf("metricRoutingKey() = %v, want %v", got, "k1v1k2v2metric")
 	}
 }
 
+func TestSplitMetricsByStream(t *testing.T) {
+	md := pmetric.NewMetrics()
+	rm := md.ResourceMetrics().AppendEmpty()
+	rm.Resource().Attributes().PutStr("service.name", "checkout")
+	sm := rm.ScopeMetrics().AppendEmpty()
+	sm.Scope().SetName("scope")
+	sum := sm.Metrics().AppendEmpty()
+	sum.SetName("http.server.requests")
+	sum.SetUnit("1")
+	sum.SetEmptySum().SetAggregationTemporality(pmetric.AggregationTemporalityDelta)
+	sum.Sum().SetIsMonotonic(true)
+	for _, route := range []string{"/cart", "/pay", "/cart"} {
+		dp := sum.Sum().DataPoints().AppendEmpty()
+		dp.Attributes().PutStr("http.route", route)
+		dp.SetIntValue(1)
+	}
+	hist := sm.Metrics().AppendEmpty()
+	hist.SetName("http.server.duration")
+	hist.SetEmptyHistogram().DataPoints().AppendEmpty().Attributes().PutStr("http.route", "/cart")
+
+	streams := splitMetricsByStream(md)
+	require.Len(t, streams, 3)
+
+	cart := streams["1:212:service.name8:checkout20:http.server.requests1:210:http.route5:/cart"]
+	require.Equal(t, 2, cart.DataPointCount())
+	assert.Equal(t, "scope", cart.ResourceMetrics().At(0).ScopeMetrics().At(0).Scope().Name())
+	m := cart.ResourceMetrics().At(0).ScopeMetrics().At(0).Metrics().At(0)
+	assert.Equal(t, "1", m.Unit())
+	assert.Equal(t, pmetric.AggregationTemporalityDelta, m.Sum().AggregationTemporality())
+	assert.True(t, m.Sum().IsMonotonic())
+	assert.Equal(t, "1:212:service.name8:checkout20:http.server.requests1:210:http.route5:/cart", streamRoutingKey(cart))
+
+	duration := streams["1:212:service.name8:checkout20:http.server.duration1:210:http.route5:/cart"]
+	assert.Equal(t, 1, duration.DataPointCount())
+	assert.Equal(t, 1, streams["1:212:service.name8:checkout20:http.server.requests1:210:http.route4:/pay"].DataPointCount())
+}
+
+func TestStreamRoutingIDDistinctStreams(t *testing.T) {
+	resource := pcommon.NewResource()
+	ab := pmetric.NewMetric()
+	ab.SetName("ab")
+	a := pmetric.NewMetric()
+	a.SetName("a")
+	abAttrs := pcommon.NewMap()
+	abAttrs.PutStr("c", "d")
+	aAttrs := pcommon.NewMap()
+	aAttrs.PutStr("bc", "d")
+
+	assert.NotEqual(t, streamRoutingID(resource, ab, abAttrs), streamRoutingID(resource, a, aAttrs))
+}
+
+func TestConsumeMetricsStreamBased(t *testing.T) {
+	var mu sync.Mutex
+	received := map[string]map[string]int{}
+	componentFactory := func(_ context.Context, endpoint string) (component.Component, error) {
+		return newMockMetricsExporter(func(_ context.Context, md pmetric.Metrics) error {
+			mu.Lock()
+			defer mu.Unlock()
+			for i := 0; i < md.ResourceMetrics().Len(); i++ {
+				dps := md.ResourceMetrics().At(i).ScopeMetrics().At(0).Metrics().At(0).Gauge().DataPoints()
+				for j := 0; j < dps.Len(); j++ {
+					host, _ := dps.At(j).Attributes().Get("host")
+					if received[host.Str()] == nil {
+						received[host.Str()] = map[string]int{}
+					}
+					received[host.Str()][endpoint]++
+				}
+			}
+			return nil
+		}), nil
+	}
+	cfg := simpleConfig()
+	cfg.RoutingKey = "streamID"
+	lb, err := newLoadBalancer(exportertest.NewNopCreateSettings(), cfg, componentFactory)
+	require.NoError(t, err)
+
+	p, err := newMetricsExporter(exportertest.NewNopCreateSettings(), cfg)
+	require.NoError(t, err)
+	assert.Equal(t, streamRouting, p.routingKey)
+
+	lb.res = &mockResolver{
+		triggerCallbacks: true,
+		onResolve: func(_ context.Context) ([]string, error) {
+			return []string{"endpoint-1", "endpoint-2", "endpoint-3"}, nil
+		},
+	}
+	p.loadBalancer = lb
+
+	require.NoError(t, p.Start(context.Background(), componenttest.NewNopHost()))
+	defer func() {
+		require.NoError(t, p.Shutdown(context.Background()))
+	}()
+
+	// test: a metric of a single service, with many series
+	for i := 0; i < 10; i++ {
+		md := pmetric.NewMetrics()
+		rm := md.ResourceMetrics().AppendEmpty()
+		rm.Resource().Attributes().PutStr("service.name", "svc")
+		m := rm.ScopeMetrics().AppendEmpty().Metrics().AppendEmpty()
+		m.SetName("load")
+		m.SetEmptyGauge()
+		for j := 0; j < 20; j++ {
+			m.Gauge().DataPoints().AppendEmpty().Attributes().PutStr("host", fmt.Sprintf("host-%d", j))
+		}
+		require.NoError(t, p.ConsumeMetrics(context.Background(), md))
+	}
+
+	// verify: each series went to a single endpoint, and the series are spread across the endpoints
+	mu.Lock()
+	defer mu.Unlock()
+	require.Len(t, received, 20)
+	used := map[string]bool{}
+	for host, endpoints := range received {
+		rm := pmetric.NewResourceMetrics()
+		rm.Resource().Attributes().PutStr("service.name", "svc")
+		m := pmetric.NewMetric()
+		m.SetName("load")
+		attrs := pcommon.NewMap()
+		attrs.PutStr("host", host)
+		_, expected, err := lb.exporterAndEndpoint([]byte(streamRoutingID(rm.Resource(), m, attrs)))
+		require.NoError(t, err)
+		assert.Equal(t, map[string]int{endpointWithPort(expected): 10}, endpoints)
+		used[expected] = true
+	}
+	assert.Greater(t, len(used), 1)
+}
+
 func TestMetricNameRoutingKey(t *testing.T) {
 
 	md := pmetric.NewMetric()
@@ -597,7 +807,8 @@ func TestRollingUpdatesWhenConsumeMetrics(t *testing.T) {
 
 	// simulate rolling updates, the dns resolver should resolve in the following order
 	// ["127.0.0.1"] -> ["127.0.0.1", "127.0.0.2"] -> ["127.0.0.2"]
-	res, err := newDNSResolver(zap.NewNop(), "service-1", "", 5*time.Second, 1*time.Second)
+	tb := newTestTelemetryBuilder(t)
+	res, err := newDNSResolver(zap.NewNop(), "service-1", "", 5*time.Second, 1*time.Second, tb)
 	require.NoError(t, err)
 
 	mu := sync.Mutex{}
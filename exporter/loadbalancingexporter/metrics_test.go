@@ -4,21 +4,291 @@
 package loadbalancingexporter
 
 import (
+	"context"
+	"errors"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/collector/component"
+	"go.opentelemetry.io/collector/component/componenttest"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/sdk/metric/metricdata"
+	"go.opentelemetry.io/otel/sdk/metric/metricdata/metricdatatest"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/exporter/loadbalancingexporter/internal/metadata"
 )
 
-func TestProcessorMetrics(t *testing.T) {
-	expectedViewNames := []string{
-		"loadbalancer_num_resolutions",
-		"loadbalancer_num_backends",
-		"loadbalancer_num_backend_updates",
-		"loadbalancer_backend_latency",
+func newTestTelemetryBuilder(t *testing.T) *metadata.TelemetryBuilder {
+	tb, err := metadata.NewTelemetryBuilder(componenttest.NewNopTelemetrySettings())
+	require.NoError(t, err)
+	return tb
+}
+
+func TestStaticResolverMetrics(t *testing.T) {
+	tt := setupTestTelemetry()
+	defer func() { require.NoError(t, tt.Shutdown(context.Background())) }()
+
+	set := tt.NewCreateSettings()
+	tb, err := metadata.NewTelemetryBuilder(set.TelemetrySettings)
+	require.NoError(t, err)
+
+	res, err := newStaticResolver([]string{"endpoint-1", "endpoint-2"}, tb)
+	require.NoError(t, err)
+	require.NoError(t, res.start(context.Background()))
+	_, err = res.resolve(context.Background())
+	require.NoError(t, err)
+
+	resolverAttr := attribute.NewSet(attribute.String("resolver", "static"))
+	tt.assertMetrics(t, []metricdata.Metrics{
+		{
+			Name:        "loadbalancer_num_resolutions",
+			Description: "Number of times the resolver has triggered new resolutions.",
+			Unit:        "{resolutions}",
+			Data: metricdata.Sum[int64]{
+				Temporality: metricdata.CumulativeTemporality,
+				IsMonotonic: true,
+				DataPoints: []metricdata.DataPoint[int64]{
+					{
+						Value:      2,
+						Attributes: attribute.NewSet(attribute.String("resolver", "static"), successTrueAttr),
+					},
+				},
+			},
+		},
+		{
+			Name:        "loadbalancer_num_backends",
+			Description: "Current number of backends in use.",
+			Unit:        "{backends}",
+			Data: metricdata.Gauge[int64]{
+				DataPoints: []metricdata.DataPoint[int64]{
+					{Value: 2, Attributes: resolverAttr},
+				},
+			},
+		},
+		{
+			Name:        "loadbalancer_num_backend_updates",
+			Description: "Number of times the list of backends was updated.",
+			Unit:        "{updates}",
+			Data: metricdata.Sum[int64]{
+				Temporality: metricdata.CumulativeTemporality,
+				IsMonotonic: true,
+				DataPoints: []metricdata.DataPoint[int64]{
+					{Value: 1, Attributes: resolverAttr},
+				},
+			},
+		},
+	})
+}
+
+func TestRoutedItemsMetrics(t *testing.T) {
+	tt := setupTestTelemetry()
+	defer func() { require.NoError(t, tt.Shutdown(context.Background())) }()
+
+	componentFactory := func(_ context.Context, _ string) (component.Component, error) {
+		return newNopMockTracesExporter(), nil
+	}
+	lb, err := newLoadBalancer(tt.NewCreateSettings(), serviceBasedRoutingConfig(), componentFactory)
+	require.NoError(t, err)
+
+	p, err := newTracesExporter(tt.NewCreateSettings(), serviceBasedRoutingConfig())
+	require.NoError(t, err)
+
+	// pre-load an exporter here, so that we don't use the actual OTLP exporter
+	lb.addMissingExporters(context.Background(), []string{"endpoint-1"})
+	lb.res = &mockResolver{
+		triggerCallbacks: true,
+		onResolve: func(_ context.Context) ([]string, error) {
+			return []string{"endpoint-1"}, nil
+		},
+	}
+	p.loadBalancer = lb
+
+	require.NoError(t, p.Start(context.Background(), componenttest.NewNopHost()))
+	defer func() { require.NoError(t, p.Shutdown(context.Background())) }()
+
+	require.NoError(t, p.ConsumeTraces(context.Background(), simpleTracesWithServiceName()))
+
+	var md metricdata.ResourceMetrics
+	require.NoError(t, tt.reader.Collect(context.Background(), &md))
+
+	metricdatatest.AssertEqual(t, metricdata.Metrics{
+		Name:        "loadbalancer_routed_spans",
+		Description: "Number of spans routed to each endpoint.",
+		Unit:        "{spans}",
+		Data: metricdata.Sum[int64]{
+			Temporality: metricdata.CumulativeTemporality,
+			IsMonotonic: true,
+			DataPoints: []metricdata.DataPoint[int64]{
+				{
+					Value: 3,
+					Attributes: attribute.NewSet(
+						attribute.String(endpointTagKey, "endpoint-1"),
+						attribute.String(routingKeyTagKey, "service"),
+					),
+				},
+			},
+		},
+	}, tt.getMetric("loadbalancer_routed_spans", md), metricdatatest.IgnoreTimestamp())
+
+	metricdatatest.AssertEqual(t, metricdata.Metrics{
+		Name:        "loadbalancer_backend_outcome",
+		Description: "Number of successes and failures for each endpoint.",
+		Unit:        "{outcomes}",
+		Data: metricdata.Sum[int64]{
+			Temporality: metricdata.CumulativeTemporality,
+			IsMonotonic: true,
+			DataPoints: []metricdata.DataPoint[int64]{
+				{
+					Value:      1,
+					Attributes: attribute.NewSet(attribute.String(endpointTagKey, "endpoint-1"), successTrueAttr),
+				},
+			},
+		},
+	}, tt.getMetric("loadbalancer_backend_outcome", md), metricdatatest.IgnoreTimestamp())
+}
+
+func TestRingChurnMetrics(t *testing.T) {
+	tt := setupTestTelemetry()
+	defer func() { require.NoError(t, tt.Shutdown(context.Background())) }()
+
+	componentFactory := func(_ context.Context, _ string) (component.Component, error) {
+		return newNopMockExporter(), nil
+	}
+	lb, err := newLoadBalancer(tt.NewCreateSettings(), simpleConfig(), componentFactory)
+	require.NoError(t, err)
+
+	lb.onBackendChanges([]string{"endpoint-1", "endpoint-2"})
+	lb.onBackendChanges([]string{"endpoint-2", "endpoint-3"})
+	// same backends, the ring isn't rebuilt
+	lb.onBackendChanges([]string{"endpoint-2", "endpoint-3"})
+
+	var md metricdata.ResourceMetrics
+	require.NoError(t, tt.reader.Collect(context.Background(), &md))
+
+	metricdatatest.AssertEqual(t, metricdata.Metrics{
+		Name:        "loadbalancer_ring_endpoints_added",
+		Description: "Number of endpoints added to the hash ring.",
+		Unit:        "{endpoints}",
+		Data: metricdata.Sum[int64]{
+			Temporality: metricdata.CumulativeTemporality,
+			IsMonotonic: true,
+			DataPoints:  []metricdata.DataPoint[int64]{{Value: 3}},
+		},
+	}, tt.getMetric("loadbalancer_ring_endpoints_added", md), metricdatatest.IgnoreTimestamp())
+
+	metricdatatest.AssertEqual(t, metricdata.Metrics{
+		Name:        "loadbalancer_ring_endpoints_removed",
+		Description: "Number of endpoints removed from the hash ring.",
+		Unit:        "{endpoints}",
+		Data: metricdata.Sum[int64]{
+			Temporality: metricdata.CumulativeTemporality,
+			IsMonotonic: true,
+			DataPoints:  []metricdata.DataPoint[int64]{{Value: 1}},
+		},
+	}, tt.getMetric("loadbalancer_ring_endpoints_removed", md), metricdatatest.IgnoreTimestamp())
+
+	rebuild, ok := tt.getMetric("loadbalancer_ring_rebuild_duration", md).Data.(metricdata.Histogram[float64])
+	require.True(t, ok)
+	require.Len(t, rebuild.DataPoints, 1)
+	assert.EqualValues(t, 2, rebuild.DataPoints[0].Count)
+}
+
+func TestSuppressedBackendUpdatesMetric(t *testing.T) {
+	tt := setupTestTelemetry()
+	defer func() { require.NoError(t, tt.Shutdown(context.Background())) }()
+
+	componentFactory := func(_ context.Context, _ string) (component.Component, error) {
+		return newNopMockExporter(), nil
+	}
+	cfg := simpleConfig()
+	cfg.Resolver.StabilizationWindow = time.Hour
+	lb, err := newLoadBalancer(tt.NewCreateSettings(), cfg, componentFactory)
+	require.NoError(t, err)
+	defer lb.debouncer.stop()
+
+	lb.debouncer.update([]string{"endpoint-1"})
+	lb.debouncer.update([]string{"endpoint-1", "endpoint-2"})
+	lb.debouncer.update([]string{"endpoint-2"})
+	lb.debouncer.update([]string{"endpoint-2", "endpoint-3"})
+
+	// only the first update was applied
+	assert.Equal(t, []string{"endpoint-1"}, lb.resolved)
+
+	var md metricdata.ResourceMetrics
+	require.NoError(t, tt.reader.Collect(context.Background(), &md))
+	metricdatatest.AssertEqual(t, metricdata.Metrics{
+		Name:        "loadbalancer_num_suppressed_backend_updates",
+		Description: "Number of updates of the list of backends superseded by a later one within the stabilization window.",
+		Unit:        "{updates}",
+		Data: metricdata.Sum[int64]{
+			Temporality: metricdata.CumulativeTemporality,
+			IsMonotonic: true,
+			DataPoints:  []metricdata.DataPoint[int64]{{Value: 2}},
+		},
+	}, tt.getMetric("loadbalancer_num_suppressed_backend_updates", md), metricdatatest.IgnoreTimestamp())
+}
+
+func TestBackpressureMetrics(t *testing.T) {
+	tt := setupTestTelemetry()
+	defer func() { require.NoError(t, tt.Shutdown(context.Background())) }()
+
+	componentFactory := func(_ context.Context, _ string) (component.Component, error) {
+		return newNopMockExporter(), nil
+	}
+	lb, err := newLoadBalancer(tt.NewCreateSettings(), simpleConfig(), componentFactory)
+	require.NoError(t, err)
+	lb.onBackendChanges([]string{"endpoint-1", "endpoint-2"})
+
+	ctx := context.Background()
+	errExport := errors.New("unavailable")
+	for _, err := range []error{errExport, errExport, nil, errExport, errExport, errExport} {
+		lb.exportStarted(ctx, "endpoint-1")
+		lb.observe(ctx, "endpoint-1", time.Millisecond, err)
+	}
+	lb.exportStarted(ctx, "endpoint-2")
+	lb.exportStarted(ctx, "endpoint-2")
+	lb.observe(ctx, "endpoint-2", time.Millisecond, errExport)
+
+	var md metricdata.ResourceMetrics
+	require.NoError(t, tt.reader.Collect(context.Background(), &md))
+
+	metricdatatest.AssertEqual(t, metricdata.Metrics{
+		Name:        "loadbalancer_backend_inflight_requests",
+		Description: "Number of exports to each endpoint waiting for their outcome.",
+		Unit:        "{requests}",
+		Data: metricdata.Sum[int64]{
+			Temporality: metricdata.CumulativeTemporality,
+			IsMonotonic: false,
+			DataPoints: []metricdata.DataPoint[int64]{
+				{Value: 0, Attributes: attribute.NewSet(attribute.String(endpointTagKey, "endpoint-1"))},
+				{Value: 1, Attributes: attribute.NewSet(attribute.String(endpointTagKey, "endpoint-2"))},
+			},
+		},
+	}, tt.getMetric("loadbalancer_backend_inflight_requests", md), metricdatatest.IgnoreTimestamp())
+
+	streak := metricdata.Metrics{
+		Name:        "loadbalancer_backend_failure_streak",
+		Description: "Number of consecutive failed exports to each endpoint, reset by a successful export.",
+		Unit:        "{failures}",
+		Data: metricdata.Gauge[int64]{
+			DataPoints: []metricdata.DataPoint[int64]{
+				{Value: 3, Attributes: attribute.NewSet(attribute.String(endpointTagKey, "endpoint-1"))},
+				{Value: 1, Attributes: attribute.NewSet(attribute.String(endpointTagKey, "endpoint-2"))},
+			},
+		},
 	}
+	metricdatatest.AssertEqual(t, streak, tt.getMetric("loadbalancer_backend_failure_streak", md), metricdatatest.IgnoreTimestamp())
 
-	views := metricViews()
-	for i, viewName := range expectedViewNames {
-		assert.Equal(t, viewName, views[i].Name)
+	// the streak of a removed endpoint is reset
+	lb.onBackendChanges([]string{"endpoint-1"})
+	require.NoError(t, tt.reader.Collect(context.Background(), &md))
+	streak.Data = metricdata.Gauge[int64]{
+		DataPoints: []metricdata.DataPoint[int64]{
+			{Value: 3, Attributes: attribute.NewSet(attribute.String(endpointTagKey, "endpoint-1"))},
+			{Value: 0, Attributes: attribute.NewSet(attribute.String(endpointTagKey, "endpoint-2"))},
+		},
 	}
+	metricdatatest.AssertEqual(t, streak, tt.getMetric("loadbalancer_backend_failure_streak", md), metricdatatest.IgnoreTimestamp())
 }
@@ -15,9 +15,9 @@ import (
 	"github.com/aws/aws-sdk-go-v2/config"
 	"github.com/aws/aws-sdk-go-v2/service/servicediscovery"
 	"github.com/aws/aws-sdk-go-v2/service/servicediscovery/types"
-	"go.opencensus.io/stats"
-	"go.opencensus.io/tag"
 	"go.uber.org/zap"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/exporter/loadbalancingexporter/internal/metadata"
 )
 
 const (
@@ -29,10 +29,7 @@ var (
 	errNoNamespace   = errors.New("no Cloud Map namespace specified to resolve the backends")
 	errNoServiceName = errors.New("no Cloud Map service_name specified to resolve the backends")
 
-	awsResolverMutator = tag.Upsert(tag.MustNewKey("resolver"), "aws")
-
-	awsResolverSuccessTrueMutators  = []tag.Mutator{awsResolverMutator, successTrueMutator}
-	awsResolverSuccessFalseMutators = []tag.Mutator{awsResolverMutator, successFalseMutator}
+	awsResolverAttrs = newResolverAttrs("aws")
 )
 
 func createDiscoveryFunction(client *servicediscovery.Client) func(params *servicediscovery.DiscoverInstancesInput) (*servicediscovery.DiscoverInstancesOutput, error) {
@@ -59,9 +56,10 @@ type cloudMapResolver struct {
 	shutdownWg         sync.WaitGroup
 	changeCallbackLock sync.RWMutex
 	discoveryFn        func(params *servicediscovery.DiscoverInstancesInput) (*servicediscovery.DiscoverInstancesOutput, error)
+	telemetry          *metadata.TelemetryBuilder
 }
 
-func newCloudMapResolver(logger *zap.Logger, namespaceName *string, serviceName *string, port *uint16, healthStatus *types.HealthStatusFilter, interval time.Duration, timeout time.Duration) (*cloudMapResolver, error) {
+func newCloudMapResolver(logger *zap.Logger, namespaceName *string, serviceName *string, port *uint16, healthStatus *types.HealthStatusFilter, interval time.Duration, timeout time.Duration, tb *metadata.TelemetryBuilder) (*cloudMapResolver, error) {
 	// Using the SDK's default configuration, loading additional config
 	// and credentials values from the environment variables, shared
 	// credentials, and shared configuration files
@@ -104,6 +102,7 @@ func newCloudMapResolver(logger *zap.Logger, namespaceName *string, serviceName
 		resTimeout:    timeout,
 		stopCh:        make(chan struct{}),
 		discoveryFn:   createDiscoveryFunction(svc),
+		telemetry:     tb,
 	}, nil
 }
 
@@ -165,11 +164,11 @@ func (r *cloudMapResolver) resolve(ctx context.Context) ([]string, error) {
 		QueryParameters:    nil,
 	})
 	if err != nil {
-		_ = stats.RecordWithTags(ctx, awsResolverSuccessFalseMutators, mNumResolutions.M(1))
+		r.telemetry.LoadbalancerNumResolutions.Add(ctx, 1, awsResolverAttrs.successFalseAttr)
 		return nil, err
 	}
 
-	_ = stats.RecordWithTags(ctx, awsResolverSuccessTrueMutators, mNumResolutions.M(1))
+	r.telemetry.LoadbalancerNumResolutions.Add(ctx, 1, awsResolverAttrs.successTrueAttr)
 
 	r.logger.Debug("resolver has discovered instances ",
 		zap.Int("Instance Count", len(discoverInstancesOutput.Instances)))
@@ -200,7 +199,8 @@ func (r *cloudMapResolver) resolve(ctx context.Context) ([]string, error) {
 	r.updateLock.Lock()
 	r.endpoints = backends
 	r.updateLock.Unlock()
-	_ = stats.RecordWithTags(ctx, awsResolverSuccessTrueMutators, mNumBackends.M(int64(len(backends))))
+	r.telemetry.LoadbalancerNumBackends.Record(ctx, int64(len(backends)), awsResolverAttrs.attrSet)
+	r.telemetry.LoadbalancerNumBackendUpdates.Add(ctx, 1, awsResolverAttrs.attrSet)
 
 	// propagate the change
 	r.changeCallbackLock.RLock()
@@ -35,6 +35,7 @@ func TestInitialCloudMapResolution(t *testing.T) {
 		resTimeout:    1 * time.Second,
 		stopCh:        make(chan struct{}),
 		discoveryFn:   mockDiscovery,
+		telemetry:     newTestTelemetryBuilder(t),
 	}
 
 	// test
@@ -67,6 +68,7 @@ func TestInitialCloudMapResolutionWithPort(t *testing.T) {
 		resTimeout:    1 * time.Second,
 		stopCh:        make(chan struct{}),
 		discoveryFn:   mockDiscovery,
+		telemetry:     newTestTelemetryBuilder(t),
 	}
 
 	// test
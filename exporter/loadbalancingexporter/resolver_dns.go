@@ -12,9 +12,9 @@ import (
 	"sync"
 	"time"
 
-	"go.opencensus.io/stats"
-	"go.opencensus.io/tag"
 	"go.uber.org/zap"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/exporter/loadbalancingexporter/internal/metadata"
 )
 
 var _ resolver = (*dnsResolver)(nil)
@@ -27,10 +27,7 @@ const (
 var (
 	errNoHostname = errors.New("no hostname specified to resolve the backends")
 
-	resolverMutator = tag.Upsert(tag.MustNewKey("resolver"), "dns")
-
-	resolverSuccessTrueMutators  = []tag.Mutator{resolverMutator, successTrueMutator}
-	resolverSuccessFalseMutators = []tag.Mutator{resolverMutator, successFalseMutator}
+	dnsResolverAttrs = newResolverAttrs("dns")
 )
 
 type dnsResolver struct {
@@ -49,13 +46,15 @@ type dnsResolver struct {
 	updateLock         sync.Mutex
 	shutdownWg         sync.WaitGroup
 	changeCallbackLock sync.RWMutex
+
+	telemetry *metadata.TelemetryBuilder
 }
 
 type netResolver interface {
 	LookupIPAddr(ctx context.Context, host string) ([]net.IPAddr, error)
 }
 
-func newDNSResolver(logger *zap.Logger, hostname string, port string, interval time.Duration, timeout time.Duration) (*dnsResolver, error) {
+func newDNSResolver(logger *zap.Logger, hostname string, port string, interval time.Duration, timeout time.Duration, tb *metadata.TelemetryBuilder) (*dnsResolver, error) {
 	if len(hostname) == 0 {
 		return nil, errNoHostname
 	}
@@ -74,6 +73,7 @@ func newDNSResolver(logger *zap.Logger, hostname string, port string, interval t
 		resInterval: interval,
 		resTimeout:  timeout,
 		stopCh:      make(chan struct{}),
+		telemetry:   tb,
 	}, nil
 }
 
@@ -125,11 +125,11 @@ func (r *dnsResolver) resolve(ctx context.Context) ([]string, error) {
 
 	addrs, err := r.resolver.LookupIPAddr(ctx, r.hostname)
 	if err != nil {
-		_ = stats.RecordWithTags(ctx, resolverSuccessFalseMutators, mNumResolutions.M(1))
+		r.telemetry.LoadbalancerNumResolutions.Add(ctx, 1, dnsResolverAttrs.successFalseAttr)
 		return nil, err
 	}
 
-	_ = stats.RecordWithTags(ctx, resolverSuccessTrueMutators, mNumResolutions.M(1))
+	r.telemetry.LoadbalancerNumResolutions.Add(ctx, 1, dnsResolverAttrs.successTrueAttr)
 
 	backends := make([]string, len(addrs))
 	for i, ip := range addrs {
@@ -160,7 +160,8 @@ func (r *dnsResolver) resolve(ctx context.Context) ([]string, error) {
 	r.updateLock.Lock()
 	r.endpoints = backends
 	r.updateLock.Unlock()
-	_ = stats.RecordWithTags(ctx, resolverSuccessTrueMutators, mNumBackends.M(int64(len(backends))))
+	r.telemetry.LoadbalancerNumBackends.Record(ctx, int64(len(backends)), dnsResolverAttrs.attrSet)
+	r.telemetry.LoadbalancerNumBackendUpdates.Add(ctx, 1, dnsResolverAttrs.attrSet)
 
 	// propagate the change
 	r.changeCallbackLock.RLock()
@@ -7,10 +7,11 @@ import (
 	"context"
 	"sync"
 
-	"go.opencensus.io/stats"
 	"go.uber.org/zap"
 	corev1 "k8s.io/api/core/v1"
 	"k8s.io/client-go/tools/cache"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/exporter/loadbalancingexporter/internal/metadata"
 )
 
 var _ cache.ResourceEventHandler = (*handler)(nil)
@@ -19,6 +20,7 @@ type handler struct {
 	endpoints *sync.Map
 	callback  func(ctx context.Context) ([]string, error)
 	logger    *zap.Logger
+	telemetry *metadata.TelemetryBuilder
 }
 
 func (h handler) OnAdd(obj any, _ bool) {
@@ -29,7 +31,7 @@ func (h handler) OnAdd(obj any, _ bool) {
 		endpoints = convertToEndpoints(object)
 	default: // unsupported
 		h.logger.Warn("Got an unexpected Kubernetes data type during the inclusion of a new pods for the service", zap.Any("obj", obj))
-		_ = stats.RecordWithTags(context.Background(), k8sResolverSuccessFalseMutators, mNumResolutions.M(1))
+		h.telemetry.LoadbalancerNumResolutions.Add(context.Background(), 1, k8sResolverAttrs.successFalseAttr)
 		return
 	}
 	changed := false
@@ -57,7 +59,7 @@ func (h handler) OnUpdate(oldObj, newObj any) {
 		newEps, ok := newObj.(*corev1.Endpoints)
 		if !ok {
 			h.logger.Warn("Got an unexpected Kubernetes data type during the update of the pods for a service", zap.Any("obj", newObj))
-			_ = stats.RecordWithTags(context.Background(), k8sResolverSuccessFalseMutators, mNumResolutions.M(1))
+			h.telemetry.LoadbalancerNumResolutions.Add(context.Background(), 1, k8sResolverAttrs.successFalseAttr)
 			return
 		}
 		changed := false
@@ -71,7 +73,7 @@ func (h handler) OnUpdate(oldObj, newObj any) {
 		}
 	default: // unsupported
 		h.logger.Warn("Got an unexpected Kubernetes data type during the update of the pods for a service", zap.Any("obj", oldObj))
-		_ = stats.RecordWithTags(context.Background(), k8sResolverSuccessFalseMutators, mNumResolutions.M(1))
+		h.telemetry.LoadbalancerNumResolutions.Add(context.Background(), 1, k8sResolverAttrs.successFalseAttr)
 		return
 	}
 }
@@ -88,7 +90,7 @@ func (h handler) OnDelete(obj any) {
 		}
 	default: // unsupported
 		h.logger.Warn("Got an unexpected Kubernetes data type during the removal of the pods for a service", zap.Any("obj", obj))
-		_ = stats.RecordWithTags(context.Background(), k8sResolverSuccessFalseMutators, mNumResolutions.M(1))
+		h.telemetry.LoadbalancerNumResolutions.Add(context.Background(), 1, k8sResolverAttrs.successFalseAttr)
 		return
 	}
 	if len(endpoints) != 0 {
@@ -56,7 +56,8 @@ func TestK8sResolve(t *testing.T) {
 		}
 
 		cl := fake.NewSimpleClientset(endpoint)
-		res, err := newK8sResolver(cl, zap.NewNop(), service, ports, defaultListWatchTimeout)
+		tb := newTestTelemetryBuilder(t)
+		res, err := newK8sResolver(cl, zap.NewNop(), service, ports, defaultListWatchTimeout, tb)
 		require.NoError(t, err)
 
 		require.NoError(t, res.start(context.Background()))
@@ -65,12 +66,12 @@ func TestK8sResolve(t *testing.T) {
 		assert.Equal(t, expectInit, res.Endpoints())
 
 		return &suiteContext{
-				endpoint:  endpoint,
-				clientset: cl,
-				resolver:  res,
-			}, func(*testing.T) {
-				require.NoError(t, res.shutdown(context.Background()))
-			}
+			endpoint:  endpoint,
+			clientset: cl,
+			resolver:  res,
+		}, func(*testing.T) {
+			require.NoError(t, res.shutdown(context.Background()))
+		}
 	}
 	tests := []struct {
 		name       string
@@ -241,7 +242,8 @@ func Test_newK8sResolver(t *testing.T) {
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			got, err := newK8sResolver(fake.NewSimpleClientset(), tt.args.logger, tt.args.service, tt.args.ports, defaultListWatchTimeout)
+			tb := newTestTelemetryBuilder(t)
+			got, err := newK8sResolver(fake.NewSimpleClientset(), tt.args.logger, tt.args.service, tt.args.ports, defaultListWatchTimeout, tb)
 			if tt.wantErr != nil {
 				require.Error(t, err, tt.wantErr)
 			} else {
@@ -6,72 +6,194 @@ package loadbalancingexporter // import "github.com/open-telemetry/opentelemetry
 import (
 	"context"
 	"errors"
+	"fmt"
+	"os"
 	"sort"
+	"strings"
 	"sync"
+	"time"
 
-	"go.opencensus.io/stats"
-	"go.opencensus.io/tag"
+	"go.uber.org/zap"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/exporter/loadbalancingexporter/internal/metadata"
 )
 
 var _ resolver = (*staticResolver)(nil)
 
+const defaultReloadInterval = 30 * time.Second
+
 var (
 	errNoEndpoints = errors.New("no endpoints specified for the static resolver")
 
-	staticResolverMutators = []tag.Mutator{tag.Upsert(tag.MustNewKey("resolver"), "static"), successTrueMutator}
+	staticResolverAttrs = newResolverAttrs("static")
 )
 
 type staticResolver struct {
+	logger *zap.Logger
+
+	// hostnames is the configured list of backends, unused when the backends are read from a file
+	hostnames      []string
+	file           string
+	reloadInterval time.Duration
+
 	endpoints         []string
 	onChangeCallbacks []func([]string)
-	once              sync.Once // we trigger the onChange only once
+
+	stopCh             chan struct{}
+	updateLock         sync.Mutex
+	shutdownWg         sync.WaitGroup
+	changeCallbackLock sync.RWMutex
+
+	telemetry *metadata.TelemetryBuilder
 }
 
-func newStaticResolver(endpoints []string) (*staticResolver, error) {
+func newStaticResolver(endpoints []string, tb *metadata.TelemetryBuilder) (*staticResolver, error) {
 	if len(endpoints) == 0 {
 		return nil, errNoEndpoints
 	}
 
-	// make sure we won't change the provided slice
-	endpointsCopy := make([]string, len(endpoints))
-	copy(endpointsCopy, endpoints)
+	return &staticResolver{
+		logger:    zap.NewNop(),
+		hostnames: sortedEndpoints(endpoints),
+		telemetry: tb,
+	}, nil
+}
 
-	// sort is a guarantee that the order of endpoints doesn't matter
-	sort.Strings(endpointsCopy)
+// newStaticFileResolver creates a static resolver reading the backends from a file, one per line, which is re-read
+// at every interval so that the list of backends can be updated without restarting the collector.
+func newStaticFileResolver(logger *zap.Logger, file string, interval time.Duration, tb *metadata.TelemetryBuilder) *staticResolver {
+	if interval == 0 {
+		interval = defaultReloadInterval
+	}
 
 	return &staticResolver{
-		endpoints: endpointsCopy,
-	}, nil
+		logger:         logger,
+		file:           file,
+		reloadInterval: interval,
+		stopCh:         make(chan struct{}),
+		telemetry:      tb,
+	}
 }
 
 func (r *staticResolver) start(ctx context.Context) error {
-	_, err := r.resolve(ctx) // right now, this can't fail
-	return err
+	if _, err := r.resolve(ctx); err != nil {
+		return err
+	}
+
+	if r.file != "" {
+		r.shutdownWg.Add(1)
+		go r.periodicallyReload()
+
+		r.logger.Debug("static resolver started",
+			zap.String("hostnames_file", r.file), zap.Duration("reload_interval", r.reloadInterval))
+	}
+	return nil
 }
 
 func (r *staticResolver) shutdown(context.Context) error {
+	if r.stopCh != nil {
+		close(r.stopCh)
+	}
+	r.shutdownWg.Wait()
+
+	r.updateLock.Lock()
 	r.endpoints = nil
+	r.updateLock.Unlock()
 
+	r.changeCallbackLock.RLock()
 	for _, callback := range r.onChangeCallbacks {
-		callback(r.endpoints)
+		callback(nil)
 	}
+	r.changeCallbackLock.RUnlock()
 
 	return nil
 }
 
+func (r *staticResolver) periodicallyReload() {
+	defer r.shutdownWg.Done()
+
+	ticker := time.NewTicker(r.reloadInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ticker.C:
+			// the previous backends are kept until the file can be read again
+			if _, err := r.resolve(context.Background()); err != nil {
+				r.logger.Warn("failed to reload the hostnames file", zap.Error(err))
+			}
+		case <-r.stopCh:
+			return
+		}
+	}
+}
+
 func (r *staticResolver) resolve(ctx context.Context) ([]string, error) {
-	_ = stats.RecordWithTags(ctx, staticResolverMutators, mNumResolutions.M(1))
+	backends := r.hostnames
+	if r.file != "" {
+		var err error
+		if backends, err = readHostnamesFile(r.file); err != nil {
+			r.telemetry.LoadbalancerNumResolutions.Add(ctx, 1, staticResolverAttrs.successFalseAttr)
+			return nil, err
+		}
+	}
 
-	r.once.Do(func() {
-		_ = stats.RecordWithTags(ctx, staticResolverMutators, mNumBackends.M(int64(len(r.endpoints))))
+	r.telemetry.LoadbalancerNumResolutions.Add(ctx, 1, staticResolverAttrs.successTrueAttr)
 
-		for _, callback := range r.onChangeCallbacks {
-			callback(r.endpoints)
-		}
-	})
-	return r.endpoints, nil
+	r.updateLock.Lock()
+	if equalStringSlice(r.endpoints, backends) {
+		r.updateLock.Unlock()
+		return backends, nil
+	}
+
+	// the list has changed, or it's the first resolution
+	r.endpoints = backends
+	r.updateLock.Unlock()
+	r.telemetry.LoadbalancerNumBackends.Record(ctx, int64(len(backends)), staticResolverAttrs.attrSet)
+	r.telemetry.LoadbalancerNumBackendUpdates.Add(ctx, 1, staticResolverAttrs.attrSet)
+
+	r.changeCallbackLock.RLock()
+	for _, callback := range r.onChangeCallbacks {
+		callback(backends)
+	}
+	r.changeCallbackLock.RUnlock()
+
+	return backends, nil
 }
 
 func (r *staticResolver) onChange(f func([]string)) {
+	r.changeCallbackLock.Lock()
+	defer r.changeCallbackLock.Unlock()
 	r.onChangeCallbacks = append(r.onChangeCallbacks, f)
 }
+
+// readHostnamesFile reads the backends listed in the file, one per line, ignoring the empty lines and the comments
+// starting with #. A file without any backend is an error, so that a file being rewritten isn't taken for an empty
+// list of backends.
+func readHostnamesFile(file string) ([]string, error) {
+	content, err := os.ReadFile(file)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read the hostnames file: %w", err)
+	}
+
+	var hostnames []string
+	for _, line := range strings.Split(string(content), "\n") {
+		line = strings.TrimSpace(line)
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+		hostnames = append(hostnames, line)
+	}
+	if len(hostnames) == 0 {
+		return nil, fmt.Errorf("no endpoints listed in the hostnames file %q", file)
+	}
+	return sortedEndpoints(hostnames), nil
+}
+
+// sortedEndpoints returns a sorted copy of the endpoints, as a guarantee that their order doesn't matter.
+func sortedEndpoints(endpoints []string) []string {
+	sorted := make([]string, len(endpoints))
+	copy(sorted, endpoints)
+	sort.Strings(sorted)
+	return sorted
+}
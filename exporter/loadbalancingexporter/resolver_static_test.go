@@ -5,16 +5,23 @@ package loadbalancingexporter
 
 import (
 	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sync"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
 )
 
 func TestInitialResolution(t *testing.T) {
 	// prepare
 	provided := []string{"endpoint-2", "endpoint-1"}
-	res, err := newStaticResolver(provided)
+	tb := newTestTelemetryBuilder(t)
+	res, err := newStaticResolver(provided, tb)
 	require.NoError(t, err)
 
 	// test
@@ -35,7 +42,8 @@ func TestInitialResolution(t *testing.T) {
 func TestResolvedOnlyOnce(t *testing.T) {
 	// prepare
 	expected := []string{"endpoint-1", "endpoint-2"}
-	res, err := newStaticResolver(expected)
+	tb := newTestTelemetryBuilder(t)
+	res, err := newStaticResolver(expected, tb)
 	require.NoError(t, err)
 
 	counter := 0
@@ -61,9 +69,65 @@ func TestFailOnMissingEndpoints(t *testing.T) {
 	var expected []string
 
 	// test
-	res, err := newStaticResolver(expected)
+	tb := newTestTelemetryBuilder(t)
+	res, err := newStaticResolver(expected, tb)
 
 	// verify
 	assert.Equal(t, errNoEndpoints, err)
 	assert.Nil(t, res)
 }
+
+func TestFileResolution(t *testing.T) {
+	// prepare
+	file := filepath.Join(t.TempDir(), "backends.txt")
+	require.NoError(t, os.WriteFile(file, []byte("endpoint-2\n# a comment\n\n  endpoint-1  \n"), 0600))
+	tb := newTestTelemetryBuilder(t)
+	res := newStaticFileResolver(zap.NewNop(), file, time.Millisecond, tb)
+
+	var mu sync.Mutex
+	var resolved []string
+	res.onChange(func(endpoints []string) {
+		mu.Lock()
+		defer mu.Unlock()
+		resolved = endpoints
+	})
+	current := func() []string {
+		mu.Lock()
+		defer mu.Unlock()
+		return resolved
+	}
+
+	// test
+	require.NoError(t, res.start(context.Background()))
+	defer func() {
+		require.NoError(t, res.shutdown(context.Background()))
+	}()
+
+	// verify
+	assert.Equal(t, []string{"endpoint-1", "endpoint-2"}, current())
+
+	// the file is reloaded
+	require.NoError(t, os.WriteFile(file, []byte("endpoint-3\n"), 0600))
+	assert.Eventually(t, func() bool {
+		return assert.ObjectsAreEqual([]string{"endpoint-3"}, current())
+	}, time.Second, time.Millisecond)
+
+	// the backends are kept while the file is invalid
+	require.NoError(t, os.WriteFile(file, nil, 0600))
+	_, err := res.resolve(context.Background())
+	assert.EqualError(t, err, fmt.Sprintf("no endpoints listed in the hostnames file %q", file))
+	assert.Equal(t, []string{"endpoint-3"}, current())
+}
+
+func TestFailOnMissingFile(t *testing.T) {
+	// prepare
+	tb := newTestTelemetryBuilder(t)
+	res := newStaticFileResolver(zap.NewNop(), filepath.Join(t.TempDir(), "missing.txt"), 0, tb)
+
+	// test
+	err := res.start(context.Background())
+
+	// verify
+	assert.ErrorIs(t, err, os.ErrNotExist)
+	assert.Equal(t, defaultReloadInterval, res.reloadInterval)
+}
@@ -0,0 +1,82 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package loadbalancingexporter // import "github.com/open-telemetry/opentelemetry-collector-contrib/exporter/loadbalancingexporter"
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"go.opentelemetry.io/collector/component"
+	"go.opentelemetry.io/collector/extension/experimental/storage"
+	"go.uber.org/zap"
+)
+
+// endpointsStorageKey is the key of the last resolved endpoints in the storage
+const endpointsStorageKey = "endpoints"
+
+// startStorage gets the storage client, and restores the endpoints saved by the previous run, so that the data can
+// be routed before the first resolution completes.
+func (lb *loadBalancer) startStorage(ctx context.Context, host component.Host) error {
+	if lb.storageID == nil {
+		return nil
+	}
+	ext, ok := host.GetExtensions()[*lb.storageID]
+	if !ok {
+		return fmt.Errorf("storage extension '%s' not found", lb.storageID)
+	}
+	storageExt, ok := ext.(storage.Extension)
+	if !ok {
+		return fmt.Errorf("non-storage extension '%s' found", lb.storageID)
+	}
+	client, err := storageExt.GetClient(ctx, component.KindExporter, lb.id, "")
+	if err != nil {
+		return fmt.Errorf("failed to get the storage client: %w", err)
+	}
+	lb.storageClient = client
+
+	saved, err := client.Get(ctx, endpointsStorageKey)
+	if err != nil {
+		return fmt.Errorf("failed to read the saved endpoints: %w", err)
+	}
+	if saved == nil {
+		return nil
+	}
+	var endpoints []string
+	if err = json.Unmarshal(saved, &endpoints); err != nil {
+		// the ring is built by the first resolution instead, which isn't a reason to stop the pipeline
+		lb.logger.Warn("ignoring the saved endpoints", zap.Error(err))
+		return nil
+	}
+	if len(endpoints) == 0 {
+		return nil
+	}
+	lb.logger.Debug("restored the saved endpoints", zap.Strings("endpoints", endpoints))
+	lb.onBackendChanges(endpoints)
+	return nil
+}
+
+// saveEndpoints saves the endpoints of the ring, to be restored at the next start.
+func (lb *loadBalancer) saveEndpoints(ctx context.Context, endpoints []string) {
+	if lb.storageClient == nil {
+		return
+	}
+	saved, err := json.Marshal(endpoints)
+	if err == nil {
+		err = lb.storageClient.Set(ctx, endpointsStorageKey, saved)
+	}
+	if err != nil {
+		lb.logger.Warn("failed to save the endpoints", zap.Error(err))
+	}
+}
+
+// shutdownStorage closes the storage client.
+func (lb *loadBalancer) shutdownStorage(ctx context.Context) error {
+	if lb.storageClient == nil {
+		return nil
+	}
+	err := lb.storageClient.Close(ctx)
+	lb.storageClient = nil
+	return err
+}
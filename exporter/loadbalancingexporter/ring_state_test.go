@@ -0,0 +1,67 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package loadbalancingexporter
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/collector/component"
+	"go.opentelemetry.io/collector/exporter/exportertest"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/extension/storage/storagetest"
+)
+
+func TestRingRestoredFromStorage(t *testing.T) {
+	componentFactory := func(_ context.Context, _ string) (component.Component, error) {
+		return newNopMockExporter(), nil
+	}
+	host := storagetest.NewStorageHost().WithFileBackedStorageExtension("ring", t.TempDir())
+	cfg := simpleConfig()
+	storageID := storagetest.NewStorageID("ring")
+	cfg.Resolver.StorageID = &storageID
+	// the storage client is identified by the ID of the exporter, which has to be the same for both runs
+	params := exportertest.NewNopCreateSettings()
+
+	// the first run saves the resolved endpoints
+	lb, err := newLoadBalancer(params, cfg, componentFactory)
+	require.NoError(t, err)
+	lb.res = &mockResolver{}
+	require.NoError(t, lb.Start(context.Background(), host))
+	lb.onBackendChanges([]string{"endpoint-1", "endpoint-2"})
+	require.NoError(t, lb.Shutdown(context.Background()))
+
+	// test: the ring of the next run is built before the first resolution
+	lb, err = newLoadBalancer(params, cfg, componentFactory)
+	require.NoError(t, err)
+	lb.res = &mockResolver{}
+	require.NoError(t, lb.Start(context.Background(), host))
+	defer func() {
+		require.NoError(t, lb.Shutdown(context.Background()))
+	}()
+
+	// verify
+	assert.Equal(t, []string{"endpoint-1", "endpoint-2"}, lb.resolved)
+	require.NotNil(t, lb.ring)
+	assert.Len(t, lb.exporters, 2)
+	_, _, err = lb.exporterAndEndpoint([]byte("some-key"))
+	assert.NoError(t, err)
+}
+
+func TestRingStorageNotFound(t *testing.T) {
+	cfg := simpleConfig()
+	storageID := storagetest.NewStorageID("missing")
+	cfg.Resolver.StorageID = &storageID
+	lb, err := newLoadBalancer(exportertest.NewNopCreateSettings(), cfg, nil)
+	require.NoError(t, err)
+	lb.res = &mockResolver{}
+
+	err = lb.Start(context.Background(), storagetest.NewStorageHost().WithExtension(storageID, storagetest.NewNonStorageExtension("missing")))
+	assert.EqualError(t, err, "non-storage extension 'test_storage/missing' found")
+
+	err = lb.Start(context.Background(), storagetest.NewStorageHost())
+	assert.EqualError(t, err, "storage extension 'test_storage/missing' not found")
+}
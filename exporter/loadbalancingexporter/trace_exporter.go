@@ -6,19 +6,19 @@ package loadbalancingexporter // import "github.com/open-telemetry/opentelemetry
 import (
 	"context"
 	"errors"
-	"fmt"
+	"strings"
 	"sync"
-	"time"
 
-	"go.opencensus.io/stats"
-	"go.opencensus.io/tag"
 	"go.opentelemetry.io/collector/component"
 	"go.opentelemetry.io/collector/consumer"
+	"go.opentelemetry.io/collector/consumer/consumererror"
 	"go.opentelemetry.io/collector/exporter"
 	"go.opentelemetry.io/collector/exporter/otlpexporter"
+	"go.opentelemetry.io/collector/pdata/pcommon"
 	"go.opentelemetry.io/collector/pdata/ptrace"
-	"go.uber.org/multierr"
+	"go.uber.org/zap"
 
+	"github.com/open-telemetry/opentelemetry-collector-contrib/exporter/loadbalancingexporter/internal/metadata"
 	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/batchpersignal"
 )
 
@@ -27,8 +27,13 @@ var _ exporter.Traces = (*traceExporterImp)(nil)
 type exporterTraces map[*wrappedExporter]ptrace.Traces
 
 type traceExporterImp struct {
-	loadBalancer *loadBalancer
-	routingKey   routingKey
+	loadBalancer       *loadBalancer
+	routingKey         routingKey
+	routingAttributes  []string
+	routingScope       *RoutingScopeConfig
+	routingMetadataKey string
+	routingTraceState  string
+	telemetry          *metadata.TelemetryBuilder
 
 	stopped    bool
 	shutdownWg sync.WaitGroup
@@ -39,21 +44,31 @@ func newTracesExporter(params exporter.CreateSettings, cfg component.Config) (*t
 	exporterFactory := otlpexporter.NewFactory()
 
 	lb, err := newLoadBalancer(params, cfg, func(ctx context.Context, endpoint string) (component.Component, error) {
-		oCfg := buildExporterConfig(cfg.(*Config), endpoint)
-		return exporterFactory.CreateTracesExporter(ctx, params, &oCfg)
+		return createEndpointExporter(ctx, cfg.(*Config), params, endpoint, component.DataTypeTraces, func(set exporter.CreateSettings, oCfg *otlpexporter.Config) (component.Component, error) {
+			return exporterFactory.CreateTracesExporter(ctx, set, oCfg)
+		})
 	})
 	if err != nil {
 		return nil, err
 	}
 
-	traceExporter := traceExporterImp{loadBalancer: lb, routingKey: traceIDRouting}
+	traceExporter := traceExporterImp{loadBalancer: lb, routingKey: traceIDRouting, routingScope: cfg.(*Config).RoutingScope, telemetry: lb.telemetry}
 
 	switch cfg.(*Config).RoutingKey {
 	case "service":
 		traceExporter.routingKey = svcRouting
+	case "resource":
+		traceExporter.routingKey = resourceRouting
+		traceExporter.routingAttributes = cfg.(*Config).RoutingAttributes
+	case "metadata":
+		traceExporter.routingKey = metadataRouting
+		traceExporter.routingMetadataKey = cfg.(*Config).RoutingMetadataKey
+	case "tracestate":
+		traceExporter.routingKey = traceStateRouting
+		traceExporter.routingTraceState = cfg.(*Config).RoutingTraceStateKey
 	case "traceID", "":
 	default:
-		return nil, fmt.Errorf("unsupported routing_key: %s", cfg.(*Config).RoutingKey)
+		return nil, errUnsupportedRoutingKey(component.DataTypeTraces, cfg.(*Config).RoutingKey)
 	}
 	return &traceExporter, nil
 }
@@ -61,9 +76,27 @@ func newTracesExporter(params exporter.CreateSettings, cfg component.Config) (*t
 func buildExporterConfig(cfg *Config, endpoint string) otlpexporter.Config {
 	oCfg := cfg.Protocol.OTLP
 	oCfg.Endpoint = endpoint
+	for configured, timeout := range cfg.EndpointTimeouts {
+		if endpointWithPort(configured) == endpoint {
+			oCfg.Timeout = timeout
+		}
+	}
 	return oCfg
 }
 
+// wrappedExporterSettings returns the settings of the exporter of the given endpoint. Each exporter gets its own ID,
+// derived from the ID of the load balancer and the endpoint, so that their sending queues are persisted separately
+// when backed by a storage extension.
+func wrappedExporterSettings(params exporter.CreateSettings, endpoint string) exporter.CreateSettings {
+	name := endpoint
+	if params.ID.Name() != "" {
+		name = params.ID.Name() + "_" + endpoint
+	}
+	params.ID = component.NewIDWithName(params.ID.Type(), name)
+	params.Logger = params.Logger.With(zap.String("endpoint", endpoint))
+	return params
+}
+
 func (e *traceExporterImp) Capabilities() consumer.Capabilities {
 	return consumer.Capabilities{MutatesData: false}
 }
@@ -80,59 +113,124 @@ func (e *traceExporterImp) Shutdown(ctx context.Context) error {
 }
 
 func (e *traceExporterImp) ConsumeTraces(ctx context.Context, td ptrace.Traces) error {
-	batches := batchpersignal.SplitTraces(td)
+	ctx, span := e.loadBalancer.startRoutingSpan(ctx, e.routingKey)
+	err := e.consumeTraces(ctx, td)
+	endSpan(span, err)
+	return err
+}
+
+func (e *traceExporterImp) consumeTraces(ctx context.Context, td ptrace.Traces) error {
+	var batches []ptrace.Traces
+	switch {
+	case e.routingKey == metadataRouting:
+		// all the data in the request shares the same routing identifier
+		batches = []ptrace.Traces{td}
+	case e.routingScope != nil:
+		// all the spans of a resource and scope are routed together, regardless of their trace
+		batches = splitTracesByScope(td)
+	case e.routingKey == resourceRouting:
+		// all the spans of a resource are routed together, regardless of their trace
+		batches = splitTracesByResource(td)
+	default:
+		batches = batchpersignal.SplitTraces(td)
+	}
 
 	exporterSegregatedTraces := make(exporterTraces)
 	endpoints := make(map[*wrappedExporter]string)
+	var routedBatches []routedBatch
 	for _, batch := range batches {
-		routingID, err := routingIdentifiersFromTraces(batch, e.routingKey)
-		if err != nil {
+		var routingID map[string]bool
+		var err error
+		if e.routingKey == metadataRouting {
+			routingID = map[string]bool{metadataRoutingID(ctx, e.routingMetadataKey): true}
+		} else if e.routingKey == traceStateRouting {
+			routingID = map[string]bool{traceStateRoutingID(batch, e.routingTraceState): true}
+		} else if routingID, err = routingIdentifiersFromTraces(batch, e.routingKey, e.routingAttributes); err != nil {
 			return err
+		} else if e.routingScope != nil {
+			routingID = withScopeRoutingID(routingID, scopeRoutingID(batch.ResourceSpans().At(0).ScopeSpans().At(0).Scope(), e.routingScope))
 		}
 
 		for rid := range routingID {
-			exp, endpoint, err := e.loadBalancer.exporterAndEndpoint([]byte(rid))
+			exps, err := e.loadBalancer.exportersAndEndpoints([]byte(rid))
 			if err != nil {
 				return err
 			}
+			recordRouting(ctx, e.routingKey, rid, exps)
 
-			_, ok := exporterSegregatedTraces[exp]
-			if !ok {
-				exp.consumeWG.Add(1)
-				exporterSegregatedTraces[exp] = ptrace.NewTraces()
-			}
-			exporterSegregatedTraces[exp] = mergeTraces(exporterSegregatedTraces[exp], batch)
+			routed := routedBatch{offsets: make(map[*wrappedExporter]int, len(exps))}
+			for exp, endpoint := range exps {
+				data := batch
+				if len(exps) > 1 {
+					// merging moves the data, so each replica gets its own copy
+					data = ptrace.NewTraces()
+					batch.CopyTo(data)
+				}
 
-			endpoints[exp] = endpoint
+				_, ok := exporterSegregatedTraces[exp]
+				if !ok {
+					exp.consumeWG.Add(1)
+					exporterSegregatedTraces[exp] = ptrace.NewTraces()
+				}
+				routed.offsets[exp] = exporterSegregatedTraces[exp].ResourceSpans().Len()
+				routed.resources = data.ResourceSpans().Len()
+				exporterSegregatedTraces[exp] = mergeTraces(exporterSegregatedTraces[exp], data)
+
+				endpoints[exp] = endpoint
+			}
+			routedBatches = append(routedBatches, routed)
 		}
 	}
 
-	var errs error
+	var failures exportFailures
+	failedExporters := make(map[*wrappedExporter]bool)
+	outgoingCtx := e.loadBalancer.propagation.OutgoingContext(ctx)
 
+	// all the exports are started before waiting for any of them, so that the queued ones run concurrently
+	results := make(map[*wrappedExporter]<-chan error, len(exporterSegregatedTraces))
+	spanCounts := make(map[*wrappedExporter]int, len(exporterSegregatedTraces))
 	for exp, td := range exporterSegregatedTraces {
-		start := time.Now()
-		err := exp.ConsumeTraces(ctx, td)
-		exp.consumeWG.Done()
-		errs = multierr.Append(errs, err)
-		duration := time.Since(start)
-
-		if err == nil {
-			_ = stats.RecordWithTags(
-				ctx,
-				[]tag.Mutator{tag.Upsert(endpointTagKey, endpoints[exp]), successTrueMutator},
-				mBackendLatency.M(duration.Milliseconds()))
-		} else {
-			_ = stats.RecordWithTags(
-				ctx,
-				[]tag.Mutator{tag.Upsert(endpointTagKey, endpoints[exp]), successFalseMutator},
-				mBackendLatency.M(duration.Milliseconds()))
+		exp, td := exp, td
+		spanCounts[exp] = td.SpanCount()
+		results[exp] = e.loadBalancer.exportTo(ctx, outgoingCtx, exp, endpoints[exp], spanCounts[exp], func(exportCtx context.Context) error {
+			return exp.ConsumeTraces(exportCtx, td)
+		})
+
+		e.telemetry.LoadbalancerRoutedSpans.Add(ctx, int64(spanCounts[exp]), routedAttrs(endpoints[exp], e.routingKey))
+	}
+
+	for exp, result := range results {
+		err := <-result
+		failures.record(endpoints[exp], spanCounts[exp], err)
+		if err != nil {
+			failedExporters[exp] = true
 		}
 	}
 
-	return errs
+	exportErr := failures.finish(ctx, e.telemetry)
+	if exportErr == nil {
+		return nil
+	}
+	// report the batches that couldn't be exported to any of their endpoints, so that only those are retried
+	failed := ptrace.NewTraces()
+	for _, routed := range routedBatches {
+		exp, offset, ok := routed.failedIn(failedExporters)
+		if !ok {
+			continue
+		}
+		resources := exporterSegregatedTraces[exp].ResourceSpans()
+		for i := offset; i < offset+routed.resources; i++ {
+			resources.At(i).CopyTo(failed.ResourceSpans().AppendEmpty())
+		}
+	}
+	if failed.ResourceSpans().Len() == 0 {
+		// every batch was accepted by at least one of its replicas
+		return nil
+	}
+	return consumererror.NewTraces(exportErr, failed)
 }
 
-func routingIdentifiersFromTraces(td ptrace.Traces, key routingKey) (map[string]bool, error) {
+func routingIdentifiersFromTraces(td ptrace.Traces, key routingKey, attrKeys []string) (map[string]bool, error) {
 	ids := make(map[string]bool)
 	rs := td.ResourceSpans()
 	if rs.Len() == 0 {
@@ -149,6 +247,12 @@ func routingIdentifiersFromTraces(td ptrace.Traces, key routingKey) (map[string]
 		return nil, errors.New("empty spans")
 	}
 
+	if key == resourceRouting {
+		for i := 0; i < rs.Len(); i++ {
+			ids[resourceRoutingID(rs.At(i).Resource().Attributes(), attrKeys)] = true
+		}
+		return ids, nil
+	}
 	if key == svcRouting {
 		for i := 0; i < rs.Len(); i++ {
 			svc, ok := rs.At(i).Resource().Attributes().Get("service.name")
@@ -163,3 +267,80 @@ func routingIdentifiersFromTraces(td ptrace.Traces, key routingKey) (map[string]
 	ids[string(tid[:])] = true
 	return ids, nil
 }
+
+// traceStateRoutingID returns the value of the tracestate key of the first span of the trace carrying it, so that
+// all the spans of the trace are routed together. Traces without the key are routed by their trace ID.
+func traceStateRoutingID(td ptrace.Traces, key string) string {
+	var tid pcommon.TraceID
+	for i := 0; i < td.ResourceSpans().Len(); i++ {
+		ilss := td.ResourceSpans().At(i).ScopeSpans()
+		for j := 0; j < ilss.Len(); j++ {
+			spans := ilss.At(j).Spans()
+			for k := 0; k < spans.Len(); k++ {
+				if value, ok := traceStateValue(spans.At(k).TraceState().AsRaw(), key); ok {
+					return value
+				}
+				tid = spans.At(k).TraceID()
+			}
+		}
+	}
+	return string(tid[:])
+}
+
+// traceStateValue returns the value of the key in the W3C tracestate, e.g. "acme" for the key "congo" in
+// "congo=acme,rojo=00f067aa0ba902b7". A key made of a list member key and a field name separated by a dot, e.g.
+// "ot.tenant", selects a field of the semicolon-separated value of the member, e.g. "acme" in "ot=th:0;tenant:acme".
+func traceStateValue(traceState string, key string) (string, bool) {
+	member, field, hasField := strings.Cut(key, ".")
+	for _, listMember := range strings.Split(traceState, ",") {
+		k, value, ok := strings.Cut(strings.TrimSpace(listMember), "=")
+		if !ok || k != member {
+			continue
+		}
+		if !hasField {
+			return value, value != ""
+		}
+		for _, f := range strings.Split(value, ";") {
+			name, fieldValue, ok := strings.Cut(f, ":")
+			if !ok {
+				name, fieldValue, ok = strings.Cut(f, "=")
+			}
+			if ok && name == field {
+				return fieldValue, fieldValue != ""
+			}
+		}
+		return "", false
+	}
+	return "", false
+}
+
+// splitTracesByScope returns one ptrace.Traces per scope of the given traces, skipping the scopes without spans.
+func splitTracesByScope(td ptrace.Traces) []ptrace.Traces {
+	var batches []ptrace.Traces
+	for i := 0; i < td.ResourceSpans().Len(); i++ {
+		rs := td.ResourceSpans().At(i)
+		for j := 0; j < rs.ScopeSpans().Len(); j++ {
+			if rs.ScopeSpans().At(j).Spans().Len() == 0 {
+				continue
+			}
+			batch := ptrace.NewTraces()
+			dest := batch.ResourceSpans().AppendEmpty()
+			rs.Resource().CopyTo(dest.Resource())
+			dest.SetSchemaUrl(rs.SchemaUrl())
+			rs.ScopeSpans().At(j).CopyTo(dest.ScopeSpans().AppendEmpty())
+			batches = append(batches, batch)
+		}
+	}
+	return batches
+}
+
+// splitTracesByResource returns one ptrace.Traces per resource of the given traces.
+func splitTracesByResource(td ptrace.Traces) []ptrace.Traces {
+	batches := make([]ptrace.Traces, 0, td.ResourceSpans().Len())
+	for i := 0; i < td.ResourceSpans().Len(); i++ {
+		batch := ptrace.NewTraces()
+		td.ResourceSpans().At(i).CopyTo(batch.ResourceSpans().AppendEmpty())
+		batches = append(batches, batch)
+	}
+	return batches
+}
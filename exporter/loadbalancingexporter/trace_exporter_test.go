@@ -10,6 +10,7 @@ import (
 	"math/rand"
 	"net"
 	"path/filepath"
+	"strings"
 	"sync"
 	"sync/atomic"
 	"testing"
@@ -17,18 +18,25 @@ import (
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/collector/client"
 	"go.opentelemetry.io/collector/component"
 	"go.opentelemetry.io/collector/component/componenttest"
 	"go.opentelemetry.io/collector/consumer"
+	"go.opentelemetry.io/collector/consumer/consumererror"
 	"go.opentelemetry.io/collector/consumer/consumertest"
 	"go.opentelemetry.io/collector/exporter"
 	"go.opentelemetry.io/collector/exporter/exportertest"
 	"go.opentelemetry.io/collector/exporter/otlpexporter"
+	"go.opentelemetry.io/collector/extension/experimental/storage"
 	"go.opentelemetry.io/collector/otelcol/otelcoltest"
 	"go.opentelemetry.io/collector/pdata/pcommon"
 	"go.opentelemetry.io/collector/pdata/ptrace"
+	"go.opentelemetry.io/collector/pdata/ptrace/ptraceotlp"
 	conventions "go.opentelemetry.io/collector/semconv/v1.9.0"
+	"go.opentelemetry.io/otel/sdk/metric/metricdata"
 	"go.uber.org/zap"
+	"google.golang.org/grpc"
+	grpcmetadata "google.golang.org/grpc/metadata"
 
 	"github.com/open-telemetry/opentelemetry-collector-contrib/exporter/loadbalancingexporter/internal/metadata"
 )
@@ -155,6 +163,393 @@ func TestConsumeTraces(t *testing.T) {
 	assert.Nil(t, res)
 }
 
+func TestConsumeTracesPropagatesMetadata(t *testing.T) {
+	var outgoing grpcmetadata.MD
+	componentFactory := func(_ context.Context, _ string) (component.Component, error) {
+		return newMockTracesExporter(func(ctx context.Context, _ ptrace.Traces) error {
+			outgoing, _ = grpcmetadata.FromOutgoingContext(ctx)
+			return nil
+		}), nil
+	}
+	cfg := simpleConfig()
+	cfg.PropagateMetadata.Keys = []string{"X-Scope-OrgID"}
+	lb, err := newLoadBalancer(exportertest.NewNopCreateSettings(), cfg, componentFactory)
+	require.NoError(t, err)
+
+	p, err := newTracesExporter(exportertest.NewNopCreateSettings(), cfg)
+	require.NoError(t, err)
+
+	// pre-load an exporter here, so that we don't use the actual OTLP exporter
+	lb.addMissingExporters(context.Background(), []string{"endpoint-1"})
+	lb.res = &mockResolver{
+		triggerCallbacks: true,
+		onResolve: func(_ context.Context) ([]string, error) {
+			return []string{"endpoint-1"}, nil
+		},
+	}
+	p.loadBalancer = lb
+
+	require.NoError(t, p.Start(context.Background(), componenttest.NewNopHost()))
+	defer func() {
+		require.NoError(t, p.Shutdown(context.Background()))
+	}()
+
+	// test
+	ctx := client.NewContext(context.Background(), client.Info{
+		Metadata: client.NewMetadata(map[string][]string{
+			"x-scope-orgid": {"tenant-1"},
+			"authorization": {"secret"},
+		}),
+	})
+	require.NoError(t, p.ConsumeTraces(ctx, simpleTraces()))
+
+	// verify
+	assert.Equal(t, grpcmetadata.Pairs("x-scope-orgid", "tenant-1"), outgoing)
+}
+
+func TestConsumeTracesPropagatesMetadataThroughSendingQueue(t *testing.T) {
+	// prepare
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	receiver := &metadataTracesReceiver{received: make(chan grpcmetadata.MD, 1)}
+	server := grpc.NewServer()
+	ptraceotlp.RegisterGRPCServer(server, receiver)
+	go func() {
+		_ = server.Serve(listener)
+	}()
+	defer server.Stop()
+
+	cfg := createDefaultConfig().(*Config)
+	cfg.Resolver.Static = &StaticResolver{Hostnames: []string{listener.Addr().String()}}
+	cfg.PropagateMetadata.Keys = []string{"X-Scope-OrgID"}
+	cfg.Protocol.OTLP.TLSSetting.Insecure = true
+	require.True(t, cfg.Protocol.OTLP.QueueConfig.Enabled)
+	require.NoError(t, component.ValidateConfig(cfg))
+
+	p, err := newTracesExporter(exportertest.NewNopCreateSettings(), cfg)
+	require.NoError(t, err)
+	require.NoError(t, p.Start(context.Background(), componenttest.NewNopHost()))
+	defer func() {
+		require.NoError(t, p.Shutdown(context.Background()))
+	}()
+
+	// test
+	ctx := client.NewContext(context.Background(), client.Info{
+		Metadata: client.NewMetadata(map[string][]string{"x-scope-orgid": {"tenant-1"}}),
+	})
+	require.NoError(t, p.ConsumeTraces(ctx, simpleTraces()))
+
+	// verify: the metadata is kept with the queued request
+	select {
+	case md := <-receiver.received:
+		assert.Equal(t, []string{"tenant-1"}, md.Get("x-scope-orgid"))
+	case <-time.After(5 * time.Second):
+		t.Fatal("the backend didn't receive the traces")
+	}
+}
+
+type metadataTracesReceiver struct {
+	ptraceotlp.UnimplementedGRPCServer
+	received chan grpcmetadata.MD
+}
+
+func (r *metadataTracesReceiver) Export(ctx context.Context, _ ptraceotlp.ExportRequest) (ptraceotlp.ExportResponse, error) {
+	md, _ := grpcmetadata.FromIncomingContext(ctx)
+	select {
+	case r.received <- md:
+	default:
+	}
+	return ptraceotlp.NewExportResponse(), nil
+}
+
+func TestConsumeTracesRoutesByMetadata(t *testing.T) {
+	var mu sync.Mutex
+	received := map[string]int{}
+	componentFactory := func(_ context.Context, endpoint string) (component.Component, error) {
+		return newMockTracesExporter(func(_ context.Context, td ptrace.Traces) error {
+			mu.Lock()
+			defer mu.Unlock()
+			received[endpoint] += td.SpanCount()
+			return nil
+		}), nil
+	}
+	cfg := simpleConfig()
+	cfg.RoutingKey = "metadata"
+	cfg.RoutingMetadataKey = "X-Scope-OrgID"
+	lb, err := newLoadBalancer(exportertest.NewNopCreateSettings(), cfg, componentFactory)
+	require.NoError(t, err)
+
+	p, err := newTracesExporter(exportertest.NewNopCreateSettings(), cfg)
+	require.NoError(t, err)
+	assert.Equal(t, metadataRouting, p.routingKey)
+
+	lb.res = &mockResolver{
+		triggerCallbacks: true,
+		onResolve: func(_ context.Context) ([]string, error) {
+			return []string{"endpoint-1", "endpoint-2", "endpoint-3"}, nil
+		},
+	}
+	p.loadBalancer = lb
+
+	require.NoError(t, p.Start(context.Background(), componenttest.NewNopHost()))
+	defer func() {
+		require.NoError(t, p.Shutdown(context.Background()))
+	}()
+
+	// test: spans from many different traces, all from the same tenant
+	ctx := client.NewContext(context.Background(), client.Info{
+		Metadata: client.NewMetadata(map[string][]string{"x-scope-orgid": {"tenant-1"}}),
+	})
+	for i := 0; i < 10; i++ {
+		td := ptrace.NewTraces()
+		span := td.ResourceSpans().AppendEmpty().ScopeSpans().AppendEmpty().Spans().AppendEmpty()
+		span.SetTraceID([16]byte{byte(i), 2, 3, 4})
+		require.NoError(t, p.ConsumeTraces(ctx, td))
+	}
+
+	// verify
+	mu.Lock()
+	defer mu.Unlock()
+	_, expected, err := lb.exporterAndEndpoint([]byte("tenant-1"))
+	require.NoError(t, err)
+	assert.Equal(t, map[string]int{endpointWithPort(expected): 10}, received)
+}
+
+func TestConsumeTracesRoutesByTraceState(t *testing.T) {
+	var mu sync.Mutex
+	received := map[string]int{}
+	componentFactory := func(_ context.Context, endpoint string) (component.Component, error) {
+		return newMockTracesExporter(func(_ context.Context, td ptrace.Traces) error {
+			mu.Lock()
+			defer mu.Unlock()
+			received[endpoint] += td.SpanCount()
+			return nil
+		}), nil
+	}
+	cfg := simpleConfig()
+	cfg.RoutingKey = "tracestate"
+	cfg.RoutingTraceStateKey = "ot.tenant"
+	lb, err := newLoadBalancer(exportertest.NewNopCreateSettings(), cfg, componentFactory)
+	require.NoError(t, err)
+
+	p, err := newTracesExporter(exportertest.NewNopCreateSettings(), cfg)
+	require.NoError(t, err)
+	assert.Equal(t, traceStateRouting, p.routingKey)
+
+	lb.res = &mockResolver{
+		triggerCallbacks: true,
+		onResolve: func(_ context.Context) ([]string, error) {
+			return []string{"endpoint-1", "endpoint-2", "endpoint-3"}, nil
+		},
+	}
+	p.loadBalancer = lb
+
+	require.NoError(t, p.Start(context.Background(), componenttest.NewNopHost()))
+	defer func() {
+		require.NoError(t, p.Shutdown(context.Background()))
+	}()
+
+	// test: spans from many different traces, all from the same tenant
+	for i := 0; i < 10; i++ {
+		td := ptrace.NewTraces()
+		spans := td.ResourceSpans().AppendEmpty().ScopeSpans().AppendEmpty().Spans()
+		// the tenant is only known from the second span of the trace
+		spans.AppendEmpty().SetTraceID([16]byte{byte(i), 2, 3, 4})
+		span := spans.AppendEmpty()
+		span.SetTraceID([16]byte{byte(i), 2, 3, 4})
+		span.TraceState().FromRaw("rojo=00f067aa0ba902b7,ot=th:0;tenant:acme")
+		require.NoError(t, p.ConsumeTraces(context.Background(), td))
+	}
+
+	// verify
+	mu.Lock()
+	defer mu.Unlock()
+	_, expected, err := lb.exporterAndEndpoint([]byte("acme"))
+	require.NoError(t, err)
+	assert.Equal(t, map[string]int{endpointWithPort(expected): 20}, received)
+}
+
+func TestTraceStateValue(t *testing.T) {
+	for _, tt := range []struct {
+		traceState string
+		key        string
+		expected   string
+		found      bool
+	}{
+		{traceState: "congo=t61rcWkgMzE,rojo=00f067aa0ba902b7", key: "rojo", expected: "00f067aa0ba902b7", found: true},
+		{traceState: "congo=t61rcWkgMzE, rojo=00f067aa0ba902b7", key: "rojo", expected: "00f067aa0ba902b7", found: true},
+		{traceState: "congo=t61rcWkgMzE", key: "rojo"},
+		{traceState: "", key: "rojo"},
+		{traceState: "ot=th:0;tenant:acme", key: "ot.tenant", expected: "acme", found: true},
+		{traceState: "ot=th:0;tenant=acme", key: "ot.tenant", expected: "acme", found: true},
+		{traceState: "ot=th:0", key: "ot.tenant"},
+		{traceState: "rojo=tenant:acme", key: "ot.tenant"},
+		{traceState: "ot=tenant:", key: "ot.tenant"},
+	} {
+		t.Run(tt.traceState+"/"+tt.key, func(t *testing.T) {
+			value, found := traceStateValue(tt.traceState, tt.key)
+			assert.Equal(t, tt.expected, value)
+			assert.Equal(t, tt.found, found)
+		})
+	}
+}
+
+func TestTraceStateRoutingIDWithoutKey(t *testing.T) {
+	td := ptrace.NewTraces()
+	span := td.ResourceSpans().AppendEmpty().ScopeSpans().AppendEmpty().Spans().AppendEmpty()
+	span.SetTraceID([16]byte{1, 2, 3, 4})
+	span.TraceState().FromRaw("rojo=00f067aa0ba902b7")
+
+	tid := span.TraceID()
+	assert.Equal(t, string(tid[:]), traceStateRoutingID(td, "congo"))
+}
+
+func TestConsumeTracesReplicated(t *testing.T) {
+	var mu sync.Mutex
+	received := map[string]int{}
+	componentFactory := func(_ context.Context, endpoint string) (component.Component, error) {
+		return newMockTracesExporter(func(_ context.Context, td ptrace.Traces) error {
+			mu.Lock()
+			defer mu.Unlock()
+			received[endpoint] += td.SpanCount()
+			return nil
+		}), nil
+	}
+	cfg := simpleConfig()
+	cfg.ReplicationFactor = 2
+	lb, err := newLoadBalancer(exportertest.NewNopCreateSettings(), cfg, componentFactory)
+	require.NoError(t, err)
+
+	p, err := newTracesExporter(exportertest.NewNopCreateSettings(), cfg)
+	require.NoError(t, err)
+
+	lb.res = &mockResolver{
+		triggerCallbacks: true,
+		onResolve: func(_ context.Context) ([]string, error) {
+			return []string{"endpoint-1", "endpoint-2", "endpoint-3"}, nil
+		},
+	}
+	p.loadBalancer = lb
+
+	require.NoError(t, p.Start(context.Background(), componenttest.NewNopHost()))
+	defer func() {
+		require.NoError(t, p.Shutdown(context.Background()))
+	}()
+
+	// test
+	require.NoError(t, p.ConsumeTraces(context.Background(), simpleTraces()))
+
+	// verify: both replicas received the whole trace
+	mu.Lock()
+	defer mu.Unlock()
+	tid := [16]byte{1, 2, 3, 4}
+	expected := map[string]int{}
+	for _, endpoint := range lb.ring.endpointsFor(tid[:], 2) {
+		expected[endpointWithPort(endpoint)] = 1
+	}
+	assert.Len(t, expected, 2)
+	assert.Equal(t, expected, received)
+}
+
+func TestConsumeTracesPartialFailure(t *testing.T) {
+	failingEndpoint := endpointWithPort("endpoint-1")
+	componentFactory := func(_ context.Context, endpoint string) (component.Component, error) {
+		return newMockTracesExporter(func(_ context.Context, _ ptrace.Traces) error {
+			if endpoint == failingEndpoint {
+				return errors.New("backend unavailable")
+			}
+			return nil
+		}), nil
+	}
+	lb, err := newLoadBalancer(exportertest.NewNopCreateSettings(), simpleConfig(), componentFactory)
+	require.NoError(t, err)
+
+	p, err := newTracesExporter(exportertest.NewNopCreateSettings(), simpleConfig())
+	require.NoError(t, err)
+
+	lb.res = &mockResolver{
+		triggerCallbacks: true,
+		onResolve: func(_ context.Context) ([]string, error) {
+			return []string{"endpoint-1", "endpoint-2", "endpoint-3"}, nil
+		},
+	}
+	p.loadBalancer = lb
+
+	require.NoError(t, p.Start(context.Background(), componenttest.NewNopHost()))
+	defer func() {
+		require.NoError(t, p.Shutdown(context.Background()))
+	}()
+
+	// find traces routed to the failing endpoint and to the other ones
+	td := ptrace.NewTraces()
+	expectedFailed := map[pcommon.TraceID]bool{}
+	for i := byte(0); i < 20; i++ {
+		tid := pcommon.TraceID([16]byte{i, 1, 2, 3})
+		appendSimpleTraceWithID(td.ResourceSpans().AppendEmpty(), tid)
+		if endpointWithPort(lb.ring.endpointFor(tid[:])) == failingEndpoint {
+			expectedFailed[tid] = true
+		}
+	}
+	require.NotEmpty(t, expectedFailed)
+	require.Less(t, len(expectedFailed), 20)
+
+	// test
+	err = p.ConsumeTraces(context.Background(), td)
+
+	// verify: only the traces routed to the failing endpoint are returned for retry
+	var tracesErr consumererror.Traces
+	require.ErrorAs(t, err, &tracesErr)
+	failed := map[pcommon.TraceID]bool{}
+	rss := tracesErr.Data().ResourceSpans()
+	for i := 0; i < rss.Len(); i++ {
+		failed[rss.At(i).ScopeSpans().At(0).Spans().At(0).TraceID()] = true
+	}
+	assert.Equal(t, expectedFailed, failed)
+
+	// the failures are accounted for per endpoint
+	var exportErr *ExportError
+	require.ErrorAs(t, err, &exportErr)
+	assert.True(t, exportErr.Partial)
+	require.Len(t, exportErr.Endpoints, 1)
+	assert.Equal(t, "endpoint-1", exportErr.Endpoints[0].Endpoint)
+	assert.Equal(t, tracesErr.Data().SpanCount(), exportErr.Endpoints[0].FailedItems)
+}
+
+func TestConsumeTracesReplicatedPartialFailure(t *testing.T) {
+	failingEndpoint := endpointWithPort("endpoint-1")
+	componentFactory := func(_ context.Context, endpoint string) (component.Component, error) {
+		return newMockTracesExporter(func(_ context.Context, _ ptrace.Traces) error {
+			if endpoint == failingEndpoint {
+				return errors.New("backend unavailable")
+			}
+			return nil
+		}), nil
+	}
+	cfg := simpleConfig()
+	cfg.ReplicationFactor = 2
+	lb, err := newLoadBalancer(exportertest.NewNopCreateSettings(), cfg, componentFactory)
+	require.NoError(t, err)
+
+	p, err := newTracesExporter(exportertest.NewNopCreateSettings(), cfg)
+	require.NoError(t, err)
+
+	lb.res = &mockResolver{
+		triggerCallbacks: true,
+		onResolve: func(_ context.Context) ([]string, error) {
+			return []string{"endpoint-1", "endpoint-2"}, nil
+		},
+	}
+	p.loadBalancer = lb
+
+	require.NoError(t, p.Start(context.Background(), componenttest.NewNopHost()))
+	defer func() {
+		require.NoError(t, p.Shutdown(context.Background()))
+	}()
+
+	// test & verify: the traces were accepted by the replica on the other endpoint
+	assert.NoError(t, p.ConsumeTraces(context.Background(), simpleTraces()))
+}
+
 // This test validates that exporter is can concurrently change the endpoints while consuming traces.
 func TestConsumeTraces_ConcurrentResolverChange(t *testing.T) {
 	consumeStarted := make(chan struct{})
@@ -208,6 +603,140 @@ func TestConsumeTraces_ConcurrentResolverChange(t *testing.T) {
 	<-consumeDone
 }
 
+func TestConsumeTracesRoutesByResource(t *testing.T) {
+	var mu sync.Mutex
+	received := map[string]map[string]int{}
+	componentFactory := func(_ context.Context, endpoint string) (component.Component, error) {
+		return newMockTracesExporter(func(_ context.Context, td ptrace.Traces) error {
+			mu.Lock()
+			defer mu.Unlock()
+			for i := 0; i < td.ResourceSpans().Len(); i++ {
+				rs := td.ResourceSpans().At(i)
+				pod, _ := rs.Resource().Attributes().Get("k8s.pod.name")
+				if received[pod.Str()] == nil {
+					received[pod.Str()] = map[string]int{}
+				}
+				received[pod.Str()][endpoint] += rs.ScopeSpans().At(0).Spans().Len()
+			}
+			return nil
+		}), nil
+	}
+	cfg := simpleConfig()
+	cfg.RoutingKey = "resource"
+	cfg.RoutingAttributes = []string{"k8s.pod.name"}
+	lb, err := newLoadBalancer(exportertest.NewNopCreateSettings(), cfg, componentFactory)
+	require.NoError(t, err)
+
+	p, err := newTracesExporter(exportertest.NewNopCreateSettings(), cfg)
+	require.NoError(t, err)
+	assert.Equal(t, resourceRouting, p.routingKey)
+
+	lb.res = &mockResolver{
+		triggerCallbacks: true,
+		onResolve: func(_ context.Context) ([]string, error) {
+			return []string{"endpoint-1", "endpoint-2", "endpoint-3"}, nil
+		},
+	}
+	p.loadBalancer = lb
+
+	require.NoError(t, p.Start(context.Background(), componenttest.NewNopHost()))
+	defer func() {
+		require.NoError(t, p.Shutdown(context.Background()))
+	}()
+
+	// test: traces spanning many pods, with attributes that aren't part of the routing key
+	for i := 0; i < 10; i++ {
+		td := ptrace.NewTraces()
+		for j := 0; j < 5; j++ {
+			rs := td.ResourceSpans().AppendEmpty()
+			rs.Resource().Attributes().PutStr("k8s.pod.name", fmt.Sprintf("pod-%d", j))
+			rs.Resource().Attributes().PutStr("process.pid", fmt.Sprintf("%d", i))
+			span := rs.ScopeSpans().AppendEmpty().Spans().AppendEmpty()
+			span.SetTraceID([16]byte{byte(i), 2, 3, 4})
+		}
+		require.NoError(t, p.ConsumeTraces(context.Background(), td))
+	}
+
+	// verify: all the spans of a pod went to the same endpoint
+	mu.Lock()
+	defer mu.Unlock()
+	require.Len(t, received, 5)
+	for pod, endpoints := range received {
+		attrs := pcommon.NewMap()
+		attrs.PutStr("k8s.pod.name", pod)
+		_, expected, err := lb.exporterAndEndpoint([]byte(resourceRoutingID(attrs, nil)))
+		require.NoError(t, err)
+		assert.Equal(t, map[string]int{endpointWithPort(expected): 10}, endpoints)
+	}
+}
+
+func TestConsumeTracesRoutesByScope(t *testing.T) {
+	var mu sync.Mutex
+	received := map[string]map[string]int{}
+	componentFactory := func(_ context.Context, endpoint string) (component.Component, error) {
+		return newMockTracesExporter(func(_ context.Context, td ptrace.Traces) error {
+			mu.Lock()
+			defer mu.Unlock()
+			for i := 0; i < td.ResourceSpans().Len(); i++ {
+				for j := 0; j < td.ResourceSpans().At(i).ScopeSpans().Len(); j++ {
+					ss := td.ResourceSpans().At(i).ScopeSpans().At(j)
+					if received[ss.Scope().Name()] == nil {
+						received[ss.Scope().Name()] = map[string]int{}
+					}
+					received[ss.Scope().Name()][endpoint] += ss.Spans().Len()
+				}
+			}
+			return nil
+		}), nil
+	}
+	cfg := simpleConfig()
+	cfg.RoutingKey = "service"
+	cfg.RoutingScope = &RoutingScopeConfig{}
+	lb, err := newLoadBalancer(exportertest.NewNopCreateSettings(), cfg, componentFactory)
+	require.NoError(t, err)
+
+	p, err := newTracesExporter(exportertest.NewNopCreateSettings(), cfg)
+	require.NoError(t, err)
+
+	lb.res = &mockResolver{
+		triggerCallbacks: true,
+		onResolve: func(_ context.Context) ([]string, error) {
+			return []string{"endpoint-1", "endpoint-2", "endpoint-3"}, nil
+		},
+	}
+	p.loadBalancer = lb
+
+	require.NoError(t, p.Start(context.Background(), componenttest.NewNopHost()))
+	defer func() {
+		require.NoError(t, p.Shutdown(context.Background()))
+	}()
+
+	// test: a service instrumented by many libraries
+	for i := 0; i < 10; i++ {
+		td := ptrace.NewTraces()
+		rs := td.ResourceSpans().AppendEmpty()
+		rs.Resource().Attributes().PutStr("service.name", "svc")
+		for j := 0; j < 5; j++ {
+			ss := rs.ScopeSpans().AppendEmpty()
+			ss.Scope().SetName(fmt.Sprintf("library-%d", j))
+			ss.Spans().AppendEmpty().SetTraceID([16]byte{byte(i), 2, 3, 4})
+		}
+		// scopes without spans aren't routed
+		rs.ScopeSpans().AppendEmpty().Scope().SetName("empty")
+		require.NoError(t, p.ConsumeTraces(context.Background(), td))
+	}
+
+	// verify: all the spans of a library went to the same endpoint
+	mu.Lock()
+	defer mu.Unlock()
+	require.Len(t, received, 5)
+	for scope, endpoints := range received {
+		_, expected, err := lb.exporterAndEndpoint([]byte("svc\x00" + scope))
+		require.NoError(t, err)
+		assert.Equal(t, map[string]int{endpointWithPort(expected): 10}, endpoints)
+	}
+}
+
 func TestConsumeTracesServiceBased(t *testing.T) {
 	componentFactory := func(_ context.Context, _ string) (component.Component, error) {
 		return newNopMockTracesExporter(), nil
@@ -267,7 +796,7 @@ func TestServiceBasedRoutingForSameTraceId(t *testing.T) {
 		},
 	} {
 		t.Run(tt.desc, func(t *testing.T) {
-			res, err := routingIdentifiersFromTraces(tt.batch, tt.routingKey)
+			res, err := routingIdentifiersFromTraces(tt.batch, tt.routingKey, nil)
 			assert.Equal(t, err, nil)
 			assert.Equal(t, res, tt.res)
 		})
@@ -341,7 +870,16 @@ func TestConsumeTracesUnexpectedExporterType(t *testing.T) {
 
 	// verify
 	assert.Error(t, res)
-	assert.EqualError(t, res, fmt.Sprintf("unable to export traces, unexpected exporter type: expected exporter.Traces but got %T", newNopMockExporter()))
+	assert.ErrorContains(t, res, fmt.Sprintf("unable to export traces, unexpected exporter type: expected exporter.Traces but got %T", newNopMockExporter()))
+}
+
+func TestNewTracesExporterUnsupportedRoutingKey(t *testing.T) {
+	cfg := simpleConfig()
+	cfg.RoutingKey = "streamID"
+
+	_, err := newTracesExporter(exportertest.NewNopCreateSettings(), cfg)
+
+	assert.EqualError(t, err, `routing_key "streamID" can't be used for traces, supported values are "traceID", "service", "resource", "metadata" and "tracestate"`)
 }
 
 func TestBuildExporterConfig(t *testing.T) {
@@ -372,6 +910,153 @@ func TestBuildExporterConfig(t *testing.T) {
 	assert.Equal(t, defaultCfg.RetryConfig, exporterCfg.RetryConfig)
 }
 
+func TestBuildExporterConfigEndpointTimeout(t *testing.T) {
+	cfg := createDefaultConfig().(*Config)
+	cfg.Protocol.OTLP.Timeout = 5 * time.Second
+	cfg.EndpointTimeouts = map[string]time.Duration{
+		"remote-region":      30 * time.Second,
+		"other-region:55690": 20 * time.Second,
+	}
+
+	assert.Equal(t, 30*time.Second, buildExporterConfig(cfg, "remote-region:4317").Timeout)
+	assert.Equal(t, 20*time.Second, buildExporterConfig(cfg, "other-region:55690").Timeout)
+	assert.Equal(t, 5*time.Second, buildExporterConfig(cfg, "other-region:4317").Timeout)
+	assert.Equal(t, 5*time.Second, buildExporterConfig(cfg, "local:4317").Timeout)
+}
+
+func TestEndpointExportersQueueConfig(t *testing.T) {
+	// prepare
+	storageID := component.NewIDWithName(component.MustNewType("file_storage"), "queue")
+	cfg := createDefaultConfig().(*Config)
+	cfg.Protocol.OTLP.QueueConfig.Enabled = true
+	cfg.Protocol.OTLP.QueueConfig.NumConsumers = 3
+	cfg.Protocol.OTLP.QueueConfig.QueueSize = 42
+	cfg.Protocol.OTLP.QueueConfig.StorageID = &storageID
+
+	// test
+	created := map[string]*otlpexporter.Config{}
+	for _, endpoint := range []string{"endpoint-1:4317", "endpoint-2:4317"} {
+		_, err := createEndpointExporter(context.Background(), cfg, exportertest.NewNopCreateSettings(), endpoint, component.DataTypeTraces, func(_ exporter.CreateSettings, oCfg *otlpexporter.Config) (component.Component, error) {
+			created[endpoint] = oCfg
+			return newNopMockExporter(), nil
+		})
+		require.NoError(t, err)
+	}
+
+	// verify
+	require.Len(t, created, 2)
+	for endpoint, oCfg := range created {
+		assert.Equal(t, endpoint, oCfg.Endpoint)
+		assert.True(t, oCfg.QueueConfig.Enabled)
+		assert.Equal(t, 3, oCfg.QueueConfig.NumConsumers)
+		assert.Equal(t, 42, oCfg.QueueConfig.QueueSize)
+		require.NotNil(t, oCfg.QueueConfig.StorageID)
+		assert.Equal(t, storageID, *oCfg.QueueConfig.StorageID)
+	}
+}
+
+func TestEndpointExporterQueueMetrics(t *testing.T) {
+	// prepare
+	tt := setupTestTelemetry()
+	defer func() { require.NoError(t, tt.Shutdown(context.Background())) }()
+
+	cfg := createDefaultConfig().(*Config)
+	cfg.Protocol.OTLP.QueueConfig.Enabled = true
+	cfg.Protocol.OTLP.QueueConfig.QueueSize = 42
+
+	exp, err := createEndpointExporter(context.Background(), cfg, tt.NewCreateSettings(), "endpoint-1:4317", component.DataTypeTraces, func(set exporter.CreateSettings, oCfg *otlpexporter.Config) (component.Component, error) {
+		return otlpexporter.NewFactory().CreateTracesExporter(context.Background(), set, oCfg)
+	})
+	require.NoError(t, err)
+	require.NoError(t, exp.Start(context.Background(), componenttest.NewNopHost()))
+	defer func() { require.NoError(t, exp.Shutdown(context.Background())) }()
+
+	// test and verify: the queue of the endpoint is reported under the ID of its exporter
+	assert.Equal(t, map[string]int64{"loadbalancing/endpoint-1:4317": 42}, queueCapacityPerExporter(t, tt))
+}
+
+func queueCapacityPerExporter(t *testing.T, tt componentTestTelemetry) map[string]int64 {
+	var rm metricdata.ResourceMetrics
+	require.NoError(t, tt.reader.Collect(context.Background(), &rm))
+	capacities := map[string]int64{}
+	for _, sm := range rm.ScopeMetrics {
+		for _, m := range sm.Metrics {
+			if !strings.HasSuffix(m.Name, "queue_capacity") {
+				continue
+			}
+			for _, dp := range m.Data.(metricdata.Gauge[int64]).DataPoints {
+				id, found := dp.Attributes.Value("exporter")
+				require.True(t, found)
+				capacities[id.AsString()] = dp.Value
+			}
+		}
+	}
+	return capacities
+}
+
+func TestWrappedExporterSettings(t *testing.T) {
+	params := exportertest.NewNopCreateSettings()
+
+	params.ID = component.NewIDWithName(metadata.Type, "tier2")
+	assert.Equal(t, component.NewIDWithName(metadata.Type, "tier2_endpoint-1:4317"), wrappedExporterSettings(params, "endpoint-1:4317").ID)
+
+	params.ID = component.NewID(metadata.Type)
+	assert.Equal(t, component.NewIDWithName(metadata.Type, "endpoint-1:4317"), wrappedExporterSettings(params, "endpoint-1:4317").ID)
+}
+
+func TestPersistentQueuePerEndpoint(t *testing.T) {
+	// prepare
+	storageID := component.NewIDWithName(component.MustNewType("file_storage"), "queue")
+	ext := &recordingStorage{}
+	host := &storageHost{Host: componenttest.NewNopHost(), extensions: map[component.ID]component.Component{storageID: ext}}
+
+	cfg := createDefaultConfig().(*Config)
+	cfg.Resolver = ResolverSettings{Static: &StaticResolver{Hostnames: []string{"endpoint-1", "endpoint-2"}}}
+	cfg.Protocol.OTLP.QueueConfig.StorageID = &storageID
+
+	params := exportertest.NewNopCreateSettings()
+	params.ID = component.NewIDWithName(metadata.Type, "tier2")
+	p, err := newTracesExporter(params, cfg)
+	require.NoError(t, err)
+
+	// test
+	require.NoError(t, p.Start(context.Background(), host))
+	require.NoError(t, p.Shutdown(context.Background()))
+
+	// verify: each endpoint has its own persistent queue
+	ext.mu.Lock()
+	defer ext.mu.Unlock()
+	assert.ElementsMatch(t, []component.ID{
+		component.NewIDWithName(metadata.Type, "tier2_endpoint-1:4317"),
+		component.NewIDWithName(metadata.Type, "tier2_endpoint-2:4317"),
+	}, ext.ids)
+}
+
+type storageHost struct {
+	component.Host
+	extensions map[component.ID]component.Component
+}
+
+func (h *storageHost) GetExtensions() map[component.ID]component.Component {
+	return h.extensions
+}
+
+// recordingStorage is a storage extension recording the components requesting a client.
+type recordingStorage struct {
+	component.StartFunc
+	component.ShutdownFunc
+
+	mu  sync.Mutex
+	ids []component.ID
+}
+
+func (s *recordingStorage) GetClient(_ context.Context, _ component.Kind, id component.ID, _ string) (storage.Client, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.ids = append(s.ids, id)
+	return storage.NewNopClient(), nil
+}
+
 func TestBatchWithTwoTraces(t *testing.T) {
 	sink := new(consumertest.TracesSink)
 	componentFactory := func(_ context.Context, _ string) (component.Component, error) {
@@ -438,7 +1123,7 @@ func TestNoTracesInBatch(t *testing.T) {
 		},
 	} {
 		t.Run(tt.desc, func(t *testing.T) {
-			res, err := routingIdentifiersFromTraces(tt.batch, tt.routingKey)
+			res, err := routingIdentifiersFromTraces(tt.batch, tt.routingKey, nil)
 			assert.Equal(t, err, tt.err)
 			assert.Equal(t, res, map[string]bool(nil))
 		})
@@ -454,7 +1139,8 @@ func TestRollingUpdatesWhenConsumeTraces(t *testing.T) {
 
 	// simulate rolling updates, the dns resolver should resolve in the following order
 	// ["127.0.0.1"] -> ["127.0.0.1", "127.0.0.2"] -> ["127.0.0.2"]
-	res, err := newDNSResolver(zap.NewNop(), "service-1", "", 5*time.Second, 1*time.Second)
+	tb := newTestTelemetryBuilder(t)
+	res, err := newDNSResolver(zap.NewNop(), "service-1", "", 5*time.Second, 1*time.Second, tb)
 	require.NoError(t, err)
 
 	mu := sync.Mutex{}
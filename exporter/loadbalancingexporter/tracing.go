@@ -0,0 +1,78 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package loadbalancingexporter // import "github.com/open-telemetry/opentelemetry-collector-contrib/exporter/loadbalancingexporter"
+
+import (
+	"context"
+	"encoding/hex"
+	"sort"
+	"unicode/utf8"
+
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/trace"
+)
+
+const (
+	routingIDTagKey   = "routing_id"
+	endpointsTagKey   = "endpoints"
+	itemsTagKey       = "items"
+	ringChangedTagKey = "ring_changed"
+	filteredTagKey    = "filtered"
+
+	routingSpanName    = "loadbalancer/route"
+	exportSpanName     = "loadbalancer/export"
+	resolutionSpanName = "loadbalancer/resolution"
+	routedEventName    = "routed"
+)
+
+// startRoutingSpan starts the span covering the routing of a request and its export to the endpoints. The routing
+// decisions are recorded as events of this span, and the exports to each endpoint as its child spans.
+func (lb *loadBalancer) startRoutingSpan(ctx context.Context, key routingKey) (context.Context, trace.Span) {
+	return lb.tracer.Start(ctx, routingSpanName, trace.WithAttributes(attribute.String(routingKeyTagKey, key.String())))
+}
+
+// startExportSpan starts the span covering the export of the given number of items to an endpoint.
+func (lb *loadBalancer) startExportSpan(ctx context.Context, endpoint string, items int) (context.Context, trace.Span) {
+	return lb.tracer.Start(ctx, exportSpanName, trace.WithAttributes(
+		attribute.String(endpointTagKey, endpoint),
+		attribute.Int(itemsTagKey, items),
+	))
+}
+
+// recordRouting records the endpoints a routing identifier was routed to as an event of the routing span of the
+// context. As it's called for every routing identifier, the event is only built when the span is recording.
+func recordRouting(ctx context.Context, key routingKey, id string, exps map[*wrappedExporter]string) {
+	span := trace.SpanFromContext(ctx)
+	if !span.IsRecording() {
+		return
+	}
+	endpoints := make([]string, 0, len(exps))
+	for _, endpoint := range exps {
+		endpoints = append(endpoints, endpoint)
+	}
+	sort.Strings(endpoints)
+	span.AddEvent(routedEventName, trace.WithAttributes(
+		attribute.String(routingIDTagKey, printableRoutingID(key, id)),
+		attribute.StringSlice(endpointsTagKey, endpoints),
+	))
+}
+
+// printableRoutingID returns the routing identifier as a string fit for a span attribute, the trace IDs and the
+// other binary identifiers being hex encoded.
+func printableRoutingID(key routingKey, id string) string {
+	if key == traceIDRouting || !utf8.ValidString(id) {
+		return hex.EncodeToString([]byte(id))
+	}
+	return id
+}
+
+// endSpan ends the span, recording the error, if any.
+func endSpan(span trace.Span, err error) {
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+	}
+	span.End()
+}
@@ -0,0 +1,99 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package loadbalancingexporter
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/collector/component"
+	"go.opentelemetry.io/collector/component/componenttest"
+	"go.opentelemetry.io/collector/exporter/exportertest"
+	"go.opentelemetry.io/collector/pdata/ptrace"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+)
+
+func TestRoutingSpans(t *testing.T) {
+	// prepare
+	recorder := tracetest.NewSpanRecorder()
+	params := exportertest.NewNopCreateSettings()
+	params.TracerProvider = sdktrace.NewTracerProvider(sdktrace.WithSpanProcessor(recorder))
+
+	componentFactory := func(_ context.Context, _ string) (component.Component, error) {
+		return newMockTracesExporter(func(_ context.Context, _ ptrace.Traces) error {
+			return errors.New("unavailable")
+		}), nil
+	}
+	cfg := simpleConfig()
+	cfg.RoutingKey = "service"
+	lb, err := newLoadBalancer(params, cfg, componentFactory)
+	require.NoError(t, err)
+
+	p, err := newTracesExporter(params, cfg)
+	require.NoError(t, err)
+
+	lb.res = &mockResolver{
+		triggerCallbacks: true,
+		onResolve: func(_ context.Context) ([]string, error) {
+			return []string{"endpoint-1", "endpoint-2"}, nil
+		},
+	}
+	p.loadBalancer = lb
+
+	require.NoError(t, p.Start(context.Background(), componenttest.NewNopHost()))
+	defer func() {
+		require.NoError(t, p.Shutdown(context.Background()))
+	}()
+
+	td := ptrace.NewTraces()
+	rs := td.ResourceSpans().AppendEmpty()
+	rs.Resource().Attributes().PutStr("service.name", "checkout")
+	rs.ScopeSpans().AppendEmpty().Spans().AppendEmpty().SetTraceID([16]byte{1, 2, 3, 4})
+	_, endpoint, err := lb.exporterAndEndpoint([]byte("checkout"))
+	require.NoError(t, err)
+
+	// test
+	err = p.ConsumeTraces(context.Background(), td)
+
+	// verify
+	assert.Error(t, err)
+	spans := map[string]sdktrace.ReadOnlySpan{}
+	for _, span := range recorder.Ended() {
+		spans[span.Name()] = span
+	}
+	require.Contains(t, spans, resolutionSpanName)
+	assert.Contains(t, spans[resolutionSpanName].Attributes(), attribute.StringSlice(endpointsTagKey, []string{"endpoint-1", "endpoint-2"}))
+	assert.Contains(t, spans[resolutionSpanName].Attributes(), attribute.Bool(ringChangedTagKey, true))
+
+	require.Contains(t, spans, routingSpanName)
+	route := spans[routingSpanName]
+	assert.Contains(t, route.Attributes(), attribute.String(routingKeyTagKey, "service"))
+	// the routing decision, and the error
+	require.Len(t, route.Events(), 2)
+	assert.Equal(t, routedEventName, route.Events()[0].Name)
+	assert.Equal(t, []attribute.KeyValue{
+		attribute.String(routingIDTagKey, "checkout"),
+		attribute.StringSlice(endpointsTagKey, []string{endpoint}),
+	}, route.Events()[0].Attributes)
+
+	require.Contains(t, spans, exportSpanName)
+	export := spans[exportSpanName]
+	assert.Equal(t, route.SpanContext().SpanID(), export.Parent().SpanID())
+	assert.Contains(t, export.Attributes(), attribute.String(endpointTagKey, endpoint))
+	assert.Contains(t, export.Attributes(), attribute.Int(itemsTagKey, 1))
+	assert.Equal(t, codes.Error, export.Status().Code)
+	assert.Equal(t, codes.Error, route.Status().Code)
+}
+
+func TestPrintableRoutingID(t *testing.T) {
+	assert.Equal(t, "01020304", printableRoutingID(traceIDRouting, string([]byte{1, 2, 3, 4})))
+	assert.Equal(t, "checkout", printableRoutingID(svcRouting, "checkout"))
+	assert.Equal(t, "ff00", printableRoutingID(traceStateRouting, string([]byte{0xff, 0})))
+}
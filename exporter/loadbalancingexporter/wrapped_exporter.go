@@ -20,6 +20,9 @@ import (
 type wrappedExporter struct {
 	component.Component
 	consumeWG sync.WaitGroup
+
+	// queue, when the endpoint queue is enabled, holds the exports to the endpoint until they're run
+	queue *endpointQueue
 }
 
 func newWrappedExporter(exp component.Component) *wrappedExporter {
@@ -28,6 +31,9 @@ func newWrappedExporter(exp component.Component) *wrappedExporter {
 
 func (we *wrappedExporter) Shutdown(ctx context.Context) error {
 	we.consumeWG.Wait()
+	if we.queue != nil {
+		we.queue.close()
+	}
 	return we.Component.Shutdown(ctx)
 }
 
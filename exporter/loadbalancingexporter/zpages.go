@@ -0,0 +1,173 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package loadbalancingexporter // import "github.com/open-telemetry/opentelemetry-collector-contrib/exporter/loadbalancingexporter"
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"html/template"
+	"net/http"
+	"sort"
+	"sync"
+
+	"go.opentelemetry.io/collector/component"
+	"go.opentelemetry.io/collector/config/confighttp"
+)
+
+const ringPagePath = "/debug/loadbalancing"
+
+var ringPageTemplate = template.Must(template.New("ring").Parse(`<!DOCTYPE html>
+<html>
+<head><title>Load balancing exporter</title></head>
+<body>
+<h1>Load balancing exporter</h1>
+{{range .}}
+<h2>{{.ID}}</h2>
+<table border="1" cellpadding="4">
+<tr><th>Endpoint</th><th>Ring positions</th><th>Key share</th></tr>
+{{range .Endpoints}}<tr><td>{{.Endpoint}}</td><td>{{.Positions}}</td><td>{{printf "%.2f" .Share}}%</td></tr>
+{{else}}<tr><td colspan="3">No backends resolved yet</td></tr>
+{{end}}
+</table>
+{{end}}
+</body>
+</html>
+`))
+
+// ringPageServers holds the HTTP servers serving the ring page, keyed by endpoint. The same
+// exporter configuration is instantiated once per signal, so a server is shared by all the
+// load balancers configured with the same endpoint.
+var ringPageServers = struct {
+	sync.Mutex
+	servers map[string]*ringPageServer
+}{servers: map[string]*ringPageServer{}}
+
+type ringPageServer struct {
+	server *http.Server
+	done   chan struct{}
+
+	mu  sync.RWMutex
+	lbs []*loadBalancer
+}
+
+// ringPage is the debug page of a single load balancer, showing the current hash ring and
+// the share of the keys each endpoint is responsible for.
+type ringPage struct {
+	config confighttp.ServerConfig
+	lb     *loadBalancer
+}
+
+func (p *ringPage) start(ctx context.Context, host component.Host) error {
+	ringPageServers.Lock()
+	defer ringPageServers.Unlock()
+
+	if srv, ok := ringPageServers.servers[p.config.Endpoint]; ok {
+		srv.mu.Lock()
+		srv.lbs = append(srv.lbs, p.lb)
+		srv.mu.Unlock()
+		return nil
+	}
+
+	ln, err := p.config.ToListener(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to bind to address %s: %w", p.config.Endpoint, err)
+	}
+
+	srv := &ringPageServer{done: make(chan struct{}), lbs: []*loadBalancer{p.lb}}
+	mux := http.NewServeMux()
+	mux.HandleFunc(ringPagePath, srv.handle)
+	srv.server, err = p.config.ToServer(ctx, host, p.lb.settings, mux)
+	if err != nil {
+		_ = ln.Close()
+		return err
+	}
+	ringPageServers.servers[p.config.Endpoint] = srv
+
+	go func() {
+		defer close(srv.done)
+		// The listener ownership goes to the server.
+		if errHTTP := srv.server.Serve(ln); !errors.Is(errHTTP, http.ErrServerClosed) && errHTTP != nil {
+			p.lb.settings.ReportStatus(component.NewFatalErrorEvent(errHTTP))
+		}
+	}()
+	return nil
+}
+
+func (p *ringPage) shutdown(ctx context.Context) error {
+	ringPageServers.Lock()
+	defer ringPageServers.Unlock()
+
+	srv, ok := ringPageServers.servers[p.config.Endpoint]
+	if !ok {
+		return nil
+	}
+
+	srv.mu.Lock()
+	for i, lb := range srv.lbs {
+		if lb == p.lb {
+			srv.lbs = append(srv.lbs[:i], srv.lbs[i+1:]...)
+			break
+		}
+	}
+	remaining := len(srv.lbs)
+	srv.mu.Unlock()
+
+	if remaining > 0 {
+		return nil
+	}
+	delete(ringPageServers.servers, p.config.Endpoint)
+	err := srv.server.Shutdown(ctx)
+	<-srv.done
+	return err
+}
+
+type ringPageData struct {
+	ID        string
+	Endpoints []ringPageEndpoint
+}
+
+type ringPageEndpoint struct {
+	Endpoint  string
+	Positions int
+	Share     float64
+}
+
+func (s *ringPageServer) handle(w http.ResponseWriter, _ *http.Request) {
+	s.mu.RLock()
+	data := make([]ringPageData, 0, len(s.lbs))
+	for _, lb := range s.lbs {
+		data = append(data, lb.ringPageData())
+	}
+	s.mu.RUnlock()
+
+	w.Header().Set("Content-Type", "text/html; charset=utf-8")
+	if err := ringPageTemplate.Execute(w, data); err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+	}
+}
+
+func (lb *loadBalancer) ringPageData() ringPageData {
+	lb.updateLock.RLock()
+	defer lb.updateLock.RUnlock()
+
+	data := ringPageData{ID: lb.id.String()}
+	positions := map[string]int{}
+	if lb.ring != nil {
+		for _, item := range lb.ring.items {
+			positions[item.endpoint]++
+		}
+	}
+	for endpoint, share := range lb.ring.keyShare() {
+		data.Endpoints = append(data.Endpoints, ringPageEndpoint{
+			Endpoint:  endpoint,
+			Positions: positions[endpoint],
+			Share:     share * 100,
+		})
+	}
+	sort.Slice(data.Endpoints, func(i, j int) bool {
+		return data.Endpoints[i].Endpoint < data.Endpoints[j].Endpoint
+	})
+	return data
+}
@@ -0,0 +1,62 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package loadbalancingexporter
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/collector/component"
+	"go.opentelemetry.io/collector/component/componenttest"
+	"go.opentelemetry.io/collector/config/confighttp"
+	"go.opentelemetry.io/collector/exporter/exportertest"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/internal/common/testutil"
+)
+
+func TestRingPage(t *testing.T) {
+	// prepare
+	endpoint := testutil.GetAvailableLocalAddress(t)
+	cfg := simpleConfig()
+	cfg.ZPages = &confighttp.ServerConfig{Endpoint: endpoint}
+	componentFactory := func(_ context.Context, _ string) (component.Component, error) {
+		return newNopMockExporter(), nil
+	}
+
+	// the same configuration is used by two signals, sharing the server
+	lb1, err := newLoadBalancer(exportertest.NewNopCreateSettings(), cfg, componentFactory)
+	require.NoError(t, err)
+	lb2, err := newLoadBalancer(exportertest.NewNopCreateSettings(), cfg, componentFactory)
+	require.NoError(t, err)
+
+	require.NoError(t, lb1.Start(context.Background(), componenttest.NewNopHost()))
+	require.NoError(t, lb2.Start(context.Background(), componenttest.NewNopHost()))
+
+	client := &http.Client{}
+	defer client.CloseIdleConnections()
+
+	// test
+	resp, err := client.Get("http://" + endpoint + ringPagePath)
+	require.NoError(t, err)
+	body, err := io.ReadAll(resp.Body)
+	require.NoError(t, err)
+	require.NoError(t, resp.Body.Close())
+
+	// verify
+	assert.Equal(t, http.StatusOK, resp.StatusCode)
+	assert.Contains(t, string(body), "<td>endpoint-1</td><td>100</td><td>100.00%</td>")
+
+	require.NoError(t, lb1.Shutdown(context.Background()))
+	resp, err = client.Get("http://" + endpoint + ringPagePath)
+	require.NoError(t, err, "the server should remain available while a load balancer uses it")
+	require.NoError(t, resp.Body.Close())
+
+	require.NoError(t, lb2.Shutdown(context.Background()))
+	_, err = client.Get("http://" + endpoint + ringPagePath) //nolint:bodyclose // the request is expected to fail
+	assert.Error(t, err)
+}
@@ -19,8 +19,40 @@ type Config struct {
 	configretry.BackOffConfig    `mapstructure:"retry_on_failure"`
 
 	DefaultLabelsEnabled map[string]bool `mapstructure:"default_labels_enabled"`
+
+	// Tenant resolves the tenant sent in the X-Scope-OrgID header. The tenant from the `loki.tenant`
+	// hint takes precedence.
+	Tenant *Tenant `mapstructure:"tenant"`
+
+	// StructuredMetadata selects the attributes sent as Loki structured metadata.
+	StructuredMetadata StructuredMetadata `mapstructure:"structured_metadata"`
+}
+
+// Tenant defines where the tenant is resolved from.
+type Tenant struct {
+	// Source is one of `static`, `context` or `attribute`.
+	Source string `mapstructure:"source"`
+
+	// Value is the tenant for the `static` source, the client metadata key for the `context`
+	// source and the resource attribute for the `attribute` source.
+	Value string `mapstructure:"value"`
+}
+
+// StructuredMetadata defines the attributes sent as Loki structured metadata instead of in the log line.
+type StructuredMetadata struct {
+	// ResourceAttributes are the resource attributes sent as structured metadata.
+	ResourceAttributes []string `mapstructure:"resource_attributes"`
+
+	// Attributes are the log record attributes sent as structured metadata.
+	Attributes []string `mapstructure:"attributes"`
 }
 
+const (
+	tenantSourceStatic    = "static"
+	tenantSourceContext   = "context"
+	tenantSourceAttribute = "attribute"
+)
+
 func (c *Config) Validate() error {
 	if err := c.QueueSettings.Validate(); err != nil {
 		return fmt.Errorf("queue settings has invalid configuration: %w", err)
@@ -29,5 +61,24 @@ func (c *Config) Validate() error {
 	if _, err := url.Parse(c.Endpoint); c.Endpoint == "" || err != nil {
 		return fmt.Errorf("\"endpoint\" must be a valid URL")
 	}
+
+	if c.Tenant != nil {
+		switch c.Tenant.Source {
+		case tenantSourceStatic, tenantSourceContext, tenantSourceAttribute:
+		default:
+			return fmt.Errorf("\"tenant.source\" must be one of %q, %q or %q", tenantSourceStatic, tenantSourceContext, tenantSourceAttribute)
+		}
+		if c.Tenant.Value == "" {
+			return fmt.Errorf("\"tenant.value\" must be set")
+		}
+	}
+
+	for _, attrs := range [][]string{c.StructuredMetadata.ResourceAttributes, c.StructuredMetadata.Attributes} {
+		for _, attr := range attrs {
+			if attr == "" {
+				return fmt.Errorf("\"structured_metadata\" attribute names can't be empty")
+			}
+		}
+	}
 	return nil
 }
@@ -72,6 +72,14 @@ func TestLoadConfigNewExporter(t *testing.T) {
 					"instance": true,
 					"level":    false,
 				},
+				Tenant: &Tenant{
+					Source: "attribute",
+					Value:  "tenant.id",
+				},
+				StructuredMetadata: StructuredMetadata{
+					ResourceAttributes: []string{"k8s.pod.name"},
+					Attributes:         []string{"trace_id"},
+				},
 			},
 		},
 	}
@@ -107,6 +115,36 @@ func TestConfigValidate(t *testing.T) {
 			cfg:  &Config{},
 			err:  fmt.Errorf("\"endpoint\" must be a valid URL"),
 		},
+		{
+			desc: "Tenant source is invalid",
+			cfg: &Config{
+				ClientConfig: confighttp.ClientConfig{
+					Endpoint: "https://loki.example.com",
+				},
+				Tenant: &Tenant{Source: "header", Value: "X-Tenant"},
+			},
+			err: fmt.Errorf("\"tenant.source\" must be one of"),
+		},
+		{
+			desc: "Tenant value is missing",
+			cfg: &Config{
+				ClientConfig: confighttp.ClientConfig{
+					Endpoint: "https://loki.example.com",
+				},
+				Tenant: &Tenant{Source: "attribute"},
+			},
+			err: fmt.Errorf("\"tenant.value\" must be set"),
+		},
+		{
+			desc: "Structured metadata attribute is empty",
+			cfg: &Config{
+				ClientConfig: confighttp.ClientConfig{
+					Endpoint: "https://loki.example.com",
+				},
+				StructuredMetadata: StructuredMetadata{Attributes: []string{""}},
+			},
+			err: fmt.Errorf("\"structured_metadata\" attribute names can't be empty"),
+		},
 		{
 			desc: "Config is valid",
 			cfg: &Config{
@@ -22,6 +22,7 @@ import (
 	"go.uber.org/zap"
 
 	"github.com/open-telemetry/opentelemetry-collector-contrib/exporter/lokiexporter/internal/metadata"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/exporter/lokiexporter/internal/tenant"
 	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/translator/loki"
 )
 
@@ -31,10 +32,12 @@ const (
 )
 
 type lokiExporter struct {
-	config   *Config
-	settings component.TelemetrySettings
-	client   *http.Client
-	wg       sync.WaitGroup
+	config       *Config
+	settings     component.TelemetrySettings
+	client       *http.Client
+	wg           sync.WaitGroup
+	tenantSource tenant.Source
+	options      []loki.Option
 
 	telemetryBuilder *metadata.TelemetryBuilder
 }
@@ -48,18 +51,61 @@ func newExporter(config *Config, settings component.TelemetrySettings) (*lokiExp
 		return nil, err
 	}
 
+	var options []loki.Option
+	if sm := config.StructuredMetadata; len(sm.ResourceAttributes) > 0 || len(sm.Attributes) > 0 {
+		options = append(options, loki.WithStructuredMetadata(sm.ResourceAttributes, sm.Attributes))
+	}
+
 	return &lokiExporter{
 		config:           config,
 		settings:         settings,
+		tenantSource:     newTenantSource(config.Tenant),
+		options:          options,
 		telemetryBuilder: builder,
 	}, nil
 }
 
+func newTenantSource(cfg *Tenant) tenant.Source {
+	if cfg == nil {
+		return nil
+	}
+	switch cfg.Source {
+	case tenantSourceStatic:
+		return &tenant.StaticTenantSource{Value: cfg.Value}
+	case tenantSourceContext:
+		return &tenant.ContextTenantSource{Key: cfg.Value}
+	case tenantSourceAttribute:
+		return &tenant.AttributeTenantSource{Value: cfg.Value}
+	}
+	return nil
+}
+
 func (l *lokiExporter) pushLogData(ctx context.Context, ld plog.Logs) error {
-	requests := loki.LogsToLokiRequests(ld, l.config.DefaultLabelsEnabled)
+	if l.tenantSource == nil {
+		return l.pushTenantLogData(ctx, "", ld)
+	}
+
+	logsPerTenant, err := tenant.Split(ctx, l.tenantSource, ld)
+	if err != nil {
+		return consumererror.NewPermanent(fmt.Errorf("failed to resolve the tenant: %w", err))
+	}
+
+	var errs error
+	for t, logs := range logsPerTenant {
+		errs = multierr.Append(errs, l.pushTenantLogData(ctx, t, logs))
+	}
+	return errs
+}
+
+// pushTenantLogData sends ld to the tenant, unless the `loki.tenant` hint of the records resolves another one.
+func (l *lokiExporter) pushTenantLogData(ctx context.Context, defaultTenant string, ld plog.Logs) error {
+	requests := loki.LogsToLokiRequests(ld, l.config.DefaultLabelsEnabled, l.options...)
 
 	var errs error
 	for tenant, request := range requests {
+		if tenant == "" {
+			tenant = defaultTenant
+		}
 		err := l.sendPushRequest(ctx, tenant, request, ld)
 		if isErrMissingLabels(err) {
 			l.telemetryBuilder.LokiexporterSendFailedDueToMissingLabels.Add(ctx, int64(ld.LogRecordCount()))
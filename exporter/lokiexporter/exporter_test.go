@@ -9,6 +9,7 @@ import (
 	"io"
 	"net/http"
 	"net/http/httptest"
+	"sync"
 	"testing"
 	"time"
 
@@ -126,6 +127,83 @@ func TestPushLogData(t *testing.T) {
 	}
 }
 
+func TestPushLogDataWithTenantAndStructuredMetadata(t *testing.T) {
+	var mu sync.Mutex
+	actualPushRequests := map[string]*push.PushRequest{}
+
+	// prepare
+	ts := httptest.NewServer(http.HandlerFunc(func(_ http.ResponseWriter, r *http.Request) {
+		encPayload, err := io.ReadAll(r.Body)
+		require.NoError(t, err)
+
+		decPayload, err := snappy.Decode(nil, encPayload)
+		require.NoError(t, err)
+
+		pushRequest := &push.PushRequest{}
+		require.NoError(t, proto.Unmarshal(decPayload, pushRequest))
+
+		mu.Lock()
+		defer mu.Unlock()
+		actualPushRequests[r.Header.Get("X-Scope-OrgID")] = pushRequest
+	}))
+	defer ts.Close()
+
+	cfg := &Config{
+		ClientConfig: confighttp.ClientConfig{
+			Endpoint: ts.URL,
+		},
+		Tenant: &Tenant{
+			Source: "attribute",
+			Value:  "tenant.id",
+		},
+		StructuredMetadata: StructuredMetadata{
+			ResourceAttributes: []string{"k8s.pod.name"},
+			Attributes:         []string{"trace.id"},
+		},
+	}
+
+	f := NewFactory()
+	exp, err := f.CreateLogsExporter(context.Background(), exportertest.NewNopCreateSettings(), cfg)
+	require.NoError(t, err)
+
+	err = exp.Start(context.Background(), componenttest.NewNopHost())
+	require.NoError(t, err)
+
+	ld := plog.NewLogs()
+	for _, tenant := range []string{"acme", "globex"} {
+		rl := ld.ResourceLogs().AppendEmpty()
+		rl.Resource().Attributes().PutStr("tenant.id", tenant)
+		rl.Resource().Attributes().PutStr("k8s.pod.name", tenant+"-pod")
+		lr := rl.ScopeLogs().AppendEmpty().LogRecords().AppendEmpty()
+		lr.Body().SetStr("hello")
+		lr.Attributes().PutStr("trace.id", "0102")
+	}
+
+	// test
+	err = exp.ConsumeLogs(context.Background(), ld)
+	require.NoError(t, err)
+
+	// verify
+	require.Len(t, actualPushRequests, 2)
+	for _, tenant := range []string{"acme", "globex"} {
+		pushRequest, ok := actualPushRequests[tenant]
+		require.True(t, ok)
+		require.Len(t, pushRequest.Streams, 1)
+		require.Len(t, pushRequest.Streams[0].Entries, 1)
+
+		entry := pushRequest.Streams[0].Entries[0]
+		assert.Equal(t, `{"body":"hello","resources":{"tenant.id":"`+tenant+`"}}`, entry.Line)
+		assert.Equal(t, []push.LabelAdapter{
+			{Name: "k8s_pod_name", Value: tenant + "-pod"},
+			{Name: "trace_id", Value: "0102"},
+		}, []push.LabelAdapter(entry.StructuredMetadata))
+	}
+
+	// cleanup
+	err = exp.Shutdown(context.Background())
+	assert.NoError(t, err)
+}
+
 func TestLogsToLokiRequestWithGroupingByTenant(t *testing.T) {
 	tests := []struct {
 		desc     string
@@ -0,0 +1,32 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package tenant // import "github.com/open-telemetry/opentelemetry-collector-contrib/exporter/lokiexporter/internal/tenant"
+
+import (
+	"context"
+
+	"go.opentelemetry.io/collector/pdata/plog"
+)
+
+// Split groups the resource logs of logs by the tenant the source resolves for each of them.
+func Split(ctx context.Context, source Source, logs plog.Logs) (LogsPerTenant, error) {
+	out := LogsPerTenant{}
+	rls := logs.ResourceLogs()
+	for i := 0; i < rls.Len(); i++ {
+		single := plog.NewLogs()
+		rls.At(i).CopyTo(single.ResourceLogs().AppendEmpty())
+
+		tenant, err := source.GetTenant(ctx, single)
+		if err != nil {
+			return nil, err
+		}
+
+		if group, ok := out[tenant]; ok {
+			single.ResourceLogs().MoveAndAppendTo(group.ResourceLogs())
+			continue
+		}
+		out[tenant] = single
+	}
+	return out, nil
+}
@@ -0,0 +1,57 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package tenant // import "github.com/open-telemetry/opentelemetry-collector-contrib/exporter/lokiexporter/internal/tenant"
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/collector/client"
+	"go.opentelemetry.io/collector/pdata/plog"
+)
+
+func TestSplitByAttribute(t *testing.T) {
+	// prepare
+	ts := &AttributeTenantSource{Value: "tenant.id"}
+
+	logs := plog.NewLogs()
+	for _, tenant := range []string{"acme", "globex", "acme", ""} {
+		rl := logs.ResourceLogs().AppendEmpty()
+		if tenant != "" {
+			rl.Resource().Attributes().PutStr("tenant.id", tenant)
+		}
+		rl.ScopeLogs().AppendEmpty().LogRecords().AppendEmpty()
+	}
+
+	// test
+	perTenant, err := Split(context.Background(), ts, logs)
+
+	// verify
+	require.NoError(t, err)
+	require.Len(t, perTenant, 3)
+	assert.Equal(t, 2, perTenant["acme"].ResourceLogs().Len())
+	assert.Equal(t, 1, perTenant["globex"].ResourceLogs().Len())
+	assert.Equal(t, 1, perTenant[""].ResourceLogs().Len())
+	assert.Equal(t, 4, logs.ResourceLogs().Len())
+}
+
+func TestSplitError(t *testing.T) {
+	// prepare
+	ts := &ContextTenantSource{Key: "X-Scope-OrgID"}
+	cl := client.FromContext(context.Background())
+	cl.Metadata = client.NewMetadata(map[string][]string{"X-Scope-OrgID": {"acme", "globex"}})
+	ctx := client.NewContext(context.Background(), cl)
+
+	logs := plog.NewLogs()
+	logs.ResourceLogs().AppendEmpty()
+
+	// test
+	perTenant, err := Split(ctx, ts, logs)
+
+	// verify
+	assert.Error(t, err)
+	assert.Nil(t, perTenant)
+}
@@ -0,0 +1,60 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package natsexporter // import "github.com/open-telemetry/opentelemetry-collector-contrib/exporter/natsexporter"
+
+import (
+	"errors"
+	"fmt"
+
+	"go.opentelemetry.io/collector/component"
+	"go.opentelemetry.io/collector/config/configretry"
+	"go.opentelemetry.io/collector/exporter/exporterhelper"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/internal/nats"
+)
+
+const (
+	encodingProto = "otlp_proto"
+	encodingJSON  = "otlp_json"
+)
+
+// Config defines configuration for the NATS exporter.
+type Config struct {
+	exporterhelper.TimeoutSettings `mapstructure:",squash"` // squash ensures fields are correctly decoded in embedded struct.
+	exporterhelper.QueueSettings   `mapstructure:"sending_queue"`
+	configretry.BackOffConfig      `mapstructure:"retry_on_failure"`
+
+	nats.ClientConfig `mapstructure:",squash"`
+
+	// Encoding of the published messages, otlp_proto (default) or otlp_json.
+	Encoding string `mapstructure:"encoding"`
+
+	// Traces is the subject the spans are published to (default otlp.spans).
+	Traces SubjectConfig `mapstructure:"traces"`
+	// Metrics is the subject the metrics are published to (default otlp.metrics).
+	Metrics SubjectConfig `mapstructure:"metrics"`
+	// Logs is the subject the logs are published to (default otlp.logs).
+	Logs SubjectConfig `mapstructure:"logs"`
+}
+
+// SubjectConfig defines the subject a signal is published to.
+type SubjectConfig struct {
+	// Subject is captured by a JetStream stream, which stores the published messages.
+	Subject string `mapstructure:"subject"`
+}
+
+var _ component.Config = (*Config)(nil)
+
+// Validate checks if the exporter configuration is valid.
+func (cfg *Config) Validate() error {
+	switch cfg.Encoding {
+	case encodingProto, encodingJSON:
+	default:
+		return fmt.Errorf("unsupported encoding %q", cfg.Encoding)
+	}
+	if cfg.Traces.Subject == "" || cfg.Metrics.Subject == "" || cfg.Logs.Subject == "" {
+		return errors.New("the subjects of the traces, the metrics and the logs must be specified")
+	}
+	return nil
+}
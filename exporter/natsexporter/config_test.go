@@ -0,0 +1,99 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package natsexporter
+
+import (
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/collector/component"
+	"go.opentelemetry.io/collector/config/configretry"
+	"go.opentelemetry.io/collector/config/configtls"
+	"go.opentelemetry.io/collector/confmap/confmaptest"
+	"go.opentelemetry.io/collector/exporter/exporterhelper"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/exporter/natsexporter/internal/metadata"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/internal/nats"
+)
+
+func TestLoadConfig(t *testing.T) {
+	t.Parallel()
+
+	cm, err := confmaptest.LoadConf(filepath.Join("testdata", "config.yaml"))
+	require.NoError(t, err)
+
+	retry := configretry.NewDefaultBackOffConfig()
+	retry.InitialInterval = 10 * time.Second
+	retry.MaxInterval = time.Minute
+	retry.MaxElapsedTime = 10 * time.Minute
+
+	tests := []struct {
+		id           component.ID
+		expected     component.Config
+		errorMessage string
+	}{
+		{
+			id:       component.NewIDWithName(metadata.Type, ""),
+			expected: createDefaultConfig(),
+		},
+		{
+			id: component.NewIDWithName(metadata.Type, "all_settings"),
+			expected: &Config{
+				TimeoutSettings: exporterhelper.TimeoutSettings{
+					Timeout: 10 * time.Second,
+				},
+				BackOffConfig: retry,
+				QueueSettings: exporterhelper.QueueSettings{
+					Enabled:      true,
+					NumConsumers: 2,
+					QueueSize:    10,
+				},
+				ClientConfig: nats.ClientConfig{
+					Endpoint: "nats://nats-1:4222,nats://nats-2:4222",
+					Auth:     nats.Authentication{Token: "secret"},
+					TLS: configtls.ClientConfig{
+						Insecure: true,
+					},
+				},
+				Encoding: encodingJSON,
+				Traces:   SubjectConfig{Subject: "telemetry.spans"},
+				Metrics:  SubjectConfig{Subject: "telemetry.metrics"},
+				Logs:     SubjectConfig{Subject: "telemetry.logs"},
+			},
+		},
+		{
+			id:           component.NewIDWithName(metadata.Type, "bad_encoding"),
+			errorMessage: `unsupported encoding "avro"`,
+		},
+		{
+			id:           component.NewIDWithName(metadata.Type, "several_auth"),
+			errorMessage: "only one of auth::username, auth::token and auth::credentials_file can be specified",
+		},
+		{
+			id:           component.NewIDWithName(metadata.Type, "no_subject"),
+			errorMessage: "the subjects of the traces, the metrics and the logs must be specified",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.id.String(), func(t *testing.T) {
+			factory := NewFactory()
+			cfg := factory.CreateDefaultConfig()
+
+			sub, err := cm.Sub(tt.id.String())
+			require.NoError(t, err)
+			require.NoError(t, sub.Unmarshal(cfg))
+
+			if tt.errorMessage != "" {
+				assert.EqualError(t, component.ValidateConfig(cfg), tt.errorMessage)
+				return
+			}
+			assert.NoError(t, component.ValidateConfig(cfg))
+			assert.Equal(t, tt.expected, cfg)
+		})
+	}
+}
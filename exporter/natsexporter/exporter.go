@@ -0,0 +1,125 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package natsexporter // import "github.com/open-telemetry/opentelemetry-collector-contrib/exporter/natsexporter"
+
+import (
+	"context"
+	"fmt"
+
+	"go.opentelemetry.io/collector/component"
+	"go.opentelemetry.io/collector/consumer/consumererror"
+	"go.opentelemetry.io/collector/exporter"
+	"go.opentelemetry.io/collector/pdata/plog"
+	"go.opentelemetry.io/collector/pdata/pmetric"
+	"go.opentelemetry.io/collector/pdata/ptrace"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/internal/nats"
+)
+
+// publisher publishes the messages to JetStream, for the NATS client to be replaced in the tests.
+type publisher interface {
+	// publish publishes the message, and waits for its acknowledgement by the stream capturing the subject.
+	publish(ctx context.Context, subject string, payload []byte) error
+	close() error
+}
+
+type jetStreamPublisher struct {
+	client *nats.Client
+}
+
+func (p *jetStreamPublisher) publish(ctx context.Context, subject string, payload []byte) error {
+	_, err := p.client.JetStream.Publish(ctx, subject, payload)
+	return err
+}
+
+func (p *jetStreamPublisher) close() error {
+	return p.client.Close()
+}
+
+// natsExporter publishes the telemetry data of one signal to a JetStream subject.
+type natsExporter struct {
+	cfg     *Config
+	subject string
+	name    string
+
+	connect   func(ctx context.Context) (publisher, error)
+	publisher publisher
+}
+
+func newExporter(cfg *Config, subject string, set exporter.CreateSettings) *natsExporter {
+	e := &natsExporter{
+		cfg:     cfg,
+		subject: subject,
+		name:    set.ID.String(),
+	}
+	e.connect = e.connectJetStream
+	return e
+}
+
+func (e *natsExporter) connectJetStream(ctx context.Context) (publisher, error) {
+	client, err := nats.Connect(ctx, e.cfg.ClientConfig, e.name)
+	if err != nil {
+		return nil, err
+	}
+	return &jetStreamPublisher{client: client}, nil
+}
+
+func (e *natsExporter) start(ctx context.Context, _ component.Host) error {
+	p, err := e.connect(ctx)
+	if err != nil {
+		return err
+	}
+	e.publisher = p
+	return nil
+}
+
+func (e *natsExporter) shutdown(context.Context) error {
+	if e.publisher == nil {
+		return nil
+	}
+	return e.publisher.close()
+}
+
+func (e *natsExporter) pushTraces(ctx context.Context, td ptrace.Traces) error {
+	var marshaler ptrace.Marshaler = &ptrace.ProtoMarshaler{}
+	if e.cfg.Encoding == encodingJSON {
+		marshaler = &ptrace.JSONMarshaler{}
+	}
+	buf, err := marshaler.MarshalTraces(td)
+	if err != nil {
+		return consumererror.NewPermanent(err)
+	}
+	return e.publish(ctx, buf)
+}
+
+func (e *natsExporter) pushMetrics(ctx context.Context, md pmetric.Metrics) error {
+	var marshaler pmetric.Marshaler = &pmetric.ProtoMarshaler{}
+	if e.cfg.Encoding == encodingJSON {
+		marshaler = &pmetric.JSONMarshaler{}
+	}
+	buf, err := marshaler.MarshalMetrics(md)
+	if err != nil {
+		return consumererror.NewPermanent(err)
+	}
+	return e.publish(ctx, buf)
+}
+
+func (e *natsExporter) pushLogs(ctx context.Context, ld plog.Logs) error {
+	var marshaler plog.Marshaler = &plog.ProtoMarshaler{}
+	if e.cfg.Encoding == encodingJSON {
+		marshaler = &plog.JSONMarshaler{}
+	}
+	buf, err := marshaler.MarshalLogs(ld)
+	if err != nil {
+		return consumererror.NewPermanent(err)
+	}
+	return e.publish(ctx, buf)
+}
+
+func (e *natsExporter) publish(ctx context.Context, payload []byte) error {
+	if err := e.publisher.publish(ctx, e.subject, payload); err != nil {
+		return fmt.Errorf("failed to publish to the subject %q: %w", e.subject, err)
+	}
+	return nil
+}
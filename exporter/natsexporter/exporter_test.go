@@ -0,0 +1,114 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package natsexporter
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/collector/component/componenttest"
+	"go.opentelemetry.io/collector/exporter/exportertest"
+	"go.opentelemetry.io/collector/pdata/plog"
+	"go.opentelemetry.io/collector/pdata/pmetric"
+	"go.opentelemetry.io/collector/pdata/ptrace"
+)
+
+type message struct {
+	subject string
+	payload []byte
+}
+
+type fakePublisher struct {
+	messages []message
+	err      error
+	closed   bool
+}
+
+func (p *fakePublisher) publish(_ context.Context, subject string, payload []byte) error {
+	if p.err != nil {
+		return p.err
+	}
+	p.messages = append(p.messages, message{subject: subject, payload: payload})
+	return nil
+}
+
+func (p *fakePublisher) close() error {
+	p.closed = true
+	return nil
+}
+
+func newTestExporter(t *testing.T, cfg *Config, subject string) (*natsExporter, *fakePublisher) {
+	p := &fakePublisher{}
+	exp := newExporter(cfg, subject, exportertest.NewNopCreateSettings())
+	exp.connect = func(context.Context) (publisher, error) {
+		return p, nil
+	}
+	require.NoError(t, exp.start(context.Background(), componenttest.NewNopHost()))
+	t.Cleanup(func() {
+		assert.NoError(t, exp.shutdown(context.Background()))
+		assert.True(t, p.closed)
+	})
+	return exp, p
+}
+
+func TestStartError(t *testing.T) {
+	cfg := createDefaultConfig().(*Config)
+	cfg.TLS.Insecure = false
+	cfg.TLS.CAFile = "/nonexistent"
+	exp := newExporter(cfg, cfg.Traces.Subject, exportertest.NewNopCreateSettings())
+	assert.ErrorContains(t, exp.start(context.Background(), componenttest.NewNopHost()), "failed to load TLS config")
+	assert.NoError(t, exp.shutdown(context.Background()))
+}
+
+func TestPushTraces(t *testing.T) {
+	cfg := createDefaultConfig().(*Config)
+	exp, p := newTestExporter(t, cfg, cfg.Traces.Subject)
+
+	td := ptrace.NewTraces()
+	td.ResourceSpans().AppendEmpty().ScopeSpans().AppendEmpty().Spans().AppendEmpty().SetName("span")
+	require.NoError(t, exp.pushTraces(context.Background(), td))
+
+	require.Len(t, p.messages, 1)
+	assert.Equal(t, "otlp.spans", p.messages[0].subject)
+	got, err := (&ptrace.ProtoUnmarshaler{}).UnmarshalTraces(p.messages[0].payload)
+	require.NoError(t, err)
+	assert.Equal(t, td, got)
+}
+
+func TestPushMetrics(t *testing.T) {
+	cfg := createDefaultConfig().(*Config)
+	cfg.Encoding = encodingJSON
+	exp, p := newTestExporter(t, cfg, cfg.Metrics.Subject)
+
+	md := pmetric.NewMetrics()
+	md.ResourceMetrics().AppendEmpty().ScopeMetrics().AppendEmpty().Metrics().AppendEmpty().SetName("metric")
+	require.NoError(t, exp.pushMetrics(context.Background(), md))
+
+	require.Len(t, p.messages, 1)
+	assert.Equal(t, "otlp.metrics", p.messages[0].subject)
+	got, err := (&pmetric.JSONUnmarshaler{}).UnmarshalMetrics(p.messages[0].payload)
+	require.NoError(t, err)
+	assert.Equal(t, md, got)
+}
+
+func TestPushLogs(t *testing.T) {
+	cfg := createDefaultConfig().(*Config)
+	exp, p := newTestExporter(t, cfg, cfg.Logs.Subject)
+
+	ld := plog.NewLogs()
+	ld.ResourceLogs().AppendEmpty().ScopeLogs().AppendEmpty().LogRecords().AppendEmpty().Body().SetStr("log")
+	require.NoError(t, exp.pushLogs(context.Background(), ld))
+
+	require.Len(t, p.messages, 1)
+	assert.Equal(t, "otlp.logs", p.messages[0].subject)
+	got, err := (&plog.ProtoUnmarshaler{}).UnmarshalLogs(p.messages[0].payload)
+	require.NoError(t, err)
+	assert.Equal(t, ld, got)
+
+	p.err = errors.New("nats: no response from stream")
+	assert.EqualError(t, exp.pushLogs(context.Background(), ld), `failed to publish to the subject "otlp.logs": nats: no response from stream`)
+}
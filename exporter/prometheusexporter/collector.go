@@ -6,6 +6,7 @@ package prometheusexporter // import "github.com/open-telemetry/opentelemetry-co
 import (
 	"encoding/hex"
 	"fmt"
+	"slices"
 	"sort"
 
 	"github.com/prometheus/client_golang/prometheus"
@@ -30,6 +31,7 @@ type collector struct {
 	addMetricSuffixes bool
 	namespace         string
 	constLabels       prometheus.Labels
+	targetInfo        TargetInfo
 }
 
 func newCollector(config *Config, logger *zap.Logger) *collector {
@@ -40,6 +42,7 @@ func newCollector(config *Config, logger *zap.Logger) *collector {
 		sendTimestamps:    config.SendTimestamps,
 		constLabels:       config.ConstLabels,
 		addMetricSuffixes: config.AddMetricSuffixes,
+		targetInfo:        config.TargetInfo,
 	}
 }
 
@@ -304,7 +307,7 @@ func (c *collector) createTargetInfoMetrics(resourceAttrs []pcommon.Map) ([]prom
 				// Remove resource attributes used for job + instance
 				return true
 			default:
-				return false
+				return slices.Contains(c.targetInfo.ExcludeResourceAttributes, k)
 			}
 		})
 
@@ -364,13 +367,15 @@ func (c *collector) Collect(ch chan<- prometheus.Metric) {
 
 	inMetrics, resourceAttrs := c.accumulator.Collect()
 
-	targetMetrics, err := c.createTargetInfoMetrics(resourceAttrs)
-	if err != nil {
-		c.logger.Error(fmt.Sprintf("failed to convert metric %s: %s", prometheustranslator.TargetInfoMetricName, err.Error()))
-	}
-	for _, m := range targetMetrics {
-		ch <- m
-		c.logger.Debug(fmt.Sprintf("metric served: %s", m.Desc().String()))
+	if c.targetInfo.Enabled {
+		targetMetrics, err := c.createTargetInfoMetrics(resourceAttrs)
+		if err != nil {
+			c.logger.Error(fmt.Sprintf("failed to convert metric %s: %s", prometheustranslator.TargetInfoMetricName, err.Error()))
+		}
+		for _, m := range targetMetrics {
+			ch <- m
+			c.logger.Debug(fmt.Sprintf("metric served: %s", m.Desc().String()))
+		}
 	}
 
 	for i := range inMetrics {
@@ -444,6 +444,7 @@ func TestCollectMetrics(t *testing.T) {
 					},
 					sendTimestamps: sendTimestamp,
 					logger:         zap.NewNop(),
+					targetInfo:     TargetInfo{Enabled: true},
 				}
 
 				ch := make(chan prometheus.Metric, 1)
@@ -706,3 +707,74 @@ func TestAccumulateSummary(t *testing.T) {
 		}
 	}
 }
+
+func TestCollectTargetInfo(t *testing.T) {
+	rAttrs := pcommon.NewMap()
+	rAttrs.PutStr(conventions.AttributeServiceInstanceID, "localhost:9090")
+	rAttrs.PutStr(conventions.AttributeServiceName, "testapp")
+	rAttrs.PutStr(conventions.AttributeHostName, "host-1")
+	rAttrs.PutStr(conventions.AttributeProcessCommandLine, "testapp --debug")
+
+	metric := pmetric.NewMetric()
+	metric.SetName("test_metric")
+	metric.SetEmptyGauge().DataPoints().AppendEmpty().SetIntValue(42)
+
+	tests := []struct {
+		name       string
+		targetInfo TargetInfo
+		labels     map[string]string
+	}{
+		{
+			name:       "enabled",
+			targetInfo: TargetInfo{Enabled: true},
+			labels:     map[string]string{"job": "testapp", "instance": "localhost:9090", "host_name": "host-1", "process_command_line": "testapp --debug"},
+		},
+		{
+			name:       "excluded resource attributes",
+			targetInfo: TargetInfo{Enabled: true, ExcludeResourceAttributes: []string{conventions.AttributeProcessCommandLine}},
+			labels:     map[string]string{"job": "testapp", "instance": "localhost:9090", "host_name": "host-1"},
+		},
+		{
+			name:       "disabled",
+			targetInfo: TargetInfo{Enabled: false},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := collector{
+				accumulator: &mockAccumulator{
+					[]pmetric.Metric{metric},
+					rAttrs,
+				},
+				logger:     zap.NewNop(),
+				targetInfo: tt.targetInfo,
+			}
+
+			ch := make(chan prometheus.Metric, 2)
+			go func() {
+				c.Collect(ch)
+				close(ch)
+			}()
+
+			var targetInfo *io_prometheus_client.Metric
+			for m := range ch {
+				if !strings.Contains(m.Desc().String(), `fqName: "target_info"`) {
+					continue
+				}
+				targetInfo = &io_prometheus_client.Metric{}
+				require.NoError(t, m.Write(targetInfo))
+			}
+
+			if tt.labels == nil {
+				require.Nil(t, targetInfo)
+				return
+			}
+			require.NotNil(t, targetInfo)
+			labels := map[string]string{}
+			for _, l := range targetInfo.Label {
+				labels[l.GetName()] = l.GetValue()
+			}
+			require.Equal(t, tt.labels, labels)
+		})
+	}
+}
@@ -4,6 +4,7 @@
 package prometheusexporter // import "github.com/open-telemetry/opentelemetry-collector-contrib/exporter/prometheusexporter"
 
 import (
+	"errors"
 	"time"
 
 	"github.com/prometheus/client_golang/prometheus"
@@ -37,11 +38,38 @@ type Config struct {
 
 	// AddMetricSuffixes controls whether suffixes are added to metric names. Defaults to true.
 	AddMetricSuffixes bool `mapstructure:"add_metric_suffixes"`
+
+	// AddScopeLabels adds the otel_scope_name and otel_scope_version labels, set to the name and version
+	// of the instrumentation scope, to every metric. Defaults to false.
+	AddScopeLabels bool `mapstructure:"add_scope_labels"`
+
+	// PromoteResourceAttributes lists the resource attributes converted to labels of every metric.
+	PromoteResourceAttributes []string `mapstructure:"promote_resource_attributes"`
+
+	// TargetInfo customizes the target_info metric.
+	TargetInfo TargetInfo `mapstructure:"target_info"`
+}
+
+// TargetInfo customizes the target_info metric generated for each resource.
+type TargetInfo struct {
+	// Enabled if false the target_info metric is not generated. Defaults to true.
+	Enabled bool `mapstructure:"enabled"`
+
+	// ExcludeResourceAttributes lists the resource attributes not converted to labels of the target_info metric.
+	ExcludeResourceAttributes []string `mapstructure:"exclude_resource_attributes"`
 }
 
 var _ component.Config = (*Config)(nil)
 
 // Validate checks if the exporter configuration is valid
 func (cfg *Config) Validate() error {
+	if len(cfg.PromoteResourceAttributes) > 0 && cfg.ResourceToTelemetrySettings.Enabled {
+		return errors.New("promote_resource_attributes cannot be used when resource_to_telemetry_conversion is enabled")
+	}
+	for _, attr := range cfg.PromoteResourceAttributes {
+		if attr == "" {
+			return errors.New("promote_resource_attributes cannot contain empty attribute names")
+		}
+	}
 	return nil
 }
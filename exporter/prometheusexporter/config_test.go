@@ -50,9 +50,23 @@ func TestLoadConfig(t *testing.T) {
 					"label1":        "value1",
 					"another label": "spaced value",
 				},
-				SendTimestamps:    true,
-				MetricExpiration:  60 * time.Minute,
-				AddMetricSuffixes: false,
+				SendTimestamps:            true,
+				MetricExpiration:          60 * time.Minute,
+				AddMetricSuffixes:         false,
+				AddScopeLabels:            true,
+				PromoteResourceAttributes: []string{"k8s.namespace.name", "k8s.pod.name"},
+				TargetInfo: TargetInfo{
+					Enabled:                   true,
+					ExcludeResourceAttributes: []string{"process.command_line"},
+				},
+			},
+		},
+		{
+			id: component.NewIDWithName(metadata.Type, "3"),
+			expected: &Config{
+				ConstLabels:       map[string]string{},
+				MetricExpiration:  5 * time.Minute,
+				AddMetricSuffixes: true,
 			},
 		},
 	}
@@ -71,3 +85,45 @@ func TestLoadConfig(t *testing.T) {
 		})
 	}
 }
+
+func TestValidateConfig(t *testing.T) {
+	tests := []struct {
+		name   string
+		modify func(*Config)
+		err    string
+	}{
+		{
+			name: "promoted resource attributes",
+			modify: func(cfg *Config) {
+				cfg.PromoteResourceAttributes = []string{"k8s.pod.name"}
+			},
+		},
+		{
+			name: "promoted resource attributes with resource to telemetry conversion",
+			modify: func(cfg *Config) {
+				cfg.PromoteResourceAttributes = []string{"k8s.pod.name"}
+				cfg.ResourceToTelemetrySettings.Enabled = true
+			},
+			err: "promote_resource_attributes cannot be used when resource_to_telemetry_conversion is enabled",
+		},
+		{
+			name: "empty promoted resource attribute",
+			modify: func(cfg *Config) {
+				cfg.PromoteResourceAttributes = []string{""}
+			},
+			err: "promote_resource_attributes cannot contain empty attribute names",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cfg := createDefaultConfig().(*Config)
+			tt.modify(cfg)
+			err := cfg.Validate()
+			if tt.err == "" {
+				assert.NoError(t, err)
+			} else {
+				assert.EqualError(t, err, tt.err)
+			}
+		})
+	}
+}
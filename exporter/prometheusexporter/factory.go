@@ -31,6 +31,9 @@ func createDefaultConfig() component.Config {
 		MetricExpiration:  time.Minute * 5,
 		EnableOpenMetrics: false,
 		AddMetricSuffixes: true,
+		TargetInfo: TargetInfo{
+			Enabled: true,
+		},
 	}
 }
 
@@ -0,0 +1,89 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package prometheusexporter // import "github.com/open-telemetry/opentelemetry-collector-contrib/exporter/prometheusexporter"
+
+import (
+	"go.opentelemetry.io/collector/pdata/pcommon"
+	"go.opentelemetry.io/collector/pdata/pmetric"
+
+	prometheustranslator "github.com/open-telemetry/opentelemetry-collector-contrib/pkg/translator/prometheus"
+)
+
+// labelAttributes adds the attributes converted to labels of every metric, the instrumentation scope name
+// and version and the promoted resource attributes, to the attributes of the data points.
+type labelAttributes struct {
+	addScopeLabels     bool
+	promotedAttributes []string
+}
+
+func (la labelAttributes) enabled() bool {
+	return la.addScopeLabels || len(la.promotedAttributes) > 0
+}
+
+// add adds the attributes to the data points of rm. The existing data point attributes are kept.
+func (la labelAttributes) add(rm pmetric.ResourceMetrics) {
+	promoted := pcommon.NewMap()
+	for _, key := range la.promotedAttributes {
+		if v, ok := rm.Resource().Attributes().Get(key); ok {
+			v.CopyTo(promoted.PutEmpty(key))
+		}
+	}
+
+	sms := rm.ScopeMetrics()
+	for i := 0; i < sms.Len(); i++ {
+		sm := sms.At(i)
+		attrs := pcommon.NewMap()
+		promoted.CopyTo(attrs)
+		if la.addScopeLabels {
+			if name := sm.Scope().Name(); name != "" {
+				attrs.PutStr(prometheustranslator.ScopeNameLabelKey, name)
+			}
+			if version := sm.Scope().Version(); version != "" {
+				attrs.PutStr(prometheustranslator.ScopeVersionLabelKey, version)
+			}
+		}
+		if attrs.Len() == 0 {
+			continue
+		}
+
+		metrics := sm.Metrics()
+		for j := 0; j < metrics.Len(); j++ {
+			addMissingAttributes(metrics.At(j), attrs)
+		}
+	}
+}
+
+func addMissingAttributes(metric pmetric.Metric, attrs pcommon.Map) {
+	switch metric.Type() {
+	case pmetric.MetricTypeGauge:
+		dps := metric.Gauge().DataPoints()
+		for i := 0; i < dps.Len(); i++ {
+			putMissing(attrs, dps.At(i).Attributes())
+		}
+	case pmetric.MetricTypeSum:
+		dps := metric.Sum().DataPoints()
+		for i := 0; i < dps.Len(); i++ {
+			putMissing(attrs, dps.At(i).Attributes())
+		}
+	case pmetric.MetricTypeHistogram:
+		dps := metric.Histogram().DataPoints()
+		for i := 0; i < dps.Len(); i++ {
+			putMissing(attrs, dps.At(i).Attributes())
+		}
+	case pmetric.MetricTypeSummary:
+		dps := metric.Summary().DataPoints()
+		for i := 0; i < dps.Len(); i++ {
+			putMissing(attrs, dps.At(i).Attributes())
+		}
+	}
+}
+
+func putMissing(from, to pcommon.Map) {
+	from.Range(func(k string, v pcommon.Value) bool {
+		if _, ok := to.Get(k); !ok {
+			v.CopyTo(to.PutEmpty(k))
+		}
+		return true
+	})
+}
@@ -0,0 +1,71 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package prometheusexporter
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"go.opentelemetry.io/collector/pdata/pmetric"
+)
+
+func TestLabelAttributes(t *testing.T) {
+	newResourceMetrics := func() pmetric.ResourceMetrics {
+		rm := pmetric.NewResourceMetrics()
+		rm.Resource().Attributes().PutStr("k8s.pod.name", "pod-1")
+		rm.Resource().Attributes().PutStr("k8s.namespace.name", "default")
+		sm := rm.ScopeMetrics().AppendEmpty()
+		sm.Scope().SetName("github.com/example/instrumentation")
+		sm.Scope().SetVersion("v1.2.3")
+		dp := sm.Metrics().AppendEmpty().SetEmptySum().DataPoints().AppendEmpty()
+		dp.Attributes().PutStr("k8s.namespace.name", "from-data-point")
+		dp.Attributes().PutStr("method", "GET")
+		sm = rm.ScopeMetrics().AppendEmpty()
+		sm.Metrics().AppendEmpty().SetEmptyHistogram().DataPoints().AppendEmpty()
+		return rm
+	}
+
+	tests := []struct {
+		name            string
+		labelAttributes labelAttributes
+		sum             map[string]any
+		histogram       map[string]any
+	}{
+		{
+			name:            "disabled",
+			labelAttributes: labelAttributes{},
+			sum:             map[string]any{"k8s.namespace.name": "from-data-point", "method": "GET"},
+			histogram:       map[string]any{},
+		},
+		{
+			name:            "scope labels",
+			labelAttributes: labelAttributes{addScopeLabels: true},
+			sum: map[string]any{
+				"k8s.namespace.name": "from-data-point",
+				"method":             "GET",
+				"otel_scope_name":    "github.com/example/instrumentation",
+				"otel_scope_version": "v1.2.3",
+			},
+			// The scope has no name or version.
+			histogram: map[string]any{},
+		},
+		{
+			name:            "promoted resource attributes",
+			labelAttributes: labelAttributes{promotedAttributes: []string{"k8s.pod.name", "k8s.namespace.name", "missing"}},
+			sum:             map[string]any{"k8s.pod.name": "pod-1", "k8s.namespace.name": "from-data-point", "method": "GET"},
+			histogram:       map[string]any{"k8s.pod.name": "pod-1", "k8s.namespace.name": "default"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			rm := newResourceMetrics()
+			tt.labelAttributes.add(rm)
+
+			sum := rm.ScopeMetrics().At(0).Metrics().At(0).Sum().DataPoints().At(0)
+			assert.Equal(t, tt.sum, sum.Attributes().AsRaw())
+			histogram := rm.ScopeMetrics().At(1).Metrics().At(0).Histogram().DataPoints().At(0)
+			assert.Equal(t, tt.histogram, histogram.Attributes().AsRaw())
+		})
+	}
+}
@@ -17,14 +17,15 @@ import (
 )
 
 type prometheusExporter struct {
-	config       Config
-	name         string
-	endpoint     string
-	shutdownFunc func() error
-	handler      http.Handler
-	collector    *collector
-	registry     *prometheus.Registry
-	settings     component.TelemetrySettings
+	config          Config
+	name            string
+	endpoint        string
+	shutdownFunc    func() error
+	handler         http.Handler
+	collector       *collector
+	registry        *prometheus.Registry
+	settings        component.TelemetrySettings
+	labelAttributes labelAttributes
 }
 
 var errBlankPrometheusAddress = errors.New("expecting a non-blank address to run the Prometheus metrics handler")
@@ -54,6 +55,10 @@ func newPrometheusExporter(config *Config, set exporter.CreateSettings) (*promet
 			},
 		),
 		settings: set.TelemetrySettings,
+		labelAttributes: labelAttributes{
+			addScopeLabels:     config.AddScopeLabels,
+			promotedAttributes: config.PromoteResourceAttributes,
+		},
 	}, nil
 }
 
@@ -82,6 +87,9 @@ func (pe *prometheusExporter) ConsumeMetrics(_ context.Context, md pmetric.Metri
 	n := 0
 	rmetrics := md.ResourceMetrics()
 	for i := 0; i < rmetrics.Len(); i++ {
+		if pe.labelAttributes.enabled() {
+			pe.labelAttributes.add(rmetrics.At(i))
+		}
 		n += pe.collector.processMetrics(rmetrics.At(i))
 	}
 
@@ -52,6 +52,10 @@ type Config struct {
 
 	// SendMetadata controls whether prometheus metadata will be generated and sent
 	SendMetadata bool `mapstructure:"send_metadata"`
+
+	// RemoteWriteVersion is the version of the remote write protocol: "v1", "v2", or "auto" to send
+	// Remote Write 2.0 requests unless the endpoint doesn't support them (default "v1").
+	RemoteWriteVersion string `mapstructure:"remote_write_version"`
 }
 
 type CreatedMetric struct {
@@ -107,6 +111,11 @@ func (cfg *Config) Validate() error {
 			Enabled: false,
 		}
 	}
+	switch cfg.RemoteWriteVersion {
+	case "", remoteWriteV1, remoteWriteV2, remoteWriteAuto:
+	default:
+		return fmt.Errorf("remote_write_version must be %q, %q or %q", remoteWriteV1, remoteWriteV2, remoteWriteAuto)
+	}
 	if cfg.MaxBatchSizeBytes < 0 {
 		return fmt.Errorf("max_batch_byte_size must be greater than 0")
 	}
@@ -78,7 +78,8 @@ func TestLoadConfig(t *testing.T) {
 				TargetInfo: &TargetInfo{
 					Enabled: true,
 				},
-				CreatedMetric: &CreatedMetric{Enabled: true},
+				CreatedMetric:      &CreatedMetric{Enabled: true},
+				RemoteWriteVersion: "auto",
 			},
 		},
 		{
@@ -89,6 +90,10 @@ func TestLoadConfig(t *testing.T) {
 			id:           component.NewIDWithName(metadata.Type, "negative_num_consumers"),
 			errorMessage: "remote write consumer number can't be negative",
 		},
+		{
+			id:           component.NewIDWithName(metadata.Type, "invalid_remote_write_version"),
+			errorMessage: `remote_write_version must be "v1", "v2" or "auto"`,
+		},
 	}
 
 	for _, tt := range tests {
@@ -14,6 +14,7 @@ import (
 	"net/url"
 	"strings"
 	"sync"
+	"sync/atomic"
 
 	"github.com/cenkalti/backoff/v4"
 	"github.com/gogo/protobuf/proto"
@@ -31,6 +32,7 @@ import (
 	"go.uber.org/zap"
 
 	"github.com/open-telemetry/opentelemetry-collector-contrib/exporter/prometheusremotewriteexporter/internal/metadata"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/exporter/prometheusremotewriteexporter/internal/writev2"
 	prometheustranslator "github.com/open-telemetry/opentelemetry-collector-contrib/pkg/translator/prometheus"
 	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/translator/prometheusremotewrite"
 )
@@ -69,6 +71,11 @@ type prwExporter struct {
 	wal               *prweWAL
 	exporterSettings  prometheusremotewrite.Settings
 	telemetry         prwTelemetry
+
+	// remoteWriteVersion is the configured version of the remote write protocol.
+	remoteWriteVersion string
+	// fallbackToV1 is set when the endpoint rejected a Remote Write 2.0 request with the "auto" version.
+	fallbackToV1 atomic.Bool
 }
 
 func newPRWTelemetry(set exporter.CreateSettings) (prwTelemetry, error) {
@@ -123,7 +130,8 @@ func newPRWExporter(cfg *Config, set exporter.CreateSettings) (*prwExporter, err
 			AddMetricSuffixes:   cfg.AddMetricSuffixes,
 			SendMetadata:        cfg.SendMetadata,
 		},
-		telemetry: prwTelemetry,
+		telemetry:          prwTelemetry,
+		remoteWriteVersion: cfg.RemoteWriteVersion,
 	}
 
 	prwe.wal = newWAL(cfg.WAL, prwe.export)
@@ -136,7 +144,8 @@ func (prwe *prwExporter) Start(ctx context.Context, host component.Host) (err er
 	if err != nil {
 		return err
 	}
-	return prwe.turnOnWALIfEnabled(contextWithLogger(ctx, prwe.settings.Logger.Named("prw.wal")))
+	// The WAL outlives the context of Start, it is stopped by Shutdown.
+	return prwe.turnOnWALIfEnabled(contextWithLogger(context.Background(), prwe.settings.Logger.Named("prw.wal")))
 }
 
 func (prwe *prwExporter) shutdownWALIfEnabled() error {
@@ -208,7 +217,16 @@ func (prwe *prwExporter) handleExport(ctx context.Context, tsMap map[string]*pro
 	}
 
 	// Calls the helper function to convert and batch the TsMap to the desired format
-	requests, err := batchTimeSeries(tsMap, prwe.maxBatchSizeBytes, m)
+	var requests []*prompb.WriteRequest
+	var err error
+	if prwe.remoteWriteVersion == remoteWriteV2 || prwe.remoteWriteVersion == remoteWriteAuto {
+		// Remote Write 2.0 sends the metadata along with the series, keep it in the same requests
+		// so it's still available when reading the requests back from the WAL.
+		requests, err = batchTimeSeries(tsMap, prwe.maxBatchSizeBytes, nil)
+		attachMetadata(requests, m, prwe.exporterSettings.Namespace)
+	} else {
+		requests, err = batchTimeSeries(tsMap, prwe.maxBatchSizeBytes, m)
+	}
 	if err != nil {
 		return err
 	}
@@ -268,17 +286,58 @@ func (prwe *prwExporter) export(ctx context.Context, requests []*prompb.WriteReq
 	return errs
 }
 
-func (prwe *prwExporter) execute(ctx context.Context, writeReq *prompb.WriteRequest) error {
-	// Uses proto.Marshal to convert the WriteRequest into bytes array
-	data, errMarshal := proto.Marshal(writeReq)
-	if errMarshal != nil {
-		return consumererror.NewPermanent(errMarshal)
+// errUnsupportedV2 is returned when the endpoint rejects Remote Write 2.0 requests.
+var errUnsupportedV2 = errors.New("remote write endpoint doesn't support Remote Write 2.0")
+
+// recoverableError is a failure to export that may succeed later, e.g. because the endpoint is unavailable.
+// The WAL keeps retrying the requests failing with a recoverableError.
+type recoverableError struct {
+	error
+}
+
+func (e recoverableError) Unwrap() error {
+	return e.error
+}
+
+func isRecoverable(err error) bool {
+	var recoverable recoverableError
+	return errors.As(err, &recoverable)
+}
+
+// useV2 reports whether requests are sent with the Remote Write 2.0 protocol.
+func (prwe *prwExporter) useV2() bool {
+	switch prwe.remoteWriteVersion {
+	case remoteWriteV2:
+		return true
+	case remoteWriteAuto:
+		return !prwe.fallbackToV1.Load()
+	default:
+		return false
+	}
+}
+
+// encode returns the Snappy-compressed request in the protocol version, and its content type and version headers.
+func (prwe *prwExporter) encode(writeReq *prompb.WriteRequest, v2 bool) ([]byte, string, string, error) {
+	var data []byte
+	var err error
+	contentType, version := "application/x-protobuf", "0.1.0"
+	if v2 {
+		contentType, version = writev2.ContentType, "2.0.0"
+		data, err = toWriteV2(writeReq, prwe.exporterSettings.ExportCreatedMetric, prwe.exporterSettings.Namespace).Marshal()
+	} else {
+		// Uses proto.Marshal to convert the WriteRequest into bytes array
+		data, err = proto.Marshal(writeReq)
+	}
+	if err != nil {
+		return nil, "", "", err
 	}
 	buf := make([]byte, len(data), cap(data))
-	compressedData := snappy.Encode(buf, data)
+	return snappy.Encode(buf, data), contentType, version, nil
+}
 
-	// executeFunc can be used for backoff and non backoff scenarios.
-	executeFunc := func() error {
+func (prwe *prwExporter) execute(ctx context.Context, writeReq *prompb.WriteRequest) error {
+	// send can be used for backoff and non backoff scenarios.
+	send := func(v2 bool) error {
 		// check there was no timeout in the component level to avoid retries
 		// to continue to run after a timeout
 		select {
@@ -288,6 +347,11 @@ func (prwe *prwExporter) execute(ctx context.Context, writeReq *prompb.WriteRequ
 			// continue
 		}
 
+		compressedData, contentType, version, err := prwe.encode(writeReq, v2)
+		if err != nil {
+			return backoff.Permanent(consumererror.NewPermanent(err))
+		}
+
 		// Create the HTTP POST request to send to the endpoint
 		req, err := http.NewRequestWithContext(ctx, "POST", prwe.endpointURL.String(), bytes.NewReader(compressedData))
 		if err != nil {
@@ -297,8 +361,8 @@ func (prwe *prwExporter) execute(ctx context.Context, writeReq *prompb.WriteRequ
 		// Add necessary headers specified by:
 		// https://cortexmetrics.io/docs/apis/#remote-api
 		req.Header.Add("Content-Encoding", "snappy")
-		req.Header.Set("Content-Type", "application/x-protobuf")
-		req.Header.Set("X-Prometheus-Remote-Write-Version", "0.1.0")
+		req.Header.Set("Content-Type", contentType)
+		req.Header.Set("X-Prometheus-Remote-Write-Version", version)
 		req.Header.Set("User-Agent", prwe.userAgentHeader)
 
 		resp, err := prwe.client.Do(req)
@@ -327,9 +391,26 @@ func (prwe *prwExporter) execute(ctx context.Context, writeReq *prompb.WriteRequ
 			return rerr
 		}
 
+		// Remote Write 2.0 endpoints reply 415 to the content types they don't support.
+		if v2 && resp.StatusCode == http.StatusUnsupportedMediaType {
+			return backoff.Permanent(fmt.Errorf("%w: %w", errUnsupportedV2, rerr))
+		}
+
 		return backoff.Permanent(consumererror.NewPermanent(rerr))
 	}
 
+	executeFunc := func() error {
+		v2 := prwe.useV2()
+		err := send(v2)
+		if v2 && prwe.remoteWriteVersion == remoteWriteAuto && errors.Is(err, errUnsupportedV2) {
+			if !prwe.fallbackToV1.Swap(true) {
+				prwe.settings.Logger.Info("Remote write endpoint doesn't support Remote Write 2.0, falling back to Remote Write 1.0", zap.String("endpoint", prwe.endpointURL.String()))
+			}
+			err = send(false)
+		}
+		return err
+	}
+
 	var err error
 	if prwe.retrySettings.Enabled {
 		// Use the BackOff instance to retry the func with exponential backoff.
@@ -347,6 +428,9 @@ func (prwe *prwExporter) execute(ctx context.Context, writeReq *prompb.WriteRequ
 	}
 
 	if err != nil {
+		if !consumererror.IsPermanent(err) && !errors.Is(err, errUnsupportedV2) && ctx.Err() == nil {
+			err = recoverableError{err}
+		}
 		return consumererror.NewPermanent(err)
 	}
 
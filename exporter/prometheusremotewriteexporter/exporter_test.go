@@ -676,9 +676,6 @@ func Test_PushMetrics(t *testing.T) {
 			name = "WAL"
 		}
 		t.Run(name, func(t *testing.T) {
-			if useWAL {
-				t.Skip("Flaky test, see https://github.com/open-telemetry/opentelemetry-collector-contrib/issues/9124")
-			}
 			for _, ttt := range tests {
 				tt := ttt
 				if useWAL && tt.skipForWAL {
@@ -903,7 +900,6 @@ func Test_validateAndSanitizeExternalLabels(t *testing.T) {
 // and that we can retrieve those exact requests back from the WAL, when the
 // exporter starts up once again, that it picks up where it left off.
 func TestWALOnExporterRoundTrip(t *testing.T) {
-	t.Skip("skipping test, see https://github.com/open-telemetry/opentelemetry-collector-contrib/issues/10142")
 	if testing.Short() {
 		t.Skip("This test could run for long")
 	}
@@ -931,7 +927,8 @@ func TestWALOnExporterRoundTrip(t *testing.T) {
 		ClientConfig: confighttp.ClientConfig{
 			Endpoint: prweServer.URL,
 		},
-		RemoteWriteQueue: RemoteWriteQueue{NumConsumers: 1},
+		MaxBatchSizeBytes: 3000000,
+		RemoteWriteQueue:  RemoteWriteQueue{NumConsumers: 1},
 		WAL: &WALConfig{
 			Directory:  tempDir,
 			BufferSize: 1,
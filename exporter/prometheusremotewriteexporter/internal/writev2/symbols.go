@@ -0,0 +1,45 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package writev2 // import "github.com/open-telemetry/opentelemetry-collector-contrib/exporter/prometheusremotewriteexporter/internal/writev2"
+
+import "github.com/prometheus/prometheus/prompb"
+
+// SymbolsTable deduplicates the strings of a request into its symbols.
+type SymbolsTable struct {
+	symbols []string
+	refs    map[string]uint32
+}
+
+// NewSymbolsTable returns a table whose first symbol is the empty string, as required by the protocol.
+func NewSymbolsTable() *SymbolsTable {
+	return &SymbolsTable{
+		symbols: []string{""},
+		refs:    map[string]uint32{"": 0},
+	}
+}
+
+// Symbolize returns the reference to s, adding it to the table if needed.
+func (t *SymbolsTable) Symbolize(s string) uint32 {
+	if ref, ok := t.refs[s]; ok {
+		return ref
+	}
+	ref := uint32(len(t.symbols))
+	t.symbols = append(t.symbols, s)
+	t.refs[s] = ref
+	return ref
+}
+
+// SymbolizeLabels returns the pairs of references to the name and value of each label.
+func (t *SymbolsTable) SymbolizeLabels(labels []prompb.Label) []uint32 {
+	refs := make([]uint32, 0, 2*len(labels))
+	for _, l := range labels {
+		refs = append(refs, t.Symbolize(l.Name), t.Symbolize(l.Value))
+	}
+	return refs
+}
+
+// Symbols returns the symbols of the table, to be set in Request.Symbols.
+func (t *SymbolsTable) Symbols() []string {
+	return t.symbols
+}
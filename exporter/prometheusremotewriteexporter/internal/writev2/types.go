@@ -0,0 +1,309 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+// Package writev2 implements the io.prometheus.write.v2.Request message of the Prometheus Remote Write 2.0
+// protocol, see https://prometheus.io/docs/specs/remote_write_spec_2_0/.
+package writev2 // import "github.com/open-telemetry/opentelemetry-collector-contrib/exporter/prometheusremotewriteexporter/internal/writev2"
+
+import (
+	"errors"
+	"fmt"
+	"math"
+
+	"github.com/prometheus/prometheus/prompb"
+	"google.golang.org/protobuf/encoding/protowire"
+)
+
+// ContentType is the content type of Remote Write 2.0 requests.
+const ContentType = "application/x-protobuf;proto=io.prometheus.write.v2.Request"
+
+// MetricType is the type of a metric, with the same values as prompb.MetricMetadata_MetricType.
+type MetricType int32
+
+const (
+	MetricTypeUnspecified    MetricType = 0
+	MetricTypeCounter        MetricType = 1
+	MetricTypeGauge          MetricType = 2
+	MetricTypeHistogram      MetricType = 3
+	MetricTypeGaugeHistogram MetricType = 4
+	MetricTypeSummary        MetricType = 5
+	MetricTypeInfo           MetricType = 6
+	MetricTypeStateset       MetricType = 7
+)
+
+// Request is a Remote Write 2.0 request. Labels, help and units of the time series are references to Symbols.
+type Request struct {
+	Symbols    []string
+	Timeseries []TimeSeries
+}
+
+// TimeSeries is a Remote Write 2.0 time series.
+type TimeSeries struct {
+	// LabelsRefs are pairs of references to the name and value of each label in Request.Symbols.
+	LabelsRefs []uint32
+	Samples    []Sample
+	// Histograms are native histograms, encoded as in Remote Write 1.0.
+	Histograms []prompb.Histogram
+	Exemplars  []Exemplar
+	Metadata   Metadata
+	// CreatedTimestamp is the time in milliseconds the counter, histogram or summary was created or reset, 0 when unknown.
+	CreatedTimestamp int64
+}
+
+// Sample is a float sample.
+type Sample struct {
+	Value     float64
+	Timestamp int64
+}
+
+// Exemplar is an exemplar, LabelsRefs are pairs of references as in TimeSeries.
+type Exemplar struct {
+	LabelsRefs []uint32
+	Value      float64
+	Timestamp  int64
+}
+
+// Metadata is the metadata of a time series.
+type Metadata struct {
+	Type    MetricType
+	HelpRef uint32
+	UnitRef uint32
+}
+
+// Field numbers of the messages.
+const (
+	requestSymbols    protowire.Number = 4
+	requestTimeseries protowire.Number = 5
+
+	seriesLabelsRefs       protowire.Number = 1
+	seriesSamples          protowire.Number = 2
+	seriesHistograms       protowire.Number = 3
+	seriesExemplars        protowire.Number = 4
+	seriesMetadata         protowire.Number = 5
+	seriesCreatedTimestamp protowire.Number = 6
+
+	sampleValue     protowire.Number = 1
+	sampleTimestamp protowire.Number = 2
+
+	exemplarLabelsRefs protowire.Number = 1
+	exemplarValue      protowire.Number = 2
+	exemplarTimestamp  protowire.Number = 3
+
+	metadataType    protowire.Number = 1
+	metadataHelpRef protowire.Number = 3
+	metadataUnitRef protowire.Number = 4
+)
+
+// Marshal encodes the request in the protobuf wire format.
+func (r *Request) Marshal() ([]byte, error) {
+	var b []byte
+	for _, symbol := range r.Symbols {
+		b = protowire.AppendTag(b, requestSymbols, protowire.BytesType)
+		b = protowire.AppendString(b, symbol)
+	}
+	for i := range r.Timeseries {
+		series, err := r.Timeseries[i].marshal()
+		if err != nil {
+			return nil, err
+		}
+		b = protowire.AppendTag(b, requestTimeseries, protowire.BytesType)
+		b = protowire.AppendBytes(b, series)
+	}
+	return b, nil
+}
+
+func (ts *TimeSeries) marshal() ([]byte, error) {
+	var b []byte
+	b = appendPackedRefs(b, seriesLabelsRefs, ts.LabelsRefs)
+	for _, sample := range ts.Samples {
+		var s []byte
+		s = appendDouble(s, sampleValue, sample.Value)
+		s = appendInt64(s, sampleTimestamp, sample.Timestamp)
+		b = protowire.AppendTag(b, seriesSamples, protowire.BytesType)
+		b = protowire.AppendBytes(b, s)
+	}
+	for i := range ts.Histograms {
+		h, err := ts.Histograms[i].Marshal()
+		if err != nil {
+			return nil, err
+		}
+		b = protowire.AppendTag(b, seriesHistograms, protowire.BytesType)
+		b = protowire.AppendBytes(b, h)
+	}
+	for _, exemplar := range ts.Exemplars {
+		var e []byte
+		e = appendPackedRefs(e, exemplarLabelsRefs, exemplar.LabelsRefs)
+		e = appendDouble(e, exemplarValue, exemplar.Value)
+		e = appendInt64(e, exemplarTimestamp, exemplar.Timestamp)
+		b = protowire.AppendTag(b, seriesExemplars, protowire.BytesType)
+		b = protowire.AppendBytes(b, e)
+	}
+	if ts.Metadata != (Metadata{}) {
+		var m []byte
+		m = appendInt64(m, metadataType, int64(ts.Metadata.Type))
+		m = appendInt64(m, metadataHelpRef, int64(ts.Metadata.HelpRef))
+		m = appendInt64(m, metadataUnitRef, int64(ts.Metadata.UnitRef))
+		b = protowire.AppendTag(b, seriesMetadata, protowire.BytesType)
+		b = protowire.AppendBytes(b, m)
+	}
+	b = appendInt64(b, seriesCreatedTimestamp, ts.CreatedTimestamp)
+	return b, nil
+}
+
+func appendPackedRefs(b []byte, num protowire.Number, refs []uint32) []byte {
+	if len(refs) == 0 {
+		return b
+	}
+	var packed []byte
+	for _, ref := range refs {
+		packed = protowire.AppendVarint(packed, uint64(ref))
+	}
+	b = protowire.AppendTag(b, num, protowire.BytesType)
+	return protowire.AppendBytes(b, packed)
+}
+
+// appendDouble appends a double field, omitted when 0 as proto3 does.
+func appendDouble(b []byte, num protowire.Number, v float64) []byte {
+	if v == 0 && !math.Signbit(v) {
+		return b
+	}
+	b = protowire.AppendTag(b, num, protowire.Fixed64Type)
+	return protowire.AppendFixed64(b, math.Float64bits(v))
+}
+
+// appendInt64 appends a varint field, omitted when 0 as proto3 does.
+func appendInt64(b []byte, num protowire.Number, v int64) []byte {
+	if v == 0 {
+		return b
+	}
+	b = protowire.AppendTag(b, num, protowire.VarintType)
+	return protowire.AppendVarint(b, uint64(v))
+}
+
+var errInvalidMessage = errors.New("invalid io.prometheus.write.v2.Request message")
+
+// Unmarshal decodes a request encoded in the protobuf wire format.
+func (r *Request) Unmarshal(b []byte) error {
+	*r = Request{}
+	return forEachField(b, func(num protowire.Number, typ protowire.Type, _ uint64, field []byte) error {
+		switch {
+		case num == requestSymbols && typ == protowire.BytesType:
+			r.Symbols = append(r.Symbols, string(field))
+		case num == requestTimeseries && typ == protowire.BytesType:
+			var ts TimeSeries
+			if err := ts.unmarshal(field); err != nil {
+				return err
+			}
+			r.Timeseries = append(r.Timeseries, ts)
+		}
+		return nil
+	})
+}
+
+func (ts *TimeSeries) unmarshal(b []byte) error {
+	return forEachField(b, func(num protowire.Number, typ protowire.Type, v uint64, field []byte) error {
+		var err error
+		switch num {
+		case seriesLabelsRefs:
+			ts.LabelsRefs, err = appendRefs(ts.LabelsRefs, typ, v, field)
+		case seriesSamples:
+			var sample Sample
+			err = forEachField(field, func(num protowire.Number, _ protowire.Type, v uint64, _ []byte) error {
+				switch num {
+				case sampleValue:
+					sample.Value = math.Float64frombits(v)
+				case sampleTimestamp:
+					sample.Timestamp = int64(v)
+				}
+				return nil
+			})
+			ts.Samples = append(ts.Samples, sample)
+		case seriesHistograms:
+			var h prompb.Histogram
+			err = h.Unmarshal(field)
+			ts.Histograms = append(ts.Histograms, h)
+		case seriesExemplars:
+			var exemplar Exemplar
+			err = forEachField(field, func(num protowire.Number, typ protowire.Type, v uint64, field []byte) error {
+				var err error
+				switch num {
+				case exemplarLabelsRefs:
+					exemplar.LabelsRefs, err = appendRefs(exemplar.LabelsRefs, typ, v, field)
+				case exemplarValue:
+					exemplar.Value = math.Float64frombits(v)
+				case exemplarTimestamp:
+					exemplar.Timestamp = int64(v)
+				}
+				return err
+			})
+			ts.Exemplars = append(ts.Exemplars, exemplar)
+		case seriesMetadata:
+			err = forEachField(field, func(num protowire.Number, _ protowire.Type, v uint64, _ []byte) error {
+				switch num {
+				case metadataType:
+					ts.Metadata.Type = MetricType(v)
+				case metadataHelpRef:
+					ts.Metadata.HelpRef = uint32(v)
+				case metadataUnitRef:
+					ts.Metadata.UnitRef = uint32(v)
+				}
+				return nil
+			})
+		case seriesCreatedTimestamp:
+			ts.CreatedTimestamp = int64(v)
+		}
+		return err
+	})
+}
+
+// appendRefs appends packed or unpacked references.
+func appendRefs(refs []uint32, typ protowire.Type, v uint64, field []byte) ([]uint32, error) {
+	if typ == protowire.VarintType {
+		return append(refs, uint32(v)), nil
+	}
+	for len(field) > 0 {
+		ref, n := protowire.ConsumeVarint(field)
+		if n < 0 {
+			return nil, errInvalidMessage
+		}
+		refs = append(refs, uint32(ref))
+		field = field[n:]
+	}
+	return refs, nil
+}
+
+// forEachField calls fn with the value of each varint and fixed field, or the content of each bytes field.
+func forEachField(b []byte, fn func(num protowire.Number, typ protowire.Type, v uint64, field []byte) error) error {
+	for len(b) > 0 {
+		num, typ, n := protowire.ConsumeTag(b)
+		if n < 0 {
+			return errInvalidMessage
+		}
+		b = b[n:]
+
+		var v uint64
+		var field []byte
+		switch typ {
+		case protowire.VarintType:
+			v, n = protowire.ConsumeVarint(b)
+		case protowire.Fixed64Type:
+			v, n = protowire.ConsumeFixed64(b)
+		case protowire.Fixed32Type:
+			var v32 uint32
+			v32, n = protowire.ConsumeFixed32(b)
+			v = uint64(v32)
+		case protowire.BytesType:
+			field, n = protowire.ConsumeBytes(b)
+		default:
+			return fmt.Errorf("%w: unexpected wire type %d", errInvalidMessage, typ)
+		}
+		if n < 0 {
+			return errInvalidMessage
+		}
+		b = b[n:]
+		if err := fn(num, typ, v, field); err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,80 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package writev2
+
+import (
+	"math"
+	"testing"
+
+	"github.com/prometheus/prometheus/prompb"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSymbolsTable(t *testing.T) {
+	symbols := NewSymbolsTable()
+	refs := symbols.SymbolizeLabels([]prompb.Label{
+		{Name: "__name__", Value: "http_requests_total"},
+		{Name: "job", Value: "api"},
+		{Name: "method", Value: ""},
+	})
+	assert.Equal(t, []uint32{1, 2, 3, 4, 5, 0}, refs)
+	assert.Equal(t, uint32(3), symbols.Symbolize("job"))
+	assert.Equal(t, []string{"", "__name__", "http_requests_total", "job", "api", "method"}, symbols.Symbols())
+}
+
+func TestRequestRoundTrip(t *testing.T) {
+	req := &Request{
+		Symbols: []string{"", "__name__", "http_requests_total", "job", "api", "trace_id", "abc", "Number of requests"},
+		Timeseries: []TimeSeries{
+			{
+				LabelsRefs:       []uint32{1, 2, 3, 4},
+				Samples:          []Sample{{Value: 12, Timestamp: 1700000000000}, {Value: math.Inf(-1), Timestamp: -1}},
+				Exemplars:        []Exemplar{{LabelsRefs: []uint32{5, 6}, Value: 0.5, Timestamp: 1700000000001}},
+				Metadata:         Metadata{Type: MetricTypeCounter, HelpRef: 7},
+				CreatedTimestamp: 1690000000000,
+			},
+			{
+				LabelsRefs: []uint32{1, 2},
+				Histograms: []prompb.Histogram{{
+					Count:          &prompb.Histogram_CountInt{CountInt: 3},
+					Sum:            1.5,
+					Schema:         2,
+					ZeroThreshold:  1e-128,
+					ZeroCount:      &prompb.Histogram_ZeroCountInt{ZeroCountInt: 1},
+					PositiveSpans:  []prompb.BucketSpan{{Offset: 1, Length: 2}},
+					PositiveDeltas: []int64{1, 0},
+					Timestamp:      1700000000000,
+				}},
+				Metadata: Metadata{Type: MetricTypeHistogram},
+			},
+		},
+	}
+
+	data, err := req.Marshal()
+	require.NoError(t, err)
+
+	var got Request
+	require.NoError(t, got.Unmarshal(data))
+	assert.Equal(t, req.Symbols, got.Symbols)
+	require.Len(t, got.Timeseries, 2)
+	assert.Equal(t, req.Timeseries[0], got.Timeseries[0])
+
+	// Compare histograms field by field, the decoded ones carry the gogo protobuf internal state.
+	assert.Equal(t, req.Timeseries[1].LabelsRefs, got.Timeseries[1].LabelsRefs)
+	assert.Equal(t, req.Timeseries[1].Metadata, got.Timeseries[1].Metadata)
+	require.Len(t, got.Timeseries[1].Histograms, 1)
+	histogram := got.Timeseries[1].Histograms[0]
+	assert.Equal(t, uint64(3), histogram.GetCountInt())
+	assert.Equal(t, 1.5, histogram.Sum)
+	assert.Equal(t, int32(2), histogram.Schema)
+	assert.Equal(t, uint64(1), histogram.GetZeroCountInt())
+	assert.Equal(t, []int64{1, 0}, histogram.PositiveDeltas)
+	assert.Equal(t, int64(1700000000000), histogram.Timestamp)
+}
+
+func TestUnmarshalInvalid(t *testing.T) {
+	var req Request
+	assert.ErrorIs(t, req.Unmarshal([]byte{0x2a, 0x05, 0x01}), errInvalidMessage)
+}
@@ -0,0 +1,184 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package prometheusremotewriteexporter // import "github.com/open-telemetry/opentelemetry-collector-contrib/exporter/prometheusremotewriteexporter"
+
+import (
+	"sort"
+	"strings"
+
+	"github.com/prometheus/common/model"
+	"github.com/prometheus/prometheus/prompb"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/exporter/prometheusremotewriteexporter/internal/writev2"
+)
+
+const (
+	remoteWriteV1   = "v1"
+	remoteWriteV2   = "v2"
+	remoteWriteAuto = "auto"
+
+	createdSuffix = "_created"
+)
+
+// familySuffixes are the suffixes of the series of histograms and summaries, appended to the name of their family
+var familySuffixes = []string{"_bucket", "_sum", "_count"}
+
+// familyNames returns the possible names of the metric family of a series, the series name first.
+func familyNames(name string) []string {
+	names := []string{name}
+	for _, suffix := range familySuffixes {
+		if trimmed, ok := strings.CutSuffix(name, suffix); ok {
+			names = append(names, trimmed)
+		}
+	}
+	return names
+}
+
+// seriesName returns the value of the __name__ label
+func seriesName(labels []prompb.Label) string {
+	for _, l := range labels {
+		if l.Name == model.MetricNameLabel {
+			return l.Value
+		}
+	}
+	return ""
+}
+
+// familyKey identifies the series of a metric family sharing the same labels, ignoring the labels
+// distinguishing the buckets and quantiles of histograms and summaries.
+func familyKey(family string, labels []prompb.Label) string {
+	pairs := make([]string, 0, len(labels))
+	for _, l := range labels {
+		if l.Name == model.MetricNameLabel || l.Name == model.BucketLabel || l.Name == model.QuantileLabel {
+			continue
+		}
+		pairs = append(pairs, l.Name+"\xff"+l.Value)
+	}
+	sort.Strings(pairs)
+	return family + "\xfe" + strings.Join(pairs, "\xfe")
+}
+
+// createdTimestamps returns the created timestamps in the _created series of a request, by family key,
+// and the indices of the _created series giving the created timestamp of another series of the request.
+func createdTimestamps(series []prompb.TimeSeries) (map[string]int64, map[int]bool) {
+	candidates := map[string]int{}
+	for i := range series {
+		family, ok := strings.CutSuffix(seriesName(series[i].Labels), createdSuffix)
+		if !ok || len(series[i].Samples) == 0 {
+			continue
+		}
+		candidates[familyKey(family, series[i].Labels)] = i
+	}
+	if len(candidates) == 0 {
+		return nil, nil
+	}
+
+	created := map[string]int64{}
+	folded := map[int]bool{}
+	for i := range series {
+		for _, family := range familyNames(seriesName(series[i].Labels)) {
+			key := familyKey(family, series[i].Labels)
+			j, ok := candidates[key]
+			if !ok || j == i {
+				continue
+			}
+			// The _created series value is the created time in milliseconds.
+			created[key] = int64(series[j].Samples[len(series[j].Samples)-1].Value)
+			folded[j] = true
+			break
+		}
+	}
+	return created, folded
+}
+
+// attachMetadata sets the metadata of the metric families of the series of each request to the request,
+// as Remote Write 2.0 sends the metadata along with each series.
+func attachMetadata(requests []*prompb.WriteRequest, m []*prompb.MetricMetadata, namespace string) {
+	if len(m) == 0 {
+		return
+	}
+	byFamily := make(map[string]*prompb.MetricMetadata, len(m))
+	for _, metadata := range m {
+		byFamily[metadata.MetricFamilyName] = metadata
+	}
+	for _, req := range requests {
+		attached := map[string]bool{}
+		for i := range req.Timeseries {
+			metadata := familyMetadata(byFamily, seriesName(req.Timeseries[i].Labels), namespace)
+			if metadata == nil || attached[metadata.MetricFamilyName] {
+				continue
+			}
+			attached[metadata.MetricFamilyName] = true
+			req.Metadata = append(req.Metadata, *metadata)
+		}
+	}
+}
+
+// familyMetadata returns the metadata of the family of the series, nil if there is none. The metadata
+// family names don't include the namespace.
+func familyMetadata[T any](byFamily map[string]T, name string, namespace string) T {
+	if namespace != "" {
+		name = strings.TrimPrefix(name, namespace+"_")
+	}
+	for _, family := range familyNames(name) {
+		if metadata, ok := byFamily[family]; ok {
+			return metadata
+		}
+	}
+	var none T
+	return none
+}
+
+// toWriteV2 converts a Remote Write 1.0 request to Remote Write 2.0. When foldCreated is true, the
+// _created series are sent as the created timestamp of the series of their family instead.
+func toWriteV2(req *prompb.WriteRequest, foldCreated bool, namespace string) *writev2.Request {
+	var created map[string]int64
+	var folded map[int]bool
+	if foldCreated {
+		created, folded = createdTimestamps(req.Timeseries)
+	}
+
+	symbols := writev2.NewSymbolsTable()
+	byFamily := make(map[string]writev2.Metadata, len(req.Metadata))
+	for _, metadata := range req.Metadata {
+		byFamily[metadata.MetricFamilyName] = writev2.Metadata{
+			Type:    writev2.MetricType(metadata.Type),
+			HelpRef: symbols.Symbolize(metadata.Help),
+			UnitRef: symbols.Symbolize(metadata.Unit),
+		}
+	}
+
+	out := &writev2.Request{Timeseries: make([]writev2.TimeSeries, 0, len(req.Timeseries))}
+	for i, ts := range req.Timeseries {
+		if folded[i] {
+			continue
+		}
+		name := seriesName(ts.Labels)
+		series := writev2.TimeSeries{
+			LabelsRefs: symbols.SymbolizeLabels(ts.Labels),
+			Histograms: ts.Histograms,
+			Metadata:   familyMetadata(byFamily, name, namespace),
+		}
+		for _, family := range familyNames(name) {
+			if createdTimestamp, ok := created[familyKey(family, ts.Labels)]; ok {
+				series.CreatedTimestamp = createdTimestamp
+				break
+			}
+		}
+		series.Samples = make([]writev2.Sample, 0, len(ts.Samples))
+		for _, sample := range ts.Samples {
+			series.Samples = append(series.Samples, writev2.Sample{Value: sample.Value, Timestamp: sample.Timestamp})
+		}
+		for _, exemplar := range ts.Exemplars {
+			series.Exemplars = append(series.Exemplars, writev2.Exemplar{
+				LabelsRefs: symbols.SymbolizeLabels(exemplar.Labels),
+				Value:      exemplar.Value,
+				Timestamp:  exemplar.Timestamp,
+			})
+		}
+		out.Timeseries = append(out.Timeseries, series)
+	}
+	out.Symbols = symbols.Symbols()
+	return out
+}
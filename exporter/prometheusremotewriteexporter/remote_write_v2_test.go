@@ -0,0 +1,264 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package prometheusremotewriteexporter
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+
+	"github.com/gogo/protobuf/proto"
+	"github.com/golang/snappy"
+	"github.com/prometheus/prometheus/prompb"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/collector/component/componenttest"
+	"go.opentelemetry.io/collector/config/confighttp"
+	"go.opentelemetry.io/collector/exporter/exportertest"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/exporter/prometheusremotewriteexporter/internal/writev2"
+)
+
+func labelsOf(pairs ...string) []prompb.Label {
+	labels := make([]prompb.Label, 0, len(pairs)/2)
+	for i := 0; i+1 < len(pairs); i += 2 {
+		labels = append(labels, prompb.Label{Name: pairs[i], Value: pairs[i+1]})
+	}
+	return labels
+}
+
+func v2SeriesName(req *writev2.Request, ts writev2.TimeSeries) string {
+	for i := 0; i+1 < len(ts.LabelsRefs); i += 2 {
+		if req.Symbols[ts.LabelsRefs[i]] == "__name__" {
+			return req.Symbols[ts.LabelsRefs[i+1]]
+		}
+	}
+	return ""
+}
+
+func TestToWriteV2(t *testing.T) {
+	req := &prompb.WriteRequest{
+		Timeseries: []prompb.TimeSeries{
+			{
+				Labels:    labelsOf("__name__", "ns_requests_total", "job", "api"),
+				Samples:   []prompb.Sample{{Value: 10, Timestamp: 2000}},
+				Exemplars: []prompb.Exemplar{{Labels: labelsOf("trace_id", "abc"), Value: 1, Timestamp: 1500}},
+			},
+			{
+				Labels:  labelsOf("__name__", "ns_requests_total_created", "job", "api"),
+				Samples: []prompb.Sample{{Value: 1000, Timestamp: 2000}},
+			},
+			{
+				Labels:  labelsOf("__name__", "ns_latency_bucket", "job", "api", "le", "+Inf"),
+				Samples: []prompb.Sample{{Value: 3, Timestamp: 2000}},
+			},
+			{
+				Labels:  labelsOf("__name__", "ns_latency_count", "job", "api"),
+				Samples: []prompb.Sample{{Value: 3, Timestamp: 2000}},
+			},
+			{
+				Labels:  labelsOf("__name__", "ns_latency_created", "job", "api"),
+				Samples: []prompb.Sample{{Value: 500, Timestamp: 2000}},
+			},
+			{
+				Labels:  labelsOf("__name__", "ns_jobs_created", "job", "other"),
+				Samples: []prompb.Sample{{Value: 7, Timestamp: 2000}},
+			},
+			{
+				Labels:     labelsOf("__name__", "ns_size", "job", "api"),
+				Histograms: []prompb.Histogram{{Count: &prompb.Histogram_CountInt{CountInt: 1}, Schema: 1, Timestamp: 2000}},
+			},
+		},
+		Metadata: []prompb.MetricMetadata{
+			{Type: prompb.MetricMetadata_COUNTER, MetricFamilyName: "requests_total", Help: "Number of requests"},
+			{Type: prompb.MetricMetadata_HISTOGRAM, MetricFamilyName: "latency", Help: "Latency", Unit: "seconds"},
+			{Type: prompb.MetricMetadata_HISTOGRAM, MetricFamilyName: "size"},
+		},
+	}
+
+	got := toWriteV2(req, true, "ns")
+	assert.Equal(t, "", got.Symbols[0])
+
+	byName := map[string]writev2.TimeSeries{}
+	for _, ts := range got.Timeseries {
+		byName[v2SeriesName(got, ts)] = ts
+	}
+	// The _created series of the counter and of the histogram are folded, not the unrelated one.
+	assert.Len(t, byName, 5)
+	assert.NotContains(t, byName, "ns_requests_total_created")
+	assert.NotContains(t, byName, "ns_latency_created")
+	assert.Contains(t, byName, "ns_jobs_created")
+
+	counter := byName["ns_requests_total"]
+	assert.Equal(t, int64(1000), counter.CreatedTimestamp)
+	assert.Equal(t, []writev2.Sample{{Value: 10, Timestamp: 2000}}, counter.Samples)
+	assert.Equal(t, writev2.MetricTypeCounter, counter.Metadata.Type)
+	assert.Equal(t, "Number of requests", got.Symbols[counter.Metadata.HelpRef])
+	require.Len(t, counter.Exemplars, 1)
+	assert.Equal(t, "trace_id", got.Symbols[counter.Exemplars[0].LabelsRefs[0]])
+
+	bucket := byName["ns_latency_bucket"]
+	assert.Equal(t, int64(500), bucket.CreatedTimestamp)
+	assert.Equal(t, writev2.MetricTypeHistogram, bucket.Metadata.Type)
+	assert.Equal(t, "seconds", got.Symbols[bucket.Metadata.UnitRef])
+	assert.Equal(t, int64(500), byName["ns_latency_count"].CreatedTimestamp)
+
+	assert.Zero(t, byName["ns_jobs_created"].CreatedTimestamp)
+	assert.Len(t, byName["ns_size"].Histograms, 1)
+
+	// The _created series are kept when created timestamps aren't folded.
+	assert.Len(t, toWriteV2(req, false, "ns").Timeseries, 7)
+}
+
+func TestAttachMetadata(t *testing.T) {
+	requests := []*prompb.WriteRequest{
+		{Timeseries: []prompb.TimeSeries{
+			{Labels: labelsOf("__name__", "latency_bucket", "le", "1")},
+			{Labels: labelsOf("__name__", "latency_bucket", "le", "+Inf")},
+			{Labels: labelsOf("__name__", "unknown")},
+		}},
+		{Timeseries: []prompb.TimeSeries{
+			{Labels: labelsOf("__name__", "requests_total")},
+		}},
+	}
+	attachMetadata(requests, []*prompb.MetricMetadata{
+		{Type: prompb.MetricMetadata_HISTOGRAM, MetricFamilyName: "latency"},
+		{Type: prompb.MetricMetadata_COUNTER, MetricFamilyName: "requests_total"},
+		{Type: prompb.MetricMetadata_GAUGE, MetricFamilyName: "unused"},
+	}, "")
+
+	assert.Equal(t, []prompb.MetricMetadata{{Type: prompb.MetricMetadata_HISTOGRAM, MetricFamilyName: "latency"}}, requests[0].Metadata)
+	assert.Equal(t, []prompb.MetricMetadata{{Type: prompb.MetricMetadata_COUNTER, MetricFamilyName: "requests_total"}}, requests[1].Metadata)
+}
+
+// remoteWriteServer records the protocol version of the requests it receives, rejecting Remote Write 2.0
+// requests when acceptV2 is false.
+type remoteWriteServer struct {
+	acceptV2 bool
+
+	mu       sync.Mutex
+	versions []string
+	v2       []*writev2.Request
+}
+
+func (s *remoteWriteServer) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	compressed, err := io.ReadAll(r.Body)
+	if err != nil {
+		w.WriteHeader(http.StatusBadRequest)
+		return
+	}
+	data, err := snappy.Decode(nil, compressed)
+	if err != nil {
+		w.WriteHeader(http.StatusBadRequest)
+		return
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	version := r.Header.Get("X-Prometheus-Remote-Write-Version")
+	s.versions = append(s.versions, version)
+	if r.Header.Get("Content-Type") != writev2.ContentType {
+		if err = proto.Unmarshal(data, &prompb.WriteRequest{}); err != nil {
+			w.WriteHeader(http.StatusBadRequest)
+		}
+		return
+	}
+	if !s.acceptV2 {
+		w.WriteHeader(http.StatusUnsupportedMediaType)
+		return
+	}
+	req := &writev2.Request{}
+	if err = req.Unmarshal(data); err != nil {
+		w.WriteHeader(http.StatusBadRequest)
+		return
+	}
+	s.v2 = append(s.v2, req)
+}
+
+func newVersionTestExporter(t *testing.T, endpoint string, version string) *prwExporter {
+	cfg := createDefaultConfig().(*Config)
+	cfg.ClientConfig = confighttp.ClientConfig{Endpoint: endpoint}
+	cfg.RemoteWriteVersion = version
+	cfg.CreatedMetric = &CreatedMetric{Enabled: true}
+	cfg.BackOffConfig.Enabled = false
+	require.NoError(t, cfg.Validate())
+
+	prwe, err := newPRWExporter(cfg, exportertest.NewNopCreateSettings())
+	require.NoError(t, err)
+	require.NoError(t, prwe.Start(context.Background(), componenttest.NewNopHost()))
+	t.Cleanup(func() {
+		require.NoError(t, prwe.Shutdown(context.Background()))
+	})
+	return prwe
+}
+
+func testWriteRequest() *prompb.WriteRequest {
+	return &prompb.WriteRequest{
+		Timeseries: []prompb.TimeSeries{{
+			Labels:  labelsOf("__name__", "requests_total"),
+			Samples: []prompb.Sample{{Value: 1, Timestamp: 1000}},
+		}},
+	}
+}
+
+func TestRemoteWriteVersions(t *testing.T) {
+	tests := []struct {
+		name     string
+		version  string
+		acceptV2 bool
+		err      bool
+		versions []string
+	}{
+		{name: "v1", version: remoteWriteV1, acceptV2: true, versions: []string{"0.1.0", "0.1.0"}},
+		{name: "v2", version: remoteWriteV2, acceptV2: true, versions: []string{"2.0.0", "2.0.0"}},
+		{name: "v2 unsupported", version: remoteWriteV2, err: true, versions: []string{"2.0.0", "2.0.0"}},
+		{name: "auto", version: remoteWriteAuto, acceptV2: true, versions: []string{"2.0.0", "2.0.0"}},
+		// The fallback to Remote Write 1.0 is remembered for the endpoint.
+		{name: "auto fallback", version: remoteWriteAuto, versions: []string{"2.0.0", "0.1.0", "0.1.0"}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			handler := &remoteWriteServer{acceptV2: tt.acceptV2}
+			server := httptest.NewServer(handler)
+			defer server.Close()
+
+			prwe := newVersionTestExporter(t, server.URL, tt.version)
+			for i := 0; i < 2; i++ {
+				err := prwe.execute(context.Background(), testWriteRequest())
+				if tt.err {
+					assert.Error(t, err)
+				} else {
+					assert.NoError(t, err)
+				}
+			}
+
+			assert.Equal(t, tt.versions, handler.versions)
+			if tt.acceptV2 && tt.version != remoteWriteV1 {
+				require.Len(t, handler.v2, 2)
+				assert.Equal(t, "requests_total", v2SeriesName(handler.v2[0], handler.v2[0].Timeseries[0]))
+			}
+		})
+	}
+}
+
+func TestExecuteRecoverableErrors(t *testing.T) {
+	status := http.StatusServiceUnavailable
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		w.WriteHeader(status)
+	}))
+	defer server.Close()
+
+	prwe := newVersionTestExporter(t, server.URL, remoteWriteV1)
+	err := prwe.execute(context.Background(), testWriteRequest())
+	assertPermanentConsumerError(t, err)
+	assert.True(t, isRecoverable(err))
+
+	status = http.StatusBadRequest
+	err = prwe.execute(context.Background(), testWriteRequest())
+	assertPermanentConsumerError(t, err)
+	assert.False(t, isRecoverable(err))
+}
@@ -7,16 +7,18 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"os"
 	"path/filepath"
+	"strconv"
+	"strings"
 	"sync"
 	"sync/atomic"
 	"time"
 
-	"github.com/fsnotify/fsnotify"
+	"github.com/cenkalti/backoff/v4"
 	"github.com/gogo/protobuf/proto"
 	"github.com/prometheus/prometheus/prompb"
 	"github.com/tidwall/wal"
-	"go.uber.org/multierr"
 	"go.uber.org/zap"
 )
 
@@ -27,9 +29,11 @@ type prweWAL struct {
 	walPath   string
 
 	exportSink func(ctx context.Context, reqL []*prompb.WriteRequest) error
+	logger     *zap.Logger
 
 	stopOnce  sync.Once
 	stopChan  chan struct{}
+	written   chan struct{} // written is signaled when requests are persisted to the WAL.
 	rWALIndex *atomic.Uint64
 	wWALIndex *atomic.Uint64
 }
@@ -37,12 +41,16 @@ type prweWAL struct {
 const (
 	defaultWALBufferSize        = 300
 	defaultWALTruncateFrequency = 1 * time.Minute
+	defaultWALMaxRetryInterval  = 30 * time.Second
 )
 
 type WALConfig struct {
 	Directory         string        `mapstructure:"directory"`
 	BufferSize        int           `mapstructure:"buffer_size"`
 	TruncateFrequency time.Duration `mapstructure:"truncate_frequency"`
+	// MaxRetryInterval is the maximum time to wait between attempts to export the requests read
+	// from the WAL while the remote endpoint is failing. The requests are kept in the WAL meanwhile.
+	MaxRetryInterval time.Duration `mapstructure:"max_retry_interval"`
 }
 
 func (wc *WALConfig) bufferSize() int {
@@ -59,6 +67,13 @@ func (wc *WALConfig) truncateFrequency() time.Duration {
 	return defaultWALTruncateFrequency
 }
 
+func (wc *WALConfig) maxRetryInterval() time.Duration {
+	if wc.MaxRetryInterval > 0 {
+		return wc.MaxRetryInterval
+	}
+	return defaultWALMaxRetryInterval
+}
+
 func newWAL(walConfig *WALConfig, exportSink func(context.Context, []*prompb.WriteRequest) error) *prweWAL {
 	if walConfig == nil {
 		// There are cases for which the WAL can be disabled.
@@ -69,7 +84,9 @@ func newWAL(walConfig *WALConfig, exportSink func(context.Context, []*prompb.Wri
 	return &prweWAL{
 		exportSink: exportSink,
 		walConfig:  walConfig,
+		logger:     zap.NewNop(),
 		stopChan:   make(chan struct{}),
+		written:    make(chan struct{}, 1),
 		rWALIndex:  &atomic.Uint64{},
 		wWALIndex:  &atomic.Uint64{},
 	}
@@ -87,6 +104,32 @@ func (wc *WALConfig) createWAL() (*wal.Log, string, error) {
 	return log, walPath, nil
 }
 
+// checkpointPath is the file storing the index of the last entry exported from the WAL. The WAL always keeps
+// its last entry, even once exported, so the checkpoint prevents exporting it again after a restart.
+func (wc *WALConfig) checkpointPath() string {
+	return filepath.Join(wc.Directory, "prom_remotewrite.checkpoint")
+}
+
+func (wc *WALConfig) readCheckpoint() (uint64, error) {
+	data, err := os.ReadFile(wc.checkpointPath())
+	if errors.Is(err, os.ErrNotExist) {
+		return 0, nil
+	}
+	if err != nil {
+		return 0, err
+	}
+	return strconv.ParseUint(strings.TrimSpace(string(data)), 10, 64)
+}
+
+func (wc *WALConfig) writeCheckpoint(index uint64) error {
+	// Write then rename so the checkpoint is never partially written.
+	tmp := wc.checkpointPath() + ".tmp"
+	if err := os.WriteFile(tmp, []byte(strconv.FormatUint(index, 10)), 0600); err != nil {
+		return err
+	}
+	return os.Rename(tmp, wc.checkpointPath())
+}
+
 var (
 	errAlreadyClosed = errors.New("already closed")
 	errNilWAL        = errors.New("wal is nil")
@@ -114,13 +157,22 @@ func (prwe *prweWAL) retrieveWALIndices() (err error) {
 	if err != nil {
 		return fmt.Errorf("prometheusremotewriteexporter: failed to retrieve the first WAL index: %w", err)
 	}
-	prwe.rWALIndex.Store(rIndex)
 
 	wIndex, err := prwe.wal.LastIndex()
 	if err != nil {
 		return fmt.Errorf("prometheusremotewriteexporter: failed to retrieve the last WAL index: %w", err)
 	}
 	prwe.wWALIndex.Store(wIndex)
+
+	exported, err := prwe.walConfig.readCheckpoint()
+	if err != nil {
+		return fmt.Errorf("prometheusremotewriteexporter: failed to read the WAL checkpoint: %w", err)
+	}
+	// Ignore a checkpoint beyond the WAL, e.g. when the WAL was deleted, not to skip new entries.
+	if exported >= rIndex && exported <= wIndex {
+		rIndex = exported + 1
+	}
+	prwe.rWALIndex.Store(rIndex)
 	return nil
 }
 
@@ -143,6 +195,7 @@ func (prwe *prweWAL) run(ctx context.Context) (err error) {
 	if err != nil {
 		return
 	}
+	prwe.logger = logger
 
 	if err = prwe.retrieveWALIndices(); err != nil {
 		logger.Error("unable to start write-ahead log", zap.Error(err))
@@ -185,28 +238,23 @@ func (prwe *prweWAL) run(ctx context.Context) (err error) {
 // the WAL's front index forward until either the read buffer period expires or the maximum
 // buffer size is exceeded. When either of the two conditions are matched, it then exports
 // the requests to the Remote-Write endpoint, and then truncates the head of the WAL to where
-// it last read from.
-func (prwe *prweWAL) continuallyPopWALThenExport(ctx context.Context, signalStart func()) (err error) {
+// it last read from. When there is nothing left to read, it waits for new requests to be
+// persisted to the WAL.
+func (prwe *prweWAL) continuallyPopWALThenExport(ctx context.Context, signalStart func()) error {
 	var reqL []*prompb.WriteRequest
-	defer func() {
-		// Keeping it within a closure to ensure that the later
-		// updated value of reqL is always flushed to disk.
-		if errL := prwe.exportSink(ctx, reqL); errL != nil {
-			err = multierr.Append(err, errL)
-		}
-	}()
 
-	freshTimer := func() *time.Timer {
-		return time.NewTimer(prwe.walConfig.truncateFrequency())
+	timer := time.NewTimer(prwe.walConfig.truncateFrequency())
+	defer timer.Stop()
+	resetTimer := func() {
+		if !timer.Stop() {
+			select {
+			case <-timer.C:
+			default:
+			}
+		}
+		timer.Reset(prwe.walConfig.truncateFrequency())
 	}
 
-	timer := freshTimer()
-	defer func() {
-		// Added in a closure to ensure we capture the later
-		// updated value of timer when changed in the loop below.
-		timer.Stop()
-	}()
-
 	signalStart()
 
 	maxCountPerUpload := prwe.walConfig.bufferSize()
@@ -219,32 +267,38 @@ func (prwe *prweWAL) continuallyPopWALThenExport(ctx context.Context, signalStar
 		default:
 		}
 
-		var req *prompb.WriteRequest
-		req, err = prwe.readPrompbFromWAL(ctx, prwe.rWALIndex.Load())
-		if err != nil {
-			return err
-		}
-		reqL = append(reqL, req)
-
-		var shouldExport bool
-		select {
-		case <-timer.C:
-			shouldExport = true
+		req, err := prwe.readPrompbFromWAL(ctx, prwe.rWALIndex.Load())
+		switch {
+		case err == nil:
+			reqL = append(reqL, req)
+			if len(reqL) < maxCountPerUpload {
+				// Export on time even when requests are continuously persisted.
+				select {
+				case <-timer.C:
+				default:
+					continue
+				}
+			}
+		case errors.Is(err, wal.ErrNotFound):
+			// Everything was read, wait for new requests or for the time to export what was read.
+			select {
+			case <-ctx.Done():
+				return ctx.Err()
+			case <-prwe.stopChan:
+				return nil
+			case <-prwe.written:
+				continue
+			case <-timer.C:
+			}
 		default:
-			shouldExport = len(reqL) >= maxCountPerUpload
-		}
-
-		if !shouldExport {
-			continue
+			return err
 		}
 
-		// Otherwise, it is time to export, flush and then truncate the WAL, but also to kill the timer!
-		timer.Stop()
-		timer = freshTimer()
-
+		// Otherwise, it is time to export, flush and then truncate the WAL, but also to reset the timer!
 		if err = prwe.exportThenFrontTruncateWAL(ctx, reqL); err != nil {
 			return err
 		}
+		resetTimer()
 		// Reset but reuse the write requests slice.
 		reqL = reqL[:0]
 	}
@@ -259,6 +313,8 @@ func (prwe *prweWAL) closeWAL() error {
 	return nil
 }
 
+// syncAndTruncateFront records that the entries before the read index were exported, then removes them
+// from the WAL. The last entry of the WAL can't be removed, the checkpoint skips it on restart.
 func (prwe *prweWAL) syncAndTruncateFront() error {
 	prwe.mu.Lock()
 	defer prwe.mu.Unlock()
@@ -271,30 +327,64 @@ func (prwe *prweWAL) syncAndTruncateFront() error {
 	if err := prwe.wal.Sync(); err != nil {
 		return err
 	}
+	rIndex := prwe.rWALIndex.Load()
+	if err := prwe.walConfig.writeCheckpoint(rIndex - 1); err != nil {
+		return err
+	}
+	lastIndex, err := prwe.wal.LastIndex()
+	if err != nil {
+		return err
+	}
+	if rIndex > lastIndex {
+		rIndex = lastIndex
+	}
 	// Truncate the WAL from the front for the entries that we already
 	// read from the WAL and had already exported.
-	if err := prwe.wal.TruncateFront(prwe.rWALIndex.Load()); err != nil && !errors.Is(err, wal.ErrOutOfRange) {
+	if err := prwe.wal.TruncateFront(rIndex); err != nil && !errors.Is(err, wal.ErrOutOfRange) {
 		return err
 	}
 	return nil
 }
 
+// exportThenFrontTruncateWAL exports the requests, retrying while the failure is recoverable so the
+// requests survive outages of the remote endpoint, then truncates them from the WAL. The requests are
+// kept in the WAL when the exporter stops before they are exported.
 func (prwe *prweWAL) exportThenFrontTruncateWAL(ctx context.Context, reqL []*prompb.WriteRequest) error {
 	if len(reqL) == 0 {
 		return nil
 	}
-	if cErr := ctx.Err(); cErr != nil {
-		return nil
-	}
 
-	if errL := prwe.exportSink(ctx, reqL); errL != nil {
-		return errL
-	}
-	if err := prwe.syncAndTruncateFront(); err != nil {
-		return err
+	retry := backoff.NewExponentialBackOff()
+	retry.MaxInterval = prwe.walConfig.maxRetryInterval()
+	retry.MaxElapsedTime = 0
+	for {
+		err := prwe.exportSink(ctx, reqL)
+		if ctx.Err() != nil {
+			return nil
+		}
+		if err == nil {
+			break
+		}
+		if !isRecoverable(err) {
+			prwe.logger.Error("dropping WAL entries rejected by the remote endpoint", zap.Int("requests", len(reqL)), zap.Error(err))
+			break
+		}
+
+		wait := retry.NextBackOff()
+		prwe.logger.Warn("failed to export WAL entries, retrying", zap.Int("requests", len(reqL)), zap.Duration("interval", wait), zap.Error(err))
+		timer := time.NewTimer(wait)
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return nil
+		case <-prwe.stopChan:
+			timer.Stop()
+			return nil
+		case <-timer.C:
+		}
 	}
-	// Reset by retrieving the respective read and write WAL indices.
-	return prwe.retrieveWALIndices()
+
+	return prwe.syncAndTruncateFront()
 }
 
 // persistToWAL is the routine that'll be hooked into the exporter's receiving side and it'll
@@ -304,121 +394,68 @@ func (prwe *prweWAL) persistToWAL(requests []*prompb.WriteRequest) error {
 	prwe.mu.Lock()
 	defer prwe.mu.Unlock()
 
+	if prwe.wal == nil {
+		return errNilWAL
+	}
+
 	// Write all the requests to the WAL in a batch.
 	batch := new(wal.Batch)
+	wIndex := prwe.wWALIndex.Load()
 	for _, req := range requests {
 		protoBlob, err := proto.Marshal(req)
 		if err != nil {
 			return err
 		}
-		wIndex := prwe.wWALIndex.Add(1)
+		wIndex++
 		batch.Write(wIndex, protoBlob)
 	}
 
-	return prwe.wal.WriteBatch(batch)
+	if err := prwe.wal.WriteBatch(batch); err != nil {
+		return err
+	}
+	prwe.wWALIndex.Store(wIndex)
+
+	// Wake up the reader waiting for new requests.
+	select {
+	case prwe.written <- struct{}{}:
+	default:
+	}
+	return nil
 }
 
+// readPrompbFromWAL reads the request at index, and returns wal.ErrNotFound when there is no request at index yet.
 func (prwe *prweWAL) readPrompbFromWAL(ctx context.Context, index uint64) (wreq *prompb.WriteRequest, err error) {
 	prwe.mu.Lock()
 	defer prwe.mu.Unlock()
 
-	var protoBlob []byte
-	for i := 0; i < 12; i++ {
-		// Firstly check if we've been terminated, then exit if so.
-		select {
-		case <-ctx.Done():
-			return nil, ctx.Err()
-		case <-prwe.stopChan:
-			return nil, fmt.Errorf("attempt to read from WAL after stopped")
-		default:
-		}
-
-		if index <= 0 {
-			index = 1
-		}
-
-		if prwe.wal == nil {
-			return nil, fmt.Errorf("attempt to read from closed WAL")
-		}
-
-		protoBlob, err = prwe.wal.Read(index)
-		if err == nil { // The read succeeded.
-			req := new(prompb.WriteRequest)
-			if err = proto.Unmarshal(protoBlob, req); err != nil {
-				return nil, err
-			}
-
-			// Now increment the WAL's read index.
-			prwe.rWALIndex.Add(1)
-
-			return req, nil
-		}
-
-		if !errors.Is(err, wal.ErrNotFound) {
-			return nil, err
-		}
-
-		if index <= 1 {
-			// This could be the very first attempted read, so try again, after a small sleep.
-			time.Sleep(time.Duration(1<<i) * time.Millisecond)
-			continue
-		}
-
-		// Otherwise, we couldn't find the record, let's try watching
-		// the WAL file until perhaps there is a write to it.
-		walWatcher, werr := fsnotify.NewWatcher()
-		if werr != nil {
-			return nil, werr
-		}
-		if werr = walWatcher.Add(prwe.walPath); werr != nil {
-			return nil, werr
-		}
-
-		// Watch until perhaps there is a write to the WAL file.
-		watchCh := make(chan error)
-		wErr := err
-		go func() {
-			defer func() {
-				watchCh <- wErr
-				close(watchCh)
-				// Close the file watcher.
-				walWatcher.Close()
-			}()
+	// Firstly check if we've been terminated, then exit if so.
+	select {
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	case <-prwe.stopChan:
+		return nil, fmt.Errorf("attempt to read from WAL after stopped")
+	default:
+	}
 
-			select {
-			case <-ctx.Done(): // If the context was cancelled, bail out ASAP.
-				wErr = ctx.Err()
-				return
+	if index <= 0 {
+		index = 1
+	}
 
-			case event, ok := <-walWatcher.Events:
-				if !ok {
-					return
-				}
-				switch event.Op {
-				case fsnotify.Remove:
-					// The file got deleted.
-					// TODO: Add capabilities to search for the updated file.
-				case fsnotify.Rename:
-					// Renamed, we don't have information about the renamed file's new name.
-				case fsnotify.Write:
-					// Finally a write, let's try reading again, but after some watch.
-					wErr = nil
-				}
+	if prwe.wal == nil {
+		return nil, fmt.Errorf("attempt to read from closed WAL")
+	}
 
-			case eerr, ok := <-walWatcher.Errors:
-				if ok {
-					wErr = eerr
-				}
-			}
-		}()
+	protoBlob, err := prwe.wal.Read(index)
+	if err != nil {
+		return nil, err
+	}
+	req := new(prompb.WriteRequest)
+	if err = proto.Unmarshal(protoBlob, req); err != nil {
+		return nil, err
+	}
 
-		if gerr := <-watchCh; gerr != nil {
-			return nil, gerr
-		}
+	// Now increment the WAL's read index.
+	prwe.rWALIndex.Store(index + 1)
 
-		// Otherwise a write occurred might have occurred,
-		// and we can sleep for a little bit then try again.
-		time.Sleep(time.Duration(1<<i) * time.Millisecond)
-	}
-	return nil, err
+	return req, nil
 }
@@ -5,13 +5,17 @@ package prometheusremotewriteexporter
 
 import (
 	"context"
+	"errors"
 	"sort"
+	"sync"
 	"testing"
 	"time"
 
 	"github.com/prometheus/prometheus/prompb"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/collector/consumer/consumererror"
+	"go.uber.org/zap"
 )
 
 func doNothingExportSink(_ context.Context, reqL []*prompb.WriteRequest) error {
@@ -149,3 +153,110 @@ func TestWAL_persist(t *testing.T) {
 	require.Equal(t, reqLFromWAL[0], reqL[0])
 	require.Equal(t, reqLFromWAL[1], reqL[1])
 }
+
+// recordingExportSink records the exported requests, failing with err the first failures times.
+type recordingExportSink struct {
+	mu       sync.Mutex
+	failures int
+	err      error
+	attempts int
+	exported []*prompb.WriteRequest
+}
+
+func (s *recordingExportSink) export(_ context.Context, reqL []*prompb.WriteRequest) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.attempts++
+	if s.attempts <= s.failures {
+		return s.err
+	}
+	s.exported = append(s.exported, reqL...)
+	return nil
+}
+
+func (s *recordingExportSink) exportedCount() int {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return len(s.exported)
+}
+
+func runTestWAL(t *testing.T, config *WALConfig, sink *recordingExportSink) *prweWAL {
+	pwal := newWAL(config, sink.export)
+	require.NoError(t, pwal.run(contextWithLogger(context.Background(), zap.NewNop())))
+	return pwal
+}
+
+func walTestRequest(value float64) *prompb.WriteRequest {
+	return &prompb.WriteRequest{
+		Timeseries: []prompb.TimeSeries{{
+			Labels:  []prompb.Label{{Name: "__name__", Value: "test"}},
+			Samples: []prompb.Sample{{Value: value, Timestamp: 100}},
+		}},
+	}
+}
+
+func TestWAL_exportsPendingRequestsOnTime(t *testing.T) {
+	// Fewer requests than the buffer size are exported once the truncate frequency elapsed,
+	// even when no more requests are persisted.
+	config := &WALConfig{Directory: t.TempDir(), TruncateFrequency: 10 * time.Millisecond}
+	sink := &recordingExportSink{}
+	pwal := runTestWAL(t, config, sink)
+	t.Cleanup(func() {
+		assert.NoError(t, pwal.stop())
+	})
+
+	require.NoError(t, pwal.persistToWAL([]*prompb.WriteRequest{walTestRequest(1), walTestRequest(2)}))
+	assert.Eventually(t, func() bool { return sink.exportedCount() == 2 }, 5*time.Second, 10*time.Millisecond)
+}
+
+func TestWAL_doesNotExportAgainAfterRestart(t *testing.T) {
+	config := &WALConfig{Directory: t.TempDir(), BufferSize: 1}
+	sink := &recordingExportSink{}
+	pwal := runTestWAL(t, config, sink)
+	require.NoError(t, pwal.persistToWAL([]*prompb.WriteRequest{walTestRequest(1)}))
+	require.Eventually(t, func() bool { return sink.exportedCount() == 1 }, 5*time.Second, 10*time.Millisecond)
+	require.Eventually(t, func() bool {
+		exported, err := config.readCheckpoint()
+		return err == nil && exported == 1
+	}, 5*time.Second, 10*time.Millisecond)
+	require.NoError(t, pwal.stop())
+
+	// The exported request is still in the WAL, which can't be emptied, but isn't exported again.
+	pwal = runTestWAL(t, config, sink)
+	t.Cleanup(func() {
+		assert.NoError(t, pwal.stop())
+	})
+	assert.Equal(t, uint64(2), pwal.rWALIndex.Load())
+	require.NoError(t, pwal.persistToWAL([]*prompb.WriteRequest{walTestRequest(2)}))
+	require.Eventually(t, func() bool { return sink.exportedCount() == 2 }, 5*time.Second, 10*time.Millisecond)
+	assert.Equal(t, 2.0, sink.exported[1].Timeseries[0].Samples[0].Value)
+}
+
+func TestWAL_retriesRecoverableFailures(t *testing.T) {
+	config := &WALConfig{Directory: t.TempDir(), BufferSize: 1, MaxRetryInterval: 10 * time.Millisecond}
+	sink := &recordingExportSink{failures: 3, err: consumererror.NewPermanent(recoverableError{errors.New("unavailable")})}
+	pwal := runTestWAL(t, config, sink)
+	t.Cleanup(func() {
+		assert.NoError(t, pwal.stop())
+	})
+
+	require.NoError(t, pwal.persistToWAL([]*prompb.WriteRequest{walTestRequest(1)}))
+	require.Eventually(t, func() bool { return sink.exportedCount() == 1 }, 5*time.Second, 10*time.Millisecond)
+	sink.mu.Lock()
+	assert.Equal(t, 4, sink.attempts)
+	sink.mu.Unlock()
+}
+
+func TestWAL_dropsRejectedRequests(t *testing.T) {
+	config := &WALConfig{Directory: t.TempDir(), BufferSize: 1}
+	sink := &recordingExportSink{failures: 1, err: consumererror.NewPermanent(errors.New("bad request"))}
+	pwal := runTestWAL(t, config, sink)
+	t.Cleanup(func() {
+		assert.NoError(t, pwal.stop())
+	})
+
+	require.NoError(t, pwal.persistToWAL([]*prompb.WriteRequest{walTestRequest(1)}))
+	require.NoError(t, pwal.persistToWAL([]*prompb.WriteRequest{walTestRequest(2)}))
+	require.Eventually(t, func() bool { return sink.exportedCount() == 1 }, 5*time.Second, 10*time.Millisecond)
+	assert.Equal(t, 2.0, sink.exported[0].Timeseries[0].Samples[0].Value)
+}
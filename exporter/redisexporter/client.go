@@ -0,0 +1,57 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package redisexporter // import "github.com/open-telemetry/opentelemetry-collector-contrib/exporter/redisexporter"
+
+import (
+	"context"
+	"strings"
+
+	"github.com/redis/go-redis/v9"
+)
+
+// payloadField is the field of the stream entries holding the encoded payload.
+const payloadField = "data"
+
+// Interface for the Redis commands used by the exporter, for the go-redis client to be replaced in the tests.
+type streamClient interface {
+	// add adds an entry to the stream, trimming it to approximately maxLen entries if maxLen is positive.
+	add(ctx context.Context, stream string, maxLen int64, payload []byte) error
+	// createGroup creates a consumer group reading the stream from its first entry, creating the stream if needed.
+	// It doesn't fail if the group already exists.
+	createGroup(ctx context.Context, stream string, group string) error
+	close() error
+}
+
+// Wraps a real Redis client, implements the `streamClient` interface.
+type redisClient struct {
+	client *redis.Client
+}
+
+var _ streamClient = (*redisClient)(nil)
+
+// Creates a new real Redis client from the passed-in redis.Options.
+func newRedisClient(options *redis.Options) streamClient {
+	return &redisClient{client: redis.NewClient(options)}
+}
+
+func (c *redisClient) add(ctx context.Context, stream string, maxLen int64, payload []byte) error {
+	return c.client.XAdd(ctx, &redis.XAddArgs{
+		Stream: stream,
+		MaxLen: maxLen,
+		Approx: maxLen > 0,
+		Values: []any{payloadField, payload},
+	}).Err()
+}
+
+func (c *redisClient) createGroup(ctx context.Context, stream string, group string) error {
+	err := c.client.XGroupCreateMkStream(ctx, stream, group, "0").Err()
+	if err != nil && strings.HasPrefix(err.Error(), "BUSYGROUP") {
+		return nil
+	}
+	return err
+}
+
+func (c *redisClient) close() error {
+	return c.client.Close()
+}
@@ -0,0 +1,103 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package redisexporter // import "github.com/open-telemetry/opentelemetry-collector-contrib/exporter/redisexporter"
+
+import (
+	"errors"
+	"fmt"
+
+	"go.opentelemetry.io/collector/component"
+	"go.opentelemetry.io/collector/config/confignet"
+	"go.opentelemetry.io/collector/config/configopaque"
+	"go.opentelemetry.io/collector/config/configretry"
+	"go.opentelemetry.io/collector/config/configtls"
+	"go.opentelemetry.io/collector/exporter/exporterhelper"
+)
+
+const (
+	encodingProto = "otlp_proto"
+	encodingJSON  = "otlp_json"
+)
+
+// Config defines configuration for the Redis exporter.
+type Config struct {
+	exporterhelper.TimeoutSettings `mapstructure:",squash"` // squash ensures fields are correctly decoded in embedded struct.
+	exporterhelper.QueueSettings   `mapstructure:"sending_queue"`
+	configretry.BackOffConfig      `mapstructure:"retry_on_failure"`
+
+	// Endpoint is the address of the Redis server (default localhost:6379).
+	confignet.AddrConfig `mapstructure:",squash"`
+
+	// Username is the optional username of the Redis ACL user.
+	Username string `mapstructure:"username"`
+
+	// Password is the optional password of the Redis server, or of the ACL user.
+	Password configopaque.String `mapstructure:"password"`
+
+	// DB is the Redis database number, selected after connecting (default 0).
+	DB int `mapstructure:"db"`
+
+	TLS configtls.ClientConfig `mapstructure:"tls,omitempty"`
+
+	// Encoding of the payloads added to the streams, otlp_proto (default) or otlp_json.
+	Encoding string `mapstructure:"encoding"`
+
+	// Traces is the stream the spans are added to (default otlp_spans).
+	Traces StreamConfig `mapstructure:"traces"`
+	// Metrics is the stream the metrics are added to (default otlp_metrics).
+	Metrics StreamConfig `mapstructure:"metrics"`
+	// Logs is the stream the logs are added to (default otlp_logs).
+	Logs StreamConfig `mapstructure:"logs"`
+
+	// ConsumerGroups are created on the streams on start, reading them from their first entry, so that the entries
+	// added before their consumers start aren't missed.
+	ConsumerGroups []string `mapstructure:"consumer_groups"`
+}
+
+// StreamConfig defines the Redis stream a signal is added to.
+type StreamConfig struct {
+	// Stream is the key of the stream.
+	Stream string `mapstructure:"stream"`
+	// MaxLen trims the stream to approximately this number of entries, 0 to never trim it.
+	MaxLen int64 `mapstructure:"max_len"`
+}
+
+var _ component.Config = (*Config)(nil)
+
+// Validate checks if the exporter configuration is valid.
+func (cfg *Config) Validate() error {
+	if cfg.Endpoint == "" {
+		return errors.New("endpoint must be specified")
+	}
+	switch cfg.Encoding {
+	case encodingProto, encodingJSON:
+	default:
+		return fmt.Errorf("unsupported encoding %q", cfg.Encoding)
+	}
+	if err := cfg.Traces.validate("traces"); err != nil {
+		return err
+	}
+	if err := cfg.Metrics.validate("metrics"); err != nil {
+		return err
+	}
+	if err := cfg.Logs.validate("logs"); err != nil {
+		return err
+	}
+	for _, group := range cfg.ConsumerGroups {
+		if group == "" {
+			return errors.New("consumer_groups must not contain an empty name")
+		}
+	}
+	return nil
+}
+
+func (cfg StreamConfig) validate(signal string) error {
+	if cfg.Stream == "" {
+		return fmt.Errorf("%s::stream must be specified", signal)
+	}
+	if cfg.MaxLen < 0 {
+		return fmt.Errorf("%s::max_len must not be negative", signal)
+	}
+	return nil
+}
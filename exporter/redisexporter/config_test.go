@@ -0,0 +1,103 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package redisexporter
+
+import (
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/collector/component"
+	"go.opentelemetry.io/collector/config/confignet"
+	"go.opentelemetry.io/collector/config/configretry"
+	"go.opentelemetry.io/collector/config/configtls"
+	"go.opentelemetry.io/collector/confmap/confmaptest"
+	"go.opentelemetry.io/collector/exporter/exporterhelper"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/exporter/redisexporter/internal/metadata"
+)
+
+func TestLoadConfig(t *testing.T) {
+	t.Parallel()
+
+	cm, err := confmaptest.LoadConf(filepath.Join("testdata", "config.yaml"))
+	require.NoError(t, err)
+
+	retry := configretry.NewDefaultBackOffConfig()
+	retry.InitialInterval = 10 * time.Second
+	retry.MaxInterval = time.Minute
+	retry.MaxElapsedTime = 10 * time.Minute
+
+	tests := []struct {
+		id           component.ID
+		expected     component.Config
+		errorMessage string
+	}{
+		{
+			id:       component.NewIDWithName(metadata.Type, ""),
+			expected: createDefaultConfig(),
+		},
+		{
+			id: component.NewIDWithName(metadata.Type, "all_settings"),
+			expected: &Config{
+				TimeoutSettings: exporterhelper.TimeoutSettings{
+					Timeout: 10 * time.Second,
+				},
+				BackOffConfig: retry,
+				QueueSettings: exporterhelper.QueueSettings{
+					Enabled:      true,
+					NumConsumers: 2,
+					QueueSize:    10,
+				},
+				AddrConfig: confignet.AddrConfig{
+					Endpoint:  "redis.example.com:6380",
+					Transport: confignet.TransportTypeTCP,
+				},
+				Username: "collector",
+				Password: "secret",
+				DB:       2,
+				TLS: configtls.ClientConfig{
+					Insecure: true,
+				},
+				Encoding:       encodingJSON,
+				Traces:         StreamConfig{Stream: "spans", MaxLen: 1000},
+				Metrics:        StreamConfig{Stream: "metrics", MaxLen: 5000},
+				Logs:           StreamConfig{Stream: "logs"},
+				ConsumerGroups: []string{"analytics", "archive"},
+			},
+		},
+		{
+			id:           component.NewIDWithName(metadata.Type, "bad_encoding"),
+			errorMessage: `unsupported encoding "avro"`,
+		},
+		{
+			id:           component.NewIDWithName(metadata.Type, "negative_max_len"),
+			errorMessage: "metrics::max_len must not be negative",
+		},
+		{
+			id:           component.NewIDWithName(metadata.Type, "empty_group"),
+			errorMessage: "consumer_groups must not contain an empty name",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.id.String(), func(t *testing.T) {
+			factory := NewFactory()
+			cfg := factory.CreateDefaultConfig()
+
+			sub, err := cm.Sub(tt.id.String())
+			require.NoError(t, err)
+			require.NoError(t, sub.Unmarshal(cfg))
+
+			if tt.errorMessage != "" {
+				assert.EqualError(t, component.ValidateConfig(cfg), tt.errorMessage)
+				return
+			}
+			assert.NoError(t, component.ValidateConfig(cfg))
+			assert.Equal(t, tt.expected, cfg)
+		})
+	}
+}
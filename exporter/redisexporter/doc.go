@@ -0,0 +1,7 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+//go:generate mdatagen metadata.yaml
+
+// Package redisexporter exports telemetry data to Redis streams.
+package redisexporter // import "github.com/open-telemetry/opentelemetry-collector-contrib/exporter/redisexporter"
@@ -0,0 +1,104 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package redisexporter // import "github.com/open-telemetry/opentelemetry-collector-contrib/exporter/redisexporter"
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/redis/go-redis/v9"
+	"go.opentelemetry.io/collector/component"
+	"go.opentelemetry.io/collector/consumer/consumererror"
+	"go.opentelemetry.io/collector/pdata/plog"
+	"go.opentelemetry.io/collector/pdata/pmetric"
+	"go.opentelemetry.io/collector/pdata/ptrace"
+)
+
+// redisExporter adds the telemetry data of one signal to a Redis stream.
+type redisExporter struct {
+	cfg    *Config
+	stream StreamConfig
+
+	newClient func(*redis.Options) streamClient
+	client    streamClient
+}
+
+func newExporter(cfg *Config, stream StreamConfig) *redisExporter {
+	return &redisExporter{
+		cfg:       cfg,
+		stream:    stream,
+		newClient: newRedisClient,
+	}
+}
+
+func (e *redisExporter) start(ctx context.Context, _ component.Host) error {
+	opts := &redis.Options{
+		Addr:     e.cfg.Endpoint,
+		Username: e.cfg.Username,
+		Password: string(e.cfg.Password),
+		DB:       e.cfg.DB,
+	}
+	var err error
+	if opts.TLSConfig, err = e.cfg.TLS.LoadTLSConfig(ctx); err != nil {
+		return fmt.Errorf("failed to load TLS config: %w", err)
+	}
+	e.client = e.newClient(opts)
+
+	for _, group := range e.cfg.ConsumerGroups {
+		if err = e.client.createGroup(ctx, e.stream.Stream, group); err != nil {
+			return fmt.Errorf("failed to create the consumer group %q of the stream %q: %w", group, e.stream.Stream, err)
+		}
+	}
+	return nil
+}
+
+func (e *redisExporter) shutdown(context.Context) error {
+	if e.client == nil {
+		return nil
+	}
+	return e.client.close()
+}
+
+func (e *redisExporter) pushTraces(ctx context.Context, td ptrace.Traces) error {
+	var marshaler ptrace.Marshaler = &ptrace.ProtoMarshaler{}
+	if e.cfg.Encoding == encodingJSON {
+		marshaler = &ptrace.JSONMarshaler{}
+	}
+	buf, err := marshaler.MarshalTraces(td)
+	if err != nil {
+		return consumererror.NewPermanent(err)
+	}
+	return e.add(ctx, buf)
+}
+
+func (e *redisExporter) pushMetrics(ctx context.Context, md pmetric.Metrics) error {
+	var marshaler pmetric.Marshaler = &pmetric.ProtoMarshaler{}
+	if e.cfg.Encoding == encodingJSON {
+		marshaler = &pmetric.JSONMarshaler{}
+	}
+	buf, err := marshaler.MarshalMetrics(md)
+	if err != nil {
+		return consumererror.NewPermanent(err)
+	}
+	return e.add(ctx, buf)
+}
+
+func (e *redisExporter) pushLogs(ctx context.Context, ld plog.Logs) error {
+	var marshaler plog.Marshaler = &plog.ProtoMarshaler{}
+	if e.cfg.Encoding == encodingJSON {
+		marshaler = &plog.JSONMarshaler{}
+	}
+	buf, err := marshaler.MarshalLogs(ld)
+	if err != nil {
+		return consumererror.NewPermanent(err)
+	}
+	return e.add(ctx, buf)
+}
+
+func (e *redisExporter) add(ctx context.Context, payload []byte) error {
+	if err := e.client.add(ctx, e.stream.Stream, e.stream.MaxLen, payload); err != nil {
+		return fmt.Errorf("failed to add the entry to the stream %q: %w", e.stream.Stream, err)
+	}
+	return nil
+}
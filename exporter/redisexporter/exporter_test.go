@@ -0,0 +1,156 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package redisexporter
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/redis/go-redis/v9"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/collector/component/componenttest"
+	"go.opentelemetry.io/collector/pdata/plog"
+	"go.opentelemetry.io/collector/pdata/pmetric"
+	"go.opentelemetry.io/collector/pdata/ptrace"
+)
+
+type entry struct {
+	stream  string
+	maxLen  int64
+	payload []byte
+}
+
+type fakeClient struct {
+	options *redis.Options
+	entries []entry
+	groups  map[string][]string
+	err     error
+	closed  bool
+}
+
+func (c *fakeClient) add(_ context.Context, stream string, maxLen int64, payload []byte) error {
+	if c.err != nil {
+		return c.err
+	}
+	c.entries = append(c.entries, entry{stream: stream, maxLen: maxLen, payload: payload})
+	return nil
+}
+
+func (c *fakeClient) createGroup(_ context.Context, stream string, group string) error {
+	if c.err != nil {
+		return c.err
+	}
+	c.groups[stream] = append(c.groups[stream], group)
+	return nil
+}
+
+func (c *fakeClient) close() error {
+	c.closed = true
+	return nil
+}
+
+func newTestExporter(t *testing.T, cfg *Config, stream StreamConfig) (*redisExporter, *fakeClient) {
+	client := &fakeClient{groups: map[string][]string{}}
+	exp := newExporter(cfg, stream)
+	exp.newClient = func(options *redis.Options) streamClient {
+		client.options = options
+		return client
+	}
+	require.NoError(t, exp.start(context.Background(), componenttest.NewNopHost()))
+	t.Cleanup(func() {
+		assert.NoError(t, exp.shutdown(context.Background()))
+		assert.True(t, client.closed)
+	})
+	return exp, client
+}
+
+func TestStart(t *testing.T) {
+	cfg := createDefaultConfig().(*Config)
+	cfg.Username = "collector"
+	cfg.Password = "secret"
+	cfg.DB = 2
+	cfg.ConsumerGroups = []string{"analytics", "archive"}
+
+	_, client := newTestExporter(t, cfg, cfg.Metrics)
+	assert.Equal(t, "localhost:6379", client.options.Addr)
+	assert.Equal(t, "collector", client.options.Username)
+	assert.Equal(t, "secret", client.options.Password)
+	assert.Equal(t, 2, client.options.DB)
+	assert.Nil(t, client.options.TLSConfig)
+	assert.Equal(t, map[string][]string{"otlp_metrics": {"analytics", "archive"}}, client.groups)
+}
+
+func TestStartErrors(t *testing.T) {
+	cfg := createDefaultConfig().(*Config)
+	cfg.TLS.Insecure = false
+	cfg.TLS.CAFile = "/nonexistent"
+	exp := newExporter(cfg, cfg.Logs)
+	err := exp.start(context.Background(), componenttest.NewNopHost())
+	assert.ErrorContains(t, err, "failed to load TLS config")
+	assert.NoError(t, exp.shutdown(context.Background()))
+
+	cfg = createDefaultConfig().(*Config)
+	cfg.ConsumerGroups = []string{"analytics"}
+	exp = newExporter(cfg, cfg.Logs)
+	exp.newClient = func(*redis.Options) streamClient {
+		return &fakeClient{err: errors.New("NOAUTH Authentication required.")}
+	}
+	err = exp.start(context.Background(), componenttest.NewNopHost())
+	assert.EqualError(t, err, `failed to create the consumer group "analytics" of the stream "otlp_logs": NOAUTH Authentication required.`)
+	assert.NoError(t, exp.shutdown(context.Background()))
+}
+
+func TestPushTraces(t *testing.T) {
+	cfg := createDefaultConfig().(*Config)
+	cfg.Traces.MaxLen = 1000
+	exp, client := newTestExporter(t, cfg, cfg.Traces)
+
+	td := ptrace.NewTraces()
+	td.ResourceSpans().AppendEmpty().ScopeSpans().AppendEmpty().Spans().AppendEmpty().SetName("span")
+	require.NoError(t, exp.pushTraces(context.Background(), td))
+
+	require.Len(t, client.entries, 1)
+	assert.Equal(t, "otlp_spans", client.entries[0].stream)
+	assert.Equal(t, int64(1000), client.entries[0].maxLen)
+	got, err := (&ptrace.ProtoUnmarshaler{}).UnmarshalTraces(client.entries[0].payload)
+	require.NoError(t, err)
+	assert.Equal(t, td, got)
+}
+
+func TestPushMetrics(t *testing.T) {
+	cfg := createDefaultConfig().(*Config)
+	cfg.Encoding = encodingJSON
+	exp, client := newTestExporter(t, cfg, cfg.Metrics)
+
+	md := pmetric.NewMetrics()
+	md.ResourceMetrics().AppendEmpty().ScopeMetrics().AppendEmpty().Metrics().AppendEmpty().SetName("metric")
+	require.NoError(t, exp.pushMetrics(context.Background(), md))
+
+	require.Len(t, client.entries, 1)
+	assert.Equal(t, "otlp_metrics", client.entries[0].stream)
+	assert.Zero(t, client.entries[0].maxLen)
+	got, err := (&pmetric.JSONUnmarshaler{}).UnmarshalMetrics(client.entries[0].payload)
+	require.NoError(t, err)
+	assert.Equal(t, md, got)
+}
+
+func TestPushLogs(t *testing.T) {
+	cfg := createDefaultConfig().(*Config)
+	exp, client := newTestExporter(t, cfg, cfg.Logs)
+
+	ld := plog.NewLogs()
+	ld.ResourceLogs().AppendEmpty().ScopeLogs().AppendEmpty().LogRecords().AppendEmpty().Body().SetStr("log")
+	require.NoError(t, exp.pushLogs(context.Background(), ld))
+
+	require.Len(t, client.entries, 1)
+	assert.Equal(t, "otlp_logs", client.entries[0].stream)
+	got, err := (&plog.ProtoUnmarshaler{}).UnmarshalLogs(client.entries[0].payload)
+	require.NoError(t, err)
+	assert.Equal(t, ld, got)
+
+	client.err = errors.New("OOM command not allowed when used memory > 'maxmemory'")
+	assert.EqualError(t, exp.pushLogs(context.Background(), ld), `failed to add the entry to the stream "otlp_logs": OOM command not allowed when used memory > 'maxmemory'`)
+}
@@ -21,6 +21,7 @@ func NewFactory() exporter.Factory {
 		metadata.Type,
 		createDefaultConfig,
 		exporter.WithTraces(createTracesExporter, metadata.TracesStability),
+		exporter.WithLogs(createLogsExporter, metadata.LogsStability),
 	)
 }
 
@@ -42,3 +43,16 @@ func createTracesExporter(
 	exp, err := createSentryExporter(sentryConfig, params)
 	return exp, err
 }
+
+func createLogsExporter(
+	_ context.Context,
+	params exporter.CreateSettings,
+	config component.Config,
+) (exporter.Logs, error) {
+	sentryConfig, ok := config.(*Config)
+	if !ok {
+		return nil, fmt.Errorf("unexpected config type: %T", config)
+	}
+
+	return createSentryLogsExporter(sentryConfig, params)
+}
@@ -33,6 +33,10 @@ func TestCreateExporter(t *testing.T) {
 	assert.NoError(t, err)
 	assert.NotNil(t, te, "failed to create trace exporter")
 
+	le, err := factory.CreateLogsExporter(context.Background(), params, eCfg)
+	assert.NoError(t, err)
+	assert.NotNil(t, le, "failed to create logs exporter")
+
 	me, err := factory.CreateMetricsExporter(context.Background(), params, eCfg)
 	assert.Error(t, err)
 	assert.Nil(t, me)
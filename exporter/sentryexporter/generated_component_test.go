@@ -35,6 +35,13 @@ func TestComponentLifecycle(t *testing.T) {
 		createFn func(ctx context.Context, set exporter.CreateSettings, cfg component.Config) (component.Component, error)
 	}{
 
+		{
+			name: "logs",
+			createFn: func(ctx context.Context, set exporter.CreateSettings, cfg component.Config) (component.Component, error) {
+				return factory.CreateLogsExporter(ctx, set, cfg)
+			},
+		},
+
 		{
 			name: "traces",
 			createFn: func(ctx context.Context, set exporter.CreateSettings, cfg component.Config) (component.Component, error) {
@@ -479,8 +479,8 @@ func generateEventID() sentry.EventID {
 	return sentry.EventID(uuid())
 }
 
-// createSentryExporter returns a new Sentry Exporter.
-func createSentryExporter(config *Config, set exporter.CreateSettings) (exporter.Traces, error) {
+// newSentryExporter returns a new Sentry Exporter sending the events with a configured transport.
+func newSentryExporter(config *Config) *SentryExporter {
 	transport := newSentryTransport()
 
 	clientOptions := sentry.ClientOptions{
@@ -494,24 +494,47 @@ func createSentryExporter(config *Config, set exporter.CreateSettings) (exporter
 
 	transport.Configure(clientOptions)
 
-	s := &SentryExporter{
+	return &SentryExporter{
 		transport:   transport,
 		environment: config.Environment,
 	}
+}
+
+// shutdown flushes the events buffered by the transport.
+func (s *SentryExporter) shutdown(set exporter.CreateSettings) func(context.Context) error {
+	return func(ctx context.Context) error {
+		allEventsFlushed := s.transport.Flush(ctx)
+
+		if !allEventsFlushed {
+			set.Logger.Warn("Could not flush all events, reached timeout")
+		}
+
+		return nil
+	}
+}
+
+// createSentryExporter returns a new Sentry Exporter.
+func createSentryExporter(config *Config, set exporter.CreateSettings) (exporter.Traces, error) {
+	s := newSentryExporter(config)
 
 	return exporterhelper.NewTracesExporter(
 		context.TODO(),
 		set,
 		config,
 		s.pushTraceData,
-		exporterhelper.WithShutdown(func(ctx context.Context) error {
-			allEventsFlushed := transport.Flush(ctx)
+		exporterhelper.WithShutdown(s.shutdown(set)),
+	)
+}
 
-			if !allEventsFlushed {
-				set.Logger.Warn("Could not flush all events, reached timeout")
-			}
+// createSentryLogsExporter returns a new Sentry Exporter sending the error log records as Sentry events.
+func createSentryLogsExporter(config *Config, set exporter.CreateSettings) (exporter.Logs, error) {
+	s := newSentryExporter(config)
 
-			return nil
-		}),
+	return exporterhelper.NewLogsExporter(
+		context.TODO(),
+		set,
+		config,
+		s.pushLogData,
+		exporterhelper.WithShutdown(s.shutdown(set)),
 	)
 }
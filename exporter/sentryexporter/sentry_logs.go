@@ -0,0 +1,246 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package sentryexporter // import "github.com/open-telemetry/opentelemetry-collector-contrib/exporter/sentryexporter"
+
+import (
+	"context"
+	"path"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/getsentry/sentry-go"
+	"go.opentelemetry.io/collector/pdata/pcommon"
+	"go.opentelemetry.io/collector/pdata/plog"
+	conventions "go.opentelemetry.io/collector/semconv/v1.6.1"
+)
+
+// maxBreadcrumbs is the maximum number of breadcrumbs attached to an event, as in the Sentry SDKs.
+const maxBreadcrumbs = 100
+
+var (
+	// javaFrameRegexp matches a frame such as "at com.example.Foo.bar(Foo.java:12)".
+	javaFrameRegexp = regexp.MustCompile(`^\s*at\s+(?:(.+)\.)?([^.(]+)\(([^:)]*)(?::(\d+))?\)`)
+	// pythonFrameRegexp matches a frame such as `File "/app/main.py", line 12, in handler`.
+	pythonFrameRegexp = regexp.MustCompile(`^\s*File "([^"]+)", line (\d+), in (.+)$`)
+	// goFunctionRegexp and goFileRegexp match the two lines of a Go frame such as
+	// "main.handler(...)" followed by "\t/app/main.go:12 +0x1d".
+	goFunctionRegexp = regexp.MustCompile(`^(\S+)\(.*\)$`)
+	goFileRegexp     = regexp.MustCompile(`^\s+(\S+\.go):(\d+)(?: \+0x[0-9a-f]+)?$`)
+)
+
+// pushLogData takes incoming OpenTelemetry logs, converts the error log records into Sentry events
+// and sends them using Sentry's transport. The other log records are attached as breadcrumbs to the
+// events of the same trace that follow them.
+func (s *SentryExporter) pushLogData(_ context.Context, ld plog.Logs) error {
+	var events []*sentry.Event
+
+	resourceLogs := ld.ResourceLogs()
+	for i := 0; i < resourceLogs.Len(); i++ {
+		rl := resourceLogs.At(i)
+		resourceTags := generateTagsFromResource(rl.Resource())
+		// Maps a trace ID to the breadcrumbs of its log records, the records without trace share the empty ID.
+		breadcrumbs := make(map[pcommon.TraceID][]*sentry.Breadcrumb)
+
+		scopeLogs := rl.ScopeLogs()
+		for j := 0; j < scopeLogs.Len(); j++ {
+			scope := scopeLogs.At(j).Scope()
+
+			logRecords := scopeLogs.At(j).LogRecords()
+			for k := 0; k < logRecords.Len(); k++ {
+				record := logRecords.At(k)
+				traceID := record.TraceID()
+
+				if !isErrorLogRecord(record) {
+					crumbs := append(breadcrumbs[traceID], breadcrumbFromLogRecord(record, scope))
+					if len(crumbs) > maxBreadcrumbs {
+						crumbs = crumbs[1:]
+					}
+					breadcrumbs[traceID] = crumbs
+					continue
+				}
+
+				event := sentryEventFromLogRecord(record, scope, resourceTags, s.environment)
+				event.Breadcrumbs = append([]*sentry.Breadcrumb(nil), breadcrumbs[traceID]...)
+				events = append(events, event)
+			}
+		}
+	}
+
+	if len(events) == 0 {
+		return nil
+	}
+
+	s.transport.SendEvents(events)
+
+	return nil
+}
+
+// isErrorLogRecord determines if a log record should be sent to Sentry as an error event:
+// its severity is at least error, or it describes an exception.
+func isErrorLogRecord(record plog.LogRecord) bool {
+	if record.SeverityNumber() >= plog.SeverityNumberError {
+		return true
+	}
+	attributes := record.Attributes()
+	_, hasType := attributes.Get(conventions.AttributeExceptionType)
+	_, hasMessage := attributes.Get(conventions.AttributeExceptionMessage)
+	return hasType || hasMessage
+}
+
+// sentryEventFromLogRecord creates a Sentry error event from a log record.
+func sentryEventFromLogRecord(record plog.LogRecord, scope pcommon.InstrumentationScope, resourceTags map[string]string, environment string) *sentry.Event {
+	event := sentry.NewEvent()
+	event.EventID = generateEventID()
+
+	event.Level = levelFromSeverity(record.SeverityNumber())
+	if event.Level != sentry.LevelFatal {
+		event.Level = sentry.LevelError
+	}
+	event.Message = record.Body().AsString()
+	event.Logger = scope.Name()
+	event.Timestamp = logRecordTime(record)
+
+	var exceptionType, exceptionMessage, exceptionStacktrace string
+	tags := make(map[string]string, len(resourceTags))
+	for k, v := range generateTagsFromAttributes(record.Attributes()) {
+		switch k {
+		case conventions.AttributeExceptionType:
+			exceptionType = v
+		case conventions.AttributeExceptionMessage:
+			exceptionMessage = v
+		case conventions.AttributeExceptionStacktrace:
+			exceptionStacktrace = v
+		default:
+			tags[k] = v
+		}
+	}
+	for k, v := range resourceTags {
+		tags[k] = v
+	}
+	tags["library_name"] = scope.Name()
+	tags["library_version"] = scope.Version()
+	event.Tags = tags
+
+	if exceptionType != "" || exceptionMessage != "" {
+		exception := sentry.Exception{
+			Type:  exceptionType,
+			Value: exceptionMessage,
+		}
+		exception.Stacktrace = stacktraceFromString(exceptionStacktrace)
+		event.Exception = []sentry.Exception{exception}
+		if event.Message == "" {
+			event.Message = exceptionMessage
+		}
+	}
+	if exceptionStacktrace != "" && (len(event.Exception) == 0 || event.Exception[0].Stacktrace == nil) {
+		// Keep the stack trace when it couldn't be parsed into frames.
+		event.Extra[conventions.AttributeExceptionStacktrace] = exceptionStacktrace
+	}
+
+	if traceID := record.TraceID(); !traceID.IsEmpty() {
+		event.Contexts["trace"] = sentry.TraceContext{
+			TraceID: sentry.TraceID(traceID),
+			SpanID:  sentry.SpanID(record.SpanID()),
+		}.Map()
+	}
+
+	event.Sdk.Name = otelSentryExporterName
+	event.Sdk.Version = otelSentryExporterVersion
+
+	if environment != "" {
+		event.Environment = environment
+	}
+
+	return event
+}
+
+// breadcrumbFromLogRecord creates a Sentry breadcrumb from a log record.
+func breadcrumbFromLogRecord(record plog.LogRecord, scope pcommon.InstrumentationScope) *sentry.Breadcrumb {
+	breadcrumb := &sentry.Breadcrumb{
+		Type:      "default",
+		Category:  scope.Name(),
+		Message:   record.Body().AsString(),
+		Level:     levelFromSeverity(record.SeverityNumber()),
+		Timestamp: logRecordTime(record),
+	}
+	if record.Attributes().Len() > 0 {
+		breadcrumb.Data = record.Attributes().AsRaw()
+	}
+	return breadcrumb
+}
+
+// levelFromSeverity maps the severity of a log record to a Sentry level.
+func levelFromSeverity(severity plog.SeverityNumber) sentry.Level {
+	switch {
+	case severity >= plog.SeverityNumberFatal:
+		return sentry.LevelFatal
+	case severity >= plog.SeverityNumberError:
+		return sentry.LevelError
+	case severity >= plog.SeverityNumberWarn:
+		return sentry.LevelWarning
+	case severity >= plog.SeverityNumberInfo, severity == plog.SeverityNumberUnspecified:
+		return sentry.LevelInfo
+	default:
+		return sentry.LevelDebug
+	}
+}
+
+// logRecordTime returns the time of the log record, or the time it was observed when it is not set.
+func logRecordTime(record plog.LogRecord) time.Time {
+	if record.Timestamp() != 0 {
+		return unixNanoToTime(record.Timestamp())
+	}
+	return unixNanoToTime(record.ObservedTimestamp())
+}
+
+// stacktraceFromString parses the frames of a Java, Python or Go stack trace, as found in the
+// exception.stacktrace attribute. It returns nil when no frame is recognized.
+//
+// Sentry expects the frames ordered from the outermost caller to the innermost callee, as in
+// Python stack traces. Java and Go stack traces are in the reverse order.
+func stacktraceFromString(stacktrace string) *sentry.Stacktrace {
+	var frames []sentry.Frame
+	calleeFirst := true
+	var goFunction string
+
+	for _, line := range strings.Split(stacktrace, "\n") {
+		line = strings.TrimRight(line, "\r")
+
+		if m := pythonFrameRegexp.FindStringSubmatch(line); m != nil {
+			calleeFirst = false
+			lineno, _ := strconv.Atoi(m[2])
+			frames = append(frames, sentry.Frame{Function: m[3], Filename: m[1], AbsPath: m[1], Lineno: lineno})
+			continue
+		}
+		if m := javaFrameRegexp.FindStringSubmatch(line); m != nil {
+			lineno, _ := strconv.Atoi(m[4])
+			frames = append(frames, sentry.Frame{Module: m[1], Function: m[2], Filename: m[3], Lineno: lineno})
+			continue
+		}
+		if goFunction != "" {
+			if m := goFileRegexp.FindStringSubmatch(line); m != nil {
+				lineno, _ := strconv.Atoi(m[2])
+				frames = append(frames, sentry.Frame{Function: goFunction, Filename: path.Base(m[1]), AbsPath: m[1], Lineno: lineno})
+				goFunction = ""
+				continue
+			}
+		}
+		goFunction = ""
+		if m := goFunctionRegexp.FindStringSubmatch(line); m != nil {
+			goFunction = m[1]
+		}
+	}
+
+	if len(frames) == 0 {
+		return nil
+	}
+	if calleeFirst {
+		for i, j := 0, len(frames)-1; i < j; i, j = i+1, j-1 {
+			frames[i], frames[j] = frames[j], frames[i]
+		}
+	}
+	return &sentry.Stacktrace{Frames: frames}
+}
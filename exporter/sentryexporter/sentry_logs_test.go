@@ -0,0 +1,169 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package sentryexporter
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/getsentry/sentry-go"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/collector/pdata/pcommon"
+	"go.opentelemetry.io/collector/pdata/plog"
+	conventions "go.opentelemetry.io/collector/semconv/v1.6.1"
+)
+
+func TestPushLogData(t *testing.T) {
+	ts := time.Date(2024, 6, 1, 12, 0, 0, 0, time.UTC)
+	traceID := pcommon.TraceID([16]byte{1, 2, 3, 4, 5, 6, 7, 8, 9, 10, 11, 12, 13, 14, 15, 16})
+	spanID := pcommon.SpanID([8]byte{1, 2, 3, 4, 5, 6, 7, 8})
+
+	logs := plog.NewLogs()
+	rl := logs.ResourceLogs().AppendEmpty()
+	rl.Resource().Attributes().PutStr(conventions.AttributeServiceName, "checkout")
+	sl := rl.ScopeLogs().AppendEmpty()
+	sl.Scope().SetName("checkout.handler")
+	sl.Scope().SetVersion("1.0.0")
+
+	info := sl.LogRecords().AppendEmpty()
+	info.SetTimestamp(pcommon.NewTimestampFromTime(ts))
+	info.SetSeverityNumber(plog.SeverityNumberInfo)
+	info.Body().SetStr("loading cart")
+	info.Attributes().PutStr("cart.id", "42")
+	info.SetTraceID(traceID)
+
+	otherTrace := sl.LogRecords().AppendEmpty()
+	otherTrace.SetSeverityNumber(plog.SeverityNumberWarn)
+	otherTrace.Body().SetStr("unrelated")
+
+	exception := sl.LogRecords().AppendEmpty()
+	exception.SetTimestamp(pcommon.NewTimestampFromTime(ts.Add(time.Second)))
+	exception.SetSeverityNumber(plog.SeverityNumberWarn)
+	exception.Attributes().PutStr(conventions.AttributeExceptionType, "java.lang.IllegalStateException")
+	exception.Attributes().PutStr(conventions.AttributeExceptionMessage, "cart is empty")
+	exception.Attributes().PutStr(conventions.AttributeExceptionStacktrace, "java.lang.IllegalStateException: cart is empty\n\tat com.example.Cart.checkout(Cart.java:12)\n\tat com.example.Main.main(Main.java:5)")
+	exception.Attributes().PutStr("user.id", "u1")
+	exception.SetTraceID(traceID)
+	exception.SetSpanID(spanID)
+
+	fatal := sl.LogRecords().AppendEmpty()
+	fatal.SetObservedTimestamp(pcommon.NewTimestampFromTime(ts.Add(2 * time.Second)))
+	fatal.SetSeverityNumber(plog.SeverityNumberFatal)
+	fatal.Body().SetStr("out of memory")
+
+	transport := &mockTransport{}
+	s := &SentryExporter{transport: transport, environment: "production"}
+	require.NoError(t, s.pushLogData(context.Background(), logs))
+	require.True(t, transport.called)
+	require.Len(t, transport.transactions, 2)
+
+	event := transport.transactions[0]
+	assert.Equal(t, sentry.LevelError, event.Level)
+	assert.Equal(t, "cart is empty", event.Message)
+	assert.Equal(t, "checkout.handler", event.Logger)
+	assert.Equal(t, ts.Add(time.Second), event.Timestamp)
+	assert.Equal(t, "production", event.Environment)
+	assert.Equal(t, map[string]string{
+		conventions.AttributeServiceName: "checkout",
+		"user.id":                        "u1",
+		"library_name":                   "checkout.handler",
+		"library_version":                "1.0.0",
+	}, event.Tags)
+	assert.Equal(t, []sentry.Exception{{
+		Type:  "java.lang.IllegalStateException",
+		Value: "cart is empty",
+		Stacktrace: &sentry.Stacktrace{Frames: []sentry.Frame{
+			{Module: "com.example.Main", Function: "main", Filename: "Main.java", Lineno: 5},
+			{Module: "com.example.Cart", Function: "checkout", Filename: "Cart.java", Lineno: 12},
+		}},
+	}}, event.Exception)
+	assert.Equal(t, sentry.TraceContext{TraceID: sentry.TraceID(traceID), SpanID: sentry.SpanID(spanID)}.Map(), event.Contexts["trace"])
+	assert.Equal(t, []*sentry.Breadcrumb{{
+		Type:      "default",
+		Category:  "checkout.handler",
+		Message:   "loading cart",
+		Level:     sentry.LevelInfo,
+		Data:      map[string]any{"cart.id": "42"},
+		Timestamp: ts,
+	}}, event.Breadcrumbs)
+
+	event = transport.transactions[1]
+	assert.Equal(t, sentry.LevelFatal, event.Level)
+	assert.Equal(t, "out of memory", event.Message)
+	assert.Equal(t, ts.Add(2*time.Second), event.Timestamp)
+	assert.Empty(t, event.Exception)
+	assert.NotContains(t, event.Contexts, "trace")
+	require.Len(t, event.Breadcrumbs, 1)
+	assert.Equal(t, "unrelated", event.Breadcrumbs[0].Message)
+	assert.Equal(t, sentry.LevelWarning, event.Breadcrumbs[0].Level)
+}
+
+func TestPushLogDataWithoutErrors(t *testing.T) {
+	logs := plog.NewLogs()
+	record := logs.ResourceLogs().AppendEmpty().ScopeLogs().AppendEmpty().LogRecords().AppendEmpty()
+	record.SetSeverityNumber(plog.SeverityNumberWarn)
+	record.Body().SetStr("slow request")
+
+	transport := &mockTransport{}
+	s := &SentryExporter{transport: transport}
+	require.NoError(t, s.pushLogData(context.Background(), logs))
+	assert.False(t, transport.called)
+}
+
+func TestUnparsedStacktraceInExtra(t *testing.T) {
+	record := plog.NewLogRecord()
+	record.SetSeverityNumber(plog.SeverityNumberError)
+	record.Attributes().PutStr(conventions.AttributeExceptionMessage, "boom")
+	record.Attributes().PutStr(conventions.AttributeExceptionStacktrace, "no frames here")
+
+	event := sentryEventFromLogRecord(record, pcommon.NewInstrumentationScope(), nil, "")
+	require.Len(t, event.Exception, 1)
+	assert.Nil(t, event.Exception[0].Stacktrace)
+	assert.Equal(t, "no frames here", event.Extra[conventions.AttributeExceptionStacktrace])
+}
+
+func TestStacktraceFromString(t *testing.T) {
+	tests := []struct {
+		name       string
+		stacktrace string
+		expected   *sentry.Stacktrace
+	}{
+		{
+			name:       "java",
+			stacktrace: "java.lang.NullPointerException\n\tat com.example.Foo.bar(Foo.java:12)\n\tat com.example.Main.main(Main.java:5)\n\tat java.base/jdk.internal.reflect.NativeMethodAccessorImpl.invoke0(Native Method)",
+			expected: &sentry.Stacktrace{Frames: []sentry.Frame{
+				{Module: "java.base/jdk.internal.reflect.NativeMethodAccessorImpl", Function: "invoke0", Filename: "Native Method"},
+				{Module: "com.example.Main", Function: "main", Filename: "Main.java", Lineno: 5},
+				{Module: "com.example.Foo", Function: "bar", Filename: "Foo.java", Lineno: 12},
+			}},
+		},
+		{
+			name:       "python",
+			stacktrace: "Traceback (most recent call last):\n  File \"/app/main.py\", line 8, in <module>\n    handler()\n  File \"/app/handler.py\", line 3, in handler\n    raise ValueError()\nValueError",
+			expected: &sentry.Stacktrace{Frames: []sentry.Frame{
+				{Function: "<module>", Filename: "/app/main.py", AbsPath: "/app/main.py", Lineno: 8},
+				{Function: "handler", Filename: "/app/handler.py", AbsPath: "/app/handler.py", Lineno: 3},
+			}},
+		},
+		{
+			name:       "go",
+			stacktrace: "goroutine 1 [running]:\nmain.handler(0x1)\n\t/app/handler.go:12 +0x1d\nmain.main()\n\t/app/main.go:5 +0x25\nexit status 2",
+			expected: &sentry.Stacktrace{Frames: []sentry.Frame{
+				{Function: "main.main", Filename: "main.go", AbsPath: "/app/main.go", Lineno: 5},
+				{Function: "main.handler", Filename: "handler.go", AbsPath: "/app/handler.go", Lineno: 12},
+			}},
+		},
+		{
+			name:       "unknown",
+			stacktrace: "something went wrong",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.expected, stacktraceFromString(tt.stacktrace))
+		})
+	}
+}
@@ -0,0 +1,201 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package splunkhecexporter // import "github.com/open-telemetry/opentelemetry-collector-contrib/exporter/splunkhecexporter"
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strconv"
+	"sync"
+	"time"
+
+	"go.uber.org/zap"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/internal/splunk"
+)
+
+var errAckPollerStopped = errors.New("stopped waiting for the indexer acknowledgement, the exporter is shutting down")
+
+// hecResponse is the response of the HEC to the events sent. AckID is only set when the indexer
+// acknowledgement is enabled on the token.
+type hecResponse struct {
+	Text  string  `json:"text"`
+	Code  int     `json:"code"`
+	AckID *uint64 `json:"ackId"`
+}
+
+type ackRequest struct {
+	Acks []uint64 `json:"acks"`
+}
+
+type ackResponse struct {
+	Acks map[string]bool `json:"acks"`
+}
+
+// ackPoller waits for the indexer acknowledgement of the events sent on a HEC channel. The ack IDs of all
+// the pending requests are queried at once, at each poll interval.
+type ackPoller struct {
+	url          *url.URL
+	client       *http.Client
+	headers      map[string]string
+	pollInterval time.Duration
+	timeout      time.Duration
+	logger       *zap.Logger
+
+	mu      sync.Mutex
+	pending map[uint64]chan struct{}
+
+	ctx    context.Context
+	cancel context.CancelFunc
+	wg     sync.WaitGroup
+}
+
+// newAckPoller returns a poller of the Ack API of the HEC at hecURL, for the channel set in the headers.
+func newAckPoller(hecURL *url.URL, client *http.Client, headers map[string]string, cfg HecAck, logger *zap.Logger) *ackPoller {
+	ackURL := *hecURL
+	ackURL.Path = cfg.Path
+	ackURL.RawQuery = url.Values{"channel": {headers[splunk.HTTPSplunkChannelHeader]}}.Encode()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	return &ackPoller{
+		url:          &ackURL,
+		client:       client,
+		headers:      headers,
+		pollInterval: cfg.PollInterval,
+		timeout:      cfg.Timeout,
+		logger:       logger,
+		pending:      map[uint64]chan struct{}{},
+		ctx:          ctx,
+		cancel:       cancel,
+	}
+}
+
+func (p *ackPoller) start() {
+	p.wg.Add(1)
+	go p.run()
+}
+
+func (p *ackPoller) shutdown() {
+	p.cancel()
+	p.wg.Wait()
+}
+
+func (p *ackPoller) run() {
+	defer p.wg.Done()
+	ticker := time.NewTicker(p.pollInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-p.ctx.Done():
+			return
+		case <-ticker.C:
+			p.poll()
+		}
+	}
+}
+
+// wait blocks until the events of the ack ID are acknowledged. It returns an error when they are not
+// acknowledged before the timeout, so that the request is retried.
+func (p *ackPoller) wait(ctx context.Context, ackID uint64) error {
+	acked := make(chan struct{})
+	p.mu.Lock()
+	p.pending[ackID] = acked
+	p.mu.Unlock()
+
+	timer := time.NewTimer(p.timeout)
+	defer timer.Stop()
+
+	var err error
+	select {
+	case <-acked:
+		return nil
+	case <-timer.C:
+		err = fmt.Errorf("events of ackId %d were not acknowledged by the indexers after %s", ackID, p.timeout)
+	case <-ctx.Done():
+		err = ctx.Err()
+	case <-p.ctx.Done():
+		err = errAckPollerStopped
+	}
+
+	p.mu.Lock()
+	delete(p.pending, ackID)
+	p.mu.Unlock()
+	return err
+}
+
+func (p *ackPoller) poll() {
+	p.mu.Lock()
+	ackIDs := make([]uint64, 0, len(p.pending))
+	for ackID := range p.pending {
+		ackIDs = append(ackIDs, ackID)
+	}
+	p.mu.Unlock()
+	if len(ackIDs) == 0 {
+		return
+	}
+
+	acked, err := p.query(ackIDs)
+	if err != nil {
+		p.logger.Warn("Failed to query the indexer acknowledgements", zap.Error(err), zap.String("host", p.url.Host))
+		return
+	}
+
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	for _, ackID := range acked {
+		if ch, ok := p.pending[ackID]; ok {
+			close(ch)
+			delete(p.pending, ackID)
+		}
+	}
+}
+
+// query returns the ack IDs the indexers acknowledged.
+func (p *ackPoller) query(ackIDs []uint64) ([]uint64, error) {
+	body, err := json.Marshal(ackRequest{Acks: ackIDs})
+	if err != nil {
+		return nil, err
+	}
+	req, err := http.NewRequestWithContext(p.ctx, http.MethodPost, p.url.String(), bytes.NewReader(body))
+	if err != nil {
+		return nil, err
+	}
+	for k, v := range p.headers {
+		req.Header.Set(k, v)
+	}
+
+	resp, err := p.client.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	if err = splunk.HandleHTTPCode(resp); err != nil {
+		_, _ = io.Copy(io.Discard, resp.Body)
+		return nil, err
+	}
+
+	var ackResp ackResponse
+	if err = json.NewDecoder(resp.Body).Decode(&ackResp); err != nil {
+		return nil, fmt.Errorf("failed to decode the Ack API response: %w", err)
+	}
+	var acked []uint64
+	for key, ok := range ackResp.Acks {
+		if !ok {
+			continue
+		}
+		ackID, err := strconv.ParseUint(key, 10, 64)
+		if err != nil {
+			return nil, fmt.Errorf("invalid ackId %q in the Ack API response: %w", key, err)
+		}
+		acked = append(acked, ackID)
+	}
+	return acked, nil
+}
@@ -0,0 +1,136 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package splunkhecexporter
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strconv"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/collector/component/componenttest"
+	"go.opentelemetry.io/collector/exporter/exportertest"
+	"go.uber.org/zap"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/internal/splunk"
+)
+
+// ackHEC is a HEC returning an ackId for each request, which is acknowledged if ack is true.
+type ackHEC struct {
+	ack      bool
+	noAckID  bool
+	mu       sync.Mutex
+	nextID   uint64
+	channels []string
+	queried  []uint64
+}
+
+func (h *ackHEC) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	switch r.URL.Path {
+	case "/services/collector":
+		h.channels = append(h.channels, r.Header.Get(splunk.HTTPSplunkChannelHeader))
+		if h.noAckID {
+			_, _ = w.Write([]byte(`{"text":"Success","code":0}`))
+			return
+		}
+		_, _ = fmt.Fprintf(w, `{"text":"Success","code":0,"ackId":%d}`, h.nextID)
+		h.nextID++
+	case "/services/collector/ack":
+		h.channels = append(h.channels, r.URL.Query().Get("channel"))
+		var req ackRequest
+		if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		resp := ackResponse{Acks: map[string]bool{}}
+		for _, ackID := range req.Acks {
+			h.queried = append(h.queried, ackID)
+			resp.Acks[strconv.FormatUint(ackID, 10)] = h.ack
+		}
+		_ = json.NewEncoder(w).Encode(resp)
+	default:
+		w.WriteHeader(http.StatusNotFound)
+	}
+}
+
+func startAckClient(t *testing.T, hec *ackHEC, timeout time.Duration) *client {
+	server := httptest.NewServer(hec)
+	t.Cleanup(server.Close)
+
+	cfg := NewFactory().CreateDefaultConfig().(*Config)
+	cfg.ClientConfig.Endpoint = server.URL
+	cfg.Token = "token"
+	cfg.Ack.Enabled = true
+	cfg.Ack.PollInterval = 10 * time.Millisecond
+	cfg.Ack.Timeout = timeout
+
+	c := newLogsClient(exportertest.NewNopCreateSettings(), cfg)
+	require.NoError(t, c.start(context.Background(), componenttest.NewNopHost()))
+	t.Cleanup(func() { assert.NoError(t, c.stop(context.Background())) })
+	return c
+}
+
+func TestAckAcknowledged(t *testing.T) {
+	hec := &ackHEC{ack: true}
+	c := startAckClient(t, hec, time.Minute)
+
+	require.NoError(t, c.pushLogData(context.Background(), createLogData(1, 1, 1)))
+	require.NoError(t, c.pushLogData(context.Background(), createLogData(1, 1, 1)))
+
+	hec.mu.Lock()
+	defer hec.mu.Unlock()
+	assert.Equal(t, []uint64{0, 1}, hec.queried)
+	require.NotEmpty(t, hec.channels)
+	for _, channel := range hec.channels {
+		assert.Equal(t, hec.channels[0], channel, "all the requests must use the same channel")
+	}
+	assert.NotEmpty(t, hec.channels[0])
+}
+
+func TestAckTimeout(t *testing.T) {
+	hec := &ackHEC{ack: false}
+	c := startAckClient(t, hec, 50*time.Millisecond)
+
+	err := c.pushLogData(context.Background(), createLogData(1, 1, 1))
+	require.ErrorContains(t, err, "events of ackId 0 were not acknowledged by the indexers after 50ms")
+	assert.Empty(t, c.ackPoller.pending)
+}
+
+func TestAckMissingAckID(t *testing.T) {
+	hec := &ackHEC{noAckID: true}
+	c := startAckClient(t, hec, time.Minute)
+
+	require.NoError(t, c.pushLogData(context.Background(), createLogData(1, 1, 1)))
+}
+
+func TestAckPollerShutdown(t *testing.T) {
+	headers := map[string]string{splunk.HTTPSplunkChannelHeader: "channel"}
+	cfg := HecAck{Path: splunk.DefaultAckPath, PollInterval: time.Hour, Timeout: time.Hour}
+	p := newAckPoller(&url.URL{Scheme: "http", Host: "splunk"}, http.DefaultClient, headers, cfg, zap.NewNop())
+	assert.Equal(t, "http://splunk/services/collector/ack?channel=channel", p.url.String())
+	p.start()
+
+	errs := make(chan error)
+	go func() {
+		errs <- p.wait(context.Background(), 1)
+	}()
+	require.Eventually(t, func() bool {
+		p.mu.Lock()
+		defer p.mu.Unlock()
+		return len(p.pending) == 1
+	}, time.Second, time.Millisecond)
+
+	p.shutdown()
+	assert.ErrorIs(t, <-errs, errAckPollerStopped)
+}
@@ -12,6 +12,7 @@ import (
 	"net/url"
 	"sync"
 
+	"github.com/google/uuid"
 	jsoniter "github.com/json-iterator/go"
 	"go.opentelemetry.io/collector/component"
 	"go.opentelemetry.io/collector/consumer/consumererror"
@@ -51,6 +52,7 @@ type client struct {
 	hecWorker         hecWorker
 	buildInfo         component.BuildInfo
 	heartbeater       *heartbeater
+	ackPoller         *ackPoller
 	bufferPool        bufferPool
 	exporterName      string
 }
@@ -613,6 +615,9 @@ func (c *client) stop(context.Context) error {
 	if c.heartbeater != nil {
 		c.heartbeater.shutdown()
 	}
+	if c.ackPoller != nil {
+		c.ackPoller.shutdown()
+	}
 	return nil
 }
 
@@ -631,7 +636,14 @@ func (c *client) start(ctx context.Context, host component.Host) (err error) {
 		}
 	}
 	url, _ := c.config.getURL()
-	c.hecWorker = &defaultHecWorker{url, httpClient, buildHTTPHeaders(c.config, c.buildInfo), c.logger}
+	headers := buildHTTPHeaders(c.config, c.buildInfo)
+	if c.config.Ack.Enabled {
+		// the indexer acknowledgement requires the requests to be sent on a channel
+		headers[splunk.HTTPSplunkChannelHeader] = uuid.NewString()
+		c.ackPoller = newAckPoller(url, httpClient, headers, c.config.Ack, c.logger)
+		c.ackPoller.start()
+	}
+	c.hecWorker = &defaultHecWorker{url, httpClient, headers, c.logger, c.ackPoller}
 	c.heartbeater = newHeartbeater(c.config, c.buildInfo, getPushLogFn(c))
 	if c.config.Heartbeat.Startup {
 		if err := c.heartbeater.sendHeartbeat(c.config, c.buildInfo, getPushLogFn(c)); err != nil {
@@ -51,6 +51,23 @@ type HecHeartbeat struct {
 	Startup bool `mapstructure:"startup"`
 }
 
+// HecAck defines the indexer acknowledgement configuration for the exporter
+type HecAck struct {
+	// Enabled waits for the events of each request to be acknowledged by the indexers before completing the export.
+	// Indexer acknowledgement must be enabled on the HEC token.
+	Enabled bool `mapstructure:"enabled"`
+
+	// Path for Ack API, default is '/services/collector/ack'.
+	Path string `mapstructure:"path"`
+
+	// PollInterval is the interval between two queries of the Ack API, default is 1s.
+	PollInterval time.Duration `mapstructure:"poll_interval"`
+
+	// Timeout is the maximum time to wait for the events of a request to be acknowledged before the request
+	// is retried, default is 1m.
+	Timeout time.Duration `mapstructure:"timeout"`
+}
+
 // HecTelemetry defines the telemetry configuration for the exporter
 type HecTelemetry struct {
 	// Enabled is the bool to enable telemetry inside splunk hec exporter
@@ -136,6 +153,9 @@ type Config struct {
 	// Heartbeat is the configuration to enable heartbeat
 	Heartbeat HecHeartbeat `mapstructure:"heartbeat"`
 
+	// Ack is the configuration of the indexer acknowledgement
+	Ack HecAck `mapstructure:"ack"`
+
 	// Telemetry is the configuration for splunk hec exporter telemetry
 	Telemetry HecTelemetry `mapstructure:"telemetry"`
 }
@@ -185,5 +205,14 @@ func (cfg *Config) Validate() error {
 		return fmt.Errorf(`requires "max_event_size" <= %d`, maxMaxEventSize)
 	}
 
+	if cfg.Ack.Enabled {
+		if cfg.Ack.PollInterval <= 0 {
+			return errors.New(`requires "ack.poll_interval" > 0`)
+		}
+		if cfg.Ack.Timeout <= 0 {
+			return errors.New(`requires "ack.timeout" > 0`)
+		}
+	}
+
 	return nil
 }
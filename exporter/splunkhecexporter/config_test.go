@@ -116,6 +116,12 @@ func TestLoadConfig(t *testing.T) {
 				Heartbeat: HecHeartbeat{
 					Interval: 30 * time.Second,
 				},
+				Ack: HecAck{
+					Enabled:      true,
+					Path:         "/services/collector/ack",
+					PollInterval: 5 * time.Second,
+					Timeout:      time.Minute,
+				},
 				Telemetry: HecTelemetry{
 					Enabled: true,
 					OverrideMetricsNames: map[string]string{
@@ -231,6 +237,30 @@ func TestConfig_Validate(t *testing.T) {
 			}(),
 			wantErr: "queue size must be positive",
 		},
+		{
+			name: "ack without poll interval",
+			cfg: func() *Config {
+				cfg := createDefaultConfig().(*Config)
+				cfg.ClientConfig.Endpoint = "http://foo_bar.com"
+				cfg.Token = "foo"
+				cfg.Ack.Enabled = true
+				cfg.Ack.PollInterval = 0
+				return cfg
+			}(),
+			wantErr: "requires \"ack.poll_interval\" > 0",
+		},
+		{
+			name: "ack without timeout",
+			cfg: func() *Config {
+				cfg := createDefaultConfig().(*Config)
+				cfg.ClientConfig.Endpoint = "http://foo_bar.com"
+				cfg.Token = "foo"
+				cfg.Ack.Enabled = true
+				cfg.Ack.Timeout = 0
+				return cfg
+			}(),
+			wantErr: "requires \"ack.timeout\" > 0",
+		},
 	}
 
 	for _, tt := range tests {
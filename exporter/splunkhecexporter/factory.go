@@ -28,6 +28,8 @@ const (
 	defaultHTTP2PingTimeout     = time.Second * 10
 	defaultIdleConnTimeout      = 10 * time.Second
 	defaultSplunkAppName        = "OpenTelemetry Collector Contrib"
+	defaultAckPollInterval      = time.Second
+	defaultAckTimeout           = time.Minute
 )
 
 // TODO: Find a place for this to be shared.
@@ -97,6 +99,11 @@ func createDefaultConfig() component.Config {
 		HealthPath:            splunk.DefaultHealthPath,
 		HecHealthCheckEnabled: false,
 		ExportRaw:             false,
+		Ack: HecAck{
+			Path:         splunk.DefaultAckPath,
+			PollInterval: defaultAckPollInterval,
+			Timeout:      defaultAckTimeout,
+		},
 		Telemetry: HecTelemetry{
 			Enabled:              false,
 			OverrideMetricsNames: map[string]string{},
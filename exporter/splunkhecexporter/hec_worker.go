@@ -5,6 +5,8 @@ package splunkhecexporter // import "github.com/open-telemetry/opentelemetry-col
 
 import (
 	"context"
+	"encoding/json"
+	"fmt"
 	"net/http"
 	"net/http/httputil"
 	"net/url"
@@ -24,6 +26,8 @@ type defaultHecWorker struct {
 	client  *http.Client
 	headers map[string]string
 	logger  *zap.Logger
+	// ackPoller is nil when the indexer acknowledgement is disabled.
+	ackPoller *ackPoller
 }
 
 func (hec *defaultHecWorker) send(ctx context.Context, buf buffer, headers map[string]string) error {
@@ -62,6 +66,10 @@ func (hec *defaultHecWorker) send(ctx context.Context, buf buffer, headers map[s
 		return err
 	}
 
+	if hec.ackPoller != nil {
+		return hec.waitForAck(ctx, resp)
+	}
+
 	// Do not drain the response when 429 or 502 status code is returned.
 	// HTTP client will not reuse the same connection unless it is drained.
 	// See https://github.com/open-telemetry/opentelemetry-collector-contrib/issues/18281 for more details.
@@ -73,4 +81,17 @@ func (hec *defaultHecWorker) send(ctx context.Context, buf buffer, headers map[s
 	return nil
 }
 
+// waitForAck waits for the indexers to acknowledge the events of the response ack ID.
+func (hec *defaultHecWorker) waitForAck(ctx context.Context, resp *http.Response) error {
+	var hecResp hecResponse
+	if err := json.NewDecoder(resp.Body).Decode(&hecResp); err != nil {
+		return fmt.Errorf("failed to decode the HEC response to get its ackId: %w", err)
+	}
+	if hecResp.AckID == nil {
+		hec.logger.Warn("The HEC response has no ackId, make sure the indexer acknowledgement is enabled on the token", zap.String("host", hec.url.String()))
+		return nil
+	}
+	return hec.ackPoller.wait(ctx, *hecResp.AckID)
+}
+
 var _ hecWorker = &defaultHecWorker{}
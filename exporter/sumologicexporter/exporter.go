@@ -285,9 +285,9 @@ func (se *sumologicexporter) pushLogsData(ctx context.Context, ld plog.Logs) err
 
 	// Follow different execution path for OTLP format
 	if sdr.config.LogFormat == OTLPLogFormat {
-		if err := sdr.sendOTLPLogs(ctx, ld); err != nil {
+		if droppedLogs, err := sdr.sendOTLPLogs(ctx, ld); err != nil {
 			se.handleUnauthorizedErrors(ctx, err)
-			return consumererror.NewLogs(err, ld)
+			return consumererror.NewLogs(err, droppedLogs)
 		}
 		return nil
 	}
@@ -363,8 +363,8 @@ func (se *sumologicexporter) pushMetricsData(ctx context.Context, md pmetric.Met
 	var droppedMetrics pmetric.Metrics
 	var errs []error
 	if sdr.config.MetricFormat == OTLPMetricFormat {
-		if err := sdr.sendOTLPMetrics(ctx, md); err != nil {
-			droppedMetrics = md
+		var err error
+		if droppedMetrics, err = sdr.sendOTLPMetrics(ctx, md); err != nil {
 			errs = []error{err}
 		}
 	} else {
@@ -412,9 +412,12 @@ func (se *sumologicexporter) pushTracesData(ctx context.Context, td ptrace.Trace
 		se.id,
 	)
 
-	err := sdr.sendTraces(ctx, td)
-	se.handleUnauthorizedErrors(ctx, err)
-	return err
+	droppedTraces, err := sdr.sendTraces(ctx, td)
+	if err != nil {
+		se.handleUnauthorizedErrors(ctx, err)
+		return consumererror.NewTraces(err, droppedTraces)
+	}
+	return nil
 }
 
 func (se *sumologicexporter) StickySessionCookie() string {
@@ -24,12 +24,17 @@ import (
 	"go.uber.org/zap"
 
 	"github.com/open-telemetry/opentelemetry-collector-contrib/exporter/sumologicexporter/internal/observability"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/pdatautil"
 )
 
 var (
 	metricsMarshaler = pmetric.ProtoMarshaler{}
 	logsMarshaler    = plog.ProtoMarshaler{}
 	tracesMarshaler  = ptrace.ProtoMarshaler{}
+
+	// sizeEstimator estimates the size of the OTLP payloads, to split them in requests not larger than the
+	// max_request_body_size before marshaling them.
+	sizeEstimator = pdatautil.NewSizeEstimator(pdatautil.DefaultSizeSampleSize)
 )
 
 // metricPair represents information required to send one metric to the Sumo Logic
@@ -442,14 +447,27 @@ func (s *sender) formatLogLine(lr plog.LogRecord) (string, error) {
 	return formattedLine, err
 }
 
-// TODO: add support for HTTP limits
-func (s *sender) sendOTLPLogs(ctx context.Context, ld plog.Logs) error {
-	body, err := logsMarshaler.MarshalLogs(ld)
-	if err != nil {
-		return err
+// sendOTLPLogs sends the logs in OTLP format, split by resource in requests not larger than
+// config.MaxRequestBodySize. It returns the logs of the requests that failed and their errors.
+func (s *sender) sendOTLPLogs(ctx context.Context, ld plog.Logs) (plog.Logs, error) {
+	chunks := sizeEstimator.SplitLogs(ld, s.config.MaxRequestBodySize)
+	dropped := plog.NewLogs()
+	var errs []error
+	for _, chunk := range chunks {
+		body, err := logsMarshaler.MarshalLogs(chunk)
+		if err == nil {
+			err = s.send(ctx, LogsPipeline, newCountingReader(chunk.LogRecordCount()).withBytes(body), fields{})
+		}
+		if err != nil {
+			if len(chunks) == 1 {
+				return ld, err
+			}
+			// the chunks are copies of the logs when there are several of them
+			chunk.ResourceLogs().MoveAndAppendTo(dropped.ResourceLogs())
+			errs = append(errs, err)
+		}
 	}
-
-	return s.send(ctx, LogsPipeline, newCountingReader(ld.LogRecordCount()).withBytes(body), fields{})
+	return dropped, errors.Join(errs...)
 }
 
 // sendNonOTLPMetrics sends metrics in right format basing on the s.config.MetricFormat
@@ -547,22 +565,35 @@ func (s *sender) sendNonOTLPMetrics(ctx context.Context, md pmetric.Metrics) (pm
 	return droppedMetrics, errs
 }
 
-func (s *sender) sendOTLPMetrics(ctx context.Context, md pmetric.Metrics) error {
+// sendOTLPMetrics sends the metrics in OTLP format, split by resource in requests not larger than
+// config.MaxRequestBodySize. It returns the metrics of the requests that failed and their errors.
+func (s *sender) sendOTLPMetrics(ctx context.Context, md pmetric.Metrics) (pmetric.Metrics, error) {
 	rms := md.ResourceMetrics()
 	if rms.Len() == 0 {
 		s.logger.Debug("there are no metrics to send, moving on")
-		return nil
+		return pmetric.NewMetrics(), nil
 	}
 	if s.config.DecomposeOtlpHistograms {
 		md = decomposeHistograms(md)
 	}
 
-	body, err := metricsMarshaler.MarshalMetrics(md)
-	if err != nil {
-		return err
+	chunks := sizeEstimator.SplitMetrics(md, s.config.MaxRequestBodySize)
+	dropped := pmetric.NewMetrics()
+	var errs []error
+	for _, chunk := range chunks {
+		body, err := metricsMarshaler.MarshalMetrics(chunk)
+		if err == nil {
+			err = s.send(ctx, MetricsPipeline, newCountingReader(chunk.DataPointCount()).withBytes(body), fields{})
+		}
+		if err != nil {
+			if len(chunks) == 1 {
+				return md, err
+			}
+			chunk.ResourceMetrics().MoveAndAppendTo(dropped.ResourceMetrics())
+			errs = append(errs, err)
+		}
 	}
-
-	return s.send(ctx, MetricsPipeline, newCountingReader(md.DataPointCount()).withBytes(body), fields{})
+	return dropped, errors.Join(errs...)
 }
 
 // appendAndMaybeSend appends line to the request body that will be sent and sends
@@ -598,27 +629,35 @@ func (s *sender) appendAndMaybeSend(
 }
 
 // sendTraces sends traces in right format basing on the s.config.TraceFormat
-func (s *sender) sendTraces(ctx context.Context, td ptrace.Traces) error {
+func (s *sender) sendTraces(ctx context.Context, td ptrace.Traces) (ptrace.Traces, error) {
 	return s.sendOTLPTraces(ctx, td)
 }
 
-// sendOTLPTraces sends trace records in OTLP format
-func (s *sender) sendOTLPTraces(ctx context.Context, td ptrace.Traces) error {
+// sendOTLPTraces sends trace records in OTLP format, split by resource in requests not larger than
+// config.MaxRequestBodySize. It returns the traces of the requests that failed and their errors.
+func (s *sender) sendOTLPTraces(ctx context.Context, td ptrace.Traces) (ptrace.Traces, error) {
 	if td.ResourceSpans().Len() == 0 {
 		s.logger.Debug("there are no traces to send, moving on")
-		return nil
+		return ptrace.NewTraces(), nil
 	}
 
-	capacity := td.SpanCount()
-
-	body, err := tracesMarshaler.MarshalTraces(td)
-	if err != nil {
-		return err
-	}
-	if err := s.send(ctx, TracesPipeline, newCountingReader(capacity).withBytes(body), fields{}); err != nil {
-		return err
+	chunks := sizeEstimator.SplitTraces(td, s.config.MaxRequestBodySize)
+	dropped := ptrace.NewTraces()
+	var errs []error
+	for _, chunk := range chunks {
+		body, err := tracesMarshaler.MarshalTraces(chunk)
+		if err == nil {
+			err = s.send(ctx, TracesPipeline, newCountingReader(chunk.SpanCount()).withBytes(body), fields{})
+		}
+		if err != nil {
+			if len(chunks) == 1 {
+				return td, err
+			}
+			chunk.ResourceSpans().MoveAndAppendTo(dropped.ResourceSpans())
+			errs = append(errs, err)
+		}
 	}
-	return nil
+	return dropped, errors.Join(errs...)
 }
 
 func addSourcesHeaders(req *http.Request, flds fields) {
@@ -13,6 +13,7 @@ import (
 	"io"
 	"net/http"
 	"net/http/httptest"
+	"strconv"
 	"strings"
 	"sync/atomic"
 	"testing"
@@ -195,7 +196,7 @@ func TestSendTrace(t *testing.T) {
 		},
 	})
 
-	err = test.s.sendTraces(context.Background(), td)
+	_, err = test.s.sendTraces(context.Background(), td)
 	assert.NoError(t, err)
 }
 
@@ -833,10 +834,44 @@ func TestSendLogsOTLP(t *testing.T) {
 
 	l.MarkReadOnly()
 
-	assert.NoError(t, test.s.sendOTLPLogs(context.Background(), l))
+	_, err := test.s.sendOTLPLogs(context.Background(), l)
+	assert.NoError(t, err)
 	assert.EqualValues(t, 1, *test.reqCounter)
 }
 
+func TestSendOTLPLogsSplitByRequestBodySize(t *testing.T) {
+	test := prepareSenderTest(t, NoCompression, []func(w http.ResponseWriter, req *http.Request){
+		func(_ http.ResponseWriter, req *http.Request) {
+			body := extractBody(t, req)
+			ld, err := (&plog.ProtoUnmarshaler{}).UnmarshalLogs([]byte(body))
+			require.NoError(t, err)
+			assert.Equal(t, 2, ld.ResourceLogs().Len())
+		},
+		func(w http.ResponseWriter, _ *http.Request) {
+			w.WriteHeader(http.StatusInternalServerError)
+		},
+	}, func(c *Config) {
+		c.LogFormat = OTLPLogFormat
+		c.MaxRequestBodySize = 1000
+	})
+
+	l := plog.NewLogs()
+	for i := 0; i < 3; i++ {
+		rl := l.ResourceLogs().AppendEmpty()
+		rl.Resource().Attributes().PutStr("index", strconv.Itoa(i))
+		rl.ScopeLogs().AppendEmpty().LogRecords().AppendEmpty().Body().SetStr(strings.Repeat("x", 400))
+	}
+	l.MarkReadOnly()
+
+	dropped, err := test.s.sendOTLPLogs(context.Background(), l)
+	assert.Error(t, err)
+	assert.EqualValues(t, 2, *test.reqCounter)
+	// only the logs of the failed request are returned
+	require.Equal(t, 1, dropped.ResourceLogs().Len())
+	index, _ := dropped.ResourceLogs().At(0).Resource().Attributes().Get("index")
+	assert.Equal(t, "2", index.Str())
+}
+
 func TestLogsHandlesReceiverResponses(t *testing.T) {
 	t.Run("json with too many fields logs a warning", func(t *testing.T) {
 		test := prepareSenderTest(t, NoCompression, []func(w http.ResponseWriter, req *http.Request){
@@ -1141,7 +1176,7 @@ func TestSendOTLPHistogram(t *testing.T) {
 	metricHistogram.CopyTo(rms.ScopeMetrics().AppendEmpty().Metrics().AppendEmpty())
 	metrics.MarkReadOnly()
 
-	err := test.s.sendOTLPMetrics(context.Background(), metrics)
+	_, err := test.s.sendOTLPMetrics(context.Background(), metrics)
 	assert.NoError(t, err)
 }
 
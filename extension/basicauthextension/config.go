@@ -12,13 +12,18 @@ import (
 var (
 	errNoCredentialSource     = errors.New("no credential source provided")
 	errMultipleAuthenticators = errors.New("only one of `htpasswd` or `client_auth` can be specified")
+	errReservedAttribute      = errors.New("the `username` and `raw` attributes can't be set in `user_metadata`")
 )
 
 type HtpasswdSettings struct {
-	// Path to the htpasswd file.
+	// Path to the htpasswd file. The file is reloaded when it changes.
 	File string `mapstructure:"file"`
+	// Env is the name of an environment variable holding htpasswd content.
+	Env string `mapstructure:"env"`
 	// Inline contents of the htpasswd file.
 	Inline string `mapstructure:"inline"`
+	// UserMetadata are attributes added to the `client.Info.Auth` of the authenticated users, per username.
+	UserMetadata map[string]map[string]string `mapstructure:"user_metadata"`
 }
 
 type ClientAuthSettings struct {
@@ -48,5 +53,16 @@ func (cfg *Config) Validate() error {
 		return errNoCredentialSource
 	}
 
+	if serverCondition {
+		for _, metadata := range cfg.Htpasswd.UserMetadata {
+			if _, ok := metadata[attributeUsername]; ok {
+				return errReservedAttribute
+			}
+			if _, ok := metadata[attributeRaw]; ok {
+				return errReservedAttribute
+			}
+		}
+	}
+
 	return nil
 }
@@ -48,6 +48,22 @@ func TestLoadConfig(t *testing.T) {
 			id:          component.NewIDWithName(metadata.Type, "both"),
 			expectedErr: true,
 		},
+		{
+			id: component.NewIDWithName(metadata.Type, "sources"),
+			expected: &Config{
+				Htpasswd: &HtpasswdSettings{
+					File: "/etc/otelcol/htpasswd",
+					Env:  "OTELCOL_HTPASSWD",
+					UserMetadata: map[string]map[string]string{
+						"username1": {"tenant": "acme"},
+					},
+				},
+			},
+		},
+		{
+			id:          component.NewIDWithName(metadata.Type, "reserved_attribute"),
+			expectedErr: true,
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.id.String(), func(t *testing.T) {
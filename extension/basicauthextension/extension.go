@@ -8,15 +8,14 @@ import (
 	"encoding/base64"
 	"errors"
 	"fmt"
-	"io"
 	"net/http"
-	"os"
 	"strings"
+	"sync"
 
-	"github.com/tg123/go-htpasswd"
 	"go.opentelemetry.io/collector/client"
 	"go.opentelemetry.io/collector/component"
 	"go.opentelemetry.io/collector/extension/auth"
+	"go.uber.org/zap"
 	creds "google.golang.org/grpc/credentials"
 )
 
@@ -27,10 +26,23 @@ var (
 	errInvalidFormat       = errors.New("invalid authorization format")
 )
 
+const (
+	attributeUsername = "username"
+	attributeRaw      = "raw"
+)
+
 type basicAuth struct {
 	htpasswd   *HtpasswdSettings
 	clientAuth *ClientAuthSettings
-	matchFunc  func(username, password string) bool
+	logger     *zap.Logger
+
+	// mu protects the htpasswd content, reloaded when the htpasswd file changes.
+	mu        sync.RWMutex
+	content   []byte
+	matchFunc func(username, password string) bool
+
+	shutdownCH chan struct{}
+	watcherWG  sync.WaitGroup
 }
 
 func newClientAuthExtension(cfg *Config) auth.Client {
@@ -43,50 +55,48 @@ func newClientAuthExtension(cfg *Config) auth.Client {
 	)
 }
 
-func newServerAuthExtension(cfg *Config) (auth.Server, error) {
+func newServerAuthExtension(cfg *Config, logger *zap.Logger) (auth.Server, error) {
 
-	if cfg.Htpasswd == nil || (cfg.Htpasswd.File == "" && cfg.Htpasswd.Inline == "") {
+	if cfg.Htpasswd == nil || (cfg.Htpasswd.File == "" && cfg.Htpasswd.Env == "" && cfg.Htpasswd.Inline == "") {
 		return nil, errNoCredentialSource
 	}
 
-	ba := basicAuth{
+	ba := &basicAuth{
 		htpasswd: cfg.Htpasswd,
+		logger:   logger,
 	}
 	return auth.NewServer(
 		auth.WithServerStart(ba.serverStart),
+		auth.WithServerShutdown(ba.serverShutdown),
 		auth.WithServerAuthenticate(ba.authenticate),
 	), nil
 }
 
 func (ba *basicAuth) serverStart(_ context.Context, _ component.Host) error {
-	var rs []io.Reader
-
-	if ba.htpasswd.File != "" {
-		f, err := os.Open(ba.htpasswd.File)
-		if err != nil {
-			return fmt.Errorf("open htpasswd file: %w", err)
-		}
-		defer f.Close()
-
-		rs = append(rs, f)
-		rs = append(rs, strings.NewReader("\n"))
+	if _, err := ba.load(); err != nil {
+		return err
 	}
-
-	// Ensure that the inline content is read the last.
-	// This way the inline content will override the content from file.
-	rs = append(rs, strings.NewReader(ba.htpasswd.Inline))
-	mr := io.MultiReader(rs...)
-
-	htp, err := htpasswd.NewFromReader(mr, htpasswd.DefaultSystems, nil)
-	if err != nil {
-		return fmt.Errorf("read htpasswd content: %w", err)
+	if ba.htpasswd.File == "" {
+		return nil
 	}
+	return ba.startWatcher()
+}
 
-	ba.matchFunc = htp.Match
-
+func (ba *basicAuth) serverShutdown(_ context.Context) error {
+	if ba.shutdownCH != nil {
+		close(ba.shutdownCH)
+		ba.watcherWG.Wait()
+		ba.shutdownCH = nil
+	}
 	return nil
 }
 
+func (ba *basicAuth) match(username, password string) bool {
+	ba.mu.RLock()
+	defer ba.mu.RUnlock()
+	return ba.matchFunc(username, password)
+}
+
 func (ba *basicAuth) authenticate(ctx context.Context, headers map[string][]string) (context.Context, error) {
 	auth := getAuthHeader(headers)
 	if auth == "" {
@@ -98,9 +108,10 @@ func (ba *basicAuth) authenticate(ctx context.Context, headers map[string][]stri
 		return ctx, err
 	}
 
-	if !ba.matchFunc(authData.username, authData.password) {
+	if !ba.match(authData.username, authData.password) {
 		return ctx, errInvalidCredentials
 	}
+	authData.metadata = ba.htpasswd.UserMetadata[authData.username]
 
 	cl := client.FromContext(ctx)
 	cl.Auth = authData
@@ -167,21 +178,30 @@ type authData struct {
 	username string
 	password string
 	raw      string
+	// metadata are the attributes configured for the user.
+	metadata map[string]string
 }
 
 func (a *authData) GetAttribute(name string) any {
 	switch name {
-	case "username":
+	case attributeUsername:
 		return a.username
-	case "raw":
+	case attributeRaw:
 		return a.raw
 	default:
+		if value, ok := a.metadata[name]; ok {
+			return value
+		}
 		return nil
 	}
 }
 
-func (*authData) GetAttributeNames() []string {
-	return []string{"username", "raw"}
+func (a *authData) GetAttributeNames() []string {
+	names := []string{attributeUsername, attributeRaw}
+	for name := range a.metadata {
+		names = append(names, name)
+	}
+	return names
 }
 
 // perRPCAuth is a gRPC credentials.PerRPCCredentials implementation that returns an 'authorization' header.
@@ -0,0 +1,116 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package basicauthextension // import "github.com/open-telemetry/opentelemetry-collector-contrib/extension/basicauthextension"
+
+import (
+	"bytes"
+	"fmt"
+	"os"
+	"path/filepath"
+
+	"github.com/fsnotify/fsnotify"
+	"github.com/tg123/go-htpasswd"
+	"go.uber.org/zap"
+)
+
+// readContent concatenates the content of the credential sources: the file, the environment variable
+// and the inline content, in this order.
+func (ba *basicAuth) readContent() ([]byte, error) {
+	var content bytes.Buffer
+
+	if ba.htpasswd.File != "" {
+		data, err := os.ReadFile(ba.htpasswd.File)
+		if err != nil {
+			return nil, fmt.Errorf("open htpasswd file: %w", err)
+		}
+		content.Write(data)
+		content.WriteString("\n")
+	}
+
+	if ba.htpasswd.Env != "" {
+		data, ok := os.LookupEnv(ba.htpasswd.Env)
+		if !ok {
+			return nil, fmt.Errorf("htpasswd environment variable %q is not set", ba.htpasswd.Env)
+		}
+		content.WriteString(data)
+		content.WriteString("\n")
+	}
+
+	// Ensure that the inline content is read the last.
+	// This way the inline content will override the content from file and environment variable.
+	content.WriteString(ba.htpasswd.Inline)
+	return content.Bytes(), nil
+}
+
+// load reads the credential sources, and replaces the credentials when their content changed.
+func (ba *basicAuth) load() (bool, error) {
+	content, err := ba.readContent()
+	if err != nil {
+		return false, err
+	}
+
+	ba.mu.RLock()
+	unchanged := ba.matchFunc != nil && bytes.Equal(content, ba.content)
+	ba.mu.RUnlock()
+	if unchanged {
+		return false, nil
+	}
+
+	htp, err := htpasswd.NewFromReader(bytes.NewReader(content), htpasswd.DefaultSystems, nil)
+	if err != nil {
+		return false, fmt.Errorf("read htpasswd content: %w", err)
+	}
+
+	ba.mu.Lock()
+	ba.content = content
+	ba.matchFunc = htp.Match
+	ba.mu.Unlock()
+	return true, nil
+}
+
+// startWatcher reloads the credentials when the htpasswd file changes.
+// The directory of the file is watched, so that the file is still watched after it's replaced, e.g. the atomic
+// updates of the Kubernetes secrets and config maps replace the symlinks to the files.
+func (ba *basicAuth) startWatcher() error {
+	watcher, err := fsnotify.NewWatcher()
+	if err != nil {
+		return err
+	}
+	if err = watcher.Add(filepath.Dir(ba.htpasswd.File)); err != nil {
+		_ = watcher.Close()
+		return fmt.Errorf("watch htpasswd file: %w", err)
+	}
+
+	ba.shutdownCH = make(chan struct{})
+	ba.watcherWG.Add(1)
+	go func() {
+		defer ba.watcherWG.Done()
+		defer watcher.Close()
+		for {
+			select {
+			case <-ba.shutdownCH:
+				return
+			case _, ok := <-watcher.Events:
+				if !ok {
+					return
+				}
+				// the events of the other files of the directory are ignored when the content didn't change
+				changed, err := ba.load()
+				if err != nil {
+					ba.logger.Error("failed to reload the htpasswd file, the previous credentials are kept", zap.Error(err))
+					continue
+				}
+				if changed {
+					ba.logger.Info("reloaded the htpasswd file", zap.String("file", ba.htpasswd.File))
+				}
+			case err, ok := <-watcher.Errors:
+				if !ok {
+					return
+				}
+				ba.logger.Error("htpasswd file watcher error", zap.Error(err))
+			}
+		}
+	}()
+	return nil
+}
@@ -8,7 +8,7 @@ import (
 	"errors"
 	"fmt"
 	"net/http"
-	"os"
+	"net/url"
 	"sync"
 
 	"github.com/fsnotify/fsnotify"
@@ -23,11 +23,27 @@ var _ credentials.PerRPCCredentials = (*PerRPCAuth)(nil)
 // PerRPCAuth is a gRPC credentials.PerRPCCredentials implementation that returns an 'authorization' header.
 type PerRPCAuth struct {
 	metadata map[string]string
+
+	// bearerTokenFunc, when set, returns the 'authorization' header for the host of the RPC.
+	bearerTokenFunc func(host string) string
 }
 
 // GetRequestMetadata returns the request metadata to be used with the RPC.
-func (c *PerRPCAuth) GetRequestMetadata(context.Context, ...string) (map[string]string, error) {
-	return c.metadata, nil
+func (c *PerRPCAuth) GetRequestMetadata(_ context.Context, uri ...string) (map[string]string, error) {
+	if c.bearerTokenFunc == nil {
+		return c.metadata, nil
+	}
+	var host string
+	if len(uri) > 0 {
+		if u, err := url.Parse(uri[0]); err == nil {
+			host = u.Host
+		}
+	}
+	token := c.bearerTokenFunc(host)
+	if token == "" {
+		return map[string]string{}, nil
+	}
+	return map[string]string{"authorization": token}, nil
 }
 
 // RequireTransportSecurity always returns true for this implementation. Passing bearer tokens in plain-text connections is a bad idea.
@@ -42,58 +58,115 @@ var (
 
 // BearerTokenAuth is an implementation of auth.Client. It embeds a static authorization "bearer" token in every rpc call.
 type BearerTokenAuth struct {
-	muTokenString sync.RWMutex
-	scheme        string
-	tokenString   string
+	scheme string
+
+	// token is the default token, it's nil when only the tokens of the endpoints are configured.
+	token     *tokenSource
+	endpoints []endpointToken
 
 	shutdownCH chan struct{}
+	cancel     context.CancelFunc
+	wg         sync.WaitGroup
 
-	filename string
-	logger   *zap.Logger
+	logger *zap.Logger
 }
 
 var _ auth.Client = (*BearerTokenAuth)(nil)
 
 func newBearerTokenAuth(cfg *Config, logger *zap.Logger) *BearerTokenAuth {
-	if cfg.Filename != "" && cfg.BearerToken != "" {
-		logger.Warn("a filename is specified. Configured token is ignored!")
+	b := &BearerTokenAuth{
+		scheme: cfg.Scheme,
+		logger: logger,
+	}
+	if cfg.BearerToken != "" || cfg.Filename != "" || len(cfg.Command) > 0 {
+		b.token = newTokenSource(cfg.BearerToken, cfg.Filename, cfg.Command, cfg.RefreshInterval, logger)
 	}
-	return &BearerTokenAuth{
-		scheme:      cfg.Scheme,
-		tokenString: string(cfg.BearerToken),
-		filename:    cfg.Filename,
-		logger:      logger,
+	for _, endpoint := range cfg.Endpoints {
+		b.endpoints = append(b.endpoints, endpointToken{
+			host:  endpoint.Host,
+			token: newTokenSource(endpoint.BearerToken, endpoint.Filename, endpoint.Command, endpoint.RefreshInterval, logger),
+		})
 	}
+	return b
 }
 
-// Start of BearerTokenAuth does nothing and returns nil if no filename
-// is specified. Otherwise a routine is started to monitor the file containing
-// the token to be transferred.
+// tokenSources returns the token sources read from a file, and the ones obtained from a command.
+func (b *BearerTokenAuth) tokenSources() (files []*tokenSource, commands []*tokenSource) {
+	sources := make([]*tokenSource, 0, len(b.endpoints)+1)
+	if b.token != nil {
+		sources = append(sources, b.token)
+	}
+	for _, endpoint := range b.endpoints {
+		sources = append(sources, endpoint.token)
+	}
+	for _, source := range sources {
+		switch {
+		case source.filename != "":
+			files = append(files, source)
+		case len(source.command) > 0:
+			commands = append(commands, source)
+		}
+	}
+	return files, commands
+}
+
+// Start of BearerTokenAuth does nothing and returns nil if no token is read from
+// a file or a command. Otherwise routines are started to monitor the files
+// containing the tokens, and to execute the commands periodically.
 func (b *BearerTokenAuth) Start(ctx context.Context, _ component.Host) error {
-	if b.filename == "" {
+	files, commands := b.tokenSources()
+	if len(files) == 0 && len(commands) == 0 {
 		return nil
 	}
 
 	if b.shutdownCH != nil {
-		return fmt.Errorf("bearerToken file monitoring is already running")
+		return fmt.Errorf("bearerToken refresh is already running")
 	}
 
-	// Read file once
-	b.refreshToken()
+	// Execute the commands and read the files once
+	for _, source := range commands {
+		if err := source.refreshFromCommand(ctx); err != nil {
+			return err
+		}
+	}
+	for _, source := range files {
+		source.refreshFromFile()
+	}
 
 	b.shutdownCH = make(chan struct{})
+	refreshCtx, cancel := context.WithCancel(context.Background())
+	b.cancel = cancel
+
+	for _, source := range commands {
+		b.wg.Add(1)
+		go func(source *tokenSource) {
+			defer b.wg.Done()
+			source.refreshPeriodically(refreshCtx)
+		}(source)
+	}
+
+	if len(files) == 0 {
+		return nil
+	}
 
 	watcher, err := fsnotify.NewWatcher()
 	if err != nil {
 		return err
 	}
 	// start file watcher
-	go b.startWatcher(ctx, watcher)
+	b.wg.Add(1)
+	go b.startWatcher(ctx, watcher, files)
 
-	return watcher.Add(b.filename)
+	for _, source := range files {
+		if err := watcher.Add(source.filename); err != nil {
+			return err
+		}
+	}
+	return nil
 }
 
-func (b *BearerTokenAuth) startWatcher(ctx context.Context, watcher *fsnotify.Watcher) {
+func (b *BearerTokenAuth) startWatcher(ctx context.Context, watcher *fsnotify.Watcher, files []*tokenSource) {
+	defer b.wg.Done()
 	defer watcher.Close()
 	for {
 		select {
@@ -106,51 +179,47 @@ func (b *BearerTokenAuth) startWatcher(ctx context.Context, watcher *fsnotify.Wa
 			if !ok {
 				continue
 			}
-			// NOTE: k8s configmaps uses symlinks, we need this workaround.
-			// original configmap file is removed.
-			// SEE: https://martensson.io/go-fsnotify-and-kubernetes-configmaps/
-			if event.Op == fsnotify.Remove || event.Op == fsnotify.Chmod {
-				// remove the watcher since the file is removed
-				if err := watcher.Remove(event.Name); err != nil {
-					b.logger.Error(err.Error())
+			for _, source := range files {
+				if event.Name != source.filename {
+					continue
 				}
-				// add a new watcher pointing to the new symlink/file
-				if err := watcher.Add(b.filename); err != nil {
-					b.logger.Error(err.Error())
+				// NOTE: k8s configmaps uses symlinks, we need this workaround.
+				// original configmap file is removed.
+				// SEE: https://martensson.io/go-fsnotify-and-kubernetes-configmaps/
+				if event.Op == fsnotify.Remove || event.Op == fsnotify.Chmod {
+					// remove the watcher since the file is removed
+					if err := watcher.Remove(event.Name); err != nil {
+						b.logger.Error(err.Error())
+					}
+					// add a new watcher pointing to the new symlink/file
+					if err := watcher.Add(source.filename); err != nil {
+						b.logger.Error(err.Error())
+					}
+					source.refreshFromFile()
+				}
+				// also allow normal files to be modified and reloaded.
+				if event.Op == fsnotify.Write {
+					source.refreshFromFile()
 				}
-				b.refreshToken()
-			}
-			// also allow normal files to be modified and reloaded.
-			if event.Op == fsnotify.Write {
-				b.refreshToken()
 			}
 		}
 	}
 }
 
-func (b *BearerTokenAuth) refreshToken() {
-	b.logger.Info("refresh token", zap.String("filename", b.filename))
-	token, err := os.ReadFile(b.filename)
-	if err != nil {
-		b.logger.Error(err.Error())
-		return
-	}
-	b.muTokenString.Lock()
-	b.tokenString = string(token)
-	b.muTokenString.Unlock()
-}
-
-// Shutdown of BearerTokenAuth does nothing and returns nil
+// Shutdown of BearerTokenAuth does nothing and returns nil if no token is read from a file or a command.
+// Otherwise it stops the routines started by Start.
 func (b *BearerTokenAuth) Shutdown(_ context.Context) error {
-	if b.filename == "" {
+	files, commands := b.tokenSources()
+	if len(files) == 0 && len(commands) == 0 {
 		return nil
 	}
 
 	if b.shutdownCH == nil {
-		return fmt.Errorf("bearerToken file monitoring is not running")
+		return fmt.Errorf("bearerToken refresh is not running")
 	}
-	b.shutdownCH <- struct{}{}
 	close(b.shutdownCH)
+	b.cancel()
+	b.wg.Wait()
 	b.shutdownCH = nil
 	return nil
 }
@@ -158,36 +227,49 @@ func (b *BearerTokenAuth) Shutdown(_ context.Context) error {
 // PerRPCCredentials returns PerRPCAuth an implementation of credentials.PerRPCCredentials that
 func (b *BearerTokenAuth) PerRPCCredentials() (credentials.PerRPCCredentials, error) {
 	return &PerRPCAuth{
-		metadata: map[string]string{"authorization": b.bearerToken()},
+		bearerTokenFunc: b.bearerTokenFor,
 	}, nil
 }
 
+// bearerToken returns the 'authorization' header with the default token.
 func (b *BearerTokenAuth) bearerToken() string {
-	b.muTokenString.RLock()
-	token := fmt.Sprintf("%s %s", b.scheme, b.tokenString)
-	b.muTokenString.RUnlock()
-	return token
+	if b.token == nil {
+		return ""
+	}
+	return fmt.Sprintf("%s %s", b.scheme, b.token.get())
+}
+
+// bearerTokenFor returns the 'authorization' header with the token of the first endpoint matching the host,
+// or with the default token when no endpoint matches. It's empty when there is no token for the host.
+func (b *BearerTokenAuth) bearerTokenFor(host string) string {
+	for _, endpoint := range b.endpoints {
+		if endpoint.matches(host) {
+			return fmt.Sprintf("%s %s", b.scheme, endpoint.token.get())
+		}
+	}
+	return b.bearerToken()
 }
 
 // RoundTripper is not implemented by BearerTokenAuth
 func (b *BearerTokenAuth) RoundTripper(base http.RoundTripper) (http.RoundTripper, error) {
 	return &BearerAuthRoundTripper{
 		baseTransport:   base,
-		bearerTokenFunc: b.bearerToken,
+		bearerTokenFunc: b.bearerTokenFor,
 	}, nil
 }
 
 // Authenticate checks whether the given context contains valid auth data.
+// Only the default token is accepted.
 func (b *BearerTokenAuth) Authenticate(ctx context.Context, headers map[string][]string) (context.Context, error) {
 	auth, ok := headers["authorization"]
 	if !ok {
 		auth, ok = headers["Authorization"]
 	}
-	if !ok || len(auth) == 0 {
+	if !ok || len(auth) == 0 || b.token == nil {
 		return ctx, errors.New("authentication didn't succeed")
 	}
 	token := auth[0]
-	expect := b.tokenString
+	expect := b.token.get()
 	if len(b.scheme) != 0 {
 		expect = fmt.Sprintf("%s %s", b.scheme, expect)
 	}
@@ -200,7 +282,7 @@ func (b *BearerTokenAuth) Authenticate(ctx context.Context, headers map[string][
 // BearerAuthRoundTripper intercepts and adds Bearer token Authorization headers to each http request.
 type BearerAuthRoundTripper struct {
 	baseTransport   http.RoundTripper
-	bearerTokenFunc func() string
+	bearerTokenFunc func(host string) string
 }
 
 // RoundTrip modifies the original request and adds Bearer token Authorization headers.
@@ -209,6 +291,12 @@ func (interceptor *BearerAuthRoundTripper) RoundTrip(req *http.Request) (*http.R
 	if req2.Header == nil {
 		req2.Header = make(http.Header)
 	}
-	req2.Header.Set("Authorization", interceptor.bearerTokenFunc())
+	var host string
+	if req2.URL != nil {
+		host = req2.URL.Host
+	}
+	if token := interceptor.bearerTokenFunc(host); token != "" {
+		req2.Header.Set("Authorization", token)
+	}
 	return interceptor.baseTransport.RoundTrip(req2)
 }
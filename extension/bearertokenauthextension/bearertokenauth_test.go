@@ -7,11 +7,15 @@ import (
 	"context"
 	"fmt"
 	"net/http"
+	"net/url"
 	"os"
+	"path/filepath"
+	"runtime"
 	"testing"
 	"time"
 
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 	"go.opentelemetry.io/collector/component/componenttest"
 	"go.uber.org/zap/zaptest"
 )
@@ -114,7 +118,7 @@ func TestBearerStartWatchStop(t *testing.T) {
 	assert.NoError(t, err)
 	assert.NotNil(t, credential)
 
-	token, err := os.ReadFile(bauth.filename)
+	token, err := os.ReadFile(cfg.Filename)
 	assert.NoError(t, err)
 
 	tokenStr := fmt.Sprintf("Bearer %s", token)
@@ -127,7 +131,7 @@ func TestBearerStartWatchStop(t *testing.T) {
 	assert.True(t, credential.RequireTransportSecurity())
 
 	// change file content once
-	assert.Nil(t, os.WriteFile(bauth.filename, []byte(fmt.Sprintf("%stest", token)), 0600))
+	assert.Nil(t, os.WriteFile(cfg.Filename, []byte(fmt.Sprintf("%stest", token)), 0600))
 	time.Sleep(5 * time.Second)
 	credential, _ = bauth.PerRPCCredentials()
 	md, err = credential.GetRequestMetadata(context.Background())
@@ -136,7 +140,7 @@ func TestBearerStartWatchStop(t *testing.T) {
 	assert.NoError(t, err)
 
 	// change file content back
-	assert.Nil(t, os.WriteFile(bauth.filename, token, 0600))
+	assert.Nil(t, os.WriteFile(cfg.Filename, token, 0600))
 	time.Sleep(5 * time.Second)
 	credential, _ = bauth.PerRPCCredentials()
 	md, err = credential.GetRequestMetadata(context.Background())
@@ -162,7 +166,7 @@ func TestBearerTokenFileContentUpdate(t *testing.T) {
 	assert.Error(t, bauth.Start(context.Background(), componenttest.NewNopHost()))
 	defer func() { assert.NoError(t, bauth.Shutdown(context.Background())) }()
 
-	token, err := os.ReadFile(bauth.filename)
+	token, err := os.ReadFile(cfg.Filename)
 	assert.NoError(t, err)
 
 	base := &mockRoundTripper{}
@@ -177,10 +181,10 @@ func TestBearerTokenFileContentUpdate(t *testing.T) {
 	assert.Equal(t, authHeaderValue, fmt.Sprintf("%s %s", scheme, string(token)))
 
 	// change file content once
-	assert.Nil(t, os.WriteFile(bauth.filename, []byte(fmt.Sprintf("%stest", token)), 0600))
+	assert.Nil(t, os.WriteFile(cfg.Filename, []byte(fmt.Sprintf("%stest", token)), 0600))
 	time.Sleep(5 * time.Second)
 
-	tokenNew, err := os.ReadFile(bauth.filename)
+	tokenNew, err := os.ReadFile(cfg.Filename)
 	assert.NoError(t, err)
 
 	// check if request is updated with the new token
@@ -191,7 +195,7 @@ func TestBearerTokenFileContentUpdate(t *testing.T) {
 	assert.Equal(t, authHeaderValue, fmt.Sprintf("%s %s", scheme, string(tokenNew)))
 
 	// change file content back
-	assert.Nil(t, os.WriteFile(bauth.filename, token, 0600))
+	assert.Nil(t, os.WriteFile(cfg.Filename, token, 0600))
 	time.Sleep(5 * time.Second)
 
 	// check if request is updated with the old token
@@ -252,3 +256,130 @@ func TestBearerServerAuthenticate(t *testing.T) {
 
 	assert.Nil(t, bauth.Shutdown(context.Background()))
 }
+
+func TestBearerAuthenticatorEndpoints(t *testing.T) {
+	cfg := createDefaultConfig().(*Config)
+	cfg.BearerToken = "default-token"
+	cfg.Endpoints = []EndpointConfig{
+		{Host: "backend-a.example.com", BearerToken: "token-a"},
+		{Host: "*.example.org", BearerToken: "token-b"},
+	}
+
+	bauth := newBearerTokenAuth(cfg, zaptest.NewLogger(t))
+	assert.Nil(t, bauth.Start(context.Background(), componenttest.NewNopHost()))
+	defer func() { assert.NoError(t, bauth.Shutdown(context.Background())) }()
+
+	rt, err := bauth.RoundTripper(&mockRoundTripper{})
+	assert.NoError(t, err)
+	credential, err := bauth.PerRPCCredentials()
+	assert.NoError(t, err)
+
+	for _, tt := range []struct {
+		host     string
+		expected string
+	}{
+		{host: "backend-a.example.com", expected: "Bearer token-a"},
+		{host: "backend-a.example.com:4318", expected: "Bearer token-a"},
+		{host: "backend-b.example.org:4317", expected: "Bearer token-b"},
+		{host: "example.org", expected: "Bearer default-token"},
+		{host: "other.example.com", expected: "Bearer default-token"},
+	} {
+		t.Run(tt.host, func(t *testing.T) {
+			resp, err := rt.RoundTrip(&http.Request{URL: &url.URL{Scheme: "https", Host: tt.host}})
+			assert.NoError(t, err)
+			assert.Equal(t, tt.expected, resp.Header.Get("Authorization"))
+
+			md, err := credential.GetRequestMetadata(context.Background(), "https://"+tt.host+"/opentelemetry.proto.collector.trace.v1.TraceService")
+			assert.NoError(t, err)
+			assert.Equal(t, map[string]string{"authorization": tt.expected}, md)
+		})
+	}
+}
+
+func TestBearerAuthenticatorEndpointsWithoutDefault(t *testing.T) {
+	cfg := createDefaultConfig().(*Config)
+	cfg.Endpoints = []EndpointConfig{
+		{Host: "backend-a.example.com", BearerToken: "token-a"},
+	}
+
+	bauth := newBearerTokenAuth(cfg, zaptest.NewLogger(t))
+	assert.Nil(t, bauth.Start(context.Background(), componenttest.NewNopHost()))
+	defer func() { assert.NoError(t, bauth.Shutdown(context.Background())) }()
+
+	rt, err := bauth.RoundTripper(&mockRoundTripper{})
+	assert.NoError(t, err)
+	resp, err := rt.RoundTrip(&http.Request{URL: &url.URL{Scheme: "https", Host: "other.example.com"}})
+	assert.NoError(t, err)
+	assert.Empty(t, resp.Header.Get("Authorization"))
+
+	credential, err := bauth.PerRPCCredentials()
+	assert.NoError(t, err)
+	md, err := credential.GetRequestMetadata(context.Background(), "https://other.example.com")
+	assert.NoError(t, err)
+	assert.Empty(t, md)
+
+	// the tokens of the endpoints are not accepted by the server authenticator
+	_, err = bauth.Authenticate(context.Background(), map[string][]string{"authorization": {"Bearer token-a"}})
+	assert.Error(t, err)
+}
+
+func TestEndpointTokenMatches(t *testing.T) {
+	for _, tt := range []struct {
+		pattern string
+		host    string
+		matches bool
+	}{
+		{pattern: "example.com", host: "example.com", matches: true},
+		{pattern: "example.com", host: "EXAMPLE.com:443", matches: true},
+		{pattern: "example.com", host: "api.example.com", matches: false},
+		{pattern: "example.com:443", host: "example.com:443", matches: true},
+		{pattern: "example.com:443", host: "example.com:8443", matches: false},
+		{pattern: "example.com:443", host: "example.com", matches: false},
+		{pattern: "*.example.com", host: "api.example.com:443", matches: true},
+		{pattern: "*.example.com", host: "example.com", matches: false},
+		{pattern: "10.0.0.1", host: "10.0.0.1:4317", matches: true},
+		{pattern: "[::1]:4317", host: "[::1]:4317", matches: true},
+	} {
+		t.Run(tt.pattern+"/"+tt.host, func(t *testing.T) {
+			assert.Equal(t, tt.matches, endpointToken{host: tt.pattern}.matches(tt.host))
+		})
+	}
+}
+
+func TestBearerTokenCommand(t *testing.T) {
+	if runtime.GOOS == "windows" {
+		t.Skip("the test command requires cat")
+	}
+	file := filepath.Join(t.TempDir(), "token")
+	require.NoError(t, os.WriteFile(file, []byte("token1\n"), 0600))
+
+	cfg := createDefaultConfig().(*Config)
+	cfg.Command = []string{"cat", file}
+	cfg.RefreshInterval = 10 * time.Millisecond
+
+	bauth := newBearerTokenAuth(cfg, zaptest.NewLogger(t))
+	require.NoError(t, bauth.Start(context.Background(), componenttest.NewNopHost()))
+	defer func() { assert.NoError(t, bauth.Shutdown(context.Background())) }()
+	assert.Equal(t, "Bearer token1", bauth.bearerToken())
+
+	require.NoError(t, os.WriteFile(file, []byte("token2\n"), 0600))
+	assert.Eventually(t, func() bool {
+		return bauth.bearerToken() == "Bearer token2"
+	}, 5*time.Second, 10*time.Millisecond)
+
+	// the previous token is kept when the command fails
+	require.NoError(t, os.Remove(file))
+	time.Sleep(50 * time.Millisecond)
+	assert.Equal(t, "Bearer token2", bauth.bearerToken())
+}
+
+func TestBearerTokenCommandFailure(t *testing.T) {
+	if runtime.GOOS == "windows" {
+		t.Skip("the test command requires cat")
+	}
+	cfg := createDefaultConfig().(*Config)
+	cfg.Command = []string{"cat", filepath.Join(t.TempDir(), "missing")}
+
+	bauth := newBearerTokenAuth(cfg, zaptest.NewLogger(t))
+	assert.Error(t, bauth.Start(context.Background(), componenttest.NewNopHost()))
+}
@@ -5,6 +5,8 @@ package bearertokenauthextension // import "github.com/open-telemetry/openteleme
 
 import (
 	"errors"
+	"fmt"
+	"time"
 
 	"go.opentelemetry.io/collector/component"
 	"go.opentelemetry.io/collector/config/configopaque"
@@ -21,15 +23,73 @@ type Config struct {
 
 	// Filename points to a file that contains the bearer token to use for every RPC.
 	Filename string `mapstructure:"filename,omitempty"`
+
+	// Command is executed to obtain the bearer token to use for every RPC, the token is its standard output.
+	Command []string `mapstructure:"command,omitempty"`
+
+	// RefreshInterval specifies how often the command is executed to rotate the token. Defaults to 5m.
+	RefreshInterval time.Duration `mapstructure:"refresh_interval,omitempty"`
+
+	// Endpoints specifies the tokens to use for the RPCs to specific hosts.
+	// The token of the first endpoint matching the host of the RPC is used, the token above is used otherwise.
+	Endpoints []EndpointConfig `mapstructure:"endpoints,omitempty"`
+}
+
+// EndpointConfig specifies the bearer token to use for the RPCs to a host.
+type EndpointConfig struct {
+	// Host is matched against the host of the RPC. It matches any port when it has no port,
+	// and any subdomain when it starts with "*.".
+	Host string `mapstructure:"host"`
+
+	// BearerToken specifies the bearer token to use for the RPCs to the host.
+	BearerToken configopaque.String `mapstructure:"token,omitempty"`
+
+	// Filename points to a file that contains the bearer token to use for the RPCs to the host.
+	Filename string `mapstructure:"filename,omitempty"`
+
+	// Command is executed to obtain the bearer token to use for the RPCs to the host.
+	Command []string `mapstructure:"command,omitempty"`
+
+	// RefreshInterval specifies how often the command is executed to rotate the token. Defaults to 5m.
+	RefreshInterval time.Duration `mapstructure:"refresh_interval,omitempty"`
 }
 
 var _ component.Config = (*Config)(nil)
-var errNoTokenProvided = errors.New("no bearer token provided")
+var (
+	errNoTokenProvided         = errors.New("no bearer token provided")
+	errFilenameAndCommand      = errors.New("only one of filename or command can be specified")
+	errNegativeRefreshInterval = errors.New("refresh_interval must not be negative")
+	errNoEndpointHost          = errors.New("no endpoint host provided")
+)
 
 // Validate checks if the extension configuration is valid
 func (cfg *Config) Validate() error {
-	if cfg.BearerToken == "" && cfg.Filename == "" {
+	if cfg.BearerToken == "" && cfg.Filename == "" && len(cfg.Command) == 0 && len(cfg.Endpoints) == 0 {
 		return errNoTokenProvided
 	}
+	if err := validateTokenSource(cfg.Filename, cfg.Command, cfg.RefreshInterval); err != nil {
+		return err
+	}
+	for i, endpoint := range cfg.Endpoints {
+		if endpoint.Host == "" {
+			return fmt.Errorf("endpoints[%d]: %w", i, errNoEndpointHost)
+		}
+		if endpoint.BearerToken == "" && endpoint.Filename == "" && len(endpoint.Command) == 0 {
+			return fmt.Errorf("endpoints[%d]: %w", i, errNoTokenProvided)
+		}
+		if err := validateTokenSource(endpoint.Filename, endpoint.Command, endpoint.RefreshInterval); err != nil {
+			return fmt.Errorf("endpoints[%d]: %w", i, err)
+		}
+	}
+	return nil
+}
+
+func validateTokenSource(filename string, command []string, refreshInterval time.Duration) error {
+	if filename != "" && len(command) > 0 {
+		return errFilenameAndCommand
+	}
+	if refreshInterval < 0 {
+		return errNegativeRefreshInterval
+	}
 	return nil
 }
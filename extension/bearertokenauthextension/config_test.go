@@ -6,6 +6,7 @@ package bearertokenauthextension
 import (
 	"path/filepath"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -41,6 +42,36 @@ func TestLoadConfig(t *testing.T) {
 				BearerToken: "my-token",
 			},
 		},
+		{
+			id: component.NewIDWithName(metadata.Type, "endpoints"),
+			expected: &Config{
+				Scheme:   defaultScheme,
+				Filename: "/var/run/secrets/default.token",
+				Endpoints: []EndpointConfig{
+					{
+						Host:        "backend-a.example.com",
+						BearerToken: "token-a",
+					},
+					{
+						Host:            "*.example.org",
+						Command:         []string{"get-token", "--audience", "example.org"},
+						RefreshInterval: 10 * time.Minute,
+					},
+				},
+			},
+		},
+		{
+			id:          component.NewIDWithName(metadata.Type, "filenameandcommand"),
+			expectedErr: true,
+		},
+		{
+			id:          component.NewIDWithName(metadata.Type, "endpointwithouthost"),
+			expectedErr: true,
+		},
+		{
+			id:          component.NewIDWithName(metadata.Type, "endpointwithouttoken"),
+			expectedErr: true,
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.id.String(), func(t *testing.T) {
@@ -0,0 +1,135 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package bearertokenauthextension // import "github.com/open-telemetry/opentelemetry-collector-contrib/extension/bearertokenauthextension"
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"net"
+	"os"
+	"os/exec"
+	"strings"
+	"sync"
+	"time"
+
+	"go.opentelemetry.io/collector/config/configopaque"
+	"go.uber.org/zap"
+)
+
+const defaultRefreshInterval = 5 * time.Minute
+
+// tokenSource holds a bearer token, which is either static, read from a file or the output of a command.
+type tokenSource struct {
+	mu    sync.RWMutex
+	token string
+
+	filename        string
+	command         []string
+	refreshInterval time.Duration
+	logger          *zap.Logger
+}
+
+func newTokenSource(token configopaque.String, filename string, command []string, refreshInterval time.Duration, logger *zap.Logger) *tokenSource {
+	if token != "" {
+		switch {
+		case filename != "":
+			logger.Warn("a filename is specified. Configured token is ignored!")
+		case len(command) > 0:
+			logger.Warn("a command is specified. Configured token is ignored!")
+		}
+	}
+	if refreshInterval == 0 {
+		refreshInterval = defaultRefreshInterval
+	}
+	return &tokenSource{
+		token:           string(token),
+		filename:        filename,
+		command:         command,
+		refreshInterval: refreshInterval,
+		logger:          logger,
+	}
+}
+
+func (s *tokenSource) get() string {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return s.token
+}
+
+func (s *tokenSource) set(token string) {
+	s.mu.Lock()
+	s.token = token
+	s.mu.Unlock()
+}
+
+func (s *tokenSource) refreshFromFile() {
+	s.logger.Info("refresh token", zap.String("filename", s.filename))
+	token, err := os.ReadFile(s.filename)
+	if err != nil {
+		s.logger.Error(err.Error())
+		return
+	}
+	s.set(string(token))
+}
+
+// refreshFromCommand executes the command, and uses its standard output without the surrounding whitespaces as the token.
+func (s *tokenSource) refreshFromCommand(ctx context.Context) error {
+	ctx, cancel := context.WithTimeout(ctx, s.refreshInterval)
+	defer cancel()
+
+	var stderr bytes.Buffer
+	// #nosec G204 -- the command is set by the collector configuration
+	cmd := exec.CommandContext(ctx, s.command[0], s.command[1:]...)
+	cmd.Stderr = &stderr
+	out, err := cmd.Output()
+	if err != nil {
+		return fmt.Errorf("failed to execute the token command: %w: %s", err, strings.TrimSpace(stderr.String()))
+	}
+	token := strings.TrimSpace(string(out))
+	if token == "" {
+		return fmt.Errorf("the token command returned an empty token")
+	}
+	s.set(token)
+	return nil
+}
+
+// refreshPeriodically executes the command every refresh interval until the context is done.
+// The previous token is kept when the command fails.
+func (s *tokenSource) refreshPeriodically(ctx context.Context) {
+	ticker := time.NewTicker(s.refreshInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			if err := s.refreshFromCommand(ctx); err != nil && ctx.Err() == nil {
+				s.logger.Error("failed to refresh the token, the previous token is kept", zap.Error(err))
+			}
+		}
+	}
+}
+
+// endpointToken is the token to use for the RPCs to the hosts matching a pattern.
+type endpointToken struct {
+	host  string
+	token *tokenSource
+}
+
+// matches returns whether the host, with an optional port, matches the pattern of the endpoint.
+func (e endpointToken) matches(host string) bool {
+	pattern := strings.ToLower(e.host)
+	host = strings.ToLower(host)
+	if _, _, err := net.SplitHostPort(pattern); err != nil {
+		// the pattern has no port, so it matches any port
+		if hostname, _, err := net.SplitHostPort(host); err == nil {
+			host = hostname
+		}
+	}
+	if suffix, ok := strings.CutPrefix(pattern, "*"); ok {
+		return strings.HasSuffix(host, suffix)
+	}
+	return host == pattern
+}
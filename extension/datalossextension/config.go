@@ -0,0 +1,46 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package datalossextension // import "github.com/open-telemetry/opentelemetry-collector-contrib/extension/datalossextension"
+
+import (
+	"errors"
+	"net/url"
+	"time"
+
+	"go.opentelemetry.io/collector/component"
+)
+
+// Config has the configuration of the data loss extension.
+type Config struct {
+	// Endpoint is the URL of the Prometheus endpoint exposing the internal metrics of the collector, set by the
+	// service::telemetry::metrics::address setting.
+	Endpoint string `mapstructure:"endpoint"`
+
+	// Window is the duration the accepted and sent items are compared over. The windows are aligned on the clock,
+	// e.g. on the minutes with a window of 1m, for the ratios of several collectors to cover the same periods.
+	Window time.Duration `mapstructure:"window"`
+
+	// Timeout of the requests to the Endpoint.
+	Timeout time.Duration `mapstructure:"timeout"`
+}
+
+var _ component.Config = (*Config)(nil)
+
+// Validate checks if the extension configuration is valid
+func (cfg *Config) Validate() error {
+	if cfg.Endpoint == "" {
+		return errors.New("endpoint must be specified")
+	}
+	u, err := url.Parse(cfg.Endpoint)
+	if err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+		return errors.New("endpoint must be an http or https URL")
+	}
+	if cfg.Window <= 0 {
+		return errors.New("window must be positive")
+	}
+	if cfg.Timeout < 0 {
+		return errors.New("timeout must not be negative")
+	}
+	return nil
+}
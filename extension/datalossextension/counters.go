@@ -0,0 +1,103 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package datalossextension // import "github.com/open-telemetry/opentelemetry-collector-contrib/extension/datalossextension"
+
+import (
+	"fmt"
+	"io"
+	"strings"
+
+	dto "github.com/prometheus/client_model/go"
+	"github.com/prometheus/common/expfmt"
+	"go.opentelemetry.io/collector/component"
+)
+
+// The counters of the internal metrics of the receivers and exporters, without the otelcol_ prefix and the suffix of
+// the data type.
+const (
+	receiverAccepted      = "receiver_accepted"
+	receiverRefused       = "receiver_refused"
+	exporterSent          = "exporter_sent"
+	exporterSendFailed    = "exporter_send_failed"
+	exporterEnqueueFailed = "exporter_enqueue_failed"
+)
+
+// dataTypeSuffixes are the suffixes of the counters, by data type.
+var dataTypeSuffixes = map[string]component.DataType{
+	"_spans":         component.DataTypeTraces,
+	"_metric_points": component.DataTypeMetrics,
+	"_log_records":   component.DataTypeLogs,
+}
+
+// counterKey identifies the counter of a component for a data type.
+type counterKey struct {
+	name      string
+	dataType  component.DataType
+	component string
+}
+
+// counters are the values of the counters of the components, summed over their other labels, e.g. the transport of
+// the receivers.
+type counters map[counterKey]float64
+
+// parseCounters parses the counters of the receivers and exporters from the Prometheus text format.
+func parseCounters(r io.Reader) (counters, error) {
+	families, err := new(expfmt.TextParser).TextToMetricFamilies(r)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse the internal metrics: %w", err)
+	}
+	c := counters{}
+	for name, family := range families {
+		if family.GetType() != dto.MetricType_COUNTER {
+			continue
+		}
+		key, label, ok := parseCounterName(name)
+		if !ok {
+			continue
+		}
+		for _, m := range family.GetMetric() {
+			for _, l := range m.GetLabel() {
+				if l.GetName() == label {
+					key.component = l.GetValue()
+					c[key] += m.GetCounter().GetValue()
+					break
+				}
+			}
+		}
+	}
+	return c, nil
+}
+
+// parseCounterName returns the key of the counter, without its component, and the label holding its component, or
+// false if the metric isn't a counter of the receivers and exporters.
+func parseCounterName(name string) (counterKey, string, bool) {
+	name = strings.TrimSuffix(strings.TrimPrefix(name, "otelcol_"), "_total")
+	for suffix, dataType := range dataTypeSuffixes {
+		base, ok := strings.CutSuffix(name, suffix)
+		if !ok {
+			continue
+		}
+		switch base {
+		case receiverAccepted, receiverRefused:
+			return counterKey{name: base, dataType: dataType}, "receiver", true
+		case exporterSent, exporterSendFailed, exporterEnqueueFailed:
+			return counterKey{name: base, dataType: dataType}, "exporter", true
+		}
+	}
+	return counterKey{}, "", false
+}
+
+// delta returns the increase of the counters since the previous values. The counters lower than before, reset by a
+// restart of the collector, are counted from zero.
+func (c counters) delta(previous counters) counters {
+	d := make(counters, len(c))
+	for key, value := range c {
+		if prev := previous[key]; prev <= value {
+			d[key] = value - prev
+		} else {
+			d[key] = value
+		}
+	}
+	return d
+}
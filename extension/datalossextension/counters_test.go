@@ -0,0 +1,54 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package datalossextension
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/collector/component"
+)
+
+const internalMetrics = `# HELP otelcol_receiver_accepted_spans Number of spans successfully pushed into the pipeline.
+# TYPE otelcol_receiver_accepted_spans counter
+otelcol_receiver_accepted_spans{receiver="otlp",service_instance_id="a",transport="grpc"} 100
+otelcol_receiver_accepted_spans{receiver="otlp",service_instance_id="a",transport="http"} 20
+# HELP otelcol_receiver_refused_spans Number of spans that could not be pushed into the pipeline.
+# TYPE otelcol_receiver_refused_spans counter
+otelcol_receiver_refused_spans{receiver="otlp",service_instance_id="a",transport="grpc"} 5
+# HELP otelcol_exporter_sent_metric_points_total Number of metric points successfully sent to destination.
+# TYPE otelcol_exporter_sent_metric_points_total counter
+otelcol_exporter_sent_metric_points_total{exporter="otlp/backend",service_instance_id="a"} 42
+# HELP otelcol_exporter_queue_size Current size of the retry queue (in batches)
+# TYPE otelcol_exporter_queue_size gauge
+otelcol_exporter_queue_size{exporter="otlp/backend",service_instance_id="a"} 3
+# HELP otelcol_process_uptime Uptime of the process
+# TYPE otelcol_process_uptime counter
+otelcol_process_uptime{service_instance_id="a"} 60
+`
+
+func TestParseCounters(t *testing.T) {
+	c, err := parseCounters(strings.NewReader(internalMetrics))
+	require.NoError(t, err)
+	assert.Equal(t, counters{
+		{name: receiverAccepted, dataType: component.DataTypeTraces, component: "otlp"}:      120,
+		{name: receiverRefused, dataType: component.DataTypeTraces, component: "otlp"}:       5,
+		{name: exporterSent, dataType: component.DataTypeMetrics, component: "otlp/backend"}: 42,
+	}, c)
+
+	_, err = parseCounters(strings.NewReader("otelcol_receiver_accepted_spans{"))
+	assert.ErrorContains(t, err, "failed to parse the internal metrics")
+}
+
+func TestDelta(t *testing.T) {
+	accepted := counterKey{name: receiverAccepted, dataType: component.DataTypeLogs, component: "filelog"}
+	sent := counterKey{name: exporterSent, dataType: component.DataTypeLogs, component: "debug"}
+	failed := counterKey{name: exporterSendFailed, dataType: component.DataTypeLogs, component: "debug"}
+	previous := counters{accepted: 10, sent: 50}
+	current := counters{accepted: 25, sent: 5, failed: 2}
+	// the counter reset by a restart and the new counter are counted from zero
+	assert.Equal(t, counters{accepted: 15, sent: 5, failed: 2}, current.delta(previous))
+}
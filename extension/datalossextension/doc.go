@@ -0,0 +1,8 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+//go:generate mdatagen metadata.yaml
+
+// Package datalossextension implements an extension measuring the data lost by the pipelines of the collector, from
+// the items accepted by their receivers and sent by their exporters.
+package datalossextension // import "github.com/open-telemetry/opentelemetry-collector-contrib/extension/datalossextension"
@@ -0,0 +1,305 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package datalossextension // import "github.com/open-telemetry/opentelemetry-collector-contrib/extension/datalossextension"
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"sort"
+	"sync"
+	"time"
+
+	"go.opentelemetry.io/collector/component"
+	"go.opentelemetry.io/collector/extension"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/metric"
+	"go.uber.org/multierr"
+	"go.uber.org/zap"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/extension/datalossextension/internal/metadata"
+)
+
+// scopeName is the instrumentation scope of the metrics of the extension.
+var scopeName = "otelcol/" + metadata.Type.String()
+
+// The stages of the pipelines the items are lost at.
+const (
+	stageReceiver  = "receiver"
+	stageProcessor = "processor"
+	stageExporter  = "exporter"
+)
+
+// The reasons of the losses.
+const (
+	reasonRefused       = "refused"
+	reasonDropped       = "dropped"
+	reasonSendFailed    = "send_failed"
+	reasonEnqueueFailed = "enqueue_failed"
+)
+
+// pipeline holds the receivers and exporters of a pipeline.
+type pipeline struct {
+	receivers map[component.ID]struct{}
+	exporters map[component.ID]struct{}
+}
+
+// pathLoss is the loss of the items received by the receivers of a pipeline and sent by one of its exporters, each
+// exporter of the pipeline sending all the items.
+type pathLoss struct {
+	pipeline component.ID
+	exporter component.ID
+	ratio    float64
+	// items are the items lost, attributed to the stages and components losing them.
+	items []itemsLoss
+}
+
+type itemsLoss struct {
+	stage     string
+	component string
+	reason    string
+	count     int64
+}
+
+// dataLossExtension compares the items accepted by the receivers of each pipeline to the items sent by its exporters
+// over aligned windows, from the internal metrics of the collector, and reports the data loss ratio of each pipeline
+// in the internal metrics.
+type dataLossExtension struct {
+	cfg      *Config
+	settings extension.CreateSettings
+	client   *http.Client
+
+	meter        metric.Meter
+	ratioGauge   metric.Float64ObservableGauge
+	itemsGauge   metric.Int64ObservableGauge
+	registration metric.Registration
+
+	mu sync.Mutex
+	// pipelines are the pipelines of the collector, learned from the status events of their components.
+	pipelines map[component.ID]*pipeline
+	// previous are the counters at the end of the previous window, nil before the first window.
+	previous counters
+	// losses are the losses of the last window.
+	losses []pathLoss
+
+	cancel context.CancelFunc
+	wg     sync.WaitGroup
+}
+
+var (
+	_ extension.Extension     = (*dataLossExtension)(nil)
+	_ extension.StatusWatcher = (*dataLossExtension)(nil)
+)
+
+func newExtension(cfg *Config, set extension.CreateSettings) (*dataLossExtension, error) {
+	e := &dataLossExtension{
+		cfg:       cfg,
+		settings:  set,
+		client:    &http.Client{Timeout: cfg.Timeout},
+		pipelines: map[component.ID]*pipeline{},
+		meter:     set.MeterProvider.Meter(scopeName),
+	}
+	var errs, err error
+	e.ratioGauge, err = e.meter.Float64ObservableGauge(
+		"pipeline_data_loss_ratio",
+		metric.WithDescription("Ratio of the items received by the receivers of the pipeline and not sent by the exporter over the last window"),
+		metric.WithUnit("1"),
+	)
+	errs = multierr.Append(errs, err)
+	e.itemsGauge, err = e.meter.Int64ObservableGauge(
+		"pipeline_data_loss_items",
+		metric.WithDescription("Items received by the receivers of the pipeline and not sent by the exporter over the last window, by component losing them"),
+		metric.WithUnit("{items}"),
+	)
+	errs = multierr.Append(errs, err)
+	if errs != nil {
+		return nil, errs
+	}
+	return e, nil
+}
+
+func (e *dataLossExtension) Start(context.Context, component.Host) error {
+	var err error
+	if e.registration, err = e.meter.RegisterCallback(e.observe, e.ratioGauge, e.itemsGauge); err != nil {
+		return err
+	}
+	ctx, cancel := context.WithCancel(context.Background())
+	e.cancel = cancel
+	e.wg.Add(1)
+	go e.run(ctx)
+	return nil
+}
+
+// run collects the counters at the end of each window, the windows being aligned on the clock.
+func (e *dataLossExtension) run(ctx context.Context) {
+	defer e.wg.Done()
+	for {
+		now := time.Now()
+		timer := time.NewTimer(now.Truncate(e.cfg.Window).Add(e.cfg.Window).Sub(now))
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return
+		case <-timer.C:
+		}
+		if err := e.collect(ctx); err != nil && ctx.Err() == nil {
+			e.settings.Logger.Warn("Failed to collect the internal metrics of the pipelines", zap.Error(err))
+		}
+	}
+}
+
+// collect scrapes the counters of the receivers and exporters, and computes the losses of the pipelines since the
+// previous collection.
+func (e *dataLossExtension) collect(ctx context.Context) error {
+	c, err := e.scrape(ctx)
+
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	if err != nil {
+		// the next window isn't compared to the counters of an older window
+		e.previous = nil
+		e.losses = nil
+		return err
+	}
+	if e.previous != nil {
+		e.losses = e.computeLosses(c.delta(e.previous))
+	}
+	e.previous = c
+	return nil
+}
+
+func (e *dataLossExtension) scrape(ctx context.Context) (counters, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, e.cfg.Endpoint, nil)
+	if err != nil {
+		return nil, err
+	}
+	resp, err := e.client.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("unexpected status of the internal metrics endpoint: %s", resp.Status)
+	}
+	return parseCounters(resp.Body)
+}
+
+// computeLosses computes the losses of the pipelines from the increase of the counters over the window. The items
+// received by the receivers of a pipeline and neither refused by them, nor failed to be sent by the exporter, are
+// attributed to the processors.
+func (e *dataLossExtension) computeLosses(d counters) []pathLoss {
+	var losses []pathLoss
+	for _, pipelineID := range sortedIDs(e.pipelines) {
+		p := e.pipelines[pipelineID]
+		dataType := pipelineID.Type()
+
+		var received, refused float64
+		var refusals []itemsLoss
+		for _, receiverID := range sortedIDs(p.receivers) {
+			key := counterKey{dataType: dataType, component: receiverID.String()}
+			key.name = receiverAccepted
+			received += d[key]
+			key.name = receiverRefused
+			received += d[key]
+			refused += d[key]
+			if d[key] > 0 {
+				refusals = append(refusals, itemsLoss{stage: stageReceiver, component: receiverID.String(), reason: reasonRefused, count: int64(d[key])})
+			}
+		}
+		if received == 0 {
+			continue
+		}
+
+		for _, exporterID := range sortedIDs(p.exporters) {
+			key := counterKey{dataType: dataType, component: exporterID.String()}
+			key.name = exporterSent
+			lost := max(received-d[key], 0)
+			loss := pathLoss{pipeline: pipelineID, exporter: exporterID, ratio: lost / received}
+			loss.items = append(loss.items, refusals...)
+			unaccounted := lost - refused
+			for _, failure := range []struct {
+				name, reason string
+			}{
+				{name: exporterSendFailed, reason: reasonSendFailed},
+				{name: exporterEnqueueFailed, reason: reasonEnqueueFailed},
+			} {
+				key.name = failure.name
+				if d[key] > 0 {
+					loss.items = append(loss.items, itemsLoss{stage: stageExporter, component: exporterID.String(), reason: failure.reason, count: int64(d[key])})
+					unaccounted -= d[key]
+				}
+			}
+			if unaccounted > 0 {
+				loss.items = append(loss.items, itemsLoss{stage: stageProcessor, reason: reasonDropped, count: int64(unaccounted)})
+			}
+			losses = append(losses, loss)
+		}
+	}
+	return losses
+}
+
+func (e *dataLossExtension) observe(_ context.Context, o metric.Observer) error {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	for _, loss := range e.losses {
+		attrs := []attribute.KeyValue{
+			attribute.String("pipeline", loss.pipeline.String()),
+			attribute.String("exporter", loss.exporter.String()),
+		}
+		o.ObserveFloat64(e.ratioGauge, loss.ratio, metric.WithAttributes(attrs...))
+		for _, items := range loss.items {
+			itemsAttrs := append([]attribute.KeyValue{
+				attribute.String("stage", items.stage),
+				attribute.String("reason", items.reason),
+			}, attrs...)
+			if items.component != "" {
+				itemsAttrs = append(itemsAttrs, attribute.String("component", items.component))
+			}
+			o.ObserveInt64(e.itemsGauge, items.count, metric.WithAttributes(itemsAttrs...))
+		}
+	}
+	return nil
+}
+
+// ComponentStatusChanged learns the receivers and exporters of the pipelines from the status events of the
+// components, reported for each component when the collector starts.
+func (e *dataLossExtension) ComponentStatusChanged(source *component.InstanceID, _ *component.StatusEvent) {
+	if source.Kind != component.KindReceiver && source.Kind != component.KindExporter {
+		return
+	}
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	for pipelineID := range source.PipelineIDs {
+		p, ok := e.pipelines[pipelineID]
+		if !ok {
+			p = &pipeline{receivers: map[component.ID]struct{}{}, exporters: map[component.ID]struct{}{}}
+			e.pipelines[pipelineID] = p
+		}
+		if source.Kind == component.KindReceiver {
+			p.receivers[source.ID] = struct{}{}
+		} else {
+			p.exporters[source.ID] = struct{}{}
+		}
+	}
+}
+
+func (e *dataLossExtension) Shutdown(context.Context) error {
+	if e.cancel != nil {
+		e.cancel()
+		e.wg.Wait()
+	}
+	if e.registration != nil {
+		return e.registration.Unregister()
+	}
+	return nil
+}
+
+func sortedIDs[V any](m map[component.ID]V) []component.ID {
+	ids := make([]component.ID, 0, len(m))
+	for id := range m {
+		ids = append(ids, id)
+	}
+	sort.Slice(ids, func(i, j int) bool { return ids[i].String() < ids[j].String() })
+	return ids
+}
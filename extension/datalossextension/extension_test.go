@@ -0,0 +1,157 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package datalossextension
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/collector/component"
+	"go.opentelemetry.io/collector/component/componenttest"
+	"go.opentelemetry.io/collector/extension/extensiontest"
+	"go.opentelemetry.io/otel/attribute"
+	sdkmetric "go.opentelemetry.io/otel/sdk/metric"
+	"go.opentelemetry.io/otel/sdk/metric/metricdata"
+)
+
+// telemetryServer serves the internal metrics of a collector with a traces pipeline.
+type telemetryServer struct {
+	*httptest.Server
+
+	mu                                  sync.Mutex
+	accepted, refused, sent, sendFailed int
+}
+
+func newTelemetryServer(t *testing.T) *telemetryServer {
+	s := &telemetryServer{}
+	s.Server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		s.mu.Lock()
+		defer s.mu.Unlock()
+		fmt.Fprintf(w, `# TYPE otelcol_receiver_accepted_spans counter
+otelcol_receiver_accepted_spans{receiver="otlp",transport="grpc"} %d
+# TYPE otelcol_receiver_refused_spans counter
+otelcol_receiver_refused_spans{receiver="otlp",transport="grpc"} %d
+# TYPE otelcol_exporter_sent_spans counter
+otelcol_exporter_sent_spans{exporter="otlp/backend"} %d
+otelcol_exporter_sent_spans{exporter="debug"} %d
+# TYPE otelcol_exporter_send_failed_spans counter
+otelcol_exporter_send_failed_spans{exporter="otlp/backend"} %d
+`, s.accepted, s.refused, s.sent, s.accepted, s.sendFailed)
+	}))
+	t.Cleanup(s.Close)
+	return s
+}
+
+func (s *telemetryServer) set(accepted, refused, sent, sendFailed int) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.accepted, s.refused, s.sent, s.sendFailed = accepted, refused, sent, sendFailed
+}
+
+func newTestExtension(t *testing.T, endpoint string) (*dataLossExtension, *sdkmetric.ManualReader) {
+	reader := sdkmetric.NewManualReader()
+	set := extensiontest.NewNopCreateSettings()
+	set.MeterProvider = sdkmetric.NewMeterProvider(sdkmetric.WithReader(reader))
+	cfg := createDefaultConfig().(*Config)
+	cfg.Endpoint = endpoint
+	e, err := newExtension(cfg, set)
+	require.NoError(t, err)
+
+	pipelines := map[component.ID]struct{}{component.NewID(component.DataTypeTraces): {}}
+	e.ComponentStatusChanged(&component.InstanceID{ID: component.MustNewID("otlp"), Kind: component.KindReceiver, PipelineIDs: pipelines}, nil)
+	e.ComponentStatusChanged(&component.InstanceID{ID: component.MustNewID("batch"), Kind: component.KindProcessor, PipelineIDs: pipelines}, nil)
+	e.ComponentStatusChanged(&component.InstanceID{ID: component.MustNewIDWithName("otlp", "backend"), Kind: component.KindExporter, PipelineIDs: pipelines}, nil)
+	e.ComponentStatusChanged(&component.InstanceID{ID: component.MustNewID("debug"), Kind: component.KindExporter, PipelineIDs: pipelines}, nil)
+	return e, reader
+}
+
+// gauges returns the values of the data points of the gauge, by their attributes.
+func gauges[N int64 | float64](t *testing.T, reader *sdkmetric.ManualReader, name string) map[attribute.Distinct]N {
+	var rm metricdata.ResourceMetrics
+	require.NoError(t, reader.Collect(context.Background(), &rm))
+	values := map[attribute.Distinct]N{}
+	for _, sm := range rm.ScopeMetrics {
+		assert.Equal(t, "otelcol/dataloss", sm.Scope.Name)
+		for _, m := range sm.Metrics {
+			if m.Name != name {
+				continue
+			}
+			for _, dp := range m.Data.(metricdata.Gauge[N]).DataPoints {
+				values[dp.Attributes.Equivalent()] = dp.Value
+			}
+		}
+	}
+	return values
+}
+
+func attrs(kv ...string) attribute.Distinct {
+	var attrs []attribute.KeyValue
+	for i := 0; i < len(kv); i += 2 {
+		attrs = append(attrs, attribute.String(kv[i], kv[i+1]))
+	}
+	set := attribute.NewSet(attrs...)
+	return set.Equivalent()
+}
+
+func TestCollect(t *testing.T) {
+	server := newTelemetryServer(t)
+	e, reader := newTestExtension(t, server.URL)
+	require.NoError(t, e.Start(context.Background(), componenttest.NewNopHost()))
+	defer func() { require.NoError(t, e.Shutdown(context.Background())) }()
+
+	server.set(1000, 10, 900, 0)
+	require.NoError(t, e.collect(context.Background()))
+	// the first window has no previous counters to compare to
+	assert.Empty(t, gauges[float64](t, reader, "pipeline_data_loss_ratio"))
+
+	server.set(2000, 10, 1700, 100)
+	require.NoError(t, e.collect(context.Background()))
+	assert.Equal(t, map[attribute.Distinct]float64{
+		attrs("pipeline", "traces", "exporter", "debug"):        0,
+		attrs("pipeline", "traces", "exporter", "otlp/backend"): 0.2,
+	}, gauges[float64](t, reader, "pipeline_data_loss_ratio"))
+	assert.Equal(t, map[attribute.Distinct]int64{
+		attrs("pipeline", "traces", "exporter", "otlp/backend", "stage", "exporter", "component", "otlp/backend", "reason", "send_failed"): 100,
+		attrs("pipeline", "traces", "exporter", "otlp/backend", "stage", "processor", "reason", "dropped"):                                 100,
+	}, gauges[int64](t, reader, "pipeline_data_loss_items"))
+
+	server.set(3000, 20, 2700, 100)
+	require.NoError(t, e.collect(context.Background()))
+	assert.Equal(t, map[attribute.Distinct]int64{
+		attrs("pipeline", "traces", "exporter", "debug", "stage", "receiver", "component", "otlp", "reason", "refused"):        10,
+		attrs("pipeline", "traces", "exporter", "otlp/backend", "stage", "receiver", "component", "otlp", "reason", "refused"): 10,
+	}, gauges[int64](t, reader, "pipeline_data_loss_items"))
+	assert.InDelta(t, 10.0/1010, gauges[float64](t, reader, "pipeline_data_loss_ratio")[attrs("pipeline", "traces", "exporter", "debug")], 1e-9)
+
+	// the losses are reset once the internal metrics can't be scraped
+	server.Close()
+	assert.Error(t, e.collect(context.Background()))
+	assert.Empty(t, gauges[float64](t, reader, "pipeline_data_loss_ratio"))
+}
+
+func TestRun(t *testing.T) {
+	server := newTelemetryServer(t)
+	e, reader := newTestExtension(t, server.URL)
+	e.cfg.Window = 20 * time.Millisecond
+	require.NoError(t, e.Start(context.Background(), componenttest.NewNopHost()))
+
+	server.set(100, 0, 50, 0)
+	require.Eventually(t, func() bool {
+		e.mu.Lock()
+		defer e.mu.Unlock()
+		return e.previous != nil
+	}, 10*time.Second, 5*time.Millisecond)
+	server.set(200, 0, 100, 0)
+	require.Eventually(t, func() bool {
+		return gauges[float64](t, reader, "pipeline_data_loss_ratio")[attrs("pipeline", "traces", "exporter", "otlp/backend")] == 0.5
+	}, 10*time.Second, 5*time.Millisecond)
+	require.NoError(t, e.Shutdown(context.Background()))
+}
@@ -0,0 +1,43 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package datalossextension // import "github.com/open-telemetry/opentelemetry-collector-contrib/extension/datalossextension"
+
+import (
+	"context"
+	"time"
+
+	"go.opentelemetry.io/collector/component"
+	"go.opentelemetry.io/collector/extension"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/extension/datalossextension/internal/metadata"
+)
+
+const (
+	// defaultEndpoint is the endpoint of the internal metrics of the collector with the default telemetry settings.
+	defaultEndpoint = "http://localhost:8888/metrics"
+	defaultWindow   = time.Minute
+	defaultTimeout  = 5 * time.Second
+)
+
+// NewFactory creates a factory for the data loss extension.
+func NewFactory() extension.Factory {
+	return extension.NewFactory(
+		metadata.Type,
+		createDefaultConfig,
+		createExtension,
+		metadata.ExtensionStability,
+	)
+}
+
+func createDefaultConfig() component.Config {
+	return &Config{
+		Endpoint: defaultEndpoint,
+		Window:   defaultWindow,
+		Timeout:  defaultTimeout,
+	}
+}
+
+func createExtension(_ context.Context, set extension.CreateSettings, cfg component.Config) (extension.Extension, error) {
+	return newExtension(cfg.(*Config), set)
+}
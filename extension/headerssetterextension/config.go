@@ -5,13 +5,16 @@ package headerssetterextension // import "github.com/open-telemetry/opentelemetr
 
 import (
 	"fmt"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/extension/headerssetterextension/internal/source"
 )
 
 var (
 	errMissingHeader        = fmt.Errorf("missing header name")
 	errMissingHeadersConfig = fmt.Errorf("missing headers configuration")
-	errMissingSource        = fmt.Errorf("missing header source, must be 'from_context' or 'value'")
-	errConflictingSources   = fmt.Errorf("invalid header source, must either 'from_context' or 'value'")
+	errMissingSource        = fmt.Errorf("missing header source, must be 'from_context', 'from_attribute', 'from_resource_attribute', 'template' or 'value'")
+	errConflictingSources   = fmt.Errorf("invalid header source, must either 'from_context', 'from_attribute', 'from_resource_attribute', 'template' or 'value'")
+	errUselessDefaultValue  = fmt.Errorf("invalid header source, 'default_value' can't be used with 'value'")
 )
 
 type Config struct {
@@ -23,6 +26,25 @@ type HeaderConfig struct {
 	Key         *string     `mapstructure:"key"`
 	Value       *string     `mapstructure:"value"`
 	FromContext *string     `mapstructure:"from_context"`
+	// FromAttribute is the name of the attribute of the authenticated client, eg.: a claim of its token.
+	FromAttribute *string `mapstructure:"from_attribute"`
+	// FromResourceAttribute is the name of the resource attribute of the data being sent.
+	FromResourceAttribute *string `mapstructure:"from_resource_attribute"`
+	// Template is a text/template where the other sources are available as functions.
+	Template *string `mapstructure:"template"`
+	// DefaultValue is the value of the header when its source is empty.
+	DefaultValue *string `mapstructure:"default_value"`
+}
+
+// sources returns the number of configured sources of the header.
+func (h HeaderConfig) sources() int {
+	n := 0
+	for _, s := range []*string{h.Value, h.FromContext, h.FromAttribute, h.FromResourceAttribute, h.Template} {
+		if s != nil {
+			n++
+		}
+	}
+	return n
 }
 
 // ActionValue is the enum to capture the four types of actions to perform on a header
@@ -54,12 +76,21 @@ func (cfg *Config) Validate() error {
 		}
 
 		if header.Action != DELETE {
-			if header.FromContext == nil && header.Value == nil {
+			sources := header.sources()
+			if sources == 0 {
 				return errMissingSource
 			}
-			if header.FromContext != nil && header.Value != nil {
+			if sources > 1 {
 				return errConflictingSources
 			}
+			if header.Value != nil && header.DefaultValue != nil {
+				return errUselessDefaultValue
+			}
+			if header.Template != nil {
+				if _, err := source.NewTemplateSource(*header.Template); err != nil {
+					return fmt.Errorf("invalid template of the header %q: %w", *header.Key, err)
+				}
+			}
 		}
 	}
 	return nil
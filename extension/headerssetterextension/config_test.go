@@ -4,6 +4,7 @@
 package headerssetterextension
 
 import (
+	"errors"
 	"path/filepath"
 	"testing"
 
@@ -56,6 +57,41 @@ func TestLoadConfig(t *testing.T) {
 				},
 			},
 		},
+		{
+			id: component.NewIDWithName(metadata.Type, "2"),
+			expected: &Config{
+				HeadersConfig: []HeaderConfig{
+					{
+						Key:           stringp("X-Scope-OrgID"),
+						Action:        UPSERT,
+						FromAttribute: stringp("tenant"),
+						DefaultValue:  stringp("anonymous"),
+					},
+					{
+						Key:                   stringp("X-Namespace"),
+						Action:                UPSERT,
+						FromResourceAttribute: stringp("service.namespace"),
+					},
+					{
+						Key:      stringp("X-Tenant-Region"),
+						Action:   UPSERT,
+						Template: stringp(`{{ from_attribute "tenant" }}-{{ from_context "region" }}`),
+					},
+				},
+			},
+		},
+		{
+			id:            component.NewIDWithName(metadata.Type, "conflicting_sources"),
+			expectedError: errConflictingSources,
+		},
+		{
+			id:            component.NewIDWithName(metadata.Type, "value_with_default"),
+			expectedError: errUselessDefaultValue,
+		},
+		{
+			id:            component.NewIDWithName(metadata.Type, "invalid_template"),
+			expectedError: errors.New(`invalid template of the header "X-Scope-OrgID"`),
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.id.String(), func(t *testing.T) {
@@ -69,7 +105,7 @@ func TestLoadConfig(t *testing.T) {
 			require.NoError(t, sub.Unmarshal(cfg))
 
 			if tt.expectedError != nil {
-				assert.Error(t, component.ValidateConfig(cfg), tt.expectedError)
+				assert.ErrorContains(t, component.ValidateConfig(cfg), tt.expectedError.Error())
 				return
 			}
 			assert.NoError(t, component.ValidateConfig(cfg))
@@ -10,6 +10,7 @@ import (
 	"net/http"
 
 	"go.opentelemetry.io/collector/extension/auth"
+	"go.opentelemetry.io/collector/pdata/pcommon"
 	"go.uber.org/zap"
 	"google.golang.org/grpc/credentials"
 
@@ -17,6 +18,12 @@ import (
 	"github.com/open-telemetry/opentelemetry-collector-contrib/extension/headerssetterextension/internal/source"
 )
 
+// ContextWithResource returns a copy of the context carrying the resource of the data being sent,
+// from which the headers with a 'from_resource_attribute' source, or using it in their template, are read.
+func ContextWithResource(ctx context.Context, resource pcommon.Resource) context.Context {
+	return source.ContextWithResource(ctx, resource)
+}
+
 type Header struct {
 	action action.Action
 	source source.Source
@@ -30,14 +37,35 @@ func newHeadersSetterExtension(cfg *Config, logger *zap.Logger) (auth.Client, er
 	headers := make([]Header, 0, len(cfg.HeadersConfig))
 	for _, header := range cfg.HeadersConfig {
 		var s source.Source
-		if header.Value != nil {
+		switch {
+		case header.Value != nil:
 			s = &source.StaticSource{
 				Value: *header.Value,
 			}
-		} else if header.FromContext != nil {
+		case header.FromContext != nil:
 			s = &source.ContextSource{
 				Key: *header.FromContext,
 			}
+		case header.FromAttribute != nil:
+			s = &source.AttributeSource{
+				Key: *header.FromAttribute,
+			}
+		case header.FromResourceAttribute != nil:
+			s = &source.ResourceAttributeSource{
+				Key: *header.FromResourceAttribute,
+			}
+		case header.Template != nil:
+			ts, err := source.NewTemplateSource(*header.Template)
+			if err != nil {
+				return nil, fmt.Errorf("invalid template of the header %q: %w", *header.Key, err)
+			}
+			s = ts
+		}
+		if s != nil && header.DefaultValue != nil {
+			s = &source.DefaultSource{
+				Source: s,
+				Value:  *header.DefaultValue,
+			}
 		}
 
 		var a action.Action
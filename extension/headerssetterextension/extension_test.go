@@ -9,7 +9,10 @@ import (
 	"testing"
 
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 	"go.opentelemetry.io/collector/client"
+	"go.opentelemetry.io/collector/pdata/pcommon"
+	"go.uber.org/zap"
 )
 
 type mockRoundTripper struct{}
@@ -87,6 +90,108 @@ func TestPerRPCCredentials(t *testing.T) {
 	}
 }
 
+type authData map[string]any
+
+func (a authData) GetAttribute(name string) any {
+	return a[name]
+}
+
+func (a authData) GetAttributeNames() []string {
+	names := make([]string, 0, len(a))
+	for name := range a {
+		names = append(names, name)
+	}
+	return names
+}
+
+func TestHeadersFromAttributesAndResource(t *testing.T) {
+	cfg := &Config{
+		HeadersConfig: []HeaderConfig{
+			{
+				Key:           stringp("X-Scope-OrgID"),
+				Action:        UPSERT,
+				FromAttribute: stringp("tenant"),
+				DefaultValue:  stringp("anonymous"),
+			},
+			{
+				Key:                   stringp("X-Namespace"),
+				Action:                UPSERT,
+				FromResourceAttribute: stringp("service.namespace"),
+			},
+			{
+				Key:      stringp("X-Tenant-Namespace"),
+				Action:   UPSERT,
+				Template: stringp(`{{ from_attribute "tenant" }}/{{ from_resource_attribute "service.namespace" }}`),
+			},
+		},
+	}
+	ext, err := newHeadersSetterExtension(cfg, nil)
+	require.NoError(t, err)
+
+	resource := pcommon.NewResource()
+	resource.Attributes().PutStr("service.namespace", "payments")
+
+	tests := []struct {
+		name     string
+		ctx      context.Context
+		expected map[string]string
+	}{
+		{
+			name: "authenticated",
+			ctx: ContextWithResource(
+				client.NewContext(context.Background(), client.Info{Auth: authData{"tenant": "acme"}}),
+				resource,
+			),
+			expected: map[string]string{
+				"X-Scope-OrgID":      "acme",
+				"X-Namespace":        "payments",
+				"X-Tenant-Namespace": "acme/payments",
+			},
+		},
+		{
+			name: "anonymous",
+			ctx:  context.Background(),
+			expected: map[string]string{
+				"X-Scope-OrgID":      "anonymous",
+				"X-Namespace":        "",
+				"X-Tenant-Namespace": "/",
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			roundTripper, err := ext.RoundTripper(mrt)
+			require.NoError(t, err)
+			req, err := http.NewRequestWithContext(tt.ctx, "GET", "", nil)
+			require.NoError(t, err)
+			resp, err := roundTripper.RoundTrip(req)
+			require.NoError(t, err)
+
+			perRPC, err := ext.PerRPCCredentials()
+			require.NoError(t, err)
+			metadata, err := perRPC.GetRequestMetadata(tt.ctx)
+			require.NoError(t, err)
+
+			for key, value := range tt.expected {
+				assert.Equal(t, value, resp.Header.Get(key), key)
+				assert.Equal(t, value, metadata[key], key)
+			}
+		})
+	}
+}
+
+func TestInvalidTemplate(t *testing.T) {
+	_, err := newHeadersSetterExtension(&Config{
+		HeadersConfig: []HeaderConfig{
+			{
+				Key:      stringp("X-Scope-OrgID"),
+				Template: stringp(`{{ from_context "tenant" `),
+			},
+		},
+	}, zap.NewNop())
+	assert.Error(t, err)
+}
+
 var (
 	mrt           = &mockRoundTripper{}
 	header        = "header_name"
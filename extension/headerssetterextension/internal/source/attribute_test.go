@@ -0,0 +1,68 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package source
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"go.opentelemetry.io/collector/client"
+)
+
+type authData map[string]any
+
+func (a authData) GetAttribute(name string) any {
+	return a[name]
+}
+
+func (a authData) GetAttributeNames() []string {
+	names := make([]string, 0, len(a))
+	for name := range a {
+		names = append(names, name)
+	}
+	return names
+}
+
+func TestAttributeSource(t *testing.T) {
+	ctx := client.NewContext(context.Background(), client.Info{
+		Auth: authData{
+			"tenant":   "acme",
+			"groups":   []string{"admin"},
+			"roles":    []string{"admin", "user"},
+			"level":    3,
+			"subjects": []string{},
+		},
+	})
+
+	tests := []struct {
+		key      string
+		expected string
+		err      bool
+	}{
+		{key: "tenant", expected: "acme"},
+		{key: "groups", expected: "admin"},
+		{key: "roles", err: true},
+		{key: "level", expected: "3"},
+		{key: "subjects", expected: ""},
+		{key: "missing", expected: ""},
+	}
+	for _, tt := range tests {
+		t.Run(tt.key, func(t *testing.T) {
+			value, err := (&AttributeSource{Key: tt.key}).Get(ctx)
+			if tt.err {
+				assert.Error(t, err)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, tt.expected, value)
+		})
+	}
+}
+
+func TestAttributeSourceNotAuthenticated(t *testing.T) {
+	value, err := (&AttributeSource{Key: "tenant"}).Get(context.Background())
+	assert.NoError(t, err)
+	assert.Empty(t, value)
+}
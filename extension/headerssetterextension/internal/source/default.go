@@ -0,0 +1,22 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package source // import "github.com/open-telemetry/opentelemetry-collector-contrib/extension/headerssetterextension/internal/source"
+
+import "context"
+
+var _ Source = (*DefaultSource)(nil)
+
+// DefaultSource returns the default value when the wrapped source returns an empty value.
+type DefaultSource struct {
+	Source Source
+	Value  string
+}
+
+func (ts *DefaultSource) Get(ctx context.Context) (string, error) {
+	value, err := ts.Source.Get(ctx)
+	if err != nil || value != "" {
+		return value, err
+	}
+	return ts.Value, nil
+}
@@ -0,0 +1,33 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package source
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"go.opentelemetry.io/collector/client"
+)
+
+func TestDefaultSource(t *testing.T) {
+	ts := &DefaultSource{Source: &ContextSource{Key: "tenant"}, Value: "default"}
+
+	value, err := ts.Get(context.Background())
+	assert.NoError(t, err)
+	assert.Equal(t, "default", value)
+
+	ctx := client.NewContext(context.Background(), client.Info{
+		Metadata: client.NewMetadata(map[string][]string{"tenant": {"acme"}}),
+	})
+	value, err = ts.Get(ctx)
+	assert.NoError(t, err)
+	assert.Equal(t, "acme", value)
+
+	ctx = client.NewContext(context.Background(), client.Info{
+		Metadata: client.NewMetadata(map[string][]string{"tenant": {"acme", "globex"}}),
+	})
+	_, err = ts.Get(ctx)
+	assert.Error(t, err)
+}
@@ -0,0 +1,36 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package source // import "github.com/open-telemetry/opentelemetry-collector-contrib/extension/headerssetterextension/internal/source"
+
+import (
+	"context"
+
+	"go.opentelemetry.io/collector/pdata/pcommon"
+)
+
+var _ Source = (*ResourceAttributeSource)(nil)
+
+type resourceKey struct{}
+
+// ContextWithResource returns a copy of the context carrying the resource of the data being sent.
+func ContextWithResource(ctx context.Context, resource pcommon.Resource) context.Context {
+	return context.WithValue(ctx, resourceKey{}, resource)
+}
+
+// ResourceAttributeSource reads the value of an attribute of the resource of the data being sent.
+type ResourceAttributeSource struct {
+	Key string
+}
+
+func (ts *ResourceAttributeSource) Get(ctx context.Context) (string, error) {
+	resource, ok := ctx.Value(resourceKey{}).(pcommon.Resource)
+	if !ok {
+		return "", nil
+	}
+	value, ok := resource.Attributes().Get(ts.Key)
+	if !ok {
+		return "", nil
+	}
+	return value.AsString(), nil
+}
@@ -0,0 +1,35 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package source
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"go.opentelemetry.io/collector/pdata/pcommon"
+)
+
+func TestResourceAttributeSource(t *testing.T) {
+	resource := pcommon.NewResource()
+	resource.Attributes().PutStr("service.namespace", "acme")
+	resource.Attributes().PutInt("tenant.id", 42)
+	ctx := ContextWithResource(context.Background(), resource)
+
+	value, err := (&ResourceAttributeSource{Key: "service.namespace"}).Get(ctx)
+	assert.NoError(t, err)
+	assert.Equal(t, "acme", value)
+
+	value, err = (&ResourceAttributeSource{Key: "tenant.id"}).Get(ctx)
+	assert.NoError(t, err)
+	assert.Equal(t, "42", value)
+
+	value, err = (&ResourceAttributeSource{Key: "missing"}).Get(ctx)
+	assert.NoError(t, err)
+	assert.Empty(t, value)
+
+	value, err = (&ResourceAttributeSource{Key: "service.namespace"}).Get(context.Background())
+	assert.NoError(t, err)
+	assert.Empty(t, value)
+}
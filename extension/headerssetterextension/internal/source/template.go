@@ -0,0 +1,59 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package source // import "github.com/open-telemetry/opentelemetry-collector-contrib/extension/headerssetterextension/internal/source"
+
+import (
+	"context"
+	"strings"
+	"text/template"
+)
+
+var _ Source = (*TemplateSource)(nil)
+
+// TemplateSource renders a text/template, where the other sources are available as functions:
+//
+//	{{ from_context "tenant" }}-{{ from_resource_attribute "service.namespace" }}
+type TemplateSource struct {
+	tmpl *template.Template
+}
+
+// NewTemplateSource parses the template.
+func NewTemplateSource(text string) (*TemplateSource, error) {
+	// the functions are placeholders to parse the template, Get binds them to the context
+	tmpl, err := template.New("header").Option("missingkey=error").Funcs(funcs(context.Background())).Parse(text)
+	if err != nil {
+		return nil, err
+	}
+	return &TemplateSource{tmpl: tmpl}, nil
+}
+
+func (ts *TemplateSource) Get(ctx context.Context) (string, error) {
+	tmpl, err := ts.tmpl.Clone()
+	if err != nil {
+		return "", err
+	}
+	var sb strings.Builder
+	if err = tmpl.Funcs(funcs(ctx)).Execute(&sb, nil); err != nil {
+		return "", err
+	}
+	return sb.String(), nil
+}
+
+// funcs returns the template functions reading the sources from the context.
+func funcs(ctx context.Context) template.FuncMap {
+	get := func(s Source) (string, error) {
+		return s.Get(ctx)
+	}
+	return template.FuncMap{
+		"from_context": func(key string) (string, error) {
+			return get(&ContextSource{Key: key})
+		},
+		"from_attribute": func(key string) (string, error) {
+			return get(&AttributeSource{Key: key})
+		},
+		"from_resource_attribute": func(key string) (string, error) {
+			return get(&ResourceAttributeSource{Key: key})
+		},
+	}
+}
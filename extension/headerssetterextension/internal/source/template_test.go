@@ -0,0 +1,51 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package source
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/collector/client"
+	"go.opentelemetry.io/collector/pdata/pcommon"
+)
+
+func TestTemplateSource(t *testing.T) {
+	ts, err := NewTemplateSource(`{{ from_context "region" }}/{{ from_attribute "tenant" }}/{{ from_resource_attribute "service.namespace" | printf "%.4s" }}`)
+	require.NoError(t, err)
+
+	resource := pcommon.NewResource()
+	resource.Attributes().PutStr("service.namespace", "payments")
+	ctx := client.NewContext(context.Background(), client.Info{
+		Metadata: client.NewMetadata(map[string][]string{"region": {"eu"}}),
+		Auth:     authData{"tenant": "acme"},
+	})
+	ctx = ContextWithResource(ctx, resource)
+
+	value, err := ts.Get(ctx)
+	assert.NoError(t, err)
+	assert.Equal(t, "eu/acme/paym", value)
+
+	value, err = ts.Get(context.Background())
+	assert.NoError(t, err)
+	assert.Equal(t, "//", value)
+}
+
+func TestTemplateSourceErrors(t *testing.T) {
+	_, err := NewTemplateSource(`{{ from_context "region" `)
+	assert.Error(t, err)
+
+	_, err = NewTemplateSource(`{{ from_unknown "region" }}`)
+	assert.Error(t, err)
+
+	ts, err := NewTemplateSource(`{{ from_context "region" }}`)
+	require.NoError(t, err)
+	ctx := client.NewContext(context.Background(), client.Info{
+		Metadata: client.NewMetadata(map[string][]string{"region": {"eu", "us"}}),
+	})
+	_, err = ts.Get(ctx)
+	assert.Error(t, err)
+}
@@ -0,0 +1,49 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package healthcheckextension // import "github.com/open-telemetry/opentelemetry-collector-contrib/extension/healthcheckextension"
+
+import (
+	"encoding/json"
+	"net/http"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/extension/healthcheckextension/internal/healthcheck"
+)
+
+// componentHealthResponse is the body of the responses of the component health endpoints.
+type componentHealthResponse struct {
+	Ready     bool                                  `json:"ready"`
+	Live      bool                                  `json:"live"`
+	Pipelines map[string]healthcheck.PipelineStatus `json:"pipelines"`
+}
+
+// mountComponentHealth mounts the readiness, liveness and status handlers when they are enabled.
+func (hc *healthCheckExtension) mountComponentHealth(mux *http.ServeMux) {
+	if !hc.config.ComponentHealth.Enabled {
+		return
+	}
+	mux.Handle(hc.config.ComponentHealth.ReadyPath, hc.componentHealthHandler(func(resp componentHealthResponse) bool { return resp.Ready }))
+	mux.Handle(hc.config.ComponentHealth.LivePath, hc.componentHealthHandler(func(resp componentHealthResponse) bool { return resp.Live }))
+	mux.Handle(hc.config.ComponentHealth.StatusPath, hc.componentHealthHandler(func(componentHealthResponse) bool { return true }))
+}
+
+// componentHealthHandler responds with the health of the components, with the status code
+// 503 Service Unavailable when healthy returns false.
+func (hc *healthCheckExtension) componentHealthHandler(healthy func(componentHealthResponse) bool) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		resp := componentHealthResponse{
+			// the pipelines are not ready while the collector is starting or shutting down
+			Ready:     hc.state.Get() == healthcheck.Ready && hc.components.Ready(),
+			Live:      hc.components.Live(),
+			Pipelines: hc.components.Pipelines(),
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		if healthy(resp) {
+			w.WriteHeader(http.StatusOK)
+		} else {
+			w.WriteHeader(http.StatusServiceUnavailable)
+		}
+		_ = json.NewEncoder(w).Encode(resp)
+	})
+}
@@ -0,0 +1,136 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package healthcheckextension
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/collector/component"
+	"go.opentelemetry.io/collector/component/componenttest"
+	"go.opentelemetry.io/collector/config/confighttp"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/internal/common/testutil"
+)
+
+func TestComponentHealth(t *testing.T) {
+	endpoint := testutil.GetAvailableLocalAddress(t)
+	config := Config{
+		ServerConfig: confighttp.ServerConfig{
+			Endpoint: endpoint,
+		},
+		CheckCollectorPipeline: defaultCheckCollectorPipelineSettings(),
+		ComponentHealth:        defaultComponentHealthSettings(),
+		Path:                   "/",
+	}
+	config.ComponentHealth.Enabled = true
+
+	hcExt := newServer(config, componenttest.NewNopTelemetrySettings())
+	require.NotNil(t, hcExt)
+	require.NoError(t, hcExt.Start(context.Background(), componenttest.NewNopHost()))
+	t.Cleanup(func() { require.NoError(t, hcExt.Shutdown(context.Background())) })
+	require.Eventuallyf(t, ensureServerRunning(endpoint), 30*time.Second, 1*time.Second, "Failed to start the testing server.")
+
+	traces := component.NewID(component.MustNewType("traces"))
+	metrics := component.NewID(component.MustNewType("metrics"))
+	receiver := &component.InstanceID{
+		ID:          component.NewID(component.MustNewType("otlp")),
+		Kind:        component.KindReceiver,
+		PipelineIDs: map[component.ID]struct{}{traces: {}, metrics: {}},
+	}
+	exporter := &component.InstanceID{
+		ID:          component.NewID(component.MustNewType("otlp")),
+		Kind:        component.KindExporter,
+		PipelineIDs: map[component.ID]struct{}{traces: {}},
+	}
+	ext := &component.InstanceID{
+		ID:   component.NewID(component.MustNewType("health_check")),
+		Kind: component.KindExtension,
+	}
+
+	get := func(path string) (int, componentHealthResponse) {
+		resp, err := http.Get("http://" + endpoint + path)
+		require.NoError(t, err)
+		defer resp.Body.Close()
+		var body componentHealthResponse
+		require.NoError(t, json.NewDecoder(resp.Body).Decode(&body))
+		return resp.StatusCode, body
+	}
+
+	// starting
+	for _, source := range []*component.InstanceID{receiver, exporter, ext} {
+		hcExt.ComponentStatusChanged(source, component.NewStatusEvent(component.StatusStarting))
+	}
+	status, _ := get("/ready")
+	assert.Equal(t, http.StatusServiceUnavailable, status)
+	status, _ = get("/live")
+	assert.Equal(t, http.StatusOK, status)
+
+	// running, but the pipelines are not ready yet
+	for _, source := range []*component.InstanceID{receiver, exporter, ext} {
+		hcExt.ComponentStatusChanged(source, component.NewStatusEvent(component.StatusOK))
+	}
+	status, _ = get("/ready")
+	assert.Equal(t, http.StatusServiceUnavailable, status)
+
+	require.NoError(t, hcExt.Ready())
+	status, body := get("/ready")
+	assert.Equal(t, http.StatusOK, status)
+	assert.True(t, body.Ready)
+	assert.True(t, body.Live)
+	assert.Len(t, body.Pipelines, 3)
+
+	// a recoverable error makes the collector not ready, but alive
+	hcExt.ComponentStatusChanged(exporter, component.NewRecoverableErrorEvent(errors.New("connection refused")))
+	status, _ = get("/ready")
+	assert.Equal(t, http.StatusServiceUnavailable, status)
+	status, _ = get("/live")
+	assert.Equal(t, http.StatusOK, status)
+
+	// a permanent error makes the collector not alive
+	hcExt.ComponentStatusChanged(exporter, component.NewPermanentErrorEvent(errors.New("invalid credentials")))
+	status, _ = get("/live")
+	assert.Equal(t, http.StatusServiceUnavailable, status)
+
+	status, body = get("/status")
+	assert.Equal(t, http.StatusOK, status)
+	assert.False(t, body.Ready)
+	assert.False(t, body.Live)
+	assert.Equal(t, "StatusPermanentError", body.Pipelines["traces"].Status)
+	require.Len(t, body.Pipelines["traces"].UnhealthyComponents, 1)
+	unhealthy := body.Pipelines["traces"].UnhealthyComponents["exporter:otlp"]
+	assert.Equal(t, "StatusPermanentError", unhealthy.Status)
+	assert.Equal(t, "invalid credentials", unhealthy.Error)
+	assert.Equal(t, "StatusOK", body.Pipelines["metrics"].Status)
+	assert.Empty(t, body.Pipelines["metrics"].UnhealthyComponents)
+	assert.Equal(t, "StatusOK", body.Pipelines["extensions"].Status)
+}
+
+func TestComponentHealthDisabled(t *testing.T) {
+	endpoint := testutil.GetAvailableLocalAddress(t)
+	config := Config{
+		ServerConfig: confighttp.ServerConfig{
+			Endpoint: endpoint,
+		},
+		CheckCollectorPipeline: defaultCheckCollectorPipelineSettings(),
+		ComponentHealth:        defaultComponentHealthSettings(),
+		Path:                   "/health",
+	}
+
+	hcExt := newServer(config, componenttest.NewNopTelemetrySettings())
+	require.NoError(t, hcExt.Start(context.Background(), componenttest.NewNopHost()))
+	t.Cleanup(func() { require.NoError(t, hcExt.Shutdown(context.Background())) })
+	require.Eventuallyf(t, ensureServerRunning(endpoint), 30*time.Second, 1*time.Second, "Failed to start the testing server.")
+
+	resp, err := http.Get("http://" + endpoint + "/ready")
+	require.NoError(t, err)
+	require.NoError(t, resp.Body.Close())
+	assert.Equal(t, http.StatusNotFound, resp.StatusCode)
+}
@@ -37,6 +37,9 @@ type Config struct {
 
 	// CheckCollectorPipeline contains the list of settings of collector pipeline health check
 	CheckCollectorPipeline checkCollectorPipelineSettings `mapstructure:"check_collector_pipeline"`
+
+	// ComponentHealth contains the settings of the health check sourced from the component status events
+	ComponentHealth componentHealthSettings `mapstructure:"component_health"`
 }
 
 var _ component.Config = (*Config)(nil)
@@ -44,6 +47,7 @@ var (
 	errNoEndpointProvided                      = errors.New("bad config: endpoint must be specified")
 	errInvalidExporterFailureThresholdProvided = errors.New("bad config: exporter_failure_threshold expects a positive number")
 	errInvalidPath                             = errors.New("bad config: path must start with /")
+	errDuplicatePath                           = errors.New("bad config: path, ready_path, live_path and status_path must be different")
 )
 
 // Validate checks if the extension configuration is valid
@@ -61,6 +65,18 @@ func (cfg *Config) Validate() error {
 	if !strings.HasPrefix(cfg.Path, "/") {
 		return errInvalidPath
 	}
+	if cfg.ComponentHealth.Enabled {
+		paths := map[string]struct{}{cfg.Path: {}}
+		for _, path := range []string{cfg.ComponentHealth.ReadyPath, cfg.ComponentHealth.LivePath, cfg.ComponentHealth.StatusPath} {
+			if !strings.HasPrefix(path, "/") {
+				return errInvalidPath
+			}
+			if _, ok := paths[path]; ok {
+				return errDuplicatePath
+			}
+			paths[path] = struct{}{}
+		}
+	}
 	return nil
 }
 
@@ -72,3 +88,18 @@ type checkCollectorPipelineSettings struct {
 	// ExporterFailureThreshold is the threshold of exporter failure numbers during the Interval
 	ExporterFailureThreshold int `mapstructure:"exporter_failure_threshold"`
 }
+
+type componentHealthSettings struct {
+	// Enabled indicates whether to serve the readiness, liveness and status endpoints reporting the
+	// health from the status events of the components.
+	Enabled bool `mapstructure:"enabled"`
+	// ReadyPath is the path of the readiness endpoint, failing until the pipelines are ready and
+	// all the components are running without error. The default path is "/ready".
+	ReadyPath string `mapstructure:"ready_path"`
+	// LivePath is the path of the liveness endpoint, failing when a component is in a permanent
+	// or fatal error state. The default path is "/live".
+	LivePath string `mapstructure:"live_path"`
+	// StatusPath is the path of the endpoint listing the unhealthy components of each pipeline.
+	// The default path is "/status".
+	StatusPath string `mapstructure:"status_path"`
+}
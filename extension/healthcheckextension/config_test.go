@@ -43,10 +43,27 @@ func TestLoadConfig(t *testing.T) {
 					},
 				},
 				CheckCollectorPipeline: defaultCheckCollectorPipelineSettings(),
+				ComponentHealth:        defaultComponentHealthSettings(),
 				Path:                   "/",
 				ResponseBody:           nil,
 			},
 		},
+		{
+			id: component.NewIDWithName(metadata.Type, "componenthealth"),
+			expected: &Config{
+				ServerConfig: confighttp.ServerConfig{
+					Endpoint: "localhost:13",
+				},
+				CheckCollectorPipeline: defaultCheckCollectorPipelineSettings(),
+				ComponentHealth: componentHealthSettings{
+					Enabled:    true,
+					ReadyPath:  "/readyz",
+					LivePath:   "/livez",
+					StatusPath: "/status",
+				},
+				Path: "/",
+			},
+		},
 		{
 			id:          component.NewIDWithName(metadata.Type, "missingendpoint"),
 			expectedErr: errNoEndpointProvided,
@@ -59,6 +76,10 @@ func TestLoadConfig(t *testing.T) {
 			id:          component.NewIDWithName(metadata.Type, "invalidpath"),
 			expectedErr: errInvalidPath,
 		},
+		{
+			id:          component.NewIDWithName(metadata.Type, "duplicatepath"),
+			expectedErr: errDuplicatePath,
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.id.String(), func(t *testing.T) {
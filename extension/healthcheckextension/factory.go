@@ -32,6 +32,7 @@ func createDefaultConfig() component.Config {
 			Endpoint: localhostgate.EndpointForPort(defaultPort),
 		},
 		CheckCollectorPipeline: defaultCheckCollectorPipelineSettings(),
+		ComponentHealth:        defaultComponentHealthSettings(),
 		Path:                   "/",
 	}
 }
@@ -50,3 +51,13 @@ func defaultCheckCollectorPipelineSettings() checkCollectorPipelineSettings {
 		ExporterFailureThreshold: 5,
 	}
 }
+
+// defaultComponentHealthSettings returns the default settings for ComponentHealth.
+func defaultComponentHealthSettings() componentHealthSettings {
+	return componentHealthSettings{
+		Enabled:    false,
+		ReadyPath:  "/ready",
+		LivePath:   "/live",
+		StatusPath: "/status",
+	}
+}
@@ -19,16 +19,18 @@ import (
 )
 
 type healthCheckExtension struct {
-	config   Config
-	logger   *zap.Logger
-	state    *healthcheck.HealthCheck
-	server   *http.Server
-	stopCh   chan struct{}
-	exporter *healthCheckExporter
-	settings component.TelemetrySettings
+	config     Config
+	logger     *zap.Logger
+	state      *healthcheck.HealthCheck
+	components *healthcheck.Components
+	server     *http.Server
+	stopCh     chan struct{}
+	exporter   *healthCheckExporter
+	settings   component.TelemetrySettings
 }
 
 var _ extension.PipelineWatcher = (*healthCheckExtension)(nil)
+var _ extension.StatusWatcher = (*healthCheckExtension)(nil)
 
 func (hc *healthCheckExtension) Start(ctx context.Context, host component.Host) error {
 
@@ -47,6 +49,7 @@ func (hc *healthCheckExtension) Start(ctx context.Context, host component.Host)
 		// Mount HC handler
 		mux := http.NewServeMux()
 		mux.Handle(hc.config.Path, hc.baseHandler())
+		hc.mountComponentHealth(mux)
 		hc.server.Handler = mux
 		hc.stopCh = make(chan struct{})
 		go func() {
@@ -72,6 +75,7 @@ func (hc *healthCheckExtension) Start(ctx context.Context, host component.Host)
 
 		mux := http.NewServeMux()
 		mux.Handle(hc.config.Path, hc.checkCollectorPipelineHandler())
+		hc.mountComponentHealth(mux)
 		hc.server.Handler = mux
 		hc.stopCh = make(chan struct{})
 		go func() {
@@ -157,12 +161,18 @@ func (hc *healthCheckExtension) NotReady() error {
 	return nil
 }
 
+// ComponentStatusChanged implements the extension.StatusWatcher interface.
+func (hc *healthCheckExtension) ComponentStatusChanged(source *component.InstanceID, event *component.StatusEvent) {
+	hc.components.Set(source, event)
+}
+
 func newServer(config Config, settings component.TelemetrySettings) *healthCheckExtension {
 	hc := &healthCheckExtension{
-		config:   config,
-		logger:   settings.Logger,
-		state:    healthcheck.New(),
-		settings: settings,
+		config:     config,
+		logger:     settings.Logger,
+		state:      healthcheck.New(),
+		components: healthcheck.NewComponents(),
+		settings:   settings,
 	}
 
 	hc.state.SetLogger(settings.Logger)
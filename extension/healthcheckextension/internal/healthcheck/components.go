@@ -0,0 +1,117 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package healthcheck // import "github.com/open-telemetry/opentelemetry-collector-contrib/extension/healthcheckextension/internal/healthcheck"
+
+import (
+	"strings"
+	"sync"
+	"time"
+
+	"go.opentelemetry.io/collector/component"
+)
+
+// extensionsKey groups the status of the extensions, which don't belong to any pipeline.
+const extensionsKey = "extensions"
+
+// Components aggregates the status events reported by the components, per pipeline.
+type Components struct {
+	mu sync.RWMutex
+	// pipelines maps a pipeline ID to the last status event of each of its components.
+	pipelines map[string]map[string]*component.StatusEvent
+}
+
+// ComponentStatus is the detail of the status of a component.
+type ComponentStatus struct {
+	Status    string    `json:"status"`
+	Error     string    `json:"error,omitempty"`
+	Timestamp time.Time `json:"timestamp"`
+}
+
+// PipelineStatus is the detail of the status of a pipeline.
+type PipelineStatus struct {
+	Status string `json:"status"`
+	// UnhealthyComponents are the components of the pipeline in an error state.
+	UnhealthyComponents map[string]ComponentStatus `json:"unhealthy_components,omitempty"`
+}
+
+// NewComponents creates an empty Components.
+func NewComponents() *Components {
+	return &Components{pipelines: map[string]map[string]*component.StatusEvent{}}
+}
+
+// Set records the status event of the source component in each of its pipelines.
+func (c *Components) Set(source *component.InstanceID, event *component.StatusEvent) {
+	// named as in the configuration, e.g. "exporter:otlp/backend"
+	name := strings.ToLower(source.Kind.String()) + ":" + source.ID.String()
+	keys := make([]string, 0, len(source.PipelineIDs))
+	for pipelineID := range source.PipelineIDs {
+		keys = append(keys, pipelineID.String())
+	}
+	if len(keys) == 0 {
+		keys = append(keys, extensionsKey)
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	for _, key := range keys {
+		components, ok := c.pipelines[key]
+		if !ok {
+			components = map[string]*component.StatusEvent{}
+			c.pipelines[key] = components
+		}
+		components[name] = event
+	}
+}
+
+// Live returns false when a component is in a permanent or fatal error state, ie.: the collector
+// can't recover without being restarted.
+func (c *Components) Live() bool {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	for _, components := range c.pipelines {
+		for _, event := range components {
+			if event.Status() == component.StatusPermanentError || event.Status() == component.StatusFatalError {
+				return false
+			}
+		}
+	}
+	return true
+}
+
+// Ready returns true when all the components which reported their status are running without error.
+func (c *Components) Ready() bool {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	for _, components := range c.pipelines {
+		if component.AggregateStatus(components) != component.StatusOK {
+			return false
+		}
+	}
+	return true
+}
+
+// Pipelines returns the aggregated status of each pipeline, with its unhealthy components.
+func (c *Components) Pipelines() map[string]PipelineStatus {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	pipelines := make(map[string]PipelineStatus, len(c.pipelines))
+	for key, components := range c.pipelines {
+		pipeline := PipelineStatus{Status: component.AggregateStatus(components).String()}
+		for name, event := range components {
+			if !component.StatusIsError(event.Status()) {
+				continue
+			}
+			if pipeline.UnhealthyComponents == nil {
+				pipeline.UnhealthyComponents = map[string]ComponentStatus{}
+			}
+			status := ComponentStatus{Status: event.Status().String(), Timestamp: event.Timestamp()}
+			if event.Err() != nil {
+				status.Error = event.Err().Error()
+			}
+			pipeline.UnhealthyComponents[name] = status
+		}
+		pipelines[key] = pipeline
+	}
+	return pipelines
+}
@@ -13,9 +13,11 @@ import (
 )
 
 var (
-	errTooManySources     = errors.New("too many sources specified, has to be either 'file' or 'remote'")
-	errNoSources          = errors.New("no sources specified, has to be either 'file' or 'remote'")
-	errAtLeastOneProtocol = errors.New("no protocols selected to serve the strategies, use 'grpc', 'http', or both")
+	errTooManySources          = errors.New("too many sources specified, has to be either 'file', 'remote' or 'adaptive'")
+	errNoSources               = errors.New("no sources specified, has to be either 'file', 'remote' or 'adaptive'")
+	errAtLeastOneProtocol      = errors.New("no protocols selected to serve the strategies, use 'grpc', 'http', or both")
+	errNegativeAdaptiveSetting = errors.New("the adaptive settings must not be negative")
+	errInvalidProbability      = errors.New("the adaptive sampling probabilities must be between 0 and 1")
 )
 
 // Config has the configuration for the extension enabling the health check
@@ -37,6 +39,39 @@ type Source struct {
 
 	// ReloadInterval determines the periodicity to refresh the strategies
 	ReloadInterval time.Duration `mapstructure:"reload_interval"`
+
+	// Adaptive computes the strategies from the throughput of the root spans reported to the extension
+	Adaptive *AdaptiveConfig `mapstructure:"adaptive"`
+}
+
+// AdaptiveConfig configures the adaptive sampling strategies, which are computed per service and operation
+// to reach a target number of sampled traces per second. The zero values are replaced by the defaults.
+type AdaptiveConfig struct {
+	// TargetSamplesPerSecond is the target number of sampled traces per second of each operation. Defaults to 1.
+	TargetSamplesPerSecond float64 `mapstructure:"target_samples_per_second"`
+
+	// DeltaTolerance is the accepted deviation between the observed and the target samples per second,
+	// expressed as a ratio. Defaults to 0.3.
+	DeltaTolerance float64 `mapstructure:"delta_tolerance"`
+
+	// CalculationInterval determines how often the probabilities are calculated. Defaults to 1m.
+	CalculationInterval time.Duration `mapstructure:"calculation_interval"`
+
+	// AggregationBuckets is the number of calculation intervals of throughput kept in memory. Defaults to 10.
+	AggregationBuckets int `mapstructure:"aggregation_buckets"`
+
+	// Delay excludes the most recent throughput from the calculation, so that its aggregation
+	// can complete. Defaults to 2m.
+	Delay time.Duration `mapstructure:"delay"`
+
+	// InitialSamplingProbability is the sampling probability of the new operations. Defaults to 0.001.
+	InitialSamplingProbability float64 `mapstructure:"initial_sampling_probability"`
+
+	// MinSamplingProbability is the minimum sampling probability of the operations. Defaults to 0.00001.
+	MinSamplingProbability float64 `mapstructure:"min_sampling_probability"`
+
+	// MinSamplesPerSecond is the lower bound of sampled traces per second of each operation. Defaults to 1/60.
+	MinSamplesPerSecond float64 `mapstructure:"min_samples_per_second"`
 }
 
 var _ component.Config = (*Config)(nil)
@@ -47,13 +82,40 @@ func (cfg *Config) Validate() error {
 		return errAtLeastOneProtocol
 	}
 
-	if cfg.Source.File != "" && cfg.Source.Remote != nil {
+	sources := 0
+	if cfg.Source.File != "" {
+		sources++
+	}
+	if cfg.Source.Remote != nil {
+		sources++
+	}
+	if cfg.Source.Adaptive != nil {
+		sources++
+	}
+
+	if sources > 1 {
 		return errTooManySources
 	}
 
-	if cfg.Source.File == "" && cfg.Source.Remote == nil {
+	if sources == 0 {
 		return errNoSources
 	}
 
 	return nil
 }
+
+// Validate checks if the adaptive sampling configuration is valid
+func (cfg *AdaptiveConfig) Validate() error {
+	if cfg.TargetSamplesPerSecond < 0 || cfg.DeltaTolerance < 0 || cfg.CalculationInterval < 0 ||
+		cfg.AggregationBuckets < 0 || cfg.Delay < 0 || cfg.MinSamplesPerSecond < 0 {
+		return errNegativeAdaptiveSetting
+	}
+
+	for _, probability := range []float64{cfg.InitialSamplingProbability, cfg.MinSamplingProbability} {
+		if probability < 0 || probability > 1 {
+			return errInvalidProbability
+		}
+	}
+
+	return nil
+}
@@ -55,6 +55,23 @@ func TestLoadConfig(t *testing.T) {
 				},
 			},
 		},
+		{
+			id: component.NewIDWithName(metadata.Type, "2"),
+			expected: &Config{
+				HTTPServerConfig: &confighttp.ServerConfig{Endpoint: "0.0.0.0:5778"},
+				GRPCServerConfig: &configgrpc.ServerConfig{NetAddr: confignet.AddrConfig{
+					Endpoint:  "0.0.0.0:14250",
+					Transport: confignet.TransportTypeTCP,
+				}},
+				Source: Source{
+					Adaptive: &AdaptiveConfig{
+						TargetSamplesPerSecond: 10,
+						CalculationInterval:    30 * time.Second,
+						MinSamplingProbability: 0.001,
+					},
+				},
+			},
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.id.String(), func(t *testing.T) {
@@ -71,6 +88,44 @@ func TestLoadConfig(t *testing.T) {
 	}
 }
 
+func TestValidateAdaptive(t *testing.T) {
+	testCases := []struct {
+		desc     string
+		cfg      AdaptiveConfig
+		expected error
+	}{
+		{
+			desc: "defaults",
+			cfg:  AdaptiveConfig{},
+		},
+		{
+			desc:     "negative target",
+			cfg:      AdaptiveConfig{TargetSamplesPerSecond: -1},
+			expected: errNegativeAdaptiveSetting,
+		},
+		{
+			desc:     "negative calculation interval",
+			cfg:      AdaptiveConfig{CalculationInterval: -time.Second},
+			expected: errNegativeAdaptiveSetting,
+		},
+		{
+			desc:     "invalid initial probability",
+			cfg:      AdaptiveConfig{InitialSamplingProbability: 1.5},
+			expected: errInvalidProbability,
+		},
+		{
+			desc:     "invalid min probability",
+			cfg:      AdaptiveConfig{MinSamplingProbability: -0.1},
+			expected: errInvalidProbability,
+		},
+	}
+	for _, tC := range testCases {
+		t.Run(tC.desc, func(t *testing.T) {
+			assert.Equal(t, tC.expected, tC.cfg.Validate())
+		})
+	}
+}
+
 func TestValidate(t *testing.T) {
 
 	testCases := []struct {
@@ -101,6 +156,26 @@ func TestValidate(t *testing.T) {
 			},
 			expected: errTooManySources,
 		},
+		{
+			desc: "file and adaptive sources",
+			cfg: Config{
+				GRPCServerConfig: &configgrpc.ServerConfig{},
+				Source: Source{
+					File:     "/tmp/some-file",
+					Adaptive: &AdaptiveConfig{},
+				},
+			},
+			expected: errTooManySources,
+		},
+		{
+			desc: "adaptive source",
+			cfg: Config{
+				GRPCServerConfig: &configgrpc.ServerConfig{},
+				Source: Source{
+					Adaptive: &AdaptiveConfig{},
+				},
+			},
+		},
 	}
 	for _, tC := range testCases {
 		t.Run(tC.desc, func(t *testing.T) {
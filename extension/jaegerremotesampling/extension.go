@@ -6,11 +6,14 @@ package jaegerremotesampling // import "github.com/open-telemetry/opentelemetry-
 import (
 	"context"
 	"fmt"
+	"strings"
 
 	"github.com/jaegertracing/jaeger/cmd/collector/app/sampling/strategystore"
+	"github.com/jaegertracing/jaeger/plugin/sampling/strategystore/adaptive"
 	"github.com/jaegertracing/jaeger/plugin/sampling/strategystore/static"
 	"go.opentelemetry.io/collector/component"
 	"go.opentelemetry.io/collector/extension"
+	"go.opentelemetry.io/collector/pdata/ptrace"
 	"go.uber.org/zap"
 
 	"github.com/open-telemetry/opentelemetry-collector-contrib/extension/jaegerremotesampling/internal"
@@ -25,10 +28,19 @@ type jrsExtension struct {
 	httpServer    component.Component
 	grpcServer    component.Component
 	samplingStore strategystore.StrategyStore
+	adaptiveStore *internal.AdaptiveStrategyStore
 
 	closers []func() error
 }
 
+// TracesObserver is implemented by the extension, so that the components processing the traces, e.g. a processor,
+// can find it with component.Host.GetExtensions and report the traces used to compute the adaptive strategies.
+type TracesObserver interface {
+	ObserveTraces(td ptrace.Traces)
+}
+
+var _ TracesObserver = (*jrsExtension)(nil)
+
 func newExtension(cfg *Config, telemetry component.TelemetrySettings) *jrsExtension {
 	jrse := &jrsExtension{
 		cfg:       cfg,
@@ -48,16 +60,31 @@ func (jrse *jrsExtension) Start(ctx context.Context, host component.Host) error
 			StrategiesFile: jrse.cfg.Source.File,
 			ReloadInterval: jrse.cfg.Source.ReloadInterval,
 		}
-		ss, err := static.NewStrategyStore(opts, jrse.telemetry.Logger)
+		var ss strategystore.StrategyStore
+		var err error
+		if isURL(jrse.cfg.Source.File) {
+			ss, err = static.NewStrategyStore(opts, jrse.telemetry.Logger)
+		} else {
+			// the local files are also reloaded as soon as they change
+			ss, err = internal.NewFileStrategyStore(opts, jrse.telemetry.Logger)
+		}
 		if err != nil {
 			return fmt.Errorf("failed to create the local file strategy store: %w", err)
 		}
-
-		// there's a Close function on the concrete type, which is not visible to us...
-		// how can we close it then?
+		jrse.closers = append(jrse.closers, ss.Close)
 		jrse.samplingStore = ss
 	}
 
+	if jrse.cfg.Source.Adaptive != nil {
+		adaptiveStore, err := internal.NewAdaptiveStrategyStore(adaptiveOptions(jrse.cfg.Source.Adaptive), jrse.telemetry.Logger)
+		if err != nil {
+			return fmt.Errorf("failed to create the adaptive strategy store: %w", err)
+		}
+		jrse.closers = append(jrse.closers, adaptiveStore.Close)
+		jrse.adaptiveStore = adaptiveStore
+		jrse.samplingStore = adaptiveStore
+	}
+
 	if jrse.cfg.Source.Remote != nil {
 		conn, err := jrse.cfg.Source.Remote.ToClientConn(ctx, host, jrse.telemetry)
 		if err != nil {
@@ -100,6 +127,14 @@ func (jrse *jrsExtension) Start(ctx context.Context, host component.Host) error
 	return nil
 }
 
+// ObserveTraces records the throughput of the root spans of the traces, when the strategies are adaptive.
+func (jrse *jrsExtension) ObserveTraces(td ptrace.Traces) {
+	if jrse.adaptiveStore == nil {
+		return
+	}
+	jrse.adaptiveStore.RecordTraces(td)
+}
+
 func (jrse *jrsExtension) Shutdown(ctx context.Context) error {
 	// we probably don't want to break whenever an error occurs, we want to continue and close the other resources
 	if jrse.httpServer != nil {
@@ -122,3 +157,49 @@ func (jrse *jrsExtension) Shutdown(ctx context.Context) error {
 
 	return nil
 }
+
+func isURL(file string) bool {
+	return strings.HasPrefix(file, "http://") || strings.HasPrefix(file, "https://")
+}
+
+// adaptiveOptions returns the options of the adaptive strategy store, with the defaults replacing the zero values.
+func adaptiveOptions(cfg *AdaptiveConfig) adaptive.Options {
+	opts := adaptive.Options{
+		TargetSamplesPerSecond:       cfg.TargetSamplesPerSecond,
+		DeltaTolerance:               cfg.DeltaTolerance,
+		CalculationInterval:          cfg.CalculationInterval,
+		AggregationBuckets:           cfg.AggregationBuckets,
+		BucketsForCalculation:        1,
+		Delay:                        cfg.Delay,
+		InitialSamplingProbability:   cfg.InitialSamplingProbability,
+		MinSamplingProbability:       cfg.MinSamplingProbability,
+		MinSamplesPerSecond:          cfg.MinSamplesPerSecond,
+		LeaderLeaseRefreshInterval:   defaultLeaderLeaseRefreshInterval,
+		FollowerLeaseRefreshInterval: defaultFollowerLeaseRefreshInterval,
+	}
+	if opts.TargetSamplesPerSecond == 0 {
+		opts.TargetSamplesPerSecond = defaultTargetSamplesPerSecond
+	}
+	if opts.DeltaTolerance == 0 {
+		opts.DeltaTolerance = defaultDeltaTolerance
+	}
+	if opts.CalculationInterval == 0 {
+		opts.CalculationInterval = defaultCalculationInterval
+	}
+	if opts.AggregationBuckets == 0 {
+		opts.AggregationBuckets = defaultAggregationBuckets
+	}
+	if opts.Delay == 0 {
+		opts.Delay = defaultDelay
+	}
+	if opts.InitialSamplingProbability == 0 {
+		opts.InitialSamplingProbability = defaultInitialSamplingProbability
+	}
+	if opts.MinSamplingProbability == 0 {
+		opts.MinSamplingProbability = defaultMinSamplingProbability
+	}
+	if opts.MinSamplesPerSecond == 0 {
+		opts.MinSamplesPerSecond = defaultMinSamplesPerSecond
+	}
+	return opts
+}
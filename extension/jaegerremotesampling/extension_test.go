@@ -12,6 +12,7 @@ import (
 	"testing"
 	"time"
 
+	"github.com/jaegertracing/jaeger/plugin/sampling/strategystore/adaptive"
 	"github.com/jaegertracing/jaeger/proto-gen/api_v2"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -19,6 +20,7 @@ import (
 	"go.opentelemetry.io/collector/config/configgrpc"
 	"go.opentelemetry.io/collector/config/configopaque"
 	"go.opentelemetry.io/collector/config/configtls"
+	"go.opentelemetry.io/collector/pdata/ptrace"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/metadata"
 )
@@ -46,6 +48,69 @@ func TestStartAndShutdownLocalFile(t *testing.T) {
 	assert.NoError(t, e.Shutdown(context.Background()))
 }
 
+func TestStartAndShutdownAdaptive(t *testing.T) {
+	// prepare
+	cfg := testConfig()
+	cfg.Source.Adaptive = &AdaptiveConfig{InitialSamplingProbability: 0.01}
+
+	e := newExtension(cfg, componenttest.NewNopTelemetrySettings())
+	require.NotNil(t, e)
+	require.NoError(t, e.Start(context.Background(), componenttest.NewNopHost()))
+
+	// test and verify
+	td := ptrace.NewTraces()
+	rs := td.ResourceSpans().AppendEmpty()
+	rs.Resource().Attributes().PutStr("service.name", "foo")
+	span := rs.ScopeSpans().AppendEmpty().Spans().AppendEmpty()
+	span.SetName("GET /users")
+	span.Attributes().PutStr("sampler.type", "probabilistic")
+	span.Attributes().PutDouble("sampler.param", 0.01)
+	e.ObserveTraces(td)
+
+	resp, err := e.samplingStore.GetSamplingStrategy(context.Background(), "foo")
+	require.NoError(t, err)
+	assert.Equal(t, 0.01, resp.GetOperationSampling().GetDefaultSamplingProbability())
+
+	assert.NoError(t, e.Shutdown(context.Background()))
+}
+
+func TestObserveTracesWithoutAdaptive(t *testing.T) {
+	cfg := testConfig()
+	cfg.Source.File = filepath.Join("testdata", "strategy.json")
+
+	e := newExtension(cfg, componenttest.NewNopTelemetrySettings())
+	require.NotNil(t, e)
+	require.NoError(t, e.Start(context.Background(), componenttest.NewNopHost()))
+	e.ObserveTraces(ptrace.NewTraces())
+	assert.NoError(t, e.Shutdown(context.Background()))
+}
+
+func TestAdaptiveOptions(t *testing.T) {
+	assert.Equal(t, adaptive.Options{
+		TargetSamplesPerSecond:       defaultTargetSamplesPerSecond,
+		DeltaTolerance:               defaultDeltaTolerance,
+		CalculationInterval:          defaultCalculationInterval,
+		AggregationBuckets:           defaultAggregationBuckets,
+		BucketsForCalculation:        1,
+		Delay:                        defaultDelay,
+		InitialSamplingProbability:   defaultInitialSamplingProbability,
+		MinSamplingProbability:       defaultMinSamplingProbability,
+		MinSamplesPerSecond:          defaultMinSamplesPerSecond,
+		LeaderLeaseRefreshInterval:   defaultLeaderLeaseRefreshInterval,
+		FollowerLeaseRefreshInterval: defaultFollowerLeaseRefreshInterval,
+	}, adaptiveOptions(&AdaptiveConfig{}))
+
+	opts := adaptiveOptions(&AdaptiveConfig{
+		TargetSamplesPerSecond: 5,
+		CalculationInterval:    30 * time.Second,
+		MinSamplingProbability: 0.001,
+	})
+	assert.Equal(t, 5.0, opts.TargetSamplesPerSecond)
+	assert.Equal(t, 30*time.Second, opts.CalculationInterval)
+	assert.Equal(t, 0.001, opts.MinSamplingProbability)
+	assert.Equal(t, defaultDelay, opts.Delay)
+}
+
 func TestRemote(t *testing.T) {
 	for _, tc := range []struct {
 		name                          string
@@ -6,6 +6,7 @@ package jaegerremotesampling // import "github.com/open-telemetry/opentelemetry-
 import (
 	"context"
 	"sync"
+	"time"
 
 	"go.opentelemetry.io/collector/component"
 	"go.opentelemetry.io/collector/config/configgrpc"
@@ -19,6 +20,20 @@ import (
 	"github.com/open-telemetry/opentelemetry-collector-contrib/internal/common/localhostgate"
 )
 
+// The defaults of the adaptive strategies are the ones of the Jaeger collector.
+const (
+	defaultTargetSamplesPerSecond       = 1
+	defaultDeltaTolerance               = 0.3
+	defaultCalculationInterval          = time.Minute
+	defaultAggregationBuckets           = 10
+	defaultDelay                        = 2 * time.Minute
+	defaultInitialSamplingProbability   = 0.001
+	defaultMinSamplingProbability       = 1e-5
+	defaultMinSamplesPerSecond          = 1.0 / 60
+	defaultLeaderLeaseRefreshInterval   = 5 * time.Second
+	defaultFollowerLeaseRefreshInterval = 60 * time.Second
+)
+
 // NewFactory creates a factory for the jaeger remote sampling extension.
 func NewFactory() extension.Factory {
 	return extension.NewFactory(
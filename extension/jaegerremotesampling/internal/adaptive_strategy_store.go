@@ -0,0 +1,136 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package internal // import "github.com/open-telemetry/opentelemetry-collector-contrib/extension/jaegerremotesampling/internal"
+
+import (
+	"context"
+	"errors"
+	"strconv"
+	"time"
+
+	"github.com/jaegertracing/jaeger/cmd/collector/app/sampling/strategystore"
+	"github.com/jaegertracing/jaeger/model"
+	"github.com/jaegertracing/jaeger/pkg/metrics"
+	"github.com/jaegertracing/jaeger/plugin/sampling/strategystore/adaptive"
+	"github.com/jaegertracing/jaeger/plugin/storage/memory"
+	"github.com/jaegertracing/jaeger/proto-gen/api_v2"
+	"go.opentelemetry.io/collector/pdata/pcommon"
+	"go.opentelemetry.io/collector/pdata/ptrace"
+	conventions "go.opentelemetry.io/collector/semconv/v1.6.1"
+	"go.uber.org/zap"
+)
+
+const (
+	samplerTypeAttribute  = "sampler.type"
+	samplerParamAttribute = "sampler.param"
+)
+
+var samplerTypes = map[string]model.SamplerType{
+	model.SamplerTypeProbabilistic.String(): model.SamplerTypeProbabilistic,
+	model.SamplerTypeLowerBound.String():    model.SamplerTypeLowerBound,
+	model.SamplerTypeRateLimiting.String():  model.SamplerTypeRateLimiting,
+	model.SamplerTypeConst.String():         model.SamplerTypeConst,
+}
+
+// AdaptiveStrategyStore computes the sampling strategies of the services and their operations
+// from the throughput of the root spans recorded with RecordTraces.
+type AdaptiveStrategyStore struct {
+	processor  *adaptive.Processor
+	aggregator strategystore.Aggregator
+}
+
+var _ strategystore.StrategyStore = (*AdaptiveStrategyStore)(nil)
+
+// NewAdaptiveStrategyStore creates an adaptive strategy store keeping the throughput in memory.
+// The collector is the only participant of the calculation, so it's always the leader.
+func NewAdaptiveStrategyStore(options adaptive.Options, logger *zap.Logger) (*AdaptiveStrategyStore, error) {
+	store := memory.NewSamplingStore(options.AggregationBuckets)
+	processor, err := adaptive.NewStrategyStore(options, metrics.NullFactory, logger, singleParticipantLock{}, store)
+	if err != nil {
+		return nil, err
+	}
+	if err = processor.Start(); err != nil {
+		return nil, err
+	}
+	aggregator := adaptive.NewAggregator(metrics.NullFactory, options.CalculationInterval, store)
+	aggregator.Start()
+
+	return &AdaptiveStrategyStore{
+		processor:  processor,
+		aggregator: aggregator,
+	}, nil
+}
+
+func (s *AdaptiveStrategyStore) GetSamplingStrategy(ctx context.Context, serviceName string) (*api_v2.SamplingStrategyResponse, error) {
+	return s.processor.GetSamplingStrategy(ctx, serviceName)
+}
+
+// RecordTraces records the throughput of the root spans of the traces. The spans have to carry the
+// sampler.type and sampler.param attributes set by the Jaeger SDKs, the other spans are ignored.
+func (s *AdaptiveStrategyStore) RecordTraces(td ptrace.Traces) {
+	for i := 0; i < td.ResourceSpans().Len(); i++ {
+		rs := td.ResourceSpans().At(i)
+		service, ok := rs.Resource().Attributes().Get(conventions.AttributeServiceName)
+		if !ok || service.Str() == "" {
+			continue
+		}
+		for j := 0; j < rs.ScopeSpans().Len(); j++ {
+			spans := rs.ScopeSpans().At(j).Spans()
+			for k := 0; k < spans.Len(); k++ {
+				span := spans.At(k)
+				if !span.ParentSpanID().IsEmpty() {
+					continue
+				}
+				samplerType, probability := samplerParams(span.Attributes())
+				if samplerType != model.SamplerTypeProbabilistic && samplerType != model.SamplerTypeLowerBound {
+					continue
+				}
+				s.aggregator.RecordThroughput(service.Str(), span.Name(), samplerType, probability)
+			}
+		}
+	}
+}
+
+func (s *AdaptiveStrategyStore) Close() error {
+	return errors.Join(s.aggregator.Close(), s.processor.Close())
+}
+
+// samplerParams returns the sampler type and parameter of the span, like model.Span.GetSamplerParams.
+func samplerParams(attrs pcommon.Map) (model.SamplerType, float64) {
+	samplerType, ok := attrs.Get(samplerTypeAttribute)
+	if !ok {
+		return model.SamplerTypeUnrecognized, 0
+	}
+	t, ok := samplerTypes[samplerType.AsString()]
+	if !ok {
+		return model.SamplerTypeUnrecognized, 0
+	}
+
+	param, ok := attrs.Get(samplerParamAttribute)
+	if !ok {
+		return model.SamplerTypeUnrecognized, 0
+	}
+	switch param.Type() {
+	case pcommon.ValueTypeDouble:
+		return t, param.Double()
+	case pcommon.ValueTypeInt:
+		return t, float64(param.Int())
+	case pcommon.ValueTypeStr:
+		if value, err := strconv.ParseFloat(param.Str(), 64); err == nil {
+			return t, value
+		}
+	}
+	return model.SamplerTypeUnrecognized, 0
+}
+
+// singleParticipantLock is the distributed lock of a single collector, which always holds the lock.
+type singleParticipantLock struct{}
+
+func (singleParticipantLock) Acquire(string, time.Duration) (bool, error) {
+	return true, nil
+}
+
+func (singleParticipantLock) Forfeit(string) (bool, error) {
+	return true, nil
+}
@@ -0,0 +1,169 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package internal
+
+import (
+	"context"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/jaegertracing/jaeger/model"
+	"github.com/jaegertracing/jaeger/plugin/sampling/strategystore/adaptive"
+	"github.com/jaegertracing/jaeger/proto-gen/api_v2"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/collector/pdata/pcommon"
+	"go.opentelemetry.io/collector/pdata/ptrace"
+	"go.uber.org/zap"
+)
+
+type throughput struct {
+	service     string
+	operation   string
+	samplerType model.SamplerType
+	probability float64
+}
+
+type recordingAggregator struct {
+	mu          sync.Mutex
+	throughputs []throughput
+}
+
+func (a *recordingAggregator) RecordThroughput(service, operation string, samplerType model.SamplerType, probability float64) {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	a.throughputs = append(a.throughputs, throughput{service, operation, samplerType, probability})
+}
+
+func (a *recordingAggregator) Start() {}
+
+func (a *recordingAggregator) Close() error {
+	return nil
+}
+
+func TestAdaptiveStrategyStoreRecordTraces(t *testing.T) {
+	td := ptrace.NewTraces()
+	rs := td.ResourceSpans().AppendEmpty()
+	rs.Resource().Attributes().PutStr("service.name", "foo")
+	spans := rs.ScopeSpans().AppendEmpty().Spans()
+
+	root := spans.AppendEmpty()
+	root.SetName("GET /users")
+	root.Attributes().PutStr("sampler.type", "probabilistic")
+	root.Attributes().PutDouble("sampler.param", 0.1)
+
+	lowerBound := spans.AppendEmpty()
+	lowerBound.SetName("GET /orders")
+	lowerBound.Attributes().PutStr("sampler.type", "lowerbound")
+	lowerBound.Attributes().PutStr("sampler.param", "0.001")
+
+	child := spans.AppendEmpty()
+	child.SetName("SELECT users")
+	child.SetParentSpanID(pcommon.SpanID([8]byte{1}))
+	child.Attributes().PutStr("sampler.type", "probabilistic")
+	child.Attributes().PutDouble("sampler.param", 0.1)
+
+	rateLimiting := spans.AppendEmpty()
+	rateLimiting.SetName("GET /health")
+	rateLimiting.Attributes().PutStr("sampler.type", "ratelimiting")
+	rateLimiting.Attributes().PutInt("sampler.param", 5)
+
+	noSampler := spans.AppendEmpty()
+	noSampler.SetName("GET /metrics")
+
+	// the spans of the resources without service are ignored
+	unknown := td.ResourceSpans().AppendEmpty().ScopeSpans().AppendEmpty().Spans().AppendEmpty()
+	unknown.SetName("GET /users")
+	unknown.Attributes().PutStr("sampler.type", "probabilistic")
+	unknown.Attributes().PutDouble("sampler.param", 0.1)
+
+	aggregator := &recordingAggregator{}
+	store := &AdaptiveStrategyStore{aggregator: aggregator}
+	store.RecordTraces(td)
+
+	assert.Equal(t, []throughput{
+		{service: "foo", operation: "GET /users", samplerType: model.SamplerTypeProbabilistic, probability: 0.1},
+		{service: "foo", operation: "GET /orders", samplerType: model.SamplerTypeLowerBound, probability: 0.001},
+	}, aggregator.throughputs)
+}
+
+func TestSamplerParams(t *testing.T) {
+	for _, tt := range []struct {
+		name                string
+		attrs               map[string]any
+		expectedType        model.SamplerType
+		expectedProbability float64
+	}{
+		{
+			name:                "double param",
+			attrs:               map[string]any{"sampler.type": "probabilistic", "sampler.param": 0.5},
+			expectedType:        model.SamplerTypeProbabilistic,
+			expectedProbability: 0.5,
+		},
+		{
+			name:                "string param",
+			attrs:               map[string]any{"sampler.type": "lowerbound", "sampler.param": "0.25"},
+			expectedType:        model.SamplerTypeLowerBound,
+			expectedProbability: 0.25,
+		},
+		{
+			name:                "int param",
+			attrs:               map[string]any{"sampler.type": "const", "sampler.param": 1},
+			expectedType:        model.SamplerTypeConst,
+			expectedProbability: 1,
+		},
+		{
+			name:         "invalid param",
+			attrs:        map[string]any{"sampler.type": "probabilistic", "sampler.param": "invalid"},
+			expectedType: model.SamplerTypeUnrecognized,
+		},
+		{
+			name:         "missing param",
+			attrs:        map[string]any{"sampler.type": "probabilistic"},
+			expectedType: model.SamplerTypeUnrecognized,
+		},
+		{
+			name:         "unknown type",
+			attrs:        map[string]any{"sampler.type": "unknown", "sampler.param": 0.5},
+			expectedType: model.SamplerTypeUnrecognized,
+		},
+		{
+			name:         "no sampler",
+			attrs:        map[string]any{},
+			expectedType: model.SamplerTypeUnrecognized,
+		},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			attrs := pcommon.NewMap()
+			require.NoError(t, attrs.FromRaw(tt.attrs))
+			samplerType, probability := samplerParams(attrs)
+			assert.Equal(t, tt.expectedType, samplerType)
+			assert.Equal(t, tt.expectedProbability, probability)
+		})
+	}
+}
+
+func TestAdaptiveStrategyStoreDefaultStrategy(t *testing.T) {
+	store, err := NewAdaptiveStrategyStore(adaptive.Options{
+		TargetSamplesPerSecond:       1,
+		DeltaTolerance:               0.3,
+		CalculationInterval:          time.Minute,
+		AggregationBuckets:           10,
+		BucketsForCalculation:        1,
+		Delay:                        2 * time.Minute,
+		InitialSamplingProbability:   0.01,
+		MinSamplingProbability:       1e-5,
+		MinSamplesPerSecond:          1.0 / 60,
+		LeaderLeaseRefreshInterval:   5 * time.Second,
+		FollowerLeaseRefreshInterval: time.Minute,
+	}, zap.NewNop())
+	require.NoError(t, err)
+	defer func() { assert.NoError(t, store.Close()) }()
+
+	resp, err := store.GetSamplingStrategy(context.Background(), "foo")
+	require.NoError(t, err)
+	assert.Equal(t, api_v2.SamplingStrategyType_PROBABILISTIC, resp.GetStrategyType())
+	assert.Equal(t, 0.01, resp.GetOperationSampling().GetDefaultSamplingProbability())
+}
@@ -0,0 +1,140 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package internal // import "github.com/open-telemetry/opentelemetry-collector-contrib/extension/jaegerremotesampling/internal"
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sync"
+
+	"github.com/fsnotify/fsnotify"
+	"github.com/jaegertracing/jaeger/cmd/collector/app/sampling/strategystore"
+	"github.com/jaegertracing/jaeger/plugin/sampling/strategystore/static"
+	"github.com/jaegertracing/jaeger/proto-gen/api_v2"
+	"go.uber.org/zap"
+)
+
+// fileStrategyStore serves the strategies of a local file, and reloads them when the file changes.
+type fileStrategyStore struct {
+	options static.Options
+	logger  *zap.Logger
+
+	mu       sync.RWMutex
+	delegate strategystore.StrategyStore
+	content  []byte
+
+	watcher *fsnotify.Watcher
+	done    chan struct{}
+	wg      sync.WaitGroup
+}
+
+var _ strategystore.StrategyStore = (*fileStrategyStore)(nil)
+
+// NewFileStrategyStore creates a strategy store for a local file, which is reloaded when it changes.
+// The directory of the file is watched, so that the file is still watched after it's replaced, e.g. the atomic
+// updates of the Kubernetes config maps replace the symlinks to the files.
+func NewFileStrategyStore(options static.Options, logger *zap.Logger) (strategystore.StrategyStore, error) {
+	s := &fileStrategyStore{
+		options: options,
+		logger:  logger,
+		done:    make(chan struct{}),
+	}
+	if _, err := s.reload(); err != nil {
+		return nil, err
+	}
+
+	watcher, err := fsnotify.NewWatcher()
+	if err != nil {
+		return nil, errors.Join(err, s.delegate.Close())
+	}
+	if err = watcher.Add(filepath.Dir(options.StrategiesFile)); err != nil {
+		return nil, errors.Join(fmt.Errorf("failed to watch the strategies file: %w", err), watcher.Close(), s.delegate.Close())
+	}
+	s.watcher = watcher
+
+	s.wg.Add(1)
+	go s.watch()
+	return s, nil
+}
+
+func (s *fileStrategyStore) GetSamplingStrategy(ctx context.Context, serviceName string) (*api_v2.SamplingStrategyResponse, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return s.delegate.GetSamplingStrategy(ctx, serviceName)
+}
+
+// reload replaces the strategies when the content of the file changed.
+func (s *fileStrategyStore) reload() (bool, error) {
+	content, err := os.ReadFile(s.options.StrategiesFile)
+	if err != nil {
+		return false, fmt.Errorf("failed to read the strategies file: %w", err)
+	}
+
+	s.mu.RLock()
+	unchanged := s.delegate != nil && bytes.Equal(content, s.content)
+	s.mu.RUnlock()
+	if unchanged {
+		return false, nil
+	}
+
+	delegate, err := static.NewStrategyStore(s.options, s.logger)
+	if err != nil {
+		return false, fmt.Errorf("failed to load the strategies file: %w", err)
+	}
+
+	s.mu.Lock()
+	previous := s.delegate
+	s.delegate = delegate
+	s.content = content
+	s.mu.Unlock()
+
+	if previous != nil {
+		if err := previous.Close(); err != nil {
+			s.logger.Warn("failed to close the previous strategies", zap.Error(err))
+		}
+	}
+	return true, nil
+}
+
+func (s *fileStrategyStore) watch() {
+	defer s.wg.Done()
+	for {
+		select {
+		case <-s.done:
+			return
+		case _, ok := <-s.watcher.Events:
+			if !ok {
+				return
+			}
+			// the events of the other files of the directory are ignored when the content didn't change
+			changed, err := s.reload()
+			if err != nil {
+				s.logger.Error("failed to reload the strategies file, the previous strategies are kept", zap.Error(err))
+				continue
+			}
+			if changed {
+				s.logger.Info("reloaded the strategies file", zap.String("file", s.options.StrategiesFile))
+			}
+		case err, ok := <-s.watcher.Errors:
+			if !ok {
+				return
+			}
+			s.logger.Error("strategies file watcher error", zap.Error(err))
+		}
+	}
+}
+
+func (s *fileStrategyStore) Close() error {
+	close(s.done)
+	err := s.watcher.Close()
+	s.wg.Wait()
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return errors.Join(err, s.delegate.Close())
+}
@@ -0,0 +1,67 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package internal
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/jaegertracing/jaeger/cmd/collector/app/sampling/strategystore"
+	"github.com/jaegertracing/jaeger/plugin/sampling/strategystore/static"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+)
+
+// replaceStrategies replaces the file, the way the atomic updates of the mounted config maps do.
+func replaceStrategies(t *testing.T, file string, content string) {
+	tmp := filepath.Join(t.TempDir(), "strategies.json")
+	require.NoError(t, os.WriteFile(tmp, []byte(content), 0600))
+	require.NoError(t, os.Rename(tmp, file))
+}
+
+func probabilisticStrategies(probability float64) string {
+	return fmt.Sprintf(`{"default_strategy": {"type": "probabilistic", "param": %v}}`, probability)
+}
+
+func samplingRate(t *testing.T, store strategystore.StrategyStore) float64 {
+	resp, err := store.GetSamplingStrategy(context.Background(), "foo")
+	require.NoError(t, err)
+	return resp.GetProbabilisticSampling().GetSamplingRate()
+}
+
+func TestFileStrategyStoreReload(t *testing.T) {
+	file := filepath.Join(t.TempDir(), "strategies.json")
+	replaceStrategies(t, file, probabilisticStrategies(0.5))
+
+	store, err := NewFileStrategyStore(static.Options{StrategiesFile: file}, zap.NewNop())
+	require.NoError(t, err)
+	defer func() { assert.NoError(t, store.Close()) }()
+	assert.Equal(t, 0.5, samplingRate(t, store))
+
+	replaceStrategies(t, file, probabilisticStrategies(0.25))
+	assert.Eventually(t, func() bool {
+		return samplingRate(t, store) == 0.25
+	}, 5*time.Second, 10*time.Millisecond)
+
+	// the previous strategies are kept when the file is invalid
+	replaceStrategies(t, file, "invalid")
+	time.Sleep(50 * time.Millisecond)
+	assert.Equal(t, 0.25, samplingRate(t, store))
+}
+
+func TestFileStrategyStoreInvalidFile(t *testing.T) {
+	file := filepath.Join(t.TempDir(), "strategies.json")
+	replaceStrategies(t, file, "invalid")
+
+	_, err := NewFileStrategyStore(static.Options{StrategiesFile: file}, zap.NewNop())
+	assert.Error(t, err)
+
+	_, err = NewFileStrategyStore(static.Options{StrategiesFile: filepath.Join(t.TempDir(), "missing.json")}, zap.NewNop())
+	assert.Error(t, err)
+}
@@ -0,0 +1,100 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package k8sleaderelector // import "github.com/open-telemetry/opentelemetry-collector-contrib/extension/k8sleaderelector"
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	"k8s.io/client-go/tools/leaderelection"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/internal/k8sconfig"
+)
+
+// Config defines the configuration of the leader elector extension.
+type Config struct {
+	k8sconfig.APIConfig `mapstructure:",squash"`
+
+	// LeaseName is the name of the default lease, the one of the components which don't name the lease they use.
+	LeaseName string `mapstructure:"lease_name"`
+	// LeaseNamespace is the namespace of the leases which don't set theirs. It's usually the namespace of the
+	// collector, which can be set using the downward API inside the collector pod spec as follows:
+	//
+	// env:
+	//   - name: K8S_NAMESPACE
+	//     valueFrom:
+	//       fieldRef:
+	//         fieldPath: metadata.namespace
+	//
+	// Then set this value to ${env:K8S_NAMESPACE} in the configuration.
+	LeaseNamespace string `mapstructure:"lease_namespace"`
+	// Leases are the other leases of the extension, held independently of the default lease, so that different
+	// components can run on different leaders.
+	Leases []LeaseConfig `mapstructure:"leases"`
+
+	// Identity is the identity of the collector in the leases, which must be unique among the candidates. It defaults
+	// to the hostname, which is the name of the pod in Kubernetes.
+	Identity string `mapstructure:"identity"`
+	// LeaseDuration is the duration the candidates wait before trying to take over the leadership of a lease which
+	// wasn't renewed.
+	LeaseDuration time.Duration `mapstructure:"lease_duration"`
+	// RenewDeadline is the duration the leader retries to renew a lease before giving up its leadership.
+	RenewDeadline time.Duration `mapstructure:"renew_deadline"`
+	// RetryPeriod is the duration the candidates wait between their attempts to take or renew the leadership.
+	RetryPeriod time.Duration `mapstructure:"retry_period"`
+}
+
+// LeaseConfig defines a named lease.
+type LeaseConfig struct {
+	// Name is the name of the lease, which the components use to refer to it.
+	Name string `mapstructure:"name"`
+	// Namespace is the namespace of the lease, defaults to the lease_namespace of the extension.
+	Namespace string `mapstructure:"namespace"`
+}
+
+// Validate checks if the extension configuration is valid
+func (cfg *Config) Validate() error {
+	if cfg.LeaseName == "" {
+		return errors.New("lease_name must be specified")
+	}
+	if cfg.LeaseNamespace == "" {
+		return errors.New("lease_namespace must be specified")
+	}
+
+	names := map[string]bool{cfg.LeaseName: true}
+	for i, lease := range cfg.Leases {
+		if lease.Name == "" {
+			return fmt.Errorf("leases[%d]: name must be specified", i)
+		}
+		if names[lease.Name] {
+			return fmt.Errorf("leases[%d]: duplicate lease name %q", i, lease.Name)
+		}
+		names[lease.Name] = true
+	}
+
+	if cfg.LeaseDuration <= 0 || cfg.RenewDeadline <= 0 || cfg.RetryPeriod <= 0 {
+		return errors.New("lease_duration, renew_deadline and retry_period must be positive")
+	}
+	if cfg.LeaseDuration <= cfg.RenewDeadline {
+		return errors.New("lease_duration must be greater than renew_deadline")
+	}
+	if float64(cfg.RenewDeadline) <= leaderelection.JitterFactor*float64(cfg.RetryPeriod) {
+		return fmt.Errorf("renew_deadline must be greater than %v times retry_period", leaderelection.JitterFactor)
+	}
+	return nil
+}
+
+// leases returns the configuration of all the leases, the default one first.
+func (cfg *Config) leases() []LeaseConfig {
+	leases := make([]LeaseConfig, 0, len(cfg.Leases)+1)
+	leases = append(leases, LeaseConfig{Name: cfg.LeaseName})
+	leases = append(leases, cfg.Leases...)
+	for i := range leases {
+		if leases[i].Namespace == "" {
+			leases[i].Namespace = cfg.LeaseNamespace
+		}
+	}
+	return leases
+}
@@ -0,0 +1,113 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package k8sleaderelector
+
+import (
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/collector/component"
+	"go.opentelemetry.io/collector/confmap/confmaptest"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/extension/k8sleaderelector/internal/metadata"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/internal/k8sconfig"
+)
+
+func TestLoadConfig(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		id          component.ID
+		expected    component.Config
+		expectedErr string
+	}{
+		{
+			id: component.NewID(metadata.Type),
+			expected: &Config{
+				APIConfig:      k8sconfig.APIConfig{AuthType: k8sconfig.AuthTypeServiceAccount},
+				LeaseName:      "otelcol",
+				LeaseNamespace: "default",
+				LeaseDuration:  15 * time.Second,
+				RenewDeadline:  10 * time.Second,
+				RetryPeriod:    2 * time.Second,
+			},
+		},
+		{
+			id: component.NewIDWithName(metadata.Type, "multiple-leases"),
+			expected: &Config{
+				APIConfig:      k8sconfig.APIConfig{AuthType: k8sconfig.AuthTypeKubeConfig},
+				LeaseName:      "otelcol",
+				LeaseNamespace: "monitoring",
+				Leases: []LeaseConfig{
+					{Name: "otelcol-k8scluster"},
+					{Name: "otelcol-sqlquery", Namespace: "databases"},
+				},
+				Identity:      "collector-1",
+				LeaseDuration: 30 * time.Second,
+				RenewDeadline: 20 * time.Second,
+				RetryPeriod:   5 * time.Second,
+			},
+		},
+		{
+			id:          component.NewIDWithName(metadata.Type, "invalid-no-lease-name"),
+			expectedErr: "lease_name must be specified",
+		},
+		{
+			id:          component.NewIDWithName(metadata.Type, "invalid-duplicate-lease"),
+			expectedErr: `leases[1]: duplicate lease name "otelcol"`,
+		},
+		{
+			id:          component.NewIDWithName(metadata.Type, "invalid-unnamed-lease"),
+			expectedErr: "leases[0]: name must be specified",
+		},
+		{
+			id:          component.NewIDWithName(metadata.Type, "invalid-renew-deadline"),
+			expectedErr: "lease_duration must be greater than renew_deadline",
+		},
+		{
+			id:          component.NewIDWithName(metadata.Type, "invalid-retry-period"),
+			expectedErr: "renew_deadline must be greater than 1.2 times retry_period",
+		},
+		{
+			id:          component.NewIDWithName(metadata.Type, "invalid-auth"),
+			expectedErr: "invalid authType for kubernetes: not a real auth type",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.id.String(), func(t *testing.T) {
+			cm, err := confmaptest.LoadConf(filepath.Join("testdata", "config.yaml"))
+			require.NoError(t, err)
+			factory := NewFactory()
+			cfg := factory.CreateDefaultConfig()
+			sub, err := cm.Sub(tt.id.String())
+			require.NoError(t, err)
+			require.NoError(t, sub.Unmarshal(cfg))
+			if tt.expectedErr != "" {
+				assert.EqualError(t, component.ValidateConfig(cfg), tt.expectedErr)
+				return
+			}
+			assert.NoError(t, component.ValidateConfig(cfg))
+			assert.Equal(t, tt.expected, cfg)
+		})
+	}
+}
+
+func TestConfigLeases(t *testing.T) {
+	cfg := &Config{
+		LeaseName:      "otelcol",
+		LeaseNamespace: "monitoring",
+		Leases: []LeaseConfig{
+			{Name: "otelcol-k8scluster"},
+			{Name: "otelcol-sqlquery", Namespace: "databases"},
+		},
+	}
+	assert.Equal(t, []LeaseConfig{
+		{Name: "otelcol", Namespace: "monitoring"},
+		{Name: "otelcol-k8scluster", Namespace: "monitoring"},
+		{Name: "otelcol-sqlquery", Namespace: "databases"},
+	}, cfg.leases())
+}
@@ -0,0 +1,9 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+// Package k8sleaderelector implements an extension electing a leader among the collectors of a Kubernetes cluster for
+// one or several leases, allowing the components which must run on a single collector to only run on the leader.
+
+//go:generate mdatagen metadata.yaml
+
+package k8sleaderelector // import "github.com/open-telemetry/opentelemetry-collector-contrib/extension/k8sleaderelector"
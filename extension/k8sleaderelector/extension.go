@@ -0,0 +1,234 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package k8sleaderelector // import "github.com/open-telemetry/opentelemetry-collector-contrib/extension/k8sleaderelector"
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"sync"
+
+	"go.opentelemetry.io/collector/component"
+	"go.opentelemetry.io/collector/extension"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/metric"
+	"go.uber.org/zap"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/tools/leaderelection"
+	"k8s.io/client-go/tools/leaderelection/resourcelock"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/extension/k8sleaderelector/internal/metadata"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/internal/k8sconfig"
+)
+
+// StartCallback is called when the collector becomes the leader of a lease. The context is canceled when the
+// leadership is lost.
+type StartCallback func(ctx context.Context)
+
+// StopCallback is called when the collector loses the leadership of a lease, or when the extension shuts down while
+// the collector is the leader.
+type StopCallback func()
+
+// LeaderElection is the interface of the extension, used by the components which must only run on the leader of a
+// lease. The callbacks are called in order, and must not block: a component should start or stop its work in the
+// background.
+type LeaderElection interface {
+	extension.Extension
+	// SetCallBackFuncs registers the callbacks of the default lease. When the collector is already its leader, the
+	// start callback is called right away.
+	SetCallBackFuncs(onStartLeading StartCallback, onStopLeading StopCallback)
+	// SetLeaseCallBackFuncs registers the callbacks of the named lease, which must be configured. When the collector
+	// is already its leader, the start callback is called right away.
+	SetLeaseCallBackFuncs(lease string, onStartLeading StartCallback, onStopLeading StopCallback) error
+}
+
+var _ LeaderElection = (*leaderElectionExtension)(nil)
+
+type leaderElectionExtension struct {
+	config           *Config
+	logger           *zap.Logger
+	telemetryBuilder *metadata.TelemetryBuilder
+	makeClient       func(k8sconfig.APIConfig) (kubernetes.Interface, error)
+
+	// leases are the leases by name, the default one included
+	leases map[string]*lease
+
+	cancel context.CancelFunc
+	wg     sync.WaitGroup
+}
+
+func newLeaderElectionExtension(cfg *Config, set extension.CreateSettings) (*leaderElectionExtension, error) {
+	telemetryBuilder, err := metadata.NewTelemetryBuilder(set.TelemetrySettings)
+	if err != nil {
+		return nil, err
+	}
+	ext := &leaderElectionExtension{
+		config:           cfg,
+		logger:           set.Logger,
+		telemetryBuilder: telemetryBuilder,
+		makeClient:       k8sconfig.MakeClient,
+		leases:           map[string]*lease{},
+	}
+	for _, leaseCfg := range cfg.leases() {
+		ext.leases[leaseCfg.Name] = &lease{
+			name:      leaseCfg.Name,
+			namespace: leaseCfg.Namespace,
+			attrs:     metric.WithAttributeSet(attribute.NewSet(attribute.String("lease", leaseCfg.Name))),
+		}
+	}
+	return ext, nil
+}
+
+func (e *leaderElectionExtension) SetCallBackFuncs(onStartLeading StartCallback, onStopLeading StopCallback) {
+	e.leases[e.config.LeaseName].addCallbacks(onStartLeading, onStopLeading)
+}
+
+func (e *leaderElectionExtension) SetLeaseCallBackFuncs(name string, onStartLeading StartCallback, onStopLeading StopCallback) error {
+	l, ok := e.leases[name]
+	if !ok {
+		return fmt.Errorf("the lease %q isn't configured in the leader elector", name)
+	}
+	l.addCallbacks(onStartLeading, onStopLeading)
+	return nil
+}
+
+func (e *leaderElectionExtension) Start(_ context.Context, _ component.Host) error {
+	client, err := e.makeClient(e.config.APIConfig)
+	if err != nil {
+		return fmt.Errorf("failed to create the Kubernetes client: %w", err)
+	}
+	identity := e.config.Identity
+	if identity == "" {
+		if identity, err = os.Hostname(); err != nil {
+			return fmt.Errorf("failed to get the hostname as identity: %w", err)
+		}
+	}
+
+	electors := make(map[*lease]*leaderelection.LeaderElector, len(e.leases))
+	for _, l := range e.leases {
+		elector, err := e.newElector(client, identity, l)
+		if err != nil {
+			return fmt.Errorf("failed to create the leader elector of the lease %q: %w", l.name, err)
+		}
+		electors[l] = elector
+	}
+
+	// the context of the extension, not the one of Start which is only valid during the startup
+	ctx, cancel := context.WithCancel(context.Background())
+	e.cancel = cancel
+	for l, elector := range electors {
+		e.logger.Info("Starting the leader election", zap.String("lease", l.name), zap.String("namespace", l.namespace), zap.String("identity", identity))
+		e.wg.Add(1)
+		go func(elector *leaderelection.LeaderElector) {
+			defer e.wg.Done()
+			// Run returns when the leadership is lost, after which the collector is a candidate again
+			for ctx.Err() == nil {
+				elector.Run(ctx)
+			}
+		}(elector)
+	}
+	return nil
+}
+
+func (e *leaderElectionExtension) newElector(client kubernetes.Interface, identity string, l *lease) (*leaderelection.LeaderElector, error) {
+	lock := &resourcelock.LeaseLock{
+		LeaseMeta: metav1.ObjectMeta{Name: l.name, Namespace: l.namespace},
+		Client:    client.CoordinationV1(),
+		LockConfig: resourcelock.ResourceLockConfig{
+			Identity: identity,
+		},
+	}
+	return leaderelection.NewLeaderElector(leaderelection.LeaderElectionConfig{
+		Lock:          lock,
+		LeaseDuration: e.config.LeaseDuration,
+		RenewDeadline: e.config.RenewDeadline,
+		RetryPeriod:   e.config.RetryPeriod,
+		// the lease is released on shutdown, so that another collector takes over right away
+		ReleaseOnCancel: true,
+		Name:            l.name,
+		Callbacks: leaderelection.LeaderCallbacks{
+			OnStartedLeading: func(ctx context.Context) {
+				if !l.startLeading(ctx) {
+					return
+				}
+				e.logger.Info("Became the leader", zap.String("lease", l.name))
+				e.telemetryBuilder.K8sLeaderElectorTransitions.Add(context.Background(), 1, l.attrs)
+				e.telemetryBuilder.K8sLeaderElectorLeader.Add(context.Background(), 1, l.attrs)
+			},
+			OnStoppedLeading: func() {
+				// called whenever the elector stops, even if it wasn't the leader
+				if !l.stopLeading() {
+					return
+				}
+				e.logger.Info("Lost the leadership", zap.String("lease", l.name))
+				e.telemetryBuilder.K8sLeaderElectorTransitions.Add(context.Background(), 1, l.attrs)
+				e.telemetryBuilder.K8sLeaderElectorLeader.Add(context.Background(), -1, l.attrs)
+			},
+			OnNewLeader: func(identity string) {
+				e.logger.Debug("New leader elected", zap.String("lease", l.name), zap.String("leader", identity))
+			},
+		},
+	})
+}
+
+func (e *leaderElectionExtension) Shutdown(_ context.Context) error {
+	if e.cancel != nil {
+		e.cancel()
+	}
+	e.wg.Wait()
+	return nil
+}
+
+// lease is a lease of the extension, with the callbacks of the components using it.
+type lease struct {
+	name      string
+	namespace string
+	attrs     metric.MeasurementOption
+
+	// lock serializes the callbacks, so that the components see the transitions in order
+	lock      sync.Mutex
+	leaderCtx context.Context
+	onStart   []StartCallback
+	onStop    []StopCallback
+}
+
+func (l *lease) addCallbacks(onStartLeading StartCallback, onStopLeading StopCallback) {
+	l.lock.Lock()
+	defer l.lock.Unlock()
+	l.onStart = append(l.onStart, onStartLeading)
+	l.onStop = append(l.onStop, onStopLeading)
+	if l.leaderCtx != nil {
+		onStartLeading(l.leaderCtx)
+	}
+}
+
+// startLeading calls the start callbacks, returning false if the leadership was already lost: the elector calls
+// OnStartedLeading in the background, possibly after OnStoppedLeading.
+func (l *lease) startLeading(ctx context.Context) bool {
+	l.lock.Lock()
+	defer l.lock.Unlock()
+	if ctx.Err() != nil {
+		return false
+	}
+	l.leaderCtx = ctx
+	for _, onStart := range l.onStart {
+		onStart(ctx)
+	}
+	return true
+}
+
+// stopLeading calls the stop callbacks if the collector was the leader, returning whether it was.
+func (l *lease) stopLeading() bool {
+	l.lock.Lock()
+	defer l.lock.Unlock()
+	if l.leaderCtx == nil {
+		return false
+	}
+	l.leaderCtx = nil
+	for _, onStop := range l.onStop {
+		onStop()
+	}
+	return true
+}
@@ -0,0 +1,213 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package k8sleaderelector
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/collector/component/componenttest"
+	"go.opentelemetry.io/collector/extension/extensiontest"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/sdk/metric/metricdata"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/kubernetes/fake"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/internal/k8sconfig"
+)
+
+const (
+	waitFor = 10 * time.Second
+	tick    = 10 * time.Millisecond
+)
+
+func TestLeaderElection(t *testing.T) {
+	client := fake.NewSimpleClientset()
+	first := newTestExtension(t, client, "collector-1")
+	firstCallbacks := &testCallbacks{}
+	first.SetCallBackFuncs(firstCallbacks.onStart, firstCallbacks.onStop)
+	require.NoError(t, first.Start(context.Background(), componenttest.NewNopHost()))
+	require.Eventually(t, firstCallbacks.isLeading, waitFor, tick)
+
+	second := newTestExtension(t, client, "collector-2")
+	secondCallbacks := &testCallbacks{}
+	second.SetCallBackFuncs(secondCallbacks.onStart, secondCallbacks.onStop)
+	require.NoError(t, second.Start(context.Background(), componenttest.NewNopHost()))
+	defer func() {
+		require.NoError(t, second.Shutdown(context.Background()))
+	}()
+
+	lease, err := client.CoordinationV1().Leases("default").Get(context.Background(), "otelcol", metav1.GetOptions{})
+	require.NoError(t, err)
+	assert.Equal(t, "collector-1", *lease.Spec.HolderIdentity)
+	assert.False(t, secondCallbacks.isLeading())
+
+	// the lease is released on shutdown, the other collector taking over
+	require.NoError(t, first.Shutdown(context.Background()))
+	assert.False(t, firstCallbacks.isLeading())
+	assert.Equal(t, 1, firstCallbacks.stops())
+	require.Eventually(t, secondCallbacks.isLeading, waitFor, tick)
+	lease, err = client.CoordinationV1().Leases("default").Get(context.Background(), "otelcol", metav1.GetOptions{})
+	require.NoError(t, err)
+	assert.Equal(t, "collector-2", *lease.Spec.HolderIdentity)
+}
+
+func TestMultipleLeases(t *testing.T) {
+	client := fake.NewSimpleClientset()
+	ext := newTestExtension(t, client, "collector-1", LeaseConfig{Name: "otelcol-sqlquery", Namespace: "databases"})
+
+	defaultCallbacks := &testCallbacks{}
+	ext.SetCallBackFuncs(defaultCallbacks.onStart, defaultCallbacks.onStop)
+	namedCallbacks := &testCallbacks{}
+	require.NoError(t, ext.SetLeaseCallBackFuncs("otelcol-sqlquery", namedCallbacks.onStart, namedCallbacks.onStop))
+	assert.EqualError(t, ext.SetLeaseCallBackFuncs("unknown", namedCallbacks.onStart, namedCallbacks.onStop),
+		`the lease "unknown" isn't configured in the leader elector`)
+
+	require.NoError(t, ext.Start(context.Background(), componenttest.NewNopHost()))
+	require.Eventually(t, defaultCallbacks.isLeading, waitFor, tick)
+	require.Eventually(t, namedCallbacks.isLeading, waitFor, tick)
+
+	_, err := client.CoordinationV1().Leases("default").Get(context.Background(), "otelcol", metav1.GetOptions{})
+	assert.NoError(t, err)
+	_, err = client.CoordinationV1().Leases("databases").Get(context.Background(), "otelcol-sqlquery", metav1.GetOptions{})
+	assert.NoError(t, err)
+
+	// the components registering while the collector is the leader are started right away
+	lateCallbacks := &testCallbacks{}
+	require.NoError(t, ext.SetLeaseCallBackFuncs("otelcol-sqlquery", lateCallbacks.onStart, lateCallbacks.onStop))
+	assert.True(t, lateCallbacks.isLeading())
+
+	require.NoError(t, ext.Shutdown(context.Background()))
+	for _, callbacks := range []*testCallbacks{defaultCallbacks, namedCallbacks, lateCallbacks} {
+		assert.False(t, callbacks.isLeading())
+		assert.Equal(t, 1, callbacks.starts())
+		assert.Equal(t, 1, callbacks.stops())
+	}
+}
+
+func TestLeaderElectionMetrics(t *testing.T) {
+	tt := setupTestTelemetry()
+	defer func() {
+		require.NoError(t, tt.Shutdown(context.Background()))
+	}()
+	ext, err := newLeaderElectionExtension(testConfig("collector-1"), tt.NewCreateSettings())
+	require.NoError(t, err)
+	ext.makeClient = fakeClient(fake.NewSimpleClientset())
+
+	callbacks := &testCallbacks{}
+	ext.SetCallBackFuncs(callbacks.onStart, callbacks.onStop)
+	require.NoError(t, ext.Start(context.Background(), componenttest.NewNopHost()))
+	require.Eventually(t, callbacks.isLeading, waitFor, tick)
+	require.NoError(t, ext.Shutdown(context.Background()))
+
+	lease := attribute.NewSet(attribute.String("lease", "otelcol"))
+	tt.assertMetrics(t, []metricdata.Metrics{
+		{
+			Name:        "k8s_leader_elector_leader",
+			Description: "Whether the collector is the leader of a lease, 1 when it's the leader and 0 otherwise",
+			Unit:        "1",
+			Data: metricdata.Sum[int64]{
+				Temporality: metricdata.CumulativeTemporality,
+				IsMonotonic: false,
+				DataPoints: []metricdata.DataPoint[int64]{
+					{Value: 0, Attributes: lease},
+				},
+			},
+		},
+		{
+			Name:        "k8s_leader_elector_transitions",
+			Description: "Number of times the collector gained or lost the leadership of a lease",
+			Unit:        "1",
+			Data: metricdata.Sum[int64]{
+				Temporality: metricdata.CumulativeTemporality,
+				IsMonotonic: true,
+				DataPoints: []metricdata.DataPoint[int64]{
+					{Value: 2, Attributes: lease},
+				},
+			},
+		},
+	})
+}
+
+func TestStartWithoutClient(t *testing.T) {
+	ext, err := newLeaderElectionExtension(testConfig("collector-1"), extensiontest.NewNopCreateSettings())
+	require.NoError(t, err)
+	ext.makeClient = func(k8sconfig.APIConfig) (kubernetes.Interface, error) {
+		return nil, errors.New("not in a cluster")
+	}
+	assert.EqualError(t, ext.Start(context.Background(), componenttest.NewNopHost()),
+		"failed to create the Kubernetes client: not in a cluster")
+	assert.NoError(t, ext.Shutdown(context.Background()))
+}
+
+func testConfig(identity string, leases ...LeaseConfig) *Config {
+	cfg := createDefaultConfig().(*Config)
+	cfg.LeaseName = "otelcol"
+	cfg.Leases = leases
+	cfg.Identity = identity
+	cfg.LeaseDuration = time.Second
+	cfg.RenewDeadline = 500 * time.Millisecond
+	cfg.RetryPeriod = 100 * time.Millisecond
+	return cfg
+}
+
+func newTestExtension(t *testing.T, client kubernetes.Interface, identity string, leases ...LeaseConfig) *leaderElectionExtension {
+	cfg := testConfig(identity, leases...)
+	require.NoError(t, cfg.Validate())
+	ext, err := newLeaderElectionExtension(cfg, extensiontest.NewNopCreateSettings())
+	require.NoError(t, err)
+	ext.makeClient = fakeClient(client)
+	return ext
+}
+
+func fakeClient(client kubernetes.Interface) func(k8sconfig.APIConfig) (kubernetes.Interface, error) {
+	return func(k8sconfig.APIConfig) (kubernetes.Interface, error) {
+		return client, nil
+	}
+}
+
+// testCallbacks records the calls of the callbacks of a component.
+type testCallbacks struct {
+	lock                  sync.Mutex
+	leading               bool
+	startCount, stopCount int
+}
+
+func (c *testCallbacks) onStart(context.Context) {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	c.leading = true
+	c.startCount++
+}
+
+func (c *testCallbacks) onStop() {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	c.leading = false
+	c.stopCount++
+}
+
+func (c *testCallbacks) isLeading() bool {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	return c.leading
+}
+
+func (c *testCallbacks) starts() int {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	return c.startCount
+}
+
+func (c *testCallbacks) stops() int {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	return c.stopCount
+}
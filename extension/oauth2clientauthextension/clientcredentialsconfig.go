@@ -6,8 +6,10 @@ package oauth2clientauthextension // import "github.com/open-telemetry/opentelem
 import (
 	"context"
 	"fmt"
+	"net/url"
 	"os"
 	"strings"
+	"time"
 
 	"go.uber.org/multierr"
 	"golang.org/x/oauth2"
@@ -36,6 +38,9 @@ type clientCredentialsConfig struct {
 
 	ClientIDFile     string
 	ClientSecretFile string
+
+	ClientAuthMethod string
+	PrivateKeyJWT    PrivateKeyJWTConfig
 }
 
 type clientCredentialsTokenSource struct {
@@ -75,6 +80,24 @@ func (c *clientCredentialsConfig) createConfig() (*clientcredentials.Config, err
 		return nil, multierr.Combine(errNoClientIDProvided, err)
 	}
 
+	switch c.ClientAuthMethod {
+	case ClientAuthMethodPrivateKeyJWT:
+		assertion, err := clientAssertion(c.PrivateKeyJWT, clientID, c.TokenURL, time.Now())
+		if err != nil {
+			return nil, fmt.Errorf("failed to create the client assertion: %w", err)
+		}
+		endpointParams := url.Values{}
+		for k, v := range c.EndpointParams {
+			endpointParams[k] = v
+		}
+		endpointParams.Set("client_assertion_type", clientAssertionType)
+		endpointParams.Set("client_assertion", assertion)
+		return c.paramsAuthConfig(clientID, endpointParams), nil
+	case ClientAuthMethodTLSClientAuth:
+		// the client is authenticated by the certificate of the TLS connection
+		return c.paramsAuthConfig(clientID, c.EndpointParams), nil
+	}
+
 	clientSecret, err := getActualValue(c.ClientSecret, c.ClientSecretFile)
 	if err != nil {
 		return nil, multierr.Combine(errNoClientSecretProvided, err)
@@ -89,6 +112,18 @@ func (c *clientCredentialsConfig) createConfig() (*clientcredentials.Config, err
 	}, nil
 }
 
+// paramsAuthConfig creates a clientcredentials.Config sending the client ID in the request body,
+// without client secret.
+func (c *clientCredentialsConfig) paramsAuthConfig(clientID string, endpointParams url.Values) *clientcredentials.Config {
+	return &clientcredentials.Config{
+		ClientID:       clientID,
+		TokenURL:       c.TokenURL,
+		Scopes:         c.Scopes,
+		EndpointParams: endpointParams,
+		AuthStyle:      oauth2.AuthStyleInParams,
+	}
+}
+
 func (c *clientCredentialsConfig) TokenSource(ctx context.Context) oauth2.TokenSource {
 	return oauth2.ReuseTokenSource(nil, clientCredentialsTokenSource{ctx: ctx, config: c})
 }
@@ -13,10 +13,27 @@ import (
 	"go.opentelemetry.io/collector/config/configtls"
 )
 
+// Methods authenticating the client to the token endpoint.
+const (
+	// ClientAuthMethodClientSecret authenticates with the client secret.
+	ClientAuthMethodClientSecret = "client_secret"
+	// ClientAuthMethodPrivateKeyJWT authenticates with a JWT assertion signed with the client private key.
+	// See https://datatracker.ietf.org/doc/html/rfc7523#section-2.2
+	ClientAuthMethodPrivateKeyJWT = "private_key_jwt"
+	// ClientAuthMethodTLSClientAuth authenticates with the client certificate of the TLS connection.
+	// See https://datatracker.ietf.org/doc/html/rfc8705#section-2
+	ClientAuthMethodTLSClientAuth = "tls_client_auth"
+)
+
 var (
-	errNoClientIDProvided     = errors.New("no ClientID provided in the OAuth2 exporter configuration")
-	errNoTokenURLProvided     = errors.New("no TokenURL provided in OAuth Client Credentials configuration")
-	errNoClientSecretProvided = errors.New("no ClientSecret provided in OAuth Client Credentials configuration")
+	errNoClientIDProvided       = errors.New("no ClientID provided in the OAuth2 exporter configuration")
+	errNoTokenURLProvided       = errors.New("no TokenURL provided in OAuth Client Credentials configuration")
+	errNoClientSecretProvided   = errors.New("no ClientSecret provided in OAuth Client Credentials configuration")
+	errNoPrivateKeyFileProvided = errors.New("no PrivateKeyJWT.KeyFile provided for the private_key_jwt client authentication method")
+	errNoClientCertProvided     = errors.New("no TLS client certificate and key provided for the tls_client_auth client authentication method")
+	errUnknownClientAuthMethod  = errors.New("unknown ClientAuthMethod, must be client_secret, private_key_jwt or tls_client_auth")
+	errUnknownSigningAlgorithm  = errors.New("unknown PrivateKeyJWT.SigningAlgorithm")
+	errInvalidAssertionExpiry   = errors.New("PrivateKeyJWT.Expiry must be positive")
 )
 
 // Config stores the configuration for OAuth2 Client Credentials (2-legged OAuth2 flow) setup.
@@ -54,6 +71,33 @@ type Config struct {
 	// Timeout parameter configures `http.Client.Timeout` for the underneath client to authorization
 	// server while fetching and refreshing tokens.
 	Timeout time.Duration `mapstructure:"timeout,omitempty"`
+
+	// ClientAuthMethod is the method authenticating the client to the token endpoint:
+	// client_secret (default), private_key_jwt or tls_client_auth.
+	ClientAuthMethod string `mapstructure:"client_auth_method,omitempty"`
+
+	// PrivateKeyJWT configures the JWT assertion of the private_key_jwt client authentication method.
+	PrivateKeyJWT PrivateKeyJWTConfig `mapstructure:"private_key_jwt,omitempty"`
+}
+
+// PrivateKeyJWTConfig configures the JWT assertion authenticating the client to the token endpoint.
+type PrivateKeyJWTConfig struct {
+	// KeyFile is the path to the PEM encoded RSA or ECDSA private key signing the assertion.
+	// The file is read again each time a token is requested, so that the key can be rotated.
+	KeyFile string `mapstructure:"key_file"`
+
+	// KeyID is the optional "kid" header of the assertion, identifying the key to the authorization server.
+	KeyID string `mapstructure:"key_id"`
+
+	// SigningAlgorithm is the algorithm signing the assertion: RS256, RS384, RS512, PS256, PS384, PS512,
+	// ES256, ES384 or ES512. Defaults to RS256 for RSA keys and to the algorithm of the curve for ECDSA keys.
+	SigningAlgorithm string `mapstructure:"signing_algorithm"`
+
+	// Audience is the "aud" claim of the assertion. Defaults to the TokenURL.
+	Audience string `mapstructure:"audience"`
+
+	// Expiry is the lifetime of the assertion. Defaults to 5 minutes.
+	Expiry time.Duration `mapstructure:"expiry"`
 }
 
 var _ component.Config = (*Config)(nil)
@@ -63,8 +107,29 @@ func (cfg *Config) Validate() error {
 	if cfg.ClientID == "" && cfg.ClientIDFile == "" {
 		return errNoClientIDProvided
 	}
-	if cfg.ClientSecret == "" && cfg.ClientSecretFile == "" {
-		return errNoClientSecretProvided
+	switch cfg.ClientAuthMethod {
+	case "", ClientAuthMethodClientSecret:
+		if cfg.ClientSecret == "" && cfg.ClientSecretFile == "" {
+			return errNoClientSecretProvided
+		}
+	case ClientAuthMethodPrivateKeyJWT:
+		if cfg.PrivateKeyJWT.KeyFile == "" {
+			return errNoPrivateKeyFileProvided
+		}
+		if _, ok := signingMethods[cfg.PrivateKeyJWT.SigningAlgorithm]; !ok && cfg.PrivateKeyJWT.SigningAlgorithm != "" {
+			return errUnknownSigningAlgorithm
+		}
+		if cfg.PrivateKeyJWT.Expiry <= 0 {
+			return errInvalidAssertionExpiry
+		}
+	case ClientAuthMethodTLSClientAuth:
+		hasCert := cfg.TLSSetting.CertFile != "" || cfg.TLSSetting.CertPem != ""
+		hasKey := cfg.TLSSetting.KeyFile != "" || cfg.TLSSetting.KeyPem != ""
+		if !hasCert || !hasKey {
+			return errNoClientCertProvided
+		}
+	default:
+		return errUnknownClientAuthMethod
 	}
 	if cfg.TokenURL == "" {
 		return errNoTokenURLProvided
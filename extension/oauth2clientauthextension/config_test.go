@@ -35,6 +35,7 @@ func TestLoadConfig(t *testing.T) {
 				Scopes:         []string{"api.metrics"},
 				TokenURL:       "https://example.com/oauth2/default/v1/token",
 				Timeout:        time.Second,
+				PrivateKeyJWT:  PrivateKeyJWTConfig{Expiry: defaultAssertionExpiry},
 			},
 		},
 		{
@@ -55,6 +56,38 @@ func TestLoadConfig(t *testing.T) {
 					InsecureSkipVerify: false,
 					ServerName:         "",
 				},
+				PrivateKeyJWT: PrivateKeyJWTConfig{Expiry: defaultAssertionExpiry},
+			},
+		},
+		{
+			id: component.NewIDWithName(metadata.Type, "privatekeyjwt"),
+			expected: &Config{
+				ClientID:         "someclientid",
+				TokenURL:         "https://example.com/oauth2/default/v1/token",
+				ClientAuthMethod: ClientAuthMethodPrivateKeyJWT,
+				PrivateKeyJWT: PrivateKeyJWTConfig{
+					KeyFile:          "/path/to/key.pem",
+					KeyID:            "key-1",
+					SigningAlgorithm: "ES256",
+					Audience:         "https://example.com",
+					Expiry:           time.Minute,
+				},
+			},
+		},
+		{
+			id: component.NewIDWithName(metadata.Type, "tlsclientauth"),
+			expected: &Config{
+				ClientID:         "someclientid",
+				TokenURL:         "https://example.com/oauth2/default/v1/token",
+				ClientAuthMethod: ClientAuthMethodTLSClientAuth,
+				TLSSetting: configtls.ClientConfig{
+					Config: configtls.Config{
+						CertFile:       "certfile",
+						KeyFile:        "keyfile",
+						ReloadInterval: time.Hour,
+					},
+				},
+				PrivateKeyJWT: PrivateKeyJWTConfig{Expiry: defaultAssertionExpiry},
 			},
 		},
 		{
@@ -69,6 +102,22 @@ func TestLoadConfig(t *testing.T) {
 			id:          component.NewIDWithName(metadata.Type, "missingsecret"),
 			expectedErr: errNoClientSecretProvided,
 		},
+		{
+			id:          component.NewIDWithName(metadata.Type, "missingprivatekey"),
+			expectedErr: errNoPrivateKeyFileProvided,
+		},
+		{
+			id:          component.NewIDWithName(metadata.Type, "unknownsigningalgorithm"),
+			expectedErr: errUnknownSigningAlgorithm,
+		},
+		{
+			id:          component.NewIDWithName(metadata.Type, "missingclientcert"),
+			expectedErr: errNoClientCertProvided,
+		},
+		{
+			id:          component.NewIDWithName(metadata.Type, "unknownauthmethod"),
+			expectedErr: errUnknownClientAuthMethod,
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.id.String(), func(t *testing.T) {
@@ -55,6 +55,8 @@ func newClientAuthenticator(cfg *Config, logger *zap.Logger) (*clientAuthenticat
 			},
 			ClientIDFile:     cfg.ClientIDFile,
 			ClientSecretFile: cfg.ClientSecretFile,
+			ClientAuthMethod: cfg.ClientAuthMethod,
+			PrivateKeyJWT:    cfg.PrivateKeyJWT,
 		},
 		logger: logger,
 		client: &http.Client{
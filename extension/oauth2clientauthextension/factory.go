@@ -5,6 +5,7 @@ package oauth2clientauthextension // import "github.com/open-telemetry/opentelem
 
 import (
 	"context"
+	"time"
 
 	"go.opentelemetry.io/collector/component"
 	"go.opentelemetry.io/collector/extension"
@@ -13,6 +14,9 @@ import (
 	"github.com/open-telemetry/opentelemetry-collector-contrib/extension/oauth2clientauthextension/internal/metadata"
 )
 
+// defaultAssertionExpiry is the default lifetime of the private_key_jwt client assertions.
+const defaultAssertionExpiry = 5 * time.Minute
+
 // NewFactory creates a factory for the oauth2 client Authenticator extension.
 func NewFactory() extension.Factory {
 	return extension.NewFactory(
@@ -24,7 +28,11 @@ func NewFactory() extension.Factory {
 }
 
 func createDefaultConfig() component.Config {
-	return &Config{}
+	return &Config{
+		PrivateKeyJWT: PrivateKeyJWTConfig{
+			Expiry: defaultAssertionExpiry,
+		},
+	}
 }
 
 func createExtension(_ context.Context, set extension.CreateSettings, cfg component.Config) (extension.Extension, error) {
@@ -14,7 +14,7 @@ import (
 
 func TestCreateDefaultConfig(t *testing.T) {
 	// prepare and test
-	expected := &Config{}
+	expected := &Config{PrivateKeyJWT: PrivateKeyJWTConfig{Expiry: defaultAssertionExpiry}}
 
 	// test
 	cfg := createDefaultConfig()
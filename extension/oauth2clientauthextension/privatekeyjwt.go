@@ -0,0 +1,109 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package oauth2clientauthextension // import "github.com/open-telemetry/opentelemetry-collector-contrib/extension/oauth2clientauthextension"
+
+import (
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/rsa"
+	"encoding/hex"
+	"fmt"
+	"os"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+)
+
+// clientAssertionType is the type of the client_assertion parameter of the private_key_jwt method.
+// See https://datatracker.ietf.org/doc/html/rfc7523#section-2.2
+const clientAssertionType = "urn:ietf:params:oauth:client-assertion-type:jwt-bearer"
+
+// signingMethods are the algorithms which can sign the client assertion.
+var signingMethods = map[string]jwt.SigningMethod{
+	jwt.SigningMethodRS256.Alg(): jwt.SigningMethodRS256,
+	jwt.SigningMethodRS384.Alg(): jwt.SigningMethodRS384,
+	jwt.SigningMethodRS512.Alg(): jwt.SigningMethodRS512,
+	jwt.SigningMethodPS256.Alg(): jwt.SigningMethodPS256,
+	jwt.SigningMethodPS384.Alg(): jwt.SigningMethodPS384,
+	jwt.SigningMethodPS512.Alg(): jwt.SigningMethodPS512,
+	jwt.SigningMethodES256.Alg(): jwt.SigningMethodES256,
+	jwt.SigningMethodES384.Alg(): jwt.SigningMethodES384,
+	jwt.SigningMethodES512.Alg(): jwt.SigningMethodES512,
+}
+
+// readPrivateKey reads the PEM encoded RSA or ECDSA private key of the file.
+func readPrivateKey(path string) (crypto.Signer, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read private key file %q: %w", path, err)
+	}
+	if key, err := jwt.ParseRSAPrivateKeyFromPEM(data); err == nil {
+		return key, nil
+	}
+	if key, err := jwt.ParseECPrivateKeyFromPEM(data); err == nil {
+		return key, nil
+	}
+	return nil, fmt.Errorf("private key file %q doesn't contain a PEM encoded RSA or ECDSA private key", path)
+}
+
+// signingMethodForKey returns the configured signing method, or the default one for the key.
+func signingMethodForKey(algorithm string, key crypto.Signer) (jwt.SigningMethod, error) {
+	if algorithm != "" {
+		method, ok := signingMethods[algorithm]
+		if !ok {
+			return nil, fmt.Errorf("%w %q", errUnknownSigningAlgorithm, algorithm)
+		}
+		return method, nil
+	}
+	switch k := key.(type) {
+	case *rsa.PrivateKey:
+		return jwt.SigningMethodRS256, nil
+	case *ecdsa.PrivateKey:
+		switch k.Curve {
+		case elliptic.P256():
+			return jwt.SigningMethodES256, nil
+		case elliptic.P384():
+			return jwt.SigningMethodES384, nil
+		case elliptic.P521():
+			return jwt.SigningMethodES512, nil
+		}
+	}
+	return nil, fmt.Errorf("no default signing algorithm for the private key of type %T", key)
+}
+
+// clientAssertion returns a JWT asserting the identity of the client, signed with its private key.
+func clientAssertion(cfg PrivateKeyJWTConfig, clientID, tokenURL string, now time.Time) (string, error) {
+	key, err := readPrivateKey(cfg.KeyFile)
+	if err != nil {
+		return "", err
+	}
+	method, err := signingMethodForKey(cfg.SigningAlgorithm, key)
+	if err != nil {
+		return "", err
+	}
+
+	audience := cfg.Audience
+	if audience == "" {
+		audience = tokenURL
+	}
+	jti := make([]byte, 16)
+	if _, err = rand.Read(jti); err != nil {
+		return "", err
+	}
+
+	token := jwt.NewWithClaims(method, jwt.RegisteredClaims{
+		Issuer:    clientID,
+		Subject:   clientID,
+		Audience:  jwt.ClaimStrings{audience},
+		ID:        hex.EncodeToString(jti),
+		IssuedAt:  jwt.NewNumericDate(now),
+		ExpiresAt: jwt.NewNumericDate(now.Add(cfg.Expiry)),
+	})
+	if cfg.KeyID != "" {
+		token.Header["kid"] = cfg.KeyID
+	}
+	return token.SignedString(key)
+}
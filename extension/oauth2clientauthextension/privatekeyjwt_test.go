@@ -0,0 +1,182 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package oauth2clientauthextension
+
+import (
+	"context"
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/x509"
+	"encoding/pem"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"golang.org/x/oauth2/clientcredentials"
+)
+
+func writeECKey(t *testing.T, curve elliptic.Curve) (*ecdsa.PrivateKey, string) {
+	key, err := ecdsa.GenerateKey(curve, rand.Reader)
+	require.NoError(t, err)
+	der, err := x509.MarshalECPrivateKey(key)
+	require.NoError(t, err)
+	return key, writeKey(t, &pem.Block{Type: "EC PRIVATE KEY", Bytes: der})
+}
+
+func writeRSAKey(t *testing.T) (*rsa.PrivateKey, string) {
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	require.NoError(t, err)
+	return key, writeKey(t, &pem.Block{Type: "RSA PRIVATE KEY", Bytes: x509.MarshalPKCS1PrivateKey(key)})
+}
+
+func writeKey(t *testing.T, block *pem.Block) string {
+	path := filepath.Join(t.TempDir(), "key.pem")
+	require.NoError(t, os.WriteFile(path, pem.EncodeToMemory(block), 0600))
+	return path
+}
+
+func TestSigningMethodForKey(t *testing.T) {
+	p256, _ := writeECKey(t, elliptic.P256())
+	p384, _ := writeECKey(t, elliptic.P384())
+	p521, _ := writeECKey(t, elliptic.P521())
+	rsaKey, _ := writeRSAKey(t)
+
+	tests := []struct {
+		name      string
+		algorithm string
+		key       crypto.Signer
+		expected  jwt.SigningMethod
+		err       error
+	}{
+		{name: "rsa", key: rsaKey, expected: jwt.SigningMethodRS256},
+		{name: "p256", key: p256, expected: jwt.SigningMethodES256},
+		{name: "p384", key: p384, expected: jwt.SigningMethodES384},
+		{name: "p521", key: p521, expected: jwt.SigningMethodES512},
+		{name: "configured", algorithm: "PS384", key: rsaKey, expected: jwt.SigningMethodPS384},
+		{name: "unknown", algorithm: "HS256", key: rsaKey, err: errUnknownSigningAlgorithm},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			method, err := signingMethodForKey(tt.algorithm, tt.key)
+			if tt.err != nil {
+				assert.ErrorIs(t, err, tt.err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.expected, method)
+		})
+	}
+}
+
+func TestReadPrivateKeyErrors(t *testing.T) {
+	_, err := readPrivateKey(filepath.Join(t.TempDir(), "missing.pem"))
+	assert.ErrorContains(t, err, "failed to read private key file")
+
+	_, err = readPrivateKey("testdata/test-cert.pem")
+	assert.ErrorContains(t, err, "doesn't contain a PEM encoded RSA or ECDSA private key")
+}
+
+func TestClientAssertion(t *testing.T) {
+	key, path := writeECKey(t, elliptic.P256())
+	now := time.Now().Truncate(time.Second)
+	cfg := PrivateKeyJWTConfig{KeyFile: path, KeyID: "key-1", Expiry: time.Minute}
+
+	assertion, err := clientAssertion(cfg, "someclientid", "https://example.com/token", now)
+	require.NoError(t, err)
+
+	claims := &jwt.RegisteredClaims{}
+	token, err := jwt.ParseWithClaims(assertion, claims, func(*jwt.Token) (any, error) {
+		return &key.PublicKey, nil
+	}, jwt.WithValidMethods([]string{"ES256"}), jwt.WithTimeFunc(func() time.Time { return now }))
+	require.NoError(t, err)
+	assert.Equal(t, "key-1", token.Header["kid"])
+	assert.Equal(t, "someclientid", claims.Issuer)
+	assert.Equal(t, "someclientid", claims.Subject)
+	assert.Equal(t, jwt.ClaimStrings{"https://example.com/token"}, claims.Audience)
+	assert.NotEmpty(t, claims.ID)
+	assert.Equal(t, now, claims.IssuedAt.Time)
+	assert.Equal(t, now.Add(time.Minute), claims.ExpiresAt.Time)
+
+	cfg.Audience = "https://example.com"
+	assertion, err = clientAssertion(cfg, "someclientid", "https://example.com/token", now)
+	require.NoError(t, err)
+	_, err = jwt.ParseWithClaims(assertion, claims, func(*jwt.Token) (any, error) {
+		return &key.PublicKey, nil
+	}, jwt.WithTimeFunc(func() time.Time { return now }))
+	require.NoError(t, err)
+	assert.Equal(t, jwt.ClaimStrings{"https://example.com"}, claims.Audience)
+}
+
+func TestClientAuthMethodToken(t *testing.T) {
+	key, path := writeRSAKey(t)
+
+	tests := []struct {
+		name   string
+		config clientCredentialsConfig
+		verify func(t *testing.T, r *http.Request)
+	}{
+		{
+			name: "private_key_jwt",
+			config: clientCredentialsConfig{
+				ClientAuthMethod: ClientAuthMethodPrivateKeyJWT,
+				PrivateKeyJWT:    PrivateKeyJWTConfig{KeyFile: path, Expiry: time.Minute},
+			},
+			verify: func(t *testing.T, r *http.Request) {
+				assert.Equal(t, clientAssertionType, r.PostForm.Get("client_assertion_type"))
+				claims := &jwt.RegisteredClaims{}
+				_, err := jwt.ParseWithClaims(r.PostForm.Get("client_assertion"), claims, func(*jwt.Token) (any, error) {
+					return &key.PublicKey, nil
+				}, jwt.WithValidMethods([]string{"RS256"}))
+				assert.NoError(t, err)
+				assert.Equal(t, "someclientid", claims.Subject)
+				assert.Equal(t, "bar", r.PostForm.Get("foo"))
+			},
+		},
+		{
+			name: "tls_client_auth",
+			config: clientCredentialsConfig{
+				ClientAuthMethod: ClientAuthMethodTLSClientAuth,
+			},
+			verify: func(t *testing.T, r *http.Request) {
+				assert.Empty(t, r.PostForm.Get("client_assertion"))
+				assert.Equal(t, "bar", r.PostForm.Get("foo"))
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				assert.NoError(t, r.ParseForm())
+				_, _, ok := r.BasicAuth()
+				assert.False(t, ok)
+				assert.Equal(t, "someclientid", r.PostForm.Get("client_id"))
+				assert.Empty(t, r.PostForm.Get("client_secret"))
+				tt.verify(t, r)
+				w.Header().Set("Content-Type", "application/json")
+				_, _ = w.Write([]byte(`{"access_token":"sometoken","token_type":"bearer"}`))
+			}))
+			defer server.Close()
+
+			tt.config.Config = clientcredentials.Config{
+				ClientID:       "someclientid",
+				TokenURL:       server.URL,
+				EndpointParams: map[string][]string{"foo": {"bar"}},
+			}
+			token, err := tt.config.TokenSource(context.Background()).Token()
+			require.NoError(t, err)
+			assert.Equal(t, "sometoken", token.AccessToken)
+			// the endpoint parameters of the configuration are left untouched
+			assert.Equal(t, map[string][]string{"foo": {"bar"}}, map[string][]string(tt.config.EndpointParams))
+		})
+	}
+}
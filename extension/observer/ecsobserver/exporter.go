@@ -5,6 +5,7 @@ package ecsobserver // import "github.com/open-telemetry/opentelemetry-collector
 
 import (
 	"fmt"
+	"strings"
 
 	"github.com/aws/aws-sdk-go/aws"
 	"go.uber.org/multierr"
@@ -93,6 +94,9 @@ func (e *taskExporter) exportTask(task *taskAnnotated) ([]prometheusECSTarget, e
 		Source:                 aws.StringValue(task.Task.TaskArn),
 		MetricsPath:            defaultMetricsPath,
 		ClusterName:            e.cluster,
+		ClusterARN:             aws.StringValue(task.Task.ClusterArn),
+		TaskID:                 taskID(aws.StringValue(task.Task.TaskArn)),
+		TaskAvailabilityZone:   aws.StringValue(task.Task.AvailabilityZone),
 		TaskDefinitionFamily:   aws.StringValue(task.Definition.Family),
 		TaskDefinitionRevision: int(aws.Int64Value(task.Definition.Revision)),
 		TaskStartedBy:          aws.StringValue(task.Task.StartedBy),
@@ -103,6 +107,7 @@ func (e *taskExporter) exportTask(task *taskAnnotated) ([]prometheusECSTarget, e
 	}
 	if task.Service != nil {
 		baseTarget.ServiceName = aws.StringValue(task.Service.ServiceName)
+		baseTarget.ServiceARN = aws.StringValue(task.Service.ServiceArn)
 	}
 	if task.EC2 != nil {
 		ec2 := task.EC2
@@ -149,3 +154,8 @@ func (e *taskExporter) exportTask(task *taskAnnotated) ([]prometheusECSTarget, e
 	}
 	return targetsInTask, merr
 }
+
+// taskID returns the ID of the task, the last part of its ARN, e.g. arn:aws:ecs:us-west-2:123456789012:task/my-cluster/<id>.
+func taskID(taskARN string) string {
+	return taskARN[strings.LastIndex(taskARN, "/")+1:]
+}
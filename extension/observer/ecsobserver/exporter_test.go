@@ -86,6 +86,43 @@ func TestTaskExporter(t *testing.T) {
 		assert.Equal(t, "172.168.1.1:2113", targets[0].Address)
 	})
 
+	t.Run("task metadata", func(t *testing.T) {
+		task := &taskAnnotated{
+			Task: &ecs.Task{
+				TaskArn:           aws.String("arn:aws:ecs:us-west-2:123456789012:task/ecs-cluster-1/0123456789abcdef"),
+				ClusterArn:        aws.String("arn:aws:ecs:us-west-2:123456789012:cluster/ecs-cluster-1"),
+				AvailabilityZone:  aws.String("us-west-2a"),
+				TaskDefinitionArn: aws.String("t2"),
+				Attachments:       awsVpcTask.Attachments,
+				Containers:        awsVpcTask.Containers,
+			},
+			Definition: awsVpcTaskDef,
+			Service: &ecs.Service{
+				ServiceName: aws.String("svc-1"),
+				ServiceArn:  aws.String("arn:aws:ecs:us-west-2:123456789012:service/ecs-cluster-1/svc-1"),
+			},
+			Matched: []matchedContainer{
+				{
+					Targets: []matchedTarget{
+						{
+							MatcherType: matcherTypeDockerLabel,
+							Port:        2112,
+							Job:         "PROM_JOB_1",
+						},
+					},
+				},
+			},
+		}
+
+		targets, err := exp.exportTask(task)
+		require.NoError(t, err)
+		require.Len(t, targets, 1)
+		assert.Equal(t, "0123456789abcdef", targets[0].TaskID)
+		assert.Equal(t, "arn:aws:ecs:us-west-2:123456789012:cluster/ecs-cluster-1", targets[0].ClusterARN)
+		assert.Equal(t, "arn:aws:ecs:us-west-2:123456789012:service/ecs-cluster-1/svc-1", targets[0].ServiceARN)
+		assert.Equal(t, "us-west-2a", targets[0].TaskAvailabilityZone)
+	})
+
 	t.Run("multiple target in one container", func(t *testing.T) {
 		task := &taskAnnotated{
 			Task:       awsVpcTask,
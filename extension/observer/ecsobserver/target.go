@@ -30,7 +30,11 @@ type prometheusECSTarget struct {
 	MetricsPath            string            `label:"__metrics_path__"`
 	Job                    string            `label:"job"`
 	ClusterName            string            `label:"cluster_name"`
+	ClusterARN             string            `label:"cluster_arn"`
 	ServiceName            string            `label:"service_name"`
+	ServiceARN             string            `label:"service_arn"`
+	TaskID                 string            `label:"task_id"`
+	TaskAvailabilityZone   string            `label:"task_availability_zone"`
 	TaskDefinitionFamily   string            `label:"task_definition_family"`
 	TaskDefinitionRevision int               `label:"task_definition_revision"`
 	TaskStartedBy          string            `label:"task_started_by"`
@@ -55,7 +59,11 @@ const (
 	labelMetricsPath            = "__metrics_path__"
 	labelJob                    = "job"
 	labelClusterName            = labelPrefix + "cluster_name"
+	labelClusterARN             = labelPrefix + "cluster_arn"
 	labelServiceName            = labelPrefix + "service_name"
+	labelServiceARN             = labelPrefix + "service_arn"
+	labelTaskID                 = labelPrefix + "task_id"
+	labelTaskAvailabilityZone   = labelPrefix + "task_availability_zone"
 	labelTaskDefinitionFamily   = labelPrefix + "task_definition_family"
 	labelTaskDefinitionRevision = labelPrefix + "task_definition_revision"
 	labelTaskStartedBy          = labelPrefix + "task_started_by"
@@ -83,7 +91,11 @@ func (t *prometheusECSTarget) ToLabels() map[string]string {
 		labelMetricsPath:            t.MetricsPath,
 		labelJob:                    t.Job,
 		labelClusterName:            t.ClusterName,
+		labelClusterARN:             t.ClusterARN,
 		labelServiceName:            t.ServiceName,
+		labelServiceARN:             t.ServiceARN,
+		labelTaskID:                 t.TaskID,
+		labelTaskAvailabilityZone:   t.TaskAvailabilityZone,
 		labelTaskDefinitionFamily:   t.TaskDefinitionFamily,
 		labelTaskDefinitionRevision: strconv.Itoa(t.TaskDefinitionRevision),
 		labelTaskStartedBy:          t.TaskStartedBy,
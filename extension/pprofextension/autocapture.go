@@ -0,0 +1,316 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package pprofextension // import "github.com/open-telemetry/opentelemetry-collector-contrib/extension/pprofextension"
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"os"
+	"path/filepath"
+	"runtime/pprof"
+	"sort"
+	"strings"
+	"time"
+
+	dto "github.com/prometheus/client_model/go"
+	"github.com/prometheus/common/expfmt"
+	"go.uber.org/zap"
+)
+
+const (
+	cpuProfile        = "cpu"
+	profileFileSuffix = ".pprof"
+)
+
+var supportedProfiles = map[string]bool{
+	cpuProfile:     true,
+	"heap":         true,
+	"allocs":       true,
+	"goroutine":    true,
+	"mutex":        true,
+	"block":        true,
+	"threadcreate": true,
+}
+
+// autoCapture evaluates the capture rules against the telemetry of the Collector,
+// and saves the profiles of the rules crossing their threshold.
+type autoCapture struct {
+	config      AutoCaptureConfig
+	logger      *zap.Logger
+	client      *http.Client
+	lastCapture map[string]time.Time
+	now         func() time.Time
+
+	stopCh chan struct{}
+	doneCh chan struct{}
+}
+
+func newAutoCapture(config AutoCaptureConfig, logger *zap.Logger) *autoCapture {
+	return &autoCapture{
+		config:      config,
+		logger:      logger,
+		client:      &http.Client{Timeout: config.CheckInterval},
+		lastCapture: map[string]time.Time{},
+		now:         time.Now,
+	}
+}
+
+func (a *autoCapture) start() error {
+	if err := os.MkdirAll(a.config.Directory, 0700); err != nil {
+		return fmt.Errorf("failed to create the profiles directory: %w", err)
+	}
+
+	a.stopCh = make(chan struct{})
+	a.doneCh = make(chan struct{})
+	go func() {
+		defer close(a.doneCh)
+		ticker := time.NewTicker(a.config.CheckInterval)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-a.stopCh:
+				return
+			case <-ticker.C:
+				a.check()
+			}
+		}
+	}()
+	return nil
+}
+
+func (a *autoCapture) stop() {
+	if a.stopCh == nil {
+		return
+	}
+	close(a.stopCh)
+	<-a.doneCh
+	a.stopCh = nil
+}
+
+// check evaluates the rules, and captures the profiles of the triggered rules.
+func (a *autoCapture) check() {
+	families, err := a.scrape()
+	if err != nil {
+		a.logger.Warn("Failed to read the Collector telemetry, the capture rules are not evaluated", zap.Error(err))
+		return
+	}
+
+	for _, rule := range a.config.Rules {
+		value, ok := evaluateRule(rule, families)
+		if !ok || value <= rule.Threshold {
+			continue
+		}
+
+		now := a.now()
+		if last, ok := a.lastCapture[rule.Name]; ok && now.Sub(last) < a.config.MinInterval {
+			a.logger.Debug("Capture rule triggered, skipped because of the min interval", zap.String("rule", rule.Name), zap.Float64("value", value))
+			continue
+		}
+		a.lastCapture[rule.Name] = now
+
+		a.logger.Info("Capture rule triggered, capturing profiles",
+			zap.String("rule", rule.Name),
+			zap.Float64("value", value),
+			zap.Float64("threshold", rule.Threshold),
+			zap.Strings("profiles", rule.Profiles))
+		for _, profile := range rule.Profiles {
+			if err := a.capture(rule.Name, profile, now); err != nil {
+				a.logger.Error("Failed to capture the profile", zap.String("rule", rule.Name), zap.String("profile", profile), zap.Error(err))
+			}
+		}
+		if err := a.removeOldProfiles(); err != nil {
+			a.logger.Warn("Failed to remove the old profiles", zap.Error(err))
+		}
+	}
+}
+
+func (a *autoCapture) scrape() (map[string]*dto.MetricFamily, error) {
+	req, err := http.NewRequest(http.MethodGet, a.config.TelemetryEndpoint, nil)
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Accept", string(expfmt.NewFormat(expfmt.TypeTextPlain)))
+
+	resp, err := a.client.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("unexpected status code %d", resp.StatusCode)
+	}
+
+	var parser expfmt.TextParser
+	return parser.TextToMetricFamilies(resp.Body)
+}
+
+// capture saves a profile to a file named after the rule, the profile and the time of the capture.
+func (a *autoCapture) capture(rule string, profile string, at time.Time) error {
+	name := fmt.Sprintf("%s-%s-%s%s", rule, profile, at.UTC().Format("20060102T150405.000Z"), profileFileSuffix)
+	f, err := os.Create(filepath.Join(a.config.Directory, name))
+	if err != nil {
+		return err
+	}
+
+	if err = a.writeProfile(f, profile); err != nil {
+		_ = f.Close()
+		_ = os.Remove(f.Name())
+		return err
+	}
+	return f.Close()
+}
+
+func (a *autoCapture) writeProfile(f *os.File, profile string) error {
+	if profile != cpuProfile {
+		p := pprof.Lookup(profile)
+		if p == nil {
+			return fmt.Errorf("unknown profile %q", profile)
+		}
+		return p.WriteTo(f, 0)
+	}
+
+	// Fails when the CPU profile is already captured, e.g. with save_to_file.
+	if err := pprof.StartCPUProfile(f); err != nil {
+		return err
+	}
+	timer := time.NewTimer(a.config.CPUProfileDuration)
+	defer timer.Stop()
+	select {
+	case <-timer.C:
+	case <-a.stopCh:
+	}
+	pprof.StopCPUProfile()
+	return nil
+}
+
+// removeOldProfiles keeps the most recent max_files profiles of the rules in the directory.
+func (a *autoCapture) removeOldProfiles() error {
+	if a.config.MaxFiles == 0 {
+		return nil
+	}
+
+	entries, err := os.ReadDir(a.config.Directory)
+	if err != nil {
+		return err
+	}
+	type profileFile struct {
+		name    string
+		modTime time.Time
+	}
+	var files []profileFile
+	for _, entry := range entries {
+		if entry.IsDir() || !strings.HasSuffix(entry.Name(), profileFileSuffix) || !a.isRuleFile(entry.Name()) {
+			continue
+		}
+		info, err := entry.Info()
+		if err != nil {
+			continue
+		}
+		files = append(files, profileFile{name: entry.Name(), modTime: info.ModTime()})
+	}
+	if len(files) <= a.config.MaxFiles {
+		return nil
+	}
+
+	sort.Slice(files, func(i, j int) bool {
+		if files[i].modTime.Equal(files[j].modTime) {
+			return files[i].name < files[j].name
+		}
+		return files[i].modTime.Before(files[j].modTime)
+	})
+	var errs error
+	for _, file := range files[:len(files)-a.config.MaxFiles] {
+		errs = errors.Join(errs, os.Remove(filepath.Join(a.config.Directory, file.name)))
+	}
+	return errs
+}
+
+// isRuleFile returns whether the file is a profile captured by one of the rules.
+func (a *autoCapture) isRuleFile(name string) bool {
+	for _, rule := range a.config.Rules {
+		if strings.HasPrefix(name, rule.Name+"-") {
+			return true
+		}
+	}
+	return false
+}
+
+// evaluateRule returns the greatest value of the series of the metric of the rule.
+// It returns false when no series matches the labels of the rule.
+func evaluateRule(rule CaptureRuleConfig, families map[string]*dto.MetricFamily) (float64, bool) {
+	family, ok := families[rule.Metric]
+	if !ok {
+		return 0, false
+	}
+	var divisors *dto.MetricFamily
+	if rule.RatioTo != "" {
+		if divisors, ok = families[rule.RatioTo]; !ok {
+			return 0, false
+		}
+	}
+
+	var result float64
+	found := false
+	for _, metric := range family.GetMetric() {
+		if !hasLabels(metric, rule.Labels) {
+			continue
+		}
+		value, ok := metricValue(metric)
+		if !ok {
+			continue
+		}
+		if divisors != nil {
+			divisor, ok := sameSeriesValue(metric, divisors)
+			if !ok || divisor == 0 {
+				continue
+			}
+			value /= divisor
+		}
+		if !found || value > result {
+			result = value
+			found = true
+		}
+	}
+	return result, found
+}
+
+func hasLabels(metric *dto.Metric, labels map[string]string) bool {
+	matched := 0
+	for _, label := range metric.GetLabel() {
+		if value, ok := labels[label.GetName()]; ok {
+			if value != label.GetValue() {
+				return false
+			}
+			matched++
+		}
+	}
+	return matched == len(labels)
+}
+
+func metricValue(metric *dto.Metric) (float64, bool) {
+	switch {
+	case metric.Gauge != nil:
+		return metric.GetGauge().GetValue(), true
+	case metric.Counter != nil:
+		return metric.GetCounter().GetValue(), true
+	case metric.Untyped != nil:
+		return metric.GetUntyped().GetValue(), true
+	}
+	return 0, false
+}
+
+// sameSeriesValue returns the value of the series of the family having the same labels as the metric.
+func sameSeriesValue(metric *dto.Metric, family *dto.MetricFamily) (float64, bool) {
+	labels := make(map[string]string, len(metric.GetLabel()))
+	for _, label := range metric.GetLabel() {
+		labels[label.GetName()] = label.GetValue()
+	}
+	for _, candidate := range family.GetMetric() {
+		if len(candidate.GetLabel()) == len(labels) && hasLabels(candidate, labels) {
+			return metricValue(candidate)
+		}
+	}
+	return 0, false
+}
@@ -0,0 +1,219 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package pprofextension
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/prometheus/common/expfmt"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+)
+
+const testTelemetry = `# TYPE otelcol_process_memory_rss gauge
+otelcol_process_memory_rss{service_instance_id="1"} 2.5e+09
+# TYPE otelcol_exporter_queue_size gauge
+otelcol_exporter_queue_size{exporter="otlp",service_instance_id="1"} 900
+otelcol_exporter_queue_size{exporter="otlphttp",service_instance_id="1"} 100
+# TYPE otelcol_exporter_queue_capacity gauge
+otelcol_exporter_queue_capacity{exporter="otlp",service_instance_id="1"} 1000
+otelcol_exporter_queue_capacity{exporter="otlphttp",service_instance_id="1"} 1000
+# TYPE otelcol_exporter_sent_spans counter
+otelcol_exporter_sent_spans{exporter="otlp",service_instance_id="1"} 42
+`
+
+func TestEvaluateRule(t *testing.T) {
+	var parser expfmt.TextParser
+	families, err := parser.TextToMetricFamilies(strings.NewReader(testTelemetry))
+	require.NoError(t, err)
+
+	tests := []struct {
+		name     string
+		rule     CaptureRuleConfig
+		expected float64
+		found    bool
+	}{
+		{
+			name:     "gauge",
+			rule:     CaptureRuleConfig{Metric: "otelcol_process_memory_rss"},
+			expected: 2.5e9,
+			found:    true,
+		},
+		{
+			name:     "counter",
+			rule:     CaptureRuleConfig{Metric: "otelcol_exporter_sent_spans"},
+			expected: 42,
+			found:    true,
+		},
+		{
+			name:     "greatest series",
+			rule:     CaptureRuleConfig{Metric: "otelcol_exporter_queue_size"},
+			expected: 900,
+			found:    true,
+		},
+		{
+			name:     "labels",
+			rule:     CaptureRuleConfig{Metric: "otelcol_exporter_queue_size", Labels: map[string]string{"exporter": "otlphttp"}},
+			expected: 100,
+			found:    true,
+		},
+		{
+			name:     "ratio",
+			rule:     CaptureRuleConfig{Metric: "otelcol_exporter_queue_size", RatioTo: "otelcol_exporter_queue_capacity"},
+			expected: 0.9,
+			found:    true,
+		},
+		{
+			name: "no matching labels",
+			rule: CaptureRuleConfig{Metric: "otelcol_exporter_queue_size", Labels: map[string]string{"exporter": "kafka"}},
+		},
+		{
+			name: "missing metric",
+			rule: CaptureRuleConfig{Metric: "otelcol_missing"},
+		},
+		{
+			name: "missing ratio metric",
+			rule: CaptureRuleConfig{Metric: "otelcol_exporter_queue_size", RatioTo: "otelcol_missing"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			value, found := evaluateRule(tt.rule, families)
+			assert.Equal(t, tt.found, found)
+			assert.Equal(t, tt.expected, value)
+		})
+	}
+}
+
+func newTestAutoCapture(t *testing.T, config AutoCaptureConfig) (*autoCapture, *atomic.Int64) {
+	scrapes := &atomic.Int64{}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		scrapes.Add(1)
+		_, _ = w.Write([]byte(testTelemetry))
+	}))
+	t.Cleanup(server.Close)
+
+	config.TelemetryEndpoint = server.URL
+	if config.Directory == "" {
+		config.Directory = t.TempDir()
+	}
+	if config.CheckInterval == 0 {
+		config.CheckInterval = time.Second
+	}
+	return newAutoCapture(config, zap.NewNop()), scrapes
+}
+
+func profileFiles(t *testing.T, dir string) []string {
+	entries, err := os.ReadDir(dir)
+	require.NoError(t, err)
+	var names []string
+	for _, entry := range entries {
+		names = append(names, entry.Name())
+	}
+	return names
+}
+
+func TestAutoCaptureCheck(t *testing.T) {
+	ac, _ := newTestAutoCapture(t, AutoCaptureConfig{
+		MinInterval: time.Minute,
+		Rules: []CaptureRuleConfig{
+			{Name: "high_rss", Metric: "otelcol_process_memory_rss", Threshold: 2e9, Profiles: []string{"heap", "goroutine"}},
+			{Name: "queue_saturation", Metric: "otelcol_exporter_queue_size", RatioTo: "otelcol_exporter_queue_capacity", Threshold: 0.95, Profiles: []string{"heap"}},
+		},
+	})
+	now := time.Date(2024, 6, 12, 10, 15, 0, 0, time.UTC)
+	ac.now = func() time.Time { return now }
+
+	ac.check()
+	assert.ElementsMatch(t, []string{
+		"high_rss-heap-20240612T101500.000Z.pprof",
+		"high_rss-goroutine-20240612T101500.000Z.pprof",
+	}, profileFiles(t, ac.config.Directory))
+	info, err := os.Stat(filepath.Join(ac.config.Directory, "high_rss-heap-20240612T101500.000Z.pprof"))
+	require.NoError(t, err)
+	assert.Positive(t, info.Size())
+
+	// rate limited by the min interval
+	now = now.Add(30 * time.Second)
+	ac.check()
+	assert.Len(t, profileFiles(t, ac.config.Directory), 2)
+
+	now = now.Add(30 * time.Second)
+	ac.check()
+	assert.Len(t, profileFiles(t, ac.config.Directory), 4)
+}
+
+func TestAutoCaptureRetention(t *testing.T) {
+	ac, _ := newTestAutoCapture(t, AutoCaptureConfig{
+		MaxFiles: 2,
+		Rules: []CaptureRuleConfig{
+			{Name: "high_rss", Metric: "otelcol_process_memory_rss", Threshold: 2e9, Profiles: []string{"heap"}},
+		},
+	})
+	// the files which weren't captured by the rules are kept
+	require.NoError(t, os.WriteFile(filepath.Join(ac.config.Directory, "other.pprof"), nil, 0600))
+
+	now := time.Date(2024, 6, 12, 10, 15, 0, 0, time.UTC)
+	ac.now = func() time.Time { return now }
+	for i := 0; i < 4; i++ {
+		ac.check()
+		// ensure the modification times of the files differ
+		past := now.Add(time.Duration(i-10) * time.Minute)
+		name := fmt.Sprintf("high_rss-heap-%s.pprof", now.Format("20060102T150405.000Z"))
+		require.NoError(t, os.Chtimes(filepath.Join(ac.config.Directory, name), past, past))
+		now = now.Add(time.Minute)
+	}
+
+	assert.ElementsMatch(t, []string{
+		"other.pprof",
+		"high_rss-heap-20240612T101700.000Z.pprof",
+		"high_rss-heap-20240612T101800.000Z.pprof",
+	}, profileFiles(t, ac.config.Directory))
+}
+
+func TestAutoCaptureTelemetryUnavailable(t *testing.T) {
+	ac := newAutoCapture(AutoCaptureConfig{
+		TelemetryEndpoint: "http://localhost:0/metrics",
+		Directory:         t.TempDir(),
+		CheckInterval:     time.Second,
+		Rules: []CaptureRuleConfig{
+			{Name: "high_rss", Metric: "otelcol_process_memory_rss", Profiles: []string{"heap"}},
+		},
+	}, zap.NewNop())
+
+	ac.check()
+	assert.Empty(t, profileFiles(t, ac.config.Directory))
+}
+
+func TestAutoCaptureStartStop(t *testing.T) {
+	ac, scrapes := newTestAutoCapture(t, AutoCaptureConfig{
+		Directory:          filepath.Join(t.TempDir(), "profiles"),
+		CheckInterval:      10 * time.Millisecond,
+		MinInterval:        time.Hour,
+		CPUProfileDuration: time.Hour,
+		Rules: []CaptureRuleConfig{
+			{Name: "high_rss", Metric: "otelcol_process_memory_rss", Threshold: 2e9, Profiles: []string{"cpu"}},
+		},
+	})
+
+	require.NoError(t, ac.start())
+	assert.Eventually(t, func() bool {
+		return scrapes.Load() > 0 && len(profileFiles(t, ac.config.Directory)) == 1
+	}, 5*time.Second, 10*time.Millisecond)
+
+	// stopping interrupts the capture of the CPU profile
+	ac.stop()
+	files := profileFiles(t, ac.config.Directory)
+	require.Len(t, files, 1)
+	assert.True(t, strings.HasPrefix(files[0], "high_rss-cpu-"))
+}
@@ -4,6 +4,11 @@
 package pprofextension // import "github.com/open-telemetry/opentelemetry-collector-contrib/extension/pprofextension"
 
 import (
+	"errors"
+	"fmt"
+	"regexp"
+	"time"
+
 	"go.opentelemetry.io/collector/component"
 	"go.opentelemetry.io/collector/config/confignet"
 )
@@ -29,11 +34,122 @@ type Config struct {
 	// Optional file name to save the CPU profile to. The profiling starts when the
 	// Collector starts and is saved to the file when the Collector is terminated.
 	SaveToFile string `mapstructure:"save_to_file"`
+
+	// AutoCapture defines rules to capture profiles automatically when the
+	// telemetry of the Collector crosses thresholds.
+	AutoCapture AutoCaptureConfig `mapstructure:"auto_capture"`
+}
+
+// AutoCaptureConfig defines the automatic capture of profiles. It's disabled when no rule is configured.
+type AutoCaptureConfig struct {
+	// TelemetryEndpoint is the URL of the Prometheus endpoint exposing the
+	// metrics of the Collector, which are evaluated by the rules.
+	TelemetryEndpoint string `mapstructure:"telemetry_endpoint"`
+
+	// Directory is the directory where the profiles are saved.
+	Directory string `mapstructure:"directory"`
+
+	// CheckInterval specifies how often the rules are evaluated.
+	CheckInterval time.Duration `mapstructure:"check_interval"`
+
+	// MinInterval is the minimum time between two captures of the same rule.
+	MinInterval time.Duration `mapstructure:"min_interval"`
+
+	// MaxFiles is the number of profiles kept in the directory, the oldest
+	// profiles are removed. A value of 0 keeps all the profiles.
+	MaxFiles int `mapstructure:"max_files"`
+
+	// CPUProfileDuration is the duration of the CPU profiles.
+	CPUProfileDuration time.Duration `mapstructure:"cpu_profile_duration"`
+
+	// Rules are the conditions triggering the capture of profiles.
+	Rules []CaptureRuleConfig `mapstructure:"rules"`
+}
+
+// CaptureRuleConfig defines the profiles captured when a metric crosses a threshold.
+type CaptureRuleConfig struct {
+	// Name identifies the rule, it's used as the prefix of the profile files.
+	Name string `mapstructure:"name"`
+
+	// Metric is the name of the metric, as exposed by the Prometheus endpoint.
+	Metric string `mapstructure:"metric"`
+
+	// Labels filters the series of the metric, only the series having all these labels are evaluated.
+	Labels map[string]string `mapstructure:"labels"`
+
+	// RatioTo is the name of a metric dividing the value of the series having the same labels,
+	// e.g. to compare the size of a queue to its capacity.
+	RatioTo string `mapstructure:"ratio_to"`
+
+	// Threshold triggers the capture when the value of a series is greater.
+	Threshold float64 `mapstructure:"threshold"`
+
+	// Profiles are the names of the captured profiles: cpu, heap, allocs, goroutine, mutex, block or threadcreate.
+	Profiles []string `mapstructure:"profiles"`
 }
 
 var _ component.Config = (*Config)(nil)
 
+var (
+	errNoDirectory         = errors.New("auto_capture: directory must be specified when rules are configured")
+	errNoTelemetryEndpoint = errors.New("auto_capture: telemetry_endpoint must be specified when rules are configured")
+	errCheckInterval       = errors.New("auto_capture: check_interval must be positive")
+	errMinInterval         = errors.New("auto_capture: min_interval must not be negative")
+	errMaxFiles            = errors.New("auto_capture: max_files must not be negative")
+	errCPUProfileDuration  = errors.New("auto_capture: cpu_profile_duration must be positive when a rule captures a cpu profile")
+
+	ruleNameRegexp = regexp.MustCompile(`^[a-zA-Z0-9_.]+$`)
+)
+
 // Validate checks if the extension configuration is valid
 func (cfg *Config) Validate() error {
 	return nil
 }
+
+// Validate checks if the automatic capture configuration is valid
+func (cfg *AutoCaptureConfig) Validate() error {
+	if len(cfg.Rules) == 0 {
+		return nil
+	}
+	if cfg.Directory == "" {
+		return errNoDirectory
+	}
+	if cfg.TelemetryEndpoint == "" {
+		return errNoTelemetryEndpoint
+	}
+	if cfg.CheckInterval <= 0 {
+		return errCheckInterval
+	}
+	if cfg.MinInterval < 0 {
+		return errMinInterval
+	}
+	if cfg.MaxFiles < 0 {
+		return errMaxFiles
+	}
+
+	names := map[string]bool{}
+	for i, rule := range cfg.Rules {
+		if !ruleNameRegexp.MatchString(rule.Name) {
+			return fmt.Errorf("auto_capture: rules[%d]: name %q must only contain letters, digits, '_' and '.'", i, rule.Name)
+		}
+		if names[rule.Name] {
+			return fmt.Errorf("auto_capture: rules[%d]: duplicate name %q", i, rule.Name)
+		}
+		names[rule.Name] = true
+		if rule.Metric == "" {
+			return fmt.Errorf("auto_capture: rules[%d]: metric must be specified", i)
+		}
+		if len(rule.Profiles) == 0 {
+			return fmt.Errorf("auto_capture: rules[%d]: at least one profile must be specified", i)
+		}
+		for _, profile := range rule.Profiles {
+			if !supportedProfiles[profile] {
+				return fmt.Errorf("auto_capture: rules[%d]: unsupported profile %q", i, profile)
+			}
+			if profile == cpuProfile && cfg.CPUProfileDuration <= 0 {
+				return errCPUProfileDuration
+			}
+		}
+	}
+	return nil
+}
@@ -6,6 +6,7 @@ package pprofextension
 import (
 	"path/filepath"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -20,8 +21,9 @@ func TestLoadConfig(t *testing.T) {
 	t.Parallel()
 
 	tests := []struct {
-		id       component.ID
-		expected component.Config
+		id          component.ID
+		expected    component.Config
+		expectedErr string
 	}{
 		{
 			id:       component.NewID(metadata.Type),
@@ -33,8 +35,51 @@ func TestLoadConfig(t *testing.T) {
 				TCPAddr:              confignet.TCPAddrConfig{Endpoint: "127.0.0.1:1777"},
 				BlockProfileFraction: 3,
 				MutexProfileFraction: 5,
+				AutoCapture:          NewFactory().CreateDefaultConfig().(*Config).AutoCapture,
 			},
 		},
+		{
+			id: component.NewIDWithName(metadata.Type, "autocapture"),
+			expected: &Config{
+				TCPAddr: confignet.TCPAddrConfig{Endpoint: defaultEndpoint},
+				AutoCapture: AutoCaptureConfig{
+					TelemetryEndpoint:  defaultTelemetryEndpoint,
+					Directory:          "/var/lib/otelcol/profiles",
+					CheckInterval:      defaultCheckInterval,
+					MinInterval:        30 * time.Minute,
+					MaxFiles:           20,
+					CPUProfileDuration: defaultCPUProfileDuration,
+					Rules: []CaptureRuleConfig{
+						{
+							Name:      "high_rss",
+							Metric:    "otelcol_process_memory_rss",
+							Threshold: 2e9,
+							Profiles:  []string{"heap", "goroutine"},
+						},
+						{
+							Name:      "queue_saturation",
+							Metric:    "otelcol_exporter_queue_size",
+							RatioTo:   "otelcol_exporter_queue_capacity",
+							Labels:    map[string]string{"exporter": "otlp"},
+							Threshold: 0.9,
+							Profiles:  []string{"cpu", "heap"},
+						},
+					},
+				},
+			},
+		},
+		{
+			id:          component.NewIDWithName(metadata.Type, "nodirectory"),
+			expectedErr: errNoDirectory.Error(),
+		},
+		{
+			id:          component.NewIDWithName(metadata.Type, "invalidprofile"),
+			expectedErr: `auto_capture: rules[0]: unsupported profile "memory"`,
+		},
+		{
+			id:          component.NewIDWithName(metadata.Type, "invalidrulename"),
+			expectedErr: `auto_capture: rules[0]: name "high/rss" must only contain letters, digits, '_' and '.'`,
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.id.String(), func(t *testing.T) {
@@ -46,6 +91,10 @@ func TestLoadConfig(t *testing.T) {
 			require.NoError(t, err)
 			require.NoError(t, sub.Unmarshal(cfg))
 
+			if tt.expectedErr != "" {
+				assert.EqualError(t, component.ValidateConfig(cfg), tt.expectedErr)
+				return
+			}
 			assert.NoError(t, component.ValidateConfig(cfg))
 			assert.Equal(t, tt.expected, cfg)
 		})
@@ -6,6 +6,7 @@ package pprofextension // import "github.com/open-telemetry/opentelemetry-collec
 import (
 	"context"
 	"errors"
+	"time"
 
 	"go.opentelemetry.io/collector/component"
 	"go.opentelemetry.io/collector/config/confignet"
@@ -16,6 +17,12 @@ import (
 
 const (
 	defaultEndpoint = "localhost:1777"
+
+	defaultTelemetryEndpoint  = "http://localhost:8888/metrics"
+	defaultCheckInterval      = 10 * time.Second
+	defaultMinInterval        = 10 * time.Minute
+	defaultMaxFiles           = 10
+	defaultCPUProfileDuration = 30 * time.Second
 )
 
 // NewFactory creates a factory for pprof extension.
@@ -33,6 +40,13 @@ func createDefaultConfig() component.Config {
 		TCPAddr: confignet.TCPAddrConfig{
 			Endpoint: defaultEndpoint,
 		},
+		AutoCapture: AutoCaptureConfig{
+			TelemetryEndpoint:  defaultTelemetryEndpoint,
+			CheckInterval:      defaultCheckInterval,
+			MinInterval:        defaultMinInterval,
+			MaxFiles:           defaultMaxFiles,
+			CPUProfileDuration: defaultCPUProfileDuration,
+		},
 	}
 }
 
@@ -20,6 +20,13 @@ func TestFactory_CreateDefaultConfig(t *testing.T) {
 	cfg := createDefaultConfig()
 	assert.Equal(t, &Config{
 		TCPAddr: confignet.TCPAddrConfig{Endpoint: defaultEndpoint},
+		AutoCapture: AutoCaptureConfig{
+			TelemetryEndpoint:  defaultTelemetryEndpoint,
+			CheckInterval:      defaultCheckInterval,
+			MinInterval:        defaultMinInterval,
+			MaxFiles:           defaultMaxFiles,
+			CPUProfileDuration: defaultCPUProfileDuration,
+		},
 	},
 		cfg)
 
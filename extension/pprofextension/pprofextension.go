@@ -23,6 +23,7 @@ var running = &atomic.Bool{}
 type pprofExtension struct {
 	config            Config
 	file              *os.File
+	autoCapture       *autoCapture
 	server            http.Server
 	stopCh            chan struct{}
 	telemetrySettings component.TelemetrySettings
@@ -78,7 +79,14 @@ func (p *pprofExtension) Start(_ context.Context, _ component.Host) error {
 			return startErr
 		}
 		p.file = f
-		startErr = pprof.StartCPUProfile(f)
+		if startErr = pprof.StartCPUProfile(f); startErr != nil {
+			return startErr
+		}
+	}
+
+	if len(p.config.AutoCapture.Rules) > 0 {
+		p.autoCapture = newAutoCapture(p.config.AutoCapture, p.telemetrySettings.Logger)
+		startErr = p.autoCapture.start()
 	}
 
 	return startErr
@@ -86,6 +94,9 @@ func (p *pprofExtension) Start(_ context.Context, _ component.Host) error {
 
 func (p *pprofExtension) Shutdown(context.Context) error {
 	defer running.Store(false)
+	if p.autoCapture != nil {
+		p.autoCapture.stop()
+	}
 	if p.file != nil {
 		pprof.StopCPUProfile()
 		_ = p.file.Close() // ignore the error
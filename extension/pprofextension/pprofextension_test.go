@@ -8,8 +8,10 @@ import (
 	"net"
 	"net/http"
 	"os"
+	"path/filepath"
 	"runtime"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/require"
 	"go.opentelemetry.io/collector/component"
@@ -141,3 +143,29 @@ func TestPerformanceProfilerLifecycleWithFile(t *testing.T) {
 	require.NoError(t, pprofExt.Start(context.Background(), componenttest.NewNopHost()))
 	require.NoError(t, pprofExt.Shutdown(context.Background()))
 }
+
+func TestPerformanceProfilerAutoCapture(t *testing.T) {
+	dir := filepath.Join(t.TempDir(), "profiles")
+	config := Config{
+		TCPAddr: confignet.TCPAddrConfig{
+			Endpoint: testutil.GetAvailableLocalAddress(t),
+		},
+		AutoCapture: AutoCaptureConfig{
+			TelemetryEndpoint: "http://" + testutil.GetAvailableLocalAddress(t) + "/metrics",
+			Directory:         dir,
+			CheckInterval:     10 * time.Millisecond,
+			Rules: []CaptureRuleConfig{
+				{Name: "high_rss", Metric: "otelcol_process_memory_rss", Profiles: []string{"heap"}},
+			},
+		},
+	}
+	tt, err := componenttest.SetupTelemetry(component.MustNewID("TestPprofExtension"))
+	require.NoError(t, err, "SetupTelemetry should succeed")
+
+	pprofExt := newServer(config, tt.TelemetrySettings())
+	require.NotNil(t, pprofExt)
+
+	require.NoError(t, pprofExt.Start(context.Background(), componenttest.NewNopHost()))
+	require.DirExists(t, dir)
+	require.NoError(t, pprofExt.Shutdown(context.Background()))
+}
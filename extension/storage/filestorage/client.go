@@ -19,6 +19,8 @@ import (
 
 var defaultBucket = []byte(`default`)
 
+var errStorageNotInitialized = errors.New("storage not initialized")
+
 const (
 	TempDbPrefix = "tempdb"
 
@@ -34,6 +36,9 @@ type fileStorageClient struct {
 	compactionMutex sync.RWMutex
 	db              *bbolt.DB
 	compactionCfg   *CompactionConfig
+	window          compactionWindow
+	lastScheduled   time.Time
+	expiryCfg       *ExpiryConfig
 	openTimeout     time.Duration
 	cancel          context.CancelFunc
 	closed          bool
@@ -48,7 +53,12 @@ func bboltOptions(timeout time.Duration, noSync bool) *bbolt.Options {
 	}
 }
 
-func newClient(logger *zap.Logger, filePath string, timeout time.Duration, compactionCfg *CompactionConfig, noSync bool) (*fileStorageClient, error) {
+func newClient(logger *zap.Logger, filePath string, timeout time.Duration, compactionCfg *CompactionConfig, expiryCfg *ExpiryConfig, noSync bool) (*fileStorageClient, error) {
+	window, err := newCompactionWindow(compactionCfg.Schedule.WindowStart, compactionCfg.Schedule.WindowEnd)
+	if err != nil {
+		return nil, err
+	}
+
 	options := bboltOptions(timeout, noSync)
 	db, err := bbolt.Open(filePath, 0600, options)
 	if err != nil {
@@ -56,7 +66,10 @@ func newClient(logger *zap.Logger, filePath string, timeout time.Duration, compa
 	}
 
 	initBucket := func(tx *bbolt.Tx) error {
-		_, err := tx.CreateBucketIfNotExists(defaultBucket)
+		if _, err := tx.CreateBucketIfNotExists(defaultBucket); err != nil {
+			return err
+		}
+		_, err := tx.CreateBucketIfNotExists(expiryBucket)
 		return err
 	}
 	if err := db.Update(initBucket); err != nil {
@@ -64,9 +77,23 @@ func newClient(logger *zap.Logger, filePath string, timeout time.Duration, compa
 		return nil, err
 	}
 
-	client := &fileStorageClient{logger: logger, db: db, compactionCfg: compactionCfg, openTimeout: timeout}
-	if compactionCfg.OnRebound {
-		client.startCompactionLoop(context.Background())
+	client := &fileStorageClient{
+		logger:        logger,
+		db:            db,
+		compactionCfg: compactionCfg,
+		window:        window,
+		expiryCfg:     expiryCfg,
+		openTimeout:   timeout,
+	}
+	if compactionCfg.OnRebound || compactionCfg.OnSchedule || expiryCfg.TTL > 0 {
+		var ctx context.Context
+		ctx, client.cancel = context.WithCancel(context.Background())
+		if compactionCfg.OnRebound || compactionCfg.OnSchedule {
+			client.startCompactionLoop(ctx)
+		}
+		if expiryCfg.TTL > 0 {
+			client.startExpiryLoop(ctx)
+		}
 	}
 
 	return client, nil
@@ -97,15 +124,21 @@ func (c *fileStorageClient) Delete(ctx context.Context, key string) error {
 func (c *fileStorageClient) Batch(_ context.Context, ops ...storage.Operation) error {
 	batch := func(tx *bbolt.Tx) error {
 		bucket := tx.Bucket(defaultBucket)
-		if bucket == nil {
-			return errors.New("storage not initialized")
+		expiry := tx.Bucket(expiryBucket)
+		if bucket == nil || expiry == nil {
+			return errStorageNotInitialized
 		}
 
+		now := time.Now()
 		var err error
 		for _, op := range ops {
 			switch op.Type {
 			case storage.Get:
 				value := bucket.Get([]byte(op.Key))
+				if value != nil && c.expiryCfg.TTL > 0 && expired(expiry.Get([]byte(op.Key)), now) {
+					// the key is deleted by the expiry loop
+					value = nil
+				}
 				if value != nil {
 					// the output of Bucket.Get is only valid within a transaction, so we need to make a copy
 					// to be able to return the value
@@ -116,8 +149,18 @@ func (c *fileStorageClient) Batch(_ context.Context, ops ...storage.Operation) e
 				}
 			case storage.Set:
 				err = bucket.Put([]byte(op.Key), op.Value)
+				if err == nil {
+					if c.expiryCfg.TTL > 0 {
+						err = expiry.Put([]byte(op.Key), encodeExpiration(now.Add(c.expiryCfg.TTL)))
+					} else {
+						err = expiry.Delete([]byte(op.Key))
+					}
+				}
 			case storage.Delete:
 				err = bucket.Delete([]byte(op.Key))
+				if err == nil {
+					err = expiry.Delete([]byte(op.Key))
+				}
 			default:
 				return errors.New("wrong operation type")
 			}
@@ -241,8 +284,6 @@ func (c *fileStorageClient) Compact(compactionDirectory string, timeout time.Dur
 
 // startCompactionLoop provides asynchronous compaction function
 func (c *fileStorageClient) startCompactionLoop(ctx context.Context) {
-	ctx, c.cancel = context.WithCancel(ctx)
-
 	go func() {
 		c.logger.Debug("starting compaction loop",
 			zap.Duration("compaction_check_interval", c.compactionCfg.CheckInterval))
@@ -252,8 +293,8 @@ func (c *fileStorageClient) startCompactionLoop(ctx context.Context) {
 
 		for {
 			select {
-			case <-compactionTicker.C:
-				if c.shouldCompact() {
+			case now := <-compactionTicker.C:
+				if c.shouldCompact() || c.shouldCompactOnSchedule(now) {
 					err := c.Compact(c.compactionCfg.Directory, c.openTimeout, c.compactionCfg.MaxTransactionSize)
 					if err != nil {
 						c.logger.Error("compaction failure",
@@ -297,6 +338,34 @@ func (c *fileStorageClient) shouldCompact() bool {
 	return true
 }
 
+// shouldCompactOnSchedule checks whether the conditions for scheduled compaction are met
+func (c *fileStorageClient) shouldCompactOnSchedule(now time.Time) bool {
+	if !c.compactionCfg.OnSchedule || !c.window.contains(now) {
+		return false
+	}
+	if !c.lastScheduled.IsZero() && now.Sub(c.lastScheduled) < c.compactionCfg.Schedule.Interval {
+		return false
+	}
+
+	totalSizeBytes, dataSizeBytes, err := c.getDbSize()
+	if err != nil {
+		c.logger.Error("failed to get db size", zap.Error(err))
+		return false
+	}
+
+	if totalSizeBytes < c.compactionCfg.Schedule.MinTotalSizeMiB*oneMiB ||
+		totalSizeBytes-dataSizeBytes < c.compactionCfg.Schedule.MinReclaimableMiB*oneMiB {
+		return false
+	}
+
+	c.logger.Debug("shouldCompactOnSchedule returns true",
+		zap.Int64("totalSizeBytes", totalSizeBytes),
+		zap.Int64("dataSizeBytes", dataSizeBytes))
+
+	c.lastScheduled = now
+	return true
+}
+
 func (c *fileStorageClient) getDbSize() (totalSizeResult int64, dataSizeResult int64, errResult error) {
 	var totalSize int64
 
@@ -18,6 +18,8 @@ type Config struct {
 
 	Compaction *CompactionConfig `mapstructure:"compaction,omitempty"`
 
+	Expiry *ExpiryConfig `mapstructure:"expiry,omitempty"`
+
 	// FSync specifies that fsync should be called after each database write
 	FSync bool `mapstructure:"fsync,omitempty"`
 }
@@ -49,6 +51,33 @@ type CompactionConfig struct {
 	// It will remove all the files in the compaction directory starting with tempdb,
 	// temp files will be left if a previous run of the process is killed while compacting.
 	CleanupOnStart bool `mapstructure:"cleanup_on_start,omitempty"`
+	// OnSchedule specifies that compaction is attempted online, at most once per schedule interval,
+	// when the current time is within the schedule window and the size thresholds of the schedule are met.
+	OnSchedule bool `mapstructure:"on_schedule,omitempty"`
+	// Schedule defines when the scheduled compaction is attempted
+	Schedule CompactionScheduleConfig `mapstructure:"schedule,omitempty"`
+}
+
+// CompactionScheduleConfig defines when the scheduled compaction is attempted.
+type CompactionScheduleConfig struct {
+	// Interval specifies the minimum duration between two scheduled compactions
+	Interval time.Duration `mapstructure:"interval,omitempty"`
+	// WindowStart and WindowEnd specify the daily window, in the HH:MM format and local time, during
+	// which the compaction might happen, eg.: off-peak hours. The window spans the whole day when both are empty.
+	WindowStart string `mapstructure:"window_start,omitempty"`
+	WindowEnd   string `mapstructure:"window_end,omitempty"`
+	// MinTotalSizeMiB specifies the minimum total allocated size (both used and empty) to compact
+	MinTotalSizeMiB int64 `mapstructure:"min_total_size_mib,omitempty"`
+	// MinReclaimableMiB specifies the minimum empty allocated size, which the compaction would reclaim, to compact
+	MinReclaimableMiB int64 `mapstructure:"min_reclaimable_mib,omitempty"`
+}
+
+// ExpiryConfig defines configuration for optional expiry of the stored keys.
+type ExpiryConfig struct {
+	// TTL specifies how long a key is kept after it was last set. Zero disables the expiry.
+	TTL time.Duration `mapstructure:"ttl,omitempty"`
+	// CheckInterval specifies frequency of the removal of the expired keys
+	CheckInterval time.Duration `mapstructure:"check_interval,omitempty"`
 }
 
 func (cfg *Config) Validate() error {
@@ -83,5 +112,25 @@ func (cfg *Config) Validate() error {
 		return errors.New("compaction check interval must be positive when rebound compaction is set")
 	}
 
+	if cfg.Compaction.OnSchedule {
+		if cfg.Compaction.CheckInterval <= 0 {
+			return errors.New("compaction check interval must be positive when scheduled compaction is set")
+		}
+		if cfg.Compaction.Schedule.Interval < 0 {
+			return errors.New("compaction schedule interval cannot be negative")
+		}
+		if _, err := newCompactionWindow(cfg.Compaction.Schedule.WindowStart, cfg.Compaction.Schedule.WindowEnd); err != nil {
+			return err
+		}
+	}
+
+	if cfg.Expiry.TTL < 0 {
+		return errors.New("expiry ttl cannot be negative")
+	}
+
+	if cfg.Expiry.TTL > 0 && cfg.Expiry.CheckInterval <= 0 {
+		return errors.New("expiry check interval must be positive when the ttl is set")
+	}
+
 	return nil
 }
@@ -46,6 +46,18 @@ func TestLoadConfig(t *testing.T) {
 					ReboundNeededThresholdMiB:  128,
 					CheckInterval:              time.Second * 5,
 					CleanupOnStart:             true,
+					OnSchedule:                 true,
+					Schedule: CompactionScheduleConfig{
+						Interval:          time.Hour * 12,
+						WindowStart:       "22:00",
+						WindowEnd:         "04:00",
+						MinTotalSizeMiB:   64,
+						MinReclaimableMiB: 32,
+					},
+				},
+				Expiry: &ExpiryConfig{
+					TTL:           time.Hour,
+					CheckInterval: time.Minute * 5,
 				},
 				Timeout: 2 * time.Second,
 				FSync:   true,
@@ -95,3 +107,84 @@ func TestHandleProvidingFilePathAsDirWithAnError(t *testing.T) {
 	require.Error(t, err)
 	require.EqualError(t, err, file.Name()+" is not a directory")
 }
+
+func TestValidateScheduleAndExpiry(t *testing.T) {
+	tests := []struct {
+		name   string
+		modify func(cfg *Config)
+		err    string
+	}{
+		{
+			name: "valid",
+			modify: func(cfg *Config) {
+				cfg.Compaction.OnSchedule = true
+				cfg.Compaction.Schedule.WindowStart = "01:00"
+				cfg.Compaction.Schedule.WindowEnd = "05:30"
+				cfg.Expiry.TTL = time.Hour
+			},
+		},
+		{
+			name: "schedule without check interval",
+			modify: func(cfg *Config) {
+				cfg.Compaction.OnSchedule = true
+				cfg.Compaction.CheckInterval = 0
+			},
+			err: "compaction check interval must be positive when scheduled compaction is set",
+		},
+		{
+			name: "negative schedule interval",
+			modify: func(cfg *Config) {
+				cfg.Compaction.OnSchedule = true
+				cfg.Compaction.Schedule.Interval = -time.Hour
+			},
+			err: "compaction schedule interval cannot be negative",
+		},
+		{
+			name: "half window",
+			modify: func(cfg *Config) {
+				cfg.Compaction.OnSchedule = true
+				cfg.Compaction.Schedule.WindowStart = "01:00"
+			},
+			err: "compaction schedule window requires both window_start and window_end",
+		},
+		{
+			name: "invalid window",
+			modify: func(cfg *Config) {
+				cfg.Compaction.OnSchedule = true
+				cfg.Compaction.Schedule.WindowStart = "1am"
+				cfg.Compaction.Schedule.WindowEnd = "05:00"
+			},
+			err: `invalid compaction schedule window_start "1am"`,
+		},
+		{
+			name: "negative ttl",
+			modify: func(cfg *Config) {
+				cfg.Expiry.TTL = -time.Hour
+			},
+			err: "expiry ttl cannot be negative",
+		},
+		{
+			name: "ttl without check interval",
+			modify: func(cfg *Config) {
+				cfg.Expiry.TTL = time.Hour
+				cfg.Expiry.CheckInterval = 0
+			},
+			err: "expiry check interval must be positive when the ttl is set",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cfg := NewFactory().CreateDefaultConfig().(*Config)
+			cfg.Directory = t.TempDir()
+			cfg.Compaction.Directory = cfg.Directory
+			tt.modify(cfg)
+
+			err := component.ValidateConfig(cfg)
+			if tt.err == "" {
+				assert.NoError(t, err)
+				return
+			}
+			assert.ErrorContains(t, err, tt.err)
+		})
+	}
+}
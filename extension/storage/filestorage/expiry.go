@@ -0,0 +1,94 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package filestorage // import "github.com/open-telemetry/opentelemetry-collector-contrib/extension/storage/filestorage"
+
+import (
+	"context"
+	"encoding/binary"
+	"time"
+
+	"go.etcd.io/bbolt"
+	"go.uber.org/zap"
+)
+
+// expiryBucket maps the keys of the default bucket to their expiration time, in nanoseconds since the epoch.
+var expiryBucket = []byte(`expiry`)
+
+func encodeExpiration(t time.Time) []byte {
+	return binary.BigEndian.AppendUint64(nil, uint64(t.UnixNano()))
+}
+
+// expired returns whether the encoded expiration time is before now.
+func expired(value []byte, now time.Time) bool {
+	if len(value) != 8 {
+		return false
+	}
+	return int64(binary.BigEndian.Uint64(value)) <= now.UnixNano()
+}
+
+// deleteExpired deletes the keys whose expiration time is before now, and returns their number.
+func (c *fileStorageClient) deleteExpired(now time.Time) (int, error) {
+	c.compactionMutex.RLock()
+	defer c.compactionMutex.RUnlock()
+	if c.closed {
+		return 0, nil
+	}
+
+	deleted := 0
+	err := c.db.Update(func(tx *bbolt.Tx) error {
+		bucket := tx.Bucket(defaultBucket)
+		expiry := tx.Bucket(expiryBucket)
+		if bucket == nil || expiry == nil {
+			return errStorageNotInitialized
+		}
+
+		// the keys can't be deleted while iterating over them
+		var keys [][]byte
+		cursor := expiry.Cursor()
+		for k, v := cursor.First(); k != nil; k, v = cursor.Next() {
+			if expired(v, now) {
+				keys = append(keys, append([]byte(nil), k...))
+			}
+		}
+		for _, k := range keys {
+			if err := bucket.Delete(k); err != nil {
+				return err
+			}
+			if err := expiry.Delete(k); err != nil {
+				return err
+			}
+		}
+		deleted = len(keys)
+		return nil
+	})
+	return deleted, err
+}
+
+// startExpiryLoop periodically deletes the expired keys
+func (c *fileStorageClient) startExpiryLoop(ctx context.Context) {
+	go func() {
+		c.logger.Debug("starting expiry loop",
+			zap.Duration("expiry_check_interval", c.expiryCfg.CheckInterval))
+
+		expiryTicker := time.NewTicker(c.expiryCfg.CheckInterval)
+		defer expiryTicker.Stop()
+
+		for {
+			select {
+			case <-expiryTicker.C:
+				deleted, err := c.deleteExpired(time.Now())
+				if err != nil {
+					c.logger.Error("failed to delete expired keys", zap.Error(err))
+					continue
+				}
+				if deleted > 0 {
+					c.logger.Debug("deleted expired keys", zap.Int("count", deleted))
+				}
+			case <-ctx.Done():
+				c.logger.Debug("shutting down expiry loop")
+				return
+			}
+		}
+	}()
+}
@@ -22,6 +22,8 @@ const (
 	defaultReboundTriggerThresholdMib = 10
 	defaultReboundNeededThresholdMib  = 100
 	defaultCompactionInterval         = time.Second * 5
+	defaultCompactionScheduleInterval = time.Hour * 24
+	defaultExpiryCheckInterval        = time.Minute
 )
 
 // NewFactory creates a factory for HostObserver extension.
@@ -46,6 +48,13 @@ func createDefaultConfig() component.Config {
 			ReboundTriggerThresholdMiB: defaultReboundTriggerThresholdMib,
 			CheckInterval:              defaultCompactionInterval,
 			CleanupOnStart:             false,
+			OnSchedule:                 false,
+			Schedule: CompactionScheduleConfig{
+				Interval: defaultCompactionScheduleInterval,
+			},
+		},
+		Expiry: &ExpiryConfig{
+			CheckInterval: defaultExpiryCheckInterval,
 		},
 		Timeout: time.Second,
 		FSync:   false,
@@ -43,6 +43,7 @@ func TestFactory(t *testing.T) {
 				return &Config{
 					Directory:  t.TempDir(),
 					Compaction: &CompactionConfig{},
+					Expiry:     &ExpiryConfig{},
 				}
 			}(),
 		},
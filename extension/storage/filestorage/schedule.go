@@ -0,0 +1,51 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package filestorage // import "github.com/open-telemetry/opentelemetry-collector-contrib/extension/storage/filestorage"
+
+import (
+	"fmt"
+	"time"
+)
+
+const windowLayout = "15:04"
+
+// compactionWindow is a daily time window, which wraps around midnight when it ends before it starts.
+type compactionWindow struct {
+	start time.Duration
+	end   time.Duration
+}
+
+func newCompactionWindow(start, end string) (compactionWindow, error) {
+	if start == "" && end == "" {
+		return compactionWindow{}, nil
+	}
+	if start == "" || end == "" {
+		return compactionWindow{}, fmt.Errorf("compaction schedule window requires both window_start and window_end")
+	}
+	startTime, err := time.Parse(windowLayout, start)
+	if err != nil {
+		return compactionWindow{}, fmt.Errorf("invalid compaction schedule window_start %q, must be in the HH:MM format: %w", start, err)
+	}
+	endTime, err := time.Parse(windowLayout, end)
+	if err != nil {
+		return compactionWindow{}, fmt.Errorf("invalid compaction schedule window_end %q, must be in the HH:MM format: %w", end, err)
+	}
+	return compactionWindow{start: sinceMidnight(startTime), end: sinceMidnight(endTime)}, nil
+}
+
+// contains returns whether the time of the day of t is within the window.
+func (w compactionWindow) contains(t time.Time) bool {
+	if w.start == w.end {
+		return true
+	}
+	now := sinceMidnight(t)
+	if w.start < w.end {
+		return now >= w.start && now < w.end
+	}
+	return now >= w.start || now < w.end
+}
+
+func sinceMidnight(t time.Time) time.Duration {
+	return time.Duration(t.Hour())*time.Hour + time.Duration(t.Minute())*time.Minute + time.Duration(t.Second())*time.Second
+}
@@ -0,0 +1,73 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package filestorage
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCompactionWindow(t *testing.T) {
+	at := func(hour, minute int) time.Time {
+		return time.Date(2024, 6, 1, hour, minute, 0, 0, time.Local)
+	}
+
+	tests := []struct {
+		name     string
+		start    string
+		end      string
+		inside   []time.Time
+		outside  []time.Time
+		hasError bool
+	}{
+		{
+			name:   "whole day",
+			inside: []time.Time{at(0, 0), at(12, 0), at(23, 59)},
+		},
+		{
+			name:    "same day",
+			start:   "01:00",
+			end:     "05:30",
+			inside:  []time.Time{at(1, 0), at(3, 0), at(5, 29)},
+			outside: []time.Time{at(0, 59), at(5, 30), at(12, 0)},
+		},
+		{
+			name:    "wraps around midnight",
+			start:   "22:00",
+			end:     "04:00",
+			inside:  []time.Time{at(22, 0), at(23, 59), at(0, 0), at(3, 59)},
+			outside: []time.Time{at(4, 0), at(12, 0), at(21, 59)},
+		},
+		{
+			name:     "missing end",
+			start:    "22:00",
+			hasError: true,
+		},
+		{
+			name:     "invalid end",
+			start:    "22:00",
+			end:      "25:00",
+			hasError: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			window, err := newCompactionWindow(tt.start, tt.end)
+			if tt.hasError {
+				assert.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			for _, now := range tt.inside {
+				assert.True(t, window.contains(now), now.String())
+			}
+			for _, now := range tt.outside {
+				assert.False(t, window.contains(now), now.String())
+			}
+		})
+	}
+}
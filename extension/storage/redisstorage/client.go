@@ -0,0 +1,66 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package redisstorage // import "github.com/open-telemetry/opentelemetry-collector-contrib/extension/storage/redisstorage"
+
+import (
+	"context"
+	"time"
+
+	"go.opentelemetry.io/collector/extension/experimental/storage"
+)
+
+// redisStorageClient stores the items of a component in Redis, under the keys "<prefix>{<component>}:<key>".
+// The hash tag of the component puts all its keys in the same hash slot of a Redis Cluster, for the operations
+// of a batch to be executed in a single transaction.
+type redisStorageClient struct {
+	client     kvClient
+	keyPrefix  string
+	expiration time.Duration
+}
+
+var _ storage.Client = (*redisStorageClient)(nil)
+
+func newClient(client kvClient, prefix string, name string, expiration time.Duration) *redisStorageClient {
+	keyPrefix := "{" + name + "}:"
+	if prefix != "" {
+		keyPrefix = prefix + ":" + keyPrefix
+	}
+	return &redisStorageClient{client: client, keyPrefix: keyPrefix, expiration: expiration}
+}
+
+// Get will retrieve data from storage that corresponds to the specified key
+func (c *redisStorageClient) Get(ctx context.Context, key string) ([]byte, error) {
+	op := storage.GetOperation(key)
+	if err := c.Batch(ctx, op); err != nil {
+		return nil, err
+	}
+	return op.Value, nil
+}
+
+// Set will store data. The data can be retrieved using the same key
+func (c *redisStorageClient) Set(ctx context.Context, key string, value []byte) error {
+	return c.Batch(ctx, storage.SetOperation(key, value))
+}
+
+// Delete will delete data associated with the specified key
+func (c *redisStorageClient) Delete(ctx context.Context, key string) error {
+	return c.Batch(ctx, storage.DeleteOperation(key))
+}
+
+// Batch executes the specified operations in order, in a single transaction. Get operation results are updated in place
+func (c *redisStorageClient) Batch(ctx context.Context, ops ...storage.Operation) error {
+	if len(ops) == 0 {
+		return nil
+	}
+	cmds := make([]command, len(ops))
+	for i, op := range ops {
+		cmds[i] = command{op: op, key: c.keyPrefix + op.Key}
+	}
+	return c.client.exec(ctx, cmds, c.expiration)
+}
+
+// Close doesn't do anything, the connection to Redis is shared by the clients and closed by the extension
+func (c *redisStorageClient) Close(context.Context) error {
+	return nil
+}
@@ -0,0 +1,63 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package redisstorage // import "github.com/open-telemetry/opentelemetry-collector-contrib/extension/storage/redisstorage"
+
+import (
+	"errors"
+	"strings"
+	"time"
+
+	"go.opentelemetry.io/collector/component"
+	"go.opentelemetry.io/collector/config/configopaque"
+	"go.opentelemetry.io/collector/config/configtls"
+)
+
+// Config defines configuration for the Redis storage extension.
+type Config struct {
+	// Endpoint is the address of the Redis server, or of a node of the Redis Cluster (default localhost:6379).
+	Endpoint string `mapstructure:"endpoint"`
+
+	// Cluster connects to a Redis Cluster, whose nodes are discovered from the endpoint.
+	Cluster bool `mapstructure:"cluster"`
+
+	// Username is the optional username of the Redis ACL user.
+	Username string `mapstructure:"username"`
+
+	// Password is the optional password of the Redis server, or of the ACL user.
+	Password configopaque.String `mapstructure:"password"`
+
+	// DB is the Redis database number, selected after connecting (default 0). Redis Cluster only has the database 0.
+	DB int `mapstructure:"db"`
+
+	TLS configtls.ClientConfig `mapstructure:"tls,omitempty"`
+
+	// Prefix is prepended to the keys, so that several collectors can share the same Redis (default otelcol).
+	Prefix string `mapstructure:"prefix"`
+
+	// Expiration is the time-to-live of the keys, from when they were last set, 0 for the keys to never expire.
+	Expiration time.Duration `mapstructure:"expiration"`
+}
+
+var _ component.Config = (*Config)(nil)
+
+// Validate checks if the extension configuration is valid.
+func (cfg *Config) Validate() error {
+	if cfg.Endpoint == "" {
+		return errors.New("endpoint must be specified")
+	}
+	if cfg.Cluster && cfg.DB != 0 {
+		return errors.New("db must be 0 when connecting to a Redis Cluster")
+	}
+	if cfg.DB < 0 {
+		return errors.New("db must not be negative")
+	}
+	// the braces would change the hash slot of the keys
+	if strings.ContainsAny(cfg.Prefix, "{}") {
+		return errors.New("prefix must not contain braces")
+	}
+	if cfg.Expiration < 0 {
+		return errors.New("expiration must not be negative")
+	}
+	return nil
+}
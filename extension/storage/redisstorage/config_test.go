@@ -0,0 +1,87 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package redisstorage
+
+import (
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/collector/component"
+	"go.opentelemetry.io/collector/config/configtls"
+	"go.opentelemetry.io/collector/confmap/confmaptest"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/extension/storage/redisstorage/internal/metadata"
+)
+
+func TestLoadConfig(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		id          component.ID
+		expected    component.Config
+		expectedErr string
+	}{
+		{
+			id:       component.NewID(metadata.Type),
+			expected: createDefaultConfig(),
+		},
+		{
+			id: component.NewIDWithName(metadata.Type, "all_settings"),
+			expected: &Config{
+				Endpoint: "redis-cluster:7000",
+				Cluster:  true,
+				Username: "collector",
+				Password: "secret",
+				TLS: configtls.ClientConfig{
+					Config: configtls.Config{
+						CAFile: "ca.pem",
+					},
+				},
+				Prefix:     "otel-agent",
+				Expiration: 24 * time.Hour,
+			},
+		},
+		{
+			id:          component.NewIDWithName(metadata.Type, "missing_endpoint"),
+			expectedErr: "endpoint must be specified",
+		},
+		{
+			id:          component.NewIDWithName(metadata.Type, "cluster_db"),
+			expectedErr: "db must be 0 when connecting to a Redis Cluster",
+		},
+		{
+			id:          component.NewIDWithName(metadata.Type, "negative_db"),
+			expectedErr: "db must not be negative",
+		},
+		{
+			id:          component.NewIDWithName(metadata.Type, "prefix_braces"),
+			expectedErr: "prefix must not contain braces",
+		},
+		{
+			id:          component.NewIDWithName(metadata.Type, "negative_expiration"),
+			expectedErr: "expiration must not be negative",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.id.String(), func(t *testing.T) {
+			cm, err := confmaptest.LoadConf(filepath.Join("testdata", "config.yaml"))
+			require.NoError(t, err)
+			factory := NewFactory()
+			cfg := factory.CreateDefaultConfig()
+			sub, err := cm.Sub(tt.id.String())
+			require.NoError(t, err)
+			require.NoError(t, sub.Unmarshal(cfg))
+
+			if tt.expectedErr != "" {
+				assert.EqualError(t, component.ValidateConfig(cfg), tt.expectedErr)
+				return
+			}
+			assert.NoError(t, component.ValidateConfig(cfg))
+			assert.Equal(t, tt.expected, cfg)
+		})
+	}
+}
@@ -0,0 +1,97 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package redisstorage // import "github.com/open-telemetry/opentelemetry-collector-contrib/extension/storage/redisstorage"
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/redis/go-redis/v9"
+	"go.opentelemetry.io/collector/component"
+	"go.opentelemetry.io/collector/extension/experimental/storage"
+	"go.uber.org/zap"
+)
+
+type redisStorage struct {
+	cfg    *Config
+	logger *zap.Logger
+
+	newClient func(options *redis.UniversalOptions, cluster bool) kvClient
+	client    kvClient
+}
+
+// Ensure this storage extension implements the appropriate interface
+var _ storage.Extension = (*redisStorage)(nil)
+
+func newRedisStorage(logger *zap.Logger, cfg *Config) *redisStorage {
+	return &redisStorage{
+		cfg:       cfg,
+		logger:    logger,
+		newClient: newRedisClient,
+	}
+}
+
+// Start connects to Redis
+func (rs *redisStorage) Start(ctx context.Context, _ component.Host) error {
+	opts := &redis.UniversalOptions{
+		Addrs:    []string{rs.cfg.Endpoint},
+		Username: rs.cfg.Username,
+		Password: string(rs.cfg.Password),
+		DB:       rs.cfg.DB,
+	}
+	var err error
+	if opts.TLSConfig, err = rs.cfg.TLS.LoadTLSConfig(ctx); err != nil {
+		return fmt.Errorf("failed to load TLS config: %w", err)
+	}
+	rs.client = rs.newClient(opts, rs.cfg.Cluster)
+
+	if err = rs.client.ping(ctx); err != nil {
+		return errors.Join(fmt.Errorf("failed to connect to Redis at %s: %w", rs.cfg.Endpoint, err), rs.client.close())
+	}
+	rs.logger.Debug("connected to Redis", zap.String("endpoint", rs.cfg.Endpoint), zap.Bool("cluster", rs.cfg.Cluster))
+	return nil
+}
+
+// Shutdown closes the connection to Redis
+func (rs *redisStorage) Shutdown(context.Context) error {
+	if rs.client == nil {
+		return nil
+	}
+	return rs.client.close()
+}
+
+// GetClient returns a storage client for an individual component
+func (rs *redisStorage) GetClient(_ context.Context, kind component.Kind, ent component.ID, name string) (storage.Client, error) {
+	if rs.client == nil {
+		return nil, errors.New("the Redis storage extension isn't started")
+	}
+	var fullName string
+	if name == "" {
+		fullName = fmt.Sprintf("%s_%s_%s", kindString(kind), ent.Type(), ent.Name())
+	} else {
+		fullName = fmt.Sprintf("%s_%s_%s_%s", kindString(kind), ent.Type(), ent.Name(), name)
+	}
+	// the braces would end the hash tag of the keys of the component
+	fullName = strings.NewReplacer("{", "_", "}", "_").Replace(fullName)
+	return newClient(rs.client, rs.cfg.Prefix, fullName, rs.cfg.Expiration), nil
+}
+
+func kindString(k component.Kind) string {
+	switch k {
+	case component.KindReceiver:
+		return "receiver"
+	case component.KindProcessor:
+		return "processor"
+	case component.KindExporter:
+		return "exporter"
+	case component.KindExtension:
+		return "extension"
+	case component.KindConnector:
+		return "connector"
+	default:
+		return "other" // not expected
+	}
+}
@@ -0,0 +1,213 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package redisstorage
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/redis/go-redis/v9"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/collector/component"
+	"go.opentelemetry.io/collector/component/componenttest"
+	"go.opentelemetry.io/collector/extension/experimental/storage"
+	"go.uber.org/zap"
+)
+
+// fakeClient is an in-memory kvClient.
+type fakeClient struct {
+	mu          sync.Mutex
+	options     *redis.UniversalOptions
+	cluster     bool
+	items       map[string][]byte
+	expirations map[string]time.Duration
+	err         error
+	closed      bool
+}
+
+func newFakeClient() *fakeClient {
+	return &fakeClient{items: map[string][]byte{}, expirations: map[string]time.Duration{}}
+}
+
+func (c *fakeClient) ping(context.Context) error {
+	return c.err
+}
+
+func (c *fakeClient) exec(_ context.Context, cmds []command, expiration time.Duration) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if c.err != nil {
+		return c.err
+	}
+	for _, cmd := range cmds {
+		switch cmd.op.Type {
+		case storage.Get:
+			cmd.op.Value = c.items[cmd.key]
+		case storage.Set:
+			c.items[cmd.key] = cmd.op.Value
+			c.expirations[cmd.key] = expiration
+		case storage.Delete:
+			delete(c.items, cmd.key)
+			delete(c.expirations, cmd.key)
+		default:
+			return errors.New("wrong operation type")
+		}
+	}
+	return nil
+}
+
+func (c *fakeClient) close() error {
+	c.closed = true
+	return nil
+}
+
+func newTestExtension(t *testing.T, cfg *Config) (*redisStorage, *fakeClient) {
+	client := newFakeClient()
+	rs := newRedisStorage(zap.NewNop(), cfg)
+	rs.newClient = func(options *redis.UniversalOptions, cluster bool) kvClient {
+		client.options = options
+		client.cluster = cluster
+		return client
+	}
+	require.NoError(t, rs.Start(context.Background(), componenttest.NewNopHost()))
+	t.Cleanup(func() {
+		assert.NoError(t, rs.Shutdown(context.Background()))
+		assert.True(t, client.closed)
+	})
+	return rs, client
+}
+
+func TestStart(t *testing.T) {
+	cfg := createDefaultConfig().(*Config)
+	cfg.Endpoint = "redis-cluster:7000"
+	cfg.Cluster = true
+	cfg.Username = "collector"
+	cfg.Password = "secret"
+
+	_, client := newTestExtension(t, cfg)
+	assert.Equal(t, []string{"redis-cluster:7000"}, client.options.Addrs)
+	assert.Equal(t, "collector", client.options.Username)
+	assert.Equal(t, "secret", client.options.Password)
+	assert.Nil(t, client.options.TLSConfig)
+	assert.True(t, client.cluster)
+}
+
+func TestStartFailsWhenRedisIsUnreachable(t *testing.T) {
+	client := newFakeClient()
+	client.err = errors.New("connection refused")
+	rs := newRedisStorage(zap.NewNop(), createDefaultConfig().(*Config))
+	rs.newClient = func(*redis.UniversalOptions, bool) kvClient {
+		return client
+	}
+
+	err := rs.Start(context.Background(), componenttest.NewNopHost())
+	assert.ErrorContains(t, err, "failed to connect to Redis at localhost:6379: connection refused")
+	assert.True(t, client.closed)
+}
+
+func TestGetClientBeforeStart(t *testing.T) {
+	rs := newRedisStorage(zap.NewNop(), createDefaultConfig().(*Config))
+	_, err := rs.GetClient(context.Background(), component.KindReceiver, component.MustNewID("filelog"), "")
+	assert.Error(t, err)
+}
+
+func TestClientKeys(t *testing.T) {
+	rs, client := newTestExtension(t, createDefaultConfig().(*Config))
+	ctx := context.Background()
+
+	tests := []struct {
+		kind     component.Kind
+		id       component.ID
+		name     string
+		expected string
+	}{
+		{
+			kind:     component.KindReceiver,
+			id:       component.MustNewIDWithName("filelog", "logs"),
+			expected: "otelcol:{receiver_filelog_logs}:key",
+		},
+		{
+			kind:     component.KindExporter,
+			id:       component.MustNewID("otlp"),
+			name:     "traces",
+			expected: "otelcol:{exporter_otlp__traces}:key",
+		},
+		{
+			kind:     component.KindExtension,
+			id:       component.MustNewIDWithName("ext", "{tenant}"),
+			expected: "otelcol:{extension_ext__tenant_}:key",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.expected, func(t *testing.T) {
+			c, err := rs.GetClient(ctx, tt.kind, tt.id, tt.name)
+			require.NoError(t, err)
+			require.NoError(t, c.Set(ctx, "key", []byte("value")))
+			assert.Equal(t, []byte("value"), client.items[tt.expected])
+			require.NoError(t, c.Close(ctx))
+		})
+	}
+}
+
+func TestClientPrefixAndExpiration(t *testing.T) {
+	cfg := createDefaultConfig().(*Config)
+	cfg.Prefix = ""
+	cfg.Expiration = time.Hour
+	rs, client := newTestExtension(t, cfg)
+	ctx := context.Background()
+
+	c, err := rs.GetClient(ctx, component.KindReceiver, component.MustNewID("filelog"), "")
+	require.NoError(t, err)
+	require.NoError(t, c.Set(ctx, "key", []byte("value")))
+	assert.Equal(t, []byte("value"), client.items["{receiver_filelog_}:key"])
+	assert.Equal(t, time.Hour, client.expirations["{receiver_filelog_}:key"])
+}
+
+func TestClientOperations(t *testing.T) {
+	rs, client := newTestExtension(t, createDefaultConfig().(*Config))
+	ctx := context.Background()
+
+	c, err := rs.GetClient(ctx, component.KindReceiver, component.MustNewID("filelog"), "")
+	require.NoError(t, err)
+
+	value, err := c.Get(ctx, "key")
+	require.NoError(t, err)
+	assert.Nil(t, value)
+
+	require.NoError(t, c.Set(ctx, "key", []byte("value")))
+	value, err = c.Get(ctx, "key")
+	require.NoError(t, err)
+	assert.Equal(t, []byte("value"), value)
+
+	require.NoError(t, c.Delete(ctx, "key"))
+	value, err = c.Get(ctx, "key")
+	require.NoError(t, err)
+	assert.Nil(t, value)
+
+	// the operations of a batch are executed in order
+	get1 := storage.GetOperation("key")
+	get2 := storage.GetOperation("key")
+	get3 := storage.GetOperation("key")
+	require.NoError(t, c.Batch(ctx,
+		get1,
+		storage.SetOperation("key", []byte("batch")),
+		get2,
+		storage.DeleteOperation("key"),
+		get3,
+	))
+	assert.Nil(t, get1.Value)
+	assert.Equal(t, []byte("batch"), get2.Value)
+	assert.Nil(t, get3.Value)
+
+	require.NoError(t, c.Batch(ctx))
+
+	client.err = errors.New("connection reset")
+	assert.EqualError(t, c.Set(ctx, "key", []byte("value")), "connection reset")
+	_, err = c.Get(ctx, "key")
+	assert.EqualError(t, err, "connection reset")
+}
@@ -0,0 +1,33 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package redisstorage
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/collector/component/componenttest"
+	"go.opentelemetry.io/collector/config/configtls"
+	"go.opentelemetry.io/collector/extension/extensiontest"
+)
+
+func TestFactory(t *testing.T) {
+	f := NewFactory()
+
+	cfg := f.CreateDefaultConfig().(*Config)
+	assert.Equal(t, &Config{
+		Endpoint: "localhost:6379",
+		TLS:      configtls.ClientConfig{Insecure: true},
+		Prefix:   "otelcol",
+	}, cfg)
+	assert.NoError(t, componenttest.CheckConfigStruct(cfg))
+
+	e, err := f.CreateExtension(context.Background(), extensiontest.NewNopCreateSettings(), cfg)
+	require.NoError(t, err)
+	require.NotNil(t, e)
+	// the extension doesn't connect to Redis before it is started
+	require.NoError(t, e.Shutdown(context.Background()))
+}
@@ -0,0 +1,90 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package redisstorage // import "github.com/open-telemetry/opentelemetry-collector-contrib/extension/storage/redisstorage"
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/redis/go-redis/v9"
+	"go.opentelemetry.io/collector/extension/experimental/storage"
+)
+
+// command is a storage operation on the full key of the item in Redis.
+type command struct {
+	op  storage.Operation
+	key string
+}
+
+// Interface for the Redis commands used by the extension, for the go-redis client to be replaced in the tests.
+type kvClient interface {
+	ping(ctx context.Context) error
+	// exec executes the commands in order in a MULTI/EXEC transaction, updating the values of the get operations
+	// in place. The set commands expire the keys after the expiration, if positive.
+	exec(ctx context.Context, cmds []command, expiration time.Duration) error
+	close() error
+}
+
+// Wraps a real Redis or Redis Cluster client, implements the `kvClient` interface.
+type redisClient struct {
+	client redis.UniversalClient
+}
+
+var _ kvClient = (*redisClient)(nil)
+
+// Creates a new real Redis client, or Redis Cluster client, from the passed-in redis.UniversalOptions.
+func newRedisClient(options *redis.UniversalOptions, cluster bool) kvClient {
+	if cluster {
+		return &redisClient{client: redis.NewClusterClient(options.Cluster())}
+	}
+	return &redisClient{client: redis.NewClient(options.Simple())}
+}
+
+func (c *redisClient) ping(ctx context.Context) error {
+	return c.client.Ping(ctx).Err()
+}
+
+func (c *redisClient) exec(ctx context.Context, cmds []command, expiration time.Duration) error {
+	gets := make([]*redis.StringCmd, len(cmds))
+	_, err := c.client.TxPipelined(ctx, func(pipe redis.Pipeliner) error {
+		for i, cmd := range cmds {
+			switch cmd.op.Type {
+			case storage.Get:
+				gets[i] = pipe.Get(ctx, cmd.key)
+			case storage.Set:
+				pipe.Set(ctx, cmd.key, cmd.op.Value, expiration)
+			case storage.Delete:
+				pipe.Del(ctx, cmd.key)
+			default:
+				return errors.New("wrong operation type")
+			}
+		}
+		return nil
+	})
+	// redis.Nil is returned when a get operation didn't find its key
+	if err != nil && !errors.Is(err, redis.Nil) {
+		return err
+	}
+
+	for i, get := range gets {
+		if get == nil {
+			continue
+		}
+		value, err := get.Bytes()
+		switch {
+		case errors.Is(err, redis.Nil):
+			cmds[i].op.Value = nil
+		case err != nil:
+			return err
+		default:
+			cmds[i].op.Value = value
+		}
+	}
+	return nil
+}
+
+func (c *redisClient) close() error {
+	return c.client.Close()
+}
@@ -0,0 +1,44 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package storagelockextension // import "github.com/open-telemetry/opentelemetry-collector-contrib/extension/storagelockextension"
+
+import (
+	"errors"
+	"time"
+
+	"go.opentelemetry.io/collector/component"
+)
+
+// Config defines the configuration of the storage lock extension.
+type Config struct {
+	// Storage is the ID of the storage extension holding the locks, which must be shared by all the collectors, e.g. a
+	// db_storage extension connected to the same database.
+	Storage component.ID `mapstructure:"storage"`
+	// Identity is the identity of the collector in the locks, which must be unique among the collectors. It defaults to
+	// the hostname, which is the name of the pod in Kubernetes.
+	Identity string `mapstructure:"identity"`
+	// TTL is the duration a lock is held after it's acquired or renewed. When the holder stops renewing it, the other
+	// collectors can acquire it once it expired.
+	TTL time.Duration `mapstructure:"ttl"`
+	// SettleDelay is the duration waited after writing a lock before reading it back to check that it wasn't taken by
+	// another collector concurrently. It must be greater than the longest write of the storage.
+	SettleDelay time.Duration `mapstructure:"settle_delay"`
+}
+
+// Validate checks if the extension configuration is valid
+func (cfg *Config) Validate() error {
+	if cfg.Storage.Type().String() == "" {
+		return errors.New("storage must be specified")
+	}
+	if cfg.TTL <= 0 {
+		return errors.New("ttl must be positive")
+	}
+	if cfg.SettleDelay <= 0 {
+		return errors.New("settle_delay must be positive")
+	}
+	if cfg.TTL <= cfg.SettleDelay {
+		return errors.New("ttl must be greater than settle_delay")
+	}
+	return nil
+}
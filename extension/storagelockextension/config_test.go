@@ -0,0 +1,79 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package storagelockextension
+
+import (
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/collector/component"
+	"go.opentelemetry.io/collector/confmap/confmaptest"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/extension/storagelockextension/internal/metadata"
+)
+
+func TestLoadConfig(t *testing.T) {
+	t.Parallel()
+
+	dbStorage := component.MustNewType("db_storage")
+	tests := []struct {
+		id          component.ID
+		expected    component.Config
+		expectedErr string
+	}{
+		{
+			id: component.NewID(metadata.Type),
+			expected: &Config{
+				Storage:     component.NewID(dbStorage),
+				TTL:         30 * time.Second,
+				SettleDelay: 500 * time.Millisecond,
+			},
+		},
+		{
+			id: component.NewIDWithName(metadata.Type, "custom"),
+			expected: &Config{
+				Storage:     component.NewIDWithName(dbStorage, "locks"),
+				Identity:    "collector-1",
+				TTL:         time.Minute,
+				SettleDelay: 2 * time.Second,
+			},
+		},
+		{
+			id:          component.NewIDWithName(metadata.Type, "invalid-no-storage"),
+			expectedErr: "storage must be specified",
+		},
+		{
+			id:          component.NewIDWithName(metadata.Type, "invalid-ttl"),
+			expectedErr: "ttl must be positive",
+		},
+		{
+			id:          component.NewIDWithName(metadata.Type, "invalid-settle-delay"),
+			expectedErr: "settle_delay must be positive",
+		},
+		{
+			id:          component.NewIDWithName(metadata.Type, "invalid-ttl-below-settle-delay"),
+			expectedErr: "ttl must be greater than settle_delay",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.id.String(), func(t *testing.T) {
+			cm, err := confmaptest.LoadConf(filepath.Join("testdata", "config.yaml"))
+			require.NoError(t, err)
+			factory := NewFactory()
+			cfg := factory.CreateDefaultConfig()
+			sub, err := cm.Sub(tt.id.String())
+			require.NoError(t, err)
+			require.NoError(t, sub.Unmarshal(cfg))
+			if tt.expectedErr != "" {
+				assert.EqualError(t, component.ValidateConfig(cfg), tt.expectedErr)
+				return
+			}
+			assert.NoError(t, component.ValidateConfig(cfg))
+			assert.Equal(t, tt.expected, cfg)
+		})
+	}
+}
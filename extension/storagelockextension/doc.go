@@ -0,0 +1,10 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+// Package storagelockextension implements an extension providing distributed locks held in a storage extension shared
+// by the collectors of a cluster, allowing the components which must run on a single collector to deduplicate their
+// work without leader election.
+
+//go:generate mdatagen metadata.yaml
+
+package storagelockextension // import "github.com/open-telemetry/opentelemetry-collector-contrib/extension/storagelockextension"
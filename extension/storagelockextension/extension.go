@@ -0,0 +1,214 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package storagelockextension // import "github.com/open-telemetry/opentelemetry-collector-contrib/extension/storagelockextension"
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"sync"
+	"time"
+
+	"go.opentelemetry.io/collector/component"
+	"go.opentelemetry.io/collector/extension"
+	"go.opentelemetry.io/collector/extension/experimental/storage"
+	"go.uber.org/zap"
+)
+
+// Locker is the interface of the extension, used by the cluster-singleton components to only do their work, e.g. a
+// scrape, on a single collector at a time.
+type Locker interface {
+	extension.Extension
+	// TryLock tries to acquire the named lock, or to renew it when the collector already holds it, returning whether the
+	// collector holds it until the ttl elapses. It doesn't wait for the lock to be released by another collector: the
+	// components are expected to call it on each of their cycles, skipping the cycle when it returns false.
+	TryLock(ctx context.Context, name string) (bool, error)
+	// Unlock releases the named lock if the collector holds it, so that another collector can acquire it right away.
+	Unlock(ctx context.Context, name string) error
+}
+
+var _ Locker = (*storageLockExtension)(nil)
+
+// lockRecord is the value of a lock in the storage.
+type lockRecord struct {
+	Holder  string    `json:"holder"`
+	Expires time.Time `json:"expires"`
+}
+
+type storageLockExtension struct {
+	config *Config
+	id     component.ID
+	logger *zap.Logger
+	now    func() time.Time
+	sleep  func(ctx context.Context, d time.Duration) error
+
+	identity string
+	client   storage.Client
+
+	// lock serializes the operations on the locks, held is the set of the locks held by the collector
+	lock sync.Mutex
+	held map[string]bool
+}
+
+func newStorageLockExtension(cfg *Config, set extension.CreateSettings) *storageLockExtension {
+	return &storageLockExtension{
+		config: cfg,
+		id:     set.ID,
+		logger: set.Logger,
+		now:    time.Now,
+		sleep:  sleep,
+		held:   map[string]bool{},
+	}
+}
+
+func (e *storageLockExtension) Start(ctx context.Context, host component.Host) error {
+	identity := e.config.Identity
+	if identity == "" {
+		var err error
+		if identity, err = os.Hostname(); err != nil {
+			return fmt.Errorf("failed to get the hostname as identity: %w", err)
+		}
+	}
+	e.identity = identity
+
+	ext, ok := host.GetExtensions()[e.config.Storage]
+	if !ok {
+		return fmt.Errorf("storage extension '%s' not found", e.config.Storage)
+	}
+	storageExtension, ok := ext.(storage.Extension)
+	if !ok {
+		return fmt.Errorf("non-storage extension '%s' found", e.config.Storage)
+	}
+	// the client is named after the lock extension, so that the collectors sharing its configuration share the locks
+	client, err := storageExtension.GetClient(ctx, component.KindExtension, e.id, "")
+	if err != nil {
+		return fmt.Errorf("failed to get the storage client: %w", err)
+	}
+	e.client = client
+	return nil
+}
+
+func (e *storageLockExtension) Shutdown(ctx context.Context) error {
+	if e.client == nil {
+		return nil
+	}
+	e.lock.Lock()
+	defer e.lock.Unlock()
+	var errs error
+	for name := range e.held {
+		errs = errors.Join(errs, e.unlock(ctx, name))
+	}
+	return errors.Join(errs, e.client.Close(ctx))
+}
+
+// TryLock implements the mutual exclusion algorithm of Fischer on top of the storage, which doesn't provide atomic
+// compare-and-set operations: the lock is written when it's free, then read back after the settle delay, the last
+// collector to write it holding it. The expiry of the locks relies on the clocks of the collectors being synchronized
+// well within the ttl.
+func (e *storageLockExtension) TryLock(ctx context.Context, name string) (bool, error) {
+	if e.client == nil {
+		return false, errors.New("the storage lock extension isn't started")
+	}
+	e.lock.Lock()
+	defer e.lock.Unlock()
+
+	acquired, err := e.tryLock(ctx, name)
+	if err != nil {
+		return false, err
+	}
+	switch {
+	case acquired && !e.held[name]:
+		e.logger.Info("Acquired the lock", zap.String("lock", name), zap.String("identity", e.identity))
+		e.held[name] = true
+	case !acquired && e.held[name]:
+		e.logger.Info("Lost the lock", zap.String("lock", name), zap.String("identity", e.identity))
+		delete(e.held, name)
+	}
+	return acquired, nil
+}
+
+func (e *storageLockExtension) tryLock(ctx context.Context, name string) (bool, error) {
+	record, err := e.get(ctx, name)
+	if err != nil {
+		return false, err
+	}
+	if record != nil && record.Holder != e.identity && e.now().Before(record.Expires) {
+		return false, nil
+	}
+
+	value, err := json.Marshal(lockRecord{Holder: e.identity, Expires: e.now().Add(e.config.TTL)})
+	if err != nil {
+		return false, err
+	}
+	if err = e.client.Set(ctx, key(name), value); err != nil {
+		return false, fmt.Errorf("failed to write the lock %q: %w", name, err)
+	}
+
+	// the collectors which read the lock as free before it was written may overwrite it until the settle delay elapses
+	if err = e.sleep(ctx, e.config.SettleDelay); err != nil {
+		return false, err
+	}
+	if record, err = e.get(ctx, name); err != nil {
+		return false, err
+	}
+	return record != nil && record.Holder == e.identity, nil
+}
+
+func (e *storageLockExtension) Unlock(ctx context.Context, name string) error {
+	if e.client == nil {
+		return errors.New("the storage lock extension isn't started")
+	}
+	e.lock.Lock()
+	defer e.lock.Unlock()
+	return e.unlock(ctx, name)
+}
+
+func (e *storageLockExtension) unlock(ctx context.Context, name string) error {
+	delete(e.held, name)
+	record, err := e.get(ctx, name)
+	if err != nil {
+		return err
+	}
+	if record == nil || record.Holder != e.identity {
+		return nil
+	}
+	if err = e.client.Delete(ctx, key(name)); err != nil {
+		return fmt.Errorf("failed to release the lock %q: %w", name, err)
+	}
+	e.logger.Info("Released the lock", zap.String("lock", name), zap.String("identity", e.identity))
+	return nil
+}
+
+// get reads the named lock, returning nil if it's not set.
+func (e *storageLockExtension) get(ctx context.Context, name string) (*lockRecord, error) {
+	value, err := e.client.Get(ctx, key(name))
+	if err != nil {
+		return nil, fmt.Errorf("failed to read the lock %q: %w", name, err)
+	}
+	if value == nil {
+		return nil, nil
+	}
+	record := &lockRecord{}
+	if err = json.Unmarshal(value, record); err != nil {
+		return nil, fmt.Errorf("failed to decode the lock %q: %w", name, err)
+	}
+	return record, nil
+}
+
+func key(name string) string {
+	return "lock/" + name
+}
+
+func sleep(ctx context.Context, d time.Duration) error {
+	timer := time.NewTimer(d)
+	defer timer.Stop()
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-timer.C:
+		return nil
+	}
+}
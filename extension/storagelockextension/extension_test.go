@@ -0,0 +1,184 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package storagelockextension
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/collector/component"
+	"go.opentelemetry.io/collector/extension/experimental/storage"
+	"go.opentelemetry.io/collector/extension/extensiontest"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/extension/storage/storagetest"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/extension/storagelockextension/internal/metadata"
+)
+
+var sharedStorageID = storagetest.NewStorageID("shared")
+
+func TestTryLock(t *testing.T) {
+	host, _ := newSharedStorageHost()
+	first := newTestExtension(t, host, "collector-1", time.Now)
+	second := newTestExtension(t, host, "collector-2", time.Now)
+
+	assertTryLock(t, first, "scrape", true)
+	assertTryLock(t, second, "scrape", false)
+	// the holder renews the lock on its next cycle
+	assertTryLock(t, first, "scrape", true)
+	assertTryLock(t, second, "scrape", false)
+	// the locks are independent
+	assertTryLock(t, second, "other", true)
+
+	// only the holder releases a lock
+	require.NoError(t, second.Unlock(context.Background(), "scrape"))
+	assertTryLock(t, second, "scrape", false)
+	require.NoError(t, first.Unlock(context.Background(), "scrape"))
+	assertTryLock(t, second, "scrape", true)
+	assertTryLock(t, first, "scrape", false)
+
+	require.NoError(t, first.Shutdown(context.Background()))
+	require.NoError(t, second.Shutdown(context.Background()))
+}
+
+func TestTryLockExpired(t *testing.T) {
+	host, _ := newSharedStorageHost()
+	now := time.Date(2024, 6, 1, 0, 0, 0, 0, time.UTC)
+	clock := func() time.Time { return now }
+	first := newTestExtension(t, host, "collector-1", clock)
+	second := newTestExtension(t, host, "collector-2", clock)
+
+	assertTryLock(t, first, "scrape", true)
+	now = now.Add(29 * time.Second)
+	assertTryLock(t, second, "scrape", false)
+
+	// the holder stopped renewing the lock, which another collector acquires once it expired
+	now = now.Add(time.Second)
+	assertTryLock(t, second, "scrape", true)
+	assertTryLock(t, first, "scrape", false)
+	assert.Empty(t, first.held)
+	assert.Equal(t, map[string]bool{"scrape": true}, second.held)
+
+	require.NoError(t, first.Shutdown(context.Background()))
+	require.NoError(t, second.Shutdown(context.Background()))
+}
+
+func TestTryLockConcurrentWrite(t *testing.T) {
+	host, client := newSharedStorageHost()
+	ext := newTestExtension(t, host, "collector-1", time.Now)
+
+	// another collector, which read the lock as free as well, writes it during the settle delay
+	ext.sleep = func(context.Context, time.Duration) error {
+		value, err := json.Marshal(lockRecord{Holder: "collector-2", Expires: time.Now().Add(time.Minute)})
+		require.NoError(t, err)
+		return client.Set(context.Background(), key("scrape"), value)
+	}
+	assertTryLock(t, ext, "scrape", false)
+	assert.Empty(t, ext.held)
+
+	require.NoError(t, ext.Shutdown(context.Background()))
+}
+
+func TestShutdownReleasesLocks(t *testing.T) {
+	host, _ := newSharedStorageHost()
+	first := newTestExtension(t, host, "collector-1", time.Now)
+	second := newTestExtension(t, host, "collector-2", time.Now)
+
+	assertTryLock(t, first, "scrape", true)
+	assertTryLock(t, first, "other", true)
+	require.NoError(t, first.Shutdown(context.Background()))
+
+	assertTryLock(t, second, "scrape", true)
+	assertTryLock(t, second, "other", true)
+	require.NoError(t, second.Shutdown(context.Background()))
+}
+
+func TestStartErrors(t *testing.T) {
+	tests := []struct {
+		name        string
+		host        component.Host
+		expectedErr string
+	}{
+		{
+			name:        "missing_storage",
+			host:        storagetest.NewStorageHost(),
+			expectedErr: "storage extension 'test_storage/shared' not found",
+		},
+		{
+			name: "non_storage",
+			host: storagetest.NewStorageHost().
+				WithExtension(sharedStorageID, storagetest.NewNonStorageExtension("shared")),
+			expectedErr: "non-storage extension 'test_storage/shared' found",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ext := newStorageLockExtension(testConfig("collector-1"), extensiontest.NewNopCreateSettings())
+			assert.EqualError(t, ext.Start(context.Background(), tt.host), tt.expectedErr)
+			assert.NoError(t, ext.Shutdown(context.Background()))
+		})
+	}
+}
+
+func TestNotStarted(t *testing.T) {
+	ext := newStorageLockExtension(testConfig("collector-1"), extensiontest.NewNopCreateSettings())
+	_, err := ext.TryLock(context.Background(), "scrape")
+	assert.EqualError(t, err, "the storage lock extension isn't started")
+	assert.EqualError(t, ext.Unlock(context.Background(), "scrape"), "the storage lock extension isn't started")
+}
+
+func testConfig(identity string) *Config {
+	cfg := createDefaultConfig().(*Config)
+	cfg.Storage = sharedStorageID
+	cfg.Identity = identity
+	return cfg
+}
+
+func newTestExtension(t *testing.T, host component.Host, identity string, now func() time.Time) *storageLockExtension {
+	cfg := testConfig(identity)
+	require.NoError(t, cfg.Validate())
+	set := extensiontest.NewNopCreateSettings()
+	set.ID = component.NewID(metadata.Type)
+	ext := newStorageLockExtension(cfg, set)
+	ext.now = now
+	ext.sleep = func(context.Context, time.Duration) error { return nil }
+	require.NoError(t, ext.Start(context.Background(), host))
+	return ext
+}
+
+func assertTryLock(t *testing.T, ext *storageLockExtension, name string, expected bool) {
+	acquired, err := ext.TryLock(context.Background(), name)
+	require.NoError(t, err)
+	assert.Equal(t, expected, acquired, "%s locking %q", ext.identity, name)
+}
+
+// newSharedStorageHost returns a host whose storage extension returns the same client to all the lock extensions, as
+// a storage shared by several collectors does.
+func newSharedStorageHost() (component.Host, storage.Client) {
+	client := storagetest.NewInMemoryClient(component.KindExtension, component.NewID(metadata.Type), "")
+	ext := &sharedStorage{client: client}
+	return storagetest.NewStorageHost().WithExtension(sharedStorageID, ext), client
+}
+
+type sharedStorage struct {
+	component.StartFunc
+	component.ShutdownFunc
+	client storage.Client
+}
+
+func (s *sharedStorage) GetClient(context.Context, component.Kind, component.ID, string) (storage.Client, error) {
+	return sharedClient{Client: s.client}, nil
+}
+
+// sharedClient doesn't close the shared client when a lock extension shuts down.
+type sharedClient struct {
+	storage.Client
+}
+
+func (sharedClient) Close(context.Context) error {
+	return nil
+}
@@ -0,0 +1,44 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package storagelockextension // import "github.com/open-telemetry/opentelemetry-collector-contrib/extension/storagelockextension"
+
+import (
+	"context"
+	"time"
+
+	"go.opentelemetry.io/collector/component"
+	"go.opentelemetry.io/collector/extension"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/extension/storagelockextension/internal/metadata"
+)
+
+const (
+	defaultTTL         = 30 * time.Second
+	defaultSettleDelay = 500 * time.Millisecond
+)
+
+// NewFactory creates a factory for the storage lock extension.
+func NewFactory() extension.Factory {
+	return extension.NewFactory(
+		metadata.Type,
+		createDefaultConfig,
+		createExtension,
+		metadata.ExtensionStability,
+	)
+}
+
+func createDefaultConfig() component.Config {
+	return &Config{
+		TTL:         defaultTTL,
+		SettleDelay: defaultSettleDelay,
+	}
+}
+
+func createExtension(
+	_ context.Context,
+	set extension.CreateSettings,
+	cfg component.Config,
+) (extension.Extension, error) {
+	return newStorageLockExtension(cfg.(*Config), set), nil
+}
@@ -8,6 +8,7 @@ type TaskMetadata struct {
 	AvailabilityZone string              `json:"AvailabilityZone,omitempty"`
 	Cluster          string              `json:"Cluster,omitempty"`
 	Containers       []ContainerMetadata `json:"Containers,omitempty"`
+	DesiredStatus    string              `json:"DesiredStatus,omitempty"`
 	Family           string              `json:"Family,omitempty"`
 	KnownStatus      string              `json:"KnownStatus,omitempty"`
 	LaunchType       string              `json:"LaunchType,omitempty"`
@@ -25,9 +26,11 @@ type ContainerMetadata struct {
 	ContainerName string            `json:"Name,omitempty"`
 	CreatedAt     string            `json:"CreatedAt,omitempty"`
 	DockerID      string            `json:"DockerId,omitempty"`
+	DesiredStatus string            `json:"DesiredStatus,omitempty"`
 	DockerName    string            `json:"DockerName,omitempty"`
 	ExitCode      *int64            `json:"ExitCode,omitempty"`
 	FinishedAt    string            `json:"FinishedAt,omitempty"`
+	Health        *ContainerHealth  `json:"Health,omitempty"`
 	Image         string            `json:"Image,omitempty"`
 	ImageID       string            `json:"ImageID,omitempty"`
 	KnownStatus   string            `json:"KnownStatus,omitempty"`
@@ -40,6 +43,12 @@ type ContainerMetadata struct {
 	Type          string            `json:"Type,omitempty"`
 }
 
+// ContainerHealth defines the health of a container, reported when a health check is defined
+type ContainerHealth struct {
+	Status      string `json:"status,omitempty"`
+	StatusSince string `json:"statusSince,omitempty"`
+}
+
 // Limits defines the Cpu and Memory limits
 type Limits struct {
 	CPU    *float64 `json:"CPU,omitempty"`
@@ -0,0 +1,24 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+// Package firehose receives the records delivered by Amazon Data Firehose streams to an HTTP endpoint, and converts
+// them to telemetry, e.g. the metrics of CloudWatch Metric Streams.
+package firehose // import "github.com/open-telemetry/opentelemetry-collector-contrib/internal/aws/firehose"
+
+import (
+	"go.opentelemetry.io/collector/config/confighttp"
+	"go.opentelemetry.io/collector/config/configopaque"
+)
+
+// Config is the configuration of the endpoint receiving the requests of a Firehose delivery stream.
+type Config struct {
+	// ServerConfig is used to set up the Firehose delivery
+	// endpoint. The Firehose delivery stream expects an HTTPS
+	// endpoint, so TLSSettings must be used to enable that.
+	confighttp.ServerConfig
+	// RecordType is the key used to determine which unmarshaler to use
+	// when receiving the requests.
+	RecordType string
+	// AccessKey is checked against the one received with each request.
+	AccessKey configopaque.String
+}
@@ -1,16 +1,20 @@
 // Copyright The OpenTelemetry Authors
 // SPDX-License-Identifier: Apache-2.0
 
-package awsfirehosereceiver // import "github.com/open-telemetry/opentelemetry-collector-contrib/receiver/awsfirehosereceiver"
+package firehose // import "github.com/open-telemetry/opentelemetry-collector-contrib/internal/aws/firehose"
 
 import (
 	"context"
+	"errors"
 	"net/http"
 
 	"go.opentelemetry.io/collector/consumer"
 	"go.opentelemetry.io/collector/receiver"
+	"go.uber.org/zap"
 
-	"github.com/open-telemetry/opentelemetry-collector-contrib/receiver/awsfirehosereceiver/internal/unmarshaler"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/internal/aws/firehose/unmarshaler"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/internal/aws/firehose/unmarshaler/cwmetricstream"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/internal/aws/firehose/unmarshaler/otlpmetricstream"
 )
 
 // The metricsConsumer implements the firehoseConsumer
@@ -26,9 +30,23 @@ type metricsConsumer struct {
 
 var _ firehoseConsumer = (*metricsConsumer)(nil)
 
-// newMetricsReceiver creates a new instance of the receiver
+// ErrUnrecognizedRecordType is returned for the record types without unmarshaler.
+var ErrUnrecognizedRecordType = errors.New("unrecognized record type")
+
+// DefaultMetricsUnmarshalers creates a map of the available metrics
+// unmarshalers, by record type.
+func DefaultMetricsUnmarshalers(logger *zap.Logger) map[string]unmarshaler.MetricsUnmarshaler {
+	cwmsu := cwmetricstream.NewUnmarshaler(logger)
+	otlpv1msu := otlpmetricstream.NewUnmarshaler(logger)
+	return map[string]unmarshaler.MetricsUnmarshaler{
+		cwmsu.Type():     cwmsu,
+		otlpv1msu.Type(): otlpv1msu,
+	}
+}
+
+// NewMetricsReceiver creates a new instance of the receiver
 // with a metricsConsumer.
-func newMetricsReceiver(
+func NewMetricsReceiver(
 	config *Config,
 	set receiver.CreateSettings,
 	unmarshalers map[string]unmarshaler.MetricsUnmarshaler,
@@ -37,7 +55,7 @@ func newMetricsReceiver(
 
 	configuredUnmarshaler := unmarshalers[config.RecordType]
 	if configuredUnmarshaler == nil {
-		return nil, errUnrecognizedRecordType
+		return nil, ErrUnrecognizedRecordType
 	}
 
 	mc := &metricsConsumer{
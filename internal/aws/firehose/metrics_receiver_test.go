@@ -1,7 +1,7 @@
 // Copyright The OpenTelemetry Authors
 // SPDX-License-Identifier: Apache-2.0
 
-package awsfirehosereceiver
+package firehose
 
 import (
 	"context"
@@ -16,7 +16,7 @@ import (
 	"go.opentelemetry.io/collector/receiver/receivertest"
 	"go.uber.org/zap"
 
-	"github.com/open-telemetry/opentelemetry-collector-contrib/receiver/awsfirehosereceiver/internal/unmarshaler/unmarshalertest"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/internal/aws/firehose/unmarshaler/unmarshalertest"
 )
 
 type recordConsumer struct {
@@ -43,17 +43,16 @@ func TestNewMetricsReceiver(t *testing.T) {
 		"WithInvalidRecordType": {
 			consumer:   consumertest.NewNop(),
 			recordType: "test",
-			wantErr:    errUnrecognizedRecordType,
+			wantErr:    ErrUnrecognizedRecordType,
 		},
 	}
 	for name, testCase := range testCases {
 		t.Run(name, func(t *testing.T) {
-			cfg := createDefaultConfig().(*Config)
-			cfg.RecordType = testCase.recordType
-			got, err := newMetricsReceiver(
+			cfg := &Config{RecordType: testCase.recordType}
+			got, err := NewMetricsReceiver(
 				cfg,
 				receivertest.NewNopCreateSettings(),
-				defaultMetricsUnmarshalers(zap.NewNop()),
+				DefaultMetricsUnmarshalers(zap.NewNop()),
 				testCase.consumer,
 			)
 			require.Equal(t, testCase.wantErr, err)
@@ -1,7 +1,7 @@
 // Copyright The OpenTelemetry Authors
 // SPDX-License-Identifier: Apache-2.0
 
-package awsfirehosereceiver // import "github.com/open-telemetry/opentelemetry-collector-contrib/receiver/awsfirehosereceiver"
+package firehose // import "github.com/open-telemetry/opentelemetry-collector-contrib/internal/aws/firehose"
 
 import (
 	"context"
@@ -1,7 +1,7 @@
 // Copyright The OpenTelemetry Authors
 // SPDX-License-Identifier: Apache-2.0
 
-package awsfirehosereceiver
+package firehose
 
 import (
 	"bytes"
@@ -21,6 +21,8 @@ import (
 	"go.opentelemetry.io/collector/component/componenttest"
 	"go.opentelemetry.io/collector/config/confighttp"
 	"go.opentelemetry.io/collector/receiver/receivertest"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/internal/aws/firehose/unmarshaler/cwmetricstream"
 )
 
 const (
@@ -58,7 +60,7 @@ func TestStart(t *testing.T) {
 	for name, testCase := range testCases {
 		t.Run(name, func(t *testing.T) {
 			cfg := &Config{
-				RecordType: defaultRecordType,
+				RecordType: cwmetricstream.TypeStr,
 			}
 			ctx := context.TODO()
 			r := testFirehoseReceiver(cfg, nil)
@@ -76,7 +78,7 @@ func TestStart(t *testing.T) {
 			require.NoError(t, listener.Close())
 		})
 		cfg := &Config{
-			RecordType: defaultRecordType,
+			RecordType: cwmetricstream.TypeStr,
 			ServerConfig: confighttp.ServerConfig{
 				Endpoint: listener.Addr().String(),
 			},
@@ -95,7 +97,7 @@ func TestFirehoseRequest(t *testing.T) {
 	defaultConsumer := newNopFirehoseConsumer(http.StatusOK, nil)
 	firehoseConsumerErr := errors.New("firehose consumer error")
 	cfg := &Config{
-		RecordType: defaultRecordType,
+		RecordType: cwmetricstream.TypeStr,
 		AccessKey:  testFirehoseAccessKey,
 	}
 	var noRecords []firehoseRecord
@@ -142,7 +144,7 @@ func TestFirehoseRequest(t *testing.T) {
 		"WithInvalidBody": {
 			body:           "{ test: ",
 			wantStatusCode: http.StatusBadRequest,
-			wantErr:        errors.New("json: cannot unmarshal string into Go value of type awsfirehosereceiver.firehoseRequest"),
+			wantErr:        errors.New("json: cannot unmarshal string into Go value of type firehose.firehoseRequest"),
 		},
 		"WithNoRecords": {
 			body:           testFirehoseRequest(testFirehoseRequestID, noRecords),
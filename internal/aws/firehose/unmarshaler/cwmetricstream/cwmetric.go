@@ -1,7 +1,7 @@
 // Copyright The OpenTelemetry Authors
 // SPDX-License-Identifier: Apache-2.0
 
-package cwmetricstream // import "github.com/open-telemetry/opentelemetry-collector-contrib/receiver/awsfirehosereceiver/internal/unmarshaler/cwmetricstream"
+package cwmetricstream // import "github.com/open-telemetry/opentelemetry-collector-contrib/internal/aws/firehose/unmarshaler/cwmetricstream"
 
 // The cWMetric is the format for the CloudWatch metric stream records.
 //
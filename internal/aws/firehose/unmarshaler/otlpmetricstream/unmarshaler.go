@@ -0,0 +1,84 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package otlpmetricstream // import "github.com/open-telemetry/opentelemetry-collector-contrib/internal/aws/firehose/unmarshaler/otlpmetricstream"
+
+import (
+	"encoding/binary"
+	"errors"
+
+	"go.opentelemetry.io/collector/pdata/pmetric"
+	"go.opentelemetry.io/collector/pdata/pmetric/pmetricotlp"
+	"go.uber.org/zap"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/internal/aws/firehose/unmarshaler"
+)
+
+const (
+	// Supported version depends on version of go.opentelemetry.io/collector/pdata/pmetric/pmetricotlp dependency
+	TypeStr = "otlp_v1"
+)
+
+var (
+	errInvalidRecords = errors.New("record format invalid")
+)
+
+// Unmarshaler for the CloudWatch Metric Stream OpenTelemetry record format.
+//
+// More details can be found at:
+// https://docs.aws.amazon.com/AmazonCloudWatch/latest/monitoring/CloudWatch-metric-streams-formats-opentelemetry-100.html
+type Unmarshaler struct {
+	logger *zap.Logger
+}
+
+var _ unmarshaler.MetricsUnmarshaler = (*Unmarshaler)(nil)
+
+// NewUnmarshaler creates a new instance of the Unmarshaler.
+func NewUnmarshaler(logger *zap.Logger) *Unmarshaler {
+	return &Unmarshaler{logger}
+}
+
+// Unmarshal deserializes the records into pmetric.Metrics. Each record holds
+// ExportMetricsServiceRequest messages, each prefixed by its size as a varint.
+// Skips the invalid messages received in the records.
+func (u Unmarshaler) Unmarshal(records [][]byte) (pmetric.Metrics, error) {
+	md := pmetric.NewMetrics()
+	for recordIndex, record := range records {
+		dataLen, pos := len(record), 0
+		for pos < dataLen {
+			n, nLen := binary.Uvarint(record[pos:])
+			if nLen <= 0 || uint64(dataLen-pos-nLen) < n {
+				u.logger.Error(
+					"Invalid message size",
+					zap.Int("record_index", recordIndex),
+					zap.Int("position", pos),
+				)
+				break
+			}
+			pos += nLen
+			req := pmetricotlp.NewExportRequest()
+			err := req.UnmarshalProto(record[pos : pos+int(n)])
+			pos += int(n)
+			if err != nil {
+				u.logger.Error(
+					"Unable to unmarshal input",
+					zap.Error(err),
+					zap.Int("record_index", recordIndex),
+				)
+				continue
+			}
+			req.Metrics().ResourceMetrics().MoveAndAppendTo(md.ResourceMetrics())
+		}
+	}
+
+	if md.ResourceMetrics().Len() == 0 {
+		return pmetric.NewMetrics(), errInvalidRecords
+	}
+
+	return md, nil
+}
+
+// Type of the serialized messages.
+func (u Unmarshaler) Type() string {
+	return TypeStr
+}
@@ -0,0 +1,100 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package otlpmetricstream
+
+import (
+	"encoding/binary"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/collector/pdata/pcommon"
+	"go.opentelemetry.io/collector/pdata/pmetric"
+	"go.opentelemetry.io/collector/pdata/pmetric/pmetricotlp"
+	"go.uber.org/zap"
+)
+
+func TestType(t *testing.T) {
+	unmarshaler := NewUnmarshaler(zap.NewNop())
+	require.Equal(t, TypeStr, unmarshaler.Type())
+}
+
+// createMessage creates a size delimited ExportMetricsServiceRequest with a single
+// summary metric, as found in the records of CloudWatch metric streams.
+func createMessage(t *testing.T, metricName string) []byte {
+	md := pmetric.NewMetrics()
+	rm := md.ResourceMetrics().AppendEmpty()
+	rm.Resource().Attributes().PutStr("cloud.provider", "aws")
+	rm.Resource().Attributes().PutStr("aws.exporter.arn", "arn:aws:cloudwatch:us-east-1:123456789:metric-stream/MyMetricStream")
+	metric := rm.ScopeMetrics().AppendEmpty().Metrics().AppendEmpty()
+	metric.SetName(metricName)
+	metric.SetUnit("{Percent}")
+	dp := metric.SetEmptySummary().DataPoints().AppendEmpty()
+	dp.SetTimestamp(pcommon.NewTimestampFromTime(time.Now()))
+	dp.SetCount(1)
+	dp.SetSum(12.5)
+
+	data, err := pmetricotlp.NewExportRequestFromMetrics(md).MarshalProto()
+	require.NoError(t, err)
+	return append(binary.AppendUvarint(nil, uint64(len(data))), data...)
+}
+
+func TestUnmarshal(t *testing.T) {
+	unmarshaler := NewUnmarshaler(zap.NewNop())
+	cpu := createMessage(t, "amazonaws.com/AWS/EC2/CPUUtilization")
+	network := createMessage(t, "amazonaws.com/AWS/EC2/NetworkIn")
+
+	testCases := map[string]struct {
+		records           [][]byte
+		wantResourceCount int
+		wantMetricNames   []string
+		wantErr           error
+	}{
+		"WithSingleRecord": {
+			records:           [][]byte{cpu},
+			wantResourceCount: 1,
+			wantMetricNames:   []string{"amazonaws.com/AWS/EC2/CPUUtilization"},
+		},
+		"WithMultipleMessagesInRecord": {
+			records:           [][]byte{append(append([]byte{}, cpu...), network...)},
+			wantResourceCount: 2,
+			wantMetricNames:   []string{"amazonaws.com/AWS/EC2/CPUUtilization", "amazonaws.com/AWS/EC2/NetworkIn"},
+		},
+		"WithMultipleRecords": {
+			records:           [][]byte{cpu, network},
+			wantResourceCount: 2,
+			wantMetricNames:   []string{"amazonaws.com/AWS/EC2/CPUUtilization", "amazonaws.com/AWS/EC2/NetworkIn"},
+		},
+		"WithSomeInvalidRecords": {
+			records:           [][]byte{cpu, {0x05, 0xff, 0xff, 0xff, 0xff, 0xff}, network[:len(network)-1]},
+			wantResourceCount: 1,
+			wantMetricNames:   []string{"amazonaws.com/AWS/EC2/CPUUtilization"},
+		},
+		"WithInvalidRecords": {
+			records: [][]byte{[]byte("invalid")},
+			wantErr: errInvalidRecords,
+		},
+	}
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			got, err := unmarshaler.Unmarshal(testCase.records)
+			if testCase.wantErr != nil {
+				require.Equal(t, testCase.wantErr, err)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, testCase.wantResourceCount, got.ResourceMetrics().Len())
+			var gotMetricNames []string
+			for i := 0; i < got.ResourceMetrics().Len(); i++ {
+				rm := got.ResourceMetrics().At(i)
+				require.Equal(t, "aws", rm.Resource().Attributes().AsRaw()["cloud.provider"])
+				metrics := rm.ScopeMetrics().At(0).Metrics()
+				for j := 0; j < metrics.Len(); j++ {
+					gotMetricNames = append(gotMetricNames, metrics.At(j).Name())
+				}
+			}
+			require.Equal(t, testCase.wantMetricNames, gotMetricNames)
+		})
+	}
+}
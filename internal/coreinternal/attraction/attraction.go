@@ -17,7 +17,7 @@ import (
 // Settings specifies the processor settings.
 type Settings struct {
 	// Actions specifies the list of attributes to act on.
-	// The set of actions are {INSERT, UPDATE, UPSERT, DELETE, HASH, EXTRACT, CONVERT}.
+	// The set of actions are {INSERT, UPDATE, UPSERT, DELETE, HASH, EXTRACT, CONVERT, FLATTEN}.
 	// This is a required field.
 	Actions []ActionKeyValue `mapstructure:"actions"`
 }
@@ -25,6 +25,8 @@ type Settings struct {
 // ActionKeyValue specifies the attribute key to act upon.
 type ActionKeyValue struct {
 	// Key specifies the attribute to act upon.
+	// For the action DELETE, it may be a path to a nested value of a map or
+	// slice attribute, e.g. attributes["http.request.header"]["x-tenant"].
 	// This is a required field.
 	Key string `mapstructure:"key"`
 
@@ -44,6 +46,8 @@ type ActionKeyValue struct {
 
 	// FromAttribute specifies the attribute to use to populate
 	// the value. If the attribute doesn't exist, no action is performed.
+	// It may be a path to a nested value of a map or slice attribute,
+	// e.g. attributes["http.request.header"]["x-tenant"] or attributes["hosts"][0].
 	FromAttribute string `mapstructure:"from_attribute"`
 
 	// FromContext specifies the context value to use to populate
@@ -81,6 +85,8 @@ type ActionKeyValue struct {
 	//           'key' to target keys specified in the 'rule'. If a target key
 	//           already exists, it will be overridden.
 	// CONVERT  - converts the type of an existing attribute, if convertable
+	// FLATTEN - Replaces an existing map or slice attribute with an attribute
+	//           for each of its nested values, the keys being joined with dots.
 	// This is a required field.
 	Action Action `mapstructure:"action"`
 }
@@ -135,6 +141,11 @@ const (
 
 	// CONVERT converts the type of an existing attribute, if convertable
 	CONVERT Action = "convert"
+
+	// FLATTEN replaces an existing map or slice attribute with an attribute for
+	// each of its nested values, the keys being joined with dots, e.g. "http.request.method"
+	// for {"http": {"request": {"method": "GET"}}} or "hosts.0" for {"hosts": ["a"]}.
+	FLATTEN Action = "flatten"
 )
 
 type attributeAction struct {
@@ -146,6 +157,9 @@ type attributeAction struct {
 	Regex *regexp.Regexp
 	// Attribute names extracted from the regexp's subexpressions.
 	AttrNames []string
+	// Paths parsed from Key and FromAttribute, if they are paths to nested values
+	KeyPath  []pathSegment
+	FromPath []pathSegment
 	// Number of non empty strings in above array
 
 	// TODO https://go.opentelemetry.io/collector/issues/296
@@ -188,6 +202,17 @@ func NewAttrProc(settings *Settings) (*AttrProc, error) {
 			Action: a.Action,
 		}
 
+		if isPath(a.Key) {
+			if a.Action != DELETE {
+				return nil, fmt.Errorf("error creating AttrProc. Action \"%s\" does not support paths in the \"key\" field at the %d-th actions", a.Action, i)
+			}
+			path, err := parsePath(a.Key)
+			if err != nil {
+				return nil, fmt.Errorf("error creating AttrProc due to invalid path %q in field \"key\" at the %d-th actions: %v", a.Key, i, err)
+			}
+			action.KeyPath = path
+		}
+
 		valueSourceCount := a.valueSourceCount()
 
 		switch a.Action {
@@ -217,6 +242,13 @@ func NewAttrProc(settings *Settings) (*AttrProc, error) {
 				action.FromAttribute = a.FromAttribute
 				action.FromContext = a.FromContext
 			}
+			if isPath(a.FromAttribute) {
+				path, err := parsePath(a.FromAttribute)
+				if err != nil {
+					return nil, fmt.Errorf("error creating AttrProc due to invalid path %q in field \"from_attribute\" at the %d-th actions: %v", a.FromAttribute, i, err)
+				}
+				action.FromPath = path
+			}
 		case HASH, DELETE:
 			if a.Value != nil || a.FromAttribute != "" {
 				return nil, fmt.Errorf("error creating AttrProc. Action \"%s\" does not use \"value\" or \"from_attribute\" field. These must not be specified for %d-th action", a.Action, i)
@@ -272,6 +304,10 @@ func NewAttrProc(settings *Settings) (*AttrProc, error) {
 				return nil, fmt.Errorf("error creating AttrProc due to invalid value \"%s\" in field \"converted_type\" for action \"%s\" at the %d-th action", a.ConvertedType, a.Action, i)
 			}
 			action.ConvertedType = a.ConvertedType
+		case FLATTEN:
+			if valueSourceCount > 0 || a.RegexPattern != "" || a.ConvertedType != "" {
+				return nil, fmt.Errorf("error creating AttrProc. Action \"%s\" does not use value sources, \"pattern\" or \"converted_type\" fields. These must not be specified for %d-th action", a.Action, i)
+			}
 		default:
 			return nil, fmt.Errorf("error creating AttrProc due to unsupported action %q at the %d-th actions", a.Action, i)
 		}
@@ -290,7 +326,11 @@ func (ap *AttrProc) Process(ctx context.Context, logger *zap.Logger, attrs pcomm
 		// and could impact performance.
 		switch action.Action {
 		case DELETE:
-			attrs.Remove(action.Key)
+			if action.KeyPath != nil {
+				deletePath(attrs, action.KeyPath)
+			} else {
+				attrs.Remove(action.Key)
+			}
 
 			for _, k := range getMatchingKeys(action.Regex, attrs) {
 				attrs.Remove(k)
@@ -335,6 +375,8 @@ func (ap *AttrProc) Process(ctx context.Context, logger *zap.Logger, attrs pcomm
 			extractAttributes(action, attrs)
 		case CONVERT:
 			convertAttribute(logger, action, attrs)
+		case FLATTEN:
+			flattenAttribute(action.Key, attrs)
 		}
 	}
 }
@@ -391,6 +433,10 @@ func getSourceAttributeValue(ctx context.Context, action attributeAction, attrs
 		return getAttributeValueFromContext(ctx, action.FromContext)
 	}
 
+	if action.FromPath != nil {
+		return getPathValue(attrs, action.FromPath)
+	}
+
 	return attrs.Get(action.FromAttribute)
 }
 
@@ -691,6 +691,163 @@ func TestAttributes_HashValue(t *testing.T) {
 	}
 }
 
+func TestAttributes_FromAttributePath(t *testing.T) {
+	testCases := []testCase{
+		{
+			name: "ExtractNestedValues",
+			inputAttributes: map[string]any{
+				"http.request.header": map[string]any{
+					"x-tenant": "acme",
+				},
+				"hosts": []any{"a", "b"},
+			},
+			expectedAttributes: map[string]any{
+				"http.request.header": map[string]any{
+					"x-tenant": "acme",
+				},
+				"hosts":  []any{"a", "b"},
+				"tenant": "acme",
+				"host":   "b",
+			},
+		},
+		// Ensure no attribute is inserted when the path doesn't exist.
+		{
+			name: "PathNoExist",
+			inputAttributes: map[string]any{
+				"http.request.header": "not a map",
+				"hosts":               []any{"a"},
+			},
+			expectedAttributes: map[string]any{
+				"http.request.header": "not a map",
+				"hosts":               []any{"a"},
+			},
+		},
+	}
+
+	cfg := &Settings{
+		Actions: []ActionKeyValue{
+			{Key: "tenant", Action: INSERT, FromAttribute: `attributes["http.request.header"]["x-tenant"]`},
+			{Key: "host", Action: UPSERT, FromAttribute: `attributes["hosts"][1]`},
+		},
+	}
+
+	ap, err := NewAttrProc(cfg)
+	require.NoError(t, err)
+	require.NotNil(t, ap)
+
+	for _, tt := range testCases {
+		runIndividualTestCase(t, tt, ap)
+	}
+}
+
+func TestAttributes_DeletePath(t *testing.T) {
+	testCases := []testCase{
+		{
+			name: "DeleteNestedValues",
+			inputAttributes: map[string]any{
+				"http.request.header": map[string]any{
+					"authorization": "secret",
+					"x-tenant":      "acme",
+				},
+				"hosts": []any{"a", "b", "c"},
+			},
+			expectedAttributes: map[string]any{
+				"http.request.header": map[string]any{
+					"x-tenant": "acme",
+				},
+				"hosts": []any{"a", "c"},
+			},
+		},
+		// Ensure the attributes contain no changes because the paths don't exist.
+		{
+			name: "DeletePathNoExist",
+			inputAttributes: map[string]any{
+				"http.request.header": map[string]any{
+					"x-tenant": "acme",
+				},
+				"hosts": []any{"a"},
+			},
+			expectedAttributes: map[string]any{
+				"http.request.header": map[string]any{
+					"x-tenant": "acme",
+				},
+				"hosts": []any{"a"},
+			},
+		},
+	}
+
+	cfg := &Settings{
+		Actions: []ActionKeyValue{
+			{Key: `attributes["http.request.header"]["authorization"]`, Action: DELETE},
+			{Key: `attributes["hosts"][1]`, Action: DELETE},
+		},
+	}
+
+	ap, err := NewAttrProc(cfg)
+	require.NoError(t, err)
+	require.NotNil(t, ap)
+
+	for _, tt := range testCases {
+		runIndividualTestCase(t, tt, ap)
+	}
+}
+
+func TestAttributes_Flatten(t *testing.T) {
+	testCases := []testCase{
+		{
+			name: "FlattenMap",
+			inputAttributes: map[string]any{
+				"http": map[string]any{
+					"method": "GET",
+					"request": map[string]any{
+						"headers": []any{"a", map[string]any{"b": int64(1)}},
+					},
+				},
+				"http.method": "POST",
+			},
+			expectedAttributes: map[string]any{
+				"http.method":              "GET",
+				"http.request.headers.0":   "a",
+				"http.request.headers.1.b": int64(1),
+			},
+		},
+		{
+			name: "FlattenSlice",
+			inputAttributes: map[string]any{
+				"http": []any{true, 1.5},
+			},
+			expectedAttributes: map[string]any{
+				"http.0": true,
+				"http.1": 1.5,
+			},
+		},
+		// Ensure the attributes contain no changes because the value isn't a map or a slice.
+		{
+			name: "FlattenScalar",
+			inputAttributes: map[string]any{
+				"http": "GET",
+			},
+			expectedAttributes: map[string]any{
+				"http": "GET",
+			},
+		},
+	}
+
+	cfg := &Settings{
+		Actions: []ActionKeyValue{
+			{Key: "http", Action: FLATTEN},
+		},
+	}
+
+	ap, err := NewAttrProc(cfg)
+	require.NoError(t, err)
+	require.NotNil(t, ap)
+
+	for _, tt := range testCases {
+		runIndividualTestCase(t, tt, ap)
+	}
+}
+
 func TestAttributes_FromAttributeNoChange(t *testing.T) {
 	tc := testCase{
 		name: "FromAttributeNoChange",
@@ -883,6 +1040,41 @@ func TestInvalidConfig(t *testing.T) {
 			},
 			errorString: "error creating AttrProc. Field \"pattern\" contains no named matcher groups at the 0-th actions",
 		},
+		{
+			name: "path in key",
+			actionLists: []ActionKeyValue{
+				{Key: `attributes["a"]["b"]`, Value: "value", Action: UPSERT},
+			},
+			errorString: "error creating AttrProc. Action \"upsert\" does not support paths in the \"key\" field at the 0-th actions",
+		},
+		{
+			name: "invalid path in key",
+			actionLists: []ActionKeyValue{
+				{Key: `attributes["a"][b]`, Action: DELETE},
+			},
+			errorString: "error creating AttrProc due to invalid path \"attributes[\\\"a\\\"][b]\" in field \"key\" at the 0-th actions: invalid index \"b\" at offset 16",
+		},
+		{
+			name: "invalid path in from attribute",
+			actionLists: []ActionKeyValue{
+				{Key: "aa", FromAttribute: `attributes["a"`, Action: INSERT},
+			},
+			errorString: "error creating AttrProc due to invalid path \"attributes[\\\"a\\\"\" in field \"from_attribute\" at the 0-th actions: expected ']' at offset 14",
+		},
+		{
+			name: "index as first segment",
+			actionLists: []ActionKeyValue{
+				{Key: "attributes[0]", Action: DELETE},
+			},
+			errorString: "error creating AttrProc due to invalid path \"attributes[0]\" in field \"key\" at the 0-th actions: the first segment must be the key of an attribute",
+		},
+		{
+			name: "set value for flatten",
+			actionLists: []ActionKeyValue{
+				{Key: "aa", Value: "value", Action: FLATTEN},
+			},
+			errorString: "error creating AttrProc. Action \"flatten\" does not use value sources, \"pattern\" or \"converted_type\" fields. These must not be specified for 0-th action",
+		},
 		{
 			name: "regex with one unnamed capture groups",
 			actionLists: []ActionKeyValue{
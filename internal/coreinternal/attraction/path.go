@@ -0,0 +1,153 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package attraction // import "github.com/open-telemetry/opentelemetry-collector-contrib/internal/coreinternal/attraction"
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"go.opentelemetry.io/collector/pdata/pcommon"
+)
+
+// pathPrefix starts the keys which are paths to nested values, e.g. attributes["http.request.header"]["x-tenant"].
+const pathPrefix = "attributes["
+
+// pathSegment is a key of a map, or an index of a slice when isIndex is set.
+type pathSegment struct {
+	key     string
+	index   int
+	isIndex bool
+}
+
+// isPath returns whether the key is a path to a nested value rather than the key of an attribute.
+func isPath(key string) bool {
+	return strings.HasPrefix(key, pathPrefix)
+}
+
+// parsePath parses a path such as attributes["http.request.header"]["x-tenant"] or attributes["hosts"][0] into its
+// segments, the first one being the key of the attribute.
+func parsePath(path string) ([]pathSegment, error) {
+	rest := strings.TrimPrefix(path, "attributes")
+	var segments []pathSegment
+	for rest != "" {
+		if rest[0] != '[' {
+			return nil, fmt.Errorf("expected '[' at offset %d", len(path)-len(rest))
+		}
+		end := strings.IndexByte(rest, ']')
+		if len(rest) > 1 && rest[1] == '"' {
+			// the key may contain ']', look for the bracket following the closing quote
+			quoted, err := strconv.QuotedPrefix(rest[1:])
+			if err != nil {
+				return nil, fmt.Errorf("invalid key at offset %d", len(path)-len(rest)+1)
+			}
+			end = len(quoted) + 1
+			if end >= len(rest) || rest[end] != ']' {
+				return nil, fmt.Errorf("expected ']' at offset %d", len(path)-len(rest)+end)
+			}
+			key, _ := strconv.Unquote(quoted)
+			segments = append(segments, pathSegment{key: key})
+		} else {
+			if end < 0 {
+				return nil, fmt.Errorf("unclosed '[' at offset %d", len(path)-len(rest))
+			}
+			index, err := strconv.Atoi(rest[1:end])
+			if err != nil || index < 0 {
+				return nil, fmt.Errorf("invalid index %q at offset %d", rest[1:end], len(path)-len(rest)+1)
+			}
+			if len(segments) == 0 {
+				return nil, errors.New("the first segment must be the key of an attribute")
+			}
+			segments = append(segments, pathSegment{index: index, isIndex: true})
+		}
+		rest = rest[end+1:]
+	}
+	if len(segments) == 0 {
+		return nil, errors.New("the path is empty")
+	}
+	return segments, nil
+}
+
+// getPathValue returns the nested value at the path, if it exists.
+func getPathValue(attrs pcommon.Map, path []pathSegment) (pcommon.Value, bool) {
+	val, found := attrs.Get(path[0].key)
+	for _, segment := range path[1:] {
+		if !found {
+			break
+		}
+		val, found = getChild(val, segment)
+	}
+	return val, found
+}
+
+func getChild(val pcommon.Value, segment pathSegment) (pcommon.Value, bool) {
+	switch {
+	case segment.isIndex && val.Type() == pcommon.ValueTypeSlice:
+		if segment.index >= val.Slice().Len() {
+			return pcommon.Value{}, false
+		}
+		return val.Slice().At(segment.index), true
+	case !segment.isIndex && val.Type() == pcommon.ValueTypeMap:
+		return val.Map().Get(segment.key)
+	}
+	return pcommon.Value{}, false
+}
+
+// deletePath deletes the nested value at the path, if it exists.
+func deletePath(attrs pcommon.Map, path []pathSegment) {
+	last := path[len(path)-1]
+	if len(path) == 1 {
+		attrs.Remove(last.key)
+		return
+	}
+	parent, found := getPathValue(attrs, path[:len(path)-1])
+	if !found {
+		return
+	}
+	switch {
+	case last.isIndex && parent.Type() == pcommon.ValueTypeSlice:
+		i := 0
+		parent.Slice().RemoveIf(func(pcommon.Value) bool {
+			i++
+			return i-1 == last.index
+		})
+	case !last.isIndex && parent.Type() == pcommon.ValueTypeMap:
+		parent.Map().Remove(last.key)
+	}
+}
+
+// flattenAttribute replaces the map or slice attribute with an attribute for each of its leaf values, whose key is
+// made of the keys and indexes of the path to the value separated by dots, e.g. the attribute "http" whose value is
+// {"request": {"headers": ["a", "b"]}} is replaced by "http.request.headers.0" and "http.request.headers.1".
+// Existing attributes with the same keys are overwritten.
+func flattenAttribute(key string, attrs pcommon.Map) {
+	value, found := attrs.Get(key)
+	if !found || (value.Type() != pcommon.ValueTypeMap && value.Type() != pcommon.ValueTypeSlice) {
+		return
+	}
+	flattened := pcommon.NewMap()
+	flattenValue(key, value, flattened)
+	attrs.Remove(key)
+	flattened.Range(func(k string, v pcommon.Value) bool {
+		v.CopyTo(attrs.PutEmpty(k))
+		return true
+	})
+}
+
+func flattenValue(prefix string, value pcommon.Value, flattened pcommon.Map) {
+	switch value.Type() {
+	case pcommon.ValueTypeMap:
+		value.Map().Range(func(k string, v pcommon.Value) bool {
+			flattenValue(prefix+"."+k, v, flattened)
+			return true
+		})
+	case pcommon.ValueTypeSlice:
+		for i := 0; i < value.Slice().Len(); i++ {
+			flattenValue(prefix+"."+strconv.Itoa(i), value.Slice().At(i), flattened)
+		}
+	default:
+		value.CopyTo(flattened.PutEmpty(prefix))
+	}
+}
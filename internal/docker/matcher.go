@@ -121,3 +121,13 @@ func (f *stringMatcher) matches(s string) bool {
 
 	return false
 }
+
+// NewImageMatcher returns a function reporting whether an image matches any of the given
+// literal, glob or regex filters, with the same semantics as the client's ExcludedImages.
+func NewImageMatcher(images []string) (func(image string) bool, error) {
+	m, err := newStringMatcher(images)
+	if err != nil {
+		return nil, err
+	}
+	return m.matches, nil
+}
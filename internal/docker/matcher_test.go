@@ -191,3 +191,15 @@ func TestInvalidStringMatchers(t *testing.T) {
 		})
 	}
 }
+
+func TestNewImageMatcher(t *testing.T) {
+	matches, err := NewImageMatcher([]string{"pause", "*/busybox:*", "/^registry\\.k8s\\.io//"})
+	require.NoError(t, err)
+	assert.True(t, matches("pause"))
+	assert.True(t, matches("docker.io/busybox:latest"))
+	assert.True(t, matches("registry.k8s.io/coredns/coredns:v1.11.1"))
+	assert.False(t, matches("docker.io/nginx:latest"))
+
+	_, err = NewImageMatcher([]string{"["})
+	assert.EqualError(t, err, "invalid glob item: unexpected end of input")
+}
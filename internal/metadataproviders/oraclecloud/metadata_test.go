@@ -0,0 +1,82 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package oraclecloud
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewProvider(t *testing.T) {
+	provider := NewProvider()
+	assert.NotNil(t, provider)
+}
+
+func TestQueryEndpointFailed(t *testing.T) {
+	ts := httptest.NewServer(http.NotFoundHandler())
+	defer ts.Close()
+
+	provider := &oracleCloudProviderImpl{
+		endpoint: ts.URL,
+		client:   &http.Client{},
+	}
+
+	_, err := provider.Metadata(context.Background())
+	assert.Error(t, err)
+}
+
+func TestQueryEndpointMalformed(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		_, err := fmt.Fprintln(w, "{")
+		assert.NoError(t, err)
+	}))
+	defer ts.Close()
+
+	provider := &oracleCloudProviderImpl{
+		endpoint: ts.URL,
+		client:   &http.Client{},
+	}
+
+	_, err := provider.Metadata(context.Background())
+	assert.Error(t, err)
+}
+
+func TestQueryEndpointCorrect(t *testing.T) {
+	sentMetadata := &ComputeMetadata{
+		AvailabilityDomain:  "EMIr:PHX-AD-1",
+		CanonicalRegionName: "us-phoenix-1",
+		CompartmentID:       "ocid1.compartment.oc1..compartment",
+		DisplayName:         "instance",
+		Hostname:            "hostname",
+		ID:                  "ocid1.instance.oc1.phx.instance",
+		Region:              "phx",
+		Shape:               "VM.Standard.E4.Flex",
+	}
+	marshalledMetadata, err := json.Marshal(sentMetadata)
+	require.NoError(t, err)
+
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "Bearer Oracle", r.Header.Get("Authorization"))
+		_, err = w.Write(marshalledMetadata)
+		assert.NoError(t, err)
+	}))
+	defer ts.Close()
+
+	provider := &oracleCloudProviderImpl{
+		endpoint: ts.URL,
+		client:   &http.Client{},
+	}
+
+	recvMetadata, err := provider.Metadata(context.Background())
+
+	require.NoError(t, err)
+	assert.Equal(t, *sentMetadata, *recvMetadata)
+}
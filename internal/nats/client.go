@@ -0,0 +1,103 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package nats // import "github.com/open-telemetry/opentelemetry-collector-contrib/internal/nats"
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	natsgo "github.com/nats-io/nats.go"
+	"github.com/nats-io/nats.go/jetstream"
+	"go.opentelemetry.io/collector/config/configopaque"
+	"go.opentelemetry.io/collector/config/configtls"
+)
+
+// DefaultEndpoint is the default URL of the NATS server.
+const DefaultEndpoint = "nats://localhost:4222"
+
+// ClientConfig defines the connection to a NATS server.
+type ClientConfig struct {
+	// Endpoint is the URL of the NATS server, or a comma-separated list of URLs of the servers of a cluster.
+	Endpoint string `mapstructure:"endpoint"`
+
+	Auth Authentication `mapstructure:"auth"`
+
+	TLS configtls.ClientConfig `mapstructure:"tls,omitempty"`
+}
+
+// Authentication defines the authentication to the NATS server. At most one of the methods can be configured.
+type Authentication struct {
+	// Username and Password authenticate with a user and a password.
+	Username string              `mapstructure:"username"`
+	Password configopaque.String `mapstructure:"password"`
+	// Token authenticates with a token.
+	Token configopaque.String `mapstructure:"token"`
+	// CredentialsFile is the path of a credentials file holding a JWT and a NKey seed, e.g. created by nsc.
+	CredentialsFile string `mapstructure:"credentials_file"`
+}
+
+// Validate checks if the client configuration is valid.
+func (cfg *ClientConfig) Validate() error {
+	if cfg.Endpoint == "" {
+		return errors.New("endpoint must be specified")
+	}
+	methods := 0
+	if cfg.Auth.Username != "" {
+		methods++
+	}
+	if cfg.Auth.Token != "" {
+		methods++
+	}
+	if cfg.Auth.CredentialsFile != "" {
+		methods++
+	}
+	if methods > 1 {
+		return errors.New("only one of auth::username, auth::token and auth::credentials_file can be specified")
+	}
+	return nil
+}
+
+// Client is a connection to a NATS server, and its JetStream context.
+type Client struct {
+	conn      *natsgo.Conn
+	JetStream jetstream.JetStream
+}
+
+// Connect connects to the NATS server, with name as the name of the connection.
+func Connect(ctx context.Context, cfg ClientConfig, name string) (*Client, error) {
+	opts := []natsgo.Option{natsgo.Name(name)}
+	switch {
+	case cfg.Auth.Username != "":
+		opts = append(opts, natsgo.UserInfo(cfg.Auth.Username, string(cfg.Auth.Password)))
+	case cfg.Auth.Token != "":
+		opts = append(opts, natsgo.Token(string(cfg.Auth.Token)))
+	case cfg.Auth.CredentialsFile != "":
+		opts = append(opts, natsgo.UserCredentials(cfg.Auth.CredentialsFile))
+	}
+	tlsConfig, err := cfg.TLS.LoadTLSConfig(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load TLS config: %w", err)
+	}
+	if tlsConfig != nil {
+		opts = append(opts, natsgo.Secure(tlsConfig))
+	}
+
+	conn, err := natsgo.Connect(cfg.Endpoint, opts...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to connect to %s: %w", cfg.Endpoint, err)
+	}
+	js, err := jetstream.New(conn)
+	if err != nil {
+		conn.Close()
+		return nil, err
+	}
+	return &Client{conn: conn, JetStream: js}, nil
+}
+
+// Close drains the connection: the pending messages are published, and the messages already received by the
+// subscriptions are processed, before the connection is closed.
+func (c *Client) Close() error {
+	return c.conn.Drain()
+}
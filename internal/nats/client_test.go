@@ -0,0 +1,60 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package nats
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"go.opentelemetry.io/collector/config/configtls"
+)
+
+func TestValidate(t *testing.T) {
+	tests := []struct {
+		name string
+		cfg  ClientConfig
+		err  string
+	}{
+		{
+			name: "default",
+			cfg:  ClientConfig{Endpoint: DefaultEndpoint},
+		},
+		{
+			name: "username",
+			cfg:  ClientConfig{Endpoint: DefaultEndpoint, Auth: Authentication{Username: "user", Password: "pass"}},
+		},
+		{
+			name: "no endpoint",
+			cfg:  ClientConfig{},
+			err:  "endpoint must be specified",
+		},
+		{
+			name: "several auth methods",
+			cfg:  ClientConfig{Endpoint: DefaultEndpoint, Auth: Authentication{Token: "token", CredentialsFile: "user.creds"}},
+			err:  "only one of auth::username, auth::token and auth::credentials_file can be specified",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.cfg.Validate()
+			if tt.err != "" {
+				assert.EqualError(t, err, tt.err)
+				return
+			}
+			assert.NoError(t, err)
+		})
+	}
+}
+
+func TestConnectTLSError(t *testing.T) {
+	cfg := ClientConfig{
+		Endpoint: DefaultEndpoint,
+		TLS: configtls.ClientConfig{
+			Config: configtls.Config{CAFile: "/nonexistent"},
+		},
+	}
+	_, err := Connect(context.Background(), cfg, "test")
+	assert.ErrorContains(t, err, "failed to load TLS config")
+}
@@ -0,0 +1,69 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+// Package partialsuccess lets the processors of a pipeline report the items they
+// reject to the receiver of the request, so that the receiver can describe them in
+// the partial success of its response, and the clients can surface actionable errors.
+//
+// The rejections are recorded in the context of the request, so only the processors
+// running synchronously with the receiver, i.e. before any batching or queueing
+// component, can report them.
+package partialsuccess // import "github.com/open-telemetry/opentelemetry-collector-contrib/internal/partialsuccess"
+
+import (
+	"context"
+	"strings"
+	"sync"
+)
+
+type recorderKey struct{}
+
+// Recorder accumulates the items rejected while processing a request.
+type Recorder struct {
+	mutex    sync.Mutex
+	rejected int64
+	reasons  []string
+}
+
+// NewContext returns a context carrying a new Recorder, to be passed to the next
+// consumer, and the Recorder to read the rejections from once it returns.
+func NewContext(ctx context.Context) (context.Context, *Recorder) {
+	r := &Recorder{}
+	return context.WithValue(ctx, recorderKey{}, r), r
+}
+
+// Record records that count items were rejected for the given reason. It is a no-op
+// when the receiver of the request doesn't report partial successes.
+func Record(ctx context.Context, count int, reason string) {
+	if count <= 0 {
+		return
+	}
+	r, ok := ctx.Value(recorderKey{}).(*Recorder)
+	if !ok {
+		return
+	}
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+	r.rejected += int64(count)
+	for _, known := range r.reasons {
+		if known == reason {
+			return
+		}
+	}
+	r.reasons = append(r.reasons, reason)
+}
+
+// Rejected returns the number of rejected items.
+func (r *Recorder) Rejected() int64 {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+	return r.rejected
+}
+
+// Message returns the distinct reasons of the rejections, in the order they were
+// first recorded.
+func (r *Recorder) Message() string {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+	return strings.Join(r.reasons, "; ")
+}
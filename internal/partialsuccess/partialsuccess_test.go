@@ -0,0 +1,32 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package partialsuccess
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRecorder(t *testing.T) {
+	ctx, r := NewContext(context.Background())
+	assert.Equal(t, int64(0), r.Rejected())
+	assert.Equal(t, "", r.Message())
+
+	Record(ctx, 3, "dropped by the filter processor")
+	Record(ctx, 0, "nothing rejected")
+	Record(ctx, 2, "over the limit")
+	Record(ctx, 1, "dropped by the filter processor")
+
+	assert.Equal(t, int64(6), r.Rejected())
+	assert.Equal(t, "dropped by the filter processor; over the limit", r.Message())
+}
+
+func TestRecordWithoutRecorder(t *testing.T) {
+	// the receivers not reporting partial successes don't have a recorder
+	assert.NotPanics(t, func() {
+		Record(context.Background(), 3, "dropped by the filter processor")
+	})
+}
@@ -39,11 +39,14 @@ func (c Config) Validate() error {
 }
 
 type Query struct {
-	SQL                string      `mapstructure:"sql"`
-	Metrics            []MetricCfg `mapstructure:"metrics"`
-	Logs               []LogsCfg   `mapstructure:"logs"`
-	TrackingColumn     string      `mapstructure:"tracking_column"`
-	TrackingStartValue string      `mapstructure:"tracking_start_value"`
+	SQL                 string      `mapstructure:"sql"`
+	Metrics             []MetricCfg `mapstructure:"metrics"`
+	Logs                []LogsCfg   `mapstructure:"logs"`
+	Parameters          []string    `mapstructure:"parameters"`
+	TrackingColumn      string      `mapstructure:"tracking_column"`
+	TrackingStartValue  string      `mapstructure:"tracking_start_value"`
+	TrackingColumns     []string    `mapstructure:"tracking_columns"`
+	TrackingStartValues []string    `mapstructure:"tracking_start_values"`
 }
 
 func (q Query) Validate() error {
@@ -54,6 +57,15 @@ func (q Query) Validate() error {
 	if len(q.Logs) == 0 && len(q.Metrics) == 0 {
 		errs = append(errs, errors.New("at least one of 'query.logs' and 'query.metrics' must not be empty"))
 	}
+	if q.TrackingColumn != "" && len(q.TrackingColumns) > 0 {
+		errs = append(errs, errors.New("'tracking_column' and 'tracking_columns' cannot be used together"))
+	}
+	if q.TrackingStartValue != "" && len(q.TrackingColumns) > 0 {
+		errs = append(errs, errors.New("'tracking_start_value' cannot be used with 'tracking_columns', use 'tracking_start_values' instead"))
+	}
+	if len(q.TrackingStartValues) > 0 && len(q.TrackingStartValues) != len(q.TrackingColumns) {
+		errs = append(errs, errors.New("'tracking_start_values' must have as many values as 'tracking_columns'"))
+	}
 	for _, logs := range q.Logs {
 		if err := logs.Validate(); err != nil {
 			errs = append(errs, err)
@@ -67,6 +79,36 @@ func (q Query) Validate() error {
 	return errors.Join(errs...)
 }
 
+// Args returns the configured parameters to bind to the query, in order.
+func (q Query) Args() []any {
+	args := make([]any, 0, len(q.Parameters))
+	for _, p := range q.Parameters {
+		args = append(args, p)
+	}
+	return args
+}
+
+// TrackingColumnNames returns the columns used to track the processed results,
+// configured with either `tracking_column` or `tracking_columns`.
+func (q Query) TrackingColumnNames() []string {
+	if q.TrackingColumn != "" {
+		return []string{q.TrackingColumn}
+	}
+	return q.TrackingColumns
+}
+
+// InitialTrackingValues returns the values of the tracking columns to use
+// for the first run of the query.
+func (q Query) InitialTrackingValues() []string {
+	if q.TrackingColumn != "" {
+		return []string{q.TrackingStartValue}
+	}
+	if len(q.TrackingStartValues) > 0 {
+		return append([]string(nil), q.TrackingStartValues...)
+	}
+	return make([]string, len(q.TrackingColumns))
+}
+
 type LogsCfg struct {
 	BodyColumn string `mapstructure:"body_column"`
 }
@@ -47,22 +47,29 @@ func (cl DbSQLClient) QueryRows(ctx context.Context, args ...any) ([]StringMap,
 		return nil, err
 	}
 	var out []StringMap
-	colTypes, err := sqlRows.ColumnTypes()
-	if err != nil {
-		return nil, err
-	}
-	scanner := newRowScanner(colTypes)
 	var warnings []error
-	for sqlRows.Next() {
-		err = scanner.scan(sqlRows)
+	// Stored procedures may return several result sets, the rows of all of them are returned.
+	for {
+		var colTypes []colType
+		colTypes, err = sqlRows.ColumnTypes()
 		if err != nil {
 			return nil, err
 		}
-		sm, scanErr := scanner.toStringMap()
-		if scanErr != nil {
-			warnings = append(warnings, scanErr)
+		scanner := newRowScanner(colTypes)
+		for sqlRows.Next() {
+			err = scanner.scan(sqlRows)
+			if err != nil {
+				return nil, err
+			}
+			sm, scanErr := scanner.toStringMap()
+			if scanErr != nil {
+				warnings = append(warnings, scanErr)
+			}
+			out = append(out, sm)
+		}
+		if !sqlRows.NextResultSet() {
+			break
 		}
-		out = append(out, sm)
 	}
 	return out, errors.Join(warnings...)
 }
@@ -81,9 +88,12 @@ type FakeDBClient struct {
 	RequestCounter int
 	StringMaps     [][]StringMap
 	Err            error
+	// Args records the arguments of each query.
+	Args [][]any
 }
 
-func (c *FakeDBClient) QueryRows(context.Context, ...any) ([]StringMap, error) {
+func (c *FakeDBClient) QueryRows(_ context.Context, args ...any) ([]StringMap, error) {
+	c.Args = append(c.Args, args)
 	if c.Err != nil {
 		return nil, c.Err
 	}
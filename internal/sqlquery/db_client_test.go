@@ -108,17 +108,38 @@ func TestDBSQLClient_Nulls_MultiRow(t *testing.T) {
 	}, rows[1])
 }
 
+func TestDBSQLClient_MultipleResultSets(t *testing.T) {
+	cl := DbSQLClient{
+		Db: fakeDB{
+			rowVals:        [][]any{{42, "hello"}},
+			nextResultSets: [][][]any{{{43, "goodbye"}, {44, "again"}}},
+		},
+		Logger: zap.NewNop(),
+		SQL:    "",
+	}
+	rows, err := cl.QueryRows(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, []StringMap{
+		{"col_0": "42", "col_1": "hello"},
+		{"col_0": "43", "col_1": "goodbye"},
+		{"col_0": "44", "col_1": "again"},
+	}, rows)
+}
+
 type fakeDB struct {
 	rowVals [][]any
+	// nextResultSets are the result sets returned after rowVals, as done by stored procedures.
+	nextResultSets [][][]any
 }
 
 func (db fakeDB) QueryContext(context.Context, string, ...any) (rows, error) {
-	return &fakeRows{vals: db.rowVals}, nil
+	return &fakeRows{vals: db.rowVals, nextResultSets: db.nextResultSets}, nil
 }
 
 type fakeRows struct {
-	vals [][]any
-	row  int
+	vals           [][]any
+	row            int
+	nextResultSets [][][]any
 }
 
 func (r *fakeRows) ColumnTypes() ([]colType, error) {
@@ -133,6 +154,15 @@ func (r *fakeRows) Next() bool {
 	return r.row < len(r.vals)
 }
 
+func (r *fakeRows) NextResultSet() bool {
+	if len(r.nextResultSets) == 0 {
+		return false
+	}
+	r.vals, r.nextResultSets = r.nextResultSets[0], r.nextResultSets[1:]
+	r.row = 0
+	return true
+}
+
 func (r *fakeRows) Scan(dest ...any) error {
 	for i := range dest {
 		ptr := dest[i].(*any)
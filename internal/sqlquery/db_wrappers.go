@@ -17,6 +17,7 @@ type Db interface {
 type rows interface {
 	ColumnTypes() ([]colType, error)
 	Next() bool
+	NextResultSet() bool
 	Scan(dest ...any) error
 }
 
@@ -53,6 +54,10 @@ func (r rowsWrapper) Next() bool {
 	return r.rows.Next()
 }
 
+func (r rowsWrapper) NextResultSet() bool {
+	return r.rows.NextResultSet()
+}
+
 func (r rowsWrapper) Scan(dest ...any) error {
 	return r.rows.Scan(dest...)
 }
@@ -69,7 +69,7 @@ func (s *Scraper) Start(context.Context, component.Host) error {
 
 func (s *Scraper) Scrape(ctx context.Context) (pmetric.Metrics, error) {
 	out := pmetric.NewMetrics()
-	rows, err := s.Client.QueryRows(ctx)
+	rows, err := s.Client.QueryRows(ctx, s.Query.Args()...)
 	if err != nil {
 		if errors.Is(err, ErrNullValueWarning) {
 			s.Logger.Warn("problems encountered getting metric rows", zap.Error(err))
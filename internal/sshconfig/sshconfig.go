@@ -0,0 +1,100 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+// Package sshconfig builds the configurations of the SSH clients of the components connecting to SSH servers.
+package sshconfig // import "github.com/open-telemetry/opentelemetry-collector-contrib/internal/sshconfig"
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"time"
+
+	"golang.org/x/crypto/ssh"
+	"golang.org/x/crypto/ssh/knownhosts"
+)
+
+// ClientVersion is the version the SSH clients identify with to the servers.
+const ClientVersion = "SSH-2.0-OTelClient"
+
+const systemKnownHosts = "/etc/ssh/known_hosts"
+
+// ClientSettings authenticate an SSH client and check the host key of the server.
+type ClientSettings struct {
+	Username string
+	// Password authenticates the user, or is the passphrase of the private key of KeyFile when set.
+	Password string
+	// KeyFile is the path of the private key authenticating the user.
+	KeyFile string
+	// KnownHosts is the path of the known_hosts file checking the host key of the server, ~/.ssh/known_hosts and
+	// /etc/ssh/known_hosts when not set, every host key being rejected when none of them exists.
+	KnownHosts string
+	// IgnoreHostKey skips the check of the host key, which is insecure.
+	IgnoreHostKey bool
+	// Timeout is the maximum time to establish the connection, unlimited when not set.
+	Timeout time.Duration
+}
+
+// ToClientConfig returns the configuration of the SSH client.
+func (s ClientSettings) ToClientConfig() (*ssh.ClientConfig, error) {
+	auth, err := s.authMethod()
+	if err != nil {
+		return nil, err
+	}
+	hkc, err := s.hostKeyCallback()
+	if err != nil {
+		return nil, err
+	}
+	return &ssh.ClientConfig{
+		User:            s.Username,
+		Auth:            []ssh.AuthMethod{auth},
+		HostKeyCallback: hkc,
+		ClientVersion:   ClientVersion,
+		Timeout:         s.Timeout,
+	}, nil
+}
+
+func (s ClientSettings) authMethod() (ssh.AuthMethod, error) {
+	if s.KeyFile == "" {
+		return ssh.Password(s.Password), nil
+	}
+	key, err := os.ReadFile(s.KeyFile)
+	if err != nil {
+		return nil, fmt.Errorf("unable to read private key: %w", err)
+	}
+	var signer ssh.Signer
+	if s.Password != "" {
+		signer, err = ssh.ParsePrivateKeyWithPassphrase(key, []byte(s.Password))
+	} else {
+		signer, err = ssh.ParsePrivateKey(key)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("unable to parse private key: %w", err)
+	}
+	return ssh.PublicKeys(signer), nil
+}
+
+func (s ClientSettings) hostKeyCallback() (ssh.HostKeyCallback, error) {
+	switch {
+	case s.IgnoreHostKey:
+		return ssh.InsecureIgnoreHostKey(), nil //#nosec G106
+	case s.KnownHosts != "":
+		return knownhosts.New(s.KnownHosts)
+	default:
+		return defaultKnownHostsCallback()
+	}
+}
+
+func defaultKnownHostsCallback() (ssh.HostKeyCallback, error) {
+	var files []string
+	if home, err := os.UserHomeDir(); err == nil {
+		path := filepath.Join(home, ".ssh", "known_hosts")
+		if _, err = os.Stat(path); err == nil {
+			files = append(files, path)
+		}
+	}
+	if _, err := os.Stat(systemKnownHosts); err == nil {
+		files = append(files, systemKnownHosts)
+	}
+	return knownhosts.New(files...)
+}
@@ -0,0 +1,124 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package sshconfig
+
+import (
+	"crypto/ed25519"
+	"crypto/rand"
+	"net"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"golang.org/x/crypto/ssh"
+	"golang.org/x/crypto/ssh/knownhosts"
+)
+
+func TestToClientConfig(t *testing.T) {
+	knownHosts := filepath.Join(t.TempDir(), "known_hosts")
+	require.NoError(t, os.WriteFile(knownHosts, nil, 0600))
+
+	tests := []struct {
+		name     string
+		settings ClientSettings
+		errMsg   string
+	}{
+		{
+			name: "password",
+			settings: ClientSettings{
+				Username:      "otelu",
+				Password:      "otelp",
+				IgnoreHostKey: true,
+			},
+		},
+		{
+			name: "key_file",
+			settings: ClientSettings{
+				Username:   "otelu",
+				KeyFile:    filepath.Join("testdata", "keys", "id_rsa"),
+				KnownHosts: knownHosts,
+			},
+		},
+		{
+			name: "key_file_with_passphrase",
+			settings: ClientSettings{
+				Username:   "otelu",
+				Password:   "r54-G0pher_t3st$",
+				KeyFile:    filepath.Join("testdata", "keys", "id_rsa_enc"),
+				KnownHosts: knownHosts,
+			},
+		},
+		{
+			name: "key_file_with_wrong_passphrase",
+			settings: ClientSettings{
+				Username:   "otelu",
+				Password:   "wrong",
+				KeyFile:    filepath.Join("testdata", "keys", "id_rsa_enc"),
+				KnownHosts: knownHosts,
+			},
+			errMsg: "unable to parse private key",
+		},
+		{
+			name: "missing_key_file",
+			settings: ClientSettings{
+				Username:   "otelu",
+				KeyFile:    filepath.Join("testdata", "keys", "missing"),
+				KnownHosts: knownHosts,
+			},
+			errMsg: "unable to read private key",
+		},
+		{
+			name: "missing_known_hosts",
+			settings: ClientSettings{
+				Username:   "otelu",
+				Password:   "otelp",
+				KnownHosts: filepath.Join("testdata", "missing"),
+			},
+			errMsg: "no such file or directory",
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			test.settings.Timeout = 5 * time.Second
+			cfg, err := test.settings.ToClientConfig()
+			if test.errMsg != "" {
+				assert.ErrorContains(t, err, test.errMsg)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, test.settings.Username, cfg.User)
+			assert.Len(t, cfg.Auth, 1)
+			assert.NotNil(t, cfg.HostKeyCallback)
+			assert.Equal(t, ClientVersion, cfg.ClientVersion)
+			assert.Equal(t, test.settings.Timeout, cfg.Timeout)
+		})
+	}
+}
+
+func TestDefaultKnownHostsCallback(t *testing.T) {
+	if _, err := os.Stat(systemKnownHosts); err == nil {
+		t.Skipf("%s is present", systemKnownHosts)
+	}
+	home := t.TempDir()
+	t.Setenv("HOME", home)
+	_, hostKey, err := ed25519.GenerateKey(rand.Reader)
+	require.NoError(t, err)
+	signer, err := ssh.NewSignerFromKey(hostKey)
+	require.NoError(t, err)
+	remote := &net.TCPAddr{IP: net.IPv4(127, 0, 0, 1), Port: 22}
+
+	cfg, err := ClientSettings{Username: "otelu", Password: "otelp"}.ToClientConfig()
+	require.NoError(t, err)
+	assert.Error(t, cfg.HostKeyCallback("localhost:22", remote, signer.PublicKey()))
+
+	line := knownhosts.Line([]string{knownhosts.Normalize("localhost:22")}, signer.PublicKey())
+	require.NoError(t, os.Mkdir(filepath.Join(home, ".ssh"), 0700))
+	require.NoError(t, os.WriteFile(filepath.Join(home, ".ssh", "known_hosts"), []byte(line+"\n"), 0600))
+	cfg, err = ClientSettings{Username: "otelu", Password: "otelp"}.ToClientConfig()
+	require.NoError(t, err)
+	assert.NoError(t, cfg.HostKeyCallback("localhost:22", remote, signer.PublicKey()))
+}
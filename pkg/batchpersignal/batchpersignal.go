@@ -153,3 +153,180 @@ func SplitMetrics(batch pmetric.Metrics) []pmetric.Metrics {
 
 	return result
 }
+
+// SplitTracesByResource returns one ptrace.Traces for each resource in the given ptrace.Traces input.
+func SplitTracesByResource(batch ptrace.Traces) []ptrace.Traces {
+	result := make([]ptrace.Traces, 0, batch.ResourceSpans().Len())
+	for i := 0; i < batch.ResourceSpans().Len(); i++ {
+		traces := ptrace.NewTraces()
+		batch.ResourceSpans().At(i).CopyTo(traces.ResourceSpans().AppendEmpty())
+		result = append(result, traces)
+	}
+	return result
+}
+
+// SplitLogsByResource returns one plog.Logs for each resource in the given plog.Logs input.
+func SplitLogsByResource(batch plog.Logs) []plog.Logs {
+	result := make([]plog.Logs, 0, batch.ResourceLogs().Len())
+	for i := 0; i < batch.ResourceLogs().Len(); i++ {
+		logs := plog.NewLogs()
+		batch.ResourceLogs().At(i).CopyTo(logs.ResourceLogs().AppendEmpty())
+		result = append(result, logs)
+	}
+	return result
+}
+
+// SplitMetricsByResource returns one pmetric.Metrics for each resource in the given pmetric.Metrics input.
+func SplitMetricsByResource(batch pmetric.Metrics) []pmetric.Metrics {
+	result := make([]pmetric.Metrics, 0, batch.ResourceMetrics().Len())
+	for i := 0; i < batch.ResourceMetrics().Len(); i++ {
+		metrics := pmetric.NewMetrics()
+		batch.ResourceMetrics().At(i).CopyTo(metrics.ResourceMetrics().AppendEmpty())
+		result = append(result, metrics)
+	}
+	return result
+}
+
+// SplitTracesByKey groups the spans of the given ptrace.Traces input by the key returned for each of them by keyFunc,
+// and returns one ptrace.Traces for each key. The spans keep their resource and scope: the spans of the same resource
+// and scope, with the same key, land in the same resource and scope of the resulting ptrace.Traces.
+func SplitTracesByKey(batch ptrace.Traces, keyFunc func(pcommon.Resource, pcommon.InstrumentationScope, ptrace.Span) string) map[string]ptrace.Traces {
+	result := map[string]ptrace.Traces{}
+
+	for i := 0; i < batch.ResourceSpans().Len(); i++ {
+		rs := batch.ResourceSpans().At(i)
+		// the resource of each key for this resource
+		resources := map[string]ptrace.ResourceSpans{}
+
+		for j := 0; j < rs.ScopeSpans().Len(); j++ {
+			ils := rs.ScopeSpans().At(j)
+			// the scope of each key for this scope
+			scopes := map[string]ptrace.ScopeSpans{}
+
+			for k := 0; k < ils.Spans().Len(); k++ {
+				span := ils.Spans().At(k)
+				key := keyFunc(rs.Resource(), ils.Scope(), span)
+
+				tgt, ok := scopes[key]
+				if !ok {
+					newRS, ok := resources[key]
+					if !ok {
+						traces, ok := result[key]
+						if !ok {
+							traces = ptrace.NewTraces()
+							result[key] = traces
+						}
+						newRS = traces.ResourceSpans().AppendEmpty()
+						rs.Resource().CopyTo(newRS.Resource())
+						newRS.SetSchemaUrl(rs.SchemaUrl())
+						resources[key] = newRS
+					}
+					tgt = newRS.ScopeSpans().AppendEmpty()
+					ils.Scope().CopyTo(tgt.Scope())
+					tgt.SetSchemaUrl(ils.SchemaUrl())
+					scopes[key] = tgt
+				}
+
+				span.CopyTo(tgt.Spans().AppendEmpty())
+			}
+		}
+	}
+
+	return result
+}
+
+// SplitLogsByKey groups the log records of the given plog.Logs input by the key returned for each of them by keyFunc,
+// and returns one plog.Logs for each key. The log records keep their resource and scope: the log records of the same
+// resource and scope, with the same key, land in the same resource and scope of the resulting plog.Logs.
+func SplitLogsByKey(batch plog.Logs, keyFunc func(pcommon.Resource, pcommon.InstrumentationScope, plog.LogRecord) string) map[string]plog.Logs {
+	result := map[string]plog.Logs{}
+
+	for i := 0; i < batch.ResourceLogs().Len(); i++ {
+		rl := batch.ResourceLogs().At(i)
+		// the resource of each key for this resource
+		resources := map[string]plog.ResourceLogs{}
+
+		for j := 0; j < rl.ScopeLogs().Len(); j++ {
+			sl := rl.ScopeLogs().At(j)
+			// the scope of each key for this scope
+			scopes := map[string]plog.ScopeLogs{}
+
+			for k := 0; k < sl.LogRecords().Len(); k++ {
+				log := sl.LogRecords().At(k)
+				key := keyFunc(rl.Resource(), sl.Scope(), log)
+
+				tgt, ok := scopes[key]
+				if !ok {
+					newRL, ok := resources[key]
+					if !ok {
+						logs, ok := result[key]
+						if !ok {
+							logs = plog.NewLogs()
+							result[key] = logs
+						}
+						newRL = logs.ResourceLogs().AppendEmpty()
+						rl.Resource().CopyTo(newRL.Resource())
+						newRL.SetSchemaUrl(rl.SchemaUrl())
+						resources[key] = newRL
+					}
+					tgt = newRL.ScopeLogs().AppendEmpty()
+					sl.Scope().CopyTo(tgt.Scope())
+					tgt.SetSchemaUrl(sl.SchemaUrl())
+					scopes[key] = tgt
+				}
+
+				log.CopyTo(tgt.LogRecords().AppendEmpty())
+			}
+		}
+	}
+
+	return result
+}
+
+// SplitMetricsByKey groups the metrics of the given pmetric.Metrics input by the key returned for each of them by
+// keyFunc, and returns one pmetric.Metrics for each key. The metrics keep their resource and scope: the metrics of the
+// same resource and scope, with the same key, land in the same resource and scope of the resulting pmetric.Metrics.
+func SplitMetricsByKey(batch pmetric.Metrics, keyFunc func(pcommon.Resource, pcommon.InstrumentationScope, pmetric.Metric) string) map[string]pmetric.Metrics {
+	result := map[string]pmetric.Metrics{}
+
+	for i := 0; i < batch.ResourceMetrics().Len(); i++ {
+		rm := batch.ResourceMetrics().At(i)
+		// the resource of each key for this resource
+		resources := map[string]pmetric.ResourceMetrics{}
+
+		for j := 0; j < rm.ScopeMetrics().Len(); j++ {
+			ils := rm.ScopeMetrics().At(j)
+			// the scope of each key for this scope
+			scopes := map[string]pmetric.ScopeMetrics{}
+
+			for k := 0; k < ils.Metrics().Len(); k++ {
+				metric := ils.Metrics().At(k)
+				key := keyFunc(rm.Resource(), ils.Scope(), metric)
+
+				tgt, ok := scopes[key]
+				if !ok {
+					newRM, ok := resources[key]
+					if !ok {
+						metrics, ok := result[key]
+						if !ok {
+							metrics = pmetric.NewMetrics()
+							result[key] = metrics
+						}
+						newRM = metrics.ResourceMetrics().AppendEmpty()
+						rm.Resource().CopyTo(newRM.Resource())
+						newRM.SetSchemaUrl(rm.SchemaUrl())
+						resources[key] = newRM
+					}
+					tgt = newRM.ScopeMetrics().AppendEmpty()
+					ils.Scope().CopyTo(tgt.Scope())
+					tgt.SetSchemaUrl(ils.SchemaUrl())
+					scopes[key] = tgt
+				}
+
+				metric.CopyTo(tgt.Metrics().AppendEmpty())
+			}
+		}
+	}
+
+	return result
+}
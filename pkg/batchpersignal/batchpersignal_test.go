@@ -4,6 +4,7 @@
 package batchpersignal
 
 import (
+	"strings"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
@@ -387,3 +388,159 @@ func TestSplitSameMetricIntoDifferentBatches(t *testing.T) {
 	assert.Equal(t, secondLibrary.Name(), batches[2].ResourceMetrics().At(0).ScopeMetrics().At(0).Scope().Name())
 	assert.Equal(t, thirdMetric.Name(), batches[2].ResourceMetrics().At(0).ScopeMetrics().At(0).Metrics().At(0).Name())
 }
+
+func TestSplitByResource(t *testing.T) {
+	// we have 2 resources, resulting in two batches of each signal
+	traces := ptrace.NewTraces()
+	logs := plog.NewLogs()
+	metrics := pmetric.NewMetrics()
+	for _, name := range []string{signalName1, signalName2} {
+		rs := traces.ResourceSpans().AppendEmpty()
+		rs.Resource().Attributes().PutStr("service.name", name)
+		rs.ScopeSpans().AppendEmpty().Spans().AppendEmpty().SetName(name)
+
+		rl := logs.ResourceLogs().AppendEmpty()
+		rl.Resource().Attributes().PutStr("service.name", name)
+		rl.ScopeLogs().AppendEmpty().LogRecords().AppendEmpty().Body().SetStr(name)
+
+		rm := metrics.ResourceMetrics().AppendEmpty()
+		rm.Resource().Attributes().PutStr("service.name", name)
+		rm.ScopeMetrics().AppendEmpty().Metrics().AppendEmpty().SetName(name)
+	}
+
+	// test
+	outTraces := SplitTracesByResource(traces)
+	outLogs := SplitLogsByResource(logs)
+	outMetrics := SplitMetricsByResource(metrics)
+
+	// verify
+	assert.Len(t, outTraces, 2)
+	assert.Len(t, outLogs, 2)
+	assert.Len(t, outMetrics, 2)
+	for i, name := range []string{signalName1, signalName2} {
+		assert.Equal(t, traces.ResourceSpans().At(i), outTraces[i].ResourceSpans().At(0))
+		assert.Equal(t, 1, outTraces[i].ResourceSpans().Len())
+		assert.Equal(t, name, outTraces[i].ResourceSpans().At(0).ScopeSpans().At(0).Spans().At(0).Name())
+
+		assert.Equal(t, logs.ResourceLogs().At(i), outLogs[i].ResourceLogs().At(0))
+		assert.Equal(t, 1, outLogs[i].ResourceLogs().Len())
+
+		assert.Equal(t, metrics.ResourceMetrics().At(i), outMetrics[i].ResourceMetrics().At(0))
+		assert.Equal(t, 1, outMetrics[i].ResourceMetrics().Len())
+	}
+}
+
+func TestSplitTracesByKey(t *testing.T) {
+	// we have 1 ResourceSpans with 2 ILS, each with spans of two keys, resulting in two batches, each with two ILS
+	inBatch := ptrace.NewTraces()
+	rs := inBatch.ResourceSpans().AppendEmpty()
+	rs.SetSchemaUrl(schemaURL)
+	rs.Resource().Attributes().PutStr("service.name", "svc")
+
+	for _, library := range []string{libraryOne, libraryTwo} {
+		ils := rs.ScopeSpans().AppendEmpty()
+		ils.SetSchemaUrl(schemaURL)
+		ils.Scope().SetName(library)
+		for _, name := range []string{signalName1, signalName2, signalName1} {
+			ils.Spans().AppendEmpty().SetName(name)
+		}
+	}
+
+	// test
+	out := SplitTracesByKey(inBatch, func(res pcommon.Resource, _ pcommon.InstrumentationScope, span ptrace.Span) string {
+		svc, _ := res.Attributes().Get("service.name")
+		return svc.Str() + "/" + span.Name()
+	})
+
+	// verify
+	assert.Len(t, out, 2)
+	for _, name := range []string{signalName1, signalName2} {
+		batch, ok := out["svc/"+name]
+		assert.True(t, ok)
+		assert.Equal(t, 1, batch.ResourceSpans().Len())
+
+		outRS := batch.ResourceSpans().At(0)
+		assert.Equal(t, rs.SchemaUrl(), outRS.SchemaUrl())
+		assert.Equal(t, rs.Resource(), outRS.Resource())
+		assert.Equal(t, 2, outRS.ScopeSpans().Len())
+		for i, library := range []string{libraryOne, libraryTwo} {
+			outILS := outRS.ScopeSpans().At(i)
+			assert.Equal(t, library, outILS.Scope().Name())
+			assert.Equal(t, schemaURL, outILS.SchemaUrl())
+			for j := 0; j < outILS.Spans().Len(); j++ {
+				assert.Equal(t, name, outILS.Spans().At(j).Name())
+			}
+		}
+	}
+	assert.Equal(t, 4, out["svc/"+signalName1].SpanCount())
+	assert.Equal(t, 2, out["svc/"+signalName2].SpanCount())
+}
+
+func TestSplitLogsByKey(t *testing.T) {
+	// we have 2 ResourceLogs with logs of the same key, resulting in one batch with two ResourceLogs
+	inBatch := plog.NewLogs()
+	for _, name := range []string{signalName1, signalName2} {
+		rl := inBatch.ResourceLogs().AppendEmpty()
+		rl.Resource().Attributes().PutStr("service.name", name)
+		sl := rl.ScopeLogs().AppendEmpty()
+		sl.Scope().SetName(libraryOne)
+		sl.LogRecords().AppendEmpty().Attributes().PutStr("tenant", "acme")
+		sl.LogRecords().AppendEmpty().Attributes().PutStr("tenant", "other")
+	}
+
+	// test
+	out := SplitLogsByKey(inBatch, func(_ pcommon.Resource, _ pcommon.InstrumentationScope, log plog.LogRecord) string {
+		tenant, _ := log.Attributes().Get("tenant")
+		return tenant.Str()
+	})
+
+	// verify
+	assert.Len(t, out, 2)
+	for _, tenant := range []string{"acme", "other"} {
+		batch := out[tenant]
+		assert.Equal(t, 2, batch.ResourceLogs().Len())
+		assert.Equal(t, 2, batch.LogRecordCount())
+		for i, name := range []string{signalName1, signalName2} {
+			outRL := batch.ResourceLogs().At(i)
+			svc, _ := outRL.Resource().Attributes().Get("service.name")
+			assert.Equal(t, name, svc.Str())
+			assert.Equal(t, libraryOne, outRL.ScopeLogs().At(0).Scope().Name())
+			value, _ := outRL.ScopeLogs().At(0).LogRecords().At(0).Attributes().Get("tenant")
+			assert.Equal(t, tenant, value.Str())
+		}
+	}
+}
+
+func TestSplitMetricsByKey(t *testing.T) {
+	// we have 1 ResourceMetrics with 1 ILS and three metrics of two keys, resulting in two batches
+	inBatch := pmetric.NewMetrics()
+	rm := inBatch.ResourceMetrics().AppendEmpty()
+	ils := rm.ScopeMetrics().AppendEmpty()
+	ils.Scope().SetName(libraryOne)
+	ils.Metrics().AppendEmpty().SetName(firstBatchFirstSignal)
+	ils.Metrics().AppendEmpty().SetName(secondBatchFirstSignal)
+	ils.Metrics().AppendEmpty().SetName(firstBatchSecondSignal)
+
+	// test
+	out := SplitMetricsByKey(inBatch, func(_ pcommon.Resource, _ pcommon.InstrumentationScope, metric pmetric.Metric) string {
+		if strings.HasPrefix(metric.Name(), "first-batch") {
+			return "first"
+		}
+		return "second"
+	})
+
+	// verify
+	assert.Len(t, out, 2)
+	first := out["first"].ResourceMetrics().At(0).ScopeMetrics().At(0)
+	assert.Equal(t, libraryOne, first.Scope().Name())
+	assert.Equal(t, 2, first.Metrics().Len())
+	assert.Equal(t, firstBatchFirstSignal, first.Metrics().At(0).Name())
+	assert.Equal(t, firstBatchSecondSignal, first.Metrics().At(1).Name())
+
+	second := out["second"].ResourceMetrics().At(0).ScopeMetrics().At(0)
+	assert.Equal(t, 1, second.Metrics().Len())
+	assert.Equal(t, secondBatchFirstSignal, second.Metrics().At(0).Name())
+
+	// the input isn't modified
+	assert.Equal(t, 3, inBatch.MetricCount())
+}
@@ -0,0 +1,263 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package batchpersignal // import "github.com/open-telemetry/opentelemetry-collector-contrib/pkg/batchpersignal"
+
+import (
+	"go.opentelemetry.io/collector/pdata/pcommon"
+	"go.opentelemetry.io/collector/pdata/plog"
+	"go.opentelemetry.io/collector/pdata/pmetric"
+	"go.opentelemetry.io/collector/pdata/ptrace"
+)
+
+// MoveTracesByKey groups the spans of the given ptrace.Traces input by the key returned for each of them by keyFunc,
+// like SplitTracesByKey, but moves the data instead of copying it: the resources and scopes whose spans all have the
+// same key are moved as they are, and only the resources and scopes of the resources with several keys are copied.
+// The input is left empty, so it must be owned by the caller, e.g. by a consumer declaring that it mutates data.
+func MoveTracesByKey(batch ptrace.Traces, keyFunc func(pcommon.Resource, pcommon.InstrumentationScope, ptrace.Span) string) map[string]ptrace.Traces {
+	result := map[string]ptrace.Traces{}
+	destination := func(key string) ptrace.ResourceSpansSlice {
+		traces, ok := result[key]
+		if !ok {
+			traces = ptrace.NewTraces()
+			result[key] = traces
+		}
+		return traces.ResourceSpans()
+	}
+
+	// the keys of the spans of the current resource, reused across resources
+	var keys []string
+	for i := 0; i < batch.ResourceSpans().Len(); i++ {
+		rs := batch.ResourceSpans().At(i)
+
+		keys = keys[:0]
+		for j := 0; j < rs.ScopeSpans().Len(); j++ {
+			ils := rs.ScopeSpans().At(j)
+			for k := 0; k < ils.Spans().Len(); k++ {
+				keys = append(keys, keyFunc(rs.Resource(), ils.Scope(), ils.Spans().At(k)))
+			}
+		}
+		if len(keys) == 0 {
+			continue
+		}
+		if sameKeys(keys) {
+			rs.MoveTo(destination(keys[0]).AppendEmpty())
+			continue
+		}
+
+		// the resource of each key for this resource
+		resources := map[string]ptrace.ResourceSpans{}
+		resourceFor := func(key string) ptrace.ResourceSpans {
+			newRS, ok := resources[key]
+			if !ok {
+				newRS = destination(key).AppendEmpty()
+				rs.Resource().CopyTo(newRS.Resource())
+				newRS.SetSchemaUrl(rs.SchemaUrl())
+				resources[key] = newRS
+			}
+			return newRS
+		}
+
+		offset := 0
+		for j := 0; j < rs.ScopeSpans().Len(); j++ {
+			ils := rs.ScopeSpans().At(j)
+			scopeKeys := keys[offset : offset+ils.Spans().Len()]
+			offset += ils.Spans().Len()
+			if len(scopeKeys) == 0 {
+				continue
+			}
+			if sameKeys(scopeKeys) {
+				ils.MoveTo(resourceFor(scopeKeys[0]).ScopeSpans().AppendEmpty())
+				continue
+			}
+
+			// the scope of each key for this scope
+			scopes := map[string]ptrace.ScopeSpans{}
+			for k, key := range scopeKeys {
+				tgt, ok := scopes[key]
+				if !ok {
+					tgt = resourceFor(key).ScopeSpans().AppendEmpty()
+					ils.Scope().CopyTo(tgt.Scope())
+					tgt.SetSchemaUrl(ils.SchemaUrl())
+					scopes[key] = tgt
+				}
+				ils.Spans().At(k).MoveTo(tgt.Spans().AppendEmpty())
+			}
+		}
+	}
+
+	batch.ResourceSpans().RemoveIf(func(ptrace.ResourceSpans) bool { return true })
+	return result
+}
+
+// MoveLogsByKey groups the log records of the given plog.Logs input by the key returned for each of them by keyFunc,
+// like SplitLogsByKey, but moves the data instead of copying it: the resources and scopes whose log records all have
+// the same key are moved as they are, and only the resources and scopes of the resources with several keys are
+// copied. The input is left empty, so it must be owned by the caller, e.g. by a consumer declaring that it mutates
+// data.
+func MoveLogsByKey(batch plog.Logs, keyFunc func(pcommon.Resource, pcommon.InstrumentationScope, plog.LogRecord) string) map[string]plog.Logs {
+	result := map[string]plog.Logs{}
+	destination := func(key string) plog.ResourceLogsSlice {
+		logs, ok := result[key]
+		if !ok {
+			logs = plog.NewLogs()
+			result[key] = logs
+		}
+		return logs.ResourceLogs()
+	}
+
+	// the keys of the log records of the current resource, reused across resources
+	var keys []string
+	for i := 0; i < batch.ResourceLogs().Len(); i++ {
+		rl := batch.ResourceLogs().At(i)
+
+		keys = keys[:0]
+		for j := 0; j < rl.ScopeLogs().Len(); j++ {
+			sl := rl.ScopeLogs().At(j)
+			for k := 0; k < sl.LogRecords().Len(); k++ {
+				keys = append(keys, keyFunc(rl.Resource(), sl.Scope(), sl.LogRecords().At(k)))
+			}
+		}
+		if len(keys) == 0 {
+			continue
+		}
+		if sameKeys(keys) {
+			rl.MoveTo(destination(keys[0]).AppendEmpty())
+			continue
+		}
+
+		// the resource of each key for this resource
+		resources := map[string]plog.ResourceLogs{}
+		resourceFor := func(key string) plog.ResourceLogs {
+			newRL, ok := resources[key]
+			if !ok {
+				newRL = destination(key).AppendEmpty()
+				rl.Resource().CopyTo(newRL.Resource())
+				newRL.SetSchemaUrl(rl.SchemaUrl())
+				resources[key] = newRL
+			}
+			return newRL
+		}
+
+		offset := 0
+		for j := 0; j < rl.ScopeLogs().Len(); j++ {
+			sl := rl.ScopeLogs().At(j)
+			scopeKeys := keys[offset : offset+sl.LogRecords().Len()]
+			offset += sl.LogRecords().Len()
+			if len(scopeKeys) == 0 {
+				continue
+			}
+			if sameKeys(scopeKeys) {
+				sl.MoveTo(resourceFor(scopeKeys[0]).ScopeLogs().AppendEmpty())
+				continue
+			}
+
+			// the scope of each key for this scope
+			scopes := map[string]plog.ScopeLogs{}
+			for k, key := range scopeKeys {
+				tgt, ok := scopes[key]
+				if !ok {
+					tgt = resourceFor(key).ScopeLogs().AppendEmpty()
+					sl.Scope().CopyTo(tgt.Scope())
+					tgt.SetSchemaUrl(sl.SchemaUrl())
+					scopes[key] = tgt
+				}
+				sl.LogRecords().At(k).MoveTo(tgt.LogRecords().AppendEmpty())
+			}
+		}
+	}
+
+	batch.ResourceLogs().RemoveIf(func(plog.ResourceLogs) bool { return true })
+	return result
+}
+
+// MoveMetricsByKey groups the metrics of the given pmetric.Metrics input by the key returned for each of them by
+// keyFunc, like SplitMetricsByKey, but moves the data instead of copying it: the resources and scopes whose metrics
+// all have the same key are moved as they are, and only the resources and scopes of the resources with several keys
+// are copied. The input is left empty, so it must be owned by the caller, e.g. by a consumer declaring that it
+// mutates data.
+func MoveMetricsByKey(batch pmetric.Metrics, keyFunc func(pcommon.Resource, pcommon.InstrumentationScope, pmetric.Metric) string) map[string]pmetric.Metrics {
+	result := map[string]pmetric.Metrics{}
+	destination := func(key string) pmetric.ResourceMetricsSlice {
+		metrics, ok := result[key]
+		if !ok {
+			metrics = pmetric.NewMetrics()
+			result[key] = metrics
+		}
+		return metrics.ResourceMetrics()
+	}
+
+	// the keys of the metrics of the current resource, reused across resources
+	var keys []string
+	for i := 0; i < batch.ResourceMetrics().Len(); i++ {
+		rm := batch.ResourceMetrics().At(i)
+
+		keys = keys[:0]
+		for j := 0; j < rm.ScopeMetrics().Len(); j++ {
+			ils := rm.ScopeMetrics().At(j)
+			for k := 0; k < ils.Metrics().Len(); k++ {
+				keys = append(keys, keyFunc(rm.Resource(), ils.Scope(), ils.Metrics().At(k)))
+			}
+		}
+		if len(keys) == 0 {
+			continue
+		}
+		if sameKeys(keys) {
+			rm.MoveTo(destination(keys[0]).AppendEmpty())
+			continue
+		}
+
+		// the resource of each key for this resource
+		resources := map[string]pmetric.ResourceMetrics{}
+		resourceFor := func(key string) pmetric.ResourceMetrics {
+			newRM, ok := resources[key]
+			if !ok {
+				newRM = destination(key).AppendEmpty()
+				rm.Resource().CopyTo(newRM.Resource())
+				newRM.SetSchemaUrl(rm.SchemaUrl())
+				resources[key] = newRM
+			}
+			return newRM
+		}
+
+		offset := 0
+		for j := 0; j < rm.ScopeMetrics().Len(); j++ {
+			ils := rm.ScopeMetrics().At(j)
+			scopeKeys := keys[offset : offset+ils.Metrics().Len()]
+			offset += ils.Metrics().Len()
+			if len(scopeKeys) == 0 {
+				continue
+			}
+			if sameKeys(scopeKeys) {
+				ils.MoveTo(resourceFor(scopeKeys[0]).ScopeMetrics().AppendEmpty())
+				continue
+			}
+
+			// the scope of each key for this scope
+			scopes := map[string]pmetric.ScopeMetrics{}
+			for k, key := range scopeKeys {
+				tgt, ok := scopes[key]
+				if !ok {
+					tgt = resourceFor(key).ScopeMetrics().AppendEmpty()
+					ils.Scope().CopyTo(tgt.Scope())
+					tgt.SetSchemaUrl(ils.SchemaUrl())
+					scopes[key] = tgt
+				}
+				ils.Metrics().At(k).MoveTo(tgt.Metrics().AppendEmpty())
+			}
+		}
+	}
+
+	batch.ResourceMetrics().RemoveIf(func(pmetric.ResourceMetrics) bool { return true })
+	return result
+}
+
+// sameKeys returns whether all the keys are the same.
+func sameKeys(keys []string) bool {
+	for _, key := range keys[1:] {
+		if key != keys[0] {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,165 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package batchpersignal
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"go.opentelemetry.io/collector/pdata/pcommon"
+	"go.opentelemetry.io/collector/pdata/plog"
+	"go.opentelemetry.io/collector/pdata/pmetric"
+	"go.opentelemetry.io/collector/pdata/ptrace"
+)
+
+// generateTraces returns resources whose scopes have the spans of a single tenant, of two tenants in different
+// scopes, or of two tenants in the same scope.
+func generateTraces(resources int) ptrace.Traces {
+	td := ptrace.NewTraces()
+	for i := 0; i < resources; i++ {
+		rs := td.ResourceSpans().AppendEmpty()
+		rs.SetSchemaUrl(schemaURL)
+		rs.Resource().Attributes().PutStr("service.name", fmt.Sprintf("service-%d", i))
+		for j, library := range []string{libraryOne, libraryTwo} {
+			ils := rs.ScopeSpans().AppendEmpty()
+			ils.Scope().SetName(library)
+			for k := 0; k < 4; k++ {
+				span := ils.Spans().AppendEmpty()
+				span.SetName(fmt.Sprintf("%d-%d-%d", i, j, k))
+				span.Attributes().PutStr("tenant", tenant(i, j, k))
+			}
+		}
+	}
+	return td
+}
+
+func generateLogs(resources int) plog.Logs {
+	ld := plog.NewLogs()
+	for i := 0; i < resources; i++ {
+		rl := ld.ResourceLogs().AppendEmpty()
+		rl.Resource().Attributes().PutStr("service.name", fmt.Sprintf("service-%d", i))
+		for j, library := range []string{libraryOne, libraryTwo} {
+			sl := rl.ScopeLogs().AppendEmpty()
+			sl.Scope().SetName(library)
+			for k := 0; k < 4; k++ {
+				log := sl.LogRecords().AppendEmpty()
+				log.Body().SetStr(fmt.Sprintf("%d-%d-%d", i, j, k))
+				log.Attributes().PutStr("tenant", tenant(i, j, k))
+			}
+		}
+	}
+	return ld
+}
+
+func generateMetrics(resources int) pmetric.Metrics {
+	md := pmetric.NewMetrics()
+	for i := 0; i < resources; i++ {
+		rm := md.ResourceMetrics().AppendEmpty()
+		rm.Resource().Attributes().PutStr("service.name", fmt.Sprintf("service-%d", i))
+		for j, library := range []string{libraryOne, libraryTwo} {
+			ils := rm.ScopeMetrics().AppendEmpty()
+			ils.Scope().SetName(library)
+			for k := 0; k < 4; k++ {
+				metric := ils.Metrics().AppendEmpty()
+				metric.SetName(tenant(i, j, k) + fmt.Sprintf("/%d-%d-%d", i, j, k))
+				metric.SetEmptyGauge().DataPoints().AppendEmpty().SetIntValue(int64(k))
+			}
+		}
+	}
+	return md
+}
+
+func tenant(resource, scope, record int) string {
+	switch resource % 3 {
+	case 0:
+		// all the records of the resource have the same key
+		return "acme"
+	case 1:
+		// all the records of each scope have the same key
+		return fmt.Sprintf("tenant-%d", scope)
+	default:
+		return fmt.Sprintf("tenant-%d", record%2)
+	}
+}
+
+func spanTenant(_ pcommon.Resource, _ pcommon.InstrumentationScope, span ptrace.Span) string {
+	v, _ := span.Attributes().Get("tenant")
+	return v.Str()
+}
+
+func logTenant(_ pcommon.Resource, _ pcommon.InstrumentationScope, log plog.LogRecord) string {
+	v, _ := log.Attributes().Get("tenant")
+	return v.Str()
+}
+
+func metricTenant(_ pcommon.Resource, _ pcommon.InstrumentationScope, metric pmetric.Metric) string {
+	for i := range metric.Name() {
+		if metric.Name()[i] == '/' {
+			return metric.Name()[:i]
+		}
+	}
+	return metric.Name()
+}
+
+func TestMoveTracesByKey(t *testing.T) {
+	td := generateTraces(6)
+	expected := SplitTracesByKey(td, spanTenant)
+
+	out := MoveTracesByKey(td, spanTenant)
+
+	assert.Equal(t, expected, out)
+	assert.Len(t, out, 3)
+	assert.Equal(t, 0, td.ResourceSpans().Len())
+}
+
+func TestMoveLogsByKey(t *testing.T) {
+	ld := generateLogs(6)
+	expected := SplitLogsByKey(ld, logTenant)
+
+	out := MoveLogsByKey(ld, logTenant)
+
+	assert.Equal(t, expected, out)
+	assert.Len(t, out, 3)
+	assert.Equal(t, 0, ld.ResourceLogs().Len())
+}
+
+func TestMoveMetricsByKey(t *testing.T) {
+	md := generateMetrics(6)
+	expected := SplitMetricsByKey(md, metricTenant)
+
+	out := MoveMetricsByKey(md, metricTenant)
+
+	assert.Equal(t, expected, out)
+	assert.Len(t, out, 3)
+	assert.Equal(t, 0, md.ResourceMetrics().Len())
+}
+
+func TestMoveEmpty(t *testing.T) {
+	td := ptrace.NewTraces()
+	// resources and scopes without spans are dropped
+	td.ResourceSpans().AppendEmpty().ScopeSpans().AppendEmpty()
+	assert.Empty(t, MoveTracesByKey(td, spanTenant))
+	assert.Empty(t, MoveLogsByKey(plog.NewLogs(), logTenant))
+	assert.Empty(t, MoveMetricsByKey(pmetric.NewMetrics(), metricTenant))
+}
+
+func BenchmarkSplitTracesByKey(b *testing.B) {
+	td := generateTraces(100)
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		SplitTracesByKey(td, spanTenant)
+	}
+}
+
+func BenchmarkMoveTracesByKey(b *testing.B) {
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		b.StopTimer()
+		td := generateTraces(100)
+		b.StartTimer()
+		MoveTracesByKey(td, spanTenant)
+	}
+}
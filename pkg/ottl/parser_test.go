@@ -13,6 +13,7 @@ import (
 	"time"
 
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 	"go.opentelemetry.io/collector/component/componenttest"
 
 	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl/ottltest"
@@ -2337,3 +2338,68 @@ func Test_ConditionSequence_Eval_Error(t *testing.T) {
 		})
 	}
 }
+
+func Test_ParseValueExpression(t *testing.T) {
+	p, _ := NewParser(
+		CreateFactoryMap[any](createFactory("Hello", &struct{}{}, hello)),
+		testParsePath[any],
+		componenttest.NewNopTelemetrySettings(),
+		WithEnumParser[any](testParseEnum),
+	)
+
+	tests := []struct {
+		name       string
+		expression string
+		tCtx       any
+		expected   any
+	}{
+		{
+			name:       "literal",
+			expression: `"foo"`,
+			expected:   "foo",
+		},
+		{
+			name:       "math expression",
+			expression: `1 + 2 * 3`,
+			expected:   int64(7),
+		},
+		{
+			name:       "path",
+			expression: `attributes["foo"]`,
+			tCtx:       "bar",
+			expected:   "bar",
+		},
+		{
+			name:       "converter",
+			expression: `Hello()`,
+			expected:   "world",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			expr, err := p.ParseValueExpression(tt.expression)
+			require.NoError(t, err)
+			val, err := expr.Eval(context.Background(), tt.tCtx)
+			require.NoError(t, err)
+			assert.Equal(t, tt.expected, val)
+		})
+	}
+}
+
+func Test_ParseValueExpression_Error(t *testing.T) {
+	p, _ := NewParser(
+		CreateFactoryMap[any](),
+		testParsePath[any],
+		componenttest.NewNopTelemetrySettings(),
+		WithEnumParser[any](testParseEnum),
+	)
+
+	for _, expression := range []string{
+		`attributes[`,
+		`set(name, "foo")`,
+		`Unknown()`,
+	} {
+		_, err := p.ParseValueExpression(expression)
+		assert.Error(t, err, expression)
+	}
+}
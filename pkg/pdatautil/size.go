@@ -0,0 +1,180 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package pdatautil // import "github.com/open-telemetry/opentelemetry-collector-contrib/pkg/pdatautil"
+
+import (
+	"go.opentelemetry.io/collector/pdata/plog"
+	"go.opentelemetry.io/collector/pdata/pmetric"
+	"go.opentelemetry.io/collector/pdata/ptrace"
+)
+
+// DefaultSizeSampleSize is the number of records measured by the SizeEstimator returned by NewSizeEstimator when
+// the given sample size isn't positive.
+const DefaultSizeSampleSize = 128
+
+var (
+	logsSizer    plog.ProtoMarshaler
+	tracesSizer  ptrace.ProtoMarshaler
+	metricsSizer pmetric.ProtoMarshaler
+)
+
+// SizeEstimator estimates the size of pdata payloads once marshaled to OTLP protobuf, e.g. to decide how to batch
+// or split them before sending them, without marshaling them. The resources and scopes of the payload are measured,
+// and the size of its records (log records, spans or metrics) is extrapolated from an evenly spread sample of them.
+// Payloads with no more records than the sample size are measured exactly.
+type SizeEstimator struct {
+	sampleSize int
+}
+
+// NewSizeEstimator returns a SizeEstimator measuring up to sampleSize records per payload.
+func NewSizeEstimator(sampleSize int) SizeEstimator {
+	if sampleSize <= 0 {
+		sampleSize = DefaultSizeSampleSize
+	}
+	return SizeEstimator{sampleSize: sampleSize}
+}
+
+// LogsSize returns the estimated size of the logs marshaled to OTLP protobuf.
+func (e SizeEstimator) LogsSize(ld plog.Logs) int {
+	total := ld.LogRecordCount()
+	if total <= e.sampleSize {
+		return logsSizer.LogsSize(ld)
+	}
+
+	// the sample holds all the resources and scopes, to measure them without the records first
+	sample := plog.NewLogs()
+	for i := 0; i < ld.ResourceLogs().Len(); i++ {
+		rl := ld.ResourceLogs().At(i)
+		srl := sample.ResourceLogs().AppendEmpty()
+		rl.Resource().CopyTo(srl.Resource())
+		srl.SetSchemaUrl(rl.SchemaUrl())
+		for j := 0; j < rl.ScopeLogs().Len(); j++ {
+			sl := rl.ScopeLogs().At(j)
+			ssl := srl.ScopeLogs().AppendEmpty()
+			sl.Scope().CopyTo(ssl.Scope())
+			ssl.SetSchemaUrl(sl.SchemaUrl())
+		}
+	}
+	overhead := logsSizer.LogsSize(sample)
+
+	s := newSampler(total, e.sampleSize)
+	for i := 0; i < ld.ResourceLogs().Len(); i++ {
+		rl := ld.ResourceLogs().At(i)
+		for j := 0; j < rl.ScopeLogs().Len(); j++ {
+			records := rl.ScopeLogs().At(j).LogRecords()
+			sampled := sample.ResourceLogs().At(i).ScopeLogs().At(j).LogRecords()
+			for k := 0; k < records.Len(); k++ {
+				if s.next() {
+					records.At(k).CopyTo(sampled.AppendEmpty())
+				}
+			}
+		}
+	}
+	return s.extrapolate(overhead, logsSizer.LogsSize(sample))
+}
+
+// TracesSize returns the estimated size of the traces marshaled to OTLP protobuf.
+func (e SizeEstimator) TracesSize(td ptrace.Traces) int {
+	total := td.SpanCount()
+	if total <= e.sampleSize {
+		return tracesSizer.TracesSize(td)
+	}
+
+	sample := ptrace.NewTraces()
+	for i := 0; i < td.ResourceSpans().Len(); i++ {
+		rs := td.ResourceSpans().At(i)
+		srs := sample.ResourceSpans().AppendEmpty()
+		rs.Resource().CopyTo(srs.Resource())
+		srs.SetSchemaUrl(rs.SchemaUrl())
+		for j := 0; j < rs.ScopeSpans().Len(); j++ {
+			ss := rs.ScopeSpans().At(j)
+			sss := srs.ScopeSpans().AppendEmpty()
+			ss.Scope().CopyTo(sss.Scope())
+			sss.SetSchemaUrl(ss.SchemaUrl())
+		}
+	}
+	overhead := tracesSizer.TracesSize(sample)
+
+	s := newSampler(total, e.sampleSize)
+	for i := 0; i < td.ResourceSpans().Len(); i++ {
+		rs := td.ResourceSpans().At(i)
+		for j := 0; j < rs.ScopeSpans().Len(); j++ {
+			spans := rs.ScopeSpans().At(j).Spans()
+			sampled := sample.ResourceSpans().At(i).ScopeSpans().At(j).Spans()
+			for k := 0; k < spans.Len(); k++ {
+				if s.next() {
+					spans.At(k).CopyTo(sampled.AppendEmpty())
+				}
+			}
+		}
+	}
+	return s.extrapolate(overhead, tracesSizer.TracesSize(sample))
+}
+
+// MetricsSize returns the estimated size of the metrics marshaled to OTLP protobuf. The metrics, with all their
+// data points, are the sampled records.
+func (e SizeEstimator) MetricsSize(md pmetric.Metrics) int {
+	total := md.MetricCount()
+	if total <= e.sampleSize {
+		return metricsSizer.MetricsSize(md)
+	}
+
+	sample := pmetric.NewMetrics()
+	for i := 0; i < md.ResourceMetrics().Len(); i++ {
+		rm := md.ResourceMetrics().At(i)
+		srm := sample.ResourceMetrics().AppendEmpty()
+		rm.Resource().CopyTo(srm.Resource())
+		srm.SetSchemaUrl(rm.SchemaUrl())
+		for j := 0; j < rm.ScopeMetrics().Len(); j++ {
+			sm := rm.ScopeMetrics().At(j)
+			ssm := srm.ScopeMetrics().AppendEmpty()
+			sm.Scope().CopyTo(ssm.Scope())
+			ssm.SetSchemaUrl(sm.SchemaUrl())
+		}
+	}
+	overhead := metricsSizer.MetricsSize(sample)
+
+	s := newSampler(total, e.sampleSize)
+	for i := 0; i < md.ResourceMetrics().Len(); i++ {
+		rm := md.ResourceMetrics().At(i)
+		for j := 0; j < rm.ScopeMetrics().Len(); j++ {
+			metrics := rm.ScopeMetrics().At(j).Metrics()
+			sampled := sample.ResourceMetrics().At(i).ScopeMetrics().At(j).Metrics()
+			for k := 0; k < metrics.Len(); k++ {
+				if s.next() {
+					metrics.At(k).CopyTo(sampled.AppendEmpty())
+				}
+			}
+		}
+	}
+	return s.extrapolate(overhead, metricsSizer.MetricsSize(sample))
+}
+
+// sampler selects every stride-th record of a payload.
+type sampler struct {
+	total   int
+	stride  int
+	index   int
+	sampled int
+}
+
+func newSampler(total, sampleSize int) *sampler {
+	return &sampler{total: total, stride: (total + sampleSize - 1) / sampleSize}
+}
+
+// next returns whether the next record is part of the sample.
+func (s *sampler) next() bool {
+	selected := s.index%s.stride == 0
+	s.index++
+	if selected {
+		s.sampled++
+	}
+	return selected
+}
+
+// extrapolate returns the size of the payload from the size of its resources and scopes, and the size of the
+// sample.
+func (s *sampler) extrapolate(overhead, sampleSize int) int {
+	return overhead + (sampleSize-overhead)*s.total/s.sampled
+}
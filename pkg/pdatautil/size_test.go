@@ -0,0 +1,126 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package pdatautil
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"go.opentelemetry.io/collector/pdata/plog"
+	"go.opentelemetry.io/collector/pdata/pmetric"
+	"go.opentelemetry.io/collector/pdata/ptrace"
+)
+
+func generateLogs(resources, records int) plog.Logs {
+	ld := plog.NewLogs()
+	for i := 0; i < resources; i++ {
+		rl := ld.ResourceLogs().AppendEmpty()
+		rl.Resource().Attributes().PutStr("service.name", fmt.Sprintf("service-%d", i))
+		sl := rl.ScopeLogs().AppendEmpty()
+		sl.Scope().SetName("scope")
+		for j := 0; j < records; j++ {
+			lr := sl.LogRecords().AppendEmpty()
+			// bodies of varying length
+			lr.Body().SetStr(strings.Repeat("x", 10+j%50))
+			lr.Attributes().PutInt("index", int64(j))
+		}
+	}
+	return ld
+}
+
+func generateTraces(resources, spans int) ptrace.Traces {
+	td := ptrace.NewTraces()
+	for i := 0; i < resources; i++ {
+		rs := td.ResourceSpans().AppendEmpty()
+		rs.Resource().Attributes().PutStr("service.name", fmt.Sprintf("service-%d", i))
+		ss := rs.ScopeSpans().AppendEmpty()
+		for j := 0; j < spans; j++ {
+			span := ss.Spans().AppendEmpty()
+			span.SetName(fmt.Sprintf("operation-%d", j%7))
+			span.Attributes().PutStr("http.route", strings.Repeat("/path", 1+j%5))
+		}
+	}
+	return td
+}
+
+func generateMetrics(resources, metrics int) pmetric.Metrics {
+	md := pmetric.NewMetrics()
+	for i := 0; i < resources; i++ {
+		rm := md.ResourceMetrics().AppendEmpty()
+		rm.Resource().Attributes().PutStr("service.name", fmt.Sprintf("service-%d", i))
+		sm := rm.ScopeMetrics().AppendEmpty()
+		for j := 0; j < metrics; j++ {
+			m := sm.Metrics().AppendEmpty()
+			m.SetName(fmt.Sprintf("metric-%d", j))
+			dps := m.SetEmptyGauge().DataPoints()
+			for k := 0; k < 1+j%3; k++ {
+				dps.AppendEmpty().SetIntValue(int64(k))
+			}
+		}
+	}
+	return md
+}
+
+func TestSizeEstimatorExactForSmallPayloads(t *testing.T) {
+	e := NewSizeEstimator(100)
+
+	ld := generateLogs(2, 50)
+	assert.Equal(t, logsSizer.LogsSize(ld), e.LogsSize(ld))
+
+	td := generateTraces(2, 50)
+	assert.Equal(t, tracesSizer.TracesSize(td), e.TracesSize(td))
+
+	md := generateMetrics(2, 50)
+	assert.Equal(t, metricsSizer.MetricsSize(md), e.MetricsSize(md))
+
+	assert.Equal(t, 0, e.LogsSize(plog.NewLogs()))
+}
+
+func TestSizeEstimatorSampled(t *testing.T) {
+	e := NewSizeEstimator(0)
+
+	ld := generateLogs(5, 2000)
+	assert.InEpsilon(t, logsSizer.LogsSize(ld), e.LogsSize(ld), 0.05)
+
+	td := generateTraces(5, 2000)
+	assert.InEpsilon(t, tracesSizer.TracesSize(td), e.TracesSize(td), 0.05)
+
+	md := generateMetrics(5, 2000)
+	assert.InEpsilon(t, metricsSizer.MetricsSize(md), e.MetricsSize(md), 0.05)
+}
+
+func TestSizeEstimatorUnevenResources(t *testing.T) {
+	e := NewSizeEstimator(10)
+
+	// a resource with a few large records, and another one with many small records
+	ld := plog.NewLogs()
+	large := ld.ResourceLogs().AppendEmpty().ScopeLogs().AppendEmpty()
+	for i := 0; i < 10; i++ {
+		large.LogRecords().AppendEmpty().Body().SetStr(strings.Repeat("x", 1000))
+	}
+	small := ld.ResourceLogs().AppendEmpty().ScopeLogs().AppendEmpty()
+	for i := 0; i < 90; i++ {
+		small.LogRecords().AppendEmpty().Body().SetStr("x")
+	}
+	assert.InEpsilon(t, logsSizer.LogsSize(ld), e.LogsSize(ld), 0.1)
+}
+
+func BenchmarkSizeEstimatorLogs(b *testing.B) {
+	e := NewSizeEstimator(0)
+	ld := generateLogs(10, 10000)
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		e.LogsSize(ld)
+	}
+}
+
+func BenchmarkProtoSizerLogs(b *testing.B) {
+	ld := generateLogs(10, 10000)
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		logsSizer.LogsSize(ld)
+	}
+}
@@ -0,0 +1,85 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package pdatautil // import "github.com/open-telemetry/opentelemetry-collector-contrib/pkg/pdatautil"
+
+import (
+	"go.opentelemetry.io/collector/pdata/plog"
+	"go.opentelemetry.io/collector/pdata/pmetric"
+	"go.opentelemetry.io/collector/pdata/ptrace"
+)
+
+// SplitLogs splits the logs by resource in chunks whose estimated size doesn't exceed maxBytes, e.g. to keep each
+// request of a size-limited exporter under its limit. A resource larger than maxBytes is kept in its own chunk. The
+// logs are returned as is when they don't need to be split, and copied otherwise.
+func (e SizeEstimator) SplitLogs(ld plog.Logs, maxBytes int) []plog.Logs {
+	if maxBytes <= 0 || ld.ResourceLogs().Len() < 2 || e.LogsSize(ld) <= maxBytes {
+		return []plog.Logs{ld}
+	}
+	var chunks []plog.Logs
+	var current plog.Logs
+	currentSize := 0
+	for i := 0; i < ld.ResourceLogs().Len(); i++ {
+		single := plog.NewLogs()
+		ld.ResourceLogs().At(i).CopyTo(single.ResourceLogs().AppendEmpty())
+		size := e.LogsSize(single)
+		if len(chunks) == 0 || currentSize+size > maxBytes {
+			current, currentSize = single, size
+			chunks = append(chunks, current)
+			continue
+		}
+		single.ResourceLogs().MoveAndAppendTo(current.ResourceLogs())
+		currentSize += size
+	}
+	return chunks
+}
+
+// SplitTraces splits the traces by resource in chunks whose estimated size doesn't exceed maxBytes. A resource
+// larger than maxBytes is kept in its own chunk. The traces are returned as is when they don't need to be split,
+// and copied otherwise.
+func (e SizeEstimator) SplitTraces(td ptrace.Traces, maxBytes int) []ptrace.Traces {
+	if maxBytes <= 0 || td.ResourceSpans().Len() < 2 || e.TracesSize(td) <= maxBytes {
+		return []ptrace.Traces{td}
+	}
+	var chunks []ptrace.Traces
+	var current ptrace.Traces
+	currentSize := 0
+	for i := 0; i < td.ResourceSpans().Len(); i++ {
+		single := ptrace.NewTraces()
+		td.ResourceSpans().At(i).CopyTo(single.ResourceSpans().AppendEmpty())
+		size := e.TracesSize(single)
+		if len(chunks) == 0 || currentSize+size > maxBytes {
+			current, currentSize = single, size
+			chunks = append(chunks, current)
+			continue
+		}
+		single.ResourceSpans().MoveAndAppendTo(current.ResourceSpans())
+		currentSize += size
+	}
+	return chunks
+}
+
+// SplitMetrics splits the metrics by resource in chunks whose estimated size doesn't exceed maxBytes. A resource
+// larger than maxBytes is kept in its own chunk. The metrics are returned as is when they don't need to be split,
+// and copied otherwise.
+func (e SizeEstimator) SplitMetrics(md pmetric.Metrics, maxBytes int) []pmetric.Metrics {
+	if maxBytes <= 0 || md.ResourceMetrics().Len() < 2 || e.MetricsSize(md) <= maxBytes {
+		return []pmetric.Metrics{md}
+	}
+	var chunks []pmetric.Metrics
+	var current pmetric.Metrics
+	currentSize := 0
+	for i := 0; i < md.ResourceMetrics().Len(); i++ {
+		single := pmetric.NewMetrics()
+		md.ResourceMetrics().At(i).CopyTo(single.ResourceMetrics().AppendEmpty())
+		size := e.MetricsSize(single)
+		if len(chunks) == 0 || currentSize+size > maxBytes {
+			current, currentSize = single, size
+			chunks = append(chunks, current)
+			continue
+		}
+		single.ResourceMetrics().MoveAndAppendTo(current.ResourceMetrics())
+		currentSize += size
+	}
+	return chunks
+}
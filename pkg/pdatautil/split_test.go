@@ -0,0 +1,80 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package pdatautil
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/collector/pdata/plog"
+	"go.opentelemetry.io/collector/pdata/pmetric"
+	"go.opentelemetry.io/collector/pdata/ptrace"
+)
+
+// each resource is about 420 bytes once marshaled
+const resourcePayload = 400
+
+func logsWithResources(count int) plog.Logs {
+	ld := plog.NewLogs()
+	for i := 0; i < count; i++ {
+		rl := ld.ResourceLogs().AppendEmpty()
+		rl.ScopeLogs().AppendEmpty().LogRecords().AppendEmpty().Body().SetStr(strings.Repeat("x", resourcePayload))
+	}
+	return ld
+}
+
+func TestSplitLogs(t *testing.T) {
+	estimator := NewSizeEstimator(DefaultSizeSampleSize)
+	ld := logsWithResources(3)
+
+	assert.Len(t, estimator.SplitLogs(ld, 0), 1)
+	assert.Len(t, estimator.SplitLogs(ld, 10*resourcePayload), 1)
+
+	chunks := estimator.SplitLogs(ld, 1000)
+	require.Len(t, chunks, 2)
+	assert.Equal(t, 2, chunks[0].ResourceLogs().Len())
+	assert.Equal(t, 1, chunks[1].ResourceLogs().Len())
+	// the input isn't modified
+	assert.Equal(t, 3, ld.ResourceLogs().Len())
+
+	// resources larger than the limit are kept on their own
+	assert.Len(t, estimator.SplitLogs(ld, 100), 3)
+	// a single resource isn't split
+	assert.Len(t, estimator.SplitLogs(logsWithResources(1), 100), 1)
+}
+
+func TestSplitTraces(t *testing.T) {
+	estimator := NewSizeEstimator(DefaultSizeSampleSize)
+	td := ptrace.NewTraces()
+	for i := 0; i < 3; i++ {
+		span := td.ResourceSpans().AppendEmpty().ScopeSpans().AppendEmpty().Spans().AppendEmpty()
+		span.SetName(strings.Repeat("x", resourcePayload))
+	}
+
+	assert.Len(t, estimator.SplitTraces(td, 10*resourcePayload), 1)
+
+	chunks := estimator.SplitTraces(td, 1000)
+	require.Len(t, chunks, 2)
+	assert.Equal(t, 2, chunks[0].ResourceSpans().Len())
+	assert.Equal(t, 1, chunks[1].ResourceSpans().Len())
+}
+
+func TestSplitMetrics(t *testing.T) {
+	estimator := NewSizeEstimator(DefaultSizeSampleSize)
+	md := pmetric.NewMetrics()
+	for i := 0; i < 3; i++ {
+		m := md.ResourceMetrics().AppendEmpty().ScopeMetrics().AppendEmpty().Metrics().AppendEmpty()
+		m.SetName(strings.Repeat("x", resourcePayload))
+		m.SetEmptyGauge().DataPoints().AppendEmpty().SetIntValue(1)
+	}
+
+	assert.Len(t, estimator.SplitMetrics(md, 10*resourcePayload), 1)
+
+	chunks := estimator.SplitMetrics(md, 1000)
+	require.Len(t, chunks, 2)
+	assert.Equal(t, 2, chunks[0].ResourceMetrics().Len())
+	assert.Equal(t, 1, chunks[1].ResourceMetrics().Len())
+}
@@ -0,0 +1,71 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package reader // import "github.com/open-telemetry/opentelemetry-collector-contrib/pkg/stanza/fileconsumer/internal/reader"
+
+import (
+	"compress/gzip"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/stanza/fileconsumer/internal/fingerprint"
+)
+
+const (
+	// CompressionGzip decompresses the files with a .gz extension, typically archives created by log rotation
+	CompressionGzip = "gzip"
+
+	gzipExtension = ".gz"
+)
+
+// isCompressed returns true if the file must be decompressed when read
+func (f *Factory) isCompressed(file *os.File) bool {
+	return f.Compression == CompressionGzip && strings.HasSuffix(file.Name(), gzipExtension)
+}
+
+// newFingerprint returns the fingerprint of the file, computed on the decompressed content of a compressed file
+// so that the fingerprint of an archive matches the one of the file it was rotated from
+func newFingerprint(file *os.File, size int, compressed bool) (*fingerprint.Fingerprint, error) {
+	if !compressed {
+		return fingerprint.NewFromFile(file, size)
+	}
+	gz, err := newGzipReader(file)
+	if err != nil {
+		if errors.Is(err, io.EOF) || errors.Is(err, io.ErrUnexpectedEOF) {
+			// The archive is still being written, it will be fingerprinted in a later poll
+			return fingerprint.New([]byte{}), nil
+		}
+		return nil, err
+	}
+	buf := make([]byte, size)
+	n, err := io.ReadFull(gz, buf)
+	if err != nil && !errors.Is(err, io.EOF) && !errors.Is(err, io.ErrUnexpectedEOF) {
+		return nil, fmt.Errorf("reading fingerprint bytes: %w", err)
+	}
+	return fingerprint.New(buf[:n]), nil
+}
+
+// newGzipReader returns a reader of the decompressed content of the file, from its beginning
+func newGzipReader(file *os.File) (*gzip.Reader, error) {
+	gz, err := gzip.NewReader(io.NewSectionReader(file, 0, 1<<63-1))
+	if err != nil {
+		return nil, fmt.Errorf("reading gzip header: %w", err)
+	}
+	return gz, nil
+}
+
+// decompressedSize returns the size of the decompressed content of the file
+func decompressedSize(file *os.File) (int64, error) {
+	gz, err := newGzipReader(file)
+	if err != nil {
+		return 0, err
+	}
+	n, err := io.Copy(io.Discard, gz)
+	if err != nil && !errors.Is(err, io.ErrUnexpectedEOF) {
+		return 0, fmt.Errorf("decompress: %w", err)
+	}
+	return n, nil
+}
@@ -0,0 +1,123 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package reader
+
+import (
+	"bytes"
+	"compress/gzip"
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/stanza/fileconsumer/internal/filetest"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/stanza/fileconsumer/internal/fingerprint"
+)
+
+func gzipContent(t *testing.T, content string) []byte {
+	var buf bytes.Buffer
+	gz := gzip.NewWriter(&buf)
+	_, err := gz.Write([]byte(content))
+	require.NoError(t, err)
+	require.NoError(t, gz.Close())
+	return buf.Bytes()
+}
+
+func writeGzipFile(t *testing.T, path string, data []byte) *os.File {
+	require.NoError(t, os.WriteFile(path, data, 0600))
+	return filetest.OpenFile(t, path)
+}
+
+func TestCompressedFingerprint(t *testing.T) {
+	t.Parallel()
+
+	content := "testlog1\ntestlog2\n"
+	path := filepath.Join(t.TempDir(), "app.log.1.gz")
+	file := writeGzipFile(t, path, gzipContent(t, content))
+
+	f, _ := testFactory(t, withCompression(CompressionGzip))
+	fp, err := f.NewFingerprint(file)
+	require.NoError(t, err)
+	require.Equal(t, fingerprint.New([]byte(content)), fp)
+
+	// Without compression the archive is fingerprinted as is
+	f, _ = testFactory(t)
+	fp, err = f.NewFingerprint(file)
+	require.NoError(t, err)
+	require.NotEqual(t, fingerprint.New([]byte(content)), fp)
+}
+
+func TestCompressedReadFromOffset(t *testing.T) {
+	t.Parallel()
+
+	path := filepath.Join(t.TempDir(), "app.log.1.gz")
+	file := writeGzipFile(t, path, gzipContent(t, "testlog1\ntestlog2\ntestlog3\n"))
+
+	f, sink := testFactory(t, withCompression(CompressionGzip))
+	fp, err := f.NewFingerprint(file)
+	require.NoError(t, err)
+
+	// The archive was rotated from a file already read up to the second line
+	r, err := f.NewReaderFromMetadata(file, &Metadata{
+		Fingerprint:    fp,
+		Offset:         int64(len("testlog1\n")),
+		FileAttributes: map[string]any{},
+	})
+	require.NoError(t, err)
+	defer r.Close()
+
+	r.ReadToEnd(context.Background())
+	sink.ExpectTokens(t, []byte("testlog2"), []byte("testlog3"))
+	require.Equal(t, int64(len("testlog1\ntestlog2\ntestlog3\n")), r.Offset)
+
+	// A fully read archive is not read again
+	info, err := file.Stat()
+	require.NoError(t, err)
+	require.Equal(t, info.Size(), r.CompressedSize)
+	r.ReadToEnd(context.Background())
+	sink.ExpectNoCalls(t)
+}
+
+func TestCompressedPartialArchive(t *testing.T) {
+	t.Parallel()
+
+	data := gzipContent(t, "testlog1\ntestlog2\n")
+	path := filepath.Join(t.TempDir(), "app.log.1.gz")
+	// The archive is still being written by the rotation
+	file := writeGzipFile(t, path, data[:len(data)-8])
+
+	f, sink := testFactory(t, withCompression(CompressionGzip))
+	fp, err := f.NewFingerprint(file)
+	require.NoError(t, err)
+
+	r, err := f.NewReader(file, fp)
+	require.NoError(t, err)
+	defer r.Close()
+
+	r.ReadToEnd(context.Background())
+	sink.ExpectTokens(t, []byte("testlog1"), []byte("testlog2"))
+	require.Zero(t, r.CompressedSize)
+}
+
+func TestCompressedStartAtEnd(t *testing.T) {
+	t.Parallel()
+
+	content := "testlog1\ntestlog2\n"
+	path := filepath.Join(t.TempDir(), "app.log.1.gz")
+	file := writeGzipFile(t, path, gzipContent(t, content))
+
+	f, sink := testFactory(t, withCompression(CompressionGzip), fromEnd())
+	fp, err := f.NewFingerprint(file)
+	require.NoError(t, err)
+
+	r, err := f.NewReader(file, fp)
+	require.NoError(t, err)
+	defer r.Close()
+	require.Equal(t, int64(len(content)), r.Offset)
+
+	r.ReadToEnd(context.Background())
+	sink.ExpectNoCalls(t)
+}
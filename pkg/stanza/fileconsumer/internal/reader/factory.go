@@ -42,10 +42,11 @@ type Factory struct {
 	EmitFunc          emit.Callback
 	Attributes        attrs.Resolver
 	DeleteAtEOF       bool
+	Compression       string
 }
 
 func (f *Factory) NewFingerprint(file *os.File) (*fingerprint.Fingerprint, error) {
-	return fingerprint.NewFromFile(file, f.FingerprintSize)
+	return newFingerprint(file, f.FingerprintSize, f.isCompressed(file))
 }
 
 func (f *Factory) NewReader(file *os.File, fp *fingerprint.Fingerprint) (*Reader, error) {
@@ -73,12 +74,13 @@ func (f *Factory) NewReaderFromMetadata(file *os.File, m *Metadata) (r *Reader,
 		decoder:           decode.New(f.Encoding),
 		lineSplitFunc:     f.SplitFunc,
 		deleteAtEOF:       f.DeleteAtEOF,
+		compressed:        f.isCompressed(file),
 	}
 	r.set.Logger = r.set.Logger.With(zap.String("path", r.fileName))
 
 	if r.Fingerprint.Len() > r.fingerprintSize {
 		// User has reconfigured fingerprint_size
-		shorter, rereadErr := fingerprint.NewFromFile(file, r.fingerprintSize)
+		shorter, rereadErr := newFingerprint(file, r.fingerprintSize, r.compressed)
 		if rereadErr != nil {
 			return nil, fmt.Errorf("reread fingerprint: %w", err)
 		}
@@ -89,11 +91,17 @@ func (f *Factory) NewReaderFromMetadata(file *os.File, m *Metadata) (r *Reader,
 	}
 
 	if !f.FromBeginning {
-		var info os.FileInfo
-		if info, err = r.file.Stat(); err != nil {
-			return nil, fmt.Errorf("stat: %w", err)
+		if r.compressed {
+			if r.Offset, err = decompressedSize(r.file); err != nil {
+				return nil, err
+			}
+		} else {
+			var info os.FileInfo
+			if info, err = r.file.Stat(); err != nil {
+				return nil, fmt.Errorf("stat: %w", err)
+			}
+			r.Offset = info.Size()
 		}
-		r.Offset = info.Size()
 	}
 
 	flushFunc := m.FlushState.Func(f.SplitFunc, f.FlushTimeout)
@@ -5,8 +5,11 @@ package reader // import "github.com/open-telemetry/opentelemetry-collector-cont
 
 import (
 	"bufio"
+	"compress/gzip"
 	"context"
 	"errors"
+	"fmt"
+	"io"
 	"os"
 
 	"go.opentelemetry.io/collector/component"
@@ -26,6 +29,9 @@ type Metadata struct {
 	FileAttributes  map[string]any
 	HeaderFinalized bool
 	FlushState      *flush.State
+	// CompressedSize is the size of a compressed file which has been read entirely,
+	// it is not decompressed again as long as its size does not change
+	CompressedSize int64
 }
 
 // Reader manages a single file
@@ -45,11 +51,19 @@ type Reader struct {
 	emitFunc               emit.Callback
 	deleteAtEOF            bool
 	needsUpdateFingerprint bool
+
+	// compressed files are read through gzipReader, the offset being within the decompressed content
+	compressed      bool
+	gzipReader      *gzip.Reader
+	decompressedEOF bool
 }
 
 // ReadToEnd will read until the end of the file
 func (r *Reader) ReadToEnd(ctx context.Context) {
-	if _, err := r.file.Seek(r.Offset, 0); err != nil {
+	if r.compressed && r.compressedUnchanged() {
+		return
+	}
+	if err := r.seek(); err != nil {
 		r.set.Logger.Error("Failed to seek", zap.Error(err))
 		return
 	}
@@ -76,6 +90,8 @@ func (r *Reader) ReadToEnd(ctx context.Context) {
 				r.set.Logger.Error("Failed during scan", zap.Error(err))
 			} else if r.deleteAtEOF {
 				r.delete()
+			} else if r.compressed {
+				r.markCompressedRead(s.Pos())
 			}
 			return
 		}
@@ -113,7 +129,7 @@ func (r *Reader) ReadToEnd(ctx context.Context) {
 		// Recreate the scanner with the normal split func.
 		// Do not use the updated offset from the old scanner, as the most recent token
 		// could be split differently with the new splitter.
-		if _, err = r.file.Seek(r.Offset, 0); err != nil {
+		if err = r.seek(); err != nil {
 			r.set.Logger.Error("Failed to seek post-header", zap.Error(err))
 			return
 		}
@@ -121,6 +137,44 @@ func (r *Reader) ReadToEnd(ctx context.Context) {
 	}
 }
 
+// seek positions the file, or the decompressed content of a compressed file, at the offset
+func (r *Reader) seek() error {
+	if !r.compressed {
+		_, err := r.file.Seek(r.Offset, 0)
+		return err
+	}
+	// The decompressed content cannot be seeked, so it is read again from the beginning up to the offset
+	gz, err := newGzipReader(r.file)
+	if err != nil {
+		return err
+	}
+	r.gzipReader = gz
+	r.decompressedEOF = false
+	if _, err = io.CopyN(io.Discard, gz, r.Offset); err != nil {
+		return fmt.Errorf("decompress up to offset: %w", err)
+	}
+	return nil
+}
+
+// compressedUnchanged returns true if the compressed file has been read entirely and has not changed since
+func (r *Reader) compressedUnchanged() bool {
+	if r.CompressedSize == 0 {
+		return false
+	}
+	info, err := r.file.Stat()
+	return err == nil && info.Size() == r.CompressedSize
+}
+
+// markCompressedRead records the size of the compressed file once all its decompressed content has been emitted
+func (r *Reader) markCompressedRead(pos int64) {
+	if !r.decompressedEOF || pos != r.Offset {
+		return
+	}
+	if info, err := r.file.Stat(); err == nil {
+		r.CompressedSize = info.Size()
+	}
+}
+
 // Delete will close and delete the file
 func (r *Reader) delete() {
 	r.close()
@@ -154,7 +208,11 @@ func (r *Reader) close() {
 
 // Read from the file and update the fingerprint if necessary
 func (r *Reader) Read(dst []byte) (n int, err error) {
-	n, err = r.file.Read(dst)
+	if r.compressed {
+		n, err = r.readDecompressed(dst)
+	} else {
+		n, err = r.file.Read(dst)
+	}
 	if n == 0 || err != nil {
 		return
 	}
@@ -165,6 +223,18 @@ func (r *Reader) Read(dst []byte) (n int, err error) {
 	return
 }
 
+func (r *Reader) readDecompressed(dst []byte) (int, error) {
+	n, err := r.gzipReader.Read(dst)
+	switch {
+	case errors.Is(err, io.EOF):
+		r.decompressedEOF = true
+	case errors.Is(err, io.ErrUnexpectedEOF):
+		// The archive is still being written, the rest of its content will be read in a later poll
+		err = io.EOF
+	}
+	return n, err
+}
+
 func (r *Reader) NameEquals(other *Reader) bool {
 	return r.fileName == other.fileName
 }
@@ -174,7 +244,7 @@ func (r *Reader) Validate() bool {
 	if r.file == nil {
 		return false
 	}
-	refreshedFingerprint, err := fingerprint.NewFromFile(r.file, r.fingerprintSize)
+	refreshedFingerprint, err := newFingerprint(r.file, r.fingerprintSize, r.compressed)
 	if err != nil {
 		return false
 	}
@@ -197,7 +267,7 @@ func (r *Reader) updateFingerprint() {
 	if r.file == nil {
 		return
 	}
-	refreshedFingerprint, err := fingerprint.NewFromFile(r.file, r.fingerprintSize)
+	refreshedFingerprint, err := newFingerprint(r.file, r.fingerprintSize, r.compressed)
 	if err != nil {
 		return
 	}
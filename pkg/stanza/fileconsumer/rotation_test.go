@@ -4,6 +4,7 @@
 package fileconsumer
 
 import (
+	"compress/gzip"
 	"context"
 	"fmt"
 	"io"
@@ -493,3 +494,47 @@ func TestFileMovedWhileOff_BigFiles(t *testing.T) {
 	sink2.ExpectTokens(t, log2, log3)
 	require.NoError(t, operator2.Stop())
 }
+
+func TestRotatedToGzip(t *testing.T) {
+	if runtime.GOOS == windowsOS {
+		t.Skip("Moving files while open is unsupported on Windows")
+	}
+	t.Parallel()
+
+	tempDir := t.TempDir()
+	cfg := NewConfig().includeDir(tempDir)
+	cfg.StartAt = "beginning"
+	cfg.Compression = "gzip"
+	operator, sink := testManager(t, cfg)
+	operator.persister = testutil.NewUnscopedMockPersister()
+
+	logPath := filepath.Join(tempDir, "app.log")
+	logFile := filetest.OpenFile(t, logPath)
+	filetest.WriteString(t, logFile, "testlog1\ntestlog2\n")
+
+	operator.poll(context.Background())
+	sink.ExpectTokens(t, []byte("testlog1"), []byte("testlog2"))
+
+	// Lines written just before the rotation are only in the compressed archive
+	filetest.WriteString(t, logFile, "testlog3\ntestlog4\n")
+	require.NoError(t, logFile.Close())
+	content, err := os.ReadFile(logPath)
+	require.NoError(t, err)
+
+	archive := filetest.OpenFile(t, logPath+".1.gz")
+	gz := gzip.NewWriter(archive)
+	_, err = gz.Write(content)
+	require.NoError(t, err)
+	require.NoError(t, gz.Close())
+	require.NoError(t, archive.Close())
+	require.NoError(t, os.Remove(logPath))
+
+	logFile = filetest.OpenFile(t, logPath)
+	filetest.WriteString(t, logFile, "testlog5\n")
+
+	operator.poll(context.Background())
+	sink.ExpectTokens(t, []byte("testlog3"), []byte("testlog4"), []byte("testlog5"))
+
+	operator.poll(context.Background())
+	sink.ExpectNoCalls(t)
+}
@@ -4,6 +4,8 @@
 package journald // import "github.com/open-telemetry/opentelemetry-collector-contrib/pkg/stanza/operator/input/journald"
 
 import (
+	"time"
+
 	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/stanza/operator/helper"
 )
 
@@ -37,6 +39,9 @@ type Config struct {
 	Grep        string        `mapstructure:"grep,omitempty"`
 	Dmesg       bool          `mapstructure:"dmesg,omitempty"`
 	All         bool          `mapstructure:"all,omitempty"`
+	// MaxLookback bounds how far back in time reading resumes from on startup. A persisted cursor
+	// older than MaxLookback is discarded, and `start_at: beginning` only reads entries within it.
+	MaxLookback time.Duration `mapstructure:"max_lookback,omitempty"`
 }
 
 type MatchConfig map[string]string
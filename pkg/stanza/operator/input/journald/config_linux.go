@@ -11,6 +11,8 @@ import (
 	"os/exec"
 	"regexp"
 	"sort"
+	"strconv"
+	"strings"
 	"time"
 
 	jsoniter "github.com/json-iterator/go"
@@ -40,10 +42,8 @@ func (c Config) Build(set component.TelemetrySettings) (operator.Operator, error
 	return &Input{
 		InputOperator: inputOperator,
 		newCmd: func(ctx context.Context, cursor []byte) cmd {
-			if cursor != nil {
-				args = append(args, "--after-cursor", string(cursor))
-			}
-			return exec.CommandContext(ctx, "journalctl", args...) // #nosec - ...
+			cmdArgs := append(args[:len(args):len(args)], c.startArgs(cursor, time.Now())...)
+			return exec.CommandContext(ctx, "journalctl", cmdArgs...) // #nosec - ...
 			// journalctl is an executable that is required for this operator to function
 		},
 		json: jsoniter.ConfigFastest,
@@ -70,6 +70,10 @@ func (c Config) buildArgs() ([]string, error) {
 		return nil, fmt.Errorf("invalid value '%s' for parameter 'start_at'", c.StartAt)
 	}
 
+	if c.MaxLookback < 0 {
+		return nil, fmt.Errorf("invalid value '%s' for parameter 'max_lookback'", c.MaxLookback)
+	}
+
 	for _, unit := range c.Units {
 		args = append(args, "--unit", unit)
 	}
@@ -112,6 +116,45 @@ func (c Config) buildArgs() ([]string, error) {
 	return args, nil
 }
 
+// startArgs returns the arguments selecting the first entry to read: the one after the
+// persisted cursor, unless that cursor is older than max_lookback, in which case reading
+// starts max_lookback ago.
+func (c Config) startArgs(cursor []byte, now time.Time) []string {
+	var since []string
+	if c.MaxLookback > 0 {
+		since = []string{"--since", fmt.Sprintf("@%d", now.Add(-c.MaxLookback).Unix())}
+	}
+
+	if cursor == nil {
+		if c.StartAt == "beginning" {
+			return since
+		}
+		return nil
+	}
+
+	if since != nil {
+		if ts, ok := cursorTimestamp(string(cursor)); ok && ts.Before(now.Add(-c.MaxLookback)) {
+			return since
+		}
+	}
+	return []string{"--after-cursor", string(cursor)}
+}
+
+// cursorTimestamp extracts the time of the entry a journal cursor points to,
+// which is stored in the "t" field as hexadecimal microseconds.
+func cursorTimestamp(cursor string) (time.Time, bool) {
+	for _, field := range strings.Split(cursor, ";") {
+		if value, ok := strings.CutPrefix(field, "t="); ok {
+			usec, err := strconv.ParseInt(value, 16, 64)
+			if err != nil {
+				return time.Time{}, false
+			}
+			return time.UnixMicro(usec), true
+		}
+	}
+	return time.Time{}, false
+}
+
 func buildMatchConfig(mc MatchConfig) ([]string, error) {
 	re := regexp.MustCompile("^[_A-Z]+$")
 
@@ -231,6 +231,81 @@ func TestBuildConfig(t *testing.T) {
 	}
 }
 
+func TestBuildConfigMaxLookback(t *testing.T) {
+	cfg := NewConfigWithID("my_journald_input")
+	cfg.MaxLookback = -time.Hour
+	_, err := cfg.buildArgs()
+	require.EqualError(t, err, "invalid value '-1h0m0s' for parameter 'max_lookback'")
+}
+
+func TestStartArgs(t *testing.T) {
+	// 2020-04-16T14:37:46.229555Z, the "t" field of the cursor
+	cursor := []byte("s=b1e713b587ae4001a9ca482c4b12c005;i=1eed30;b=c4fa36de06824d21835c05ff80c54468;m=9f9d630205;t=5a369604ee333;x=16c2d4fd4fdb7c36")
+	cursorTime := time.UnixMicro(1587047866229555)
+
+	testCases := []struct {
+		Name     string
+		Config   func(_ *Config)
+		Cursor   []byte
+		Now      time.Time
+		Expected []string
+	}{
+		{
+			Name:   "end without cursor",
+			Config: func(_ *Config) {},
+		},
+		{
+			Name:   "beginning without cursor",
+			Config: func(cfg *Config) { cfg.StartAt = "beginning" },
+		},
+		{
+			Name: "beginning without cursor with max lookback",
+			Config: func(cfg *Config) {
+				cfg.StartAt = "beginning"
+				cfg.MaxLookback = time.Hour
+			},
+			Now:      cursorTime,
+			Expected: []string{"--since", "@1587044266"},
+		},
+		{
+			Name:     "cursor",
+			Config:   func(_ *Config) {},
+			Cursor:   cursor,
+			Now:      cursorTime.Add(24 * time.Hour),
+			Expected: []string{"--after-cursor", string(cursor)},
+		},
+		{
+			Name:     "cursor within max lookback",
+			Config:   func(cfg *Config) { cfg.MaxLookback = time.Hour },
+			Cursor:   cursor,
+			Now:      cursorTime.Add(time.Minute),
+			Expected: []string{"--after-cursor", string(cursor)},
+		},
+		{
+			Name:     "cursor older than max lookback",
+			Config:   func(cfg *Config) { cfg.MaxLookback = time.Hour },
+			Cursor:   cursor,
+			Now:      cursorTime.Add(2 * time.Hour),
+			Expected: []string{"--since", "@1587051466"},
+		},
+		{
+			Name:     "cursor without timestamp",
+			Config:   func(cfg *Config) { cfg.MaxLookback = time.Hour },
+			Cursor:   []byte("s=b1e713b587ae4001a9ca482c4b12c005;i=1eed30"),
+			Now:      cursorTime.Add(2 * time.Hour),
+			Expected: []string{"--after-cursor", "s=b1e713b587ae4001a9ca482c4b12c005;i=1eed30"},
+		},
+	}
+
+	for _, tt := range testCases {
+		t.Run(tt.Name, func(t *testing.T) {
+			cfg := NewConfigWithID("my_journald_input")
+			tt.Config(cfg)
+			assert.Equal(t, tt.Expected, cfg.startArgs(tt.Cursor, tt.Now))
+		})
+	}
+}
+
 func TestInputJournaldError(t *testing.T) {
 	cfg := NewConfigWithID("my_journald_input")
 	cfg.OutputIDs = []string{"output"}
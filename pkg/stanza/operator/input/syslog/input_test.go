@@ -4,13 +4,24 @@
 package syslog
 
 import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
 	"fmt"
+	"math/big"
 	"net"
+	"os"
+	"path/filepath"
 	"testing"
 	"time"
 
 	"github.com/stretchr/testify/require"
 	"go.opentelemetry.io/collector/component/componenttest"
+	"go.opentelemetry.io/collector/config/configtls"
 
 	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/stanza/entry"
 	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/stanza/operator"
@@ -214,6 +225,127 @@ func InputTest(t *testing.T, tc syslog.Case, cfg *Config, rsrc map[string]any, a
 	}
 }
 
+// TestInputRFC5425 verifies syslog over TLS as per RFC 5425, which frames messages with octet counting
+// and may require clients to authenticate with a certificate
+func TestInputRFC5425(t *testing.T) {
+	dir := t.TempDir()
+	ca, caKey := writeTestCertificate(t, dir, "ca", nil, nil)
+	writeTestCertificate(t, dir, "server", ca, caKey)
+	writeTestCertificate(t, dir, "client", ca, caKey)
+
+	cfg := NewConfigWithTCP(&OctetCase.Config.BaseConfig)
+	cfg.TCP.ListenAddress = "127.0.0.1:14202"
+	cfg.TCP.TLS = &configtls.ServerConfig{
+		Config: configtls.Config{
+			CertFile: filepath.Join(dir, "server.crt"),
+			KeyFile:  filepath.Join(dir, "server.key"),
+		},
+		ClientCAFile: filepath.Join(dir, "ca.crt"),
+	}
+
+	set := componenttest.NewNopTelemetrySettings()
+	op, err := cfg.Build(set)
+	require.NoError(t, err)
+
+	fake := testutil.NewFakeOutput(t)
+	p, err := pipeline.NewDirectedPipeline([]operator.Operator{op, fake})
+	require.NoError(t, err)
+	require.NoError(t, p.Start(testutil.NewUnscopedMockPersister()))
+	defer func() {
+		require.NoError(t, p.Stop())
+	}()
+
+	roots := x509.NewCertPool()
+	roots.AddCert(ca)
+
+	t.Run("ClientCertificate", func(t *testing.T) {
+		clientCert, err := tls.LoadX509KeyPair(filepath.Join(dir, "client.crt"), filepath.Join(dir, "client.key"))
+		require.NoError(t, err)
+		conn, err := tls.Dial("tcp", cfg.TCP.ListenAddress, &tls.Config{
+			RootCAs:      roots,
+			Certificates: []tls.Certificate{clientCert},
+			MinVersion:   tls.VersionTLS12,
+		})
+		require.NoError(t, err)
+		defer conn.Close()
+
+		msg := `<86>1 2015-08-05T21:58:59.693Z 192.168.2.132 SecureAuth0 23108 ID52020 [SecureAuth@27389 UserID="Tester2" PEN="27389"][origin ip="192.168.2.132"] Found the user`
+		frame := fmt.Sprintf("%d %s", len(msg), msg)
+		_, err = conn.Write([]byte(frame + frame))
+		require.NoError(t, err)
+
+		for i := 0; i < 2; i++ {
+			select {
+			case e := <-fake.Received:
+				require.Equal(t, frame, e.Body)
+				require.Equal(t, map[string]any{
+					"SecureAuth@27389": map[string]any{
+						"PEN":    "27389",
+						"UserID": "Tester2",
+					},
+					"origin": map[string]any{
+						"ip": "192.168.2.132",
+					},
+				}, e.Attributes["structured_data"])
+			case <-time.After(time.Second):
+				require.FailNow(t, "Timed out waiting for entry to be processed")
+			}
+		}
+	})
+
+	t.Run("NoClientCertificate", func(t *testing.T) {
+		conn, err := tls.Dial("tcp", cfg.TCP.ListenAddress, &tls.Config{
+			RootCAs:    roots,
+			MinVersion: tls.VersionTLS12,
+		})
+		if err == nil {
+			// With TLS 1.3 the client certificate is only verified once the client sends data
+			_, _ = conn.Write([]byte("5 <86>1"))
+			conn.Close()
+		}
+
+		select {
+		case e := <-fake.Received:
+			require.FailNow(t, "Unexpected entry", "%v", e)
+		case <-time.After(100 * time.Millisecond):
+		}
+	})
+}
+
+// writeTestCertificate writes a certificate and its key as <name>.crt and <name>.key in dir.
+// The certificate is a CA when no parent is given, and is signed by the parent otherwise.
+func writeTestCertificate(t *testing.T, dir, name string, parent *x509.Certificate, parentKey *ecdsa.PrivateKey) (*x509.Certificate, *ecdsa.PrivateKey) {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+
+	template := &x509.Certificate{
+		SerialNumber: big.NewInt(time.Now().UnixNano()),
+		Subject:      pkix.Name{CommonName: name},
+		NotBefore:    time.Now().Add(-time.Hour),
+		NotAfter:     time.Now().Add(time.Hour),
+		KeyUsage:     x509.KeyUsageDigitalSignature,
+		ExtKeyUsage:  []x509.ExtKeyUsage{x509.ExtKeyUsageServerAuth, x509.ExtKeyUsageClientAuth},
+		IPAddresses:  []net.IP{net.ParseIP("127.0.0.1")},
+	}
+	if parent == nil {
+		template.IsCA = true
+		template.BasicConstraintsValid = true
+		template.KeyUsage |= x509.KeyUsageCertSign
+		parent, parentKey = template, key
+	}
+
+	der, err := x509.CreateCertificate(rand.Reader, template, parent, &key.PublicKey, parentKey)
+	require.NoError(t, err)
+	cert, err := x509.ParseCertificate(der)
+	require.NoError(t, err)
+	keyDER, err := x509.MarshalECPrivateKey(key)
+	require.NoError(t, err)
+
+	require.NoError(t, os.WriteFile(filepath.Join(dir, name+".crt"), pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der}), 0600))
+	require.NoError(t, os.WriteFile(filepath.Join(dir, name+".key"), pem.EncodeToMemory(&pem.Block{Type: "EC PRIVATE KEY", Bytes: keyDER}), 0600))
+	return cert, key
+}
+
 func TestSyslogIDs(t *testing.T) {
 	basicConfig := func() *syslog.BaseConfig {
 		cfg := syslog.NewConfigWithID("test_syslog_parser")
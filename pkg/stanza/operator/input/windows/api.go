@@ -24,6 +24,7 @@ var (
 	updateBookmarkProc        SyscallProc = api.NewProc("EvtUpdateBookmark")
 	openPublisherMetadataProc SyscallProc = api.NewProc("EvtOpenPublisherMetadata")
 	formatMessageProc         SyscallProc = api.NewProc("EvtFormatMessage")
+	openSessionProc           SyscallProc = api.NewProc("EvtOpenSession")
 )
 
 // SyscallProc is a syscall procedure.
@@ -58,6 +59,23 @@ const (
 	EvtFormatMessageXML uint32 = 9
 )
 
+const (
+	// EvtRPCLogin is the login class of a session to a remote computer using RPC.
+	EvtRPCLogin uint32 = 1
+	// EvtRPCLoginAuthNegotiate is a flag that will authenticate a remote session using the Negotiate method.
+	EvtRPCLoginAuthNegotiate uint32 = 1
+)
+
+// EvtRPCLoginInfo is the EVT_RPC_LOGIN structure used to open a session to a remote computer
+// (https://learn.microsoft.com/en-us/windows/win32/api/winevt/ns-winevt-evt_rpc_login)
+type EvtRPCLoginInfo struct {
+	Server   *uint16
+	User     *uint16
+	Domain   *uint16
+	Password *uint16
+	Flags    uint32
+}
+
 const (
 	// EvtRenderEventXML is a flag to render an event as an XML string
 	EvtRenderEventXML uint32 = 1
@@ -147,3 +165,13 @@ func evtFormatMessage(publisherMetadata uintptr, event uintptr, messageID uint32
 
 	return bufferUsed, nil
 }
+
+// evtOpenSession is the direct syscall implementation of EvtOpenSession (https://learn.microsoft.com/en-us/windows/win32/api/winevt/nf-winevt-evtopensession)
+func evtOpenSession(loginClass uint32, login *EvtRPCLoginInfo, timeout uint32, flags uint32) (uintptr, error) {
+	handle, _, err := openSessionProc.Call(uintptr(loginClass), uintptr(unsafe.Pointer(login)), uintptr(timeout), uintptr(flags))
+	if !errors.Is(err, ErrorSuccess) {
+		return 0, err
+	}
+
+	return handle, nil
+}
@@ -6,6 +6,8 @@ package windows // import "github.com/open-telemetry/opentelemetry-collector-con
 import (
 	"time"
 
+	"go.opentelemetry.io/collector/config/configopaque"
+
 	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/stanza/operator/helper"
 )
 
@@ -35,4 +37,14 @@ type Config struct {
 	PollInterval       time.Duration `mapstructure:"poll_interval,omitempty"`
 	Raw                bool          `mapstructure:"raw,omitempty"`
 	ExcludeProviders   []string      `mapstructure:"exclude_providers,omitempty"`
+	Query              string        `mapstructure:"query,omitempty"`
+	Remote             RemoteConfig  `mapstructure:"remote,omitempty"`
+}
+
+// RemoteConfig is the configuration of the session used to read the event logs of a remote computer.
+type RemoteConfig struct {
+	Server   string              `mapstructure:"server"`
+	Username string              `mapstructure:"username"`
+	Password configopaque.String `mapstructure:"password"`
+	Domain   string              `mapstructure:"domain"`
 }
@@ -7,6 +7,7 @@ package windows // import "github.com/open-telemetry/opentelemetry-collector-con
 
 import (
 	"fmt"
+	"strings"
 
 	"go.opentelemetry.io/collector/component"
 
@@ -24,10 +25,14 @@ func (c *Config) Build(set component.TelemetrySettings) (operator.Operator, erro
 		return nil, err
 	}
 
-	if c.Channel == "" {
+	if c.Channel == "" && !isStructuredQuery(c.Query) {
 		return nil, fmt.Errorf("missing required `channel` field")
 	}
 
+	if c.Remote.Server == "" && (c.Remote.Username != "" || c.Remote.Password != "" || c.Remote.Domain != "") {
+		return nil, fmt.Errorf("the `remote.server` field must be set when using remote credentials")
+	}
+
 	if c.MaxReads < 1 {
 		return nil, fmt.Errorf("the `max_reads` field must be greater than zero")
 	}
@@ -45,5 +50,13 @@ func (c *Config) Build(set component.TelemetrySettings) (operator.Operator, erro
 		pollInterval:     c.PollInterval,
 		raw:              c.Raw,
 		excludeProviders: c.ExcludeProviders,
+		query:            c.Query,
+		remote:           c.Remote,
 	}, nil
 }
+
+// isStructuredQuery returns whether a query is a structured XML query, which selects its own channels,
+// rather than an XPath query filtering the events of the configured channel.
+func isStructuredQuery(query string) bool {
+	return strings.HasPrefix(strings.TrimSpace(query), "<")
+}
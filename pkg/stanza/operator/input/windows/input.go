@@ -21,6 +21,7 @@ import (
 type Input struct {
 	helper.InputOperator
 	bookmark         Bookmark
+	session          Session
 	subscription     Subscription
 	buffer           Buffer
 	channel          string
@@ -28,6 +29,8 @@ type Input struct {
 	startAt          string
 	raw              bool
 	excludeProviders []string
+	query            string
+	remote           RemoteConfig
 	pollInterval     time.Duration
 	persister        operator.Persister
 	publisherCache   publisherCache
@@ -46,7 +49,7 @@ func (i *Input) Start(persister operator.Persister) error {
 	offsetXML, err := i.getBookmarkOffset(ctx)
 	if err != nil {
 		i.Logger().Error("Failed to open bookmark, continuing without previous bookmark", zap.Error(err))
-		_ = i.persister.Delete(ctx, i.channel)
+		_ = i.persister.Delete(ctx, i.getPersistKey())
 	}
 
 	if offsetXML != "" {
@@ -55,12 +58,19 @@ func (i *Input) Start(persister operator.Persister) error {
 		}
 	}
 
+	i.session = NewSession()
+	if i.remote.Server != "" {
+		if err := i.session.Open(i.remote); err != nil {
+			return fmt.Errorf("failed to open remote session: %w", err)
+		}
+	}
+
 	i.subscription = NewSubscription()
-	if err := i.subscription.Open(i.channel, i.startAt, i.bookmark); err != nil {
+	if err := i.subscription.Open(i.session.handle, i.channel, i.query, i.startAt, i.bookmark); err != nil {
 		return fmt.Errorf("failed to open subscription: %w", err)
 	}
 
-	i.publisherCache = newPublisherCache()
+	i.publisherCache = newPublisherCache(i.session.handle)
 
 	i.wg.Add(1)
 	go i.readOnInterval(ctx)
@@ -84,6 +94,10 @@ func (i *Input) Stop() error {
 		return fmt.Errorf("failed to close publishers: %w", err)
 	}
 
+	if err := i.session.Close(); err != nil {
+		return fmt.Errorf("failed to close remote session: %w", err)
+	}
+
 	return nil
 }
 
@@ -225,7 +239,7 @@ func (i *Input) sendEventRaw(ctx context.Context, eventRaw EventRaw) {
 
 // getBookmarkXML will get the bookmark xml from the offsets database.
 func (i *Input) getBookmarkOffset(ctx context.Context) (string, error) {
-	bytes, err := i.persister.Get(ctx, i.channel)
+	bytes, err := i.persister.Get(ctx, i.getPersistKey())
 	return string(bytes), err
 }
 
@@ -242,8 +256,21 @@ func (i *Input) updateBookmarkOffset(ctx context.Context, event Event) {
 		return
 	}
 
-	if err := i.persister.Set(ctx, i.channel, []byte(bookmarkXML)); err != nil {
+	if err := i.persister.Set(ctx, i.getPersistKey(), []byte(bookmarkXML)); err != nil {
 		i.Logger().Error("failed to set offsets", zap.Error(err))
 		return
 	}
 }
+
+// getPersistKey returns the key under which the bookmark is stored. It identifies the channel, or the
+// query when it selects its own channels, and is prefixed by the server when reading remote event logs.
+func (i *Input) getPersistKey() string {
+	key := i.channel
+	if key == "" {
+		key = i.query
+	}
+	if i.remote.Server != "" {
+		key = i.remote.Server + "/" + key
+	}
+	return key
+}
@@ -15,8 +15,8 @@ type Publisher struct {
 	handle uintptr
 }
 
-// Open will open the publisher handle using the supplied provider.
-func (p *Publisher) Open(provider string) error {
+// Open will open the publisher handle using the supplied session and provider.
+func (p *Publisher) Open(session uintptr, provider string) error {
 	if p.handle != 0 {
 		return fmt.Errorf("publisher handle is already open")
 	}
@@ -26,7 +26,7 @@ func (p *Publisher) Open(provider string) error {
 		return fmt.Errorf("failed to convert the provider name %q to utf16: %w", provider, err)
 	}
 
-	handle, err := evtOpenPublisherMetadata(0, utf16, nil, 0, 0)
+	handle, err := evtOpenPublisherMetadata(session, utf16, nil, 0, 0)
 	if err != nil {
 		return fmt.Errorf("failed to open the metadata for the %q provider: %w", provider, err)
 	}
@@ -10,12 +10,14 @@ import (
 )
 
 type publisherCache struct {
-	cache map[string]Publisher
+	session uintptr
+	cache   map[string]Publisher
 }
 
-func newPublisherCache() publisherCache {
+func newPublisherCache(session uintptr) publisherCache {
 	return publisherCache{
-		cache: make(map[string]Publisher),
+		session: session,
+		cache:   make(map[string]Publisher),
 	}
 }
 
@@ -26,7 +28,7 @@ func (c *publisherCache) get(provider string) (publisher Publisher, openPublishe
 	}
 
 	publisher = NewPublisher()
-	err := publisher.Open(provider)
+	err := publisher.Open(c.session, provider)
 
 	// Always store the publisher even if there was an error opening it.
 	c.cache[provider] = publisher
@@ -12,7 +12,7 @@ import (
 )
 
 func TestGetValidPublisher(t *testing.T) {
-	publisherCache := newPublisherCache()
+	publisherCache := newPublisherCache(0)
 	defer func() {
 		require.NoError(t, publisherCache.evictAll())
 	}()
@@ -29,7 +29,7 @@ func TestGetValidPublisher(t *testing.T) {
 }
 
 func TestGetInvalidPublisher(t *testing.T) {
-	publisherCache := newPublisherCache()
+	publisherCache := newPublisherCache(0)
 	defer func() {
 		require.NoError(t, publisherCache.evictAll())
 	}()
@@ -46,7 +46,7 @@ func TestGetInvalidPublisher(t *testing.T) {
 }
 
 func TestValidAndInvalidPublishers(t *testing.T) {
-	publisherCache := newPublisherCache()
+	publisherCache := newPublisherCache(0)
 	defer func() {
 		require.NoError(t, publisherCache.evictAll())
 	}()
@@ -0,0 +1,75 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+//go:build windows
+
+package windows // import "github.com/open-telemetry/opentelemetry-collector-contrib/pkg/stanza/operator/input/windows"
+
+import (
+	"fmt"
+	"syscall"
+)
+
+// Session is a session to the event log service of a remote computer.
+// A zero handle refers to the event log service of the local computer.
+type Session struct {
+	handle uintptr
+}
+
+// Open will open a session to the remote computer with the supplied credentials.
+func (s *Session) Open(remote RemoteConfig) error {
+	if s.handle != 0 {
+		return fmt.Errorf("session handle is already open")
+	}
+
+	login := EvtRPCLoginInfo{Flags: EvtRPCLoginAuthNegotiate}
+	fields := []struct {
+		name  string
+		value string
+		ptr   **uint16
+	}{
+		{"server", remote.Server, &login.Server},
+		{"username", remote.Username, &login.User},
+		{"domain", remote.Domain, &login.Domain},
+		{"password", string(remote.Password), &login.Password},
+	}
+	for _, field := range fields {
+		if field.value == "" {
+			continue
+		}
+		utf16, err := syscall.UTF16PtrFromString(field.value)
+		if err != nil {
+			return fmt.Errorf("failed to convert the remote %s to utf16: %w", field.name, err)
+		}
+		*field.ptr = utf16
+	}
+
+	handle, err := evtOpenSession(EvtRPCLogin, &login, 0, 0)
+	if err != nil {
+		return fmt.Errorf("failed to open session to %q: %w", remote.Server, err)
+	}
+
+	s.handle = handle
+	return nil
+}
+
+// Close will close the session.
+func (s *Session) Close() error {
+	if s.handle == 0 {
+		return nil
+	}
+
+	if err := evtClose(s.handle); err != nil {
+		return fmt.Errorf("failed to close session handle: %w", err)
+	}
+
+	s.handle = 0
+	return nil
+}
+
+// NewSession will create a new session with an empty handle.
+func NewSession() Session {
+	return Session{
+		handle: 0,
+	}
+}
@@ -18,8 +18,9 @@ type Subscription struct {
 	handle uintptr
 }
 
-// Open will open the subscription handle.
-func (s *Subscription) Open(channel string, startAt string, bookmark Bookmark) error {
+// Open will open the subscription handle using the supplied session, channel and query.
+// An empty channel or query is omitted from the subscription.
+func (s *Subscription) Open(session uintptr, channel string, query string, startAt string, bookmark Bookmark) error {
 	if s.handle != 0 {
 		return fmt.Errorf("subscription handle is already open")
 	}
@@ -32,14 +33,24 @@ func (s *Subscription) Open(channel string, startAt string, bookmark Bookmark) e
 		_ = windows.CloseHandle(signalEvent)
 	}()
 
-	channelPtr, err := syscall.UTF16PtrFromString(channel)
-	if err != nil {
-		return fmt.Errorf("failed to convert channel to utf16: %w", err)
+	var channelPtr, queryPtr *uint16
+	if channel != "" {
+		if channelPtr, err = syscall.UTF16PtrFromString(channel); err != nil {
+			return fmt.Errorf("failed to convert channel to utf16: %w", err)
+		}
+	}
+	if query != "" {
+		if queryPtr, err = syscall.UTF16PtrFromString(query); err != nil {
+			return fmt.Errorf("failed to convert query to utf16: %w", err)
+		}
 	}
 
 	flags := s.createFlags(startAt, bookmark)
-	subscriptionHandle, err := evtSubscribe(0, signalEvent, channelPtr, nil, bookmark.handle, 0, 0, flags)
+	subscriptionHandle, err := evtSubscribe(session, signalEvent, channelPtr, queryPtr, bookmark.handle, 0, 0, flags)
 	if err != nil {
+		if channel == "" {
+			return fmt.Errorf("failed to subscribe to query: %w", err)
+		}
 		return fmt.Errorf("failed to subscribe to %s channel: %w", channel, err)
 	}
 
@@ -5,6 +5,7 @@ package loki // import "github.com/open-telemetry/opentelemetry-collector-contri
 
 import (
 	"fmt"
+	"sort"
 
 	"github.com/grafana/loki/pkg/push"
 	"github.com/prometheus/common/model"
@@ -47,7 +48,8 @@ const (
 // batch or send only the data that could be parsed. The caller can use the PushReport
 // to make this decision, as it includes all of the errors that were encountered,
 // as well as the number of items dropped and submitted.
-func LogsToLokiRequests(ld plog.Logs, defaultLabelsEnabled map[string]bool) map[string]PushRequest {
+// The options customize the conversion of each record, see LogToLokiEntry.
+func LogsToLokiRequests(ld plog.Logs, defaultLabelsEnabled map[string]bool, opts ...Option) map[string]PushRequest {
 	groups := map[string]pushRequestGroup{}
 
 	rls := ld.ResourceLogs()
@@ -70,7 +72,7 @@ func LogsToLokiRequests(ld plog.Logs, defaultLabelsEnabled map[string]bool) map[
 					groups[tenant] = group
 				}
 
-				entry, err := LogToLokiEntry(log, resource, scope, defaultLabelsEnabled)
+				entry, err := LogToLokiEntry(log, resource, scope, defaultLabelsEnabled, opts...)
 				if err != nil {
 					// Couldn't convert so dropping log.
 					group.report.Errors = append(group.report.Errors, fmt.Errorf("failed to convert, dropping log: %w", err))
@@ -120,8 +122,32 @@ type PushEntry struct {
 	Labels model.LabelSet
 }
 
+// Option customizes the conversion of log records into Loki entries.
+type Option func(*options)
+
+type options struct {
+	structuredMetadataResourceAttributes []string
+	structuredMetadataAttributes         []string
+}
+
+// WithStructuredMetadata sends the given resource and record attributes as the structured metadata
+// of the entries instead of the body. The attributes are removed from the body and their names are
+// normalized like label names. A record attribute takes precedence over a resource attribute with
+// the same normalized name.
+func WithStructuredMetadata(resourceAttributes, attributes []string) Option {
+	return func(o *options) {
+		o.structuredMetadataResourceAttributes = resourceAttributes
+		o.structuredMetadataAttributes = attributes
+	}
+}
+
 // LogToLokiEntry converts LogRecord into Loki log entry enriched with normalized labels
-func LogToLokiEntry(lr plog.LogRecord, rl pcommon.Resource, scope pcommon.InstrumentationScope, defaultLabelsEnabled map[string]bool) (*PushEntry, error) {
+func LogToLokiEntry(lr plog.LogRecord, rl pcommon.Resource, scope pcommon.InstrumentationScope, defaultLabelsEnabled map[string]bool, opts ...Option) (*PushEntry, error) {
+	var o options
+	for _, opt := range opts {
+		opt(&o)
+	}
+
 	// we may remove attributes, so change only our version
 	log := plog.NewLogRecord()
 	lr.CopyTo(log)
@@ -142,10 +168,16 @@ func LogToLokiEntry(lr plog.LogRecord, rl pcommon.Resource, scope pcommon.Instru
 	removeAttributes(log.Attributes(), mergedLabels)
 	removeAttributes(resource.Attributes(), mergedLabels)
 
+	// move the attributes sent as structured metadata out of the body
+	metadata := map[string]string{}
+	extractStructuredMetadata(resource.Attributes(), o.structuredMetadataResourceAttributes, metadata)
+	extractStructuredMetadata(log.Attributes(), o.structuredMetadataAttributes, metadata)
+
 	entry, err := convertLogToLokiEntry(log, resource, format, scope)
 	if err != nil {
 		return nil, err
 	}
+	entry.StructuredMetadata = structuredMetadata(metadata)
 
 	labels := model.LabelSet{}
 	for label := range mergedLabels {
@@ -161,6 +193,29 @@ func LogToLokiEntry(lr plog.LogRecord, rl pcommon.Resource, scope pcommon.Instru
 	}, nil
 }
 
+// extractStructuredMetadata removes the selected attributes from attrs and adds them to metadata
+// under their normalized names.
+func extractStructuredMetadata(attrs pcommon.Map, selected []string, metadata map[string]string) {
+	for _, name := range selected {
+		if v, ok := attrs.Get(name); ok {
+			metadata[prometheustranslator.NormalizeLabel(name)] = v.AsString()
+			attrs.Remove(name)
+		}
+	}
+}
+
+func structuredMetadata(metadata map[string]string) []push.LabelAdapter {
+	if len(metadata) == 0 {
+		return nil
+	}
+	out := make([]push.LabelAdapter, 0, len(metadata))
+	for name, value := range metadata {
+		out = append(out, push.LabelAdapter{Name: name, Value: value})
+	}
+	sort.Slice(out, func(i, j int) bool { return out[i].Name < out[j].Name })
+	return out
+}
+
 func getFormatFromFormatHint(logAttr pcommon.Map, resourceAttr pcommon.Map) string {
 	format := formatJSON
 	formatVal, found := resourceAttr.Get(hintFormat)
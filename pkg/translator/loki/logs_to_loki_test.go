@@ -692,6 +692,71 @@ func TestLogToLokiEntry(t *testing.T) {
 	}
 }
 
+func TestLogToLokiEntryWithStructuredMetadata(t *testing.T) {
+	lr := plog.NewLogRecord()
+	lr.SetTimestamp(pcommon.NewTimestampFromTime(time.Unix(0, 1677592916000000000)))
+	lr.Attributes().PutStr("trace.id", "0102")
+	lr.Attributes().PutStr("http.status", "200")
+	lr.Attributes().PutStr("host.name", "guarana")
+	lr.Attributes().PutStr(hintAttributes, "host.name")
+
+	resource := pcommon.NewResource()
+	resource.Attributes().PutStr("k8s.pod.name", "pod-1")
+	resource.Attributes().PutStr("trace.id", "ignored")
+	resource.Attributes().PutStr("region.az", "eu-west-1a")
+
+	entry, err := LogToLokiEntry(lr, resource, pcommon.NewInstrumentationScope(), nil,
+		WithStructuredMetadata([]string{"k8s.pod.name", "trace.id", "missing"}, []string{"trace.id", "host.name"}))
+	require.NoError(t, err)
+
+	assert.Equal(t, &PushEntry{
+		Entry: &push.Entry{
+			Timestamp: time.Unix(0, 1677592916000000000),
+			Line:      `{"attributes":{"http.status":"200"},"resources":{"region.az":"eu-west-1a"}}`,
+			StructuredMetadata: []push.LabelAdapter{
+				{Name: "k8s_pod_name", Value: "pod-1"},
+				{Name: "trace_id", Value: "0102"},
+			},
+		},
+		Labels: model.LabelSet{
+			"exporter":  "OTLP",
+			"host_name": "guarana",
+		},
+	}, entry)
+
+	// the original record and resource are not modified
+	assert.Equal(t, 4, lr.Attributes().Len())
+	assert.Equal(t, 3, resource.Attributes().Len())
+}
+
+func TestLogsToLokiRequestsWithStructuredMetadata(t *testing.T) {
+	ld := plog.NewLogs()
+	rl := ld.ResourceLogs().AppendEmpty()
+	rl.Resource().Attributes().PutStr("k8s.pod.name", "pod-1")
+	sl := rl.ScopeLogs().AppendEmpty()
+	for _, traceID := range []string{"01", "02"} {
+		lr := sl.LogRecords().AppendEmpty()
+		lr.Body().SetStr("hello")
+		lr.Attributes().PutStr("trace.id", traceID)
+	}
+
+	requests := LogsToLokiRequests(ld, nil, WithStructuredMetadata([]string{"k8s.pod.name"}, []string{"trace.id"}))
+	require.Len(t, requests, 1)
+
+	streams := requests[""].Streams
+	// the structured metadata doesn't create new streams
+	require.Len(t, streams, 1)
+	require.Len(t, streams[0].Entries, 2)
+	for i, traceID := range []string{"01", "02"} {
+		entry := streams[0].Entries[i]
+		assert.Equal(t, `{"body":"hello"}`, entry.Line)
+		assert.Equal(t, []push.LabelAdapter{
+			{Name: "k8s_pod_name", Value: "pod-1"},
+			{Name: "trace_id", Value: traceID},
+		}, []push.LabelAdapter(entry.StructuredMetadata))
+	}
+}
+
 func TestGetTenantFromTenantHint(t *testing.T) {
 	testCases := []struct {
 		name     string
@@ -0,0 +1,34 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package parquet // import "github.com/open-telemetry/opentelemetry-collector-contrib/pkg/translator/parquet"
+
+import (
+	"fmt"
+
+	"github.com/apache/arrow/go/v15/parquet/compress"
+)
+
+// The names of the supported compression codecs.
+const (
+	CompressionNone   = "none"
+	CompressionSnappy = "snappy"
+	CompressionGzip   = "gzip"
+	CompressionZstd   = "zstd"
+)
+
+var codecs = map[string]compress.Compression{
+	CompressionNone:   compress.Codecs.Uncompressed,
+	CompressionSnappy: compress.Codecs.Snappy,
+	CompressionGzip:   compress.Codecs.Gzip,
+	CompressionZstd:   compress.Codecs.Zstd,
+}
+
+// CompressionCodec returns the codec of the compression name.
+func CompressionCodec(name string) (compress.Compression, error) {
+	codec, ok := codecs[name]
+	if !ok {
+		return compress.Codecs.Uncompressed, fmt.Errorf("parquet compression %q is not supported", name)
+	}
+	return codec, nil
+}
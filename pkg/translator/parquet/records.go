@@ -0,0 +1,427 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package parquet // import "github.com/open-telemetry/opentelemetry-collector-contrib/pkg/translator/parquet"
+
+import (
+	"github.com/apache/arrow/go/v15/arrow"
+	"github.com/apache/arrow/go/v15/arrow/array"
+	"github.com/apache/arrow/go/v15/arrow/memory"
+	"go.opentelemetry.io/collector/pdata/pcommon"
+	"go.opentelemetry.io/collector/pdata/plog"
+	"go.opentelemetry.io/collector/pdata/pmetric"
+	"go.opentelemetry.io/collector/pdata/ptrace"
+)
+
+var (
+	attributesType = arrow.MapOf(arrow.BinaryTypes.String, arrow.BinaryTypes.String)
+	timestampType  = arrow.FixedWidthTypes.Timestamp_ns
+)
+
+// resourceFields are the columns shared by the schemas of all the signals.
+var resourceFields = []arrow.Field{
+	{Name: "resource_attributes", Type: attributesType},
+	{Name: "resource_schema_url", Type: arrow.BinaryTypes.String},
+	{Name: "scope_name", Type: arrow.BinaryTypes.String},
+	{Name: "scope_version", Type: arrow.BinaryTypes.String},
+	{Name: "scope_attributes", Type: attributesType},
+}
+
+// LogsSchema is the schema of the records of logs, with a row per log record.
+var LogsSchema = arrow.NewSchema(append([]arrow.Field{
+	{Name: "timestamp", Type: timestampType},
+	{Name: "observed_timestamp", Type: timestampType},
+	{Name: "severity_number", Type: arrow.PrimitiveTypes.Int32},
+	{Name: "severity_text", Type: arrow.BinaryTypes.String},
+	{Name: "body", Type: arrow.BinaryTypes.String},
+	{Name: "attributes", Type: attributesType},
+	{Name: "trace_id", Type: arrow.BinaryTypes.String},
+	{Name: "span_id", Type: arrow.BinaryTypes.String},
+	{Name: "flags", Type: arrow.PrimitiveTypes.Uint32},
+}, resourceFields...), nil)
+
+// TracesSchema is the schema of the records of traces, with a row per span.
+var TracesSchema = arrow.NewSchema(append([]arrow.Field{
+	{Name: "start_timestamp", Type: timestampType},
+	{Name: "end_timestamp", Type: timestampType},
+	{Name: "duration_nanos", Type: arrow.PrimitiveTypes.Int64},
+	{Name: "trace_id", Type: arrow.BinaryTypes.String},
+	{Name: "span_id", Type: arrow.BinaryTypes.String},
+	{Name: "parent_span_id", Type: arrow.BinaryTypes.String},
+	{Name: "trace_state", Type: arrow.BinaryTypes.String},
+	{Name: "name", Type: arrow.BinaryTypes.String},
+	{Name: "kind", Type: arrow.BinaryTypes.String},
+	{Name: "status_code", Type: arrow.BinaryTypes.String},
+	{Name: "status_message", Type: arrow.BinaryTypes.String},
+	{Name: "attributes", Type: attributesType},
+	{Name: "events_count", Type: arrow.PrimitiveTypes.Int32},
+	{Name: "links_count", Type: arrow.PrimitiveTypes.Int32},
+}, resourceFields...), nil)
+
+// MetricsSchema is the schema of the records of metrics, with a row per data point.
+var MetricsSchema = arrow.NewSchema(append([]arrow.Field{
+	{Name: "timestamp", Type: timestampType},
+	{Name: "start_timestamp", Type: timestampType},
+	{Name: "metric_name", Type: arrow.BinaryTypes.String},
+	{Name: "metric_description", Type: arrow.BinaryTypes.String},
+	{Name: "metric_unit", Type: arrow.BinaryTypes.String},
+	{Name: "metric_type", Type: arrow.BinaryTypes.String},
+	{Name: "aggregation_temporality", Type: arrow.BinaryTypes.String, Nullable: true},
+	{Name: "is_monotonic", Type: arrow.FixedWidthTypes.Boolean, Nullable: true},
+	{Name: "attributes", Type: attributesType},
+	{Name: "value", Type: arrow.PrimitiveTypes.Float64, Nullable: true},
+	{Name: "count", Type: arrow.PrimitiveTypes.Uint64, Nullable: true},
+	{Name: "sum", Type: arrow.PrimitiveTypes.Float64, Nullable: true},
+	{Name: "min", Type: arrow.PrimitiveTypes.Float64, Nullable: true},
+	{Name: "max", Type: arrow.PrimitiveTypes.Float64, Nullable: true},
+	{Name: "bucket_counts", Type: arrow.ListOf(arrow.PrimitiveTypes.Uint64), Nullable: true},
+	{Name: "explicit_bounds", Type: arrow.ListOf(arrow.PrimitiveTypes.Float64), Nullable: true},
+}, resourceFields...), nil)
+
+// recordBuilder appends the columns of a schema in order.
+type recordBuilder struct {
+	*array.RecordBuilder
+	col int
+}
+
+func newRecordBuilder(schema *arrow.Schema) *recordBuilder {
+	return &recordBuilder{RecordBuilder: array.NewRecordBuilder(memory.DefaultAllocator, schema)}
+}
+
+func (b *recordBuilder) next() array.Builder {
+	field := b.Field(b.col)
+	b.col++
+	return field
+}
+
+// endRow checks that all the columns of the row were appended.
+func (b *recordBuilder) endRow() {
+	if b.col != len(b.Fields()) {
+		panic("parquet row is missing columns")
+	}
+	b.col = 0
+}
+
+func (b *recordBuilder) appendString(v string) {
+	b.next().(*array.StringBuilder).Append(v)
+}
+
+func (b *recordBuilder) appendTimestamp(v pcommon.Timestamp) {
+	b.next().(*array.TimestampBuilder).Append(arrow.Timestamp(v))
+}
+
+func (b *recordBuilder) appendInt32(v int32) {
+	b.next().(*array.Int32Builder).Append(v)
+}
+
+func (b *recordBuilder) appendInt64(v int64) {
+	b.next().(*array.Int64Builder).Append(v)
+}
+
+func (b *recordBuilder) appendUint32(v uint32) {
+	b.next().(*array.Uint32Builder).Append(v)
+}
+
+func (b *recordBuilder) appendUint64(v uint64, valid bool) {
+	builder := b.next().(*array.Uint64Builder)
+	if !valid {
+		builder.AppendNull()
+		return
+	}
+	builder.Append(v)
+}
+
+func (b *recordBuilder) appendFloat64(v float64, valid bool) {
+	builder := b.next().(*array.Float64Builder)
+	if !valid {
+		builder.AppendNull()
+		return
+	}
+	builder.Append(v)
+}
+
+func (b *recordBuilder) appendNullableString(v string, valid bool) {
+	builder := b.next().(*array.StringBuilder)
+	if !valid {
+		builder.AppendNull()
+		return
+	}
+	builder.Append(v)
+}
+
+func (b *recordBuilder) appendNullableBool(v bool, valid bool) {
+	builder := b.next().(*array.BooleanBuilder)
+	if !valid {
+		builder.AppendNull()
+		return
+	}
+	builder.Append(v)
+}
+
+func (b *recordBuilder) appendAttributes(attrs pcommon.Map) {
+	builder := b.next().(*array.MapBuilder)
+	builder.Append(true)
+	keys := builder.KeyBuilder().(*array.StringBuilder)
+	items := builder.ItemBuilder().(*array.StringBuilder)
+	attrs.Range(func(k string, v pcommon.Value) bool {
+		keys.Append(k)
+		items.Append(v.AsString())
+		return true
+	})
+}
+
+func (b *recordBuilder) appendUint64List(values []uint64, valid bool) {
+	builder := b.next().(*array.ListBuilder)
+	if !valid {
+		builder.AppendNull()
+		return
+	}
+	builder.Append(true)
+	builder.ValueBuilder().(*array.Uint64Builder).AppendValues(values, nil)
+}
+
+func (b *recordBuilder) appendFloat64List(values []float64, valid bool) {
+	builder := b.next().(*array.ListBuilder)
+	if !valid {
+		builder.AppendNull()
+		return
+	}
+	builder.Append(true)
+	builder.ValueBuilder().(*array.Float64Builder).AppendValues(values, nil)
+}
+
+func (b *recordBuilder) appendResource(resource pcommon.Resource, schemaURL string, scope pcommon.InstrumentationScope) {
+	b.appendAttributes(resource.Attributes())
+	b.appendString(schemaURL)
+	b.appendString(scope.Name())
+	b.appendString(scope.Version())
+	b.appendAttributes(scope.Attributes())
+}
+
+func traceIDString(id pcommon.TraceID) string {
+	if id.IsEmpty() {
+		return ""
+	}
+	return id.String()
+}
+
+func spanIDString(id pcommon.SpanID) string {
+	if id.IsEmpty() {
+		return ""
+	}
+	return id.String()
+}
+
+// LogsToRecord converts the logs to a record of LogsSchema, the caller must release the record.
+func LogsToRecord(ld plog.Logs) arrow.Record {
+	b := newRecordBuilder(LogsSchema)
+	defer b.Release()
+
+	rls := ld.ResourceLogs()
+	for i := 0; i < rls.Len(); i++ {
+		rl := rls.At(i)
+		for j := 0; j < rl.ScopeLogs().Len(); j++ {
+			sl := rl.ScopeLogs().At(j)
+			for k := 0; k < sl.LogRecords().Len(); k++ {
+				lr := sl.LogRecords().At(k)
+				b.appendTimestamp(lr.Timestamp())
+				b.appendTimestamp(lr.ObservedTimestamp())
+				b.appendInt32(int32(lr.SeverityNumber()))
+				b.appendString(lr.SeverityText())
+				b.appendString(lr.Body().AsString())
+				b.appendAttributes(lr.Attributes())
+				b.appendString(traceIDString(lr.TraceID()))
+				b.appendString(spanIDString(lr.SpanID()))
+				b.appendUint32(uint32(lr.Flags()))
+				b.appendResource(rl.Resource(), rl.SchemaUrl(), sl.Scope())
+				b.endRow()
+			}
+		}
+	}
+	return b.NewRecord()
+}
+
+// TracesToRecord converts the traces to a record of TracesSchema, the caller must release the record.
+func TracesToRecord(td ptrace.Traces) arrow.Record {
+	b := newRecordBuilder(TracesSchema)
+	defer b.Release()
+
+	rss := td.ResourceSpans()
+	for i := 0; i < rss.Len(); i++ {
+		rs := rss.At(i)
+		for j := 0; j < rs.ScopeSpans().Len(); j++ {
+			ss := rs.ScopeSpans().At(j)
+			for k := 0; k < ss.Spans().Len(); k++ {
+				span := ss.Spans().At(k)
+				b.appendTimestamp(span.StartTimestamp())
+				b.appendTimestamp(span.EndTimestamp())
+				b.appendInt64(int64(span.EndTimestamp()) - int64(span.StartTimestamp()))
+				b.appendString(traceIDString(span.TraceID()))
+				b.appendString(spanIDString(span.SpanID()))
+				b.appendString(spanIDString(span.ParentSpanID()))
+				b.appendString(span.TraceState().AsRaw())
+				b.appendString(span.Name())
+				b.appendString(span.Kind().String())
+				b.appendString(span.Status().Code().String())
+				b.appendString(span.Status().Message())
+				b.appendAttributes(span.Attributes())
+				b.appendInt32(int32(span.Events().Len()))
+				b.appendInt32(int32(span.Links().Len()))
+				b.appendResource(rs.Resource(), rs.SchemaUrl(), ss.Scope())
+				b.endRow()
+			}
+		}
+	}
+	return b.NewRecord()
+}
+
+// MetricsToRecord converts the metrics to a record of MetricsSchema, the caller must release the record.
+func MetricsToRecord(md pmetric.Metrics) arrow.Record {
+	b := newRecordBuilder(MetricsSchema)
+	defer b.Release()
+
+	rms := md.ResourceMetrics()
+	for i := 0; i < rms.Len(); i++ {
+		rm := rms.At(i)
+		for j := 0; j < rm.ScopeMetrics().Len(); j++ {
+			sm := rm.ScopeMetrics().At(j)
+			for k := 0; k < sm.Metrics().Len(); k++ {
+				appendMetric(b, sm.Metrics().At(k), func() {
+					b.appendResource(rm.Resource(), rm.SchemaUrl(), sm.Scope())
+					b.endRow()
+				})
+			}
+		}
+	}
+	return b.NewRecord()
+}
+
+// metricPoint holds the columns of a data point.
+type metricPoint struct {
+	timestamp, startTimestamp pcommon.Timestamp
+	attributes                pcommon.Map
+
+	value, sum, min, max             float64
+	hasValue, hasSum, hasMin, hasMax bool
+	count                            uint64
+	hasCount                         bool
+	bucketCounts                     []uint64
+	explicitBounds                   []float64
+	hasBuckets                       bool
+}
+
+func appendMetric(b *recordBuilder, metric pmetric.Metric, endRow func()) {
+	var (
+		temporality    string
+		hasTemporality bool
+		monotonic      bool
+		hasMonotonic   bool
+		points         []metricPoint
+	)
+
+	//exhaustive:enforce
+	switch metric.Type() {
+	case pmetric.MetricTypeGauge:
+		points = numberPoints(metric.Gauge().DataPoints())
+	case pmetric.MetricTypeSum:
+		temporality, hasTemporality = metric.Sum().AggregationTemporality().String(), true
+		monotonic, hasMonotonic = metric.Sum().IsMonotonic(), true
+		points = numberPoints(metric.Sum().DataPoints())
+	case pmetric.MetricTypeHistogram:
+		temporality, hasTemporality = metric.Histogram().AggregationTemporality().String(), true
+		dps := metric.Histogram().DataPoints()
+		for i := 0; i < dps.Len(); i++ {
+			dp := dps.At(i)
+			points = append(points, metricPoint{
+				timestamp:      dp.Timestamp(),
+				startTimestamp: dp.StartTimestamp(),
+				attributes:     dp.Attributes(),
+				count:          dp.Count(),
+				hasCount:       true,
+				sum:            dp.Sum(),
+				hasSum:         dp.HasSum(),
+				min:            dp.Min(),
+				hasMin:         dp.HasMin(),
+				max:            dp.Max(),
+				hasMax:         dp.HasMax(),
+				bucketCounts:   dp.BucketCounts().AsRaw(),
+				explicitBounds: dp.ExplicitBounds().AsRaw(),
+				hasBuckets:     true,
+			})
+		}
+	case pmetric.MetricTypeExponentialHistogram:
+		temporality, hasTemporality = metric.ExponentialHistogram().AggregationTemporality().String(), true
+		dps := metric.ExponentialHistogram().DataPoints()
+		for i := 0; i < dps.Len(); i++ {
+			dp := dps.At(i)
+			points = append(points, metricPoint{
+				timestamp:      dp.Timestamp(),
+				startTimestamp: dp.StartTimestamp(),
+				attributes:     dp.Attributes(),
+				count:          dp.Count(),
+				hasCount:       true,
+				sum:            dp.Sum(),
+				hasSum:         dp.HasSum(),
+				min:            dp.Min(),
+				hasMin:         dp.HasMin(),
+				max:            dp.Max(),
+				hasMax:         dp.HasMax(),
+			})
+		}
+	case pmetric.MetricTypeSummary:
+		dps := metric.Summary().DataPoints()
+		for i := 0; i < dps.Len(); i++ {
+			dp := dps.At(i)
+			points = append(points, metricPoint{
+				timestamp:      dp.Timestamp(),
+				startTimestamp: dp.StartTimestamp(),
+				attributes:     dp.Attributes(),
+				count:          dp.Count(),
+				hasCount:       true,
+				sum:            dp.Sum(),
+				hasSum:         true,
+			})
+		}
+	case pmetric.MetricTypeEmpty:
+	}
+
+	for _, p := range points {
+		b.appendTimestamp(p.timestamp)
+		b.appendTimestamp(p.startTimestamp)
+		b.appendString(metric.Name())
+		b.appendString(metric.Description())
+		b.appendString(metric.Unit())
+		b.appendString(metric.Type().String())
+		b.appendNullableString(temporality, hasTemporality)
+		b.appendNullableBool(monotonic, hasMonotonic)
+		b.appendAttributes(p.attributes)
+		b.appendFloat64(p.value, p.hasValue)
+		b.appendUint64(p.count, p.hasCount)
+		b.appendFloat64(p.sum, p.hasSum)
+		b.appendFloat64(p.min, p.hasMin)
+		b.appendFloat64(p.max, p.hasMax)
+		b.appendUint64List(p.bucketCounts, p.hasBuckets)
+		b.appendFloat64List(p.explicitBounds, p.hasBuckets)
+		endRow()
+	}
+}
+
+func numberPoints(dps pmetric.NumberDataPointSlice) []metricPoint {
+	points := make([]metricPoint, 0, dps.Len())
+	for i := 0; i < dps.Len(); i++ {
+		dp := dps.At(i)
+		p := metricPoint{
+			timestamp:      dp.Timestamp(),
+			startTimestamp: dp.StartTimestamp(),
+			attributes:     dp.Attributes(),
+			hasValue:       dp.ValueType() != pmetric.NumberDataPointValueTypeEmpty,
+		}
+		if dp.ValueType() == pmetric.NumberDataPointValueTypeInt {
+			p.value = float64(dp.IntValue())
+		} else {
+			p.value = dp.DoubleValue()
+		}
+		points = append(points, p)
+	}
+	return points
+}
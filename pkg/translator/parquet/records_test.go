@@ -0,0 +1,161 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package parquet
+
+import (
+	"testing"
+
+	"github.com/apache/arrow/go/v15/arrow"
+	"github.com/apache/arrow/go/v15/arrow/array"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/collector/pdata/pcommon"
+	"go.opentelemetry.io/collector/pdata/plog"
+	"go.opentelemetry.io/collector/pdata/pmetric"
+	"go.opentelemetry.io/collector/pdata/ptrace"
+)
+
+func column(t *testing.T, rec arrow.Record, name string) arrow.Array {
+	indices := rec.Schema().FieldIndices(name)
+	require.Len(t, indices, 1)
+	return rec.Column(indices[0])
+}
+
+func TestLogsToRecord(t *testing.T) {
+	ld := plog.NewLogs()
+	rl := ld.ResourceLogs().AppendEmpty()
+	rl.Resource().Attributes().PutStr("service.name", "checkout")
+	rl.SetSchemaUrl("https://opentelemetry.io/schemas/1.24.0")
+	sl := rl.ScopeLogs().AppendEmpty()
+	sl.Scope().SetName("scope")
+	lr := sl.LogRecords().AppendEmpty()
+	lr.SetTimestamp(pcommon.Timestamp(1000))
+	lr.SetSeverityNumber(plog.SeverityNumberError)
+	lr.SetSeverityText("ERROR")
+	lr.Body().SetStr("payment failed")
+	lr.Attributes().PutInt("http.status_code", 500)
+	lr.SetTraceID([16]byte{1, 2, 3, 4, 5, 6, 7, 8, 9, 10, 11, 12, 13, 14, 15, 16})
+	sl.LogRecords().AppendEmpty().Body().SetEmptyMap().PutStr("key", "value")
+
+	rec := LogsToRecord(ld)
+	defer rec.Release()
+	require.EqualValues(t, 2, rec.NumRows())
+	assert.True(t, LogsSchema.Equal(rec.Schema()))
+
+	assert.Equal(t, arrow.Timestamp(1000), column(t, rec, "timestamp").(*array.Timestamp).Value(0))
+	assert.Equal(t, int32(plog.SeverityNumberError), column(t, rec, "severity_number").(*array.Int32).Value(0))
+	body := column(t, rec, "body").(*array.String)
+	assert.Equal(t, "payment failed", body.Value(0))
+	assert.Equal(t, `{"key":"value"}`, body.Value(1))
+	traceIDs := column(t, rec, "trace_id").(*array.String)
+	assert.Equal(t, "0102030405060708090a0b0c0d0e0f10", traceIDs.Value(0))
+	assert.Equal(t, "", traceIDs.Value(1))
+
+	attributes := column(t, rec, "attributes").(*array.Map)
+	assert.Equal(t, "http.status_code", attributes.Keys().(*array.String).Value(0))
+	assert.Equal(t, "500", attributes.Items().(*array.String).Value(0))
+	resource := column(t, rec, "resource_attributes").(*array.Map)
+	start, end := resource.ValueOffsets(1)
+	assert.EqualValues(t, 1, end-start)
+	assert.Equal(t, "https://opentelemetry.io/schemas/1.24.0", column(t, rec, "resource_schema_url").(*array.String).Value(1))
+	assert.Equal(t, "scope", column(t, rec, "scope_name").(*array.String).Value(1))
+}
+
+func TestTracesToRecord(t *testing.T) {
+	td := ptrace.NewTraces()
+	ss := td.ResourceSpans().AppendEmpty().ScopeSpans().AppendEmpty()
+	span := ss.Spans().AppendEmpty()
+	span.SetName("GET /cart")
+	span.SetKind(ptrace.SpanKindServer)
+	span.SetStartTimestamp(pcommon.Timestamp(1000))
+	span.SetEndTimestamp(pcommon.Timestamp(1500))
+	span.Status().SetCode(ptrace.StatusCodeError)
+	span.Status().SetMessage("timeout")
+	span.Events().AppendEmpty()
+	span.SetParentSpanID([8]byte{1, 2, 3, 4, 5, 6, 7, 8})
+
+	rec := TracesToRecord(td)
+	defer rec.Release()
+	require.EqualValues(t, 1, rec.NumRows())
+	assert.True(t, TracesSchema.Equal(rec.Schema()))
+
+	assert.Equal(t, int64(500), column(t, rec, "duration_nanos").(*array.Int64).Value(0))
+	assert.Equal(t, "GET /cart", column(t, rec, "name").(*array.String).Value(0))
+	assert.Equal(t, "Server", column(t, rec, "kind").(*array.String).Value(0))
+	assert.Equal(t, "Error", column(t, rec, "status_code").(*array.String).Value(0))
+	assert.Equal(t, "timeout", column(t, rec, "status_message").(*array.String).Value(0))
+	assert.Equal(t, "0102030405060708", column(t, rec, "parent_span_id").(*array.String).Value(0))
+	assert.Equal(t, int32(1), column(t, rec, "events_count").(*array.Int32).Value(0))
+	assert.Equal(t, int32(0), column(t, rec, "links_count").(*array.Int32).Value(0))
+}
+
+func TestMetricsToRecord(t *testing.T) {
+	md := pmetric.NewMetrics()
+	rm := md.ResourceMetrics().AppendEmpty()
+	rm.Resource().Attributes().PutStr("service.name", "checkout")
+	sm := rm.ScopeMetrics().AppendEmpty()
+	sm.Scope().SetName("scope")
+
+	sum := sm.Metrics().AppendEmpty()
+	sum.SetName("requests")
+	sum.SetEmptySum().SetIsMonotonic(true)
+	sum.Sum().SetAggregationTemporality(pmetric.AggregationTemporalityCumulative)
+	dp := sum.Sum().DataPoints().AppendEmpty()
+	dp.SetIntValue(42)
+	dp.Attributes().PutStr("route", "/cart")
+
+	histogram := sm.Metrics().AppendEmpty()
+	histogram.SetName("latency")
+	hdp := histogram.SetEmptyHistogram().DataPoints().AppendEmpty()
+	hdp.SetCount(3)
+	hdp.SetSum(1.5)
+	hdp.BucketCounts().FromRaw([]uint64{1, 2})
+	hdp.ExplicitBounds().FromRaw([]float64{1})
+
+	summary := sm.Metrics().AppendEmpty()
+	summary.SetName("sizes")
+	summary.SetEmptySummary().DataPoints().AppendEmpty().SetCount(2)
+	sm.Metrics().AppendEmpty().SetName("empty")
+
+	rec := MetricsToRecord(md)
+	defer rec.Release()
+	require.EqualValues(t, 3, rec.NumRows())
+	assert.True(t, MetricsSchema.Equal(rec.Schema()))
+
+	names := column(t, rec, "metric_name").(*array.String)
+	assert.Equal(t, "requests", names.Value(0))
+	assert.Equal(t, "latency", names.Value(1))
+	assert.Equal(t, "sizes", names.Value(2))
+	assert.Equal(t, "Sum", column(t, rec, "metric_type").(*array.String).Value(0))
+	temporality := column(t, rec, "aggregation_temporality").(*array.String)
+	assert.Equal(t, "Cumulative", temporality.Value(0))
+	assert.True(t, temporality.IsNull(2))
+	assert.True(t, column(t, rec, "is_monotonic").(*array.Boolean).Value(0))
+	assert.True(t, column(t, rec, "is_monotonic").IsNull(1))
+
+	values := column(t, rec, "value").(*array.Float64)
+	assert.Equal(t, float64(42), values.Value(0))
+	assert.True(t, values.IsNull(1))
+	counts := column(t, rec, "count").(*array.Uint64)
+	assert.True(t, counts.IsNull(0))
+	assert.Equal(t, uint64(3), counts.Value(1))
+	assert.Equal(t, uint64(2), counts.Value(2))
+	assert.Equal(t, 1.5, column(t, rec, "sum").(*array.Float64).Value(1))
+	assert.True(t, column(t, rec, "min").IsNull(1))
+
+	buckets := column(t, rec, "bucket_counts").(*array.List)
+	assert.True(t, buckets.IsNull(0))
+	assert.False(t, buckets.IsNull(1))
+	start, end := buckets.ValueOffsets(1)
+	assert.Equal(t, []uint64{1, 2}, buckets.ListValues().(*array.Uint64).Uint64Values()[start:end])
+}
+
+func TestCompressionCodec(t *testing.T) {
+	for _, name := range []string{CompressionNone, CompressionSnappy, CompressionGzip, CompressionZstd} {
+		_, err := CompressionCodec(name)
+		assert.NoError(t, err, name)
+	}
+	_, err := CompressionCodec("lz4")
+	assert.EqualError(t, err, `parquet compression "lz4" is not supported`)
+}
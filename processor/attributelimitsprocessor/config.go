@@ -0,0 +1,42 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package attributelimitsprocessor // import "github.com/open-telemetry/opentelemetry-collector-contrib/processor/attributelimitsprocessor"
+
+import (
+	"errors"
+
+	"go.opentelemetry.io/collector/component"
+)
+
+// Config defines configuration for the attribute limits processor. A limit of 0 disables it.
+type Config struct {
+	// MaxValueLength is the maximum length in bytes of the string and bytes attribute values, including the
+	// values nested in maps and slices. Longer strings are truncated on a UTF-8 character boundary.
+	MaxValueLength int `mapstructure:"max_value_length"`
+
+	// MaxAttributes is the maximum number of attributes of each resource, scope, span, span event, span link,
+	// data point and log record. The attributes over the limit are dropped, keeping the first ones.
+	MaxAttributes int `mapstructure:"max_attributes"`
+
+	// MaxBodyDepth is the maximum nesting depth of the map and slice log bodies, the body itself being at depth 1.
+	// The deeper levels are replaced by their JSON representation.
+	MaxBodyDepth int `mapstructure:"max_body_depth"`
+
+	// MaxBodySize is the maximum size in bytes of the log bodies. Longer string and bytes bodies are truncated,
+	// and the map and slice bodies are replaced by their truncated JSON representation.
+	MaxBodySize int `mapstructure:"max_body_size"`
+}
+
+var _ component.Config = (*Config)(nil)
+
+// Validate checks if the processor configuration is valid.
+func (cfg *Config) Validate() error {
+	if cfg.MaxValueLength < 0 || cfg.MaxAttributes < 0 || cfg.MaxBodyDepth < 0 || cfg.MaxBodySize < 0 {
+		return errors.New("limits can't be negative")
+	}
+	if cfg.MaxValueLength == 0 && cfg.MaxAttributes == 0 && cfg.MaxBodyDepth == 0 && cfg.MaxBodySize == 0 {
+		return errors.New("at least one limit must be set")
+	}
+	return nil
+}
@@ -0,0 +1,9 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+//go:generate mdatagen metadata.yaml
+
+// Package attributelimitsprocessor implements a processor that enforces
+// limits on the attribute values, the number of attributes and the log
+// bodies, so that the data fits the field limits of the backends.
+package attributelimitsprocessor // import "github.com/open-telemetry/opentelemetry-collector-contrib/processor/attributelimitsprocessor"
@@ -0,0 +1,140 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package attributelimitsprocessor // import "github.com/open-telemetry/opentelemetry-collector-contrib/processor/attributelimitsprocessor"
+
+import (
+	"unicode/utf8"
+
+	"go.opentelemetry.io/collector/pdata/pcommon"
+)
+
+// limitStats counts the changes made to the data to enforce each limit.
+type limitStats struct {
+	truncatedValues   int64
+	droppedAttributes int64
+	flattenedBodies   int64
+	truncatedBodies   int64
+}
+
+// limiter enforces the limits of the configuration, counting the changes in its stats.
+type limiter struct {
+	cfg   *Config
+	stats limitStats
+}
+
+// limitAttributes enforces the limits of the attributes, and returns the number of dropped attributes.
+func (l *limiter) limitAttributes(attrs pcommon.Map) uint32 {
+	var dropped uint32
+	if l.cfg.MaxAttributes > 0 && attrs.Len() > l.cfg.MaxAttributes {
+		kept := 0
+		attrs.RemoveIf(func(string, pcommon.Value) bool {
+			kept++
+			return kept > l.cfg.MaxAttributes
+		})
+		dropped = uint32(kept - l.cfg.MaxAttributes)
+		l.stats.droppedAttributes += int64(dropped)
+	}
+	if l.cfg.MaxValueLength > 0 {
+		attrs.Range(func(_ string, v pcommon.Value) bool {
+			l.limitValue(v)
+			return true
+		})
+	}
+	return dropped
+}
+
+// limitValue truncates the string and bytes values, including the ones nested in maps and slices.
+func (l *limiter) limitValue(v pcommon.Value) {
+	switch v.Type() {
+	case pcommon.ValueTypeStr:
+		if truncated, ok := truncateUTF8(v.Str(), l.cfg.MaxValueLength); ok {
+			v.SetStr(truncated)
+			l.stats.truncatedValues++
+		}
+	case pcommon.ValueTypeBytes:
+		if v.Bytes().Len() > l.cfg.MaxValueLength {
+			truncated := v.Bytes().AsRaw()[:l.cfg.MaxValueLength]
+			v.SetEmptyBytes().FromRaw(truncated)
+			l.stats.truncatedValues++
+		}
+	case pcommon.ValueTypeMap:
+		v.Map().Range(func(_ string, nested pcommon.Value) bool {
+			l.limitValue(nested)
+			return true
+		})
+	case pcommon.ValueTypeSlice:
+		for i := 0; i < v.Slice().Len(); i++ {
+			l.limitValue(v.Slice().At(i))
+		}
+	}
+}
+
+// limitBody enforces the depth and then the size limits of a log body.
+func (l *limiter) limitBody(body pcommon.Value) {
+	if l.cfg.MaxBodyDepth > 0 && flatten(body, 1, l.cfg.MaxBodyDepth) {
+		l.stats.flattenedBodies++
+	}
+	if l.cfg.MaxBodySize <= 0 {
+		return
+	}
+	switch body.Type() {
+	case pcommon.ValueTypeStr:
+		if truncated, ok := truncateUTF8(body.Str(), l.cfg.MaxBodySize); ok {
+			body.SetStr(truncated)
+			l.stats.truncatedBodies++
+		}
+	case pcommon.ValueTypeBytes:
+		if body.Bytes().Len() > l.cfg.MaxBodySize {
+			truncated := body.Bytes().AsRaw()[:l.cfg.MaxBodySize]
+			body.SetEmptyBytes().FromRaw(truncated)
+			l.stats.truncatedBodies++
+		}
+	case pcommon.ValueTypeMap, pcommon.ValueTypeSlice:
+		if truncated, ok := truncateUTF8(body.AsString(), l.cfg.MaxBodySize); ok {
+			body.SetStr(truncated)
+			l.stats.truncatedBodies++
+		}
+	}
+}
+
+// flatten replaces the maps and slices nested in v deeper than the max depth with their JSON representation,
+// v being at the given depth. It reports whether anything was replaced.
+func flatten(v pcommon.Value, depth int, maxDepth int) bool {
+	flattened := false
+	visit := func(nested pcommon.Value) {
+		if nested.Type() != pcommon.ValueTypeMap && nested.Type() != pcommon.ValueTypeSlice {
+			return
+		}
+		if depth >= maxDepth {
+			nested.SetStr(nested.AsString())
+			flattened = true
+			return
+		}
+		flattened = flatten(nested, depth+1, maxDepth) || flattened
+	}
+	switch v.Type() {
+	case pcommon.ValueTypeMap:
+		v.Map().Range(func(_ string, nested pcommon.Value) bool {
+			visit(nested)
+			return true
+		})
+	case pcommon.ValueTypeSlice:
+		for i := 0; i < v.Slice().Len(); i++ {
+			visit(v.Slice().At(i))
+		}
+	}
+	return flattened
+}
+
+// truncateUTF8 truncates s to at most limit bytes without splitting a UTF-8 encoded character, and reports whether
+// it was truncated.
+func truncateUTF8(s string, limit int) (string, bool) {
+	if len(s) <= limit {
+		return s, false
+	}
+	for limit > 0 && !utf8.RuneStart(s[limit]) {
+		limit--
+	}
+	return s[:limit], true
+}
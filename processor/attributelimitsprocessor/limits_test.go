@@ -0,0 +1,117 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package attributelimitsprocessor
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/collector/pdata/pcommon"
+)
+
+func TestTruncateUTF8(t *testing.T) {
+	for _, tt := range []struct {
+		s         string
+		limit     int
+		expected  string
+		truncated bool
+	}{
+		{s: "hello", limit: 10, expected: "hello"},
+		{s: "hello", limit: 5, expected: "hello"},
+		{s: "hello", limit: 3, expected: "hel", truncated: true},
+		// é is 2 bytes, it isn't split
+		{s: "café", limit: 4, expected: "caf", truncated: true},
+		{s: "café", limit: 5, expected: "café"},
+		// 😀 is 4 bytes
+		{s: "😀😀", limit: 7, expected: "😀", truncated: true},
+		{s: "😀", limit: 3, expected: "", truncated: true},
+		{s: "hello", limit: 0, expected: "", truncated: true},
+	} {
+		t.Run(tt.s, func(t *testing.T) {
+			truncated, ok := truncateUTF8(tt.s, tt.limit)
+			assert.Equal(t, tt.expected, truncated)
+			assert.Equal(t, tt.truncated, ok)
+		})
+	}
+}
+
+func TestLimitAttributes(t *testing.T) {
+	attrs := pcommon.NewMap()
+	require.NoError(t, attrs.FromRaw(map[string]any{
+		"a": "short",
+		"b": "long value",
+		"c": []byte("long bytes"),
+		"d": map[string]any{"nested": "long nested value"},
+		"e": []any{"long slice value", 1},
+	}))
+
+	l := &limiter{cfg: &Config{MaxValueLength: 4}}
+	assert.Zero(t, l.limitAttributes(attrs))
+	assert.Equal(t, map[string]any{
+		"a": "shor",
+		"b": "long",
+		"c": []byte("long"),
+		"d": map[string]any{"nested": "long"},
+		"e": []any{"long", int64(1)},
+	}, attrs.AsRaw())
+	assert.Equal(t, limitStats{truncatedValues: 5}, l.stats)
+
+	l = &limiter{cfg: &Config{MaxAttributes: 2}}
+	assert.Equal(t, uint32(3), l.limitAttributes(attrs))
+	assert.Equal(t, 2, attrs.Len())
+	assert.Equal(t, limitStats{droppedAttributes: 3}, l.stats)
+}
+
+func TestLimitBody(t *testing.T) {
+	newBody := func() pcommon.Value {
+		body := pcommon.NewValueEmpty()
+		require.NoError(t, body.FromRaw(map[string]any{
+			"level1": map[string]any{
+				"level2": map[string]any{"level3": "value"},
+			},
+			"list": []any{[]any{"a", "b"}},
+			"msg":  "message",
+		}))
+		return body
+	}
+
+	t.Run("depth", func(t *testing.T) {
+		body := newBody()
+		l := &limiter{cfg: &Config{MaxBodyDepth: 2}}
+		l.limitBody(body)
+		assert.Equal(t, map[string]any{
+			"level1": map[string]any{"level2": `{"level3":"value"}`},
+			"list":   []any{`["a","b"]`},
+			"msg":    "message",
+		}, body.AsRaw())
+		assert.Equal(t, limitStats{flattenedBodies: 1}, l.stats)
+
+		// within the limit
+		l = &limiter{cfg: &Config{MaxBodyDepth: 3}}
+		l.limitBody(newBody())
+		assert.Equal(t, limitStats{}, l.stats)
+	})
+
+	t.Run("structured size", func(t *testing.T) {
+		body := newBody()
+		l := &limiter{cfg: &Config{MaxBodySize: 10}}
+		l.limitBody(body)
+		assert.Equal(t, `{"level1":`, body.Str())
+		assert.Equal(t, limitStats{truncatedBodies: 1}, l.stats)
+	})
+
+	t.Run("string size", func(t *testing.T) {
+		body := pcommon.NewValueStr("ünïcödé")
+		l := &limiter{cfg: &Config{MaxBodySize: 4}}
+		l.limitBody(body)
+		assert.Equal(t, "ün", body.Str())
+
+		body = pcommon.NewValueBytes()
+		body.Bytes().FromRaw([]byte("0123456789"))
+		l.limitBody(body)
+		assert.Equal(t, []byte("0123"), body.Bytes().AsRaw())
+		assert.Equal(t, limitStats{truncatedBodies: 2}, l.stats)
+	})
+}
@@ -0,0 +1,152 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package attributelimitsprocessor // import "github.com/open-telemetry/opentelemetry-collector-contrib/processor/attributelimitsprocessor"
+
+import (
+	"context"
+
+	"go.opentelemetry.io/collector/pdata/pcommon"
+	"go.opentelemetry.io/collector/pdata/plog"
+	"go.opentelemetry.io/collector/pdata/pmetric"
+	"go.opentelemetry.io/collector/pdata/ptrace"
+	"go.opentelemetry.io/collector/processor"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/metric"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/processor/attributelimitsprocessor/internal/metadata"
+)
+
+type attributeLimitsProcessor struct {
+	cfg *Config
+
+	telemetryBuilder *metadata.TelemetryBuilder
+	processorAttr    []attribute.KeyValue
+}
+
+func newAttributeLimitsProcessor(set processor.CreateSettings, cfg *Config) (*attributeLimitsProcessor, error) {
+	telemetryBuilder, err := metadata.NewTelemetryBuilder(set.TelemetrySettings)
+	if err != nil {
+		return nil, err
+	}
+	return &attributeLimitsProcessor{
+		cfg:              cfg,
+		telemetryBuilder: telemetryBuilder,
+		processorAttr:    []attribute.KeyValue{attribute.String(metadata.Type.String(), set.ID.String())},
+	}, nil
+}
+
+func (p *attributeLimitsProcessor) processTraces(ctx context.Context, td ptrace.Traces) (ptrace.Traces, error) {
+	l := &limiter{cfg: p.cfg}
+	for i := 0; i < td.ResourceSpans().Len(); i++ {
+		rs := td.ResourceSpans().At(i)
+		l.limitResource(rs.Resource())
+		for j := 0; j < rs.ScopeSpans().Len(); j++ {
+			ss := rs.ScopeSpans().At(j)
+			l.limitScope(ss.Scope())
+			for k := 0; k < ss.Spans().Len(); k++ {
+				span := ss.Spans().At(k)
+				span.SetDroppedAttributesCount(span.DroppedAttributesCount() + l.limitAttributes(span.Attributes()))
+				for e := 0; e < span.Events().Len(); e++ {
+					event := span.Events().At(e)
+					event.SetDroppedAttributesCount(event.DroppedAttributesCount() + l.limitAttributes(event.Attributes()))
+				}
+				for n := 0; n < span.Links().Len(); n++ {
+					link := span.Links().At(n)
+					link.SetDroppedAttributesCount(link.DroppedAttributesCount() + l.limitAttributes(link.Attributes()))
+				}
+			}
+		}
+	}
+	p.record(ctx, l.stats)
+	return td, nil
+}
+
+func (p *attributeLimitsProcessor) processMetrics(ctx context.Context, md pmetric.Metrics) (pmetric.Metrics, error) {
+	l := &limiter{cfg: p.cfg}
+	for i := 0; i < md.ResourceMetrics().Len(); i++ {
+		rm := md.ResourceMetrics().At(i)
+		l.limitResource(rm.Resource())
+		for j := 0; j < rm.ScopeMetrics().Len(); j++ {
+			sm := rm.ScopeMetrics().At(j)
+			l.limitScope(sm.Scope())
+			for k := 0; k < sm.Metrics().Len(); k++ {
+				l.limitDataPoints(sm.Metrics().At(k))
+			}
+		}
+	}
+	p.record(ctx, l.stats)
+	return md, nil
+}
+
+func (p *attributeLimitsProcessor) processLogs(ctx context.Context, ld plog.Logs) (plog.Logs, error) {
+	l := &limiter{cfg: p.cfg}
+	for i := 0; i < ld.ResourceLogs().Len(); i++ {
+		rl := ld.ResourceLogs().At(i)
+		l.limitResource(rl.Resource())
+		for j := 0; j < rl.ScopeLogs().Len(); j++ {
+			sl := rl.ScopeLogs().At(j)
+			l.limitScope(sl.Scope())
+			for k := 0; k < sl.LogRecords().Len(); k++ {
+				lr := sl.LogRecords().At(k)
+				lr.SetDroppedAttributesCount(lr.DroppedAttributesCount() + l.limitAttributes(lr.Attributes()))
+				l.limitBody(lr.Body())
+			}
+		}
+	}
+	p.record(ctx, l.stats)
+	return ld, nil
+}
+
+func (l *limiter) limitResource(resource pcommon.Resource) {
+	resource.SetDroppedAttributesCount(resource.DroppedAttributesCount() + l.limitAttributes(resource.Attributes()))
+}
+
+func (l *limiter) limitScope(scope pcommon.InstrumentationScope) {
+	scope.SetDroppedAttributesCount(scope.DroppedAttributesCount() + l.limitAttributes(scope.Attributes()))
+}
+
+// limitDataPoints enforces the limits of the attributes of the data points, which don't keep a count of the
+// dropped attributes.
+func (l *limiter) limitDataPoints(m pmetric.Metric) {
+	//exhaustive:enforce
+	switch m.Type() {
+	case pmetric.MetricTypeGauge:
+		for i := 0; i < m.Gauge().DataPoints().Len(); i++ {
+			l.limitAttributes(m.Gauge().DataPoints().At(i).Attributes())
+		}
+	case pmetric.MetricTypeSum:
+		for i := 0; i < m.Sum().DataPoints().Len(); i++ {
+			l.limitAttributes(m.Sum().DataPoints().At(i).Attributes())
+		}
+	case pmetric.MetricTypeHistogram:
+		for i := 0; i < m.Histogram().DataPoints().Len(); i++ {
+			l.limitAttributes(m.Histogram().DataPoints().At(i).Attributes())
+		}
+	case pmetric.MetricTypeExponentialHistogram:
+		for i := 0; i < m.ExponentialHistogram().DataPoints().Len(); i++ {
+			l.limitAttributes(m.ExponentialHistogram().DataPoints().At(i).Attributes())
+		}
+	case pmetric.MetricTypeSummary:
+		for i := 0; i < m.Summary().DataPoints().Len(); i++ {
+			l.limitAttributes(m.Summary().DataPoints().At(i).Attributes())
+		}
+	case pmetric.MetricTypeEmpty:
+	}
+}
+
+func (p *attributeLimitsProcessor) record(ctx context.Context, stats limitStats) {
+	attrs := metric.WithAttributes(p.processorAttr...)
+	if stats.truncatedValues > 0 {
+		p.telemetryBuilder.ProcessorAttributelimitsValuesTruncated.Add(ctx, stats.truncatedValues, attrs)
+	}
+	if stats.droppedAttributes > 0 {
+		p.telemetryBuilder.ProcessorAttributelimitsAttributesDropped.Add(ctx, stats.droppedAttributes, attrs)
+	}
+	if stats.flattenedBodies > 0 {
+		p.telemetryBuilder.ProcessorAttributelimitsBodiesFlattened.Add(ctx, stats.flattenedBodies, attrs)
+	}
+	if stats.truncatedBodies > 0 {
+		p.telemetryBuilder.ProcessorAttributelimitsBodiesTruncated.Add(ctx, stats.truncatedBodies, attrs)
+	}
+}
@@ -0,0 +1,121 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package attributelimitsprocessor
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/collector/consumer/consumertest"
+	"go.opentelemetry.io/collector/pdata/plog"
+	"go.opentelemetry.io/collector/pdata/pmetric"
+	"go.opentelemetry.io/collector/pdata/ptrace"
+	"go.opentelemetry.io/collector/processor/processortest"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/sdk/metric/metricdata"
+)
+
+func TestProcessTraces(t *testing.T) {
+	cfg := &Config{MaxValueLength: 5, MaxAttributes: 2}
+	next := new(consumertest.TracesSink)
+	p, err := NewFactory().CreateTracesProcessor(context.Background(), processortest.NewNopCreateSettings(), cfg, next)
+	require.NoError(t, err)
+
+	td := ptrace.NewTraces()
+	rs := td.ResourceSpans().AppendEmpty()
+	rs.Resource().Attributes().PutStr("service.name", "checkout-service")
+	ss := rs.ScopeSpans().AppendEmpty()
+	span := ss.Spans().AppendEmpty()
+	span.Attributes().PutStr("a", "1")
+	span.Attributes().PutStr("b", "2")
+	span.Attributes().PutStr("c", "3")
+	span.SetDroppedAttributesCount(1)
+	event := span.Events().AppendEmpty()
+	event.Attributes().PutStr("exception.message", "something failed")
+	link := span.Links().AppendEmpty()
+	link.Attributes().PutStr("a", "1")
+
+	require.NoError(t, p.ConsumeTraces(context.Background(), td))
+
+	got := next.AllTraces()[0].ResourceSpans().At(0)
+	assert.Equal(t, map[string]any{"service.name": "check"}, got.Resource().Attributes().AsRaw())
+	span = got.ScopeSpans().At(0).Spans().At(0)
+	assert.Equal(t, map[string]any{"a": "1", "b": "2"}, span.Attributes().AsRaw())
+	assert.Equal(t, uint32(2), span.DroppedAttributesCount())
+	assert.Equal(t, map[string]any{"exception.message": "somet"}, span.Events().At(0).Attributes().AsRaw())
+	assert.Equal(t, uint32(0), span.Events().At(0).DroppedAttributesCount())
+}
+
+func TestProcessMetrics(t *testing.T) {
+	cfg := &Config{MaxAttributes: 1}
+	next := new(consumertest.MetricsSink)
+	p, err := NewFactory().CreateMetricsProcessor(context.Background(), processortest.NewNopCreateSettings(), cfg, next)
+	require.NoError(t, err)
+
+	md := pmetric.NewMetrics()
+	metrics := md.ResourceMetrics().AppendEmpty().ScopeMetrics().AppendEmpty().Metrics()
+	gauge := metrics.AppendEmpty().SetEmptyGauge().DataPoints().AppendEmpty()
+	gauge.Attributes().PutStr("a", "1")
+	gauge.Attributes().PutStr("b", "2")
+	histogram := metrics.AppendEmpty().SetEmptyHistogram().DataPoints().AppendEmpty()
+	histogram.Attributes().PutStr("a", "1")
+	histogram.Attributes().PutStr("b", "2")
+	histogram.Attributes().PutStr("c", "3")
+
+	require.NoError(t, p.ConsumeMetrics(context.Background(), md))
+
+	got := next.AllMetrics()[0].ResourceMetrics().At(0).ScopeMetrics().At(0).Metrics()
+	assert.Equal(t, map[string]any{"a": "1"}, got.At(0).Gauge().DataPoints().At(0).Attributes().AsRaw())
+	assert.Equal(t, map[string]any{"a": "1"}, got.At(1).Histogram().DataPoints().At(0).Attributes().AsRaw())
+}
+
+func TestProcessLogs(t *testing.T) {
+	tel := setupTestTelemetry()
+	cfg := &Config{MaxValueLength: 3, MaxAttributes: 1, MaxBodyDepth: 1, MaxBodySize: 32}
+	next := new(consumertest.LogsSink)
+	p, err := NewFactory().CreateLogsProcessor(context.Background(), tel.NewCreateSettings(), cfg, next)
+	require.NoError(t, err)
+
+	ld := plog.NewLogs()
+	records := ld.ResourceLogs().AppendEmpty().ScopeLogs().AppendEmpty().LogRecords()
+	lr := records.AppendEmpty()
+	lr.Attributes().PutStr("user.id", "12345")
+	lr.Attributes().PutStr("user.name", "jane")
+	require.NoError(t, lr.Body().FromRaw(map[string]any{"request": map[string]any{"path": "/"}}))
+	records.AppendEmpty().Body().SetStr("a message longer than the limit of the log bodies")
+
+	require.NoError(t, p.ConsumeLogs(context.Background(), ld))
+
+	got := next.AllLogs()[0].ResourceLogs().At(0).ScopeLogs().At(0).LogRecords()
+	assert.Equal(t, map[string]any{"user.id": "123"}, got.At(0).Attributes().AsRaw())
+	assert.Equal(t, uint32(1), got.At(0).DroppedAttributesCount())
+	assert.Equal(t, map[string]any{"request": `{"path":"/"}`}, got.At(0).Body().AsRaw())
+	assert.Equal(t, "a message longer than the limit ", got.At(1).Body().Str())
+
+	sum := func(name string, description string, value int64) metricdata.Metrics {
+		return metricdata.Metrics{
+			Name:        name,
+			Description: description,
+			Unit:        "1",
+			Data: metricdata.Sum[int64]{
+				Temporality: metricdata.CumulativeTemporality,
+				IsMonotonic: true,
+				DataPoints: []metricdata.DataPoint[int64]{
+					{
+						Value:      value,
+						Attributes: attribute.NewSet(attribute.String("attributelimits", "attributelimits")),
+					},
+				},
+			},
+		}
+	}
+	tel.assertMetrics(t, []metricdata.Metrics{
+		sum("processor_attributelimits_values.truncated", "Number of attribute values truncated to the max_value_length", 1),
+		sum("processor_attributelimits_attributes.dropped", "Number of attributes dropped over the max_attributes", 1),
+		sum("processor_attributelimits_bodies.flattened", "Number of log bodies whose levels nested deeper than the max_body_depth were flattened", 1),
+		sum("processor_attributelimits_bodies.truncated", "Number of log bodies truncated to the max_body_size", 1),
+	})
+}
@@ -197,6 +197,18 @@ func TestLoadConfig(t *testing.T) {
 				},
 			},
 		},
+		{
+			id: component.NewIDWithName(metadata.Type, "nested"),
+			expected: &Config{
+				Settings: attraction.Settings{
+					Actions: []attraction.ActionKeyValue{
+						{Key: "tenant", FromAttribute: `attributes["http.request.header"]["x-tenant"]`, Action: attraction.INSERT},
+						{Key: `attributes["http.request.header"]["authorization"]`, Action: attraction.DELETE},
+						{Key: "http.request.header", Action: attraction.FLATTEN},
+					},
+				},
+			},
+		},
 	}
 
 	for _, tt := range tests {
@@ -31,6 +31,14 @@ type Config struct {
 	// Cannot be used with deprecated Metrics config option.
 	Include MatchMetrics `mapstructure:"include"`
 	Exclude MatchMetrics `mapstructure:"exclude"`
+
+	// StorageID is the optional storage extension where the last values of the series are saved on shutdown, so that
+	// the first points after a restart are converted against them.
+	StorageID *component.ID `mapstructure:"storage"`
+
+	// StorageMaxStaleness is how old the saved values can be to be restored. When 0, MaxStaleness is used, and all
+	// the values are restored when both are 0.
+	StorageMaxStaleness time.Duration `mapstructure:"storage_max_staleness"`
 }
 
 type MatchMetrics struct {
@@ -52,5 +60,8 @@ func (config *Config) Validate() error {
 		(len(config.Exclude.MatchType) > 0 && len(config.Exclude.Metrics) == 0) {
 		return fmt.Errorf("metrics must be supplied if match_type is set")
 	}
+	if config.StorageMaxStaleness < 0 {
+		return fmt.Errorf("storage_max_staleness must not be negative")
+	}
 	return nil
 }
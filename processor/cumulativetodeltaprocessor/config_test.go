@@ -21,6 +21,7 @@ import (
 func TestLoadConfig(t *testing.T) {
 	t.Parallel()
 
+	storageID := component.MustNewID("file_storage")
 	tests := []struct {
 		id           component.ID
 		expected     component.Config
@@ -108,6 +109,17 @@ func TestLoadConfig(t *testing.T) {
 				InitialValue: tracking.InitialValueDrop,
 			},
 		},
+		{
+			id: component.NewIDWithName(metadata.Type, "storage"),
+			expected: &Config{
+				StorageID:           &storageID,
+				StorageMaxStaleness: time.Hour,
+			},
+		},
+		{
+			id:           component.NewIDWithName(metadata.Type, "negative_storage_max_staleness"),
+			errorMessage: "storage_max_staleness must not be negative",
+		},
 	}
 
 	for _, tt := range tests {
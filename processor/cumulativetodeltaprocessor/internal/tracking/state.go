@@ -0,0 +1,55 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package tracking // import "github.com/open-telemetry/opentelemetry-collector-contrib/processor/cumulativetodeltaprocessor/internal/tracking"
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"go.opentelemetry.io/collector/pdata/pcommon"
+)
+
+// savedState is the persisted last value of a series. The identity is kept as bytes since the
+// hashes of the attributes it contains aren't valid strings.
+type savedState struct {
+	Identity  []byte     `json:"identity"`
+	PrevPoint ValuePoint `json:"prev_point"`
+}
+
+// MarshalStates returns the last values of the tracked series, so that they can be restored after a restart.
+func (t *MetricTracker) MarshalStates() ([]byte, error) {
+	var states []savedState
+	t.states.Range(func(key, value any) bool {
+		s := value.(*State)
+		s.Lock()
+		states = append(states, savedState{Identity: []byte(key.(string)), PrevPoint: s.PrevPoint})
+		s.Unlock()
+		return true
+	})
+	return json.Marshal(states)
+}
+
+// RestoreStates restores the last values saved by MarshalStates. The values observed before staleBefore are
+// skipped, they would be reported as a single delta covering the whole time the series wasn't tracked.
+// It returns the number of restored series.
+func (t *MetricTracker) RestoreStates(data []byte, staleBefore pcommon.Timestamp) (int, error) {
+	if len(data) == 0 {
+		return 0, nil
+	}
+	var states []savedState
+	if err := json.Unmarshal(data, &states); err != nil {
+		return 0, fmt.Errorf("failed to unmarshal the saved states: %w", err)
+	}
+	restored := 0
+	for _, s := range states {
+		if s.PrevPoint.ObservedTimestamp < staleBefore {
+			continue
+		}
+		// the series already seen since the start are more recent
+		if _, loaded := t.states.LoadOrStore(string(s.Identity), &State{PrevPoint: s.PrevPoint}); !loaded {
+			restored++
+		}
+	}
+	return restored, nil
+}
@@ -0,0 +1,90 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package tracking
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/collector/pdata/pcommon"
+	"go.opentelemetry.io/collector/pdata/pmetric"
+	"go.uber.org/zap"
+)
+
+func TestMetricTracker_RestoreStates(t *testing.T) {
+	sum := MetricIdentity{
+		Resource:               pcommon.NewResource(),
+		InstrumentationLibrary: pcommon.NewInstrumentationScope(),
+		MetricType:             pmetric.MetricTypeSum,
+		MetricIsMonotonic:      true,
+		MetricName:             "requests",
+		MetricValueType:        pmetric.NumberDataPointValueTypeInt,
+		Attributes:             pcommon.NewMap(),
+	}
+	sum.Resource.Attributes().PutStr("service.name", "foo")
+	sum.Attributes.PutStr("route", "/users")
+	histogram := MetricIdentity{
+		Resource:               pcommon.NewResource(),
+		InstrumentationLibrary: pcommon.NewInstrumentationScope(),
+		MetricType:             pmetric.MetricTypeHistogram,
+		MetricIsMonotonic:      true,
+		MetricName:             "latency",
+		MetricValueType:        pmetric.NumberDataPointValueTypeInt,
+		Attributes:             pcommon.NewMap(),
+	}
+	stale := sum
+	stale.MetricName = "stale"
+
+	now := time.Now()
+	first := pcommon.NewTimestampFromTime(now.Add(-time.Minute))
+	tracker := NewMetricTracker(context.Background(), zap.NewNop(), 0, InitialValueKeep)
+	tracker.Convert(MetricPoint{Identity: sum, Value: ValuePoint{ObservedTimestamp: first, IntValue: 100}})
+	tracker.Convert(MetricPoint{Identity: histogram, Value: ValuePoint{
+		ObservedTimestamp: first,
+		HistogramValue:    &HistogramPoint{Count: 10, Sum: 5, Buckets: []uint64{4, 6}},
+	}})
+	tracker.Convert(MetricPoint{Identity: stale, Value: ValuePoint{
+		ObservedTimestamp: pcommon.NewTimestampFromTime(now.Add(-time.Hour)),
+		IntValue:          100,
+	}})
+
+	saved, err := tracker.MarshalStates()
+	require.NoError(t, err)
+
+	restarted := NewMetricTracker(context.Background(), zap.NewNop(), 0, InitialValueAuto)
+	restored, err := restarted.RestoreStates(saved, pcommon.NewTimestampFromTime(now.Add(-10*time.Minute)))
+	require.NoError(t, err)
+	assert.Equal(t, 2, restored)
+
+	// the first points after the restart are converted against the restored values
+	second := pcommon.NewTimestampFromTime(now)
+	out, valid := restarted.Convert(MetricPoint{Identity: sum, Value: ValuePoint{ObservedTimestamp: second, IntValue: 150}})
+	assert.True(t, valid)
+	assert.Equal(t, DeltaValue{StartTimestamp: first, IntValue: 50}, out)
+
+	out, valid = restarted.Convert(MetricPoint{Identity: histogram, Value: ValuePoint{
+		ObservedTimestamp: second,
+		HistogramValue:    &HistogramPoint{Count: 15, Sum: 8, Buckets: []uint64{6, 9}},
+	}})
+	assert.True(t, valid)
+	assert.Equal(t, DeltaValue{StartTimestamp: first, HistogramValue: &HistogramPoint{Count: 5, Sum: 3, Buckets: []uint64{2, 3}}}, out)
+
+	// the stale series is handled like a new one
+	_, valid = restarted.Convert(MetricPoint{Identity: stale, Value: ValuePoint{ObservedTimestamp: second, IntValue: 200}})
+	assert.False(t, valid)
+}
+
+func TestMetricTracker_RestoreStatesErrors(t *testing.T) {
+	tracker := NewMetricTracker(context.Background(), zap.NewNop(), 0, InitialValueAuto)
+
+	restored, err := tracker.RestoreStates(nil, 0)
+	require.NoError(t, err)
+	assert.Equal(t, 0, restored)
+
+	_, err = tracker.RestoreStates([]byte("invalid"), 0)
+	assert.ErrorContains(t, err, "failed to unmarshal the saved states")
+}
@@ -5,8 +5,14 @@ package cumulativetodeltaprocessor // import "github.com/open-telemetry/opentele
 
 import (
 	"context"
+	"errors"
+	"fmt"
 	"math"
+	"time"
 
+	"go.opentelemetry.io/collector/component"
+	"go.opentelemetry.io/collector/extension/experimental/storage"
+	"go.opentelemetry.io/collector/pdata/pcommon"
 	"go.opentelemetry.io/collector/pdata/pmetric"
 	"go.uber.org/zap"
 
@@ -14,20 +20,36 @@ import (
 	"github.com/open-telemetry/opentelemetry-collector-contrib/processor/cumulativetodeltaprocessor/internal/tracking"
 )
 
+// storageKey is the key of the last values of the series in the storage extension.
+const storageKey = "states"
+
 type cumulativeToDeltaProcessor struct {
+	id              component.ID
 	includeFS       filterset.FilterSet
 	excludeFS       filterset.FilterSet
 	logger          *zap.Logger
 	deltaCalculator *tracking.MetricTracker
 	cancelFunc      context.CancelFunc
+
+	storageID           *component.ID
+	storageMaxStaleness time.Duration
+	storageClient       storage.Client
+	now                 func() time.Time
 }
 
-func newCumulativeToDeltaProcessor(config *Config, logger *zap.Logger) *cumulativeToDeltaProcessor {
+func newCumulativeToDeltaProcessor(config *Config, id component.ID, logger *zap.Logger) *cumulativeToDeltaProcessor {
 	ctx, cancel := context.WithCancel(context.Background())
 	p := &cumulativeToDeltaProcessor{
-		logger:          logger,
-		deltaCalculator: tracking.NewMetricTracker(ctx, logger, config.MaxStaleness, config.InitialValue),
-		cancelFunc:      cancel,
+		id:                  id,
+		logger:              logger,
+		deltaCalculator:     tracking.NewMetricTracker(ctx, logger, config.MaxStaleness, config.InitialValue),
+		cancelFunc:          cancel,
+		storageID:           config.StorageID,
+		storageMaxStaleness: config.StorageMaxStaleness,
+		now:                 time.Now,
+	}
+	if p.storageMaxStaleness == 0 {
+		p.storageMaxStaleness = config.MaxStaleness
 	}
 	if len(config.Include.Metrics) > 0 {
 		p.includeFS, _ = filterset.CreateFilterSet(config.Include.Metrics, &config.Include.Config)
@@ -106,11 +128,58 @@ func (ctdp *cumulativeToDeltaProcessor) processMetrics(_ context.Context, md pme
 	return md, nil
 }
 
-func (ctdp *cumulativeToDeltaProcessor) shutdown(context.Context) error {
-	ctdp.cancelFunc()
+func (ctdp *cumulativeToDeltaProcessor) start(ctx context.Context, host component.Host) error {
+	if ctdp.storageID == nil {
+		return nil
+	}
+	ext, ok := host.GetExtensions()[*ctdp.storageID]
+	if !ok {
+		return fmt.Errorf("storage extension '%s' not found", ctdp.storageID)
+	}
+	storageExt, ok := ext.(storage.Extension)
+	if !ok {
+		return fmt.Errorf("non-storage extension '%s' found", ctdp.storageID)
+	}
+	client, err := storageExt.GetClient(ctx, component.KindProcessor, ctdp.id, "")
+	if err != nil {
+		return fmt.Errorf("failed to get the storage client: %w", err)
+	}
+	ctdp.storageClient = client
+
+	saved, err := client.Get(ctx, storageKey)
+	if err != nil {
+		return fmt.Errorf("failed to read the saved states: %w", err)
+	}
+	var staleBefore pcommon.Timestamp
+	if ctdp.storageMaxStaleness > 0 {
+		staleBefore = pcommon.NewTimestampFromTime(ctdp.now().Add(-ctdp.storageMaxStaleness))
+	}
+	restored, err := ctdp.deltaCalculator.RestoreStates(saved, staleBefore)
+	if err != nil {
+		// the first points are handled like after a restart without storage, which isn't a reason to stop the pipeline
+		ctdp.logger.Warn("Ignoring the saved states", zap.Error(err))
+		return nil
+	}
+	ctdp.logger.Debug("Restored the saved states", zap.Int("states", restored))
 	return nil
 }
 
+func (ctdp *cumulativeToDeltaProcessor) shutdown(ctx context.Context) error {
+	ctdp.cancelFunc()
+	if ctdp.storageClient == nil {
+		return nil
+	}
+
+	var errs error
+	saved, err := ctdp.deltaCalculator.MarshalStates()
+	if err != nil {
+		errs = fmt.Errorf("failed to marshal the states: %w", err)
+	} else if err = ctdp.storageClient.Set(ctx, storageKey, saved); err != nil {
+		errs = fmt.Errorf("failed to save the states: %w", err)
+	}
+	return errors.Join(errs, ctdp.storageClient.Close(ctx))
+}
+
 func (ctdp *cumulativeToDeltaProcessor) shouldConvertMetric(metricName string) bool {
 	return (ctdp.includeFS == nil || ctdp.includeFS.Matches(metricName)) &&
 		(ctdp.excludeFS == nil || !ctdp.excludeFS.Matches(metricName))
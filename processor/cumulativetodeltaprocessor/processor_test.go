@@ -12,6 +12,7 @@ import (
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 	"go.opentelemetry.io/collector/component"
+	"go.opentelemetry.io/collector/component/componenttest"
 	"go.opentelemetry.io/collector/consumer/consumertest"
 	"go.opentelemetry.io/collector/pdata/pcommon"
 	"go.opentelemetry.io/collector/pdata/pmetric"
@@ -19,6 +20,7 @@ import (
 	"go.opentelemetry.io/collector/processor/processortest"
 	"go.uber.org/zap"
 
+	"github.com/open-telemetry/opentelemetry-collector-contrib/extension/storage/storagetest"
 	"github.com/open-telemetry/opentelemetry-collector-contrib/internal/filter/filterset"
 )
 
@@ -613,6 +615,94 @@ func generateTestHistogramMetrics(tm testHistogramMetric) pmetric.Metrics {
 	return md
 }
 
+// newCounter returns metrics with a single cumulative monotonic sum data point.
+func newCounter(startTime, timestamp time.Time, value int64) pmetric.Metrics {
+	md := pmetric.NewMetrics()
+	m := md.ResourceMetrics().AppendEmpty().ScopeMetrics().AppendEmpty().Metrics().AppendEmpty()
+	m.SetName("requests")
+	sum := m.SetEmptySum()
+	sum.SetIsMonotonic(true)
+	sum.SetAggregationTemporality(pmetric.AggregationTemporalityCumulative)
+	dp := sum.DataPoints().AppendEmpty()
+	dp.SetStartTimestamp(pcommon.NewTimestampFromTime(startTime))
+	dp.SetTimestamp(pcommon.NewTimestampFromTime(timestamp))
+	dp.SetIntValue(value)
+	return md
+}
+
+func TestStorageSurvivesRestart(t *testing.T) {
+	ext := storagetest.NewFileBackedStorageExtension("cumulativetodelta", t.TempDir())
+	host := storagetest.NewStorageHost().WithExtension(ext.ID, ext)
+	cfg := createDefaultConfig().(*Config)
+	cfg.StorageID = &ext.ID
+	cfg.StorageMaxStaleness = time.Hour
+
+	// the storage client is identified by the processor ID, which has to be the same after the restart
+	set := processortest.NewNopCreateSettings()
+	next := new(consumertest.MetricsSink)
+	startTime := time.Now().Add(-time.Hour)
+	p, err := createMetricsProcessor(context.Background(), set, cfg, next)
+	require.NoError(t, err)
+	require.NoError(t, p.Start(context.Background(), host))
+	require.NoError(t, p.ConsumeMetrics(context.Background(), newCounter(startTime, time.Now().Add(-time.Minute), 100)))
+	require.NoError(t, p.Shutdown(context.Background()))
+	// the counter started before the processor, its first point is dropped
+	assert.Equal(t, 0, next.DataPointCount())
+
+	p, err = createMetricsProcessor(context.Background(), set, cfg, next)
+	require.NoError(t, err)
+	require.NoError(t, p.Start(context.Background(), host))
+	require.NoError(t, p.ConsumeMetrics(context.Background(), newCounter(startTime, time.Now(), 150)))
+	require.NoError(t, p.Shutdown(context.Background()))
+
+	require.Equal(t, 1, next.DataPointCount())
+	all := next.AllMetrics()
+	sum := all[len(all)-1].ResourceMetrics().At(0).ScopeMetrics().At(0).Metrics().At(0).Sum()
+	assert.Equal(t, pmetric.AggregationTemporalityDelta, sum.AggregationTemporality())
+	assert.Equal(t, int64(50), sum.DataPoints().At(0).IntValue())
+}
+
+func TestStorageMaxStaleness(t *testing.T) {
+	ext := storagetest.NewFileBackedStorageExtension("cumulativetodelta", t.TempDir())
+	host := storagetest.NewStorageHost().WithExtension(ext.ID, ext)
+	cfg := createDefaultConfig().(*Config)
+	cfg.StorageID = &ext.ID
+	cfg.StorageMaxStaleness = time.Minute
+
+	set := processortest.NewNopCreateSettings()
+	next := new(consumertest.MetricsSink)
+	startTime := time.Now().Add(-time.Hour)
+	p, err := createMetricsProcessor(context.Background(), set, cfg, next)
+	require.NoError(t, err)
+	require.NoError(t, p.Start(context.Background(), host))
+	require.NoError(t, p.ConsumeMetrics(context.Background(), newCounter(startTime, time.Now().Add(-10*time.Minute), 100)))
+	require.NoError(t, p.Shutdown(context.Background()))
+
+	// the saved value is too old to be restored
+	p, err = createMetricsProcessor(context.Background(), set, cfg, next)
+	require.NoError(t, err)
+	require.NoError(t, p.Start(context.Background(), host))
+	require.NoError(t, p.ConsumeMetrics(context.Background(), newCounter(startTime, time.Now(), 150)))
+	require.NoError(t, p.Shutdown(context.Background()))
+	assert.Equal(t, 0, next.DataPointCount())
+}
+
+func TestStorageExtensionErrors(t *testing.T) {
+	cfg := createDefaultConfig().(*Config)
+	missing := storagetest.NewStorageID("missing")
+	cfg.StorageID = &missing
+	p, err := createMetricsProcessor(context.Background(), processortest.NewNopCreateSettings(), cfg, consumertest.NewNop())
+	require.NoError(t, err)
+	assert.EqualError(t, p.Start(context.Background(), componenttest.NewNopHost()), "storage extension 'test_storage/missing' not found")
+
+	nonStorage := storagetest.NewNonStorageExtension("other")
+	cfg.StorageID = &nonStorage.ID
+	p, err = createMetricsProcessor(context.Background(), processortest.NewNopCreateSettings(), cfg, consumertest.NewNop())
+	require.NoError(t, err)
+	host := storagetest.NewStorageHost().WithExtension(nonStorage.ID, nonStorage)
+	assert.EqualError(t, p.Start(context.Background(), host), "non-storage extension 'non_storage/other' found")
+}
+
 func BenchmarkConsumeMetrics(b *testing.B) {
 	c := consumertest.NewNop()
 	params := processor.CreateSettings{
@@ -43,10 +43,17 @@ func (dp ExpHistogram) Add(in ExpHistogram) ExpHistogram {
 	if dp.ZeroThreshold() != in.ZeroThreshold() {
 		hi, lo := expo.HiLo(dp, in, H.ZeroThreshold)
 		expo.WidenZero(lo.DataPoint, hi.ZeroThreshold())
+
+		// the widened zero-bucket covers full buckets, so it may now be wider
+		// than the other one, which needs to catch up for the merge
+		if lo.ZeroThreshold() > hi.ZeroThreshold() {
+			expo.WidenZero(hi.DataPoint, lo.ZeroThreshold())
+		}
 	}
 
 	expo.Merge(dp.Positive(), in.Positive())
 	expo.Merge(dp.Negative(), in.Negative())
+	expo.Limit(dp.DataPoint, expo.MaxSize)
 
 	dp.SetTimestamp(in.Timestamp())
 	dp.SetCount(dp.Count() + in.Count())
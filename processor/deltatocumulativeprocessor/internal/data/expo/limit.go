@@ -0,0 +1,65 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package expo // import "github.com/open-telemetry/opentelemetry-collector-contrib/processor/deltatocumulativeprocessor/internal/data/expo"
+
+// MaxSize is the default maximum number of buckets of the positive and negative
+// ranges of the OpenTelemetry SDKs, which is also used as limit when
+// accumulating. Every merge may widen the bucket range, so an unbounded
+// cumulative histogram would keep growing for as long as the stream exists.
+const MaxSize = 160
+
+// Limit downscales dp until both its positive and negative buckets span at most
+// size buckets. Only the populated area counts, so the empty buckets at either
+// end, e.g. left behind by [Collapse], are dropped when downscaling.
+//
+// Downscaling loses resolution, but never correctness: due to the "perfect
+// subsetting" property, each observation still lies in its bucket.
+func Limit(dp DataPoint, size int) {
+	if size < 1 {
+		return
+	}
+
+	pos, neg := Abs(dp.Positive()), Abs(dp.Negative())
+	if pos.span() <= size && neg.span() <= size {
+		return
+	}
+
+	for pos.span() > size || neg.span() > size {
+		Collapse(dp.Positive())
+		Collapse(dp.Negative())
+		dp.SetScale(dp.Scale() - 1)
+	}
+	pos.trim()
+	neg.trim()
+}
+
+// populated returns the range lo <= i < up of the non-empty buckets. If all are
+// empty, lo == up.
+func (a Absolute) populated() (lo, up int) {
+	lo, up = a.Lower(), a.Upper()
+	for lo < up && a.Abs(lo) == 0 {
+		lo++
+	}
+	for up > lo && a.Abs(up-1) == 0 {
+		up--
+	}
+	return lo, up
+}
+
+// span returns the number of buckets between the first and the last non-empty one.
+func (a Absolute) span() int {
+	lo, up := a.populated()
+	return up - lo
+}
+
+// trim drops the empty buckets at either end
+func (a Absolute) trim() {
+	lo, up := a.populated()
+	if lo == up {
+		a.BucketCounts().FromRaw(nil)
+		a.SetOffset(0)
+		return
+	}
+	a.Slice(lo, up)
+}
@@ -0,0 +1,70 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package expo_test
+
+import (
+	"testing"
+
+	"go.opentelemetry.io/collector/pdata/pmetric"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/processor/deltatocumulativeprocessor/internal/data/expo"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/processor/deltatocumulativeprocessor/internal/data/expo/expotest"
+)
+
+func TestLimit(t *testing.T) {
+	cases := []struct {
+		name string
+		size int
+		hist hist
+		want hist
+	}{{
+		name: "fits",
+		size: 8,
+		hist: hist{PosNeg: bins{ø, 1, 0, 0, 0, 0, 1, 0}.Into(), Scale: 2},
+		want: hist{PosNeg: bins{ø, 1, 0, 0, 0, 0, 1, 0}.Into(), Scale: 2},
+	}, {
+		// empty buckets at the ends don't count
+		name: "fits/populated",
+		size: 3,
+		hist: hist{PosNeg: bins{0, 0, 1, 2, 3, 0, 0, 0}.Into(), Scale: 2},
+		want: hist{PosNeg: bins{0, 0, 1, 2, 3, 0, 0, 0}.Into(), Scale: 2},
+	}, {
+		// -3 -2 -1 0 1 at scale 0 are -2 -1 -1 0 0 at scale -1
+		name: "downscale",
+		size: 4,
+		hist: hist{PosNeg: bins{1, 0, 0, 0, 1, ø, ø, ø}.Into(), Scale: 0},
+		want: hist{PosNeg: rawbs([]uint64{1, 0, 1}, -2), Scale: -1},
+	}, {
+		// 1 2 3 4 5 at scale 0 are 0 1 1 2 2 at scale -1, and 0 0 0 1 1 at scale -2
+		name: "downscale/twice",
+		size: 2,
+		hist: hist{Pos: rawbs([]uint64{1, 1, 1, 1, 1}, 1), Scale: 0},
+		want: hist{Pos: rawbs([]uint64{3, 2}, 0), Scale: -2},
+	}, {
+		// the negative buckets may require downscaling the positive ones
+		name: "downscale/negative",
+		size: 2,
+		hist: hist{Pos: rawbs([]uint64{1, 1}, 0), Neg: rawbs([]uint64{1, 0, 1}, 0), Scale: 0},
+		want: hist{Pos: rawbs([]uint64{2}, 0), Neg: rawbs([]uint64{1, 1}, 0), Scale: -1},
+	}}
+
+	for _, cs := range cases {
+		t.Run(cs.name, func(t *testing.T) {
+			dp := cs.hist.Into()
+			want := cs.want.Into()
+
+			expo.Limit(dp, cs.size)
+
+			is := expotest.Is(t)
+			is.Equal(want, dp)
+		})
+	}
+}
+
+func rawbs(data []uint64, offset int32) expo.Buckets {
+	bs := pmetric.NewExponentialHistogramDataPointBuckets()
+	bs.BucketCounts().FromRaw(data)
+	bs.SetOffset(offset)
+	return bs
+}
@@ -71,6 +71,12 @@ func TestAdd(t *testing.T) {
 		dp:   expdp{PosNeg: bins{ø, 1, 1, 1, 1, 1}.Into(), Zt: 0.2, Zc: 2},
 		in:   expdp{PosNeg: bins{ø, ø, 1, 1, 1, 1}.Into(), Zt: 0.3, Zc: 2},
 		want: expdp{PosNeg: bins{ø, ø, 2, 2, 2, 2}.Into(), Zt: 0.5, Zc: 4 + 2*1},
+	}, {
+		// dp is widened to 0.5, which in needs to be widened to as well
+		name: "zero/straddle",
+		dp:   expdp{PosNeg: bins{ø, 1, 1, 1, 1, 1}.Into(), Zt: 0.2, Zc: 2},
+		in:   expdp{PosNeg: bins{ø, 1, 1, 1, 1, 1}.Into(), Zt: 0.3, Zc: 2},
+		want: expdp{PosNeg: bins{ø, ø, 2, 2, 2, 2}.Into(), Zt: 0.5, Zc: 4 + 2*1 + 2*1},
 	}, {
 		name: "negative-offset",
 		dp:   expdp{PosNeg: rawbs([]uint64{ /*   */ 1, 2}, -2)},
@@ -89,6 +95,21 @@ func TestAdd(t *testing.T) {
 		}()},
 	}}
 
+	// merging widens the buckets beyond the limit, which is downscaled. the
+	// populated buckets -80..80 at scale 0 are -40..40 at scale -1
+	cases = append(cases, struct {
+		name   string
+		dp, in expdp
+		want   expdp
+		flip   bool
+	}{
+		name: "limit",
+		dp:   expdp{PosNeg: rawbs([]uint64{1}, -80), Count: 2},
+		in:   expdp{PosNeg: rawbs([]uint64{1}, 80), Count: 2},
+		want: expdp{PosNeg: rawbs(append(append([]uint64{1}, make([]uint64, 79)...), 1), -40), Scale: -1, Count: 4},
+		flip: true,
+	})
+
 	for _, cs := range cases {
 		run := func(dp, in expdp) func(t *testing.T) {
 			return func(t *testing.T) {
@@ -0,0 +1,69 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package deltatocumulativeprocessor
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/collector/component/componenttest"
+	"go.opentelemetry.io/collector/consumer/consumertest"
+	"go.opentelemetry.io/collector/pdata/pcommon"
+	"go.opentelemetry.io/collector/pdata/pmetric"
+	"go.opentelemetry.io/collector/processor/processortest"
+)
+
+func TestExpHistogram(t *testing.T) {
+	sink := new(consumertest.MetricsSink)
+	proc, err := NewFactory().CreateMetricsProcessor(context.Background(), processortest.NewNopCreateSettings(), createDefaultConfig(), sink)
+	require.NoError(t, err)
+	require.NoError(t, proc.Start(context.Background(), componenttest.NewNopHost()))
+	defer func() {
+		require.NoError(t, proc.Shutdown(context.Background()))
+	}()
+
+	// the second sample has a higher scale, its buckets being downscaled to the one of the first
+	require.NoError(t, proc.ConsumeMetrics(context.Background(), expHistogram(1, 2, 0, 1, []uint64{1, 2}, 3)))
+	require.NoError(t, proc.ConsumeMetrics(context.Background(), expHistogram(2, 3, 1, 2, []uint64{1, 1, 1, 1}, 4)))
+
+	got := sink.AllMetrics()
+	require.Len(t, got, 2)
+	for _, md := range got {
+		assert.Equal(t, pmetric.AggregationTemporalityCumulative, md.ResourceMetrics().At(0).ScopeMetrics().At(0).Metrics().At(0).ExponentialHistogram().AggregationTemporality())
+	}
+
+	dp := got[1].ResourceMetrics().At(0).ScopeMetrics().At(0).Metrics().At(0).ExponentialHistogram().DataPoints().At(0)
+	assert.Equal(t, pcommon.Timestamp(1), dp.StartTimestamp())
+	assert.Equal(t, pcommon.Timestamp(3), dp.Timestamp())
+	assert.Equal(t, int32(0), dp.Scale())
+	assert.Equal(t, uint64(7), dp.Count())
+	assert.Equal(t, 7.0, dp.Sum())
+	// buckets 2..5 at scale 1 are buckets 1..2 at scale 0
+	assert.Equal(t, int32(1), dp.Positive().Offset())
+	assert.Equal(t, []uint64{3, 4, 0, 0}, dp.Positive().BucketCounts().AsRaw())
+}
+
+func expHistogram(start, ts pcommon.Timestamp, scale, offset int32, counts []uint64, sum float64) pmetric.Metrics {
+	md := pmetric.NewMetrics()
+	m := md.ResourceMetrics().AppendEmpty().ScopeMetrics().AppendEmpty().Metrics().AppendEmpty()
+	m.SetName("latency")
+	hist := m.SetEmptyExponentialHistogram()
+	hist.SetAggregationTemporality(pmetric.AggregationTemporalityDelta)
+
+	dp := hist.DataPoints().AppendEmpty()
+	dp.SetStartTimestamp(start)
+	dp.SetTimestamp(ts)
+	dp.SetScale(scale)
+	dp.Positive().SetOffset(offset)
+	dp.Positive().BucketCounts().FromRaw(counts)
+	var count uint64
+	for _, c := range counts {
+		count += c
+	}
+	dp.SetCount(count)
+	dp.SetSum(sum)
+	return md
+}
@@ -36,6 +36,11 @@ type Config struct {
 	Spans filterconfig.MatchConfig `mapstructure:"spans"`
 
 	Traces TraceFilters `mapstructure:"traces"`
+
+	// ReportRejected reports the dropped items as rejected to the receivers supporting it, which describe them in
+	// the partial success of their response. Only the receivers of the same pipeline, without any batching or
+	// queueing processor before this one, get the reports.
+	ReportRejected bool `mapstructure:"report_rejected"`
 }
 
 // MetricFilters filters by Metric properties.
@@ -62,6 +67,67 @@ type MetricFilters struct {
 	// If any condition resolves to true, the datapoint will be dropped.
 	// Supports `and`, `or`, and `()`
 	DataPointConditions []string `mapstructure:"datapoint"`
+
+	// DataPointValues is a list of conditions on the values of the datapoints, dropping the datapoints of useless
+	// values, e.g. the gauges equal to 0 or the histograms without any count.
+	// They can be used along with the include/exclude properties or the OTTL conditions.
+	DataPointValues []DataPointValueFilter `mapstructure:"datapoint_value"`
+}
+
+// Fields of the datapoints compared by the DataPointValueFilter.
+const (
+	dataPointValueField = "value"
+	dataPointCountField = "count"
+	dataPointSumField   = "sum"
+)
+
+// Actions of the DataPointValueFilter.
+const (
+	dataPointValueDrop = "drop"
+	dataPointValueKeep = "keep"
+)
+
+// DataPointValueFilter compares a field of the datapoints of the metrics matching its names to a threshold.
+type DataPointValueFilter struct {
+	// Config configures how the MetricNames are matched, `strict` or `regexp`. Defaults to `strict`.
+	filterset.Config `mapstructure:",squash"`
+
+	// MetricNames are the names of the metrics the filter applies to. The filter applies to all metrics when empty.
+	MetricNames []string `mapstructure:"metric_names"`
+
+	// Field is the field compared to the threshold: `value` for the datapoints of gauges and sums, `count` or `sum`
+	// for the datapoints of histograms, exponential histograms and summaries. Defaults to `value`.
+	// The datapoints without the field, e.g. the histogram datapoints with the `value` field, are not filtered.
+	Field string `mapstructure:"field"`
+
+	// Operator compares the field to the threshold: `==`, `!=`, `<`, `<=`, `>` or `>=`.
+	Operator string `mapstructure:"operator"`
+
+	// Threshold is the value the field is compared to.
+	Threshold float64 `mapstructure:"threshold"`
+
+	// Action is what happens to the datapoints matching the condition: `drop` drops them, `keep` drops all other
+	// datapoints. Defaults to `drop`.
+	Action string `mapstructure:"action"`
+}
+
+// validate checks that the DataPointValueFilter is valid
+func (f DataPointValueFilter) validate() error {
+	switch f.Field {
+	case "", dataPointValueField, dataPointCountField, dataPointSumField:
+	default:
+		return fmt.Errorf("unsupported datapoint field %q", f.Field)
+	}
+	if _, ok := comparisons[f.Operator]; !ok {
+		return fmt.Errorf("unsupported operator %q", f.Operator)
+	}
+	switch f.Action {
+	case "", dataPointValueDrop, dataPointValueKeep:
+	default:
+		return fmt.Errorf("unsupported action %q", f.Action)
+	}
+	_, err := f.metricNames()
+	return err
 }
 
 // TraceFilters filters by OTTL conditions
@@ -303,6 +369,12 @@ func (cfg *Config) Validate() error {
 		errors = multierr.Append(errors, err)
 	}
 
+	for i, f := range cfg.Metrics.DataPointValues {
+		if err := f.validate(); err != nil {
+			errors = multierr.Append(errors, fmt.Errorf("metrics.datapoint_value[%d]: %w", i, err))
+		}
+	}
+
 	if cfg.Logs.LogConditions != nil && cfg.Logs.Include != nil {
 		errors = multierr.Append(errors, cfg.Logs.Include.validate())
 	}
@@ -931,3 +931,75 @@ func TestLoadingConfigOTTL(t *testing.T) {
 		})
 	}
 }
+
+func TestLoadingConfigDataPointValue(t *testing.T) {
+	cm, err := confmaptest.LoadConf(filepath.Join("testdata", "config_datapoint_value.yaml"))
+	require.NoError(t, err)
+
+	tests := []struct {
+		id           component.ID
+		expected     *Config
+		errorMessage string
+	}{
+		{
+			id: component.NewIDWithName(metadata.Type, "datapoint_value"),
+			expected: &Config{
+				ErrorMode: ottl.PropagateError,
+				Metrics: MetricFilters{
+					DataPointValues: []DataPointValueFilter{
+						{
+							MetricNames: []string{"k8s.pod.phase"},
+							Operator:    "==",
+						},
+						{
+							Config:      filterset.Config{MatchType: filterset.Regexp},
+							MetricNames: []string{`http\..*\.duration`},
+							Field:       dataPointCountField,
+							Operator:    "==",
+						},
+						{
+							Field:     dataPointSumField,
+							Operator:  ">=",
+							Threshold: 100,
+							Action:    dataPointValueKeep,
+						},
+					},
+				},
+			},
+		},
+		{
+			id:           component.NewIDWithName(metadata.Type, "bad_field"),
+			errorMessage: `metrics.datapoint_value[0]: unsupported datapoint field "bucket_counts"`,
+		},
+		{
+			id:           component.NewIDWithName(metadata.Type, "bad_operator"),
+			errorMessage: `metrics.datapoint_value[0]: unsupported operator "<>"`,
+		},
+		{
+			id:           component.NewIDWithName(metadata.Type, "bad_action"),
+			errorMessage: `metrics.datapoint_value[0]: unsupported action "sample"`,
+		},
+		{
+			id:           component.NewIDWithName(metadata.Type, "bad_match_type"),
+			errorMessage: "metrics.datapoint_value[0]: unrecognized match_type: 'expr', valid types are: [regexp strict]",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.id.String(), func(t *testing.T) {
+			factory := NewFactory()
+			cfg := factory.CreateDefaultConfig()
+
+			sub, err := cm.Sub(tt.id.String())
+			require.NoError(t, err)
+			require.NoError(t, sub.Unmarshal(cfg))
+
+			if tt.expected == nil {
+				assert.EqualError(t, component.ValidateConfig(cfg), tt.errorMessage)
+			} else {
+				assert.NoError(t, component.ValidateConfig(cfg))
+				assert.Equal(t, tt.expected, cfg)
+			}
+		})
+	}
+}
@@ -0,0 +1,118 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package filterprocessor // import "github.com/open-telemetry/opentelemetry-collector-contrib/processor/filterprocessor"
+
+import (
+	"context"
+
+	"go.opentelemetry.io/collector/pdata/pmetric"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/internal/filter/expr"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/internal/filter/filterset"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl/contexts/ottldatapoint"
+)
+
+var comparisons = map[string]func(value, threshold float64) bool{
+	"==": func(value, threshold float64) bool { return value == threshold },
+	"!=": func(value, threshold float64) bool { return value != threshold },
+	"<":  func(value, threshold float64) bool { return value < threshold },
+	"<=": func(value, threshold float64) bool { return value <= threshold },
+	">":  func(value, threshold float64) bool { return value > threshold },
+	">=": func(value, threshold float64) bool { return value >= threshold },
+}
+
+// metricNames returns the FilterSet matching the MetricNames, or nil when the filter applies to all metrics.
+func (f DataPointValueFilter) metricNames() (filterset.FilterSet, error) {
+	if len(f.MetricNames) == 0 {
+		return nil, nil
+	}
+	cfg := f.Config
+	if cfg.MatchType == "" {
+		cfg.MatchType = filterset.Strict
+	}
+	return filterset.CreateFilterSet(f.MetricNames, &cfg)
+}
+
+type dataPointValueExpr struct {
+	metricNames filterset.FilterSet
+	field       string
+	compare     func(value, threshold float64) bool
+	threshold   float64
+	keep        bool
+}
+
+// newDataPointValueExpr returns the expression skipping the datapoints according to the filters, or nil without
+// filters.
+func newDataPointValueExpr(filters []DataPointValueFilter) (expr.BoolExpr[ottldatapoint.TransformContext], error) {
+	var matchers []expr.BoolExpr[ottldatapoint.TransformContext]
+	for _, f := range filters {
+		metricNames, err := f.metricNames()
+		if err != nil {
+			return nil, err
+		}
+		field := f.Field
+		if field == "" {
+			field = dataPointValueField
+		}
+		matchers = append(matchers, dataPointValueExpr{
+			metricNames: metricNames,
+			field:       field,
+			compare:     comparisons[f.Operator],
+			threshold:   f.Threshold,
+			keep:        f.Action == dataPointValueKeep,
+		})
+	}
+	return expr.Or(matchers...), nil
+}
+
+func (e dataPointValueExpr) Eval(_ context.Context, tCtx ottldatapoint.TransformContext) (bool, error) {
+	if e.metricNames != nil && !e.metricNames.Matches(tCtx.GetMetric().Name()) {
+		return false, nil
+	}
+	value, ok := dataPointField(tCtx.GetDataPoint(), e.field)
+	if !ok {
+		return false, nil
+	}
+	return e.compare(value, e.threshold) != e.keep, nil
+}
+
+// dataPointField returns the value of the field of the datapoint, or false if the datapoint doesn't have the field.
+func dataPointField(dp any, field string) (float64, bool) {
+	switch dp := dp.(type) {
+	case pmetric.NumberDataPoint:
+		if field != dataPointValueField {
+			return 0, false
+		}
+		switch dp.ValueType() {
+		case pmetric.NumberDataPointValueTypeInt:
+			return float64(dp.IntValue()), true
+		case pmetric.NumberDataPointValueTypeDouble:
+			return dp.DoubleValue(), true
+		default:
+			return 0, false
+		}
+	case pmetric.HistogramDataPoint:
+		switch field {
+		case dataPointCountField:
+			return float64(dp.Count()), true
+		case dataPointSumField:
+			return dp.Sum(), dp.HasSum()
+		}
+	case pmetric.ExponentialHistogramDataPoint:
+		switch field {
+		case dataPointCountField:
+			return float64(dp.Count()), true
+		case dataPointSumField:
+			return dp.Sum(), dp.HasSum()
+		}
+	case pmetric.SummaryDataPoint:
+		switch field {
+		case dataPointCountField:
+			return float64(dp.Count()), true
+		case dataPointSumField:
+			return dp.Sum(), true
+		}
+	}
+	return 0, false
+}
@@ -0,0 +1,143 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package filterprocessor
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/collector/pdata/pmetric"
+	"go.opentelemetry.io/collector/processor/processortest"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/internal/filter/filterset"
+)
+
+func newDataPointValueMetrics() pmetric.Metrics {
+	md := pmetric.NewMetrics()
+	metrics := md.ResourceMetrics().AppendEmpty().ScopeMetrics().AppendEmpty().Metrics()
+
+	phase := metrics.AppendEmpty()
+	phase.SetName("k8s.pod.phase")
+	phase.SetEmptyGauge().DataPoints().AppendEmpty().SetIntValue(0)
+	phase.Gauge().DataPoints().AppendEmpty().SetIntValue(2)
+
+	usage := metrics.AppendEmpty()
+	usage.SetName("cpu.usage")
+	usage.SetEmptySum().DataPoints().AppendEmpty().SetDoubleValue(0)
+	usage.Sum().DataPoints().AppendEmpty().SetDoubleValue(0.5)
+
+	duration := metrics.AppendEmpty()
+	duration.SetName("http.server.duration")
+	duration.SetEmptyHistogram().DataPoints().AppendEmpty().SetCount(0)
+	dp := duration.Histogram().DataPoints().AppendEmpty()
+	dp.SetCount(3)
+	dp.SetSum(150)
+
+	summary := metrics.AppendEmpty()
+	summary.SetName("rpc.duration")
+	summary.SetEmptySummary().DataPoints().AppendEmpty().SetCount(0)
+	return md
+}
+
+func TestFilterMetricProcessorDataPointValue(t *testing.T) {
+	tests := []struct {
+		name     string
+		filters  []DataPointValueFilter
+		conds    []string
+		expected map[string][]float64
+	}{
+		{
+			name:    "drop zero values of all metrics",
+			filters: []DataPointValueFilter{{Operator: "=="}},
+			expected: map[string][]float64{
+				"k8s.pod.phase":        {2},
+				"cpu.usage":            {0.5},
+				"http.server.duration": {0, 3},
+				"rpc.duration":         {0},
+			},
+		},
+		{
+			name:    "drop zero values of a metric",
+			filters: []DataPointValueFilter{{MetricNames: []string{"cpu.usage"}, Operator: "=="}},
+			expected: map[string][]float64{
+				"k8s.pod.phase":        {0, 2},
+				"cpu.usage":            {0.5},
+				"http.server.duration": {0, 3},
+				"rpc.duration":         {0},
+			},
+		},
+		{
+			name: "drop empty histograms and summaries",
+			filters: []DataPointValueFilter{{
+				Config:      filterset.Config{MatchType: filterset.Regexp},
+				MetricNames: []string{`.*\.duration`},
+				Field:       dataPointCountField,
+				Operator:    "==",
+			}},
+			expected: map[string][]float64{
+				"k8s.pod.phase":        {0, 2},
+				"cpu.usage":            {0, 0.5},
+				"http.server.duration": {3},
+			},
+		},
+		{
+			name:    "keep the histograms above a sum",
+			filters: []DataPointValueFilter{{Field: dataPointSumField, Operator: ">", Threshold: 100, Action: dataPointValueKeep}},
+			expected: map[string][]float64{
+				// the histogram without sum and the numbers don't have the field
+				"k8s.pod.phase":        {0, 2},
+				"cpu.usage":            {0, 0.5},
+				"http.server.duration": {0, 3},
+			},
+		},
+		{
+			name:    "along with the OTTL conditions",
+			filters: []DataPointValueFilter{{Operator: "<", Threshold: 1}},
+			conds:   []string{`metric.name == "http.server.duration"`},
+			expected: map[string][]float64{
+				"k8s.pod.phase": {2},
+				"rpc.duration":  {0},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cfg := &Config{Metrics: MetricFilters{DataPointValues: tt.filters, DataPointConditions: tt.conds}}
+			require.NoError(t, cfg.Validate())
+			fmp, err := newFilterMetricProcessor(processortest.NewNopCreateSettings(), cfg)
+			require.NoError(t, err)
+
+			md, err := fmp.processMetrics(context.Background(), newDataPointValueMetrics())
+			require.NoError(t, err)
+			actual := map[string][]float64{}
+			metrics := md.ResourceMetrics().At(0).ScopeMetrics().At(0).Metrics()
+			for i := 0; i < metrics.Len(); i++ {
+				m := metrics.At(i)
+				var values []float64
+				switch m.Type() {
+				case pmetric.MetricTypeGauge:
+					for j := 0; j < m.Gauge().DataPoints().Len(); j++ {
+						values = append(values, float64(m.Gauge().DataPoints().At(j).IntValue()))
+					}
+				case pmetric.MetricTypeSum:
+					for j := 0; j < m.Sum().DataPoints().Len(); j++ {
+						values = append(values, m.Sum().DataPoints().At(j).DoubleValue())
+					}
+				case pmetric.MetricTypeHistogram:
+					for j := 0; j < m.Histogram().DataPoints().Len(); j++ {
+						values = append(values, float64(m.Histogram().DataPoints().At(j).Count()))
+					}
+				case pmetric.MetricTypeSummary:
+					for j := 0; j < m.Summary().DataPoints().Len(); j++ {
+						values = append(values, float64(m.Summary().DataPoints().At(j).Count()))
+					}
+				}
+				actual[m.Name()] = values
+			}
+			assert.Equal(t, tt.expected, actual)
+		})
+	}
+}
@@ -17,18 +17,21 @@ import (
 	"github.com/open-telemetry/opentelemetry-collector-contrib/internal/filter/filterconfig"
 	"github.com/open-telemetry/opentelemetry-collector-contrib/internal/filter/filterlog"
 	"github.com/open-telemetry/opentelemetry-collector-contrib/internal/filter/filterottl"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/internal/partialsuccess"
 	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl/contexts/ottllog"
 )
 
 type filterLogProcessor struct {
-	skipExpr  expr.BoolExpr[ottllog.TransformContext]
-	telemetry *filterProcessorTelemetry
-	logger    *zap.Logger
+	skipExpr       expr.BoolExpr[ottllog.TransformContext]
+	telemetry      *filterProcessorTelemetry
+	logger         *zap.Logger
+	reportRejected bool
 }
 
 func newFilterLogsProcessor(set processor.CreateSettings, cfg *Config) (*filterLogProcessor, error) {
 	flp := &filterLogProcessor{
-		logger: set.Logger,
+		logger:         set.Logger,
+		reportRejected: cfg.ReportRejected,
 	}
 
 	fpt, err := newfilterProcessorTelemetry(set)
@@ -93,6 +96,9 @@ func (flp *filterLogProcessor) processLogs(ctx context.Context, ld plog.Logs) (p
 
 	logCountAfterFilters := ld.LogRecordCount()
 	flp.telemetry.record(triggerLogsDropped, int64(logCountBeforeFilters-logCountAfterFilters))
+	if flp.reportRejected {
+		partialsuccess.Record(ctx, logCountBeforeFilters-logCountAfterFilters, rejectedReason)
+	}
 
 	if errors != nil {
 		flp.logger.Error("failed processing logs", zap.Error(errors))
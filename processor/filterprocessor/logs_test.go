@@ -17,6 +17,7 @@ import (
 	"go.opentelemetry.io/collector/processor/processortest"
 
 	"github.com/open-telemetry/opentelemetry-collector-contrib/internal/filter/filterconfig"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/internal/partialsuccess"
 	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl"
 )
 
@@ -779,6 +780,27 @@ func TestFilterLogProcessorTelemetry(t *testing.T) {
 	})
 }
 
+func TestFilterLogProcessorReportRejected(t *testing.T) {
+	for _, report := range []bool{false, true} {
+		processor, err := newFilterLogsProcessor(processortest.NewNopCreateSettings(), &Config{
+			Logs:           LogFilters{LogConditions: []string{`IsMatch(body, "operationA")`}},
+			ReportRejected: report,
+		})
+		assert.NoError(t, err)
+
+		ctx, recorder := partialsuccess.NewContext(context.Background())
+		_, err = processor.processLogs(ctx, constructLogs())
+		assert.NoError(t, err)
+
+		if report {
+			assert.Equal(t, int64(2), recorder.Rejected())
+			assert.Equal(t, "dropped by the filter processor", recorder.Message())
+		} else {
+			assert.Equal(t, int64(0), recorder.Rejected())
+		}
+	}
+}
+
 func constructLogs() plog.Logs {
 	td := plog.NewLogs()
 	rs0 := td.ResourceLogs().AppendEmpty()
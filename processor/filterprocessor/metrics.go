@@ -20,6 +20,7 @@ import (
 	"github.com/open-telemetry/opentelemetry-collector-contrib/internal/filter/filtermetric"
 	"github.com/open-telemetry/opentelemetry-collector-contrib/internal/filter/filterottl"
 	"github.com/open-telemetry/opentelemetry-collector-contrib/internal/filter/filterset"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/internal/partialsuccess"
 	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl/contexts/ottldatapoint"
 	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl/contexts/ottlmetric"
 	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl/contexts/ottlresource"
@@ -31,12 +32,14 @@ type filterMetricProcessor struct {
 	skipDataPointExpr expr.BoolExpr[ottldatapoint.TransformContext]
 	telemetry         *filterProcessorTelemetry
 	logger            *zap.Logger
+	reportRejected    bool
 }
 
 func newFilterMetricProcessor(set processor.CreateSettings, cfg *Config) (*filterMetricProcessor, error) {
 	var err error
 	fsp := &filterMetricProcessor{
-		logger: set.Logger,
+		logger:         set.Logger,
+		reportRejected: cfg.ReportRejected,
 	}
 
 	fpt, err := newfilterProcessorTelemetry(set)
@@ -45,6 +48,12 @@ func newFilterMetricProcessor(set processor.CreateSettings, cfg *Config) (*filte
 	}
 	fsp.telemetry = fpt
 
+	skipDataPointValueExpr, err := newDataPointValueExpr(cfg.Metrics.DataPointValues)
+	if err != nil {
+		return nil, err
+	}
+	fsp.skipDataPointExpr = skipDataPointValueExpr
+
 	if cfg.Metrics.MetricConditions != nil || cfg.Metrics.DataPointConditions != nil {
 		if cfg.Metrics.MetricConditions != nil {
 			fsp.skipMetricExpr, err = filterottl.NewBoolExprForMetric(cfg.Metrics.MetricConditions, filterottl.StandardMetricFuncs(), cfg.ErrorMode, set.TelemetrySettings)
@@ -58,6 +67,9 @@ func newFilterMetricProcessor(set processor.CreateSettings, cfg *Config) (*filte
 			if err != nil {
 				return nil, err
 			}
+			if skipDataPointValueExpr != nil {
+				fsp.skipDataPointExpr = expr.Or(fsp.skipDataPointExpr, skipDataPointValueExpr)
+			}
 		}
 
 		return fsp, nil
@@ -174,6 +186,9 @@ func (fmp *filterMetricProcessor) processMetrics(ctx context.Context, md pmetric
 
 	metricDataPointCountAfterFilters := md.DataPointCount()
 	fmp.telemetry.record(triggerMetricDataPointsDropped, int64(metricDataPointCountBeforeFilters-metricDataPointCountAfterFilters))
+	if fmp.reportRejected {
+		partialsuccess.Record(ctx, metricDataPointCountBeforeFilters-metricDataPointCountAfterFilters, rejectedReason)
+	}
 
 	if errors != nil {
 		fmp.logger.Error("failed processing metrics", zap.Error(errors))
@@ -21,6 +21,9 @@ const (
 	triggerSpansDropped
 )
 
+// rejectedReason is the reason of the items dropped by the processor, when reported as rejected
+const rejectedReason = "dropped by the filter processor"
+
 type filterProcessorTelemetry struct {
 	exportCtx context.Context
 
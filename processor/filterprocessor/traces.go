@@ -16,6 +16,7 @@ import (
 	"github.com/open-telemetry/opentelemetry-collector-contrib/internal/filter/expr"
 	"github.com/open-telemetry/opentelemetry-collector-contrib/internal/filter/filterottl"
 	"github.com/open-telemetry/opentelemetry-collector-contrib/internal/filter/filterspan"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/internal/partialsuccess"
 	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl/contexts/ottlspan"
 	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl/contexts/ottlspanevent"
 )
@@ -25,12 +26,14 @@ type filterSpanProcessor struct {
 	skipSpanEventExpr expr.BoolExpr[ottlspanevent.TransformContext]
 	telemetry         *filterProcessorTelemetry
 	logger            *zap.Logger
+	reportRejected    bool
 }
 
 func newFilterSpansProcessor(set processor.CreateSettings, cfg *Config) (*filterSpanProcessor, error) {
 	var err error
 	fsp := &filterSpanProcessor{
-		logger: set.Logger,
+		logger:         set.Logger,
+		reportRejected: cfg.ReportRejected,
 	}
 
 	fpt, err := newfilterProcessorTelemetry(set)
@@ -121,6 +124,9 @@ func (fsp *filterSpanProcessor) processTraces(ctx context.Context, td ptrace.Tra
 
 	spanCountAfterFilters := td.SpanCount()
 	fsp.telemetry.record(triggerSpansDropped, int64(spanCountBeforeFilters-spanCountAfterFilters))
+	if fsp.reportRejected {
+		partialsuccess.Record(ctx, spanCountBeforeFilters-spanCountAfterFilters, rejectedReason)
+	}
 
 	if errors != nil {
 		fsp.logger.Error("failed processing traces", zap.Error(errors))
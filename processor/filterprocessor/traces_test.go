@@ -18,6 +18,7 @@ import (
 
 	"github.com/open-telemetry/opentelemetry-collector-contrib/internal/filter/filterconfig"
 	"github.com/open-telemetry/opentelemetry-collector-contrib/internal/filter/filterset"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/internal/partialsuccess"
 	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl"
 )
 
@@ -300,6 +301,21 @@ func TestFilterTraceProcessorTelemetry(t *testing.T) {
 	})
 }
 
+func TestFilterTraceProcessorReportRejected(t *testing.T) {
+	processor, err := newFilterSpansProcessor(processortest.NewNopCreateSettings(), &Config{
+		Traces:         TraceFilters{SpanConditions: []string{`name == "operationA"`}},
+		ReportRejected: true,
+	})
+	assert.NoError(t, err)
+
+	ctx, recorder := partialsuccess.NewContext(context.Background())
+	_, err = processor.processTraces(ctx, constructTraces())
+	assert.NoError(t, err)
+
+	assert.Equal(t, int64(2), recorder.Rejected())
+	assert.Equal(t, "dropped by the filter processor", recorder.Message())
+}
+
 func constructTraces() ptrace.Traces {
 	td := ptrace.NewTraces()
 	rs0 := td.ResourceSpans().AppendEmpty()
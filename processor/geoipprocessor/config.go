@@ -3,9 +3,46 @@
 
 package geoipprocessor // import "github.com/open-telemetry/opentelemetry-collector-contrib/processor/geoipprocessor"
 
+import (
+	"errors"
+	"fmt"
+	"slices"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/processor/geoipprocessor/internal/convention"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/processor/geoipprocessor/internal/provider/ip2locationprovider"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/processor/geoipprocessor/internal/provider/maxmindprovider"
+)
+
 // Config holds the configuration for the GeoIP processor.
-type Config struct{}
+type Config struct {
+	// Providers are the sources of the geographical locations of the IP addresses.
+	Providers ProvidersConfig `mapstructure:"providers"`
+
+	// Attributes are the resource attributes holding the IP address to look up, the first one holding an IP
+	// address being used.
+	Attributes []string `mapstructure:"attributes"`
+
+	// Fields are the geo attributes added to the resources, all of them by default.
+	Fields []string `mapstructure:"fields"`
+}
+
+// ProvidersConfig holds the configuration of the providers, the unset ones being disabled.
+type ProvidersConfig struct {
+	MaxMind     *maxmindprovider.Config     `mapstructure:"maxmind"`
+	IP2Location *ip2locationprovider.Config `mapstructure:"ip2location"`
+}
 
 func (cfg *Config) Validate() error {
+	if cfg.Providers.MaxMind == nil && cfg.Providers.IP2Location == nil {
+		return errors.New("must specify at least one geo IP data provider when using the geoip processor")
+	}
+	if len(cfg.Attributes) == 0 {
+		return errors.New("must specify at least one attribute holding the IP address")
+	}
+	for _, field := range cfg.Fields {
+		if !slices.Contains(convention.Attributes, field) {
+			return fmt.Errorf("unknown field %q", field)
+		}
+	}
 	return nil
 }
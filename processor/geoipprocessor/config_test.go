@@ -13,6 +13,8 @@ import (
 	"go.opentelemetry.io/collector/confmap/confmaptest"
 
 	"github.com/open-telemetry/opentelemetry-collector-contrib/processor/geoipprocessor/internal/metadata"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/processor/geoipprocessor/internal/provider/ip2locationprovider"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/processor/geoipprocessor/internal/provider/maxmindprovider"
 )
 
 func TestLoadConfig(t *testing.T) {
@@ -24,8 +26,40 @@ func TestLoadConfig(t *testing.T) {
 		errorMessage string
 	}{
 		{
-			id:       component.NewID(metadata.Type),
-			expected: &Config{},
+			id:           component.NewID(metadata.Type),
+			errorMessage: "must specify at least one geo IP data provider when using the geoip processor",
+		},
+		{
+			id: component.NewIDWithName(metadata.Type, "maxmind"),
+			expected: &Config{
+				Providers: ProvidersConfig{
+					MaxMind: &maxmindprovider.Config{DatabasePath: "/tmp/GeoLite2-City.mmdb"},
+				},
+				Attributes: []string{"source.address"},
+			},
+		},
+		{
+			id: component.NewIDWithName(metadata.Type, "all"),
+			expected: &Config{
+				Providers: ProvidersConfig{
+					MaxMind:     &maxmindprovider.Config{DatabasePath: "/tmp/GeoLite2-City.mmdb"},
+					IP2Location: &ip2locationprovider.Config{DatabasePath: "/tmp/IP2LOCATION-LITE-DB11.BIN"},
+				},
+				Attributes: []string{"client.address", "source.address"},
+				Fields:     []string{"geo.country_iso_code", "geo.city_name"},
+			},
+		},
+		{
+			id:           component.NewIDWithName(metadata.Type, "no_database_path"),
+			errorMessage: "a database_path must be provided for the ip2location provider",
+		},
+		{
+			id:           component.NewIDWithName(metadata.Type, "no_attributes"),
+			errorMessage: "must specify at least one attribute holding the IP address",
+		},
+		{
+			id:           component.NewIDWithName(metadata.Type, "unknown_field"),
+			errorMessage: `unknown field "geo.city"`,
 		},
 	}
 
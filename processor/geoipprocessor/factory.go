@@ -5,6 +5,8 @@ package geoipprocessor // import "github.com/open-telemetry/opentelemetry-collec
 
 import (
 	"context"
+	"errors"
+	"slices"
 
 	"go.opentelemetry.io/collector/component"
 	"go.opentelemetry.io/collector/consumer"
@@ -14,6 +16,9 @@ import (
 	semconv "go.opentelemetry.io/otel/semconv/v1.25.0"
 
 	"github.com/open-telemetry/opentelemetry-collector-contrib/processor/geoipprocessor/internal/metadata"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/processor/geoipprocessor/internal/provider"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/processor/geoipprocessor/internal/provider/ip2locationprovider"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/processor/geoipprocessor/internal/provider/maxmindprovider"
 )
 
 var (
@@ -33,17 +38,76 @@ func NewFactory() processor.Factory {
 
 // createDefaultConfig returns a default configuration for the processor.
 func createDefaultConfig() component.Config {
-	return &Config{}
+	attributes := make([]string, 0, len(defaultResourceAttributes))
+	for _, key := range defaultResourceAttributes {
+		attributes = append(attributes, string(key))
+	}
+	return &Config{
+		Attributes: attributes,
+	}
+}
+
+// createProviders opens the databases of the configured providers. The attributes of the latter providers override
+// the ones of the former, IP2Location ones overriding MaxMind ones.
+func createProviders(cfg *Config, set processor.CreateSettings) ([]provider.GeoIPProvider, error) {
+	var providers []provider.GeoIPProvider
+	var errs error
+	if cfg.Providers.MaxMind != nil {
+		p, err := maxmindprovider.NewProvider(cfg.Providers.MaxMind, set.Logger)
+		errs = errors.Join(errs, err)
+		if err == nil {
+			providers = append(providers, p)
+		}
+	}
+	if cfg.Providers.IP2Location != nil {
+		p, err := ip2locationprovider.NewProvider(cfg.Providers.IP2Location, set.Logger)
+		errs = errors.Join(errs, err)
+		if err == nil {
+			providers = append(providers, p)
+		}
+	}
+	if errs != nil {
+		for _, p := range providers {
+			_ = p.Close(context.Background())
+		}
+		return nil, errs
+	}
+	return providers, nil
+}
+
+func createGeoIPProcessor(cfg component.Config, set processor.CreateSettings) (*geoIPProcessor, error) {
+	geoCfg := cfg.(*Config)
+	providers, err := createProviders(geoCfg, set)
+	if err != nil {
+		return nil, err
+	}
+	resourceAttributes := make([]attribute.Key, 0, len(geoCfg.Attributes))
+	for _, attr := range geoCfg.Attributes {
+		resourceAttributes = append(resourceAttributes, attribute.Key(attr))
+	}
+	return newGeoIPProcessor(resourceAttributes, slices.Clone(geoCfg.Fields), providers), nil
 }
 
 func createMetricsProcessor(ctx context.Context, set processor.CreateSettings, cfg component.Config, nextConsumer consumer.Metrics) (processor.Metrics, error) {
-	return processorhelper.NewMetricsProcessor(ctx, set, cfg, nextConsumer, newGeoIPProcessor(defaultResourceAttributes).processMetrics, processorhelper.WithCapabilities(processorCapabilities))
+	geoProcessor, err := createGeoIPProcessor(cfg, set)
+	if err != nil {
+		return nil, err
+	}
+	return processorhelper.NewMetricsProcessor(ctx, set, cfg, nextConsumer, geoProcessor.processMetrics, processorhelper.WithCapabilities(processorCapabilities), processorhelper.WithShutdown(geoProcessor.shutdown))
 }
 
 func createTracesProcessor(ctx context.Context, set processor.CreateSettings, cfg component.Config, nextConsumer consumer.Traces) (processor.Traces, error) {
-	return processorhelper.NewTracesProcessor(ctx, set, cfg, nextConsumer, newGeoIPProcessor(defaultResourceAttributes).processTraces, processorhelper.WithCapabilities(processorCapabilities))
+	geoProcessor, err := createGeoIPProcessor(cfg, set)
+	if err != nil {
+		return nil, err
+	}
+	return processorhelper.NewTracesProcessor(ctx, set, cfg, nextConsumer, geoProcessor.processTraces, processorhelper.WithCapabilities(processorCapabilities), processorhelper.WithShutdown(geoProcessor.shutdown))
 }
 
 func createLogsProcessor(ctx context.Context, set processor.CreateSettings, cfg component.Config, nextConsumer consumer.Logs) (processor.Logs, error) {
-	return processorhelper.NewLogsProcessor(ctx, set, cfg, nextConsumer, newGeoIPProcessor(defaultResourceAttributes).processLogs, processorhelper.WithCapabilities(processorCapabilities))
+	geoProcessor, err := createGeoIPProcessor(cfg, set)
+	if err != nil {
+		return nil, err
+	}
+	return processorhelper.NewLogsProcessor(ctx, set, cfg, nextConsumer, geoProcessor.processLogs, processorhelper.WithCapabilities(processorCapabilities), processorhelper.WithShutdown(geoProcessor.shutdown))
 }
@@ -5,12 +5,16 @@ package geoipprocessor
 
 import (
 	"context"
+	"os"
+	"path/filepath"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
 	"go.opentelemetry.io/collector/component/componenttest"
 	"go.opentelemetry.io/collector/consumer/consumertest"
 	"go.opentelemetry.io/collector/processor/processortest"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/processor/geoipprocessor/internal/provider/maxmindprovider"
 )
 
 func TestCreateDefaultConfig(t *testing.T) {
@@ -50,3 +54,23 @@ func TestCreateProcessor(t *testing.T) {
 	assert.NotNil(t, lp)
 	assert.NoError(t, err)
 }
+
+func TestCreateProcessorMissingDatabase(t *testing.T) {
+	factory := NewFactory()
+
+	cfg := factory.CreateDefaultConfig().(*Config)
+	cfg.Providers.MaxMind = &maxmindprovider.Config{DatabasePath: filepath.Join(t.TempDir(), "missing.mmdb")}
+	params := processortest.NewNopCreateSettings()
+
+	tp, err := factory.CreateTracesProcessor(context.Background(), params, cfg, consumertest.NewNop())
+	assert.Nil(t, tp)
+	assert.ErrorIs(t, err, os.ErrNotExist)
+
+	mp, err := factory.CreateMetricsProcessor(context.Background(), params, cfg, consumertest.NewNop())
+	assert.Nil(t, mp)
+	assert.ErrorIs(t, err, os.ErrNotExist)
+
+	lp, err := factory.CreateLogsProcessor(context.Background(), params, cfg, consumertest.NewNop())
+	assert.Nil(t, lp)
+	assert.ErrorIs(t, err, os.ErrNotExist)
+}
@@ -7,6 +7,7 @@ import (
 	"context"
 	"errors"
 	"net"
+	"slices"
 
 	"go.opentelemetry.io/collector/pdata/pcommon"
 	"go.opentelemetry.io/collector/pdata/plog"
@@ -23,11 +24,15 @@ var errIPNotFound = errors.New("no IP address found in the resource attributes")
 type geoIPProcessor struct {
 	providers          []provider.GeoIPProvider
 	resourceAttributes []attribute.Key
+	// fields are the geo attributes added to the resources, all of them when empty
+	fields []string
 }
 
-func newGeoIPProcessor(resourceAttributes []attribute.Key) *geoIPProcessor {
+func newGeoIPProcessor(resourceAttributes []attribute.Key, fields []string, providers []provider.GeoIPProvider) *geoIPProcessor {
 	return &geoIPProcessor{
 		resourceAttributes: resourceAttributes,
+		fields:             fields,
+		providers:          providers,
 	}
 }
 
@@ -80,12 +85,29 @@ func (g *geoIPProcessor) processResource(ctx context.Context, resource pcommon.R
 	}
 
 	for _, geoAttr := range attributes.ToSlice() {
-		resource.Attributes().PutStr(string(geoAttr.Key), geoAttr.Value.AsString())
+		if len(g.fields) > 0 && !slices.Contains(g.fields, string(geoAttr.Key)) {
+			continue
+		}
+		switch geoAttr.Value.Type() {
+		case attribute.FLOAT64:
+			resource.Attributes().PutDouble(string(geoAttr.Key), geoAttr.Value.AsFloat64())
+		default:
+			resource.Attributes().PutStr(string(geoAttr.Key), geoAttr.Value.AsString())
+		}
 	}
 
 	return nil
 }
 
+// shutdown closes the providers.
+func (g *geoIPProcessor) shutdown(ctx context.Context) error {
+	var errs error
+	for _, provider := range g.providers {
+		errs = errors.Join(errs, provider.Close(ctx))
+	}
+	return errs
+}
+
 func (g *geoIPProcessor) processMetrics(ctx context.Context, ms pmetric.Metrics) (pmetric.Metrics, error) {
 	rm := ms.ResourceMetrics()
 	for i := 0; i < rm.Len(); i++ {
@@ -38,6 +38,10 @@ func (pm *ProviderMock) Location(ctx context.Context, ip net.IP) (attribute.Set,
 	return pm.LocationF(ctx, ip)
 }
 
+func (pm *ProviderMock) Close(context.Context) error {
+	return nil
+}
+
 type generateResourceFunc func(res pcommon.Resource)
 
 func generateTraces(resourceFunc ...generateResourceFunc) ptrace.Traces {
@@ -90,6 +94,7 @@ func TestProcessPdata(t *testing.T) {
 	tests := []struct {
 		name                       string
 		resourceAttributes         []attribute.Key
+		fields                     []string
 		initResourceAttributes     []generateResourceFunc
 		geoLocationMock            func(context.Context, net.IP) (attribute.Set, error)
 		expectedResourceAttributes []generateResourceFunc
@@ -171,6 +176,33 @@ func TestProcessPdata(t *testing.T) {
 				}),
 			},
 		},
+		{
+			name:               "selected fields",
+			resourceAttributes: []attribute.Key{"ip"},
+			fields:             []string{"geo.city_name", "geo.location.lat"},
+			initResourceAttributes: []generateResourceFunc{
+				withAttributes([]attribute.KeyValue{
+					attribute.String("ip", "1.2.3.4"),
+				}),
+			},
+			geoLocationMock: func(context.Context, net.IP) (attribute.Set, error) {
+				return attribute.NewSet(
+					attribute.String("geo.city_name", "barcelona"),
+					attribute.String("geo.country_iso_code", "ES"),
+					attribute.Float64("geo.location.lat", 41.3888),
+					attribute.Float64("geo.location.lon", 2.159)), nil
+			},
+			expectedResourceAttributes: []generateResourceFunc{
+				withAttributes([]attribute.KeyValue{
+					attribute.String("ip", "1.2.3.4"),
+					attribute.String("geo.city_name", "barcelona"),
+				}),
+				func(res pcommon.Resource) {
+					// the coordinates keep their type
+					res.Attributes().PutDouble("geo.location.lat", 41.3888)
+				},
+			},
+		},
 		{
 			name:               "do not add resource attributes with an invalid ip",
 			resourceAttributes: defaultResourceAttributes,
@@ -194,8 +226,7 @@ func TestProcessPdata(t *testing.T) {
 		t.Run(tt.name, func(t *testing.T) {
 			// prepare processor
 			baseProviderMock.LocationF = tt.geoLocationMock
-			processor := newGeoIPProcessor(tt.resourceAttributes)
-			processor.providers = []provider.GeoIPProvider{&baseProviderMock}
+			processor := newGeoIPProcessor(tt.resourceAttributes, tt.fields, []provider.GeoIPProvider{&baseProviderMock})
 
 			// assert metrics
 			actualMetrics, err := processor.processMetrics(context.Background(), generateMetrics(tt.initResourceAttributes...))
@@ -0,0 +1,46 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+// Package convention defines the geo attributes added by the processor, which aren't part of the semantic conventions
+// yet.
+package convention // import "github.com/open-telemetry/opentelemetry-collector-contrib/processor/geoipprocessor/internal/convention"
+
+const (
+	// AttributeGeoCityName is the name of the city.
+	AttributeGeoCityName = "geo.city_name"
+	// AttributeGeoPostalCode is the postal code.
+	AttributeGeoPostalCode = "geo.postal_code"
+	// AttributeGeoCountryName is the name of the country.
+	AttributeGeoCountryName = "geo.country_name"
+	// AttributeGeoCountryIsoCode is the ISO 3166-1 alpha-2 code of the country.
+	AttributeGeoCountryIsoCode = "geo.country_iso_code"
+	// AttributeGeoContinentName is the name of the continent.
+	AttributeGeoContinentName = "geo.continent_name"
+	// AttributeGeoContinentCode is the two-letter code of the continent.
+	AttributeGeoContinentCode = "geo.continent_code"
+	// AttributeGeoRegionName is the name of the region, e.g. the state or the province.
+	AttributeGeoRegionName = "geo.region_name"
+	// AttributeGeoRegionIsoCode is the ISO 3166-2 code of the region, without the country code.
+	AttributeGeoRegionIsoCode = "geo.region_iso_code"
+	// AttributeGeoTimezone is the IANA time zone, e.g. Europe/Madrid.
+	AttributeGeoTimezone = "geo.timezone"
+	// AttributeGeoLocationLat is the latitude.
+	AttributeGeoLocationLat = "geo.location.lat"
+	// AttributeGeoLocationLon is the longitude.
+	AttributeGeoLocationLon = "geo.location.lon"
+)
+
+// Attributes are all the geo attributes.
+var Attributes = []string{
+	AttributeGeoCityName,
+	AttributeGeoPostalCode,
+	AttributeGeoCountryName,
+	AttributeGeoCountryIsoCode,
+	AttributeGeoContinentName,
+	AttributeGeoContinentCode,
+	AttributeGeoRegionName,
+	AttributeGeoRegionIsoCode,
+	AttributeGeoTimezone,
+	AttributeGeoLocationLat,
+	AttributeGeoLocationLon,
+}
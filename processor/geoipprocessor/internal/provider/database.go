@@ -0,0 +1,119 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package provider // import "github.com/open-telemetry/opentelemetry-collector-contrib/processor/geoipprocessor/internal/provider"
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"sync"
+	"time"
+
+	"github.com/fsnotify/fsnotify"
+	"go.uber.org/zap"
+)
+
+// Database holds a database loaded from a file, and reloads it when the file changes on disk, e.g. when it's updated
+// by a scheduled download. The previous database is kept when the file can't be loaded, e.g. while it's being written,
+// so the database must be loaded in memory rather than read from the file on each lookup.
+type Database[T any] struct {
+	path   string
+	load   func(path string) (T, error)
+	logger *zap.Logger
+
+	lock    sync.RWMutex
+	db      T
+	modTime time.Time
+	size    int64
+
+	watcher *fsnotify.Watcher
+	wg      sync.WaitGroup
+}
+
+// NewDatabase loads the database from the file at path, and starts watching the file.
+func NewDatabase[T any](path string, load func(path string) (T, error), logger *zap.Logger) (*Database[T], error) {
+	d := &Database[T]{
+		path:   path,
+		load:   load,
+		logger: logger,
+	}
+	info, err := os.Stat(path)
+	if err != nil {
+		return nil, err
+	}
+	if d.db, err = load(path); err != nil {
+		return nil, err
+	}
+	d.modTime, d.size = info.ModTime(), info.Size()
+
+	watcher, err := fsnotify.NewWatcher()
+	if err != nil {
+		return nil, fmt.Errorf("failed to watch the database: %w", err)
+	}
+	// the directory is watched rather than the file, which may be replaced by a rename or an update of a symbolic link
+	// like the files of the Kubernetes volumes
+	if err = watcher.Add(filepath.Dir(path)); err != nil {
+		_ = watcher.Close()
+		return nil, fmt.Errorf("failed to watch the database: %w", err)
+	}
+	d.watcher = watcher
+	d.wg.Add(1)
+	go d.watch()
+	return d, nil
+}
+
+// Get returns the current database.
+func (d *Database[T]) Get() T {
+	d.lock.RLock()
+	defer d.lock.RUnlock()
+	return d.db
+}
+
+// Close stops watching the file.
+func (d *Database[T]) Close() error {
+	err := d.watcher.Close()
+	d.wg.Wait()
+	return err
+}
+
+func (d *Database[T]) watch() {
+	defer d.wg.Done()
+	for {
+		select {
+		case _, ok := <-d.watcher.Events:
+			if !ok {
+				return
+			}
+			d.reloadIfChanged()
+		case err, ok := <-d.watcher.Errors:
+			if !ok {
+				return
+			}
+			d.logger.Warn("Failed to watch the database", zap.String("path", d.path), zap.Error(err))
+		}
+	}
+}
+
+// reloadIfChanged reloads the database when the modification time or the size of the file changed. The file isn't
+// compared with the loaded one if it can't be loaded, so that it's loaded again on the next change.
+func (d *Database[T]) reloadIfChanged() {
+	info, err := os.Stat(d.path)
+	if err != nil {
+		// the file is being replaced
+		return
+	}
+	if info.ModTime().Equal(d.modTime) && info.Size() == d.size {
+		return
+	}
+	db, err := d.load(d.path)
+	if err != nil {
+		d.logger.Warn("Failed to reload the database, keeping the previous one", zap.String("path", d.path), zap.Error(err))
+		return
+	}
+	d.lock.Lock()
+	d.db = db
+	d.lock.Unlock()
+	d.modTime, d.size = info.ModTime(), info.Size()
+	d.logger.Info("Reloaded the database", zap.String("path", d.path))
+}
@@ -0,0 +1,65 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package provider
+
+import (
+	"errors"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+)
+
+// loadVersion loads the content of the file, failing when it isn't a version.
+func loadVersion(path string) (string, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return "", err
+	}
+	if len(data) < 2 || data[0] != 'v' {
+		return "", errors.New("invalid database")
+	}
+	return string(data), nil
+}
+
+func TestDatabaseReload(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "geo.db")
+	require.NoError(t, os.WriteFile(path, []byte("v1"), 0600))
+	db, err := NewDatabase(path, loadVersion, zap.NewNop())
+	require.NoError(t, err)
+	defer func() { assert.NoError(t, db.Close()) }()
+	assert.Equal(t, "v1", db.Get())
+
+	// the file is written in place
+	require.NoError(t, os.WriteFile(path, []byte("v22"), 0600))
+	assert.Eventually(t, func() bool { return db.Get() == "v22" }, 5*time.Second, 10*time.Millisecond)
+
+	// the file is replaced by a rename, like the downloaded updates
+	tmp := filepath.Join(filepath.Dir(path), "geo.db.tmp")
+	require.NoError(t, os.WriteFile(tmp, []byte("v333"), 0600))
+	require.NoError(t, os.Rename(tmp, path))
+	assert.Eventually(t, func() bool { return db.Get() == "v333" }, 5*time.Second, 10*time.Millisecond)
+
+	// the previous database is kept while the file is invalid
+	require.NoError(t, os.WriteFile(path, []byte("partial"), 0600))
+	require.NoError(t, os.WriteFile(tmp, []byte("other file"), 0600))
+	assert.Never(t, func() bool { return db.Get() != "v333" }, 200*time.Millisecond, 10*time.Millisecond)
+	require.NoError(t, os.WriteFile(path, []byte("v4444"), 0600))
+	assert.Eventually(t, func() bool { return db.Get() == "v4444" }, 5*time.Second, 10*time.Millisecond)
+}
+
+func TestNewDatabaseErrors(t *testing.T) {
+	dir := t.TempDir()
+	_, err := NewDatabase(filepath.Join(dir, "missing.db"), loadVersion, zap.NewNop())
+	assert.ErrorIs(t, err, os.ErrNotExist)
+
+	path := filepath.Join(dir, "invalid.db")
+	require.NoError(t, os.WriteFile(path, []byte("invalid"), 0600))
+	_, err = NewDatabase(path, loadVersion, zap.NewNop())
+	assert.EqualError(t, err, "invalid database")
+}
@@ -0,0 +1,20 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package ip2locationprovider // import "github.com/open-telemetry/opentelemetry-collector-contrib/processor/geoipprocessor/internal/provider/ip2locationprovider"
+
+import "errors"
+
+// Config defines the configuration of the IP2Location provider.
+type Config struct {
+	// DatabasePath is the path of the IP2Location database file, in the BIN format.
+	DatabasePath string `mapstructure:"database_path"`
+}
+
+// Validate checks if the provider configuration is valid.
+func (cfg *Config) Validate() error {
+	if cfg.DatabasePath == "" {
+		return errors.New("a database_path must be provided for the ip2location provider")
+	}
+	return nil
+}
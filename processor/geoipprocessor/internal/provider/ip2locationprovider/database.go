@@ -0,0 +1,219 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package ip2locationprovider // import "github.com/open-telemetry/opentelemetry-collector-contrib/processor/geoipprocessor/internal/provider/ip2locationprovider"
+
+import (
+	"bytes"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"math"
+	"net"
+)
+
+// The positions of the fields in the rows of each type of database, 0 when the type doesn't contain the field. The
+// first field of the rows, at position 1, is the first IP address of the range.
+var (
+	countryPosition   = [27]uint32{0, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2}
+	regionPosition    = [27]uint32{0, 0, 0, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3}
+	cityPosition      = [27]uint32{0, 0, 0, 4, 4, 4, 4, 4, 4, 4, 4, 4, 4, 4, 4, 4, 4, 4, 4, 4, 4, 4, 4, 4, 4, 4, 4}
+	latitudePosition  = [27]uint32{0, 0, 0, 0, 0, 5, 5, 0, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5}
+	longitudePosition = [27]uint32{0, 0, 0, 0, 0, 6, 6, 0, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6}
+	zipCodePosition   = [27]uint32{0, 0, 0, 0, 0, 0, 0, 0, 0, 7, 7, 7, 7, 0, 7, 7, 7, 0, 7, 0, 7, 7, 7, 0, 7, 7, 7}
+)
+
+const (
+	headerSize = 64
+	// productCode identifies the IP2Location databases, as opposed to the IP2Proxy ones
+	productCode = 1
+)
+
+var errCorrupted = errors.New("the IP2Location database is corrupted")
+
+// database reads the IP2Location BIN databases, whose rows are sorted by ranges of IP addresses. The rows of each
+// range start with its first IP address, followed by the fields, strings being stored as offsets to the values.
+// The addresses of the rows in the header are 1-based, while the offsets to the strings are 0-based.
+type database struct {
+	data    []byte
+	dbType  uint8
+	columns uint32
+
+	ipv4Count, ipv4Addr, ipv4IndexAddr uint32
+	ipv6Count, ipv6Addr, ipv6IndexAddr uint32
+}
+
+// record is the location of an IP address, the missing fields being empty.
+type record struct {
+	countryCode string
+	countryName string
+	region      string
+	city        string
+	zipCode     string
+	latitude    float32
+	longitude   float32
+	hasLocation bool
+}
+
+func parseDatabase(data []byte) (*database, error) {
+	if len(data) < headerSize {
+		return nil, errors.New("the file is too short to be an IP2Location BIN database")
+	}
+	db := &database{
+		data:          data,
+		dbType:        data[0],
+		columns:       uint32(data[1]),
+		ipv4Count:     binary.LittleEndian.Uint32(data[5:]),
+		ipv4Addr:      binary.LittleEndian.Uint32(data[9:]),
+		ipv6Count:     binary.LittleEndian.Uint32(data[13:]),
+		ipv6Addr:      binary.LittleEndian.Uint32(data[17:]),
+		ipv4IndexAddr: binary.LittleEndian.Uint32(data[21:]),
+		ipv6IndexAddr: binary.LittleEndian.Uint32(data[25:]),
+	}
+	year, product := data[2], data[29]
+	// the product code is only set in the databases released since 2021
+	if product != productCode && (product != 0 || year > 20) {
+		return nil, errors.New("the file isn't an IP2Location BIN database")
+	}
+	if db.dbType == 0 || int(db.dbType) >= len(countryPosition) {
+		return nil, fmt.Errorf("unsupported IP2Location database type %d", db.dbType)
+	}
+	if db.columns < 2 {
+		return nil, errCorrupted
+	}
+	return db, nil
+}
+
+// lookup returns the location of the IP address, and false if the database doesn't contain it.
+func (db *database) lookup(ip net.IP) (record, bool, error) {
+	ipNumber := ip.To4()
+	count, base, indexAddr, ipSize := db.ipv4Count, db.ipv4Addr, db.ipv4IndexAddr, uint32(net.IPv4len)
+	if ipNumber == nil {
+		ipNumber = ip.To16()
+		count, base, indexAddr, ipSize = db.ipv6Count, db.ipv6Addr, db.ipv6IndexAddr, net.IPv6len
+	}
+	if ipNumber == nil || count == 0 {
+		return record{}, false, nil
+	}
+	ipNumber = bytes.Clone(ipNumber)
+	// the ranges don't include their last address, the last address of the table is in the last range
+	if bytes.Count(ipNumber, []byte{0xff}) == len(ipNumber) {
+		ipNumber[len(ipNumber)-1]--
+	}
+	rowSize := ipSize + (db.columns-1)*4
+
+	low, high := uint32(0), count
+	if indexAddr > 0 {
+		// the index holds the rows of the ranges of the first 16 bits of the addresses
+		pos := indexAddr + uint32(binary.BigEndian.Uint16(ipNumber))*8
+		var err error
+		if low, err = db.uint32(pos); err != nil {
+			return record{}, false, err
+		}
+		if high, err = db.uint32(pos + 4); err != nil {
+			return record{}, false, err
+		}
+	}
+
+	for low <= high {
+		mid := low + (high-low)/2
+		row := base + mid*rowSize
+		from, err := db.ipNumber(row, ipSize)
+		if err != nil {
+			return record{}, false, err
+		}
+		to, err := db.ipNumber(row+rowSize, ipSize)
+		if err != nil {
+			return record{}, false, err
+		}
+		switch {
+		case bytes.Compare(ipNumber, from) < 0:
+			if mid == 0 {
+				return record{}, false, nil
+			}
+			high = mid - 1
+		case bytes.Compare(ipNumber, to) >= 0:
+			low = mid + 1
+		default:
+			rec, err := db.record(row + ipSize)
+			return rec, err == nil, err
+		}
+	}
+	return record{}, false, nil
+}
+
+// record reads the fields of the row starting at the 1-based address.
+func (db *database) record(fields uint32) (record, error) {
+	var rec record
+	var err error
+	field := func(positions [27]uint32) (uint32, bool) {
+		position := positions[db.dbType]
+		if position == 0 || err != nil {
+			return 0, false
+		}
+		var value uint32
+		value, err = db.uint32(fields + (position-2)*4)
+		return value, err == nil
+	}
+	str := func(offset uint32) string {
+		if err != nil {
+			return ""
+		}
+		var value string
+		value, err = db.string(offset)
+		return value
+	}
+
+	if offset, ok := field(countryPosition); ok {
+		rec.countryCode = str(offset)
+		rec.countryName = str(offset + 3)
+	}
+	if offset, ok := field(regionPosition); ok {
+		rec.region = str(offset)
+	}
+	if offset, ok := field(cityPosition); ok {
+		rec.city = str(offset)
+	}
+	if offset, ok := field(zipCodePosition); ok {
+		rec.zipCode = str(offset)
+	}
+	latitude, hasLatitude := field(latitudePosition)
+	longitude, hasLongitude := field(longitudePosition)
+	if hasLatitude && hasLongitude {
+		rec.latitude, rec.longitude = math.Float32frombits(latitude), math.Float32frombits(longitude)
+		rec.hasLocation = true
+	}
+	return rec, err
+}
+
+// ipNumber returns the big-endian IP address stored in little-endian at the 1-based address.
+func (db *database) ipNumber(addr, size uint32) ([]byte, error) {
+	if addr == 0 || uint64(addr)-1+uint64(size) > uint64(len(db.data)) {
+		return nil, errCorrupted
+	}
+	ip := bytes.Clone(db.data[addr-1 : addr-1+size])
+	for i, j := 0, len(ip)-1; i < j; i, j = i+1, j-1 {
+		ip[i], ip[j] = ip[j], ip[i]
+	}
+	return ip, nil
+}
+
+// uint32 returns the little-endian number at the 1-based address.
+func (db *database) uint32(addr uint32) (uint32, error) {
+	if addr == 0 || uint64(addr)+3 > uint64(len(db.data)) {
+		return 0, errCorrupted
+	}
+	return binary.LittleEndian.Uint32(db.data[addr-1:]), nil
+}
+
+// string returns the string prefixed by its length at the 0-based offset.
+func (db *database) string(offset uint32) (string, error) {
+	if uint64(offset) >= uint64(len(db.data)) {
+		return "", errCorrupted
+	}
+	end := uint64(offset) + 1 + uint64(db.data[offset])
+	if end > uint64(len(db.data)) {
+		return "", errCorrupted
+	}
+	return string(db.data[offset+1 : end]), nil
+}
@@ -0,0 +1,83 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+// Package ip2locationprovider implements a geo location provider reading the IP2Location and IP2Location LITE
+// databases in the BIN format.
+package ip2locationprovider // import "github.com/open-telemetry/opentelemetry-collector-contrib/processor/geoipprocessor/internal/provider/ip2locationprovider"
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"os"
+	"strconv"
+
+	"go.opentelemetry.io/otel/attribute"
+	"go.uber.org/zap"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/processor/geoipprocessor/internal/convention"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/processor/geoipprocessor/internal/provider"
+)
+
+// unknown is the value of the fields whose value is unknown, e.g. for the private IP addresses.
+const unknown = "-"
+
+type ip2LocationProvider struct {
+	database *provider.Database[*database]
+}
+
+var _ provider.GeoIPProvider = (*ip2LocationProvider)(nil)
+
+// NewProvider loads the database of the configuration, and reloads it when the file changes.
+func NewProvider(cfg *Config, logger *zap.Logger) (provider.GeoIPProvider, error) {
+	db, err := provider.NewDatabase(cfg.DatabasePath, load, logger)
+	if err != nil {
+		return nil, fmt.Errorf("failed to open the IP2Location database: %w", err)
+	}
+	return &ip2LocationProvider{database: db}, nil
+}
+
+func load(path string) (*database, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	return parseDatabase(data)
+}
+
+func (p *ip2LocationProvider) Location(_ context.Context, ip net.IP) (attribute.Set, error) {
+	rec, found, err := p.database.Get().lookup(ip)
+	if err != nil || !found {
+		return attribute.Set{}, err
+	}
+
+	var attributes []attribute.KeyValue
+	putString := func(key, value string) {
+		if value != "" && value != unknown {
+			attributes = append(attributes, attribute.String(key, value))
+		}
+	}
+	putString(convention.AttributeGeoCityName, rec.city)
+	putString(convention.AttributeGeoPostalCode, rec.zipCode)
+	putString(convention.AttributeGeoCountryName, rec.countryName)
+	putString(convention.AttributeGeoCountryIsoCode, rec.countryCode)
+	putString(convention.AttributeGeoRegionName, rec.region)
+	// the unknown locations are at 0, 0
+	if rec.hasLocation && (rec.latitude != 0 || rec.longitude != 0) {
+		attributes = append(attributes,
+			attribute.Float64(convention.AttributeGeoLocationLat, toFloat64(rec.latitude)),
+			attribute.Float64(convention.AttributeGeoLocationLon, toFloat64(rec.longitude)))
+	}
+	return attribute.NewSet(attributes...), nil
+}
+
+// toFloat64 converts the coordinate without the precision artifacts of the conversion, e.g. 41.3888 rather than
+// 41.38880157470703.
+func toFloat64(f float32) float64 {
+	value, _ := strconv.ParseFloat(strconv.FormatFloat(float64(f), 'f', -1, 32), 64)
+	return value
+}
+
+func (p *ip2LocationProvider) Close(context.Context) error {
+	return p.database.Close()
+}
@@ -0,0 +1,207 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package ip2locationprovider
+
+import (
+	"bytes"
+	"context"
+	"encoding/binary"
+	"math"
+	"math/big"
+	"net"
+	"os"
+	"path/filepath"
+	"slices"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/otel/attribute"
+	"go.uber.org/zap"
+)
+
+// testRange is a range of IP addresses of a test database of type 5: country, region, city, latitude and longitude.
+type testRange struct {
+	from        string
+	countryCode string
+	countryName string
+	region      string
+	city        string
+	latitude    float32
+	longitude   float32
+}
+
+// writeTestDatabase writes a database of type 5 in the BIN format, with the IPv4 and IPv6 ranges, the last range of
+// each table ending at the last address.
+func writeTestDatabase(t *testing.T, path string, ipv4, ipv6 []testRange) {
+	const dbType, columns = 5, 6
+	ipv4RowSize, ipv6RowSize := 4+(columns-1)*4, 16+(columns-1)*4
+	// each table ends with a row starting at the last address
+	ipv4Addr := headerSize + 1
+	ipv6Addr := ipv4Addr + (len(ipv4)+1)*ipv4RowSize
+	stringsOffset := ipv6Addr - 1 + (len(ipv6)+1)*ipv6RowSize
+
+	var rows, strs bytes.Buffer
+	putString := func(s string) uint32 {
+		offset := uint32(stringsOffset + strs.Len())
+		strs.WriteByte(byte(len(s)))
+		strs.WriteString(s)
+		return offset
+	}
+	writeRows := func(ranges []testRange, size int, last []byte) {
+		for _, r := range append(ranges, testRange{from: net.IP(last).String()}) {
+			ip := net.ParseIP(r.from)
+			if size == net.IPv4len {
+				ip = ip.To4()
+			}
+			number := make([]byte, size)
+			copy(number, ip)
+			slices.Reverse(number)
+			rows.Write(number)
+
+			// the country code takes 2 bytes, followed by the name
+			country := putString(r.countryCode)
+			strs.Write(make([]byte, 2-len(r.countryCode)))
+			putString(r.countryName)
+			for _, value := range []uint32{
+				country,
+				putString(r.region),
+				putString(r.city),
+				math.Float32bits(r.latitude),
+				math.Float32bits(r.longitude),
+			} {
+				require.NoError(t, binary.Write(&rows, binary.LittleEndian, value))
+			}
+		}
+	}
+
+	header := make([]byte, headerSize)
+	header[0], header[1], header[2], header[29] = dbType, columns, 24, productCode
+	binary.LittleEndian.PutUint32(header[5:], uint32(len(ipv4)+1))
+	binary.LittleEndian.PutUint32(header[9:], uint32(ipv4Addr))
+	binary.LittleEndian.PutUint32(header[13:], uint32(len(ipv6)+1))
+	binary.LittleEndian.PutUint32(header[17:], uint32(ipv6Addr))
+	writeRows(ipv4, net.IPv4len, net.IPv4bcast.To4())
+	writeRows(ipv6, net.IPv6len, new(big.Int).Sub(new(big.Int).Lsh(big.NewInt(1), 128), big.NewInt(1)).Bytes())
+
+	require.NoError(t, os.WriteFile(path, append(append(header, rows.Bytes()...), strs.Bytes()...), 0600))
+}
+
+func TestLocation(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "IP2LOCATION-LITE-DB5.BIN")
+	writeTestDatabase(t, path,
+		[]testRange{
+			{from: "0.0.0.0", countryCode: "-", countryName: "-", region: "-", city: "-"},
+			{from: "1.2.3.0", countryCode: "ES", countryName: "Spain", region: "Catalonia", city: "Barcelona", latitude: 41.3888, longitude: 2.159},
+			{from: "1.2.4.0", countryCode: "-", countryName: "-", region: "-", city: "-"},
+			{from: "89.160.20.0", countryCode: "SE", countryName: "Sweden", region: "Ostergotlands lan", city: "Linkoping", latitude: 58.4167, longitude: 15.6167},
+			{from: "89.160.21.0", countryCode: "-", countryName: "-", region: "-", city: "-"},
+		},
+		[]testRange{
+			{from: "::", countryCode: "-", countryName: "-", region: "-", city: "-"},
+			{from: "2001:db8::", countryCode: "FR", countryName: "France", region: "Ile-de-France", city: "Paris", latitude: 48.8534, longitude: 2.3488},
+			{from: "2001:db9::", countryCode: "-", countryName: "-", region: "-", city: "-"},
+		})
+
+	p, err := NewProvider(&Config{DatabasePath: path}, zap.NewNop())
+	require.NoError(t, err)
+	defer func() { assert.NoError(t, p.Close(context.Background())) }()
+
+	tests := []struct {
+		ip       string
+		expected attribute.Set
+	}{
+		{
+			ip: "1.2.3.4",
+			expected: attribute.NewSet(
+				attribute.String("geo.city_name", "Barcelona"),
+				attribute.String("geo.country_name", "Spain"),
+				attribute.String("geo.country_iso_code", "ES"),
+				attribute.String("geo.region_name", "Catalonia"),
+				attribute.Float64("geo.location.lat", 41.3888),
+				attribute.Float64("geo.location.lon", 2.159)),
+		},
+		{
+			ip: "89.160.20.255",
+			expected: attribute.NewSet(
+				attribute.String("geo.city_name", "Linkoping"),
+				attribute.String("geo.country_name", "Sweden"),
+				attribute.String("geo.country_iso_code", "SE"),
+				attribute.String("geo.region_name", "Ostergotlands lan"),
+				attribute.Float64("geo.location.lat", 58.4167),
+				attribute.Float64("geo.location.lon", 15.6167)),
+		},
+		{
+			// the IPv4-mapped addresses are looked up in the IPv4 ranges
+			ip: "::ffff:1.2.3.4",
+			expected: attribute.NewSet(
+				attribute.String("geo.city_name", "Barcelona"),
+				attribute.String("geo.country_name", "Spain"),
+				attribute.String("geo.country_iso_code", "ES"),
+				attribute.String("geo.region_name", "Catalonia"),
+				attribute.Float64("geo.location.lat", 41.3888),
+				attribute.Float64("geo.location.lon", 2.159)),
+		},
+		{
+			ip: "2001:db8::1",
+			expected: attribute.NewSet(
+				attribute.String("geo.city_name", "Paris"),
+				attribute.String("geo.country_name", "France"),
+				attribute.String("geo.country_iso_code", "FR"),
+				attribute.String("geo.region_name", "Ile-de-France"),
+				attribute.Float64("geo.location.lat", 48.8534),
+				attribute.Float64("geo.location.lon", 2.3488)),
+		},
+		{
+			// the unknown values are omitted
+			ip:       "10.0.0.1",
+			expected: attribute.NewSet(),
+		},
+		{
+			ip:       "255.255.255.255",
+			expected: attribute.NewSet(),
+		},
+		{
+			ip:       "0.0.0.0",
+			expected: attribute.NewSet(),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.ip, func(t *testing.T) {
+			actual, err := p.Location(context.Background(), net.ParseIP(tt.ip))
+			require.NoError(t, err)
+			assert.Equal(t, tt.expected.ToSlice(), actual.ToSlice())
+		})
+	}
+}
+
+func TestNewProviderErrors(t *testing.T) {
+	dir := t.TempDir()
+	_, err := NewProvider(&Config{DatabasePath: filepath.Join(dir, "missing.BIN")}, zap.NewNop())
+	assert.ErrorIs(t, err, os.ErrNotExist)
+
+	path := filepath.Join(dir, "short.BIN")
+	require.NoError(t, os.WriteFile(path, []byte("not a database"), 0600))
+	_, err = NewProvider(&Config{DatabasePath: path}, zap.NewNop())
+	assert.EqualError(t, err, "failed to open the IP2Location database: the file is too short to be an IP2Location BIN database")
+
+	path = filepath.Join(dir, "proxy.BIN")
+	header := make([]byte, headerSize)
+	header[0], header[1], header[2], header[29] = 1, 2, 24, 2
+	require.NoError(t, os.WriteFile(path, header, 0600))
+	_, err = NewProvider(&Config{DatabasePath: path}, zap.NewNop())
+	assert.EqualError(t, err, "failed to open the IP2Location database: the file isn't an IP2Location BIN database")
+}
+
+func TestLookupCorrupted(t *testing.T) {
+	header := make([]byte, headerSize)
+	header[0], header[1], header[2], header[29] = 5, 6, 24, productCode
+	// the table is out of the file
+	binary.LittleEndian.PutUint32(header[5:], 10)
+	binary.LittleEndian.PutUint32(header[9:], 1000)
+	db, err := parseDatabase(header)
+	require.NoError(t, err)
+	_, _, err = db.lookup(net.ParseIP("1.2.3.4"))
+	assert.ErrorIs(t, err, errCorrupted)
+}
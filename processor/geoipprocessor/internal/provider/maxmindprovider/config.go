@@ -0,0 +1,20 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package maxmindprovider // import "github.com/open-telemetry/opentelemetry-collector-contrib/processor/geoipprocessor/internal/provider/maxmindprovider"
+
+import "errors"
+
+// Config defines the configuration of the MaxMind provider.
+type Config struct {
+	// DatabasePath is the path of the GeoIP2 or GeoLite2 City or Country database file, in the MaxMind DB format.
+	DatabasePath string `mapstructure:"database_path"`
+}
+
+// Validate checks if the provider configuration is valid.
+func (cfg *Config) Validate() error {
+	if cfg.DatabasePath == "" {
+		return errors.New("a database_path must be provided for the maxmind provider")
+	}
+	return nil
+}
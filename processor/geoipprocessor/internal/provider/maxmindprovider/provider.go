@@ -0,0 +1,91 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+// Package maxmindprovider implements a geo location provider reading the GeoIP2 and GeoLite2 databases of MaxMind.
+package maxmindprovider // import "github.com/open-telemetry/opentelemetry-collector-contrib/processor/geoipprocessor/internal/provider/maxmindprovider"
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"os"
+
+	"github.com/oschwald/geoip2-golang"
+	"go.opentelemetry.io/otel/attribute"
+	"go.uber.org/zap"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/processor/geoipprocessor/internal/convention"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/processor/geoipprocessor/internal/provider"
+)
+
+// language is the language of the names of the locations.
+const language = "en"
+
+type maxMindProvider struct {
+	database *provider.Database[*geoip2.Reader]
+}
+
+var _ provider.GeoIPProvider = (*maxMindProvider)(nil)
+
+// NewProvider loads the database of the configuration, and reloads it when the file changes.
+func NewProvider(cfg *Config, logger *zap.Logger) (provider.GeoIPProvider, error) {
+	database, err := provider.NewDatabase(cfg.DatabasePath, load, logger)
+	if err != nil {
+		return nil, fmt.Errorf("failed to open the MaxMind database: %w", err)
+	}
+	return &maxMindProvider{database: database}, nil
+}
+
+// load reads the database in memory, the file being replaced when the database is updated.
+func load(path string) (*geoip2.Reader, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	reader, err := geoip2.FromBytes(data)
+	if err != nil {
+		return nil, err
+	}
+	// fail early when the database doesn't contain the locations, e.g. an ASN database
+	if _, err = reader.City(net.IPv4zero); err != nil {
+		return nil, err
+	}
+	return reader, nil
+}
+
+func (p *maxMindProvider) Location(_ context.Context, ip net.IP) (attribute.Set, error) {
+	city, err := p.database.Get().City(ip)
+	if err != nil {
+		return attribute.Set{}, err
+	}
+
+	var attributes []attribute.KeyValue
+	putString := func(key, value string) {
+		if value != "" {
+			attributes = append(attributes, attribute.String(key, value))
+		}
+	}
+	putString(convention.AttributeGeoCityName, city.City.Names[language])
+	putString(convention.AttributeGeoPostalCode, city.Postal.Code)
+	putString(convention.AttributeGeoCountryName, city.Country.Names[language])
+	putString(convention.AttributeGeoCountryIsoCode, city.Country.IsoCode)
+	putString(convention.AttributeGeoContinentName, city.Continent.Names[language])
+	putString(convention.AttributeGeoContinentCode, city.Continent.Code)
+	if len(city.Subdivisions) > 0 {
+		// the first subdivision is the largest one, e.g. the state rather than the county
+		putString(convention.AttributeGeoRegionName, city.Subdivisions[0].Names[language])
+		putString(convention.AttributeGeoRegionIsoCode, city.Subdivisions[0].IsoCode)
+	}
+	putString(convention.AttributeGeoTimezone, city.Location.TimeZone)
+	// the Country databases don't contain the coordinates
+	if city.Location.Latitude != 0 || city.Location.Longitude != 0 {
+		attributes = append(attributes,
+			attribute.Float64(convention.AttributeGeoLocationLat, city.Location.Latitude),
+			attribute.Float64(convention.AttributeGeoLocationLon, city.Location.Longitude))
+	}
+	return attribute.NewSet(attributes...), nil
+}
+
+func (p *maxMindProvider) Close(context.Context) error {
+	return p.database.Close()
+}
@@ -0,0 +1,148 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package maxmindprovider
+
+import (
+	"context"
+	"net"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/maxmind/mmdbwriter"
+	"github.com/maxmind/mmdbwriter/mmdbtype"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/otel/attribute"
+	"go.uber.org/zap"
+)
+
+// writeTestDatabase writes a GeoLite2-City database with the locations of the networks.
+func writeTestDatabase(t *testing.T, path string, locations map[string]mmdbtype.Map) {
+	writer, err := mmdbwriter.New(mmdbwriter.Options{DatabaseType: "GeoLite2-City", RecordSize: 24})
+	require.NoError(t, err)
+	for network, location := range locations {
+		_, ipNet, err := net.ParseCIDR(network)
+		require.NoError(t, err)
+		require.NoError(t, writer.Insert(ipNet, location))
+	}
+
+	// the database is replaced by renaming a new file, as the MaxMind updaters do
+	tmp := path + ".tmp"
+	f, err := os.Create(tmp)
+	require.NoError(t, err)
+	_, err = writer.WriteTo(f)
+	require.NoError(t, err)
+	require.NoError(t, f.Close())
+	require.NoError(t, os.Rename(tmp, path))
+}
+
+func names(name string) mmdbtype.Map {
+	return mmdbtype.Map{"names": mmdbtype.Map{"en": mmdbtype.String(name)}}
+}
+
+var linkoping = mmdbtype.Map{
+	"city":      names("Linköping"),
+	"continent": mmdbtype.Map{"code": mmdbtype.String("EU"), "names": mmdbtype.Map{"en": mmdbtype.String("Europe")}},
+	"country":   mmdbtype.Map{"iso_code": mmdbtype.String("SE"), "names": mmdbtype.Map{"en": mmdbtype.String("Sweden")}},
+	"location": mmdbtype.Map{
+		"latitude":  mmdbtype.Float64(58.4167),
+		"longitude": mmdbtype.Float64(15.6167),
+		"time_zone": mmdbtype.String("Europe/Stockholm"),
+	},
+	"postal": mmdbtype.Map{"code": mmdbtype.String("58")},
+	"subdivisions": mmdbtype.Slice{
+		mmdbtype.Map{"iso_code": mmdbtype.String("E"), "names": mmdbtype.Map{"en": mmdbtype.String("Östergötland County")}},
+	},
+}
+
+var spain = mmdbtype.Map{
+	"continent": mmdbtype.Map{"code": mmdbtype.String("EU"), "names": mmdbtype.Map{"en": mmdbtype.String("Europe")}},
+	"country":   mmdbtype.Map{"iso_code": mmdbtype.String("ES"), "names": mmdbtype.Map{"en": mmdbtype.String("Spain")}},
+}
+
+func TestLocation(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "GeoLite2-City.mmdb")
+	writeTestDatabase(t, path, map[string]mmdbtype.Map{
+		"89.160.20.0/24": linkoping,
+		"1.2.3.0/24":     spain,
+	})
+
+	p, err := NewProvider(&Config{DatabasePath: path}, zap.NewNop())
+	require.NoError(t, err)
+	defer func() { assert.NoError(t, p.Close(context.Background())) }()
+
+	tests := []struct {
+		ip       string
+		expected attribute.Set
+	}{
+		{
+			ip: "89.160.20.128",
+			expected: attribute.NewSet(
+				attribute.String("geo.city_name", "Linköping"),
+				attribute.String("geo.postal_code", "58"),
+				attribute.String("geo.country_name", "Sweden"),
+				attribute.String("geo.country_iso_code", "SE"),
+				attribute.String("geo.continent_name", "Europe"),
+				attribute.String("geo.continent_code", "EU"),
+				attribute.String("geo.region_name", "Östergötland County"),
+				attribute.String("geo.region_iso_code", "E"),
+				attribute.String("geo.timezone", "Europe/Stockholm"),
+				attribute.Float64("geo.location.lat", 58.4167),
+				attribute.Float64("geo.location.lon", 15.6167)),
+		},
+		{
+			// the missing fields are omitted
+			ip: "1.2.3.4",
+			expected: attribute.NewSet(
+				attribute.String("geo.country_name", "Spain"),
+				attribute.String("geo.country_iso_code", "ES"),
+				attribute.String("geo.continent_name", "Europe"),
+				attribute.String("geo.continent_code", "EU")),
+		},
+		{
+			ip:       "10.0.0.1",
+			expected: attribute.NewSet(),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.ip, func(t *testing.T) {
+			actual, err := p.Location(context.Background(), net.ParseIP(tt.ip))
+			require.NoError(t, err)
+			assert.Equal(t, tt.expected.ToSlice(), actual.ToSlice())
+		})
+	}
+}
+
+func TestLocationReload(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "GeoLite2-City.mmdb")
+	writeTestDatabase(t, path, map[string]mmdbtype.Map{"1.2.3.0/24": spain})
+
+	p, err := NewProvider(&Config{DatabasePath: path}, zap.NewNop())
+	require.NoError(t, err)
+	defer func() { assert.NoError(t, p.Close(context.Background())) }()
+
+	countryName := func() string {
+		location, err := p.Location(context.Background(), net.ParseIP("89.160.20.128"))
+		require.NoError(t, err)
+		value, _ := location.Value("geo.country_name")
+		return value.AsString()
+	}
+	assert.Equal(t, "", countryName())
+
+	writeTestDatabase(t, path, map[string]mmdbtype.Map{"1.2.3.0/24": spain, "89.160.20.0/24": linkoping})
+	assert.Eventually(t, func() bool { return countryName() == "Sweden" }, 5*time.Second, 10*time.Millisecond)
+}
+
+func TestNewProviderErrors(t *testing.T) {
+	dir := t.TempDir()
+	_, err := NewProvider(&Config{DatabasePath: filepath.Join(dir, "missing.mmdb")}, zap.NewNop())
+	assert.ErrorIs(t, err, os.ErrNotExist)
+
+	path := filepath.Join(dir, "invalid.mmdb")
+	require.NoError(t, os.WriteFile(path, []byte("not a database"), 0600))
+	_, err = NewProvider(&Config{DatabasePath: path}, zap.NewNop())
+	assert.ErrorContains(t, err, "failed to open the MaxMind database: ")
+}
@@ -5,6 +5,8 @@ package groupbytraceprocessor // import "github.com/open-telemetry/opentelemetry
 
 import (
 	"time"
+
+	"go.opentelemetry.io/collector/component"
 )
 
 // Config is the configuration for the processor.
@@ -33,4 +35,10 @@ type Config struct {
 	// Default: false.
 	// Not yet implemented, and an error will be returned when this option is used.
 	StoreOnDisk bool `mapstructure:"store_on_disk"`
+
+	// StorageID is the optional storage extension where the traces are kept while waiting for the duration,
+	// instead of being kept in memory. The traces waiting when the processor is shut down are restored on the
+	// next start, and released once the duration elapsed again.
+	// Default: none, the traces are kept in memory.
+	StorageID *component.ID `mapstructure:"storage"`
 }
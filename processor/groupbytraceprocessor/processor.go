@@ -83,20 +83,53 @@ func (sp *groupByTraceProcessor) Capabilities() consumer.Capabilities {
 }
 
 // Start is invoked during service startup.
-func (sp *groupByTraceProcessor) Start(context.Context, component.Host) error {
+func (sp *groupByTraceProcessor) Start(ctx context.Context, host component.Host) error {
 	// start these metrics, as it might take a while for them to receive their first event
 	stats.Record(context.Background(), mTracesEvicted.M(0))
 	stats.Record(context.Background(), mIncompleteReleases.M(0))
 	stats.Record(context.Background(), mNumTracesConf.M(int64(sp.config.NumTraces)))
 
 	sp.eventMachine.startInBackground()
-	return sp.st.start()
+	if err := sp.st.start(ctx, host); err != nil {
+		return err
+	}
+
+	if rst, ok := sp.st.(restorableStorage); ok {
+		sp.restoreTraces(rst.restored())
+	}
+	return nil
+}
+
+// restoreTraces hands the traces kept by the storage during a restart back to the event machine, so that they
+// are released once the wait duration elapsed again.
+func (sp *groupByTraceProcessor) restoreTraces(traceIDs []pcommon.TraceID) {
+	for _, traceID := range traceIDs {
+		rss, err := sp.st.delete(traceID)
+		if err != nil {
+			sp.logger.Warn("couldn't restore trace from the storage", zap.Stringer("traceID", traceID), zap.Error(err))
+			continue
+		}
+		if rss == nil {
+			continue
+		}
+
+		trace := ptrace.NewTraces()
+		for _, rs := range rss {
+			rs.MoveTo(trace.ResourceSpans().AppendEmpty())
+		}
+		if err = sp.eventMachine.consume(trace); err != nil {
+			sp.logger.Warn("couldn't restore trace from the storage", zap.Stringer("traceID", traceID), zap.Error(err))
+		}
+	}
+	if len(traceIDs) > 0 {
+		sp.logger.Info("restored traces from the storage", zap.Int("traces", len(traceIDs)))
+	}
 }
 
 // Shutdown is invoked during service shutdown.
-func (sp *groupByTraceProcessor) Shutdown(_ context.Context) error {
+func (sp *groupByTraceProcessor) Shutdown(ctx context.Context) error {
 	sp.eventMachine.shutdown()
-	return sp.st.shutdown()
+	return sp.st.shutdown(ctx)
 }
 
 func (sp *groupByTraceProcessor) onTraceReceived(trace tracesWithID, worker *eventMachineWorker) error {
@@ -0,0 +1,193 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package groupbytraceprocessor // import "github.com/open-telemetry/opentelemetry-collector-contrib/processor/groupbytraceprocessor"
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+
+	"go.opentelemetry.io/collector/component"
+	experimentalstorage "go.opentelemetry.io/collector/extension/experimental/storage"
+	"go.opentelemetry.io/collector/pdata/pcommon"
+	"go.opentelemetry.io/collector/pdata/ptrace"
+)
+
+// extensionStorageIndexKey is the key of the index of the stored traces, saved on shutdown.
+const extensionStorageIndexKey = "trace_ids"
+
+var (
+	extensionStorageMarshaler   ptrace.ProtoMarshaler
+	extensionStorageUnmarshaler ptrace.ProtoUnmarshaler
+)
+
+// extensionStorage keeps the traces in a storage extension, so that they survive a restart of the collector.
+// Only the IDs of the stored traces are kept in memory, and saved to the storage extension on shutdown.
+type extensionStorage struct {
+	storageID   component.ID
+	componentID component.ID
+	client      experimentalstorage.Client
+
+	sync.Mutex
+	traceIDs map[pcommon.TraceID]struct{}
+	// restoredIDs are the IDs of the traces stored before the last shutdown
+	restoredIDs []pcommon.TraceID
+}
+
+var (
+	_ storage           = (*extensionStorage)(nil)
+	_ restorableStorage = (*extensionStorage)(nil)
+)
+
+func newExtensionStorage(storageID component.ID, componentID component.ID) *extensionStorage {
+	return &extensionStorage{
+		storageID:   storageID,
+		componentID: componentID,
+		traceIDs:    make(map[pcommon.TraceID]struct{}),
+	}
+}
+
+func extensionStorageKey(traceID pcommon.TraceID) string {
+	return "trace_" + traceID.String()
+}
+
+func (st *extensionStorage) createOrAppend(traceID pcommon.TraceID, td ptrace.Traces) error {
+	st.Lock()
+	defer st.Unlock()
+
+	ctx := context.Background()
+	key := extensionStorageKey(traceID)
+	if _, ok := st.traceIDs[traceID]; ok {
+		stored, err := st.load(ctx, key)
+		if err != nil {
+			return err
+		}
+		td.ResourceSpans().MoveAndAppendTo(stored.ResourceSpans())
+		td = stored
+	}
+
+	buf, err := extensionStorageMarshaler.MarshalTraces(td)
+	if err != nil {
+		return err
+	}
+	if err = st.client.Set(ctx, key, buf); err != nil {
+		return err
+	}
+	st.traceIDs[traceID] = struct{}{}
+	return nil
+}
+
+func (st *extensionStorage) get(traceID pcommon.TraceID) ([]ptrace.ResourceSpans, error) {
+	st.Lock()
+	defer st.Unlock()
+
+	if _, ok := st.traceIDs[traceID]; !ok {
+		return nil, nil
+	}
+	td, err := st.load(context.Background(), extensionStorageKey(traceID))
+	if err != nil {
+		return nil, err
+	}
+	return resourceSpansOf(td), nil
+}
+
+func (st *extensionStorage) delete(traceID pcommon.TraceID) ([]ptrace.ResourceSpans, error) {
+	st.Lock()
+	defer st.Unlock()
+
+	if _, ok := st.traceIDs[traceID]; !ok {
+		return nil, nil
+	}
+	ctx := context.Background()
+	key := extensionStorageKey(traceID)
+	td, err := st.load(ctx, key)
+	if err != nil {
+		return nil, err
+	}
+	if err = st.client.Delete(ctx, key); err != nil {
+		return nil, err
+	}
+	delete(st.traceIDs, traceID)
+	return resourceSpansOf(td), nil
+}
+
+// load returns the trace stored under the given key.
+func (st *extensionStorage) load(ctx context.Context, key string) (ptrace.Traces, error) {
+	buf, err := st.client.Get(ctx, key)
+	if err != nil {
+		return ptrace.Traces{}, err
+	}
+	if buf == nil {
+		return ptrace.Traces{}, fmt.Errorf("%q not found in the storage extension", key)
+	}
+	return extensionStorageUnmarshaler.UnmarshalTraces(buf)
+}
+
+func (st *extensionStorage) start(ctx context.Context, host component.Host) error {
+	ext, ok := host.GetExtensions()[st.storageID]
+	if !ok {
+		return fmt.Errorf("storage extension '%s' not found", st.storageID)
+	}
+	storageExt, ok := ext.(experimentalstorage.Extension)
+	if !ok {
+		return fmt.Errorf("non-storage extension '%s' found", st.storageID)
+	}
+	client, err := storageExt.GetClient(ctx, component.KindProcessor, st.componentID, "")
+	if err != nil {
+		return fmt.Errorf("failed to get the storage client: %w", err)
+	}
+
+	buf, err := client.Get(ctx, extensionStorageIndexKey)
+	if err != nil {
+		return errors.Join(fmt.Errorf("failed to read the index of the stored traces: %w", err), client.Close(ctx))
+	}
+	if len(buf)%16 != 0 {
+		return errors.Join(errors.New("corrupted index of the stored traces"), client.Close(ctx))
+	}
+
+	st.Lock()
+	defer st.Unlock()
+	st.client = client
+	for ; len(buf) > 0; buf = buf[16:] {
+		traceID := pcommon.TraceID(buf[:16])
+		st.traceIDs[traceID] = struct{}{}
+		st.restoredIDs = append(st.restoredIDs, traceID)
+	}
+	return nil
+}
+
+func (st *extensionStorage) restored() []pcommon.TraceID {
+	st.Lock()
+	defer st.Unlock()
+	return st.restoredIDs
+}
+
+// shutdown saves the index of the stored traces, for them to be restored on the next start, and closes the
+// storage client.
+func (st *extensionStorage) shutdown(ctx context.Context) error {
+	st.Lock()
+	defer st.Unlock()
+
+	if st.client == nil {
+		return nil
+	}
+	buf := make([]byte, 0, len(st.traceIDs)*16)
+	for traceID := range st.traceIDs {
+		buf = append(buf, traceID[:]...)
+	}
+	var errs error
+	if err := st.client.Set(ctx, extensionStorageIndexKey, buf); err != nil {
+		errs = fmt.Errorf("failed to save the index of the stored traces: %w", err)
+	}
+	return errors.Join(errs, st.client.Close(ctx))
+}
+
+func resourceSpansOf(td ptrace.Traces) []ptrace.ResourceSpans {
+	result := make([]ptrace.ResourceSpans, td.ResourceSpans().Len())
+	for i := range result {
+		result[i] = td.ResourceSpans().At(i)
+	}
+	return result
+}
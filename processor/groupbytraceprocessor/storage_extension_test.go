@@ -0,0 +1,99 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package groupbytraceprocessor
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/collector/component"
+	"go.opentelemetry.io/collector/consumer/consumertest"
+	"go.opentelemetry.io/collector/pdata/pcommon"
+	"go.opentelemetry.io/collector/processor/processortest"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/extension/storage/storagetest"
+)
+
+func TestExtensionCreateAppendAndDeleteTrace(t *testing.T) {
+	// prepare
+	ctx := context.Background()
+	host := storagetest.NewStorageHost().WithInMemoryStorageExtension("test")
+	st := newExtensionStorage(storagetest.NewStorageID("test"), component.MustNewID("groupbytrace"))
+	require.NoError(t, st.start(ctx, host))
+	defer func() {
+		assert.NoError(t, st.shutdown(ctx))
+	}()
+
+	traceID := pcommon.TraceID([16]byte{1, 2, 3, 4})
+	first := simpleTracesWithID(traceID)
+	second := simpleTracesWithID(traceID)
+	second.ResourceSpans().At(0).Resource().Attributes().PutStr("service.name", "second")
+
+	// test
+	assert.NoError(t, st.createOrAppend(traceID, first))
+	assert.NoError(t, st.createOrAppend(traceID, second))
+
+	// verify
+	retrieved, err := st.get(traceID)
+	require.NoError(t, err)
+	require.Len(t, retrieved, 2)
+	assert.Equal(t, "second", retrieved[1].Resource().Attributes().AsRaw()["service.name"])
+
+	deleted, err := st.delete(traceID)
+	require.NoError(t, err)
+	assert.Len(t, deleted, 2)
+
+	retrieved, err = st.get(traceID)
+	require.NoError(t, err)
+	assert.Nil(t, retrieved)
+
+	deleted, err = st.delete(traceID)
+	require.NoError(t, err)
+	assert.Nil(t, deleted)
+}
+
+func TestExtensionStartErrors(t *testing.T) {
+	host := storagetest.NewStorageHost().WithNonStorageExtension("other")
+
+	st := newExtensionStorage(storagetest.NewStorageID("missing"), component.MustNewID("groupbytrace"))
+	assert.EqualError(t, st.start(context.Background(), host), "storage extension 'test_storage/missing' not found")
+
+	st = newExtensionStorage(storagetest.NewNonStorageID("other"), component.MustNewID("groupbytrace"))
+	assert.EqualError(t, st.start(context.Background(), host), "non-storage extension 'non_storage/other' found")
+	assert.NoError(t, st.shutdown(context.Background()))
+}
+
+func TestTracesSurviveRestart(t *testing.T) {
+	ext := storagetest.NewFileBackedStorageExtension("test", t.TempDir())
+	host := storagetest.NewStorageHost().WithExtension(ext.ID, ext)
+	cfg := createDefaultConfig().(*Config)
+	cfg.WaitDuration = time.Hour
+	cfg.StorageID = &ext.ID
+
+	// the storage client is identified by the processor ID, which has to be the same after the restart
+	set := processortest.NewNopCreateSettings()
+	next := new(consumertest.TracesSink)
+	p, err := createTracesProcessor(context.Background(), set, cfg, next)
+	require.NoError(t, err)
+	require.NoError(t, p.Start(context.Background(), host))
+	require.NoError(t, p.ConsumeTraces(context.Background(), simpleTraces()))
+	require.NoError(t, p.Shutdown(context.Background()))
+	assert.Empty(t, next.AllTraces())
+
+	cfg.WaitDuration = time.Millisecond
+	p, err = createTracesProcessor(context.Background(), set, cfg, next)
+	require.NoError(t, err)
+	require.NoError(t, p.Start(context.Background(), host))
+	defer func() {
+		assert.NoError(t, p.Shutdown(context.Background()))
+	}()
+
+	assert.Eventually(t, func() bool {
+		return next.SpanCount() == 1
+	}, time.Second, 10*time.Millisecond)
+	assert.Equal(t, simpleTraces(), next.AllTraces()[0])
+}
@@ -0,0 +1,173 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package intervalprocessor // import "github.com/open-telemetry/opentelemetry-collector-contrib/processor/intervalprocessor"
+
+import (
+	"math"
+	"slices"
+
+	"go.opentelemetry.io/collector/pdata/pmetric"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/processor/intervalprocessor/internal/metrics"
+)
+
+// aggregateFunc aggregates a data point into the existing data point of its series. It returns false when they can't
+// be aggregated, e.g. histograms with different bucket boundaries, the data point being passed through then.
+type aggregateFunc[DP metrics.DataPoint[DP]] func(existing, dp DP) bool
+
+// last keeps the newest data point.
+func last[DP metrics.DataPoint[DP]](existing, dp DP) bool {
+	if dp.Timestamp() > existing.Timestamp() {
+		dp.CopyTo(existing)
+	}
+	return true
+}
+
+func numberAggregation(function AggregationFunction) aggregateFunc[pmetric.NumberDataPoint] {
+	switch function {
+	case AggregationSum:
+		return sumNumbers
+	case AggregationMin:
+		return func(existing, dp pmetric.NumberDataPoint) bool {
+			return keepNumber(existing, dp, func(value, current float64) bool { return value < current })
+		}
+	case AggregationMax:
+		return func(existing, dp pmetric.NumberDataPoint) bool {
+			return keepNumber(existing, dp, func(value, current float64) bool { return value > current })
+		}
+	default:
+		return last[pmetric.NumberDataPoint]
+	}
+}
+
+func histogramAggregation(function AggregationFunction) aggregateFunc[pmetric.HistogramDataPoint] {
+	if function == AggregationSum {
+		return sumHistograms
+	}
+	return last[pmetric.HistogramDataPoint]
+}
+
+func expHistogramAggregation(function AggregationFunction) aggregateFunc[pmetric.ExponentialHistogramDataPoint] {
+	if function == AggregationSum {
+		return sumExpHistograms
+	}
+	return last[pmetric.ExponentialHistogramDataPoint]
+}
+
+func sumNumbers(existing, dp pmetric.NumberDataPoint) bool {
+	if existing.ValueType() == pmetric.NumberDataPointValueTypeInt && dp.ValueType() == pmetric.NumberDataPointValueTypeInt {
+		existing.SetIntValue(existing.IntValue() + dp.IntValue())
+	} else {
+		existing.SetDoubleValue(numberValue(existing) + numberValue(dp))
+	}
+	widenInterval(existing, dp)
+	return true
+}
+
+// keepNumber replaces the existing data point by the data point when its value is preferred, covering the time
+// intervals of both of them.
+func keepNumber(existing, dp pmetric.NumberDataPoint, preferred func(value, current float64) bool) bool {
+	if preferred(numberValue(dp), numberValue(existing)) {
+		start, timestamp := existing.StartTimestamp(), existing.Timestamp()
+		dp.CopyTo(existing)
+		existing.SetStartTimestamp(start)
+		existing.SetTimestamp(timestamp)
+	}
+	widenInterval(existing, dp)
+	return true
+}
+
+func numberValue(dp pmetric.NumberDataPoint) float64 {
+	if dp.ValueType() == pmetric.NumberDataPointValueTypeInt {
+		return float64(dp.IntValue())
+	}
+	return dp.DoubleValue()
+}
+
+func sumHistograms(existing, dp pmetric.HistogramDataPoint) bool {
+	if !slices.Equal(existing.ExplicitBounds().AsRaw(), dp.ExplicitBounds().AsRaw()) ||
+		existing.BucketCounts().Len() != dp.BucketCounts().Len() {
+		return false
+	}
+	for i := 0; i < dp.BucketCounts().Len(); i++ {
+		existing.BucketCounts().SetAt(i, existing.BucketCounts().At(i)+dp.BucketCounts().At(i))
+	}
+	existing.SetCount(existing.Count() + dp.Count())
+	if existing.HasSum() && dp.HasSum() {
+		existing.SetSum(existing.Sum() + dp.Sum())
+	} else {
+		existing.RemoveSum()
+	}
+	if existing.HasMin() && dp.HasMin() {
+		existing.SetMin(math.Min(existing.Min(), dp.Min()))
+	} else {
+		existing.RemoveMin()
+	}
+	if existing.HasMax() && dp.HasMax() {
+		existing.SetMax(math.Max(existing.Max(), dp.Max()))
+	} else {
+		existing.RemoveMax()
+	}
+	widenInterval(existing, dp)
+	return true
+}
+
+func sumExpHistograms(existing, dp pmetric.ExponentialHistogramDataPoint) bool {
+	if existing.Scale() != dp.Scale() || existing.ZeroThreshold() != dp.ZeroThreshold() {
+		return false
+	}
+	sumExpBuckets(existing.Positive(), dp.Positive())
+	sumExpBuckets(existing.Negative(), dp.Negative())
+	existing.SetZeroCount(existing.ZeroCount() + dp.ZeroCount())
+	existing.SetCount(existing.Count() + dp.Count())
+	if existing.HasSum() && dp.HasSum() {
+		existing.SetSum(existing.Sum() + dp.Sum())
+	} else {
+		existing.RemoveSum()
+	}
+	if existing.HasMin() && dp.HasMin() {
+		existing.SetMin(math.Min(existing.Min(), dp.Min()))
+	} else {
+		existing.RemoveMin()
+	}
+	if existing.HasMax() && dp.HasMax() {
+		existing.SetMax(math.Max(existing.Max(), dp.Max()))
+	} else {
+		existing.RemoveMax()
+	}
+	widenInterval(existing, dp)
+	return true
+}
+
+// sumExpBuckets adds the buckets to the existing buckets of the same scale, extending their range as needed.
+func sumExpBuckets(existing, buckets pmetric.ExponentialHistogramDataPointBuckets) {
+	if buckets.BucketCounts().Len() == 0 {
+		return
+	}
+	if existing.BucketCounts().Len() == 0 {
+		buckets.CopyTo(existing)
+		return
+	}
+	offset := min(existing.Offset(), buckets.Offset())
+	end := max(existing.Offset()+int32(existing.BucketCounts().Len()), buckets.Offset()+int32(buckets.BucketCounts().Len()))
+	counts := make([]uint64, end-offset)
+	for i, count := range existing.BucketCounts().AsRaw() {
+		counts[existing.Offset()-offset+int32(i)] += count
+	}
+	for i, count := range buckets.BucketCounts().AsRaw() {
+		counts[buckets.Offset()-offset+int32(i)] += count
+	}
+	existing.SetOffset(offset)
+	existing.BucketCounts().FromRaw(counts)
+}
+
+// widenInterval extends the time interval of the existing data point to cover the one of the data point.
+func widenInterval[DP metrics.DataPoint[DP]](existing, dp DP) {
+	if dp.StartTimestamp() < existing.StartTimestamp() {
+		existing.SetStartTimestamp(dp.StartTimestamp())
+	}
+	if dp.Timestamp() > existing.Timestamp() {
+		existing.SetTimestamp(dp.Timestamp())
+	}
+}
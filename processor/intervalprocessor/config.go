@@ -11,7 +11,23 @@ import (
 )
 
 var (
-	ErrInvalidIntervalValue = errors.New("invalid interval value")
+	ErrInvalidIntervalValue    = errors.New("invalid interval value")
+	ErrInvalidGaugeAggregation = errors.New("invalid gauge_aggregation value, must be one of last, sum, min or max")
+	ErrInvalidDeltaAggregation = errors.New("invalid delta_aggregation value, must be one of last or sum")
+)
+
+// AggregationFunction is the function aggregating the data points of a series received during an interval.
+type AggregationFunction string
+
+const (
+	// AggregationLast keeps the newest data point.
+	AggregationLast AggregationFunction = "last"
+	// AggregationSum adds up the data points.
+	AggregationSum AggregationFunction = "sum"
+	// AggregationMin keeps the data point with the lowest value.
+	AggregationMin AggregationFunction = "min"
+	// AggregationMax keeps the data point with the highest value.
+	AggregationMax AggregationFunction = "max"
 )
 
 var _ component.Config = (*Config)(nil)
@@ -20,6 +36,12 @@ var _ component.Config = (*Config)(nil)
 type Config struct {
 	// Interval is the time
 	Interval time.Duration `mapstructure:"interval"`
+	// GaugeAggregation is the function aggregating the gauges: last, sum, min or max.
+	// The gauges are passed through when it's empty.
+	GaugeAggregation AggregationFunction `mapstructure:"gauge_aggregation"`
+	// DeltaAggregation is the function aggregating the delta sums and histograms: last or sum.
+	// The delta metrics are passed through when it's empty.
+	DeltaAggregation AggregationFunction `mapstructure:"delta_aggregation"`
 }
 
 // Validate checks whether the input configuration has all of the required fields for the processor.
@@ -29,5 +51,17 @@ func (config *Config) Validate() error {
 		return ErrInvalidIntervalValue
 	}
 
+	switch config.GaugeAggregation {
+	case "", AggregationLast, AggregationSum, AggregationMin, AggregationMax:
+	default:
+		return ErrInvalidGaugeAggregation
+	}
+
+	switch config.DeltaAggregation {
+	case "", AggregationLast, AggregationSum:
+	default:
+		return ErrInvalidDeltaAggregation
+	}
+
 	return nil
 }
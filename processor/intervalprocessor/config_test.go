@@ -0,0 +1,48 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package intervalprocessor
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestValidate(t *testing.T) {
+	tests := []struct {
+		name        string
+		config      *Config
+		expectedErr error
+	}{
+		{
+			name:   "default",
+			config: createDefaultConfig().(*Config),
+		},
+		{
+			name:   "aggregations",
+			config: &Config{Interval: time.Second, GaugeAggregation: AggregationMin, DeltaAggregation: AggregationSum},
+		},
+		{
+			name:        "invalid_interval",
+			config:      &Config{},
+			expectedErr: ErrInvalidIntervalValue,
+		},
+		{
+			name:        "invalid_gauge_aggregation",
+			config:      &Config{Interval: time.Second, GaugeAggregation: "avg"},
+			expectedErr: ErrInvalidGaugeAggregation,
+		},
+		{
+			name:        "invalid_delta_aggregation",
+			config:      &Config{Interval: time.Second, DeltaAggregation: AggregationMax},
+			expectedErr: ErrInvalidDeltaAggregation,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.expectedErr, tt.config.Validate())
+		})
+	}
+}
@@ -12,12 +12,16 @@ type DataPointSlice[DP DataPoint[DP]] interface {
 	Len() int
 	At(i int) DP
 	AppendEmpty() DP
+	RemoveIf(f func(DP) bool)
 }
 
 type DataPoint[Self any] interface {
 	pmetric.NumberDataPoint | pmetric.HistogramDataPoint | pmetric.ExponentialHistogramDataPoint
 
+	StartTimestamp() pcommon.Timestamp
+	SetStartTimestamp(pcommon.Timestamp)
 	Timestamp() pcommon.Timestamp
+	SetTimestamp(pcommon.Timestamp)
 	Attributes() pcommon.Map
 	CopyTo(dest Self)
 }
@@ -25,6 +25,7 @@ var _ processor.Metrics = (*Processor)(nil)
 type Processor struct {
 	ctx    context.Context
 	cancel context.CancelFunc
+	wg     sync.WaitGroup
 	logger *zap.Logger
 
 	stateLock sync.Mutex
@@ -37,7 +38,9 @@ type Processor struct {
 	histogramLookup    map[identity.Stream]pmetric.HistogramDataPoint
 	expHistogramLookup map[identity.Stream]pmetric.ExponentialHistogramDataPoint
 
-	exportInterval time.Duration
+	exportInterval   time.Duration
+	gaugeAggregation AggregationFunction
+	deltaAggregation AggregationFunction
 
 	nextConsumer consumer.Metrics
 }
@@ -60,7 +63,9 @@ func newProcessor(config *Config, log *zap.Logger, nextConsumer consumer.Metrics
 		histogramLookup:    map[identity.Stream]pmetric.HistogramDataPoint{},
 		expHistogramLookup: map[identity.Stream]pmetric.ExponentialHistogramDataPoint{},
 
-		exportInterval: config.Interval,
+		exportInterval:   config.Interval,
+		gaugeAggregation: config.GaugeAggregation,
+		deltaAggregation: config.DeltaAggregation,
 
 		nextConsumer: nextConsumer,
 	}
@@ -68,7 +73,9 @@ func newProcessor(config *Config, log *zap.Logger, nextConsumer consumer.Metrics
 
 func (p *Processor) Start(_ context.Context, _ component.Host) error {
 	exportTicker := time.NewTicker(p.exportInterval)
+	p.wg.Add(1)
 	go func() {
+		defer p.wg.Done()
 		for {
 			select {
 			case <-p.ctx.Done():
@@ -83,9 +90,16 @@ func (p *Processor) Start(_ context.Context, _ component.Host) error {
 	return nil
 }
 
-func (p *Processor) Shutdown(_ context.Context) error {
+// Shutdown stops the exports at each interval, and exports the metrics aggregated during the current interval.
+func (p *Processor) Shutdown(ctx context.Context) error {
 	p.cancel()
-	return nil
+	p.wg.Wait()
+
+	md := p.takeMetrics()
+	if md.ResourceMetrics().Len() == 0 {
+		return nil
+	}
+	return p.nextConsumer.ConsumeMetrics(ctx, md)
 }
 
 func (p *Processor) Capabilities() consumer.Capabilities {
@@ -102,49 +116,59 @@ func (p *Processor) ConsumeMetrics(ctx context.Context, md pmetric.Metrics) erro
 		rm.ScopeMetrics().RemoveIf(func(sm pmetric.ScopeMetrics) bool {
 			sm.Metrics().RemoveIf(func(m pmetric.Metric) bool {
 				switch m.Type() {
-				case pmetric.MetricTypeGauge, pmetric.MetricTypeSummary:
+				case pmetric.MetricTypeSummary:
 					return false
+				case pmetric.MetricTypeGauge:
+					if p.gaugeAggregation == "" {
+						return false
+					}
+
+					mClone, metricID := p.getOrCloneMetric(rm, sm, m)
+					cloneGauge := mClone.Gauge()
+
+					return aggregateDataPoints(m.Gauge().DataPoints(), cloneGauge.DataPoints(), metricID, p.numberLookup, numberAggregation(p.gaugeAggregation))
 				case pmetric.MetricTypeSum:
 					// Check if we care about this value
 					sum := m.Sum()
 
-					if !sum.IsMonotonic() {
+					aggregation, ok := p.aggregation(sum.AggregationTemporality())
+					if !ok {
 						return false
 					}
 
-					if sum.AggregationTemporality() != pmetric.AggregationTemporalityCumulative {
+					// The cumulative non-monotonic sums are passed through
+					if !sum.IsMonotonic() && sum.AggregationTemporality() == pmetric.AggregationTemporalityCumulative {
 						return false
 					}
 
 					mClone, metricID := p.getOrCloneMetric(rm, sm, m)
 					cloneSum := mClone.Sum()
 
-					aggregateDataPoints(sum.DataPoints(), cloneSum.DataPoints(), metricID, p.numberLookup)
-					return true
+					return aggregateDataPoints(sum.DataPoints(), cloneSum.DataPoints(), metricID, p.numberLookup, numberAggregation(aggregation))
 				case pmetric.MetricTypeHistogram:
 					histogram := m.Histogram()
 
-					if histogram.AggregationTemporality() != pmetric.AggregationTemporalityCumulative {
+					aggregation, ok := p.aggregation(histogram.AggregationTemporality())
+					if !ok {
 						return false
 					}
 
 					mClone, metricID := p.getOrCloneMetric(rm, sm, m)
 					cloneHistogram := mClone.Histogram()
 
-					aggregateDataPoints(histogram.DataPoints(), cloneHistogram.DataPoints(), metricID, p.histogramLookup)
-					return true
+					return aggregateDataPoints(histogram.DataPoints(), cloneHistogram.DataPoints(), metricID, p.histogramLookup, histogramAggregation(aggregation))
 				case pmetric.MetricTypeExponentialHistogram:
 					expHistogram := m.ExponentialHistogram()
 
-					if expHistogram.AggregationTemporality() != pmetric.AggregationTemporalityCumulative {
+					aggregation, ok := p.aggregation(expHistogram.AggregationTemporality())
+					if !ok {
 						return false
 					}
 
 					mClone, metricID := p.getOrCloneMetric(rm, sm, m)
 					cloneExpHistogram := mClone.ExponentialHistogram()
 
-					aggregateDataPoints(expHistogram.DataPoints(), cloneExpHistogram.DataPoints(), metricID, p.expHistogramLookup)
-					return true
+					return aggregateDataPoints(expHistogram.DataPoints(), cloneExpHistogram.DataPoints(), metricID, p.expHistogramLookup, expHistogramAggregation(aggregation))
 				default:
 					errs = errors.Join(fmt.Errorf("invalid MetricType %d", m.Type()))
 					return false
@@ -162,55 +186,66 @@ func (p *Processor) ConsumeMetrics(ctx context.Context, md pmetric.Metrics) erro
 	return errs
 }
 
-func aggregateDataPoints[DPS metrics.DataPointSlice[DP], DP metrics.DataPoint[DP]](dataPoints DPS, mCloneDataPoints DPS, metricID identity.Metric, dpLookup map[identity.Stream]DP) {
-	for i := 0; i < dataPoints.Len(); i++ {
-		dp := dataPoints.At(i)
+// aggregation returns the function aggregating the metrics of the temporality, and false if they are passed through.
+// The cumulative metrics always keep their newest data point.
+func (p *Processor) aggregation(temporality pmetric.AggregationTemporality) (AggregationFunction, bool) {
+	switch temporality {
+	case pmetric.AggregationTemporalityCumulative:
+		return AggregationLast, true
+	case pmetric.AggregationTemporalityDelta:
+		return p.deltaAggregation, p.deltaAggregation != ""
+	default:
+		return "", false
+	}
+}
 
+// aggregateDataPoints aggregates the data points into the ones of the clone of their metric, removing them from the
+// input. It returns whether all of them were aggregated, the remaining ones being passed through.
+func aggregateDataPoints[DPS metrics.DataPointSlice[DP], DP metrics.DataPoint[DP]](dataPoints DPS, mCloneDataPoints DPS, metricID identity.Metric, dpLookup map[identity.Stream]DP, aggregate aggregateFunc[DP]) bool {
+	dataPoints.RemoveIf(func(dp DP) bool {
 		streamID := identity.OfStream(metricID, dp)
 		existingDP, ok := dpLookup[streamID]
 		if !ok {
 			dpClone := mCloneDataPoints.AppendEmpty()
 			dp.CopyTo(dpClone)
 			dpLookup[streamID] = dpClone
-			continue
-		}
-
-		// Check if the datapoint is newer
-		if dp.Timestamp() > existingDP.Timestamp() {
-			dp.CopyTo(existingDP)
-			continue
+			return true
 		}
 
-		// Otherwise, we leave existing as-is
-	}
+		return aggregate(existingDP, dp)
+	})
+	return dataPoints.Len() == 0
 }
 
 func (p *Processor) exportMetrics() {
-	md := func() pmetric.Metrics {
-		p.stateLock.Lock()
-		defer p.stateLock.Unlock()
-
-		// ConsumeMetrics() has prepared our own pmetric.Metrics instance ready for us to use
-		// Take it and clear replace it with a new empty one
-		out := p.md
-		p.md = pmetric.NewMetrics()
-
-		// Clear all the lookup references
-		clear(p.rmLookup)
-		clear(p.smLookup)
-		clear(p.mLookup)
-		clear(p.numberLookup)
-		clear(p.histogramLookup)
-		clear(p.expHistogramLookup)
-
-		return out
-	}()
+	md := p.takeMetrics()
 
 	if err := p.nextConsumer.ConsumeMetrics(p.ctx, md); err != nil {
 		p.logger.Error("Metrics export failed", zap.Error(err))
 	}
 }
 
+// takeMetrics returns the metrics aggregated during the current interval, and starts a new interval.
+func (p *Processor) takeMetrics() pmetric.Metrics {
+	p.stateLock.Lock()
+	defer p.stateLock.Unlock()
+
+	// ConsumeMetrics() has prepared our own pmetric.Metrics instance ready for us to use
+	// Take it and clear replace it with a new empty one
+	out := p.md
+	p.md = pmetric.NewMetrics()
+
+	// Clear all the lookup references
+	clear(p.rmLookup)
+	clear(p.smLookup)
+	clear(p.mLookup)
+	clear(p.numberLookup)
+	clear(p.histogramLookup)
+	clear(p.expHistogramLookup)
+
+	return out
+}
+
 func (p *Processor) getOrCloneMetric(rm pmetric.ResourceMetrics, sm pmetric.ScopeMetrics, m pmetric.Metric) (pmetric.Metric, identity.Metric) {
 	// Find the ResourceMetrics
 	resID := identity.OfResource(rm.Resource())
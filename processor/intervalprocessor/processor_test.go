@@ -10,6 +10,7 @@ import (
 	"time"
 
 	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/collector/component/componenttest"
 	"go.opentelemetry.io/collector/consumer/consumertest"
 	"go.opentelemetry.io/collector/pdata/pmetric"
 	"go.opentelemetry.io/collector/processor/processortest"
@@ -21,22 +22,40 @@ import (
 func TestAggregation(t *testing.T) {
 	t.Parallel()
 
-	testCases := []string{
-		"basic_aggregation",
-		"non_monotonic_sums_are_passed_through",
-		"summaries_are_passed_through",
-		"histograms_are_aggregated",
-		"exp_histograms_are_aggregated",
-		"all_delta_metrics_are_passed_through",
+	testCases := []struct {
+		name   string
+		config *Config
+	}{
+		{name: "basic_aggregation"},
+		{name: "non_monotonic_sums_are_passed_through"},
+		{name: "summaries_are_passed_through"},
+		{name: "histograms_are_aggregated"},
+		{name: "exp_histograms_are_aggregated"},
+		{name: "all_delta_metrics_are_passed_through"},
+		{name: "gauges_are_passed_through"},
+		{
+			name:   "gauges_are_aggregated",
+			config: &Config{Interval: time.Second, GaugeAggregation: AggregationMax},
+		},
+		{
+			name:   "delta_sums_are_summed",
+			config: &Config{Interval: time.Second, DeltaAggregation: AggregationSum},
+		},
+		{
+			name:   "delta_histograms_are_summed",
+			config: &Config{Interval: time.Second, DeltaAggregation: AggregationSum},
+		},
 	}
 
 	ctx, cancel := context.WithCancel(context.Background())
 	defer cancel()
 
-	config := &Config{Interval: time.Second}
-
 	for _, tc := range testCases {
-		testName := tc
+		testName := tc.name
+		config := tc.config
+		if config == nil {
+			config = &Config{Interval: time.Second}
+		}
 
 		t.Run(testName, func(t *testing.T) {
 			t.Parallel()
@@ -102,3 +121,31 @@ func TestAggregation(t *testing.T) {
 		})
 	}
 }
+
+func TestShutdownExportsMetrics(t *testing.T) {
+	next := &consumertest.MetricsSink{}
+	mgp, err := NewFactory().CreateMetricsProcessor(context.Background(), processortest.NewNopCreateSettings(), &Config{Interval: time.Hour}, next)
+	require.NoError(t, err)
+	require.NoError(t, mgp.Start(context.Background(), componenttest.NewNopHost()))
+
+	md, err := golden.ReadMetrics(filepath.Join("testdata", "basic_aggregation", "input.yaml"))
+	require.NoError(t, err)
+	require.NoError(t, mgp.ConsumeMetrics(context.Background(), md))
+	require.NoError(t, mgp.Shutdown(context.Background()))
+
+	// the metrics aggregated during the interval are exported when shutting down, before the end of the interval
+	allMetrics := next.AllMetrics()
+	require.Len(t, allMetrics, 2)
+	expectedExportData, err := golden.ReadMetrics(filepath.Join("testdata", "basic_aggregation", "output.yaml"))
+	require.NoError(t, err)
+	require.NoError(t, pmetrictest.CompareMetrics(expectedExportData, allMetrics[1]))
+}
+
+func TestShutdownWithoutMetrics(t *testing.T) {
+	next := &consumertest.MetricsSink{}
+	mgp, err := NewFactory().CreateMetricsProcessor(context.Background(), processortest.NewNopCreateSettings(), &Config{Interval: time.Hour}, next)
+	require.NoError(t, err)
+	require.NoError(t, mgp.Start(context.Background(), componenttest.NewNopHost()))
+	require.NoError(t, mgp.Shutdown(context.Background()))
+	require.Empty(t, next.AllMetrics())
+}
@@ -26,6 +26,7 @@ type fakeClient struct {
 	NodeInformer       cache.SharedInformer
 	Namespaces         map[string]*kube.Namespace
 	Nodes              map[string]*kube.Node
+	Workloads          map[string]*kube.Workload
 	StopCh             chan struct{}
 }
 
@@ -69,6 +70,11 @@ func (f *fakeClient) GetNode(nodeName string) (*kube.Node, bool) {
 	return node, ok
 }
 
+func (f *fakeClient) GetWorkload(owner kube.PodOwner) (*kube.Workload, bool) {
+	workload, ok := f.Workloads[owner.UID]
+	return workload, ok
+}
+
 // Start is a noop for FakeClient.
 func (f *fakeClient) Start() {
 	if f.Informer != nil {
@@ -57,9 +57,10 @@ func (cfg *Config) Validate() error {
 		}
 
 		switch f.From {
-		case "", kube.MetadataFromPod, kube.MetadataFromNamespace, kube.MetadataFromNode:
+		case "", kube.MetadataFromPod, kube.MetadataFromNamespace, kube.MetadataFromNode,
+			kube.MetadataFromDeployment, kube.MetadataFromStatefulSet, kube.MetadataFromDaemonSet, kube.MetadataFromJob:
 		default:
-			return fmt.Errorf("%s is not a valid choice for From. Must be one of: pod, namespace, node", f.From)
+			return fmt.Errorf("%s is not a valid choice for From. Must be one of: pod, namespace, node, deployment, statefulset, daemonset, job", f.From)
 		}
 
 		if f.Regex != "" {
@@ -100,6 +101,14 @@ func (cfg *Config) Validate() error {
 		}
 	}
 
+	for _, labels := range [][]string{cfg.Extract.ServiceAttributes.NameLabels, cfg.Extract.ServiceAttributes.NamespaceLabels, cfg.Extract.ServiceAttributes.VersionLabels} {
+		for _, label := range labels {
+			if label == "" {
+				return fmt.Errorf("the labels of the service attributes must not be empty")
+			}
+		}
+	}
+
 	for _, f := range cfg.Filter.Labels {
 		switch f.Op {
 		case "", filterOPEquals, filterOPNotEquals, filterOPExists, filterOPDoesNotExist:
@@ -160,6 +169,32 @@ type ExtractConfig struct {
 	// It is a list of FieldExtractConfig type. See FieldExtractConfig
 	// documentation for more details.
 	Labels []FieldExtractConfig `mapstructure:"labels"`
+
+	// ServiceAttributes allows inferring the service.name, service.namespace and service.version resource
+	// attributes from the well-known Kubernetes labels of the pods, when the telemetry doesn't hold them.
+	// See ServiceAttributesConfig documentation for more details.
+	ServiceAttributes ServiceAttributesConfig `mapstructure:"service_attributes"`
+}
+
+// ServiceAttributesConfig allows inferring the service attributes from the labels of the pods, following the
+// recommendation of the semantic conventions for Kubernetes. The attributes set by the SDKs are never overwritten,
+// except the service.name defaulting to unknown_service when not configured. The attributes extracted by the
+// annotations and labels rules take precedence over the inferred ones.
+type ServiceAttributesConfig struct {
+	// Enabled turns on the inference of the service attributes. Disabled by default.
+	Enabled bool `mapstructure:"enabled"`
+
+	// NameLabels are the labels, in order of precedence, whose value is set as service.name.
+	// Defaults to app.kubernetes.io/name, then app.kubernetes.io/instance.
+	NameLabels []string `mapstructure:"name_labels"`
+
+	// NamespaceLabels are the labels, in order of precedence, whose value is set as service.namespace.
+	// Defaults to app.kubernetes.io/part-of.
+	NamespaceLabels []string `mapstructure:"namespace_labels"`
+
+	// VersionLabels are the labels, in order of precedence, whose value is set as service.version.
+	// Defaults to app.kubernetes.io/version.
+	VersionLabels []string `mapstructure:"version_labels"`
 }
 
 // FieldExtractConfig allows specifying an extraction rule to extract a resource attribute from pod (or namespace)
@@ -216,7 +251,8 @@ type FieldExtractConfig struct {
 	Regex string `mapstructure:"regex"`
 
 	// From represents the source of the labels/annotations.
-	// Allowed values are "pod" and "namespace". The default is pod.
+	// Allowed values are "pod", "namespace", "node", and the workloads owning the pod:
+	// "deployment", "statefulset", "daemonset" and "job". The default is pod.
 	From string `mapstructure:"from"`
 }
 
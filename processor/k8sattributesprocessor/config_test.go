@@ -127,9 +127,26 @@ func TestLoadConfig(t *testing.T) {
 				},
 			},
 		},
+		{
+			id: component.NewIDWithName(metadata.Type, "service_attributes"),
+			expected: &Config{
+				APIConfig: k8sconfig.APIConfig{AuthType: k8sconfig.AuthTypeServiceAccount},
+				Exclude:   ExcludeConfig{Pods: []ExcludePodConfig{{Name: "jaeger-agent"}, {Name: "jaeger-collector"}}},
+				Extract: ExtractConfig{
+					Metadata: enabledAttributes(),
+					ServiceAttributes: ServiceAttributesConfig{
+						Enabled:    true,
+						NameLabels: []string{"app.kubernetes.io/instance", "app"},
+					},
+				},
+			},
+		},
 		{
 			id: component.NewIDWithName(metadata.Type, "too_many_sources"),
 		},
+		{
+			id: component.NewIDWithName(metadata.Type, "bad_service_labels"),
+		},
 		{
 			id: component.NewIDWithName(metadata.Type, "bad_keys_labels"),
 		},
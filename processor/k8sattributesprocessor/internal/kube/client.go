@@ -14,6 +14,7 @@ import (
 	conventions "go.opentelemetry.io/collector/semconv/v1.6.1"
 	"go.uber.org/zap"
 	apps_v1 "k8s.io/api/apps/v1"
+	batch_v1 "k8s.io/api/batch/v1"
 	api_v1 "k8s.io/api/core/v1"
 	meta_v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/fields"
@@ -44,6 +45,7 @@ type WatchClient struct {
 	namespaceInformer  cache.SharedInformer
 	nodeInformer       cache.SharedInformer
 	replicasetInformer cache.SharedInformer
+	workloadInformers  map[string]cache.SharedInformer
 	replicasetRegex    *regexp.Regexp
 	cronJobRegex       *regexp.Regexp
 	deleteQueue        []deleteRequest
@@ -68,6 +70,10 @@ type WatchClient struct {
 	// A map containing ReplicaSets related data, used to associate them with resources.
 	// Key is replicaset uid
 	ReplicaSets map[string]*ReplicaSet
+
+	// A map containing the deployments, statefulsets, daemonsets and jobs owning pods, used to associate them with resources.
+	// Key is workload uid
+	Workloads map[string]*Workload
 }
 
 // Extract replicaset name from the pod name. Pod name is created using
@@ -96,6 +102,8 @@ func New(logger *zap.Logger, apiCfg k8sconfig.APIConfig, rules ExtractionRules,
 	c.Namespaces = map[string]*Namespace{}
 	c.Nodes = map[string]*Node{}
 	c.ReplicaSets = map[string]*ReplicaSet{}
+	c.Workloads = map[string]*Workload{}
+	c.workloadInformers = map[string]cache.SharedInformer{}
 	if newClientSet == nil {
 		newClientSet = k8sconfig.MakeClient
 	}
@@ -151,7 +159,7 @@ func New(logger *zap.Logger, apiCfg k8sconfig.APIConfig, rules ExtractionRules,
 
 	c.namespaceInformer = newNamespaceInformer(c.kc)
 
-	if rules.DeploymentName || rules.DeploymentUID {
+	if needReplicaSets(rules) {
 		if newReplicaSetInformer == nil {
 			newReplicaSetInformer = newReplicaSetSharedInformer
 		}
@@ -175,6 +183,19 @@ func New(logger *zap.Logger, apiCfg k8sconfig.APIConfig, rules ExtractionRules,
 		c.nodeInformer = k8sconfig.NewNodeSharedInformer(c.kc, c.Filters.Node, 5*time.Minute)
 	}
 
+	for _, kind := range rules.workloadKinds() {
+		informer := newWorkloadSharedInformer(c.kc, c.Filters.Namespace, kind)
+		err = informer.SetTransform(
+			func(object any) (any, error) {
+				return removeUnnecessaryWorkloadData(object), nil
+			},
+		)
+		if err != nil {
+			return nil, err
+		}
+		c.workloadInformers[kind] = informer
+	}
+
 	return c, err
 }
 
@@ -200,7 +221,7 @@ func (c *WatchClient) Start() {
 	}
 	go c.namespaceInformer.Run(c.stopCh)
 
-	if c.Rules.DeploymentName || c.Rules.DeploymentUID {
+	if needReplicaSets(c.Rules) {
 		_, err = c.replicasetInformer.AddEventHandler(cache.ResourceEventHandlerFuncs{
 			AddFunc:    c.handleReplicaSetAdd,
 			UpdateFunc: c.handleReplicaSetUpdate,
@@ -223,6 +244,18 @@ func (c *WatchClient) Start() {
 		}
 		go c.nodeInformer.Run(c.stopCh)
 	}
+
+	for kind, informer := range c.workloadInformers {
+		_, err = informer.AddEventHandler(cache.ResourceEventHandlerFuncs{
+			AddFunc:    c.handleWorkloadAdd,
+			UpdateFunc: c.handleWorkloadUpdate,
+			DeleteFunc: c.handleWorkloadDelete,
+		})
+		if err != nil {
+			c.logger.Error("error adding event handler to workload informer", zap.String("kind", kind), zap.Error(err))
+		}
+		go informer.Run(c.stopCh)
+	}
 }
 
 // Stop signals the the k8s watcher/informer to stop watching for new events.
@@ -406,6 +439,23 @@ func (c *WatchClient) GetNode(nodeName string) (*Node, bool) {
 	return nil, false
 }
 
+// GetWorkload takes an owner of a pod and returns the workload the owner is associated with,
+// the deployment owning the replicaset for a replicaset.
+func (c *WatchClient) GetWorkload(owner PodOwner) (*Workload, bool) {
+	c.m.RLock()
+	defer c.m.RUnlock()
+	uid := owner.UID
+	if owner.Kind == "ReplicaSet" {
+		replicaset, ok := c.ReplicaSets[uid]
+		if !ok || replicaset.Deployment.UID == "" {
+			return nil, false
+		}
+		uid = replicaset.Deployment.UID
+	}
+	workload, ok := c.Workloads[uid]
+	return workload, ok
+}
+
 func (c *WatchClient) extractPodAttributes(pod *api_v1.Pod) map[string]string {
 	tags := map[string]string{}
 	if c.Rules.PodName {
@@ -519,6 +569,19 @@ func (c *WatchClient) extractPodAttributes(pod *api_v1.Pod) map[string]string {
 	for _, r := range c.Rules.Annotations {
 		r.extractFromPodMetadata(pod.Annotations, tags, "k8s.pod.annotations.%s")
 	}
+
+	for attr, labels := range c.Rules.ServiceLabels {
+		// the attributes explicitly extracted by the rules above take precedence
+		if _, ok := tags[attr]; ok {
+			continue
+		}
+		for _, label := range labels {
+			if v := pod.Labels[label]; v != "" {
+				tags[attr] = v
+				break
+			}
+		}
+	}
 	return tags
 }
 
@@ -601,7 +664,7 @@ func removeUnnecessaryPodData(pod *api_v1.Pod, rules ExtractionRules) *api_v1.Po
 		}
 	}
 
-	if len(rules.Labels) > 0 {
+	if len(rules.Labels) > 0 || len(rules.ServiceLabels) > 0 {
 		transformedPod.Labels = pod.Labels
 	}
 
@@ -713,6 +776,9 @@ func (c *WatchClient) podFromAPI(pod *api_v1.Pod) *Pod {
 		if needContainerAttributes(c.Rules) {
 			newPod.Containers = c.extractPodContainersAttributes(pod)
 		}
+		if c.Rules.IncludesWorkloadMetadata() {
+			newPod.Owners = podOwners(pod)
+		}
 	}
 
 	return newPod
@@ -948,6 +1014,24 @@ func (c *WatchClient) addOrUpdateNode(node *api_v1.Node) {
 	c.m.Unlock()
 }
 
+// podOwners returns the owners of the pod the workload labels and annotations are extracted from
+func podOwners(pod *api_v1.Pod) []PodOwner {
+	var owners []PodOwner
+	for _, ref := range pod.OwnerReferences {
+		switch ref.Kind {
+		case "ReplicaSet", "StatefulSet", "DaemonSet", "Job":
+			owners = append(owners, PodOwner{Kind: ref.Kind, UID: string(ref.UID)})
+		}
+	}
+	return owners
+}
+
+func needReplicaSets(rules ExtractionRules) bool {
+	return rules.DeploymentName ||
+		rules.DeploymentUID ||
+		rules.extractsFrom(MetadataFromDeployment)
+}
+
 func needContainerAttributes(rules ExtractionRules) bool {
 	return rules.ContainerImageName ||
 		rules.ContainerName ||
@@ -1032,6 +1116,101 @@ func (c *WatchClient) getReplicaSet(uid string) (*ReplicaSet, bool) {
 	return nil, false
 }
 
+func (c *WatchClient) handleWorkloadAdd(obj any) {
+	if workload, kind, ok := workloadKind(obj); ok {
+		c.addOrUpdateWorkload(workload, kind)
+	} else {
+		c.logger.Error("object received was not a workload", zap.Any("received", obj))
+	}
+}
+
+func (c *WatchClient) handleWorkloadUpdate(_, newWorkload any) {
+	if workload, kind, ok := workloadKind(newWorkload); ok {
+		c.addOrUpdateWorkload(workload, kind)
+	} else {
+		c.logger.Error("object received was not a workload", zap.Any("received", newWorkload))
+	}
+}
+
+func (c *WatchClient) handleWorkloadDelete(obj any) {
+	if workload, _, ok := workloadKind(ignoreDeletedFinalStateUnknown(obj)); ok {
+		c.m.Lock()
+		delete(c.Workloads, string(workload.GetUID()))
+		c.m.Unlock()
+	} else {
+		c.logger.Error("object received was not a workload", zap.Any("received", obj))
+	}
+}
+
+// workloadKind returns the metadata of the workload and its kind, as used by the extraction rules
+func workloadKind(obj any) (meta_v1.Object, string, bool) {
+	switch workload := obj.(type) {
+	case *apps_v1.Deployment:
+		return workload, MetadataFromDeployment, true
+	case *apps_v1.StatefulSet:
+		return workload, MetadataFromStatefulSet, true
+	case *apps_v1.DaemonSet:
+		return workload, MetadataFromDaemonSet, true
+	case *batch_v1.Job:
+		return workload, MetadataFromJob, true
+	default:
+		return nil, "", false
+	}
+}
+
+func (c *WatchClient) addOrUpdateWorkload(workload meta_v1.Object, kind string) {
+	newWorkload := &Workload{
+		Name: workload.GetName(),
+		UID:  string(workload.GetUID()),
+	}
+	newWorkload.Attributes = c.extractWorkloadAttributes(workload, kind)
+
+	c.m.Lock()
+	if newWorkload.UID != "" {
+		c.Workloads[newWorkload.UID] = newWorkload
+	}
+	c.m.Unlock()
+}
+
+func (c *WatchClient) extractWorkloadAttributes(workload meta_v1.Object, kind string) map[string]string {
+	tags := map[string]string{}
+
+	for _, r := range c.Rules.Labels {
+		r.extractFromWorkloadMetadata(kind, workload.GetLabels(), tags, "k8s."+kind+".labels.%s")
+	}
+
+	for _, r := range c.Rules.Annotations {
+		r.extractFromWorkloadMetadata(kind, workload.GetAnnotations(), tags, "k8s."+kind+".annotations.%s")
+	}
+
+	return tags
+}
+
+// This function removes all data from the workload except what is required by extraction rules
+func removeUnnecessaryWorkloadData(object any) any {
+	workload, _, ok := workloadKind(object)
+	if !ok { // means this is a cache.DeletedFinalStateUnknown, in which case we do nothing
+		return object
+	}
+	objectMeta := meta_v1.ObjectMeta{
+		Name:        workload.GetName(),
+		Namespace:   workload.GetNamespace(),
+		UID:         workload.GetUID(),
+		Labels:      workload.GetLabels(),
+		Annotations: workload.GetAnnotations(),
+	}
+	switch object.(type) {
+	case *apps_v1.Deployment:
+		return &apps_v1.Deployment{ObjectMeta: objectMeta}
+	case *apps_v1.StatefulSet:
+		return &apps_v1.StatefulSet{ObjectMeta: objectMeta}
+	case *apps_v1.DaemonSet:
+		return &apps_v1.DaemonSet{ObjectMeta: objectMeta}
+	default:
+		return &batch_v1.Job{ObjectMeta: objectMeta}
+	}
+}
+
 // ignoreDeletedFinalStateUnknown returns the object wrapped in
 // DeletedFinalStateUnknown. Useful in OnDelete resource event handlers that do
 // not need the additional context.
@@ -15,6 +15,7 @@ import (
 	"go.uber.org/zap/zapcore"
 	"go.uber.org/zap/zaptest/observer"
 	apps_v1 "k8s.io/api/apps/v1"
+	batch_v1 "k8s.io/api/batch/v1"
 	api_v1 "k8s.io/api/core/v1"
 	meta_v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/selection"
@@ -949,6 +950,35 @@ func TestExtractionRules(t *testing.T) {
 				"prefix-annotation1": "av1",
 			},
 		},
+		{
+			name: "service-labels",
+			rules: ExtractionRules{
+				ServiceLabels: map[string][]string{
+					"service.name":    {"missing", "label1"},
+					"service.version": {"missing"},
+				},
+			},
+			attributes: map[string]string{
+				"service.name": "lv1",
+			},
+		},
+		{
+			name: "service-labels-after-rules",
+			rules: ExtractionRules{
+				Labels: []FieldExtractionRule{{
+					Name: "service.name",
+					Key:  "label2",
+					From: MetadataFromPod,
+				},
+				},
+				ServiceLabels: map[string][]string{
+					"service.name": {"label1"},
+				},
+			},
+			attributes: map[string]string{
+				"service.name": "k1=v1 k5=v5 extra!",
+			},
+		},
 	}
 	for _, tc := range testCases {
 		t.Run(tc.name, func(t *testing.T) {
@@ -1308,6 +1338,119 @@ func TestNodeExtractionRules(t *testing.T) {
 	}
 }
 
+func TestWorkloadExtractionRules(t *testing.T) {
+	c, _ := newTestClientWithRulesAndFilters(t, Filters{})
+	c.Rules = ExtractionRules{
+		Labels: []FieldExtractionRule{
+			{
+				Name: "team",
+				Key:  "team",
+				From: MetadataFromDeployment,
+			},
+			{
+				KeyRegex: regexp.MustCompile("^(?:app.*)$"),
+				From:     MetadataFromStatefulSet,
+			},
+		},
+		Annotations: []FieldExtractionRule{
+			{
+				Name: "owner",
+				Key:  "owner",
+				From: MetadataFromJob,
+			},
+		},
+	}
+	assert.Equal(t, []string{MetadataFromDeployment, MetadataFromStatefulSet, MetadataFromJob}, c.Rules.workloadKinds())
+
+	isController := true
+	c.handleReplicaSetAdd(&apps_v1.ReplicaSet{
+		ObjectMeta: meta_v1.ObjectMeta{
+			Name: "checkout-7d4b9c",
+			UID:  "rs-uid",
+			OwnerReferences: []meta_v1.OwnerReference{
+				{Kind: "Deployment", Name: "checkout", UID: "deployment-uid", Controller: &isController},
+			},
+		},
+	})
+	workloads := []any{
+		&apps_v1.Deployment{ObjectMeta: meta_v1.ObjectMeta{
+			Name:   "checkout",
+			UID:    "deployment-uid",
+			Labels: map[string]string{"team": "payments", "tier": "backend"},
+		}},
+		&apps_v1.StatefulSet{ObjectMeta: meta_v1.ObjectMeta{
+			Name:   "db",
+			UID:    "statefulset-uid",
+			Labels: map[string]string{"app": "postgres", "team": "storage"},
+		}},
+		&batch_v1.Job{ObjectMeta: meta_v1.ObjectMeta{
+			Name:        "migration",
+			UID:         "job-uid",
+			Annotations: map[string]string{"owner": "platform"},
+		}},
+	}
+	for _, w := range workloads {
+		c.handleWorkloadAdd(removeUnnecessaryWorkloadData(w))
+	}
+	assert.Len(t, c.Workloads, 3)
+
+	pod := &api_v1.Pod{
+		ObjectMeta: meta_v1.ObjectMeta{
+			Name: "checkout-7d4b9c-x2x4z",
+			UID:  "pod-uid",
+			OwnerReferences: []meta_v1.OwnerReference{
+				{Kind: "ReplicaSet", Name: "checkout-7d4b9c", UID: "rs-uid"},
+				{Kind: "StatefulSet", Name: "db", UID: "statefulset-uid"},
+				{Kind: "Job", Name: "migration", UID: "job-uid"},
+				{Kind: "Node", Name: "node-1", UID: "node-uid"},
+			},
+		},
+	}
+	p := c.podFromAPI(removeUnnecessaryPodData(pod, c.Rules))
+	assert.Equal(t, []PodOwner{
+		{Kind: "ReplicaSet", UID: "rs-uid"},
+		{Kind: "StatefulSet", UID: "statefulset-uid"},
+		{Kind: "Job", UID: "job-uid"},
+	}, p.Owners)
+
+	expected := []map[string]string{
+		{"team": "payments"},
+		{"k8s.statefulset.labels.app": "postgres"},
+		{"owner": "platform"},
+	}
+	for i, owner := range p.Owners {
+		w, ok := c.GetWorkload(owner)
+		require.True(t, ok)
+		assert.Equal(t, expected[i], w.Attributes)
+	}
+
+	// the replicaset of a deleted deployment isn't associated with a workload anymore
+	c.handleWorkloadDelete(workloads[0])
+	_, ok := c.GetWorkload(p.Owners[0])
+	assert.False(t, ok)
+	_, ok = c.GetWorkload(PodOwner{Kind: "ReplicaSet", UID: "unknown-uid"})
+	assert.False(t, ok)
+}
+
+func TestWorkloadInformers(t *testing.T) {
+	rules := ExtractionRules{
+		Labels: []FieldExtractionRule{
+			{Key: "team", From: MetadataFromDeployment},
+			{Key: "team", From: MetadataFromDaemonSet},
+		},
+	}
+	c, err := New(zap.NewNop(), k8sconfig.APIConfig{}, rules, Filters{}, []Association{}, Excludes{}, newFakeAPIClientset, NewFakeInformer, NewFakeNamespaceInformer, NewFakeReplicaSetInformer)
+	require.NoError(t, err)
+	wc := c.(*WatchClient)
+	assert.Len(t, wc.workloadInformers, 2)
+	assert.Contains(t, wc.workloadInformers, MetadataFromDeployment)
+	assert.Contains(t, wc.workloadInformers, MetadataFromDaemonSet)
+	// the deployments are resolved from the replicasets owning the pods
+	assert.NotNil(t, wc.replicasetInformer)
+	c.Start()
+	c.Stop()
+}
+
 func TestFilters(t *testing.T) {
 	testCases := []struct {
 		name    string
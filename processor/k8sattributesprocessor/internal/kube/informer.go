@@ -7,6 +7,7 @@ import (
 	"context"
 
 	apps_v1 "k8s.io/api/apps/v1"
+	batch_v1 "k8s.io/api/batch/v1"
 	api_v1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/fields"
@@ -155,3 +156,56 @@ func replicasetWatchFuncWithSelectors(client kubernetes.Interface, namespace str
 		return client.AppsV1().ReplicaSets(namespace).Watch(context.Background(), opts)
 	}
 }
+
+// newWorkloadSharedInformer watches the workloads of the given kind: deployment, statefulset, daemonset or job
+func newWorkloadSharedInformer(
+	client kubernetes.Interface,
+	namespace string,
+	kind string,
+) cache.SharedInformer {
+	var lw *cache.ListWatch
+	var objType runtime.Object
+	switch kind {
+	case MetadataFromDeployment:
+		lw = &cache.ListWatch{
+			ListFunc: func(opts metav1.ListOptions) (runtime.Object, error) {
+				return client.AppsV1().Deployments(namespace).List(context.Background(), opts)
+			},
+			WatchFunc: func(opts metav1.ListOptions) (watch.Interface, error) {
+				return client.AppsV1().Deployments(namespace).Watch(context.Background(), opts)
+			},
+		}
+		objType = &apps_v1.Deployment{}
+	case MetadataFromStatefulSet:
+		lw = &cache.ListWatch{
+			ListFunc: func(opts metav1.ListOptions) (runtime.Object, error) {
+				return client.AppsV1().StatefulSets(namespace).List(context.Background(), opts)
+			},
+			WatchFunc: func(opts metav1.ListOptions) (watch.Interface, error) {
+				return client.AppsV1().StatefulSets(namespace).Watch(context.Background(), opts)
+			},
+		}
+		objType = &apps_v1.StatefulSet{}
+	case MetadataFromDaemonSet:
+		lw = &cache.ListWatch{
+			ListFunc: func(opts metav1.ListOptions) (runtime.Object, error) {
+				return client.AppsV1().DaemonSets(namespace).List(context.Background(), opts)
+			},
+			WatchFunc: func(opts metav1.ListOptions) (watch.Interface, error) {
+				return client.AppsV1().DaemonSets(namespace).Watch(context.Background(), opts)
+			},
+		}
+		objType = &apps_v1.DaemonSet{}
+	default:
+		lw = &cache.ListWatch{
+			ListFunc: func(opts metav1.ListOptions) (runtime.Object, error) {
+				return client.BatchV1().Jobs(namespace).List(context.Background(), opts)
+			},
+			WatchFunc: func(opts metav1.ListOptions) (watch.Interface, error) {
+				return client.BatchV1().Jobs(namespace).Watch(context.Background(), opts)
+			},
+		}
+		objType = &batch_v1.Job{}
+	}
+	return cache.NewSharedInformer(lw, objType, watchSyncPeriod)
+}
@@ -28,7 +28,15 @@ const (
 	// MetadataFromNamespace is used to specify to extract metadata/labels/annotations from namespace
 	MetadataFromNamespace = "namespace"
 	// MetadataFromNode is used to specify to extract metadata/labels/annotations from node
-	MetadataFromNode       = "node"
+	MetadataFromNode = "node"
+	// MetadataFromDeployment is used to specify to extract labels/annotations from the deployment owning the pod
+	MetadataFromDeployment = "deployment"
+	// MetadataFromStatefulSet is used to specify to extract labels/annotations from the statefulset owning the pod
+	MetadataFromStatefulSet = "statefulset"
+	// MetadataFromDaemonSet is used to specify to extract labels/annotations from the daemonset owning the pod
+	MetadataFromDaemonSet = "daemonset"
+	// MetadataFromJob is used to specify to extract labels/annotations from the job owning the pod
+	MetadataFromJob        = "job"
 	PodIdentifierMaxLength = 4
 
 	ResourceSource   = "resource_attribute"
@@ -91,6 +99,7 @@ type Client interface {
 	GetPod(PodIdentifier) (*Pod, bool)
 	GetNamespace(string) (*Namespace, bool)
 	GetNode(string) (*Node, bool)
+	GetWorkload(PodOwner) (*Workload, bool)
 	Start()
 	Stop()
 }
@@ -117,6 +126,9 @@ type Pod struct {
 	// Containers specifies all containers in this pod.
 	Containers PodContainers
 
+	// Owners specifies the owners of this pod the workload labels and annotations are extracted from.
+	Owners []PodOwner
+
 	DeletedAt time.Time
 }
 
@@ -159,6 +171,19 @@ type Node struct {
 	Attributes map[string]string
 }
 
+// PodOwner represents the owner of a pod: a replicaset, a statefulset, a daemonset or a job.
+type PodOwner struct {
+	Kind string
+	UID  string
+}
+
+// Workload represents a kubernetes workload owning pods: a deployment, a statefulset, a daemonset or a job.
+type Workload struct {
+	Name       string
+	UID        string
+	Attributes map[string]string
+}
+
 type deleteRequest struct {
 	// id is identifier (IP address or Pod UID) of pod to remove from pods map
 	id PodIdentifier
@@ -220,6 +245,10 @@ type ExtractionRules struct {
 
 	Annotations []FieldExtractionRule
 	Labels      []FieldExtractionRule
+
+	// ServiceLabels maps the service attributes, e.g. service.name, to the pod labels their value is taken from,
+	// in order of precedence.
+	ServiceLabels map[string][]string
 }
 
 // IncludesOwnerMetadata determines whether the ExtractionRules include metadata about Pod Owners
@@ -236,6 +265,7 @@ func (rules *ExtractionRules) IncludesOwnerMetadata() bool {
 		rules.ReplicaSetName,
 		rules.StatefulSetUID,
 		rules.StatefulSetName,
+		rules.IncludesWorkloadMetadata(),
 	}
 	for _, ruleEnabled := range rulesNeedingOwnerMetadata {
 		if ruleEnabled {
@@ -245,6 +275,37 @@ func (rules *ExtractionRules) IncludesOwnerMetadata() bool {
 	return false
 }
 
+// IncludesWorkloadMetadata determines whether the ExtractionRules include labels or annotations of the workloads owning the pods
+func (rules *ExtractionRules) IncludesWorkloadMetadata() bool {
+	return len(rules.workloadKinds()) > 0
+}
+
+// workloadKinds returns the kinds of workloads the ExtractionRules extract labels or annotations from
+func (rules *ExtractionRules) workloadKinds() []string {
+	var kinds []string
+	for _, kind := range []string{MetadataFromDeployment, MetadataFromStatefulSet, MetadataFromDaemonSet, MetadataFromJob} {
+		if rules.extractsFrom(kind) {
+			kinds = append(kinds, kind)
+		}
+	}
+	return kinds
+}
+
+// extractsFrom determines whether the ExtractionRules extract labels or annotations from the given kind of object
+func (rules *ExtractionRules) extractsFrom(from string) bool {
+	for _, r := range rules.Labels {
+		if r.From == from {
+			return true
+		}
+	}
+	for _, r := range rules.Annotations {
+		if r.From == from {
+			return true
+		}
+	}
+	return false
+}
+
 // FieldExtractionRule is used to specify which fields to extract from pod fields
 // and inject into spans as attributes.
 type FieldExtractionRule struct {
@@ -259,10 +320,14 @@ type FieldExtractionRule struct {
 	// Full value is extracted when no regexp is provided.
 	Regex *regexp.Regexp
 	// From determines the kubernetes object the field should be retrieved from.
-	// Currently only three values are supported,
+	// Currently the following values are supported,
 	//  - pod
 	//  - namespace
 	//  - node
+	//  - deployment
+	//  - statefulset
+	//  - daemonset
+	//  - job
 	From string
 }
 
@@ -285,6 +350,12 @@ func (r *FieldExtractionRule) extractFromNodeMetadata(metadata map[string]string
 	}
 }
 
+func (r *FieldExtractionRule) extractFromWorkloadMetadata(from string, metadata map[string]string, tags map[string]string, formatter string) {
+	if r.From == from {
+		r.extractFromMetadata(metadata, tags, formatter)
+	}
+}
+
 func (r *FieldExtractionRule) extractFromMetadata(metadata map[string]string, tags map[string]string, formatter string) {
 	if r.KeyRegex != nil {
 		for k, v := range metadata {
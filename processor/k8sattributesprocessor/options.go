@@ -25,6 +25,12 @@ const (
 	specPodHostName      = "k8s.pod.hostname"
 	// TODO: use k8s.cluster.uid from semconv when available, and replace clusterUID with conventions.AttributeClusterUid
 	clusterUID = "k8s.cluster.uid"
+
+	// well-known labels of the applications, see https://kubernetes.io/docs/concepts/overview/working-with-objects/common-labels/
+	labelAppName     = "app.kubernetes.io/name"
+	labelAppInstance = "app.kubernetes.io/instance"
+	labelAppVersion  = "app.kubernetes.io/version"
+	labelAppPartOf   = "app.kubernetes.io/part-of"
 )
 
 // option represents a configuration option that can be passes.
@@ -206,6 +212,30 @@ func withExtractAnnotations(annotations ...FieldExtractConfig) option {
 	}
 }
 
+// withExtractServiceAttributes allows specifying options to control the inference of the service attributes from
+// the labels of the pods.
+func withExtractServiceAttributes(cfg ServiceAttributesConfig) option {
+	return func(p *kubernetesprocessor) error {
+		if !cfg.Enabled {
+			p.rules.ServiceLabels = nil
+			return nil
+		}
+		p.rules.ServiceLabels = map[string][]string{
+			conventions.AttributeServiceName:      defaultLabels(cfg.NameLabels, labelAppName, labelAppInstance),
+			conventions.AttributeServiceNamespace: defaultLabels(cfg.NamespaceLabels, labelAppPartOf),
+			conventions.AttributeServiceVersion:   defaultLabels(cfg.VersionLabels, labelAppVersion),
+		}
+		return nil
+	}
+}
+
+func defaultLabels(labels []string, defaults ...string) []string {
+	if len(labels) == 0 {
+		return defaults
+	}
+	return labels
+}
+
 func extractFieldRules(fieldType string, fields ...FieldExtractConfig) ([]kube.FieldExtractionRule, error) {
 	var rules []kube.FieldExtractionRule
 	for _, a := range fields {
@@ -769,3 +769,47 @@ func TestWithExcludes(t *testing.T) {
 		})
 	}
 }
+
+func TestWithExtractServiceAttributes(t *testing.T) {
+	tests := []struct {
+		name string
+		args ServiceAttributesConfig
+		want map[string][]string
+	}{
+		{
+			"disabled",
+			ServiceAttributesConfig{NameLabels: []string{"app"}},
+			nil,
+		},
+		{
+			"default",
+			ServiceAttributesConfig{Enabled: true},
+			map[string][]string{
+				conventions.AttributeServiceName:      {"app.kubernetes.io/name", "app.kubernetes.io/instance"},
+				conventions.AttributeServiceNamespace: {"app.kubernetes.io/part-of"},
+				conventions.AttributeServiceVersion:   {"app.kubernetes.io/version"},
+			},
+		},
+		{
+			"configured",
+			ServiceAttributesConfig{
+				Enabled:       true,
+				NameLabels:    []string{"app", "k8s-app"},
+				VersionLabels: []string{"version"},
+			},
+			map[string][]string{
+				conventions.AttributeServiceName:      {"app", "k8s-app"},
+				conventions.AttributeServiceNamespace: {"app.kubernetes.io/part-of"},
+				conventions.AttributeServiceVersion:   {"version"},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			p := &kubernetesprocessor{}
+			opt := withExtractServiceAttributes(tt.args)
+			assert.NoError(t, opt(p))
+			assert.Equal(t, tt.want, p.rules.ServiceLabels)
+		})
+	}
+}
@@ -7,6 +7,7 @@ import (
 	"context"
 	"fmt"
 	"strconv"
+	"strings"
 
 	"go.opentelemetry.io/collector/component"
 	"go.opentelemetry.io/collector/pdata/pcommon"
@@ -22,6 +23,9 @@ import (
 
 const (
 	clientIPLabelName string = "ip"
+	// defaultServiceName is the prefix of the service.name set by the SDKs not configured with a service name,
+	// e.g. unknown_service:java.
+	defaultServiceName = "unknown_service"
 )
 
 type kubernetesprocessor struct {
@@ -140,11 +144,23 @@ func (kp *kubernetesprocessor) processResource(ctx context.Context, resource pco
 			kp.logger.Debug("getting the pod", zap.Any("pod", pod))
 
 			for key, val := range pod.Attributes {
-				if _, found := resource.Attributes().Get(key); !found {
+				if _, found := resource.Attributes().Get(key); !found || kp.replacesDefaultServiceName(resource.Attributes(), key) {
 					resource.Attributes().PutStr(key, val)
 				}
 			}
 			kp.addContainerAttributes(resource.Attributes(), pod)
+
+			for _, owner := range pod.Owners {
+				workload, ok := kp.kc.GetWorkload(owner)
+				if !ok {
+					continue
+				}
+				for key, val := range workload.Attributes {
+					if _, found := resource.Attributes().Get(key); !found {
+						resource.Attributes().PutStr(key, val)
+					}
+				}
+			}
 		}
 	}
 
@@ -175,6 +191,15 @@ func (kp *kubernetesprocessor) processResource(ctx context.Context, resource pco
 	}
 }
 
+// replacesDefaultServiceName returns whether the service attributes are inferred, the key is service.name and its
+// value is the unknown_service default of the SDKs not configured with a service name, rather than an explicit value.
+func (kp *kubernetesprocessor) replacesDefaultServiceName(attrs pcommon.Map, key string) bool {
+	if kp.rules.ServiceLabels == nil || key != conventions.AttributeServiceName {
+		return false
+	}
+	return strings.HasPrefix(stringAttributeFromMap(attrs, conventions.AttributeServiceName), defaultServiceName)
+}
+
 func getNamespace(pod *kube.Pod, resAttrs pcommon.Map) string {
 	if pod != nil && pod.Namespace != "" {
 		return pod.Namespace
@@ -880,6 +880,128 @@ func TestAddNodeLabels(t *testing.T) {
 	})
 }
 
+func TestAddWorkloadLabels(t *testing.T) {
+	m := newMultiTest(
+		t,
+		func() component.Config {
+			cfg := createDefaultConfig().(*Config)
+			cfg.Extract.Metadata = []string{}
+			cfg.Extract.Labels = []FieldExtractConfig{
+				{
+					From: kube.MetadataFromDeployment,
+					Key:  "team",
+				},
+			}
+			return cfg
+		}(),
+		nil,
+	)
+
+	podIP := "1.1.1.1"
+	m.kubernetesProcessorOperation(func(kp *kubernetesprocessor) {
+		kp.podAssociations = []kube.Association{
+			{
+				Sources: []kube.AssociationSource{
+					{
+						From: "connection",
+					},
+				},
+			},
+		}
+	})
+
+	m.kubernetesProcessorOperation(func(kp *kubernetesprocessor) {
+		pi := kube.PodIdentifier{
+			kube.PodIdentifierAttributeFromConnection(podIP),
+		}
+		kp.kc.(*fakeClient).Pods[pi] = &kube.Pod{
+			Name:   "test-2323",
+			Owners: []kube.PodOwner{{Kind: "ReplicaSet", UID: "rs-uid"}, {Kind: "Job", UID: "unknown-uid"}},
+		}
+		kp.kc.(*fakeClient).Workloads = map[string]*kube.Workload{
+			"rs-uid": {Name: "checkout", Attributes: map[string]string{"k8s.deployment.labels.team": "payments"}},
+		}
+	})
+
+	ctx := client.NewContext(context.Background(), client.Info{
+		Addr: &net.IPAddr{
+			IP: net.ParseIP(podIP),
+		},
+	})
+	m.testConsume(
+		ctx,
+		generateTraces(),
+		generateMetrics(),
+		generateLogs(),
+		func(err error) {
+			assert.NoError(t, err)
+		})
+
+	m.assertBatchesLen(1)
+	m.assertResourceObjectLen(0)
+	m.assertResource(0, func(res pcommon.Resource) {
+		assert.Equal(t, 2, res.Attributes().Len())
+		assertResourceHasStringAttribute(t, res, "k8s.pod.ip", podIP)
+		assertResourceHasStringAttribute(t, res, "k8s.deployment.labels.team", "payments")
+	})
+}
+
+func TestServiceAttributesPrecedence(t *testing.T) {
+	tests := []struct {
+		name          string
+		serviceLabels map[string][]string
+		serviceName   string
+		expected      string
+	}{
+		{
+			name:          "missing",
+			serviceLabels: map[string][]string{conventions.AttributeServiceName: {"app.kubernetes.io/name"}},
+			expected:      "checkout",
+		},
+		{
+			name:          "explicit",
+			serviceLabels: map[string][]string{conventions.AttributeServiceName: {"app.kubernetes.io/name"}},
+			serviceName:   "cart",
+			expected:      "cart",
+		},
+		{
+			name:          "sdk_default",
+			serviceLabels: map[string][]string{conventions.AttributeServiceName: {"app.kubernetes.io/name"}},
+			serviceName:   "unknown_service:java",
+			expected:      "checkout",
+		},
+		{
+			name:        "sdk_default_not_inferred",
+			serviceName: "unknown_service:java",
+			expected:    "unknown_service:java",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			kc, err := newFakeClient(zap.NewNop(), k8sconfig.APIConfig{}, kube.ExtractionRules{}, kube.Filters{}, nil, kube.Excludes{}, nil, nil, nil, nil)
+			require.NoError(t, err)
+			kp := &kubernetesprocessor{
+				logger: zap.NewNop(),
+				kc:     kc,
+				rules:  kube.ExtractionRules{ServiceLabels: tt.serviceLabels},
+				podAssociations: []kube.Association{
+					{Sources: []kube.AssociationSource{{From: kube.ResourceSource, Name: "k8s.pod.uid"}}},
+				},
+			}
+			pi := kube.PodIdentifier{{Source: kube.AssociationSource{From: kube.ResourceSource, Name: "k8s.pod.uid"}, Value: "uid-1"}}
+			kc.(*fakeClient).Pods[pi] = &kube.Pod{Attributes: map[string]string{conventions.AttributeServiceName: "checkout"}}
+
+			resource := pcommon.NewResource()
+			resource.Attributes().PutStr("k8s.pod.uid", "uid-1")
+			if tt.serviceName != "" {
+				resource.Attributes().PutStr(conventions.AttributeServiceName, tt.serviceName)
+			}
+			kp.processResource(context.Background(), resource)
+			assertResourceHasStringAttribute(t, resource, conventions.AttributeServiceName, tt.expected)
+		})
+	}
+}
+
 func TestAddNodeUID(t *testing.T) {
 	nodeUID := "asdfasdf-asdfasdf-asdf"
 	m := newMultiTest(
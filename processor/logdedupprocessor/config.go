@@ -0,0 +1,50 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package logdedupprocessor // import "github.com/open-telemetry/opentelemetry-collector-contrib/processor/logdedupprocessor"
+
+import (
+	"errors"
+	"time"
+
+	"go.opentelemetry.io/collector/component"
+)
+
+// Config defines configuration for the log deduplication processor.
+type Config struct {
+	// Interval is the duration over which the identical log records are merged. The merged log records are exported
+	// at the end of each interval.
+	Interval time.Duration `mapstructure:"interval"`
+
+	// LogCountAttribute is the attribute set to the number of log records merged into the exported log record.
+	LogCountAttribute string `mapstructure:"log_count_attribute"`
+
+	// FirstTimestampAttribute is the attribute set to the timestamp of the first merged log record.
+	FirstTimestampAttribute string `mapstructure:"first_timestamp_attribute"`
+
+	// LastTimestampAttribute is the attribute set to the timestamp of the last merged log record.
+	LastTimestampAttribute string `mapstructure:"last_timestamp_attribute"`
+
+	// ExcludeAttributes are the log record attributes ignored when comparing the log records, e.g. request IDs. The
+	// exported log record keeps the values of the first merged log record.
+	ExcludeAttributes []string `mapstructure:"exclude_attributes"`
+}
+
+var _ component.Config = (*Config)(nil)
+
+// Validate checks if the processor configuration is valid.
+func (cfg *Config) Validate() error {
+	if cfg.Interval <= 0 {
+		return errors.New("interval must be positive")
+	}
+	if cfg.LogCountAttribute == "" {
+		return errors.New("log_count_attribute must not be empty")
+	}
+	if cfg.FirstTimestampAttribute == "" {
+		return errors.New("first_timestamp_attribute must not be empty")
+	}
+	if cfg.LastTimestampAttribute == "" {
+		return errors.New("last_timestamp_attribute must not be empty")
+	}
+	return nil
+}
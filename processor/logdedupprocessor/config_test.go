@@ -0,0 +1,75 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package logdedupprocessor
+
+import (
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/collector/component"
+	"go.opentelemetry.io/collector/confmap/confmaptest"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/processor/logdedupprocessor/internal/metadata"
+)
+
+func TestLoadConfig(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		id           component.ID
+		expected     component.Config
+		errorMessage string
+	}{
+		{
+			id: component.NewID(metadata.Type),
+			expected: &Config{
+				Interval:                time.Minute,
+				LogCountAttribute:       "dedup.count",
+				FirstTimestampAttribute: "dedup.first",
+				LastTimestampAttribute:  "dedup.last",
+				ExcludeAttributes:       []string{"request.id"},
+			},
+		},
+		{
+			id:           component.NewIDWithName(metadata.Type, "invalid_interval"),
+			errorMessage: "interval must be positive",
+		},
+		{
+			id:           component.NewIDWithName(metadata.Type, "empty_log_count_attribute"),
+			errorMessage: "log_count_attribute must not be empty",
+		},
+		{
+			id:           component.NewIDWithName(metadata.Type, "empty_first_timestamp_attribute"),
+			errorMessage: "first_timestamp_attribute must not be empty",
+		},
+		{
+			id:           component.NewIDWithName(metadata.Type, "empty_last_timestamp_attribute"),
+			errorMessage: "last_timestamp_attribute must not be empty",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.id.String(), func(t *testing.T) {
+			cm, err := confmaptest.LoadConf(filepath.Join("testdata", "config.yaml"))
+			require.NoError(t, err)
+
+			factory := NewFactory()
+			cfg := factory.CreateDefaultConfig()
+
+			sub, err := cm.Sub(tt.id.String())
+			require.NoError(t, err)
+			require.NoError(t, sub.Unmarshal(cfg))
+
+			if tt.errorMessage != "" {
+				assert.EqualError(t, component.ValidateConfig(cfg), tt.errorMessage)
+				return
+			}
+			assert.NoError(t, component.ValidateConfig(cfg))
+			assert.Equal(t, tt.expected, cfg)
+		})
+	}
+}
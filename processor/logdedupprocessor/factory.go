@@ -0,0 +1,47 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package logdedupprocessor // import "github.com/open-telemetry/opentelemetry-collector-contrib/processor/logdedupprocessor"
+
+import (
+	"context"
+	"time"
+
+	"go.opentelemetry.io/collector/component"
+	"go.opentelemetry.io/collector/consumer"
+	"go.opentelemetry.io/collector/processor"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/processor/logdedupprocessor/internal/metadata"
+)
+
+const (
+	defaultInterval                = 10 * time.Second
+	defaultLogCountAttribute       = "log.record.count"
+	defaultFirstTimestampAttribute = "log.record.first_timestamp"
+	defaultLastTimestampAttribute  = "log.record.last_timestamp"
+)
+
+// NewFactory returns a new factory for the log deduplication processor.
+func NewFactory() processor.Factory {
+	return processor.NewFactory(
+		metadata.Type,
+		createDefaultConfig,
+		processor.WithLogs(createLogsProcessor, metadata.LogsStability))
+}
+
+func createDefaultConfig() component.Config {
+	return &Config{
+		Interval:                defaultInterval,
+		LogCountAttribute:       defaultLogCountAttribute,
+		FirstTimestampAttribute: defaultFirstTimestampAttribute,
+		LastTimestampAttribute:  defaultLastTimestampAttribute,
+	}
+}
+
+func createLogsProcessor(
+	_ context.Context,
+	set processor.CreateSettings,
+	cfg component.Config,
+	nextConsumer consumer.Logs) (processor.Logs, error) {
+	return newLogDedupProcessor(set, cfg.(*Config), nextConsumer)
+}
@@ -0,0 +1,262 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package logdedupprocessor // import "github.com/open-telemetry/opentelemetry-collector-contrib/processor/logdedupprocessor"
+
+import (
+	"context"
+	"slices"
+	"sync"
+	"time"
+
+	"go.opentelemetry.io/collector/component"
+	"go.opentelemetry.io/collector/consumer"
+	"go.opentelemetry.io/collector/pdata/pcommon"
+	"go.opentelemetry.io/collector/pdata/plog"
+	"go.opentelemetry.io/collector/processor"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/metric"
+	"go.uber.org/zap"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/pdatautil"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/processor/logdedupprocessor/internal/metadata"
+)
+
+type resourceKey struct {
+	attributes [16]byte
+	schemaURL  string
+}
+
+type scopeKey struct {
+	resource   resourceKey
+	name       string
+	version    string
+	attributes [16]byte
+	schemaURL  string
+}
+
+// recordKey identifies the identical log records of a scope. The timestamps and the trace context aren't compared.
+type recordKey struct {
+	scope        scopeKey
+	severity     plog.SeverityNumber
+	severityText string
+	body         [16]byte
+	attributes   [16]byte
+}
+
+// aggregate is a log record the identical log records received during the interval are merged into.
+type aggregate struct {
+	record      plog.LogRecord
+	count       int64
+	first, last pcommon.Timestamp
+}
+
+type logDedupProcessor struct {
+	ctx    context.Context
+	cancel context.CancelFunc
+	wg     sync.WaitGroup
+	logger *zap.Logger
+
+	interval                time.Duration
+	logCountAttribute       string
+	firstTimestampAttribute string
+	lastTimestampAttribute  string
+	excludeAttributes       []string
+
+	telemetryBuilder *metadata.TelemetryBuilder
+	processorAttr    []attribute.KeyValue
+
+	mu             sync.Mutex
+	ld             plog.Logs
+	resourceLookup map[resourceKey]plog.ResourceLogs
+	scopeLookup    map[scopeKey]plog.ScopeLogs
+	recordLookup   map[recordKey]*aggregate
+
+	nextConsumer consumer.Logs
+}
+
+var _ processor.Logs = (*logDedupProcessor)(nil)
+
+func newLogDedupProcessor(set processor.CreateSettings, cfg *Config, nextConsumer consumer.Logs) (*logDedupProcessor, error) {
+	telemetryBuilder, err := metadata.NewTelemetryBuilder(set.TelemetrySettings)
+	if err != nil {
+		return nil, err
+	}
+	ctx, cancel := context.WithCancel(context.Background())
+	return &logDedupProcessor{
+		ctx:    ctx,
+		cancel: cancel,
+		logger: set.Logger,
+
+		interval:                cfg.Interval,
+		logCountAttribute:       cfg.LogCountAttribute,
+		firstTimestampAttribute: cfg.FirstTimestampAttribute,
+		lastTimestampAttribute:  cfg.LastTimestampAttribute,
+		excludeAttributes:       slices.Clone(cfg.ExcludeAttributes),
+
+		telemetryBuilder: telemetryBuilder,
+		processorAttr:    []attribute.KeyValue{attribute.String(metadata.Type.String(), set.ID.String())},
+
+		ld:             plog.NewLogs(),
+		resourceLookup: map[resourceKey]plog.ResourceLogs{},
+		scopeLookup:    map[scopeKey]plog.ScopeLogs{},
+		recordLookup:   map[recordKey]*aggregate{},
+
+		nextConsumer: nextConsumer,
+	}, nil
+}
+
+func (p *logDedupProcessor) Start(_ context.Context, _ component.Host) error {
+	ticker := time.NewTicker(p.interval)
+	p.wg.Add(1)
+	go func() {
+		defer p.wg.Done()
+		defer ticker.Stop()
+		for {
+			select {
+			case <-p.ctx.Done():
+				return
+			case <-ticker.C:
+				p.exportLogs()
+			}
+		}
+	}()
+	return nil
+}
+
+// Shutdown stops the exports at each interval, and exports the log records merged during the current interval.
+func (p *logDedupProcessor) Shutdown(ctx context.Context) error {
+	p.cancel()
+	p.wg.Wait()
+
+	ld := p.takeLogs()
+	if ld.ResourceLogs().Len() == 0 {
+		return nil
+	}
+	return p.nextConsumer.ConsumeLogs(ctx, ld)
+}
+
+func (p *logDedupProcessor) Capabilities() consumer.Capabilities {
+	return consumer.Capabilities{MutatesData: true}
+}
+
+// ConsumeLogs merges the log records into the identical log records received during the interval. The log records
+// are exported at the end of the interval.
+func (p *logDedupProcessor) ConsumeLogs(ctx context.Context, ld plog.Logs) error {
+	var deduplicated int64
+
+	p.mu.Lock()
+	for i := 0; i < ld.ResourceLogs().Len(); i++ {
+		rl := ld.ResourceLogs().At(i)
+		rKey := resourceKey{
+			attributes: pdatautil.MapHash(rl.Resource().Attributes()),
+			schemaURL:  rl.SchemaUrl(),
+		}
+		for j := 0; j < rl.ScopeLogs().Len(); j++ {
+			sl := rl.ScopeLogs().At(j)
+			sKey := scopeKey{
+				resource:   rKey,
+				name:       sl.Scope().Name(),
+				version:    sl.Scope().Version(),
+				attributes: pdatautil.MapHash(sl.Scope().Attributes()),
+				schemaURL:  sl.SchemaUrl(),
+			}
+			for k := 0; k < sl.LogRecords().Len(); k++ {
+				lr := sl.LogRecords().At(k)
+				key := recordKey{
+					scope:        sKey,
+					severity:     lr.SeverityNumber(),
+					severityText: lr.SeverityText(),
+					body:         pdatautil.ValueHash(lr.Body()),
+					attributes:   p.attributesHash(lr.Attributes()),
+				}
+				timestamp := recordTimestamp(lr)
+				if agg, ok := p.recordLookup[key]; ok {
+					agg.count++
+					agg.first = min(agg.first, timestamp)
+					agg.last = max(agg.last, timestamp)
+					deduplicated++
+					continue
+				}
+				record := p.getOrCreateScopeLogs(rl, sl, rKey, sKey).LogRecords().AppendEmpty()
+				lr.CopyTo(record)
+				p.recordLookup[key] = &aggregate{record: record, count: 1, first: timestamp, last: timestamp}
+			}
+		}
+	}
+	p.mu.Unlock()
+
+	if deduplicated > 0 {
+		p.telemetryBuilder.ProcessorLogdedupLogRecordsDeduplicated.Add(ctx, deduplicated, metric.WithAttributes(p.processorAttr...))
+	}
+	return nil
+}
+
+// attributesHash returns the hash of the attributes, without the excluded ones.
+func (p *logDedupProcessor) attributesHash(attributes pcommon.Map) [16]byte {
+	if len(p.excludeAttributes) == 0 {
+		return pdatautil.MapHash(attributes)
+	}
+	compared := pcommon.NewMap()
+	attributes.CopyTo(compared)
+	compared.RemoveIf(func(k string, _ pcommon.Value) bool {
+		return slices.Contains(p.excludeAttributes, k)
+	})
+	return pdatautil.MapHash(compared)
+}
+
+func (p *logDedupProcessor) getOrCreateScopeLogs(rl plog.ResourceLogs, sl plog.ScopeLogs, rKey resourceKey, sKey scopeKey) plog.ScopeLogs {
+	if scopeLogs, ok := p.scopeLookup[sKey]; ok {
+		return scopeLogs
+	}
+	resourceLogs, ok := p.resourceLookup[rKey]
+	if !ok {
+		resourceLogs = p.ld.ResourceLogs().AppendEmpty()
+		rl.Resource().CopyTo(resourceLogs.Resource())
+		resourceLogs.SetSchemaUrl(rl.SchemaUrl())
+		p.resourceLookup[rKey] = resourceLogs
+	}
+	scopeLogs := resourceLogs.ScopeLogs().AppendEmpty()
+	sl.Scope().CopyTo(scopeLogs.Scope())
+	scopeLogs.SetSchemaUrl(sl.SchemaUrl())
+	p.scopeLookup[sKey] = scopeLogs
+	return scopeLogs
+}
+
+// recordTimestamp returns the time of the event, or the time it was observed when unknown.
+func recordTimestamp(lr plog.LogRecord) pcommon.Timestamp {
+	if lr.Timestamp() != 0 {
+		return lr.Timestamp()
+	}
+	return lr.ObservedTimestamp()
+}
+
+func (p *logDedupProcessor) exportLogs() {
+	ld := p.takeLogs()
+	if ld.ResourceLogs().Len() == 0 {
+		return
+	}
+	if err := p.nextConsumer.ConsumeLogs(p.ctx, ld); err != nil {
+		p.logger.Error("Logs export failed", zap.Error(err))
+	}
+}
+
+// takeLogs returns the log records merged during the current interval, and starts a new interval.
+func (p *logDedupProcessor) takeLogs() plog.Logs {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	for _, agg := range p.recordLookup {
+		attributes := agg.record.Attributes()
+		attributes.PutInt(p.logCountAttribute, agg.count)
+		attributes.PutStr(p.firstTimestampAttribute, agg.first.AsTime().Format(time.RFC3339Nano))
+		attributes.PutStr(p.lastTimestampAttribute, agg.last.AsTime().Format(time.RFC3339Nano))
+	}
+
+	ld := p.ld
+	p.ld = plog.NewLogs()
+	clear(p.resourceLookup)
+	clear(p.scopeLookup)
+	clear(p.recordLookup)
+	return ld
+}
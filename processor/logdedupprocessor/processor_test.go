@@ -0,0 +1,198 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package logdedupprocessor
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/collector/component/componenttest"
+	"go.opentelemetry.io/collector/consumer/consumertest"
+	"go.opentelemetry.io/collector/pdata/pcommon"
+	"go.opentelemetry.io/collector/pdata/plog"
+	"go.opentelemetry.io/collector/processor"
+	"go.opentelemetry.io/collector/processor/processortest"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/sdk/metric/metricdata"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/pdatatest/plogtest"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/processor/logdedupprocessor/internal/metadata"
+)
+
+var baseTime = time.Date(2024, 6, 1, 12, 0, 0, 0, time.UTC)
+
+// testRecord is a log record of the service received at the offset from the base time.
+type testRecord struct {
+	service    string
+	body       string
+	attributes map[string]any
+	offset     time.Duration
+}
+
+func newLogs(records ...testRecord) plog.Logs {
+	ld := plog.NewLogs()
+	for _, r := range records {
+		rl := ld.ResourceLogs().AppendEmpty()
+		rl.Resource().Attributes().PutStr("service.name", r.service)
+		sl := rl.ScopeLogs().AppendEmpty()
+		sl.Scope().SetName("test")
+		lr := sl.LogRecords().AppendEmpty()
+		lr.SetSeverityNumber(plog.SeverityNumberError)
+		lr.SetSeverityText("ERROR")
+		lr.Body().SetStr(r.body)
+		if r.attributes != nil {
+			_ = lr.Attributes().FromRaw(r.attributes)
+		}
+		lr.SetTimestamp(pcommon.NewTimestampFromTime(baseTime.Add(r.offset)))
+	}
+	return ld
+}
+
+func newTestProcessor(t *testing.T, set processor.CreateSettings, cfg *Config, next *consumertest.LogsSink) *logDedupProcessor {
+	p, err := NewFactory().CreateLogsProcessor(context.Background(), set, cfg, next)
+	require.NoError(t, err)
+	return p.(*logDedupProcessor)
+}
+
+func TestConsumeLogsMergesIdenticalRecords(t *testing.T) {
+	tel := setupTestTelemetry()
+	next := new(consumertest.LogsSink)
+	p := newTestProcessor(t, tel.NewCreateSettings(), createDefaultConfig().(*Config), next)
+
+	require.NoError(t, p.ConsumeLogs(context.Background(), newLogs(
+		testRecord{service: "api", body: "connection refused", attributes: map[string]any{"db": "users"}, offset: time.Second},
+		testRecord{service: "api", body: "connection refused", attributes: map[string]any{"db": "users"}},
+		testRecord{service: "api", body: "connection refused", attributes: map[string]any{"db": "orders"}},
+		testRecord{service: "worker", body: "connection refused", attributes: map[string]any{"db": "users"}},
+	)))
+	require.NoError(t, p.ConsumeLogs(context.Background(), newLogs(
+		testRecord{service: "api", body: "connection refused", attributes: map[string]any{"db": "users"}, offset: 3 * time.Second},
+		testRecord{service: "api", body: "timeout", attributes: map[string]any{"db": "users"}, offset: 2 * time.Second},
+	)))
+	// the log records are held until the end of the interval
+	assert.Empty(t, next.AllLogs())
+
+	require.NoError(t, p.Shutdown(context.Background()))
+	require.Len(t, next.AllLogs(), 1)
+
+	expected := plog.NewLogs()
+	api := expected.ResourceLogs().AppendEmpty()
+	api.Resource().Attributes().PutStr("service.name", "api")
+	apiRecords := api.ScopeLogs().AppendEmpty()
+	apiRecords.Scope().SetName("test")
+	appendExpectedRecord(apiRecords, "connection refused", map[string]any{"db": "users"}, time.Second, 3, 0, 3*time.Second)
+	appendExpectedRecord(apiRecords, "connection refused", map[string]any{"db": "orders"}, 0, 1, 0, 0)
+	appendExpectedRecord(apiRecords, "timeout", map[string]any{"db": "users"}, 2*time.Second, 1, 2*time.Second, 2*time.Second)
+	worker := expected.ResourceLogs().AppendEmpty()
+	worker.Resource().Attributes().PutStr("service.name", "worker")
+	workerRecords := worker.ScopeLogs().AppendEmpty()
+	workerRecords.Scope().SetName("test")
+	appendExpectedRecord(workerRecords, "connection refused", map[string]any{"db": "users"}, 0, 1, 0, 0)
+	assert.NoError(t, plogtest.CompareLogs(expected, next.AllLogs()[0]))
+
+	tel.assertMetrics(t, []metricdata.Metrics{
+		{
+			Name:        "processor_logdedup_log_records.deduplicated",
+			Description: "Number of log records merged into an identical log record by the log deduplication processor",
+			Unit:        "1",
+			Data: metricdata.Sum[int64]{
+				Temporality: metricdata.CumulativeTemporality,
+				IsMonotonic: true,
+				DataPoints: []metricdata.DataPoint[int64]{
+					{
+						Value:      2,
+						Attributes: attribute.NewSet(attribute.String(metadata.Type.String(), "logdedup")),
+					},
+				},
+			},
+		},
+	})
+	require.NoError(t, tel.Shutdown(context.Background()))
+}
+
+// appendExpectedRecord appends the record exported for the first record received at the offset, merging count
+// records received between the first and last offsets.
+func appendExpectedRecord(sl plog.ScopeLogs, body string, attributes map[string]any, offset time.Duration, count int64, first, last time.Duration) {
+	lr := sl.LogRecords().AppendEmpty()
+	lr.SetSeverityNumber(plog.SeverityNumberError)
+	lr.SetSeverityText("ERROR")
+	lr.Body().SetStr(body)
+	_ = lr.Attributes().FromRaw(attributes)
+	lr.SetTimestamp(pcommon.NewTimestampFromTime(baseTime.Add(offset)))
+	lr.Attributes().PutInt("log.record.count", count)
+	lr.Attributes().PutStr("log.record.first_timestamp", baseTime.Add(first).Format(time.RFC3339Nano))
+	lr.Attributes().PutStr("log.record.last_timestamp", baseTime.Add(last).Format(time.RFC3339Nano))
+}
+
+func TestConsumeLogsExcludeAttributes(t *testing.T) {
+	cfg := createDefaultConfig().(*Config)
+	cfg.ExcludeAttributes = []string{"request.id"}
+	next := new(consumertest.LogsSink)
+	p := newTestProcessor(t, processortest.NewNopCreateSettings(), cfg, next)
+
+	require.NoError(t, p.ConsumeLogs(context.Background(), newLogs(
+		testRecord{service: "api", body: "not found", attributes: map[string]any{"request.id": "1", "path": "/a"}},
+		testRecord{service: "api", body: "not found", attributes: map[string]any{"request.id": "2", "path": "/a"}},
+		testRecord{service: "api", body: "not found", attributes: map[string]any{"request.id": "3", "path": "/b"}},
+	)))
+	require.NoError(t, p.Shutdown(context.Background()))
+
+	require.Len(t, next.AllLogs(), 1)
+	records := next.AllLogs()[0].ResourceLogs().At(0).ScopeLogs().At(0).LogRecords()
+	require.Equal(t, 2, records.Len())
+	// the merged record keeps the attributes of the first record
+	assert.Equal(t, map[string]any{
+		"request.id":                 "1",
+		"path":                       "/a",
+		"log.record.count":           int64(2),
+		"log.record.first_timestamp": baseTime.Format(time.RFC3339Nano),
+		"log.record.last_timestamp":  baseTime.Format(time.RFC3339Nano),
+	}, records.At(0).Attributes().AsRaw())
+	count, _ := records.At(1).Attributes().Get("log.record.count")
+	assert.Equal(t, int64(1), count.Int())
+}
+
+func TestConsumeLogsObservedTimestamp(t *testing.T) {
+	next := new(consumertest.LogsSink)
+	p := newTestProcessor(t, processortest.NewNopCreateSettings(), createDefaultConfig().(*Config), next)
+
+	ld := newLogs(testRecord{service: "api", body: "boom"}, testRecord{service: "api", body: "boom"})
+	// the records without a timestamp are timed when observed
+	for i, offset := range []time.Duration{5 * time.Second, time.Second} {
+		lr := ld.ResourceLogs().At(i).ScopeLogs().At(0).LogRecords().At(0)
+		lr.SetTimestamp(0)
+		lr.SetObservedTimestamp(pcommon.NewTimestampFromTime(baseTime.Add(offset)))
+	}
+	require.NoError(t, p.ConsumeLogs(context.Background(), ld))
+	require.NoError(t, p.Shutdown(context.Background()))
+
+	require.Len(t, next.AllLogs(), 1)
+	attributes := next.AllLogs()[0].ResourceLogs().At(0).ScopeLogs().At(0).LogRecords().At(0).Attributes()
+	first, _ := attributes.Get("log.record.first_timestamp")
+	last, _ := attributes.Get("log.record.last_timestamp")
+	assert.Equal(t, baseTime.Add(time.Second).Format(time.RFC3339Nano), first.Str())
+	assert.Equal(t, baseTime.Add(5*time.Second).Format(time.RFC3339Nano), last.Str())
+}
+
+func TestExportAtEachInterval(t *testing.T) {
+	cfg := createDefaultConfig().(*Config)
+	cfg.Interval = 10 * time.Millisecond
+	next := new(consumertest.LogsSink)
+	p := newTestProcessor(t, processortest.NewNopCreateSettings(), cfg, next)
+	require.NoError(t, p.Start(context.Background(), componenttest.NewNopHost()))
+
+	require.NoError(t, p.ConsumeLogs(context.Background(), newLogs(testRecord{service: "api", body: "boom"})))
+	assert.Eventually(t, func() bool { return next.LogRecordCount() == 1 }, 5*time.Second, 5*time.Millisecond)
+
+	// a new interval starts after the export
+	require.NoError(t, p.ConsumeLogs(context.Background(), newLogs(testRecord{service: "api", body: "boom"})))
+	assert.Eventually(t, func() bool { return next.LogRecordCount() == 2 }, 5*time.Second, 5*time.Millisecond)
+
+	require.NoError(t, p.Shutdown(context.Background()))
+	// the empty intervals aren't exported
+	assert.Len(t, next.AllLogs(), 2)
+}
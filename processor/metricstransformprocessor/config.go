@@ -36,6 +36,9 @@ const (
 
 	// submatchCaseFieldName is the mapstructure field name for submatchCase field
 	submatchCaseFieldName = "submatch_case"
+
+	// valueRegexpFieldName is the mapstructure field name for ValueRegexp field
+	valueRegexpFieldName = "value_regexp"
 )
 
 // Config defines configuration for Resource processor.
@@ -117,7 +120,11 @@ type Operation struct {
 	// AggregatedValues is a list of label values to aggregate away.
 	AggregatedValues []string `mapstructure:"aggregated_values"`
 
-	// NewValue is used to set a new label value either when the operation is `AggregatedValues` or `addLabel`.
+	// ValueRegexp is a regexp matching the label values to aggregate away.
+	ValueRegexp string `mapstructure:"value_regexp"`
+
+	// NewValue is used to set a new label value either when the operation is `AggregatedValues`, `aggregateLabelValuesRegexp`
+	// or `addLabel`. With `aggregateLabelValuesRegexp`, it can reference the submatches of ValueRegexp, e.g. `$1`.
 	NewValue string `mapstructure:"new_value"`
 
 	// ValueActions is a list of renaming actions for label values.
@@ -202,9 +209,15 @@ const (
 	// Metric has to match the FilterConfig with all its data points if used with Update ConfigAction,
 	// otherwise the operation will be ignored.
 	aggregateLabelValues operationAction = "aggregate_label_values"
+
+	// aggregateLabelValuesRegexp aggregates away the values matching Operation.ValueRegexp, rewritten to Operation.NewValue,
+	// by the method indicated by Operation.AggregationType.
+	// Metric has to match the FilterConfig with all its data points if used with Update ConfigAction,
+	// otherwise the operation will be ignored.
+	aggregateLabelValuesRegexp operationAction = "aggregate_label_values_regexp"
 )
 
-var operationActions = []operationAction{addLabel, updateLabel, deleteLabelValue, toggleScalarDataType, scaleValue, aggregateLabels, aggregateLabelValues}
+var operationActions = []operationAction{addLabel, updateLabel, deleteLabelValue, toggleScalarDataType, scaleValue, aggregateLabels, aggregateLabelValues, aggregateLabelValuesRegexp}
 
 func (oa operationAction) isValid() bool {
 	for _, operationAction := range operationActions {
@@ -106,6 +106,13 @@ func TestLoadConfig(t *testing.T) {
 								AggregatedValues: []string{"value1", "value2"},
 								NewValue:         "new_value",
 							},
+							{
+								Action:          "aggregate_label_values_regexp",
+								Label:           "path",
+								AggregationType: "sum",
+								ValueRegexp:     "^/api/users/[0-9]+$",
+								NewValue:        "/api/users/{id}",
+							},
 						},
 					},
 					{
@@ -113,6 +113,20 @@ func validateConfiguration(config *Config) error {
 			if op.Action == scaleValue && op.Scale == 0 {
 				return fmt.Errorf("operation %v: missing required field %q while %q is %v", i+1, scaleFieldName, actionFieldName, scaleValue)
 			}
+			if op.Action == aggregateLabelValuesRegexp {
+				if op.Label == "" {
+					return fmt.Errorf("operation %v: missing required field %q while %q is %v", i+1, labelFieldName, actionFieldName, aggregateLabelValuesRegexp)
+				}
+				if op.ValueRegexp == "" {
+					return fmt.Errorf("operation %v: missing required field %q while %q is %v", i+1, valueRegexpFieldName, actionFieldName, aggregateLabelValuesRegexp)
+				}
+				if _, err := regexp.Compile(op.ValueRegexp); err != nil {
+					return fmt.Errorf("operation %v: %q, %w", i+1, valueRegexpFieldName, err)
+				}
+				if op.NewValue == "" {
+					return fmt.Errorf("operation %v: missing required field %q while %q is %v", i+1, newValueFieldName, actionFieldName, aggregateLabelValuesRegexp)
+				}
+			}
 
 			if op.AggregationType != "" && !op.AggregationType.isValid() {
 				return fmt.Errorf("operation %v: %q must be in %q", i+1, aggregationTypeFieldName, aggregationTypes)
@@ -158,6 +172,8 @@ func buildHelperConfig(config *Config, version string) ([]internalTransform, err
 				mtpOp.labelSetMap = sliceToSet(op.LabelSet)
 			} else if op.Action == aggregateLabelValues {
 				mtpOp.aggregatedValuesSet = sliceToSet(op.AggregatedValues)
+			} else if op.Action == aggregateLabelValuesRegexp {
+				mtpOp.valueRegexp = regexp.MustCompile(op.ValueRegexp)
 			}
 			helperT.Operations[j] = mtpOp
 		}
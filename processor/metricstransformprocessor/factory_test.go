@@ -7,6 +7,7 @@ import (
 	"context"
 	"fmt"
 	"path/filepath"
+	"regexp"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
@@ -104,6 +105,16 @@ func TestCreateProcessors(t *testing.T) {
 			succeed:      false,
 			errorMessage: fmt.Sprintf("%q must be in %q", submatchCaseFieldName, submatchCases),
 		},
+		{
+			configName:   "config_invalid_value_regexp.yaml",
+			succeed:      false,
+			errorMessage: fmt.Sprintf("operation %v: %q, error parsing regexp: missing closing ]: `[0-9+$`", 1, valueRegexpFieldName),
+		},
+		{
+			configName:   "config_invalid_value_regexp_missing.yaml",
+			succeed:      false,
+			errorMessage: fmt.Sprintf("operation %v: missing required field %q while %q is %v", 1, valueRegexpFieldName, actionFieldName, aggregateLabelValuesRegexp),
+		},
 	}
 
 	for _, tt := range tests {
@@ -230,6 +241,13 @@ func TestCreateProcessorsFilledData(t *testing.T) {
 					NewValue:         "new-value",
 					AggregationType:  sum,
 				},
+				{
+					Action:          aggregateLabelValuesRegexp,
+					Label:           "label",
+					ValueRegexp:     "^value[0-9]+$",
+					NewValue:        "new-value",
+					AggregationType: sum,
+				},
 			},
 		},
 	}
@@ -285,6 +303,16 @@ func TestCreateProcessorsFilledData(t *testing.T) {
 						"value2": true,
 					},
 				},
+				{
+					configOperation: Operation{
+						Action:          aggregateLabelValuesRegexp,
+						Label:           "label",
+						ValueRegexp:     "^value[0-9]+$",
+						NewValue:        "new-value",
+						AggregationType: sum,
+					},
+					valueRegexp: regexp.MustCompile("^value[0-9]+$"),
+				},
 			},
 		},
 	}
@@ -303,6 +331,7 @@ func TestCreateProcessorsFilledData(t *testing.T) {
 			assert.Equal(t, expOp.valueActionsMapping, mtpOp.valueActionsMapping)
 			assert.Equal(t, expOp.labelSetMap, mtpOp.labelSetMap)
 			assert.Equal(t, expOp.aggregatedValuesSet, mtpOp.aggregatedValuesSet)
+			assert.Equal(t, expOp.valueRegexp, mtpOp.valueRegexp)
 		}
 	}
 }
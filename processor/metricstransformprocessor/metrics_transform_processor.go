@@ -33,6 +33,7 @@ type internalOperation struct {
 	valueActionsMapping map[string]string
 	labelSetMap         map[string]bool
 	aggregatedValuesSet map[string]bool
+	valueRegexp         *regexp.Regexp
 }
 
 type internalFilter interface {
@@ -547,6 +547,10 @@ func transformMetric(metric pmetric.Metric, transform internalTransform) bool {
 			if canChangeMetric {
 				aggregateLabelValuesOp(metric, op)
 			}
+		case aggregateLabelValuesRegexp:
+			if canChangeMetric {
+				aggregateLabelValuesRegexpOp(metric, op)
+			}
 		case toggleScalarDataType:
 			toggleScalarDataTypeOp(metric, transform.MetricIncludeFilter)
 		case scaleValue:
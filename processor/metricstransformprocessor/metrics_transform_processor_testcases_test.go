@@ -515,6 +515,57 @@ var (
 					build(),
 			},
 		},
+		{
+			name: "metric_label_values_regexp_aggregation_sum_update",
+			transforms: []internalTransform{
+				{
+					MetricIncludeFilter: internalFilterRegexp{include: regexp.MustCompile("^http\\..*$")},
+					Action:              Update,
+					Operations: []internalOperation{
+						{
+							configOperation: Operation{
+								Action:          aggregateLabelValuesRegexp,
+								Label:           "path",
+								ValueRegexp:     "^/api/([a-z]+)/[0-9]+$",
+								NewValue:        "/api/$1/{id}",
+								AggregationType: sum,
+							},
+							valueRegexp: regexp.MustCompile("^/api/([a-z]+)/[0-9]+$"),
+						},
+					},
+				},
+			},
+			in: []pmetric.Metric{
+				metricBuilder(pmetric.MetricTypeSum, "http.requests", "method", "path").
+					addIntDatapoint(1, 2, 3, "GET", "/api/users/123").
+					addIntDatapoint(1, 2, 1, "GET", "/api/users/456").
+					addIntDatapoint(1, 2, 2, "POST", "/api/users/789").
+					addIntDatapoint(1, 2, 5, "GET", "/api/orders/1").
+					addIntDatapoint(1, 2, 4, "GET", "/health").
+					build(),
+				metricBuilder(pmetric.MetricTypeGauge, "http.latency", "method", "path").
+					addDoubleDatapoint(1, 2, 0.5, "GET", "/api/users/123").
+					addDoubleDatapoint(1, 2, 1.5, "GET", "/api/users/456").
+					build(),
+				metricBuilder(pmetric.MetricTypeSum, "rpc.requests", "method", "path").
+					addIntDatapoint(1, 2, 3, "GET", "/api/users/123").
+					build(),
+			},
+			out: []pmetric.Metric{
+				metricBuilder(pmetric.MetricTypeSum, "http.requests", "method", "path").
+					addIntDatapoint(1, 2, 4, "GET", "/api/users/{id}").
+					addIntDatapoint(1, 2, 2, "POST", "/api/users/{id}").
+					addIntDatapoint(1, 2, 5, "GET", "/api/orders/{id}").
+					addIntDatapoint(1, 2, 4, "GET", "/health").
+					build(),
+				metricBuilder(pmetric.MetricTypeGauge, "http.latency", "method", "path").
+					addDoubleDatapoint(1, 2, 2, "GET", "/api/users/{id}").
+					build(),
+				metricBuilder(pmetric.MetricTypeSum, "rpc.requests", "method", "path").
+					addIntDatapoint(1, 2, 3, "GET", "/api/users/123").
+					build(),
+			},
+		},
 		// this test case also tests the correctness of the SumOfSquaredDeviation merging
 		{
 			name: "metric_label_values_aggregation_sum_distribution_update",
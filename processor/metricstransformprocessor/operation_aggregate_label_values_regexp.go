@@ -0,0 +1,33 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package metricstransformprocessor // import "github.com/open-telemetry/opentelemetry-collector-contrib/processor/metricstransformprocessor"
+
+import (
+	"go.opentelemetry.io/collector/pdata/pcommon"
+	"go.opentelemetry.io/collector/pdata/pmetric"
+)
+
+// aggregateLabelValuesRegexpOp aggregates points that have label values matching value_regexp, the values being
+// rewritten to new_value, expanded with the submatches of the regexp
+func aggregateLabelValuesRegexpOp(metric pmetric.Metric, mtpOp internalOperation) {
+	re := mtpOp.valueRegexp
+	rangeDataPointAttributes(metric, func(attrs pcommon.Map) bool {
+		val, ok := attrs.Get(mtpOp.configOperation.Label)
+		if !ok {
+			return true
+		}
+
+		value := val.AsString()
+		if match := re.FindStringSubmatchIndex(value); match != nil {
+			val.SetStr(string(re.ExpandString(nil, mtpOp.configOperation.NewValue, value, match)))
+		}
+		return true
+	})
+
+	newMetric := pmetric.NewMetric()
+	copyMetricDetails(metric, newMetric)
+	ag := groupDataPoints(metric, aggGroups{})
+	mergeDataPoints(newMetric, mtpOp.configOperation.AggregationType, ag)
+	newMetric.MoveTo(metric)
+}
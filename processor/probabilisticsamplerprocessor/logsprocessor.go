@@ -5,6 +5,8 @@ package probabilisticsamplerprocessor // import "github.com/open-telemetry/opent
 
 import (
 	"context"
+	"math"
+	"strconv"
 
 	"go.opentelemetry.io/collector/consumer"
 	"go.opentelemetry.io/collector/pdata/pcommon"
@@ -120,7 +122,12 @@ func (lsp *logsProcessor) priorityFunc(logRec plog.LogRecord, rnd randomnessName
 	// Note: in logs, unlike traces, the sampling priority
 	// attribute is interpreted as a request to be sampled.
 	if lsp.samplingPriority != "" {
-		priorityThreshold := lsp.logRecordToPriorityThreshold(logRec)
+		priorityThreshold, ok := lsp.logRecordToPriorityThreshold(logRec)
+		if !ok {
+			// The record has no sampling priority, the configured
+			// sampling percentage applies.
+			return rnd, threshold
+		}
 
 		if priorityThreshold == sampling.NeverSampleThreshold {
 			threshold = priorityThreshold
@@ -133,23 +140,43 @@ func (lsp *logsProcessor) priorityFunc(logRec plog.LogRecord, rnd randomnessName
 	return rnd, threshold
 }
 
-func (lsp *logsProcessor) logRecordToPriorityThreshold(logRec plog.LogRecord) sampling.Threshold {
-	if localPriority, ok := logRec.Attributes().Get(lsp.samplingPriority); ok {
-		// Potentially raise the sampling probability to minProb
-		minProb := 0.0
-		switch localPriority.Type() {
-		case pcommon.ValueTypeDouble:
-			minProb = localPriority.Double() / 100.0
-		case pcommon.ValueTypeInt:
-			minProb = float64(localPriority.Int()) / 100.0
-		}
-		if minProb != 0 {
-			if th, err := sampling.ProbabilityToThresholdWithPrecision(minProb, defaultPrecision); err == nil {
-				// The record has supplied a valid alternative sampling probability
-				return th
-			}
-
+// logRecordToPriorityThreshold returns the threshold of the sampling
+// priority of the log record, a percentage, or false if the record has
+// no valid sampling priority.
+func (lsp *logsProcessor) logRecordToPriorityThreshold(logRec plog.LogRecord) (sampling.Threshold, bool) {
+	localPriority, ok := logRec.Attributes().Get(lsp.samplingPriority)
+	if !ok {
+		return sampling.Threshold{}, false
+	}
+	// Potentially raise the sampling probability to minProb
+	var minProb float64
+	switch localPriority.Type() {
+	case pcommon.ValueTypeDouble:
+		minProb = localPriority.Double() / 100.0
+	case pcommon.ValueTypeInt:
+		minProb = float64(localPriority.Int()) / 100.0
+	case pcommon.ValueTypeStr:
+		// e.g. parsed from the body of the log record
+		pct, err := strconv.ParseFloat(localPriority.Str(), 64)
+		if err != nil {
+			return sampling.Threshold{}, false
 		}
+		minProb = pct / 100.0
+	default:
+		return sampling.Threshold{}, false
+	}
+	switch {
+	case math.IsNaN(minProb):
+		return sampling.Threshold{}, false
+	case minProb <= 0:
+		return sampling.NeverSampleThreshold, true
+	case minProb >= 1:
+		return sampling.AlwaysSampleThreshold, true
+	}
+	th, err := sampling.ProbabilityToThresholdWithPrecision(minProb, defaultPrecision)
+	if err != nil {
+		return sampling.Threshold{}, false
 	}
-	return sampling.NeverSampleThreshold
+	// The record has supplied a valid alternative sampling probability
+	return th, true
 }
@@ -5,6 +5,7 @@ package probabilisticsamplerprocessor
 
 import (
 	"context"
+	"encoding/binary"
 	"fmt"
 	"testing"
 	"time"
@@ -15,6 +16,7 @@ import (
 	"go.opentelemetry.io/collector/consumer/consumertest"
 	"go.opentelemetry.io/collector/pdata/pcommon"
 	"go.opentelemetry.io/collector/pdata/plog"
+	"go.opentelemetry.io/collector/pdata/ptrace"
 	"go.opentelemetry.io/collector/processor/processortest"
 	"go.uber.org/zap"
 	"go.uber.org/zap/zaptest/observer"
@@ -151,6 +153,17 @@ func TestLogsSampling(t *testing.T) {
 			},
 			received: 25,
 		},
+		{
+			name: "sampling_priority with sampling percentage",
+			cfg: &Config{
+				SamplingPercentage: 50,
+				SamplingPriority:   "priority",
+				FailClosed:         true,
+			},
+			// The 25 records with a priority, and the sampled
+			// ones of the others.
+			received: 57,
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
@@ -191,6 +204,99 @@ func TestLogsSampling(t *testing.T) {
 	}
 }
 
+func TestLogsSamplingPriority(t *testing.T) {
+	tests := []struct {
+		name     string
+		pct      float32
+		priority pcommon.Value
+		sampled  bool
+	}{
+		{"no priority, sampled", 100, pcommon.NewValueEmpty(), true},
+		{"no priority, not sampled", 0, pcommon.NewValueEmpty(), false},
+		{"zero", 100, pcommon.NewValueInt(0), false},
+		{"negative", 100, pcommon.NewValueDouble(-1), false},
+		{"int", 0, pcommon.NewValueInt(100), true},
+		{"double", 0, pcommon.NewValueDouble(100), true},
+		{"above 100", 0, pcommon.NewValueInt(250), true},
+		{"string", 0, pcommon.NewValueStr("100"), true},
+		{"string zero", 100, pcommon.NewValueStr("0"), false},
+		{"invalid string", 100, pcommon.NewValueStr("high"), true},
+		{"unsupported type", 100, pcommon.NewValueBool(true), true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			sink := new(consumertest.LogsSink)
+			cfg := &Config{
+				SamplingPercentage: tt.pct,
+				SamplingPriority:   "sampling.priority",
+			}
+			processor, err := newLogsProcessor(context.Background(), processortest.NewNopCreateSettings(), sink, cfg)
+			require.NoError(t, err)
+
+			logs := plog.NewLogs()
+			record := logs.ResourceLogs().AppendEmpty().ScopeLogs().AppendEmpty().LogRecords().AppendEmpty()
+			record.SetTraceID(pcommon.TraceID{1, 2, 3, 4, 5, 6, 7, 8, 9, 10, 11, 12, 13, 14, 15, 16})
+			if tt.priority.Type() != pcommon.ValueTypeEmpty {
+				tt.priority.CopyTo(record.Attributes().PutEmpty("sampling.priority"))
+			}
+
+			require.NoError(t, processor.ConsumeLogs(context.Background(), logs))
+			assert.Equal(t, tt.sampled, sink.LogRecordCount() == 1)
+		})
+	}
+}
+
+// TestLogsConsistentWithTraces verifies that log records are sampled
+// along with the spans of their trace.
+func TestLogsConsistentWithTraces(t *testing.T) {
+	for _, pct := range []float32{1, 10, 33.3, 50, 75} {
+		t.Run(fmt.Sprint(pct), func(t *testing.T) {
+			cfg := &Config{
+				SamplingPercentage: pct,
+				HashSeed:           defaultHashSeed,
+			}
+			logsSink := new(consumertest.LogsSink)
+			lp, err := newLogsProcessor(context.Background(), processortest.NewNopCreateSettings(), logsSink, cfg)
+			require.NoError(t, err)
+			tracesSink := new(consumertest.TracesSink)
+			tp, err := newTracesProcessor(context.Background(), processortest.NewNopCreateSettings(), cfg, tracesSink)
+			require.NoError(t, err)
+
+			logs := plog.NewLogs()
+			records := logs.ResourceLogs().AppendEmpty().ScopeLogs().AppendEmpty().LogRecords()
+			traces := ptrace.NewTraces()
+			spans := traces.ResourceSpans().AppendEmpty().ScopeSpans().AppendEmpty().Spans()
+			for i := 1; i <= 1000; i++ {
+				var traceID pcommon.TraceID
+				binary.BigEndian.PutUint64(traceID[:8], uint64(i))
+				binary.BigEndian.PutUint64(traceID[8:], uint64(i)*0x9e3779b97f4a7c15)
+				records.AppendEmpty().SetTraceID(traceID)
+				spans.AppendEmpty().SetTraceID(traceID)
+			}
+
+			require.NoError(t, lp.ConsumeLogs(context.Background(), logs))
+			require.NoError(t, tp.ConsumeTraces(context.Background(), traces))
+
+			sampledLogs := map[pcommon.TraceID]bool{}
+			for _, ld := range logsSink.AllLogs() {
+				lrs := ld.ResourceLogs().At(0).ScopeLogs().At(0).LogRecords()
+				for i := 0; i < lrs.Len(); i++ {
+					sampledLogs[lrs.At(i).TraceID()] = true
+				}
+			}
+			sampledSpans := map[pcommon.TraceID]bool{}
+			for _, td := range tracesSink.AllTraces() {
+				ss := td.ResourceSpans().At(0).ScopeSpans().At(0).Spans()
+				for i := 0; i < ss.Len(); i++ {
+					sampledSpans[ss.At(i).TraceID()] = true
+				}
+			}
+			assert.NotEmpty(t, sampledLogs)
+			assert.Equal(t, sampledSpans, sampledLogs)
+		})
+	}
+}
+
 func TestLogsMissingRandomness(t *testing.T) {
 	type test struct {
 		pct        float32
@@ -268,7 +268,9 @@ func makeSampler(cfg *Config) dataSampler {
 		hashSeed:        cfg.HashSeed,
 
 		// Logs specific:
-		logsTraceIDEnabled:            cfg.AttributeSource == traceIDAttributeSource,
+		// An unset attribute source defaults to the trace ID, so that log
+		// records are sampled consistently with the spans of their trace.
+		logsTraceIDEnabled:            cfg.AttributeSource != recordAttributeSource,
 		logsRandomnessSourceAttribute: cfg.FromAttribute,
 	}
 }
@@ -3,6 +3,12 @@
 
 package redactionprocessor // import "github.com/open-telemetry/opentelemetry-collector-contrib/processor/redactionprocessor"
 
+import (
+	"fmt"
+
+	"go.opentelemetry.io/collector/config/configopaque"
+)
+
 type Config struct {
 
 	// AllowAllKeys is a flag to allow all span attribute keys. Setting this
@@ -24,6 +30,17 @@ type Config struct {
 	// allowed span attributes. Values that match are masked
 	BlockedValues []string `mapstructure:"blocked_values"`
 
+	// BlockedValueRules is a list of regular expressions for blocking values
+	// of allowed span attributes and log bodies, each one with the mode used
+	// to redact the matching values. The rules are applied in order, after
+	// the BlockedValues.
+	BlockedValueRules []BlockedValueRule `mapstructure:"blocked_value_rules"`
+
+	// HashSalt is prepended to the values redacted by the rules of the hash
+	// mode before hashing them, so that the hashes can't be reversed by
+	// hashing the likely values, e.g. all the credit card numbers.
+	HashSalt configopaque.String `mapstructure:"hash_salt"`
+
 	// Summary controls the verbosity level of the diagnostic attributes that
 	// the processor adds to the spans when it redacts or masks other
 	// attributes. In some contexts a list of redacted attributes leaks
@@ -31,3 +48,43 @@ type Config struct {
 	// configuration. Possible values are `debug`, `info`, and `silent`.
 	Summary string `mapstructure:"summary"`
 }
+
+// BlockedValueRule is a regular expression for blocking values, with the
+// mode used to redact the matching values.
+type BlockedValueRule struct {
+	// Pattern is the regular expression matching the values to redact.
+	Pattern string `mapstructure:"pattern"`
+
+	// Mode is the way the values are redacted, `mask` by default.
+	Mode RedactionMode `mapstructure:"mode"`
+}
+
+// RedactionMode is the way the values matching a blocked value are redacted.
+type RedactionMode string
+
+const (
+	// ModeMask replaces the values with a fixed length of asterisks.
+	ModeMask RedactionMode = "mask"
+	// ModeKeepLast4 replaces all but the last 4 characters of the values
+	// with asterisks, e.g. "************1111" for a credit card number.
+	ModeKeepLast4 RedactionMode = "keep_last_4"
+	// ModeHash replaces the values with the hex-encoded SHA-256 hash of the
+	// salt and the value, so that the redacted values can still be
+	// correlated.
+	ModeHash RedactionMode = "hash"
+)
+
+// Validate checks if the processor configuration is valid
+func (cfg *Config) Validate() error {
+	for i, rule := range cfg.BlockedValueRules {
+		if rule.Pattern == "" {
+			return fmt.Errorf("blocked_value_rules[%d]: pattern must be specified", i)
+		}
+		switch rule.Mode {
+		case "", ModeMask, ModeKeepLast4, ModeHash:
+		default:
+			return fmt.Errorf("blocked_value_rules[%d]: invalid mode %q, must be one of %q, %q or %q", i, rule.Mode, ModeMask, ModeKeepLast4, ModeHash)
+		}
+	}
+	return nil
+}
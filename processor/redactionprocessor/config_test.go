@@ -19,8 +19,9 @@ func TestLoadConfig(t *testing.T) {
 	t.Parallel()
 
 	tests := []struct {
-		id       component.ID
-		expected component.Config
+		id          component.ID
+		expected    component.Config
+		expectedErr string
 	}{
 		{
 			id: component.NewIDWithName(metadata.Type, ""),
@@ -29,13 +30,27 @@ func TestLoadConfig(t *testing.T) {
 				AllowedKeys:   []string{"description", "group", "id", "name"},
 				IgnoredKeys:   []string{"safe_attribute"},
 				BlockedValues: []string{"4[0-9]{12}(?:[0-9]{3})?", "(5[1-5][0-9]{14})"},
-				Summary:       debug,
+				BlockedValueRules: []BlockedValueRule{
+					{Pattern: "3[47][0-9]{13}", Mode: ModeKeepLast4},
+					{Pattern: "[a-zA-Z0-9._%+-]+@[a-zA-Z0-9.-]+\\.[a-zA-Z]{2,}", Mode: ModeHash},
+					{Pattern: "token-[0-9a-f]+"},
+				},
+				HashSalt: "pepper",
+				Summary:  debug,
 			},
 		},
 		{
 			id:       component.NewIDWithName(metadata.Type, "empty"),
 			expected: createDefaultConfig(),
 		},
+		{
+			id:          component.NewIDWithName(metadata.Type, "invalid-mode"),
+			expectedErr: `blocked_value_rules[0]: invalid mode "encrypt", must be one of "mask", "keep_last_4" or "hash"`,
+		},
+		{
+			id:          component.NewIDWithName(metadata.Type, "invalid-no-pattern"),
+			expectedErr: "blocked_value_rules[0]: pattern must be specified",
+		},
 	}
 
 	for _, tt := range tests {
@@ -50,6 +65,10 @@ func TestLoadConfig(t *testing.T) {
 			require.NoError(t, err)
 			require.NoError(t, sub.Unmarshal(cfg))
 
+			if tt.expectedErr != "" {
+				assert.EqualError(t, component.ValidateConfig(cfg), tt.expectedErr)
+				return
+			}
 			assert.NoError(t, component.ValidateConfig(cfg))
 			assert.Equal(t, tt.expected, cfg)
 		})
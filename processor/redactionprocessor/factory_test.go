@@ -26,3 +26,19 @@ func TestCreateTestProcessor(t *testing.T) {
 	assert.NotNil(t, tp)
 	assert.Equal(t, true, tp.Capabilities().MutatesData)
 }
+
+func TestCreateLogsProcessor(t *testing.T) {
+	cfg := &Config{}
+
+	lp, err := createLogsProcessor(context.Background(), processortest.NewNopCreateSettings(), cfg, consumertest.NewNop())
+	assert.NoError(t, err)
+	assert.NotNil(t, lp)
+	assert.Equal(t, true, lp.Capabilities().MutatesData)
+}
+
+func TestCreateProcessorInvalidPattern(t *testing.T) {
+	cfg := &Config{BlockedValueRules: []BlockedValueRule{{Pattern: "(", Mode: ModeHash}}}
+
+	_, err := createLogsProcessor(context.Background(), processortest.NewNopCreateSettings(), cfg, consumertest.NewNop())
+	assert.ErrorContains(t, err, "error compiling regex in block list")
+}
@@ -5,12 +5,16 @@ package redactionprocessor // import "github.com/open-telemetry/opentelemetry-co
 
 import (
 	"context"
+	"crypto/sha256"
+	"encoding/hex"
 	"fmt"
 	"regexp"
 	"sort"
 	"strings"
+	"unicode/utf8"
 
 	"go.opentelemetry.io/collector/pdata/pcommon"
+	"go.opentelemetry.io/collector/pdata/plog"
 	"go.opentelemetry.io/collector/pdata/ptrace"
 	"go.uber.org/zap"
 )
@@ -22,8 +26,8 @@ type redaction struct {
 	allowList map[string]string
 	// Attribute keys ignored in a span
 	ignoreList map[string]string
-	// Attribute values blocked in a span
-	blockRegexList map[string]*regexp.Regexp
+	// Attribute values blocked in a span, in order
+	blockRules []blockRule
 	// Redaction processor configuration
 	config *Config
 	// Logger
@@ -34,18 +38,18 @@ type redaction struct {
 func newRedaction(ctx context.Context, config *Config, logger *zap.Logger) (*redaction, error) {
 	allowList := makeAllowList(config)
 	ignoreList := makeIgnoreList(config)
-	blockRegexList, err := makeBlockRegexList(ctx, config)
+	blockRules, err := makeBlockRules(ctx, config)
 	if err != nil {
 		// TODO: Placeholder for an error metric in the next PR
 		return nil, fmt.Errorf("failed to process block list: %w", err)
 	}
 
 	return &redaction{
-		allowList:      allowList,
-		ignoreList:     ignoreList,
-		blockRegexList: blockRegexList,
-		config:         config,
-		logger:         logger,
+		allowList:  allowList,
+		ignoreList: ignoreList,
+		blockRules: blockRules,
+		config:     config,
+		logger:     logger,
 	}, nil
 }
 
@@ -79,7 +83,35 @@ func (s *redaction) processResourceSpan(ctx context.Context, rs ptrace.ResourceS
 	}
 }
 
-// processAttrs redacts the attributes of a resource span or a span
+// processLogs implements ProcessLogsFunc. It processes the incoming data
+// and returns the data to be sent to the next component
+func (s *redaction) processLogs(ctx context.Context, logs plog.Logs) (plog.Logs, error) {
+	for i := 0; i < logs.ResourceLogs().Len(); i++ {
+		rl := logs.ResourceLogs().At(i)
+		s.processAttrs(ctx, rl.Resource().Attributes())
+		for j := 0; j < rl.ScopeLogs().Len(); j++ {
+			sl := rl.ScopeLogs().At(j)
+			for k := 0; k < sl.LogRecords().Len(); k++ {
+				record := sl.LogRecords().At(k)
+				s.processAttrs(ctx, record.Attributes())
+				s.processBody(record.Body())
+			}
+		}
+	}
+	return logs, nil
+}
+
+// processBody masks the blocked values of a string log body
+func (s *redaction) processBody(body pcommon.Value) {
+	if body.Type() != pcommon.ValueTypeStr {
+		return
+	}
+	if masked, ok := s.maskValue(body.Str()); ok {
+		body.SetStr(masked)
+	}
+}
+
+// processAttrs redacts the attributes of a resource, a span or a log record
 func (s *redaction) processAttrs(_ context.Context, attributes pcommon.Map) {
 	// TODO: Use the context for recording metrics
 	var toDelete []string
@@ -112,20 +144,9 @@ func (s *redaction) processAttrs(_ context.Context, attributes pcommon.Map) {
 		}
 
 		// Mask any blocked values for the other attributes
-		strVal := value.Str()
-		var matched bool
-		for _, compiledRE := range s.blockRegexList {
-			match := compiledRE.MatchString(strVal)
-			if match {
-				if !matched {
-					matched = true
-					toBlock = append(toBlock, k)
-				}
-
-				maskedValue := compiledRE.ReplaceAllString(strVal, "****")
-				value.SetStr(maskedValue)
-				strVal = maskedValue
-			}
+		if masked, ok := s.maskValue(value.Str()); ok {
+			toBlock = append(toBlock, k)
+			value.SetStr(masked)
 		}
 		return true
 	})
@@ -140,6 +161,19 @@ func (s *redaction) processAttrs(_ context.Context, attributes pcommon.Map) {
 	s.addMetaAttrs(ignoring, attributes, "", ignoredKeyCount)
 }
 
+// maskValue redacts the parts of the value matching the blocked values,
+// returning whether any did
+func (s *redaction) maskValue(value string) (string, bool) {
+	var matched bool
+	for _, rule := range s.blockRules {
+		if rule.re.MatchString(value) {
+			matched = true
+			value = rule.re.ReplaceAllStringFunc(value, rule.redact)
+		}
+	}
+	return value, matched
+}
+
 // addMetaAttrs adds diagnostic information about redacted or masked attribute keys
 func (s *redaction) addMetaAttrs(redactedAttrs []string, attributes pcommon.Map, valuesAttr, countAttr string) {
 	redactedCount := int64(len(redactedAttrs))
@@ -207,16 +241,64 @@ func makeIgnoreList(c *Config) map[string]string {
 	return ignoreList
 }
 
-// makeBlockRegexList precompiles all the blocked regex patterns
-func makeBlockRegexList(_ context.Context, config *Config) (map[string]*regexp.Regexp, error) {
-	blockRegexList := make(map[string]*regexp.Regexp, len(config.BlockedValues))
+// blockRule is a precompiled blocked regex pattern with the function
+// redacting its matches
+type blockRule struct {
+	re     *regexp.Regexp
+	redact func(string) string
+}
+
+// makeBlockRules precompiles all the blocked regex patterns, the blocked
+// values first then the blocked value rules
+func makeBlockRules(_ context.Context, config *Config) ([]blockRule, error) {
+	blockRules := make([]blockRule, 0, len(config.BlockedValues)+len(config.BlockedValueRules))
 	for _, pattern := range config.BlockedValues {
 		re, err := regexp.Compile(pattern)
 		if err != nil {
 			// TODO: Placeholder for an error metric in the next PR
 			return nil, fmt.Errorf("error compiling regex in block list: %w", err)
 		}
-		blockRegexList[pattern] = re
+		blockRules = append(blockRules, blockRule{re: re, redact: mask})
+	}
+	for _, rule := range config.BlockedValueRules {
+		re, err := regexp.Compile(rule.Pattern)
+		if err != nil {
+			return nil, fmt.Errorf("error compiling regex in block list: %w", err)
+		}
+		redact := mask
+		switch rule.Mode {
+		case ModeKeepLast4:
+			redact = keepLast4
+		case ModeHash:
+			salt := string(config.HashSalt)
+			redact = func(value string) string {
+				return hash(salt, value)
+			}
+		}
+		blockRules = append(blockRules, blockRule{re: re, redact: redact})
 	}
-	return blockRegexList, nil
+	return blockRules, nil
+}
+
+// mask replaces the value with a fixed length of asterisks
+func mask(string) string {
+	return "****"
+}
+
+// keepLast4 replaces all but the last 4 characters of the value with
+// asterisks, or the whole value if it's not longer than 4 characters
+func keepLast4(value string) string {
+	count := utf8.RuneCountInString(value)
+	if count <= 4 {
+		return mask(value)
+	}
+	runes := []rune(value)
+	return strings.Repeat("*", count-4) + string(runes[count-4:])
+}
+
+// hash replaces the value with the hex-encoded SHA-256 hash of the salt and
+// the value
+func hash(salt, value string) string {
+	sum := sha256.Sum256([]byte(salt + value))
+	return hex.EncodeToString(sum[:])
 }
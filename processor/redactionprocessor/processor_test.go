@@ -12,6 +12,7 @@ import (
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 	"go.opentelemetry.io/collector/pdata/pcommon"
+	"go.opentelemetry.io/collector/pdata/plog"
 	"go.opentelemetry.io/collector/pdata/ptrace"
 	"go.uber.org/zap/zaptest"
 )
@@ -367,6 +368,104 @@ func TestProcessAttrsAppliedTwice(t *testing.T) {
 	assert.Equal(t, int64(2), val.Int())
 }
 
+// TestBlockedValueRules validates that the processor redacts the values
+// matching the blocked value rules with their mode, in order
+func TestBlockedValueRules(t *testing.T) {
+	config := &Config{
+		AllowAllKeys: true,
+		BlockedValueRules: []BlockedValueRule{
+			{Pattern: "4[0-9]{12}(?:[0-9]{3})?", Mode: ModeKeepLast4},
+			{Pattern: "[a-z]+@example\\.com", Mode: ModeHash},
+			{Pattern: "secret-[0-9]+"},
+			{Pattern: "\\b[0-9]{3}\\b"},
+		},
+		HashSalt: "pepper",
+		Summary:  "debug",
+	}
+	masked := map[string]pcommon.Value{
+		"card":    pcommon.NewValueStr("card 4111111111111111"),
+		"email":   pcommon.NewValueStr("john@example.com"),
+		"token":   pcommon.NewValueStr("token secret-42"),
+		"short":   pcommon.NewValueStr("123"),
+		"unicode": pcommon.NewValueStr("4111111111111111 é"),
+	}
+
+	outTraces := runTest(t, nil, nil, masked, nil, config)
+
+	attr := outTraces.ResourceSpans().At(0).ScopeSpans().At(0).Spans().At(0).Attributes()
+	expected := map[string]string{
+		"card":    "card ************1111",
+		"email":   "309fbb5ee672993f074eac80a8c68f778f12b8bb0363226b1eff8629a5667910",
+		"token":   "token ****",
+		"short":   "****",
+		"unicode": "************1111 é",
+	}
+	for k, v := range expected {
+		val, ok := attr.Get(k)
+		assert.True(t, ok)
+		assert.Equal(t, v, val.Str(), k)
+	}
+	maskedKeys, ok := attr.Get(maskedValues)
+	assert.True(t, ok)
+	assert.Equal(t, "card,email,short,token,unicode", maskedKeys.Str())
+}
+
+func TestKeepLast4(t *testing.T) {
+	assert.Equal(t, "************1111", keepLast4("4111111111111111"))
+	assert.Equal(t, "*ábcd", keepLast4("xábcd"))
+	assert.Equal(t, "****", keepLast4("abcd"))
+	assert.Equal(t, "****", keepLast4("ab"))
+}
+
+func TestHash(t *testing.T) {
+	assert.Equal(t, "17377e973e763f2b43b26fc71306e7ea51306efeb769d1e32507bec9ee848709", hash("pepper", "4111111111111111"))
+	// the same value is hashed the same way, so that it can be correlated
+	assert.Equal(t, hash("pepper", "4111111111111111"), hash("pepper", "4111111111111111"))
+	assert.NotEqual(t, hash("pepper", "4111111111111111"), hash("salt", "4111111111111111"))
+}
+
+// TestProcessLogs validates that the processor redacts the attributes of the
+// log records and masks the blocked values of their bodies
+func TestProcessLogs(t *testing.T) {
+	config := &Config{
+		AllowedKeys:       []string{"user", "service.name"},
+		BlockedValues:     []string{"4[0-9]{12}(?:[0-9]{3})?"},
+		BlockedValueRules: []BlockedValueRule{{Pattern: "[a-z]+@example\\.com", Mode: ModeHash}},
+		HashSalt:          "pepper",
+		Summary:           "info",
+	}
+	logs := plog.NewLogs()
+	rl := logs.ResourceLogs().AppendEmpty()
+	rl.Resource().Attributes().PutStr("service.name", "checkout")
+	rl.Resource().Attributes().PutStr("host.name", "checkout-1")
+	records := rl.ScopeLogs().AppendEmpty().LogRecords()
+	record := records.AppendEmpty()
+	record.Attributes().PutStr("user", "john@example.com")
+	record.Attributes().PutStr("password", "hunter2")
+	record.Body().SetStr("payment of john@example.com with 4111111111111111 accepted")
+	mapBody := records.AppendEmpty()
+	mapBody.Body().SetEmptyMap().PutStr("card", "4111111111111111")
+
+	processor, err := newRedaction(context.Background(), config, zaptest.NewLogger(t))
+	require.NoError(t, err)
+	logs, err = processor.processLogs(context.Background(), logs)
+	require.NoError(t, err)
+
+	assert.Equal(t, map[string]any{
+		"service.name":   "checkout",
+		redactedKeyCount: int64(1),
+	}, logs.ResourceLogs().At(0).Resource().Attributes().AsRaw())
+	records = logs.ResourceLogs().At(0).ScopeLogs().At(0).LogRecords()
+	assert.Equal(t, map[string]any{
+		"user":           "309fbb5ee672993f074eac80a8c68f778f12b8bb0363226b1eff8629a5667910",
+		redactedKeyCount: int64(1),
+		maskedValueCount: int64(1),
+	}, records.At(0).Attributes().AsRaw())
+	assert.Equal(t, "payment of 309fbb5ee672993f074eac80a8c68f778f12b8bb0363226b1eff8629a5667910 with **** accepted", records.At(0).Body().Str())
+	// only the string bodies are masked
+	assert.Equal(t, map[string]any{"card": "4111111111111111"}, records.At(1).Body().AsRaw())
+}
+
 // runTest transforms the test input data and passes it through the processor
 func runTest(
 	t *testing.T,
@@ -0,0 +1,112 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package resourceauthorityprocessor // import "github.com/open-telemetry/opentelemetry-collector-contrib/processor/resourceauthorityprocessor"
+
+import (
+	"errors"
+	"fmt"
+
+	"go.opentelemetry.io/collector/component"
+)
+
+// TrustLevel defines who is allowed to set a resource attribute.
+type TrustLevel string
+
+const (
+	// TrustClient accepts the value sent by the client, optionally restricted to a set of allowed values.
+	TrustClient TrustLevel = "client"
+	// TrustCollector only accepts the value determined by the collector.
+	TrustCollector TrustLevel = "collector"
+)
+
+// ViolationAction defines what happens when a resource carries an untrusted value.
+type ViolationAction string
+
+const (
+	// ActionOverwrite replaces the untrusted value with the value determined by the collector.
+	ActionOverwrite ViolationAction = "overwrite"
+	// ActionDelete removes the untrusted attribute from the resource.
+	ActionDelete ViolationAction = "delete"
+	// ActionReject refuses the whole request with a permanent error.
+	ActionReject ViolationAction = "reject"
+)
+
+// Config defines configuration for the resource authority processor.
+type Config struct {
+	// Attributes lists the resource attributes under the processor's authority.
+	Attributes []AttributeConfig `mapstructure:"attributes"`
+}
+
+// AttributeConfig defines the authority rule for a single resource attribute.
+type AttributeConfig struct {
+	// Key is the resource attribute key.
+	Key string `mapstructure:"key"`
+
+	// Trust is either "client" or "collector".
+	Trust TrustLevel `mapstructure:"trust"`
+
+	// Value is the static value set by the collector.
+	Value string `mapstructure:"value"`
+
+	// FromContext takes the value set by the collector from the request context.
+	// Use "metadata.<key>" for client metadata (e.g. HTTP headers) or "auth.<attribute>"
+	// for attributes populated by an authenticator.
+	FromContext string `mapstructure:"from_context"`
+
+	// AllowedValues restricts the values a client may set. Only valid with the "client" trust level.
+	// An empty list accepts any value.
+	AllowedValues []string `mapstructure:"allowed_values"`
+
+	// OnViolation is one of "overwrite", "delete" or "reject". Defaults to "overwrite"
+	// for the "collector" trust level and "delete" for the "client" trust level.
+	OnViolation ViolationAction `mapstructure:"on_violation"`
+}
+
+var _ component.Config = (*Config)(nil)
+
+// Validate checks if the processor configuration is valid
+func (cfg *Config) Validate() error {
+	if len(cfg.Attributes) == 0 {
+		return errors.New("missing required field \"attributes\"")
+	}
+	keys := make(map[string]struct{}, len(cfg.Attributes))
+	for i, attr := range cfg.Attributes {
+		if attr.Key == "" {
+			return fmt.Errorf("attributes[%d]: missing required field \"key\"", i)
+		}
+		if _, ok := keys[attr.Key]; ok {
+			return fmt.Errorf("attribute %q: configured more than once", attr.Key)
+		}
+		keys[attr.Key] = struct{}{}
+
+		if attr.Value != "" && attr.FromContext != "" {
+			return fmt.Errorf("attribute %q: only one of \"value\" and \"from_context\" can be set", attr.Key)
+		}
+		hasSource := attr.Value != "" || attr.FromContext != ""
+
+		switch attr.Trust {
+		case TrustCollector:
+			if !hasSource {
+				return fmt.Errorf("attribute %q: \"value\" or \"from_context\" is required with the %q trust level", attr.Key, TrustCollector)
+			}
+			if len(attr.AllowedValues) > 0 {
+				return fmt.Errorf("attribute %q: \"allowed_values\" is only supported with the %q trust level", attr.Key, TrustClient)
+			}
+		case TrustClient:
+		default:
+			return fmt.Errorf("attribute %q: unsupported trust level %q", attr.Key, attr.Trust)
+		}
+
+		switch attr.OnViolation {
+		case "", ActionDelete, ActionReject:
+		case ActionOverwrite:
+			if !hasSource {
+				return fmt.Errorf("attribute %q: \"value\" or \"from_context\" is required to overwrite untrusted values", attr.Key)
+			}
+		default:
+			return fmt.Errorf("attribute %q: unsupported on_violation action %q", attr.Key, attr.OnViolation)
+		}
+	}
+	return nil
+}
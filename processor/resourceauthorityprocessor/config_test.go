@@ -0,0 +1,86 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package resourceauthorityprocessor
+
+import (
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/collector/component"
+	"go.opentelemetry.io/collector/confmap/confmaptest"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/processor/resourceauthorityprocessor/internal/metadata"
+)
+
+func TestLoadConfig(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		id           component.ID
+		expected     component.Config
+		errorMessage string
+	}{
+		{
+			id: component.NewID(metadata.Type),
+			expected: &Config{
+				Attributes: []AttributeConfig{
+					{Key: "tenant.id", Trust: TrustCollector, FromContext: "auth.tenant", OnViolation: ActionReject},
+					{Key: "deployment.environment", Trust: TrustCollector, Value: "production"},
+					{Key: "service.namespace", Trust: TrustClient, AllowedValues: []string{"checkout", "payments"}},
+				},
+			},
+		},
+		{
+			id:           component.NewIDWithName(metadata.Type, "missing_attributes"),
+			errorMessage: `missing required field "attributes"`,
+		},
+		{
+			id:           component.NewIDWithName(metadata.Type, "missing_source"),
+			errorMessage: `attribute "tenant.id": "value" or "from_context" is required with the "collector" trust level`,
+		},
+		{
+			id:           component.NewIDWithName(metadata.Type, "both_sources"),
+			errorMessage: `attribute "tenant.id": only one of "value" and "from_context" can be set`,
+		},
+		{
+			id:           component.NewIDWithName(metadata.Type, "invalid_trust"),
+			errorMessage: `attribute "tenant.id": unsupported trust level "everyone"`,
+		},
+		{
+			id:           component.NewIDWithName(metadata.Type, "invalid_action"),
+			errorMessage: `attribute "tenant.id": unsupported on_violation action "ignore"`,
+		},
+		{
+			id:           component.NewIDWithName(metadata.Type, "overwrite_without_source"),
+			errorMessage: `attribute "service.namespace": "value" or "from_context" is required to overwrite untrusted values`,
+		},
+		{
+			id:           component.NewIDWithName(metadata.Type, "duplicate_key"),
+			errorMessage: `attribute "tenant.id": configured more than once`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.id.String(), func(t *testing.T) {
+			cm, err := confmaptest.LoadConf(filepath.Join("testdata", "config.yaml"))
+			require.NoError(t, err)
+
+			factory := NewFactory()
+			cfg := factory.CreateDefaultConfig()
+
+			sub, err := cm.Sub(tt.id.String())
+			require.NoError(t, err)
+			require.NoError(t, sub.Unmarshal(cfg))
+
+			if tt.errorMessage != "" {
+				assert.EqualError(t, component.ValidateConfig(cfg), tt.errorMessage)
+				return
+			}
+			assert.NoError(t, component.ValidateConfig(cfg))
+			assert.Equal(t, tt.expected, cfg)
+		})
+	}
+}
@@ -0,0 +1,9 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+//go:generate mdatagen metadata.yaml
+
+// Package resourceauthorityprocessor implements a processor that enforces
+// which resource attributes may be set by clients and which ones must be
+// set by the collector.
+package resourceauthorityprocessor // import "github.com/open-telemetry/opentelemetry-collector-contrib/processor/resourceauthorityprocessor"
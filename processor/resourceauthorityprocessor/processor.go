@@ -0,0 +1,182 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package resourceauthorityprocessor // import "github.com/open-telemetry/opentelemetry-collector-contrib/processor/resourceauthorityprocessor"
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"go.opentelemetry.io/collector/client"
+	"go.opentelemetry.io/collector/consumer/consumererror"
+	"go.opentelemetry.io/collector/pdata/pcommon"
+	"go.opentelemetry.io/collector/pdata/plog"
+	"go.opentelemetry.io/collector/pdata/pmetric"
+	"go.opentelemetry.io/collector/pdata/ptrace"
+	"go.uber.org/zap"
+)
+
+type rule struct {
+	key           string
+	trust         TrustLevel
+	value         string
+	fromContext   string
+	allowedValues map[string]struct{}
+	onViolation   ViolationAction
+}
+
+func newRules(cfg *Config) []rule {
+	rules := make([]rule, 0, len(cfg.Attributes))
+	for _, attr := range cfg.Attributes {
+		r := rule{
+			key:         attr.Key,
+			trust:       attr.Trust,
+			value:       attr.Value,
+			fromContext: attr.FromContext,
+			onViolation: attr.OnViolation,
+		}
+		if r.onViolation == "" {
+			r.onViolation = ActionOverwrite
+			if r.trust == TrustClient {
+				r.onViolation = ActionDelete
+			}
+		}
+		if len(attr.AllowedValues) > 0 {
+			r.allowedValues = make(map[string]struct{}, len(attr.AllowedValues))
+			for _, v := range attr.AllowedValues {
+				r.allowedValues[v] = struct{}{}
+			}
+		}
+		rules = append(rules, r)
+	}
+	return rules
+}
+
+// authoritativeValue returns the value the collector determined for the attribute.
+func (r *rule) authoritativeValue(ctx context.Context) (string, bool) {
+	if r.value != "" {
+		return r.value, true
+	}
+	return valueFromContext(ctx, r.fromContext)
+}
+
+type resourceAuthorityProcessor struct {
+	logger *zap.Logger
+	rules  []rule
+}
+
+func (p *resourceAuthorityProcessor) processTraces(ctx context.Context, td ptrace.Traces) (ptrace.Traces, error) {
+	rss := td.ResourceSpans()
+	for i := 0; i < rss.Len(); i++ {
+		if err := p.enforce(ctx, rss.At(i).Resource().Attributes()); err != nil {
+			return td, err
+		}
+	}
+	return td, nil
+}
+
+func (p *resourceAuthorityProcessor) processMetrics(ctx context.Context, md pmetric.Metrics) (pmetric.Metrics, error) {
+	rms := md.ResourceMetrics()
+	for i := 0; i < rms.Len(); i++ {
+		if err := p.enforce(ctx, rms.At(i).Resource().Attributes()); err != nil {
+			return md, err
+		}
+	}
+	return md, nil
+}
+
+func (p *resourceAuthorityProcessor) processLogs(ctx context.Context, ld plog.Logs) (plog.Logs, error) {
+	rls := ld.ResourceLogs()
+	for i := 0; i < rls.Len(); i++ {
+		if err := p.enforce(ctx, rls.At(i).Resource().Attributes()); err != nil {
+			return ld, err
+		}
+	}
+	return ld, nil
+}
+
+// enforce applies all rules to the given resource attributes.
+func (p *resourceAuthorityProcessor) enforce(ctx context.Context, attrs pcommon.Map) error {
+	for i := range p.rules {
+		r := &p.rules[i]
+		authoritative, hasAuthoritative := r.authoritativeValue(ctx)
+		current, present := attrs.Get(r.key)
+
+		if r.trust == TrustCollector {
+			if present && hasAuthoritative && current.AsString() == authoritative {
+				continue
+			}
+			if present && r.onViolation == ActionReject {
+				return p.reject(r, current.AsString())
+			}
+			if hasAuthoritative {
+				attrs.PutStr(r.key, authoritative)
+			} else {
+				// Nothing the collector can vouch for: a client-provided value must not survive.
+				attrs.Remove(r.key)
+			}
+			continue
+		}
+
+		// TrustClient
+		if !present || r.allowedValues == nil {
+			continue
+		}
+		if _, ok := r.allowedValues[current.AsString()]; ok {
+			continue
+		}
+		switch r.onViolation {
+		case ActionReject:
+			return p.reject(r, current.AsString())
+		case ActionOverwrite:
+			if hasAuthoritative {
+				attrs.PutStr(r.key, authoritative)
+				continue
+			}
+			attrs.Remove(r.key)
+		default:
+			attrs.Remove(r.key)
+		}
+	}
+	return nil
+}
+
+func (p *resourceAuthorityProcessor) reject(r *rule, value string) error {
+	p.logger.Debug("Rejecting data with an untrusted resource attribute", zap.String("key", r.key), zap.String("value", value))
+	return consumererror.NewPermanent(fmt.Errorf("resource attribute %q has an untrusted value", r.key))
+}
+
+func valueFromContext(ctx context.Context, key string) (string, bool) {
+	const (
+		metadataPrefix = "metadata."
+		authPrefix     = "auth."
+	)
+
+	info := client.FromContext(ctx)
+	var vals []string
+
+	switch {
+	case strings.HasPrefix(key, metadataPrefix):
+		vals = info.Metadata.Get(strings.TrimPrefix(key, metadataPrefix))
+	case strings.HasPrefix(key, authPrefix):
+		if info.Auth == nil {
+			return "", false
+		}
+		switch a := info.Auth.GetAttribute(strings.TrimPrefix(key, authPrefix)).(type) {
+		case string:
+			return a, a != ""
+		case []string:
+			vals = a
+		default:
+			return "", false
+		}
+	default:
+		vals = info.Metadata.Get(key)
+	}
+
+	if len(vals) == 0 {
+		return "", false
+	}
+	return strings.Join(vals, ";"), true
+}
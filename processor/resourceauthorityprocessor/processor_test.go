@@ -0,0 +1,169 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package resourceauthorityprocessor
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/collector/client"
+	"go.opentelemetry.io/collector/component/componenttest"
+	"go.opentelemetry.io/collector/consumer/consumererror"
+	"go.opentelemetry.io/collector/consumer/consumertest"
+	"go.opentelemetry.io/collector/pdata/pcommon"
+	"go.opentelemetry.io/collector/pdata/plog"
+	"go.opentelemetry.io/collector/pdata/pmetric"
+	"go.opentelemetry.io/collector/pdata/ptrace"
+	"go.opentelemetry.io/collector/processor/processortest"
+)
+
+func TestEnforce(t *testing.T) {
+	tests := []struct {
+		name     string
+		attrs    []AttributeConfig
+		ctx      context.Context
+		input    map[string]any
+		expected map[string]any
+		wantErr  bool
+	}{
+		{
+			name:     "collector static value overwrites client value",
+			attrs:    []AttributeConfig{{Key: "deployment.environment", Trust: TrustCollector, Value: "production"}},
+			ctx:      context.Background(),
+			input:    map[string]any{"deployment.environment": "dev", "service.name": "checkout"},
+			expected: map[string]any{"deployment.environment": "production", "service.name": "checkout"},
+		},
+		{
+			name:     "collector static value is added when missing",
+			attrs:    []AttributeConfig{{Key: "deployment.environment", Trust: TrustCollector, Value: "production"}},
+			ctx:      context.Background(),
+			input:    map[string]any{"service.name": "checkout"},
+			expected: map[string]any{"deployment.environment": "production", "service.name": "checkout"},
+		},
+		{
+			name:  "collector value from metadata",
+			attrs: []AttributeConfig{{Key: "tenant.id", Trust: TrustCollector, FromContext: "metadata.x-tenant"}},
+			ctx: client.NewContext(context.Background(), client.Info{
+				Metadata: client.NewMetadata(map[string][]string{"x-tenant": {"acme"}}),
+			}),
+			input:    map[string]any{"tenant.id": "other"},
+			expected: map[string]any{"tenant.id": "acme"},
+		},
+		{
+			name:     "collector value missing from context removes client value",
+			attrs:    []AttributeConfig{{Key: "tenant.id", Trust: TrustCollector, FromContext: "metadata.x-tenant"}},
+			ctx:      context.Background(),
+			input:    map[string]any{"tenant.id": "other", "service.name": "checkout"},
+			expected: map[string]any{"service.name": "checkout"},
+		},
+		{
+			name:    "collector reject on mismatch",
+			attrs:   []AttributeConfig{{Key: "tenant.id", Trust: TrustCollector, Value: "acme", OnViolation: ActionReject}},
+			ctx:     context.Background(),
+			input:   map[string]any{"tenant.id": "other"},
+			wantErr: true,
+		},
+		{
+			name:     "collector reject accepts matching value",
+			attrs:    []AttributeConfig{{Key: "tenant.id", Trust: TrustCollector, Value: "acme", OnViolation: ActionReject}},
+			ctx:      context.Background(),
+			input:    map[string]any{"tenant.id": "acme"},
+			expected: map[string]any{"tenant.id": "acme"},
+		},
+		{
+			name:     "client allowed value is kept",
+			attrs:    []AttributeConfig{{Key: "service.namespace", Trust: TrustClient, AllowedValues: []string{"checkout"}}},
+			ctx:      context.Background(),
+			input:    map[string]any{"service.namespace": "checkout"},
+			expected: map[string]any{"service.namespace": "checkout"},
+		},
+		{
+			name:     "client disallowed value is deleted by default",
+			attrs:    []AttributeConfig{{Key: "service.namespace", Trust: TrustClient, AllowedValues: []string{"checkout"}}},
+			ctx:      context.Background(),
+			input:    map[string]any{"service.namespace": "payments", "service.name": "api"},
+			expected: map[string]any{"service.name": "api"},
+		},
+		{
+			name: "client disallowed value is overwritten",
+			attrs: []AttributeConfig{{
+				Key: "service.namespace", Trust: TrustClient, AllowedValues: []string{"checkout"},
+				Value: "unknown", OnViolation: ActionOverwrite,
+			}},
+			ctx:      context.Background(),
+			input:    map[string]any{"service.namespace": "payments"},
+			expected: map[string]any{"service.namespace": "unknown"},
+		},
+		{
+			name: "client disallowed value is rejected",
+			attrs: []AttributeConfig{{
+				Key: "service.namespace", Trust: TrustClient, AllowedValues: []string{"checkout"}, OnViolation: ActionReject,
+			}},
+			ctx:     context.Background(),
+			input:   map[string]any{"service.namespace": "payments"},
+			wantErr: true,
+		},
+		{
+			name:     "client without allowed values accepts anything",
+			attrs:    []AttributeConfig{{Key: "service.namespace", Trust: TrustClient}},
+			ctx:      context.Background(),
+			input:    map[string]any{"service.namespace": "payments"},
+			expected: map[string]any{"service.namespace": "payments"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cfg := &Config{Attributes: tt.attrs}
+			require.NoError(t, cfg.Validate())
+			p := &resourceAuthorityProcessor{logger: componenttest.NewNopTelemetrySettings().Logger, rules: newRules(cfg)}
+
+			attrs := pcommon.NewMap()
+			require.NoError(t, attrs.FromRaw(tt.input))
+			err := p.enforce(tt.ctx, attrs)
+			if tt.wantErr {
+				require.Error(t, err)
+				assert.True(t, consumererror.IsPermanent(err))
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.expected, attrs.AsRaw())
+		})
+	}
+}
+
+func TestProcessorSignals(t *testing.T) {
+	factory := NewFactory()
+	cfg := &Config{Attributes: []AttributeConfig{{Key: "deployment.environment", Trust: TrustCollector, Value: "production"}}}
+	ctx := context.Background()
+
+	tracesSink := new(consumertest.TracesSink)
+	tp, err := factory.CreateTracesProcessor(ctx, processortest.NewNopCreateSettings(), cfg, tracesSink)
+	require.NoError(t, err)
+	td := ptrace.NewTraces()
+	td.ResourceSpans().AppendEmpty().Resource().Attributes().PutStr("deployment.environment", "dev")
+	require.NoError(t, tp.ConsumeTraces(ctx, td))
+	v, _ := tracesSink.AllTraces()[0].ResourceSpans().At(0).Resource().Attributes().Get("deployment.environment")
+	assert.Equal(t, "production", v.Str())
+
+	metricsSink := new(consumertest.MetricsSink)
+	mp, err := factory.CreateMetricsProcessor(ctx, processortest.NewNopCreateSettings(), cfg, metricsSink)
+	require.NoError(t, err)
+	md := pmetric.NewMetrics()
+	md.ResourceMetrics().AppendEmpty()
+	require.NoError(t, mp.ConsumeMetrics(ctx, md))
+	v, _ = metricsSink.AllMetrics()[0].ResourceMetrics().At(0).Resource().Attributes().Get("deployment.environment")
+	assert.Equal(t, "production", v.Str())
+
+	logsSink := new(consumertest.LogsSink)
+	lp, err := factory.CreateLogsProcessor(ctx, processortest.NewNopCreateSettings(), cfg, logsSink)
+	require.NoError(t, err)
+	ld := plog.NewLogs()
+	ld.ResourceLogs().AppendEmpty()
+	require.NoError(t, lp.ConsumeLogs(ctx, ld))
+	v, _ = logsSink.AllLogs()[0].ResourceLogs().At(0).Resource().Attributes().Get("deployment.environment")
+	assert.Equal(t, "production", v.Str())
+}
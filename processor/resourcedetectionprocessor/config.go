@@ -18,8 +18,10 @@ import (
 	"github.com/open-telemetry/opentelemetry-collector-contrib/processor/resourcedetectionprocessor/internal/docker"
 	"github.com/open-telemetry/opentelemetry-collector-contrib/processor/resourcedetectionprocessor/internal/gcp"
 	"github.com/open-telemetry/opentelemetry-collector-contrib/processor/resourcedetectionprocessor/internal/heroku"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/processor/resourcedetectionprocessor/internal/httpmetadata"
 	"github.com/open-telemetry/opentelemetry-collector-contrib/processor/resourcedetectionprocessor/internal/k8snode"
 	"github.com/open-telemetry/opentelemetry-collector-contrib/processor/resourcedetectionprocessor/internal/openshift"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/processor/resourcedetectionprocessor/internal/oraclecloud"
 	"github.com/open-telemetry/opentelemetry-collector-contrib/processor/resourcedetectionprocessor/internal/system"
 )
 
@@ -78,6 +80,9 @@ type DetectorConfig struct {
 	// HerokuConfig contains user-specified configurations for the heroku detector
 	HerokuConfig heroku.Config `mapstructure:"heroku"`
 
+	// HTTPMetadataConfig contains user-specified configurations for the httpmetadata detector
+	HTTPMetadataConfig httpmetadata.Config `mapstructure:"httpmetadata"`
+
 	// SystemConfig contains user-specified configurations for the System detector
 	SystemConfig system.Config `mapstructure:"system"`
 
@@ -86,6 +91,9 @@ type DetectorConfig struct {
 
 	// K8SNode contains user-specified configurations for the K8SNode detector
 	K8SNodeConfig k8snode.Config `mapstructure:"k8snode"`
+
+	// OracleCloudConfig contains user-specified configurations for the Oracle Cloud detector
+	OracleCloudConfig oraclecloud.Config `mapstructure:"oraclecloud"`
 }
 
 func detectorCreateDefaultConfig() DetectorConfig {
@@ -101,9 +109,11 @@ func detectorCreateDefaultConfig() DetectorConfig {
 		DockerConfig:           docker.CreateDefaultConfig(),
 		GcpConfig:              gcp.CreateDefaultConfig(),
 		HerokuConfig:           heroku.CreateDefaultConfig(),
+		HTTPMetadataConfig:     httpmetadata.CreateDefaultConfig(),
 		SystemConfig:           system.CreateDefaultConfig(),
 		OpenShiftConfig:        openshift.CreateDefaultConfig(),
 		K8SNodeConfig:          k8snode.CreateDefaultConfig(),
+		OracleCloudConfig:      oraclecloud.CreateDefaultConfig(),
 	}
 }
 
@@ -131,12 +141,16 @@ func (d *DetectorConfig) GetConfigFromType(detectorType internal.DetectorType) i
 		return d.GcpConfig
 	case heroku.TypeStr:
 		return d.HerokuConfig
+	case httpmetadata.TypeStr:
+		return d.HTTPMetadataConfig
 	case system.TypeStr:
 		return d.SystemConfig
 	case openshift.TypeStr:
 		return d.OpenShiftConfig
 	case k8snode.TypeStr:
 		return d.K8SNodeConfig
+	case oraclecloud.TypeStr:
+		return d.OracleCloudConfig
 	default:
 		return nil
 	}
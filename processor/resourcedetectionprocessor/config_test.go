@@ -12,6 +12,7 @@ import (
 	"github.com/stretchr/testify/require"
 	"go.opentelemetry.io/collector/component"
 	"go.opentelemetry.io/collector/config/confighttp"
+	"go.opentelemetry.io/collector/config/configopaque"
 	"go.opentelemetry.io/collector/config/configtls"
 	"go.opentelemetry.io/collector/confmap/confmaptest"
 
@@ -19,8 +20,10 @@ import (
 	"github.com/open-telemetry/opentelemetry-collector-contrib/processor/resourcedetectionprocessor/internal/aws/ec2"
 	"github.com/open-telemetry/opentelemetry-collector-contrib/processor/resourcedetectionprocessor/internal/aws/lambda"
 	"github.com/open-telemetry/opentelemetry-collector-contrib/processor/resourcedetectionprocessor/internal/heroku"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/processor/resourcedetectionprocessor/internal/httpmetadata"
 	"github.com/open-telemetry/opentelemetry-collector-contrib/processor/resourcedetectionprocessor/internal/metadata"
 	"github.com/open-telemetry/opentelemetry-collector-contrib/processor/resourcedetectionprocessor/internal/openshift"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/processor/resourcedetectionprocessor/internal/oraclecloud"
 	"github.com/open-telemetry/opentelemetry-collector-contrib/processor/resourcedetectionprocessor/internal/system"
 )
 
@@ -51,6 +54,13 @@ func TestLoadConfig(t *testing.T) {
 		ResourceAttributes: system.CreateDefaultConfig().ResourceAttributes,
 	}
 
+	httpMetadataConfig := detectorCreateDefaultConfig()
+	httpMetadataConfig.HTTPMetadataConfig = httpmetadata.Config{
+		Endpoint:   "http://metadata.internal/v1/instance",
+		Headers:    map[string]configopaque.String{"X-Metadata-Token": "secret"},
+		Attributes: map[string]string{"instance.id": "host.id", "placement.rack": "rack"},
+	}
+
 	resourceAttributesConfig := detectorCreateDefaultConfig()
 	ec2ResourceAttributesConfig := ec2.CreateDefaultConfig()
 	ec2ResourceAttributesConfig.ResourceAttributes.HostName.Enabled = false
@@ -112,6 +122,15 @@ func TestLoadConfig(t *testing.T) {
 				DetectorConfig: detectorCreateDefaultConfig(),
 			},
 		},
+		{
+			id: component.NewIDWithName(metadata.Type, "httpmetadata"),
+			expected: &Config{
+				Detectors:      []string{"env", "httpmetadata"},
+				ClientConfig:   cfg,
+				Override:       false,
+				DetectorConfig: httpMetadataConfig,
+			},
+		},
 		{
 			id: component.NewIDWithName(metadata.Type, "lambda"),
 			expected: &Config{
@@ -160,6 +179,7 @@ func TestLoadConfig(t *testing.T) {
 func TestGetConfigFromType(t *testing.T) {
 	herokuDetectorConfig := DetectorConfig{HerokuConfig: heroku.CreateDefaultConfig()}
 	lambdaDetectorConfig := DetectorConfig{LambdaConfig: lambda.CreateDefaultConfig()}
+	oracleCloudDetectorConfig := DetectorConfig{OracleCloudConfig: oraclecloud.CreateDefaultConfig()}
 	ec2DetectorConfig := DetectorConfig{
 		EC2Config: ec2.Config{
 			Tags: []string{"tag1", "tag2"},
@@ -207,6 +227,12 @@ func TestGetConfigFromType(t *testing.T) {
 			inputDetectorConfig: lambdaDetectorConfig,
 			expectedConfig:      lambdaDetectorConfig.LambdaConfig,
 		},
+		{
+			name:                "Get Oracle Cloud Config",
+			detectorType:        oraclecloud.TypeStr,
+			inputDetectorConfig: oracleCloudDetectorConfig,
+			expectedConfig:      oracleCloudDetectorConfig.OracleCloudConfig,
+		},
 	}
 
 	for _, tt := range tests {
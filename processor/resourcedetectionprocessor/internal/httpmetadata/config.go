@@ -0,0 +1,60 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package httpmetadata // import "github.com/open-telemetry/opentelemetry-collector-contrib/processor/resourcedetectionprocessor/internal/httpmetadata"
+
+import (
+	"errors"
+	"fmt"
+	"net/url"
+
+	"go.opentelemetry.io/collector/config/configopaque"
+	"go.opentelemetry.io/collector/config/configtls"
+)
+
+// Config defines the metadata service queried by the detector and how the fields of its reply are mapped to
+// resource attributes.
+// See `httpmetadata.go#NewDetector` for more information.
+type Config struct {
+	// Endpoint is the URL of the metadata service, replying with a JSON object.
+	Endpoint string `mapstructure:"endpoint"`
+
+	// Headers are added to the requests to the metadata service, e.g. to authenticate.
+	Headers map[string]configopaque.String `mapstructure:"headers"`
+
+	// TLSSettings contains the TLS configuration of the connection to the metadata service.
+	TLSSettings configtls.ClientConfig `mapstructure:"tls"`
+
+	// Attributes maps the paths of the fields of the reply to the resource attributes they're set to. The keys of
+	// the nested objects and the indexes of the arrays are separated with dots, e.g. "placement.zone" or
+	// "interfaces.0.address".
+	Attributes map[string]string `mapstructure:"attributes"`
+}
+
+func CreateDefaultConfig() Config {
+	return Config{}
+}
+
+// validate checks the configuration when the detector is used, the configuration of the unused detectors being
+// empty.
+func (c Config) validate() error {
+	if c.Endpoint == "" {
+		return errors.New("endpoint must be specified")
+	}
+	u, err := url.Parse(c.Endpoint)
+	if err != nil {
+		return fmt.Errorf("invalid endpoint: %w", err)
+	}
+	if u.Scheme != "http" && u.Scheme != "https" {
+		return fmt.Errorf("invalid endpoint %q, the scheme must be http or https", c.Endpoint)
+	}
+	if len(c.Attributes) == 0 {
+		return errors.New("at least one attribute must be specified")
+	}
+	for path, attribute := range c.Attributes {
+		if path == "" || attribute == "" {
+			return fmt.Errorf("invalid mapping of the path %q to the attribute %q, both must be specified", path, attribute)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,163 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+// Package httpmetadata provides a detector that loads resource information from the JSON reply of a metadata
+// service, e.g. the internal metadata service of a private cloud or a bare-metal fleet.
+package httpmetadata // import "github.com/open-telemetry/opentelemetry-collector-contrib/processor/resourcedetectionprocessor/internal/httpmetadata"
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"go.opentelemetry.io/collector/pdata/pcommon"
+	"go.opentelemetry.io/collector/processor"
+	"go.uber.org/zap"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/processor/resourcedetectionprocessor/internal"
+)
+
+// TypeStr is type of detector.
+const TypeStr = "httpmetadata"
+
+// maxReplySize is the maximum size of the reply of the metadata service.
+const maxReplySize = 1 << 20
+
+var _ internal.Detector = (*Detector)(nil)
+
+// Detector is a detector querying a metadata service over HTTP
+type Detector struct {
+	cfg    Config
+	client *http.Client
+	logger *zap.Logger
+}
+
+// NewDetector creates a new detector querying the metadata service configured by the user
+func NewDetector(set processor.CreateSettings, dcfg internal.DetectorConfig) (internal.Detector, error) {
+	cfg := dcfg.(Config)
+	if err := cfg.validate(); err != nil {
+		return nil, fmt.Errorf("httpmetadata detector: %w", err)
+	}
+
+	tlsCfg, err := cfg.TLSSettings.LoadTLSConfig(context.Background())
+	if err != nil {
+		return nil, err
+	}
+	transport := http.DefaultTransport.(*http.Transport).Clone()
+	transport.TLSClientConfig = tlsCfg
+
+	return &Detector{
+		cfg:    cfg,
+		client: &http.Client{Transport: transport},
+		logger: set.Logger,
+	}, nil
+}
+
+// Detect queries the metadata service and returns a resource with the attributes mapped from its reply
+func (d *Detector) Detect(ctx context.Context) (resource pcommon.Resource, schemaURL string, err error) {
+	reply, err := d.query(ctx)
+	if err != nil {
+		return pcommon.NewResource(), "", err
+	}
+
+	res := pcommon.NewResource()
+	for path, attribute := range d.cfg.Attributes {
+		value, ok := lookup(reply, path)
+		if !ok {
+			d.logger.Debug("Field not found in the reply of the metadata service", zap.String("path", path))
+			continue
+		}
+		if err := putValue(res.Attributes(), attribute, value); err != nil {
+			d.logger.Warn("Failed to set the resource attribute from the reply of the metadata service",
+				zap.String("path", path), zap.String("attribute", attribute), zap.Error(err))
+		}
+	}
+	return res, "", nil
+}
+
+func (d *Detector) query(ctx context.Context) (any, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, d.cfg.Endpoint, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create request: %w", err)
+	}
+	req.Header.Set("Accept", "application/json")
+	for name, value := range d.cfg.Headers {
+		req.Header.Set(name, string(value))
+	}
+
+	resp, err := d.client.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query the metadata service: %w", err)
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("metadata service replied with status code: %s", resp.Status)
+	}
+
+	body, err := io.ReadAll(io.LimitReader(resp.Body, maxReplySize))
+	if err != nil {
+		return nil, fmt.Errorf("failed to read the reply of the metadata service: %w", err)
+	}
+	decoder := json.NewDecoder(bytes.NewReader(body))
+	// the integers are kept as integers instead of being converted to floats
+	decoder.UseNumber()
+	var reply any
+	if err = decoder.Decode(&reply); err != nil {
+		return nil, fmt.Errorf("failed to decode the reply of the metadata service: %w", err)
+	}
+	return reply, nil
+}
+
+// lookup returns the value of the field at the path, made of the keys of the nested objects and the indexes of the
+// arrays separated with dots.
+func lookup(value any, path string) (any, bool) {
+	for _, segment := range strings.Split(path, ".") {
+		switch v := value.(type) {
+		case map[string]any:
+			var ok bool
+			if value, ok = v[segment]; !ok {
+				return nil, false
+			}
+		case []any:
+			i, err := strconv.Atoi(segment)
+			if err != nil || i < 0 || i >= len(v) {
+				return nil, false
+			}
+			value = v[i]
+		default:
+			return nil, false
+		}
+	}
+	return value, true
+}
+
+// putValue sets the attribute to the value of a field, which must be a string, a number or a boolean.
+func putValue(attrs pcommon.Map, attribute string, value any) error {
+	switch v := value.(type) {
+	case string:
+		attrs.PutStr(attribute, v)
+	case bool:
+		attrs.PutBool(attribute, v)
+	case json.Number:
+		if i, err := v.Int64(); err == nil {
+			attrs.PutInt(attribute, i)
+			return nil
+		}
+		f, err := v.Float64()
+		if err != nil {
+			return err
+		}
+		attrs.PutDouble(attribute, f)
+	case nil:
+		return errors.New("the field is null")
+	default:
+		return errors.New("the field is an object or an array, only strings, numbers and booleans are supported")
+	}
+	return nil
+}
@@ -0,0 +1,143 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package httpmetadata
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/collector/config/configopaque"
+	"go.opentelemetry.io/collector/processor/processortest"
+)
+
+const reply = `{
+  "instance": {"id": "i-42", "cpus": 8, "load": 0.5, "virtual": true, "tags": {"team": "core"}},
+  "placement": {"rack": null},
+  "interfaces": [{"address": "10.0.0.1"}, {"address": "10.0.0.2"}]
+}`
+
+func TestNewDetector(t *testing.T) {
+	tests := []struct {
+		name     string
+		cfg      Config
+		expected string
+	}{
+		{
+			name:     "no endpoint",
+			cfg:      Config{Attributes: map[string]string{"instance.id": "host.id"}},
+			expected: "httpmetadata detector: endpoint must be specified",
+		},
+		{
+			name:     "invalid scheme",
+			cfg:      Config{Endpoint: "ftp://metadata", Attributes: map[string]string{"instance.id": "host.id"}},
+			expected: `httpmetadata detector: invalid endpoint "ftp://metadata", the scheme must be http or https`,
+		},
+		{
+			name:     "no attributes",
+			cfg:      Config{Endpoint: "http://metadata/instance"},
+			expected: "httpmetadata detector: at least one attribute must be specified",
+		},
+		{
+			name:     "empty attribute",
+			cfg:      Config{Endpoint: "http://metadata/instance", Attributes: map[string]string{"instance.id": ""}},
+			expected: `httpmetadata detector: invalid mapping of the path "instance.id" to the attribute "", both must be specified`,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := NewDetector(processortest.NewNopCreateSettings(), tt.cfg)
+			assert.EqualError(t, err, tt.expected)
+		})
+	}
+
+	d, err := NewDetector(processortest.NewNopCreateSettings(), Config{
+		Endpoint:   "http://metadata/instance",
+		Attributes: map[string]string{"instance.id": "host.id"},
+	})
+	require.NoError(t, err)
+	assert.NotNil(t, d)
+}
+
+func TestDetect(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "secret", r.Header.Get("X-Metadata-Token"))
+		_, err := w.Write([]byte(reply))
+		assert.NoError(t, err)
+	}))
+	defer ts.Close()
+
+	d, err := NewDetector(processortest.NewNopCreateSettings(), Config{
+		Endpoint: ts.URL,
+		Headers:  map[string]configopaque.String{"X-Metadata-Token": "secret"},
+		Attributes: map[string]string{
+			"instance.id":          "host.id",
+			"instance.cpus":        "host.cpu.count",
+			"instance.load":        "host.load",
+			"instance.virtual":     "host.virtual",
+			"instance.tags.team":   "team",
+			"interfaces.1.address": "host.ip",
+			// not set
+			"instance.tags":        "tags",
+			"placement.rack":       "rack",
+			"placement.zone":       "cloud.availability_zone",
+			"interfaces.2.address": "host.ip.2",
+			"instance.id.value":    "id",
+		},
+	})
+	require.NoError(t, err)
+
+	res, schemaURL, err := d.Detect(context.Background())
+	require.NoError(t, err)
+	assert.Empty(t, schemaURL)
+	assert.Equal(t, map[string]any{
+		"host.id":        "i-42",
+		"host.cpu.count": int64(8),
+		"host.load":      0.5,
+		"host.virtual":   true,
+		"team":           "core",
+		"host.ip":        "10.0.0.2",
+	}, res.Attributes().AsRaw())
+}
+
+func TestDetectError(t *testing.T) {
+	tests := []struct {
+		name     string
+		handler  http.HandlerFunc
+		expected string
+	}{
+		{
+			name:     "status",
+			handler:  func(w http.ResponseWriter, _ *http.Request) { w.WriteHeader(http.StatusForbidden) },
+			expected: "metadata service replied with status code: 403 Forbidden",
+		},
+		{
+			name: "malformed reply",
+			handler: func(w http.ResponseWriter, _ *http.Request) {
+				_, err := w.Write([]byte("{"))
+				assert.NoError(t, err)
+			},
+			expected: "failed to decode the reply of the metadata service: unexpected EOF",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ts := httptest.NewServer(tt.handler)
+			defer ts.Close()
+
+			d, err := NewDetector(processortest.NewNopCreateSettings(), Config{
+				Endpoint:   ts.URL,
+				Attributes: map[string]string{"instance.id": "host.id"},
+			})
+			require.NoError(t, err)
+
+			res, _, err := d.Detect(context.Background())
+			assert.EqualError(t, err, tt.expected)
+			assert.Equal(t, 0, res.Attributes().Len())
+		})
+	}
+}
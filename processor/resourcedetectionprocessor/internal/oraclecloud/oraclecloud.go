@@ -0,0 +1,71 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package oraclecloud // import "github.com/open-telemetry/opentelemetry-collector-contrib/processor/resourcedetectionprocessor/internal/oraclecloud"
+
+import (
+	"context"
+
+	"go.opentelemetry.io/collector/pdata/pcommon"
+	"go.opentelemetry.io/collector/processor"
+	conventions "go.opentelemetry.io/collector/semconv/v1.6.1"
+	"go.uber.org/zap"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/internal/metadataproviders/oraclecloud"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/processor/resourcedetectionprocessor/internal"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/processor/resourcedetectionprocessor/internal/oraclecloud/internal/metadata"
+)
+
+const (
+	// TypeStr is type of detector.
+	TypeStr = "oraclecloud"
+
+	// The values of cloud.provider and cloud.platform, not defined by the semantic conventions.
+	cloudProviderOracleCloud        = "oracle_cloud"
+	cloudPlatformOracleCloudCompute = "oracle_cloud_compute"
+)
+
+var _ internal.Detector = (*Detector)(nil)
+
+// Detector is an Oracle Cloud Infrastructure metadata detector
+type Detector struct {
+	provider oraclecloud.Provider
+	logger   *zap.Logger
+	rb       *metadata.ResourceBuilder
+}
+
+// NewDetector creates a new Oracle Cloud Infrastructure metadata detector
+func NewDetector(p processor.CreateSettings, dcfg internal.DetectorConfig) (internal.Detector, error) {
+	cfg := dcfg.(Config)
+	return &Detector{
+		provider: oraclecloud.NewProvider(),
+		logger:   p.Logger,
+		rb:       metadata.NewResourceBuilder(cfg.ResourceAttributes),
+	}, nil
+}
+
+// Detect detects the metadata of the compute instance and returns a resource with the available ones
+func (d *Detector) Detect(ctx context.Context) (resource pcommon.Resource, schemaURL string, err error) {
+	compute, err := d.provider.Metadata(ctx)
+	if err != nil {
+		d.logger.Debug("Oracle Cloud detector metadata retrieval failed", zap.Error(err))
+		// return an empty Resource and no error
+		return pcommon.NewResource(), "", nil
+	}
+
+	d.rb.SetCloudProvider(cloudProviderOracleCloud)
+	d.rb.SetCloudPlatform(cloudPlatformOracleCloudCompute)
+	// the canonical name, e.g. us-phoenix-1, is only missing from the replies of older instances, which have the
+	// region key, e.g. phx, instead
+	region := compute.CanonicalRegionName
+	if region == "" {
+		region = compute.Region
+	}
+	d.rb.SetCloudRegion(region)
+	d.rb.SetCloudAvailabilityZone(compute.AvailabilityDomain)
+	d.rb.SetHostID(compute.ID)
+	d.rb.SetHostName(compute.Hostname)
+	d.rb.SetHostType(compute.Shape)
+
+	return d.rb.Emit(), conventions.SchemaURL, nil
+}
@@ -0,0 +1,89 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package oraclecloud
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/collector/processor/processortest"
+	conventions "go.opentelemetry.io/collector/semconv/v1.6.1"
+	"go.uber.org/zap"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/internal/metadataproviders/oraclecloud"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/processor/resourcedetectionprocessor/internal"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/processor/resourcedetectionprocessor/internal/oraclecloud/internal/metadata"
+)
+
+func TestNewDetector(t *testing.T) {
+	dcfg := CreateDefaultConfig()
+	d, err := NewDetector(processortest.NewNopCreateSettings(), dcfg)
+	require.NoError(t, err)
+	assert.NotNil(t, d)
+}
+
+func TestDetectOracleCloudAvailable(t *testing.T) {
+	mp := &oraclecloud.MockProvider{}
+	mp.On("Metadata").Return(&oraclecloud.ComputeMetadata{
+		AvailabilityDomain:  "EMIr:PHX-AD-1",
+		CanonicalRegionName: "us-phoenix-1",
+		DisplayName:         "instance",
+		Hostname:            "hostname",
+		ID:                  "ocid1.instance.oc1.phx.instance",
+		Region:              "phx",
+		Shape:               "VM.Standard.E4.Flex",
+	}, nil)
+
+	detector := &Detector{
+		provider: mp,
+		rb:       metadata.NewResourceBuilder(metadata.DefaultResourceAttributesConfig()),
+	}
+	res, schemaURL, err := detector.Detect(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, conventions.SchemaURL, schemaURL)
+	mp.AssertExpectations(t)
+
+	expected := map[string]any{
+		conventions.AttributeCloudProvider:         "oracle_cloud",
+		conventions.AttributeCloudPlatform:         "oracle_cloud_compute",
+		conventions.AttributeCloudRegion:           "us-phoenix-1",
+		conventions.AttributeCloudAvailabilityZone: "EMIr:PHX-AD-1",
+		conventions.AttributeHostID:                "ocid1.instance.oc1.phx.instance",
+		conventions.AttributeHostName:              "hostname",
+		conventions.AttributeHostType:              "VM.Standard.E4.Flex",
+	}
+	assert.Equal(t, expected, res.Attributes().AsRaw())
+}
+
+func TestDetectOracleCloudRegionKey(t *testing.T) {
+	mp := &oraclecloud.MockProvider{}
+	mp.On("Metadata").Return(&oraclecloud.ComputeMetadata{
+		ID:     "ocid1.instance.oc1.phx.instance",
+		Region: "phx",
+	}, nil)
+
+	detector := &Detector{
+		provider: mp,
+		rb:       metadata.NewResourceBuilder(metadata.DefaultResourceAttributesConfig()),
+	}
+	res, _, err := detector.Detect(context.Background())
+	require.NoError(t, err)
+
+	region, ok := res.Attributes().Get(conventions.AttributeCloudRegion)
+	require.True(t, ok)
+	assert.Equal(t, "phx", region.Str())
+}
+
+func TestDetectError(t *testing.T) {
+	mp := &oraclecloud.MockProvider{}
+	mp.On("Metadata").Return(nil, errors.New("connection refused"))
+
+	detector := &Detector{provider: mp, logger: zap.NewNop()}
+	res, _, err := detector.Detect(context.Background())
+	assert.NoError(t, err)
+	assert.True(t, internal.IsEmptyResource(res))
+}
@@ -22,6 +22,14 @@ type Resource interface {
 	Resource() pcommon.Resource
 }
 
+// Scope defines a minimal interface of the scoped signals
+// whose schema URL takes precedence over the resource one
+type Scope interface {
+	SchemaUrl() string
+
+	SetSchemaUrl(url string)
+}
+
 // NamedSignal represents a subset of incoming pdata
 // that can be updated using the schema processor
 type NamedSignal interface {
@@ -35,6 +43,10 @@ var (
 	_ Resource = (*pmetric.ResourceMetrics)(nil)
 	_ Resource = (*ptrace.ResourceSpans)(nil)
 
+	_ Scope = (*plog.ScopeLogs)(nil)
+	_ Scope = (*pmetric.ScopeMetrics)(nil)
+	_ Scope = (*ptrace.ScopeSpans)(nil)
+
 	_ NamedSignal = (*pmetric.Metric)(nil)
 	_ NamedSignal = (*ptrace.Span)(nil)
 	_ NamedSignal = (*ptrace.SpanEvent)(nil)
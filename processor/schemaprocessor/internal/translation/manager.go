@@ -0,0 +1,163 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package translation // import "github.com/open-telemetry/opentelemetry-collector-contrib/processor/schemaprocessor/internal/translation"
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	"go.uber.org/zap"
+)
+
+// retryInterval is how long a schema file failing to be retrieved
+// isn't requested again, to avoid retrieving it for every signal.
+const retryInterval = time.Minute
+
+type target struct {
+	schemaURL string
+	version   *Version
+}
+
+// cacheEntry holds the translation of a schema file once retrieved,
+// its lock ensuring that the schema file is only retrieved once.
+type cacheEntry struct {
+	mu          sync.Mutex
+	translation *Translation
+	err         error
+	retryAfter  time.Time
+}
+
+// Manager translates the signals to the target version of their schema family,
+// retrieving and caching the needed schema files.
+type Manager struct {
+	log      *zap.Logger
+	provider Provider
+	targets  map[string]target
+	now      func() time.Time
+
+	mu    sync.Mutex
+	cache map[string]*cacheEntry
+	// retrieved holds the translations retrieved for each family,
+	// the schema file of a version also defining all the previous versions.
+	retrieved map[string][]retrievedTranslation
+}
+
+type retrievedTranslation struct {
+	version     *Version
+	translation *Translation
+}
+
+func NewManager(targets []string, provider Provider, log *zap.Logger) (*Manager, error) {
+	m := &Manager{
+		log:       log,
+		provider:  provider,
+		targets:   make(map[string]target, len(targets)),
+		now:       time.Now,
+		cache:     make(map[string]*cacheEntry),
+		retrieved: make(map[string][]retrievedTranslation),
+	}
+	for _, schemaURL := range targets {
+		family, version, err := GetFamilyAndVersion(schemaURL)
+		if err != nil {
+			return nil, err
+		}
+		m.targets[family] = target{schemaURL: schemaURL, version: version}
+	}
+	return m, nil
+}
+
+// RequestTranslator returns the translator of the signals published with the schema URL
+// to the target version of its family.
+// It returns nil when the signals don't need to be translated,
+// having no schema URL, a family without target or the target version.
+func (m *Manager) RequestTranslator(ctx context.Context, schemaURL string) (*Translator, error) {
+	if schemaURL == "" {
+		return nil, nil
+	}
+	family, version, err := GetFamilyAndVersion(schemaURL)
+	if err != nil {
+		return nil, err
+	}
+	tgt, ok := m.targets[family]
+	if !ok || version.Equal(tgt.version) {
+		return nil, nil
+	}
+	// The schema file of the newest version defines the revisions of all the previous ones.
+	fileURL, fileVersion := tgt.schemaURL, tgt.version
+	if version.GreaterThan(tgt.version) {
+		fileURL, fileVersion = schemaURL, version
+	}
+	translation := m.retrievedTranslation(family, fileVersion)
+	if translation == nil {
+		if translation, err = m.RequestTranslation(ctx, fileURL); err != nil {
+			return nil, err
+		}
+	}
+	return translation.Translator(version, tgt.version, tgt.schemaURL), nil
+}
+
+// retrievedTranslation returns a translation already retrieved for the family
+// that defines the version, or nil if none does.
+func (m *Manager) retrievedTranslation(family string, version *Version) *Translation {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	for _, rt := range m.retrieved[family] {
+		if !rt.version.LessThan(version) {
+			return rt.translation
+		}
+	}
+	return nil
+}
+
+// RequestTranslation returns the translation defined by the schema file published at the schema URL,
+// retrieving it if not cached yet.
+func (m *Manager) RequestTranslation(ctx context.Context, schemaURL string) (*Translation, error) {
+	m.mu.Lock()
+	entry, ok := m.cache[schemaURL]
+	if !ok {
+		entry = &cacheEntry{}
+		m.cache[schemaURL] = entry
+	}
+	m.mu.Unlock()
+
+	entry.mu.Lock()
+	defer entry.mu.Unlock()
+
+	if entry.translation != nil {
+		return entry.translation, nil
+	}
+	if m.now().Before(entry.retryAfter) {
+		return nil, entry.err
+	}
+	entry.translation, entry.err = m.retrieve(ctx, schemaURL)
+	if entry.err == nil {
+		if family, version, err := GetFamilyAndVersion(schemaURL); err == nil {
+			m.mu.Lock()
+			m.retrieved[family] = append(m.retrieved[family], retrievedTranslation{version: version, translation: entry.translation})
+			m.mu.Unlock()
+		}
+	} else {
+		entry.retryAfter = m.now().Add(retryInterval)
+		m.log.Warn("Failed to retrieve the schema file",
+			zap.String("schema-url", schemaURL),
+			zap.Error(entry.err),
+		)
+	}
+	return entry.translation, entry.err
+}
+
+func (m *Manager) retrieve(ctx context.Context, schemaURL string) (*Translation, error) {
+	content, err := m.provider.Retrieve(ctx, schemaURL)
+	if err != nil {
+		return nil, err
+	}
+	translation, err := NewTranslationFromReader(bytes.NewReader(content))
+	if err != nil {
+		return nil, fmt.Errorf("invalid schema file %q: %w", schemaURL, err)
+	}
+	return translation, nil
+}
@@ -0,0 +1,137 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package translation
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap/zaptest"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/processor/schemaprocessor/internal/fixture"
+)
+
+// newTestSchemaServer serves the test schema file at /schemas/1.2.0, counting the requests.
+func newTestSchemaServer(t *testing.T) (*httptest.Server, *atomic.Int64) {
+	content, err := os.ReadFile(filepath.Join("..", "..", "testdata", "schema.yaml"))
+	require.NoError(t, err, "Must be able to read the test schema file")
+
+	requests := new(atomic.Int64)
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests.Add(1)
+		if r.URL.Path != "/schemas/1.2.0" {
+			http.NotFound(w, r)
+			return
+		}
+		_, _ = w.Write(content)
+	}))
+	t.Cleanup(srv.Close)
+	return srv, requests
+}
+
+func TestManagerRequestTranslator(t *testing.T) {
+	t.Parallel()
+
+	srv, requests := newTestSchemaServer(t)
+	m, err := NewManager(
+		[]string{srv.URL + "/schemas/1.0.0"},
+		NewHTTPProvider(srv.Client()),
+		zaptest.NewLogger(t),
+	)
+	require.NoError(t, err, "Must create the manager")
+
+	for _, schemaURL := range []string{"", srv.URL + "/schemas/1.0.0", "https://example.com/schemas/1.2.0"} {
+		tr, err := m.RequestTranslator(context.Background(), schemaURL)
+		assert.NoError(t, err, "Must not error for %q", schemaURL)
+		assert.Nil(t, tr, "Must not translate %q", schemaURL)
+	}
+	_, err = m.RequestTranslator(context.Background(), "invalid")
+	assert.Error(t, err, "Must error on an invalid schema URL")
+	assert.Zero(t, requests.Load(), "Must not retrieve any schema file")
+
+	tr, err := m.RequestTranslator(context.Background(), srv.URL+"/schemas/1.2.0")
+	require.NoError(t, err, "Must retrieve the schema file of the newest version")
+	assert.Equal(t, srv.URL+"/schemas/1.0.0", tr.TargetSchemaURL())
+	assert.Len(t, tr.revisions, 2)
+
+	tr, err = m.RequestTranslator(context.Background(), srv.URL+"/schemas/1.1.0")
+	require.NoError(t, err, "Must reuse the cached schema file")
+	assert.Len(t, tr.revisions, 1)
+	assert.Equal(t, int64(1), requests.Load(), "Must retrieve the schema file once")
+}
+
+func TestManagerRetryInterval(t *testing.T) {
+	t.Parallel()
+
+	srv, requests := newTestSchemaServer(t)
+	m, err := NewManager(
+		[]string{srv.URL + "/schemas/1.0.0"},
+		NewHTTPProvider(srv.Client()),
+		zaptest.NewLogger(t),
+	)
+	require.NoError(t, err, "Must create the manager")
+	now := time.Now()
+	m.now = func() time.Time { return now }
+
+	_, err = m.RequestTranslation(context.Background(), srv.URL+"/schemas/1.3.0")
+	assert.ErrorContains(t, err, "unexpected status code 404")
+	_, err = m.RequestTranslation(context.Background(), srv.URL+"/schemas/1.3.0")
+	assert.ErrorContains(t, err, "unexpected status code 404")
+	assert.Equal(t, int64(1), requests.Load(), "Must not retry before the end of the interval")
+
+	now = now.Add(retryInterval)
+	_, err = m.RequestTranslation(context.Background(), srv.URL+"/schemas/1.3.0")
+	assert.Error(t, err)
+	assert.Equal(t, int64(2), requests.Load(), "Must retry at the end of the interval")
+}
+
+func TestManagerFileProvider(t *testing.T) {
+	t.Parallel()
+
+	invalid := filepath.Join(t.TempDir(), "invalid.yaml")
+	require.NoError(t, os.WriteFile(invalid, []byte("file_format: 1.0.0\n"), 0600))
+	m, err := NewManager(
+		[]string{"https://example.com/schemas/1.0.0"},
+		NewFileProvider(map[string]string{
+			"https://example.com/schemas/1.2.0": filepath.Join("..", "..", "testdata", "schema.yaml"),
+			"https://example.com/schemas/1.3.0": invalid,
+		}, nil),
+		zaptest.NewLogger(t),
+	)
+	require.NoError(t, err, "Must create the manager")
+
+	tr, err := m.RequestTranslator(context.Background(), "https://example.com/schemas/1.2.0")
+	require.NoError(t, err, "Must read the local schema file")
+	assert.Len(t, tr.revisions, 2)
+
+	_, err = m.RequestTranslator(context.Background(), "https://example.com/schemas/1.3.0")
+	assert.ErrorContains(t, err, "invalid schema file")
+
+	_, err = m.RequestTranslator(context.Background(), "https://example.com/schemas/1.4.0")
+	assert.ErrorIs(t, err, ErrNoSchemaFile)
+}
+
+func TestManagerConcurrentRequests(t *testing.T) {
+	srv, requests := newTestSchemaServer(t)
+	m, err := NewManager(
+		[]string{srv.URL + "/schemas/1.0.0"},
+		NewHTTPProvider(srv.Client()),
+		zaptest.NewLogger(t),
+	)
+	require.NoError(t, err, "Must create the manager")
+
+	fixture.ParallelRaceCompute(t, 10, func() error {
+		_, err := m.RequestTranslator(context.Background(), srv.URL+"/schemas/1.2.0")
+		return err
+	})
+	assert.Equal(t, int64(1), requests.Load(), "Must retrieve the schema file once")
+}
@@ -0,0 +1,79 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package translation // import "github.com/open-telemetry/opentelemetry-collector-contrib/processor/schemaprocessor/internal/translation"
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+)
+
+// maxSchemaFileSize limits the size of the retrieved schema files,
+// the published ones being a few kilobytes.
+const maxSchemaFileSize = 4 << 20
+
+var ErrNoSchemaFile = errors.New("no schema file available")
+
+// Provider retrieves the content of the schema file published at a schema URL.
+type Provider interface {
+	Retrieve(ctx context.Context, schemaURL string) ([]byte, error)
+}
+
+type httpProvider struct {
+	client *http.Client
+}
+
+// NewHTTPProvider returns a provider downloading the schema files from their schema URL.
+func NewHTTPProvider(client *http.Client) Provider {
+	return &httpProvider{client: client}
+}
+
+func (p *httpProvider) Retrieve(ctx context.Context, schemaURL string) ([]byte, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, schemaURL, nil)
+	if err != nil {
+		return nil, err
+	}
+	resp, err := p.client.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("unexpected status code %d retrieving %q", resp.StatusCode, schemaURL)
+	}
+	content, err := io.ReadAll(io.LimitReader(resp.Body, maxSchemaFileSize+1))
+	if err != nil {
+		return nil, err
+	}
+	if len(content) > maxSchemaFileSize {
+		return nil, fmt.Errorf("schema file %q exceeds %d bytes", schemaURL, maxSchemaFileSize)
+	}
+	return content, nil
+}
+
+type fileProvider struct {
+	files map[string]string
+	next  Provider
+}
+
+// NewFileProvider returns a provider reading the schema files from local paths, by schema URL,
+// allowing the schema files to be bundled with the collector.
+// The schema files without a local path are retrieved by the next provider, if any.
+func NewFileProvider(files map[string]string, next Provider) Provider {
+	return &fileProvider{files: files, next: next}
+}
+
+func (p *fileProvider) Retrieve(ctx context.Context, schemaURL string) ([]byte, error) {
+	if path, ok := p.files[schemaURL]; ok {
+		return os.ReadFile(path)
+	}
+	if p.next == nil {
+		return nil, fmt.Errorf("%q: %w", schemaURL, ErrNoSchemaFile)
+	}
+	return p.next.Retrieve(ctx, schemaURL)
+}
@@ -4,23 +4,34 @@
 package translation // import "github.com/open-telemetry/opentelemetry-collector-contrib/processor/schemaprocessor/internal/translation"
 
 import (
+	"go.opentelemetry.io/collector/pdata/pcommon"
+	"go.opentelemetry.io/collector/pdata/plog"
+	"go.opentelemetry.io/collector/pdata/pmetric"
+	"go.opentelemetry.io/collector/pdata/ptrace"
 	"go.opentelemetry.io/otel/schema/v1.0/ast"
+	"go.uber.org/multierr"
 
 	"github.com/open-telemetry/opentelemetry-collector-contrib/processor/schemaprocessor/internal/migrate"
 )
 
+// The fields matched by the conditions of the span event attribute changes.
+const (
+	spanNameField  = "span.name"
+	eventNameField = "event.name"
+)
+
 // RevisionV1 represents all changes that are to be
 // applied to a signal at a given version.
 type RevisionV1 struct {
-	ver              *Version
-	all              *migrate.AttributeChangeSetSlice
-	resource         *migrate.AttributeChangeSetSlice
-	spans            *migrate.ConditionalAttributeSetSlice
-	eventNames       *migrate.SignalNameChangeSlice
-	eventAttrsOnSpan *migrate.ConditionalAttributeSetSlice
-	eventAttrsOnName *migrate.ConditionalAttributeSetSlice
-	metricsAttrs     *migrate.ConditionalAttributeSetSlice
-	metricNames      *migrate.SignalNameChangeSlice
+	ver          *Version
+	all          *migrate.AttributeChangeSetSlice
+	resource     *migrate.AttributeChangeSetSlice
+	spans        *migrate.ConditionalAttributeSetSlice
+	eventNames   *migrate.SignalNameChangeSlice
+	eventAttrs   *migrate.MultiConditionalAttributeSetSlice
+	logs         *migrate.AttributeChangeSetSlice
+	metricsAttrs *migrate.ConditionalAttributeSetSlice
+	metricNames  *migrate.SignalNameChangeSlice
 }
 
 // NewRevision processes the VersionDef and assigns the version to this revision
@@ -30,16 +41,127 @@ type RevisionV1 struct {
 // Generics would be handy here.
 func NewRevision(ver *Version, def ast.VersionDef) *RevisionV1 {
 	return &RevisionV1{
-		ver:              ver,
-		all:              newAttributeChangeSetSliceFromChanges(def.All),
-		resource:         newAttributeChangeSetSliceFromChanges(def.Resources),
-		spans:            newSpanConditionalAttributeSlice(def.Spans),
-		eventNames:       newSpanEventSignalSlice(def.SpanEvents),
-		eventAttrsOnSpan: newSpanEventConditionalSpans(def.SpanEvents),
-		eventAttrsOnName: newSpanEventConditionalNames(def.SpanEvents),
-		metricsAttrs:     newMetricConditionalSlice(def.Metrics),
-		metricNames:      newMetricNameSignalSlice(def.Metrics),
+		ver:          ver,
+		all:          newAttributeChangeSetSliceFromChanges(def.All),
+		resource:     newAttributeChangeSetSliceFromChanges(def.Resources),
+		spans:        newSpanConditionalAttributeSlice(def.Spans),
+		eventNames:   newSpanEventSignalSlice(def.SpanEvents),
+		eventAttrs:   newSpanEventConditionalAttributeSlice(def.SpanEvents),
+		logs:         newLogsAttributeChangeSetSlice(def.Logs),
+		metricsAttrs: newMetricConditionalSlice(def.Metrics),
+		metricNames:  newMetricNameSignalSlice(def.Metrics),
+	}
+}
+
+// Version returns the version the revision migrates to.
+func (r *RevisionV1) Version() *Version {
+	return r.ver
+}
+
+// applyResource updates the resource attributes to the revision,
+// or reverts the update when rolling back.
+func (r *RevisionV1) applyResource(resource pcommon.Resource, ss migrate.StateSelector) error {
+	attrs := resource.Attributes()
+	if ss == migrate.StateSelectorRollback {
+		return multierr.Append(r.resource.Rollback(attrs), r.all.Rollback(attrs))
+	}
+	return multierr.Append(r.all.Apply(attrs), r.resource.Apply(attrs))
+}
+
+// applySpan updates the span and its events to the revision,
+// or reverts the update when rolling back.
+// The attribute changes match the names of the spans and events before their renaming.
+func (r *RevisionV1) applySpan(span ptrace.Span, ss migrate.StateSelector) (errs error) {
+	attrs := span.Attributes()
+	if ss == migrate.StateSelectorApply {
+		errs = multierr.Append(errs, r.all.Apply(attrs))
+		errs = multierr.Append(errs, r.spans.Apply(attrs, span.Name()))
+	}
+	for i := 0; i < span.Events().Len(); i++ {
+		event := span.Events().At(i)
+		switch ss {
+		case migrate.StateSelectorApply:
+			errs = multierr.Append(errs, r.all.Apply(event.Attributes()))
+			errs = multierr.Append(errs, r.eventAttrs.Apply(event.Attributes(), map[string]string{
+				spanNameField:  span.Name(),
+				eventNameField: event.Name(),
+			}))
+			r.eventNames.Apply(event)
+		case migrate.StateSelectorRollback:
+			r.eventNames.Rollback(event)
+			errs = multierr.Append(errs, r.eventAttrs.Rollback(event.Attributes(), map[string]string{
+				spanNameField:  span.Name(),
+				eventNameField: event.Name(),
+			}))
+			errs = multierr.Append(errs, r.all.Rollback(event.Attributes()))
+		}
+	}
+	if ss == migrate.StateSelectorRollback {
+		errs = multierr.Append(errs, r.spans.Rollback(attrs, span.Name()))
+		errs = multierr.Append(errs, r.all.Rollback(attrs))
+	}
+	return errs
+}
+
+// applyLogRecord updates the log record attributes to the revision,
+// or reverts the update when rolling back.
+func (r *RevisionV1) applyLogRecord(record plog.LogRecord, ss migrate.StateSelector) error {
+	attrs := record.Attributes()
+	if ss == migrate.StateSelectorRollback {
+		return multierr.Append(r.logs.Rollback(attrs), r.all.Rollback(attrs))
+	}
+	return multierr.Append(r.all.Apply(attrs), r.logs.Apply(attrs))
+}
+
+// applyMetric updates the metric and the attributes of its data points to the revision,
+// or reverts the update when rolling back.
+// The attribute changes match the name of the metric before its renaming.
+func (r *RevisionV1) applyMetric(metric pmetric.Metric, ss migrate.StateSelector) (errs error) {
+	if ss == migrate.StateSelectorRollback {
+		r.metricNames.Rollback(metric)
+	}
+	for _, attrs := range dataPointAttributes(metric) {
+		switch ss {
+		case migrate.StateSelectorApply:
+			errs = multierr.Append(errs, r.all.Apply(attrs))
+			errs = multierr.Append(errs, r.metricsAttrs.Apply(attrs, metric.Name()))
+		case migrate.StateSelectorRollback:
+			errs = multierr.Append(errs, r.metricsAttrs.Rollback(attrs, metric.Name()))
+			errs = multierr.Append(errs, r.all.Rollback(attrs))
+		}
+	}
+	if ss == migrate.StateSelectorApply {
+		r.metricNames.Apply(metric)
+	}
+	return errs
+}
+
+// dataPointAttributes returns the attributes of each data point of the metric.
+func dataPointAttributes(metric pmetric.Metric) []pcommon.Map {
+	var attrs []pcommon.Map
+	switch metric.Type() {
+	case pmetric.MetricTypeGauge:
+		for i := 0; i < metric.Gauge().DataPoints().Len(); i++ {
+			attrs = append(attrs, metric.Gauge().DataPoints().At(i).Attributes())
+		}
+	case pmetric.MetricTypeSum:
+		for i := 0; i < metric.Sum().DataPoints().Len(); i++ {
+			attrs = append(attrs, metric.Sum().DataPoints().At(i).Attributes())
+		}
+	case pmetric.MetricTypeHistogram:
+		for i := 0; i < metric.Histogram().DataPoints().Len(); i++ {
+			attrs = append(attrs, metric.Histogram().DataPoints().At(i).Attributes())
+		}
+	case pmetric.MetricTypeExponentialHistogram:
+		for i := 0; i < metric.ExponentialHistogram().DataPoints().Len(); i++ {
+			attrs = append(attrs, metric.ExponentialHistogram().DataPoints().At(i).Attributes())
+		}
+	case pmetric.MetricTypeSummary:
+		for i := 0; i < metric.Summary().DataPoints().Len(); i++ {
+			attrs = append(attrs, metric.Summary().DataPoints().At(i).Attributes())
+		}
 	}
+	return attrs
 }
 
 func newAttributeChangeSetSliceFromChanges(attrs ast.Attributes) *migrate.AttributeChangeSetSlice {
@@ -75,24 +197,37 @@ func newSpanEventSignalSlice(events ast.SpanEvents) *migrate.SignalNameChangeSli
 	return migrate.NewSignalNameChangeSlice(values...)
 }
 
-func newSpanEventConditionalSpans(events ast.SpanEvents) *migrate.ConditionalAttributeSetSlice {
-	values := make([]*migrate.ConditionalAttributeSet, 0, 10)
+// newSpanEventConditionalAttributeSlice creates the changes of the span event attributes,
+// applied when both the span name and the event name match the definition.
+func newSpanEventConditionalAttributeSlice(events ast.SpanEvents) *migrate.MultiConditionalAttributeSetSlice {
+	values := make([]*migrate.MultiConditionalAttributeSet, 0, 10)
 	for _, ch := range events.Changes {
 		if rename := ch.RenameAttributes; rename != nil {
-			values = append(values, migrate.NewConditionalAttributeSet(rename.AttributeMap, rename.ApplyToSpans...))
+			spans := make([]string, 0, len(rename.ApplyToSpans))
+			for _, name := range rename.ApplyToSpans {
+				spans = append(spans, string(name))
+			}
+			events := make([]string, 0, len(rename.ApplyToEvents))
+			for _, name := range rename.ApplyToEvents {
+				events = append(events, string(name))
+			}
+			values = append(values, migrate.NewMultiConditionalAttributeSet(rename.AttributeMap, map[string][]string{
+				spanNameField:  spans,
+				eventNameField: events,
+			}))
 		}
 	}
-	return migrate.NewConditionalAttributeSetSlice(values...)
+	return migrate.NewMultiConditionalAttributeSetSlice(values...)
 }
 
-func newSpanEventConditionalNames(events ast.SpanEvents) *migrate.ConditionalAttributeSetSlice {
-	values := make([]*migrate.ConditionalAttributeSet, 0, 10)
-	for _, ch := range events.Changes {
-		if rename := ch.RenameAttributes; rename != nil {
-			values = append(values, migrate.NewConditionalAttributeSet(rename.AttributeMap, rename.ApplyToEvents...))
+func newLogsAttributeChangeSetSlice(logs ast.Logs) *migrate.AttributeChangeSetSlice {
+	values := make([]*migrate.AttributeChangeSet, 0, 10)
+	for _, ch := range logs.Changes {
+		if renamed := ch.RenameAttributes; renamed != nil {
+			values = append(values, migrate.NewAttributeChangeSet(renamed.AttributeMap))
 		}
 	}
-	return migrate.NewConditionalAttributeSetSlice(values...)
+	return migrate.NewAttributeChangeSetSlice(values...)
 }
 
 func newMetricConditionalSlice(metrics ast.Metrics) *migrate.ConditionalAttributeSetSlice {
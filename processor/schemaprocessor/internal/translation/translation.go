@@ -0,0 +1,153 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package translation // import "github.com/open-telemetry/opentelemetry-collector-contrib/processor/schemaprocessor/internal/translation"
+
+import (
+	"fmt"
+	"io"
+	"sort"
+
+	"go.opentelemetry.io/collector/pdata/pcommon"
+	"go.opentelemetry.io/collector/pdata/plog"
+	"go.opentelemetry.io/collector/pdata/pmetric"
+	"go.opentelemetry.io/collector/pdata/ptrace"
+	ast10 "go.opentelemetry.io/otel/schema/v1.0/ast"
+	schema "go.opentelemetry.io/otel/schema/v1.1"
+	"go.opentelemetry.io/otel/schema/v1.1/ast"
+	"go.uber.org/multierr"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/processor/schemaprocessor/internal/migrate"
+)
+
+// Translation holds the revisions defined by a schema file,
+// allowing signals to be translated between any of its versions.
+type Translation struct {
+	revisions []*RevisionV1
+}
+
+// NewTranslationFromReader parses the content of a schema file,
+// of the 1.0 or 1.1 file formats.
+func NewTranslationFromReader(r io.Reader) (*Translation, error) {
+	content, err := schema.Parse(r)
+	if err != nil {
+		return nil, err
+	}
+	return NewTranslation(content)
+}
+
+// NewTranslation creates the revisions of the schema file,
+// sorted by version so they can be applied in order.
+// The metric splits of the 1.1 file format aren't supported and are ignored.
+func NewTranslation(content *ast.Schema) (*Translation, error) {
+	t := &Translation{
+		revisions: make([]*RevisionV1, 0, len(content.Versions)),
+	}
+	for v, def := range content.Versions {
+		ver, err := NewVersion(string(v))
+		if err != nil {
+			return nil, fmt.Errorf("version %q: %w", v, err)
+		}
+		t.revisions = append(t.revisions, NewRevision(ver, versionDefV10(def)))
+	}
+	sort.Slice(t.revisions, func(i, j int) bool {
+		return t.revisions[i].Version().LessThan(t.revisions[j].Version())
+	})
+	return t, nil
+}
+
+// versionDefV10 converts a version definition of the 1.1 file format
+// into the 1.0 one, without the metric splits.
+func versionDefV10(def ast.VersionDef) ast10.VersionDef {
+	metrics := ast10.Metrics{Changes: make([]ast10.MetricsChange, 0, len(def.Metrics.Changes))}
+	for _, ch := range def.Metrics.Changes {
+		if ch.RenameMetrics == nil && ch.RenameAttributes == nil {
+			continue
+		}
+		metrics.Changes = append(metrics.Changes, ast10.MetricsChange{
+			RenameMetrics:    ch.RenameMetrics,
+			RenameAttributes: ch.RenameAttributes,
+		})
+	}
+	return ast10.VersionDef{
+		All:        def.All,
+		Resources:  def.Resources,
+		Spans:      def.Spans,
+		SpanEvents: def.SpanEvents,
+		Logs:       def.Logs,
+		Metrics:    metrics,
+	}
+}
+
+// Translator returns the translator of signals from one version to another,
+// published as the target schema URL.
+// Upgrading applies the revisions newer than `from` up to `to`,
+// while downgrading rolls back the revisions newer than `to` up to `from` in reverse order.
+func (t *Translation) Translator(from, to *Version, targetSchemaURL string) *Translator {
+	tr := &Translator{targetSchemaURL: targetSchemaURL}
+	switch {
+	case from.LessThan(to):
+		tr.selector = migrate.StateSelectorApply
+		for _, rev := range t.revisions {
+			if rev.Version().GreaterThan(from) && !rev.Version().GreaterThan(to) {
+				tr.revisions = append(tr.revisions, rev)
+			}
+		}
+	case from.GreaterThan(to):
+		tr.selector = migrate.StateSelectorRollback
+		for i := len(t.revisions) - 1; i >= 0; i-- {
+			rev := t.revisions[i]
+			if rev.Version().GreaterThan(to) && !rev.Version().GreaterThan(from) {
+				tr.revisions = append(tr.revisions, rev)
+			}
+		}
+	}
+	return tr
+}
+
+// Translator translates signals from one schema version to another.
+// The returned errors report attribute name conflicts, the signals being translated nonetheless.
+type Translator struct {
+	targetSchemaURL string
+	selector        migrate.StateSelector
+	revisions       []*RevisionV1
+}
+
+// TargetSchemaURL returns the schema URL of the translated signals.
+func (t *Translator) TargetSchemaURL() string {
+	return t.targetSchemaURL
+}
+
+func (t *Translator) ApplyResourceChanges(resource pcommon.Resource) (errs error) {
+	for _, rev := range t.revisions {
+		errs = multierr.Append(errs, rev.applyResource(resource, t.selector))
+	}
+	return errs
+}
+
+func (t *Translator) ApplyScopeSpanChanges(scope ptrace.ScopeSpans) (errs error) {
+	for _, rev := range t.revisions {
+		for i := 0; i < scope.Spans().Len(); i++ {
+			errs = multierr.Append(errs, rev.applySpan(scope.Spans().At(i), t.selector))
+		}
+	}
+	return errs
+}
+
+func (t *Translator) ApplyScopeLogChanges(scope plog.ScopeLogs) (errs error) {
+	for _, rev := range t.revisions {
+		for i := 0; i < scope.LogRecords().Len(); i++ {
+			errs = multierr.Append(errs, rev.applyLogRecord(scope.LogRecords().At(i), t.selector))
+		}
+	}
+	return errs
+}
+
+func (t *Translator) ApplyScopeMetricChanges(scope pmetric.ScopeMetrics) (errs error) {
+	for _, rev := range t.revisions {
+		for i := 0; i < scope.Metrics().Len(); i++ {
+			errs = multierr.Append(errs, rev.applyMetric(scope.Metrics().At(i), t.selector))
+		}
+	}
+	return errs
+}
@@ -0,0 +1,162 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package translation
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/collector/pdata/pcommon"
+	"go.opentelemetry.io/collector/pdata/plog"
+	"go.opentelemetry.io/collector/pdata/pmetric"
+	"go.opentelemetry.io/collector/pdata/ptrace"
+)
+
+const testTargetURL = "https://example.com/schemas/1.0.0"
+
+func newTestTranslation(t *testing.T) *Translation {
+	f, err := os.Open(filepath.Join("..", "..", "testdata", "schema.yaml"))
+	require.NoError(t, err, "Must be able to open the test schema file")
+	defer f.Close()
+
+	tn, err := NewTranslationFromReader(f)
+	require.NoError(t, err, "Must be able to parse the test schema file")
+	return tn
+}
+
+func TestNewTranslationFromReaderErrors(t *testing.T) {
+	t.Parallel()
+
+	_, err := NewTranslationFromReader(strings.NewReader("file_format: 2.0.0\nschema_url: https://example.com/schemas/1.0.0\n"))
+	assert.Error(t, err, "Must error on an unsupported file format")
+
+	_, err = NewTranslationFromReader(strings.NewReader("file_format: 1.1.0\nschema_url: https://example.com/schemas/1.0.0\nversions:\n  1.0:\n"))
+	assert.ErrorIs(t, err, ErrInvalidVersion, "Must error on an invalid version")
+}
+
+func TestTranslatorRevisions(t *testing.T) {
+	t.Parallel()
+
+	tn := newTestTranslation(t)
+	versions := func(tr *Translator) (vers []string) {
+		for _, rev := range tr.revisions {
+			vers = append(vers, rev.Version().String())
+		}
+		return vers
+	}
+
+	assert.Equal(t, []string{"1.1.0", "1.2.0"}, versions(tn.Translator(&Version{1, 0, 0}, &Version{1, 2, 0}, testTargetURL)))
+	assert.Equal(t, []string{"1.2.0"}, versions(tn.Translator(&Version{1, 1, 0}, &Version{1, 2, 0}, testTargetURL)))
+	assert.Equal(t, []string{"1.2.0", "1.1.0"}, versions(tn.Translator(&Version{1, 2, 0}, &Version{1, 0, 0}, testTargetURL)))
+	assert.Empty(t, versions(tn.Translator(&Version{1, 1, 0}, &Version{1, 1, 0}, testTargetURL)))
+	assert.Equal(t, testTargetURL, tn.Translator(&Version{1, 1, 0}, &Version{1, 0, 0}, testTargetURL).TargetSchemaURL())
+}
+
+func TestTranslatorResource(t *testing.T) {
+	t.Parallel()
+
+	tn := newTestTranslation(t)
+	resource := pcommon.NewResource()
+	resource.Attributes().PutStr("peer.ip", "127.0.0.1")
+	resource.Attributes().PutStr("telemetry.auto.version", "1.0.0")
+
+	require.NoError(t, tn.Translator(&Version{1, 0, 0}, &Version{1, 2, 0}, testTargetURL).ApplyResourceChanges(resource))
+	assert.Equal(t, map[string]any{
+		"net.sock.peer.addr":       "127.0.0.1",
+		"telemetry.distro.version": "1.0.0",
+	}, resource.Attributes().AsRaw())
+
+	require.NoError(t, tn.Translator(&Version{1, 2, 0}, &Version{1, 0, 0}, testTargetURL).ApplyResourceChanges(resource))
+	assert.Equal(t, map[string]any{
+		"peer.ip":                "127.0.0.1",
+		"telemetry.auto.version": "1.0.0",
+	}, resource.Attributes().AsRaw())
+}
+
+func TestTranslatorSpans(t *testing.T) {
+	t.Parallel()
+
+	tn := newTestTranslation(t)
+	scope := ptrace.NewScopeSpans()
+	get := scope.Spans().AppendEmpty()
+	get.SetName("GET /")
+	get.Attributes().PutStr("http.method", "GET")
+	get.Attributes().PutStr("peer.ip", "127.0.0.1")
+	exception := get.Events().AppendEmpty()
+	exception.SetName("exception.thrown")
+	exception.Attributes().PutStr("exception.msg", "boom")
+	retry := get.Events().AppendEmpty()
+	retry.SetName("retry")
+	retry.Attributes().PutStr("exception.msg", "boom")
+	post := scope.Spans().AppendEmpty()
+	post.SetName("POST /")
+	post.Attributes().PutStr("http.method", "POST")
+	original := ptrace.NewScopeSpans()
+	scope.CopyTo(original)
+
+	require.NoError(t, tn.Translator(&Version{1, 0, 0}, &Version{1, 2, 0}, testTargetURL).ApplyScopeSpanChanges(scope))
+	assert.Equal(t, map[string]any{"http.request.method": "GET", "net.sock.peer.addr": "127.0.0.1"}, get.Attributes().AsRaw())
+	assert.Equal(t, "exception", exception.Name())
+	assert.Equal(t, map[string]any{"exception.message": "boom"}, exception.Attributes().AsRaw())
+	assert.Equal(t, "retry", retry.Name())
+	assert.Equal(t, map[string]any{"exception.msg": "boom"}, retry.Attributes().AsRaw(), "Must only apply to the matching events")
+	assert.Equal(t, map[string]any{"http.method": "POST"}, post.Attributes().AsRaw(), "Must only apply to the matching spans")
+
+	require.NoError(t, tn.Translator(&Version{1, 2, 0}, &Version{1, 0, 0}, testTargetURL).ApplyScopeSpanChanges(scope))
+	assert.Equal(t, original, scope, "Must roll back to the original spans")
+}
+
+func TestTranslatorLogs(t *testing.T) {
+	t.Parallel()
+
+	tn := newTestTranslation(t)
+	scope := plog.NewScopeLogs()
+	record := scope.LogRecords().AppendEmpty()
+	record.Attributes().PutStr("log.file", "app.log")
+	record.Attributes().PutStr("net.peer.ip", "127.0.0.1")
+
+	require.NoError(t, tn.Translator(&Version{1, 1, 0}, &Version{1, 2, 0}, testTargetURL).ApplyScopeLogChanges(scope))
+	assert.Equal(t, map[string]any{
+		"log.file.name":      "app.log",
+		"net.sock.peer.addr": "127.0.0.1",
+	}, record.Attributes().AsRaw())
+
+	require.NoError(t, tn.Translator(&Version{1, 2, 0}, &Version{1, 0, 0}, testTargetURL).ApplyScopeLogChanges(scope))
+	assert.Equal(t, map[string]any{
+		"log.file": "app.log",
+		"peer.ip":  "127.0.0.1",
+	}, record.Attributes().AsRaw())
+}
+
+func TestTranslatorMetrics(t *testing.T) {
+	t.Parallel()
+
+	tn := newTestTranslation(t)
+	scope := pmetric.NewScopeMetrics()
+	usage := scope.Metrics().AppendEmpty()
+	usage.SetName("process.runtime.jvm.memory.usage")
+	usage.SetEmptySum().DataPoints().AppendEmpty().Attributes().PutStr("type", "heap")
+	other := scope.Metrics().AppendEmpty()
+	other.SetName("process.runtime.jvm.threads.count")
+	other.SetEmptyGauge().DataPoints().AppendEmpty().Attributes().PutStr("type", "daemon")
+	histogram := scope.Metrics().AppendEmpty()
+	histogram.SetName("rpc.duration")
+	histogram.SetEmptyHistogram().DataPoints().AppendEmpty().Attributes().PutStr("net.peer.ip", "127.0.0.1")
+	original := pmetric.NewScopeMetrics()
+	scope.CopyTo(original)
+
+	require.NoError(t, tn.Translator(&Version{1, 1, 0}, &Version{1, 2, 0}, testTargetURL).ApplyScopeMetricChanges(scope))
+	assert.Equal(t, "jvm.memory.usage", usage.Name())
+	assert.Equal(t, map[string]any{"jvm.memory.type": "heap"}, usage.Sum().DataPoints().At(0).Attributes().AsRaw())
+	assert.Equal(t, "process.runtime.jvm.threads.count", other.Name())
+	assert.Equal(t, map[string]any{"type": "daemon"}, other.Gauge().DataPoints().At(0).Attributes().AsRaw(), "Must only apply to the matching metrics")
+	assert.Equal(t, map[string]any{"net.sock.peer.addr": "127.0.0.1"}, histogram.Histogram().DataPoints().At(0).Attributes().AsRaw())
+
+	require.NoError(t, tn.Translator(&Version{1, 2, 0}, &Version{1, 1, 0}, testTargetURL).ApplyScopeMetricChanges(scope))
+	assert.Equal(t, original, scope, "Must roll back to the original metrics")
+}
@@ -8,16 +8,26 @@ import (
 	"errors"
 
 	"go.opentelemetry.io/collector/component"
+	"go.opentelemetry.io/collector/config/confighttp"
 	"go.opentelemetry.io/collector/pdata/plog"
 	"go.opentelemetry.io/collector/pdata/pmetric"
 	"go.opentelemetry.io/collector/pdata/ptrace"
 	"go.opentelemetry.io/collector/processor"
 	"go.uber.org/zap"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/processor/schemaprocessor/internal/alias"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/processor/schemaprocessor/internal/translation"
 )
 
 type transformer struct {
-	targets []string
-	log     *zap.Logger
+	targets     []string
+	prefetch    []string
+	schemaFiles map[string]string
+	client      confighttp.ClientConfig
+	telemetry   component.TelemetrySettings
+	log         *zap.Logger
+
+	manager *translation.Manager
 }
 
 func newTransformer(
@@ -30,28 +40,132 @@ func newTransformer(
 		return nil, errors.New("invalid configuration provided")
 	}
 	return &transformer{
-		log:     set.Logger,
-		targets: cfg.Targets,
+		log:         set.Logger,
+		telemetry:   set.TelemetrySettings,
+		targets:     cfg.Targets,
+		prefetch:    cfg.Prefetch,
+		schemaFiles: cfg.SchemaFiles,
+		client:      cfg.ClientConfig,
 	}, nil
 }
 
-func (t transformer) processLogs(_ context.Context, ld plog.Logs) (plog.Logs, error) {
+func (t transformer) processLogs(ctx context.Context, ld plog.Logs) (plog.Logs, error) {
+	for i := 0; i < ld.ResourceLogs().Len(); i++ {
+		rl := ld.ResourceLogs().At(i)
+		resourceTranslator := t.translateResource(ctx, rl)
+		for j := 0; j < rl.ScopeLogs().Len(); j++ {
+			sl := rl.ScopeLogs().At(j)
+			translator := t.scopeTranslator(ctx, resourceTranslator, sl)
+			if translator == nil {
+				continue
+			}
+			t.logConflicts(translator.ApplyScopeLogChanges(sl))
+			if sl.SchemaUrl() != "" {
+				sl.SetSchemaUrl(translator.TargetSchemaURL())
+			}
+		}
+	}
 	return ld, nil
 }
 
-func (t transformer) processMetrics(_ context.Context, md pmetric.Metrics) (pmetric.Metrics, error) {
+func (t transformer) processMetrics(ctx context.Context, md pmetric.Metrics) (pmetric.Metrics, error) {
+	for i := 0; i < md.ResourceMetrics().Len(); i++ {
+		rm := md.ResourceMetrics().At(i)
+		resourceTranslator := t.translateResource(ctx, rm)
+		for j := 0; j < rm.ScopeMetrics().Len(); j++ {
+			sm := rm.ScopeMetrics().At(j)
+			translator := t.scopeTranslator(ctx, resourceTranslator, sm)
+			if translator == nil {
+				continue
+			}
+			t.logConflicts(translator.ApplyScopeMetricChanges(sm))
+			if sm.SchemaUrl() != "" {
+				sm.SetSchemaUrl(translator.TargetSchemaURL())
+			}
+		}
+	}
 	return md, nil
 }
 
-func (t transformer) processTraces(_ context.Context, td ptrace.Traces) (ptrace.Traces, error) {
+func (t transformer) processTraces(ctx context.Context, td ptrace.Traces) (ptrace.Traces, error) {
+	for i := 0; i < td.ResourceSpans().Len(); i++ {
+		rs := td.ResourceSpans().At(i)
+		resourceTranslator := t.translateResource(ctx, rs)
+		for j := 0; j < rs.ScopeSpans().Len(); j++ {
+			ss := rs.ScopeSpans().At(j)
+			translator := t.scopeTranslator(ctx, resourceTranslator, ss)
+			if translator == nil {
+				continue
+			}
+			t.logConflicts(translator.ApplyScopeSpanChanges(ss))
+			if ss.SchemaUrl() != "" {
+				ss.SetSchemaUrl(translator.TargetSchemaURL())
+			}
+		}
+	}
 	return td, nil
 }
 
+// translateResource translates the resource to the target schema URL,
+// and returns the translator of its scopes without their own schema URL.
+func (t transformer) translateResource(ctx context.Context, rs alias.Resource) *translation.Translator {
+	translator := t.translator(ctx, rs.SchemaUrl())
+	if translator == nil {
+		return nil
+	}
+	t.logConflicts(translator.ApplyResourceChanges(rs.Resource()))
+	rs.SetSchemaUrl(translator.TargetSchemaURL())
+	return translator
+}
+
+// scopeTranslator returns the translator of the scope,
+// the scope schema URL taking precedence over the one of its resource.
+func (t transformer) scopeTranslator(ctx context.Context, resourceTranslator *translation.Translator, scope alias.Scope) *translation.Translator {
+	if scope.SchemaUrl() == "" {
+		return resourceTranslator
+	}
+	return t.translator(ctx, scope.SchemaUrl())
+}
+
+// translator returns the translator of the signals published with the schema URL,
+// or nil if they are passed through unchanged.
+func (t transformer) translator(ctx context.Context, schemaURL string) *translation.Translator {
+	translator, err := t.manager.RequestTranslator(ctx, schemaURL)
+	if err != nil {
+		t.log.Debug("Signals left untranslated",
+			zap.String("schema-url", schemaURL),
+			zap.Error(err),
+		)
+		return nil
+	}
+	return translator
+}
+
+func (t transformer) logConflicts(err error) {
+	if err != nil {
+		t.log.Debug("Conflicting attributes while translating signals", zap.Error(err))
+	}
+}
+
 // start will load the remote file definition if it isn't already cached
 // and resolve the schema translation file
-func (t *transformer) start(_ context.Context, _ component.Host) error {
-	for _, target := range t.targets {
-		t.log.Info("Fetching remote schema url", zap.String("schema-url", target))
+func (t *transformer) start(ctx context.Context, host component.Host) error {
+	client, err := t.client.ToClient(ctx, host, t.telemetry)
+	if err != nil {
+		return err
+	}
+	t.manager, err = translation.NewManager(
+		t.targets,
+		translation.NewFileProvider(t.schemaFiles, translation.NewHTTPProvider(client)),
+		t.log,
+	)
+	if err != nil {
+		return err
+	}
+	for _, schemaURL := range t.prefetch {
+		t.log.Info("Fetching remote schema url", zap.String("schema-url", schemaURL))
+		// The failures are logged by the manager, the schema file being retrieved again when needed.
+		_, _ = t.manager.RequestTranslation(ctx, schemaURL)
 	}
 	return nil
 }
@@ -6,11 +6,13 @@ package schemaprocessor
 import (
 	"context"
 	_ "embed"
+	"path/filepath"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 	"go.opentelemetry.io/collector/component"
+	"go.opentelemetry.io/collector/component/componenttest"
 	"go.opentelemetry.io/collector/pdata/plog"
 	"go.opentelemetry.io/collector/pdata/pmetric"
 	"go.opentelemetry.io/collector/pdata/ptrace"
@@ -25,13 +27,19 @@ func newTestTransformer(t *testing.T) *transformer {
 		},
 	})
 	require.NoError(t, err, "Must not error when creating default transformer")
+	require.NoError(t, trans.start(context.Background(), componenttest.NewNopHost()))
 	return trans
 }
 
 func TestTransformerStart(t *testing.T) {
 	t.Parallel()
 
-	trans := newTestTransformer(t)
+	trans, err := newTransformer(context.Background(), newDefaultConfiguration(), processor.CreateSettings{
+		TelemetrySettings: component.TelemetrySettings{
+			Logger: zaptest.NewLogger(t),
+		},
+	})
+	require.NoError(t, err, "Must not error when creating default transformer")
 	assert.NoError(t, trans.start(context.Background(), nil))
 }
 
@@ -52,7 +60,7 @@ func TestTransformerProcessing(t *testing.T) {
 
 		out, err := trans.processMetrics(context.Background(), in)
 		assert.NoError(t, err, "Must not error when processing metrics")
-		assert.Equal(t, in, out, "Must return the same data (subject to change)")
+		assert.Equal(t, in, out, "Must return the same data without matching targets")
 	})
 
 	t.Run("traces", func(t *testing.T) {
@@ -68,7 +76,7 @@ func TestTransformerProcessing(t *testing.T) {
 
 		out, err := trans.processTraces(context.Background(), in)
 		assert.NoError(t, err, "Must not error when processing metrics")
-		assert.Equal(t, in, out, "Must return the same data (subject to change)")
+		assert.Equal(t, in, out, "Must return the same data without matching targets")
 	})
 
 	t.Run("logs", func(t *testing.T) {
@@ -83,6 +91,107 @@ func TestTransformerProcessing(t *testing.T) {
 
 		out, err := trans.processLogs(context.Background(), in)
 		assert.NoError(t, err, "Must not error when processing metrics")
-		assert.Equal(t, in, out, "Must return the same data (subject to change)")
+		assert.Equal(t, in, out, "Must return the same data without matching targets")
+	})
+}
+
+func newTranslatingTransformer(t *testing.T) *transformer {
+	cfg := newDefaultConfiguration().(*Config)
+	cfg.Targets = []string{"https://example.com/schemas/1.0.0"}
+	cfg.SchemaFiles = map[string]string{
+		"https://example.com/schemas/1.2.0": filepath.Join("testdata", "schema.yaml"),
+	}
+	trans, err := newTransformer(context.Background(), cfg, processor.CreateSettings{
+		TelemetrySettings: component.TelemetrySettings{
+			Logger: zaptest.NewLogger(t),
+		},
+	})
+	require.NoError(t, err, "Must not error when creating the transformer")
+	require.NoError(t, trans.start(context.Background(), componenttest.NewNopHost()))
+	return trans
+}
+
+func TestTransformerTranslation(t *testing.T) {
+	t.Parallel()
+
+	trans := newTranslatingTransformer(t)
+	t.Run("metrics", func(t *testing.T) {
+		in := pmetric.NewMetrics()
+		rm := in.ResourceMetrics().AppendEmpty()
+		rm.SetSchemaUrl("https://example.com/schemas/1.2.0")
+		rm.Resource().Attributes().PutStr("telemetry.distro.version", "1.0.0")
+		m := rm.ScopeMetrics().AppendEmpty().Metrics().AppendEmpty()
+		m.SetName("jvm.memory.usage")
+		m.SetEmptySum().DataPoints().AppendEmpty().Attributes().PutStr("jvm.memory.type", "heap")
+
+		out, err := trans.processMetrics(context.Background(), in)
+		require.NoError(t, err, "Must not error when processing metrics")
+		rm = out.ResourceMetrics().At(0)
+		assert.Equal(t, "https://example.com/schemas/1.0.0", rm.SchemaUrl())
+		assert.Equal(t, map[string]any{"telemetry.auto.version": "1.0.0"}, rm.Resource().Attributes().AsRaw())
+		assert.Empty(t, rm.ScopeMetrics().At(0).SchemaUrl(), "Must keep inheriting the resource schema URL")
+		m = rm.ScopeMetrics().At(0).Metrics().At(0)
+		assert.Equal(t, "process.runtime.jvm.memory.usage", m.Name())
+		assert.Equal(t, map[string]any{"type": "heap"}, m.Sum().DataPoints().At(0).Attributes().AsRaw())
+	})
+
+	t.Run("traces", func(t *testing.T) {
+		in := ptrace.NewTraces()
+		rs := in.ResourceSpans().AppendEmpty()
+		rs.SetSchemaUrl("https://example.com/schemas/1.2.0")
+		rs.Resource().Attributes().PutStr("net.sock.peer.addr", "127.0.0.1")
+		// the scope schema URL takes precedence over the resource one
+		ss := rs.ScopeSpans().AppendEmpty()
+		ss.SetSchemaUrl("https://example.com/schemas/1.1.0")
+		s := ss.Spans().AppendEmpty()
+		s.SetName("GET /")
+		s.Attributes().PutStr("net.peer.ip", "127.0.0.1")
+		s.Attributes().PutStr("http.method", "GET")
+
+		out, err := trans.processTraces(context.Background(), in)
+		require.NoError(t, err, "Must not error when processing traces")
+		rs = out.ResourceSpans().At(0)
+		assert.Equal(t, "https://example.com/schemas/1.0.0", rs.SchemaUrl())
+		assert.Equal(t, map[string]any{"peer.ip": "127.0.0.1"}, rs.Resource().Attributes().AsRaw())
+		ss = rs.ScopeSpans().At(0)
+		assert.Equal(t, "https://example.com/schemas/1.0.0", ss.SchemaUrl())
+		assert.Equal(t, map[string]any{"peer.ip": "127.0.0.1", "http.method": "GET"}, ss.Spans().At(0).Attributes().AsRaw())
+	})
+
+	t.Run("logs", func(t *testing.T) {
+		in := plog.NewLogs()
+		rl := in.ResourceLogs().AppendEmpty()
+		// only the scope has a schema URL
+		sl := rl.ScopeLogs().AppendEmpty()
+		sl.SetSchemaUrl("https://example.com/schemas/1.2.0")
+		sl.LogRecords().AppendEmpty().Attributes().PutStr("log.file.name", "app.log")
+		// the schema file of the other families isn't available
+		other := in.ResourceLogs().AppendEmpty()
+		other.SetSchemaUrl("https://opentelemetry.io/schemas/1.9.0")
+		other.Resource().Attributes().PutStr("log.file.name", "app.log")
+
+		out, err := trans.processLogs(context.Background(), in)
+		require.NoError(t, err, "Must not error when processing logs")
+		rl = out.ResourceLogs().At(0)
+		assert.Empty(t, rl.SchemaUrl())
+		sl = rl.ScopeLogs().At(0)
+		assert.Equal(t, "https://example.com/schemas/1.0.0", sl.SchemaUrl())
+		assert.Equal(t, map[string]any{"log.file": "app.log"}, sl.LogRecords().At(0).Attributes().AsRaw())
+		other = out.ResourceLogs().At(1)
+		assert.Equal(t, "https://opentelemetry.io/schemas/1.9.0", other.SchemaUrl())
+		assert.Equal(t, map[string]any{"log.file.name": "app.log"}, other.Resource().Attributes().AsRaw())
+	})
+
+	t.Run("unavailable schema file", func(t *testing.T) {
+		in := plog.NewLogs()
+		rl := in.ResourceLogs().AppendEmpty()
+		rl.SetSchemaUrl("https://example.com/schemas/1.3.0")
+		rl.Resource().Attributes().PutStr("net.sock.peer.addr", "127.0.0.1")
+		expected := plog.NewLogs()
+		in.CopyTo(expected)
+
+		out, err := trans.processLogs(context.Background(), in)
+		require.NoError(t, err, "Must pass the logs through")
+		assert.Equal(t, expected, out)
 	})
 }
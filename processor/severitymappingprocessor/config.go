@@ -0,0 +1,74 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package severitymappingprocessor // import "github.com/open-telemetry/opentelemetry-collector-contrib/processor/severitymappingprocessor"
+
+import (
+	"fmt"
+
+	"go.opentelemetry.io/collector/component"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/stanza/entry"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/stanza/operator/helper"
+)
+
+// Config defines configuration for the severity mapping processor.
+type Config struct {
+	// Attribute is the log record attribute holding the text severity. The severity text of the log records is
+	// used when empty.
+	Attribute string `mapstructure:"attribute"`
+
+	// Preset is the built-in mapping the mapping is added to, as in the severity parsing of the stanza operators:
+	// "default", "otel" or "none".
+	Preset string `mapstructure:"preset"`
+
+	// Mapping maps the severities to the values they are parsed from, as in the severity parsing of the stanza
+	// operators: each severity is mapped to a value, or a list of values, including ranges of integers and the
+	// HTTP status code classes, e.g. "5xx".
+	Mapping map[string]any `mapstructure:"mapping"`
+
+	// SyslogPriority interprets the integer values, possibly enclosed in angle brackets like in the syslog header,
+	// as syslog priorities, whose severity is mapped like by the syslog parser.
+	SyslogPriority bool `mapstructure:"syslog_priority"`
+
+	// OverwriteText replaces the severity text of the mapped log records with the name of their severity.
+	OverwriteText bool `mapstructure:"overwrite_text"`
+
+	// Override maps the log records already having a severity number as well. They are left untouched by default.
+	Override bool `mapstructure:"override"`
+}
+
+var _ component.Config = (*Config)(nil)
+
+// Validate checks if the processor configuration is valid.
+func (cfg *Config) Validate() error {
+	if _, err := cfg.severityParser(); err != nil {
+		return err
+	}
+	// the severity parser silently ignores the unknown severities, they are parsed with the default mapping to
+	// be reported
+	names, err := (&Config{}).severityParser()
+	if err != nil {
+		return err
+	}
+	ent := &entry.Entry{}
+	for severity := range cfg.Mapping {
+		ent.Body = severity
+		if parseErr := names.Parse(ent); parseErr != nil || ent.Severity == entry.Default {
+			return fmt.Errorf("unrecognized severity %q", severity)
+		}
+	}
+	return nil
+}
+
+// severityParser builds the severity parser of the stanza operators, parsing the severity from the body of an
+// entry holding the text severity.
+func (cfg *Config) severityParser() (helper.SeverityParser, error) {
+	body := entry.NewBodyField()
+	severityCfg := helper.NewSeverityConfig()
+	severityCfg.ParseFrom = &body
+	severityCfg.Preset = cfg.Preset
+	severityCfg.Mapping = cfg.Mapping
+	severityCfg.OverwriteText = cfg.OverwriteText
+	return severityCfg.Build(component.TelemetrySettings{})
+}
@@ -0,0 +1,9 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+//go:generate mdatagen metadata.yaml
+
+// Package severitymappingprocessor implements a processor that sets the
+// severity number of the log records from their text severity, according to
+// configurable mappings.
+package severitymappingprocessor // import "github.com/open-telemetry/opentelemetry-collector-contrib/processor/severitymappingprocessor"
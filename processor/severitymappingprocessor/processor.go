@@ -0,0 +1,175 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package severitymappingprocessor // import "github.com/open-telemetry/opentelemetry-collector-contrib/processor/severitymappingprocessor"
+
+import (
+	"context"
+	"strconv"
+	"strings"
+
+	"go.opentelemetry.io/collector/pdata/pcommon"
+	"go.opentelemetry.io/collector/pdata/plog"
+	"go.opentelemetry.io/collector/processor"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/metric"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/stanza/entry"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/stanza/operator/helper"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/processor/severitymappingprocessor/internal/metadata"
+)
+
+// syslogSeverities maps the syslog severities, the priority modulo 8, like the syslog parser.
+var syslogSeverities = [...]entry.Severity{
+	0: entry.Fatal,
+	1: entry.Error3,
+	2: entry.Error2,
+	3: entry.Error,
+	4: entry.Warn,
+	5: entry.Info2,
+	6: entry.Info,
+	7: entry.Debug,
+}
+
+var syslogSeverityTexts = [...]string{
+	0: "emerg",
+	1: "alert",
+	2: "crit",
+	3: "err",
+	4: "warning",
+	5: "notice",
+	6: "info",
+	7: "debug",
+}
+
+// maxSyslogPriority is the highest priority, of the local7 facility and the debug severity.
+const maxSyslogPriority = 191
+
+type severityProcessor struct {
+	attribute      string
+	parser         helper.SeverityParser
+	syslogPriority bool
+	overwriteText  bool
+	override       bool
+
+	telemetryBuilder *metadata.TelemetryBuilder
+	processorAttr    []attribute.KeyValue
+}
+
+func newSeverityProcessor(set processor.CreateSettings, cfg *Config) (*severityProcessor, error) {
+	parser, err := cfg.severityParser()
+	if err != nil {
+		return nil, err
+	}
+	telemetryBuilder, err := metadata.NewTelemetryBuilder(set.TelemetrySettings)
+	if err != nil {
+		return nil, err
+	}
+	return &severityProcessor{
+		attribute:        cfg.Attribute,
+		parser:           parser,
+		syslogPriority:   cfg.SyslogPriority,
+		overwriteText:    cfg.OverwriteText,
+		override:         cfg.Override,
+		telemetryBuilder: telemetryBuilder,
+		processorAttr:    []attribute.KeyValue{attribute.String(metadata.Type.String(), set.ID.String())},
+	}, nil
+}
+
+func (p *severityProcessor) processLogs(ctx context.Context, ld plog.Logs) (plog.Logs, error) {
+	var mapped, unmapped int64
+	// the entry only carries the text severity to the parser, it's reused for all the log records
+	ent := &entry.Entry{}
+	for i := 0; i < ld.ResourceLogs().Len(); i++ {
+		sls := ld.ResourceLogs().At(i).ScopeLogs()
+		for j := 0; j < sls.Len(); j++ {
+			records := sls.At(j).LogRecords()
+			for k := 0; k < records.Len(); k++ {
+				lr := records.At(k)
+				if !p.override && lr.SeverityNumber() != plog.SeverityNumberUnspecified {
+					continue
+				}
+				value, ok := p.textSeverity(lr)
+				if !ok {
+					continue
+				}
+				severity, text := p.mapSeverity(ent, value)
+				if severity == entry.Default {
+					unmapped++
+					continue
+				}
+				lr.SetSeverityNumber(plog.SeverityNumber(severity))
+				lr.SetSeverityText(text)
+				mapped++
+			}
+		}
+	}
+	attrs := metric.WithAttributes(p.processorAttr...)
+	if mapped > 0 {
+		p.telemetryBuilder.ProcessorSeveritymappingLogsMapped.Add(ctx, mapped, attrs)
+	}
+	if unmapped > 0 {
+		p.telemetryBuilder.ProcessorSeveritymappingLogsUnmapped.Add(ctx, unmapped, attrs)
+	}
+	return ld, nil
+}
+
+// textSeverity returns the text severity of the log record, from the attribute or the severity text, as a value
+// the severity parser accepts.
+func (p *severityProcessor) textSeverity(lr plog.LogRecord) (any, bool) {
+	if p.attribute == "" {
+		return lr.SeverityText(), lr.SeverityText() != ""
+	}
+	value, ok := lr.Attributes().Get(p.attribute)
+	if !ok {
+		return nil, false
+	}
+	switch value.Type() {
+	case pcommon.ValueTypeStr:
+		return value.Str(), true
+	case pcommon.ValueTypeInt:
+		return int(value.Int()), true
+	case pcommon.ValueTypeDouble:
+		return value.Double(), true
+	case pcommon.ValueTypeBytes:
+		return value.Bytes().AsRaw(), true
+	default:
+		return nil, false
+	}
+}
+
+// mapSeverity returns the severity of the value and its text, entry.Default when it doesn't match any mapping.
+func (p *severityProcessor) mapSeverity(ent *entry.Entry, value any) (entry.Severity, string) {
+	if p.syslogPriority {
+		if priority, ok := syslogPriority(value); ok {
+			severity := syslogSeverities[priority%8]
+			if p.overwriteText {
+				return severity, severity.String()
+			}
+			return severity, syslogSeverityTexts[priority%8]
+		}
+	}
+	ent.Body = value
+	ent.Severity = entry.Default
+	if err := p.parser.Parse(ent); err != nil {
+		return entry.Default, ""
+	}
+	return ent.Severity, ent.SeverityText
+}
+
+// syslogPriority returns the syslog priority of the value, an integer possibly enclosed in angle brackets.
+func syslogPriority(value any) (int, bool) {
+	var priority int
+	switch v := value.(type) {
+	case int:
+		priority = v
+	case string:
+		var err error
+		if priority, err = strconv.Atoi(strings.TrimSuffix(strings.TrimPrefix(v, "<"), ">")); err != nil {
+			return 0, false
+		}
+	default:
+		return 0, false
+	}
+	return priority, priority >= 0 && priority <= maxSyslogPriority
+}
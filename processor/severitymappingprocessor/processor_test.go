@@ -0,0 +1,116 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package severitymappingprocessor
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/collector/consumer/consumertest"
+	"go.opentelemetry.io/collector/pdata/plog"
+	"go.opentelemetry.io/collector/processor/processortest"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/sdk/metric/metricdata"
+)
+
+func TestProcessLogs(t *testing.T) {
+	tel := setupTestTelemetry()
+	cfg := &Config{
+		Mapping: map[string]any{
+			"error": []any{"E", "fatal_error"},
+			"info2": "notice",
+		},
+	}
+	next := new(consumertest.LogsSink)
+	p, err := NewFactory().CreateLogsProcessor(context.Background(), tel.NewCreateSettings(), cfg, next)
+	require.NoError(t, err)
+
+	ld := plog.NewLogs()
+	records := ld.ResourceLogs().AppendEmpty().ScopeLogs().AppendEmpty().LogRecords()
+	for _, text := range []string{"E", "Notice", "WARNING", "verbose", ""} {
+		records.AppendEmpty().SetSeverityText(text)
+	}
+	severe := records.AppendEmpty()
+	severe.SetSeverityText("fatal_error")
+	severe.SetSeverityNumber(plog.SeverityNumberFatal)
+
+	require.NoError(t, p.ConsumeLogs(context.Background(), ld))
+
+	got := next.AllLogs()[0].ResourceLogs().At(0).ScopeLogs().At(0).LogRecords()
+	assert.Equal(t, plog.SeverityNumberError, got.At(0).SeverityNumber())
+	assert.Equal(t, "E", got.At(0).SeverityText())
+	assert.Equal(t, plog.SeverityNumberInfo2, got.At(1).SeverityNumber())
+	assert.Equal(t, plog.SeverityNumberWarn, got.At(2).SeverityNumber())
+	assert.Equal(t, plog.SeverityNumberUnspecified, got.At(3).SeverityNumber())
+	assert.Equal(t, "verbose", got.At(3).SeverityText())
+	assert.Equal(t, plog.SeverityNumberUnspecified, got.At(4).SeverityNumber())
+	// the records with a severity number are left untouched
+	assert.Equal(t, plog.SeverityNumberFatal, got.At(5).SeverityNumber())
+
+	sum := func(name string, description string, value int64) metricdata.Metrics {
+		return metricdata.Metrics{
+			Name:        name,
+			Description: description,
+			Unit:        "1",
+			Data: metricdata.Sum[int64]{
+				Temporality: metricdata.CumulativeTemporality,
+				IsMonotonic: true,
+				DataPoints: []metricdata.DataPoint[int64]{
+					{
+						Value:      value,
+						Attributes: attribute.NewSet(attribute.String("severitymapping", "severitymapping")),
+					},
+				},
+			},
+		}
+	}
+	tel.assertMetrics(t, []metricdata.Metrics{
+		sum("processor_severitymapping_logs.mapped", "Number of log records whose severity number was set from their text severity", 3),
+		sum("processor_severitymapping_logs.unmapped", "Number of log records whose text severity didn't match any mapping", 1),
+	})
+}
+
+func TestProcessLogsFromAttribute(t *testing.T) {
+	cfg := &Config{
+		Attribute: "level",
+		Preset:    "none",
+		Mapping: map[string]any{
+			"warn": map[string]any{"min": 400, "max": 499},
+			"info": "2xx",
+		},
+		SyslogPriority: true,
+		OverwriteText:  true,
+		Override:       true,
+	}
+	next := new(consumertest.LogsSink)
+	p, err := NewFactory().CreateLogsProcessor(context.Background(), processortest.NewNopCreateSettings(), cfg, next)
+	require.NoError(t, err)
+
+	ld := plog.NewLogs()
+	records := ld.ResourceLogs().AppendEmpty().ScopeLogs().AppendEmpty().LogRecords()
+	records.AppendEmpty().Attributes().PutInt("level", 404)
+	records.AppendEmpty().Attributes().PutStr("level", "204")
+	syslog := records.AppendEmpty()
+	syslog.Attributes().PutStr("level", "<11>")
+	syslog.SetSeverityNumber(plog.SeverityNumberInfo)
+	records.AppendEmpty().Attributes().PutInt("level", 14)
+	records.AppendEmpty().Attributes().PutStr("level", "warn")
+	records.AppendEmpty().SetSeverityText("error")
+
+	require.NoError(t, p.ConsumeLogs(context.Background(), ld))
+
+	got := next.AllLogs()[0].ResourceLogs().At(0).ScopeLogs().At(0).LogRecords()
+	assert.Equal(t, plog.SeverityNumberWarn, got.At(0).SeverityNumber())
+	assert.Equal(t, "WARN", got.At(0).SeverityText())
+	assert.Equal(t, plog.SeverityNumberInfo, got.At(1).SeverityNumber())
+	// the priority of the user facility with the error severity
+	assert.Equal(t, plog.SeverityNumberError, got.At(2).SeverityNumber())
+	assert.Equal(t, "ERROR", got.At(2).SeverityText())
+	assert.Equal(t, plog.SeverityNumberInfo, got.At(3).SeverityNumber())
+	// the none preset doesn't map the severity names
+	assert.Equal(t, plog.SeverityNumberUnspecified, got.At(4).SeverityNumber())
+	assert.Equal(t, plog.SeverityNumberUnspecified, got.At(5).SeverityNumber())
+}
@@ -0,0 +1,114 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package spandedupprocessor // import "github.com/open-telemetry/opentelemetry-collector-contrib/processor/spandedupprocessor"
+
+import (
+	"container/list"
+	"encoding/binary"
+	"fmt"
+	"time"
+
+	"go.opentelemetry.io/collector/pdata/pcommon"
+)
+
+// spanKey identifies a span by its trace ID followed by its span ID.
+type spanKey [24]byte
+
+func newSpanKey(traceID pcommon.TraceID, spanID pcommon.SpanID) spanKey {
+	var key spanKey
+	copy(key[:16], traceID[:])
+	copy(key[16:], spanID[:])
+	return key
+}
+
+// entryLen is the length of an encoded cache entry: the span key followed by the time it was seen, in nanoseconds.
+const entryLen = len(spanKey{}) + 8
+
+type cacheEntry struct {
+	key  spanKey
+	seen time.Time
+}
+
+// spanCache remembers the spans seen within the window, up to a maximum number of spans. It isn't safe for
+// concurrent use.
+type spanCache struct {
+	window   time.Duration
+	maxSpans int
+
+	// order holds the cacheEntry of the spans, oldest first
+	order *list.List
+	spans map[spanKey]*list.Element
+}
+
+func newSpanCache(window time.Duration, maxSpans int) *spanCache {
+	return &spanCache{
+		window:   window,
+		maxSpans: maxSpans,
+		order:    list.New(),
+		spans:    map[spanKey]*list.Element{},
+	}
+}
+
+// seen reports whether the span was already seen within the window, and remembers it otherwise.
+func (c *spanCache) seen(key spanKey, now time.Time) bool {
+	c.expire(now)
+	if _, ok := c.spans[key]; ok {
+		return true
+	}
+	c.add(key, now)
+	return false
+}
+
+func (c *spanCache) add(key spanKey, seen time.Time) {
+	c.spans[key] = c.order.PushBack(cacheEntry{key: key, seen: seen})
+	for len(c.spans) > c.maxSpans {
+		c.remove(c.order.Front())
+	}
+}
+
+// expire forgets the spans seen before the window.
+func (c *spanCache) expire(now time.Time) {
+	for e := c.order.Front(); e != nil && now.Sub(e.Value.(cacheEntry).seen) >= c.window; e = c.order.Front() {
+		c.remove(e)
+	}
+}
+
+func (c *spanCache) remove(e *list.Element) {
+	c.order.Remove(e)
+	delete(c.spans, e.Value.(cacheEntry).key)
+}
+
+func (c *spanCache) len() int {
+	return len(c.spans)
+}
+
+// marshal encodes the remembered spans, oldest first.
+func (c *spanCache) marshal() []byte {
+	buf := make([]byte, 0, c.order.Len()*entryLen)
+	for e := c.order.Front(); e != nil; e = e.Next() {
+		entry := e.Value.(cacheEntry)
+		buf = append(buf, entry.key[:]...)
+		buf = binary.BigEndian.AppendUint64(buf, uint64(entry.seen.UnixNano()))
+	}
+	return buf
+}
+
+// unmarshal adds the spans encoded by marshal that are still within the window.
+func (c *spanCache) unmarshal(buf []byte, now time.Time) error {
+	if len(buf)%entryLen != 0 {
+		return fmt.Errorf("invalid length %d of the saved spans", len(buf))
+	}
+	for ; len(buf) > 0; buf = buf[entryLen:] {
+		var key spanKey
+		copy(key[:], buf)
+		seen := time.Unix(0, int64(binary.BigEndian.Uint64(buf[len(key):entryLen])))
+		if now.Sub(seen) >= c.window {
+			continue
+		}
+		if _, ok := c.spans[key]; !ok {
+			c.add(key, seen)
+		}
+	}
+	return nil
+}
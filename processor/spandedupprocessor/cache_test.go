@@ -0,0 +1,65 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package spandedupprocessor
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/collector/pdata/pcommon"
+)
+
+func testSpanKey(i byte) spanKey {
+	return newSpanKey(pcommon.TraceID([16]byte{1, 2, 3, i}), pcommon.SpanID([8]byte{4, 5, 6, i}))
+}
+
+func TestSpanCacheWindow(t *testing.T) {
+	now := time.Unix(1_700_000_000, 0)
+	c := newSpanCache(time.Minute, 100)
+
+	assert.False(t, c.seen(testSpanKey(1), now))
+	assert.True(t, c.seen(testSpanKey(1), now.Add(30*time.Second)))
+	assert.False(t, c.seen(testSpanKey(2), now.Add(30*time.Second)))
+
+	// the first span expired, the second one is still within the window
+	assert.False(t, c.seen(testSpanKey(1), now.Add(time.Minute)))
+	assert.True(t, c.seen(testSpanKey(2), now.Add(time.Minute)))
+	assert.Equal(t, 2, c.len())
+}
+
+func TestSpanCacheMaxSpans(t *testing.T) {
+	now := time.Unix(1_700_000_000, 0)
+	c := newSpanCache(time.Minute, 2)
+
+	assert.False(t, c.seen(testSpanKey(1), now))
+	assert.False(t, c.seen(testSpanKey(2), now))
+	assert.False(t, c.seen(testSpanKey(3), now))
+	assert.Equal(t, 2, c.len())
+
+	// the oldest span was forgotten
+	assert.True(t, c.seen(testSpanKey(3), now))
+	assert.False(t, c.seen(testSpanKey(1), now))
+}
+
+func TestSpanCacheMarshal(t *testing.T) {
+	now := time.Unix(1_700_000_000, 0)
+	c := newSpanCache(time.Minute, 100)
+	c.seen(testSpanKey(1), now)
+	c.seen(testSpanKey(2), now.Add(30*time.Second))
+
+	restored := newSpanCache(time.Minute, 100)
+	require.NoError(t, restored.unmarshal(c.marshal(), now.Add(45*time.Second)))
+	assert.Equal(t, 2, restored.len())
+	assert.True(t, restored.seen(testSpanKey(1), now.Add(45*time.Second)))
+
+	// expired spans aren't restored
+	restored = newSpanCache(time.Minute, 100)
+	require.NoError(t, restored.unmarshal(c.marshal(), now.Add(time.Minute)))
+	assert.Equal(t, 1, restored.len())
+	assert.True(t, restored.seen(testSpanKey(2), now.Add(time.Minute)))
+
+	assert.EqualError(t, restored.unmarshal([]byte{1, 2, 3}, now), "invalid length 3 of the saved spans")
+}
@@ -0,0 +1,40 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package spandedupprocessor // import "github.com/open-telemetry/opentelemetry-collector-contrib/processor/spandedupprocessor"
+
+import (
+	"errors"
+	"time"
+
+	"go.opentelemetry.io/collector/component"
+)
+
+// Config defines configuration for the span deduplication processor.
+type Config struct {
+	// Window is how long a span is remembered after it was first seen. A span delivered again within the window
+	// is dropped. It should cover the time it takes for a transport to redeliver the data, e.g. a Kafka consumer
+	// group rebalance.
+	Window time.Duration `mapstructure:"window"`
+
+	// MaxSpans is the maximum number of spans remembered. When reached, the oldest spans are forgotten before the
+	// end of the window.
+	MaxSpans int `mapstructure:"max_spans"`
+
+	// StorageID is the optional storage extension where the remembered spans are saved on shutdown, so that the
+	// data redelivered after a restart of the collector is deduplicated as well.
+	StorageID *component.ID `mapstructure:"storage"`
+}
+
+var _ component.Config = (*Config)(nil)
+
+// Validate checks if the processor configuration is valid.
+func (cfg *Config) Validate() error {
+	if cfg.Window <= 0 {
+		return errors.New("window must be positive")
+	}
+	if cfg.MaxSpans <= 0 {
+		return errors.New("max_spans must be positive")
+	}
+	return nil
+}
@@ -0,0 +1,8 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+//go:generate mdatagen metadata.yaml
+
+// Package spandedupprocessor implements a processor that drops the spans
+// already seen recently, as delivered again by at-least-once transports.
+package spandedupprocessor // import "github.com/open-telemetry/opentelemetry-collector-contrib/processor/spandedupprocessor"
@@ -0,0 +1,137 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package spandedupprocessor // import "github.com/open-telemetry/opentelemetry-collector-contrib/processor/spandedupprocessor"
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+
+	"go.opentelemetry.io/collector/component"
+	"go.opentelemetry.io/collector/extension/experimental/storage"
+	"go.opentelemetry.io/collector/pdata/ptrace"
+	"go.opentelemetry.io/collector/processor"
+	"go.opentelemetry.io/collector/processor/processorhelper"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/metric"
+	"go.uber.org/zap"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/processor/spandedupprocessor/internal/metadata"
+)
+
+// storageKey is the key of the remembered spans in the storage extension.
+const storageKey = "spans"
+
+type spanDedupProcessor struct {
+	id        component.ID
+	logger    *zap.Logger
+	storageID *component.ID
+
+	telemetryBuilder *metadata.TelemetryBuilder
+	processorAttr    []attribute.KeyValue
+
+	mu    sync.Mutex
+	cache *spanCache
+	now   func() time.Time
+
+	storageClient storage.Client
+}
+
+func newSpanDedupProcessor(set processor.CreateSettings, cfg *Config) (*spanDedupProcessor, error) {
+	telemetryBuilder, err := metadata.NewTelemetryBuilder(set.TelemetrySettings)
+	if err != nil {
+		return nil, err
+	}
+	return &spanDedupProcessor{
+		id:               set.ID,
+		logger:           set.Logger,
+		storageID:        cfg.StorageID,
+		telemetryBuilder: telemetryBuilder,
+		processorAttr:    []attribute.KeyValue{attribute.String(metadata.Type.String(), set.ID.String())},
+		cache:            newSpanCache(cfg.Window, cfg.MaxSpans),
+		now:              time.Now,
+	}, nil
+}
+
+func (p *spanDedupProcessor) start(ctx context.Context, host component.Host) error {
+	if p.storageID == nil {
+		return nil
+	}
+	ext, ok := host.GetExtensions()[*p.storageID]
+	if !ok {
+		return fmt.Errorf("storage extension '%s' not found", p.storageID)
+	}
+	storageExt, ok := ext.(storage.Extension)
+	if !ok {
+		return fmt.Errorf("non-storage extension '%s' found", p.storageID)
+	}
+	client, err := storageExt.GetClient(ctx, component.KindProcessor, p.id, "")
+	if err != nil {
+		return fmt.Errorf("failed to get the storage client: %w", err)
+	}
+	p.storageClient = client
+
+	saved, err := client.Get(ctx, storageKey)
+	if err != nil {
+		return fmt.Errorf("failed to read the saved spans: %w", err)
+	}
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	if err = p.cache.unmarshal(saved, p.now()); err != nil {
+		// the spans aren't deduplicated against the previous run, which isn't a reason to stop the pipeline
+		p.logger.Warn("Ignoring the saved spans", zap.Error(err))
+		return nil
+	}
+	p.logger.Debug("Restored the saved spans", zap.Int("spans", p.cache.len()))
+	return nil
+}
+
+func (p *spanDedupProcessor) shutdown(ctx context.Context) error {
+	if p.storageClient == nil {
+		return nil
+	}
+	p.mu.Lock()
+	saved := p.cache.marshal()
+	p.mu.Unlock()
+
+	var errs error
+	if err := p.storageClient.Set(ctx, storageKey, saved); err != nil {
+		errs = errors.Join(errs, fmt.Errorf("failed to save the spans: %w", err))
+	}
+	return errors.Join(errs, p.storageClient.Close(ctx))
+}
+
+func (p *spanDedupProcessor) processTraces(ctx context.Context, td ptrace.Traces) (ptrace.Traces, error) {
+	var dropped int64
+	p.mu.Lock()
+	now := p.now()
+	td.ResourceSpans().RemoveIf(func(rs ptrace.ResourceSpans) bool {
+		rs.ScopeSpans().RemoveIf(func(ss ptrace.ScopeSpans) bool {
+			ss.Spans().RemoveIf(func(span ptrace.Span) bool {
+				// spans without identifiers can't be told apart, they are never dropped
+				if span.TraceID().IsEmpty() || span.SpanID().IsEmpty() {
+					return false
+				}
+				if p.cache.seen(newSpanKey(span.TraceID(), span.SpanID()), now) {
+					dropped++
+					return true
+				}
+				return false
+			})
+			return ss.Spans().Len() == 0
+		})
+		return rs.ScopeSpans().Len() == 0
+	})
+	p.mu.Unlock()
+
+	if dropped > 0 {
+		p.telemetryBuilder.ProcessorSpandedupSpansDropped.Add(ctx, dropped, metric.WithAttributes(p.processorAttr...))
+	}
+	if td.ResourceSpans().Len() == 0 {
+		return td, processorhelper.ErrSkipProcessingData
+	}
+	return td, nil
+}
@@ -0,0 +1,121 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package spandedupprocessor
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/collector/component/componenttest"
+	"go.opentelemetry.io/collector/consumer/consumertest"
+	"go.opentelemetry.io/collector/pdata/ptrace"
+	"go.opentelemetry.io/collector/processor"
+	"go.opentelemetry.io/collector/processor/processortest"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/extension/storage/storagetest"
+)
+
+// newTraces returns traces with a span per span ID, all in the same trace.
+func newTraces(spanIDs ...byte) ptrace.Traces {
+	td := ptrace.NewTraces()
+	spans := td.ResourceSpans().AppendEmpty().ScopeSpans().AppendEmpty().Spans()
+	for _, id := range spanIDs {
+		span := spans.AppendEmpty()
+		span.SetTraceID([16]byte{1, 2, 3, 4})
+		span.SetSpanID([8]byte{id})
+	}
+	return td
+}
+
+func newTestProcessor(t *testing.T, set processor.CreateSettings, cfg *Config, next *consumertest.TracesSink) processor.Traces {
+	p, err := NewFactory().CreateTracesProcessor(context.Background(), set, cfg, next)
+	require.NoError(t, err)
+	return p
+}
+
+func TestProcessTracesDropsDuplicates(t *testing.T) {
+	next := new(consumertest.TracesSink)
+	p := newTestProcessor(t, processortest.NewNopCreateSettings(), createDefaultConfig().(*Config), next)
+	require.NoError(t, p.Start(context.Background(), componenttest.NewNopHost()))
+	defer func() {
+		require.NoError(t, p.Shutdown(context.Background()))
+	}()
+
+	require.NoError(t, p.ConsumeTraces(context.Background(), newTraces(1, 2)))
+	// a redelivery of the first batch, along with a new span
+	require.NoError(t, p.ConsumeTraces(context.Background(), newTraces(1, 2, 3)))
+	// a redelivery of the spans only
+	require.NoError(t, p.ConsumeTraces(context.Background(), newTraces(3)))
+
+	require.Len(t, next.AllTraces(), 2)
+	assert.Equal(t, 2, next.AllTraces()[0].SpanCount())
+	assert.Equal(t, 1, next.AllTraces()[1].SpanCount())
+	assert.Equal(t, [8]byte{3}, [8]byte(next.AllTraces()[1].ResourceSpans().At(0).ScopeSpans().At(0).Spans().At(0).SpanID()))
+}
+
+func TestProcessTracesKeepsSpansWithoutIDs(t *testing.T) {
+	next := new(consumertest.TracesSink)
+	p := newTestProcessor(t, processortest.NewNopCreateSettings(), createDefaultConfig().(*Config), next)
+
+	require.NoError(t, p.ConsumeTraces(context.Background(), newTraces(0, 0)))
+	require.NoError(t, p.ConsumeTraces(context.Background(), newTraces(0)))
+	assert.Equal(t, 3, next.SpanCount())
+}
+
+func TestProcessTracesWindow(t *testing.T) {
+	proc, err := newSpanDedupProcessor(processortest.NewNopCreateSettings(), &Config{Window: time.Minute, MaxSpans: 100})
+	require.NoError(t, err)
+	now := time.Unix(1_700_000_000, 0)
+	proc.now = func() time.Time { return now }
+
+	_, err = proc.processTraces(context.Background(), newTraces(1))
+	require.NoError(t, err)
+	_, err = proc.processTraces(context.Background(), newTraces(1))
+	require.Error(t, err)
+
+	now = now.Add(time.Minute)
+	td, err := proc.processTraces(context.Background(), newTraces(1))
+	require.NoError(t, err)
+	assert.Equal(t, 1, td.SpanCount())
+}
+
+func TestStorageSurvivesRestart(t *testing.T) {
+	ext := storagetest.NewFileBackedStorageExtension("dedup", t.TempDir())
+	host := storagetest.NewStorageHost().WithExtension(ext.ID, ext)
+	cfg := createDefaultConfig().(*Config)
+	cfg.StorageID = &ext.ID
+
+	// the storage client is identified by the processor ID, which has to be the same after the restart
+	set := processortest.NewNopCreateSettings()
+	next := new(consumertest.TracesSink)
+	p := newTestProcessor(t, set, cfg, next)
+	require.NoError(t, p.Start(context.Background(), host))
+	require.NoError(t, p.ConsumeTraces(context.Background(), newTraces(1, 2)))
+	require.NoError(t, p.Shutdown(context.Background()))
+
+	// the restarted collector receives the batch again, e.g. after a Kafka rebalance
+	p = newTestProcessor(t, set, cfg, next)
+	require.NoError(t, p.Start(context.Background(), host))
+	require.NoError(t, p.ConsumeTraces(context.Background(), newTraces(1, 2, 3)))
+	require.NoError(t, p.Shutdown(context.Background()))
+
+	assert.Equal(t, 3, next.SpanCount())
+}
+
+func TestStorageExtensionErrors(t *testing.T) {
+	cfg := createDefaultConfig().(*Config)
+	missing := storagetest.NewStorageID("missing")
+	cfg.StorageID = &missing
+	p := newTestProcessor(t, processortest.NewNopCreateSettings(), cfg, new(consumertest.TracesSink))
+	assert.EqualError(t, p.Start(context.Background(), componenttest.NewNopHost()), "storage extension 'test_storage/missing' not found")
+
+	nonStorage := storagetest.NewNonStorageExtension("other")
+	cfg.StorageID = &nonStorage.ID
+	p = newTestProcessor(t, processortest.NewNopCreateSettings(), cfg, new(consumertest.TracesSink))
+	host := storagetest.NewStorageHost().WithExtension(nonStorage.ID, nonStorage)
+	assert.EqualError(t, p.Start(context.Background(), host), "non-storage extension 'non_storage/other' found")
+}
@@ -30,8 +30,8 @@ type Config struct {
 // Name specifies the attributes to use to re-name a span.
 type Name struct {
 	// Specifies transformations of span name to and from attributes.
-	// First FromAttributes rules are applied, then ToAttributes are applied.
-	// At least one of these 2 fields must be set.
+	// First FromAttributes or Template rules are applied, then ToAttributes are applied.
+	// At least one of these fields must be set.
 
 	// FromAttributes represents the attribute keys to pull the values from to
 	// generate the new span name. All attribute keys are required in the span
@@ -46,6 +46,14 @@ type Name struct {
 	// values. Used with FromAttributes only.
 	Separator string `mapstructure:"separator"`
 
+	// Template is the template of the new span name, whose placeholders in
+	// braces are replaced by the values of span attributes, e.g.
+	// "{http.request.method} {url.template}", or of OTTL expressions, e.g.
+	// "{http.request.method} {NormalizePath(attributes[\"url.path\"])}".
+	// If any value is missing, no re-name will occur. Literal braces are
+	// escaped by doubling them. It can't be used with FromAttributes.
+	Template string `mapstructure:"template"`
+
 	// ToAttributes specifies a configuration to extract attributes from span name.
 	ToAttributes *ToAttributes `mapstructure:"to_attributes"`
 }
@@ -41,6 +41,14 @@ func TestLoadingConfig(t *testing.T) {
 				},
 			},
 		},
+		{
+			id: component.MustNewIDWithName("span", "template"),
+			expected: &Config{
+				Rename: Name{
+					Template: `{http.request.method} {NormalizePath(attributes["url.path"])}`,
+				},
+			},
+		},
 		{
 			id: component.MustNewIDWithName("span", "to_attributes"),
 			expected: &Config{
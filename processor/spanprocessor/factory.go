@@ -30,7 +30,8 @@ var processorCapabilities = consumer.Capabilities{MutatesData: true}
 //
 //	Move this to the error package that allows for span name and field to be specified.
 var (
-	errMissingRequiredField       = errors.New("error creating \"span\" processor: either \"from_attributes\" or \"to_attributes\" must be specified in \"name:\" or \"setStatus\" must be specified")
+	errMissingRequiredField       = errors.New("error creating \"span\" processor: either \"from_attributes\", \"template\" or \"to_attributes\" must be specified in \"name:\" or \"setStatus\" must be specified")
+	errTemplateWithFromAttributes = errors.New("error creating \"span\" processor: \"template\" and \"from_attributes\" can't both be specified in \"name:\"")
 	errIncorrectStatusCode        = errors.New("error creating \"span\" processor: \"status\" must have specified \"code\" as \"Ok\" or \"Error\" or \"Unset\"")
 	errIncorrectStatusDescription = errors.New("error creating \"span\" processor: \"description\" can be specified only for \"code\" \"Error\"")
 )
@@ -57,11 +58,14 @@ func createTracesProcessor(
 	// 'from_attributes' or 'to_attributes' under 'name' has to be set for the span
 	// processor to be valid. If not set and not enforced, the processor would do no work.
 	oCfg := cfg.(*Config)
-	if len(oCfg.Rename.FromAttributes) == 0 &&
+	if len(oCfg.Rename.FromAttributes) == 0 && oCfg.Rename.Template == "" &&
 		(oCfg.Rename.ToAttributes == nil || len(oCfg.Rename.ToAttributes.Rules) == 0) &&
 		oCfg.SetStatus == nil {
 		return nil, errMissingRequiredField
 	}
+	if len(oCfg.Rename.FromAttributes) > 0 && oCfg.Rename.Template != "" {
+		return nil, errTemplateWithFromAttributes
+	}
 
 	if oCfg.SetStatus != nil {
 		if oCfg.SetStatus.Code != statusCodeUnset && oCfg.SetStatus.Code != statusCodeError && oCfg.SetStatus.Code != statusCodeOk {
@@ -72,7 +76,7 @@ func createTracesProcessor(
 		}
 	}
 
-	sp, err := newSpanProcessor(*oCfg)
+	sp, err := newSpanProcessor(*oCfg, set.TelemetrySettings)
 	if err != nil {
 		return nil, err
 	}
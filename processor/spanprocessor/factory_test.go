@@ -69,6 +69,14 @@ func TestFactory_CreateTracesProcessor_InvalidConfig(t *testing.T) {
 			},
 			err: fmt.Errorf("invalid regexp pattern \\"),
 		},
+		{
+			name: "template_with_from_attributes",
+			cfg: Name{
+				FromAttributes: []string{"key1"},
+				Template:       "{key1}",
+			},
+			err: errTemplateWithFromAttributes,
+		},
 	}
 
 	for _, test := range testcases {
@@ -83,6 +91,17 @@ func TestFactory_CreateTracesProcessor_InvalidConfig(t *testing.T) {
 	}
 }
 
+func TestFactory_CreateTracesProcessor_InvalidTemplate(t *testing.T) {
+	factory := NewFactory()
+	cfg := factory.CreateDefaultConfig().(*Config)
+	cfg.Rename.Template = "{Unknown(attributes[\"key1\"])}"
+
+	tp, err := factory.CreateTracesProcessor(context.Background(), processortest.NewNopCreateSettings(), cfg, consumertest.NewNop())
+	require.Nil(t, tp)
+	assert.ErrorContains(t, err, `invalid name template "{Unknown(attributes[\"key1\"])}"`)
+	assert.ErrorContains(t, err, `undefined function "Unknown"`)
+}
+
 func TestFactory_CreateMetricsProcessor(t *testing.T) {
 	factory := NewFactory()
 	cfg := factory.CreateDefaultConfig()
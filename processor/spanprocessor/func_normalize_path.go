@@ -0,0 +1,59 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package spanprocessor // import "github.com/open-telemetry/opentelemetry-collector-contrib/processor/spanprocessor"
+
+import (
+	"context"
+	"errors"
+	"regexp"
+	"strings"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl"
+)
+
+// identifierSegmentPattern matches the path segments which are identifiers: numbers, UUIDs and long hexadecimal
+// strings, such as hashes or object IDs.
+var identifierSegmentPattern = regexp.MustCompile(`^(\d+|[0-9a-fA-F]{8}-[0-9a-fA-F]{4}-[0-9a-fA-F]{4}-[0-9a-fA-F]{4}-[0-9a-fA-F]{12}|[0-9a-fA-F]{16,})$`)
+
+// normalizedSegment replaces the identifier segments in the normalized paths.
+const normalizedSegment = "{id}"
+
+type normalizePathArguments[K any] struct {
+	Target ottl.StringGetter[K]
+}
+
+// newNormalizePathFactory returns the factory of the NormalizePath converter, which removes the query and the
+// fragment of a path and replaces its identifier segments by "{id}", e.g. "/api/users/123?verbose" becomes
+// "/api/users/{id}", so that it can be part of the span name without exploding its cardinality.
+func newNormalizePathFactory[K any]() ottl.Factory[K] {
+	return ottl.NewFactory("NormalizePath", &normalizePathArguments[K]{}, createNormalizePathFunction[K])
+}
+
+func createNormalizePathFunction[K any](_ ottl.FunctionContext, oArgs ottl.Arguments) (ottl.ExprFunc[K], error) {
+	args, ok := oArgs.(*normalizePathArguments[K])
+	if !ok {
+		return nil, errors.New("NormalizePathFactory args must be of type *normalizePathArguments[K]")
+	}
+
+	return func(ctx context.Context, tCtx K) (any, error) {
+		path, err := args.Target.Get(ctx, tCtx)
+		if err != nil {
+			return nil, err
+		}
+		return normalizePath(path), nil
+	}, nil
+}
+
+func normalizePath(path string) string {
+	if i := strings.IndexAny(path, "?#"); i >= 0 {
+		path = path[:i]
+	}
+	segments := strings.Split(path, "/")
+	for i, segment := range segments {
+		if identifierSegmentPattern.MatchString(segment) {
+			segments[i] = normalizedSegment
+		}
+	}
+	return strings.Join(segments, "/")
+}
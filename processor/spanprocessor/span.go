@@ -10,16 +10,21 @@ import (
 	"strconv"
 	"strings"
 
+	"go.opentelemetry.io/collector/component"
 	"go.opentelemetry.io/collector/pdata/pcommon"
 	"go.opentelemetry.io/collector/pdata/ptrace"
+	"go.uber.org/zap"
 
 	"github.com/open-telemetry/opentelemetry-collector-contrib/internal/filter/expr"
 	"github.com/open-telemetry/opentelemetry-collector-contrib/internal/filter/filterspan"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl"
 	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl/contexts/ottlspan"
 )
 
 type spanProcessor struct {
 	config           Config
+	logger           *zap.Logger
+	nameTemplate     *ottl.Statement[ottlspan.TransformContext]
 	toAttributeRules []toAttributeRule
 	skipExpr         expr.BoolExpr[ottlspan.TransformContext]
 }
@@ -34,7 +39,7 @@ type toAttributeRule struct {
 }
 
 // newSpanProcessor returns the span processor.
-func newSpanProcessor(config Config) (*spanProcessor, error) {
+func newSpanProcessor(config Config, set component.TelemetrySettings) (*spanProcessor, error) {
 	skipExpr, err := filterspan.NewSkipExpr(&config.MatchConfig)
 	if err != nil {
 		return nil, err
@@ -42,9 +47,17 @@ func newSpanProcessor(config Config) (*spanProcessor, error) {
 
 	sp := &spanProcessor{
 		config:   config,
+		logger:   set.Logger,
 		skipExpr: skipExpr,
 	}
 
+	if config.Rename.Template != "" {
+		sp.nameTemplate, err = newNameTemplate(config.Rename.Template, set)
+		if err != nil {
+			return nil, fmt.Errorf("invalid name template %q: %w", config.Rename.Template, err)
+		}
+	}
+
 	// Compile ToAttributes regexp and extract attributes names.
 	if config.Rename.ToAttributes != nil {
 		for _, pattern := range config.Rename.ToAttributes.Rules {
@@ -88,6 +101,7 @@ func (sp *spanProcessor) processTraces(ctx context.Context, td ptrace.Traces) (p
 					}
 				}
 				sp.processFromAttributes(span)
+				sp.processTemplate(ctx, span, scope, resource)
 				sp.processToAttributes(span)
 				sp.processUpdateStatus(span)
 			}
@@ -157,6 +171,18 @@ func (sp *spanProcessor) processFromAttributes(span ptrace.Span) {
 	span.SetName(sb.String())
 }
 
+func (sp *spanProcessor) processTemplate(ctx context.Context, span ptrace.Span, scope pcommon.InstrumentationScope, resource pcommon.Resource) {
+	if sp.nameTemplate == nil {
+		return
+	}
+
+	// The errors, such as a converter getting a missing attribute, leave the span name as is, like the missing
+	// attributes.
+	if _, _, err := sp.nameTemplate.Execute(ctx, ottlspan.NewTransformContext(span, scope, resource)); err != nil {
+		sp.logger.Debug("failed to execute the span name template", zap.Error(err))
+	}
+}
+
 func (sp *spanProcessor) processToAttributes(span ptrace.Span) {
 	if span.Name() == "" {
 		// There is no span name to work on.
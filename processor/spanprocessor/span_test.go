@@ -416,6 +416,90 @@ func TestSpanProcessor_NilName(t *testing.T) {
 }
 
 // TestSpanProcessor_ToAttributes
+// TestSpanProcessor_Template tests naming a span with a template of attributes and OTTL expressions.
+func TestSpanProcessor_Template(t *testing.T) {
+	testCases := []testCase{
+		{
+			inputName: "all-values-set",
+			inputAttributes: map[string]any{
+				"http.request.method": "get",
+				"url.path":            "/api/users/123?verbose",
+				"http.response.size":  512,
+			},
+			outputName: "GET /api/users/{id} (512)",
+			outputAttributes: map[string]any{
+				"http.request.method": "get",
+				"url.path":            "/api/users/123?verbose",
+				"http.response.size":  512,
+			},
+		},
+		{
+			inputName: "attribute-missing",
+			inputAttributes: map[string]any{
+				"http.request.method": "get",
+				"url.path":            "/api/users/123",
+			},
+			outputName: "attribute-missing",
+			outputAttributes: map[string]any{
+				"http.request.method": "get",
+				"url.path":            "/api/users/123",
+			},
+		},
+		{
+			inputName: "converter-argument-missing",
+			inputAttributes: map[string]any{
+				"url.path":           "/api/users/123",
+				"http.response.size": 512,
+			},
+			outputName: "converter-argument-missing",
+			outputAttributes: map[string]any{
+				"url.path":           "/api/users/123",
+				"http.response.size": 512,
+			},
+		},
+	}
+
+	factory := NewFactory()
+	cfg := factory.CreateDefaultConfig()
+	oCfg := cfg.(*Config)
+	oCfg.Rename.Template = `{ConvertCase(attributes["http.request.method"], "upper")} {NormalizePath(attributes["url.path"])} ({http.response.size})`
+
+	tp, err := factory.CreateTracesProcessor(context.Background(), processortest.NewNopCreateSettings(), oCfg, consumertest.NewNop())
+	require.NoError(t, err)
+	require.NotNil(t, tp)
+	for _, tc := range testCases {
+		runIndividualTestCase(t, tc, tp)
+	}
+}
+
+// TestSpanProcessor_TemplateToAttributes tests that the attributes are extracted from the span name generated by the
+// template.
+func TestSpanProcessor_TemplateToAttributes(t *testing.T) {
+	factory := NewFactory()
+	cfg := factory.CreateDefaultConfig()
+	oCfg := cfg.(*Config)
+	oCfg.Rename.Template = "{{{http.route}}}"
+	oCfg.Rename.ToAttributes = &ToAttributes{
+		Rules: []string{`^\{(?P<route>.*)\}$`},
+	}
+
+	tp, err := factory.CreateTracesProcessor(context.Background(), processortest.NewNopCreateSettings(), oCfg, consumertest.NewNop())
+	require.NoError(t, err)
+	require.NotNil(t, tp)
+
+	runIndividualTestCase(t, testCase{
+		inputName: "route",
+		inputAttributes: map[string]any{
+			"http.route": "/users",
+		},
+		outputName: "{{route}}",
+		outputAttributes: map[string]any{
+			"http.route": "/users",
+			"route":      "/users",
+		},
+	}, tp)
+}
+
 func TestSpanProcessor_ToAttributes(t *testing.T) {
 
 	testCases := []struct {
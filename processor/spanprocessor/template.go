@@ -0,0 +1,153 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package spanprocessor // import "github.com/open-telemetry/opentelemetry-collector-contrib/processor/spanprocessor"
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"go.opentelemetry.io/collector/component"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl/contexts/ottlspan"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl/ottlfuncs"
+)
+
+// attributeKeyPattern matches the placeholders which are span attribute keys, e.g. {http.request.method}, rather
+// than OTTL expressions.
+var attributeKeyPattern = regexp.MustCompile(`^[\w.\-/:@]+$`)
+
+// newNameTemplate compiles the template of the span name into an OTTL statement of the rename_span editor, whose
+// arguments are the literal text and the placeholders of the template, in order. E.g. the template
+// `{http.request.method} {ConvertCase(attributes["url.template"], "lower")}` becomes:
+//
+//	rename_span([attributes["http.request.method"], " ", ConvertCase(attributes["url.template"], "lower")])
+func newNameTemplate(template string, set component.TelemetrySettings) (*ottl.Statement[ottlspan.TransformContext], error) {
+	parts, err := templateParts(template)
+	if err != nil {
+		return nil, err
+	}
+
+	functions := ottlfuncs.StandardConverters[ottlspan.TransformContext]()
+	for _, f := range []ottl.Factory[ottlspan.TransformContext]{newRenameSpanFactory(), newNormalizePathFactory[ottlspan.TransformContext]()} {
+		functions[f.Name()] = f
+	}
+	parser, err := ottlspan.NewParser(functions, set)
+	if err != nil {
+		return nil, err
+	}
+	return parser.ParseStatement("rename_span([" + strings.Join(parts, ", ") + "])")
+}
+
+// templateParts splits the template into the OTTL expressions of its literal text, as quoted strings, and of its
+// placeholders. The braces are escaped by doubling them, e.g. "{{" for a literal "{".
+func templateParts(template string) ([]string, error) {
+	var parts []string
+	var literal strings.Builder
+	flushLiteral := func() {
+		if literal.Len() > 0 {
+			parts = append(parts, strconv.Quote(literal.String()))
+			literal.Reset()
+		}
+	}
+
+	for i := 0; i < len(template); {
+		switch {
+		case strings.HasPrefix(template[i:], "{{"):
+			literal.WriteByte('{')
+			i += 2
+		case strings.HasPrefix(template[i:], "}}"):
+			literal.WriteByte('}')
+			i += 2
+		case template[i] == '}':
+			return nil, fmt.Errorf("unexpected '}' at offset %d, literal braces must be doubled", i)
+		case template[i] == '{':
+			end, err := placeholderEnd(template, i+1)
+			if err != nil {
+				return nil, err
+			}
+			expr := strings.TrimSpace(template[i+1 : end])
+			if expr == "" {
+				return nil, fmt.Errorf("empty placeholder at offset %d", i)
+			}
+			flushLiteral()
+			if attributeKeyPattern.MatchString(expr) {
+				expr = "attributes[" + strconv.Quote(expr) + "]"
+			}
+			parts = append(parts, expr)
+			i = end + 1
+		default:
+			literal.WriteByte(template[i])
+			i++
+		}
+	}
+	flushLiteral()
+
+	if len(parts) == 0 {
+		return nil, errors.New("the template is empty")
+	}
+	return parts, nil
+}
+
+// placeholderEnd returns the offset of the brace closing the placeholder starting at the given offset, skipping the
+// braces of the strings and nested braces of the OTTL expression.
+func placeholderEnd(template string, start int) (int, error) {
+	depth := 0
+	inString := false
+	for i := start; i < len(template); i++ {
+		c := template[i]
+		switch {
+		case inString && c == '\\':
+			i++
+		case c == '"':
+			inString = !inString
+		case inString:
+		case c == '{':
+			depth++
+		case c == '}':
+			if depth == 0 {
+				return i, nil
+			}
+			depth--
+		}
+	}
+	return 0, fmt.Errorf("unclosed placeholder at offset %d", start-1)
+}
+
+type renameSpanArguments struct {
+	Parts []ottl.StringLikeGetter[ottlspan.TransformContext]
+}
+
+// newRenameSpanFactory returns the factory of the rename_span editor, setting the span name to the concatenation of
+// its parts. The span isn't renamed if any of them is nil, e.g. a missing attribute.
+func newRenameSpanFactory() ottl.Factory[ottlspan.TransformContext] {
+	return ottl.NewFactory("rename_span", &renameSpanArguments{}, createRenameSpanFunction)
+}
+
+func createRenameSpanFunction(_ ottl.FunctionContext, oArgs ottl.Arguments) (ottl.ExprFunc[ottlspan.TransformContext], error) {
+	args, ok := oArgs.(*renameSpanArguments)
+	if !ok {
+		return nil, errors.New("RenameSpanFactory args must be of type *renameSpanArguments")
+	}
+
+	return func(ctx context.Context, tCtx ottlspan.TransformContext) (any, error) {
+		var sb strings.Builder
+		for _, part := range args.Parts {
+			val, err := part.Get(ctx, tCtx)
+			if err != nil {
+				return nil, err
+			}
+			if val == nil {
+				return nil, nil
+			}
+			sb.WriteString(*val)
+		}
+		tCtx.GetSpan().SetName(sb.String())
+		return nil, nil
+	}, nil
+}
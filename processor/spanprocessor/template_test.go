@@ -0,0 +1,88 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package spanprocessor
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestTemplateParts(t *testing.T) {
+	tests := []struct {
+		template string
+		parts    []string
+		err      string
+	}{
+		{
+			template: "{http.request.method} {url.template}",
+			parts:    []string{`attributes["http.request.method"]`, `" "`, `attributes["url.template"]`},
+		},
+		{
+			template: `GET {Substring(attributes["url.path"], 0, 4)}`,
+			parts:    []string{`"GET "`, `Substring(attributes["url.path"], 0, 4)`},
+		},
+		{
+			template: `{ Concat([attributes["a"], "}"], "{") }`,
+			parts:    []string{`Concat([attributes["a"], "}"], "{")`},
+		},
+		{
+			template: `{{"literal"}} {key}`,
+			parts:    []string{`"{\"literal\"} "`, `attributes["key"]`},
+		},
+		{
+			template: "static",
+			parts:    []string{`"static"`},
+		},
+		{
+			template: "",
+			err:      "the template is empty",
+		},
+		{
+			template: "{key} {}",
+			err:      "empty placeholder at offset 6",
+		},
+		{
+			template: "{key",
+			err:      "unclosed placeholder at offset 0",
+		},
+		{
+			template: "key}",
+			err:      "unexpected '}' at offset 3, literal braces must be doubled",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.template, func(t *testing.T) {
+			parts, err := templateParts(tt.template)
+			if tt.err != "" {
+				assert.EqualError(t, err, tt.err)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, tt.parts, parts)
+		})
+	}
+}
+
+func TestNormalizePath(t *testing.T) {
+	tests := []struct {
+		path     string
+		expected string
+	}{
+		{"/api/users", "/api/users"},
+		{"/api/users/123", "/api/users/{id}"},
+		{"/api/users/123/orders/456/", "/api/users/{id}/orders/{id}/"},
+		{"/api/users/123?verbose=true", "/api/users/{id}"},
+		{"/docs#section-1", "/docs"},
+		{"/objects/550e8400-e29b-41d4-a716-446655440000", "/objects/{id}"},
+		{"/commits/9fceb02d0ae598e95dc970b74767f19372d61af8", "/commits/{id}"},
+		{"/api/v2/cafe", "/api/v2/cafe"},
+		{"", ""},
+	}
+	for _, tt := range tests {
+		t.Run(tt.path, func(t *testing.T) {
+			assert.Equal(t, tt.expected, normalizePath(tt.path))
+		})
+	}
+}
@@ -0,0 +1,42 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package stalenessmarkerprocessor // import "github.com/open-telemetry/opentelemetry-collector-contrib/processor/stalenessmarkerprocessor"
+
+import (
+	"errors"
+	"time"
+
+	"go.opentelemetry.io/collector/component"
+)
+
+// Config defines configuration for the staleness marker processor.
+type Config struct {
+	// Timeout is how long a series can go without data points before it's considered stale, and a staleness marker
+	// is emitted for it.
+	Timeout time.Duration `mapstructure:"timeout"`
+
+	// CheckInterval is how often the series are checked for staleness. The markers are emitted up to this duration
+	// after the timeout.
+	CheckInterval time.Duration `mapstructure:"check_interval"`
+
+	// MaxSeries is the maximum number of tracked series. The new series received when it's reached aren't tracked,
+	// and no staleness marker is emitted for them.
+	MaxSeries int `mapstructure:"max_series"`
+}
+
+var _ component.Config = (*Config)(nil)
+
+// Validate checks if the processor configuration is valid.
+func (cfg *Config) Validate() error {
+	if cfg.Timeout <= 0 {
+		return errors.New("timeout must be positive")
+	}
+	if cfg.CheckInterval <= 0 {
+		return errors.New("check_interval must be positive")
+	}
+	if cfg.MaxSeries <= 0 {
+		return errors.New("max_series must be positive")
+	}
+	return nil
+}
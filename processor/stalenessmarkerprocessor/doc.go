@@ -0,0 +1,9 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+//go:generate mdatagen metadata.yaml
+
+// Package stalenessmarkerprocessor implements a processor that emits staleness
+// markers for the metric series which stopped reporting, so that the
+// Prometheus-compatible backends show gaps instead of their last values.
+package stalenessmarkerprocessor // import "github.com/open-telemetry/opentelemetry-collector-contrib/processor/stalenessmarkerprocessor"
@@ -0,0 +1,45 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package stalenessmarkerprocessor // import "github.com/open-telemetry/opentelemetry-collector-contrib/processor/stalenessmarkerprocessor"
+
+import (
+	"context"
+	"time"
+
+	"go.opentelemetry.io/collector/component"
+	"go.opentelemetry.io/collector/consumer"
+	"go.opentelemetry.io/collector/processor"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/processor/stalenessmarkerprocessor/internal/metadata"
+)
+
+const (
+	defaultTimeout       = 5 * time.Minute
+	defaultCheckInterval = 30 * time.Second
+	defaultMaxSeries     = 100000
+)
+
+// NewFactory returns a new factory for the staleness marker processor.
+func NewFactory() processor.Factory {
+	return processor.NewFactory(
+		metadata.Type,
+		createDefaultConfig,
+		processor.WithMetrics(createMetricsProcessor, metadata.MetricsStability))
+}
+
+func createDefaultConfig() component.Config {
+	return &Config{
+		Timeout:       defaultTimeout,
+		CheckInterval: defaultCheckInterval,
+		MaxSeries:     defaultMaxSeries,
+	}
+}
+
+func createMetricsProcessor(
+	_ context.Context,
+	set processor.CreateSettings,
+	cfg component.Config,
+	nextConsumer consumer.Metrics) (processor.Metrics, error) {
+	return newStalenessMarkerProcessor(set, cfg.(*Config), nextConsumer)
+}
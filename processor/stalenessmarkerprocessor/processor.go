@@ -0,0 +1,411 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package stalenessmarkerprocessor // import "github.com/open-telemetry/opentelemetry-collector-contrib/processor/stalenessmarkerprocessor"
+
+import (
+	"context"
+	"math"
+	"sync"
+	"time"
+
+	"go.opentelemetry.io/collector/component"
+	"go.opentelemetry.io/collector/consumer"
+	"go.opentelemetry.io/collector/pdata/pcommon"
+	"go.opentelemetry.io/collector/pdata/pmetric"
+	"go.opentelemetry.io/collector/processor"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/metric"
+	"go.uber.org/zap"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/pdatautil"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/processor/stalenessmarkerprocessor/internal/metadata"
+)
+
+type resourceKey struct {
+	attributes [16]byte
+	schemaURL  string
+}
+
+type scopeKey struct {
+	resource   resourceKey
+	name       string
+	version    string
+	attributes [16]byte
+	schemaURL  string
+}
+
+type metricKey struct {
+	scope      scopeKey
+	name       string
+	unit       string
+	metricType pmetric.MetricType
+}
+
+// seriesKey identifies the series of a metric by the attributes of their data points.
+type seriesKey struct {
+	metric     metricKey
+	attributes [16]byte
+}
+
+// origin is the resource and the scope of the series. It's copied once for all the new series of a scope.
+type origin struct {
+	resource          pcommon.Resource
+	resourceSchemaURL string
+	scope             pcommon.InstrumentationScope
+	scopeSchemaURL    string
+}
+
+// series is a tracked series, with the metric holding its staleness marker.
+type series struct {
+	origin         *origin
+	marker         pmetric.Metric
+	startTimestamp pcommon.Timestamp
+	lastSeen       time.Time
+}
+
+type stalenessMarkerProcessor struct {
+	ctx    context.Context
+	cancel context.CancelFunc
+	wg     sync.WaitGroup
+	logger *zap.Logger
+
+	timeout       time.Duration
+	checkInterval time.Duration
+	maxSeries     int
+
+	telemetryBuilder *metadata.TelemetryBuilder
+	processorAttr    []attribute.KeyValue
+
+	mu     sync.Mutex
+	series map[seriesKey]*series
+	now    func() time.Time
+
+	nextConsumer consumer.Metrics
+}
+
+var _ processor.Metrics = (*stalenessMarkerProcessor)(nil)
+
+func newStalenessMarkerProcessor(set processor.CreateSettings, cfg *Config, nextConsumer consumer.Metrics) (*stalenessMarkerProcessor, error) {
+	telemetryBuilder, err := metadata.NewTelemetryBuilder(set.TelemetrySettings)
+	if err != nil {
+		return nil, err
+	}
+	ctx, cancel := context.WithCancel(context.Background())
+	return &stalenessMarkerProcessor{
+		ctx:    ctx,
+		cancel: cancel,
+		logger: set.Logger,
+
+		timeout:       cfg.Timeout,
+		checkInterval: cfg.CheckInterval,
+		maxSeries:     cfg.MaxSeries,
+
+		telemetryBuilder: telemetryBuilder,
+		processorAttr:    []attribute.KeyValue{attribute.String(metadata.Type.String(), set.ID.String())},
+
+		series: map[seriesKey]*series{},
+		now:    time.Now,
+
+		nextConsumer: nextConsumer,
+	}, nil
+}
+
+func (p *stalenessMarkerProcessor) Start(_ context.Context, _ component.Host) error {
+	ticker := time.NewTicker(p.checkInterval)
+	p.wg.Add(1)
+	go func() {
+		defer p.wg.Done()
+		defer ticker.Stop()
+		for {
+			select {
+			case <-p.ctx.Done():
+				return
+			case <-ticker.C:
+				p.exportMarkers()
+			}
+		}
+	}()
+	return nil
+}
+
+// Shutdown stops the staleness checks. No marker is emitted for the tracked series, since they aren't stale when
+// the collector is only restarted.
+func (p *stalenessMarkerProcessor) Shutdown(context.Context) error {
+	p.cancel()
+	p.wg.Wait()
+	return nil
+}
+
+func (p *stalenessMarkerProcessor) Capabilities() consumer.Capabilities {
+	return consumer.Capabilities{MutatesData: false}
+}
+
+// ConsumeMetrics records when the series were last seen, and passes the metrics to the next consumer.
+func (p *stalenessMarkerProcessor) ConsumeMetrics(ctx context.Context, md pmetric.Metrics) error {
+	untracked := p.trackSeries(md)
+	if untracked > 0 {
+		p.telemetryBuilder.ProcessorStalenessmarkerSeriesUntracked.Add(ctx, untracked, metric.WithAttributes(p.processorAttr...))
+	}
+	return p.nextConsumer.ConsumeMetrics(ctx, md)
+}
+
+// trackSeries records the series of the metrics, and returns the number of new series which weren't tracked.
+func (p *stalenessMarkerProcessor) trackSeries(md pmetric.Metrics) int64 {
+	var untracked int64
+	now := p.now()
+
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	for i := 0; i < md.ResourceMetrics().Len(); i++ {
+		rm := md.ResourceMetrics().At(i)
+		rKey := resourceKey{
+			attributes: pdatautil.MapHash(rm.Resource().Attributes()),
+			schemaURL:  rm.SchemaUrl(),
+		}
+		for j := 0; j < rm.ScopeMetrics().Len(); j++ {
+			sm := rm.ScopeMetrics().At(j)
+			sKey := scopeKey{
+				resource:   rKey,
+				name:       sm.Scope().Name(),
+				version:    sm.Scope().Version(),
+				attributes: pdatautil.MapHash(sm.Scope().Attributes()),
+				schemaURL:  sm.SchemaUrl(),
+			}
+			var o *origin
+			track := func(key seriesKey, flags pmetric.DataPointFlags, startTimestamp pcommon.Timestamp, newMarker func() pmetric.Metric) {
+				// the source already marked the series as stale
+				if flags.NoRecordedValue() {
+					delete(p.series, key)
+					return
+				}
+				if s, ok := p.series[key]; ok {
+					s.startTimestamp = startTimestamp
+					s.lastSeen = now
+					return
+				}
+				if len(p.series) >= p.maxSeries {
+					untracked++
+					return
+				}
+				if o == nil {
+					o = newOrigin(rm, sm)
+				}
+				p.series[key] = &series{origin: o, marker: newMarker(), startTimestamp: startTimestamp, lastSeen: now}
+			}
+			for k := 0; k < sm.Metrics().Len(); k++ {
+				trackMetric(sm.Metrics().At(k), sKey, track)
+			}
+		}
+	}
+	return untracked
+}
+
+func newOrigin(rm pmetric.ResourceMetrics, sm pmetric.ScopeMetrics) *origin {
+	o := &origin{
+		resource:          pcommon.NewResource(),
+		resourceSchemaURL: rm.SchemaUrl(),
+		scope:             pcommon.NewInstrumentationScope(),
+		scopeSchemaURL:    sm.SchemaUrl(),
+	}
+	rm.Resource().CopyTo(o.resource)
+	sm.Scope().CopyTo(o.scope)
+	return o
+}
+
+// trackMetric calls track for each data point of the metric, with a function creating its staleness marker.
+func trackMetric(m pmetric.Metric, sKey scopeKey, track func(seriesKey, pmetric.DataPointFlags, pcommon.Timestamp, func() pmetric.Metric)) {
+	mKey := metricKey{scope: sKey, name: m.Name(), unit: m.Unit(), metricType: m.Type()}
+	key := func(attributes pcommon.Map) seriesKey {
+		return seriesKey{metric: mKey, attributes: pdatautil.MapHash(attributes)}
+	}
+	newMarker := func(markPoint func(marker pmetric.Metric)) func() pmetric.Metric {
+		return func() pmetric.Metric {
+			marker := pmetric.NewMetric()
+			copyMetadata(m, marker)
+			markPoint(marker)
+			return marker
+		}
+	}
+
+	switch m.Type() {
+	case pmetric.MetricTypeGauge:
+		dps := m.Gauge().DataPoints()
+		for i := 0; i < dps.Len(); i++ {
+			dp := dps.At(i)
+			track(key(dp.Attributes()), dp.Flags(), dp.StartTimestamp(), newMarker(func(marker pmetric.Metric) {
+				markNumberDataPoint(dp, marker.Gauge().DataPoints().AppendEmpty())
+			}))
+		}
+	case pmetric.MetricTypeSum:
+		dps := m.Sum().DataPoints()
+		for i := 0; i < dps.Len(); i++ {
+			dp := dps.At(i)
+			track(key(dp.Attributes()), dp.Flags(), dp.StartTimestamp(), newMarker(func(marker pmetric.Metric) {
+				markNumberDataPoint(dp, marker.Sum().DataPoints().AppendEmpty())
+			}))
+		}
+	case pmetric.MetricTypeHistogram:
+		dps := m.Histogram().DataPoints()
+		for i := 0; i < dps.Len(); i++ {
+			dp := dps.At(i)
+			track(key(dp.Attributes()), dp.Flags(), dp.StartTimestamp(), newMarker(func(marker pmetric.Metric) {
+				dst := marker.Histogram().DataPoints().AppendEmpty()
+				dp.Attributes().CopyTo(dst.Attributes())
+				// the buckets are kept, so that a marker is emitted for the series of each bucket
+				dp.ExplicitBounds().CopyTo(dst.ExplicitBounds())
+				dst.BucketCounts().FromRaw(make([]uint64, dp.BucketCounts().Len()))
+				dst.SetFlags(pmetric.DefaultDataPointFlags.WithNoRecordedValue(true))
+			}))
+		}
+	case pmetric.MetricTypeExponentialHistogram:
+		dps := m.ExponentialHistogram().DataPoints()
+		for i := 0; i < dps.Len(); i++ {
+			dp := dps.At(i)
+			track(key(dp.Attributes()), dp.Flags(), dp.StartTimestamp(), newMarker(func(marker pmetric.Metric) {
+				dst := marker.ExponentialHistogram().DataPoints().AppendEmpty()
+				dp.Attributes().CopyTo(dst.Attributes())
+				dst.SetScale(dp.Scale())
+				dst.SetFlags(pmetric.DefaultDataPointFlags.WithNoRecordedValue(true))
+			}))
+		}
+	case pmetric.MetricTypeSummary:
+		dps := m.Summary().DataPoints()
+		for i := 0; i < dps.Len(); i++ {
+			dp := dps.At(i)
+			track(key(dp.Attributes()), dp.Flags(), dp.StartTimestamp(), newMarker(func(marker pmetric.Metric) {
+				dst := marker.Summary().DataPoints().AppendEmpty()
+				dp.Attributes().CopyTo(dst.Attributes())
+				// the quantiles are kept, so that a marker is emitted for the series of each quantile
+				for j := 0; j < dp.QuantileValues().Len(); j++ {
+					q := dst.QuantileValues().AppendEmpty()
+					q.SetQuantile(dp.QuantileValues().At(j).Quantile())
+					q.SetValue(math.NaN())
+				}
+				dst.SetFlags(pmetric.DefaultDataPointFlags.WithNoRecordedValue(true))
+			}))
+		}
+	case pmetric.MetricTypeEmpty:
+	}
+}
+
+func markNumberDataPoint(dp, dst pmetric.NumberDataPoint) {
+	dp.Attributes().CopyTo(dst.Attributes())
+	switch dp.ValueType() {
+	case pmetric.NumberDataPointValueTypeDouble:
+		dst.SetDoubleValue(math.NaN())
+	case pmetric.NumberDataPointValueTypeInt:
+		dst.SetIntValue(0)
+	case pmetric.NumberDataPointValueTypeEmpty:
+	}
+	dst.SetFlags(pmetric.DefaultDataPointFlags.WithNoRecordedValue(true))
+}
+
+// copyMetadata copies the metadata of the metric to dst, which gets empty data points of the same type.
+func copyMetadata(m, dst pmetric.Metric) {
+	dst.SetName(m.Name())
+	dst.SetDescription(m.Description())
+	dst.SetUnit(m.Unit())
+	switch m.Type() {
+	case pmetric.MetricTypeGauge:
+		dst.SetEmptyGauge()
+	case pmetric.MetricTypeSum:
+		sum := dst.SetEmptySum()
+		sum.SetAggregationTemporality(m.Sum().AggregationTemporality())
+		sum.SetIsMonotonic(m.Sum().IsMonotonic())
+	case pmetric.MetricTypeHistogram:
+		dst.SetEmptyHistogram().SetAggregationTemporality(m.Histogram().AggregationTemporality())
+	case pmetric.MetricTypeExponentialHistogram:
+		dst.SetEmptyExponentialHistogram().SetAggregationTemporality(m.ExponentialHistogram().AggregationTemporality())
+	case pmetric.MetricTypeSummary:
+		dst.SetEmptySummary()
+	case pmetric.MetricTypeEmpty:
+	}
+}
+
+// appendMarker appends the staleness marker of the series to the data points of dst.
+func appendMarker(s *series, dst pmetric.Metric, timestamp pcommon.Timestamp) {
+	switch s.marker.Type() {
+	case pmetric.MetricTypeGauge:
+		dp := dst.Gauge().DataPoints().AppendEmpty()
+		s.marker.Gauge().DataPoints().At(0).CopyTo(dp)
+		dp.SetStartTimestamp(s.startTimestamp)
+		dp.SetTimestamp(timestamp)
+	case pmetric.MetricTypeSum:
+		dp := dst.Sum().DataPoints().AppendEmpty()
+		s.marker.Sum().DataPoints().At(0).CopyTo(dp)
+		dp.SetStartTimestamp(s.startTimestamp)
+		dp.SetTimestamp(timestamp)
+	case pmetric.MetricTypeHistogram:
+		dp := dst.Histogram().DataPoints().AppendEmpty()
+		s.marker.Histogram().DataPoints().At(0).CopyTo(dp)
+		dp.SetStartTimestamp(s.startTimestamp)
+		dp.SetTimestamp(timestamp)
+	case pmetric.MetricTypeExponentialHistogram:
+		dp := dst.ExponentialHistogram().DataPoints().AppendEmpty()
+		s.marker.ExponentialHistogram().DataPoints().At(0).CopyTo(dp)
+		dp.SetStartTimestamp(s.startTimestamp)
+		dp.SetTimestamp(timestamp)
+	case pmetric.MetricTypeSummary:
+		dp := dst.Summary().DataPoints().AppendEmpty()
+		s.marker.Summary().DataPoints().At(0).CopyTo(dp)
+		dp.SetStartTimestamp(s.startTimestamp)
+		dp.SetTimestamp(timestamp)
+	case pmetric.MetricTypeEmpty:
+	}
+}
+
+func (p *stalenessMarkerProcessor) exportMarkers() {
+	md := p.takeStaleSeries()
+	if md.DataPointCount() == 0 {
+		return
+	}
+	p.telemetryBuilder.ProcessorStalenessmarkerMarkersEmitted.Add(p.ctx, int64(md.DataPointCount()), metric.WithAttributes(p.processorAttr...))
+	if err := p.nextConsumer.ConsumeMetrics(p.ctx, md); err != nil {
+		p.logger.Error("Staleness markers export failed", zap.Error(err))
+	}
+}
+
+// takeStaleSeries stops tracking the series which weren't seen during the timeout, and returns their staleness
+// markers.
+func (p *stalenessMarkerProcessor) takeStaleSeries() pmetric.Metrics {
+	md := pmetric.NewMetrics()
+	resourceLookup := map[resourceKey]pmetric.ResourceMetrics{}
+	scopeLookup := map[scopeKey]pmetric.ScopeMetrics{}
+	metricLookup := map[metricKey]pmetric.Metric{}
+
+	now := p.now()
+	timestamp := pcommon.NewTimestampFromTime(now)
+
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	for key, s := range p.series {
+		if now.Sub(s.lastSeen) < p.timeout {
+			continue
+		}
+		delete(p.series, key)
+
+		m, ok := metricLookup[key.metric]
+		if !ok {
+			sm, ok := scopeLookup[key.metric.scope]
+			if !ok {
+				rm, ok := resourceLookup[key.metric.scope.resource]
+				if !ok {
+					rm = md.ResourceMetrics().AppendEmpty()
+					s.origin.resource.CopyTo(rm.Resource())
+					rm.SetSchemaUrl(s.origin.resourceSchemaURL)
+					resourceLookup[key.metric.scope.resource] = rm
+				}
+				sm = rm.ScopeMetrics().AppendEmpty()
+				s.origin.scope.CopyTo(sm.Scope())
+				sm.SetSchemaUrl(s.origin.scopeSchemaURL)
+				scopeLookup[key.metric.scope] = sm
+			}
+			m = sm.Metrics().AppendEmpty()
+			copyMetadata(s.marker, m)
+			metricLookup[key.metric] = m
+		}
+		appendMarker(s, m, timestamp)
+	}
+	return md
+}
@@ -0,0 +1,270 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package stalenessmarkerprocessor
+
+import (
+	"context"
+	"math"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/collector/component/componenttest"
+	"go.opentelemetry.io/collector/consumer/consumertest"
+	"go.opentelemetry.io/collector/pdata/pcommon"
+	"go.opentelemetry.io/collector/pdata/pmetric"
+	"go.opentelemetry.io/collector/processor"
+	"go.opentelemetry.io/collector/processor/processortest"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/sdk/metric/metricdata"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/processor/stalenessmarkerprocessor/internal/metadata"
+)
+
+var (
+	baseTime  = time.Date(2024, 6, 1, 12, 0, 0, 0, time.UTC)
+	startTime = pcommon.NewTimestampFromTime(baseTime.Add(-time.Hour))
+)
+
+// newMetrics returns the metrics of the service, with a series of each metric type.
+func newMetrics(service string) pmetric.Metrics {
+	md := pmetric.NewMetrics()
+	rm := md.ResourceMetrics().AppendEmpty()
+	rm.Resource().Attributes().PutStr("service.name", service)
+	sm := rm.ScopeMetrics().AppendEmpty()
+	sm.Scope().SetName("test")
+
+	gauge := sm.Metrics().AppendEmpty()
+	gauge.SetName("memory")
+	gauge.SetUnit("By")
+	dp := gauge.SetEmptyGauge().DataPoints().AppendEmpty()
+	dp.SetDoubleValue(512)
+
+	sum := sm.Metrics().AppendEmpty()
+	sum.SetName("requests")
+	sum.SetEmptySum().SetAggregationTemporality(pmetric.AggregationTemporalityCumulative)
+	sum.Sum().SetIsMonotonic(true)
+	dp = sum.Sum().DataPoints().AppendEmpty()
+	dp.Attributes().PutStr("route", "/users")
+	dp.SetStartTimestamp(startTime)
+	dp.SetIntValue(42)
+
+	histogram := sm.Metrics().AppendEmpty()
+	histogram.SetName("latency")
+	histogram.SetEmptyHistogram().SetAggregationTemporality(pmetric.AggregationTemporalityCumulative)
+	hdp := histogram.Histogram().DataPoints().AppendEmpty()
+	hdp.SetStartTimestamp(startTime)
+	hdp.ExplicitBounds().FromRaw([]float64{0.1, 1})
+	hdp.BucketCounts().FromRaw([]uint64{3, 2, 1})
+	hdp.SetCount(6)
+	hdp.SetSum(4.2)
+
+	summary := sm.Metrics().AppendEmpty()
+	summary.SetName("duration")
+	sdp := summary.SetEmptySummary().DataPoints().AppendEmpty()
+	sdp.SetStartTimestamp(startTime)
+	q := sdp.QuantileValues().AppendEmpty()
+	q.SetQuantile(0.99)
+	q.SetValue(1.5)
+	return md
+}
+
+func newTestProcessor(t *testing.T, set processor.CreateSettings, cfg *Config, next *consumertest.MetricsSink) *stalenessMarkerProcessor {
+	p, err := NewFactory().CreateMetricsProcessor(context.Background(), set, cfg, next)
+	require.NoError(t, err)
+	return p.(*stalenessMarkerProcessor)
+}
+
+// markers returns the staleness markers of the metrics by the service and name of their metric.
+func markers(md pmetric.Metrics) map[string]pmetric.Metric {
+	found := map[string]pmetric.Metric{}
+	for i := 0; i < md.ResourceMetrics().Len(); i++ {
+		rm := md.ResourceMetrics().At(i)
+		service, _ := rm.Resource().Attributes().Get("service.name")
+		for j := 0; j < rm.ScopeMetrics().Len(); j++ {
+			metrics := rm.ScopeMetrics().At(j).Metrics()
+			for k := 0; k < metrics.Len(); k++ {
+				found[service.Str()+"/"+metrics.At(k).Name()] = metrics.At(k)
+			}
+		}
+	}
+	return found
+}
+
+func TestExportMarkers(t *testing.T) {
+	tel := setupTestTelemetry()
+	next := new(consumertest.MetricsSink)
+	p := newTestProcessor(t, tel.NewCreateSettings(), createDefaultConfig().(*Config), next)
+	now := baseTime
+	p.now = func() time.Time { return now }
+
+	require.NoError(t, p.ConsumeMetrics(context.Background(), newMetrics("api")))
+	require.NoError(t, p.ConsumeMetrics(context.Background(), newMetrics("worker")))
+	require.Len(t, next.AllMetrics(), 2)
+
+	// no series is stale before the timeout
+	now = baseTime.Add(4 * time.Minute)
+	p.exportMarkers()
+	assert.Len(t, next.AllMetrics(), 2)
+	require.NoError(t, p.ConsumeMetrics(context.Background(), newMetrics("api")))
+
+	now = baseTime.Add(6 * time.Minute)
+	p.exportMarkers()
+	require.Len(t, next.AllMetrics(), 4)
+	md := next.AllMetrics()[3]
+	assert.Equal(t, 4, md.DataPointCount())
+	assert.Equal(t, "test", md.ResourceMetrics().At(0).ScopeMetrics().At(0).Scope().Name())
+
+	found := markers(md)
+	require.Len(t, found, 4)
+	timestamp := pcommon.NewTimestampFromTime(now)
+
+	gauge := found["worker/memory"]
+	assert.Equal(t, "By", gauge.Unit())
+	gdp := gauge.Gauge().DataPoints().At(0)
+	assert.True(t, gdp.Flags().NoRecordedValue())
+	assert.True(t, math.IsNaN(gdp.DoubleValue()))
+	assert.Equal(t, timestamp, gdp.Timestamp())
+
+	sum := found["worker/requests"].Sum()
+	assert.Equal(t, pmetric.AggregationTemporalityCumulative, sum.AggregationTemporality())
+	assert.True(t, sum.IsMonotonic())
+	sdp := sum.DataPoints().At(0)
+	assert.True(t, sdp.Flags().NoRecordedValue())
+	assert.Equal(t, map[string]any{"route": "/users"}, sdp.Attributes().AsRaw())
+	assert.Equal(t, pmetric.NumberDataPointValueTypeInt, sdp.ValueType())
+	assert.Equal(t, startTime, sdp.StartTimestamp())
+	assert.Equal(t, timestamp, sdp.Timestamp())
+
+	hdp := found["worker/latency"].Histogram().DataPoints().At(0)
+	assert.True(t, hdp.Flags().NoRecordedValue())
+	assert.Equal(t, []float64{0.1, 1}, hdp.ExplicitBounds().AsRaw())
+	assert.Equal(t, []uint64{0, 0, 0}, hdp.BucketCounts().AsRaw())
+	assert.Equal(t, uint64(0), hdp.Count())
+
+	qdp := found["worker/duration"].Summary().DataPoints().At(0)
+	assert.True(t, qdp.Flags().NoRecordedValue())
+	require.Equal(t, 1, qdp.QuantileValues().Len())
+	assert.Equal(t, 0.99, qdp.QuantileValues().At(0).Quantile())
+	assert.True(t, math.IsNaN(qdp.QuantileValues().At(0).Value()))
+
+	// the markers are emitted once, and the series are tracked again when they report again
+	now = baseTime.Add(20 * time.Minute)
+	p.exportMarkers()
+	require.Len(t, next.AllMetrics(), 5)
+	assert.Len(t, markers(next.AllMetrics()[4]), 4)
+	assert.Contains(t, markers(next.AllMetrics()[4]), "api/memory")
+	p.exportMarkers()
+	assert.Len(t, next.AllMetrics(), 5)
+
+	tel.assertMetrics(t, []metricdata.Metrics{
+		{
+			Name:        "processor_stalenessmarker_markers.emitted",
+			Description: "Number of staleness markers emitted for the series which stopped reporting",
+			Unit:        "1",
+			Data: metricdata.Sum[int64]{
+				Temporality: metricdata.CumulativeTemporality,
+				IsMonotonic: true,
+				DataPoints: []metricdata.DataPoint[int64]{
+					{
+						Value:      8,
+						Attributes: attribute.NewSet(attribute.String(metadata.Type.String(), "stalenessmarker")),
+					},
+				},
+			},
+		},
+	})
+	require.NoError(t, tel.Shutdown(context.Background()))
+}
+
+func TestSourceStalenessMarker(t *testing.T) {
+	next := new(consumertest.MetricsSink)
+	p := newTestProcessor(t, processortest.NewNopCreateSettings(), createDefaultConfig().(*Config), next)
+	now := baseTime
+	p.now = func() time.Time { return now }
+
+	require.NoError(t, p.ConsumeMetrics(context.Background(), newMetrics("api")))
+	// the source marks the gauge as stale itself
+	md := newMetrics("api")
+	md.ResourceMetrics().At(0).ScopeMetrics().At(0).Metrics().At(0).Gauge().DataPoints().At(0).SetFlags(
+		pmetric.DefaultDataPointFlags.WithNoRecordedValue(true))
+	require.NoError(t, p.ConsumeMetrics(context.Background(), md))
+
+	now = baseTime.Add(10 * time.Minute)
+	p.exportMarkers()
+	require.Len(t, next.AllMetrics(), 3)
+	found := markers(next.AllMetrics()[2])
+	assert.Len(t, found, 3)
+	assert.NotContains(t, found, "api/memory")
+}
+
+func TestMaxSeries(t *testing.T) {
+	tel := setupTestTelemetry()
+	cfg := createDefaultConfig().(*Config)
+	cfg.MaxSeries = 2
+	next := new(consumertest.MetricsSink)
+	p := newTestProcessor(t, tel.NewCreateSettings(), cfg, next)
+	now := baseTime
+	p.now = func() time.Time { return now }
+
+	require.NoError(t, p.ConsumeMetrics(context.Background(), newMetrics("api")))
+	// the metrics of the untracked series are still passed
+	require.Len(t, next.AllMetrics(), 1)
+	assert.Equal(t, 4, next.AllMetrics()[0].DataPointCount())
+
+	now = baseTime.Add(10 * time.Minute)
+	p.exportMarkers()
+	require.Len(t, next.AllMetrics(), 2)
+	assert.Equal(t, 2, next.AllMetrics()[1].DataPointCount())
+
+	tel.assertMetrics(t, []metricdata.Metrics{
+		{
+			Name:        "processor_stalenessmarker_markers.emitted",
+			Description: "Number of staleness markers emitted for the series which stopped reporting",
+			Unit:        "1",
+			Data: metricdata.Sum[int64]{
+				Temporality: metricdata.CumulativeTemporality,
+				IsMonotonic: true,
+				DataPoints: []metricdata.DataPoint[int64]{
+					{
+						Value:      2,
+						Attributes: attribute.NewSet(attribute.String(metadata.Type.String(), "stalenessmarker")),
+					},
+				},
+			},
+		},
+		{
+			Name:        "processor_stalenessmarker_series.untracked",
+			Description: "Number of new series which weren't tracked because the maximum number of series was reached",
+			Unit:        "1",
+			Data: metricdata.Sum[int64]{
+				Temporality: metricdata.CumulativeTemporality,
+				IsMonotonic: true,
+				DataPoints: []metricdata.DataPoint[int64]{
+					{
+						Value:      2,
+						Attributes: attribute.NewSet(attribute.String(metadata.Type.String(), "stalenessmarker")),
+					},
+				},
+			},
+		},
+	})
+	require.NoError(t, tel.Shutdown(context.Background()))
+}
+
+func TestCheckAtEachInterval(t *testing.T) {
+	cfg := createDefaultConfig().(*Config)
+	cfg.Timeout = 10 * time.Millisecond
+	cfg.CheckInterval = 10 * time.Millisecond
+	next := new(consumertest.MetricsSink)
+	p := newTestProcessor(t, processortest.NewNopCreateSettings(), cfg, next)
+	require.NoError(t, p.Start(context.Background(), componenttest.NewNopHost()))
+
+	require.NoError(t, p.ConsumeMetrics(context.Background(), newMetrics("api")))
+	assert.Eventually(t, func() bool { return len(next.AllMetrics()) == 2 }, 5*time.Second, 5*time.Millisecond)
+
+	require.NoError(t, p.Shutdown(context.Background()))
+	assert.Equal(t, 4, next.AllMetrics()[1].DataPointCount())
+}
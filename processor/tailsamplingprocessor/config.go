@@ -4,8 +4,11 @@
 package tailsamplingprocessor // import "github.com/open-telemetry/opentelemetry-collector-contrib/processor/tailsamplingprocessor"
 
 import (
+	"errors"
 	"time"
 
+	"go.opentelemetry.io/collector/component"
+
 	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl"
 )
 
@@ -29,6 +32,8 @@ const (
 	StringAttribute PolicyType = "string_attribute"
 	// RateLimiting allows all traces until the specified limits are satisfied.
 	RateLimiting PolicyType = "rate_limiting"
+	// ServiceRateLimiting allows the traces of each service until the specified limits are satisfied.
+	ServiceRateLimiting PolicyType = "service_rate_limiting"
 	// Composite allows defining a composite policy, combining the other policies in one
 	Composite PolicyType = "composite"
 	// And allows defining a And policy, combining the other policies in one
@@ -64,6 +69,8 @@ type sharedPolicyCfg struct {
 	StringAttributeCfg StringAttributeCfg `mapstructure:"string_attribute"`
 	// Configs for rate limiting filter sampling policy evaluator.
 	RateLimitingCfg RateLimitingCfg `mapstructure:"rate_limiting"`
+	// Configs for service rate limiting filter sampling policy evaluator.
+	ServiceRateLimitingCfg ServiceRateLimitingCfg `mapstructure:"service_rate_limiting"`
 	// Configs for span count filter sampling policy evaluator.
 	SpanCountCfg SpanCountCfg `mapstructure:"span_count"`
 	// Configs for defining trace_state policy
@@ -194,6 +201,16 @@ type RateLimitingCfg struct {
 	SpansPerSecond int64 `mapstructure:"spans_per_second"`
 }
 
+// ServiceRateLimitingCfg holds the configurable settings to create a service rate limiting
+// sampling policy evaluator.
+type ServiceRateLimitingCfg struct {
+	// TracesPerSecond sets the limit on the number of traces of each service that can be sampled each second.
+	TracesPerSecond int64 `mapstructure:"traces_per_second"`
+	// Burst sets the number of traces of a service that can be sampled at once after a quiet period.
+	// Defaults to TracesPerSecond.
+	Burst int64 `mapstructure:"burst"`
+}
+
 // SpanCountCfg holds the configurable settings to create a Span Count filter sampling
 // policy evaluator
 type SpanCountCfg struct {
@@ -216,6 +233,7 @@ type BooleanAttributeCfg struct {
 // sampling policy evaluator.
 type OTTLConditionCfg struct {
 	ErrorMode           ottl.ErrorMode `mapstructure:"error_mode"`
+	ResourceConditions  []string       `mapstructure:"resource"`
 	SpanConditions      []string       `mapstructure:"span"`
 	SpanEventConditions []string       `mapstructure:"spanevent"`
 }
@@ -234,4 +252,20 @@ type Config struct {
 	// PolicyCfgs sets the tail-based sampling policy which makes a sampling decision
 	// for a given trace when requested.
 	PolicyCfgs []PolicyCfg `mapstructure:"policies"`
+	// StorageID is the optional storage extension where the pending traces are spilled instead of being dropped
+	// when more than NumTraces traces are kept, and saved on shutdown so that they survive a restart.
+	StorageID *component.ID `mapstructure:"storage"`
+	// MaxStorageSizeMiB is the maximum size, in MiB, of the traces spilled to the storage extension. The pending
+	// traces are dropped once reached.
+	MaxStorageSizeMiB int64 `mapstructure:"max_storage_size_mib"`
+}
+
+var _ component.ConfigValidator = (*Config)(nil)
+
+// Validate checks if the processor configuration is valid.
+func (cfg *Config) Validate() error {
+	if cfg.StorageID != nil && cfg.MaxStorageSizeMiB <= 0 {
+		return errors.New("max_storage_size_mib must be positive when a storage is configured")
+	}
+	return nil
 }
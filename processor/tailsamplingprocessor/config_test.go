@@ -36,6 +36,7 @@ func TestLoadConfig(t *testing.T) {
 			DecisionWait:            10 * time.Second,
 			NumTraces:               100,
 			ExpectedNewTracesPerSec: 10,
+			MaxStorageSizeMiB:       1024,
 			PolicyCfgs: []PolicyCfg{
 				{
 					sharedPolicyCfg: sharedPolicyCfg{
@@ -112,11 +113,19 @@ func TestLoadConfig(t *testing.T) {
 						Type: OTTLCondition,
 						OTTLConditionCfg: OTTLConditionCfg{
 							ErrorMode:           ottl.IgnoreError,
+							ResourceConditions:  []string{"attributes[\"deployment.environment\"] == \"production\""},
 							SpanConditions:      []string{"attributes[\"test_attr_key_1\"] == \"test_attr_val_1\"", "attributes[\"test_attr_key_2\"] != \"test_attr_val_1\""},
 							SpanEventConditions: []string{"name != \"test_span_event_name\"", "attributes[\"test_event_attr_key_2\"] != \"test_event_attr_val_1\""},
 						},
 					},
 				},
+				{
+					sharedPolicyCfg: sharedPolicyCfg{
+						Name:                   "test-policy-12",
+						Type:                   ServiceRateLimiting,
+						ServiceRateLimitingCfg: ServiceRateLimitingCfg{TracesPerSecond: 10, Burst: 50},
+					},
+				},
 				{
 					sharedPolicyCfg: sharedPolicyCfg{
 						Name: "and-policy-1",
@@ -186,3 +195,15 @@ func TestLoadConfig(t *testing.T) {
 			},
 		})
 }
+
+func TestValidateConfig(t *testing.T) {
+	cfg := createDefaultConfig().(*Config)
+	assert.NoError(t, cfg.Validate())
+
+	storageID := component.MustNewID("file_storage")
+	cfg.StorageID = &storageID
+	assert.NoError(t, cfg.Validate())
+
+	cfg.MaxStorageSizeMiB = 0
+	assert.EqualError(t, cfg.Validate(), "max_storage_size_mib must be positive when a storage is configured")
+}
@@ -14,11 +14,13 @@ import (
 	"github.com/open-telemetry/opentelemetry-collector-contrib/internal/filter/expr"
 	"github.com/open-telemetry/opentelemetry-collector-contrib/internal/filter/filterottl"
 	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl/contexts/ottlresource"
 	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl/contexts/ottlspan"
 	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl/contexts/ottlspanevent"
 )
 
 type ottlConditionFilter struct {
+	sampleResourceExpr  expr.BoolExpr[ottlresource.TransformContext]
 	sampleSpanExpr      expr.BoolExpr[ottlspan.TransformContext]
 	sampleSpanEventExpr expr.BoolExpr[ottlspanevent.TransformContext]
 	errorMode           ottl.ErrorMode
@@ -28,7 +30,8 @@ type ottlConditionFilter struct {
 var _ PolicyEvaluator = (*ottlConditionFilter)(nil)
 
 // NewOTTLConditionFilter looks at the trace data and returns a corresponding SamplingDecision.
-func NewOTTLConditionFilter(settings component.TelemetrySettings, spanConditions, spanEventConditions []string, errMode ottl.ErrorMode) (PolicyEvaluator, error) {
+// The trace is sampled as soon as one of the resource, span or span event conditions is true.
+func NewOTTLConditionFilter(settings component.TelemetrySettings, resourceConditions, spanConditions, spanEventConditions []string, errMode ottl.ErrorMode) (PolicyEvaluator, error) {
 	filter := &ottlConditionFilter{
 		errorMode: errMode,
 		logger:    settings.Logger,
@@ -36,10 +39,16 @@ func NewOTTLConditionFilter(settings component.TelemetrySettings, spanConditions
 
 	var err error
 
-	if len(spanConditions) == 0 && len(spanEventConditions) == 0 {
+	if len(resourceConditions) == 0 && len(spanConditions) == 0 && len(spanEventConditions) == 0 {
 		return nil, errors.New("expected at least one OTTL condition to filter on")
 	}
 
+	if len(resourceConditions) > 0 {
+		if filter.sampleResourceExpr, err = filterottl.NewBoolExprForResource(resourceConditions, filterottl.StandardResourceFuncs(), errMode, settings); err != nil {
+			return nil, err
+		}
+	}
+
 	if len(spanConditions) > 0 {
 		if filter.sampleSpanExpr, err = filterottl.NewBoolExprForSpan(spanConditions, filterottl.StandardSpanFuncs(), errMode, settings); err != nil {
 			return nil, err
@@ -58,7 +67,7 @@ func NewOTTLConditionFilter(settings component.TelemetrySettings, spanConditions
 func (ocf *ottlConditionFilter) Evaluate(ctx context.Context, traceID pcommon.TraceID, trace *TraceData) (Decision, error) {
 	ocf.logger.Debug("Evaluating with OTTL conditions filter", zap.String("traceID", traceID.String()))
 
-	if ocf.sampleSpanExpr == nil && ocf.sampleSpanEventExpr == nil {
+	if ocf.sampleResourceExpr == nil && ocf.sampleSpanExpr == nil && ocf.sampleSpanEventExpr == nil {
 		return NotSampled, nil
 	}
 
@@ -69,6 +78,18 @@ func (ocf *ottlConditionFilter) Evaluate(ctx context.Context, traceID pcommon.Tr
 	for i := 0; i < batches.ResourceSpans().Len(); i++ {
 		rs := batches.ResourceSpans().At(i)
 		resource := rs.Resource()
+
+		// Resource evaluation, once for all the spans of the resource
+		if ocf.sampleResourceExpr != nil {
+			ok, err := ocf.sampleResourceExpr.Eval(ctx, ottlresource.NewTransformContext(resource))
+			if err != nil {
+				return Error, err
+			}
+			if ok {
+				return Sampled, nil
+			}
+		}
+
 		for j := 0; j < rs.ScopeSpans().Len(); j++ {
 			ss := rs.ScopeSpans().At(j)
 			scope := ss.Scope()
@@ -6,6 +6,7 @@ package sampling
 import (
 	"context"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 	"go.opentelemetry.io/collector/component/componenttest"
@@ -20,6 +21,7 @@ func TestEvaluate_OTTL(t *testing.T) {
 
 	cases := []struct {
 		Desc                string
+		ResourceConditions  []string
 		SpanConditions      []string
 		SpanEventConditions []string
 		Spans               []spanWithAttributes
@@ -31,12 +33,14 @@ func TestEvaluate_OTTL(t *testing.T) {
 			"OTTL conditions not set",
 			[]string{},
 			[]string{},
+			[]string{},
 			[]spanWithAttributes{{SpanAttributes: map[string]string{"attr_k_1": "attr_v_1"}}},
 			true,
 			NotSampled,
 		},
 		{
 			"OTTL conditions match specific span attributes 1",
+			[]string{},
 			[]string{"attributes[\"attr_k_1\"] == \"attr_v_1\""},
 			[]string{},
 			[]spanWithAttributes{{SpanAttributes: map[string]string{"attr_k_1": "attr_v_1"}}},
@@ -45,6 +49,7 @@ func TestEvaluate_OTTL(t *testing.T) {
 		},
 		{
 			"OTTL conditions match specific span attributes 2",
+			[]string{},
 			[]string{"attributes[\"attr_k_1\"] != \"attr_v_1\""},
 			[]string{},
 			[]spanWithAttributes{{SpanAttributes: map[string]string{"attr_k_1": "attr_v_1"}}},
@@ -53,6 +58,7 @@ func TestEvaluate_OTTL(t *testing.T) {
 		},
 		{
 			"OTTL conditions inverse match(!=) span attributes 2",
+			[]string{},
 			[]string{"attributes[\"attr_k_1\"] != \"attr_v_1\""},
 			[]string{},
 			[]spanWithAttributes{{SpanAttributes: map[string]string{"attr_k_1": "attr_v_2"}}},
@@ -62,6 +68,7 @@ func TestEvaluate_OTTL(t *testing.T) {
 		{
 			"OTTL conditions match specific span event attributes",
 			[]string{},
+			[]string{},
 			[]string{"attributes[\"event_attr_k_1\"] == \"event_attr_v_1\""},
 			[]spanWithAttributes{{SpanEventAttributes: map[string]string{"event_attr_k_1": "event_attr_v_1"}}},
 			false,
@@ -70,6 +77,7 @@ func TestEvaluate_OTTL(t *testing.T) {
 		{
 			"OTTL conditions match specific span event name",
 			[]string{},
+			[]string{},
 			[]string{"name != \"incorrect event name\""},
 			[]spanWithAttributes{{SpanEventAttributes: nil}},
 			false,
@@ -77,17 +85,54 @@ func TestEvaluate_OTTL(t *testing.T) {
 		},
 		{
 			"OTTL conditions not matched",
+			[]string{},
 			[]string{"attributes[\"attr_k_1\"] == \"attr_v_1\""},
 			[]string{"attributes[\"event_attr_k_1\"] == \"event_attr_v_1\""},
 			[]spanWithAttributes{},
 			false,
 			NotSampled,
 		},
+		{
+			"OTTL conditions match the resource attributes",
+			[]string{"attributes[\"service.name\"] == \"checkout\""},
+			[]string{},
+			[]string{},
+			[]spanWithAttributes{{SpanAttributes: map[string]string{"attr_k_1": "attr_v_1"}}},
+			false,
+			Sampled,
+		},
+		{
+			"OTTL conditions don't match the resource attributes",
+			[]string{"attributes[\"service.name\"] == \"payment\""},
+			[]string{},
+			[]string{},
+			[]spanWithAttributes{{SpanAttributes: map[string]string{"attr_k_1": "attr_v_1"}}},
+			false,
+			NotSampled,
+		},
+		{
+			"OTTL condition combining the span attributes, its duration and the resource attributes",
+			[]string{},
+			[]string{"attributes[\"attr_k_1\"] == \"attr_v_1\" and end_time - start_time > Duration(\"2s\") and resource.attributes[\"service.name\"] == \"checkout\""},
+			[]string{},
+			[]spanWithAttributes{{SpanAttributes: map[string]string{"attr_k_1": "attr_v_1"}, Duration: 3 * time.Second}},
+			false,
+			Sampled,
+		},
+		{
+			"OTTL condition combining the span attributes and its duration, too short",
+			[]string{},
+			[]string{"attributes[\"attr_k_1\"] == \"attr_v_1\" and end_time - start_time > Duration(\"2s\")"},
+			[]string{},
+			[]spanWithAttributes{{SpanAttributes: map[string]string{"attr_k_1": "attr_v_1"}, Duration: time.Second}},
+			false,
+			NotSampled,
+		},
 	}
 
 	for _, c := range cases {
 		t.Run(c.Desc, func(t *testing.T) {
-			filter, err := NewOTTLConditionFilter(componenttest.NewNopTelemetrySettings(), c.SpanConditions, c.SpanEventConditions, ottl.IgnoreError)
+			filter, err := NewOTTLConditionFilter(componenttest.NewNopTelemetrySettings(), c.ResourceConditions, c.SpanConditions, c.SpanEventConditions, ottl.IgnoreError)
 			assert.Equal(t, err != nil, c.WantErr)
 
 			if err == nil {
@@ -102,17 +147,22 @@ func TestEvaluate_OTTL(t *testing.T) {
 type spanWithAttributes struct {
 	SpanAttributes      map[string]string
 	SpanEventAttributes map[string]string
+	Duration            time.Duration
 }
 
 func newTraceWithSpansAttributes(spans []spanWithAttributes) *TraceData {
 	traces := ptrace.NewTraces()
 	rs := traces.ResourceSpans().AppendEmpty()
+	rs.Resource().Attributes().PutStr("service.name", "checkout")
 	ils := rs.ScopeSpans().AppendEmpty()
 
 	for _, s := range spans {
 		span := ils.Spans().AppendEmpty()
 		span.SetTraceID([16]byte{1, 2, 3, 4, 5, 6, 7, 8, 9, 10, 11, 12, 13, 14, 15, 16})
 		span.SetSpanID([8]byte{1, 2, 3, 4, 5, 6, 7, 8})
+		start := time.Now()
+		span.SetStartTimestamp(pcommon.NewTimestampFromTime(start))
+		span.SetEndTimestamp(pcommon.NewTimestampFromTime(start.Add(s.Duration)))
 		for k, v := range s.SpanAttributes {
 			span.Attributes().PutStr(k, v)
 		}
@@ -0,0 +1,101 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package sampling // import "github.com/open-telemetry/opentelemetry-collector-contrib/processor/tailsamplingprocessor/internal/sampling"
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"go.opentelemetry.io/collector/component"
+	"go.opentelemetry.io/collector/pdata/pcommon"
+	"go.opentelemetry.io/collector/pdata/ptrace"
+	"go.uber.org/zap"
+)
+
+const serviceNameAttribute = "service.name"
+
+type serviceRateLimiting struct {
+	tracesPerSecond float64
+	burst           float64
+	// buckets are the token buckets of the services, by service name
+	buckets map[string]*tokenBucket
+	now     func() time.Time
+	logger  *zap.Logger
+}
+
+type tokenBucket struct {
+	tokens     float64
+	lastRefill time.Time
+}
+
+var _ PolicyEvaluator = (*serviceRateLimiting)(nil)
+
+// NewServiceRateLimiting creates a policy evaluator that samples up to tracesPerSecond traces per second of each
+// service, allowing bursts of up to burst traces. The service of a trace is the service of its root span, or of its
+// first span if the root span wasn't received.
+func NewServiceRateLimiting(settings component.TelemetrySettings, tracesPerSecond int64, burst int64) (PolicyEvaluator, error) {
+	if tracesPerSecond <= 0 {
+		return nil, errors.New("traces_per_second must be positive")
+	}
+	if burst <= 0 {
+		burst = tracesPerSecond
+	}
+	return &serviceRateLimiting{
+		tracesPerSecond: float64(tracesPerSecond),
+		burst:           float64(burst),
+		buckets:         map[string]*tokenBucket{},
+		now:             time.Now,
+		logger:          settings.Logger,
+	}, nil
+}
+
+// Evaluate looks at the trace data and returns a corresponding SamplingDecision.
+func (r *serviceRateLimiting) Evaluate(_ context.Context, _ pcommon.TraceID, trace *TraceData) (Decision, error) {
+	r.logger.Debug("Evaluating spans in service rate-limiting filter")
+
+	trace.Lock()
+	service := traceServiceName(trace.ReceivedBatches)
+	trace.Unlock()
+
+	now := r.now()
+	bucket, ok := r.buckets[service]
+	if !ok {
+		bucket = &tokenBucket{tokens: r.burst, lastRefill: now}
+		r.buckets[service] = bucket
+	}
+	bucket.tokens += now.Sub(bucket.lastRefill).Seconds() * r.tracesPerSecond
+	if bucket.tokens > r.burst {
+		bucket.tokens = r.burst
+	}
+	bucket.lastRefill = now
+
+	if bucket.tokens < 1 {
+		return NotSampled, nil
+	}
+	bucket.tokens--
+	return Sampled, nil
+}
+
+// traceServiceName returns the service name of the root span of the trace, or of its first span with a service name
+// if the root span isn't in the trace.
+func traceServiceName(td ptrace.Traces) string {
+	var first string
+	for i := 0; i < td.ResourceSpans().Len(); i++ {
+		rs := td.ResourceSpans().At(i)
+		service, ok := rs.Resource().Attributes().Get(serviceNameAttribute)
+		if !ok {
+			continue
+		}
+		if first == "" {
+			first = service.AsString()
+		}
+		if hasInstrumentationLibrarySpanWithCondition(rs.ScopeSpans(), func(span ptrace.Span) bool {
+			return span.ParentSpanID().IsEmpty()
+		}) {
+			return service.AsString()
+		}
+	}
+	return first
+}
@@ -0,0 +1,123 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package sampling
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/collector/component/componenttest"
+	"go.opentelemetry.io/collector/pdata/pcommon"
+	"go.opentelemetry.io/collector/pdata/ptrace"
+)
+
+type serviceSpan struct {
+	service string
+	root    bool
+}
+
+func newTraceWithServices(spans ...serviceSpan) *TraceData {
+	traces := ptrace.NewTraces()
+	for _, s := range spans {
+		rs := traces.ResourceSpans().AppendEmpty()
+		if s.service != "" {
+			rs.Resource().Attributes().PutStr("service.name", s.service)
+		}
+		span := rs.ScopeSpans().AppendEmpty().Spans().AppendEmpty()
+		span.SetTraceID([16]byte{1, 2, 3, 4, 5, 6, 7, 8, 9, 10, 11, 12, 13, 14, 15, 16})
+		span.SetSpanID([8]byte{1, 2, 3, 4, 5, 6, 7, 8})
+		if !s.root {
+			span.SetParentSpanID([8]byte{8, 7, 6, 5, 4, 3, 2, 1})
+		}
+	}
+	return &TraceData{ReceivedBatches: traces}
+}
+
+func TestServiceRateLimiting(t *testing.T) {
+	traceID := pcommon.TraceID([16]byte{1, 2, 3, 4, 5, 6, 7, 8, 9, 10, 11, 12, 13, 14, 15, 16})
+	evaluator, err := NewServiceRateLimiting(componenttest.NewNopTelemetrySettings(), 2, 0)
+	require.NoError(t, err)
+	now := time.Unix(100, 0)
+	evaluator.(*serviceRateLimiting).now = func() time.Time { return now }
+
+	evaluate := func(trace *TraceData) Decision {
+		decision, err := evaluator.Evaluate(context.Background(), traceID, trace)
+		require.NoError(t, err)
+		return decision
+	}
+
+	chatty := newTraceWithServices(serviceSpan{service: "chatty", root: true})
+	quiet := newTraceWithServices(serviceSpan{service: "quiet", root: true})
+
+	// the chatty service spends its own budget only
+	assert.Equal(t, Sampled, evaluate(chatty))
+	assert.Equal(t, Sampled, evaluate(chatty))
+	assert.Equal(t, NotSampled, evaluate(chatty))
+	assert.Equal(t, Sampled, evaluate(quiet))
+
+	// the budget is refilled over time
+	now = now.Add(500 * time.Millisecond)
+	assert.Equal(t, Sampled, evaluate(chatty))
+	assert.Equal(t, NotSampled, evaluate(chatty))
+
+	// up to the burst
+	now = now.Add(time.Hour)
+	assert.Equal(t, Sampled, evaluate(chatty))
+	assert.Equal(t, Sampled, evaluate(chatty))
+	assert.Equal(t, NotSampled, evaluate(chatty))
+}
+
+func TestServiceRateLimitingBurst(t *testing.T) {
+	traceID := pcommon.TraceID([16]byte{1, 2, 3, 4, 5, 6, 7, 8, 9, 10, 11, 12, 13, 14, 15, 16})
+	evaluator, err := NewServiceRateLimiting(componenttest.NewNopTelemetrySettings(), 1, 3)
+	require.NoError(t, err)
+	evaluator.(*serviceRateLimiting).now = func() time.Time { return time.Unix(100, 0) }
+
+	trace := newTraceWithServices(serviceSpan{service: "svc", root: true})
+	for i := 0; i < 3; i++ {
+		decision, err := evaluator.Evaluate(context.Background(), traceID, trace)
+		require.NoError(t, err)
+		assert.Equal(t, Sampled, decision)
+	}
+	decision, err := evaluator.Evaluate(context.Background(), traceID, trace)
+	require.NoError(t, err)
+	assert.Equal(t, NotSampled, decision)
+}
+
+func TestServiceRateLimitingInvalid(t *testing.T) {
+	_, err := NewServiceRateLimiting(componenttest.NewNopTelemetrySettings(), 0, 0)
+	assert.EqualError(t, err, "traces_per_second must be positive")
+}
+
+func TestTraceServiceName(t *testing.T) {
+	tests := []struct {
+		name  string
+		spans []serviceSpan
+		want  string
+	}{
+		{
+			name:  "root span",
+			spans: []serviceSpan{{service: "backend"}, {service: "frontend", root: true}},
+			want:  "frontend",
+		},
+		{
+			name:  "no root span",
+			spans: []serviceSpan{{}, {service: "backend"}, {service: "database"}},
+			want:  "backend",
+		},
+		{
+			name:  "no service name",
+			spans: []serviceSpan{{root: true}},
+			want:  "",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, traceServiceName(newTraceWithServices(tt.spans...).ReceivedBatches))
+		})
+	}
+}
@@ -5,6 +5,7 @@ package tailsamplingprocessor // import "github.com/open-telemetry/opentelemetry
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"math"
 	"runtime"
@@ -14,6 +15,7 @@ import (
 
 	"go.opentelemetry.io/collector/component"
 	"go.opentelemetry.io/collector/consumer"
+	"go.opentelemetry.io/collector/extension/experimental/storage"
 	"go.opentelemetry.io/collector/pdata/pcommon"
 	"go.opentelemetry.io/collector/pdata/ptrace"
 	"go.opentelemetry.io/collector/processor"
@@ -51,6 +53,12 @@ type tailSamplingSpanProcessor struct {
 	decisionBatcher idbatcher.Batcher
 	deleteChan      chan pcommon.TraceID
 	numTracesOnMap  *atomic.Uint64
+
+	id             component.ID
+	storageID      *component.ID
+	maxStorageSize int64
+	// spill holds the pending traces moved to the storage extension, nil without storage extension.
+	spill *traceSpill
 }
 
 // spanAndScope a structure for holding information about span and its instrumentation scope.
@@ -67,7 +75,8 @@ const (
 
 // newTracesProcessor returns a processor.TracesProcessor that will perform tail sampling according to the given
 // configuration.
-func newTracesProcessor(ctx context.Context, settings component.TelemetrySettings, nextConsumer consumer.Traces, cfg Config) (processor.Traces, error) {
+func newTracesProcessor(ctx context.Context, set processor.CreateSettings, nextConsumer consumer.Traces, cfg Config) (processor.Traces, error) {
+	settings := set.TelemetrySettings
 	telemetry := telemetry.New()
 	policyNames := map[string]bool{}
 	policies := make([]*policy, len(cfg.PolicyCfgs))
@@ -113,6 +122,9 @@ func newTracesProcessor(ctx context.Context, settings component.TelemetrySetting
 		tickerFrequency: time.Second,
 		numTracesOnMap:  &atomic.Uint64{},
 		T:               telemetry,
+		id:              set.ID,
+		storageID:       cfg.StorageID,
+		maxStorageSize:  cfg.MaxStorageSizeMiB << 20,
 	}
 
 	tsp.policyTicker = &timeutils.PolicyTicker{OnTickFunc: tsp.samplingPolicyOnTick}
@@ -156,6 +168,9 @@ func getSharedPolicyEvaluator(settings component.TelemetrySettings, cfg *sharedP
 	case RateLimiting:
 		rlfCfg := cfg.RateLimitingCfg
 		return sampling.NewRateLimiting(settings, rlfCfg.SpansPerSecond), nil
+	case ServiceRateLimiting:
+		srlfCfg := cfg.ServiceRateLimitingCfg
+		return sampling.NewServiceRateLimiting(settings, srlfCfg.TracesPerSecond, srlfCfg.Burst)
 	case SpanCount:
 		spCfg := cfg.SpanCountCfg
 		return sampling.NewSpanCount(settings, spCfg.MinSpans, spCfg.MaxSpans), nil
@@ -167,7 +182,7 @@ func getSharedPolicyEvaluator(settings component.TelemetrySettings, cfg *sharedP
 		return sampling.NewBooleanAttributeFilter(settings, bafCfg.Key, bafCfg.Value), nil
 	case OTTLCondition:
 		ottlfCfg := cfg.OTTLConditionCfg
-		return sampling.NewOTTLConditionFilter(settings, ottlfCfg.SpanConditions, ottlfCfg.SpanEventConditions, ottlfCfg.ErrorMode)
+		return sampling.NewOTTLConditionFilter(settings, ottlfCfg.ResourceConditions, ottlfCfg.SpanConditions, ottlfCfg.SpanEventConditions, ottlfCfg.ErrorMode)
 
 	default:
 		return nil, fmt.Errorf("unknown sampling policy type %s", cfg.Type)
@@ -186,12 +201,18 @@ func (tsp *tailSamplingSpanProcessor) samplingPolicyOnTick() {
 	batchLen := len(batch)
 	tsp.logger.Debug("Sampling Policy Evaluation ticked")
 	for _, id := range batch {
-		d, ok := tsp.idToTrace.Load(id)
+		trace, ok := tsp.loadTrace(id)
 		if !ok {
 			metrics.idNotFoundOnMapCount++
 			continue
 		}
-		trace := d.(*sampling.TraceData)
+		trace.Lock()
+		decided := trace.FinalDecision != sampling.Unspecified
+		trace.Unlock()
+		if decided {
+			// the late spans of a spilled trace, decided with the spilled spans
+			continue
+		}
 		trace.DecisionTime = time.Now()
 
 		decision, policy := tsp.makeDecision(id, trace, &metrics)
@@ -405,16 +426,126 @@ func (tsp *tailSamplingSpanProcessor) Capabilities() consumer.Capabilities {
 }
 
 // Start is invoked during service startup.
-func (tsp *tailSamplingSpanProcessor) Start(context.Context, component.Host) error {
+func (tsp *tailSamplingSpanProcessor) Start(ctx context.Context, host component.Host) error {
+	if tsp.storageID != nil {
+		if err := tsp.startSpill(ctx, host); err != nil {
+			return err
+		}
+	}
 	tsp.policyTicker.Start(tsp.tickerFrequency)
 	return nil
 }
 
+func (tsp *tailSamplingSpanProcessor) startSpill(ctx context.Context, host component.Host) error {
+	ext, ok := host.GetExtensions()[*tsp.storageID]
+	if !ok {
+		return fmt.Errorf("storage extension '%s' not found", tsp.storageID)
+	}
+	storageExt, ok := ext.(storage.Extension)
+	if !ok {
+		return fmt.Errorf("non-storage extension '%s' found", tsp.storageID)
+	}
+	client, err := storageExt.GetClient(ctx, component.KindProcessor, tsp.id, "")
+	if err != nil {
+		return fmt.Errorf("failed to get the storage client: %w", err)
+	}
+	tsp.spill = newTraceSpill(client, tsp.maxStorageSize)
+
+	ids, err := tsp.spill.restoreIndex(ctx)
+	if err != nil {
+		// the traces of the previous run are lost, which isn't a reason to stop the pipeline
+		tsp.logger.Warn("Ignoring the spilled traces", zap.Error(err))
+		return nil
+	}
+	// the restored traces are decided once the decision wait elapsed again
+	for _, id := range ids {
+		tsp.decisionBatcher.AddToCurrentBatch(id)
+	}
+	if len(ids) > 0 {
+		tsp.logger.Info("Restored the spilled traces", zap.Int("traces", len(ids)))
+	}
+	return nil
+}
+
 // Shutdown is invoked during service shutdown.
-func (tsp *tailSamplingSpanProcessor) Shutdown(context.Context) error {
+func (tsp *tailSamplingSpanProcessor) Shutdown(ctx context.Context) error {
 	tsp.decisionBatcher.Stop()
 	tsp.policyTicker.Stop()
-	return nil
+	if tsp.spill == nil {
+		return nil
+	}
+
+	// the pending traces are saved to be decided after a restart
+	var errs error
+	tsp.idToTrace.Range(func(key, value any) bool {
+		if _, err := tsp.spillTrace(ctx, key.(pcommon.TraceID), value.(*sampling.TraceData)); err != nil {
+			errs = errors.Join(errs, fmt.Errorf("failed to spill the trace: %w", err))
+			return false
+		}
+		return true
+	})
+	return errors.Join(errs, tsp.spill.close(ctx))
+}
+
+// spillTrace moves the spans of a pending trace to the storage extension. It returns whether the trace was spilled,
+// which it isn't when already decided or when the storage is full.
+func (tsp *tailSamplingSpanProcessor) spillTrace(ctx context.Context, id pcommon.TraceID, trace *sampling.TraceData) (bool, error) {
+	trace.Lock()
+	defer trace.Unlock()
+	if trace.FinalDecision != sampling.Unspecified || !trace.DecisionTime.IsZero() || trace.ReceivedBatches.ResourceSpans().Len() == 0 {
+		return false, nil
+	}
+	spilled, err := tsp.spill.store(ctx, id, trace.ArrivalTime, trace.SpanCount.Load(), trace.ReceivedBatches)
+	if spilled {
+		trace.ReceivedBatches = ptrace.NewTraces()
+	}
+	return spilled, err
+}
+
+// loadTrace returns the trace to decide on, with its spans spilled to the storage extension, if any.
+func (tsp *tailSamplingSpanProcessor) loadTrace(id pcommon.TraceID) (*sampling.TraceData, bool) {
+	d, ok := tsp.idToTrace.Load(id)
+	if tsp.spill == nil {
+		if !ok {
+			return nil, false
+		}
+		return d.(*sampling.TraceData), true
+	}
+
+	spilled, td, found, err := tsp.spill.load(tsp.ctx, id)
+	if err != nil {
+		tsp.logger.Warn("Failed to load the spilled trace", zap.Stringer("traceID", id), zap.Error(err))
+	}
+	if !ok {
+		if !found {
+			return nil, false
+		}
+		// the late spans of the traces spilled then decided aren't forwarded, like the ones of the dropped traces
+		decisions := make([]sampling.Decision, len(tsp.policies))
+		for i := range decisions {
+			decisions[i] = sampling.Pending
+		}
+		spanCount := &atomic.Int64{}
+		spanCount.Store(spilled.spanCount)
+		return &sampling.TraceData{
+			Decisions:       decisions,
+			ArrivalTime:     spilled.arrivalTime,
+			SpanCount:       spanCount,
+			ReceivedBatches: td,
+		}, true
+	}
+
+	trace := d.(*sampling.TraceData)
+	if found {
+		trace.Lock()
+		td.ResourceSpans().MoveAndAppendTo(trace.ReceivedBatches.ResourceSpans())
+		if spilled.arrivalTime.Before(trace.ArrivalTime) {
+			trace.ArrivalTime = spilled.arrivalTime
+		}
+		trace.Unlock()
+		trace.SpanCount.Add(spilled.spanCount)
+	}
+	return trace, true
 }
 
 func (tsp *tailSamplingSpanProcessor) dropTrace(traceID pcommon.TraceID, deletionTime time.Time) {
@@ -430,6 +561,13 @@ func (tsp *tailSamplingSpanProcessor) dropTrace(traceID pcommon.TraceID, deletio
 		return
 	}
 
+	if tsp.spill != nil {
+		// the pending trace is kept in the storage extension until its decision
+		if _, err := tsp.spillTrace(tsp.ctx, traceID, trace); err != nil {
+			tsp.logger.Warn("Failed to spill the trace", zap.Stringer("traceID", traceID), zap.Error(err))
+		}
+	}
+
 	tsp.RecordTraceRemovalAge(tsp.ctx, int64(deletionTime.Sub(trace.ArrivalTime)/time.Second))
 }
 
@@ -0,0 +1,182 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package tailsamplingprocessor // import "github.com/open-telemetry/opentelemetry-collector-contrib/processor/tailsamplingprocessor"
+
+import (
+	"context"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+
+	"go.opentelemetry.io/collector/extension/experimental/storage"
+	"go.opentelemetry.io/collector/pdata/pcommon"
+	"go.opentelemetry.io/collector/pdata/ptrace"
+)
+
+// spillIndexKey is the key of the index of the spilled traces, saved on shutdown.
+const spillIndexKey = "spilled_traces"
+
+// spillIndexEntryLen is the length of an entry of the index: the trace ID, its arrival time, its span count and the
+// size of its spans.
+const spillIndexEntryLen = 16 + 8 + 8 + 8
+
+var (
+	spillProtoMarshaler   ptrace.ProtoMarshaler
+	spillProtoUnmarshaler ptrace.ProtoUnmarshaler
+)
+
+// traceSpill holds the pending traces moved to a storage extension, either because there were too many traces to
+// keep in memory, or on shutdown so that they survive a restart of the collector.
+type traceSpill struct {
+	client  storage.Client
+	maxSize int64
+
+	mu     sync.Mutex
+	traces map[pcommon.TraceID]spilledTrace
+	// size is the total size of the spilled spans
+	size int64
+}
+
+type spilledTrace struct {
+	arrivalTime time.Time
+	spanCount   int64
+	size        int64
+}
+
+func newTraceSpill(client storage.Client, maxSize int64) *traceSpill {
+	return &traceSpill{
+		client:  client,
+		maxSize: maxSize,
+		traces:  map[pcommon.TraceID]spilledTrace{},
+	}
+}
+
+func spillKey(id pcommon.TraceID) string {
+	return "trace_" + id.String()
+}
+
+// store moves the spans of a trace to the storage. It returns false, keeping the spans, when the storage is full.
+func (s *traceSpill) store(ctx context.Context, id pcommon.TraceID, arrivalTime time.Time, spanCount int64, td ptrace.Traces) (bool, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	previous, spilled := s.traces[id]
+	if spilled {
+		// late spans of a trace spilled before, stored with the previous ones
+		buf, err := s.client.Get(ctx, spillKey(id))
+		if err != nil {
+			return false, err
+		}
+		stored, err := spillProtoUnmarshaler.UnmarshalTraces(buf)
+		if err != nil {
+			return false, err
+		}
+		for i := 0; i < td.ResourceSpans().Len(); i++ {
+			td.ResourceSpans().At(i).CopyTo(stored.ResourceSpans().AppendEmpty())
+		}
+		td = stored
+		arrivalTime = previous.arrivalTime
+		spanCount += previous.spanCount
+	}
+
+	buf, err := spillProtoMarshaler.MarshalTraces(td)
+	if err != nil {
+		return false, err
+	}
+	size := int64(len(buf))
+	if s.size-previous.size+size > s.maxSize {
+		return false, nil
+	}
+	if err = s.client.Set(ctx, spillKey(id), buf); err != nil {
+		return false, err
+	}
+	s.traces[id] = spilledTrace{arrivalTime: arrivalTime, spanCount: spanCount, size: size}
+	s.size += size - previous.size
+	return true, nil
+}
+
+// load removes a trace from the storage, and returns its spans.
+func (s *traceSpill) load(ctx context.Context, id pcommon.TraceID) (spilledTrace, ptrace.Traces, bool, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	spilled, ok := s.traces[id]
+	if !ok {
+		return spilledTrace{}, ptrace.Traces{}, false, nil
+	}
+	delete(s.traces, id)
+	s.size -= spilled.size
+
+	key := spillKey(id)
+	buf, err := s.client.Get(ctx, key)
+	if err != nil {
+		return spilledTrace{}, ptrace.Traces{}, false, err
+	}
+	if err = s.client.Delete(ctx, key); err != nil {
+		return spilledTrace{}, ptrace.Traces{}, false, err
+	}
+	if buf == nil {
+		return spilledTrace{}, ptrace.Traces{}, false, fmt.Errorf("spilled trace %s not found in the storage", id)
+	}
+	td, err := spillProtoUnmarshaler.UnmarshalTraces(buf)
+	if err != nil {
+		return spilledTrace{}, ptrace.Traces{}, false, err
+	}
+	return spilled, td, true, nil
+}
+
+// saveIndex saves the index of the spilled traces, for them to be restored by restoreIndex after a restart.
+func (s *traceSpill) saveIndex(ctx context.Context) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	buf := make([]byte, 0, len(s.traces)*spillIndexEntryLen)
+	for id, spilled := range s.traces {
+		buf = append(buf, id[:]...)
+		buf = binary.BigEndian.AppendUint64(buf, uint64(spilled.arrivalTime.UnixNano()))
+		buf = binary.BigEndian.AppendUint64(buf, uint64(spilled.spanCount))
+		buf = binary.BigEndian.AppendUint64(buf, uint64(spilled.size))
+	}
+	return s.client.Set(ctx, spillIndexKey, buf)
+}
+
+// restoreIndex restores the index saved by saveIndex, and returns the IDs of the spilled traces.
+func (s *traceSpill) restoreIndex(ctx context.Context) ([]pcommon.TraceID, error) {
+	buf, err := s.client.Get(ctx, spillIndexKey)
+	if err != nil {
+		return nil, err
+	}
+	if len(buf)%spillIndexEntryLen != 0 {
+		return nil, errors.New("corrupted index of the spilled traces")
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	ids := make([]pcommon.TraceID, 0, len(buf)/spillIndexEntryLen)
+	for ; len(buf) > 0; buf = buf[spillIndexEntryLen:] {
+		var id pcommon.TraceID
+		copy(id[:], buf[:16])
+		spilled := spilledTrace{
+			arrivalTime: time.Unix(0, int64(binary.BigEndian.Uint64(buf[16:24]))),
+			spanCount:   int64(binary.BigEndian.Uint64(buf[24:32])),
+			size:        int64(binary.BigEndian.Uint64(buf[32:40])),
+		}
+		s.traces[id] = spilled
+		s.size += spilled.size
+		ids = append(ids, id)
+	}
+	return ids, nil
+}
+
+// close saves the index of the spilled traces, and closes the storage client.
+func (s *traceSpill) close(ctx context.Context) error {
+	var errs error
+	if err := s.saveIndex(ctx); err != nil {
+		errs = errors.Join(errs, fmt.Errorf("failed to save the index of the spilled traces: %w", err))
+	}
+	return errors.Join(errs, s.client.Close(ctx))
+}
@@ -0,0 +1,195 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package tailsamplingprocessor
+
+import (
+	"context"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/collector/component"
+	"go.opentelemetry.io/collector/component/componenttest"
+	"go.opentelemetry.io/collector/consumer/consumertest"
+	"go.opentelemetry.io/collector/pdata/pcommon"
+	"go.opentelemetry.io/collector/processor/processortest"
+	"go.uber.org/zap"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/extension/storage/storagetest"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/processor/tailsamplingprocessor/internal/sampling"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/processor/tailsamplingprocessor/internal/telemetry"
+)
+
+func newTestSpill(maxSize int64) *traceSpill {
+	return newTraceSpill(storagetest.NewInMemoryClient(component.KindProcessor, component.MustNewID("tail_sampling"), ""), maxSize)
+}
+
+func TestTraceSpill(t *testing.T) {
+	ctx := context.Background()
+	s := newTestSpill(1 << 20)
+	id := uInt64ToTraceID(1)
+	arrival := time.Unix(100, 0)
+
+	spilled, err := s.store(ctx, id, arrival, 1, simpleTracesWithID(id))
+	require.NoError(t, err)
+	assert.True(t, spilled)
+	// late spans are stored with the spilled ones
+	spilled, err = s.store(ctx, id, arrival.Add(time.Second), 1, simpleTracesWithID(id))
+	require.NoError(t, err)
+	assert.True(t, spilled)
+
+	trace, td, found, err := s.load(ctx, id)
+	require.NoError(t, err)
+	require.True(t, found)
+	assert.Equal(t, 2, td.SpanCount())
+	assert.Equal(t, int64(2), trace.spanCount)
+	assert.Equal(t, arrival, trace.arrivalTime)
+	assert.Zero(t, s.size)
+
+	// the spans are removed from the storage once loaded
+	_, _, found, err = s.load(ctx, id)
+	require.NoError(t, err)
+	assert.False(t, found)
+}
+
+func TestTraceSpillMaxSize(t *testing.T) {
+	ctx := context.Background()
+	size := int64(spillSize(t))
+	s := newTestSpill(2 * size)
+
+	for i := uint64(1); i <= 2; i++ {
+		spilled, err := s.store(ctx, uInt64ToTraceID(i), time.Now(), 1, simpleTracesWithID(uInt64ToTraceID(i)))
+		require.NoError(t, err)
+		assert.True(t, spilled)
+	}
+	spilled, err := s.store(ctx, uInt64ToTraceID(3), time.Now(), 1, simpleTracesWithID(uInt64ToTraceID(3)))
+	require.NoError(t, err)
+	assert.False(t, spilled, "the storage is full")
+
+	_, _, found, err := s.load(ctx, uInt64ToTraceID(1))
+	require.NoError(t, err)
+	require.True(t, found)
+	spilled, err = s.store(ctx, uInt64ToTraceID(3), time.Now(), 1, simpleTracesWithID(uInt64ToTraceID(3)))
+	require.NoError(t, err)
+	assert.True(t, spilled)
+}
+
+func spillSize(t *testing.T) int {
+	buf, err := spillProtoMarshaler.MarshalTraces(simpleTracesWithID(uInt64ToTraceID(1)))
+	require.NoError(t, err)
+	return len(buf)
+}
+
+func TestTraceSpillIndex(t *testing.T) {
+	ctx := context.Background()
+	client := storagetest.NewInMemoryClient(component.KindProcessor, component.MustNewID("tail_sampling"), "")
+	s := newTraceSpill(client, 1<<20)
+	id := uInt64ToTraceID(1)
+	arrival := time.Unix(100, 0)
+	_, err := s.store(ctx, id, arrival, 1, simpleTracesWithID(id))
+	require.NoError(t, err)
+	require.NoError(t, s.saveIndex(ctx))
+
+	restored := newTraceSpill(client, 1<<20)
+	ids, err := restored.restoreIndex(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, []pcommon.TraceID{id}, ids)
+	assert.Equal(t, s.size, restored.size)
+
+	trace, td, found, err := restored.load(ctx, id)
+	require.NoError(t, err)
+	require.True(t, found)
+	assert.Equal(t, 1, td.SpanCount())
+	assert.True(t, arrival.Equal(trace.arrivalTime))
+
+	require.NoError(t, client.Set(ctx, spillIndexKey, []byte{1, 2, 3}))
+	_, err = newTraceSpill(client, 1<<20).restoreIndex(ctx)
+	assert.EqualError(t, err, "corrupted index of the spilled traces")
+}
+
+func TestSpillEvictedTraces(t *testing.T) {
+	msp := new(consumertest.TracesSink)
+	mpe := &mockPolicyEvaluator{NextDecision: sampling.Sampled}
+	tsp := &tailSamplingSpanProcessor{
+		T:               telemetry.New(),
+		ctx:             context.Background(),
+		nextConsumer:    msp,
+		maxNumTraces:    1,
+		logger:          zap.NewNop(),
+		decisionBatcher: newSyncIDBatcher(1),
+		policies:        []*policy{{name: "mock-policy", evaluator: mpe, ctx: context.TODO()}},
+		deleteChan:      make(chan pcommon.TraceID, 1),
+		policyTicker:    &manualTTicker{},
+		tickerFrequency: 100 * time.Millisecond,
+		numTracesOnMap:  &atomic.Uint64{},
+		spill:           newTestSpill(1 << 20),
+	}
+
+	// the first trace is evicted from the memory by the second one, and spilled
+	require.NoError(t, tsp.ConsumeTraces(context.Background(), simpleTracesWithID(uInt64ToTraceID(1))))
+	require.NoError(t, tsp.ConsumeTraces(context.Background(), simpleTracesWithID(uInt64ToTraceID(2))))
+	_, ok := tsp.idToTrace.Load(uInt64ToTraceID(1))
+	assert.False(t, ok)
+	assert.Len(t, tsp.spill.traces, 1)
+
+	tsp.samplingPolicyOnTick()
+	tsp.samplingPolicyOnTick()
+
+	require.Len(t, msp.AllTraces(), 2)
+	assert.Equal(t, uInt64ToTraceID(1), msp.AllTraces()[0].ResourceSpans().At(0).ScopeSpans().At(0).Spans().At(0).TraceID())
+	assert.Empty(t, tsp.spill.traces)
+}
+
+func TestSpillSurvivesRestart(t *testing.T) {
+	ext := storagetest.NewFileBackedStorageExtension("tail_sampling", t.TempDir())
+	host := storagetest.NewStorageHost().WithExtension(ext.ID, ext)
+	cfg := createDefaultConfig().(*Config)
+	cfg.DecisionWait = time.Second
+	cfg.PolicyCfgs = testPolicy
+	cfg.StorageID = &ext.ID
+
+	// the storage client is identified by the processor ID, which has to be the same after the restart
+	set := processortest.NewNopCreateSettings()
+	next := new(consumertest.TracesSink)
+	p, err := newTracesProcessor(context.Background(), set, next, *cfg)
+	require.NoError(t, err)
+	require.NoError(t, p.Start(context.Background(), host))
+	require.NoError(t, p.ConsumeTraces(context.Background(), simpleTraces()))
+	require.NoError(t, p.Shutdown(context.Background()))
+	assert.Zero(t, next.SpanCount())
+
+	p, err = newTracesProcessor(context.Background(), set, next, *cfg)
+	require.NoError(t, err)
+	tsp := p.(*tailSamplingSpanProcessor)
+	tsp.tickerFrequency = 100 * time.Millisecond
+	require.NoError(t, p.Start(context.Background(), host))
+	defer func() {
+		require.NoError(t, p.Shutdown(context.Background()))
+	}()
+
+	// the pending trace is decided after the restart
+	assert.Eventually(t, func() bool { return next.SpanCount() == 1 }, 5*time.Second, 10*time.Millisecond)
+}
+
+func TestSpillStorageExtensionErrors(t *testing.T) {
+	cfg := createDefaultConfig().(*Config)
+	cfg.PolicyCfgs = testPolicy
+
+	missing := storagetest.NewStorageID("missing")
+	cfg.StorageID = &missing
+	p, err := newTracesProcessor(context.Background(), processortest.NewNopCreateSettings(), consumertest.NewNop(), *cfg)
+	require.NoError(t, err)
+	assert.EqualError(t, p.Start(context.Background(), componenttest.NewNopHost()), "storage extension 'test_storage/missing' not found")
+	assert.NoError(t, p.Shutdown(context.Background()))
+
+	nonStorage := storagetest.NewNonStorageExtension("other")
+	cfg.StorageID = &nonStorage.ID
+	p, err = newTracesProcessor(context.Background(), processortest.NewNopCreateSettings(), consumertest.NewNop(), *cfg)
+	require.NoError(t, err)
+	host := storagetest.NewStorageHost().WithExtension(nonStorage.ID, nonStorage)
+	assert.EqualError(t, p.Start(context.Background(), host), "non-storage extension 'non_storage/other' found")
+	assert.NoError(t, p.Shutdown(context.Background()))
+}
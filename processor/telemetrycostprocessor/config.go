@@ -0,0 +1,85 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package telemetrycostprocessor // import "github.com/open-telemetry/opentelemetry-collector-contrib/processor/telemetrycostprocessor"
+
+import (
+	"errors"
+	"fmt"
+	"slices"
+	"time"
+
+	"go.opentelemetry.io/collector/component"
+)
+
+// Config defines configuration for the telemetry cost processor.
+type Config struct {
+	// Attributes are the resource attributes the data is attributed by, e.g. the tenant or the service.
+	Attributes []string `mapstructure:"attributes"`
+
+	// CostPerGB is the estimated cost of a gigabyte of each signal, in the currency of the backend. The cost
+	// of a signal isn't estimated when it's 0.
+	CostPerGB CostPerGB `mapstructure:"cost_per_gb"`
+
+	// Window is the duration over which the data is counted against the budgets.
+	Window time.Duration `mapstructure:"window"`
+
+	// Budgets are the budgets of the values of the attributes over a window. The first budget matching the
+	// resource applies.
+	Budgets []Budget `mapstructure:"budgets"`
+
+	// BudgetAttribute is the resource attribute set to true on the data ingested over its budget.
+	BudgetAttribute string `mapstructure:"budget_attribute"`
+}
+
+// CostPerGB is the estimated cost of a gigabyte of each signal.
+type CostPerGB struct {
+	Traces  float64 `mapstructure:"traces"`
+	Metrics float64 `mapstructure:"metrics"`
+	Logs    float64 `mapstructure:"logs"`
+}
+
+// Budget is the budget of the data of the resources matching values of the attributes over a window.
+type Budget struct {
+	// Match are the values of the attributes of the resources the budget applies to. The budget applies to all
+	// the resources when it's empty.
+	Match map[string]string `mapstructure:"match"`
+
+	// MaxItems is the maximum number of spans, data points or log records over a window, 0 meaning no limit.
+	MaxItems int64 `mapstructure:"max_items"`
+
+	// MaxBytes is the maximum size in bytes of the data over a window, 0 meaning no limit.
+	MaxBytes int64 `mapstructure:"max_bytes"`
+}
+
+var _ component.Config = (*Config)(nil)
+
+// Validate checks if the processor configuration is valid.
+func (cfg *Config) Validate() error {
+	if len(cfg.Attributes) == 0 {
+		return errors.New("attributes must not be empty")
+	}
+	if cfg.CostPerGB.Traces < 0 || cfg.CostPerGB.Metrics < 0 || cfg.CostPerGB.Logs < 0 {
+		return errors.New("cost_per_gb can't be negative")
+	}
+	if cfg.Window <= 0 {
+		return errors.New("window must be positive")
+	}
+	if len(cfg.Budgets) > 0 && cfg.BudgetAttribute == "" {
+		return errors.New("budget_attribute must be specified when budgets are set")
+	}
+	for i, budget := range cfg.Budgets {
+		for key := range budget.Match {
+			if !slices.Contains(cfg.Attributes, key) {
+				return fmt.Errorf("budgets[%d]: the matched attribute %q must be one of the attributes", i, key)
+			}
+		}
+		if budget.MaxItems < 0 || budget.MaxBytes < 0 {
+			return fmt.Errorf("budgets[%d]: the limits can't be negative", i)
+		}
+		if budget.MaxItems == 0 && budget.MaxBytes == 0 {
+			return fmt.Errorf("budgets[%d]: at least one of max_items and max_bytes must be set", i)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,90 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package telemetrycostprocessor
+
+import (
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/collector/component"
+	"go.opentelemetry.io/collector/confmap/confmaptest"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/processor/telemetrycostprocessor/internal/metadata"
+)
+
+func TestLoadConfig(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		id           component.ID
+		expected     component.Config
+		errorMessage string
+	}{
+		{
+			id:       component.NewID(metadata.Type),
+			expected: createDefaultConfig(),
+		},
+		{
+			id: component.NewIDWithName(metadata.Type, "budgets"),
+			expected: &Config{
+				Attributes: []string{"tenant.id", "service.name"},
+				CostPerGB:  CostPerGB{Traces: 0.3, Metrics: 0.1, Logs: 0.5},
+				Window:     24 * time.Hour,
+				Budgets: []Budget{
+					{Match: map[string]string{"tenant.id": "acme"}, MaxBytes: 10_000_000_000},
+					{MaxItems: 1_000_000},
+				},
+				BudgetAttribute: "acme.budget.exceeded",
+			},
+		},
+		{
+			id:           component.NewIDWithName(metadata.Type, "no_attributes"),
+			errorMessage: "attributes must not be empty",
+		},
+		{
+			id:           component.NewIDWithName(metadata.Type, "negative_cost"),
+			errorMessage: "cost_per_gb can't be negative",
+		},
+		{
+			id:           component.NewIDWithName(metadata.Type, "no_window"),
+			errorMessage: "window must be positive",
+		},
+		{
+			id:           component.NewIDWithName(metadata.Type, "no_budget_attribute"),
+			errorMessage: "budget_attribute must be specified when budgets are set",
+		},
+		{
+			id:           component.NewIDWithName(metadata.Type, "unknown_match"),
+			errorMessage: `budgets[0]: the matched attribute "tenant.id" must be one of the attributes`,
+		},
+		{
+			id:           component.NewIDWithName(metadata.Type, "no_limits"),
+			errorMessage: "budgets[0]: at least one of max_items and max_bytes must be set",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.id.String(), func(t *testing.T) {
+			cm, err := confmaptest.LoadConf(filepath.Join("testdata", "config.yaml"))
+			require.NoError(t, err)
+
+			factory := NewFactory()
+			cfg := factory.CreateDefaultConfig()
+
+			sub, err := cm.Sub(tt.id.String())
+			require.NoError(t, err)
+			require.NoError(t, sub.Unmarshal(cfg))
+
+			if tt.errorMessage != "" {
+				assert.EqualError(t, component.ValidateConfig(cfg), tt.errorMessage)
+				return
+			}
+			assert.NoError(t, component.ValidateConfig(cfg))
+			assert.Equal(t, tt.expected, cfg)
+		})
+	}
+}
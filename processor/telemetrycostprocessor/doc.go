@@ -0,0 +1,9 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+//go:generate mdatagen metadata.yaml
+
+// Package telemetrycostprocessor implements a processor that estimates the
+// volume and the cost of the data ingested per tenant or service, and tags
+// the data ingested over its budget.
+package telemetrycostprocessor // import "github.com/open-telemetry/opentelemetry-collector-contrib/processor/telemetrycostprocessor"
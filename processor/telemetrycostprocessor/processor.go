@@ -0,0 +1,178 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package telemetrycostprocessor // import "github.com/open-telemetry/opentelemetry-collector-contrib/processor/telemetrycostprocessor"
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"go.opentelemetry.io/collector/pdata/pcommon"
+	"go.opentelemetry.io/collector/pdata/plog"
+	"go.opentelemetry.io/collector/pdata/pmetric"
+	"go.opentelemetry.io/collector/pdata/ptrace"
+	"go.opentelemetry.io/collector/processor"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/metric"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/processor/telemetrycostprocessor/internal/metadata"
+)
+
+const (
+	signalAttribute = "signal"
+	bytesPerGB      = 1e9
+)
+
+type telemetryCostProcessor struct {
+	cfg *Config
+	now func() time.Time
+
+	telemetryBuilder *metadata.TelemetryBuilder
+	processorAttr    []attribute.KeyValue
+
+	tracesSizer  ptrace.ProtoMarshaler
+	metricsSizer pmetric.ProtoMarshaler
+	logsSizer    plog.ProtoMarshaler
+
+	// lock protects the usage of the budgets during the current window, by values of the attributes
+	lock        sync.Mutex
+	windowStart time.Time
+	usage       map[attribute.Distinct]*usage
+}
+
+type usage struct {
+	items int64
+	bytes int64
+}
+
+func newTelemetryCostProcessor(set processor.CreateSettings, cfg *Config) (*telemetryCostProcessor, error) {
+	telemetryBuilder, err := metadata.NewTelemetryBuilder(set.TelemetrySettings)
+	if err != nil {
+		return nil, err
+	}
+	return &telemetryCostProcessor{
+		cfg:              cfg,
+		now:              time.Now,
+		telemetryBuilder: telemetryBuilder,
+		processorAttr:    []attribute.KeyValue{attribute.String(metadata.Type.String(), set.ID.String())},
+		usage:            map[attribute.Distinct]*usage{},
+	}, nil
+}
+
+// processTraces accounts the spans of each resource. The size of a resource is computed by moving it to a batch of
+// its own, which doesn't copy the data, then moving it back.
+func (p *telemetryCostProcessor) processTraces(ctx context.Context, td ptrace.Traces) (ptrace.Traces, error) {
+	single := ptrace.NewTraces()
+	for i := 0; i < td.ResourceSpans().Len(); i++ {
+		rs := td.ResourceSpans().At(i)
+		rs.MoveTo(single.ResourceSpans().AppendEmpty())
+		items, bytes := single.SpanCount(), p.tracesSizer.TracesSize(single)
+		single.ResourceSpans().At(0).MoveTo(rs)
+		single.ResourceSpans().RemoveIf(func(ptrace.ResourceSpans) bool { return true })
+		p.account(ctx, "traces", rs.Resource(), int64(items), int64(bytes), p.cfg.CostPerGB.Traces)
+	}
+	return td, nil
+}
+
+func (p *telemetryCostProcessor) processMetrics(ctx context.Context, md pmetric.Metrics) (pmetric.Metrics, error) {
+	single := pmetric.NewMetrics()
+	for i := 0; i < md.ResourceMetrics().Len(); i++ {
+		rm := md.ResourceMetrics().At(i)
+		rm.MoveTo(single.ResourceMetrics().AppendEmpty())
+		items, bytes := single.DataPointCount(), p.metricsSizer.MetricsSize(single)
+		single.ResourceMetrics().At(0).MoveTo(rm)
+		single.ResourceMetrics().RemoveIf(func(pmetric.ResourceMetrics) bool { return true })
+		p.account(ctx, "metrics", rm.Resource(), int64(items), int64(bytes), p.cfg.CostPerGB.Metrics)
+	}
+	return md, nil
+}
+
+func (p *telemetryCostProcessor) processLogs(ctx context.Context, ld plog.Logs) (plog.Logs, error) {
+	single := plog.NewLogs()
+	for i := 0; i < ld.ResourceLogs().Len(); i++ {
+		rl := ld.ResourceLogs().At(i)
+		rl.MoveTo(single.ResourceLogs().AppendEmpty())
+		items, bytes := single.LogRecordCount(), p.logsSizer.LogsSize(single)
+		single.ResourceLogs().At(0).MoveTo(rl)
+		single.ResourceLogs().RemoveIf(func(plog.ResourceLogs) bool { return true })
+		p.account(ctx, "logs", rl.Resource(), int64(items), int64(bytes), p.cfg.CostPerGB.Logs)
+	}
+	return ld, nil
+}
+
+// account records the volume and the cost of the data of the resource, and tags the resource when the data exceeds
+// its budget.
+func (p *telemetryCostProcessor) account(ctx context.Context, signal string, resource pcommon.Resource, items, bytes int64, costPerGB float64) {
+	values := p.attributeValues(resource)
+	attrs := make([]attribute.KeyValue, 0, len(p.processorAttr)+1+len(values))
+	attrs = append(attrs, p.processorAttr...)
+	attrs = append(attrs, attribute.String(signalAttribute, signal))
+	attrs = append(attrs, values...)
+	opt := metric.WithAttributes(attrs...)
+
+	p.telemetryBuilder.ProcessorTelemetrycostItems.Add(ctx, items, opt)
+	p.telemetryBuilder.ProcessorTelemetrycostBytes.Add(ctx, bytes, opt)
+	if costPerGB > 0 {
+		p.telemetryBuilder.ProcessorTelemetrycostCost.Add(ctx, float64(bytes)/bytesPerGB*costPerGB, opt)
+	}
+
+	budget := p.budget(resource)
+	if budget == nil {
+		return
+	}
+	set := attribute.NewSet(values...)
+	if p.overBudget(budget, set.Equivalent(), items, bytes) {
+		resource.Attributes().PutBool(p.cfg.BudgetAttribute, true)
+		p.telemetryBuilder.ProcessorTelemetrycostBatchesOverBudget.Add(ctx, 1, opt)
+	}
+}
+
+// attributeValues returns the values of the configured attributes of the resource, omitting the missing ones.
+func (p *telemetryCostProcessor) attributeValues(resource pcommon.Resource) []attribute.KeyValue {
+	values := make([]attribute.KeyValue, 0, len(p.cfg.Attributes))
+	for _, key := range p.cfg.Attributes {
+		if value, ok := resource.Attributes().Get(key); ok {
+			values = append(values, attribute.String(key, value.AsString()))
+		}
+	}
+	return values
+}
+
+// budget returns the first budget matching the resource, if any.
+func (p *telemetryCostProcessor) budget(resource pcommon.Resource) *Budget {
+	for i := range p.cfg.Budgets {
+		budget := &p.cfg.Budgets[i]
+		matches := true
+		for key, expected := range budget.Match {
+			if value, ok := resource.Attributes().Get(key); !ok || value.AsString() != expected {
+				matches = false
+				break
+			}
+		}
+		if matches {
+			return budget
+		}
+	}
+	return nil
+}
+
+// overBudget adds the data to the usage of the values of the attributes during the current window, returning whether
+// the usage exceeds the budget. The windows are aligned on multiples of the window duration.
+func (p *telemetryCostProcessor) overBudget(budget *Budget, key attribute.Distinct, items, bytes int64) bool {
+	p.lock.Lock()
+	defer p.lock.Unlock()
+
+	if windowStart := p.now().Truncate(p.cfg.Window); !windowStart.Equal(p.windowStart) {
+		p.windowStart = windowStart
+		p.usage = map[attribute.Distinct]*usage{}
+	}
+	u, ok := p.usage[key]
+	if !ok {
+		u = &usage{}
+		p.usage[key] = u
+	}
+	u.items += items
+	u.bytes += bytes
+	return (budget.MaxItems > 0 && u.items > budget.MaxItems) || (budget.MaxBytes > 0 && u.bytes > budget.MaxBytes)
+}
@@ -0,0 +1,214 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package telemetrycostprocessor
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/collector/consumer/consumertest"
+	"go.opentelemetry.io/collector/pdata/plog"
+	"go.opentelemetry.io/collector/pdata/pmetric"
+	"go.opentelemetry.io/collector/pdata/ptrace"
+	"go.opentelemetry.io/collector/processor/processortest"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/sdk/metric/metricdata"
+)
+
+func TestProcessTraces(t *testing.T) {
+	tel := setupTestTelemetry()
+	cfg := createDefaultConfig().(*Config)
+	cfg.Attributes = []string{"tenant.id"}
+	cfg.CostPerGB.Traces = 2
+	next := new(consumertest.TracesSink)
+	p, err := NewFactory().CreateTracesProcessor(context.Background(), tel.NewCreateSettings(), cfg, next)
+	require.NoError(t, err)
+
+	td := ptrace.NewTraces()
+	acme := td.ResourceSpans().AppendEmpty()
+	acme.Resource().Attributes().PutStr("tenant.id", "acme")
+	spans := acme.ScopeSpans().AppendEmpty().Spans()
+	spans.AppendEmpty().SetName("GET /")
+	spans.AppendEmpty().SetName("SELECT")
+	// the resources without the attribute are accounted without its value
+	td.ResourceSpans().AppendEmpty().ScopeSpans().AppendEmpty().Spans().AppendEmpty().SetName("POST /")
+	acmeSize, otherSize := int64(resourceSpansSize(td, 0)), int64(resourceSpansSize(td, 1))
+	expected := ptrace.NewTraces()
+	td.CopyTo(expected)
+
+	require.NoError(t, p.ConsumeTraces(context.Background(), td))
+	require.Len(t, next.AllTraces(), 1)
+	// the resources are moved back in place after their size is computed
+	assert.Equal(t, expected, next.AllTraces()[0])
+
+	acmeAttrs := attribute.NewSet(
+		attribute.String("telemetrycost", "telemetrycost"),
+		attribute.String("signal", "traces"),
+		attribute.String("tenant.id", "acme"))
+	otherAttrs := attribute.NewSet(
+		attribute.String("telemetrycost", "telemetrycost"),
+		attribute.String("signal", "traces"))
+	tel.assertMetrics(t, []metricdata.Metrics{
+		{
+			Name:        "processor_telemetrycost_items",
+			Description: "Number of spans, data points and log records ingested, by signal and by the values of the configured attributes",
+			Unit:        "{items}",
+			Data: metricdata.Sum[int64]{
+				Temporality: metricdata.CumulativeTemporality,
+				IsMonotonic: true,
+				DataPoints: []metricdata.DataPoint[int64]{
+					{Value: 2, Attributes: acmeAttrs},
+					{Value: 1, Attributes: otherAttrs},
+				},
+			},
+		},
+		{
+			Name:        "processor_telemetrycost_bytes",
+			Description: "Estimated size of the data ingested in the OTLP protobuf encoding, by signal and by the values of the configured attributes",
+			Unit:        "By",
+			Data: metricdata.Sum[int64]{
+				Temporality: metricdata.CumulativeTemporality,
+				IsMonotonic: true,
+				DataPoints: []metricdata.DataPoint[int64]{
+					{Value: acmeSize, Attributes: acmeAttrs},
+					{Value: otherSize, Attributes: otherAttrs},
+				},
+			},
+		},
+		{
+			Name:        "processor_telemetrycost_cost",
+			Description: "Estimated cost of the data ingested, in the currency of the configured cost_per_gb, by signal and by the values of the configured attributes",
+			Unit:        "1",
+			Data: metricdata.Sum[float64]{
+				Temporality: metricdata.CumulativeTemporality,
+				IsMonotonic: true,
+				DataPoints: []metricdata.DataPoint[float64]{
+					{Value: float64(acmeSize) / 1e9 * 2, Attributes: acmeAttrs},
+					{Value: float64(otherSize) / 1e9 * 2, Attributes: otherAttrs},
+				},
+			},
+		},
+	})
+}
+
+func TestProcessMetrics(t *testing.T) {
+	tel := setupTestTelemetry()
+	cfg := createDefaultConfig().(*Config)
+	cfg.Budgets = []Budget{{MaxItems: 2}}
+	next := new(consumertest.MetricsSink)
+	p, err := NewFactory().CreateMetricsProcessor(context.Background(), tel.NewCreateSettings(), cfg, next)
+	require.NoError(t, err)
+
+	newMetrics := func() pmetric.Metrics {
+		md := pmetric.NewMetrics()
+		rm := md.ResourceMetrics().AppendEmpty()
+		rm.Resource().Attributes().PutStr("service.name", "checkout")
+		dps := rm.ScopeMetrics().AppendEmpty().Metrics().AppendEmpty().SetEmptyGauge().DataPoints()
+		dps.AppendEmpty().SetIntValue(1)
+		dps.AppendEmpty().SetIntValue(2)
+		return md
+	}
+	require.NoError(t, p.ConsumeMetrics(context.Background(), newMetrics()))
+	require.NoError(t, p.ConsumeMetrics(context.Background(), newMetrics()))
+
+	require.Len(t, next.AllMetrics(), 2)
+	assert.Equal(t, map[string]any{"service.name": "checkout"},
+		next.AllMetrics()[0].ResourceMetrics().At(0).Resource().Attributes().AsRaw())
+	assert.Equal(t, map[string]any{"service.name": "checkout", "telemetry.budget.exceeded": true},
+		next.AllMetrics()[1].ResourceMetrics().At(0).Resource().Attributes().AsRaw())
+
+	attrs := attribute.NewSet(
+		attribute.String("telemetrycost", "telemetrycost"),
+		attribute.String("signal", "metrics"),
+		attribute.String("service.name", "checkout"))
+	size := int64(resourceMetricsSize(newMetrics(), 0))
+	tel.assertMetrics(t, []metricdata.Metrics{
+		{
+			Name:        "processor_telemetrycost_items",
+			Description: "Number of spans, data points and log records ingested, by signal and by the values of the configured attributes",
+			Unit:        "{items}",
+			Data: metricdata.Sum[int64]{
+				Temporality: metricdata.CumulativeTemporality,
+				IsMonotonic: true,
+				DataPoints:  []metricdata.DataPoint[int64]{{Value: 4, Attributes: attrs}},
+			},
+		},
+		{
+			Name:        "processor_telemetrycost_bytes",
+			Description: "Estimated size of the data ingested in the OTLP protobuf encoding, by signal and by the values of the configured attributes",
+			Unit:        "By",
+			Data: metricdata.Sum[int64]{
+				Temporality: metricdata.CumulativeTemporality,
+				IsMonotonic: true,
+				DataPoints:  []metricdata.DataPoint[int64]{{Value: 2 * size, Attributes: attrs}},
+			},
+		},
+		{
+			Name:        "processor_telemetrycost_batches.over_budget",
+			Description: "Number of batches ingested over their budget, by signal and by the values of the configured attributes",
+			Unit:        "1",
+			Data: metricdata.Sum[int64]{
+				Temporality: metricdata.CumulativeTemporality,
+				IsMonotonic: true,
+				DataPoints:  []metricdata.DataPoint[int64]{{Value: 1, Attributes: attrs}},
+			},
+		},
+	})
+}
+
+func TestProcessLogsBudgets(t *testing.T) {
+	cfg := createDefaultConfig().(*Config)
+	cfg.Attributes = []string{"tenant.id"}
+	cfg.Budgets = []Budget{
+		{Match: map[string]string{"tenant.id": "acme"}, MaxItems: 3},
+		{MaxItems: 1},
+	}
+	p, err := newTelemetryCostProcessor(processortest.NewNopCreateSettings(), cfg)
+	require.NoError(t, err)
+	now := time.Date(2024, 6, 1, 10, 0, 0, 0, time.UTC)
+	p.now = func() time.Time { return now }
+
+	exceeded := func(tenants ...string) []bool {
+		ld := plog.NewLogs()
+		for _, tenant := range tenants {
+			rl := ld.ResourceLogs().AppendEmpty()
+			rl.Resource().Attributes().PutStr("tenant.id", tenant)
+			rl.ScopeLogs().AppendEmpty().LogRecords().AppendEmpty().Body().SetStr("message")
+		}
+		ld, err = p.processLogs(context.Background(), ld)
+		require.NoError(t, err)
+		var result []bool
+		for i := 0; i < ld.ResourceLogs().Len(); i++ {
+			_, ok := ld.ResourceLogs().At(i).Resource().Attributes().Get("telemetry.budget.exceeded")
+			result = append(result, ok)
+		}
+		return result
+	}
+
+	// the usage is counted by tenant, each of them having the first budget matching it
+	assert.Equal(t, []bool{false, false, false}, exceeded("acme", "acme", "other"))
+	assert.Equal(t, []bool{false, true, true}, exceeded("acme", "acme", "other"))
+	assert.Equal(t, []bool{false}, exceeded("another"))
+
+	// the usage is reset at the start of the next window
+	now = now.Add(59 * time.Minute)
+	assert.Equal(t, []bool{true}, exceeded("other"))
+	now = now.Add(time.Minute)
+	assert.Equal(t, []bool{false, true}, exceeded("other", "other"))
+}
+
+func resourceSpansSize(td ptrace.Traces, i int) int {
+	single := ptrace.NewTraces()
+	td.ResourceSpans().At(i).CopyTo(single.ResourceSpans().AppendEmpty())
+	return (&ptrace.ProtoMarshaler{}).TracesSize(single)
+}
+
+func resourceMetricsSize(md pmetric.Metrics, i int) int {
+	single := pmetric.NewMetrics()
+	md.ResourceMetrics().At(i).CopyTo(single.ResourceMetrics().AppendEmpty())
+	return (&pmetric.ProtoMarshaler{}).MetricsSize(single)
+}
@@ -0,0 +1,37 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package timestampnormalizationprocessor // import "github.com/open-telemetry/opentelemetry-collector-contrib/processor/timestampnormalizationprocessor"
+
+import (
+	"errors"
+	"time"
+
+	"go.opentelemetry.io/collector/component"
+)
+
+// Config defines configuration for the timestamp normalization processor.
+type Config struct {
+	// MaxPast is how far in the past of the collector clock a timestamp can be before being corrected.
+	MaxPast time.Duration `mapstructure:"max_past"`
+
+	// MaxFuture is how far in the future of the collector clock a timestamp can be before being corrected.
+	MaxFuture time.Duration `mapstructure:"max_future"`
+
+	// OriginalTimestampAttribute is the attribute of the spans and log records where the original timestamp is
+	// recorded when corrected, in the RFC 3339 format. The original timestamp isn't recorded when empty.
+	OriginalTimestampAttribute string `mapstructure:"original_timestamp_attribute"`
+}
+
+var _ component.Config = (*Config)(nil)
+
+// Validate checks if the processor configuration is valid.
+func (cfg *Config) Validate() error {
+	if cfg.MaxPast <= 0 {
+		return errors.New("max_past must be positive")
+	}
+	if cfg.MaxFuture <= 0 {
+		return errors.New("max_future must be positive")
+	}
+	return nil
+}
@@ -0,0 +1,9 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+//go:generate mdatagen metadata.yaml
+
+// Package timestampnormalizationprocessor implements a processor that
+// corrects the timestamps too far from the clock of the collector, as sent
+// by devices with broken clocks.
+package timestampnormalizationprocessor // import "github.com/open-telemetry/opentelemetry-collector-contrib/processor/timestampnormalizationprocessor"
@@ -0,0 +1,193 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package timestampnormalizationprocessor // import "github.com/open-telemetry/opentelemetry-collector-contrib/processor/timestampnormalizationprocessor"
+
+import (
+	"context"
+	"time"
+
+	"go.opentelemetry.io/collector/pdata/pcommon"
+	"go.opentelemetry.io/collector/pdata/plog"
+	"go.opentelemetry.io/collector/pdata/pmetric"
+	"go.opentelemetry.io/collector/pdata/ptrace"
+	"go.opentelemetry.io/collector/processor"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/metric"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/processor/timestampnormalizationprocessor/internal/metadata"
+)
+
+type timestampProcessor struct {
+	maxPast      time.Duration
+	maxFuture    time.Duration
+	originalAttr string
+	now          func() time.Time
+
+	telemetryBuilder *metadata.TelemetryBuilder
+	processorAttr    []attribute.KeyValue
+}
+
+func newTimestampProcessor(set processor.CreateSettings, cfg *Config) (*timestampProcessor, error) {
+	telemetryBuilder, err := metadata.NewTelemetryBuilder(set.TelemetrySettings)
+	if err != nil {
+		return nil, err
+	}
+	return &timestampProcessor{
+		maxPast:          cfg.MaxPast,
+		maxFuture:        cfg.MaxFuture,
+		originalAttr:     cfg.OriginalTimestampAttribute,
+		now:              time.Now,
+		telemetryBuilder: telemetryBuilder,
+		processorAttr:    []attribute.KeyValue{attribute.String(metadata.Type.String(), set.ID.String())},
+	}, nil
+}
+
+// skewed reports whether the timestamp is too far from the collector clock. Unset timestamps aren't skewed.
+func (p *timestampProcessor) skewed(ts pcommon.Timestamp, now time.Time) bool {
+	if ts == 0 {
+		return false
+	}
+	t := ts.AsTime()
+	return t.Before(now.Add(-p.maxPast)) || t.After(now.Add(p.maxFuture))
+}
+
+// recordOriginal records the original timestamp in the attributes, when enabled.
+func (p *timestampProcessor) recordOriginal(attrs pcommon.Map, ts pcommon.Timestamp) {
+	if p.originalAttr != "" {
+		attrs.PutStr(p.originalAttr, ts.AsTime().Format(time.RFC3339Nano))
+	}
+}
+
+// shift moves the timestamp by the offset correcting the reference timestamp to the corrected one, keeping the
+// durations between them. Unset timestamps stay unset.
+func shift(ts pcommon.Timestamp, reference pcommon.Timestamp, corrected pcommon.Timestamp) pcommon.Timestamp {
+	if ts == 0 {
+		return 0
+	}
+	// the unsigned arithmetic wraps around, which gives the exact result whatever the sign of the offset
+	return ts - reference + corrected
+}
+
+func (p *timestampProcessor) processTraces(ctx context.Context, td ptrace.Traces) (ptrace.Traces, error) {
+	now := p.now()
+	corrected := pcommon.NewTimestampFromTime(now)
+	var count int64
+	for i := 0; i < td.ResourceSpans().Len(); i++ {
+		ilss := td.ResourceSpans().At(i).ScopeSpans()
+		for j := 0; j < ilss.Len(); j++ {
+			spans := ilss.At(j).Spans()
+			for k := 0; k < spans.Len(); k++ {
+				span := spans.At(k)
+				start := span.StartTimestamp()
+				if !p.skewed(start, now) {
+					continue
+				}
+				// the whole span is moved, keeping its duration and the times of its events
+				span.SetStartTimestamp(corrected)
+				span.SetEndTimestamp(shift(span.EndTimestamp(), start, corrected))
+				for e := 0; e < span.Events().Len(); e++ {
+					event := span.Events().At(e)
+					event.SetTimestamp(shift(event.Timestamp(), start, corrected))
+				}
+				p.recordOriginal(span.Attributes(), start)
+				count++
+			}
+		}
+	}
+	if count > 0 {
+		p.telemetryBuilder.ProcessorTimestampnormalizationSpansCorrected.Add(ctx, count, metric.WithAttributes(p.processorAttr...))
+	}
+	return td, nil
+}
+
+func (p *timestampProcessor) processMetrics(ctx context.Context, md pmetric.Metrics) (pmetric.Metrics, error) {
+	now := p.now()
+	corrected := pcommon.NewTimestampFromTime(now)
+	var count int64
+	// correct moves the data point to the collector time, keeping the duration of its aggregation interval
+	correct := func(dp timestampedDataPoint) {
+		ts := dp.Timestamp()
+		if !p.skewed(ts, now) {
+			return
+		}
+		dp.SetTimestamp(corrected)
+		dp.SetStartTimestamp(shift(dp.StartTimestamp(), ts, corrected))
+		count++
+	}
+	for i := 0; i < md.ResourceMetrics().Len(); i++ {
+		sms := md.ResourceMetrics().At(i).ScopeMetrics()
+		for j := 0; j < sms.Len(); j++ {
+			metrics := sms.At(j).Metrics()
+			for k := 0; k < metrics.Len(); k++ {
+				m := metrics.At(k)
+				//exhaustive:enforce
+				switch m.Type() {
+				case pmetric.MetricTypeGauge:
+					for l := 0; l < m.Gauge().DataPoints().Len(); l++ {
+						correct(m.Gauge().DataPoints().At(l))
+					}
+				case pmetric.MetricTypeSum:
+					for l := 0; l < m.Sum().DataPoints().Len(); l++ {
+						correct(m.Sum().DataPoints().At(l))
+					}
+				case pmetric.MetricTypeHistogram:
+					for l := 0; l < m.Histogram().DataPoints().Len(); l++ {
+						correct(m.Histogram().DataPoints().At(l))
+					}
+				case pmetric.MetricTypeExponentialHistogram:
+					for l := 0; l < m.ExponentialHistogram().DataPoints().Len(); l++ {
+						correct(m.ExponentialHistogram().DataPoints().At(l))
+					}
+				case pmetric.MetricTypeSummary:
+					for l := 0; l < m.Summary().DataPoints().Len(); l++ {
+						correct(m.Summary().DataPoints().At(l))
+					}
+				case pmetric.MetricTypeEmpty:
+				}
+			}
+		}
+	}
+	if count > 0 {
+		p.telemetryBuilder.ProcessorTimestampnormalizationDatapointsCorrected.Add(ctx, count, metric.WithAttributes(p.processorAttr...))
+	}
+	return md, nil
+}
+
+// timestampedDataPoint is implemented by the data points of all the metric types.
+type timestampedDataPoint interface {
+	Timestamp() pcommon.Timestamp
+	SetTimestamp(pcommon.Timestamp)
+	StartTimestamp() pcommon.Timestamp
+	SetStartTimestamp(pcommon.Timestamp)
+}
+
+func (p *timestampProcessor) processLogs(ctx context.Context, ld plog.Logs) (plog.Logs, error) {
+	now := p.now()
+	var count int64
+	for i := 0; i < ld.ResourceLogs().Len(); i++ {
+		sls := ld.ResourceLogs().At(i).ScopeLogs()
+		for j := 0; j < sls.Len(); j++ {
+			records := sls.At(j).LogRecords()
+			for k := 0; k < records.Len(); k++ {
+				lr := records.At(k)
+				ts := lr.Timestamp()
+				if !p.skewed(ts, now) {
+					continue
+				}
+				// the time the record was observed by the first collector is closer to the time of the event
+				corrected := lr.ObservedTimestamp()
+				if corrected == 0 || p.skewed(corrected, now) {
+					corrected = pcommon.NewTimestampFromTime(now)
+				}
+				lr.SetTimestamp(corrected)
+				p.recordOriginal(lr.Attributes(), ts)
+				count++
+			}
+		}
+	}
+	if count > 0 {
+		p.telemetryBuilder.ProcessorTimestampnormalizationLogsCorrected.Add(ctx, count, metric.WithAttributes(p.processorAttr...))
+	}
+	return ld, nil
+}
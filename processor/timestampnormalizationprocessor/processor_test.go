@@ -0,0 +1,144 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package timestampnormalizationprocessor
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/collector/pdata/pcommon"
+	"go.opentelemetry.io/collector/pdata/plog"
+	"go.opentelemetry.io/collector/pdata/pmetric"
+	"go.opentelemetry.io/collector/pdata/ptrace"
+	"go.opentelemetry.io/collector/processor/processortest"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/sdk/metric/metricdata"
+)
+
+var now = time.Date(2024, 6, 10, 12, 0, 0, 0, time.UTC)
+
+func newTestProcessor(t *testing.T, tel *componentTestTelemetry) *timestampProcessor {
+	set := processortest.NewNopCreateSettings()
+	if tel != nil {
+		set = tel.NewCreateSettings()
+	}
+	cfg := createDefaultConfig().(*Config)
+	p, err := newTimestampProcessor(set, cfg)
+	require.NoError(t, err)
+	p.now = func() time.Time { return now }
+	return p
+}
+
+func ts(t time.Time) pcommon.Timestamp {
+	return pcommon.NewTimestampFromTime(t)
+}
+
+func TestProcessTraces(t *testing.T) {
+	tel := setupTestTelemetry()
+	p := newTestProcessor(t, &tel)
+
+	td := ptrace.NewTraces()
+	spans := td.ResourceSpans().AppendEmpty().ScopeSpans().AppendEmpty().Spans()
+	past := now.Add(-48 * time.Hour)
+	skewed := spans.AppendEmpty()
+	skewed.SetStartTimestamp(ts(past))
+	skewed.SetEndTimestamp(ts(past.Add(2 * time.Second)))
+	skewed.Events().AppendEmpty().SetTimestamp(ts(past.Add(time.Second)))
+	future := spans.AppendEmpty()
+	future.SetStartTimestamp(ts(now.Add(time.Hour)))
+	future.SetEndTimestamp(ts(now.Add(time.Hour + time.Second)))
+	valid := spans.AppendEmpty()
+	valid.SetStartTimestamp(ts(now.Add(-time.Hour)))
+	valid.SetEndTimestamp(ts(now.Add(-time.Hour + time.Second)))
+	spans.AppendEmpty()
+
+	td, err := p.processTraces(context.Background(), td)
+	require.NoError(t, err)
+
+	assert.Equal(t, ts(now), skewed.StartTimestamp())
+	assert.Equal(t, ts(now.Add(2*time.Second)), skewed.EndTimestamp())
+	assert.Equal(t, ts(now.Add(time.Second)), skewed.Events().At(0).Timestamp())
+	assert.Equal(t, map[string]any{"timestamp.original": "2024-06-08T12:00:00Z"}, skewed.Attributes().AsRaw())
+
+	assert.Equal(t, ts(now), future.StartTimestamp())
+	assert.Equal(t, ts(now.Add(time.Second)), future.EndTimestamp())
+
+	assert.Equal(t, ts(now.Add(-time.Hour)), valid.StartTimestamp())
+	assert.Equal(t, 0, valid.Attributes().Len())
+
+	unset := td.ResourceSpans().At(0).ScopeSpans().At(0).Spans().At(3)
+	assert.Equal(t, pcommon.Timestamp(0), unset.StartTimestamp())
+	assert.Equal(t, 0, unset.Attributes().Len())
+
+	tel.assertMetrics(t, []metricdata.Metrics{
+		{
+			Name:        "processor_timestampnormalization_spans.corrected",
+			Description: "Number of spans whose timestamps were corrected",
+			Unit:        "1",
+			Data: metricdata.Sum[int64]{
+				Temporality: metricdata.CumulativeTemporality,
+				IsMonotonic: true,
+				DataPoints: []metricdata.DataPoint[int64]{
+					{
+						Value:      2,
+						Attributes: attribute.NewSet(attribute.String("timestampnormalization", "timestampnormalization")),
+					},
+				},
+			},
+		},
+	})
+}
+
+func TestProcessMetrics(t *testing.T) {
+	p := newTestProcessor(t, nil)
+
+	md := pmetric.NewMetrics()
+	metrics := md.ResourceMetrics().AppendEmpty().ScopeMetrics().AppendEmpty().Metrics()
+	past := now.Add(-48 * time.Hour)
+	sum := metrics.AppendEmpty().SetEmptySum().DataPoints().AppendEmpty()
+	sum.SetStartTimestamp(ts(past.Add(-time.Minute)))
+	sum.SetTimestamp(ts(past))
+	sum.Attributes().PutStr("a", "1")
+	gauge := metrics.AppendEmpty().SetEmptyGauge().DataPoints().AppendEmpty()
+	gauge.SetTimestamp(ts(now.Add(time.Hour)))
+	summary := metrics.AppendEmpty().SetEmptySummary().DataPoints().AppendEmpty()
+	summary.SetTimestamp(ts(now.Add(-time.Minute)))
+
+	_, err := p.processMetrics(context.Background(), md)
+	require.NoError(t, err)
+
+	assert.Equal(t, ts(now), sum.Timestamp())
+	assert.Equal(t, ts(now.Add(-time.Minute)), sum.StartTimestamp())
+	assert.Equal(t, map[string]any{"a": "1"}, sum.Attributes().AsRaw())
+	assert.Equal(t, ts(now), gauge.Timestamp())
+	assert.Equal(t, pcommon.Timestamp(0), gauge.StartTimestamp())
+	assert.Equal(t, ts(now.Add(-time.Minute)), summary.Timestamp())
+}
+
+func TestProcessLogs(t *testing.T) {
+	p := newTestProcessor(t, nil)
+	p.originalAttr = ""
+
+	ld := plog.NewLogs()
+	records := ld.ResourceLogs().AppendEmpty().ScopeLogs().AppendEmpty().LogRecords()
+	observed := records.AppendEmpty()
+	observed.SetTimestamp(ts(time.Date(1970, 1, 1, 0, 0, 1, 0, time.UTC)))
+	observed.SetObservedTimestamp(ts(now.Add(-time.Second)))
+	unobserved := records.AppendEmpty()
+	unobserved.SetTimestamp(ts(now.AddDate(1, 0, 0)))
+	valid := records.AppendEmpty()
+	valid.SetTimestamp(ts(now.Add(-time.Second)))
+	valid.SetObservedTimestamp(ts(now))
+
+	_, err := p.processLogs(context.Background(), ld)
+	require.NoError(t, err)
+
+	assert.Equal(t, ts(now.Add(-time.Second)), observed.Timestamp())
+	assert.Equal(t, 0, observed.Attributes().Len())
+	assert.Equal(t, ts(now), unobserved.Timestamp())
+	assert.Equal(t, ts(now.Add(-time.Second)), valid.Timestamp())
+}
@@ -0,0 +1,118 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package tracestateprocessor // import "github.com/open-telemetry/opentelemetry-collector-contrib/processor/tracestateprocessor"
+
+import (
+	"errors"
+	"fmt"
+	"regexp"
+	"strings"
+
+	"go.opentelemetry.io/collector/component"
+)
+
+// The places the attributes are looked up in.
+const (
+	fromSpan     = "span"
+	fromResource = "resource"
+)
+
+// The actions applied to the members of the tracestate.
+const (
+	actionUpsert = "upsert"
+	actionInsert = "insert"
+)
+
+// keyPattern is the format of the keys of the list members of the W3C tracestate, either a simple key or a
+// multi-tenant key, e.g. "acme" or "tenant@acme".
+var keyPattern = regexp.MustCompile(`^([a-z][_0-9a-z\-*/]{0,255}|[a-z0-9][_0-9a-z\-*/]{0,240}@[a-z][_0-9a-z\-*/]{0,13})$`)
+
+// Config defines configuration for the tracestate processor.
+type Config struct {
+	// Members are the list members set in the tracestate of the spans.
+	Members []MemberConfig `mapstructure:"members"`
+}
+
+// MemberConfig defines a list member of the tracestate and the attributes its value is made of.
+type MemberConfig struct {
+	// Key of the list member, e.g. "acme".
+	Key string `mapstructure:"key"`
+
+	// Attribute is the attribute whose value is the value of the list member. It can't be used together with
+	// Fields.
+	Attribute string `mapstructure:"attribute"`
+
+	// Fields make the value of the list member out of semicolon-separated fields, e.g. "tenant:acme;region:eu",
+	// each taken from an attribute. The fields whose attribute is missing or empty are left out. It can't be used together
+	// with Attribute.
+	Fields []FieldConfig `mapstructure:"fields"`
+
+	// From is where the attributes are looked up, either "span" or "resource". By default, they're looked up in
+	// the span first, and then in the resource.
+	From string `mapstructure:"from"`
+
+	// Action is either "upsert", the default, replacing the list member when the span already has it, or "insert",
+	// keeping the list member of the span.
+	Action string `mapstructure:"action"`
+}
+
+// FieldConfig defines a field of the value of a list member.
+type FieldConfig struct {
+	// Name of the field, e.g. "tenant".
+	Name string `mapstructure:"name"`
+
+	// Attribute is the attribute whose value is the value of the field.
+	Attribute string `mapstructure:"attribute"`
+}
+
+var _ component.Config = (*Config)(nil)
+
+// Validate checks if the processor configuration is valid.
+func (cfg *Config) Validate() error {
+	if len(cfg.Members) == 0 {
+		return errors.New("at least one member must be specified")
+	}
+	keys := map[string]struct{}{}
+	for i, member := range cfg.Members {
+		if !keyPattern.MatchString(member.Key) {
+			return fmt.Errorf("members[%d]: invalid key %q, must be a key of the W3C tracestate, e.g. \"acme\"", i, member.Key)
+		}
+		if _, ok := keys[member.Key]; ok {
+			return fmt.Errorf("members[%d]: duplicate key %q", i, member.Key)
+		}
+		keys[member.Key] = struct{}{}
+
+		switch {
+		case member.Attribute == "" && len(member.Fields) == 0:
+			return fmt.Errorf("members[%d]: either attribute or fields must be specified", i)
+		case member.Attribute != "" && len(member.Fields) > 0:
+			return fmt.Errorf("members[%d]: attribute and fields can't be used together", i)
+		}
+		names := map[string]struct{}{}
+		for j, field := range member.Fields {
+			if field.Name == "" || strings.ContainsAny(field.Name, reservedFieldChars) || !isPrintable(field.Name) {
+				return fmt.Errorf("members[%d].fields[%d]: invalid name %q", i, j, field.Name)
+			}
+			if _, ok := names[field.Name]; ok {
+				return fmt.Errorf("members[%d].fields[%d]: duplicate name %q", i, j, field.Name)
+			}
+			names[field.Name] = struct{}{}
+			if field.Attribute == "" {
+				return fmt.Errorf("members[%d].fields[%d]: attribute must be specified", i, j)
+			}
+		}
+
+		switch member.From {
+		case "", fromSpan, fromResource:
+		default:
+			return fmt.Errorf("members[%d]: unsupported from %q, must be either %q or %q", i, member.From, fromSpan, fromResource)
+		}
+		switch member.Action {
+		case "", actionUpsert, actionInsert:
+		default:
+			return fmt.Errorf("members[%d]: unsupported action %q, must be either %q or %q", i, member.Action, actionUpsert, actionInsert)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,108 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package tracestateprocessor
+
+import (
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/collector/component"
+	"go.opentelemetry.io/collector/confmap/confmaptest"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/processor/tracestateprocessor/internal/metadata"
+)
+
+func TestLoadConfig(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		id           component.ID
+		expected     component.Config
+		errorMessage string
+	}{
+		{
+			id: component.NewID(metadata.Type),
+			expected: &Config{
+				Members: []MemberConfig{
+					{
+						Key: "acme",
+						Fields: []FieldConfig{
+							{Name: "tenant", Attribute: "tenant.id"},
+							{Name: "region", Attribute: "cloud.region"},
+						},
+						From: "resource",
+					},
+					{
+						Key:       "congo",
+						Attribute: "user.id",
+						Action:    "insert",
+					},
+				},
+			},
+		},
+		{
+			id:           component.NewIDWithName(metadata.Type, "no_members"),
+			errorMessage: "at least one member must be specified",
+		},
+		{
+			id:           component.NewIDWithName(metadata.Type, "invalid_key"),
+			errorMessage: `members[0]: invalid key "Acme", must be a key of the W3C tracestate, e.g. "acme"`,
+		},
+		{
+			id:           component.NewIDWithName(metadata.Type, "duplicate_key"),
+			errorMessage: `members[1]: duplicate key "acme"`,
+		},
+		{
+			id:           component.NewIDWithName(metadata.Type, "no_attribute"),
+			errorMessage: "members[0]: either attribute or fields must be specified",
+		},
+		{
+			id:           component.NewIDWithName(metadata.Type, "attribute_and_fields"),
+			errorMessage: "members[0]: attribute and fields can't be used together",
+		},
+		{
+			id:           component.NewIDWithName(metadata.Type, "invalid_field_name"),
+			errorMessage: `members[0].fields[0]: invalid name "tenant:id"`,
+		},
+		{
+			id:           component.NewIDWithName(metadata.Type, "duplicate_field_name"),
+			errorMessage: `members[0].fields[1]: duplicate name "tenant"`,
+		},
+		{
+			id:           component.NewIDWithName(metadata.Type, "no_field_attribute"),
+			errorMessage: "members[0].fields[0]: attribute must be specified",
+		},
+		{
+			id:           component.NewIDWithName(metadata.Type, "invalid_from"),
+			errorMessage: `members[0]: unsupported from "scope", must be either "span" or "resource"`,
+		},
+		{
+			id:           component.NewIDWithName(metadata.Type, "invalid_action"),
+			errorMessage: `members[0]: unsupported action "delete", must be either "upsert" or "insert"`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.id.String(), func(t *testing.T) {
+			cm, err := confmaptest.LoadConf(filepath.Join("testdata", "config.yaml"))
+			require.NoError(t, err)
+
+			factory := NewFactory()
+			cfg := factory.CreateDefaultConfig()
+
+			sub, err := cm.Sub(tt.id.String())
+			require.NoError(t, err)
+			require.NoError(t, sub.Unmarshal(cfg))
+
+			if tt.errorMessage != "" {
+				assert.EqualError(t, component.ValidateConfig(cfg), tt.errorMessage)
+				return
+			}
+			assert.NoError(t, component.ValidateConfig(cfg))
+			assert.Equal(t, tt.expected, cfg)
+		})
+	}
+}
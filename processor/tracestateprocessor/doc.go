@@ -0,0 +1,9 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+//go:generate mdatagen metadata.yaml
+
+// Package tracestateprocessor implements a processor that copies resource and
+// span attributes into members of the W3C tracestate of the spans, so that the
+// next tiers can route the spans on them.
+package tracestateprocessor // import "github.com/open-telemetry/opentelemetry-collector-contrib/processor/tracestateprocessor"
@@ -0,0 +1,186 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package tracestateprocessor // import "github.com/open-telemetry/opentelemetry-collector-contrib/processor/tracestateprocessor"
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"go.opentelemetry.io/collector/pdata/pcommon"
+	"go.opentelemetry.io/collector/pdata/ptrace"
+	"go.uber.org/zap"
+)
+
+const (
+	// maxMembers is the maximum number of list members of the W3C tracestate. The members beyond it, which are the
+	// oldest ones, are removed.
+	maxMembers = 32
+	// maxValueLength is the maximum length of the value of a list member.
+	maxValueLength = 256
+)
+
+// reservedValueChars can't be part of the value of a list member, and reservedFieldChars of the name or the value of
+// its fields. They're percent-encoded in the values, along with the percent sign itself and the space, which would be
+// trimmed at the end of a value.
+const (
+	reservedValueChars = ",="
+	reservedFieldChars = reservedValueChars + ";:"
+)
+
+type traceStateProcessor struct {
+	logger  *zap.Logger
+	members []MemberConfig
+}
+
+func newTraceStateProcessor(logger *zap.Logger, cfg *Config) *traceStateProcessor {
+	return &traceStateProcessor{logger: logger, members: cfg.Members}
+}
+
+func (p *traceStateProcessor) processTraces(_ context.Context, td ptrace.Traces) (ptrace.Traces, error) {
+	rss := td.ResourceSpans()
+	for i := 0; i < rss.Len(); i++ {
+		rs := rss.At(i)
+		resource := rs.Resource().Attributes()
+		ilss := rs.ScopeSpans()
+		for j := 0; j < ilss.Len(); j++ {
+			spans := ilss.At(j).Spans()
+			for k := 0; k < spans.Len(); k++ {
+				p.processSpan(spans.At(k), resource)
+			}
+		}
+	}
+	return td, nil
+}
+
+func (p *traceStateProcessor) processSpan(span ptrace.Span, resource pcommon.Map) {
+	raw := span.TraceState().AsRaw()
+	members := parseTraceState(raw)
+	changed := false
+	for _, member := range p.members {
+		if member.Action == actionInsert && members.index(member.Key) >= 0 {
+			continue
+		}
+		value, ok := memberValue(member, span.Attributes(), resource)
+		if !ok {
+			continue
+		}
+		if len(value) > maxValueLength {
+			p.logger.Debug("The value of the tracestate member is too long, the member isn't set",
+				zap.String("key", member.Key), zap.Int("length", len(value)))
+			continue
+		}
+		members = members.upsert(member.Key, value)
+		changed = true
+	}
+	if changed {
+		span.TraceState().FromRaw(members.String())
+	}
+}
+
+// memberValue returns the value of the list member from the attributes, or false if none of its attributes are set
+// to a non-empty value.
+func memberValue(member MemberConfig, span pcommon.Map, resource pcommon.Map) (string, bool) {
+	if member.Attribute != "" {
+		value, ok := lookup(member.Attribute, member.From, span, resource)
+		if !ok || value == "" {
+			return "", false
+		}
+		return encodeValue(value, reservedValueChars), true
+	}
+
+	var fields []string
+	for _, field := range member.Fields {
+		value, ok := lookup(field.Attribute, member.From, span, resource)
+		if !ok || value == "" {
+			continue
+		}
+		fields = append(fields, field.Name+":"+encodeValue(value, reservedFieldChars))
+	}
+	if len(fields) == 0 {
+		return "", false
+	}
+	return strings.Join(fields, ";"), true
+}
+
+// lookup returns the value of the attribute, looked up in the span first and then in the resource, unless from
+// restricts it to one of them.
+func lookup(attribute string, from string, span pcommon.Map, resource pcommon.Map) (string, bool) {
+	if from != fromResource {
+		if value, ok := span.Get(attribute); ok {
+			return value.AsString(), true
+		}
+	}
+	if from != fromSpan {
+		if value, ok := resource.Get(attribute); ok {
+			return value.AsString(), true
+		}
+	}
+	return "", false
+}
+
+// encodeValue percent-encodes the characters of the value which aren't allowed in the tracestate, the reserved ones,
+// the percent sign and the space.
+func encodeValue(value string, reserved string) string {
+	var sb strings.Builder
+	for i := 0; i < len(value); i++ {
+		c := value[i]
+		if c <= ' ' || c > '~' || c == '%' || strings.IndexByte(reserved, c) >= 0 {
+			fmt.Fprintf(&sb, "%%%02X", c)
+			continue
+		}
+		sb.WriteByte(c)
+	}
+	return sb.String()
+}
+
+// traceState holds the list members of a W3C tracestate, e.g. "congo=t61rcWkgMzE", the most recently updated first.
+type traceState []string
+
+func parseTraceState(raw string) traceState {
+	var members traceState
+	for _, member := range strings.Split(raw, ",") {
+		if member = strings.TrimSpace(member); member != "" {
+			members = append(members, member)
+		}
+	}
+	return members
+}
+
+// index returns the index of the list member with the key, or -1 if there's none.
+func (ts traceState) index(key string) int {
+	for i, member := range ts {
+		if k, _, _ := strings.Cut(member, "="); k == key {
+			return i
+		}
+	}
+	return -1
+}
+
+// upsert moves the list member with the key to the front with the given value, as required for the updated members,
+// removing the oldest members beyond the maximum.
+func (ts traceState) upsert(key string, value string) traceState {
+	if i := ts.index(key); i >= 0 {
+		ts = append(ts[:i], ts[i+1:]...)
+	}
+	ts = append(traceState{key + "=" + value}, ts...)
+	if len(ts) > maxMembers {
+		ts = ts[:maxMembers]
+	}
+	return ts
+}
+
+func (ts traceState) String() string {
+	return strings.Join(ts, ",")
+}
+
+// isPrintable returns whether the string only has printable ASCII characters, other than the space.
+func isPrintable(s string) bool {
+	for i := 0; i < len(s); i++ {
+		if s[i] <= ' ' || s[i] > '~' {
+			return false
+		}
+	}
+	return true
+}
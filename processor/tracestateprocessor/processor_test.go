@@ -0,0 +1,151 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package tracestateprocessor
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/collector/consumer/consumertest"
+	"go.opentelemetry.io/collector/pdata/ptrace"
+	"go.opentelemetry.io/collector/processor/processortest"
+)
+
+// newTraces returns traces with a span of the given tracestate, with the resource and span attributes.
+func newTraces(traceState string, resource map[string]any, span map[string]any) ptrace.Traces {
+	td := ptrace.NewTraces()
+	rs := td.ResourceSpans().AppendEmpty()
+	_ = rs.Resource().Attributes().FromRaw(resource)
+	s := rs.ScopeSpans().AppendEmpty().Spans().AppendEmpty()
+	s.TraceState().FromRaw(traceState)
+	_ = s.Attributes().FromRaw(span)
+	return td
+}
+
+func TestProcessTraces(t *testing.T) {
+	tenant := MemberConfig{
+		Key: "acme",
+		Fields: []FieldConfig{
+			{Name: "tenant", Attribute: "tenant.id"},
+			{Name: "region", Attribute: "cloud.region"},
+		},
+	}
+	user := MemberConfig{Key: "congo", Attribute: "user.id"}
+
+	tests := []struct {
+		name       string
+		members    []MemberConfig
+		traceState string
+		resource   map[string]any
+		span       map[string]any
+		expected   string
+	}{
+		{
+			name:     "attribute",
+			members:  []MemberConfig{user},
+			span:     map[string]any{"user.id": "u-42"},
+			expected: "congo=u-42",
+		},
+		{
+			name:     "fields from the resource and the span",
+			members:  []MemberConfig{tenant},
+			resource: map[string]any{"tenant.id": "t1", "cloud.region": "eu-west-1"},
+			span:     map[string]any{"tenant.id": "t2"},
+			expected: "acme=tenant:t2;region:eu-west-1",
+		},
+		{
+			name:     "missing field",
+			members:  []MemberConfig{tenant},
+			resource: map[string]any{"cloud.region": "eu-west-1"},
+			expected: "acme=region:eu-west-1",
+		},
+		{
+			name:       "missing attributes",
+			members:    []MemberConfig{user, tenant},
+			traceState: "rojo=00f067aa0ba902b7",
+			span:       map[string]any{"user.id": ""},
+			expected:   "rojo=00f067aa0ba902b7",
+		},
+		{
+			name:     "from resource",
+			members:  []MemberConfig{{Key: "congo", Attribute: "user.id", From: "resource"}},
+			resource: map[string]any{"user.id": "u-1"},
+			span:     map[string]any{"user.id": "u-2"},
+			expected: "congo=u-1",
+		},
+		{
+			name:     "from span",
+			members:  []MemberConfig{{Key: "congo", Attribute: "user.id", From: "span"}},
+			resource: map[string]any{"user.id": "u-1"},
+			expected: "",
+		},
+		{
+			name:     "non-string value",
+			members:  []MemberConfig{{Key: "congo", Attribute: "user.id"}},
+			span:     map[string]any{"user.id": 42},
+			expected: "congo=42",
+		},
+		{
+			name:       "upsert moves the member to the front",
+			members:    []MemberConfig{user},
+			traceState: "rojo=00f067aa0ba902b7, congo=u-1",
+			span:       map[string]any{"user.id": "u-2"},
+			expected:   "congo=u-2,rojo=00f067aa0ba902b7",
+		},
+		{
+			name:       "insert keeps the member",
+			members:    []MemberConfig{{Key: "congo", Attribute: "user.id", Action: "insert"}},
+			traceState: "rojo=00f067aa0ba902b7,congo=u-1",
+			span:       map[string]any{"user.id": "u-2"},
+			expected:   "rojo=00f067aa0ba902b7,congo=u-1",
+		},
+		{
+			name:     "reserved characters",
+			members:  []MemberConfig{user, tenant},
+			span:     map[string]any{"user.id": "a=b, 100%", "tenant.id": "x;y:z"},
+			expected: "acme=tenant:x%3By%3Az,congo=a%3Db%2C%20100%25",
+		},
+		{
+			name:     "too long",
+			members:  []MemberConfig{user},
+			span:     map[string]any{"user.id": strings.Repeat("u", 257)},
+			expected: "",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			next := new(consumertest.TracesSink)
+			p, err := NewFactory().CreateTracesProcessor(context.Background(), processortest.NewNopCreateSettings(), &Config{Members: tt.members}, next)
+			require.NoError(t, err)
+
+			require.NoError(t, p.ConsumeTraces(context.Background(), newTraces(tt.traceState, tt.resource, tt.span)))
+
+			require.Len(t, next.AllTraces(), 1)
+			span := next.AllTraces()[0].ResourceSpans().At(0).ScopeSpans().At(0).Spans().At(0)
+			assert.Equal(t, tt.expected, span.TraceState().AsRaw())
+		})
+	}
+}
+
+func TestProcessTracesMaxMembers(t *testing.T) {
+	members := make([]string, maxMembers)
+	for i := range members {
+		members[i] = "k" + strings.Repeat("x", i) + "=v"
+	}
+
+	next := new(consumertest.TracesSink)
+	p, err := NewFactory().CreateTracesProcessor(context.Background(), processortest.NewNopCreateSettings(), &Config{Members: []MemberConfig{{Key: "congo", Attribute: "user.id"}}}, next)
+	require.NoError(t, err)
+
+	require.NoError(t, p.ConsumeTraces(context.Background(), newTraces(strings.Join(members, ","), nil, map[string]any{"user.id": "u-1"})))
+
+	// the oldest member is removed
+	span := next.AllTraces()[0].ResourceSpans().At(0).ScopeSpans().At(0).Spans().At(0)
+	expected := append([]string{"congo=u-1"}, members[:maxMembers-1]...)
+	assert.Equal(t, strings.Join(expected, ","), span.TraceState().AsRaw())
+}
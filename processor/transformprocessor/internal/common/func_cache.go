@@ -0,0 +1,88 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package common // import "github.com/open-telemetry/opentelemetry-collector-contrib/processor/transformprocessor/internal/common"
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"go.opentelemetry.io/collector/pdata/pcommon"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl"
+)
+
+type cacheArguments[K any] struct {
+	Key   ottl.StringLikeGetter[K]
+	Value ottl.Getter[K]
+	TTL   ottl.DurationGetter[K]
+}
+
+type cacheEntry struct {
+	value   any
+	expires time.Time
+}
+
+func newCacheFactory[K any]() ottl.Factory[K] {
+	return ottl.NewFactory("Cache", &cacheArguments[K]{}, createCacheFunction[K])
+}
+
+func createCacheFunction[K any](_ ottl.FunctionContext, oArgs ottl.Arguments) (ottl.ExprFunc[K], error) {
+	args, ok := oArgs.(*cacheArguments[K])
+
+	if !ok {
+		return nil, fmt.Errorf("CacheFactory args must be of type *cacheArguments[K]")
+	}
+
+	return cache(args.Key, args.Value, args.TTL, time.Now), nil
+}
+
+func cache[K any](key ottl.StringLikeGetter[K], value ottl.Getter[K], ttl ottl.DurationGetter[K], now func() time.Time) ottl.ExprFunc[K] {
+	state := newKeyedState[cacheEntry](maxStateKeys)
+	return func(ctx context.Context, tCtx K) (any, error) {
+		k, err := key.Get(ctx, tCtx)
+		if err != nil {
+			return nil, err
+		}
+		if k == nil {
+			return nil, fmt.Errorf("the key of the cache is nil")
+		}
+		v, err := value.Get(ctx, tCtx)
+		if err != nil {
+			return nil, err
+		}
+		d, err := ttl.Get(ctx, tCtx)
+		if err != nil {
+			return nil, err
+		}
+		t := now()
+		entry := state.update(*k, func(current cacheEntry, found bool) cacheEntry {
+			if found && t.Before(current.expires) {
+				return current
+			}
+			return cacheEntry{value: detach(v), expires: t.Add(d)}
+		})
+		return entry.value, nil
+	}
+}
+
+// detach copies the pdata values, which are references to the processed telemetry.
+func detach(v any) any {
+	switch val := v.(type) {
+	case pcommon.Map:
+		m := pcommon.NewMap()
+		val.CopyTo(m)
+		return m
+	case pcommon.Slice:
+		s := pcommon.NewSlice()
+		val.CopyTo(s)
+		return s
+	case pcommon.Value:
+		c := pcommon.NewValueEmpty()
+		val.CopyTo(c)
+		return c
+	default:
+		return v
+	}
+}
@@ -0,0 +1,109 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package common
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/collector/pdata/pcommon"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl"
+)
+
+// testContext is the context the stateful functions are executed on in the tests, the key and value are read from it.
+type testContext struct {
+	key   any
+	value any
+}
+
+var (
+	testKey = &ottl.StandardStringLikeGetter[testContext]{
+		Getter: func(_ context.Context, tCtx testContext) (any, error) {
+			return tCtx.key, nil
+		},
+	}
+	testValue = &ottl.StandardGetSetter[testContext]{
+		Getter: func(_ context.Context, tCtx testContext) (any, error) {
+			return tCtx.value, nil
+		},
+	}
+)
+
+type testClock struct {
+	now time.Time
+}
+
+func (c *testClock) Now() time.Time {
+	return c.now
+}
+
+func Test_cache(t *testing.T) {
+	clock := &testClock{now: time.Date(2024, 6, 1, 12, 0, 0, 0, time.UTC)}
+	ttl := &ottl.StandardDurationGetter[testContext]{
+		Getter: func(context.Context, testContext) (any, error) {
+			return time.Minute, nil
+		},
+	}
+	exprFunc := cache[testContext](testKey, testValue, ttl, clock.Now)
+
+	get := func(key string, value any) any {
+		result, err := exprFunc(context.Background(), testContext{key: key, value: value})
+		require.NoError(t, err)
+		return result
+	}
+
+	assert.Equal(t, "first", get("a", "first"))
+	assert.Equal(t, "other", get("b", "other"))
+	clock.now = clock.now.Add(30 * time.Second)
+	assert.Equal(t, "first", get("a", "second"))
+	clock.now = clock.now.Add(30 * time.Second)
+	assert.Equal(t, "third", get("a", "third"))
+	assert.Equal(t, "third", get("a", "fourth"))
+}
+
+func Test_cache_copiesPdata(t *testing.T) {
+	ttl := &ottl.StandardDurationGetter[testContext]{
+		Getter: func(context.Context, testContext) (any, error) {
+			return time.Hour, nil
+		},
+	}
+	exprFunc := cache[testContext](testKey, testValue, ttl, time.Now)
+
+	m := pcommon.NewMap()
+	m.PutStr("user", "alice")
+	_, err := exprFunc(context.Background(), testContext{key: "a", value: m})
+	require.NoError(t, err)
+	m.PutStr("user", "bob")
+
+	result, err := exprFunc(context.Background(), testContext{key: "a", value: pcommon.NewMap()})
+	require.NoError(t, err)
+	assert.Equal(t, map[string]any{"user": "alice"}, result.(pcommon.Map).AsRaw())
+}
+
+func Test_cache_nilKey(t *testing.T) {
+	ttl := &ottl.StandardDurationGetter[testContext]{
+		Getter: func(context.Context, testContext) (any, error) {
+			return time.Hour, nil
+		},
+	}
+	exprFunc := cache[testContext](testKey, testValue, ttl, time.Now)
+	_, err := exprFunc(context.Background(), testContext{value: "value"})
+	assert.EqualError(t, err, "the key of the cache is nil")
+}
+
+func Test_keyedState_evicts(t *testing.T) {
+	state := newKeyedState[int](2)
+	increment := func(current int, _ bool) int { return current + 1 }
+	state.update("a", increment)
+	state.update("b", increment)
+	state.update("a", increment)
+	state.update("c", increment)
+	// b, the least recently used key, was evicted by c
+	assert.Equal(t, 3, state.update("a", increment))
+	assert.Equal(t, 1, state.update("b", increment))
+}
@@ -0,0 +1,71 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package common // import "github.com/open-telemetry/opentelemetry-collector-contrib/processor/transformprocessor/internal/common"
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"time"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl"
+)
+
+type rateLimitArguments[K any] struct {
+	Key   ottl.StringLikeGetter[K]
+	Limit ottl.IntGetter[K]
+}
+
+// bucket holds the calls still allowed for a key, refilled at the rate of the limit per second.
+type bucket struct {
+	tokens  float64
+	updated time.Time
+	allowed bool
+}
+
+func newRateLimitFactory[K any]() ottl.Factory[K] {
+	return ottl.NewFactory("RateLimit", &rateLimitArguments[K]{}, createRateLimitFunction[K])
+}
+
+func createRateLimitFunction[K any](_ ottl.FunctionContext, oArgs ottl.Arguments) (ottl.ExprFunc[K], error) {
+	args, ok := oArgs.(*rateLimitArguments[K])
+
+	if !ok {
+		return nil, fmt.Errorf("RateLimitFactory args must be of type *rateLimitArguments[K]")
+	}
+
+	return rateLimit(args.Key, args.Limit, time.Now), nil
+}
+
+func rateLimit[K any](key ottl.StringLikeGetter[K], limit ottl.IntGetter[K], now func() time.Time) ottl.ExprFunc[K] {
+	state := newKeyedState[bucket](maxStateKeys)
+	return func(ctx context.Context, tCtx K) (any, error) {
+		k, err := key.Get(ctx, tCtx)
+		if err != nil {
+			return nil, err
+		}
+		if k == nil {
+			return nil, fmt.Errorf("the key of the rate limit is nil")
+		}
+		n, err := limit.Get(ctx, tCtx)
+		if err != nil {
+			return nil, err
+		}
+		if n <= 0 {
+			return nil, fmt.Errorf("the rate limit must be positive, got %d", n)
+		}
+		t := now()
+		b := state.update(*k, func(current bucket, found bool) bucket {
+			tokens := float64(n)
+			if found {
+				tokens = math.Min(tokens, current.tokens+t.Sub(current.updated).Seconds()*float64(n))
+			}
+			if tokens < 1 {
+				return bucket{tokens: tokens, updated: t}
+			}
+			return bucket{tokens: tokens - 1, updated: t, allowed: true}
+		})
+		return b.allowed, nil
+	}
+}
@@ -0,0 +1,62 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package common
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl"
+)
+
+func Test_rateLimit(t *testing.T) {
+	clock := &testClock{now: time.Date(2024, 6, 1, 12, 0, 0, 0, time.UTC)}
+	limit := &ottl.StandardIntGetter[testContext]{
+		Getter: func(context.Context, testContext) (any, error) {
+			return int64(2), nil
+		},
+	}
+	exprFunc := rateLimit[testContext](testKey, limit, clock.Now)
+
+	allowed := func(key string) bool {
+		result, err := exprFunc(context.Background(), testContext{key: key})
+		require.NoError(t, err)
+		return result.(bool)
+	}
+
+	assert.True(t, allowed("a"))
+	assert.True(t, allowed("a"))
+	assert.False(t, allowed("a"))
+	// the keys are limited independently
+	assert.True(t, allowed("b"))
+
+	// half a second gives back one call
+	clock.now = clock.now.Add(500 * time.Millisecond)
+	assert.True(t, allowed("a"))
+	assert.False(t, allowed("a"))
+
+	// the calls don't accumulate beyond the limit
+	clock.now = clock.now.Add(time.Minute)
+	assert.True(t, allowed("a"))
+	assert.True(t, allowed("a"))
+	assert.False(t, allowed("a"))
+}
+
+func Test_rateLimit_errors(t *testing.T) {
+	limit := &ottl.StandardIntGetter[testContext]{
+		Getter: func(_ context.Context, tCtx testContext) (any, error) {
+			return tCtx.value, nil
+		},
+	}
+	exprFunc := rateLimit[testContext](testKey, limit, time.Now)
+
+	_, err := exprFunc(context.Background(), testContext{key: "a", value: int64(0)})
+	assert.EqualError(t, err, "the rate limit must be positive, got 0")
+	_, err = exprFunc(context.Background(), testContext{value: int64(1)})
+	assert.EqualError(t, err, "the key of the rate limit is nil")
+}
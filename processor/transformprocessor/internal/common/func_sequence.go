@@ -0,0 +1,49 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package common // import "github.com/open-telemetry/opentelemetry-collector-contrib/processor/transformprocessor/internal/common"
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl"
+)
+
+type sequenceArguments[K any] struct {
+	Key ottl.Optional[ottl.StringLikeGetter[K]]
+}
+
+func newSequenceFactory[K any]() ottl.Factory[K] {
+	return ottl.NewFactory("Sequence", &sequenceArguments[K]{}, createSequenceFunction[K])
+}
+
+func createSequenceFunction[K any](_ ottl.FunctionContext, oArgs ottl.Arguments) (ottl.ExprFunc[K], error) {
+	args, ok := oArgs.(*sequenceArguments[K])
+
+	if !ok {
+		return nil, fmt.Errorf("SequenceFactory args must be of type *sequenceArguments[K]")
+	}
+
+	return sequence(args.Key), nil
+}
+
+func sequence[K any](key ottl.Optional[ottl.StringLikeGetter[K]]) ottl.ExprFunc[K] {
+	state := newKeyedState[int64](maxStateKeys)
+	return func(ctx context.Context, tCtx K) (any, error) {
+		var k string
+		if !key.IsEmpty() {
+			v, err := key.Get().Get(ctx, tCtx)
+			if err != nil {
+				return nil, err
+			}
+			if v == nil {
+				return nil, fmt.Errorf("the key of the sequence is nil")
+			}
+			k = *v
+		}
+		return state.update(k, func(current int64, _ bool) int64 {
+			return current + 1
+		}), nil
+	}
+}
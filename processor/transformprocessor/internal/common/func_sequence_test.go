@@ -0,0 +1,42 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package common
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl"
+)
+
+func Test_sequence(t *testing.T) {
+	exprFunc := sequence[testContext](ottl.NewTestingOptional[ottl.StringLikeGetter[testContext]](testKey))
+	next := func(key any) any {
+		result, err := exprFunc(context.Background(), testContext{key: key})
+		require.NoError(t, err)
+		return result
+	}
+
+	assert.Equal(t, int64(1), next("a"))
+	assert.Equal(t, int64(2), next("a"))
+	assert.Equal(t, int64(1), next("b"))
+	// the keys are converted to strings
+	assert.Equal(t, int64(1), next(int64(42)))
+	assert.Equal(t, int64(2), next("42"))
+
+	_, err := exprFunc(context.Background(), testContext{})
+	assert.EqualError(t, err, "the key of the sequence is nil")
+}
+
+func Test_sequence_withoutKey(t *testing.T) {
+	exprFunc := sequence[testContext](ottl.Optional[ottl.StringLikeGetter[testContext]]{})
+	for i := int64(1); i <= 3; i++ {
+		result, err := exprFunc(context.Background(), testContext{key: "ignored"})
+		require.NoError(t, err)
+		assert.Equal(t, i, result)
+	}
+}
@@ -10,10 +10,23 @@ import (
 	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl/ottlfuncs"
 )
 
+// Functions returns the standard OTTL functions and the stateful functions of the processor, usable in any context.
+func Functions[K any]() map[string]ottl.Factory[K] {
+	functions := ottlfuncs.StandardFuncs[K]()
+	for _, f := range []ottl.Factory[K]{
+		newCacheFactory[K](),
+		newRateLimitFactory[K](),
+		newSequenceFactory[K](),
+	} {
+		functions[f.Name()] = f
+	}
+	return functions
+}
+
 func ResourceFunctions() map[string]ottl.Factory[ottlresource.TransformContext] {
-	return ottlfuncs.StandardFuncs[ottlresource.TransformContext]()
+	return Functions[ottlresource.TransformContext]()
 }
 
 func ScopeFunctions() map[string]ottl.Factory[ottlscope.TransformContext] {
-	return ottlfuncs.StandardFuncs[ottlscope.TransformContext]()
+	return Functions[ottlscope.TransformContext]()
 }
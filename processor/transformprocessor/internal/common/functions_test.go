@@ -0,0 +1,26 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package common
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl/contexts/ottlresource"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl/ottlfuncs"
+)
+
+func Test_Functions(t *testing.T) {
+	expected := ottlfuncs.StandardFuncs[ottlresource.TransformContext]()
+	expected["Cache"] = newCacheFactory[ottlresource.TransformContext]()
+	expected["RateLimit"] = newRateLimitFactory[ottlresource.TransformContext]()
+	expected["Sequence"] = newSequenceFactory[ottlresource.TransformContext]()
+	actual := ResourceFunctions()
+	require.Equal(t, len(expected), len(actual))
+	for k := range actual {
+		assert.Contains(t, expected, k)
+	}
+}
@@ -0,0 +1,38 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package common // import "github.com/open-telemetry/opentelemetry-collector-contrib/processor/transformprocessor/internal/common"
+
+import (
+	"sync"
+
+	"github.com/hashicorp/golang-lru/v2/simplelru"
+)
+
+// maxStateKeys is the maximum number of keys held by a call of a stateful function,
+// the least recently used keys are evicted once it is reached.
+const maxStateKeys = 10000
+
+// keyedState holds the state of a call of a stateful function. Each call in the statements
+// gets its own state, shared by all the telemetry the statement is executed on.
+type keyedState[V any] struct {
+	mu  sync.Mutex
+	lru *simplelru.LRU[string, V]
+}
+
+func newKeyedState[V any](size int) *keyedState[V] {
+	// the size is always positive, so the creation can't fail
+	lru, _ := simplelru.NewLRU[string, V](size, nil)
+	return &keyedState[V]{lru: lru}
+}
+
+// update replaces the state of the key by the one returned by fn, which is given the current
+// state of the key and whether there is one.
+func (s *keyedState[V]) update(key string, fn func(current V, found bool) V) V {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	current, found := s.lru.Get(key)
+	next := fn(current, found)
+	s.lru.Add(key, next)
+	return next
+}
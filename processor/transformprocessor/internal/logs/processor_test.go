@@ -261,6 +261,26 @@ func Test_ProcessLogs_LogContext(t *testing.T) {
 			statement: `set(attributes["test"], Split(attributes["not_exist"], "|"))`,
 			want:      func(_ plog.Logs) {},
 		},
+		{
+			statement: `set(attributes["test"], Sequence())`,
+			want: func(td plog.Logs) {
+				td.ResourceLogs().At(0).ScopeLogs().At(0).LogRecords().At(0).Attributes().PutInt("test", 1)
+				td.ResourceLogs().At(0).ScopeLogs().At(0).LogRecords().At(1).Attributes().PutInt("test", 2)
+			},
+		},
+		{
+			statement: `set(attributes["test"], Cache("first", body, Duration("1h")))`,
+			want: func(td plog.Logs) {
+				td.ResourceLogs().At(0).ScopeLogs().At(0).LogRecords().At(0).Attributes().PutStr("test", "operationA")
+				td.ResourceLogs().At(0).ScopeLogs().At(0).LogRecords().At(1).Attributes().PutStr("test", "operationA")
+			},
+		},
+		{
+			statement: `set(attributes["test"], "limited") where not RateLimit("all", 1)`,
+			want: func(td plog.Logs) {
+				td.ResourceLogs().At(0).ScopeLogs().At(0).LogRecords().At(1).Attributes().PutStr("test", "limited")
+			},
+		},
 		{
 			statement: `set(attributes["test"], Substring(attributes["total.string"], 3, 3))`,
 			want: func(td plog.Logs) {
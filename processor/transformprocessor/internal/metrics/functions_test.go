@@ -12,11 +12,11 @@ import (
 	"github.com/open-telemetry/opentelemetry-collector-contrib/internal/common/testutil"
 	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl/contexts/ottldatapoint"
 	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl/contexts/ottlmetric"
-	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl/ottlfuncs"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/processor/transformprocessor/internal/common"
 )
 
 func Test_DataPointFunctions(t *testing.T) {
-	expected := ottlfuncs.StandardFuncs[ottldatapoint.TransformContext]()
+	expected := common.Functions[ottldatapoint.TransformContext]()
 	expected["convert_sum_to_gauge"] = newConvertDatapointSumToGaugeFactory()
 	expected["convert_gauge_to_sum"] = newConvertDatapointGaugeToSumFactory()
 	expected["convert_summary_sum_val_to_sum"] = newConvertSummarySumValToSumFactory()
@@ -31,7 +31,7 @@ func Test_DataPointFunctions(t *testing.T) {
 }
 
 func Test_MetricFunctions(t *testing.T) {
-	expected := ottlfuncs.StandardFuncs[ottlmetric.TransformContext]()
+	expected := common.Functions[ottlmetric.TransformContext]()
 	expected["convert_sum_to_gauge"] = newConvertSumToGaugeFactory()
 	expected["convert_gauge_to_sum"] = newConvertGaugeToSumFactory()
 	expected["extract_sum_metric"] = newExtractSumMetricFactory()
@@ -0,0 +1,61 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package unitnormalizationprocessor // import "github.com/open-telemetry/opentelemetry-collector-contrib/processor/unitnormalizationprocessor"
+
+import (
+	"errors"
+	"fmt"
+
+	"go.opentelemetry.io/collector/component"
+)
+
+// Config defines configuration for the unit normalization processor.
+type Config struct {
+	// Conversions is the mapping table of the units to convert. Defaults to the conversions of the common time and
+	// size units to seconds and bytes.
+	Conversions []Conversion `mapstructure:"conversions"`
+
+	// UnitAttribute is the data point attribute holding the unit, for the agents reporting it as an attribute instead
+	// of the unit of the metric. The data points are converted according to its value, which is rewritten to the
+	// converted unit. Disabled when empty.
+	UnitAttribute string `mapstructure:"unit_attribute"`
+}
+
+// Conversion defines the conversion of a unit to another one.
+type Conversion struct {
+	// From is the unit to convert.
+	From string `mapstructure:"from"`
+
+	// To is the unit converted to.
+	To string `mapstructure:"to"`
+
+	// Factor is the factor the values are multiplied by.
+	Factor float64 `mapstructure:"factor"`
+}
+
+var _ component.Config = (*Config)(nil)
+
+// Validate checks if the processor configuration is valid.
+func (cfg *Config) Validate() error {
+	if len(cfg.Conversions) == 0 {
+		return errors.New("at least one conversion must be set")
+	}
+	from := make(map[string]bool, len(cfg.Conversions))
+	for i, conversion := range cfg.Conversions {
+		if conversion.From == "" || conversion.To == "" {
+			return fmt.Errorf("conversions[%d]: from and to are required", i)
+		}
+		if conversion.From == conversion.To {
+			return fmt.Errorf("conversions[%d]: from and to must be different units", i)
+		}
+		if conversion.Factor <= 0 {
+			return fmt.Errorf("conversions[%d]: factor must be positive", i)
+		}
+		if from[conversion.From] {
+			return fmt.Errorf("conversions[%d]: duplicate conversion of the unit %q", i, conversion.From)
+		}
+		from[conversion.From] = true
+	}
+	return nil
+}
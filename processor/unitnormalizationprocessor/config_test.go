@@ -0,0 +1,75 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package unitnormalizationprocessor
+
+import (
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/collector/component"
+	"go.opentelemetry.io/collector/confmap/confmaptest"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/processor/unitnormalizationprocessor/internal/metadata"
+)
+
+func TestLoadConfig(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		id           component.ID
+		expected     component.Config
+		errorMessage string
+	}{
+		{
+			id:       component.NewID(metadata.Type),
+			expected: createDefaultConfig(),
+		},
+		{
+			id: component.NewIDWithName(metadata.Type, "custom"),
+			expected: &Config{
+				Conversions:   []Conversion{{From: "milliseconds", To: "s", Factor: 0.001}},
+				UnitAttribute: "unit",
+			},
+		},
+		{
+			id:           component.NewIDWithName(metadata.Type, "no_conversions"),
+			errorMessage: "at least one conversion must be set",
+		},
+		{
+			id:           component.NewIDWithName(metadata.Type, "same_unit"),
+			errorMessage: "conversions[0]: from and to must be different units",
+		},
+		{
+			id:           component.NewIDWithName(metadata.Type, "zero_factor"),
+			errorMessage: "conversions[0]: factor must be positive",
+		},
+		{
+			id:           component.NewIDWithName(metadata.Type, "duplicate"),
+			errorMessage: `conversions[1]: duplicate conversion of the unit "ms"`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.id.String(), func(t *testing.T) {
+			cm, err := confmaptest.LoadConf(filepath.Join("testdata", "config.yaml"))
+			require.NoError(t, err)
+
+			factory := NewFactory()
+			cfg := factory.CreateDefaultConfig()
+
+			sub, err := cm.Sub(tt.id.String())
+			require.NoError(t, err)
+			require.NoError(t, sub.Unmarshal(cfg))
+
+			if tt.errorMessage != "" {
+				assert.EqualError(t, component.ValidateConfig(cfg), tt.errorMessage)
+				return
+			}
+			assert.NoError(t, component.ValidateConfig(cfg))
+			assert.Equal(t, tt.expected, cfg)
+		})
+	}
+}
@@ -0,0 +1,9 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+//go:generate mdatagen metadata.yaml
+
+// Package unitnormalizationprocessor implements a processor that converts
+// the metrics to a canonical set of units, scaling their values, so that the
+// series sent by different agents in different units can be merged.
+package unitnormalizationprocessor // import "github.com/open-telemetry/opentelemetry-collector-contrib/processor/unitnormalizationprocessor"
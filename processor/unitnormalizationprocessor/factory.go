@@ -0,0 +1,78 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package unitnormalizationprocessor // import "github.com/open-telemetry/opentelemetry-collector-contrib/processor/unitnormalizationprocessor"
+
+import (
+	"context"
+
+	"go.opentelemetry.io/collector/component"
+	"go.opentelemetry.io/collector/consumer"
+	"go.opentelemetry.io/collector/processor"
+	"go.opentelemetry.io/collector/processor/processorhelper"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/processor/unitnormalizationprocessor/internal/metadata"
+)
+
+var processorCapabilities = consumer.Capabilities{MutatesData: true}
+
+// NewFactory returns a new factory for the unit normalization processor.
+func NewFactory() processor.Factory {
+	return processor.NewFactory(
+		metadata.Type,
+		createDefaultConfig,
+		processor.WithMetrics(createMetricsProcessor, metadata.MetricsStability))
+}
+
+func createDefaultConfig() component.Config {
+	return &Config{
+		Conversions: defaultConversions(),
+	}
+}
+
+// defaultConversions converts the common time units to seconds and size units to bytes, as recommended by the
+// semantic conventions.
+func defaultConversions() []Conversion {
+	return []Conversion{
+		{From: "ns", To: "s", Factor: 1e-9},
+		{From: "us", To: "s", Factor: 1e-6},
+		{From: "ms", To: "s", Factor: 1e-3},
+		{From: "min", To: "s", Factor: 60},
+		{From: "h", To: "s", Factor: 3600},
+		{From: "d", To: "s", Factor: 86400},
+		{From: "kB", To: "By", Factor: 1e3},
+		{From: "MB", To: "By", Factor: 1e6},
+		{From: "GB", To: "By", Factor: 1e9},
+		{From: "TB", To: "By", Factor: 1e12},
+		{From: "KiB", To: "By", Factor: 1 << 10},
+		{From: "MiB", To: "By", Factor: 1 << 20},
+		{From: "GiB", To: "By", Factor: 1 << 30},
+		{From: "TiB", To: "By", Factor: 1 << 40},
+		{From: "kBy", To: "By", Factor: 1e3},
+		{From: "MBy", To: "By", Factor: 1e6},
+		{From: "GBy", To: "By", Factor: 1e9},
+		{From: "TBy", To: "By", Factor: 1e12},
+		{From: "KiBy", To: "By", Factor: 1 << 10},
+		{From: "MiBy", To: "By", Factor: 1 << 20},
+		{From: "GiBy", To: "By", Factor: 1 << 30},
+		{From: "TiBy", To: "By", Factor: 1 << 40},
+	}
+}
+
+func createMetricsProcessor(
+	ctx context.Context,
+	set processor.CreateSettings,
+	cfg component.Config,
+	nextConsumer consumer.Metrics) (processor.Metrics, error) {
+	proc, err := newUnitProcessor(set, cfg.(*Config))
+	if err != nil {
+		return nil, err
+	}
+	return processorhelper.NewMetricsProcessor(
+		ctx,
+		set,
+		cfg,
+		nextConsumer,
+		proc.processMetrics,
+		processorhelper.WithCapabilities(processorCapabilities))
+}
@@ -0,0 +1,188 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package unitnormalizationprocessor // import "github.com/open-telemetry/opentelemetry-collector-contrib/processor/unitnormalizationprocessor"
+
+import (
+	"context"
+	"math"
+
+	"go.opentelemetry.io/collector/pdata/pcommon"
+	"go.opentelemetry.io/collector/pdata/pmetric"
+	"go.opentelemetry.io/collector/processor"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/metric"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/processor/unitnormalizationprocessor/internal/metadata"
+)
+
+type unitProcessor struct {
+	// conversions maps the units to convert to their conversion
+	conversions   map[string]Conversion
+	unitAttribute string
+
+	telemetryBuilder *metadata.TelemetryBuilder
+	processorAttr    []attribute.KeyValue
+}
+
+func newUnitProcessor(set processor.CreateSettings, cfg *Config) (*unitProcessor, error) {
+	telemetryBuilder, err := metadata.NewTelemetryBuilder(set.TelemetrySettings)
+	if err != nil {
+		return nil, err
+	}
+	conversions := make(map[string]Conversion, len(cfg.Conversions))
+	for _, conversion := range cfg.Conversions {
+		conversions[conversion.From] = conversion
+	}
+	return &unitProcessor{
+		conversions:      conversions,
+		unitAttribute:    cfg.UnitAttribute,
+		telemetryBuilder: telemetryBuilder,
+		processorAttr:    []attribute.KeyValue{attribute.String(metadata.Type.String(), set.ID.String())},
+	}, nil
+}
+
+func (p *unitProcessor) processMetrics(ctx context.Context, md pmetric.Metrics) (pmetric.Metrics, error) {
+	var count int64
+	for i := 0; i < md.ResourceMetrics().Len(); i++ {
+		sms := md.ResourceMetrics().At(i).ScopeMetrics()
+		for j := 0; j < sms.Len(); j++ {
+			metrics := sms.At(j).Metrics()
+			for k := 0; k < metrics.Len(); k++ {
+				count += p.convertMetric(metrics.At(k))
+			}
+		}
+	}
+	if count > 0 {
+		p.telemetryBuilder.ProcessorUnitnormalizationDatapointsConverted.Add(ctx, count, metric.WithAttributes(p.processorAttr...))
+	}
+	return md, nil
+}
+
+// convertMetric converts the data points of the metric, according to the unit of the metric or else to their unit
+// attribute, and returns the number of converted data points.
+func (p *unitProcessor) convertMetric(m pmetric.Metric) int64 {
+	// the buckets of the exponential histograms can't be scaled by an arbitrary factor
+	if m.Type() == pmetric.MetricTypeExponentialHistogram || m.Type() == pmetric.MetricTypeEmpty {
+		return 0
+	}
+	metricConversion, metricConverted := p.conversions[m.Unit()]
+	if metricConverted {
+		m.SetUnit(metricConversion.To)
+	} else if p.unitAttribute == "" {
+		return 0
+	}
+
+	var count int64
+	factorOf := func(attrs pcommon.Map) (float64, bool) {
+		conversion, ok := metricConversion, metricConverted
+		if p.unitAttribute != "" {
+			if unit, found := attrs.Get(p.unitAttribute); found {
+				if !ok {
+					conversion, ok = p.conversions[unit.AsString()]
+				}
+				if ok && unit.AsString() == conversion.From {
+					unit.SetStr(conversion.To)
+				}
+			}
+		}
+		if ok {
+			count++
+		}
+		return conversion.Factor, ok
+	}
+
+	//exhaustive:enforce
+	switch m.Type() {
+	case pmetric.MetricTypeGauge:
+		convertNumberDataPoints(m.Gauge().DataPoints(), factorOf)
+	case pmetric.MetricTypeSum:
+		convertNumberDataPoints(m.Sum().DataPoints(), factorOf)
+	case pmetric.MetricTypeHistogram:
+		for i := 0; i < m.Histogram().DataPoints().Len(); i++ {
+			dp := m.Histogram().DataPoints().At(i)
+			if factor, ok := factorOf(dp.Attributes()); ok {
+				scaleHistogramDataPoint(dp, factor)
+			}
+		}
+	case pmetric.MetricTypeSummary:
+		for i := 0; i < m.Summary().DataPoints().Len(); i++ {
+			dp := m.Summary().DataPoints().At(i)
+			if factor, ok := factorOf(dp.Attributes()); ok {
+				scaleSummaryDataPoint(dp, factor)
+			}
+		}
+	case pmetric.MetricTypeExponentialHistogram, pmetric.MetricTypeEmpty:
+	}
+	return count
+}
+
+func convertNumberDataPoints(dps pmetric.NumberDataPointSlice, factorOf func(pcommon.Map) (float64, bool)) {
+	for i := 0; i < dps.Len(); i++ {
+		dp := dps.At(i)
+		factor, ok := factorOf(dp.Attributes())
+		if !ok {
+			continue
+		}
+		switch dp.ValueType() {
+		case pmetric.NumberDataPointValueTypeInt:
+			if isWhole(factor) {
+				dp.SetIntValue(dp.IntValue() * int64(factor))
+			} else {
+				// the converted value isn't necessarily an integer anymore
+				dp.SetDoubleValue(float64(dp.IntValue()) * factor)
+			}
+		case pmetric.NumberDataPointValueTypeDouble:
+			dp.SetDoubleValue(dp.DoubleValue() * factor)
+		case pmetric.NumberDataPointValueTypeEmpty:
+		}
+		scaleExemplars(dp.Exemplars(), factor)
+	}
+}
+
+func scaleHistogramDataPoint(dp pmetric.HistogramDataPoint, factor float64) {
+	if dp.HasSum() {
+		dp.SetSum(dp.Sum() * factor)
+	}
+	if dp.HasMin() {
+		dp.SetMin(dp.Min() * factor)
+	}
+	if dp.HasMax() {
+		dp.SetMax(dp.Max() * factor)
+	}
+	bounds := dp.ExplicitBounds().AsRaw()
+	for i := range bounds {
+		bounds[i] *= factor
+	}
+	dp.ExplicitBounds().FromRaw(bounds)
+	scaleExemplars(dp.Exemplars(), factor)
+}
+
+func scaleSummaryDataPoint(dp pmetric.SummaryDataPoint, factor float64) {
+	dp.SetSum(dp.Sum() * factor)
+	for i := 0; i < dp.QuantileValues().Len(); i++ {
+		quantile := dp.QuantileValues().At(i)
+		quantile.SetValue(quantile.Value() * factor)
+	}
+}
+
+func scaleExemplars(exemplars pmetric.ExemplarSlice, factor float64) {
+	for i := 0; i < exemplars.Len(); i++ {
+		exemplar := exemplars.At(i)
+		switch exemplar.ValueType() {
+		case pmetric.ExemplarValueTypeInt:
+			if isWhole(factor) {
+				exemplar.SetIntValue(exemplar.IntValue() * int64(factor))
+			} else {
+				exemplar.SetDoubleValue(float64(exemplar.IntValue()) * factor)
+			}
+		case pmetric.ExemplarValueTypeDouble:
+			exemplar.SetDoubleValue(exemplar.DoubleValue() * factor)
+		case pmetric.ExemplarValueTypeEmpty:
+		}
+	}
+}
+
+func isWhole(factor float64) bool {
+	return factor == math.Trunc(factor)
+}
@@ -0,0 +1,136 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package unitnormalizationprocessor
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/collector/consumer/consumertest"
+	"go.opentelemetry.io/collector/pdata/pmetric"
+	"go.opentelemetry.io/collector/processor/processortest"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/sdk/metric/metricdata"
+)
+
+func TestProcessMetrics(t *testing.T) {
+	tel := setupTestTelemetry()
+	cfg := createDefaultConfig().(*Config)
+	next := new(consumertest.MetricsSink)
+	p, err := NewFactory().CreateMetricsProcessor(context.Background(), tel.NewCreateSettings(), cfg, next)
+	require.NoError(t, err)
+
+	md := pmetric.NewMetrics()
+	metrics := md.ResourceMetrics().AppendEmpty().ScopeMetrics().AppendEmpty().Metrics()
+	duration := metrics.AppendEmpty()
+	duration.SetUnit("ms")
+	dp := duration.SetEmptyGauge().DataPoints().AppendEmpty()
+	dp.SetIntValue(1500)
+	dp.Exemplars().AppendEmpty().SetDoubleValue(250)
+	memory := metrics.AppendEmpty()
+	memory.SetUnit("MiB")
+	memory.SetEmptySum().DataPoints().AppendEmpty().SetIntValue(3)
+	latency := metrics.AppendEmpty()
+	latency.SetUnit("ms")
+	histogram := latency.SetEmptyHistogram().DataPoints().AppendEmpty()
+	histogram.SetSum(1000)
+	histogram.SetMin(100)
+	histogram.SetMax(500)
+	histogram.ExplicitBounds().FromRaw([]float64{250, 500})
+	histogram.BucketCounts().FromRaw([]uint64{1, 2, 0})
+	summary := metrics.AppendEmpty()
+	summary.SetUnit("min")
+	summaryDP := summary.SetEmptySummary().DataPoints().AppendEmpty()
+	summaryDP.SetSum(2)
+	summaryDP.QuantileValues().AppendEmpty().SetValue(1)
+	exponential := metrics.AppendEmpty()
+	exponential.SetUnit("ms")
+	exponential.SetEmptyExponentialHistogram().DataPoints().AppendEmpty().SetSum(1000)
+	canonical := metrics.AppendEmpty()
+	canonical.SetUnit("s")
+	canonical.SetEmptyGauge().DataPoints().AppendEmpty().SetIntValue(2)
+
+	require.NoError(t, p.ConsumeMetrics(context.Background(), md))
+
+	got := next.AllMetrics()[0].ResourceMetrics().At(0).ScopeMetrics().At(0).Metrics()
+
+	assert.Equal(t, "s", got.At(0).Unit())
+	gotDP := got.At(0).Gauge().DataPoints().At(0)
+	assert.Equal(t, pmetric.NumberDataPointValueTypeDouble, gotDP.ValueType())
+	assert.InDelta(t, 1.5, gotDP.DoubleValue(), 1e-9)
+	assert.InDelta(t, 0.25, gotDP.Exemplars().At(0).DoubleValue(), 1e-9)
+
+	assert.Equal(t, "By", got.At(1).Unit())
+	assert.Equal(t, int64(3<<20), got.At(1).Sum().DataPoints().At(0).IntValue())
+
+	assert.Equal(t, "s", got.At(2).Unit())
+	gotHistogram := got.At(2).Histogram().DataPoints().At(0)
+	assert.InDelta(t, 1, gotHistogram.Sum(), 1e-9)
+	assert.InDelta(t, 0.1, gotHistogram.Min(), 1e-9)
+	assert.InDelta(t, 0.5, gotHistogram.Max(), 1e-9)
+	assert.InDeltaSlice(t, []float64{0.25, 0.5}, gotHistogram.ExplicitBounds().AsRaw(), 1e-9)
+	assert.Equal(t, []uint64{1, 2, 0}, gotHistogram.BucketCounts().AsRaw())
+
+	assert.Equal(t, "s", got.At(3).Unit())
+	assert.Equal(t, float64(120), got.At(3).Summary().DataPoints().At(0).Sum())
+	assert.Equal(t, float64(60), got.At(3).Summary().DataPoints().At(0).QuantileValues().At(0).Value())
+
+	assert.Equal(t, "ms", got.At(4).Unit())
+	assert.Equal(t, float64(1000), got.At(4).ExponentialHistogram().DataPoints().At(0).Sum())
+
+	assert.Equal(t, "s", got.At(5).Unit())
+	assert.Equal(t, int64(2), got.At(5).Gauge().DataPoints().At(0).IntValue())
+
+	tel.assertMetrics(t, []metricdata.Metrics{
+		{
+			Name:        "processor_unitnormalization_datapoints.converted",
+			Description: "Number of metric data points whose values were converted to another unit",
+			Unit:        "1",
+			Data: metricdata.Sum[int64]{
+				Temporality: metricdata.CumulativeTemporality,
+				IsMonotonic: true,
+				DataPoints: []metricdata.DataPoint[int64]{
+					{
+						Value:      4,
+						Attributes: attribute.NewSet(attribute.String("unitnormalization", "unitnormalization")),
+					},
+				},
+			},
+		},
+	})
+}
+
+func TestProcessMetricsUnitAttribute(t *testing.T) {
+	cfg := createDefaultConfig().(*Config)
+	cfg.UnitAttribute = "unit"
+	next := new(consumertest.MetricsSink)
+	p, err := NewFactory().CreateMetricsProcessor(context.Background(), processortest.NewNopCreateSettings(), cfg, next)
+	require.NoError(t, err)
+
+	md := pmetric.NewMetrics()
+	dps := md.ResourceMetrics().AppendEmpty().ScopeMetrics().AppendEmpty().Metrics().AppendEmpty().SetEmptyGauge().DataPoints()
+	kib := dps.AppendEmpty()
+	kib.SetDoubleValue(2)
+	kib.Attributes().PutStr("unit", "KiB")
+	bytes := dps.AppendEmpty()
+	bytes.SetDoubleValue(2)
+	bytes.Attributes().PutStr("unit", "By")
+	unknown := dps.AppendEmpty()
+	unknown.SetDoubleValue(2)
+	unknown.Attributes().PutStr("unit", "pages")
+
+	require.NoError(t, p.ConsumeMetrics(context.Background(), md))
+
+	got := next.AllMetrics()[0].ResourceMetrics().At(0).ScopeMetrics().At(0).Metrics().At(0)
+	assert.Equal(t, "", got.Unit())
+	gotDPs := got.Gauge().DataPoints()
+	assert.Equal(t, float64(2048), gotDPs.At(0).DoubleValue())
+	assert.Equal(t, map[string]any{"unit": "By"}, gotDPs.At(0).Attributes().AsRaw())
+	assert.Equal(t, float64(2), gotDPs.At(1).DoubleValue())
+	assert.Equal(t, map[string]any{"unit": "By"}, gotDPs.At(1).Attributes().AsRaw())
+	assert.Equal(t, float64(2), gotDPs.At(2).DoubleValue())
+	assert.Equal(t, map[string]any{"unit": "pages"}, gotDPs.At(2).Attributes().AsRaw())
+}
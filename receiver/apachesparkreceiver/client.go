@@ -29,6 +29,7 @@ type client interface {
 	StageStats(appID string) ([]models.Stage, error)
 	ExecutorStats(appID string) ([]models.Executor, error)
 	JobStats(appID string) ([]models.Job, error)
+	StreamingStats(appID string) (*models.StreamingStatistics, error)
 }
 
 var _ client = (*apacheSparkClient)(nil)
@@ -164,6 +165,41 @@ func (c *apacheSparkClient) JobStats(appID string) ([]models.Job, error) {
 	return jobStats, nil
 }
 
+// StreamingStats returns the streaming statistics of the application, or nil if the
+// application doesn't use Spark Streaming.
+func (c *apacheSparkClient) StreamingStats(appID string) (*models.StreamingStatistics, error) {
+	req, err := c.buildReq(fmt.Sprintf("%s/%s/streaming/statistics", applicationsPath, appID))
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := c.client.Do(req)
+	if err != nil {
+		return nil, err
+	}
+
+	defer func() {
+		if err = resp.Body.Close(); err != nil {
+			c.logger.Warn("failed to close response body", zap.Error(err))
+		}
+	}()
+
+	// Spark only serves the streaming statistics of applications with a StreamingContext.
+	if resp.StatusCode == http.StatusNotFound {
+		return nil, nil
+	}
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("request GET %s failed - %q", req.URL.String(), resp.Status)
+	}
+
+	var streamingStats *models.StreamingStatistics
+	if err = json.NewDecoder(resp.Body).Decode(&streamingStats); err != nil {
+		return nil, err
+	}
+
+	return streamingStats, nil
+}
+
 func (c *apacheSparkClient) buildReq(path string) (*http.Request, error) {
 	url := c.cfg.Endpoint + path
 	req, err := http.NewRequest(http.MethodGet, url, nil)
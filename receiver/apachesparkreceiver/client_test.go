@@ -483,6 +483,69 @@ func TestJobStats(t *testing.T) {
 	}
 }
 
+func TestStreamingStats(t *testing.T) {
+	testCases := []struct {
+		desc     string
+		testFunc func(*testing.T)
+	}{
+		{
+			desc: "Returns nil for applications without streaming",
+			testFunc: func(t *testing.T) {
+				ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+					w.WriteHeader(http.StatusNotFound)
+				}))
+				defer ts.Close()
+
+				tc := createTestClient(t, ts.URL)
+
+				streamingStats, err := tc.StreamingStats("some_app_id")
+				require.NoError(t, err)
+				require.Nil(t, streamingStats)
+			},
+		},
+		{
+			desc: "Returns an error on request failure",
+			testFunc: func(t *testing.T) {
+				ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+					w.WriteHeader(http.StatusUnauthorized)
+				}))
+				defer ts.Close()
+
+				tc := createTestClient(t, ts.URL)
+
+				streamingStats, err := tc.StreamingStats("some_app_id")
+				require.Error(t, err)
+				require.Nil(t, streamingStats)
+			},
+		},
+		{
+			desc: "Success case",
+			testFunc: func(t *testing.T) {
+				data := loadAPIResponseData(t, streamingStatsResponseFile)
+				ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+					require.True(t, strings.HasSuffix(r.RequestURI, "/some_app_id/streaming/statistics"))
+					_, err := w.Write(data)
+					require.NoError(t, err)
+				}))
+				defer ts.Close()
+
+				tc := createTestClient(t, ts.URL)
+
+				streamingStats, err := tc.StreamingStats("some_app_id")
+				require.NoError(t, err)
+				require.NotNil(t, streamingStats)
+				require.EqualValues(t, 2, streamingStats.NumActiveBatches)
+				require.EqualValues(t, 37, *streamingStats.AvgSchedulingDelay)
+				require.EqualValues(t, 412, *streamingStats.AvgProcessingTime)
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.desc, tc.testFunc)
+	}
+}
+
 func createTestClient(t *testing.T, baseEndpoint string) client {
 	t.Helper()
 	cfg := createDefaultConfig().(*Config)
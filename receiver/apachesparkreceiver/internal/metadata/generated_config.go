@@ -91,6 +91,9 @@ type MetricsConfig struct {
 	SparkStageTaskActive                               MetricConfig `mapstructure:"spark.stage.task.active"`
 	SparkStageTaskResult                               MetricConfig `mapstructure:"spark.stage.task.result"`
 	SparkStageTaskResultSize                           MetricConfig `mapstructure:"spark.stage.task.result_size"`
+	SparkStreamingBatchActive                          MetricConfig `mapstructure:"spark.streaming.batch.active"`
+	SparkStreamingBatchProcessingTime                  MetricConfig `mapstructure:"spark.streaming.batch.processing_time"`
+	SparkStreamingBatchSchedulingDelay                 MetricConfig `mapstructure:"spark.streaming.batch.scheduling_delay"`
 }
 
 func DefaultMetricsConfig() MetricsConfig {
@@ -284,6 +287,15 @@ func DefaultMetricsConfig() MetricsConfig {
 		SparkStageTaskResultSize: MetricConfig{
 			Enabled: true,
 		},
+		SparkStreamingBatchActive: MetricConfig{
+			Enabled: true,
+		},
+		SparkStreamingBatchProcessingTime: MetricConfig{
+			Enabled: true,
+		},
+		SparkStreamingBatchSchedulingDelay: MetricConfig{
+			Enabled: true,
+		},
 	}
 }
 
@@ -88,6 +88,9 @@ func TestMetricsBuilderConfig(t *testing.T) {
 					SparkStageTaskActive:                               MetricConfig{Enabled: true},
 					SparkStageTaskResult:                               MetricConfig{Enabled: true},
 					SparkStageTaskResultSize:                           MetricConfig{Enabled: true},
+					SparkStreamingBatchActive:                          MetricConfig{Enabled: true},
+					SparkStreamingBatchProcessingTime:                  MetricConfig{Enabled: true},
+					SparkStreamingBatchSchedulingDelay:                 MetricConfig{Enabled: true},
 				},
 				ResourceAttributes: ResourceAttributesConfig{
 					SparkApplicationID:   ResourceAttributeConfig{Enabled: true},
@@ -166,6 +169,9 @@ func TestMetricsBuilderConfig(t *testing.T) {
 					SparkStageTaskActive:                               MetricConfig{Enabled: false},
 					SparkStageTaskResult:                               MetricConfig{Enabled: false},
 					SparkStageTaskResultSize:                           MetricConfig{Enabled: false},
+					SparkStreamingBatchActive:                          MetricConfig{Enabled: false},
+					SparkStreamingBatchProcessingTime:                  MetricConfig{Enabled: false},
+					SparkStreamingBatchSchedulingDelay:                 MetricConfig{Enabled: false},
 				},
 				ResourceAttributes: ResourceAttributesConfig{
 					SparkApplicationID:   ResourceAttributeConfig{Enabled: false},
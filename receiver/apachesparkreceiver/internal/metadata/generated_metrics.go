@@ -3528,6 +3528,155 @@ func newMetricSparkStageTaskResultSize(cfg MetricConfig) metricSparkStageTaskRes
 	return m
 }
 
+type metricSparkStreamingBatchActive struct {
+	data     pmetric.Metric // data buffer for generated metric.
+	config   MetricConfig   // metric config provided by user.
+	capacity int            // max observed number of data points added to the metric.
+}
+
+// init fills spark.streaming.batch.active metric with initial data.
+func (m *metricSparkStreamingBatchActive) init() {
+	m.data.SetName("spark.streaming.batch.active")
+	m.data.SetDescription("Number of batches of a streaming application that are waiting to be processed or being processed.")
+	m.data.SetUnit("{ batch }")
+	m.data.SetEmptySum()
+	m.data.Sum().SetIsMonotonic(false)
+	m.data.Sum().SetAggregationTemporality(pmetric.AggregationTemporalityCumulative)
+}
+
+func (m *metricSparkStreamingBatchActive) recordDataPoint(start pcommon.Timestamp, ts pcommon.Timestamp, val int64) {
+	if !m.config.Enabled {
+		return
+	}
+	dp := m.data.Sum().DataPoints().AppendEmpty()
+	dp.SetStartTimestamp(start)
+	dp.SetTimestamp(ts)
+	dp.SetIntValue(val)
+}
+
+// updateCapacity saves max length of data point slices that will be used for the slice capacity.
+func (m *metricSparkStreamingBatchActive) updateCapacity() {
+	if m.data.Sum().DataPoints().Len() > m.capacity {
+		m.capacity = m.data.Sum().DataPoints().Len()
+	}
+}
+
+// emit appends recorded metric data to a metrics slice and prepares it for recording another set of data points.
+func (m *metricSparkStreamingBatchActive) emit(metrics pmetric.MetricSlice) {
+	if m.config.Enabled && m.data.Sum().DataPoints().Len() > 0 {
+		m.updateCapacity()
+		m.data.MoveTo(metrics.AppendEmpty())
+		m.init()
+	}
+}
+
+func newMetricSparkStreamingBatchActive(cfg MetricConfig) metricSparkStreamingBatchActive {
+	m := metricSparkStreamingBatchActive{config: cfg}
+	if cfg.Enabled {
+		m.data = pmetric.NewMetric()
+		m.init()
+	}
+	return m
+}
+
+type metricSparkStreamingBatchProcessingTime struct {
+	data     pmetric.Metric // data buffer for generated metric.
+	config   MetricConfig   // metric config provided by user.
+	capacity int            // max observed number of data points added to the metric.
+}
+
+// init fills spark.streaming.batch.processing_time metric with initial data.
+func (m *metricSparkStreamingBatchProcessingTime) init() {
+	m.data.SetName("spark.streaming.batch.processing_time")
+	m.data.SetDescription("Average time taken to process the retained batches of a streaming application.")
+	m.data.SetUnit("ms")
+	m.data.SetEmptyGauge()
+}
+
+func (m *metricSparkStreamingBatchProcessingTime) recordDataPoint(start pcommon.Timestamp, ts pcommon.Timestamp, val int64) {
+	if !m.config.Enabled {
+		return
+	}
+	dp := m.data.Gauge().DataPoints().AppendEmpty()
+	dp.SetStartTimestamp(start)
+	dp.SetTimestamp(ts)
+	dp.SetIntValue(val)
+}
+
+// updateCapacity saves max length of data point slices that will be used for the slice capacity.
+func (m *metricSparkStreamingBatchProcessingTime) updateCapacity() {
+	if m.data.Gauge().DataPoints().Len() > m.capacity {
+		m.capacity = m.data.Gauge().DataPoints().Len()
+	}
+}
+
+// emit appends recorded metric data to a metrics slice and prepares it for recording another set of data points.
+func (m *metricSparkStreamingBatchProcessingTime) emit(metrics pmetric.MetricSlice) {
+	if m.config.Enabled && m.data.Gauge().DataPoints().Len() > 0 {
+		m.updateCapacity()
+		m.data.MoveTo(metrics.AppendEmpty())
+		m.init()
+	}
+}
+
+func newMetricSparkStreamingBatchProcessingTime(cfg MetricConfig) metricSparkStreamingBatchProcessingTime {
+	m := metricSparkStreamingBatchProcessingTime{config: cfg}
+	if cfg.Enabled {
+		m.data = pmetric.NewMetric()
+		m.init()
+	}
+	return m
+}
+
+type metricSparkStreamingBatchSchedulingDelay struct {
+	data     pmetric.Metric // data buffer for generated metric.
+	config   MetricConfig   // metric config provided by user.
+	capacity int            // max observed number of data points added to the metric.
+}
+
+// init fills spark.streaming.batch.scheduling_delay metric with initial data.
+func (m *metricSparkStreamingBatchSchedulingDelay) init() {
+	m.data.SetName("spark.streaming.batch.scheduling_delay")
+	m.data.SetDescription("Average time the retained batches of a streaming application waited before their processing started. A growing delay means the application is falling behind its input.")
+	m.data.SetUnit("ms")
+	m.data.SetEmptyGauge()
+}
+
+func (m *metricSparkStreamingBatchSchedulingDelay) recordDataPoint(start pcommon.Timestamp, ts pcommon.Timestamp, val int64) {
+	if !m.config.Enabled {
+		return
+	}
+	dp := m.data.Gauge().DataPoints().AppendEmpty()
+	dp.SetStartTimestamp(start)
+	dp.SetTimestamp(ts)
+	dp.SetIntValue(val)
+}
+
+// updateCapacity saves max length of data point slices that will be used for the slice capacity.
+func (m *metricSparkStreamingBatchSchedulingDelay) updateCapacity() {
+	if m.data.Gauge().DataPoints().Len() > m.capacity {
+		m.capacity = m.data.Gauge().DataPoints().Len()
+	}
+}
+
+// emit appends recorded metric data to a metrics slice and prepares it for recording another set of data points.
+func (m *metricSparkStreamingBatchSchedulingDelay) emit(metrics pmetric.MetricSlice) {
+	if m.config.Enabled && m.data.Gauge().DataPoints().Len() > 0 {
+		m.updateCapacity()
+		m.data.MoveTo(metrics.AppendEmpty())
+		m.init()
+	}
+}
+
+func newMetricSparkStreamingBatchSchedulingDelay(cfg MetricConfig) metricSparkStreamingBatchSchedulingDelay {
+	m := metricSparkStreamingBatchSchedulingDelay{config: cfg}
+	if cfg.Enabled {
+		m.data = pmetric.NewMetric()
+		m.init()
+	}
+	return m
+}
+
 // MetricsBuilder provides an interface for scrapers to report metrics while taking care of all the transformations
 // required to produce metric representation defined in metadata and user config.
 type MetricsBuilder struct {
@@ -3601,6 +3750,9 @@ type MetricsBuilder struct {
 	metricSparkStageTaskActive                               metricSparkStageTaskActive
 	metricSparkStageTaskResult                               metricSparkStageTaskResult
 	metricSparkStageTaskResultSize                           metricSparkStageTaskResultSize
+	metricSparkStreamingBatchActive                          metricSparkStreamingBatchActive
+	metricSparkStreamingBatchProcessingTime                  metricSparkStreamingBatchProcessingTime
+	metricSparkStreamingBatchSchedulingDelay                 metricSparkStreamingBatchSchedulingDelay
 }
 
 // metricBuilderOption applies changes to default metrics builder.
@@ -3682,6 +3834,9 @@ func NewMetricsBuilder(mbc MetricsBuilderConfig, settings receiver.CreateSetting
 		metricSparkStageTaskActive:                               newMetricSparkStageTaskActive(mbc.Metrics.SparkStageTaskActive),
 		metricSparkStageTaskResult:                               newMetricSparkStageTaskResult(mbc.Metrics.SparkStageTaskResult),
 		metricSparkStageTaskResultSize:                           newMetricSparkStageTaskResultSize(mbc.Metrics.SparkStageTaskResultSize),
+		metricSparkStreamingBatchActive:                          newMetricSparkStreamingBatchActive(mbc.Metrics.SparkStreamingBatchActive),
+		metricSparkStreamingBatchProcessingTime:                  newMetricSparkStreamingBatchProcessingTime(mbc.Metrics.SparkStreamingBatchProcessingTime),
+		metricSparkStreamingBatchSchedulingDelay:                 newMetricSparkStreamingBatchSchedulingDelay(mbc.Metrics.SparkStreamingBatchSchedulingDelay),
 		resourceAttributeIncludeFilter:                           make(map[string]filter.Filter),
 		resourceAttributeExcludeFilter:                           make(map[string]filter.Filter),
 	}
@@ -3845,6 +4000,9 @@ func (mb *MetricsBuilder) EmitForResource(rmo ...ResourceMetricsOption) {
 	mb.metricSparkStageTaskActive.emit(ils.Metrics())
 	mb.metricSparkStageTaskResult.emit(ils.Metrics())
 	mb.metricSparkStageTaskResultSize.emit(ils.Metrics())
+	mb.metricSparkStreamingBatchActive.emit(ils.Metrics())
+	mb.metricSparkStreamingBatchProcessingTime.emit(ils.Metrics())
+	mb.metricSparkStreamingBatchSchedulingDelay.emit(ils.Metrics())
 
 	for _, op := range rmo {
 		op(rm)
@@ -4191,6 +4349,21 @@ func (mb *MetricsBuilder) RecordSparkStageTaskResultSizeDataPoint(ts pcommon.Tim
 	mb.metricSparkStageTaskResultSize.recordDataPoint(mb.startTime, ts, val)
 }
 
+// RecordSparkStreamingBatchActiveDataPoint adds a data point to spark.streaming.batch.active metric.
+func (mb *MetricsBuilder) RecordSparkStreamingBatchActiveDataPoint(ts pcommon.Timestamp, val int64) {
+	mb.metricSparkStreamingBatchActive.recordDataPoint(mb.startTime, ts, val)
+}
+
+// RecordSparkStreamingBatchProcessingTimeDataPoint adds a data point to spark.streaming.batch.processing_time metric.
+func (mb *MetricsBuilder) RecordSparkStreamingBatchProcessingTimeDataPoint(ts pcommon.Timestamp, val int64) {
+	mb.metricSparkStreamingBatchProcessingTime.recordDataPoint(mb.startTime, ts, val)
+}
+
+// RecordSparkStreamingBatchSchedulingDelayDataPoint adds a data point to spark.streaming.batch.scheduling_delay metric.
+func (mb *MetricsBuilder) RecordSparkStreamingBatchSchedulingDelayDataPoint(ts pcommon.Timestamp, val int64) {
+	mb.metricSparkStreamingBatchSchedulingDelay.recordDataPoint(mb.startTime, ts, val)
+}
+
 // Reset resets metrics builder to its initial state. It should be used when external metrics source is restarted,
 // and metrics builder should update its startTime and reset it's internal state accordingly.
 func (mb *MetricsBuilder) Reset(options ...metricBuilderOption) {
@@ -143,6 +143,32 @@ func (_m *MockClient) JobStats(appID string) ([]models.Job, error) {
 	return r0, r1
 }
 
+// StreamingStats provides a mock function with given fields: appID
+func (_m *MockClient) StreamingStats(appID string) (*models.StreamingStatistics, error) {
+	ret := _m.Called(appID)
+
+	var r0 *models.StreamingStatistics
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string) (*models.StreamingStatistics, error)); ok {
+		return rf(appID)
+	}
+	if rf, ok := ret.Get(0).(func(string) *models.StreamingStatistics); ok {
+		r0 = rf(appID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*models.StreamingStatistics)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string) error); ok {
+		r1 = rf(appID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // GetStageStats provides a mock function with given fields: appID
 func (_m *MockClient) StageStats(appID string) ([]models.Stage, error) {
 	ret := _m.Called(appID)
@@ -0,0 +1,16 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package models // import "github.com/open-telemetry/opentelemetry-collector-contrib/receiver/apachesparkreceiver/internal/models"
+
+// StreamingStatistics represents the json returned by the api/v1/applications/[app-id]/streaming/statistics endpoint.
+// The averages are omitted by Spark until the application has completed a batch.
+type StreamingStatistics struct {
+	BatchDuration            int64    `json:"batchDuration"`
+	NumActiveBatches         int64    `json:"numActiveBatches"`
+	NumTotalCompletedBatches int64    `json:"numTotalCompletedBatches"`
+	AvgInputRate             *float64 `json:"avgInputRate"`
+	AvgSchedulingDelay       *int64   `json:"avgSchedulingDelay"`
+	AvgProcessingTime        *int64   `json:"avgProcessingTime"`
+	AvgTotalDelay            *int64   `json:"avgTotalDelay"`
+}
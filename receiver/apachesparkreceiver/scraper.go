@@ -121,6 +121,14 @@ func (s *sparkScraper) scrape(_ context.Context) (pmetric.Metrics, error) {
 		} else {
 			s.recordJobs(jobStats, now, app.ApplicationID, app.Name)
 		}
+
+		streamingStats, err := s.client.StreamingStats(app.ApplicationID)
+		if err != nil {
+			scrapeErrors.AddPartial(3, err)
+			s.logger.Warn("Failed to scrape streaming stats", zap.Error(err))
+		} else if streamingStats != nil {
+			s.recordStreaming(streamingStats, now, app.ApplicationID, app.Name)
+		}
 	}
 	return s.mb.Emit(), scrapeErrors.Combine()
 }
@@ -348,3 +356,18 @@ func (s *sparkScraper) recordJobs(jobStats []models.Job, now pcommon.Timestamp,
 		s.mb.EmitForResource(metadata.WithResource(rb.Emit()))
 	}
 }
+
+func (s *sparkScraper) recordStreaming(streamingStats *models.StreamingStatistics, now pcommon.Timestamp, appID string, appName string) {
+	if streamingStats.AvgSchedulingDelay != nil {
+		s.mb.RecordSparkStreamingBatchSchedulingDelayDataPoint(now, *streamingStats.AvgSchedulingDelay)
+	}
+	if streamingStats.AvgProcessingTime != nil {
+		s.mb.RecordSparkStreamingBatchProcessingTimeDataPoint(now, *streamingStats.AvgProcessingTime)
+	}
+	s.mb.RecordSparkStreamingBatchActiveDataPoint(now, streamingStats.NumActiveBatches)
+
+	rb := s.mb.NewResourceBuilder()
+	rb.SetSparkApplicationID(appID)
+	rb.SetSparkApplicationName(appName)
+	s.mb.EmitForResource(metadata.WithResource(rb.Emit()))
+}
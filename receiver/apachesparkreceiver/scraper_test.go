@@ -32,6 +32,7 @@ const (
 	stagesStatsResponseFile    = "stages_stats_response.json"
 	executorsStatsResponseFile = "executors_stats_response.json"
 	jobsStatsResponseFile      = "jobs_stats_response.json"
+	streamingStatsResponseFile = "streaming_stats_response.json"
 )
 
 func TestScraper(t *testing.T) {
@@ -85,6 +86,7 @@ func TestScraper(t *testing.T) {
 				mockClient.On("StageStats", mock.Anything).Return([]models.Stage{}, nil)
 				mockClient.On("ExecutorStats", mock.Anything).Return([]models.Executor{}, nil)
 				mockClient.On("JobStats", mock.Anything).Return([]models.Job{}, nil)
+				mockClient.On("StreamingStats", mock.Anything).Return(nil, nil)
 				return &mockClient
 			},
 			expectedMetricGen: func(t *testing.T) pmetric.Metrics {
@@ -115,6 +117,7 @@ func TestScraper(t *testing.T) {
 				mockClient.On("StageStats", mock.Anything).Return(nil, errors.New("stage api error"))
 				mockClient.On("ExecutorStats", mock.Anything).Return(nil, errors.New("executor api error"))
 				mockClient.On("JobStats", mock.Anything).Return(nil, errors.New("jobs api error"))
+				mockClient.On("StreamingStats", mock.Anything).Return(nil, errors.New("streaming api error"))
 
 				return &mockClient
 			},
@@ -125,7 +128,7 @@ func TestScraper(t *testing.T) {
 				return expectedMetrics
 			},
 			config:      createDefaultConfig().(*Config),
-			expectedErr: scrapererror.NewPartialScrapeError(errors.New("stage api error; executor api error; jobs api error"), 0),
+			expectedErr: scrapererror.NewPartialScrapeError(errors.New("stage api error; executor api error; jobs api error; streaming api error"), 0),
 		},
 		{
 			desc: "Successful Full Collection",
@@ -160,6 +163,12 @@ func TestScraper(t *testing.T) {
 				err = json.Unmarshal(data, &jobs)
 				require.NoError(t, err)
 				mockClient.On("JobStats", mock.Anything).Return(jobs, nil)
+
+				data = loadAPIResponseData(t, streamingStatsResponseFile)
+				var streamingStats *models.StreamingStatistics
+				err = json.Unmarshal(data, &streamingStats)
+				require.NoError(t, err)
+				mockClient.On("StreamingStats", mock.Anything).Return(streamingStats, nil)
 				return &mockClient
 			},
 			expectedMetricGen: func(t *testing.T) pmetric.Metrics {
@@ -204,6 +213,12 @@ func TestScraper(t *testing.T) {
 				err = json.Unmarshal(data, &jobs)
 				require.NoError(t, err)
 				mockClient.On("JobStats", mock.Anything).Return(jobs, nil)
+
+				data = loadAPIResponseData(t, streamingStatsResponseFile)
+				var streamingStats *models.StreamingStatistics
+				err = json.Unmarshal(data, &streamingStats)
+				require.NoError(t, err)
+				mockClient.On("StreamingStats", mock.Anything).Return(streamingStats, nil)
 				return &mockClient
 			},
 			expectedMetricGen: func(t *testing.T) pmetric.Metrics {
@@ -9,6 +9,8 @@ import (
 	"net/url"
 	"time"
 
+	"go.opentelemetry.io/collector/config/confighttp"
+	"go.opentelemetry.io/collector/config/configopaque"
 	"go.opentelemetry.io/collector/confmap"
 )
 
@@ -20,10 +22,11 @@ var (
 
 // Config is the overall config structure for the awscloudwatchreceiver
 type Config struct {
-	Region       string      `mapstructure:"region"`
-	Profile      string      `mapstructure:"profile"`
-	IMDSEndpoint string      `mapstructure:"imds_endpoint"`
-	Logs         *LogsConfig `mapstructure:"logs"`
+	Region       string         `mapstructure:"region"`
+	Profile      string         `mapstructure:"profile"`
+	IMDSEndpoint string         `mapstructure:"imds_endpoint"`
+	Logs         *LogsConfig    `mapstructure:"logs"`
+	Metrics      *MetricsConfig `mapstructure:"metrics"`
 }
 
 // LogsConfig is the configuration for the logs portion of this receiver
@@ -52,6 +55,28 @@ type StreamConfig struct {
 	Names    []*string `mapstructure:"names"`
 }
 
+// The output formats of CloudWatch Metric Streams
+const (
+	metricStreamFormatJSON          = "json"
+	metricStreamFormatOpenTelemetry = "opentelemetry1.0"
+)
+
+// MetricsConfig is the configuration for the metrics portion of this receiver
+type MetricsConfig struct {
+	Streams *MetricStreamsConfig `mapstructure:"streams"`
+}
+
+// MetricStreamsConfig is the configuration of the HTTP endpoint receiving the metrics of CloudWatch Metric Streams,
+// delivered by an Amazon Data Firehose stream.
+type MetricStreamsConfig struct {
+	// The Firehose stream expects an HTTPS endpoint, so TLS must be set.
+	confighttp.ServerConfig `mapstructure:",squash"`
+	// Format is the output format of the metric stream: json or opentelemetry1.0.
+	Format string `mapstructure:"format"`
+	// AccessKey is checked against the one sent by the Firehose stream with each request.
+	AccessKey configopaque.String `mapstructure:"access_key"`
+}
+
 var (
 	errNoRegion                       = errors.New("no region was specified")
 	errNoLogsConfigured               = errors.New("no logs configured")
@@ -59,11 +84,15 @@ var (
 	errInvalidPollInterval            = errors.New("poll interval is incorrect, it must be a duration greater than one second")
 	errInvalidAutodiscoverLimit       = errors.New("the limit of autodiscovery of log groups is improperly configured, value must be greater than 0")
 	errAutodiscoverAndNamedConfigured = errors.New("both autodiscover and named configs are configured, Only one or the other is permitted")
+	errNoMetricStreamsConfigured      = errors.New("no metric streams configured")
+	errNoMetricStreamsEndpoint        = errors.New("the endpoint of the metric streams must be specified")
+	errNoMetricStreamsTLS             = errors.New("the tls settings of the metric streams must be specified, as the Firehose stream expects an HTTPS endpoint")
 )
 
 // Validate validates all portions of the relevant config
 func (c *Config) Validate() error {
-	if c.Region == "" {
+	// the metrics are pushed to the receiver, only the polling of the logs needs the region
+	if c.Region == "" && (c.Logs != nil || c.Metrics == nil) {
 		return errNoRegion
 	}
 
@@ -75,21 +104,34 @@ func (c *Config) Validate() error {
 	}
 
 	var errs error
-	errs = errors.Join(errs, c.validateLogsConfig())
+	if c.Logs != nil || c.Metrics == nil {
+		errs = errors.Join(errs, c.validateLogsConfig())
+	}
+	if c.Metrics != nil {
+		errs = errors.Join(errs, c.Metrics.validate())
+	}
 	return errs
 }
 
 // Unmarshal is a custom unmarshaller that ensures that autodiscover is nil if
-// autodiscover is not specified
+// autodiscover is not specified, that the metric streams get their defaults
+// when specified, and that only the metric streams are received when the logs
+// are not specified
 func (c *Config) Unmarshal(componentParser *confmap.Conf) error {
 	if componentParser == nil {
 		return errors.New("")
 	}
+	if componentParser.IsSet("metrics::streams") {
+		c.Metrics = &MetricsConfig{Streams: defaultMetricStreamsConfig()}
+	}
 	err := componentParser.Unmarshal(c)
 	if err != nil {
 		return err
 	}
 
+	if componentParser.IsSet("metrics") && !componentParser.IsSet("logs") {
+		c.Logs = nil
+	}
 	if componentParser.IsSet("logs::groups::named") && !componentParser.IsSet("logs::groups::autodiscover") {
 		c.Logs.Groups.AutodiscoverConfig = nil
 	}
@@ -111,6 +153,24 @@ func (c *Config) validateLogsConfig() error {
 	return c.Logs.Groups.validate()
 }
 
+func (c *MetricsConfig) validate() error {
+	if c.Streams == nil {
+		return errNoMetricStreamsConfigured
+	}
+	if c.Streams.Endpoint == "" {
+		return errNoMetricStreamsEndpoint
+	}
+	if c.Streams.TLSSetting == nil {
+		return errNoMetricStreamsTLS
+	}
+	switch c.Streams.Format {
+	case metricStreamFormatJSON, metricStreamFormatOpenTelemetry:
+		return nil
+	default:
+		return fmt.Errorf("unsupported metric stream format %q, must be %s or %s", c.Streams.Format, metricStreamFormatJSON, metricStreamFormatOpenTelemetry)
+	}
+}
+
 func (c *GroupConfig) validate() error {
 	if c.AutodiscoverConfig != nil && len(c.NamedConfigs) > 0 {
 		return errAutodiscoverAndNamedConfigured
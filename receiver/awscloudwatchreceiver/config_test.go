@@ -12,6 +12,8 @@ import (
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/stretchr/testify/require"
 	"go.opentelemetry.io/collector/component"
+	"go.opentelemetry.io/collector/config/confighttp"
+	"go.opentelemetry.io/collector/config/configtls"
 	"go.opentelemetry.io/collector/confmap/confmaptest"
 
 	"github.com/open-telemetry/opentelemetry-collector-contrib/receiver/awscloudwatchreceiver/internal/metadata"
@@ -127,6 +129,85 @@ func TestValidate(t *testing.T) {
 			},
 			expectedErr: errAutodiscoverAndNamedConfigured,
 		},
+		{
+			name: "Valid Metric Streams",
+			config: Config{
+				Region: "us-west-2",
+				Logs:   createDefaultConfig().(*Config).Logs,
+				Metrics: &MetricsConfig{
+					Streams: &MetricStreamsConfig{
+						ServerConfig: confighttp.ServerConfig{
+							Endpoint:   "0.0.0.0:4433",
+							TLSSetting: &configtls.ServerConfig{},
+						},
+						Format: metricStreamFormatOpenTelemetry,
+					},
+				},
+			},
+		},
+		{
+			name: "Valid Metric Streams Without Logs",
+			config: Config{
+				Metrics: &MetricsConfig{
+					Streams: &MetricStreamsConfig{
+						ServerConfig: confighttp.ServerConfig{
+							Endpoint:   "0.0.0.0:4433",
+							TLSSetting: &configtls.ServerConfig{},
+						},
+						Format: metricStreamFormatJSON,
+					},
+				},
+			},
+		},
+		{
+			name: "No Metric Streams TLS",
+			config: Config{
+				Metrics: &MetricsConfig{
+					Streams: &MetricStreamsConfig{
+						ServerConfig: confighttp.ServerConfig{Endpoint: "0.0.0.0:4433"},
+						Format:       metricStreamFormatJSON,
+					},
+				},
+			},
+			expectedErr: errNoMetricStreamsTLS,
+		},
+		{
+			name: "Nil Metric Streams",
+			config: Config{
+				Region:  "us-west-2",
+				Logs:    createDefaultConfig().(*Config).Logs,
+				Metrics: &MetricsConfig{},
+			},
+			expectedErr: errNoMetricStreamsConfigured,
+		},
+		{
+			name: "No Metric Streams Endpoint",
+			config: Config{
+				Region: "us-west-2",
+				Logs:   createDefaultConfig().(*Config).Logs,
+				Metrics: &MetricsConfig{
+					Streams: &MetricStreamsConfig{Format: metricStreamFormatJSON},
+				},
+			},
+			expectedErr: errNoMetricStreamsEndpoint,
+		},
+		{
+			name: "Invalid Metric Streams Format",
+			config: Config{
+				Region: "us-west-2",
+				Logs:   createDefaultConfig().(*Config).Logs,
+				Metrics: &MetricsConfig{
+					Streams: &MetricStreamsConfig{
+						ServerConfig: confighttp.ServerConfig{
+							Endpoint:   "0.0.0.0:4433",
+							TLSSetting: &configtls.ServerConfig{},
+						},
+						Format: "csv",
+					},
+				},
+			},
+			expectedErr: errors.New(`unsupported metric stream format "csv"`),
+		},
 	}
 
 	for _, tc := range cases {
@@ -250,6 +331,26 @@ func TestLoadConfig(t *testing.T) {
 				},
 			},
 		},
+		{
+			name: "metric-streams",
+			expectedConfig: &Config{
+				Metrics: &MetricsConfig{
+					Streams: &MetricStreamsConfig{
+						ServerConfig: confighttp.ServerConfig{
+							Endpoint: "0.0.0.0:4433",
+							TLSSetting: &configtls.ServerConfig{
+								Config: configtls.Config{
+									CertFile: "server.crt",
+									KeyFile:  "server.key",
+								},
+							},
+						},
+						Format:    metricStreamFormatOpenTelemetry,
+						AccessKey: "secret",
+					},
+				},
+			},
+		},
 	}
 
 	for _, tc := range cases {
@@ -7,18 +7,23 @@ import (
 	"context"
 
 	"go.opentelemetry.io/collector/component"
+	"go.opentelemetry.io/collector/config/confighttp"
 	"go.opentelemetry.io/collector/consumer"
 	"go.opentelemetry.io/collector/receiver"
 
+	"github.com/open-telemetry/opentelemetry-collector-contrib/internal/common/localhostgate"
 	"github.com/open-telemetry/opentelemetry-collector-contrib/receiver/awscloudwatchreceiver/internal/metadata"
 )
 
+const defaultMetricStreamsPort = 4433
+
 // NewFactory returns the component factory for the awscloudwatchreceiver
 func NewFactory() receiver.Factory {
 	return receiver.NewFactory(
 		metadata.Type,
 		createDefaultConfig,
 		receiver.WithLogs(createLogsReceiver, metadata.LogsStability),
+		receiver.WithMetrics(createMetricsReceiver, metadata.MetricsStability),
 	)
 }
 
@@ -29,10 +34,26 @@ func createLogsReceiver(
 	consumer consumer.Logs,
 ) (receiver.Logs, error) {
 	cfg := rConf.(*Config)
+	if cfg.Logs == nil {
+		return nil, errNoLogsConfigured
+	}
 	rcvr := newLogsReceiver(cfg, params.Logger, consumer)
 	return rcvr, nil
 }
 
+func createMetricsReceiver(
+	ctx context.Context,
+	params receiver.CreateSettings,
+	rConf component.Config,
+	consumer consumer.Metrics,
+) (receiver.Metrics, error) {
+	cfg := rConf.(*Config)
+	if cfg.Metrics == nil || cfg.Metrics.Streams == nil {
+		return nil, errNoMetricStreamsConfigured
+	}
+	return newMetricStreamsReceiver(ctx, params, cfg.Metrics.Streams, consumer)
+}
+
 func createDefaultConfig() component.Config {
 	return &Config{
 		Logs: &LogsConfig{
@@ -46,3 +67,13 @@ func createDefaultConfig() component.Config {
 		},
 	}
 }
+
+// defaultMetricStreamsConfig returns the defaults of the metric streams, which are only received when specified.
+func defaultMetricStreamsConfig() *MetricStreamsConfig {
+	return &MetricStreamsConfig{
+		ServerConfig: confighttp.ServerConfig{
+			Endpoint: localhostgate.EndpointForPort(defaultMetricStreamsPort),
+		},
+		Format: metricStreamFormatJSON,
+	}
+}
@@ -0,0 +1,37 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package awscloudwatchreceiver // import "github.com/open-telemetry/opentelemetry-collector-contrib/receiver/awscloudwatchreceiver"
+
+import (
+	"context"
+
+	"go.opentelemetry.io/collector/consumer"
+	"go.opentelemetry.io/collector/receiver"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/internal/aws/firehose"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/internal/aws/firehose/unmarshaler/cwmetricstream"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/internal/aws/firehose/unmarshaler/otlpmetricstream"
+)
+
+// metricStreamsRecordTypes maps the formats of the metric streams to the record types of the Firehose records.
+var metricStreamsRecordTypes = map[string]string{
+	metricStreamFormatJSON:          cwmetricstream.TypeStr,
+	metricStreamFormatOpenTelemetry: otlpmetricstream.TypeStr,
+}
+
+// newMetricStreamsReceiver returns a receiver of the metrics pushed by CloudWatch Metric Streams, instead of
+// polling them, through the HTTP endpoint of an Amazon Data Firehose stream.
+func newMetricStreamsReceiver(
+	_ context.Context,
+	params receiver.CreateSettings,
+	cfg *MetricStreamsConfig,
+	consumer consumer.Metrics,
+) (receiver.Metrics, error) {
+	firehoseCfg := &firehose.Config{
+		ServerConfig: cfg.ServerConfig,
+		RecordType:   metricStreamsRecordTypes[cfg.Format],
+		AccessKey:    cfg.AccessKey,
+	}
+	return firehose.NewMetricsReceiver(firehoseCfg, params, firehose.DefaultMetricsUnmarshalers(params.Logger), consumer)
+}
@@ -0,0 +1,99 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package awscloudwatchreceiver
+
+import (
+	"bytes"
+	"context"
+	"encoding/base64"
+	"encoding/binary"
+	"encoding/json"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/collector/component/componenttest"
+	"go.opentelemetry.io/collector/config/confighttp"
+	"go.opentelemetry.io/collector/consumer/consumertest"
+	"go.opentelemetry.io/collector/pdata/pmetric"
+	"go.opentelemetry.io/collector/pdata/pmetric/pmetricotlp"
+	"go.opentelemetry.io/collector/receiver/receivertest"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/internal/common/testutil"
+)
+
+const jsonMetricStreamRecord = `{"metric_stream_name":"MyMetricStream","account_id":"1234567890","region":"us-east-1",` +
+	`"namespace":"AWS/EC2","metric_name":"DiskWriteOps","dimensions":{"InstanceId":"i-123456789012"},` +
+	`"timestamp":1611929698000,"value":{"count":3.0,"sum":20.0,"max":18.0,"min":0.0},"unit":"Seconds"}`
+
+func openTelemetryMetricStreamRecord(t *testing.T) []byte {
+	md := pmetric.NewMetrics()
+	m := md.ResourceMetrics().AppendEmpty().ScopeMetrics().AppendEmpty().Metrics().AppendEmpty()
+	m.SetName("amazonaws.com/AWS/EC2/DiskWriteOps")
+	m.SetEmptySummary().DataPoints().AppendEmpty().SetCount(3)
+	data, err := pmetricotlp.NewExportRequestFromMetrics(md).MarshalProto()
+	require.NoError(t, err)
+	// the records hold length-delimited export requests
+	return append(binary.AppendUvarint(nil, uint64(len(data))), data...)
+}
+
+func TestMetricStreamsReceiver(t *testing.T) {
+	cases := []struct {
+		format       string
+		record       func(t *testing.T) []byte
+		expectedName string
+	}{
+		{
+			format:       metricStreamFormatJSON,
+			record:       func(*testing.T) []byte { return []byte(jsonMetricStreamRecord) },
+			expectedName: "DiskWriteOps",
+		},
+		{
+			format:       metricStreamFormatOpenTelemetry,
+			record:       openTelemetryMetricStreamRecord,
+			expectedName: "amazonaws.com/AWS/EC2/DiskWriteOps",
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.format, func(t *testing.T) {
+			endpoint := testutil.GetAvailableLocalAddress(t)
+			sink := new(consumertest.MetricsSink)
+			rcvr, err := newMetricStreamsReceiver(context.Background(), receivertest.NewNopCreateSettings(), &MetricStreamsConfig{
+				ServerConfig: confighttp.ServerConfig{Endpoint: endpoint},
+				Format:       tc.format,
+				AccessKey:    "secret",
+			}, sink)
+			require.NoError(t, err)
+			require.NoError(t, rcvr.Start(context.Background(), componenttest.NewNopHost()))
+			defer func() {
+				require.NoError(t, rcvr.Shutdown(context.Background()))
+			}()
+
+			body, err := json.Marshal(map[string]any{
+				"requestId": "request-id",
+				"timestamp": time.Now().UnixMilli(),
+				"records": []map[string]string{
+					{"data": base64.StdEncoding.EncodeToString(tc.record(t))},
+				},
+			})
+			require.NoError(t, err)
+			req, err := http.NewRequest(http.MethodPost, "http://"+endpoint, bytes.NewReader(body))
+			require.NoError(t, err)
+			req.Header.Set("X-Amz-Firehose-Request-Id", "request-id")
+			req.Header.Set("X-Amz-Firehose-Access-Key", "secret")
+			resp, err := http.DefaultClient.Do(req)
+			require.NoError(t, err)
+			require.NoError(t, resp.Body.Close())
+			assert.Equal(t, http.StatusOK, resp.StatusCode)
+
+			require.Len(t, sink.AllMetrics(), 1)
+			md := sink.AllMetrics()[0]
+			require.Equal(t, 1, md.MetricCount())
+			assert.Equal(t, tc.expectedName, md.ResourceMetrics().At(0).ScopeMetrics().At(0).Metrics().At(0).Name())
+		})
+	}
+}
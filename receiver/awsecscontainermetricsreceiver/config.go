@@ -12,4 +12,8 @@ type Config struct {
 
 	// CollectionInterval is the interval at which metrics should be collected
 	CollectionInterval time.Duration `mapstructure:"collection_interval"`
+
+	// ServiceMetrics enables the metrics of the ECS service of the task, e.g. its numbers of running and desired tasks,
+	// fetched from the ECS API. It requires the ecs:DescribeServices permission in the task role.
+	ServiceMetrics bool `mapstructure:"service_metrics"`
 }
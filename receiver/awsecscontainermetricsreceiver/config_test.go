@@ -36,6 +36,13 @@ func TestLoadConfig(t *testing.T) {
 				CollectionInterval: 10 * time.Second,
 			},
 		},
+		{
+			id: component.NewIDWithName(metadata.Type, "service_metrics"),
+			expected: &Config{
+				CollectionInterval: 20 * time.Second,
+				ServiceMetrics:     true,
+			},
+		},
 	}
 
 	for _, tt := range tests {
@@ -10,9 +10,11 @@ const (
 	attributeECSTaskID            = "aws.ecs.task.id"
 	attributeECSTaskRevision      = "aws.ecs.task.version"
 	attributeECSServiceName       = "aws.ecs.service.name"
+	attributeECSServiceARN        = "aws.ecs.service.arn"
 	attributeECSTaskPullStartedAt = "aws.ecs.task.pull_started_at"
 	attributeECSTaskPullStoppedAt = "aws.ecs.task.pull_stopped_at"
 	attributeECSTaskKnownStatus   = "aws.ecs.task.known_status"
+	attributeECSTaskDesiredStatus = "aws.ecs.task.desired_status"
 	attributeECSTaskLaunchType    = "aws.ecs.task.launch_type"
 	attributeContainerImageID     = "aws.ecs.container.image.id"
 	attributeContainerCreatedAt   = "aws.ecs.container.created_at"
@@ -20,12 +22,14 @@ const (
 	attributeContainerFinishedAt  = "aws.ecs.container.finished_at"
 	attributeContainerKnownStatus = "aws.ecs.container.know_status"
 	attributeContainerExitCode    = "aws.ecs.container.exit_code"
+	attributeContainerHealth      = "aws.ecs.container.health_status"
 
 	cpusInVCpu = 1024
 	bytesInMiB = 1024 * 1024
 
 	taskPrefix      = "ecs.task."
 	containerPrefix = "container."
+	servicePrefix   = "ecs.service."
 
 	attributeMemoryUsage    = "memory.usage"
 	attributeMemoryMaxUsage = "memory.usage.max"
@@ -60,6 +64,12 @@ const (
 
 	attributeDuration = "duration"
 
+	attributeRunningTaskCount = "task.running"
+	attributeDesiredTaskCount = "task.desired"
+	attributePendingTaskCount = "task.pending"
+	attributeDeploymentCount  = "deployment.count"
+	attributeTaskSetCount     = "task_set.count"
+
 	unitBytes       = "Bytes"
 	unitMegaBytes   = "Megabytes"
 	unitNanoSecond  = "Nanoseconds"
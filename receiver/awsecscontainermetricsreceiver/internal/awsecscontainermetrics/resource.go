@@ -4,6 +4,7 @@
 package awsecscontainermetrics // import "github.com/open-telemetry/opentelemetry-collector-contrib/receiver/awsecscontainermetricsreceiver/internal/awsecscontainermetrics"
 
 import (
+	"fmt"
 	"strings"
 
 	"go.opentelemetry.io/collector/pdata/pcommon"
@@ -37,6 +38,19 @@ func containerResource(cm ecsutil.ContainerMetadata, logger *zap.Logger) pcommon
 	if cm.ExitCode != nil {
 		resource.Attributes().PutInt(attributeContainerExitCode, *cm.ExitCode)
 	}
+	if cm.ContainerARN != "" {
+		resource.Attributes().PutStr(conventions.AttributeAWSECSContainerARN, cm.ContainerARN)
+	}
+	if cm.Health != nil && cm.Health.Status != "" {
+		resource.Attributes().PutStr(attributeContainerHealth, cm.Health.Status)
+	}
+	// The CloudWatch log group and stream of the container, when it uses the awslogs log driver
+	if cm.LogDriver == "awslogs" && cm.LogOptions.LogGroup != "" {
+		resource.Attributes().PutEmptySlice(conventions.AttributeAWSLogGroupNames).AppendEmpty().SetStr(cm.LogOptions.LogGroup)
+		if cm.LogOptions.Stream != "" {
+			resource.Attributes().PutEmptySlice(conventions.AttributeAWSLogStreamNames).AppendEmpty().SetStr(cm.LogOptions.Stream)
+		}
+	}
 	return resource
 }
 
@@ -44,6 +58,9 @@ func taskResource(tm ecsutil.TaskMetadata) pcommon.Resource {
 	resource := pcommon.NewResource()
 	region, accountID, taskID := getResourceFromARN(tm.TaskARN)
 	resource.Attributes().PutStr(attributeECSCluster, getNameFromCluster(tm.Cluster))
+	if clusterARN := getClusterARN(tm.Cluster, region, accountID); clusterARN != "" {
+		resource.Attributes().PutStr(conventions.AttributeAWSECSClusterARN, clusterARN)
+	}
 	resource.Attributes().PutStr(conventions.AttributeAWSECSTaskARN, tm.TaskARN)
 	resource.Attributes().PutStr(attributeECSTaskID, taskID)
 	resource.Attributes().PutStr(conventions.AttributeAWSECSTaskFamily, tm.Family)
@@ -58,6 +75,9 @@ func taskResource(tm ecsutil.TaskMetadata) pcommon.Resource {
 	resource.Attributes().PutStr(attributeECSTaskPullStartedAt, tm.PullStartedAt)
 	resource.Attributes().PutStr(attributeECSTaskPullStoppedAt, tm.PullStoppedAt)
 	resource.Attributes().PutStr(attributeECSTaskKnownStatus, tm.KnownStatus)
+	if tm.DesiredStatus != "" {
+		resource.Attributes().PutStr(attributeECSTaskDesiredStatus, tm.DesiredStatus)
+	}
 
 	// Task launchtype: aws.ecs.task.launch_type (raw string) and aws.ecs.launchtype (lowercase)
 	resource.Attributes().PutStr(attributeECSTaskLaunchType, tm.LaunchType)
@@ -103,3 +123,15 @@ func getNameFromCluster(cluster string) string {
 
 	return splits[len(splits)-1]
 }
+
+// getClusterARN returns the ARN of the cluster, which task metadata v4 reports either as an ARN or as a name, in which
+// case the ARN is built from the region and the account of the task.
+func getClusterARN(cluster, region, accountID string) string {
+	if cluster == "" || strings.HasPrefix(cluster, "arn:aws") {
+		return cluster
+	}
+	if region == "" || accountID == "" {
+		return ""
+	}
+	return fmt.Sprintf("arn:aws:ecs:%s:%s:cluster/%s", region, accountID, cluster)
+}
@@ -100,9 +100,10 @@ func TestTaskResource(t *testing.T) {
 	require.NotNil(t, r)
 
 	attrMap := r.Attributes()
-	require.EqualValues(t, 15, attrMap.Len())
+	require.EqualValues(t, 16, attrMap.Len())
 	expected := map[string]string{
 		attributeECSCluster:                        "cluster-1",
+		conventions.AttributeAWSECSClusterARN:      "arn:aws:ecs:us-west-2:111122223333:cluster/cluster-1",
 		conventions.AttributeAWSECSTaskARN:         "arn:aws:ecs:us-west-2:111122223333:task/default/158d1c8083dd49d6b527399fd6414f5c",
 		attributeECSTaskID:                         "158d1c8083dd49d6b527399fd6414f5c",
 		conventions.AttributeAWSECSTaskFamily:      "task-def-family-1",
@@ -139,10 +140,11 @@ func TestTaskResourceWithClusterARN(t *testing.T) {
 	require.NotNil(t, r)
 
 	attrMap := r.Attributes()
-	require.EqualValues(t, 15, attrMap.Len())
+	require.EqualValues(t, 16, attrMap.Len())
 
 	expected := map[string]string{
 		attributeECSCluster:                        "main-cluster",
+		conventions.AttributeAWSECSClusterARN:      "arn:aws:ecs:us-west-2:803860917211:cluster/main-cluster",
 		conventions.AttributeAWSECSTaskARN:         "arn:aws:ecs:us-west-2:803860917211:cluster/main-cluster/c8083dd49d6b527399fd6414",
 		attributeECSTaskID:                         "c8083dd49d6b527399fd6414",
 		conventions.AttributeAWSECSTaskFamily:      "task-def-family-1",
@@ -162,6 +164,61 @@ func TestTaskResourceWithClusterARN(t *testing.T) {
 	verifyAttributeMap(t, expected, attrMap)
 }
 
+func TestContainerResourceV4Metadata(t *testing.T) {
+	cm := ecsutil.ContainerMetadata{
+		ContainerARN:  "arn:aws:ecs:us-west-2:111122223333:container/0206b271-b33f-47ab-86c6-a0ba208a70a9",
+		ContainerName: "container-1",
+		DockerID:      "001",
+		Image:         "nginx:v1.0",
+		KnownStatus:   "RUNNING",
+		Health:        &ecsutil.ContainerHealth{Status: "HEALTHY"},
+		LogDriver:     "awslogs",
+		LogOptions: ecsutil.LogOptions{
+			LogGroup: "/ecs/my-service",
+			Region:   "us-west-2",
+			Stream:   "ecs/container-1/158d1c8083dd49d6b527399fd6414f5c",
+		},
+	}
+
+	r := containerResource(cm, zap.NewNop())
+	attrMap := r.Attributes()
+	verifyAttributeMap(t, map[string]string{
+		conventions.AttributeAWSECSContainerARN: "arn:aws:ecs:us-west-2:111122223333:container/0206b271-b33f-47ab-86c6-a0ba208a70a9",
+		attributeContainerHealth:                "HEALTHY",
+	}, attrMap)
+	logGroups, found := attrMap.Get(conventions.AttributeAWSLogGroupNames)
+	require.True(t, found)
+	require.Equal(t, []any{"/ecs/my-service"}, logGroups.Slice().AsRaw())
+	logStreams, found := attrMap.Get(conventions.AttributeAWSLogStreamNames)
+	require.True(t, found)
+	require.Equal(t, []any{"ecs/container-1/158d1c8083dd49d6b527399fd6414f5c"}, logStreams.Slice().AsRaw())
+
+	// the log group is only known for the awslogs log driver
+	cm.LogDriver = "fluentd"
+	_, found = containerResource(cm, zap.NewNop()).Attributes().Get(conventions.AttributeAWSLogGroupNames)
+	require.False(t, found)
+}
+
+func TestTaskResourceDesiredStatus(t *testing.T) {
+	tm := ecsutil.TaskMetadata{
+		Cluster:       "arn:aws:ecs:us-west-2:803860917211:cluster/main-cluster",
+		TaskARN:       "arn:aws:ecs:us-west-2:803860917211:task/main-cluster/c8083dd49d6b527399fd6414",
+		KnownStatus:   "RUNNING",
+		DesiredStatus: "STOPPED",
+	}
+	verifyAttributeMap(t, map[string]string{
+		attributeECSTaskKnownStatus:   "RUNNING",
+		attributeECSTaskDesiredStatus: "STOPPED",
+	}, taskResource(tm).Attributes())
+}
+
+func TestGetClusterARN(t *testing.T) {
+	require.Equal(t, "arn:aws:ecs:us-west-2:803860917211:cluster/main", getClusterARN("arn:aws:ecs:us-west-2:803860917211:cluster/main", "", ""))
+	require.Equal(t, "arn:aws:ecs:us-west-2:803860917211:cluster/main", getClusterARN("main", "us-west-2", "803860917211"))
+	require.Equal(t, "", getClusterARN("main", "", ""))
+	require.Equal(t, "", getClusterARN("", "us-west-2", "803860917211"))
+}
+
 func verifyAttributeMap(t *testing.T, expected map[string]string, found pcommon.Map) {
 	for key, val := range expected {
 		attributeVal, found := found.Get(key)
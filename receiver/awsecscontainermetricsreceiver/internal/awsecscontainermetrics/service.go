@@ -0,0 +1,92 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package awsecscontainermetrics // import "github.com/open-telemetry/opentelemetry-collector-contrib/receiver/awsecscontainermetricsreceiver/internal/awsecscontainermetrics"
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/request"
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/aws/aws-sdk-go/service/ecs"
+	"go.opentelemetry.io/collector/pdata/pcommon"
+	"go.opentelemetry.io/collector/pdata/pmetric"
+	conventions "go.opentelemetry.io/collector/semconv/v1.6.1"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/internal/aws/ecsutil"
+)
+
+// ECSClient is the part of the ECS API used to describe the service of the task.
+type ECSClient interface {
+	DescribeServicesWithContext(ctx context.Context, input *ecs.DescribeServicesInput, opts ...request.Option) (*ecs.DescribeServicesOutput, error)
+}
+
+// NewECSClient creates a client of the ECS API in the region of the task.
+func NewECSClient(metadata ecsutil.TaskMetadata) (ECSClient, error) {
+	region, _, _ := getResourceFromARN(metadata.TaskARN)
+	if region == "" {
+		return nil, fmt.Errorf("unable to get the region from the task ARN %q", metadata.TaskARN)
+	}
+	sess, err := session.NewSession(aws.NewConfig().WithRegion(region).WithCredentialsChainVerboseErrors(true))
+	if err != nil {
+		return nil, fmt.Errorf("failed to create the AWS session: %w", err)
+	}
+	return ecs.New(sess), nil
+}
+
+// ServiceMetricsData describes the ECS service of the task and generates the OTLP metrics of its tasks and deployments,
+// the metrics of the services of Container Insights. It returns false if the task doesn't belong to a service.
+func ServiceMetricsData(ctx context.Context, client ECSClient, metadata ecsutil.TaskMetadata) (pmetric.Metrics, bool, error) {
+	if metadata.ServiceName == "" {
+		return pmetric.Metrics{}, false, nil
+	}
+	out, err := client.DescribeServicesWithContext(ctx, &ecs.DescribeServicesInput{
+		Cluster:  aws.String(metadata.Cluster),
+		Services: []*string{aws.String(metadata.ServiceName)},
+	})
+	if err != nil {
+		return pmetric.Metrics{}, false, fmt.Errorf("ecs.DescribeServices failed: %w", err)
+	}
+	if len(out.Services) == 0 {
+		if len(out.Failures) > 0 {
+			return pmetric.Metrics{}, false, fmt.Errorf("ecs.DescribeServices failed for service %q: %s", metadata.ServiceName, aws.StringValue(out.Failures[0].Reason))
+		}
+		return pmetric.Metrics{}, false, errors.New("ecs.DescribeServices returned no service")
+	}
+	return convertServiceToOTLPMetrics(out.Services[0], metadata, pcommon.NewTimestampFromTime(time.Now())), true, nil
+}
+
+func convertServiceToOTLPMetrics(service *ecs.Service, metadata ecsutil.TaskMetadata, timestamp pcommon.Timestamp) pmetric.Metrics {
+	md := pmetric.NewMetrics()
+	rm := md.ResourceMetrics().AppendEmpty()
+	rm.SetSchemaUrl(conventions.SchemaURL)
+	serviceResource(service, metadata).CopyTo(rm.Resource())
+
+	ilms := rm.ScopeMetrics()
+	appendIntGauge(servicePrefix+attributeRunningTaskCount, unitCount, aws.Int64Value(service.RunningCount), timestamp, ilms.AppendEmpty())
+	appendIntGauge(servicePrefix+attributeDesiredTaskCount, unitCount, aws.Int64Value(service.DesiredCount), timestamp, ilms.AppendEmpty())
+	appendIntGauge(servicePrefix+attributePendingTaskCount, unitCount, aws.Int64Value(service.PendingCount), timestamp, ilms.AppendEmpty())
+	appendIntGauge(servicePrefix+attributeDeploymentCount, unitCount, int64(len(service.Deployments)), timestamp, ilms.AppendEmpty())
+	appendIntGauge(servicePrefix+attributeTaskSetCount, unitCount, int64(len(service.TaskSets)), timestamp, ilms.AppendEmpty())
+	return md
+}
+
+// serviceResource returns the resource of the service, identified by its cluster and its name like in Container
+// Insights, so that all the tasks of the service report the same metrics.
+func serviceResource(service *ecs.Service, metadata ecsutil.TaskMetadata) pcommon.Resource {
+	resource := pcommon.NewResource()
+	region, accountID, _ := getResourceFromARN(metadata.TaskARN)
+	resource.Attributes().PutStr(attributeECSCluster, getNameFromCluster(metadata.Cluster))
+	if clusterARN := getClusterARN(metadata.Cluster, region, accountID); clusterARN != "" {
+		resource.Attributes().PutStr(conventions.AttributeAWSECSClusterARN, clusterARN)
+	}
+	resource.Attributes().PutStr(attributeECSServiceName, aws.StringValue(service.ServiceName))
+	resource.Attributes().PutStr(attributeECSServiceARN, aws.StringValue(service.ServiceArn))
+	resource.Attributes().PutStr(conventions.AttributeCloudRegion, region)
+	resource.Attributes().PutStr(conventions.AttributeCloudAccountID, accountID)
+	return resource
+}
@@ -0,0 +1,135 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package awsecscontainermetrics
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/request"
+	"github.com/aws/aws-sdk-go/service/ecs"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/collector/pdata/pmetric"
+	conventions "go.opentelemetry.io/collector/semconv/v1.6.1"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/internal/aws/ecsutil"
+)
+
+type fakeECSClient struct {
+	input  *ecs.DescribeServicesInput
+	output *ecs.DescribeServicesOutput
+	err    error
+}
+
+func (c *fakeECSClient) DescribeServicesWithContext(_ context.Context, input *ecs.DescribeServicesInput, _ ...request.Option) (*ecs.DescribeServicesOutput, error) {
+	c.input = input
+	return c.output, c.err
+}
+
+var serviceTaskMetadata = ecsutil.TaskMetadata{
+	Cluster:     "main-cluster",
+	TaskARN:     "arn:aws:ecs:us-west-2:803860917211:task/main-cluster/c8083dd49d6b527399fd6414",
+	ServiceName: "my-service",
+}
+
+func TestServiceMetricsData(t *testing.T) {
+	client := &fakeECSClient{output: &ecs.DescribeServicesOutput{
+		Services: []*ecs.Service{{
+			ServiceName:  aws.String("my-service"),
+			ServiceArn:   aws.String("arn:aws:ecs:us-west-2:803860917211:service/main-cluster/my-service"),
+			RunningCount: aws.Int64(3),
+			DesiredCount: aws.Int64(4),
+			PendingCount: aws.Int64(1),
+			Deployments:  []*ecs.Deployment{{Id: aws.String("ecs-svc/1")}, {Id: aws.String("ecs-svc/2")}},
+		}},
+	}}
+
+	md, ok, err := ServiceMetricsData(context.Background(), client, serviceTaskMetadata)
+	require.NoError(t, err)
+	require.True(t, ok)
+	assert.Equal(t, "main-cluster", aws.StringValue(client.input.Cluster))
+	assert.Equal(t, []*string{aws.String("my-service")}, client.input.Services)
+
+	require.Equal(t, 1, md.ResourceMetrics().Len())
+	assert.Equal(t, map[string]any{
+		attributeECSCluster:                   "main-cluster",
+		conventions.AttributeAWSECSClusterARN: "arn:aws:ecs:us-west-2:803860917211:cluster/main-cluster",
+		attributeECSServiceName:               "my-service",
+		attributeECSServiceARN:                "arn:aws:ecs:us-west-2:803860917211:service/main-cluster/my-service",
+		conventions.AttributeCloudRegion:      "us-west-2",
+		conventions.AttributeCloudAccountID:   "803860917211",
+	}, md.ResourceMetrics().At(0).Resource().Attributes().AsRaw())
+
+	values := map[string]int64{}
+	ilms := md.ResourceMetrics().At(0).ScopeMetrics()
+	for i := 0; i < ilms.Len(); i++ {
+		metric := ilms.At(i).Metrics().At(0)
+		require.Equal(t, pmetric.MetricTypeGauge, metric.Type())
+		assert.Equal(t, unitCount, metric.Unit())
+		values[metric.Name()] = metric.Gauge().DataPoints().At(0).IntValue()
+	}
+	assert.Equal(t, map[string]int64{
+		"ecs.service.task.running":     3,
+		"ecs.service.task.desired":     4,
+		"ecs.service.task.pending":     1,
+		"ecs.service.deployment.count": 2,
+		"ecs.service.task_set.count":   0,
+	}, values)
+}
+
+func TestServiceMetricsDataWithoutService(t *testing.T) {
+	client := &fakeECSClient{}
+	metadata := serviceTaskMetadata
+	metadata.ServiceName = ""
+
+	_, ok, err := ServiceMetricsData(context.Background(), client, metadata)
+	require.NoError(t, err)
+	assert.False(t, ok)
+	assert.Nil(t, client.input, "the ECS API must not be called for standalone tasks")
+}
+
+func TestServiceMetricsDataErrors(t *testing.T) {
+	tests := []struct {
+		name        string
+		client      *fakeECSClient
+		expectedErr string
+	}{
+		{
+			name:        "api_error",
+			client:      &fakeECSClient{err: errors.New("access denied")},
+			expectedErr: "ecs.DescribeServices failed: access denied",
+		},
+		{
+			name: "failure",
+			client: &fakeECSClient{output: &ecs.DescribeServicesOutput{
+				Failures: []*ecs.Failure{{Reason: aws.String("MISSING")}},
+			}},
+			expectedErr: `ecs.DescribeServices failed for service "my-service": MISSING`,
+		},
+		{
+			name:        "no_service",
+			client:      &fakeECSClient{output: &ecs.DescribeServicesOutput{}},
+			expectedErr: "ecs.DescribeServices returned no service",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, ok, err := ServiceMetricsData(context.Background(), tt.client, serviceTaskMetadata)
+			assert.EqualError(t, err, tt.expectedErr)
+			assert.False(t, ok)
+		})
+	}
+}
+
+func TestNewECSClient(t *testing.T) {
+	_, err := NewECSClient(ecsutil.TaskMetadata{TaskARN: "not-an-arn"})
+	assert.EqualError(t, err, `unable to get the region from the task ARN "not-an-arn"`)
+
+	client, err := NewECSClient(serviceTaskMetadata)
+	require.NoError(t, err)
+	assert.NotNil(t, client)
+}
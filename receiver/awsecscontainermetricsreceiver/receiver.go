@@ -9,6 +9,7 @@ import (
 
 	"go.opentelemetry.io/collector/component"
 	"go.opentelemetry.io/collector/consumer"
+	"go.opentelemetry.io/collector/pdata/pmetric"
 	"go.opentelemetry.io/collector/receiver"
 	"go.uber.org/zap"
 
@@ -26,6 +27,9 @@ type awsEcsContainerMetricsReceiver struct {
 	cancel       context.CancelFunc
 	restClient   ecsutil.RestClient
 	provider     *awsecscontainermetrics.StatsProvider
+	// ecsClient describes the service of the task, created on the first collection from the task metadata
+	ecsClient    awsecscontainermetrics.ECSClient
+	newECSClient func(ecsutil.TaskMetadata) (awsecscontainermetrics.ECSClient, error)
 }
 
 // New creates the aws ecs container metrics receiver with the given parameters.
@@ -39,6 +43,7 @@ func newAWSECSContainermetrics(
 		nextConsumer: nextConsumer,
 		config:       config,
 		restClient:   rest,
+		newECSClient: awsecscontainermetrics.NewECSClient,
 	}
 	return r, nil
 }
@@ -82,6 +87,14 @@ func (aecmr *awsEcsContainerMetricsReceiver) collectDataFromEndpoint(ctx context
 
 	// TODO: report self metrics using obsreport
 	mds := awsecscontainermetrics.MetricsData(stats, metadata, aecmr.logger)
+	if aecmr.config.ServiceMetrics {
+		// the metrics of the containers and the task are reported even if the service can't be described
+		if md, ok, err := aecmr.serviceMetrics(ctx, metadata); err != nil {
+			aecmr.logger.Warn("Failed to collect the service metrics", zap.Error(err))
+		} else if ok {
+			mds = append(mds, md)
+		}
+	}
 	for _, md := range mds {
 		err = aecmr.nextConsumer.ConsumeMetrics(ctx, md)
 		if err != nil {
@@ -91,3 +104,16 @@ func (aecmr *awsEcsContainerMetricsReceiver) collectDataFromEndpoint(ctx context
 
 	return nil
 }
+
+// serviceMetrics collects the metrics of the ECS service of the task, returning false if the task doesn't belong to a
+// service.
+func (aecmr *awsEcsContainerMetricsReceiver) serviceMetrics(ctx context.Context, metadata ecsutil.TaskMetadata) (pmetric.Metrics, bool, error) {
+	if aecmr.ecsClient == nil {
+		client, err := aecmr.newECSClient(metadata)
+		if err != nil {
+			return pmetric.Metrics{}, false, err
+		}
+		aecmr.ecsClient = client
+	}
+	return awsecscontainermetrics.ServiceMetricsData(ctx, aecmr.ecsClient, metadata)
+}
@@ -15,6 +15,7 @@ import (
 	"go.opentelemetry.io/collector/consumer/consumertest"
 	"go.uber.org/zap"
 
+	"github.com/open-telemetry/opentelemetry-collector-contrib/internal/aws/ecsutil"
 	"github.com/open-telemetry/opentelemetry-collector-contrib/internal/aws/ecsutil/ecsutiltest"
 	"github.com/open-telemetry/opentelemetry-collector-contrib/receiver/awsecscontainermetricsreceiver/internal/awsecscontainermetrics"
 )
@@ -92,6 +93,28 @@ func TestCollectDataFromEndpointWithConsumerError(t *testing.T) {
 	require.EqualError(t, err, "Test Error for Metrics Consumer")
 }
 
+func TestCollectDataFromEndpointWithServiceMetricsError(t *testing.T) {
+	cfg := createDefaultConfig().(*Config)
+	cfg.ServiceMetrics = true
+	sink := new(consumertest.MetricsSink)
+	metricsReceiver, err := newAWSECSContainermetrics(
+		zap.NewNop(),
+		cfg,
+		sink,
+		&fakeRestClient{},
+	)
+	require.NoError(t, err)
+
+	r := metricsReceiver.(*awsEcsContainerMetricsReceiver)
+	r.newECSClient = func(ecsutil.TaskMetadata) (awsecscontainermetrics.ECSClient, error) {
+		return nil, errors.New("no credentials")
+	}
+
+	// the metrics of the containers and the task are still reported
+	require.NoError(t, r.collectDataFromEndpoint(context.Background()))
+	require.NotEmpty(t, sink.AllMetrics())
+}
+
 type invalidFakeClient struct {
 }
 
@@ -5,18 +5,17 @@ package awsfirehosereceiver // import "github.com/open-telemetry/opentelemetry-c
 
 import (
 	"context"
-	"errors"
 
 	"go.opentelemetry.io/collector/component"
 	"go.opentelemetry.io/collector/config/confighttp"
 	"go.opentelemetry.io/collector/consumer"
 	"go.opentelemetry.io/collector/receiver"
-	"go.uber.org/zap"
 
+	"github.com/open-telemetry/opentelemetry-collector-contrib/internal/aws/firehose"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/internal/aws/firehose/unmarshaler/cwmetricstream"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/internal/aws/firehose/unmarshaler/otlpmetricstream"
 	"github.com/open-telemetry/opentelemetry-collector-contrib/internal/common/localhostgate"
 	"github.com/open-telemetry/opentelemetry-collector-contrib/receiver/awsfirehosereceiver/internal/metadata"
-	"github.com/open-telemetry/opentelemetry-collector-contrib/receiver/awsfirehosereceiver/internal/unmarshaler"
-	"github.com/open-telemetry/opentelemetry-collector-contrib/receiver/awsfirehosereceiver/internal/unmarshaler/cwmetricstream"
 )
 
 const (
@@ -25,12 +24,10 @@ const (
 	defaultPort       = 4433
 )
 
-var (
-	errUnrecognizedRecordType = errors.New("unrecognized record type")
-	availableRecordTypes      = map[string]bool{
-		cwmetricstream.TypeStr: true,
-	}
-)
+var availableRecordTypes = map[string]bool{
+	cwmetricstream.TypeStr:   true,
+	otlpmetricstream.TypeStr: true,
+}
 
 // NewFactory creates a receiver factory for awsfirehose. Currently, only
 // available in metrics pipelines.
@@ -45,20 +42,11 @@ func NewFactory() receiver.Factory {
 // passed in one and returns an error if not found.
 func validateRecordType(recordType string) error {
 	if _, ok := availableRecordTypes[recordType]; !ok {
-		return errUnrecognizedRecordType
+		return firehose.ErrUnrecognizedRecordType
 	}
 	return nil
 }
 
-// defaultMetricsUnmarshalers creates a map of the available metrics
-// unmarshalers.
-func defaultMetricsUnmarshalers(logger *zap.Logger) map[string]unmarshaler.MetricsUnmarshaler {
-	cwmsu := cwmetricstream.NewUnmarshaler(logger)
-	return map[string]unmarshaler.MetricsUnmarshaler{
-		cwmsu.Type(): cwmsu,
-	}
-}
-
 // createDefaultConfig creates a default config with the endpoint set
 // to port 8443 and the record type set to the CloudWatch metric stream.
 func createDefaultConfig() component.Config {
@@ -77,5 +65,7 @@ func createMetricsReceiver(
 	cfg component.Config,
 	nextConsumer consumer.Metrics,
 ) (receiver.Metrics, error) {
-	return newMetricsReceiver(cfg.(*Config), set, defaultMetricsUnmarshalers(set.Logger), nextConsumer)
+	c := cfg.(*Config)
+	firehoseCfg := &firehose.Config{ServerConfig: c.ServerConfig, RecordType: c.RecordType, AccessKey: c.AccessKey}
+	return firehose.NewMetricsReceiver(firehoseCfg, set, firehose.DefaultMetricsUnmarshalers(set.Logger), nextConsumer)
 }
@@ -0,0 +1,153 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package azureeventhubreceiver // import "github.com/open-telemetry/opentelemetry-collector-contrib/receiver/azureeventhubreceiver"
+
+import (
+	"context"
+	"errors"
+	"math/rand"
+	"sort"
+	"time"
+
+	"go.uber.org/zap"
+)
+
+// ownershipStore persists the ownership of partitions shared by the collectors reading an event hub consumer group
+type ownershipStore interface {
+	listOwnership(ctx context.Context) ([]ownership, error)
+	claimOwnership(ctx context.Context, claim ownership) (ownership, error)
+}
+
+// partitionBalancer spreads the partitions of an event hub evenly across the collectors sharing an ownership store.
+// Each collector renews the ownership of its partitions and claims at most one more partition per cycle,
+// either an unowned one or one of an owner having more than its share, so ownership converges without
+// collectors repeatedly stealing partitions from each other.
+type partitionBalancer struct {
+	store        ownershipStore
+	ownerID      string
+	partitionIDs []string
+	expiration   time.Duration
+	logger       *zap.Logger
+	now          func() time.Time
+}
+
+// balance renews the ownership of the partitions owned by this collector, claims another one if it owns less
+// than its share, and returns the partitions it owns.
+func (b *partitionBalancer) balance(ctx context.Context) ([]string, error) {
+	ownerships, err := b.store.listOwnership(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	renew, claim := b.plan(ownerships)
+	if claim != nil {
+		renew = append(renew, *claim)
+	}
+
+	var owned []string
+	for _, o := range renew {
+		o.ownerID = b.ownerID
+		if _, err = b.store.claimOwnership(ctx, o); err != nil {
+			if !errors.Is(err, errOwnershipLost) {
+				b.logger.Warn("Failed to claim partition ownership", zap.String("partition", o.partitionID), zap.Error(err))
+			}
+			continue
+		}
+		owned = append(owned, o.partitionID)
+	}
+	sort.Strings(owned)
+	return owned, nil
+}
+
+// release gives up the ownership of the partitions owned by this collector so other collectors can claim them
+// without waiting for the ownership to expire.
+func (b *partitionBalancer) release(ctx context.Context) error {
+	ownerships, err := b.store.listOwnership(ctx)
+	if err != nil {
+		return err
+	}
+	var errs error
+	for _, o := range ownerships {
+		if o.ownerID != b.ownerID {
+			continue
+		}
+		o.ownerID = ""
+		if _, err = b.store.claimOwnership(ctx, o); err != nil && !errors.Is(err, errOwnershipLost) {
+			errs = errors.Join(errs, err)
+		}
+	}
+	return errs
+}
+
+// plan returns the ownerships this collector must renew and the one it must claim, if any.
+func (b *partitionBalancer) plan(ownerships []ownership) ([]ownership, *ownership) {
+	current := map[string]ownership{}
+	for _, o := range ownerships {
+		current[o.partitionID] = o
+	}
+
+	now := b.now()
+	var mine, available []ownership
+	byOwner := map[string][]ownership{b.ownerID: nil}
+	for _, partitionID := range b.partitionIDs {
+		o, ok := current[partitionID]
+		switch {
+		case !ok:
+			available = append(available, ownership{partitionID: partitionID})
+		case o.ownerID == b.ownerID:
+			mine = append(mine, o)
+			byOwner[o.ownerID] = append(byOwner[o.ownerID], o)
+		case o.ownerID == "" || now.Sub(o.lastModified) >= b.expiration:
+			available = append(available, o)
+		default:
+			byOwner[o.ownerID] = append(byOwner[o.ownerID], o)
+		}
+	}
+
+	minShare := len(b.partitionIDs) / len(byOwner)
+	maxShare := minShare
+	if len(b.partitionIDs)%len(byOwner) != 0 {
+		maxShare++
+	}
+
+	// owners with the maximum share of partitions, the ones allowed to get it
+	ownersAtMax := 0
+	for _, owned := range byOwner {
+		if len(owned) >= maxShare {
+			ownersAtMax++
+		}
+	}
+	allowedAtMax := len(b.partitionIDs) % len(byOwner)
+	if allowedAtMax == 0 {
+		allowedAtMax = len(byOwner)
+	}
+
+	switch {
+	case len(mine) < minShare:
+	case len(mine) == minShare && maxShare > minShare && ownersAtMax < allowedAtMax:
+	default:
+		return mine, nil
+	}
+
+	if len(available) > 0 {
+		claim := available[rand.Intn(len(available))] // nolint:gosec
+		return mine, &claim
+	}
+
+	// steal from the owner with the most partitions when it owns more than its share
+	var victim []ownership
+	for ownerID, owned := range byOwner {
+		if ownerID == b.ownerID {
+			continue
+		}
+		if len(owned) > len(victim) || (len(owned) == len(victim) && len(owned) > 0 && owned[0].ownerID < victim[0].ownerID) {
+			victim = owned
+		}
+	}
+	if len(victim) > maxShare || (len(mine) < minShare && len(victim) > minShare) {
+		claim := victim[rand.Intn(len(victim))] // nolint:gosec
+		return mine, &claim
+	}
+	return mine, nil
+}
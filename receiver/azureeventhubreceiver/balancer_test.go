@@ -0,0 +1,164 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package azureeventhubreceiver // import "github.com/open-telemetry/opentelemetry-collector-contrib/receiver/azureeventhubreceiver"
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+)
+
+func newTestBalancer(store ownershipStore, ownerID string, partitionIDs []string, now func() time.Time) *partitionBalancer {
+	return &partitionBalancer{
+		store:        store,
+		ownerID:      ownerID,
+		partitionIDs: partitionIDs,
+		expiration:   time.Minute,
+		logger:       zap.NewNop(),
+		now:          now,
+	}
+}
+
+func TestPartitionBalancerConverges(t *testing.T) {
+	testCases := []struct {
+		name       string
+		partitions []string
+		owners     []string
+		wantShares []int
+	}{
+		{
+			name:       "single owner",
+			partitions: []string{"0", "1", "2", "3"},
+			owners:     []string{"a"},
+			wantShares: []int{4},
+		},
+		{
+			name:       "even",
+			partitions: []string{"0", "1", "2", "3"},
+			owners:     []string{"a", "b"},
+			wantShares: []int{2, 2},
+		},
+		{
+			name:       "uneven",
+			partitions: []string{"0", "1", "2", "3", "4"},
+			owners:     []string{"a", "b", "c"},
+			wantShares: []int{1, 2, 2},
+		},
+		{
+			name:       "more owners than partitions",
+			partitions: []string{"0", "1"},
+			owners:     []string{"a", "b", "c"},
+			wantShares: []int{0, 1, 1},
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			client := newMockBlobClient()
+			store := newBlobCheckpointStore(client, "namespace", "hub", "$Default")
+			var balancers []*partitionBalancer
+			for _, owner := range tc.owners {
+				balancers = append(balancers, newTestBalancer(store, owner, tc.partitions, time.Now))
+			}
+
+			owned := map[string][]string{}
+			for cycle := 0; cycle < 3*len(tc.partitions); cycle++ {
+				for _, b := range balancers {
+					partitions, err := b.balance(context.Background())
+					require.NoError(t, err)
+					owned[b.ownerID] = partitions
+				}
+			}
+
+			seen := map[string]bool{}
+			var shares []int
+			for _, partitions := range owned {
+				shares = append(shares, len(partitions))
+				for _, partition := range partitions {
+					assert.False(t, seen[partition], "partition %s owned twice", partition)
+					seen[partition] = true
+				}
+			}
+			assert.Len(t, seen, len(tc.partitions))
+			assert.ElementsMatch(t, tc.wantShares, shares)
+		})
+	}
+}
+
+func TestPartitionBalancerNewOwnerTakesItsShare(t *testing.T) {
+	store := newBlobCheckpointStore(newMockBlobClient(), "namespace", "hub", "$Default")
+	partitions := []string{"0", "1", "2", "3"}
+	a := newTestBalancer(store, "a", partitions, time.Now)
+	for i := 0; i < len(partitions); i++ {
+		owned, err := a.balance(context.Background())
+		require.NoError(t, err)
+		assert.Len(t, owned, i+1)
+	}
+
+	b := newTestBalancer(store, "b", partitions, time.Now)
+	for i := 0; i < len(partitions); i++ {
+		_, err := a.balance(context.Background())
+		require.NoError(t, err)
+		_, err = b.balance(context.Background())
+		require.NoError(t, err)
+	}
+	ownedByA, err := a.balance(context.Background())
+	require.NoError(t, err)
+	ownedByB, err := b.balance(context.Background())
+	require.NoError(t, err)
+	assert.Len(t, ownedByA, 2)
+	assert.Len(t, ownedByB, 2)
+}
+
+func TestPartitionBalancerClaimsExpiredOwnership(t *testing.T) {
+	client := newMockBlobClient()
+	store := newBlobCheckpointStore(client, "namespace", "hub", "$Default")
+	now := time.Now()
+	client.now = func() time.Time { return now }
+
+	a := newTestBalancer(store, "a", []string{"0"}, func() time.Time { return now })
+	owned, err := a.balance(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, []string{"0"}, owned)
+
+	// b can't claim the partition while a renews it
+	b := newTestBalancer(store, "b", []string{"0"}, func() time.Time { return now.Add(30 * time.Second) })
+	owned, err = b.balance(context.Background())
+	require.NoError(t, err)
+	assert.Empty(t, owned)
+
+	// a stopped without releasing its partition
+	b.now = func() time.Time { return now.Add(2 * time.Minute) }
+	owned, err = b.balance(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, []string{"0"}, owned)
+
+	// a lost its partition
+	owned, err = a.balance(context.Background())
+	require.NoError(t, err)
+	assert.Empty(t, owned)
+}
+
+func TestPartitionBalancerRelease(t *testing.T) {
+	store := newBlobCheckpointStore(newMockBlobClient(), "namespace", "hub", "$Default")
+	a := newTestBalancer(store, "a", []string{"0"}, time.Now)
+	owned, err := a.balance(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, []string{"0"}, owned)
+
+	require.NoError(t, a.release(context.Background()))
+	ownerships, err := store.listOwnership(context.Background())
+	require.NoError(t, err)
+	require.Len(t, ownerships, 1)
+	assert.Empty(t, ownerships[0].ownerID)
+
+	// released partitions are claimed without waiting for their ownership to expire
+	b := newTestBalancer(store, "b", []string{"0"}, time.Now)
+	owned, err = b.balance(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, []string{"0"}, owned)
+}
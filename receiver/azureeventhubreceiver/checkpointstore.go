@@ -0,0 +1,292 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package azureeventhubreceiver // import "github.com/open-telemetry/opentelemetry-collector-contrib/receiver/azureeventhubreceiver"
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/Azure/azure-event-hubs-go/v3/persist"
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore"
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore/streaming"
+	"github.com/Azure/azure-sdk-for-go/sdk/storage/azblob/blob"
+	"github.com/Azure/azure-sdk-for-go/sdk/storage/azblob/bloberror"
+	"github.com/Azure/azure-sdk-for-go/sdk/storage/azblob/blockblob"
+	"github.com/Azure/azure-sdk-for-go/sdk/storage/azblob/container"
+)
+
+const (
+	checkpointBlobFormat = "%s/%s/%s/checkpoint/%s"
+	ownershipBlobFormat  = "%s/%s/%s/ownership/%s"
+
+	ownerIDMetadata        = "ownerid"
+	offsetMetadata         = "offset"
+	sequenceNumberMetadata = "sequencenumber"
+	enqueueTimeMetadata    = "enqueuetime"
+)
+
+// errOwnershipLost is returned when an ownership blob was updated by another collector since it was read
+var errOwnershipLost = errors.New("partition ownership was claimed by another owner")
+
+// blobItem is a blob of the checkpoint store with the properties used for checkpointing and load balancing
+type blobItem struct {
+	name         string
+	metadata     map[string]string
+	etag         string
+	lastModified time.Time
+}
+
+// blobClient stores the checkpoint and ownership blobs of a container
+type blobClient interface {
+	// list returns the blobs whose name starts with prefix
+	list(ctx context.Context, prefix string) ([]blobItem, error)
+	// upload creates or replaces an empty blob with the given metadata.
+	// The blob must not exist if etag is empty, or have the given etag otherwise, when conditional is true.
+	upload(ctx context.Context, name string, metadata map[string]string, etag string, conditional bool) (blobItem, error)
+}
+
+type azblobClient struct {
+	client *container.Client
+}
+
+func newAzblobClient(cfg *CheckpointStoreConfig) (*azblobClient, error) {
+	client, err := container.NewClientFromConnectionString(cfg.Connection, cfg.Container, nil)
+	if err != nil {
+		return nil, err
+	}
+	return &azblobClient{client: client}, nil
+}
+
+func (c *azblobClient) list(ctx context.Context, prefix string) ([]blobItem, error) {
+	var items []blobItem
+	pager := c.client.NewListBlobsFlatPager(&container.ListBlobsFlatOptions{
+		Include: container.ListBlobsInclude{Metadata: true},
+		Prefix:  &prefix,
+	})
+	for pager.More() {
+		page, err := pager.NextPage(ctx)
+		if err != nil {
+			return nil, err
+		}
+		for _, item := range page.Segment.BlobItems {
+			if item.Name == nil || item.Properties == nil {
+				continue
+			}
+			blob := blobItem{name: *item.Name, metadata: map[string]string{}}
+			for key, value := range item.Metadata {
+				if value != nil {
+					blob.metadata[strings.ToLower(key)] = *value
+				}
+			}
+			if item.Properties.ETag != nil {
+				blob.etag = string(*item.Properties.ETag)
+			}
+			if item.Properties.LastModified != nil {
+				blob.lastModified = *item.Properties.LastModified
+			}
+			items = append(items, blob)
+		}
+	}
+	return items, nil
+}
+
+func (c *azblobClient) upload(ctx context.Context, name string, metadata map[string]string, etag string, conditional bool) (blobItem, error) {
+	options := &blockblob.UploadOptions{Metadata: map[string]*string{}}
+	for key, value := range metadata {
+		value := value
+		options.Metadata[key] = &value
+	}
+	if conditional {
+		conditions := &blob.ModifiedAccessConditions{}
+		if etag == "" {
+			conditions.IfNoneMatch = to(azcore.ETagAny)
+		} else {
+			conditions.IfMatch = to(azcore.ETag(etag))
+		}
+		options.AccessConditions = &blob.AccessConditions{ModifiedAccessConditions: conditions}
+	}
+
+	resp, err := c.client.NewBlockBlobClient(name).Upload(ctx, streaming.NopCloser(bytes.NewReader(nil)), options)
+	if err != nil {
+		if bloberror.HasCode(err, bloberror.ConditionNotMet, bloberror.BlobAlreadyExists) {
+			return blobItem{}, errOwnershipLost
+		}
+		return blobItem{}, err
+	}
+
+	uploaded := blobItem{name: name, metadata: metadata}
+	if resp.ETag != nil {
+		uploaded.etag = string(*resp.ETag)
+	}
+	if resp.LastModified != nil {
+		uploaded.lastModified = *resp.LastModified
+	}
+	return uploaded, nil
+}
+
+func to[T any](value T) *T {
+	return &value
+}
+
+// ownership is the claim of a collector on a partition, renewed while the collector receives its events
+type ownership struct {
+	partitionID  string
+	ownerID      string
+	etag         string
+	lastModified time.Time
+}
+
+// blobCheckpointStore persists the checkpoints and ownership of partitions as blobs of an Azure Blob Storage container,
+// using the same layout as the checkpoint store of the Event Processor of the Azure SDKs.
+// Checkpoints are kept in memory as events are received and written to the container by flush.
+type blobCheckpointStore struct {
+	client    blobClient
+	namespace string
+	hubName   string
+	group     string
+
+	mu      sync.Mutex
+	pending map[string]persist.Checkpoint
+}
+
+func newBlobCheckpointStore(client blobClient, namespace, hubName, group string) *blobCheckpointStore {
+	return &blobCheckpointStore{
+		client:    client,
+		namespace: strings.ToLower(namespace),
+		hubName:   strings.ToLower(hubName),
+		group:     strings.ToLower(group),
+		pending:   map[string]persist.Checkpoint{},
+	}
+}
+
+// Write records the checkpoint of a partition, to be written to the container by the next flush.
+func (s *blobCheckpointStore) Write(namespace, name, consumerGroup, partitionID string, checkpoint persist.Checkpoint) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.pending[s.checkpointBlobName(namespace, name, consumerGroup, partitionID)] = checkpoint
+	return nil
+}
+
+// Read returns the checkpoint of a partition, or a checkpoint at the end of the stream if the partition has none.
+func (s *blobCheckpointStore) Read(namespace, name, consumerGroup, partitionID string) (persist.Checkpoint, error) {
+	blobName := s.checkpointBlobName(namespace, name, consumerGroup, partitionID)
+
+	s.mu.Lock()
+	checkpoint, ok := s.pending[blobName]
+	s.mu.Unlock()
+	if ok {
+		return checkpoint, nil
+	}
+
+	items, err := s.client.list(context.Background(), blobName)
+	if err != nil {
+		return persist.NewCheckpointFromEndOfStream(), err
+	}
+	for _, item := range items {
+		if item.name == blobName {
+			return checkpointFromMetadata(item.metadata)
+		}
+	}
+	return persist.NewCheckpointFromEndOfStream(), nil
+}
+
+// checkpoint returns the checkpoint of a partition of the event hub consumer group.
+func (s *blobCheckpointStore) checkpoint(partitionID string) (persist.Checkpoint, error) {
+	return s.Read(s.namespace, s.hubName, s.group, partitionID)
+}
+
+// flush writes the checkpoints received since the last flush to the container.
+func (s *blobCheckpointStore) flush(ctx context.Context) error {
+	s.mu.Lock()
+	pending := s.pending
+	s.pending = map[string]persist.Checkpoint{}
+	s.mu.Unlock()
+
+	var errs error
+	for blobName, checkpoint := range pending {
+		if _, err := s.client.upload(ctx, blobName, checkpointMetadata(checkpoint), "", false); err != nil {
+			errs = errors.Join(errs, fmt.Errorf("failed to write checkpoint %q: %w", blobName, err))
+			// keep the checkpoint for the next flush unless a newer one was received meanwhile
+			s.mu.Lock()
+			if _, ok := s.pending[blobName]; !ok {
+				s.pending[blobName] = checkpoint
+			}
+			s.mu.Unlock()
+		}
+	}
+	return errs
+}
+
+// listOwnership returns the ownership of the partitions of the event hub consumer group.
+func (s *blobCheckpointStore) listOwnership(ctx context.Context) ([]ownership, error) {
+	prefix := fmt.Sprintf(ownershipBlobFormat, s.namespace, s.hubName, s.group, "")
+	items, err := s.client.list(ctx, prefix)
+	if err != nil {
+		return nil, err
+	}
+	ownerships := make([]ownership, 0, len(items))
+	for _, item := range items {
+		ownerships = append(ownerships, ownership{
+			partitionID:  strings.TrimPrefix(item.name, prefix),
+			ownerID:      item.metadata[ownerIDMetadata],
+			etag:         item.etag,
+			lastModified: item.lastModified,
+		})
+	}
+	return ownerships, nil
+}
+
+// claimOwnership sets the owner of a partition if its ownership did not change since it was listed,
+// which also renews the ownership when the owner is unchanged. errOwnershipLost is returned otherwise.
+func (s *blobCheckpointStore) claimOwnership(ctx context.Context, claim ownership) (ownership, error) {
+	blobName := fmt.Sprintf(ownershipBlobFormat, s.namespace, s.hubName, s.group, claim.partitionID)
+	item, err := s.client.upload(ctx, blobName, map[string]string{ownerIDMetadata: claim.ownerID}, claim.etag, true)
+	if err != nil {
+		return ownership{}, err
+	}
+	claim.etag = item.etag
+	claim.lastModified = item.lastModified
+	return claim, nil
+}
+
+func (s *blobCheckpointStore) checkpointBlobName(namespace, name, consumerGroup, partitionID string) string {
+	return fmt.Sprintf(checkpointBlobFormat, strings.ToLower(namespace), strings.ToLower(name), strings.ToLower(consumerGroup), partitionID)
+}
+
+func checkpointMetadata(checkpoint persist.Checkpoint) map[string]string {
+	return map[string]string{
+		offsetMetadata:         checkpoint.Offset,
+		sequenceNumberMetadata: strconv.FormatInt(checkpoint.SequenceNumber, 10),
+		enqueueTimeMetadata:    checkpoint.EnqueueTime.UTC().Format(time.RFC3339Nano),
+	}
+}
+
+func checkpointFromMetadata(metadata map[string]string) (persist.Checkpoint, error) {
+	offset, ok := metadata[offsetMetadata]
+	if !ok {
+		return persist.NewCheckpointFromEndOfStream(), fmt.Errorf("checkpoint has no %s", offsetMetadata)
+	}
+	checkpoint := persist.Checkpoint{Offset: offset}
+	if value, ok := metadata[sequenceNumberMetadata]; ok {
+		sequenceNumber, err := strconv.ParseInt(value, 10, 64)
+		if err != nil {
+			return persist.NewCheckpointFromEndOfStream(), fmt.Errorf("invalid checkpoint %s: %w", sequenceNumberMetadata, err)
+		}
+		checkpoint.SequenceNumber = sequenceNumber
+	}
+	if value, ok := metadata[enqueueTimeMetadata]; ok {
+		enqueueTime, err := time.Parse(time.RFC3339Nano, value)
+		if err != nil {
+			return persist.NewCheckpointFromEndOfStream(), fmt.Errorf("invalid checkpoint %s: %w", enqueueTimeMetadata, err)
+		}
+		checkpoint.EnqueueTime = enqueueTime
+	}
+	return checkpoint, nil
+}
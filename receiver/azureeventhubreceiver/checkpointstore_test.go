@@ -0,0 +1,126 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package azureeventhubreceiver // import "github.com/open-telemetry/opentelemetry-collector-contrib/receiver/azureeventhubreceiver"
+
+import (
+	"context"
+	"strconv"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/Azure/azure-event-hubs-go/v3/persist"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// mockBlobClient is an in memory blob container
+type mockBlobClient struct {
+	mu      sync.Mutex
+	blobs   map[string]blobItem
+	version int
+	now     func() time.Time
+}
+
+func newMockBlobClient() *mockBlobClient {
+	return &mockBlobClient{blobs: map[string]blobItem{}, now: time.Now}
+}
+
+func (m *mockBlobClient) list(_ context.Context, prefix string) ([]blobItem, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	var items []blobItem
+	for name, item := range m.blobs {
+		if strings.HasPrefix(name, prefix) {
+			items = append(items, item)
+		}
+	}
+	return items, nil
+}
+
+func (m *mockBlobClient) upload(_ context.Context, name string, metadata map[string]string, etag string, conditional bool) (blobItem, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	current, exists := m.blobs[name]
+	if conditional && ((etag == "" && exists) || (etag != "" && current.etag != etag)) {
+		return blobItem{}, errOwnershipLost
+	}
+	m.version++
+	item := blobItem{name: name, metadata: metadata, etag: strconv.Itoa(m.version), lastModified: m.now()}
+	m.blobs[name] = item
+	return item, nil
+}
+
+func TestBlobCheckpointStoreUnknownCheckpoint(t *testing.T) {
+	store := newBlobCheckpointStore(newMockBlobClient(), "namespace", "hub", "$Default")
+	checkpoint, err := store.Read("namespace", "hub", "$Default", "0")
+	require.NoError(t, err)
+	assert.Equal(t, persist.NewCheckpointFromEndOfStream().Offset, checkpoint.Offset)
+}
+
+func TestBlobCheckpointStoreWithKnownCheckpoint(t *testing.T) {
+	client := newMockBlobClient()
+	store := newBlobCheckpointStore(client, "Namespace", "Hub", "$Default")
+	checkpoint := persist.Checkpoint{
+		Offset:         "1234",
+		SequenceNumber: 2,
+		EnqueueTime:    time.Now(),
+	}
+	require.NoError(t, store.Write("Namespace", "Hub", "$Default", "0", checkpoint))
+
+	// checkpoints are only written to the container on flush
+	assert.Empty(t, client.blobs)
+	read, err := store.checkpoint("0")
+	require.NoError(t, err)
+	assert.Equal(t, checkpoint.Offset, read.Offset)
+
+	require.NoError(t, store.flush(context.Background()))
+	assert.Contains(t, client.blobs, "namespace/hub/$default/checkpoint/0")
+
+	// a collector restarting with the same container resumes from the checkpoint
+	restarted := newBlobCheckpointStore(client, "Namespace", "Hub", "$Default")
+	read, err = restarted.Read("Namespace", "Hub", "$Default", "0")
+	require.NoError(t, err)
+	assert.Equal(t, checkpoint.Offset, read.Offset)
+	assert.Equal(t, checkpoint.SequenceNumber, read.SequenceNumber)
+	assert.True(t, checkpoint.EnqueueTime.Equal(read.EnqueueTime))
+}
+
+func TestBlobCheckpointStoreInvalidCheckpoint(t *testing.T) {
+	client := newMockBlobClient()
+	_, err := client.upload(context.Background(), "namespace/hub/$default/checkpoint/0", map[string]string{offsetMetadata: "1", sequenceNumberMetadata: "foo"}, "", false)
+	require.NoError(t, err)
+
+	store := newBlobCheckpointStore(client, "namespace", "hub", "$Default")
+	_, err = store.checkpoint("0")
+	assert.ErrorContains(t, err, "invalid checkpoint sequencenumber")
+}
+
+func TestBlobCheckpointStoreClaimOwnership(t *testing.T) {
+	store := newBlobCheckpointStore(newMockBlobClient(), "namespace", "hub", "$Default")
+	ctx := context.Background()
+
+	claimed, err := store.claimOwnership(ctx, ownership{partitionID: "0", ownerID: "a"})
+	require.NoError(t, err)
+	assert.NotEmpty(t, claimed.etag)
+
+	// a claim based on an outdated ownership fails
+	_, err = store.claimOwnership(ctx, ownership{partitionID: "0", ownerID: "b"})
+	assert.ErrorIs(t, err, errOwnershipLost)
+
+	renewed, err := store.claimOwnership(ctx, claimed)
+	require.NoError(t, err)
+	assert.NotEqual(t, claimed.etag, renewed.etag)
+
+	_, err = store.claimOwnership(ctx, claimed)
+	assert.ErrorIs(t, err, errOwnershipLost)
+
+	ownerships, err := store.listOwnership(ctx)
+	require.NoError(t, err)
+	require.Len(t, ownerships, 1)
+	assert.Equal(t, "0", ownerships[0].partitionID)
+	assert.Equal(t, "a", ownerships[0].ownerID)
+	assert.Equal(t, renewed.etag, ownerships[0].etag)
+}
@@ -6,6 +6,7 @@ package azureeventhubreceiver // import "github.com/open-telemetry/opentelemetry
 import (
 	"errors"
 	"fmt"
+	"time"
 
 	"github.com/Azure/azure-amqp-common-go/v4/conn"
 	"go.opentelemetry.io/collector/component"
@@ -19,9 +20,21 @@ const (
 	azureLogFormat   logFormat = "azure"
 )
 
+const (
+	defaultLoadBalancingInterval = 10 * time.Second
+	defaultOwnershipExpiration   = time.Minute
+)
+
 var (
 	validFormats         = []logFormat{defaultLogFormat, rawLogFormat, azureLogFormat}
 	errMissingConnection = errors.New("missing connection")
+
+	errMissingCheckpointStoreConnection = errors.New("missing checkpoint_store connection")
+	errMissingCheckpointStoreContainer  = errors.New("missing checkpoint_store container")
+	errCheckpointStoreWithPartition     = errors.New("partition cannot be set with checkpoint_store, partitions are balanced across collectors")
+	errCheckpointStoreWithStorage       = errors.New("storage cannot be set with checkpoint_store, checkpoints are persisted in the checkpoint_store")
+	errInvalidLoadBalancingInterval     = errors.New("checkpoint_store load_balancing_interval cannot be negative")
+	errInvalidOwnershipExpiration       = errors.New("checkpoint_store ownership_expiration must be greater than load_balancing_interval")
 )
 
 type Config struct {
@@ -31,6 +44,23 @@ type Config struct {
 	StorageID     *component.ID `mapstructure:"storage"`
 	Format        string        `mapstructure:"format"`
 	ConsumerGroup string        `mapstructure:"group"`
+	// CheckpointStore persists checkpoints in an Azure Blob Storage container and balances
+	// the partitions across the collectors sharing it.
+	CheckpointStore *CheckpointStoreConfig `mapstructure:"checkpoint_store"`
+}
+
+// CheckpointStoreConfig defines the Azure Blob Storage container used to persist checkpoints and partition ownership.
+type CheckpointStoreConfig struct {
+	// Connection is the connection string of the Azure Storage account
+	Connection string `mapstructure:"connection"`
+	// Container is the name of the blob container, which must exist
+	Container string `mapstructure:"container"`
+	// LoadBalancingInterval is the interval at which partitions are balanced, ownership renewed and checkpoints written.
+	// Defaults to 10s.
+	LoadBalancingInterval time.Duration `mapstructure:"load_balancing_interval"`
+	// OwnershipExpiration is the duration after which the partitions of a collector that stopped renewing them can be claimed.
+	// Defaults to 1m.
+	OwnershipExpiration time.Duration `mapstructure:"ownership_expiration"`
 }
 
 func isValidFormat(format string) bool {
@@ -53,5 +83,44 @@ func (config *Config) Validate() error {
 	if !isValidFormat(config.Format) {
 		return fmt.Errorf("invalid format; must be one of %#v", validFormats)
 	}
+	if config.CheckpointStore != nil {
+		if config.Partition != "" {
+			return errCheckpointStoreWithPartition
+		}
+		if config.StorageID != nil {
+			return errCheckpointStoreWithStorage
+		}
+	}
 	return nil
 }
+
+// Validate checkpoint store config
+func (config *CheckpointStoreConfig) Validate() error {
+	if config.Connection == "" {
+		return errMissingCheckpointStoreConnection
+	}
+	if config.Container == "" {
+		return errMissingCheckpointStoreContainer
+	}
+	if config.LoadBalancingInterval < 0 {
+		return errInvalidLoadBalancingInterval
+	}
+	if config.OwnershipExpiration != 0 && config.OwnershipExpiration <= config.loadBalancingInterval() {
+		return errInvalidOwnershipExpiration
+	}
+	return nil
+}
+
+func (config *CheckpointStoreConfig) loadBalancingInterval() time.Duration {
+	if config.LoadBalancingInterval == 0 {
+		return defaultLoadBalancingInterval
+	}
+	return config.LoadBalancingInterval
+}
+
+func (config *CheckpointStoreConfig) ownershipExpiration() time.Duration {
+	if config.OwnershipExpiration == 0 {
+		return defaultOwnershipExpiration
+	}
+	return config.OwnershipExpiration
+}
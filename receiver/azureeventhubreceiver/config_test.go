@@ -6,6 +6,7 @@ package azureeventhubreceiver // import "github.com/open-telemetry/opentelemetry
 import (
 	"path/filepath"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -26,7 +27,7 @@ func TestLoadConfig(t *testing.T) {
 	require.NoError(t, err)
 	require.NotNil(t, cfg)
 
-	assert.Equal(t, len(cfg.Receivers), 2)
+	assert.Equal(t, len(cfg.Receivers), 3)
 
 	r0 := cfg.Receivers[component.NewID(metadata.Type)]
 	assert.Equal(t, "Endpoint=sb://namespace.servicebus.windows.net/;SharedAccessKeyName=RootManageSharedAccessKey;SharedAccessKey=superSecret1234=;EntityPath=hubName", r0.(*Config).Connection)
@@ -39,6 +40,16 @@ func TestLoadConfig(t *testing.T) {
 	assert.Equal(t, "1234-5566", r1.(*Config).Offset)
 	assert.Equal(t, "foo", r1.(*Config).Partition)
 	assert.Equal(t, rawLogFormat, logFormat(r1.(*Config).Format))
+
+	r2 := cfg.Receivers[component.NewIDWithName(metadata.Type, "checkpoint_store")]
+	assert.Equal(t, "collectors", r2.(*Config).ConsumerGroup)
+	assert.Equal(t, &CheckpointStoreConfig{
+		Connection:            "DefaultEndpointsProtocol=https;AccountName=account;AccountKey=key;EndpointSuffix=core.windows.net",
+		Container:             "checkpoints",
+		LoadBalancingInterval: 5 * time.Second,
+	}, r2.(*Config).CheckpointStore)
+	assert.Equal(t, 5*time.Second, r2.(*Config).CheckpointStore.loadBalancingInterval())
+	assert.Equal(t, defaultOwnershipExpiration, r2.(*Config).CheckpointStore.ownershipExpiration())
 }
 
 func TestMissingConnection(t *testing.T) {
@@ -71,3 +82,55 @@ func TestInvalidFormat(t *testing.T) {
 	err := component.ValidateConfig(cfg)
 	assert.ErrorContains(t, err, "invalid format; must be one of")
 }
+
+func TestInvalidCheckpointStore(t *testing.T) {
+	storageID := component.MustNewID("file_storage")
+	testCases := []struct {
+		name      string
+		configure func(cfg *Config)
+		wantErr   error
+	}{
+		{
+			name:      "missing connection",
+			configure: func(cfg *Config) { cfg.CheckpointStore.Connection = "" },
+			wantErr:   errMissingCheckpointStoreConnection,
+		},
+		{
+			name:      "missing container",
+			configure: func(cfg *Config) { cfg.CheckpointStore.Container = "" },
+			wantErr:   errMissingCheckpointStoreContainer,
+		},
+		{
+			name:      "with partition",
+			configure: func(cfg *Config) { cfg.Partition = "0" },
+			wantErr:   errCheckpointStoreWithPartition,
+		},
+		{
+			name:      "with storage",
+			configure: func(cfg *Config) { cfg.StorageID = &storageID },
+			wantErr:   errCheckpointStoreWithStorage,
+		},
+		{
+			name:      "negative load balancing interval",
+			configure: func(cfg *Config) { cfg.CheckpointStore.LoadBalancingInterval = -time.Second },
+			wantErr:   errInvalidLoadBalancingInterval,
+		},
+		{
+			name:      "ownership expiring before renewal",
+			configure: func(cfg *Config) { cfg.CheckpointStore.OwnershipExpiration = 5 * time.Second },
+			wantErr:   errInvalidOwnershipExpiration,
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			cfg := NewFactory().CreateDefaultConfig().(*Config)
+			cfg.Connection = "Endpoint=sb://namespace.servicebus.windows.net/;SharedAccessKeyName=RootManageSharedAccessKey;SharedAccessKey=superSecret1234=;EntityPath=hubName"
+			cfg.CheckpointStore = &CheckpointStoreConfig{
+				Connection: "DefaultEndpointsProtocol=https;AccountName=account;AccountKey=key;EndpointSuffix=core.windows.net",
+				Container:  "checkpoints",
+			}
+			tc.configure(cfg)
+			assert.ErrorIs(t, component.ValidateConfig(cfg), tc.wantErr)
+		})
+	}
+}
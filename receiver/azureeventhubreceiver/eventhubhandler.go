@@ -5,8 +5,14 @@ package azureeventhubreceiver // import "github.com/open-telemetry/opentelemetry
 
 import (
 	"context"
+	"errors"
+	"fmt"
+	"sync"
+	"time"
 
+	"github.com/Azure/azure-amqp-common-go/v4/conn"
 	eventhub "github.com/Azure/azure-event-hubs-go/v3"
+	"github.com/google/uuid"
 	"go.opentelemetry.io/collector/component"
 	"go.opentelemetry.io/collector/receiver"
 	"go.uber.org/zap"
@@ -38,6 +44,7 @@ func (h *hubWrapperImpl) Close(ctx context.Context) error {
 }
 
 type listerHandleWrapper interface {
+	Close(ctx context.Context) error
 	Done() <-chan struct{}
 	Err() error
 }
@@ -48,11 +55,21 @@ type eventhubHandler struct {
 	config       *Config
 	settings     receiver.CreateSettings
 	cancel       context.CancelFunc
+
+	// set when partitions are balanced across collectors sharing a checkpoint store
+	checkpointStore *blobCheckpointStore
+	balancer        *partitionBalancer
+	partitions      map[string]listerHandleWrapper
+	balancerWG      sync.WaitGroup
 }
 
 func (h *eventhubHandler) run(ctx context.Context, host component.Host) error {
 	ctx, h.cancel = context.WithCancel(ctx)
 
+	if h.config.CheckpointStore != nil {
+		return h.runBalanced(ctx)
+	}
+
 	storageClient, err := adapter.GetStorageClient(ctx, host, h.config.StorageID, h.settings.ID)
 	if err != nil {
 		h.settings.Logger.Debug("Error connecting to Storage", zap.Error(err))
@@ -135,9 +152,14 @@ func (h *eventhubHandler) setUpOnePartition(ctx context.Context, partitionID str
 		receiverOptions = append(receiverOptions, eventhub.ReceiveWithConsumerGroup(h.config.ConsumerGroup))
 	}
 
+	_, err := h.receive(ctx, partitionID, receiverOptions...)
+	return err
+}
+
+func (h *eventhubHandler) receive(ctx context.Context, partitionID string, receiverOptions ...eventhub.ReceiveOption) (listerHandleWrapper, error) {
 	handle, err := h.hub.Receive(ctx, partitionID, h.newMessageHandler, receiverOptions...)
 	if err != nil {
-		return err
+		return nil, err
 	}
 	go func() {
 		<-handle.Done()
@@ -147,9 +169,155 @@ func (h *eventhubHandler) setUpOnePartition(ctx context.Context, partitionID str
 		}
 	}()
 
+	return handle, nil
+}
+
+// runBalanced receives the events of the partitions owned by this collector in the checkpoint store,
+// starting from their last checkpoint, and balances the ownership of partitions until the handler is closed.
+func (h *eventhubHandler) runBalanced(ctx context.Context) error {
+	parsed, err := conn.ParsedConnectionFromStr(h.config.Connection)
+	if err != nil {
+		return err
+	}
+	group := h.config.ConsumerGroup
+	if group == "" {
+		group = eventhub.DefaultConsumerGroup
+	}
+
+	if h.checkpointStore == nil { // set manually for testing.
+		client, clientErr := newAzblobClient(h.config.CheckpointStore)
+		if clientErr != nil {
+			h.settings.Logger.Debug("Error connecting to checkpoint store", zap.Error(clientErr))
+			return clientErr
+		}
+		h.checkpointStore = newBlobCheckpointStore(client, parsed.Namespace, parsed.HubName, group)
+	}
+
+	if h.hub == nil { // set manually for testing.
+		hub, newHubErr := eventhub.NewHubFromConnectionString(h.config.Connection, eventhub.HubWithOffsetPersistence(h.checkpointStore))
+		if newHubErr != nil {
+			h.settings.Logger.Debug("Error connecting to Event Hub", zap.Error(newHubErr))
+			return newHubErr
+		}
+		h.hub = &hubWrapperImpl{
+			hub: hub,
+		}
+	}
+
+	runtimeInfo, err := h.hub.GetRuntimeInformation(ctx)
+	if err != nil {
+		h.settings.Logger.Debug("Error getting Runtime Information", zap.Error(err))
+		return err
+	}
+
+	h.balancer = &partitionBalancer{
+		store:        h.checkpointStore,
+		ownerID:      uuid.NewString(),
+		partitionIDs: runtimeInfo.PartitionIDs,
+		expiration:   h.config.CheckpointStore.ownershipExpiration(),
+		logger:       h.settings.Logger,
+		now:          time.Now,
+	}
+	h.partitions = map[string]listerHandleWrapper{}
+	h.rebalance(ctx)
+
+	h.balancerWG.Add(1)
+	go func() {
+		defer h.balancerWG.Done()
+		ticker := time.NewTicker(h.config.CheckpointStore.loadBalancingInterval())
+		defer ticker.Stop()
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+				h.rebalance(ctx)
+			}
+		}
+	}()
+
 	return nil
 }
 
+// rebalance updates the ownership of partitions, starts receiving the events of newly owned partitions
+// and stops receiving the events of partitions claimed by other collectors.
+func (h *eventhubHandler) rebalance(ctx context.Context) {
+	owned, err := h.balancer.balance(ctx)
+	if err != nil {
+		h.settings.Logger.Warn("Failed to balance partitions", zap.Error(err))
+		return
+	}
+
+	ownedSet := map[string]bool{}
+	for _, partitionID := range owned {
+		ownedSet[partitionID] = true
+	}
+	for partitionID, handle := range h.partitions {
+		if ownedSet[partitionID] {
+			continue
+		}
+		h.settings.Logger.Info("Partition claimed by another collector", zap.String("partition", partitionID))
+		if err = handle.Close(ctx); err != nil {
+			h.settings.Logger.Warn("Failed to close partition receiver", zap.String("partition", partitionID), zap.Error(err))
+		}
+		delete(h.partitions, partitionID)
+	}
+	for _, partitionID := range owned {
+		if _, ok := h.partitions[partitionID]; ok {
+			continue
+		}
+		if err = h.setUpBalancedPartition(ctx, partitionID); err != nil {
+			h.settings.Logger.Warn("Error setting up partition", zap.String("partition", partitionID), zap.Error(err))
+		}
+	}
+
+	if err = h.checkpointStore.flush(ctx); err != nil {
+		h.settings.Logger.Warn("Failed to write checkpoints", zap.Error(err))
+	}
+}
+
+func (h *eventhubHandler) setUpBalancedPartition(ctx context.Context, partitionID string) error {
+	checkpoint, err := h.checkpointStore.checkpoint(partitionID)
+	if err != nil {
+		return fmt.Errorf("failed to read checkpoint: %w", err)
+	}
+
+	receiverOptions := []eventhub.ReceiveOption{eventhub.ReceiveWithStartingOffset(checkpoint.Offset)}
+	if h.config.ConsumerGroup != "" {
+		receiverOptions = append(receiverOptions, eventhub.ReceiveWithConsumerGroup(h.config.ConsumerGroup))
+	}
+
+	handle, err := h.receive(ctx, partitionID, receiverOptions...)
+	if err != nil {
+		return err
+	}
+	h.settings.Logger.Info("Receiving events of partition", zap.String("partition", partitionID), zap.String("offset", checkpoint.Offset))
+	h.partitions[partitionID] = handle
+	return nil
+}
+
+// stopBalancing stops receiving events, writes the last checkpoints and releases the ownership of the partitions.
+func (h *eventhubHandler) stopBalancing(ctx context.Context) error {
+	h.cancel()
+	h.balancerWG.Wait()
+
+	var errs error
+	for partitionID, handle := range h.partitions {
+		if err := handle.Close(ctx); err != nil {
+			errs = errors.Join(errs, fmt.Errorf("failed to close partition %q receiver: %w", partitionID, err))
+		}
+		delete(h.partitions, partitionID)
+	}
+	if err := h.checkpointStore.flush(ctx); err != nil {
+		errs = errors.Join(errs, err)
+	}
+	if err := h.balancer.release(ctx); err != nil {
+		errs = errors.Join(errs, fmt.Errorf("failed to release partitions: %w", err))
+	}
+	h.balancer = nil
+	return errs
+}
+
 func (h *eventhubHandler) newMessageHandler(ctx context.Context, event *eventhub.Event) error {
 
 	err := h.dataConsumer.consume(ctx, event)
@@ -163,6 +331,11 @@ func (h *eventhubHandler) newMessageHandler(ctx context.Context, event *eventhub
 
 func (h *eventhubHandler) close(ctx context.Context) error {
 
+	var balancingErr error
+	if h.balancer != nil {
+		balancingErr = h.stopBalancing(ctx)
+	}
+
 	if h.hub != nil {
 		err := h.hub.Close(ctx)
 		if err != nil {
@@ -174,7 +347,7 @@ func (h *eventhubHandler) close(ctx context.Context) error {
 		h.cancel()
 	}
 
-	return nil
+	return balancingErr
 }
 
 func (h *eventhubHandler) setDataConsumer(dataConsumer dataConsumer) {
@@ -9,6 +9,7 @@ import (
 	"time"
 
 	eventhub "github.com/Azure/azure-event-hubs-go/v3"
+	"github.com/Azure/azure-event-hubs-go/v3/persist"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 	"go.opentelemetry.io/collector/component"
@@ -48,6 +49,10 @@ type mockListenerHandleWrapper struct {
 	ctx context.Context
 }
 
+func (m *mockListenerHandleWrapper) Close(_ context.Context) error {
+	return nil
+}
+
 func (m *mockListenerHandleWrapper) Done() <-chan struct{} {
 	return m.ctx.Done()
 }
@@ -98,6 +103,42 @@ func TestEventhubHandler_Start(t *testing.T) {
 	assert.NoError(t, ehHandler.close(context.Background()))
 }
 
+func TestEventhubHandler_StartWithCheckpointStore(t *testing.T) {
+	config := createDefaultConfig()
+	config.(*Config).Connection = "Endpoint=sb://namespace.servicebus.windows.net/;SharedAccessKeyName=RootManageSharedAccessKey;SharedAccessKey=superSecret1234=;EntityPath=hubName"
+	config.(*Config).CheckpointStore = &CheckpointStoreConfig{
+		Connection: "DefaultEndpointsProtocol=https;AccountName=account;AccountKey=key;EndpointSuffix=core.windows.net",
+		Container:  "checkpoints",
+	}
+
+	client := newMockBlobClient()
+	checkpointStore := newBlobCheckpointStore(client, "namespace", "hubName", "$Default")
+	ehHandler := &eventhubHandler{
+		settings:        receivertest.NewNopCreateSettings(),
+		dataConsumer:    &mockDataConsumer{},
+		config:          config.(*Config),
+		checkpointStore: checkpointStore,
+	}
+	ehHandler.hub = &mockHubWrapper{}
+
+	require.NoError(t, ehHandler.run(context.Background(), componenttest.NewNopHost()))
+	assert.Contains(t, ehHandler.partitions, "foo")
+	ownerships, err := checkpointStore.listOwnership(context.Background())
+	require.NoError(t, err)
+	require.Len(t, ownerships, 1)
+	assert.Equal(t, ehHandler.balancer.ownerID, ownerships[0].ownerID)
+
+	require.NoError(t, checkpointStore.Write("namespace", "hubName", "$Default", "foo", persist.Checkpoint{Offset: "1234", SequenceNumber: 1, EnqueueTime: time.Now()}))
+	assert.NoError(t, ehHandler.close(context.Background()))
+
+	// the last checkpoints are written and partitions released on close
+	assert.Contains(t, client.blobs, "namespace/hubname/$default/checkpoint/foo")
+	ownerships, err = checkpointStore.listOwnership(context.Background())
+	require.NoError(t, err)
+	require.Len(t, ownerships, 1)
+	assert.Empty(t, ownerships[0].ownerID)
+}
+
 func TestEventhubHandler_newMessageHandler(t *testing.T) {
 	config := createDefaultConfig()
 	config.(*Config).Connection = "Endpoint=sb://namespace.servicebus.windows.net/;SharedAccessKeyName=RootManageSharedAccessKey;SharedAccessKey=superSecret1234=;EntityPath=hubName"
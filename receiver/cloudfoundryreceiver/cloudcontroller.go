@@ -0,0 +1,165 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package cloudfoundryreceiver // import "github.com/open-telemetry/opentelemetry-collector-contrib/receiver/cloudfoundryreceiver"
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"regexp"
+	"strings"
+	"sync"
+	"time"
+
+	"go.opentelemetry.io/collector/pdata/pcommon"
+	"go.uber.org/zap"
+)
+
+const (
+	attributeAppID     = "cloudfoundry.app.id"
+	attributeAppName   = "cloudfoundry.app.name"
+	attributeSpaceID   = "cloudfoundry.space.id"
+	attributeSpaceName = "cloudfoundry.space.name"
+	attributeOrgID     = "cloudfoundry.org.id"
+	attributeOrgName   = "cloudfoundry.org.name"
+)
+
+// the source ID of the envelopes emitted by applications is the application GUID
+var appGUIDRegexp = regexp.MustCompile(`^[0-9a-f]{8}-[0-9a-f]{4}-[0-9a-f]{4}-[0-9a-f]{4}-[0-9a-f]{12}$`)
+
+// appMetadata holds the names and GUIDs of an application and of the space and organization it belongs to.
+type appMetadata struct {
+	appID     string
+	appName   string
+	spaceID   string
+	spaceName string
+	orgID     string
+	orgName   string
+}
+
+func (md *appMetadata) copyToResource(attributes pcommon.Map) {
+	attributes.PutStr(attributeAppID, md.appID)
+	attributes.PutStr(attributeAppName, md.appName)
+	attributes.PutStr(attributeSpaceID, md.spaceID)
+	attributes.PutStr(attributeSpaceName, md.spaceName)
+	attributes.PutStr(attributeOrgID, md.orgID)
+	attributes.PutStr(attributeOrgName, md.orgName)
+}
+
+type cachedAppMetadata struct {
+	metadata *appMetadata
+	expires  time.Time
+}
+
+// cloudControllerClient resolves application metadata through the Cloud Controller v3 API, caching the
+// results. Failed lookups are cached as well, so that unknown applications aren't looked up on every envelope.
+type cloudControllerClient struct {
+	logger   *zap.Logger
+	endpoint string
+	client   *authorizationProvider
+	ttl      time.Duration
+
+	mutex sync.Mutex
+	cache map[string]cachedAppMetadata
+}
+
+func newCloudControllerClient(logger *zap.Logger, endpoint string, client *authorizationProvider, ttl time.Duration) *cloudControllerClient {
+	return &cloudControllerClient{
+		logger:   logger,
+		endpoint: strings.TrimSuffix(endpoint, "/"),
+		client:   client,
+		ttl:      ttl,
+		cache:    map[string]cachedAppMetadata{},
+	}
+}
+
+// appMetadata returns the metadata of the application with the given GUID, or nil if the source ID isn't an
+// application GUID or the application couldn't be resolved.
+func (ccc *cloudControllerClient) appMetadata(ctx context.Context, sourceID string) *appMetadata {
+	if !appGUIDRegexp.MatchString(sourceID) {
+		return nil
+	}
+
+	ccc.mutex.Lock()
+	defer ccc.mutex.Unlock()
+
+	now := time.Now()
+	if cached, ok := ccc.cache[sourceID]; ok && now.Before(cached.expires) {
+		return cached.metadata
+	}
+
+	md, err := ccc.fetchAppMetadata(ctx, sourceID)
+	if err != nil {
+		ccc.logger.Debug("failed to resolve the cloud foundry application metadata", zap.String("app_id", sourceID), zap.Error(err))
+	}
+	ccc.cache[sourceID] = cachedAppMetadata{metadata: md, expires: now.Add(ccc.ttl)}
+	return md
+}
+
+type capiResource struct {
+	GUID          string `json:"guid"`
+	Name          string `json:"name"`
+	Relationships struct {
+		Space struct {
+			Data struct {
+				GUID string `json:"guid"`
+			} `json:"data"`
+		} `json:"space"`
+		Organization struct {
+			Data struct {
+				GUID string `json:"guid"`
+			} `json:"data"`
+		} `json:"organization"`
+	} `json:"relationships"`
+}
+
+type capiApp struct {
+	capiResource
+	Included struct {
+		Spaces        []capiResource `json:"spaces"`
+		Organizations []capiResource `json:"organizations"`
+	} `json:"included"`
+}
+
+func (ccc *cloudControllerClient) fetchAppMetadata(ctx context.Context, appID string) (*appMetadata, error) {
+	url := fmt.Sprintf("%s/v3/apps/%s?include=space.organization", ccc.endpoint, appID)
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := ccc.client.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("request GET %s failed - %q", url, resp.Status)
+	}
+
+	var app capiApp
+	if err = json.NewDecoder(resp.Body).Decode(&app); err != nil {
+		return nil, fmt.Errorf("decoding the cloud controller response: %w", err)
+	}
+
+	md := &appMetadata{
+		appID:   app.GUID,
+		appName: app.Name,
+		spaceID: app.Relationships.Space.Data.GUID,
+	}
+	for _, space := range app.Included.Spaces {
+		if space.GUID == md.spaceID {
+			md.spaceName = space.Name
+			md.orgID = space.Relationships.Organization.Data.GUID
+		}
+	}
+	for _, org := range app.Included.Organizations {
+		if org.GUID == md.orgID {
+			md.orgName = org.Name
+		}
+	}
+	return md, nil
+}
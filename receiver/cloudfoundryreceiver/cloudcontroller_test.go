@@ -0,0 +1,110 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package cloudfoundryreceiver
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/collector/pdata/pcommon"
+	"go.uber.org/zap"
+)
+
+const (
+	testAppID   = "5db33854-09a4-4519-ba71-af33a878df6f"
+	testSpaceID = "a0e3a8c4-3d8b-4d4c-9a5c-0f2cb3f0b6a1"
+	testOrgID   = "e5c9f5f8-0c7e-4c0b-9f7c-6f1b3c8d2e4a"
+)
+
+const testAppResponse = `{
+  "guid": "` + testAppID + `",
+  "name": "checkout",
+  "relationships": {"space": {"data": {"guid": "` + testSpaceID + `"}}},
+  "included": {
+    "spaces": [{"guid": "` + testSpaceID + `", "name": "production", "relationships": {"organization": {"data": {"guid": "` + testOrgID + `"}}}}],
+    "organizations": [{"guid": "` + testOrgID + `", "name": "shop"}]
+  }
+}`
+
+func newTestCloudControllerClient(t *testing.T, ttl time.Duration) (*cloudControllerClient, *int) {
+	requests := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		assert.Equal(t, "bearer test", r.Header.Get("Authorization"))
+		assert.Equal(t, "space.organization", r.URL.Query().Get("include"))
+		if r.URL.Path != "/v3/apps/"+testAppID {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		_, err := w.Write([]byte(testAppResponse))
+		assert.NoError(t, err)
+	}))
+	t.Cleanup(server.Close)
+
+	client := newCloudControllerClient(zap.NewNop(), server.URL+"/", &authorizationProvider{
+		logger:            zap.NewNop(),
+		authTokenProvider: &UAATokenProvider{cachedToken: "bearer test", mutex: &sync.Mutex{}},
+		client:            server.Client(),
+	}, ttl)
+	return client, &requests
+}
+
+func TestCloudControllerAppMetadata(t *testing.T) {
+	client, requests := newTestCloudControllerClient(t, time.Hour)
+
+	md := client.appMetadata(context.Background(), testAppID)
+	require.NotNil(t, md)
+	assert.Equal(t, &appMetadata{
+		appID:     testAppID,
+		appName:   "checkout",
+		spaceID:   testSpaceID,
+		spaceName: "production",
+		orgID:     testOrgID,
+		orgName:   "shop",
+	}, md)
+
+	attributes := pcommon.NewMap()
+	md.copyToResource(attributes)
+	assertAttributes(t, attributes, map[string]string{
+		"cloudfoundry.app.id":     testAppID,
+		"cloudfoundry.app.name":   "checkout",
+		"cloudfoundry.space.id":   testSpaceID,
+		"cloudfoundry.space.name": "production",
+		"cloudfoundry.org.id":     testOrgID,
+		"cloudfoundry.org.name":   "shop",
+	})
+
+	// cached
+	assert.Equal(t, md, client.appMetadata(context.Background(), testAppID))
+	assert.Equal(t, 1, *requests)
+}
+
+func TestCloudControllerAppMetadataNotFound(t *testing.T) {
+	client, requests := newTestCloudControllerClient(t, time.Hour)
+
+	unknownApp := "00000000-0000-0000-0000-000000000000"
+	assert.Nil(t, client.appMetadata(context.Background(), unknownApp))
+	assert.Nil(t, client.appMetadata(context.Background(), unknownApp))
+	// the failed lookup is cached too
+	assert.Equal(t, 1, *requests)
+
+	// platform components aren't looked up
+	assert.Nil(t, client.appMetadata(context.Background(), "gorouter"))
+	assert.Equal(t, 1, *requests)
+}
+
+func TestCloudControllerAppMetadataExpires(t *testing.T) {
+	client, requests := newTestCloudControllerClient(t, time.Nanosecond)
+
+	require.NotNil(t, client.appMetadata(context.Background(), testAppID))
+	time.Sleep(time.Millisecond)
+	require.NotNil(t, client.appMetadata(context.Background(), testAppID))
+	assert.Equal(t, 2, *requests)
+}
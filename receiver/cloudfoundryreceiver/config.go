@@ -7,6 +7,7 @@ import (
 	"errors"
 	"fmt"
 	"net/url"
+	"time"
 
 	"go.opentelemetry.io/collector/config/confighttp"
 	"go.opentelemetry.io/collector/config/configopaque"
@@ -35,10 +36,19 @@ type UAAConfig struct {
 	Password            configopaque.String `mapstructure:"password"`
 }
 
+// CloudControllerConfig configures the Cloud Controller API used to resolve the application, space and
+// organization of the logs. The lookups are disabled when no endpoint is configured.
+type CloudControllerConfig struct {
+	confighttp.ClientConfig `mapstructure:",squash"`
+	// CacheTTL is how long the resolved application metadata is kept before being fetched again.
+	CacheTTL time.Duration `mapstructure:"cache_ttl"`
+}
+
 // Config defines configuration for Collectd receiver.
 type Config struct {
-	RLPGateway RLPGatewayConfig `mapstructure:"rlp_gateway"`
-	UAA        UAAConfig        `mapstructure:"uaa"`
+	RLPGateway      RLPGatewayConfig      `mapstructure:"rlp_gateway"`
+	UAA             UAAConfig             `mapstructure:"uaa"`
+	CloudController CloudControllerConfig `mapstructure:"cloud_controller"`
 }
 
 func (c *Config) Validate() error {
@@ -60,6 +70,17 @@ func (c *Config) Validate() error {
 		return errors.New("UAA password not specified")
 	}
 
+	if c.CloudController.Endpoint != "" {
+		err = validateURLOption("cloud_controller.endpoint", c.CloudController.Endpoint)
+		if err != nil {
+			return err
+		}
+
+		if c.CloudController.CacheTTL <= 0 {
+			return errors.New("cloud_controller.cache_ttl must be positive")
+		}
+	}
+
 	return nil
 }
 
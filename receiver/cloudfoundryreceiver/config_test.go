@@ -53,6 +53,12 @@ func TestLoadConfig(t *testing.T) {
 					Username: "admin",
 					Password: "test",
 				},
+				CloudController: CloudControllerConfig{
+					ClientConfig: confighttp.ClientConfig{
+						Endpoint: "https://api.sys.example.internal",
+					},
+					CacheTTL: time.Minute,
+				},
 			},
 		},
 		{
@@ -99,6 +105,14 @@ func TestInvalidConfigValidation(t *testing.T) {
 	configuration = loadSuccessfulConfig(t)
 	configuration.UAA.Endpoint = "https://[invalid"
 	require.Error(t, configuration.Validate())
+
+	configuration = loadSuccessfulConfig(t)
+	configuration.CloudController.Endpoint = "https://[invalid"
+	require.Error(t, configuration.Validate())
+
+	configuration = loadSuccessfulConfig(t)
+	configuration.CloudController.CacheTTL = 0
+	require.EqualError(t, configuration.Validate(), "cloud_controller.cache_ttl must be positive")
 }
 
 func TestHTTPConfigurationStructConsistency(t *testing.T) {
@@ -131,6 +145,12 @@ func loadSuccessfulConfig(t *testing.T) *Config {
 			Username: "admin",
 			Password: "test",
 		},
+		CloudController: CloudControllerConfig{
+			ClientConfig: confighttp.ClientConfig{
+				Endpoint: "https://api.sys.example.internal",
+			},
+			CacheTTL: time.Minute,
+		},
 	}
 
 	require.NoError(t, configuration.Validate())
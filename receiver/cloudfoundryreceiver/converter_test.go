@@ -11,6 +11,7 @@ import (
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 	"go.opentelemetry.io/collector/pdata/pcommon"
+	"go.opentelemetry.io/collector/pdata/plog"
 	"go.opentelemetry.io/collector/pdata/pmetric"
 )
 
@@ -142,6 +143,49 @@ func TestConvertGaugeEnvelope(t *testing.T) {
 	assertAttributes(t, dataPoint.Attributes(), expectedAttributes)
 }
 
+func TestConvertLogEnvelope(t *testing.T) {
+	now := time.Now()
+	observed := time.Now().Add(time.Second)
+
+	envelope := loggregator_v2.Envelope{
+		Timestamp:  now.UnixNano(),
+		SourceId:   "5db33854-09a4-4519-ba71-af33a878df6f",
+		InstanceId: "0",
+		Tags: map[string]string{
+			"origin":      "rep",
+			"source_type": "APP/PROC/WEB",
+		},
+		Message: &loggregator_v2.Envelope_Log{
+			Log: &loggregator_v2.Log{
+				Payload: []byte("connection refused"),
+				Type:    loggregator_v2.Log_ERR,
+			},
+		},
+	}
+
+	logSlice := plog.NewLogRecordSlice()
+
+	convertEnvelopeToLogs(&envelope, logSlice, observed)
+
+	require.Equal(t, 1, logSlice.Len())
+	logRecord := logSlice.At(0)
+	assert.Equal(t, pcommon.NewTimestampFromTime(now), logRecord.Timestamp())
+	assert.Equal(t, pcommon.NewTimestampFromTime(observed), logRecord.ObservedTimestamp())
+	assert.Equal(t, "connection refused", logRecord.Body().Str())
+	assert.Equal(t, "ERR", logRecord.SeverityText())
+	assert.Equal(t, plog.SeverityNumberError, logRecord.SeverityNumber())
+	assertAttributes(t, logRecord.Attributes(), map[string]string{
+		"org.cloudfoundry.source_id":   "5db33854-09a4-4519-ba71-af33a878df6f",
+		"org.cloudfoundry.instance_id": "0",
+		"org.cloudfoundry.origin":      "rep",
+		"org.cloudfoundry.source_type": "APP/PROC/WEB",
+	})
+
+	// envelopes other than logs are ignored
+	convertEnvelopeToLogs(&loggregator_v2.Envelope{Message: &loggregator_v2.Envelope_Counter{}}, logSlice, observed)
+	assert.Equal(t, 1, logSlice.Len())
+}
+
 func assertAttributes(t *testing.T, attributes pcommon.Map, expected map[string]string) {
 	assert.Equal(t, len(expected), attributes.Len())
 
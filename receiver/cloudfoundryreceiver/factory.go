@@ -5,6 +5,7 @@ package cloudfoundryreceiver // import "github.com/open-telemetry/opentelemetry-
 
 import (
 	"context"
+	"time"
 
 	"go.opentelemetry.io/collector/component"
 	"go.opentelemetry.io/collector/config/confighttp"
@@ -21,6 +22,7 @@ const (
 	defaultUAAUsername       = "admin"
 	defaultRLPGatewayShardID = "opentelemetry"
 	defaultURL               = "https://localhost"
+	defaultCacheTTL          = 5 * time.Minute
 )
 
 // NewFactory creates a factory for collectd receiver.
@@ -28,7 +30,8 @@ func NewFactory() receiver.Factory {
 	return receiver.NewFactory(
 		metadata.Type,
 		createDefaultConfig,
-		receiver.WithMetrics(createMetricsReceiver, metadata.MetricsStability))
+		receiver.WithMetrics(createMetricsReceiver, metadata.MetricsStability),
+		receiver.WithLogs(createLogsReceiver, metadata.LogsStability))
 }
 
 func createDefaultConfig() component.Config {
@@ -51,6 +54,9 @@ func createDefaultConfig() component.Config {
 			},
 			Username: defaultUAAUsername,
 		},
+		CloudController: CloudControllerConfig{
+			CacheTTL: defaultCacheTTL,
+		},
 	}
 }
 
@@ -61,5 +67,15 @@ func createMetricsReceiver(
 	nextConsumer consumer.Metrics,
 ) (receiver.Metrics, error) {
 	c := cfg.(*Config)
-	return newCloudFoundryReceiver(params, *c, nextConsumer)
+	return newCloudFoundryMetricsReceiver(params, *c, nextConsumer)
+}
+
+func createLogsReceiver(
+	_ context.Context,
+	params receiver.CreateSettings,
+	cfg component.Config,
+	nextConsumer consumer.Logs,
+) (receiver.Logs, error) {
+	c := cfg.(*Config)
+	return newCloudFoundryLogsReceiver(params, *c, nextConsumer)
 }
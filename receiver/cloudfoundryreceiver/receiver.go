@@ -13,6 +13,7 @@ import (
 	"code.cloudfoundry.org/go-loggregator"
 	"go.opentelemetry.io/collector/component"
 	"go.opentelemetry.io/collector/consumer"
+	"go.opentelemetry.io/collector/pdata/plog"
 	"go.opentelemetry.io/collector/pdata/pmetric"
 	"go.opentelemetry.io/collector/receiver"
 	"go.opentelemetry.io/collector/receiver/receiverhelper"
@@ -25,24 +26,53 @@ const (
 )
 
 var _ receiver.Metrics = (*cloudFoundryReceiver)(nil)
+var _ receiver.Logs = (*cloudFoundryReceiver)(nil)
 
-// newCloudFoundryReceiver implements the receiver.Metrics for Cloud Foundry protocol.
+// cloudFoundryReceiver implements the receiver.Metrics and receiver.Logs for Cloud Foundry protocol.
 type cloudFoundryReceiver struct {
 	settings          component.TelemetrySettings
 	cancel            context.CancelFunc
 	config            Config
-	nextConsumer      consumer.Metrics
+	nextMetrics       consumer.Metrics
+	nextLogs          consumer.Logs
+	cloudController   *cloudControllerClient
 	obsrecv           *receiverhelper.ObsReport
 	goroutines        sync.WaitGroup
 	receiverStartTime time.Time
 }
 
-// newCloudFoundryReceiver creates the Cloud Foundry receiver with the given parameters.
-func newCloudFoundryReceiver(
+// newCloudFoundryMetricsReceiver creates the Cloud Foundry metrics receiver with the given parameters.
+func newCloudFoundryMetricsReceiver(
 	settings receiver.CreateSettings,
 	config Config,
 	nextConsumer consumer.Metrics) (receiver.Metrics, error) {
 
+	cfr, err := newCloudFoundryReceiver(settings, config)
+	if err != nil {
+		return nil, err
+	}
+	cfr.nextMetrics = nextConsumer
+	return cfr, nil
+}
+
+// newCloudFoundryLogsReceiver creates the Cloud Foundry logs receiver with the given parameters.
+func newCloudFoundryLogsReceiver(
+	settings receiver.CreateSettings,
+	config Config,
+	nextConsumer consumer.Logs) (receiver.Logs, error) {
+
+	cfr, err := newCloudFoundryReceiver(settings, config)
+	if err != nil {
+		return nil, err
+	}
+	cfr.nextLogs = nextConsumer
+	return cfr, nil
+}
+
+func newCloudFoundryReceiver(
+	settings receiver.CreateSettings,
+	config Config) (*cloudFoundryReceiver, error) {
+
 	obsrecv, err := receiverhelper.NewObsReport(receiverhelper.ObsReportSettings{
 		ReceiverID:             settings.ID,
 		Transport:              transport,
@@ -55,7 +85,6 @@ func newCloudFoundryReceiver(
 	return &cloudFoundryReceiver{
 		settings:          settings.TelemetrySettings,
 		config:            config,
-		nextConsumer:      nextConsumer,
 		obsrecv:           obsrecv,
 		receiverStartTime: time.Now(),
 	}, nil
@@ -78,6 +107,23 @@ func (cfr *cloudFoundryReceiver) Start(ctx context.Context, host component.Host)
 		return fmt.Errorf("creating cloud foundry RLP envelope stream factory: %w", streamErr)
 	}
 
+	if cfr.nextLogs != nil && cfr.config.CloudController.Endpoint != "" {
+		httpClient, err := cfr.config.CloudController.ToClient(ctx, host, cfr.settings)
+		if err != nil {
+			return fmt.Errorf("creating HTTP client for Cloud Foundry Cloud Controller: %w", err)
+		}
+		cfr.cloudController = newCloudControllerClient(
+			cfr.settings.Logger,
+			cfr.config.CloudController.Endpoint,
+			&authorizationProvider{
+				logger:            cfr.settings.Logger,
+				authTokenProvider: tokenProvider,
+				client:            httpClient,
+			},
+			cfr.config.CloudController.CacheTTL,
+		)
+	}
+
 	innerCtx, cancel := context.WithCancel(ctx)
 	cfr.cancel = cancel
 
@@ -93,14 +139,23 @@ func (cfr *cloudFoundryReceiver) Start(ctx context.Context, host component.Host)
 			return
 		}
 
-		envelopeStream, err := streamFactory.CreateStream(innerCtx, cfr.config.RLPGateway.ShardID)
+		selectors := metricSelectors
+		if cfr.nextLogs != nil {
+			selectors = logSelectors
+		}
+		envelopeStream, err := streamFactory.CreateStream(innerCtx, cfr.config.RLPGateway.ShardID, selectors)
 		if err != nil {
 			cfr.settings.ReportStatus(component.NewFatalErrorEvent(fmt.Errorf("creating RLP gateway envelope stream: %w", err)))
 			return
 		}
 
-		cfr.streamMetrics(innerCtx, envelopeStream)
-		cfr.settings.Logger.Debug("cloudfoundry metrics streamer stopped")
+		if cfr.nextLogs != nil {
+			cfr.streamLogs(innerCtx, envelopeStream)
+			cfr.settings.Logger.Debug("cloudfoundry logs streamer stopped")
+		} else {
+			cfr.streamMetrics(innerCtx, envelopeStream)
+			cfr.settings.Logger.Debug("cloudfoundry metrics streamer stopped")
+		}
 	}()
 
 	return nil
@@ -144,12 +199,66 @@ func (cfr *cloudFoundryReceiver) streamMetrics(
 
 		if libraryMetrics.Len() > 0 {
 			obsCtx := cfr.obsrecv.StartMetricsOp(ctx)
-			err := cfr.nextConsumer.ConsumeMetrics(ctx, metrics)
+			err := cfr.nextMetrics.ConsumeMetrics(ctx, metrics)
 			cfr.obsrecv.EndMetricsOp(obsCtx, dataFormat, metrics.DataPointCount(), err)
 		}
 	}
 }
 
+func (cfr *cloudFoundryReceiver) streamLogs(
+	ctx context.Context,
+	stream loggregator.EnvelopeStream) {
+
+	for {
+		// Blocks until non-empty result or context is cancelled (returns nil in that case)
+		envelopes := stream()
+		if envelopes == nil {
+			// If context has not been cancelled, then nil means the shutdown was due to an error within stream
+			if ctx.Err() == nil {
+				cfr.settings.ReportStatus(component.NewFatalErrorEvent(errors.New("RLP gateway streamer shut down due to an error")))
+			}
+
+			break
+		}
+
+		logs := plog.NewLogs()
+		observedTime := time.Now()
+		// the logs of each source get their own resource, which carries the application metadata
+		sourceLogs := map[string]plog.LogRecordSlice{}
+
+		for _, envelope := range envelopes {
+			if envelope == nil || envelope.GetLog() == nil {
+				continue
+			}
+
+			logSlice, ok := sourceLogs[envelope.SourceId]
+			if !ok {
+				logSlice = cfr.createSourceLogSlice(ctx, logs, envelope.SourceId)
+				sourceLogs[envelope.SourceId] = logSlice
+			}
+			convertEnvelopeToLogs(envelope, logSlice, observedTime)
+		}
+
+		if logs.LogRecordCount() > 0 {
+			obsCtx := cfr.obsrecv.StartLogsOp(ctx)
+			err := cfr.nextLogs.ConsumeLogs(ctx, logs)
+			cfr.obsrecv.EndLogsOp(obsCtx, dataFormat, logs.LogRecordCount(), err)
+		}
+	}
+}
+
+func (cfr *cloudFoundryReceiver) createSourceLogSlice(ctx context.Context, logs plog.Logs, sourceID string) plog.LogRecordSlice {
+	resourceLogs := logs.ResourceLogs().AppendEmpty()
+	if cfr.cloudController != nil {
+		if md := cfr.cloudController.appMetadata(ctx, sourceID); md != nil {
+			md.copyToResource(resourceLogs.Resource().Attributes())
+		}
+	}
+	scopeLogs := resourceLogs.ScopeLogs().AppendEmpty()
+	scopeLogs.Scope().SetName(instrumentationLibName)
+	return scopeLogs.LogRecords()
+}
+
 func createLibraryMetricsSlice(metrics pmetric.Metrics) pmetric.MetricSlice {
 	resourceMetrics := metrics.ResourceMetrics()
 	resourceMetric := resourceMetrics.AppendEmpty()
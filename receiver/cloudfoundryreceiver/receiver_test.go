@@ -6,7 +6,10 @@ package cloudfoundryreceiver
 import (
 	"context"
 	"testing"
+	"time"
 
+	"code.cloudfoundry.org/go-loggregator/rpc/loggregator_v2"
+	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 	"go.opentelemetry.io/collector/component/componenttest"
 	"go.opentelemetry.io/collector/consumer/consumertest"
@@ -19,7 +22,7 @@ func TestDefaultValidReceiver(t *testing.T) {
 	cfg := factory.CreateDefaultConfig().(*Config)
 	params := receivertest.NewNopCreateSettings()
 
-	receiver, err := newCloudFoundryReceiver(
+	receiver, err := newCloudFoundryMetricsReceiver(
 		params,
 		*cfg,
 		consumertest.NewNop(),
@@ -37,3 +40,84 @@ func TestDefaultValidReceiver(t *testing.T) {
 	err = receiver.Shutdown(ctx)
 	require.NoError(t, err)
 }
+
+func TestDefaultValidLogsReceiver(t *testing.T) {
+	factory := NewFactory()
+	cfg := factory.CreateDefaultConfig().(*Config)
+	cfg.CloudController.Endpoint = "https://api.sys.example.internal"
+	params := receivertest.NewNopCreateSettings()
+
+	receiver, err := newCloudFoundryLogsReceiver(
+		params,
+		*cfg,
+		consumertest.NewNop(),
+	)
+
+	require.NoError(t, err)
+	require.NotNil(t, receiver, "receiver creation failed")
+
+	// Test start
+	ctx := context.Background()
+	err = receiver.Start(ctx, componenttest.NewNopHost())
+	require.NoError(t, err)
+
+	// Test shutdown
+	err = receiver.Shutdown(ctx)
+	require.NoError(t, err)
+}
+
+func TestStreamLogs(t *testing.T) {
+	factory := NewFactory()
+	cfg := factory.CreateDefaultConfig().(*Config)
+	sink := new(consumertest.LogsSink)
+
+	receiver, err := newCloudFoundryLogsReceiver(receivertest.NewNopCreateSettings(), *cfg, sink)
+	require.NoError(t, err)
+	cfr := receiver.(*cloudFoundryReceiver)
+	cfr.cloudController, _ = newTestCloudControllerClient(t, time.Hour)
+
+	logEnvelope := func(sourceID string, payload string) *loggregator_v2.Envelope {
+		return &loggregator_v2.Envelope{
+			SourceId: sourceID,
+			Message: &loggregator_v2.Envelope_Log{
+				Log: &loggregator_v2.Log{Payload: []byte(payload)},
+			},
+		}
+	}
+	ctx, cancel := context.WithCancel(context.Background())
+	batches := [][]*loggregator_v2.Envelope{
+		{
+			logEnvelope(testAppID, "first"),
+			logEnvelope("gorouter", "routed"),
+			{SourceId: testAppID, Message: &loggregator_v2.Envelope_Counter{}},
+			logEnvelope(testAppID, "second"),
+		},
+	}
+	stream := func() []*loggregator_v2.Envelope {
+		if len(batches) == 0 {
+			cancel()
+			return nil
+		}
+		batch := batches[0]
+		batches = batches[1:]
+		return batch
+	}
+
+	cfr.streamLogs(ctx, stream)
+
+	require.Len(t, sink.AllLogs(), 1)
+	logs := sink.AllLogs()[0]
+	assert.Equal(t, 3, logs.LogRecordCount())
+	require.Equal(t, 2, logs.ResourceLogs().Len())
+
+	appLogs := logs.ResourceLogs().At(0)
+	appName, ok := appLogs.Resource().Attributes().Get("cloudfoundry.app.name")
+	require.True(t, ok)
+	assert.Equal(t, "checkout", appName.Str())
+	assert.Equal(t, 2, appLogs.ScopeLogs().At(0).LogRecords().Len())
+	assert.Equal(t, instrumentationLibName, appLogs.ScopeLogs().At(0).Scope().Name())
+
+	routerLogs := logs.ResourceLogs().At(1)
+	assert.Equal(t, 0, routerLogs.Resource().Attributes().Len())
+	assert.Equal(t, "routed", routerLogs.ScopeLogs().At(0).LogRecords().At(0).Body().Str())
+}
@@ -17,6 +17,28 @@ import (
 	"go.uber.org/zap"
 )
 
+var (
+	metricSelectors = []*loggregator_v2.Selector{
+		{
+			Message: &loggregator_v2.Selector_Counter{
+				Counter: &loggregator_v2.CounterSelector{},
+			},
+		},
+		{
+			Message: &loggregator_v2.Selector_Gauge{
+				Gauge: &loggregator_v2.GaugeSelector{},
+			},
+		},
+	}
+	logSelectors = []*loggregator_v2.Selector{
+		{
+			Message: &loggregator_v2.Selector_Log{
+				Log: &loggregator_v2.LogSelector{},
+			},
+		},
+	}
+)
+
 type EnvelopeStreamFactory struct {
 	rlpGatewayClient *loggregator.RLPGatewayClient
 }
@@ -47,26 +69,16 @@ func newEnvelopeStreamFactory(
 
 func (rgc *EnvelopeStreamFactory) CreateStream(
 	ctx context.Context,
-	shardID string) (loggregator.EnvelopeStream, error) {
+	shardID string,
+	selectors []*loggregator_v2.Selector) (loggregator.EnvelopeStream, error) {
 
 	if strings.TrimSpace(shardID) == "" {
 		return nil, errors.New("shardID cannot be empty")
 	}
 
 	stream := rgc.rlpGatewayClient.Stream(ctx, &loggregator_v2.EgressBatchRequest{
-		ShardId: shardID,
-		Selectors: []*loggregator_v2.Selector{
-			{
-				Message: &loggregator_v2.Selector_Counter{
-					Counter: &loggregator_v2.CounterSelector{},
-				},
-			},
-			{
-				Message: &loggregator_v2.Selector_Gauge{
-					Gauge: &loggregator_v2.GaugeSelector{},
-				},
-			},
-		},
+		ShardId:   shardID,
+		Selectors: selectors,
 	})
 
 	return stream, nil
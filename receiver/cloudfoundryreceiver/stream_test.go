@@ -40,7 +40,8 @@ func TestValidStream(t *testing.T) {
 
 	envelopeStream, createErr := streamFactory.CreateStream(
 		innerCtx,
-		cfg.RLPGateway.ShardID)
+		cfg.RLPGateway.ShardID,
+		metricSelectors)
 
 	require.NoError(t, createErr)
 	require.NotNil(t, envelopeStream)
@@ -79,7 +80,8 @@ func TestInvalidStream(t *testing.T) {
 	invalidShardID := ""
 	envelopeStream, createErr := streamFactory.CreateStream(
 		innerCtx,
-		invalidShardID)
+		invalidShardID,
+		metricSelectors)
 
 	require.EqualError(t, createErr, "shardID cannot be empty")
 	require.Nil(t, envelopeStream)
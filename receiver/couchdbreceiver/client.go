@@ -19,6 +19,7 @@ import (
 type client interface {
 	Get(path string) ([]byte, error)
 	GetStats(nodeName string) (map[string]any, error)
+	GetMembership() ([]string, error)
 }
 
 var _ client = (*couchDBClient)(nil)
@@ -93,6 +94,24 @@ func (c *couchDBClient) GetStats(nodeName string) (map[string]any, error) {
 	return stats, nil
 }
 
+// GetMembership gets the names of the nodes that are part of the cluster.
+func (c *couchDBClient) GetMembership() ([]string, error) {
+	body, err := c.Get("/_membership")
+	if err != nil {
+		return nil, err
+	}
+
+	var membership struct {
+		ClusterNodes []string `json:"cluster_nodes"`
+	}
+	err = json.Unmarshal(body, &membership)
+	if err != nil {
+		return nil, err
+	}
+
+	return membership.ClusterNodes, nil
+}
+
 func (c *couchDBClient) buildReq(path string) (*http.Request, error) {
 	url := c.cfg.Endpoint + path
 	req, err := http.NewRequest(http.MethodGet, url, nil)
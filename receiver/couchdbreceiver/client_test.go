@@ -182,6 +182,40 @@ func TestGetNodeStats(t *testing.T) {
 	})
 }
 
+func TestGetMembership(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if strings.Contains(r.URL.Path, "/invalid_json") {
+			w.WriteHeader(200)
+			_, err := w.Write([]byte(`{"}`))
+			require.NoError(t, err)
+			return
+		}
+		if strings.Contains(r.URL.Path, "/_membership") {
+			w.WriteHeader(200)
+			_, err := w.Write([]byte(`{"all_nodes":["couchdb@node1","couchdb@node2"],"cluster_nodes":["couchdb@node1","couchdb@node2"]}`))
+			require.NoError(t, err)
+			return
+		}
+		w.WriteHeader(404)
+	}))
+	defer ts.Close()
+
+	t.Run("invalid json", func(t *testing.T) {
+		couchdbClient := defaultClient(t, ts.URL+"/invalid_json")
+
+		nodes, err := couchdbClient.GetMembership()
+		require.Error(t, err)
+		require.Nil(t, nodes)
+	})
+	t.Run("no error", func(t *testing.T) {
+		couchdbClient := defaultClient(t, ts.URL)
+
+		nodes, err := couchdbClient.GetMembership()
+		require.NoError(t, err)
+		require.Equal(t, []string{"couchdb@node1", "couchdb@node2"}, nodes)
+	})
+}
+
 func TestBuildReq(t *testing.T) {
 	couchdbClient := couchDBClient{
 		client: &http.Client{},
@@ -34,6 +34,8 @@ type Config struct {
 	metadata.MetricsBuilderConfig  `mapstructure:",squash"`
 	Username                       string              `mapstructure:"username"`
 	Password                       configopaque.String `mapstructure:"password"`
+	// CollectClusterMetrics discovers the nodes of the cluster from the endpoint, and scrapes all of them.
+	CollectClusterMetrics bool `mapstructure:"collect_cluster_metrics"`
 }
 
 // Validate validates missing and invalid configuration fields.
@@ -48,6 +48,10 @@ func (c *couchdbScraper) scrape(context.Context) (pmetric.Metrics, error) {
 		return pmetric.NewMetrics(), errors.New("no client available")
 	}
 
+	if c.config.CollectClusterMetrics {
+		return c.scrapeCluster()
+	}
+
 	localNode := "_local"
 	stats, err := c.client.GetStats(localNode)
 	if err != nil {
@@ -58,10 +62,48 @@ func (c *couchdbScraper) scrape(context.Context) (pmetric.Metrics, error) {
 		return pmetric.NewMetrics(), err
 	}
 
-	now := pcommon.NewTimestampFromTime(time.Now())
+	errs := &scrapererror.ScrapeErrors{}
+	c.recordStats(pcommon.NewTimestampFromTime(time.Now()), stats, errs)
+
+	rb := c.mb.NewResourceBuilder()
+	rb.SetCouchdbNodeName(c.config.Endpoint)
+	return c.mb.Emit(metadata.WithResource(rb.Emit())), errs.Combine()
+}
+
+// scrapeCluster discovers the nodes of the cluster from the configured endpoint, and scrapes the stats of each of
+// them through it, with the node name as resource.
+func (c *couchdbScraper) scrapeCluster() (pmetric.Metrics, error) {
+	nodes, err := c.client.GetMembership()
+	if err != nil {
+		c.settings.Logger.Error("Failed to fetch couchdb cluster membership",
+			zap.String("endpoint", c.config.Endpoint),
+			zap.Error(err),
+		)
+		return pmetric.NewMetrics(), err
+	}
 
 	errs := &scrapererror.ScrapeErrors{}
+	for _, node := range nodes {
+		stats, err := c.client.GetStats(node)
+		if err != nil {
+			c.settings.Logger.Error("Failed to fetch couchdb stats",
+				zap.String("endpoint", c.config.Endpoint),
+				zap.String("node", node),
+				zap.Error(err),
+			)
+			errs.AddPartial(1, err)
+			continue
+		}
+		c.recordStats(pcommon.NewTimestampFromTime(time.Now()), stats, errs)
 
+		rb := c.mb.NewResourceBuilder()
+		rb.SetCouchdbNodeName(node)
+		c.mb.EmitForResource(metadata.WithResource(rb.Emit()))
+	}
+	return c.mb.Emit(), errs.Combine()
+}
+
+func (c *couchdbScraper) recordStats(now pcommon.Timestamp, stats map[string]any, errs *scrapererror.ScrapeErrors) {
 	c.recordCouchdbAverageRequestTimeDataPoint(now, stats, errs)
 	c.recordCouchdbHttpdBulkRequestsDataPoint(now, stats, errs)
 	c.recordCouchdbHttpdRequestsDataPoint(now, stats, errs)
@@ -70,8 +112,4 @@ func (c *couchdbScraper) scrape(context.Context) (pmetric.Metrics, error) {
 	c.recordCouchdbDatabaseOpenDataPoint(now, stats, errs)
 	c.recordCouchdbFileDescriptorOpenDataPoint(now, stats, errs)
 	c.recordCouchdbDatabaseOperationsDataPoint(now, stats, errs)
-
-	rb := c.mb.NewResourceBuilder()
-	rb.SetCouchdbNodeName(c.config.Endpoint)
-	return c.mb.Emit(metadata.WithResource(rb.Emit())), errs.Combine()
 }
@@ -117,6 +117,63 @@ func TestScrape(t *testing.T) {
 	})
 }
 
+func TestScrapeCluster(t *testing.T) {
+	f := NewFactory()
+	cfg := f.CreateDefaultConfig().(*Config)
+	cfg.Username = "otelu"
+	cfg.Password = "otelp"
+	cfg.CollectClusterMetrics = true
+	require.NoError(t, component.ValidateConfig(cfg))
+
+	t.Run("scrape all the nodes", func(t *testing.T) {
+		mockClient := new(mockClient)
+		mockClient.On("GetMembership").Return([]string{"couchdb@node1", "couchdb@node2"}, nil)
+		mockClient.On("GetStats", "couchdb@node1").Return(getStats("response_3.12.json"))
+		mockClient.On("GetStats", "couchdb@node2").Return(getStats("response_3.12.json"))
+		scraper := newCouchdbScraper(receivertest.NewNopCreateSettings(), cfg)
+		scraper.client = mockClient
+
+		actualMetrics, err := scraper.scrape(context.Background())
+		require.NoError(t, err)
+
+		expectedFile := filepath.Join("testdata", "scraper", "expected_cluster.yaml")
+		expectedMetrics, err := golden.ReadMetrics(expectedFile)
+		require.NoError(t, err)
+
+		require.NoError(t, pmetrictest.CompareMetrics(expectedMetrics, actualMetrics,
+			pmetrictest.IgnoreMetricDataPointsOrder(), pmetrictest.IgnoreStartTimestamp(), pmetrictest.IgnoreTimestamp()))
+	})
+
+	t.Run("scrape error: node stats error", func(t *testing.T) {
+		mockClient := new(mockClient)
+		mockClient.On("GetMembership").Return([]string{"couchdb@node1", "couchdb@node2"}, nil)
+		mockClient.On("GetStats", "couchdb@node1").Return(getStats("response_3.12.json"))
+		mockClient.On("GetStats", "couchdb@node2").Return(getStats(""))
+		scraper := newCouchdbScraper(receivertest.NewNopCreateSettings(), cfg)
+		scraper.client = mockClient
+
+		metrics, err := scraper.scrape(context.Background())
+		require.Error(t, err)
+		var partialScrapeErr scrapererror.PartialScrapeError
+		require.True(t, errors.As(err, &partialScrapeErr), "returned error was not PartialScrapeError")
+		require.Equal(t, 1, metrics.ResourceMetrics().Len())
+		nodeName, ok := metrics.ResourceMetrics().At(0).Resource().Attributes().Get("couchdb.node.name")
+		require.True(t, ok)
+		require.Equal(t, "couchdb@node1", nodeName.Str())
+	})
+
+	t.Run("scrape error: membership error", func(t *testing.T) {
+		mockClient := new(mockClient)
+		mockClient.On("GetMembership").Return(nil, errors.New("bad response"))
+		scraper := newCouchdbScraper(receivertest.NewNopCreateSettings(), cfg)
+		scraper.client = mockClient
+
+		metrics, err := scraper.scrape(context.Background())
+		require.EqualError(t, err, "bad response")
+		require.Equal(t, 0, metrics.DataPointCount())
+	})
+}
+
 func TestStart(t *testing.T) {
 	t.Run("start success", func(t *testing.T) {
 		f := NewFactory()
@@ -245,3 +302,24 @@ func (_m *mockClient) GetStats(nodeName string) (map[string]any, error) {
 
 	return r0, r1
 }
+
+// GetMembership provides a mock function with given fields:
+func (_m *mockClient) GetMembership() ([]string, error) {
+	ret := _m.Called()
+
+	var r0 []string
+	if rf, ok := ret.Get(0).(func() []string); ok {
+		r0 = rf()
+	} else if ret.Get(0) != nil {
+		r0 = ret.Get(0).([]string)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func() error); ok {
+		r1 = rf()
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
@@ -5,8 +5,10 @@ package dockerstatsreceiver // import "github.com/open-telemetry/opentelemetry-c
 
 import (
 	"errors"
+	"fmt"
 
 	"go.opentelemetry.io/collector/component"
+	"go.opentelemetry.io/collector/confmap"
 	"go.opentelemetry.io/collector/receiver/scraperhelper"
 
 	"github.com/open-telemetry/opentelemetry-collector-contrib/internal/docker"
@@ -15,11 +17,31 @@ import (
 
 var _ component.Config = (*Config)(nil)
 
+const (
+	runtimeDocker     = "docker"
+	runtimePodman     = "podman"
+	runtimeContainerd = "containerd"
+)
+
+// defaultEndpoints are the default sockets of the supported container runtimes.
+var defaultEndpoints = map[string]string{
+	runtimeDocker:     "unix:///var/run/docker.sock",
+	runtimePodman:     "unix:///run/podman/podman.sock",
+	runtimeContainerd: "unix:///run/containerd/containerd.sock",
+}
+
 type Config struct {
 	scraperhelper.ControllerConfig `mapstructure:",squash"`
-	// The URL of the docker server.  Default is "unix:///var/run/docker.sock"
+	// The container runtime to collect stats from: "docker", "podman" or "containerd". Default is "docker".
+	Runtime string `mapstructure:"runtime"`
+
+	// The URL of the container runtime API.  Defaults to the default socket of the runtime,
+	// e.g. "unix:///var/run/docker.sock" for docker.
 	Endpoint string `mapstructure:"endpoint"`
 
+	// The containerd namespace to collect stats from. Only used by the containerd runtime. Default is "default".
+	ContainerdNamespace string `mapstructure:"containerd_namespace"`
+
 	// A mapping of container label names to MetricDescriptor label keys.
 	// The corresponding container label value will become the DataPoint label value
 	// for the mapped name.  E.g. `io.kubernetes.container.name: container_spec_name`
@@ -45,12 +67,39 @@ type Config struct {
 	metadata.MetricsBuilderConfig `mapstructure:",squash"`
 }
 
+func (config *Config) Unmarshal(componentParser *confmap.Conf) error {
+	if componentParser == nil {
+		return nil
+	}
+
+	if err := componentParser.Unmarshal(config); err != nil {
+		return err
+	}
+
+	// The default endpoint depends on the configured runtime.
+	if !componentParser.IsSet("endpoint") {
+		if endpoint, ok := defaultEndpoints[config.Runtime]; ok {
+			config.Endpoint = endpoint
+		}
+	}
+	return nil
+}
+
 func (config Config) Validate() error {
 	if config.Endpoint == "" {
 		return errors.New("endpoint must be specified")
 	}
-	if err := docker.VersionIsValidAndGTE(config.DockerAPIVersion, minimumRequiredDockerAPIVersion); err != nil {
-		return err
+	switch config.Runtime {
+	case "", runtimeDocker, runtimePodman:
+		if err := docker.VersionIsValidAndGTE(config.DockerAPIVersion, minimumRequiredDockerAPIVersion); err != nil {
+			return err
+		}
+	case runtimeContainerd:
+		if config.ContainerdNamespace == "" {
+			return errors.New("containerd_namespace must be specified")
+		}
+	default:
+		return fmt.Errorf("runtime %q is not supported, must be one of %q, %q or %q", config.Runtime, runtimeDocker, runtimePodman, runtimeContainerd)
 	}
 	return nil
 }
@@ -39,8 +39,10 @@ func TestLoadConfig(t *testing.T) {
 					Timeout:            20 * time.Second,
 				},
 
-				Endpoint:         "http://example.com/",
-				DockerAPIVersion: "1.40",
+				Runtime:             "docker",
+				Endpoint:            "http://example.com/",
+				ContainerdNamespace: "default",
+				DockerAPIVersion:    "1.40",
 
 				ExcludedImages: []string{
 					"undesired-container",
@@ -68,6 +70,25 @@ func TestLoadConfig(t *testing.T) {
 				}(),
 			},
 		},
+		{
+			id: component.NewIDWithName(metadata.Type, "podman"),
+			expected: func() component.Config {
+				cfg := createDefaultConfig().(*Config)
+				cfg.Runtime = "podman"
+				cfg.Endpoint = "unix:///run/podman/podman.sock"
+				return cfg
+			}(),
+		},
+		{
+			id: component.NewIDWithName(metadata.Type, "containerd"),
+			expected: func() component.Config {
+				cfg := createDefaultConfig().(*Config)
+				cfg.Runtime = "containerd"
+				cfg.Endpoint = "unix:///run/k3s/containerd/containerd.sock"
+				cfg.ContainerdNamespace = "k8s.io"
+				return cfg
+			}(),
+		},
 	}
 
 	for _, tt := range tests {
@@ -107,4 +128,19 @@ func TestValidateErrors(t *testing.T) {
 		ControllerConfig: scraperhelper.ControllerConfig{},
 	}
 	assert.Equal(t, `"collection_interval": requires positive value`, component.ValidateConfig(cfg).Error())
+
+	cfg = &Config{
+		Runtime:          "cri-o",
+		Endpoint:         "someEndpoint",
+		DockerAPIVersion: "1.25",
+		ControllerConfig: scraperhelper.ControllerConfig{CollectionInterval: 1 * time.Second},
+	}
+	assert.Equal(t, `runtime "cri-o" is not supported, must be one of "docker", "podman" or "containerd"`, component.ValidateConfig(cfg).Error())
+
+	cfg = &Config{
+		Runtime:          "containerd",
+		Endpoint:         "someEndpoint",
+		ControllerConfig: scraperhelper.ControllerConfig{CollectionInterval: 1 * time.Second},
+	}
+	assert.Equal(t, "containerd_namespace must be specified", component.ValidateConfig(cfg).Error())
 }
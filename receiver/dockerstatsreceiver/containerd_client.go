@@ -0,0 +1,308 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package dockerstatsreceiver // import "github.com/open-telemetry/opentelemetry-collector-contrib/receiver/dockerstatsreceiver"
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"sync"
+	"time"
+
+	containersapi "github.com/containerd/containerd/api/services/containers/v1"
+	eventsapi "github.com/containerd/containerd/api/services/events/v1"
+	tasksapi "github.com/containerd/containerd/api/services/tasks/v1"
+	"github.com/containerd/containerd/api/types/task"
+	dtypes "github.com/docker/docker/api/types"
+	ctypes "github.com/docker/docker/api/types/container"
+	"go.uber.org/zap"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/metadata"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/internal/docker"
+)
+
+// containerdNamespaceHeader is the gRPC metadata key containerd reads the namespace of a request from.
+const containerdNamespaceHeader = "containerd-namespace"
+
+// containerNameLabels are the container labels the name of a containerd container is read from,
+// in order of preference. Containers without any of them are named after their id.
+var containerNameLabels = []string{"nerdctl/name", "io.kubernetes.container.name"}
+
+// containerdTaskTopics are the event topics after which the list of running containers is reloaded.
+var containerdTaskTopics = []string{"/tasks/start", "/tasks/exit", "/tasks/delete", "/tasks/paused", "/tasks/resumed"}
+
+// containerdClient collects container stats through the containerd gRPC API. Containers and
+// their stats are translated to their docker API equivalents so they are recorded the same way.
+type containerdClient struct {
+	conn            *grpc.ClientConn
+	containers      containersapi.ContainersClient
+	tasks           tasksapi.TasksClient
+	events          eventsapi.EventsClient
+	namespace       string
+	timeout         time.Duration
+	isExcluded      func(image string) bool
+	logger          *zap.Logger
+	systemCPUUsage  func() uint64
+	runningCache    map[string]docker.Container
+	previousCPU     map[string]dtypes.CPUStats
+	containersMutex sync.Mutex
+}
+
+var _ io.Closer = (*containerdClient)(nil)
+
+func newContainerdClient(config *Config, logger *zap.Logger) (*containerdClient, error) {
+	isExcluded, err := docker.NewImageMatcher(config.ExcludedImages)
+	if err != nil {
+		return nil, fmt.Errorf("could not determine containerd client excluded images: %w", err)
+	}
+
+	conn, err := grpc.NewClient(config.Endpoint, grpc.WithTransportCredentials(insecure.NewCredentials()))
+	if err != nil {
+		return nil, fmt.Errorf("could not create containerd client: %w", err)
+	}
+
+	return &containerdClient{
+		conn:           conn,
+		containers:     containersapi.NewContainersClient(conn),
+		tasks:          tasksapi.NewTasksClient(conn),
+		events:         eventsapi.NewEventsClient(conn),
+		namespace:      config.ContainerdNamespace,
+		timeout:        config.Timeout,
+		isExcluded:     isExcluded,
+		logger:         logger,
+		systemCPUUsage: readSystemCPUUsage,
+		runningCache:   make(map[string]docker.Container),
+		previousCPU:    make(map[string]dtypes.CPUStats),
+	}, nil
+}
+
+func (c *containerdClient) withNamespace(ctx context.Context) context.Context {
+	return metadata.AppendToOutgoingContext(ctx, containerdNamespaceHeader, c.namespace)
+}
+
+func (c *containerdClient) Close() error {
+	return c.conn.Close()
+}
+
+// Containers returns the running containers that aren't excluded.
+func (c *containerdClient) Containers() []docker.Container {
+	c.containersMutex.Lock()
+	defer c.containersMutex.Unlock()
+	containers := make([]docker.Container, 0, len(c.runningCache))
+	for _, container := range c.runningCache {
+		containers = append(containers, container)
+	}
+	return containers
+}
+
+// LoadContainerList reloads the list of running containers.
+func (c *containerdClient) LoadContainerList(ctx context.Context) error {
+	ctx, cancel := context.WithTimeout(c.withNamespace(ctx), c.timeout)
+	defer cancel()
+
+	tasks, err := c.tasks.List(ctx, &tasksapi.ListTasksRequest{})
+	if err != nil {
+		return fmt.Errorf("could not list containerd tasks: %w", err)
+	}
+	running := make(map[string]bool, len(tasks.Tasks))
+	for _, t := range tasks.Tasks {
+		if t.Status == task.Status_RUNNING {
+			running[t.ID] = true
+		}
+	}
+
+	containers, err := c.containers.List(ctx, &containersapi.ListContainersRequest{})
+	if err != nil {
+		return fmt.Errorf("could not list containerd containers: %w", err)
+	}
+
+	cache := make(map[string]docker.Container, len(running))
+	for _, container := range containers.Containers {
+		if !running[container.ID] {
+			continue
+		}
+		if c.isExcluded(container.Image) {
+			c.logger.Debug("Not monitoring container per ExcludedImages", zap.String("image", container.Image), zap.String("id", container.ID))
+			continue
+		}
+		cache[container.ID] = toDockerContainer(container, c.logger)
+	}
+
+	c.containersMutex.Lock()
+	defer c.containersMutex.Unlock()
+	c.runningCache = cache
+	for id := range c.previousCPU {
+		if _, ok := cache[id]; !ok {
+			delete(c.previousCPU, id)
+		}
+	}
+	return nil
+}
+
+// ContainerEventLoop reloads the list of running containers whenever a task starts, stops,
+// is paused or resumed, until ctx is done.
+func (c *containerdClient) ContainerEventLoop(ctx context.Context) {
+	filters := make([]string, 0, len(containerdTaskTopics))
+	for _, topic := range containerdTaskTopics {
+		filters = append(filters, fmt.Sprintf(`namespace==%s,topic==%q`, c.namespace, topic))
+	}
+
+	for {
+		err := c.watchEvents(ctx, filters)
+		if ctx.Err() != nil {
+			return
+		}
+		c.logger.Error("Error watching containerd task events", zap.Error(err))
+		// Resume after waiting a moment, and reload the containers in case events were missed.
+		select {
+		case <-time.After(3 * time.Second):
+		case <-ctx.Done():
+			return
+		}
+		if err := c.LoadContainerList(ctx); err != nil {
+			c.logger.Error("Could not reload containerd containers", zap.Error(err))
+		}
+	}
+}
+
+func (c *containerdClient) watchEvents(ctx context.Context, filters []string) error {
+	stream, err := c.events.Subscribe(c.withNamespace(ctx), &eventsapi.SubscribeRequest{Filters: filters})
+	if err != nil {
+		return err
+	}
+	for {
+		envelope, err := stream.Recv()
+		if err != nil {
+			return err
+		}
+		c.logger.Debug("containerd task update", zap.String("topic", envelope.Topic))
+		if err := c.LoadContainerList(ctx); err != nil {
+			c.logger.Error("Could not reload containerd containers", zap.Error(err))
+		}
+	}
+}
+
+// FetchContainerStatsAsJSON fetches the cgroup metrics of a container and translates them to docker stats.
+func (c *containerdClient) FetchContainerStatsAsJSON(ctx context.Context, container docker.Container) (*dtypes.StatsJSON, error) {
+	ctx, cancel := context.WithTimeout(c.withNamespace(ctx), c.timeout)
+	defer cancel()
+
+	resp, err := c.tasks.Metrics(ctx, &tasksapi.MetricsRequest{Filters: []string{"id==" + container.ID}})
+	if err != nil {
+		c.logger.Warn("Could not fetch containerd metrics for container", zap.String("id", container.ID), zap.Error(err))
+		return nil, err
+	}
+	if len(resp.Metrics) == 0 {
+		c.logger.Debug("containerd reported no task for container. Will no longer monitor.", zap.String("id", container.ID))
+		c.removeContainer(container.ID)
+		return nil, fmt.Errorf("no metrics found for container %s", container.ID)
+	}
+
+	stats, err := cgroupMetricsToStatsJSON(resp.Metrics[0])
+	if err != nil {
+		return nil, err
+	}
+	stats.ID = container.ID
+	stats.Name = container.Name
+	stats.CPUStats.SystemUsage = c.systemCPUUsage()
+
+	c.containersMutex.Lock()
+	defer c.containersMutex.Unlock()
+	// The first sample has no previous one, report no CPU utilization for it rather than
+	// the average utilization since the container started.
+	stats.PreCPUStats = stats.CPUStats
+	if previous, ok := c.previousCPU[container.ID]; ok {
+		stats.PreCPUStats = previous
+	}
+	c.previousCPU[container.ID] = stats.CPUStats
+	return stats, nil
+}
+
+func (c *containerdClient) removeContainer(id string) {
+	c.containersMutex.Lock()
+	defer c.containersMutex.Unlock()
+	delete(c.runningCache, id)
+	delete(c.previousCPU, id)
+}
+
+// ociSpec holds the fields of the OCI runtime spec of a container used for its metadata.
+type ociSpec struct {
+	Hostname string `json:"hostname"`
+	Process  *struct {
+		Args []string `json:"args"`
+		Env  []string `json:"env"`
+	} `json:"process"`
+	Linux *struct {
+		Resources *struct {
+			CPU *struct {
+				Shares *uint64 `json:"shares"`
+				Quota  *int64  `json:"quota"`
+				Period *uint64 `json:"period"`
+				Cpus   string  `json:"cpus"`
+			} `json:"cpu"`
+		} `json:"resources"`
+	} `json:"linux"`
+}
+
+// toDockerContainer translates a containerd container to its docker inspect equivalent.
+func toDockerContainer(container *containersapi.Container, logger *zap.Logger) docker.Container {
+	name := container.ID
+	for _, label := range containerNameLabels {
+		if v := container.Labels[label]; v != "" {
+			name = v
+			break
+		}
+	}
+
+	var createdAt string
+	if container.CreatedAt != nil {
+		createdAt = container.CreatedAt.AsTime().Format(time.RFC3339Nano)
+	}
+
+	hostConfig := &ctypes.HostConfig{}
+	config := &ctypes.Config{Image: container.Image, Labels: container.Labels}
+
+	var spec ociSpec
+	if container.Spec != nil {
+		if err := json.Unmarshal(container.Spec.Value, &spec); err != nil {
+			logger.Debug("Could not parse containerd container spec", zap.String("id", container.ID), zap.Error(err))
+		}
+	}
+	config.Hostname = spec.Hostname
+	if spec.Process != nil {
+		config.Cmd = spec.Process.Args
+		config.Env = spec.Process.Env
+	}
+	if spec.Linux != nil && spec.Linux.Resources != nil && spec.Linux.Resources.CPU != nil {
+		cpu := spec.Linux.Resources.CPU
+		if cpu.Shares != nil {
+			hostConfig.CPUShares = int64(*cpu.Shares)
+		}
+		if cpu.Quota != nil {
+			hostConfig.CPUQuota = *cpu.Quota
+		}
+		if cpu.Period != nil {
+			hostConfig.CPUPeriod = int64(*cpu.Period)
+		}
+		hostConfig.CpusetCpus = cpu.Cpus
+	}
+
+	return docker.Container{
+		ContainerJSON: &dtypes.ContainerJSON{
+			ContainerJSONBase: &dtypes.ContainerJSONBase{
+				ID:      container.ID,
+				Name:    name,
+				Created: createdAt,
+				// containerd doesn't report when a task started, containers are usually started right after being created.
+				State:      &dtypes.ContainerState{Running: true, StartedAt: createdAt},
+				HostConfig: hostConfig,
+			},
+			Config: config,
+		},
+		EnvMap: docker.ContainerEnvToMap(config.Env),
+	}
+}
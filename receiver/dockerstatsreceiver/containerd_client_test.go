@@ -0,0 +1,242 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package dockerstatsreceiver
+
+import (
+	"context"
+	"net"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/containerd/cgroups/v3/cgroup1/stats"
+	v2 "github.com/containerd/cgroups/v3/cgroup2/stats"
+	containersapi "github.com/containerd/containerd/api/services/containers/v1"
+	eventsapi "github.com/containerd/containerd/api/services/events/v1"
+	tasksapi "github.com/containerd/containerd/api/services/tasks/v1"
+	ctypes "github.com/containerd/containerd/api/types"
+	"github.com/containerd/containerd/api/types/task"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/collector/receiver/receivertest"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/protobuf/types/known/anypb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+const testContainerdSpec = `{
+	"hostname": "web-host",
+	"process": {"args": ["nginx", "-g", "daemon off;"], "env": ["PATH=/usr/bin", "APP_VERSION=1.2.3"]},
+	"linux": {"resources": {"cpu": {"shares": 512, "quota": 50000, "period": 100000}}}
+}`
+
+type fakeContainerd struct {
+	tasksapi.UnimplementedTasksServer
+	eventsapi.UnimplementedEventsServer
+
+	namespaces chan string
+	metrics    *anypb.Any
+}
+
+func (f *fakeContainerd) recordNamespace(ctx context.Context) {
+	md, _ := metadata.FromIncomingContext(ctx)
+	for _, ns := range md.Get(containerdNamespaceHeader) {
+		select {
+		case f.namespaces <- ns:
+		default:
+		}
+	}
+}
+
+func (f *fakeContainerd) List(ctx context.Context, _ *tasksapi.ListTasksRequest) (*tasksapi.ListTasksResponse, error) {
+	f.recordNamespace(ctx)
+	return &tasksapi.ListTasksResponse{Tasks: []*task.Process{
+		{ID: "web", Pid: 42, Status: task.Status_RUNNING},
+		{ID: "stopped", Status: task.Status_STOPPED},
+		{ID: "pause", Pid: 43, Status: task.Status_RUNNING},
+	}}, nil
+}
+
+func (f *fakeContainerd) Metrics(_ context.Context, req *tasksapi.MetricsRequest) (*tasksapi.MetricsResponse, error) {
+	if len(req.Filters) != 1 || req.Filters[0] != "id==web" {
+		return &tasksapi.MetricsResponse{}, nil
+	}
+	return &tasksapi.MetricsResponse{Metrics: []*ctypes.Metric{{ID: "web", Timestamp: timestamppb.Now(), Data: f.metrics}}}, nil
+}
+
+type fakeContainersServer struct {
+	containersapi.UnimplementedContainersServer
+}
+
+func (fakeContainersServer) List(context.Context, *containersapi.ListContainersRequest) (*containersapi.ListContainersResponse, error) {
+	spec := &anypb.Any{TypeUrl: "types.containerd.io/opencontainers/runtime-spec/1/Spec", Value: []byte(testContainerdSpec)}
+	created := timestamppb.New(time.Now().Add(-time.Minute))
+	return &containersapi.ListContainersResponse{Containers: []*containersapi.Container{
+		{ID: "web", Image: "docker.io/library/nginx:latest", Labels: map[string]string{"nerdctl/name": "web", "app": "frontend"}, Spec: spec, CreatedAt: created},
+		{ID: "stopped", Image: "docker.io/library/nginx:latest", CreatedAt: created},
+		{ID: "pause", Image: "registry.k8s.io/pause:3.9", CreatedAt: created},
+	}}, nil
+}
+
+func (f *fakeContainerd) Subscribe(_ *eventsapi.SubscribeRequest, stream eventsapi.Events_SubscribeServer) error {
+	<-stream.Context().Done()
+	return nil
+}
+
+func startFakeContainerd(t *testing.T, fake *fakeContainerd) string {
+	socket := filepath.Join(t.TempDir(), "containerd.sock")
+	listener, err := net.Listen("unix", socket)
+	require.NoError(t, err)
+
+	srv := grpc.NewServer()
+	tasksapi.RegisterTasksServer(srv, fake)
+	containersapi.RegisterContainersServer(srv, fakeContainersServer{})
+	eventsapi.RegisterEventsServer(srv, fake)
+	go func() {
+		_ = srv.Serve(listener)
+	}()
+	t.Cleanup(srv.Stop)
+	return "unix://" + socket
+}
+
+func TestContainerdScrape(t *testing.T) {
+	metrics, err := anypb.New(&stats.Metrics{
+		CPU: &stats.CPUStat{
+			Usage:      &stats.CPUUsage{Total: 2_000_000, Kernel: 500_000, User: 1_500_000, PerCPU: []uint64{1_200_000, 800_000}},
+			Throttling: &stats.Throttle{Periods: 10, ThrottledPeriods: 2, ThrottledTime: 3000},
+		},
+		Memory: &stats.MemoryStat{
+			TotalInactiveFile: 1024,
+			Usage:             &stats.MemoryEntry{Usage: 4096, Limit: 8192, Max: 5000},
+		},
+		Pids: &stats.PidsStat{Current: 3, Limit: 100},
+	})
+	require.NoError(t, err)
+	fake := &fakeContainerd{namespaces: make(chan string, 10), metrics: metrics}
+
+	cfg := createDefaultConfig().(*Config)
+	cfg.Runtime = runtimeContainerd
+	cfg.Endpoint = startFakeContainerd(t, fake)
+	cfg.ContainerdNamespace = "k8s.io"
+	cfg.ExcludedImages = []string{"registry.k8s.io/pause:*"}
+	cfg.EnvVarsToMetricLabels = map[string]string{"APP_VERSION": "app.version"}
+	cfg.ContainerLabelsToMetricLabels = map[string]string{"app": "app.name"}
+	cfg.MetricsBuilderConfig.ResourceAttributes.ContainerCommandLine.Enabled = true
+	cfg.MetricsBuilderConfig.Metrics.ContainerPidsCount.Enabled = true
+	cfg.MetricsBuilderConfig.Metrics.ContainerCPULogicalCount.Enabled = true
+	cfg.MetricsBuilderConfig.Metrics.ContainerCPULimit.Enabled = true
+	cfg.MetricsBuilderConfig.Metrics.ContainerCPUShares.Enabled = true
+
+	r := newMetricsReceiver(receivertest.NewNopCreateSettings(), cfg)
+	require.NoError(t, r.start(context.Background(), nil))
+	defer func() { require.NoError(t, r.shutdown(context.Background())) }()
+	assert.Equal(t, "k8s.io", <-fake.namespaces)
+
+	containers := r.client.Containers()
+	require.Len(t, containers, 1)
+	assert.Equal(t, "web", containers[0].Name)
+	assert.Equal(t, "web-host", containers[0].Config.Hostname)
+	assert.Equal(t, int64(512), containers[0].HostConfig.CPUShares)
+
+	md, err := r.scrapeV2(context.Background())
+	require.NoError(t, err)
+	require.Equal(t, 1, md.ResourceMetrics().Len())
+
+	rm := md.ResourceMetrics().At(0)
+	attrs := rm.Resource().Attributes().AsRaw()
+	assert.Equal(t, "containerd", attrs["container.runtime"])
+	assert.Equal(t, "web", attrs["container.id"])
+	assert.Equal(t, "web", attrs["container.name"])
+	assert.Equal(t, "docker.io/library/nginx:latest", attrs["container.image.name"])
+	assert.Equal(t, "nginx -g daemon off;", attrs["container.command_line"])
+	assert.Equal(t, "1.2.3", attrs["app.version"])
+	assert.Equal(t, "frontend", attrs["app.name"])
+
+	values := map[string]any{}
+	ms := rm.ScopeMetrics().At(0).Metrics()
+	for i := 0; i < ms.Len(); i++ {
+		m := ms.At(i)
+		switch {
+		case m.Type().String() == "Sum" && m.Sum().DataPoints().Len() == 1:
+			values[m.Name()] = m.Sum().DataPoints().At(0).IntValue()
+		case m.Type().String() == "Gauge" && m.Gauge().DataPoints().Len() == 1:
+			dp := m.Gauge().DataPoints().At(0)
+			if dp.ValueType().String() == "Double" {
+				values[m.Name()] = dp.DoubleValue()
+			} else {
+				values[m.Name()] = dp.IntValue()
+			}
+		}
+	}
+	assert.Equal(t, int64(2_000_000), values["container.cpu.usage.total"])
+	assert.Equal(t, int64(500_000), values["container.cpu.usage.kernelmode"])
+	assert.Equal(t, int64(3072), values["container.memory.usage.total"])
+	assert.Equal(t, int64(8192), values["container.memory.usage.limit"])
+	assert.Equal(t, int64(3), values["container.pids.count"])
+	assert.Equal(t, int64(2), values["container.cpu.logical.count"])
+	assert.Equal(t, 0.5, values["container.cpu.limit"])
+	assert.Equal(t, int64(512), values["container.cpu.shares"])
+}
+
+func TestContainerdContainerGone(t *testing.T) {
+	fake := &fakeContainerd{namespaces: make(chan string, 10)}
+	cfg := createDefaultConfig().(*Config)
+	cfg.Runtime = runtimeContainerd
+	cfg.Endpoint = startFakeContainerd(t, fake)
+
+	client, err := newContainerdClient(cfg, receivertest.NewNopCreateSettings().Logger)
+	require.NoError(t, err)
+	defer client.Close()
+	require.NoError(t, client.LoadContainerList(context.Background()))
+	require.Len(t, client.Containers(), 2)
+
+	pause := client.runningCache["pause"]
+	_, err = client.FetchContainerStatsAsJSON(context.Background(), pause)
+	assert.EqualError(t, err, "no metrics found for container pause")
+	assert.Len(t, client.Containers(), 1)
+}
+
+func TestCgroupV2MetricsToStatsJSON(t *testing.T) {
+	data, err := anypb.New(&v2.Metrics{
+		CPU:    &v2.CPUStat{UsageUsec: 2000, UserUsec: 1500, SystemUsec: 500, NrPeriods: 10, NrThrottled: 2, ThrottledUsec: 3},
+		Memory: &v2.MemoryStat{Usage: 4096, UsageLimit: 8192, InactiveFile: 1024, Anon: 2048},
+		Io:     &v2.IOStat{Usage: []*v2.IOEntry{{Major: 8, Minor: 0, Rbytes: 100, Wbytes: 200, Rios: 1, Wios: 2}}},
+		Pids:   &v2.PidsStat{Current: 3, Limit: 100},
+	})
+	require.NoError(t, err)
+
+	s, err := cgroupMetricsToStatsJSON(&ctypes.Metric{ID: "web", Data: data})
+	require.NoError(t, err)
+	assert.Equal(t, uint64(2_000_000), s.CPUStats.CPUUsage.TotalUsage)
+	assert.Equal(t, uint64(500_000), s.CPUStats.CPUUsage.UsageInKernelmode)
+	assert.Equal(t, uint64(1_500_000), s.CPUStats.CPUUsage.UsageInUsermode)
+	assert.Equal(t, uint64(3000), s.CPUStats.ThrottlingData.ThrottledTime)
+	assert.Equal(t, uint64(4096), s.MemoryStats.Usage)
+	assert.Equal(t, uint64(8192), s.MemoryStats.Limit)
+	assert.Equal(t, uint64(1024), s.MemoryStats.Stats["inactive_file"])
+	assert.Equal(t, uint64(2048), s.MemoryStats.Stats["anon"])
+	require.Len(t, s.BlkioStats.IoServiceBytesRecursive, 2)
+	assert.Equal(t, "read", s.BlkioStats.IoServiceBytesRecursive[0].Op)
+	assert.Equal(t, uint64(100), s.BlkioStats.IoServiceBytesRecursive[0].Value)
+	assert.Equal(t, uint64(2), s.BlkioStats.IoServicedRecursive[1].Value)
+	assert.Equal(t, uint64(3), s.PidsStats.Current)
+
+	_, err = cgroupMetricsToStatsJSON(&ctypes.Metric{ID: "web", Data: &anypb.Any{TypeUrl: "io.containerd.windows.Metrics"}})
+	assert.EqualError(t, err, `unsupported containerd metrics type "io.containerd.windows.Metrics"`)
+}
+
+func TestReadSystemCPUUsage(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "stat")
+	require.NoError(t, os.WriteFile(path, []byte("cpu  100 0 50 800 20 5 5 0 0 0\ncpu0 100 0 50 800 20 5 5 0 0 0\n"), 0600))
+
+	original := procStatPath
+	procStatPath = path
+	defer func() { procStatPath = original }()
+	assert.Equal(t, uint64(980*10_000_000), readSystemCPUUsage())
+
+	procStatPath = filepath.Join(t.TempDir(), "missing")
+	assert.Equal(t, uint64(0), readSystemCPUUsage())
+}
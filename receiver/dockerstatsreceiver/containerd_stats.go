@@ -0,0 +1,271 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package dockerstatsreceiver // import "github.com/open-telemetry/opentelemetry-collector-contrib/receiver/dockerstatsreceiver"
+
+import (
+	"bufio"
+	"fmt"
+	"os"
+	"runtime"
+	"strconv"
+	"strings"
+
+	"github.com/containerd/cgroups/v3/cgroup1/stats"
+	v2 "github.com/containerd/cgroups/v3/cgroup2/stats"
+	ctypes "github.com/containerd/containerd/api/types"
+	dtypes "github.com/docker/docker/api/types"
+	"google.golang.org/protobuf/proto"
+)
+
+const (
+	cgroupV1MetricsType = "io.containerd.cgroups.v1.Metrics"
+	cgroupV2MetricsType = "io.containerd.cgroups.v2.Metrics"
+)
+
+// cgroupMetricsToStatsJSON translates the cgroup metrics of a containerd task to docker stats,
+// following the translation done by the docker daemon for the same cgroup files.
+func cgroupMetricsToStatsJSON(metric *ctypes.Metric) (*dtypes.StatsJSON, error) {
+	if metric.Data == nil {
+		return nil, fmt.Errorf("no metrics data for task %s", metric.ID)
+	}
+
+	statsJSON := &dtypes.StatsJSON{}
+	if metric.Timestamp != nil {
+		statsJSON.Read = metric.Timestamp.AsTime()
+	}
+
+	// The type URL may be prefixed, e.g. by "type.googleapis.com/".
+	typeURL := metric.Data.TypeUrl
+	typeURL = typeURL[strings.LastIndex(typeURL, "/")+1:]
+	switch typeURL {
+	case cgroupV1MetricsType:
+		m := &stats.Metrics{}
+		if err := proto.Unmarshal(metric.Data.Value, m); err != nil {
+			return nil, fmt.Errorf("could not decode cgroup v1 metrics: %w", err)
+		}
+		setCgroupV1Stats(statsJSON, m)
+	case cgroupV2MetricsType:
+		m := &v2.Metrics{}
+		if err := proto.Unmarshal(metric.Data.Value, m); err != nil {
+			return nil, fmt.Errorf("could not decode cgroup v2 metrics: %w", err)
+		}
+		setCgroupV2Stats(statsJSON, m)
+	default:
+		return nil, fmt.Errorf("unsupported containerd metrics type %q", metric.Data.TypeUrl)
+	}
+	return statsJSON, nil
+}
+
+func setCgroupV1Stats(s *dtypes.StatsJSON, m *stats.Metrics) {
+	if cpu := m.GetCPU(); cpu != nil {
+		if usage := cpu.GetUsage(); usage != nil {
+			s.CPUStats.CPUUsage = dtypes.CPUUsage{
+				TotalUsage:        usage.Total,
+				PercpuUsage:       usage.PerCPU,
+				UsageInKernelmode: usage.Kernel,
+				UsageInUsermode:   usage.User,
+			}
+			s.CPUStats.OnlineCPUs = uint32(len(usage.PerCPU))
+		}
+		if throttling := cpu.GetThrottling(); throttling != nil {
+			s.CPUStats.ThrottlingData = dtypes.ThrottlingData{
+				Periods:          throttling.Periods,
+				ThrottledPeriods: throttling.ThrottledPeriods,
+				ThrottledTime:    throttling.ThrottledTime,
+			}
+		}
+	}
+
+	if mem := m.GetMemory(); mem != nil {
+		s.MemoryStats.Stats = map[string]uint64{
+			"cache":                     mem.Cache,
+			"rss":                       mem.RSS,
+			"rss_huge":                  mem.RSSHuge,
+			"mapped_file":               mem.MappedFile,
+			"dirty":                     mem.Dirty,
+			"writeback":                 mem.Writeback,
+			"pgpgin":                    mem.PgPgIn,
+			"pgpgout":                   mem.PgPgOut,
+			"pgfault":                   mem.PgFault,
+			"pgmajfault":                mem.PgMajFault,
+			"inactive_anon":             mem.InactiveAnon,
+			"active_anon":               mem.ActiveAnon,
+			"inactive_file":             mem.InactiveFile,
+			"active_file":               mem.ActiveFile,
+			"unevictable":               mem.Unevictable,
+			"hierarchical_memory_limit": mem.HierarchicalMemoryLimit,
+			"hierarchical_memsw_limit":  mem.HierarchicalSwapLimit,
+			"total_cache":               mem.TotalCache,
+			"total_rss":                 mem.TotalRSS,
+			"total_rss_huge":            mem.TotalRSSHuge,
+			"total_mapped_file":         mem.TotalMappedFile,
+			"total_dirty":               mem.TotalDirty,
+			"total_writeback":           mem.TotalWriteback,
+			"total_pgpgin":              mem.TotalPgPgIn,
+			"total_pgpgout":             mem.TotalPgPgOut,
+			"total_pgfault":             mem.TotalPgFault,
+			"total_pgmajfault":          mem.TotalPgMajFault,
+			"total_inactive_anon":       mem.TotalInactiveAnon,
+			"total_active_anon":         mem.TotalActiveAnon,
+			"total_inactive_file":       mem.TotalInactiveFile,
+			"total_active_file":         mem.TotalActiveFile,
+			"total_unevictable":         mem.TotalUnevictable,
+		}
+		if usage := mem.GetUsage(); usage != nil {
+			s.MemoryStats.Usage = usage.Usage
+			s.MemoryStats.MaxUsage = usage.Max
+			s.MemoryStats.Failcnt = usage.Failcnt
+			s.MemoryStats.Limit = usage.Limit
+		}
+	}
+
+	if blkio := m.GetBlkio(); blkio != nil {
+		s.BlkioStats = dtypes.BlkioStats{
+			IoServiceBytesRecursive: toBlkioStatEntries(blkio.IoServiceBytesRecursive),
+			IoServicedRecursive:     toBlkioStatEntries(blkio.IoServicedRecursive),
+			IoQueuedRecursive:       toBlkioStatEntries(blkio.IoQueuedRecursive),
+			IoServiceTimeRecursive:  toBlkioStatEntries(blkio.IoServiceTimeRecursive),
+			IoWaitTimeRecursive:     toBlkioStatEntries(blkio.IoWaitTimeRecursive),
+			IoMergedRecursive:       toBlkioStatEntries(blkio.IoMergedRecursive),
+			IoTimeRecursive:         toBlkioStatEntries(blkio.IoTimeRecursive),
+			SectorsRecursive:        toBlkioStatEntries(blkio.SectorsRecursive),
+		}
+	}
+
+	if pids := m.GetPids(); pids != nil {
+		s.PidsStats = dtypes.PidsStats{Current: pids.Current, Limit: pids.Limit}
+	}
+
+	if len(m.Network) > 0 {
+		s.Networks = make(map[string]dtypes.NetworkStats, len(m.Network))
+		for _, n := range m.Network {
+			s.Networks[n.Name] = dtypes.NetworkStats{
+				RxBytes:   n.RxBytes,
+				RxPackets: n.RxPackets,
+				RxErrors:  n.RxErrors,
+				RxDropped: n.RxDropped,
+				TxBytes:   n.TxBytes,
+				TxPackets: n.TxPackets,
+				TxErrors:  n.TxErrors,
+				TxDropped: n.TxDropped,
+			}
+		}
+	}
+}
+
+func toBlkioStatEntries(entries []*stats.BlkIOEntry) []dtypes.BlkioStatEntry {
+	out := make([]dtypes.BlkioStatEntry, 0, len(entries))
+	for _, e := range entries {
+		out = append(out, dtypes.BlkioStatEntry{Major: e.Major, Minor: e.Minor, Op: e.Op, Value: e.Value})
+	}
+	return out
+}
+
+func setCgroupV2Stats(s *dtypes.StatsJSON, m *v2.Metrics) {
+	if cpu := m.GetCPU(); cpu != nil {
+		// cgroup v2 reports CPU times in microseconds, docker stats in nanoseconds.
+		s.CPUStats.CPUUsage = dtypes.CPUUsage{
+			TotalUsage:        cpu.UsageUsec * 1000,
+			UsageInKernelmode: cpu.SystemUsec * 1000,
+			UsageInUsermode:   cpu.UserUsec * 1000,
+		}
+		s.CPUStats.ThrottlingData = dtypes.ThrottlingData{
+			Periods:          cpu.NrPeriods,
+			ThrottledPeriods: cpu.NrThrottled,
+			ThrottledTime:    cpu.ThrottledUsec * 1000,
+		}
+		s.CPUStats.OnlineCPUs = uint32(runtime.NumCPU())
+	}
+
+	if mem := m.GetMemory(); mem != nil {
+		s.MemoryStats = dtypes.MemoryStats{
+			Usage: mem.Usage,
+			Limit: mem.UsageLimit,
+			Stats: map[string]uint64{
+				"anon":                   mem.Anon,
+				"file":                   mem.File,
+				"kernel_stack":           mem.KernelStack,
+				"slab":                   mem.Slab,
+				"sock":                   mem.Sock,
+				"shmem":                  mem.Shmem,
+				"file_mapped":            mem.FileMapped,
+				"file_dirty":             mem.FileDirty,
+				"file_writeback":         mem.FileWriteback,
+				"anon_thp":               mem.AnonThp,
+				"inactive_anon":          mem.InactiveAnon,
+				"active_anon":            mem.ActiveAnon,
+				"inactive_file":          mem.InactiveFile,
+				"active_file":            mem.ActiveFile,
+				"unevictable":            mem.Unevictable,
+				"slab_reclaimable":       mem.SlabReclaimable,
+				"slab_unreclaimable":     mem.SlabUnreclaimable,
+				"pgfault":                mem.Pgfault,
+				"pgmajfault":             mem.Pgmajfault,
+				"workingset_refault":     mem.WorkingsetRefault,
+				"workingset_activate":    mem.WorkingsetActivate,
+				"workingset_nodereclaim": mem.WorkingsetNodereclaim,
+				"pgrefill":               mem.Pgrefill,
+				"pgscan":                 mem.Pgscan,
+				"pgsteal":                mem.Pgsteal,
+				"pgactivate":             mem.Pgactivate,
+				"pgdeactivate":           mem.Pgdeactivate,
+				"pglazyfree":             mem.Pglazyfree,
+				"pglazyfreed":            mem.Pglazyfreed,
+				"thp_fault_alloc":        mem.ThpFaultAlloc,
+				"thp_collapse_alloc":     mem.ThpCollapseAlloc,
+			},
+		}
+	}
+
+	if io := m.GetIo(); io != nil {
+		for _, e := range io.Usage {
+			s.BlkioStats.IoServiceBytesRecursive = append(s.BlkioStats.IoServiceBytesRecursive,
+				dtypes.BlkioStatEntry{Major: e.Major, Minor: e.Minor, Op: "read", Value: e.Rbytes},
+				dtypes.BlkioStatEntry{Major: e.Major, Minor: e.Minor, Op: "write", Value: e.Wbytes})
+			s.BlkioStats.IoServicedRecursive = append(s.BlkioStats.IoServicedRecursive,
+				dtypes.BlkioStatEntry{Major: e.Major, Minor: e.Minor, Op: "read", Value: e.Rios},
+				dtypes.BlkioStatEntry{Major: e.Major, Minor: e.Minor, Op: "write", Value: e.Wios})
+		}
+	}
+
+	if pids := m.GetPids(); pids != nil {
+		s.PidsStats = dtypes.PidsStats{Current: pids.Current, Limit: pids.Limit}
+	}
+}
+
+// procStatPath is the file the system CPU usage is read from.
+var procStatPath = "/proc/stat"
+
+// clockTicksPerSecond is the USER_HZ /proc/stat times are expressed in, which is 100 on all
+// architectures supported by containerd.
+const clockTicksPerSecond = 100
+
+// readSystemCPUUsage returns the CPU time of the host in nanoseconds, like the docker daemon
+// reports it in the system_cpu_usage stat, or 0 if it can't be read.
+func readSystemCPUUsage() uint64 {
+	f, err := os.Open(procStatPath)
+	if err != nil {
+		return 0
+	}
+	defer f.Close()
+
+	scanner := bufio.NewScanner(f)
+	for scanner.Scan() {
+		fields := strings.Fields(scanner.Text())
+		if len(fields) < 8 || fields[0] != "cpu" {
+			continue
+		}
+		var ticks uint64
+		// user, nice, system, idle, iowait, irq and softirq.
+		for _, field := range fields[1:8] {
+			v, err := strconv.ParseUint(field, 10, 64)
+			if err != nil {
+				return 0
+			}
+			ticks += v
+		}
+		return ticks * (1e9 / clockTicksPerSecond)
+	}
+	return 0
+}
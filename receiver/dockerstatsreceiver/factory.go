@@ -28,7 +28,9 @@ func createDefaultConfig() component.Config {
 	scs.Timeout = 5 * time.Second
 	return &Config{
 		ControllerConfig:     scs,
-		Endpoint:             "unix:///var/run/docker.sock",
+		Runtime:              runtimeDocker,
+		Endpoint:             defaultEndpoints[runtimeDocker],
+		ContainerdNamespace:  "default",
 		DockerAPIVersion:     defaultDockerAPIVersion,
 		MetricsBuilderConfig: metadata.DefaultMetricsBuilderConfig(),
 	}
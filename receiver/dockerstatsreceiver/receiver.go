@@ -6,6 +6,7 @@ package dockerstatsreceiver // import "github.com/open-telemetry/opentelemetry-c
 import (
 	"context"
 	"fmt"
+	"io"
 	"strconv"
 	"strings"
 	"sync"
@@ -35,10 +36,18 @@ type resultV2 struct {
 	err       error
 }
 
+// containerClient is the interface of the clients collecting container stats from the supported runtimes.
+type containerClient interface {
+	LoadContainerList(ctx context.Context) error
+	ContainerEventLoop(ctx context.Context)
+	Containers() []docker.Container
+	FetchContainerStatsAsJSON(ctx context.Context, container docker.Container) (*dtypes.StatsJSON, error)
+}
+
 type metricsReceiver struct {
 	config   *Config
 	settings receiver.CreateSettings
-	client   *docker.Client
+	client   containerClient
 	mb       *metadata.MetricsBuilder
 	cancel   context.CancelFunc
 }
@@ -52,12 +61,18 @@ func newMetricsReceiver(set receiver.CreateSettings, config *Config) *metricsRec
 }
 
 func (r *metricsReceiver) start(ctx context.Context, _ component.Host) error {
-	dConfig, err := docker.NewConfig(r.config.Endpoint, r.config.Timeout, r.config.ExcludedImages, r.config.DockerAPIVersion)
-	if err != nil {
-		return err
+	var err error
+	if r.config.Runtime == runtimeContainerd {
+		r.client, err = newContainerdClient(r.config, r.settings.Logger)
+	} else {
+		// Podman serves the docker API, its containers are collected with the docker client.
+		var dConfig *docker.Config
+		dConfig, err = docker.NewConfig(r.config.Endpoint, r.config.Timeout, r.config.ExcludedImages, r.config.DockerAPIVersion)
+		if err != nil {
+			return err
+		}
+		r.client, err = docker.NewDockerClient(dConfig, r.settings.Logger)
 	}
-
-	r.client, err = docker.NewDockerClient(dConfig, r.settings.Logger)
 	if err != nil {
 		return err
 	}
@@ -77,6 +92,9 @@ func (r *metricsReceiver) shutdown(context.Context) error {
 	if r.cancel != nil {
 		r.cancel()
 	}
+	if closer, ok := r.client.(io.Closer); ok {
+		return closer.Close()
+	}
 	return nil
 }
 
@@ -139,7 +157,7 @@ func (r *metricsReceiver) recordContainerStats(now pcommon.Timestamp, containerS
 
 	// Always-present resource attrs + the user-configured resource attrs
 	rb := r.mb.NewResourceBuilder()
-	rb.SetContainerRuntime("docker")
+	rb.SetContainerRuntime(r.runtime())
 	rb.SetContainerHostname(container.Config.Hostname)
 	rb.SetContainerID(container.ID)
 	rb.SetContainerImageName(container.Config.Image)
@@ -163,6 +181,14 @@ func (r *metricsReceiver) recordContainerStats(now pcommon.Timestamp, containerS
 	return errs
 }
 
+// runtime returns the name of the container runtime stats are collected from.
+func (r *metricsReceiver) runtime() string {
+	if r.config.Runtime == "" {
+		return runtimeDocker
+	}
+	return r.config.Runtime
+}
+
 func (r *metricsReceiver) recordMemoryMetrics(now pcommon.Timestamp, memoryStats *dtypes.MemoryStats) {
 	totalUsage := calculateMemUsageNoCache(memoryStats)
 	r.mb.RecordContainerMemoryUsageTotalDataPoint(now, int64(totalUsage))
@@ -0,0 +1,172 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package execreceiver // import "github.com/open-telemetry/opentelemetry-collector-contrib/receiver/execreceiver"
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"strings"
+	"time"
+
+	"go.opentelemetry.io/collector/component"
+)
+
+const (
+	outputText = "text"
+	outputJSON = "json"
+
+	defaultCollectionInterval = time.Minute
+	defaultTimeout            = 30 * time.Second
+	defaultMaxOutputSize      = 1 << 20
+)
+
+// Config defines configuration for the exec receiver.
+type Config struct {
+	// CollectionInterval is the interval the commands are executed at, unless they set their own.
+	CollectionInterval time.Duration `mapstructure:"collection_interval"`
+	// Timeout of each execution of the commands, unless they set their own.
+	Timeout time.Duration `mapstructure:"timeout"`
+
+	// Commands are the commands executed, and how their output is converted to logs.
+	Commands []CommandConfig `mapstructure:"commands"`
+}
+
+// CommandConfig defines a command executed on its own schedule, the limits of its executions, and how its output is
+// converted to logs.
+type CommandConfig struct {
+	// Name identifies the command in the logs, the command line when not set.
+	Name string `mapstructure:"name"`
+	// Command is the path of the executable and its arguments. The executable is found in the PATH of the collector
+	// when the path has no separator, and no shell is involved.
+	Command []string `mapstructure:"command"`
+	// WorkingDirectory is the directory the command is executed in, the one of the collector when not set.
+	WorkingDirectory string `mapstructure:"working_directory"`
+	// Output is how the standard output is converted: text (default) for a log record per line holding the line in
+	// its body, or json for a JSON object per line mapped to a log record.
+	Output string `mapstructure:"output"`
+
+	// CollectionInterval is the interval the command is executed at, the collection_interval of the receiver when
+	// not set. Each command is executed on its own schedule, and an execution never overlaps the previous one.
+	CollectionInterval time.Duration `mapstructure:"collection_interval"`
+	// Timeout of each execution, the timeout of the receiver when not set. The processes of the command are killed
+	// when it expires.
+	Timeout time.Duration `mapstructure:"timeout"`
+	// CPUTimeLimit is the CPU time each execution may use before being killed, unlimited when not set. Only
+	// supported on Linux.
+	CPUTimeLimit time.Duration `mapstructure:"cpu_time_limit"`
+	// MaxOutputSize is the number of bytes of the standard output of each execution converted to logs, 1 MiB when
+	// not set. The rest of the output is discarded.
+	MaxOutputSize int `mapstructure:"max_output_size"`
+
+	// Environment holds the environment variables of the command. The command doesn't inherit the environment of
+	// the collector, except the variables listed in InheritEnvironment.
+	Environment map[string]string `mapstructure:"environment"`
+	// InheritEnvironment lists the environment variables of the collector passed to the command.
+	InheritEnvironment []string `mapstructure:"inherit_environment"`
+	// User is the name or the id of the user the command is executed as, the user of the collector when not set.
+	// The collector must be allowed to change its user. Not supported on Windows.
+	User string `mapstructure:"user"`
+}
+
+var _ component.Config = (*Config)(nil)
+
+// Validate checks the receiver configuration is valid.
+func (cfg *Config) Validate() error {
+	var errs error
+	if cfg.CollectionInterval <= 0 {
+		errs = errors.Join(errs, errors.New("collection_interval must be positive"))
+	}
+	if cfg.Timeout <= 0 {
+		errs = errors.Join(errs, errors.New("timeout must be positive"))
+	}
+	if len(cfg.Commands) == 0 {
+		errs = errors.Join(errs, errors.New("at least one command must be specified"))
+	}
+	names := map[string]bool{}
+	for i := range cfg.Commands {
+		cmd := &cfg.Commands[i]
+		if err := cmd.validate(); err != nil {
+			errs = errors.Join(errs, fmt.Errorf("commands[%d]: %w", i, err))
+			continue
+		}
+		if names[cmd.name()] {
+			errs = errors.Join(errs, fmt.Errorf("commands[%d]: duplicate name %q", i, cmd.name()))
+		}
+		names[cmd.name()] = true
+	}
+	return errs
+}
+
+func (cmd *CommandConfig) validate() error {
+	if len(cmd.Command) == 0 || cmd.Command[0] == "" {
+		return errors.New("command must be specified")
+	}
+	var errs error
+	switch cmd.Output {
+	case "", outputText, outputJSON:
+	default:
+		errs = errors.Join(errs, fmt.Errorf("unsupported output %q, must be %s or %s", cmd.Output, outputText, outputJSON))
+	}
+	if cmd.CollectionInterval < 0 {
+		errs = errors.Join(errs, errors.New("collection_interval must not be negative"))
+	}
+	if cmd.Timeout < 0 {
+		errs = errors.Join(errs, errors.New("timeout must not be negative"))
+	}
+	if cmd.CPUTimeLimit < 0 {
+		errs = errors.Join(errs, errors.New("cpu_time_limit must not be negative"))
+	}
+	if cmd.CPUTimeLimit > 0 && !cpuTimeLimitSupported {
+		errs = errors.Join(errs, errors.New("cpu_time_limit is only supported on Linux"))
+	}
+	if cmd.MaxOutputSize < 0 {
+		errs = errors.Join(errs, errors.New("max_output_size must not be negative"))
+	}
+	if cmd.User != "" && !userSupported {
+		errs = errors.Join(errs, errors.New("user is not supported on this platform"))
+	}
+	for name := range cmd.Environment {
+		if name == "" || strings.ContainsAny(name, "=\x00") {
+			errs = errors.Join(errs, fmt.Errorf("invalid environment variable name %q", name))
+		}
+	}
+	return errs
+}
+
+// name returns the name of the command in the logs.
+func (cmd *CommandConfig) name() string {
+	if cmd.Name != "" {
+		return cmd.Name
+	}
+	return strings.Join(cmd.Command, " ")
+}
+
+// environment returns the environment variables of the command.
+func (cmd *CommandConfig) environment() []string {
+	env := make([]string, 0, len(cmd.InheritEnvironment)+len(cmd.Environment))
+	for _, name := range cmd.InheritEnvironment {
+		if value, ok := os.LookupEnv(name); ok {
+			env = append(env, name+"="+value)
+		}
+	}
+	for name, value := range cmd.Environment {
+		env = append(env, name+"="+value)
+	}
+	return env
+}
+
+func (cmd *CommandConfig) output() string {
+	if cmd.Output == "" {
+		return outputText
+	}
+	return cmd.Output
+}
+
+func (cmd *CommandConfig) maxOutputSize() int {
+	if cmd.MaxOutputSize == 0 {
+		return defaultMaxOutputSize
+	}
+	return cmd.MaxOutputSize
+}
@@ -0,0 +1,118 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package execreceiver
+
+import (
+	"path/filepath"
+	"runtime"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/collector/component"
+	"go.opentelemetry.io/collector/confmap/confmaptest"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/receiver/execreceiver/internal/metadata"
+)
+
+func TestLoadConfig(t *testing.T) {
+	t.Parallel()
+
+	cm, err := confmaptest.LoadConf(filepath.Join("testdata", "config.yaml"))
+	require.NoError(t, err)
+
+	defaultCfg := createDefaultConfig().(*Config)
+	defaultCfg.Commands = []CommandConfig{{Command: []string{"uptime"}}}
+
+	tests := []struct {
+		id           component.ID
+		expected     component.Config
+		errorMessage string
+	}{
+		{
+			id:       component.NewIDWithName(metadata.Type, ""),
+			expected: defaultCfg,
+		},
+		{
+			id: component.NewIDWithName(metadata.Type, "all_settings"),
+			expected: &Config{
+				CollectionInterval: 30 * time.Second,
+				Timeout:            5 * time.Second,
+				Commands: []CommandConfig{
+					{
+						Name:               "disk",
+						Command:            []string{"/usr/local/bin/check_disk", "--json"},
+						WorkingDirectory:   "/tmp",
+						Output:             outputJSON,
+						CollectionInterval: 5 * time.Minute,
+						Timeout:            20 * time.Second,
+						CPUTimeLimit:       2 * time.Second,
+						MaxOutputSize:      65536,
+						Environment:        map[string]string{"CHECK_LEVEL": "warning"},
+						InheritEnvironment: []string{"PATH"},
+						User:               "nobody",
+					},
+					{Command: []string{"uptime"}},
+				},
+			},
+		},
+		{
+			id:           component.NewIDWithName(metadata.Type, "no_commands"),
+			errorMessage: "at least one command must be specified",
+		},
+		{
+			id: component.NewIDWithName(metadata.Type, "invalid_command"),
+			errorMessage: "commands[0]: command must be specified\n" +
+				"commands[1]: unsupported output \"xml\", must be text or json\n" +
+				"max_output_size must not be negative",
+		},
+		{
+			id:           component.NewIDWithName(metadata.Type, "duplicate_names"),
+			errorMessage: `commands[1]: duplicate name "uptime"`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.id.String(), func(t *testing.T) {
+			factory := NewFactory()
+			cfg := factory.CreateDefaultConfig()
+
+			sub, err := cm.Sub(tt.id.String())
+			require.NoError(t, err)
+			require.NoError(t, sub.Unmarshal(cfg))
+
+			if tt.errorMessage != "" {
+				assert.ErrorContains(t, component.ValidateConfig(cfg), tt.errorMessage)
+				return
+			}
+			if runtime.GOOS != "linux" {
+				// The CPU time limit and the user aren't supported everywhere.
+				return
+			}
+			assert.NoError(t, component.ValidateConfig(cfg))
+			assert.Equal(t, tt.expected, cfg)
+		})
+	}
+}
+
+func TestValidateEnvironment(t *testing.T) {
+	cfg := createDefaultConfig().(*Config)
+	cfg.Commands = []CommandConfig{{
+		Command:     []string{"env"},
+		Environment: map[string]string{"A=B": "c", "VALID": "d"},
+	}}
+	assert.EqualError(t, cfg.Validate(), `commands[0]: invalid environment variable name "A=B"`)
+}
+
+func TestCommandEnvironment(t *testing.T) {
+	t.Setenv("EXEC_RECEIVER_INHERITED", "inherited")
+	t.Setenv("EXEC_RECEIVER_SECRET", "secret")
+	cmd := CommandConfig{
+		Command:            []string{"env"},
+		Environment:        map[string]string{"LEVEL": "warning"},
+		InheritEnvironment: []string{"EXEC_RECEIVER_INHERITED", "EXEC_RECEIVER_MISSING"},
+	}
+	assert.ElementsMatch(t, []string{"EXEC_RECEIVER_INHERITED=inherited", "LEVEL=warning"}, cmd.environment())
+}
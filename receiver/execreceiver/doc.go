@@ -0,0 +1,7 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+//go:generate mdatagen metadata.yaml
+
+// Package execreceiver executes local commands or scripts on schedules, and converts their output to logs.
+package execreceiver // import "github.com/open-telemetry/opentelemetry-collector-contrib/receiver/execreceiver"
@@ -0,0 +1,36 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+//go:build linux
+
+package execreceiver // import "github.com/open-telemetry/opentelemetry-collector-contrib/receiver/execreceiver"
+
+import (
+	"math"
+	"os/exec"
+	"strconv"
+	"time"
+)
+
+const cpuTimeLimitSupported = true
+
+// cpuTimeLimitShell is the shell limiting the CPU time of the process before replacing itself with the command.
+const cpuTimeLimitShell = "/bin/sh"
+
+// cpuTimeLimitScript sets the soft and hard limits passed as its first two arguments, in this order for the soft limit
+// never to exceed the hard one, and executes the rest.
+const cpuTimeLimitScript = `ulimit -S -t "$1" && ulimit -H -t "$2" && shift 2 && exec "$@"`
+
+// limitCPUTime makes the command limit the CPU time of its process before executing its program, so that the limit
+// applies from the start of the program. The process receives a SIGXCPU once the limit is reached and a SIGKILL a
+// second later, the limit being inherited by the processes it starts.
+func limitCPUTime(cmd *exec.Cmd, limit time.Duration) error {
+	if cmd.Err != nil {
+		return cmd.Err
+	}
+	seconds := uint64(math.Ceil(limit.Seconds()))
+	args := []string{"sh", "-c", cpuTimeLimitScript, "sh", strconv.FormatUint(seconds, 10), strconv.FormatUint(seconds+1, 10), cmd.Path}
+	cmd.Args = append(args, cmd.Args[1:]...)
+	cmd.Path = cpuTimeLimitShell
+	return nil
+}
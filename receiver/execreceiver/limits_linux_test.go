@@ -0,0 +1,61 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+//go:build linux
+
+package execreceiver
+
+import (
+	"context"
+	"os/exec"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/collector/consumer/consumertest"
+)
+
+func TestReceiverCPUTimeLimit(t *testing.T) {
+	sink := new(consumertest.LogsSink)
+	r, logs := newTestReceiver(t, CommandConfig{
+		Command:      []string{"sh", "-c", "echo started; while :; do :; done"},
+		Timeout:      20 * time.Second,
+		CPUTimeLimit: time.Second,
+	}, sink)
+
+	start := time.Now()
+	r.collect(context.Background(), &r.cfg.Commands[0])
+	assert.Less(t, time.Since(start), 10*time.Second)
+
+	assert.Equal(t, []string{"started"}, bodies(sink.AllLogs()[0]))
+	failures := logs.FilterMessage("The execution of the command failed").All()
+	require.Len(t, failures, 1)
+	assert.Contains(t, failures[0].ContextMap()["error"], "signal: CPU time limit exceeded")
+}
+
+func TestReceiverCPUTimeLimitAppliedBeforeStart(t *testing.T) {
+	sink := new(consumertest.LogsSink)
+	r, logs := newTestReceiver(t, CommandConfig{
+		Command:      []string{"sh", "-c", "ulimit -S -t; ulimit -H -t"},
+		CPUTimeLimit: 1500 * time.Millisecond,
+	}, sink)
+
+	r.collect(context.Background(), &r.cfg.Commands[0])
+
+	// the command sees the limits, rounded up to the second, as soon as it starts
+	assert.Empty(t, logs.All())
+	require.Len(t, sink.AllLogs(), 1)
+	assert.Equal(t, []string{"2", "3"}, bodies(sink.AllLogs()[0]))
+}
+
+func TestLimitCPUTime(t *testing.T) {
+	cmd := exec.Command("echo", "hello")
+	require.NoError(t, limitCPUTime(cmd, time.Second))
+	assert.Equal(t, cpuTimeLimitShell, cmd.Path)
+	path, err := exec.LookPath("echo")
+	require.NoError(t, err)
+	assert.Equal(t, []string{"sh", "-c", cpuTimeLimitScript, "sh", "1", "2", path, "hello"}, cmd.Args)
+
+	assert.Error(t, limitCPUTime(exec.Command("does-not-exist"), time.Second))
+}
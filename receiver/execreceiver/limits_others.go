@@ -0,0 +1,18 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+//go:build !linux
+
+package execreceiver // import "github.com/open-telemetry/opentelemetry-collector-contrib/receiver/execreceiver"
+
+import (
+	"errors"
+	"os/exec"
+	"time"
+)
+
+const cpuTimeLimitSupported = false
+
+func limitCPUTime(*exec.Cmd, time.Duration) error {
+	return errors.New("cpu_time_limit is only supported on Linux")
+}
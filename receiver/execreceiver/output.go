@@ -0,0 +1,167 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package execreceiver // import "github.com/open-telemetry/opentelemetry-collector-contrib/receiver/execreceiver"
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math"
+	"time"
+
+	"go.opentelemetry.io/collector/pdata/pcommon"
+	"go.opentelemetry.io/collector/pdata/plog"
+)
+
+// The fields of the JSON objects of the json output, each line holding an object mapped to a log record:
+//
+//	{"body": "queue is full", "severity_text": "error", "severity_number": 17, "attributes": {"queue": "orders"}}
+//
+// The other fields of the objects are added to the attributes of the records.
+const (
+	fieldBody           = "body"
+	fieldTimestamp      = "timestamp"
+	fieldSeverityText   = "severity_text"
+	fieldSeverityNumber = "severity_number"
+	fieldAttributes     = "attributes"
+)
+
+// commandAttribute is the attribute of the log records holding the name of the command.
+const commandAttribute = "exec.command"
+
+// limitedBuffer keeps the first limit bytes written to it, and discards the others without failing, for the
+// command not to be blocked or killed by a full or closed pipe.
+type limitedBuffer struct {
+	buf       bytes.Buffer
+	limit     int
+	truncated bool
+}
+
+func (b *limitedBuffer) Write(p []byte) (int, error) {
+	if remaining := b.limit - b.buf.Len(); len(p) > remaining {
+		b.truncated = true
+		b.buf.Write(p[:remaining])
+		return len(p), nil
+	}
+	return b.buf.Write(p)
+}
+
+// lines returns the non-empty lines of the output, without the last one when the output was truncated, as it is
+// likely incomplete.
+func (b *limitedBuffer) lines() [][]byte {
+	data := b.buf.Bytes()
+	if b.truncated {
+		data = data[:bytes.LastIndexByte(data, '\n')+1]
+	}
+	var lines [][]byte
+	for _, line := range bytes.Split(data, []byte("\n")) {
+		line = bytes.TrimSuffix(line, []byte("\r"))
+		if len(bytes.TrimSpace(line)) > 0 {
+			lines = append(lines, line)
+		}
+	}
+	return lines
+}
+
+// appendTextRecords appends a log record per line, holding the line in its body.
+func appendTextRecords(lines [][]byte, records plog.LogRecordSlice, name string, now pcommon.Timestamp) {
+	for _, line := range lines {
+		lr := records.AppendEmpty()
+		initRecord(lr, name, now)
+		lr.Body().SetStr(string(line))
+	}
+}
+
+// appendJSONRecords appends a log record per line, mapped from the JSON object of the line. The lines which aren't
+// valid objects are appended as text records, and their errors returned.
+func appendJSONRecords(lines [][]byte, records plog.LogRecordSlice, name string, now pcommon.Timestamp) error {
+	var errs error
+	for i, line := range lines {
+		lr := plog.NewLogRecord()
+		initRecord(lr, name, now)
+		var fields map[string]any
+		err := json.Unmarshal(line, &fields)
+		if err == nil && fields == nil {
+			err = errors.New("not an object")
+		}
+		if err == nil {
+			err = setRecordFields(lr, fields)
+		}
+		if err != nil {
+			errs = errors.Join(errs, fmt.Errorf("line %d: %w", i+1, err))
+			lr = plog.NewLogRecord()
+			initRecord(lr, name, now)
+			lr.Body().SetStr(string(line))
+		}
+		lr.MoveTo(records.AppendEmpty())
+	}
+	return errs
+}
+
+func initRecord(lr plog.LogRecord, name string, now pcommon.Timestamp) {
+	lr.SetObservedTimestamp(now)
+	lr.SetTimestamp(now)
+	lr.Attributes().PutStr(commandAttribute, name)
+}
+
+func setRecordFields(lr plog.LogRecord, fields map[string]any) error {
+	for key, value := range fields {
+		var err error
+		switch key {
+		case fieldBody:
+			err = lr.Body().FromRaw(value)
+		case fieldTimestamp:
+			var ts pcommon.Timestamp
+			if ts, err = timestampOf(value); err == nil {
+				lr.SetTimestamp(ts)
+			}
+		case fieldSeverityText:
+			text, ok := value.(string)
+			if !ok {
+				err = errors.New("the severity text must be a string")
+			}
+			lr.SetSeverityText(text)
+		case fieldSeverityNumber:
+			number, ok := value.(float64)
+			if !ok || number != math.Trunc(number) || number < float64(plog.SeverityNumberUnspecified) || number > float64(plog.SeverityNumberFatal4) {
+				err = fmt.Errorf("invalid severity number %v", value)
+			}
+			lr.SetSeverityNumber(plog.SeverityNumber(number))
+		case fieldAttributes:
+			attributes, ok := value.(map[string]any)
+			if !ok {
+				err = errors.New("the attributes must be an object")
+			}
+			for k, v := range attributes {
+				if err = lr.Attributes().PutEmpty(k).FromRaw(v); err != nil {
+					break
+				}
+			}
+		default:
+			err = lr.Attributes().PutEmpty(key).FromRaw(value)
+		}
+		if err != nil {
+			return fmt.Errorf("field %q: %w", key, err)
+		}
+	}
+	return nil
+}
+
+// timestampOf returns the timestamp of a RFC 3339 string or of a number of seconds since the epoch.
+func timestampOf(value any) (pcommon.Timestamp, error) {
+	switch v := value.(type) {
+	case float64:
+		sec, frac := math.Modf(v)
+		return pcommon.NewTimestampFromTime(time.Unix(int64(sec), int64(frac*1e9))), nil
+	case string:
+		t, err := time.Parse(time.RFC3339Nano, v)
+		if err != nil {
+			return 0, err
+		}
+		return pcommon.NewTimestampFromTime(t), nil
+	default:
+		return 0, fmt.Errorf("invalid timestamp %v", value)
+	}
+}
@@ -0,0 +1,84 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package execreceiver
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/collector/pdata/pcommon"
+	"go.opentelemetry.io/collector/pdata/plog"
+)
+
+func TestLimitedBuffer(t *testing.T) {
+	b := &limitedBuffer{limit: 16}
+	n, err := b.Write([]byte("first\nsecond\n"))
+	require.NoError(t, err)
+	assert.Equal(t, 13, n)
+	assert.False(t, b.truncated)
+
+	n, err = b.Write([]byte("third\n"))
+	require.NoError(t, err)
+	assert.Equal(t, 6, n)
+	assert.True(t, b.truncated)
+	assert.Equal(t, "first\nsecond\nthi", b.buf.String())
+	assert.Equal(t, [][]byte{[]byte("first"), []byte("second")}, b.lines())
+}
+
+func TestLines(t *testing.T) {
+	b := &limitedBuffer{limit: 1024}
+	_, err := b.Write([]byte("first\r\n\n  \nsecond"))
+	require.NoError(t, err)
+	assert.Equal(t, [][]byte{[]byte("first"), []byte("second")}, b.lines())
+}
+
+func TestAppendTextRecords(t *testing.T) {
+	now := pcommon.NewTimestampFromTime(time.Unix(1700000000, 0))
+	records := plog.NewLogRecordSlice()
+	appendTextRecords([][]byte{[]byte("first"), []byte("second")}, records, "uptime", now)
+
+	require.Equal(t, 2, records.Len())
+	lr := records.At(1)
+	assert.Equal(t, "second", lr.Body().Str())
+	assert.Equal(t, now, lr.Timestamp())
+	assert.Equal(t, now, lr.ObservedTimestamp())
+	assert.Equal(t, map[string]any{commandAttribute: "uptime"}, lr.Attributes().AsRaw())
+}
+
+func TestAppendJSONRecords(t *testing.T) {
+	now := pcommon.NewTimestampFromTime(time.Unix(1700000000, 0))
+	records := plog.NewLogRecordSlice()
+	err := appendJSONRecords([][]byte{
+		[]byte(`{"body": "queue is full", "timestamp": "2024-06-01T10:00:00.5Z", "severity_text": "error", "severity_number": 17, "attributes": {"queue": "orders"}, "size": 10}`),
+		[]byte(`{"body": {"used": 0.5}, "timestamp": 1717236000}`),
+		[]byte(`not json`),
+		[]byte(`["array"]`),
+		[]byte(`{"severity_number": 42}`),
+	}, records, "check", now)
+	assert.EqualError(t, err, "line 3: invalid character 'o' in literal null (expecting 'u')\n"+
+		"line 4: json: cannot unmarshal array into Go value of type map[string]interface {}\n"+
+		"line 5: field \"severity_number\": invalid severity number 42")
+
+	require.Equal(t, 5, records.Len())
+	lr := records.At(0)
+	assert.Equal(t, "queue is full", lr.Body().Str())
+	assert.Equal(t, time.Date(2024, 6, 1, 10, 0, 0, 500_000_000, time.UTC), lr.Timestamp().AsTime())
+	assert.Equal(t, now, lr.ObservedTimestamp())
+	assert.Equal(t, "error", lr.SeverityText())
+	assert.Equal(t, plog.SeverityNumberError, lr.SeverityNumber())
+	assert.Equal(t, map[string]any{commandAttribute: "check", "queue": "orders", "size": float64(10)}, lr.Attributes().AsRaw())
+
+	lr = records.At(1)
+	assert.Equal(t, map[string]any{"used": 0.5}, lr.Body().Map().AsRaw())
+	assert.Equal(t, time.Unix(1717236000, 0).UTC(), lr.Timestamp().AsTime())
+
+	for i, body := range []string{"not json", `["array"]`, `{"severity_number": 42}`} {
+		lr = records.At(i + 2)
+		assert.Equal(t, body, lr.Body().Str())
+		assert.Equal(t, plog.SeverityNumberUnspecified, lr.SeverityNumber())
+		assert.Equal(t, map[string]any{commandAttribute: "check"}, lr.Attributes().AsRaw())
+	}
+}
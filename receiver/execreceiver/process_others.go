@@ -0,0 +1,21 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+//go:build !unix
+
+package execreceiver // import "github.com/open-telemetry/opentelemetry-collector-contrib/receiver/execreceiver"
+
+import (
+	"errors"
+	"os/exec"
+)
+
+const userSupported = false
+
+// configureProcess only kills the process of the command when the execution is canceled, its children aren't.
+func configureProcess(_ *exec.Cmd, username string) error {
+	if username != "" {
+		return errors.New("user is not supported on this platform")
+	}
+	return nil
+}
@@ -0,0 +1,178 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package execreceiver // import "github.com/open-telemetry/opentelemetry-collector-contrib/receiver/execreceiver"
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os/exec"
+	"sync"
+	"time"
+
+	"go.opentelemetry.io/collector/component"
+	"go.opentelemetry.io/collector/consumer"
+	"go.opentelemetry.io/collector/pdata/pcommon"
+	"go.opentelemetry.io/collector/pdata/plog"
+	"go.opentelemetry.io/collector/receiver"
+	"go.opentelemetry.io/collector/receiver/receiverhelper"
+	"go.uber.org/zap"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/receiver/execreceiver/internal/metadata"
+)
+
+const (
+	transport = "exec"
+
+	// maxErrorOutputSize is the number of bytes of the standard error of the failed executions which are logged.
+	maxErrorOutputSize = 4096
+	// waitDelay is how long the output of a killed command is waited for, in case processes it started outside of
+	// its process group still hold it.
+	waitDelay = time.Second
+)
+
+// scopeName is the instrumentation scope of the logs converted from the output of the commands.
+var scopeName = "otelcol/" + metadata.Type.String() + "receiver"
+
+// execReceiver executes each command on its own schedule, and converts its output to logs.
+type execReceiver struct {
+	cfg      *Config
+	settings receiver.CreateSettings
+	obsrecv  *receiverhelper.ObsReport
+	next     consumer.Logs
+
+	cancel context.CancelFunc
+	wg     sync.WaitGroup
+}
+
+func newReceiver(cfg *Config, set receiver.CreateSettings, next consumer.Logs) (*execReceiver, error) {
+	obsrecv, err := receiverhelper.NewObsReport(receiverhelper.ObsReportSettings{
+		ReceiverID:             set.ID,
+		Transport:              transport,
+		ReceiverCreateSettings: set,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &execReceiver{
+		cfg:      cfg,
+		settings: set,
+		obsrecv:  obsrecv,
+		next:     next,
+	}, nil
+}
+
+func (r *execReceiver) Start(_ context.Context, _ component.Host) error {
+	ctx, cancel := context.WithCancel(context.Background())
+	r.cancel = cancel
+	for i := range r.cfg.Commands {
+		r.wg.Add(1)
+		go r.schedule(ctx, &r.cfg.Commands[i])
+	}
+	return nil
+}
+
+func (r *execReceiver) Shutdown(context.Context) error {
+	if r.cancel != nil {
+		r.cancel()
+	}
+	r.wg.Wait()
+	return nil
+}
+
+// schedule executes the command at its collection interval until the receiver is shut down. The executions of a
+// command never overlap: the ticks missed while the command is running are dropped.
+func (r *execReceiver) schedule(ctx context.Context, cmd *CommandConfig) {
+	defer r.wg.Done()
+	interval := cmd.CollectionInterval
+	if interval == 0 {
+		interval = r.cfg.CollectionInterval
+	}
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		r.collect(ctx, cmd)
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// collect executes the command, and consumes the logs converted from its output. The output of the failed
+// executions is converted as well.
+func (r *execReceiver) collect(ctx context.Context, cmd *CommandConfig) {
+	logger := r.settings.Logger.With(zap.String("command", cmd.name()))
+	now := pcommon.NewTimestampFromTime(time.Now())
+	stdout, err := r.execute(ctx, cmd)
+	if err != nil {
+		if ctx.Err() != nil {
+			return
+		}
+		logger.Warn("The execution of the command failed", zap.Error(err))
+	}
+	if stdout.truncated {
+		logger.Warn("The output of the command was truncated", zap.Int("max_output_size", cmd.maxOutputSize()))
+	}
+
+	lines := stdout.lines()
+	if len(lines) == 0 {
+		return
+	}
+	ld := plog.NewLogs()
+	sl := ld.ResourceLogs().AppendEmpty().ScopeLogs().AppendEmpty()
+	sl.Scope().SetName(scopeName)
+	sl.Scope().SetVersion(r.settings.BuildInfo.Version)
+	if cmd.output() == outputJSON {
+		if err = appendJSONRecords(lines, sl.LogRecords(), cmd.name(), now); err != nil {
+			logger.Warn("The output of the command holds invalid JSON objects, kept as text", zap.Error(err))
+		}
+	} else {
+		appendTextRecords(lines, sl.LogRecords(), cmd.name(), now)
+	}
+
+	obsCtx := r.obsrecv.StartLogsOp(ctx)
+	err = r.next.ConsumeLogs(obsCtx, ld)
+	r.obsrecv.EndLogsOp(obsCtx, metadata.Type.String(), ld.LogRecordCount(), err)
+	if err != nil {
+		logger.Error("Failed to consume the logs of the command", zap.Error(err))
+	}
+}
+
+// execute executes the command within its limits, and returns its standard output.
+func (r *execReceiver) execute(ctx context.Context, cmd *CommandConfig) (*limitedBuffer, error) {
+	timeout := cmd.Timeout
+	if timeout == 0 {
+		timeout = r.cfg.Timeout
+	}
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	stdout := &limitedBuffer{limit: cmd.maxOutputSize()}
+	stderr := &limitedBuffer{limit: maxErrorOutputSize}
+	c := exec.CommandContext(ctx, cmd.Command[0], cmd.Command[1:]...)
+	c.Dir = cmd.WorkingDirectory
+	c.Env = cmd.environment()
+	c.Stdout = stdout
+	c.Stderr = stderr
+	c.WaitDelay = waitDelay
+	if err := configureProcess(c, cmd.User); err != nil {
+		return stdout, err
+	}
+	if cmd.CPUTimeLimit > 0 {
+		if err := limitCPUTime(c, cmd.CPUTimeLimit); err != nil {
+			return stdout, fmt.Errorf("unable to limit the CPU time: %w", err)
+		}
+	}
+
+	err := c.Run()
+	if err != nil && errors.Is(ctx.Err(), context.DeadlineExceeded) {
+		err = fmt.Errorf("timed out after %s", timeout)
+	}
+	if err != nil && stderr.buf.Len() > 0 {
+		err = fmt.Errorf("%w: %s", err, stderr.buf.String())
+	}
+	return stdout, err
+}
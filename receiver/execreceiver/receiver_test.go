@@ -0,0 +1,170 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+//go:build unix
+
+package execreceiver
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/collector/component/componenttest"
+	"go.opentelemetry.io/collector/consumer/consumertest"
+	"go.opentelemetry.io/collector/pdata/plog"
+	"go.opentelemetry.io/collector/receiver/receivertest"
+	"go.uber.org/zap"
+	"go.uber.org/zap/zapcore"
+	"go.uber.org/zap/zaptest/observer"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/receiver/execreceiver/internal/metadata"
+)
+
+func newTestReceiver(t *testing.T, cmd CommandConfig, next *consumertest.LogsSink) (*execReceiver, *observer.ObservedLogs) {
+	cfg := createDefaultConfig().(*Config)
+	cfg.Commands = []CommandConfig{cmd}
+	require.NoError(t, cfg.Validate())
+
+	core, logs := observer.New(zapcore.WarnLevel)
+	set := receivertest.NewNopCreateSettings()
+	set.Logger = zap.New(core)
+	r, err := newReceiver(cfg, set, next)
+	require.NoError(t, err)
+	return r, logs
+}
+
+func bodies(ld plog.Logs) []string {
+	var bodies []string
+	records := ld.ResourceLogs().At(0).ScopeLogs().At(0).LogRecords()
+	for i := 0; i < records.Len(); i++ {
+		bodies = append(bodies, records.At(i).Body().AsString())
+	}
+	return bodies
+}
+
+func TestReceiverText(t *testing.T) {
+	sink := new(consumertest.LogsSink)
+	r, _ := newTestReceiver(t, CommandConfig{
+		Name:    "greeting",
+		Command: []string{"sh", "-c", "echo hello; echo world"},
+	}, sink)
+
+	require.NoError(t, r.Start(context.Background(), componenttest.NewNopHost()))
+	require.Eventually(t, func() bool { return sink.LogRecordCount() > 0 }, 5*time.Second, 10*time.Millisecond)
+	require.NoError(t, r.Shutdown(context.Background()))
+
+	ld := sink.AllLogs()[0]
+	assert.Equal(t, []string{"hello", "world"}, bodies(ld))
+	scope := ld.ResourceLogs().At(0).ScopeLogs().At(0).Scope()
+	assert.Equal(t, "otelcol/"+metadata.Type.String()+"receiver", scope.Name())
+	name, _ := ld.ResourceLogs().At(0).ScopeLogs().At(0).LogRecords().At(0).Attributes().Get(commandAttribute)
+	assert.Equal(t, "greeting", name.Str())
+}
+
+func TestReceiverJSON(t *testing.T) {
+	sink := new(consumertest.LogsSink)
+	r, _ := newTestReceiver(t, CommandConfig{
+		Command: []string{"echo", `{"body": "queue is full", "severity_text": "error"}`},
+		Output:  outputJSON,
+	}, sink)
+
+	r.collect(context.Background(), &r.cfg.Commands[0])
+
+	require.Equal(t, 1, sink.LogRecordCount())
+	lr := sink.AllLogs()[0].ResourceLogs().At(0).ScopeLogs().At(0).LogRecords().At(0)
+	assert.Equal(t, "queue is full", lr.Body().Str())
+	assert.Equal(t, "error", lr.SeverityText())
+}
+
+func TestReceiverTimeout(t *testing.T) {
+	sink := new(consumertest.LogsSink)
+	r, logs := newTestReceiver(t, CommandConfig{
+		Command: []string{"sh", "-c", "echo started; sleep 10 & wait"},
+		Timeout: 200 * time.Millisecond,
+	}, sink)
+
+	start := time.Now()
+	r.collect(context.Background(), &r.cfg.Commands[0])
+	assert.Less(t, time.Since(start), 5*time.Second)
+
+	assert.Equal(t, []string{"started"}, bodies(sink.AllLogs()[0]))
+	require.Equal(t, 1, logs.FilterMessage("The execution of the command failed").Len())
+	assert.Equal(t, "timed out after 200ms", logs.FilterMessage("The execution of the command failed").All()[0].ContextMap()["error"])
+}
+
+func TestReceiverMaxOutputSize(t *testing.T) {
+	sink := new(consumertest.LogsSink)
+	r, logs := newTestReceiver(t, CommandConfig{
+		Command:       []string{"sh", "-c", "for i in $(seq 1000); do echo line-$i; done"},
+		MaxOutputSize: 20,
+	}, sink)
+
+	r.collect(context.Background(), &r.cfg.Commands[0])
+
+	assert.Equal(t, []string{"line-1", "line-2"}, bodies(sink.AllLogs()[0]))
+	assert.Equal(t, 1, logs.FilterMessage("The output of the command was truncated").Len())
+}
+
+func TestReceiverEnvironment(t *testing.T) {
+	t.Setenv("EXEC_RECEIVER_INHERITED", "inherited")
+	t.Setenv("EXEC_RECEIVER_SECRET", "secret")
+	dir := t.TempDir()
+	sink := new(consumertest.LogsSink)
+	r, _ := newTestReceiver(t, CommandConfig{
+		Command:            []string{"/usr/bin/env"},
+		WorkingDirectory:   dir,
+		Environment:        map[string]string{"LEVEL": "warning"},
+		InheritEnvironment: []string{"EXEC_RECEIVER_INHERITED"},
+	}, sink)
+
+	r.collect(context.Background(), &r.cfg.Commands[0])
+
+	assert.ElementsMatch(t, []string{"EXEC_RECEIVER_INHERITED=inherited", "LEVEL=warning"}, bodies(sink.AllLogs()[0]))
+}
+
+func TestReceiverWorkingDirectory(t *testing.T) {
+	dir := t.TempDir()
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "check.sh"), []byte("#!/bin/sh\necho checked\n"), 0o700))
+	sink := new(consumertest.LogsSink)
+	r, _ := newTestReceiver(t, CommandConfig{
+		Command:          []string{"/bin/sh", "check.sh"},
+		WorkingDirectory: dir,
+	}, sink)
+
+	r.collect(context.Background(), &r.cfg.Commands[0])
+
+	assert.Equal(t, []string{"checked"}, bodies(sink.AllLogs()[0]))
+}
+
+func TestReceiverFailure(t *testing.T) {
+	sink := new(consumertest.LogsSink)
+	r, logs := newTestReceiver(t, CommandConfig{
+		Command: []string{"sh", "-c", "echo partial; echo broken >&2; exit 3"},
+	}, sink)
+
+	r.collect(context.Background(), &r.cfg.Commands[0])
+
+	assert.Equal(t, []string{"partial"}, bodies(sink.AllLogs()[0]))
+	failures := logs.FilterMessage("The execution of the command failed").All()
+	require.Len(t, failures, 1)
+	assert.True(t, strings.HasPrefix(failures[0].ContextMap()["error"].(string), "exit status 3: broken"))
+}
+
+func TestReceiverUnknownUser(t *testing.T) {
+	sink := new(consumertest.LogsSink)
+	r, logs := newTestReceiver(t, CommandConfig{
+		Command: []string{"echo", "hello"},
+		User:    "exec-receiver-unknown-user",
+	}, sink)
+
+	r.collect(context.Background(), &r.cfg.Commands[0])
+
+	assert.Zero(t, sink.LogRecordCount())
+	assert.Equal(t, 1, logs.FilterMessage("The execution of the command failed").Len())
+}
@@ -12,6 +12,7 @@ import (
 	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/stanza/operator"
 	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/stanza/operator/input/file"
 	"github.com/open-telemetry/opentelemetry-collector-contrib/receiver/filelogreceiver/internal/metadata"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/receiver/filelogreceiver/internal/sftpinput"
 )
 
 // NewFactory creates a factory for filelog receiver
@@ -51,9 +52,15 @@ func (f ReceiverType) BaseConfig(cfg component.Config) adapter.BaseConfig {
 type FileLogConfig struct {
 	InputConfig        file.Config `mapstructure:",squash"`
 	adapter.BaseConfig `mapstructure:",squash"`
+	// SFTP when set tails the matching files on remote hosts over SFTP instead of the local files
+	SFTP *sftpinput.Config `mapstructure:"sftp"`
 }
 
 // InputConfig unmarshals the input operator
 func (f ReceiverType) InputConfig(cfg component.Config) operator.Config {
-	return operator.NewConfig(&cfg.(*FileLogConfig).InputConfig)
+	c := cfg.(*FileLogConfig)
+	if c.SFTP != nil {
+		return operator.NewConfig(sftpinput.NewInputConfig(c.InputConfig.InputConfig, c.InputConfig.Config, *c.SFTP))
+	}
+	return operator.NewConfig(&c.InputConfig)
 }
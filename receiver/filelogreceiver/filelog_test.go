@@ -32,6 +32,7 @@ import (
 	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/stanza/operator/input/file"
 	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/stanza/operator/parser/json"
 	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/stanza/operator/parser/regex"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/receiver/filelogreceiver/internal/sftpinput"
 )
 
 func TestDefaultConfig(t *testing.T) {
@@ -384,3 +385,37 @@ func (g *fileLogGenerator) Generate() []receivertest.UniqueIDAttrVal {
 	require.NoError(g.t, err)
 	return []receivertest.UniqueIDAttrVal{id}
 }
+
+func TestLoadSFTPConfig(t *testing.T) {
+	cm, err := confmaptest.LoadConf(filepath.Join("testdata", "sftp.yaml"))
+	require.NoError(t, err)
+
+	factory := NewFactory()
+	cfg := factory.CreateDefaultConfig()
+
+	sub, err := cm.Sub(component.MustNewID("filelog").String())
+	require.NoError(t, err)
+	require.NoError(t, sub.Unmarshal(cfg))
+	assert.NoError(t, component.ValidateConfig(cfg))
+
+	expected := &sftpinput.Config{
+		MaxConcurrentFilesPerHost: 2,
+		Hosts: []sftpinput.HostConfig{
+			{Endpoint: "appliance1:22", Username: "otel", Password: "secret", IgnoreHostKey: true},
+			{Endpoint: "appliance2:22", Username: "otel", Password: "secret", Timeout: 5 * time.Second, IgnoreHostKey: true},
+		},
+	}
+	assert.Equal(t, expected, cfg.(*FileLogConfig).SFTP)
+
+	inputCfg := ReceiverType{}.InputConfig(cfg)
+	assert.IsType(t, &sftpinput.InputConfig{}, inputCfg.Builder)
+
+	rcvr, err := factory.CreateLogsReceiver(
+		context.Background(),
+		receivertest.NewNopCreateSettings(),
+		cfg,
+		new(consumertest.LogsSink),
+	)
+	require.NoError(t, err)
+	require.NotNil(t, rcvr)
+}
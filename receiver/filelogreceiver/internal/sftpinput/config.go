@@ -0,0 +1,231 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package sftpinput // import "github.com/open-telemetry/opentelemetry-collector-contrib/receiver/filelogreceiver/internal/sftpinput"
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/pkg/sftp"
+	"go.opentelemetry.io/collector/component"
+	"golang.org/x/crypto/ssh"
+	"golang.org/x/text/encoding"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/internal/sshconfig"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/stanza/decode"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/stanza/fileconsumer"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/stanza/operator"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/stanza/operator/helper"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/stanza/trim"
+)
+
+const (
+	defaultMaxConcurrentFilesPerHost = 4
+	defaultTimeout                   = 10 * time.Second
+)
+
+// Config is the configuration of the remote hosts tailed over SFTP
+type Config struct {
+	Hosts []HostConfig `mapstructure:"hosts"`
+	// MaxConcurrentFilesPerHost limits the number of files read at once from a single host, 4 when not set
+	MaxConcurrentFilesPerHost int `mapstructure:"max_concurrent_files_per_host"`
+}
+
+// HostConfig is the configuration of the SSH connection to a remote host
+type HostConfig struct {
+	Endpoint string        `mapstructure:"endpoint"`
+	Timeout  time.Duration `mapstructure:"timeout"`
+
+	// authentication requires a Username and either a Password or KeyFile
+	Username string `mapstructure:"username"`
+	Password string `mapstructure:"password"`
+	KeyFile  string `mapstructure:"key_file"`
+
+	// file path to the known_hosts
+	KnownHosts string `mapstructure:"known_hosts"`
+
+	// IgnoreHostKey provides an insecure path to quickstarts and testing
+	IgnoreHostKey bool `mapstructure:"ignore_host_key"`
+}
+
+// Validate checks the SFTP settings
+func (c Config) Validate() error {
+	if len(c.Hosts) == 0 {
+		return errors.New("'hosts' must not be empty")
+	}
+	if c.MaxConcurrentFilesPerHost < 0 {
+		return errors.New("'max_concurrent_files_per_host' must not be negative")
+	}
+	seen := make(map[string]bool, len(c.Hosts))
+	for _, h := range c.Hosts {
+		if h.Endpoint == "" {
+			return errors.New("host 'endpoint' must be specified")
+		}
+		if seen[h.Endpoint] {
+			return fmt.Errorf("duplicate host endpoint '%s'", h.Endpoint)
+		}
+		seen[h.Endpoint] = true
+		if h.Username == "" {
+			return fmt.Errorf("host '%s': 'username' must be specified", h.Endpoint)
+		}
+		if h.Password == "" && h.KeyFile == "" {
+			return fmt.Errorf("host '%s': either 'password' or 'key_file' must be specified", h.Endpoint)
+		}
+	}
+	return nil
+}
+
+// InputConfig is the configuration of an input operator tailing files on remote hosts over SFTP.
+// The matching, start_at, encoding and multiline settings of the file input apply to the remote files.
+type InputConfig struct {
+	helper.InputConfig
+	fileconsumer.Config
+	SFTP Config
+}
+
+// NewInputConfig creates the configuration of an input operator tailing the files over SFTP
+func NewInputConfig(input helper.InputConfig, files fileconsumer.Config, sftpCfg Config) *InputConfig {
+	return &InputConfig{
+		InputConfig: input,
+		Config:      files,
+		SFTP:        sftpCfg,
+	}
+}
+
+// Build will build an SFTP input operator from the supplied configuration
+func (c InputConfig) Build(set component.TelemetrySettings) (operator.Operator, error) {
+	if err := c.validate(); err != nil {
+		return nil, err
+	}
+
+	inputOperator, err := c.InputConfig.Build(set)
+	if err != nil {
+		return nil, err
+	}
+
+	enc, err := decode.LookupEncoding(c.Encoding)
+	if err != nil {
+		return nil, fmt.Errorf("failed to find encoding: %w", err)
+	}
+	splitFunc, err := c.SplitConfig.Func(enc, false, int(c.MaxLogSize))
+	if err != nil {
+		return nil, err
+	}
+	trimFunc := trim.Nop
+	if enc != encoding.Nop {
+		trimFunc = c.TrimConfig.Func()
+	}
+
+	var startAtBeginning bool
+	switch c.StartAt {
+	case "beginning":
+		startAtBeginning = true
+	case "end":
+		startAtBeginning = false
+	default:
+		return nil, fmt.Errorf("invalid start_at location '%s'", c.StartAt)
+	}
+
+	maxConcurrentFiles := c.SFTP.MaxConcurrentFilesPerHost
+	if maxConcurrentFiles == 0 {
+		maxConcurrentFiles = defaultMaxConcurrentFilesPerHost
+	}
+	hosts := make([]*remoteHost, 0, len(c.SFTP.Hosts))
+	for _, h := range c.SFTP.Hosts {
+		clientConfig, err := h.clientConfig()
+		if err != nil {
+			return nil, fmt.Errorf("host '%s': %w", h.Endpoint, err)
+		}
+		hosts = append(hosts, newRemoteHost(h, clientConfig, maxConcurrentFiles))
+	}
+
+	return &Input{
+		InputOperator:   inputOperator,
+		hosts:           hosts,
+		include:         c.Include,
+		exclude:         c.Exclude,
+		pollInterval:    c.PollInterval,
+		fromBeginning:   startAtBeginning,
+		fingerprintSize: int(c.FingerprintSize),
+		maxLogSize:      int(c.MaxLogSize),
+		encoding:        enc,
+		splitFunc:       trim.WithFunc(trim.ToLength(splitFunc, int(c.MaxLogSize)), trimFunc),
+		includeFileName: c.IncludeFileName,
+		includeFilePath: c.IncludeFilePath,
+		toBody:          toBodyFunc(decode.IsNop(c.Encoding)),
+		dial:            dialSSH,
+		files:           make(map[string]map[string]*fileState),
+	}, nil
+}
+
+func (c InputConfig) validate() error {
+	if err := c.SFTP.Validate(); err != nil {
+		return err
+	}
+	if len(c.Include) == 0 {
+		return errors.New("'include' must be specified")
+	}
+	if c.PollInterval <= 0 {
+		return errors.New("'poll_interval' must be positive")
+	}
+	if c.MaxLogSize <= 0 {
+		return errors.New("'max_log_size' must be positive")
+	}
+	// The settings relying on local file system features are not available for remote files
+	switch {
+	case c.DeleteAfterRead:
+		return errors.New("'delete_after_read' is not supported with 'sftp'")
+	case c.Header != nil:
+		return errors.New("'header' is not supported with 'sftp'")
+	case c.Compression != "":
+		return errors.New("'compression' is not supported with 'sftp'")
+	case c.IncludeFileNameResolved || c.IncludeFilePathResolved || c.IncludeFileOwnerName || c.IncludeFileOwnerGroupName:
+		return errors.New("only 'include_file_name' and 'include_file_path' attributes are supported with 'sftp'")
+	}
+	return nil
+}
+
+func (h HostConfig) clientConfig() (*ssh.ClientConfig, error) {
+	timeout := h.Timeout
+	if timeout <= 0 {
+		timeout = defaultTimeout
+	}
+	return sshconfig.ClientSettings{
+		Username:      h.Username,
+		Password:      h.Password,
+		KeyFile:       h.KeyFile,
+		KnownHosts:    h.KnownHosts,
+		IgnoreHostKey: h.IgnoreHostKey,
+		Timeout:       timeout,
+	}.ToClientConfig()
+}
+
+// connection is an SFTP session over an SSH connection
+type connection struct {
+	*sftp.Client
+	ssh *ssh.Client
+}
+
+func (c *connection) Close() error {
+	err := c.Client.Close()
+	if c.ssh != nil {
+		err = errors.Join(err, c.ssh.Close())
+	}
+	return err
+}
+
+type dialFunc func(endpoint string, config *ssh.ClientConfig) (*connection, error)
+
+func dialSSH(endpoint string, config *ssh.ClientConfig) (*connection, error) {
+	sshClient, err := ssh.Dial("tcp", endpoint, config)
+	if err != nil {
+		return nil, err
+	}
+	client, err := sftp.NewClient(sshClient)
+	if err != nil {
+		return nil, errors.Join(err, sshClient.Close())
+	}
+	return &connection{Client: client, ssh: sshClient}, nil
+}
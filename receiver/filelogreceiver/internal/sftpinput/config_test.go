@@ -0,0 +1,134 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package sftpinput
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/collector/component/componenttest"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/stanza/fileconsumer"
+)
+
+func TestValidate(t *testing.T) {
+	host := HostConfig{Endpoint: "host1:22", Username: "otel", Password: "secret"}
+	testCases := []struct {
+		name   string
+		cfg    Config
+		errMsg string
+	}{
+		{
+			name: "valid",
+			cfg:  Config{Hosts: []HostConfig{host}},
+		},
+		{
+			name:   "no hosts",
+			cfg:    Config{},
+			errMsg: "'hosts' must not be empty",
+		},
+		{
+			name:   "negative concurrency",
+			cfg:    Config{Hosts: []HostConfig{host}, MaxConcurrentFilesPerHost: -1},
+			errMsg: "'max_concurrent_files_per_host' must not be negative",
+		},
+		{
+			name:   "missing endpoint",
+			cfg:    Config{Hosts: []HostConfig{{Username: "otel", Password: "secret"}}},
+			errMsg: "host 'endpoint' must be specified",
+		},
+		{
+			name:   "duplicate endpoint",
+			cfg:    Config{Hosts: []HostConfig{host, host}},
+			errMsg: "duplicate host endpoint 'host1:22'",
+		},
+		{
+			name:   "missing username",
+			cfg:    Config{Hosts: []HostConfig{{Endpoint: "host1:22", Password: "secret"}}},
+			errMsg: "host 'host1:22': 'username' must be specified",
+		},
+		{
+			name:   "missing credentials",
+			cfg:    Config{Hosts: []HostConfig{{Endpoint: "host1:22", Username: "otel"}}},
+			errMsg: "host 'host1:22': either 'password' or 'key_file' must be specified",
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.cfg.Validate()
+			if tc.errMsg == "" {
+				require.NoError(t, err)
+				return
+			}
+			require.EqualError(t, err, tc.errMsg)
+		})
+	}
+}
+
+func TestBuild(t *testing.T) {
+	testCases := []struct {
+		name   string
+		modify func(*InputConfig)
+		errMsg string
+	}{
+		{
+			name:   "valid",
+			modify: func(*InputConfig) {},
+		},
+		{
+			name: "no include",
+			modify: func(c *InputConfig) {
+				c.Include = nil
+			},
+			errMsg: "'include' must be specified",
+		},
+		{
+			name: "delete after read",
+			modify: func(c *InputConfig) {
+				c.DeleteAfterRead = true
+			},
+			errMsg: "'delete_after_read' is not supported with 'sftp'",
+		},
+		{
+			name: "header",
+			modify: func(c *InputConfig) {
+				c.Header = &fileconsumer.HeaderConfig{}
+			},
+			errMsg: "'header' is not supported with 'sftp'",
+		},
+		{
+			name: "owner attributes",
+			modify: func(c *InputConfig) {
+				c.IncludeFileOwnerName = true
+			},
+			errMsg: "only 'include_file_name' and 'include_file_path' attributes are supported with 'sftp'",
+		},
+		{
+			name: "invalid start_at",
+			modify: func(c *InputConfig) {
+				c.StartAt = "middle"
+			},
+			errMsg: "invalid start_at location 'middle'",
+		},
+		{
+			name: "missing key file",
+			modify: func(c *InputConfig) {
+				c.SFTP.Hosts[0].KeyFile = "/nonexistent/id_rsa"
+			},
+			errMsg: "host 'host1:22': unable to read private key: open /nonexistent/id_rsa: no such file or directory",
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			cfg := newTestConfig(t.TempDir())
+			tc.modify(cfg)
+			_, err := cfg.Build(componenttest.NewNopTelemetrySettings())
+			if tc.errMsg == "" {
+				require.NoError(t, err)
+				return
+			}
+			require.EqualError(t, err, tc.errMsg)
+		})
+	}
+}
@@ -0,0 +1,421 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package sftpinput // import "github.com/open-telemetry/opentelemetry-collector-contrib/receiver/filelogreceiver/internal/sftpinput"
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"path"
+	"sync"
+	"time"
+
+	"github.com/bmatcuk/doublestar/v4"
+	"go.uber.org/zap"
+	"golang.org/x/crypto/ssh"
+	"golang.org/x/text/encoding"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/stanza/decode"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/stanza/entry"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/stanza/fileconsumer/attrs"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/stanza/operator"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/stanza/operator/helper"
+)
+
+const (
+	// LogFileHost is the attribute holding the endpoint of the host the file was read from
+	LogFileHost = "log.file.host"
+
+	knownFilesKey = "sftpKnownFiles"
+)
+
+// fileState is the progress of the reading of a remote file
+type fileState struct {
+	Offset int64 `json:"offset"`
+	// Fingerprint is the first bytes of the file, used to detect it has been replaced by the rotation
+	Fingerprint []byte `json:"fingerprint"`
+}
+
+type toBody func([]byte) any
+
+func toBodyFunc(nop bool) toBody {
+	if nop {
+		return func(token []byte) any {
+			copied := make([]byte, len(token))
+			copy(copied, token)
+			return copied
+		}
+	}
+	return func(token []byte) any {
+		return string(token)
+	}
+}
+
+// remoteHost is a host the files are read from, with its connection established on first use
+type remoteHost struct {
+	cfg          HostConfig
+	clientConfig *ssh.ClientConfig
+	conn         *connection
+	// sem limits the number of files read at once from the host
+	sem chan struct{}
+}
+
+func newRemoteHost(cfg HostConfig, clientConfig *ssh.ClientConfig, maxConcurrentFiles int) *remoteHost {
+	return &remoteHost{
+		cfg:          cfg,
+		clientConfig: clientConfig,
+		sem:          make(chan struct{}, maxConcurrentFiles),
+	}
+}
+
+func (h *remoteHost) close() error {
+	if h.conn == nil {
+		return nil
+	}
+	err := h.conn.Close()
+	h.conn = nil
+	return err
+}
+
+// Input is an operator that tails files on remote hosts over SFTP
+type Input struct {
+	helper.InputOperator
+
+	hosts           []*remoteHost
+	include         []string
+	exclude         []string
+	pollInterval    time.Duration
+	fromBeginning   bool
+	fingerprintSize int
+	maxLogSize      int
+	encoding        encoding.Encoding
+	splitFunc       bufio.SplitFunc
+	includeFileName bool
+	includeFilePath bool
+	toBody          toBody
+	dial            dialFunc
+
+	persister operator.Persister
+	// files holds the state of the known files, by host endpoint then path
+	files   map[string]map[string]*fileState
+	filesMu sync.Mutex
+
+	wg     sync.WaitGroup
+	cancel context.CancelFunc
+}
+
+// Start will start polling the remote hosts
+func (i *Input) Start(persister operator.Persister) error {
+	ctx, cancel := context.WithCancel(context.Background())
+	i.cancel = cancel
+
+	if persister != nil {
+		i.persister = persister
+		known, err := i.loadFiles(ctx)
+		if err != nil {
+			return fmt.Errorf("read known files from database: %w", err)
+		}
+		if len(known) > 0 {
+			i.Logger().Info("Resuming from previously known offset(s). 'start_at' setting is not applicable.")
+			i.files = known
+			i.fromBeginning = true
+		}
+	}
+
+	i.wg.Add(1)
+	go func() {
+		defer i.wg.Done()
+		ticker := time.NewTicker(i.pollInterval)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+			}
+			i.poll(ctx)
+		}
+	}()
+	return nil
+}
+
+// Stop will stop polling and close the connections to the remote hosts
+func (i *Input) Stop() error {
+	if i.cancel != nil {
+		i.cancel()
+		i.cancel = nil
+	}
+	i.wg.Wait()
+
+	var errs []error
+	for _, h := range i.hosts {
+		if err := h.close(); err != nil {
+			errs = append(errs, fmt.Errorf("close connection to '%s': %w", h.cfg.Endpoint, err))
+		}
+	}
+	if i.persister != nil {
+		if err := i.saveFiles(context.Background()); err != nil {
+			errs = append(errs, fmt.Errorf("save offsets: %w", err))
+		}
+	}
+	return errors.Join(errs...)
+}
+
+// poll reads the new content of the matching files of every host
+func (i *Input) poll(ctx context.Context) {
+	var wg sync.WaitGroup
+	for _, h := range i.hosts {
+		wg.Add(1)
+		go func(h *remoteHost) {
+			defer wg.Done()
+			i.pollHost(ctx, h)
+		}(h)
+	}
+	wg.Wait()
+
+	// Any new files that appear should be consumed entirely
+	i.fromBeginning = true
+	if i.persister != nil {
+		if err := i.saveFiles(ctx); err != nil {
+			i.Logger().Error("save offsets", zap.Error(err))
+		}
+	}
+}
+
+func (i *Input) pollHost(ctx context.Context, h *remoteHost) {
+	logger := i.Logger().With(zap.String("host", h.cfg.Endpoint))
+	if h.conn != nil {
+		// Listing the files does not fail on a broken connection, it would be taken as all the files being removed
+		if _, err := h.conn.Getwd(); err != nil {
+			logger.Debug("Connection lost", zap.Error(err))
+			if err = h.close(); err != nil {
+				logger.Debug("Problem closing connection", zap.Error(err))
+			}
+		}
+	}
+	if h.conn == nil {
+		conn, err := i.dial(h.cfg.Endpoint, h.clientConfig)
+		if err != nil {
+			logger.Warn("Failed to connect", zap.Error(err))
+			return
+		}
+		h.conn = conn
+	}
+
+	paths, err := i.matchFiles(h.conn)
+	if err != nil {
+		logger.Warn("Failed to list files", zap.Error(err))
+		return
+	}
+	i.forgetMissingFiles(h.cfg.Endpoint, paths)
+
+	var wg sync.WaitGroup
+	for _, p := range paths {
+		select {
+		case <-ctx.Done():
+			wg.Wait()
+			return
+		case h.sem <- struct{}{}:
+		}
+		wg.Add(1)
+		go func(p string) {
+			defer func() {
+				<-h.sem
+				wg.Done()
+			}()
+			if err := i.readFile(ctx, h, p); err != nil {
+				logger.Error("Failed to read file", zap.String("path", p), zap.Error(err))
+			}
+		}(p)
+	}
+	wg.Wait()
+}
+
+// matchFiles returns the remote files matching the include patterns and none of the exclude patterns
+func (i *Input) matchFiles(conn *connection) ([]string, error) {
+	seen := make(map[string]bool)
+	var paths []string
+	for _, pattern := range i.include {
+		matches, err := conn.Glob(pattern)
+		if err != nil {
+			return nil, fmt.Errorf("glob '%s': %w", pattern, err)
+		}
+	MATCH:
+		for _, m := range matches {
+			if seen[m] {
+				continue
+			}
+			for _, ex := range i.exclude {
+				if excluded, _ := doublestar.Match(ex, m); excluded {
+					continue MATCH
+				}
+			}
+			seen[m] = true
+			paths = append(paths, m)
+		}
+	}
+	return paths, nil
+}
+
+// readFile emits the entries written to the remote file since the last poll
+func (i *Input) readFile(ctx context.Context, h *remoteHost, p string) error {
+	file, err := h.conn.Open(p)
+	if err != nil {
+		return err
+	}
+	defer file.Close()
+
+	info, err := file.Stat()
+	if err != nil {
+		return err
+	}
+	if info.IsDir() {
+		return nil
+	}
+
+	state, err := i.fileState(h.cfg.Endpoint, p, file, info.Size())
+	if err != nil {
+		return err
+	}
+	if state.Offset >= info.Size() {
+		return nil
+	}
+	if _, err = file.Seek(state.Offset, io.SeekStart); err != nil {
+		return fmt.Errorf("seek: %w", err)
+	}
+
+	attributes := make(map[string]any, 3)
+	attributes[LogFileHost] = h.cfg.Endpoint
+	if i.includeFileName {
+		attributes[attrs.LogFileName] = path.Base(p)
+	}
+	if i.includeFilePath {
+		attributes[attrs.LogFilePath] = p
+	}
+
+	offset := state.Offset
+	s := bufio.NewScanner(file)
+	s.Buffer(make([]byte, 0, 16*1024), 2*i.maxLogSize)
+	s.Split(func(data []byte, atEOF bool) (int, []byte, error) {
+		advance, token, err := i.splitFunc(data, atEOF)
+		offset += int64(advance)
+		return advance, token, err
+	})
+	decoder := decode.New(i.encoding)
+	for s.Scan() {
+		select {
+		case <-ctx.Done():
+			return nil
+		default:
+		}
+		token, err := decoder.Decode(s.Bytes())
+		if err != nil {
+			i.Logger().Error("decode", zap.Error(err))
+		} else if err = i.emit(ctx, token, attributes); err != nil {
+			i.Logger().Error("emit", zap.Error(err))
+		}
+		i.setOffset(h.cfg.Endpoint, p, offset)
+	}
+	return s.Err()
+}
+
+// fileState returns the state of the remote file, starting over when its content changed since the last poll
+func (i *Input) fileState(host, p string, file io.ReaderAt, size int64) (*fileState, error) {
+	fp := make([]byte, min(int64(i.fingerprintSize), size))
+	if _, err := file.ReadAt(fp, 0); err != nil && !errors.Is(err, io.EOF) {
+		return nil, fmt.Errorf("reading fingerprint bytes: %w", err)
+	}
+
+	i.filesMu.Lock()
+	defer i.filesMu.Unlock()
+	hostFiles, ok := i.files[host]
+	if !ok {
+		hostFiles = make(map[string]*fileState)
+		i.files[host] = hostFiles
+	}
+	state, ok := hostFiles[p]
+	switch {
+	case !ok:
+		state = &fileState{}
+		if !i.fromBeginning {
+			state.Offset = size
+		}
+		hostFiles[p] = state
+	case size < state.Offset || !bytes.HasPrefix(fp, state.Fingerprint[:min(len(fp), len(state.Fingerprint))]):
+		// The file has been truncated or replaced by the rotation
+		i.Logger().Debug("File has been rotated", zap.String("host", host), zap.String("path", p))
+		state.Offset = 0
+	}
+	state.Fingerprint = fp
+	return &fileState{Offset: state.Offset, Fingerprint: fp}, nil
+}
+
+// forgetMissingFiles drops the state of the files of the host which no longer match
+func (i *Input) forgetMissingFiles(host string, paths []string) {
+	matched := make(map[string]bool, len(paths))
+	for _, p := range paths {
+		matched[p] = true
+	}
+	i.filesMu.Lock()
+	defer i.filesMu.Unlock()
+	for p := range i.files[host] {
+		if !matched[p] {
+			delete(i.files[host], p)
+		}
+	}
+}
+
+func (i *Input) setOffset(host, p string, offset int64) {
+	i.filesMu.Lock()
+	defer i.filesMu.Unlock()
+	i.files[host][p].Offset = offset
+}
+
+func (i *Input) emit(ctx context.Context, token []byte, attributes map[string]any) error {
+	if len(token) == 0 {
+		return nil
+	}
+
+	ent, err := i.NewEntry(i.toBody(token))
+	if err != nil {
+		return fmt.Errorf("create entry: %w", err)
+	}
+
+	for k, v := range attributes {
+		if err := ent.Set(entry.NewAttributeField(k), v); err != nil {
+			i.Logger().Error("set attribute", zap.Error(err))
+		}
+	}
+	i.Write(ctx, ent)
+	return nil
+}
+
+func (i *Input) loadFiles(ctx context.Context) (map[string]map[string]*fileState, error) {
+	encoded, err := i.persister.Get(ctx, knownFilesKey)
+	if err != nil {
+		return nil, err
+	}
+	files := make(map[string]map[string]*fileState)
+	if encoded == nil {
+		return files, nil
+	}
+	if err = json.Unmarshal(encoded, &files); err != nil {
+		return nil, fmt.Errorf("decoding known files: %w", err)
+	}
+	return files, nil
+}
+
+func (i *Input) saveFiles(ctx context.Context) error {
+	i.filesMu.Lock()
+	encoded, err := json.Marshal(i.files)
+	i.filesMu.Unlock()
+	if err != nil {
+		return fmt.Errorf("encode known files: %w", err)
+	}
+	return i.persister.Set(ctx, knownFilesKey, encoded)
+}
@@ -0,0 +1,229 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package sftpinput
+
+import (
+	"context"
+	"net"
+	"os"
+	"path/filepath"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/pkg/sftp"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/collector/component/componenttest"
+	"golang.org/x/crypto/ssh"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/stanza/fileconsumer"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/stanza/fileconsumer/attrs"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/stanza/operator"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/stanza/operator/helper"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/stanza/testutil"
+)
+
+// pipeDial serves the local file system over SFTP through an in-memory connection
+func pipeDial(t *testing.T, dials *atomic.Int32) dialFunc {
+	return func(string, *ssh.ClientConfig) (*connection, error) {
+		dials.Add(1)
+		clientConn, serverConn := net.Pipe()
+		server, err := sftp.NewServer(serverConn)
+		require.NoError(t, err)
+		go func() {
+			_ = server.Serve()
+			_ = server.Close()
+		}()
+		client, err := sftp.NewClientPipe(clientConn, clientConn)
+		if err != nil {
+			return nil, err
+		}
+		return &connection{Client: client}, nil
+	}
+}
+
+func newTestConfig(tempDir string) *InputConfig {
+	files := fileconsumer.NewConfig()
+	files.Include = []string{filepath.Join(tempDir, "*.log")}
+	files.PollInterval = 10 * time.Millisecond
+	files.StartAt = "beginning"
+	files.IncludeFilePath = true
+
+	input := helper.NewInputConfig("sftp_test", "sftp_input")
+	input.OutputIDs = []string{"fake"}
+
+	return NewInputConfig(input, *files, Config{
+		Hosts: []HostConfig{
+			{Endpoint: "host1:22", Username: "otel", Password: "secret", IgnoreHostKey: true},
+		},
+		MaxConcurrentFilesPerHost: 1,
+	})
+}
+
+func newTestInput(t *testing.T, cfg *InputConfig, dials *atomic.Int32) (*Input, *testutil.FakeOutput) {
+	op, err := cfg.Build(componenttest.NewNopTelemetrySettings())
+	require.NoError(t, err)
+	fakeOutput := testutil.NewFakeOutput(t)
+	require.NoError(t, op.SetOutputs([]operator.Operator{fakeOutput}))
+
+	input := op.(*Input)
+	input.dial = pipeDial(t, dials)
+	return input, fakeOutput
+}
+
+func expectBodies(t *testing.T, output *testutil.FakeOutput, expected ...string) {
+	received := make(map[string]bool, len(expected))
+	for range expected {
+		select {
+		case e := <-output.Received:
+			received[e.Body.(string)] = true
+			require.Equal(t, "host1:22", e.Attributes[LogFileHost])
+			require.Contains(t, e.Attributes, attrs.LogFilePath)
+		case <-time.After(3 * time.Second):
+			require.FailNow(t, "Timed out waiting for entries", "received %v, expected %v", received, expected)
+		}
+	}
+	for _, body := range expected {
+		require.True(t, received[body], "missing %q", body)
+	}
+}
+
+func TestTailRemoteFiles(t *testing.T) {
+	tempDir := t.TempDir()
+	logPath := filepath.Join(tempDir, "app.log")
+	require.NoError(t, os.WriteFile(logPath, []byte("testlog1\ntestlog2\n"), 0600))
+	require.NoError(t, os.WriteFile(filepath.Join(tempDir, "other.log"), []byte("other1\n"), 0600))
+	require.NoError(t, os.WriteFile(filepath.Join(tempDir, "ignored.txt"), []byte("ignored\n"), 0600))
+
+	var dials atomic.Int32
+	input, output := newTestInput(t, newTestConfig(tempDir), &dials)
+	persister := testutil.NewUnscopedMockPersister()
+	require.NoError(t, input.Start(persister))
+
+	expectBodies(t, output, "testlog1", "testlog2", "other1")
+
+	// Only the new lines are read, the incomplete last line once it is terminated
+	file, err := os.OpenFile(logPath, os.O_APPEND|os.O_WRONLY, 0600)
+	require.NoError(t, err)
+	_, err = file.WriteString("testlog3\ntestlog")
+	require.NoError(t, err)
+	expectBodies(t, output, "testlog3")
+	output.ExpectNoEntry(t, 50*time.Millisecond)
+	_, err = file.WriteString("4\n")
+	require.NoError(t, err)
+	require.NoError(t, file.Close())
+	expectBodies(t, output, "testlog4")
+
+	require.NoError(t, input.Stop())
+	require.Equal(t, int32(1), dials.Load())
+
+	// The offsets are resumed from the storage
+	require.NoError(t, os.WriteFile(filepath.Join(tempDir, "other.log"), []byte("other1\nother2\n"), 0600))
+	input, output = newTestInput(t, newTestConfig(tempDir), &dials)
+	require.NoError(t, input.Start(persister))
+	expectBodies(t, output, "other2")
+	output.ExpectNoEntry(t, 50*time.Millisecond)
+	require.NoError(t, input.Stop())
+}
+
+func TestRemoteFileRotated(t *testing.T) {
+	tempDir := t.TempDir()
+	logPath := filepath.Join(tempDir, "app.log")
+	require.NoError(t, os.WriteFile(logPath, []byte("testlog1\ntestlog2\n"), 0600))
+
+	var dials atomic.Int32
+	input, output := newTestInput(t, newTestConfig(tempDir), &dials)
+	require.NoError(t, input.Start(nil))
+	defer func() {
+		require.NoError(t, input.Stop())
+	}()
+	expectBodies(t, output, "testlog1", "testlog2")
+
+	// The file is replaced by a longer one, which is read from its beginning
+	require.NoError(t, os.WriteFile(logPath, []byte("rotated1\nrotated2\nrotated3\n"), 0600))
+	expectBodies(t, output, "rotated1", "rotated2", "rotated3")
+
+	// The file is truncated
+	require.NoError(t, os.WriteFile(logPath, []byte("short\n"), 0600))
+	expectBodies(t, output, "short")
+}
+
+func TestStartAtEnd(t *testing.T) {
+	tempDir := t.TempDir()
+	logPath := filepath.Join(tempDir, "app.log")
+	require.NoError(t, os.WriteFile(logPath, []byte("testlog1\n"), 0600))
+
+	cfg := newTestConfig(tempDir)
+	cfg.StartAt = "end"
+	var dials atomic.Int32
+	input, output := newTestInput(t, cfg, &dials)
+	require.NoError(t, input.Start(nil))
+	defer func() {
+		require.NoError(t, input.Stop())
+	}()
+
+	require.Eventually(t, func() bool {
+		return dials.Load() > 0
+	}, 3*time.Second, 5*time.Millisecond)
+	time.Sleep(50 * time.Millisecond)
+
+	file, err := os.OpenFile(logPath, os.O_APPEND|os.O_WRONLY, 0600)
+	require.NoError(t, err)
+	_, err = file.WriteString("testlog2\n")
+	require.NoError(t, err)
+	require.NoError(t, file.Close())
+
+	// A file created after the first poll is read from its beginning
+	require.NoError(t, os.WriteFile(filepath.Join(tempDir, "new.log"), []byte("new1\n"), 0600))
+	expectBodies(t, output, "testlog2", "new1")
+}
+
+func TestPerHostConcurrency(t *testing.T) {
+	tempDir := t.TempDir()
+	for _, name := range []string{"a.log", "b.log", "c.log"} {
+		require.NoError(t, os.WriteFile(filepath.Join(tempDir, name), []byte(name+"\n"), 0600))
+	}
+
+	var dials atomic.Int32
+	input, _ := newTestInput(t, newTestConfig(tempDir), &dials)
+	host := input.hosts[0]
+	require.Equal(t, 1, cap(host.sem))
+
+	// The only slot of the host is taken, no file can be read until it is released
+	host.sem <- struct{}{}
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+	input.pollHost(ctx, host)
+	for _, state := range input.files["host1:22"] {
+		require.Zero(t, state.Offset)
+	}
+	<-host.sem
+
+	input.pollHost(context.Background(), host)
+	require.Len(t, input.files["host1:22"], 3)
+	for _, state := range input.files["host1:22"] {
+		require.NotZero(t, state.Offset)
+	}
+	require.NoError(t, input.Stop())
+}
+
+func TestReconnect(t *testing.T) {
+	tempDir := t.TempDir()
+	require.NoError(t, os.WriteFile(filepath.Join(tempDir, "app.log"), []byte("testlog1\n"), 0600))
+
+	var dials atomic.Int32
+	input, output := newTestInput(t, newTestConfig(tempDir), &dials)
+	host := input.hosts[0]
+
+	input.pollHost(context.Background(), host)
+	expectBodies(t, output, "testlog1")
+
+	// The broken connection is established again, the file being read from its known offset
+	require.NoError(t, host.conn.Client.Close())
+	require.NoError(t, os.WriteFile(filepath.Join(tempDir, "app.log"), []byte("testlog1\ntestlog2\n"), 0600))
+	input.pollHost(context.Background(), host)
+	expectBodies(t, output, "testlog2")
+	require.Equal(t, int32(2), dials.Load())
+	require.NoError(t, input.Stop())
+}
@@ -56,7 +56,11 @@ type MetricsConfig struct {
 	FlinkMemoryManagedUsed            MetricConfig `mapstructure:"flink.memory.managed.used"`
 	FlinkOperatorRecordCount          MetricConfig `mapstructure:"flink.operator.record.count"`
 	FlinkOperatorWatermarkOutput      MetricConfig `mapstructure:"flink.operator.watermark.output"`
+	FlinkTaskBackpressureRatio        MetricConfig `mapstructure:"flink.task.backpressure.ratio"`
+	FlinkTaskCheckpointAlignmentTime  MetricConfig `mapstructure:"flink.task.checkpoint.alignment.time"`
+	FlinkTaskCheckpointStartDelay     MetricConfig `mapstructure:"flink.task.checkpoint.start_delay"`
 	FlinkTaskRecordCount              MetricConfig `mapstructure:"flink.task.record.count"`
+	FlinkTaskWatermarkLag             MetricConfig `mapstructure:"flink.task.watermark.lag"`
 }
 
 func DefaultMetricsConfig() MetricsConfig {
@@ -145,9 +149,21 @@ func DefaultMetricsConfig() MetricsConfig {
 		FlinkOperatorWatermarkOutput: MetricConfig{
 			Enabled: true,
 		},
+		FlinkTaskBackpressureRatio: MetricConfig{
+			Enabled: true,
+		},
+		FlinkTaskCheckpointAlignmentTime: MetricConfig{
+			Enabled: true,
+		},
+		FlinkTaskCheckpointStartDelay: MetricConfig{
+			Enabled: true,
+		},
 		FlinkTaskRecordCount: MetricConfig{
 			Enabled: true,
 		},
+		FlinkTaskWatermarkLag: MetricConfig{
+			Enabled: true,
+		},
 	}
 }
 
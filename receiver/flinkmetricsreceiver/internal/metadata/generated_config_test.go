@@ -53,7 +53,11 @@ func TestMetricsBuilderConfig(t *testing.T) {
 					FlinkMemoryManagedUsed:            MetricConfig{Enabled: true},
 					FlinkOperatorRecordCount:          MetricConfig{Enabled: true},
 					FlinkOperatorWatermarkOutput:      MetricConfig{Enabled: true},
+					FlinkTaskBackpressureRatio:        MetricConfig{Enabled: true},
+					FlinkTaskCheckpointAlignmentTime:  MetricConfig{Enabled: true},
+					FlinkTaskCheckpointStartDelay:     MetricConfig{Enabled: true},
 					FlinkTaskRecordCount:              MetricConfig{Enabled: true},
+					FlinkTaskWatermarkLag:             MetricConfig{Enabled: true},
 				},
 				ResourceAttributes: ResourceAttributesConfig{
 					FlinkJobName:       ResourceAttributeConfig{Enabled: true},
@@ -97,7 +101,11 @@ func TestMetricsBuilderConfig(t *testing.T) {
 					FlinkMemoryManagedUsed:            MetricConfig{Enabled: false},
 					FlinkOperatorRecordCount:          MetricConfig{Enabled: false},
 					FlinkOperatorWatermarkOutput:      MetricConfig{Enabled: false},
+					FlinkTaskBackpressureRatio:        MetricConfig{Enabled: false},
+					FlinkTaskCheckpointAlignmentTime:  MetricConfig{Enabled: false},
+					FlinkTaskCheckpointStartDelay:     MetricConfig{Enabled: false},
 					FlinkTaskRecordCount:              MetricConfig{Enabled: false},
+					FlinkTaskWatermarkLag:             MetricConfig{Enabled: false},
 				},
 				ResourceAttributes: ResourceAttributesConfig{
 					FlinkJobName:       ResourceAttributeConfig{Enabled: false},
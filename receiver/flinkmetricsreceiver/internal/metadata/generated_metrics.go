@@ -1539,6 +1539,153 @@ func newMetricFlinkOperatorWatermarkOutput(cfg MetricConfig) metricFlinkOperator
 	return m
 }
 
+type metricFlinkTaskBackpressureRatio struct {
+	data     pmetric.Metric // data buffer for generated metric.
+	config   MetricConfig   // metric config provided by user.
+	capacity int            // max observed number of data points added to the metric.
+}
+
+// init fills flink.task.backpressure.ratio metric with initial data.
+func (m *metricFlinkTaskBackpressureRatio) init() {
+	m.data.SetName("flink.task.backpressure.ratio")
+	m.data.SetDescription("The ratio of time a task is back pressured, between 0 and 1. Derived from Flink's `backPressuredTimeMsPerSecond` metric.")
+	m.data.SetUnit("1")
+	m.data.SetEmptyGauge()
+}
+
+func (m *metricFlinkTaskBackpressureRatio) recordDataPoint(start pcommon.Timestamp, ts pcommon.Timestamp, val float64) {
+	if !m.config.Enabled {
+		return
+	}
+	dp := m.data.Gauge().DataPoints().AppendEmpty()
+	dp.SetStartTimestamp(start)
+	dp.SetTimestamp(ts)
+	dp.SetDoubleValue(val)
+}
+
+// updateCapacity saves max length of data point slices that will be used for the slice capacity.
+func (m *metricFlinkTaskBackpressureRatio) updateCapacity() {
+	if m.data.Gauge().DataPoints().Len() > m.capacity {
+		m.capacity = m.data.Gauge().DataPoints().Len()
+	}
+}
+
+// emit appends recorded metric data to a metrics slice and prepares it for recording another set of data points.
+func (m *metricFlinkTaskBackpressureRatio) emit(metrics pmetric.MetricSlice) {
+	if m.config.Enabled && m.data.Gauge().DataPoints().Len() > 0 {
+		m.updateCapacity()
+		m.data.MoveTo(metrics.AppendEmpty())
+		m.init()
+	}
+}
+
+func newMetricFlinkTaskBackpressureRatio(cfg MetricConfig) metricFlinkTaskBackpressureRatio {
+	m := metricFlinkTaskBackpressureRatio{config: cfg}
+	if cfg.Enabled {
+		m.data = pmetric.NewMetric()
+		m.init()
+	}
+	return m
+}
+
+type metricFlinkTaskCheckpointAlignmentTime struct {
+	data     pmetric.Metric // data buffer for generated metric.
+	config   MetricConfig   // metric config provided by user.
+	capacity int            // max observed number of data points added to the metric.
+}
+
+// init fills flink.task.checkpoint.alignment.time metric with initial data.
+func (m *metricFlinkTaskCheckpointAlignmentTime) init() {
+	m.data.SetName("flink.task.checkpoint.alignment.time")
+	m.data.SetDescription("The time it took a task to align the barriers of the last checkpoint.")
+	m.data.SetUnit("ns")
+	m.data.SetEmptyGauge()
+}
+
+func (m *metricFlinkTaskCheckpointAlignmentTime) recordDataPoint(start pcommon.Timestamp, ts pcommon.Timestamp, val int64) {
+	if !m.config.Enabled {
+		return
+	}
+	dp := m.data.Gauge().DataPoints().AppendEmpty()
+	dp.SetStartTimestamp(start)
+	dp.SetTimestamp(ts)
+	dp.SetIntValue(val)
+}
+
+// updateCapacity saves max length of data point slices that will be used for the slice capacity.
+func (m *metricFlinkTaskCheckpointAlignmentTime) updateCapacity() {
+	if m.data.Gauge().DataPoints().Len() > m.capacity {
+		m.capacity = m.data.Gauge().DataPoints().Len()
+	}
+}
+
+// emit appends recorded metric data to a metrics slice and prepares it for recording another set of data points.
+func (m *metricFlinkTaskCheckpointAlignmentTime) emit(metrics pmetric.MetricSlice) {
+	if m.config.Enabled && m.data.Gauge().DataPoints().Len() > 0 {
+		m.updateCapacity()
+		m.data.MoveTo(metrics.AppendEmpty())
+		m.init()
+	}
+}
+
+func newMetricFlinkTaskCheckpointAlignmentTime(cfg MetricConfig) metricFlinkTaskCheckpointAlignmentTime {
+	m := metricFlinkTaskCheckpointAlignmentTime{config: cfg}
+	if cfg.Enabled {
+		m.data = pmetric.NewMetric()
+		m.init()
+	}
+	return m
+}
+
+type metricFlinkTaskCheckpointStartDelay struct {
+	data     pmetric.Metric // data buffer for generated metric.
+	config   MetricConfig   // metric config provided by user.
+	capacity int            // max observed number of data points added to the metric.
+}
+
+// init fills flink.task.checkpoint.start_delay metric with initial data.
+func (m *metricFlinkTaskCheckpointStartDelay) init() {
+	m.data.SetName("flink.task.checkpoint.start_delay")
+	m.data.SetDescription("The time between the start of the last checkpoint and its first barrier reaching a task. A high delay is a sign of back pressure.")
+	m.data.SetUnit("ns")
+	m.data.SetEmptyGauge()
+}
+
+func (m *metricFlinkTaskCheckpointStartDelay) recordDataPoint(start pcommon.Timestamp, ts pcommon.Timestamp, val int64) {
+	if !m.config.Enabled {
+		return
+	}
+	dp := m.data.Gauge().DataPoints().AppendEmpty()
+	dp.SetStartTimestamp(start)
+	dp.SetTimestamp(ts)
+	dp.SetIntValue(val)
+}
+
+// updateCapacity saves max length of data point slices that will be used for the slice capacity.
+func (m *metricFlinkTaskCheckpointStartDelay) updateCapacity() {
+	if m.data.Gauge().DataPoints().Len() > m.capacity {
+		m.capacity = m.data.Gauge().DataPoints().Len()
+	}
+}
+
+// emit appends recorded metric data to a metrics slice and prepares it for recording another set of data points.
+func (m *metricFlinkTaskCheckpointStartDelay) emit(metrics pmetric.MetricSlice) {
+	if m.config.Enabled && m.data.Gauge().DataPoints().Len() > 0 {
+		m.updateCapacity()
+		m.data.MoveTo(metrics.AppendEmpty())
+		m.init()
+	}
+}
+
+func newMetricFlinkTaskCheckpointStartDelay(cfg MetricConfig) metricFlinkTaskCheckpointStartDelay {
+	m := metricFlinkTaskCheckpointStartDelay{config: cfg}
+	if cfg.Enabled {
+		m.data = pmetric.NewMetric()
+		m.init()
+	}
+	return m
+}
+
 type metricFlinkTaskRecordCount struct {
 	data     pmetric.Metric // data buffer for generated metric.
 	config   MetricConfig   // metric config provided by user.
@@ -1592,6 +1739,55 @@ func newMetricFlinkTaskRecordCount(cfg MetricConfig) metricFlinkTaskRecordCount
 	return m
 }
 
+type metricFlinkTaskWatermarkLag struct {
+	data     pmetric.Metric // data buffer for generated metric.
+	config   MetricConfig   // metric config provided by user.
+	capacity int            // max observed number of data points added to the metric.
+}
+
+// init fills flink.task.watermark.lag metric with initial data.
+func (m *metricFlinkTaskWatermarkLag) init() {
+	m.data.SetName("flink.task.watermark.lag")
+	m.data.SetDescription("The difference between the current time and the last watermark a task has received.")
+	m.data.SetUnit("ms")
+	m.data.SetEmptyGauge()
+}
+
+func (m *metricFlinkTaskWatermarkLag) recordDataPoint(start pcommon.Timestamp, ts pcommon.Timestamp, val int64) {
+	if !m.config.Enabled {
+		return
+	}
+	dp := m.data.Gauge().DataPoints().AppendEmpty()
+	dp.SetStartTimestamp(start)
+	dp.SetTimestamp(ts)
+	dp.SetIntValue(val)
+}
+
+// updateCapacity saves max length of data point slices that will be used for the slice capacity.
+func (m *metricFlinkTaskWatermarkLag) updateCapacity() {
+	if m.data.Gauge().DataPoints().Len() > m.capacity {
+		m.capacity = m.data.Gauge().DataPoints().Len()
+	}
+}
+
+// emit appends recorded metric data to a metrics slice and prepares it for recording another set of data points.
+func (m *metricFlinkTaskWatermarkLag) emit(metrics pmetric.MetricSlice) {
+	if m.config.Enabled && m.data.Gauge().DataPoints().Len() > 0 {
+		m.updateCapacity()
+		m.data.MoveTo(metrics.AppendEmpty())
+		m.init()
+	}
+}
+
+func newMetricFlinkTaskWatermarkLag(cfg MetricConfig) metricFlinkTaskWatermarkLag {
+	m := metricFlinkTaskWatermarkLag{config: cfg}
+	if cfg.Enabled {
+		m.data = pmetric.NewMetric()
+		m.init()
+	}
+	return m
+}
+
 // MetricsBuilder provides an interface for scrapers to report metrics while taking care of all the transformations
 // required to produce metric representation defined in metadata and user config.
 type MetricsBuilder struct {
@@ -1630,7 +1826,11 @@ type MetricsBuilder struct {
 	metricFlinkMemoryManagedUsed            metricFlinkMemoryManagedUsed
 	metricFlinkOperatorRecordCount          metricFlinkOperatorRecordCount
 	metricFlinkOperatorWatermarkOutput      metricFlinkOperatorWatermarkOutput
+	metricFlinkTaskBackpressureRatio        metricFlinkTaskBackpressureRatio
+	metricFlinkTaskCheckpointAlignmentTime  metricFlinkTaskCheckpointAlignmentTime
+	metricFlinkTaskCheckpointStartDelay     metricFlinkTaskCheckpointStartDelay
 	metricFlinkTaskRecordCount              metricFlinkTaskRecordCount
+	metricFlinkTaskWatermarkLag             metricFlinkTaskWatermarkLag
 }
 
 // metricBuilderOption applies changes to default metrics builder.
@@ -1677,7 +1877,11 @@ func NewMetricsBuilder(mbc MetricsBuilderConfig, settings receiver.CreateSetting
 		metricFlinkMemoryManagedUsed:            newMetricFlinkMemoryManagedUsed(mbc.Metrics.FlinkMemoryManagedUsed),
 		metricFlinkOperatorRecordCount:          newMetricFlinkOperatorRecordCount(mbc.Metrics.FlinkOperatorRecordCount),
 		metricFlinkOperatorWatermarkOutput:      newMetricFlinkOperatorWatermarkOutput(mbc.Metrics.FlinkOperatorWatermarkOutput),
+		metricFlinkTaskBackpressureRatio:        newMetricFlinkTaskBackpressureRatio(mbc.Metrics.FlinkTaskBackpressureRatio),
+		metricFlinkTaskCheckpointAlignmentTime:  newMetricFlinkTaskCheckpointAlignmentTime(mbc.Metrics.FlinkTaskCheckpointAlignmentTime),
+		metricFlinkTaskCheckpointStartDelay:     newMetricFlinkTaskCheckpointStartDelay(mbc.Metrics.FlinkTaskCheckpointStartDelay),
 		metricFlinkTaskRecordCount:              newMetricFlinkTaskRecordCount(mbc.Metrics.FlinkTaskRecordCount),
+		metricFlinkTaskWatermarkLag:             newMetricFlinkTaskWatermarkLag(mbc.Metrics.FlinkTaskWatermarkLag),
 		resourceAttributeIncludeFilter:          make(map[string]filter.Filter),
 		resourceAttributeExcludeFilter:          make(map[string]filter.Filter),
 	}
@@ -1806,7 +2010,11 @@ func (mb *MetricsBuilder) EmitForResource(rmo ...ResourceMetricsOption) {
 	mb.metricFlinkMemoryManagedUsed.emit(ils.Metrics())
 	mb.metricFlinkOperatorRecordCount.emit(ils.Metrics())
 	mb.metricFlinkOperatorWatermarkOutput.emit(ils.Metrics())
+	mb.metricFlinkTaskBackpressureRatio.emit(ils.Metrics())
+	mb.metricFlinkTaskCheckpointAlignmentTime.emit(ils.Metrics())
+	mb.metricFlinkTaskCheckpointStartDelay.emit(ils.Metrics())
 	mb.metricFlinkTaskRecordCount.emit(ils.Metrics())
+	mb.metricFlinkTaskWatermarkLag.emit(ils.Metrics())
 
 	for _, op := range rmo {
 		op(rm)
@@ -2118,6 +2326,31 @@ func (mb *MetricsBuilder) RecordFlinkOperatorWatermarkOutputDataPoint(ts pcommon
 	return nil
 }
 
+// RecordFlinkTaskBackpressureRatioDataPoint adds a data point to flink.task.backpressure.ratio metric.
+func (mb *MetricsBuilder) RecordFlinkTaskBackpressureRatioDataPoint(ts pcommon.Timestamp, val float64) {
+	mb.metricFlinkTaskBackpressureRatio.recordDataPoint(mb.startTime, ts, val)
+}
+
+// RecordFlinkTaskCheckpointAlignmentTimeDataPoint adds a data point to flink.task.checkpoint.alignment.time metric.
+func (mb *MetricsBuilder) RecordFlinkTaskCheckpointAlignmentTimeDataPoint(ts pcommon.Timestamp, inputVal string) error {
+	val, err := strconv.ParseInt(inputVal, 10, 64)
+	if err != nil {
+		return fmt.Errorf("failed to parse int64 for FlinkTaskCheckpointAlignmentTime, value was %s: %w", inputVal, err)
+	}
+	mb.metricFlinkTaskCheckpointAlignmentTime.recordDataPoint(mb.startTime, ts, val)
+	return nil
+}
+
+// RecordFlinkTaskCheckpointStartDelayDataPoint adds a data point to flink.task.checkpoint.start_delay metric.
+func (mb *MetricsBuilder) RecordFlinkTaskCheckpointStartDelayDataPoint(ts pcommon.Timestamp, inputVal string) error {
+	val, err := strconv.ParseInt(inputVal, 10, 64)
+	if err != nil {
+		return fmt.Errorf("failed to parse int64 for FlinkTaskCheckpointStartDelay, value was %s: %w", inputVal, err)
+	}
+	mb.metricFlinkTaskCheckpointStartDelay.recordDataPoint(mb.startTime, ts, val)
+	return nil
+}
+
 // RecordFlinkTaskRecordCountDataPoint adds a data point to flink.task.record.count metric.
 func (mb *MetricsBuilder) RecordFlinkTaskRecordCountDataPoint(ts pcommon.Timestamp, inputVal string, recordAttributeValue AttributeRecord) error {
 	val, err := strconv.ParseInt(inputVal, 10, 64)
@@ -2128,6 +2361,11 @@ func (mb *MetricsBuilder) RecordFlinkTaskRecordCountDataPoint(ts pcommon.Timesta
 	return nil
 }
 
+// RecordFlinkTaskWatermarkLagDataPoint adds a data point to flink.task.watermark.lag metric.
+func (mb *MetricsBuilder) RecordFlinkTaskWatermarkLagDataPoint(ts pcommon.Timestamp, val int64) {
+	mb.metricFlinkTaskWatermarkLag.recordDataPoint(mb.startTime, ts, val)
+}
+
 // Reset resets metrics builder to its initial state. It should be used when external metrics source is restarted,
 // and metrics builder should update its startTime and reset it's internal state accordingly.
 func (mb *MetricsBuilder) Reset(options ...metricBuilderOption) {
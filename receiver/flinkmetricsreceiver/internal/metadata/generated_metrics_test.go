@@ -180,10 +180,26 @@ func TestMetricsBuilder(t *testing.T) {
 			allMetricsCount++
 			mb.RecordFlinkOperatorWatermarkOutputDataPoint(ts, "1", "operator_name-val")
 
+			defaultMetricsCount++
+			allMetricsCount++
+			mb.RecordFlinkTaskBackpressureRatioDataPoint(ts, 1)
+
+			defaultMetricsCount++
+			allMetricsCount++
+			mb.RecordFlinkTaskCheckpointAlignmentTimeDataPoint(ts, "1")
+
+			defaultMetricsCount++
+			allMetricsCount++
+			mb.RecordFlinkTaskCheckpointStartDelayDataPoint(ts, "1")
+
 			defaultMetricsCount++
 			allMetricsCount++
 			mb.RecordFlinkTaskRecordCountDataPoint(ts, "1", AttributeRecordIn)
 
+			defaultMetricsCount++
+			allMetricsCount++
+			mb.RecordFlinkTaskWatermarkLagDataPoint(ts, 1)
+
 			rb := mb.NewResourceBuilder()
 			rb.SetFlinkJobName("flink.job.name-val")
 			rb.SetFlinkResourceTypeJobmanager()
@@ -619,6 +635,42 @@ func TestMetricsBuilder(t *testing.T) {
 					attrVal, ok := dp.Attributes().Get("name")
 					assert.True(t, ok)
 					assert.EqualValues(t, "operator_name-val", attrVal.Str())
+				case "flink.task.backpressure.ratio":
+					assert.False(t, validatedMetrics["flink.task.backpressure.ratio"], "Found a duplicate in the metrics slice: flink.task.backpressure.ratio")
+					validatedMetrics["flink.task.backpressure.ratio"] = true
+					assert.Equal(t, pmetric.MetricTypeGauge, ms.At(i).Type())
+					assert.Equal(t, 1, ms.At(i).Gauge().DataPoints().Len())
+					assert.Equal(t, "The ratio of time a task is back pressured, between 0 and 1. Derived from Flink's `backPressuredTimeMsPerSecond` metric.", ms.At(i).Description())
+					assert.Equal(t, "1", ms.At(i).Unit())
+					dp := ms.At(i).Gauge().DataPoints().At(0)
+					assert.Equal(t, start, dp.StartTimestamp())
+					assert.Equal(t, ts, dp.Timestamp())
+					assert.Equal(t, pmetric.NumberDataPointValueTypeDouble, dp.ValueType())
+					assert.Equal(t, float64(1), dp.DoubleValue())
+				case "flink.task.checkpoint.alignment.time":
+					assert.False(t, validatedMetrics["flink.task.checkpoint.alignment.time"], "Found a duplicate in the metrics slice: flink.task.checkpoint.alignment.time")
+					validatedMetrics["flink.task.checkpoint.alignment.time"] = true
+					assert.Equal(t, pmetric.MetricTypeGauge, ms.At(i).Type())
+					assert.Equal(t, 1, ms.At(i).Gauge().DataPoints().Len())
+					assert.Equal(t, "The time it took a task to align the barriers of the last checkpoint.", ms.At(i).Description())
+					assert.Equal(t, "ns", ms.At(i).Unit())
+					dp := ms.At(i).Gauge().DataPoints().At(0)
+					assert.Equal(t, start, dp.StartTimestamp())
+					assert.Equal(t, ts, dp.Timestamp())
+					assert.Equal(t, pmetric.NumberDataPointValueTypeInt, dp.ValueType())
+					assert.Equal(t, int64(1), dp.IntValue())
+				case "flink.task.checkpoint.start_delay":
+					assert.False(t, validatedMetrics["flink.task.checkpoint.start_delay"], "Found a duplicate in the metrics slice: flink.task.checkpoint.start_delay")
+					validatedMetrics["flink.task.checkpoint.start_delay"] = true
+					assert.Equal(t, pmetric.MetricTypeGauge, ms.At(i).Type())
+					assert.Equal(t, 1, ms.At(i).Gauge().DataPoints().Len())
+					assert.Equal(t, "The time between the start of the last checkpoint and its first barrier reaching a task. A high delay is a sign of back pressure.", ms.At(i).Description())
+					assert.Equal(t, "ns", ms.At(i).Unit())
+					dp := ms.At(i).Gauge().DataPoints().At(0)
+					assert.Equal(t, start, dp.StartTimestamp())
+					assert.Equal(t, ts, dp.Timestamp())
+					assert.Equal(t, pmetric.NumberDataPointValueTypeInt, dp.ValueType())
+					assert.Equal(t, int64(1), dp.IntValue())
 				case "flink.task.record.count":
 					assert.False(t, validatedMetrics["flink.task.record.count"], "Found a duplicate in the metrics slice: flink.task.record.count")
 					validatedMetrics["flink.task.record.count"] = true
@@ -636,6 +688,18 @@ func TestMetricsBuilder(t *testing.T) {
 					attrVal, ok := dp.Attributes().Get("record")
 					assert.True(t, ok)
 					assert.EqualValues(t, "in", attrVal.Str())
+				case "flink.task.watermark.lag":
+					assert.False(t, validatedMetrics["flink.task.watermark.lag"], "Found a duplicate in the metrics slice: flink.task.watermark.lag")
+					validatedMetrics["flink.task.watermark.lag"] = true
+					assert.Equal(t, pmetric.MetricTypeGauge, ms.At(i).Type())
+					assert.Equal(t, 1, ms.At(i).Gauge().DataPoints().Len())
+					assert.Equal(t, "The difference between the current time and the last watermark a task has received.", ms.At(i).Description())
+					assert.Equal(t, "ms", ms.At(i).Unit())
+					dp := ms.At(i).Gauge().DataPoints().At(0)
+					assert.Equal(t, start, dp.StartTimestamp())
+					assert.Equal(t, ts, dp.Timestamp())
+					assert.Equal(t, pmetric.NumberDataPointValueTypeInt, dp.ValueType())
+					assert.Equal(t, int64(1), dp.IntValue())
 				}
 			}
 		})
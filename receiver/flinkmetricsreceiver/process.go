@@ -4,6 +4,7 @@
 package flinkmetricsreceiver // import "github.com/open-telemetry/opentelemetry-collector-contrib/receiver/flinkmetricsreceiver"
 
 import (
+	"strconv"
 	"strings"
 
 	"go.opentelemetry.io/collector/pdata/pcommon"
@@ -161,6 +162,20 @@ func (s *flinkmetricsScraper) processSubtaskMetrics(now pcommon.Timestamp, subta
 	for _, subtaskMetrics := range subtaskMetricsInstances {
 		for _, metric := range subtaskMetrics.Metrics {
 			switch {
+			// record backpressure and checkpoint metrics
+			case metric.ID == "backPressuredTimeMsPerSecond":
+				if val, err := strconv.ParseFloat(metric.Value, 64); err == nil {
+					s.mb.RecordFlinkTaskBackpressureRatioDataPoint(now, val/1000)
+				}
+			case metric.ID == "currentInputWatermark":
+				// tasks that didn't receive any watermark yet report the minimum int64 value
+				if watermark, err := strconv.ParseInt(metric.Value, 10, 64); err == nil && watermark > 0 {
+					s.mb.RecordFlinkTaskWatermarkLagDataPoint(now, now.AsTime().UnixMilli()-watermark)
+				}
+			case metric.ID == "checkpointAlignmentTime":
+				_ = s.mb.RecordFlinkTaskCheckpointAlignmentTimeDataPoint(now, metric.Value)
+			case metric.ID == "checkpointStartDelayNanos":
+				_ = s.mb.RecordFlinkTaskCheckpointStartDelayDataPoint(now, metric.Value)
 			// record task metrics
 			case metric.ID == "numRecordsIn":
 				_ = s.mb.RecordFlinkTaskRecordCountDataPoint(now, metric.Value, metadata.AttributeRecordIn)
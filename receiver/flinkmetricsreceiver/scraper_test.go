@@ -259,6 +259,7 @@ func TestScraperScrape(t *testing.T) {
 			require.NoError(t, err)
 
 			require.NoError(t, pmetrictest.CompareMetrics(expectedMetrics, actualMetrics,
+				pmetrictest.IgnoreMetricValues("flink.task.watermark.lag"),
 				pmetrictest.IgnoreMetricDataPointsOrder(),
 				pmetrictest.IgnoreResourceMetricsOrder(),
 				pmetrictest.IgnoreStartTimestamp(), pmetrictest.IgnoreTimestamp()))
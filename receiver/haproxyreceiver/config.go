@@ -4,8 +4,6 @@
 package haproxyreceiver // import "github.com/open-telemetry/opentelemetry-collector-contrib/receiver/haproxyreceiver"
 
 import (
-	"errors"
-
 	"go.opentelemetry.io/collector/config/confighttp"
 	"go.opentelemetry.io/collector/receiver/scraperhelper"
 
@@ -17,10 +15,3 @@ type Config struct {
 	scraperhelper.ControllerConfig `mapstructure:",squash"`
 	metadata.MetricsBuilderConfig  `mapstructure:",squash"`
 }
-
-func (c Config) Validate() error {
-	if c.ClientConfig.Endpoint == "" {
-		return errors.New("'endpoint' cannot be empty")
-	}
-	return nil
-}
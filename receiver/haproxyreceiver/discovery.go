@@ -0,0 +1,75 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package haproxyreceiver // import "github.com/open-telemetry/opentelemetry-collector-contrib/receiver/haproxyreceiver"
+
+import (
+	"bufio"
+	"errors"
+	"os"
+	"path/filepath"
+	"strings"
+)
+
+// defaultConfigPaths are the locations of the HAProxy configuration in the distribution
+// packages and in the official container image.
+var defaultConfigPaths = []string{
+	"/etc/haproxy/haproxy.cfg",
+	"/usr/local/etc/haproxy/haproxy.cfg",
+}
+
+// defaultStatsSocketPaths are the stats socket paths commonly used by the distribution
+// packages and container images.
+var defaultStatsSocketPaths = []string{
+	"/run/haproxy/admin.sock",
+	"/var/run/haproxy/admin.sock",
+	"/var/run/haproxy.sock",
+	"/var/lib/haproxy/stats",
+	"/tmp/haproxy.sock",
+}
+
+// discoverStatsSocket looks for the stats socket of HAProxy, first using the `stats socket`
+// directives of the configuration files, then trying the well-known socket paths.
+func discoverStatsSocket(configPaths []string, socketPaths []string) (string, error) {
+	for _, configPath := range configPaths {
+		for _, socketPath := range statsSocketsFromConfig(configPath) {
+			if isSocket(socketPath) {
+				return socketPath, nil
+			}
+		}
+	}
+	for _, socketPath := range socketPaths {
+		if isSocket(socketPath) {
+			return socketPath, nil
+		}
+	}
+	return "", errors.New("no 'endpoint' configured, and no HAProxy stats socket was found")
+}
+
+// statsSocketsFromConfig returns the paths of the unix stats sockets declared in an HAProxy configuration file.
+func statsSocketsFromConfig(configPath string) []string {
+	f, err := os.Open(filepath.Clean(configPath))
+	if err != nil {
+		return nil
+	}
+	defer f.Close()
+
+	var paths []string
+	scanner := bufio.NewScanner(f)
+	for scanner.Scan() {
+		fields := strings.Fields(scanner.Text())
+		if len(fields) < 3 || fields[0] != "stats" || fields[1] != "socket" {
+			continue
+		}
+		path := strings.TrimPrefix(fields[2], "unix@")
+		if filepath.IsAbs(path) {
+			paths = append(paths, path)
+		}
+	}
+	return paths
+}
+
+func isSocket(path string) bool {
+	info, err := os.Stat(path)
+	return err == nil && info.Mode()&os.ModeSocket != 0
+}
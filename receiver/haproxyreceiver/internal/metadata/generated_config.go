@@ -54,6 +54,8 @@ type MetricsConfig struct {
 	HaproxySessionsCount        MetricConfig `mapstructure:"haproxy.sessions.count"`
 	HaproxySessionsRate         MetricConfig `mapstructure:"haproxy.sessions.rate"`
 	HaproxySessionsTotal        MetricConfig `mapstructure:"haproxy.sessions.total"`
+	HaproxyUp                   MetricConfig `mapstructure:"haproxy.up"`
+	HaproxyWeight               MetricConfig `mapstructure:"haproxy.weight"`
 }
 
 func DefaultMetricsConfig() MetricsConfig {
@@ -136,6 +138,12 @@ func DefaultMetricsConfig() MetricsConfig {
 		HaproxySessionsTotal: MetricConfig{
 			Enabled: false,
 		},
+		HaproxyUp: MetricConfig{
+			Enabled: false,
+		},
+		HaproxyWeight: MetricConfig{
+			Enabled: false,
+		},
 	}
 }
 
@@ -51,6 +51,8 @@ func TestMetricsBuilderConfig(t *testing.T) {
 					HaproxySessionsCount:        MetricConfig{Enabled: true},
 					HaproxySessionsRate:         MetricConfig{Enabled: true},
 					HaproxySessionsTotal:        MetricConfig{Enabled: true},
+					HaproxyUp:                   MetricConfig{Enabled: true},
+					HaproxyWeight:               MetricConfig{Enabled: true},
 				},
 				ResourceAttributes: ResourceAttributesConfig{
 					HaproxyAddr:        ResourceAttributeConfig{Enabled: true},
@@ -89,6 +91,8 @@ func TestMetricsBuilderConfig(t *testing.T) {
 					HaproxySessionsCount:        MetricConfig{Enabled: false},
 					HaproxySessionsRate:         MetricConfig{Enabled: false},
 					HaproxySessionsTotal:        MetricConfig{Enabled: false},
+					HaproxyUp:                   MetricConfig{Enabled: false},
+					HaproxyWeight:               MetricConfig{Enabled: false},
 				},
 				ResourceAttributes: ResourceAttributesConfig{
 					HaproxyAddr:        ResourceAttributeConfig{Enabled: false},
@@ -1374,6 +1374,104 @@ func newMetricHaproxySessionsTotal(cfg MetricConfig) metricHaproxySessionsTotal
 	return m
 }
 
+type metricHaproxyUp struct {
+	data     pmetric.Metric // data buffer for generated metric.
+	config   MetricConfig   // metric config provided by user.
+	capacity int            // max observed number of data points added to the metric.
+}
+
+// init fills haproxy.up metric with initial data.
+func (m *metricHaproxyUp) init() {
+	m.data.SetName("haproxy.up")
+	m.data.SetDescription("Whether the backend or server is up (1) or not (0), based on HAProxy's `status` field. Servers without health checks are reported as up.")
+	m.data.SetUnit("1")
+	m.data.SetEmptyGauge()
+}
+
+func (m *metricHaproxyUp) recordDataPoint(start pcommon.Timestamp, ts pcommon.Timestamp, val int64) {
+	if !m.config.Enabled {
+		return
+	}
+	dp := m.data.Gauge().DataPoints().AppendEmpty()
+	dp.SetStartTimestamp(start)
+	dp.SetTimestamp(ts)
+	dp.SetIntValue(val)
+}
+
+// updateCapacity saves max length of data point slices that will be used for the slice capacity.
+func (m *metricHaproxyUp) updateCapacity() {
+	if m.data.Gauge().DataPoints().Len() > m.capacity {
+		m.capacity = m.data.Gauge().DataPoints().Len()
+	}
+}
+
+// emit appends recorded metric data to a metrics slice and prepares it for recording another set of data points.
+func (m *metricHaproxyUp) emit(metrics pmetric.MetricSlice) {
+	if m.config.Enabled && m.data.Gauge().DataPoints().Len() > 0 {
+		m.updateCapacity()
+		m.data.MoveTo(metrics.AppendEmpty())
+		m.init()
+	}
+}
+
+func newMetricHaproxyUp(cfg MetricConfig) metricHaproxyUp {
+	m := metricHaproxyUp{config: cfg}
+	if cfg.Enabled {
+		m.data = pmetric.NewMetric()
+		m.init()
+	}
+	return m
+}
+
+type metricHaproxyWeight struct {
+	data     pmetric.Metric // data buffer for generated metric.
+	config   MetricConfig   // metric config provided by user.
+	capacity int            // max observed number of data points added to the metric.
+}
+
+// init fills haproxy.weight metric with initial data.
+func (m *metricHaproxyWeight) init() {
+	m.data.SetName("haproxy.weight")
+	m.data.SetDescription("Total effective weight of the backend, or effective weight of the server. Corresponds to HAProxy's `weight` metric.")
+	m.data.SetUnit("{weight}")
+	m.data.SetEmptyGauge()
+}
+
+func (m *metricHaproxyWeight) recordDataPoint(start pcommon.Timestamp, ts pcommon.Timestamp, val int64) {
+	if !m.config.Enabled {
+		return
+	}
+	dp := m.data.Gauge().DataPoints().AppendEmpty()
+	dp.SetStartTimestamp(start)
+	dp.SetTimestamp(ts)
+	dp.SetIntValue(val)
+}
+
+// updateCapacity saves max length of data point slices that will be used for the slice capacity.
+func (m *metricHaproxyWeight) updateCapacity() {
+	if m.data.Gauge().DataPoints().Len() > m.capacity {
+		m.capacity = m.data.Gauge().DataPoints().Len()
+	}
+}
+
+// emit appends recorded metric data to a metrics slice and prepares it for recording another set of data points.
+func (m *metricHaproxyWeight) emit(metrics pmetric.MetricSlice) {
+	if m.config.Enabled && m.data.Gauge().DataPoints().Len() > 0 {
+		m.updateCapacity()
+		m.data.MoveTo(metrics.AppendEmpty())
+		m.init()
+	}
+}
+
+func newMetricHaproxyWeight(cfg MetricConfig) metricHaproxyWeight {
+	m := metricHaproxyWeight{config: cfg}
+	if cfg.Enabled {
+		m.data = pmetric.NewMetric()
+		m.init()
+	}
+	return m
+}
+
 // MetricsBuilder provides an interface for scrapers to report metrics while taking care of all the transformations
 // required to produce metric representation defined in metadata and user config.
 type MetricsBuilder struct {
@@ -1410,6 +1508,8 @@ type MetricsBuilder struct {
 	metricHaproxySessionsCount        metricHaproxySessionsCount
 	metricHaproxySessionsRate         metricHaproxySessionsRate
 	metricHaproxySessionsTotal        metricHaproxySessionsTotal
+	metricHaproxyUp                   metricHaproxyUp
+	metricHaproxyWeight               metricHaproxyWeight
 }
 
 // metricBuilderOption applies changes to default metrics builder.
@@ -1454,6 +1554,8 @@ func NewMetricsBuilder(mbc MetricsBuilderConfig, settings receiver.CreateSetting
 		metricHaproxySessionsCount:        newMetricHaproxySessionsCount(mbc.Metrics.HaproxySessionsCount),
 		metricHaproxySessionsRate:         newMetricHaproxySessionsRate(mbc.Metrics.HaproxySessionsRate),
 		metricHaproxySessionsTotal:        newMetricHaproxySessionsTotal(mbc.Metrics.HaproxySessionsTotal),
+		metricHaproxyUp:                   newMetricHaproxyUp(mbc.Metrics.HaproxyUp),
+		metricHaproxyWeight:               newMetricHaproxyWeight(mbc.Metrics.HaproxyWeight),
 		resourceAttributeIncludeFilter:    make(map[string]filter.Filter),
 		resourceAttributeExcludeFilter:    make(map[string]filter.Filter),
 	}
@@ -1562,6 +1664,8 @@ func (mb *MetricsBuilder) EmitForResource(rmo ...ResourceMetricsOption) {
 	mb.metricHaproxySessionsCount.emit(ils.Metrics())
 	mb.metricHaproxySessionsRate.emit(ils.Metrics())
 	mb.metricHaproxySessionsTotal.emit(ils.Metrics())
+	mb.metricHaproxyUp.emit(ils.Metrics())
+	mb.metricHaproxyWeight.emit(ils.Metrics())
 
 	for _, op := range rmo {
 		op(rm)
@@ -1848,6 +1952,21 @@ func (mb *MetricsBuilder) RecordHaproxySessionsTotalDataPoint(ts pcommon.Timesta
 	return nil
 }
 
+// RecordHaproxyUpDataPoint adds a data point to haproxy.up metric.
+func (mb *MetricsBuilder) RecordHaproxyUpDataPoint(ts pcommon.Timestamp, val int64) {
+	mb.metricHaproxyUp.recordDataPoint(mb.startTime, ts, val)
+}
+
+// RecordHaproxyWeightDataPoint adds a data point to haproxy.weight metric.
+func (mb *MetricsBuilder) RecordHaproxyWeightDataPoint(ts pcommon.Timestamp, inputVal string) error {
+	val, err := strconv.ParseInt(inputVal, 10, 64)
+	if err != nil {
+		return fmt.Errorf("failed to parse int64 for HaproxyWeight, value was %s: %w", inputVal, err)
+	}
+	mb.metricHaproxyWeight.recordDataPoint(mb.startTime, ts, val)
+	return nil
+}
+
 // Reset resets metrics builder to its initial state. It should be used when external metrics source is restarted,
 // and metrics builder should update its startTime and reset it's internal state accordingly.
 func (mb *MetricsBuilder) Reset(options ...metricBuilderOption) {
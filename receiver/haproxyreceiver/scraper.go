@@ -31,8 +31,15 @@ var (
 	showStatsCommand = []byte("show stat\n")
 )
 
+// Values of the `type` field of the stats.
+const (
+	typeBackend = "1"
+	typeServer  = "2"
+)
+
 type scraper struct {
 	cfg               *Config
+	endpoint          string
 	httpClient        *http.Client
 	logger            *zap.Logger
 	mb                *metadata.MetricsBuilder
@@ -40,11 +47,20 @@ type scraper struct {
 }
 
 func (s *scraper) scrape(ctx context.Context) (pmetric.Metrics, error) {
+	// Discover the stats socket on scrape, as HAProxy may start after the collector.
+	if s.endpoint == "" {
+		endpoint, err := discoverStatsSocket(defaultConfigPaths, defaultStatsSocketPaths)
+		if err != nil {
+			return pmetric.NewMetrics(), err
+		}
+		s.logger.Info("Discovered HAProxy stats socket", zap.String("endpoint", endpoint))
+		s.endpoint = endpoint
+	}
 
 	var records []map[string]string
-	if u, notURLerr := url.Parse(s.cfg.Endpoint); notURLerr == nil && strings.HasPrefix(u.Scheme, "http") {
+	if u, notURLerr := url.Parse(s.endpoint); notURLerr == nil && strings.HasPrefix(u.Scheme, "http") {
 
-		resp, err := s.httpClient.Get(s.cfg.Endpoint + ";csv")
+		resp, err := s.httpClient.Get(s.endpoint + ";csv")
 		if err != nil {
 			return pmetric.NewMetrics(), err
 		}
@@ -59,7 +75,7 @@ func (s *scraper) scrape(ctx context.Context) (pmetric.Metrics, error) {
 		}
 	} else {
 		var d net.Dialer
-		c, err := d.DialContext(ctx, "unix", s.cfg.Endpoint)
+		c, err := d.DialContext(ctx, "unix", s.endpoint)
 		if err != nil {
 			return pmetric.NewMetrics(), err
 		}
@@ -247,10 +263,20 @@ func (s *scraper) scrape(ctx context.Context) (pmetric.Metrics, error) {
 				scrapeErrors = append(scrapeErrors, err)
 			}
 		}
+		if record["type"] == typeBackend || record["type"] == typeServer {
+			if record["status"] != "" {
+				s.mb.RecordHaproxyUpDataPoint(now, statusUp(record["status"]))
+			}
+			if record["weight"] != "" {
+				if err := s.mb.RecordHaproxyWeightDataPoint(now, record["weight"]); err != nil {
+					scrapeErrors = append(scrapeErrors, err)
+				}
+			}
+		}
 		rb := s.mb.NewResourceBuilder()
 		rb.SetHaproxyProxyName(record["pxname"])
 		rb.SetHaproxyServiceName(record["svname"])
-		rb.SetHaproxyAddr(s.cfg.Endpoint)
+		rb.SetHaproxyAddr(s.endpoint)
 		s.mb.EmitForResource(metadata.WithResource(rb.Emit()))
 	}
 
@@ -260,6 +286,15 @@ func (s *scraper) scrape(ctx context.Context) (pmetric.Metrics, error) {
 	return s.mb.Emit(), nil
 }
 
+// statusUp returns 1 if the status reports the backend or server as up, including while it's
+// transitioning to down, or when it isn't health checked. It returns 0 otherwise.
+func statusUp(status string) int64 {
+	if strings.HasPrefix(status, "UP") || status == "no check" {
+		return 1
+	}
+	return 0
+}
+
 func (s *scraper) readStats(buf []byte) ([]map[string]string, error) {
 	reader := csv.NewReader(bytes.NewReader(bytes.TrimSpace(buf)))
 	headers, err := reader.Read()
@@ -295,6 +330,7 @@ func newScraper(cfg *Config, settings receiver.CreateSettings) *scraper {
 		logger:            settings.TelemetrySettings.Logger,
 		mb:                metadata.NewMetricsBuilder(cfg.MetricsBuilderConfig, settings),
 		cfg:               cfg,
+		endpoint:          cfg.Endpoint,
 		telemetrySettings: settings.TelemetrySettings,
 	}
 }
@@ -12,6 +12,7 @@ import (
 	"testing"
 
 	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/collector/pdata/pmetric"
 	"go.opentelemetry.io/collector/receiver/receivertest"
 
 	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/golden"
@@ -144,3 +145,99 @@ func Test_scraper_readStatsWithNoValues(t *testing.T) {
 
 	require.Equal(t, 0, m.MetricCount())
 }
+
+func Test_scraper_readHealthStats(t *testing.T) {
+	socketAddr := serveStats(t, filepath.Join("testdata", "health_stats.txt"))
+
+	haProxyCfg := newDefaultConfig().(*Config)
+	haProxyCfg.Endpoint = socketAddr
+	haProxyCfg.Metrics.HaproxyUp.Enabled = true
+	haProxyCfg.Metrics.HaproxyWeight.Enabled = true
+	s := newScraper(haProxyCfg, receivertest.NewNopCreateSettings())
+	m, err := s.scrape(context.Background())
+	require.NoError(t, err)
+
+	require.Equal(t, map[string]int64{
+		"webservers/s1":      1,
+		"webservers/s2":      0,
+		"webservers/s3":      0,
+		"webservers/s4":      1,
+		"webservers/BACKEND": 1,
+	}, gaugeValues(m, "haproxy.up"))
+	require.Equal(t, map[string]int64{
+		"webservers/s1":      2,
+		"webservers/s2":      1,
+		"webservers/s3":      0,
+		"webservers/s4":      1,
+		"webservers/BACKEND": 4,
+	}, gaugeValues(m, "haproxy.weight"))
+}
+
+func Test_scraper_discoverStatsSocket(t *testing.T) {
+	socketAddr := serveStats(t, filepath.Join("testdata", "stats.txt"))
+
+	configPath := filepath.Join(t.TempDir(), "haproxy.cfg")
+	require.NoError(t, os.WriteFile(configPath, []byte("global\n    stats socket unix@"+socketAddr+" mode 660 level admin\n"), 0600))
+
+	// from the configuration file
+	endpoint, err := discoverStatsSocket([]string{filepath.Join(t.TempDir(), "missing.cfg"), configPath}, nil)
+	require.NoError(t, err)
+	require.Equal(t, socketAddr, endpoint)
+
+	// from the well-known socket paths
+	endpoint, err = discoverStatsSocket(nil, []string{filepath.Join(t.TempDir(), "missing.sock"), configPath, socketAddr})
+	require.NoError(t, err)
+	require.Equal(t, socketAddr, endpoint)
+
+	_, err = discoverStatsSocket([]string{configPath + ".missing"}, []string{configPath})
+	require.EqualError(t, err, "no 'endpoint' configured, and no HAProxy stats socket was found")
+}
+
+// serveStats serves the given stats file on a unix socket, returning its path.
+func serveStats(t *testing.T, statsFile string) string {
+	f, err := os.MkdirTemp("", "haproxytest")
+	require.NoError(t, err)
+	t.Cleanup(func() { _ = os.RemoveAll(f) })
+	socketAddr := filepath.Join(f, "testhaproxy.sock")
+	l, err := net.Listen("unix", socketAddr)
+	require.NoError(t, err)
+	t.Cleanup(func() { _ = l.Close() })
+
+	go func() {
+		for {
+			c, err2 := l.Accept()
+			if err2 != nil {
+				return
+			}
+			buf := make([]byte, 512)
+			nr, err2 := c.Read(buf)
+			if err2 == nil && string(buf[0:nr]) == "show stat\n" {
+				stats, err3 := os.ReadFile(statsFile)
+				if err3 == nil {
+					_, _ = c.Write(stats)
+				}
+			}
+			_ = c.Close()
+		}
+	}()
+	return socketAddr
+}
+
+// gaugeValues returns the values of a gauge metric, keyed by proxy and service name.
+func gaugeValues(m pmetric.Metrics, name string) map[string]int64 {
+	values := map[string]int64{}
+	for i := 0; i < m.ResourceMetrics().Len(); i++ {
+		rm := m.ResourceMetrics().At(i)
+		proxy, _ := rm.Resource().Attributes().Get("haproxy.proxy_name")
+		service, _ := rm.Resource().Attributes().Get("haproxy.service_name")
+		for j := 0; j < rm.ScopeMetrics().Len(); j++ {
+			metrics := rm.ScopeMetrics().At(j).Metrics()
+			for k := 0; k < metrics.Len(); k++ {
+				if metrics.At(k).Name() == name {
+					values[proxy.Str()+"/"+service.Str()] = metrics.At(k).Gauge().DataPoints().At(0).IntValue()
+				}
+			}
+		}
+	}
+	return values
+}
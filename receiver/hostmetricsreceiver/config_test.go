@@ -21,6 +21,7 @@ import (
 	"github.com/open-telemetry/opentelemetry-collector-contrib/receiver/hostmetricsreceiver/internal/scraper/cpuscraper"
 	"github.com/open-telemetry/opentelemetry-collector-contrib/receiver/hostmetricsreceiver/internal/scraper/diskscraper"
 	"github.com/open-telemetry/opentelemetry-collector-contrib/receiver/hostmetricsreceiver/internal/scraper/filesystemscraper"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/receiver/hostmetricsreceiver/internal/scraper/gpuscraper"
 	"github.com/open-telemetry/opentelemetry-collector-contrib/receiver/hostmetricsreceiver/internal/scraper/loadscraper"
 	"github.com/open-telemetry/opentelemetry-collector-contrib/receiver/hostmetricsreceiver/internal/scraper/memoryscraper"
 	"github.com/open-telemetry/opentelemetry-collector-contrib/receiver/hostmetricsreceiver/internal/scraper/networkscraper"
@@ -82,6 +83,13 @@ func TestLoadConfig(t *testing.T) {
 				cfg.SetEnvMap(common.EnvMap{})
 				return cfg
 			}(),
+			gpuscraper.TypeStr: (func() internal.Config {
+				cfg := (&gpuscraper.Factory{}).CreateDefaultConfig()
+				cfg.(*gpuscraper.Config).Vendors = []string{"nvidia"}
+				cfg.(*gpuscraper.Config).NvidiaSMIPath = "/usr/bin/nvidia-smi"
+				cfg.SetEnvMap(common.EnvMap{})
+				return cfg
+			})(),
 			memoryscraper.TypeStr: func() internal.Config {
 				cfg := (&memoryscraper.Factory{}).CreateDefaultConfig()
 				cfg.SetEnvMap(common.EnvMap{})
@@ -0,0 +1,158 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package gpuscraper // import "github.com/open-telemetry/opentelemetry-collector-contrib/receiver/hostmetricsreceiver/internal/scraper/gpuscraper"
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/receiver/hostmetricsreceiver/internal/scraper/gpuscraper/internal/metadata"
+)
+
+const (
+	amdCardPrefix    = "card"
+	amdProcessPrefix = "PID"
+
+	amdUtilizationKey    = "GPU use (%)"
+	amdMemoryTotalKey    = "VRAM Total Memory (B)"
+	amdMemoryUsedKey     = "VRAM Total Used Memory (B)"
+	amdEdgeTemperature   = "Temperature (Sensor edge) (C)"
+	amdTemperaturePrefix = "Temperature (Sensor"
+	amdPowerSuffix       = "Graphics Package Power (W)"
+)
+
+var (
+	amdDeviceArgs  = []string{"--showproductname", "--showuse", "--showtemp", "--showpower", "--showmeminfo", "vram", "--json"}
+	amdProcessArgs = []string{"--showpids", "--json"}
+	amdModelKeys   = []string{"Card series", "Card Series", "Card model", "Card Model"}
+)
+
+// rocmSMI reads the statistics of the AMD GPUs with rocm-smi.
+type rocmSMI struct {
+	path string
+	run  commandRunner
+}
+
+func (r *rocmSMI) attribute() metadata.AttributeGpuVendor {
+	return metadata.AttributeGpuVendorAmd
+}
+
+func (r *rocmSMI) devices(ctx context.Context) ([]gpuDevice, error) {
+	cards, err := r.query(ctx, amdDeviceArgs)
+	if err != nil {
+		return nil, err
+	}
+	var devices []gpuDevice
+	for key, card := range cards {
+		if !strings.HasPrefix(key, amdCardPrefix) {
+			continue
+		}
+		index, err := strconv.ParseInt(strings.TrimPrefix(key, amdCardPrefix), 10, 64)
+		if err != nil {
+			return nil, fmt.Errorf("invalid GPU %q: %w", key, err)
+		}
+		d := gpuDevice{
+			index:       index,
+			utilization: parseOptionalFloat(card[amdUtilizationKey]),
+			temperature: amdTemperature(card),
+		}
+		for _, modelKey := range amdModelKeys {
+			if model, ok := card[modelKey]; ok {
+				d.model = model
+				break
+			}
+		}
+		if d.utilization != nil {
+			*d.utilization /= 100
+		}
+		for k, v := range card {
+			if strings.HasSuffix(k, amdPowerSuffix) {
+				d.power = parseOptionalFloat(v)
+				break
+			}
+		}
+		total := parseOptionalFloat(card[amdMemoryTotalKey])
+		used := parseOptionalFloat(card[amdMemoryUsedKey])
+		if used != nil {
+			memoryUsed := int64(*used)
+			d.memoryUsed = &memoryUsed
+			if total != nil {
+				memoryFree := int64(*total) - memoryUsed
+				d.memoryFree = &memoryFree
+			}
+		}
+		devices = append(devices, d)
+	}
+	sort.Slice(devices, func(i, j int) bool { return devices[i].index < devices[j].index })
+	return devices, nil
+}
+
+// amdTemperature returns the edge temperature, or the temperature of the first sensor reported.
+func amdTemperature(card map[string]string) *float64 {
+	if t := parseOptionalFloat(card[amdEdgeTemperature]); t != nil {
+		return t
+	}
+	var keys []string
+	for k := range card {
+		if strings.HasPrefix(k, amdTemperaturePrefix) {
+			keys = append(keys, k)
+		}
+	}
+	sort.Strings(keys)
+	for _, k := range keys {
+		if t := parseOptionalFloat(card[k]); t != nil {
+			return t
+		}
+	}
+	return nil
+}
+
+func (r *rocmSMI) processes(ctx context.Context) ([]gpuProcess, error) {
+	sections, err := r.query(ctx, amdProcessArgs)
+	if err != nil {
+		return nil, err
+	}
+	var processes []gpuProcess
+	// Each process is reported as "PID<pid>": "<name>, <GPU count>, <VRAM bytes>, <SDMA usage>, <CU occupancy>".
+	for key, value := range sections["system"] {
+		if !strings.HasPrefix(key, amdProcessPrefix) {
+			continue
+		}
+		pid, err := strconv.ParseInt(strings.TrimSpace(strings.TrimPrefix(key, amdProcessPrefix)), 10, 64)
+		if err != nil {
+			return nil, fmt.Errorf("invalid process id %q: %w", key, err)
+		}
+		fields := strings.Split(value, ",")
+		if len(fields) < 3 {
+			return nil, fmt.Errorf("invalid process %q: %q", key, value)
+		}
+		memory := parseOptionalFloat(fields[2])
+		if memory == nil {
+			continue
+		}
+		processes = append(processes, gpuProcess{
+			pid:         pid,
+			name:        strings.TrimSpace(fields[0]),
+			memoryUsage: int64(*memory),
+		})
+	}
+	sort.Slice(processes, func(i, j int) bool { return processes[i].pid < processes[j].pid })
+	return processes, nil
+}
+
+func (r *rocmSMI) query(ctx context.Context, args []string) (map[string]map[string]string, error) {
+	out, err := r.run(ctx, r.path, args...)
+	if err != nil {
+		return nil, err
+	}
+	var sections map[string]map[string]string
+	if err := json.Unmarshal(out, &sections); err != nil {
+		return nil, fmt.Errorf("failed to parse the rocm-smi output: %w", err)
+	}
+	return sections, nil
+}
@@ -0,0 +1,22 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package gpuscraper // import "github.com/open-telemetry/opentelemetry-collector-contrib/receiver/hostmetricsreceiver/internal/scraper/gpuscraper"
+
+import (
+	"github.com/open-telemetry/opentelemetry-collector-contrib/receiver/hostmetricsreceiver/internal"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/receiver/hostmetricsreceiver/internal/scraper/gpuscraper/internal/metadata"
+)
+
+// Config relating to GPU Metric Scraper.
+type Config struct {
+	// Vendors are the GPU vendors to scrape, "nvidia" and "amd". The vendors whose tool is not found are skipped.
+	Vendors []string `mapstructure:"vendors"`
+	// NvidiaSMIPath is the path of the nvidia-smi tool, looked up in the PATH if empty.
+	NvidiaSMIPath string `mapstructure:"nvidia_smi_path"`
+	// ROCmSMIPath is the path of the rocm-smi tool, looked up in the PATH if empty.
+	ROCmSMIPath string `mapstructure:"rocm_smi_path"`
+	// MetricsBuilderConfig allows to customize scraped metrics/attributes representation.
+	metadata.MetricsBuilderConfig `mapstructure:",squash"`
+	internal.ScraperConfig
+}
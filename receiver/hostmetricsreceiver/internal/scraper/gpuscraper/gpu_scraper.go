@@ -0,0 +1,199 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package gpuscraper // import "github.com/open-telemetry/opentelemetry-collector-contrib/receiver/hostmetricsreceiver/internal/scraper/gpuscraper"
+
+import (
+	"context"
+	"fmt"
+	"os/exec"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/shirou/gopsutil/v3/common"
+	"github.com/shirou/gopsutil/v3/host"
+	"go.opentelemetry.io/collector/component"
+	"go.opentelemetry.io/collector/pdata/pcommon"
+	"go.opentelemetry.io/collector/pdata/pmetric"
+	"go.opentelemetry.io/collector/receiver"
+	"go.opentelemetry.io/collector/receiver/scrapererror"
+	"go.uber.org/zap"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/receiver/hostmetricsreceiver/internal/scraper/gpuscraper/internal/metadata"
+)
+
+const (
+	deviceMetricsLen  = 4
+	processMetricsLen = 1
+
+	vendorNvidia = "nvidia"
+	vendorAMD    = "amd"
+)
+
+// gpuDevice holds the statistics of a GPU, the statistics not reported by the vendor tool are nil.
+type gpuDevice struct {
+	index       int64
+	model       string
+	utilization *float64
+	memoryUsed  *int64
+	memoryFree  *int64
+	temperature *float64
+	power       *float64
+}
+
+// gpuProcess holds the GPU memory used by a process.
+type gpuProcess struct {
+	pid         int64
+	name        string
+	memoryUsage int64
+}
+
+// commandRunner runs a command and returns its standard output.
+type commandRunner func(ctx context.Context, path string, args ...string) ([]byte, error)
+
+// vendor reads the statistics of the GPUs of a vendor.
+type vendor interface {
+	attribute() metadata.AttributeGpuVendor
+	devices(ctx context.Context) ([]gpuDevice, error)
+	processes(ctx context.Context) ([]gpuProcess, error)
+}
+
+// scraper for GPU Metrics
+type scraper struct {
+	settings receiver.CreateSettings
+	config   *Config
+	mb       *metadata.MetricsBuilder
+	vendors  []vendor
+
+	// for mocking
+	bootTime func(context.Context) (uint64, error)
+	lookPath func(file string) (string, error)
+	run      commandRunner
+}
+
+// newGPUScraper creates a set of GPU related metrics
+func newGPUScraper(_ context.Context, settings receiver.CreateSettings, cfg *Config) (*scraper, error) {
+	for _, v := range cfg.Vendors {
+		if v != vendorNvidia && v != vendorAMD {
+			return nil, fmt.Errorf("unsupported GPU vendor %q, must be %q or %q", v, vendorNvidia, vendorAMD)
+		}
+	}
+	return &scraper{
+		settings: settings,
+		config:   cfg,
+		bootTime: host.BootTimeWithContext,
+		lookPath: exec.LookPath,
+		run:      runCommand,
+	}, nil
+}
+
+func runCommand(ctx context.Context, path string, args ...string) ([]byte, error) {
+	return exec.CommandContext(ctx, path, args...).Output()
+}
+
+func (s *scraper) start(ctx context.Context, _ component.Host) error {
+	ctx = context.WithValue(ctx, common.EnvKey, s.config.EnvMap)
+	bootTime, err := s.bootTime(ctx)
+	if err != nil {
+		return err
+	}
+	s.mb = metadata.NewMetricsBuilder(s.config.MetricsBuilderConfig, s.settings, metadata.WithStartTime(pcommon.Timestamp(bootTime*1e9)))
+
+	s.vendors = nil
+	for _, v := range s.config.Vendors {
+		tool, configured := s.tool(v)
+		path, err := s.lookPath(tool)
+		switch {
+		case err != nil && configured:
+			return fmt.Errorf("failed to find the %s GPU tool: %w", v, err)
+		case err != nil:
+			s.settings.Logger.Info("GPU tool not found, skipping the vendor", zap.String("vendor", v), zap.String("tool", tool))
+			continue
+		}
+		switch v {
+		case vendorNvidia:
+			s.vendors = append(s.vendors, &nvidiaSMI{path: path, run: s.run})
+		case vendorAMD:
+			s.vendors = append(s.vendors, &rocmSMI{path: path, run: s.run})
+		}
+	}
+	if len(s.vendors) == 0 {
+		s.settings.Logger.Warn("No GPU tool found, GPU metrics will not be scraped")
+	}
+	return nil
+}
+
+// tool returns the tool of the vendor, and whether its path was configured.
+func (s *scraper) tool(v string) (string, bool) {
+	switch v {
+	case vendorNvidia:
+		if s.config.NvidiaSMIPath != "" {
+			return s.config.NvidiaSMIPath, true
+		}
+		return "nvidia-smi", false
+	default:
+		if s.config.ROCmSMIPath != "" {
+			return s.config.ROCmSMIPath, true
+		}
+		return "rocm-smi", false
+	}
+}
+
+func (s *scraper) scrape(ctx context.Context) (pmetric.Metrics, error) {
+	var errors scrapererror.ScrapeErrors
+
+	for _, v := range s.vendors {
+		now := pcommon.NewTimestampFromTime(time.Now())
+		devices, err := v.devices(ctx)
+		if err != nil {
+			errors.AddPartial(deviceMetricsLen, fmt.Errorf("failed to read %s GPU stats: %w", v.attribute(), err))
+		} else {
+			s.recordDeviceMetrics(now, v.attribute(), devices)
+		}
+
+		if !s.config.Metrics.SystemGpuProcessMemoryUsage.Enabled {
+			continue
+		}
+		processes, err := v.processes(ctx)
+		if err != nil {
+			errors.AddPartial(processMetricsLen, fmt.Errorf("failed to read %s GPU processes: %w", v.attribute(), err))
+			continue
+		}
+		for _, p := range processes {
+			s.mb.RecordSystemGpuProcessMemoryUsageDataPoint(now, p.memoryUsage, v.attribute(), p.pid, p.name)
+		}
+	}
+
+	return s.mb.Emit(), errors.Combine()
+}
+
+func (s *scraper) recordDeviceMetrics(now pcommon.Timestamp, vendor metadata.AttributeGpuVendor, devices []gpuDevice) {
+	for _, d := range devices {
+		if d.utilization != nil {
+			s.mb.RecordSystemGpuUtilizationDataPoint(now, *d.utilization, vendor, d.index, d.model)
+		}
+		if d.memoryUsed != nil {
+			s.mb.RecordSystemGpuMemoryUsageDataPoint(now, *d.memoryUsed, vendor, d.index, d.model, metadata.AttributeStateUsed)
+		}
+		if d.memoryFree != nil {
+			s.mb.RecordSystemGpuMemoryUsageDataPoint(now, *d.memoryFree, vendor, d.index, d.model, metadata.AttributeStateFree)
+		}
+		if d.temperature != nil {
+			s.mb.RecordSystemGpuTemperatureDataPoint(now, *d.temperature, vendor, d.index, d.model)
+		}
+		if d.power != nil {
+			s.mb.RecordSystemGpuPowerUsageDataPoint(now, *d.power, vendor, d.index, d.model)
+		}
+	}
+}
+
+// parseOptionalFloat parses a value reported by a vendor tool, returning nil for unavailable values
+// such as "N/A" or "[Not Supported]".
+func parseOptionalFloat(value string) *float64 {
+	f, err := strconv.ParseFloat(strings.TrimSpace(value), 64)
+	if err != nil {
+		return nil
+	}
+	return &f
+}
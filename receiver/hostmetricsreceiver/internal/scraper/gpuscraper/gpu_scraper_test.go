@@ -0,0 +1,191 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package gpuscraper
+
+import (
+	"context"
+	"errors"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/collector/component/componenttest"
+	"go.opentelemetry.io/collector/pdata/pcommon"
+	"go.opentelemetry.io/collector/pdata/pmetric"
+	"go.opentelemetry.io/collector/receiver/receivertest"
+	"go.opentelemetry.io/collector/receiver/scrapererror"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/receiver/hostmetricsreceiver/internal/scraper/gpuscraper/internal/metadata"
+)
+
+// testOutputs maps the first argument of the vendor tools to the file holding their output.
+var testOutputs = map[string]string{
+	nvidiaDeviceArgs[0]:  "nvidia_devices.csv",
+	nvidiaProcessArgs[0]: "nvidia_processes.csv",
+	amdDeviceArgs[0]:     "rocm_devices.json",
+	amdProcessArgs[0]:    "rocm_processes.json",
+}
+
+func newTestScraper(t *testing.T, cfg *Config) *scraper {
+	s, err := newGPUScraper(context.Background(), receivertest.NewNopCreateSettings(), cfg)
+	require.NoError(t, err)
+	s.bootTime = func(context.Context) (uint64, error) { return 100, nil }
+	s.lookPath = func(file string) (string, error) { return filepath.Join("/usr/bin", file), nil }
+	s.run = func(_ context.Context, _ string, args ...string) ([]byte, error) {
+		return os.ReadFile(filepath.Join("testdata", testOutputs[args[0]]))
+	}
+	return s
+}
+
+func TestScrape(t *testing.T) {
+	s := newTestScraper(t, (&Factory{}).CreateDefaultConfig().(*Config))
+	require.NoError(t, s.start(context.Background(), componenttest.NewNopHost()))
+
+	md, err := s.scrape(context.Background())
+	require.NoError(t, err)
+
+	metrics := metricsByName(md)
+	require.Len(t, metrics, 5)
+
+	utilization := metrics["system.gpu.utilization"].Gauge().DataPoints()
+	require.Equal(t, 4, utilization.Len())
+	assertDataPoint(t, utilization.At(0), 0.35, "nvidia", 0, "NVIDIA A100-SXM4-40GB")
+	assertDataPoint(t, utilization.At(1), 1.0, "nvidia", 1, "NVIDIA A100-SXM4-40GB")
+	assertDataPoint(t, utilization.At(2), 0.12, "amd", 0, "Instinct MI210")
+	assertDataPoint(t, utilization.At(3), 0.0, "amd", 1, "Instinct MI210")
+
+	memory := metrics["system.gpu.memory.usage"].Sum().DataPoints()
+	require.Equal(t, 8, memory.Len())
+	assert.Equal(t, int64(1024*mebibyte), memory.At(0).IntValue())
+	assertAttribute(t, memory.At(0), "state", "used")
+	assert.Equal(t, int64(39512*mebibyte), memory.At(1).IntValue())
+	assertAttribute(t, memory.At(1), "state", "free")
+	assert.Equal(t, int64(11005853696), memory.At(4).IntValue())
+	assert.Equal(t, int64(68702699520-11005853696), memory.At(5).IntValue())
+
+	temperature := metrics["system.gpu.temperature"].Gauge().DataPoints()
+	require.Equal(t, 4, temperature.Len())
+	assertDataPoint(t, temperature.At(0), 41, "nvidia", 0, "NVIDIA A100-SXM4-40GB")
+	assertDataPoint(t, temperature.At(2), 38, "amd", 0, "Instinct MI210")
+	// Without an edge sensor, the first sensor reported is used.
+	assertDataPoint(t, temperature.At(3), 40, "amd", 1, "Instinct MI210")
+
+	// The power draw unavailable for the second GPU of each vendor is not reported.
+	power := metrics["system.gpu.power.usage"].Gauge().DataPoints()
+	require.Equal(t, 2, power.Len())
+	assertDataPoint(t, power.At(0), 60.52, "nvidia", 0, "NVIDIA A100-SXM4-40GB")
+	assertDataPoint(t, power.At(1), 91, "amd", 0, "Instinct MI210")
+
+	processes := metrics["system.gpu.process.memory.usage"].Sum().DataPoints()
+	require.Equal(t, 4, processes.Len())
+	// The memory of the process using two NVIDIA GPUs is summed.
+	assertProcess(t, processes.At(0), 768*mebibyte, "nvidia", 1234, "python3")
+	assertProcess(t, processes.At(1), 19968*mebibyte, "nvidia", 5678, "tritonserver")
+	assertProcess(t, processes.At(2), 1073741824, "amd", 2345, "python3")
+	assertProcess(t, processes.At(3), 2147483648, "amd", 3456, "rccl-tests")
+}
+
+func TestScrape_ProcessMetricDisabled(t *testing.T) {
+	cfg := (&Factory{}).CreateDefaultConfig().(*Config)
+	cfg.Metrics.SystemGpuProcessMemoryUsage.Enabled = false
+	s := newTestScraper(t, cfg)
+	run := s.run
+	s.run = func(ctx context.Context, path string, args ...string) ([]byte, error) {
+		assert.NotEqual(t, nvidiaProcessArgs[0], args[0])
+		assert.NotEqual(t, amdProcessArgs[0], args[0])
+		return run(ctx, path, args...)
+	}
+	require.NoError(t, s.start(context.Background(), componenttest.NewNopHost()))
+
+	md, err := s.scrape(context.Background())
+	require.NoError(t, err)
+	assert.NotContains(t, metricsByName(md), "system.gpu.process.memory.usage")
+}
+
+func TestScrape_ToolNotFound(t *testing.T) {
+	s := newTestScraper(t, (&Factory{}).CreateDefaultConfig().(*Config))
+	s.lookPath = func(file string) (string, error) {
+		if file == "rocm-smi" {
+			return "", errors.New("not found")
+		}
+		return filepath.Join("/usr/bin", file), nil
+	}
+	require.NoError(t, s.start(context.Background(), componenttest.NewNopHost()))
+
+	md, err := s.scrape(context.Background())
+	require.NoError(t, err)
+	dps := metricsByName(md)["system.gpu.utilization"].Gauge().DataPoints()
+	require.Equal(t, 2, dps.Len())
+	for i := 0; i < dps.Len(); i++ {
+		assertAttribute(t, dps.At(i), "gpu.vendor", "nvidia")
+	}
+}
+
+func TestStart_ConfiguredToolNotFound(t *testing.T) {
+	cfg := (&Factory{}).CreateDefaultConfig().(*Config)
+	cfg.NvidiaSMIPath = "/opt/nvidia/nvidia-smi"
+	s := newTestScraper(t, cfg)
+	s.lookPath = func(string) (string, error) { return "", errors.New("not found") }
+
+	err := s.start(context.Background(), componenttest.NewNopHost())
+	assert.EqualError(t, err, "failed to find the nvidia GPU tool: not found")
+}
+
+func TestScrape_Errors(t *testing.T) {
+	s := newTestScraper(t, &Config{
+		Vendors:              []string{vendorNvidia},
+		MetricsBuilderConfig: metadata.DefaultMetricsBuilderConfig(),
+	})
+	s.run = func(context.Context, string, ...string) ([]byte, error) {
+		return []byte("NVIDIA-SMI has failed because it couldn't communicate with the NVIDIA driver"), nil
+	}
+	require.NoError(t, s.start(context.Background(), componenttest.NewNopHost()))
+
+	md, err := s.scrape(context.Background())
+	assert.Equal(t, 0, md.MetricCount())
+	require.Error(t, err)
+	assert.True(t, scrapererror.IsPartialScrapeError(err))
+	var partialErr scrapererror.PartialScrapeError
+	require.ErrorAs(t, err, &partialErr)
+	assert.Equal(t, deviceMetricsLen+processMetricsLen, partialErr.Failed)
+}
+
+func metricsByName(md pmetric.Metrics) map[string]pmetric.Metric {
+	metrics := map[string]pmetric.Metric{}
+	if md.ResourceMetrics().Len() == 0 {
+		return metrics
+	}
+	ms := md.ResourceMetrics().At(0).ScopeMetrics().At(0).Metrics()
+	for i := 0; i < ms.Len(); i++ {
+		metrics[ms.At(i).Name()] = ms.At(i)
+	}
+	return metrics
+}
+
+func assertDataPoint(t *testing.T, dp pmetric.NumberDataPoint, value float64, vendor string, index int64, model string) {
+	assert.InDelta(t, value, dp.DoubleValue(), 1e-9)
+	assertAttribute(t, dp, "gpu.vendor", vendor)
+	gpuIndex, ok := dp.Attributes().Get("gpu.index")
+	require.True(t, ok)
+	assert.Equal(t, index, gpuIndex.Int())
+	assertAttribute(t, dp, "gpu.model", model)
+}
+
+func assertProcess(t *testing.T, dp pmetric.NumberDataPoint, value int64, vendor string, pid int64, name string) {
+	assert.Equal(t, value, dp.IntValue())
+	assertAttribute(t, dp, "gpu.vendor", vendor)
+	processPid, ok := dp.Attributes().Get("process.pid")
+	require.True(t, ok)
+	assert.Equal(t, pid, processPid.Int())
+	assertAttribute(t, dp, "process.executable.name", name)
+}
+
+func assertAttribute(t *testing.T, dp pmetric.NumberDataPoint, key, value string) {
+	attr, ok := dp.Attributes().Get(key)
+	require.True(t, ok, key)
+	assert.Equal(t, pcommon.ValueTypeStr, attr.Type())
+	assert.Equal(t, value, attr.Str())
+}
@@ -0,0 +1,121 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package gpuscraper // import "github.com/open-telemetry/opentelemetry-collector-contrib/receiver/hostmetricsreceiver/internal/scraper/gpuscraper"
+
+import (
+	"bytes"
+	"context"
+	"encoding/csv"
+	"fmt"
+	"path/filepath"
+	"strconv"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/receiver/hostmetricsreceiver/internal/scraper/gpuscraper/internal/metadata"
+)
+
+const mebibyte = 1 << 20
+
+var (
+	nvidiaDeviceArgs = []string{
+		"--query-gpu=index,name,utilization.gpu,memory.used,memory.free,temperature.gpu,power.draw",
+		"--format=csv,noheader,nounits",
+	}
+	nvidiaProcessArgs = []string{
+		"--query-compute-apps=pid,process_name,used_memory",
+		"--format=csv,noheader,nounits",
+	}
+)
+
+// nvidiaSMI reads the statistics of the NVIDIA GPUs with nvidia-smi, which queries NVML.
+type nvidiaSMI struct {
+	path string
+	run  commandRunner
+}
+
+func (n *nvidiaSMI) attribute() metadata.AttributeGpuVendor {
+	return metadata.AttributeGpuVendorNvidia
+}
+
+func (n *nvidiaSMI) devices(ctx context.Context) ([]gpuDevice, error) {
+	records, err := n.query(ctx, nvidiaDeviceArgs, 7)
+	if err != nil {
+		return nil, err
+	}
+	devices := make([]gpuDevice, 0, len(records))
+	for _, record := range records {
+		index, err := strconv.ParseInt(record[0], 10, 64)
+		if err != nil {
+			return nil, fmt.Errorf("invalid GPU index %q: %w", record[0], err)
+		}
+		d := gpuDevice{
+			index:       index,
+			model:       record[1],
+			utilization: parseOptionalFloat(record[2]),
+			memoryUsed:  parseNvidiaMebibytes(record[3]),
+			memoryFree:  parseNvidiaMebibytes(record[4]),
+			temperature: parseOptionalFloat(record[5]),
+			power:       parseOptionalFloat(record[6]),
+		}
+		if d.utilization != nil {
+			*d.utilization /= 100
+		}
+		devices = append(devices, d)
+	}
+	return devices, nil
+}
+
+func (n *nvidiaSMI) processes(ctx context.Context) ([]gpuProcess, error) {
+	records, err := n.query(ctx, nvidiaProcessArgs, 3)
+	if err != nil {
+		return nil, err
+	}
+	// A process using several GPUs is listed once per GPU.
+	var processes []gpuProcess
+	byPID := map[int64]int{}
+	for _, record := range records {
+		pid, err := strconv.ParseInt(record[0], 10, 64)
+		if err != nil {
+			return nil, fmt.Errorf("invalid process id %q: %w", record[0], err)
+		}
+		memory := parseNvidiaMebibytes(record[2])
+		if memory == nil {
+			continue
+		}
+		if i, ok := byPID[pid]; ok {
+			processes[i].memoryUsage += *memory
+			continue
+		}
+		byPID[pid] = len(processes)
+		processes = append(processes, gpuProcess{
+			pid:         pid,
+			name:        filepath.Base(record[1]),
+			memoryUsage: *memory,
+		})
+	}
+	return processes, nil
+}
+
+func (n *nvidiaSMI) query(ctx context.Context, args []string, fields int) ([][]string, error) {
+	out, err := n.run(ctx, n.path, args...)
+	if err != nil {
+		return nil, err
+	}
+	reader := csv.NewReader(bytes.NewReader(out))
+	reader.TrimLeadingSpace = true
+	reader.FieldsPerRecord = fields
+	records, err := reader.ReadAll()
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse the nvidia-smi output: %w", err)
+	}
+	return records, nil
+}
+
+func parseNvidiaMebibytes(value string) *int64 {
+	f := parseOptionalFloat(value)
+	if f == nil {
+		return nil
+	}
+	b := int64(*f * mebibyte)
+	return &b
+}
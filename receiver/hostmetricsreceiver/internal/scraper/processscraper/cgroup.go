@@ -0,0 +1,274 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package processscraper // import "github.com/open-telemetry/opentelemetry-collector-contrib/receiver/hostmetricsreceiver/internal/scraper/processscraper"
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/internal/filter/filterset"
+)
+
+const (
+	containerRuntimeDocker     = "docker"
+	containerRuntimeContainerd = "containerd"
+	containerRuntimeCRIO       = "crio"
+	containerRuntimePodman     = "podman"
+)
+
+// containerRuntimePatterns recognize the cgroup directories created for containers
+// by the supported runtimes, both with the systemd and the cgroupfs cgroup drivers.
+var containerRuntimePatterns = []struct {
+	runtime string
+	pattern *regexp.Regexp
+}{
+	{containerRuntimeDocker, regexp.MustCompile(`(^|/)docker[-/][0-9a-f]{12,}`)},
+	{containerRuntimeContainerd, regexp.MustCompile(`(^|/)cri-containerd-[0-9a-f]{12,}`)},
+	{containerRuntimeCRIO, regexp.MustCompile(`(^|/)crio-[0-9a-f]{12,}`)},
+	{containerRuntimePodman, regexp.MustCompile(`(^|/)libpod-[0-9a-f]{12,}`)},
+}
+
+// cgroupEntry is a single line of /proc/[pid]/cgroup.
+type cgroupEntry struct {
+	hierarchyID string
+	controllers []string
+	path        string
+}
+
+// unified reports whether the entry belongs to the cgroup v2 hierarchy.
+func (e cgroupEntry) unified() bool {
+	return e.hierarchyID == "0" && len(e.controllers) == 0
+}
+
+func (e cgroupEntry) hasController(controller string) bool {
+	for _, c := range e.controllers {
+		if c == controller {
+			return true
+		}
+	}
+	return false
+}
+
+// parseCgroupEntries parses the content of /proc/[pid]/cgroup, skipping malformed lines.
+func parseCgroupEntries(content string) []cgroupEntry {
+	var entries []cgroupEntry
+	for _, line := range strings.Split(content, "\n") {
+		fields := strings.SplitN(strings.TrimSpace(line), ":", 3)
+		if len(fields) != 3 {
+			continue
+		}
+		var controllers []string
+		if fields[1] != "" {
+			controllers = strings.Split(fields[1], ",")
+		}
+		entries = append(entries, cgroupEntry{hierarchyID: fields[0], controllers: controllers, path: fields[2]})
+	}
+	return entries
+}
+
+// containerRuntime returns the container runtime that created the cgroups of a process,
+// or an empty string if the process doesn't run in a recognized container.
+func containerRuntime(entries []cgroupEntry) string {
+	for _, entry := range entries {
+		for _, p := range containerRuntimePatterns {
+			if p.pattern.MatchString(entry.path) {
+				return p.runtime
+			}
+		}
+	}
+	return ""
+}
+
+// cgroupFilter matches processes on their cgroup paths and container runtime.
+type cgroupFilter struct {
+	paths    filterset.FilterSet
+	runtimes map[string]struct{}
+}
+
+// newCgroupFilter creates a cgroupFilter from the cgroup criteria of the MatchConfig.
+// It returns nil if the MatchConfig doesn't set any cgroup criteria.
+func newCgroupFilter(cfg *MatchConfig) (*cgroupFilter, error) {
+	if len(cfg.Cgroups) == 0 && len(cfg.ContainerRuntimes) == 0 {
+		return nil, nil
+	}
+
+	f := &cgroupFilter{}
+	if len(cfg.Cgroups) > 0 {
+		var err error
+		if f.paths, err = filterset.CreateFilterSet(cfg.Cgroups, &cfg.Config); err != nil {
+			return nil, err
+		}
+	}
+
+	if len(cfg.ContainerRuntimes) > 0 {
+		f.runtimes = make(map[string]struct{}, len(cfg.ContainerRuntimes))
+		for _, runtime := range cfg.ContainerRuntimes {
+			switch runtime {
+			case containerRuntimeDocker, containerRuntimeContainerd, containerRuntimeCRIO, containerRuntimePodman:
+				f.runtimes[runtime] = struct{}{}
+			default:
+				return nil, fmt.Errorf("unsupported container runtime %q", runtime)
+			}
+		}
+	}
+	return f, nil
+}
+
+// matches reports whether a process with the given cgroup entries matches all
+// criteria of the filter. A cgroup path criterion matches if any of the paths does.
+func (f *cgroupFilter) matches(entries []cgroupEntry) bool {
+	if f.paths != nil {
+		matched := false
+		for _, entry := range entries {
+			if f.paths.Matches(entry.path) {
+				matched = true
+				break
+			}
+		}
+		if !matched {
+			return false
+		}
+	}
+
+	if f.runtimes != nil {
+		if _, ok := f.runtimes[containerRuntime(entries)]; !ok {
+			return false
+		}
+	}
+	return true
+}
+
+// cgroupLimits holds the resource limits of a cgroup. Unset limits are nil.
+type cgroupLimits struct {
+	cpu    *float64
+	memory *int64
+}
+
+// memoryUnlimitedThreshold is the value above which cgroup v1 memory limits are
+// considered unlimited; the kernel reports "no limit" as the page-aligned max int64.
+const memoryUnlimitedThreshold = math.MaxInt64 / 2
+
+// readCgroupLimits reads the CPU quota and memory limit of the cgroups in entries
+// from the cgroup filesystem mounted at root. cgroup v1 controllers take precedence
+// over the unified hierarchy for hosts running in hybrid mode.
+func readCgroupLimits(root string, entries []cgroupEntry) (cgroupLimits, error) {
+	var limits cgroupLimits
+	var unified *cgroupEntry
+	var errs []error
+	cpuFound, memoryFound := false, false
+
+	for i, entry := range entries {
+		if entry.unified() {
+			unified = &entries[i]
+			continue
+		}
+		dir := filepath.Join(root, strings.Join(entry.controllers, ","), entry.path)
+		if entry.hasController("cpu") {
+			cpuFound = true
+			cpu, err := readCgroupV1CPULimit(dir)
+			errs = append(errs, err)
+			limits.cpu = cpu
+		}
+		if entry.hasController("memory") {
+			memoryFound = true
+			memory, err := readCgroupLimitFile(filepath.Join(dir, "memory.limit_in_bytes"))
+			errs = append(errs, err)
+			if memory != nil && *memory >= memoryUnlimitedThreshold {
+				memory = nil
+			}
+			limits.memory = memory
+		}
+	}
+
+	if unified != nil {
+		dir := filepath.Join(root, unified.path)
+		if !cpuFound {
+			cpu, err := readCgroupV2CPULimit(dir)
+			errs = append(errs, err)
+			limits.cpu = cpu
+		}
+		if !memoryFound {
+			memory, err := readCgroupLimitFile(filepath.Join(dir, "memory.max"))
+			errs = append(errs, err)
+			limits.memory = memory
+		}
+	}
+
+	return limits, errors.Join(errs...)
+}
+
+// readCgroupV2CPULimit reads cpu.max, formatted as "$MAX $PERIOD".
+func readCgroupV2CPULimit(dir string) (*float64, error) {
+	content, err := readCgroupFile(filepath.Join(dir, "cpu.max"))
+	if err != nil || content == "" {
+		return nil, err
+	}
+
+	fields := strings.Fields(content)
+	if len(fields) != 2 {
+		return nil, fmt.Errorf("unexpected cpu.max format %q", content)
+	}
+	if fields[0] == "max" {
+		return nil, nil
+	}
+	return cpuQuota(fields[0], fields[1])
+}
+
+// readCgroupV1CPULimit reads cpu.cfs_quota_us and cpu.cfs_period_us. A negative quota means no limit.
+func readCgroupV1CPULimit(dir string) (*float64, error) {
+	quota, err := readCgroupFile(filepath.Join(dir, "cpu.cfs_quota_us"))
+	if err != nil || quota == "" || strings.HasPrefix(quota, "-") {
+		return nil, err
+	}
+	period, err := readCgroupFile(filepath.Join(dir, "cpu.cfs_period_us"))
+	if err != nil || period == "" {
+		return nil, err
+	}
+	return cpuQuota(quota, period)
+}
+
+func cpuQuota(quota, period string) (*float64, error) {
+	q, err := strconv.ParseFloat(quota, 64)
+	if err != nil {
+		return nil, fmt.Errorf("invalid cpu quota %q: %w", quota, err)
+	}
+	p, err := strconv.ParseFloat(period, 64)
+	if err != nil || p <= 0 {
+		return nil, fmt.Errorf("invalid cpu period %q", period)
+	}
+	cpus := q / p
+	return &cpus, nil
+}
+
+// readCgroupLimitFile reads a file containing a byte count, or "max" when there is no limit.
+func readCgroupLimitFile(path string) (*int64, error) {
+	content, err := readCgroupFile(path)
+	if err != nil || content == "" || content == "max" {
+		return nil, err
+	}
+	v, err := strconv.ParseInt(content, 10, 64)
+	if err != nil {
+		return nil, fmt.Errorf("invalid value %q in %s: %w", content, path, err)
+	}
+	return &v, nil
+}
+
+// readCgroupFile returns the trimmed content of a cgroup file, or an empty string if
+// the file doesn't exist, which happens for the root cgroup or disabled controllers.
+func readCgroupFile(path string) (string, error) {
+	content, err := os.ReadFile(path)
+	if errors.Is(err, os.ErrNotExist) {
+		return "", nil
+	}
+	if err != nil {
+		return "", err
+	}
+	return strings.TrimSpace(string(content)), nil
+}
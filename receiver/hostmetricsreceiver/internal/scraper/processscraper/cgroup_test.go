@@ -0,0 +1,256 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package processscraper
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"runtime"
+	"testing"
+
+	"github.com/shirou/gopsutil/v3/common"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/collector/component/componenttest"
+	"go.opentelemetry.io/collector/receiver/receivertest"
+	conventions "go.opentelemetry.io/collector/semconv/v1.6.1"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/internal/filter/filterset"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/receiver/hostmetricsreceiver/internal"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/receiver/hostmetricsreceiver/internal/scraper/processscraper/internal/metadata"
+)
+
+const (
+	dockerCgroupV2 = "0::/system.slice/docker-3f4e5c6d7a8b9c0d1e2f3a4b5c6d7e8f9a0b1c2d3e4f5a6b7c8d9e0f1a2b3c4d.scope"
+	crioCgroupV2   = "0::/kubepods.slice/kubepods-burstable.slice/crio-0123456789abcdef0123456789abcdef0123456789abcdef0123456789abcdef.scope"
+	hostCgroupV2   = "0::/user.slice/user-1000.slice/session-2.scope"
+	dockerCgroupV1 = `12:memory:/docker/3f4e5c6d7a8b9c0d1e2f3a4b5c6d7e8f9a0b1c2d3e4f5a6b7c8d9e0f1a2b3c4d
+4:cpu,cpuacct:/docker/3f4e5c6d7a8b9c0d1e2f3a4b5c6d7e8f9a0b1c2d3e4f5a6b7c8d9e0f1a2b3c4d
+1:name=systemd:/docker/3f4e5c6d7a8b9c0d1e2f3a4b5c6d7e8f9a0b1c2d3e4f5a6b7c8d9e0f1a2b3c4d
+0::/system.slice/containerd.service`
+)
+
+func TestParseCgroupEntries(t *testing.T) {
+	entries := parseCgroupEntries(dockerCgroupV1 + "\n\nmalformed\n")
+	require.Len(t, entries, 4)
+	assert.Equal(t, cgroupEntry{hierarchyID: "12", controllers: []string{"memory"}, path: "/docker/3f4e5c6d7a8b9c0d1e2f3a4b5c6d7e8f9a0b1c2d3e4f5a6b7c8d9e0f1a2b3c4d"}, entries[0])
+	assert.True(t, entries[1].hasController("cpu"))
+	assert.False(t, entries[2].unified())
+	assert.True(t, entries[3].unified())
+}
+
+func TestContainerRuntime(t *testing.T) {
+	tests := []struct {
+		cgroup   string
+		expected string
+	}{
+		{cgroup: dockerCgroupV2, expected: containerRuntimeDocker},
+		{cgroup: dockerCgroupV1, expected: containerRuntimeDocker},
+		{cgroup: crioCgroupV2, expected: containerRuntimeCRIO},
+		{cgroup: "0::/kubepods.slice/kubepods-pod1.slice/cri-containerd-0123456789abcdef0123.scope", expected: containerRuntimeContainerd},
+		{cgroup: "0::/machine.slice/libpod-0123456789abcdef0123.scope/container", expected: containerRuntimePodman},
+		{cgroup: hostCgroupV2, expected: ""},
+		{cgroup: "0::/system.slice/docker.service", expected: ""},
+	}
+	for _, tt := range tests {
+		assert.Equal(t, tt.expected, containerRuntime(parseCgroupEntries(tt.cgroup)), tt.cgroup)
+	}
+}
+
+func TestNewCgroupFilter(t *testing.T) {
+	f, err := newCgroupFilter(&MatchConfig{Names: []string{"test"}})
+	require.NoError(t, err)
+	assert.Nil(t, f)
+
+	_, err = newCgroupFilter(&MatchConfig{ContainerRuntimes: []string{"lxc"}})
+	assert.EqualError(t, err, `unsupported container runtime "lxc"`)
+
+	_, err = newCgroupFilter(&MatchConfig{Cgroups: []string{"test"}})
+	assert.Error(t, err)
+
+	f, err = newCgroupFilter(&MatchConfig{
+		Config:            filterset.Config{MatchType: filterset.Regexp},
+		Cgroups:           []string{"^/kubepods"},
+		ContainerRuntimes: []string{containerRuntimeCRIO},
+	})
+	require.NoError(t, err)
+	assert.True(t, f.matches(parseCgroupEntries(crioCgroupV2)))
+	assert.False(t, f.matches(parseCgroupEntries(dockerCgroupV2)))
+	assert.False(t, f.matches(parseCgroupEntries("0::/kubepods.slice/kubepods-pod1.slice/cri-containerd-0123456789abcdef0123.scope")))
+}
+
+func writeCgroupFiles(t *testing.T, root string, files map[string]string) {
+	for name, content := range files {
+		path := filepath.Join(root, name)
+		require.NoError(t, os.MkdirAll(filepath.Dir(path), 0700))
+		require.NoError(t, os.WriteFile(path, []byte(content+"\n"), 0600))
+	}
+}
+
+func TestReadCgroupLimits(t *testing.T) {
+	cpu := func(v float64) *float64 { return &v }
+	memory := func(v int64) *int64 { return &v }
+
+	tests := []struct {
+		name     string
+		cgroup   string
+		files    map[string]string
+		expected cgroupLimits
+		errMsg   string
+	}{
+		{
+			name:   "v2 limited",
+			cgroup: dockerCgroupV2,
+			files: map[string]string{
+				"system.slice/docker-3f4e5c6d7a8b9c0d1e2f3a4b5c6d7e8f9a0b1c2d3e4f5a6b7c8d9e0f1a2b3c4d.scope/cpu.max":    "150000 100000",
+				"system.slice/docker-3f4e5c6d7a8b9c0d1e2f3a4b5c6d7e8f9a0b1c2d3e4f5a6b7c8d9e0f1a2b3c4d.scope/memory.max": "536870912",
+			},
+			expected: cgroupLimits{cpu: cpu(1.5), memory: memory(536870912)},
+		},
+		{
+			name:   "v2 unlimited",
+			cgroup: hostCgroupV2,
+			files: map[string]string{
+				"user.slice/user-1000.slice/session-2.scope/cpu.max":    "max 100000",
+				"user.slice/user-1000.slice/session-2.scope/memory.max": "max",
+			},
+		},
+		{
+			name:   "v2 missing files",
+			cgroup: "0::/",
+		},
+		{
+			name:   "v1 limited",
+			cgroup: dockerCgroupV1,
+			files: map[string]string{
+				"cpu,cpuacct/docker/3f4e5c6d7a8b9c0d1e2f3a4b5c6d7e8f9a0b1c2d3e4f5a6b7c8d9e0f1a2b3c4d/cpu.cfs_quota_us":  "50000",
+				"cpu,cpuacct/docker/3f4e5c6d7a8b9c0d1e2f3a4b5c6d7e8f9a0b1c2d3e4f5a6b7c8d9e0f1a2b3c4d/cpu.cfs_period_us": "100000",
+				"memory/docker/3f4e5c6d7a8b9c0d1e2f3a4b5c6d7e8f9a0b1c2d3e4f5a6b7c8d9e0f1a2b3c4d/memory.limit_in_bytes":  "268435456",
+				// the unified hierarchy must be ignored when the v1 controllers are present
+				"system.slice/containerd.service/memory.max": "1024",
+			},
+			expected: cgroupLimits{cpu: cpu(0.5), memory: memory(268435456)},
+		},
+		{
+			name:   "v1 unlimited",
+			cgroup: dockerCgroupV1,
+			files: map[string]string{
+				"cpu,cpuacct/docker/3f4e5c6d7a8b9c0d1e2f3a4b5c6d7e8f9a0b1c2d3e4f5a6b7c8d9e0f1a2b3c4d/cpu.cfs_quota_us":  "-1",
+				"cpu,cpuacct/docker/3f4e5c6d7a8b9c0d1e2f3a4b5c6d7e8f9a0b1c2d3e4f5a6b7c8d9e0f1a2b3c4d/cpu.cfs_period_us": "100000",
+				"memory/docker/3f4e5c6d7a8b9c0d1e2f3a4b5c6d7e8f9a0b1c2d3e4f5a6b7c8d9e0f1a2b3c4d/memory.limit_in_bytes":  "9223372036854771712",
+			},
+		},
+		{
+			name:   "invalid content",
+			cgroup: hostCgroupV2,
+			files: map[string]string{
+				"user.slice/user-1000.slice/session-2.scope/cpu.max":    "max",
+				"user.slice/user-1000.slice/session-2.scope/memory.max": "1024",
+			},
+			expected: cgroupLimits{memory: memory(1024)},
+			errMsg:   `unexpected cpu.max format "max"`,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			root := t.TempDir()
+			writeCgroupFiles(t, root, tt.files)
+
+			limits, err := readCgroupLimits(root, parseCgroupEntries(tt.cgroup))
+			if tt.errMsg != "" {
+				assert.ErrorContains(t, err, tt.errMsg)
+			} else {
+				assert.NoError(t, err)
+			}
+			assert.Equal(t, tt.expected, limits)
+		})
+	}
+}
+
+func TestScrapeMetrics_CgroupFilteredWithLimits(t *testing.T) {
+	if runtime.GOOS != "linux" {
+		t.Skipf("skipping test on %v", runtime.GOOS)
+	}
+
+	root := t.TempDir()
+	writeCgroupFiles(t, filepath.Join(root, "fs", "cgroup"), map[string]string{
+		"kubepods.slice/kubepods-burstable.slice/crio-0123456789abcdef0123456789abcdef0123456789abcdef0123456789abcdef.scope/cpu.max":    "200000 100000",
+		"kubepods.slice/kubepods-burstable.slice/crio-0123456789abcdef0123456789abcdef0123456789abcdef0123456789abcdef.scope/memory.max": "1073741824",
+	})
+
+	metricsBuilderConfig := metadata.DefaultMetricsBuilderConfig()
+	metricsBuilderConfig.Metrics.ProcessCgroupCPULimit.Enabled = true
+	metricsBuilderConfig.Metrics.ProcessCgroupMemoryLimit.Enabled = true
+	config := &Config{
+		MetricsBuilderConfig: metricsBuilderConfig,
+		ScraperConfig:        internal.ScraperConfig{EnvMap: common.EnvMap{common.HostSysEnvKey: root}},
+		Include:              MatchConfig{ContainerRuntimes: []string{containerRuntimeCRIO, containerRuntimeDocker}},
+		Exclude: MatchConfig{
+			Config:  filterset.Config{MatchType: filterset.Regexp},
+			Names:   []string{"pause"},
+			Cgroups: []string{"^/kubepods"},
+		},
+	}
+
+	scraper, err := newProcessScraper(receivertest.NewNopCreateSettings(), config)
+	require.NoError(t, err)
+	require.NoError(t, scraper.start(context.Background(), componenttest.NewNopHost()))
+
+	processes := []struct {
+		name   string
+		cgroup string
+	}{
+		{name: "app", cgroup: crioCgroupV2},
+		{name: "pause", cgroup: crioCgroupV2},
+		{name: "pause", cgroup: hostCgroupV2},
+		{name: "dockerd-app", cgroup: dockerCgroupV2},
+	}
+	handles := make([]*processHandleMock, 0, len(processes))
+	for _, p := range processes {
+		handleMock := &processHandleMock{}
+		handleMock.On("NameWithContext", mock.Anything).Return(p.name, nil)
+		handleMock.On("CgroupWithContext", mock.Anything).Return(p.cgroup, nil)
+		initDefaultsHandleMock(t, handleMock)
+		handles = append(handles, handleMock)
+	}
+	scraper.getProcessHandles = func(context.Context) (processHandles, error) {
+		return &processHandlesMock{handles: handles}, nil
+	}
+
+	md, err := scraper.scrape(context.Background())
+	require.NoError(t, err)
+
+	// the host "pause" process doesn't run in a container and the crio "pause" process
+	// matches both the name and the cgroup exclusions.
+	require.Equal(t, 2, md.ResourceMetrics().Len())
+	expectedNames := []string{"app", "dockerd-app"}
+	for i, expectedName := range expectedNames {
+		rm := md.ResourceMetrics().At(i)
+		name, _ := rm.Resource().Attributes().Get(conventions.AttributeProcessExecutableName)
+		assert.Equal(t, expectedName, name.Str())
+	}
+
+	metrics := md.ResourceMetrics().At(0).ScopeMetrics().At(0).Metrics()
+	found := map[string]bool{}
+	for i := 0; i < metrics.Len(); i++ {
+		m := metrics.At(i)
+		switch m.Name() {
+		case "process.cgroup.cpu.limit":
+			found[m.Name()] = true
+			assert.Equal(t, 2.0, m.Gauge().DataPoints().At(0).DoubleValue())
+		case "process.cgroup.memory.limit":
+			found[m.Name()] = true
+			assert.Equal(t, int64(1073741824), m.Sum().DataPoints().At(0).IntValue())
+		}
+	}
+	assert.Len(t, found, 2)
+
+	// the docker cgroup has no limit files, so no limit metrics are emitted
+	metrics = md.ResourceMetrics().At(1).ScopeMetrics().At(0).Metrics()
+	for i := 0; i < metrics.Len(); i++ {
+		assert.NotContains(t, []string{"process.cgroup.cpu.limit", "process.cgroup.memory.limit"}, metrics.At(i).Name())
+	}
+}
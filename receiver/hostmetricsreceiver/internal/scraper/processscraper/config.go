@@ -16,8 +16,8 @@ type Config struct {
 	// MetricsBuilderConfig allows to customize scraped metrics/attributes representation.
 	metadata.MetricsBuilderConfig `mapstructure:",squash"`
 	internal.ScraperConfig
-	// Include specifies a filter on the process names that should be included from the generated metrics.
-	// Exclude specifies a filter on the process names that should be excluded from the generated metrics.
+	// Include specifies a filter on the processes that should be included from the generated metrics.
+	// Exclude specifies a filter on the processes that should be excluded from the generated metrics.
 	// If neither `include` or `exclude` are set, process metrics will be generated for all processes.
 	Include MatchConfig `mapstructure:"include"`
 	Exclude MatchConfig `mapstructure:"exclude"`
@@ -48,8 +48,17 @@ type Config struct {
 	ScrapeProcessDelay time.Duration `mapstructure:"scrape_process_delay"`
 }
 
+// MatchConfig matches processes on their name, cgroup paths and container runtime.
+// A process matches when it satisfies all the criteria that are set.
 type MatchConfig struct {
 	filterset.Config `mapstructure:",squash"`
 
 	Names []string `mapstructure:"names"`
+
+	// Cgroups matches the cgroup paths of the process, as listed in /proc/[pid]/cgroup (Linux only).
+	Cgroups []string `mapstructure:"cgroups"`
+
+	// ContainerRuntimes matches the runtime of the container the process runs in, derived
+	// from its cgroup paths (Linux only). Supported values are docker, containerd, crio and podman.
+	ContainerRuntimes []string `mapstructure:"container_runtimes"`
 }
@@ -28,6 +28,8 @@ func (ms *MetricConfig) Unmarshal(parser *confmap.Conf) error {
 
 // MetricsConfig provides config for hostmetricsreceiver/process metrics.
 type MetricsConfig struct {
+	ProcessCgroupCPULimit      MetricConfig `mapstructure:"process.cgroup.cpu.limit"`
+	ProcessCgroupMemoryLimit   MetricConfig `mapstructure:"process.cgroup.memory.limit"`
 	ProcessContextSwitches     MetricConfig `mapstructure:"process.context_switches"`
 	ProcessCPUTime             MetricConfig `mapstructure:"process.cpu.time"`
 	ProcessCPUUtilization      MetricConfig `mapstructure:"process.cpu.utilization"`
@@ -45,6 +47,12 @@ type MetricsConfig struct {
 
 func DefaultMetricsConfig() MetricsConfig {
 	return MetricsConfig{
+		ProcessCgroupCPULimit: MetricConfig{
+			Enabled: false,
+		},
+		ProcessCgroupMemoryLimit: MetricConfig{
+			Enabled: false,
+		},
 		ProcessContextSwitches: MetricConfig{
 			Enabled: false,
 		},
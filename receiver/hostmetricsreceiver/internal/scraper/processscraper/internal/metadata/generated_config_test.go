@@ -25,6 +25,8 @@ func TestMetricsBuilderConfig(t *testing.T) {
 			name: "all_set",
 			want: MetricsBuilderConfig{
 				Metrics: MetricsConfig{
+					ProcessCgroupCPULimit:      MetricConfig{Enabled: true},
+					ProcessCgroupMemoryLimit:   MetricConfig{Enabled: true},
 					ProcessContextSwitches:     MetricConfig{Enabled: true},
 					ProcessCPUTime:             MetricConfig{Enabled: true},
 					ProcessCPUUtilization:      MetricConfig{Enabled: true},
@@ -55,6 +57,8 @@ func TestMetricsBuilderConfig(t *testing.T) {
 			name: "none_set",
 			want: MetricsBuilderConfig{
 				Metrics: MetricsConfig{
+					ProcessCgroupCPULimit:      MetricConfig{Enabled: false},
+					ProcessCgroupMemoryLimit:   MetricConfig{Enabled: false},
 					ProcessContextSwitches:     MetricConfig{Enabled: false},
 					ProcessCPUTime:             MetricConfig{Enabled: false},
 					ProcessCPUUtilization:      MetricConfig{Enabled: false},
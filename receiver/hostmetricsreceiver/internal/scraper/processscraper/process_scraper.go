@@ -37,6 +37,7 @@ const (
 	fileDescriptorMetricsLen    = 1
 	handleMetricsLen            = 1
 	signalMetricsLen            = 1
+	cgroupLimitMetricsLen       = 2
 
 	metricsLen = cpuMetricsLen + memoryMetricsLen + diskMetricsLen + memoryUtilizationMetricsLen + pagingMetricsLen + threadMetricsLen + contextSwitchMetricsLen + fileDescriptorMetricsLen + signalMetricsLen
 )
@@ -48,6 +49,8 @@ type scraper struct {
 	mb                 *metadata.MetricsBuilder
 	includeFS          filterset.FilterSet
 	excludeFS          filterset.FilterSet
+	includeCgroups     *cgroupFilter
+	excludeCgroups     *cgroupFilter
 	scrapeProcessDelay time.Duration
 	ucals              map[int32]*ucal.CPUUtilizationCalculator
 	logicalCores       int
@@ -87,6 +90,14 @@ func newProcessScraper(settings receiver.CreateSettings, cfg *Config) (*scraper,
 		}
 	}
 
+	if scraper.includeCgroups, err = newCgroupFilter(&cfg.Include); err != nil {
+		return nil, fmt.Errorf("error creating process include cgroup filters: %w", err)
+	}
+
+	if scraper.excludeCgroups, err = newCgroupFilter(&cfg.Exclude); err != nil {
+		return nil, fmt.Errorf("error creating process exclude cgroup filters: %w", err)
+	}
+
 	logicalCores, err := cpu.Counts(true)
 	if err != nil {
 		return nil, fmt.Errorf("error getting number of logical cores: %w", err)
@@ -176,6 +187,10 @@ func (s *scraper) scrape(ctx context.Context) (pmetric.Metrics, error) {
 			errs.AddPartial(signalMetricsLen, fmt.Errorf("error reading pending signals for process %q (pid %v): %w", md.executable.name, md.pid, err))
 		}
 
+		if err = s.scrapeAndAppendCgroupLimitMetrics(ctx, now, md.executable.cgroup); err != nil && !s.config.MuteProcessCgroupError {
+			errs.AddPartial(cgroupLimitMetricsLen, fmt.Errorf("error reading cgroup limits for process %q (pid %v): %w", md.executable.name, md.pid, err))
+		}
+
 		s.mb.EmitForResource(metadata.WithResource(md.buildResource(s.mb.NewResourceBuilder())),
 			metadata.WithStartTimeOverride(pcommon.Timestamp(md.createTime*1e6)))
 	}
@@ -236,9 +251,7 @@ func (s *scraper) getProcessMetadata() ([]*processMetadata, error) {
 
 		executable := &executableMetadata{name: name, path: exe, cgroup: cgroup}
 
-		// filter processes by name
-		if (s.includeFS != nil && !s.includeFS.Matches(executable.name)) ||
-			(s.excludeFS != nil && s.excludeFS.Matches(executable.name)) {
+		if !s.matchesFilters(executable) {
 			continue
 		}
 
@@ -285,6 +298,28 @@ func (s *scraper) getProcessMetadata() ([]*processMetadata, error) {
 	return data, errs.Combine()
 }
 
+// matchesFilters reports whether a process should be scraped according to the
+// include and exclude filters on its name and cgroups.
+func (s *scraper) matchesFilters(executable *executableMetadata) bool {
+	var cgroups []cgroupEntry
+	if s.includeCgroups != nil || s.excludeCgroups != nil {
+		cgroups = parseCgroupEntries(executable.cgroup)
+	}
+
+	included := (s.includeFS == nil || s.includeFS.Matches(executable.name)) &&
+		(s.includeCgroups == nil || s.includeCgroups.matches(cgroups))
+	if !included {
+		return false
+	}
+
+	if s.excludeFS == nil && s.excludeCgroups == nil {
+		return true
+	}
+	excluded := (s.excludeFS == nil || s.excludeFS.Matches(executable.name)) &&
+		(s.excludeCgroups == nil || s.excludeCgroups.matches(cgroups))
+	return !excluded
+}
+
 func (s *scraper) scrapeAndAppendCPUTimeMetric(ctx context.Context, now pcommon.Timestamp, handle processHandle, pid int32) error {
 	if !s.config.MetricsBuilderConfig.Metrics.ProcessCPUTime.Enabled && !s.config.MetricsBuilderConfig.Metrics.ProcessCPUUtilization.Enabled {
 		return nil
@@ -466,3 +501,23 @@ func (s *scraper) scrapeAndAppendSignalsPendingMetric(ctx context.Context, now p
 
 	return nil
 }
+
+func (s *scraper) scrapeAndAppendCgroupLimitMetrics(ctx context.Context, now pcommon.Timestamp, cgroup string) error {
+	if !s.config.MetricsBuilderConfig.Metrics.ProcessCgroupCPULimit.Enabled && !s.config.MetricsBuilderConfig.Metrics.ProcessCgroupMemoryLimit.Enabled {
+		return nil
+	}
+
+	if cgroup == "" {
+		return nil
+	}
+
+	root := getEnvWithContext(ctx, string(common.HostSysEnvKey), "/sys", "fs", "cgroup")
+	limits, err := readCgroupLimits(root, parseCgroupEntries(cgroup))
+	if limits.cpu != nil {
+		s.mb.RecordProcessCgroupCPULimitDataPoint(now, *limits.cpu)
+	}
+	if limits.memory != nil {
+		s.mb.RecordProcessCgroupMemoryLimitDataPoint(now, *limits.memory)
+	}
+	return err
+}
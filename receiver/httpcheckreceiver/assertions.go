@@ -0,0 +1,184 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package httpcheckreceiver // import "github.com/open-telemetry/opentelemetry-collector-contrib/receiver/httpcheckreceiver"
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+// jsonPathSegment is either an object key or an array index of a JSON path
+type jsonPathSegment struct {
+	key     string
+	index   int
+	isIndex bool
+}
+
+type jsonPathCheck struct {
+	path     string
+	segments []jsonPathSegment
+	value    *regexp.Regexp
+}
+
+// assertions are the compiled assertions of a target
+type assertions struct {
+	body      *regexp.Regexp
+	jsonPaths []jsonPathCheck
+	headers   map[string]*regexp.Regexp
+}
+
+// newAssertions compiles the assertions of a target.
+// Relies on config being validated thoroughly
+func newAssertions(cfg assertionsConfig) (*assertions, error) {
+	a := &assertions{headers: map[string]*regexp.Regexp{}}
+	var err error
+
+	if cfg.BodyRegex != "" {
+		if a.body, err = regexp.Compile(cfg.BodyRegex); err != nil {
+			return nil, err
+		}
+	}
+
+	for _, assertion := range cfg.JSONPaths {
+		check := jsonPathCheck{path: assertion.Path}
+		if check.segments, err = parseJSONPath(assertion.Path); err != nil {
+			return nil, err
+		}
+		if assertion.Value != "" {
+			if check.value, err = regexp.Compile(assertion.Value); err != nil {
+				return nil, err
+			}
+		}
+		a.jsonPaths = append(a.jsonPaths, check)
+	}
+
+	for header, value := range cfg.Headers {
+		if a.headers[header], err = regexp.Compile(value); err != nil {
+			return nil, err
+		}
+	}
+
+	return a, nil
+}
+
+// needsBody returns whether the response body must be read to check the assertions
+func (a *assertions) needsBody() bool {
+	return a.body != nil || len(a.jsonPaths) > 0
+}
+
+// check returns an error describing the first assertion the response does not pass
+func (a *assertions) check(header http.Header, body []byte) error {
+	for name, value := range a.headers {
+		values, ok := header[http.CanonicalHeaderKey(name)]
+		if !ok {
+			return fmt.Errorf("header %q is missing", name)
+		}
+		if !value.MatchString(strings.Join(values, ",")) {
+			return fmt.Errorf("header %q does not match %q", name, value)
+		}
+	}
+
+	if a.body != nil && !a.body.Match(body) {
+		return fmt.Errorf("body does not match %q", a.body)
+	}
+
+	if len(a.jsonPaths) == 0 {
+		return nil
+	}
+
+	decoder := json.NewDecoder(bytes.NewReader(body))
+	decoder.UseNumber()
+	var document any
+	if err := decoder.Decode(&document); err != nil {
+		return fmt.Errorf("body is not valid JSON: %w", err)
+	}
+
+	for _, check := range a.jsonPaths {
+		value, ok := lookupJSONPath(document, check.segments)
+		if !ok {
+			return fmt.Errorf("JSON path %q is missing", check.path)
+		}
+		if check.value != nil && !check.value.MatchString(jsonValueString(value)) {
+			return fmt.Errorf("JSON path %q does not match %q", check.path, check.value)
+		}
+	}
+	return nil
+}
+
+// parseJSONPath parses a path made of object keys and array indexes such as "$.checks[0].state".
+// The leading "$" is optional.
+func parseJSONPath(path string) ([]jsonPathSegment, error) {
+	rest := strings.TrimPrefix(path, "$")
+	var segments []jsonPathSegment
+	for rest != "" {
+		switch {
+		case rest[0] == '[':
+			end := strings.IndexByte(rest, ']')
+			if end == -1 {
+				return nil, fmt.Errorf("invalid JSON path %q: missing closing bracket", path)
+			}
+			index, err := strconv.Atoi(rest[1:end])
+			if err != nil || index < 0 {
+				return nil, fmt.Errorf("invalid JSON path %q: invalid array index %q", path, rest[1:end])
+			}
+			segments = append(segments, jsonPathSegment{index: index, isIndex: true})
+			rest = rest[end+1:]
+		default:
+			// Keys are separated by dots, which can be omitted before the first key
+			if rest[0] == '.' {
+				rest = rest[1:]
+			} else if len(segments) > 0 || strings.HasPrefix(path, "$") {
+				return nil, fmt.Errorf("invalid JSON path %q: unexpected %q", path, rest[0])
+			}
+			end := strings.IndexAny(rest, ".[")
+			if end == -1 {
+				end = len(rest)
+			}
+			if end == 0 {
+				return nil, fmt.Errorf("invalid JSON path %q: empty key", path)
+			}
+			segments = append(segments, jsonPathSegment{key: rest[:end]})
+			rest = rest[end:]
+		}
+	}
+	return segments, nil
+}
+
+// lookupJSONPath returns the value at the given path of a decoded JSON document
+func lookupJSONPath(document any, segments []jsonPathSegment) (any, bool) {
+	value := document
+	for _, segment := range segments {
+		if segment.isIndex {
+			array, ok := value.([]any)
+			if !ok || segment.index >= len(array) {
+				return nil, false
+			}
+			value = array[segment.index]
+			continue
+		}
+		object, ok := value.(map[string]any)
+		if !ok {
+			return nil, false
+		}
+		if value, ok = object[segment.key]; !ok {
+			return nil, false
+		}
+	}
+	return value, true
+}
+
+// jsonValueString returns the string matched against the regular expression of a JSON path assertion:
+// strings are used as is, other values as their JSON encoding
+func jsonValueString(value any) string {
+	if s, ok := value.(string); ok {
+		return s
+	}
+	encoded, _ := json.Marshal(value)
+	return string(encoded)
+}
@@ -0,0 +1,129 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package httpcheckreceiver // import "github.com/open-telemetry/opentelemetry-collector-contrib/receiver/httpcheckreceiver"
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseJSONPath(t *testing.T) {
+	testCases := []struct {
+		path             string
+		expectedSegments []jsonPathSegment
+		expectedErr      string
+	}{
+		{
+			path:             "$.status",
+			expectedSegments: []jsonPathSegment{{key: "status"}},
+		},
+		{
+			path:             "checks[1].state",
+			expectedSegments: []jsonPathSegment{{key: "checks"}, {index: 1, isIndex: true}, {key: "state"}},
+		},
+		{
+			path:             "$[0]",
+			expectedSegments: []jsonPathSegment{{index: 0, isIndex: true}},
+		},
+		{
+			path:        "$status",
+			expectedErr: `invalid JSON path "$status": unexpected 's'`,
+		},
+		{
+			path:        "$.checks[0",
+			expectedErr: `invalid JSON path "$.checks[0": missing closing bracket`,
+		},
+		{
+			path:        "$..status",
+			expectedErr: `invalid JSON path "$..status": empty key`,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.path, func(t *testing.T) {
+			segments, err := parseJSONPath(tc.path)
+			if tc.expectedErr != "" {
+				require.EqualError(t, err, tc.expectedErr)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.expectedSegments, segments)
+		})
+	}
+}
+
+func TestAssertionsCheck(t *testing.T) {
+	header := http.Header{"Content-Type": []string{"application/json"}}
+	body := []byte(`{"status": "ok", "checks": [{"name": "db", "state": "up", "latency": 12}], "degraded": false}`)
+
+	testCases := []struct {
+		desc        string
+		cfg         assertionsConfig
+		expectedErr string
+	}{
+		{
+			desc: "No assertions",
+		},
+		{
+			desc: "All assertions pass",
+			cfg: assertionsConfig{
+				BodyRegex: `"status":\s*"ok"`,
+				JSONPaths: []jsonPathAssertion{
+					{Path: "$.checks[0].state", Value: "^up$"},
+					{Path: "$.checks[0].latency", Value: `^\d+$`},
+					{Path: "$.degraded", Value: "^false$"},
+					{Path: "$.checks[0].name"},
+				},
+				Headers: map[string]string{"content-type": "^application/json"},
+			},
+		},
+		{
+			desc:        "Body does not match",
+			cfg:         assertionsConfig{BodyRegex: "error"},
+			expectedErr: `body does not match "error"`,
+		},
+		{
+			desc:        "Missing JSON path",
+			cfg:         assertionsConfig{JSONPaths: []jsonPathAssertion{{Path: "$.checks[1].state"}}},
+			expectedErr: `JSON path "$.checks[1].state" is missing`,
+		},
+		{
+			desc:        "JSON path does not match",
+			cfg:         assertionsConfig{JSONPaths: []jsonPathAssertion{{Path: "$.status", Value: "^down$"}}},
+			expectedErr: `JSON path "$.status" does not match "^down$"`,
+		},
+		{
+			desc:        "Missing header",
+			cfg:         assertionsConfig{Headers: map[string]string{"X-Version": "."}},
+			expectedErr: `header "X-Version" is missing`,
+		},
+		{
+			desc:        "Header does not match",
+			cfg:         assertionsConfig{Headers: map[string]string{"Content-Type": "^text/"}},
+			expectedErr: `header "Content-Type" does not match "^text/"`,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.desc, func(t *testing.T) {
+			a, err := newAssertions(tc.cfg)
+			require.NoError(t, err)
+			err = a.check(header, body)
+			if tc.expectedErr != "" {
+				require.EqualError(t, err, tc.expectedErr)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestAssertionsCheckInvalidJSON(t *testing.T) {
+	a, err := newAssertions(assertionsConfig{JSONPaths: []jsonPathAssertion{{Path: "$.status"}}})
+	require.NoError(t, err)
+	require.True(t, a.needsBody())
+	require.ErrorContains(t, a.check(http.Header{}, []byte("ok")), "body is not valid JSON")
+}
@@ -7,6 +7,7 @@ import (
 	"errors"
 	"fmt"
 	"net/url"
+	"regexp"
 
 	"go.opentelemetry.io/collector/config/confighttp"
 	"go.opentelemetry.io/collector/receiver/scraperhelper"
@@ -19,6 +20,7 @@ import (
 var (
 	errMissingEndpoint = errors.New(`"endpoint" must be specified`)
 	errInvalidEndpoint = errors.New(`"endpoint" must be in the form of <scheme>://<hostname>[:<port>]`)
+	errMissingJSONPath = errors.New(`"path" must be specified for JSON path assertions`)
 )
 
 // Config defines the configuration for the various elements of the receiver agent.
@@ -30,7 +32,54 @@ type Config struct {
 
 type targetConfig struct {
 	confighttp.ClientConfig `mapstructure:",squash"`
-	Method                  string `mapstructure:"method"`
+	Method                  string           `mapstructure:"method"`
+	Assertions              assertionsConfig `mapstructure:"assertions"`
+}
+
+// assertionsConfig defines the checks a response must pass for the target to be successful
+type assertionsConfig struct {
+	// BodyRegex is a regular expression the response body must match
+	BodyRegex string `mapstructure:"body_regex"`
+	// JSONPaths are values the JSON response body must contain
+	JSONPaths []jsonPathAssertion `mapstructure:"json_paths"`
+	// Headers maps response header names to a regular expression their value must match
+	Headers map[string]string `mapstructure:"headers"`
+}
+
+// jsonPathAssertion defines a value the JSON response body must contain
+type jsonPathAssertion struct {
+	// Path of the value, e.g. "$.status" or "$.checks[0].state"
+	Path string `mapstructure:"path"`
+	// Value is a regular expression the value must match. The value only has to exist if empty.
+	Value string `mapstructure:"value"`
+}
+
+// Validate validates the assertions by checking their paths and regular expressions
+func (cfg *assertionsConfig) Validate() error {
+	var err error
+
+	if _, regexErr := regexp.Compile(cfg.BodyRegex); regexErr != nil {
+		err = multierr.Append(err, fmt.Errorf("invalid body_regex: %w", regexErr))
+	}
+
+	for _, assertion := range cfg.JSONPaths {
+		if assertion.Path == "" {
+			err = multierr.Append(err, errMissingJSONPath)
+		} else if _, pathErr := parseJSONPath(assertion.Path); pathErr != nil {
+			err = multierr.Append(err, pathErr)
+		}
+		if _, regexErr := regexp.Compile(assertion.Value); regexErr != nil {
+			err = multierr.Append(err, fmt.Errorf("invalid value for JSON path %q: %w", assertion.Path, regexErr))
+		}
+	}
+
+	for header, value := range cfg.Headers {
+		if _, regexErr := regexp.Compile(value); regexErr != nil {
+			err = multierr.Append(err, fmt.Errorf("invalid value for header %q: %w", header, regexErr))
+		}
+	}
+
+	return err
 }
 
 // Validate validates the configuration by checking for missing or invalid fields
@@ -46,6 +95,8 @@ func (cfg *targetConfig) Validate() error {
 		}
 	}
 
+	err = multierr.Append(err, cfg.Assertions.Validate())
+
 	return err
 }
 
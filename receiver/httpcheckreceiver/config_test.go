@@ -4,6 +4,7 @@
 package httpcheckreceiver // import "github.com/open-telemetry/opentelemetry-collector-contrib/receiver/httpcheckreceiver"
 
 import (
+	"errors"
 	"fmt"
 	"testing"
 
@@ -86,6 +87,31 @@ func TestValidate(t *testing.T) {
 				fmt.Errorf("%w: %s", errInvalidEndpoint, `parse "www.opentelemetry.io/docs": invalid URI for request`),
 			),
 		},
+		{
+			desc: "invalid assertions",
+			cfg: &Config{
+				Targets: []*targetConfig{
+					{
+						ClientConfig: confighttp.ClientConfig{
+							Endpoint: "https://opentelemetry.io",
+						},
+						Assertions: assertionsConfig{
+							BodyRegex: "(",
+							JSONPaths: []jsonPathAssertion{
+								{Value: "ok"},
+								{Path: "$.checks[first]"},
+							},
+						},
+					},
+				},
+				ControllerConfig: scraperhelper.NewDefaultControllerConfig(),
+			},
+			expectedErr: multierr.Combine(
+				errors.New("invalid body_regex: error parsing regexp: missing closing ): `(`"),
+				errMissingJSONPath,
+				errors.New(`invalid JSON path "$.checks[first]": invalid array index "first"`),
+			),
+		},
 		{
 			desc: "valid config",
 			cfg: &Config{
@@ -99,6 +125,15 @@ func TestValidate(t *testing.T) {
 						ClientConfig: confighttp.ClientConfig{
 							Endpoint: "https://opentelemetry.io:80/docs",
 						},
+						Assertions: assertionsConfig{
+							BodyRegex: "ok",
+							JSONPaths: []jsonPathAssertion{
+								{Path: "$.checks[0].state", Value: "^up$"},
+							},
+							Headers: map[string]string{
+								"Content-Type": "^application/json",
+							},
+						},
 					},
 				},
 				ControllerConfig: scraperhelper.NewDefaultControllerConfig(),
@@ -27,9 +27,11 @@ func (ms *MetricConfig) Unmarshal(parser *confmap.Conf) error {
 
 // MetricsConfig provides config for httpcheck metrics.
 type MetricsConfig struct {
-	HttpcheckDuration MetricConfig `mapstructure:"httpcheck.duration"`
-	HttpcheckError    MetricConfig `mapstructure:"httpcheck.error"`
-	HttpcheckStatus   MetricConfig `mapstructure:"httpcheck.status"`
+	HttpcheckDuration        MetricConfig `mapstructure:"httpcheck.duration"`
+	HttpcheckError           MetricConfig `mapstructure:"httpcheck.error"`
+	HttpcheckStatus          MetricConfig `mapstructure:"httpcheck.status"`
+	HttpcheckSuccess         MetricConfig `mapstructure:"httpcheck.success"`
+	HttpcheckTLSCertTimeLeft MetricConfig `mapstructure:"httpcheck.tls.cert.time_left"`
 }
 
 func DefaultMetricsConfig() MetricsConfig {
@@ -43,6 +45,12 @@ func DefaultMetricsConfig() MetricsConfig {
 		HttpcheckStatus: MetricConfig{
 			Enabled: true,
 		},
+		HttpcheckSuccess: MetricConfig{
+			Enabled: false,
+		},
+		HttpcheckTLSCertTimeLeft: MetricConfig{
+			Enabled: false,
+		},
 	}
 }
 
@@ -25,9 +25,11 @@ func TestMetricsBuilderConfig(t *testing.T) {
 			name: "all_set",
 			want: MetricsBuilderConfig{
 				Metrics: MetricsConfig{
-					HttpcheckDuration: MetricConfig{Enabled: true},
-					HttpcheckError:    MetricConfig{Enabled: true},
-					HttpcheckStatus:   MetricConfig{Enabled: true},
+					HttpcheckDuration:        MetricConfig{Enabled: true},
+					HttpcheckError:           MetricConfig{Enabled: true},
+					HttpcheckStatus:          MetricConfig{Enabled: true},
+					HttpcheckSuccess:         MetricConfig{Enabled: true},
+					HttpcheckTLSCertTimeLeft: MetricConfig{Enabled: true},
 				},
 			},
 		},
@@ -35,9 +37,11 @@ func TestMetricsBuilderConfig(t *testing.T) {
 			name: "none_set",
 			want: MetricsBuilderConfig{
 				Metrics: MetricsConfig{
-					HttpcheckDuration: MetricConfig{Enabled: false},
-					HttpcheckError:    MetricConfig{Enabled: false},
-					HttpcheckStatus:   MetricConfig{Enabled: false},
+					HttpcheckDuration:        MetricConfig{Enabled: false},
+					HttpcheckError:           MetricConfig{Enabled: false},
+					HttpcheckStatus:          MetricConfig{Enabled: false},
+					HttpcheckSuccess:         MetricConfig{Enabled: false},
+					HttpcheckTLSCertTimeLeft: MetricConfig{Enabled: false},
 				},
 			},
 		},
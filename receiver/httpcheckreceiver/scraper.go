@@ -6,6 +6,7 @@ package httpcheckreceiver // import "github.com/open-telemetry/opentelemetry-col
 import (
 	"context"
 	"errors"
+	"io"
 	"net/http"
 	"sync"
 	"time"
@@ -20,16 +21,20 @@ import (
 	"github.com/open-telemetry/opentelemetry-collector-contrib/receiver/httpcheckreceiver/internal/metadata"
 )
 
+// maxBodySize is the maximum number of bytes of a response body read to check assertions
+const maxBodySize = 1 << 20
+
 var (
 	errClientNotInit    = errors.New("client not initialized")
 	httpResponseClasses = map[string]int{"1xx": 1, "2xx": 2, "3xx": 3, "4xx": 4, "5xx": 5}
 )
 
 type httpcheckScraper struct {
-	clients  []*http.Client
-	cfg      *Config
-	settings component.TelemetrySettings
-	mb       *metadata.MetricsBuilder
+	clients    []*http.Client
+	assertions []*assertions
+	cfg        *Config
+	settings   component.TelemetrySettings
+	mb         *metadata.MetricsBuilder
 }
 
 // start starts the scraper by creating a new HTTP Client on the scraper
@@ -40,6 +45,12 @@ func (h *httpcheckScraper) start(ctx context.Context, host component.Host) (err
 			err = multierr.Append(err, clentErr)
 		}
 		h.clients = append(h.clients, client)
+
+		targetAssertions, assertionsErr := newAssertions(target.Assertions)
+		if assertionsErr != nil {
+			err = multierr.Append(err, assertionsErr)
+		}
+		h.assertions = append(h.assertions, targetAssertions)
 	}
 	return
 }
@@ -72,10 +83,22 @@ func (h *httpcheckScraper) scrape(ctx context.Context) (pmetric.Metrics, error)
 			h.mb.RecordHttpcheckDurationDataPoint(now, time.Since(start).Milliseconds(), h.cfg.Targets[targetIndex].Endpoint)
 
 			statusCode := 0
+			success := false
 			if err != nil {
 				h.mb.RecordHttpcheckErrorDataPoint(now, int64(1), h.cfg.Targets[targetIndex].Endpoint, err.Error())
 			} else {
 				statusCode = resp.StatusCode
+				success = h.checkResponse(resp, targetIndex)
+				if resp.TLS != nil && len(resp.TLS.PeerCertificates) > 0 {
+					timeLeft := time.Until(resp.TLS.PeerCertificates[0].NotAfter)
+					h.mb.RecordHttpcheckTLSCertTimeLeftDataPoint(now, int64(timeLeft.Seconds()), h.cfg.Targets[targetIndex].Endpoint)
+				}
+			}
+
+			if success {
+				h.mb.RecordHttpcheckSuccessDataPoint(now, int64(1), h.cfg.Targets[targetIndex].Endpoint)
+			} else {
+				h.mb.RecordHttpcheckSuccessDataPoint(now, int64(0), h.cfg.Targets[targetIndex].Endpoint)
 			}
 
 			for class, intVal := range httpResponseClasses {
@@ -94,6 +117,32 @@ func (h *httpcheckScraper) scrape(ctx context.Context) (pmetric.Metrics, error)
 	return h.mb.Emit(), nil
 }
 
+// checkResponse closes the response of a target, after reading its body if the assertions of the target
+// need it, and returns whether the response passed all of them
+func (h *httpcheckScraper) checkResponse(resp *http.Response, targetIndex int) bool {
+	defer resp.Body.Close()
+
+	targetAssertions := h.assertions[targetIndex]
+	if targetAssertions == nil {
+		return true
+	}
+
+	var body []byte
+	if targetAssertions.needsBody() {
+		var err error
+		if body, err = io.ReadAll(io.LimitReader(resp.Body, maxBodySize)); err != nil {
+			h.settings.Logger.Debug("failed to read response body", zap.String("endpoint", h.cfg.Targets[targetIndex].Endpoint), zap.Error(err))
+			return false
+		}
+	}
+
+	if err := targetAssertions.check(resp.Header, body); err != nil {
+		h.settings.Logger.Debug("response assertion failed", zap.String("endpoint", h.cfg.Targets[targetIndex].Endpoint), zap.Error(err))
+		return false
+	}
+	return true
+}
+
 func newScraper(conf *Config, settings receiver.CreateSettings) *httpcheckScraper {
 	return &httpcheckScraper{
 		cfg:      conf,
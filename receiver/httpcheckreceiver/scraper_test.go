@@ -9,6 +9,7 @@ import (
 	"net/http/httptest"
 	"path/filepath"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/require"
 	"go.opentelemetry.io/collector/component/componenttest"
@@ -233,3 +234,72 @@ func TestScraperMultipleTargets(t *testing.T) {
 		pmetrictest.IgnoreTimestamp(),
 	))
 }
+
+func TestScraperAssertionsAndTLS(t *testing.T) {
+	ms := httptest.NewTLSServer(http.HandlerFunc(func(rw http.ResponseWriter, _ *http.Request) {
+		rw.Header().Set("Content-Type", "application/json")
+		_, err := rw.Write([]byte(`{"status": "ok"}`))
+		require.NoError(t, err)
+	}))
+	defer ms.Close()
+
+	testCases := []struct {
+		desc            string
+		assertions      assertionsConfig
+		expectedSuccess int64
+	}{
+		{
+			desc: "Assertions pass",
+			assertions: assertionsConfig{
+				JSONPaths: []jsonPathAssertion{{Path: "$.status", Value: "^ok$"}},
+				Headers:   map[string]string{"Content-Type": "json"},
+			},
+			expectedSuccess: 1,
+		},
+		{
+			desc: "Assertions fail",
+			assertions: assertionsConfig{
+				BodyRegex: "degraded",
+			},
+			expectedSuccess: 0,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.desc, func(t *testing.T) {
+			cfg := createDefaultConfig().(*Config)
+			cfg.Metrics.HttpcheckSuccess.Enabled = true
+			cfg.Metrics.HttpcheckTLSCertTimeLeft.Enabled = true
+			cfg.Targets = []*targetConfig{{
+				ClientConfig: confighttp.ClientConfig{
+					Endpoint: ms.URL,
+					TLSSetting: configtls.ClientConfig{
+						InsecureSkipVerify: true,
+					},
+				},
+				Assertions: tc.assertions,
+			}}
+			scraper := newScraper(cfg, receivertest.NewNopCreateSettings())
+			require.NoError(t, scraper.start(context.Background(), componenttest.NewNopHost()))
+
+			actualMetrics, err := scraper.scrape(context.Background())
+			require.NoError(t, err)
+
+			metrics := actualMetrics.ResourceMetrics().At(0).ScopeMetrics().At(0).Metrics()
+			found := map[string]bool{}
+			for i := 0; i < metrics.Len(); i++ {
+				metric := metrics.At(i)
+				switch metric.Name() {
+				case "httpcheck.success":
+					require.Equal(t, tc.expectedSuccess, metric.Gauge().DataPoints().At(0).IntValue())
+				case "httpcheck.tls.cert.time_left":
+					expected := time.Until(ms.Certificate().NotAfter).Seconds()
+					require.InDelta(t, expected, float64(metric.Gauge().DataPoints().At(0).IntValue()), 60)
+				}
+				found[metric.Name()] = true
+			}
+			require.True(t, found["httpcheck.success"])
+			require.True(t, found["httpcheck.tls.cert.time_left"])
+		})
+	}
+}
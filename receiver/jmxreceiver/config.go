@@ -14,6 +14,7 @@ import (
 	"strings"
 	"time"
 
+	"go.opentelemetry.io/collector/config/confighttp"
 	"go.opentelemetry.io/collector/config/configopaque"
 	"go.opentelemetry.io/collector/exporter/exporterhelper"
 	"go.uber.org/zap"
@@ -61,6 +62,19 @@ type Config struct {
 	// Log level used by the JMX metric gatherer. Should be one of:
 	// `"trace"`, `"debug"`, `"info"`, `"warn"`, `"error"`, `"off"`
 	LogLevel string `mapstructure:"log_level"`
+	// The Jolokia agent to collect the metrics from over HTTP, instead of the JMX Metric Gatherer, when the
+	// endpoint isn't set or isn't reachable, e.g. when remote JMX is firewalled.
+	Jolokia JolokiaConfig `mapstructure:"jolokia"`
+}
+
+// JolokiaConfig defines the Jolokia agent to collect the metrics from.
+type JolokiaConfig struct {
+	// The URL of the Jolokia agent, e.g. http://localhost:8778/jolokia.
+	confighttp.ClientConfig `mapstructure:",squash"`
+	// The Jolokia username
+	Username string `mapstructure:"username"`
+	// The Jolokia password
+	Password configopaque.String `mapstructure:"password"`
 }
 
 // We don't embed the existing OTLP Exporter config as most fields are unsupported
@@ -217,10 +231,10 @@ func initAdditionalTargetSystems() {
 
 func (c *Config) Validate() error {
 	var missingFields []string
-	if c.JARPath == "" {
+	if c.JARPath == "" && c.Endpoint != "" {
 		missingFields = append(missingFields, "`jar_path`")
 	}
-	if c.Endpoint == "" {
+	if c.Endpoint == "" && c.Jolokia.Endpoint == "" {
 		missingFields = append(missingFields, "`endpoint`")
 	}
 	if c.TargetSystem == "" {
@@ -230,16 +244,19 @@ func (c *Config) Validate() error {
 		return fmt.Errorf("missing required field(s): %v", strings.Join(missingFields, ", "))
 	}
 
-	err := c.validateJar(jmxMetricsGathererVersions, c.JARPath)
-	if err != nil {
-		return fmt.Errorf("invalid `jar_path`: %w", err)
-	}
-
-	for _, additionalJar := range c.AdditionalJars {
-		err := c.validateJar(wildflyJarVersions, additionalJar)
+	// The JMX Metric Gatherer isn't run when only Jolokia is configured
+	if c.Endpoint != "" {
+		err := c.validateJar(jmxMetricsGathererVersions, c.JARPath)
 		if err != nil {
-			return fmt.Errorf("invalid `additional_jars`. Additional Jar should be a jboss-client.jar from Wildfly, "+
-				"no other integrations require additional jars at this time: %w", err)
+			return fmt.Errorf("invalid `jar_path`: %w", err)
+		}
+
+		for _, additionalJar := range c.AdditionalJars {
+			err := c.validateJar(wildflyJarVersions, additionalJar)
+			if err != nil {
+				return fmt.Errorf("invalid `additional_jars`. Additional Jar should be a jboss-client.jar from Wildfly, "+
+					"no other integrations require additional jars at this time: %w", err)
+			}
 		}
 	}
 
@@ -261,6 +278,9 @@ func (c *Config) Validate() error {
 		if _, ok := validTargetSystems[strings.ToLower(system)]; !ok {
 			return fmt.Errorf("`target_system` list may only be a subset of %s", listKeys(validTargetSystems))
 		}
+		if _, ok := jolokiaTargetSystems[strings.ToLower(system)]; c.Jolokia.Endpoint != "" && !ok {
+			return fmt.Errorf("`target_system` list may only be a subset of %s with `jolokia`", listKeys(jolokiaTargetSystems))
+		}
 	}
 
 	return nil
@@ -11,6 +11,7 @@ import (
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 	"go.opentelemetry.io/collector/component"
+	"go.opentelemetry.io/collector/config/confighttp"
 	"go.opentelemetry.io/collector/confmap/confmaptest"
 	"go.opentelemetry.io/collector/exporter/exporterhelper"
 
@@ -197,6 +198,67 @@ func TestLoadConfig(t *testing.T) {
 				},
 			},
 		},
+		{
+			id: component.NewIDWithName(metadata.Type, "jolokia"),
+			expected: &Config{
+				JARPath:            "testdata/fake_jmx.jar",
+				Endpoint:           "service:jmx:jmxmp://myhost:9876",
+				TargetSystem:       "jvm",
+				CollectionInterval: 10 * time.Second,
+				OTLPExporterConfig: otlpExporterConfig{
+					Endpoint: "0.0.0.0:0",
+					TimeoutSettings: exporterhelper.TimeoutSettings{
+						Timeout: 5 * time.Second,
+					},
+				},
+				Jolokia: JolokiaConfig{
+					ClientConfig: confighttp.ClientConfig{
+						Endpoint: "http://myhost:8778/jolokia",
+					},
+					Username: "myusername",
+					Password: "mypassword",
+				},
+			},
+		},
+		{
+			id: component.NewIDWithName(metadata.Type, "jolokiaonly"),
+			expected: &Config{
+				JARPath:            "/opt/opentelemetry-java-contrib-jmx-metrics.jar",
+				TargetSystem:       "jvm",
+				CollectionInterval: 10 * time.Second,
+				OTLPExporterConfig: otlpExporterConfig{
+					Endpoint: "0.0.0.0:0",
+					TimeoutSettings: exporterhelper.TimeoutSettings{
+						Timeout: 5 * time.Second,
+					},
+				},
+				Jolokia: JolokiaConfig{
+					ClientConfig: confighttp.ClientConfig{
+						Endpoint: "http://myhost:8778/jolokia",
+					},
+				},
+			},
+		},
+		{
+			id:          component.NewIDWithName(metadata.Type, "jolokiatargetsystem"),
+			expectedErr: "`target_system` list may only be a subset of 'jvm' with `jolokia`",
+			expected: &Config{
+				JARPath:            "/opt/opentelemetry-java-contrib-jmx-metrics.jar",
+				TargetSystem:       "jvm,kafka",
+				CollectionInterval: 10 * time.Second,
+				OTLPExporterConfig: otlpExporterConfig{
+					Endpoint: "0.0.0.0:0",
+					TimeoutSettings: exporterhelper.TimeoutSettings{
+						Timeout: 5 * time.Second,
+					},
+				},
+				Jolokia: JolokiaConfig{
+					ClientConfig: confighttp.ClientConfig{
+						Endpoint: "http://myhost:8778/jolokia",
+					},
+				},
+			},
+		},
 	}
 
 	for _, tt := range tests {
@@ -0,0 +1,254 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package jmxreceiver // import "github.com/open-telemetry/opentelemetry-collector-contrib/receiver/jmxreceiver"
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"sort"
+	"strings"
+	"time"
+
+	"go.opentelemetry.io/collector/pdata/pcommon"
+	"go.opentelemetry.io/collector/pdata/pmetric"
+)
+
+// jolokiaTargetSystems are the target systems whose metrics are collected through Jolokia, with the definitions
+// of the JMX Metric Gatherer.
+var jolokiaTargetSystems = map[string]struct{}{"jvm": {}}
+
+// jolokiaRequest is a read request of the Jolokia bulk API.
+type jolokiaRequest struct {
+	Type      string   `json:"type"`
+	MBean     string   `json:"mbean"`
+	Attribute []string `json:"attribute,omitempty"`
+}
+
+type jolokiaResponse struct {
+	Status int             `json:"status"`
+	Error  string          `json:"error"`
+	Value  json.RawMessage `json:"value"`
+}
+
+// jolokiaMemoryUsage is the java.lang.management.MemoryUsage composite data.
+type jolokiaMemoryUsage struct {
+	Init      int64 `json:"init"`
+	Used      int64 `json:"used"`
+	Committed int64 `json:"committed"`
+	Max       int64 `json:"max"`
+}
+
+// jvmJolokiaRequests read the MBeans of the jvm target system, in the order expected by jolokiaClient.jvmMetrics.
+var jvmJolokiaRequests = []jolokiaRequest{
+	{Type: "read", MBean: "java.lang:type=ClassLoading", Attribute: []string{"LoadedClassCount"}},
+	{Type: "read", MBean: "java.lang:type=GarbageCollector,*", Attribute: []string{"CollectionCount", "CollectionTime"}},
+	{Type: "read", MBean: "java.lang:type=Memory", Attribute: []string{"HeapMemoryUsage", "NonHeapMemoryUsage"}},
+	{Type: "read", MBean: "java.lang:type=MemoryPool,*", Attribute: []string{"Usage"}},
+	{Type: "read", MBean: "java.lang:type=Threading", Attribute: []string{"ThreadCount"}},
+}
+
+// jolokiaClient reads the MBeans of the target systems from a Jolokia agent, as an alternative to the remote JMX
+// connection of the JMX Metric Gatherer, and converts them to the same metrics.
+type jolokiaClient struct {
+	client   *http.Client
+	endpoint string
+	username string
+	password string
+	// resourceAttributes are the attributes of the resource of the metrics
+	resourceAttributes map[string]string
+	start              pcommon.Timestamp
+}
+
+func newJolokiaClient(client *http.Client, cfg *Config) *jolokiaClient {
+	return &jolokiaClient{
+		client:             client,
+		endpoint:           strings.TrimSuffix(cfg.Jolokia.Endpoint, "/"),
+		username:           cfg.Jolokia.Username,
+		password:           string(cfg.Jolokia.Password),
+		resourceAttributes: cfg.ResourceAttributes,
+		start:              pcommon.NewTimestampFromTime(time.Now()),
+	}
+}
+
+func (c *jolokiaClient) read(ctx context.Context, requests []jolokiaRequest) ([]jolokiaResponse, error) {
+	body, err := json.Marshal(requests)
+	if err != nil {
+		return nil, err
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, c.endpoint+"/", bytes.NewReader(body))
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	if c.username != "" {
+		req.SetBasicAuth(c.username, c.password)
+	}
+
+	resp, err := c.client.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("request POST %s failed - %q", c.endpoint, resp.Status)
+	}
+
+	var responses []jolokiaResponse
+	if err = json.NewDecoder(resp.Body).Decode(&responses); err != nil {
+		return nil, fmt.Errorf("decoding the Jolokia response: %w", err)
+	}
+	if len(responses) != len(requests) {
+		return nil, fmt.Errorf("expected %d Jolokia responses, got %d", len(requests), len(responses))
+	}
+	for i, response := range responses {
+		if response.Status != http.StatusOK {
+			return nil, fmt.Errorf("failed to read %s: %d %s", requests[i].MBean, response.Status, response.Error)
+		}
+	}
+	return responses, nil
+}
+
+// metrics reads the metrics of the jvm target system, the only one collected through Jolokia.
+func (c *jolokiaClient) metrics(ctx context.Context) (pmetric.Metrics, error) {
+	responses, err := c.read(ctx, jvmJolokiaRequests)
+	if err != nil {
+		return pmetric.Metrics{}, err
+	}
+
+	var classLoading struct {
+		LoadedClassCount int64
+	}
+	var garbageCollectors map[string]struct {
+		CollectionCount int64
+		CollectionTime  int64
+	}
+	var memory struct {
+		HeapMemoryUsage    jolokiaMemoryUsage
+		NonHeapMemoryUsage jolokiaMemoryUsage
+	}
+	var memoryPools map[string]struct {
+		Usage jolokiaMemoryUsage
+	}
+	var threading struct {
+		ThreadCount int64
+	}
+	for i, value := range []any{&classLoading, &garbageCollectors, &memory, &memoryPools, &threading} {
+		if err = json.Unmarshal(responses[i].Value, value); err != nil {
+			return pmetric.Metrics{}, fmt.Errorf("decoding %s: %w", jvmJolokiaRequests[i].MBean, err)
+		}
+	}
+
+	md := pmetric.NewMetrics()
+	rm := md.ResourceMetrics().AppendEmpty()
+	for k, v := range c.resourceAttributes {
+		rm.Resource().Attributes().PutStr(k, v)
+	}
+	sm := rm.ScopeMetrics().AppendEmpty()
+	sm.Scope().SetName("otelcol/jmxreceiver")
+	now := pcommon.NewTimestampFromTime(time.Now())
+
+	appendGauge(sm, now, "jvm.classes.loaded", "number of loaded classes", "1").SetIntValue(classLoading.LoadedClassCount)
+
+	collections := appendSum(sm, "jvm.gc.collections.count", "total number of collections that have occurred", "1")
+	elapsed := appendSum(sm, "jvm.gc.collections.elapsed", "the approximate accumulated collection elapsed time in milliseconds", "ms")
+	for _, mbean := range sortedKeys(garbageCollectors) {
+		name := mbeanKeyProperty(mbean, "name")
+		dp := collections.DataPoints().AppendEmpty()
+		dp.SetStartTimestamp(c.start)
+		dp.SetTimestamp(now)
+		dp.SetIntValue(garbageCollectors[mbean].CollectionCount)
+		dp.Attributes().PutStr("name", name)
+		dp = elapsed.DataPoints().AppendEmpty()
+		dp.SetStartTimestamp(c.start)
+		dp.SetTimestamp(now)
+		dp.SetIntValue(garbageCollectors[mbean].CollectionTime)
+		dp.Attributes().PutStr("name", name)
+	}
+
+	appendMemoryUsage(sm, now, "jvm.memory.heap", "current heap usage", map[string]jolokiaMemoryUsage{"": memory.HeapMemoryUsage})
+	appendMemoryUsage(sm, now, "jvm.memory.nonheap", "current non-heap usage", map[string]jolokiaMemoryUsage{"": memory.NonHeapMemoryUsage})
+	pools := make(map[string]jolokiaMemoryUsage, len(memoryPools))
+	for mbean, pool := range memoryPools {
+		pools[mbeanKeyProperty(mbean, "name")] = pool.Usage
+	}
+	appendMemoryUsage(sm, now, "jvm.memory.pool", "current memory pool usage", pools)
+
+	appendGauge(sm, now, "jvm.threads.count", "number of threads", "1").SetIntValue(threading.ThreadCount)
+	return md, nil
+}
+
+func appendGauge(sm pmetric.ScopeMetrics, now pcommon.Timestamp, name string, description string, unit string) pmetric.NumberDataPoint {
+	m := sm.Metrics().AppendEmpty()
+	m.SetName(name)
+	m.SetDescription(description)
+	m.SetUnit(unit)
+	dp := m.SetEmptyGauge().DataPoints().AppendEmpty()
+	dp.SetTimestamp(now)
+	return dp
+}
+
+func appendSum(sm pmetric.ScopeMetrics, name string, description string, unit string) pmetric.Sum {
+	m := sm.Metrics().AppendEmpty()
+	m.SetName(name)
+	m.SetDescription(description)
+	m.SetUnit(unit)
+	sum := m.SetEmptySum()
+	sum.SetIsMonotonic(true)
+	sum.SetAggregationTemporality(pmetric.AggregationTemporalityCumulative)
+	return sum
+}
+
+// appendMemoryUsage appends the gauges of the memory usage fields, with the name attribute of the pools when not
+// empty.
+func appendMemoryUsage(sm pmetric.ScopeMetrics, now pcommon.Timestamp, name string, description string, usages map[string]jolokiaMemoryUsage) {
+	fields := []struct {
+		suffix string
+		value  func(jolokiaMemoryUsage) int64
+	}{
+		{"committed", func(u jolokiaMemoryUsage) int64 { return u.Committed }},
+		{"init", func(u jolokiaMemoryUsage) int64 { return u.Init }},
+		{"max", func(u jolokiaMemoryUsage) int64 { return u.Max }},
+		{"used", func(u jolokiaMemoryUsage) int64 { return u.Used }},
+	}
+	for _, field := range fields {
+		m := sm.Metrics().AppendEmpty()
+		m.SetName(name + "." + field.suffix)
+		m.SetDescription(description)
+		m.SetUnit("by")
+		gauge := m.SetEmptyGauge()
+		for _, pool := range sortedKeys(usages) {
+			dp := gauge.DataPoints().AppendEmpty()
+			dp.SetTimestamp(now)
+			dp.SetIntValue(field.value(usages[pool]))
+			if pool != "" {
+				dp.Attributes().PutStr("name", pool)
+			}
+		}
+	}
+}
+
+// mbeanKeyProperty returns the value of a key property of the MBean name, e.g. G1 Young Generation for the name
+// of java.lang:name=G1 Young Generation,type=GarbageCollector.
+func mbeanKeyProperty(mbean string, key string) string {
+	_, properties, _ := strings.Cut(mbean, ":")
+	for _, property := range strings.Split(properties, ",") {
+		if k, v, ok := strings.Cut(property, "="); ok && k == key {
+			return v
+		}
+	}
+	return ""
+}
+
+func sortedKeys[V any](m map[string]V) []string {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	return keys
+}
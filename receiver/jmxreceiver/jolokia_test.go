@@ -0,0 +1,117 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package jmxreceiver
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/collector/config/confighttp"
+	"go.opentelemetry.io/collector/pdata/pmetric"
+)
+
+// newJolokiaServer serves the canned Jolokia response to the read requests of the jvm target system.
+func newJolokiaServer(t *testing.T) *httptest.Server {
+	response, err := os.ReadFile(filepath.Join("testdata", "jolokia", "response.json"))
+	require.NoError(t, err)
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		username, password, ok := r.BasicAuth()
+		if !ok || username != "user" || password != "secret" {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		var requests []jolokiaRequest
+		assert.NoError(t, json.NewDecoder(r.Body).Decode(&requests))
+		assert.Equal(t, jvmJolokiaRequests, requests)
+		_, err := w.Write(response)
+		assert.NoError(t, err)
+	}))
+}
+
+func newJolokiaTestConfig(endpoint string) *Config {
+	cfg := createDefaultConfig().(*Config)
+	cfg.TargetSystem = "jvm"
+	cfg.ResourceAttributes = map[string]string{"service.name": "myapp"}
+	cfg.Jolokia = JolokiaConfig{
+		ClientConfig: confighttp.ClientConfig{Endpoint: endpoint},
+		Username:     "user",
+		Password:     "secret",
+	}
+	return cfg
+}
+
+func TestJolokiaMetrics(t *testing.T) {
+	srv := newJolokiaServer(t)
+	defer srv.Close()
+
+	client := newJolokiaClient(srv.Client(), newJolokiaTestConfig(srv.URL+"/jolokia/"))
+	md, err := client.metrics(context.Background())
+	require.NoError(t, err)
+
+	require.Equal(t, 1, md.ResourceMetrics().Len())
+	serviceName, _ := md.ResourceMetrics().At(0).Resource().Attributes().Get("service.name")
+	assert.Equal(t, "myapp", serviceName.Str())
+
+	metrics := map[string]pmetric.Metric{}
+	ms := md.ResourceMetrics().At(0).ScopeMetrics().At(0).Metrics()
+	for i := 0; i < ms.Len(); i++ {
+		metrics[ms.At(i).Name()] = ms.At(i)
+	}
+	assert.Len(t, metrics, 16)
+
+	assert.Equal(t, int64(5412), metrics["jvm.classes.loaded"].Gauge().DataPoints().At(0).IntValue())
+	assert.Equal(t, int64(27), metrics["jvm.threads.count"].Gauge().DataPoints().At(0).IntValue())
+	assert.Equal(t, int64(51380224), metrics["jvm.memory.heap.used"].Gauge().DataPoints().At(0).IntValue())
+	assert.Equal(t, int64(-1), metrics["jvm.memory.nonheap.max"].Gauge().DataPoints().At(0).IntValue())
+	assert.Equal(t, "by", metrics["jvm.memory.heap.used"].Unit())
+
+	collections := metrics["jvm.gc.collections.count"].Sum()
+	assert.True(t, collections.IsMonotonic())
+	require.Equal(t, 2, collections.DataPoints().Len())
+	name, _ := collections.DataPoints().At(1).Attributes().Get("name")
+	assert.Equal(t, "G1 Young Generation", name.Str())
+	assert.Equal(t, int64(12), collections.DataPoints().At(1).IntValue())
+	assert.Equal(t, int64(85), metrics["jvm.gc.collections.elapsed"].Sum().DataPoints().At(1).IntValue())
+
+	pools := metrics["jvm.memory.pool.used"].Gauge().DataPoints()
+	require.Equal(t, 2, pools.Len())
+	name, _ = pools.At(1).Attributes().Get("name")
+	assert.Equal(t, "Metaspace", name.Str())
+	assert.Equal(t, int64(38651720), pools.At(1).IntValue())
+}
+
+func TestJolokiaMetricsErrors(t *testing.T) {
+	srv := newJolokiaServer(t)
+	defer srv.Close()
+
+	cfg := newJolokiaTestConfig(srv.URL)
+	cfg.Jolokia.Password = "wrong"
+	_, err := newJolokiaClient(srv.Client(), cfg).metrics(context.Background())
+	require.ErrorContains(t, err, "401 Unauthorized")
+
+	failing := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		responses := make([]jolokiaResponse, len(jvmJolokiaRequests))
+		for i := range responses {
+			responses[i] = jolokiaResponse{Status: http.StatusOK, Value: json.RawMessage(`{}`)}
+		}
+		responses[2] = jolokiaResponse{Status: http.StatusNotFound, Error: "javax.management.InstanceNotFoundException"}
+		assert.NoError(t, json.NewEncoder(w).Encode(responses))
+	}))
+	defer failing.Close()
+	_, err = newJolokiaClient(failing.Client(), newJolokiaTestConfig(failing.URL)).metrics(context.Background())
+	require.EqualError(t, err, "failed to read java.lang:type=Memory: 404 javax.management.InstanceNotFoundException")
+}
+
+func TestMBeanKeyProperty(t *testing.T) {
+	assert.Equal(t, "G1 Young Generation", mbeanKeyProperty("java.lang:name=G1 Young Generation,type=GarbageCollector", "name"))
+	assert.Equal(t, "GarbageCollector", mbeanKeyProperty("java.lang:name=G1 Young Generation,type=GarbageCollector", "type"))
+	assert.Equal(t, "", mbeanKeyProperty("java.lang:type=Memory", "name"))
+}
@@ -12,6 +12,8 @@ import (
 	"sort"
 	"strconv"
 	"strings"
+	"sync"
+	"time"
 
 	"go.opentelemetry.io/collector/component"
 	"go.opentelemetry.io/collector/config/confignet"
@@ -23,8 +25,12 @@ import (
 	"github.com/open-telemetry/opentelemetry-collector-contrib/receiver/jmxreceiver/internal/subprocess"
 )
 
-// jmxMainClass the class containing the main function for the JMX Metric Gatherer JAR
-const jmxMainClass = "io.opentelemetry.contrib.jmxmetrics.JmxMetrics"
+const (
+	// jmxMainClass the class containing the main function for the JMX Metric Gatherer JAR
+	jmxMainClass = "io.opentelemetry.contrib.jmxmetrics.JmxMetrics"
+	// jmxDialTimeout is the timeout of the connection to the endpoint, when checking whether to fall back to Jolokia
+	jmxDialTimeout = 5 * time.Second
+)
 
 var _ receiver.Metrics = (*jmxMetricReceiver)(nil)
 
@@ -37,6 +43,9 @@ type jmxMetricReceiver struct {
 	nextConsumer consumer.Metrics
 	configFile   string
 	cancel       context.CancelFunc
+	// jolokia collects the metrics instead of the JMX Metric Gatherer subprocess, when the endpoint isn't reachable
+	jolokia *jolokiaClient
+	wg      sync.WaitGroup
 }
 
 func newJMXMetricReceiver(
@@ -55,6 +64,10 @@ func newJMXMetricReceiver(
 func (jmx *jmxMetricReceiver) Start(ctx context.Context, host component.Host) error {
 	jmx.logger.Debug("starting JMX Receiver")
 
+	if jmx.useJolokia(ctx) {
+		return jmx.startJolokia(ctx, host)
+	}
+
 	ctx, jmx.cancel = context.WithCancel(ctx)
 
 	var err error
@@ -116,7 +129,73 @@ func (jmx *jmxMetricReceiver) Start(ctx context.Context, host component.Host) er
 	return jmx.subprocess.Start(ctx)
 }
 
+// useJolokia returns whether the metrics are collected from the Jolokia agent, when it is configured and the
+// endpoint isn't set or isn't reachable.
+func (jmx *jmxMetricReceiver) useJolokia(ctx context.Context) bool {
+	if jmx.config.Jolokia.Endpoint == "" {
+		return false
+	}
+	if jmx.config.Endpoint == "" {
+		return true
+	}
+	address, err := jmxAddress(jmx.config.Endpoint)
+	if err != nil {
+		jmx.logger.Debug("failed to parse the address of the endpoint, not falling back to Jolokia", zap.Error(err))
+		return false
+	}
+	conn, err := (&net.Dialer{Timeout: jmxDialTimeout}).DialContext(ctx, "tcp", address)
+	if err != nil {
+		jmx.logger.Warn("the endpoint is not reachable, falling back to Jolokia",
+			zap.String("endpoint", jmx.config.Endpoint), zap.String("jolokia", jmx.config.Jolokia.Endpoint), zap.Error(err))
+		return true
+	}
+	_ = conn.Close()
+	return false
+}
+
+func (jmx *jmxMetricReceiver) startJolokia(ctx context.Context, host component.Host) error {
+	httpClient, err := jmx.config.Jolokia.ToClient(ctx, host, jmx.params.TelemetrySettings)
+	if err != nil {
+		return fmt.Errorf("failed to create the Jolokia HTTP client: %w", err)
+	}
+	jmx.jolokia = newJolokiaClient(httpClient, jmx.config)
+
+	var pollCtx context.Context
+	pollCtx, jmx.cancel = context.WithCancel(context.Background())
+	jmx.wg.Add(1)
+	go func() {
+		defer jmx.wg.Done()
+		ticker := time.NewTicker(jmx.config.CollectionInterval)
+		defer ticker.Stop()
+		for {
+			jmx.collectJolokia(pollCtx)
+			select {
+			case <-ticker.C:
+			case <-pollCtx.Done():
+				return
+			}
+		}
+	}()
+	return nil
+}
+
+func (jmx *jmxMetricReceiver) collectJolokia(ctx context.Context) {
+	md, err := jmx.jolokia.metrics(ctx)
+	if err != nil {
+		jmx.logger.Error("failed to collect the metrics from Jolokia", zap.Error(err))
+		return
+	}
+	if err = jmx.nextConsumer.ConsumeMetrics(ctx, md); err != nil {
+		jmx.logger.Error("failed to consume the Jolokia metrics", zap.Error(err))
+	}
+}
+
 func (jmx *jmxMetricReceiver) Shutdown(ctx context.Context) error {
+	if jmx.jolokia != nil {
+		jmx.cancel()
+		jmx.wg.Wait()
+		return nil
+	}
 	if jmx.subprocess == nil {
 		return nil
 	}
@@ -138,6 +217,29 @@ func (jmx *jmxMetricReceiver) Shutdown(ctx context.Context) error {
 	return removeErr
 }
 
+// jmxAddress returns the host:port to connect to for the endpoint, either a host:port or a JMX service URL, e.g.
+// service:jmx:rmi:///jndi/rmi://<host>:<port>/jmxrmi or service:jmx:jmxmp://<host>:<port>.
+func jmxAddress(endpoint string) (string, error) {
+	if !strings.HasPrefix(endpoint, "service:jmx:") {
+		if _, _, err := net.SplitHostPort(endpoint); err != nil {
+			return "", err
+		}
+		return endpoint, nil
+	}
+	_, serviceURL, _ := strings.Cut(strings.TrimPrefix(endpoint, "service:jmx:"), "://")
+	hostPort, path, _ := strings.Cut(serviceURL, "/")
+	if hostPort == "" {
+		// the address of the RMI registry is in the JNDI path
+		if _, registry, ok := strings.Cut(path, "rmi://"); ok {
+			hostPort, _, _ = strings.Cut(registry, "/")
+		}
+	}
+	if _, _, err := net.SplitHostPort(hostPort); err != nil {
+		return "", fmt.Errorf("no host and port in %q: %w", endpoint, err)
+	}
+	return hostPort, nil
+}
+
 func (jmx *jmxMetricReceiver) buildOTLPReceiver() (receiver.Metrics, error) {
 	endpoint := jmx.config.OTLPExporterConfig.Endpoint
 	host, port, err := net.SplitHostPort(endpoint)
@@ -188,3 +188,48 @@ func TestBuildOTLPReceiverInvalidEndpoints(t *testing.T) {
 		})
 	}
 }
+
+func TestReceiverJolokiaFallback(t *testing.T) {
+	srv := newJolokiaServer(t)
+	defer srv.Close()
+
+	// nothing listens on the endpoint, as if remote JMX were firewalled
+	config := newJolokiaTestConfig(srv.URL)
+	config.Endpoint = testutil.GetAvailableLocalAddress(t)
+	config.CollectionInterval = 10 * time.Millisecond
+
+	sink := &consumertest.MetricsSink{}
+	receiver := newJMXMetricReceiver(receivertest.NewNopCreateSettings(), config, sink)
+	require.NoError(t, receiver.Start(context.Background(), componenttest.NewNopHost()))
+	require.Eventually(t, func() bool {
+		return len(sink.AllMetrics()) >= 2
+	}, 5*time.Second, 10*time.Millisecond)
+	require.NoError(t, receiver.Shutdown(context.Background()))
+	require.Nil(t, receiver.subprocess)
+}
+
+func TestJMXAddress(t *testing.T) {
+	tests := []struct {
+		endpoint string
+		expected string
+		err      bool
+	}{
+		{endpoint: "myhost:12345", expected: "myhost:12345"},
+		{endpoint: "service:jmx:rmi:///jndi/rmi://myhost:12345/jmxrmi", expected: "myhost:12345"},
+		{endpoint: "service:jmx:jmxmp://myhost:9876", expected: "myhost:9876"},
+		{endpoint: "service:jmx:remote+http://myhost:9990", expected: "myhost:9990"},
+		{endpoint: "service:jmx:protocol:sap", err: true},
+		{endpoint: "myhost", err: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.endpoint, func(t *testing.T) {
+			address, err := jmxAddress(tt.endpoint)
+			if tt.err {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tt.expected, address)
+		})
+	}
+}
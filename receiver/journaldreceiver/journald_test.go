@@ -9,6 +9,7 @@ import (
 	"context"
 	"path/filepath"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -67,6 +68,7 @@ func testdataConfigYaml() *JournaldConfig {
 			c.Priority = "info"
 			dir := "/run/log/journal"
 			c.Directory = &dir
+			c.MaxLookback = 2 * time.Hour
 			return *c
 		}(),
 	}
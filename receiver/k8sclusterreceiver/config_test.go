@@ -14,6 +14,7 @@ import (
 	"go.opentelemetry.io/collector/confmap/confmaptest"
 
 	"github.com/open-telemetry/opentelemetry-collector-contrib/internal/k8sconfig"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/receiver/k8sclusterreceiver/internal/customresource"
 	"github.com/open-telemetry/opentelemetry-collector-contrib/receiver/k8sclusterreceiver/internal/metadata"
 )
 
@@ -60,6 +61,36 @@ func TestLoadConfig(t *testing.T) {
 				MetricsBuilderConfig:       metadata.DefaultMetricsBuilderConfig(),
 			},
 		},
+		{
+			id: component.NewIDWithName(metadata.Type, "custom_resources"),
+			expected: func() component.Config {
+				cfg := createDefaultConfig().(*Config)
+				cfg.CustomResources = []customresource.Config{
+					{
+						Group:   "argoproj.io",
+						Version: "v1alpha1",
+						Kind:    "Rollout",
+						Metrics: []customresource.MetricConfig{
+							{
+								Name:        "k8s.rollout.phase",
+								Description: "The current phase of the rollout.",
+								Field:       "status.phase",
+								States:      []string{"Healthy", "Progressing", "Paused", "Degraded"},
+							},
+							{
+								Name:  "k8s.rollout.available_replicas",
+								Field: "status.availableReplicas",
+								Unit:  "{replica}",
+								Attributes: []customresource.AttributeConfig{
+									{Name: "strategy", Field: "spec.strategy.canary.trafficRouting.name"},
+								},
+							},
+						},
+					},
+				}
+				return cfg
+			}(),
+		},
 	}
 
 	for _, tt := range tests {
@@ -96,4 +127,17 @@ func TestInvalidConfig(t *testing.T) {
 	err = component.ValidateConfig(cfg)
 	assert.Error(t, err)
 	assert.Equal(t, "\"wrong\" is not a supported distribution. Must be one of: \"openshift\", \"kubernetes\"", err.Error())
+
+	// Invalid custom resource
+	cfg = &Config{
+		APIConfig:          k8sconfig.APIConfig{AuthType: k8sconfig.AuthTypeNone},
+		Distribution:       distributionKubernetes,
+		CollectionInterval: 30 * time.Second,
+		CustomResources: []customresource.Config{
+			{Group: "argoproj.io", Version: "v1alpha1", Kind: "Rollout"},
+		},
+	}
+	err = component.ValidateConfig(cfg)
+	assert.Error(t, err)
+	assert.Equal(t, "custom resource \"Rollout\" must define at least one metric", err.Error())
 }
@@ -0,0 +1,85 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package customresource // import "github.com/open-telemetry/opentelemetry-collector-contrib/receiver/k8sclusterreceiver/internal/customresource"
+
+import (
+	"errors"
+	"fmt"
+
+	"k8s.io/apimachinery/pkg/runtime/schema"
+)
+
+// Config defines the metrics to derive from the objects of a custom resource.
+type Config struct {
+	// Group of the custom resource, e.g. "argoproj.io".
+	Group string `mapstructure:"group"`
+	// Version of the custom resource, e.g. "v1alpha1".
+	Version string `mapstructure:"version"`
+	// Kind of the custom resource, e.g. "Rollout".
+	Kind string `mapstructure:"kind"`
+	// Metrics to derive from each object of the custom resource.
+	Metrics []MetricConfig `mapstructure:"metrics"`
+}
+
+// MetricConfig defines a metric derived from a field of a custom resource object.
+type MetricConfig struct {
+	// Name of the metric.
+	Name string `mapstructure:"name"`
+	// Description of the metric.
+	Description string `mapstructure:"description"`
+	// Unit of the metric.
+	Unit string `mapstructure:"unit"`
+	// Field is the path to the field the metric value is read from, e.g. "status.replicas"
+	// or "status.conditions[type=Ready].status". Numbers are reported as is, booleans as 0 or 1
+	// and RFC3339 timestamps as seconds since the epoch.
+	Field string `mapstructure:"field"`
+	// States, if set, reports the field as a state set: one data point per state, with the
+	// "state" attribute, set to 1 for the current value of the field and 0 for the others.
+	States []string `mapstructure:"states"`
+	// Attributes to set on the data points from other fields of the object.
+	Attributes []AttributeConfig `mapstructure:"attributes"`
+}
+
+// AttributeConfig defines a data point attribute read from a field of a custom resource object.
+type AttributeConfig struct {
+	// Name of the attribute.
+	Name string `mapstructure:"name"`
+	// Field is the path to the field the attribute value is read from.
+	Field string `mapstructure:"field"`
+}
+
+// GroupVersionKind returns the GroupVersionKind of the custom resource.
+func (c Config) GroupVersionKind() schema.GroupVersionKind {
+	return schema.GroupVersionKind{Group: c.Group, Version: c.Version, Kind: c.Kind}
+}
+
+func (c Config) Validate() error {
+	var errs []error
+	if c.Version == "" {
+		errs = append(errs, errors.New("custom resource 'version' cannot be empty"))
+	}
+	if c.Kind == "" {
+		errs = append(errs, errors.New("custom resource 'kind' cannot be empty"))
+	}
+	if len(c.Metrics) == 0 {
+		errs = append(errs, fmt.Errorf("custom resource %q must define at least one metric", c.Kind))
+	}
+	for _, m := range c.Metrics {
+		if m.Name == "" {
+			errs = append(errs, fmt.Errorf("custom resource %q metric 'name' cannot be empty", c.Kind))
+		}
+		if _, err := parsePath(m.Field); err != nil {
+			errs = append(errs, fmt.Errorf("custom resource %q metric %q: %w", c.Kind, m.Name, err))
+		}
+		for _, a := range m.Attributes {
+			if a.Name == "" {
+				errs = append(errs, fmt.Errorf("custom resource %q metric %q attribute 'name' cannot be empty", c.Kind, m.Name))
+			}
+			if _, err := parsePath(a.Field); err != nil {
+				errs = append(errs, fmt.Errorf("custom resource %q metric %q attribute %q: %w", c.Kind, m.Name, a.Name, err))
+			}
+		}
+	}
+	return errors.Join(errs...)
+}
@@ -0,0 +1,72 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package customresource
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestConfigValidate(t *testing.T) {
+	tests := []struct {
+		name string
+		cfg  Config
+		err  string
+	}{
+		{
+			name: "valid",
+			cfg: Config{
+				Version: "v1",
+				Kind:    "Certificate",
+				Metrics: []MetricConfig{{Name: "certificate.expiration", Field: "status.notAfter"}},
+			},
+		},
+		{
+			name: "missing_version_and_kind",
+			cfg: Config{
+				Metrics: []MetricConfig{{Name: "certificate.expiration", Field: "status.notAfter"}},
+			},
+			err: "custom resource 'version' cannot be empty\ncustom resource 'kind' cannot be empty",
+		},
+		{
+			name: "no_metrics",
+			cfg:  Config{Version: "v1", Kind: "Certificate"},
+			err:  `custom resource "Certificate" must define at least one metric`,
+		},
+		{
+			name: "invalid_metric",
+			cfg: Config{
+				Version: "v1",
+				Kind:    "Certificate",
+				Metrics: []MetricConfig{{Field: "status.conditions[type=Ready"}},
+			},
+			err: "custom resource \"Certificate\" metric 'name' cannot be empty\n" +
+				`custom resource "Certificate" metric "": invalid field "status.conditions[type=Ready": unterminated '['`,
+		},
+		{
+			name: "invalid_attribute",
+			cfg: Config{
+				Version: "v1",
+				Kind:    "Certificate",
+				Metrics: []MetricConfig{{
+					Name:       "certificate.expiration",
+					Field:      "status.notAfter",
+					Attributes: []AttributeConfig{{Name: "issuer"}},
+				}},
+			},
+			err: `custom resource "Certificate" metric "certificate.expiration" attribute "issuer": 'field' cannot be empty`,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.cfg.Validate()
+			if tt.err == "" {
+				assert.NoError(t, err)
+				return
+			}
+			assert.EqualError(t, err, tt.err)
+		})
+	}
+}
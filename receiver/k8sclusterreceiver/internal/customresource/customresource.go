@@ -0,0 +1,130 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package customresource // import "github.com/open-telemetry/opentelemetry-collector-contrib/receiver/k8sclusterreceiver/internal/customresource"
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"go.opentelemetry.io/collector/pdata/pcommon"
+	"go.opentelemetry.io/collector/pdata/pmetric"
+	"go.opentelemetry.io/collector/receiver"
+	conventions "go.opentelemetry.io/collector/semconv/v1.18.0"
+	"go.uber.org/zap"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+)
+
+const stateAttribute = "state"
+
+// CustomMetrics returns the metrics derived from a custom resource object according to cfg.
+// The resource identifies the object with the "k8s.<kind>.name" and "k8s.<kind>.uid" attributes,
+// following the naming of the built-in Kubernetes objects.
+func CustomMetrics(set receiver.CreateSettings, cfg Config, obj *unstructured.Unstructured, ts pcommon.Timestamp) pmetric.ResourceMetrics {
+	rm := pmetric.NewResourceMetrics()
+	sm := rm.ScopeMetrics().AppendEmpty()
+
+	for _, metricCfg := range cfg.Metrics {
+		path, err := parsePath(metricCfg.Field)
+		if err != nil {
+			continue
+		}
+		value, ok := lookup(obj.Object, path)
+		if !ok {
+			set.Logger.Debug("custom resource field not found", zap.String("kind", cfg.Kind),
+				zap.String("name", obj.GetName()), zap.String("field", metricCfg.Field))
+			continue
+		}
+
+		m := pmetric.NewMetric()
+		m.SetName(metricCfg.Name)
+		m.SetDescription(metricCfg.Description)
+		m.SetUnit(metricCfg.Unit)
+		dps := m.SetEmptyGauge().DataPoints()
+
+		if len(metricCfg.States) > 0 {
+			current := fmt.Sprint(value)
+			for _, state := range metricCfg.States {
+				dp := dps.AppendEmpty()
+				dp.SetTimestamp(ts)
+				dp.SetDoubleValue(0)
+				if state == current {
+					dp.SetDoubleValue(1)
+				}
+				setAttributes(dp.Attributes(), obj, metricCfg.Attributes)
+				dp.Attributes().PutStr(stateAttribute, state)
+			}
+		} else {
+			v, ok := toFloat(value)
+			if !ok {
+				set.Logger.Debug("custom resource field is not numeric", zap.String("kind", cfg.Kind),
+					zap.String("name", obj.GetName()), zap.String("field", metricCfg.Field))
+				continue
+			}
+			dp := dps.AppendEmpty()
+			dp.SetTimestamp(ts)
+			dp.SetDoubleValue(v)
+			setAttributes(dp.Attributes(), obj, metricCfg.Attributes)
+		}
+		m.MoveTo(sm.Metrics().AppendEmpty())
+	}
+
+	if sm.Metrics().Len() == 0 {
+		return pmetric.NewResourceMetrics()
+	}
+
+	rm.SetSchemaUrl(conventions.SchemaURL)
+	sm.Scope().SetName("otelcol/k8sclusterreceiver")
+	sm.Scope().SetVersion(set.BuildInfo.Version)
+
+	kind := strings.ToLower(cfg.Kind)
+	attrs := rm.Resource().Attributes()
+	attrs.PutStr(fmt.Sprintf("k8s.%s.name", kind), obj.GetName())
+	attrs.PutStr(fmt.Sprintf("k8s.%s.uid", kind), string(obj.GetUID()))
+	if namespace := obj.GetNamespace(); namespace != "" {
+		attrs.PutStr(conventions.AttributeK8SNamespaceName, namespace)
+	}
+	return rm
+}
+
+func setAttributes(attrs pcommon.Map, obj *unstructured.Unstructured, cfgs []AttributeConfig) {
+	for _, attrCfg := range cfgs {
+		path, err := parsePath(attrCfg.Field)
+		if err != nil {
+			continue
+		}
+		if value, ok := lookup(obj.Object, path); ok {
+			attrs.PutStr(attrCfg.Name, fmt.Sprint(value))
+		}
+	}
+}
+
+// toFloat converts a field value to a metric value.
+func toFloat(value any) (float64, bool) {
+	switch v := value.(type) {
+	case int64:
+		return float64(v), true
+	case int:
+		return float64(v), true
+	case float64:
+		return v, true
+	case bool:
+		if v {
+			return 1, true
+		}
+		return 0, true
+	case string:
+		if f, err := strconv.ParseFloat(v, 64); err == nil {
+			return f, true
+		}
+		if b, err := strconv.ParseBool(v); err == nil {
+			return toFloat(b)
+		}
+		if t, err := time.Parse(time.RFC3339, v); err == nil {
+			return float64(t.Unix()), true
+		}
+	}
+	return 0, false
+}
@@ -0,0 +1,127 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package customresource
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/collector/pdata/pcommon"
+	"go.opentelemetry.io/collector/pdata/pmetric"
+	"go.opentelemetry.io/collector/receiver/receivertest"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+)
+
+func newRollout() *unstructured.Unstructured {
+	return &unstructured.Unstructured{Object: map[string]any{
+		"apiVersion": "argoproj.io/v1alpha1",
+		"kind":       "Rollout",
+		"metadata": map[string]any{
+			"name":      "checkout",
+			"namespace": "shop",
+			"uid":       "test-rollout-uid",
+		},
+		"spec": map[string]any{
+			"strategy": map[string]any{"canary": map[string]any{}},
+		},
+		"status": map[string]any{
+			"phase":             "Progressing",
+			"availableReplicas": int64(2),
+			"paused":            false,
+			"restartedAt":       "2024-05-01T10:00:00Z",
+			"conditions": []any{
+				map[string]any{"type": "Available", "status": "True", "reason": "AvailableReason"},
+			},
+		},
+	}}
+}
+
+func TestCustomMetrics(t *testing.T) {
+	cfg := Config{
+		Group:   "argoproj.io",
+		Version: "v1alpha1",
+		Kind:    "Rollout",
+		Metrics: []MetricConfig{
+			{
+				Name:        "k8s.rollout.phase",
+				Description: "The current phase of the rollout.",
+				Field:       "status.phase",
+				States:      []string{"Healthy", "Progressing", "Degraded"},
+			},
+			{
+				Name:  "k8s.rollout.available_replicas",
+				Unit:  "{replica}",
+				Field: "status.availableReplicas",
+				Attributes: []AttributeConfig{
+					{Name: "reason", Field: "status.conditions[type=Available].reason"},
+					{Name: "missing", Field: "status.missing"},
+				},
+			},
+			{Name: "k8s.rollout.paused", Field: "status.paused"},
+			{Name: "k8s.rollout.available", Field: "status.conditions[type=Available].status"},
+			{Name: "k8s.rollout.restarted_at", Unit: "s", Field: "status.restartedAt"},
+			{Name: "k8s.rollout.not_found", Field: "status.notFound"},
+			{Name: "k8s.rollout.not_numeric", Field: "status.phase"},
+		},
+	}
+	ts := pcommon.NewTimestampFromTime(time.Now())
+
+	rm := CustomMetrics(receivertest.NewNopCreateSettings(), cfg, newRollout(), ts)
+
+	assert.Equal(t, map[string]any{
+		"k8s.rollout.name":   "checkout",
+		"k8s.rollout.uid":    "test-rollout-uid",
+		"k8s.namespace.name": "shop",
+	}, rm.Resource().Attributes().AsRaw())
+	require.Equal(t, 1, rm.ScopeMetrics().Len())
+	sm := rm.ScopeMetrics().At(0)
+	assert.Equal(t, "otelcol/k8sclusterreceiver", sm.Scope().Name())
+
+	metrics := map[string]pmetric.Metric{}
+	for i := 0; i < sm.Metrics().Len(); i++ {
+		metrics[sm.Metrics().At(i).Name()] = sm.Metrics().At(i)
+	}
+	require.Len(t, metrics, 5)
+
+	phase := metrics["k8s.rollout.phase"]
+	assert.Equal(t, "The current phase of the rollout.", phase.Description())
+	require.Equal(t, 3, phase.Gauge().DataPoints().Len())
+	for i, expected := range map[string]float64{"Healthy": 0, "Progressing": 1, "Degraded": 0} {
+		found := false
+		for j := 0; j < phase.Gauge().DataPoints().Len(); j++ {
+			dp := phase.Gauge().DataPoints().At(j)
+			if state, _ := dp.Attributes().Get("state"); state.Str() == i {
+				found = true
+				assert.Equal(t, expected, dp.DoubleValue())
+				assert.Equal(t, ts, dp.Timestamp())
+			}
+		}
+		assert.True(t, found, i)
+	}
+
+	replicas := metrics["k8s.rollout.available_replicas"]
+	assert.Equal(t, "{replica}", replicas.Unit())
+	require.Equal(t, 1, replicas.Gauge().DataPoints().Len())
+	assert.Equal(t, 2.0, replicas.Gauge().DataPoints().At(0).DoubleValue())
+	assert.Equal(t, map[string]any{"reason": "AvailableReason"}, replicas.Gauge().DataPoints().At(0).Attributes().AsRaw())
+
+	assert.Equal(t, 0.0, metrics["k8s.rollout.paused"].Gauge().DataPoints().At(0).DoubleValue())
+	assert.Equal(t, 1.0, metrics["k8s.rollout.available"].Gauge().DataPoints().At(0).DoubleValue())
+	assert.Equal(t, float64(time.Date(2024, 5, 1, 10, 0, 0, 0, time.UTC).Unix()),
+		metrics["k8s.rollout.restarted_at"].Gauge().DataPoints().At(0).DoubleValue())
+}
+
+func TestCustomMetricsNoMatchingFields(t *testing.T) {
+	cfg := Config{
+		Version: "v1alpha1",
+		Kind:    "Rollout",
+		Metrics: []MetricConfig{{Name: "k8s.rollout.not_found", Field: "status.notFound"}},
+	}
+
+	rm := CustomMetrics(receivertest.NewNopCreateSettings(), cfg, newRollout(), 0)
+	assert.Equal(t, 0, rm.ScopeMetrics().Len())
+	assert.Equal(t, 0, rm.Resource().Attributes().Len())
+}
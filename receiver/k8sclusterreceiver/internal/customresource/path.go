@@ -0,0 +1,99 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package customresource // import "github.com/open-telemetry/opentelemetry-collector-contrib/receiver/k8sclusterreceiver/internal/customresource"
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// pathSegment is a step of a field path: a map key, optionally followed by
+// either an index or a key=value selector on the elements of a list.
+type pathSegment struct {
+	key string
+
+	index    int
+	hasIndex bool
+
+	selectorKey   string
+	selectorValue string
+}
+
+// parsePath parses a dot separated field path, e.g. "status.conditions[type=Ready].status".
+func parsePath(path string) ([]pathSegment, error) {
+	if path == "" {
+		return nil, errors.New("'field' cannot be empty")
+	}
+
+	parts := strings.Split(path, ".")
+	segments := make([]pathSegment, 0, len(parts))
+	for _, part := range parts {
+		seg := pathSegment{key: part}
+		if open := strings.IndexByte(part, '['); open >= 0 {
+			if !strings.HasSuffix(part, "]") {
+				return nil, fmt.Errorf("invalid field %q: unterminated '['", path)
+			}
+			seg.key = part[:open]
+			selector := part[open+1 : len(part)-1]
+			if key, value, ok := strings.Cut(selector, "="); ok {
+				if key == "" {
+					return nil, fmt.Errorf("invalid field %q: empty selector key", path)
+				}
+				seg.selectorKey, seg.selectorValue = key, value
+			} else {
+				index, err := strconv.Atoi(selector)
+				if err != nil || index < 0 {
+					return nil, fmt.Errorf("invalid field %q: %q is neither an index nor a key=value selector", path, selector)
+				}
+				seg.index, seg.hasIndex = index, true
+			}
+		}
+		if seg.key == "" {
+			return nil, fmt.Errorf("invalid field %q: empty key", path)
+		}
+		segments = append(segments, seg)
+	}
+	return segments, nil
+}
+
+// lookup returns the value at path in obj, and whether it was found.
+func lookup(obj map[string]any, path []pathSegment) (any, bool) {
+	var current any = obj
+	for _, seg := range path {
+		m, ok := current.(map[string]any)
+		if !ok {
+			return nil, false
+		}
+		if current, ok = m[seg.key]; !ok {
+			return nil, false
+		}
+
+		switch {
+		case seg.hasIndex:
+			list, ok := current.([]any)
+			if !ok || seg.index >= len(list) {
+				return nil, false
+			}
+			current = list[seg.index]
+		case seg.selectorKey != "":
+			list, ok := current.([]any)
+			if !ok {
+				return nil, false
+			}
+			found := false
+			for _, item := range list {
+				if itemMap, ok := item.(map[string]any); ok && fmt.Sprint(itemMap[seg.selectorKey]) == seg.selectorValue {
+					current, found = item, true
+					break
+				}
+			}
+			if !found {
+				return nil, false
+			}
+		}
+	}
+	return current, true
+}
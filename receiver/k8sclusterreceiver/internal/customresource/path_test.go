@@ -0,0 +1,69 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package customresource
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParsePathErrors(t *testing.T) {
+	tests := []struct {
+		path string
+		err  string
+	}{
+		{path: "", err: "'field' cannot be empty"},
+		{path: "status..phase", err: `invalid field "status..phase": empty key`},
+		{path: "status.conditions[0", err: `invalid field "status.conditions[0": unterminated '['`},
+		{path: "status.conditions[=Ready]", err: `invalid field "status.conditions[=Ready]": empty selector key`},
+		{path: "status.conditions[-1]", err: `invalid field "status.conditions[-1]": "-1" is neither an index nor a key=value selector`},
+		{path: "[0]", err: `invalid field "[0]": empty key`},
+	}
+	for _, tt := range tests {
+		t.Run(tt.path, func(t *testing.T) {
+			_, err := parsePath(tt.path)
+			assert.EqualError(t, err, tt.err)
+		})
+	}
+}
+
+func TestLookup(t *testing.T) {
+	obj := map[string]any{
+		"status": map[string]any{
+			"phase":    "Healthy",
+			"replicas": int64(3),
+			"conditions": []any{
+				map[string]any{"type": "Available", "status": "True"},
+				map[string]any{"type": "Progressing", "status": "False"},
+			},
+		},
+	}
+
+	tests := []struct {
+		path     string
+		expected any
+		found    bool
+	}{
+		{path: "status.phase", expected: "Healthy", found: true},
+		{path: "status.replicas", expected: int64(3), found: true},
+		{path: "status.conditions[1].type", expected: "Progressing", found: true},
+		{path: "status.conditions[type=Available].status", expected: "True", found: true},
+		{path: "status.conditions[type=Unknown].status"},
+		{path: "status.conditions[2].type"},
+		{path: "status.phase.value"},
+		{path: "status.phase[0]"},
+		{path: "spec.replicas"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.path, func(t *testing.T) {
+			path, err := parsePath(tt.path)
+			require.NoError(t, err)
+			value, found := lookup(obj, path)
+			assert.Equal(t, tt.found, found)
+			assert.Equal(t, tt.expected, value)
+		})
+	}
+}
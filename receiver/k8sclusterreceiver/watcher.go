@@ -7,6 +7,7 @@ import (
 	"context"
 	"fmt"
 	"reflect"
+	"strings"
 	"sync/atomic"
 	"time"
 
@@ -22,7 +23,11 @@ import (
 	batchv1 "k8s.io/api/batch/v1"
 	corev1 "k8s.io/api/core/v1"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/client-go/dynamic"
+	"k8s.io/client-go/dynamic/dynamicinformer"
 	"k8s.io/client-go/informers"
 	"k8s.io/client-go/kubernetes"
 	"k8s.io/client-go/tools/cache"
@@ -52,6 +57,7 @@ type sharedInformer interface {
 type resourceWatcher struct {
 	client              kubernetes.Interface
 	osQuotaClient       quotaclientset.Interface
+	dynamicClient       dynamic.Interface
 	informerFactories   []sharedInformer
 	metadataStore       *metadata.Store
 	logger              *zap.Logger
@@ -65,6 +71,7 @@ type resourceWatcher struct {
 	// For mocking.
 	makeClient               func(apiConf k8sconfig.APIConfig) (kubernetes.Interface, error)
 	makeOpenShiftQuotaClient func(apiConf k8sconfig.APIConfig) (quotaclientset.Interface, error)
+	makeDynamicClient        func(apiConf k8sconfig.APIConfig) (dynamic.Interface, error)
 }
 
 type metadataConsumer func(metadata []*experimentalmetricmetadata.MetadataUpdate) error
@@ -80,6 +87,7 @@ func newResourceWatcher(set receiver.CreateSettings, cfg *Config, metadataStore
 		config:                   cfg,
 		makeClient:               k8sconfig.MakeClient,
 		makeOpenShiftQuotaClient: k8sconfig.MakeOpenShiftQuotaClient,
+		makeDynamicClient:        k8sconfig.MakeDynamicClient,
 	}
 }
 
@@ -97,6 +105,13 @@ func (rw *resourceWatcher) initialize() error {
 		}
 	}
 
+	if len(rw.config.CustomResources) > 0 {
+		rw.dynamicClient, err = rw.makeDynamicClient(rw.config.APIConfig)
+		if err != nil {
+			return fmt.Errorf("Failed to create Kubernetes dynamic client: %w", err)
+		}
+	}
+
 	err = rw.prepareSharedInformerFactory()
 	if err != nil {
 		return err
@@ -153,25 +168,74 @@ func (rw *resourceWatcher) prepareSharedInformerFactory() error {
 	}
 	rw.informerFactories = append(rw.informerFactories, factory)
 
+	if rw.dynamicClient != nil {
+		if err := rw.prepareCustomResourceInformers(); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// prepareCustomResourceInformers sets up a dynamic informer for each configured custom resource.
+// Custom resources that aren't served by the API server are skipped with a warning.
+func (rw *resourceWatcher) prepareCustomResourceInformers() error {
+	factory := dynamicinformer.NewDynamicSharedInformerFactory(rw.dynamicClient, rw.config.MetadataCollectionInterval)
+	for _, cr := range rw.config.CustomResources {
+		kind := cr.GroupVersionKind()
+		resource, supported, err := rw.findAPIResource(kind)
+		if err != nil {
+			return err
+		}
+		if !supported {
+			rw.logger.Warn("Server doesn't support the group version of the custom resource",
+				zap.String("group version kind", kind.String()))
+			continue
+		}
+		rw.setupInformer(kind, factory.ForResource(kind.GroupVersion().WithResource(resource.Name)).Informer())
+	}
+	rw.informerFactories = append(rw.informerFactories, dynamicSharedInformer{factory})
 	return nil
 }
 
 func (rw *resourceWatcher) isKindSupported(gvk schema.GroupVersionKind) (bool, error) {
+	_, supported, err := rw.findAPIResource(gvk)
+	return supported, err
+}
+
+// findAPIResource returns the API resource serving the given kind, if any.
+func (rw *resourceWatcher) findAPIResource(gvk schema.GroupVersionKind) (metav1.APIResource, bool, error) {
 	resources, err := rw.client.Discovery().ServerResourcesForGroupVersion(gvk.GroupVersion().String())
 	if err != nil {
 		if apierrors.IsNotFound(err) { // if the discovery endpoint isn't present, assume group version is not supported
 			rw.logger.Debug("Group version is not supported", zap.String("group", gvk.GroupVersion().String()))
-			return false, nil
+			return metav1.APIResource{}, false, nil
 		}
-		return false, fmt.Errorf("failed to fetch group version details: %w", err)
+		return metav1.APIResource{}, false, fmt.Errorf("failed to fetch group version details: %w", err)
 	}
 
 	for _, r := range resources.APIResources {
-		if r.Kind == gvk.Kind {
-			return true, nil
+		// Skip subresources, e.g. "deployments/status", which share the kind of their parent.
+		if r.Kind == gvk.Kind && !strings.Contains(r.Name, "/") {
+			return r, true, nil
 		}
 	}
-	return false, nil
+	return metav1.APIResource{}, false, nil
+}
+
+// dynamicSharedInformer adapts a dynamic informer factory to the sharedInformer interface.
+type dynamicSharedInformer struct {
+	dynamicinformer.DynamicSharedInformerFactory
+}
+
+// All custom resources share the same object type, so they are reported as synced
+// only once every one of them is.
+func (d dynamicSharedInformer) WaitForCacheSync(stopCh <-chan struct{}) map[reflect.Type]bool {
+	synced := true
+	for _, ok := range d.DynamicSharedInformerFactory.WaitForCacheSync(stopCh) {
+		synced = synced && ok
+	}
+	return map[reflect.Type]bool{reflect.TypeOf(&unstructured.Unstructured{}): synced}
 }
 
 func (rw *resourceWatcher) setupInformerForKind(kind schema.GroupVersionKind, factory informers.SharedInformerFactory) {
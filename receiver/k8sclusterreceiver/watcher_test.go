@@ -17,13 +17,17 @@ import (
 	"go.uber.org/zap/zaptest/observer"
 	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/runtime/schema"
 	"k8s.io/apimachinery/pkg/types"
+	dynamicfake "k8s.io/client-go/dynamic/fake"
 	"k8s.io/client-go/informers"
 	"k8s.io/client-go/kubernetes/fake"
 
 	"github.com/open-telemetry/opentelemetry-collector-contrib/internal/common/maps"
 	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/experimentalmetricmetadata"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/receiver/k8sclusterreceiver/internal/customresource"
 	"github.com/open-telemetry/opentelemetry-collector-contrib/receiver/k8sclusterreceiver/internal/gvk"
 	"github.com/open-telemetry/opentelemetry-collector-contrib/receiver/k8sclusterreceiver/internal/metadata"
 	"github.com/open-telemetry/opentelemetry-collector-contrib/receiver/k8sclusterreceiver/internal/testutils"
@@ -203,6 +207,65 @@ func TestPrepareSharedInformerFactory(t *testing.T) {
 	}
 }
 
+func TestPrepareCustomResourceInformers(t *testing.T) {
+	rolloutGVK := schema.GroupVersionKind{Group: "argoproj.io", Version: "v1alpha1", Kind: "Rollout"}
+	certificateGVK := schema.GroupVersionKind{Group: "cert-manager.io", Version: "v1", Kind: "Certificate"}
+
+	client := newFakeClientWithAllResources()
+	client.Resources = append(client.Resources, &metav1.APIResourceList{
+		GroupVersion: rolloutGVK.GroupVersion().String(),
+		APIResources: []metav1.APIResource{
+			{Name: "rollouts/status", Kind: rolloutGVK.Kind},
+			{Name: "rollouts", Kind: rolloutGVK.Kind},
+		},
+	})
+
+	rollout := &unstructured.Unstructured{}
+	rollout.SetGroupVersionKind(rolloutGVK)
+	rollout.SetNamespace("default")
+	rollout.SetName("checkout")
+	dynamicClient := dynamicfake.NewSimpleDynamicClientWithCustomListKinds(runtime.NewScheme(),
+		map[schema.GroupVersionResource]string{rolloutGVK.GroupVersion().WithResource("rollouts"): "RolloutList"},
+		rollout)
+
+	obs, logs := observer.New(zap.WarnLevel)
+	rw := &resourceWatcher{
+		client:        client,
+		dynamicClient: dynamicClient,
+		logger:        zap.New(obs),
+		metadataStore: metadata.NewStore(),
+		config: &Config{
+			CustomResources: []customresource.Config{
+				{Group: rolloutGVK.Group, Version: rolloutGVK.Version, Kind: rolloutGVK.Kind},
+				{Group: certificateGVK.Group, Version: certificateGVK.Version, Kind: certificateGVK.Kind},
+			},
+		},
+	}
+
+	require.NoError(t, rw.prepareSharedInformerFactory())
+	assert.NotNil(t, rw.metadataStore.Get(rolloutGVK))
+	assert.Nil(t, rw.metadataStore.Get(certificateGVK))
+	require.Equal(t, 1, logs.Len())
+	assert.Equal(t, "Server doesn't support the group version of the custom resource", logs.All()[0].Message)
+
+	stopCh := make(chan struct{})
+	defer close(stopCh)
+	for _, factory := range rw.informerFactories {
+		factory.Start(stopCh)
+	}
+	for _, factory := range rw.informerFactories {
+		for _, synced := range factory.WaitForCacheSync(stopCh) {
+			assert.True(t, synced)
+		}
+	}
+
+	var names []string
+	rw.metadataStore.ForEach(rolloutGVK, func(o any) {
+		names = append(names, o.(*unstructured.Unstructured).GetName())
+	})
+	assert.Equal(t, []string{"checkout"}, names)
+}
+
 func TestSetupInformerForKind(t *testing.T) {
 	obs, logs := observer.New(zap.WarnLevel)
 	obsLogger := zap.New(obs)
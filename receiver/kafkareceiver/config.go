@@ -4,6 +4,9 @@
 package kafkareceiver // import "github.com/open-telemetry/opentelemetry-collector-contrib/receiver/kafkareceiver"
 
 import (
+	"fmt"
+	"regexp"
+	"strings"
 	"time"
 
 	"go.opentelemetry.io/collector/component"
@@ -35,6 +38,24 @@ type MessageMarking struct {
 type HeaderExtraction struct {
 	ExtractHeaders bool     `mapstructure:"extract_headers"`
 	Headers        []string `mapstructure:"headers"`
+	// Mappings set the value of the headers as attributes with a chosen name, on the resource or on every record
+	Mappings []HeaderMapping `mapstructure:"mappings"`
+}
+
+const (
+	headerContextResource = "resource"
+	headerContextRecord   = "record"
+)
+
+// HeaderMapping maps a header of the kafka records to an attribute
+type HeaderMapping struct {
+	// Header is the key of the header
+	Header string `mapstructure:"header"`
+	// Attribute is the key of the attribute set to the value of the header
+	Attribute string `mapstructure:"attribute"`
+	// Context is where the attribute is set, either "resource" (default) or "record" for the spans,
+	// metric data points or log records
+	Context string `mapstructure:"context"`
 }
 
 // Config defines configuration for Kafka receiver.
@@ -49,7 +70,10 @@ type Config struct {
 	// Kafka protocol version
 	ProtocolVersion string `mapstructure:"protocol_version"`
 	// The name of the kafka topic to consume from (default "otlp_spans" for traces, "otlp_metrics" for metrics, "otlp_logs" for logs)
+	// A topic starting with "^" is a regular expression, consuming from all the matching topics
 	Topic string `mapstructure:"topic"`
+	// How frequently the topics matching a regular expression topic are refreshed (default 1m)
+	TopicRefreshInterval time.Duration `mapstructure:"topic_refresh_interval"`
 	// Encoding of the messages (default "otlp_proto")
 	Encoding string `mapstructure:"encoding"`
 	// The consumer group that receiver will be consuming messages from (default "otel-collector")
@@ -85,5 +109,23 @@ var _ component.Config = (*Config)(nil)
 
 // Validate checks the receiver configuration is valid
 func (cfg *Config) Validate() error {
+	if strings.HasPrefix(cfg.Topic, topicRegexPrefix) {
+		if _, err := regexp.Compile(cfg.Topic); err != nil {
+			return fmt.Errorf("invalid topic regex %q: %w", cfg.Topic, err)
+		}
+		if cfg.TopicRefreshInterval <= 0 {
+			return fmt.Errorf("topic_refresh_interval must be positive: %v", cfg.TopicRefreshInterval)
+		}
+	}
+	for _, mapping := range cfg.HeaderExtraction.Mappings {
+		if mapping.Header == "" || mapping.Attribute == "" {
+			return fmt.Errorf("header mapping must have a header and an attribute: %+v", mapping)
+		}
+		switch mapping.Context {
+		case "", headerContextResource, headerContextRecord:
+		default:
+			return fmt.Errorf("invalid header mapping context %q, must be %q or %q", mapping.Context, headerContextResource, headerContextRecord)
+		}
+	}
 	return nil
 }
@@ -34,6 +34,7 @@ func TestLoadConfig(t *testing.T) {
 			id: component.NewIDWithName(metadata.Type, ""),
 			expected: &Config{
 				Topic:                                "spans",
+				TopicRefreshInterval:                 time.Minute,
 				Encoding:                             "otlp_proto",
 				Brokers:                              []string{"foo:123", "bar:456"},
 				ResolveCanonicalBootstrapServersOnly: true,
@@ -66,12 +67,13 @@ func TestLoadConfig(t *testing.T) {
 
 			id: component.NewIDWithName(metadata.Type, "logs"),
 			expected: &Config{
-				Topic:         "logs",
-				Encoding:      "direct",
-				Brokers:       []string{"coffee:123", "foobar:456"},
-				ClientID:      "otel-collector",
-				GroupID:       "otel-collector",
-				InitialOffset: "earliest",
+				Topic:                "logs",
+				TopicRefreshInterval: time.Minute,
+				Encoding:             "direct",
+				Brokers:              []string{"coffee:123", "foobar:456"},
+				ClientID:             "otel-collector",
+				GroupID:              "otel-collector",
+				InitialOffset:        "earliest",
 				Authentication: kafka.Authentication{
 					TLS: &configtls.ClientConfig{
 						Config: configtls.Config{
@@ -94,6 +96,35 @@ func TestLoadConfig(t *testing.T) {
 				},
 			},
 		},
+		{
+			id: component.NewIDWithName(metadata.Type, "regex"),
+			expected: &Config{
+				Topic:                "^otlp_logs_.*",
+				TopicRefreshInterval: 30 * time.Second,
+				Encoding:             "otlp_proto",
+				Brokers:              []string{"foo:123"},
+				ClientID:             "otel-collector",
+				GroupID:              "otel-collector",
+				InitialOffset:        "latest",
+				Metadata: kafkaexporter.Metadata{
+					Full: true,
+					Retry: kafkaexporter.MetadataRetry{
+						Max:     3,
+						Backoff: time.Millisecond * 250,
+					},
+				},
+				AutoCommit: AutoCommit{
+					Enable:   true,
+					Interval: 1 * time.Second,
+				},
+				HeaderExtraction: HeaderExtraction{
+					Mappings: []HeaderMapping{
+						{Header: "tenant", Attribute: "tenant.id"},
+						{Header: "trace-origin", Attribute: "origin", Context: "record"},
+					},
+				},
+			},
+		},
 	}
 
 	for _, tt := range tests {
@@ -110,3 +141,55 @@ func TestLoadConfig(t *testing.T) {
 		})
 	}
 }
+
+func TestConfigValidate(t *testing.T) {
+	tests := []struct {
+		name   string
+		modify func(*Config)
+		errMsg string
+	}{
+		{
+			name:   "default",
+			modify: func(*Config) {},
+		},
+		{
+			name:   "invalid topic regex",
+			modify: func(cfg *Config) { cfg.Topic = "^otlp_[" },
+			errMsg: `invalid topic regex "^otlp_["`,
+		},
+		{
+			name: "invalid topic refresh interval",
+			modify: func(cfg *Config) {
+				cfg.Topic = "^otlp_.*"
+				cfg.TopicRefreshInterval = 0
+			},
+			errMsg: "topic_refresh_interval must be positive",
+		},
+		{
+			name: "mapping without attribute",
+			modify: func(cfg *Config) {
+				cfg.HeaderExtraction.Mappings = []HeaderMapping{{Header: "tenant"}}
+			},
+			errMsg: "header mapping must have a header and an attribute",
+		},
+		{
+			name: "invalid mapping context",
+			modify: func(cfg *Config) {
+				cfg.HeaderExtraction.Mappings = []HeaderMapping{{Header: "tenant", Attribute: "tenant.id", Context: "scope"}}
+			},
+			errMsg: `invalid header mapping context "scope"`,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cfg := createDefaultConfig().(*Config)
+			tt.modify(cfg)
+			err := cfg.Validate()
+			if tt.errMsg == "" {
+				assert.NoError(t, err)
+				return
+			}
+			assert.ErrorContains(t, err, tt.errMsg)
+		})
+	}
+}
@@ -39,6 +39,9 @@ const (
 	defaultAutoCommitEnable = true
 	// default from sarama.NewConfig()
 	defaultAutoCommitInterval = 1 * time.Second
+
+	// default for how frequently the topics matching a regular expression are refreshed
+	defaultTopicRefreshInterval = time.Minute
 )
 
 var errUnrecognizedEncoding = fmt.Errorf("unrecognized encoding")
@@ -96,11 +99,12 @@ func NewFactory(options ...FactoryOption) receiver.Factory {
 
 func createDefaultConfig() component.Config {
 	return &Config{
-		Encoding:      defaultEncoding,
-		Brokers:       []string{defaultBroker},
-		ClientID:      defaultClientID,
-		GroupID:       defaultGroupID,
-		InitialOffset: defaultInitialOffset,
+		Encoding:             defaultEncoding,
+		Brokers:              []string{defaultBroker},
+		ClientID:             defaultClientID,
+		GroupID:              defaultGroupID,
+		InitialOffset:        defaultInitialOffset,
+		TopicRefreshInterval: defaultTopicRefreshInterval,
 		Metadata: kafkaexporter.Metadata{
 			Full: defaultMetadataFull,
 			Retry: kafkaexporter.MetadataRetry{
@@ -24,8 +24,9 @@ type HeaderExtractor interface {
 }
 
 type headerExtractor struct {
-	logger  *zap.Logger
-	headers []string
+	logger   *zap.Logger
+	headers  []string
+	mappings []HeaderMapping
 }
 
 func (he *headerExtractor) extractHeadersTraces(traces ptrace.Traces, message *sarama.ConsumerMessage) {
@@ -40,6 +41,26 @@ func (he *headerExtractor) extractHeadersTraces(traces ptrace.Traces, message *s
 			rs.Resource().Attributes().PutStr(getAttribute(header), value)
 		}
 	}
+	for _, mapping := range he.mappings {
+		value, ok := getHeaderValue(message.Headers, mapping.Header)
+		if !ok {
+			he.logger.Debug("Header key not found in the trace: ", zap.String("key", mapping.Header))
+			continue
+		}
+		for i := 0; i < traces.ResourceSpans().Len(); i++ {
+			rs := traces.ResourceSpans().At(i)
+			if mapping.Context != headerContextRecord {
+				rs.Resource().Attributes().PutStr(mapping.Attribute, value)
+				continue
+			}
+			for j := 0; j < rs.ScopeSpans().Len(); j++ {
+				spans := rs.ScopeSpans().At(j).Spans()
+				for k := 0; k < spans.Len(); k++ {
+					spans.At(k).Attributes().PutStr(mapping.Attribute, value)
+				}
+			}
+		}
+	}
 }
 
 func (he *headerExtractor) extractHeadersLogs(logs plog.Logs, message *sarama.ConsumerMessage) {
@@ -54,6 +75,26 @@ func (he *headerExtractor) extractHeadersLogs(logs plog.Logs, message *sarama.Co
 			rl.Resource().Attributes().PutStr(getAttribute(header), value)
 		}
 	}
+	for _, mapping := range he.mappings {
+		value, ok := getHeaderValue(message.Headers, mapping.Header)
+		if !ok {
+			he.logger.Debug("Header key not found in the log: ", zap.String("key", mapping.Header))
+			continue
+		}
+		for i := 0; i < logs.ResourceLogs().Len(); i++ {
+			rl := logs.ResourceLogs().At(i)
+			if mapping.Context != headerContextRecord {
+				rl.Resource().Attributes().PutStr(mapping.Attribute, value)
+				continue
+			}
+			for j := 0; j < rl.ScopeLogs().Len(); j++ {
+				records := rl.ScopeLogs().At(j).LogRecords()
+				for k := 0; k < records.Len(); k++ {
+					records.At(k).Attributes().PutStr(mapping.Attribute, value)
+				}
+			}
+		}
+	}
 }
 
 func (he *headerExtractor) extractHeadersMetrics(metrics pmetric.Metrics, message *sarama.ConsumerMessage) {
@@ -68,6 +109,57 @@ func (he *headerExtractor) extractHeadersMetrics(metrics pmetric.Metrics, messag
 			rm.Resource().Attributes().PutStr(getAttribute(header), value)
 		}
 	}
+	for _, mapping := range he.mappings {
+		value, ok := getHeaderValue(message.Headers, mapping.Header)
+		if !ok {
+			he.logger.Debug("Header key not found in the metric: ", zap.String("key", mapping.Header))
+			continue
+		}
+		for i := 0; i < metrics.ResourceMetrics().Len(); i++ {
+			rm := metrics.ResourceMetrics().At(i)
+			if mapping.Context != headerContextRecord {
+				rm.Resource().Attributes().PutStr(mapping.Attribute, value)
+				continue
+			}
+			for j := 0; j < rm.ScopeMetrics().Len(); j++ {
+				ms := rm.ScopeMetrics().At(j).Metrics()
+				for k := 0; k < ms.Len(); k++ {
+					putDataPointsAttribute(ms.At(k), mapping.Attribute, value)
+				}
+			}
+		}
+	}
+}
+
+// putDataPointsAttribute sets the attribute on all the data points of the metric
+func putDataPointsAttribute(metric pmetric.Metric, key, value string) {
+	switch metric.Type() {
+	case pmetric.MetricTypeGauge:
+		dps := metric.Gauge().DataPoints()
+		for i := 0; i < dps.Len(); i++ {
+			dps.At(i).Attributes().PutStr(key, value)
+		}
+	case pmetric.MetricTypeSum:
+		dps := metric.Sum().DataPoints()
+		for i := 0; i < dps.Len(); i++ {
+			dps.At(i).Attributes().PutStr(key, value)
+		}
+	case pmetric.MetricTypeHistogram:
+		dps := metric.Histogram().DataPoints()
+		for i := 0; i < dps.Len(); i++ {
+			dps.At(i).Attributes().PutStr(key, value)
+		}
+	case pmetric.MetricTypeExponentialHistogram:
+		dps := metric.ExponentialHistogram().DataPoints()
+		for i := 0; i < dps.Len(); i++ {
+			dps.At(i).Attributes().PutStr(key, value)
+		}
+	case pmetric.MetricTypeSummary:
+		dps := metric.Summary().DataPoints()
+		for i := 0; i < dps.Len(); i++ {
+			dps.At(i).Attributes().PutStr(key, value)
+		}
+	}
 }
 
 func getHeaderValue(headers []*sarama.RecordHeader, header string) (string, bool) {
@@ -13,6 +13,7 @@ import (
 	"github.com/stretchr/testify/require"
 	"go.opentelemetry.io/collector/consumer/consumertest"
 	"go.opentelemetry.io/collector/pdata/pcommon"
+	"go.opentelemetry.io/collector/pdata/plog"
 	"go.opentelemetry.io/collector/pdata/pmetric"
 	"go.opentelemetry.io/collector/pdata/ptrace"
 	"go.opentelemetry.io/collector/pdata/testdata"
@@ -207,3 +208,79 @@ func validateHeader(t *testing.T, rs pcommon.Resource, headerKey string, headerV
 	assert.Equal(t, ok, true)
 	assert.Equal(t, val.Str(), headerValue)
 }
+
+func TestHeaderMappings(t *testing.T) {
+	extractor := &headerExtractor{
+		logger: zaptest.NewLogger(t),
+		mappings: []HeaderMapping{
+			{Header: "tenant", Attribute: "tenant.id"},
+			{Header: "origin", Attribute: "origin", Context: headerContextRecord},
+			{Header: "missing", Attribute: "missing"},
+		},
+	}
+	message := &sarama.ConsumerMessage{
+		Headers: []*sarama.RecordHeader{
+			{Key: []byte("tenant"), Value: []byte("acme")},
+			{Key: []byte("origin"), Value: []byte("edge")},
+		},
+	}
+
+	t.Run("traces", func(t *testing.T) {
+		traces := ptrace.NewTraces()
+		rs := traces.ResourceSpans().AppendEmpty()
+		span := rs.ScopeSpans().AppendEmpty().Spans().AppendEmpty()
+		extractor.extractHeadersTraces(traces, message)
+		assert.Equal(t, map[string]any{"tenant.id": "acme"}, rs.Resource().Attributes().AsRaw())
+		assert.Equal(t, map[string]any{"origin": "edge"}, span.Attributes().AsRaw())
+	})
+
+	t.Run("logs", func(t *testing.T) {
+		logs := plog.NewLogs()
+		rl := logs.ResourceLogs().AppendEmpty()
+		record := rl.ScopeLogs().AppendEmpty().LogRecords().AppendEmpty()
+		extractor.extractHeadersLogs(logs, message)
+		assert.Equal(t, map[string]any{"tenant.id": "acme"}, rl.Resource().Attributes().AsRaw())
+		assert.Equal(t, map[string]any{"origin": "edge"}, record.Attributes().AsRaw())
+	})
+
+	t.Run("metrics", func(t *testing.T) {
+		metrics := testdata.GenerateMetrics(1)
+		extractor.extractHeadersMetrics(metrics, message)
+		rm := metrics.ResourceMetrics().At(0)
+		assert.Equal(t, "acme", rm.Resource().Attributes().AsRaw()["tenant.id"])
+		ms := rm.ScopeMetrics().At(0).Metrics()
+		require.Positive(t, ms.Len())
+		for i := 0; i < ms.Len(); i++ {
+			forEachDataPointAttributes(ms.At(i), func(attrs pcommon.Map) {
+				value, ok := attrs.Get("origin")
+				require.True(t, ok, ms.At(i).Name())
+				assert.Equal(t, "edge", value.Str())
+			})
+		}
+	})
+}
+
+func forEachDataPointAttributes(metric pmetric.Metric, f func(pcommon.Map)) {
+	switch metric.Type() {
+	case pmetric.MetricTypeGauge:
+		for i := 0; i < metric.Gauge().DataPoints().Len(); i++ {
+			f(metric.Gauge().DataPoints().At(i).Attributes())
+		}
+	case pmetric.MetricTypeSum:
+		for i := 0; i < metric.Sum().DataPoints().Len(); i++ {
+			f(metric.Sum().DataPoints().At(i).Attributes())
+		}
+	case pmetric.MetricTypeHistogram:
+		for i := 0; i < metric.Histogram().DataPoints().Len(); i++ {
+			f(metric.Histogram().DataPoints().At(i).Attributes())
+		}
+	case pmetric.MetricTypeExponentialHistogram:
+		for i := 0; i < metric.ExponentialHistogram().DataPoints().Len(); i++ {
+			f(metric.ExponentialHistogram().DataPoints().At(i).Attributes())
+		}
+	case pmetric.MetricTypeSummary:
+		for i := 0; i < metric.Summary().DataPoints().Len(); i++ {
+			f(metric.Summary().DataPoints().At(i).Attributes())
+		}
+	}
+}
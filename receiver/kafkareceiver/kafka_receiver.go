@@ -33,7 +33,7 @@ type kafkaTracesConsumer struct {
 	config            Config
 	consumerGroup     sarama.ConsumerGroup
 	nextConsumer      consumer.Traces
-	topics            []string
+	subscription      *topicSubscription
 	cancelConsumeLoop context.CancelFunc
 	unmarshaler       TracesUnmarshaler
 
@@ -50,7 +50,7 @@ type kafkaMetricsConsumer struct {
 	config            Config
 	consumerGroup     sarama.ConsumerGroup
 	nextConsumer      consumer.Metrics
-	topics            []string
+	subscription      *topicSubscription
 	cancelConsumeLoop context.CancelFunc
 	unmarshaler       MetricsUnmarshaler
 
@@ -67,7 +67,7 @@ type kafkaLogsConsumer struct {
 	config            Config
 	consumerGroup     sarama.ConsumerGroup
 	nextConsumer      consumer.Logs
-	topics            []string
+	subscription      *topicSubscription
 	cancelConsumeLoop context.CancelFunc
 	unmarshaler       LogsUnmarshaler
 
@@ -87,10 +87,14 @@ func newTracesReceiver(config Config, set receiver.CreateSettings, unmarshaler T
 	if unmarshaler == nil {
 		return nil, errUnrecognizedEncoding
 	}
+	subscription, err := newTopicSubscription(config, set.Logger)
+	if err != nil {
+		return nil, err
+	}
 
 	return &kafkaTracesConsumer{
 		config:            config,
-		topics:            []string{config.Topic},
+		subscription:      subscription,
 		nextConsumer:      nextConsumer,
 		unmarshaler:       unmarshaler,
 		settings:          set,
@@ -102,6 +106,14 @@ func newTracesReceiver(config Config, set receiver.CreateSettings, unmarshaler T
 }
 
 func createKafkaClient(config Config) (sarama.ConsumerGroup, error) {
+	saramaConfig, err := newSaramaConfig(config)
+	if err != nil {
+		return nil, err
+	}
+	return sarama.NewConsumerGroup(config.Brokers, config.GroupID, saramaConfig)
+}
+
+func newSaramaConfig(config Config) (*sarama.Config, error) {
 	saramaConfig := sarama.NewConfig()
 	saramaConfig.ClientID = config.ClientID
 	saramaConfig.Metadata.Full = config.Metadata.Full
@@ -124,7 +136,7 @@ func createKafkaClient(config Config) (sarama.ConsumerGroup, error) {
 	if err := kafka.ConfigureAuthentication(config.Authentication, saramaConfig); err != nil {
 		return nil, err
 	}
-	return sarama.NewConsumerGroup(config.Brokers, config.GroupID, saramaConfig)
+	return saramaConfig, nil
 }
 
 func (c *kafkaTracesConsumer) Start(_ context.Context, _ component.Host) error {
@@ -144,6 +156,9 @@ func (c *kafkaTracesConsumer) Start(_ context.Context, _ component.Host) error {
 			return err
 		}
 	}
+	if err = c.subscription.start(c.config); err != nil {
+		return err
+	}
 	consumerGroup := &tracesConsumerGroupHandler{
 		logger:            c.settings.Logger,
 		unmarshaler:       c.unmarshaler,
@@ -154,35 +169,30 @@ func (c *kafkaTracesConsumer) Start(_ context.Context, _ component.Host) error {
 		messageMarking:    c.messageMarking,
 		headerExtractor:   &nopHeaderExtractor{},
 	}
-	if c.headerExtraction {
-		consumerGroup.headerExtractor = &headerExtractor{
-			logger:  c.settings.Logger,
-			headers: c.headers,
+	if c.headerExtraction || len(c.config.HeaderExtraction.Mappings) > 0 {
+		extractor := &headerExtractor{
+			logger:   c.settings.Logger,
+			mappings: c.config.HeaderExtraction.Mappings,
+		}
+		if c.headerExtraction {
+			extractor.headers = c.headers
 		}
+		consumerGroup.headerExtractor = extractor
 	}
 	go func() {
 		if err := c.consumeLoop(ctx, consumerGroup); !errors.Is(err, context.Canceled) {
 			c.settings.ReportStatus(component.NewFatalErrorEvent(err))
 		}
 	}()
-	<-consumerGroup.ready
+	// The topics matching a regular expression may not exist yet, the first session is not waited for
+	if !c.subscription.isPattern() {
+		<-consumerGroup.ready
+	}
 	return nil
 }
 
 func (c *kafkaTracesConsumer) consumeLoop(ctx context.Context, handler sarama.ConsumerGroupHandler) error {
-	for {
-		// `Consume` should be called inside an infinite loop, when a
-		// server-side rebalance happens, the consumer session will need to be
-		// recreated to get the new claims
-		if err := c.consumerGroup.Consume(ctx, c.topics, handler); err != nil {
-			c.settings.Logger.Error("Error from consumer", zap.Error(err))
-		}
-		// check if context was cancelled, signaling that the consumer should stop
-		if ctx.Err() != nil {
-			c.settings.Logger.Info("Consumer stopped", zap.Error(ctx.Err()))
-			return ctx.Err()
-		}
-	}
+	return c.subscription.consume(ctx, c.consumerGroup, handler)
 }
 
 func (c *kafkaTracesConsumer) Shutdown(context.Context) error {
@@ -193,17 +203,21 @@ func (c *kafkaTracesConsumer) Shutdown(context.Context) error {
 	if c.consumerGroup == nil {
 		return nil
 	}
-	return c.consumerGroup.Close()
+	return errors.Join(c.consumerGroup.Close(), c.subscription.shutdown())
 }
 
 func newMetricsReceiver(config Config, set receiver.CreateSettings, unmarshaler MetricsUnmarshaler, nextConsumer consumer.Metrics) (*kafkaMetricsConsumer, error) {
 	if unmarshaler == nil {
 		return nil, errUnrecognizedEncoding
 	}
+	subscription, err := newTopicSubscription(config, set.Logger)
+	if err != nil {
+		return nil, err
+	}
 
 	return &kafkaMetricsConsumer{
 		config:            config,
-		topics:            []string{config.Topic},
+		subscription:      subscription,
 		nextConsumer:      nextConsumer,
 		unmarshaler:       unmarshaler,
 		settings:          set,
@@ -231,6 +245,9 @@ func (c *kafkaMetricsConsumer) Start(_ context.Context, _ component.Host) error
 			return err
 		}
 	}
+	if err = c.subscription.start(c.config); err != nil {
+		return err
+	}
 	metricsConsumerGroup := &metricsConsumerGroupHandler{
 		logger:            c.settings.Logger,
 		unmarshaler:       c.unmarshaler,
@@ -241,35 +258,30 @@ func (c *kafkaMetricsConsumer) Start(_ context.Context, _ component.Host) error
 		messageMarking:    c.messageMarking,
 		headerExtractor:   &nopHeaderExtractor{},
 	}
-	if c.headerExtraction {
-		metricsConsumerGroup.headerExtractor = &headerExtractor{
-			logger:  c.settings.Logger,
-			headers: c.headers,
+	if c.headerExtraction || len(c.config.HeaderExtraction.Mappings) > 0 {
+		extractor := &headerExtractor{
+			logger:   c.settings.Logger,
+			mappings: c.config.HeaderExtraction.Mappings,
 		}
+		if c.headerExtraction {
+			extractor.headers = c.headers
+		}
+		metricsConsumerGroup.headerExtractor = extractor
 	}
 	go func() {
 		if err := c.consumeLoop(ctx, metricsConsumerGroup); err != nil {
 			c.settings.ReportStatus(component.NewFatalErrorEvent(err))
 		}
 	}()
-	<-metricsConsumerGroup.ready
+	// The topics matching a regular expression may not exist yet, the first session is not waited for
+	if !c.subscription.isPattern() {
+		<-metricsConsumerGroup.ready
+	}
 	return nil
 }
 
 func (c *kafkaMetricsConsumer) consumeLoop(ctx context.Context, handler sarama.ConsumerGroupHandler) error {
-	for {
-		// `Consume` should be called inside an infinite loop, when a
-		// server-side rebalance happens, the consumer session will need to be
-		// recreated to get the new claims
-		if err := c.consumerGroup.Consume(ctx, c.topics, handler); err != nil {
-			c.settings.Logger.Error("Error from consumer", zap.Error(err))
-		}
-		// check if context was cancelled, signaling that the consumer should stop
-		if ctx.Err() != nil {
-			c.settings.Logger.Info("Consumer stopped", zap.Error(ctx.Err()))
-			return ctx.Err()
-		}
-	}
+	return c.subscription.consume(ctx, c.consumerGroup, handler)
 }
 
 func (c *kafkaMetricsConsumer) Shutdown(context.Context) error {
@@ -280,17 +292,21 @@ func (c *kafkaMetricsConsumer) Shutdown(context.Context) error {
 	if c.consumerGroup == nil {
 		return nil
 	}
-	return c.consumerGroup.Close()
+	return errors.Join(c.consumerGroup.Close(), c.subscription.shutdown())
 }
 
 func newLogsReceiver(config Config, set receiver.CreateSettings, unmarshaler LogsUnmarshaler, nextConsumer consumer.Logs) (*kafkaLogsConsumer, error) {
 	if unmarshaler == nil {
 		return nil, errUnrecognizedEncoding
 	}
+	subscription, err := newTopicSubscription(config, set.Logger)
+	if err != nil {
+		return nil, err
+	}
 
 	return &kafkaLogsConsumer{
 		config:            config,
-		topics:            []string{config.Topic},
+		subscription:      subscription,
 		nextConsumer:      nextConsumer,
 		unmarshaler:       unmarshaler,
 		settings:          set,
@@ -318,6 +334,9 @@ func (c *kafkaLogsConsumer) Start(_ context.Context, _ component.Host) error {
 			return err
 		}
 	}
+	if err = c.subscription.start(c.config); err != nil {
+		return err
+	}
 	logsConsumerGroup := &logsConsumerGroupHandler{
 		logger:            c.settings.Logger,
 		unmarshaler:       c.unmarshaler,
@@ -328,35 +347,30 @@ func (c *kafkaLogsConsumer) Start(_ context.Context, _ component.Host) error {
 		messageMarking:    c.messageMarking,
 		headerExtractor:   &nopHeaderExtractor{},
 	}
-	if c.headerExtraction {
-		logsConsumerGroup.headerExtractor = &headerExtractor{
-			logger:  c.settings.Logger,
-			headers: c.headers,
+	if c.headerExtraction || len(c.config.HeaderExtraction.Mappings) > 0 {
+		extractor := &headerExtractor{
+			logger:   c.settings.Logger,
+			mappings: c.config.HeaderExtraction.Mappings,
+		}
+		if c.headerExtraction {
+			extractor.headers = c.headers
 		}
+		logsConsumerGroup.headerExtractor = extractor
 	}
 	go func() {
 		if err := c.consumeLoop(ctx, logsConsumerGroup); err != nil {
 			c.settings.ReportStatus(component.NewFatalErrorEvent(err))
 		}
 	}()
-	<-logsConsumerGroup.ready
+	// The topics matching a regular expression may not exist yet, the first session is not waited for
+	if !c.subscription.isPattern() {
+		<-logsConsumerGroup.ready
+	}
 	return nil
 }
 
 func (c *kafkaLogsConsumer) consumeLoop(ctx context.Context, handler sarama.ConsumerGroupHandler) error {
-	for {
-		// `Consume` should be called inside an infinite loop, when a
-		// server-side rebalance happens, the consumer session will need to be
-		// recreated to get the new claims
-		if err := c.consumerGroup.Consume(ctx, c.topics, handler); err != nil {
-			c.settings.Logger.Error("Error from consumer", zap.Error(err))
-		}
-		// check if context was cancelled, signaling that the consumer should stop
-		if ctx.Err() != nil {
-			c.settings.Logger.Info("Consumer stopped", zap.Error(ctx.Err()))
-			return ctx.Err()
-		}
-	}
+	return c.subscription.consume(ctx, c.consumerGroup, handler)
 }
 
 func (c *kafkaLogsConsumer) Shutdown(context.Context) error {
@@ -367,7 +381,7 @@ func (c *kafkaLogsConsumer) Shutdown(context.Context) error {
 	if c.consumerGroup == nil {
 		return nil
 	}
-	return c.consumerGroup.Close()
+	return errors.Join(c.consumerGroup.Close(), c.subscription.shutdown())
 }
 
 type tracesConsumerGroupHandler struct {
@@ -95,6 +95,7 @@ func TestTracesReceiverStart(t *testing.T) {
 		nextConsumer:  consumertest.NewNop(),
 		settings:      receivertest.NewNopCreateSettings(),
 		consumerGroup: &testConsumerGroup{},
+		subscription:  &topicSubscription{logger: zap.NewNop()},
 	}
 
 	require.NoError(t, c.Start(context.Background(), componenttest.NewNopHost()))
@@ -106,6 +107,7 @@ func TestTracesReceiverStartConsume(t *testing.T) {
 		nextConsumer:  consumertest.NewNop(),
 		settings:      receivertest.NewNopCreateSettings(),
 		consumerGroup: &testConsumerGroup{},
+		subscription:  &topicSubscription{logger: zap.NewNop()},
 	}
 	ctx, cancelFunc := context.WithCancel(context.Background())
 	c.cancelConsumeLoop = cancelFunc
@@ -127,6 +129,7 @@ func TestTracesReceiver_error(t *testing.T) {
 		nextConsumer:  consumertest.NewNop(),
 		settings:      settings,
 		consumerGroup: &testConsumerGroup{err: expectedErr},
+		subscription:  &topicSubscription{logger: logger},
 	}
 
 	require.NoError(t, c.Start(context.Background(), componenttest.NewNopHost()))
@@ -362,6 +365,7 @@ func TestMetricsReceiverStartConsume(t *testing.T) {
 		nextConsumer:  consumertest.NewNop(),
 		settings:      receivertest.NewNopCreateSettings(),
 		consumerGroup: &testConsumerGroup{},
+		subscription:  &topicSubscription{logger: zap.NewNop()},
 	}
 	ctx, cancelFunc := context.WithCancel(context.Background())
 	c.cancelConsumeLoop = cancelFunc
@@ -383,6 +387,7 @@ func TestMetricsReceiver_error(t *testing.T) {
 		nextConsumer:  consumertest.NewNop(),
 		settings:      settings,
 		consumerGroup: &testConsumerGroup{err: expectedErr},
+		subscription:  &topicSubscription{logger: logger},
 	}
 
 	require.NoError(t, c.Start(context.Background(), componenttest.NewNopHost()))
@@ -617,6 +622,7 @@ func TestLogsReceiverStart(t *testing.T) {
 		nextConsumer:  consumertest.NewNop(),
 		settings:      receivertest.NewNopCreateSettings(),
 		consumerGroup: &testConsumerGroup{},
+		subscription:  &topicSubscription{logger: zap.NewNop()},
 	}
 
 	require.NoError(t, c.Start(context.Background(), componenttest.NewNopHost()))
@@ -628,6 +634,7 @@ func TestLogsReceiverStartConsume(t *testing.T) {
 		nextConsumer:  consumertest.NewNop(),
 		settings:      receivertest.NewNopCreateSettings(),
 		consumerGroup: &testConsumerGroup{},
+		subscription:  &topicSubscription{logger: zap.NewNop()},
 	}
 	ctx, cancelFunc := context.WithCancel(context.Background())
 	c.cancelConsumeLoop = cancelFunc
@@ -649,6 +656,7 @@ func TestLogsReceiver_error(t *testing.T) {
 		nextConsumer:  consumertest.NewNop(),
 		settings:      settings,
 		consumerGroup: &testConsumerGroup{err: expectedErr},
+		subscription:  &topicSubscription{logger: logger},
 		config:        *createDefaultConfig().(*Config),
 	}
 
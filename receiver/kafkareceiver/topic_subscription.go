@@ -0,0 +1,165 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package kafkareceiver // import "github.com/open-telemetry/opentelemetry-collector-contrib/receiver/kafkareceiver"
+
+import (
+	"context"
+	"regexp"
+	"slices"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/IBM/sarama"
+	"go.uber.org/zap"
+)
+
+// topicRegexPrefix marks a topic as a regular expression matching the topics to consume from
+const topicRegexPrefix = "^"
+
+// topicLister lists the topics of the cluster, implemented by sarama.Client
+type topicLister interface {
+	RefreshMetadata(topics ...string) error
+	Topics() ([]string, error)
+	Close() error
+}
+
+// topicSubscription runs the consumer group sessions on the configured topic, or on all the topics
+// matching the configured regular expression, new matching topics being picked up as they are created
+type topicSubscription struct {
+	logger          *zap.Logger
+	topic           string
+	pattern         *regexp.Regexp
+	refreshInterval time.Duration
+	// lister is only used with a regular expression, it may be set in tests to inject fake implementation
+	lister topicLister
+}
+
+func newTopicSubscription(config Config, logger *zap.Logger) (*topicSubscription, error) {
+	s := &topicSubscription{
+		logger:          logger,
+		topic:           config.Topic,
+		refreshInterval: config.TopicRefreshInterval,
+	}
+	if strings.HasPrefix(config.Topic, topicRegexPrefix) {
+		var err error
+		if s.pattern, err = regexp.Compile(config.Topic); err != nil {
+			return nil, err
+		}
+	}
+	return s, nil
+}
+
+// isPattern returns true if the topics are matched by a regular expression
+func (s *topicSubscription) isPattern() bool {
+	return s.pattern != nil
+}
+
+// start creates the client listing the topics matching the regular expression
+func (s *topicSubscription) start(config Config) error {
+	if !s.isPattern() || s.lister != nil {
+		return nil
+	}
+	saramaConfig, err := newSaramaConfig(config)
+	if err != nil {
+		return err
+	}
+	s.lister, err = sarama.NewClient(config.Brokers, saramaConfig)
+	return err
+}
+
+func (s *topicSubscription) shutdown() error {
+	if s.lister == nil {
+		return nil
+	}
+	return s.lister.Close()
+}
+
+// matchingTopics returns the sorted topics of the cluster matching the regular expression
+func (s *topicSubscription) matchingTopics() ([]string, error) {
+	if err := s.lister.RefreshMetadata(); err != nil {
+		return nil, err
+	}
+	all, err := s.lister.Topics()
+	if err != nil {
+		return nil, err
+	}
+	var topics []string
+	for _, topic := range all {
+		if s.pattern.MatchString(topic) {
+			topics = append(topics, topic)
+		}
+	}
+	sort.Strings(topics)
+	return topics, nil
+}
+
+// consume runs the consumer group sessions until the context is cancelled
+func (s *topicSubscription) consume(ctx context.Context, group sarama.ConsumerGroup, handler sarama.ConsumerGroupHandler) error {
+	for {
+		topics := []string{s.topic}
+		if s.isPattern() {
+			var err error
+			if topics, err = s.matchingTopics(); err != nil {
+				s.logger.Error("Failed to list the topics", zap.Error(err))
+			}
+		}
+		if len(topics) == 0 {
+			// Wait for the topics matching the regular expression to be created
+			select {
+			case <-ctx.Done():
+				s.logger.Info("Consumer stopped", zap.Error(ctx.Err()))
+				return ctx.Err()
+			case <-time.After(s.refreshInterval):
+			}
+			continue
+		}
+
+		sessionCtx, cancel := context.WithCancel(ctx)
+		watched := make(chan struct{})
+		go func() {
+			defer close(watched)
+			if s.isPattern() {
+				s.watchTopics(sessionCtx, topics, cancel)
+			}
+		}()
+		// `Consume` should be called inside an infinite loop, when a
+		// server-side rebalance happens, the consumer session will need to be
+		// recreated to get the new claims
+		if err := group.Consume(sessionCtx, topics, handler); err != nil {
+			s.logger.Error("Error from consumer", zap.Error(err))
+		}
+		cancel()
+		<-watched
+		// check if context was cancelled, signaling that the consumer should stop
+		if ctx.Err() != nil {
+			s.logger.Info("Consumer stopped", zap.Error(ctx.Err()))
+			return ctx.Err()
+		}
+	}
+}
+
+// watchTopics cancels the session when the topics matching the regular expression change,
+// so that a new session is started on the new topics
+func (s *topicSubscription) watchTopics(ctx context.Context, topics []string, cancel context.CancelFunc) {
+	ticker := time.NewTicker(s.refreshInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+		current, err := s.matchingTopics()
+		if err != nil {
+			s.logger.Error("Failed to list the topics", zap.Error(err))
+			continue
+		}
+		if !slices.Equal(current, topics) {
+			s.logger.Info("Topics matching the regex changed", zap.Strings("topics", current))
+			cancel()
+			return
+		}
+	}
+}
@@ -0,0 +1,126 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package kafkareceiver
+
+import (
+	"context"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/IBM/sarama"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap/zaptest"
+)
+
+type testTopicLister struct {
+	mu     sync.Mutex
+	topics []string
+}
+
+func (l *testTopicLister) RefreshMetadata(...string) error {
+	return nil
+}
+
+func (l *testTopicLister) Topics() ([]string, error) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	return append([]string(nil), l.topics...), nil
+}
+
+func (l *testTopicLister) Close() error {
+	return nil
+}
+
+func (l *testTopicLister) setTopics(topics ...string) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	l.topics = topics
+}
+
+// recordingConsumerGroup records the topics of the sessions, each session lasting until its context is cancelled
+type recordingConsumerGroup struct {
+	testConsumerGroup
+	sessions chan []string
+}
+
+func (g *recordingConsumerGroup) Consume(ctx context.Context, topics []string, _ sarama.ConsumerGroupHandler) error {
+	g.sessions <- topics
+	<-ctx.Done()
+	return nil
+}
+
+func TestNewTopicSubscription(t *testing.T) {
+	cfg := createDefaultConfig().(*Config)
+	s, err := newTopicSubscription(*cfg, zaptest.NewLogger(t))
+	require.NoError(t, err)
+	assert.False(t, s.isPattern())
+	// no client is created for a plain topic
+	require.NoError(t, s.start(*cfg))
+	assert.Nil(t, s.lister)
+	assert.NoError(t, s.shutdown())
+
+	cfg.Topic = "^otlp_.*"
+	s, err = newTopicSubscription(*cfg, zaptest.NewLogger(t))
+	require.NoError(t, err)
+	assert.True(t, s.isPattern())
+
+	cfg.Topic = "^otlp_["
+	_, err = newTopicSubscription(*cfg, zaptest.NewLogger(t))
+	assert.Error(t, err)
+}
+
+func TestTopicSubscriptionConsume(t *testing.T) {
+	cfg := createDefaultConfig().(*Config)
+	cfg.Topic = "^otlp_logs_.*"
+	cfg.TopicRefreshInterval = 10 * time.Millisecond
+	s, err := newTopicSubscription(*cfg, zaptest.NewLogger(t))
+	require.NoError(t, err)
+	lister := &testTopicLister{topics: []string{"otlp_spans"}}
+	s.lister = lister
+	require.NoError(t, s.start(*cfg))
+
+	group := &recordingConsumerGroup{sessions: make(chan []string, 10)}
+	ctx, cancel := context.WithCancel(context.Background())
+	done := make(chan error)
+	go func() {
+		done <- s.consume(ctx, group, nil)
+	}()
+
+	// no session is started until a topic matches
+	select {
+	case topics := <-group.sessions:
+		t.Fatalf("unexpected session on %v", topics)
+	case <-time.After(50 * time.Millisecond):
+	}
+
+	lister.setTopics("otlp_spans", "otlp_logs_b")
+	assert.Equal(t, []string{"otlp_logs_b"}, <-group.sessions)
+
+	// the new matching topic is picked up by a new session
+	lister.setTopics("otlp_spans", "otlp_logs_b", "otlp_logs_a")
+	assert.Equal(t, []string{"otlp_logs_a", "otlp_logs_b"}, <-group.sessions)
+
+	cancel()
+	assert.ErrorIs(t, <-done, context.Canceled)
+}
+
+func TestTopicSubscriptionConsumeTopic(t *testing.T) {
+	cfg := createDefaultConfig().(*Config)
+	cfg.Topic = defaultTracesTopic
+	s, err := newTopicSubscription(*cfg, zaptest.NewLogger(t))
+	require.NoError(t, err)
+
+	group := &recordingConsumerGroup{sessions: make(chan []string, 10)}
+	ctx, cancel := context.WithCancel(context.Background())
+	done := make(chan error)
+	go func() {
+		done <- s.consume(ctx, group, nil)
+	}()
+	assert.Equal(t, []string{defaultTracesTopic}, <-group.sessions)
+
+	cancel()
+	assert.ErrorIs(t, <-done, context.Canceled)
+}
@@ -53,10 +53,22 @@ type Config struct {
 	// Then set this value to ${env:K8S_NODE_NAME} in the configuration.
 	NodeName string `mapstructure:"node"`
 
+	// CollectAllNetworkInterfaces reports the network metrics of every network interface of the
+	// nodes and pods, instead of only the default interface.
+	CollectAllNetworkInterfaces NetworkInterfacesConfig `mapstructure:"collect_all_network_interfaces"`
+
 	// MetricsBuilderConfig allows customizing scraped metrics/attributes representation.
 	metadata.MetricsBuilderConfig `mapstructure:",squash"`
 }
 
+// NetworkInterfacesConfig enables the collection of the metrics of all network interfaces per metric group.
+type NetworkInterfacesConfig struct {
+	// NodeMetrics enables it for the node network metrics.
+	NodeMetrics bool `mapstructure:"node"`
+	// PodMetrics enables it for the pod network metrics.
+	PodMetrics bool `mapstructure:"pod"`
+}
+
 // getReceiverOptions returns scraperOptions is the config is valid,
 // otherwise it will return an error.
 func (cfg *Config) getReceiverOptions() (*scraperOptions, error) {
@@ -82,7 +94,11 @@ func (cfg *Config) getReceiverOptions() (*scraperOptions, error) {
 		collectionInterval:    cfg.CollectionInterval,
 		extraMetadataLabels:   cfg.ExtraMetadataLabels,
 		metricGroupsToCollect: mgs,
-		k8sAPIClient:          k8sAPIClient,
+		allNetworkInterfaces: map[kubelet.MetricGroup]bool{
+			kubelet.NodeMetricGroup: cfg.CollectAllNetworkInterfaces.NodeMetrics,
+			kubelet.PodMetricGroup:  cfg.CollectAllNetworkInterfaces.PodMetrics,
+		},
+		k8sAPIClient: k8sAPIClient,
 	}, nil
 }
 
@@ -0,0 +1,22 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package kubelet // import "github.com/open-telemetry/opentelemetry-collector-contrib/receiver/kubeletstatsreceiver/internal/kubelet"
+
+import (
+	"go.opentelemetry.io/collector/pdata/pcommon"
+	stats "k8s.io/kubelet/pkg/apis/stats/v1alpha1"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/receiver/kubeletstatsreceiver/internal/metadata"
+)
+
+func addEphemeralStorageMetrics(mb *metadata.MetricsBuilder, ephemeralStorageMetrics metadata.EphemeralStorageMetrics, s *stats.FsStats, currentTime pcommon.Timestamp, r resources) {
+	if s == nil {
+		return
+	}
+
+	recordIntDataPoint(mb, ephemeralStorageMetrics.Usage, s.UsedBytes, currentTime)
+	if r.ephemeralStorageLimit > 0 {
+		ephemeralStorageMetrics.Limit(mb, currentTime, r.ephemeralStorageLimit)
+	}
+}
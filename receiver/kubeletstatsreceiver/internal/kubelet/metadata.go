@@ -56,10 +56,11 @@ type Metadata struct {
 }
 
 type resources struct {
-	cpuRequest    float64
-	cpuLimit      float64
-	memoryRequest int64
-	memoryLimit   int64
+	cpuRequest            float64
+	cpuLimit              float64
+	memoryRequest         int64
+	memoryLimit           int64
+	ephemeralStorageLimit int64
 }
 
 type NodeLimits struct {
@@ -73,10 +74,11 @@ func getContainerResources(r *v1.ResourceRequirements) resources {
 	}
 
 	return resources{
-		cpuRequest:    r.Requests.Cpu().AsApproximateFloat64(),
-		cpuLimit:      r.Limits.Cpu().AsApproximateFloat64(),
-		memoryRequest: r.Requests.Memory().Value(),
-		memoryLimit:   r.Limits.Memory().Value(),
+		cpuRequest:            r.Requests.Cpu().AsApproximateFloat64(),
+		cpuLimit:              r.Limits.Cpu().AsApproximateFloat64(),
+		memoryRequest:         r.Requests.Memory().Value(),
+		memoryLimit:           r.Limits.Memory().Value(),
+		ephemeralStorageLimit: r.Limits.StorageEphemeral().Value(),
 	}
 }
 
@@ -98,6 +100,7 @@ func NewMetadata(labels []MetadataLabel, podsMetadata *v1.PodList, nodeResourceL
 			allContainersCPURequestsDefined := true
 			allContainersMemoryLimitsDefined := true
 			allContainersMemoryRequestsDefined := true
+			allContainersEphemeralStorageLimitsDefined := true
 			for i := range pod.Spec.Containers {
 				container := pod.Spec.Containers[i]
 				containerResource := getContainerResources(&container.Resources)
@@ -118,6 +121,10 @@ func NewMetadata(labels []MetadataLabel, podsMetadata *v1.PodList, nodeResourceL
 					allContainersMemoryRequestsDefined = false
 					podResource.memoryRequest = 0
 				}
+				if allContainersEphemeralStorageLimitsDefined && containerResource.ephemeralStorageLimit == 0 {
+					allContainersEphemeralStorageLimitsDefined = false
+					podResource.ephemeralStorageLimit = 0
+				}
 
 				if allContainersCPULimitsDefined {
 					podResource.cpuLimit += containerResource.cpuLimit
@@ -131,6 +138,9 @@ func NewMetadata(labels []MetadataLabel, podsMetadata *v1.PodList, nodeResourceL
 				if allContainersMemoryRequestsDefined {
 					podResource.memoryRequest += containerResource.memoryRequest
 				}
+				if allContainersEphemeralStorageLimitsDefined {
+					podResource.ephemeralStorageLimit += containerResource.ephemeralStorageLimit
+				}
 
 				m.containerResources[string(pod.UID)+container.Name] = containerResource
 			}
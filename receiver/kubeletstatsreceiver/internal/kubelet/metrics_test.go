@@ -12,6 +12,10 @@ import (
 	"go.opentelemetry.io/collector/pdata/pmetric"
 	"go.opentelemetry.io/collector/receiver/receivertest"
 	"go.uber.org/zap"
+	v1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	stats "k8s.io/kubelet/pkg/apis/stats/v1alpha1"
 
 	"github.com/open-telemetry/opentelemetry-collector-contrib/receiver/kubeletstatsreceiver/internal/metadata"
 )
@@ -40,12 +44,12 @@ func TestMetricAccumulator(t *testing.T) {
 		ContainerMetricsBuilder: metadata.NewMetricsBuilder(metadata.DefaultMetricsBuilderConfig(), receivertest.NewNopCreateSettings()),
 		OtherMetricsBuilder:     metadata.NewMetricsBuilder(metadata.DefaultMetricsBuilderConfig(), receivertest.NewNopCreateSettings()),
 	}
-	requireMetricsOk(t, MetricsData(zap.NewNop(), summary, k8sMetadata, ValidMetricGroups, mbs))
+	requireMetricsOk(t, MetricsData(zap.NewNop(), summary, k8sMetadata, ValidMetricGroups, nil, mbs))
 	// Disable all groups
 	mbs.NodeMetricsBuilder.Reset()
 	mbs.PodMetricsBuilder.Reset()
 	mbs.OtherMetricsBuilder.Reset()
-	require.Equal(t, 0, len(MetricsData(zap.NewNop(), summary, k8sMetadata, map[MetricGroup]bool{}, mbs)))
+	require.Equal(t, 0, len(MetricsData(zap.NewNop(), summary, k8sMetadata, map[MetricGroup]bool{}, nil, mbs)))
 }
 
 func requireMetricsOk(t *testing.T, mds []pmetric.Metrics) {
@@ -155,7 +159,7 @@ func TestUptime(t *testing.T) {
 		ContainerMetricsBuilder: metadata.NewMetricsBuilder(cfg, receivertest.NewNopCreateSettings()),
 	}
 
-	metrics := indexedFakeMetrics(MetricsData(zap.NewNop(), summary, Metadata{}, mgs, mbs))
+	metrics := indexedFakeMetrics(MetricsData(zap.NewNop(), summary, Metadata{}, mgs, nil, mbs))
 
 	requireContains(t, metrics, "k8s.node.uptime")
 	requireContains(t, metrics, "k8s.pod.uptime")
@@ -181,6 +185,99 @@ func TestEmitMetrics(t *testing.T) {
 	}
 }
 
+func TestAllNetworkInterfaces(t *testing.T) {
+	interfaceStats := func(name string, rx uint64) stats.InterfaceStats {
+		return stats.InterfaceStats{Name: name, RxBytes: &rx}
+	}
+	network := &stats.NetworkStats{
+		InterfaceStats: interfaceStats("eth0", 10),
+		Interfaces:     []stats.InterfaceStats{interfaceStats("eth0", 10), interfaceStats("eth1", 20)},
+	}
+	summary := &stats.Summary{
+		Node: stats.NodeStats{NodeName: "node", Network: network},
+		Pods: []stats.PodStats{{PodRef: stats.PodReference{Name: "pod", UID: "pod-uid"}, Network: network}},
+	}
+	mgs := map[MetricGroup]bool{PodMetricGroup: true, NodeMetricGroup: true}
+
+	tests := []struct {
+		name                 string
+		allNetworkInterfaces map[MetricGroup]bool
+		wantNodeInterfaces   map[string]int64
+		wantPodInterfaces    map[string]int64
+	}{
+		{
+			name:               "default_interface",
+			wantNodeInterfaces: map[string]int64{"eth0": 10},
+			wantPodInterfaces:  map[string]int64{"eth0": 10},
+		},
+		{
+			name:                 "all_pod_interfaces",
+			allNetworkInterfaces: map[MetricGroup]bool{PodMetricGroup: true},
+			wantNodeInterfaces:   map[string]int64{"eth0": 10},
+			wantPodInterfaces:    map[string]int64{"eth0": 10, "eth1": 20},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mbs := &metadata.MetricsBuilders{
+				NodeMetricsBuilder: metadata.NewMetricsBuilder(metadata.DefaultMetricsBuilderConfig(), receivertest.NewNopCreateSettings()),
+				PodMetricsBuilder:  metadata.NewMetricsBuilder(metadata.DefaultMetricsBuilderConfig(), receivertest.NewNopCreateSettings()),
+			}
+			metrics := indexedFakeMetrics(MetricsData(zap.NewNop(), summary, Metadata{}, mgs, tt.allNetworkInterfaces, mbs))
+
+			receivedBytes := func(m pmetric.Metric) map[string]int64 {
+				out := map[string]int64{}
+				for i := 0; i < m.Sum().DataPoints().Len(); i++ {
+					dp := m.Sum().DataPoints().At(i)
+					iface, _ := dp.Attributes().Get("interface")
+					out[iface.Str()] = dp.IntValue()
+				}
+				return out
+			}
+			require.Equal(t, tt.wantNodeInterfaces, receivedBytes(metrics["k8s.node.network.io"][0]))
+			require.Equal(t, tt.wantPodInterfaces, receivedBytes(metrics["k8s.pod.network.io"][0]))
+		})
+	}
+}
+
+func TestPodEphemeralStorage(t *testing.T) {
+	usedBytes := uint64(1024)
+	summary := &stats.Summary{
+		Pods: []stats.PodStats{{
+			PodRef:           stats.PodReference{Name: "pod", UID: "pod-uid"},
+			EphemeralStorage: &stats.FsStats{UsedBytes: &usedBytes},
+		}},
+	}
+	container := func(limit string) v1.Container {
+		return v1.Container{Resources: v1.ResourceRequirements{
+			Limits: v1.ResourceList{v1.ResourceEphemeralStorage: resource.MustParse(limit)},
+		}}
+	}
+	podsMetadata := &v1.PodList{Items: []v1.Pod{{
+		ObjectMeta: metav1.ObjectMeta{UID: "pod-uid"},
+		Spec:       v1.PodSpec{Containers: []v1.Container{container("1Gi"), container("512Mi")}},
+	}}}
+
+	cfg := metadata.DefaultMetricsBuilderConfig()
+	cfg.Metrics.K8sPodEphemeralStorageUsage.Enabled = true
+	cfg.Metrics.K8sPodEphemeralStorageLimit.Enabled = true
+	mbs := &metadata.MetricsBuilders{
+		PodMetricsBuilder: metadata.NewMetricsBuilder(cfg, receivertest.NewNopCreateSettings()),
+	}
+
+	metrics := indexedFakeMetrics(MetricsData(zap.NewNop(), summary, NewMetadata(nil, podsMetadata, NodeLimits{}, nil),
+		map[MetricGroup]bool{PodMetricGroup: true}, nil, mbs))
+	require.Equal(t, int64(1024), metrics["k8s.pod.ephemeral_storage.usage"][0].Gauge().DataPoints().At(0).IntValue())
+	require.Equal(t, int64(1536*1024*1024), metrics["k8s.pod.ephemeral_storage.limit"][0].Gauge().DataPoints().At(0).IntValue())
+
+	// The limit isn't reported if any container doesn't have one.
+	podsMetadata.Items[0].Spec.Containers = append(podsMetadata.Items[0].Spec.Containers, v1.Container{})
+	metrics = indexedFakeMetrics(MetricsData(zap.NewNop(), summary, NewMetadata(nil, podsMetadata, NodeLimits{}, nil),
+		map[MetricGroup]bool{PodMetricGroup: true}, nil, mbs))
+	requireContains(t, metrics, "k8s.pod.ephemeral_storage.usage")
+	require.NotContains(t, metrics, "k8s.pod.ephemeral_storage.limit")
+}
+
 func requireContains(t *testing.T, metrics map[string][]pmetric.Metric, metricName string) {
 	_, found := metrics[metricName]
 	require.True(t, found)
@@ -221,5 +318,5 @@ func fakeMetrics() []pmetric.Metrics {
 		ContainerMetricsBuilder: metadata.NewMetricsBuilder(metadata.DefaultMetricsBuilderConfig(), receivertest.NewNopCreateSettings()),
 		OtherMetricsBuilder:     metadata.NewMetricsBuilder(metadata.DefaultMetricsBuilderConfig(), receivertest.NewNopCreateSettings()),
 	}
-	return MetricsData(zap.NewNop(), summary, Metadata{}, mgs, mbs)
+	return MetricsData(zap.NewNop(), summary, Metadata{}, mgs, nil, mbs)
 }
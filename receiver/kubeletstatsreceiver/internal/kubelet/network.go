@@ -10,18 +10,31 @@ import (
 	"github.com/open-telemetry/opentelemetry-collector-contrib/receiver/kubeletstatsreceiver/internal/metadata"
 )
 
-type getNetworkDataFunc func(s *stats.NetworkStats) (rx *uint64, tx *uint64)
+type getNetworkDataFunc func(s *stats.InterfaceStats) (rx *uint64, tx *uint64)
 
-func addNetworkMetrics(mb *metadata.MetricsBuilder, networkMetrics metadata.NetworkMetrics, s *stats.NetworkStats, currentTime pcommon.Timestamp) {
+// addNetworkMetrics records the network metrics of the default interface, or of every
+// interface reported by the kubelet if allInterfaces is set.
+func addNetworkMetrics(mb *metadata.MetricsBuilder, networkMetrics metadata.NetworkMetrics, s *stats.NetworkStats, currentTime pcommon.Timestamp, allInterfaces bool) {
 	if s == nil {
 		return
 	}
 
+	if !allInterfaces || len(s.Interfaces) == 0 {
+		addInterfaceMetrics(mb, networkMetrics, &s.InterfaceStats, currentTime)
+		return
+	}
+
+	for i := range s.Interfaces {
+		addInterfaceMetrics(mb, networkMetrics, &s.Interfaces[i], currentTime)
+	}
+}
+
+func addInterfaceMetrics(mb *metadata.MetricsBuilder, networkMetrics metadata.NetworkMetrics, s *stats.InterfaceStats, currentTime pcommon.Timestamp) {
 	recordNetworkDataPoint(mb, networkMetrics.IO, s, getNetworkIO, currentTime)
 	recordNetworkDataPoint(mb, networkMetrics.Errors, s, getNetworkErrors, currentTime)
 }
 
-func recordNetworkDataPoint(mb *metadata.MetricsBuilder, recordDataPoint metadata.RecordIntDataPointWithDirectionFunc, s *stats.NetworkStats, getData getNetworkDataFunc, currentTime pcommon.Timestamp) {
+func recordNetworkDataPoint(mb *metadata.MetricsBuilder, recordDataPoint metadata.RecordIntDataPointWithDirectionFunc, s *stats.InterfaceStats, getData getNetworkDataFunc, currentTime pcommon.Timestamp) {
 	rx, tx := getData(s)
 
 	if rx != nil {
@@ -33,10 +46,10 @@ func recordNetworkDataPoint(mb *metadata.MetricsBuilder, recordDataPoint metadat
 	}
 }
 
-func getNetworkIO(s *stats.NetworkStats) (*uint64, *uint64) {
+func getNetworkIO(s *stats.InterfaceStats) (*uint64, *uint64) {
 	return s.RxBytes, s.TxBytes
 }
 
-func getNetworkErrors(s *stats.NetworkStats) (*uint64, *uint64) {
+func getNetworkErrors(s *stats.InterfaceStats) (*uint64, *uint64) {
 	return s.RxErrors, s.TxErrors
 }
@@ -66,6 +66,8 @@ type MetricsConfig struct {
 	K8sPodCPUUtilization                 MetricConfig `mapstructure:"k8s.pod.cpu.utilization"`
 	K8sPodCPULimitUtilization            MetricConfig `mapstructure:"k8s.pod.cpu_limit_utilization"`
 	K8sPodCPURequestUtilization          MetricConfig `mapstructure:"k8s.pod.cpu_request_utilization"`
+	K8sPodEphemeralStorageLimit          MetricConfig `mapstructure:"k8s.pod.ephemeral_storage.limit"`
+	K8sPodEphemeralStorageUsage          MetricConfig `mapstructure:"k8s.pod.ephemeral_storage.usage"`
 	K8sPodFilesystemAvailable            MetricConfig `mapstructure:"k8s.pod.filesystem.available"`
 	K8sPodFilesystemCapacity             MetricConfig `mapstructure:"k8s.pod.filesystem.capacity"`
 	K8sPodFilesystemUsage                MetricConfig `mapstructure:"k8s.pod.filesystem.usage"`
@@ -203,6 +205,12 @@ func DefaultMetricsConfig() MetricsConfig {
 		K8sPodCPURequestUtilization: MetricConfig{
 			Enabled: false,
 		},
+		K8sPodEphemeralStorageLimit: MetricConfig{
+			Enabled: false,
+		},
+		K8sPodEphemeralStorageUsage: MetricConfig{
+			Enabled: false,
+		},
 		K8sPodFilesystemAvailable: MetricConfig{
 			Enabled: true,
 		},
@@ -63,6 +63,8 @@ func TestMetricsBuilderConfig(t *testing.T) {
 					K8sPodCPUUtilization:                 MetricConfig{Enabled: true},
 					K8sPodCPULimitUtilization:            MetricConfig{Enabled: true},
 					K8sPodCPURequestUtilization:          MetricConfig{Enabled: true},
+					K8sPodEphemeralStorageLimit:          MetricConfig{Enabled: true},
+					K8sPodEphemeralStorageUsage:          MetricConfig{Enabled: true},
 					K8sPodFilesystemAvailable:            MetricConfig{Enabled: true},
 					K8sPodFilesystemCapacity:             MetricConfig{Enabled: true},
 					K8sPodFilesystemUsage:                MetricConfig{Enabled: true},
@@ -144,6 +146,8 @@ func TestMetricsBuilderConfig(t *testing.T) {
 					K8sPodCPUUtilization:                 MetricConfig{Enabled: false},
 					K8sPodCPULimitUtilization:            MetricConfig{Enabled: false},
 					K8sPodCPURequestUtilization:          MetricConfig{Enabled: false},
+					K8sPodEphemeralStorageLimit:          MetricConfig{Enabled: false},
+					K8sPodEphemeralStorageUsage:          MetricConfig{Enabled: false},
 					K8sPodFilesystemAvailable:            MetricConfig{Enabled: false},
 					K8sPodFilesystemCapacity:             MetricConfig{Enabled: false},
 					K8sPodFilesystemUsage:                MetricConfig{Enabled: false},
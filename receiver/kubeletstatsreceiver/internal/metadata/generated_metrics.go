@@ -1920,6 +1920,104 @@ func newMetricK8sPodCPURequestUtilization(cfg MetricConfig) metricK8sPodCPUReque
 	return m
 }
 
+type metricK8sPodEphemeralStorageLimit struct {
+	data     pmetric.Metric // data buffer for generated metric.
+	config   MetricConfig   // metric config provided by user.
+	capacity int            // max observed number of data points added to the metric.
+}
+
+// init fills k8s.pod.ephemeral_storage.limit metric with initial data.
+func (m *metricK8sPodEphemeralStorageLimit) init() {
+	m.data.SetName("k8s.pod.ephemeral_storage.limit")
+	m.data.SetDescription("Pod ephemeral storage limit, the sum of the container limits. If any container is missing a limit the metric is not emitted.")
+	m.data.SetUnit("By")
+	m.data.SetEmptyGauge()
+}
+
+func (m *metricK8sPodEphemeralStorageLimit) recordDataPoint(start pcommon.Timestamp, ts pcommon.Timestamp, val int64) {
+	if !m.config.Enabled {
+		return
+	}
+	dp := m.data.Gauge().DataPoints().AppendEmpty()
+	dp.SetStartTimestamp(start)
+	dp.SetTimestamp(ts)
+	dp.SetIntValue(val)
+}
+
+// updateCapacity saves max length of data point slices that will be used for the slice capacity.
+func (m *metricK8sPodEphemeralStorageLimit) updateCapacity() {
+	if m.data.Gauge().DataPoints().Len() > m.capacity {
+		m.capacity = m.data.Gauge().DataPoints().Len()
+	}
+}
+
+// emit appends recorded metric data to a metrics slice and prepares it for recording another set of data points.
+func (m *metricK8sPodEphemeralStorageLimit) emit(metrics pmetric.MetricSlice) {
+	if m.config.Enabled && m.data.Gauge().DataPoints().Len() > 0 {
+		m.updateCapacity()
+		m.data.MoveTo(metrics.AppendEmpty())
+		m.init()
+	}
+}
+
+func newMetricK8sPodEphemeralStorageLimit(cfg MetricConfig) metricK8sPodEphemeralStorageLimit {
+	m := metricK8sPodEphemeralStorageLimit{config: cfg}
+	if cfg.Enabled {
+		m.data = pmetric.NewMetric()
+		m.init()
+	}
+	return m
+}
+
+type metricK8sPodEphemeralStorageUsage struct {
+	data     pmetric.Metric // data buffer for generated metric.
+	config   MetricConfig   // metric config provided by user.
+	capacity int            // max observed number of data points added to the metric.
+}
+
+// init fills k8s.pod.ephemeral_storage.usage metric with initial data.
+func (m *metricK8sPodEphemeralStorageUsage) init() {
+	m.data.SetName("k8s.pod.ephemeral_storage.usage")
+	m.data.SetDescription("Pod ephemeral storage usage, including the container writable layers, logs and emptyDir volumes")
+	m.data.SetUnit("By")
+	m.data.SetEmptyGauge()
+}
+
+func (m *metricK8sPodEphemeralStorageUsage) recordDataPoint(start pcommon.Timestamp, ts pcommon.Timestamp, val int64) {
+	if !m.config.Enabled {
+		return
+	}
+	dp := m.data.Gauge().DataPoints().AppendEmpty()
+	dp.SetStartTimestamp(start)
+	dp.SetTimestamp(ts)
+	dp.SetIntValue(val)
+}
+
+// updateCapacity saves max length of data point slices that will be used for the slice capacity.
+func (m *metricK8sPodEphemeralStorageUsage) updateCapacity() {
+	if m.data.Gauge().DataPoints().Len() > m.capacity {
+		m.capacity = m.data.Gauge().DataPoints().Len()
+	}
+}
+
+// emit appends recorded metric data to a metrics slice and prepares it for recording another set of data points.
+func (m *metricK8sPodEphemeralStorageUsage) emit(metrics pmetric.MetricSlice) {
+	if m.config.Enabled && m.data.Gauge().DataPoints().Len() > 0 {
+		m.updateCapacity()
+		m.data.MoveTo(metrics.AppendEmpty())
+		m.init()
+	}
+}
+
+func newMetricK8sPodEphemeralStorageUsage(cfg MetricConfig) metricK8sPodEphemeralStorageUsage {
+	m := metricK8sPodEphemeralStorageUsage{config: cfg}
+	if cfg.Enabled {
+		m.data = pmetric.NewMetric()
+		m.init()
+	}
+	return m
+}
+
 type metricK8sPodFilesystemAvailable struct {
 	data     pmetric.Metric // data buffer for generated metric.
 	config   MetricConfig   // metric config provided by user.
@@ -2911,6 +3009,8 @@ type MetricsBuilder struct {
 	metricK8sPodCPUUtilization                 metricK8sPodCPUUtilization
 	metricK8sPodCPULimitUtilization            metricK8sPodCPULimitUtilization
 	metricK8sPodCPURequestUtilization          metricK8sPodCPURequestUtilization
+	metricK8sPodEphemeralStorageLimit          metricK8sPodEphemeralStorageLimit
+	metricK8sPodEphemeralStorageUsage          metricK8sPodEphemeralStorageUsage
 	metricK8sPodFilesystemAvailable            metricK8sPodFilesystemAvailable
 	metricK8sPodFilesystemCapacity             metricK8sPodFilesystemCapacity
 	metricK8sPodFilesystemUsage                metricK8sPodFilesystemUsage
@@ -2995,6 +3095,8 @@ func NewMetricsBuilder(mbc MetricsBuilderConfig, settings receiver.CreateSetting
 		metricK8sPodCPUUtilization:                 newMetricK8sPodCPUUtilization(mbc.Metrics.K8sPodCPUUtilization),
 		metricK8sPodCPULimitUtilization:            newMetricK8sPodCPULimitUtilization(mbc.Metrics.K8sPodCPULimitUtilization),
 		metricK8sPodCPURequestUtilization:          newMetricK8sPodCPURequestUtilization(mbc.Metrics.K8sPodCPURequestUtilization),
+		metricK8sPodEphemeralStorageLimit:          newMetricK8sPodEphemeralStorageLimit(mbc.Metrics.K8sPodEphemeralStorageLimit),
+		metricK8sPodEphemeralStorageUsage:          newMetricK8sPodEphemeralStorageUsage(mbc.Metrics.K8sPodEphemeralStorageUsage),
 		metricK8sPodFilesystemAvailable:            newMetricK8sPodFilesystemAvailable(mbc.Metrics.K8sPodFilesystemAvailable),
 		metricK8sPodFilesystemCapacity:             newMetricK8sPodFilesystemCapacity(mbc.Metrics.K8sPodFilesystemCapacity),
 		metricK8sPodFilesystemUsage:                newMetricK8sPodFilesystemUsage(mbc.Metrics.K8sPodFilesystemUsage),
@@ -3206,6 +3308,8 @@ func (mb *MetricsBuilder) EmitForResource(rmo ...ResourceMetricsOption) {
 	mb.metricK8sPodCPUUtilization.emit(ils.Metrics())
 	mb.metricK8sPodCPULimitUtilization.emit(ils.Metrics())
 	mb.metricK8sPodCPURequestUtilization.emit(ils.Metrics())
+	mb.metricK8sPodEphemeralStorageLimit.emit(ils.Metrics())
+	mb.metricK8sPodEphemeralStorageUsage.emit(ils.Metrics())
 	mb.metricK8sPodFilesystemAvailable.emit(ils.Metrics())
 	mb.metricK8sPodFilesystemCapacity.emit(ils.Metrics())
 	mb.metricK8sPodFilesystemUsage.emit(ils.Metrics())
@@ -3446,6 +3550,16 @@ func (mb *MetricsBuilder) RecordK8sPodCPURequestUtilizationDataPoint(ts pcommon.
 	mb.metricK8sPodCPURequestUtilization.recordDataPoint(mb.startTime, ts, val)
 }
 
+// RecordK8sPodEphemeralStorageLimitDataPoint adds a data point to k8s.pod.ephemeral_storage.limit metric.
+func (mb *MetricsBuilder) RecordK8sPodEphemeralStorageLimitDataPoint(ts pcommon.Timestamp, val int64) {
+	mb.metricK8sPodEphemeralStorageLimit.recordDataPoint(mb.startTime, ts, val)
+}
+
+// RecordK8sPodEphemeralStorageUsageDataPoint adds a data point to k8s.pod.ephemeral_storage.usage metric.
+func (mb *MetricsBuilder) RecordK8sPodEphemeralStorageUsageDataPoint(ts pcommon.Timestamp, val int64) {
+	mb.metricK8sPodEphemeralStorageUsage.recordDataPoint(mb.startTime, ts, val)
+}
+
 // RecordK8sPodFilesystemAvailableDataPoint adds a data point to k8s.pod.filesystem.available metric.
 func (mb *MetricsBuilder) RecordK8sPodFilesystemAvailableDataPoint(ts pcommon.Timestamp, val int64) {
 	mb.metricK8sPodFilesystemAvailable.recordDataPoint(mb.startTime, ts, val)
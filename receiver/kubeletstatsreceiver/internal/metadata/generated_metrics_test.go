@@ -220,6 +220,12 @@ func TestMetricsBuilder(t *testing.T) {
 			allMetricsCount++
 			mb.RecordK8sPodCPURequestUtilizationDataPoint(ts, 1)
 
+			allMetricsCount++
+			mb.RecordK8sPodEphemeralStorageLimitDataPoint(ts, 1)
+
+			allMetricsCount++
+			mb.RecordK8sPodEphemeralStorageUsageDataPoint(ts, 1)
+
 			defaultMetricsCount++
 			allMetricsCount++
 			mb.RecordK8sPodFilesystemAvailableDataPoint(ts, 1)
@@ -813,6 +819,30 @@ func TestMetricsBuilder(t *testing.T) {
 					assert.Equal(t, ts, dp.Timestamp())
 					assert.Equal(t, pmetric.NumberDataPointValueTypeDouble, dp.ValueType())
 					assert.Equal(t, float64(1), dp.DoubleValue())
+				case "k8s.pod.ephemeral_storage.limit":
+					assert.False(t, validatedMetrics["k8s.pod.ephemeral_storage.limit"], "Found a duplicate in the metrics slice: k8s.pod.ephemeral_storage.limit")
+					validatedMetrics["k8s.pod.ephemeral_storage.limit"] = true
+					assert.Equal(t, pmetric.MetricTypeGauge, ms.At(i).Type())
+					assert.Equal(t, 1, ms.At(i).Gauge().DataPoints().Len())
+					assert.Equal(t, "Pod ephemeral storage limit, the sum of the container limits. If any container is missing a limit the metric is not emitted.", ms.At(i).Description())
+					assert.Equal(t, "By", ms.At(i).Unit())
+					dp := ms.At(i).Gauge().DataPoints().At(0)
+					assert.Equal(t, start, dp.StartTimestamp())
+					assert.Equal(t, ts, dp.Timestamp())
+					assert.Equal(t, pmetric.NumberDataPointValueTypeInt, dp.ValueType())
+					assert.Equal(t, int64(1), dp.IntValue())
+				case "k8s.pod.ephemeral_storage.usage":
+					assert.False(t, validatedMetrics["k8s.pod.ephemeral_storage.usage"], "Found a duplicate in the metrics slice: k8s.pod.ephemeral_storage.usage")
+					validatedMetrics["k8s.pod.ephemeral_storage.usage"] = true
+					assert.Equal(t, pmetric.MetricTypeGauge, ms.At(i).Type())
+					assert.Equal(t, 1, ms.At(i).Gauge().DataPoints().Len())
+					assert.Equal(t, "Pod ephemeral storage usage, including the container writable layers, logs and emptyDir volumes", ms.At(i).Description())
+					assert.Equal(t, "By", ms.At(i).Unit())
+					dp := ms.At(i).Gauge().DataPoints().At(0)
+					assert.Equal(t, start, dp.StartTimestamp())
+					assert.Equal(t, ts, dp.Timestamp())
+					assert.Equal(t, pmetric.NumberDataPointValueTypeInt, dp.ValueType())
+					assert.Equal(t, int64(1), dp.IntValue())
 				case "k8s.pod.filesystem.available":
 					assert.False(t, validatedMetrics["k8s.pod.filesystem.available"], "Found a duplicate in the metrics slice: k8s.pod.filesystem.available")
 					validatedMetrics["k8s.pod.filesystem.available"] = true
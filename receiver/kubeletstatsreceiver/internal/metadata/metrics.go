@@ -116,6 +116,16 @@ var ContainerFilesystemMetrics = FilesystemMetrics{
 	Usage:     (*MetricsBuilder).RecordContainerFilesystemUsageDataPoint,
 }
 
+type EphemeralStorageMetrics struct {
+	Usage RecordIntDataPointFunc
+	Limit RecordIntDataPointFunc
+}
+
+var PodEphemeralStorageMetrics = EphemeralStorageMetrics{
+	Usage: (*MetricsBuilder).RecordK8sPodEphemeralStorageUsageDataPoint,
+	Limit: (*MetricsBuilder).RecordK8sPodEphemeralStorageLimitDataPoint,
+}
+
 type NetworkMetrics struct {
 	IO     RecordIntDataPointWithDirectionFunc
 	Errors RecordIntDataPointWithDirectionFunc
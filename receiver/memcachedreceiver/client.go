@@ -4,7 +4,10 @@
 package memcachedreceiver // import "github.com/open-telemetry/opentelemetry-collector-contrib/receiver/memcachedreceiver"
 
 import (
+	"bufio"
+	"fmt"
 	"net"
+	"strings"
 	"time"
 
 	"github.com/grobie/gomemcache/memcache"
@@ -25,3 +28,69 @@ func newMemcachedClient(endpoint string, timeout time.Duration) (client, error)
 	newClient.Timeout = timeout
 	return newClient, nil
 }
+
+type discoverNodesFunc func(endpoint string, timeout time.Duration) ([]string, error)
+
+// discoverClusterNodes returns the endpoints of the nodes of the cluster the given node belongs to, using the
+// `config get cluster` auto discovery command, as supported by Amazon ElastiCache.
+func discoverClusterNodes(endpoint string, timeout time.Duration) ([]string, error) {
+	conn, err := net.DialTimeout("tcp", endpoint, timeout)
+	if err != nil {
+		return nil, err
+	}
+	defer conn.Close()
+
+	if timeout > 0 {
+		if err = conn.SetDeadline(time.Now().Add(timeout)); err != nil {
+			return nil, err
+		}
+	}
+	if _, err = conn.Write([]byte("config get cluster\r\n")); err != nil {
+		return nil, err
+	}
+	return parseClusterConfig(bufio.NewReader(conn))
+}
+
+// parseClusterConfig parses the response to the `config get cluster` command, which is made of the configuration
+// version, followed by a line of space separated nodes in the `hostname|ip|port` format.
+func parseClusterConfig(r *bufio.Reader) ([]string, error) {
+	header, err := r.ReadString('\n')
+	if err != nil {
+		return nil, err
+	}
+	if !strings.HasPrefix(header, "CONFIG cluster ") {
+		return nil, fmt.Errorf("the node doesn't support cluster auto discovery: %q", strings.TrimSpace(header))
+	}
+
+	var lines []string
+	for {
+		line, err := r.ReadString('\n')
+		if err != nil {
+			return nil, err
+		}
+		line = strings.TrimSpace(line)
+		if line == "END" {
+			break
+		}
+		if line != "" {
+			lines = append(lines, line)
+		}
+	}
+	if len(lines) != 2 {
+		return nil, fmt.Errorf("unexpected cluster configuration: %q", lines)
+	}
+
+	var nodes []string
+	for _, node := range strings.Fields(lines[1]) {
+		parts := strings.Split(node, "|")
+		if len(parts) != 3 {
+			return nil, fmt.Errorf("unexpected cluster node: %q", node)
+		}
+		host := parts[0]
+		if host == "" {
+			host = parts[1]
+		}
+		nodes = append(nodes, net.JoinHostPort(host, parts[2]))
+	}
+	return nodes, nil
+}
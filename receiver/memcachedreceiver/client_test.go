@@ -0,0 +1,55 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package memcachedreceiver
+
+import (
+	"bufio"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseClusterConfig(t *testing.T) {
+	testCases := []struct {
+		desc     string
+		response string
+		expected []string
+		errMsg   string
+	}{
+		{
+			desc: "cluster nodes",
+			response: "CONFIG cluster 0 147\r\n12\n" +
+				"node1.cache.amazonaws.com|10.82.235.120|11211 node2.cache.amazonaws.com|10.80.249.27|11211\n\r\nEND\r\n",
+			expected: []string{"node1.cache.amazonaws.com:11211", "node2.cache.amazonaws.com:11211"},
+		},
+		{
+			desc:     "nodes without hostname",
+			response: "CONFIG cluster 0 34\r\n1\n|10.82.235.120|11211\n\r\nEND\r\n",
+			expected: []string{"10.82.235.120:11211"},
+		},
+		{
+			desc:     "auto discovery not supported",
+			response: "ERROR\r\n",
+			errMsg:   `the node doesn't support cluster auto discovery: "ERROR"`,
+		},
+		{
+			desc:     "invalid node",
+			response: "CONFIG cluster 0 20\r\n1\nnode1|11211\n\r\nEND\r\n",
+			errMsg:   `unexpected cluster node: "node1|11211"`,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.desc, func(t *testing.T) {
+			nodes, err := parseClusterConfig(bufio.NewReader(strings.NewReader(tc.response)))
+			if tc.errMsg != "" {
+				require.EqualError(t, err, tc.errMsg)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.expected, nodes)
+		})
+	}
+}
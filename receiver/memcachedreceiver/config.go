@@ -14,6 +14,9 @@ type Config struct {
 	scraperhelper.ControllerConfig `mapstructure:",squash"`
 	confignet.AddrConfig           `mapstructure:",squash"`
 
+	// CollectClusterMetrics discovers the nodes of the cluster from the endpoint, and scrapes all of them.
+	CollectClusterMetrics bool `mapstructure:"collect_cluster_metrics"`
+
 	// MetricsBuilderConfig allows customizing scraped metrics/attributes representation.
 	metadata.MetricsBuilderConfig `mapstructure:",squash"`
 }
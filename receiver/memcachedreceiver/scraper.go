@@ -5,12 +5,15 @@ package memcachedreceiver // import "github.com/open-telemetry/opentelemetry-col
 
 import (
 	"context"
+	"net"
 	"strconv"
 	"time"
 
+	"github.com/grobie/gomemcache/memcache"
 	"go.opentelemetry.io/collector/pdata/pcommon"
 	"go.opentelemetry.io/collector/pdata/pmetric"
 	"go.opentelemetry.io/collector/receiver"
+	"go.opentelemetry.io/collector/receiver/scrapererror"
 	"go.uber.org/zap"
 
 	"github.com/open-telemetry/opentelemetry-collector-contrib/receiver/memcachedreceiver/internal/metadata"
@@ -21,6 +24,8 @@ type memcachedScraper struct {
 	config    *Config
 	mb        *metadata.MetricsBuilder
 	newClient newMemcachedClientFunc
+
+	discoverNodes discoverNodesFunc
 }
 
 func newMemcachedScraper(
@@ -32,10 +37,16 @@ func newMemcachedScraper(
 		config:    config,
 		newClient: newMemcachedClient,
 		mb:        metadata.NewMetricsBuilder(config.MetricsBuilderConfig, settings),
+
+		discoverNodes: discoverClusterNodes,
 	}
 }
 
 func (r *memcachedScraper) scrape(_ context.Context) (pmetric.Metrics, error) {
+	if r.config.CollectClusterMetrics {
+		return r.scrapeCluster()
+	}
+
 	// Init client in scrape method in case there are transient errors in the
 	// constructor.
 	statsClient, err := r.newClient(r.config.Endpoint, r.config.Timeout)
@@ -50,8 +61,43 @@ func (r *memcachedScraper) scrape(_ context.Context) (pmetric.Metrics, error) {
 		return pmetric.Metrics{}, err
 	}
 
-	now := pcommon.NewTimestampFromTime(time.Now())
+	r.recordStats(pcommon.NewTimestampFromTime(time.Now()), allServerStats)
+	return r.mb.Emit(), nil
+}
 
+// scrapeCluster discovers the nodes of the cluster from the configured endpoint, and scrapes each of them, with
+// the node endpoint as resource.
+func (r *memcachedScraper) scrapeCluster() (pmetric.Metrics, error) {
+	nodes, err := r.discoverNodes(r.config.Endpoint, r.config.Timeout)
+	if err != nil {
+		r.logger.Error("Failed to discover the memcached cluster nodes", zap.Error(err))
+		return pmetric.Metrics{}, err
+	}
+
+	errs := &scrapererror.ScrapeErrors{}
+	for _, node := range nodes {
+		statsClient, err := r.newClient(node, r.config.Timeout)
+		if err != nil {
+			r.logger.Error("Failed to establish client", zap.String("node", node), zap.Error(err))
+			errs.AddPartial(1, err)
+			continue
+		}
+		allServerStats, err := statsClient.Stats()
+		if err != nil {
+			r.logger.Error("Failed to fetch memcached stats", zap.String("node", node), zap.Error(err))
+			errs.AddPartial(1, err)
+			continue
+		}
+		r.recordStats(pcommon.NewTimestampFromTime(time.Now()), allServerStats)
+
+		rb := r.mb.NewResourceBuilder()
+		rb.SetMemcachedNodeEndpoint(node)
+		r.mb.EmitForResource(metadata.WithResource(rb.Emit()))
+	}
+	return r.mb.Emit(), errs.Combine()
+}
+
+func (r *memcachedScraper) recordStats(now pcommon.Timestamp, allServerStats map[net.Addr]memcache.Stats) {
 	for _, stats := range allServerStats {
 		for k, v := range stats.Stats {
 			switch k {
@@ -169,7 +215,6 @@ func (r *memcachedScraper) scrape(_ context.Context) (pmetric.Metrics, error) {
 		}
 	}
 
-	return r.mb.Emit(), nil
 }
 
 func calculateHitRatio(misses, hits int64) float64 {
@@ -5,12 +5,14 @@ package memcachedreceiver
 
 import (
 	"context"
+	"errors"
 	"path/filepath"
 	"testing"
 	"time"
 
 	"github.com/stretchr/testify/require"
 	"go.opentelemetry.io/collector/receiver/receivertest"
+	"go.opentelemetry.io/collector/receiver/scrapererror"
 
 	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/golden"
 	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/pdatatest/pmetrictest"
@@ -34,3 +36,31 @@ func TestScraper(t *testing.T) {
 	require.NoError(t, pmetrictest.CompareMetrics(expectedMetrics, actualMetrics,
 		pmetrictest.IgnoreMetricDataPointsOrder(), pmetrictest.IgnoreStartTimestamp(), pmetrictest.IgnoreTimestamp()))
 }
+
+func TestScraperCluster(t *testing.T) {
+	f := NewFactory()
+	cfg := f.CreateDefaultConfig().(*Config)
+	cfg.CollectClusterMetrics = true
+	scraper := newMemcachedScraper(receivertest.NewNopCreateSettings(), cfg)
+	scraper.discoverNodes = func(string, time.Duration) ([]string, error) {
+		return []string{"node1:11211", "node2:11211", "node3:11211"}, nil
+	}
+	scraper.newClient = func(endpoint string, _ time.Duration) (client, error) {
+		if endpoint == "node3:11211" {
+			return nil, errors.New("connection refused")
+		}
+		return &fakeClient{}, nil
+	}
+
+	actualMetrics, err := scraper.scrape(context.Background())
+	var partialScrapeErr scrapererror.PartialScrapeError
+	require.True(t, errors.As(err, &partialScrapeErr), "returned error was not PartialScrapeError")
+
+	expectedFile := filepath.Join("testdata", "scraper", "expected_cluster.yaml")
+	expectedMetrics, err := golden.ReadMetrics(expectedFile)
+	require.NoError(t, err)
+
+	require.NoError(t, pmetrictest.CompareMetrics(expectedMetrics, actualMetrics,
+		pmetrictest.IgnoreMetricDataPointsOrder(), pmetrictest.IgnoreStartTimestamp(), pmetrictest.IgnoreTimestamp(),
+		pmetrictest.IgnoreResourceMetricsOrder()))
+}
@@ -0,0 +1,134 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package modbusreceiver // import "github.com/open-telemetry/opentelemetry-collector-contrib/receiver/modbusreceiver"
+
+import (
+	"context"
+	"encoding/binary"
+	"fmt"
+	"io"
+	"net"
+)
+
+// functionCode is the code of a Modbus function.
+type functionCode byte
+
+const (
+	readCoils            functionCode = 0x01
+	readDiscreteInputs   functionCode = 0x02
+	readHoldingRegisters functionCode = 0x03
+	readInputRegisters   functionCode = 0x04
+
+	// exceptionFlag is set on the function code of the responses reporting an exception.
+	exceptionFlag = 0x80
+	// mbapHeaderLength is the length of the header of the Modbus TCP frames.
+	mbapHeaderLength = 7
+	// maxFrameLength is the maximum length of the Modbus TCP frames, header included.
+	maxFrameLength = 260
+)
+
+var functionCodes = map[string]functionCode{
+	registerTypeCoil:          readCoils,
+	registerTypeDiscreteInput: readDiscreteInputs,
+	registerTypeHolding:       readHoldingRegisters,
+	registerTypeInput:         readInputRegisters,
+}
+
+var exceptionMessages = map[byte]string{
+	0x01: "illegal function",
+	0x02: "illegal data address",
+	0x03: "illegal data value",
+	0x04: "server device failure",
+	0x05: "acknowledge",
+	0x06: "server device busy",
+	0x08: "memory parity error",
+	0x0A: "gateway path unavailable",
+	0x0B: "gateway target device failed to respond",
+}
+
+// exceptionError is the exception returned by the device for a request, the connection is still usable.
+type exceptionError struct {
+	code byte
+}
+
+func (e *exceptionError) Error() string {
+	if msg, ok := exceptionMessages[e.code]; ok {
+		return fmt.Sprintf("exception %d: %s", e.code, msg)
+	}
+	return fmt.Sprintf("exception %d", e.code)
+}
+
+// client sends the requests of the receiver to a Modbus TCP server, one at a time.
+type client struct {
+	conn          net.Conn
+	unitID        byte
+	transactionID uint16
+}
+
+func dial(ctx context.Context, endpoint string, unitID byte) (*client, error) {
+	var d net.Dialer
+	conn, err := d.DialContext(ctx, "tcp", endpoint)
+	if err != nil {
+		return nil, err
+	}
+	return &client{conn: conn, unitID: unitID}, nil
+}
+
+// read reads quantity registers from address with the given function, and returns the data of the response.
+func (c *client) read(ctx context.Context, function functionCode, address, quantity uint16) ([]byte, error) {
+	// without deadline, the zero time leaves the connection without timeout
+	deadline, _ := ctx.Deadline()
+	if err := c.conn.SetDeadline(deadline); err != nil {
+		return nil, err
+	}
+
+	c.transactionID++
+	request := make([]byte, mbapHeaderLength+5)
+	binary.BigEndian.PutUint16(request[0:], c.transactionID)
+	// request[2:4] is the protocol identifier, 0 for Modbus
+	binary.BigEndian.PutUint16(request[4:], 6)
+	request[6] = c.unitID
+	request[7] = byte(function)
+	binary.BigEndian.PutUint16(request[8:], address)
+	binary.BigEndian.PutUint16(request[10:], quantity)
+	if _, err := c.conn.Write(request); err != nil {
+		return nil, err
+	}
+
+	header := make([]byte, mbapHeaderLength)
+	if _, err := io.ReadFull(c.conn, header); err != nil {
+		return nil, err
+	}
+	length := int(binary.BigEndian.Uint16(header[4:]))
+	if length < 2 || mbapHeaderLength-1+length > maxFrameLength {
+		return nil, fmt.Errorf("invalid length %d of the response", length)
+	}
+	pdu := make([]byte, length-1)
+	if _, err := io.ReadFull(c.conn, pdu); err != nil {
+		return nil, err
+	}
+	if id := binary.BigEndian.Uint16(header[0:]); id != c.transactionID {
+		return nil, fmt.Errorf("unexpected transaction %d of the response, expected %d", id, c.transactionID)
+	}
+
+	switch pdu[0] {
+	case byte(function):
+	case byte(function) | exceptionFlag:
+		return nil, &exceptionError{code: pdu[1]}
+	default:
+		return nil, fmt.Errorf("unexpected function %d of the response", pdu[0])
+	}
+	expected := int(quantity) * 2
+	if function == readCoils || function == readDiscreteInputs {
+		expected = (int(quantity) + 7) / 8
+	}
+	if len(pdu) < 2 || int(pdu[1]) != expected || len(pdu) != expected+2 {
+		return nil, fmt.Errorf("unexpected length of the response data, expected %d bytes", expected)
+	}
+	return pdu[2:], nil
+}
+
+func (c *client) close() error {
+	return c.conn.Close()
+}
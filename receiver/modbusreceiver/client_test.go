@@ -0,0 +1,244 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package modbusreceiver
+
+import (
+	"context"
+	"encoding/binary"
+	"errors"
+	"io"
+	"net"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// fakeServer is a Modbus TCP server answering the read requests from its registers.
+type fakeServer struct {
+	listener net.Listener
+	unitID   byte
+
+	mu sync.Mutex
+	// registers holds the 16-bit registers and the single-bit registers, 0 or 1, of each read function.
+	registers map[functionCode]map[uint16]uint16
+	// respond overrides the response of the server when set.
+	respond func(request []byte) []byte
+	conns   int
+}
+
+func newFakeServer(t *testing.T) *fakeServer {
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	s := &fakeServer{
+		listener:  listener,
+		unitID:    1,
+		registers: map[functionCode]map[uint16]uint16{},
+	}
+	go s.serve()
+	t.Cleanup(func() {
+		_ = listener.Close()
+	})
+	return s
+}
+
+func (s *fakeServer) endpoint() string {
+	return s.listener.Addr().String()
+}
+
+func (s *fakeServer) set(function functionCode, address uint16, values ...uint16) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.registers[function] == nil {
+		s.registers[function] = map[uint16]uint16{}
+	}
+	for i, v := range values {
+		s.registers[function][address+uint16(i)] = v
+	}
+}
+
+func (s *fakeServer) connections() int {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.conns
+}
+
+func (s *fakeServer) serve() {
+	for {
+		conn, err := s.listener.Accept()
+		if err != nil {
+			return
+		}
+		s.mu.Lock()
+		s.conns++
+		s.mu.Unlock()
+		go s.handle(conn)
+	}
+}
+
+func (s *fakeServer) handle(conn net.Conn) {
+	defer conn.Close()
+	for {
+		request := make([]byte, 12)
+		if _, err := io.ReadFull(conn, request); err != nil {
+			return
+		}
+		if _, err := conn.Write(s.response(request)); err != nil {
+			return
+		}
+	}
+}
+
+func (s *fakeServer) response(request []byte) []byte {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.respond != nil {
+		return s.respond(request)
+	}
+
+	function := functionCode(request[7])
+	address := binary.BigEndian.Uint16(request[8:])
+	quantity := binary.BigEndian.Uint16(request[10:])
+	registers, ok := s.registers[function]
+	var data []byte
+	for i := uint16(0); ok && i < quantity; i++ {
+		v, found := registers[address+i]
+		if !found {
+			ok = false
+			break
+		}
+		switch function {
+		case readCoils, readDiscreteInputs:
+			if i%8 == 0 {
+				data = append(data, 0)
+			}
+			data[len(data)-1] |= byte(v&1) << (i % 8)
+		default:
+			data = binary.BigEndian.AppendUint16(data, v)
+		}
+	}
+	pdu := append([]byte{byte(function), byte(len(data))}, data...)
+	if !ok {
+		// illegal data address
+		pdu = []byte{byte(function) | exceptionFlag, 0x02}
+	}
+	return frame(request, s.unitID, pdu)
+}
+
+// frame returns the response to the request holding the given PDU.
+func frame(request []byte, unitID byte, pdu []byte) []byte {
+	response := make([]byte, mbapHeaderLength, mbapHeaderLength+len(pdu))
+	copy(response, request[:4])
+	binary.BigEndian.PutUint16(response[4:], uint16(len(pdu)+1))
+	response[6] = unitID
+	return append(response, pdu...)
+}
+
+func TestClientRead(t *testing.T) {
+	server := newFakeServer(t)
+	server.set(readHoldingRegisters, 10, 0x41ac, 0x0000)
+	server.set(readCoils, 0, 0, 1, 1)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	c, err := dial(ctx, server.endpoint(), 1)
+	require.NoError(t, err)
+	defer c.close()
+
+	data, err := c.read(ctx, readHoldingRegisters, 10, 2)
+	require.NoError(t, err)
+	assert.Equal(t, []byte{0x41, 0xac, 0x00, 0x00}, data)
+
+	data, err = c.read(ctx, readCoils, 1, 2)
+	require.NoError(t, err)
+	assert.Equal(t, []byte{0x03}, data)
+
+	// the connection stays usable after an exception
+	_, err = c.read(ctx, readInputRegisters, 10, 1)
+	var exception *exceptionError
+	require.True(t, errors.As(err, &exception))
+	assert.EqualError(t, err, "exception 2: illegal data address")
+
+	data, err = c.read(ctx, readHoldingRegisters, 11, 1)
+	require.NoError(t, err)
+	assert.Equal(t, []byte{0x00, 0x00}, data)
+}
+
+func TestClientReadInvalidResponses(t *testing.T) {
+	tests := []struct {
+		name    string
+		respond func(request []byte) []byte
+		err     string
+	}{
+		{
+			name: "transaction",
+			respond: func(request []byte) []byte {
+				response := frame(request, 1, []byte{0x03, 0x02, 0x00, 0x01})
+				response[1]++
+				return response
+			},
+			err: "unexpected transaction 2 of the response, expected 1",
+		},
+		{
+			name: "function",
+			respond: func(request []byte) []byte {
+				return frame(request, 1, []byte{0x04, 0x02, 0x00, 0x01})
+			},
+			err: "unexpected function 4 of the response",
+		},
+		{
+			name: "data length",
+			respond: func(request []byte) []byte {
+				return frame(request, 1, []byte{0x03, 0x01, 0x00})
+			},
+			err: "unexpected length of the response data, expected 2 bytes",
+		},
+		{
+			name: "frame length",
+			respond: func(request []byte) []byte {
+				return frame(request, 1, nil)
+			},
+			err: "invalid length 1 of the response",
+		},
+		{
+			name: "unknown exception",
+			respond: func(request []byte) []byte {
+				return frame(request, 1, []byte{0x83, 0x42})
+			},
+			err: "exception 66",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			server := newFakeServer(t)
+			server.mu.Lock()
+			server.respond = tt.respond
+			server.mu.Unlock()
+			c, err := dial(context.Background(), server.endpoint(), 1)
+			require.NoError(t, err)
+			defer c.close()
+			_, err = c.read(context.Background(), readHoldingRegisters, 0, 1)
+			assert.EqualError(t, err, tt.err)
+		})
+	}
+}
+
+func TestClientReadTimeout(t *testing.T) {
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	defer listener.Close()
+
+	// the connection is accepted by the backlog, but the server never answers
+	c, err := dial(context.Background(), listener.Addr().String(), 1)
+	require.NoError(t, err)
+	defer c.close()
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+	_, err = c.read(ctx, readHoldingRegisters, 0, 1)
+	var netErr net.Error
+	require.True(t, errors.As(err, &netErr))
+	assert.True(t, netErr.Timeout())
+}
@@ -0,0 +1,178 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package modbusreceiver // import "github.com/open-telemetry/opentelemetry-collector-contrib/receiver/modbusreceiver"
+
+import (
+	"errors"
+	"fmt"
+	"net"
+
+	"go.opentelemetry.io/collector/receiver/scraperhelper"
+	"go.uber.org/multierr"
+)
+
+const (
+	registerTypeHolding       = "holding"
+	registerTypeInput         = "input"
+	registerTypeCoil          = "coil"
+	registerTypeDiscreteInput = "discrete_input"
+
+	dataTypeBool    = "bool"
+	dataTypeInt16   = "int16"
+	dataTypeUint16  = "uint16"
+	dataTypeInt32   = "int32"
+	dataTypeUint32  = "uint32"
+	dataTypeInt64   = "int64"
+	dataTypeUint64  = "uint64"
+	dataTypeFloat32 = "float32"
+	dataTypeFloat64 = "float64"
+
+	wordOrderBig    = "big"
+	wordOrderLittle = "little"
+
+	metricTypeGauge = "gauge"
+	metricTypeSum   = "sum"
+)
+
+// registerCounts holds the number of 16-bit registers read for each data type.
+var registerCounts = map[string]uint16{
+	dataTypeBool:    1,
+	dataTypeInt16:   1,
+	dataTypeUint16:  1,
+	dataTypeInt32:   2,
+	dataTypeUint32:  2,
+	dataTypeInt64:   4,
+	dataTypeUint64:  4,
+	dataTypeFloat32: 2,
+	dataTypeFloat64: 4,
+}
+
+// Config defines the configuration of the Modbus receiver.
+type Config struct {
+	scraperhelper.ControllerConfig `mapstructure:",squash"`
+
+	// Endpoint is the address of the Modbus TCP server, the device or a gateway, as host:port.
+	Endpoint string `mapstructure:"endpoint"`
+	// UnitID is the identifier of the device, the unit identifier of the requests sent through a gateway.
+	UnitID uint8 `mapstructure:"unit_id"`
+	// Metrics are the metrics read from the registers of the device.
+	Metrics []MetricConfig `mapstructure:"metrics"`
+}
+
+// MetricConfig defines a metric read from registers of the device.
+type MetricConfig struct {
+	// Name is the name of the metric.
+	Name string `mapstructure:"name"`
+	// Description is the description of the metric.
+	Description string `mapstructure:"description"`
+	// Unit is the unit of the metric, after scaling.
+	Unit string `mapstructure:"unit"`
+	// Type is the type of the metric, gauge or sum.
+	Type string `mapstructure:"type"`
+	// Monotonic tells whether the sum only increases.
+	Monotonic bool `mapstructure:"monotonic"`
+	// RegisterType is the type of the registers read: holding, input, coil or discrete_input.
+	RegisterType string `mapstructure:"register_type"`
+	// Address is the address of the first register read, starting at 0.
+	Address uint16 `mapstructure:"address"`
+	// DataType is the type of the value held by the registers. The coils and discrete inputs are booleans.
+	DataType string `mapstructure:"data_type"`
+	// WordOrder is the order of the registers of the values held by several registers, big for the most significant
+	// register first.
+	WordOrder string `mapstructure:"word_order"`
+	// Scale is the factor the value is multiplied by.
+	Scale *float64 `mapstructure:"scale"`
+	// Offset is added to the value, after scaling.
+	Offset float64 `mapstructure:"offset"`
+	// Attributes are the attributes of the data point.
+	Attributes map[string]string `mapstructure:"attributes"`
+}
+
+// Validate checks the configuration of the receiver.
+func (cfg *Config) Validate() error {
+	var errs error
+	if cfg.Endpoint == "" {
+		errs = multierr.Append(errs, errors.New("endpoint must be specified"))
+	} else if _, _, err := net.SplitHostPort(cfg.Endpoint); err != nil {
+		errs = multierr.Append(errs, fmt.Errorf("invalid endpoint %q: %w", cfg.Endpoint, err))
+	}
+	if len(cfg.Metrics) == 0 {
+		errs = multierr.Append(errs, errors.New("at least one metric must be specified"))
+	}
+	names := map[string]bool{}
+	for i := range cfg.Metrics {
+		m := &cfg.Metrics[i]
+		if err := m.validate(); err != nil {
+			errs = multierr.Append(errs, fmt.Errorf("metrics[%d]: %w", i, err))
+			continue
+		}
+		if names[m.Name] {
+			errs = multierr.Append(errs, fmt.Errorf("metrics[%d]: duplicate metric %q", i, m.Name))
+		}
+		names[m.Name] = true
+	}
+	return errs
+}
+
+func (m *MetricConfig) validate() error {
+	if m.Name == "" {
+		return errors.New("name must be specified")
+	}
+	switch m.Type {
+	case "", metricTypeGauge, metricTypeSum:
+	default:
+		return fmt.Errorf("unsupported type %q", m.Type)
+	}
+	switch m.WordOrder {
+	case "", wordOrderBig, wordOrderLittle:
+	default:
+		return fmt.Errorf("unsupported word_order %q", m.WordOrder)
+	}
+	switch m.registerType() {
+	case registerTypeHolding, registerTypeInput:
+		if _, ok := registerCounts[m.dataType()]; !ok {
+			return fmt.Errorf("unsupported data_type %q", m.DataType)
+		}
+	case registerTypeCoil, registerTypeDiscreteInput:
+		if m.dataType() != dataTypeBool {
+			return fmt.Errorf("the data_type of the %s registers must be bool", m.RegisterType)
+		}
+	default:
+		return fmt.Errorf("unsupported register_type %q", m.RegisterType)
+	}
+	if int(m.Address)+int(m.quantity()) > 1<<16 {
+		return fmt.Errorf("the registers at address %d exceed the address space", m.Address)
+	}
+	return nil
+}
+
+func (m *MetricConfig) registerType() string {
+	if m.RegisterType == "" {
+		return registerTypeHolding
+	}
+	return m.RegisterType
+}
+
+func (m *MetricConfig) dataType() string {
+	if m.DataType != "" {
+		return m.DataType
+	}
+	if m.isBit() {
+		return dataTypeBool
+	}
+	return dataTypeUint16
+}
+
+// isBit tells whether the metric is read from single-bit registers.
+func (m *MetricConfig) isBit() bool {
+	return m.RegisterType == registerTypeCoil || m.RegisterType == registerTypeDiscreteInput
+}
+
+// quantity returns the number of registers read for the metric.
+func (m *MetricConfig) quantity() uint16 {
+	if m.isBit() {
+		return 1
+	}
+	return registerCounts[m.dataType()]
+}
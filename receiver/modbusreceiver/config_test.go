@@ -0,0 +1,137 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package modbusreceiver
+
+import (
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/collector/component"
+	"go.opentelemetry.io/collector/confmap/confmaptest"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/receiver/modbusreceiver/internal/metadata"
+)
+
+func TestLoadConfig(t *testing.T) {
+	t.Parallel()
+
+	cm, err := confmaptest.LoadConf(filepath.Join("testdata", "config.yaml"))
+	require.NoError(t, err)
+
+	defaultCfg := createDefaultConfig().(*Config)
+	defaultCfg.Metrics = []MetricConfig{{Name: "temperature"}}
+
+	allSettings := createDefaultConfig().(*Config)
+	allSettings.Endpoint = "plc.local:5020"
+	allSettings.UnitID = 17
+	allSettings.CollectionInterval = 30 * time.Second
+	allSettings.Timeout = 2 * time.Second
+	scale := 0.1
+	allSettings.Metrics = []MetricConfig{
+		{
+			Name:         "pump.temperature",
+			Description:  "Temperature of the pump.",
+			Unit:         "Cel",
+			RegisterType: registerTypeInput,
+			Address:      100,
+			DataType:     dataTypeInt16,
+			Scale:        &scale,
+			Attributes:   map[string]string{"pump": "p1"},
+		},
+		{
+			Name:      "pump.energy",
+			Unit:      "kWh",
+			Type:      metricTypeSum,
+			Monotonic: true,
+			Address:   200,
+			DataType:  dataTypeFloat32,
+			WordOrder: wordOrderLittle,
+		},
+		{
+			Name:         "pump.running",
+			RegisterType: registerTypeCoil,
+			Address:      3,
+		},
+	}
+
+	tests := []struct {
+		id           component.ID
+		expected     component.Config
+		errorMessage string
+	}{
+		{
+			id:       component.NewIDWithName(metadata.Type, ""),
+			expected: defaultCfg,
+		},
+		{
+			id:       component.NewIDWithName(metadata.Type, "all_settings"),
+			expected: allSettings,
+		},
+		{
+			id:           component.NewIDWithName(metadata.Type, "no_endpoint"),
+			errorMessage: "endpoint must be specified",
+		},
+		{
+			id:           component.NewIDWithName(metadata.Type, "bad_endpoint"),
+			errorMessage: `invalid endpoint "plc.local": address plc.local: missing port in address`,
+		},
+		{
+			id:           component.NewIDWithName(metadata.Type, "no_metric"),
+			errorMessage: "at least one metric must be specified",
+		},
+		{
+			id:           component.NewIDWithName(metadata.Type, "no_name"),
+			errorMessage: "metrics[0]: name must be specified",
+		},
+		{
+			id:           component.NewIDWithName(metadata.Type, "duplicate_metric"),
+			errorMessage: `metrics[1]: duplicate metric "temperature"`,
+		},
+		{
+			id:           component.NewIDWithName(metadata.Type, "bad_register_type"),
+			errorMessage: `metrics[0]: unsupported register_type "analog"`,
+		},
+		{
+			id:           component.NewIDWithName(metadata.Type, "bad_data_type"),
+			errorMessage: `metrics[0]: unsupported data_type "int8"`,
+		},
+		{
+			id:           component.NewIDWithName(metadata.Type, "bad_coil_data_type"),
+			errorMessage: "metrics[0]: the data_type of the coil registers must be bool",
+		},
+		{
+			id:           component.NewIDWithName(metadata.Type, "bad_type"),
+			errorMessage: `metrics[0]: unsupported type "histogram"`,
+		},
+		{
+			id:           component.NewIDWithName(metadata.Type, "bad_word_order"),
+			errorMessage: `metrics[0]: unsupported word_order "middle"`,
+		},
+		{
+			id:           component.NewIDWithName(metadata.Type, "bad_address"),
+			errorMessage: "metrics[0]: the registers at address 65535 exceed the address space",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.id.String(), func(t *testing.T) {
+			factory := NewFactory()
+			cfg := factory.CreateDefaultConfig()
+
+			sub, err := cm.Sub(tt.id.String())
+			require.NoError(t, err)
+			require.NoError(t, sub.Unmarshal(cfg))
+
+			if tt.errorMessage != "" {
+				assert.EqualError(t, component.ValidateConfig(cfg), tt.errorMessage)
+				return
+			}
+			assert.NoError(t, component.ValidateConfig(cfg))
+			assert.Equal(t, tt.expected, cfg)
+		})
+	}
+}
@@ -0,0 +1,7 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+//go:generate mdatagen metadata.yaml
+
+// Package modbusreceiver polls the registers of Modbus TCP devices and converts them to metrics.
+package modbusreceiver // import "github.com/open-telemetry/opentelemetry-collector-contrib/receiver/modbusreceiver"
@@ -0,0 +1,132 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package modbusreceiver // import "github.com/open-telemetry/opentelemetry-collector-contrib/receiver/modbusreceiver"
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net"
+	"strconv"
+	"time"
+
+	"go.opentelemetry.io/collector/component"
+	"go.opentelemetry.io/collector/pdata/pcommon"
+	"go.opentelemetry.io/collector/pdata/pmetric"
+	"go.opentelemetry.io/collector/receiver"
+	"go.opentelemetry.io/collector/receiver/scrapererror"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/receiver/modbusreceiver/internal/metadata"
+)
+
+var scopeName = "otelcol/" + metadata.Type.String() + "receiver"
+
+// modbusScraper reads the registers of the metrics at each scrape, keeping the connection to the server
+// between the scrapes.
+type modbusScraper struct {
+	cfg       *Config
+	settings  receiver.CreateSettings
+	client    *client
+	startTime pcommon.Timestamp
+	now       func() time.Time
+}
+
+func newScraper(cfg *Config, settings receiver.CreateSettings) *modbusScraper {
+	return &modbusScraper{
+		cfg:      cfg,
+		settings: settings,
+		now:      time.Now,
+	}
+}
+
+// start doesn't connect to the server, for the collector to start while the device is unreachable.
+func (s *modbusScraper) start(context.Context, component.Host) error {
+	s.startTime = pcommon.NewTimestampFromTime(s.now())
+	return nil
+}
+
+func (s *modbusScraper) scrape(ctx context.Context) (pmetric.Metrics, error) {
+	if s.client == nil {
+		c, err := dial(ctx, s.cfg.Endpoint, s.cfg.UnitID)
+		if err != nil {
+			return pmetric.NewMetrics(), fmt.Errorf("failed to connect to the Modbus server: %w", err)
+		}
+		s.client = c
+	}
+
+	md := pmetric.NewMetrics()
+	rm := md.ResourceMetrics().AppendEmpty()
+	s.setResourceAttributes(rm.Resource())
+	sm := rm.ScopeMetrics().AppendEmpty()
+	sm.Scope().SetName(scopeName)
+	sm.Scope().SetVersion(s.settings.BuildInfo.Version)
+
+	var errs scrapererror.ScrapeErrors
+	for i := range s.cfg.Metrics {
+		m := &s.cfg.Metrics[i]
+		data, err := s.client.read(ctx, functionCodes[m.registerType()], m.Address, m.quantity())
+		if err != nil {
+			var exception *exceptionError
+			if errors.As(err, &exception) {
+				errs.AddPartial(1, fmt.Errorf("failed to read the metric %q: %w", m.Name, err))
+				continue
+			}
+			// the connection is out of sync or broken, the next scrape connects again
+			_ = s.client.close()
+			s.client = nil
+			errs.AddPartial(len(s.cfg.Metrics)-i, fmt.Errorf("failed to read the metric %q: %w", m.Name, err))
+			break
+		}
+		s.appendMetric(sm.Metrics(), m, m.decode(data))
+	}
+	return md, errs.Combine()
+}
+
+func (s *modbusScraper) shutdown(context.Context) error {
+	if s.client == nil {
+		return nil
+	}
+	err := s.client.close()
+	s.client = nil
+	return err
+}
+
+func (s *modbusScraper) setResourceAttributes(resource pcommon.Resource) {
+	attrs := resource.Attributes()
+	if host, port, err := net.SplitHostPort(s.cfg.Endpoint); err == nil {
+		attrs.PutStr("server.address", host)
+		if p, err := strconv.ParseInt(port, 10, 64); err == nil {
+			attrs.PutInt("server.port", p)
+		}
+	}
+	attrs.PutInt("modbus.unit_id", int64(s.cfg.UnitID))
+}
+
+func (s *modbusScraper) appendMetric(metrics pmetric.MetricSlice, m *MetricConfig, value any) {
+	metric := metrics.AppendEmpty()
+	metric.SetName(m.Name)
+	metric.SetDescription(m.Description)
+	metric.SetUnit(m.Unit)
+
+	var dp pmetric.NumberDataPoint
+	if m.Type == metricTypeSum {
+		sum := metric.SetEmptySum()
+		sum.SetIsMonotonic(m.Monotonic)
+		sum.SetAggregationTemporality(pmetric.AggregationTemporalityCumulative)
+		dp = sum.DataPoints().AppendEmpty()
+		dp.SetStartTimestamp(s.startTime)
+	} else {
+		dp = metric.SetEmptyGauge().DataPoints().AppendEmpty()
+	}
+	dp.SetTimestamp(pcommon.NewTimestampFromTime(s.now()))
+	switch v := value.(type) {
+	case int64:
+		dp.SetIntValue(v)
+	case float64:
+		dp.SetDoubleValue(v)
+	}
+	for k, v := range m.Attributes {
+		dp.Attributes().PutStr(k, v)
+	}
+}
@@ -0,0 +1,165 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package modbusreceiver
+
+import (
+	"context"
+	"net"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/collector/component/componenttest"
+	"go.opentelemetry.io/collector/pdata/pcommon"
+	"go.opentelemetry.io/collector/pdata/pmetric"
+	"go.opentelemetry.io/collector/receiver/receivertest"
+	"go.opentelemetry.io/collector/receiver/scrapererror"
+)
+
+var testNow = time.Date(2024, 6, 1, 12, 0, 0, 0, time.UTC)
+
+func newTestScraper(t *testing.T, server *fakeServer) *modbusScraper {
+	cfg := createDefaultConfig().(*Config)
+	cfg.Endpoint = server.endpoint()
+	scale := 0.1
+	cfg.Metrics = []MetricConfig{
+		{
+			Name:         "pump.temperature",
+			Unit:         "Cel",
+			RegisterType: registerTypeInput,
+			Address:      100,
+			DataType:     dataTypeInt16,
+			Scale:        &scale,
+			Attributes:   map[string]string{"pump": "p1"},
+		},
+		{
+			Name:      "pump.energy",
+			Unit:      "kWh",
+			Type:      metricTypeSum,
+			Monotonic: true,
+			Address:   200,
+			DataType:  dataTypeUint32,
+		},
+		{
+			Name:         "pump.running",
+			RegisterType: registerTypeCoil,
+			Address:      3,
+		},
+	}
+	require.NoError(t, cfg.Validate())
+	s := newScraper(cfg, receivertest.NewNopCreateSettings())
+	s.now = func() time.Time { return testNow }
+	require.NoError(t, s.start(context.Background(), componenttest.NewNopHost()))
+	t.Cleanup(func() {
+		assert.NoError(t, s.shutdown(context.Background()))
+	})
+	return s
+}
+
+func TestScrape(t *testing.T) {
+	server := newFakeServer(t)
+	server.set(readInputRegisters, 100, 215)
+	server.set(readHoldingRegisters, 200, 0x0001, 0x0002)
+	server.set(readCoils, 3, 1)
+	s := newTestScraper(t, server)
+
+	md, err := s.scrape(context.Background())
+	require.NoError(t, err)
+
+	require.Equal(t, 1, md.ResourceMetrics().Len())
+	rm := md.ResourceMetrics().At(0)
+	host, port, err := net.SplitHostPort(server.endpoint())
+	require.NoError(t, err)
+	p, err := strconv.ParseInt(port, 10, 64)
+	require.NoError(t, err)
+	assert.Equal(t, map[string]any{"server.address": host, "server.port": p, "modbus.unit_id": int64(1)}, rm.Resource().Attributes().AsRaw())
+	sm := rm.ScopeMetrics().At(0)
+	assert.Equal(t, "otelcol/modbusreceiver", sm.Scope().Name())
+	require.Equal(t, 3, sm.Metrics().Len())
+
+	temperature := sm.Metrics().At(0)
+	assert.Equal(t, "pump.temperature", temperature.Name())
+	assert.Equal(t, "Cel", temperature.Unit())
+	require.Equal(t, pmetric.MetricTypeGauge, temperature.Type())
+	dp := temperature.Gauge().DataPoints().At(0)
+	assert.InDelta(t, 21.5, dp.DoubleValue(), 1e-9)
+	assert.Equal(t, pcommon.NewTimestampFromTime(testNow), dp.Timestamp())
+	assert.Equal(t, map[string]any{"pump": "p1"}, dp.Attributes().AsRaw())
+
+	energy := sm.Metrics().At(1)
+	require.Equal(t, pmetric.MetricTypeSum, energy.Type())
+	assert.True(t, energy.Sum().IsMonotonic())
+	assert.Equal(t, pmetric.AggregationTemporalityCumulative, energy.Sum().AggregationTemporality())
+	assert.Equal(t, int64(65538), energy.Sum().DataPoints().At(0).IntValue())
+	assert.Equal(t, pcommon.NewTimestampFromTime(testNow), energy.Sum().DataPoints().At(0).StartTimestamp())
+
+	running := sm.Metrics().At(2)
+	assert.Equal(t, int64(1), running.Gauge().DataPoints().At(0).IntValue())
+
+	// the connection is kept between the scrapes
+	_, err = s.scrape(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, 1, server.connections())
+}
+
+func TestScrapeException(t *testing.T) {
+	server := newFakeServer(t)
+	server.set(readInputRegisters, 100, 215)
+	server.set(readCoils, 3, 1)
+	s := newTestScraper(t, server)
+
+	md, err := s.scrape(context.Background())
+	require.True(t, scrapererror.IsPartialScrapeError(err))
+	assert.EqualError(t, err, `failed to read the metric "pump.energy": exception 2: illegal data address`)
+	assert.Equal(t, 1, err.(scrapererror.PartialScrapeError).Failed)
+	assert.Equal(t, 2, md.MetricCount())
+	assert.Equal(t, 1, server.connections())
+}
+
+func TestScrapeReconnects(t *testing.T) {
+	server := newFakeServer(t)
+	server.set(readInputRegisters, 100, 215)
+	server.set(readHoldingRegisters, 200, 0x0001, 0x0002)
+	server.set(readCoils, 3, 1)
+	s := newTestScraper(t, server)
+
+	// a response of another transaction desynchronizes the connection
+	server.mu.Lock()
+	server.respond = func(request []byte) []byte {
+		response := frame(request, 1, []byte{0x04, 0x02, 0x00, 0xd7})
+		response[1]++
+		return response
+	}
+	server.mu.Unlock()
+	md, err := s.scrape(context.Background())
+	require.True(t, scrapererror.IsPartialScrapeError(err))
+	assert.Equal(t, 3, err.(scrapererror.PartialScrapeError).Failed)
+	assert.Equal(t, 0, md.MetricCount())
+	assert.Nil(t, s.client)
+
+	server.mu.Lock()
+	server.respond = nil
+	server.mu.Unlock()
+	md, err = s.scrape(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, 3, md.MetricCount())
+	assert.Equal(t, 2, server.connections())
+}
+
+func TestScrapeConnectionError(t *testing.T) {
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	endpoint := listener.Addr().String()
+	require.NoError(t, listener.Close())
+
+	cfg := createDefaultConfig().(*Config)
+	cfg.Endpoint = endpoint
+	cfg.Metrics = []MetricConfig{{Name: "temperature"}}
+	s := newScraper(cfg, receivertest.NewNopCreateSettings())
+	_, err = s.scrape(context.Background())
+	assert.ErrorContains(t, err, "failed to connect to the Modbus server")
+	assert.NoError(t, s.shutdown(context.Background()))
+}
@@ -0,0 +1,75 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package modbusreceiver // import "github.com/open-telemetry/opentelemetry-collector-contrib/receiver/modbusreceiver"
+
+import (
+	"encoding/binary"
+	"math"
+)
+
+// decode converts the data read from the registers of the metric to an int64 or a float64.
+func (m *MetricConfig) decode(data []byte) any {
+	if m.isBit() {
+		return int64(data[0] & 1)
+	}
+	if m.WordOrder == wordOrderLittle {
+		data = reverseWords(data)
+	}
+
+	var value any
+	switch m.dataType() {
+	case dataTypeBool:
+		value = int64(0)
+		if binary.BigEndian.Uint16(data) != 0 {
+			value = int64(1)
+		}
+	case dataTypeInt16:
+		value = int64(int16(binary.BigEndian.Uint16(data)))
+	case dataTypeUint16:
+		value = int64(binary.BigEndian.Uint16(data))
+	case dataTypeInt32:
+		value = int64(int32(binary.BigEndian.Uint32(data)))
+	case dataTypeUint32:
+		value = int64(binary.BigEndian.Uint32(data))
+	case dataTypeInt64:
+		value = int64(binary.BigEndian.Uint64(data))
+	case dataTypeUint64:
+		u := binary.BigEndian.Uint64(data)
+		if u > math.MaxInt64 {
+			value = float64(u)
+		} else {
+			value = int64(u)
+		}
+	case dataTypeFloat32:
+		value = float64(math.Float32frombits(binary.BigEndian.Uint32(data)))
+	case dataTypeFloat64:
+		value = math.Float64frombits(binary.BigEndian.Uint64(data))
+	}
+	return m.scale(value)
+}
+
+// scale applies the scale and the offset of the metric, the integers stay integers without them.
+func (m *MetricConfig) scale(value any) any {
+	if m.Scale == nil && m.Offset == 0 {
+		return value
+	}
+	f, ok := value.(float64)
+	if !ok {
+		f = float64(value.(int64))
+	}
+	if m.Scale != nil {
+		f *= *m.Scale
+	}
+	return f + m.Offset
+}
+
+// reverseWords returns the data with its 16-bit registers in the reverse order.
+func reverseWords(data []byte) []byte {
+	reversed := make([]byte, len(data))
+	for i := 0; i < len(data); i += 2 {
+		j := len(data) - i - 2
+		reversed[j], reversed[j+1] = data[i], data[i+1]
+	}
+	return reversed
+}
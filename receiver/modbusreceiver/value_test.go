@@ -0,0 +1,46 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package modbusreceiver
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDecode(t *testing.T) {
+	scale := 0.1
+	tests := []struct {
+		name   string
+		metric MetricConfig
+		data   []byte
+		want   any
+	}{
+		{name: "uint16", metric: MetricConfig{}, data: []byte{0xff, 0xfe}, want: int64(65534)},
+		{name: "int16", metric: MetricConfig{DataType: dataTypeInt16}, data: []byte{0xff, 0xfe}, want: int64(-2)},
+		{name: "int32", metric: MetricConfig{DataType: dataTypeInt32}, data: []byte{0xff, 0xff, 0xff, 0xfe}, want: int64(-2)},
+		{name: "uint32", metric: MetricConfig{DataType: dataTypeUint32}, data: []byte{0x00, 0x01, 0x00, 0x02}, want: int64(65538)},
+		{name: "uint32 little word order", metric: MetricConfig{DataType: dataTypeUint32, WordOrder: wordOrderLittle}, data: []byte{0x00, 0x02, 0x00, 0x01}, want: int64(65538)},
+		{name: "int64", metric: MetricConfig{DataType: dataTypeInt64}, data: []byte{0, 0, 0, 0, 0, 0, 0x01, 0x00}, want: int64(256)},
+		{name: "uint64 overflowing int64", metric: MetricConfig{DataType: dataTypeUint64}, data: []byte{0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff}, want: float64(1<<64 - 1)},
+		{name: "float32", metric: MetricConfig{DataType: dataTypeFloat32}, data: []byte{0x41, 0xac, 0x00, 0x00}, want: 21.5},
+		{name: "float32 little word order", metric: MetricConfig{DataType: dataTypeFloat32, WordOrder: wordOrderLittle}, data: []byte{0x00, 0x00, 0x41, 0xac}, want: 21.5},
+		{name: "float64", metric: MetricConfig{DataType: dataTypeFloat64}, data: []byte{0x40, 0x35, 0x80, 0, 0, 0, 0, 0}, want: 21.5},
+		{name: "bool register", metric: MetricConfig{DataType: dataTypeBool}, data: []byte{0x00, 0x04}, want: int64(1)},
+		{name: "coil", metric: MetricConfig{RegisterType: registerTypeCoil}, data: []byte{0x01}, want: int64(1)},
+		{name: "discrete input", metric: MetricConfig{RegisterType: registerTypeDiscreteInput}, data: []byte{0x00}, want: int64(0)},
+		{name: "scale", metric: MetricConfig{DataType: dataTypeInt16, Scale: &scale}, data: []byte{0x00, 0xd7}, want: 21.5},
+		{name: "offset", metric: MetricConfig{Offset: -273.15}, data: []byte{0x01, 0x27}, want: 295.0 - 273.15},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := tt.metric.decode(tt.data)
+			if f, ok := tt.want.(float64); ok {
+				assert.InDelta(t, f, got, 1e-9)
+				return
+			}
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
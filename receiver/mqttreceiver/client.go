@@ -0,0 +1,109 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package mqttreceiver // import "github.com/open-telemetry/opentelemetry-collector-contrib/receiver/mqttreceiver"
+
+import (
+	"context"
+	"crypto/tls"
+	"sort"
+
+	mqtt "github.com/eclipse/paho.mqtt.golang"
+	"go.uber.org/zap"
+)
+
+// messageHandler handles a message published on a topic matching the filters subscribed to.
+type messageHandler func(topic string, payload []byte)
+
+// brokerClient subscribes to the topic filters of the MQTT broker, for the MQTT clients to be replaced in the tests.
+// The client connects in the background, and reconnects until it's closed, subscribing again on each connection.
+type brokerClient interface {
+	connect(ctx context.Context) error
+	disconnect(ctx context.Context) error
+}
+
+// clientSettings are the settings of the MQTT clients, resolved from the configuration.
+type clientSettings struct {
+	cfg     *Config
+	tls     *tls.Config
+	filters map[string]byte
+	handler messageHandler
+	logger  *zap.Logger
+}
+
+// sortedFilters returns the topic filters subscribed to, sorted for the subscriptions to be deterministic.
+func (s *clientSettings) sortedFilters() []string {
+	filters := make([]string, 0, len(s.filters))
+	for filter := range s.filters {
+		filters = append(filters, filter)
+	}
+	sort.Strings(filters)
+	return filters
+}
+
+// v311Client is the MQTT 3.1.1 client.
+type v311Client struct {
+	settings clientSettings
+	client   mqtt.Client
+	done     chan struct{}
+}
+
+func newV311Client(settings clientSettings) brokerClient {
+	return &v311Client{settings: settings, done: make(chan struct{})}
+}
+
+func (c *v311Client) connect(context.Context) error {
+	cfg := c.settings.cfg
+	opts := mqtt.NewClientOptions().
+		AddBroker(cfg.Endpoint).
+		SetClientID(cfg.ClientID).
+		SetUsername(cfg.Username).
+		SetPassword(string(cfg.Password)).
+		SetProtocolVersion(4).
+		SetCleanSession(!cfg.PersistentSession).
+		SetKeepAlive(cfg.KeepAlive).
+		SetConnectTimeout(cfg.ConnectTimeout).
+		SetAutoReconnect(true).
+		SetConnectRetry(true).
+		SetOnConnectHandler(c.subscribe).
+		SetConnectionLostHandler(func(_ mqtt.Client, err error) {
+			c.settings.logger.Warn("Lost the connection to the MQTT broker", zap.Error(err))
+		})
+	if c.settings.tls != nil {
+		opts.SetTLSConfig(c.settings.tls)
+	}
+	c.client = mqtt.NewClient(opts)
+
+	// the connection is retried in the background until it succeeds, the token completes once connected
+	token := c.client.Connect()
+	go func() {
+		select {
+		case <-token.Done():
+			if err := token.Error(); err != nil {
+				c.settings.logger.Error("Failed to connect to the MQTT broker", zap.Error(err))
+			}
+		case <-c.done:
+		}
+	}()
+	return nil
+}
+
+// subscribe subscribes to the topic filters on each connection, the broker forgetting the subscriptions of a clean
+// session.
+func (c *v311Client) subscribe(client mqtt.Client) {
+	token := client.SubscribeMultiple(c.settings.filters, func(_ mqtt.Client, msg mqtt.Message) {
+		c.settings.handler(msg.Topic(), msg.Payload())
+	})
+	if token.WaitTimeout(c.settings.cfg.ConnectTimeout) && token.Error() != nil {
+		c.settings.logger.Error("Failed to subscribe to the topic filters", zap.Error(token.Error()))
+	}
+}
+
+func (c *v311Client) disconnect(context.Context) error {
+	close(c.done)
+	if c.client != nil {
+		// waiting for the messages being handled, in milliseconds
+		c.client.Disconnect(250)
+	}
+	return nil
+}
@@ -0,0 +1,89 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package mqttreceiver // import "github.com/open-telemetry/opentelemetry-collector-contrib/receiver/mqttreceiver"
+
+import (
+	"context"
+	"net/url"
+
+	"github.com/eclipse/paho.golang/autopaho"
+	"github.com/eclipse/paho.golang/paho"
+	"go.uber.org/zap"
+)
+
+// v5Client is the MQTT 5 client.
+type v5Client struct {
+	settings clientSettings
+	cm       *autopaho.ConnectionManager
+	cancel   context.CancelFunc
+}
+
+func newV5Client(settings clientSettings) brokerClient {
+	return &v5Client{settings: settings}
+}
+
+func (c *v5Client) connect(context.Context) error {
+	cfg := c.settings.cfg
+	u, err := url.Parse(cfg.Endpoint)
+	if err != nil {
+		return err
+	}
+	subscriptions := make([]paho.SubscribeOptions, 0, len(c.settings.filters))
+	for _, filter := range c.settings.sortedFilters() {
+		subscriptions = append(subscriptions, paho.SubscribeOptions{Topic: filter, QoS: c.settings.filters[filter]})
+	}
+
+	var sessionExpiry uint32
+	if cfg.PersistentSession {
+		sessionExpiry = uint32(cfg.SessionExpiry.Seconds())
+	}
+	clientCfg := autopaho.ClientConfig{
+		ServerUrls:                    []*url.URL{u},
+		TlsCfg:                        c.settings.tls,
+		KeepAlive:                     uint16(cfg.KeepAlive.Seconds()),
+		CleanStartOnInitialConnection: !cfg.PersistentSession,
+		SessionExpiryInterval:         sessionExpiry,
+		ConnectTimeout:                cfg.ConnectTimeout,
+		ConnectUsername:               cfg.Username,
+		ConnectPassword:               []byte(cfg.Password),
+		// subscribing on each connection, the broker forgetting the subscriptions of a clean session
+		OnConnectionUp: func(cm *autopaho.ConnectionManager, _ *paho.Connack) {
+			if _, err := cm.Subscribe(context.Background(), &paho.Subscribe{Subscriptions: subscriptions}); err != nil {
+				c.settings.logger.Error("Failed to subscribe to the topic filters", zap.Error(err))
+			}
+		},
+		OnConnectError: func(err error) {
+			c.settings.logger.Warn("Failed to connect to the MQTT broker", zap.Error(err))
+		},
+		ClientConfig: paho.ClientConfig{
+			ClientID: cfg.ClientID,
+			OnPublishReceived: []func(paho.PublishReceived) (bool, error){
+				func(pr paho.PublishReceived) (bool, error) {
+					c.settings.handler(pr.Packet.Topic, pr.Packet.Payload)
+					return true, nil
+				},
+			},
+			OnClientError: func(err error) {
+				c.settings.logger.Warn("Lost the connection to the MQTT broker", zap.Error(err))
+			},
+		},
+	}
+
+	// the connection manager connects in the background, and reconnects until it's disconnected
+	var ctx context.Context
+	ctx, c.cancel = context.WithCancel(context.Background())
+	c.cm, err = autopaho.NewConnection(ctx, clientCfg)
+	return err
+}
+
+func (c *v5Client) disconnect(ctx context.Context) error {
+	if c.cancel == nil {
+		return nil
+	}
+	defer c.cancel()
+	if c.cm == nil {
+		return nil
+	}
+	return c.cm.Disconnect(ctx)
+}
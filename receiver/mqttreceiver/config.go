@@ -0,0 +1,202 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package mqttreceiver // import "github.com/open-telemetry/opentelemetry-collector-contrib/receiver/mqttreceiver"
+
+import (
+	"errors"
+	"fmt"
+	"net/url"
+	"time"
+
+	"go.opentelemetry.io/collector/component"
+	"go.opentelemetry.io/collector/config/configopaque"
+	"go.opentelemetry.io/collector/config/configtls"
+)
+
+const (
+	protocolVersion311 = "3.1.1"
+	protocolVersion5   = "5"
+
+	encodingJSON      = "json"
+	encodingOTLPProto = "otlp_proto"
+	encodingOTLPJSON  = "otlp_json"
+
+	signalMetrics = "metrics"
+	signalLogs    = "logs"
+
+	metricTypeGauge = "gauge"
+	metricTypeSum   = "sum"
+)
+
+// Config defines configuration for the MQTT receiver.
+type Config struct {
+	// Endpoint is the URL of the MQTT broker, e.g. tcp://localhost:1883, ssl://broker:8883 or ws://broker:80/mqtt.
+	Endpoint string `mapstructure:"endpoint"`
+	// ProtocolVersion is the MQTT version used to connect to the broker, 3.1.1 (default) or 5.
+	ProtocolVersion string `mapstructure:"protocol_version"`
+	// ClientID identifies the session of the receiver on the broker, assigned by the broker when not set.
+	ClientID string `mapstructure:"client_id"`
+	// Username and Password authenticate the receiver to the broker.
+	Username string              `mapstructure:"username"`
+	Password configopaque.String `mapstructure:"password"`
+	// TLS configures the TLS connection to the broker, used with the ssl, tls, mqtts and wss schemes of the endpoint.
+	TLS configtls.ClientConfig `mapstructure:"tls,omitempty"`
+
+	// PersistentSession keeps the session on the broker while the receiver is disconnected, for the messages of QoS 1
+	// and 2 published meanwhile to be delivered once it reconnects. A client_id is required.
+	PersistentSession bool `mapstructure:"persistent_session"`
+	// SessionExpiry is the time the broker keeps the persistent session after the disconnection (MQTT 5 only).
+	SessionExpiry time.Duration `mapstructure:"session_expiry"`
+	// KeepAlive is the interval of the pings keeping the connection alive.
+	KeepAlive time.Duration `mapstructure:"keep_alive"`
+	// ConnectTimeout is the timeout of each attempt to connect to the broker.
+	ConnectTimeout time.Duration `mapstructure:"connect_timeout"`
+
+	// Subscriptions are the topic filters subscribed to, and how their messages are converted.
+	Subscriptions []SubscriptionConfig `mapstructure:"subscriptions"`
+}
+
+// SubscriptionConfig defines a topic filter subscribed to, and how its messages are converted to metrics or logs.
+type SubscriptionConfig struct {
+	// Topic is the topic filter, with the + and # wildcards, e.g. plant/+/sensors/#.
+	Topic string `mapstructure:"topic"`
+	// QoS is the maximum quality of service of the messages delivered by the broker, 0 (default), 1 or 2.
+	QoS byte `mapstructure:"qos"`
+	// Signal is the type of telemetry converted from the messages, metrics or logs.
+	Signal string `mapstructure:"signal"`
+	// Encoding of the messages: json (default) for JSON objects mapped by the settings below, otlp_proto or otlp_json
+	// for OTLP payloads.
+	Encoding string `mapstructure:"encoding"`
+
+	// Timestamp is the field holding the time of the JSON object, in seconds since the epoch or as a RFC 3339 string.
+	// The time of reception is used when not set.
+	Timestamp string `mapstructure:"timestamp"`
+	// Attributes are the attributes of the data points and log records, set from the given fields of the JSON object.
+	Attributes map[string]string `mapstructure:"attributes"`
+	// Metrics are the metrics converted from the fields of the JSON object.
+	Metrics []MetricConfig `mapstructure:"metrics"`
+	// Logs is how the JSON object is converted to a log record.
+	Logs LogConfig `mapstructure:"logs"`
+}
+
+// MetricConfig defines a metric converted from a field of the JSON messages.
+type MetricConfig struct {
+	// Name of the metric.
+	Name string `mapstructure:"name"`
+	// Field holding the value of the metric, a number or a boolean.
+	Field string `mapstructure:"field"`
+	// Type of the metric, gauge (default) or sum.
+	Type string `mapstructure:"type"`
+	// Monotonic tells whether the sum only increases.
+	Monotonic bool `mapstructure:"monotonic"`
+	// Unit of the metric.
+	Unit string `mapstructure:"unit"`
+	// Description of the metric.
+	Description string `mapstructure:"description"`
+}
+
+// LogConfig defines how the JSON messages are converted to log records.
+type LogConfig struct {
+	// Body is the field holding the body of the log record, the whole JSON object when not set.
+	Body string `mapstructure:"body"`
+	// Severity is the field holding the severity text of the log record.
+	Severity string `mapstructure:"severity"`
+}
+
+var _ component.Config = (*Config)(nil)
+
+// Validate checks if the receiver configuration is valid.
+func (cfg *Config) Validate() error {
+	u, err := url.Parse(cfg.Endpoint)
+	if err != nil {
+		return fmt.Errorf("invalid endpoint: %w", err)
+	}
+	switch u.Scheme {
+	case "tcp", "mqtt", "ssl", "tls", "mqtts", "ws", "wss":
+	default:
+		return fmt.Errorf("unsupported scheme %q of the endpoint", u.Scheme)
+	}
+	switch cfg.ProtocolVersion {
+	case protocolVersion311, protocolVersion5:
+	default:
+		return fmt.Errorf("unsupported protocol_version %q", cfg.ProtocolVersion)
+	}
+	if cfg.PersistentSession && cfg.ClientID == "" {
+		return errors.New("client_id must be specified with a persistent session")
+	}
+	if cfg.KeepAlive <= 0 {
+		return errors.New("keep_alive must be positive")
+	}
+	if cfg.ConnectTimeout <= 0 {
+		return errors.New("connect_timeout must be positive")
+	}
+	if len(cfg.Subscriptions) == 0 {
+		return errors.New("at least one subscription must be specified")
+	}
+	for i, sub := range cfg.Subscriptions {
+		if err := sub.validate(); err != nil {
+			return fmt.Errorf("subscriptions[%d]: %w", i, err)
+		}
+	}
+	return nil
+}
+
+func (sub *SubscriptionConfig) validate() error {
+	if sub.Topic == "" {
+		return errors.New("topic must be specified")
+	}
+	if err := validateTopicFilter(sub.Topic); err != nil {
+		return err
+	}
+	if sub.QoS > 2 {
+		return fmt.Errorf("unsupported qos %d", sub.QoS)
+	}
+	if sub.Signal != signalMetrics && sub.Signal != signalLogs {
+		return fmt.Errorf("unsupported signal %q", sub.Signal)
+	}
+	switch sub.Encoding {
+	case "", encodingJSON:
+	case encodingOTLPProto, encodingOTLPJSON:
+		if sub.Timestamp != "" || len(sub.Attributes) > 0 || len(sub.Metrics) > 0 || sub.Logs != (LogConfig{}) {
+			return fmt.Errorf("the messages encoded as %s can't be mapped", sub.Encoding)
+		}
+		return nil
+	default:
+		return fmt.Errorf("unsupported encoding %q", sub.Encoding)
+	}
+
+	if sub.Signal == signalMetrics && len(sub.Metrics) == 0 {
+		return errors.New("at least one metric must be specified")
+	}
+	for _, m := range sub.Metrics {
+		if m.Name == "" || m.Field == "" {
+			return errors.New("the name and field of the metrics must be specified")
+		}
+		if m.Type != "" && m.Type != metricTypeGauge && m.Type != metricTypeSum {
+			return fmt.Errorf("unsupported type %q of the metric %q", m.Type, m.Name)
+		}
+	}
+	return nil
+}
+
+// encoding returns the encoding of the messages, json when not set.
+func (sub *SubscriptionConfig) encoding() string {
+	if sub.Encoding == "" {
+		return encodingJSON
+	}
+	return sub.Encoding
+}
+
+// usesTLS returns whether the endpoint is connected to with TLS.
+func (cfg *Config) usesTLS() bool {
+	u, err := url.Parse(cfg.Endpoint)
+	if err != nil {
+		return false
+	}
+	switch u.Scheme {
+	case "ssl", "tls", "mqtts", "wss":
+		return true
+	}
+	return false
+}
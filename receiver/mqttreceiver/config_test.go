@@ -0,0 +1,138 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package mqttreceiver
+
+import (
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/collector/component"
+	"go.opentelemetry.io/collector/config/configtls"
+	"go.opentelemetry.io/collector/confmap/confmaptest"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/receiver/mqttreceiver/internal/metadata"
+)
+
+func TestLoadConfig(t *testing.T) {
+	t.Parallel()
+
+	cm, err := confmaptest.LoadConf(filepath.Join("testdata", "config.yaml"))
+	require.NoError(t, err)
+
+	defaultCfg := createDefaultConfig().(*Config)
+	defaultCfg.Subscriptions = []SubscriptionConfig{
+		{Topic: "otlp/metrics", Signal: signalMetrics, Encoding: encodingOTLPProto},
+	}
+
+	tests := []struct {
+		id           component.ID
+		expected     component.Config
+		errorMessage string
+	}{
+		{
+			id:       component.NewIDWithName(metadata.Type, ""),
+			expected: defaultCfg,
+		},
+		{
+			id: component.NewIDWithName(metadata.Type, "all_settings"),
+			expected: &Config{
+				Endpoint:        "ssl://broker:8883",
+				ProtocolVersion: protocolVersion5,
+				ClientID:        "gateway-1",
+				Username:        "collector",
+				Password:        "secret",
+				TLS: configtls.ClientConfig{
+					Config: configtls.Config{
+						CAFile: "/etc/mqtt/ca.pem",
+					},
+				},
+				PersistentSession: true,
+				SessionExpiry:     24 * time.Hour,
+				KeepAlive:         time.Minute,
+				ConnectTimeout:    10 * time.Second,
+				Subscriptions: []SubscriptionConfig{
+					{
+						Topic:      "$share/collectors/plant/+/telemetry",
+						QoS:        1,
+						Signal:     signalMetrics,
+						Timestamp:  "ts",
+						Attributes: map[string]string{"device.id": "device"},
+						Metrics: []MetricConfig{
+							{Name: "temperature", Field: "sensors.temperature", Unit: "Cel"},
+							{Name: "energy", Field: "energy", Type: metricTypeSum, Monotonic: true, Unit: "kWh", Description: "Energy consumed by the device."},
+						},
+					},
+					{
+						Topic:    "plant/+/events",
+						QoS:      2,
+						Signal:   signalLogs,
+						Encoding: encodingJSON,
+						Logs:     LogConfig{Body: "message", Severity: "level"},
+					},
+				},
+			},
+		},
+		{
+			id:           component.NewIDWithName(metadata.Type, "no_subscription"),
+			errorMessage: "at least one subscription must be specified",
+		},
+		{
+			id:           component.NewIDWithName(metadata.Type, "persistent_session_without_client_id"),
+			errorMessage: "client_id must be specified with a persistent session",
+		},
+		{
+			id:           component.NewIDWithName(metadata.Type, "bad_scheme"),
+			errorMessage: `unsupported scheme "http" of the endpoint`,
+		},
+		{
+			id:           component.NewIDWithName(metadata.Type, "bad_protocol_version"),
+			errorMessage: `unsupported protocol_version "3.1"`,
+		},
+		{
+			id:           component.NewIDWithName(metadata.Type, "bad_topic"),
+			errorMessage: "subscriptions[0]: the wildcard # must be the last level of the topic filter",
+		},
+		{
+			id:           component.NewIDWithName(metadata.Type, "bad_signal"),
+			errorMessage: `subscriptions[0]: unsupported signal "traces"`,
+		},
+		{
+			id:           component.NewIDWithName(metadata.Type, "bad_qos"),
+			errorMessage: "subscriptions[0]: unsupported qos 3",
+		},
+		{
+			id:           component.NewIDWithName(metadata.Type, "no_metric"),
+			errorMessage: "subscriptions[0]: at least one metric must be specified",
+		},
+		{
+			id:           component.NewIDWithName(metadata.Type, "bad_metric_type"),
+			errorMessage: `subscriptions[0]: unsupported type "histogram" of the metric "temperature"`,
+		},
+		{
+			id:           component.NewIDWithName(metadata.Type, "mapped_otlp"),
+			errorMessage: "subscriptions[0]: the messages encoded as otlp_proto can't be mapped",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.id.String(), func(t *testing.T) {
+			factory := NewFactory()
+			cfg := factory.CreateDefaultConfig()
+
+			sub, err := cm.Sub(tt.id.String())
+			require.NoError(t, err)
+			require.NoError(t, sub.Unmarshal(cfg))
+
+			if tt.errorMessage != "" {
+				assert.EqualError(t, component.ValidateConfig(cfg), tt.errorMessage)
+				return
+			}
+			assert.NoError(t, component.ValidateConfig(cfg))
+			assert.Equal(t, tt.expected, cfg)
+		})
+	}
+}
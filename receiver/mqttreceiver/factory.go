@@ -0,0 +1,87 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package mqttreceiver // import "github.com/open-telemetry/opentelemetry-collector-contrib/receiver/mqttreceiver"
+
+import (
+	"context"
+	"time"
+
+	"go.opentelemetry.io/collector/component"
+	"go.opentelemetry.io/collector/consumer"
+	"go.opentelemetry.io/collector/receiver"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/internal/sharedcomponent"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/receiver/mqttreceiver/internal/metadata"
+)
+
+const (
+	defaultEndpoint       = "tcp://localhost:1883"
+	defaultSessionExpiry  = time.Hour
+	defaultKeepAlive      = 30 * time.Second
+	defaultConnectTimeout = 30 * time.Second
+)
+
+// receivers holds the receivers shared by the metrics and logs pipelines, for them to use a single session.
+var receivers = sharedcomponent.NewSharedComponents()
+
+// NewFactory creates a factory for the MQTT receiver.
+func NewFactory() receiver.Factory {
+	return receiver.NewFactory(
+		metadata.Type,
+		createDefaultConfig,
+		receiver.WithMetrics(createMetricsReceiver, metadata.MetricsStability),
+		receiver.WithLogs(createLogsReceiver, metadata.LogsStability),
+	)
+}
+
+func createDefaultConfig() component.Config {
+	return &Config{
+		Endpoint:        defaultEndpoint,
+		ProtocolVersion: protocolVersion311,
+		SessionExpiry:   defaultSessionExpiry,
+		KeepAlive:       defaultKeepAlive,
+		ConnectTimeout:  defaultConnectTimeout,
+	}
+}
+
+func createMetricsReceiver(
+	_ context.Context,
+	set receiver.CreateSettings,
+	cfg component.Config,
+	nextConsumer consumer.Metrics,
+) (receiver.Metrics, error) {
+	r, err := getOrAddReceiver(cfg.(*Config), set)
+	if err != nil {
+		return nil, err
+	}
+	r.Unwrap().(*mqttReceiver).nextMetrics = nextConsumer
+	return r, nil
+}
+
+func createLogsReceiver(
+	_ context.Context,
+	set receiver.CreateSettings,
+	cfg component.Config,
+	nextConsumer consumer.Logs,
+) (receiver.Logs, error) {
+	r, err := getOrAddReceiver(cfg.(*Config), set)
+	if err != nil {
+		return nil, err
+	}
+	r.Unwrap().(*mqttReceiver).nextLogs = nextConsumer
+	return r, nil
+}
+
+func getOrAddReceiver(cfg *Config, set receiver.CreateSettings) (*sharedcomponent.SharedComponent, error) {
+	var err error
+	r := receivers.GetOrAdd(cfg, func() component.Component {
+		var rcvr *mqttReceiver
+		rcvr, err = newReceiver(cfg, set)
+		return rcvr
+	})
+	if err != nil {
+		return nil, err
+	}
+	return r, nil
+}
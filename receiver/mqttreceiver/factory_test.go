@@ -0,0 +1,28 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package mqttreceiver
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"go.opentelemetry.io/collector/component/componenttest"
+)
+
+func TestCreateDefaultConfig(t *testing.T) {
+	cfg := createDefaultConfig()
+	assert.NotNil(t, cfg, "failed to create default config")
+	assert.NoError(t, componenttest.CheckConfigStruct(cfg))
+}
+
+func TestUsesTLS(t *testing.T) {
+	cfg := createDefaultConfig().(*Config)
+	assert.False(t, cfg.usesTLS())
+	cfg.Endpoint = "mqtts://broker:8883"
+	assert.True(t, cfg.usesTLS())
+	cfg.Endpoint = "wss://broker:443/mqtt"
+	assert.True(t, cfg.usesTLS())
+	cfg.Endpoint = "ws://broker:80/mqtt"
+	assert.False(t, cfg.usesTLS())
+}
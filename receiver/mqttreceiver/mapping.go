@@ -0,0 +1,254 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package mqttreceiver // import "github.com/open-telemetry/opentelemetry-collector-contrib/receiver/mqttreceiver"
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math"
+	"strings"
+	"time"
+
+	"go.opentelemetry.io/collector/pdata/pcommon"
+	"go.opentelemetry.io/collector/pdata/plog"
+	"go.opentelemetry.io/collector/pdata/pmetric"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/receiver/mqttreceiver/internal/metadata"
+)
+
+// topicAttribute is the resource attribute holding the topic of the message the telemetry was converted from.
+const topicAttribute = "mqtt.topic"
+
+// scopeName is the instrumentation scope of the telemetry converted from the JSON messages.
+var scopeName = "otelcol/" + metadata.Type.String() + "receiver"
+
+// unmarshalMetrics converts the payload of a message of the subscription to metrics.
+func (sub *SubscriptionConfig) unmarshalMetrics(topic string, payload []byte, now time.Time) (pmetric.Metrics, error) {
+	switch sub.Encoding {
+	case encodingOTLPProto:
+		return (&pmetric.ProtoUnmarshaler{}).UnmarshalMetrics(payload)
+	case encodingOTLPJSON:
+		return (&pmetric.JSONUnmarshaler{}).UnmarshalMetrics(payload)
+	}
+
+	objects, err := parseJSONObjects(payload)
+	if err != nil {
+		return pmetric.Metrics{}, err
+	}
+	md := pmetric.NewMetrics()
+	rm := md.ResourceMetrics().AppendEmpty()
+	rm.Resource().Attributes().PutStr(topicAttribute, topic)
+	sm := rm.ScopeMetrics().AppendEmpty()
+	sm.Scope().SetName(scopeName)
+
+	for _, mc := range sub.Metrics {
+		var dps pmetric.NumberDataPointSlice
+		appended := false
+		for _, obj := range objects {
+			value, ok := lookupField(obj, mc.Field)
+			if !ok {
+				continue
+			}
+			// the metric is only appended when at least one object holds its field
+			if !appended {
+				dps = appendMetric(sm.Metrics(), mc)
+				appended = true
+			}
+			dp := dps.AppendEmpty()
+			if err = setDataPointValue(dp, value); err != nil {
+				return pmetric.Metrics{}, fmt.Errorf("invalid value of the metric %q: %w", mc.Name, err)
+			}
+			ts, err := sub.timestamp(obj, now)
+			if err != nil {
+				return pmetric.Metrics{}, err
+			}
+			dp.SetTimestamp(ts)
+			sub.putAttributes(obj, dp.Attributes())
+		}
+	}
+	return md, nil
+}
+
+// unmarshalLogs converts the payload of a message of the subscription to logs.
+func (sub *SubscriptionConfig) unmarshalLogs(topic string, payload []byte, now time.Time) (plog.Logs, error) {
+	switch sub.Encoding {
+	case encodingOTLPProto:
+		return (&plog.ProtoUnmarshaler{}).UnmarshalLogs(payload)
+	case encodingOTLPJSON:
+		return (&plog.JSONUnmarshaler{}).UnmarshalLogs(payload)
+	}
+
+	objects, err := parseJSONObjects(payload)
+	if err != nil {
+		return plog.Logs{}, err
+	}
+	ld := plog.NewLogs()
+	rl := ld.ResourceLogs().AppendEmpty()
+	rl.Resource().Attributes().PutStr(topicAttribute, topic)
+	sl := rl.ScopeLogs().AppendEmpty()
+	sl.Scope().SetName(scopeName)
+
+	for _, obj := range objects {
+		lr := sl.LogRecords().AppendEmpty()
+		lr.SetObservedTimestamp(pcommon.NewTimestampFromTime(now))
+		ts, err := sub.timestamp(obj, now)
+		if err != nil {
+			return plog.Logs{}, err
+		}
+		lr.SetTimestamp(ts)
+
+		if sub.Logs.Body == "" {
+			if err = lr.Body().SetEmptyMap().FromRaw(obj); err != nil {
+				return plog.Logs{}, err
+			}
+		} else if body, ok := lookupField(obj, sub.Logs.Body); ok {
+			if err = lr.Body().FromRaw(body); err != nil {
+				return plog.Logs{}, err
+			}
+		}
+		if sub.Logs.Severity != "" {
+			if severity, ok := lookupField(obj, sub.Logs.Severity); ok {
+				lr.SetSeverityText(fmt.Sprint(severity))
+			}
+		}
+		sub.putAttributes(obj, lr.Attributes())
+	}
+	return ld, nil
+}
+
+func appendMetric(metrics pmetric.MetricSlice, mc MetricConfig) pmetric.NumberDataPointSlice {
+	m := metrics.AppendEmpty()
+	m.SetName(mc.Name)
+	m.SetUnit(mc.Unit)
+	m.SetDescription(mc.Description)
+	if mc.Type != metricTypeSum {
+		return m.SetEmptyGauge().DataPoints()
+	}
+	sum := m.SetEmptySum()
+	// each message holds the current value of the sum, e.g. the counter of a device since it started
+	sum.SetAggregationTemporality(pmetric.AggregationTemporalityCumulative)
+	sum.SetIsMonotonic(mc.Monotonic)
+	return sum.DataPoints()
+}
+
+// parseJSONObjects parses the JSON object of a message, or the JSON objects of an array, e.g. of a gateway batching
+// the readings of several devices.
+func parseJSONObjects(payload []byte) ([]map[string]any, error) {
+	decoder := json.NewDecoder(bytes.NewReader(payload))
+	// keeping the integers as int64, rather than converting them to float64
+	decoder.UseNumber()
+	var raw any
+	if err := decoder.Decode(&raw); err != nil {
+		return nil, fmt.Errorf("failed to parse the JSON message: %w", err)
+	}
+
+	switch v := raw.(type) {
+	case map[string]any:
+		return []map[string]any{normalizeNumbers(v).(map[string]any)}, nil
+	case []any:
+		objects := make([]map[string]any, 0, len(v))
+		for _, item := range v {
+			obj, ok := item.(map[string]any)
+			if !ok {
+				return nil, errors.New("the JSON array must hold objects")
+			}
+			objects = append(objects, normalizeNumbers(obj).(map[string]any))
+		}
+		return objects, nil
+	default:
+		return nil, errors.New("the JSON message must be an object or an array of objects")
+	}
+}
+
+// normalizeNumbers converts the JSON numbers to int64 or float64, the types supported by pcommon.Value.
+func normalizeNumbers(v any) any {
+	switch t := v.(type) {
+	case json.Number:
+		if i, err := t.Int64(); err == nil {
+			return i
+		}
+		f, _ := t.Float64()
+		return f
+	case map[string]any:
+		for k, item := range t {
+			t[k] = normalizeNumbers(item)
+		}
+	case []any:
+		for i, item := range t {
+			t[i] = normalizeNumbers(item)
+		}
+	}
+	return v
+}
+
+// lookupField returns the value of a field of the JSON object, the fields of nested objects being separated by dots,
+// e.g. sensors.temperature.
+func lookupField(obj map[string]any, field string) (any, bool) {
+	var current any = obj
+	for _, name := range strings.Split(field, ".") {
+		m, ok := current.(map[string]any)
+		if !ok {
+			return nil, false
+		}
+		if current, ok = m[name]; !ok {
+			return nil, false
+		}
+	}
+	return current, true
+}
+
+func setDataPointValue(dp pmetric.NumberDataPoint, value any) error {
+	switch v := value.(type) {
+	case int64:
+		dp.SetIntValue(v)
+	case float64:
+		dp.SetDoubleValue(v)
+	case bool:
+		if v {
+			dp.SetIntValue(1)
+		} else {
+			dp.SetIntValue(0)
+		}
+	default:
+		return fmt.Errorf("expected a number or a boolean, got %v", value)
+	}
+	return nil
+}
+
+// timestamp returns the time of the JSON object, or the given time of reception without timestamp field.
+func (sub *SubscriptionConfig) timestamp(obj map[string]any, now time.Time) (pcommon.Timestamp, error) {
+	if sub.Timestamp == "" {
+		return pcommon.NewTimestampFromTime(now), nil
+	}
+	value, ok := lookupField(obj, sub.Timestamp)
+	if !ok {
+		return pcommon.NewTimestampFromTime(now), nil
+	}
+	switch v := value.(type) {
+	case int64:
+		return pcommon.NewTimestampFromTime(time.Unix(v, 0)), nil
+	case float64:
+		sec, frac := math.Modf(v)
+		return pcommon.NewTimestampFromTime(time.Unix(int64(sec), int64(frac*1e9))), nil
+	case string:
+		t, err := time.Parse(time.RFC3339Nano, v)
+		if err != nil {
+			return 0, fmt.Errorf("invalid timestamp: %w", err)
+		}
+		return pcommon.NewTimestampFromTime(t), nil
+	default:
+		return 0, fmt.Errorf("invalid timestamp %v", value)
+	}
+}
+
+func (sub *SubscriptionConfig) putAttributes(obj map[string]any, attrs pcommon.Map) {
+	for name, field := range sub.Attributes {
+		if value, ok := lookupField(obj, field); ok {
+			// the values which can't be converted, e.g. nested arrays of unsupported types, are skipped
+			_ = attrs.PutEmpty(name).FromRaw(value)
+		}
+	}
+}
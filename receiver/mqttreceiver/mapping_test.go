@@ -0,0 +1,143 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package mqttreceiver
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/collector/pdata/pcommon"
+	"go.opentelemetry.io/collector/pdata/plog"
+	"go.opentelemetry.io/collector/pdata/pmetric"
+)
+
+var testNow = time.Date(2024, 6, 1, 12, 0, 0, 0, time.UTC)
+
+func TestUnmarshalJSONMetrics(t *testing.T) {
+	sub := &SubscriptionConfig{
+		Signal:     signalMetrics,
+		Timestamp:  "ts",
+		Attributes: map[string]string{"device.id": "device"},
+		Metrics: []MetricConfig{
+			{Name: "temperature", Field: "sensors.temperature", Unit: "Cel"},
+			{Name: "energy", Field: "energy", Type: metricTypeSum, Monotonic: true, Unit: "kWh"},
+			{Name: "running", Field: "running"},
+			{Name: "pressure", Field: "pressure"},
+		},
+	}
+	payload := `[
+		{"device": "pump-1", "ts": 1717243200.5, "sensors": {"temperature": 21.5}, "energy": 1200, "running": true},
+		{"device": "pump-2", "sensors": {"temperature": 19}, "running": false}
+	]`
+
+	md, err := sub.unmarshalMetrics("plant/line-1/telemetry", []byte(payload), testNow)
+	require.NoError(t, err)
+
+	rm := md.ResourceMetrics().At(0)
+	assert.Equal(t, map[string]any{"mqtt.topic": "plant/line-1/telemetry"}, rm.Resource().Attributes().AsRaw())
+	sm := rm.ScopeMetrics().At(0)
+	assert.Equal(t, "otelcol/mqttreceiver", sm.Scope().Name())
+	// the pressure isn't held by any object
+	require.Equal(t, 3, sm.Metrics().Len())
+
+	temperature := sm.Metrics().At(0)
+	assert.Equal(t, "temperature", temperature.Name())
+	assert.Equal(t, "Cel", temperature.Unit())
+	require.Equal(t, pmetric.MetricTypeGauge, temperature.Type())
+	dps := temperature.Gauge().DataPoints()
+	require.Equal(t, 2, dps.Len())
+	assert.Equal(t, 21.5, dps.At(0).DoubleValue())
+	assert.Equal(t, pcommon.NewTimestampFromTime(time.Unix(1717243200, 5e8)), dps.At(0).Timestamp())
+	assert.Equal(t, map[string]any{"device.id": "pump-1"}, dps.At(0).Attributes().AsRaw())
+	assert.Equal(t, int64(19), dps.At(1).IntValue())
+	assert.Equal(t, pcommon.NewTimestampFromTime(testNow), dps.At(1).Timestamp())
+
+	energy := sm.Metrics().At(1)
+	require.Equal(t, pmetric.MetricTypeSum, energy.Type())
+	assert.True(t, energy.Sum().IsMonotonic())
+	assert.Equal(t, pmetric.AggregationTemporalityCumulative, energy.Sum().AggregationTemporality())
+	require.Equal(t, 1, energy.Sum().DataPoints().Len())
+	assert.Equal(t, int64(1200), energy.Sum().DataPoints().At(0).IntValue())
+
+	running := sm.Metrics().At(2).Gauge().DataPoints()
+	require.Equal(t, 2, running.Len())
+	assert.Equal(t, int64(1), running.At(0).IntValue())
+	assert.Equal(t, int64(0), running.At(1).IntValue())
+}
+
+func TestUnmarshalJSONLogs(t *testing.T) {
+	sub := &SubscriptionConfig{
+		Signal:     signalLogs,
+		Timestamp:  "time",
+		Attributes: map[string]string{"device.id": "device"},
+		Logs:       LogConfig{Body: "message", Severity: "level"},
+	}
+	payload := `{"device": "pump-1", "time": "2024-06-01T11:59:00Z", "message": "pressure too high", "level": "WARN"}`
+
+	ld, err := sub.unmarshalLogs("plant/line-1/events", []byte(payload), testNow)
+	require.NoError(t, err)
+	require.Equal(t, 1, ld.LogRecordCount())
+	lr := ld.ResourceLogs().At(0).ScopeLogs().At(0).LogRecords().At(0)
+	assert.Equal(t, "pressure too high", lr.Body().Str())
+	assert.Equal(t, "WARN", lr.SeverityText())
+	assert.Equal(t, pcommon.NewTimestampFromTime(time.Date(2024, 6, 1, 11, 59, 0, 0, time.UTC)), lr.Timestamp())
+	assert.Equal(t, pcommon.NewTimestampFromTime(testNow), lr.ObservedTimestamp())
+	assert.Equal(t, map[string]any{"device.id": "pump-1"}, lr.Attributes().AsRaw())
+
+	// without body field, the body is the whole object
+	sub = &SubscriptionConfig{Signal: signalLogs}
+	ld, err = sub.unmarshalLogs("plant/line-1/events", []byte(payload), testNow)
+	require.NoError(t, err)
+	lr = ld.ResourceLogs().At(0).ScopeLogs().At(0).LogRecords().At(0)
+	assert.Equal(t, map[string]any{
+		"device":  "pump-1",
+		"time":    "2024-06-01T11:59:00Z",
+		"message": "pressure too high",
+		"level":   "WARN",
+	}, lr.Body().Map().AsRaw())
+}
+
+func TestUnmarshalOTLP(t *testing.T) {
+	md := pmetric.NewMetrics()
+	md.ResourceMetrics().AppendEmpty().ScopeMetrics().AppendEmpty().Metrics().AppendEmpty().SetName("metric")
+	data, err := (&pmetric.ProtoMarshaler{}).MarshalMetrics(md)
+	require.NoError(t, err)
+	sub := &SubscriptionConfig{Signal: signalMetrics, Encoding: encodingOTLPProto}
+	got, err := sub.unmarshalMetrics("otlp/metrics", data, testNow)
+	require.NoError(t, err)
+	assert.Equal(t, md, got)
+
+	ld := plog.NewLogs()
+	ld.ResourceLogs().AppendEmpty().ScopeLogs().AppendEmpty().LogRecords().AppendEmpty().Body().SetStr("log")
+	data, err = (&plog.JSONMarshaler{}).MarshalLogs(ld)
+	require.NoError(t, err)
+	sub = &SubscriptionConfig{Signal: signalLogs, Encoding: encodingOTLPJSON}
+	gotLogs, err := sub.unmarshalLogs("otlp/logs", data, testNow)
+	require.NoError(t, err)
+	assert.Equal(t, ld, gotLogs)
+}
+
+func TestUnmarshalErrors(t *testing.T) {
+	sub := &SubscriptionConfig{
+		Signal:    signalMetrics,
+		Timestamp: "ts",
+		Metrics:   []MetricConfig{{Name: "temperature", Field: "temperature"}},
+	}
+	tests := []struct {
+		payload string
+		err     string
+	}{
+		{payload: `not json`, err: "failed to parse the JSON message: invalid character 'o' in literal null (expecting 'u')"},
+		{payload: `42`, err: "the JSON message must be an object or an array of objects"},
+		{payload: `[42]`, err: "the JSON array must hold objects"},
+		{payload: `{"temperature": "hot"}`, err: `invalid value of the metric "temperature": expected a number or a boolean, got hot`},
+		{payload: `{"temperature": 21, "ts": "yesterday"}`, err: `invalid timestamp: parsing time "yesterday" as "2006-01-02T15:04:05.999999999Z07:00": cannot parse "yesterday" as "2006"`},
+	}
+	for _, tt := range tests {
+		_, err := sub.unmarshalMetrics("plant/line-1/telemetry", []byte(tt.payload), testNow)
+		assert.EqualError(t, err, tt.err, tt.payload)
+	}
+}
@@ -0,0 +1,143 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package mqttreceiver // import "github.com/open-telemetry/opentelemetry-collector-contrib/receiver/mqttreceiver"
+
+import (
+	"context"
+	"crypto/tls"
+	"fmt"
+	"time"
+
+	"go.opentelemetry.io/collector/component"
+	"go.opentelemetry.io/collector/consumer"
+	"go.opentelemetry.io/collector/receiver"
+	"go.opentelemetry.io/collector/receiver/receiverhelper"
+	"go.uber.org/zap"
+)
+
+const transport = "mqtt"
+
+// mqttReceiver subscribes to the topic filters of the MQTT broker, and converts the messages to metrics or logs. The
+// receiver is shared by the metrics and logs pipelines, with a single session on the broker.
+type mqttReceiver struct {
+	cfg      *Config
+	settings receiver.CreateSettings
+	obsrecv  *receiverhelper.ObsReport
+
+	nextMetrics consumer.Metrics
+	nextLogs    consumer.Logs
+
+	newClient func(settings clientSettings) brokerClient
+	client    brokerClient
+	now       func() time.Time
+}
+
+func newReceiver(cfg *Config, set receiver.CreateSettings) (*mqttReceiver, error) {
+	obsrecv, err := receiverhelper.NewObsReport(receiverhelper.ObsReportSettings{
+		ReceiverID:             set.ID,
+		Transport:              transport,
+		ReceiverCreateSettings: set,
+	})
+	if err != nil {
+		return nil, err
+	}
+	r := &mqttReceiver{
+		cfg:       cfg,
+		settings:  set,
+		obsrecv:   obsrecv,
+		newClient: newV311Client,
+		now:       time.Now,
+	}
+	if cfg.ProtocolVersion == protocolVersion5 {
+		r.newClient = newV5Client
+	}
+	return r, nil
+}
+
+func (r *mqttReceiver) Start(ctx context.Context, _ component.Host) error {
+	var tlsCfg *tls.Config
+	if r.cfg.usesTLS() {
+		var err error
+		if tlsCfg, err = r.cfg.TLS.LoadTLSConfig(ctx); err != nil {
+			return fmt.Errorf("failed to load TLS config: %w", err)
+		}
+	}
+
+	// the same filter subscribed to by several subscriptions, e.g. for metrics and logs, is subscribed to once
+	filters := make(map[string]byte, len(r.cfg.Subscriptions))
+	for _, sub := range r.cfg.Subscriptions {
+		if qos, ok := filters[sub.Topic]; !ok || sub.QoS > qos {
+			filters[sub.Topic] = sub.QoS
+		}
+	}
+	r.client = r.newClient(clientSettings{
+		cfg:     r.cfg,
+		tls:     tlsCfg,
+		filters: filters,
+		handler: r.handleMessage,
+		logger:  r.settings.Logger,
+	})
+	return r.client.connect(ctx)
+}
+
+// handleMessage converts the message for each subscription matching its topic. The messages failing to be converted
+// or consumed are dropped, the broker not redelivering them.
+func (r *mqttReceiver) handleMessage(topic string, payload []byte) {
+	now := r.now()
+	for i := range r.cfg.Subscriptions {
+		sub := &r.cfg.Subscriptions[i]
+		if !topicMatches(sub.Topic, topic) {
+			continue
+		}
+		switch sub.Signal {
+		case signalMetrics:
+			r.consumeMetrics(sub, topic, payload, now)
+		case signalLogs:
+			r.consumeLogs(sub, topic, payload, now)
+		}
+	}
+}
+
+func (r *mqttReceiver) consumeMetrics(sub *SubscriptionConfig, topic string, payload []byte, now time.Time) {
+	if r.nextMetrics == nil {
+		return
+	}
+	ctx := r.obsrecv.StartMetricsOp(context.Background())
+	md, err := sub.unmarshalMetrics(topic, payload, now)
+	if err != nil {
+		r.obsrecv.EndMetricsOp(ctx, sub.encoding(), 0, err)
+		r.settings.Logger.Error("Failed to convert the message to metrics", zap.String("topic", topic), zap.Error(err))
+		return
+	}
+	err = r.nextMetrics.ConsumeMetrics(ctx, md)
+	r.obsrecv.EndMetricsOp(ctx, sub.encoding(), md.DataPointCount(), err)
+	if err != nil {
+		r.settings.Logger.Error("Failed to consume the metrics", zap.String("topic", topic), zap.Error(err))
+	}
+}
+
+func (r *mqttReceiver) consumeLogs(sub *SubscriptionConfig, topic string, payload []byte, now time.Time) {
+	if r.nextLogs == nil {
+		return
+	}
+	ctx := r.obsrecv.StartLogsOp(context.Background())
+	ld, err := sub.unmarshalLogs(topic, payload, now)
+	if err != nil {
+		r.obsrecv.EndLogsOp(ctx, sub.encoding(), 0, err)
+		r.settings.Logger.Error("Failed to convert the message to logs", zap.String("topic", topic), zap.Error(err))
+		return
+	}
+	err = r.nextLogs.ConsumeLogs(ctx, ld)
+	r.obsrecv.EndLogsOp(ctx, sub.encoding(), ld.LogRecordCount(), err)
+	if err != nil {
+		r.settings.Logger.Error("Failed to consume the logs", zap.String("topic", topic), zap.Error(err))
+	}
+}
+
+func (r *mqttReceiver) Shutdown(ctx context.Context) error {
+	if r.client == nil {
+		return nil
+	}
+	return r.client.disconnect(ctx)
+}
@@ -0,0 +1,147 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package mqttreceiver
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/collector/component"
+	"go.opentelemetry.io/collector/component/componenttest"
+	"go.opentelemetry.io/collector/consumer/consumertest"
+	"go.opentelemetry.io/collector/pdata/plog"
+	"go.opentelemetry.io/collector/receiver/receivertest"
+	"go.uber.org/zap"
+	"go.uber.org/zap/zaptest/observer"
+)
+
+type fakeClient struct {
+	settings     clientSettings
+	connected    bool
+	disconnected bool
+}
+
+func (c *fakeClient) connect(context.Context) error {
+	c.connected = true
+	return nil
+}
+
+func (c *fakeClient) disconnect(context.Context) error {
+	c.disconnected = true
+	return nil
+}
+
+func newTestConfig() *Config {
+	cfg := createDefaultConfig().(*Config)
+	cfg.Subscriptions = []SubscriptionConfig{
+		{
+			Topic:   "plant/+/telemetry",
+			QoS:     1,
+			Signal:  signalMetrics,
+			Metrics: []MetricConfig{{Name: "temperature", Field: "temperature"}},
+		},
+		{
+			Topic:  "plant/+/telemetry",
+			Signal: signalLogs,
+		},
+		{
+			Topic:    "otlp/logs",
+			QoS:      2,
+			Signal:   signalLogs,
+			Encoding: encodingOTLPJSON,
+		},
+	}
+	return cfg
+}
+
+func TestReceiver(t *testing.T) {
+	cfg := newTestConfig()
+	metricsSink := new(consumertest.MetricsSink)
+	logsSink := new(consumertest.LogsSink)
+	set := receivertest.NewNopCreateSettings()
+
+	metricsReceiver, err := createMetricsReceiver(context.Background(), set, cfg, metricsSink)
+	require.NoError(t, err)
+	logsReceiver, err := createLogsReceiver(context.Background(), set, cfg, logsSink)
+	require.NoError(t, err)
+
+	// the metrics and logs pipelines share the session on the broker
+	client := &fakeClient{}
+	r := metricsReceiver.(interface{ Unwrap() component.Component }).Unwrap().(*mqttReceiver)
+	r.newClient = func(settings clientSettings) brokerClient {
+		client.settings = settings
+		return client
+	}
+	r.now = func() time.Time { return testNow }
+	require.NoError(t, metricsReceiver.Start(context.Background(), componenttest.NewNopHost()))
+	require.NoError(t, logsReceiver.Start(context.Background(), componenttest.NewNopHost()))
+	assert.True(t, client.connected)
+	assert.Equal(t, map[string]byte{"plant/+/telemetry": 1, "otlp/logs": 2}, client.settings.filters)
+	assert.Nil(t, client.settings.tls)
+
+	client.settings.handler("plant/line-1/telemetry", []byte(`{"temperature": 21.5}`))
+	require.Len(t, metricsSink.AllMetrics(), 1)
+	assert.Equal(t, 1, metricsSink.DataPointCount())
+	require.Len(t, logsSink.AllLogs(), 1)
+	assert.Equal(t, 1, logsSink.LogRecordCount())
+
+	ld := plog.NewLogs()
+	ld.ResourceLogs().AppendEmpty().ScopeLogs().AppendEmpty().LogRecords().AppendEmpty().Body().SetStr("log")
+	data, err := (&plog.JSONMarshaler{}).MarshalLogs(ld)
+	require.NoError(t, err)
+	client.settings.handler("otlp/logs", data)
+	require.Len(t, logsSink.AllLogs(), 2)
+	assert.Equal(t, ld, logsSink.AllLogs()[1])
+
+	// the message of a topic matching no subscription is ignored
+	client.settings.handler("factory/line-1/telemetry", []byte(`{"temperature": 21.5}`))
+	assert.Len(t, metricsSink.AllMetrics(), 1)
+	assert.Len(t, logsSink.AllLogs(), 2)
+
+	require.NoError(t, metricsReceiver.Shutdown(context.Background()))
+	require.NoError(t, logsReceiver.Shutdown(context.Background()))
+	assert.True(t, client.disconnected)
+}
+
+func TestReceiverConsumerError(t *testing.T) {
+	cfg := newTestConfig()
+	core, observed := observer.New(zap.ErrorLevel)
+	set := receivertest.NewNopCreateSettings()
+	set.Logger = zap.New(core)
+	logsReceiver, err := createLogsReceiver(context.Background(), set, cfg, consumertest.NewErr(errors.New("queue is full")))
+	require.NoError(t, err)
+	client := &fakeClient{}
+	r := logsReceiver.(interface{ Unwrap() component.Component }).Unwrap().(*mqttReceiver)
+	r.newClient = func(settings clientSettings) brokerClient {
+		client.settings = settings
+		return client
+	}
+	require.NoError(t, logsReceiver.Start(context.Background(), componenttest.NewNopHost()))
+	defer func() {
+		assert.NoError(t, logsReceiver.Shutdown(context.Background()))
+	}()
+
+	// the message is dropped, without metrics consumer the metrics subscription is ignored
+	client.settings.handler("plant/line-1/telemetry", []byte(`{"temperature": 21.5}`))
+	require.Equal(t, 1, observed.Len())
+	assert.Equal(t, "Failed to consume the logs", observed.All()[0].Message)
+
+	client.settings.handler("otlp/logs", []byte(`not json`))
+	require.Equal(t, 2, observed.Len())
+	assert.Equal(t, "Failed to convert the message to logs", observed.All()[1].Message)
+}
+
+func TestStartTLSError(t *testing.T) {
+	cfg := newTestConfig()
+	cfg.Endpoint = "ssl://broker:8883"
+	cfg.TLS.CAFile = "/nonexistent"
+	r, err := createMetricsReceiver(context.Background(), receivertest.NewNopCreateSettings(), cfg, consumertest.NewNop())
+	require.NoError(t, err)
+	assert.ErrorContains(t, r.Start(context.Background(), componenttest.NewNopHost()), "failed to load TLS config")
+	assert.NoError(t, r.Shutdown(context.Background()))
+}
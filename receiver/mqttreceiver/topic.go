@@ -0,0 +1,61 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package mqttreceiver // import "github.com/open-telemetry/opentelemetry-collector-contrib/receiver/mqttreceiver"
+
+import (
+	"errors"
+	"strings"
+)
+
+// sharedSubscriptionPrefix prefixes the filters of the shared subscriptions, $share/<group>/<filter>, whose messages
+// are distributed among the clients of the group.
+const sharedSubscriptionPrefix = "$share/"
+
+// validateTopicFilter checks that the multi-level wildcard # is the last level of the filter, and that the wildcards
+// fill whole levels.
+func validateTopicFilter(filter string) error {
+	if strings.HasPrefix(filter, sharedSubscriptionPrefix) {
+		group, rest, ok := strings.Cut(strings.TrimPrefix(filter, sharedSubscriptionPrefix), "/")
+		if !ok || group == "" || rest == "" {
+			return errors.New("invalid shared subscription, expected $share/<group>/<filter>")
+		}
+		filter = rest
+	}
+	levels := strings.Split(filter, "/")
+	for i, level := range levels {
+		if strings.Contains(level, "#") && (level != "#" || i != len(levels)-1) {
+			return errors.New("the wildcard # must be the last level of the topic filter")
+		}
+		if strings.Contains(level, "+") && level != "+" {
+			return errors.New("the wildcard + must fill a whole level of the topic filter")
+		}
+	}
+	return nil
+}
+
+// topicMatches returns whether the topic of a message matches the topic filter.
+func topicMatches(filter string, topic string) bool {
+	if strings.HasPrefix(filter, sharedSubscriptionPrefix) {
+		_, filter, _ = strings.Cut(strings.TrimPrefix(filter, sharedSubscriptionPrefix), "/")
+	}
+	// the topics starting with $, e.g. $SYS, aren't matched by the filters starting with a wildcard
+	if strings.HasPrefix(topic, "$") && (strings.HasPrefix(filter, "+") || strings.HasPrefix(filter, "#")) {
+		return false
+	}
+
+	filterLevels := strings.Split(filter, "/")
+	topicLevels := strings.Split(topic, "/")
+	for i, level := range filterLevels {
+		if level == "#" {
+			return true
+		}
+		if i >= len(topicLevels) {
+			return false
+		}
+		if level != "+" && level != topicLevels[i] {
+			return false
+		}
+	}
+	return len(filterLevels) == len(topicLevels)
+}
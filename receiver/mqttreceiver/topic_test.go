@@ -0,0 +1,43 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package mqttreceiver
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestValidateTopicFilter(t *testing.T) {
+	for _, filter := range []string{"plant/line-1/temperature", "plant/+/temperature", "plant/#", "#", "+/+", "$share/collectors/plant/#"} {
+		assert.NoError(t, validateTopicFilter(filter), filter)
+	}
+	for _, filter := range []string{"plant/#/temperature", "plant/line#", "plant/line+/temperature", "$share/collectors", "$share//plant/#"} {
+		assert.Error(t, validateTopicFilter(filter), filter)
+	}
+}
+
+func TestTopicMatches(t *testing.T) {
+	tests := []struct {
+		filter string
+		topic  string
+		want   bool
+	}{
+		{filter: "plant/line-1/temperature", topic: "plant/line-1/temperature", want: true},
+		{filter: "plant/line-1/temperature", topic: "plant/line-2/temperature", want: false},
+		{filter: "plant/+/temperature", topic: "plant/line-2/temperature", want: true},
+		{filter: "plant/+/temperature", topic: "plant/line-2/humidity", want: false},
+		{filter: "plant/+", topic: "plant/line-2/humidity", want: false},
+		{filter: "plant/#", topic: "plant/line-2/humidity", want: true},
+		{filter: "plant/#", topic: "plant", want: true},
+		{filter: "plant/line-1", topic: "plant", want: false},
+		{filter: "#", topic: "plant/line-2/humidity", want: true},
+		{filter: "#", topic: "$SYS/broker/uptime", want: false},
+		{filter: "$SYS/#", topic: "$SYS/broker/uptime", want: true},
+		{filter: "$share/collectors/plant/+/temperature", topic: "plant/line-2/temperature", want: true},
+	}
+	for _, tt := range tests {
+		assert.Equal(t, tt.want, topicMatches(tt.filter, tt.topic), "%s %s", tt.filter, tt.topic)
+	}
+}
@@ -0,0 +1,93 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package natsreceiver // import "github.com/open-telemetry/opentelemetry-collector-contrib/receiver/natsreceiver"
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/nats-io/nats.go/jetstream"
+	"go.opentelemetry.io/collector/component"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/internal/nats"
+)
+
+const (
+	encodingProto = "otlp_proto"
+	encodingJSON  = "otlp_json"
+)
+
+var (
+	ackPolicies = map[string]jetstream.AckPolicy{
+		"explicit": jetstream.AckExplicitPolicy,
+		"all":      jetstream.AckAllPolicy,
+		"none":     jetstream.AckNonePolicy,
+	}
+	deliverPolicies = map[string]jetstream.DeliverPolicy{
+		"all":  jetstream.DeliverAllPolicy,
+		"last": jetstream.DeliverLastPolicy,
+		"new":  jetstream.DeliverNewPolicy,
+	}
+)
+
+// Config defines configuration for the NATS receiver.
+type Config struct {
+	nats.ClientConfig `mapstructure:",squash"`
+
+	// Encoding of the received messages, otlp_proto (default) or otlp_json.
+	Encoding string `mapstructure:"encoding"`
+
+	// Stream is the JetStream stream the messages are consumed from.
+	Stream string `mapstructure:"stream"`
+	// Subject filters the messages of the stream (default otlp.spans for traces, otlp.metrics for metrics,
+	// otlp.logs for logs).
+	Subject string `mapstructure:"subject"`
+	// Durable is the name of the durable consumer, shared by the collectors consuming the same messages
+	// (default otelcol_traces for traces, otelcol_metrics for metrics, otelcol_logs for logs).
+	Durable string `mapstructure:"durable"`
+	// AckPolicy is the acknowledgement policy of the consumer, explicit (default), all or none.
+	AckPolicy string `mapstructure:"ack_policy"`
+	// AckWait is the time after which a message not acknowledged is redelivered (default 30s).
+	AckWait time.Duration `mapstructure:"ack_wait"`
+	// MaxDeliver is the maximum number of deliveries of a message, -1 for unlimited (default).
+	MaxDeliver int `mapstructure:"max_deliver"`
+	// DeliverPolicy is the message the consumer starts from when it's created, all (default), last or new.
+	DeliverPolicy string `mapstructure:"deliver_policy"`
+}
+
+var _ component.Config = (*Config)(nil)
+
+// Validate checks if the receiver configuration is valid.
+func (cfg *Config) Validate() error {
+	switch cfg.Encoding {
+	case encodingProto, encodingJSON:
+	default:
+		return fmt.Errorf("unsupported encoding %q", cfg.Encoding)
+	}
+	if cfg.Stream == "" {
+		return errors.New("stream must be specified")
+	}
+	if _, ok := ackPolicies[cfg.AckPolicy]; !ok {
+		return fmt.Errorf("unsupported ack_policy %q", cfg.AckPolicy)
+	}
+	if _, ok := deliverPolicies[cfg.DeliverPolicy]; !ok {
+		return fmt.Errorf("unsupported deliver_policy %q", cfg.DeliverPolicy)
+	}
+	if cfg.AckWait <= 0 {
+		return errors.New("ack_wait must be positive")
+	}
+	return nil
+}
+
+func (cfg *Config) consumerConfig() jetstream.ConsumerConfig {
+	return jetstream.ConsumerConfig{
+		Durable:       cfg.Durable,
+		FilterSubject: cfg.Subject,
+		AckPolicy:     ackPolicies[cfg.AckPolicy],
+		AckWait:       cfg.AckWait,
+		MaxDeliver:    cfg.MaxDeliver,
+		DeliverPolicy: deliverPolicies[cfg.DeliverPolicy],
+	}
+}
@@ -0,0 +1,90 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package natsreceiver
+
+import (
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/collector/component"
+	"go.opentelemetry.io/collector/config/configtls"
+	"go.opentelemetry.io/collector/confmap/confmaptest"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/internal/nats"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/receiver/natsreceiver/internal/metadata"
+)
+
+func TestLoadConfig(t *testing.T) {
+	t.Parallel()
+
+	cm, err := confmaptest.LoadConf(filepath.Join("testdata", "config.yaml"))
+	require.NoError(t, err)
+
+	defaultCfg := createDefaultConfig().(*Config)
+	defaultCfg.Stream = "TELEMETRY"
+
+	tests := []struct {
+		id           component.ID
+		expected     component.Config
+		errorMessage string
+	}{
+		{
+			id:       component.NewIDWithName(metadata.Type, ""),
+			expected: defaultCfg,
+		},
+		{
+			id: component.NewIDWithName(metadata.Type, "all_settings"),
+			expected: &Config{
+				ClientConfig: nats.ClientConfig{
+					Endpoint: "nats://nats-1:4222,nats://nats-2:4222",
+					Auth:     nats.Authentication{CredentialsFile: "/etc/nats/collector.creds"},
+					TLS: configtls.ClientConfig{
+						Insecure: true,
+					},
+				},
+				Encoding:      encodingJSON,
+				Stream:        "TELEMETRY",
+				Subject:       "telemetry.edge.>",
+				Durable:       "gateway",
+				AckPolicy:     "all",
+				AckWait:       time.Minute,
+				MaxDeliver:    5,
+				DeliverPolicy: "new",
+			},
+		},
+		{
+			id:           component.NewIDWithName(metadata.Type, "no_stream"),
+			errorMessage: "stream must be specified",
+		},
+		{
+			id:           component.NewIDWithName(metadata.Type, "bad_ack_policy"),
+			errorMessage: `unsupported ack_policy "sometimes"`,
+		},
+		{
+			id:           component.NewIDWithName(metadata.Type, "bad_deliver_policy"),
+			errorMessage: `unsupported deliver_policy "first"`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.id.String(), func(t *testing.T) {
+			factory := NewFactory()
+			cfg := factory.CreateDefaultConfig()
+
+			sub, err := cm.Sub(tt.id.String())
+			require.NoError(t, err)
+			require.NoError(t, sub.Unmarshal(cfg))
+
+			if tt.errorMessage != "" {
+				assert.EqualError(t, component.ValidateConfig(cfg), tt.errorMessage)
+				return
+			}
+			assert.NoError(t, component.ValidateConfig(cfg))
+			assert.Equal(t, tt.expected, cfg)
+		})
+	}
+}
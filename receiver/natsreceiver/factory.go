@@ -0,0 +1,97 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package natsreceiver // import "github.com/open-telemetry/opentelemetry-collector-contrib/receiver/natsreceiver"
+
+import (
+	"context"
+	"time"
+
+	"go.opentelemetry.io/collector/component"
+	"go.opentelemetry.io/collector/config/configtls"
+	"go.opentelemetry.io/collector/consumer"
+	"go.opentelemetry.io/collector/receiver"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/internal/nats"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/receiver/natsreceiver/internal/metadata"
+)
+
+const (
+	defaultTracesSubject  = "otlp.spans"
+	defaultMetricsSubject = "otlp.metrics"
+	defaultLogsSubject    = "otlp.logs"
+	defaultTracesDurable  = "otelcol_traces"
+	defaultMetricsDurable = "otelcol_metrics"
+	defaultLogsDurable    = "otelcol_logs"
+	defaultAckWait        = 30 * time.Second
+)
+
+// NewFactory creates a factory for the NATS receiver.
+func NewFactory() receiver.Factory {
+	return receiver.NewFactory(
+		metadata.Type,
+		createDefaultConfig,
+		receiver.WithTraces(createTracesReceiver, metadata.TracesStability),
+		receiver.WithMetrics(createMetricsReceiver, metadata.MetricsStability),
+		receiver.WithLogs(createLogsReceiver, metadata.LogsStability),
+	)
+}
+
+func createDefaultConfig() component.Config {
+	return &Config{
+		ClientConfig: nats.ClientConfig{
+			Endpoint: nats.DefaultEndpoint,
+			TLS: configtls.ClientConfig{
+				Insecure: true,
+			},
+		},
+		Encoding: encodingProto,
+		// using an empty subject and durable to track when they have not been set by user, default is based on the
+		// signal.
+		Subject:       "",
+		Durable:       "",
+		AckPolicy:     "explicit",
+		AckWait:       defaultAckWait,
+		MaxDeliver:    -1,
+		DeliverPolicy: "all",
+	}
+}
+
+// withDefaults returns a copy of the configuration, with the default subject and durable of the signal.
+func withDefaults(cfg component.Config, subject string, durable string) *Config {
+	oCfg := *(cfg.(*Config)) // Clone the config
+	if oCfg.Subject == "" {
+		oCfg.Subject = subject
+	}
+	if oCfg.Durable == "" {
+		oCfg.Durable = durable
+	}
+	return &oCfg
+}
+
+func createTracesReceiver(
+	_ context.Context,
+	set receiver.CreateSettings,
+	cfg component.Config,
+	nextConsumer consumer.Traces,
+) (receiver.Traces, error) {
+	return newTracesReceiver(withDefaults(cfg, defaultTracesSubject, defaultTracesDurable), set, nextConsumer)
+}
+
+func createMetricsReceiver(
+	_ context.Context,
+	set receiver.CreateSettings,
+	cfg component.Config,
+	nextConsumer consumer.Metrics,
+) (receiver.Metrics, error) {
+	return newMetricsReceiver(withDefaults(cfg, defaultMetricsSubject, defaultMetricsDurable), set, nextConsumer)
+}
+
+func createLogsReceiver(
+	_ context.Context,
+	set receiver.CreateSettings,
+	cfg component.Config,
+	nextConsumer consumer.Logs,
+) (receiver.Logs, error) {
+	return newLogsReceiver(withDefaults(cfg, defaultLogsSubject, defaultLogsDurable), set, nextConsumer)
+}
@@ -0,0 +1,33 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package natsreceiver
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"go.opentelemetry.io/collector/component/componenttest"
+)
+
+func TestCreateDefaultConfig(t *testing.T) {
+	cfg := createDefaultConfig()
+	assert.NotNil(t, cfg, "failed to create default config")
+	assert.NoError(t, componenttest.CheckConfigStruct(cfg))
+}
+
+func TestWithDefaults(t *testing.T) {
+	cfg := createDefaultConfig().(*Config)
+	traces := withDefaults(cfg, defaultTracesSubject, defaultTracesDurable)
+	assert.Equal(t, "otlp.spans", traces.Subject)
+	assert.Equal(t, "otelcol_traces", traces.Durable)
+	// the configuration shared by the signals isn't modified
+	assert.Empty(t, cfg.Subject)
+	assert.Empty(t, cfg.Durable)
+
+	cfg.Subject = "edge.>"
+	cfg.Durable = "gateway"
+	logs := withDefaults(cfg, defaultLogsSubject, defaultLogsDurable)
+	assert.Equal(t, "edge.>", logs.Subject)
+	assert.Equal(t, "gateway", logs.Durable)
+}
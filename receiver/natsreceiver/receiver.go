@@ -0,0 +1,207 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package natsreceiver // import "github.com/open-telemetry/opentelemetry-collector-contrib/receiver/natsreceiver"
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/nats-io/nats.go/jetstream"
+	"go.opentelemetry.io/collector/component"
+	"go.opentelemetry.io/collector/consumer"
+	"go.opentelemetry.io/collector/consumer/consumererror"
+	"go.opentelemetry.io/collector/pdata/plog"
+	"go.opentelemetry.io/collector/pdata/pmetric"
+	"go.opentelemetry.io/collector/pdata/ptrace"
+	"go.opentelemetry.io/collector/receiver"
+	"go.opentelemetry.io/collector/receiver/receiverhelper"
+	"go.uber.org/zap"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/internal/nats"
+)
+
+const transport = "nats"
+
+// consumerClient creates the JetStream consumers, for the NATS client to be replaced in the tests.
+type consumerClient interface {
+	createConsumer(ctx context.Context, stream string, cfg jetstream.ConsumerConfig) (jetstream.Consumer, error)
+	close() error
+}
+
+type jetStreamClient struct {
+	client *nats.Client
+}
+
+func (c *jetStreamClient) createConsumer(ctx context.Context, stream string, cfg jetstream.ConsumerConfig) (jetstream.Consumer, error) {
+	return c.client.JetStream.CreateOrUpdateConsumer(ctx, stream, cfg)
+}
+
+func (c *jetStreamClient) close() error {
+	return c.client.Close()
+}
+
+// natsReceiver consumes the telemetry data of one signal from a JetStream durable consumer.
+type natsReceiver struct {
+	cfg      *Config
+	settings receiver.CreateSettings
+	obsrecv  *receiverhelper.ObsReport
+	// handle passes the data of a message to the next consumer
+	handle func(ctx context.Context, data []byte) error
+
+	connect    func(ctx context.Context) (consumerClient, error)
+	client     consumerClient
+	consumeCtx jetstream.ConsumeContext
+	ctx        context.Context
+	cancel     context.CancelFunc
+}
+
+func newReceiver(cfg *Config, set receiver.CreateSettings) (*natsReceiver, error) {
+	obsrecv, err := receiverhelper.NewObsReport(receiverhelper.ObsReportSettings{
+		ReceiverID:             set.ID,
+		Transport:              transport,
+		ReceiverCreateSettings: set,
+	})
+	if err != nil {
+		return nil, err
+	}
+	r := &natsReceiver{
+		cfg:      cfg,
+		settings: set,
+		obsrecv:  obsrecv,
+	}
+	r.connect = r.connectJetStream
+	return r, nil
+}
+
+func newTracesReceiver(cfg *Config, set receiver.CreateSettings, next consumer.Traces) (*natsReceiver, error) {
+	r, err := newReceiver(cfg, set)
+	if err != nil {
+		return nil, err
+	}
+	var unmarshaler ptrace.Unmarshaler = &ptrace.ProtoUnmarshaler{}
+	if cfg.Encoding == encodingJSON {
+		unmarshaler = &ptrace.JSONUnmarshaler{}
+	}
+	r.handle = func(ctx context.Context, data []byte) error {
+		ctx = r.obsrecv.StartTracesOp(ctx)
+		td, err := unmarshaler.UnmarshalTraces(data)
+		if err != nil {
+			r.obsrecv.EndTracesOp(ctx, cfg.Encoding, 0, err)
+			return consumererror.NewPermanent(fmt.Errorf("failed to unmarshal the message: %w", err))
+		}
+		err = next.ConsumeTraces(ctx, td)
+		r.obsrecv.EndTracesOp(ctx, cfg.Encoding, td.SpanCount(), err)
+		return err
+	}
+	return r, nil
+}
+
+func newMetricsReceiver(cfg *Config, set receiver.CreateSettings, next consumer.Metrics) (*natsReceiver, error) {
+	r, err := newReceiver(cfg, set)
+	if err != nil {
+		return nil, err
+	}
+	var unmarshaler pmetric.Unmarshaler = &pmetric.ProtoUnmarshaler{}
+	if cfg.Encoding == encodingJSON {
+		unmarshaler = &pmetric.JSONUnmarshaler{}
+	}
+	r.handle = func(ctx context.Context, data []byte) error {
+		ctx = r.obsrecv.StartMetricsOp(ctx)
+		md, err := unmarshaler.UnmarshalMetrics(data)
+		if err != nil {
+			r.obsrecv.EndMetricsOp(ctx, cfg.Encoding, 0, err)
+			return consumererror.NewPermanent(fmt.Errorf("failed to unmarshal the message: %w", err))
+		}
+		err = next.ConsumeMetrics(ctx, md)
+		r.obsrecv.EndMetricsOp(ctx, cfg.Encoding, md.DataPointCount(), err)
+		return err
+	}
+	return r, nil
+}
+
+func newLogsReceiver(cfg *Config, set receiver.CreateSettings, next consumer.Logs) (*natsReceiver, error) {
+	r, err := newReceiver(cfg, set)
+	if err != nil {
+		return nil, err
+	}
+	var unmarshaler plog.Unmarshaler = &plog.ProtoUnmarshaler{}
+	if cfg.Encoding == encodingJSON {
+		unmarshaler = &plog.JSONUnmarshaler{}
+	}
+	r.handle = func(ctx context.Context, data []byte) error {
+		ctx = r.obsrecv.StartLogsOp(ctx)
+		ld, err := unmarshaler.UnmarshalLogs(data)
+		if err != nil {
+			r.obsrecv.EndLogsOp(ctx, cfg.Encoding, 0, err)
+			return consumererror.NewPermanent(fmt.Errorf("failed to unmarshal the message: %w", err))
+		}
+		err = next.ConsumeLogs(ctx, ld)
+		r.obsrecv.EndLogsOp(ctx, cfg.Encoding, ld.LogRecordCount(), err)
+		return err
+	}
+	return r, nil
+}
+
+func (r *natsReceiver) connectJetStream(ctx context.Context) (consumerClient, error) {
+	client, err := nats.Connect(ctx, r.cfg.ClientConfig, r.settings.ID.String())
+	if err != nil {
+		return nil, err
+	}
+	return &jetStreamClient{client: client}, nil
+}
+
+func (r *natsReceiver) Start(ctx context.Context, _ component.Host) error {
+	client, err := r.connect(ctx)
+	if err != nil {
+		return err
+	}
+	r.client = client
+
+	cons, err := client.createConsumer(ctx, r.cfg.Stream, r.cfg.consumerConfig())
+	if err != nil {
+		return fmt.Errorf("failed to create the consumer %q of the stream %q: %w", r.cfg.Durable, r.cfg.Stream, err)
+	}
+	r.ctx, r.cancel = context.WithCancel(context.Background())
+	r.consumeCtx, err = cons.Consume(r.onMessage, jetstream.ConsumeErrHandler(func(_ jetstream.ConsumeContext, err error) {
+		r.settings.Logger.Warn("Failed to consume the messages", zap.Error(err))
+	}))
+	return err
+}
+
+// onMessage passes the message to the next consumer, and acknowledges it, unless the ack policy is none. A message
+// failing with a permanent error, e.g. because it can't be unmarshaled, is terminated instead of being redelivered.
+func (r *natsReceiver) onMessage(msg jetstream.Msg) {
+	err := r.handle(r.ctx, msg.Data())
+	if r.cfg.AckPolicy == "none" {
+		return
+	}
+
+	var ackErr error
+	switch {
+	case err == nil:
+		ackErr = msg.Ack()
+	case consumererror.IsPermanent(err):
+		r.settings.Logger.Error("Dropping the message", zap.String("subject", msg.Subject()), zap.Error(err))
+		ackErr = msg.Term()
+	default:
+		r.settings.Logger.Debug("Failed to consume the message, it will be redelivered", zap.Error(err))
+		ackErr = msg.Nak()
+	}
+	if ackErr != nil {
+		r.settings.Logger.Warn("Failed to acknowledge the message", zap.Error(ackErr))
+	}
+}
+
+func (r *natsReceiver) Shutdown(context.Context) error {
+	if r.consumeCtx != nil {
+		r.consumeCtx.Stop()
+	}
+	if r.cancel != nil {
+		r.cancel()
+	}
+	if r.client == nil {
+		return nil
+	}
+	return r.client.close()
+}
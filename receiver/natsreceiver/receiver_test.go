@@ -0,0 +1,227 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package natsreceiver
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/nats-io/nats.go/jetstream"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/collector/component/componenttest"
+	"go.opentelemetry.io/collector/consumer/consumererror"
+	"go.opentelemetry.io/collector/consumer/consumertest"
+	"go.opentelemetry.io/collector/pdata/plog"
+	"go.opentelemetry.io/collector/pdata/pmetric"
+	"go.opentelemetry.io/collector/pdata/ptrace"
+	"go.opentelemetry.io/collector/receiver/receivertest"
+)
+
+type fakeMsg struct {
+	jetstream.Msg
+	data  []byte
+	acked string
+}
+
+func (m *fakeMsg) Data() []byte    { return m.data }
+func (m *fakeMsg) Subject() string { return "otlp.spans" }
+func (m *fakeMsg) Ack() error      { m.acked = "ack"; return nil }
+func (m *fakeMsg) Nak() error      { m.acked = "nak"; return nil }
+func (m *fakeMsg) Term() error     { m.acked = "term"; return nil }
+
+type fakeConsumeContext struct {
+	jetstream.ConsumeContext
+	stopped bool
+}
+
+func (c *fakeConsumeContext) Stop() { c.stopped = true }
+
+type fakeConsumer struct {
+	jetstream.Consumer
+	handler    jetstream.MessageHandler
+	consumeCtx *fakeConsumeContext
+}
+
+func (c *fakeConsumer) Consume(handler jetstream.MessageHandler, _ ...jetstream.PullConsumeOpt) (jetstream.ConsumeContext, error) {
+	c.handler = handler
+	c.consumeCtx = &fakeConsumeContext{}
+	return c.consumeCtx, nil
+}
+
+type fakeClient struct {
+	stream   string
+	cfg      jetstream.ConsumerConfig
+	consumer *fakeConsumer
+	err      error
+	closed   bool
+}
+
+func (c *fakeClient) createConsumer(_ context.Context, stream string, cfg jetstream.ConsumerConfig) (jetstream.Consumer, error) {
+	if c.err != nil {
+		return nil, c.err
+	}
+	c.stream = stream
+	c.cfg = cfg
+	c.consumer = &fakeConsumer{}
+	return c.consumer, nil
+}
+
+func (c *fakeClient) close() error {
+	c.closed = true
+	return nil
+}
+
+func startTestReceiver(t *testing.T, r *natsReceiver) *fakeClient {
+	client := &fakeClient{}
+	r.connect = func(context.Context) (consumerClient, error) {
+		return client, nil
+	}
+	require.NoError(t, r.Start(context.Background(), componenttest.NewNopHost()))
+	t.Cleanup(func() {
+		assert.NoError(t, r.Shutdown(context.Background()))
+		assert.True(t, client.closed)
+		assert.True(t, client.consumer.consumeCtx.stopped)
+	})
+	return client
+}
+
+func newTestConfig() *Config {
+	cfg := createDefaultConfig().(*Config)
+	cfg.Stream = "TELEMETRY"
+	return cfg
+}
+
+func TestTracesReceiver(t *testing.T) {
+	sink := new(consumertest.TracesSink)
+	r, err := createTracesReceiver(context.Background(), receivertest.NewNopCreateSettings(), newTestConfig(), sink)
+	require.NoError(t, err)
+	client := startTestReceiver(t, r.(*natsReceiver))
+
+	assert.Equal(t, "TELEMETRY", client.stream)
+	assert.Equal(t, jetstream.ConsumerConfig{
+		Durable:       "otelcol_traces",
+		FilterSubject: "otlp.spans",
+		AckPolicy:     jetstream.AckExplicitPolicy,
+		AckWait:       30 * time.Second,
+		MaxDeliver:    -1,
+		DeliverPolicy: jetstream.DeliverAllPolicy,
+	}, client.cfg)
+
+	td := ptrace.NewTraces()
+	td.ResourceSpans().AppendEmpty().ScopeSpans().AppendEmpty().Spans().AppendEmpty().SetName("span")
+	data, err := (&ptrace.ProtoMarshaler{}).MarshalTraces(td)
+	require.NoError(t, err)
+	msg := &fakeMsg{data: data}
+	client.consumer.handler(msg)
+
+	assert.Equal(t, "ack", msg.acked)
+	require.Len(t, sink.AllTraces(), 1)
+	assert.Equal(t, td, sink.AllTraces()[0])
+}
+
+func TestMetricsReceiver(t *testing.T) {
+	cfg := newTestConfig()
+	cfg.Encoding = encodingJSON
+	sink := new(consumertest.MetricsSink)
+	r, err := createMetricsReceiver(context.Background(), receivertest.NewNopCreateSettings(), cfg, sink)
+	require.NoError(t, err)
+	client := startTestReceiver(t, r.(*natsReceiver))
+	assert.Equal(t, "otlp.metrics", client.cfg.FilterSubject)
+
+	md := pmetric.NewMetrics()
+	md.ResourceMetrics().AppendEmpty().ScopeMetrics().AppendEmpty().Metrics().AppendEmpty().SetName("metric")
+	data, err := (&pmetric.JSONMarshaler{}).MarshalMetrics(md)
+	require.NoError(t, err)
+	msg := &fakeMsg{data: data}
+	client.consumer.handler(msg)
+
+	assert.Equal(t, "ack", msg.acked)
+	require.Len(t, sink.AllMetrics(), 1)
+	assert.Equal(t, md, sink.AllMetrics()[0])
+}
+
+func TestLogsReceiver(t *testing.T) {
+	cfg := newTestConfig()
+	cfg.Subject = "edge.logs"
+	cfg.Durable = "gateway"
+	sink := new(consumertest.LogsSink)
+	r, err := createLogsReceiver(context.Background(), receivertest.NewNopCreateSettings(), cfg, sink)
+	require.NoError(t, err)
+	client := startTestReceiver(t, r.(*natsReceiver))
+	assert.Equal(t, "edge.logs", client.cfg.FilterSubject)
+	assert.Equal(t, "gateway", client.cfg.Durable)
+
+	ld := plog.NewLogs()
+	ld.ResourceLogs().AppendEmpty().ScopeLogs().AppendEmpty().LogRecords().AppendEmpty().Body().SetStr("log")
+	data, err := (&plog.ProtoMarshaler{}).MarshalLogs(ld)
+	require.NoError(t, err)
+	msg := &fakeMsg{data: data}
+	client.consumer.handler(msg)
+
+	assert.Equal(t, "ack", msg.acked)
+	require.Len(t, sink.AllLogs(), 1)
+	assert.Equal(t, ld, sink.AllLogs()[0])
+}
+
+func TestAcknowledgement(t *testing.T) {
+	td := ptrace.NewTraces()
+	td.ResourceSpans().AppendEmpty().ScopeSpans().AppendEmpty().Spans().AppendEmpty().SetName("span")
+	data, err := (&ptrace.ProtoMarshaler{}).MarshalTraces(td)
+	require.NoError(t, err)
+
+	tests := []struct {
+		name      string
+		ackPolicy string
+		data      []byte
+		err       error
+		want      string
+	}{
+		{name: "consumed", ackPolicy: "explicit", data: data, want: "ack"},
+		{name: "retryable error", ackPolicy: "explicit", data: data, err: errors.New("queue is full"), want: "nak"},
+		{name: "permanent error", ackPolicy: "explicit", data: data, err: consumererror.NewPermanent(errors.New("invalid")), want: "term"},
+		{name: "unmarshal error", ackPolicy: "explicit", data: []byte("not a trace"), want: "term"},
+		{name: "ack policy all", ackPolicy: "all", data: data, want: "ack"},
+		{name: "ack policy none", ackPolicy: "none", data: data, err: errors.New("queue is full"), want: ""},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cfg := newTestConfig()
+			cfg.AckPolicy = tt.ackPolicy
+			next := consumertest.NewErr(tt.err)
+			if tt.err == nil {
+				next = consumertest.NewNop()
+			}
+			r, err := createTracesReceiver(context.Background(), receivertest.NewNopCreateSettings(), cfg, next)
+			require.NoError(t, err)
+			client := startTestReceiver(t, r.(*natsReceiver))
+
+			msg := &fakeMsg{data: tt.data}
+			client.consumer.handler(msg)
+			assert.Equal(t, tt.want, msg.acked)
+		})
+	}
+}
+
+func TestStartErrors(t *testing.T) {
+	cfg := newTestConfig()
+	cfg.TLS.Insecure = false
+	cfg.TLS.CAFile = "/nonexistent"
+	r, err := createLogsReceiver(context.Background(), receivertest.NewNopCreateSettings(), cfg, consumertest.NewNop())
+	require.NoError(t, err)
+	assert.ErrorContains(t, r.Start(context.Background(), componenttest.NewNopHost()), "failed to load TLS config")
+	assert.NoError(t, r.Shutdown(context.Background()))
+
+	r, err = createLogsReceiver(context.Background(), receivertest.NewNopCreateSettings(), newTestConfig(), consumertest.NewNop())
+	require.NoError(t, err)
+	client := &fakeClient{err: errors.New("nats: stream not found")}
+	r.(*natsReceiver).connect = func(context.Context) (consumerClient, error) {
+		return client, nil
+	}
+	assert.EqualError(t, r.Start(context.Background(), componenttest.NewNopHost()), `failed to create the consumer "otelcol_logs" of the stream "TELEMETRY": nats: stream not found`)
+	assert.NoError(t, r.Shutdown(context.Background()))
+	assert.True(t, client.closed)
+}
@@ -13,5 +13,8 @@ import (
 type Config struct {
 	scraperhelper.ControllerConfig `mapstructure:",squash"`
 	confighttp.ClientConfig        `mapstructure:",squash"`
-	MetricsBuilderConfig           metadata.MetricsBuilderConfig `mapstructure:",squash"`
+	// PlusAPIEndpoint is the URL of the NGINX Plus API, including its version (e.g. http://localhost:8080/api/9).
+	// When set, the state of the upstream peers is collected from it.
+	PlusAPIEndpoint      string                        `mapstructure:"plus_api_endpoint"`
+	MetricsBuilderConfig metadata.MetricsBuilderConfig `mapstructure:",squash"`
 }
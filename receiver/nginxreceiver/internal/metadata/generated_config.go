@@ -31,6 +31,8 @@ type MetricsConfig struct {
 	NginxConnectionsCurrent  MetricConfig `mapstructure:"nginx.connections_current"`
 	NginxConnectionsHandled  MetricConfig `mapstructure:"nginx.connections_handled"`
 	NginxRequests            MetricConfig `mapstructure:"nginx.requests"`
+	NginxUpstreamPeerUp      MetricConfig `mapstructure:"nginx.upstream.peer.up"`
+	NginxUpstreamPeerWeight  MetricConfig `mapstructure:"nginx.upstream.peer.weight"`
 }
 
 func DefaultMetricsConfig() MetricsConfig {
@@ -47,6 +49,12 @@ func DefaultMetricsConfig() MetricsConfig {
 		NginxRequests: MetricConfig{
 			Enabled: true,
 		},
+		NginxUpstreamPeerUp: MetricConfig{
+			Enabled: false,
+		},
+		NginxUpstreamPeerWeight: MetricConfig{
+			Enabled: false,
+		},
 	}
 }
 
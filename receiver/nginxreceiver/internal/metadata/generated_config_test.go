@@ -29,6 +29,8 @@ func TestMetricsBuilderConfig(t *testing.T) {
 					NginxConnectionsCurrent:  MetricConfig{Enabled: true},
 					NginxConnectionsHandled:  MetricConfig{Enabled: true},
 					NginxRequests:            MetricConfig{Enabled: true},
+					NginxUpstreamPeerUp:      MetricConfig{Enabled: true},
+					NginxUpstreamPeerWeight:  MetricConfig{Enabled: true},
 				},
 			},
 		},
@@ -40,6 +42,8 @@ func TestMetricsBuilderConfig(t *testing.T) {
 					NginxConnectionsCurrent:  MetricConfig{Enabled: false},
 					NginxConnectionsHandled:  MetricConfig{Enabled: false},
 					NginxRequests:            MetricConfig{Enabled: false},
+					NginxUpstreamPeerUp:      MetricConfig{Enabled: false},
+					NginxUpstreamPeerWeight:  MetricConfig{Enabled: false},
 				},
 			},
 		},
@@ -75,6 +75,12 @@ func TestMetricsBuilder(t *testing.T) {
 			allMetricsCount++
 			mb.RecordNginxRequestsDataPoint(ts, 1)
 
+			allMetricsCount++
+			mb.RecordNginxUpstreamPeerUpDataPoint(ts, 1, "upstream.block.name-val", "upstream.peer.address-val")
+
+			allMetricsCount++
+			mb.RecordNginxUpstreamPeerWeightDataPoint(ts, 1, "upstream.block.name-val", "upstream.peer.address-val")
+
 			res := pcommon.NewResource()
 			metrics := mb.Emit(WithResource(res))
 
@@ -156,6 +162,42 @@ func TestMetricsBuilder(t *testing.T) {
 					assert.Equal(t, ts, dp.Timestamp())
 					assert.Equal(t, pmetric.NumberDataPointValueTypeInt, dp.ValueType())
 					assert.Equal(t, int64(1), dp.IntValue())
+				case "nginx.upstream.peer.up":
+					assert.False(t, validatedMetrics["nginx.upstream.peer.up"], "Found a duplicate in the metrics slice: nginx.upstream.peer.up")
+					validatedMetrics["nginx.upstream.peer.up"] = true
+					assert.Equal(t, pmetric.MetricTypeGauge, ms.At(i).Type())
+					assert.Equal(t, 1, ms.At(i).Gauge().DataPoints().Len())
+					assert.Equal(t, "Whether the upstream peer is up (1) or not (0). Requires the NGINX Plus API.", ms.At(i).Description())
+					assert.Equal(t, "1", ms.At(i).Unit())
+					dp := ms.At(i).Gauge().DataPoints().At(0)
+					assert.Equal(t, start, dp.StartTimestamp())
+					assert.Equal(t, ts, dp.Timestamp())
+					assert.Equal(t, pmetric.NumberDataPointValueTypeInt, dp.ValueType())
+					assert.Equal(t, int64(1), dp.IntValue())
+					attrVal, ok := dp.Attributes().Get("upstream.block.name")
+					assert.True(t, ok)
+					assert.EqualValues(t, "upstream.block.name-val", attrVal.Str())
+					attrVal, ok = dp.Attributes().Get("upstream.peer.address")
+					assert.True(t, ok)
+					assert.EqualValues(t, "upstream.peer.address-val", attrVal.Str())
+				case "nginx.upstream.peer.weight":
+					assert.False(t, validatedMetrics["nginx.upstream.peer.weight"], "Found a duplicate in the metrics slice: nginx.upstream.peer.weight")
+					validatedMetrics["nginx.upstream.peer.weight"] = true
+					assert.Equal(t, pmetric.MetricTypeGauge, ms.At(i).Type())
+					assert.Equal(t, 1, ms.At(i).Gauge().DataPoints().Len())
+					assert.Equal(t, "The weight of the upstream peer. Requires the NGINX Plus API.", ms.At(i).Description())
+					assert.Equal(t, "{weight}", ms.At(i).Unit())
+					dp := ms.At(i).Gauge().DataPoints().At(0)
+					assert.Equal(t, start, dp.StartTimestamp())
+					assert.Equal(t, ts, dp.Timestamp())
+					assert.Equal(t, pmetric.NumberDataPointValueTypeInt, dp.ValueType())
+					assert.Equal(t, int64(1), dp.IntValue())
+					attrVal, ok := dp.Attributes().Get("upstream.block.name")
+					assert.True(t, ok)
+					assert.EqualValues(t, "upstream.block.name-val", attrVal.Str())
+					attrVal, ok = dp.Attributes().Get("upstream.peer.address")
+					assert.True(t, ok)
+					assert.EqualValues(t, "upstream.peer.address-val", attrVal.Str())
 				}
 			}
 		})
@@ -0,0 +1,54 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package nginxreceiver // import "github.com/open-telemetry/opentelemetry-collector-contrib/receiver/nginxreceiver"
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+)
+
+// errPlusAPINotFound is returned when the NGINX Plus API isn't available, which is the case
+// for the open source version of NGINX.
+var errPlusAPINotFound = errors.New("the NGINX Plus API is not available")
+
+// upstream is an upstream server group, as returned by the /http/upstreams endpoint of the NGINX Plus API.
+type upstream struct {
+	Peers []upstreamPeer `json:"peers"`
+}
+
+type upstreamPeer struct {
+	Server string `json:"server"`
+	State  string `json:"state"`
+	Weight int64  `json:"weight"`
+}
+
+func getUpstreams(ctx context.Context, client *http.Client, endpoint string) (map[string]upstream, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, strings.TrimSuffix(endpoint, "/")+"/http/upstreams", nil)
+	if err != nil {
+		return nil, err
+	}
+	resp, err := client.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	switch resp.StatusCode {
+	case http.StatusOK:
+	case http.StatusNotFound:
+		return nil, errPlusAPINotFound
+	default:
+		return nil, fmt.Errorf("expected 200 response from the NGINX Plus API, got %d", resp.StatusCode)
+	}
+
+	var upstreams map[string]upstream
+	if err = json.NewDecoder(resp.Body).Decode(&upstreams); err != nil {
+		return nil, fmt.Errorf("failed to decode the upstreams: %w", err)
+	}
+	return upstreams, nil
+}
@@ -5,6 +5,7 @@ package nginxreceiver // import "github.com/open-telemetry/opentelemetry-collect
 
 import (
 	"context"
+	"errors"
 	"net/http"
 	"time"
 
@@ -13,6 +14,7 @@ import (
 	"go.opentelemetry.io/collector/pdata/pcommon"
 	"go.opentelemetry.io/collector/pdata/pmetric"
 	"go.opentelemetry.io/collector/receiver"
+	"go.opentelemetry.io/collector/receiver/scrapererror"
 	"go.uber.org/zap"
 
 	"github.com/open-telemetry/opentelemetry-collector-contrib/receiver/nginxreceiver/internal/metadata"
@@ -25,6 +27,10 @@ type nginxScraper struct {
 	settings component.TelemetrySettings
 	cfg      *Config
 	mb       *metadata.MetricsBuilder
+
+	// plusAPIUnavailable is set once the NGINX Plus API is found to be unavailable,
+	// after which only the stub_status metrics are collected.
+	plusAPIUnavailable bool
 }
 
 func newNginxScraper(
@@ -49,7 +55,7 @@ func (r *nginxScraper) start(ctx context.Context, host component.Host) error {
 	return nil
 }
 
-func (r *nginxScraper) scrape(context.Context) (pmetric.Metrics, error) {
+func (r *nginxScraper) scrape(ctx context.Context) (pmetric.Metrics, error) {
 	// Init client in scrape method in case there are transient errors in the constructor.
 	if r.client == nil {
 		var err error
@@ -74,5 +80,44 @@ func (r *nginxScraper) scrape(context.Context) (pmetric.Metrics, error) {
 	r.mb.RecordNginxConnectionsCurrentDataPoint(now, stats.Connections.Reading, metadata.AttributeStateReading)
 	r.mb.RecordNginxConnectionsCurrentDataPoint(now, stats.Connections.Writing, metadata.AttributeStateWriting)
 	r.mb.RecordNginxConnectionsCurrentDataPoint(now, stats.Connections.Waiting, metadata.AttributeStateWaiting)
+
+	if err = r.scrapeUpstreams(ctx, now); err != nil {
+		return r.mb.Emit(), scrapererror.NewPartialScrapeError(err, 0)
+	}
 	return r.mb.Emit(), nil
 }
+
+// scrapeUpstreams records the state of the upstream peers using the NGINX Plus API, when configured.
+func (r *nginxScraper) scrapeUpstreams(ctx context.Context, now pcommon.Timestamp) error {
+	if r.cfg.PlusAPIEndpoint == "" || r.plusAPIUnavailable {
+		return nil
+	}
+	metrics := r.cfg.MetricsBuilderConfig.Metrics
+	if !metrics.NginxUpstreamPeerUp.Enabled && !metrics.NginxUpstreamPeerWeight.Enabled {
+		return nil
+	}
+
+	upstreams, err := getUpstreams(ctx, r.httpClient, r.cfg.PlusAPIEndpoint)
+	if errors.Is(err, errPlusAPINotFound) {
+		r.settings.Logger.Warn("The NGINX Plus API is not available, only collecting the stub_status metrics",
+			zap.String("plus_api_endpoint", r.cfg.PlusAPIEndpoint))
+		r.plusAPIUnavailable = true
+		return nil
+	}
+	if err != nil {
+		r.settings.Logger.Error("Failed to fetch nginx upstreams", zap.Error(err))
+		return err
+	}
+
+	for name, u := range upstreams {
+		for _, peer := range u.Peers {
+			up := int64(0)
+			if peer.State == "up" {
+				up = 1
+			}
+			r.mb.RecordNginxUpstreamPeerUpDataPoint(now, up, name, peer.Server)
+			r.mb.RecordNginxUpstreamPeerWeightDataPoint(now, peer.Weight, name, peer.Server)
+		}
+	}
+	return nil
+}
@@ -49,6 +49,61 @@ func TestScraper(t *testing.T) {
 		pmetrictest.IgnoreMetricsOrder()))
 }
 
+func TestScraperPlusAPI(t *testing.T) {
+	nginxMock := newMockServer(t)
+	defer nginxMock.Close()
+
+	cfg := createDefaultConfig().(*Config)
+	cfg.Endpoint = nginxMock.URL + "/status"
+	cfg.PlusAPIEndpoint = nginxMock.URL + "/api/9"
+	cfg.MetricsBuilderConfig.Metrics.NginxUpstreamPeerUp.Enabled = true
+	cfg.MetricsBuilderConfig.Metrics.NginxUpstreamPeerWeight.Enabled = true
+
+	scraper := newNginxScraper(receivertest.NewNopCreateSettings(), cfg)
+	require.NoError(t, scraper.start(context.Background(), componenttest.NewNopHost()))
+
+	actualMetrics, err := scraper.scrape(context.Background())
+	require.NoError(t, err)
+
+	expectedFile := filepath.Join("testdata", "scraper", "expected_plus.yaml")
+	expectedMetrics, err := golden.ReadMetrics(expectedFile)
+	require.NoError(t, err)
+
+	require.NoError(t, pmetrictest.CompareMetrics(expectedMetrics, actualMetrics,
+		pmetrictest.IgnoreStartTimestamp(),
+		pmetrictest.IgnoreMetricDataPointsOrder(),
+		pmetrictest.IgnoreTimestamp(),
+		pmetrictest.IgnoreMetricsOrder()))
+}
+
+func TestScraperPlusAPIFallback(t *testing.T) {
+	nginxMock := newMockServer(t)
+	defer nginxMock.Close()
+
+	cfg := createDefaultConfig().(*Config)
+	cfg.Endpoint = nginxMock.URL + "/status"
+	cfg.PlusAPIEndpoint = nginxMock.URL + "/missing"
+	cfg.MetricsBuilderConfig.Metrics.NginxUpstreamPeerUp.Enabled = true
+
+	scraper := newNginxScraper(receivertest.NewNopCreateSettings(), cfg)
+	require.NoError(t, scraper.start(context.Background(), componenttest.NewNopHost()))
+
+	// the stub_status metrics are still collected
+	actualMetrics, err := scraper.scrape(context.Background())
+	require.NoError(t, err)
+	require.True(t, scraper.plusAPIUnavailable)
+
+	expectedFile := filepath.Join("testdata", "scraper", "expected.yaml")
+	expectedMetrics, err := golden.ReadMetrics(expectedFile)
+	require.NoError(t, err)
+
+	require.NoError(t, pmetrictest.CompareMetrics(expectedMetrics, actualMetrics,
+		pmetrictest.IgnoreStartTimestamp(),
+		pmetrictest.IgnoreMetricDataPointsOrder(),
+		pmetrictest.IgnoreTimestamp(),
+		pmetrictest.IgnoreMetricsOrder()))
+}
+
 func TestScraperError(t *testing.T) {
 	nginxMock := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {
 		if req.URL.Path == "/status" {
@@ -111,6 +166,22 @@ Reading: 6 Writing: 179 Waiting: 106
 			require.NoError(t, err)
 			return
 		}
+		if req.URL.Path == "/api/9/http/upstreams" {
+			rw.WriteHeader(200)
+			_, err := rw.Write([]byte(`{
+  "backend": {
+    "peers": [
+      {"id": 0, "server": "10.0.0.1:8080", "backup": false, "weight": 5, "state": "up", "active": 2},
+      {"id": 1, "server": "10.0.0.2:8080", "backup": false, "weight": 1, "state": "unhealthy", "active": 0}
+    ],
+    "keepalive": 0,
+    "zombies": 0,
+    "zone": "backend"
+  }
+}`))
+			require.NoError(t, err)
+			return
+		}
 		rw.WriteHeader(404)
 	}))
 }
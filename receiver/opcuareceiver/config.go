@@ -0,0 +1,160 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package opcuareceiver // import "github.com/open-telemetry/opentelemetry-collector-contrib/receiver/opcuareceiver"
+
+import (
+	"errors"
+	"fmt"
+	"net/url"
+	"time"
+
+	"github.com/gopcua/opcua/ua"
+	"go.opentelemetry.io/collector/config/configopaque"
+	"go.uber.org/multierr"
+)
+
+const (
+	securityModeNone           = "None"
+	securityModeSign           = "Sign"
+	securityModeSignAndEncrypt = "SignAndEncrypt"
+
+	metricTypeGauge = "gauge"
+	metricTypeSum   = "sum"
+)
+
+// Config defines the configuration of the OPC UA receiver.
+type Config struct {
+	// Endpoint is the URL of the OPC UA server, e.g. opc.tcp://localhost:4840.
+	Endpoint string `mapstructure:"endpoint"`
+	// SecurityPolicy is the security policy of the secure channel, e.g. None or Basic256Sha256.
+	SecurityPolicy string `mapstructure:"security_policy"`
+	// SecurityMode is the security mode of the secure channel: None, Sign or SignAndEncrypt.
+	SecurityMode string `mapstructure:"security_mode"`
+	// CertFile and KeyFile are the paths of the certificate and the private key of the client, required by the
+	// secure channels signing or encrypting the messages.
+	CertFile string `mapstructure:"cert_file"`
+	KeyFile  string `mapstructure:"key_file"`
+	// Username and Password authenticate the session, which is anonymous when the username isn't set.
+	Username string              `mapstructure:"username"`
+	Password configopaque.String `mapstructure:"password"`
+	// Timeout is the timeout of the connection to the server, and of the creation of the subscription.
+	Timeout time.Duration `mapstructure:"timeout"`
+	// PublishingInterval is the interval the server publishes the value changes of the nodes at.
+	PublishingInterval time.Duration `mapstructure:"publishing_interval"`
+	// SamplingInterval is the interval the server samples the values of the nodes at, the fastest supported by the
+	// server when not set.
+	SamplingInterval time.Duration `mapstructure:"sampling_interval"`
+	// Nodes are the nodes whose values are converted to metrics.
+	Nodes []NodeConfig `mapstructure:"nodes"`
+}
+
+// NodeConfig defines a metric converted from the value of a node.
+type NodeConfig struct {
+	// NodeID is the identifier of the node, e.g. ns=2;s=Pump1.Temperature.
+	NodeID string `mapstructure:"node_id"`
+	// Name is the name of the metric. Several nodes can be converted to the same metric with different attributes.
+	Name string `mapstructure:"name"`
+	// Description is the description of the metric.
+	Description string `mapstructure:"description"`
+	// Unit is the unit of the metric, after scaling.
+	Unit string `mapstructure:"unit"`
+	// Type is the type of the metric, gauge or sum.
+	Type string `mapstructure:"type"`
+	// Monotonic tells whether the sum only increases.
+	Monotonic bool `mapstructure:"monotonic"`
+	// Scale is the factor the value is multiplied by.
+	Scale *float64 `mapstructure:"scale"`
+	// Offset is added to the value, after scaling.
+	Offset float64 `mapstructure:"offset"`
+	// Attributes are the attributes of the data points.
+	Attributes map[string]string `mapstructure:"attributes"`
+}
+
+// Validate checks the configuration of the receiver.
+func (cfg *Config) Validate() error {
+	var errs error
+	if u, err := url.Parse(cfg.Endpoint); err != nil || u.Scheme != "opc.tcp" || u.Host == "" {
+		errs = multierr.Append(errs, fmt.Errorf("invalid endpoint %q, must be opc.tcp://host:port", cfg.Endpoint))
+	}
+	if _, ok := ua.SecurityPolicyURIs[cfg.SecurityPolicy]; !ok {
+		errs = multierr.Append(errs, fmt.Errorf("unsupported security_policy %q", cfg.SecurityPolicy))
+	}
+	switch cfg.SecurityMode {
+	case securityModeNone:
+	case securityModeSign, securityModeSignAndEncrypt:
+		if cfg.CertFile == "" || cfg.KeyFile == "" {
+			errs = multierr.Append(errs, fmt.Errorf("cert_file and key_file must be specified with the %s security_mode", cfg.SecurityMode))
+		}
+	default:
+		errs = multierr.Append(errs, fmt.Errorf("unsupported security_mode %q", cfg.SecurityMode))
+	}
+	if (cfg.SecurityPolicy == "None") != (cfg.SecurityMode == securityModeNone) {
+		errs = multierr.Append(errs, errors.New("security_policy and security_mode must both be None or both be secure"))
+	}
+	if cfg.Password != "" && cfg.Username == "" {
+		errs = multierr.Append(errs, errors.New("username must be specified with the password"))
+	}
+	if cfg.Timeout <= 0 {
+		errs = multierr.Append(errs, errors.New("timeout must be positive"))
+	}
+	if cfg.PublishingInterval <= 0 {
+		errs = multierr.Append(errs, errors.New("publishing_interval must be positive"))
+	}
+	if cfg.SamplingInterval < 0 {
+		errs = multierr.Append(errs, errors.New("sampling_interval must not be negative"))
+	}
+	if len(cfg.Nodes) == 0 {
+		errs = multierr.Append(errs, errors.New("at least one node must be specified"))
+	}
+	nodeIDs := map[string]bool{}
+	metrics := map[string]int{}
+	for i := range cfg.Nodes {
+		n := &cfg.Nodes[i]
+		if err := n.validate(); err != nil {
+			errs = multierr.Append(errs, fmt.Errorf("nodes[%d]: %w", i, err))
+			continue
+		}
+		if nodeIDs[n.NodeID] {
+			errs = multierr.Append(errs, fmt.Errorf("nodes[%d]: duplicate node %q", i, n.NodeID))
+		}
+		nodeIDs[n.NodeID] = true
+		if j, ok := metrics[n.Name]; ok && !n.sameMetric(&cfg.Nodes[j]) {
+			errs = multierr.Append(errs, fmt.Errorf("nodes[%d]: the metric %q differs from the one of nodes[%d]", i, n.Name, j))
+		} else if !ok {
+			metrics[n.Name] = i
+		}
+	}
+	return errs
+}
+
+func (n *NodeConfig) validate() error {
+	if n.NodeID == "" {
+		return errors.New("node_id must be specified")
+	}
+	if _, err := ua.ParseNodeID(n.NodeID); err != nil {
+		return fmt.Errorf("invalid node_id %q: %w", n.NodeID, err)
+	}
+	if n.Name == "" {
+		return errors.New("name must be specified")
+	}
+	switch n.Type {
+	case "", metricTypeGauge, metricTypeSum:
+	default:
+		return fmt.Errorf("unsupported type %q", n.Type)
+	}
+	return nil
+}
+
+// sameMetric tells whether the nodes define the same metric, only their data points differing.
+func (n *NodeConfig) sameMetric(other *NodeConfig) bool {
+	return n.metricType() == other.metricType() && n.Monotonic == other.Monotonic &&
+		n.Unit == other.Unit && n.Description == other.Description
+}
+
+func (n *NodeConfig) metricType() string {
+	if n.Type == "" {
+		return metricTypeGauge
+	}
+	return n.Type
+}
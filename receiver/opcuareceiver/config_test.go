@@ -0,0 +1,147 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package opcuareceiver
+
+import (
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/collector/component"
+	"go.opentelemetry.io/collector/confmap/confmaptest"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/receiver/opcuareceiver/internal/metadata"
+)
+
+func TestLoadConfig(t *testing.T) {
+	t.Parallel()
+
+	cm, err := confmaptest.LoadConf(filepath.Join("testdata", "config.yaml"))
+	require.NoError(t, err)
+
+	defaultCfg := createDefaultConfig().(*Config)
+	defaultCfg.Nodes = []NodeConfig{{NodeID: "ns=2;s=Temperature", Name: "temperature"}}
+
+	allSettings := createDefaultConfig().(*Config)
+	allSettings.Endpoint = "opc.tcp://plc.local:4841"
+	allSettings.SecurityPolicy = "Basic256Sha256"
+	allSettings.SecurityMode = securityModeSignAndEncrypt
+	allSettings.CertFile = "/etc/otelcol/opcua/cert.pem"
+	allSettings.KeyFile = "/etc/otelcol/opcua/key.pem"
+	allSettings.Username = "collector"
+	allSettings.Password = "secret"
+	allSettings.Timeout = 5 * time.Second
+	allSettings.PublishingInterval = 500 * time.Millisecond
+	allSettings.SamplingInterval = 100 * time.Millisecond
+	scale := 0.1
+	allSettings.Nodes = []NodeConfig{
+		{
+			NodeID:      "ns=2;s=Pump1.Temperature",
+			Name:        "pump.temperature",
+			Description: "Temperature of the pump.",
+			Unit:        "Cel",
+			Scale:       &scale,
+			Attributes:  map[string]string{"pump": "p1"},
+		},
+		{
+			NodeID:      "ns=2;s=Pump2.Temperature",
+			Name:        "pump.temperature",
+			Description: "Temperature of the pump.",
+			Unit:        "Cel",
+			Scale:       &scale,
+			Attributes:  map[string]string{"pump": "p2"},
+		},
+		{
+			NodeID:    "ns=2;i=1001",
+			Name:      "pump.energy",
+			Unit:      "kWh",
+			Type:      metricTypeSum,
+			Monotonic: true,
+			Offset:    10,
+		},
+	}
+
+	tests := []struct {
+		id           component.ID
+		expected     component.Config
+		errorMessage string
+	}{
+		{
+			id:       component.NewIDWithName(metadata.Type, ""),
+			expected: defaultCfg,
+		},
+		{
+			id:       component.NewIDWithName(metadata.Type, "all_settings"),
+			expected: allSettings,
+		},
+		{
+			id:           component.NewIDWithName(metadata.Type, "bad_endpoint"),
+			errorMessage: `invalid endpoint "plc.local:4840", must be opc.tcp://host:port`,
+		},
+		{
+			id:           component.NewIDWithName(metadata.Type, "bad_security_policy"),
+			errorMessage: `unsupported security_policy "Basic512"; security_policy and security_mode must both be None or both be secure`,
+		},
+		{
+			id:           component.NewIDWithName(metadata.Type, "no_certificate"),
+			errorMessage: "cert_file and key_file must be specified with the Sign security_mode",
+		},
+		{
+			id:           component.NewIDWithName(metadata.Type, "mismatched_security"),
+			errorMessage: "security_policy and security_mode must both be None or both be secure",
+		},
+		{
+			id:           component.NewIDWithName(metadata.Type, "no_username"),
+			errorMessage: "username must be specified with the password",
+		},
+		{
+			id:           component.NewIDWithName(metadata.Type, "no_node"),
+			errorMessage: "at least one node must be specified",
+		},
+		{
+			id:           component.NewIDWithName(metadata.Type, "bad_node_id"),
+			errorMessage: `nodes[0]: invalid node_id "ns=2;i=abc": opcua: invalid numeric id: ns=2;i=abc`,
+		},
+		{
+			id:           component.NewIDWithName(metadata.Type, "no_node_id"),
+			errorMessage: "nodes[0]: node_id must be specified",
+		},
+		{
+			id:           component.NewIDWithName(metadata.Type, "no_name"),
+			errorMessage: "nodes[0]: name must be specified",
+		},
+		{
+			id:           component.NewIDWithName(metadata.Type, "bad_type"),
+			errorMessage: `nodes[0]: unsupported type "histogram"`,
+		},
+		{
+			id:           component.NewIDWithName(metadata.Type, "duplicate_node"),
+			errorMessage: `nodes[1]: duplicate node "ns=2;s=Temperature"`,
+		},
+		{
+			id:           component.NewIDWithName(metadata.Type, "different_metrics"),
+			errorMessage: `nodes[1]: the metric "temperature" differs from the one of nodes[0]`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.id.String(), func(t *testing.T) {
+			factory := NewFactory()
+			cfg := factory.CreateDefaultConfig()
+
+			sub, err := cm.Sub(tt.id.String())
+			require.NoError(t, err)
+			require.NoError(t, sub.Unmarshal(cfg))
+
+			if tt.errorMessage != "" {
+				assert.EqualError(t, component.ValidateConfig(cfg), tt.errorMessage)
+				return
+			}
+			assert.NoError(t, component.ValidateConfig(cfg))
+			assert.Equal(t, tt.expected, cfg)
+		})
+	}
+}
@@ -0,0 +1,7 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+//go:generate mdatagen metadata.yaml
+
+// Package opcuareceiver subscribes to the values of the nodes of OPC UA servers and converts them to metrics.
+package opcuareceiver // import "github.com/open-telemetry/opentelemetry-collector-contrib/receiver/opcuareceiver"
@@ -0,0 +1,49 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package opcuareceiver // import "github.com/open-telemetry/opentelemetry-collector-contrib/receiver/opcuareceiver"
+
+import (
+	"context"
+	"time"
+
+	"go.opentelemetry.io/collector/component"
+	"go.opentelemetry.io/collector/consumer"
+	"go.opentelemetry.io/collector/receiver"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/receiver/opcuareceiver/internal/metadata"
+)
+
+const (
+	defaultEndpoint           = "opc.tcp://localhost:4840"
+	defaultTimeout            = 10 * time.Second
+	defaultPublishingInterval = time.Second
+)
+
+// NewFactory creates a factory for the OPC UA receiver.
+func NewFactory() receiver.Factory {
+	return receiver.NewFactory(
+		metadata.Type,
+		createDefaultConfig,
+		receiver.WithMetrics(createMetricsReceiver, metadata.MetricsStability),
+	)
+}
+
+func createDefaultConfig() component.Config {
+	return &Config{
+		Endpoint:           defaultEndpoint,
+		SecurityPolicy:     "None",
+		SecurityMode:       securityModeNone,
+		Timeout:            defaultTimeout,
+		PublishingInterval: defaultPublishingInterval,
+	}
+}
+
+func createMetricsReceiver(
+	_ context.Context,
+	set receiver.CreateSettings,
+	cfg component.Config,
+	nextConsumer consumer.Metrics,
+) (receiver.Metrics, error) {
+	return newReceiver(cfg.(*Config), set, nextConsumer)
+}
@@ -0,0 +1,173 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package opcuareceiver // import "github.com/open-telemetry/opentelemetry-collector-contrib/receiver/opcuareceiver"
+
+import (
+	"math"
+	"net/url"
+	"strconv"
+	"time"
+
+	"github.com/gopcua/opcua/ua"
+	"go.opentelemetry.io/collector/pdata/pcommon"
+	"go.opentelemetry.io/collector/pdata/pmetric"
+	"go.uber.org/zap"
+)
+
+// metricsBuilder converts the value changes of the nodes to metrics.
+type metricsBuilder struct {
+	cfg       *Config
+	version   string
+	logger    *zap.Logger
+	startTime pcommon.Timestamp
+	now       func() time.Time
+}
+
+// build converts the value changes of a notification of the subscription, the client handles of the items being the
+// indexes of the nodes.
+func (b *metricsBuilder) build(notification *ua.DataChangeNotification) pmetric.Metrics {
+	md := pmetric.NewMetrics()
+	rm := md.ResourceMetrics().AppendEmpty()
+	b.setResourceAttributes(rm.Resource())
+	sm := rm.ScopeMetrics().AppendEmpty()
+	sm.Scope().SetName(scopeName)
+	sm.Scope().SetVersion(b.version)
+
+	// the nodes of a metric share it, with different attributes
+	metrics := map[string]pmetric.Metric{}
+	for _, item := range notification.MonitoredItems {
+		if int(item.ClientHandle) >= len(b.cfg.Nodes) || item.Value == nil {
+			continue
+		}
+		n := &b.cfg.Nodes[item.ClientHandle]
+		if item.Value.Status != ua.StatusOK {
+			b.logger.Debug("Skipping the bad value of the node", zap.String("node_id", n.NodeID), zap.Error(item.Value.Status))
+			continue
+		}
+		value, ok := n.convert(item.Value.Value)
+		if !ok {
+			b.logger.Debug("Skipping the non-numeric value of the node", zap.String("node_id", n.NodeID))
+			continue
+		}
+		metric, ok := metrics[n.Name]
+		if !ok {
+			metric = b.newMetric(sm.Metrics(), n)
+			metrics[n.Name] = metric
+		}
+		b.appendDataPoint(metric, n, item.Value, value)
+	}
+	return md
+}
+
+func (b *metricsBuilder) setResourceAttributes(resource pcommon.Resource) {
+	u, err := url.Parse(b.cfg.Endpoint)
+	if err != nil {
+		return
+	}
+	attrs := resource.Attributes()
+	attrs.PutStr("server.address", u.Hostname())
+	if p, err := strconv.ParseInt(u.Port(), 10, 64); err == nil {
+		attrs.PutInt("server.port", p)
+	}
+}
+
+func (b *metricsBuilder) newMetric(metrics pmetric.MetricSlice, n *NodeConfig) pmetric.Metric {
+	metric := metrics.AppendEmpty()
+	metric.SetName(n.Name)
+	metric.SetDescription(n.Description)
+	metric.SetUnit(n.Unit)
+	if n.metricType() == metricTypeSum {
+		sum := metric.SetEmptySum()
+		sum.SetIsMonotonic(n.Monotonic)
+		sum.SetAggregationTemporality(pmetric.AggregationTemporalityCumulative)
+	} else {
+		metric.SetEmptyGauge()
+	}
+	return metric
+}
+
+func (b *metricsBuilder) appendDataPoint(metric pmetric.Metric, n *NodeConfig, dv *ua.DataValue, value any) {
+	var dp pmetric.NumberDataPoint
+	if metric.Type() == pmetric.MetricTypeSum {
+		dp = metric.Sum().DataPoints().AppendEmpty()
+		dp.SetStartTimestamp(b.startTime)
+	} else {
+		dp = metric.Gauge().DataPoints().AppendEmpty()
+	}
+	// the source timestamp is the time the value changed on the device
+	switch {
+	case !dv.SourceTimestamp.IsZero():
+		dp.SetTimestamp(pcommon.NewTimestampFromTime(dv.SourceTimestamp))
+	case !dv.ServerTimestamp.IsZero():
+		dp.SetTimestamp(pcommon.NewTimestampFromTime(dv.ServerTimestamp))
+	default:
+		dp.SetTimestamp(pcommon.NewTimestampFromTime(b.now()))
+	}
+	switch v := value.(type) {
+	case int64:
+		dp.SetIntValue(v)
+	case float64:
+		dp.SetDoubleValue(v)
+	}
+	for k, v := range n.Attributes {
+		dp.Attributes().PutStr(k, v)
+	}
+}
+
+// convert converts the value of the node to an int64 or a float64, it returns false when the value isn't numeric.
+func (n *NodeConfig) convert(variant *ua.Variant) (any, bool) {
+	if variant == nil {
+		return nil, false
+	}
+	var value any
+	switch v := variant.Value().(type) {
+	case bool:
+		value = int64(0)
+		if v {
+			value = int64(1)
+		}
+	case int8:
+		value = int64(v)
+	case int16:
+		value = int64(v)
+	case int32:
+		value = int64(v)
+	case int64:
+		value = v
+	case uint8:
+		value = int64(v)
+	case uint16:
+		value = int64(v)
+	case uint32:
+		value = int64(v)
+	case uint64:
+		if v > math.MaxInt64 {
+			value = float64(v)
+		} else {
+			value = int64(v)
+		}
+	case float32:
+		value = float64(v)
+	case float64:
+		value = v
+	default:
+		return nil, false
+	}
+	return n.scale(value), true
+}
+
+// scale applies the scale and the offset of the node, the integers stay integers without them.
+func (n *NodeConfig) scale(value any) any {
+	if n.Scale == nil && n.Offset == 0 {
+		return value
+	}
+	f, ok := value.(float64)
+	if !ok {
+		f = float64(value.(int64))
+	}
+	if n.Scale != nil {
+		f *= *n.Scale
+	}
+	return f + n.Offset
+}
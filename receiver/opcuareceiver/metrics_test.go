@@ -0,0 +1,134 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package opcuareceiver
+
+import (
+	"math"
+	"testing"
+	"time"
+
+	"github.com/gopcua/opcua/ua"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/collector/pdata/pcommon"
+	"go.opentelemetry.io/collector/pdata/pmetric"
+	"go.uber.org/zap"
+)
+
+func item(handle uint32, value any, source time.Time) *ua.MonitoredItemNotification {
+	return &ua.MonitoredItemNotification{
+		ClientHandle: handle,
+		Value: &ua.DataValue{
+			EncodingMask:    ua.DataValueValue | ua.DataValueSourceTimestamp,
+			Value:           ua.MustVariant(value),
+			Status:          ua.StatusOK,
+			SourceTimestamp: source,
+		},
+	}
+}
+
+func TestBuild(t *testing.T) {
+	scale := 0.1
+	cfg := createDefaultConfig().(*Config)
+	cfg.Endpoint = "opc.tcp://plc.local:4841"
+	cfg.Nodes = []NodeConfig{
+		{NodeID: "ns=2;s=Pump1.Temperature", Name: "pump.temperature", Unit: "Cel", Scale: &scale, Attributes: map[string]string{"pump": "p1"}},
+		{NodeID: "ns=2;s=Pump2.Temperature", Name: "pump.temperature", Unit: "Cel", Scale: &scale, Attributes: map[string]string{"pump": "p2"}},
+		{NodeID: "ns=2;i=1001", Name: "pump.energy", Type: metricTypeSum, Monotonic: true},
+		{NodeID: "ns=2;s=Pump1.Name", Name: "pump.name"},
+	}
+	start := time.Unix(1700000000, 0)
+	source := start.Add(time.Minute)
+	now := start.Add(time.Hour)
+	b := &metricsBuilder{
+		cfg:       cfg,
+		version:   "1.0.0",
+		logger:    zap.NewNop(),
+		startTime: pcommon.NewTimestampFromTime(start),
+		now:       func() time.Time { return now },
+	}
+
+	bad := item(1, int16(0), source)
+	bad.Value.Status = ua.StatusBadNodeIDUnknown
+	noTimestamp := item(2, uint32(42), time.Time{})
+	md := b.build(&ua.DataChangeNotification{MonitoredItems: []*ua.MonitoredItemNotification{
+		item(0, int16(215), source),
+		bad,
+		noTimestamp,
+		item(3, "P-101", source),
+		item(1, int16(198), source),
+		item(7, int16(1), source),
+	}})
+
+	require.Equal(t, 1, md.ResourceMetrics().Len())
+	rm := md.ResourceMetrics().At(0)
+	address, _ := rm.Resource().Attributes().Get("server.address")
+	assert.Equal(t, "plc.local", address.Str())
+	port, _ := rm.Resource().Attributes().Get("server.port")
+	assert.Equal(t, int64(4841), port.Int())
+	sm := rm.ScopeMetrics().At(0)
+	assert.Equal(t, scopeName, sm.Scope().Name())
+	assert.Equal(t, "1.0.0", sm.Scope().Version())
+
+	require.Equal(t, 2, sm.Metrics().Len())
+	temperature := sm.Metrics().At(0)
+	assert.Equal(t, "pump.temperature", temperature.Name())
+	assert.Equal(t, "Cel", temperature.Unit())
+	require.Equal(t, pmetric.MetricTypeGauge, temperature.Type())
+	dps := temperature.Gauge().DataPoints()
+	require.Equal(t, 2, dps.Len())
+	assert.InDelta(t, 21.5, dps.At(0).DoubleValue(), 1e-9)
+	pump, _ := dps.At(0).Attributes().Get("pump")
+	assert.Equal(t, "p1", pump.Str())
+	assert.Equal(t, pcommon.NewTimestampFromTime(source), dps.At(0).Timestamp())
+	assert.InDelta(t, 19.8, dps.At(1).DoubleValue(), 1e-9)
+	pump, _ = dps.At(1).Attributes().Get("pump")
+	assert.Equal(t, "p2", pump.Str())
+
+	energy := sm.Metrics().At(1)
+	assert.Equal(t, "pump.energy", energy.Name())
+	require.Equal(t, pmetric.MetricTypeSum, energy.Type())
+	assert.True(t, energy.Sum().IsMonotonic())
+	assert.Equal(t, pmetric.AggregationTemporalityCumulative, energy.Sum().AggregationTemporality())
+	dp := energy.Sum().DataPoints().At(0)
+	assert.Equal(t, int64(42), dp.IntValue())
+	assert.Equal(t, pcommon.NewTimestampFromTime(start), dp.StartTimestamp())
+	assert.Equal(t, pcommon.NewTimestampFromTime(now), dp.Timestamp())
+}
+
+func TestConvert(t *testing.T) {
+	scale := 2.0
+	tests := []struct {
+		name  string
+		node  NodeConfig
+		value any
+		want  any
+	}{
+		{name: "bool", value: true, want: int64(1)},
+		{name: "int8", value: int8(-3), want: int64(-3)},
+		{name: "int32", value: int32(-70000), want: int64(-70000)},
+		{name: "int64", value: int64(math.MinInt64), want: int64(math.MinInt64)},
+		{name: "uint8", value: uint8(255), want: int64(255)},
+		{name: "uint16", value: uint16(65535), want: int64(65535)},
+		{name: "uint64", value: uint64(42), want: int64(42)},
+		{name: "large uint64", value: uint64(math.MaxUint64), want: float64(math.MaxUint64)},
+		{name: "float", value: float32(1.5), want: 1.5},
+		{name: "double", value: 2.25, want: 2.25},
+		{name: "scale", node: NodeConfig{Scale: &scale}, value: int32(21), want: 42.0},
+		{name: "offset", node: NodeConfig{Offset: -10}, value: 300.5, want: 290.5},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			value, ok := tt.node.convert(ua.MustVariant(tt.value))
+			require.True(t, ok)
+			assert.Equal(t, tt.want, value)
+		})
+	}
+
+	n := NodeConfig{}
+	_, ok := n.convert(ua.MustVariant("running"))
+	assert.False(t, ok)
+	_, ok = n.convert(nil)
+	assert.False(t, ok)
+}
@@ -0,0 +1,150 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package opcuareceiver // import "github.com/open-telemetry/opentelemetry-collector-contrib/receiver/opcuareceiver"
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/gopcua/opcua"
+	"github.com/gopcua/opcua/ua"
+	"go.opentelemetry.io/collector/component"
+	"go.opentelemetry.io/collector/consumer"
+	"go.opentelemetry.io/collector/pdata/pcommon"
+	"go.opentelemetry.io/collector/receiver"
+	"go.opentelemetry.io/collector/receiver/receiverhelper"
+	"go.uber.org/zap"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/receiver/opcuareceiver/internal/metadata"
+)
+
+const (
+	transport = "opc.tcp"
+
+	// retryInterval is the interval at which the subscription is retried while the server is unreachable.
+	retryInterval = 10 * time.Second
+)
+
+var scopeName = "otelcol/" + metadata.Type.String() + "receiver"
+
+// opcuaReceiver subscribes to the value changes of the nodes, and converts them to metrics. The subscription is
+// restored by the client when the connection to the server is lost.
+type opcuaReceiver struct {
+	cfg        *Config
+	settings   receiver.CreateSettings
+	obsrecv    *receiverhelper.ObsReport
+	next       consumer.Metrics
+	subscriber subscriber
+	builder    *metricsBuilder
+
+	cancel context.CancelFunc
+	wg     sync.WaitGroup
+}
+
+func newReceiver(cfg *Config, set receiver.CreateSettings, next consumer.Metrics) (*opcuaReceiver, error) {
+	obsrecv, err := receiverhelper.NewObsReport(receiverhelper.ObsReportSettings{
+		ReceiverID:             set.ID,
+		Transport:              transport,
+		ReceiverCreateSettings: set,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &opcuaReceiver{
+		cfg:        cfg,
+		settings:   set,
+		obsrecv:    obsrecv,
+		next:       next,
+		subscriber: newOPCUASubscriber(cfg),
+		builder: &metricsBuilder{
+			cfg:     cfg,
+			version: set.BuildInfo.Version,
+			logger:  set.Logger,
+			now:     time.Now,
+		},
+	}, nil
+}
+
+// Start doesn't connect to the server, for the collector to start while the server is unreachable.
+func (r *opcuaReceiver) Start(_ context.Context, _ component.Host) error {
+	r.builder.startTime = pcommon.NewTimestampFromTime(time.Now())
+	ctx, cancel := context.WithCancel(context.Background())
+	r.cancel = cancel
+	r.wg.Add(1)
+	go r.run(ctx)
+	return nil
+}
+
+func (r *opcuaReceiver) Shutdown(ctx context.Context) error {
+	if r.cancel == nil {
+		return nil
+	}
+	r.cancel()
+	r.wg.Wait()
+	return r.subscriber.close(ctx)
+}
+
+// run subscribes to the nodes, retrying until the server is reachable, and consumes the metrics converted from the
+// notifications of the subscription until the receiver is shut down.
+func (r *opcuaReceiver) run(ctx context.Context) {
+	defer r.wg.Done()
+	nodeIDs := make([]*ua.NodeID, len(r.cfg.Nodes))
+	for i := range r.cfg.Nodes {
+		// the node identifiers are validated with the configuration
+		nodeIDs[i], _ = ua.ParseNodeID(r.cfg.Nodes[i].NodeID)
+	}
+
+	notifyCh := make(chan *opcua.PublishNotificationData)
+	for {
+		nodeErrs, err := r.subscriber.subscribe(ctx, nodeIDs, notifyCh)
+		if err == nil {
+			for i, nodeErr := range nodeErrs {
+				r.settings.Logger.Warn("Failed to monitor the node", zap.String("node_id", r.cfg.Nodes[i].NodeID), zap.Error(nodeErr))
+			}
+			break
+		}
+		if ctx.Err() != nil {
+			return
+		}
+		r.settings.Logger.Warn("Failed to subscribe to the nodes, retrying", zap.String("endpoint", r.cfg.Endpoint), zap.Error(err))
+		select {
+		case <-ctx.Done():
+			return
+		case <-time.After(retryInterval):
+		}
+	}
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case data := <-notifyCh:
+			r.handle(ctx, data)
+		}
+	}
+}
+
+func (r *opcuaReceiver) handle(ctx context.Context, data *opcua.PublishNotificationData) {
+	if data.Error != nil {
+		r.settings.Logger.Warn("The subscription failed", zap.Error(data.Error))
+		return
+	}
+	// the status changes and the events aren't converted
+	notification, ok := data.Value.(*ua.DataChangeNotification)
+	if !ok {
+		return
+	}
+	md := r.builder.build(notification)
+	if md.DataPointCount() == 0 {
+		return
+	}
+
+	obsCtx := r.obsrecv.StartMetricsOp(ctx)
+	err := r.next.ConsumeMetrics(obsCtx, md)
+	r.obsrecv.EndMetricsOp(obsCtx, metadata.Type.String(), md.DataPointCount(), err)
+	if err != nil {
+		r.settings.Logger.Error("Failed to consume the metrics of the nodes", zap.Error(err))
+	}
+}
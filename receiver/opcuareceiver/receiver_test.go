@@ -0,0 +1,108 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package opcuareceiver
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/gopcua/opcua"
+	"github.com/gopcua/opcua/ua"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/collector/component/componenttest"
+	"go.opentelemetry.io/collector/consumer/consumertest"
+	"go.opentelemetry.io/collector/receiver/receivertest"
+)
+
+// fakeSubscriber hands the notification channel of the subscription to the test, failing the first subscriptions.
+type fakeSubscriber struct {
+	failures int
+
+	mu       sync.Mutex
+	attempts int
+	nodeIDs  []*ua.NodeID
+	closed   bool
+	notifyCh chan chan<- *opcua.PublishNotificationData
+}
+
+func (s *fakeSubscriber) subscribe(_ context.Context, nodeIDs []*ua.NodeID, notifyCh chan<- *opcua.PublishNotificationData) (map[int]error, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.attempts++
+	if s.attempts <= s.failures {
+		return nil, errors.New("connection refused")
+	}
+	s.nodeIDs = nodeIDs
+	s.notifyCh <- notifyCh
+	return map[int]error{1: ua.StatusBadNodeIDUnknown}, nil
+}
+
+func (s *fakeSubscriber) close(context.Context) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.closed = true
+	return nil
+}
+
+func newTestReceiver(t *testing.T, sink *consumertest.MetricsSink, sub *fakeSubscriber) *opcuaReceiver {
+	cfg := createDefaultConfig().(*Config)
+	cfg.Nodes = []NodeConfig{
+		{NodeID: "ns=2;s=Temperature", Name: "temperature"},
+		{NodeID: "ns=2;s=Missing", Name: "missing"},
+	}
+	require.NoError(t, cfg.Validate())
+
+	r, err := newReceiver(cfg, receivertest.NewNopCreateSettings(), sink)
+	require.NoError(t, err)
+	r.subscriber = sub
+	return r
+}
+
+func TestReceiver(t *testing.T) {
+	sink := new(consumertest.MetricsSink)
+	sub := &fakeSubscriber{notifyCh: make(chan chan<- *opcua.PublishNotificationData, 1)}
+	r := newTestReceiver(t, sink, sub)
+
+	require.NoError(t, r.Start(context.Background(), componenttest.NewNopHost()))
+	notifyCh := <-sub.notifyCh
+	assert.Equal(t, []*ua.NodeID{ua.NewStringNodeID(2, "Temperature"), ua.NewStringNodeID(2, "Missing")}, sub.nodeIDs)
+
+	notifyCh <- &opcua.PublishNotificationData{Error: errors.New("subscription timed out")}
+	notifyCh <- &opcua.PublishNotificationData{Value: &ua.StatusChangeNotification{Status: ua.StatusOK}}
+	notifyCh <- &opcua.PublishNotificationData{Value: &ua.DataChangeNotification{
+		MonitoredItems: []*ua.MonitoredItemNotification{item(0, 21.5, time.Now())},
+	}}
+	require.Eventually(t, func() bool { return sink.DataPointCount() > 0 }, 5*time.Second, 10*time.Millisecond)
+	require.NoError(t, r.Shutdown(context.Background()))
+
+	require.Len(t, sink.AllMetrics(), 1)
+	metric := sink.AllMetrics()[0].ResourceMetrics().At(0).ScopeMetrics().At(0).Metrics().At(0)
+	assert.Equal(t, "temperature", metric.Name())
+	assert.Equal(t, 21.5, metric.Gauge().DataPoints().At(0).DoubleValue())
+	assert.True(t, sub.closed)
+}
+
+func TestReceiverShutdownWhileUnreachable(t *testing.T) {
+	sink := new(consumertest.MetricsSink)
+	sub := &fakeSubscriber{failures: 1}
+	r := newTestReceiver(t, sink, sub)
+
+	require.NoError(t, r.Start(context.Background(), componenttest.NewNopHost()))
+	require.Eventually(t, func() bool {
+		sub.mu.Lock()
+		defer sub.mu.Unlock()
+		return sub.attempts == 1
+	}, 5*time.Second, 10*time.Millisecond)
+	require.NoError(t, r.Shutdown(context.Background()))
+	assert.Equal(t, 0, sink.DataPointCount())
+}
+
+func TestReceiverShutdownWithoutStart(t *testing.T) {
+	r := newTestReceiver(t, new(consumertest.MetricsSink), &fakeSubscriber{})
+	assert.NoError(t, r.Shutdown(context.Background()))
+}
@@ -0,0 +1,116 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package opcuareceiver // import "github.com/open-telemetry/opentelemetry-collector-contrib/receiver/opcuareceiver"
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/gopcua/opcua"
+	"github.com/gopcua/opcua/ua"
+)
+
+// subscriber subscribes to the value changes of nodes of a server.
+type subscriber interface {
+	// subscribe connects to the server, and monitors the values of the nodes, the client handle of each node being
+	// its index. The notifications of the subscription are sent to notifyCh. It returns the errors of the nodes which
+	// can't be monitored, by index.
+	subscribe(ctx context.Context, nodeIDs []*ua.NodeID, notifyCh chan<- *opcua.PublishNotificationData) (map[int]error, error)
+	// close closes the session, deleting the subscription, and the connection.
+	close(ctx context.Context) error
+}
+
+// opcuaSubscriber subscribes with the gopcua client, which reconnects to the server and restores the subscription
+// when the connection is lost.
+type opcuaSubscriber struct {
+	cfg    *Config
+	client *opcua.Client
+}
+
+func newOPCUASubscriber(cfg *Config) *opcuaSubscriber {
+	return &opcuaSubscriber{cfg: cfg}
+}
+
+func (s *opcuaSubscriber) subscribe(ctx context.Context, nodeIDs []*ua.NodeID, notifyCh chan<- *opcua.PublishNotificationData) (map[int]error, error) {
+	ctx, cancel := context.WithTimeout(ctx, s.cfg.Timeout)
+	defer cancel()
+
+	client, err := s.connect(ctx)
+	if err != nil {
+		return nil, err
+	}
+	sub, err := client.Subscribe(ctx, &opcua.SubscriptionParameters{Interval: s.cfg.PublishingInterval}, notifyCh)
+	if err != nil {
+		_ = client.Close(ctx)
+		return nil, fmt.Errorf("failed to create the subscription: %w", err)
+	}
+
+	reqs := make([]*ua.MonitoredItemCreateRequest, len(nodeIDs))
+	for i, nodeID := range nodeIDs {
+		reqs[i] = opcua.NewMonitoredItemCreateRequestWithDefaults(nodeID, ua.AttributeIDValue, uint32(i))
+		reqs[i].RequestedParameters.SamplingInterval = float64(s.cfg.SamplingInterval / time.Millisecond)
+	}
+	res, err := sub.Monitor(ctx, ua.TimestampsToReturnBoth, reqs...)
+	if err != nil {
+		_ = client.Close(ctx)
+		return nil, fmt.Errorf("failed to monitor the nodes: %w", err)
+	}
+	nodeErrs := map[int]error{}
+	for i, result := range res.Results {
+		if result.StatusCode != ua.StatusOK {
+			nodeErrs[i] = result.StatusCode
+		}
+	}
+
+	s.client = client
+	return nodeErrs, nil
+}
+
+// connect selects the endpoint of the server with the configured security, and opens a session.
+func (s *opcuaSubscriber) connect(ctx context.Context) (*opcua.Client, error) {
+	endpoints, err := opcua.GetEndpoints(ctx, s.cfg.Endpoint)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get the endpoints of the server: %w", err)
+	}
+	ep := opcua.SelectEndpoint(endpoints, s.cfg.SecurityPolicy, ua.MessageSecurityModeFromString(s.cfg.SecurityMode))
+	if ep == nil {
+		return nil, fmt.Errorf("the server has no endpoint with the %s security policy and the %s security mode", s.cfg.SecurityPolicy, s.cfg.SecurityMode)
+	}
+
+	opts := []opcua.Option{
+		opcua.SecurityPolicy(s.cfg.SecurityPolicy),
+		opcua.SecurityModeString(s.cfg.SecurityMode),
+	}
+	if s.cfg.CertFile != "" {
+		opts = append(opts, opcua.CertificateFile(s.cfg.CertFile), opcua.PrivateKeyFile(s.cfg.KeyFile))
+	}
+	authType := ua.UserTokenTypeAnonymous
+	if s.cfg.Username != "" {
+		authType = ua.UserTokenTypeUserName
+		opts = append(opts, opcua.AuthUsername(s.cfg.Username, string(s.cfg.Password)))
+	} else {
+		opts = append(opts, opcua.AuthAnonymous())
+	}
+	opts = append(opts, opcua.SecurityFromEndpoint(ep, authType))
+
+	// the endpoint advertised by the server may hold a host name unknown to the collector
+	client, err := opcua.NewClient(s.cfg.Endpoint, opts...)
+	if err != nil {
+		return nil, err
+	}
+	if err = client.Connect(ctx); err != nil {
+		return nil, fmt.Errorf("failed to connect to the server: %w", err)
+	}
+	return client, nil
+}
+
+func (s *opcuaSubscriber) close(ctx context.Context) error {
+	if s.client == nil {
+		return nil
+	}
+	err := s.client.Close(ctx)
+	s.client = nil
+	return err
+}
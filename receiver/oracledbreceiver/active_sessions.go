@@ -0,0 +1,120 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package oracledbreceiver // import "github.com/open-telemetry/opentelemetry-collector-contrib/receiver/oracledbreceiver"
+
+import (
+	"regexp"
+	"sort"
+	"strconv"
+	"sync"
+)
+
+// activeSessionsSQL samples the active user sessions of the instance, like Active Session History does, without
+// querying v$active_session_history, which requires the Diagnostics Pack license. Sessions not waiting are on CPU,
+// and sessions waiting on idle events aren't active.
+const activeSessionsSQL = `
+	select CASE WHEN s.STATE = 'WAITING' THEN s.WAIT_CLASS ELSE 'CPU' END as WAIT_CLASS, s.SQL_ID, MAX(q.SQL_TEXT) as SQL_TEXT, count(*) as VALUE
+	FROM v$session s LEFT JOIN v$sqlarea q ON s.SQL_ID = q.SQL_ID
+	WHERE s.STATUS = 'ACTIVE' AND s.TYPE = 'USER' AND (s.STATE <> 'WAITING' OR s.WAIT_CLASS <> 'Idle')
+	GROUP BY CASE WHEN s.STATE = 'WAITING' THEN s.WAIT_CLASS ELSE 'CPU' END, s.SQL_ID`
+
+var (
+	sqlStringLiteralRegexp  = regexp.MustCompile(`'(?:[^']|'')*'`)
+	sqlNumericLiteralRegexp = regexp.MustCompile(`\b\d+(?:\.\d+)?(?:[eE][+-]?\d+)?\b`)
+	sqlWhitespaceRegexp     = regexp.MustCompile(`\s+`)
+)
+
+// obfuscateSQL replaces the string and numeric literals of the statement with placeholders, so that the values
+// bound into the statement text don't end up in the metric attributes.
+func obfuscateSQL(text string) string {
+	text = sqlStringLiteralRegexp.ReplaceAllString(text, "?")
+	text = sqlNumericLiteralRegexp.ReplaceAllString(text, "?")
+	return sqlWhitespaceRegexp.ReplaceAllString(text, " ")
+}
+
+type sqlActivity struct {
+	sqlID    string
+	sqlText  string
+	sessions int64
+}
+
+// activeSessionSamples accumulates the active sessions samples taken since the previous collection.
+type activeSessionSamples struct {
+	mutex     sync.Mutex
+	samples   int64
+	waitClass map[string]int64
+	sql       map[string]*sqlActivity
+}
+
+func newActiveSessionSamples() *activeSessionSamples {
+	return &activeSessionSamples{
+		waitClass: map[string]int64{},
+		sql:       map[string]*sqlActivity{},
+	}
+}
+
+// add records a sample of the active sessions, as returned by activeSessionsSQL.
+func (a *activeSessionSamples) add(rows []metricRow) error {
+	a.mutex.Lock()
+	defer a.mutex.Unlock()
+
+	for _, row := range rows {
+		sessions, err := strconv.ParseInt(row["VALUE"], 10, 64)
+		if err != nil {
+			return err
+		}
+		a.waitClass[row["WAIT_CLASS"]] += sessions
+		if row["SQL_ID"] == "" {
+			continue
+		}
+		activity, ok := a.sql[row["SQL_ID"]]
+		if !ok {
+			activity = &sqlActivity{sqlID: row["SQL_ID"]}
+			a.sql[row["SQL_ID"]] = activity
+		}
+		if row["SQL_TEXT"] != "" {
+			activity.sqlText = row["SQL_TEXT"]
+		}
+		activity.sessions += sessions
+	}
+	a.samples++
+	return nil
+}
+
+func (a *activeSessionSamples) count() int64 {
+	a.mutex.Lock()
+	defer a.mutex.Unlock()
+	return a.samples
+}
+
+// flush returns the average active sessions by wait class, and the statements with the most active sessions, up to
+// topSQL statements, then resets the samples.
+func (a *activeSessionSamples) flush(topSQL int) (map[string]float64, []sqlActivity, float64) {
+	a.mutex.Lock()
+	defer a.mutex.Unlock()
+
+	samples := float64(a.samples)
+	waitClass := make(map[string]float64, len(a.waitClass))
+	for class, sessions := range a.waitClass {
+		waitClass[class] = float64(sessions) / samples
+	}
+	statements := make([]sqlActivity, 0, len(a.sql))
+	for _, activity := range a.sql {
+		statements = append(statements, *activity)
+	}
+	sort.Slice(statements, func(i, j int) bool {
+		if statements[i].sessions != statements[j].sessions {
+			return statements[i].sessions > statements[j].sessions
+		}
+		return statements[i].sqlID < statements[j].sqlID
+	})
+	if len(statements) > topSQL {
+		statements = statements[:topSQL]
+	}
+
+	a.samples = 0
+	a.waitClass = map[string]int64{}
+	a.sql = map[string]*sqlActivity{}
+	return waitClass, statements, samples
+}
@@ -0,0 +1,59 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package oracledbreceiver
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestObfuscateSQL(t *testing.T) {
+	tests := []struct {
+		text     string
+		expected string
+	}{
+		{
+			text:     "select * from t1 where id = 42 and amount > 3.5e2",
+			expected: "select * from t1 where id = ? and amount > ?",
+		},
+		{
+			text:     "update users set name = 'O''Brien',\n\temail = 'ob@example.com' where id = :1",
+			expected: "update users set name = ?, email = ? where id = :?",
+		},
+		{
+			text:     "select count(*) from dual",
+			expected: "select count(*) from dual",
+		},
+	}
+	for _, tt := range tests {
+		assert.Equal(t, tt.expected, obfuscateSQL(tt.text))
+	}
+}
+
+func TestActiveSessionSamples(t *testing.T) {
+	samples := newActiveSessionSamples()
+	require.NoError(t, samples.add([]metricRow{
+		{"WAIT_CLASS": "CPU", "SQL_ID": "b", "SQL_TEXT": "select 2 from dual", "VALUE": "3"},
+		{"WAIT_CLASS": "Concurrency", "SQL_ID": "a", "SQL_TEXT": "select 1 from dual", "VALUE": "1"},
+		{"WAIT_CLASS": "Concurrency", "SQL_ID": "", "VALUE": "2"},
+	}))
+	require.NoError(t, samples.add([]metricRow{
+		{"WAIT_CLASS": "CPU", "SQL_ID": "a", "SQL_TEXT": "", "VALUE": "2"},
+		{"WAIT_CLASS": "Cluster", "SQL_ID": "c", "SQL_TEXT": "select 3 from dual", "VALUE": "1"},
+	}))
+	assert.Equal(t, int64(2), samples.count())
+
+	waitClasses, statements, count := samples.flush(2)
+	assert.Equal(t, 2.0, count)
+	assert.Equal(t, map[string]float64{"CPU": 2.5, "Concurrency": 1.5, "Cluster": 0.5}, waitClasses)
+	assert.Equal(t, []sqlActivity{
+		{sqlID: "a", sqlText: "select 1 from dual", sessions: 3},
+		{sqlID: "b", sqlText: "select 2 from dual", sessions: 3},
+	}, statements)
+
+	assert.Equal(t, int64(0), samples.count())
+	assert.Error(t, samples.add([]metricRow{{"WAIT_CLASS": "CPU", "VALUE": "x"}}))
+}
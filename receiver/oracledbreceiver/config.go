@@ -9,6 +9,7 @@ import (
 	"net"
 	"net/url"
 	"strconv"
+	"time"
 
 	"go.opentelemetry.io/collector/receiver/scraperhelper"
 	"go.uber.org/multierr"
@@ -24,14 +25,27 @@ var (
 	errEmptyPassword = errors.New("password must be set")
 	errEmptyService  = errors.New("service must be specified")
 	errEmptyUsername = errors.New("username must be set")
+	errBadSampling   = errors.New("active_sessions.sampling_interval must be positive")
+	errBadTopSQL     = errors.New("active_sessions.top_sql must be positive")
 )
 
+// ActiveSessionsConfig configures the sampling of the active sessions, reported by the oracledb.active_sessions
+// and oracledb.top_sql.active_sessions metrics.
+type ActiveSessionsConfig struct {
+	// SamplingInterval is the interval at which the active sessions are sampled. The metrics report the average
+	// of the samples taken during the collection interval.
+	SamplingInterval time.Duration `mapstructure:"sampling_interval"`
+	// TopSQL is the number of statements with the most active sessions that are reported.
+	TopSQL int `mapstructure:"top_sql"`
+}
+
 type Config struct {
-	DataSource                     string `mapstructure:"datasource"`
-	Endpoint                       string `mapstructure:"endpoint"`
-	Password                       string `mapstructure:"password"`
-	Service                        string `mapstructure:"service"`
-	Username                       string `mapstructure:"username"`
+	DataSource                     string               `mapstructure:"datasource"`
+	Endpoint                       string               `mapstructure:"endpoint"`
+	Password                       string               `mapstructure:"password"`
+	Service                        string               `mapstructure:"service"`
+	Username                       string               `mapstructure:"username"`
+	ActiveSessions                 ActiveSessionsConfig `mapstructure:"active_sessions"`
 	scraperhelper.ControllerConfig `mapstructure:",squash"`
 	metadata.MetricsBuilderConfig  `mapstructure:",squash"`
 }
@@ -39,6 +53,13 @@ type Config struct {
 func (c Config) Validate() error {
 	var allErrs error
 
+	if c.ActiveSessions.SamplingInterval <= 0 {
+		allErrs = multierr.Append(allErrs, errBadSampling)
+	}
+	if c.ActiveSessions.TopSQL <= 0 {
+		allErrs = multierr.Append(allErrs, errBadTopSQL)
+	}
+
 	// If DataSource is defined it takes precedence over the rest of the connection options.
 	if c.DataSource == "" {
 
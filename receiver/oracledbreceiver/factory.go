@@ -37,6 +37,10 @@ func createDefaultConfig() component.Config {
 	return &Config{
 		ControllerConfig:     cfg,
 		MetricsBuilderConfig: metadata.DefaultMetricsBuilderConfig(),
+		ActiveSessions: ActiveSessionsConfig{
+			SamplingInterval: 5 * time.Second,
+			TopSQL:           10,
+		},
 	}
 }
 
@@ -59,7 +63,7 @@ func createReceiverFunc(sqlOpenerFunc sqlOpenerFunc, clientProviderFunc clientPr
 
 		mp, err := newScraper(metricsBuilder, sqlCfg.MetricsBuilderConfig, sqlCfg.ControllerConfig, settings.TelemetrySettings.Logger, func() (*sql.DB, error) {
 			return sqlOpenerFunc(getDataSource(*sqlCfg))
-		}, clientProviderFunc, instanceName)
+		}, clientProviderFunc, instanceName, sqlCfg.ActiveSessions)
 		if err != nil {
 			return nil, err
 		}
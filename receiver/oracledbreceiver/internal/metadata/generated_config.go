@@ -28,6 +28,7 @@ func (ms *MetricConfig) Unmarshal(parser *confmap.Conf) error {
 
 // MetricsConfig provides config for oracledb metrics.
 type MetricsConfig struct {
+	OracledbActiveSessions        MetricConfig `mapstructure:"oracledb.active_sessions"`
 	OracledbConsistentGets        MetricConfig `mapstructure:"oracledb.consistent_gets"`
 	OracledbCPUTime               MetricConfig `mapstructure:"oracledb.cpu_time"`
 	OracledbDbBlockGets           MetricConfig `mapstructure:"oracledb.db_block_gets"`
@@ -51,6 +52,7 @@ type MetricsConfig struct {
 	OracledbSessionsUsage         MetricConfig `mapstructure:"oracledb.sessions.usage"`
 	OracledbTablespaceSizeLimit   MetricConfig `mapstructure:"oracledb.tablespace_size.limit"`
 	OracledbTablespaceSizeUsage   MetricConfig `mapstructure:"oracledb.tablespace_size.usage"`
+	OracledbTopSQLActiveSessions  MetricConfig `mapstructure:"oracledb.top_sql.active_sessions"`
 	OracledbTransactionsLimit     MetricConfig `mapstructure:"oracledb.transactions.limit"`
 	OracledbTransactionsUsage     MetricConfig `mapstructure:"oracledb.transactions.usage"`
 	OracledbUserCommits           MetricConfig `mapstructure:"oracledb.user_commits"`
@@ -59,6 +61,9 @@ type MetricsConfig struct {
 
 func DefaultMetricsConfig() MetricsConfig {
 	return MetricsConfig{
+		OracledbActiveSessions: MetricConfig{
+			Enabled: false,
+		},
 		OracledbConsistentGets: MetricConfig{
 			Enabled: false,
 		},
@@ -128,6 +133,9 @@ func DefaultMetricsConfig() MetricsConfig {
 		OracledbTablespaceSizeUsage: MetricConfig{
 			Enabled: true,
 		},
+		OracledbTopSQLActiveSessions: MetricConfig{
+			Enabled: false,
+		},
 		OracledbTransactionsLimit: MetricConfig{
 			Enabled: true,
 		},
@@ -25,6 +25,7 @@ func TestMetricsBuilderConfig(t *testing.T) {
 			name: "all_set",
 			want: MetricsBuilderConfig{
 				Metrics: MetricsConfig{
+					OracledbActiveSessions:        MetricConfig{Enabled: true},
 					OracledbConsistentGets:        MetricConfig{Enabled: true},
 					OracledbCPUTime:               MetricConfig{Enabled: true},
 					OracledbDbBlockGets:           MetricConfig{Enabled: true},
@@ -48,6 +49,7 @@ func TestMetricsBuilderConfig(t *testing.T) {
 					OracledbSessionsUsage:         MetricConfig{Enabled: true},
 					OracledbTablespaceSizeLimit:   MetricConfig{Enabled: true},
 					OracledbTablespaceSizeUsage:   MetricConfig{Enabled: true},
+					OracledbTopSQLActiveSessions:  MetricConfig{Enabled: true},
 					OracledbTransactionsLimit:     MetricConfig{Enabled: true},
 					OracledbTransactionsUsage:     MetricConfig{Enabled: true},
 					OracledbUserCommits:           MetricConfig{Enabled: true},
@@ -62,6 +64,7 @@ func TestMetricsBuilderConfig(t *testing.T) {
 			name: "none_set",
 			want: MetricsBuilderConfig{
 				Metrics: MetricsConfig{
+					OracledbActiveSessions:        MetricConfig{Enabled: false},
 					OracledbConsistentGets:        MetricConfig{Enabled: false},
 					OracledbCPUTime:               MetricConfig{Enabled: false},
 					OracledbDbBlockGets:           MetricConfig{Enabled: false},
@@ -85,6 +88,7 @@ func TestMetricsBuilderConfig(t *testing.T) {
 					OracledbSessionsUsage:         MetricConfig{Enabled: false},
 					OracledbTablespaceSizeLimit:   MetricConfig{Enabled: false},
 					OracledbTablespaceSizeUsage:   MetricConfig{Enabled: false},
+					OracledbTopSQLActiveSessions:  MetricConfig{Enabled: false},
 					OracledbTransactionsLimit:     MetricConfig{Enabled: false},
 					OracledbTransactionsUsage:     MetricConfig{Enabled: false},
 					OracledbUserCommits:           MetricConfig{Enabled: false},
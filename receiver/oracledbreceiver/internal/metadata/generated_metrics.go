@@ -14,6 +14,57 @@ import (
 	"go.opentelemetry.io/collector/receiver"
 )
 
+type metricOracledbActiveSessions struct {
+	data     pmetric.Metric // data buffer for generated metric.
+	config   MetricConfig   // metric config provided by user.
+	capacity int            // max observed number of data points added to the metric.
+}
+
+// init fills oracledb.active_sessions metric with initial data.
+func (m *metricOracledbActiveSessions) init() {
+	m.data.SetName("oracledb.active_sessions")
+	m.data.SetDescription("Average number of active user sessions over the collection interval, sampled from v$session.")
+	m.data.SetUnit("{sessions}")
+	m.data.SetEmptyGauge()
+	m.data.Gauge().DataPoints().EnsureCapacity(m.capacity)
+}
+
+func (m *metricOracledbActiveSessions) recordDataPoint(start pcommon.Timestamp, ts pcommon.Timestamp, val float64, waitClassAttributeValue string) {
+	if !m.config.Enabled {
+		return
+	}
+	dp := m.data.Gauge().DataPoints().AppendEmpty()
+	dp.SetStartTimestamp(start)
+	dp.SetTimestamp(ts)
+	dp.SetDoubleValue(val)
+	dp.Attributes().PutStr("wait_class", waitClassAttributeValue)
+}
+
+// updateCapacity saves max length of data point slices that will be used for the slice capacity.
+func (m *metricOracledbActiveSessions) updateCapacity() {
+	if m.data.Gauge().DataPoints().Len() > m.capacity {
+		m.capacity = m.data.Gauge().DataPoints().Len()
+	}
+}
+
+// emit appends recorded metric data to a metrics slice and prepares it for recording another set of data points.
+func (m *metricOracledbActiveSessions) emit(metrics pmetric.MetricSlice) {
+	if m.config.Enabled && m.data.Gauge().DataPoints().Len() > 0 {
+		m.updateCapacity()
+		m.data.MoveTo(metrics.AppendEmpty())
+		m.init()
+	}
+}
+
+func newMetricOracledbActiveSessions(cfg MetricConfig) metricOracledbActiveSessions {
+	m := metricOracledbActiveSessions{config: cfg}
+	if cfg.Enabled {
+		m.data = pmetric.NewMetric()
+		m.init()
+	}
+	return m
+}
+
 type metricOracledbConsistentGets struct {
 	data     pmetric.Metric // data buffer for generated metric.
 	config   MetricConfig   // metric config provided by user.
@@ -1170,6 +1221,58 @@ func newMetricOracledbTablespaceSizeUsage(cfg MetricConfig) metricOracledbTables
 	return m
 }
 
+type metricOracledbTopSQLActiveSessions struct {
+	data     pmetric.Metric // data buffer for generated metric.
+	config   MetricConfig   // metric config provided by user.
+	capacity int            // max observed number of data points added to the metric.
+}
+
+// init fills oracledb.top_sql.active_sessions metric with initial data.
+func (m *metricOracledbTopSQLActiveSessions) init() {
+	m.data.SetName("oracledb.top_sql.active_sessions")
+	m.data.SetDescription("Average number of active user sessions over the collection interval executing the statements with the most active sessions, sampled from v$session.")
+	m.data.SetUnit("{sessions}")
+	m.data.SetEmptyGauge()
+	m.data.Gauge().DataPoints().EnsureCapacity(m.capacity)
+}
+
+func (m *metricOracledbTopSQLActiveSessions) recordDataPoint(start pcommon.Timestamp, ts pcommon.Timestamp, val float64, sqlIDAttributeValue string, sqlTextAttributeValue string) {
+	if !m.config.Enabled {
+		return
+	}
+	dp := m.data.Gauge().DataPoints().AppendEmpty()
+	dp.SetStartTimestamp(start)
+	dp.SetTimestamp(ts)
+	dp.SetDoubleValue(val)
+	dp.Attributes().PutStr("sql_id", sqlIDAttributeValue)
+	dp.Attributes().PutStr("sql_text", sqlTextAttributeValue)
+}
+
+// updateCapacity saves max length of data point slices that will be used for the slice capacity.
+func (m *metricOracledbTopSQLActiveSessions) updateCapacity() {
+	if m.data.Gauge().DataPoints().Len() > m.capacity {
+		m.capacity = m.data.Gauge().DataPoints().Len()
+	}
+}
+
+// emit appends recorded metric data to a metrics slice and prepares it for recording another set of data points.
+func (m *metricOracledbTopSQLActiveSessions) emit(metrics pmetric.MetricSlice) {
+	if m.config.Enabled && m.data.Gauge().DataPoints().Len() > 0 {
+		m.updateCapacity()
+		m.data.MoveTo(metrics.AppendEmpty())
+		m.init()
+	}
+}
+
+func newMetricOracledbTopSQLActiveSessions(cfg MetricConfig) metricOracledbTopSQLActiveSessions {
+	m := metricOracledbTopSQLActiveSessions{config: cfg}
+	if cfg.Enabled {
+		m.data = pmetric.NewMetric()
+		m.init()
+	}
+	return m
+}
+
 type metricOracledbTransactionsLimit struct {
 	data     pmetric.Metric // data buffer for generated metric.
 	config   MetricConfig   // metric config provided by user.
@@ -1380,6 +1483,7 @@ type MetricsBuilder struct {
 	buildInfo                           component.BuildInfo  // contains version information.
 	resourceAttributeIncludeFilter      map[string]filter.Filter
 	resourceAttributeExcludeFilter      map[string]filter.Filter
+	metricOracledbActiveSessions        metricOracledbActiveSessions
 	metricOracledbConsistentGets        metricOracledbConsistentGets
 	metricOracledbCPUTime               metricOracledbCPUTime
 	metricOracledbDbBlockGets           metricOracledbDbBlockGets
@@ -1403,6 +1507,7 @@ type MetricsBuilder struct {
 	metricOracledbSessionsUsage         metricOracledbSessionsUsage
 	metricOracledbTablespaceSizeLimit   metricOracledbTablespaceSizeLimit
 	metricOracledbTablespaceSizeUsage   metricOracledbTablespaceSizeUsage
+	metricOracledbTopSQLActiveSessions  metricOracledbTopSQLActiveSessions
 	metricOracledbTransactionsLimit     metricOracledbTransactionsLimit
 	metricOracledbTransactionsUsage     metricOracledbTransactionsUsage
 	metricOracledbUserCommits           metricOracledbUserCommits
@@ -1425,6 +1530,7 @@ func NewMetricsBuilder(mbc MetricsBuilderConfig, settings receiver.CreateSetting
 		startTime:                           pcommon.NewTimestampFromTime(time.Now()),
 		metricsBuffer:                       pmetric.NewMetrics(),
 		buildInfo:                           settings.BuildInfo,
+		metricOracledbActiveSessions:        newMetricOracledbActiveSessions(mbc.Metrics.OracledbActiveSessions),
 		metricOracledbConsistentGets:        newMetricOracledbConsistentGets(mbc.Metrics.OracledbConsistentGets),
 		metricOracledbCPUTime:               newMetricOracledbCPUTime(mbc.Metrics.OracledbCPUTime),
 		metricOracledbDbBlockGets:           newMetricOracledbDbBlockGets(mbc.Metrics.OracledbDbBlockGets),
@@ -1448,6 +1554,7 @@ func NewMetricsBuilder(mbc MetricsBuilderConfig, settings receiver.CreateSetting
 		metricOracledbSessionsUsage:         newMetricOracledbSessionsUsage(mbc.Metrics.OracledbSessionsUsage),
 		metricOracledbTablespaceSizeLimit:   newMetricOracledbTablespaceSizeLimit(mbc.Metrics.OracledbTablespaceSizeLimit),
 		metricOracledbTablespaceSizeUsage:   newMetricOracledbTablespaceSizeUsage(mbc.Metrics.OracledbTablespaceSizeUsage),
+		metricOracledbTopSQLActiveSessions:  newMetricOracledbTopSQLActiveSessions(mbc.Metrics.OracledbTopSQLActiveSessions),
 		metricOracledbTransactionsLimit:     newMetricOracledbTransactionsLimit(mbc.Metrics.OracledbTransactionsLimit),
 		metricOracledbTransactionsUsage:     newMetricOracledbTransactionsUsage(mbc.Metrics.OracledbTransactionsUsage),
 		metricOracledbUserCommits:           newMetricOracledbUserCommits(mbc.Metrics.OracledbUserCommits),
@@ -1522,6 +1629,7 @@ func (mb *MetricsBuilder) EmitForResource(rmo ...ResourceMetricsOption) {
 	ils.Scope().SetName("otelcol/oracledbreceiver")
 	ils.Scope().SetVersion(mb.buildInfo.Version)
 	ils.Metrics().EnsureCapacity(mb.metricsCapacity)
+	mb.metricOracledbActiveSessions.emit(ils.Metrics())
 	mb.metricOracledbConsistentGets.emit(ils.Metrics())
 	mb.metricOracledbCPUTime.emit(ils.Metrics())
 	mb.metricOracledbDbBlockGets.emit(ils.Metrics())
@@ -1545,6 +1653,7 @@ func (mb *MetricsBuilder) EmitForResource(rmo ...ResourceMetricsOption) {
 	mb.metricOracledbSessionsUsage.emit(ils.Metrics())
 	mb.metricOracledbTablespaceSizeLimit.emit(ils.Metrics())
 	mb.metricOracledbTablespaceSizeUsage.emit(ils.Metrics())
+	mb.metricOracledbTopSQLActiveSessions.emit(ils.Metrics())
 	mb.metricOracledbTransactionsLimit.emit(ils.Metrics())
 	mb.metricOracledbTransactionsUsage.emit(ils.Metrics())
 	mb.metricOracledbUserCommits.emit(ils.Metrics())
@@ -1580,6 +1689,11 @@ func (mb *MetricsBuilder) Emit(rmo ...ResourceMetricsOption) pmetric.Metrics {
 	return metrics
 }
 
+// RecordOracledbActiveSessionsDataPoint adds a data point to oracledb.active_sessions metric.
+func (mb *MetricsBuilder) RecordOracledbActiveSessionsDataPoint(ts pcommon.Timestamp, val float64, waitClassAttributeValue string) {
+	mb.metricOracledbActiveSessions.recordDataPoint(mb.startTime, ts, val, waitClassAttributeValue)
+}
+
 // RecordOracledbConsistentGetsDataPoint adds a data point to oracledb.consistent_gets metric.
 func (mb *MetricsBuilder) RecordOracledbConsistentGetsDataPoint(ts pcommon.Timestamp, inputVal string) error {
 	val, err := strconv.ParseInt(inputVal, 10, 64)
@@ -1795,6 +1909,11 @@ func (mb *MetricsBuilder) RecordOracledbTablespaceSizeUsageDataPoint(ts pcommon.
 	mb.metricOracledbTablespaceSizeUsage.recordDataPoint(mb.startTime, ts, val, tablespaceNameAttributeValue)
 }
 
+// RecordOracledbTopSQLActiveSessionsDataPoint adds a data point to oracledb.top_sql.active_sessions metric.
+func (mb *MetricsBuilder) RecordOracledbTopSQLActiveSessionsDataPoint(ts pcommon.Timestamp, val float64, sqlIDAttributeValue string, sqlTextAttributeValue string) {
+	mb.metricOracledbTopSQLActiveSessions.recordDataPoint(mb.startTime, ts, val, sqlIDAttributeValue, sqlTextAttributeValue)
+}
+
 // RecordOracledbTransactionsLimitDataPoint adds a data point to oracledb.transactions.limit metric.
 func (mb *MetricsBuilder) RecordOracledbTransactionsLimitDataPoint(ts pcommon.Timestamp, inputVal string) error {
 	val, err := strconv.ParseInt(inputVal, 10, 64)
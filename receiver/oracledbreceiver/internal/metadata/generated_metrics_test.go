@@ -68,6 +68,9 @@ func TestMetricsBuilder(t *testing.T) {
 			defaultMetricsCount := 0
 			allMetricsCount := 0
 
+			allMetricsCount++
+			mb.RecordOracledbActiveSessionsDataPoint(ts, 1, "wait_class-val")
+
 			allMetricsCount++
 			mb.RecordOracledbConsistentGetsDataPoint(ts, "1")
 
@@ -158,6 +161,9 @@ func TestMetricsBuilder(t *testing.T) {
 			allMetricsCount++
 			mb.RecordOracledbTablespaceSizeUsageDataPoint(ts, 1, "tablespace_name-val")
 
+			allMetricsCount++
+			mb.RecordOracledbTopSQLActiveSessionsDataPoint(ts, 1, "sql_id-val", "sql_text-val")
+
 			defaultMetricsCount++
 			allMetricsCount++
 			mb.RecordOracledbTransactionsLimitDataPoint(ts, "1")
@@ -198,6 +204,21 @@ func TestMetricsBuilder(t *testing.T) {
 			validatedMetrics := make(map[string]bool)
 			for i := 0; i < ms.Len(); i++ {
 				switch ms.At(i).Name() {
+				case "oracledb.active_sessions":
+					assert.False(t, validatedMetrics["oracledb.active_sessions"], "Found a duplicate in the metrics slice: oracledb.active_sessions")
+					validatedMetrics["oracledb.active_sessions"] = true
+					assert.Equal(t, pmetric.MetricTypeGauge, ms.At(i).Type())
+					assert.Equal(t, 1, ms.At(i).Gauge().DataPoints().Len())
+					assert.Equal(t, "Average number of active user sessions over the collection interval, sampled from v$session.", ms.At(i).Description())
+					assert.Equal(t, "{sessions}", ms.At(i).Unit())
+					dp := ms.At(i).Gauge().DataPoints().At(0)
+					assert.Equal(t, start, dp.StartTimestamp())
+					assert.Equal(t, ts, dp.Timestamp())
+					assert.Equal(t, pmetric.NumberDataPointValueTypeDouble, dp.ValueType())
+					assert.Equal(t, float64(1), dp.DoubleValue())
+					attrVal, ok := dp.Attributes().Get("wait_class")
+					assert.True(t, ok)
+					assert.EqualValues(t, "wait_class-val", attrVal.Str())
 				case "oracledb.consistent_gets":
 					assert.False(t, validatedMetrics["oracledb.consistent_gets"], "Found a duplicate in the metrics slice: oracledb.consistent_gets")
 					validatedMetrics["oracledb.consistent_gets"] = true
@@ -508,6 +529,24 @@ func TestMetricsBuilder(t *testing.T) {
 					attrVal, ok := dp.Attributes().Get("tablespace_name")
 					assert.True(t, ok)
 					assert.EqualValues(t, "tablespace_name-val", attrVal.Str())
+				case "oracledb.top_sql.active_sessions":
+					assert.False(t, validatedMetrics["oracledb.top_sql.active_sessions"], "Found a duplicate in the metrics slice: oracledb.top_sql.active_sessions")
+					validatedMetrics["oracledb.top_sql.active_sessions"] = true
+					assert.Equal(t, pmetric.MetricTypeGauge, ms.At(i).Type())
+					assert.Equal(t, 1, ms.At(i).Gauge().DataPoints().Len())
+					assert.Equal(t, "Average number of active user sessions over the collection interval executing the statements with the most active sessions, sampled from v$session.", ms.At(i).Description())
+					assert.Equal(t, "{sessions}", ms.At(i).Unit())
+					dp := ms.At(i).Gauge().DataPoints().At(0)
+					assert.Equal(t, start, dp.StartTimestamp())
+					assert.Equal(t, ts, dp.Timestamp())
+					assert.Equal(t, pmetric.NumberDataPointValueTypeDouble, dp.ValueType())
+					assert.Equal(t, float64(1), dp.DoubleValue())
+					attrVal, ok := dp.Attributes().Get("sql_id")
+					assert.True(t, ok)
+					assert.EqualValues(t, "sql_id-val", attrVal.Str())
+					attrVal, ok = dp.Attributes().Get("sql_text")
+					assert.True(t, ok)
+					assert.EqualValues(t, "sql_text-val", attrVal.Str())
 				case "oracledb.transactions.limit":
 					assert.False(t, validatedMetrics["oracledb.transactions.limit"], "Found a duplicate in the metrics slice: oracledb.transactions.limit")
 					validatedMetrics["oracledb.transactions.limit"] = true
@@ -8,6 +8,7 @@ import (
 	"database/sql"
 	"fmt"
 	"strconv"
+	"sync"
 	"time"
 
 	"go.opentelemetry.io/collector/component"
@@ -53,6 +54,7 @@ type scraper struct {
 	tablespaceUsageClient      dbClient
 	systemResourceLimitsClient dbClient
 	sessionCountClient         dbClient
+	activeSessionsClient       dbClient
 	db                         *sql.DB
 	clientProviderFunc         clientProviderFunc
 	mb                         *metadata.MetricsBuilder
@@ -63,10 +65,16 @@ type scraper struct {
 	scrapeCfg                  scraperhelper.ControllerConfig
 	startTime                  pcommon.Timestamp
 	metricsBuilderConfig       metadata.MetricsBuilderConfig
+	activeSessionsCfg          ActiveSessionsConfig
+	activeSessions             *activeSessionSamples
+	samplingMutex              sync.Mutex
+	stopSampling               context.CancelFunc
+	samplingWg                 sync.WaitGroup
 }
 
-func newScraper(metricsBuilder *metadata.MetricsBuilder, metricsBuilderConfig metadata.MetricsBuilderConfig, scrapeCfg scraperhelper.ControllerConfig, logger *zap.Logger, providerFunc dbProviderFunc, clientProviderFunc clientProviderFunc, instanceName string) (scraperhelper.Scraper, error) {
+func newScraper(metricsBuilder *metadata.MetricsBuilder, metricsBuilderConfig metadata.MetricsBuilderConfig, scrapeCfg scraperhelper.ControllerConfig, logger *zap.Logger, providerFunc dbProviderFunc, clientProviderFunc clientProviderFunc, instanceName string, activeSessionsCfg ActiveSessionsConfig) (scraperhelper.Scraper, error) {
 	s := &scraper{
+		activeSessionsCfg:    activeSessionsCfg,
 		mb:                   metricsBuilder,
 		metricsBuilderConfig: metricsBuilderConfig,
 		scrapeCfg:            scrapeCfg,
@@ -89,9 +97,49 @@ func (s *scraper) start(context.Context, component.Host) error {
 	s.sessionCountClient = s.clientProviderFunc(s.db, sessionCountSQL, s.logger)
 	s.systemResourceLimitsClient = s.clientProviderFunc(s.db, systemResourceLimitsSQL, s.logger)
 	s.tablespaceUsageClient = s.clientProviderFunc(s.db, tablespaceUsageSQL, s.logger)
+	if s.metricsBuilderConfig.Metrics.OracledbActiveSessions.Enabled ||
+		s.metricsBuilderConfig.Metrics.OracledbTopSQLActiveSessions.Enabled {
+		s.activeSessionsClient = s.clientProviderFunc(s.db, activeSessionsSQL, s.logger)
+		s.activeSessions = newActiveSessionSamples()
+		s.startSampling()
+	}
 	return nil
 }
 
+// startSampling samples the active sessions in the background, so that the metrics reflect the activity over the
+// whole collection interval rather than at the time of the collection.
+func (s *scraper) startSampling() {
+	ctx, cancel := context.WithCancel(context.Background())
+	s.stopSampling = cancel
+	s.samplingWg.Add(1)
+	go func() {
+		defer s.samplingWg.Done()
+		ticker := time.NewTicker(s.activeSessionsCfg.SamplingInterval)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+				if err := s.sampleActiveSessions(ctx); err != nil {
+					s.logger.Debug("Failed to sample the active sessions", zap.Error(err))
+				}
+			}
+		}
+	}()
+}
+
+func (s *scraper) sampleActiveSessions(ctx context.Context) error {
+	s.samplingMutex.Lock()
+	defer s.samplingMutex.Unlock()
+
+	rows, err := s.activeSessionsClient.metricRows(ctx)
+	if err != nil {
+		return fmt.Errorf("error executing %s: %w", activeSessionsSQL, err)
+	}
+	return s.activeSessions.add(rows)
+}
+
 func (s *scraper) scrape(ctx context.Context) (pmetric.Metrics, error) {
 	s.logger.Debug("Begin scrape")
 
@@ -321,6 +369,25 @@ func (s *scraper) scrape(ctx context.Context) (pmetric.Metrics, error) {
 		}
 	}
 
+	if s.activeSessions != nil {
+		// sample at least once per collection, in case the sampling interval is longer than the collection interval
+		if s.activeSessions.count() == 0 {
+			if err := s.sampleActiveSessions(ctx); err != nil {
+				scrapeErrors = append(scrapeErrors, err)
+			}
+		}
+		now := pcommon.NewTimestampFromTime(time.Now())
+		waitClasses, statements, samples := s.activeSessions.flush(s.activeSessionsCfg.TopSQL)
+		if samples > 0 {
+			for waitClass, sessions := range waitClasses {
+				s.mb.RecordOracledbActiveSessionsDataPoint(now, sessions, waitClass)
+			}
+			for _, statement := range statements {
+				s.mb.RecordOracledbTopSQLActiveSessionsDataPoint(now, float64(statement.sessions)/samples, statement.sqlID, obfuscateSQL(statement.sqlText))
+			}
+		}
+	}
+
 	rb := s.mb.NewResourceBuilder()
 	rb.SetOracledbInstanceName(s.instanceName)
 	out := s.mb.Emit(metadata.WithResource(rb.Emit()))
@@ -332,6 +399,10 @@ func (s *scraper) scrape(ctx context.Context) (pmetric.Metrics, error) {
 }
 
 func (s *scraper) shutdown(_ context.Context) error {
+	if s.stopSampling != nil {
+		s.stopSampling()
+		s.samplingWg.Wait()
+	}
 	if s.db == nil {
 		return nil
 	}
@@ -8,6 +8,7 @@ import (
 	"database/sql"
 	"errors"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -167,3 +168,66 @@ func TestScraper_Scrape(t *testing.T) {
 	}
 
 }
+
+func TestScraper_ScrapeActiveSessions(t *testing.T) {
+	cfg := metadata.DefaultMetricsBuilderConfig()
+	cfg.Metrics.OracledbActiveSessions.Enabled = true
+	cfg.Metrics.OracledbTopSQLActiveSessions.Enabled = true
+
+	samples := &fakeDbClient{Responses: [][]metricRow{
+		{
+			{"WAIT_CLASS": "CPU", "SQL_ID": "a1b2c3", "SQL_TEXT": "select * from orders where id = 42", "VALUE": "2"},
+			{"WAIT_CLASS": "User I/O", "SQL_ID": "d4e5f6", "SQL_TEXT": "update orders set status = 'shipped'", "VALUE": "1"},
+		},
+		{
+			{"WAIT_CLASS": "CPU", "SQL_ID": "a1b2c3", "SQL_TEXT": "select * from orders where id = 42", "VALUE": "1"},
+		},
+	}}
+	scrpr := scraper{
+		logger: zap.NewNop(),
+		mb:     metadata.NewMetricsBuilder(cfg, receivertest.NewNopCreateSettings()),
+		dbProviderFunc: func() (*sql.DB, error) {
+			return nil, nil
+		},
+		clientProviderFunc: func(_ *sql.DB, s string, _ *zap.Logger) dbClient {
+			if s == activeSessionsSQL {
+				return samples
+			}
+			return &fakeDbClient{Responses: [][]metricRow{queryResponses[s]}}
+		},
+		metricsBuilderConfig: cfg,
+		activeSessionsCfg:    ActiveSessionsConfig{SamplingInterval: time.Hour, TopSQL: 1},
+	}
+	require.NoError(t, scrpr.start(context.Background(), componenttest.NewNopHost()))
+	defer func() {
+		assert.NoError(t, scrpr.shutdown(context.Background()))
+	}()
+	require.NoError(t, scrpr.sampleActiveSessions(context.Background()))
+	require.NoError(t, scrpr.sampleActiveSessions(context.Background()))
+
+	m, err := scrpr.scrape(context.Background())
+	require.NoError(t, err)
+
+	metrics := m.ResourceMetrics().At(0).ScopeMetrics().At(0).Metrics()
+	waitClasses := map[string]float64{}
+	var topSQL pmetric.NumberDataPointSlice
+	for i := 0; i < metrics.Len(); i++ {
+		switch metrics.At(i).Name() {
+		case "oracledb.active_sessions":
+			dps := metrics.At(i).Gauge().DataPoints()
+			for j := 0; j < dps.Len(); j++ {
+				waitClass, _ := dps.At(j).Attributes().Get("wait_class")
+				waitClasses[waitClass.Str()] = dps.At(j).DoubleValue()
+			}
+		case "oracledb.top_sql.active_sessions":
+			topSQL = metrics.At(i).Gauge().DataPoints()
+		}
+	}
+	assert.Equal(t, map[string]float64{"CPU": 1.5, "User I/O": 0.5}, waitClasses)
+	require.Equal(t, 1, topSQL.Len())
+	assert.Equal(t, 1.5, topSQL.At(0).DoubleValue())
+	sqlID, _ := topSQL.At(0).Attributes().Get("sql_id")
+	assert.Equal(t, "a1b2c3", sqlID.Str())
+	sqlText, _ := topSQL.At(0).Attributes().Get("sql_text")
+	assert.Equal(t, "select * from orders where id = ?", sqlText.Str())
+}
@@ -9,6 +9,8 @@ import (
 	"go.opentelemetry.io/collector/consumer"
 	"go.opentelemetry.io/collector/pdata/plog/plogotlp"
 	"go.opentelemetry.io/collector/receiver/receiverhelper"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/internal/partialsuccess"
 )
 
 const dataFormatProtobuf = "protobuf"
@@ -37,10 +39,17 @@ func (r *Receiver) Export(ctx context.Context, req plogotlp.ExportRequest) (plog
 	}
 
 	ctx = r.obsrecv.StartLogsOp(ctx)
+	ctx, recorder := partialsuccess.NewContext(ctx)
 	err := r.nextConsumer.ConsumeLogs(ctx, ld)
 	r.obsrecv.EndLogsOp(ctx, dataFormatProtobuf, numSpans, err)
 
-	return plogotlp.NewExportResponse(), err
+	resp := plogotlp.NewExportResponse()
+	if err == nil && recorder.Rejected() > 0 {
+		// the items rejected by the processors, e.g. dropped by a filter
+		resp.PartialSuccess().SetRejectedLogRecords(recorder.Rejected())
+		resp.PartialSuccess().SetErrorMessage(recorder.Message())
+	}
+	return resp, err
 }
 
 func (r *Receiver) Consumer() consumer.Logs {
@@ -9,6 +9,8 @@ import (
 	"go.opentelemetry.io/collector/consumer"
 	"go.opentelemetry.io/collector/pdata/pmetric/pmetricotlp"
 	"go.opentelemetry.io/collector/receiver/receiverhelper"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/internal/partialsuccess"
 )
 
 const dataFormatProtobuf = "protobuf"
@@ -37,10 +39,17 @@ func (r *Receiver) Export(ctx context.Context, req pmetricotlp.ExportRequest) (p
 	}
 
 	ctx = r.obsrecv.StartMetricsOp(ctx)
+	ctx, recorder := partialsuccess.NewContext(ctx)
 	err := r.nextConsumer.ConsumeMetrics(ctx, md)
 	r.obsrecv.EndMetricsOp(ctx, dataFormatProtobuf, dataPointCount, err)
 
-	return pmetricotlp.NewExportResponse(), err
+	resp := pmetricotlp.NewExportResponse()
+	if err == nil && recorder.Rejected() > 0 {
+		// the items rejected by the processors, e.g. dropped by a filter
+		resp.PartialSuccess().SetRejectedDataPoints(recorder.Rejected())
+		resp.PartialSuccess().SetErrorMessage(recorder.Message())
+	}
+	return resp, err
 }
 
 func (r *Receiver) Consumer() consumer.Metrics {
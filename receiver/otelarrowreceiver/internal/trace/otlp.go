@@ -9,6 +9,8 @@ import (
 	"go.opentelemetry.io/collector/consumer"
 	"go.opentelemetry.io/collector/pdata/ptrace/ptraceotlp"
 	"go.opentelemetry.io/collector/receiver/receiverhelper"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/internal/partialsuccess"
 )
 
 const dataFormatProtobuf = "protobuf"
@@ -38,10 +40,17 @@ func (r *Receiver) Export(ctx context.Context, req ptraceotlp.ExportRequest) (pt
 	}
 
 	ctx = r.obsrecv.StartTracesOp(ctx)
+	ctx, recorder := partialsuccess.NewContext(ctx)
 	err := r.nextConsumer.ConsumeTraces(ctx, td)
 	r.obsrecv.EndTracesOp(ctx, dataFormatProtobuf, numSpans, err)
 
-	return ptraceotlp.NewExportResponse(), err
+	resp := ptraceotlp.NewExportResponse()
+	if err == nil && recorder.Rejected() > 0 {
+		// the items rejected by the processors, e.g. dropped by a filter
+		resp.PartialSuccess().SetRejectedSpans(recorder.Rejected())
+		resp.PartialSuccess().SetErrorMessage(recorder.Message())
+	}
+	return resp, err
 }
 
 func (r *Receiver) Consumer() consumer.Traces {
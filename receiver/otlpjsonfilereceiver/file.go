@@ -37,6 +37,7 @@ type Config struct {
 	fileconsumer.Config `mapstructure:",squash"`
 	StorageID           *component.ID `mapstructure:"storage"`
 	ReplayFile          bool          `mapstructure:"replay_file"`
+	Replay              ReplayConfig  `mapstructure:"replay"`
 }
 
 func createDefaultConfig() component.Config {
@@ -78,6 +79,7 @@ func createLogsReceiver(_ context.Context, settings receiver.CreateSettings, con
 	if cfg.ReplayFile {
 		opts = append(opts, fileconsumer.WithNoTracking())
 	}
+	replay := newReplayer(cfg.Replay)
 	input, err := cfg.Config.Build(settings.TelemetrySettings, func(ctx context.Context, token []byte, _ map[string]any) error {
 		ctx = obsrecv.StartLogsOp(ctx)
 		var l plog.Logs
@@ -87,7 +89,9 @@ func createLogsReceiver(_ context.Context, settings receiver.CreateSettings, con
 		} else {
 			logRecordCount := l.LogRecordCount()
 			if logRecordCount != 0 {
-				err = logs.ConsumeLogs(ctx, l)
+				if err = replay.replayLogs(ctx, l); err == nil {
+					err = logs.ConsumeLogs(ctx, l)
+				}
 			}
 			obsrecv.EndLogsOp(ctx, metadata.Type.String(), logRecordCount, err)
 		}
@@ -115,6 +119,7 @@ func createMetricsReceiver(_ context.Context, settings receiver.CreateSettings,
 	if cfg.ReplayFile {
 		opts = append(opts, fileconsumer.WithNoTracking())
 	}
+	replay := newReplayer(cfg.Replay)
 	input, err := cfg.Config.Build(settings.TelemetrySettings, func(ctx context.Context, token []byte, _ map[string]any) error {
 		ctx = obsrecv.StartMetricsOp(ctx)
 		var m pmetric.Metrics
@@ -123,7 +128,9 @@ func createMetricsReceiver(_ context.Context, settings receiver.CreateSettings,
 			obsrecv.EndMetricsOp(ctx, metadata.Type.String(), 0, err)
 		} else {
 			if m.ResourceMetrics().Len() != 0 {
-				err = metrics.ConsumeMetrics(ctx, m)
+				if err = replay.replayMetrics(ctx, m); err == nil {
+					err = metrics.ConsumeMetrics(ctx, m)
+				}
 			}
 			obsrecv.EndMetricsOp(ctx, metadata.Type.String(), m.MetricCount(), err)
 		}
@@ -151,6 +158,7 @@ func createTracesReceiver(_ context.Context, settings receiver.CreateSettings, c
 	if cfg.ReplayFile {
 		opts = append(opts, fileconsumer.WithNoTracking())
 	}
+	replay := newReplayer(cfg.Replay)
 	input, err := cfg.Config.Build(settings.TelemetrySettings, func(ctx context.Context, token []byte, _ map[string]any) error {
 		ctx = obsrecv.StartTracesOp(ctx)
 		var t ptrace.Traces
@@ -159,7 +167,9 @@ func createTracesReceiver(_ context.Context, settings receiver.CreateSettings, c
 			obsrecv.EndTracesOp(ctx, metadata.Type.String(), 0, err)
 		} else {
 			if t.ResourceSpans().Len() != 0 {
-				err = traces.ConsumeTraces(ctx, t)
+				if err = replay.replayTraces(ctx, t); err == nil {
+					err = traces.ConsumeTraces(ctx, t)
+				}
 			}
 			obsrecv.EndTracesOp(ctx, metadata.Type.String(), t.SpanCount(), err)
 		}
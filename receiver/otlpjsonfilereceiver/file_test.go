@@ -127,6 +127,39 @@ func TestFileMetricsReceiverWithReplay(t *testing.T) {
 	assert.NoError(t, err)
 }
 
+func TestFileTracesReceiverReplayShiftsTimestamps(t *testing.T) {
+	tempFolder := t.TempDir()
+	factory := NewFactory()
+	cfg := createDefaultConfig().(*Config)
+	cfg.Config.Include = []string{filepath.Join(tempFolder, "*")}
+	cfg.Config.StartAt = "beginning"
+	cfg.Replay = ReplayConfig{Timestamps: timestampsNow, Rate: 10}
+	sink := new(consumertest.TracesSink)
+	receiver, err := factory.CreateTracesReceiver(context.Background(), receivertest.NewNopCreateSettings(), cfg, sink)
+	assert.NoError(t, err)
+	err = receiver.Start(context.Background(), nil)
+	require.NoError(t, err)
+
+	td := testdata.GenerateTraces(2)
+	marshaler := &ptrace.JSONMarshaler{}
+	b, err := marshaler.MarshalTraces(td)
+	assert.NoError(t, err)
+	b = append(append(b, '\n'), append(b, '\n')...)
+	start := time.Now()
+	err = os.WriteFile(filepath.Join(tempFolder, "traces.json"), b, 0600)
+	assert.NoError(t, err)
+
+	require.Eventually(t, func() bool { return len(sink.AllTraces()) == 2 }, 5*time.Second, 50*time.Millisecond)
+	for _, traces := range sink.AllTraces() {
+		span := traces.ResourceSpans().At(0).ScopeSpans().At(0).Spans().At(0)
+		assert.False(t, span.EndTimestamp().AsTime().Before(start))
+		recorded := td.ResourceSpans().At(0).ScopeSpans().At(0).Spans().At(0)
+		assert.Equal(t, recorded.EndTimestamp()-recorded.StartTimestamp(), span.EndTimestamp()-span.StartTimestamp())
+	}
+	err = receiver.Shutdown(context.Background())
+	assert.NoError(t, err)
+}
+
 func TestFileLogsReceiver(t *testing.T) {
 	tempFolder := t.TempDir()
 	factory := NewFactory()
@@ -191,6 +224,20 @@ func TestLoadConfig(t *testing.T) {
 	assert.Equal(t, testdataConfigYamlAsMap(), cfg)
 }
 
+func TestLoadReplayConfig(t *testing.T) {
+	cm, err := confmaptest.LoadConf(filepath.Join("testdata", "config.yaml"))
+	require.NoError(t, err)
+	cfg := NewFactory().CreateDefaultConfig().(*Config)
+
+	sub, err := cm.Sub(component.NewIDWithName(metadata.Type, "replay").String())
+	require.NoError(t, err)
+	require.NoError(t, sub.Unmarshal(cfg))
+
+	assert.True(t, cfg.ReplayFile)
+	assert.Equal(t, ReplayConfig{Timestamps: timestampsNow, Rate: 100}, cfg.Replay)
+	assert.NoError(t, component.ValidateConfig(cfg))
+}
+
 func TestFileMixedSignals(t *testing.T) {
 	tempFolder := t.TempDir()
 	factory := NewFactory()
@@ -0,0 +1,251 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package otlpjsonfilereceiver // import "github.com/open-telemetry/opentelemetry-collector-contrib/receiver/otlpjsonfilereceiver"
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+
+	"go.opentelemetry.io/collector/pdata/pcommon"
+	"go.opentelemetry.io/collector/pdata/plog"
+	"go.opentelemetry.io/collector/pdata/pmetric"
+	"go.opentelemetry.io/collector/pdata/ptrace"
+)
+
+const (
+	// timestampsOriginal preserves the recorded timestamps
+	timestampsOriginal = "original"
+	// timestampsNow shifts the timestamps of each replayed payload so its latest timestamp is the time it is replayed
+	timestampsNow = "now"
+)
+
+var errNegativeRate = errors.New("replay rate cannot be negative")
+
+// ReplayConfig defines how recorded data is emitted again, e.g. to load test pipelines with captured traffic.
+type ReplayConfig struct {
+	// Timestamps is either "original" to preserve recorded timestamps or "now" to shift them to the time data is replayed.
+	Timestamps string `mapstructure:"timestamps"`
+	// Rate is the maximum number of payloads, i.e. lines of the files, emitted per second. Unlimited when 0.
+	Rate float64 `mapstructure:"rate"`
+}
+
+// Validate checks the replay configuration is valid
+func (cfg *ReplayConfig) Validate() error {
+	switch cfg.Timestamps {
+	case "", timestampsOriginal, timestampsNow:
+	default:
+		return fmt.Errorf("invalid replay timestamps %q, must be %q or %q", cfg.Timestamps, timestampsOriginal, timestampsNow)
+	}
+	if cfg.Rate < 0 {
+		return errNegativeRate
+	}
+	return nil
+}
+
+// replayer applies the replay configuration to the payloads read from files
+type replayer struct {
+	shift bool
+	now   func() time.Time
+
+	mu       sync.Mutex
+	interval time.Duration
+	next     time.Time
+}
+
+func newReplayer(cfg ReplayConfig) *replayer {
+	r := &replayer{shift: cfg.Timestamps == timestampsNow, now: time.Now}
+	if cfg.Rate > 0 {
+		r.interval = time.Duration(float64(time.Second) / cfg.Rate)
+	}
+	return r
+}
+
+// wait blocks until the next payload can be emitted at the configured rate
+func (r *replayer) wait(ctx context.Context) error {
+	if r.interval == 0 {
+		return nil
+	}
+	r.mu.Lock()
+	now := r.now()
+	if r.next.Before(now) {
+		r.next = now
+	}
+	delay := r.next.Sub(now)
+	r.next = r.next.Add(r.interval)
+	r.mu.Unlock()
+
+	if delay <= 0 {
+		return nil
+	}
+	timer := time.NewTimer(delay)
+	defer timer.Stop()
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-timer.C:
+		return nil
+	}
+}
+
+func (r *replayer) replayLogs(ctx context.Context, logs plog.Logs) error {
+	if err := r.wait(ctx); err != nil {
+		return err
+	}
+	if r.shift {
+		shiftLogs(logs, r.now())
+	}
+	return nil
+}
+
+func (r *replayer) replayMetrics(ctx context.Context, metrics pmetric.Metrics) error {
+	if err := r.wait(ctx); err != nil {
+		return err
+	}
+	if r.shift {
+		shiftMetrics(metrics, r.now())
+	}
+	return nil
+}
+
+func (r *replayer) replayTraces(ctx context.Context, traces ptrace.Traces) error {
+	if err := r.wait(ctx); err != nil {
+		return err
+	}
+	if r.shift {
+		shiftTraces(traces, r.now())
+	}
+	return nil
+}
+
+// timestampShifter collects the timestamps of a payload to shift them all by the same offset,
+// which preserves durations and ordering within the payload
+type timestampShifter struct {
+	getters []func() pcommon.Timestamp
+	setters []func(pcommon.Timestamp)
+	latest  pcommon.Timestamp
+}
+
+func (s *timestampShifter) add(get func() pcommon.Timestamp, set func(pcommon.Timestamp)) {
+	// unset timestamps stay unset
+	if get() == 0 {
+		return
+	}
+	if get() > s.latest {
+		s.latest = get()
+	}
+	s.getters = append(s.getters, get)
+	s.setters = append(s.setters, set)
+}
+
+func (s *timestampShifter) shift(now time.Time) {
+	if s.latest == 0 {
+		return
+	}
+	offset := now.UnixNano() - int64(s.latest)
+	for i, get := range s.getters {
+		s.setters[i](pcommon.Timestamp(int64(get()) + offset))
+	}
+}
+
+func shiftLogs(logs plog.Logs, now time.Time) {
+	s := &timestampShifter{}
+	for i := 0; i < logs.ResourceLogs().Len(); i++ {
+		scopeLogs := logs.ResourceLogs().At(i).ScopeLogs()
+		for j := 0; j < scopeLogs.Len(); j++ {
+			records := scopeLogs.At(j).LogRecords()
+			for k := 0; k < records.Len(); k++ {
+				record := records.At(k)
+				s.add(record.Timestamp, record.SetTimestamp)
+				s.add(record.ObservedTimestamp, record.SetObservedTimestamp)
+			}
+		}
+	}
+	s.shift(now)
+}
+
+func shiftTraces(traces ptrace.Traces, now time.Time) {
+	s := &timestampShifter{}
+	for i := 0; i < traces.ResourceSpans().Len(); i++ {
+		scopeSpans := traces.ResourceSpans().At(i).ScopeSpans()
+		for j := 0; j < scopeSpans.Len(); j++ {
+			spans := scopeSpans.At(j).Spans()
+			for k := 0; k < spans.Len(); k++ {
+				span := spans.At(k)
+				s.add(span.StartTimestamp, span.SetStartTimestamp)
+				s.add(span.EndTimestamp, span.SetEndTimestamp)
+				for l := 0; l < span.Events().Len(); l++ {
+					event := span.Events().At(l)
+					s.add(event.Timestamp, event.SetTimestamp)
+				}
+			}
+		}
+	}
+	s.shift(now)
+}
+
+// dataPoint is implemented by the data points of all metric types
+type dataPoint interface {
+	StartTimestamp() pcommon.Timestamp
+	SetStartTimestamp(pcommon.Timestamp)
+	Timestamp() pcommon.Timestamp
+	SetTimestamp(pcommon.Timestamp)
+}
+
+func shiftMetrics(metrics pmetric.Metrics, now time.Time) {
+	s := &timestampShifter{}
+	addDataPoint := func(dp dataPoint) {
+		s.add(dp.StartTimestamp, dp.SetStartTimestamp)
+		s.add(dp.Timestamp, dp.SetTimestamp)
+	}
+	addExemplars := func(exemplars pmetric.ExemplarSlice) {
+		for i := 0; i < exemplars.Len(); i++ {
+			exemplar := exemplars.At(i)
+			s.add(exemplar.Timestamp, exemplar.SetTimestamp)
+		}
+	}
+	for i := 0; i < metrics.ResourceMetrics().Len(); i++ {
+		scopeMetrics := metrics.ResourceMetrics().At(i).ScopeMetrics()
+		for j := 0; j < scopeMetrics.Len(); j++ {
+			ms := scopeMetrics.At(j).Metrics()
+			for k := 0; k < ms.Len(); k++ {
+				metric := ms.At(k)
+				switch metric.Type() {
+				case pmetric.MetricTypeGauge:
+					for l := 0; l < metric.Gauge().DataPoints().Len(); l++ {
+						dp := metric.Gauge().DataPoints().At(l)
+						addDataPoint(dp)
+						addExemplars(dp.Exemplars())
+					}
+				case pmetric.MetricTypeSum:
+					for l := 0; l < metric.Sum().DataPoints().Len(); l++ {
+						dp := metric.Sum().DataPoints().At(l)
+						addDataPoint(dp)
+						addExemplars(dp.Exemplars())
+					}
+				case pmetric.MetricTypeHistogram:
+					for l := 0; l < metric.Histogram().DataPoints().Len(); l++ {
+						dp := metric.Histogram().DataPoints().At(l)
+						addDataPoint(dp)
+						addExemplars(dp.Exemplars())
+					}
+				case pmetric.MetricTypeExponentialHistogram:
+					for l := 0; l < metric.ExponentialHistogram().DataPoints().Len(); l++ {
+						dp := metric.ExponentialHistogram().DataPoints().At(l)
+						addDataPoint(dp)
+						addExemplars(dp.Exemplars())
+					}
+				case pmetric.MetricTypeSummary:
+					for l := 0; l < metric.Summary().DataPoints().Len(); l++ {
+						addDataPoint(metric.Summary().DataPoints().At(l))
+					}
+				case pmetric.MetricTypeEmpty:
+				}
+			}
+		}
+	}
+	s.shift(now)
+}
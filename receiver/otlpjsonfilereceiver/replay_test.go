@@ -0,0 +1,109 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package otlpjsonfilereceiver // import "github.com/open-telemetry/opentelemetry-collector-contrib/receiver/otlpjsonfilereceiver"
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/collector/pdata/pcommon"
+	"go.opentelemetry.io/collector/pdata/plog"
+	"go.opentelemetry.io/collector/pdata/pmetric"
+	"go.opentelemetry.io/collector/pdata/ptrace"
+)
+
+var recordedAt = time.Date(2024, 1, 2, 3, 4, 5, 0, time.UTC)
+
+func TestReplayConfigValidate(t *testing.T) {
+	assert.NoError(t, (&ReplayConfig{}).Validate())
+	assert.NoError(t, (&ReplayConfig{Timestamps: timestampsOriginal, Rate: 10}).Validate())
+	assert.NoError(t, (&ReplayConfig{Timestamps: timestampsNow}).Validate())
+	assert.EqualError(t, (&ReplayConfig{Timestamps: "later"}).Validate(), `invalid replay timestamps "later", must be "original" or "now"`)
+	assert.ErrorIs(t, (&ReplayConfig{Rate: -1}).Validate(), errNegativeRate)
+}
+
+func TestReplayOriginalTimestamps(t *testing.T) {
+	logs := plog.NewLogs()
+	record := logs.ResourceLogs().AppendEmpty().ScopeLogs().AppendEmpty().LogRecords().AppendEmpty()
+	record.SetTimestamp(pcommon.NewTimestampFromTime(recordedAt))
+
+	require.NoError(t, newReplayer(ReplayConfig{}).replayLogs(context.Background(), logs))
+	assert.Equal(t, recordedAt, record.Timestamp().AsTime())
+}
+
+func TestReplayShiftLogs(t *testing.T) {
+	logs := plog.NewLogs()
+	records := logs.ResourceLogs().AppendEmpty().ScopeLogs().AppendEmpty().LogRecords()
+	first := records.AppendEmpty()
+	first.SetTimestamp(pcommon.NewTimestampFromTime(recordedAt))
+	first.SetObservedTimestamp(pcommon.NewTimestampFromTime(recordedAt.Add(time.Second)))
+	second := records.AppendEmpty()
+	second.SetObservedTimestamp(pcommon.NewTimestampFromTime(recordedAt.Add(2 * time.Second)))
+
+	now := time.Now()
+	r := newReplayer(ReplayConfig{Timestamps: timestampsNow})
+	r.now = func() time.Time { return now }
+	require.NoError(t, r.replayLogs(context.Background(), logs))
+
+	assert.Equal(t, now.Add(-2*time.Second).UnixNano(), int64(first.Timestamp()))
+	assert.Equal(t, now.Add(-time.Second).UnixNano(), int64(first.ObservedTimestamp()))
+	assert.Zero(t, second.Timestamp())
+	assert.Equal(t, now.UnixNano(), int64(second.ObservedTimestamp()))
+}
+
+func TestReplayShiftTraces(t *testing.T) {
+	traces := ptrace.NewTraces()
+	span := traces.ResourceSpans().AppendEmpty().ScopeSpans().AppendEmpty().Spans().AppendEmpty()
+	span.SetStartTimestamp(pcommon.NewTimestampFromTime(recordedAt))
+	span.SetEndTimestamp(pcommon.NewTimestampFromTime(recordedAt.Add(time.Second)))
+	event := span.Events().AppendEmpty()
+	event.SetTimestamp(pcommon.NewTimestampFromTime(recordedAt.Add(500 * time.Millisecond)))
+
+	now := time.Now()
+	shiftTraces(traces, now)
+
+	assert.Equal(t, now.Add(-time.Second).UnixNano(), int64(span.StartTimestamp()))
+	assert.Equal(t, now.UnixNano(), int64(span.EndTimestamp()))
+	assert.Equal(t, now.Add(-500*time.Millisecond).UnixNano(), int64(event.Timestamp()))
+}
+
+func TestReplayShiftMetrics(t *testing.T) {
+	metrics := pmetric.NewMetrics()
+	ms := metrics.ResourceMetrics().AppendEmpty().ScopeMetrics().AppendEmpty().Metrics()
+	sum := ms.AppendEmpty().SetEmptySum().DataPoints().AppendEmpty()
+	sum.SetStartTimestamp(pcommon.NewTimestampFromTime(recordedAt))
+	sum.SetTimestamp(pcommon.NewTimestampFromTime(recordedAt.Add(time.Minute)))
+	exemplar := sum.Exemplars().AppendEmpty()
+	exemplar.SetTimestamp(pcommon.NewTimestampFromTime(recordedAt.Add(30 * time.Second)))
+	summary := ms.AppendEmpty().SetEmptySummary().DataPoints().AppendEmpty()
+	summary.SetTimestamp(pcommon.NewTimestampFromTime(recordedAt.Add(time.Minute)))
+
+	now := time.Now()
+	shiftMetrics(metrics, now)
+
+	assert.Equal(t, now.Add(-time.Minute).UnixNano(), int64(sum.StartTimestamp()))
+	assert.Equal(t, now.UnixNano(), int64(sum.Timestamp()))
+	assert.Equal(t, now.Add(-30*time.Second).UnixNano(), int64(exemplar.Timestamp()))
+	assert.Zero(t, summary.StartTimestamp())
+	assert.Equal(t, now.UnixNano(), int64(summary.Timestamp()))
+}
+
+func TestReplayRate(t *testing.T) {
+	r := newReplayer(ReplayConfig{Rate: 20})
+	start := time.Now()
+	for i := 0; i < 5; i++ {
+		require.NoError(t, r.wait(context.Background()))
+	}
+	// the first payload is emitted immediately, the next ones every 50ms
+	assert.GreaterOrEqual(t, time.Since(start), 200*time.Millisecond)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	r = newReplayer(ReplayConfig{Rate: 0.1})
+	require.NoError(t, r.wait(ctx))
+	assert.ErrorIs(t, r.wait(ctx), context.Canceled)
+}
@@ -38,6 +38,20 @@ type Config struct {
 	ReportExtraScrapeMetrics bool `mapstructure:"report_extra_scrape_metrics"`
 
 	TargetAllocator *TargetAllocator `mapstructure:"target_allocator"`
+
+	// NativeHistograms configures the scraping of Prometheus native histograms.
+	NativeHistograms NativeHistogramsConfig `mapstructure:"native_histograms"`
+}
+
+// NativeHistogramsConfig configures the scraping of Prometheus native histograms.
+type NativeHistogramsConfig struct {
+	// Enabled converts the Prometheus native histograms to OpenTelemetry exponential histograms, as does
+	// the receiver.prometheusreceiver.EnableNativeHistograms feature gate. The targets are asked for the
+	// protobuf format first, unless scrape_protocols is configured, as it is the only one exposing native histograms.
+	Enabled bool `mapstructure:"enabled"`
+	// PreferNative keeps the native buckets of the histograms exposing both native and classic buckets.
+	// When false, the classic buckets are kept instead.
+	PreferNative bool `mapstructure:"prefer_native"`
 }
 
 // Validate checks the receiver configuration is valid.
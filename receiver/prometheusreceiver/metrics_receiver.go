@@ -14,6 +14,7 @@ import (
 	"os"
 	"reflect"
 	"regexp"
+	"slices"
 	"sync"
 	"time"
 	"unsafe"
@@ -240,12 +241,7 @@ func (r *pReceiver) getScrapeConfigsResponse(baseURL string) (map[string]*config
 }
 
 func (r *pReceiver) applyCfg(cfg *PromConfig) error {
-	if !enableNativeHistogramsGate.IsEnabled() {
-		// Enforce scraping classic histograms to avoid dropping them.
-		for _, scrapeConfig := range cfg.ScrapeConfigs {
-			scrapeConfig.ScrapeClassicHistograms = true
-		}
-	}
+	r.configureHistograms(cfg)
 
 	if err := r.scrapeManager.ApplyConfig((*config.Config)(cfg)); err != nil {
 		return err
@@ -259,6 +255,37 @@ func (r *pReceiver) applyCfg(cfg *PromConfig) error {
 	return r.discoveryManager.ApplyConfig(discoveryCfg)
 }
 
+// nativeHistogramsEnabled returns true if native histograms are converted to exponential histograms.
+func (r *pReceiver) nativeHistogramsEnabled() bool {
+	return enableNativeHistogramsGate.IsEnabled() || r.cfg.NativeHistograms.Enabled
+}
+
+// configureHistograms sets how the histograms of the scrape configs are scraped.
+func (r *pReceiver) configureHistograms(cfg *PromConfig) {
+	nativeHistograms := r.nativeHistogramsEnabled()
+	for _, scrapeConfig := range cfg.ScrapeConfigs {
+		if !nativeHistograms {
+			// Enforce scraping classic histograms to avoid dropping them.
+			scrapeConfig.ScrapeClassicHistograms = true
+			continue
+		}
+		// Native histograms are only exposed in the protobuf format, negotiate it first unless
+		// the scrape protocols were configured.
+		protocols := scrapeConfig.ScrapeProtocols
+		if protocols == nil {
+			protocols = cfg.GlobalConfig.ScrapeProtocols
+		}
+		if slices.Equal(protocols, config.DefaultScrapeProtocols) {
+			protocols = config.DefaultProtoFirstScrapeProtocols
+		}
+		scrapeConfig.ScrapeProtocols = protocols
+		if !r.cfg.NativeHistograms.PreferNative {
+			// Scraping the classic buckets makes them preferred over the native buckets.
+			scrapeConfig.ScrapeClassicHistograms = true
+		}
+	}
+}
+
 func (r *pReceiver) initPrometheusComponents(ctx context.Context, logger log.Logger) error {
 	// Some SD mechanisms use the "refresh" package, which has its own metrics.
 	refreshSdMetrics := discovery.NewRefreshMetrics(r.registerer)
@@ -298,7 +325,7 @@ func (r *pReceiver) initPrometheusComponents(ctx context.Context, logger log.Log
 		r.cfg.UseStartTimeMetric,
 		startTimeMetricRegex,
 		useCreatedMetricGate.IsEnabled(),
-		enableNativeHistogramsGate.IsEnabled(),
+		r.nativeHistogramsEnabled(),
 		r.cfg.PrometheusConfig.GlobalConfig.ExternalLabels,
 		r.cfg.TrimMetricSuffixes,
 	)
@@ -653,6 +653,9 @@ func testComponent(t *testing.T, targets []*testData, alterConfig func(*Config),
 	config := &Config{
 		PrometheusConfig:     cfg,
 		StartTimeMetricRegex: "",
+		NativeHistograms: NativeHistogramsConfig{
+			PreferNative: true,
+		},
 	}
 	if alterConfig != nil {
 		alterConfig(config)
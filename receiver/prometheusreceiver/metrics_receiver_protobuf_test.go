@@ -262,6 +262,7 @@ func TestNativeVsClassicHistogramScrapeViaProtobuf(t *testing.T) {
 	testCases := map[string]struct {
 		mutCfg                 func(*PromConfig)
 		enableNativeHistograms bool
+		nativeHistograms       *NativeHistogramsConfig
 		expected               []testExpectation
 	}{
 		"feature enabled scrape classic off": {
@@ -398,6 +399,76 @@ func TestNativeVsClassicHistogramScrapeViaProtobuf(t *testing.T) {
 				assertMetricAbsent("test_native_histogram"),
 			},
 		},
+		"config enabled prefer native": {
+			nativeHistograms: &NativeHistogramsConfig{Enabled: true, PreferNative: true},
+			expected: []testExpectation{
+				assertMetricPresent( // Scrape classic only histograms as is.
+					"test_classic_histogram",
+					compareMetricType(pmetric.MetricTypeHistogram),
+					compareMetricUnit(""),
+					[]dataPointExpectation{{
+						histogramPointComparator: []histogramPointComparator{
+							compareHistogram(1213, 456, []float64{0.5, 10}, []uint64{789, 222, 202}),
+						},
+					}},
+				),
+				assertMetricPresent( // Only scrape native buckets from mixed histograms.
+					"test_mixed_histogram",
+					compareMetricType(pmetric.MetricTypeExponentialHistogram),
+					compareMetricUnit(""),
+					[]dataPointExpectation{{
+						exponentialHistogramComparator: []exponentialHistogramComparator{
+							compareExponentialHistogram(3, 1213, 456, 2, -1, []uint64{1, 0, 2}, -3, []uint64{1, 0, 1}),
+						},
+					}},
+				),
+				assertMetricPresent( // Scrape native only histograms as is.
+					"test_native_histogram",
+					compareMetricType(pmetric.MetricTypeExponentialHistogram),
+					compareMetricUnit(""),
+					[]dataPointExpectation{{
+						exponentialHistogramComparator: []exponentialHistogramComparator{
+							compareExponentialHistogram(3, 1214, 3456, 5, -3, []uint64{1, 0, 2}, 2, []uint64{1, 0, 0, 1}),
+						},
+					}},
+				),
+			},
+		},
+		"config enabled prefer classic": {
+			nativeHistograms: &NativeHistogramsConfig{Enabled: true, PreferNative: false},
+			expected: []testExpectation{
+				assertMetricPresent( // Scrape classic only histograms as is.
+					"test_classic_histogram",
+					compareMetricType(pmetric.MetricTypeHistogram),
+					compareMetricUnit(""),
+					[]dataPointExpectation{{
+						histogramPointComparator: []histogramPointComparator{
+							compareHistogram(1213, 456, []float64{0.5, 10}, []uint64{789, 222, 202}),
+						},
+					}},
+				),
+				assertMetricPresent( // Only scrape classic buckets from mixed histograms.
+					"test_mixed_histogram",
+					compareMetricType(pmetric.MetricTypeHistogram),
+					compareMetricUnit(""),
+					[]dataPointExpectation{{
+						histogramPointComparator: []histogramPointComparator{
+							compareHistogram(1213, 456, []float64{0.5, 10}, []uint64{789, 222, 202}),
+						},
+					}},
+				),
+				assertMetricPresent( // Scrape native only histograms as is.
+					"test_native_histogram",
+					compareMetricType(pmetric.MetricTypeExponentialHistogram),
+					compareMetricUnit(""),
+					[]dataPointExpectation{{
+						exponentialHistogramComparator: []exponentialHistogramComparator{
+							compareExponentialHistogram(3, 1214, 3456, 5, -3, []uint64{1, 0, 2}, 2, []uint64{1, 0, 0, 1}),
+						},
+					}},
+				),
+			},
+		},
 	}
 
 	defer func() {
@@ -427,6 +498,9 @@ func TestNativeVsClassicHistogramScrapeViaProtobuf(t *testing.T) {
 			}
 			testComponent(t, targets, func(c *Config) {
 				c.PrometheusConfig.GlobalConfig.ScrapeProtocols = []config.ScrapeProtocol{config.PrometheusProto}
+				if tc.nativeHistograms != nil {
+					c.NativeHistograms = *tc.nativeHistograms
+				}
 			}, mutCfg)
 		})
 	}
@@ -1640,3 +1640,67 @@ scrape_configs:
 	require.Contains(t, gotUA, set.BuildInfo.Command)
 	require.Contains(t, gotUA, set.BuildInfo.Version)
 }
+
+func TestConfigureHistograms(t *testing.T) {
+	newPromConfig := func() *PromConfig {
+		return &PromConfig{
+			GlobalConfig: promConfig.DefaultGlobalConfig,
+			ScrapeConfigs: []*promConfig.ScrapeConfig{
+				{JobName: "default", ScrapeProtocols: promConfig.DefaultScrapeProtocols},
+				{JobName: "custom", ScrapeProtocols: []promConfig.ScrapeProtocol{promConfig.OpenMetricsText1_0_0}},
+				{JobName: "unset"},
+			},
+		}
+	}
+
+	tests := []struct {
+		name              string
+		nativeHistograms  NativeHistogramsConfig
+		expectedProtocols [][]promConfig.ScrapeProtocol
+		expectedClassic   bool
+	}{
+		{
+			name: "disabled",
+			expectedProtocols: [][]promConfig.ScrapeProtocol{
+				promConfig.DefaultScrapeProtocols,
+				{promConfig.OpenMetricsText1_0_0},
+				nil,
+			},
+			expectedClassic: true,
+		},
+		{
+			name:             "enabled prefer native",
+			nativeHistograms: NativeHistogramsConfig{Enabled: true, PreferNative: true},
+			expectedProtocols: [][]promConfig.ScrapeProtocol{
+				promConfig.DefaultProtoFirstScrapeProtocols,
+				{promConfig.OpenMetricsText1_0_0},
+				promConfig.DefaultProtoFirstScrapeProtocols,
+			},
+			expectedClassic: false,
+		},
+		{
+			name:             "enabled prefer classic",
+			nativeHistograms: NativeHistogramsConfig{Enabled: true},
+			expectedProtocols: [][]promConfig.ScrapeProtocol{
+				promConfig.DefaultProtoFirstScrapeProtocols,
+				{promConfig.OpenMetricsText1_0_0},
+				promConfig.DefaultProtoFirstScrapeProtocols,
+			},
+			expectedClassic: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cfg := newPromConfig()
+			r := newPrometheusReceiver(receivertest.NewNopCreateSettings(), &Config{
+				PrometheusConfig: cfg,
+				NativeHistograms: tt.nativeHistograms,
+			}, new(consumertest.MetricsSink))
+			r.configureHistograms(cfg)
+			for i, scrapeConfig := range cfg.ScrapeConfigs {
+				assert.Equal(t, tt.expectedProtocols[i], scrapeConfig.ScrapeProtocols, scrapeConfig.JobName)
+				assert.Equal(t, tt.expectedClassic, scrapeConfig.ScrapeClassicHistograms, scrapeConfig.JobName)
+			}
+		})
+	}
+}
@@ -9,6 +9,7 @@ import (
 
 	"go.opentelemetry.io/collector/component"
 	"go.opentelemetry.io/collector/config/confighttp"
+	"go.opentelemetry.io/collector/config/configopaque"
 	"go.uber.org/multierr"
 
 	"github.com/open-telemetry/opentelemetry-collector-contrib/receiver/purefareceiver/internal"
@@ -43,6 +44,20 @@ type Config struct {
 
 	// ArrayName represents the display name that is appended to the received metrics, as the `host` label if not provided by OpenMetrics output, and to the `fa_array_name` label always.
 	ArrayName string `mapstructure:"fa_array_name"`
+
+	// Alerts configures the collection of the alerts of the array as logs
+	Alerts AlertsConfig `mapstructure:"alerts"`
+}
+
+// AlertsConfig relates to the alerts polled from the REST API of the array.
+type AlertsConfig struct {
+	confighttp.ClientConfig `mapstructure:",squash"`
+
+	// APIToken is the API token of the user the REST API is accessed as
+	APIToken configopaque.String `mapstructure:"api_token"`
+
+	// CollectionInterval is how often the alerts are polled
+	CollectionInterval time.Duration `mapstructure:"collection_interval"`
 }
 
 type Settings struct {
@@ -78,6 +93,14 @@ func (c *Config) Validate() error {
 	if c.Settings.ReloadIntervals.Volumes == 0 {
 		errs = multierr.Append(errs, errors.New("reload interval for 'volumes' must be provided"))
 	}
+	if c.Alerts.Endpoint != "" {
+		if c.Alerts.APIToken == "" {
+			errs = multierr.Append(errs, errors.New("the 'api_token' of the 'alerts' must be provided"))
+		}
+		if c.Alerts.CollectionInterval <= 0 {
+			errs = multierr.Append(errs, errors.New("the 'collection_interval' of the 'alerts' must be positive"))
+		}
+	}
 
 	return errs
 }
@@ -11,6 +11,7 @@ import (
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 	"go.opentelemetry.io/collector/component"
+	"go.opentelemetry.io/collector/config/confighttp"
 	"go.opentelemetry.io/collector/confmap/confmaptest"
 
 	"github.com/open-telemetry/opentelemetry-collector-contrib/receiver/purefareceiver/internal/metadata"
@@ -39,6 +40,10 @@ func TestLoadConfig(t *testing.T) {
 						Volumes:     15 * time.Second,
 					},
 				},
+				Alerts: AlertsConfig{
+					ClientConfig:       confighttp.ClientConfig{Timeout: 10 * time.Second},
+					CollectionInterval: time.Minute,
+				},
 			},
 		},
 	}
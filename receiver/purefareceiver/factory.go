@@ -7,6 +7,7 @@ package purefareceiver // import "github.com/open-telemetry/opentelemetry-collec
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"time"
 
@@ -23,7 +24,8 @@ func NewFactory() receiver.Factory {
 	return receiver.NewFactory(
 		metadata.Type,
 		createDefaultConfig,
-		receiver.WithMetrics(createMetricsReceiver, metadata.MetricsStability))
+		receiver.WithMetrics(createMetricsReceiver, metadata.MetricsStability),
+		receiver.WithLogs(createLogsReceiver, metadata.LogsStability))
 }
 
 func createDefaultConfig() component.Config {
@@ -39,6 +41,10 @@ func createDefaultConfig() component.Config {
 				Volumes:     15 * time.Second,
 			},
 		},
+		Alerts: AlertsConfig{
+			ClientConfig:       confighttp.ClientConfig{Timeout: 10 * time.Second},
+			CollectionInterval: time.Minute,
+		},
 	}
 }
 
@@ -54,3 +60,19 @@ func createMetricsReceiver(
 	}
 	return newReceiver(cfg, set, next), nil
 }
+
+func createLogsReceiver(
+	_ context.Context,
+	set receiver.CreateSettings,
+	rCfg component.Config,
+	next consumer.Logs,
+) (receiver.Logs, error) {
+	cfg, ok := rCfg.(*Config)
+	if !ok {
+		return nil, fmt.Errorf("a purefa receiver config was expected by the receiver factory, but got %T", rCfg)
+	}
+	if cfg.Alerts.Endpoint == "" {
+		return nil, errors.New("the 'endpoint' of the 'alerts' must be provided to receive logs")
+	}
+	return newAlertsReceiver(cfg, set, next), nil
+}
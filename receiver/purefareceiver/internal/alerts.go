@@ -0,0 +1,147 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package internal // import "github.com/open-telemetry/opentelemetry-collector-contrib/receiver/purefareceiver/internal"
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+)
+
+const (
+	alertsAPIVersion = "2.4"
+	authTokenHeader  = "x-auth-token"
+)
+
+var errUnauthorized = errors.New("the session is not authorized")
+
+// Alert is an alert raised by the array, as returned by the REST API.
+type Alert struct {
+	ID            string `json:"id"`
+	Name          string `json:"name"`
+	Code          int64  `json:"code"`
+	ComponentName string `json:"component_name"`
+	ComponentType string `json:"component_type"`
+	Severity      string `json:"severity"`
+	State         string `json:"state"`
+	Summary       string `json:"summary"`
+	Description   string `json:"description"`
+	Flagged       bool   `json:"flagged"`
+	// Created and Updated are in milliseconds since the epoch
+	Created int64 `json:"created"`
+	Updated int64 `json:"updated"`
+}
+
+type alertsPage struct {
+	Items             []Alert `json:"items"`
+	ContinuationToken string  `json:"continuation_token"`
+}
+
+// AlertsClient fetches the alerts of an array through its REST API, with a session opened with the API token.
+type AlertsClient struct {
+	client   *http.Client
+	endpoint string
+	apiToken string
+
+	authToken string
+}
+
+func NewAlertsClient(client *http.Client, endpoint string, apiToken string) *AlertsClient {
+	return &AlertsClient{
+		client:   client,
+		endpoint: strings.TrimSuffix(endpoint, "/"),
+		apiToken: apiToken,
+	}
+}
+
+// Alerts returns the alerts matching the given filter, in the REST API filter syntax. The session is opened on the
+// first call, and opened again when it expires.
+func (c *AlertsClient) Alerts(ctx context.Context, filter string) ([]Alert, error) {
+	if c.authToken == "" {
+		if err := c.login(ctx); err != nil {
+			return nil, err
+		}
+	}
+
+	var alerts []Alert
+	continuationToken := ""
+	for {
+		page, err := c.alertsPage(ctx, filter, continuationToken)
+		if errors.Is(err, errUnauthorized) {
+			if err = c.login(ctx); err != nil {
+				return nil, err
+			}
+			page, err = c.alertsPage(ctx, filter, continuationToken)
+		}
+		if err != nil {
+			return nil, err
+		}
+		alerts = append(alerts, page.Items...)
+		if page.ContinuationToken == "" {
+			return alerts, nil
+		}
+		continuationToken = page.ContinuationToken
+	}
+}
+
+func (c *AlertsClient) login(ctx context.Context) error {
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, fmt.Sprintf("%s/api/%s/login", c.endpoint, alertsAPIVersion), nil)
+	if err != nil {
+		return err
+	}
+	req.Header.Set("api-token", c.apiToken)
+
+	resp, err := c.client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return fmt.Errorf("failed to log in to %s: %q", c.endpoint, resp.Status)
+	}
+	c.authToken = resp.Header.Get(authTokenHeader)
+	if c.authToken == "" {
+		return fmt.Errorf("failed to log in to %s: no session token returned", c.endpoint)
+	}
+	return nil
+}
+
+func (c *AlertsClient) alertsPage(ctx context.Context, filter string, continuationToken string) (*alertsPage, error) {
+	query := url.Values{}
+	if filter != "" {
+		query.Set("filter", filter)
+	}
+	if continuationToken != "" {
+		query.Set("continuation_token", continuationToken)
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, fmt.Sprintf("%s/api/%s/alerts?%s", c.endpoint, alertsAPIVersion, query.Encode()), nil)
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set(authTokenHeader, c.authToken)
+
+	resp, err := c.client.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode == http.StatusUnauthorized {
+		return nil, errUnauthorized
+	}
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("request GET %s failed - %q", req.URL.String(), resp.Status)
+	}
+
+	page := &alertsPage{}
+	if err = json.NewDecoder(resp.Body).Decode(page); err != nil {
+		return nil, fmt.Errorf("decoding the alerts: %w", err)
+	}
+	return page, nil
+}
@@ -0,0 +1,152 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package purefareceiver // import "github.com/open-telemetry/opentelemetry-collector-contrib/receiver/purefareceiver"
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	"go.opentelemetry.io/collector/component"
+	"go.opentelemetry.io/collector/consumer"
+	"go.opentelemetry.io/collector/pdata/pcommon"
+	"go.opentelemetry.io/collector/pdata/plog"
+	"go.opentelemetry.io/collector/receiver"
+	"go.uber.org/zap"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/receiver/purefareceiver/internal"
+)
+
+var _ receiver.Logs = (*alertsReceiver)(nil)
+
+// alertsReceiver polls the alerts of the array and emits them as logs. The alerts open when the receiver starts
+// are emitted first, then every alert raised or updated since the latest poll, e.g. when it gets closed.
+type alertsReceiver struct {
+	cfg  *Config
+	set  receiver.CreateSettings
+	next consumer.Logs
+
+	client *internal.AlertsClient
+	// lastUpdated is the latest update time of the emitted alerts, in milliseconds since the epoch
+	lastUpdated int64
+
+	cancel context.CancelFunc
+	wg     sync.WaitGroup
+}
+
+func newAlertsReceiver(cfg *Config, set receiver.CreateSettings, next consumer.Logs) *alertsReceiver {
+	return &alertsReceiver{
+		cfg:  cfg,
+		set:  set,
+		next: next,
+	}
+}
+
+func (r *alertsReceiver) Start(ctx context.Context, host component.Host) error {
+	httpClient, err := r.cfg.Alerts.ToClient(ctx, host, r.set.TelemetrySettings)
+	if err != nil {
+		return fmt.Errorf("failed to create the alerts HTTP client: %w", err)
+	}
+	r.client = internal.NewAlertsClient(httpClient, r.cfg.Alerts.Endpoint, string(r.cfg.Alerts.APIToken))
+
+	pollCtx, cancel := context.WithCancel(context.Background())
+	r.cancel = cancel
+	r.wg.Add(1)
+	go func() {
+		defer r.wg.Done()
+		ticker := time.NewTicker(r.cfg.Alerts.CollectionInterval)
+		defer ticker.Stop()
+		for {
+			r.poll(pollCtx)
+			select {
+			case <-ticker.C:
+			case <-pollCtx.Done():
+				return
+			}
+		}
+	}()
+	return nil
+}
+
+func (r *alertsReceiver) poll(ctx context.Context) {
+	filter := "state='open'"
+	if r.lastUpdated > 0 {
+		filter = fmt.Sprintf("updated>%d", r.lastUpdated)
+	}
+	alerts, err := r.client.Alerts(ctx, filter)
+	if err != nil {
+		r.set.Logger.Error("failed to fetch the array alerts", zap.String("endpoint", r.cfg.Alerts.Endpoint), zap.Error(err))
+		return
+	}
+	if len(alerts) == 0 {
+		return
+	}
+
+	if err = r.next.ConsumeLogs(ctx, r.alertsToLogs(alerts)); err != nil {
+		r.set.Logger.Error("failed to consume the array alerts", zap.Error(err))
+		return
+	}
+	for _, alert := range alerts {
+		r.lastUpdated = max(r.lastUpdated, alert.Updated)
+	}
+}
+
+func (r *alertsReceiver) alertsToLogs(alerts []internal.Alert) plog.Logs {
+	logs := plog.NewLogs()
+	rl := logs.ResourceLogs().AppendEmpty()
+	rl.Resource().Attributes().PutStr("fa_array_name", r.cfg.ArrayName)
+	if r.cfg.Env != "" {
+		rl.Resource().Attributes().PutStr("environment", r.cfg.Env)
+	}
+	sl := rl.ScopeLogs().AppendEmpty()
+	sl.Scope().SetName("otelcol/purefareceiver")
+	sl.Scope().SetVersion(r.set.BuildInfo.Version)
+
+	observed := pcommon.NewTimestampFromTime(time.Now())
+	for _, alert := range alerts {
+		lr := sl.LogRecords().AppendEmpty()
+		lr.SetTimestamp(pcommon.NewTimestampFromTime(time.UnixMilli(alert.Updated)))
+		lr.SetObservedTimestamp(observed)
+		lr.SetSeverityText(alert.Severity)
+		lr.SetSeverityNumber(alertSeverityNumber(alert.Severity))
+		lr.Body().SetStr(alert.Summary)
+
+		attrs := lr.Attributes()
+		attrs.PutStr("alert.id", alert.ID)
+		attrs.PutStr("alert.name", alert.Name)
+		attrs.PutInt("alert.code", alert.Code)
+		attrs.PutStr("alert.state", alert.State)
+		attrs.PutBool("alert.flagged", alert.Flagged)
+		attrs.PutStr("alert.description", alert.Description)
+		attrs.PutStr("alert.component.name", alert.ComponentName)
+		attrs.PutStr("alert.component.type", alert.ComponentType)
+		attrs.PutInt("alert.created", alert.Created)
+	}
+	return logs
+}
+
+// alertSeverityNumber maps the severity of the alerts to the log severity.
+func alertSeverityNumber(severity string) plog.SeverityNumber {
+	switch severity {
+	case "critical":
+		return plog.SeverityNumberError
+	case "warning":
+		return plog.SeverityNumberWarn
+	case "info":
+		return plog.SeverityNumberInfo
+	case "hidden":
+		return plog.SeverityNumberDebug
+	default:
+		return plog.SeverityNumberUnspecified
+	}
+}
+
+func (r *alertsReceiver) Shutdown(context.Context) error {
+	if r.cancel != nil {
+		r.cancel()
+	}
+	r.wg.Wait()
+	return nil
+}
@@ -0,0 +1,153 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package purefareceiver // import "github.com/open-telemetry/opentelemetry-collector-contrib/receiver/purefareceiver"
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/collector/component/componenttest"
+	"go.opentelemetry.io/collector/consumer/consumertest"
+	"go.opentelemetry.io/collector/pdata/plog"
+	"go.opentelemetry.io/collector/receiver/receivertest"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/receiver/purefareceiver/internal"
+)
+
+type fakeArray struct {
+	t *testing.T
+
+	mutex   sync.Mutex
+	logins  int
+	filters []string
+}
+
+func (a *fakeArray) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	a.mutex.Lock()
+	defer a.mutex.Unlock()
+
+	switch r.URL.Path {
+	case "/api/2.4/login":
+		if r.Method != http.MethodPost || r.Header.Get("api-token") != "token" {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		a.logins++
+		w.Header().Set("x-auth-token", "session")
+	case "/api/2.4/alerts":
+		if r.Header.Get("x-auth-token") != "session" {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		filter := r.URL.Query().Get("filter")
+		a.filters = append(a.filters, filter)
+		var body map[string]any
+		switch {
+		case filter == "state='open'" && r.URL.Query().Get("continuation_token") == "":
+			body = map[string]any{
+				"items": []map[string]any{
+					{"id": "a1", "name": "101", "code": 101, "severity": "critical", "state": "open", "summary": "ct0.eth0 down", "component_name": "ct0.eth0", "component_type": "hardware", "created": 1700000000000, "updated": 1700000000000},
+				},
+				"continuation_token": "next",
+			}
+		case filter == "state='open'":
+			body = map[string]any{
+				"items": []map[string]any{
+					{"id": "a2", "name": "102", "code": 102, "severity": "warning", "state": "open", "summary": "space low", "created": 1700000001000, "updated": 1700000002000},
+				},
+			}
+		default:
+			body = map[string]any{
+				"items": []map[string]any{
+					{"id": "a1", "name": "101", "code": 101, "severity": "critical", "state": "closed", "summary": "ct0.eth0 down", "created": 1700000000000, "updated": 1700000003000},
+				},
+			}
+		}
+		assert.NoError(a.t, json.NewEncoder(w).Encode(body))
+	default:
+		w.WriteHeader(http.StatusNotFound)
+	}
+}
+
+func TestAlertsReceiver(t *testing.T) {
+	array := &fakeArray{t: t}
+	srv := httptest.NewServer(array)
+	defer srv.Close()
+
+	cfg := createDefaultConfig().(*Config)
+	cfg.Alerts.Endpoint = srv.URL
+	cfg.Alerts.APIToken = "token"
+	cfg.Alerts.CollectionInterval = 10 * time.Millisecond
+	cfg.Env = "dev"
+
+	sink := &consumertest.LogsSink{}
+	recv := newAlertsReceiver(cfg, receivertest.NewNopCreateSettings(), sink)
+	require.NoError(t, recv.Start(context.Background(), componenttest.NewNopHost()))
+	require.Eventually(t, func() bool {
+		return len(sink.AllLogs()) >= 2
+	}, 5*time.Second, 10*time.Millisecond)
+	require.NoError(t, recv.Shutdown(context.Background()))
+
+	// the open alerts come first, across all the pages
+	first := sink.AllLogs()[0]
+	require.Equal(t, 2, first.LogRecordCount())
+	resource := first.ResourceLogs().At(0).Resource().Attributes()
+	arrayName, _ := resource.Get("fa_array_name")
+	assert.Equal(t, "foobar.example.com", arrayName.Str())
+	env, _ := resource.Get("environment")
+	assert.Equal(t, "dev", env.Str())
+
+	record := first.ResourceLogs().At(0).ScopeLogs().At(0).LogRecords().At(0)
+	assert.Equal(t, "ct0.eth0 down", record.Body().Str())
+	assert.Equal(t, plog.SeverityNumberError, record.SeverityNumber())
+	assert.Equal(t, "critical", record.SeverityText())
+	assert.Equal(t, time.UnixMilli(1700000000000).UTC(), record.Timestamp().AsTime())
+	componentName, _ := record.Attributes().Get("alert.component.name")
+	assert.Equal(t, "ct0.eth0", componentName.Str())
+	state, _ := record.Attributes().Get("alert.state")
+	assert.Equal(t, "open", state.Str())
+
+	// then the alerts updated since the latest one
+	second := sink.AllLogs()[1]
+	record = second.ResourceLogs().At(0).ScopeLogs().At(0).LogRecords().At(0)
+	state, _ = record.Attributes().Get("alert.state")
+	assert.Equal(t, "closed", state.Str())
+
+	array.mutex.Lock()
+	defer array.mutex.Unlock()
+	assert.Equal(t, 1, array.logins)
+	assert.Contains(t, array.filters, "updated>1700000002000")
+}
+
+func TestAlertsReceiverLoginFailure(t *testing.T) {
+	array := &fakeArray{t: t}
+	srv := httptest.NewServer(array)
+	defer srv.Close()
+
+	cfg := createDefaultConfig().(*Config)
+	cfg.Alerts.Endpoint = srv.URL
+	cfg.Alerts.APIToken = "wrong"
+
+	sink := &consumertest.LogsSink{}
+	recv := newAlertsReceiver(cfg, receivertest.NewNopCreateSettings(), sink)
+	recv.client = internal.NewAlertsClient(srv.Client(), srv.URL, string(cfg.Alerts.APIToken))
+	recv.poll(context.Background())
+	assert.Empty(t, sink.AllLogs())
+	assert.Zero(t, recv.lastUpdated)
+}
+
+func TestAlertSeverityNumber(t *testing.T) {
+	assert.Equal(t, plog.SeverityNumberError, alertSeverityNumber("critical"))
+	assert.Equal(t, plog.SeverityNumberWarn, alertSeverityNumber("warning"))
+	assert.Equal(t, plog.SeverityNumberInfo, alertSeverityNumber("info"))
+	assert.Equal(t, plog.SeverityNumberDebug, alertSeverityNumber("hidden"))
+	assert.Equal(t, plog.SeverityNumberUnspecified, alertSeverityNumber("unknown"))
+}
@@ -9,6 +9,7 @@ import (
 
 	"go.opentelemetry.io/collector/component"
 	"go.opentelemetry.io/collector/config/confighttp"
+	"go.opentelemetry.io/collector/config/configopaque"
 	"go.uber.org/multierr"
 
 	"github.com/open-telemetry/opentelemetry-collector-contrib/receiver/purefbreceiver/internal"
@@ -34,6 +35,20 @@ type Config struct {
 
 	// Env represents the respective environment value valid to scrape
 	Env string `mapstructure:"env"`
+
+	// Alerts configures the collection of the alerts of the FlashBlade as logs
+	Alerts AlertsConfig `mapstructure:"alerts"`
+}
+
+// AlertsConfig relates to the alerts polled from the REST API of the FlashBlade.
+type AlertsConfig struct {
+	confighttp.ClientConfig `mapstructure:",squash"`
+
+	// APIToken is the API token of the user the REST API is accessed as
+	APIToken configopaque.String `mapstructure:"api_token"`
+
+	// CollectionInterval is how often the alerts are polled
+	CollectionInterval time.Duration `mapstructure:"collection_interval"`
 }
 
 type Settings struct {
@@ -59,5 +74,14 @@ func (c *Config) Validate() error {
 		err = multierr.Append(err, errors.New("reload interval for 'usage' must be provided"))
 	}
 
+	if c.Alerts.Endpoint != "" {
+		if c.Alerts.APIToken == "" {
+			err = multierr.Append(err, errors.New("the 'api_token' of the 'alerts' must be provided"))
+		}
+		if c.Alerts.CollectionInterval <= 0 {
+			err = multierr.Append(err, errors.New("the 'collection_interval' of the 'alerts' must be positive"))
+		}
+	}
+
 	return err
 }
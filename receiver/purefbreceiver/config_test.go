@@ -11,6 +11,7 @@ import (
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 	"go.opentelemetry.io/collector/component"
+	"go.opentelemetry.io/collector/config/confighttp"
 	"go.opentelemetry.io/collector/confmap/confmaptest"
 
 	"github.com/open-telemetry/opentelemetry-collector-contrib/receiver/purefbreceiver/internal/metadata"
@@ -36,6 +37,10 @@ func TestLoadConfig(t *testing.T) {
 						Usage:   5 * time.Minute,
 					},
 				},
+				Alerts: AlertsConfig{
+					ClientConfig:       confighttp.ClientConfig{Timeout: 10 * time.Second},
+					CollectionInterval: time.Minute,
+				},
 			},
 		},
 	}
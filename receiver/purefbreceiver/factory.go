@@ -7,6 +7,7 @@ package purefbreceiver // import "github.com/open-telemetry/opentelemetry-collec
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"time"
 
@@ -23,7 +24,8 @@ func NewFactory() receiver.Factory {
 	return receiver.NewFactory(
 		metadata.Type,
 		createDefaultConfig,
-		receiver.WithMetrics(createMetricsReceiver, metadata.MetricsStability))
+		receiver.WithMetrics(createMetricsReceiver, metadata.MetricsStability),
+		receiver.WithLogs(createLogsReceiver, metadata.LogsStability))
 }
 
 func createDefaultConfig() component.Config {
@@ -36,6 +38,10 @@ func createDefaultConfig() component.Config {
 				Usage:   5 * time.Minute,
 			},
 		},
+		Alerts: AlertsConfig{
+			ClientConfig:       confighttp.ClientConfig{Timeout: 10 * time.Second},
+			CollectionInterval: time.Minute,
+		},
 	}
 }
 
@@ -51,3 +57,19 @@ func createMetricsReceiver(
 	}
 	return newReceiver(cfg, set, next), nil
 }
+
+func createLogsReceiver(
+	_ context.Context,
+	set receiver.CreateSettings,
+	rCfg component.Config,
+	next consumer.Logs,
+) (receiver.Logs, error) {
+	cfg, ok := rCfg.(*Config)
+	if !ok {
+		return nil, fmt.Errorf("a purefb receiver config was expected by the receiver factory, but got %T", rCfg)
+	}
+	if cfg.Alerts.Endpoint == "" {
+		return nil, errors.New("the 'endpoint' of the 'alerts' must be provided to receive logs")
+	}
+	return newAlertsReceiver(cfg, set, next), nil
+}
@@ -10,6 +10,7 @@ import (
 	"github.com/stretchr/testify/assert"
 	"go.opentelemetry.io/collector/component"
 	"go.opentelemetry.io/collector/component/componenttest"
+	"go.opentelemetry.io/collector/consumer/consumertest"
 	"go.opentelemetry.io/collector/receiver/receivertest"
 )
 
@@ -38,3 +39,21 @@ func TestCreateReceiver(t *testing.T) {
 	assert.Equal(t, err, component.ErrDataTypeIsNotSupported)
 	assert.Nil(t, tReceiver)
 }
+
+func TestCreateLogsReceiver(t *testing.T) {
+	factory := NewFactory()
+	cfg := factory.CreateDefaultConfig().(*Config)
+	set := receivertest.NewNopCreateSettings()
+	_, err := factory.CreateLogsReceiver(context.Background(), set, cfg, consumertest.NewNop())
+	assert.EqualError(t, err, "the 'endpoint' of the 'alerts' must be provided to receive logs")
+
+	cfg.Alerts.Endpoint = "https://array01"
+	cfg.Alerts.APIToken = "token"
+	assert.NoError(t, component.ValidateConfig(cfg))
+	lReceiver, err := factory.CreateLogsReceiver(context.Background(), set, cfg, consumertest.NewNop())
+	assert.NoError(t, err, "receiver creation failed")
+	assert.NotNil(t, lReceiver, "receiver creation failed")
+
+	cfg.Alerts.APIToken = ""
+	assert.ErrorContains(t, component.ValidateConfig(cfg), "the 'api_token' of the 'alerts' must be provided")
+}
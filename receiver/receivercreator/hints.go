@@ -0,0 +1,163 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package receivercreator // import "github.com/open-telemetry/opentelemetry-collector-contrib/receiver/receivercreator"
+
+import (
+	"errors"
+	"fmt"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"go.opentelemetry.io/collector/component"
+	"gopkg.in/yaml.v3"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/extension/observer"
+)
+
+const (
+	// logsHintsPrefix is the prefix of the pod annotations hinting how to collect the logs of the pod,
+	// e.g. "io.opentelemetry.discovery.logs/config" for all its containers or
+	// "io.opentelemetry.discovery.logs.<container name>/config" for one of them.
+	logsHintsPrefix = "io.opentelemetry.discovery.logs"
+	// hintEnabled is the hint enabling or disabling the collection of logs
+	hintEnabled = "enabled"
+	// hintConfig is the hint holding the filelog receiver config used to collect logs, as YAML
+	hintConfig = "config"
+	// podLogsDir is the directory the kubelet writes the logs of pod containers to
+	podLogsDir = "/var/log/pods"
+	// hintsReceiverName is the name of the receivers created from hints
+	hintsReceiverName = "hints"
+)
+
+var filelogType = component.MustNewType("filelog")
+
+// DiscoveryConfig defines how receivers are created based on hints from discovered endpoints.
+type DiscoveryConfig struct {
+	// Enabled starts filelog receivers for pods with logs hints in their annotations.
+	Enabled bool `mapstructure:"enabled"`
+}
+
+// logsHints are the logs hints of a pod or container
+type logsHints struct {
+	enabled *bool
+	config  string
+}
+
+// hintsTemplates returns the templates of the receivers to start for the logs hints of a pod.
+// A receiver is created for each container with its own hints and one for the other containers of the pod
+// if the pod has hints. Containers only have to be named in annotations when their hints differ.
+func hintsTemplates(pod *observer.Pod) ([]receiverTemplate, error) {
+	podHints := logsHints{}
+	containerHints := map[string]*logsHints{}
+	for key, value := range pod.Annotations {
+		prefix, hint, ok := strings.Cut(key, "/")
+		if !ok || !strings.HasPrefix(prefix, logsHintsPrefix) {
+			continue
+		}
+		hints := &podHints
+		if container, isContainer := strings.CutPrefix(prefix, logsHintsPrefix+"."); isContainer {
+			if containerHints[container] == nil {
+				containerHints[container] = &logsHints{}
+			}
+			hints = containerHints[container]
+		} else if prefix != logsHintsPrefix {
+			continue
+		}
+		switch hint {
+		case hintEnabled:
+			enabled := value == "true"
+			hints.enabled = &enabled
+		case hintConfig:
+			hints.config = value
+		}
+	}
+
+	podDir := filepath.Join(podLogsDir, fmt.Sprintf("%s_%s_%s", pod.Namespace, pod.Name, pod.UID))
+	containers := make([]string, 0, len(containerHints))
+	for container := range containerHints {
+		containers = append(containers, container)
+	}
+	sort.Strings(containers)
+
+	var templates []receiverTemplate
+	var excludes []any
+	for _, container := range containers {
+		hints := containerHints[container]
+		// Containers inherit the hints of their pod they don't override
+		if hints.enabled == nil {
+			hints.enabled = podHints.enabled
+		}
+		if hints.config == "" {
+			hints.config = podHints.config
+		}
+
+		path := filepath.Join(podDir, container, "*.log")
+		excludes = append(excludes, path)
+		if !hints.isEnabled() {
+			continue
+		}
+		template, err := newHintsTemplate(hintsReceiverName+"/"+container, hints.config, []any{path}, nil)
+		if err != nil {
+			return nil, fmt.Errorf("invalid logs hints for container %q: %w", container, err)
+		}
+		templates = append(templates, template)
+	}
+
+	if podHints.isEnabled() {
+		template, err := newHintsTemplate(hintsReceiverName, podHints.config, []any{filepath.Join(podDir, "*", "*.log")}, excludes)
+		if err != nil {
+			return nil, fmt.Errorf("invalid logs hints: %w", err)
+		}
+		templates = append(templates, template)
+	}
+
+	return templates, nil
+}
+
+// isEnabled returns whether logs are collected. Providing a config enables collection unless it is explicitly disabled.
+func (h *logsHints) isEnabled() bool {
+	if h.enabled != nil {
+		return *h.enabled
+	}
+	return h.config != ""
+}
+
+// newHintsTemplate creates the template of a filelog receiver reading the given container log files.
+// The files to read can't be set by the hints config. The container parser is run before the operators of the config.
+func newHintsTemplate(name string, hintsConfig string, include []any, exclude []any) (receiverTemplate, error) {
+	var parsed map[string]any
+	if err := yaml.Unmarshal([]byte(hintsConfig), &parsed); err != nil {
+		return receiverTemplate{}, fmt.Errorf("failed to parse config: %w", err)
+	}
+	cfg := userConfigMap{}
+	for key, value := range parsed {
+		cfg[key] = value
+	}
+
+	operators := []any{map[string]any{"type": "container", "id": "container-parser"}}
+	if hintsOperators, ok := cfg["operators"]; ok {
+		list, isList := hintsOperators.([]any)
+		if !isList {
+			return receiverTemplate{}, errors.New("operators must be a list")
+		}
+		operators = append(operators, list...)
+	}
+	cfg["operators"] = operators
+	cfg["include"] = include
+	delete(cfg, "exclude")
+	if len(exclude) > 0 {
+		cfg["exclude"] = exclude
+	}
+	if _, ok := cfg["include_file_path"]; !ok {
+		cfg["include_file_path"] = true
+	}
+
+	return receiverTemplate{
+		receiverConfig: receiverConfig{
+			id:     component.NewIDWithName(filelogType, name),
+			config: cfg,
+		},
+	}, nil
+}
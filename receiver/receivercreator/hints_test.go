@@ -0,0 +1,143 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package receivercreator
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/collector/component"
+	"go.opentelemetry.io/collector/consumer/consumertest"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/extension/observer"
+)
+
+func TestHintsTemplates(t *testing.T) {
+	containerParser := map[string]any{"type": "container", "id": "container-parser"}
+
+	for _, test := range []struct {
+		name              string
+		annotations       map[string]string
+		expectedTemplates []receiverTemplate
+		expectedError     string
+	}{
+		{
+			name:        "no hints",
+			annotations: map[string]string{"scrape": "true"},
+		},
+		{
+			name:        "enabled for pod",
+			annotations: map[string]string{"io.opentelemetry.discovery.logs/enabled": "true"},
+			expectedTemplates: []receiverTemplate{{
+				receiverConfig: receiverConfig{
+					id: component.MustNewIDWithName("filelog", "hints"),
+					config: userConfigMap{
+						"include":           []any{"/var/log/pods/default_pod-1_uid-1/*/*.log"},
+						"include_file_path": true,
+						"operators":         []any{containerParser},
+					},
+				},
+			}},
+		},
+		{
+			name: "config for pod",
+			annotations: map[string]string{
+				"io.opentelemetry.discovery.logs/config": `
+include: [/etc/passwd]
+start_at: beginning
+operators:
+  - type: json_parser
+`,
+			},
+			expectedTemplates: []receiverTemplate{{
+				receiverConfig: receiverConfig{
+					id: component.MustNewIDWithName("filelog", "hints"),
+					config: userConfigMap{
+						"include":           []any{"/var/log/pods/default_pod-1_uid-1/*/*.log"},
+						"include_file_path": true,
+						"start_at":          "beginning",
+						"operators":         []any{containerParser, map[string]any{"type": "json_parser"}},
+					},
+				},
+			}},
+		},
+		{
+			name: "config for container",
+			annotations: map[string]string{
+				"io.opentelemetry.discovery.logs/enabled":             "true",
+				"io.opentelemetry.discovery.logs.redis/config":        "operators: [{type: regex_parser, regex: '^(?P<msg>.*)$'}]",
+				"io.opentelemetry.discovery.logs.istio-proxy/enabled": "false",
+			},
+			expectedTemplates: []receiverTemplate{
+				{
+					receiverConfig: receiverConfig{
+						id: component.MustNewIDWithName("filelog", "hints/redis"),
+						config: userConfigMap{
+							"include":           []any{"/var/log/pods/default_pod-1_uid-1/redis/*.log"},
+							"include_file_path": true,
+							"operators":         []any{containerParser, map[string]any{"type": "regex_parser", "regex": "^(?P<msg>.*)$"}},
+						},
+					},
+				},
+				{
+					receiverConfig: receiverConfig{
+						id: component.MustNewIDWithName("filelog", "hints"),
+						config: userConfigMap{
+							"include": []any{"/var/log/pods/default_pod-1_uid-1/*/*.log"},
+							"exclude": []any{
+								"/var/log/pods/default_pod-1_uid-1/istio-proxy/*.log",
+								"/var/log/pods/default_pod-1_uid-1/redis/*.log",
+							},
+							"include_file_path": true,
+							"operators":         []any{containerParser},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "disabled for pod",
+			annotations: map[string]string{
+				"io.opentelemetry.discovery.logs/enabled": "false",
+				"io.opentelemetry.discovery.logs/config":  "start_at: beginning",
+			},
+		},
+		{
+			name:          "invalid config",
+			annotations:   map[string]string{"io.opentelemetry.discovery.logs/config": "operators: json_parser"},
+			expectedError: "invalid logs hints: operators must be a list",
+		},
+	} {
+		t.Run(test.name, func(t *testing.T) {
+			p := pod
+			p.Annotations = test.annotations
+			templates, err := hintsTemplates(&p)
+			if test.expectedError != "" {
+				require.EqualError(t, err, test.expectedError)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, test.expectedTemplates, templates)
+		})
+	}
+}
+
+func TestOnAddWithHints(t *testing.T) {
+	cfg := createDefaultConfig().(*Config)
+	cfg.Discovery.Enabled = true
+
+	p := pod
+	p.Annotations = map[string]string{"io.opentelemetry.discovery.logs/enabled": "true"}
+
+	handler, mr := newObserverHandler(t, cfg, consumertest.NewNop(), nil, nil)
+	handler.OnAdd([]observer.Endpoint{
+		{ID: podEndpoint.ID, Target: podEndpoint.Target, Details: &p},
+		portEndpoint,
+	})
+
+	// The filelog receiver isn't available in the tests, but its creation was attempted for the pod only
+	require.EqualError(t, mr.lastError, `unable to lookup factory for receiver "filelog/hints"`)
+	assert.Equal(t, 0, handler.receiversByEndpointID.Size())
+}
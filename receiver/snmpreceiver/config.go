@@ -3,6 +3,7 @@
 package snmpreceiver // import "github.com/open-telemetry/opentelemetry-collector-contrib/receiver/snmpreceiver"
 
 import (
+	"encoding/hex"
 	"errors"
 	"fmt"
 	"net/url"
@@ -23,6 +24,7 @@ const (
 	defaultSecurityLevel      = "no_auth_no_priv"
 	defaultAuthType           = "MD5"
 	defaultPrivacyType        = "DES"
+	defaultTrapsEndpoint      = "udp://0.0.0.0:162"
 )
 
 var (
@@ -52,6 +54,8 @@ var (
 	errMsgMultipleKeysSetOnResourceAttribute        = `resource attribute '%s' must have only one of oid, scalar_oid, or indexed_value_prefix`
 	errScalarOIDResourceAttributeEndsInNonzeroDigit = `resource attribute '%s' has scalar_oid '%s' that ends in a nonzero digit (scalar oids should not be indexed)`
 	errColumnOIDResourceAttributeEndsInZero         = `resource attribute '%s' has oid '%s' that ends in a zero (column oids should be indexed)`
+	errMsgInvalidTrapsEndpoint                      = `invalid traps endpoint '%s': must be in 'udp://[host]:[port]' format`
+	errMsgBadTrapsEngineID                          = `traps engine_id '%s' must be hex encoded: %w`
 
 	// Config errors
 	errEmptyEndpoint        = errors.New("endpoint must be specified")
@@ -68,6 +72,8 @@ var (
 	errBadPrivacyType       = errors.New("privacy_type must be either DES, AES, AES192, AES192C, AES256, AES256C")
 	errEmptyPrivacyPassword = errors.New("privacy_password must be specified when security_level is auth_priv")
 	errMetricRequired       = errors.New("must have at least one config under metrics")
+	errTrapsBadScheme       = errors.New("traps endpoint scheme must be udp")
+	errEmptyTrapsEngineID   = errors.New("traps engine_id must be specified when version is v3 and security_level is auth_no_priv or auth_priv")
 )
 
 // Config defines the configuration for the various elements of the receiver.
@@ -131,6 +137,29 @@ type Config struct {
 	// Metrics defines what SNMP metrics will be collected for this receiver and is composed of metric
 	// names along with their metric configurations
 	Metrics map[string]*MetricConfig `mapstructure:"metrics"`
+
+	// Traps configures the listener used to receive SNMP traps and informs as logs.
+	// The Version, Community, and v3 security configs above are used to authenticate received messages.
+	// Required only if the receiver is used in a logs pipeline, in which case Metrics may be left empty
+	Traps *TrapsConfig `mapstructure:"traps"`
+}
+
+// TrapsConfig contains config info about receiving SNMP traps and informs.
+type TrapsConfig struct {
+	// Endpoint is the address to listen for traps and informs on. Must be formatted as udp://{host}:{port}.
+	// Default: udp://0.0.0.0:162
+	// If no scheme is given, udp is assumed.
+	// If no port is given, 162 is assumed.
+	Endpoint string `mapstructure:"endpoint"`
+
+	// EngineID is the hex encoded SNMP engine ID the v3 auth and privacy keys are localized with. This is
+	// the engine ID of the sending agent for traps, and the engine ID senders use for this receiver for informs.
+	// Only valid for version "v3" and required if "no_auth_no_priv" is not selected for SecurityLevel
+	EngineID string `mapstructure:"engine_id"`
+
+	// MIBFiles is an optional list of MIB files whose object definitions are used to resolve the OIDs
+	// of received traps and their variable bindings to names. Common SNMPv2-MIB names are always resolved.
+	MIBFiles []string `mapstructure:"mib_files"`
 }
 
 // ResourceAttributeConfig contains config info about all of the resource attributes that will be used by this receiver.
@@ -250,6 +279,9 @@ func (cfg *Config) Validate() error {
 		combinedErr = errors.Join(combinedErr, validateSecurity(cfg))
 	}
 	combinedErr = errors.Join(combinedErr, validateMetricConfigs(cfg))
+	if cfg.Traps != nil {
+		combinedErr = errors.Join(combinedErr, validateTraps(cfg))
+	}
 
 	return combinedErr
 }
@@ -279,6 +311,34 @@ func validateEndpoint(cfg *Config) error {
 	return nil
 }
 
+// validateTraps validates the TrapsConfig
+func validateTraps(cfg *Config) error {
+	var combinedErr error
+
+	// An empty endpoint is replaced by the default one
+	if cfg.Traps.Endpoint != "" {
+		u, err := url.Parse(cfg.Traps.Endpoint)
+		switch {
+		case err != nil || u.Port() == "":
+			combinedErr = errors.Join(combinedErr, fmt.Errorf(errMsgInvalidTrapsEndpoint, cfg.Traps.Endpoint))
+		case strings.ToUpper(u.Scheme) != "UDP":
+			combinedErr = errors.Join(combinedErr, errTrapsBadScheme)
+		}
+	}
+
+	if _, err := hex.DecodeString(strings.TrimPrefix(cfg.Traps.EngineID, "0x")); err != nil {
+		combinedErr = errors.Join(combinedErr, fmt.Errorf(errMsgBadTrapsEngineID, cfg.Traps.EngineID, err))
+	}
+	if strings.ToUpper(cfg.Version) == "V3" && cfg.Traps.EngineID == "" {
+		switch strings.ToUpper(cfg.SecurityLevel) {
+		case "AUTH_NO_PRIV", "AUTH_PRIV":
+			combinedErr = errors.Join(combinedErr, errEmptyTrapsEngineID)
+		}
+	}
+
+	return combinedErr
+}
+
 // validateVersion validates the Version
 func validateVersion(cfg *Config) error {
 	if cfg.Version == "" {
@@ -378,9 +438,12 @@ func validateMetricConfigs(cfg *Config) error {
 	combinedErr = errors.Join(combinedErr, validateAttributeConfigs(cfg))
 	combinedErr = errors.Join(combinedErr, validateResourceAttributeConfigs(cfg))
 
-	// Ensure there is at least one MetricConfig
+	// Ensure there is at least one MetricConfig, unless only traps are received
 	metrics := cfg.Metrics
 	if len(metrics) == 0 {
+		if cfg.Traps != nil {
+			return combinedErr
+		}
 		return errors.Join(combinedErr, errMetricRequired)
 	}
 
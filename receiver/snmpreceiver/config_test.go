@@ -347,6 +347,92 @@ func getBaseResourceAttrConfig(attrType string) map[string]*ResourceAttributeCon
 	}
 }
 
+func TestLoadConfigTrapsConfigs(t *testing.T) {
+	cm, err := confmaptest.LoadConf(filepath.Join("testdata", "config.yaml"))
+	require.NoError(t, err)
+
+	factory := NewFactory()
+
+	type testCase struct {
+		name        string
+		nameVal     string
+		expectedCfg *Config
+		expectedErr string
+	}
+
+	expectedConfigTrapsOnly := factory.CreateDefaultConfig().(*Config)
+	expectedConfigTrapsOnly.Version = "v3"
+	expectedConfigTrapsOnly.User = "u"
+	expectedConfigTrapsOnly.SecurityLevel = "auth_priv"
+	expectedConfigTrapsOnly.AuthType = "sha"
+	expectedConfigTrapsOnly.AuthPassword = "p"
+	expectedConfigTrapsOnly.PrivacyType = "aes"
+	expectedConfigTrapsOnly.PrivacyPassword = "pp"
+	expectedConfigTrapsOnly.Traps = &TrapsConfig{
+		Endpoint: "udp://0.0.0.0:1162",
+		EngineID: "8000000001020304",
+		MIBFiles: []string{"testdata/mibs/TEST-MIB.txt"},
+	}
+
+	expectedConfigTrapsBadScheme := factory.CreateDefaultConfig().(*Config)
+	expectedConfigTrapsBadScheme.Traps = &TrapsConfig{Endpoint: "tcp://0.0.0.0:162"}
+
+	expectedConfigTrapsBadEngineID := factory.CreateDefaultConfig().(*Config)
+	expectedConfigTrapsBadEngineID.Traps = &TrapsConfig{EngineID: "not hex"}
+
+	expectedConfigTrapsV3NoEngineID := factory.CreateDefaultConfig().(*Config)
+	expectedConfigTrapsV3NoEngineID.Version = "v3"
+	expectedConfigTrapsV3NoEngineID.User = "u"
+	expectedConfigTrapsV3NoEngineID.SecurityLevel = "auth_no_priv"
+	expectedConfigTrapsV3NoEngineID.AuthType = "md5"
+	expectedConfigTrapsV3NoEngineID.AuthPassword = "p"
+	expectedConfigTrapsV3NoEngineID.Traps = &TrapsConfig{Endpoint: "udp://0.0.0.0:162"}
+
+	testCases := []testCase{
+		{
+			name:        "GoodTrapsOnlyNoErrors",
+			nameVal:     "traps_only",
+			expectedCfg: expectedConfigTrapsOnly,
+			expectedErr: "",
+		},
+		{
+			name:        "TrapsBadSchemeErrors",
+			nameVal:     "traps_bad_scheme",
+			expectedCfg: expectedConfigTrapsBadScheme,
+			expectedErr: errTrapsBadScheme.Error(),
+		},
+		{
+			name:        "TrapsBadEngineIDErrors",
+			nameVal:     "traps_bad_engine_id",
+			expectedCfg: expectedConfigTrapsBadEngineID,
+			expectedErr: "traps engine_id 'not hex' must be hex encoded",
+		},
+		{
+			name:        "TrapsV3NoEngineIDErrors",
+			nameVal:     "traps_v3_no_engine_id",
+			expectedCfg: expectedConfigTrapsV3NoEngineID,
+			expectedErr: errEmptyTrapsEngineID.Error(),
+		},
+	}
+
+	for _, test := range testCases {
+		t.Run(test.name, func(t *testing.T) {
+			sub, err := cm.Sub(component.NewIDWithName(metadata.Type, test.nameVal).String())
+			require.NoError(t, err)
+
+			cfg := factory.CreateDefaultConfig()
+			require.NoError(t, sub.Unmarshal(cfg))
+			if test.expectedErr == "" {
+				require.NoError(t, component.ValidateConfig(cfg))
+			} else {
+				require.ErrorContains(t, component.ValidateConfig(cfg), test.expectedErr)
+			}
+
+			require.Equal(t, test.expectedCfg, cfg)
+		})
+	}
+}
+
 func TestLoadConfigMetricConfigs(t *testing.T) {
 	cm, err := confmaptest.LoadConf(filepath.Join("testdata", "config.yaml"))
 	require.NoError(t, err)
@@ -18,14 +18,18 @@ import (
 	"github.com/open-telemetry/opentelemetry-collector-contrib/receiver/snmpreceiver/internal/metadata"
 )
 
-var errConfigNotSNMP = errors.New("config was not a SNMP receiver config")
+var (
+	errConfigNotSNMP = errors.New("config was not a SNMP receiver config")
+	errTrapsRequired = errors.New("traps must be configured to receive SNMP traps as logs")
+)
 
 // NewFactory creates a new receiver factory for SNMP
 func NewFactory() receiver.Factory {
 	return receiver.NewFactory(
 		metadata.Type,
 		createDefaultConfig,
-		receiver.WithMetrics(createMetricsReceiver, metadata.MetricsStability))
+		receiver.WithMetrics(createMetricsReceiver, metadata.MetricsStability),
+		receiver.WithLogs(createLogsReceiver, metadata.LogsStability))
 }
 
 // createDefaultConfig creates a config for SNMP with as many default values as possible
@@ -59,6 +63,9 @@ func createMetricsReceiver(
 	if err := addMissingConfigDefaults(snmpConfig); err != nil {
 		return nil, fmt.Errorf("failed to validate added config defaults: %w", err)
 	}
+	if len(snmpConfig.Metrics) == 0 {
+		return nil, errMetricRequired
+	}
 
 	snmpScraper := newScraper(params.Logger, snmpConfig, params)
 	scraper, err := scraperhelper.NewScraper(metadata.Type.String(), snmpScraper.scrape, scraperhelper.WithStart(snmpScraper.start))
@@ -69,6 +76,28 @@ func createMetricsReceiver(
 	return scraperhelper.NewScraperControllerReceiver(&snmpConfig.ControllerConfig, params, consumer, scraperhelper.AddScraper(scraper))
 }
 
+// createLogsReceiver creates the logs receiver for SNMP traps
+func createLogsReceiver(
+	_ context.Context,
+	params receiver.CreateSettings,
+	config component.Config,
+	consumer consumer.Logs,
+) (receiver.Logs, error) {
+	snmpConfig, ok := config.(*Config)
+	if !ok {
+		return nil, errConfigNotSNMP
+	}
+	if snmpConfig.Traps == nil {
+		return nil, errTrapsRequired
+	}
+
+	if err := addMissingConfigDefaults(snmpConfig); err != nil {
+		return nil, fmt.Errorf("failed to validate added config defaults: %w", err)
+	}
+
+	return newTrapReceiver(params, snmpConfig, consumer)
+}
+
 // addMissingConfigDefaults adds any missing config parameters that have defaults
 func addMissingConfigDefaults(cfg *Config) error {
 	// Add the schema prefix to the endpoint if it doesn't contain one
@@ -86,6 +115,20 @@ func addMissingConfigDefaults(cfg *Config) error {
 		cfg.Endpoint += portSuffix
 	}
 
+	// Add the default traps endpoint, schema prefix, and port if they are missing
+	if cfg.Traps != nil {
+		switch {
+		case cfg.Traps.Endpoint == "":
+			cfg.Traps.Endpoint = defaultTrapsEndpoint
+		case !strings.Contains(cfg.Traps.Endpoint, "://"):
+			cfg.Traps.Endpoint = "udp://" + cfg.Traps.Endpoint
+		}
+		u, err := url.Parse(cfg.Traps.Endpoint)
+		if err == nil && u.Port() == "" {
+			cfg.Traps.Endpoint = strings.TrimSuffix(cfg.Traps.Endpoint, ":") + ":162"
+		}
+	}
+
 	// Set defaults for metric configs
 	for _, metricCfg := range cfg.Metrics {
 		if metricCfg.Unit == "" {
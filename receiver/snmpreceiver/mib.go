@@ -0,0 +1,276 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package snmpreceiver // import "github.com/open-telemetry/opentelemetry-collector-contrib/receiver/snmpreceiver"
+
+import (
+	"fmt"
+	"os"
+	"strconv"
+	"strings"
+	"unicode"
+)
+
+// builtinMIB defines the OID tree roots from SNMPv2-SMI along with the SNMPv2-MIB objects and notifications
+// commonly found in traps, so they are resolved without any MIB files being configured
+const builtinMIB = `
+org            OBJECT IDENTIFIER ::= { iso 3 }
+dod            OBJECT IDENTIFIER ::= { org 6 }
+internet       OBJECT IDENTIFIER ::= { dod 1 }
+directory      OBJECT IDENTIFIER ::= { internet 1 }
+mgmt           OBJECT IDENTIFIER ::= { internet 2 }
+mib-2          OBJECT IDENTIFIER ::= { mgmt 1 }
+transmission   OBJECT IDENTIFIER ::= { mib-2 10 }
+experimental   OBJECT IDENTIFIER ::= { internet 3 }
+private        OBJECT IDENTIFIER ::= { internet 4 }
+enterprises    OBJECT IDENTIFIER ::= { private 1 }
+security       OBJECT IDENTIFIER ::= { internet 5 }
+snmpV2         OBJECT IDENTIFIER ::= { internet 6 }
+snmpDomains    OBJECT IDENTIFIER ::= { snmpV2 1 }
+snmpProxys     OBJECT IDENTIFIER ::= { snmpV2 2 }
+snmpModules    OBJECT IDENTIFIER ::= { snmpV2 3 }
+system         OBJECT IDENTIFIER ::= { mib-2 1 }
+sysDescr       OBJECT-TYPE ::= { system 1 }
+sysObjectID    OBJECT-TYPE ::= { system 2 }
+sysUpTime      OBJECT-TYPE ::= { system 3 }
+snmpMIB        MODULE-IDENTITY ::= { snmpModules 1 }
+snmpMIBObjects OBJECT IDENTIFIER ::= { snmpMIB 1 }
+snmpTrap       OBJECT IDENTIFIER ::= { snmpMIBObjects 4 }
+snmpTrapOID    OBJECT-TYPE ::= { snmpTrap 1 }
+snmpTrapEnterprise OBJECT-TYPE ::= { snmpTrap 3 }
+snmpTraps      OBJECT IDENTIFIER ::= { snmpMIBObjects 5 }
+coldStart      NOTIFICATION-TYPE ::= { snmpTraps 1 }
+warmStart      NOTIFICATION-TYPE ::= { snmpTraps 2 }
+linkDown       NOTIFICATION-TYPE ::= { snmpTraps 3 }
+linkUp         NOTIFICATION-TYPE ::= { snmpTraps 4 }
+authenticationFailure NOTIFICATION-TYPE ::= { snmpTraps 5 }
+`
+
+// mibDefinitionKeywords are the macros and types following the name of a MIB definition with an OID value
+var mibDefinitionKeywords = map[string]bool{
+	"OBJECT":             true, // OBJECT IDENTIFIER
+	"OBJECT-TYPE":        true,
+	"OBJECT-IDENTITY":    true,
+	"MODULE-IDENTITY":    true,
+	"NOTIFICATION-TYPE":  true,
+	"TRAP-TYPE":          true,
+	"OBJECT-GROUP":       true,
+	"NOTIFICATION-GROUP": true,
+	"MODULE-COMPLIANCE":  true,
+	"AGENT-CAPABILITIES": true,
+}
+
+// mibArc is a single component of an OID value, e.g. "ifEntry 1" or "internet(1)"
+type mibArc struct {
+	name   string
+	number string
+}
+
+// mibDefinition is an unresolved OID value assignment from a MIB
+type mibDefinition struct {
+	name   string
+	parent string
+	arcs   []mibArc
+}
+
+// mibTree resolves OIDs to the names of the objects defined for them in MIBs
+type mibTree struct {
+	// oids maps object names to their numeric OID
+	oids map[string]string
+	// names maps numeric OIDs to their object name
+	names map[string]string
+}
+
+// newMIBTree creates a mibTree from the built-in definitions and the given MIB files
+func newMIBTree(files []string) (*mibTree, error) {
+	definitions := parseMIB(builtinMIB)
+	for _, file := range files {
+		content, err := os.ReadFile(file)
+		if err != nil {
+			return nil, fmt.Errorf("failed to read MIB file '%s': %w", file, err)
+		}
+		definitions = append(definitions, parseMIB(string(content))...)
+	}
+
+	tree := &mibTree{
+		oids:  map[string]string{"ccitt": ".0", "iso": ".1", "joint-iso-ccitt": ".2"},
+		names: map[string]string{},
+	}
+
+	// Definitions may reference parents defined later on or in other files, so keep resolving
+	// until no more progress is made. Definitions whose parents are never found are ignored.
+	for len(definitions) > 0 {
+		var unresolved []mibDefinition
+		for _, def := range definitions {
+			if !tree.resolve(def) {
+				unresolved = append(unresolved, def)
+			}
+		}
+		if len(unresolved) == len(definitions) {
+			break
+		}
+		definitions = unresolved
+	}
+
+	for name, oid := range tree.oids {
+		tree.names[oid] = name
+	}
+	return tree, nil
+}
+
+// resolve adds the OID of a definition to the tree if its parent is known
+func (t *mibTree) resolve(def mibDefinition) bool {
+	oid, ok := t.oids[def.parent]
+	if !ok {
+		if !isNumber(def.parent) {
+			return false
+		}
+		oid = "." + def.parent
+	}
+
+	for _, arc := range def.arcs {
+		oid += "." + arc.number
+		// Intermediate named arcs, e.g. "{ iso org(3) dod(6) 1 }", also define names
+		if arc.name != "" {
+			if _, exists := t.oids[arc.name]; !exists {
+				t.oids[arc.name] = oid
+			}
+		}
+	}
+	t.oids[def.name] = oid
+	return true
+}
+
+// Name returns the name of an OID, suffixed by the remaining arcs when only a parent of the OID is
+// defined, e.g. "ifIndex.3" for ".1.3.6.1.2.1.2.2.1.1.3". It returns false if no parent is defined.
+func (t *mibTree) Name(oid string) (string, bool) {
+	oid = "." + strings.TrimPrefix(oid, ".")
+	for prefix := oid; prefix != ""; prefix = prefix[:strings.LastIndex(prefix, ".")] {
+		if name, ok := t.names[prefix]; ok {
+			return name + oid[len(prefix):], true
+		}
+	}
+	return "", false
+}
+
+// parseMIB extracts the OID value assignments of the objects defined in the content of a MIB.
+// Only what is needed to name OIDs is understood, everything else in the MIB is skipped.
+func parseMIB(content string) []mibDefinition {
+	tokens := tokenizeMIB(content)
+
+	var definitions []mibDefinition
+	var current *mibDefinition
+	var enterprise string
+	var trapType bool
+	for i := 0; i < len(tokens); i++ {
+		token := tokens[i]
+		switch {
+		case token == "MACRO":
+			// Skip macro definitions, such as the ones for OBJECT-TYPE in SNMPv2-SMI
+			for i < len(tokens) && tokens[i] != "END" {
+				i++
+			}
+			current = nil
+		case mibDefinitionKeywords[token] && i > 0 && isMIBValueName(tokens[i-1]):
+			if token == "OBJECT" && (i+1 >= len(tokens) || tokens[i+1] != "IDENTIFIER") {
+				continue
+			}
+			current = &mibDefinition{name: tokens[i-1]}
+			trapType = token == "TRAP-TYPE"
+			enterprise = ""
+		case token == "ENTERPRISE" && current != nil && i+1 < len(tokens):
+			enterprise = tokens[i+1]
+		case token == "::=" && current != nil && i+1 < len(tokens):
+			if trapType {
+				// SNMPv1 traps are identified by their enterprise and specific trap number, as per RFC 3584
+				if enterprise != "" {
+					current.parent = enterprise
+					current.arcs = []mibArc{{number: "0"}, {number: tokens[i+1]}}
+					definitions = append(definitions, *current)
+				}
+			} else if tokens[i+1] == "{" {
+				if def, ok := parseMIBValue(current.name, tokens[i+2:]); ok {
+					definitions = append(definitions, def)
+				}
+			}
+			current = nil
+		}
+	}
+	return definitions
+}
+
+// parseMIBValue parses an OID value such as "{ parent 1 }" or "{ iso org(3) dod(6) 1 }",
+// starting after its opening brace
+func parseMIBValue(name string, tokens []string) (mibDefinition, bool) {
+	def := mibDefinition{name: name}
+	for i := 0; i < len(tokens); i++ {
+		token := tokens[i]
+		switch {
+		case token == "}":
+			return def, def.parent != "" && len(def.arcs) > 0
+		case def.parent == "":
+			def.parent = token
+		case isNumber(token):
+			def.arcs = append(def.arcs, mibArc{number: token})
+		case i+3 < len(tokens) && tokens[i+1] == "(" && isNumber(tokens[i+2]) && tokens[i+3] == ")":
+			def.arcs = append(def.arcs, mibArc{name: token, number: tokens[i+2]})
+			i += 3
+		default:
+			return def, false
+		}
+	}
+	return def, false
+}
+
+// tokenizeMIB splits the content of a MIB into tokens, dropping comments and quoted strings
+func tokenizeMIB(content string) []string {
+	var tokens []string
+	runes := []rune(content)
+	for i := 0; i < len(runes); {
+		r := runes[i]
+		switch {
+		case unicode.IsSpace(r):
+			i++
+		case r == '-' && i+1 < len(runes) && runes[i+1] == '-':
+			// Comments end at the end of the line or at the next "--"
+			i += 2
+			for i < len(runes) && runes[i] != '\n' {
+				if runes[i] == '-' && i+1 < len(runes) && runes[i+1] == '-' {
+					i += 2
+					break
+				}
+				i++
+			}
+		case r == '"':
+			i++
+			for i < len(runes) && runes[i] != '"' {
+				i++
+			}
+			i++
+		case r == ':' && i+2 < len(runes) && runes[i+1] == ':' && runes[i+2] == '=':
+			tokens = append(tokens, "::=")
+			i += 3
+		case unicode.IsLetter(r) || unicode.IsDigit(r):
+			start := i
+			for i < len(runes) && (unicode.IsLetter(runes[i]) || unicode.IsDigit(runes[i]) || runes[i] == '_' ||
+				(runes[i] == '-' && !(i+1 < len(runes) && runes[i+1] == '-'))) {
+				i++
+			}
+			tokens = append(tokens, string(runes[start:i]))
+		default:
+			tokens = append(tokens, string(r))
+			i++
+		}
+	}
+	return tokens
+}
+
+// isMIBValueName returns whether a token can name a value, which unlike types start with a lowercase letter
+func isMIBValueName(token string) bool {
+	return token != "" && unicode.IsLower([]rune(token)[0])
+}
+
+func isNumber(token string) bool {
+	_, err := strconv.ParseUint(token, 10, 32)
+	return err == nil
+}
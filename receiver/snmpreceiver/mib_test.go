@@ -0,0 +1,107 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package snmpreceiver // import "github.com/open-telemetry/opentelemetry-collector-contrib/receiver/snmpreceiver"
+
+import (
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMIBTreeName(t *testing.T) {
+	tree, err := newMIBTree([]string{filepath.Join("testdata", "mibs", "TEST-MIB.txt")})
+	require.NoError(t, err)
+
+	testCases := []struct {
+		desc         string
+		oid          string
+		expectedName string
+		expectedOK   bool
+	}{
+		{
+			desc:         "Notification defined before its parent",
+			oid:          ".1.3.6.1.4.1.99999.0.1",
+			expectedName: "testAlarm",
+			expectedOK:   true,
+		},
+		{
+			desc:         "Column with index",
+			oid:          ".1.3.6.1.4.1.99999.1.1.1.2.5",
+			expectedName: "testAlarmSeverity.5",
+			expectedOK:   true,
+		},
+		{
+			desc:         "OID without leading dot",
+			oid:          "1.3.6.1.4.1.99999.1.1.1.3.5",
+			expectedName: "testAlarmText.5",
+			expectedOK:   true,
+		},
+		{
+			desc:         "Named arc of OID value",
+			oid:          ".1.3.6.1.4.1.88888",
+			expectedName: "legacyVendor",
+			expectedOK:   true,
+		},
+		{
+			desc:         "SNMPv1 trap type",
+			oid:          ".1.3.6.1.4.1.88888.1.0.7",
+			expectedName: "testLegacyTrap",
+			expectedOK:   true,
+		},
+		{
+			desc:         "Definition in a quoted string is ignored",
+			oid:          ".1.3.6.1.4.1.1",
+			expectedName: "enterprises.1",
+			expectedOK:   true,
+		},
+		{
+			desc:         "Built-in notification",
+			oid:          ".1.3.6.1.6.3.1.1.5.3",
+			expectedName: "linkDown",
+			expectedOK:   true,
+		},
+		{
+			desc:         "Built-in object instance",
+			oid:          ".1.3.6.1.2.1.1.3.0",
+			expectedName: "sysUpTime.0",
+			expectedOK:   true,
+		},
+		{
+			desc:       "Unknown root",
+			oid:        ".3.1",
+			expectedOK: false,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.desc, func(t *testing.T) {
+			name, ok := tree.Name(tc.oid)
+			assert.Equal(t, tc.expectedOK, ok)
+			assert.Equal(t, tc.expectedName, name)
+		})
+	}
+}
+
+func TestMIBTreeMissingFile(t *testing.T) {
+	_, err := newMIBTree([]string{filepath.Join("testdata", "mibs", "MISSING-MIB.txt")})
+	require.ErrorContains(t, err, "failed to read MIB file")
+}
+
+func TestParseMIBSkipsMacros(t *testing.T) {
+	definitions := parseMIB(`
+OBJECT-TYPE MACRO ::=
+BEGIN
+    VALUE NOTATION ::= value (VALUE ObjectName)
+    ObjectName ::= OBJECT IDENTIFIER
+END
+foo OBJECT IDENTIFIER ::= { bar 1 }
+sysObjectID OBJECT-TYPE SYNTAX OBJECT IDENTIFIER ::= { system 2 }
+`)
+	assert.Equal(t, []mibDefinition{
+		{name: "foo", parent: "bar", arcs: []mibArc{{number: "1"}}},
+		{name: "sysObjectID", parent: "system", arcs: []mibArc{{number: "2"}}},
+	}, definitions)
+}
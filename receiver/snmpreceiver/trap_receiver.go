@@ -0,0 +1,275 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package snmpreceiver // import "github.com/open-telemetry/opentelemetry-collector-contrib/receiver/snmpreceiver"
+
+import (
+	"context"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"net"
+	"strconv"
+	"strings"
+	"time"
+	"unicode"
+	"unicode/utf8"
+
+	"github.com/gosnmp/gosnmp"
+	"go.opentelemetry.io/collector/component"
+	"go.opentelemetry.io/collector/consumer"
+	"go.opentelemetry.io/collector/pdata/pcommon"
+	"go.opentelemetry.io/collector/pdata/plog"
+	"go.opentelemetry.io/collector/receiver"
+	"go.opentelemetry.io/collector/receiver/receiverhelper"
+	"go.uber.org/zap"
+)
+
+const (
+	// snmpTrapOID is the OID of the variable binding holding the OID of v2c and v3 traps
+	snmpTrapOID = ".1.3.6.1.6.3.1.1.4.1.0"
+	// snmpTrapsOIDPrefix is the OID prefix of the generic traps of SNMPv2-MIB
+	snmpTrapsOIDPrefix = ".1.3.6.1.6.3.1.1.5."
+	// enterpriseSpecificTrap is the generic trap value of v1 traps which are specific to their enterprise
+	enterpriseSpecificTrap = 6
+
+	attributeTrapOID         = "snmp.trap.oid"
+	attributeVersion         = "snmp.version"
+	attributePDUType         = "snmp.pdu.type"
+	attributeAgentAddress    = "snmp.agent.address"
+	attributeVarbinds        = "snmp.varbinds"
+	attributePeerAddress     = "network.peer.address"
+	attributePeerPort        = "network.peer.port"
+	trapsTransport           = "udp"
+	trapsListenStartTimeout  = 5 * time.Second
+	trapsConsumeFormatString = "snmp"
+)
+
+var errTrapsListenerTimeout = errors.New("timed out waiting for the traps listener to start")
+
+// trapReceiver listens for SNMP traps and informs and converts them into logs
+type trapReceiver struct {
+	logger   *zap.Logger
+	cfg      *Config
+	consumer consumer.Logs
+	obsrecv  *receiverhelper.ObsReport
+	mibs     *mibTree
+	listener *gosnmp.TrapListener
+}
+
+// Verify trapReceiver implements the receiver.Logs interface
+var _ receiver.Logs = (*trapReceiver)(nil)
+
+// newTrapReceiver creates a trapReceiver
+// Relies on config being validated thoroughly
+func newTrapReceiver(params receiver.CreateSettings, cfg *Config, consumer consumer.Logs) (*trapReceiver, error) {
+	obsrecv, err := receiverhelper.NewObsReport(receiverhelper.ObsReportSettings{
+		ReceiverID:             params.ID,
+		Transport:              trapsTransport,
+		ReceiverCreateSettings: params,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &trapReceiver{
+		logger:   params.Logger,
+		cfg:      cfg,
+		consumer: consumer,
+		obsrecv:  obsrecv,
+	}, nil
+}
+
+// Start loads the configured MIBs and starts listening for traps
+func (r *trapReceiver) Start(_ context.Context, _ component.Host) error {
+	mibs, err := newMIBTree(r.cfg.Traps.MIBFiles)
+	if err != nil {
+		return err
+	}
+	r.mibs = mibs
+
+	params, err := newTrapListenerParams(r.cfg)
+	if err != nil {
+		return err
+	}
+	r.listener = gosnmp.NewTrapListener()
+	r.listener.Params = params
+	r.listener.OnNewTrap = r.handleTrap
+
+	listenErr := make(chan error, 1)
+	go func() {
+		listenErr <- r.listener.Listen(r.cfg.Traps.Endpoint)
+	}()
+
+	select {
+	case <-r.listener.Listening():
+		return nil
+	case err := <-listenErr:
+		return fmt.Errorf("failed to listen for traps on '%s': %w", r.cfg.Traps.Endpoint, err)
+	case <-time.After(trapsListenStartTimeout):
+		return errTrapsListenerTimeout
+	}
+}
+
+// Shutdown stops listening for traps
+func (r *trapReceiver) Shutdown(_ context.Context) error {
+	if r.listener != nil {
+		r.listener.Close()
+	}
+	return nil
+}
+
+// newTrapListenerParams creates the gosnmp parameters used to decode and authenticate traps based on config
+func newTrapListenerParams(cfg *Config) (*gosnmp.GoSNMP, error) {
+	goSNMP := &otelGoSNMPWrapper{}
+	switch strings.ToUpper(cfg.Version) {
+	case "V3":
+		goSNMP.SetVersion(gosnmp.Version3)
+	case "V1":
+		goSNMP.SetVersion(gosnmp.Version1)
+	default:
+		goSNMP.SetVersion(gosnmp.Version2c)
+	}
+
+	if goSNMP.GetVersion() != gosnmp.Version3 {
+		goSNMP.SetCommunity(cfg.Community)
+		return &goSNMP.GoSNMP, nil
+	}
+
+	// Set goSNMP v3 configs, with the keys localized to the configured engine ID
+	setV3ClientConfigs(goSNMP, cfg)
+	engineID, err := hex.DecodeString(strings.TrimPrefix(cfg.Traps.EngineID, "0x"))
+	if err != nil {
+		return nil, fmt.Errorf(errMsgBadTrapsEngineID, cfg.Traps.EngineID, err)
+	}
+	securityParams := goSNMP.GetSecurityParameters().(*gosnmp.UsmSecurityParameters)
+	securityParams.AuthoritativeEngineID = string(engineID)
+	return &goSNMP.GoSNMP, nil
+}
+
+// handleTrap converts a received trap or inform to a log and passes it to the next consumer
+func (r *trapReceiver) handleTrap(packet *gosnmp.SnmpPacket, addr *net.UDPAddr) {
+	if !r.isAccepted(packet) {
+		r.logger.Debug("Dropping SNMP trap not matching the configured version, community, or user",
+			zap.Stringer("peer", addr), zap.String("version", packet.Version.String()))
+		return
+	}
+
+	logs := r.trapToLogs(packet, addr, time.Now())
+
+	ctx := r.obsrecv.StartLogsOp(context.Background())
+	err := r.consumer.ConsumeLogs(ctx, logs)
+	r.obsrecv.EndLogsOp(ctx, trapsConsumeFormatString, logs.LogRecordCount(), err)
+	if err != nil {
+		r.logger.Error("Failed to consume SNMP trap", zap.Error(err))
+	}
+}
+
+// isAccepted returns whether a trap was sent with the configured version and community or v3 user.
+// The v3 authentication and decryption have already been done when decoding the trap.
+func (r *trapReceiver) isAccepted(packet *gosnmp.SnmpPacket) bool {
+	if packet.Version != r.listener.Params.Version {
+		return false
+	}
+	if packet.Version != gosnmp.Version3 {
+		return packet.Community == r.cfg.Community
+	}
+	securityParams, ok := packet.SecurityParameters.(*gosnmp.UsmSecurityParameters)
+	return ok && securityParams.UserName == r.cfg.User
+}
+
+// trapToLogs converts a trap to a log record with the trap name as body
+func (r *trapReceiver) trapToLogs(packet *gosnmp.SnmpPacket, addr *net.UDPAddr, now time.Time) plog.Logs {
+	logs := plog.NewLogs()
+	scopeLogs := logs.ResourceLogs().AppendEmpty().ScopeLogs().AppendEmpty()
+	scopeLogs.Scope().SetName("otelcol/snmpreceiver")
+	record := scopeLogs.LogRecords().AppendEmpty()
+	record.SetObservedTimestamp(pcommon.NewTimestampFromTime(now))
+	record.SetTimestamp(pcommon.NewTimestampFromTime(now))
+
+	attrs := record.Attributes()
+	attrs.PutStr(attributeVersion, packet.Version.String())
+	if packet.PDUType == gosnmp.InformRequest {
+		attrs.PutStr(attributePDUType, "inform")
+	} else {
+		attrs.PutStr(attributePDUType, "trap")
+	}
+	if addr != nil {
+		attrs.PutStr(attributePeerAddress, addr.IP.String())
+		attrs.PutInt(attributePeerPort, int64(addr.Port))
+	}
+
+	var trapOID string
+	if packet.PDUType == gosnmp.Trap {
+		trapOID = v1TrapOID(packet.SnmpTrap)
+		attrs.PutStr(attributeAgentAddress, packet.AgentAddress)
+	}
+
+	varbinds := attrs.PutEmptyMap(attributeVarbinds)
+	for _, pdu := range packet.Variables {
+		if pdu.Name == snmpTrapOID {
+			if oid, ok := pdu.Value.(string); ok {
+				trapOID = oid
+			}
+			continue
+		}
+		r.putVarbind(varbinds, pdu)
+	}
+
+	attrs.PutStr(attributeTrapOID, trapOID)
+	record.Body().SetStr(r.oidName(trapOID))
+	return logs
+}
+
+// v1TrapOID returns the OID identifying a v1 trap, as per RFC 3584
+func v1TrapOID(trap gosnmp.SnmpTrap) string {
+	if trap.GenericTrap >= 0 && trap.GenericTrap < enterpriseSpecificTrap {
+		return snmpTrapsOIDPrefix + strconv.Itoa(trap.GenericTrap+1)
+	}
+	return "." + strings.TrimPrefix(trap.Enterprise, ".") + ".0." + strconv.Itoa(trap.SpecificTrap)
+}
+
+// oidName returns the name of an OID if it is defined in the MIBs, or the OID itself otherwise
+func (r *trapReceiver) oidName(oid string) string {
+	if name, ok := r.mibs.Name(oid); ok {
+		return name
+	}
+	return oid
+}
+
+// putVarbind adds the value of a variable binding to a map, keyed by the name of its OID
+func (r *trapReceiver) putVarbind(varbinds pcommon.Map, pdu gosnmp.SnmpPDU) {
+	key := r.oidName(pdu.Name)
+	switch pdu.Type {
+	case gosnmp.Integer, gosnmp.Counter32, gosnmp.Gauge32, gosnmp.TimeTicks, gosnmp.Counter64, gosnmp.Uinteger32:
+		varbinds.PutInt(key, gosnmp.ToBigInt(pdu.Value).Int64())
+	case gosnmp.OpaqueFloat, gosnmp.OpaqueDouble:
+		switch value := pdu.Value.(type) {
+		case float32:
+			varbinds.PutDouble(key, float64(value))
+		case float64:
+			varbinds.PutDouble(key, value)
+		}
+	case gosnmp.OctetString:
+		value, _ := pdu.Value.([]byte)
+		if isPrintable(value) {
+			varbinds.PutStr(key, string(value))
+		} else {
+			varbinds.PutStr(key, hex.EncodeToString(value))
+		}
+	case gosnmp.ObjectIdentifier:
+		varbinds.PutStr(key, r.oidName(toString(pdu.Value)))
+	case gosnmp.IPAddress:
+		varbinds.PutStr(key, toString(pdu.Value))
+	default:
+		// Null, NoSuchObject, NoSuchInstance, EndOfMibView and unsupported types have no value
+		varbinds.PutEmpty(key)
+	}
+}
+
+// isPrintable returns whether an octet string is text rather than binary data such as a MAC address
+func isPrintable(value []byte) bool {
+	return utf8.Valid(value) && strings.IndexFunc(string(value), func(r rune) bool {
+		return !unicode.IsPrint(r) && !unicode.IsSpace(r)
+	}) == -1
+}
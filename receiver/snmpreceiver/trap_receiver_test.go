@@ -0,0 +1,253 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package snmpreceiver // import "github.com/open-telemetry/opentelemetry-collector-contrib/receiver/snmpreceiver"
+
+import (
+	"context"
+	"encoding/hex"
+	"fmt"
+	"net"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/gosnmp/gosnmp"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/collector/component/componenttest"
+	"go.opentelemetry.io/collector/consumer/consumertest"
+	"go.opentelemetry.io/collector/pdata/plog"
+	"go.opentelemetry.io/collector/receiver/receivertest"
+)
+
+const testEngineID = "8000000001020304"
+
+var testMIBFile = filepath.Join("testdata", "mibs", "TEST-MIB.txt")
+
+// startTrapReceiver starts a logs receiver listening for traps on a free local port and returns the port
+func startTrapReceiver(t *testing.T, cfg *Config, sink *consumertest.LogsSink) uint16 {
+	conn, err := net.ListenPacket("udp", "127.0.0.1:0")
+	require.NoError(t, err)
+	port := conn.LocalAddr().(*net.UDPAddr).Port
+	require.NoError(t, conn.Close())
+
+	cfg.Traps.Endpoint = fmt.Sprintf("udp://127.0.0.1:%d", port)
+	rcvr, err := NewFactory().CreateLogsReceiver(context.Background(), receivertest.NewNopCreateSettings(), cfg, sink)
+	require.NoError(t, err)
+	require.NoError(t, rcvr.Start(context.Background(), componenttest.NewNopHost()))
+	t.Cleanup(func() {
+		require.NoError(t, rcvr.Shutdown(context.Background()))
+	})
+	return uint16(port)
+}
+
+func newTrapSender(t *testing.T, port uint16, version gosnmp.SnmpVersion, community string) *gosnmp.GoSNMP {
+	return connectTrapSender(t, &gosnmp.GoSNMP{
+		Target:    "127.0.0.1",
+		Port:      port,
+		Version:   version,
+		Community: community,
+		Timeout:   2 * time.Second,
+		MaxOids:   gosnmp.MaxOids,
+	})
+}
+
+func connectTrapSender(t *testing.T, sender *gosnmp.GoSNMP) *gosnmp.GoSNMP {
+	require.NoError(t, sender.Connect())
+	t.Cleanup(func() {
+		require.NoError(t, sender.Conn.Close())
+	})
+	return sender
+}
+
+func waitForLogRecords(t *testing.T, sink *consumertest.LogsSink, count int) []plog.LogRecord {
+	require.Eventually(t, func() bool {
+		return sink.LogRecordCount() >= count
+	}, 5*time.Second, 10*time.Millisecond)
+
+	var records []plog.LogRecord
+	for _, logs := range sink.AllLogs() {
+		scopeLogs := logs.ResourceLogs().At(0).ScopeLogs().At(0)
+		require.Equal(t, "otelcol/snmpreceiver", scopeLogs.Scope().Name())
+		for i := 0; i < scopeLogs.LogRecords().Len(); i++ {
+			records = append(records, scopeLogs.LogRecords().At(i))
+		}
+	}
+	return records
+}
+
+func TestTrapReceiverV2C(t *testing.T) {
+	cfg := NewFactory().CreateDefaultConfig().(*Config)
+	cfg.Traps = &TrapsConfig{MIBFiles: []string{testMIBFile}}
+	sink := new(consumertest.LogsSink)
+	port := startTrapReceiver(t, cfg, sink)
+
+	// Traps with another community are dropped
+	_, err := newTrapSender(t, port, gosnmp.Version2c, "private").SendTrap(gosnmp.SnmpTrap{
+		Variables: []gosnmp.SnmpPDU{{Name: snmpTrapOID, Type: gosnmp.ObjectIdentifier, Value: ".1.3.6.1.6.3.1.1.5.1"}},
+	})
+	require.NoError(t, err)
+
+	_, err = newTrapSender(t, port, gosnmp.Version2c, "public").SendTrap(gosnmp.SnmpTrap{
+		Variables: []gosnmp.SnmpPDU{
+			{Name: ".1.3.6.1.2.1.1.3.0", Type: gosnmp.TimeTicks, Value: uint32(1234)},
+			{Name: snmpTrapOID, Type: gosnmp.ObjectIdentifier, Value: ".1.3.6.1.4.1.99999.0.1"},
+			{Name: ".1.3.6.1.4.1.99999.1.1.1.2.5", Type: gosnmp.Integer, Value: 3},
+			{Name: ".1.3.6.1.4.1.99999.1.1.1.3.5", Type: gosnmp.OctetString, Value: "disk full"},
+			{Name: ".1.3.6.1.4.1.12345.1", Type: gosnmp.OctetString, Value: []byte{0x00, 0x1a, 0x2b}},
+			{Name: ".1.3.6.1.4.1.12345.2", Type: gosnmp.IPAddress, Value: "10.0.0.1"},
+			{Name: ".1.3.6.1.4.1.12345.3", Type: gosnmp.ObjectIdentifier, Value: ".1.3.6.1.6.3.1.1.5.4"},
+			{Name: ".1.3.6.1.4.1.12345.4", Type: gosnmp.Counter64, Value: uint64(42)},
+		},
+	})
+	require.NoError(t, err)
+
+	records := waitForLogRecords(t, sink, 1)
+	require.Len(t, records, 1)
+	record := records[0]
+	assert.Equal(t, "testAlarm", record.Body().Str())
+	assert.NotZero(t, record.Timestamp())
+
+	attrs := record.Attributes().AsRaw()
+	assert.Equal(t, ".1.3.6.1.4.1.99999.0.1", attrs[attributeTrapOID])
+	assert.Equal(t, "2c", attrs[attributeVersion])
+	assert.Equal(t, "trap", attrs[attributePDUType])
+	assert.Equal(t, "127.0.0.1", attrs[attributePeerAddress])
+	assert.NotZero(t, attrs[attributePeerPort])
+	assert.Equal(t, map[string]any{
+		"sysUpTime.0":         int64(1234),
+		"testAlarmSeverity.5": int64(3),
+		"testAlarmText.5":     "disk full",
+		"enterprises.12345.1": "001a2b",
+		"enterprises.12345.2": "10.0.0.1",
+		"enterprises.12345.3": "linkUp",
+		"enterprises.12345.4": int64(42),
+	}, attrs[attributeVarbinds])
+}
+
+func TestTrapReceiverV2CInform(t *testing.T) {
+	cfg := NewFactory().CreateDefaultConfig().(*Config)
+	cfg.Traps = &TrapsConfig{}
+	sink := new(consumertest.LogsSink)
+	port := startTrapReceiver(t, cfg, sink)
+
+	// Informs are acknowledged, which SendTrap waits for
+	response, err := newTrapSender(t, port, gosnmp.Version2c, "public").SendTrap(gosnmp.SnmpTrap{
+		Variables: []gosnmp.SnmpPDU{{Name: snmpTrapOID, Type: gosnmp.ObjectIdentifier, Value: ".1.3.6.1.6.3.1.1.5.3"}},
+		IsInform:  true,
+	})
+	require.NoError(t, err)
+	assert.Equal(t, gosnmp.GetResponse, response.PDUType)
+
+	records := waitForLogRecords(t, sink, 1)
+	assert.Equal(t, "linkDown", records[0].Body().Str())
+	assert.Equal(t, "inform", records[0].Attributes().AsRaw()[attributePDUType])
+}
+
+func TestTrapReceiverV1(t *testing.T) {
+	cfg := NewFactory().CreateDefaultConfig().(*Config)
+	cfg.Version = "v1"
+	cfg.Traps = &TrapsConfig{MIBFiles: []string{testMIBFile}}
+	sink := new(consumertest.LogsSink)
+	port := startTrapReceiver(t, cfg, sink)
+
+	sender := newTrapSender(t, port, gosnmp.Version1, "public")
+	_, err := sender.SendTrap(gosnmp.SnmpTrap{
+		Enterprise:   ".1.3.6.1.4.1.88888.1",
+		AgentAddress: "192.168.1.10",
+		GenericTrap:  enterpriseSpecificTrap,
+		SpecificTrap: 7,
+		Variables:    []gosnmp.SnmpPDU{{Name: ".1.3.6.1.4.1.99999.1.1.1.3.1", Type: gosnmp.OctetString, Value: "legacy"}},
+	})
+	require.NoError(t, err)
+	_, err = sender.SendTrap(gosnmp.SnmpTrap{
+		Enterprise:   ".1.3.6.1.4.1.88888.1",
+		AgentAddress: "192.168.1.10",
+		GenericTrap:  0,
+	})
+	require.NoError(t, err)
+
+	records := waitForLogRecords(t, sink, 2)
+	assert.Equal(t, "testLegacyTrap", records[0].Body().Str())
+	attrs := records[0].Attributes().AsRaw()
+	assert.Equal(t, ".1.3.6.1.4.1.88888.1.0.7", attrs[attributeTrapOID])
+	assert.Equal(t, "1", attrs[attributeVersion])
+	assert.Equal(t, "192.168.1.10", attrs[attributeAgentAddress])
+	assert.Equal(t, map[string]any{"testAlarmText.1": "legacy"}, attrs[attributeVarbinds])
+
+	assert.Equal(t, "coldStart", records[1].Body().Str())
+	assert.Equal(t, ".1.3.6.1.6.3.1.1.5.1", records[1].Attributes().AsRaw()[attributeTrapOID])
+}
+
+func TestTrapReceiverV3AuthPriv(t *testing.T) {
+	cfg := NewFactory().CreateDefaultConfig().(*Config)
+	cfg.Version = "v3"
+	cfg.User = "u"
+	cfg.SecurityLevel = "auth_priv"
+	cfg.AuthType = "SHA"
+	cfg.AuthPassword = "authpassword"
+	cfg.PrivacyType = "AES"
+	cfg.PrivacyPassword = "privpassword"
+	cfg.Traps = &TrapsConfig{EngineID: testEngineID}
+	sink := new(consumertest.LogsSink)
+	port := startTrapReceiver(t, cfg, sink)
+
+	engineID, err := hex.DecodeString(testEngineID)
+	require.NoError(t, err)
+	newV3Sender := func(user string, authPassword string) *gosnmp.GoSNMP {
+		return connectTrapSender(t, &gosnmp.GoSNMP{
+			Target:        "127.0.0.1",
+			Port:          port,
+			Version:       gosnmp.Version3,
+			Timeout:       2 * time.Second,
+			MaxOids:       gosnmp.MaxOids,
+			SecurityModel: gosnmp.UserSecurityModel,
+			MsgFlags:      gosnmp.AuthPriv,
+			SecurityParameters: &gosnmp.UsmSecurityParameters{
+				UserName:                 user,
+				AuthoritativeEngineID:    string(engineID),
+				AuthenticationProtocol:   gosnmp.SHA,
+				AuthenticationPassphrase: authPassword,
+				PrivacyProtocol:          gosnmp.AES,
+				PrivacyPassphrase:        "privpassword",
+			},
+		})
+	}
+	trap := gosnmp.SnmpTrap{
+		Variables: []gosnmp.SnmpPDU{{Name: snmpTrapOID, Type: gosnmp.ObjectIdentifier, Value: ".1.3.6.1.6.3.1.1.5.5"}},
+	}
+
+	// Traps which fail authentication or are sent by another user are dropped
+	_, err = newV3Sender("u", "wrongpassword").SendTrap(trap)
+	require.NoError(t, err)
+	_, err = newV3Sender("other", "authpassword").SendTrap(trap)
+	require.NoError(t, err)
+
+	_, err = newV3Sender("u", "authpassword").SendTrap(trap)
+	require.NoError(t, err)
+
+	records := waitForLogRecords(t, sink, 1)
+	require.Len(t, records, 1)
+	assert.Equal(t, "authenticationFailure", records[0].Body().Str())
+	assert.Equal(t, "3", records[0].Attributes().AsRaw()[attributeVersion])
+}
+
+func TestTrapReceiverStartErrors(t *testing.T) {
+	cfg := NewFactory().CreateDefaultConfig().(*Config)
+	cfg.Traps = &TrapsConfig{MIBFiles: []string{filepath.Join("testdata", "mibs", "MISSING-MIB.txt")}}
+	rcvr, err := NewFactory().CreateLogsReceiver(context.Background(), receivertest.NewNopCreateSettings(), cfg, consumertest.NewNop())
+	require.NoError(t, err)
+	require.ErrorContains(t, rcvr.Start(context.Background(), componenttest.NewNopHost()), "failed to read MIB file")
+	require.NoError(t, rcvr.Shutdown(context.Background()))
+
+	conn, err := net.ListenPacket("udp", "127.0.0.1:0")
+	require.NoError(t, err)
+	defer conn.Close()
+	cfg = NewFactory().CreateDefaultConfig().(*Config)
+	cfg.Traps = &TrapsConfig{Endpoint: "udp://" + conn.LocalAddr().String()}
+	rcvr, err = NewFactory().CreateLogsReceiver(context.Background(), receivertest.NewNopCreateSettings(), cfg, consumertest.NewNop())
+	require.NoError(t, err)
+	require.ErrorContains(t, rcvr.Start(context.Background(), componenttest.NewNopHost()), "failed to listen for traps")
+	require.NoError(t, rcvr.Shutdown(context.Background()))
+}
@@ -134,6 +134,38 @@ func TestLoadConfig(t *testing.T) {
 				},
 			},
 		},
+		{
+			fname: "config-logs-composite-tracking.yaml",
+			id:    component.NewIDWithName(metadata.Type, ""),
+			expected: &Config{
+				Config: sqlquery.Config{
+					ControllerConfig: scraperhelper.ControllerConfig{
+						CollectionInterval: 10 * time.Second,
+						InitialDelay:       time.Second,
+					},
+					Driver:     "mydriver",
+					DataSource: "host=localhost port=5432 user=me password=s3cr3t sslmode=disable",
+					Queries: []sqlquery.Query{
+						{
+							SQL:                 "call get_logs(?, ?, ?)",
+							Parameters:          []string{"eu-west"},
+							TrackingColumns:     []string{"partition_id", "seq"},
+							TrackingStartValues: []string{"0", "100"},
+							Logs: []sqlquery.LogsCfg{
+								{
+									BodyColumn: "log_body",
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			fname:        "config-logs-invalid-tracking-start-values.yaml",
+			id:           component.NewIDWithName(metadata.Type, ""),
+			errorMessage: "'tracking_start_values' must have as many values as 'tracking_columns'",
+		},
 		{
 			fname:        "config-logs-missing-body-column.yaml",
 			id:           component.NewIDWithName(metadata.Type, ""),
@@ -6,6 +6,7 @@ package sqlqueryreceiver // import "github.com/open-telemetry/opentelemetry-coll
 import (
 	"context"
 	"database/sql"
+	"encoding/json"
 	"errors"
 	"fmt"
 	"time"
@@ -206,9 +207,9 @@ type logsQueryReceiver struct {
 	logger       *zap.Logger
 	telemetry    sqlquery.TelemetryConfig
 
-	db            *sql.DB
-	client        sqlquery.DbClient
-	trackingValue string
+	db             *sql.DB
+	client         sqlquery.DbClient
+	trackingValues []string
 	// TODO: Extract persistence into its own component
 	storageClient           storage.Client
 	trackingValueStorageKey string
@@ -232,7 +233,7 @@ func newLogsQueryReceiver(
 		telemetry:     telemetry,
 		storageClient: storageClient,
 	}
-	queryReceiver.trackingValue = queryReceiver.query.TrackingStartValue
+	queryReceiver.trackingValues = queryReceiver.query.InitialTrackingValues()
 	queryReceiver.trackingValueStorageKey = fmt.Sprintf("%s.%s", queryReceiver.id, "trackingValue")
 	return queryReceiver
 }
@@ -249,26 +250,34 @@ func (queryReceiver *logsQueryReceiver) start(ctx context.Context) error {
 	}
 	queryReceiver.client = queryReceiver.createClient(sqlquery.DbWrapper{Db: queryReceiver.db}, queryReceiver.query.SQL, queryReceiver.logger, queryReceiver.telemetry)
 
-	queryReceiver.trackingValue = queryReceiver.retrieveTrackingValue(ctx)
+	queryReceiver.trackingValues = queryReceiver.retrieveTrackingValues(ctx)
 
 	return nil
 }
 
-// retrieveTrackingValue retrieves the tracking value from storage, if storage is configured.
-// Otherwise, it returns the tracking value configured in `tracking_start_value`.
-func (queryReceiver *logsQueryReceiver) retrieveTrackingValue(ctx context.Context) string {
-	trackingValueFromConfig := queryReceiver.query.TrackingStartValue
+// retrieveTrackingValues retrieves the tracking values from storage, if storage is configured.
+// Otherwise, it returns the tracking values configured in `tracking_start_value` or `tracking_start_values`.
+func (queryReceiver *logsQueryReceiver) retrieveTrackingValues(ctx context.Context) []string {
+	trackingValuesFromConfig := queryReceiver.query.InitialTrackingValues()
 	if queryReceiver.storageClient == nil {
-		return trackingValueFromConfig
+		return trackingValuesFromConfig
 	}
 
 	storedTrackingValueBytes, err := queryReceiver.storageClient.Get(ctx, queryReceiver.trackingValueStorageKey)
 	if err != nil || storedTrackingValueBytes == nil {
-		return trackingValueFromConfig
+		return trackingValuesFromConfig
 	}
 
-	return string(storedTrackingValueBytes)
-
+	// A single tracking value is stored as is, composite tracking values as a JSON array.
+	if len(trackingValuesFromConfig) <= 1 {
+		return []string{string(storedTrackingValueBytes)}
+	}
+	var storedTrackingValues []string
+	if err = json.Unmarshal(storedTrackingValueBytes, &storedTrackingValues); err != nil || len(storedTrackingValues) != len(trackingValuesFromConfig) {
+		queryReceiver.logger.Warn("ignoring stored tracking values that don't match the tracking columns", zap.String("query", queryReceiver.id))
+		return trackingValuesFromConfig
+	}
+	return storedTrackingValues
 }
 
 func (queryReceiver *logsQueryReceiver) collect(ctx context.Context) (plog.Logs, error) {
@@ -277,11 +286,13 @@ func (queryReceiver *logsQueryReceiver) collect(ctx context.Context) (plog.Logs,
 	var rows []sqlquery.StringMap
 	var err error
 	observedAt := pcommon.NewTimestampFromTime(time.Now())
-	if queryReceiver.query.TrackingColumn != "" {
-		rows, err = queryReceiver.client.QueryRows(ctx, queryReceiver.trackingValue)
-	} else {
-		rows, err = queryReceiver.client.QueryRows(ctx)
+	args := queryReceiver.query.Args()
+	if len(queryReceiver.query.TrackingColumnNames()) > 0 {
+		for _, trackingValue := range queryReceiver.trackingValues {
+			args = append(args, trackingValue)
+		}
 	}
+	rows, err = queryReceiver.client.QueryRows(ctx, args...)
 	if err != nil {
 		return logs, fmt.Errorf("error getting rows: %w", err)
 	}
@@ -302,12 +313,22 @@ func (queryReceiver *logsQueryReceiver) collect(ctx context.Context) (plog.Logs,
 }
 
 func (queryReceiver *logsQueryReceiver) storeTrackingValue(ctx context.Context, row sqlquery.StringMap) error {
-	if queryReceiver.query.TrackingColumn == "" {
+	trackingColumns := queryReceiver.query.TrackingColumnNames()
+	if len(trackingColumns) == 0 {
 		return nil
 	}
-	queryReceiver.trackingValue = row[queryReceiver.query.TrackingColumn]
+	for i, column := range trackingColumns {
+		queryReceiver.trackingValues[i] = row[column]
+	}
 	if queryReceiver.storageClient != nil {
-		err := queryReceiver.storageClient.Set(ctx, queryReceiver.trackingValueStorageKey, []byte(queryReceiver.trackingValue))
+		storedTrackingValue := []byte(queryReceiver.trackingValues[0])
+		if len(trackingColumns) > 1 {
+			var err error
+			if storedTrackingValue, err = json.Marshal(queryReceiver.trackingValues); err != nil {
+				return err
+			}
+		}
+		err := queryReceiver.storageClient.Set(ctx, queryReceiver.trackingValueStorageKey, storedTrackingValue)
 		if err != nil {
 			return err
 		}
@@ -9,9 +9,14 @@ import (
 	"time"
 
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/collector/component"
 	"go.opentelemetry.io/collector/pdata/pcommon"
+	"go.uber.org/zap"
 
+	"github.com/open-telemetry/opentelemetry-collector-contrib/extension/storage/storagetest"
 	"github.com/open-telemetry/opentelemetry-collector-contrib/internal/sqlquery"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/receiver/sqlqueryreceiver/internal/metadata"
 )
 
 func TestLogsQueryReceiver_Collect(t *testing.T) {
@@ -51,3 +56,68 @@ func TestLogsQueryReceiver_Collect(t *testing.T) {
 		"Observed timestamps of all log records collected in a single scrape should be equal",
 	)
 }
+
+func TestLogsQueryReceiver_CompositeTracking(t *testing.T) {
+	ctx := context.Background()
+	storageClient := storagetest.NewInMemoryClient(component.KindReceiver, component.NewID(metadata.Type), "test")
+	query := sqlquery.Query{
+		SQL:                 "call get_logs(?, ?, ?)",
+		Parameters:          []string{"eu-west"},
+		TrackingColumns:     []string{"partition_id", "seq"},
+		TrackingStartValues: []string{"0", "100"},
+		Logs:                []sqlquery.LogsCfg{{BodyColumn: "body"}},
+	}
+
+	fakeClient := &sqlquery.FakeDBClient{
+		StringMaps: [][]sqlquery.StringMap{
+			{
+				{"partition_id": "1", "seq": "101", "body": "a"},
+				{"partition_id": "2", "seq": "7", "body": "b"},
+			},
+			{},
+		},
+	}
+	queryReceiver := newLogsQueryReceiver("query-0", query, nil, nil, zap.NewNop(), sqlquery.TelemetryConfig{}, storageClient)
+	queryReceiver.client = fakeClient
+
+	logs, err := queryReceiver.collect(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, 2, logs.LogRecordCount())
+	_, err = queryReceiver.collect(ctx)
+	require.NoError(t, err)
+
+	assert.Equal(t, [][]any{
+		{"eu-west", "0", "100"},
+		{"eu-west", "2", "7"},
+	}, fakeClient.Args)
+
+	// a new receiver resumes from the stored tracking values
+	restarted := newLogsQueryReceiver("query-0", query, nil, nil, zap.NewNop(), sqlquery.TelemetryConfig{}, storageClient)
+	assert.Equal(t, []string{"2", "7"}, restarted.retrieveTrackingValues(ctx))
+
+	// stored values that don't match the tracking columns are ignored
+	require.NoError(t, storageClient.Set(ctx, restarted.trackingValueStorageKey, []byte("7")))
+	assert.Equal(t, []string{"0", "100"}, restarted.retrieveTrackingValues(ctx))
+}
+
+func TestLogsQueryReceiver_SingleTrackingColumnStorage(t *testing.T) {
+	ctx := context.Background()
+	storageClient := storagetest.NewInMemoryClient(component.KindReceiver, component.NewID(metadata.Type), "test")
+	query := sqlquery.Query{
+		SQL:                "select * from logs where id > ?",
+		TrackingColumn:     "id",
+		TrackingStartValue: "10",
+		Logs:               []sqlquery.LogsCfg{{BodyColumn: "body"}},
+	}
+
+	queryReceiver := newLogsQueryReceiver("query-0", query, nil, nil, zap.NewNop(), sqlquery.TelemetryConfig{}, storageClient)
+	queryReceiver.client = &sqlquery.FakeDBClient{
+		StringMaps: [][]sqlquery.StringMap{{{"id": "11", "body": "a"}}},
+	}
+	_, err := queryReceiver.collect(ctx)
+	require.NoError(t, err)
+
+	stored, err := storageClient.Get(ctx, queryReceiver.trackingValueStorageKey)
+	require.NoError(t, err)
+	assert.Equal(t, "11", string(stored))
+}
@@ -20,6 +20,9 @@ type Config struct {
 	InstanceName string `mapstructure:"instance_name"`
 	ComputerName string `mapstructure:"computer_name"`
 
+	// TopQueryCount is the number of queries using the most CPU time reported by the Query Store metrics.
+	TopQueryCount uint `mapstructure:"top_query_count"`
+
 	// The following options currently do nothing. Functionality will be added in a future PR.
 	Password configopaque.String `mapstructure:"password"`
 	Port     uint                `mapstructure:"port"`
@@ -33,6 +36,10 @@ func (cfg *Config) Validate() error {
 		return err
 	}
 
+	if cfg.TopQueryCount == 0 && isQueryStoreQueryEnabled(&cfg.MetricsBuilderConfig.Metrics) {
+		return fmt.Errorf("top_query_count must be positive when the Query Store metrics are enabled")
+	}
+
 	if !directDBConnectionEnabled(cfg) {
 		if cfg.Server != "" || cfg.Username != "" || string(cfg.Password) != "" {
 			return fmt.Errorf("Found one or more of the following configuration options set: [server, port, username, password]. " +
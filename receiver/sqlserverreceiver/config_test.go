@@ -63,6 +63,16 @@ func TestValidate(t *testing.T) {
 			},
 			expectedSuccess: true,
 		},
+		{
+			desc: "invalid config with query store metrics and no top queries",
+			cfg: func() *Config {
+				cfg := createDefaultConfig().(*Config)
+				cfg.Metrics.SqlserverQueryStoreQueryCPUTime.Enabled = true
+				cfg.TopQueryCount = 0
+				return cfg
+			}(),
+			expectedSuccess: false,
+		},
 	}
 
 	for _, tc := range testCases {
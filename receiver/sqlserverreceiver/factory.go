@@ -33,6 +33,7 @@ func createDefaultConfig() component.Config {
 	return &Config{
 		ControllerConfig:     cfg,
 		MetricsBuilderConfig: metadata.DefaultMetricsBuilderConfig(),
+		TopQueryCount:        10,
 	}
 }
 
@@ -55,6 +56,14 @@ func setupQueries(cfg *Config) []string {
 		queries = append(queries, getSQLServerPropertiesQuery(cfg.InstanceName))
 	}
 
+	if isAvailabilityReplicaQueryEnabled(&cfg.MetricsBuilderConfig.Metrics) {
+		queries = append(queries, getSQLServerAvailabilityReplicaQuery(cfg.InstanceName))
+	}
+
+	if isQueryStoreQueryEnabled(&cfg.MetricsBuilderConfig.Metrics) {
+		queries = append(queries, getSQLServerQueryStoreQuery(cfg.InstanceName, cfg.TopQueryCount))
+	}
+
 	return queries
 }
 
@@ -94,6 +103,7 @@ func setupSQLServerScrapers(params receiver.CreateSettings, cfg *Config) []*sqlS
 
 		sqlServerScraper := newSQLServerScraper(id, query,
 			cfg.InstanceName,
+			cfg.TopQueryCount,
 			cfg.ControllerConfig,
 			params.Logger,
 			sqlquery.TelemetryConfig{},
@@ -138,3 +148,17 @@ func isDatabaseIOQueryEnabled(metrics *metadata.MetricsConfig) bool {
 	}
 	return false
 }
+
+func isAvailabilityReplicaQueryEnabled(metrics *metadata.MetricsConfig) bool {
+	return metrics.SqlserverAvailabilityReplicaDatabaseSynchronizationState.Enabled ||
+		metrics.SqlserverAvailabilityReplicaDatabaseLogSendQueueSize.Enabled ||
+		metrics.SqlserverAvailabilityReplicaDatabaseRedoQueueSize.Enabled ||
+		metrics.SqlserverAvailabilityReplicaDatabaseRedoRate.Enabled
+}
+
+func isQueryStoreQueryEnabled(metrics *metadata.MetricsConfig) bool {
+	return metrics.SqlserverQueryStoreQueryExecutions.Enabled ||
+		metrics.SqlserverQueryStoreQueryCPUTime.Enabled ||
+		metrics.SqlserverQueryStoreQueryDuration.Enabled ||
+		metrics.SqlserverQueryStoreQueryLogicalReads.Enabled
+}
@@ -41,6 +41,7 @@ func TestCreateMetricsReceiver(t *testing.T) {
 						InitialDelay:       time.Second,
 					},
 					MetricsBuilderConfig: metadata.DefaultMetricsBuilderConfig(),
+					TopQueryCount:        10,
 				}
 
 				require.Equal(t, expectedCfg, factory.CreateDefaultConfig())
@@ -28,37 +28,57 @@ func (ms *MetricConfig) Unmarshal(parser *confmap.Conf) error {
 
 // MetricsConfig provides config for sqlserver metrics.
 type MetricsConfig struct {
-	SqlserverBatchRequestRate                   MetricConfig `mapstructure:"sqlserver.batch.request.rate"`
-	SqlserverBatchSQLCompilationRate            MetricConfig `mapstructure:"sqlserver.batch.sql_compilation.rate"`
-	SqlserverBatchSQLRecompilationRate          MetricConfig `mapstructure:"sqlserver.batch.sql_recompilation.rate"`
-	SqlserverDatabaseCount                      MetricConfig `mapstructure:"sqlserver.database.count"`
-	SqlserverDatabaseIo                         MetricConfig `mapstructure:"sqlserver.database.io"`
-	SqlserverDatabaseLatency                    MetricConfig `mapstructure:"sqlserver.database.latency"`
-	SqlserverDatabaseOperations                 MetricConfig `mapstructure:"sqlserver.database.operations"`
-	SqlserverLockWaitRate                       MetricConfig `mapstructure:"sqlserver.lock.wait.rate"`
-	SqlserverLockWaitTimeAvg                    MetricConfig `mapstructure:"sqlserver.lock.wait_time.avg"`
-	SqlserverPageBufferCacheHitRatio            MetricConfig `mapstructure:"sqlserver.page.buffer_cache.hit_ratio"`
-	SqlserverPageCheckpointFlushRate            MetricConfig `mapstructure:"sqlserver.page.checkpoint.flush.rate"`
-	SqlserverPageLazyWriteRate                  MetricConfig `mapstructure:"sqlserver.page.lazy_write.rate"`
-	SqlserverPageLifeExpectancy                 MetricConfig `mapstructure:"sqlserver.page.life_expectancy"`
-	SqlserverPageOperationRate                  MetricConfig `mapstructure:"sqlserver.page.operation.rate"`
-	SqlserverPageSplitRate                      MetricConfig `mapstructure:"sqlserver.page.split.rate"`
-	SqlserverProcessesBlocked                   MetricConfig `mapstructure:"sqlserver.processes.blocked"`
-	SqlserverResourcePoolDiskThrottledReadRate  MetricConfig `mapstructure:"sqlserver.resource_pool.disk.throttled.read.rate"`
-	SqlserverResourcePoolDiskThrottledWriteRate MetricConfig `mapstructure:"sqlserver.resource_pool.disk.throttled.write.rate"`
-	SqlserverTransactionRate                    MetricConfig `mapstructure:"sqlserver.transaction.rate"`
-	SqlserverTransactionWriteRate               MetricConfig `mapstructure:"sqlserver.transaction.write.rate"`
-	SqlserverTransactionLogFlushDataRate        MetricConfig `mapstructure:"sqlserver.transaction_log.flush.data.rate"`
-	SqlserverTransactionLogFlushRate            MetricConfig `mapstructure:"sqlserver.transaction_log.flush.rate"`
-	SqlserverTransactionLogFlushWaitRate        MetricConfig `mapstructure:"sqlserver.transaction_log.flush.wait.rate"`
-	SqlserverTransactionLogGrowthCount          MetricConfig `mapstructure:"sqlserver.transaction_log.growth.count"`
-	SqlserverTransactionLogShrinkCount          MetricConfig `mapstructure:"sqlserver.transaction_log.shrink.count"`
-	SqlserverTransactionLogUsage                MetricConfig `mapstructure:"sqlserver.transaction_log.usage"`
-	SqlserverUserConnectionCount                MetricConfig `mapstructure:"sqlserver.user.connection.count"`
+	SqlserverAvailabilityReplicaDatabaseLogSendQueueSize     MetricConfig `mapstructure:"sqlserver.availability_replica.database.log_send_queue.size"`
+	SqlserverAvailabilityReplicaDatabaseRedoRate             MetricConfig `mapstructure:"sqlserver.availability_replica.database.redo.rate"`
+	SqlserverAvailabilityReplicaDatabaseRedoQueueSize        MetricConfig `mapstructure:"sqlserver.availability_replica.database.redo_queue.size"`
+	SqlserverAvailabilityReplicaDatabaseSynchronizationState MetricConfig `mapstructure:"sqlserver.availability_replica.database.synchronization_state"`
+	SqlserverBatchRequestRate                                MetricConfig `mapstructure:"sqlserver.batch.request.rate"`
+	SqlserverBatchSQLCompilationRate                         MetricConfig `mapstructure:"sqlserver.batch.sql_compilation.rate"`
+	SqlserverBatchSQLRecompilationRate                       MetricConfig `mapstructure:"sqlserver.batch.sql_recompilation.rate"`
+	SqlserverDatabaseCount                                   MetricConfig `mapstructure:"sqlserver.database.count"`
+	SqlserverDatabaseIo                                      MetricConfig `mapstructure:"sqlserver.database.io"`
+	SqlserverDatabaseLatency                                 MetricConfig `mapstructure:"sqlserver.database.latency"`
+	SqlserverDatabaseOperations                              MetricConfig `mapstructure:"sqlserver.database.operations"`
+	SqlserverLockWaitRate                                    MetricConfig `mapstructure:"sqlserver.lock.wait.rate"`
+	SqlserverLockWaitTimeAvg                                 MetricConfig `mapstructure:"sqlserver.lock.wait_time.avg"`
+	SqlserverPageBufferCacheHitRatio                         MetricConfig `mapstructure:"sqlserver.page.buffer_cache.hit_ratio"`
+	SqlserverPageCheckpointFlushRate                         MetricConfig `mapstructure:"sqlserver.page.checkpoint.flush.rate"`
+	SqlserverPageLazyWriteRate                               MetricConfig `mapstructure:"sqlserver.page.lazy_write.rate"`
+	SqlserverPageLifeExpectancy                              MetricConfig `mapstructure:"sqlserver.page.life_expectancy"`
+	SqlserverPageOperationRate                               MetricConfig `mapstructure:"sqlserver.page.operation.rate"`
+	SqlserverPageSplitRate                                   MetricConfig `mapstructure:"sqlserver.page.split.rate"`
+	SqlserverProcessesBlocked                                MetricConfig `mapstructure:"sqlserver.processes.blocked"`
+	SqlserverQueryStoreQueryCPUTime                          MetricConfig `mapstructure:"sqlserver.query_store.query.cpu_time"`
+	SqlserverQueryStoreQueryDuration                         MetricConfig `mapstructure:"sqlserver.query_store.query.duration"`
+	SqlserverQueryStoreQueryExecutions                       MetricConfig `mapstructure:"sqlserver.query_store.query.executions"`
+	SqlserverQueryStoreQueryLogicalReads                     MetricConfig `mapstructure:"sqlserver.query_store.query.logical_reads"`
+	SqlserverResourcePoolDiskThrottledReadRate               MetricConfig `mapstructure:"sqlserver.resource_pool.disk.throttled.read.rate"`
+	SqlserverResourcePoolDiskThrottledWriteRate              MetricConfig `mapstructure:"sqlserver.resource_pool.disk.throttled.write.rate"`
+	SqlserverTransactionRate                                 MetricConfig `mapstructure:"sqlserver.transaction.rate"`
+	SqlserverTransactionWriteRate                            MetricConfig `mapstructure:"sqlserver.transaction.write.rate"`
+	SqlserverTransactionLogFlushDataRate                     MetricConfig `mapstructure:"sqlserver.transaction_log.flush.data.rate"`
+	SqlserverTransactionLogFlushRate                         MetricConfig `mapstructure:"sqlserver.transaction_log.flush.rate"`
+	SqlserverTransactionLogFlushWaitRate                     MetricConfig `mapstructure:"sqlserver.transaction_log.flush.wait.rate"`
+	SqlserverTransactionLogGrowthCount                       MetricConfig `mapstructure:"sqlserver.transaction_log.growth.count"`
+	SqlserverTransactionLogShrinkCount                       MetricConfig `mapstructure:"sqlserver.transaction_log.shrink.count"`
+	SqlserverTransactionLogUsage                             MetricConfig `mapstructure:"sqlserver.transaction_log.usage"`
+	SqlserverUserConnectionCount                             MetricConfig `mapstructure:"sqlserver.user.connection.count"`
 }
 
 func DefaultMetricsConfig() MetricsConfig {
 	return MetricsConfig{
+		SqlserverAvailabilityReplicaDatabaseLogSendQueueSize: MetricConfig{
+			Enabled: false,
+		},
+		SqlserverAvailabilityReplicaDatabaseRedoRate: MetricConfig{
+			Enabled: false,
+		},
+		SqlserverAvailabilityReplicaDatabaseRedoQueueSize: MetricConfig{
+			Enabled: false,
+		},
+		SqlserverAvailabilityReplicaDatabaseSynchronizationState: MetricConfig{
+			Enabled: false,
+		},
 		SqlserverBatchRequestRate: MetricConfig{
 			Enabled: true,
 		},
@@ -107,6 +127,18 @@ func DefaultMetricsConfig() MetricsConfig {
 		SqlserverProcessesBlocked: MetricConfig{
 			Enabled: false,
 		},
+		SqlserverQueryStoreQueryCPUTime: MetricConfig{
+			Enabled: false,
+		},
+		SqlserverQueryStoreQueryDuration: MetricConfig{
+			Enabled: false,
+		},
+		SqlserverQueryStoreQueryExecutions: MetricConfig{
+			Enabled: false,
+		},
+		SqlserverQueryStoreQueryLogicalReads: MetricConfig{
+			Enabled: false,
+		},
 		SqlserverResourcePoolDiskThrottledReadRate: MetricConfig{
 			Enabled: false,
 		},
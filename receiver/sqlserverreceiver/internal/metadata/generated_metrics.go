@@ -108,6 +108,257 @@ var MapAttributePageOperations = map[string]AttributePageOperations{
 	"write": AttributePageOperationsWrite,
 }
 
+// AttributeSynchronizationState specifies the a value synchronization_state attribute.
+type AttributeSynchronizationState int
+
+const (
+	_ AttributeSynchronizationState = iota
+	AttributeSynchronizationStateNotSynchronizing
+	AttributeSynchronizationStateSynchronizing
+	AttributeSynchronizationStateSynchronized
+	AttributeSynchronizationStateReverting
+	AttributeSynchronizationStateInitializing
+)
+
+// String returns the string representation of the AttributeSynchronizationState.
+func (av AttributeSynchronizationState) String() string {
+	switch av {
+	case AttributeSynchronizationStateNotSynchronizing:
+		return "not_synchronizing"
+	case AttributeSynchronizationStateSynchronizing:
+		return "synchronizing"
+	case AttributeSynchronizationStateSynchronized:
+		return "synchronized"
+	case AttributeSynchronizationStateReverting:
+		return "reverting"
+	case AttributeSynchronizationStateInitializing:
+		return "initializing"
+	}
+	return ""
+}
+
+// MapAttributeSynchronizationState is a helper map of string to AttributeSynchronizationState attribute value.
+var MapAttributeSynchronizationState = map[string]AttributeSynchronizationState{
+	"not_synchronizing": AttributeSynchronizationStateNotSynchronizing,
+	"synchronizing":     AttributeSynchronizationStateSynchronizing,
+	"synchronized":      AttributeSynchronizationStateSynchronized,
+	"reverting":         AttributeSynchronizationStateReverting,
+	"initializing":      AttributeSynchronizationStateInitializing,
+}
+
+type metricSqlserverAvailabilityReplicaDatabaseLogSendQueueSize struct {
+	data     pmetric.Metric // data buffer for generated metric.
+	config   MetricConfig   // metric config provided by user.
+	capacity int            // max observed number of data points added to the metric.
+}
+
+// init fills sqlserver.availability_replica.database.log_send_queue.size metric with initial data.
+func (m *metricSqlserverAvailabilityReplicaDatabaseLogSendQueueSize) init() {
+	m.data.SetName("sqlserver.availability_replica.database.log_send_queue.size")
+	m.data.SetDescription("The amount of log records of the primary database that haven't been sent to the secondary replica.")
+	m.data.SetUnit("By")
+	m.data.SetEmptyGauge()
+	m.data.Gauge().DataPoints().EnsureCapacity(m.capacity)
+}
+
+func (m *metricSqlserverAvailabilityReplicaDatabaseLogSendQueueSize) recordDataPoint(start pcommon.Timestamp, ts pcommon.Timestamp, val int64, availabilityGroupNameAttributeValue string, availabilityReplicaNameAttributeValue string, databaseNameAttributeValue string) {
+	if !m.config.Enabled {
+		return
+	}
+	dp := m.data.Gauge().DataPoints().AppendEmpty()
+	dp.SetStartTimestamp(start)
+	dp.SetTimestamp(ts)
+	dp.SetIntValue(val)
+	dp.Attributes().PutStr("availability_group.name", availabilityGroupNameAttributeValue)
+	dp.Attributes().PutStr("availability_replica.name", availabilityReplicaNameAttributeValue)
+	dp.Attributes().PutStr("database.name", databaseNameAttributeValue)
+}
+
+// updateCapacity saves max length of data point slices that will be used for the slice capacity.
+func (m *metricSqlserverAvailabilityReplicaDatabaseLogSendQueueSize) updateCapacity() {
+	if m.data.Gauge().DataPoints().Len() > m.capacity {
+		m.capacity = m.data.Gauge().DataPoints().Len()
+	}
+}
+
+// emit appends recorded metric data to a metrics slice and prepares it for recording another set of data points.
+func (m *metricSqlserverAvailabilityReplicaDatabaseLogSendQueueSize) emit(metrics pmetric.MetricSlice) {
+	if m.config.Enabled && m.data.Gauge().DataPoints().Len() > 0 {
+		m.updateCapacity()
+		m.data.MoveTo(metrics.AppendEmpty())
+		m.init()
+	}
+}
+
+func newMetricSqlserverAvailabilityReplicaDatabaseLogSendQueueSize(cfg MetricConfig) metricSqlserverAvailabilityReplicaDatabaseLogSendQueueSize {
+	m := metricSqlserverAvailabilityReplicaDatabaseLogSendQueueSize{config: cfg}
+	if cfg.Enabled {
+		m.data = pmetric.NewMetric()
+		m.init()
+	}
+	return m
+}
+
+type metricSqlserverAvailabilityReplicaDatabaseRedoRate struct {
+	data     pmetric.Metric // data buffer for generated metric.
+	config   MetricConfig   // metric config provided by user.
+	capacity int            // max observed number of data points added to the metric.
+}
+
+// init fills sqlserver.availability_replica.database.redo.rate metric with initial data.
+func (m *metricSqlserverAvailabilityReplicaDatabaseRedoRate) init() {
+	m.data.SetName("sqlserver.availability_replica.database.redo.rate")
+	m.data.SetDescription("The average rate at which the log records are redone on the secondary replica.")
+	m.data.SetUnit("By/s")
+	m.data.SetEmptyGauge()
+	m.data.Gauge().DataPoints().EnsureCapacity(m.capacity)
+}
+
+func (m *metricSqlserverAvailabilityReplicaDatabaseRedoRate) recordDataPoint(start pcommon.Timestamp, ts pcommon.Timestamp, val int64, availabilityGroupNameAttributeValue string, availabilityReplicaNameAttributeValue string, databaseNameAttributeValue string) {
+	if !m.config.Enabled {
+		return
+	}
+	dp := m.data.Gauge().DataPoints().AppendEmpty()
+	dp.SetStartTimestamp(start)
+	dp.SetTimestamp(ts)
+	dp.SetIntValue(val)
+	dp.Attributes().PutStr("availability_group.name", availabilityGroupNameAttributeValue)
+	dp.Attributes().PutStr("availability_replica.name", availabilityReplicaNameAttributeValue)
+	dp.Attributes().PutStr("database.name", databaseNameAttributeValue)
+}
+
+// updateCapacity saves max length of data point slices that will be used for the slice capacity.
+func (m *metricSqlserverAvailabilityReplicaDatabaseRedoRate) updateCapacity() {
+	if m.data.Gauge().DataPoints().Len() > m.capacity {
+		m.capacity = m.data.Gauge().DataPoints().Len()
+	}
+}
+
+// emit appends recorded metric data to a metrics slice and prepares it for recording another set of data points.
+func (m *metricSqlserverAvailabilityReplicaDatabaseRedoRate) emit(metrics pmetric.MetricSlice) {
+	if m.config.Enabled && m.data.Gauge().DataPoints().Len() > 0 {
+		m.updateCapacity()
+		m.data.MoveTo(metrics.AppendEmpty())
+		m.init()
+	}
+}
+
+func newMetricSqlserverAvailabilityReplicaDatabaseRedoRate(cfg MetricConfig) metricSqlserverAvailabilityReplicaDatabaseRedoRate {
+	m := metricSqlserverAvailabilityReplicaDatabaseRedoRate{config: cfg}
+	if cfg.Enabled {
+		m.data = pmetric.NewMetric()
+		m.init()
+	}
+	return m
+}
+
+type metricSqlserverAvailabilityReplicaDatabaseRedoQueueSize struct {
+	data     pmetric.Metric // data buffer for generated metric.
+	config   MetricConfig   // metric config provided by user.
+	capacity int            // max observed number of data points added to the metric.
+}
+
+// init fills sqlserver.availability_replica.database.redo_queue.size metric with initial data.
+func (m *metricSqlserverAvailabilityReplicaDatabaseRedoQueueSize) init() {
+	m.data.SetName("sqlserver.availability_replica.database.redo_queue.size")
+	m.data.SetDescription("The amount of log records in the log files of the secondary replica that haven't been redone yet.")
+	m.data.SetUnit("By")
+	m.data.SetEmptyGauge()
+	m.data.Gauge().DataPoints().EnsureCapacity(m.capacity)
+}
+
+func (m *metricSqlserverAvailabilityReplicaDatabaseRedoQueueSize) recordDataPoint(start pcommon.Timestamp, ts pcommon.Timestamp, val int64, availabilityGroupNameAttributeValue string, availabilityReplicaNameAttributeValue string, databaseNameAttributeValue string) {
+	if !m.config.Enabled {
+		return
+	}
+	dp := m.data.Gauge().DataPoints().AppendEmpty()
+	dp.SetStartTimestamp(start)
+	dp.SetTimestamp(ts)
+	dp.SetIntValue(val)
+	dp.Attributes().PutStr("availability_group.name", availabilityGroupNameAttributeValue)
+	dp.Attributes().PutStr("availability_replica.name", availabilityReplicaNameAttributeValue)
+	dp.Attributes().PutStr("database.name", databaseNameAttributeValue)
+}
+
+// updateCapacity saves max length of data point slices that will be used for the slice capacity.
+func (m *metricSqlserverAvailabilityReplicaDatabaseRedoQueueSize) updateCapacity() {
+	if m.data.Gauge().DataPoints().Len() > m.capacity {
+		m.capacity = m.data.Gauge().DataPoints().Len()
+	}
+}
+
+// emit appends recorded metric data to a metrics slice and prepares it for recording another set of data points.
+func (m *metricSqlserverAvailabilityReplicaDatabaseRedoQueueSize) emit(metrics pmetric.MetricSlice) {
+	if m.config.Enabled && m.data.Gauge().DataPoints().Len() > 0 {
+		m.updateCapacity()
+		m.data.MoveTo(metrics.AppendEmpty())
+		m.init()
+	}
+}
+
+func newMetricSqlserverAvailabilityReplicaDatabaseRedoQueueSize(cfg MetricConfig) metricSqlserverAvailabilityReplicaDatabaseRedoQueueSize {
+	m := metricSqlserverAvailabilityReplicaDatabaseRedoQueueSize{config: cfg}
+	if cfg.Enabled {
+		m.data = pmetric.NewMetric()
+		m.init()
+	}
+	return m
+}
+
+type metricSqlserverAvailabilityReplicaDatabaseSynchronizationState struct {
+	data     pmetric.Metric // data buffer for generated metric.
+	config   MetricConfig   // metric config provided by user.
+	capacity int            // max observed number of data points added to the metric.
+}
+
+// init fills sqlserver.availability_replica.database.synchronization_state metric with initial data.
+func (m *metricSqlserverAvailabilityReplicaDatabaseSynchronizationState) init() {
+	m.data.SetName("sqlserver.availability_replica.database.synchronization_state")
+	m.data.SetDescription("The data movement state of the availability databases on the replicas. The value is 1 for the current state, and 0 for the other states.")
+	m.data.SetUnit("1")
+	m.data.SetEmptyGauge()
+	m.data.Gauge().DataPoints().EnsureCapacity(m.capacity)
+}
+
+func (m *metricSqlserverAvailabilityReplicaDatabaseSynchronizationState) recordDataPoint(start pcommon.Timestamp, ts pcommon.Timestamp, val int64, availabilityGroupNameAttributeValue string, availabilityReplicaNameAttributeValue string, databaseNameAttributeValue string, synchronizationStateAttributeValue string) {
+	if !m.config.Enabled {
+		return
+	}
+	dp := m.data.Gauge().DataPoints().AppendEmpty()
+	dp.SetStartTimestamp(start)
+	dp.SetTimestamp(ts)
+	dp.SetIntValue(val)
+	dp.Attributes().PutStr("availability_group.name", availabilityGroupNameAttributeValue)
+	dp.Attributes().PutStr("availability_replica.name", availabilityReplicaNameAttributeValue)
+	dp.Attributes().PutStr("database.name", databaseNameAttributeValue)
+	dp.Attributes().PutStr("synchronization_state", synchronizationStateAttributeValue)
+}
+
+// updateCapacity saves max length of data point slices that will be used for the slice capacity.
+func (m *metricSqlserverAvailabilityReplicaDatabaseSynchronizationState) updateCapacity() {
+	if m.data.Gauge().DataPoints().Len() > m.capacity {
+		m.capacity = m.data.Gauge().DataPoints().Len()
+	}
+}
+
+// emit appends recorded metric data to a metrics slice and prepares it for recording another set of data points.
+func (m *metricSqlserverAvailabilityReplicaDatabaseSynchronizationState) emit(metrics pmetric.MetricSlice) {
+	if m.config.Enabled && m.data.Gauge().DataPoints().Len() > 0 {
+		m.updateCapacity()
+		m.data.MoveTo(metrics.AppendEmpty())
+		m.init()
+	}
+}
+
+func newMetricSqlserverAvailabilityReplicaDatabaseSynchronizationState(cfg MetricConfig) metricSqlserverAvailabilityReplicaDatabaseSynchronizationState {
+	m := metricSqlserverAvailabilityReplicaDatabaseSynchronizationState{config: cfg}
+	if cfg.Enabled {
+		m.data = pmetric.NewMetric()
+		m.init()
+	}
+	return m
+}
+
 type metricSqlserverBatchRequestRate struct {
 	data     pmetric.Metric // data buffer for generated metric.
 	config   MetricConfig   // metric config provided by user.
@@ -917,6 +1168,218 @@ func newMetricSqlserverProcessesBlocked(cfg MetricConfig) metricSqlserverProcess
 	return m
 }
 
+type metricSqlserverQueryStoreQueryCPUTime struct {
+	data     pmetric.Metric // data buffer for generated metric.
+	config   MetricConfig   // metric config provided by user.
+	capacity int            // max observed number of data points added to the metric.
+}
+
+// init fills sqlserver.query_store.query.cpu_time metric with initial data.
+func (m *metricSqlserverQueryStoreQueryCPUTime) init() {
+	m.data.SetName("sqlserver.query_store.query.cpu_time")
+	m.data.SetDescription("The CPU time used by the queries using the most CPU time, over the last hour of Query Store statistics.")
+	m.data.SetUnit("s")
+	m.data.SetEmptyGauge()
+	m.data.Gauge().DataPoints().EnsureCapacity(m.capacity)
+}
+
+func (m *metricSqlserverQueryStoreQueryCPUTime) recordDataPoint(start pcommon.Timestamp, ts pcommon.Timestamp, val float64, databaseNameAttributeValue string, queryHashAttributeValue string, queryTextAttributeValue string) {
+	if !m.config.Enabled {
+		return
+	}
+	dp := m.data.Gauge().DataPoints().AppendEmpty()
+	dp.SetStartTimestamp(start)
+	dp.SetTimestamp(ts)
+	dp.SetDoubleValue(val)
+	dp.Attributes().PutStr("database.name", databaseNameAttributeValue)
+	dp.Attributes().PutStr("query.hash", queryHashAttributeValue)
+	dp.Attributes().PutStr("query.text", queryTextAttributeValue)
+}
+
+// updateCapacity saves max length of data point slices that will be used for the slice capacity.
+func (m *metricSqlserverQueryStoreQueryCPUTime) updateCapacity() {
+	if m.data.Gauge().DataPoints().Len() > m.capacity {
+		m.capacity = m.data.Gauge().DataPoints().Len()
+	}
+}
+
+// emit appends recorded metric data to a metrics slice and prepares it for recording another set of data points.
+func (m *metricSqlserverQueryStoreQueryCPUTime) emit(metrics pmetric.MetricSlice) {
+	if m.config.Enabled && m.data.Gauge().DataPoints().Len() > 0 {
+		m.updateCapacity()
+		m.data.MoveTo(metrics.AppendEmpty())
+		m.init()
+	}
+}
+
+func newMetricSqlserverQueryStoreQueryCPUTime(cfg MetricConfig) metricSqlserverQueryStoreQueryCPUTime {
+	m := metricSqlserverQueryStoreQueryCPUTime{config: cfg}
+	if cfg.Enabled {
+		m.data = pmetric.NewMetric()
+		m.init()
+	}
+	return m
+}
+
+type metricSqlserverQueryStoreQueryDuration struct {
+	data     pmetric.Metric // data buffer for generated metric.
+	config   MetricConfig   // metric config provided by user.
+	capacity int            // max observed number of data points added to the metric.
+}
+
+// init fills sqlserver.query_store.query.duration metric with initial data.
+func (m *metricSqlserverQueryStoreQueryDuration) init() {
+	m.data.SetName("sqlserver.query_store.query.duration")
+	m.data.SetDescription("The total elapsed time of the executions of the queries using the most CPU time, over the last hour of Query Store statistics.")
+	m.data.SetUnit("s")
+	m.data.SetEmptyGauge()
+	m.data.Gauge().DataPoints().EnsureCapacity(m.capacity)
+}
+
+func (m *metricSqlserverQueryStoreQueryDuration) recordDataPoint(start pcommon.Timestamp, ts pcommon.Timestamp, val float64, databaseNameAttributeValue string, queryHashAttributeValue string, queryTextAttributeValue string) {
+	if !m.config.Enabled {
+		return
+	}
+	dp := m.data.Gauge().DataPoints().AppendEmpty()
+	dp.SetStartTimestamp(start)
+	dp.SetTimestamp(ts)
+	dp.SetDoubleValue(val)
+	dp.Attributes().PutStr("database.name", databaseNameAttributeValue)
+	dp.Attributes().PutStr("query.hash", queryHashAttributeValue)
+	dp.Attributes().PutStr("query.text", queryTextAttributeValue)
+}
+
+// updateCapacity saves max length of data point slices that will be used for the slice capacity.
+func (m *metricSqlserverQueryStoreQueryDuration) updateCapacity() {
+	if m.data.Gauge().DataPoints().Len() > m.capacity {
+		m.capacity = m.data.Gauge().DataPoints().Len()
+	}
+}
+
+// emit appends recorded metric data to a metrics slice and prepares it for recording another set of data points.
+func (m *metricSqlserverQueryStoreQueryDuration) emit(metrics pmetric.MetricSlice) {
+	if m.config.Enabled && m.data.Gauge().DataPoints().Len() > 0 {
+		m.updateCapacity()
+		m.data.MoveTo(metrics.AppendEmpty())
+		m.init()
+	}
+}
+
+func newMetricSqlserverQueryStoreQueryDuration(cfg MetricConfig) metricSqlserverQueryStoreQueryDuration {
+	m := metricSqlserverQueryStoreQueryDuration{config: cfg}
+	if cfg.Enabled {
+		m.data = pmetric.NewMetric()
+		m.init()
+	}
+	return m
+}
+
+type metricSqlserverQueryStoreQueryExecutions struct {
+	data     pmetric.Metric // data buffer for generated metric.
+	config   MetricConfig   // metric config provided by user.
+	capacity int            // max observed number of data points added to the metric.
+}
+
+// init fills sqlserver.query_store.query.executions metric with initial data.
+func (m *metricSqlserverQueryStoreQueryExecutions) init() {
+	m.data.SetName("sqlserver.query_store.query.executions")
+	m.data.SetDescription("The number of executions of the queries using the most CPU time, over the last hour of Query Store statistics.")
+	m.data.SetUnit("{executions}")
+	m.data.SetEmptyGauge()
+	m.data.Gauge().DataPoints().EnsureCapacity(m.capacity)
+}
+
+func (m *metricSqlserverQueryStoreQueryExecutions) recordDataPoint(start pcommon.Timestamp, ts pcommon.Timestamp, val int64, databaseNameAttributeValue string, queryHashAttributeValue string, queryTextAttributeValue string) {
+	if !m.config.Enabled {
+		return
+	}
+	dp := m.data.Gauge().DataPoints().AppendEmpty()
+	dp.SetStartTimestamp(start)
+	dp.SetTimestamp(ts)
+	dp.SetIntValue(val)
+	dp.Attributes().PutStr("database.name", databaseNameAttributeValue)
+	dp.Attributes().PutStr("query.hash", queryHashAttributeValue)
+	dp.Attributes().PutStr("query.text", queryTextAttributeValue)
+}
+
+// updateCapacity saves max length of data point slices that will be used for the slice capacity.
+func (m *metricSqlserverQueryStoreQueryExecutions) updateCapacity() {
+	if m.data.Gauge().DataPoints().Len() > m.capacity {
+		m.capacity = m.data.Gauge().DataPoints().Len()
+	}
+}
+
+// emit appends recorded metric data to a metrics slice and prepares it for recording another set of data points.
+func (m *metricSqlserverQueryStoreQueryExecutions) emit(metrics pmetric.MetricSlice) {
+	if m.config.Enabled && m.data.Gauge().DataPoints().Len() > 0 {
+		m.updateCapacity()
+		m.data.MoveTo(metrics.AppendEmpty())
+		m.init()
+	}
+}
+
+func newMetricSqlserverQueryStoreQueryExecutions(cfg MetricConfig) metricSqlserverQueryStoreQueryExecutions {
+	m := metricSqlserverQueryStoreQueryExecutions{config: cfg}
+	if cfg.Enabled {
+		m.data = pmetric.NewMetric()
+		m.init()
+	}
+	return m
+}
+
+type metricSqlserverQueryStoreQueryLogicalReads struct {
+	data     pmetric.Metric // data buffer for generated metric.
+	config   MetricConfig   // metric config provided by user.
+	capacity int            // max observed number of data points added to the metric.
+}
+
+// init fills sqlserver.query_store.query.logical_reads metric with initial data.
+func (m *metricSqlserverQueryStoreQueryLogicalReads) init() {
+	m.data.SetName("sqlserver.query_store.query.logical_reads")
+	m.data.SetDescription("The number of pages read from the buffer cache by the queries using the most CPU time, over the last hour of Query Store statistics.")
+	m.data.SetUnit("{pages}")
+	m.data.SetEmptyGauge()
+	m.data.Gauge().DataPoints().EnsureCapacity(m.capacity)
+}
+
+func (m *metricSqlserverQueryStoreQueryLogicalReads) recordDataPoint(start pcommon.Timestamp, ts pcommon.Timestamp, val int64, databaseNameAttributeValue string, queryHashAttributeValue string, queryTextAttributeValue string) {
+	if !m.config.Enabled {
+		return
+	}
+	dp := m.data.Gauge().DataPoints().AppendEmpty()
+	dp.SetStartTimestamp(start)
+	dp.SetTimestamp(ts)
+	dp.SetIntValue(val)
+	dp.Attributes().PutStr("database.name", databaseNameAttributeValue)
+	dp.Attributes().PutStr("query.hash", queryHashAttributeValue)
+	dp.Attributes().PutStr("query.text", queryTextAttributeValue)
+}
+
+// updateCapacity saves max length of data point slices that will be used for the slice capacity.
+func (m *metricSqlserverQueryStoreQueryLogicalReads) updateCapacity() {
+	if m.data.Gauge().DataPoints().Len() > m.capacity {
+		m.capacity = m.data.Gauge().DataPoints().Len()
+	}
+}
+
+// emit appends recorded metric data to a metrics slice and prepares it for recording another set of data points.
+func (m *metricSqlserverQueryStoreQueryLogicalReads) emit(metrics pmetric.MetricSlice) {
+	if m.config.Enabled && m.data.Gauge().DataPoints().Len() > 0 {
+		m.updateCapacity()
+		m.data.MoveTo(metrics.AppendEmpty())
+		m.init()
+	}
+}
+
+func newMetricSqlserverQueryStoreQueryLogicalReads(cfg MetricConfig) metricSqlserverQueryStoreQueryLogicalReads {
+	m := metricSqlserverQueryStoreQueryLogicalReads{config: cfg}
+	if cfg.Enabled {
+		m.data = pmetric.NewMetric()
+		m.init()
+	}
+	return m
+}
+
 type metricSqlserverResourcePoolDiskThrottledReadRate struct {
 	data     pmetric.Metric // data buffer for generated metric.
 	config   MetricConfig   // metric config provided by user.
@@ -1463,40 +1926,48 @@ func newMetricSqlserverUserConnectionCount(cfg MetricConfig) metricSqlserverUser
 // MetricsBuilder provides an interface for scrapers to report metrics while taking care of all the transformations
 // required to produce metric representation defined in metadata and user config.
 type MetricsBuilder struct {
-	config                                            MetricsBuilderConfig // config of the metrics builder.
-	startTime                                         pcommon.Timestamp    // start time that will be applied to all recorded data points.
-	metricsCapacity                                   int                  // maximum observed number of metrics per resource.
-	metricsBuffer                                     pmetric.Metrics      // accumulates metrics data before emitting.
-	buildInfo                                         component.BuildInfo  // contains version information.
-	resourceAttributeIncludeFilter                    map[string]filter.Filter
-	resourceAttributeExcludeFilter                    map[string]filter.Filter
-	metricSqlserverBatchRequestRate                   metricSqlserverBatchRequestRate
-	metricSqlserverBatchSQLCompilationRate            metricSqlserverBatchSQLCompilationRate
-	metricSqlserverBatchSQLRecompilationRate          metricSqlserverBatchSQLRecompilationRate
-	metricSqlserverDatabaseCount                      metricSqlserverDatabaseCount
-	metricSqlserverDatabaseIo                         metricSqlserverDatabaseIo
-	metricSqlserverDatabaseLatency                    metricSqlserverDatabaseLatency
-	metricSqlserverDatabaseOperations                 metricSqlserverDatabaseOperations
-	metricSqlserverLockWaitRate                       metricSqlserverLockWaitRate
-	metricSqlserverLockWaitTimeAvg                    metricSqlserverLockWaitTimeAvg
-	metricSqlserverPageBufferCacheHitRatio            metricSqlserverPageBufferCacheHitRatio
-	metricSqlserverPageCheckpointFlushRate            metricSqlserverPageCheckpointFlushRate
-	metricSqlserverPageLazyWriteRate                  metricSqlserverPageLazyWriteRate
-	metricSqlserverPageLifeExpectancy                 metricSqlserverPageLifeExpectancy
-	metricSqlserverPageOperationRate                  metricSqlserverPageOperationRate
-	metricSqlserverPageSplitRate                      metricSqlserverPageSplitRate
-	metricSqlserverProcessesBlocked                   metricSqlserverProcessesBlocked
-	metricSqlserverResourcePoolDiskThrottledReadRate  metricSqlserverResourcePoolDiskThrottledReadRate
-	metricSqlserverResourcePoolDiskThrottledWriteRate metricSqlserverResourcePoolDiskThrottledWriteRate
-	metricSqlserverTransactionRate                    metricSqlserverTransactionRate
-	metricSqlserverTransactionWriteRate               metricSqlserverTransactionWriteRate
-	metricSqlserverTransactionLogFlushDataRate        metricSqlserverTransactionLogFlushDataRate
-	metricSqlserverTransactionLogFlushRate            metricSqlserverTransactionLogFlushRate
-	metricSqlserverTransactionLogFlushWaitRate        metricSqlserverTransactionLogFlushWaitRate
-	metricSqlserverTransactionLogGrowthCount          metricSqlserverTransactionLogGrowthCount
-	metricSqlserverTransactionLogShrinkCount          metricSqlserverTransactionLogShrinkCount
-	metricSqlserverTransactionLogUsage                metricSqlserverTransactionLogUsage
-	metricSqlserverUserConnectionCount                metricSqlserverUserConnectionCount
+	config                                                         MetricsBuilderConfig // config of the metrics builder.
+	startTime                                                      pcommon.Timestamp    // start time that will be applied to all recorded data points.
+	metricsCapacity                                                int                  // maximum observed number of metrics per resource.
+	metricsBuffer                                                  pmetric.Metrics      // accumulates metrics data before emitting.
+	buildInfo                                                      component.BuildInfo  // contains version information.
+	resourceAttributeIncludeFilter                                 map[string]filter.Filter
+	resourceAttributeExcludeFilter                                 map[string]filter.Filter
+	metricSqlserverAvailabilityReplicaDatabaseLogSendQueueSize     metricSqlserverAvailabilityReplicaDatabaseLogSendQueueSize
+	metricSqlserverAvailabilityReplicaDatabaseRedoRate             metricSqlserverAvailabilityReplicaDatabaseRedoRate
+	metricSqlserverAvailabilityReplicaDatabaseRedoQueueSize        metricSqlserverAvailabilityReplicaDatabaseRedoQueueSize
+	metricSqlserverAvailabilityReplicaDatabaseSynchronizationState metricSqlserverAvailabilityReplicaDatabaseSynchronizationState
+	metricSqlserverBatchRequestRate                                metricSqlserverBatchRequestRate
+	metricSqlserverBatchSQLCompilationRate                         metricSqlserverBatchSQLCompilationRate
+	metricSqlserverBatchSQLRecompilationRate                       metricSqlserverBatchSQLRecompilationRate
+	metricSqlserverDatabaseCount                                   metricSqlserverDatabaseCount
+	metricSqlserverDatabaseIo                                      metricSqlserverDatabaseIo
+	metricSqlserverDatabaseLatency                                 metricSqlserverDatabaseLatency
+	metricSqlserverDatabaseOperations                              metricSqlserverDatabaseOperations
+	metricSqlserverLockWaitRate                                    metricSqlserverLockWaitRate
+	metricSqlserverLockWaitTimeAvg                                 metricSqlserverLockWaitTimeAvg
+	metricSqlserverPageBufferCacheHitRatio                         metricSqlserverPageBufferCacheHitRatio
+	metricSqlserverPageCheckpointFlushRate                         metricSqlserverPageCheckpointFlushRate
+	metricSqlserverPageLazyWriteRate                               metricSqlserverPageLazyWriteRate
+	metricSqlserverPageLifeExpectancy                              metricSqlserverPageLifeExpectancy
+	metricSqlserverPageOperationRate                               metricSqlserverPageOperationRate
+	metricSqlserverPageSplitRate                                   metricSqlserverPageSplitRate
+	metricSqlserverProcessesBlocked                                metricSqlserverProcessesBlocked
+	metricSqlserverQueryStoreQueryCPUTime                          metricSqlserverQueryStoreQueryCPUTime
+	metricSqlserverQueryStoreQueryDuration                         metricSqlserverQueryStoreQueryDuration
+	metricSqlserverQueryStoreQueryExecutions                       metricSqlserverQueryStoreQueryExecutions
+	metricSqlserverQueryStoreQueryLogicalReads                     metricSqlserverQueryStoreQueryLogicalReads
+	metricSqlserverResourcePoolDiskThrottledReadRate               metricSqlserverResourcePoolDiskThrottledReadRate
+	metricSqlserverResourcePoolDiskThrottledWriteRate              metricSqlserverResourcePoolDiskThrottledWriteRate
+	metricSqlserverTransactionRate                                 metricSqlserverTransactionRate
+	metricSqlserverTransactionWriteRate                            metricSqlserverTransactionWriteRate
+	metricSqlserverTransactionLogFlushDataRate                     metricSqlserverTransactionLogFlushDataRate
+	metricSqlserverTransactionLogFlushRate                         metricSqlserverTransactionLogFlushRate
+	metricSqlserverTransactionLogFlushWaitRate                     metricSqlserverTransactionLogFlushWaitRate
+	metricSqlserverTransactionLogGrowthCount                       metricSqlserverTransactionLogGrowthCount
+	metricSqlserverTransactionLogShrinkCount                       metricSqlserverTransactionLogShrinkCount
+	metricSqlserverTransactionLogUsage                             metricSqlserverTransactionLogUsage
+	metricSqlserverUserConnectionCount                             metricSqlserverUserConnectionCount
 }
 
 // metricBuilderOption applies changes to default metrics builder.
@@ -1511,39 +1982,47 @@ func WithStartTime(startTime pcommon.Timestamp) metricBuilderOption {
 
 func NewMetricsBuilder(mbc MetricsBuilderConfig, settings receiver.CreateSettings, options ...metricBuilderOption) *MetricsBuilder {
 	mb := &MetricsBuilder{
-		config:                                            mbc,
-		startTime:                                         pcommon.NewTimestampFromTime(time.Now()),
-		metricsBuffer:                                     pmetric.NewMetrics(),
-		buildInfo:                                         settings.BuildInfo,
-		metricSqlserverBatchRequestRate:                   newMetricSqlserverBatchRequestRate(mbc.Metrics.SqlserverBatchRequestRate),
-		metricSqlserverBatchSQLCompilationRate:            newMetricSqlserverBatchSQLCompilationRate(mbc.Metrics.SqlserverBatchSQLCompilationRate),
-		metricSqlserverBatchSQLRecompilationRate:          newMetricSqlserverBatchSQLRecompilationRate(mbc.Metrics.SqlserverBatchSQLRecompilationRate),
-		metricSqlserverDatabaseCount:                      newMetricSqlserverDatabaseCount(mbc.Metrics.SqlserverDatabaseCount),
-		metricSqlserverDatabaseIo:                         newMetricSqlserverDatabaseIo(mbc.Metrics.SqlserverDatabaseIo),
-		metricSqlserverDatabaseLatency:                    newMetricSqlserverDatabaseLatency(mbc.Metrics.SqlserverDatabaseLatency),
-		metricSqlserverDatabaseOperations:                 newMetricSqlserverDatabaseOperations(mbc.Metrics.SqlserverDatabaseOperations),
-		metricSqlserverLockWaitRate:                       newMetricSqlserverLockWaitRate(mbc.Metrics.SqlserverLockWaitRate),
-		metricSqlserverLockWaitTimeAvg:                    newMetricSqlserverLockWaitTimeAvg(mbc.Metrics.SqlserverLockWaitTimeAvg),
-		metricSqlserverPageBufferCacheHitRatio:            newMetricSqlserverPageBufferCacheHitRatio(mbc.Metrics.SqlserverPageBufferCacheHitRatio),
-		metricSqlserverPageCheckpointFlushRate:            newMetricSqlserverPageCheckpointFlushRate(mbc.Metrics.SqlserverPageCheckpointFlushRate),
-		metricSqlserverPageLazyWriteRate:                  newMetricSqlserverPageLazyWriteRate(mbc.Metrics.SqlserverPageLazyWriteRate),
-		metricSqlserverPageLifeExpectancy:                 newMetricSqlserverPageLifeExpectancy(mbc.Metrics.SqlserverPageLifeExpectancy),
-		metricSqlserverPageOperationRate:                  newMetricSqlserverPageOperationRate(mbc.Metrics.SqlserverPageOperationRate),
-		metricSqlserverPageSplitRate:                      newMetricSqlserverPageSplitRate(mbc.Metrics.SqlserverPageSplitRate),
-		metricSqlserverProcessesBlocked:                   newMetricSqlserverProcessesBlocked(mbc.Metrics.SqlserverProcessesBlocked),
-		metricSqlserverResourcePoolDiskThrottledReadRate:  newMetricSqlserverResourcePoolDiskThrottledReadRate(mbc.Metrics.SqlserverResourcePoolDiskThrottledReadRate),
-		metricSqlserverResourcePoolDiskThrottledWriteRate: newMetricSqlserverResourcePoolDiskThrottledWriteRate(mbc.Metrics.SqlserverResourcePoolDiskThrottledWriteRate),
-		metricSqlserverTransactionRate:                    newMetricSqlserverTransactionRate(mbc.Metrics.SqlserverTransactionRate),
-		metricSqlserverTransactionWriteRate:               newMetricSqlserverTransactionWriteRate(mbc.Metrics.SqlserverTransactionWriteRate),
-		metricSqlserverTransactionLogFlushDataRate:        newMetricSqlserverTransactionLogFlushDataRate(mbc.Metrics.SqlserverTransactionLogFlushDataRate),
-		metricSqlserverTransactionLogFlushRate:            newMetricSqlserverTransactionLogFlushRate(mbc.Metrics.SqlserverTransactionLogFlushRate),
-		metricSqlserverTransactionLogFlushWaitRate:        newMetricSqlserverTransactionLogFlushWaitRate(mbc.Metrics.SqlserverTransactionLogFlushWaitRate),
-		metricSqlserverTransactionLogGrowthCount:          newMetricSqlserverTransactionLogGrowthCount(mbc.Metrics.SqlserverTransactionLogGrowthCount),
-		metricSqlserverTransactionLogShrinkCount:          newMetricSqlserverTransactionLogShrinkCount(mbc.Metrics.SqlserverTransactionLogShrinkCount),
-		metricSqlserverTransactionLogUsage:                newMetricSqlserverTransactionLogUsage(mbc.Metrics.SqlserverTransactionLogUsage),
-		metricSqlserverUserConnectionCount:                newMetricSqlserverUserConnectionCount(mbc.Metrics.SqlserverUserConnectionCount),
-		resourceAttributeIncludeFilter:                    make(map[string]filter.Filter),
-		resourceAttributeExcludeFilter:                    make(map[string]filter.Filter),
+		config:        mbc,
+		startTime:     pcommon.NewTimestampFromTime(time.Now()),
+		metricsBuffer: pmetric.NewMetrics(),
+		buildInfo:     settings.BuildInfo,
+		metricSqlserverAvailabilityReplicaDatabaseLogSendQueueSize:     newMetricSqlserverAvailabilityReplicaDatabaseLogSendQueueSize(mbc.Metrics.SqlserverAvailabilityReplicaDatabaseLogSendQueueSize),
+		metricSqlserverAvailabilityReplicaDatabaseRedoRate:             newMetricSqlserverAvailabilityReplicaDatabaseRedoRate(mbc.Metrics.SqlserverAvailabilityReplicaDatabaseRedoRate),
+		metricSqlserverAvailabilityReplicaDatabaseRedoQueueSize:        newMetricSqlserverAvailabilityReplicaDatabaseRedoQueueSize(mbc.Metrics.SqlserverAvailabilityReplicaDatabaseRedoQueueSize),
+		metricSqlserverAvailabilityReplicaDatabaseSynchronizationState: newMetricSqlserverAvailabilityReplicaDatabaseSynchronizationState(mbc.Metrics.SqlserverAvailabilityReplicaDatabaseSynchronizationState),
+		metricSqlserverBatchRequestRate:                                newMetricSqlserverBatchRequestRate(mbc.Metrics.SqlserverBatchRequestRate),
+		metricSqlserverBatchSQLCompilationRate:                         newMetricSqlserverBatchSQLCompilationRate(mbc.Metrics.SqlserverBatchSQLCompilationRate),
+		metricSqlserverBatchSQLRecompilationRate:                       newMetricSqlserverBatchSQLRecompilationRate(mbc.Metrics.SqlserverBatchSQLRecompilationRate),
+		metricSqlserverDatabaseCount:                                   newMetricSqlserverDatabaseCount(mbc.Metrics.SqlserverDatabaseCount),
+		metricSqlserverDatabaseIo:                                      newMetricSqlserverDatabaseIo(mbc.Metrics.SqlserverDatabaseIo),
+		metricSqlserverDatabaseLatency:                                 newMetricSqlserverDatabaseLatency(mbc.Metrics.SqlserverDatabaseLatency),
+		metricSqlserverDatabaseOperations:                              newMetricSqlserverDatabaseOperations(mbc.Metrics.SqlserverDatabaseOperations),
+		metricSqlserverLockWaitRate:                                    newMetricSqlserverLockWaitRate(mbc.Metrics.SqlserverLockWaitRate),
+		metricSqlserverLockWaitTimeAvg:                                 newMetricSqlserverLockWaitTimeAvg(mbc.Metrics.SqlserverLockWaitTimeAvg),
+		metricSqlserverPageBufferCacheHitRatio:                         newMetricSqlserverPageBufferCacheHitRatio(mbc.Metrics.SqlserverPageBufferCacheHitRatio),
+		metricSqlserverPageCheckpointFlushRate:                         newMetricSqlserverPageCheckpointFlushRate(mbc.Metrics.SqlserverPageCheckpointFlushRate),
+		metricSqlserverPageLazyWriteRate:                               newMetricSqlserverPageLazyWriteRate(mbc.Metrics.SqlserverPageLazyWriteRate),
+		metricSqlserverPageLifeExpectancy:                              newMetricSqlserverPageLifeExpectancy(mbc.Metrics.SqlserverPageLifeExpectancy),
+		metricSqlserverPageOperationRate:                               newMetricSqlserverPageOperationRate(mbc.Metrics.SqlserverPageOperationRate),
+		metricSqlserverPageSplitRate:                                   newMetricSqlserverPageSplitRate(mbc.Metrics.SqlserverPageSplitRate),
+		metricSqlserverProcessesBlocked:                                newMetricSqlserverProcessesBlocked(mbc.Metrics.SqlserverProcessesBlocked),
+		metricSqlserverQueryStoreQueryCPUTime:                          newMetricSqlserverQueryStoreQueryCPUTime(mbc.Metrics.SqlserverQueryStoreQueryCPUTime),
+		metricSqlserverQueryStoreQueryDuration:                         newMetricSqlserverQueryStoreQueryDuration(mbc.Metrics.SqlserverQueryStoreQueryDuration),
+		metricSqlserverQueryStoreQueryExecutions:                       newMetricSqlserverQueryStoreQueryExecutions(mbc.Metrics.SqlserverQueryStoreQueryExecutions),
+		metricSqlserverQueryStoreQueryLogicalReads:                     newMetricSqlserverQueryStoreQueryLogicalReads(mbc.Metrics.SqlserverQueryStoreQueryLogicalReads),
+		metricSqlserverResourcePoolDiskThrottledReadRate:               newMetricSqlserverResourcePoolDiskThrottledReadRate(mbc.Metrics.SqlserverResourcePoolDiskThrottledReadRate),
+		metricSqlserverResourcePoolDiskThrottledWriteRate:              newMetricSqlserverResourcePoolDiskThrottledWriteRate(mbc.Metrics.SqlserverResourcePoolDiskThrottledWriteRate),
+		metricSqlserverTransactionRate:                                 newMetricSqlserverTransactionRate(mbc.Metrics.SqlserverTransactionRate),
+		metricSqlserverTransactionWriteRate:                            newMetricSqlserverTransactionWriteRate(mbc.Metrics.SqlserverTransactionWriteRate),
+		metricSqlserverTransactionLogFlushDataRate:                     newMetricSqlserverTransactionLogFlushDataRate(mbc.Metrics.SqlserverTransactionLogFlushDataRate),
+		metricSqlserverTransactionLogFlushRate:                         newMetricSqlserverTransactionLogFlushRate(mbc.Metrics.SqlserverTransactionLogFlushRate),
+		metricSqlserverTransactionLogFlushWaitRate:                     newMetricSqlserverTransactionLogFlushWaitRate(mbc.Metrics.SqlserverTransactionLogFlushWaitRate),
+		metricSqlserverTransactionLogGrowthCount:                       newMetricSqlserverTransactionLogGrowthCount(mbc.Metrics.SqlserverTransactionLogGrowthCount),
+		metricSqlserverTransactionLogShrinkCount:                       newMetricSqlserverTransactionLogShrinkCount(mbc.Metrics.SqlserverTransactionLogShrinkCount),
+		metricSqlserverTransactionLogUsage:                             newMetricSqlserverTransactionLogUsage(mbc.Metrics.SqlserverTransactionLogUsage),
+		metricSqlserverUserConnectionCount:                             newMetricSqlserverUserConnectionCount(mbc.Metrics.SqlserverUserConnectionCount),
+		resourceAttributeIncludeFilter:                                 make(map[string]filter.Filter),
+		resourceAttributeExcludeFilter:                                 make(map[string]filter.Filter),
 	}
 	if mbc.ResourceAttributes.SqlserverComputerName.MetricsInclude != nil {
 		mb.resourceAttributeIncludeFilter["sqlserver.computer.name"] = filter.CreateFilter(mbc.ResourceAttributes.SqlserverComputerName.MetricsInclude)
@@ -1624,6 +2103,10 @@ func (mb *MetricsBuilder) EmitForResource(rmo ...ResourceMetricsOption) {
 	ils.Scope().SetName("otelcol/sqlserverreceiver")
 	ils.Scope().SetVersion(mb.buildInfo.Version)
 	ils.Metrics().EnsureCapacity(mb.metricsCapacity)
+	mb.metricSqlserverAvailabilityReplicaDatabaseLogSendQueueSize.emit(ils.Metrics())
+	mb.metricSqlserverAvailabilityReplicaDatabaseRedoRate.emit(ils.Metrics())
+	mb.metricSqlserverAvailabilityReplicaDatabaseRedoQueueSize.emit(ils.Metrics())
+	mb.metricSqlserverAvailabilityReplicaDatabaseSynchronizationState.emit(ils.Metrics())
 	mb.metricSqlserverBatchRequestRate.emit(ils.Metrics())
 	mb.metricSqlserverBatchSQLCompilationRate.emit(ils.Metrics())
 	mb.metricSqlserverBatchSQLRecompilationRate.emit(ils.Metrics())
@@ -1640,6 +2123,10 @@ func (mb *MetricsBuilder) EmitForResource(rmo ...ResourceMetricsOption) {
 	mb.metricSqlserverPageOperationRate.emit(ils.Metrics())
 	mb.metricSqlserverPageSplitRate.emit(ils.Metrics())
 	mb.metricSqlserverProcessesBlocked.emit(ils.Metrics())
+	mb.metricSqlserverQueryStoreQueryCPUTime.emit(ils.Metrics())
+	mb.metricSqlserverQueryStoreQueryDuration.emit(ils.Metrics())
+	mb.metricSqlserverQueryStoreQueryExecutions.emit(ils.Metrics())
+	mb.metricSqlserverQueryStoreQueryLogicalReads.emit(ils.Metrics())
 	mb.metricSqlserverResourcePoolDiskThrottledReadRate.emit(ils.Metrics())
 	mb.metricSqlserverResourcePoolDiskThrottledWriteRate.emit(ils.Metrics())
 	mb.metricSqlserverTransactionRate.emit(ils.Metrics())
@@ -1682,6 +2169,26 @@ func (mb *MetricsBuilder) Emit(rmo ...ResourceMetricsOption) pmetric.Metrics {
 	return metrics
 }
 
+// RecordSqlserverAvailabilityReplicaDatabaseLogSendQueueSizeDataPoint adds a data point to sqlserver.availability_replica.database.log_send_queue.size metric.
+func (mb *MetricsBuilder) RecordSqlserverAvailabilityReplicaDatabaseLogSendQueueSizeDataPoint(ts pcommon.Timestamp, val int64, availabilityGroupNameAttributeValue string, availabilityReplicaNameAttributeValue string, databaseNameAttributeValue string) {
+	mb.metricSqlserverAvailabilityReplicaDatabaseLogSendQueueSize.recordDataPoint(mb.startTime, ts, val, availabilityGroupNameAttributeValue, availabilityReplicaNameAttributeValue, databaseNameAttributeValue)
+}
+
+// RecordSqlserverAvailabilityReplicaDatabaseRedoRateDataPoint adds a data point to sqlserver.availability_replica.database.redo.rate metric.
+func (mb *MetricsBuilder) RecordSqlserverAvailabilityReplicaDatabaseRedoRateDataPoint(ts pcommon.Timestamp, val int64, availabilityGroupNameAttributeValue string, availabilityReplicaNameAttributeValue string, databaseNameAttributeValue string) {
+	mb.metricSqlserverAvailabilityReplicaDatabaseRedoRate.recordDataPoint(mb.startTime, ts, val, availabilityGroupNameAttributeValue, availabilityReplicaNameAttributeValue, databaseNameAttributeValue)
+}
+
+// RecordSqlserverAvailabilityReplicaDatabaseRedoQueueSizeDataPoint adds a data point to sqlserver.availability_replica.database.redo_queue.size metric.
+func (mb *MetricsBuilder) RecordSqlserverAvailabilityReplicaDatabaseRedoQueueSizeDataPoint(ts pcommon.Timestamp, val int64, availabilityGroupNameAttributeValue string, availabilityReplicaNameAttributeValue string, databaseNameAttributeValue string) {
+	mb.metricSqlserverAvailabilityReplicaDatabaseRedoQueueSize.recordDataPoint(mb.startTime, ts, val, availabilityGroupNameAttributeValue, availabilityReplicaNameAttributeValue, databaseNameAttributeValue)
+}
+
+// RecordSqlserverAvailabilityReplicaDatabaseSynchronizationStateDataPoint adds a data point to sqlserver.availability_replica.database.synchronization_state metric.
+func (mb *MetricsBuilder) RecordSqlserverAvailabilityReplicaDatabaseSynchronizationStateDataPoint(ts pcommon.Timestamp, val int64, availabilityGroupNameAttributeValue string, availabilityReplicaNameAttributeValue string, databaseNameAttributeValue string, synchronizationStateAttributeValue AttributeSynchronizationState) {
+	mb.metricSqlserverAvailabilityReplicaDatabaseSynchronizationState.recordDataPoint(mb.startTime, ts, val, availabilityGroupNameAttributeValue, availabilityReplicaNameAttributeValue, databaseNameAttributeValue, synchronizationStateAttributeValue.String())
+}
+
 // RecordSqlserverBatchRequestRateDataPoint adds a data point to sqlserver.batch.request.rate metric.
 func (mb *MetricsBuilder) RecordSqlserverBatchRequestRateDataPoint(ts pcommon.Timestamp, val float64) {
 	mb.metricSqlserverBatchRequestRate.recordDataPoint(mb.startTime, ts, val)
@@ -1782,6 +2289,36 @@ func (mb *MetricsBuilder) RecordSqlserverProcessesBlockedDataPoint(ts pcommon.Ti
 	return nil
 }
 
+// RecordSqlserverQueryStoreQueryCPUTimeDataPoint adds a data point to sqlserver.query_store.query.cpu_time metric.
+func (mb *MetricsBuilder) RecordSqlserverQueryStoreQueryCPUTimeDataPoint(ts pcommon.Timestamp, val float64, databaseNameAttributeValue string, queryHashAttributeValue string, queryTextAttributeValue string) {
+	mb.metricSqlserverQueryStoreQueryCPUTime.recordDataPoint(mb.startTime, ts, val, databaseNameAttributeValue, queryHashAttributeValue, queryTextAttributeValue)
+}
+
+// RecordSqlserverQueryStoreQueryDurationDataPoint adds a data point to sqlserver.query_store.query.duration metric.
+func (mb *MetricsBuilder) RecordSqlserverQueryStoreQueryDurationDataPoint(ts pcommon.Timestamp, val float64, databaseNameAttributeValue string, queryHashAttributeValue string, queryTextAttributeValue string) {
+	mb.metricSqlserverQueryStoreQueryDuration.recordDataPoint(mb.startTime, ts, val, databaseNameAttributeValue, queryHashAttributeValue, queryTextAttributeValue)
+}
+
+// RecordSqlserverQueryStoreQueryExecutionsDataPoint adds a data point to sqlserver.query_store.query.executions metric.
+func (mb *MetricsBuilder) RecordSqlserverQueryStoreQueryExecutionsDataPoint(ts pcommon.Timestamp, inputVal string, databaseNameAttributeValue string, queryHashAttributeValue string, queryTextAttributeValue string) error {
+	val, err := strconv.ParseInt(inputVal, 10, 64)
+	if err != nil {
+		return fmt.Errorf("failed to parse int64 for SqlserverQueryStoreQueryExecutions, value was %s: %w", inputVal, err)
+	}
+	mb.metricSqlserverQueryStoreQueryExecutions.recordDataPoint(mb.startTime, ts, val, databaseNameAttributeValue, queryHashAttributeValue, queryTextAttributeValue)
+	return nil
+}
+
+// RecordSqlserverQueryStoreQueryLogicalReadsDataPoint adds a data point to sqlserver.query_store.query.logical_reads metric.
+func (mb *MetricsBuilder) RecordSqlserverQueryStoreQueryLogicalReadsDataPoint(ts pcommon.Timestamp, inputVal string, databaseNameAttributeValue string, queryHashAttributeValue string, queryTextAttributeValue string) error {
+	val, err := strconv.ParseInt(inputVal, 10, 64)
+	if err != nil {
+		return fmt.Errorf("failed to parse int64 for SqlserverQueryStoreQueryLogicalReads, value was %s: %w", inputVal, err)
+	}
+	mb.metricSqlserverQueryStoreQueryLogicalReads.recordDataPoint(mb.startTime, ts, val, databaseNameAttributeValue, queryHashAttributeValue, queryTextAttributeValue)
+	return nil
+}
+
 // RecordSqlserverResourcePoolDiskThrottledReadRateDataPoint adds a data point to sqlserver.resource_pool.disk.throttled.read.rate metric.
 func (mb *MetricsBuilder) RecordSqlserverResourcePoolDiskThrottledReadRateDataPoint(ts pcommon.Timestamp, inputVal string) error {
 	val, err := strconv.ParseInt(inputVal, 10, 64)
@@ -68,6 +68,18 @@ func TestMetricsBuilder(t *testing.T) {
 			defaultMetricsCount := 0
 			allMetricsCount := 0
 
+			allMetricsCount++
+			mb.RecordSqlserverAvailabilityReplicaDatabaseLogSendQueueSizeDataPoint(ts, 1, "availability_group.name-val", "availability_replica.name-val", "database.name-val")
+
+			allMetricsCount++
+			mb.RecordSqlserverAvailabilityReplicaDatabaseRedoRateDataPoint(ts, 1, "availability_group.name-val", "availability_replica.name-val", "database.name-val")
+
+			allMetricsCount++
+			mb.RecordSqlserverAvailabilityReplicaDatabaseRedoQueueSizeDataPoint(ts, 1, "availability_group.name-val", "availability_replica.name-val", "database.name-val")
+
+			allMetricsCount++
+			mb.RecordSqlserverAvailabilityReplicaDatabaseSynchronizationStateDataPoint(ts, 1, "availability_group.name-val", "availability_replica.name-val", "database.name-val", AttributeSynchronizationStateNotSynchronizing)
+
 			defaultMetricsCount++
 			allMetricsCount++
 			mb.RecordSqlserverBatchRequestRateDataPoint(ts, 1)
@@ -127,6 +139,18 @@ func TestMetricsBuilder(t *testing.T) {
 			allMetricsCount++
 			mb.RecordSqlserverProcessesBlockedDataPoint(ts, "1")
 
+			allMetricsCount++
+			mb.RecordSqlserverQueryStoreQueryCPUTimeDataPoint(ts, 1, "database.name-val", "query.hash-val", "query.text-val")
+
+			allMetricsCount++
+			mb.RecordSqlserverQueryStoreQueryDurationDataPoint(ts, 1, "database.name-val", "query.hash-val", "query.text-val")
+
+			allMetricsCount++
+			mb.RecordSqlserverQueryStoreQueryExecutionsDataPoint(ts, "1", "database.name-val", "query.hash-val", "query.text-val")
+
+			allMetricsCount++
+			mb.RecordSqlserverQueryStoreQueryLogicalReadsDataPoint(ts, "1", "database.name-val", "query.hash-val", "query.text-val")
+
 			allMetricsCount++
 			mb.RecordSqlserverResourcePoolDiskThrottledReadRateDataPoint(ts, "1")
 
@@ -195,6 +219,93 @@ func TestMetricsBuilder(t *testing.T) {
 			validatedMetrics := make(map[string]bool)
 			for i := 0; i < ms.Len(); i++ {
 				switch ms.At(i).Name() {
+				case "sqlserver.availability_replica.database.log_send_queue.size":
+					assert.False(t, validatedMetrics["sqlserver.availability_replica.database.log_send_queue.size"], "Found a duplicate in the metrics slice: sqlserver.availability_replica.database.log_send_queue.size")
+					validatedMetrics["sqlserver.availability_replica.database.log_send_queue.size"] = true
+					assert.Equal(t, pmetric.MetricTypeGauge, ms.At(i).Type())
+					assert.Equal(t, 1, ms.At(i).Gauge().DataPoints().Len())
+					assert.Equal(t, "The amount of log records of the primary database that haven't been sent to the secondary replica.", ms.At(i).Description())
+					assert.Equal(t, "By", ms.At(i).Unit())
+					dp := ms.At(i).Gauge().DataPoints().At(0)
+					assert.Equal(t, start, dp.StartTimestamp())
+					assert.Equal(t, ts, dp.Timestamp())
+					assert.Equal(t, pmetric.NumberDataPointValueTypeInt, dp.ValueType())
+					assert.Equal(t, int64(1), dp.IntValue())
+					attrVal, ok := dp.Attributes().Get("availability_group.name")
+					assert.True(t, ok)
+					assert.EqualValues(t, "availability_group.name-val", attrVal.Str())
+					attrVal, ok = dp.Attributes().Get("availability_replica.name")
+					assert.True(t, ok)
+					assert.EqualValues(t, "availability_replica.name-val", attrVal.Str())
+					attrVal, ok = dp.Attributes().Get("database.name")
+					assert.True(t, ok)
+					assert.EqualValues(t, "database.name-val", attrVal.Str())
+				case "sqlserver.availability_replica.database.redo.rate":
+					assert.False(t, validatedMetrics["sqlserver.availability_replica.database.redo.rate"], "Found a duplicate in the metrics slice: sqlserver.availability_replica.database.redo.rate")
+					validatedMetrics["sqlserver.availability_replica.database.redo.rate"] = true
+					assert.Equal(t, pmetric.MetricTypeGauge, ms.At(i).Type())
+					assert.Equal(t, 1, ms.At(i).Gauge().DataPoints().Len())
+					assert.Equal(t, "The average rate at which the log records are redone on the secondary replica.", ms.At(i).Description())
+					assert.Equal(t, "By/s", ms.At(i).Unit())
+					dp := ms.At(i).Gauge().DataPoints().At(0)
+					assert.Equal(t, start, dp.StartTimestamp())
+					assert.Equal(t, ts, dp.Timestamp())
+					assert.Equal(t, pmetric.NumberDataPointValueTypeInt, dp.ValueType())
+					assert.Equal(t, int64(1), dp.IntValue())
+					attrVal, ok := dp.Attributes().Get("availability_group.name")
+					assert.True(t, ok)
+					assert.EqualValues(t, "availability_group.name-val", attrVal.Str())
+					attrVal, ok = dp.Attributes().Get("availability_replica.name")
+					assert.True(t, ok)
+					assert.EqualValues(t, "availability_replica.name-val", attrVal.Str())
+					attrVal, ok = dp.Attributes().Get("database.name")
+					assert.True(t, ok)
+					assert.EqualValues(t, "database.name-val", attrVal.Str())
+				case "sqlserver.availability_replica.database.redo_queue.size":
+					assert.False(t, validatedMetrics["sqlserver.availability_replica.database.redo_queue.size"], "Found a duplicate in the metrics slice: sqlserver.availability_replica.database.redo_queue.size")
+					validatedMetrics["sqlserver.availability_replica.database.redo_queue.size"] = true
+					assert.Equal(t, pmetric.MetricTypeGauge, ms.At(i).Type())
+					assert.Equal(t, 1, ms.At(i).Gauge().DataPoints().Len())
+					assert.Equal(t, "The amount of log records in the log files of the secondary replica that haven't been redone yet.", ms.At(i).Description())
+					assert.Equal(t, "By", ms.At(i).Unit())
+					dp := ms.At(i).Gauge().DataPoints().At(0)
+					assert.Equal(t, start, dp.StartTimestamp())
+					assert.Equal(t, ts, dp.Timestamp())
+					assert.Equal(t, pmetric.NumberDataPointValueTypeInt, dp.ValueType())
+					assert.Equal(t, int64(1), dp.IntValue())
+					attrVal, ok := dp.Attributes().Get("availability_group.name")
+					assert.True(t, ok)
+					assert.EqualValues(t, "availability_group.name-val", attrVal.Str())
+					attrVal, ok = dp.Attributes().Get("availability_replica.name")
+					assert.True(t, ok)
+					assert.EqualValues(t, "availability_replica.name-val", attrVal.Str())
+					attrVal, ok = dp.Attributes().Get("database.name")
+					assert.True(t, ok)
+					assert.EqualValues(t, "database.name-val", attrVal.Str())
+				case "sqlserver.availability_replica.database.synchronization_state":
+					assert.False(t, validatedMetrics["sqlserver.availability_replica.database.synchronization_state"], "Found a duplicate in the metrics slice: sqlserver.availability_replica.database.synchronization_state")
+					validatedMetrics["sqlserver.availability_replica.database.synchronization_state"] = true
+					assert.Equal(t, pmetric.MetricTypeGauge, ms.At(i).Type())
+					assert.Equal(t, 1, ms.At(i).Gauge().DataPoints().Len())
+					assert.Equal(t, "The data movement state of the availability databases on the replicas. The value is 1 for the current state, and 0 for the other states.", ms.At(i).Description())
+					assert.Equal(t, "1", ms.At(i).Unit())
+					dp := ms.At(i).Gauge().DataPoints().At(0)
+					assert.Equal(t, start, dp.StartTimestamp())
+					assert.Equal(t, ts, dp.Timestamp())
+					assert.Equal(t, pmetric.NumberDataPointValueTypeInt, dp.ValueType())
+					assert.Equal(t, int64(1), dp.IntValue())
+					attrVal, ok := dp.Attributes().Get("availability_group.name")
+					assert.True(t, ok)
+					assert.EqualValues(t, "availability_group.name-val", attrVal.Str())
+					attrVal, ok = dp.Attributes().Get("availability_replica.name")
+					assert.True(t, ok)
+					assert.EqualValues(t, "availability_replica.name-val", attrVal.Str())
+					attrVal, ok = dp.Attributes().Get("database.name")
+					assert.True(t, ok)
+					assert.EqualValues(t, "database.name-val", attrVal.Str())
+					attrVal, ok = dp.Attributes().Get("synchronization_state")
+					assert.True(t, ok)
+					assert.EqualValues(t, "not_synchronizing", attrVal.Str())
 				case "sqlserver.batch.request.rate":
 					assert.False(t, validatedMetrics["sqlserver.batch.request.rate"], "Found a duplicate in the metrics slice: sqlserver.batch.request.rate")
 					validatedMetrics["sqlserver.batch.request.rate"] = true
@@ -435,6 +546,90 @@ func TestMetricsBuilder(t *testing.T) {
 					assert.Equal(t, ts, dp.Timestamp())
 					assert.Equal(t, pmetric.NumberDataPointValueTypeInt, dp.ValueType())
 					assert.Equal(t, int64(1), dp.IntValue())
+				case "sqlserver.query_store.query.cpu_time":
+					assert.False(t, validatedMetrics["sqlserver.query_store.query.cpu_time"], "Found a duplicate in the metrics slice: sqlserver.query_store.query.cpu_time")
+					validatedMetrics["sqlserver.query_store.query.cpu_time"] = true
+					assert.Equal(t, pmetric.MetricTypeGauge, ms.At(i).Type())
+					assert.Equal(t, 1, ms.At(i).Gauge().DataPoints().Len())
+					assert.Equal(t, "The CPU time used by the queries using the most CPU time, over the last hour of Query Store statistics.", ms.At(i).Description())
+					assert.Equal(t, "s", ms.At(i).Unit())
+					dp := ms.At(i).Gauge().DataPoints().At(0)
+					assert.Equal(t, start, dp.StartTimestamp())
+					assert.Equal(t, ts, dp.Timestamp())
+					assert.Equal(t, pmetric.NumberDataPointValueTypeDouble, dp.ValueType())
+					assert.Equal(t, float64(1), dp.DoubleValue())
+					attrVal, ok := dp.Attributes().Get("database.name")
+					assert.True(t, ok)
+					assert.EqualValues(t, "database.name-val", attrVal.Str())
+					attrVal, ok = dp.Attributes().Get("query.hash")
+					assert.True(t, ok)
+					assert.EqualValues(t, "query.hash-val", attrVal.Str())
+					attrVal, ok = dp.Attributes().Get("query.text")
+					assert.True(t, ok)
+					assert.EqualValues(t, "query.text-val", attrVal.Str())
+				case "sqlserver.query_store.query.duration":
+					assert.False(t, validatedMetrics["sqlserver.query_store.query.duration"], "Found a duplicate in the metrics slice: sqlserver.query_store.query.duration")
+					validatedMetrics["sqlserver.query_store.query.duration"] = true
+					assert.Equal(t, pmetric.MetricTypeGauge, ms.At(i).Type())
+					assert.Equal(t, 1, ms.At(i).Gauge().DataPoints().Len())
+					assert.Equal(t, "The total elapsed time of the executions of the queries using the most CPU time, over the last hour of Query Store statistics.", ms.At(i).Description())
+					assert.Equal(t, "s", ms.At(i).Unit())
+					dp := ms.At(i).Gauge().DataPoints().At(0)
+					assert.Equal(t, start, dp.StartTimestamp())
+					assert.Equal(t, ts, dp.Timestamp())
+					assert.Equal(t, pmetric.NumberDataPointValueTypeDouble, dp.ValueType())
+					assert.Equal(t, float64(1), dp.DoubleValue())
+					attrVal, ok := dp.Attributes().Get("database.name")
+					assert.True(t, ok)
+					assert.EqualValues(t, "database.name-val", attrVal.Str())
+					attrVal, ok = dp.Attributes().Get("query.hash")
+					assert.True(t, ok)
+					assert.EqualValues(t, "query.hash-val", attrVal.Str())
+					attrVal, ok = dp.Attributes().Get("query.text")
+					assert.True(t, ok)
+					assert.EqualValues(t, "query.text-val", attrVal.Str())
+				case "sqlserver.query_store.query.executions":
+					assert.False(t, validatedMetrics["sqlserver.query_store.query.executions"], "Found a duplicate in the metrics slice: sqlserver.query_store.query.executions")
+					validatedMetrics["sqlserver.query_store.query.executions"] = true
+					assert.Equal(t, pmetric.MetricTypeGauge, ms.At(i).Type())
+					assert.Equal(t, 1, ms.At(i).Gauge().DataPoints().Len())
+					assert.Equal(t, "The number of executions of the queries using the most CPU time, over the last hour of Query Store statistics.", ms.At(i).Description())
+					assert.Equal(t, "{executions}", ms.At(i).Unit())
+					dp := ms.At(i).Gauge().DataPoints().At(0)
+					assert.Equal(t, start, dp.StartTimestamp())
+					assert.Equal(t, ts, dp.Timestamp())
+					assert.Equal(t, pmetric.NumberDataPointValueTypeInt, dp.ValueType())
+					assert.Equal(t, int64(1), dp.IntValue())
+					attrVal, ok := dp.Attributes().Get("database.name")
+					assert.True(t, ok)
+					assert.EqualValues(t, "database.name-val", attrVal.Str())
+					attrVal, ok = dp.Attributes().Get("query.hash")
+					assert.True(t, ok)
+					assert.EqualValues(t, "query.hash-val", attrVal.Str())
+					attrVal, ok = dp.Attributes().Get("query.text")
+					assert.True(t, ok)
+					assert.EqualValues(t, "query.text-val", attrVal.Str())
+				case "sqlserver.query_store.query.logical_reads":
+					assert.False(t, validatedMetrics["sqlserver.query_store.query.logical_reads"], "Found a duplicate in the metrics slice: sqlserver.query_store.query.logical_reads")
+					validatedMetrics["sqlserver.query_store.query.logical_reads"] = true
+					assert.Equal(t, pmetric.MetricTypeGauge, ms.At(i).Type())
+					assert.Equal(t, 1, ms.At(i).Gauge().DataPoints().Len())
+					assert.Equal(t, "The number of pages read from the buffer cache by the queries using the most CPU time, over the last hour of Query Store statistics.", ms.At(i).Description())
+					assert.Equal(t, "{pages}", ms.At(i).Unit())
+					dp := ms.At(i).Gauge().DataPoints().At(0)
+					assert.Equal(t, start, dp.StartTimestamp())
+					assert.Equal(t, ts, dp.Timestamp())
+					assert.Equal(t, pmetric.NumberDataPointValueTypeInt, dp.ValueType())
+					assert.Equal(t, int64(1), dp.IntValue())
+					attrVal, ok := dp.Attributes().Get("database.name")
+					assert.True(t, ok)
+					assert.EqualValues(t, "database.name-val", attrVal.Str())
+					attrVal, ok = dp.Attributes().Get("query.hash")
+					assert.True(t, ok)
+					assert.EqualValues(t, "query.hash-val", attrVal.Str())
+					attrVal, ok = dp.Attributes().Get("query.text")
+					assert.True(t, ok)
+					assert.EqualValues(t, "query.text-val", attrVal.Str())
 				case "sqlserver.resource_pool.disk.throttled.read.rate":
 					assert.False(t, validatedMetrics["sqlserver.resource_pool.disk.throttled.read.rate"], "Found a duplicate in the metrics slice: sqlserver.resource_pool.disk.throttled.read.rate")
 					validatedMetrics["sqlserver.resource_pool.disk.throttled.read.rate"] = true
@@ -333,3 +333,113 @@ func getSQLServerPropertiesQuery(instanceName string) string {
 
 	return fmt.Sprintf(sqlServerProperties, "")
 }
+
+const sqlServerAvailabilityReplicaQuery = `
+SET DEADLOCK_PRIORITY -10;
+IF SERVERPROPERTY('EngineEdition') NOT IN (2,3,4) BEGIN /*NOT IN Standard, Enterprise, Express*/
+	DECLARE @ErrorMessage AS nvarchar(500) = 'Connection string Server:'+ @@ServerName + ',Database:' + DB_NAME() +' is not a SQL Server Standard, Enterprise or Express. This query is only supported on these editions.';
+	RAISERROR (@ErrorMessage,11,1)
+	RETURN
+END
+
+SELECT
+	 'sqlserver_hadr_replica_states' AS [measurement]
+	,REPLACE(@@SERVERNAME,'\',':') AS [sql_instance]
+	,ag.[name] AS [availability_group_name]
+	,ar.[replica_server_name]
+	,DB_NAME(drs.[database_id]) AS [database_name]
+	,drs.[synchronization_state_desc] AS [synchronization_state]
+	,ISNULL(drs.[log_send_queue_size],0) AS [log_send_queue_size]
+	,ISNULL(drs.[redo_queue_size],0) AS [redo_queue_size]
+	,ISNULL(drs.[redo_rate],0) AS [redo_rate]
+FROM sys.[dm_hadr_database_replica_states] AS drs
+INNER JOIN sys.[availability_replicas] AS ar
+	ON drs.[replica_id] = ar.[replica_id]
+INNER JOIN sys.[availability_groups] AS ag
+	ON drs.[group_id] = ag.[group_id]
+{filter_instance_name}
+`
+
+func getSQLServerAvailabilityReplicaQuery(instanceName string) string {
+	if instanceName != "" {
+		whereClause := fmt.Sprintf("WHERE @@SERVERNAME = '%s'", instanceName)
+		r := strings.NewReplacer("{filter_instance_name}", whereClause)
+		return r.Replace(sqlServerAvailabilityReplicaQuery)
+	}
+
+	r := strings.NewReplacer("{filter_instance_name}", "")
+	return r.Replace(sqlServerAvailabilityReplicaQuery)
+}
+
+// The Query Store views are scoped to each database, so the query aggregates the runtime statistics of the last hour
+// of every database with the Query Store enabled, by query hash, and keeps the queries using the most CPU time.
+const sqlServerQueryStoreQuery = `
+SET DEADLOCK_PRIORITY -10;
+IF SERVERPROPERTY('EngineEdition') NOT IN (2,3,4) BEGIN /*NOT IN Standard, Enterprise, Express*/
+	DECLARE @ErrorMessage AS nvarchar(500) = 'Connection string Server:'+ @@ServerName + ',Database:' + DB_NAME() +' is not a SQL Server Standard, Enterprise or Express. This query is only supported on these editions.';
+	RAISERROR (@ErrorMessage,11,1)
+	RETURN
+END
+
+DECLARE @SqlStatement AS nvarchar(max) = N''
+
+SELECT @SqlStatement += CASE WHEN @SqlStatement = N'' THEN N'' ELSE N'
+UNION ALL' END + N'
+SELECT
+	 ' + QUOTENAME(d.[name],'''') + N' AS [database_name]
+	,CONVERT(varchar(18),q.[query_hash],1) AS [query_hash]
+	,MIN(LEFT(qt.[query_sql_text],4000)) AS [query_sql_text]
+	,SUM(rs.[count_executions]) AS [executions]
+	,SUM(rs.[avg_cpu_time] * rs.[count_executions]) AS [cpu_time_us]
+	,SUM(rs.[avg_duration] * rs.[count_executions]) AS [duration_us]
+	,CAST(SUM(rs.[avg_logical_io_reads] * rs.[count_executions]) AS bigint) AS [logical_reads]
+FROM ' + QUOTENAME(d.[name]) + N'.sys.[query_store_runtime_stats] AS rs
+INNER JOIN ' + QUOTENAME(d.[name]) + N'.sys.[query_store_runtime_stats_interval] AS rsi
+	ON rs.[runtime_stats_interval_id] = rsi.[runtime_stats_interval_id]
+INNER JOIN ' + QUOTENAME(d.[name]) + N'.sys.[query_store_plan] AS p
+	ON rs.[plan_id] = p.[plan_id]
+INNER JOIN ' + QUOTENAME(d.[name]) + N'.sys.[query_store_query] AS q
+	ON p.[query_id] = q.[query_id]
+INNER JOIN ' + QUOTENAME(d.[name]) + N'.sys.[query_store_query_text] AS qt
+	ON q.[query_text_id] = qt.[query_text_id]
+WHERE rsi.[end_time] > DATEADD(HOUR,-1,SYSUTCDATETIME())
+GROUP BY q.[query_hash]'
+FROM sys.databases AS d
+WHERE d.[is_query_store_on] = 1 AND d.[state] = 0
+
+IF @SqlStatement = N''
+	SET @SqlStatement = N'
+SELECT
+	 CAST(NULL AS sysname) AS [database_name]
+	,CAST(NULL AS varchar(18)) AS [query_hash]
+	,CAST(NULL AS nvarchar(4000)) AS [query_sql_text]
+	,CAST(0 AS bigint) AS [executions]
+	,CAST(0 AS float) AS [cpu_time_us]
+	,CAST(0 AS float) AS [duration_us]
+	,CAST(0 AS bigint) AS [logical_reads]
+WHERE 1 = 0'
+
+SET @SqlStatement = N'
+SELECT TOP ({top_query_count})
+	 ''sqlserver_query_store'' AS [measurement]
+	,REPLACE(@@SERVERNAME,''\'','':'') AS [sql_instance]
+	,qs.*
+FROM (' + @SqlStatement + N') AS qs
+{filter_instance_name}
+ORDER BY qs.[cpu_time_us] DESC'
+
+EXEC sp_executesql @SqlStatement
+`
+
+func getSQLServerQueryStoreQuery(instanceName string, topQueryCount uint) string {
+	whereClause := ""
+	if instanceName != "" {
+		whereClause = fmt.Sprintf("WHERE @@SERVERNAME = ''%s''", instanceName)
+	}
+
+	r := strings.NewReplacer(
+		"{top_query_count}", fmt.Sprint(topQueryCount),
+		"{filter_instance_name}", whereClause,
+	)
+	return r.Replace(sqlServerQueryStoreQuery)
+}
@@ -55,6 +55,34 @@ func TestQueryContents(t *testing.T) {
 			getQuery:                 getSQLServerPropertiesQuery,
 			expectedQueryValFilename: "propertyQueryWithInstanceName.txt",
 		},
+		{
+			name:                     "Test availability replica query without instance name",
+			instanceName:             "",
+			getQuery:                 getSQLServerAvailabilityReplicaQuery,
+			expectedQueryValFilename: "availabilityReplicaQueryWithoutInstanceName.txt",
+		},
+		{
+			name:                     "Test availability replica query with instance name",
+			instanceName:             "instanceName",
+			getQuery:                 getSQLServerAvailabilityReplicaQuery,
+			expectedQueryValFilename: "availabilityReplicaQueryWithInstanceName.txt",
+		},
+		{
+			name:         "Test query store query without instance name",
+			instanceName: "",
+			getQuery: func(instanceName string) string {
+				return getSQLServerQueryStoreQuery(instanceName, 10)
+			},
+			expectedQueryValFilename: "queryStoreQueryWithoutInstanceName.txt",
+		},
+		{
+			name:         "Test query store query with instance name",
+			instanceName: "instanceName",
+			getQuery: func(instanceName string) string {
+				return getSQLServerQueryStoreQuery(instanceName, 10)
+			},
+			expectedQueryValFilename: "queryStoreQueryWithInstanceName.txt",
+		},
 	}
 
 	for _, tt := range queryTests {
@@ -8,7 +8,9 @@ import (
 	"database/sql"
 	"errors"
 	"fmt"
+	"regexp"
 	"strconv"
+	"strings"
 	"time"
 
 	"go.opentelemetry.io/collector/component"
@@ -27,6 +29,7 @@ type sqlServerScraperHelper struct {
 	id                 component.ID
 	sqlQuery           string
 	instanceName       string
+	topQueryCount      uint
 	scrapeCfg          scraperhelper.ControllerConfig
 	clientProviderFunc sqlquery.ClientProviderFunc
 	dbProviderFunc     sqlquery.DbProviderFunc
@@ -42,6 +45,7 @@ var _ scraperhelper.Scraper = (*sqlServerScraperHelper)(nil)
 func newSQLServerScraper(id component.ID,
 	query string,
 	instanceName string,
+	topQueryCount uint,
 	scrapeCfg scraperhelper.ControllerConfig,
 	logger *zap.Logger,
 	telemetry sqlquery.TelemetryConfig,
@@ -53,6 +57,7 @@ func newSQLServerScraper(id component.ID,
 		id:                 id,
 		sqlQuery:           query,
 		instanceName:       instanceName,
+		topQueryCount:      topQueryCount,
 		scrapeCfg:          scrapeCfg,
 		logger:             logger,
 		telemetry:          telemetry,
@@ -88,6 +93,10 @@ func (s *sqlServerScraperHelper) Scrape(ctx context.Context) (pmetric.Metrics, e
 		err = s.recordDatabasePerfCounterMetrics(ctx, rb)
 	case getSQLServerPropertiesQuery(s.instanceName):
 		err = s.recordDatabaseStatusMetrics(ctx, rb)
+	case getSQLServerAvailabilityReplicaQuery(s.instanceName):
+		err = s.recordAvailabilityReplicaMetrics(ctx, rb)
+	case getSQLServerQueryStoreQuery(s.instanceName, s.topQueryCount):
+		err = s.recordQueryStoreMetrics(ctx, rb)
 	default:
 		return pmetric.Metrics{}, fmt.Errorf("Attempted to get metrics from unsupported query: %s", s.sqlQuery)
 	}
@@ -252,3 +261,131 @@ func (s *sqlServerScraperHelper) recordDatabaseStatusMetrics(ctx context.Context
 
 	return errors.Join(errs...)
 }
+
+func (s *sqlServerScraperHelper) recordAvailabilityReplicaMetrics(ctx context.Context, rb *metadata.ResourceBuilder) error {
+	// Constants are the column names of the availability database replica states
+	const availabilityGroupName = "availability_group_name"
+	const replicaServerName = "replica_server_name"
+	const databaseName = "database_name"
+	const synchronizationState = "synchronization_state"
+	const logSendQueueSize = "log_send_queue_size"
+	const redoQueueSize = "redo_queue_size"
+	const redoRate = "redo_rate"
+
+	rows, err := s.client.QueryRows(ctx)
+
+	if err != nil {
+		if errors.Is(err, sqlquery.ErrNullValueWarning) {
+			s.logger.Warn("problems encountered getting metric rows", zap.Error(err))
+		} else {
+			return fmt.Errorf("sqlServerScraperHelper failed getting metric rows: %w", err)
+		}
+	}
+
+	var errs []error
+	now := pcommon.NewTimestampFromTime(time.Now())
+	for i, row := range rows {
+		if i == 0 {
+			rb.SetSqlserverInstanceName(row[instanceNameKey])
+		}
+
+		group, replica, database := row[availabilityGroupName], row[replicaServerName], row[databaseName]
+		// the states are reported as NOT SYNCHRONIZING, SYNCHRONIZING, etc.
+		state := strings.ReplaceAll(strings.ToLower(row[synchronizationState]), " ", "_")
+		for name, value := range metadata.MapAttributeSynchronizationState {
+			current := int64(0)
+			if name == state {
+				current = 1
+			}
+			s.mb.RecordSqlserverAvailabilityReplicaDatabaseSynchronizationStateDataPoint(now, current, group, replica, database, value)
+		}
+
+		// the queue sizes are reported in kilobytes, and the redo rate in kilobytes per second
+		logSendQueue, err := strconv.ParseInt(row[logSendQueueSize], 10, 64)
+		if err != nil {
+			errs = append(errs, fmt.Errorf("row %d: %w", i, err))
+		} else {
+			s.mb.RecordSqlserverAvailabilityReplicaDatabaseLogSendQueueSizeDataPoint(now, logSendQueue*1024, group, replica, database)
+		}
+
+		redoQueue, err := strconv.ParseInt(row[redoQueueSize], 10, 64)
+		if err != nil {
+			errs = append(errs, fmt.Errorf("row %d: %w", i, err))
+		} else {
+			s.mb.RecordSqlserverAvailabilityReplicaDatabaseRedoQueueSizeDataPoint(now, redoQueue*1024, group, replica, database)
+		}
+
+		redo, err := strconv.ParseInt(row[redoRate], 10, 64)
+		if err != nil {
+			errs = append(errs, fmt.Errorf("row %d: %w", i, err))
+		} else {
+			s.mb.RecordSqlserverAvailabilityReplicaDatabaseRedoRateDataPoint(now, redo*1024, group, replica, database)
+		}
+	}
+
+	return errors.Join(errs...)
+}
+
+func (s *sqlServerScraperHelper) recordQueryStoreMetrics(ctx context.Context, rb *metadata.ResourceBuilder) error {
+	// Constants are the column names of the Query Store statistics
+	const databaseName = "database_name"
+	const queryHash = "query_hash"
+	const queryText = "query_sql_text"
+	const executions = "executions"
+	const cpuTimeUs = "cpu_time_us"
+	const durationUs = "duration_us"
+	const logicalReads = "logical_reads"
+
+	rows, err := s.client.QueryRows(ctx)
+
+	if err != nil {
+		if errors.Is(err, sqlquery.ErrNullValueWarning) {
+			s.logger.Warn("problems encountered getting metric rows", zap.Error(err))
+		} else {
+			return fmt.Errorf("sqlServerScraperHelper failed getting metric rows: %w", err)
+		}
+	}
+
+	var errs []error
+	now := pcommon.NewTimestampFromTime(time.Now())
+	for i, row := range rows {
+		if i == 0 {
+			rb.SetSqlserverInstanceName(row[instanceNameKey])
+		}
+
+		database, hash, text := row[databaseName], row[queryHash], normalizeQueryText(row[queryText])
+
+		errs = append(errs, s.mb.RecordSqlserverQueryStoreQueryExecutionsDataPoint(now, row[executions], database, hash, text))
+		errs = append(errs, s.mb.RecordSqlserverQueryStoreQueryLogicalReadsDataPoint(now, row[logicalReads], database, hash, text))
+
+		val, err := strconv.ParseFloat(row[cpuTimeUs], 64)
+		if err != nil {
+			errs = append(errs, fmt.Errorf("row %d: %w", i, err))
+		} else {
+			s.mb.RecordSqlserverQueryStoreQueryCPUTimeDataPoint(now, val/1e6, database, hash, text)
+		}
+
+		val, err = strconv.ParseFloat(row[durationUs], 64)
+		if err != nil {
+			errs = append(errs, fmt.Errorf("row %d: %w", i, err))
+		} else {
+			s.mb.RecordSqlserverQueryStoreQueryDurationDataPoint(now, val/1e6, database, hash, text)
+		}
+	}
+
+	return errors.Join(errs...)
+}
+
+var (
+	queryStringLiteralRegexp  = regexp.MustCompile(`N?'(?:[^']|'')*'`)
+	queryNumericLiteralRegexp = regexp.MustCompile(`\b\d+(?:\.\d+)?(?:[eE][+-]?\d+)?\b`)
+	queryWhitespaceRegexp     = regexp.MustCompile(`\s+`)
+)
+
+// normalizeQueryText replaces the string and numeric literals of the query with placeholders, so that the values
+// used by the queries don't end up in the metric attributes.
+func normalizeQueryText(text string) string {
+	text = queryStringLiteralRegexp.ReplaceAllString(text, "?")
+	text = queryNumericLiteralRegexp.ReplaceAllString(text, "?")
+	return strings.TrimSpace(queryWhitespaceRegexp.ReplaceAllString(text, " "))
+}
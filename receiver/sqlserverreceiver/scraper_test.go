@@ -30,6 +30,16 @@ func enableAllScraperMetrics(cfg *Config) {
 	cfg.MetricsBuilderConfig.Metrics.SqlserverProcessesBlocked.Enabled = true
 
 	cfg.MetricsBuilderConfig.Metrics.SqlserverDatabaseCount.Enabled = true
+
+	cfg.MetricsBuilderConfig.Metrics.SqlserverAvailabilityReplicaDatabaseSynchronizationState.Enabled = true
+	cfg.MetricsBuilderConfig.Metrics.SqlserverAvailabilityReplicaDatabaseLogSendQueueSize.Enabled = true
+	cfg.MetricsBuilderConfig.Metrics.SqlserverAvailabilityReplicaDatabaseRedoQueueSize.Enabled = true
+	cfg.MetricsBuilderConfig.Metrics.SqlserverAvailabilityReplicaDatabaseRedoRate.Enabled = true
+
+	cfg.MetricsBuilderConfig.Metrics.SqlserverQueryStoreQueryExecutions.Enabled = true
+	cfg.MetricsBuilderConfig.Metrics.SqlserverQueryStoreQueryCPUTime.Enabled = true
+	cfg.MetricsBuilderConfig.Metrics.SqlserverQueryStoreQueryDuration.Enabled = true
+	cfg.MetricsBuilderConfig.Metrics.SqlserverQueryStoreQueryLogicalReads.Enabled = true
 }
 
 func TestEmptyScrape(t *testing.T) {
@@ -70,8 +80,9 @@ func TestSuccessfulScrape(t *testing.T) {
 		defer assert.NoError(t, scraper.Shutdown(context.Background()))
 
 		scraper.client = mockClient{
-			instanceName: scraper.instanceName,
-			SQL:          scraper.sqlQuery,
+			instanceName:  scraper.instanceName,
+			topQueryCount: scraper.topQueryCount,
+			SQL:           scraper.sqlQuery,
 		}
 
 		actualMetrics, err := scraper.Scrape(context.Background())
@@ -85,6 +96,10 @@ func TestSuccessfulScrape(t *testing.T) {
 			expectedFile = filepath.Join("testdata", "expectedPerfCounters.yaml")
 		case getSQLServerPropertiesQuery(scraper.instanceName):
 			expectedFile = filepath.Join("testdata", "expectedProperties.yaml")
+		case getSQLServerAvailabilityReplicaQuery(scraper.instanceName):
+			expectedFile = filepath.Join("testdata", "expectedAvailabilityReplica.yaml")
+		case getSQLServerQueryStoreQuery(scraper.instanceName, scraper.topQueryCount):
+			expectedFile = filepath.Join("testdata", "expectedQueryStore.yaml")
 		}
 
 		// Uncomment line below to re-generate expected metrics.
@@ -132,8 +147,9 @@ func TestScrapeInvalidQuery(t *testing.T) {
 var _ sqlquery.DbClient = (*mockClient)(nil)
 
 type mockClient struct {
-	SQL          string
-	instanceName string
+	SQL           string
+	instanceName  string
+	topQueryCount uint
 }
 
 func readFile(fname string) ([]sqlquery.StringMap, error) {
@@ -163,6 +179,10 @@ func (mc mockClient) QueryRows(context.Context, ...any) ([]sqlquery.StringMap, e
 		queryResults, err = readFile("perfCounterQueryData.txt")
 	case getSQLServerPropertiesQuery(mc.instanceName):
 		queryResults, err = readFile("propertyQueryData.txt")
+	case getSQLServerAvailabilityReplicaQuery(mc.instanceName):
+		queryResults, err = readFile("availabilityReplicaQueryData.txt")
+	case getSQLServerQueryStoreQuery(mc.instanceName, mc.topQueryCount):
+		queryResults, err = readFile("queryStoreQueryData.txt")
 	default:
 		return nil, fmt.Errorf("No valid query found")
 	}
@@ -172,3 +192,13 @@ func (mc mockClient) QueryRows(context.Context, ...any) ([]sqlquery.StringMap, e
 	}
 	return queryResults, nil
 }
+
+func TestNormalizeQueryText(t *testing.T) {
+	assert.Equal(t,
+		"SELECT * FROM [dbo].[orders] WHERE [customer_id] = ? AND [status] = ?",
+		normalizeQueryText("SELECT * FROM [dbo].[orders] WHERE [customer_id] = 42 AND [status] = N'shipped'"))
+	assert.Equal(t,
+		"UPDATE [dbo].[stock] SET [quantity] = [quantity] - ? WHERE [sku] = ? AND [name] = ?",
+		normalizeQueryText("UPDATE [dbo].[stock]\n\tSET [quantity] = [quantity] - 1 WHERE [sku] = 'A-100' AND [name] = 'O''Neil'"))
+	assert.Equal(t, "SELECT [col1] FROM [t2]", normalizeQueryText("SELECT [col1] FROM [t2]"))
+}
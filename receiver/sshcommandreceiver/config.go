@@ -0,0 +1,194 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package sshcommandreceiver // import "github.com/open-telemetry/opentelemetry-collector-contrib/receiver/sshcommandreceiver"
+
+import (
+	"errors"
+	"fmt"
+	"net"
+	"regexp"
+	"slices"
+
+	"go.opentelemetry.io/collector/component"
+	"go.opentelemetry.io/collector/config/configopaque"
+	"go.opentelemetry.io/collector/receiver/scraperhelper"
+)
+
+const (
+	signalMetrics = "metrics"
+	signalLogs    = "logs"
+
+	parserNone  = "none"
+	parserRegex = "regex"
+	parserJSON  = "json"
+
+	metricTypeGauge = "gauge"
+	metricTypeSum   = "sum"
+)
+
+// Config defines configuration for the SSH command receiver.
+type Config struct {
+	// ControllerConfig holds the interval the commands are executed at, and the timeout of each command.
+	scraperhelper.ControllerConfig `mapstructure:",squash"`
+
+	// Endpoint is the host:port of the SSH server.
+	Endpoint string `mapstructure:"endpoint"`
+	// Username authenticates the receiver to the SSH server, with either the Password or the private key of KeyFile.
+	Username string              `mapstructure:"username"`
+	Password configopaque.String `mapstructure:"password"`
+	// KeyFile is the path of the private key, the Password being its passphrase if set.
+	KeyFile string `mapstructure:"key_file"`
+	// KnownHosts is the path of the known_hosts file checking the host key of the server, ~/.ssh/known_hosts and
+	// /etc/ssh/known_hosts when not set.
+	KnownHosts string `mapstructure:"known_hosts"`
+	// IgnoreHostKey skips the check of the host key, which is insecure.
+	IgnoreHostKey bool `mapstructure:"ignore_host_key"`
+
+	// Commands are the commands executed on the host, and how their output is converted.
+	Commands []CommandConfig `mapstructure:"commands"`
+}
+
+// CommandConfig defines a command executed on the host, and how its output is converted to metrics or logs.
+type CommandConfig struct {
+	// Name identifies the command in the telemetry, the command itself or the path of the script when not set.
+	Name string `mapstructure:"name"`
+	// Command is the command line executed on the host.
+	Command string `mapstructure:"command"`
+	// Script is the path of a local script, sent to the Interpreter on the host through its standard input.
+	Script string `mapstructure:"script"`
+	// Interpreter is the command line executing the Script on the host, sh -s by default.
+	Interpreter string `mapstructure:"interpreter"`
+	// Signal is the type of telemetry converted from the output, metrics or logs.
+	Signal string `mapstructure:"signal"`
+
+	// Parser is how the standard output is parsed: none (default) for a record per line holding the line in its body,
+	// regex for a record per line matching the Regex, or json for a record per JSON object.
+	Parser string `mapstructure:"parser"`
+	// Regex is matched against each line of the output, its named capture groups being the fields of the records.
+	Regex string `mapstructure:"regex"`
+
+	// Attributes are the attributes of the data points and log records, set from the given fields of the records.
+	Attributes map[string]string `mapstructure:"attributes"`
+	// Metrics are the metrics converted from the fields of the records.
+	Metrics []MetricConfig `mapstructure:"metrics"`
+	// Logs is how the records are converted to log records.
+	Logs LogConfig `mapstructure:"logs"`
+}
+
+// MetricConfig defines a metric converted from a field of the records.
+type MetricConfig struct {
+	// Name of the metric.
+	Name string `mapstructure:"name"`
+	// Field holding the value of the metric, a number or a boolean.
+	Field string `mapstructure:"field"`
+	// Type of the metric, gauge (default) or sum.
+	Type string `mapstructure:"type"`
+	// Monotonic tells whether the sum only increases.
+	Monotonic bool `mapstructure:"monotonic"`
+	// Unit of the metric.
+	Unit string `mapstructure:"unit"`
+	// Description of the metric.
+	Description string `mapstructure:"description"`
+}
+
+// LogConfig defines how the records are converted to log records.
+type LogConfig struct {
+	// Body is the field holding the body of the log record, the line or the JSON object of the record when not set.
+	Body string `mapstructure:"body"`
+	// Severity is the field holding the severity text of the log record.
+	Severity string `mapstructure:"severity"`
+}
+
+var _ component.Config = (*Config)(nil)
+
+// Validate checks if the receiver configuration is valid.
+func (cfg *Config) Validate() error {
+	if cfg.Endpoint == "" {
+		return errors.New("endpoint must be specified")
+	}
+	if _, _, err := net.SplitHostPort(cfg.Endpoint); err != nil {
+		return fmt.Errorf("invalid endpoint: %w", err)
+	}
+	if cfg.Username == "" {
+		return errors.New("username must be specified")
+	}
+	if cfg.Password == "" && cfg.KeyFile == "" {
+		return errors.New("either password or key_file must be specified")
+	}
+	if len(cfg.Commands) == 0 {
+		return errors.New("at least one command must be specified")
+	}
+	for i, cmd := range cfg.Commands {
+		if err := cmd.validate(); err != nil {
+			return fmt.Errorf("commands[%d]: %w", i, err)
+		}
+	}
+	return nil
+}
+
+func (cmd *CommandConfig) validate() error {
+	if (cmd.Command == "") == (cmd.Script == "") {
+		return errors.New("either command or script must be specified")
+	}
+	if cmd.Interpreter != "" && cmd.Script == "" {
+		return errors.New("interpreter can only be specified with a script")
+	}
+	if cmd.Signal != signalMetrics && cmd.Signal != signalLogs {
+		return fmt.Errorf("unsupported signal %q", cmd.Signal)
+	}
+	switch cmd.Parser {
+	case "", parserNone, parserJSON:
+		if cmd.Regex != "" {
+			return errors.New("regex can only be specified with the regex parser")
+		}
+	case parserRegex:
+		re, err := regexp.Compile(cmd.Regex)
+		if err != nil {
+			return fmt.Errorf("invalid regex: %w", err)
+		}
+		if !slices.ContainsFunc(re.SubexpNames(), func(name string) bool { return name != "" }) {
+			return errors.New("the regex must have named capture groups")
+		}
+	default:
+		return fmt.Errorf("unsupported parser %q", cmd.Parser)
+	}
+
+	if cmd.Signal == signalMetrics {
+		if cmd.parser() == parserNone {
+			return errors.New("the output converted to metrics must be parsed with the regex or json parser")
+		}
+		if len(cmd.Metrics) == 0 {
+			return errors.New("at least one metric must be specified")
+		}
+	}
+	for _, m := range cmd.Metrics {
+		if m.Name == "" || m.Field == "" {
+			return errors.New("the name and field of the metrics must be specified")
+		}
+		if m.Type != "" && m.Type != metricTypeGauge && m.Type != metricTypeSum {
+			return fmt.Errorf("unsupported type %q of the metric %q", m.Type, m.Name)
+		}
+	}
+	return nil
+}
+
+// parser returns how the output is parsed, none when not set.
+func (cmd *CommandConfig) parser() string {
+	if cmd.Parser == "" {
+		return parserNone
+	}
+	return cmd.Parser
+}
+
+// name returns the name identifying the command, the command itself or the path of the script when not set.
+func (cmd *CommandConfig) name() string {
+	switch {
+	case cmd.Name != "":
+		return cmd.Name
+	case cmd.Command != "":
+		return cmd.Command
+	default:
+		return cmd.Script
+	}
+}
@@ -0,0 +1,142 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package sshcommandreceiver
+
+import (
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/collector/component"
+	"go.opentelemetry.io/collector/confmap/confmaptest"
+	"go.opentelemetry.io/collector/receiver/scraperhelper"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/receiver/sshcommandreceiver/internal/metadata"
+)
+
+func TestLoadConfig(t *testing.T) {
+	t.Parallel()
+
+	cm, err := confmaptest.LoadConf(filepath.Join("testdata", "config.yaml"))
+	require.NoError(t, err)
+
+	defaultCfg := createDefaultConfig().(*Config)
+	defaultCfg.Endpoint = "router:22"
+	defaultCfg.Username = "otel"
+	defaultCfg.Password = "secret"
+	defaultCfg.Commands = []CommandConfig{{Command: "uptime", Signal: signalLogs}}
+
+	tests := []struct {
+		id           component.ID
+		expected     component.Config
+		errorMessage string
+	}{
+		{
+			id:       component.NewIDWithName(metadata.Type, ""),
+			expected: defaultCfg,
+		},
+		{
+			id: component.NewIDWithName(metadata.Type, "all_settings"),
+			expected: &Config{
+				ControllerConfig: scraperhelper.ControllerConfig{
+					CollectionInterval: 30 * time.Second,
+					InitialDelay:       time.Second,
+					Timeout:            5 * time.Second,
+				},
+				Endpoint:   "10.0.0.1:2222",
+				Username:   "otel",
+				KeyFile:    "/etc/otel/id_ed25519",
+				KnownHosts: "/etc/otel/known_hosts",
+				Commands: []CommandConfig{
+					{
+						Name:       "disk",
+						Command:    "df -P",
+						Signal:     signalMetrics,
+						Parser:     parserRegex,
+						Regex:      `^(?P<filesystem>\S+)\s+\d+\s+(?P<used>\d+)\s+(?P<available>\d+)`,
+						Attributes: map[string]string{"filesystem": "filesystem"},
+						Metrics: []MetricConfig{
+							{Name: "disk.used", Field: "used", Unit: "KiBy"},
+							{Name: "disk.available", Field: "available", Unit: "KiBy"},
+						},
+					},
+					{
+						Name:        "interfaces",
+						Script:      "/etc/otel/interfaces.sh",
+						Interpreter: "bash -s",
+						Signal:      signalMetrics,
+						Parser:      parserJSON,
+						Attributes:  map[string]string{"interface": "name"},
+						Metrics: []MetricConfig{
+							{Name: "interface.rx", Field: "stats.rx_bytes", Type: metricTypeSum, Monotonic: true, Unit: "By", Description: "Bytes received by the interface."},
+						},
+					},
+					{
+						Command: "tail -n 100 /var/log/messages",
+						Signal:  signalLogs,
+						Parser:  parserRegex,
+						Regex:   `^(?P<time>\S+ +\S+ \S+) (?P<host>\S+) (?P<message>.*)$`,
+						Logs:    LogConfig{Body: "message"},
+					},
+				},
+			},
+		},
+		{
+			id:           component.NewIDWithName(metadata.Type, "no_endpoint"),
+			errorMessage: "endpoint must be specified",
+		},
+		{
+			id:           component.NewIDWithName(metadata.Type, "no_credentials"),
+			errorMessage: "either password or key_file must be specified",
+		},
+		{
+			id:           component.NewIDWithName(metadata.Type, "no_command"),
+			errorMessage: "at least one command must be specified",
+		},
+		{
+			id:           component.NewIDWithName(metadata.Type, "command_and_script"),
+			errorMessage: "commands[0]: either command or script must be specified",
+		},
+		{
+			id:           component.NewIDWithName(metadata.Type, "bad_signal"),
+			errorMessage: `commands[0]: unsupported signal "traces"`,
+		},
+		{
+			id:           component.NewIDWithName(metadata.Type, "bad_parser"),
+			errorMessage: `commands[0]: unsupported parser "csv"`,
+		},
+		{
+			id:           component.NewIDWithName(metadata.Type, "regex_without_group"),
+			errorMessage: "commands[0]: the regex must have named capture groups",
+		},
+		{
+			id:           component.NewIDWithName(metadata.Type, "unparsed_metrics"),
+			errorMessage: "commands[0]: the output converted to metrics must be parsed with the regex or json parser",
+		},
+		{
+			id:           component.NewIDWithName(metadata.Type, "bad_metric_type"),
+			errorMessage: `commands[0]: unsupported type "histogram" of the metric "load"`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.id.String(), func(t *testing.T) {
+			factory := NewFactory()
+			cfg := factory.CreateDefaultConfig()
+
+			sub, err := cm.Sub(tt.id.String())
+			require.NoError(t, err)
+			require.NoError(t, sub.Unmarshal(cfg))
+
+			if tt.errorMessage != "" {
+				assert.EqualError(t, component.ValidateConfig(cfg), tt.errorMessage)
+				return
+			}
+			assert.NoError(t, component.ValidateConfig(cfg))
+			assert.Equal(t, tt.expected, cfg)
+		})
+	}
+}
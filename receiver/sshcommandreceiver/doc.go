@@ -0,0 +1,7 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+//go:generate mdatagen metadata.yaml
+
+// Package sshcommandreceiver executes commands on remote hosts over SSH, and converts their output to metrics or logs.
+package sshcommandreceiver // import "github.com/open-telemetry/opentelemetry-collector-contrib/receiver/sshcommandreceiver"
@@ -0,0 +1,81 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package sshcommandreceiver // import "github.com/open-telemetry/opentelemetry-collector-contrib/receiver/sshcommandreceiver"
+
+import (
+	"context"
+	"time"
+
+	"go.opentelemetry.io/collector/component"
+	"go.opentelemetry.io/collector/consumer"
+	"go.opentelemetry.io/collector/receiver"
+	"go.opentelemetry.io/collector/receiver/scraperhelper"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/internal/sharedcomponent"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/receiver/sshcommandreceiver/internal/metadata"
+)
+
+const defaultTimeout = 10 * time.Second
+
+// receivers holds the receivers shared by the metrics and logs pipelines, for them to use a single connection.
+var receivers = sharedcomponent.NewSharedComponents()
+
+// NewFactory creates a factory for the SSH command receiver.
+func NewFactory() receiver.Factory {
+	return receiver.NewFactory(
+		metadata.Type,
+		createDefaultConfig,
+		receiver.WithMetrics(createMetricsReceiver, metadata.MetricsStability),
+		receiver.WithLogs(createLogsReceiver, metadata.LogsStability),
+	)
+}
+
+func createDefaultConfig() component.Config {
+	cfg := scraperhelper.NewDefaultControllerConfig()
+	cfg.Timeout = defaultTimeout
+	return &Config{
+		ControllerConfig: cfg,
+	}
+}
+
+func createMetricsReceiver(
+	_ context.Context,
+	set receiver.CreateSettings,
+	cfg component.Config,
+	nextConsumer consumer.Metrics,
+) (receiver.Metrics, error) {
+	r, err := getOrAddReceiver(cfg.(*Config), set)
+	if err != nil {
+		return nil, err
+	}
+	r.Unwrap().(*sshCommandReceiver).nextMetrics = nextConsumer
+	return r, nil
+}
+
+func createLogsReceiver(
+	_ context.Context,
+	set receiver.CreateSettings,
+	cfg component.Config,
+	nextConsumer consumer.Logs,
+) (receiver.Logs, error) {
+	r, err := getOrAddReceiver(cfg.(*Config), set)
+	if err != nil {
+		return nil, err
+	}
+	r.Unwrap().(*sshCommandReceiver).nextLogs = nextConsumer
+	return r, nil
+}
+
+func getOrAddReceiver(cfg *Config, set receiver.CreateSettings) (*sharedcomponent.SharedComponent, error) {
+	var err error
+	r := receivers.GetOrAdd(cfg, func() component.Component {
+		var rcvr *sshCommandReceiver
+		rcvr, err = newReceiver(cfg, set)
+		return rcvr
+	})
+	if err != nil {
+		return nil, err
+	}
+	return r, nil
+}
@@ -0,0 +1,262 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package sshcommandreceiver // import "github.com/open-telemetry/opentelemetry-collector-contrib/receiver/sshcommandreceiver"
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"go.opentelemetry.io/collector/pdata/pcommon"
+	"go.opentelemetry.io/collector/pdata/plog"
+	"go.opentelemetry.io/collector/pdata/pmetric"
+)
+
+// commandAttribute is the attribute of the data points and log records holding the name of the command.
+const commandAttribute = "ssh.command.name"
+
+// record is a line or a JSON object of the output of a command.
+type record struct {
+	// raw is the line or the JSON object.
+	raw any
+	// fields are the named capture groups of the regex matching the line, or the JSON object.
+	fields map[string]any
+}
+
+// outputParser splits the output of a command into records.
+type outputParser struct {
+	parser string
+	regex  *regexp.Regexp
+}
+
+func newOutputParser(cmd *CommandConfig) *outputParser {
+	p := &outputParser{parser: cmd.parser()}
+	if p.parser == parserRegex {
+		// the regex was validated with the config
+		p.regex = regexp.MustCompile(cmd.Regex)
+	}
+	return p
+}
+
+func (p *outputParser) parse(output []byte) ([]record, error) {
+	if p.parser == parserJSON {
+		return parseJSONRecords(output)
+	}
+
+	var records []record
+	scanner := bufio.NewScanner(bytes.NewReader(output))
+	scanner.Buffer(nil, maxOutputSize)
+	for scanner.Scan() {
+		line := strings.TrimRight(scanner.Text(), "\r")
+		if p.parser == parserNone {
+			if line != "" {
+				records = append(records, record{raw: line})
+			}
+			continue
+		}
+		// the lines not matching the regex, e.g. headers, are skipped
+		match := p.regex.FindStringSubmatch(line)
+		if match == nil {
+			continue
+		}
+		fields := make(map[string]any, len(match))
+		for i, name := range p.regex.SubexpNames() {
+			if name != "" {
+				fields[name] = match[i]
+			}
+		}
+		records = append(records, record{raw: line, fields: fields})
+	}
+	return records, scanner.Err()
+}
+
+// parseJSONRecords parses the JSON objects of the output, either separated by whitespaces, e.g. one per line, or held
+// by JSON arrays.
+func parseJSONRecords(output []byte) ([]record, error) {
+	decoder := json.NewDecoder(bytes.NewReader(output))
+	// keeping the integers as int64, rather than converting them to float64
+	decoder.UseNumber()
+	var records []record
+	for {
+		var raw any
+		err := decoder.Decode(&raw)
+		if errors.Is(err, io.EOF) {
+			return records, nil
+		}
+		if err != nil {
+			return nil, fmt.Errorf("failed to parse the JSON output: %w", err)
+		}
+
+		switch v := raw.(type) {
+		case map[string]any:
+			obj := normalizeNumbers(v).(map[string]any)
+			records = append(records, record{raw: obj, fields: obj})
+		case []any:
+			for _, item := range v {
+				obj, ok := item.(map[string]any)
+				if !ok {
+					return nil, errors.New("the JSON arrays must hold objects")
+				}
+				obj = normalizeNumbers(obj).(map[string]any)
+				records = append(records, record{raw: obj, fields: obj})
+			}
+		default:
+			return nil, errors.New("the JSON output must hold objects or arrays of objects")
+		}
+	}
+}
+
+// normalizeNumbers converts the JSON numbers to int64 or float64, the types supported by pcommon.Value.
+func normalizeNumbers(v any) any {
+	switch t := v.(type) {
+	case json.Number:
+		if i, err := t.Int64(); err == nil {
+			return i
+		}
+		f, _ := t.Float64()
+		return f
+	case map[string]any:
+		for k, item := range t {
+			t[k] = normalizeNumbers(item)
+		}
+	case []any:
+		for i, item := range t {
+			t[i] = normalizeNumbers(item)
+		}
+	}
+	return v
+}
+
+// lookupField returns the value of a field of the record, the fields of nested JSON objects being separated by dots,
+// e.g. disk.used.
+func (r record) lookupField(field string) (any, bool) {
+	if v, ok := r.fields[field]; ok {
+		return v, true
+	}
+	var current any = r.fields
+	for _, name := range strings.Split(field, ".") {
+		m, ok := current.(map[string]any)
+		if !ok {
+			return nil, false
+		}
+		if current, ok = m[name]; !ok {
+			return nil, false
+		}
+	}
+	return current, true
+}
+
+// appendMetrics converts the records of the output of the command to metrics.
+func (cmd *CommandConfig) appendMetrics(records []record, metrics pmetric.MetricSlice, now pcommon.Timestamp) error {
+	for _, mc := range cmd.Metrics {
+		var dps pmetric.NumberDataPointSlice
+		appended := false
+		for _, r := range records {
+			value, ok := r.lookupField(mc.Field)
+			if !ok {
+				continue
+			}
+			// the metric is only appended when at least one record holds its field
+			if !appended {
+				dps = appendMetric(metrics, mc)
+				appended = true
+			}
+			dp := dps.AppendEmpty()
+			if err := setDataPointValue(dp, value); err != nil {
+				return fmt.Errorf("invalid value of the metric %q: %w", mc.Name, err)
+			}
+			dp.SetTimestamp(now)
+			cmd.putAttributes(r, dp.Attributes())
+		}
+	}
+	return nil
+}
+
+// appendLogs converts the records of the output of the command to log records.
+func (cmd *CommandConfig) appendLogs(records []record, logs plog.LogRecordSlice, now pcommon.Timestamp) error {
+	for _, r := range records {
+		lr := logs.AppendEmpty()
+		lr.SetObservedTimestamp(now)
+		body := r.raw
+		if cmd.Logs.Body != "" {
+			var ok bool
+			if body, ok = r.lookupField(cmd.Logs.Body); !ok {
+				body = nil
+			}
+		}
+		if body != nil {
+			if err := lr.Body().FromRaw(body); err != nil {
+				return err
+			}
+		}
+		if cmd.Logs.Severity != "" {
+			if severity, ok := r.lookupField(cmd.Logs.Severity); ok {
+				lr.SetSeverityText(fmt.Sprint(severity))
+			}
+		}
+		cmd.putAttributes(r, lr.Attributes())
+	}
+	return nil
+}
+
+func appendMetric(metrics pmetric.MetricSlice, mc MetricConfig) pmetric.NumberDataPointSlice {
+	m := metrics.AppendEmpty()
+	m.SetName(mc.Name)
+	m.SetUnit(mc.Unit)
+	m.SetDescription(mc.Description)
+	if mc.Type != metricTypeSum {
+		return m.SetEmptyGauge().DataPoints()
+	}
+	sum := m.SetEmptySum()
+	// each execution reads the current value of the sum, e.g. a counter of the host since it booted
+	sum.SetAggregationTemporality(pmetric.AggregationTemporalityCumulative)
+	sum.SetIsMonotonic(mc.Monotonic)
+	return sum.DataPoints()
+}
+
+// setDataPointValue sets the value of the data point from a JSON number or boolean, or from the number or boolean
+// captured by a regex.
+func setDataPointValue(dp pmetric.NumberDataPoint, value any) error {
+	switch v := value.(type) {
+	case int64:
+		dp.SetIntValue(v)
+	case float64:
+		dp.SetDoubleValue(v)
+	case bool:
+		if v {
+			dp.SetIntValue(1)
+		} else {
+			dp.SetIntValue(0)
+		}
+	case string:
+		if i, err := strconv.ParseInt(v, 10, 64); err == nil {
+			dp.SetIntValue(i)
+		} else if f, err := strconv.ParseFloat(v, 64); err == nil {
+			dp.SetDoubleValue(f)
+		} else if b, err := strconv.ParseBool(v); err == nil {
+			return setDataPointValue(dp, b)
+		} else {
+			return fmt.Errorf("expected a number or a boolean, got %q", v)
+		}
+	default:
+		return fmt.Errorf("expected a number or a boolean, got %v", value)
+	}
+	return nil
+}
+
+func (cmd *CommandConfig) putAttributes(r record, attrs pcommon.Map) {
+	attrs.PutStr(commandAttribute, cmd.name())
+	for name, field := range cmd.Attributes {
+		if value, ok := r.lookupField(field); ok {
+			// the values which can't be converted, e.g. nested arrays of unsupported types, are skipped
+			_ = attrs.PutEmpty(name).FromRaw(value)
+		}
+	}
+}
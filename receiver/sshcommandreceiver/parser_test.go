@@ -0,0 +1,196 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package sshcommandreceiver
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/collector/pdata/pcommon"
+	"go.opentelemetry.io/collector/pdata/plog"
+	"go.opentelemetry.io/collector/pdata/pmetric"
+)
+
+const dfOutput = `Filesystem     1024-blocks    Used Available Capacity Mounted on
+/dev/sda1         20509264 8015308  11428940      42% /
+tmpfs               817812       0    817812       0% /dev/shm
+`
+
+func TestParseNone(t *testing.T) {
+	p := newOutputParser(&CommandConfig{})
+	records, err := p.parse([]byte("first line\r\n\nsecond line"))
+	require.NoError(t, err)
+	require.Len(t, records, 2)
+	assert.Equal(t, "first line", records[0].raw)
+	assert.Equal(t, "second line", records[1].raw)
+	assert.Nil(t, records[0].fields)
+}
+
+func TestParseRegex(t *testing.T) {
+	p := newOutputParser(&CommandConfig{
+		Parser: parserRegex,
+		Regex:  `^(?P<filesystem>/\S+|tmpfs)\s+\d+\s+(?P<used>\d+)\s+(?P<available>\d+)`,
+	})
+	records, err := p.parse([]byte(dfOutput))
+	require.NoError(t, err)
+	// the header doesn't match the regex
+	require.Len(t, records, 2)
+	assert.Equal(t, map[string]any{"filesystem": "/dev/sda1", "used": "8015308", "available": "11428940"}, records[0].fields)
+	assert.Equal(t, "tmpfs               817812       0    817812       0% /dev/shm", records[1].raw)
+}
+
+func TestParseJSON(t *testing.T) {
+	p := newOutputParser(&CommandConfig{Parser: parserJSON})
+
+	records, err := p.parse([]byte(`{"name": "eth0", "stats": {"rx_bytes": 1024}}
+{"name": "eth1", "stats": {"rx_bytes": 2.5}}
+[{"name": "eth2"}, {"name": "eth3"}]`))
+	require.NoError(t, err)
+	require.Len(t, records, 4)
+	assert.Equal(t, map[string]any{"name": "eth0", "stats": map[string]any{"rx_bytes": int64(1024)}}, records[0].fields)
+	value, ok := records[1].lookupField("stats.rx_bytes")
+	assert.True(t, ok)
+	assert.Equal(t, 2.5, value)
+	assert.Equal(t, map[string]any{"name": "eth3"}, records[3].raw)
+
+	_, err = p.parse([]byte(`{"name": "eth0"`))
+	assert.ErrorContains(t, err, "failed to parse the JSON output")
+	_, err = p.parse([]byte(`42`))
+	assert.EqualError(t, err, "the JSON output must hold objects or arrays of objects")
+	_, err = p.parse([]byte(`[1, 2]`))
+	assert.EqualError(t, err, "the JSON arrays must hold objects")
+}
+
+func TestLookupField(t *testing.T) {
+	r := record{fields: map[string]any{
+		"a.b": "flat",
+		"c":   map[string]any{"d": int64(1)},
+	}}
+	value, ok := r.lookupField("a.b")
+	assert.True(t, ok)
+	assert.Equal(t, "flat", value)
+	value, ok = r.lookupField("c.d")
+	assert.True(t, ok)
+	assert.Equal(t, int64(1), value)
+	_, ok = r.lookupField("c.e")
+	assert.False(t, ok)
+	_, ok = r.lookupField("c.d.e")
+	assert.False(t, ok)
+	_, ok = record{raw: "line"}.lookupField("line")
+	assert.False(t, ok)
+}
+
+func TestAppendMetrics(t *testing.T) {
+	cmd := &CommandConfig{
+		Name:       "disk",
+		Command:    "df -P",
+		Signal:     signalMetrics,
+		Parser:     parserRegex,
+		Regex:      `^(?P<filesystem>/\S+|tmpfs)\s+\d+\s+(?P<used>\d+)\s+(?P<available>\d+)`,
+		Attributes: map[string]string{"filesystem": "filesystem"},
+		Metrics: []MetricConfig{
+			{Name: "disk.used", Field: "used", Unit: "KiBy"},
+			{Name: "disk.writes", Field: "writes", Type: metricTypeSum, Monotonic: true},
+		},
+	}
+	records, err := newOutputParser(cmd).parse([]byte(dfOutput))
+	require.NoError(t, err)
+
+	now := pcommon.Timestamp(1e9)
+	metrics := pmetric.NewMetricSlice()
+	require.NoError(t, cmd.appendMetrics(records, metrics, now))
+	// the metric without field in the records isn't appended
+	require.Equal(t, 1, metrics.Len())
+	m := metrics.At(0)
+	assert.Equal(t, "disk.used", m.Name())
+	assert.Equal(t, "KiBy", m.Unit())
+	require.Equal(t, 2, m.Gauge().DataPoints().Len())
+	dp := m.Gauge().DataPoints().At(0)
+	assert.Equal(t, int64(8015308), dp.IntValue())
+	assert.Equal(t, now, dp.Timestamp())
+	assert.Equal(t, map[string]any{commandAttribute: "disk", "filesystem": "/dev/sda1"}, dp.Attributes().AsRaw())
+
+	cmd.Metrics[0].Field = "filesystem"
+	err = cmd.appendMetrics(records, pmetric.NewMetricSlice(), now)
+	assert.EqualError(t, err, `invalid value of the metric "disk.used": expected a number or a boolean, got "/dev/sda1"`)
+}
+
+func TestAppendSum(t *testing.T) {
+	cmd := &CommandConfig{
+		Command: "interfaces",
+		Parser:  parserJSON,
+		Metrics: []MetricConfig{{Name: "interface.rx", Field: "rx", Type: metricTypeSum, Monotonic: true}},
+	}
+	metrics := pmetric.NewMetricSlice()
+	require.NoError(t, cmd.appendMetrics([]record{{fields: map[string]any{"rx": 1.5}}}, metrics, 0))
+	sum := metrics.At(0).Sum()
+	assert.True(t, sum.IsMonotonic())
+	assert.Equal(t, pmetric.AggregationTemporalityCumulative, sum.AggregationTemporality())
+	assert.Equal(t, 1.5, sum.DataPoints().At(0).DoubleValue())
+	assert.Equal(t, "interfaces", sum.DataPoints().At(0).Attributes().AsRaw()[commandAttribute])
+}
+
+func TestSetDataPointValue(t *testing.T) {
+	tests := []struct {
+		value    any
+		expected any
+	}{
+		{value: int64(3), expected: int64(3)},
+		{value: 0.5, expected: 0.5},
+		{value: true, expected: int64(1)},
+		{value: false, expected: int64(0)},
+		{value: "42", expected: int64(42)},
+		{value: "0.25", expected: 0.25},
+		{value: "true", expected: int64(1)},
+	}
+	for _, tt := range tests {
+		dp := pmetric.NewNumberDataPoint()
+		require.NoError(t, setDataPointValue(dp, tt.value))
+		if dp.ValueType() == pmetric.NumberDataPointValueTypeInt {
+			assert.Equal(t, tt.expected, dp.IntValue())
+		} else {
+			assert.Equal(t, tt.expected, dp.DoubleValue())
+		}
+	}
+	assert.Error(t, setDataPointValue(pmetric.NewNumberDataPoint(), "up"))
+	assert.Error(t, setDataPointValue(pmetric.NewNumberDataPoint(), map[string]any{}))
+}
+
+func TestAppendLogs(t *testing.T) {
+	now := pcommon.Timestamp(1e9)
+
+	cmd := &CommandConfig{Command: "uptime"}
+	logs := plog.NewLogRecordSlice()
+	require.NoError(t, cmd.appendLogs([]record{{raw: "10:00:00 up 1 day"}}, logs, now))
+	require.Equal(t, 1, logs.Len())
+	assert.Equal(t, "10:00:00 up 1 day", logs.At(0).Body().Str())
+	assert.Equal(t, now, logs.At(0).ObservedTimestamp())
+	assert.Equal(t, map[string]any{commandAttribute: "uptime"}, logs.At(0).Attributes().AsRaw())
+
+	cmd = &CommandConfig{
+		Name:       "events",
+		Parser:     parserJSON,
+		Attributes: map[string]string{"host": "host"},
+		Logs:       LogConfig{Body: "message", Severity: "level"},
+	}
+	logs = plog.NewLogRecordSlice()
+	records := []record{
+		{fields: map[string]any{"message": "link down", "level": "warn", "host": "router-1"}},
+		{raw: map[string]any{"level": "info"}, fields: map[string]any{"level": "info"}},
+	}
+	require.NoError(t, cmd.appendLogs(records, logs, now))
+	require.Equal(t, 2, logs.Len())
+	assert.Equal(t, "link down", logs.At(0).Body().Str())
+	assert.Equal(t, "warn", logs.At(0).SeverityText())
+	assert.Equal(t, map[string]any{commandAttribute: "events", "host": "router-1"}, logs.At(0).Attributes().AsRaw())
+	// the record without body field has an empty body
+	assert.Equal(t, pcommon.ValueTypeEmpty, logs.At(1).Body().Type())
+
+	cmd = &CommandConfig{Command: "events", Parser: parserJSON}
+	logs = plog.NewLogRecordSlice()
+	obj := map[string]any{"message": "link down"}
+	require.NoError(t, cmd.appendLogs([]record{{raw: obj, fields: obj}}, logs, now))
+	assert.Equal(t, obj, logs.At(0).Body().Map().AsRaw())
+}
@@ -0,0 +1,242 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package sshcommandreceiver // import "github.com/open-telemetry/opentelemetry-collector-contrib/receiver/sshcommandreceiver"
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"os"
+	"sync"
+	"time"
+
+	"go.opentelemetry.io/collector/component"
+	"go.opentelemetry.io/collector/consumer"
+	"go.opentelemetry.io/collector/pdata/pcommon"
+	"go.opentelemetry.io/collector/pdata/plog"
+	"go.opentelemetry.io/collector/pdata/pmetric"
+	"go.opentelemetry.io/collector/receiver"
+	"go.opentelemetry.io/collector/receiver/receiverhelper"
+	"go.uber.org/zap"
+	"golang.org/x/crypto/ssh"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/receiver/sshcommandreceiver/internal/metadata"
+)
+
+const (
+	transport = "ssh"
+
+	// endpointAttribute is the resource attribute holding the endpoint of the SSH server.
+	endpointAttribute = "ssh.endpoint"
+
+	defaultInterpreter = "sh -s"
+)
+
+// scopeName is the instrumentation scope of the telemetry converted from the output of the commands.
+var scopeName = "otelcol/" + metadata.Type.String() + "receiver"
+
+// sshCommandReceiver executes the commands on the host at each collection interval, and converts their output to
+// metrics or logs. The receiver is shared by the metrics and logs pipelines, with a single connection to the server.
+type sshCommandReceiver struct {
+	cfg      *Config
+	settings receiver.CreateSettings
+	obsrecv  *receiverhelper.ObsReport
+
+	nextMetrics consumer.Metrics
+	nextLogs    consumer.Logs
+
+	clientConfig *ssh.ClientConfig
+	client       *ssh.Client
+	// scripts are the contents of the scripts of the commands, read once started.
+	scripts [][]byte
+	parsers []*outputParser
+
+	cancel context.CancelFunc
+	wg     sync.WaitGroup
+}
+
+func newReceiver(cfg *Config, set receiver.CreateSettings) (*sshCommandReceiver, error) {
+	obsrecv, err := receiverhelper.NewObsReport(receiverhelper.ObsReportSettings{
+		ReceiverID:             set.ID,
+		Transport:              transport,
+		ReceiverCreateSettings: set,
+	})
+	if err != nil {
+		return nil, err
+	}
+	parsers := make([]*outputParser, len(cfg.Commands))
+	for i := range cfg.Commands {
+		parsers[i] = newOutputParser(&cfg.Commands[i])
+	}
+	return &sshCommandReceiver{
+		cfg:      cfg,
+		settings: set,
+		obsrecv:  obsrecv,
+		parsers:  parsers,
+	}, nil
+}
+
+func (r *sshCommandReceiver) Start(_ context.Context, _ component.Host) error {
+	var err error
+	if r.clientConfig, err = r.cfg.sshClientConfig(); err != nil {
+		return err
+	}
+	r.scripts = make([][]byte, len(r.cfg.Commands))
+	for i, cmd := range r.cfg.Commands {
+		if cmd.Script == "" {
+			continue
+		}
+		if r.scripts[i], err = os.ReadFile(cmd.Script); err != nil {
+			return fmt.Errorf("unable to read script: %w", err)
+		}
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	r.cancel = cancel
+	r.wg.Add(1)
+	go r.run(ctx)
+	return nil
+}
+
+func (r *sshCommandReceiver) run(ctx context.Context) {
+	defer r.wg.Done()
+	if r.cfg.InitialDelay > 0 {
+		select {
+		case <-ctx.Done():
+			return
+		case <-time.After(r.cfg.InitialDelay):
+		}
+	}
+
+	ticker := time.NewTicker(r.cfg.CollectionInterval)
+	defer ticker.Stop()
+	for {
+		r.collect(ctx)
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// collect executes the commands, and consumes the telemetry converted from their output. The commands failing are
+// skipped until the next collection.
+func (r *sshCommandReceiver) collect(ctx context.Context) {
+	md := pmetric.NewMetrics()
+	rm := md.ResourceMetrics().AppendEmpty()
+	rm.Resource().Attributes().PutStr(endpointAttribute, r.cfg.Endpoint)
+	sm := rm.ScopeMetrics().AppendEmpty()
+	sm.Scope().SetName(scopeName)
+
+	ld := plog.NewLogs()
+	rl := ld.ResourceLogs().AppendEmpty()
+	rl.Resource().Attributes().PutStr(endpointAttribute, r.cfg.Endpoint)
+	sl := rl.ScopeLogs().AppendEmpty()
+	sl.Scope().SetName(scopeName)
+
+	for i := range r.cfg.Commands {
+		cmd := &r.cfg.Commands[i]
+		if (cmd.Signal == signalMetrics && r.nextMetrics == nil) || (cmd.Signal == signalLogs && r.nextLogs == nil) {
+			continue
+		}
+		if err := r.execute(ctx, i, sm.Metrics(), sl.LogRecords()); err != nil {
+			if ctx.Err() != nil {
+				return
+			}
+			r.settings.Logger.Error("Failed to execute the command", zap.String("command", cmd.name()), zap.Error(err))
+		}
+	}
+
+	if sm.Metrics().Len() > 0 {
+		obsCtx := r.obsrecv.StartMetricsOp(ctx)
+		err := r.nextMetrics.ConsumeMetrics(obsCtx, md)
+		r.obsrecv.EndMetricsOp(obsCtx, transport, md.DataPointCount(), err)
+		if err != nil {
+			r.settings.Logger.Error("Failed to consume the metrics", zap.Error(err))
+		}
+	}
+	if sl.LogRecords().Len() > 0 {
+		obsCtx := r.obsrecv.StartLogsOp(ctx)
+		err := r.nextLogs.ConsumeLogs(obsCtx, ld)
+		r.obsrecv.EndLogsOp(obsCtx, transport, ld.LogRecordCount(), err)
+		if err != nil {
+			r.settings.Logger.Error("Failed to consume the logs", zap.Error(err))
+		}
+	}
+}
+
+// execute executes the i-th command, and appends the metrics or log records converted from its output.
+func (r *sshCommandReceiver) execute(ctx context.Context, i int, metrics pmetric.MetricSlice, logs plog.LogRecordSlice) error {
+	cmd := &r.cfg.Commands[i]
+	if r.cfg.Timeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, r.cfg.Timeout)
+		defer cancel()
+	}
+	if err := r.connect(ctx); err != nil {
+		return err
+	}
+
+	cmdline, stdin := cmd.Command, []byte(nil)
+	if cmd.Script != "" {
+		cmdline, stdin = cmd.Interpreter, r.scripts[i]
+		if cmdline == "" {
+			cmdline = defaultInterpreter
+		}
+	}
+	output, err := run(ctx, r.client, cmdline, stdin)
+	if err != nil {
+		if !keepsConnection(err) {
+			// connecting again on the next execution
+			_ = r.client.Close()
+			r.client = nil
+		}
+		return err
+	}
+	now := pcommon.NewTimestampFromTime(time.Now())
+
+	records, err := r.parsers[i].parse(output)
+	if err != nil {
+		return err
+	}
+	if cmd.Signal == signalMetrics {
+		return cmd.appendMetrics(records, metrics, now)
+	}
+	return cmd.appendLogs(records, logs, now)
+}
+
+// connect connects to the server unless already connected.
+func (r *sshCommandReceiver) connect(ctx context.Context) error {
+	if r.client != nil {
+		return nil
+	}
+	conn, err := (&net.Dialer{}).DialContext(ctx, "tcp", r.cfg.Endpoint)
+	if err != nil {
+		return err
+	}
+	// bounding the handshake by the deadline of the execution
+	if deadline, ok := ctx.Deadline(); ok {
+		_ = conn.SetDeadline(deadline)
+	}
+	c, chans, reqs, err := ssh.NewClientConn(conn, r.cfg.Endpoint, r.clientConfig)
+	if err != nil {
+		_ = conn.Close()
+		return err
+	}
+	_ = conn.SetDeadline(time.Time{})
+	r.client = ssh.NewClient(c, chans, reqs)
+	return nil
+}
+
+func (r *sshCommandReceiver) Shutdown(context.Context) error {
+	if r.cancel != nil {
+		r.cancel()
+		r.wg.Wait()
+	}
+	if r.client != nil {
+		return r.client.Close()
+	}
+	return nil
+}
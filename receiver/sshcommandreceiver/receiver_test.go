@@ -0,0 +1,420 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package sshcommandreceiver
+
+import (
+	"bytes"
+	"context"
+	"crypto/ed25519"
+	"crypto/rand"
+	"encoding/pem"
+	"errors"
+	"io"
+	"net"
+	"os"
+	"path/filepath"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/collector/component/componenttest"
+	"go.opentelemetry.io/collector/consumer/consumertest"
+	"go.opentelemetry.io/collector/pdata/plog"
+	"go.opentelemetry.io/collector/pdata/pmetric"
+	"go.opentelemetry.io/collector/receiver/receivertest"
+	"golang.org/x/crypto/ssh"
+	"golang.org/x/crypto/ssh/knownhosts"
+)
+
+// commandResult is the result of a command executed by the test server.
+type commandResult struct {
+	stdout string
+	stderr string
+	status uint32
+	// hang keeps the command running until the session is closed.
+	hang bool
+}
+
+// execution is a command executed by the test server.
+type execution struct {
+	cmdline string
+	stdin   string
+}
+
+// testServer is an SSH server executing the commands by returning their configured results.
+type testServer struct {
+	listener net.Listener
+	hostKey  ssh.Signer
+	config   *ssh.ServerConfig
+	wg       sync.WaitGroup
+
+	mu          sync.Mutex
+	results     map[string]commandResult
+	executions  []execution
+	connections int
+}
+
+func newTestServer(t *testing.T, results map[string]commandResult, opts ...func(*ssh.ServerConfig)) *testServer {
+	_, key, err := ed25519.GenerateKey(rand.Reader)
+	require.NoError(t, err)
+	hostKey, err := ssh.NewSignerFromKey(key)
+	require.NoError(t, err)
+	listener, err := net.Listen("tcp", "localhost:0")
+	require.NoError(t, err)
+
+	s := &testServer{
+		listener: listener,
+		hostKey:  hostKey,
+		results:  results,
+		config: &ssh.ServerConfig{
+			PasswordCallback: func(c ssh.ConnMetadata, password []byte) (*ssh.Permissions, error) {
+				if c.User() == "otel" && string(password) == "secret" {
+					return nil, nil
+				}
+				return nil, errors.New("invalid credentials")
+			},
+		},
+	}
+	s.config.AddHostKey(hostKey)
+	for _, opt := range opts {
+		opt(s.config)
+	}
+	s.wg.Add(1)
+	go s.serve()
+	t.Cleanup(s.close)
+	return s
+}
+
+func (s *testServer) endpoint() string {
+	return s.listener.Addr().String()
+}
+
+func (s *testServer) serve() {
+	defer s.wg.Done()
+	for {
+		conn, err := s.listener.Accept()
+		if err != nil {
+			return
+		}
+		s.wg.Add(1)
+		go func() {
+			defer s.wg.Done()
+			s.handleConn(conn)
+		}()
+	}
+}
+
+func (s *testServer) handleConn(conn net.Conn) {
+	defer conn.Close()
+	sconn, chans, reqs, err := ssh.NewServerConn(conn, s.config)
+	if err != nil {
+		return
+	}
+	defer sconn.Close()
+	s.mu.Lock()
+	s.connections++
+	s.mu.Unlock()
+	go ssh.DiscardRequests(reqs)
+
+	var wg sync.WaitGroup
+	defer wg.Wait()
+	for newChannel := range chans {
+		if newChannel.ChannelType() != "session" {
+			_ = newChannel.Reject(ssh.UnknownChannelType, "unknown channel type")
+			continue
+		}
+		channel, requests, err := newChannel.Accept()
+		if err != nil {
+			continue
+		}
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			s.handleSession(channel, requests)
+		}()
+	}
+}
+
+func (s *testServer) handleSession(channel ssh.Channel, requests <-chan *ssh.Request) {
+	defer channel.Close()
+	for req := range requests {
+		if req.Type != "exec" {
+			_ = req.Reply(false, nil)
+			continue
+		}
+		var payload struct{ Command string }
+		if err := ssh.Unmarshal(req.Payload, &payload); err != nil {
+			_ = req.Reply(false, nil)
+			continue
+		}
+		_ = req.Reply(true, nil)
+
+		stdin, _ := io.ReadAll(channel)
+		s.mu.Lock()
+		s.executions = append(s.executions, execution{cmdline: payload.Command, stdin: string(stdin)})
+		result, ok := s.results[payload.Command]
+		s.mu.Unlock()
+		if !ok {
+			result = commandResult{stderr: "command not found", status: 127}
+		}
+		if result.hang {
+			// waiting for the client to close the session
+			for range requests {
+			}
+			return
+		}
+		_, _ = io.WriteString(channel, result.stdout)
+		_, _ = io.WriteString(channel.Stderr(), result.stderr)
+		_, _ = channel.SendRequest("exit-status", false, ssh.Marshal(struct{ Status uint32 }{result.status}))
+		return
+	}
+}
+
+func (s *testServer) close() {
+	_ = s.listener.Close()
+	s.wg.Wait()
+}
+
+func (s *testServer) getExecutions() []execution {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return append([]execution(nil), s.executions...)
+}
+
+func (s *testServer) getConnections() int {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.connections
+}
+
+func testConfig(endpoint string, commands ...CommandConfig) *Config {
+	cfg := createDefaultConfig().(*Config)
+	cfg.Endpoint = endpoint
+	cfg.Username = "otel"
+	cfg.Password = "secret"
+	cfg.IgnoreHostKey = true
+	cfg.Commands = commands
+	return cfg
+}
+
+func TestReceiver(t *testing.T) {
+	server := newTestServer(t, map[string]commandResult{
+		"df -P": {stdout: dfOutput},
+		"sh -s": {stdout: `{"level": "warn", "message": "link down"}` + "\n" + `{"level": "info", "message": "link up"}`},
+	})
+	script := filepath.Join(t.TempDir(), "events.sh")
+	require.NoError(t, os.WriteFile(script, []byte("cat /var/log/events.json\n"), 0600))
+
+	cfg := testConfig(server.endpoint(),
+		CommandConfig{
+			Name:       "disk",
+			Command:    "df -P",
+			Signal:     signalMetrics,
+			Parser:     parserRegex,
+			Regex:      `^(?P<filesystem>/\S+|tmpfs)\s+\d+\s+(?P<used>\d+)`,
+			Attributes: map[string]string{"filesystem": "filesystem"},
+			Metrics:    []MetricConfig{{Name: "disk.used", Field: "used", Unit: "KiBy"}},
+		},
+		CommandConfig{
+			Script: script,
+			Signal: signalLogs,
+			Parser: parserJSON,
+			Logs:   LogConfig{Body: "message", Severity: "level"},
+		},
+	)
+	cfg.InitialDelay = 0
+	cfg.CollectionInterval = 10 * time.Millisecond
+
+	metricsSink := new(consumertest.MetricsSink)
+	logsSink := new(consumertest.LogsSink)
+	set := receivertest.NewNopCreateSettings()
+	mr, err := createMetricsReceiver(context.Background(), set, cfg, metricsSink)
+	require.NoError(t, err)
+	lr, err := createLogsReceiver(context.Background(), set, cfg, logsSink)
+	require.NoError(t, err)
+	require.NoError(t, mr.Start(context.Background(), componenttest.NewNopHost()))
+	require.NoError(t, lr.Start(context.Background(), componenttest.NewNopHost()))
+
+	require.Eventually(t, func() bool {
+		return len(metricsSink.AllMetrics()) >= 2 && len(logsSink.AllLogs()) >= 2
+	}, 10*time.Second, 10*time.Millisecond)
+	require.NoError(t, mr.Shutdown(context.Background()))
+	require.NoError(t, lr.Shutdown(context.Background()))
+
+	// the metrics and logs pipelines share a single connection
+	assert.Equal(t, 1, server.getConnections())
+	executions := server.getExecutions()
+	assert.Equal(t, execution{cmdline: "df -P"}, executions[0])
+	assert.Equal(t, execution{cmdline: "sh -s", stdin: "cat /var/log/events.json\n"}, executions[1])
+
+	md := metricsSink.AllMetrics()[0]
+	rm := md.ResourceMetrics().At(0)
+	assert.Equal(t, map[string]any{endpointAttribute: server.endpoint()}, rm.Resource().Attributes().AsRaw())
+	assert.Equal(t, scopeName, rm.ScopeMetrics().At(0).Scope().Name())
+	dps := rm.ScopeMetrics().At(0).Metrics().At(0).Gauge().DataPoints()
+	require.Equal(t, 2, dps.Len())
+	assert.Equal(t, int64(8015308), dps.At(0).IntValue())
+	assert.Equal(t, map[string]any{commandAttribute: "disk", "filesystem": "/dev/sda1"}, dps.At(0).Attributes().AsRaw())
+	assert.Equal(t, int64(0), dps.At(1).IntValue())
+
+	ld := logsSink.AllLogs()[0]
+	rl := ld.ResourceLogs().At(0)
+	assert.Equal(t, map[string]any{endpointAttribute: server.endpoint()}, rl.Resource().Attributes().AsRaw())
+	lrs := rl.ScopeLogs().At(0).LogRecords()
+	require.Equal(t, 2, lrs.Len())
+	assert.Equal(t, "link down", lrs.At(0).Body().Str())
+	assert.Equal(t, "warn", lrs.At(0).SeverityText())
+	assert.Equal(t, map[string]any{commandAttribute: script}, lrs.At(0).Attributes().AsRaw())
+}
+
+func TestReceiverSkipsSignalWithoutPipeline(t *testing.T) {
+	server := newTestServer(t, map[string]commandResult{"uptime": {stdout: "up 1 day"}})
+	cfg := testConfig(server.endpoint(),
+		CommandConfig{Command: "uptime", Signal: signalLogs},
+		CommandConfig{Command: "cat /proc/loadavg", Signal: signalMetrics, Parser: parserJSON, Metrics: []MetricConfig{{Name: "load", Field: "load"}}},
+	)
+	r, err := newReceiver(cfg, receivertest.NewNopCreateSettings())
+	require.NoError(t, err)
+	sink := new(consumertest.LogsSink)
+	r.nextLogs = sink
+	r.clientConfig, err = cfg.sshClientConfig()
+	require.NoError(t, err)
+
+	r.collect(context.Background())
+	require.NoError(t, r.Shutdown(context.Background()))
+	assert.Equal(t, []execution{{cmdline: "uptime"}}, server.getExecutions())
+	require.Len(t, sink.AllLogs(), 1)
+	assert.Equal(t, "up 1 day", sink.AllLogs()[0].ResourceLogs().At(0).ScopeLogs().At(0).LogRecords().At(0).Body().Str())
+}
+
+func TestExecuteErrors(t *testing.T) {
+	server := newTestServer(t, map[string]commandResult{
+		"false":  {stderr: "failed\n", status: 1},
+		"sleep":  {hang: true},
+		"uptime": {stdout: "up 1 day"},
+		"json":   {stdout: "not json"},
+	})
+	cfg := testConfig(server.endpoint(),
+		CommandConfig{Command: "false", Signal: signalLogs},
+		CommandConfig{Command: "sleep", Signal: signalLogs},
+		CommandConfig{Command: "uptime", Signal: signalLogs},
+		CommandConfig{Command: "json", Signal: signalLogs, Parser: parserJSON},
+	)
+	cfg.Timeout = 200 * time.Millisecond
+	r, err := newReceiver(cfg, receivertest.NewNopCreateSettings())
+	require.NoError(t, err)
+	r.clientConfig, err = cfg.sshClientConfig()
+	require.NoError(t, err)
+	defer func() { require.NoError(t, r.Shutdown(context.Background())) }()
+
+	metrics := pmetric.NewMetricSlice()
+	logs := plog.NewLogRecordSlice()
+
+	// the command failing keeps the connection
+	err = r.execute(context.Background(), 0, metrics, logs)
+	var ee *exitError
+	require.ErrorAs(t, err, &ee)
+	assert.EqualError(t, err, "the command exited with status 1: failed")
+	assert.NotNil(t, r.client)
+
+	// the connection is closed once the command times out
+	err = r.execute(context.Background(), 1, metrics, logs)
+	assert.ErrorIs(t, err, context.DeadlineExceeded)
+	assert.Nil(t, r.client)
+
+	require.NoError(t, r.execute(context.Background(), 2, metrics, logs))
+	assert.Equal(t, 2, server.getConnections())
+	require.Equal(t, 1, logs.Len())
+	assert.Equal(t, "up 1 day", logs.At(0).Body().Str())
+
+	err = r.execute(context.Background(), 3, metrics, logs)
+	assert.ErrorContains(t, err, "failed to parse the JSON output")
+	assert.NotNil(t, r.client)
+}
+
+func TestOutputTooLarge(t *testing.T) {
+	server := newTestServer(t, map[string]commandResult{
+		"yes": {stdout: string(bytes.Repeat([]byte("y\n"), maxOutputSize))},
+	})
+	cfg := testConfig(server.endpoint(), CommandConfig{Command: "yes", Signal: signalLogs})
+	r, err := newReceiver(cfg, receivertest.NewNopCreateSettings())
+	require.NoError(t, err)
+	r.clientConfig, err = cfg.sshClientConfig()
+	require.NoError(t, err)
+	defer func() { require.NoError(t, r.Shutdown(context.Background())) }()
+
+	err = r.execute(context.Background(), 0, pmetric.NewMetricSlice(), plog.NewLogRecordSlice())
+	assert.ErrorIs(t, err, errOutputTooLarge)
+	assert.NotNil(t, r.client)
+}
+
+func TestHostKeyAndPrivateKey(t *testing.T) {
+	publicKey, privateKey, err := ed25519.GenerateKey(rand.Reader)
+	require.NoError(t, err)
+	sshPublicKey, err := ssh.NewPublicKey(publicKey)
+	require.NoError(t, err)
+	server := newTestServer(t, map[string]commandResult{"uptime": {stdout: "up 1 day"}}, func(config *ssh.ServerConfig) {
+		config.PublicKeyCallback = func(_ ssh.ConnMetadata, key ssh.PublicKey) (*ssh.Permissions, error) {
+			if bytes.Equal(key.Marshal(), sshPublicKey.Marshal()) {
+				return nil, nil
+			}
+			return nil, errors.New("unknown key")
+		}
+	})
+
+	dir := t.TempDir()
+	block, err := ssh.MarshalPrivateKey(privateKey, "")
+	require.NoError(t, err)
+	keyFile := filepath.Join(dir, "id_ed25519")
+	require.NoError(t, os.WriteFile(keyFile, pem.EncodeToMemory(block), 0600))
+	knownHosts := filepath.Join(dir, "known_hosts")
+	line := knownhosts.Line([]string{knownhosts.Normalize(server.endpoint())}, server.hostKey.PublicKey())
+	require.NoError(t, os.WriteFile(knownHosts, []byte(line+"\n"), 0600))
+
+	cfg := testConfig(server.endpoint(), CommandConfig{Command: "uptime", Signal: signalLogs})
+	cfg.Password = ""
+	cfg.KeyFile = keyFile
+	cfg.IgnoreHostKey = false
+	cfg.KnownHosts = knownHosts
+	r, err := newReceiver(cfg, receivertest.NewNopCreateSettings())
+	require.NoError(t, err)
+	r.clientConfig, err = cfg.sshClientConfig()
+	require.NoError(t, err)
+	logs := plog.NewLogRecordSlice()
+	require.NoError(t, r.execute(context.Background(), 0, pmetric.NewMetricSlice(), logs))
+	assert.Equal(t, 1, logs.Len())
+	require.NoError(t, r.Shutdown(context.Background()))
+
+	// another host key isn't trusted
+	_, otherKey, err := ed25519.GenerateKey(rand.Reader)
+	require.NoError(t, err)
+	otherSigner, err := ssh.NewSignerFromKey(otherKey)
+	require.NoError(t, err)
+	line = knownhosts.Line([]string{knownhosts.Normalize(server.endpoint())}, otherSigner.PublicKey())
+	require.NoError(t, os.WriteFile(knownHosts, []byte(line+"\n"), 0600))
+	r, err = newReceiver(cfg, receivertest.NewNopCreateSettings())
+	require.NoError(t, err)
+	r.clientConfig, err = cfg.sshClientConfig()
+	require.NoError(t, err)
+	err = r.execute(context.Background(), 0, pmetric.NewMetricSlice(), plog.NewLogRecordSlice())
+	assert.ErrorContains(t, err, "key mismatch")
+	require.NoError(t, r.Shutdown(context.Background()))
+}
+
+func TestStartErrors(t *testing.T) {
+	cfg := testConfig("localhost:22", CommandConfig{Script: filepath.Join(t.TempDir(), "missing.sh"), Signal: signalLogs})
+	r, err := newReceiver(cfg, receivertest.NewNopCreateSettings())
+	require.NoError(t, err)
+	assert.ErrorContains(t, r.Start(context.Background(), componenttest.NewNopHost()), "unable to read script")
+
+	cfg = testConfig("localhost:22", CommandConfig{Command: "uptime", Signal: signalLogs})
+	cfg.Password = ""
+	cfg.KeyFile = filepath.Join(t.TempDir(), "missing")
+	r, err = newReceiver(cfg, receivertest.NewNopCreateSettings())
+	require.NoError(t, err)
+	assert.ErrorContains(t, r.Start(context.Background(), componenttest.NewNopHost()), "unable to read private key")
+	require.NoError(t, r.Shutdown(context.Background()))
+}
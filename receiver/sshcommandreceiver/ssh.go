@@ -0,0 +1,116 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package sshcommandreceiver // import "github.com/open-telemetry/opentelemetry-collector-contrib/receiver/sshcommandreceiver"
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+
+	"golang.org/x/crypto/ssh"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/internal/sshconfig"
+)
+
+const (
+	// maxOutputSize is the maximum size of the standard output of a command, to bound the memory used by the receiver.
+	maxOutputSize = 4 << 20
+	// maxStderrSize is the maximum size of the standard error of a failing command reported with the error.
+	maxStderrSize = 1 << 10
+)
+
+var errOutputTooLarge = fmt.Errorf("the output exceeds %d bytes", maxOutputSize)
+
+// sshClientConfig returns the SSH configuration authenticating the receiver and checking the host key of the server.
+func (cfg *Config) sshClientConfig() (*ssh.ClientConfig, error) {
+	return sshconfig.ClientSettings{
+		Username:      cfg.Username,
+		Password:      string(cfg.Password),
+		KeyFile:       cfg.KeyFile,
+		KnownHosts:    cfg.KnownHosts,
+		IgnoreHostKey: cfg.IgnoreHostKey,
+		Timeout:       cfg.Timeout,
+	}.ToClientConfig()
+}
+
+// exitError is the error of a command exiting with a non-zero status, the connection to the server still being usable.
+type exitError struct {
+	status int
+	stderr string
+}
+
+func (e *exitError) Error() string {
+	if e.stderr == "" {
+		return fmt.Sprintf("the command exited with status %d", e.status)
+	}
+	return fmt.Sprintf("the command exited with status %d: %s", e.status, e.stderr)
+}
+
+// run executes the command line in a new session of the client, with the given standard input, and returns its
+// standard output. The session is closed, ending the command, once the context is done.
+func run(ctx context.Context, client *ssh.Client, cmdline string, stdin []byte) ([]byte, error) {
+	session, err := client.NewSession()
+	if err != nil {
+		return nil, err
+	}
+	defer session.Close()
+
+	stdout := &limitedBuffer{limit: maxOutputSize}
+	stderr := &limitedBuffer{limit: maxStderrSize}
+	session.Stdout = stdout
+	session.Stderr = stderr
+	if stdin != nil {
+		session.Stdin = bytes.NewReader(stdin)
+	}
+
+	done := make(chan error, 1)
+	go func() {
+		done <- session.Run(cmdline)
+	}()
+	select {
+	case err = <-done:
+	case <-ctx.Done():
+		_ = session.Signal(ssh.SIGKILL)
+		_ = session.Close()
+		<-done
+		return nil, ctx.Err()
+	}
+
+	var ee *ssh.ExitError
+	switch {
+	case errors.As(err, &ee):
+		return nil, &exitError{status: ee.ExitStatus(), stderr: string(bytes.TrimSpace(stderr.buf.Bytes()))}
+	case err != nil:
+		return nil, err
+	case stdout.exceeded:
+		return nil, errOutputTooLarge
+	}
+	return stdout.buf.Bytes(), nil
+}
+
+// keepsConnection returns whether the connection to the server is still usable after the error of a command, which
+// only failed on its own.
+func keepsConnection(err error) bool {
+	var ee *exitError
+	return errors.As(err, &ee) || errors.Is(err, errOutputTooLarge)
+}
+
+// limitedBuffer is a buffer of a bounded size, dropping the bytes written beyond its limit. The bytes are still
+// consumed, for the command not to be blocked writing its output.
+type limitedBuffer struct {
+	// buf isn't embedded, for io.Copy not to bypass the limit with bytes.Buffer.ReadFrom.
+	buf      bytes.Buffer
+	limit    int
+	exceeded bool
+}
+
+func (b *limitedBuffer) Write(p []byte) (int, error) {
+	if remaining := b.limit - b.buf.Len(); len(p) > remaining {
+		b.exceeded = true
+		b.buf.Write(p[:remaining])
+		return len(p), nil
+	}
+	return b.buf.Write(p)
+}
@@ -7,6 +7,8 @@ import (
 	"fmt"
 	"time"
 
+	"github.com/lightstep/go-expohisto/mapping/exponent"
+	"github.com/lightstep/go-expohisto/mapping/logarithm"
 	"github.com/lightstep/go-expohisto/structure"
 	"go.opentelemetry.io/collector/config/confignet"
 	"go.uber.org/multierr"
@@ -22,6 +24,7 @@ type Config struct {
 	EnableSimpleTags      bool                             `mapstructure:"enable_simple_tags"`
 	IsMonotonicCounter    bool                             `mapstructure:"is_monotonic_counter"`
 	TimerHistogramMapping []protocol.TimerHistogramMapping `mapstructure:"timer_histogram_mapping"`
+	Flush                 protocol.FlushConfig             `mapstructure:"flush"`
 }
 
 func (c *Config) Validate() error {
@@ -66,6 +69,9 @@ func (c *Config) Validate() error {
 			if eachMap.Histogram.MaxSize != 0 && (eachMap.Histogram.MaxSize < structure.MinSize || eachMap.Histogram.MaxSize > structure.MaximumMaxSize) {
 				errs = multierr.Append(errs, fmt.Errorf("histogram max_size out of range: %v", eachMap.Histogram.MaxSize))
 			}
+			if maxScale := eachMap.Histogram.MaxScale; maxScale != nil && (*maxScale < exponent.MinScale || *maxScale > logarithm.MaxScale) {
+				errs = multierr.Append(errs, fmt.Errorf("histogram max_scale out of range: %v", *maxScale))
+			}
 		} else {
 			// Non-histogram observer w/ histogram config
 			var empty protocol.HistogramConfig
@@ -79,5 +85,31 @@ func (c *Config) Validate() error {
 		errs = multierr.Append(errs, fmt.Errorf("must specify object id for all TimerHistogramMappings"))
 	}
 
+	for _, flush := range []struct {
+		name string
+		cfg  protocol.FlushTypeConfig
+	}{
+		{"counter", c.Flush.Counter},
+		{"timer", c.Flush.Timer},
+	} {
+		switch flush.cfg.Temporality {
+		case "", protocol.DeltaTemporality, protocol.CumulativeTemporality:
+		default:
+			errs = multierr.Append(errs, fmt.Errorf("flush %s temporality is not supported: %s", flush.name, flush.cfg.Temporality))
+		}
+	}
+
+	if c.Flush.Gauge.Temporality != "" {
+		errs = multierr.Append(errs, fmt.Errorf("flush gauge temporality is not supported, gauges have no temporality"))
+	}
+
+	if c.Flush.Timer.Temporality == protocol.CumulativeTemporality {
+		for _, eachMap := range c.TimerHistogramMapping {
+			if eachMap.ObserverType != protocol.HistogramObserver && eachMap.ObserverType != protocol.DisableObserver {
+				errs = multierr.Append(errs, fmt.Errorf("flush timer cumulative temporality requires observer_type: histogram, got %s for %s", eachMap.ObserverType, eachMap.StatsdType))
+			}
+		}
+	}
+
 	return errs
 }
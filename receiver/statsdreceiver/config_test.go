@@ -58,12 +58,39 @@ func TestLoadConfig(t *testing.T) {
 						StatsdType:   "distribution",
 						ObserverType: "histogram",
 						Histogram: protocol.HistogramConfig{
-							MaxSize: 170,
+							MaxSize:  170,
+							MaxScale: func() *int32 { scale := int32(10); return &scale }(),
 						},
 					},
 				},
 			},
 		},
+		{
+			id: component.NewIDWithName(metadata.Type, "flush"),
+			expected: &Config{
+				NetAddr: confignet.AddrConfig{
+					Endpoint:  "localhost:8125",
+					Transport: confignet.TransportTypeUDP,
+				},
+				AggregationInterval: 10 * time.Second,
+				TimerHistogramMapping: []protocol.TimerHistogramMapping{
+					{
+						StatsdType:   "timing",
+						ObserverType: "histogram",
+					},
+				},
+				Flush: protocol.FlushConfig{
+					Counter: protocol.FlushTypeConfig{
+						Temporality:     protocol.CumulativeTemporality,
+						AlignToInterval: true,
+					},
+					Timer: protocol.FlushTypeConfig{
+						Temporality:     protocol.DeltaTemporality,
+						AlignToInterval: true,
+					},
+				},
+			},
+		},
 	}
 
 	for _, tt := range tests {
@@ -94,6 +121,9 @@ func TestValidate(t *testing.T) {
 		statsdTypeNotSupportErr        = "statsd_type is not a supported mapping for histogram and timing metrics: %s"
 		observerTypeNotSupportErr      = "observer_type is not supported for histogram and timing metrics: %s"
 		invalidHistogramErr            = "histogram configuration requires observer_type: histogram"
+		temporalityNotSupportErr       = "flush %s temporality is not supported: %s"
+		gaugeTemporalityErr            = "flush gauge temporality is not supported, gauges have no temporality"
+		cumulativeTimerErr             = "flush timer cumulative temporality requires observer_type: histogram, got %s for %s"
 	)
 
 	tests := []test{
@@ -173,6 +203,39 @@ func TestValidate(t *testing.T) {
 			},
 			expectedErr: negativeAggregationIntervalErr,
 		},
+		{
+			name: "temporalityNotSupport",
+			cfg: &Config{
+				AggregationInterval: 10,
+				Flush: protocol.FlushConfig{
+					Counter: protocol.FlushTypeConfig{Temporality: "unspecified"},
+				},
+			},
+			expectedErr: fmt.Sprintf(temporalityNotSupportErr, "counter", "unspecified"),
+		},
+		{
+			name: "gaugeTemporality",
+			cfg: &Config{
+				AggregationInterval: 10,
+				Flush: protocol.FlushConfig{
+					Gauge: protocol.FlushTypeConfig{Temporality: protocol.CumulativeTemporality},
+				},
+			},
+			expectedErr: gaugeTemporalityErr,
+		},
+		{
+			name: "cumulativeTimerWithSummary",
+			cfg: &Config{
+				AggregationInterval: 10,
+				TimerHistogramMapping: []protocol.TimerHistogramMapping{
+					{StatsdType: "timing", ObserverType: "summary"},
+				},
+				Flush: protocol.FlushConfig{
+					Timer: protocol.FlushTypeConfig{Temporality: protocol.CumulativeTemporality},
+				},
+			},
+			expectedErr: fmt.Sprintf(cumulativeTimerErr, "summary", "timing"),
+		},
 	}
 
 	for _, test := range tests {
@@ -200,6 +263,40 @@ func TestConfig_Validate_MaxSize(t *testing.T) {
 		assert.Contains(t, err.Error(), "histogram max_size out of range")
 	}
 }
+func TestConfig_Validate_MaxScale(t *testing.T) {
+	for _, maxScale := range []int32{-11, 21} {
+		cfg := &Config{
+			AggregationInterval: 20 * time.Second,
+			TimerHistogramMapping: []protocol.TimerHistogramMapping{
+				{
+					StatsdType:   "distribution",
+					ObserverType: "histogram",
+					Histogram: protocol.HistogramConfig{
+						MaxScale: &maxScale,
+					},
+				},
+			},
+		}
+		err := cfg.Validate()
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), "histogram max_scale out of range")
+	}
+	for _, maxScale := range []int32{-10, 0, 20} {
+		cfg := &Config{
+			AggregationInterval: 20 * time.Second,
+			TimerHistogramMapping: []protocol.TimerHistogramMapping{
+				{
+					StatsdType:   "distribution",
+					ObserverType: "histogram",
+					Histogram: protocol.HistogramConfig{
+						MaxScale: &maxScale,
+					},
+				},
+			},
+		}
+		assert.NoError(t, cfg.Validate())
+	}
+}
 func TestConfig_Validate_HistogramGoodConfig(t *testing.T) {
 	for _, maxSize := range []int32{structure.MaximumMaxSize, 0, 2} {
 		cfg := &Config{
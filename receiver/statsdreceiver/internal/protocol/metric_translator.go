@@ -41,8 +41,17 @@ func buildCounterMetric(parsedMetric statsDMetric, isMonotonicCounter bool) pmet
 	return ilm
 }
 
-func setTimestampsForCounterMetric(ilm pmetric.ScopeMetrics, startTime, timeNow time.Time) {
+func setTimestampsForCounterMetric(ilm pmetric.ScopeMetrics, startTime, timeNow time.Time, cumulative bool) {
 	dp := ilm.Metrics().At(0).Sum().DataPoints().At(0)
+	if cumulative {
+		// the start timestamp is kept from the first flush, while the timestamp always
+		// reflects the end of the current interval.
+		if dp.StartTimestamp() == 0 {
+			dp.SetStartTimestamp(pcommon.NewTimestampFromTime(startTime))
+		}
+		dp.SetTimestamp(pcommon.NewTimestampFromTime(timeNow))
+		return
+	}
 	dp.SetStartTimestamp(pcommon.NewTimestampFromTime(startTime))
 
 	if dp.Timestamp() == 0 {
@@ -99,11 +108,11 @@ func buildSummaryMetric(desc statsDMetricDescription, summary summaryMetric, sta
 	}
 }
 
-func buildHistogramMetric(desc statsDMetricDescription, histogram histogramMetric, startTime, timeNow time.Time, ilm pmetric.ScopeMetrics) {
+func buildHistogramMetric(desc statsDMetricDescription, histogram histogramMetric, startTime, timeNow time.Time, temporality pmetric.AggregationTemporality, ilm pmetric.ScopeMetrics) {
 	nm := ilm.Metrics().AppendEmpty()
 	nm.SetName(desc.name)
 	expo := nm.SetEmptyExponentialHistogram()
-	expo.SetAggregationTemporality(pmetric.AggregationTemporalityDelta)
+	expo.SetAggregationTemporality(temporality)
 
 	dp := expo.DataPoints().AppendEmpty()
 	agg := histogram.agg
@@ -123,7 +132,13 @@ func buildHistogramMetric(desc statsDMetricDescription, histogram histogramMetri
 	}
 
 	dp.SetZeroCount(agg.ZeroCount())
-	dp.SetScale(agg.Scale())
+	scale := agg.Scale()
+	var downscale int32
+	if scale > histogram.maxScale {
+		downscale = scale - histogram.maxScale
+		scale = histogram.maxScale
+	}
+	dp.SetScale(scale)
 
 	for _, half := range []struct {
 		inFunc  func() *structure.Buckets
@@ -134,6 +149,10 @@ func buildHistogramMetric(desc statsDMetricDescription, histogram histogramMetri
 	} {
 		in := half.inFunc()
 		out := half.outFunc()
+		if downscale > 0 {
+			downscaleBuckets(in, out, downscale)
+			continue
+		}
 		out.SetOffset(in.Offset())
 
 		out.BucketCounts().EnsureCapacity(int(in.Len()))
@@ -144,6 +163,22 @@ func buildHistogramMetric(desc statsDMetricDescription, histogram histogramMetri
 	}
 }
 
+// downscaleBuckets copies the buckets, merging them to a scale lower by change: each bucket
+// at the lower scale covers 2^change buckets at the original scale.
+func downscaleBuckets(in *structure.Buckets, out pmetric.ExponentialHistogramDataPointBuckets, change int32) {
+	if in.Len() == 0 {
+		return
+	}
+	offset := in.Offset() >> change
+	last := (in.Offset() + int32(in.Len()) - 1) >> change
+	counts := make([]uint64, last-offset+1)
+	for i := uint32(0); i < in.Len(); i++ {
+		counts[((in.Offset()+int32(i))>>change)-offset] += in.At(i)
+	}
+	out.SetOffset(offset)
+	out.BucketCounts().FromRaw(counts)
+}
+
 func (s statsDMetric) counterValue() int64 {
 	x := s.asFloat
 	// Note statds counters are always represented as integers.
@@ -45,7 +45,7 @@ func TestSetTimestampsForCounterMetric(t *testing.T) {
 	parsedMetric := statsDMetric{}
 	isMonotonicCounter := false
 	metric := buildCounterMetric(parsedMetric, isMonotonicCounter)
-	setTimestampsForCounterMetric(metric, lastUpdateInterval, timeNow)
+	setTimestampsForCounterMetric(metric, lastUpdateInterval, timeNow, false)
 
 	expectedMetrics := pmetric.NewScopeMetrics()
 	expectedMetric := expectedMetrics.Metrics().AppendEmpty()
@@ -5,6 +5,7 @@ package protocol // import "github.com/open-telemetry/opentelemetry-collector-co
 
 import (
 	"net"
+	"time"
 
 	"go.opentelemetry.io/collector/client"
 	"go.opentelemetry.io/collector/pdata/pmetric"
@@ -12,8 +13,9 @@ import (
 
 // Parser is something that can map input StatsD strings to OTLP Metric representations.
 type Parser interface {
-	Initialize(enableMetricType bool, enableSimpleTags bool, isMonotonicCounter bool, sendTimerHistogram []TimerHistogramMapping) error
+	Initialize(enableMetricType bool, enableSimpleTags bool, isMonotonicCounter bool, sendTimerHistogram []TimerHistogramMapping, flush FlushConfig) error
 	GetMetrics() []BatchMetrics
+	GetAlignedMetrics(boundary time.Time) []BatchMetrics
 	Aggregate(line string, addr net.Addr) error
 }
 
@@ -11,6 +11,7 @@ import (
 	"strings"
 	"time"
 
+	"github.com/lightstep/go-expohisto/mapping/logarithm"
 	"github.com/lightstep/go-expohisto/structure"
 	"go.opentelemetry.io/collector/client"
 	"go.opentelemetry.io/collector/component"
@@ -65,15 +66,51 @@ type TimerHistogramMapping struct {
 
 type HistogramConfig struct {
 	MaxSize int32 `mapstructure:"max_size"`
+	// MaxScale is the highest scale of the reported exponential histograms, the histograms
+	// are downscaled to it when flushed. Defaults to the maximum scale, 20.
+	MaxScale *int32 `mapstructure:"max_scale"`
+}
+
+// Temporality is the aggregation temporality used when flushing metrics.
+type Temporality string
+
+const (
+	DeltaTemporality      Temporality = "delta"
+	CumulativeTemporality Temporality = "cumulative"
+)
+
+// FlushConfig configures how the aggregated metrics are flushed, per StatsD type.
+type FlushConfig struct {
+	Counter FlushTypeConfig `mapstructure:"counter"`
+	Gauge   FlushTypeConfig `mapstructure:"gauge"`
+	// Timer applies to the timing, histogram and distribution types.
+	Timer FlushTypeConfig `mapstructure:"timer"`
+}
+
+// FlushTypeConfig configures how the aggregated metrics of a StatsD type are flushed.
+type FlushTypeConfig struct {
+	// Temporality is either "delta" (default) or "cumulative". Cumulative metrics keep being
+	// reported on each flush after they are first received.
+	Temporality Temporality `mapstructure:"temporality"`
+	// AlignToInterval flushes the metrics at wall-clock multiples of the aggregation interval
+	// (e.g. :00, :10, :20 for a 10s interval) instead of relative to the receiver start.
+	AlignToInterval bool `mapstructure:"align_to_interval"`
+}
+
+// Aligned reports whether any StatsD type is flushed at wall-clock aligned boundaries.
+func (c FlushConfig) Aligned() bool {
+	return c.Counter.AlignToInterval || c.Gauge.AlignToInterval || c.Timer.AlignToInterval
 }
 
 type ObserverCategory struct {
-	method          ObserverType
-	histogramConfig structure.Config
+	method            ObserverType
+	histogramConfig   structure.Config
+	histogramMaxScale int32
 }
 
 var defaultObserverCategory = ObserverCategory{
-	method: DefaultObserverType,
+	method:            DefaultObserverType,
+	histogramMaxScale: logarithm.MaxScale,
 }
 
 // StatsDParser supports the Parse method for parsing StatsD messages with Tags.
@@ -84,7 +121,10 @@ type StatsDParser struct {
 	isMonotonicCounter   bool
 	timerEvents          ObserverCategory
 	histogramEvents      ObserverCategory
+	distributionEvents   ObserverCategory
+	flush                FlushConfig
 	lastIntervalTime     time.Time
+	lastAlignedTime      time.Time
 	BuildInfo            component.BuildInfo
 }
 
@@ -97,6 +137,12 @@ type instruments struct {
 	timersAndDistributions []pmetric.ScopeMetrics
 }
 
+// empty reports whether the instruments hold no state to be flushed.
+func (i *instruments) empty() bool {
+	return len(i.gauges) == 0 && len(i.counters) == 0 && len(i.summaries) == 0 &&
+		len(i.histograms) == 0 && len(i.timersAndDistributions) == 0
+}
+
 func newInstruments(addr net.Addr) *instruments {
 	return &instruments{
 		addr:       addr,
@@ -121,6 +167,10 @@ type histogramStructure = structure.Histogram[float64]
 
 type histogramMetric struct {
 	agg *histogramStructure
+	// maxScale is the highest scale the histogram is reported at.
+	maxScale int32
+	// startTime is set on the first flush of a cumulative histogram.
+	startTime time.Time
 }
 
 type statsDMetric struct {
@@ -156,32 +206,57 @@ func (t MetricType) FullName() TypeName {
 
 func (p *StatsDParser) resetState(when time.Time) {
 	p.lastIntervalTime = when
+	p.lastAlignedTime = when
 	p.instrumentsByAddress = make(map[netAddr]*instruments)
 }
 
-func (p *StatsDParser) Initialize(enableMetricType bool, enableSimpleTags bool, isMonotonicCounter bool, sendTimerHistogram []TimerHistogramMapping) error {
+func (p *StatsDParser) Initialize(enableMetricType bool, enableSimpleTags bool, isMonotonicCounter bool, sendTimerHistogram []TimerHistogramMapping, flush FlushConfig) error {
 	p.resetState(timeNowFunc())
+	p.flush = flush
 
 	p.histogramEvents = defaultObserverCategory
 	p.timerEvents = defaultObserverCategory
+	p.distributionEvents = defaultObserverCategory
 	p.enableMetricType = enableMetricType
 	p.enableSimpleTags = enableSimpleTags
 	p.isMonotonicCounter = isMonotonicCounter
 	// Note: validation occurs in ("../".Config).validate()
+	var histogramMapped, distributionMapped bool
 	for _, eachMap := range sendTimerHistogram {
 		switch eachMap.StatsdType {
-		case HistogramTypeName, DistributionTypeName:
-			p.histogramEvents.method = eachMap.ObserverType
-			p.histogramEvents.histogramConfig = expoHistogramConfig(eachMap.Histogram)
+		case HistogramTypeName:
+			p.histogramEvents = newObserverCategory(eachMap)
+			histogramMapped = true
+		case DistributionTypeName:
+			p.distributionEvents = newObserverCategory(eachMap)
+			distributionMapped = true
 		case TimingTypeName, TimingAltTypeName:
-			p.timerEvents.method = eachMap.ObserverType
-			p.timerEvents.histogramConfig = expoHistogramConfig(eachMap.Histogram)
+			p.timerEvents = newObserverCategory(eachMap)
 		case CounterTypeName, GaugeTypeName:
 		}
 	}
+	// Histograms and distributions share their mapping unless both are mapped.
+	switch {
+	case histogramMapped && !distributionMapped:
+		p.distributionEvents = p.histogramEvents
+	case distributionMapped && !histogramMapped:
+		p.histogramEvents = p.distributionEvents
+	}
 	return nil
 }
 
+func newObserverCategory(mapping TimerHistogramMapping) ObserverCategory {
+	category := ObserverCategory{
+		method:            mapping.ObserverType,
+		histogramConfig:   expoHistogramConfig(mapping.Histogram),
+		histogramMaxScale: logarithm.MaxScale,
+	}
+	if mapping.Histogram.MaxScale != nil {
+		category.histogramMaxScale = *mapping.Histogram.MaxScale
+	}
+	return category
+}
+
 func expoHistogramConfig(opts HistogramConfig) structure.Config {
 	var r []structure.Option
 	if opts.MaxSize >= structure.MinSize {
@@ -190,11 +265,31 @@ func expoHistogramConfig(opts HistogramConfig) structure.Config {
 	return structure.NewConfig(r...)
 }
 
-// GetMetrics gets the metrics preparing for flushing and reset the state.
+// GetMetrics gets the metrics of the types flushed relative to the receiver start, and resets their state.
 func (p *StatsDParser) GetMetrics() []BatchMetrics {
-	batchMetrics := make([]BatchMetrics, 0, len(p.instrumentsByAddress))
 	now := timeNowFunc()
-	for _, instrument := range p.instrumentsByAddress {
+	batchMetrics := p.getMetrics(false, p.lastIntervalTime, now)
+	p.lastIntervalTime = now
+	return batchMetrics
+}
+
+// GetAlignedMetrics gets the metrics of the types flushed at wall-clock aligned boundaries, and resets
+// their state. The boundary is used as the end of the interval.
+func (p *StatsDParser) GetAlignedMetrics(boundary time.Time) []BatchMetrics {
+	batchMetrics := p.getMetrics(true, p.lastAlignedTime, boundary)
+	p.lastAlignedTime = boundary
+	return batchMetrics
+}
+
+func (p *StatsDParser) getMetrics(aligned bool, start, now time.Time) []BatchMetrics {
+	batchMetrics := make([]BatchMetrics, 0, len(p.instrumentsByAddress))
+	flushGauges := p.flush.Gauge.AlignToInterval == aligned
+	flushCounters := p.flush.Counter.AlignToInterval == aligned
+	flushTimers := p.flush.Timer.AlignToInterval == aligned
+	cumulativeCounters := p.flush.Counter.Temporality == CumulativeTemporality
+	cumulativeTimers := p.flush.Timer.Temporality == CumulativeTemporality
+
+	for addrKey, instrument := range p.instrumentsByAddress {
 		batch := BatchMetrics{
 			Info: client.Info{
 				Addr: instrument.addr,
@@ -202,49 +297,82 @@ func (p *StatsDParser) GetMetrics() []BatchMetrics {
 			Metrics: pmetric.NewMetrics(),
 		}
 		rm := batch.Metrics.ResourceMetrics().AppendEmpty()
-		for _, metric := range instrument.gauges {
-			p.copyMetricAndScope(rm, metric)
-		}
-
-		for _, metric := range instrument.timersAndDistributions {
-			p.copyMetricAndScope(rm, metric)
+		if flushGauges {
+			for _, metric := range instrument.gauges {
+				p.copyMetricAndScope(rm, metric)
+			}
+			instrument.gauges = make(map[statsDMetricDescription]pmetric.ScopeMetrics)
 		}
 
-		for _, metric := range instrument.counters {
-			setTimestampsForCounterMetric(metric, p.lastIntervalTime, now)
-			p.copyMetricAndScope(rm, metric)
+		if flushTimers {
+			for _, metric := range instrument.timersAndDistributions {
+				p.copyMetricAndScope(rm, metric)
+			}
+			instrument.timersAndDistributions = nil
 		}
 
-		for desc, summaryMetric := range instrument.summaries {
-			ilm := rm.ScopeMetrics().AppendEmpty()
-			p.setVersionAndNameScope(ilm.Scope())
-
-			buildSummaryMetric(
-				desc,
-				summaryMetric,
-				p.lastIntervalTime,
-				now,
-				statsDDefaultPercentiles,
-				ilm,
-			)
+		if flushCounters {
+			for _, metric := range instrument.counters {
+				setTimestampsForCounterMetric(metric, start, now, cumulativeCounters)
+				p.copyMetricAndScope(rm, metric)
+			}
+			if !cumulativeCounters {
+				instrument.counters = make(map[statsDMetricDescription]pmetric.ScopeMetrics)
+			}
 		}
 
-		for desc, histogramMetric := range instrument.histograms {
-			ilm := rm.ScopeMetrics().AppendEmpty()
-			p.setVersionAndNameScope(ilm.Scope())
+		if flushTimers {
+			for desc, summaryMetric := range instrument.summaries {
+				ilm := rm.ScopeMetrics().AppendEmpty()
+				p.setVersionAndNameScope(ilm.Scope())
+
+				buildSummaryMetric(
+					desc,
+					summaryMetric,
+					start,
+					now,
+					statsDDefaultPercentiles,
+					ilm,
+				)
+			}
+			instrument.summaries = make(map[statsDMetricDescription]summaryMetric)
+
+			for desc, histogramMetric := range instrument.histograms {
+				ilm := rm.ScopeMetrics().AppendEmpty()
+				p.setVersionAndNameScope(ilm.Scope())
+
+				temporality := pmetric.AggregationTemporalityDelta
+				histogramStart := start
+				if cumulativeTimers {
+					temporality = pmetric.AggregationTemporalityCumulative
+					if histogramMetric.startTime.IsZero() {
+						histogramMetric.startTime = start
+						instrument.histograms[desc] = histogramMetric
+					}
+					histogramStart = histogramMetric.startTime
+				}
 
-			buildHistogramMetric(
-				desc,
-				histogramMetric,
-				p.lastIntervalTime,
-				now,
-				ilm,
-			)
+				buildHistogramMetric(
+					desc,
+					histogramMetric,
+					histogramStart,
+					now,
+					temporality,
+					ilm,
+				)
+			}
+			if !cumulativeTimers {
+				instrument.histograms = make(map[statsDMetricDescription]histogramMetric)
+			}
 		}
 
-		batchMetrics = append(batchMetrics, batch)
+		if rm.ScopeMetrics().Len() > 0 {
+			batchMetrics = append(batchMetrics, batch)
+		}
+		if instrument.empty() {
+			delete(p.instrumentsByAddress, addrKey)
+		}
 	}
-	p.resetState(now)
 	return batchMetrics
 }
 
@@ -263,8 +391,10 @@ var timeNowFunc = time.Now
 
 func (p *StatsDParser) observerCategoryFor(t MetricType) ObserverCategory {
 	switch t {
-	case HistogramType, DistributionType:
+	case HistogramType:
 		return p.histogramEvents
+	case DistributionType:
+		return p.distributionEvents
 	case TimingType:
 		return p.timerEvents
 	case CounterType, GaugeType:
@@ -303,7 +433,11 @@ func (p *StatsDParser) Aggregate(line string, addr net.Addr) error {
 	case CounterType:
 		_, ok := instrument.counters[parsedMetric.description]
 		if !ok {
-			instrument.counters[parsedMetric.description] = buildCounterMetric(parsedMetric, p.isMonotonicCounter)
+			counter := buildCounterMetric(parsedMetric, p.isMonotonicCounter)
+			if p.flush.Counter.Temporality == CumulativeTemporality {
+				counter.Metrics().At(0).Sum().SetAggregationTemporality(pmetric.AggregationTemporalityCumulative)
+			}
+			instrument.counters[parsedMetric.description] = counter
 		} else {
 			point := instrument.counters[parsedMetric.description].Metrics().At(0).Sum().DataPoints().At(0)
 			point.SetIntValue(point.IntValue() + parsedMetric.counterValue())
@@ -337,7 +471,8 @@ func (p *StatsDParser) Aggregate(line string, addr net.Addr) error {
 				agg.Init(category.histogramConfig)
 
 				instrument.histograms[parsedMetric.description] = histogramMetric{
-					agg: agg,
+					agg:      agg,
+					maxScale: category.histogramMaxScale,
 				}
 			}
 			agg.UpdateByIncr(
@@ -13,6 +13,7 @@ import (
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 	"go.opentelemetry.io/collector/component"
+	"go.opentelemetry.io/collector/pdata/pcommon"
 	"go.opentelemetry.io/collector/pdata/pmetric"
 	semconv "go.opentelemetry.io/collector/semconv/v1.22.0"
 	"go.opentelemetry.io/otel/attribute"
@@ -1040,7 +1041,7 @@ func TestStatsDParser_Aggregate(t *testing.T) {
 		t.Run(tt.name, func(t *testing.T) {
 			var err error
 			p := &StatsDParser{}
-			assert.NoError(t, p.Initialize(false, false, false, []TimerHistogramMapping{{StatsdType: "timer", ObserverType: "gauge"}, {StatsdType: "histogram", ObserverType: "gauge"}}))
+			assert.NoError(t, p.Initialize(false, false, false, []TimerHistogramMapping{{StatsdType: "timer", ObserverType: "gauge"}, {StatsdType: "histogram", ObserverType: "gauge"}}, FlushConfig{}))
 			p.lastIntervalTime = time.Unix(611, 0)
 			addr, _ := net.ResolveUDPAddr("udp", "1.2.3.4:5678")
 			addrKey := newNetAddr(addr)
@@ -1149,7 +1150,7 @@ func TestStatsDParser_AggregateByAddress(t *testing.T) {
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
 			p := &StatsDParser{}
-			assert.NoError(t, p.Initialize(true, false, false, []TimerHistogramMapping{{StatsdType: "timer", ObserverType: "gauge"}, {StatsdType: "histogram", ObserverType: "gauge"}}))
+			assert.NoError(t, p.Initialize(true, false, false, []TimerHistogramMapping{{StatsdType: "timer", ObserverType: "gauge"}, {StatsdType: "histogram", ObserverType: "gauge"}}, FlushConfig{}))
 			p.lastIntervalTime = time.Unix(611, 0)
 			for i, addr := range tt.addresses {
 				for _, line := range tt.input[i] {
@@ -1257,7 +1258,7 @@ func TestStatsDParser_AggregateWithMetricType(t *testing.T) {
 		t.Run(tt.name, func(t *testing.T) {
 			var err error
 			p := &StatsDParser{}
-			assert.NoError(t, p.Initialize(true, false, false, []TimerHistogramMapping{{StatsdType: "timer", ObserverType: "gauge"}, {StatsdType: "histogram", ObserverType: "gauge"}}))
+			assert.NoError(t, p.Initialize(true, false, false, []TimerHistogramMapping{{StatsdType: "timer", ObserverType: "gauge"}, {StatsdType: "histogram", ObserverType: "gauge"}}, FlushConfig{}))
 			p.lastIntervalTime = time.Unix(611, 0)
 			addr, _ := net.ResolveUDPAddr("udp", "1.2.3.4:5678")
 			addrKey := newNetAddr(addr)
@@ -1327,7 +1328,7 @@ func TestStatsDParser_AggregateWithIsMonotonicCounter(t *testing.T) {
 		t.Run(tt.name, func(t *testing.T) {
 			var err error
 			p := &StatsDParser{}
-			assert.NoError(t, p.Initialize(false, false, true, []TimerHistogramMapping{{StatsdType: "timer", ObserverType: "gauge"}, {StatsdType: "histogram", ObserverType: "gauge"}}))
+			assert.NoError(t, p.Initialize(false, false, true, []TimerHistogramMapping{{StatsdType: "timer", ObserverType: "gauge"}, {StatsdType: "histogram", ObserverType: "gauge"}}, FlushConfig{}))
 			p.lastIntervalTime = time.Unix(611, 0)
 			addr, _ := net.ResolveUDPAddr("udp", "1.2.3.4:5678")
 			addrKey := newNetAddr(addr)
@@ -1449,7 +1450,7 @@ func TestStatsDParser_AggregateTimerWithSummary(t *testing.T) {
 		t.Run(tt.name, func(t *testing.T) {
 			var err error
 			p := &StatsDParser{}
-			assert.NoError(t, p.Initialize(false, false, false, []TimerHistogramMapping{{StatsdType: "timer", ObserverType: "summary"}, {StatsdType: "histogram", ObserverType: "summary"}}))
+			assert.NoError(t, p.Initialize(false, false, false, []TimerHistogramMapping{{StatsdType: "timer", ObserverType: "summary"}, {StatsdType: "histogram", ObserverType: "summary"}}, FlushConfig{}))
 			addr, _ := net.ResolveUDPAddr("udp", "1.2.3.4:5678")
 			addrKey := newNetAddr(addr)
 			for _, line := range tt.input {
@@ -1466,7 +1467,7 @@ func TestStatsDParser_AggregateTimerWithSummary(t *testing.T) {
 
 func TestStatsDParser_Initialize(t *testing.T) {
 	p := &StatsDParser{}
-	assert.NoError(t, p.Initialize(true, false, false, []TimerHistogramMapping{{StatsdType: "timer", ObserverType: "gauge"}, {StatsdType: "histogram", ObserverType: "gauge"}}))
+	assert.NoError(t, p.Initialize(true, false, false, []TimerHistogramMapping{{StatsdType: "timer", ObserverType: "gauge"}, {StatsdType: "histogram", ObserverType: "gauge"}}, FlushConfig{}))
 	teststatsdDMetricdescription := statsDMetricDescription{
 		name:       "test",
 		metricType: "g",
@@ -1485,7 +1486,7 @@ func TestStatsDParser_Initialize(t *testing.T) {
 
 func TestStatsDParser_GetMetricsWithMetricType(t *testing.T) {
 	p := &StatsDParser{}
-	assert.NoError(t, p.Initialize(true, false, false, []TimerHistogramMapping{{StatsdType: "timer", ObserverType: "gauge"}, {StatsdType: "histogram", ObserverType: "gauge"}}))
+	assert.NoError(t, p.Initialize(true, false, false, []TimerHistogramMapping{{StatsdType: "timer", ObserverType: "gauge"}, {StatsdType: "histogram", ObserverType: "gauge"}}, FlushConfig{}))
 	instrument := newInstruments(nil)
 	instrument.gauges[testDescription("statsdTestMetric1", "g",
 		[]string{"mykey", "metric_type"}, []string{"myvalue", "gauge"})] = buildGaugeMetric(
@@ -1598,7 +1599,7 @@ func TestStatsDParser_Mappings(t *testing.T) {
 		t.Run(tc.name, func(t *testing.T) {
 			p := &StatsDParser{}
 
-			assert.NoError(t, p.Initialize(false, false, false, tc.mapping))
+			assert.NoError(t, p.Initialize(false, false, false, tc.mapping, FlushConfig{}))
 
 			addr, _ := net.ResolveUDPAddr("udp", "1.2.3.4:5678")
 			assert.NoError(t, p.Aggregate("H:10|h", addr))
@@ -1637,6 +1638,7 @@ func TestStatsDParser_ScopeIsIncluded(t *testing.T) {
 			{StatsdType: "timer", ObserverType: "summary"},
 			{StatsdType: "histogram", ObserverType: "histogram"},
 		},
+		FlushConfig{},
 	)
 	require.NoError(t, err)
 	require.NoError(t, p.Aggregate("test.metric:1|c", testAddress))
@@ -1897,12 +1899,46 @@ func TestStatsDParser_AggregateTimerWithHistogram(t *testing.T) {
 			}(),
 			mapping: normalMapping,
 		},
+		{
+			name: "max_scale",
+			input: []string{
+				"expohisto:1.5|d|#mykey:myvalue",
+				"expohisto:2.5|d|#mykey:myvalue",
+				"expohisto:3.5|d|#mykey:myvalue",
+				"expohisto:4.5|d|#mykey:myvalue",
+				"expohisto:8.5|d|#mykey:myvalue",
+			},
+			expected: func() pmetric.Metrics {
+				data, dp := newPoint()
+				dp.SetCount(5)
+				dp.SetSum(20.5)
+				dp.SetMin(1.5)
+				dp.SetMax(8.5)
+				dp.SetZeroCount(0)
+				// Downscaled from the scale the 160 buckets allow to the maximum scale.
+				dp.SetScale(0)
+				dp.Positive().SetOffset(0)
+				dp.Positive().BucketCounts().FromRaw([]uint64{
+					1, 2, 1, 1,
+				})
+				return data
+			}(),
+			mapping: []TimerHistogramMapping{
+				{
+					StatsdType:   "distribution",
+					ObserverType: "histogram",
+					Histogram: HistogramConfig{
+						MaxScale: func() *int32 { scale := int32(0); return &scale }(),
+					},
+				},
+			},
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
 			var err error
 			p := &StatsDParser{}
-			assert.NoError(t, p.Initialize(false, false, false, tt.mapping))
+			assert.NoError(t, p.Initialize(false, false, false, tt.mapping, FlushConfig{}))
 			addr, _ := net.ResolveUDPAddr("udp", "1.2.3.4:5678")
 			for _, line := range tt.input {
 				err = p.Aggregate(line, addr)
@@ -1913,3 +1949,149 @@ func TestStatsDParser_AggregateTimerWithHistogram(t *testing.T) {
 		})
 	}
 }
+
+func TestStatsDParser_DistributionMapping(t *testing.T) {
+	histogram := TimerHistogramMapping{StatsdType: "histogram", ObserverType: "summary"}
+	distribution := TimerHistogramMapping{StatsdType: "distribution", ObserverType: "histogram"}
+
+	tests := []struct {
+		name                 string
+		mapping              []TimerHistogramMapping
+		expectedHistogram    ObserverType
+		expectedDistribution ObserverType
+	}{
+		{
+			name:                 "none",
+			expectedHistogram:    DisableObserver,
+			expectedDistribution: DisableObserver,
+		},
+		{
+			name:                 "histogram only",
+			mapping:              []TimerHistogramMapping{histogram},
+			expectedHistogram:    SummaryObserver,
+			expectedDistribution: SummaryObserver,
+		},
+		{
+			name:                 "distribution only",
+			mapping:              []TimerHistogramMapping{distribution},
+			expectedHistogram:    HistogramObserver,
+			expectedDistribution: HistogramObserver,
+		},
+		{
+			name:                 "both",
+			mapping:              []TimerHistogramMapping{distribution, histogram},
+			expectedHistogram:    SummaryObserver,
+			expectedDistribution: HistogramObserver,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			p := &StatsDParser{}
+			require.NoError(t, p.Initialize(false, false, false, tt.mapping, FlushConfig{}))
+			assert.Equal(t, tt.expectedHistogram, p.observerCategoryFor(HistogramType).method)
+			assert.Equal(t, tt.expectedDistribution, p.observerCategoryFor(DistributionType).method)
+		})
+	}
+}
+
+func TestStatsDParser_CumulativeFlush(t *testing.T) {
+	timeNowFunc = func() time.Time {
+		return time.Unix(100, 0)
+	}
+	defer func() { timeNowFunc = time.Now }()
+
+	p := &StatsDParser{}
+	testAddress, _ := net.ResolveUDPAddr("udp", "1.2.3.4:5678")
+	require.NoError(t, p.Initialize(false, false, true,
+		[]TimerHistogramMapping{{StatsdType: "timer", ObserverType: "histogram"}},
+		FlushConfig{
+			Counter: FlushTypeConfig{Temporality: CumulativeTemporality},
+			Timer:   FlushTypeConfig{Temporality: CumulativeTemporality},
+		},
+	))
+
+	require.NoError(t, p.Aggregate("counter:3|c", testAddress))
+	require.NoError(t, p.Aggregate("timer:10|ms", testAddress))
+	require.NoError(t, p.Aggregate("gauge:1|g", testAddress))
+
+	timeNowFunc = func() time.Time { return time.Unix(110, 0) }
+	first := p.GetMetrics()
+	require.Len(t, first, 1)
+	assert.Equal(t, 3, first[0].Metrics.MetricCount())
+
+	require.NoError(t, p.Aggregate("counter:4|c", testAddress))
+	require.NoError(t, p.Aggregate("timer:20|ms", testAddress))
+
+	timeNowFunc = func() time.Time { return time.Unix(120, 0) }
+	second := p.GetMetrics()
+	require.Len(t, second, 1)
+	// the gauge isn't reported anymore, while cumulative metrics are
+	assert.Equal(t, 2, second[0].Metrics.MetricCount())
+
+	metrics := second[0].Metrics.ResourceMetrics().At(0).ScopeMetrics()
+	for i := 0; i < metrics.Len(); i++ {
+		m := metrics.At(i).Metrics().At(0)
+		switch m.Name() {
+		case "counter":
+			assert.Equal(t, pmetric.AggregationTemporalityCumulative, m.Sum().AggregationTemporality())
+			dp := m.Sum().DataPoints().At(0)
+			assert.Equal(t, int64(7), dp.IntValue())
+			assert.Equal(t, pcommon.NewTimestampFromTime(time.Unix(100, 0)), dp.StartTimestamp())
+			assert.Equal(t, pcommon.NewTimestampFromTime(time.Unix(120, 0)), dp.Timestamp())
+		case "timer":
+			assert.Equal(t, pmetric.AggregationTemporalityCumulative, m.ExponentialHistogram().AggregationTemporality())
+			dp := m.ExponentialHistogram().DataPoints().At(0)
+			assert.Equal(t, uint64(2), dp.Count())
+			assert.Equal(t, 30.0, dp.Sum())
+			assert.Equal(t, pcommon.NewTimestampFromTime(time.Unix(100, 0)), dp.StartTimestamp())
+			assert.Equal(t, pcommon.NewTimestampFromTime(time.Unix(120, 0)), dp.Timestamp())
+		default:
+			t.Errorf("unexpected metric %q", m.Name())
+		}
+	}
+}
+
+func TestStatsDParser_AlignedFlush(t *testing.T) {
+	timeNowFunc = func() time.Time {
+		return time.Unix(105, 0)
+	}
+	defer func() { timeNowFunc = time.Now }()
+
+	p := &StatsDParser{}
+	testAddress, _ := net.ResolveUDPAddr("udp", "1.2.3.4:5678")
+	require.NoError(t, p.Initialize(false, false, false, nil, FlushConfig{
+		Counter: FlushTypeConfig{AlignToInterval: true},
+	}))
+
+	require.NoError(t, p.Aggregate("counter:3|c", testAddress))
+	require.NoError(t, p.Aggregate("gauge:1|g", testAddress))
+
+	// the regular flush only reports the gauge
+	timeNowFunc = func() time.Time { return time.Unix(115, 0) }
+	regular := p.GetMetrics()
+	require.Len(t, regular, 1)
+	require.Equal(t, 1, regular[0].Metrics.MetricCount())
+	assert.Equal(t, "gauge", regular[0].Metrics.ResourceMetrics().At(0).ScopeMetrics().At(0).Metrics().At(0).Name())
+
+	require.NoError(t, p.Aggregate("counter:4|c", testAddress))
+
+	aligned := p.GetAlignedMetrics(time.Unix(120, 0))
+	require.Len(t, aligned, 1)
+	require.Equal(t, 1, aligned[0].Metrics.MetricCount())
+	m := aligned[0].Metrics.ResourceMetrics().At(0).ScopeMetrics().At(0).Metrics().At(0)
+	assert.Equal(t, "counter", m.Name())
+	dp := m.Sum().DataPoints().At(0)
+	assert.Equal(t, int64(7), dp.IntValue())
+	assert.Equal(t, pcommon.NewTimestampFromTime(time.Unix(105, 0)), dp.StartTimestamp())
+	assert.Equal(t, pcommon.NewTimestampFromTime(time.Unix(120, 0)), dp.Timestamp())
+
+	// the next aligned interval starts at the previous boundary
+	require.NoError(t, p.Aggregate("counter:1|c", testAddress))
+	aligned = p.GetAlignedMetrics(time.Unix(130, 0))
+	require.Len(t, aligned, 1)
+	dp = aligned[0].Metrics.ResourceMetrics().At(0).ScopeMetrics().At(0).Metrics().At(0).Sum().DataPoints().At(0)
+	assert.Equal(t, pcommon.NewTimestampFromTime(time.Unix(120, 0)), dp.StartTimestamp())
+
+	assert.Empty(t, p.GetMetrics())
+	assert.Empty(t, p.instrumentsByAddress)
+}
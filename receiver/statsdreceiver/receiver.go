@@ -92,10 +92,20 @@ func (r *statsdReceiver) Start(ctx context.Context, _ component.Host) error {
 		r.config.EnableSimpleTags,
 		r.config.IsMonotonicCounter,
 		r.config.TimerHistogramMapping,
+		r.config.Flush,
 	)
 	if err != nil {
+		ticker.Stop()
 		return err
 	}
+	// alignedC fires at the wall-clock multiples of the aggregation interval, for the types
+	// configured to be flushed at aligned boundaries.
+	var alignedTimer *time.Timer
+	var alignedC <-chan time.Time
+	if r.config.Flush.Aligned() {
+		alignedTimer = time.NewTimer(untilNextBoundary(time.Now(), r.config.AggregationInterval))
+		alignedC = alignedTimer.C
+	}
 	go func() {
 		if err := r.server.ListenAndServe(r.nextConsumer, r.reporter, transferChan); err != nil {
 			if !errors.Is(err, net.ErrClosed) {
@@ -107,20 +117,20 @@ func (r *statsdReceiver) Start(ctx context.Context, _ component.Host) error {
 		for {
 			select {
 			case <-ticker.C:
-				batchMetrics := r.parser.GetMetrics()
-				for _, batch := range batchMetrics {
-					batchCtx := client.NewContext(ctx, batch.Info)
-
-					if err := r.Flush(batchCtx, batch.Metrics, r.nextConsumer); err != nil {
-						r.reporter.OnDebugf("Error flushing metrics", zap.Error(err))
-					}
-				}
+				r.flushBatches(ctx, r.parser.GetMetrics())
+			case t := <-alignedC:
+				boundary := t.Truncate(r.config.AggregationInterval)
+				r.flushBatches(ctx, r.parser.GetAlignedMetrics(boundary))
+				alignedTimer.Reset(untilNextBoundary(time.Now(), r.config.AggregationInterval))
 			case metric := <-transferChan:
 				if err := r.parser.Aggregate(metric.Raw, metric.Addr); err != nil {
 					r.reporter.OnDebugf("Error aggregating metric", zap.Error(err))
 				}
 			case <-ctx.Done():
 				ticker.Stop()
+				if alignedTimer != nil {
+					alignedTimer.Stop()
+				}
 				return
 			}
 		}
@@ -139,6 +149,21 @@ func (r *statsdReceiver) Shutdown(context.Context) error {
 	return err
 }
 
+func (r *statsdReceiver) flushBatches(ctx context.Context, batchMetrics []protocol.BatchMetrics) {
+	for _, batch := range batchMetrics {
+		batchCtx := client.NewContext(ctx, batch.Info)
+
+		if err := r.Flush(batchCtx, batch.Metrics, r.nextConsumer); err != nil {
+			r.reporter.OnDebugf("Error flushing metrics", zap.Error(err))
+		}
+	}
+}
+
+// untilNextBoundary returns the time left until the next wall-clock multiple of the interval.
+func untilNextBoundary(now time.Time, interval time.Duration) time.Duration {
+	return now.Truncate(interval).Add(interval).Sub(now)
+}
+
 func (r *statsdReceiver) Flush(ctx context.Context, metrics pmetric.Metrics, nextConsumer consumer.Metrics) error {
 	return nextConsumer.ConsumeMetrics(ctx, metrics)
 }
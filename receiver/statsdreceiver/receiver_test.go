@@ -163,3 +163,10 @@ func Test_statsdreceiver_EndToEnd(t *testing.T) {
 		})
 	}
 }
+
+func TestUntilNextBoundary(t *testing.T) {
+	now := time.Date(2024, 1, 1, 10, 0, 7, 0, time.UTC)
+	assert.Equal(t, 3*time.Second, untilNextBoundary(now, 10*time.Second))
+	assert.Equal(t, 53*time.Second, untilNextBoundary(now, time.Minute))
+	assert.Equal(t, 10*time.Second, untilNextBoundary(now.Add(3*time.Second), 10*time.Second))
+}
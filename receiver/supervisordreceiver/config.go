@@ -0,0 +1,72 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package supervisordreceiver // import "github.com/open-telemetry/opentelemetry-collector-contrib/receiver/supervisordreceiver"
+
+import (
+	"errors"
+	"fmt"
+	"net/url"
+
+	"go.opentelemetry.io/collector/config/confighttp"
+	"go.opentelemetry.io/collector/config/configopaque"
+	"go.opentelemetry.io/collector/receiver/scraperhelper"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/receiver/supervisordreceiver/internal/metadata"
+)
+
+var (
+	errNoSource        = errors.New(`either "endpoint" or "systemd.units" must be specified`)
+	errInvalidEndpoint = errors.New(`"endpoint" must be in the form of <scheme>://<hostname>:<port>/RPC2 or unix://<socket path>`)
+)
+
+// Config defines the configuration for the supervisord receiver.
+type Config struct {
+	scraperhelper.ControllerConfig `mapstructure:",squash"`
+	// ClientConfig is the configuration of the supervisord XML-RPC interface. The endpoint is either the URL of
+	// the inet_http_server, or the path of the unix_http_server socket prefixed with unix://. An empty endpoint
+	// disables the supervisord processes.
+	confighttp.ClientConfig `mapstructure:",squash"`
+	// Username and Password are the credentials of the supervisord HTTP server, when it requires them.
+	Username string              `mapstructure:"username"`
+	Password configopaque.String `mapstructure:"password"`
+
+	// Systemd is the configuration of the systemd user services monitored along the supervisord processes.
+	Systemd SystemdConfig `mapstructure:"systemd"`
+
+	metadata.MetricsBuilderConfig `mapstructure:",squash"`
+}
+
+// SystemdConfig defines the systemd user services to monitor.
+type SystemdConfig struct {
+	// Units are the names of the user units to monitor, e.g. myapp.service.
+	Units []string `mapstructure:"units"`
+}
+
+// Validate validates the configuration by checking for missing or invalid fields
+func (cfg *Config) Validate() error {
+	if cfg.Endpoint == "" {
+		if len(cfg.Systemd.Units) == 0 {
+			return errNoSource
+		}
+		return nil
+	}
+
+	u, err := url.Parse(cfg.Endpoint)
+	if err != nil {
+		return fmt.Errorf("%s: %w", errInvalidEndpoint.Error(), err)
+	}
+	switch u.Scheme {
+	case "http", "https":
+		if u.Host == "" {
+			return errInvalidEndpoint
+		}
+	case "unix":
+		if u.Path == "" {
+			return errInvalidEndpoint
+		}
+	default:
+		return errInvalidEndpoint
+	}
+	return nil
+}
@@ -0,0 +1,109 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package supervisordreceiver
+
+import (
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/collector/component"
+	"go.opentelemetry.io/collector/confmap/confmaptest"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/receiver/supervisordreceiver/internal/metadata"
+)
+
+func TestValidate(t *testing.T) {
+	testCases := []struct {
+		desc     string
+		endpoint string
+		units    []string
+		err      error
+	}{
+		{
+			desc:     "default endpoint",
+			endpoint: defaultEndpoint,
+		},
+		{
+			desc:     "unix socket",
+			endpoint: "unix:///var/run/supervisor.sock",
+		},
+		{
+			desc:  "systemd only",
+			units: []string{"app.service"},
+		},
+		{
+			desc: "no source",
+			err:  errNoSource,
+		},
+		{
+			desc:     "missing hostname",
+			endpoint: "http:///RPC2",
+			err:      errInvalidEndpoint,
+		},
+		{
+			desc:     "missing socket path",
+			endpoint: "unix://",
+			err:      errInvalidEndpoint,
+		},
+		{
+			desc:     "unsupported scheme",
+			endpoint: "tcp://localhost:9001",
+			err:      errInvalidEndpoint,
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.desc, func(t *testing.T) {
+			cfg := NewFactory().CreateDefaultConfig().(*Config)
+			cfg.Endpoint = tc.endpoint
+			cfg.Systemd.Units = tc.units
+			err := component.ValidateConfig(cfg)
+			if tc.err != nil {
+				require.ErrorIs(t, err, tc.err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
+
+func TestLoadConfig(t *testing.T) {
+	cm, err := confmaptest.LoadConf(filepath.Join("testdata", "config.yaml"))
+	require.NoError(t, err)
+
+	tests := []struct {
+		id       component.ID
+		expected func(cfg *Config)
+	}{
+		{
+			id:       component.NewID(metadata.Type),
+			expected: func(*Config) {},
+		},
+		{
+			id: component.NewIDWithName(metadata.Type, "socket"),
+			expected: func(cfg *Config) {
+				cfg.Endpoint = "unix:///var/run/supervisor.sock"
+				cfg.Username = "user"
+				cfg.Password = "secret"
+				cfg.Systemd.Units = []string{"app.service", "worker.service"}
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.id.String(), func(t *testing.T) {
+			factory := NewFactory()
+			cfg := factory.CreateDefaultConfig()
+
+			sub, err := cm.Sub(tt.id.String())
+			require.NoError(t, err)
+			require.NoError(t, sub.Unmarshal(cfg))
+			require.NoError(t, component.ValidateConfig(cfg))
+
+			expected := factory.CreateDefaultConfig().(*Config)
+			tt.expected(expected)
+			assert.Equal(t, expected, cfg)
+		})
+	}
+}
@@ -0,0 +1,8 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+//go:generate mdatagen metadata.yaml
+
+// Package supervisordreceiver implements a receiver that monitors the processes managed by supervisord, and
+// optionally the systemd user services, for their state and restarts.
+package supervisordreceiver // import "github.com/open-telemetry/opentelemetry-collector-contrib/receiver/supervisordreceiver"
@@ -0,0 +1,75 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package supervisordreceiver // import "github.com/open-telemetry/opentelemetry-collector-contrib/receiver/supervisordreceiver"
+
+import (
+	"context"
+	"time"
+
+	"go.opentelemetry.io/collector/component"
+	"go.opentelemetry.io/collector/config/confighttp"
+	"go.opentelemetry.io/collector/consumer"
+	"go.opentelemetry.io/collector/receiver"
+	"go.opentelemetry.io/collector/receiver/scraperhelper"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/receiver/supervisordreceiver/internal/metadata"
+)
+
+const (
+	defaultEndpoint           = "http://localhost:9001/RPC2"
+	defaultTimeout            = 10 * time.Second
+	defaultCollectionInterval = 10 * time.Second
+)
+
+// NewFactory creates a factory for supervisord receiver.
+func NewFactory() receiver.Factory {
+	return receiver.NewFactory(
+		metadata.Type,
+		createDefaultConfig,
+		receiver.WithMetrics(createMetricsReceiver, metadata.MetricsStability),
+		receiver.WithLogs(createLogsReceiver, metadata.LogsStability))
+}
+
+func createDefaultConfig() component.Config {
+	cfg := scraperhelper.NewDefaultControllerConfig()
+	cfg.CollectionInterval = defaultCollectionInterval
+
+	return &Config{
+		ControllerConfig: cfg,
+		ClientConfig: confighttp.ClientConfig{
+			Endpoint: defaultEndpoint,
+			Timeout:  defaultTimeout,
+		},
+		MetricsBuilderConfig: metadata.DefaultMetricsBuilderConfig(),
+	}
+}
+
+func createMetricsReceiver(
+	_ context.Context,
+	params receiver.CreateSettings,
+	rConf component.Config,
+	consumer consumer.Metrics,
+) (receiver.Metrics, error) {
+	cfg := rConf.(*Config)
+
+	ss := newSupervisordScraper(params, cfg)
+	scraper, err := scraperhelper.NewScraper(metadata.Type.String(), ss.scrape, scraperhelper.WithStart(ss.start))
+	if err != nil {
+		return nil, err
+	}
+
+	return scraperhelper.NewScraperControllerReceiver(
+		&cfg.ControllerConfig, params, consumer,
+		scraperhelper.AddScraper(scraper),
+	)
+}
+
+func createLogsReceiver(
+	_ context.Context,
+	params receiver.CreateSettings,
+	rConf component.Config,
+	consumer consumer.Logs,
+) (receiver.Logs, error) {
+	return newStateChangeReceiver(rConf.(*Config), params, consumer), nil
+}
@@ -0,0 +1,147 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package supervisordreceiver // import "github.com/open-telemetry/opentelemetry-collector-contrib/receiver/supervisordreceiver"
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	"go.opentelemetry.io/collector/component"
+	"go.opentelemetry.io/collector/consumer"
+	"go.opentelemetry.io/collector/pdata/pcommon"
+	"go.opentelemetry.io/collector/pdata/plog"
+	"go.opentelemetry.io/collector/receiver"
+	"go.uber.org/zap"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/receiver/supervisordreceiver/internal/metadata"
+)
+
+var _ receiver.Logs = (*stateChangeReceiver)(nil)
+
+// stateChangeReceiver polls the processes at the collection interval, and emits a log when the state of a process
+// changed since the previous poll. The states of the first poll are the reference, no log is emitted for them.
+type stateChangeReceiver struct {
+	cfg  *Config
+	set  receiver.CreateSettings
+	next consumer.Logs
+
+	listers []processLister
+	// states are the latest states of the processes, by key
+	states map[string]metadata.AttributeState
+
+	cancel context.CancelFunc
+	wg     sync.WaitGroup
+}
+
+func newStateChangeReceiver(cfg *Config, set receiver.CreateSettings, next consumer.Logs) *stateChangeReceiver {
+	return &stateChangeReceiver{
+		cfg:    cfg,
+		set:    set,
+		next:   next,
+		states: map[string]metadata.AttributeState{},
+	}
+}
+
+func (r *stateChangeReceiver) Start(ctx context.Context, host component.Host) error {
+	listers, err := newProcessListers(ctx, host, r.cfg, r.set.TelemetrySettings)
+	if err != nil {
+		return err
+	}
+	r.listers = listers
+
+	pollCtx, cancel := context.WithCancel(context.Background())
+	r.cancel = cancel
+	r.wg.Add(1)
+	go func() {
+		defer r.wg.Done()
+		ticker := time.NewTicker(r.cfg.CollectionInterval)
+		defer ticker.Stop()
+		for {
+			r.poll(pollCtx)
+			select {
+			case <-ticker.C:
+			case <-pollCtx.Done():
+				return
+			}
+		}
+	}()
+	return nil
+}
+
+func (r *stateChangeReceiver) poll(ctx context.Context) {
+	logs := plog.NewLogs()
+	observed := pcommon.NewTimestampFromTime(time.Now())
+	for _, lister := range r.listers {
+		processes, err := lister.processes(ctx)
+		if err != nil {
+			r.set.Logger.Error("failed to list the processes", zap.Error(err))
+			continue
+		}
+		for _, process := range processes {
+			previous, ok := r.states[process.key()]
+			r.states[process.key()] = process.state
+			if !ok || previous == process.state {
+				continue
+			}
+			r.appendStateChange(logs, observed, process, previous)
+		}
+	}
+	if logs.LogRecordCount() == 0 {
+		return
+	}
+	if err := r.next.ConsumeLogs(ctx, logs); err != nil {
+		r.set.Logger.Error("failed to consume the state changes", zap.Error(err))
+	}
+}
+
+func (r *stateChangeReceiver) appendStateChange(logs plog.Logs, observed pcommon.Timestamp, process processInfo, previous metadata.AttributeState) {
+	rl := logs.ResourceLogs().AppendEmpty()
+	resource := rl.Resource().Attributes()
+	resource.PutStr("supervisord.process.manager", process.manager)
+	resource.PutStr("supervisord.process.name", process.name)
+	if process.group != "" {
+		resource.PutStr("supervisord.process.group", process.group)
+	}
+	sl := rl.ScopeLogs().AppendEmpty()
+	sl.Scope().SetName("otelcol/supervisordreceiver")
+	sl.Scope().SetVersion(r.set.BuildInfo.Version)
+
+	lr := sl.LogRecords().AppendEmpty()
+	lr.SetTimestamp(observed)
+	lr.SetObservedTimestamp(observed)
+	lr.SetSeverityNumber(stateChangeSeverityNumber(process.state))
+	lr.SetSeverityText(lr.SeverityNumber().String())
+	lr.Body().SetStr(fmt.Sprintf("process %s changed state from %s to %s", process.name, previous.String(), process.state.String()))
+
+	attrs := lr.Attributes()
+	attrs.PutStr("supervisord.process.state", process.state.String())
+	attrs.PutStr("supervisord.process.previous_state", previous.String())
+	attrs.PutInt("supervisord.process.pid", process.pid)
+	attrs.PutInt("supervisord.process.exit_status", process.exitStatus)
+	if process.description != "" {
+		attrs.PutStr("supervisord.process.description", process.description)
+	}
+}
+
+// stateChangeSeverityNumber returns the severity of a change to the given state.
+func stateChangeSeverityNumber(state metadata.AttributeState) plog.SeverityNumber {
+	switch state {
+	case metadata.AttributeStateFatal, metadata.AttributeStateBackoff:
+		return plog.SeverityNumberError
+	case metadata.AttributeStateExited, metadata.AttributeStateUnknown:
+		return plog.SeverityNumberWarn
+	default:
+		return plog.SeverityNumberInfo
+	}
+}
+
+func (r *stateChangeReceiver) Shutdown(context.Context) error {
+	if r.cancel != nil {
+		r.cancel()
+	}
+	r.wg.Wait()
+	return nil
+}
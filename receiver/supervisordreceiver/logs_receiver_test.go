@@ -0,0 +1,64 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package supervisordreceiver
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/collector/consumer/consumertest"
+	"go.opentelemetry.io/collector/pdata/plog"
+	"go.opentelemetry.io/collector/receiver/receivertest"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/receiver/supervisordreceiver/internal/metadata"
+)
+
+func TestStateChangeReceiver(t *testing.T) {
+	running := processInfo{manager: managerSupervisord, name: "app", group: "web", state: metadata.AttributeStateRunning, pid: 1234, restarts: -1}
+	exited := processInfo{manager: managerSupervisord, name: "app", group: "web", state: metadata.AttributeStateExited, exitStatus: 137, description: "Nov 14 10:00 PM", restarts: -1}
+	backoff := exited
+	backoff.state = metadata.AttributeStateBackoff
+
+	sink := &consumertest.LogsSink{}
+	recv := newStateChangeReceiver(createDefaultConfig().(*Config), receivertest.NewNopCreateSettings(), sink)
+	recv.listers = []processLister{&fakeLister{lists: [][]processInfo{{running}, {running}, {exited}, {backoff}}}}
+
+	// the first states are the reference, and unchanged states aren't emitted
+	recv.poll(context.Background())
+	recv.poll(context.Background())
+	assert.Empty(t, sink.AllLogs())
+
+	recv.poll(context.Background())
+	recv.poll(context.Background())
+	require.Len(t, sink.AllLogs(), 2)
+
+	rl := sink.AllLogs()[0].ResourceLogs().At(0)
+	manager, _ := rl.Resource().Attributes().Get("supervisord.process.manager")
+	assert.Equal(t, managerSupervisord, manager.Str())
+	group, _ := rl.Resource().Attributes().Get("supervisord.process.group")
+	assert.Equal(t, "web", group.Str())
+
+	record := rl.ScopeLogs().At(0).LogRecords().At(0)
+	assert.Equal(t, "process app changed state from running to exited", record.Body().Str())
+	assert.Equal(t, plog.SeverityNumberWarn, record.SeverityNumber())
+	previous, _ := record.Attributes().Get("supervisord.process.previous_state")
+	assert.Equal(t, "running", previous.Str())
+	exitStatus, _ := record.Attributes().Get("supervisord.process.exit_status")
+	assert.Equal(t, int64(137), exitStatus.Int())
+
+	record = sink.AllLogs()[1].ResourceLogs().At(0).ScopeLogs().At(0).LogRecords().At(0)
+	assert.Equal(t, plog.SeverityNumberError, record.SeverityNumber())
+}
+
+func TestStateChangeReceiverListFailure(t *testing.T) {
+	sink := &consumertest.LogsSink{}
+	recv := newStateChangeReceiver(createDefaultConfig().(*Config), receivertest.NewNopCreateSettings(), sink)
+	recv.listers = []processLister{&fakeLister{err: errors.New("connection refused")}}
+	recv.poll(context.Background())
+	assert.Empty(t, sink.AllLogs())
+	assert.Empty(t, recv.states)
+}
@@ -0,0 +1,43 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package supervisordreceiver // import "github.com/open-telemetry/opentelemetry-collector-contrib/receiver/supervisordreceiver"
+
+import (
+	"context"
+	"time"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/receiver/supervisordreceiver/internal/metadata"
+)
+
+const (
+	managerSupervisord = "supervisord"
+	managerSystemd     = "systemd"
+)
+
+// processInfo is the state of a process, as reported by its manager.
+type processInfo struct {
+	manager     string
+	name        string
+	group       string
+	state       metadata.AttributeState
+	description string
+	pid         int64
+	exitStatus  int64
+	// start is the time the process was last started, zero when it never was
+	start time.Time
+	// uptime is the time since the process was started, while it is running
+	uptime time.Duration
+	// restarts is the number of restarts counted by the manager, -1 when the manager doesn't count them
+	restarts int64
+}
+
+// key identifies the process across the collections.
+func (p processInfo) key() string {
+	return p.manager + "/" + p.group + "/" + p.name
+}
+
+// processLister lists the processes of a manager.
+type processLister interface {
+	processes(ctx context.Context) ([]processInfo, error)
+}
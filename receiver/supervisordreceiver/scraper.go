@@ -0,0 +1,123 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package supervisordreceiver // import "github.com/open-telemetry/opentelemetry-collector-contrib/receiver/supervisordreceiver"
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"go.opentelemetry.io/collector/component"
+	"go.opentelemetry.io/collector/pdata/pcommon"
+	"go.opentelemetry.io/collector/pdata/pmetric"
+	"go.opentelemetry.io/collector/receiver"
+	"go.opentelemetry.io/collector/receiver/scrapererror"
+	"go.uber.org/zap"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/receiver/supervisordreceiver/internal/metadata"
+)
+
+// newProcessListers creates the listers of the managers enabled in the configuration.
+func newProcessListers(ctx context.Context, host component.Host, cfg *Config, settings component.TelemetrySettings) ([]processLister, error) {
+	var listers []processLister
+	if cfg.Endpoint != "" {
+		httpClient, err := cfg.ToClient(ctx, host, settings)
+		if err != nil {
+			return nil, err
+		}
+		client, err := newSupervisordClient(httpClient, cfg.Endpoint, cfg.Username, string(cfg.Password))
+		if err != nil {
+			return nil, err
+		}
+		listers = append(listers, client)
+	}
+	if len(cfg.Systemd.Units) > 0 {
+		listers = append(listers, newSystemdClient(cfg.Systemd.Units))
+	}
+	return listers, nil
+}
+
+type supervisordScraper struct {
+	settings component.TelemetrySettings
+	cfg      *Config
+	mb       *metadata.MetricsBuilder
+	listers  []processLister
+
+	// starts and restarts are the latest start time and the restarts observed of the supervisord processes
+	starts   map[string]time.Time
+	restarts map[string]int64
+}
+
+func newSupervisordScraper(settings receiver.CreateSettings, cfg *Config) *supervisordScraper {
+	return &supervisordScraper{
+		settings: settings.TelemetrySettings,
+		cfg:      cfg,
+		mb:       metadata.NewMetricsBuilder(cfg.MetricsBuilderConfig, settings),
+		starts:   map[string]time.Time{},
+		restarts: map[string]int64{},
+	}
+}
+
+func (s *supervisordScraper) start(ctx context.Context, host component.Host) error {
+	listers, err := newProcessListers(ctx, host, s.cfg, s.settings)
+	if err != nil {
+		return err
+	}
+	s.listers = listers
+	return nil
+}
+
+func (s *supervisordScraper) scrape(ctx context.Context) (pmetric.Metrics, error) {
+	if len(s.listers) == 0 {
+		return pmetric.NewMetrics(), errors.New("no process manager to scrape")
+	}
+
+	errs := &scrapererror.ScrapeErrors{}
+	now := pcommon.NewTimestampFromTime(time.Now())
+	for _, lister := range s.listers {
+		processes, err := lister.processes(ctx)
+		if err != nil {
+			s.settings.Logger.Error("failed to list the processes", zap.Error(err))
+			errs.Add(err)
+			continue
+		}
+		for _, process := range processes {
+			s.recordProcess(now, process)
+		}
+	}
+	return s.mb.Emit(), errs.Combine()
+}
+
+func (s *supervisordScraper) recordProcess(now pcommon.Timestamp, process processInfo) {
+	s.mb.RecordSupervisordProcessStateDataPoint(now, 1, process.state)
+	s.mb.RecordSupervisordProcessRestartsDataPoint(now, s.observeRestarts(process))
+	if process.state == metadata.AttributeStateRunning {
+		s.mb.RecordSupervisordProcessUptimeDataPoint(now, int64(process.uptime/time.Second))
+	}
+
+	rb := s.mb.NewResourceBuilder()
+	rb.SetSupervisordProcessManager(process.manager)
+	rb.SetSupervisordProcessName(process.name)
+	if process.group != "" {
+		rb.SetSupervisordProcessGroup(process.group)
+	}
+	s.mb.EmitForResource(metadata.WithResource(rb.Emit()))
+}
+
+// observeRestarts returns the restarts of the process. supervisord doesn't count them, so a new start time of a
+// process that was started before counts as a restart.
+func (s *supervisordScraper) observeRestarts(process processInfo) int64 {
+	if process.restarts >= 0 {
+		return process.restarts
+	}
+	key := process.key()
+	previous, ok := s.starts[key]
+	if ok && !previous.IsZero() && !process.start.IsZero() && !process.start.Equal(previous) {
+		s.restarts[key]++
+	}
+	if !process.start.IsZero() {
+		s.starts[key] = process.start
+	}
+	return s.restarts[key]
+}
@@ -0,0 +1,106 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package supervisordreceiver
+
+import (
+	"context"
+	"errors"
+	"net/http/httptest"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/collector/component"
+	"go.opentelemetry.io/collector/component/componenttest"
+	"go.opentelemetry.io/collector/receiver/receivertest"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/golden"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/pdatatest/pmetrictest"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/receiver/supervisordreceiver/internal/metadata"
+)
+
+// fakeLister returns the next list of processes at each call, then the last one.
+type fakeLister struct {
+	lists [][]processInfo
+	err   error
+}
+
+func (l *fakeLister) processes(context.Context) ([]processInfo, error) {
+	if l.err != nil {
+		return nil, l.err
+	}
+	processes := l.lists[0]
+	if len(l.lists) > 1 {
+		l.lists = l.lists[1:]
+	}
+	return processes, nil
+}
+
+func TestScraper(t *testing.T) {
+	srv := httptest.NewServer(newSupervisordHandler(t, "getAllProcessInfo.xml"))
+	defer srv.Close()
+
+	cfg := createDefaultConfig().(*Config)
+	cfg.Endpoint = srv.URL + "/RPC2"
+	cfg.Username = "user"
+	cfg.Password = "secret"
+	require.NoError(t, component.ValidateConfig(cfg))
+
+	scraper := newSupervisordScraper(receivertest.NewNopCreateSettings(), cfg)
+	require.NoError(t, scraper.start(context.Background(), componenttest.NewNopHost()))
+
+	actualMetrics, err := scraper.scrape(context.Background())
+	require.NoError(t, err)
+
+	expectedMetrics, err := golden.ReadMetrics(filepath.Join("testdata", "scraper", "expected.yaml"))
+	require.NoError(t, err)
+	require.NoError(t, pmetrictest.CompareMetrics(expectedMetrics, actualMetrics,
+		pmetrictest.IgnoreResourceMetricsOrder(), pmetrictest.IgnoreStartTimestamp(), pmetrictest.IgnoreTimestamp()))
+}
+
+func TestScraperRestarts(t *testing.T) {
+	process := processInfo{manager: managerSupervisord, name: "app", group: "web", state: metadata.AttributeStateRunning, restarts: -1}
+	started := func(start int64) processInfo {
+		p := process
+		p.start = time.Unix(start, 0)
+		return p
+	}
+	unit := processInfo{manager: managerSystemd, name: "app.service", state: metadata.AttributeStateRunning, restarts: 4}
+
+	scraper := newSupervisordScraper(receivertest.NewNopCreateSettings(), createDefaultConfig().(*Config))
+	// the first start isn't a restart, nor the unchanged start time
+	assert.Equal(t, int64(0), scraper.observeRestarts(process))
+	assert.Equal(t, int64(0), scraper.observeRestarts(started(100)))
+	assert.Equal(t, int64(0), scraper.observeRestarts(started(100)))
+	assert.Equal(t, int64(1), scraper.observeRestarts(started(200)))
+	assert.Equal(t, int64(2), scraper.observeRestarts(started(300)))
+	// systemd counts the restarts of the units
+	assert.Equal(t, int64(4), scraper.observeRestarts(unit))
+}
+
+func TestScraperPartialFailure(t *testing.T) {
+	scraper := newSupervisordScraper(receivertest.NewNopCreateSettings(), createDefaultConfig().(*Config))
+	scraper.listers = []processLister{
+		&fakeLister{err: errors.New("connection refused")},
+		&fakeLister{lists: [][]processInfo{{
+			{manager: managerSystemd, name: "app.service", state: metadata.AttributeStateStopped},
+		}}},
+	}
+
+	metrics, err := scraper.scrape(context.Background())
+	require.ErrorContains(t, err, "connection refused")
+	require.Equal(t, 1, metrics.ResourceMetrics().Len())
+	name, _ := metrics.ResourceMetrics().At(0).Resource().Attributes().Get("supervisord.process.name")
+	assert.Equal(t, "app.service", name.Str())
+	_, ok := metrics.ResourceMetrics().At(0).Resource().Attributes().Get("supervisord.process.group")
+	assert.False(t, ok)
+}
+
+func TestScraperNoListers(t *testing.T) {
+	scraper := newSupervisordScraper(receivertest.NewNopCreateSettings(), createDefaultConfig().(*Config))
+	_, err := scraper.scrape(context.Background())
+	require.Error(t, err)
+}
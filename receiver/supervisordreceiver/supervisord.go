@@ -0,0 +1,200 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package supervisordreceiver // import "github.com/open-telemetry/opentelemetry-collector-contrib/receiver/supervisordreceiver"
+
+import (
+	"bytes"
+	"context"
+	"encoding/xml"
+	"errors"
+	"fmt"
+	"net"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/receiver/supervisordreceiver/internal/metadata"
+)
+
+const getAllProcessInfoRequest = `<?xml version="1.0"?><methodCall><methodName>supervisor.getAllProcessInfo</methodName><params></params></methodCall>`
+
+// xmlrpcValue is an XML-RPC value, limited to the types returned by supervisord.
+type xmlrpcValue struct {
+	Int     *string       `xml:"int"`
+	I4      *string       `xml:"i4"`
+	Boolean *string       `xml:"boolean"`
+	String  *string       `xml:"string"`
+	Struct  *xmlrpcStruct `xml:"struct"`
+	Array   *xmlrpcArray  `xml:"array"`
+	// Text is the value of the untyped values, which are strings
+	Text string `xml:",chardata"`
+}
+
+type xmlrpcStruct struct {
+	Members []struct {
+		Name  string      `xml:"name"`
+		Value xmlrpcValue `xml:"value"`
+	} `xml:"member"`
+}
+
+type xmlrpcArray struct {
+	Values []xmlrpcValue `xml:"data>value"`
+}
+
+type xmlrpcResponse struct {
+	Params []xmlrpcValue `xml:"params>param>value"`
+	Fault  *xmlrpcValue  `xml:"fault>value"`
+}
+
+func (v xmlrpcValue) str() string {
+	if v.String != nil {
+		return *v.String
+	}
+	return strings.TrimSpace(v.Text)
+}
+
+func (v xmlrpcValue) int() (int64, error) {
+	switch {
+	case v.Int != nil:
+		return strconv.ParseInt(strings.TrimSpace(*v.Int), 10, 64)
+	case v.I4 != nil:
+		return strconv.ParseInt(strings.TrimSpace(*v.I4), 10, 64)
+	case v.Boolean != nil:
+		return strconv.ParseInt(strings.TrimSpace(*v.Boolean), 10, 64)
+	default:
+		return 0, fmt.Errorf("not an integer: %q", v.str())
+	}
+}
+
+func (s *xmlrpcStruct) members() map[string]xmlrpcValue {
+	members := make(map[string]xmlrpcValue, len(s.Members))
+	for _, member := range s.Members {
+		members[member.Name] = member.Value
+	}
+	return members
+}
+
+// supervisordClient lists the processes of supervisord through its XML-RPC interface.
+type supervisordClient struct {
+	client   *http.Client
+	endpoint string
+	username string
+	password string
+}
+
+var _ processLister = (*supervisordClient)(nil)
+
+// newSupervisordClient creates a client for the given endpoint. The unix:// endpoints are sent over the socket
+// with the given HTTP client settings, except its transport.
+func newSupervisordClient(client *http.Client, endpoint string, username string, password string) (*supervisordClient, error) {
+	u, err := url.Parse(endpoint)
+	if err != nil {
+		return nil, err
+	}
+	if u.Scheme == "unix" {
+		socket := u.Path
+		unixClient := *client
+		unixClient.Transport = &http.Transport{
+			DialContext: func(ctx context.Context, _, _ string) (net.Conn, error) {
+				return (&net.Dialer{}).DialContext(ctx, "unix", socket)
+			},
+		}
+		client = &unixClient
+		endpoint = "http://localhost/RPC2"
+	}
+	return &supervisordClient{
+		client:   client,
+		endpoint: endpoint,
+		username: username,
+		password: password,
+	}, nil
+}
+
+func (c *supervisordClient) processes(ctx context.Context) ([]processInfo, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, c.endpoint, bytes.NewBufferString(getAllProcessInfoRequest))
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Content-Type", "text/xml")
+	if c.username != "" {
+		req.SetBasicAuth(c.username, c.password)
+	}
+
+	resp, err := c.client.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("request POST %s failed - %q", c.endpoint, resp.Status)
+	}
+
+	var response xmlrpcResponse
+	if err = xml.NewDecoder(resp.Body).Decode(&response); err != nil {
+		return nil, fmt.Errorf("decoding the XML-RPC response: %w", err)
+	}
+	return parseProcessInfo(response)
+}
+
+// parseProcessInfo converts the response to supervisor.getAllProcessInfo.
+func parseProcessInfo(response xmlrpcResponse) ([]processInfo, error) {
+	if response.Fault != nil {
+		if response.Fault.Struct != nil {
+			fault := response.Fault.Struct.members()
+			return nil, fmt.Errorf("supervisord returned a fault: %s", fault["faultString"].str())
+		}
+		return nil, errors.New("supervisord returned a fault")
+	}
+	if len(response.Params) != 1 || response.Params[0].Array == nil {
+		return nil, errors.New("unexpected XML-RPC response: expected an array")
+	}
+
+	values := response.Params[0].Array.Values
+	processes := make([]processInfo, 0, len(values))
+	for _, value := range values {
+		if value.Struct == nil {
+			return nil, errors.New("unexpected XML-RPC response: expected a struct")
+		}
+		members := value.Struct.members()
+		process := processInfo{
+			manager:     managerSupervisord,
+			name:        members["name"].str(),
+			group:       members["group"].str(),
+			description: members["description"].str(),
+			restarts:    -1,
+		}
+		state, ok := metadata.MapAttributeState[strings.ToLower(members["statename"].str())]
+		if !ok {
+			state = metadata.AttributeStateUnknown
+		}
+		process.state = state
+
+		var err error
+		if process.pid, err = members["pid"].int(); err != nil {
+			return nil, fmt.Errorf("invalid pid of process %s: %w", process.name, err)
+		}
+		if process.exitStatus, err = members["exitstatus"].int(); err != nil {
+			return nil, fmt.Errorf("invalid exit status of process %s: %w", process.name, err)
+		}
+		start, err := members["start"].int()
+		if err != nil {
+			return nil, fmt.Errorf("invalid start time of process %s: %w", process.name, err)
+		}
+		if start > 0 {
+			process.start = time.Unix(start, 0)
+		}
+		if process.state == metadata.AttributeStateRunning {
+			now, err := members["now"].int()
+			if err != nil {
+				return nil, fmt.Errorf("invalid current time of process %s: %w", process.name, err)
+			}
+			process.uptime = time.Duration(now-start) * time.Second
+		}
+		processes = append(processes, process)
+	}
+	return processes, nil
+}
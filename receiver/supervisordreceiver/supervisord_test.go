@@ -0,0 +1,125 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package supervisordreceiver
+
+import (
+	"context"
+	"io"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/receiver/supervisordreceiver/internal/metadata"
+)
+
+// newSupervisordHandler serves the given response file to the XML-RPC requests authenticated with user:secret.
+func newSupervisordHandler(t *testing.T, file string) http.Handler {
+	response, err := os.ReadFile(filepath.Join("testdata", file))
+	require.NoError(t, err)
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		username, password, ok := r.BasicAuth()
+		if !ok || username != "user" || password != "secret" {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		body, err := io.ReadAll(r.Body)
+		assert.NoError(t, err)
+		assert.Equal(t, http.MethodPost, r.Method)
+		assert.Equal(t, getAllProcessInfoRequest, string(body))
+		w.Header().Set("Content-Type", "text/xml")
+		_, err = w.Write(response)
+		assert.NoError(t, err)
+	})
+}
+
+func TestSupervisordProcesses(t *testing.T) {
+	srv := httptest.NewServer(newSupervisordHandler(t, "getAllProcessInfo.xml"))
+	defer srv.Close()
+
+	client, err := newSupervisordClient(srv.Client(), srv.URL+"/RPC2", "user", "secret")
+	require.NoError(t, err)
+	processes, err := client.processes(context.Background())
+	require.NoError(t, err)
+	require.Equal(t, []processInfo{
+		{
+			manager:     managerSupervisord,
+			name:        "app",
+			group:       "web",
+			state:       metadata.AttributeStateRunning,
+			description: "pid 1234, uptime 0:10:00",
+			pid:         1234,
+			start:       time.Unix(1700000000, 0),
+			uptime:      10 * time.Minute,
+			restarts:    -1,
+		},
+		{
+			manager:     managerSupervisord,
+			name:        "worker",
+			group:       "workers",
+			state:       metadata.AttributeStateFatal,
+			description: "Exited too quickly (process log may have details)",
+			exitStatus:  1,
+			start:       time.Unix(1700000490, 0),
+			restarts:    -1,
+		},
+	}, processes)
+}
+
+func TestSupervisordProcessesUnixSocket(t *testing.T) {
+	socket := filepath.Join(t.TempDir(), "supervisor.sock")
+	listener, err := net.Listen("unix", socket)
+	require.NoError(t, err)
+	srv := &httptest.Server{
+		Listener: listener,
+		Config:   &http.Server{Handler: newSupervisordHandler(t, "getAllProcessInfo.xml"), ReadHeaderTimeout: time.Second},
+	}
+	srv.Start()
+	defer srv.Close()
+
+	client, err := newSupervisordClient(&http.Client{}, "unix://"+socket, "user", "secret")
+	require.NoError(t, err)
+	processes, err := client.processes(context.Background())
+	require.NoError(t, err)
+	require.Len(t, processes, 2)
+}
+
+func TestSupervisordProcessesErrors(t *testing.T) {
+	testCases := []struct {
+		desc     string
+		file     string
+		username string
+		err      string
+	}{
+		{
+			desc:     "fault",
+			file:     "fault.xml",
+			username: "user",
+			err:      "supervisord returned a fault: UNKNOWN_METHOD",
+		},
+		{
+			desc:     "unauthorized",
+			file:     "getAllProcessInfo.xml",
+			username: "other",
+			err:      `failed - "401 Unauthorized"`,
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.desc, func(t *testing.T) {
+			srv := httptest.NewServer(newSupervisordHandler(t, tc.file))
+			defer srv.Close()
+
+			client, err := newSupervisordClient(srv.Client(), srv.URL+"/RPC2", tc.username, "secret")
+			require.NoError(t, err)
+			_, err = client.processes(context.Background())
+			require.ErrorContains(t, err, tc.err)
+		})
+	}
+}
@@ -0,0 +1,155 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package supervisordreceiver // import "github.com/open-telemetry/opentelemetry-collector-contrib/receiver/supervisordreceiver"
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"fmt"
+	"os/exec"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/receiver/supervisordreceiver/internal/metadata"
+)
+
+// systemdTimestampLayout is the layout of the timestamps shown by systemctl.
+const systemdTimestampLayout = "Mon 2006-01-02 15:04:05 MST"
+
+var systemdProperties = []string{"Id", "Description", "ActiveState", "SubState", "MainPID", "ExecMainStatus", "NRestarts", "ActiveEnterTimestamp"}
+
+// systemdClient lists the systemd user services with systemctl, which doesn't require access to the user bus
+// from the collector.
+type systemdClient struct {
+	units []string
+	// run runs systemctl with the given arguments and returns its output
+	run func(ctx context.Context, args ...string) ([]byte, error)
+	now func() time.Time
+}
+
+var _ processLister = (*systemdClient)(nil)
+
+func newSystemdClient(units []string) *systemdClient {
+	return &systemdClient{
+		units: units,
+		run: func(ctx context.Context, args ...string) ([]byte, error) {
+			return exec.CommandContext(ctx, "systemctl", args...).Output()
+		},
+		now: time.Now,
+	}
+}
+
+func (c *systemdClient) processes(ctx context.Context) ([]processInfo, error) {
+	args := append([]string{"--user", "show", "--property=" + strings.Join(systemdProperties, ","), "--"}, c.units...)
+	out, err := c.run(ctx, args...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to show the systemd user units: %w", err)
+	}
+	return parseSystemdUnits(out, c.now())
+}
+
+// parseSystemdUnits converts the output of systemctl show, with the properties of each unit separated by an empty
+// line.
+func parseSystemdUnits(out []byte, now time.Time) ([]processInfo, error) {
+	var processes []processInfo
+	properties := map[string]string{}
+	flush := func() error {
+		if len(properties) == 0 {
+			return nil
+		}
+		process, err := systemdUnitProcess(properties, now)
+		if err != nil {
+			return err
+		}
+		processes = append(processes, process)
+		properties = map[string]string{}
+		return nil
+	}
+
+	scanner := bufio.NewScanner(bytes.NewReader(out))
+	for scanner.Scan() {
+		line := strings.TrimSpace(scanner.Text())
+		if line == "" {
+			if err := flush(); err != nil {
+				return nil, err
+			}
+			continue
+		}
+		key, value, ok := strings.Cut(line, "=")
+		if !ok {
+			return nil, fmt.Errorf("unexpected systemctl output: %q", line)
+		}
+		properties[key] = value
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, err
+	}
+	if err := flush(); err != nil {
+		return nil, err
+	}
+	return processes, nil
+}
+
+func systemdUnitProcess(properties map[string]string, now time.Time) (processInfo, error) {
+	process := processInfo{
+		manager:     managerSystemd,
+		name:        properties["Id"],
+		description: properties["Description"],
+		state:       systemdState(properties["ActiveState"], properties["SubState"]),
+	}
+
+	var err error
+	if process.pid, err = parseSystemdInt(properties["MainPID"]); err != nil {
+		return processInfo{}, fmt.Errorf("invalid main pid of unit %s: %w", process.name, err)
+	}
+	if process.exitStatus, err = parseSystemdInt(properties["ExecMainStatus"]); err != nil {
+		return processInfo{}, fmt.Errorf("invalid exit status of unit %s: %w", process.name, err)
+	}
+	if process.restarts, err = parseSystemdInt(properties["NRestarts"]); err != nil {
+		return processInfo{}, fmt.Errorf("invalid restarts of unit %s: %w", process.name, err)
+	}
+	if timestamp := properties["ActiveEnterTimestamp"]; timestamp != "" {
+		if process.start, err = time.Parse(systemdTimestampLayout, timestamp); err != nil {
+			return processInfo{}, fmt.Errorf("invalid active enter timestamp of unit %s: %w", process.name, err)
+		}
+	}
+	if process.state == metadata.AttributeStateRunning && !process.start.IsZero() {
+		process.uptime = now.Sub(process.start).Truncate(time.Second)
+	}
+	return process, nil
+}
+
+// parseSystemdInt parses an integer property, which is empty for the units that aren't loaded.
+func parseSystemdInt(value string) (int64, error) {
+	if value == "" {
+		return 0, nil
+	}
+	return strconv.ParseInt(value, 10, 64)
+}
+
+// systemdState maps the state of a unit to the supervisord process states.
+func systemdState(activeState string, subState string) metadata.AttributeState {
+	switch activeState {
+	case "active", "reloading":
+		if subState == "exited" {
+			return metadata.AttributeStateExited
+		}
+		return metadata.AttributeStateRunning
+	case "activating":
+		if subState == "auto-restart" {
+			return metadata.AttributeStateBackoff
+		}
+		return metadata.AttributeStateStarting
+	case "deactivating":
+		return metadata.AttributeStateStopping
+	case "inactive":
+		return metadata.AttributeStateStopped
+	case "failed":
+		return metadata.AttributeStateFatal
+	default:
+		return metadata.AttributeStateUnknown
+	}
+}
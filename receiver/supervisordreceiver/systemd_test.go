@@ -0,0 +1,97 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package supervisordreceiver
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/receiver/supervisordreceiver/internal/metadata"
+)
+
+const systemctlShowOutput = `Id=app.service
+Description=My application
+ActiveState=active
+SubState=running
+MainPID=4321
+ExecMainStatus=0
+NRestarts=3
+ActiveEnterTimestamp=Tue 2023-11-14 22:13:20 UTC
+
+Id=worker.service
+Description=My worker
+ActiveState=activating
+SubState=auto-restart
+MainPID=0
+ExecMainStatus=2
+NRestarts=7
+ActiveEnterTimestamp=
+`
+
+func TestSystemdProcesses(t *testing.T) {
+	client := newSystemdClient([]string{"app.service", "worker.service"})
+	client.run = func(_ context.Context, args ...string) ([]byte, error) {
+		assert.Equal(t, []string{
+			"--user", "show",
+			"--property=Id,Description,ActiveState,SubState,MainPID,ExecMainStatus,NRestarts,ActiveEnterTimestamp",
+			"--", "app.service", "worker.service",
+		}, args)
+		return []byte(systemctlShowOutput), nil
+	}
+	client.now = func() time.Time {
+		return time.Unix(1700000600, 0)
+	}
+
+	processes, err := client.processes(context.Background())
+	require.NoError(t, err)
+	require.Equal(t, []processInfo{
+		{
+			manager:     managerSystemd,
+			name:        "app.service",
+			description: "My application",
+			state:       metadata.AttributeStateRunning,
+			pid:         4321,
+			restarts:    3,
+			start:       time.Date(2023, 11, 14, 22, 13, 20, 0, time.UTC),
+			uptime:      10 * time.Minute,
+		},
+		{
+			manager:     managerSystemd,
+			name:        "worker.service",
+			description: "My worker",
+			state:       metadata.AttributeStateBackoff,
+			exitStatus:  2,
+			restarts:    7,
+		},
+	}, processes)
+}
+
+func TestSystemdProcessesError(t *testing.T) {
+	client := newSystemdClient([]string{"app.service"})
+	client.run = func(context.Context, ...string) ([]byte, error) {
+		return nil, errors.New("exit status 1")
+	}
+	_, err := client.processes(context.Background())
+	require.ErrorContains(t, err, "failed to show the systemd user units")
+
+	_, err = parseSystemdUnits([]byte("not a property\n"), time.Now())
+	require.ErrorContains(t, err, "unexpected systemctl output")
+}
+
+func TestSystemdState(t *testing.T) {
+	assert.Equal(t, metadata.AttributeStateRunning, systemdState("active", "running"))
+	assert.Equal(t, metadata.AttributeStateRunning, systemdState("reloading", "reload"))
+	assert.Equal(t, metadata.AttributeStateExited, systemdState("active", "exited"))
+	assert.Equal(t, metadata.AttributeStateStarting, systemdState("activating", "start"))
+	assert.Equal(t, metadata.AttributeStateBackoff, systemdState("activating", "auto-restart"))
+	assert.Equal(t, metadata.AttributeStateStopping, systemdState("deactivating", "stop-sigterm"))
+	assert.Equal(t, metadata.AttributeStateStopped, systemdState("inactive", "dead"))
+	assert.Equal(t, metadata.AttributeStateFatal, systemdState("failed", "failed"))
+	assert.Equal(t, metadata.AttributeStateUnknown, systemdState("maintenance", ""))
+}
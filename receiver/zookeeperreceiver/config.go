@@ -4,7 +4,11 @@
 package zookeeperreceiver // import "github.com/open-telemetry/opentelemetry-collector-contrib/receiver/zookeeperreceiver"
 
 import (
+	"fmt"
+	"net"
+
 	"go.opentelemetry.io/collector/config/confignet"
+	"go.opentelemetry.io/collector/config/configtls"
 	"go.opentelemetry.io/collector/receiver/scraperhelper"
 
 	"github.com/open-telemetry/opentelemetry-collector-contrib/receiver/zookeeperreceiver/internal/metadata"
@@ -13,5 +17,33 @@ import (
 type Config struct {
 	scraperhelper.ControllerConfig `mapstructure:",squash"`
 	confignet.TCPAddrConfig        `mapstructure:",squash"`
-	metadata.MetricsBuilderConfig  `mapstructure:",squash"`
+	// Endpoints lists the members of a Zookeeper ensemble to collect metrics from.
+	// When set, it takes precedence over Endpoint.
+	Endpoints []string `mapstructure:"endpoints"`
+	// TLS configures the connection used to send the four letter word commands.
+	// Plaintext connections are used by default.
+	TLS                           configtls.ClientConfig `mapstructure:"tls,omitempty"`
+	metadata.MetricsBuilderConfig `mapstructure:",squash"`
+}
+
+func (cfg *Config) Validate() error {
+	seen := make(map[string]struct{}, len(cfg.Endpoints))
+	for _, endpoint := range cfg.Endpoints {
+		if _, _, err := net.SplitHostPort(endpoint); err != nil {
+			return fmt.Errorf("invalid endpoint %q: %w", endpoint, err)
+		}
+		if _, ok := seen[endpoint]; ok {
+			return fmt.Errorf("duplicate endpoint %q", endpoint)
+		}
+		seen[endpoint] = struct{}{}
+	}
+	return nil
+}
+
+// targets returns the endpoints of the servers to collect metrics from.
+func (cfg *Config) targets() []string {
+	if len(cfg.Endpoints) > 0 {
+		return cfg.Endpoints
+	}
+	return []string{cfg.Endpoint}
 }
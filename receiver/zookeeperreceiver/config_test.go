@@ -0,0 +1,25 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package zookeeperreceiver
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/collector/component"
+)
+
+func TestValidate(t *testing.T) {
+	cfg := createDefaultConfig().(*Config)
+	require.NoError(t, component.ValidateConfig(cfg))
+
+	cfg.Endpoints = []string{"zk-0:2181", "zk-1:2181"}
+	require.NoError(t, component.ValidateConfig(cfg))
+
+	cfg.Endpoints = []string{"zk-0:2181", "zk-1"}
+	require.EqualError(t, component.ValidateConfig(cfg), `invalid endpoint "zk-1": address zk-1: missing port in address`)
+
+	cfg.Endpoints = []string{"zk-0:2181", "zk-0:2181"}
+	require.EqualError(t, component.ValidateConfig(cfg), `duplicate endpoint "zk-0:2181"`)
+}
@@ -48,6 +48,8 @@ func integrationTest(name string, image string, standalone bool) func(*testing.T
 			filepath.Join("testdata", "integration", fmt.Sprintf("expected-%s.yaml", name))),
 		scraperinttest.WithCompareOptions(
 			pmetrictest.IgnoreMetricValues(),
+			pmetrictest.IgnoreResourceAttributeValue("server.address"),
+			pmetrictest.IgnoreResourceAttributeValue("server.port"),
 			pmetrictest.IgnoreStartTimestamp(),
 			pmetrictest.IgnoreTimestamp(),
 		),
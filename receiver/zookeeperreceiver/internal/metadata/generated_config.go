@@ -31,6 +31,7 @@ type MetricsConfig struct {
 	ZookeeperConnectionActive            MetricConfig `mapstructure:"zookeeper.connection.active"`
 	ZookeeperDataTreeEphemeralNodeCount  MetricConfig `mapstructure:"zookeeper.data_tree.ephemeral_node.count"`
 	ZookeeperDataTreeSize                MetricConfig `mapstructure:"zookeeper.data_tree.size"`
+	ZookeeperEnsembleLeaderCount         MetricConfig `mapstructure:"zookeeper.ensemble.leader.count"`
 	ZookeeperFileDescriptorLimit         MetricConfig `mapstructure:"zookeeper.file_descriptor.limit"`
 	ZookeeperFileDescriptorOpen          MetricConfig `mapstructure:"zookeeper.file_descriptor.open"`
 	ZookeeperFollowerCount               MetricConfig `mapstructure:"zookeeper.follower.count"`
@@ -38,6 +39,7 @@ type MetricsConfig struct {
 	ZookeeperLatencyAvg                  MetricConfig `mapstructure:"zookeeper.latency.avg"`
 	ZookeeperLatencyMax                  MetricConfig `mapstructure:"zookeeper.latency.max"`
 	ZookeeperLatencyMin                  MetricConfig `mapstructure:"zookeeper.latency.min"`
+	ZookeeperLatencyPercentile           MetricConfig `mapstructure:"zookeeper.latency.percentile"`
 	ZookeeperPacketCount                 MetricConfig `mapstructure:"zookeeper.packet.count"`
 	ZookeeperRequestActive               MetricConfig `mapstructure:"zookeeper.request.active"`
 	ZookeeperRuok                        MetricConfig `mapstructure:"zookeeper.ruok"`
@@ -57,6 +59,9 @@ func DefaultMetricsConfig() MetricsConfig {
 		ZookeeperDataTreeSize: MetricConfig{
 			Enabled: true,
 		},
+		ZookeeperEnsembleLeaderCount: MetricConfig{
+			Enabled: true,
+		},
 		ZookeeperFileDescriptorLimit: MetricConfig{
 			Enabled: true,
 		},
@@ -78,6 +83,9 @@ func DefaultMetricsConfig() MetricsConfig {
 		ZookeeperLatencyMin: MetricConfig{
 			Enabled: true,
 		},
+		ZookeeperLatencyPercentile: MetricConfig{
+			Enabled: true,
+		},
 		ZookeeperPacketCount: MetricConfig{
 			Enabled: true,
 		},
@@ -127,12 +135,20 @@ func (rac *ResourceAttributeConfig) Unmarshal(parser *confmap.Conf) error {
 
 // ResourceAttributesConfig provides config for zookeeper resource attributes.
 type ResourceAttributesConfig struct {
-	ServerState ResourceAttributeConfig `mapstructure:"server.state"`
-	ZkVersion   ResourceAttributeConfig `mapstructure:"zk.version"`
+	ServerAddress ResourceAttributeConfig `mapstructure:"server.address"`
+	ServerPort    ResourceAttributeConfig `mapstructure:"server.port"`
+	ServerState   ResourceAttributeConfig `mapstructure:"server.state"`
+	ZkVersion     ResourceAttributeConfig `mapstructure:"zk.version"`
 }
 
 func DefaultResourceAttributesConfig() ResourceAttributesConfig {
 	return ResourceAttributesConfig{
+		ServerAddress: ResourceAttributeConfig{
+			Enabled: true,
+		},
+		ServerPort: ResourceAttributeConfig{
+			Enabled: true,
+		},
 		ServerState: ResourceAttributeConfig{
 			Enabled: true,
 		},
@@ -28,6 +28,7 @@ func TestMetricsBuilderConfig(t *testing.T) {
 					ZookeeperConnectionActive:            MetricConfig{Enabled: true},
 					ZookeeperDataTreeEphemeralNodeCount:  MetricConfig{Enabled: true},
 					ZookeeperDataTreeSize:                MetricConfig{Enabled: true},
+					ZookeeperEnsembleLeaderCount:         MetricConfig{Enabled: true},
 					ZookeeperFileDescriptorLimit:         MetricConfig{Enabled: true},
 					ZookeeperFileDescriptorOpen:          MetricConfig{Enabled: true},
 					ZookeeperFollowerCount:               MetricConfig{Enabled: true},
@@ -35,6 +36,7 @@ func TestMetricsBuilderConfig(t *testing.T) {
 					ZookeeperLatencyAvg:                  MetricConfig{Enabled: true},
 					ZookeeperLatencyMax:                  MetricConfig{Enabled: true},
 					ZookeeperLatencyMin:                  MetricConfig{Enabled: true},
+					ZookeeperLatencyPercentile:           MetricConfig{Enabled: true},
 					ZookeeperPacketCount:                 MetricConfig{Enabled: true},
 					ZookeeperRequestActive:               MetricConfig{Enabled: true},
 					ZookeeperRuok:                        MetricConfig{Enabled: true},
@@ -43,8 +45,10 @@ func TestMetricsBuilderConfig(t *testing.T) {
 					ZookeeperZnodeCount:                  MetricConfig{Enabled: true},
 				},
 				ResourceAttributes: ResourceAttributesConfig{
-					ServerState: ResourceAttributeConfig{Enabled: true},
-					ZkVersion:   ResourceAttributeConfig{Enabled: true},
+					ServerAddress: ResourceAttributeConfig{Enabled: true},
+					ServerPort:    ResourceAttributeConfig{Enabled: true},
+					ServerState:   ResourceAttributeConfig{Enabled: true},
+					ZkVersion:     ResourceAttributeConfig{Enabled: true},
 				},
 			},
 		},
@@ -55,6 +59,7 @@ func TestMetricsBuilderConfig(t *testing.T) {
 					ZookeeperConnectionActive:            MetricConfig{Enabled: false},
 					ZookeeperDataTreeEphemeralNodeCount:  MetricConfig{Enabled: false},
 					ZookeeperDataTreeSize:                MetricConfig{Enabled: false},
+					ZookeeperEnsembleLeaderCount:         MetricConfig{Enabled: false},
 					ZookeeperFileDescriptorLimit:         MetricConfig{Enabled: false},
 					ZookeeperFileDescriptorOpen:          MetricConfig{Enabled: false},
 					ZookeeperFollowerCount:               MetricConfig{Enabled: false},
@@ -62,6 +67,7 @@ func TestMetricsBuilderConfig(t *testing.T) {
 					ZookeeperLatencyAvg:                  MetricConfig{Enabled: false},
 					ZookeeperLatencyMax:                  MetricConfig{Enabled: false},
 					ZookeeperLatencyMin:                  MetricConfig{Enabled: false},
+					ZookeeperLatencyPercentile:           MetricConfig{Enabled: false},
 					ZookeeperPacketCount:                 MetricConfig{Enabled: false},
 					ZookeeperRequestActive:               MetricConfig{Enabled: false},
 					ZookeeperRuok:                        MetricConfig{Enabled: false},
@@ -70,8 +76,10 @@ func TestMetricsBuilderConfig(t *testing.T) {
 					ZookeeperZnodeCount:                  MetricConfig{Enabled: false},
 				},
 				ResourceAttributes: ResourceAttributesConfig{
-					ServerState: ResourceAttributeConfig{Enabled: false},
-					ZkVersion:   ResourceAttributeConfig{Enabled: false},
+					ServerAddress: ResourceAttributeConfig{Enabled: false},
+					ServerPort:    ResourceAttributeConfig{Enabled: false},
+					ServerState:   ResourceAttributeConfig{Enabled: false},
+					ZkVersion:     ResourceAttributeConfig{Enabled: false},
 				},
 			},
 		},
@@ -108,15 +116,19 @@ func TestResourceAttributesConfig(t *testing.T) {
 		{
 			name: "all_set",
 			want: ResourceAttributesConfig{
-				ServerState: ResourceAttributeConfig{Enabled: true},
-				ZkVersion:   ResourceAttributeConfig{Enabled: true},
+				ServerAddress: ResourceAttributeConfig{Enabled: true},
+				ServerPort:    ResourceAttributeConfig{Enabled: true},
+				ServerState:   ResourceAttributeConfig{Enabled: true},
+				ZkVersion:     ResourceAttributeConfig{Enabled: true},
 			},
 		},
 		{
 			name: "none_set",
 			want: ResourceAttributesConfig{
-				ServerState: ResourceAttributeConfig{Enabled: false},
-				ZkVersion:   ResourceAttributeConfig{Enabled: false},
+				ServerAddress: ResourceAttributeConfig{Enabled: false},
+				ServerPort:    ResourceAttributeConfig{Enabled: false},
+				ServerState:   ResourceAttributeConfig{Enabled: false},
+				ZkVersion:     ResourceAttributeConfig{Enabled: false},
 			},
 		},
 	}
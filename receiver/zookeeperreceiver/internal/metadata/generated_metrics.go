@@ -38,6 +38,66 @@ var MapAttributeDirection = map[string]AttributeDirection{
 	"sent":     AttributeDirectionSent,
 }
 
+// AttributeOperation specifies the a value operation attribute.
+type AttributeOperation int
+
+const (
+	_ AttributeOperation = iota
+	AttributeOperationRead
+	AttributeOperationUpdate
+)
+
+// String returns the string representation of the AttributeOperation.
+func (av AttributeOperation) String() string {
+	switch av {
+	case AttributeOperationRead:
+		return "read"
+	case AttributeOperationUpdate:
+		return "update"
+	}
+	return ""
+}
+
+// MapAttributeOperation is a helper map of string to AttributeOperation attribute value.
+var MapAttributeOperation = map[string]AttributeOperation{
+	"read":   AttributeOperationRead,
+	"update": AttributeOperationUpdate,
+}
+
+// AttributePercentile specifies the a value percentile attribute.
+type AttributePercentile int
+
+const (
+	_ AttributePercentile = iota
+	AttributePercentileP50
+	AttributePercentileP95
+	AttributePercentileP99
+	AttributePercentileP999
+)
+
+// String returns the string representation of the AttributePercentile.
+func (av AttributePercentile) String() string {
+	switch av {
+	case AttributePercentileP50:
+		return "p50"
+	case AttributePercentileP95:
+		return "p95"
+	case AttributePercentileP99:
+		return "p99"
+	case AttributePercentileP999:
+		return "p999"
+	}
+	return ""
+}
+
+// MapAttributePercentile is a helper map of string to AttributePercentile attribute value.
+var MapAttributePercentile = map[string]AttributePercentile{
+	"p50":  AttributePercentileP50,
+	"p95":  AttributePercentileP95,
+	"p99":  AttributePercentileP99,
+	"p999": AttributePercentileP999,
+}
+
 // AttributeState specifies the a value state attribute.
 type AttributeState int
 
@@ -217,6 +277,55 @@ func newMetricZookeeperDataTreeSize(cfg MetricConfig) metricZookeeperDataTreeSiz
 	return m
 }
 
+type metricZookeeperEnsembleLeaderCount struct {
+	data     pmetric.Metric // data buffer for generated metric.
+	config   MetricConfig   // metric config provided by user.
+	capacity int            // max observed number of data points added to the metric.
+}
+
+// init fills zookeeper.ensemble.leader.count metric with initial data.
+func (m *metricZookeeperEnsembleLeaderCount) init() {
+	m.data.SetName("zookeeper.ensemble.leader.count")
+	m.data.SetDescription("The number of ensemble members reporting themselves as leader. Only reported when multiple endpoints are configured.")
+	m.data.SetUnit("{servers}")
+	m.data.SetEmptyGauge()
+}
+
+func (m *metricZookeeperEnsembleLeaderCount) recordDataPoint(start pcommon.Timestamp, ts pcommon.Timestamp, val int64) {
+	if !m.config.Enabled {
+		return
+	}
+	dp := m.data.Gauge().DataPoints().AppendEmpty()
+	dp.SetStartTimestamp(start)
+	dp.SetTimestamp(ts)
+	dp.SetIntValue(val)
+}
+
+// updateCapacity saves max length of data point slices that will be used for the slice capacity.
+func (m *metricZookeeperEnsembleLeaderCount) updateCapacity() {
+	if m.data.Gauge().DataPoints().Len() > m.capacity {
+		m.capacity = m.data.Gauge().DataPoints().Len()
+	}
+}
+
+// emit appends recorded metric data to a metrics slice and prepares it for recording another set of data points.
+func (m *metricZookeeperEnsembleLeaderCount) emit(metrics pmetric.MetricSlice) {
+	if m.config.Enabled && m.data.Gauge().DataPoints().Len() > 0 {
+		m.updateCapacity()
+		m.data.MoveTo(metrics.AppendEmpty())
+		m.init()
+	}
+}
+
+func newMetricZookeeperEnsembleLeaderCount(cfg MetricConfig) metricZookeeperEnsembleLeaderCount {
+	m := metricZookeeperEnsembleLeaderCount{config: cfg}
+	if cfg.Enabled {
+		m.data = pmetric.NewMetric()
+		m.init()
+	}
+	return m
+}
+
 type metricZookeeperFileDescriptorLimit struct {
 	data     pmetric.Metric // data buffer for generated metric.
 	config   MetricConfig   // metric config provided by user.
@@ -568,6 +677,58 @@ func newMetricZookeeperLatencyMin(cfg MetricConfig) metricZookeeperLatencyMin {
 	return m
 }
 
+type metricZookeeperLatencyPercentile struct {
+	data     pmetric.Metric // data buffer for generated metric.
+	config   MetricConfig   // metric config provided by user.
+	capacity int            // max observed number of data points added to the metric.
+}
+
+// init fills zookeeper.latency.percentile metric with initial data.
+func (m *metricZookeeperLatencyPercentile) init() {
+	m.data.SetName("zookeeper.latency.percentile")
+	m.data.SetDescription("Percentiles of the time in milliseconds for requests to be processed. Only exposed by Zookeeper 3.6 and later.")
+	m.data.SetUnit("ms")
+	m.data.SetEmptyGauge()
+	m.data.Gauge().DataPoints().EnsureCapacity(m.capacity)
+}
+
+func (m *metricZookeeperLatencyPercentile) recordDataPoint(start pcommon.Timestamp, ts pcommon.Timestamp, val float64, operationAttributeValue string, percentileAttributeValue string) {
+	if !m.config.Enabled {
+		return
+	}
+	dp := m.data.Gauge().DataPoints().AppendEmpty()
+	dp.SetStartTimestamp(start)
+	dp.SetTimestamp(ts)
+	dp.SetDoubleValue(val)
+	dp.Attributes().PutStr("operation", operationAttributeValue)
+	dp.Attributes().PutStr("percentile", percentileAttributeValue)
+}
+
+// updateCapacity saves max length of data point slices that will be used for the slice capacity.
+func (m *metricZookeeperLatencyPercentile) updateCapacity() {
+	if m.data.Gauge().DataPoints().Len() > m.capacity {
+		m.capacity = m.data.Gauge().DataPoints().Len()
+	}
+}
+
+// emit appends recorded metric data to a metrics slice and prepares it for recording another set of data points.
+func (m *metricZookeeperLatencyPercentile) emit(metrics pmetric.MetricSlice) {
+	if m.config.Enabled && m.data.Gauge().DataPoints().Len() > 0 {
+		m.updateCapacity()
+		m.data.MoveTo(metrics.AppendEmpty())
+		m.init()
+	}
+}
+
+func newMetricZookeeperLatencyPercentile(cfg MetricConfig) metricZookeeperLatencyPercentile {
+	m := metricZookeeperLatencyPercentile{config: cfg}
+	if cfg.Enabled {
+		m.data = pmetric.NewMetric()
+		m.init()
+	}
+	return m
+}
+
 type metricZookeeperPacketCount struct {
 	data     pmetric.Metric // data buffer for generated metric.
 	config   MetricConfig   // metric config provided by user.
@@ -887,6 +1048,7 @@ type MetricsBuilder struct {
 	metricZookeeperConnectionActive            metricZookeeperConnectionActive
 	metricZookeeperDataTreeEphemeralNodeCount  metricZookeeperDataTreeEphemeralNodeCount
 	metricZookeeperDataTreeSize                metricZookeeperDataTreeSize
+	metricZookeeperEnsembleLeaderCount         metricZookeeperEnsembleLeaderCount
 	metricZookeeperFileDescriptorLimit         metricZookeeperFileDescriptorLimit
 	metricZookeeperFileDescriptorOpen          metricZookeeperFileDescriptorOpen
 	metricZookeeperFollowerCount               metricZookeeperFollowerCount
@@ -894,6 +1056,7 @@ type MetricsBuilder struct {
 	metricZookeeperLatencyAvg                  metricZookeeperLatencyAvg
 	metricZookeeperLatencyMax                  metricZookeeperLatencyMax
 	metricZookeeperLatencyMin                  metricZookeeperLatencyMin
+	metricZookeeperLatencyPercentile           metricZookeeperLatencyPercentile
 	metricZookeeperPacketCount                 metricZookeeperPacketCount
 	metricZookeeperRequestActive               metricZookeeperRequestActive
 	metricZookeeperRuok                        metricZookeeperRuok
@@ -921,6 +1084,7 @@ func NewMetricsBuilder(mbc MetricsBuilderConfig, settings receiver.CreateSetting
 		metricZookeeperConnectionActive: newMetricZookeeperConnectionActive(mbc.Metrics.ZookeeperConnectionActive),
 		metricZookeeperDataTreeEphemeralNodeCount:  newMetricZookeeperDataTreeEphemeralNodeCount(mbc.Metrics.ZookeeperDataTreeEphemeralNodeCount),
 		metricZookeeperDataTreeSize:                newMetricZookeeperDataTreeSize(mbc.Metrics.ZookeeperDataTreeSize),
+		metricZookeeperEnsembleLeaderCount:         newMetricZookeeperEnsembleLeaderCount(mbc.Metrics.ZookeeperEnsembleLeaderCount),
 		metricZookeeperFileDescriptorLimit:         newMetricZookeeperFileDescriptorLimit(mbc.Metrics.ZookeeperFileDescriptorLimit),
 		metricZookeeperFileDescriptorOpen:          newMetricZookeeperFileDescriptorOpen(mbc.Metrics.ZookeeperFileDescriptorOpen),
 		metricZookeeperFollowerCount:               newMetricZookeeperFollowerCount(mbc.Metrics.ZookeeperFollowerCount),
@@ -928,6 +1092,7 @@ func NewMetricsBuilder(mbc MetricsBuilderConfig, settings receiver.CreateSetting
 		metricZookeeperLatencyAvg:                  newMetricZookeeperLatencyAvg(mbc.Metrics.ZookeeperLatencyAvg),
 		metricZookeeperLatencyMax:                  newMetricZookeeperLatencyMax(mbc.Metrics.ZookeeperLatencyMax),
 		metricZookeeperLatencyMin:                  newMetricZookeeperLatencyMin(mbc.Metrics.ZookeeperLatencyMin),
+		metricZookeeperLatencyPercentile:           newMetricZookeeperLatencyPercentile(mbc.Metrics.ZookeeperLatencyPercentile),
 		metricZookeeperPacketCount:                 newMetricZookeeperPacketCount(mbc.Metrics.ZookeeperPacketCount),
 		metricZookeeperRequestActive:               newMetricZookeeperRequestActive(mbc.Metrics.ZookeeperRequestActive),
 		metricZookeeperRuok:                        newMetricZookeeperRuok(mbc.Metrics.ZookeeperRuok),
@@ -937,6 +1102,18 @@ func NewMetricsBuilder(mbc MetricsBuilderConfig, settings receiver.CreateSetting
 		resourceAttributeIncludeFilter:             make(map[string]filter.Filter),
 		resourceAttributeExcludeFilter:             make(map[string]filter.Filter),
 	}
+	if mbc.ResourceAttributes.ServerAddress.MetricsInclude != nil {
+		mb.resourceAttributeIncludeFilter["server.address"] = filter.CreateFilter(mbc.ResourceAttributes.ServerAddress.MetricsInclude)
+	}
+	if mbc.ResourceAttributes.ServerAddress.MetricsExclude != nil {
+		mb.resourceAttributeExcludeFilter["server.address"] = filter.CreateFilter(mbc.ResourceAttributes.ServerAddress.MetricsExclude)
+	}
+	if mbc.ResourceAttributes.ServerPort.MetricsInclude != nil {
+		mb.resourceAttributeIncludeFilter["server.port"] = filter.CreateFilter(mbc.ResourceAttributes.ServerPort.MetricsInclude)
+	}
+	if mbc.ResourceAttributes.ServerPort.MetricsExclude != nil {
+		mb.resourceAttributeExcludeFilter["server.port"] = filter.CreateFilter(mbc.ResourceAttributes.ServerPort.MetricsExclude)
+	}
 	if mbc.ResourceAttributes.ServerState.MetricsInclude != nil {
 		mb.resourceAttributeIncludeFilter["server.state"] = filter.CreateFilter(mbc.ResourceAttributes.ServerState.MetricsInclude)
 	}
@@ -1013,6 +1190,7 @@ func (mb *MetricsBuilder) EmitForResource(rmo ...ResourceMetricsOption) {
 	mb.metricZookeeperConnectionActive.emit(ils.Metrics())
 	mb.metricZookeeperDataTreeEphemeralNodeCount.emit(ils.Metrics())
 	mb.metricZookeeperDataTreeSize.emit(ils.Metrics())
+	mb.metricZookeeperEnsembleLeaderCount.emit(ils.Metrics())
 	mb.metricZookeeperFileDescriptorLimit.emit(ils.Metrics())
 	mb.metricZookeeperFileDescriptorOpen.emit(ils.Metrics())
 	mb.metricZookeeperFollowerCount.emit(ils.Metrics())
@@ -1020,6 +1198,7 @@ func (mb *MetricsBuilder) EmitForResource(rmo ...ResourceMetricsOption) {
 	mb.metricZookeeperLatencyAvg.emit(ils.Metrics())
 	mb.metricZookeeperLatencyMax.emit(ils.Metrics())
 	mb.metricZookeeperLatencyMin.emit(ils.Metrics())
+	mb.metricZookeeperLatencyPercentile.emit(ils.Metrics())
 	mb.metricZookeeperPacketCount.emit(ils.Metrics())
 	mb.metricZookeeperRequestActive.emit(ils.Metrics())
 	mb.metricZookeeperRuok.emit(ils.Metrics())
@@ -1072,6 +1251,11 @@ func (mb *MetricsBuilder) RecordZookeeperDataTreeSizeDataPoint(ts pcommon.Timest
 	mb.metricZookeeperDataTreeSize.recordDataPoint(mb.startTime, ts, val)
 }
 
+// RecordZookeeperEnsembleLeaderCountDataPoint adds a data point to zookeeper.ensemble.leader.count metric.
+func (mb *MetricsBuilder) RecordZookeeperEnsembleLeaderCountDataPoint(ts pcommon.Timestamp, val int64) {
+	mb.metricZookeeperEnsembleLeaderCount.recordDataPoint(mb.startTime, ts, val)
+}
+
 // RecordZookeeperFileDescriptorLimitDataPoint adds a data point to zookeeper.file_descriptor.limit metric.
 func (mb *MetricsBuilder) RecordZookeeperFileDescriptorLimitDataPoint(ts pcommon.Timestamp, val int64) {
 	mb.metricZookeeperFileDescriptorLimit.recordDataPoint(mb.startTime, ts, val)
@@ -1107,6 +1291,11 @@ func (mb *MetricsBuilder) RecordZookeeperLatencyMinDataPoint(ts pcommon.Timestam
 	mb.metricZookeeperLatencyMin.recordDataPoint(mb.startTime, ts, val)
 }
 
+// RecordZookeeperLatencyPercentileDataPoint adds a data point to zookeeper.latency.percentile metric.
+func (mb *MetricsBuilder) RecordZookeeperLatencyPercentileDataPoint(ts pcommon.Timestamp, val float64, operationAttributeValue AttributeOperation, percentileAttributeValue AttributePercentile) {
+	mb.metricZookeeperLatencyPercentile.recordDataPoint(mb.startTime, ts, val, operationAttributeValue.String(), percentileAttributeValue.String())
+}
+
 // RecordZookeeperPacketCountDataPoint adds a data point to zookeeper.packet.count metric.
 func (mb *MetricsBuilder) RecordZookeeperPacketCountDataPoint(ts pcommon.Timestamp, val int64, directionAttributeValue AttributeDirection) {
 	mb.metricZookeeperPacketCount.recordDataPoint(mb.startTime, ts, val, directionAttributeValue.String())
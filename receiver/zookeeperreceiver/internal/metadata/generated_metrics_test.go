@@ -80,6 +80,10 @@ func TestMetricsBuilder(t *testing.T) {
 			allMetricsCount++
 			mb.RecordZookeeperDataTreeSizeDataPoint(ts, 1)
 
+			defaultMetricsCount++
+			allMetricsCount++
+			mb.RecordZookeeperEnsembleLeaderCountDataPoint(ts, 1)
+
 			defaultMetricsCount++
 			allMetricsCount++
 			mb.RecordZookeeperFileDescriptorLimitDataPoint(ts, 1)
@@ -108,6 +112,10 @@ func TestMetricsBuilder(t *testing.T) {
 			allMetricsCount++
 			mb.RecordZookeeperLatencyMinDataPoint(ts, 1)
 
+			defaultMetricsCount++
+			allMetricsCount++
+			mb.RecordZookeeperLatencyPercentileDataPoint(ts, 1, AttributeOperationRead, AttributePercentileP50)
+
 			defaultMetricsCount++
 			allMetricsCount++
 			mb.RecordZookeeperPacketCountDataPoint(ts, 1, AttributeDirectionReceived)
@@ -133,6 +141,8 @@ func TestMetricsBuilder(t *testing.T) {
 			mb.RecordZookeeperZnodeCountDataPoint(ts, 1)
 
 			rb := mb.NewResourceBuilder()
+			rb.SetServerAddress("server.address-val")
+			rb.SetServerPort(11)
 			rb.SetServerState("server.state-val")
 			rb.SetZkVersion("zk.version-val")
 			res := rb.Emit()
@@ -199,6 +209,18 @@ func TestMetricsBuilder(t *testing.T) {
 					assert.Equal(t, ts, dp.Timestamp())
 					assert.Equal(t, pmetric.NumberDataPointValueTypeInt, dp.ValueType())
 					assert.Equal(t, int64(1), dp.IntValue())
+				case "zookeeper.ensemble.leader.count":
+					assert.False(t, validatedMetrics["zookeeper.ensemble.leader.count"], "Found a duplicate in the metrics slice: zookeeper.ensemble.leader.count")
+					validatedMetrics["zookeeper.ensemble.leader.count"] = true
+					assert.Equal(t, pmetric.MetricTypeGauge, ms.At(i).Type())
+					assert.Equal(t, 1, ms.At(i).Gauge().DataPoints().Len())
+					assert.Equal(t, "The number of ensemble members reporting themselves as leader. Only reported when multiple endpoints are configured.", ms.At(i).Description())
+					assert.Equal(t, "{servers}", ms.At(i).Unit())
+					dp := ms.At(i).Gauge().DataPoints().At(0)
+					assert.Equal(t, start, dp.StartTimestamp())
+					assert.Equal(t, ts, dp.Timestamp())
+					assert.Equal(t, pmetric.NumberDataPointValueTypeInt, dp.ValueType())
+					assert.Equal(t, int64(1), dp.IntValue())
 				case "zookeeper.file_descriptor.limit":
 					assert.False(t, validatedMetrics["zookeeper.file_descriptor.limit"], "Found a duplicate in the metrics slice: zookeeper.file_descriptor.limit")
 					validatedMetrics["zookeeper.file_descriptor.limit"] = true
@@ -292,6 +314,24 @@ func TestMetricsBuilder(t *testing.T) {
 					assert.Equal(t, ts, dp.Timestamp())
 					assert.Equal(t, pmetric.NumberDataPointValueTypeInt, dp.ValueType())
 					assert.Equal(t, int64(1), dp.IntValue())
+				case "zookeeper.latency.percentile":
+					assert.False(t, validatedMetrics["zookeeper.latency.percentile"], "Found a duplicate in the metrics slice: zookeeper.latency.percentile")
+					validatedMetrics["zookeeper.latency.percentile"] = true
+					assert.Equal(t, pmetric.MetricTypeGauge, ms.At(i).Type())
+					assert.Equal(t, 1, ms.At(i).Gauge().DataPoints().Len())
+					assert.Equal(t, "Percentiles of the time in milliseconds for requests to be processed. Only exposed by Zookeeper 3.6 and later.", ms.At(i).Description())
+					assert.Equal(t, "ms", ms.At(i).Unit())
+					dp := ms.At(i).Gauge().DataPoints().At(0)
+					assert.Equal(t, start, dp.StartTimestamp())
+					assert.Equal(t, ts, dp.Timestamp())
+					assert.Equal(t, pmetric.NumberDataPointValueTypeDouble, dp.ValueType())
+					assert.Equal(t, float64(1), dp.DoubleValue())
+					attrVal, ok := dp.Attributes().Get("operation")
+					assert.True(t, ok)
+					assert.EqualValues(t, "read", attrVal.Str())
+					attrVal, ok = dp.Attributes().Get("percentile")
+					assert.True(t, ok)
+					assert.EqualValues(t, "p50", attrVal.Str())
 				case "zookeeper.packet.count":
 					assert.False(t, validatedMetrics["zookeeper.packet.count"], "Found a duplicate in the metrics slice: zookeeper.packet.count")
 					validatedMetrics["zookeeper.packet.count"] = true
@@ -13,6 +13,8 @@ func TestResourceBuilder(t *testing.T) {
 		t.Run(test, func(t *testing.T) {
 			cfg := loadResourceAttributesConfig(t, test)
 			rb := NewResourceBuilder(cfg)
+			rb.SetServerAddress("server.address-val")
+			rb.SetServerPort(11)
 			rb.SetServerState("server.state-val")
 			rb.SetZkVersion("zk.version-val")
 
@@ -21,9 +23,9 @@ func TestResourceBuilder(t *testing.T) {
 
 			switch test {
 			case "default":
-				assert.Equal(t, 2, res.Attributes().Len())
+				assert.Equal(t, 4, res.Attributes().Len())
 			case "all_set":
-				assert.Equal(t, 2, res.Attributes().Len())
+				assert.Equal(t, 4, res.Attributes().Len())
 			case "none_set":
 				assert.Equal(t, 0, res.Attributes().Len())
 				return
@@ -31,7 +33,17 @@ func TestResourceBuilder(t *testing.T) {
 				assert.Failf(t, "unexpected test case: %s", test)
 			}
 
-			val, ok := res.Attributes().Get("server.state")
+			val, ok := res.Attributes().Get("server.address")
+			assert.True(t, ok)
+			if ok {
+				assert.EqualValues(t, "server.address-val", val.Str())
+			}
+			val, ok = res.Attributes().Get("server.port")
+			assert.True(t, ok)
+			if ok {
+				assert.EqualValues(t, 11, val.Int())
+			}
+			val, ok = res.Attributes().Get("server.state")
 			assert.True(t, ok)
 			if ok {
 				assert.EqualValues(t, "server.state-val", val.Str())
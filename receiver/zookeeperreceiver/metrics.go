@@ -5,6 +5,7 @@ package zookeeperreceiver // import "github.com/open-telemetry/opentelemetry-col
 
 import (
 	"fmt"
+	"regexp"
 
 	"go.opentelemetry.io/collector/pdata/pcommon"
 	"go.uber.org/zap"
@@ -40,6 +41,19 @@ const (
 	ruokKey = "ruok"
 )
 
+// latencyPercentileRE matches the latency percentiles exposed in the "mntr" output by Zookeeper 3.6+,
+// e.g. zk_p99_readlatency.
+var latencyPercentileRE = regexp.MustCompile(`^zk_(p50|p95|p99|p999)_(read|update)latency$`)
+
+// latencyPercentile returns the operation and percentile of a latency percentile entry of the "mntr" output.
+func latencyPercentile(metricKey string) (metadata.AttributeOperation, metadata.AttributePercentile, bool) {
+	parts := latencyPercentileRE.FindStringSubmatch(metricKey)
+	if parts == nil {
+		return 0, 0, false
+	}
+	return metadata.MapAttributeOperation[parts[2]], metadata.MapAttributePercentile[parts[1]], true
+}
+
 // metricCreator handles generation of metric and metric recording
 type metricCreator struct {
 	computedMetricStore map[string]int64
@@ -6,6 +6,7 @@ package zookeeperreceiver // import "github.com/open-telemetry/opentelemetry-col
 import (
 	"bufio"
 	"context"
+	"crypto/tls"
 	"errors"
 	"fmt"
 	"net"
@@ -13,9 +14,12 @@ import (
 	"strconv"
 	"time"
 
+	"go.opentelemetry.io/collector/config/confignet"
 	"go.opentelemetry.io/collector/pdata/pcommon"
 	"go.opentelemetry.io/collector/pdata/pmetric"
 	"go.opentelemetry.io/collector/receiver"
+	"go.opentelemetry.io/collector/receiver/scrapererror"
+	"go.uber.org/multierr"
 	"go.uber.org/zap"
 
 	"github.com/open-telemetry/opentelemetry-collector-contrib/receiver/zookeeperreceiver/internal/metadata"
@@ -26,6 +30,8 @@ var zookeeperFormatRE = regexp.MustCompile(`(^zk_\w+)\s+([\w\.\-]+)`)
 const (
 	mntrCommand = "mntr"
 	ruokCommand = "ruok"
+
+	leaderServerState = "leader"
 )
 
 type zookeeperMetricsScraper struct {
@@ -35,6 +41,8 @@ type zookeeperMetricsScraper struct {
 	rb     *metadata.ResourceBuilder
 	mb     *metadata.MetricsBuilder
 
+	tlsConfig *tls.Config
+
 	// For mocking.
 	closeConnection       func(net.Conn) error
 	setConnectionDeadline func(net.Conn, time.Time) error
@@ -46,20 +54,27 @@ func (z *zookeeperMetricsScraper) Name() string {
 }
 
 func newZookeeperMetricsScraper(settings receiver.CreateSettings, config *Config) (*zookeeperMetricsScraper, error) {
-	_, _, err := net.SplitHostPort(config.TCPAddrConfig.Endpoint)
-	if err != nil {
-		return nil, err
+	for _, endpoint := range config.targets() {
+		if _, _, err := net.SplitHostPort(endpoint); err != nil {
+			return nil, err
+		}
 	}
 
 	if config.Timeout <= 0 {
 		return nil, errors.New("timeout must be a positive duration")
 	}
 
+	tlsConfig, err := config.TLS.LoadTLSConfig(context.Background())
+	if err != nil {
+		return nil, err
+	}
+
 	z := &zookeeperMetricsScraper{
 		logger:                settings.Logger,
 		config:                config,
 		rb:                    metadata.NewResourceBuilder(config.ResourceAttributes),
 		mb:                    metadata.NewMetricsBuilder(config.MetricsBuilderConfig, settings),
+		tlsConfig:             tlsConfig,
 		closeConnection:       closeConnection,
 		setConnectionDeadline: setConnectionDeadline,
 		sendCmd:               sendCmd,
@@ -77,32 +92,83 @@ func (z *zookeeperMetricsScraper) shutdown(_ context.Context) error {
 }
 
 func (z *zookeeperMetricsScraper) scrape(ctx context.Context) (pmetric.Metrics, error) {
-	responseMntr, err := z.runCommand(ctx, "mntr")
+	targets := z.config.targets()
+
+	var errs error
+	scraped, leaders := 0, 0
+	for _, endpoint := range targets {
+		state, err := z.scrapeServer(ctx, endpoint)
+		if err != nil {
+			errs = multierr.Append(errs, err)
+			continue
+		}
+		scraped++
+		if state == leaderServerState {
+			leaders++
+		}
+	}
+	if scraped == 0 {
+		return pmetric.NewMetrics(), errs
+	}
+
+	// In ensemble mode, exactly one member is expected to be the leader. Anything else
+	// means that the ensemble lost its quorum, or that some members are unreachable.
+	if len(targets) > 1 {
+		if leaders != 1 {
+			z.logger.Warn("unexpected number of leaders in the ensemble",
+				zap.Int("leaders", leaders),
+				zap.Int("members", scraped),
+			)
+		}
+		z.mb.RecordZookeeperEnsembleLeaderCountDataPoint(pcommon.NewTimestampFromTime(time.Now()), int64(leaders))
+	}
+
+	metrics := z.mb.Emit()
+	if errs != nil {
+		return metrics, scrapererror.NewPartialScrapeError(errs, 0)
+	}
+	return metrics, nil
+}
+
+// scrapeServer collects the metrics of a single server, returning the state it reported.
+func (z *zookeeperMetricsScraper) scrapeServer(ctx context.Context, endpoint string) (string, error) {
+	responseMntr, err := z.runCommand(ctx, endpoint, mntrCommand)
 	if err != nil {
-		return pmetric.NewMetrics(), err
+		return "", err
 	}
 
-	responseRuok, err := z.runCommand(ctx, "ruok")
+	responseRuok, err := z.runCommand(ctx, endpoint, ruokCommand)
 	if err != nil {
-		return pmetric.NewMetrics(), err
+		return "", err
 	}
 
-	z.processMntr(responseMntr)
+	if host, portStr, err := net.SplitHostPort(endpoint); err == nil {
+		z.rb.SetServerAddress(host)
+		if port, err := strconv.ParseInt(portStr, 10, 64); err == nil {
+			z.rb.SetServerPort(port)
+		}
+	}
+	state := z.processMntr(responseMntr)
 	z.processRuok(responseRuok)
 
-	return z.mb.Emit(metadata.WithResource(z.rb.Emit())), nil
+	z.mb.EmitForResource(metadata.WithResource(z.rb.Emit()))
+	return state, nil
 }
 
-func (z *zookeeperMetricsScraper) runCommand(ctx context.Context, command string) ([]string, error) {
-	conn, err := z.config.Dial(context.Background())
+func (z *zookeeperMetricsScraper) runCommand(ctx context.Context, endpoint string, command string) ([]string, error) {
+	addr := confignet.TCPAddrConfig{Endpoint: endpoint, DialerConfig: z.config.DialerConfig}
+	conn, err := addr.Dial(context.Background())
 
 	if err != nil {
 		z.logger.Error("failed to establish connection",
-			zap.String("endpoint", z.config.Endpoint),
+			zap.String("endpoint", endpoint),
 			zap.Error(err),
 		)
 		return nil, err
 	}
+	if z.tlsConfig != nil {
+		conn = tls.Client(conn, z.clientTLSConfig(endpoint))
+	}
 	defer func() {
 		if closeErr := z.closeConnection(conn); closeErr != nil {
 			z.logger.Warn("failed to shutdown connection", zap.Error(closeErr))
@@ -121,6 +187,7 @@ func (z *zookeeperMetricsScraper) runCommand(ctx context.Context, command string
 		z.logger.Error("failed to send command",
 			zap.Error(err),
 			zap.String("command", command),
+			zap.String("endpoint", endpoint),
 		)
 		return nil, err
 	}
@@ -132,7 +199,18 @@ func (z *zookeeperMetricsScraper) runCommand(ctx context.Context, command string
 	return response, nil
 }
 
-func (z *zookeeperMetricsScraper) processMntr(response []string) {
+// clientTLSConfig returns the TLS configuration used to connect to the given endpoint,
+// verifying the server certificate against its host unless a server name is configured.
+func (z *zookeeperMetricsScraper) clientTLSConfig(endpoint string) *tls.Config {
+	if z.tlsConfig.ServerName != "" {
+		return z.tlsConfig
+	}
+	cfg := z.tlsConfig.Clone()
+	cfg.ServerName, _, _ = net.SplitHostPort(endpoint)
+	return cfg
+}
+
+func (z *zookeeperMetricsScraper) processMntr(response []string) (state string) {
 	creator := newMetricCreator(z.mb)
 	now := pcommon.NewTimestampFromTime(time.Now())
 	for _, line := range response {
@@ -153,8 +231,22 @@ func (z *zookeeperMetricsScraper) processMntr(response []string) {
 			continue
 		case serverStateKey:
 			z.rb.SetServerState(metricValue)
+			state = metricValue
 			continue
 		default:
+			if operation, percentile, ok := latencyPercentile(metricKey); ok {
+				floatVal, err := strconv.ParseFloat(metricValue, 64)
+				if err != nil {
+					z.logger.Debug(
+						fmt.Sprintf("non-numeric value from %s", mntrCommand),
+						zap.String("value", metricValue),
+					)
+					continue
+				}
+				z.mb.RecordZookeeperLatencyPercentileDataPoint(now, floatVal, operation, percentile)
+				continue
+			}
+
 			// Skip metric if there is no descriptor associated with it.
 			recordDataPoints := creator.recordDataPointsFunc(metricKey)
 			if recordDataPoints == nil {
@@ -175,6 +267,7 @@ func (z *zookeeperMetricsScraper) processMntr(response []string) {
 
 	// Generate computed metrics
 	creator.generateComputedMetrics(z.logger, now)
+	return state
 }
 
 func (z *zookeeperMetricsScraper) processRuok(response []string) {
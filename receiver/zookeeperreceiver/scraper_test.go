@@ -6,6 +6,7 @@ package zookeeperreceiver
 import (
 	"bufio"
 	"context"
+	"crypto/tls"
 	"errors"
 	"fmt"
 	"net"
@@ -16,8 +17,10 @@ import (
 	"time"
 
 	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/collector/config/configtls"
 	"go.opentelemetry.io/collector/pdata/pmetric"
 	"go.opentelemetry.io/collector/receiver/receivertest"
+	"go.opentelemetry.io/collector/receiver/scrapererror"
 	"go.uber.org/zap"
 	"go.uber.org/zap/zapcore"
 	"go.uber.org/zap/zaptest/observer"
@@ -332,6 +335,7 @@ func TestZookeeperMetricsScraperScrape(t *testing.T) {
 			require.NoError(t, err)
 
 			require.NoError(t, pmetrictest.CompareMetrics(expectedMetrics, actualMetrics,
+				pmetrictest.IgnoreResourceAttributeValue("server.port"),
 				pmetrictest.IgnoreStartTimestamp(), pmetrictest.IgnoreTimestamp()))
 		})
 	}
@@ -344,6 +348,93 @@ func TestZookeeperShutdownBeforeScrape(t *testing.T) {
 	require.NoError(t, z.shutdown(context.Background()))
 }
 
+func TestZookeeperMetricsScraperScrapeEnsemble(t *testing.T) {
+	leader := startMockedServer(t, nil, map[string]string{
+		"mntr": "mntr-3.5.5",
+		"ruok": "ruok-valid",
+	})
+	follower := startMockedServer(t, nil, map[string]string{
+		"mntr": "mntr-3.6.3-follower",
+		"ruok": "ruok-valid",
+	})
+
+	cfg := createDefaultConfig().(*Config)
+	cfg.Endpoints = []string{leader, follower}
+	z, err := newZookeeperMetricsScraper(receivertest.NewNopCreateSettings(), cfg)
+	require.NoError(t, err)
+
+	actualMetrics, err := z.scrape(context.Background())
+	require.NoError(t, err)
+
+	expectedMetrics, err := golden.ReadMetrics(filepath.Join("testdata", "scraper", "ensemble.yaml"))
+	require.NoError(t, err)
+	require.NoError(t, pmetrictest.CompareMetrics(expectedMetrics, actualMetrics,
+		pmetrictest.IgnoreResourceAttributeValue("server.port"),
+		pmetrictest.IgnoreResourceMetricsOrder(),
+		pmetrictest.IgnoreStartTimestamp(), pmetrictest.IgnoreTimestamp()))
+
+	// an unreachable member doesn't prevent collecting metrics from the others
+	cfg.Endpoints = append(cfg.Endpoints, testutil.GetAvailableLocalAddress(t))
+	z, err = newZookeeperMetricsScraper(receivertest.NewNopCreateSettings(), cfg)
+	require.NoError(t, err)
+
+	actualMetrics, err = z.scrape(context.Background())
+	require.True(t, scrapererror.IsPartialScrapeError(err))
+	require.NoError(t, pmetrictest.CompareMetrics(expectedMetrics, actualMetrics,
+		pmetrictest.IgnoreResourceAttributeValue("server.port"),
+		pmetrictest.IgnoreResourceMetricsOrder(),
+		pmetrictest.IgnoreStartTimestamp(), pmetrictest.IgnoreTimestamp()))
+}
+
+func TestZookeeperMetricsScraperScrapeTLS(t *testing.T) {
+	cert, err := tls.LoadX509KeyPair(filepath.Join("testdata", "certs", "server.crt"), filepath.Join("testdata", "certs", "server.key"))
+	require.NoError(t, err)
+	endpoint := startMockedServer(t, &tls.Config{Certificates: []tls.Certificate{cert}, MinVersion: tls.VersionTLS12}, map[string]string{
+		"mntr": "mntr-3.5.5",
+		"ruok": "ruok-valid",
+	})
+
+	cfg := createDefaultConfig().(*Config)
+	cfg.Endpoint = endpoint
+	cfg.TLS = configtls.ClientConfig{
+		Config: configtls.Config{
+			CAFile: filepath.Join("testdata", "certs", "ca.crt"),
+		},
+		ServerName: "localhost",
+	}
+	z, err := newZookeeperMetricsScraper(receivertest.NewNopCreateSettings(), cfg)
+	require.NoError(t, err)
+
+	actualMetrics, err := z.scrape(context.Background())
+	require.NoError(t, err)
+
+	expectedMetrics, err := golden.ReadMetrics(filepath.Join("testdata", "scraper", "correctness-v3.5.5.yaml"))
+	require.NoError(t, err)
+	require.NoError(t, pmetrictest.CompareMetrics(expectedMetrics, actualMetrics,
+		pmetrictest.IgnoreResourceAttributeValue("server.port"),
+		pmetrictest.IgnoreStartTimestamp(), pmetrictest.IgnoreTimestamp()))
+
+}
+
+// startMockedServer starts a mocked Zookeeper server, optionally using TLS, and returns its endpoint.
+func startMockedServer(t *testing.T, tlsConfig *tls.Config, cmdToFileMap map[string]string) string {
+	endpoint := testutil.GetAvailableLocalAddress(t)
+	listener, err := net.Listen("tcp", endpoint)
+	require.NoError(t, err)
+	if tlsConfig != nil {
+		listener = tls.NewListener(listener, tlsConfig)
+	}
+	ms := mockedServer{
+		listener: listener,
+		ready:    make(chan bool, 1),
+		quit:     make(chan struct{}),
+	}
+	t.Cleanup(ms.shutdown)
+	go ms.mockZKServer(t, cmdToFileMap)
+	<-ms.ready
+	return endpoint
+}
+
 type mockedServer struct {
 	listener net.Listener
 